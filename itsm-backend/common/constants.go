@@ -51,12 +51,15 @@ const (
 	TicketStatusOpen       = "open"
 	TicketStatusInProgress = "in_progress"
 	TicketStatusPending    = "pending"
-	TicketStatusResolved   = "resolved"
-	TicketStatusClosed     = "closed"
-	TicketStatusCancelled  = "cancelled"
-	TicketStatusAssigned   = "assigned" // 分配中状态
-	TicketStatusApproved   = "approved"
-	TicketStatusRejected   = "rejected"
+	// TicketStatusPendingCustomer / TicketStatusPendingVendor 等待客户、供应商答复，常作为 SLA 暂停计时条件
+	TicketStatusPendingCustomer = "pending_customer"
+	TicketStatusPendingVendor   = "pending_vendor"
+	TicketStatusResolved        = "resolved"
+	TicketStatusClosed          = "closed"
+	TicketStatusCancelled       = "cancelled"
+	TicketStatusAssigned        = "assigned" // 分配中状态
+	TicketStatusApproved        = "approved"
+	TicketStatusRejected        = "rejected"
 )
 
 // ===================================
@@ -134,10 +137,11 @@ func IsValidIncidentStatusTransition(currentStatus, newStatus string) bool {
 //
 // 规则：
 //   - new        → open / assigned / in_progress / cancelled
-//   - assigned   → in_progress / pending / resolved / cancelled
-//   - open       → in_progress / pending / resolved / cancelled
-//   - in_progress → resolved / pending / cancelled
+//   - assigned   → in_progress / pending* / resolved / cancelled
+//   - open       → in_progress / pending* / resolved / cancelled
+//   - in_progress → resolved / pending* / cancelled
 //   - pending    → in_progress / resolved / open / cancelled
+//   - pending_customer / pending_vendor → 同 pending，且三者之间可互转
 //   - resolved   → closed / in_progress / open
 //   - closed     → 终态，禁止转换
 //   - cancelled  → 终态，禁止转换
@@ -148,16 +152,18 @@ func IsValidTicketStatusTransition(currentStatus, newStatus string) bool {
 		return true
 	}
 	validTransitions := map[string][]string{
-		TicketStatusNew:        {TicketStatusOpen, TicketStatusAssigned, TicketStatusInProgress, TicketStatusCancelled},
-		TicketStatusAssigned:   {TicketStatusInProgress, TicketStatusPending, TicketStatusResolved, TicketStatusCancelled},
-		TicketStatusOpen:       {TicketStatusInProgress, TicketStatusPending, TicketStatusResolved, TicketStatusCancelled},
-		TicketStatusInProgress: {TicketStatusResolved, TicketStatusPending, TicketStatusCancelled},
-		TicketStatusPending:    {TicketStatusInProgress, TicketStatusResolved, TicketStatusOpen, TicketStatusCancelled},
-		TicketStatusResolved:   {TicketStatusClosed, TicketStatusInProgress, TicketStatusOpen},
-		TicketStatusClosed:     {},
-		TicketStatusCancelled:  {},
-		TicketStatusApproved:   {TicketStatusInProgress, TicketStatusResolved, TicketStatusClosed},
-		TicketStatusRejected:   {TicketStatusOpen, TicketStatusCancelled},
+		TicketStatusNew:             {TicketStatusOpen, TicketStatusAssigned, TicketStatusInProgress, TicketStatusCancelled},
+		TicketStatusAssigned:        {TicketStatusInProgress, TicketStatusPending, TicketStatusPendingCustomer, TicketStatusPendingVendor, TicketStatusResolved, TicketStatusCancelled},
+		TicketStatusOpen:            {TicketStatusInProgress, TicketStatusPending, TicketStatusPendingCustomer, TicketStatusPendingVendor, TicketStatusResolved, TicketStatusCancelled},
+		TicketStatusInProgress:      {TicketStatusResolved, TicketStatusPending, TicketStatusPendingCustomer, TicketStatusPendingVendor, TicketStatusCancelled},
+		TicketStatusPending:         {TicketStatusInProgress, TicketStatusResolved, TicketStatusOpen, TicketStatusCancelled, TicketStatusPendingCustomer, TicketStatusPendingVendor},
+		TicketStatusPendingCustomer: {TicketStatusInProgress, TicketStatusResolved, TicketStatusOpen, TicketStatusCancelled, TicketStatusPending, TicketStatusPendingVendor},
+		TicketStatusPendingVendor:   {TicketStatusInProgress, TicketStatusResolved, TicketStatusOpen, TicketStatusCancelled, TicketStatusPending, TicketStatusPendingCustomer},
+		TicketStatusResolved:        {TicketStatusClosed, TicketStatusInProgress, TicketStatusOpen},
+		TicketStatusClosed:          {},
+		TicketStatusCancelled:       {},
+		TicketStatusApproved:        {TicketStatusInProgress, TicketStatusResolved, TicketStatusClosed},
+		TicketStatusRejected:        {TicketStatusOpen, TicketStatusCancelled},
 	}
 	allowed, ok := validTransitions[currentStatus]
 	if !ok {
@@ -0,0 +1,17 @@
+// Package slapause 定义 SLA 计时暂停条件与暂停原因。
+//
+// ent schema（sla_definitions.pause_conditions）、DTO 与 handler 共用这些定义；
+// 本包不依赖项目内其他包，避免 DTO 层为取暂停条件而依赖 ent/schema，也避免与 common 形成循环引用。
+package slapause
+
+// SLA 计时暂停原因
+const (
+	ReasonStatus = "status"  // 工单状态命中 Conditions.Statuses
+	ReasonOnHold = "on_hold" // 工单被挂起且 Conditions.OnHold 为 true
+)
+
+// Conditions SLA计时暂停条件：工单状态命中 Statuses，或 OnHold 为 true 且工单被挂起时停止计时
+type Conditions struct {
+	Statuses []string `json:"statuses,omitempty"` // 暂停计时的工单状态，如 pending_customer、pending_vendor
+	OnHold   bool     `json:"on_hold,omitempty"`  // 工单挂起时是否暂停计时
+}
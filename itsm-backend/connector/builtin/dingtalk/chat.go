@@ -0,0 +1,79 @@
+package dingtalk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"itsm-backend/connector"
+)
+
+// chatChannelPrefix Message.Channel 以此前缀开头时发往企业内部群
+const chatChannelPrefix = "chat:"
+
+// CreateChat 创建企业内部群（/chat/create），成员与群主均为 userid
+func (c *Client) CreateChat(ctx context.Context, spec connector.ChatSpec) (*connector.Chat, error) {
+	if strings.TrimSpace(spec.Name) == "" {
+		return nil, fmt.Errorf("dingtalk: chat name is required")
+	}
+	if spec.OwnerID == "" {
+		return nil, fmt.Errorf("dingtalk: chat owner is required")
+	}
+	tok, err := c.Token(ctx)
+	if err != nil {
+		return nil, err
+	}
+	members := append([]string{spec.OwnerID}, spec.MemberIDs...)
+	payload := map[string]interface{}{
+		"name":       spec.Name,
+		"owner":      spec.OwnerID,
+		"useridlist": dedupe(members),
+	}
+	var out struct {
+		ErrCode int    `json:"errcode"`
+		ErrMsg  string `json:"errmsg"`
+		ChatID  string `json:"chatid"`
+	}
+	if err := c.doJSON(ctx, "POST", "/chat/create", map[string]string{"access_token": tok}, payload, &out); err != nil {
+		return nil, err
+	}
+	if out.ErrCode != 0 {
+		return nil, fmt.Errorf("dingtalk: create chat failed code=%d msg=%s", out.ErrCode, out.ErrMsg)
+	}
+	return &connector.Chat{ID: out.ChatID, Name: spec.Name, Channel: chatChannelPrefix + out.ChatID}, nil
+}
+
+func (c *Client) sendChat(ctx context.Context, msg *connector.Message) error {
+	tok, err := c.Token(ctx)
+	if err != nil {
+		return err
+	}
+	payload := map[string]interface{}{
+		"chatid": strings.TrimPrefix(msg.Channel, chatChannelPrefix),
+		"msg":    buildDingTalkMsg(msg),
+	}
+	var out struct {
+		ErrCode int    `json:"errcode"`
+		ErrMsg  string `json:"errmsg"`
+	}
+	if err := c.doJSON(ctx, "POST", "/chat/send", map[string]string{"access_token": tok}, payload, &out); err != nil {
+		return err
+	}
+	if out.ErrCode != 0 {
+		return fmt.Errorf("dingtalk: chat send failed code=%d msg=%s", out.ErrCode, out.ErrMsg)
+	}
+	return nil
+}
+
+func dedupe(ids []string) []string {
+	seen := make(map[string]bool, len(ids))
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		out = append(out, id)
+	}
+	return out
+}
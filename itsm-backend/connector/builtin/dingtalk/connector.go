@@ -12,6 +12,7 @@ type DingTalk struct {
 	client    *Client
 	cfg       connector.Config
 	startedAt time.Time
+	now       func() time.Time // 测试注入，校验回调时间戳
 }
 
 func init() {
@@ -31,7 +32,9 @@ func (d *DingTalk) Manifest() connector.Manifest {
 		Capabilities: []connector.Capability{
 			connector.CapSendMessage,
 			connector.CapSendCard,
+			connector.CapCreateChat,
 			connector.CapReplyMessage,
+			connector.CapReceiveMessage,
 		},
 		Tags:                []string{"im", "dingtalk", "china"},
 		Homepage:            "https://open.dingtalk.com",
@@ -60,6 +63,14 @@ func (d *DingTalk) Send(ctx context.Context, msg *connector.Message) error {
 	return d.client.Send(ctx, msg)
 }
 
+// CreateChat 实现 connector.ChatCreator
+func (d *DingTalk) CreateChat(ctx context.Context, spec connector.ChatSpec) (*connector.Chat, error) {
+	if d.client == nil {
+		return nil, fmt.Errorf("dingtalk: connector not initialized")
+	}
+	return d.client.CreateChat(ctx, spec)
+}
+
 func (d *DingTalk) HealthCheck(ctx context.Context) connector.HealthStatus {
 	if d.client == nil {
 		return connector.HealthStatus{OK: false, Message: "not initialized"}
@@ -78,4 +89,4 @@ func (d *DingTalk) HealthCheck(ctx context.Context) connector.HealthStatus {
 
 func (d *DingTalk) Close() error { return nil }
 
-var _ connector.Connector = (*DingTalk)(nil)
+var _ connector.ChatCreator = (*DingTalk)(nil)
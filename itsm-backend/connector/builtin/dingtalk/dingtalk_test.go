@@ -1,10 +1,18 @@
 package dingtalk
 
 import (
+	"context"
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
 	"testing"
+	"time"
+
+	"itsm-backend/connector"
 )
 
 func TestSignRobotWebhook(t *testing.T) {
@@ -49,3 +57,86 @@ func TestDingTalkManifest(t *testing.T) {
 		t.Fatalf("unexpected manifest: %+v", m)
 	}
 }
+
+func TestCreateChatAndSendToChat(t *testing.T) {
+	var created, sent map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/gettoken":
+			_, _ = w.Write([]byte(`{"errcode":0,"access_token":"tok","expires_in":7200}`))
+		case "/chat/create":
+			_ = json.NewDecoder(r.Body).Decode(&created)
+			_, _ = w.Write([]byte(`{"errcode":0,"chatid":"chat123"}`))
+		case "/chat/send":
+			_ = json.NewDecoder(r.Body).Decode(&sent)
+			_, _ = w.Write([]byte(`{"errcode":0}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient("k", "s", "", srv.URL)
+	chat, err := c.CreateChat(context.Background(), connector.ChatSpec{Name: "war room", OwnerID: "u1", MemberIDs: []string{"u2", "u1"}})
+	if err != nil {
+		t.Fatalf("create chat: %v", err)
+	}
+	if chat.Channel != "chat:chat123" {
+		t.Fatalf("unexpected channel %q", chat.Channel)
+	}
+	if ids, _ := created["useridlist"].([]interface{}); len(ids) != 2 {
+		t.Fatalf("members should be deduplicated: %+v", created)
+	}
+	if err := c.Send(context.Background(), &connector.Message{Channel: chat.Channel, Content: "hi"}); err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	if sent["chatid"] != "chat123" {
+		t.Fatalf("unexpected send payload: %+v", sent)
+	}
+}
+
+func TestVerifyCallbackSignature(t *testing.T) {
+	d := New()
+	if err := d.Init(context.Background(), connector.Config{Credentials: map[string]string{"app_key": "k", "app_secret": "s"}}); err != nil {
+		t.Fatal(err)
+	}
+	now := time.UnixMilli(1700000000000)
+	d.now = func() time.Time { return now }
+	ts := strconv.FormatInt(now.UnixMilli(), 10)
+
+	if err := d.VerifySignature(map[string]string{"Timestamp": ts, "Sign": CallbackSign("s", ts)}, nil); err != nil {
+		t.Fatalf("valid signature rejected: %v", err)
+	}
+	if err := d.VerifySignature(map[string]string{"timestamp": ts, "sign": CallbackSign("other", ts)}, nil); err == nil {
+		t.Fatal("signature with wrong secret should be rejected")
+	}
+	stale := strconv.FormatInt(now.Add(-2*time.Hour).UnixMilli(), 10)
+	if err := d.VerifySignature(map[string]string{"timestamp": stale, "sign": CallbackSign("s", stale)}, nil); err == nil {
+		t.Fatal("stale timestamp should be rejected")
+	}
+	if err := d.VerifySignature(map[string]string{}, nil); err == nil {
+		t.Fatal("missing headers should be rejected")
+	}
+}
+
+func TestParseInbound(t *testing.T) {
+	d := New()
+	msg, err := d.ParseInbound([]byte(`{"msgtype":"text","text":{"content":" 查询 TK-1 "},"msgId":"msg1","createAt":1700000000000,
+		"conversationType":"2","conversationId":"cid1","senderId":"sid","senderNick":"alice","senderStaffId":"staff1",
+		"chatbotUserId":"bot","atUsers":[{"dingtalkId":"bot"},{"dingtalkId":"d2","staffId":"staff2"}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.ConnectorName != "dingtalk" || msg.MessageID != "msg1" || msg.Type != "text" || msg.Content != "查询 TK-1" {
+		t.Fatalf("unexpected message: %+v", msg)
+	}
+	if msg.UserID != "staff1" || msg.ChatID != "cid1" || msg.ChatType != "group" {
+		t.Fatalf("unexpected sender or chat: %+v", msg)
+	}
+	if len(msg.Mentions) != 1 || msg.Mentions[0].ID != "staff2" {
+		t.Fatalf("bot mention should be skipped: %+v", msg.Mentions)
+	}
+	if _, err := d.ParseInbound([]byte(`{"msgtype":"text"}`)); err == nil {
+		t.Fatal("message without msgId should fail")
+	}
+}
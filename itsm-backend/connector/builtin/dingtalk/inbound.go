@@ -0,0 +1,152 @@
+package dingtalk
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"itsm-backend/connector"
+)
+
+// maxCallbackSkew 机器人回调 timestamp 与本机时间允许的最大偏差（钉钉要求 1 小时内）
+const maxCallbackSkew = time.Hour
+
+// robotCallback 企业内部机器人接收消息回调
+// https://open.dingtalk.com/document/orgapp/receive-message
+type robotCallback struct {
+	MsgType string `json:"msgtype"`
+	Text    struct {
+		Content string `json:"content"`
+	} `json:"text"`
+	MsgID                     string `json:"msgId"`
+	CreateAt                  int64  `json:"createAt"`
+	ConversationType          string `json:"conversationType"` // 1 单聊 / 2 群聊
+	ConversationID            string `json:"conversationId"`
+	ConversationTitle         string `json:"conversationTitle"`
+	SenderID                  string `json:"senderId"`
+	SenderNick                string `json:"senderNick"`
+	SenderStaffID             string `json:"senderStaffId"`
+	ChatbotUserID             string `json:"chatbotUserId"`
+	SessionWebhook            string `json:"sessionWebhook"`
+	SessionWebhookExpiredTime int64  `json:"sessionWebhookExpiredTime"`
+	AtUsers                   []struct {
+		DingtalkID string `json:"dingtalkId"`
+		StaffID    string `json:"staffId"`
+	} `json:"atUsers"`
+}
+
+// VerifySignature 满足 connector.Receiver 接口
+// 签名 = base64(HMAC-SHA256(appSecret, timestamp + "\n" + appSecret))，timestamp 为毫秒
+func (d *DingTalk) VerifySignature(headers map[string]string, _ []byte) error {
+	if d.client == nil {
+		return fmt.Errorf("dingtalk: not initialized")
+	}
+	now := time.Now
+	if d.now != nil {
+		now = d.now
+	}
+	return VerifyCallbackSignature(d.client.appSecret, headerValue(headers, "timestamp"), headerValue(headers, "sign"), now())
+}
+
+// VerifyCallbackSignature 校验机器人回调签名与时间戳
+func VerifyCallbackSignature(appSecret, timestamp, sign string, now time.Time) error {
+	if appSecret == "" {
+		return fmt.Errorf("dingtalk: app_secret is not configured")
+	}
+	if timestamp == "" || sign == "" {
+		return fmt.Errorf("dingtalk: missing signature headers")
+	}
+	ms, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("dingtalk: invalid request timestamp")
+	}
+	if skew := now.Sub(time.UnixMilli(ms)); skew > maxCallbackSkew || skew < -maxCallbackSkew {
+		return fmt.Errorf("dingtalk: stale request timestamp")
+	}
+	if !hmac.Equal([]byte(sign), []byte(CallbackSign(appSecret, timestamp))) {
+		return fmt.Errorf("dingtalk: signature mismatch")
+	}
+	return nil
+}
+
+// CallbackSign 计算机器人回调签名
+func CallbackSign(appSecret, timestamp string) string {
+	mac := hmac.New(sha256.New, []byte(appSecret))
+	mac.Write([]byte(timestamp + "\n" + appSecret))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// ParseInbound 解析机器人消息回调；消息ID用于入站去重
+func (d *DingTalk) ParseInbound(body []byte) (*connector.InboundMessage, error) {
+	var cb robotCallback
+	if err := json.Unmarshal(body, &cb); err != nil {
+		return nil, fmt.Errorf("dingtalk: parse inbound: %w", err)
+	}
+	if cb.MsgID == "" {
+		return nil, fmt.Errorf("dingtalk: missing msgId")
+	}
+	chatType := "group"
+	if cb.ConversationType == "1" {
+		chatType = "direct"
+	}
+	mentions := make([]connector.Mention, 0, len(cb.AtUsers))
+	for _, u := range cb.AtUsers {
+		if u.DingtalkID == cb.ChatbotUserID {
+			continue
+		}
+		mentions = append(mentions, connector.Mention{Type: "user", ID: firstNonEmpty(u.StaffID, u.DingtalkID)})
+	}
+	receivedAt := time.Now()
+	if cb.CreateAt > 0 {
+		receivedAt = time.UnixMilli(cb.CreateAt)
+	}
+	return &connector.InboundMessage{
+		ConnectorType: connector.TypeIM,
+		ConnectorName: "dingtalk",
+		Channel:       cb.ConversationID,
+		UserID:        firstNonEmpty(cb.SenderStaffID, cb.SenderID),
+		UserName:      cb.SenderNick,
+		ChatID:        cb.ConversationID,
+		ChatType:      chatType,
+		MessageID:     cb.MsgID,
+		Content:       strings.TrimSpace(cb.Text.Content),
+		Type:          cb.MsgType,
+		Mentions:      mentions,
+		Raw:           body,
+		ReceivedAt:    receivedAt,
+		Extras: map[string]interface{}{
+			"conversation_title": cb.ConversationTitle,
+			"session_webhook":    cb.SessionWebhook,
+			"chatbot_user_id":    cb.ChatbotUserID,
+		},
+	}, nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// headerValue 兼容 http.Header 规范化前后的大小写
+func headerValue(headers map[string]string, key string) string {
+	if v, ok := headers[key]; ok {
+		return v
+	}
+	for k, v := range headers {
+		if strings.EqualFold(k, key) {
+			return v
+		}
+	}
+	return ""
+}
+
+var _ connector.Receiver = (*DingTalk)(nil)
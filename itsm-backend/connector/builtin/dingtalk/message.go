@@ -14,6 +14,7 @@ import (
 //   - "user:USERID" 或 "USERID"   -> 工作通知（发给指定 userid）
 //   - "dept:DEPTID"               -> 部门通知
 //   - "all"                       -> 全员
+//   - "chat:CHATID"               -> 企业内部群（见 CreateChat）
 //   - 其它：当作群机器人 webhook URL
 func (c *Client) Send(ctx context.Context, msg *connector.Message) error {
 	if msg == nil || msg.Channel == "" {
@@ -22,6 +23,9 @@ func (c *Client) Send(ctx context.Context, msg *connector.Message) error {
 	if strings.HasPrefix(msg.Channel, "http://") || strings.HasPrefix(msg.Channel, "https://") {
 		return c.sendRobot(ctx, msg)
 	}
+	if strings.HasPrefix(msg.Channel, chatChannelPrefix) {
+		return c.sendChat(ctx, msg)
+	}
 	return c.sendWorkNotice(ctx, msg)
 }
 
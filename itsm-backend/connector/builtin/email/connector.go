@@ -0,0 +1,479 @@
+// Package email 邮件连接器：SMTP 出站 + IMAP 轮询/Webhook 原始 MIME 入站。
+// 入站邮件解析为统一的 InboundMessage，由业务层映射发件人、创建工单或按线程追加回复。
+package email
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"itsm-backend/connector"
+)
+
+const (
+	defaultIMAPPort        = 993
+	defaultSMTPPort        = 587
+	defaultMaxMessageBytes = 25 << 20
+	defaultMaxPerPoll      = 50
+	defaultTimeout         = 30 * time.Second
+
+	// SignatureHeader Webhook 投递原始 MIME 时的签名头：sha256=HMAC-SHA256(webhook_secret, body)，与出站 webhook 连接器一致
+	SignatureHeader = "X-ITSM-Signature"
+	// LoopHeader 出站邮件携带的回环标记，入站见到即丢弃
+	LoopHeader = "X-ITSM-Loop"
+)
+
+type imapConfig struct {
+	Host               string
+	Port               int
+	Username           string
+	Password           string
+	Folder             string
+	Security           string
+	InsecureSkipVerify bool
+	MaxMessageBytes    int64
+	Timeout            time.Duration
+}
+
+type smtpConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	Security string
+}
+
+// Mailbox 入站邮箱的业务配置
+type Mailbox struct {
+	Address            string // 本邮箱地址：出站 From、回环检测、Message-ID 域名
+	DefaultRequesterID int    // 发件人未匹配到用户时的兜底报告人；0 表示忽略陌生发件人
+	DefaultPriority    string // 新建工单优先级，默认 medium
+	DefaultType        string // 新建工单类型，为空时沿用工单服务默认值
+	Category           string // 新建工单分类名称
+}
+
+// Email 邮件连接器实现
+type Email struct {
+	cfg        connector.Config
+	mailbox    Mailbox
+	imap       imapConfig
+	smtp       smtpConfig
+	secret     string
+	maxPerPoll int
+	startedAt  time.Time
+
+	pollMu sync.Mutex // 同一实例的轮询串行执行
+}
+
+func init() {
+	connector.MustRegister(func() connector.Connector { return New() })
+}
+
+func New() *Email { return &Email{} }
+
+func (e *Email) Manifest() connector.Manifest {
+	return connector.Manifest{
+		Name:        "email",
+		Version:     "1.0.0",
+		Title:       "邮件 Email",
+		Provider:    "imap",
+		Type:        connector.TypeEmail,
+		Description: "邮件渠道：IMAP 轮询或 Webhook 接收原始 MIME 创建工单，按 [TICKET-xxx] 标签与 In-Reply-To/References 线程归并回复；SMTP 发送通知。",
+		Capabilities: []connector.Capability{
+			connector.CapSendMessage,
+			connector.CapReceiveMessage,
+			connector.CapReplyMessage,
+			connector.CapCreateTicket,
+			connector.CapUpdateTicket,
+			connector.CapHealthCheck,
+		},
+		Tags:                []string{"email", "imap", "smtp", "inbound"},
+		IsOfficial:          true,
+		RequiredPermissions: []string{"connector:write", "ticket:write"},
+	}
+}
+
+// Init 读取配置。settings: address, imap_host, imap_port, imap_username, imap_folder, imap_security,
+// smtp_host, smtp_port, smtp_username, smtp_security, default_requester_id, default_priority,
+// default_type, category, max_messages_per_poll, callbackInstanceId；
+// credentials: imap_password, smtp_password, webhook_secret。IMAP 与 webhook_secret 至少配置一种入站方式。
+func (e *Email) Init(_ context.Context, cfg connector.Config) error {
+	address := strings.ToLower(settingString(cfg.Settings, "address"))
+	if address == "" {
+		return fmt.Errorf("email: settings.address is required")
+	}
+	e.mailbox = Mailbox{
+		Address:            address,
+		DefaultRequesterID: settingInt(cfg.Settings, "default_requester_id", 0),
+		DefaultPriority:    settingString(cfg.Settings, "default_priority"),
+		DefaultType:        settingString(cfg.Settings, "default_type"),
+		Category:           settingString(cfg.Settings, "category"),
+	}
+	e.imap = imapConfig{
+		Host:               settingString(cfg.Settings, "imap_host"),
+		Port:               settingInt(cfg.Settings, "imap_port", defaultIMAPPort),
+		Username:           firstNonEmpty(settingString(cfg.Settings, "imap_username"), address),
+		Password:           cfg.Credentials["imap_password"],
+		Folder:             firstNonEmpty(settingString(cfg.Settings, "imap_folder"), "INBOX"),
+		Security:           firstNonEmpty(settingString(cfg.Settings, "imap_security"), SecuritySSL),
+		InsecureSkipVerify: settingBool(cfg.Settings, "imap_insecure_skip_verify"),
+		MaxMessageBytes:    int64(settingInt(cfg.Settings, "max_message_bytes", defaultMaxMessageBytes)),
+		Timeout:            defaultTimeout,
+	}
+	e.smtp = smtpConfig{
+		Host:     settingString(cfg.Settings, "smtp_host"),
+		Port:     settingInt(cfg.Settings, "smtp_port", defaultSMTPPort),
+		Username: firstNonEmpty(settingString(cfg.Settings, "smtp_username"), address),
+		Password: cfg.Credentials["smtp_password"],
+		Security: firstNonEmpty(settingString(cfg.Settings, "smtp_security"), SecuritySTARTTLS),
+	}
+	e.secret = cfg.Credentials["webhook_secret"]
+	e.maxPerPoll = settingInt(cfg.Settings, "max_messages_per_poll", defaultMaxPerPoll)
+	if e.imap.Host == "" && e.secret == "" {
+		return fmt.Errorf("email: settings.imap_host or credentials.webhook_secret is required")
+	}
+	if e.imap.Host != "" && e.imap.Password == "" {
+		return fmt.Errorf("email: credentials.imap_password is required when imap_host is set")
+	}
+	switch e.imap.Security {
+	case SecuritySSL, SecuritySTARTTLS, SecurityNone:
+	default:
+		return fmt.Errorf("email: unsupported imap_security %q", e.imap.Security)
+	}
+	e.cfg = cfg
+	e.startedAt = time.Now()
+	return nil
+}
+
+// Mailbox 返回入站邮箱配置
+func (e *Email) Mailbox() Mailbox { return e.mailbox }
+
+// PollingEnabled 是否配置了 IMAP 轮询
+func (e *Email) PollingEnabled() bool { return e.imap.Host != "" }
+
+// CallbackInstanceID Webhook 入站地址中的实例ID
+func (e *Email) CallbackInstanceID() string {
+	id, _ := e.cfg.Settings["callbackInstanceId"].(string)
+	return id
+}
+
+// Send 通过 SMTP 发送通知。工单相关消息在主题中带 [TICKET-<id>] 标签并编码 Message-ID，
+// 收件人直接回复即可线程归并；同时标记 Auto-Submitted 与回环头，避免与对端自动回复互相触发。
+func (e *Email) Send(ctx context.Context, msg *connector.Message) error {
+	if e.smtp.Host == "" {
+		return fmt.Errorf("email: smtp_host not configured")
+	}
+	if msg == nil || msg.Channel == "" {
+		return fmt.Errorf("email: recipient is required")
+	}
+	to := strings.TrimSpace(msg.Channel)
+	if strings.ContainsAny(to, "\r\n") || strings.ContainsAny(msg.Title, "\r\n") {
+		return fmt.Errorf("email: invalid header characters")
+	}
+	ticketID := 0
+	if resourceType, _ := msg.Metadata["resource_type"].(string); resourceType == "ticket" {
+		ticketID = metadataInt(msg.Metadata["resource_id"])
+	}
+	subject := msg.Title
+	if ticketID > 0 {
+		subject = fmt.Sprintf("[TICKET-%d] %s", ticketID, subject)
+	}
+	domain := e.domain()
+	digestSrc := sha256.Sum256([]byte(msg.ID + "|" + to + "|" + strconv.FormatInt(time.Now().UnixNano(), 10)))
+	digest := hex.EncodeToString(digestSrc[:8])
+	messageID := digest + "@" + domain
+	if ticketID > 0 {
+		messageID = OutboundMessageID(ticketID, digest, domain)
+	}
+
+	body := msg.Content
+	for _, action := range msg.Actions {
+		if action.URL != "" {
+			body += "\n\n" + action.Text + ": " + action.URL
+		}
+	}
+	var b strings.Builder
+	b.WriteString("From: " + e.mailbox.Address + "\r\n")
+	b.WriteString("To: " + to + "\r\n")
+	b.WriteString("Subject: " + mime.BEncoding.Encode("UTF-8", subject) + "\r\n")
+	b.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	b.WriteString("Message-ID: <" + messageID + ">\r\n")
+	if msg.ReplyTo != "" && !strings.ContainsAny(msg.ReplyTo, "\r\n<>") {
+		b.WriteString("In-Reply-To: <" + msg.ReplyTo + ">\r\n")
+		b.WriteString("References: <" + msg.ReplyTo + ">\r\n")
+	}
+	b.WriteString("Auto-Submitted: auto-generated\r\n")
+	b.WriteString(LoopHeader + ": " + e.mailbox.Address + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: base64\r\n\r\n")
+	b.WriteString(wrapBase64([]byte(body)))
+	return e.sendSMTP(ctx, to, []byte(b.String()))
+}
+
+func (e *Email) sendSMTP(ctx context.Context, to string, data []byte) error {
+	addr := net.JoinHostPort(e.smtp.Host, strconv.Itoa(e.smtp.Port))
+	dialer := &net.Dialer{Timeout: defaultTimeout}
+	var conn net.Conn
+	var err error
+	tlsConfig := &tls.Config{ServerName: e.smtp.Host}
+	if e.smtp.Security == SecuritySSL {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: tlsConfig}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return fmt.Errorf("email: smtp dial: %w", err)
+	}
+	_ = conn.SetDeadline(time.Now().Add(defaultTimeout))
+	client, err := smtp.NewClient(conn, e.smtp.Host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("email: smtp handshake: %w", err)
+	}
+	defer client.Close()
+	if e.smtp.Security == SecuritySTARTTLS {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err := client.StartTLS(tlsConfig); err != nil {
+				return fmt.Errorf("email: smtp starttls: %w", err)
+			}
+		}
+	}
+	if e.smtp.Password != "" {
+		if err := client.Auth(smtp.PlainAuth("", e.smtp.Username, e.smtp.Password, e.smtp.Host)); err != nil {
+			return fmt.Errorf("email: smtp auth: %w", err)
+		}
+	}
+	if err := client.Mail(e.mailbox.Address); err != nil {
+		return fmt.Errorf("email: smtp mail from: %w", err)
+	}
+	if err := client.Rcpt(to); err != nil {
+		return fmt.Errorf("email: smtp rcpt: %w", err)
+	}
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("email: smtp data: %w", err)
+	}
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("email: smtp write: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("email: smtp data: %w", err)
+	}
+	return client.Quit()
+}
+
+func (e *Email) HealthCheck(ctx context.Context) connector.HealthStatus {
+	if e.mailbox.Address == "" {
+		return connector.HealthStatus{OK: false, Message: "not initialized"}
+	}
+	if !e.PollingEnabled() {
+		return connector.HealthStatus{OK: true, Message: "webhook inbound only", CheckedAt: time.Now()}
+	}
+	start := time.Now()
+	c, err := dialIMAP(ctx, e.imap)
+	if err == nil {
+		err = c.Login(e.imap.Username, e.imap.Password)
+		if err == nil {
+			err = c.Select(e.imap.Folder)
+		}
+		c.Logout()
+		c.Close()
+	}
+	if err != nil {
+		return connector.HealthStatus{OK: false, Message: err.Error(), CheckedAt: time.Now()}
+	}
+	return connector.HealthStatus{
+		OK:        true,
+		LatencyMs: time.Since(start).Milliseconds(),
+		Message:   "imap mailbox reachable",
+		CheckedAt: time.Now(),
+		Extra:     map[string]interface{}{"started_at": e.startedAt, "folder": e.imap.Folder},
+	}
+}
+
+func (e *Email) Close() error { return nil }
+
+// VerifySignature 校验 Webhook 投递的原始 MIME：sha256=HMAC-SHA256(webhook_secret, body)
+func (e *Email) VerifySignature(headers map[string]string, body []byte) error {
+	if e.secret == "" {
+		return fmt.Errorf("email: webhook inbound not enabled")
+	}
+	var sig string
+	for k, v := range headers {
+		if strings.EqualFold(k, SignatureHeader) {
+			sig = v
+			break
+		}
+	}
+	if sig == "" {
+		return fmt.Errorf("email: missing signature header")
+	}
+	mac := hmac.New(sha256.New, []byte(e.secret))
+	mac.Write(body)
+	expected := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(expected), []byte(strings.TrimSpace(sig))) {
+		return fmt.Errorf("email: signature mismatch")
+	}
+	return nil
+}
+
+// ParseInbound 解析原始 MIME 为统一入站消息；解析后的邮件放在 Extras["email"]
+func (e *Email) ParseInbound(body []byte) (*connector.InboundMessage, error) {
+	m, err := Parse(body)
+	if err != nil {
+		return nil, err
+	}
+	return e.toInbound(m), nil
+}
+
+func (e *Email) toInbound(m *Message) *connector.InboundMessage {
+	return &connector.InboundMessage{
+		ConnectorName: "email",
+		ConnectorType: connector.TypeEmail,
+		Channel:       e.mailbox.Address,
+		UserID:        m.From.Address,
+		UserName:      m.From.Name,
+		ChatType:      "direct",
+		MessageID:     m.MessageID,
+		Content:       m.Text,
+		Type:          "email",
+		ReceivedAt:    time.Now(),
+		Extras:        map[string]interface{}{"email": m, "subject": m.Subject},
+	}
+}
+
+// Poll 拉取 IMAP 未读邮件并逐封交给 handle 处理；处理成功（或无法解析）的邮件标记为已读，
+// 处理失败的保持未读，下个周期重试。返回成功处理的封数。
+func (e *Email) Poll(ctx context.Context, handle func(context.Context, *connector.InboundMessage) error) (int, error) {
+	if !e.PollingEnabled() {
+		return 0, connector.ErrNotSupported
+	}
+	e.pollMu.Lock()
+	defer e.pollMu.Unlock()
+
+	c, err := dialIMAP(ctx, e.imap)
+	if err != nil {
+		return 0, err
+	}
+	defer c.Close()
+	if err := c.Login(e.imap.Username, e.imap.Password); err != nil {
+		return 0, err
+	}
+	defer c.Logout()
+	if err := c.Select(e.imap.Folder); err != nil {
+		return 0, err
+	}
+	uids, err := c.SearchUnseen()
+	if err != nil {
+		return 0, err
+	}
+	if e.maxPerPoll > 0 && len(uids) > e.maxPerPoll {
+		uids = uids[:e.maxPerPoll]
+	}
+	handled := 0
+	for _, uid := range uids {
+		if ctx.Err() != nil {
+			return handled, ctx.Err()
+		}
+		raw, err := c.FetchRaw(uid)
+		if err != nil {
+			return handled, err
+		}
+		m, err := Parse(raw)
+		if err == nil {
+			if err := handle(ctx, e.toInbound(m)); err != nil {
+				continue
+			}
+			handled++
+		}
+		if err := c.MarkSeen(uid); err != nil {
+			return handled, err
+		}
+	}
+	return handled, nil
+}
+
+func (e *Email) domain() string {
+	if _, domain, ok := strings.Cut(e.mailbox.Address, "@"); ok && domain != "" {
+		return domain
+	}
+	return "itsm.local"
+}
+
+func wrapBase64(data []byte) string {
+	encoded := []byte(base64.StdEncoding.EncodeToString(data))
+	var b strings.Builder
+	for len(encoded) > 76 {
+		b.Write(encoded[:76])
+		b.WriteString("\r\n")
+		encoded = encoded[76:]
+	}
+	b.Write(encoded)
+	b.WriteString("\r\n")
+	return b.String()
+}
+
+func settingString(settings map[string]interface{}, key string) string {
+	v, _ := settings[key].(string)
+	return strings.TrimSpace(v)
+}
+
+func settingInt(settings map[string]interface{}, key string, def int) int {
+	switch v := settings[key].(type) {
+	case float64:
+		return int(v)
+	case int:
+		return v
+	case string:
+		if n, err := strconv.Atoi(strings.TrimSpace(v)); err == nil {
+			return n
+		}
+	}
+	return def
+}
+
+func settingBool(settings map[string]interface{}, key string) bool {
+	switch v := settings[key].(type) {
+	case bool:
+		return v
+	case string:
+		return v == "true"
+	}
+	return false
+}
+
+func metadataInt(v interface{}) int {
+	switch n := v.(type) {
+	case int:
+		return n
+	case float64:
+		return int(n)
+	case string:
+		id, _ := strconv.Atoi(n)
+		return id
+	}
+	return 0
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+var _ connector.Receiver = (*Email)(nil)
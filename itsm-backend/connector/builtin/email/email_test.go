@@ -0,0 +1,368 @@
+package email
+
+import (
+	"bufio"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"itsm-backend/connector"
+)
+
+func crlf(s string) []byte { return []byte(strings.ReplaceAll(s, "\n", "\r\n")) }
+
+func TestParse_MultipartWithAttachment(t *testing.T) {
+	raw := crlf(`From: =?UTF-8?B?5byg5LiJ?= <ZhangSan@Example.COM>
+To: support@itsm.example.com, ops@itsm.example.com
+Subject: =?UTF-8?B?5omT5Y2w5py65peg5rOV5L2/55So?=
+Message-ID: <abc@example.com>
+In-Reply-To: <ticket-7.aa@itsm.example.com>
+References: <x1@example.com> <ticket-7.aa@itsm.example.com>
+MIME-Version: 1.0
+Content-Type: multipart/mixed; boundary="outer"
+
+--outer
+Content-Type: multipart/alternative; boundary="inner"
+
+--inner
+Content-Type: text/plain; charset=UTF-8
+Content-Transfer-Encoding: quoted-printable
+
+=E6=89=93=E5=8D=B0=E6=9C=BA=E5=8D=A1=E7=BA=B8
+--inner
+Content-Type: text/html; charset=UTF-8
+
+<p>打印机卡纸</p>
+--inner--
+--outer
+Content-Type: image/png
+Content-Disposition: attachment; filename="=?UTF-8?B?5oiq5Zu+LnBuZw==?="
+Content-Transfer-Encoding: base64
+
+iVBORw0KGgo=
+--outer--
+`)
+	m, err := Parse(raw)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if m.MessageID != "abc@example.com" || m.InReplyTo != "ticket-7.aa@itsm.example.com" {
+		t.Fatalf("unexpected ids: %q %q", m.MessageID, m.InReplyTo)
+	}
+	if len(m.References) != 2 || m.References[0] != "x1@example.com" {
+		t.Fatalf("unexpected references: %v", m.References)
+	}
+	if m.From.Address != "zhangsan@example.com" || m.From.Name != "张三" {
+		t.Fatalf("unexpected from: %+v", m.From)
+	}
+	if len(m.To) != 2 {
+		t.Fatalf("unexpected to: %v", m.To)
+	}
+	if m.Subject != "打印机无法使用" {
+		t.Fatalf("unexpected subject: %q", m.Subject)
+	}
+	if strings.TrimSpace(m.Text) != "打印机卡纸" || !strings.Contains(m.HTML, "<p>") {
+		t.Fatalf("unexpected bodies: %q %q", m.Text, m.HTML)
+	}
+	if len(m.Attachments) != 1 {
+		t.Fatalf("expected 1 attachment, got %d", len(m.Attachments))
+	}
+	att := m.Attachments[0]
+	if att.Filename != "截图.png" || att.ContentType != "image/png" || string(att.Data[:4]) != "\x89PNG" {
+		t.Fatalf("unexpected attachment: %s %s %q", att.Filename, att.ContentType, att.Data)
+	}
+}
+
+func TestParse_CharsetAndFallbacks(t *testing.T) {
+	// GBK 编码的 "你好"
+	raw := append(crlf("From: a@example.com\nSubject: hi\nContent-Type: text/plain; charset=gbk\n\n"), 0xc4, 0xe3, 0xba, 0xc3)
+	m, err := Parse(raw)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if m.Text != "你好" {
+		t.Fatalf("expected gbk decoded text, got %q", m.Text)
+	}
+	if !strings.HasSuffix(m.MessageID, "@generated.invalid") {
+		t.Fatalf("expected generated message id, got %q", m.MessageID)
+	}
+	again, _ := Parse(raw)
+	if again.MessageID != m.MessageID {
+		t.Fatal("generated message id must be stable for redelivery")
+	}
+
+	htmlOnly := crlf("From: a@example.com\nSubject: hi\nContent-Type: text/html; charset=UTF-8\n\n<div>新问题<br>第二行</div><div class=\"gmail_quote\">旧内容</div>")
+	m, err = Parse(htmlOnly)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if m.Text != "新问题\n第二行" {
+		t.Fatalf("unexpected html fallback text: %q", m.Text)
+	}
+}
+
+func TestStripReply(t *testing.T) {
+	cases := map[string]struct{ in, want string }{
+		"english quote": {
+			in:   "Still broken.\n\nOn Mon, Oct 12, 2026 at 9:00 AM Support <s@x.com> wrote:\n> try again\n",
+			want: "Still broken.",
+		},
+		"wrapped english quote": {
+			in:   "ok\nOn Mon, Oct 12, 2026 at 9:00 AM Support <s@x.com>\nwrote:\n> try again\n",
+			want: "ok",
+		},
+		"chinese quote": {
+			in:   "已处理\n\n在 2026年10月12日 09:00，支持团队 <s@x.com> 写道：\n> 请确认\n",
+			want: "已处理",
+		},
+		"outlook": {
+			in:   "收到\n\n发件人: 支持团队 <s@x.com>\n发送时间: 2026年10月12日 9:00\n收件人: me\n",
+			want: "收到",
+		},
+		"original message": {
+			in:   "thanks\n-----Original Message-----\nFrom: x\n",
+			want: "thanks",
+		},
+		"signature": {
+			in:   "line one\nline two\n-- \nAlice\nIT Dept\n",
+			want: "line one\nline two",
+		},
+		"mobile signature": {
+			in:   "好的\n\n发自我的iPhone\n",
+			want: "好的",
+		},
+		"inline quotes dropped": {
+			in:   "> old\nnew text\n",
+			want: "new text",
+		},
+		"all quoted keeps original": {
+			in:   "> only quoted\n",
+			want: "> only quoted",
+		},
+	}
+	for name, c := range cases {
+		if got := StripReply(c.in); got != c.want {
+			t.Errorf("%s: got %q, want %q", name, got, c.want)
+		}
+	}
+}
+
+func TestHTMLToText(t *testing.T) {
+	in := `<html><head><title>x</title><style>p{}</style></head><body><p>第一段 &amp; more</p><script>alert(1)</script>` +
+		`<p>第二段</p><blockquote>引用</blockquote><div id="divRplyFwdMsg">From: x</div></body></html>`
+	if got := HTMLToText(in); got != "第一段 & more\n\n第二段" {
+		t.Fatalf("unexpected text: %q", got)
+	}
+}
+
+func TestSubjectHelpers(t *testing.T) {
+	if got := TicketTag("Re: [TICKET-42] 打印机"); got != "42" {
+		t.Fatalf("tag: %q", got)
+	}
+	if got := TicketTag("回复：[ticket-INC-2026-0001] x"); got != "INC-2026-0001" {
+		t.Fatalf("tag number: %q", got)
+	}
+	if got := TicketTag("no tag"); got != "" {
+		t.Fatalf("expected no tag, got %q", got)
+	}
+	if got := CleanSubject("RE: Fwd: 回复：[TICKET-42]  打印机   卡纸"); got != "打印机 卡纸" {
+		t.Fatalf("clean subject: %q", got)
+	}
+	id := OutboundMessageID(42, "0a1b", "itsm.example.com")
+	if TicketIDFromMessageID(id, "ITSM.example.com") != 42 {
+		t.Fatalf("expected ticket id from %q", id)
+	}
+	if TicketIDFromMessageID(id, "evil.com") != 0 {
+		t.Fatal("foreign domain must not resolve")
+	}
+}
+
+func TestIgnoreReason(t *testing.T) {
+	cases := map[string]string{
+		"":               "From: a@example.com\nSubject: 请求\n\nbody",
+		"auto_submitted": "From: a@example.com\nAuto-Submitted: auto-replied\nSubject: x\n\nbody",
+		"auto_reply":     "From: a@example.com\nSubject: Automatic reply: 请求\n\nbody",
+		"bulk":           "From: a@example.com\nPrecedence: list\nSubject: x\n\nbody",
+		"mailing_list":   "From: a@example.com\nList-Id: <team.example.com>\nSubject: x\n\nbody",
+		"bounce":         "From: a@example.com\nReturn-Path: <>\nSubject: x\n\nbody",
+		"system_sender":  "From: no-reply@example.com\nSubject: x\n\nbody",
+		"loop_self":      "From: Support@ITSM.example.com\nSubject: x\n\nbody",
+		"loop_header":    "From: a@example.com\nX-ITSM-Loop: support@itsm.example.com\nSubject: x\n\nbody",
+	}
+	for want, raw := range cases {
+		m, err := Parse(crlf(raw))
+		if err != nil {
+			t.Fatalf("parse %s: %v", want, err)
+		}
+		if got := m.IgnoreReason("support@itsm.example.com"); got != want {
+			t.Errorf("expected %q, got %q", want, got)
+		}
+	}
+}
+
+func TestInitAndVerifySignature(t *testing.T) {
+	e := New()
+	if err := e.Init(context.Background(), connector.Config{Settings: map[string]interface{}{"address": "support@x.com"}}); err == nil {
+		t.Fatal("expected error without any inbound method")
+	}
+	err := e.Init(context.Background(), connector.Config{
+		Name:        "email",
+		Settings:    map[string]interface{}{"address": "Support@X.com", "default_requester_id": float64(9)},
+		Credentials: map[string]string{"webhook_secret": "s3cret"},
+	})
+	if err != nil {
+		t.Fatalf("init: %v", err)
+	}
+	if e.PollingEnabled() || e.Mailbox().Address != "support@x.com" || e.Mailbox().DefaultRequesterID != 9 {
+		t.Fatalf("unexpected mailbox: %+v", e.Mailbox())
+	}
+
+	body := []byte("From: a@example.com\r\nSubject: x\r\n\r\nhi")
+	mac := hmac.New(sha256.New, []byte("s3cret"))
+	mac.Write(body)
+	sig := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	if err := e.VerifySignature(map[string]string{"x-itsm-signature": sig}, body); err != nil {
+		t.Fatalf("expected valid signature: %v", err)
+	}
+	if err := e.VerifySignature(map[string]string{SignatureHeader: "sha256=00"}, body); err == nil {
+		t.Fatal("expected mismatch")
+	}
+	if err := e.VerifySignature(map[string]string{}, body); err == nil {
+		t.Fatal("expected missing header error")
+	}
+	msg, err := e.ParseInbound(body)
+	if err != nil {
+		t.Fatalf("parse inbound: %v", err)
+	}
+	if msg.ConnectorType != connector.TypeEmail || msg.UserID != "a@example.com" || msg.Extras["email"] == nil {
+		t.Fatalf("unexpected inbound: %+v", msg)
+	}
+}
+
+// fakeIMAP 最小化的 IMAP 服务端，只覆盖轮询用到的命令
+type fakeIMAP struct {
+	mu       sync.Mutex
+	messages map[uint32]string
+	seen     map[uint32]bool
+}
+
+func (f *fakeIMAP) serve(ln net.Listener) {
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		go f.handle(conn)
+	}
+}
+
+func (f *fakeIMAP) handle(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	fmt.Fprint(conn, "* OK fake imap ready\r\n")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		tag, cmd, _ := strings.Cut(strings.TrimRight(line, "\r\n"), " ")
+		f.mu.Lock()
+		switch {
+		case strings.HasPrefix(cmd, "LOGIN"):
+			if cmd != `LOGIN "support@x.com" "pw"` {
+				fmt.Fprintf(conn, "%s NO invalid credentials\r\n", tag)
+				break
+			}
+			fmt.Fprintf(conn, "%s OK logged in\r\n", tag)
+		case strings.HasPrefix(cmd, "SELECT"):
+			fmt.Fprintf(conn, "* %d EXISTS\r\n%s OK [READ-WRITE] selected\r\n", len(f.messages), tag)
+		case cmd == "UID SEARCH UNSEEN":
+			var uids []string
+			for uid := uint32(1); uid <= uint32(len(f.messages)); uid++ {
+				if !f.seen[uid] {
+					uids = append(uids, strconv.Itoa(int(uid)))
+				}
+			}
+			fmt.Fprintf(conn, "* SEARCH %s\r\n%s OK search done\r\n", strings.Join(uids, " "), tag)
+		case strings.HasPrefix(cmd, "UID FETCH"):
+			var uid uint32
+			fmt.Sscanf(cmd, "UID FETCH %d", &uid)
+			body := f.messages[uid]
+			fmt.Fprintf(conn, "* %d FETCH (UID %d BODY[] {%d}\r\n%s)\r\n%s OK fetch done\r\n", uid, uid, len(body), body, tag)
+		case strings.HasPrefix(cmd, "UID STORE"):
+			var uid uint32
+			fmt.Sscanf(cmd, "UID STORE %d", &uid)
+			f.seen[uid] = true
+			fmt.Fprintf(conn, "%s OK store done\r\n", tag)
+		case cmd == "LOGOUT":
+			fmt.Fprintf(conn, "* BYE\r\n%s OK bye\r\n", tag)
+			f.mu.Unlock()
+			return
+		default:
+			fmt.Fprintf(conn, "%s BAD unknown command\r\n", tag)
+		}
+		f.mu.Unlock()
+	}
+}
+
+func TestPoll(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skipf("listen: %v", err)
+	}
+	defer ln.Close()
+	srv := &fakeIMAP{
+		messages: map[uint32]string{
+			1: "From: a@example.com\r\nSubject: first\r\nMessage-ID: <m1@example.com>\r\n\r\nhello\r\n",
+			2: "From: b@example.com\r\nSubject: second\r\nMessage-ID: <m2@example.com>\r\n\r\nretry me\r\n",
+			3: "From: c@example.com\r\nSubject: third\r\nMessage-ID: <m3@example.com>\r\n\r\nbye\r\n",
+		},
+		seen: map[uint32]bool{},
+	}
+	go srv.serve(ln)
+
+	host, port, _ := net.SplitHostPort(ln.Addr().String())
+	portNum, _ := strconv.Atoi(port)
+	e := New()
+	err = e.Init(context.Background(), connector.Config{
+		Name: "email",
+		Settings: map[string]interface{}{
+			"address":       "support@x.com",
+			"imap_host":     host,
+			"imap_port":     float64(portNum),
+			"imap_security": SecurityNone,
+		},
+		Credentials: map[string]string{"imap_password": "pw"},
+	})
+	if err != nil {
+		t.Fatalf("init: %v", err)
+	}
+
+	var subjects []string
+	handled, err := e.Poll(context.Background(), func(_ context.Context, msg *connector.InboundMessage) error {
+		m := msg.Extras["email"].(*Message)
+		subjects = append(subjects, m.Subject)
+		if m.Subject == "second" {
+			return fmt.Errorf("temporary failure")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("poll: %v", err)
+	}
+	if handled != 2 || strings.Join(subjects, ",") != "first,second,third" {
+		t.Fatalf("unexpected poll result: %d %v", handled, subjects)
+	}
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	if !srv.seen[1] || srv.seen[2] || !srv.seen[3] {
+		t.Fatalf("failed message must stay unseen: %v", srv.seen)
+	}
+}
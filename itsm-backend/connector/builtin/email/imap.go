@@ -0,0 +1,223 @@
+package email
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// IMAP 安全模式
+const (
+	SecuritySSL      = "ssl"      // 隐式 TLS（993）
+	SecuritySTARTTLS = "starttls" // 明文连接后升级
+	SecurityNone     = "none"     // 仅用于内网/测试
+)
+
+var literalPattern = regexp.MustCompile(`\{(\d+)\}$`)
+
+// imapClient 轮询收件箱所需的最小 IMAP4rev1 客户端：
+// LOGIN / SELECT / UID SEARCH UNSEEN / UID FETCH BODY.PEEK[] / UID STORE \Seen / LOGOUT
+type imapClient struct {
+	conn     net.Conn
+	r        *bufio.Reader
+	tag      int
+	maxBytes int64
+	timeout  time.Duration
+}
+
+// imapResponse 一条响应：文本行（字面量处以 {n} 占位）及其携带的字面量
+type imapResponse struct {
+	line     string
+	literals [][]byte
+}
+
+func dialIMAP(ctx context.Context, cfg imapConfig) (*imapClient, error) {
+	addr := net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port))
+	dialer := &net.Dialer{Timeout: cfg.Timeout}
+	var conn net.Conn
+	var err error
+	tlsConfig := &tls.Config{ServerName: cfg.Host, InsecureSkipVerify: cfg.InsecureSkipVerify} // #nosec G402 -- 由连接器配置显式开启
+	if cfg.Security == SecuritySSL {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: tlsConfig}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("imap: dial %s: %w", addr, err)
+	}
+	c := &imapClient{conn: conn, r: bufio.NewReader(conn), maxBytes: cfg.MaxMessageBytes, timeout: cfg.Timeout}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	} else if cfg.Timeout > 0 {
+		_ = conn.SetDeadline(time.Now().Add(cfg.Timeout))
+	}
+	greeting, err := c.readLine()
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if !strings.HasPrefix(greeting, "* OK") && !strings.HasPrefix(greeting, "* PREAUTH") {
+		conn.Close()
+		return nil, fmt.Errorf("imap: unexpected greeting %q", greeting)
+	}
+	if cfg.Security == SecuritySTARTTLS {
+		if _, err := c.command("STARTTLS"); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		tlsConn := tls.Client(conn, tlsConfig)
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("imap: starttls: %w", err)
+		}
+		c.conn = tlsConn
+		c.r = bufio.NewReader(tlsConn)
+	}
+	return c, nil
+}
+
+func (c *imapClient) Close() error { return c.conn.Close() }
+
+func (c *imapClient) Login(username, password string) error {
+	user, err := imapQuote(username)
+	if err != nil {
+		return err
+	}
+	pass, err := imapQuote(password)
+	if err != nil {
+		return err
+	}
+	_, err = c.command("LOGIN " + user + " " + pass)
+	return err
+}
+
+func (c *imapClient) Select(mailbox string) error {
+	name, err := imapQuote(mailbox)
+	if err != nil {
+		return err
+	}
+	_, err = c.command("SELECT " + name)
+	return err
+}
+
+// SearchUnseen 返回未读邮件的 UID（升序）
+func (c *imapClient) SearchUnseen() ([]uint32, error) {
+	resps, err := c.command("UID SEARCH UNSEEN")
+	if err != nil {
+		return nil, err
+	}
+	var uids []uint32
+	for _, r := range resps {
+		if !strings.HasPrefix(r.line, "* SEARCH") {
+			continue
+		}
+		for _, f := range strings.Fields(strings.TrimPrefix(r.line, "* SEARCH")) {
+			if uid, err := strconv.ParseUint(f, 10, 32); err == nil {
+				uids = append(uids, uint32(uid))
+			}
+		}
+	}
+	return uids, nil
+}
+
+// FetchRaw 取回原始邮件，不改变 \Seen 标记
+func (c *imapClient) FetchRaw(uid uint32) ([]byte, error) {
+	resps, err := c.command(fmt.Sprintf("UID FETCH %d (BODY.PEEK[])", uid))
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range resps {
+		if strings.Contains(r.line, "FETCH") && len(r.literals) > 0 {
+			return r.literals[0], nil
+		}
+	}
+	return nil, fmt.Errorf("imap: message uid %d not found", uid)
+}
+
+func (c *imapClient) MarkSeen(uid uint32) error {
+	_, err := c.command(fmt.Sprintf("UID STORE %d +FLAGS.SILENT (\\Seen)", uid))
+	return err
+}
+
+func (c *imapClient) Logout() {
+	_, _ = c.command("LOGOUT")
+}
+
+// command 发送命令并读取到对应的带标签完成响应；NO/BAD 作为错误返回
+func (c *imapClient) command(cmd string) ([]imapResponse, error) {
+	c.tag++
+	tag := fmt.Sprintf("A%03d", c.tag)
+	if c.timeout > 0 {
+		_ = c.conn.SetDeadline(time.Now().Add(c.timeout))
+	}
+	if _, err := io.WriteString(c.conn, tag+" "+cmd+"\r\n"); err != nil {
+		return nil, fmt.Errorf("imap: write: %w", err)
+	}
+	var resps []imapResponse
+	for {
+		resp, err := c.readResponse()
+		if err != nil {
+			return nil, err
+		}
+		if strings.HasPrefix(resp.line, tag+" ") {
+			status := strings.TrimPrefix(resp.line, tag+" ")
+			if strings.HasPrefix(status, "OK") {
+				return resps, nil
+			}
+			verb, _, _ := strings.Cut(cmd, " ")
+			return nil, fmt.Errorf("imap: %s failed: %s", verb, status)
+		}
+		resps = append(resps, resp)
+	}
+}
+
+func (c *imapClient) readResponse() (imapResponse, error) {
+	var resp imapResponse
+	var line strings.Builder
+	for {
+		part, err := c.readLine()
+		if err != nil {
+			return resp, err
+		}
+		line.WriteString(part)
+		m := literalPattern.FindStringSubmatch(part)
+		if m == nil {
+			resp.line = line.String()
+			return resp, nil
+		}
+		size, err := strconv.ParseInt(m[1], 10, 64)
+		if err != nil || (c.maxBytes > 0 && size > c.maxBytes) {
+			return resp, fmt.Errorf("imap: literal of %s bytes exceeds limit", m[1])
+		}
+		buf := make([]byte, size)
+		if _, err := io.ReadFull(c.r, buf); err != nil {
+			return resp, fmt.Errorf("imap: read literal: %w", err)
+		}
+		resp.literals = append(resp.literals, buf)
+	}
+}
+
+func (c *imapClient) readLine() (string, error) {
+	line, err := c.r.ReadString('\n')
+	if err != nil {
+		return "", fmt.Errorf("imap: read: %w", err)
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// imapQuote 生成 IMAP quoted string；拒绝 CR/LF 以防命令注入
+func imapQuote(s string) (string, error) {
+	if strings.ContainsAny(s, "\r\n") {
+		return "", fmt.Errorf("imap: invalid characters in argument")
+	}
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return `"` + s + `"`, nil
+}
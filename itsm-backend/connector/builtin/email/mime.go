@@ -0,0 +1,264 @@
+package email
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"golang.org/x/text/encoding/htmlindex"
+)
+
+// maxPartDepth 限制 multipart 嵌套层数，防止恶意构造的深层嵌套耗尽栈
+const maxPartDepth = 10
+
+// Message 解析后的入站邮件
+type Message struct {
+	MessageID   string // 不含尖括号；缺失时以原文摘要生成，保证去重可用
+	InReplyTo   string
+	References  []string
+	From        mail.Address
+	To          []string
+	Cc          []string
+	Subject     string
+	Date        time.Time
+	Text        string // 纯文本正文；仅有 HTML 时由 HTML 转换而来
+	HTML        string
+	Attachments []Attachment
+	Header      mail.Header
+}
+
+// Attachment 邮件附件
+type Attachment struct {
+	Filename    string
+	ContentType string
+	ContentID   string
+	Inline      bool // 正文内嵌资源（签名图片等），入站处理时默认不入库
+	Data        []byte
+}
+
+var wordDecoder = &mime.WordDecoder{CharsetReader: charsetReader}
+
+// Parse 解析原始 MIME 邮件：解码 RFC 2047 头部、multipart 正文、传输编码与字符集，分离附件
+func Parse(raw []byte) (*Message, error) {
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("email: parse message: %w", err)
+	}
+	h := msg.Header
+	out := &Message{
+		MessageID:  trimMessageID(h.Get("Message-Id")),
+		InReplyTo:  firstMessageID(h.Get("In-Reply-To")),
+		References: parseMessageIDs(h.Get("References")),
+		Subject:    decodeHeader(h.Get("Subject")),
+		Header:     h,
+	}
+	if out.MessageID == "" {
+		sum := sha256.Sum256(raw)
+		out.MessageID = hex.EncodeToString(sum[:16]) + "@generated.invalid"
+	}
+	parser := mail.AddressParser{WordDecoder: wordDecoder}
+	if from, err := parser.Parse(h.Get("From")); err == nil {
+		out.From = *from
+	} else if from := strings.TrimSpace(h.Get("From")); from != "" {
+		out.From = mail.Address{Address: strings.Trim(from, "<>")}
+	}
+	out.From.Address = strings.ToLower(out.From.Address)
+	out.To = addressList(parser, h.Get("To"))
+	out.Cc = addressList(parser, h.Get("Cc"))
+	if date, err := h.Date(); err == nil {
+		out.Date = date
+	}
+
+	partHeader := textproto.MIMEHeader{
+		"Content-Type":              {h.Get("Content-Type")},
+		"Content-Transfer-Encoding": {h.Get("Content-Transfer-Encoding")},
+		"Content-Disposition":       {h.Get("Content-Disposition")},
+	}
+	if err := out.walk(partHeader, msg.Body, 0); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(out.Text) == "" && out.HTML != "" {
+		out.Text = HTMLToText(out.HTML)
+	}
+	out.Text = strings.TrimSpace(strings.ReplaceAll(out.Text, "\r\n", "\n"))
+	return out, nil
+}
+
+func (m *Message) walk(h textproto.MIMEHeader, body io.Reader, depth int) error {
+	if depth > maxPartDepth {
+		return fmt.Errorf("email: multipart nesting too deep")
+	}
+	mediaType, params, err := mime.ParseMediaType(h.Get("Content-Type"))
+	if err != nil {
+		mediaType, params = "text/plain", map[string]string{}
+	}
+	if strings.HasPrefix(mediaType, "multipart/") {
+		boundary := params["boundary"]
+		if boundary == "" {
+			return fmt.Errorf("email: multipart without boundary")
+		}
+		reader := multipart.NewReader(body, boundary)
+		for {
+			part, err := reader.NextRawPart()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("email: read multipart: %w", err)
+			}
+			if err := m.walk(part.Header, part, depth+1); err != nil {
+				return err
+			}
+		}
+	}
+
+	data, err := io.ReadAll(transferDecoder(h.Get("Content-Transfer-Encoding"), body))
+	if err != nil {
+		return fmt.Errorf("email: decode part: %w", err)
+	}
+	disposition, dispParams, _ := mime.ParseMediaType(h.Get("Content-Disposition"))
+	filename := dispParams["filename"]
+	if filename == "" {
+		filename = params["name"]
+	}
+	filename = decodeHeader(filename)
+	isAttachment := disposition == "attachment" || filename != ""
+
+	switch {
+	case mediaType == "text/plain" && !isAttachment:
+		m.Text += decodeCharset(data, params["charset"])
+	case mediaType == "text/html" && !isAttachment:
+		m.HTML += decodeCharset(data, params["charset"])
+	default:
+		if filename == "" {
+			filename = "attachment"
+			if exts, _ := mime.ExtensionsByType(mediaType); len(exts) > 0 {
+				filename += exts[0]
+			}
+		}
+		m.Attachments = append(m.Attachments, Attachment{
+			Filename:    filename,
+			ContentType: mediaType,
+			ContentID:   trimMessageID(h.Get("Content-Id")),
+			Inline:      disposition == "inline" || (disposition == "" && h.Get("Content-Id") != ""),
+			Data:        data,
+		})
+	}
+	return nil
+}
+
+func transferDecoder(encoding string, r io.Reader) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "base64":
+		return base64.NewDecoder(base64.StdEncoding, &base64Cleaner{r: r})
+	case "quoted-printable":
+		return quotedprintable.NewReader(r)
+	default:
+		return r
+	}
+}
+
+// base64Cleaner 去掉 base64 正文中的换行与空白，标准库解码器不接受它们
+type base64Cleaner struct{ r io.Reader }
+
+func (c *base64Cleaner) Read(p []byte) (int, error) {
+	for {
+		n, err := c.r.Read(p)
+		kept := 0
+		for _, b := range p[:n] {
+			if b != '\r' && b != '\n' && b != ' ' && b != '\t' {
+				p[kept] = b
+				kept++
+			}
+		}
+		if kept > 0 || err != nil {
+			return kept, err
+		}
+	}
+}
+
+func charsetReader(charset string, input io.Reader) (io.Reader, error) {
+	enc, err := htmlindex.Get(charset)
+	if err != nil {
+		return nil, fmt.Errorf("email: unsupported charset %q", charset)
+	}
+	return enc.NewDecoder().Reader(input), nil
+}
+
+// decodeCharset 按声明的字符集转为 UTF-8；无法识别的字符集原样返回
+func decodeCharset(data []byte, charset string) string {
+	charset = strings.ToLower(strings.TrimSpace(charset))
+	if charset == "" || charset == "utf-8" || charset == "us-ascii" {
+		return string(data)
+	}
+	r, err := charsetReader(charset, bytes.NewReader(data))
+	if err != nil {
+		return string(data)
+	}
+	decoded, err := io.ReadAll(r)
+	if err != nil {
+		return string(data)
+	}
+	return string(decoded)
+}
+
+func decodeHeader(value string) string {
+	decoded, err := wordDecoder.DecodeHeader(value)
+	if err != nil {
+		return strings.TrimSpace(value)
+	}
+	return strings.TrimSpace(decoded)
+}
+
+func addressList(parser mail.AddressParser, value string) []string {
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+	list, err := parser.ParseList(value)
+	if err != nil {
+		return nil
+	}
+	out := make([]string, 0, len(list))
+	for _, addr := range list {
+		out = append(out, strings.ToLower(addr.Address))
+	}
+	return out
+}
+
+func trimMessageID(value string) string {
+	return strings.TrimSpace(strings.Trim(strings.TrimSpace(value), "<>"))
+}
+
+func firstMessageID(value string) string {
+	if ids := parseMessageIDs(value); len(ids) > 0 {
+		return ids[0]
+	}
+	return ""
+}
+
+// parseMessageIDs 解析 References/In-Reply-To 中以空白或逗号分隔的 <id> 列表
+func parseMessageIDs(value string) []string {
+	fields := strings.FieldsFunc(value, func(r rune) bool {
+		return r == ' ' || r == '\t' || r == '\r' || r == '\n' || r == ','
+	})
+	out := make([]string, 0, len(fields))
+	for _, f := range fields {
+		if !strings.Contains(f, "@") {
+			continue
+		}
+		if id := trimMessageID(f); id != "" {
+			out = append(out, id)
+		}
+	}
+	return out
+}
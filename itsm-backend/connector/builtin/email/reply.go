@@ -0,0 +1,246 @@
+package email
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+var (
+	ticketTagPattern     = regexp.MustCompile(`(?i)\[TICKET-([A-Za-z0-9][A-Za-z0-9-]*)\]`)
+	subjectPrefixPattern = regexp.MustCompile(`(?i)^\s*((re|fw|fwd|aw|sv|回复|答复|回覆|转发|轉寄)\s*(\[\d+\])?\s*[:：]\s*)+`)
+	outboundIDPattern    = regexp.MustCompile(`^ticket-(\d+)\.[0-9a-f]+@(.+)$`)
+
+	// 引用头：出现后其后的内容都视为被引用的历史邮件
+	quoteHeaderPatterns = []*regexp.Regexp{
+		regexp.MustCompile(`(?i)^on\s.+wrote:\s*$`),
+		regexp.MustCompile(`^在.+(写道|寫道)[:：]?\s*$`),
+		regexp.MustCompile(`^.+于.+(写道|寫道)[:：]\s*$`),
+		regexp.MustCompile(`(?i)^-{2,}\s*(original message|forwarded message|原始邮件|原始郵件|转发邮件|轉寄郵件)\s*-{2,}\s*$`),
+		regexp.MustCompile(`^_{20,}\s*$`),
+	}
+	// Outlook 纯文本回复：发件人行紧跟发送时间/收件人等头部
+	outlookFromPattern = regexp.MustCompile(`(?i)^\*?(from|发件人|寄件者)\s*[:：]`)
+	outlookNextPattern = regexp.MustCompile(`(?i)^\*?(sent|date|to|subject|发送时间|日期|时间|收件人|主题)\s*[:：]`)
+	// 签名：标准分隔符 "-- " 与移动端自动签名
+	signaturePatterns = []*regexp.Regexp{
+		regexp.MustCompile(`^--\s?$`),
+		regexp.MustCompile(`(?i)^(sent from my|get outlook for)\b`),
+		regexp.MustCompile(`^(发自我的|发送自我的|从我的).*`),
+	}
+	autoReplySubjectPattern = regexp.MustCompile(`(?i)^\s*(auto:|automatic reply|autoreply|out of office|undeliverable|undelivered mail|delivery status notification|mail delivery failed|自动回复|自動回覆|退信|系统退信)`)
+	systemSenders           = []string{"mailer-daemon", "postmaster", "noreply", "no-reply", "donotreply", "do-not-reply"}
+)
+
+// TicketTag 提取主题中的 [TICKET-xxx] 标签内容（工单ID或工单编号），无标签返回空串
+func TicketTag(subject string) string {
+	m := ticketTagPattern.FindStringSubmatch(subject)
+	if len(m) < 2 {
+		return ""
+	}
+	return m[1]
+}
+
+// CleanSubject 去掉回复/转发前缀与工单标签，作为新工单标题
+func CleanSubject(subject string) string {
+	subject = ticketTagPattern.ReplaceAllString(subject, "")
+	subject = subjectPrefixPattern.ReplaceAllString(subject, "")
+	return strings.Join(strings.Fields(subject), " ")
+}
+
+// OutboundMessageID 生成出站邮件 Message-ID（不含尖括号），编码工单ID以便回复时线程归并
+func OutboundMessageID(ticketID int, digest, domain string) string {
+	return "ticket-" + strconv.Itoa(ticketID) + "." + digest + "@" + domain
+}
+
+// TicketIDFromMessageID 识别本连接器发出的 Message-ID，返回其中的工单ID；域名不匹配时返回 0
+func TicketIDFromMessageID(messageID, domain string) int {
+	m := outboundIDPattern.FindStringSubmatch(messageID)
+	if len(m) < 3 || !strings.EqualFold(m[2], domain) {
+		return 0
+	}
+	id, _ := strconv.Atoi(m[1])
+	return id
+}
+
+// StripReply 去掉回复邮件中的引用历史与签名，仅保留本次新写的内容；
+// 若剥离后为空（例如整封都是引用），返回原文，避免丢失信息
+func StripReply(text string) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	lines := strings.Split(text, "\n")
+	kept := make([]string, 0, len(lines))
+cut:
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		for _, p := range quoteHeaderPatterns {
+			if p.MatchString(trimmed) {
+				break cut
+			}
+		}
+		// "On ... <addr>" 被客户端折行到下一行 "wrote:"
+		if i+1 < len(lines) && strings.HasPrefix(strings.ToLower(trimmed), "on ") &&
+			strings.EqualFold(strings.TrimSpace(lines[i+1]), "wrote:") {
+			break
+		}
+		if outlookFromPattern.MatchString(trimmed) && i+1 < len(lines) &&
+			outlookNextPattern.MatchString(strings.TrimSpace(lines[i+1])) {
+			break
+		}
+		for _, p := range signaturePatterns {
+			if p.MatchString(line) || p.MatchString(trimmed) {
+				break cut
+			}
+		}
+		if strings.HasPrefix(trimmed, ">") {
+			continue
+		}
+		kept = append(kept, strings.TrimRight(line, " \t"))
+	}
+	out := strings.TrimSpace(collapseBlankLines(kept))
+	if out == "" {
+		return strings.TrimSpace(text)
+	}
+	return out
+}
+
+func collapseBlankLines(lines []string) string {
+	var b strings.Builder
+	blank := 0
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			blank++
+			if blank > 1 {
+				continue
+			}
+		} else {
+			blank = 0
+		}
+		b.WriteString(line)
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// HTMLToText 把 HTML 正文转为纯文本：丢弃 script/style 与常见客户端的引用块，块级元素换行
+func HTMLToText(src string) string {
+	z := html.NewTokenizer(strings.NewReader(src))
+	var b strings.Builder
+	skipDepth := 0
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			lines := strings.Split(b.String(), "\n")
+			for i := range lines {
+				lines[i] = strings.Join(strings.Fields(lines[i]), " ")
+			}
+			return strings.TrimSpace(collapseBlankLines(lines))
+		case html.TextToken:
+			if skipDepth == 0 {
+				b.WriteString(string(z.Text()))
+			}
+		case html.StartTagToken, html.SelfClosingTagToken:
+			tok := z.Token()
+			if skipDepth > 0 {
+				if tt == html.StartTagToken && !voidElement(tok.Data) {
+					skipDepth++
+				}
+				continue
+			}
+			if tt == html.StartTagToken && skippedElement(tok) {
+				skipDepth = 1
+				continue
+			}
+			switch tok.Data {
+			case "br", "p", "div", "tr", "li", "h1", "h2", "h3", "h4", "h5", "h6", "table":
+				b.WriteByte('\n')
+			}
+		case html.EndTagToken:
+			if skipDepth > 0 {
+				name, _ := z.TagName()
+				if !voidElement(string(name)) {
+					skipDepth--
+				}
+				continue
+			}
+			name, _ := z.TagName()
+			switch string(name) {
+			case "p", "div", "tr", "li", "h1", "h2", "h3", "h4", "h5", "h6", "table":
+				b.WriteByte('\n')
+			case "td", "th":
+				b.WriteByte(' ')
+			}
+		}
+	}
+}
+
+func skippedElement(tok html.Token) bool {
+	switch tok.Data {
+	case "script", "style", "head", "title", "blockquote":
+		return true
+	}
+	for _, attr := range tok.Attr {
+		switch {
+		case attr.Key == "class" && strings.Contains(attr.Val, "gmail_quote"):
+			return true
+		case attr.Key == "id" && (attr.Val == "divRplyFwdMsg" || attr.Val == "appendonsend"):
+			return true
+		}
+	}
+	return false
+}
+
+func voidElement(name string) bool {
+	switch name {
+	case "area", "base", "br", "col", "embed", "hr", "img", "input", "link", "meta", "source", "track", "wbr":
+		return true
+	}
+	return false
+}
+
+// IgnoreReason 判断邮件是否应被忽略（自动回复、退信、群发、回环），返回原因；正常人工邮件返回空串。
+// mailbox 为本邮箱地址，用于识别自己发出又被转回的邮件。
+func (m *Message) IgnoreReason(mailbox string) string {
+	h := m.Header
+	if h.Get("X-ITSM-Loop") != "" {
+		return "loop_header"
+	}
+	from := strings.ToLower(m.From.Address)
+	if from == "" {
+		return "missing_sender"
+	}
+	if mailbox != "" && strings.EqualFold(from, mailbox) {
+		return "loop_self"
+	}
+	if v := strings.ToLower(strings.TrimSpace(h.Get("Auto-Submitted"))); v != "" && v != "no" {
+		return "auto_submitted"
+	}
+	if h.Get("X-Autoreply") != "" || h.Get("X-Autorespond") != "" || h.Get("X-Autoresponder") != "" {
+		return "auto_reply"
+	}
+	switch strings.ToLower(strings.TrimSpace(h.Get("Precedence"))) {
+	case "bulk", "junk", "list", "auto_reply":
+		return "bulk"
+	}
+	if h.Get("List-Id") != "" || h.Get("List-Unsubscribe") != "" {
+		return "mailing_list"
+	}
+	if strings.TrimSpace(h.Get("Return-Path")) == "<>" {
+		return "bounce"
+	}
+	if mediaType, _, _ := strings.Cut(strings.ToLower(h.Get("Content-Type")), ";"); strings.TrimSpace(mediaType) == "multipart/report" {
+		return "bounce"
+	}
+	local, _, _ := strings.Cut(from, "@")
+	for _, s := range systemSenders {
+		if local == s {
+			return "system_sender"
+		}
+	}
+	if autoReplySubjectPattern.MatchString(m.Subject) {
+		return "auto_reply"
+	}
+	return ""
+}
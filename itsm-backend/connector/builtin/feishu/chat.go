@@ -0,0 +1,52 @@
+package feishu
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"itsm-backend/connector"
+)
+
+// chatChannelPrefix Message.Channel 以此前缀开头时按 chat_id 发往群聊
+const chatChannelPrefix = "chat:"
+
+// CreateChat 创建群聊（POST /open-apis/im/v1/chats），成员与群主均为 open_id
+func (c *Client) CreateChat(ctx context.Context, spec connector.ChatSpec) (*connector.Chat, error) {
+	if strings.TrimSpace(spec.Name) == "" {
+		return nil, fmt.Errorf("feishu: chat name is required")
+	}
+	body := map[string]interface{}{
+		"name":         spec.Name,
+		"description":  spec.Description,
+		"user_id_list": spec.MemberIDs,
+		"chat_mode":    "group",
+		"chat_type":    "private",
+	}
+	if spec.OwnerID != "" {
+		body["owner_id"] = spec.OwnerID
+	}
+	var out struct {
+		Code int    `json:"code"`
+		Msg  string `json:"msg"`
+		Data struct {
+			ChatID string `json:"chat_id"`
+			Name   string `json:"name"`
+		} `json:"data"`
+	}
+	if err := c.doJSON(ctx, "POST", "/open-apis/im/v1/chats?user_id_type=open_id&set_bot_manager=true", body, &out); err != nil {
+		return nil, err
+	}
+	if out.Code != 0 {
+		return nil, fmt.Errorf("feishu: create chat failed code=%d msg=%s", out.Code, out.Msg)
+	}
+	return &connector.Chat{ID: out.Data.ChatID, Name: spec.Name, Channel: chatChannelPrefix + out.Data.ChatID}, nil
+}
+
+// receiveTarget 解析 Channel 得到 receive_id_type 与 receive_id
+func receiveTarget(channel string) (idType, id string) {
+	if strings.HasPrefix(channel, chatChannelPrefix) {
+		return "chat_id", strings.TrimPrefix(channel, chatChannelPrefix)
+	}
+	return "open_id", channel
+}
@@ -55,6 +55,7 @@ func (f *Feishu) Manifest() connector.Manifest {
 			connector.CapSendMessage,
 			connector.CapReceiveMessage,
 			connector.CapSendCard,
+			connector.CapCreateChat,
 			connector.CapReplyMessage,
 			connector.CapCreateTicket,
 			connector.CapUpdateTicket,
@@ -93,6 +94,14 @@ func (f *Feishu) Send(ctx context.Context, msg *connector.Message) error {
 	return f.client.Send(ctx, msg)
 }
 
+// CreateChat 实现 connector.ChatCreator
+func (f *Feishu) CreateChat(ctx context.Context, spec connector.ChatSpec) (*connector.Chat, error) {
+	if f.client == nil {
+		return nil, fmt.Errorf("feishu: connector not initialized")
+	}
+	return f.client.CreateChat(ctx, spec)
+}
+
 func (f *Feishu) HealthCheck(ctx context.Context) connector.HealthStatus {
 	if f.client == nil {
 		return connector.HealthStatus{OK: false, Message: "not initialized"}
@@ -81,3 +81,12 @@ func TestBuildFeishuMessageBody(t *testing.T) {
 		t.Fatalf("unexpected body: %+v", b)
 	}
 }
+
+func TestReceiveTarget(t *testing.T) {
+	if typ, id := receiveTarget("chat:oc_1"); typ != "chat_id" || id != "oc_1" {
+		t.Fatalf("chat target: %s %s", typ, id)
+	}
+	if typ, id := receiveTarget("ou_x"); typ != "open_id" || id != "ou_x" {
+		t.Fatalf("open_id target: %s %s", typ, id)
+	}
+}
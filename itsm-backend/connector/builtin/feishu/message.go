@@ -10,7 +10,7 @@ import (
 
 // Send 实现 connector.Connector.Send
 // 飞书 send_msg 接受 receive_id_type（open_id/email/chat_id/user_id）
-// 这里统一用 open_id，调用方在 Channel 字段传 open_id 即可
+// Channel 传 open_id；以 "chat:" 开头时按 chat_id 发往群聊（见 CreateChat）
 func (c *Client) Send(ctx context.Context, msg *connector.Message) error {
 	if msg == nil {
 		return fmt.Errorf("feishu: nil message")
@@ -18,7 +18,9 @@ func (c *Client) Send(ctx context.Context, msg *connector.Message) error {
 	if msg.Channel == "" {
 		return fmt.Errorf("feishu: channel (receive_id) is required")
 	}
+	idType, id := receiveTarget(msg.Channel)
 	body := buildFeishuMessageBody(msg)
+	body["receive_id"] = id
 	var out struct {
 		Code int    `json:"code"`
 		Msg  string `json:"msg"`
@@ -26,8 +28,8 @@ func (c *Client) Send(ctx context.Context, msg *connector.Message) error {
 			MessageID string `json:"message_id"`
 		} `json:"data"`
 	}
-	// 飞书接口：POST /open-apis/im/v1/messages?receive_id_type=open_id
-	if err := c.doJSON(ctx, "POST", "/open-apis/im/v1/messages?receive_id_type=open_id", body, &out); err != nil {
+	// 飞书接口：POST /open-apis/im/v1/messages?receive_id_type=open_id|chat_id
+	if err := c.doJSON(ctx, "POST", "/open-apis/im/v1/messages?receive_id_type="+idType, body, &out); err != nil {
 		return err
 	}
 	if out.Code != 0 {
@@ -0,0 +1,157 @@
+package monitoring
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"itsm-backend/connector"
+)
+
+// webhookPayload Alertmanager webhook v4 负载；Grafana 统一告警在此基础上增加了若干字段
+type webhookPayload struct {
+	Version           string            `json:"version"`
+	GroupKey          string            `json:"groupKey"`
+	Status            string            `json:"status"`
+	Receiver          string            `json:"receiver"`
+	GroupLabels       map[string]string `json:"groupLabels"`
+	CommonLabels      map[string]string `json:"commonLabels"`
+	CommonAnnotations map[string]string `json:"commonAnnotations"`
+	ExternalURL       string            `json:"externalURL"`
+	Alerts            []webhookAlert    `json:"alerts"`
+}
+
+type webhookAlert struct {
+	Status       string            `json:"status"`
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations"`
+	StartsAt     time.Time         `json:"startsAt"`
+	EndsAt       time.Time         `json:"endsAt"`
+	GeneratorURL string            `json:"generatorURL"`
+	Fingerprint  string            `json:"fingerprint"`
+}
+
+// toAlerts 转为统一告警；单条告警缺失的标签/注解从 common* 继承
+func (p *webhookPayload) toAlerts(source string) []Alert {
+	out := make([]Alert, 0, len(p.Alerts))
+	for _, a := range p.Alerts {
+		labels := mergeMaps(p.CommonLabels, a.Labels)
+		annotations := mergeMaps(p.CommonAnnotations, a.Annotations)
+		status := StatusFiring
+		if strings.EqualFold(a.Status, StatusResolved) {
+			status = StatusResolved
+		}
+		fingerprint := strings.TrimSpace(a.Fingerprint)
+		if fingerprint == "" {
+			fingerprint = labelFingerprint(labels)
+		}
+		alert := Alert{
+			Source:       source,
+			Fingerprint:  fingerprint,
+			Status:       status,
+			Name:         firstNonEmpty(labels["alertname"], annotations["summary"], fingerprint),
+			Summary:      annotations["summary"],
+			Description:  firstNonEmpty(annotations["description"], annotations["message"]),
+			Severity:     NormalizeSeverity(firstNonEmpty(labels["severity"], labels["priority"])),
+			Labels:       labels,
+			Annotations:  annotations,
+			StartsAt:     a.StartsAt,
+			GeneratorURL: a.GeneratorURL,
+		}
+		if alert.StartsAt.IsZero() {
+			alert.StartsAt = time.Now()
+		}
+		// 告警中的 endsAt 是 Alertmanager 推算的过期时间，只有恢复时才是真实结束时间
+		if status == StatusResolved && !a.EndsAt.IsZero() {
+			endsAt := a.EndsAt
+			alert.EndsAt = &endsAt
+		}
+		out = append(out, alert)
+	}
+	return out
+}
+
+// Alertmanager Prometheus Alertmanager webhook_configs 接收器
+type Alertmanager struct {
+	base
+}
+
+func init() {
+	connector.MustRegister(func() connector.Connector { return NewAlertmanager() })
+}
+
+func NewAlertmanager() *Alertmanager { return &Alertmanager{base: base{name: "alertmanager"}} }
+
+func (a *Alertmanager) Manifest() connector.Manifest {
+	return connector.Manifest{
+		Name:                "alertmanager",
+		Version:             "1.0.0",
+		Title:               "Prometheus Alertmanager",
+		Provider:            "prometheus",
+		Type:                connector.TypeMonitor,
+		Description:         "接收 Alertmanager webhook v4 推送（Bearer Token / Basic 认证），按指纹去重并自动开启、更新、解决事件。",
+		Capabilities:        []connector.Capability{connector.CapReceiveAlert, connector.CapHealthCheck},
+		Tags:                []string{"monitoring", "prometheus", "alertmanager", "inbound"},
+		IsOfficial:          true,
+		RequiredPermissions: []string{"connector:write", "incident:write"},
+	}
+}
+
+func (a *Alertmanager) Init(_ context.Context, cfg connector.Config) error {
+	if err := a.init(cfg); err != nil {
+		return err
+	}
+	if a.token == "" {
+		return fmt.Errorf("alertmanager: credentials.token is required")
+	}
+	return nil
+}
+
+// VerifySignature 校验 http_config 中配置的 Bearer Token 或 Basic 认证
+func (a *Alertmanager) VerifySignature(headers map[string]string, _ []byte) error {
+	return a.verifyToken(headers)
+}
+
+// ParseAlerts 解析 webhook v4 负载
+func (a *Alertmanager) ParseAlerts(body []byte) ([]Alert, error) {
+	p, err := a.decode(body)
+	if err != nil {
+		return nil, err
+	}
+	return p.toAlerts("alertmanager"), nil
+}
+
+// ParseInbound 解析为统一入站消息，告警列表在 Extras["alerts"]
+func (a *Alertmanager) ParseInbound(body []byte) (*connector.InboundMessage, error) {
+	p, err := a.decode(body)
+	if err != nil {
+		return nil, err
+	}
+	return a.inbound(p.Receiver, p.GroupKey, p.toAlerts("alertmanager"), body), nil
+}
+
+func (a *Alertmanager) decode(body []byte) (*webhookPayload, error) {
+	var p webhookPayload
+	if err := json.Unmarshal(body, &p); err != nil {
+		return nil, fmt.Errorf("alertmanager: invalid payload: %w", err)
+	}
+	if p.Version != "4" {
+		return nil, fmt.Errorf("alertmanager: unsupported webhook version %q", p.Version)
+	}
+	return &p, nil
+}
+
+func mergeMaps(common, own map[string]string) map[string]string {
+	out := make(map[string]string, len(common)+len(own))
+	for k, v := range common {
+		out[k] = v
+	}
+	for k, v := range own {
+		out[k] = v
+	}
+	return out
+}
+
+var _ Source = (*Alertmanager)(nil)
@@ -0,0 +1,168 @@
+package monitoring
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"itsm-backend/connector"
+)
+
+const (
+	// GrafanaSignatureHeader Grafana webhook 联络点 HMAC 签名的默认请求头
+	GrafanaSignatureHeader = "X-Grafana-Alerting-Signature"
+	// grafanaSignatureMaxSkew 带时间戳签名的最大允许时钟偏差，防重放
+	grafanaSignatureMaxSkew = 5 * time.Minute
+)
+
+// grafanaPayload Grafana 统一告警 webhook 负载（version 1），结构兼容 Alertmanager
+type grafanaPayload struct {
+	webhookPayload
+	Alerts  []grafanaAlert `json:"alerts"`
+	OrgID   int            `json:"orgId"`
+	Title   string         `json:"title"`
+	State   string         `json:"state"`
+	Message string         `json:"message"`
+}
+
+type grafanaAlert struct {
+	webhookAlert
+	DashboardURL string `json:"dashboardURL"`
+	PanelURL     string `json:"panelURL"`
+	SilenceURL   string `json:"silenceURL"`
+	ValueString  string `json:"valueString"`
+}
+
+// Grafana 统一告警（Unified Alerting）webhook 联络点接收器
+type Grafana struct {
+	base
+	hmacSecret      string
+	signatureHeader string
+	timestampHeader string
+	now             func() time.Time
+}
+
+func init() {
+	connector.MustRegister(func() connector.Connector { return NewGrafana() })
+}
+
+func NewGrafana() *Grafana { return &Grafana{base: base{name: "grafana"}, now: time.Now} }
+
+func (g *Grafana) Manifest() connector.Manifest {
+	return connector.Manifest{
+		Name:                "grafana",
+		Version:             "1.0.0",
+		Title:               "Grafana 统一告警",
+		Provider:            "grafana",
+		Type:                connector.TypeMonitor,
+		Description:         "接收 Grafana 统一告警 webhook 联络点推送（HMAC 签名或 Bearer Token），按指纹去重并自动开启、更新、解决事件。",
+		Capabilities:        []connector.Capability{connector.CapReceiveAlert, connector.CapHealthCheck},
+		Tags:                []string{"monitoring", "grafana", "inbound"},
+		IsOfficial:          true,
+		RequiredPermissions: []string{"connector:write", "incident:write"},
+	}
+}
+
+// Init 除公共配置外支持 credentials.hmac_secret，以及 settings.signature_header / timestamp_header
+// （与联络点 HMAC 配置一致；配置了时间戳头时签名内容为 "<timestamp>:<body>"）
+func (g *Grafana) Init(_ context.Context, cfg connector.Config) error {
+	if err := g.init(cfg); err != nil {
+		return err
+	}
+	g.hmacSecret = cfg.Credentials["hmac_secret"]
+	g.signatureHeader = firstNonEmpty(settingString(cfg.Settings, "signature_header"), GrafanaSignatureHeader)
+	g.timestampHeader = settingString(cfg.Settings, "timestamp_header")
+	if g.hmacSecret == "" && g.token == "" {
+		return fmt.Errorf("grafana: credentials.hmac_secret or credentials.token is required")
+	}
+	return nil
+}
+
+// VerifySignature 配置了 hmac_secret 时校验 HMAC-SHA256 签名，否则校验 Authorization 头
+func (g *Grafana) VerifySignature(headers map[string]string, body []byte) error {
+	if g.hmacSecret == "" {
+		return g.verifyToken(headers)
+	}
+	sig := strings.TrimSpace(header(headers, g.signatureHeader))
+	if sig == "" {
+		return fmt.Errorf("grafana: missing signature header")
+	}
+	mac := hmac.New(sha256.New, []byte(g.hmacSecret))
+	if g.timestampHeader != "" {
+		ts := strings.TrimSpace(header(headers, g.timestampHeader))
+		sec, err := strconv.ParseInt(ts, 10, 64)
+		if err != nil {
+			return fmt.Errorf("grafana: missing or invalid signature timestamp")
+		}
+		if skew := g.now().Sub(time.Unix(sec, 0)); skew > grafanaSignatureMaxSkew || skew < -grafanaSignatureMaxSkew {
+			return fmt.Errorf("grafana: signature timestamp out of range")
+		}
+		mac.Write([]byte(ts + ":"))
+	}
+	mac.Write(body)
+	if !hmac.Equal([]byte(hex.EncodeToString(mac.Sum(nil))), []byte(strings.ToLower(sig))) {
+		return fmt.Errorf("grafana: signature mismatch")
+	}
+	return nil
+}
+
+// ParseAlerts 解析统一告警负载
+func (g *Grafana) ParseAlerts(body []byte) ([]Alert, error) {
+	p, err := g.decode(body)
+	if err != nil {
+		return nil, err
+	}
+	return g.toAlerts(p), nil
+}
+
+// ParseInbound 解析为统一入站消息，告警列表在 Extras["alerts"]
+func (g *Grafana) ParseInbound(body []byte) (*connector.InboundMessage, error) {
+	p, err := g.decode(body)
+	if err != nil {
+		return nil, err
+	}
+	return g.inbound(p.Receiver, p.GroupKey, g.toAlerts(p), body), nil
+}
+
+func (g *Grafana) decode(body []byte) (*grafanaPayload, error) {
+	var p grafanaPayload
+	if err := json.Unmarshal(body, &p); err != nil {
+		return nil, fmt.Errorf("grafana: invalid payload: %w", err)
+	}
+	if len(p.Alerts) == 0 && p.Status == "" {
+		return nil, fmt.Errorf("grafana: payload has no alerts")
+	}
+	return &p, nil
+}
+
+// toAlerts 复用 Alertmanager 的映射，并把面板链接与取值写入注解，便于在事件中直接跳转
+func (g *Grafana) toAlerts(p *grafanaPayload) []Alert {
+	common := p.webhookPayload
+	common.Alerts = make([]webhookAlert, 0, len(p.Alerts))
+	for _, a := range p.Alerts {
+		common.Alerts = append(common.Alerts, a.webhookAlert)
+	}
+	alerts := common.toAlerts("grafana")
+	for i, a := range p.Alerts {
+		extras := map[string]string{
+			"dashboard_url": a.DashboardURL,
+			"panel_url":     a.PanelURL,
+			"silence_url":   a.SilenceURL,
+			"value":         a.ValueString,
+		}
+		for k, v := range extras {
+			if v != "" {
+				alerts[i].Annotations[k] = v
+			}
+		}
+	}
+	return alerts
+}
+
+var _ Source = (*Grafana)(nil)
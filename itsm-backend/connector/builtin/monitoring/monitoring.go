@@ -0,0 +1,316 @@
+// Package monitoring 监控告警源连接器：Prometheus Alertmanager、Zabbix、Grafana 统一告警。
+// 三者都只做入站：校验 Token/签名后把推送解析为统一的 Alert 列表（放在 InboundMessage.Extras["alerts"]），
+// 由业务层按指纹去重、按标签映射配置项并开启/更新/解决事件。
+package monitoring
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"itsm-backend/connector"
+)
+
+const (
+	StatusFiring   = "firing"
+	StatusResolved = "resolved"
+
+	// ExtrasKey InboundMessage.Extras 中存放 []Alert 的键
+	ExtrasKey = "alerts"
+)
+
+// defaultCILabels 未配置 ci_labels 时按顺序尝试映射配置项的标签
+var defaultCILabels = []string{"ci_id", "ci", "ci_name", "hostname", "host", "instance", "service"}
+
+// defaultCorrelationLabels 标签关联默认比较的标签，与 Alertmanager 常见的 group_by 一致
+var defaultCorrelationLabels = []string{"alertname", "cluster", "namespace", "service", "job"}
+
+// Alert 归一化后的告警
+type Alert struct {
+	Source       string            `json:"source"`
+	Fingerprint  string            `json:"fingerprint"`
+	Status       string            `json:"status"` // firing / resolved
+	Name         string            `json:"name"`
+	Summary      string            `json:"summary,omitempty"`
+	Description  string            `json:"description,omitempty"`
+	Severity     string            `json:"severity"` // critical / high / medium / low
+	Labels       map[string]string `json:"labels,omitempty"`
+	Annotations  map[string]string `json:"annotations,omitempty"`
+	StartsAt     time.Time         `json:"starts_at"`
+	EndsAt       *time.Time        `json:"ends_at,omitempty"`
+	GeneratorURL string            `json:"generator_url,omitempty"`
+}
+
+// Policy 告警转事件的业务配置
+type Policy struct {
+	ReporterID  int      // 自动建事件的报告人
+	AssigneeID  int      // 新建事件的默认处理人；0 表示不指派
+	Category    string   // 新建事件分类，默认 monitoring
+	AutoResolve bool     // 事件关联告警全部恢复时自动解决事件；关闭时仅在时间线上记录恢复
+	CILabels    []string // 按顺序尝试映射配置项的标签
+
+	Correlate           bool          // 新告警先尝试归并到时间窗内已有告警的事件
+	CorrelationWindow   time.Duration // 关联时间窗
+	CorrelationLabels   []string      // 标签关联时比较的标签
+	SimilarityThreshold float64       // 文本相似度关联阈值 (0,1]
+	TopologyDepth       int           // 拓扑关联向上游追溯的层数
+	FlapThreshold       int           // 抖动窗口内状态切换达到该次数视为抖动；0 关闭抖动检测
+	FlapWindow          time.Duration // 抖动检测窗口
+}
+
+// Source 监控告警源连接器的公共能力
+type Source interface {
+	connector.Receiver
+	// Policy 返回告警转事件配置
+	Policy() Policy
+	// ParseAlerts 解析推送为告警列表
+	ParseAlerts(body []byte) ([]Alert, error)
+}
+
+// base 三种告警源共用的配置、Token 校验与空实现
+type base struct {
+	name   string
+	cfg    connector.Config
+	policy Policy
+	token  string
+}
+
+// init 读取配置。settings: reporter_id（必填）, assignee_id, category, auto_resolve, ci_labels, callbackInstanceId，
+// 关联降噪 correlate, correlation_window_minutes, correlation_labels, similarity_threshold, topology_depth,
+// flap_threshold, flap_window_minutes；credentials: token（Bearer Token 或 Basic 认证密码）
+func (b *base) init(cfg connector.Config) error {
+	reporterID := settingInt(cfg.Settings, "reporter_id", 0)
+	if reporterID <= 0 {
+		return fmt.Errorf("%s: settings.reporter_id is required", b.name)
+	}
+	b.policy = Policy{
+		ReporterID:  reporterID,
+		AssigneeID:  settingInt(cfg.Settings, "assignee_id", 0),
+		Category:    firstNonEmpty(settingString(cfg.Settings, "category"), "monitoring"),
+		AutoResolve: settingBool(cfg.Settings, "auto_resolve", true),
+		CILabels:    settingStrings(cfg.Settings, "ci_labels"),
+
+		Correlate:           settingBool(cfg.Settings, "correlate", true),
+		CorrelationWindow:   time.Duration(settingInt(cfg.Settings, "correlation_window_minutes", 10)) * time.Minute,
+		CorrelationLabels:   settingStrings(cfg.Settings, "correlation_labels"),
+		SimilarityThreshold: settingFloat(cfg.Settings, "similarity_threshold", 0.8),
+		TopologyDepth:       settingInt(cfg.Settings, "topology_depth", 3),
+		FlapThreshold:       settingInt(cfg.Settings, "flap_threshold", 4),
+		FlapWindow:          time.Duration(settingInt(cfg.Settings, "flap_window_minutes", 30)) * time.Minute,
+	}
+	if len(b.policy.CILabels) == 0 {
+		b.policy.CILabels = append([]string(nil), defaultCILabels...)
+	}
+	if len(b.policy.CorrelationLabels) == 0 {
+		b.policy.CorrelationLabels = append([]string(nil), defaultCorrelationLabels...)
+	}
+	if b.policy.SimilarityThreshold <= 0 || b.policy.SimilarityThreshold > 1 {
+		return fmt.Errorf("%s: settings.similarity_threshold must be in (0, 1]", b.name)
+	}
+	b.token = strings.TrimSpace(cfg.Credentials["token"])
+	b.cfg = cfg
+	return nil
+}
+
+// Policy 返回告警转事件配置
+func (b *base) Policy() Policy { return b.policy }
+
+// CallbackInstanceID 推送地址中的实例ID
+func (b *base) CallbackInstanceID() string {
+	id, _ := b.cfg.Settings["callbackInstanceId"].(string)
+	return id
+}
+
+// Send 告警源只做入站
+func (b *base) Send(context.Context, *connector.Message) error { return connector.ErrNotSupported }
+
+// HealthCheck 推送型连接器无需主动探测，配置完整即视为健康
+func (b *base) HealthCheck(context.Context) connector.HealthStatus {
+	if b.CallbackInstanceID() == "" {
+		return connector.HealthStatus{OK: false, Message: "settings.callbackInstanceId missing", CheckedAt: time.Now()}
+	}
+	return connector.HealthStatus{OK: true, Message: "waiting for push", CheckedAt: time.Now()}
+}
+
+func (b *base) Close() error { return nil }
+
+// verifyToken 校验 Authorization: Bearer <token> 或 Basic 认证（密码为 token）
+func (b *base) verifyToken(headers map[string]string) error {
+	if b.token == "" {
+		return fmt.Errorf("%s: credentials.token not configured", b.name)
+	}
+	auth := strings.TrimSpace(header(headers, "Authorization"))
+	scheme, value, _ := strings.Cut(auth, " ")
+	var presented string
+	switch strings.ToLower(scheme) {
+	case "bearer":
+		presented = strings.TrimSpace(value)
+	case "basic":
+		decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(value))
+		if err != nil {
+			return fmt.Errorf("%s: malformed basic auth", b.name)
+		}
+		_, presented, _ = strings.Cut(string(decoded), ":")
+	default:
+		return fmt.Errorf("%s: missing authorization", b.name)
+	}
+	if !hmac.Equal([]byte(presented), []byte(b.token)) {
+		return fmt.Errorf("%s: token mismatch", b.name)
+	}
+	return nil
+}
+
+// inbound 把告警列表包装为统一入站消息
+func (b *base) inbound(channel, messageID string, alerts []Alert, raw []byte) *connector.InboundMessage {
+	firing := 0
+	for _, a := range alerts {
+		if a.Status == StatusFiring {
+			firing++
+		}
+	}
+	return &connector.InboundMessage{
+		ConnectorName: b.name,
+		ConnectorType: connector.TypeMonitor,
+		Channel:       channel,
+		MessageID:     messageID,
+		Type:          "alert",
+		Content:       fmt.Sprintf("%d firing, %d resolved", firing, len(alerts)-firing),
+		Raw:           raw,
+		ReceivedAt:    time.Now(),
+		Extras:        map[string]interface{}{ExtrasKey: alerts},
+	}
+}
+
+// AlertsFrom 取出 ParseInbound 放入的告警列表
+func AlertsFrom(msg *connector.InboundMessage) ([]Alert, bool) {
+	if msg == nil {
+		return nil, false
+	}
+	alerts, ok := msg.Extras[ExtrasKey].([]Alert)
+	return alerts, ok
+}
+
+// NormalizeSeverity 把各告警源的级别映射为 critical / high / medium / low
+func NormalizeSeverity(raw string) string {
+	switch strings.ToLower(strings.TrimSpace(raw)) {
+	case "critical", "disaster", "fatal", "emergency", "p1", "page", "5":
+		return "critical"
+	case "high", "error", "major", "p2", "4":
+		return "high"
+	case "low", "info", "information", "informational", "not classified", "none", "p4", "p5", "0", "1":
+		return "low"
+	default: // warning / average / minor / p3 及未知级别
+		return "medium"
+	}
+}
+
+// labelFingerprint 告警源未给出指纹时，以排序后的标签集计算稳定指纹
+func labelFingerprint(labels map[string]string) string {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	h := sha256.New()
+	for _, k := range keys {
+		h.Write([]byte(k))
+		h.Write([]byte{0})
+		h.Write([]byte(labels[k]))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+func header(headers map[string]string, name string) string {
+	for k, v := range headers {
+		if strings.EqualFold(k, name) {
+			return v
+		}
+	}
+	return ""
+}
+
+func settingString(settings map[string]interface{}, key string) string {
+	v, _ := settings[key].(string)
+	return strings.TrimSpace(v)
+}
+
+func settingInt(settings map[string]interface{}, key string, def int) int {
+	switch v := settings[key].(type) {
+	case float64:
+		return int(v)
+	case int:
+		return v
+	case string:
+		if n, err := strconv.Atoi(strings.TrimSpace(v)); err == nil {
+			return n
+		}
+	}
+	return def
+}
+
+func settingFloat(settings map[string]interface{}, key string, def float64) float64 {
+	switch v := settings[key].(type) {
+	case float64:
+		return v
+	case int:
+		return float64(v)
+	case string:
+		if f, err := strconv.ParseFloat(strings.TrimSpace(v), 64); err == nil {
+			return f
+		}
+	}
+	return def
+}
+
+func settingBool(settings map[string]interface{}, key string, def bool) bool {
+	switch v := settings[key].(type) {
+	case bool:
+		return v
+	case string:
+		if b, err := strconv.ParseBool(strings.TrimSpace(v)); err == nil {
+			return b
+		}
+	}
+	return def
+}
+
+// settingStrings 支持 JSON 数组或逗号分隔字符串
+func settingStrings(settings map[string]interface{}, key string) []string {
+	var raw []string
+	switch v := settings[key].(type) {
+	case []interface{}:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				raw = append(raw, s)
+			}
+		}
+	case []string:
+		raw = v
+	case string:
+		raw = strings.Split(v, ",")
+	}
+	out := make([]string, 0, len(raw))
+	for _, s := range raw {
+		if s = strings.TrimSpace(s); s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if strings.TrimSpace(v) != "" {
+			return strings.TrimSpace(v)
+		}
+	}
+	return ""
+}
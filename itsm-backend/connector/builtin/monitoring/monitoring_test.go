@@ -0,0 +1,185 @@
+package monitoring
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strconv"
+	"testing"
+	"time"
+
+	"itsm-backend/connector"
+)
+
+func testConfig(name string, settings map[string]interface{}, creds map[string]string) connector.Config {
+	if settings == nil {
+		settings = map[string]interface{}{}
+	}
+	settings["reporter_id"] = 7
+	return connector.Config{TenantID: 1, Name: name, Provider: name, Enabled: true, Settings: settings, Credentials: creds}
+}
+
+const alertmanagerBody = `{
+  "version": "4",
+  "groupKey": "{}:{alertname=\"HighCPU\"}",
+  "status": "firing",
+  "receiver": "itsm",
+  "commonLabels": {"alertname": "HighCPU", "team": "ops"},
+  "commonAnnotations": {"runbook": "https://wiki/cpu"},
+  "alerts": [
+    {"status": "firing", "labels": {"alertname": "HighCPU", "severity": "critical", "instance": "web-01:9100"},
+     "annotations": {"summary": "CPU > 90%"}, "startsAt": "2026-10-01T08:00:00Z", "endsAt": "2026-10-01T09:00:00Z",
+     "generatorURL": "http://prom/graph", "fingerprint": "abc123"},
+    {"status": "resolved", "labels": {"alertname": "HighCPU", "severity": "warning", "instance": "web-02:9100"},
+     "startsAt": "2026-10-01T07:00:00Z", "endsAt": "2026-10-01T08:30:00Z"}
+  ]
+}`
+
+func TestAlertmanager_ParseAndVerify(t *testing.T) {
+	am := NewAlertmanager()
+	if err := am.Init(context.Background(), testConfig("alertmanager", nil, nil)); err == nil {
+		t.Fatal("token 缺失时应拒绝初始化")
+	}
+	if err := am.Init(context.Background(), testConfig("alertmanager", map[string]interface{}{"auto_resolve": false}, map[string]string{"token": "s3cret"})); err != nil {
+		t.Fatal(err)
+	}
+	if p := am.Policy(); p.ReporterID != 7 || p.AutoResolve || p.Category != "monitoring" || len(p.CILabels) == 0 {
+		t.Fatalf("unexpected policy: %+v", p)
+	}
+
+	if err := am.VerifySignature(map[string]string{"Authorization": "Bearer s3cret"}, nil); err != nil {
+		t.Fatalf("bearer: %v", err)
+	}
+	basic := "Basic " + base64.StdEncoding.EncodeToString([]byte("alertmanager:s3cret"))
+	if err := am.VerifySignature(map[string]string{"authorization": basic}, nil); err != nil {
+		t.Fatalf("basic: %v", err)
+	}
+	if err := am.VerifySignature(map[string]string{"Authorization": "Bearer wrong"}, nil); err == nil {
+		t.Fatal("错误 token 应被拒绝")
+	}
+
+	msg, err := am.ParseInbound([]byte(alertmanagerBody))
+	if err != nil {
+		t.Fatal(err)
+	}
+	alerts, ok := AlertsFrom(msg)
+	if !ok || len(alerts) != 2 {
+		t.Fatalf("alerts = %v, %v", alerts, ok)
+	}
+	first := alerts[0]
+	if first.Fingerprint != "abc123" || first.Status != StatusFiring || first.Severity != "critical" || first.Summary != "CPU > 90%" {
+		t.Fatalf("unexpected firing alert: %+v", first)
+	}
+	if first.Labels["team"] != "ops" || first.Annotations["runbook"] != "https://wiki/cpu" {
+		t.Fatalf("common labels/annotations not inherited: %+v", first)
+	}
+	if first.EndsAt != nil {
+		t.Fatal("firing 告警的 endsAt 不是真实结束时间")
+	}
+	second := alerts[1]
+	if second.Status != StatusResolved || second.Fingerprint == "" || second.EndsAt == nil || second.Severity != "medium" {
+		t.Fatalf("unexpected resolved alert: %+v", second)
+	}
+
+	if _, err := am.ParseAlerts([]byte(`{"version":"3","alerts":[]}`)); err == nil {
+		t.Fatal("非 v4 负载应被拒绝")
+	}
+}
+
+func TestGrafana_HMACSignature(t *testing.T) {
+	g := NewGrafana()
+	if err := g.Init(context.Background(), testConfig("grafana",
+		map[string]interface{}{"timestamp_header": "X-Grafana-Alerting-Signature-Timestamp"},
+		map[string]string{"hmac_secret": "k"})); err != nil {
+		t.Fatal(err)
+	}
+	now := time.Unix(1_790_000_000, 0)
+	g.now = func() time.Time { return now }
+
+	body := []byte(`{"receiver":"itsm","status":"firing","orgId":1,"alerts":[{"status":"firing",
+		"labels":{"alertname":"DiskFull","severity":"high","host":"db-01"},"annotations":{"summary":"磁盘满"},
+		"fingerprint":"f1","dashboardURL":"http://grafana/d/1","valueString":"[ var='A' value=97 ]"}]}`)
+	sign := func(ts string) string {
+		mac := hmac.New(sha256.New, []byte("k"))
+		mac.Write([]byte(ts + ":"))
+		mac.Write(body)
+		return hex.EncodeToString(mac.Sum(nil))
+	}
+	ts := strconv.FormatInt(now.Unix(), 10)
+	headers := map[string]string{GrafanaSignatureHeader: sign(ts), "X-Grafana-Alerting-Signature-Timestamp": ts}
+	if err := g.VerifySignature(headers, body); err != nil {
+		t.Fatalf("valid signature rejected: %v", err)
+	}
+	if err := g.VerifySignature(headers, append(body, ' ')); err == nil {
+		t.Fatal("篡改的负载应被拒绝")
+	}
+	stale := strconv.FormatInt(now.Add(-10*time.Minute).Unix(), 10)
+	if err := g.VerifySignature(map[string]string{GrafanaSignatureHeader: sign(stale), "X-Grafana-Alerting-Signature-Timestamp": stale}, body); err == nil {
+		t.Fatal("过期时间戳应被拒绝")
+	}
+
+	alerts, err := g.ParseAlerts(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(alerts) != 1 || alerts[0].Source != "grafana" || alerts[0].Fingerprint != "f1" || alerts[0].Severity != "high" {
+		t.Fatalf("unexpected alerts: %+v", alerts)
+	}
+	if alerts[0].Annotations["dashboard_url"] != "http://grafana/d/1" || alerts[0].Annotations["value"] == "" {
+		t.Fatalf("grafana extras missing: %+v", alerts[0].Annotations)
+	}
+}
+
+func TestZabbix_ProblemAndRecovery(t *testing.T) {
+	z := NewZabbix()
+	if err := z.Init(context.Background(), testConfig("zabbix", map[string]interface{}{"timezone": "Asia/Shanghai"}, map[string]string{"token": "zbx"})); err != nil {
+		t.Fatal(err)
+	}
+	if err := z.VerifySignature(map[string]string{"Authorization": "Bearer zbx"}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	problem := []byte(`{"event_id":"4711","event_value":"1","event_name":"MySQL is down","event_severity":"Disaster",
+		"event_nseverity":5,"event_date":"2026.10.01","event_time":"16:00:00","host_name":"db-01","host_ip":"10.0.0.5",
+		"trigger_id":"900","event_tags":"[{\"tag\":\"service\",\"value\":\"mysql\"}]"}`)
+	alerts, err := z.ParseAlerts(problem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := alerts[0]
+	if a.Fingerprint != "4711" || a.Status != StatusFiring || a.Severity != "critical" || a.Labels["host"] != "db-01" || a.Labels["service"] != "mysql" {
+		t.Fatalf("unexpected problem alert: %+v", a)
+	}
+	if !a.StartsAt.Equal(time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC)) {
+		t.Fatalf("startsAt = %v", a.StartsAt)
+	}
+
+	recovery := []byte(`{"event_id":4711,"event_value":"0","event_name":"MySQL is down","event_nseverity":"5",
+		"event_recovery_date":"2026.10.01","event_recovery_time":"16:20:00","host_name":"db-01","event_tags":"service:mysql, role:primary"}`)
+	alerts, err = z.ParseAlerts(recovery)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := alerts[0]
+	if r.Fingerprint != "4711" || r.Status != StatusResolved || r.EndsAt == nil || r.Labels["role"] != "primary" {
+		t.Fatalf("unexpected recovery alert: %+v", r)
+	}
+
+	if _, err := z.ParseAlerts([]byte(`{"event_id":"{EVENT.ID}"}`)); err == nil {
+		t.Fatal("未展开的宏应被拒绝")
+	}
+}
+
+func TestNormalizeSeverity(t *testing.T) {
+	cases := map[string]string{
+		"critical": "critical", "Disaster": "critical", "5": "critical",
+		"high": "high", "error": "high", "warning": "medium", "info": "low", "": "medium",
+	}
+	for in, want := range cases {
+		if got := NormalizeSeverity(in); got != want {
+			t.Errorf("NormalizeSeverity(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,237 @@
+package monitoring
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"itsm-backend/connector"
+)
+
+// zabbixTimeLayout {EVENT.DATE} {EVENT.TIME} 的格式
+const zabbixTimeLayout = "2006.01.02 15:04:05"
+
+// zabbixPayload Zabbix Webhook 媒介类型脚本推送的 JSON。参数名与媒介类型参数一一对应：
+//
+//	event_id={EVENT.ID} event_value={EVENT.VALUE} event_update_status={EVENT.UPDATE.STATUS}
+//	event_name={EVENT.NAME} event_severity={EVENT.SEVERITY} event_nseverity={EVENT.NSEVERITY}
+//	event_date={EVENT.DATE} event_time={EVENT.TIME} event_recovery_date={EVENT.RECOVERY.DATE}
+//	event_recovery_time={EVENT.RECOVERY.TIME} event_tags={EVENT.TAGSJSON} host_name={HOST.NAME}
+//	host_ip={HOST.IP} trigger_id={TRIGGER.ID} alert_message={ALERT.MESSAGE} event_url=<问题详情链接>
+//
+// 宏展开后均为字符串，数字也兼容。
+type zabbixPayload struct {
+	EventID           flexString `json:"event_id"`
+	EventValue        flexString `json:"event_value"` // 1 问题，0 恢复
+	EventUpdateStatus flexString `json:"event_update_status"`
+	EventName         flexString `json:"event_name"`
+	EventSeverity     flexString `json:"event_severity"`
+	EventNSeverity    flexString `json:"event_nseverity"`
+	EventDate         flexString `json:"event_date"`
+	EventTime         flexString `json:"event_time"`
+	EventRecoveryDate flexString `json:"event_recovery_date"`
+	EventRecoveryTime flexString `json:"event_recovery_time"`
+	EventTags         zabbixTags `json:"event_tags"`
+	HostName          flexString `json:"host_name"`
+	HostIP            flexString `json:"host_ip"`
+	TriggerID         flexString `json:"trigger_id"`
+	AlertMessage      flexString `json:"alert_message"`
+	EventURL          flexString `json:"event_url"`
+}
+
+// Zabbix Webhook 媒介类型接收器。Zabbix 的恢复事件沿用问题事件的 {EVENT.ID}，因此以问题事件ID为指纹
+type Zabbix struct {
+	base
+	location *time.Location
+}
+
+func init() {
+	connector.MustRegister(func() connector.Connector { return NewZabbix() })
+}
+
+func NewZabbix() *Zabbix { return &Zabbix{base: base{name: "zabbix"}, location: time.Local} }
+
+func (z *Zabbix) Manifest() connector.Manifest {
+	return connector.Manifest{
+		Name:                "zabbix",
+		Version:             "1.0.0",
+		Title:               "Zabbix",
+		Provider:            "zabbix",
+		Type:                connector.TypeMonitor,
+		Description:         "接收 Zabbix Webhook 媒介类型推送的问题/恢复事件（Bearer Token），按事件ID去重并自动开启、更新、解决事件。",
+		Capabilities:        []connector.Capability{connector.CapReceiveAlert, connector.CapHealthCheck},
+		Tags:                []string{"monitoring", "zabbix", "inbound"},
+		IsOfficial:          true,
+		RequiredPermissions: []string{"connector:write", "incident:write"},
+	}
+}
+
+// Init 除公共配置外支持 settings.timezone（Zabbix 服务器时区，用于解析事件时间，默认本机时区）
+func (z *Zabbix) Init(_ context.Context, cfg connector.Config) error {
+	if err := z.init(cfg); err != nil {
+		return err
+	}
+	if z.token == "" {
+		return fmt.Errorf("zabbix: credentials.token is required")
+	}
+	if tz := settingString(cfg.Settings, "timezone"); tz != "" {
+		loc, err := time.LoadLocation(tz)
+		if err != nil {
+			return fmt.Errorf("zabbix: invalid timezone %q", tz)
+		}
+		z.location = loc
+	}
+	return nil
+}
+
+// VerifySignature 校验媒介类型脚本携带的 Authorization: Bearer <token>
+func (z *Zabbix) VerifySignature(headers map[string]string, _ []byte) error {
+	return z.verifyToken(headers)
+}
+
+// ParseAlerts 解析单个问题/恢复事件
+func (z *Zabbix) ParseAlerts(body []byte) ([]Alert, error) {
+	alert, err := z.parse(body)
+	if err != nil {
+		return nil, err
+	}
+	return []Alert{*alert}, nil
+}
+
+// ParseInbound 解析为统一入站消息，告警列表在 Extras["alerts"]
+func (z *Zabbix) ParseInbound(body []byte) (*connector.InboundMessage, error) {
+	alert, err := z.parse(body)
+	if err != nil {
+		return nil, err
+	}
+	return z.inbound(alert.Labels["host"], alert.Fingerprint, []Alert{*alert}, body), nil
+}
+
+func (z *Zabbix) parse(body []byte) (*Alert, error) {
+	var p zabbixPayload
+	if err := json.Unmarshal(body, &p); err != nil {
+		return nil, fmt.Errorf("zabbix: invalid payload: %w", err)
+	}
+	eventID := p.EventID.String()
+	if eventID == "" || strings.HasPrefix(eventID, "{") {
+		return nil, fmt.Errorf("zabbix: event_id is required")
+	}
+	labels := map[string]string{"event_id": eventID}
+	for _, tag := range p.EventTags {
+		if tag.Tag != "" {
+			labels[tag.Tag] = tag.Value
+		}
+	}
+	for k, v := range map[string]string{"host": p.HostName.String(), "host_ip": p.HostIP.String(), "trigger_id": p.TriggerID.String()} {
+		if v != "" {
+			labels[k] = v
+		}
+	}
+	severity := p.EventNSeverity.String()
+	if severity == "" {
+		severity = p.EventSeverity.String()
+	}
+	status := StatusFiring
+	if p.EventValue.String() == "0" {
+		status = StatusResolved
+	}
+	alert := &Alert{
+		Source:       "zabbix",
+		Fingerprint:  eventID,
+		Status:       status,
+		Name:         firstNonEmpty(p.EventName.String(), "Zabbix problem "+eventID),
+		Summary:      p.EventName.String(),
+		Description:  p.AlertMessage.String(),
+		Severity:     NormalizeSeverity(severity),
+		Labels:       labels,
+		Annotations:  map[string]string{},
+		StartsAt:     z.parseTime(p.EventDate.String(), p.EventTime.String()),
+		GeneratorURL: p.EventURL.String(),
+	}
+	if s := p.EventSeverity.String(); s != "" {
+		alert.Annotations["zabbix_severity"] = s
+	}
+	if p.EventUpdateStatus.String() == "1" {
+		alert.Annotations["update"] = "true"
+	}
+	if status == StatusResolved {
+		endsAt := z.parseTime(p.EventRecoveryDate.String(), p.EventRecoveryTime.String())
+		alert.EndsAt = &endsAt
+	}
+	return alert, nil
+}
+
+// parseTime 解析 Zabbix 日期与时间，未展开的宏或格式错误时回退为当前时间
+func (z *Zabbix) parseTime(date, clock string) time.Time {
+	if date != "" && clock != "" {
+		if t, err := time.ParseInLocation(zabbixTimeLayout, date+" "+clock, z.location); err == nil {
+			return t
+		}
+	}
+	return time.Now()
+}
+
+// flexString 兼容字符串与数字的 JSON 值
+type flexString string
+
+func (f *flexString) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		*f = ""
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*f = flexString(s)
+		return nil
+	}
+	*f = flexString(data)
+	return nil
+}
+
+func (f flexString) String() string { return strings.TrimSpace(string(f)) }
+
+// zabbixTags 兼容 {EVENT.TAGSJSON} 数组、其字符串形式，以及 {EVENT.TAGS} 的 "k:v, k2:v2" 形式
+type zabbixTags []zabbixTag
+
+type zabbixTag struct {
+	Tag   string `json:"tag"`
+	Value string `json:"value"`
+}
+
+func (t *zabbixTags) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	if data[0] == '[' {
+		type plain zabbixTags
+		return json.Unmarshal(data, (*plain)(t))
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "[") {
+		return t.UnmarshalJSON([]byte(s))
+	}
+	if strings.HasPrefix(s, "{") { // 未展开的宏
+		return nil
+	}
+	for _, pair := range strings.Split(s, ",") {
+		k, v, _ := strings.Cut(pair, ":")
+		if k = strings.TrimSpace(k); k != "" {
+			*t = append(*t, zabbixTag{Tag: k, Value: strings.TrimSpace(v)})
+		}
+	}
+	return nil
+}
+
+var _ Source = (*Zabbix)(nil)
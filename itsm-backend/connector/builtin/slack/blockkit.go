@@ -0,0 +1,260 @@
+package slack
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"itsm-backend/connector"
+)
+
+// Block Kit 限制：section 文本 3000 字符，header 150 字符，按钮文案 75 字符，value 2000 字符
+const (
+	maxSectionText = 3000
+	maxHeaderText  = 150
+	maxButtonText  = 75
+	maxButtonValue = 2000
+	maxFields      = 10
+	maxActions     = 25
+)
+
+var markdownLink = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)\)`)
+
+// renderer 将 connector.Message / Card 渲染为 Block Kit
+// baseURL 用于把站内相对链接（/tickets/1）补全为绝对地址；为空时丢弃相对链接按钮
+type renderer struct {
+	baseURL string
+	blocks  []map[string]interface{}
+	buttons []map[string]interface{}
+}
+
+// Blocks 渲染消息为 Block Kit blocks 以及通知/无障碍场景使用的纯文本 fallback
+func Blocks(msg *connector.Message, baseURL string) ([]map[string]interface{}, string) {
+	r := &renderer{baseURL: strings.TrimRight(baseURL, "/")}
+	fallback := msg.Title
+	if msg.Card != nil {
+		r.card(msg.Card)
+		if fallback == "" && msg.Card.Header != nil {
+			fallback = msg.Card.Header.Title
+		}
+	}
+	if msg.Card == nil || msg.Content != "" {
+		if msg.Card == nil && msg.Title != "" {
+			r.header(msg.Title, "")
+		}
+		if msg.Content != "" {
+			r.section(mrkdwn(msg.Content))
+		}
+	}
+	for i := range msg.Actions {
+		r.button(msg.Actions[i])
+	}
+	r.flushButtons()
+	if fallback == "" {
+		fallback = msg.Content
+	}
+	return r.blocks, truncate(fallback, maxSectionText)
+}
+
+func (r *renderer) card(card *connector.Card) {
+	if card.Header != nil && card.Header.Title != "" {
+		r.header(card.Header.Title, card.Header.Subtitle)
+	}
+	r.elements(card.Elements)
+	for _, sec := range card.Sections {
+		if sec.Title != "" {
+			r.flushButtons()
+			r.section("*" + escape(sec.Title) + "*")
+		}
+		r.elements(sec.Content)
+	}
+}
+
+func (r *renderer) elements(elems []connector.CardElement) {
+	for _, el := range elems {
+		switch el.Type {
+		case "button":
+			if el.Action != nil {
+				r.button(*el.Action)
+			}
+			continue
+		case "select":
+			r.flushButtons()
+			r.selectMenu(el)
+			continue
+		}
+		r.flushButtons()
+		switch el.Type {
+		case "divider":
+			r.blocks = append(r.blocks, map[string]interface{}{"type": "divider"})
+		case "image":
+			if el.ImageURL == "" {
+				continue
+			}
+			alt := el.Text
+			if alt == "" {
+				alt = "image"
+			}
+			r.blocks = append(r.blocks, map[string]interface{}{"type": "image", "image_url": el.ImageURL, "alt_text": alt})
+		case "text":
+			r.fields(escape(el.Text), el.Fields)
+		default:
+			// markdown / input 以及未知类型按 mrkdwn 文本降级
+			r.fields(mrkdwn(el.Text), el.Fields)
+		}
+	}
+}
+
+func (r *renderer) header(title, subtitle string) {
+	r.blocks = append(r.blocks, map[string]interface{}{
+		"type": "header",
+		"text": plainText(truncate(title, maxHeaderText)),
+	})
+	if subtitle != "" {
+		r.blocks = append(r.blocks, map[string]interface{}{
+			"type":     "context",
+			"elements": []map[string]interface{}{{"type": "mrkdwn", "text": truncate(mrkdwn(subtitle), maxSectionText)}},
+		})
+	}
+}
+
+func (r *renderer) section(text string) {
+	if strings.TrimSpace(text) == "" {
+		return
+	}
+	r.blocks = append(r.blocks, map[string]interface{}{
+		"type": "section",
+		"text": map[string]interface{}{"type": "mrkdwn", "text": truncate(text, maxSectionText)},
+	})
+}
+
+// fields 键值对渲染为 section.fields，每个 section 最多 10 个字段
+func (r *renderer) fields(text string, kvs []connector.KV) {
+	if len(kvs) == 0 {
+		r.section(text)
+		return
+	}
+	for start := 0; start < len(kvs); start += maxFields {
+		end := start + maxFields
+		if end > len(kvs) {
+			end = len(kvs)
+		}
+		fields := make([]map[string]interface{}, 0, end-start)
+		for _, kv := range kvs[start:end] {
+			fields = append(fields, map[string]interface{}{
+				"type": "mrkdwn",
+				"text": truncate(fmt.Sprintf("*%s*\n%s", escape(kv.Key), mrkdwn(kv.Value)), 2000),
+			})
+		}
+		block := map[string]interface{}{"type": "section", "fields": fields}
+		if start == 0 && strings.TrimSpace(text) != "" {
+			block["text"] = map[string]interface{}{"type": "mrkdwn", "text": truncate(text, maxSectionText)}
+		}
+		r.blocks = append(r.blocks, block)
+	}
+}
+
+// button 连续按钮合并为一个 actions block
+// link 按钮只跳转；其余按钮通过 value 回传，交互回调时原样出现在 actions[].value
+func (r *renderer) button(a connector.Action) {
+	if a.Text == "" || len(r.buttons) >= maxActions {
+		return
+	}
+	btn := map[string]interface{}{
+		"type":      "button",
+		"text":      plainText(truncate(a.Text, maxButtonText)),
+		"action_id": fmt.Sprintf("itsm_action_%d", len(r.buttons)),
+	}
+	if a.URL != "" {
+		u := r.absolute(a.URL)
+		if u == "" {
+			return
+		}
+		btn["url"] = u
+	}
+	if a.Value != "" {
+		btn["value"] = truncate(a.Value, maxButtonValue)
+	} else if a.URL == "" {
+		return
+	}
+	switch a.Type {
+	case "primary":
+		btn["style"] = "primary"
+	case "danger":
+		btn["style"] = "danger"
+	}
+	r.buttons = append(r.buttons, btn)
+}
+
+func (r *renderer) flushButtons() {
+	if len(r.buttons) == 0 {
+		return
+	}
+	elements := make([]interface{}, 0, len(r.buttons))
+	for _, b := range r.buttons {
+		elements = append(elements, b)
+	}
+	r.blocks = append(r.blocks, map[string]interface{}{"type": "actions", "elements": elements})
+	r.buttons = nil
+}
+
+func (r *renderer) selectMenu(el connector.CardElement) {
+	options := make([]map[string]interface{}, 0, len(el.Options))
+	for _, opt := range el.Options {
+		value := opt.Text
+		if opt.Action != nil && opt.Action.Value != "" {
+			value = opt.Action.Value
+		}
+		options = append(options, map[string]interface{}{"text": plainText(truncate(opt.Text, maxButtonText)), "value": truncate(value, 150)})
+	}
+	if len(options) == 0 {
+		return
+	}
+	placeholder := el.Text
+	if placeholder == "" {
+		placeholder = "请选择"
+	}
+	r.blocks = append(r.blocks, map[string]interface{}{
+		"type": "actions",
+		"elements": []interface{}{map[string]interface{}{
+			"type":        "static_select",
+			"action_id":   fmt.Sprintf("itsm_select_%d", len(r.blocks)),
+			"placeholder": plainText(truncate(placeholder, maxButtonText)),
+			"options":     options,
+		}},
+	})
+}
+
+func (r *renderer) absolute(u string) string {
+	if strings.HasPrefix(u, "https://") || strings.HasPrefix(u, "http://") {
+		return u
+	}
+	if r.baseURL == "" || !strings.HasPrefix(u, "/") {
+		return ""
+	}
+	return r.baseURL + u
+}
+
+func plainText(s string) map[string]interface{} {
+	return map[string]interface{}{"type": "plain_text", "text": s, "emoji": true}
+}
+
+// mrkdwn 把通用 markdown 的加粗与链接转换为 Slack mrkdwn 语法
+func mrkdwn(s string) string {
+	s = markdownLink.ReplaceAllString(escape(s), "<$2|$1>")
+	return strings.ReplaceAll(s, "**", "*")
+}
+
+// escape 转义 mrkdwn 控制字符
+func escape(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+}
+
+func truncate(s string, max int) string {
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	runes := []rune(s)
+	return string(runes[:max-1]) + "…"
+}
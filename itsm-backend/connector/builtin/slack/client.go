@@ -0,0 +1,134 @@
+// Package slack Slack 连接器
+// 文档：
+//   - chat.postMessage: https://api.slack.com/methods/chat.postMessage
+//   - Incoming Webhooks: https://api.slack.com/messaging/webhooks
+//   - 请求签名: https://api.slack.com/authentication/verifying-requests-from-slack
+//   - Block Kit: https://api.slack.com/block-kit
+//   - 交互回调: https://api.slack.com/interactivity/handling
+package slack
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const BaseURL = "https://slack.com/api"
+
+// maxSignatureSkew 请求时间戳允许的偏差，超过视为重放
+const maxSignatureSkew = 5 * time.Minute
+
+type Client struct {
+	baseURL  string
+	botToken string
+	logger   *zap.SugaredLogger
+	hc       *http.Client
+}
+
+func NewClient(botToken, baseURL string) *Client {
+	if baseURL == "" {
+		baseURL = BaseURL
+	}
+	return &Client{
+		baseURL:  strings.TrimRight(baseURL, "/"),
+		botToken: botToken,
+		logger:   zap.S().Named("connector.slack"),
+		hc:       &http.Client{Timeout: 8 * time.Second},
+	}
+}
+
+// apiResponse Web API 公共响应：HTTP 200 也可能 ok=false
+type apiResponse struct {
+	OK      bool   `json:"ok"`
+	Error   string `json:"error"`
+	Channel string `json:"channel"`
+	TS      string `json:"ts"`
+	TeamID  string `json:"team_id"`
+	UserID  string `json:"user_id"`
+}
+
+// Call 调用 Web API 方法（JSON 请求体，Bearer bot token）
+func (c *Client) Call(ctx context.Context, method string, in interface{}) (*apiResponse, error) {
+	if c.botToken == "" {
+		return nil, fmt.Errorf("slack: bot_token is required for %s", method)
+	}
+	body, _ := json.Marshal(in)
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/"+method, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("Authorization", "Bearer "+c.botToken)
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	raw, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return nil, fmt.Errorf("slack: %s rate limited, retry after %ss", method, resp.Header.Get("Retry-After"))
+	}
+	var out apiResponse
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil, fmt.Errorf("slack: decode %s: %w", method, err)
+	}
+	if !out.OK {
+		return nil, fmt.Errorf("slack: %s failed: %s", method, out.Error)
+	}
+	return &out, nil
+}
+
+// PostWebhook 投递到 Incoming Webhook 或交互回调的 response_url（响应体为纯文本 "ok"）
+func (c *Client) PostWebhook(ctx context.Context, url string, payload map[string]interface{}) error {
+	body, _ := json.Marshal(payload)
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	raw, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("slack: webhook status=%d body=%s", resp.StatusCode, strings.TrimSpace(string(raw)))
+	}
+	return nil
+}
+
+// VerifyRequestSignature 校验 Slack 请求签名
+// X-Slack-Signature = "v0=" + hex(HMAC-SHA256(signing_secret, "v0:" + timestamp + ":" + body))
+func VerifyRequestSignature(signingSecret, timestamp, signature string, body []byte, now time.Time) error {
+	if signingSecret == "" {
+		return fmt.Errorf("slack: signing_secret is not configured")
+	}
+	if timestamp == "" || signature == "" {
+		return fmt.Errorf("slack: missing signature headers")
+	}
+	unixTS, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("slack: invalid request timestamp")
+	}
+	if skew := now.Sub(time.Unix(unixTS, 0)); skew > maxSignatureSkew || skew < -maxSignatureSkew {
+		return fmt.Errorf("slack: stale request timestamp")
+	}
+	if !hmac.Equal([]byte(signature), []byte(Sign(signingSecret, timestamp, body))) {
+		return fmt.Errorf("slack: signature mismatch")
+	}
+	return nil
+}
+
+// Sign 计算 v0 签名
+func Sign(signingSecret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(signingSecret))
+	mac.Write([]byte("v0:" + timestamp + ":"))
+	mac.Write(body)
+	return "v0=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,206 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"itsm-backend/connector"
+)
+
+// Slack Slack 连接器
+// 发送：Web API chat.postMessage（bot_token）或 Incoming Webhook（webhook_url / 以 https:// 开头的 channel）
+// 接收：Events API 与 Interactivity 回调，signing_secret 校验签名
+type Slack struct {
+	client        *Client
+	cfg           connector.Config
+	signingSecret string
+	webhookURL    string
+	defaultChan   string
+	appBaseURL    string
+	startedAt     time.Time
+	now           func() time.Time
+}
+
+func init() {
+	connector.MustRegister(func() connector.Connector { return &Slack{} })
+}
+
+func New() *Slack { return &Slack{} }
+
+// Compile-time assertions
+var (
+	_ connector.Connector       = (*Slack)(nil)
+	_ connector.Receiver        = (*Slack)(nil)
+	_ connector.ActionResponder = (*Slack)(nil)
+)
+
+func (s *Slack) Manifest() connector.Manifest {
+	return connector.Manifest{
+		Name:        "slack",
+		Version:     "1.0.0",
+		Title:       "Slack",
+		Provider:    "slack",
+		Type:        connector.TypeIM,
+		Description: "Slack 连接器：Web API / Incoming Webhook 发送 Block Kit 卡片，Events API 与交互按钮回调（签名校验），按钮可直接审批、认领事件或分派工单。",
+		Capabilities: []connector.Capability{
+			connector.CapSendMessage,
+			connector.CapReceiveMessage,
+			connector.CapSendCard,
+			connector.CapReplyMessage,
+			connector.CapApproveProcess,
+			connector.CapUpdateTicket,
+		},
+		Tags:                []string{"im", "slack", "global"},
+		Homepage:            "https://api.slack.com",
+		IsOfficial:          true,
+		RequiredPermissions: []string{"connector:write", "ticket:write", "approval:write"},
+	}
+}
+
+// Init 凭据：
+//   - bot_token       Bot User OAuth Token（xoxb-），Web API 发送必需
+//   - webhook_url     Incoming Webhook，未配置 bot_token 时的发送通道
+//   - signing_secret  接收回调必需
+//
+// 设置：base_url（测试/代理）、default_channel、app_base_url（补全站内相对链接）
+func (s *Slack) Init(_ context.Context, cfg connector.Config) error {
+	botToken := cfg.Credentials["bot_token"]
+	webhookURL := cfg.Credentials["webhook_url"]
+	if botToken == "" && webhookURL == "" {
+		return fmt.Errorf("slack: credentials.bot_token or webhook_url is required")
+	}
+	baseURL, _ := cfg.Settings["base_url"].(string)
+	s.client = NewClient(botToken, baseURL)
+	s.signingSecret = cfg.Credentials["signing_secret"]
+	s.webhookURL = webhookURL
+	s.defaultChan, _ = cfg.Settings["default_channel"].(string)
+	s.appBaseURL, _ = cfg.Settings["app_base_url"].(string)
+	s.cfg = cfg
+	s.startedAt = time.Now()
+	if s.now == nil {
+		s.now = time.Now
+	}
+	return nil
+}
+
+// Send 实现 connector.Connector.Send
+// channel 字段：
+//   - "C0123" / "U0123" / "D0123"  -> chat.postMessage（U 开头即机器人私聊）
+//   - URL 开头（https）             -> Incoming Webhook / response_url
+//   - 空                            -> default_channel，否则 webhook_url
+func (s *Slack) Send(ctx context.Context, msg *connector.Message) error {
+	if s.client == nil {
+		return fmt.Errorf("slack: connector not initialized")
+	}
+	if msg == nil {
+		return fmt.Errorf("slack: message is required")
+	}
+	channel := msg.Channel
+	if channel == "" {
+		channel = s.defaultChan
+	}
+	if channel == "" {
+		channel = s.webhookURL
+	}
+	if channel == "" {
+		return fmt.Errorf("slack: channel is required")
+	}
+	blocks, text := Blocks(msg, s.appBaseURL)
+	payload := map[string]interface{}{"text": text, "blocks": blocks}
+	if isURL(channel) {
+		return s.client.PostWebhook(ctx, channel, payload)
+	}
+	payload["channel"] = channel
+	if thread := firstNonEmpty(msg.ThreadID, msg.ReplyTo); thread != "" {
+		payload["thread_ts"] = thread
+	}
+	_, err := s.client.Call(ctx, "chat.postMessage", payload)
+	return err
+}
+
+// RespondAction 通过交互回调的 response_url 替换原消息，按钮随之移除，避免重复点击
+func (s *Slack) RespondAction(ctx context.Context, in *connector.InboundMessage, reply *connector.Message) error {
+	if s.client == nil {
+		return fmt.Errorf("slack: connector not initialized")
+	}
+	responseURL, _ := in.Extras["response_url"].(string)
+	blocks, text := Blocks(reply, s.appBaseURL)
+	if responseURL == "" {
+		return s.Send(ctx, &connector.Message{Channel: in.ChatID, Title: reply.Title, Content: reply.Content, ThreadID: in.MessageID})
+	}
+	return s.client.PostWebhook(ctx, responseURL, map[string]interface{}{
+		"replace_original": true,
+		"text":             text,
+		"blocks":           blocks,
+	})
+}
+
+func (s *Slack) HealthCheck(ctx context.Context) connector.HealthStatus {
+	if s.client == nil {
+		return connector.HealthStatus{OK: false, Message: "not initialized"}
+	}
+	if s.client.botToken == "" {
+		// 仅 Webhook 模式无法主动探测，配置存在即视为可用
+		return connector.HealthStatus{OK: true, Message: "incoming webhook configured", CheckedAt: time.Now()}
+	}
+	start := time.Now()
+	out, err := s.client.Call(ctx, "auth.test", map[string]interface{}{})
+	if err != nil {
+		return connector.HealthStatus{OK: false, Message: err.Error(), CheckedAt: time.Now()}
+	}
+	return connector.HealthStatus{
+		OK:        true,
+		LatencyMs: time.Since(start).Milliseconds(),
+		Message:   "bot token valid",
+		CheckedAt: time.Now(),
+		Extra:     map[string]interface{}{"team_id": out.TeamID, "bot_user_id": out.UserID, "started_at": s.startedAt},
+	}
+}
+
+func (s *Slack) Close() error { return nil }
+
+func (s *Slack) CallbackInstanceID() string {
+	id, _ := s.cfg.Settings["callbackInstanceId"].(string)
+	return id
+}
+
+// VerifySignature 满足 connector.Receiver 接口
+func (s *Slack) VerifySignature(headers map[string]string, body []byte) error {
+	if s.client == nil {
+		return fmt.Errorf("slack: not initialized")
+	}
+	now := time.Now
+	if s.now != nil {
+		now = s.now
+	}
+	return VerifyRequestSignature(s.signingSecret,
+		headerValue(headers, "X-Slack-Request-Timestamp"), headerValue(headers, "X-Slack-Signature"), body, now())
+}
+
+func isURL(s string) bool {
+	return strings.HasPrefix(s, "https://") || strings.HasPrefix(s, "http://")
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// headerValue 兼容 http.Header 规范化前后的大小写
+func headerValue(headers map[string]string, key string) string {
+	if v, ok := headers[key]; ok {
+		return v
+	}
+	for k, v := range headers {
+		if strings.EqualFold(k, key) {
+			return v
+		}
+	}
+	return ""
+}
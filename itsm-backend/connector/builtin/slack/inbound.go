@@ -0,0 +1,154 @@
+package slack
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"itsm-backend/connector"
+)
+
+// interactionPayload Interactivity 回调（block_actions），以表单字段 payload=<json> 投递
+type interactionPayload struct {
+	Type      string `json:"type"`
+	TriggerID string `json:"trigger_id"`
+	User      struct {
+		ID       string `json:"id"`
+		Username string `json:"username"`
+		Name     string `json:"name"`
+	} `json:"user"`
+	Team struct {
+		ID string `json:"id"`
+	} `json:"team"`
+	Channel struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"channel"`
+	Container struct {
+		MessageTS string `json:"message_ts"`
+		ChannelID string `json:"channel_id"`
+	} `json:"container"`
+	ResponseURL string `json:"response_url"`
+	Actions     []struct {
+		ActionID       string `json:"action_id"`
+		BlockID        string `json:"block_id"`
+		Type           string `json:"type"`
+		Value          string `json:"value"`
+		ActionTS       string `json:"action_ts"`
+		SelectedOption *struct {
+			Value string `json:"value"`
+		} `json:"selected_option"`
+	} `json:"actions"`
+}
+
+// eventEnvelope Events API 回调
+type eventEnvelope struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	EventID   string `json:"event_id"`
+	TeamID    string `json:"team_id"`
+	Event     struct {
+		Type        string `json:"type"`
+		User        string `json:"user"`
+		BotID       string `json:"bot_id"`
+		Text        string `json:"text"`
+		Channel     string `json:"channel"`
+		ChannelType string `json:"channel_type"`
+		TS          string `json:"ts"`
+		ThreadTS    string `json:"thread_ts"`
+	} `json:"event"`
+}
+
+// ParseInbound 解析 Slack 回调
+// 支持：url_verification / event_callback（message、app_mention）/ block_actions 交互按钮
+func (s *Slack) ParseInbound(body []byte) (*connector.InboundMessage, error) {
+	if bytes.HasPrefix(body, []byte("payload=")) {
+		return parseInteraction(body)
+	}
+	var env eventEnvelope
+	if err := json.Unmarshal(body, &env); err != nil {
+		return nil, fmt.Errorf("slack: parse inbound: %w", err)
+	}
+	switch env.Type {
+	case "url_verification":
+		return &connector.InboundMessage{
+			ConnectorName: "slack",
+			Type:          "url_verification",
+			Content:       env.Challenge,
+			ReceivedAt:    time.Now(),
+		}, nil
+	case "event_callback":
+		chatType := "group"
+		if env.Event.ChannelType == "im" {
+			chatType = "direct"
+		}
+		return &connector.InboundMessage{
+			ConnectorType: connector.TypeIM,
+			ConnectorName: "slack",
+			Channel:       env.Event.Channel,
+			UserID:        env.Event.User,
+			ChatID:        env.Event.Channel,
+			ChatType:      chatType,
+			MessageID:     env.EventID,
+			Content:       env.Event.Text,
+			Type:          env.Event.Type,
+			Raw:           body,
+			ReceivedAt:    time.Now(),
+			Extras: map[string]interface{}{
+				"team_id": env.TeamID, "ts": env.Event.TS, "thread_ts": env.Event.ThreadTS, "bot_id": env.Event.BotID,
+			},
+		}, nil
+	}
+	return nil, fmt.Errorf("slack: unknown event type=%s", env.Type)
+}
+
+func parseInteraction(body []byte) (*connector.InboundMessage, error) {
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, fmt.Errorf("slack: parse interaction form: %w", err)
+	}
+	raw := form.Get("payload")
+	var p interactionPayload
+	if err := json.Unmarshal([]byte(raw), &p); err != nil {
+		return nil, fmt.Errorf("slack: parse interaction payload: %w", err)
+	}
+	if p.Type != "block_actions" || len(p.Actions) == 0 {
+		return nil, fmt.Errorf("slack: unsupported interaction type=%s", p.Type)
+	}
+	act := p.Actions[0]
+	value := act.Value
+	if act.SelectedOption != nil {
+		value = act.SelectedOption.Value
+	}
+	channelID := p.Channel.ID
+	if channelID == "" {
+		channelID = p.Container.ChannelID
+	}
+	userName := p.User.Name
+	if userName == "" {
+		userName = p.User.Username
+	}
+	return &connector.InboundMessage{
+		ConnectorType: connector.TypeIM,
+		ConnectorName: "slack",
+		Channel:       channelID,
+		UserID:        p.User.ID,
+		UserName:      userName,
+		ChatID:        channelID,
+		// 同一次点击的重试携带相同 trigger_id + action_ts
+		MessageID:  p.TriggerID + ":" + act.ActionTS,
+		Content:    value,
+		Type:       connector.InboundCardAction,
+		Raw:        json.RawMessage(raw),
+		ReceivedAt: time.Now(),
+		Extras: map[string]interface{}{
+			connector.ExtraActionValue: value,
+			"action_id":                act.ActionID,
+			"response_url":             p.ResponseURL,
+			"message_ts":               p.Container.MessageTS,
+			"team_id":                  p.Team.ID,
+		},
+	}, nil
+}
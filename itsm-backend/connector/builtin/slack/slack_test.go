@@ -0,0 +1,219 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"itsm-backend/connector"
+)
+
+// fakeSlack 模拟 Slack Web API 与 Webhook / response_url
+type fakeSlack struct {
+	mu       sync.Mutex
+	requests map[string][]map[string]interface{}
+	auth     []string
+}
+
+func newFakeSlack(t *testing.T) (*fakeSlack, *httptest.Server) {
+	f := &fakeSlack{requests: map[string][]map[string]interface{}{}}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := io.ReadAll(r.Body)
+		var body map[string]interface{}
+		_ = json.Unmarshal(raw, &body)
+		f.mu.Lock()
+		f.requests[r.URL.Path] = append(f.requests[r.URL.Path], body)
+		f.auth = append(f.auth, r.Header.Get("Authorization"))
+		f.mu.Unlock()
+		switch r.URL.Path {
+		case "/api/chat.postMessage":
+			if body["channel"] == "C_ARCHIVED" {
+				_, _ = w.Write([]byte(`{"ok":false,"error":"is_archived"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"ok":true,"channel":"C1","ts":"1700000000.000100"}`))
+		case "/api/auth.test":
+			_, _ = w.Write([]byte(`{"ok":true,"team_id":"T1","user_id":"UBOT"}`))
+		case "/hooks/incoming", "/actions/response":
+			_, _ = w.Write([]byte("ok"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return f, srv
+}
+
+func (f *fakeSlack) last(path string) map[string]interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	reqs := f.requests[path]
+	if len(reqs) == 0 {
+		return nil
+	}
+	return reqs[len(reqs)-1]
+}
+
+func newTestSlack(t *testing.T, srv *httptest.Server, creds map[string]string) *Slack {
+	s := New()
+	if creds == nil {
+		creds = map[string]string{"bot_token": "xoxb-test", "signing_secret": "sig-secret"}
+	}
+	err := s.Init(context.Background(), connector.Config{
+		TenantID: 1, Name: "slack", Provider: "slack", Enabled: true, Credentials: creds,
+		Settings: map[string]interface{}{"base_url": srv.URL + "/api", "app_base_url": "https://itsm.example.com"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestSend_WebAPIRendersBlockKitCard(t *testing.T) {
+	fake, srv := newFakeSlack(t)
+	s := newTestSlack(t, srv, nil)
+
+	err := s.Send(context.Background(), &connector.Message{
+		Channel: "U123",
+		Card: &connector.Card{
+			Header: &connector.CardHeader{Title: "审批请求 TK-1", Subtitle: "**紧急**"},
+			Sections: []connector.CardSection{{
+				Title: "详情",
+				Content: []connector.CardElement{
+					{Type: "markdown", Text: "查看 [工单](https://itsm.example.com/tickets/1) <script>", Fields: []connector.KV{{Key: "优先级", Value: "high"}}},
+					{Type: "divider"},
+					{Type: "button", Action: &connector.Action{Type: "primary", Text: "同意", Value: "approval:approve:9"}},
+					{Type: "button", Action: &connector.Action{Type: "danger", Text: "拒绝", Value: "approval:reject:9"}},
+				},
+			}},
+		},
+		Actions: []connector.Action{{Type: "link", Text: "打开", URL: "/tickets/1"}, {Type: "link", Text: "无效", URL: "tickets/1"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := fake.last("/api/chat.postMessage")
+	if body["channel"] != "U123" || body["text"] != "审批请求 TK-1" {
+		t.Fatalf("unexpected payload: %+v", body)
+	}
+	if fake.auth[0] != "Bearer xoxb-test" {
+		t.Fatalf("unexpected auth header: %q", fake.auth[0])
+	}
+	var buf strings.Builder
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(body["blocks"])
+	blocks := buf.String()
+	for _, want := range []string{
+		`"type":"header"`, `"text":"*紧急*"`, `<https://itsm.example.com/tickets/1|工单> &lt;script&gt;`,
+		`"*优先级*\nhigh"`, `"type":"divider"`, `"style":"primary"`, `"value":"approval:approve:9"`,
+		`"style":"danger"`, `"url":"https://itsm.example.com/tickets/1"`,
+	} {
+		if !strings.Contains(blocks, want) {
+			t.Fatalf("blocks missing %s: %s", want, blocks)
+		}
+	}
+	if strings.Contains(blocks, "无效") {
+		t.Fatalf("relative link without leading slash should be dropped: %s", blocks)
+	}
+
+	if err := s.Send(context.Background(), &connector.Message{Channel: "C_ARCHIVED", Content: "hi"}); err == nil || !strings.Contains(err.Error(), "is_archived") {
+		t.Fatalf("expected API error, got %v", err)
+	}
+}
+
+func TestSend_IncomingWebhookAndRespondAction(t *testing.T) {
+	fake, srv := newFakeSlack(t)
+	s := newTestSlack(t, srv, map[string]string{"webhook_url": srv.URL + "/hooks/incoming"})
+
+	if err := s.Send(context.Background(), &connector.Message{Title: "P1 事件", Content: "数据库不可用"}); err != nil {
+		t.Fatal(err)
+	}
+	if body := fake.last("/hooks/incoming"); body == nil || body["text"] != "P1 事件" {
+		t.Fatalf("webhook not called: %+v", body)
+	}
+	if err := s.Send(context.Background(), &connector.Message{Channel: "C1", Content: "x"}); err == nil {
+		t.Fatal("Web API without bot_token should fail")
+	}
+
+	in := &connector.InboundMessage{ChatID: "C1", Extras: map[string]interface{}{"response_url": srv.URL + "/actions/response"}}
+	if err := s.RespondAction(context.Background(), in, &connector.Message{Content: "已审批通过"}); err != nil {
+		t.Fatal(err)
+	}
+	body := fake.last("/actions/response")
+	if body["replace_original"] != true || body["text"] != "已审批通过" {
+		t.Fatalf("unexpected response_url payload: %+v", body)
+	}
+
+	if h := s.HealthCheck(context.Background()); !h.OK {
+		t.Fatalf("webhook-only health: %+v", h)
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	_, srv := newFakeSlack(t)
+	s := newTestSlack(t, srv, nil)
+	now := time.Unix(1700000000, 0)
+	s.now = func() time.Time { return now }
+	body := []byte("payload=%7B%7D")
+	ts := strconv.FormatInt(now.Unix(), 10)
+
+	headers := map[string]string{"X-Slack-Request-Timestamp": ts, "X-Slack-Signature": Sign("sig-secret", ts, body)}
+	if err := s.VerifySignature(headers, body); err != nil {
+		t.Fatalf("valid signature rejected: %v", err)
+	}
+	if err := s.VerifySignature(headers, []byte("payload=tampered")); err == nil {
+		t.Fatal("tampered body should be rejected")
+	}
+	stale := strconv.FormatInt(now.Add(-10*time.Minute).Unix(), 10)
+	if err := s.VerifySignature(map[string]string{"x-slack-request-timestamp": stale, "x-slack-signature": Sign("sig-secret", stale, body)}, body); err == nil {
+		t.Fatal("stale timestamp should be rejected")
+	}
+	if err := s.VerifySignature(map[string]string{}, body); err == nil {
+		t.Fatal("missing headers should be rejected")
+	}
+}
+
+func TestParseInbound(t *testing.T) {
+	s := New()
+
+	msg, err := s.ParseInbound([]byte(`{"type":"url_verification","challenge":"chal-1"}`))
+	if err != nil || msg.Type != "url_verification" || msg.Content != "chal-1" {
+		t.Fatalf("url_verification: %+v %v", msg, err)
+	}
+
+	msg, err = s.ParseInbound([]byte(`{"type":"event_callback","event_id":"Ev1","team_id":"T1",
+		"event":{"type":"app_mention","user":"U1","text":"<@UBOT> 帮我查下 TK-1","channel":"C1","channel_type":"channel","ts":"1.2"}}`))
+	if err != nil || msg.MessageID != "Ev1" || msg.UserID != "U1" || msg.Type != "app_mention" || msg.ChatType != "group" {
+		t.Fatalf("event_callback: %+v %v", msg, err)
+	}
+
+	payload := `{"type":"block_actions","trigger_id":"trg1","user":{"id":"U9","username":"alice"},"team":{"id":"T1"},
+		"channel":{"id":"C1"},"container":{"message_ts":"1.2","channel_id":"C1"},"response_url":"https://hooks.slack.com/actions/x",
+		"actions":[{"action_id":"itsm_action_0","type":"button","value":"approval:approve:9","action_ts":"1700000001.1"}]}`
+	msg, err = s.ParseInbound([]byte("payload=" + url.QueryEscape(payload)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.Type != connector.InboundCardAction || msg.UserID != "U9" || msg.UserName != "alice" || msg.ChatID != "C1" {
+		t.Fatalf("block_actions: %+v", msg)
+	}
+	if msg.Extras[connector.ExtraActionValue] != "approval:approve:9" || msg.Extras["response_url"] != "https://hooks.slack.com/actions/x" {
+		t.Fatalf("block_actions extras: %+v", msg.Extras)
+	}
+	if msg.MessageID != "trg1:1700000001.1" {
+		t.Fatalf("unexpected message id: %s", msg.MessageID)
+	}
+
+	if _, err := s.ParseInbound([]byte(`{"type":"app_rate_limited"}`)); err == nil {
+		t.Fatal("unknown envelope should fail")
+	}
+}
@@ -0,0 +1,148 @@
+package teams
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// OpenIDMetadataURL Bot Connector 服务签发令牌的 OpenID 元数据
+	OpenIDMetadataURL = "https://login.botframework.com/v1/.well-known/openidconfiguration"
+	// TokenIssuer Bot Connector 服务令牌的签发者
+	TokenIssuer = "https://api.botframework.com"
+
+	jwksMaxAge       = 24 * time.Hour
+	jwksMinRefresh   = time.Minute
+	tokenClockLeeway = 5 * time.Minute
+)
+
+// verifier 校验 Bot Connector 发往机器人的 JWT（RS256，签名密钥来自 OpenID 元数据的 jwks_uri）
+type verifier struct {
+	metadataURL string
+	appID       string
+	hc          *http.Client
+	now         func() time.Time
+
+	mu        sync.Mutex
+	keys      map[string]*rsa.PublicKey
+	fetchedAt time.Time
+}
+
+func newVerifier(metadataURL, appID string) *verifier {
+	if metadataURL == "" {
+		metadataURL = OpenIDMetadataURL
+	}
+	return &verifier{metadataURL: metadataURL, appID: appID, hc: &http.Client{Timeout: 8 * time.Second}, now: time.Now}
+}
+
+// Verify 校验 Authorization 头并返回令牌声明
+// 要求：RS256、iss=api.botframework.com、aud=机器人 AppID、在有效期内
+func (v *verifier) Verify(ctx context.Context, authorization string) (jwt.MapClaims, error) {
+	raw, ok := strings.CutPrefix(authorization, "Bearer ")
+	if !ok || raw == "" {
+		return nil, fmt.Errorf("teams: missing bearer token")
+	}
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(raw, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return v.key(ctx, kid)
+	},
+		jwt.WithValidMethods([]string{"RS256"}),
+		jwt.WithIssuer(TokenIssuer),
+		jwt.WithAudience(v.appID),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(tokenClockLeeway),
+		jwt.WithTimeFunc(v.now),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("teams: invalid token: %w", err)
+	}
+	return claims, nil
+}
+
+// key 按 kid 取公钥；未命中时刷新 JWKS（限频，防止伪造 kid 打爆元数据端点）
+func (v *verifier) key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if k, ok := v.keys[kid]; ok && v.now().Sub(v.fetchedAt) < jwksMaxAge {
+		return k, nil
+	}
+	if v.keys == nil || v.now().Sub(v.fetchedAt) >= jwksMinRefresh {
+		keys, err := v.fetchKeys(ctx)
+		if err != nil {
+			return nil, err
+		}
+		v.keys, v.fetchedAt = keys, v.now()
+	}
+	if k, ok := v.keys[kid]; ok {
+		return k, nil
+	}
+	return nil, fmt.Errorf("teams: unknown signing key %q", kid)
+}
+
+func (v *verifier) fetchKeys(ctx context.Context) (map[string]*rsa.PublicKey, error) {
+	var meta struct {
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err := v.getJSON(ctx, v.metadataURL, &meta); err != nil {
+		return nil, fmt.Errorf("teams: openid metadata: %w", err)
+	}
+	if meta.JWKSURI == "" {
+		return nil, fmt.Errorf("teams: openid metadata has no jwks_uri")
+	}
+	var set struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := v.getJSON(ctx, meta.JWKSURI, &set); err != nil {
+		return nil, fmt.Errorf("teams: jwks: %w", err)
+	}
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" || k.Kid == "" {
+			continue
+		}
+		n, errN := base64.RawURLEncoding.DecodeString(k.N)
+		e, errE := base64.RawURLEncoding.DecodeString(k.E)
+		if errN != nil || errE != nil || len(e) == 0 {
+			continue
+		}
+		keys[k.Kid] = &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("teams: jwks contains no usable RSA keys")
+	}
+	return keys, nil
+}
+
+func (v *verifier) getJSON(ctx context.Context, u string, out interface{}) error {
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	resp, err := v.hc.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("status=%d", resp.StatusCode)
+	}
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, out)
+}
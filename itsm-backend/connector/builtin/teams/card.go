@@ -0,0 +1,218 @@
+package teams
+
+import (
+	"strings"
+
+	"itsm-backend/connector"
+)
+
+const (
+	adaptiveCardContentType = "application/vnd.microsoft.card.adaptive"
+	adaptiveCardSchema      = "http://adaptivecards.io/schemas/adaptive-card.json"
+	// Teams 桌面/移动端均支持的 Adaptive Card 版本
+	adaptiveCardVersion = "1.4"
+)
+
+// cardBuilder 将 connector.Message / Card 渲染为 Adaptive Card
+// 卡片内按钮就地渲染为 ActionSet，Message.Actions 渲染为卡片底部 actions
+// 回传按钮使用 Action.Submit，data.value 在回调活动的 value.value 中原样返回
+type cardBuilder struct {
+	baseURL string
+	body    []map[string]interface{}
+	pending []map[string]interface{}
+}
+
+// AdaptiveCard 渲染消息为 Adaptive Card 内容
+func AdaptiveCard(msg *connector.Message, baseURL string) map[string]interface{} {
+	b := &cardBuilder{baseURL: strings.TrimRight(baseURL, "/")}
+	if msg.Card != nil {
+		b.card(msg.Card)
+	} else if msg.Title != "" {
+		b.header(msg.Title, "", "")
+	}
+	if msg.Content != "" {
+		b.text(msg.Content, false)
+	}
+	b.flush()
+	actions := make([]map[string]interface{}, 0, len(msg.Actions))
+	for _, a := range msg.Actions {
+		if act := b.action(a); act != nil {
+			actions = append(actions, act)
+		}
+	}
+	card := map[string]interface{}{
+		"type":    "AdaptiveCard",
+		"$schema": adaptiveCardSchema,
+		"version": adaptiveCardVersion,
+		"body":    b.body,
+		// 宽屏下占满消息区域，避免字段被截断
+		"msteams": map[string]interface{}{"width": "Full"},
+	}
+	if len(actions) > 0 {
+		card["actions"] = actions
+	}
+	return card
+}
+
+// Activity 构造 message 活动：有卡片或按钮时附带 Adaptive Card，否则发送 markdown 文本
+func Activity(msg *connector.Message, baseURL string) map[string]interface{} {
+	if msg.Card == nil && len(msg.Actions) == 0 {
+		text := msg.Content
+		if msg.Title != "" {
+			text = "**" + msg.Title + "**\n\n" + text
+		}
+		return map[string]interface{}{"type": "message", "text": text, "textFormat": "markdown"}
+	}
+	return map[string]interface{}{
+		"type":        "message",
+		"summary":     summary(msg),
+		"attachments": []map[string]interface{}{{"contentType": adaptiveCardContentType, "content": AdaptiveCard(msg, baseURL)}},
+	}
+}
+
+func (b *cardBuilder) card(card *connector.Card) {
+	if card.Header != nil && card.Header.Title != "" {
+		b.header(card.Header.Title, card.Header.Subtitle, card.Header.Color)
+	}
+	b.elements(card.Elements)
+	for _, sec := range card.Sections {
+		if sec.Title != "" {
+			b.flush()
+			b.body = append(b.body, map[string]interface{}{
+				"type": "TextBlock", "text": sec.Title, "weight": "Bolder", "wrap": true, "separator": true, "spacing": "Medium",
+			})
+		}
+		b.elements(sec.Content)
+	}
+}
+
+func (b *cardBuilder) elements(elems []connector.CardElement) {
+	for _, el := range elems {
+		switch el.Type {
+		case "button":
+			if el.Action != nil {
+				if act := b.action(*el.Action); act != nil {
+					b.pending = append(b.pending, act)
+				}
+			}
+			continue
+		case "select":
+			// 选项逐个渲染为回传按钮，避免 Input.ChoiceSet 需要额外的提交按钮
+			for _, opt := range el.Options {
+				a := connector.Action{Type: "button", Text: opt.Text, Value: opt.Text}
+				if opt.Action != nil && opt.Action.Value != "" {
+					a.Value = opt.Action.Value
+				}
+				if act := b.action(a); act != nil {
+					b.pending = append(b.pending, act)
+				}
+			}
+			continue
+		}
+		b.flush()
+		switch el.Type {
+		case "divider":
+			b.body = append(b.body, map[string]interface{}{"type": "Container", "separator": true, "items": []interface{}{}})
+		case "image":
+			if el.ImageURL != "" {
+				b.body = append(b.body, map[string]interface{}{"type": "Image", "url": el.ImageURL, "altText": el.Text})
+			}
+		default:
+			if el.Text != "" {
+				b.text(el.Text, false)
+			}
+			if len(el.Fields) > 0 {
+				facts := make([]map[string]string, 0, len(el.Fields))
+				for _, kv := range el.Fields {
+					facts = append(facts, map[string]string{"title": kv.Key, "value": kv.Value})
+				}
+				b.body = append(b.body, map[string]interface{}{"type": "FactSet", "facts": facts})
+			}
+		}
+	}
+}
+
+func (b *cardBuilder) header(title, subtitle, color string) {
+	block := map[string]interface{}{"type": "TextBlock", "text": title, "size": "Large", "weight": "Bolder", "wrap": true}
+	if c := headerColor(color); c != "" {
+		block["color"] = c
+	}
+	b.body = append(b.body, block)
+	if subtitle != "" {
+		b.text(subtitle, true)
+	}
+}
+
+func (b *cardBuilder) text(text string, subtle bool) {
+	block := map[string]interface{}{"type": "TextBlock", "text": text, "wrap": true}
+	if subtle {
+		block["isSubtle"] = true
+		block["spacing"] = "None"
+	}
+	b.body = append(b.body, block)
+}
+
+// flush 连续按钮合并为一个 ActionSet
+func (b *cardBuilder) flush() {
+	if len(b.pending) == 0 {
+		return
+	}
+	b.body = append(b.body, map[string]interface{}{"type": "ActionSet", "actions": b.pending})
+	b.pending = nil
+}
+
+func (b *cardBuilder) action(a connector.Action) map[string]interface{} {
+	if a.Text == "" {
+		return nil
+	}
+	if a.Value == "" {
+		u := b.absolute(a.URL)
+		if u == "" {
+			return nil
+		}
+		return map[string]interface{}{"type": "Action.OpenUrl", "title": a.Text, "url": u}
+	}
+	act := map[string]interface{}{"type": "Action.Submit", "title": a.Text, "data": map[string]string{"value": a.Value}}
+	switch a.Type {
+	case "primary":
+		act["style"] = "positive"
+	case "danger":
+		act["style"] = "destructive"
+	}
+	return act
+}
+
+func (b *cardBuilder) absolute(u string) string {
+	if strings.HasPrefix(u, "https://") || strings.HasPrefix(u, "http://") {
+		return u
+	}
+	if b.baseURL == "" || !strings.HasPrefix(u, "/") {
+		return ""
+	}
+	return b.baseURL + u
+}
+
+func headerColor(color string) string {
+	switch color {
+	case "red":
+		return "Attention"
+	case "orange":
+		return "Warning"
+	case "green":
+		return "Good"
+	case "blue":
+		return "Accent"
+	}
+	return ""
+}
+
+// summary 通知栏与活动源中显示的摘要
+func summary(msg *connector.Message) string {
+	if msg.Title != "" {
+		return msg.Title
+	}
+	if msg.Card != nil && msg.Card.Header != nil && msg.Card.Header.Title != "" {
+		return msg.Card.Header.Title
+	}
+	return msg.Content
+}
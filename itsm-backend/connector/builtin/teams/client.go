@@ -0,0 +1,195 @@
+// Package teams Microsoft Teams 连接器
+// 文档：
+//   - Bot Connector 鉴权: https://learn.microsoft.com/azure/bot-service/rest-api/bot-framework-rest-connector-authentication
+//   - 会话与活动 API: https://learn.microsoft.com/azure/bot-service/rest-api/bot-framework-rest-connector-api-reference
+//   - 主动消息: https://learn.microsoft.com/microsoftteams/platform/bots/how-to/conversations/send-proactive-messages
+//   - Incoming Webhook: https://learn.microsoft.com/microsoftteams/platform/webhooks-and-connectors/how-to/add-incoming-webhook
+//   - Adaptive Cards: https://adaptivecards.io/explorer/
+package teams
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	LoginURL = "https://login.microsoftonline.com"
+	// ServiceURL 公有云 Teams 渠道的默认 Bot Connector 地址；以入站活动携带的 serviceUrl 为准
+	ServiceURL = "https://smba.trafficmanager.net/teams/"
+	// botFrameworkTenant 多租户 Bot 的令牌颁发租户
+	botFrameworkTenant = "botframework.com"
+	botFrameworkScope  = "https://api.botframework.com/.default"
+)
+
+type Client struct {
+	loginURL  string
+	appID     string
+	appSecret string
+	tenant    string
+	logger    *zap.SugaredLogger
+	hc        *http.Client
+
+	mu    sync.Mutex
+	token string
+	exp   time.Time
+}
+
+// NewClient tenant 为空时按多租户 Bot 处理；单租户 Bot 传 Azure AD 租户ID
+func NewClient(appID, appSecret, tenant, loginURL string) *Client {
+	if loginURL == "" {
+		loginURL = LoginURL
+	}
+	if tenant == "" {
+		tenant = botFrameworkTenant
+	}
+	return &Client{
+		loginURL:  strings.TrimRight(loginURL, "/"),
+		appID:     appID,
+		appSecret: appSecret,
+		tenant:    tenant,
+		logger:    zap.S().Named("connector.teams"),
+		hc:        &http.Client{Timeout: 8 * time.Second},
+	}
+}
+
+// Token 以客户端凭据换取 Bot Connector 访问令牌（带缓存）
+func (c *Client) Token(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.token != "" && time.Until(c.exp) > 5*time.Minute {
+		return c.token, nil
+	}
+	form := url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {c.appID},
+		"client_secret": {c.appSecret},
+		"scope":         {botFrameworkScope},
+	}
+	u := fmt.Sprintf("%s/%s/oauth2/v2.0/token", c.loginURL, c.tenant)
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, u, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	raw, _ := io.ReadAll(resp.Body)
+	var out struct {
+		AccessToken      string `json:"access_token"`
+		ExpiresIn        int    `json:"expires_in"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return "", fmt.Errorf("teams: decode token: %w", err)
+	}
+	if out.AccessToken == "" {
+		return "", fmt.Errorf("teams: token status=%d error=%s", resp.StatusCode, out.Error)
+	}
+	c.token = out.AccessToken
+	c.exp = time.Now().Add(time.Duration(out.ExpiresIn) * time.Second)
+	return c.token, nil
+}
+
+func (c *Client) doJSON(ctx context.Context, method, u string, in, out interface{}) error {
+	tok, err := c.Token(ctx)
+	if err != nil {
+		return err
+	}
+	body, _ := json.Marshal(in)
+	req, _ := http.NewRequestWithContext(ctx, method, u, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+tok)
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	raw, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("teams: %s %s status=%d body=%s", method, u, resp.StatusCode, truncateBody(raw))
+	}
+	if out != nil && len(raw) > 0 {
+		if err := json.Unmarshal(raw, out); err != nil {
+			return fmt.Errorf("teams: decode response: %w", err)
+		}
+	}
+	return nil
+}
+
+// CreateConversation 与用户建立 1:1 会话（主动消息）；userID 可为 AAD 对象ID 或 29: 开头的 Teams 用户ID
+func (c *Client) CreateConversation(ctx context.Context, serviceURL, userID, aadTenantID string) (string, error) {
+	payload := map[string]interface{}{
+		"bot":     map[string]string{"id": "28:" + c.appID},
+		"members": []map[string]string{{"id": userID}},
+		"isGroup": false,
+	}
+	if aadTenantID != "" {
+		payload["tenantId"] = aadTenantID
+		payload["channelData"] = map[string]interface{}{"tenant": map[string]string{"id": aadTenantID}}
+	}
+	var out struct {
+		ID string `json:"id"`
+	}
+	if err := c.doJSON(ctx, http.MethodPost, joinURL(serviceURL, "v3/conversations"), payload, &out); err != nil {
+		return "", err
+	}
+	if out.ID == "" {
+		return "", fmt.Errorf("teams: create conversation returned empty id")
+	}
+	return out.ID, nil
+}
+
+// SendActivity 向会话发送活动；replyToID 非空时作为对该活动的回复
+func (c *Client) SendActivity(ctx context.Context, serviceURL, conversationID, replyToID string, activity map[string]interface{}) error {
+	path := "v3/conversations/" + url.PathEscape(conversationID) + "/activities"
+	if replyToID != "" {
+		path += "/" + url.PathEscape(replyToID)
+	}
+	return c.doJSON(ctx, http.MethodPost, joinURL(serviceURL, path), activity, nil)
+}
+
+// UpdateActivity 替换机器人已发送的活动（用于卡片按钮点击后移除按钮、展示处理结果）
+func (c *Client) UpdateActivity(ctx context.Context, serviceURL, conversationID, activityID string, activity map[string]interface{}) error {
+	path := "v3/conversations/" + url.PathEscape(conversationID) + "/activities/" + url.PathEscape(activityID)
+	return c.doJSON(ctx, http.MethodPut, joinURL(serviceURL, path), activity, nil)
+}
+
+// PostWebhook 投递到 Teams Incoming Webhook / Workflows（无需 Bot 鉴权）
+func (c *Client) PostWebhook(ctx context.Context, u string, payload map[string]interface{}) error {
+	body, _ := json.Marshal(payload)
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	raw, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("teams: webhook status=%d body=%s", resp.StatusCode, truncateBody(raw))
+	}
+	return nil
+}
+
+func joinURL(base, path string) string {
+	return strings.TrimRight(base, "/") + "/" + path
+}
+
+func truncateBody(raw []byte) string {
+	s := strings.TrimSpace(string(raw))
+	if len(s) > 256 {
+		return s[:256]
+	}
+	return s
+}
@@ -0,0 +1,345 @@
+package teams
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"itsm-backend/connector"
+)
+
+// userChannelPrefix "user:<AAD 对象ID>" 表示向用户发起 1:1 主动消息
+const userChannelPrefix = "user:"
+
+// Teams Microsoft Teams 连接器
+// 发送：Bot Framework（app_id/app_password，会话ID 或 user:<AAD对象ID>）或 Incoming Webhook（https:// 开头的 channel）
+// 接收：Bot Framework 消息端点，校验 Bot Connector 签发的 JWT
+type Teams struct {
+	client      *Client
+	verifier    *verifier
+	cfg         connector.Config
+	appID       string
+	aadTenantID string
+	serviceURL  string
+	webhookURL  string
+	defaultChan string
+	appBaseURL  string
+	startedAt   time.Time
+
+	mu            sync.Mutex
+	conversations map[string]string // user:<id> -> 1:1 会话ID
+}
+
+func init() {
+	connector.MustRegister(func() connector.Connector { return &Teams{} })
+}
+
+func New() *Teams { return &Teams{} }
+
+// Compile-time assertions
+var (
+	_ connector.Connector       = (*Teams)(nil)
+	_ connector.Receiver        = (*Teams)(nil)
+	_ connector.ActionResponder = (*Teams)(nil)
+)
+
+func (t *Teams) Manifest() connector.Manifest {
+	return connector.Manifest{
+		Name:        "teams",
+		Version:     "1.0.0",
+		Title:       "Microsoft Teams",
+		Provider:    "microsoft",
+		Type:        connector.TypeIM,
+		Description: "Microsoft Teams 连接器：Bot Framework 主动消息或 Incoming Webhook 发送 Adaptive Card，机器人回调校验 JWT，卡片按钮可直接审批、认领事件或分派工单。",
+		Capabilities: []connector.Capability{
+			connector.CapSendMessage,
+			connector.CapReceiveMessage,
+			connector.CapSendCard,
+			connector.CapReplyMessage,
+			connector.CapApproveProcess,
+			connector.CapUpdateTicket,
+		},
+		Tags:                []string{"im", "teams", "microsoft", "global"},
+		Homepage:            "https://learn.microsoft.com/microsoftteams/platform/",
+		IsOfficial:          true,
+		RequiredPermissions: []string{"connector:write", "ticket:write", "approval:write"},
+	}
+}
+
+// Init 凭据：
+//   - app_id / app_password  Azure Bot 的应用ID与客户端密钥，Bot Framework 收发必需
+//   - tenant_id              单租户 Bot 的 Azure AD 租户ID（同时用于主动消息）
+//   - webhook_url            Incoming Webhook，未配置 Bot 时的发送通道
+//
+// 设置：service_url、login_url / openid_metadata_url（测试/主权云）、default_channel、app_base_url
+func (t *Teams) Init(_ context.Context, cfg connector.Config) error {
+	appID := cfg.Credentials["app_id"]
+	appPassword := cfg.Credentials["app_password"]
+	webhookURL := cfg.Credentials["webhook_url"]
+	if (appID == "" || appPassword == "") && webhookURL == "" {
+		return fmt.Errorf("teams: credentials.app_id and app_password, or webhook_url, are required")
+	}
+	loginURL, _ := cfg.Settings["login_url"].(string)
+	metadataURL, _ := cfg.Settings["openid_metadata_url"].(string)
+	t.serviceURL, _ = cfg.Settings["service_url"].(string)
+	if t.serviceURL == "" {
+		t.serviceURL = ServiceURL
+	}
+	t.aadTenantID = cfg.Credentials["tenant_id"]
+	t.client = NewClient(appID, appPassword, t.aadTenantID, loginURL)
+	t.verifier = newVerifier(metadataURL, appID)
+	t.appID = appID
+	t.webhookURL = webhookURL
+	t.defaultChan, _ = cfg.Settings["default_channel"].(string)
+	t.appBaseURL, _ = cfg.Settings["app_base_url"].(string)
+	t.conversations = make(map[string]string)
+	t.cfg = cfg
+	t.startedAt = time.Now()
+	return nil
+}
+
+// Send 实现 connector.Connector.Send
+// channel 字段：
+//   - URL 开头（https）       -> Incoming Webhook
+//   - "user:<AAD对象ID>"      -> 建立 1:1 会话后发送
+//   - 其他                    -> Bot Framework 会话ID（频道/群聊/个人）
+//
+// Metadata["service_url"] 可覆盖 Bot Connector 地址（回复入站会话时取自活动 serviceUrl）
+func (t *Teams) Send(ctx context.Context, msg *connector.Message) error {
+	if t.client == nil {
+		return fmt.Errorf("teams: connector not initialized")
+	}
+	if msg == nil {
+		return fmt.Errorf("teams: message is required")
+	}
+	channel := msg.Channel
+	if channel == "" {
+		channel = t.defaultChan
+	}
+	if channel == "" {
+		channel = t.webhookURL
+	}
+	if channel == "" {
+		return fmt.Errorf("teams: channel is required")
+	}
+	if strings.HasPrefix(channel, "https://") || strings.HasPrefix(channel, "http://") {
+		return t.client.PostWebhook(ctx, channel, map[string]interface{}{
+			"type":        "message",
+			"attachments": []map[string]interface{}{{"contentType": adaptiveCardContentType, "content": AdaptiveCard(msg, t.appBaseURL)}},
+		})
+	}
+	if t.appID == "" {
+		return fmt.Errorf("teams: app_id is required for bot messages")
+	}
+	serviceURL := t.serviceURL
+	if v, _ := msg.Metadata["service_url"].(string); v != "" {
+		serviceURL = v
+	}
+	conversationID := channel
+	if strings.HasPrefix(channel, userChannelPrefix) {
+		var err error
+		if conversationID, err = t.personalConversation(ctx, serviceURL, channel); err != nil {
+			return err
+		}
+	}
+	return t.client.SendActivity(ctx, serviceURL, conversationID, msg.ReplyTo, Activity(msg, t.appBaseURL))
+}
+
+// personalConversation 1:1 会话ID对同一用户稳定，缓存以减少 createConversation 调用
+func (t *Teams) personalConversation(ctx context.Context, serviceURL, channel string) (string, error) {
+	t.mu.Lock()
+	id, ok := t.conversations[channel]
+	t.mu.Unlock()
+	if ok {
+		return id, nil
+	}
+	id, err := t.client.CreateConversation(ctx, serviceURL, strings.TrimPrefix(channel, userChannelPrefix), t.aadTenantID)
+	if err != nil {
+		return "", err
+	}
+	t.mu.Lock()
+	t.conversations[channel] = id
+	t.mu.Unlock()
+	return id, nil
+}
+
+// RespondAction 用处理结果替换被点击的卡片（按钮随之移除）；无法定位原卡片时在会话内回复
+func (t *Teams) RespondAction(ctx context.Context, in *connector.InboundMessage, reply *connector.Message) error {
+	if t.client == nil {
+		return fmt.Errorf("teams: connector not initialized")
+	}
+	serviceURL, _ := in.Extras["service_url"].(string)
+	if serviceURL == "" {
+		serviceURL = t.serviceURL
+	}
+	activity := Activity(reply, t.appBaseURL)
+	if replyTo, _ := in.Extras["reply_to_id"].(string); replyTo != "" {
+		activity["id"] = replyTo
+		return t.client.UpdateActivity(ctx, serviceURL, in.ChatID, replyTo, activity)
+	}
+	return t.client.SendActivity(ctx, serviceURL, in.ChatID, in.MessageID, activity)
+}
+
+func (t *Teams) HealthCheck(ctx context.Context) connector.HealthStatus {
+	if t.client == nil {
+		return connector.HealthStatus{OK: false, Message: "not initialized"}
+	}
+	if t.appID == "" {
+		return connector.HealthStatus{OK: true, Message: "incoming webhook configured", CheckedAt: time.Now()}
+	}
+	tok, err := t.client.Token(ctx)
+	if err != nil {
+		return connector.HealthStatus{OK: false, Message: err.Error(), CheckedAt: time.Now()}
+	}
+	return connector.HealthStatus{
+		OK:        tok != "",
+		Message:   "bot framework token valid",
+		CheckedAt: time.Now(),
+		Extra:     map[string]interface{}{"started_at": t.startedAt, "uptime_s": int(time.Since(t.startedAt).Seconds())},
+	}
+}
+
+func (t *Teams) Close() error { return nil }
+
+func (t *Teams) CallbackInstanceID() string {
+	id, _ := t.cfg.Settings["callbackInstanceId"].(string)
+	return id
+}
+
+// VerifySignature 满足 connector.Receiver 接口
+// 校验 Authorization 中的 Bot Connector JWT，并要求令牌的 serviceurl 声明与活动一致，
+// 防止合法令牌被挪用到指向其他 serviceUrl 的伪造活动
+func (t *Teams) VerifySignature(headers map[string]string, body []byte) error {
+	if t.verifier == nil || t.appID == "" {
+		return fmt.Errorf("teams: bot app_id is not configured")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	claims, err := t.verifier.Verify(ctx, headerValue(headers, "Authorization"))
+	if err != nil {
+		return err
+	}
+	if claimed, _ := claims["serviceurl"].(string); claimed != "" {
+		var act struct {
+			ServiceURL string `json:"serviceUrl"`
+		}
+		if err := json.Unmarshal(body, &act); err != nil || !sameServiceURL(claimed, act.ServiceURL) {
+			return fmt.Errorf("teams: serviceUrl does not match token")
+		}
+	}
+	return nil
+}
+
+// activity Bot Framework 活动（精简）
+type activity struct {
+	Type       string `json:"type"`
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	ServiceURL string `json:"serviceUrl"`
+	ChannelID  string `json:"channelId"`
+	From       struct {
+		ID          string `json:"id"`
+		Name        string `json:"name"`
+		AADObjectID string `json:"aadObjectId"`
+	} `json:"from"`
+	Conversation struct {
+		ID               string `json:"id"`
+		ConversationType string `json:"conversationType"`
+		TenantID         string `json:"tenantId"`
+	} `json:"conversation"`
+	Text      string          `json:"text"`
+	ReplyToID string          `json:"replyToId"`
+	Value     json.RawMessage `json:"value"`
+}
+
+// ParseInbound 解析 Bot Framework 活动
+// 支持：message（文本）/ message + value（Action.Submit）/ invoke adaptiveCard/action（Action.Execute）
+func (t *Teams) ParseInbound(body []byte) (*connector.InboundMessage, error) {
+	var act activity
+	if err := json.Unmarshal(body, &act); err != nil {
+		return nil, fmt.Errorf("teams: parse inbound: %w", err)
+	}
+	if act.Type == "" {
+		return nil, fmt.Errorf("teams: activity type is required")
+	}
+	userID := act.From.AADObjectID
+	if userID == "" {
+		userID = act.From.ID
+	}
+	chatType := "group"
+	if act.Conversation.ConversationType == "personal" {
+		chatType = "direct"
+	}
+	msg := &connector.InboundMessage{
+		ConnectorType: connector.TypeIM,
+		ConnectorName: "teams",
+		Channel:       act.Conversation.ID,
+		UserID:        userID,
+		UserName:      act.From.Name,
+		ChatID:        act.Conversation.ID,
+		ChatType:      chatType,
+		MessageID:     act.ID,
+		Content:       strings.TrimSpace(act.Text),
+		Type:          act.Type,
+		Raw:           body,
+		ReceivedAt:    time.Now(),
+		Extras: map[string]interface{}{
+			"service_url":   act.ServiceURL,
+			"reply_to_id":   act.ReplyToID,
+			"aad_tenant_id": act.Conversation.TenantID,
+			"teams_user_id": act.From.ID,
+		},
+	}
+	if value := submitValue(act); value != "" {
+		msg.Type = connector.InboundCardAction
+		msg.Content = value
+		msg.Extras[connector.ExtraActionValue] = value
+	}
+	return msg, nil
+}
+
+// submitValue 取按钮回传的 data.value
+func submitValue(act activity) string {
+	if len(act.Value) == 0 {
+		return ""
+	}
+	switch {
+	case act.Type == "message":
+		var v struct {
+			Value string `json:"value"`
+		}
+		_ = json.Unmarshal(act.Value, &v)
+		return v.Value
+	case act.Type == "invoke" && act.Name == "adaptiveCard/action":
+		var v struct {
+			Action struct {
+				Data struct {
+					Value string `json:"value"`
+				} `json:"data"`
+			} `json:"action"`
+		}
+		_ = json.Unmarshal(act.Value, &v)
+		return v.Action.Data.Value
+	}
+	return ""
+}
+
+func sameServiceURL(a, b string) bool {
+	return strings.EqualFold(strings.TrimRight(a, "/"), strings.TrimRight(b, "/"))
+}
+
+// headerValue 兼容 http.Header 规范化前后的大小写
+func headerValue(headers map[string]string, key string) string {
+	if v, ok := headers[key]; ok {
+		return v
+	}
+	for k, v := range headers {
+		if strings.EqualFold(k, key) {
+			return v
+		}
+	}
+	return ""
+}
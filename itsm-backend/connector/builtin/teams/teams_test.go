@@ -0,0 +1,290 @@
+package teams
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"itsm-backend/connector"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// fakeBotFramework 模拟 AAD 令牌端点、Bot Connector、OpenID 元数据/JWKS 与 Incoming Webhook
+type fakeBotFramework struct {
+	srv *httptest.Server
+	key *rsa.PrivateKey
+
+	mu          sync.Mutex
+	calls       []string
+	bodies      map[string]map[string]interface{}
+	tokenCalls  int
+	jwksFetches int
+}
+
+func newFakeBotFramework(t *testing.T) *fakeBotFramework {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &fakeBotFramework{key: key, bodies: map[string]map[string]interface{}{}}
+	f.srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := io.ReadAll(r.Body)
+		var body map[string]interface{}
+		_ = json.Unmarshal(raw, &body)
+		f.mu.Lock()
+		call := r.Method + " " + r.URL.Path
+		f.calls = append(f.calls, call)
+		f.bodies[call] = body
+		f.mu.Unlock()
+		switch {
+		case r.URL.Path == "/login/botframework.com/oauth2/v2.0/token":
+			f.mu.Lock()
+			f.tokenCalls++
+			f.mu.Unlock()
+			form, _ := url.ParseQuery(string(raw))
+			if form.Get("client_secret") != "app-secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"error":"invalid_client"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"access_token":"bf-token","expires_in":3600}`))
+		case r.URL.Path == "/openid":
+			_, _ = w.Write([]byte(`{"issuer":"https://api.botframework.com","jwks_uri":"` + f.srv.URL + `/keys"}`))
+		case r.URL.Path == "/keys":
+			f.mu.Lock()
+			f.jwksFetches++
+			f.mu.Unlock()
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"keys": []map[string]string{{
+				"kty": "RSA", "kid": "k1",
+				"n": base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e": base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}}})
+		case r.URL.Path == "/webhook":
+			w.WriteHeader(http.StatusAccepted)
+		case strings.HasPrefix(r.URL.Path, "/svc/v3/conversations"):
+			if r.Header.Get("Authorization") != "Bearer bf-token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			if r.URL.Path == "/svc/v3/conversations" {
+				_, _ = w.Write([]byte(`{"id":"a:1on1-conv"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"id":"act-1"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(f.srv.Close)
+	return f
+}
+
+func (f *fakeBotFramework) has(call string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, c := range f.calls {
+		if c == call {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *fakeBotFramework) body(call string) map[string]interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.bodies[call]
+}
+
+func (f *fakeBotFramework) sign(t *testing.T, claims jwt.MapClaims, kid string) string {
+	tok := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	tok.Header["kid"] = kid
+	s, err := tok.SignedString(f.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func newTestTeams(t *testing.T, f *fakeBotFramework) *Teams {
+	tm := New()
+	err := tm.Init(context.Background(), connector.Config{
+		TenantID: 1, Name: "teams", Provider: "microsoft", Enabled: true,
+		Credentials: map[string]string{"app_id": "bot-app", "app_password": "app-secret"},
+		Settings: map[string]interface{}{
+			"login_url": f.srv.URL + "/login", "openid_metadata_url": f.srv.URL + "/openid",
+			"service_url": f.srv.URL + "/svc/", "app_base_url": "https://itsm.example.com",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tm
+}
+
+func TestSend_ProactiveAdaptiveCard(t *testing.T) {
+	f := newFakeBotFramework(t)
+	tm := newTestTeams(t, f)
+
+	msg := &connector.Message{
+		Channel: "user:aad-123",
+		Card: &connector.Card{
+			Header: &connector.CardHeader{Title: "审批请求 TK-1", Color: "red"},
+			Elements: []connector.CardElement{
+				{Type: "markdown", Text: "**数据库扩容**", Fields: []connector.KV{{Key: "申请人", Value: "张三"}}},
+				{Type: "button", Action: &connector.Action{Type: "primary", Text: "同意", Value: "approval:approve:9"}},
+				{Type: "button", Action: &connector.Action{Type: "danger", Text: "拒绝", Value: "approval:reject:9"}},
+			},
+		},
+		Actions: []connector.Action{{Type: "link", Text: "打开", URL: "/tickets/1"}},
+	}
+	if err := tm.Send(context.Background(), msg); err != nil {
+		t.Fatal(err)
+	}
+	if err := tm.Send(context.Background(), msg); err != nil {
+		t.Fatal(err)
+	}
+	create := f.body("POST /svc/v3/conversations")
+	if create == nil || !strings.Contains(mustJSON(create["members"]), "aad-123") || mustJSON(create["bot"]) != `{"id":"28:bot-app"}` {
+		t.Fatalf("unexpected createConversation: %+v", create)
+	}
+	posted := mustJSON(f.body("POST /svc/v3/conversations/a:1on1-conv/activities"))
+	for _, want := range []string{
+		`"contentType":"application/vnd.microsoft.card.adaptive"`, `"color":"Attention"`, `"type":"FactSet"`,
+		`"type":"ActionSet"`, `"style":"positive"`, `"data":{"value":"approval:approve:9"}`, `"style":"destructive"`,
+		`"type":"Action.OpenUrl"`, `"url":"https://itsm.example.com/tickets/1"`,
+	} {
+		if !strings.Contains(posted, want) {
+			t.Fatalf("activity missing %s: %s", want, posted)
+		}
+	}
+	f.mu.Lock()
+	creates, tokens := 0, f.tokenCalls
+	for _, c := range f.calls {
+		if c == "POST /svc/v3/conversations" {
+			creates++
+		}
+	}
+	f.mu.Unlock()
+	if creates != 1 || tokens != 1 {
+		t.Fatalf("conversation id and token should be cached: creates=%d tokens=%d", creates, tokens)
+	}
+
+	if err := tm.Send(context.Background(), &connector.Message{Channel: f.srv.URL + "/webhook", Title: "P1", Content: "宕机"}); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(mustJSON(f.body("POST /webhook")), `"type":"AdaptiveCard"`) {
+		t.Fatalf("webhook should carry an adaptive card: %+v", f.body("POST /webhook"))
+	}
+}
+
+func TestRespondActionUpdatesOriginalCard(t *testing.T) {
+	f := newFakeBotFramework(t)
+	tm := newTestTeams(t, f)
+	in := &connector.InboundMessage{ChatID: "19:chan", MessageID: "act-9", Extras: map[string]interface{}{
+		"service_url": f.srv.URL + "/svc", "reply_to_id": "card-1",
+	}}
+	if err := tm.RespondAction(context.Background(), in, &connector.Message{Content: "已审批通过"}); err != nil {
+		t.Fatal(err)
+	}
+	if !f.has("PUT /svc/v3/conversations/19:chan/activities/card-1") {
+		t.Fatalf("expected card update, calls=%v", f.calls)
+	}
+	in.Extras["reply_to_id"] = ""
+	if err := tm.RespondAction(context.Background(), in, &connector.Message{Content: "已认领"}); err != nil {
+		t.Fatal(err)
+	}
+	if !f.has("POST /svc/v3/conversations/19:chan/activities/act-9") {
+		t.Fatalf("expected reply, calls=%v", f.calls)
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	f := newFakeBotFramework(t)
+	tm := newTestTeams(t, f)
+	body := []byte(`{"type":"message","serviceUrl":"` + f.srv.URL + `/svc/"}`)
+	valid := jwt.MapClaims{
+		"iss": TokenIssuer, "aud": "bot-app", "serviceurl": f.srv.URL + "/svc",
+		"exp": time.Now().Add(time.Hour).Unix(), "nbf": time.Now().Add(-time.Minute).Unix(),
+	}
+	if err := tm.VerifySignature(map[string]string{"Authorization": "Bearer " + f.sign(t, valid, "k1")}, body); err != nil {
+		t.Fatalf("valid token rejected: %v", err)
+	}
+
+	cases := map[string]jwt.MapClaims{
+		"wrong audience": {"iss": TokenIssuer, "aud": "other-bot", "exp": time.Now().Add(time.Hour).Unix()},
+		"wrong issuer":   {"iss": "https://evil", "aud": "bot-app", "exp": time.Now().Add(time.Hour).Unix()},
+		"expired":        {"iss": TokenIssuer, "aud": "bot-app", "exp": time.Now().Add(-time.Hour).Unix()},
+		"service url":    {"iss": TokenIssuer, "aud": "bot-app", "exp": time.Now().Add(time.Hour).Unix(), "serviceurl": "https://evil"},
+	}
+	for name, claims := range cases {
+		if err := tm.VerifySignature(map[string]string{"authorization": "Bearer " + f.sign(t, claims, "k1")}, body); err == nil {
+			t.Fatalf("%s: expected rejection", name)
+		}
+	}
+	if err := tm.VerifySignature(map[string]string{"Authorization": "Bearer " + f.sign(t, valid, "unknown")}, body); err == nil {
+		t.Fatal("unknown kid should be rejected")
+	}
+	if err := tm.VerifySignature(map[string]string{}, body); err == nil {
+		t.Fatal("missing token should be rejected")
+	}
+	// 未知 kid 触发的刷新受限频保护
+	f.mu.Lock()
+	fetches := f.jwksFetches
+	f.mu.Unlock()
+	if fetches != 1 {
+		t.Fatalf("jwks should be fetched once, got %d", fetches)
+	}
+}
+
+func TestParseInbound(t *testing.T) {
+	tm := New()
+	msg, err := tm.ParseInbound([]byte(`{"type":"message","id":"act-2","serviceUrl":"https://smba/","channelId":"msteams",
+		"from":{"id":"29:abc","name":"Alice","aadObjectId":"aad-123"},
+		"conversation":{"id":"a:conv","conversationType":"personal","tenantId":"tid"},
+		"replyToId":"card-1","value":{"value":"ticket:assign_me:5"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.Type != connector.InboundCardAction || msg.UserID != "aad-123" || msg.ChatID != "a:conv" || msg.ChatType != "direct" {
+		t.Fatalf("submit: %+v", msg)
+	}
+	if msg.Extras[connector.ExtraActionValue] != "ticket:assign_me:5" || msg.Extras["reply_to_id"] != "card-1" || msg.Extras["service_url"] != "https://smba/" {
+		t.Fatalf("submit extras: %+v", msg.Extras)
+	}
+
+	msg, err = tm.ParseInbound([]byte(`{"type":"invoke","name":"adaptiveCard/action","id":"act-3",
+		"from":{"id":"29:abc"},"conversation":{"id":"19:chan","conversationType":"channel"},
+		"value":{"action":{"type":"Action.Execute","data":{"value":"incident:ack:7"}}}}`))
+	if err != nil || msg.Type != connector.InboundCardAction || msg.Content != "incident:ack:7" || msg.UserID != "29:abc" {
+		t.Fatalf("execute: %+v %v", msg, err)
+	}
+
+	msg, err = tm.ParseInbound([]byte(`{"type":"message","id":"act-4","text":" hello ","from":{"id":"29:abc"},"conversation":{"id":"19:chan"}}`))
+	if err != nil || msg.Type != "message" || msg.Content != "hello" {
+		t.Fatalf("text: %+v %v", msg, err)
+	}
+	if _, err := tm.ParseInbound([]byte(`{}`)); err == nil {
+		t.Fatal("activity without type should fail")
+	}
+}
+
+func mustJSON(v interface{}) string {
+	var buf strings.Builder
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(v)
+	return strings.TrimSpace(buf.String())
+}
@@ -0,0 +1,87 @@
+package wecom
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"itsm-backend/connector"
+)
+
+// chatChannelPrefix Message.Channel 以此前缀开头时发往应用群聊
+const chatChannelPrefix = "chat:"
+
+// CreateChat 创建应用群聊（/cgi-bin/appchat/create）。企业微信要求群成员至少 2 人
+func (c *Client) CreateChat(ctx context.Context, spec connector.ChatSpec) (*connector.Chat, error) {
+	if strings.TrimSpace(spec.Name) == "" {
+		return nil, fmt.Errorf("wecom: chat name is required")
+	}
+	members := dedupe(append([]string{spec.OwnerID}, spec.MemberIDs...))
+	if len(members) < 2 {
+		return nil, fmt.Errorf("wecom: app chat requires at least 2 members")
+	}
+	tok, err := c.Token(ctx)
+	if err != nil {
+		return nil, err
+	}
+	payload := map[string]interface{}{
+		"name":     spec.Name,
+		"userlist": members,
+	}
+	if spec.OwnerID != "" {
+		payload["owner"] = spec.OwnerID
+	}
+	var out struct {
+		ErrCode int    `json:"errcode"`
+		ErrMsg  string `json:"errmsg"`
+		ChatID  string `json:"chatid"`
+	}
+	if err := c.doJSON(ctx, "POST", "/cgi-bin/appchat/create", map[string]string{"access_token": tok}, payload, &out); err != nil {
+		return nil, err
+	}
+	if out.ErrCode != 0 {
+		return nil, fmt.Errorf("wecom: create chat failed code=%d msg=%s", out.ErrCode, out.ErrMsg)
+	}
+	return &connector.Chat{ID: out.ChatID, Name: spec.Name, Channel: chatChannelPrefix + out.ChatID}, nil
+}
+
+func (c *Client) sendChat(ctx context.Context, msg *connector.Message) error {
+	tok, err := c.Token(ctx)
+	if err != nil {
+		return err
+	}
+	payload := map[string]interface{}{
+		"chatid":  strings.TrimPrefix(msg.Channel, chatChannelPrefix),
+		"msgtype": "text",
+		"text":    map[string]interface{}{"content": msg.Content},
+	}
+	if msg.Type == "markdown" {
+		payload["msgtype"] = "markdown"
+		payload["markdown"] = map[string]interface{}{"content": buildMarkdown(msg)}
+		delete(payload, "text")
+	}
+	var out struct {
+		ErrCode int    `json:"errcode"`
+		ErrMsg  string `json:"errmsg"`
+	}
+	if err := c.doJSON(ctx, "POST", "/cgi-bin/appchat/send", map[string]string{"access_token": tok}, payload, &out); err != nil {
+		return err
+	}
+	if out.ErrCode != 0 {
+		return fmt.Errorf("wecom: chat send failed code=%d msg=%s", out.ErrCode, out.ErrMsg)
+	}
+	return nil
+}
+
+func dedupe(ids []string) []string {
+	seen := make(map[string]bool, len(ids))
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		out = append(out, id)
+	}
+	return out
+}
@@ -31,6 +31,7 @@ func (w *WeCom) Manifest() connector.Manifest {
 		Capabilities: []connector.Capability{
 			connector.CapSendMessage,
 			connector.CapSendCard,
+			connector.CapCreateChat,
 		},
 		Tags:                []string{"im", "wecom", "wechat", "china"},
 		Homepage:            "https://developer.work.weixin.qq.com",
@@ -59,6 +60,14 @@ func (w *WeCom) Send(ctx context.Context, msg *connector.Message) error {
 	return w.client.Send(ctx, msg)
 }
 
+// CreateChat 实现 connector.ChatCreator
+func (w *WeCom) CreateChat(ctx context.Context, spec connector.ChatSpec) (*connector.Chat, error) {
+	if w.client == nil {
+		return nil, fmt.Errorf("wecom: connector not initialized")
+	}
+	return w.client.CreateChat(ctx, spec)
+}
+
 func (w *WeCom) HealthCheck(ctx context.Context) connector.HealthStatus {
 	if w.client == nil {
 		return connector.HealthStatus{OK: false, Message: "not initialized"}
@@ -77,4 +86,4 @@ func (w *WeCom) HealthCheck(ctx context.Context) connector.HealthStatus {
 
 func (w *WeCom) Close() error { return nil }
 
-var _ connector.Connector = (*WeCom)(nil)
+var _ connector.ChatCreator = (*WeCom)(nil)
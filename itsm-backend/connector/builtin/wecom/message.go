@@ -17,6 +17,7 @@ import (
 //   - "PartyID"                         -> 指定部门
 //   - "TagID"                           -> 指定标签
 //   - URL 开头（http/https）            -> 群机器人 webhook
+//   - "chat:CHATID"                     -> 应用群聊（见 CreateChat）
 func (c *Client) Send(ctx context.Context, msg *connector.Message) error {
 	if msg == nil || msg.Channel == "" {
 		return fmt.Errorf("wecom: channel is required")
@@ -24,6 +25,9 @@ func (c *Client) Send(ctx context.Context, msg *connector.Message) error {
 	if strings.HasPrefix(msg.Channel, "http://") || strings.HasPrefix(msg.Channel, "https://") {
 		return c.sendRobot(ctx, msg)
 	}
+	if strings.HasPrefix(msg.Channel, chatChannelPrefix) {
+		return c.sendChat(ctx, msg)
+	}
 	return c.sendApp(ctx, msg)
 }
 
@@ -51,6 +51,8 @@ const (
 	CapApproveProcess   Capability = "approve_process"   // 审批流程
 	CapSyncOrganization Capability = "sync_organization" // 同步组织架构
 	CapAutoDiscoverCI   Capability = "auto_discover_ci"  // 自动发现CI配置项
+	CapCreateChat       Capability = "create_chat"       // 创建群聊
+	CapReceiveAlert     Capability = "receive_alert"     // 接收监控告警
 
 )
 
@@ -256,5 +258,38 @@ type Receiver interface {
 	ParseInbound(body []byte) (*InboundMessage, error)
 }
 
+// InboundCardAction 卡片按钮回调的入站消息类型；按钮的 Action.Value 放在 Extras[ExtraActionValue]
+const (
+	InboundCardAction = "card_action"
+	ExtraActionValue  = "action_value"
+)
+
+// ActionResponder 可选：支持就地回复卡片按钮回调的连接器（Slack response_url / Teams 会话回复）
+type ActionResponder interface {
+	Connector
+	RespondAction(ctx context.Context, in *InboundMessage, reply *Message) error
+}
+
+// ChatSpec 创建群聊的参数；成员 ID 为 IM 平台内的用户标识（open_id / userid）
+type ChatSpec struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	OwnerID     string   `json:"owner_id,omitempty"`
+	MemberIDs   []string `json:"member_ids,omitempty"`
+}
+
+// Chat 已创建的群聊。Channel 可直接作为 Message.Channel 向该群发消息
+type Chat struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Channel string `json:"channel"`
+}
+
+// ChatCreator 可选：支持创建群聊的 IM 连接器（重大事件作战室）
+type ChatCreator interface {
+	Connector
+	CreateChat(ctx context.Context, spec ChatSpec) (*Chat, error)
+}
+
 // ErrNotSupported 连接器不支持的能力
 var ErrNotSupported = errors.New("connector: capability not supported")
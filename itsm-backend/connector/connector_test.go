@@ -3,8 +3,10 @@ package connector
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync/atomic"
 	"testing"
+	"time"
 )
 
 // fakeConnector 测试用连接器
@@ -137,3 +139,107 @@ func TestRouter_Dedup(t *testing.T) {
 		t.Fatalf("expected handler called once due to dedup, got %d", n)
 	}
 }
+
+func TestRouter_SharedDedupStoreAcrossReplicas(t *testing.T) {
+	store := NewMemoryDedupStore()
+	var n int32
+	handler := func(_ context.Context, _ *InboundMessage) error {
+		atomic.AddInt32(&n, 1)
+		return nil
+	}
+	replicas := []*Router{NewRouter(nil), NewRouter(nil)}
+	for _, r := range replicas {
+		r.SetDedupStore(store)
+		r.Register(handler)
+	}
+	msg := &InboundMessage{ConnectorName: "feishu", MessageID: "m1", Channel: "c1"}
+	for _, r := range replicas {
+		_ = r.Dispatch(context.Background(), msg)
+	}
+	if atomic.LoadInt32(&n) != 1 {
+		t.Fatalf("expected handler called once across replicas, got %d", n)
+	}
+}
+
+func TestMemoryDedupStore_ExpiresLazily(t *testing.T) {
+	store := NewMemoryDedupStore()
+	now := time.Unix(1_700_000_000, 0)
+	store.now = func() time.Time { return now }
+	ctx := context.Background()
+	if ok, _ := store.Claim(ctx, "k1", time.Minute); !ok {
+		t.Fatal("expected first claim to succeed")
+	}
+	if ok, _ := store.Claim(ctx, "k1", time.Minute); ok {
+		t.Fatal("expected claim within ttl to be deduplicated")
+	}
+	now = now.Add(time.Minute)
+	if ok, _ := store.Claim(ctx, "k1", time.Minute); !ok {
+		t.Fatal("expected claim after ttl to succeed")
+	}
+
+	now = now.Add(2 * time.Minute)
+	for i := 0; i < memoryDedupSweepEvery; i++ {
+		_, _ = store.Claim(ctx, fmt.Sprintf("sweep-%d", i), time.Second)
+	}
+	if _, exists := store.expires["k1"]; exists {
+		t.Fatal("expected expired key to be swept")
+	}
+}
+
+type failingDedupStore struct{}
+
+func (failingDedupStore) Claim(context.Context, string, time.Duration) (bool, error) {
+	return false, errors.New("store down")
+}
+
+func TestRouter_DedupFallsBackToLocal(t *testing.T) {
+	r := NewRouter(nil)
+	r.SetDedupStore(failingDedupStore{})
+	if !r.Claim(context.Background(), "k1") {
+		t.Fatal("expected first claim to succeed via local fallback")
+	}
+	if r.Claim(context.Background(), "k1") {
+		t.Fatal("expected second claim to be deduplicated locally")
+	}
+}
+
+type fakeRecorder struct {
+	handlers []string
+	results  map[string]error
+}
+
+func (f *fakeRecorder) RecordInbound(_ context.Context, _ string, _ *InboundMessage, handlers []string) (int, error) {
+	f.handlers = handlers
+	return 1, nil
+}
+
+func (f *fakeRecorder) RecordResults(_ context.Context, _ int, results map[string]error) {
+	f.results = results
+}
+
+func TestRouter_HandlerFailureDoesNotStopOthers(t *testing.T) {
+	r := NewRouter(nil)
+	rec := &fakeRecorder{}
+	r.SetRecorder(rec)
+	var second int32
+	r.RegisterNamed("first", func(_ context.Context, _ *InboundMessage) error { return errors.New("boom") })
+	r.RegisterNamed("second", func(_ context.Context, _ *InboundMessage) error {
+		atomic.AddInt32(&second, 1)
+		return nil
+	})
+	_ = r.Dispatch(context.Background(), &InboundMessage{MessageID: "m1"})
+	if atomic.LoadInt32(&second) != 1 {
+		t.Fatal("expected second handler to run after first failed")
+	}
+	if len(rec.handlers) != 2 || rec.results["first"] == nil || rec.results["second"] != nil {
+		t.Fatalf("unexpected recorded results: %v %v", rec.handlers, rec.results)
+	}
+
+	results, err := r.Replay(context.Background(), &InboundMessage{MessageID: "m1"}, "second")
+	if err != nil || len(results) != 1 || atomic.LoadInt32(&second) != 2 {
+		t.Fatalf("expected replay of second handler only, got %v %v", results, err)
+	}
+	if _, err := r.Replay(context.Background(), &InboundMessage{}, "missing"); !errors.Is(err, ErrUnknownInboundHandler) {
+		t.Fatalf("expected ErrUnknownInboundHandler, got %v", err)
+	}
+}
@@ -0,0 +1,69 @@
+package connector
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// DedupStore 入站消息去重存储
+// Claim 在 ttl 内首次占用 key 时返回 true，其余调用返回 false；多副本部署时须使用共享存储（Redis / 数据库）
+type DedupStore interface {
+	Claim(ctx context.Context, key string, ttl time.Duration) (bool, error)
+}
+
+// memoryDedupSweepEvery 每占用这么多次做一次全量过期清理，避免每条入站消息都遍历整个表
+const memoryDedupSweepEvery = 1024
+
+// MemoryDedupStore 进程内去重，仅适用于单副本部署，也是共享存储不可用时的兜底
+type MemoryDedupStore struct {
+	mu      sync.Mutex
+	expires map[string]time.Time
+	claims  int
+	now     func() time.Time
+}
+
+// NewMemoryDedupStore 创建进程内去重存储
+func NewMemoryDedupStore() *MemoryDedupStore {
+	return &MemoryDedupStore{expires: make(map[string]time.Time), now: time.Now}
+}
+
+// Claim 实现 DedupStore。命中的 key 已过期时视为未占用；其余过期 key 每 memoryDedupSweepEvery 次占用清理一次
+func (s *MemoryDedupStore) Claim(_ context.Context, key string, ttl time.Duration) (bool, error) {
+	now := s.now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.claims++; s.claims >= memoryDedupSweepEvery {
+		s.claims = 0
+		for k, exp := range s.expires {
+			if !now.Before(exp) {
+				delete(s.expires, k)
+			}
+		}
+	}
+	if exp, exists := s.expires[key]; exists && now.Before(exp) {
+		return false, nil
+	}
+	s.expires[key] = now.Add(ttl)
+	return true, nil
+}
+
+// redisDedupPrefix Redis 去重 key 前缀
+const redisDedupPrefix = "connector:inbound:dedup:"
+
+// RedisDedupStore 基于 Redis SET NX 的集群级去重
+type RedisDedupStore struct {
+	client *redis.Client
+}
+
+// NewRedisDedupStore 创建 Redis 去重存储
+func NewRedisDedupStore(client *redis.Client) *RedisDedupStore {
+	return &RedisDedupStore{client: client}
+}
+
+// Claim 实现 DedupStore：SET key NX EX ttl，写入成功即首次占用
+func (s *RedisDedupStore) Claim(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	return s.client.SetNX(ctx, redisDedupPrefix+key, time.Now().Unix(), ttl).Result()
+}
@@ -121,6 +121,25 @@ func (m *Manager) ListByTenant(tenantID int) []Config {
 	return out
 }
 
+// Provisioned 一个运行中的连接器实例及其配置
+type Provisioned struct {
+	Config    Config
+	Connector Connector
+}
+
+// Instances 列出所有租户下指定名称的运行中实例（用于邮箱轮询等按实例执行的后台任务）
+func (m *Manager) Instances(name string) []Provisioned {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	out := make([]Provisioned, 0)
+	for _, inst := range m.instances {
+		if inst.cfg.Name == name && inst.cfg.Enabled {
+			out = append(out, Provisioned{Config: inst.cfg, Connector: inst.conn})
+		}
+	}
+	return out
+}
+
 // Send 通过指定连接器发送消息
 func (m *Manager) Send(ctx context.Context, tenantID int, name string, msg *Message) error {
 	c, ok := m.Get(tenantID, name)
@@ -130,6 +149,19 @@ func (m *Manager) Send(ctx context.Context, tenantID int, name string, msg *Mess
 	return c.Send(ctx, msg)
 }
 
+// CreateChat 通过指定连接器创建群聊；连接器未实现 ChatCreator 时返回 ErrNotSupported
+func (m *Manager) CreateChat(ctx context.Context, tenantID int, name string, spec ChatSpec) (*Chat, error) {
+	c, ok := m.Get(tenantID, name)
+	if !ok {
+		return nil, fmt.Errorf("connector %q not provisioned for tenant %d", name, tenantID)
+	}
+	cc, ok := c.(ChatCreator)
+	if !ok {
+		return nil, ErrNotSupported
+	}
+	return cc.CreateChat(ctx, spec)
+}
+
 // HealthCheckAll 对所有运行中的连接器做健康检查
 func (m *Manager) HealthCheckAll(ctx context.Context) map[string]HealthStatus {
 	m.mu.RLock()
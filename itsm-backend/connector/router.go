@@ -3,17 +3,55 @@ package connector
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"sync"
 	"time"
 
+	"itsm-backend/common/tenantctx"
+
 	"go.uber.org/zap"
 )
 
 // InboundHandler 入站消息处理器：连接器收到 IM 回调后调用
-// 内部以 Channel 为 key 做去重幂等（避免 IM 平台重试造成重复消息）
+// 同一消息由 Router 按 DedupKey 去重幂等（避免 IM 平台重试或多副本造成重复处理）
 type InboundHandler func(ctx context.Context, msg *InboundMessage) error
 
+// InboundRecorder 入站消息日志：持久化通过去重的消息与各处理器执行结果，供排查、重试与重放
+type InboundRecorder interface {
+	// RecordInbound 记录一条入站消息，返回日志ID；handlers 为本次将要执行的处理器名
+	RecordInbound(ctx context.Context, dedupKey string, msg *InboundMessage, handlers []string) (int, error)
+	// RecordResults 回写各处理器的执行结果，nil 表示成功
+	RecordResults(ctx context.Context, logID int, results map[string]error)
+}
+
+// ErrUnknownInboundHandler 重放时指定了未注册的处理器
+var ErrUnknownInboundHandler = errors.New("unknown inbound handler")
+
+// ErrPermanent 不可重试的处理器错误标记，以 errors.Is 判断；由 Permanent 包装
+var ErrPermanent = errors.New("permanent inbound handler error")
+
+// permanentError 保留原错误文案与错误链，仅追加 ErrPermanent 标记
+type permanentError struct{ err error }
+
+func (e *permanentError) Error() string        { return e.err.Error() }
+func (e *permanentError) Unwrap() error        { return e.err }
+func (e *permanentError) Is(target error) bool { return target == ErrPermanent }
+
+// Permanent 标记处理器错误不可重试（如负载非法、业务拒绝且已答复用户）：入站日志记为失败，但不再自动重试
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+type namedHandler struct {
+	name string
+	fn   InboundHandler
+}
+
 // Router 负责把"入站消息"分发到"业务处理器"
 // 典型用法：
 //  1. 飞书事件回调 -> 入站消息 -> 创建/查询工单 -> 回发卡片
@@ -22,52 +60,173 @@ type Router struct {
 	logger *zap.SugaredLogger
 
 	mu       sync.RWMutex
-	handlers []InboundHandler
-	seen     sync.Map // key = messageID + channel -> time.Time
+	handlers []namedHandler
+	store    DedupStore
+	recorder InboundRecorder
+	// local 共享去重存储出错时的兜底，保证单副本内仍然幂等
+	local    *MemoryDedupStore
 	dedupTTL time.Duration
 }
 
 func NewRouter(logger *zap.SugaredLogger) *Router {
-	return &Router{logger: logger, dedupTTL: 5 * time.Minute}
+	local := NewMemoryDedupStore()
+	return &Router{logger: logger, store: local, local: local, dedupTTL: 5 * time.Minute}
+}
+
+// SetDedupStore 替换去重存储（多副本部署时注入 Redis / 数据库实现）
+func (r *Router) SetDedupStore(store DedupStore) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if store == nil {
+		store = r.local
+	}
+	r.store = store
+}
+
+// SetDedupTTL 设置去重窗口，应覆盖 IM 平台的最长重试间隔
+func (r *Router) SetDedupTTL(ttl time.Duration) {
+	if ttl > 0 {
+		r.mu.Lock()
+		r.dedupTTL = ttl
+		r.mu.Unlock()
+	}
+}
+
+// SetRecorder 注入入站消息日志
+func (r *Router) SetRecorder(recorder InboundRecorder) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.recorder = recorder
 }
 
-// Register 注册处理器（按注册顺序串行调用，第一个 error 即返回）
+// Register 注册匿名处理器，按注册序号命名为 handler_<序号>
 func (r *Router) Register(h InboundHandler) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
-	r.handlers = append(r.handlers, h)
+	r.handlers = append(r.handlers, namedHandler{name: fmt.Sprintf("handler_%d", len(r.handlers)), fn: h})
+}
+
+// RegisterNamed 注册具名处理器；名称写入入站日志，重试与重放按名称定位处理器，部署间应保持稳定
+func (r *Router) RegisterNamed(name string, h InboundHandler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.handlers = append(r.handlers, namedHandler{name: name, fn: h})
+}
+
+// Handlers 返回已注册的处理器名（按注册顺序）
+func (r *Router) Handlers() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := make([]string, 0, len(r.handlers))
+	for _, h := range r.handlers {
+		names = append(names, h.name)
+	}
+	return names
+}
+
+// DedupKey 入站消息的去重 key：连接器 + 租户 + 消息ID + 渠道；无消息ID的消息不去重，返回空串
+func DedupKey(ctx context.Context, msg *InboundMessage) string {
+	if msg == nil || msg.MessageID == "" {
+		return ""
+	}
+	tenantID, _ := tenantctx.TenantID(ctx)
+	return fmt.Sprintf("%s:%d:%s@%s", msg.ConnectorName, tenantID, msg.MessageID, msg.Channel)
+}
+
+// Claim 在去重窗口内首次占用 key 时返回 true；共享存储出错时退回进程内去重（宁可跨副本重复，不丢消息）
+func (r *Router) Claim(ctx context.Context, key string) bool {
+	if key == "" {
+		return true
+	}
+	r.mu.RLock()
+	store, ttl := r.store, r.dedupTTL
+	r.mu.RUnlock()
+	claimed, err := store.Claim(ctx, key, ttl)
+	if err != nil {
+		if r.logger != nil {
+			r.logger.Warnw("inbound dedup store failed, falling back to local dedup", "key", key, "err", err)
+		}
+		claimed, _ = r.local.Claim(ctx, key, ttl)
+	}
+	return claimed
 }
 
-// Dispatch 派发入站消息
+// Dispatch 派发入站消息：去重 → 记录日志 → 串行调用全部处理器 → 回写各处理器结果
+// 处理器失败不影响后续处理器，失败的重试由 InboundRecorder 负责
 func (r *Router) Dispatch(ctx context.Context, msg *InboundMessage) error {
 	if msg == nil {
 		return nil
 	}
-	// 幂等去重
-	key := msg.MessageID + "@" + msg.Channel
-	if msg.MessageID != "" {
-		if v, ok := r.seen.Load(key); ok {
-			if t, ok := v.(time.Time); ok && time.Since(t) < r.dedupTTL {
-				if r.logger != nil {
-					r.logger.Debugw("duplicate inbound message skipped", "key", key)
-				}
-				return nil
-			}
+	key := DedupKey(ctx, msg)
+	if !r.Claim(ctx, key) {
+		if r.logger != nil {
+			r.logger.Debugw("duplicate inbound message skipped", "key", key)
 		}
-		r.seen.Store(key, time.Now())
+		return nil
 	}
 	r.mu.RLock()
-	handlers := append([]InboundHandler(nil), r.handlers...)
+	handlers := append([]namedHandler(nil), r.handlers...)
+	recorder := r.recorder
 	r.mu.RUnlock()
-	for i, h := range handlers {
-		if err := h(ctx, msg); err != nil && r.logger != nil {
-			r.logger.Errorw("inbound handler failed",
-				"idx", i, "err", err, "channel", msg.Channel, "user", msg.UserID)
+
+	logID := 0
+	if recorder != nil {
+		names := make([]string, 0, len(handlers))
+		for _, h := range handlers {
+			names = append(names, h.name)
 		}
+		id, err := recorder.RecordInbound(ctx, key, msg, names)
+		if err != nil && r.logger != nil {
+			r.logger.Warnw("failed to record inbound message", "key", key, "err", err)
+		}
+		logID = id
+	}
+	results := r.run(ctx, msg, handlers)
+	if recorder != nil && logID > 0 {
+		recorder.RecordResults(ctx, logID, results)
 	}
 	return nil
 }
 
+// Replay 跳过去重与日志，重新执行指定处理器（为空时执行全部），返回各处理器结果
+// 供入站日志的失败重试与管理端重放使用，调用方负责回写结果
+func (r *Router) Replay(ctx context.Context, msg *InboundMessage, names ...string) (map[string]error, error) {
+	r.mu.RLock()
+	all := append([]namedHandler(nil), r.handlers...)
+	r.mu.RUnlock()
+	handlers := all
+	if len(names) > 0 {
+		handlers = make([]namedHandler, 0, len(names))
+		for _, name := range names {
+			found := false
+			for _, h := range all {
+				if h.name == name {
+					handlers = append(handlers, h)
+					found = true
+					break
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("%w: %s", ErrUnknownInboundHandler, name)
+			}
+		}
+	}
+	return r.run(ctx, msg, handlers), nil
+}
+
+func (r *Router) run(ctx context.Context, msg *InboundMessage, handlers []namedHandler) map[string]error {
+	results := make(map[string]error, len(handlers))
+	for _, h := range handlers {
+		err := h.fn(ctx, msg)
+		results[h.name] = err
+		if err != nil && r.logger != nil {
+			r.logger.Errorw("inbound handler failed",
+				"handler", h.name, "err", err, "channel", msg.Channel, "user", msg.UserID)
+		}
+	}
+	return results
+}
+
 // HTTPIngress 一个开箱即用的"入站网关"辅助函数：把 HTTP POST 解析为 InboundMessage 后 Dispatch
 // 业务方可自行校验签名/解析负载后再调用 Dispatch
 func (r *Router) HTTPIngress(maxBytes int64) http.HandlerFunc {
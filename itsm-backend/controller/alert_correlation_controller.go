@@ -0,0 +1,194 @@
+package controller
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"itsm-backend/common"
+	"itsm-backend/dto"
+	"itsm-backend/middleware"
+	"itsm-backend/service"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// AlertCorrelationController 告警关联降噪：屏蔽规则管理与降噪统计
+type AlertCorrelationController struct {
+	service *service.AlertCorrelationService
+	logger  *zap.SugaredLogger
+}
+
+// NewAlertCorrelationController 创建告警关联降噪控制器
+func NewAlertCorrelationController(correlationService *service.AlertCorrelationService, logger *zap.SugaredLogger) *AlertCorrelationController {
+	return &AlertCorrelationController{service: correlationService, logger: logger}
+}
+
+// RegisterRoutes 注册租户内路由
+func (c *AlertCorrelationController) RegisterRoutes(r *gin.RouterGroup) {
+	monitoring := r.Group("/monitoring")
+	{
+		monitoring.GET("/noise-report", middleware.RequirePermission("incident", "read"), c.NoiseReport)
+		monitoring.GET("/suppression-rules", middleware.RequirePermission("incident", "read"), c.ListSuppressionRules)
+		monitoring.POST("/suppression-rules", middleware.RequirePermission("incident", "write"), c.CreateSuppressionRule)
+		monitoring.PUT("/suppression-rules/:id", middleware.RequirePermission("incident", "write"), c.UpdateSuppressionRule)
+		monitoring.DELETE("/suppression-rules/:id", middleware.RequirePermission("incident", "write"), c.DeleteSuppressionRule)
+	}
+}
+
+// NoiseReport 按告警源统计降噪率
+// @Summary 告警降噪统计
+// @Description 降噪率 = 1 - 开启事件数 / 推送次数；按最近接收时间落在区间内的告警汇总
+// @Tags 监控告警
+// @Produce json
+// @Param from query string false "开始时间 (RFC3339)，默认 7 天前"
+// @Param to query string false "结束时间 (RFC3339)，默认当前时间"
+// @Success 200 {object} common.Response{data=dto.AlertNoiseReport}
+// @Router /api/v1/monitoring/noise-report [get]
+func (c *AlertCorrelationController) NoiseReport(ctx *gin.Context) {
+	var query dto.AlertNoiseReportQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return
+	}
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return
+	}
+	var from, to time.Time
+	if query.From != nil {
+		from = *query.From
+	}
+	if query.To != nil {
+		to = *query.To
+	}
+	report, err := c.service.NoiseReport(ctx.Request.Context(), tenantID, from, to)
+	if err != nil {
+		common.Fail(ctx, common.ParamErrorCode, err.Error())
+		return
+	}
+	common.Success(ctx, report)
+}
+
+// ListSuppressionRules 屏蔽规则列表
+// @Summary 告警屏蔽规则列表
+// @Tags 监控告警
+// @Produce json
+// @Success 200 {object} common.Response
+// @Router /api/v1/monitoring/suppression-rules [get]
+func (c *AlertCorrelationController) ListSuppressionRules(ctx *gin.Context) {
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return
+	}
+	rules, err := c.service.ListSuppressionRules(ctx.Request.Context(), tenantID)
+	if err != nil {
+		common.InternalError(ctx, "获取屏蔽规则失败: "+err.Error())
+		return
+	}
+	common.Success(ctx, rules)
+}
+
+// CreateSuppressionRule 创建屏蔽规则
+// @Summary 创建告警屏蔽规则
+// @Tags 监控告警
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateAlertSuppressionRuleRequest true "屏蔽规则"
+// @Success 200 {object} common.Response
+// @Router /api/v1/monitoring/suppression-rules [post]
+func (c *AlertCorrelationController) CreateSuppressionRule(ctx *gin.Context) {
+	var req dto.CreateAlertSuppressionRuleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return
+	}
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return
+	}
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.AuthFailedCode, "获取用户ID失败")
+		return
+	}
+	rule, err := c.service.CreateSuppressionRule(ctx.Request.Context(), tenantID, userID, &req)
+	if err != nil {
+		c.failSuppressionRule(ctx, err)
+		return
+	}
+	common.Success(ctx, rule)
+}
+
+// UpdateSuppressionRule 修改屏蔽规则
+// @Summary 修改告警屏蔽规则
+// @Tags 监控告警
+// @Accept json
+// @Produce json
+// @Param id path int true "规则ID"
+// @Param request body dto.UpdateAlertSuppressionRuleRequest true "屏蔽规则"
+// @Success 200 {object} common.Response
+// @Router /api/v1/monitoring/suppression-rules/{id} [put]
+func (c *AlertCorrelationController) UpdateSuppressionRule(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || id <= 0 {
+		common.Fail(ctx, common.ParamErrorCode, "无效的规则ID")
+		return
+	}
+	var req dto.UpdateAlertSuppressionRuleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return
+	}
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return
+	}
+	rule, err := c.service.UpdateSuppressionRule(ctx.Request.Context(), tenantID, id, &req)
+	if err != nil {
+		c.failSuppressionRule(ctx, err)
+		return
+	}
+	common.Success(ctx, rule)
+}
+
+// DeleteSuppressionRule 删除屏蔽规则
+// @Summary 删除告警屏蔽规则
+// @Tags 监控告警
+// @Produce json
+// @Param id path int true "规则ID"
+// @Success 200 {object} common.Response
+// @Router /api/v1/monitoring/suppression-rules/{id} [delete]
+func (c *AlertCorrelationController) DeleteSuppressionRule(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || id <= 0 {
+		common.Fail(ctx, common.ParamErrorCode, "无效的规则ID")
+		return
+	}
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return
+	}
+	if err := c.service.DeleteSuppressionRule(ctx.Request.Context(), tenantID, id); err != nil {
+		c.failSuppressionRule(ctx, err)
+		return
+	}
+	common.Success(ctx, nil)
+}
+
+func (c *AlertCorrelationController) failSuppressionRule(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrAlertSuppressionRuleNotFound):
+		common.Fail(ctx, common.NotFoundCode, err.Error())
+	case errors.Is(err, service.ErrAlertSuppressionRuleInvalid):
+		common.Fail(ctx, common.ParamErrorCode, err.Error())
+	default:
+		common.InternalError(ctx, err.Error())
+	}
+}
@@ -0,0 +1,115 @@
+package controller
+
+import (
+	"strconv"
+
+	"itsm-backend/common"
+	"itsm-backend/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// BPMNDecisionController DMN决策定义控制器：部署、查询与试算决策表
+type BPMNDecisionController struct {
+	deploymentService *service.BPMNDeploymentService
+	processEngine     *service.CustomProcessEngine
+}
+
+// NewBPMNDecisionController 创建DMN决策定义控制器
+func NewBPMNDecisionController(deploymentService *service.BPMNDeploymentService, processEngine *service.CustomProcessEngine) *BPMNDecisionController {
+	return &BPMNDecisionController{
+		deploymentService: deploymentService,
+		processEngine:     processEngine,
+	}
+}
+
+// RegisterRoutes 注册路由
+func (c *BPMNDecisionController) RegisterRoutes(r *gin.RouterGroup) {
+	decisions := r.Group("/bpmn/decision-definitions")
+	{
+		decisions.POST("", c.DeployDecisionDefinition)
+		decisions.GET("", c.ListDecisionDefinitions)
+		decisions.GET("/:key/versions", c.ListDecisionVersions)
+		decisions.POST("/:key/evaluate", c.EvaluateDecision)
+	}
+}
+
+// DeployDecisionDefinition 部署DMN决策（每个决策生成新版本）
+func (c *BPMNDecisionController) DeployDecisionDefinition(ctx *gin.Context) {
+	reqCtx, tenantID, ok := getBPMNTenantContext(ctx)
+	if !ok {
+		return
+	}
+	var req service.DeployDecisionDefinitionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return
+	}
+	req.TenantID = tenantID
+
+	definitions, err := c.deploymentService.DeployDecisionDefinition(reqCtx, &req)
+	if err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "部署决策失败: "+err.Error())
+		return
+	}
+	common.SuccessWithMessage(ctx, "决策部署成功", definitions)
+}
+
+// ListDecisionDefinitions 获取各决策的最新版本
+func (c *BPMNDecisionController) ListDecisionDefinitions(ctx *gin.Context) {
+	reqCtx, tenantID, ok := getBPMNTenantContext(ctx)
+	if !ok {
+		return
+	}
+	definitions, err := c.deploymentService.ListDecisionDefinitions(reqCtx, tenantID, "")
+	if err != nil {
+		common.InternalError(ctx, "获取决策定义失败: "+err.Error())
+		return
+	}
+	common.Success(ctx, definitions)
+}
+
+// ListDecisionVersions 获取决策的全部版本
+func (c *BPMNDecisionController) ListDecisionVersions(ctx *gin.Context) {
+	reqCtx, tenantID, ok := getBPMNTenantContext(ctx)
+	if !ok {
+		return
+	}
+	definitions, err := c.deploymentService.ListDecisionDefinitions(reqCtx, tenantID, ctx.Param("key"))
+	if err != nil {
+		common.InternalError(ctx, "获取决策版本失败: "+err.Error())
+		return
+	}
+	common.Success(ctx, definitions)
+}
+
+// EvaluateDecision 以请求变量试算决策，?version= 指定版本，缺省为最新版本
+func (c *BPMNDecisionController) EvaluateDecision(ctx *gin.Context) {
+	reqCtx, _, ok := getBPMNTenantContext(ctx)
+	if !ok {
+		return
+	}
+	version := 0
+	if v := ctx.Query("version"); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil || parsed <= 0 {
+			common.Fail(ctx, common.ParamErrorCode, "无效的决策版本")
+			return
+		}
+		version = parsed
+	}
+	var req struct {
+		Variables map[string]interface{} `json:"variables"`
+	}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return
+	}
+
+	result, err := c.processEngine.EvaluateDecision(reqCtx, ctx.Param("key"), version, req.Variables)
+	if err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "决策求值失败: "+err.Error())
+		return
+	}
+	common.Success(ctx, result)
+}
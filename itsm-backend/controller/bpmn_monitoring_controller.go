@@ -1,6 +1,7 @@
 package controller
 
 import (
+	"context"
 	"net/http"
 	"strconv"
 	"time"
@@ -50,6 +51,11 @@ func (c *BPMNMonitoringController) RegisterRoutes(r *gin.RouterGroup) {
 
 		// 审计日志
 		monitoring.GET("/audit-logs", c.GetAuditLogs)
+
+		// 流程事故（异步服务任务重试用尽）
+		monitoring.GET("/incidents", c.ListProcessIncidents)
+		monitoring.POST("/incidents/:incidentId/retry", c.RetryProcessIncident)
+		monitoring.POST("/incidents/:incidentId/skip", c.SkipProcessIncident)
 	}
 }
 
@@ -390,3 +396,78 @@ func (c *BPMNMonitoringController) GetAuditLogs(ctx *gin.Context) {
 		},
 	})
 }
+
+// ListProcessIncidents 获取流程事故列表
+func (c *BPMNMonitoringController) ListProcessIncidents(ctx *gin.Context) {
+	// 从JWT获取租户ID
+	tenantID, exists := ctx.Get("tenant_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "未授权访问"})
+		return
+	}
+
+	// 解析查询参数
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("page_size", "20"))
+	processInstanceID, _ := strconv.Atoi(ctx.Query("process_instance_id"))
+
+	query := &service.ListProcessIncidentsQuery{
+		TenantID:          tenantID.(int),
+		ProcessInstanceID: processInstanceID,
+		ElementID:         ctx.Query("element_id"),
+		Status:            ctx.DefaultQuery("status", "open"),
+		Page:              page,
+		PageSize:          pageSize,
+	}
+
+	incidents, total, err := c.monitoringService.ListProcessIncidents(ctx, query)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "获取流程事故失败: " + err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "获取流程事故成功",
+		"data": gin.H{
+			"incidents": incidents,
+			"total":     total,
+			"page":      page,
+			"page_size": pageSize,
+		},
+	})
+}
+
+// RetryProcessIncident 重试流程事故
+func (c *BPMNMonitoringController) RetryProcessIncident(ctx *gin.Context) {
+	c.resolveProcessIncident(ctx, c.monitoringService.RetryProcessIncident, "重试")
+}
+
+// SkipProcessIncident 跳过流程事故
+func (c *BPMNMonitoringController) SkipProcessIncident(ctx *gin.Context) {
+	c.resolveProcessIncident(ctx, c.monitoringService.SkipProcessIncident, "跳过")
+}
+
+func (c *BPMNMonitoringController) resolveProcessIncident(ctx *gin.Context, resolve func(context.Context, int, int, string) error, action string) {
+	incidentID, err := strconv.Atoi(ctx.Param("incidentId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "无效的流程事故ID"})
+		return
+	}
+
+	// 从JWT获取租户ID
+	tenantID, exists := ctx.Get("tenant_id")
+	if !exists {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "未授权访问"})
+		return
+	}
+
+	operator := strconv.Itoa(ctx.GetInt("user_id"))
+	if err := resolve(ctx, tenantID.(int), incidentID, operator); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": action + "流程事故失败: " + err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": action + "流程事故成功",
+	})
+}
@@ -3,6 +3,7 @@ package controller
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -73,6 +74,11 @@ func (c *BPMNWorkflowController) RegisterRoutes(r *gin.RouterGroup) {
 		bpmn.PUT("/process-instances/:id/suspend", c.SuspendProcess)
 		bpmn.PUT("/process-instances/:id/resume", c.ResumeProcess)
 		bpmn.PUT("/process-instances/:id/terminate", c.TerminateProcess)
+		bpmn.POST("/process-instances/migrate", c.MigrateProcessInstances)
+
+		// 消息关联与信号广播（外部系统回调唤醒等待中的流程实例）
+		bpmn.POST("/messages/correlate", c.CorrelateMessage)
+		bpmn.POST("/signals/broadcast", c.BroadcastSignal)
 
 		// 任务管理
 		bpmn.GET("/tasks", c.ListUserTasks)
@@ -606,6 +612,91 @@ func (c *BPMNWorkflowController) TerminateProcess(ctx *gin.Context) {
 	common.SuccessWithMessage(ctx, "流程实例终止成功", nil)
 }
 
+// MigrateProcessInstances 按迁移计划把运行中的实例迁移到另一个流程定义版本，dryRun 时只返回校验报告
+func (c *BPMNWorkflowController) MigrateProcessInstances(ctx *gin.Context) {
+	var req service.ProcessMigrationRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return
+	}
+	workflowCtx, _, ok := getBPMNTenantContext(ctx)
+	if !ok {
+		return
+	}
+
+	report, err := c.processEngine.MigrateProcessInstances(workflowCtx, &req)
+	switch {
+	case errors.Is(err, service.ErrInvalidMigrationPlan):
+		common.Fail(ctx, common.ParamErrorCode, "迁移计划校验失败: "+err.Error())
+		return
+	case err != nil:
+		common.InternalError(ctx, "流程实例迁移失败: "+err.Error())
+		return
+	}
+
+	if req.DryRun {
+		common.SuccessWithMessage(ctx, "迁移计划校验完成", report)
+		return
+	}
+	common.SuccessWithMessage(ctx, "流程实例迁移完成", report)
+}
+
+// CorrelateMessage 将消息关联到正在等待它的流程实例
+func (c *BPMNWorkflowController) CorrelateMessage(ctx *gin.Context) {
+	var req struct {
+		MessageName     string                 `json:"messageName" binding:"required"`
+		CorrelationKeys map[string]interface{} `json:"correlationKeys"`
+		Variables       map[string]interface{} `json:"variables"`
+	}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return
+	}
+	workflowCtx, _, ok := getBPMNTenantContext(ctx)
+	if !ok {
+		return
+	}
+
+	result, err := c.processEngine.CorrelateMessage(workflowCtx, req.MessageName, req.CorrelationKeys, req.Variables)
+	switch {
+	case errors.Is(err, service.ErrMessageNotCorrelated):
+		common.NotFound(ctx, "消息关联失败: "+err.Error())
+		return
+	case errors.Is(err, service.ErrMessageAmbiguous):
+		common.Fail(ctx, common.ConflictCode, "消息关联失败: "+err.Error())
+		return
+	case err != nil:
+		common.InternalError(ctx, "消息关联失败: "+err.Error())
+		return
+	}
+
+	common.SuccessWithMessage(ctx, "消息关联成功", result)
+}
+
+// BroadcastSignal 向租户内所有订阅该信号的流程实例广播信号
+func (c *BPMNWorkflowController) BroadcastSignal(ctx *gin.Context) {
+	var req struct {
+		SignalName string                 `json:"signalName" binding:"required"`
+		Variables  map[string]interface{} `json:"variables"`
+	}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return
+	}
+	workflowCtx, _, ok := getBPMNTenantContext(ctx)
+	if !ok {
+		return
+	}
+
+	result, err := c.processEngine.BroadcastSignal(workflowCtx, req.SignalName, req.Variables)
+	if err != nil {
+		common.InternalError(ctx, "信号广播失败: "+err.Error())
+		return
+	}
+
+	common.SuccessWithMessage(ctx, "信号广播成功", result)
+}
+
 // ListUserTasks 获取用户任务列表（默认「我的待办」语义）
 func (c *BPMNWorkflowController) ListUserTasks(ctx *gin.Context) {
 	var req service.ListUserTasksRequest
@@ -160,6 +160,15 @@ func (e *fakeProcessEngine) ResumeProcess(ctx context.Context, id string) error
 func (e *fakeProcessEngine) TerminateProcess(ctx context.Context, id, reason string) error {
 	return nil
 }
+func (e *fakeProcessEngine) CorrelateMessage(ctx context.Context, name string, keys, vars map[string]interface{}) (*service.MessageCorrelationResult, error) {
+	return nil, nil
+}
+func (e *fakeProcessEngine) BroadcastSignal(ctx context.Context, name string, vars map[string]interface{}) (*service.SignalBroadcastResult, error) {
+	return nil, nil
+}
+func (e *fakeProcessEngine) MigrateProcessInstances(ctx context.Context, req *service.ProcessMigrationRequest) (*service.ProcessMigrationReport, error) {
+	return nil, nil
+}
 
 func newBPMNWorkflowTestRouter(t *testing.T) (*gin.Engine, *fakeTaskService) {
 	gin.SetMode(gin.TestMode)
@@ -0,0 +1,246 @@
+package controller
+
+import (
+	"io"
+	"strconv"
+
+	"itsm-backend/common"
+	"itsm-backend/dto"
+	"itsm-backend/ent"
+	"itsm-backend/middleware"
+	"itsm-backend/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxICSUploadSize iCalendar 文件大小上限
+const maxICSUploadSize = 2 << 20
+
+// BusinessCalendarController 工作日历控制器：维护日历、导入节假日并指定给 SLA 与团队
+type BusinessCalendarController struct {
+	service *service.BusinessCalendarService
+}
+
+// NewBusinessCalendarController 创建工作日历控制器
+func NewBusinessCalendarController(calendarService *service.BusinessCalendarService) *BusinessCalendarController {
+	return &BusinessCalendarController{service: calendarService}
+}
+
+// RegisterRoutes 注册路由
+func (c *BusinessCalendarController) RegisterRoutes(r *gin.RouterGroup) {
+	calendars := r.Group("/sla/calendars")
+	{
+		calendars.GET("", middleware.RequirePermission("sla", "read"), c.ListCalendars)
+		calendars.POST("", middleware.RequirePermission("sla", "write"), c.CreateCalendar)
+		calendars.PUT("/assignments", middleware.RequirePermission("sla", "write"), c.AssignCalendar)
+		calendars.GET("/:id", middleware.RequirePermission("sla", "read"), c.GetCalendar)
+		calendars.PUT("/:id", middleware.RequirePermission("sla", "write"), c.UpdateCalendar)
+		calendars.DELETE("/:id", middleware.RequirePermission("sla", "delete"), c.DeleteCalendar)
+		calendars.POST("/:id/holidays/import", middleware.RequirePermission("sla", "write"), c.ImportHolidays)
+	}
+}
+
+// CreateCalendar 创建工作日历
+// @Summary 创建工作日历
+// @Tags 工作日历
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateBusinessCalendarRequest true "工作日历"
+// @Success 200 {object} common.Response
+// @Router /api/v1/sla/calendars [post]
+func (c *BusinessCalendarController) CreateCalendar(ctx *gin.Context) {
+	var req dto.CreateBusinessCalendarRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return
+	}
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return
+	}
+	req.TenantID = tenantID
+
+	calendar, err := c.service.CreateCalendar(ctx.Request.Context(), &req)
+	if err != nil {
+		common.Fail(ctx, common.ParamErrorCode, err.Error())
+		return
+	}
+	common.Success(ctx, calendar)
+}
+
+// ListCalendars 获取工作日历列表
+// @Summary 获取工作日历列表
+// @Tags 工作日历
+// @Produce json
+// @Success 200 {object} common.Response
+// @Router /api/v1/sla/calendars [get]
+func (c *BusinessCalendarController) ListCalendars(ctx *gin.Context) {
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return
+	}
+	calendars, err := c.service.ListCalendars(ctx.Request.Context(), tenantID)
+	if err != nil {
+		common.InternalError(ctx, "获取工作日历失败: "+err.Error())
+		return
+	}
+	common.Success(ctx, calendars)
+}
+
+// GetCalendar 获取工作日历
+// @Summary 获取工作日历
+// @Tags 工作日历
+// @Produce json
+// @Param id path int true "工作日历ID"
+// @Success 200 {object} common.Response
+// @Router /api/v1/sla/calendars/{id} [get]
+func (c *BusinessCalendarController) GetCalendar(ctx *gin.Context) {
+	id, tenantID, ok := c.calendarParams(ctx)
+	if !ok {
+		return
+	}
+	calendar, err := c.service.GetCalendar(ctx.Request.Context(), tenantID, id)
+	if err != nil {
+		c.failCalendar(ctx, err)
+		return
+	}
+	common.Success(ctx, calendar)
+}
+
+// UpdateCalendar 更新工作日历
+// @Summary 更新工作日历
+// @Tags 工作日历
+// @Accept json
+// @Produce json
+// @Param id path int true "工作日历ID"
+// @Param request body dto.UpdateBusinessCalendarRequest true "工作日历"
+// @Success 200 {object} common.Response
+// @Router /api/v1/sla/calendars/{id} [put]
+func (c *BusinessCalendarController) UpdateCalendar(ctx *gin.Context) {
+	id, tenantID, ok := c.calendarParams(ctx)
+	if !ok {
+		return
+	}
+	var req dto.UpdateBusinessCalendarRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return
+	}
+	calendar, err := c.service.UpdateCalendar(ctx.Request.Context(), tenantID, id, &req)
+	if err != nil {
+		c.failCalendar(ctx, err)
+		return
+	}
+	common.Success(ctx, calendar)
+}
+
+// DeleteCalendar 删除工作日历
+// @Summary 删除工作日历
+// @Tags 工作日历
+// @Produce json
+// @Param id path int true "工作日历ID"
+// @Success 200 {object} common.Response
+// @Router /api/v1/sla/calendars/{id} [delete]
+func (c *BusinessCalendarController) DeleteCalendar(ctx *gin.Context) {
+	id, tenantID, ok := c.calendarParams(ctx)
+	if !ok {
+		return
+	}
+	if err := c.service.DeleteCalendar(ctx.Request.Context(), tenantID, id); err != nil {
+		c.failCalendar(ctx, err)
+		return
+	}
+	common.SuccessWithMessage(ctx, "工作日历已删除", nil)
+}
+
+// ImportHolidays 从 iCalendar (.ics) 导入节假日与调休上班日，支持 multipart 字段 file 或直接提交文件内容
+// @Summary 导入节假日
+// @Tags 工作日历
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path int true "工作日历ID"
+// @Param file formData file false "iCalendar 文件"
+// @Success 200 {object} common.Response
+// @Router /api/v1/sla/calendars/{id}/holidays/import [post]
+func (c *BusinessCalendarController) ImportHolidays(ctx *gin.Context) {
+	id, tenantID, ok := c.calendarParams(ctx)
+	if !ok {
+		return
+	}
+	var reader io.Reader = ctx.Request.Body
+	if file, err := ctx.FormFile("file"); err == nil {
+		opened, err := file.Open()
+		if err != nil {
+			common.Fail(ctx, common.ParamErrorCode, "读取上传文件失败: "+err.Error())
+			return
+		}
+		defer opened.Close()
+		reader = opened
+	}
+	data, err := io.ReadAll(io.LimitReader(reader, maxICSUploadSize+1))
+	if err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "读取 iCalendar 内容失败: "+err.Error())
+		return
+	}
+	if len(data) > maxICSUploadSize {
+		common.Fail(ctx, common.ParamErrorCode, "iCalendar 文件不能超过 2MB")
+		return
+	}
+
+	result, err := c.service.ImportICS(ctx.Request.Context(), tenantID, id, data)
+	if err != nil {
+		c.failCalendar(ctx, err)
+		return
+	}
+	common.Success(ctx, result)
+}
+
+// AssignCalendar 为 SLA 策略、SLA 定义或团队指定工作日历
+// @Summary 指定工作日历
+// @Tags 工作日历
+// @Accept json
+// @Produce json
+// @Param request body dto.AssignBusinessCalendarRequest true "指定对象"
+// @Success 200 {object} common.Response
+// @Router /api/v1/sla/calendars/assignments [put]
+func (c *BusinessCalendarController) AssignCalendar(ctx *gin.Context) {
+	var req dto.AssignBusinessCalendarRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return
+	}
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return
+	}
+	if err := c.service.AssignCalendar(ctx.Request.Context(), tenantID, &req); err != nil {
+		common.Fail(ctx, common.ParamErrorCode, err.Error())
+		return
+	}
+	common.SuccessWithMessage(ctx, "工作日历已指定", nil)
+}
+
+func (c *BusinessCalendarController) calendarParams(ctx *gin.Context) (int, int, bool) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || id <= 0 {
+		common.Fail(ctx, common.ParamErrorCode, "无效的工作日历ID")
+		return 0, 0, false
+	}
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return 0, 0, false
+	}
+	return id, tenantID, true
+}
+
+func (c *BusinessCalendarController) failCalendar(ctx *gin.Context, err error) {
+	if ent.IsNotFound(err) {
+		common.Fail(ctx, common.NotFoundCode, "工作日历不存在")
+		return
+	}
+	common.Fail(ctx, common.ParamErrorCode, err.Error())
+}
@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"io"
+	"net/http"
+
+	"itsm-backend/common"
+	"itsm-backend/common/tenantctx"
+	"itsm-backend/connector"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// maxChatOpsCallbackBytes IM 回调负载上限
+const maxChatOpsCallbackBytes = 1 << 20
+
+// chatOpsProviders 经统一入口接收回调的 IM 连接器；飞书沿用独立的 FeishuController
+var chatOpsProviders = map[string]bool{"slack": true, "teams": true, "dingtalk": true}
+
+// ChatOpsController Slack / Teams 事件与卡片按钮回调、钉钉机器人消息回调入口
+// 签名校验与负载解析由连接器完成，解析后的入站消息经 connector.Router 分发给业务处理器
+type ChatOpsController struct {
+	connectorManager *connector.Manager
+	router           *connector.Router
+	logger           *zap.SugaredLogger
+}
+
+// NewChatOpsController 创建 IM 回调控制器
+func NewChatOpsController(connectorManager *connector.Manager, router *connector.Router, logger *zap.SugaredLogger) *ChatOpsController {
+	return &ChatOpsController{connectorManager: connectorManager, router: router, logger: logger}
+}
+
+// RegisterPublicRoutes 注册公开回调入口（以高熵实例ID定位租户，签名校验在连接器内完成）
+func (c *ChatOpsController) RegisterPublicRoutes(public *gin.RouterGroup) {
+	public.POST("/chatops/:provider/:instance_id", c.Callback)
+}
+
+// Callback 接收 Slack Events API / Interactivity、Teams Bot Framework 活动与钉钉机器人消息
+// @Summary IM 事件与卡片按钮回调
+// @Description provider 为 slack / teams / dingtalk；卡片按钮可执行审批通过/拒绝、认领事件、分派工单给自己，结果就地回复点击者
+// @Tags ChatOps
+// @Accept json
+// @Produce json
+// @Param provider path string true "IM 平台: slack/teams/dingtalk"
+// @Param instance_id path string true "连接器实例ID"
+// @Success 200 {object} common.Response
+// @Router /api/v1/chatops/{provider}/{instance_id} [post]
+func (c *ChatOpsController) Callback(ctx *gin.Context) {
+	provider := ctx.Param("provider")
+	if !chatOpsProviders[provider] {
+		common.Fail(ctx, common.ParamErrorCode, "Invalid request")
+		return
+	}
+	conn, tenantID, ok := c.connectorManager.GetByCallbackInstanceID(provider, ctx.Param("instance_id"))
+	if !ok {
+		common.Fail(ctx, common.ParamErrorCode, "Invalid request")
+		return
+	}
+	rcv, ok := conn.(connector.Receiver)
+	if !ok {
+		common.Fail(ctx, common.ParamErrorCode, "Invalid request")
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxChatOpsCallbackBytes))
+	if err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "回调内容过大或读取失败")
+		return
+	}
+	headers := make(map[string]string)
+	for k, v := range ctx.Request.Header {
+		if len(v) > 0 {
+			headers[k] = v[0]
+		}
+	}
+	if err := rcv.VerifySignature(headers, body); err != nil {
+		c.logger.Warnw("Invalid chatops callback signature", "tenant_id", tenantID, "provider", provider, "err", err)
+		common.Fail(ctx, common.ForbiddenCode, "Invalid signature")
+		return
+	}
+	msg, err := rcv.ParseInbound(body)
+	if err != nil {
+		c.logger.Warnw("Failed to parse chatops callback", "tenant_id", tenantID, "provider", provider, "err", err)
+		common.Fail(ctx, common.ParamErrorCode, "Invalid event payload")
+		return
+	}
+	if msg.Type == "url_verification" {
+		ctx.JSON(http.StatusOK, gin.H{"challenge": msg.Content})
+		return
+	}
+	msg.ConnectorName = provider
+	if c.router != nil {
+		_ = c.router.Dispatch(tenantctx.WithTenantID(ctx.Request.Context(), tenantID), msg)
+	}
+	common.Success(ctx, gin.H{"type": msg.Type})
+}
@@ -0,0 +1,113 @@
+package controller
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+
+	"itsm-backend/common"
+	"itsm-backend/connector"
+	emailConn "itsm-backend/connector/builtin/email"
+	"itsm-backend/middleware"
+	"itsm-backend/service"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// maxInboundEmailBytes Webhook 投递的原始 MIME 上限
+const maxInboundEmailBytes = 25 << 20
+
+// EmailInboundController 邮件渠道入站：Webhook 接收原始 MIME，以及入站邮件台账查询
+type EmailInboundController struct {
+	connectorManager *connector.Manager
+	service          *service.EmailInboundService
+	logger           *zap.SugaredLogger
+}
+
+// NewEmailInboundController 创建邮件入站控制器
+func NewEmailInboundController(connectorManager *connector.Manager, inboundService *service.EmailInboundService, logger *zap.SugaredLogger) *EmailInboundController {
+	return &EmailInboundController{connectorManager: connectorManager, service: inboundService, logger: logger}
+}
+
+// RegisterRoutes 注册租户内路由
+func (c *EmailInboundController) RegisterRoutes(r *gin.RouterGroup) {
+	r.GET("/inbound-emails", middleware.RequirePermission("connector", "read"), c.ListInboundEmails)
+}
+
+// RegisterPublicRoutes 注册公开的 Webhook 入口（以高熵实例ID定位租户，签名校验在连接器内完成）
+func (c *EmailInboundController) RegisterPublicRoutes(public *gin.RouterGroup) {
+	public.POST("/email/inbound/:instance_id", c.Webhook)
+}
+
+// Webhook 接收邮件网关转发的原始 MIME
+// @Summary 邮件入站 Webhook
+// @Tags 邮件渠道
+// @Accept message/rfc822
+// @Produce json
+// @Param instance_id path string true "连接器实例ID"
+// @Success 200 {object} common.Response
+// @Router /api/v1/email/inbound/{instance_id} [post]
+func (c *EmailInboundController) Webhook(ctx *gin.Context) {
+	conn, tenantID, ok := c.connectorManager.GetByCallbackInstanceID("email", ctx.Param("instance_id"))
+	if !ok {
+		common.Fail(ctx, common.ParamErrorCode, "Invalid request")
+		return
+	}
+	ec, ok := conn.(*emailConn.Email)
+	if !ok {
+		common.Fail(ctx, common.ParamErrorCode, "Invalid request")
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxInboundEmailBytes))
+	if err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "邮件内容过大或读取失败")
+		return
+	}
+	headers := make(map[string]string)
+	for k, v := range ctx.Request.Header {
+		if len(v) > 0 {
+			headers[k] = v[0]
+		}
+	}
+	if err := ec.VerifySignature(headers, body); err != nil {
+		c.logger.Warnw("Invalid inbound email signature", "tenant_id", tenantID, "err", err)
+		common.Fail(ctx, common.ForbiddenCode, "Invalid signature")
+		return
+	}
+	msg, err := ec.ParseInbound(body)
+	if err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "Invalid email payload")
+		return
+	}
+	record, err := c.service.HandleInbound(ctx.Request.Context(), tenantID, ec.Mailbox(), msg)
+	if err != nil {
+		c.logger.Errorw("Failed to process inbound email", "tenant_id", tenantID, "message_id", msg.MessageID, "err", err)
+		common.Fail(ctx, common.InternalErrorCode, "邮件处理失败")
+		return
+	}
+	common.Success(ctx, gin.H{"status": record.Status, "ticketId": record.TicketID, "reason": record.Reason})
+}
+
+// ListInboundEmails 查询入站邮件台账
+// @Summary 入站邮件列表
+// @Tags 邮件渠道
+// @Produce json
+// @Param status query string false "状态: pending/created/threaded/ignored/failed"
+// @Param limit query int false "返回条数，默认 50，最大 200"
+// @Success 200 {object} common.Response
+// @Router /api/v1/inbound-emails [get]
+func (c *EmailInboundController) ListInboundEmails(ctx *gin.Context) {
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return
+	}
+	limit, _ := strconv.Atoi(ctx.Query("limit"))
+	records, err := c.service.ListInboundEmails(ctx.Request.Context(), tenantID, ctx.Query("status"), limit)
+	if err != nil {
+		common.Fail(ctx, common.ParamErrorCode, err.Error())
+		return
+	}
+	common.Success(ctx, records)
+}
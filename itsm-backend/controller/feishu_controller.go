@@ -1,6 +1,7 @@
 package controller
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -8,6 +9,7 @@ import (
 	"time"
 
 	"itsm-backend/common"
+	"itsm-backend/common/tenantctx"
 	"itsm-backend/connector"
 	feishuConn "itsm-backend/connector/builtin/feishu"
 	"itsm-backend/dto"
@@ -25,6 +27,8 @@ type FeishuController struct {
 	logger           *zap.SugaredLogger
 	replayMu         sync.Mutex
 	replayed         map[string]time.Time
+	// inbound 注入后 nonce 防重放与事件去重走其共享去重存储，多副本间生效，事件经其派发并记入入站日志
+	inbound *connector.Router
 }
 
 func NewFeishuController(connectorManager *connector.Manager, syncService *service.FeishuSyncService, marketplace *marketplaceService.Service, logger *zap.SugaredLogger) *FeishuController {
@@ -37,6 +41,11 @@ func NewFeishuController(connectorManager *connector.Manager, syncService *servi
 	}
 }
 
+// SetInboundRouter 注入入站路由：使用其集群级去重存储，事件经路由派发给已注册的处理器
+func (c *FeishuController) SetInboundRouter(r *connector.Router) {
+	c.inbound = r
+}
+
 // getFeishuConnector 获取当前租户的飞书连接器（仅从认证上下文取 tenant_id，禁止 query 参数绕过）
 func (c *FeishuController) getFeishuConnector(ctx *gin.Context) (*feishuConn.Feishu, int, bool) {
 	tenantID := ctx.GetInt("tenant_id")
@@ -178,7 +187,8 @@ func (c *FeishuController) Webhook(ctx *gin.Context) {
 		common.Fail(ctx, common.ForbiddenCode, "Invalid signature")
 		return
 	}
-	if !c.consumeWebhookNonce(headers["X-Lark-Request-Timestamp"], headers["X-Lark-Request-Nonce"], headers["X-Lark-Signature"]) {
+	tenantCtx := tenantctx.WithTenantID(ctx.Request.Context(), tenantID)
+	if !c.consumeWebhookNonce(tenantCtx, headers["X-Lark-Request-Timestamp"], headers["X-Lark-Request-Nonce"], headers["X-Lark-Signature"]) {
 		common.Fail(ctx, common.ForbiddenCode, "Replay detected")
 		return
 	}
@@ -193,6 +203,15 @@ func (c *FeishuController) Webhook(ctx *gin.Context) {
 		return
 	}
 
+	msg.ConnectorName = "feishu"
+	// 经入站路由派发：飞书未收到及时响应会以相同事件ID重投（可能落到其他副本），由路由去重；
+	// 任务事件同步作为具名处理器执行，失败记入入站日志并经命令总线重试，不依赖飞书重投
+	if c.inbound != nil {
+		_ = c.inbound.Dispatch(tenantCtx, msg)
+		common.Success(ctx, &dto.FeishuWebhookResponse{EventType: msg.Type, Action: "dispatched"})
+		return
+	}
+
 	if msg.Type == "task_event" {
 		eventType := msg.Content
 		taskData, ok := msg.Extras["task_data"].(map[string]interface{})
@@ -201,7 +220,7 @@ func (c *FeishuController) Webhook(ctx *gin.Context) {
 			return
 		}
 
-		resp, err := c.syncService.HandleTaskEvent(ctx.Request.Context(), tenantID, fc, eventType, taskData)
+		resp, err := c.syncService.HandleTaskEvent(tenantCtx, tenantID, fc, eventType, taskData)
 		if err != nil {
 			c.logger.Errorw("Failed to handle Feishu task event", "event_type", eventType, "err", err)
 			common.Fail(ctx, common.InternalErrorCode, "Failed to handle event")
@@ -214,8 +233,11 @@ func (c *FeishuController) Webhook(ctx *gin.Context) {
 	common.Success(ctx, &dto.FeishuWebhookResponse{EventType: msg.Type, Action: "ignored"})
 }
 
-func (c *FeishuController) consumeWebhookNonce(timestamp, nonce, signature string) bool {
+func (c *FeishuController) consumeWebhookNonce(ctx context.Context, timestamp, nonce, signature string) bool {
 	key := timestamp + ":" + nonce + ":" + signature
+	if c.inbound != nil {
+		return c.inbound.Claim(ctx, "feishu:nonce:"+key)
+	}
 	now := time.Now()
 	c.replayMu.Lock()
 	defer c.replayMu.Unlock()
@@ -0,0 +1,130 @@
+package controller
+
+import (
+	"errors"
+	"strconv"
+
+	"itsm-backend/common"
+	"itsm-backend/dto"
+	"itsm-backend/middleware"
+	"itsm-backend/service"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// InboundMessageController IM 入站消息日志：查看各处理器执行结果并手动重放
+type InboundMessageController struct {
+	service *service.InboundMessageService
+	logger  *zap.SugaredLogger
+}
+
+// NewInboundMessageController 创建入站消息控制器
+func NewInboundMessageController(inboundService *service.InboundMessageService, logger *zap.SugaredLogger) *InboundMessageController {
+	return &InboundMessageController{service: inboundService, logger: logger}
+}
+
+// RegisterRoutes 注册租户内路由
+func (c *InboundMessageController) RegisterRoutes(r *gin.RouterGroup) {
+	r.GET("/inbound-messages", middleware.RequirePermission("connector", "read"), c.ListInboundMessages)
+	r.GET("/inbound-messages/:id", middleware.RequirePermission("connector", "read"), c.GetInboundMessage)
+	r.POST("/inbound-messages/:id/replay", middleware.RequirePermission("connector", "write"), c.ReplayInboundMessage)
+}
+
+// ListInboundMessages 入站消息日志列表
+// @Summary 入站消息日志列表
+// @Tags 连接器
+// @Produce json
+// @Param connector query string false "连接器名称"
+// @Param status query string false "状态：received / succeeded / failed"
+// @Param limit query int false "返回条数，默认 50，最大 200"
+// @Success 200 {object} common.Response
+// @Router /api/v1/inbound-messages [get]
+func (c *InboundMessageController) ListInboundMessages(ctx *gin.Context) {
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return
+	}
+	limit, _ := strconv.Atoi(ctx.Query("limit"))
+	records, err := c.service.ListInboundMessages(ctx.Request.Context(), tenantID, ctx.Query("connector"), ctx.Query("status"), limit)
+	if err != nil {
+		common.Fail(ctx, common.ParamErrorCode, err.Error())
+		return
+	}
+	common.Success(ctx, records)
+}
+
+// GetInboundMessage 入站消息详情
+// @Summary 入站消息详情
+// @Description 含原始负载与各处理器的执行状态、错误和尝试次数
+// @Tags 连接器
+// @Produce json
+// @Param id path int true "入站消息ID"
+// @Success 200 {object} common.Response
+// @Router /api/v1/inbound-messages/{id} [get]
+func (c *InboundMessageController) GetInboundMessage(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "无效的入站消息ID")
+		return
+	}
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return
+	}
+	record, err := c.service.GetInboundMessage(ctx.Request.Context(), tenantID, id)
+	if errors.Is(err, service.ErrInboundMessageNotFound) {
+		common.Fail(ctx, common.NotFoundCode, err.Error())
+		return
+	}
+	if err != nil {
+		common.InternalError(ctx, "获取入站消息失败: "+err.Error())
+		return
+	}
+	common.Success(ctx, record)
+}
+
+// ReplayInboundMessage 手动重放入站消息
+// @Summary 重放入站消息
+// @Description 跳过去重重新执行指定处理器（为空时全部），结果写回日志
+// @Tags 连接器
+// @Accept json
+// @Produce json
+// @Param id path int true "入站消息ID"
+// @Param request body dto.ReplayInboundMessageRequest false "重放的处理器"
+// @Success 200 {object} common.Response
+// @Router /api/v1/inbound-messages/{id}/replay [post]
+func (c *InboundMessageController) ReplayInboundMessage(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "无效的入站消息ID")
+		return
+	}
+	var req dto.ReplayInboundMessageRequest
+	if ctx.Request.ContentLength > 0 {
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			common.Fail(ctx, common.ParamErrorCode, "请求参数错误: "+err.Error())
+			return
+		}
+	}
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return
+	}
+	record, err := c.service.ReplayInboundMessage(ctx.Request.Context(), tenantID, id, req.Handlers)
+	switch {
+	case errors.Is(err, service.ErrInboundMessageNotFound):
+		common.Fail(ctx, common.NotFoundCode, err.Error())
+		return
+	case errors.Is(err, service.ErrInboundMessageInvalid):
+		common.Fail(ctx, common.ParamErrorCode, err.Error())
+		return
+	case err != nil:
+		common.InternalError(ctx, "重放入站消息失败: "+err.Error())
+		return
+	}
+	common.Success(ctx, record)
+}
@@ -781,7 +781,7 @@ func (c *IncidentController) EscalateMajorIncident(ctx *gin.Context) {
 
 // AssignIncident 分配事件
 // @Summary 分配事件
-// @Description 将事件分配给指定处理人
+// @Description 将事件分配给指定处理人，或分配给值班表的当前值班人
 // @Tags 事件管理
 // @Produce json
 // @Param id path int true "事件ID"
@@ -804,13 +804,22 @@ func (c *IncidentController) AssignIncident(ctx *gin.Context) {
 		return
 	}
 	assigneeID := req.AssigneeID
-	if assigneeID <= 0 {
-		common.Fail(ctx, common.ParamErrorCode, "assigneeId 必填")
+	if (assigneeID <= 0) == (req.OnCallScheduleID <= 0) {
+		common.Fail(ctx, common.ParamErrorCode, "assigneeId 与 onCallScheduleId 必须且只能指定一个")
 		return
 	}
 
 	tenantID := ctx.GetInt("tenant_id")
-	incident, err := c.incidentService.AssignIncident(ctx.Request.Context(), id, assigneeID, tenantID)
+	var incident *dto.IncidentResponse
+	if req.OnCallScheduleID > 0 {
+		incident, err = c.incidentService.AssignIncidentToOnCall(ctx.Request.Context(), id, req.OnCallScheduleID, tenantID)
+	} else {
+		incident, err = c.incidentService.AssignIncident(ctx.Request.Context(), id, assigneeID, tenantID)
+	}
+	if errors.Is(err, service.ErrOnCallScheduleNotFound) || errors.Is(err, service.ErrNobodyOnCall) || errors.Is(err, service.ErrOnCallScheduleDisabled) {
+		common.Fail(ctx, common.ParamErrorCode, err.Error())
+		return
+	}
 	if err != nil {
 		c.logger.Errorw("Failed to assign incident", "error", err, "id", id)
 		common.Fail(ctx, common.InternalErrorCode, err.Error())
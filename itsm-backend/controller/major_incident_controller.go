@@ -0,0 +1,274 @@
+package controller
+
+import (
+	"errors"
+	"strconv"
+
+	"itsm-backend/common"
+	"itsm-backend/dto"
+	"itsm-backend/middleware"
+	"itsm-backend/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MajorIncidentController 重大事件控制器：宣告、指挥官与通报节奏、干系人通报、时间线与复盘草稿
+type MajorIncidentController struct {
+	service *service.MajorIncidentService
+}
+
+// NewMajorIncidentController 创建重大事件控制器
+func NewMajorIncidentController(majorIncidentService *service.MajorIncidentService) *MajorIncidentController {
+	return &MajorIncidentController{service: majorIncidentService}
+}
+
+// RegisterRoutes 注册路由
+func (c *MajorIncidentController) RegisterRoutes(r *gin.RouterGroup) {
+	inc := r.Group("/incidents")
+	{
+		inc.GET("/major", middleware.RequirePermission("incident", "read"), c.ListMajorIncidents)
+		inc.GET("/:id/timeline", middleware.RequirePermission("incident", "read"), c.GetTimeline)
+		inc.GET("/:id/major", middleware.RequirePermission("incident", "read"), c.GetMajorIncident)
+		inc.POST("/:id/major", middleware.RequirePermission("incident", "write"), c.DeclareMajorIncident)
+		inc.PUT("/:id/major", middleware.RequirePermission("incident", "write"), c.UpdateMajorIncident)
+		inc.POST("/:id/major/updates", middleware.RequirePermission("incident", "write"), c.PostUpdate)
+		inc.POST("/:id/major/postmortem", middleware.RequirePermission("incident", "write"), c.GeneratePostmortem)
+	}
+}
+
+// DeclareMajorIncident 宣告重大事件
+// @Summary 宣告重大事件
+// @Description 指定事件指挥官，可选经飞书/钉钉/企业微信建立作战室，并按节奏向干系人通报进展
+// @Tags 事件管理
+// @Accept json
+// @Produce json
+// @Param id path int true "事件ID"
+// @Param request body dto.DeclareMajorIncidentRequest true "宣告信息"
+// @Success 200 {object} common.Response{data=dto.MajorIncidentResponse}
+// @Router /api/v1/incidents/{id}/major [post]
+func (c *MajorIncidentController) DeclareMajorIncident(ctx *gin.Context) {
+	id, ok := c.incidentID(ctx)
+	if !ok {
+		return
+	}
+	var req dto.DeclareMajorIncidentRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return
+	}
+	tenantID, userID, ok := c.identity(ctx)
+	if !ok {
+		return
+	}
+	resp, err := c.service.Declare(ctx.Request.Context(), tenantID, id, userID, &req)
+	if err != nil {
+		c.failMajorIncident(ctx, err)
+		return
+	}
+	common.Success(ctx, resp)
+}
+
+// GetMajorIncident 获取重大事件
+// @Summary 获取重大事件
+// @Tags 事件管理
+// @Produce json
+// @Param id path int true "事件ID"
+// @Success 200 {object} common.Response{data=dto.MajorIncidentResponse}
+// @Router /api/v1/incidents/{id}/major [get]
+func (c *MajorIncidentController) GetMajorIncident(ctx *gin.Context) {
+	id, ok := c.incidentID(ctx)
+	if !ok {
+		return
+	}
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return
+	}
+	resp, err := c.service.GetMajorIncident(ctx.Request.Context(), tenantID, id)
+	if err != nil {
+		c.failMajorIncident(ctx, err)
+		return
+	}
+	common.Success(ctx, resp)
+}
+
+// ListMajorIncidents 重大事件列表
+// @Summary 重大事件列表
+// @Tags 事件管理
+// @Produce json
+// @Param status query string false "状态：active / resolved"
+// @Success 200 {object} common.Response
+// @Router /api/v1/incidents/major [get]
+func (c *MajorIncidentController) ListMajorIncidents(ctx *gin.Context) {
+	var filter dto.MajorIncidentListFilter
+	if err := ctx.ShouldBindQuery(&filter); err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return
+	}
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return
+	}
+	list, err := c.service.ListMajorIncidents(ctx.Request.Context(), tenantID, &filter)
+	if err != nil {
+		common.InternalError(ctx, "获取重大事件失败: "+err.Error())
+		return
+	}
+	common.Success(ctx, list)
+}
+
+// UpdateMajorIncident 调整重大事件
+// @Summary 更换指挥官、调整干系人或通报节奏
+// @Tags 事件管理
+// @Accept json
+// @Produce json
+// @Param id path int true "事件ID"
+// @Param request body dto.UpdateMajorIncidentRequest true "调整内容"
+// @Success 200 {object} common.Response{data=dto.MajorIncidentResponse}
+// @Router /api/v1/incidents/{id}/major [put]
+func (c *MajorIncidentController) UpdateMajorIncident(ctx *gin.Context) {
+	id, ok := c.incidentID(ctx)
+	if !ok {
+		return
+	}
+	var req dto.UpdateMajorIncidentRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return
+	}
+	tenantID, userID, ok := c.identity(ctx)
+	if !ok {
+		return
+	}
+	resp, err := c.service.UpdateMajorIncident(ctx.Request.Context(), tenantID, id, userID, &req)
+	if err != nil {
+		c.failMajorIncident(ctx, err)
+		return
+	}
+	common.Success(ctx, resp)
+}
+
+// PostUpdate 发布干系人进展通报
+// @Summary 发布干系人进展通报
+// @Description 立即向指挥官、干系人与作战室发送一次进展通报，并从此刻起重新计时下一次自动通报
+// @Tags 事件管理
+// @Accept json
+// @Produce json
+// @Param id path int true "事件ID"
+// @Param request body dto.PostStakeholderUpdateRequest true "通报内容"
+// @Success 200 {object} common.Response{data=dto.MajorIncidentResponse}
+// @Router /api/v1/incidents/{id}/major/updates [post]
+func (c *MajorIncidentController) PostUpdate(ctx *gin.Context) {
+	id, ok := c.incidentID(ctx)
+	if !ok {
+		return
+	}
+	var req dto.PostStakeholderUpdateRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return
+	}
+	tenantID, userID, ok := c.identity(ctx)
+	if !ok {
+		return
+	}
+	resp, err := c.service.PostUpdate(ctx.Request.Context(), tenantID, id, userID, &req)
+	if err != nil {
+		c.failMajorIncident(ctx, err)
+		return
+	}
+	common.Success(ctx, resp)
+}
+
+// GeneratePostmortem 重新生成复盘草稿
+// @Summary 生成事后复盘草稿
+// @Tags 事件管理
+// @Produce json
+// @Param id path int true "事件ID"
+// @Success 200 {object} common.Response{data=dto.MajorIncidentResponse}
+// @Router /api/v1/incidents/{id}/major/postmortem [post]
+func (c *MajorIncidentController) GeneratePostmortem(ctx *gin.Context) {
+	id, ok := c.incidentID(ctx)
+	if !ok {
+		return
+	}
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return
+	}
+	resp, err := c.service.GeneratePostmortem(ctx.Request.Context(), tenantID, id)
+	if err != nil {
+		c.failMajorIncident(ctx, err)
+		return
+	}
+	common.Success(ctx, resp)
+}
+
+// GetTimeline 事件时间线
+// @Summary 事件时间线
+// @Description 汇总事件创建、活动记录、评论、状态流转与干系人通报，按时间升序
+// @Tags 事件管理
+// @Produce json
+// @Param id path int true "事件ID"
+// @Success 200 {object} common.Response{data=[]dto.IncidentTimelineEntry}
+// @Router /api/v1/incidents/{id}/timeline [get]
+func (c *MajorIncidentController) GetTimeline(ctx *gin.Context) {
+	id, ok := c.incidentID(ctx)
+	if !ok {
+		return
+	}
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return
+	}
+	entries, err := c.service.Timeline(ctx.Request.Context(), tenantID, id)
+	if err != nil {
+		c.failMajorIncident(ctx, err)
+		return
+	}
+	common.Success(ctx, entries)
+}
+
+func (c *MajorIncidentController) incidentID(ctx *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || id <= 0 {
+		common.Fail(ctx, common.ParamErrorCode, "无效的事件ID")
+		return 0, false
+	}
+	return id, true
+}
+
+func (c *MajorIncidentController) identity(ctx *gin.Context) (int, int, bool) {
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return 0, 0, false
+	}
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.AuthFailedCode, "获取用户ID失败")
+		return 0, 0, false
+	}
+	return tenantID, userID, true
+}
+
+func (c *MajorIncidentController) failMajorIncident(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrIncidentNotFound):
+		common.Fail(ctx, common.NotFoundCode, "事件不存在")
+	case errors.Is(err, service.ErrMajorIncidentNotDeclared):
+		common.Fail(ctx, common.NotFoundCode, err.Error())
+	case errors.Is(err, service.ErrMajorIncidentAlreadyDeclared),
+		errors.Is(err, service.ErrMajorIncidentClosed),
+		errors.Is(err, service.ErrMajorIncidentResolved):
+		common.Fail(ctx, common.ConflictCode, err.Error())
+	case errors.Is(err, service.ErrMajorIncidentInvalidUser):
+		common.Fail(ctx, common.ParamErrorCode, err.Error())
+	default:
+		common.InternalError(ctx, err.Error())
+	}
+}
@@ -0,0 +1,116 @@
+package controller
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+
+	"itsm-backend/common"
+	"itsm-backend/connector"
+	monitorconn "itsm-backend/connector/builtin/monitoring"
+	"itsm-backend/middleware"
+	"itsm-backend/service"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// maxMonitoringAlertBytes 单次告警推送的负载上限
+const maxMonitoringAlertBytes = 5 << 20
+
+// MonitoringAlertController 监控告警入站：Alertmanager / Zabbix / Grafana 推送入口与告警台账查询
+type MonitoringAlertController struct {
+	connectorManager *connector.Manager
+	service          *service.MonitoringAlertService
+	logger           *zap.SugaredLogger
+}
+
+// NewMonitoringAlertController 创建监控告警控制器
+func NewMonitoringAlertController(connectorManager *connector.Manager, alertService *service.MonitoringAlertService, logger *zap.SugaredLogger) *MonitoringAlertController {
+	return &MonitoringAlertController{connectorManager: connectorManager, service: alertService, logger: logger}
+}
+
+// RegisterRoutes 注册租户内路由
+func (c *MonitoringAlertController) RegisterRoutes(r *gin.RouterGroup) {
+	r.GET("/monitoring/alerts", middleware.RequirePermission("incident", "read"), c.ListAlerts)
+}
+
+// RegisterPublicRoutes 注册公开的推送入口（以高熵实例ID定位租户，Token/签名校验在连接器内完成）
+func (c *MonitoringAlertController) RegisterPublicRoutes(public *gin.RouterGroup) {
+	public.POST("/monitoring/:source/alerts/:instance_id", c.Webhook)
+}
+
+// Webhook 接收监控系统推送的告警
+// @Summary 监控告警推送入口
+// @Description source 为 alertmanager / zabbix / grafana；告警按指纹去重，触发时开启或更新事件，恢复时自动解决或记录到事件时间线
+// @Tags 监控告警
+// @Accept json
+// @Produce json
+// @Param source path string true "告警源"
+// @Param instance_id path string true "连接器实例ID"
+// @Success 200 {object} common.Response{data=dto.MonitoringAlertIngestResult}
+// @Router /api/v1/monitoring/{source}/alerts/{instance_id} [post]
+func (c *MonitoringAlertController) Webhook(ctx *gin.Context) {
+	conn, tenantID, ok := c.connectorManager.GetByCallbackInstanceID(ctx.Param("source"), ctx.Param("instance_id"))
+	if !ok {
+		common.Fail(ctx, common.ParamErrorCode, "Invalid request")
+		return
+	}
+	src, ok := conn.(monitorconn.Source)
+	if !ok {
+		common.Fail(ctx, common.ParamErrorCode, "Invalid request")
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxMonitoringAlertBytes))
+	if err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "告警内容过大或读取失败")
+		return
+	}
+	headers := make(map[string]string)
+	for k, v := range ctx.Request.Header {
+		if len(v) > 0 {
+			headers[k] = v[0]
+		}
+	}
+	if err := src.VerifySignature(headers, body); err != nil {
+		c.logger.Warnw("Invalid monitoring alert signature", "tenant_id", tenantID, "source", ctx.Param("source"), "err", err)
+		common.Fail(ctx, common.ForbiddenCode, "Invalid signature")
+		return
+	}
+	msg, err := src.ParseInbound(body)
+	if err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "Invalid alert payload")
+		return
+	}
+	result, err := c.service.HandleInbound(ctx.Request.Context(), tenantID, src.Policy(), msg)
+	if err != nil {
+		// 返回 5xx 让告警源重试；已处理的告警按指纹幂等
+		c.logger.Errorw("Failed to process monitoring alerts", "tenant_id", tenantID, "source", ctx.Param("source"), "err", err)
+		common.FailWithData(ctx, common.InternalErrorCode, "告警处理失败", result)
+		return
+	}
+	common.Success(ctx, result)
+}
+
+// ListAlerts 查询监控告警台账
+// @Summary 监控告警列表
+// @Tags 监控告警
+// @Produce json
+// @Param status query string false "状态: firing/resolved"
+// @Param limit query int false "返回条数，默认 50，最大 200"
+// @Success 200 {object} common.Response
+// @Router /api/v1/monitoring/alerts [get]
+func (c *MonitoringAlertController) ListAlerts(ctx *gin.Context) {
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return
+	}
+	limit, _ := strconv.Atoi(ctx.Query("limit"))
+	alerts, err := c.service.ListAlerts(ctx.Request.Context(), tenantID, ctx.Query("status"), limit)
+	if err != nil {
+		common.Fail(ctx, common.ParamErrorCode, err.Error())
+		return
+	}
+	common.Success(ctx, alerts)
+}
@@ -0,0 +1,705 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"itsm-backend/common"
+	"itsm-backend/dto"
+	"itsm-backend/middleware"
+	"itsm-backend/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxOnCallRange 单次展开值班的最长区间
+const maxOnCallRange = 93 * 24 * time.Hour
+
+// OnCallController 值班控制器：值班表与轮值、值班替换与换班、当前值班查询、升级策略、值班呼叫与个人日历订阅
+type OnCallController struct {
+	service *service.OnCallService
+}
+
+// NewOnCallController 创建值班控制器
+func NewOnCallController(onCallService *service.OnCallService) *OnCallController {
+	return &OnCallController{service: onCallService}
+}
+
+// RegisterRoutes 注册路由
+func (c *OnCallController) RegisterRoutes(r *gin.RouterGroup) {
+	oc := r.Group("/oncall")
+	{
+		oc.GET("/who", middleware.RequirePermission("incident", "read"), c.WhoIsOnCall)
+		oc.GET("/me/calendar-feed", c.GetCalendarFeed)
+
+		oc.GET("/schedules", middleware.RequirePermission("incident", "read"), c.ListSchedules)
+		oc.POST("/schedules", middleware.RequirePermission("incident", "write"), c.CreateSchedule)
+		oc.GET("/schedules/:id", middleware.RequirePermission("incident", "read"), c.GetSchedule)
+		oc.PUT("/schedules/:id", middleware.RequirePermission("incident", "write"), c.UpdateSchedule)
+		oc.DELETE("/schedules/:id", middleware.RequirePermission("incident", "write"), c.DeleteSchedule)
+		oc.GET("/schedules/:id/shifts", middleware.RequirePermission("incident", "read"), c.ListShifts)
+		oc.GET("/schedules/:id/overrides", middleware.RequirePermission("incident", "read"), c.ListOverrides)
+		oc.POST("/schedules/:id/overrides", middleware.RequirePermission("incident", "write"), c.CreateOverride)
+		oc.DELETE("/schedules/:id/overrides/:override_id", middleware.RequirePermission("incident", "write"), c.DeleteOverride)
+		oc.POST("/schedules/:id/swaps", middleware.RequirePermission("incident", "write"), c.SwapShifts)
+
+		oc.GET("/policies", middleware.RequirePermission("incident", "read"), c.ListPolicies)
+		oc.POST("/policies", middleware.RequirePermission("incident", "write"), c.CreatePolicy)
+		oc.GET("/policies/:id", middleware.RequirePermission("incident", "read"), c.GetPolicy)
+		oc.PUT("/policies/:id", middleware.RequirePermission("incident", "write"), c.UpdatePolicy)
+		oc.DELETE("/policies/:id", middleware.RequirePermission("incident", "write"), c.DeletePolicy)
+
+		oc.POST("/pages/:id/acknowledge", middleware.RequirePermission("incident", "write"), c.AcknowledgePage)
+	}
+	inc := r.Group("/incidents")
+	{
+		inc.GET("/:id/oncall-pages", middleware.RequirePermission("incident", "read"), c.ListPages)
+		inc.POST("/:id/oncall-pages", middleware.RequirePermission("incident", "write"), c.TriggerPage)
+	}
+}
+
+// RegisterPublicRoutes 注册公开的日历订阅地址（日历客户端无法携带登录态，以签名令牌定位用户）
+func (c *OnCallController) RegisterPublicRoutes(public *gin.RouterGroup) {
+	public.GET("/oncall/calendar/:token", c.CalendarFeed)
+}
+
+// WhoIsOnCall 查询当前值班人
+// @Summary 查询值班人
+// @Description 查询指定时刻（默认当前）各启用值班表的值班人，可按团队或值班表过滤；值班替换与换班已生效
+// @Tags 值班管理
+// @Produce json
+// @Param teamId query int false "团队ID"
+// @Param scheduleId query int false "值班表ID"
+// @Param at query string false "时刻（RFC3339）"
+// @Success 200 {object} common.Response{data=[]dto.OnCallNow}
+// @Router /api/v1/oncall/who [get]
+func (c *OnCallController) WhoIsOnCall(ctx *gin.Context) {
+	var query dto.OnCallWhoQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return
+	}
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return
+	}
+	var at time.Time
+	if query.At != nil {
+		at = *query.At
+	}
+	list, err := c.service.WhoIsOnCall(ctx.Request.Context(), tenantID, query.TeamID, query.ScheduleID, at)
+	if err != nil {
+		c.failOnCall(ctx, err)
+		return
+	}
+	common.Success(ctx, list)
+}
+
+// CreateSchedule 创建值班表
+// @Summary 创建值班表
+// @Description 值班表由多层轮值组成（daily / weekly / custom），后面的层覆盖前面的层；交接时间按值班表时区计算
+// @Tags 值班管理
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateOnCallScheduleRequest true "值班表"
+// @Success 200 {object} common.Response{data=dto.OnCallScheduleResponse}
+// @Router /api/v1/oncall/schedules [post]
+func (c *OnCallController) CreateSchedule(ctx *gin.Context) {
+	var req dto.CreateOnCallScheduleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return
+	}
+	tenantID, userID, ok := c.identity(ctx)
+	if !ok {
+		return
+	}
+	resp, err := c.service.CreateSchedule(ctx.Request.Context(), tenantID, userID, &req)
+	if err != nil {
+		c.failOnCall(ctx, err)
+		return
+	}
+	common.Success(ctx, resp)
+}
+
+// ListSchedules 值班表列表
+// @Summary 值班表列表
+// @Tags 值班管理
+// @Produce json
+// @Param teamId query int false "团队ID"
+// @Success 200 {object} common.Response{data=[]dto.OnCallScheduleResponse}
+// @Router /api/v1/oncall/schedules [get]
+func (c *OnCallController) ListSchedules(ctx *gin.Context) {
+	teamID, _ := strconv.Atoi(ctx.Query("teamId"))
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return
+	}
+	list, err := c.service.ListSchedules(ctx.Request.Context(), tenantID, teamID)
+	if err != nil {
+		c.failOnCall(ctx, err)
+		return
+	}
+	common.Success(ctx, list)
+}
+
+// GetSchedule 获取值班表
+// @Summary 获取值班表
+// @Tags 值班管理
+// @Produce json
+// @Param id path int true "值班表ID"
+// @Success 200 {object} common.Response{data=dto.OnCallScheduleResponse}
+// @Router /api/v1/oncall/schedules/{id} [get]
+func (c *OnCallController) GetSchedule(ctx *gin.Context) {
+	id, ok := c.pathID(ctx, "id", "无效的值班表ID")
+	if !ok {
+		return
+	}
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return
+	}
+	resp, err := c.service.GetSchedule(ctx.Request.Context(), tenantID, id)
+	if err != nil {
+		c.failOnCall(ctx, err)
+		return
+	}
+	common.Success(ctx, resp)
+}
+
+// UpdateSchedule 修改值班表
+// @Summary 修改值班表
+// @Tags 值班管理
+// @Accept json
+// @Produce json
+// @Param id path int true "值班表ID"
+// @Param request body dto.UpdateOnCallScheduleRequest true "修改内容"
+// @Success 200 {object} common.Response{data=dto.OnCallScheduleResponse}
+// @Router /api/v1/oncall/schedules/{id} [put]
+func (c *OnCallController) UpdateSchedule(ctx *gin.Context) {
+	id, ok := c.pathID(ctx, "id", "无效的值班表ID")
+	if !ok {
+		return
+	}
+	var req dto.UpdateOnCallScheduleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return
+	}
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return
+	}
+	resp, err := c.service.UpdateSchedule(ctx.Request.Context(), tenantID, id, &req)
+	if err != nil {
+		c.failOnCall(ctx, err)
+		return
+	}
+	common.Success(ctx, resp)
+}
+
+// DeleteSchedule 删除值班表
+// @Summary 删除值班表
+// @Description 仍被升级策略引用的值班表不能删除
+// @Tags 值班管理
+// @Produce json
+// @Param id path int true "值班表ID"
+// @Success 200 {object} common.Response
+// @Router /api/v1/oncall/schedules/{id} [delete]
+func (c *OnCallController) DeleteSchedule(ctx *gin.Context) {
+	id, ok := c.pathID(ctx, "id", "无效的值班表ID")
+	if !ok {
+		return
+	}
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return
+	}
+	if err := c.service.DeleteSchedule(ctx.Request.Context(), tenantID, id); err != nil {
+		c.failOnCall(ctx, err)
+		return
+	}
+	common.Success(ctx, nil)
+}
+
+// ListShifts 展开值班
+// @Summary 展开值班
+// @Description 返回区间内合并了各轮值层与值班替换后的最终值班，默认从当前时间起 14 天，最长 93 天
+// @Tags 值班管理
+// @Produce json
+// @Param id path int true "值班表ID"
+// @Param from query string false "开始时间（RFC3339）"
+// @Param to query string false "结束时间（RFC3339）"
+// @Success 200 {object} common.Response{data=[]dto.OnCallShift}
+// @Router /api/v1/oncall/schedules/{id}/shifts [get]
+func (c *OnCallController) ListShifts(ctx *gin.Context) {
+	id, ok := c.pathID(ctx, "id", "无效的值班表ID")
+	if !ok {
+		return
+	}
+	from, to, ok := c.bindRange(ctx)
+	if !ok {
+		return
+	}
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return
+	}
+	shifts, err := c.service.Shifts(ctx.Request.Context(), tenantID, id, from, to)
+	if err != nil {
+		c.failOnCall(ctx, err)
+		return
+	}
+	common.Success(ctx, shifts)
+}
+
+// ListOverrides 值班替换列表
+// @Summary 值班替换列表
+// @Tags 值班管理
+// @Produce json
+// @Param id path int true "值班表ID"
+// @Param from query string false "开始时间（RFC3339）"
+// @Param to query string false "结束时间（RFC3339）"
+// @Success 200 {object} common.Response{data=[]dto.OnCallOverrideResponse}
+// @Router /api/v1/oncall/schedules/{id}/overrides [get]
+func (c *OnCallController) ListOverrides(ctx *gin.Context) {
+	id, ok := c.pathID(ctx, "id", "无效的值班表ID")
+	if !ok {
+		return
+	}
+	from, to, ok := c.bindRange(ctx)
+	if !ok {
+		return
+	}
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return
+	}
+	list, err := c.service.ListOverrides(ctx.Request.Context(), tenantID, id, from, to)
+	if err != nil {
+		c.failOnCall(ctx, err)
+		return
+	}
+	common.Success(ctx, list)
+}
+
+// CreateOverride 创建值班替换
+// @Summary 创建值班替换
+// @Description 替换时段内由 userId 值班；指定 originalUserId 时只替换原本由其值的班
+// @Tags 值班管理
+// @Accept json
+// @Produce json
+// @Param id path int true "值班表ID"
+// @Param request body dto.CreateOnCallOverrideRequest true "值班替换"
+// @Success 200 {object} common.Response{data=dto.OnCallOverrideResponse}
+// @Router /api/v1/oncall/schedules/{id}/overrides [post]
+func (c *OnCallController) CreateOverride(ctx *gin.Context) {
+	id, ok := c.pathID(ctx, "id", "无效的值班表ID")
+	if !ok {
+		return
+	}
+	var req dto.CreateOnCallOverrideRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return
+	}
+	tenantID, userID, ok := c.identity(ctx)
+	if !ok {
+		return
+	}
+	resp, err := c.service.CreateOverride(ctx.Request.Context(), tenantID, id, userID, &req)
+	if err != nil {
+		c.failOnCall(ctx, err)
+		return
+	}
+	common.Success(ctx, resp)
+}
+
+// DeleteOverride 删除值班替换
+// @Summary 删除值班替换
+// @Description 换班产生的两条替换成对删除
+// @Tags 值班管理
+// @Produce json
+// @Param id path int true "值班表ID"
+// @Param override_id path int true "值班替换ID"
+// @Success 200 {object} common.Response
+// @Router /api/v1/oncall/schedules/{id}/overrides/{override_id} [delete]
+func (c *OnCallController) DeleteOverride(ctx *gin.Context) {
+	id, ok := c.pathID(ctx, "id", "无效的值班表ID")
+	if !ok {
+		return
+	}
+	overrideID, ok := c.pathID(ctx, "override_id", "无效的值班替换ID")
+	if !ok {
+		return
+	}
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return
+	}
+	if err := c.service.DeleteOverride(ctx.Request.Context(), tenantID, id, overrideID); err != nil {
+		c.failOnCall(ctx, err)
+		return
+	}
+	common.Success(ctx, nil)
+}
+
+// SwapShifts 换班
+// @Summary 换班
+// @Description 两名值班人互换班次，生成一对只替换对方班次的值班替换
+// @Tags 值班管理
+// @Accept json
+// @Produce json
+// @Param id path int true "值班表ID"
+// @Param request body dto.SwapOnCallShiftsRequest true "换班"
+// @Success 200 {object} common.Response{data=[]dto.OnCallOverrideResponse}
+// @Router /api/v1/oncall/schedules/{id}/swaps [post]
+func (c *OnCallController) SwapShifts(ctx *gin.Context) {
+	id, ok := c.pathID(ctx, "id", "无效的值班表ID")
+	if !ok {
+		return
+	}
+	var req dto.SwapOnCallShiftsRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return
+	}
+	tenantID, userID, ok := c.identity(ctx)
+	if !ok {
+		return
+	}
+	list, err := c.service.SwapShifts(ctx.Request.Context(), tenantID, id, userID, &req)
+	if err != nil {
+		c.failOnCall(ctx, err)
+		return
+	}
+	common.Success(ctx, list)
+}
+
+// CreatePolicy 创建升级策略
+// @Summary 创建升级策略
+// @Description 每一步呼叫若干值班表的当前值班人或指定用户，确认超时后进入下一步，最后一步后按重复次数从头开始
+// @Tags 值班管理
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateEscalationPolicyRequest true "升级策略"
+// @Success 200 {object} common.Response{data=dto.EscalationPolicyResponse}
+// @Router /api/v1/oncall/policies [post]
+func (c *OnCallController) CreatePolicy(ctx *gin.Context) {
+	var req dto.CreateEscalationPolicyRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return
+	}
+	tenantID, userID, ok := c.identity(ctx)
+	if !ok {
+		return
+	}
+	resp, err := c.service.CreatePolicy(ctx.Request.Context(), tenantID, userID, &req)
+	if err != nil {
+		c.failOnCall(ctx, err)
+		return
+	}
+	common.Success(ctx, resp)
+}
+
+// ListPolicies 升级策略列表
+// @Summary 升级策略列表
+// @Tags 值班管理
+// @Produce json
+// @Param teamId query int false "团队ID"
+// @Success 200 {object} common.Response{data=[]dto.EscalationPolicyResponse}
+// @Router /api/v1/oncall/policies [get]
+func (c *OnCallController) ListPolicies(ctx *gin.Context) {
+	teamID, _ := strconv.Atoi(ctx.Query("teamId"))
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return
+	}
+	list, err := c.service.ListPolicies(ctx.Request.Context(), tenantID, teamID)
+	if err != nil {
+		c.failOnCall(ctx, err)
+		return
+	}
+	common.Success(ctx, list)
+}
+
+// GetPolicy 获取升级策略
+// @Summary 获取升级策略
+// @Tags 值班管理
+// @Produce json
+// @Param id path int true "升级策略ID"
+// @Success 200 {object} common.Response{data=dto.EscalationPolicyResponse}
+// @Router /api/v1/oncall/policies/{id} [get]
+func (c *OnCallController) GetPolicy(ctx *gin.Context) {
+	id, ok := c.pathID(ctx, "id", "无效的升级策略ID")
+	if !ok {
+		return
+	}
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return
+	}
+	resp, err := c.service.GetPolicy(ctx.Request.Context(), tenantID, id)
+	if err != nil {
+		c.failOnCall(ctx, err)
+		return
+	}
+	common.Success(ctx, resp)
+}
+
+// UpdatePolicy 修改升级策略
+// @Summary 修改升级策略
+// @Tags 值班管理
+// @Accept json
+// @Produce json
+// @Param id path int true "升级策略ID"
+// @Param request body dto.UpdateEscalationPolicyRequest true "修改内容"
+// @Success 200 {object} common.Response{data=dto.EscalationPolicyResponse}
+// @Router /api/v1/oncall/policies/{id} [put]
+func (c *OnCallController) UpdatePolicy(ctx *gin.Context) {
+	id, ok := c.pathID(ctx, "id", "无效的升级策略ID")
+	if !ok {
+		return
+	}
+	var req dto.UpdateEscalationPolicyRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return
+	}
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return
+	}
+	resp, err := c.service.UpdatePolicy(ctx.Request.Context(), tenantID, id, &req)
+	if err != nil {
+		c.failOnCall(ctx, err)
+		return
+	}
+	common.Success(ctx, resp)
+}
+
+// DeletePolicy 删除升级策略
+// @Summary 删除升级策略
+// @Description 删除后进行中的值班呼叫一并结束
+// @Tags 值班管理
+// @Produce json
+// @Param id path int true "升级策略ID"
+// @Success 200 {object} common.Response
+// @Router /api/v1/oncall/policies/{id} [delete]
+func (c *OnCallController) DeletePolicy(ctx *gin.Context) {
+	id, ok := c.pathID(ctx, "id", "无效的升级策略ID")
+	if !ok {
+		return
+	}
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return
+	}
+	if err := c.service.DeletePolicy(ctx.Request.Context(), tenantID, id); err != nil {
+		c.failOnCall(ctx, err)
+		return
+	}
+	common.Success(ctx, nil)
+}
+
+// TriggerPage 发起值班呼叫
+// @Summary 发起值班呼叫
+// @Description 按升级策略为事件呼叫值班人，确认超时后自动升级到下一步
+// @Tags 值班管理
+// @Accept json
+// @Produce json
+// @Param id path int true "事件ID"
+// @Param request body dto.TriggerOnCallPageRequest true "升级策略"
+// @Success 200 {object} common.Response{data=dto.OnCallPageResponse}
+// @Router /api/v1/incidents/{id}/oncall-pages [post]
+func (c *OnCallController) TriggerPage(ctx *gin.Context) {
+	id, ok := c.pathID(ctx, "id", "无效的事件ID")
+	if !ok {
+		return
+	}
+	var req dto.TriggerOnCallPageRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return
+	}
+	tenantID, userID, ok := c.identity(ctx)
+	if !ok {
+		return
+	}
+	resp, err := c.service.TriggerPage(ctx.Request.Context(), tenantID, id, req.PolicyID, userID)
+	if err != nil {
+		c.failOnCall(ctx, err)
+		return
+	}
+	common.Success(ctx, resp)
+}
+
+// ListPages 事件的值班呼叫
+// @Summary 事件的值班呼叫
+// @Tags 值班管理
+// @Produce json
+// @Param id path int true "事件ID"
+// @Success 200 {object} common.Response{data=[]dto.OnCallPageResponse}
+// @Router /api/v1/incidents/{id}/oncall-pages [get]
+func (c *OnCallController) ListPages(ctx *gin.Context) {
+	id, ok := c.pathID(ctx, "id", "无效的事件ID")
+	if !ok {
+		return
+	}
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return
+	}
+	list, err := c.service.ListPages(ctx.Request.Context(), tenantID, id)
+	if err != nil {
+		c.failOnCall(ctx, err)
+		return
+	}
+	common.Success(ctx, list)
+}
+
+// AcknowledgePage 确认值班呼叫
+// @Summary 确认值班呼叫
+// @Description 停止升级；事件处于可确认状态时一并确认事件
+// @Tags 值班管理
+// @Produce json
+// @Param id path int true "值班呼叫ID"
+// @Success 200 {object} common.Response{data=dto.OnCallPageResponse}
+// @Router /api/v1/oncall/pages/{id}/acknowledge [post]
+func (c *OnCallController) AcknowledgePage(ctx *gin.Context) {
+	id, ok := c.pathID(ctx, "id", "无效的值班呼叫ID")
+	if !ok {
+		return
+	}
+	tenantID, userID, ok := c.identity(ctx)
+	if !ok {
+		return
+	}
+	resp, err := c.service.AcknowledgePage(ctx.Request.Context(), tenantID, id, userID)
+	if err != nil {
+		c.failOnCall(ctx, err)
+		return
+	}
+	common.Success(ctx, resp)
+}
+
+// GetCalendarFeed 获取个人值班日历订阅地址
+// @Summary 个人值班日历订阅地址
+// @Description 返回带签名令牌的 iCal 订阅地址，可添加到 Outlook / Google / Apple 日历
+// @Tags 值班管理
+// @Produce json
+// @Success 200 {object} common.Response{data=dto.OnCallCalendarFeed}
+// @Router /api/v1/oncall/me/calendar-feed [get]
+func (c *OnCallController) GetCalendarFeed(ctx *gin.Context) {
+	tenantID, userID, ok := c.identity(ctx)
+	if !ok {
+		return
+	}
+	feed, err := c.service.CalendarFeed(tenantID, userID)
+	if err != nil {
+		common.InternalError(ctx, err.Error())
+		return
+	}
+	common.Success(ctx, feed)
+}
+
+// CalendarFeed 个人值班 iCal 订阅
+// @Summary 个人值班 iCal 订阅
+// @Description 公开访问，以签名令牌定位租户与用户，返回过去 7 天至未来 60 天的值班
+// @Tags 值班管理
+// @Produce plain
+// @Param token path string true "订阅令牌"
+// @Success 200 {string} string "text/calendar"
+// @Router /api/v1/oncall/calendar/{token} [get]
+func (c *OnCallController) CalendarFeed(ctx *gin.Context) {
+	tenantID, userID, err := c.service.VerifyCalendarFeedToken(ctx.Param("token"))
+	if err != nil {
+		ctx.String(http.StatusNotFound, "not found")
+		return
+	}
+	body, err := c.service.UserCalendar(ctx.Request.Context(), tenantID, userID)
+	if err != nil {
+		ctx.String(http.StatusNotFound, "not found")
+		return
+	}
+	ctx.Header("Cache-Control", "private, max-age=300")
+	ctx.Data(http.StatusOK, "text/calendar; charset=utf-8", []byte(body))
+}
+
+func (c *OnCallController) bindRange(ctx *gin.Context) (time.Time, time.Time, bool) {
+	var query dto.OnCallRangeQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return time.Time{}, time.Time{}, false
+	}
+	var from, to time.Time
+	if query.From != nil {
+		from = *query.From
+	}
+	if query.To != nil {
+		to = *query.To
+	}
+	if !from.IsZero() && !to.IsZero() && (!from.Before(to) || to.Sub(from) > maxOnCallRange) {
+		common.Fail(ctx, common.ParamErrorCode, "时间区间无效，最长 93 天")
+		return time.Time{}, time.Time{}, false
+	}
+	return from, to, true
+}
+
+func (c *OnCallController) pathID(ctx *gin.Context, name, message string) (int, bool) {
+	id, err := strconv.Atoi(ctx.Param(name))
+	if err != nil || id <= 0 {
+		common.Fail(ctx, common.ParamErrorCode, message)
+		return 0, false
+	}
+	return id, true
+}
+
+func (c *OnCallController) identity(ctx *gin.Context) (int, int, bool) {
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return 0, 0, false
+	}
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.AuthFailedCode, "获取用户ID失败")
+		return 0, 0, false
+	}
+	return tenantID, userID, true
+}
+
+func (c *OnCallController) failOnCall(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrOnCallScheduleNotFound),
+		errors.Is(err, service.ErrOnCallOverrideNotFound),
+		errors.Is(err, service.ErrEscalationPolicyNotFound),
+		errors.Is(err, service.ErrOnCallPageNotFound):
+		common.Fail(ctx, common.NotFoundCode, err.Error())
+	case errors.Is(err, service.ErrIncidentNotFound):
+		common.Fail(ctx, common.NotFoundCode, "事件不存在")
+	case errors.Is(err, service.ErrOnCallScheduleInUse),
+		errors.Is(err, service.ErrOnCallPageClosed):
+		common.Fail(ctx, common.ConflictCode, err.Error())
+	case errors.Is(err, service.ErrOnCallInvalid):
+		common.Fail(ctx, common.ParamErrorCode, err.Error())
+	default:
+		common.InternalError(ctx, err.Error())
+	}
+}
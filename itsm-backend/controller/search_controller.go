@@ -0,0 +1,79 @@
+package controller
+
+import (
+	"errors"
+	"strings"
+
+	"itsm-backend/common"
+	"itsm-backend/dto"
+	"itsm-backend/service"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// SearchController 全文检索控制器
+type SearchController struct {
+	searchService *service.FullTextSearchService
+	logger        *zap.SugaredLogger
+}
+
+// NewSearchController 创建全文检索控制器
+func NewSearchController(searchService *service.FullTextSearchService, logger *zap.SugaredLogger) *SearchController {
+	return &SearchController{
+		searchService: searchService,
+		logger:        logger,
+	}
+}
+
+// Search 跨工单、评论、知识文章与配置项的全文检索
+// GET /api/v1/search?q=&types=ticket,knowledge_article&limit=&offset=
+func (sc *SearchController) Search(c *gin.Context) {
+	var req dto.FullTextSearchRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		common.Fail(c, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return
+	}
+	req.Query = strings.TrimSpace(req.Query)
+
+	tenantID := c.GetInt("tenant_id")
+	userID := c.GetInt("user_id")
+	if tenantID == 0 || userID == 0 {
+		common.Fail(c, common.AuthFailedCode, "认证信息缺失")
+		return
+	}
+
+	result, err := sc.searchService.Search(c.Request.Context(), &req, service.SearchCaller{
+		TenantID: tenantID,
+		UserID:   userID,
+		Role:     c.GetString("role"),
+	})
+	if err != nil {
+		if errors.Is(err, service.ErrUnsupportedSearchType) {
+			common.Fail(c, common.ParamErrorCode, err.Error())
+			return
+		}
+		sc.logger.Errorw("Failed to search", "error", err, "tenant_id", tenantID)
+		common.Fail(c, common.InternalErrorCode, err.Error())
+		return
+	}
+	common.Success(c, result)
+}
+
+// Reindex 重建当前租户的检索索引
+// POST /api/v1/search/reindex
+func (sc *SearchController) Reindex(c *gin.Context) {
+	tenantID := c.GetInt("tenant_id")
+	if tenantID == 0 {
+		common.Fail(c, common.AuthFailedCode, "租户信息缺失")
+		return
+	}
+
+	indexed, err := sc.searchService.ReindexTenant(c.Request.Context(), tenantID)
+	if err != nil {
+		sc.logger.Errorw("Failed to rebuild search index", "error", err, "tenant_id", tenantID)
+		common.Fail(c, common.InternalErrorCode, err.Error())
+		return
+	}
+	common.Success(c, dto.FullTextReindexResponse{Indexed: indexed})
+}
@@ -11,6 +11,7 @@ import (
 	"itsm-backend/service"
 
 	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
 )
 
 // SLAPolicyController SLA策略控制器
@@ -19,9 +20,9 @@ type SLAPolicyController struct {
 }
 
 // NewSLAPolicyController 创建SLA策略控制器
-func NewSLAPolicyController(client *ent.Client) *SLAPolicyController {
+func NewSLAPolicyController(client *ent.Client, logger *zap.SugaredLogger) *SLAPolicyController {
 	return &SLAPolicyController{
-		service: service.NewSLAPolicyService(client),
+		service: service.NewSLAPolicyService(client, logger),
 	}
 }
 
@@ -504,6 +504,36 @@ func (tc *TicketController) SearchTickets(c *gin.Context) {
 	common.Success(c, ticketListToResponse(tickets))
 }
 
+// QueryTickets 按 TQL 查询工单
+// POST /api/v1/tickets/query
+func (tc *TicketController) QueryTickets(c *gin.Context) {
+	var req dto.TicketQueryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.Fail(c, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return
+	}
+
+	tenantID := c.GetInt("tenant_id")
+	userID := c.GetInt("user_id")
+
+	page, err := tc.ticketService.QueryTickets(c.Request.Context(), &req, tenantID, userID, c.GetString("role"))
+	if err != nil {
+		if service.IsTicketQueryError(err) {
+			common.Fail(c, common.ParamErrorCode, err.Error())
+			return
+		}
+		tc.logger.Errorw("Failed to query tickets", "error", err, "query", req.Query, "tenant_id", tenantID)
+		common.Fail(c, common.InternalErrorCode, err.Error())
+		return
+	}
+
+	common.Success(c, dto.TicketQueryResponse{
+		Tickets:    dto.ToTicketResponseList(page.Tickets),
+		NextCursor: page.NextCursor,
+		HasMore:    page.HasMore,
+	})
+}
+
 // GetOverdueTickets 获取逾期工单
 func (tc *TicketController) GetOverdueTickets(c *gin.Context) {
 	tenantID := c.GetInt("tenant_id")
@@ -567,14 +597,26 @@ func (tc *TicketController) ExportTickets(c *gin.Context) {
 	}
 
 	tenantID := c.GetInt("tenant_id")
+	userID := c.GetInt("user_id")
+	role := c.GetString("role")
 
 	// 实现导出功能
-	filters := map[string]interface{}{
-		"status":   req.Filters.Status,
-		"priority": req.Filters.Priority,
+	var data []byte
+	var err error
+	if strings.TrimSpace(req.Query) != "" {
+		data, err = tc.ticketService.ExportTicketsByQuery(c.Request.Context(), tenantID, userID, role, req.Query, req.Format)
+	} else {
+		filters := map[string]interface{}{
+			"status":   req.Filters.Status,
+			"priority": req.Filters.Priority,
+		}
+		data, err = tc.ticketService.ExportTickets(c.Request.Context(), tenantID, userID, role, filters, req.Format)
 	}
-	data, err := tc.ticketService.ExportTickets(c.Request.Context(), tenantID, filters, req.Format)
 	if err != nil {
+		if service.IsTicketQueryError(err) {
+			common.Fail(c, common.ParamErrorCode, err.Error())
+			return
+		}
 		tc.logger.Errorw("Export tickets failed", "error", err, "tenant_id", tenantID)
 		common.Fail(c, common.InternalErrorCode, "导出失败: "+err.Error())
 		return
@@ -1091,6 +1133,78 @@ func (tc *TicketController) DeleteSubtask(c *gin.Context) {
 	common.Success(c, gin.H{"message": "子任务删除成功"})
 }
 
+// MergeTickets 将重复工单合并到当前工单
+// POST /api/v1/tickets/:id/merge
+func (tc *TicketController) MergeTickets(c *gin.Context) {
+	primaryID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		common.Fail(c, common.ParamErrorCode, "无效的工单ID")
+		return
+	}
+
+	var req dto.MergeTicketsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.Fail(c, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return
+	}
+
+	tenantID := c.GetInt("tenant_id")
+	result, err := tc.ticketService.MergeTickets(c.Request.Context(), primaryID, &req, tenantID, c.GetInt("user_id"), c.GetString("role"))
+	if err != nil {
+		if isForbiddenErr(err) {
+			common.Fail(c, common.ForbiddenCode, err.Error())
+			return
+		}
+		if msg, ok := validationErrMessage(err); ok {
+			common.Fail(c, common.ParamErrorCode, msg)
+			return
+		}
+		tc.logger.Errorw("Failed to merge tickets", "error", err, "primary_id", primaryID, "tenant_id", tenantID)
+		common.Fail(c, common.InternalErrorCode, err.Error())
+		return
+	}
+
+	common.Success(c, result)
+}
+
+// SplitTicket 按所选评论或表单字段拆分出子工单
+// POST /api/v1/tickets/:id/split
+func (tc *TicketController) SplitTicket(c *gin.Context) {
+	sourceID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		common.Fail(c, common.ParamErrorCode, "无效的工单ID")
+		return
+	}
+
+	var req dto.SplitTicketRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		common.Fail(c, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return
+	}
+
+	tenantID := c.GetInt("tenant_id")
+	children, err := tc.ticketService.SplitTicket(c.Request.Context(), sourceID, &req, tenantID, c.GetInt("user_id"), c.GetString("role"))
+	if err != nil {
+		if isForbiddenErr(err) {
+			common.Fail(c, common.ForbiddenCode, err.Error())
+			return
+		}
+		if msg, ok := validationErrMessage(err); ok {
+			common.Fail(c, common.ParamErrorCode, msg)
+			return
+		}
+		tc.logger.Errorw("Failed to split ticket", "error", err, "source_id", sourceID, "tenant_id", tenantID)
+		common.Fail(c, common.InternalErrorCode, err.Error())
+		return
+	}
+
+	resp := dto.SplitTicketResponse{SourceID: sourceID, Children: make([]*dto.TicketResponse, len(children))}
+	for i, child := range children {
+		resp.Children[i] = ticketToResponse(child)
+	}
+	common.Success(c, resp)
+}
+
 // isForbiddenErr 判断错误是否为行级数据权限被拒绝（common.ForbiddenError）。
 // 命中时由调用方映射为 HTTP 403（common.ForbiddenCode），避免越权误报成 500。
 func isForbiddenErr(err error) bool {
@@ -1101,6 +1215,16 @@ func isForbiddenErr(err error) bool {
 	return false
 }
 
+// validationErrMessage 判断错误是否为参数校验失败（common.ValidationError），命中时返回可展示给用户的提示，
+// 由调用方映射为 common.ParamErrorCode。
+func validationErrMessage(err error) (string, bool) {
+	var appErr *common.AppError
+	if errors.As(err, &appErr) && appErr.Code == common.ErrCodeValidation {
+		return appErr.Message, true
+	}
+	return "", false
+}
+
 // isUserInputUpdateError 判断工单更新失败是否为用户输入错误（分类/标签/处理人不存在等），
 // 这种错误应返回 400 而不是 500。
 func isUserInputUpdateError(err error) bool {
@@ -0,0 +1,227 @@
+package controller
+
+import (
+	"strconv"
+
+	"itsm-backend/common"
+	"itsm-backend/dto"
+	"itsm-backend/ent"
+	"itsm-backend/middleware"
+	"itsm-backend/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TicketScheduleController 周期工单计划控制器：按 cron/RRULE 定期以模板或服务目录项生成工单（预防性维护）
+type TicketScheduleController struct {
+	service *service.TicketScheduleService
+}
+
+// NewTicketScheduleController 创建周期工单计划控制器
+func NewTicketScheduleController(scheduleService *service.TicketScheduleService) *TicketScheduleController {
+	return &TicketScheduleController{service: scheduleService}
+}
+
+// RegisterRoutes 注册路由
+func (c *TicketScheduleController) RegisterRoutes(r *gin.RouterGroup) {
+	schedules := r.Group("/ticket-schedules")
+	{
+		schedules.GET("", middleware.RequirePermission("template", "read"), c.ListSchedules)
+		schedules.POST("", middleware.RequirePermission("template", "create"), c.CreateSchedule)
+		schedules.POST("/preview", middleware.RequirePermission("template", "read"), c.PreviewSchedule)
+		schedules.GET("/:id", middleware.RequirePermission("template", "read"), c.GetSchedule)
+		schedules.PUT("/:id", middleware.RequirePermission("template", "update"), c.UpdateSchedule)
+		schedules.DELETE("/:id", middleware.RequirePermission("template", "delete"), c.DeleteSchedule)
+		schedules.GET("/:id/runs", middleware.RequirePermission("template", "read"), c.ListRuns)
+	}
+}
+
+// CreateSchedule 创建周期工单计划
+// @Summary 创建周期工单计划
+// @Tags 周期工单计划
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateTicketScheduleRequest true "周期工单计划"
+// @Success 200 {object} common.Response
+// @Router /api/v1/ticket-schedules [post]
+func (c *TicketScheduleController) CreateSchedule(ctx *gin.Context) {
+	var req dto.CreateTicketScheduleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return
+	}
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return
+	}
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.AuthFailedCode, "获取用户ID失败")
+		return
+	}
+
+	schedule, err := c.service.CreateSchedule(ctx.Request.Context(), &req, tenantID, userID)
+	if err != nil {
+		common.Fail(ctx, common.ParamErrorCode, err.Error())
+		return
+	}
+	common.Success(ctx, schedule)
+}
+
+// ListSchedules 获取周期工单计划列表
+// @Summary 获取周期工单计划列表
+// @Tags 周期工单计划
+// @Produce json
+// @Success 200 {object} common.Response
+// @Router /api/v1/ticket-schedules [get]
+func (c *TicketScheduleController) ListSchedules(ctx *gin.Context) {
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return
+	}
+	schedules, err := c.service.ListSchedules(ctx.Request.Context(), tenantID)
+	if err != nil {
+		common.InternalError(ctx, "获取周期工单计划失败: "+err.Error())
+		return
+	}
+	common.Success(ctx, schedules)
+}
+
+// PreviewSchedule 预览表达式接下来的发生时刻
+// @Summary 预览周期工单计划
+// @Tags 周期工单计划
+// @Accept json
+// @Produce json
+// @Param request body dto.TicketSchedulePreviewRequest true "周期表达式"
+// @Success 200 {object} common.Response
+// @Router /api/v1/ticket-schedules/preview [post]
+func (c *TicketScheduleController) PreviewSchedule(ctx *gin.Context) {
+	var req dto.TicketSchedulePreviewRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return
+	}
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return
+	}
+	occurrences, err := c.service.Preview(ctx.Request.Context(), tenantID, &req)
+	if err != nil {
+		common.Fail(ctx, common.ParamErrorCode, err.Error())
+		return
+	}
+	common.Success(ctx, occurrences)
+}
+
+// GetSchedule 获取周期工单计划
+// @Summary 获取周期工单计划
+// @Tags 周期工单计划
+// @Produce json
+// @Param id path int true "计划ID"
+// @Success 200 {object} common.Response
+// @Router /api/v1/ticket-schedules/{id} [get]
+func (c *TicketScheduleController) GetSchedule(ctx *gin.Context) {
+	id, tenantID, ok := c.scheduleParams(ctx)
+	if !ok {
+		return
+	}
+	schedule, err := c.service.GetSchedule(ctx.Request.Context(), tenantID, id)
+	if err != nil {
+		c.failSchedule(ctx, err)
+		return
+	}
+	common.Success(ctx, schedule)
+}
+
+// UpdateSchedule 更新周期工单计划
+// @Summary 更新周期工单计划
+// @Tags 周期工单计划
+// @Accept json
+// @Produce json
+// @Param id path int true "计划ID"
+// @Param request body dto.UpdateTicketScheduleRequest true "周期工单计划"
+// @Success 200 {object} common.Response
+// @Router /api/v1/ticket-schedules/{id} [put]
+func (c *TicketScheduleController) UpdateSchedule(ctx *gin.Context) {
+	id, tenantID, ok := c.scheduleParams(ctx)
+	if !ok {
+		return
+	}
+	var req dto.UpdateTicketScheduleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return
+	}
+	schedule, err := c.service.UpdateSchedule(ctx.Request.Context(), tenantID, id, &req)
+	if err != nil {
+		c.failSchedule(ctx, err)
+		return
+	}
+	common.Success(ctx, schedule)
+}
+
+// DeleteSchedule 删除周期工单计划
+// @Summary 删除周期工单计划
+// @Tags 周期工单计划
+// @Produce json
+// @Param id path int true "计划ID"
+// @Success 200 {object} common.Response
+// @Router /api/v1/ticket-schedules/{id} [delete]
+func (c *TicketScheduleController) DeleteSchedule(ctx *gin.Context) {
+	id, tenantID, ok := c.scheduleParams(ctx)
+	if !ok {
+		return
+	}
+	if err := c.service.DeleteSchedule(ctx.Request.Context(), tenantID, id); err != nil {
+		c.failSchedule(ctx, err)
+		return
+	}
+	common.SuccessWithMessage(ctx, "周期工单计划已删除", nil)
+}
+
+// ListRuns 获取周期工单计划的执行记录
+// @Summary 获取周期工单计划执行记录
+// @Tags 周期工单计划
+// @Produce json
+// @Param id path int true "计划ID"
+// @Param limit query int false "返回条数，默认 50，最大 200"
+// @Success 200 {object} common.Response
+// @Router /api/v1/ticket-schedules/{id}/runs [get]
+func (c *TicketScheduleController) ListRuns(ctx *gin.Context) {
+	id, tenantID, ok := c.scheduleParams(ctx)
+	if !ok {
+		return
+	}
+	limit, _ := strconv.Atoi(ctx.Query("limit"))
+	runs, err := c.service.ListRuns(ctx.Request.Context(), tenantID, id, limit)
+	if err != nil {
+		c.failSchedule(ctx, err)
+		return
+	}
+	common.Success(ctx, runs)
+}
+
+func (c *TicketScheduleController) scheduleParams(ctx *gin.Context) (int, int, bool) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || id <= 0 {
+		common.Fail(ctx, common.ParamErrorCode, "无效的计划ID")
+		return 0, 0, false
+	}
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return 0, 0, false
+	}
+	return id, tenantID, true
+}
+
+func (c *TicketScheduleController) failSchedule(ctx *gin.Context, err error) {
+	if ent.IsNotFound(err) {
+		common.Fail(ctx, common.NotFoundCode, "周期工单计划不存在")
+		return
+	}
+	common.Fail(ctx, common.ParamErrorCode, err.Error())
+}
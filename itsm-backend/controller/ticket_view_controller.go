@@ -5,6 +5,7 @@ import (
 
 	"itsm-backend/common"
 	"itsm-backend/dto"
+	"itsm-backend/ent"
 	"itsm-backend/service"
 
 	"github.com/gin-gonic/gin"
@@ -189,3 +190,42 @@ func (tvc *TicketViewController) ShareTicketView(c *gin.Context) {
 
 	common.Success(c, nil)
 }
+
+// RunTicketView 执行工单视图，返回视图队列中的一页工单
+// GET /api/v1/tickets/views/:id/tickets?cursor=&limit=
+func (tvc *TicketViewController) RunTicketView(c *gin.Context) {
+	viewID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		common.Fail(c, common.ParamErrorCode, "无效的视图ID")
+		return
+	}
+
+	tenantID := c.GetInt("tenant_id")
+	userID := c.GetInt("user_id")
+	if tenantID == 0 || userID == 0 {
+		common.Fail(c, common.AuthFailedCode, "认证信息缺失")
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	page, err := tvc.viewService.RunTicketView(c.Request.Context(), viewID, userID, tenantID, c.GetString("role"), c.Query("cursor"), limit)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			common.Fail(c, common.NotFoundCode, "工单视图不存在")
+			return
+		}
+		if service.IsTicketQueryError(err) {
+			common.Fail(c, common.ParamErrorCode, err.Error())
+			return
+		}
+		tvc.logger.Errorw("Failed to run ticket view", "error", err, "view_id", viewID, "tenant_id", tenantID)
+		common.Fail(c, common.InternalErrorCode, err.Error())
+		return
+	}
+
+	common.Success(c, dto.TicketQueryResponse{
+		Tickets:    dto.ToTicketResponseList(page.Tickets),
+		NextCursor: page.NextCursor,
+		HasMore:    page.HasMore,
+	})
+}
@@ -0,0 +1,351 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"itsm-backend/common"
+	"itsm-backend/dto"
+	"itsm-backend/ent"
+	"itsm-backend/middleware"
+	"itsm-backend/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// WorkLogController 工时控制器：工单、变更、问题上的工时登记、计时器与汇总导出
+type WorkLogController struct {
+	service *service.WorkLogService
+}
+
+// NewWorkLogController 创建工时控制器
+func NewWorkLogController(workLogService *service.WorkLogService) *WorkLogController {
+	return &WorkLogController{service: workLogService}
+}
+
+// RegisterRoutes 注册路由
+func (c *WorkLogController) RegisterRoutes(r *gin.RouterGroup) {
+	worklogs := r.Group("/worklogs")
+	{
+		// 工时记录含内部备注、计费标记与其他员工的工时，仅对支持人员开放
+		worklogs.GET("", middleware.RequirePermission("ticket", "write"), c.ListWorkLogs)
+		worklogs.POST("", middleware.RequirePermission("ticket", "write"), c.LogTime)
+		worklogs.PUT("/:id", middleware.RequirePermission("ticket", "write"), c.UpdateWorkLog)
+		worklogs.DELETE("/:id", middleware.RequirePermission("ticket", "write"), c.DeleteWorkLog)
+		worklogs.GET("/timer", middleware.RequirePermission("ticket", "read"), c.GetTimer)
+		worklogs.POST("/timer/start", middleware.RequirePermission("ticket", "write"), c.StartTimer)
+		worklogs.POST("/timer/stop", middleware.RequirePermission("ticket", "write"), c.StopTimer)
+		worklogs.GET("/report", middleware.RequirePermission("report", "read"), c.GetReport)
+		worklogs.GET("/report/export", middleware.RequirePermission("report", "read"), c.ExportReport)
+	}
+}
+
+// LogTime 手工登记工时
+// @Summary 登记工时
+// @Tags 工时
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateWorkLogRequest true "工时"
+// @Success 200 {object} common.Response
+// @Router /api/v1/worklogs [post]
+func (c *WorkLogController) LogTime(ctx *gin.Context) {
+	var req dto.CreateWorkLogRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return
+	}
+	tenantID, userID, ok := c.identity(ctx)
+	if !ok {
+		return
+	}
+	entry, err := c.service.LogTime(ctx.Request.Context(), tenantID, userID, &req)
+	if err != nil {
+		c.failWorkLog(ctx, err)
+		return
+	}
+	common.Success(ctx, entry)
+}
+
+// ListWorkLogs 查询工时记录
+// @Summary 工时记录列表
+// @Tags 工时
+// @Produce json
+// @Param ticket_id query int false "工单ID"
+// @Param change_id query int false "变更ID"
+// @Param problem_id query int false "问题ID"
+// @Param user_id query int false "用户ID"
+// @Param from query string false "开始时间下限 (RFC3339)"
+// @Param to query string false "开始时间上限 (RFC3339)"
+// @Param billable query bool false "是否可计费"
+// @Param limit query int false "返回条数，默认 100，最大 500"
+// @Success 200 {object} common.Response
+// @Router /api/v1/worklogs [get]
+func (c *WorkLogController) ListWorkLogs(ctx *gin.Context) {
+	var filter dto.WorkLogListFilter
+	if err := ctx.ShouldBindQuery(&filter); err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return
+	}
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return
+	}
+	entries, err := c.service.ListWorkLogs(ctx.Request.Context(), tenantID, &filter)
+	if err != nil {
+		common.InternalError(ctx, "获取工时记录失败: "+err.Error())
+		return
+	}
+	common.Success(ctx, entries)
+}
+
+// UpdateWorkLog 修改工时记录
+// @Summary 修改工时记录
+// @Tags 工时
+// @Accept json
+// @Produce json
+// @Param id path int true "工时记录ID"
+// @Param request body dto.UpdateWorkLogRequest true "工时"
+// @Success 200 {object} common.Response
+// @Router /api/v1/worklogs/{id} [put]
+func (c *WorkLogController) UpdateWorkLog(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || id <= 0 {
+		common.Fail(ctx, common.ParamErrorCode, "无效的工时记录ID")
+		return
+	}
+	var req dto.UpdateWorkLogRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return
+	}
+	tenantID, userID, ok := c.identity(ctx)
+	if !ok {
+		return
+	}
+	entry, err := c.service.UpdateWorkLog(ctx.Request.Context(), tenantID, userID, id, &req)
+	if err != nil {
+		c.failWorkLog(ctx, err)
+		return
+	}
+	common.Success(ctx, entry)
+}
+
+// DeleteWorkLog 删除工时记录
+// @Summary 删除工时记录
+// @Tags 工时
+// @Produce json
+// @Param id path int true "工时记录ID"
+// @Success 200 {object} common.Response
+// @Router /api/v1/worklogs/{id} [delete]
+func (c *WorkLogController) DeleteWorkLog(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || id <= 0 {
+		common.Fail(ctx, common.ParamErrorCode, "无效的工时记录ID")
+		return
+	}
+	tenantID, userID, ok := c.identity(ctx)
+	if !ok {
+		return
+	}
+	if err := c.service.DeleteWorkLog(ctx.Request.Context(), tenantID, userID, id); err != nil {
+		c.failWorkLog(ctx, err)
+		return
+	}
+	common.SuccessWithMessage(ctx, "工时记录已删除", nil)
+}
+
+// GetTimer 获取当前用户运行中的计时器
+// @Summary 当前计时器
+// @Tags 工时
+// @Produce json
+// @Success 200 {object} common.Response
+// @Router /api/v1/worklogs/timer [get]
+func (c *WorkLogController) GetTimer(ctx *gin.Context) {
+	tenantID, userID, ok := c.identity(ctx)
+	if !ok {
+		return
+	}
+	entry, err := c.service.RunningTimer(ctx.Request.Context(), tenantID, userID)
+	if err != nil {
+		common.InternalError(ctx, err.Error())
+		return
+	}
+	common.Success(ctx, entry)
+}
+
+// StartTimer 开始计时
+// @Summary 开始计时
+// @Tags 工时
+// @Accept json
+// @Produce json
+// @Param request body dto.StartWorkLogTimerRequest true "计时对象"
+// @Success 200 {object} common.Response
+// @Router /api/v1/worklogs/timer/start [post]
+func (c *WorkLogController) StartTimer(ctx *gin.Context) {
+	var req dto.StartWorkLogTimerRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return
+	}
+	tenantID, userID, ok := c.identity(ctx)
+	if !ok {
+		return
+	}
+	entry, err := c.service.StartTimer(ctx.Request.Context(), tenantID, userID, &req)
+	if err != nil {
+		c.failWorkLog(ctx, err)
+		return
+	}
+	common.Success(ctx, entry)
+}
+
+// StopTimer 停止计时并写入工时
+// @Summary 停止计时
+// @Tags 工时
+// @Accept json
+// @Produce json
+// @Param request body dto.StopWorkLogTimerRequest false "停止时补充的信息"
+// @Success 200 {object} common.Response
+// @Router /api/v1/worklogs/timer/stop [post]
+func (c *WorkLogController) StopTimer(ctx *gin.Context) {
+	var req dto.StopWorkLogTimerRequest
+	if ctx.Request.ContentLength > 0 {
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			common.Fail(ctx, common.ParamErrorCode, "请求参数错误: "+err.Error())
+			return
+		}
+	}
+	tenantID, userID, ok := c.identity(ctx)
+	if !ok {
+		return
+	}
+	entry, err := c.service.StopTimer(ctx.Request.Context(), tenantID, userID, &req)
+	if err != nil {
+		c.failWorkLog(ctx, err)
+		return
+	}
+	common.Success(ctx, entry)
+}
+
+// GetReport 工时汇总
+// @Summary 工时汇总
+// @Description 按用户、团队、客户租户、分类或活动类型汇总已结束的工时；MSP 服务商的统计范围包含其托管的客户工单
+// @Tags 工时
+// @Produce json
+// @Param group_by query string false "分组: user/team/customer/category/activity_type，默认 user"
+// @Param from query string false "开始时间下限 (RFC3339)"
+// @Param to query string false "开始时间上限 (RFC3339)"
+// @Param billable query bool false "是否可计费"
+// @Param msp_only query bool false "仅统计 MSP 托管工单"
+// @Success 200 {object} common.Response
+// @Router /api/v1/worklogs/report [get]
+func (c *WorkLogController) GetReport(ctx *gin.Context) {
+	var req dto.WorkLogReportRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return
+	}
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return
+	}
+	report, err := c.service.Report(ctx.Request.Context(), tenantID, &req)
+	if err != nil {
+		c.failWorkLog(ctx, err)
+		return
+	}
+	common.Success(ctx, report)
+}
+
+// ExportReport 导出工时汇总
+// @Summary 导出工时汇总
+// @Tags 工时
+// @Produce application/octet-stream
+// @Param format query string false "导出格式: excel/pdf，默认 excel"
+// @Param group_by query string false "分组: user/team/customer/category/activity_type，默认 user"
+// @Param from query string false "开始时间下限 (RFC3339)"
+// @Param to query string false "开始时间上限 (RFC3339)"
+// @Param billable query bool false "是否可计费"
+// @Param msp_only query bool false "仅统计 MSP 托管工单"
+// @Success 200 {file} file
+// @Router /api/v1/worklogs/report/export [get]
+func (c *WorkLogController) ExportReport(ctx *gin.Context) {
+	var req dto.WorkLogReportRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		common.Fail(ctx, common.ParamErrorCode, "请求参数错误: "+err.Error())
+		return
+	}
+	format := ctx.DefaultQuery("format", "excel")
+	if format != "excel" && format != "pdf" {
+		common.Fail(ctx, common.ParamErrorCode, "不支持的导出格式，支持: excel, pdf")
+		return
+	}
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return
+	}
+
+	exportCtx, cancel := context.WithTimeout(ctx.Request.Context(), 60*time.Second)
+	defer cancel()
+	data, filename, err := c.service.ExportReport(exportCtx, tenantID, &req, format)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "导出工时汇总失败")
+		return
+	}
+
+	contentType := "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	if format == "pdf" {
+		contentType = "application/pdf"
+	}
+	ctx.Header("Content-Type", contentType)
+	ctx.Header("Content-Disposition", "attachment; filename="+filename)
+	ctx.Data(200, contentType, data)
+}
+
+func (c *WorkLogController) identity(ctx *gin.Context) (int, int, bool) {
+	tenantID, err := middleware.GetTenantID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.InternalErrorCode, "获取租户ID失败")
+		return 0, 0, false
+	}
+	userID, err := middleware.GetUserID(ctx)
+	if err != nil {
+		common.Fail(ctx, common.AuthFailedCode, "获取用户ID失败")
+		return 0, 0, false
+	}
+	return tenantID, userID, true
+}
+
+func (c *WorkLogController) failWorkLog(ctx *gin.Context, err error) {
+	var appErr *common.AppError
+	if errors.As(err, &appErr) {
+		switch appErr.Code {
+		case common.ErrCodeValidation:
+			common.Fail(ctx, common.ParamErrorCode, appErr.Message)
+			return
+		case common.ErrCodeNotFound:
+			common.Fail(ctx, common.NotFoundCode, appErr.Message)
+			return
+		case common.ErrCodeForbidden:
+			common.Fail(ctx, common.ForbiddenCode, appErr.Message)
+			return
+		}
+	}
+	switch {
+	case ent.IsNotFound(err):
+		common.Fail(ctx, common.NotFoundCode, "工时记录不存在")
+	case errors.Is(err, service.ErrWorkLogNoTimer):
+		common.Fail(ctx, common.NotFoundCode, err.Error())
+	case errors.Is(err, service.ErrWorkLogTimerRunning):
+		common.Fail(ctx, common.ConflictCode, err.Error())
+	case errors.Is(err, service.ErrWorkLogForbidden):
+		common.Fail(ctx, common.ForbiddenCode, err.Error())
+	default:
+		common.InternalError(ctx, err.Error())
+	}
+}
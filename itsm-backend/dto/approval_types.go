@@ -17,6 +17,7 @@ const (
 	ApprovalNodeTypeProjectManager ApprovalNodeType = "project_manager"
 	ApprovalNodeTypeTempTeamLeader ApprovalNodeType = "temp_team_leader"
 	ApprovalNodeTypeAmountBased    ApprovalNodeType = "amount_based"
+	ApprovalNodeTypeOnCall         ApprovalNodeType = "oncall" // 值班表当前值班人，assigneeValue 为值班表ID
 )
 
 // ApprovalMode 审批模式枚举
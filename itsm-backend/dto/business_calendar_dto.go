@@ -0,0 +1,41 @@
+package dto
+
+import (
+	"itsm-backend/ent/schema"
+)
+
+// CreateBusinessCalendarRequest 创建工作日历请求
+type CreateBusinessCalendarRequest struct {
+	Name          string                      `json:"name" binding:"required"`
+	Description   string                      `json:"description"`
+	TimeZone      string                      `json:"timeZone" example:"Asia/Shanghai"`
+	WorkWindows   []schema.CalendarWorkWindow `json:"workWindows" binding:"required"`
+	Holidays      []schema.CalendarDay        `json:"holidays"`
+	ExtraWorkdays []schema.CalendarDay        `json:"extraWorkdays"`
+	IsDefault     bool                        `json:"isDefault"`
+	TenantID      int                         `json:"-"`
+}
+
+// UpdateBusinessCalendarRequest 更新工作日历请求
+type UpdateBusinessCalendarRequest struct {
+	Name          *string                      `json:"name,omitempty"`
+	Description   *string                      `json:"description,omitempty"`
+	TimeZone      *string                      `json:"timeZone,omitempty"`
+	WorkWindows   *[]schema.CalendarWorkWindow `json:"workWindows,omitempty"`
+	Holidays      *[]schema.CalendarDay        `json:"holidays,omitempty"`
+	ExtraWorkdays *[]schema.CalendarDay        `json:"extraWorkdays,omitempty"`
+	IsDefault     *bool                        `json:"isDefault,omitempty"`
+}
+
+// AssignBusinessCalendarRequest 为 SLA 策略、SLA 定义或团队指定工作日历，CalendarID 为空表示解除
+type AssignBusinessCalendarRequest struct {
+	TargetType string `json:"targetType" binding:"required,oneof=sla_policy sla_definition team"`
+	TargetID   int    `json:"targetId" binding:"required"`
+	CalendarID *int   `json:"calendarId"`
+}
+
+// BusinessCalendarImportResult iCalendar 导入结果
+type BusinessCalendarImportResult struct {
+	HolidaysImported      int `json:"holidaysImported"`
+	ExtraWorkdaysImported int `json:"extraWorkdaysImported"`
+}
@@ -0,0 +1,6 @@
+package dto
+
+// ReplayInboundMessageRequest 重放入站消息，handlers 为空时重放全部已注册处理器
+type ReplayInboundMessageRequest struct {
+	Handlers []string `json:"handlers,omitempty" binding:"omitempty,max=20,dive,required,max=100"`
+}
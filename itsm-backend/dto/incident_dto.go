@@ -87,9 +87,11 @@ type UpdateIncidentRequest struct {
 	Force           bool                   `json:"force"`   // 是否强制更新（忽略版本检查）
 }
 
-// AssignIncidentRequest 分配事件请求
+// AssignIncidentRequest 分配事件请求，assigneeId 与 onCallScheduleId 二选一；
+// 指定值班表时分配给该值班表当前的值班人
 type AssignIncidentRequest struct {
-	AssigneeID int `json:"assigneeId" binding:"required"`
+	AssigneeID       int `json:"assigneeId" binding:"omitempty,min=1"`
+	OnCallScheduleID int `json:"onCallScheduleId,omitempty" binding:"omitempty,min=1"`
 }
 
 // EscalateMajorIncidentRequest 升级为重大事件请求
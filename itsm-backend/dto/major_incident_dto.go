@@ -0,0 +1,83 @@
+package dto
+
+import "time"
+
+// StakeholderChannel 干系人外部通报通道，Channel 的含义由连接器决定（open_id、群机器人 webhook 等）
+type StakeholderChannel struct {
+	Connector string `json:"connector" binding:"required"`
+	Channel   string `json:"channel" binding:"required"`
+}
+
+// WarRoomRequest 作战室群聊参数。IM 成员 ID 为平台内用户标识；
+// 使用飞书时指挥官与干系人已绑定的 open_id 会自动拉入，群主默认为指挥官
+type WarRoomRequest struct {
+	Connector   string   `json:"connector" binding:"required,oneof=feishu dingtalk wecom"`
+	Name        string   `json:"name" binding:"max=60"`
+	OwnerIMID   string   `json:"ownerImId"`
+	MemberIMIDs []string `json:"memberImIds"`
+}
+
+// DeclareMajorIncidentRequest 宣告重大事件请求。事件尚未升级为重大事件时按影响信息一并升级
+type DeclareMajorIncidentRequest struct {
+	EscalateMajorIncidentRequest
+	CommanderID          int                  `json:"commanderId" binding:"required,min=1"`
+	StakeholderUserIDs   []int                `json:"stakeholderUserIds"`
+	StakeholderChannels  []StakeholderChannel `json:"stakeholderChannels" binding:"omitempty,dive"`
+	UpdateCadenceMinutes int                  `json:"updateCadenceMinutes" binding:"omitempty,min=5,max=1440"` // 默认 30
+	WarRoom              *WarRoomRequest      `json:"warRoom,omitempty"`
+}
+
+// UpdateMajorIncidentRequest 调整指挥官、干系人与通报节奏，未传字段保持不变
+type UpdateMajorIncidentRequest struct {
+	CommanderID          *int                  `json:"commanderId,omitempty" binding:"omitempty,min=1"`
+	StakeholderUserIDs   *[]int                `json:"stakeholderUserIds,omitempty"`
+	StakeholderChannels  *[]StakeholderChannel `json:"stakeholderChannels,omitempty" binding:"omitempty,dive"`
+	UpdateCadenceMinutes *int                  `json:"updateCadenceMinutes,omitempty" binding:"omitempty,min=5,max=1440"`
+}
+
+// PostStakeholderUpdateRequest 手工发布一次干系人进展通报
+type PostStakeholderUpdateRequest struct {
+	Message string `json:"message" binding:"required,max=4000"`
+}
+
+// MajorIncidentListFilter 重大事件查询条件
+type MajorIncidentListFilter struct {
+	Status string `form:"status" binding:"omitempty,oneof=active resolved"`
+}
+
+// MajorIncidentResponse 重大事件
+type MajorIncidentResponse struct {
+	ID                    int                  `json:"id"`
+	IncidentID            int                  `json:"incidentId"`
+	IncidentNumber        string               `json:"incidentNumber,omitempty"`
+	IncidentTitle         string               `json:"incidentTitle,omitempty"`
+	CommanderID           int                  `json:"commanderId"`
+	CommanderName         string               `json:"commanderName,omitempty"`
+	DeclaredBy            int                  `json:"declaredBy"`
+	DeclaredAt            time.Time            `json:"declaredAt"`
+	Status                string               `json:"status"`
+	WarRoomConnector      string               `json:"warRoomConnector,omitempty"`
+	WarRoomChatID         string               `json:"warRoomChatId,omitempty"`
+	WarRoomError          string               `json:"warRoomError,omitempty"`
+	StakeholderUserIDs    []int                `json:"stakeholderUserIds"`
+	StakeholderChannels   []StakeholderChannel `json:"stakeholderChannels"`
+	UpdateCadenceMinutes  int                  `json:"updateCadenceMinutes"`
+	NextUpdateAt          *time.Time           `json:"nextUpdateAt,omitempty"`
+	LastUpdateAt          *time.Time           `json:"lastUpdateAt,omitempty"`
+	UpdateCount           int                  `json:"updateCount"`
+	ResolvedAt            *time.Time           `json:"resolvedAt,omitempty"`
+	PostmortemDraft       string               `json:"postmortemDraft,omitempty"`
+	PostmortemGeneratedAt *time.Time           `json:"postmortemGeneratedAt,omitempty"`
+}
+
+// IncidentTimelineEntry 事件时间线条目，汇总活动记录、评论与状态流转
+type IncidentTimelineEntry struct {
+	At          time.Time              `json:"at"`
+	Kind        string                 `json:"kind"` // 活动类型：created / comment / status_change / resolution / stakeholder_update ...
+	Title       string                 `json:"title"`
+	Description string                 `json:"description,omitempty"`
+	UserID      *int                   `json:"userId,omitempty"`
+	UserName    string                 `json:"userName,omitempty"`
+	Source      string                 `json:"source,omitempty"`
+	Data        map[string]interface{} `json:"data,omitempty"`
+}
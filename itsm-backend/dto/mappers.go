@@ -269,6 +269,8 @@ func ToSLADefinitionResponse(sla *ent.SLADefinition) *SLADefinitionResponse {
 		ResponseTime:    sla.ResponseTime,
 		ResolutionTime:  sla.ResolutionTime,
 		BusinessHours:   sla.BusinessHours,
+		CalendarID:      sla.CalendarID,
+		PauseConditions: sla.PauseConditions,
 		EscalationRules: sla.EscalationRules,
 		Conditions:      sla.Conditions,
 		IsActive:        sla.IsActive,
@@ -694,9 +696,14 @@ func ToSLAPolicyResponse(policy *ent.SLAPolicy) *SLAPolicyResponse {
 		CustomerTier:          policy.CustomerTier,
 		TicketType:            policy.TicketType,
 		Priority:              policy.Priority,
+		AgreementType:         policy.AgreementType,
+		TeamID:                policy.TeamID,
+		VendorID:              policy.VendorID,
+		Metrics:               policy.Metrics,
 		ResponseTimeMinutes:   policy.ResponseTimeMinutes,
 		ResolutionTimeMinutes: policy.ResolutionTimeMinutes,
 		BusinessHours:         policy.BusinessHours,
+		CalendarID:            policy.CalendarID,
 		ExcludeWeekends:       policy.ExcludeWeekends,
 		ExcludeHolidays:       policy.ExcludeHolidays,
 		IsActive:              policy.IsActive,
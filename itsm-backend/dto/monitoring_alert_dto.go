@@ -0,0 +1,75 @@
+package dto
+
+import (
+	"time"
+
+	"itsm-backend/ent/schema"
+)
+
+// MonitoringAlertIngestItem 单条告警的处理结果
+type MonitoringAlertIngestItem struct {
+	Fingerprint string `json:"fingerprint"`
+	Status      string `json:"status"` // firing / resolved
+	// Action 处理动作：created 新建事件 / updated 更新已有事件 / reopened 重新打开已解决事件 /
+	// correlated 归并到已有事件 / suppressed 命中屏蔽规则 / resolved 告警恢复并自动解决事件 /
+	// annotated 告警恢复仅记录到事件时间线 / ignored 未知告警的恢复通知 / failed 处理失败
+	Action     string `json:"action"`
+	IncidentID int    `json:"incidentId,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// MonitoringAlertIngestResult 一次推送的处理结果
+type MonitoringAlertIngestResult struct {
+	Received int                         `json:"received"`
+	Items    []MonitoringAlertIngestItem `json:"items"`
+}
+
+// CreateAlertSuppressionRuleRequest 创建告警屏蔽规则
+type CreateAlertSuppressionRuleRequest struct {
+	Name        string                `json:"name" binding:"required,max=100"`
+	Description string                `json:"description" binding:"max=2000"`
+	Source      string                `json:"source" binding:"omitempty,oneof=alertmanager zabbix grafana"`
+	Matchers    []schema.AlertMatcher `json:"matchers" binding:"required,min=1"`
+	StartsAt    *time.Time            `json:"startsAt,omitempty"`
+	EndsAt      *time.Time            `json:"endsAt,omitempty"`
+	Enabled     *bool                 `json:"enabled,omitempty"` // 为空时默认启用
+}
+
+// UpdateAlertSuppressionRuleRequest 修改告警屏蔽规则，未传字段保持不变
+type UpdateAlertSuppressionRuleRequest struct {
+	Name        *string                `json:"name,omitempty" binding:"omitempty,max=100"`
+	Description *string                `json:"description,omitempty" binding:"omitempty,max=2000"`
+	Source      *string                `json:"source,omitempty" binding:"omitempty,oneof=alertmanager zabbix grafana"`
+	Matchers    *[]schema.AlertMatcher `json:"matchers,omitempty"`
+	StartsAt    *time.Time             `json:"startsAt,omitempty"`
+	EndsAt      *time.Time             `json:"endsAt,omitempty"`
+	ClearWindow bool                   `json:"clearWindow,omitempty"` // 清空生效窗口，改为长期有效
+	Enabled     *bool                  `json:"enabled,omitempty"`
+}
+
+// AlertNoiseReportQuery 降噪统计查询条件，默认最近 7 天
+type AlertNoiseReportQuery struct {
+	From *time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To   *time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+}
+
+// AlertNoiseStats 单个告警源的降噪统计
+type AlertNoiseStats struct {
+	Source          string `json:"source"`
+	Alerts          int    `json:"alerts"`          // 去重后的告警数
+	Notifications   int    `json:"notifications"`   // 收到的推送次数
+	IncidentsOpened int    `json:"incidentsOpened"` // 开启的事件数
+	Correlated      int    `json:"correlated"`      // 归并到已有事件的次数
+	Suppressed      int    `json:"suppressed"`      // 被屏蔽规则拦截的推送次数
+	Flapping        int    `json:"flapping"`        // 当前处于抖动中的告警数
+	// NoiseReductionRatio 降噪率 = 1 - 开启事件数 / 推送次数
+	NoiseReductionRatio float64 `json:"noiseReductionRatio"`
+}
+
+// AlertNoiseReport 降噪统计报表，按最近接收时间落在区间内的告警汇总
+type AlertNoiseReport struct {
+	From    time.Time         `json:"from"`
+	To      time.Time         `json:"to"`
+	Sources []AlertNoiseStats `json:"sources"`
+	Total   AlertNoiseStats   `json:"total"`
+}
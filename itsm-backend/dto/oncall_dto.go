@@ -0,0 +1,169 @@
+package dto
+
+import (
+	"time"
+
+	"itsm-backend/ent/schema"
+)
+
+// CreateOnCallScheduleRequest 创建值班表
+type CreateOnCallScheduleRequest struct {
+	Name        string               `json:"name" binding:"required,max=100"`
+	Description string               `json:"description" binding:"max=2000"`
+	TeamID      *int                 `json:"teamId,omitempty" binding:"omitempty,min=1"`
+	TimeZone    string               `json:"timeZone"` // 默认 Asia/Shanghai
+	Layers      []schema.OnCallLayer `json:"layers" binding:"required,min=1"`
+	Enabled     *bool                `json:"enabled,omitempty"` // 为空时默认启用
+}
+
+// UpdateOnCallScheduleRequest 修改值班表，未传字段保持不变
+type UpdateOnCallScheduleRequest struct {
+	Name        *string               `json:"name,omitempty" binding:"omitempty,max=100"`
+	Description *string               `json:"description,omitempty" binding:"omitempty,max=2000"`
+	TeamID      *int                  `json:"teamId,omitempty" binding:"omitempty,min=0"` // 传 0 解除团队归属
+	TimeZone    *string               `json:"timeZone,omitempty"`
+	Layers      *[]schema.OnCallLayer `json:"layers,omitempty"`
+	Enabled     *bool                 `json:"enabled,omitempty"`
+}
+
+// OnCallScheduleResponse 值班表
+type OnCallScheduleResponse struct {
+	ID          int                  `json:"id"`
+	Name        string               `json:"name"`
+	Description string               `json:"description,omitempty"`
+	TeamID      *int                 `json:"teamId,omitempty"`
+	TimeZone    string               `json:"timeZone"`
+	Layers      []schema.OnCallLayer `json:"layers"`
+	Enabled     bool                 `json:"enabled"`
+	CreatedBy   int                  `json:"createdBy,omitempty"`
+	CreatedAt   time.Time            `json:"createdAt"`
+	UpdatedAt   time.Time            `json:"updatedAt"`
+}
+
+// OnCallRangeQuery 值班展开区间，默认从当前时间起 14 天，最长 93 天
+type OnCallRangeQuery struct {
+	From *time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To   *time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+}
+
+// OnCallShift 展开后的一段值班
+type OnCallShift struct {
+	UserID     int       `json:"userId"`
+	UserName   string    `json:"userName,omitempty"`
+	Start      time.Time `json:"start"`
+	End        time.Time `json:"end"`
+	Layer      string    `json:"layer,omitempty"`
+	OverrideID int       `json:"overrideId,omitempty"`
+}
+
+// OnCallWhoQuery 查询当前值班人：teamId 为空时查询全部启用的值班表，at 为空时取当前时间
+type OnCallWhoQuery struct {
+	TeamID     int        `form:"teamId" binding:"omitempty,min=1"`
+	ScheduleID int        `form:"scheduleId" binding:"omitempty,min=1"`
+	At         *time.Time `form:"at" time_format:"2006-01-02T15:04:05Z07:00"`
+}
+
+// OnCallNow 某值班表在指定时刻的值班人，无人值班时 Shift 为空
+type OnCallNow struct {
+	ScheduleID   int          `json:"scheduleId"`
+	ScheduleName string       `json:"scheduleName"`
+	TeamID       *int         `json:"teamId,omitempty"`
+	Shift        *OnCallShift `json:"shift,omitempty"`
+}
+
+// CreateOnCallOverrideRequest 创建值班替换。originalUserId 非空时只替换该时段内原本由其值的班
+type CreateOnCallOverrideRequest struct {
+	UserID         int       `json:"userId" binding:"required,min=1"`
+	OriginalUserID *int      `json:"originalUserId,omitempty" binding:"omitempty,min=1"`
+	StartAt        time.Time `json:"startAt" binding:"required"`
+	EndAt          time.Time `json:"endAt" binding:"required"`
+	Reason         string    `json:"reason" binding:"max=500"`
+}
+
+// SwapOnCallShiftsRequest 换班：userId 在 [startAt, endAt) 的班由 withUserId 顶替，
+// withUserId 在 [withStartAt, withEndAt) 的班由 userId 顶替
+type SwapOnCallShiftsRequest struct {
+	UserID      int       `json:"userId" binding:"required,min=1"`
+	StartAt     time.Time `json:"startAt" binding:"required"`
+	EndAt       time.Time `json:"endAt" binding:"required"`
+	WithUserID  int       `json:"withUserId" binding:"required,min=1"`
+	WithStartAt time.Time `json:"withStartAt" binding:"required"`
+	WithEndAt   time.Time `json:"withEndAt" binding:"required"`
+	Reason      string    `json:"reason" binding:"max=500"`
+}
+
+// OnCallOverrideResponse 值班替换
+type OnCallOverrideResponse struct {
+	ID             int       `json:"id"`
+	ScheduleID     int       `json:"scheduleId"`
+	UserID         int       `json:"userId"`
+	OriginalUserID *int      `json:"originalUserId,omitempty"`
+	StartAt        time.Time `json:"startAt"`
+	EndAt          time.Time `json:"endAt"`
+	Reason         string    `json:"reason,omitempty"`
+	SwapGroup      string    `json:"swapGroup,omitempty"`
+	CreatedBy      int       `json:"createdBy,omitempty"`
+	CreatedAt      time.Time `json:"createdAt"`
+}
+
+// OnCallCalendarFeed 个人值班日历订阅地址
+type OnCallCalendarFeed struct {
+	Token string `json:"token"`
+	Path  string `json:"path"` // 相对路径，拼接服务地址后可在日历客户端中订阅
+}
+
+// CreateEscalationPolicyRequest 创建值班升级策略
+type CreateEscalationPolicyRequest struct {
+	Name        string                        `json:"name" binding:"required,max=100"`
+	Description string                        `json:"description" binding:"max=2000"`
+	TeamID      *int                          `json:"teamId,omitempty" binding:"omitempty,min=1"`
+	Steps       []schema.EscalationPolicyStep `json:"steps" binding:"required,min=1"`
+	RepeatCount int                           `json:"repeatCount" binding:"min=0,max=9"`
+	Enabled     *bool                         `json:"enabled,omitempty"`
+}
+
+// UpdateEscalationPolicyRequest 修改值班升级策略，未传字段保持不变
+type UpdateEscalationPolicyRequest struct {
+	Name        *string                        `json:"name,omitempty" binding:"omitempty,max=100"`
+	Description *string                        `json:"description,omitempty" binding:"omitempty,max=2000"`
+	TeamID      *int                           `json:"teamId,omitempty" binding:"omitempty,min=0"` // 传 0 解除团队归属
+	Steps       *[]schema.EscalationPolicyStep `json:"steps,omitempty"`
+	RepeatCount *int                           `json:"repeatCount,omitempty" binding:"omitempty,min=0,max=9"`
+	Enabled     *bool                          `json:"enabled,omitempty"`
+}
+
+// EscalationPolicyResponse 值班升级策略
+type EscalationPolicyResponse struct {
+	ID          int                           `json:"id"`
+	Name        string                        `json:"name"`
+	Description string                        `json:"description,omitempty"`
+	TeamID      *int                          `json:"teamId,omitempty"`
+	Steps       []schema.EscalationPolicyStep `json:"steps"`
+	RepeatCount int                           `json:"repeatCount"`
+	Enabled     bool                          `json:"enabled"`
+	CreatedBy   int                           `json:"createdBy,omitempty"`
+	CreatedAt   time.Time                     `json:"createdAt"`
+	UpdatedAt   time.Time                     `json:"updatedAt"`
+}
+
+// TriggerOnCallPageRequest 按升级策略为事件发起值班呼叫
+type TriggerOnCallPageRequest struct {
+	PolicyID int `json:"policyId" binding:"required,min=1"`
+}
+
+// OnCallPageResponse 值班呼叫
+type OnCallPageResponse struct {
+	ID               int        `json:"id"`
+	PolicyID         int        `json:"policyId"`
+	PolicyName       string     `json:"policyName,omitempty"`
+	IncidentID       int        `json:"incidentId"`
+	Status           string     `json:"status"` // triggered / acknowledged / resolved / exhausted
+	Step             int        `json:"step"`   // 从 0 开始
+	Round            int        `json:"round"`  // 从 0 开始
+	NotifiedUserIDs  []int      `json:"notifiedUserIds"`
+	NextEscalationAt *time.Time `json:"nextEscalationAt,omitempty"`
+	AcknowledgedBy   *int       `json:"acknowledgedBy,omitempty"`
+	AcknowledgedAt   *time.Time `json:"acknowledgedAt,omitempty"`
+	CreatedBy        int        `json:"createdBy,omitempty"`
+	CreatedAt        time.Time  `json:"createdAt"`
+}
@@ -0,0 +1,35 @@
+package dto
+
+import "time"
+
+// FullTextSearchRequest 全文检索请求
+type FullTextSearchRequest struct {
+	Query  string   `form:"q" json:"q"`
+	Types  []string `form:"types" json:"types"` // ticket/ticket_comment/knowledge_article/configuration_item，为空表示全部
+	Limit  int      `form:"limit" json:"limit"`
+	Offset int      `form:"offset" json:"offset"`
+}
+
+// FullTextSearchHit 检索命中项，Highlight 中命中词以 <em> 标记，其余内容已做 HTML 转义
+type FullTextSearchHit struct {
+	Type      string    `json:"type"`
+	ID        int       `json:"id"`
+	ParentID  int       `json:"parentId,omitempty"`
+	Reference string    `json:"reference,omitempty"`
+	Title     string    `json:"title"`
+	Highlight string    `json:"highlight"`
+	Score     float64   `json:"score"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// FullTextSearchResponse 全文检索响应，Facets 为各实体类型的命中数
+type FullTextSearchResponse struct {
+	Hits   []*FullTextSearchHit `json:"hits"`
+	Total  int                  `json:"total"`
+	Facets map[string]int       `json:"facets"`
+}
+
+// FullTextReindexResponse 重建索引响应
+type FullTextReindexResponse struct {
+	Indexed map[string]int `json:"indexed"`
+}
@@ -2,6 +2,8 @@ package dto
 
 import (
 	"time"
+
+	"itsm-backend/common/slapause"
 )
 
 // SLA定义相关DTO
@@ -13,6 +15,8 @@ type CreateSLADefinitionRequest struct {
 	ResponseTime    int                    `json:"responseTime" binding:"required,min=1" example:"30"`
 	ResolutionTime  int                    `json:"resolutionTime" binding:"required,min=1" example:"240"`
 	BusinessHours   map[string]interface{} `json:"businessHours" example:"{\"timezone\":\"Asia/Shanghai\"}"`
+	CalendarID      *int                   `json:"calendarId" example:"1"` // 工作日历ID，设置后替代 businessHours
+	PauseConditions *slapause.Conditions   `json:"pauseConditions"`        // 暂停计时条件：工单状态、挂起标记
 	EscalationRules map[string]interface{} `json:"escalationRules" example:"{\"levels\":[]}"`
 	Conditions      map[string]interface{} `json:"conditions" example:"{\"priority\":[\"low\",\"medium\"]}"`
 	IsActive        bool                   `json:"isActive" example:"true"`
@@ -26,6 +30,8 @@ type UpdateSLADefinitionRequest struct {
 	ResponseTime    *int                   `json:"responseTime,omitempty"`
 	ResolutionTime  *int                   `json:"resolutionTime,omitempty"`
 	BusinessHours   map[string]interface{} `json:"businessHours,omitempty"`
+	CalendarID      *int                   `json:"calendarId,omitempty"` // 0 表示解除工作日历
+	PauseConditions *slapause.Conditions   `json:"pauseConditions,omitempty"`
 	EscalationRules map[string]interface{} `json:"escalationRules,omitempty"`
 	Conditions      map[string]interface{} `json:"conditions,omitempty"`
 	IsActive        *bool                  `json:"isActive,omitempty"`
@@ -40,6 +46,8 @@ type SLADefinitionResponse struct {
 	ResponseTime    int                    `json:"responseTime" example:"30"`
 	ResolutionTime  int                    `json:"resolutionTime" example:"240"`
 	BusinessHours   map[string]interface{} `json:"businessHours"`
+	CalendarID      *int                   `json:"calendarId,omitempty"`
+	PauseConditions *slapause.Conditions   `json:"pauseConditions,omitempty"`
 	EscalationRules map[string]interface{} `json:"escalationRules"`
 	Conditions      map[string]interface{} `json:"conditions"`
 	IsActive        bool                   `json:"isActive" example:"true"`
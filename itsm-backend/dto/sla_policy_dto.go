@@ -2,59 +2,76 @@ package dto
 
 import (
 	"time"
+
+	"itsm-backend/ent/schema"
 )
 
 // SLAPolicyDTO SLA策略DTO
 type CreateSLAPolicyRequest struct {
-	Name                  string                 `json:"name" binding:"required"`
-	Description           string                 `json:"description"`
-	CustomerTier          *string                `json:"customerTier"` // platinum/gold/silver/bronze
-	TicketType            *string                `json:"ticketType"`   // incident/problem/change/request
-	Priority              *string                `json:"priority"`     // critical/high/medium/low
-	ResponseTimeMinutes   int                    `json:"responseTimeMinutes"`
-	ResolutionTimeMinutes int                    `json:"resolutionTimeMinutes"`
-	BusinessHours         map[string]interface{} `json:"businessHours"`
-	ExcludeWeekends       bool                   `json:"excludeWeekends"`
-	ExcludeHolidays       bool                   `json:"excludeHolidays"`
-	EscalationRules       map[string]interface{} `json:"escalationRules"`
-	IsActive              bool                   `json:"isActive"`
-	PriorityScore         int                    `json:"priorityScore"`
-	TenantID              int                    `json:"tenantId"`
+	Name                  string                       `json:"name" binding:"required"`
+	Description           string                       `json:"description"`
+	CustomerTier          *string                      `json:"customerTier"`  // platinum/gold/silver/bronze
+	TicketType            *string                      `json:"ticketType"`    // incident/problem/change/request
+	Priority              *string                      `json:"priority"`      // critical/high/medium/low
+	AgreementType         string                       `json:"agreementType"` // sla/ola/uc，默认 sla
+	TeamID                *int                         `json:"teamId"`        // OLA 适用的处理团队
+	VendorID              *int                         `json:"vendorId"`      // UC 适用的供应商
+	Metrics               []schema.SLAMetricDefinition `json:"metrics"`       // 计时指标，为空时按响应/解决时间生成
+	ResponseTimeMinutes   int                          `json:"responseTimeMinutes"`
+	ResolutionTimeMinutes int                          `json:"resolutionTimeMinutes"`
+	BusinessHours         map[string]interface{}       `json:"businessHours"`
+	CalendarID            *int                         `json:"calendarId"` // 工作日历ID，设置后替代 businessHours
+	ExcludeWeekends       bool                         `json:"excludeWeekends"`
+	ExcludeHolidays       bool                         `json:"excludeHolidays"`
+	EscalationRules       map[string]interface{}       `json:"escalationRules"`
+	IsActive              bool                         `json:"isActive"`
+	PriorityScore         int                          `json:"priorityScore"`
+	TenantID              int                          `json:"tenantId"`
 }
 
 type UpdateSLAPolicyRequest struct {
-	Name                  *string                 `json:"name,omitempty"`
-	Description           *string                 `json:"description,omitempty"`
-	CustomerTier          *string                 `json:"customerTier,omitempty"`
-	TicketType            *string                 `json:"ticketType,omitempty"`
-	Priority              *string                 `json:"priority,omitempty"`
-	ResponseTimeMinutes   *int                    `json:"responseTimeMinutes,omitempty"`
-	ResolutionTimeMinutes *int                    `json:"resolutionTimeMinutes,omitempty"`
-	BusinessHours         *map[string]interface{} `json:"businessHours,omitempty"`
-	ExcludeWeekends       *bool                   `json:"excludeWeekends,omitempty"`
-	ExcludeHolidays       *bool                   `json:"excludeHolidays,omitempty"`
-	EscalationRules       *map[string]interface{} `json:"escalationRules,omitempty"`
-	IsActive              *bool                   `json:"isActive,omitempty"`
-	PriorityScore         *int                    `json:"priorityScore,omitempty"`
+	Name                  *string                       `json:"name,omitempty"`
+	Description           *string                       `json:"description,omitempty"`
+	CustomerTier          *string                       `json:"customerTier,omitempty"`
+	TicketType            *string                       `json:"ticketType,omitempty"`
+	Priority              *string                       `json:"priority,omitempty"`
+	AgreementType         *string                       `json:"agreementType,omitempty"`
+	TeamID                *int                          `json:"teamId,omitempty"`   // 0 表示解除团队
+	VendorID              *int                          `json:"vendorId,omitempty"` // 0 表示解除供应商
+	Metrics               *[]schema.SLAMetricDefinition `json:"metrics,omitempty"`
+	ResponseTimeMinutes   *int                          `json:"responseTimeMinutes,omitempty"`
+	ResolutionTimeMinutes *int                          `json:"resolutionTimeMinutes,omitempty"`
+	BusinessHours         *map[string]interface{}       `json:"businessHours,omitempty"`
+	CalendarID            *int                          `json:"calendarId,omitempty"` // 0 表示解除工作日历
+	ExcludeWeekends       *bool                         `json:"excludeWeekends,omitempty"`
+	ExcludeHolidays       *bool                         `json:"excludeHolidays,omitempty"`
+	EscalationRules       *map[string]interface{}       `json:"escalationRules,omitempty"`
+	IsActive              *bool                         `json:"isActive,omitempty"`
+	PriorityScore         *int                          `json:"priorityScore,omitempty"`
 }
 
 type SLAPolicyResponse struct {
-	ID                    int                    `json:"id"`
-	Name                  string                 `json:"name"`
-	Description           string                 `json:"description"`
-	CustomerTier          string                 `json:"customerTier"`
-	TicketType            string                 `json:"ticketType"`
-	Priority              string                 `json:"priority"`
-	ResponseTimeMinutes   int                    `json:"responseTimeMinutes"`
-	ResolutionTimeMinutes int                    `json:"resolutionTimeMinutes"`
-	BusinessHours         map[string]interface{} `json:"businessHours"`
-	ExcludeWeekends       bool                   `json:"excludeWeekends"`
-	ExcludeHolidays       bool                   `json:"excludeHolidays"`
-	IsActive              bool                   `json:"isActive"`
-	PriorityScore         int                    `json:"priorityScore"`
-	TenantID              int                    `json:"tenantId"`
-	CreatedAt             time.Time              `json:"createdAt"`
-	UpdatedAt             time.Time              `json:"updatedAt"`
+	ID                    int                          `json:"id"`
+	Name                  string                       `json:"name"`
+	Description           string                       `json:"description"`
+	CustomerTier          string                       `json:"customerTier"`
+	TicketType            string                       `json:"ticketType"`
+	Priority              string                       `json:"priority"`
+	AgreementType         string                       `json:"agreementType"`
+	TeamID                *int                         `json:"teamId,omitempty"`
+	VendorID              *int                         `json:"vendorId,omitempty"`
+	Metrics               []schema.SLAMetricDefinition `json:"metrics"`
+	ResponseTimeMinutes   int                          `json:"responseTimeMinutes"`
+	ResolutionTimeMinutes int                          `json:"resolutionTimeMinutes"`
+	BusinessHours         map[string]interface{}       `json:"businessHours"`
+	CalendarID            *int                         `json:"calendarId,omitempty"`
+	ExcludeWeekends       bool                         `json:"excludeWeekends"`
+	ExcludeHolidays       bool                         `json:"excludeHolidays"`
+	IsActive              bool                         `json:"isActive"`
+	PriorityScore         int                          `json:"priorityScore"`
+	TenantID              int                          `json:"tenantId"`
+	CreatedAt             time.Time                    `json:"createdAt"`
+	UpdatedAt             time.Time                    `json:"updatedAt"`
 }
 
 // EngineerSkillDTO 工程师技能DTO
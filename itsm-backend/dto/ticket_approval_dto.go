@@ -24,7 +24,7 @@ type UpdateApprovalWorkflowRequest struct {
 type ApprovalNodeRequest struct {
 	Level            int                       `json:"level" binding:"required,min=1" example:"1"`
 	Name             string                    `json:"name" binding:"required" example:"直属主管审批"`
-	ApproverType     string                    `json:"approverType" binding:"required,oneof=user role department dynamic dept_manager team_leader project_manager temp_team_leader amount_based" example:"role"`
+	ApproverType     string                    `json:"approverType" binding:"required,oneof=user role department dynamic dept_manager team_leader project_manager temp_team_leader amount_based oncall" example:"role"`
 	ApproverIDs      []int                     `json:"approverIds,omitempty" example:"1,2"`
 	AssigneeType     string                    `json:"assigneeType,omitempty" example:"dept_manager"`
 	AssigneeValue    string                    `json:"assigneeValue,omitempty" example:"1"`
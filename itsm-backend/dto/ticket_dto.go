@@ -39,7 +39,7 @@ type UpdateTicketRequest struct {
 	Title       string                 `json:"title" binding:"omitempty,min=2,max=200"`
 	Description string                 `json:"description" binding:"omitempty,min=10,max=5000"`
 	Priority    string                 `json:"priority" binding:"omitempty,oneof=low medium high critical"`
-	Status      string                 `json:"status" binding:"omitempty,oneof=new open assigned in_progress pending resolved closed cancelled approved rejected"`
+	Status      string                 `json:"status" binding:"omitempty,oneof=new open assigned in_progress pending pending_customer pending_vendor resolved closed cancelled approved rejected"`
 	Type        string                 `json:"type" binding:"omitempty,oneof=incident service_request change ticket problem improvement"`
 	Category    string                 `json:"category" binding:"omitempty"`
 	CategoryID  *int                   `json:"categoryId,omitempty"`
@@ -48,6 +48,8 @@ type UpdateTicketRequest struct {
 	Tags        []string               `json:"tags"`
 	Resolution  string                 `json:"resolution" binding:"omitempty"`
 	FormFields  map[string]interface{} `json:"formFields"`
+	OnHold      *bool                  `json:"onHold,omitempty"`           // 挂起工单，SLA 定义配置 on_hold 暂停条件时停止计时
+	VendorID    *int                   `json:"vendorId,omitempty"`         // 承接供应商，用于跟踪支撑合同；0 表示解除
 	UserID      int                    `json:"userId" binding:"omitempty"` // 操作用户ID (后端自动填充)
 	Version     int                    `json:"version"`                    // 版本号（乐观锁）
 	Force       bool                   `json:"-"`                          // 仅限内部受信调用，禁止客户端绕过乐观锁
@@ -159,10 +161,25 @@ type BatchDeleteRequest struct {
 type TicketExportRequest struct {
 	Format  string                 `json:"format" binding:"required,oneof=csv excel pdf"`
 	Filters ListTicketsRequest     `json:"filters"`
+	Query   string                 `json:"query"` // TQL 查询，设置后忽略 filters
 	Fields  []string               `json:"fields"`
 	Options map[string]interface{} `json:"options"`
 }
 
+// TicketQueryRequest TQL 工单查询请求
+type TicketQueryRequest struct {
+	Query  string `json:"query"`
+	Cursor string `json:"cursor"`
+	Limit  int    `json:"limit"`
+}
+
+// TicketQueryResponse TQL 工单查询响应
+type TicketQueryResponse struct {
+	Tickets    []*TicketResponse `json:"tickets"`
+	NextCursor string            `json:"nextCursor,omitempty"`
+	HasMore    bool              `json:"hasMore"`
+}
+
 // TicketImportRequest 工单导入请求
 type TicketImportRequest struct {
 	File     string                 `json:"file" binding:"required"`
@@ -297,3 +314,41 @@ type CreateSubtaskRequest struct {
 	AssigneeID  int                    `json:"assigneeId"`
 	FormFields  map[string]interface{} `json:"formFields"`
 }
+
+// MergeTicketsRequest 合并重复工单请求：重复工单的评论、附件、抄送与标签并入主工单后关闭
+type MergeTicketsRequest struct {
+	DuplicateIDs []int  `json:"duplicateIds" binding:"required,min=1,max=100"`
+	Comment      string `json:"comment,omitempty"`
+}
+
+// MergeTicketsResponse 合并结果
+type MergeTicketsResponse struct {
+	PrimaryID        int   `json:"primaryId"`
+	MergedIDs        []int `json:"mergedIds"`
+	MovedComments    int   `json:"movedComments"`
+	MovedAttachments int   `json:"movedAttachments"`
+	AddedCCUsers     int   `json:"addedCcUsers"`
+	AddedTags        int   `json:"addedTags"`
+}
+
+// SplitTicketChild 拆分出的子工单：内容取自所选评论或表单字段
+type SplitTicketChild struct {
+	Title         string   `json:"title" binding:"required,min=2,max=200"`
+	Description   string   `json:"description"`
+	Priority      string   `json:"priority" binding:"omitempty,oneof=low medium high critical urgent"`
+	AssigneeID    int      `json:"assigneeId"`
+	CommentIDs    []int    `json:"commentIds"`
+	FormFieldKeys []string `json:"formFieldKeys"`
+}
+
+// SplitTicketRequest 拆分工单请求
+type SplitTicketRequest struct {
+	Children []SplitTicketChild `json:"children" binding:"required,min=1,max=20,dive"`
+	Comment  string             `json:"comment,omitempty"`
+}
+
+// SplitTicketResponse 拆分结果
+type SplitTicketResponse struct {
+	SourceID int               `json:"sourceId"`
+	Children []*TicketResponse `json:"children"`
+}
@@ -0,0 +1,66 @@
+package dto
+
+import "time"
+
+// CreateTicketScheduleRequest 创建周期工单计划请求
+type CreateTicketScheduleRequest struct {
+	Name          string                 `json:"name" binding:"required,max=100"`
+	Description   string                 `json:"description"`
+	SourceType    string                 `json:"sourceType" binding:"required,oneof=template catalog_item"`
+	TemplateID    *int                   `json:"templateId"`
+	CatalogItemID *int                   `json:"catalogItemId"`
+	Title         string                 `json:"title" binding:"max=200"`
+	Priority      string                 `json:"priority" binding:"omitempty,oneof=low medium high critical urgent"`
+	RequesterID   int                    `json:"requesterId"` // 为空时取创建人
+	AssigneeID    *int                   `json:"assigneeId"`
+	FormFields    map[string]interface{} `json:"formFields"`
+	RuleType      string                 `json:"ruleType" binding:"required,oneof=cron rrule"`
+	Expression    string                 `json:"expression" binding:"required" example:"FREQ=MONTHLY;BYDAY=2TU;BYHOUR=22;BYMINUTE=0"`
+	TimeZone      string                 `json:"timeZone" example:"Asia/Shanghai"`
+	StartAt       *time.Time             `json:"startAt"` // 为空时取当前时间
+	EndAt         *time.Time             `json:"endAt"`
+	CalendarID    *int                   `json:"calendarId"`
+	HolidayPolicy string                 `json:"holidayPolicy" binding:"omitempty,oneof=none skip next_business_day"`
+	CatchUpPolicy string                 `json:"catchUpPolicy" binding:"omitempty,oneof=all latest none"`
+	Enabled       *bool                  `json:"enabled"`
+}
+
+// UpdateTicketScheduleRequest 更新周期工单计划请求，未传字段保持不变；
+// 修改表达式、时区、生效区间、节假日策略或启停时重新调度
+type UpdateTicketScheduleRequest struct {
+	Name          *string                 `json:"name,omitempty"`
+	Description   *string                 `json:"description,omitempty"`
+	Title         *string                 `json:"title,omitempty"`
+	Priority      *string                 `json:"priority,omitempty" binding:"omitempty,oneof=low medium high critical urgent"`
+	RequesterID   *int                    `json:"requesterId,omitempty"`
+	AssigneeID    *int                    `json:"assigneeId,omitempty"` // 0 表示清除
+	FormFields    *map[string]interface{} `json:"formFields,omitempty"`
+	RuleType      *string                 `json:"ruleType,omitempty" binding:"omitempty,oneof=cron rrule"`
+	Expression    *string                 `json:"expression,omitempty"`
+	TimeZone      *string                 `json:"timeZone,omitempty"`
+	StartAt       *time.Time              `json:"startAt,omitempty"`
+	EndAt         *time.Time              `json:"endAt,omitempty"`
+	ClearEndAt    bool                    `json:"clearEndAt,omitempty"`
+	CalendarID    *int                    `json:"calendarId,omitempty"` // 0 表示改用租户默认日历
+	HolidayPolicy *string                 `json:"holidayPolicy,omitempty" binding:"omitempty,oneof=none skip next_business_day"`
+	CatchUpPolicy *string                 `json:"catchUpPolicy,omitempty" binding:"omitempty,oneof=all latest none"`
+	Enabled       *bool                   `json:"enabled,omitempty"`
+}
+
+// TicketSchedulePreviewRequest 预览周期表达式请求
+type TicketSchedulePreviewRequest struct {
+	RuleType      string     `json:"ruleType" binding:"required,oneof=cron rrule"`
+	Expression    string     `json:"expression" binding:"required"`
+	TimeZone      string     `json:"timeZone"`
+	StartAt       *time.Time `json:"startAt"`
+	EndAt         *time.Time `json:"endAt"`
+	CalendarID    *int       `json:"calendarId"`
+	HolidayPolicy string     `json:"holidayPolicy" binding:"omitempty,oneof=none skip next_business_day"`
+	Count         int        `json:"count" binding:"omitempty,min=1,max=50"`
+}
+
+// TicketScheduleOccurrence 计划的一次发生
+type TicketScheduleOccurrence struct {
+	OccurrenceAt time.Time `json:"occurrenceAt"` // 表达式计算出的发生时刻
+	FireAt       time.Time `json:"fireAt"`       // 节假日顺延后的实际触发时刻
+}
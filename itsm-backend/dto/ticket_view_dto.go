@@ -10,6 +10,7 @@ import (
 type CreateTicketViewRequest struct {
 	Name        string                 `json:"name" binding:"required"`
 	Description *string                `json:"description"`
+	Query       string                 `json:"query"` // TQL 查询，设置后优先于 filters
 	Filters     map[string]interface{} `json:"filters"`
 	Columns     []string               `json:"columns"`
 	SortConfig  map[string]interface{} `json:"sortConfig"`
@@ -21,6 +22,7 @@ type CreateTicketViewRequest struct {
 type UpdateTicketViewRequest struct {
 	Name        *string                `json:"name"`
 	Description *string                `json:"description"`
+	Query       *string                `json:"query"`
 	Filters     map[string]interface{} `json:"filters"`
 	Columns     []string               `json:"columns"`
 	SortConfig  map[string]interface{} `json:"sortConfig"`
@@ -38,6 +40,7 @@ type TicketViewResponse struct {
 	ID          int                    `json:"id"`
 	Name        string                 `json:"name"`
 	Description *string                `json:"description"`
+	Query       string                 `json:"query,omitempty"`
 	Filters     map[string]interface{} `json:"filters"`
 	Columns     []string               `json:"columns"`
 	SortConfig  map[string]interface{} `json:"sortConfig"`
@@ -67,6 +70,7 @@ func ToTicketViewResponse(view *ent.TicketView, creator *ent.User) *TicketViewRe
 		ID:          view.ID,
 		Name:        view.Name,
 		Description: description,
+		Query:       view.Query,
 		Filters:     view.Filters,
 		Columns:     view.Columns,
 		SortConfig:  view.SortConfig,
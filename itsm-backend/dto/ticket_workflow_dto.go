@@ -18,6 +18,8 @@ const (
 	WorkflowActionResolve       TicketWorkflowAction = "resolve"
 	WorkflowActionClose         TicketWorkflowAction = "close"
 	WorkflowActionReopen        TicketWorkflowAction = "reopen"
+	WorkflowActionMerge         TicketWorkflowAction = "merge"
+	WorkflowActionSplit         TicketWorkflowAction = "split"
 )
 
 // ApprovalStatus 审批状态
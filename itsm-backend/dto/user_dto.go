@@ -26,6 +26,9 @@ type UpdateUserRequest struct {
 	Name       string `json:"name,omitempty" binding:"omitempty,min=1,max=100"`
 	Department string `json:"department,omitempty"`
 	Phone      string `json:"phone,omitempty"`
+	// IM 账号绑定，用于 Slack/Teams 卡片按钮回调时识别操作人
+	SlackUserID string `json:"slackUserId,omitempty" binding:"omitempty,max=64"`
+	TeamsUserID string `json:"teamsUserId,omitempty" binding:"omitempty,max=64"`
 	// 角色更新，仅管理员有权限更新
 	Role string `json:"role,omitempty" binding:"omitempty,oneof=super_admin admin manager agent technician security end_user user"`
 }
@@ -0,0 +1,90 @@
+package dto
+
+import "time"
+
+// WorkLogTarget 工时关联对象，工单、变更、问题三者必须且只能指定一个
+type WorkLogTarget struct {
+	TicketID  *int `json:"ticketId,omitempty"`
+	ChangeID  *int `json:"changeId,omitempty"`
+	ProblemID *int `json:"problemId,omitempty"`
+}
+
+// CreateWorkLogRequest 手工登记工时请求
+type CreateWorkLogRequest struct {
+	WorkLogTarget
+	StartedAt       time.Time `json:"startedAt" binding:"required"`
+	DurationMinutes int       `json:"durationMinutes" binding:"required,min=1,max=1440"`
+	Billable        *bool     `json:"billable"` // 为空时 MSP 托管工单默认可计费，其余默认不可计费
+	ActivityType    string    `json:"activityType" binding:"omitempty,oneof=general troubleshooting implementation communication meeting travel onsite documentation"`
+	Comment         string    `json:"comment" binding:"max=2000"`
+}
+
+// StartWorkLogTimerRequest 开始计时请求
+type StartWorkLogTimerRequest struct {
+	WorkLogTarget
+	Billable     *bool  `json:"billable"`
+	ActivityType string `json:"activityType" binding:"omitempty,oneof=general troubleshooting implementation communication meeting travel onsite documentation"`
+	Comment      string `json:"comment" binding:"max=2000"`
+}
+
+// StopWorkLogTimerRequest 停止计时请求，未传字段保持开始时的值
+type StopWorkLogTimerRequest struct {
+	Billable     *bool   `json:"billable,omitempty"`
+	ActivityType *string `json:"activityType,omitempty" binding:"omitempty,oneof=general troubleshooting implementation communication meeting travel onsite documentation"`
+	Comment      *string `json:"comment,omitempty" binding:"omitempty,max=2000"`
+}
+
+// UpdateWorkLogRequest 修改已结束的工时记录，未传字段保持不变
+type UpdateWorkLogRequest struct {
+	StartedAt       *time.Time `json:"startedAt,omitempty"`
+	DurationMinutes *int       `json:"durationMinutes,omitempty" binding:"omitempty,min=1,max=1440"`
+	Billable        *bool      `json:"billable,omitempty"`
+	ActivityType    *string    `json:"activityType,omitempty" binding:"omitempty,oneof=general troubleshooting implementation communication meeting travel onsite documentation"`
+	Comment         *string    `json:"comment,omitempty" binding:"omitempty,max=2000"`
+}
+
+// WorkLogListFilter 工时记录查询条件
+type WorkLogListFilter struct {
+	TicketID  int        `form:"ticket_id"`
+	ChangeID  int        `form:"change_id"`
+	ProblemID int        `form:"problem_id"`
+	UserID    int        `form:"user_id"`
+	From      *time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To        *time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+	Billable  *bool      `form:"billable"`
+	Limit     int        `form:"limit"`
+}
+
+// WorkLogReportRequest 工时汇总请求
+type WorkLogReportRequest struct {
+	GroupBy  string     `form:"group_by" binding:"omitempty,oneof=user team customer category activity_type"` // 默认 user
+	From     *time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To       *time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+	Billable *bool      `form:"billable"`
+	// MSPOnly 仅统计 MSP 托管工单上的工时（MSP 向客户开票时使用）
+	MSPOnly bool `form:"msp_only"`
+}
+
+// WorkLogReportRow 一个分组的工时汇总
+type WorkLogReportRow struct {
+	Key             int     `json:"key"` // 用户/团队/客户租户ID；按分类或活动类型分组时为 0
+	Label           string  `json:"label"`
+	Entries         int     `json:"entries"`
+	TotalMinutes    int     `json:"totalMinutes"`
+	BillableMinutes int     `json:"billableMinutes"`
+	TotalHours      float64 `json:"totalHours"`
+	BillableHours   float64 `json:"billableHours"`
+}
+
+// WorkLogReport 工时汇总报表
+type WorkLogReport struct {
+	GroupBy         string             `json:"groupBy"`
+	From            *time.Time         `json:"from,omitempty"`
+	To              *time.Time         `json:"to,omitempty"`
+	Rows            []WorkLogReportRow `json:"rows"`
+	TotalMinutes    int                `json:"totalMinutes"`
+	BillableMinutes int                `json:"billableMinutes"`
+	TotalHours      float64            `json:"totalHours"`
+	BillableHours   float64            `json:"billableHours"`
+	GeneratedAt     time.Time          `json:"generatedAt"`
+}
@@ -0,0 +1,255 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"itsm-backend/ent/alertsuppressionrule"
+	"itsm-backend/ent/schema"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AlertSuppressionRule is the model entity for the AlertSuppressionRule schema.
+type AlertSuppressionRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 租户ID
+	TenantID int `json:"tenant_id,omitempty"`
+	// 规则名称
+	Name string `json:"name,omitempty"`
+	// 规则说明
+	Description string `json:"description,omitempty"`
+	// 限定的告警源，为空表示所有告警源
+	Source string `json:"source,omitempty"`
+	// 标签匹配条件，全部满足才屏蔽
+	Matchers []schema.AlertMatcher `json:"matchers,omitempty"`
+	// 生效开始时间，为空表示立即生效
+	StartsAt *time.Time `json:"starts_at,omitempty"`
+	// 生效结束时间，为空表示长期有效
+	EndsAt *time.Time `json:"ends_at,omitempty"`
+	// 是否启用
+	Enabled bool `json:"enabled,omitempty"`
+	// 累计命中次数
+	HitCount int `json:"hit_count,omitempty"`
+	// 最近命中时间
+	LastHitAt *time.Time `json:"last_hit_at,omitempty"`
+	// 创建人ID
+	CreatedBy int `json:"created_by,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AlertSuppressionRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case alertsuppressionrule.FieldMatchers:
+			values[i] = new([]byte)
+		case alertsuppressionrule.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case alertsuppressionrule.FieldID, alertsuppressionrule.FieldTenantID, alertsuppressionrule.FieldHitCount, alertsuppressionrule.FieldCreatedBy:
+			values[i] = new(sql.NullInt64)
+		case alertsuppressionrule.FieldName, alertsuppressionrule.FieldDescription, alertsuppressionrule.FieldSource:
+			values[i] = new(sql.NullString)
+		case alertsuppressionrule.FieldStartsAt, alertsuppressionrule.FieldEndsAt, alertsuppressionrule.FieldLastHitAt, alertsuppressionrule.FieldCreatedAt, alertsuppressionrule.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AlertSuppressionRule fields.
+func (_m *AlertSuppressionRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case alertsuppressionrule.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case alertsuppressionrule.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = int(value.Int64)
+			}
+		case alertsuppressionrule.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case alertsuppressionrule.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case alertsuppressionrule.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = value.String
+			}
+		case alertsuppressionrule.FieldMatchers:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field matchers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Matchers); err != nil {
+					return fmt.Errorf("unmarshal field matchers: %w", err)
+				}
+			}
+		case alertsuppressionrule.FieldStartsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
+			} else if value.Valid {
+				_m.StartsAt = new(time.Time)
+				*_m.StartsAt = value.Time
+			}
+		case alertsuppressionrule.FieldEndsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ends_at", values[i])
+			} else if value.Valid {
+				_m.EndsAt = new(time.Time)
+				*_m.EndsAt = value.Time
+			}
+		case alertsuppressionrule.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case alertsuppressionrule.FieldHitCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field hit_count", values[i])
+			} else if value.Valid {
+				_m.HitCount = int(value.Int64)
+			}
+		case alertsuppressionrule.FieldLastHitAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_hit_at", values[i])
+			} else if value.Valid {
+				_m.LastHitAt = new(time.Time)
+				*_m.LastHitAt = value.Time
+			}
+		case alertsuppressionrule.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = int(value.Int64)
+			}
+		case alertsuppressionrule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case alertsuppressionrule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AlertSuppressionRule.
+// This includes values selected through modifiers, order, etc.
+func (_m *AlertSuppressionRule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AlertSuppressionRule.
+// Note that you need to call AlertSuppressionRule.Unwrap() before calling this method if this AlertSuppressionRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AlertSuppressionRule) Update() *AlertSuppressionRuleUpdateOne {
+	return NewAlertSuppressionRuleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AlertSuppressionRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AlertSuppressionRule) Unwrap() *AlertSuppressionRule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AlertSuppressionRule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AlertSuppressionRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("AlertSuppressionRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(_m.Source)
+	builder.WriteString(", ")
+	builder.WriteString("matchers=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Matchers))
+	builder.WriteString(", ")
+	if v := _m.StartsAt; v != nil {
+		builder.WriteString("starts_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.EndsAt; v != nil {
+		builder.WriteString("ends_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("hit_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HitCount))
+	builder.WriteString(", ")
+	if v := _m.LastHitAt; v != nil {
+		builder.WriteString("last_hit_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedBy))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AlertSuppressionRules is a parsable slice of AlertSuppressionRule.
+type AlertSuppressionRules []*AlertSuppressionRule
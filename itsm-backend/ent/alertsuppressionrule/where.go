@@ -0,0 +1,715 @@
+// Code generated by ent, DO NOT EDIT.
+
+package alertsuppressionrule
+
+import (
+	"itsm-backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldEQ(FieldDescription, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldEQ(FieldSource, v))
+}
+
+// StartsAt applies equality check predicate on the "starts_at" field. It's identical to StartsAtEQ.
+func StartsAt(v time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// EndsAt applies equality check predicate on the "ends_at" field. It's identical to EndsAtEQ.
+func EndsAt(v time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldEQ(FieldEndsAt, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// HitCount applies equality check predicate on the "hit_count" field. It's identical to HitCountEQ.
+func HitCount(v int) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldEQ(FieldHitCount, v))
+}
+
+// LastHitAt applies equality check predicate on the "last_hit_at" field. It's identical to LastHitAtEQ.
+func LastHitAt(v time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldEQ(FieldLastHitAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v int) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldLTE(FieldTenantID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceIsNil applies the IsNil predicate on the "source" field.
+func SourceIsNil() predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldIsNull(FieldSource))
+}
+
+// SourceNotNil applies the NotNil predicate on the "source" field.
+func SourceNotNil() predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldNotNull(FieldSource))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldContainsFold(FieldSource, v))
+}
+
+// StartsAtEQ applies the EQ predicate on the "starts_at" field.
+func StartsAtEQ(v time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// StartsAtNEQ applies the NEQ predicate on the "starts_at" field.
+func StartsAtNEQ(v time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldNEQ(FieldStartsAt, v))
+}
+
+// StartsAtIn applies the In predicate on the "starts_at" field.
+func StartsAtIn(vs ...time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldIn(FieldStartsAt, vs...))
+}
+
+// StartsAtNotIn applies the NotIn predicate on the "starts_at" field.
+func StartsAtNotIn(vs ...time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldNotIn(FieldStartsAt, vs...))
+}
+
+// StartsAtGT applies the GT predicate on the "starts_at" field.
+func StartsAtGT(v time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldGT(FieldStartsAt, v))
+}
+
+// StartsAtGTE applies the GTE predicate on the "starts_at" field.
+func StartsAtGTE(v time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldGTE(FieldStartsAt, v))
+}
+
+// StartsAtLT applies the LT predicate on the "starts_at" field.
+func StartsAtLT(v time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldLT(FieldStartsAt, v))
+}
+
+// StartsAtLTE applies the LTE predicate on the "starts_at" field.
+func StartsAtLTE(v time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldLTE(FieldStartsAt, v))
+}
+
+// StartsAtIsNil applies the IsNil predicate on the "starts_at" field.
+func StartsAtIsNil() predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldIsNull(FieldStartsAt))
+}
+
+// StartsAtNotNil applies the NotNil predicate on the "starts_at" field.
+func StartsAtNotNil() predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldNotNull(FieldStartsAt))
+}
+
+// EndsAtEQ applies the EQ predicate on the "ends_at" field.
+func EndsAtEQ(v time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldEQ(FieldEndsAt, v))
+}
+
+// EndsAtNEQ applies the NEQ predicate on the "ends_at" field.
+func EndsAtNEQ(v time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldNEQ(FieldEndsAt, v))
+}
+
+// EndsAtIn applies the In predicate on the "ends_at" field.
+func EndsAtIn(vs ...time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldIn(FieldEndsAt, vs...))
+}
+
+// EndsAtNotIn applies the NotIn predicate on the "ends_at" field.
+func EndsAtNotIn(vs ...time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldNotIn(FieldEndsAt, vs...))
+}
+
+// EndsAtGT applies the GT predicate on the "ends_at" field.
+func EndsAtGT(v time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldGT(FieldEndsAt, v))
+}
+
+// EndsAtGTE applies the GTE predicate on the "ends_at" field.
+func EndsAtGTE(v time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldGTE(FieldEndsAt, v))
+}
+
+// EndsAtLT applies the LT predicate on the "ends_at" field.
+func EndsAtLT(v time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldLT(FieldEndsAt, v))
+}
+
+// EndsAtLTE applies the LTE predicate on the "ends_at" field.
+func EndsAtLTE(v time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldLTE(FieldEndsAt, v))
+}
+
+// EndsAtIsNil applies the IsNil predicate on the "ends_at" field.
+func EndsAtIsNil() predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldIsNull(FieldEndsAt))
+}
+
+// EndsAtNotNil applies the NotNil predicate on the "ends_at" field.
+func EndsAtNotNil() predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldNotNull(FieldEndsAt))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// HitCountEQ applies the EQ predicate on the "hit_count" field.
+func HitCountEQ(v int) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldEQ(FieldHitCount, v))
+}
+
+// HitCountNEQ applies the NEQ predicate on the "hit_count" field.
+func HitCountNEQ(v int) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldNEQ(FieldHitCount, v))
+}
+
+// HitCountIn applies the In predicate on the "hit_count" field.
+func HitCountIn(vs ...int) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldIn(FieldHitCount, vs...))
+}
+
+// HitCountNotIn applies the NotIn predicate on the "hit_count" field.
+func HitCountNotIn(vs ...int) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldNotIn(FieldHitCount, vs...))
+}
+
+// HitCountGT applies the GT predicate on the "hit_count" field.
+func HitCountGT(v int) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldGT(FieldHitCount, v))
+}
+
+// HitCountGTE applies the GTE predicate on the "hit_count" field.
+func HitCountGTE(v int) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldGTE(FieldHitCount, v))
+}
+
+// HitCountLT applies the LT predicate on the "hit_count" field.
+func HitCountLT(v int) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldLT(FieldHitCount, v))
+}
+
+// HitCountLTE applies the LTE predicate on the "hit_count" field.
+func HitCountLTE(v int) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldLTE(FieldHitCount, v))
+}
+
+// LastHitAtEQ applies the EQ predicate on the "last_hit_at" field.
+func LastHitAtEQ(v time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldEQ(FieldLastHitAt, v))
+}
+
+// LastHitAtNEQ applies the NEQ predicate on the "last_hit_at" field.
+func LastHitAtNEQ(v time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldNEQ(FieldLastHitAt, v))
+}
+
+// LastHitAtIn applies the In predicate on the "last_hit_at" field.
+func LastHitAtIn(vs ...time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldIn(FieldLastHitAt, vs...))
+}
+
+// LastHitAtNotIn applies the NotIn predicate on the "last_hit_at" field.
+func LastHitAtNotIn(vs ...time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldNotIn(FieldLastHitAt, vs...))
+}
+
+// LastHitAtGT applies the GT predicate on the "last_hit_at" field.
+func LastHitAtGT(v time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldGT(FieldLastHitAt, v))
+}
+
+// LastHitAtGTE applies the GTE predicate on the "last_hit_at" field.
+func LastHitAtGTE(v time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldGTE(FieldLastHitAt, v))
+}
+
+// LastHitAtLT applies the LT predicate on the "last_hit_at" field.
+func LastHitAtLT(v time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldLT(FieldLastHitAt, v))
+}
+
+// LastHitAtLTE applies the LTE predicate on the "last_hit_at" field.
+func LastHitAtLTE(v time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldLTE(FieldLastHitAt, v))
+}
+
+// LastHitAtIsNil applies the IsNil predicate on the "last_hit_at" field.
+func LastHitAtIsNil() predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldIsNull(FieldLastHitAt))
+}
+
+// LastHitAtNotNil applies the NotNil predicate on the "last_hit_at" field.
+func LastHitAtNotNil() predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldNotNull(FieldLastHitAt))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v int) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v int) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...int) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...int) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v int) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v int) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v int) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v int) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AlertSuppressionRule) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AlertSuppressionRule) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AlertSuppressionRule) predicate.AlertSuppressionRule {
+	return predicate.AlertSuppressionRule(sql.NotPredicates(p))
+}
@@ -0,0 +1,440 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/alertsuppressionrule"
+	"itsm-backend/ent/schema"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AlertSuppressionRuleCreate is the builder for creating a AlertSuppressionRule entity.
+type AlertSuppressionRuleCreate struct {
+	config
+	mutation *AlertSuppressionRuleMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *AlertSuppressionRuleCreate) SetTenantID(v int) *AlertSuppressionRuleCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *AlertSuppressionRuleCreate) SetName(v string) *AlertSuppressionRuleCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *AlertSuppressionRuleCreate) SetDescription(v string) *AlertSuppressionRuleCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *AlertSuppressionRuleCreate) SetNillableDescription(v *string) *AlertSuppressionRuleCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *AlertSuppressionRuleCreate) SetSource(v string) *AlertSuppressionRuleCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_c *AlertSuppressionRuleCreate) SetNillableSource(v *string) *AlertSuppressionRuleCreate {
+	if v != nil {
+		_c.SetSource(*v)
+	}
+	return _c
+}
+
+// SetMatchers sets the "matchers" field.
+func (_c *AlertSuppressionRuleCreate) SetMatchers(v []schema.AlertMatcher) *AlertSuppressionRuleCreate {
+	_c.mutation.SetMatchers(v)
+	return _c
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (_c *AlertSuppressionRuleCreate) SetStartsAt(v time.Time) *AlertSuppressionRuleCreate {
+	_c.mutation.SetStartsAt(v)
+	return _c
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (_c *AlertSuppressionRuleCreate) SetNillableStartsAt(v *time.Time) *AlertSuppressionRuleCreate {
+	if v != nil {
+		_c.SetStartsAt(*v)
+	}
+	return _c
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (_c *AlertSuppressionRuleCreate) SetEndsAt(v time.Time) *AlertSuppressionRuleCreate {
+	_c.mutation.SetEndsAt(v)
+	return _c
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (_c *AlertSuppressionRuleCreate) SetNillableEndsAt(v *time.Time) *AlertSuppressionRuleCreate {
+	if v != nil {
+		_c.SetEndsAt(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *AlertSuppressionRuleCreate) SetEnabled(v bool) *AlertSuppressionRuleCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *AlertSuppressionRuleCreate) SetNillableEnabled(v *bool) *AlertSuppressionRuleCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetHitCount sets the "hit_count" field.
+func (_c *AlertSuppressionRuleCreate) SetHitCount(v int) *AlertSuppressionRuleCreate {
+	_c.mutation.SetHitCount(v)
+	return _c
+}
+
+// SetNillableHitCount sets the "hit_count" field if the given value is not nil.
+func (_c *AlertSuppressionRuleCreate) SetNillableHitCount(v *int) *AlertSuppressionRuleCreate {
+	if v != nil {
+		_c.SetHitCount(*v)
+	}
+	return _c
+}
+
+// SetLastHitAt sets the "last_hit_at" field.
+func (_c *AlertSuppressionRuleCreate) SetLastHitAt(v time.Time) *AlertSuppressionRuleCreate {
+	_c.mutation.SetLastHitAt(v)
+	return _c
+}
+
+// SetNillableLastHitAt sets the "last_hit_at" field if the given value is not nil.
+func (_c *AlertSuppressionRuleCreate) SetNillableLastHitAt(v *time.Time) *AlertSuppressionRuleCreate {
+	if v != nil {
+		_c.SetLastHitAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *AlertSuppressionRuleCreate) SetCreatedBy(v int) *AlertSuppressionRuleCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *AlertSuppressionRuleCreate) SetNillableCreatedBy(v *int) *AlertSuppressionRuleCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AlertSuppressionRuleCreate) SetCreatedAt(v time.Time) *AlertSuppressionRuleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AlertSuppressionRuleCreate) SetNillableCreatedAt(v *time.Time) *AlertSuppressionRuleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AlertSuppressionRuleCreate) SetUpdatedAt(v time.Time) *AlertSuppressionRuleCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *AlertSuppressionRuleCreate) SetNillableUpdatedAt(v *time.Time) *AlertSuppressionRuleCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the AlertSuppressionRuleMutation object of the builder.
+func (_c *AlertSuppressionRuleCreate) Mutation() *AlertSuppressionRuleMutation {
+	return _c.mutation
+}
+
+// Save creates the AlertSuppressionRule in the database.
+func (_c *AlertSuppressionRuleCreate) Save(ctx context.Context) (*AlertSuppressionRule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AlertSuppressionRuleCreate) SaveX(ctx context.Context) *AlertSuppressionRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AlertSuppressionRuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AlertSuppressionRuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AlertSuppressionRuleCreate) defaults() {
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := alertsuppressionrule.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.HitCount(); !ok {
+		v := alertsuppressionrule.DefaultHitCount
+		_c.mutation.SetHitCount(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := alertsuppressionrule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := alertsuppressionrule.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AlertSuppressionRuleCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "AlertSuppressionRule.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := alertsuppressionrule.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "AlertSuppressionRule.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "AlertSuppressionRule.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := alertsuppressionrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AlertSuppressionRule.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Matchers(); !ok {
+		return &ValidationError{Name: "matchers", err: errors.New(`ent: missing required field "AlertSuppressionRule.matchers"`)}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "AlertSuppressionRule.enabled"`)}
+	}
+	if _, ok := _c.mutation.HitCount(); !ok {
+		return &ValidationError{Name: "hit_count", err: errors.New(`ent: missing required field "AlertSuppressionRule.hit_count"`)}
+	}
+	if v, ok := _c.mutation.HitCount(); ok {
+		if err := alertsuppressionrule.HitCountValidator(v); err != nil {
+			return &ValidationError{Name: "hit_count", err: fmt.Errorf(`ent: validator failed for field "AlertSuppressionRule.hit_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AlertSuppressionRule.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AlertSuppressionRule.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *AlertSuppressionRuleCreate) sqlSave(ctx context.Context) (*AlertSuppressionRule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AlertSuppressionRuleCreate) createSpec() (*AlertSuppressionRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AlertSuppressionRule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(alertsuppressionrule.Table, sqlgraph.NewFieldSpec(alertsuppressionrule.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(alertsuppressionrule.FieldTenantID, field.TypeInt, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(alertsuppressionrule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(alertsuppressionrule.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(alertsuppressionrule.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.Matchers(); ok {
+		_spec.SetField(alertsuppressionrule.FieldMatchers, field.TypeJSON, value)
+		_node.Matchers = value
+	}
+	if value, ok := _c.mutation.StartsAt(); ok {
+		_spec.SetField(alertsuppressionrule.FieldStartsAt, field.TypeTime, value)
+		_node.StartsAt = &value
+	}
+	if value, ok := _c.mutation.EndsAt(); ok {
+		_spec.SetField(alertsuppressionrule.FieldEndsAt, field.TypeTime, value)
+		_node.EndsAt = &value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(alertsuppressionrule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.HitCount(); ok {
+		_spec.SetField(alertsuppressionrule.FieldHitCount, field.TypeInt, value)
+		_node.HitCount = value
+	}
+	if value, ok := _c.mutation.LastHitAt(); ok {
+		_spec.SetField(alertsuppressionrule.FieldLastHitAt, field.TypeTime, value)
+		_node.LastHitAt = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(alertsuppressionrule.FieldCreatedBy, field.TypeInt, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(alertsuppressionrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(alertsuppressionrule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// AlertSuppressionRuleCreateBulk is the builder for creating many AlertSuppressionRule entities in bulk.
+type AlertSuppressionRuleCreateBulk struct {
+	config
+	err      error
+	builders []*AlertSuppressionRuleCreate
+}
+
+// Save creates the AlertSuppressionRule entities in the database.
+func (_c *AlertSuppressionRuleCreateBulk) Save(ctx context.Context) ([]*AlertSuppressionRule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AlertSuppressionRule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AlertSuppressionRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AlertSuppressionRuleCreateBulk) SaveX(ctx context.Context) []*AlertSuppressionRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AlertSuppressionRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AlertSuppressionRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
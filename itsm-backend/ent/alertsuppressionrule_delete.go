@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"itsm-backend/ent/alertsuppressionrule"
+	"itsm-backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AlertSuppressionRuleDelete is the builder for deleting a AlertSuppressionRule entity.
+type AlertSuppressionRuleDelete struct {
+	config
+	hooks    []Hook
+	mutation *AlertSuppressionRuleMutation
+}
+
+// Where appends a list predicates to the AlertSuppressionRuleDelete builder.
+func (_d *AlertSuppressionRuleDelete) Where(ps ...predicate.AlertSuppressionRule) *AlertSuppressionRuleDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *AlertSuppressionRuleDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AlertSuppressionRuleDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *AlertSuppressionRuleDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(alertsuppressionrule.Table, sqlgraph.NewFieldSpec(alertsuppressionrule.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// AlertSuppressionRuleDeleteOne is the builder for deleting a single AlertSuppressionRule entity.
+type AlertSuppressionRuleDeleteOne struct {
+	_d *AlertSuppressionRuleDelete
+}
+
+// Where appends a list predicates to the AlertSuppressionRuleDelete builder.
+func (_d *AlertSuppressionRuleDeleteOne) Where(ps ...predicate.AlertSuppressionRule) *AlertSuppressionRuleDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *AlertSuppressionRuleDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{alertsuppressionrule.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AlertSuppressionRuleDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
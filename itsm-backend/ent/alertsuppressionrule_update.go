@@ -0,0 +1,808 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/alertsuppressionrule"
+	"itsm-backend/ent/predicate"
+	"itsm-backend/ent/schema"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// AlertSuppressionRuleUpdate is the builder for updating AlertSuppressionRule entities.
+type AlertSuppressionRuleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AlertSuppressionRuleMutation
+}
+
+// Where appends a list predicates to the AlertSuppressionRuleUpdate builder.
+func (_u *AlertSuppressionRuleUpdate) Where(ps ...predicate.AlertSuppressionRule) *AlertSuppressionRuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *AlertSuppressionRuleUpdate) SetTenantID(v int) *AlertSuppressionRuleUpdate {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *AlertSuppressionRuleUpdate) SetNillableTenantID(v *int) *AlertSuppressionRuleUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *AlertSuppressionRuleUpdate) AddTenantID(v int) *AlertSuppressionRuleUpdate {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *AlertSuppressionRuleUpdate) SetName(v string) *AlertSuppressionRuleUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AlertSuppressionRuleUpdate) SetNillableName(v *string) *AlertSuppressionRuleUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *AlertSuppressionRuleUpdate) SetDescription(v string) *AlertSuppressionRuleUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *AlertSuppressionRuleUpdate) SetNillableDescription(v *string) *AlertSuppressionRuleUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *AlertSuppressionRuleUpdate) ClearDescription() *AlertSuppressionRuleUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *AlertSuppressionRuleUpdate) SetSource(v string) *AlertSuppressionRuleUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *AlertSuppressionRuleUpdate) SetNillableSource(v *string) *AlertSuppressionRuleUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// ClearSource clears the value of the "source" field.
+func (_u *AlertSuppressionRuleUpdate) ClearSource() *AlertSuppressionRuleUpdate {
+	_u.mutation.ClearSource()
+	return _u
+}
+
+// SetMatchers sets the "matchers" field.
+func (_u *AlertSuppressionRuleUpdate) SetMatchers(v []schema.AlertMatcher) *AlertSuppressionRuleUpdate {
+	_u.mutation.SetMatchers(v)
+	return _u
+}
+
+// AppendMatchers appends value to the "matchers" field.
+func (_u *AlertSuppressionRuleUpdate) AppendMatchers(v []schema.AlertMatcher) *AlertSuppressionRuleUpdate {
+	_u.mutation.AppendMatchers(v)
+	return _u
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (_u *AlertSuppressionRuleUpdate) SetStartsAt(v time.Time) *AlertSuppressionRuleUpdate {
+	_u.mutation.SetStartsAt(v)
+	return _u
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (_u *AlertSuppressionRuleUpdate) SetNillableStartsAt(v *time.Time) *AlertSuppressionRuleUpdate {
+	if v != nil {
+		_u.SetStartsAt(*v)
+	}
+	return _u
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (_u *AlertSuppressionRuleUpdate) ClearStartsAt() *AlertSuppressionRuleUpdate {
+	_u.mutation.ClearStartsAt()
+	return _u
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (_u *AlertSuppressionRuleUpdate) SetEndsAt(v time.Time) *AlertSuppressionRuleUpdate {
+	_u.mutation.SetEndsAt(v)
+	return _u
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (_u *AlertSuppressionRuleUpdate) SetNillableEndsAt(v *time.Time) *AlertSuppressionRuleUpdate {
+	if v != nil {
+		_u.SetEndsAt(*v)
+	}
+	return _u
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (_u *AlertSuppressionRuleUpdate) ClearEndsAt() *AlertSuppressionRuleUpdate {
+	_u.mutation.ClearEndsAt()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *AlertSuppressionRuleUpdate) SetEnabled(v bool) *AlertSuppressionRuleUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *AlertSuppressionRuleUpdate) SetNillableEnabled(v *bool) *AlertSuppressionRuleUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetHitCount sets the "hit_count" field.
+func (_u *AlertSuppressionRuleUpdate) SetHitCount(v int) *AlertSuppressionRuleUpdate {
+	_u.mutation.ResetHitCount()
+	_u.mutation.SetHitCount(v)
+	return _u
+}
+
+// SetNillableHitCount sets the "hit_count" field if the given value is not nil.
+func (_u *AlertSuppressionRuleUpdate) SetNillableHitCount(v *int) *AlertSuppressionRuleUpdate {
+	if v != nil {
+		_u.SetHitCount(*v)
+	}
+	return _u
+}
+
+// AddHitCount adds value to the "hit_count" field.
+func (_u *AlertSuppressionRuleUpdate) AddHitCount(v int) *AlertSuppressionRuleUpdate {
+	_u.mutation.AddHitCount(v)
+	return _u
+}
+
+// SetLastHitAt sets the "last_hit_at" field.
+func (_u *AlertSuppressionRuleUpdate) SetLastHitAt(v time.Time) *AlertSuppressionRuleUpdate {
+	_u.mutation.SetLastHitAt(v)
+	return _u
+}
+
+// SetNillableLastHitAt sets the "last_hit_at" field if the given value is not nil.
+func (_u *AlertSuppressionRuleUpdate) SetNillableLastHitAt(v *time.Time) *AlertSuppressionRuleUpdate {
+	if v != nil {
+		_u.SetLastHitAt(*v)
+	}
+	return _u
+}
+
+// ClearLastHitAt clears the value of the "last_hit_at" field.
+func (_u *AlertSuppressionRuleUpdate) ClearLastHitAt() *AlertSuppressionRuleUpdate {
+	_u.mutation.ClearLastHitAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *AlertSuppressionRuleUpdate) SetCreatedBy(v int) *AlertSuppressionRuleUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *AlertSuppressionRuleUpdate) SetNillableCreatedBy(v *int) *AlertSuppressionRuleUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *AlertSuppressionRuleUpdate) AddCreatedBy(v int) *AlertSuppressionRuleUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *AlertSuppressionRuleUpdate) ClearCreatedBy() *AlertSuppressionRuleUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AlertSuppressionRuleUpdate) SetUpdatedAt(v time.Time) *AlertSuppressionRuleUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the AlertSuppressionRuleMutation object of the builder.
+func (_u *AlertSuppressionRuleUpdate) Mutation() *AlertSuppressionRuleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AlertSuppressionRuleUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AlertSuppressionRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AlertSuppressionRuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AlertSuppressionRuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AlertSuppressionRuleUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := alertsuppressionrule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AlertSuppressionRuleUpdate) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := alertsuppressionrule.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "AlertSuppressionRule.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := alertsuppressionrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AlertSuppressionRule.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HitCount(); ok {
+		if err := alertsuppressionrule.HitCountValidator(v); err != nil {
+			return &ValidationError{Name: "hit_count", err: fmt.Errorf(`ent: validator failed for field "AlertSuppressionRule.hit_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AlertSuppressionRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(alertsuppressionrule.Table, alertsuppressionrule.Columns, sqlgraph.NewFieldSpec(alertsuppressionrule.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(alertsuppressionrule.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(alertsuppressionrule.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(alertsuppressionrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(alertsuppressionrule.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(alertsuppressionrule.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(alertsuppressionrule.FieldSource, field.TypeString, value)
+	}
+	if _u.mutation.SourceCleared() {
+		_spec.ClearField(alertsuppressionrule.FieldSource, field.TypeString)
+	}
+	if value, ok := _u.mutation.Matchers(); ok {
+		_spec.SetField(alertsuppressionrule.FieldMatchers, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMatchers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, alertsuppressionrule.FieldMatchers, value)
+		})
+	}
+	if value, ok := _u.mutation.StartsAt(); ok {
+		_spec.SetField(alertsuppressionrule.FieldStartsAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartsAtCleared() {
+		_spec.ClearField(alertsuppressionrule.FieldStartsAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EndsAt(); ok {
+		_spec.SetField(alertsuppressionrule.FieldEndsAt, field.TypeTime, value)
+	}
+	if _u.mutation.EndsAtCleared() {
+		_spec.ClearField(alertsuppressionrule.FieldEndsAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(alertsuppressionrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.HitCount(); ok {
+		_spec.SetField(alertsuppressionrule.FieldHitCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHitCount(); ok {
+		_spec.AddField(alertsuppressionrule.FieldHitCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastHitAt(); ok {
+		_spec.SetField(alertsuppressionrule.FieldLastHitAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastHitAtCleared() {
+		_spec.ClearField(alertsuppressionrule.FieldLastHitAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(alertsuppressionrule.FieldCreatedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(alertsuppressionrule.FieldCreatedBy, field.TypeInt, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(alertsuppressionrule.FieldCreatedBy, field.TypeInt)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(alertsuppressionrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{alertsuppressionrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AlertSuppressionRuleUpdateOne is the builder for updating a single AlertSuppressionRule entity.
+type AlertSuppressionRuleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AlertSuppressionRuleMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *AlertSuppressionRuleUpdateOne) SetTenantID(v int) *AlertSuppressionRuleUpdateOne {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *AlertSuppressionRuleUpdateOne) SetNillableTenantID(v *int) *AlertSuppressionRuleUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *AlertSuppressionRuleUpdateOne) AddTenantID(v int) *AlertSuppressionRuleUpdateOne {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *AlertSuppressionRuleUpdateOne) SetName(v string) *AlertSuppressionRuleUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AlertSuppressionRuleUpdateOne) SetNillableName(v *string) *AlertSuppressionRuleUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *AlertSuppressionRuleUpdateOne) SetDescription(v string) *AlertSuppressionRuleUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *AlertSuppressionRuleUpdateOne) SetNillableDescription(v *string) *AlertSuppressionRuleUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *AlertSuppressionRuleUpdateOne) ClearDescription() *AlertSuppressionRuleUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *AlertSuppressionRuleUpdateOne) SetSource(v string) *AlertSuppressionRuleUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *AlertSuppressionRuleUpdateOne) SetNillableSource(v *string) *AlertSuppressionRuleUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// ClearSource clears the value of the "source" field.
+func (_u *AlertSuppressionRuleUpdateOne) ClearSource() *AlertSuppressionRuleUpdateOne {
+	_u.mutation.ClearSource()
+	return _u
+}
+
+// SetMatchers sets the "matchers" field.
+func (_u *AlertSuppressionRuleUpdateOne) SetMatchers(v []schema.AlertMatcher) *AlertSuppressionRuleUpdateOne {
+	_u.mutation.SetMatchers(v)
+	return _u
+}
+
+// AppendMatchers appends value to the "matchers" field.
+func (_u *AlertSuppressionRuleUpdateOne) AppendMatchers(v []schema.AlertMatcher) *AlertSuppressionRuleUpdateOne {
+	_u.mutation.AppendMatchers(v)
+	return _u
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (_u *AlertSuppressionRuleUpdateOne) SetStartsAt(v time.Time) *AlertSuppressionRuleUpdateOne {
+	_u.mutation.SetStartsAt(v)
+	return _u
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (_u *AlertSuppressionRuleUpdateOne) SetNillableStartsAt(v *time.Time) *AlertSuppressionRuleUpdateOne {
+	if v != nil {
+		_u.SetStartsAt(*v)
+	}
+	return _u
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (_u *AlertSuppressionRuleUpdateOne) ClearStartsAt() *AlertSuppressionRuleUpdateOne {
+	_u.mutation.ClearStartsAt()
+	return _u
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (_u *AlertSuppressionRuleUpdateOne) SetEndsAt(v time.Time) *AlertSuppressionRuleUpdateOne {
+	_u.mutation.SetEndsAt(v)
+	return _u
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (_u *AlertSuppressionRuleUpdateOne) SetNillableEndsAt(v *time.Time) *AlertSuppressionRuleUpdateOne {
+	if v != nil {
+		_u.SetEndsAt(*v)
+	}
+	return _u
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (_u *AlertSuppressionRuleUpdateOne) ClearEndsAt() *AlertSuppressionRuleUpdateOne {
+	_u.mutation.ClearEndsAt()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *AlertSuppressionRuleUpdateOne) SetEnabled(v bool) *AlertSuppressionRuleUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *AlertSuppressionRuleUpdateOne) SetNillableEnabled(v *bool) *AlertSuppressionRuleUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetHitCount sets the "hit_count" field.
+func (_u *AlertSuppressionRuleUpdateOne) SetHitCount(v int) *AlertSuppressionRuleUpdateOne {
+	_u.mutation.ResetHitCount()
+	_u.mutation.SetHitCount(v)
+	return _u
+}
+
+// SetNillableHitCount sets the "hit_count" field if the given value is not nil.
+func (_u *AlertSuppressionRuleUpdateOne) SetNillableHitCount(v *int) *AlertSuppressionRuleUpdateOne {
+	if v != nil {
+		_u.SetHitCount(*v)
+	}
+	return _u
+}
+
+// AddHitCount adds value to the "hit_count" field.
+func (_u *AlertSuppressionRuleUpdateOne) AddHitCount(v int) *AlertSuppressionRuleUpdateOne {
+	_u.mutation.AddHitCount(v)
+	return _u
+}
+
+// SetLastHitAt sets the "last_hit_at" field.
+func (_u *AlertSuppressionRuleUpdateOne) SetLastHitAt(v time.Time) *AlertSuppressionRuleUpdateOne {
+	_u.mutation.SetLastHitAt(v)
+	return _u
+}
+
+// SetNillableLastHitAt sets the "last_hit_at" field if the given value is not nil.
+func (_u *AlertSuppressionRuleUpdateOne) SetNillableLastHitAt(v *time.Time) *AlertSuppressionRuleUpdateOne {
+	if v != nil {
+		_u.SetLastHitAt(*v)
+	}
+	return _u
+}
+
+// ClearLastHitAt clears the value of the "last_hit_at" field.
+func (_u *AlertSuppressionRuleUpdateOne) ClearLastHitAt() *AlertSuppressionRuleUpdateOne {
+	_u.mutation.ClearLastHitAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *AlertSuppressionRuleUpdateOne) SetCreatedBy(v int) *AlertSuppressionRuleUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *AlertSuppressionRuleUpdateOne) SetNillableCreatedBy(v *int) *AlertSuppressionRuleUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *AlertSuppressionRuleUpdateOne) AddCreatedBy(v int) *AlertSuppressionRuleUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *AlertSuppressionRuleUpdateOne) ClearCreatedBy() *AlertSuppressionRuleUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AlertSuppressionRuleUpdateOne) SetUpdatedAt(v time.Time) *AlertSuppressionRuleUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the AlertSuppressionRuleMutation object of the builder.
+func (_u *AlertSuppressionRuleUpdateOne) Mutation() *AlertSuppressionRuleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AlertSuppressionRuleUpdate builder.
+func (_u *AlertSuppressionRuleUpdateOne) Where(ps ...predicate.AlertSuppressionRule) *AlertSuppressionRuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AlertSuppressionRuleUpdateOne) Select(field string, fields ...string) *AlertSuppressionRuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AlertSuppressionRule entity.
+func (_u *AlertSuppressionRuleUpdateOne) Save(ctx context.Context) (*AlertSuppressionRule, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AlertSuppressionRuleUpdateOne) SaveX(ctx context.Context) *AlertSuppressionRule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AlertSuppressionRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AlertSuppressionRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AlertSuppressionRuleUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := alertsuppressionrule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AlertSuppressionRuleUpdateOne) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := alertsuppressionrule.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "AlertSuppressionRule.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := alertsuppressionrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AlertSuppressionRule.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HitCount(); ok {
+		if err := alertsuppressionrule.HitCountValidator(v); err != nil {
+			return &ValidationError{Name: "hit_count", err: fmt.Errorf(`ent: validator failed for field "AlertSuppressionRule.hit_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AlertSuppressionRuleUpdateOne) sqlSave(ctx context.Context) (_node *AlertSuppressionRule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(alertsuppressionrule.Table, alertsuppressionrule.Columns, sqlgraph.NewFieldSpec(alertsuppressionrule.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AlertSuppressionRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, alertsuppressionrule.FieldID)
+		for _, f := range fields {
+			if !alertsuppressionrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != alertsuppressionrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(alertsuppressionrule.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(alertsuppressionrule.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(alertsuppressionrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(alertsuppressionrule.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(alertsuppressionrule.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(alertsuppressionrule.FieldSource, field.TypeString, value)
+	}
+	if _u.mutation.SourceCleared() {
+		_spec.ClearField(alertsuppressionrule.FieldSource, field.TypeString)
+	}
+	if value, ok := _u.mutation.Matchers(); ok {
+		_spec.SetField(alertsuppressionrule.FieldMatchers, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMatchers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, alertsuppressionrule.FieldMatchers, value)
+		})
+	}
+	if value, ok := _u.mutation.StartsAt(); ok {
+		_spec.SetField(alertsuppressionrule.FieldStartsAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartsAtCleared() {
+		_spec.ClearField(alertsuppressionrule.FieldStartsAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EndsAt(); ok {
+		_spec.SetField(alertsuppressionrule.FieldEndsAt, field.TypeTime, value)
+	}
+	if _u.mutation.EndsAtCleared() {
+		_spec.ClearField(alertsuppressionrule.FieldEndsAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(alertsuppressionrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.HitCount(); ok {
+		_spec.SetField(alertsuppressionrule.FieldHitCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHitCount(); ok {
+		_spec.AddField(alertsuppressionrule.FieldHitCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastHitAt(); ok {
+		_spec.SetField(alertsuppressionrule.FieldLastHitAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastHitAtCleared() {
+		_spec.ClearField(alertsuppressionrule.FieldLastHitAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(alertsuppressionrule.FieldCreatedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(alertsuppressionrule.FieldCreatedBy, field.TypeInt, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(alertsuppressionrule.FieldCreatedBy, field.TypeInt)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(alertsuppressionrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &AlertSuppressionRule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{alertsuppressionrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
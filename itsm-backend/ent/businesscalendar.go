@@ -0,0 +1,217 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"itsm-backend/ent/businesscalendar"
+	"itsm-backend/ent/schema"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BusinessCalendar is the model entity for the BusinessCalendar schema.
+type BusinessCalendar struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 租户ID
+	TenantID int `json:"tenant_id,omitempty"`
+	// 日历名称
+	Name string `json:"name,omitempty"`
+	// 日历描述
+	Description string `json:"description,omitempty"`
+	// 时区，工作时段按此时区计算
+	TimeZone string `json:"time_zone,omitempty"`
+	// 每周工作时段，同一天可配置多个时段（分段班次）
+	WorkWindows []schema.CalendarWorkWindow `json:"work_windows,omitempty"`
+	// 节假日
+	Holidays []schema.CalendarDay `json:"holidays,omitempty"`
+	// 调休上班日
+	ExtraWorkdays []schema.CalendarDay `json:"extra_workdays,omitempty"`
+	// 是否租户默认日历
+	IsDefault bool `json:"is_default,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BusinessCalendar) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case businesscalendar.FieldWorkWindows, businesscalendar.FieldHolidays, businesscalendar.FieldExtraWorkdays:
+			values[i] = new([]byte)
+		case businesscalendar.FieldIsDefault:
+			values[i] = new(sql.NullBool)
+		case businesscalendar.FieldID, businesscalendar.FieldTenantID:
+			values[i] = new(sql.NullInt64)
+		case businesscalendar.FieldName, businesscalendar.FieldDescription, businesscalendar.FieldTimeZone:
+			values[i] = new(sql.NullString)
+		case businesscalendar.FieldCreatedAt, businesscalendar.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BusinessCalendar fields.
+func (_m *BusinessCalendar) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case businesscalendar.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case businesscalendar.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = int(value.Int64)
+			}
+		case businesscalendar.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case businesscalendar.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case businesscalendar.FieldTimeZone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field time_zone", values[i])
+			} else if value.Valid {
+				_m.TimeZone = value.String
+			}
+		case businesscalendar.FieldWorkWindows:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field work_windows", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.WorkWindows); err != nil {
+					return fmt.Errorf("unmarshal field work_windows: %w", err)
+				}
+			}
+		case businesscalendar.FieldHolidays:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field holidays", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Holidays); err != nil {
+					return fmt.Errorf("unmarshal field holidays: %w", err)
+				}
+			}
+		case businesscalendar.FieldExtraWorkdays:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field extra_workdays", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ExtraWorkdays); err != nil {
+					return fmt.Errorf("unmarshal field extra_workdays: %w", err)
+				}
+			}
+		case businesscalendar.FieldIsDefault:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_default", values[i])
+			} else if value.Valid {
+				_m.IsDefault = value.Bool
+			}
+		case businesscalendar.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case businesscalendar.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BusinessCalendar.
+// This includes values selected through modifiers, order, etc.
+func (_m *BusinessCalendar) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BusinessCalendar.
+// Note that you need to call BusinessCalendar.Unwrap() before calling this method if this BusinessCalendar
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BusinessCalendar) Update() *BusinessCalendarUpdateOne {
+	return NewBusinessCalendarClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BusinessCalendar entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BusinessCalendar) Unwrap() *BusinessCalendar {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BusinessCalendar is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BusinessCalendar) String() string {
+	var builder strings.Builder
+	builder.WriteString("BusinessCalendar(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("time_zone=")
+	builder.WriteString(_m.TimeZone)
+	builder.WriteString(", ")
+	builder.WriteString("work_windows=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkWindows))
+	builder.WriteString(", ")
+	builder.WriteString("holidays=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Holidays))
+	builder.WriteString(", ")
+	builder.WriteString("extra_workdays=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExtraWorkdays))
+	builder.WriteString(", ")
+	builder.WriteString("is_default=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsDefault))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BusinessCalendars is a parsable slice of BusinessCalendar.
+type BusinessCalendars []*BusinessCalendar
@@ -0,0 +1,365 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/businesscalendar"
+	"itsm-backend/ent/schema"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BusinessCalendarCreate is the builder for creating a BusinessCalendar entity.
+type BusinessCalendarCreate struct {
+	config
+	mutation *BusinessCalendarMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *BusinessCalendarCreate) SetTenantID(v int) *BusinessCalendarCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *BusinessCalendarCreate) SetName(v string) *BusinessCalendarCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *BusinessCalendarCreate) SetDescription(v string) *BusinessCalendarCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *BusinessCalendarCreate) SetNillableDescription(v *string) *BusinessCalendarCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (_c *BusinessCalendarCreate) SetTimeZone(v string) *BusinessCalendarCreate {
+	_c.mutation.SetTimeZone(v)
+	return _c
+}
+
+// SetNillableTimeZone sets the "time_zone" field if the given value is not nil.
+func (_c *BusinessCalendarCreate) SetNillableTimeZone(v *string) *BusinessCalendarCreate {
+	if v != nil {
+		_c.SetTimeZone(*v)
+	}
+	return _c
+}
+
+// SetWorkWindows sets the "work_windows" field.
+func (_c *BusinessCalendarCreate) SetWorkWindows(v []schema.CalendarWorkWindow) *BusinessCalendarCreate {
+	_c.mutation.SetWorkWindows(v)
+	return _c
+}
+
+// SetHolidays sets the "holidays" field.
+func (_c *BusinessCalendarCreate) SetHolidays(v []schema.CalendarDay) *BusinessCalendarCreate {
+	_c.mutation.SetHolidays(v)
+	return _c
+}
+
+// SetExtraWorkdays sets the "extra_workdays" field.
+func (_c *BusinessCalendarCreate) SetExtraWorkdays(v []schema.CalendarDay) *BusinessCalendarCreate {
+	_c.mutation.SetExtraWorkdays(v)
+	return _c
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_c *BusinessCalendarCreate) SetIsDefault(v bool) *BusinessCalendarCreate {
+	_c.mutation.SetIsDefault(v)
+	return _c
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_c *BusinessCalendarCreate) SetNillableIsDefault(v *bool) *BusinessCalendarCreate {
+	if v != nil {
+		_c.SetIsDefault(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BusinessCalendarCreate) SetCreatedAt(v time.Time) *BusinessCalendarCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BusinessCalendarCreate) SetNillableCreatedAt(v *time.Time) *BusinessCalendarCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *BusinessCalendarCreate) SetUpdatedAt(v time.Time) *BusinessCalendarCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *BusinessCalendarCreate) SetNillableUpdatedAt(v *time.Time) *BusinessCalendarCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the BusinessCalendarMutation object of the builder.
+func (_c *BusinessCalendarCreate) Mutation() *BusinessCalendarMutation {
+	return _c.mutation
+}
+
+// Save creates the BusinessCalendar in the database.
+func (_c *BusinessCalendarCreate) Save(ctx context.Context) (*BusinessCalendar, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BusinessCalendarCreate) SaveX(ctx context.Context) *BusinessCalendar {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BusinessCalendarCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BusinessCalendarCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BusinessCalendarCreate) defaults() {
+	if _, ok := _c.mutation.TimeZone(); !ok {
+		v := businesscalendar.DefaultTimeZone
+		_c.mutation.SetTimeZone(v)
+	}
+	if _, ok := _c.mutation.IsDefault(); !ok {
+		v := businesscalendar.DefaultIsDefault
+		_c.mutation.SetIsDefault(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := businesscalendar.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := businesscalendar.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BusinessCalendarCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "BusinessCalendar.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := businesscalendar.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "BusinessCalendar.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "BusinessCalendar.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := businesscalendar.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "BusinessCalendar.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TimeZone(); !ok {
+		return &ValidationError{Name: "time_zone", err: errors.New(`ent: missing required field "BusinessCalendar.time_zone"`)}
+	}
+	if _, ok := _c.mutation.WorkWindows(); !ok {
+		return &ValidationError{Name: "work_windows", err: errors.New(`ent: missing required field "BusinessCalendar.work_windows"`)}
+	}
+	if _, ok := _c.mutation.IsDefault(); !ok {
+		return &ValidationError{Name: "is_default", err: errors.New(`ent: missing required field "BusinessCalendar.is_default"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BusinessCalendar.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "BusinessCalendar.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *BusinessCalendarCreate) sqlSave(ctx context.Context) (*BusinessCalendar, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BusinessCalendarCreate) createSpec() (*BusinessCalendar, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BusinessCalendar{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(businesscalendar.Table, sqlgraph.NewFieldSpec(businesscalendar.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(businesscalendar.FieldTenantID, field.TypeInt, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(businesscalendar.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(businesscalendar.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.TimeZone(); ok {
+		_spec.SetField(businesscalendar.FieldTimeZone, field.TypeString, value)
+		_node.TimeZone = value
+	}
+	if value, ok := _c.mutation.WorkWindows(); ok {
+		_spec.SetField(businesscalendar.FieldWorkWindows, field.TypeJSON, value)
+		_node.WorkWindows = value
+	}
+	if value, ok := _c.mutation.Holidays(); ok {
+		_spec.SetField(businesscalendar.FieldHolidays, field.TypeJSON, value)
+		_node.Holidays = value
+	}
+	if value, ok := _c.mutation.ExtraWorkdays(); ok {
+		_spec.SetField(businesscalendar.FieldExtraWorkdays, field.TypeJSON, value)
+		_node.ExtraWorkdays = value
+	}
+	if value, ok := _c.mutation.IsDefault(); ok {
+		_spec.SetField(businesscalendar.FieldIsDefault, field.TypeBool, value)
+		_node.IsDefault = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(businesscalendar.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(businesscalendar.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// BusinessCalendarCreateBulk is the builder for creating many BusinessCalendar entities in bulk.
+type BusinessCalendarCreateBulk struct {
+	config
+	err      error
+	builders []*BusinessCalendarCreate
+}
+
+// Save creates the BusinessCalendar entities in the database.
+func (_c *BusinessCalendarCreateBulk) Save(ctx context.Context) ([]*BusinessCalendar, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BusinessCalendar, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BusinessCalendarMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BusinessCalendarCreateBulk) SaveX(ctx context.Context) []*BusinessCalendar {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BusinessCalendarCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BusinessCalendarCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"itsm-backend/ent/businesscalendar"
+	"itsm-backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BusinessCalendarDelete is the builder for deleting a BusinessCalendar entity.
+type BusinessCalendarDelete struct {
+	config
+	hooks    []Hook
+	mutation *BusinessCalendarMutation
+}
+
+// Where appends a list predicates to the BusinessCalendarDelete builder.
+func (_d *BusinessCalendarDelete) Where(ps ...predicate.BusinessCalendar) *BusinessCalendarDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BusinessCalendarDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BusinessCalendarDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BusinessCalendarDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(businesscalendar.Table, sqlgraph.NewFieldSpec(businesscalendar.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BusinessCalendarDeleteOne is the builder for deleting a single BusinessCalendar entity.
+type BusinessCalendarDeleteOne struct {
+	_d *BusinessCalendarDelete
+}
+
+// Where appends a list predicates to the BusinessCalendarDelete builder.
+func (_d *BusinessCalendarDeleteOne) Where(ps ...predicate.BusinessCalendar) *BusinessCalendarDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BusinessCalendarDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{businesscalendar.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BusinessCalendarDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
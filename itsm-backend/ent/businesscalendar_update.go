@@ -0,0 +1,648 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/businesscalendar"
+	"itsm-backend/ent/predicate"
+	"itsm-backend/ent/schema"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BusinessCalendarUpdate is the builder for updating BusinessCalendar entities.
+type BusinessCalendarUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BusinessCalendarMutation
+}
+
+// Where appends a list predicates to the BusinessCalendarUpdate builder.
+func (_u *BusinessCalendarUpdate) Where(ps ...predicate.BusinessCalendar) *BusinessCalendarUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *BusinessCalendarUpdate) SetTenantID(v int) *BusinessCalendarUpdate {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *BusinessCalendarUpdate) SetNillableTenantID(v *int) *BusinessCalendarUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *BusinessCalendarUpdate) AddTenantID(v int) *BusinessCalendarUpdate {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BusinessCalendarUpdate) SetName(v string) *BusinessCalendarUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BusinessCalendarUpdate) SetNillableName(v *string) *BusinessCalendarUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BusinessCalendarUpdate) SetDescription(v string) *BusinessCalendarUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BusinessCalendarUpdate) SetNillableDescription(v *string) *BusinessCalendarUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BusinessCalendarUpdate) ClearDescription() *BusinessCalendarUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (_u *BusinessCalendarUpdate) SetTimeZone(v string) *BusinessCalendarUpdate {
+	_u.mutation.SetTimeZone(v)
+	return _u
+}
+
+// SetNillableTimeZone sets the "time_zone" field if the given value is not nil.
+func (_u *BusinessCalendarUpdate) SetNillableTimeZone(v *string) *BusinessCalendarUpdate {
+	if v != nil {
+		_u.SetTimeZone(*v)
+	}
+	return _u
+}
+
+// SetWorkWindows sets the "work_windows" field.
+func (_u *BusinessCalendarUpdate) SetWorkWindows(v []schema.CalendarWorkWindow) *BusinessCalendarUpdate {
+	_u.mutation.SetWorkWindows(v)
+	return _u
+}
+
+// AppendWorkWindows appends value to the "work_windows" field.
+func (_u *BusinessCalendarUpdate) AppendWorkWindows(v []schema.CalendarWorkWindow) *BusinessCalendarUpdate {
+	_u.mutation.AppendWorkWindows(v)
+	return _u
+}
+
+// SetHolidays sets the "holidays" field.
+func (_u *BusinessCalendarUpdate) SetHolidays(v []schema.CalendarDay) *BusinessCalendarUpdate {
+	_u.mutation.SetHolidays(v)
+	return _u
+}
+
+// AppendHolidays appends value to the "holidays" field.
+func (_u *BusinessCalendarUpdate) AppendHolidays(v []schema.CalendarDay) *BusinessCalendarUpdate {
+	_u.mutation.AppendHolidays(v)
+	return _u
+}
+
+// ClearHolidays clears the value of the "holidays" field.
+func (_u *BusinessCalendarUpdate) ClearHolidays() *BusinessCalendarUpdate {
+	_u.mutation.ClearHolidays()
+	return _u
+}
+
+// SetExtraWorkdays sets the "extra_workdays" field.
+func (_u *BusinessCalendarUpdate) SetExtraWorkdays(v []schema.CalendarDay) *BusinessCalendarUpdate {
+	_u.mutation.SetExtraWorkdays(v)
+	return _u
+}
+
+// AppendExtraWorkdays appends value to the "extra_workdays" field.
+func (_u *BusinessCalendarUpdate) AppendExtraWorkdays(v []schema.CalendarDay) *BusinessCalendarUpdate {
+	_u.mutation.AppendExtraWorkdays(v)
+	return _u
+}
+
+// ClearExtraWorkdays clears the value of the "extra_workdays" field.
+func (_u *BusinessCalendarUpdate) ClearExtraWorkdays() *BusinessCalendarUpdate {
+	_u.mutation.ClearExtraWorkdays()
+	return _u
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_u *BusinessCalendarUpdate) SetIsDefault(v bool) *BusinessCalendarUpdate {
+	_u.mutation.SetIsDefault(v)
+	return _u
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_u *BusinessCalendarUpdate) SetNillableIsDefault(v *bool) *BusinessCalendarUpdate {
+	if v != nil {
+		_u.SetIsDefault(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *BusinessCalendarUpdate) SetCreatedAt(v time.Time) *BusinessCalendarUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *BusinessCalendarUpdate) SetNillableCreatedAt(v *time.Time) *BusinessCalendarUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BusinessCalendarUpdate) SetUpdatedAt(v time.Time) *BusinessCalendarUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the BusinessCalendarMutation object of the builder.
+func (_u *BusinessCalendarUpdate) Mutation() *BusinessCalendarMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BusinessCalendarUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BusinessCalendarUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BusinessCalendarUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BusinessCalendarUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BusinessCalendarUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := businesscalendar.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BusinessCalendarUpdate) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := businesscalendar.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "BusinessCalendar.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := businesscalendar.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "BusinessCalendar.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BusinessCalendarUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(businesscalendar.Table, businesscalendar.Columns, sqlgraph.NewFieldSpec(businesscalendar.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(businesscalendar.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(businesscalendar.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(businesscalendar.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(businesscalendar.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(businesscalendar.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.TimeZone(); ok {
+		_spec.SetField(businesscalendar.FieldTimeZone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.WorkWindows(); ok {
+		_spec.SetField(businesscalendar.FieldWorkWindows, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedWorkWindows(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, businesscalendar.FieldWorkWindows, value)
+		})
+	}
+	if value, ok := _u.mutation.Holidays(); ok {
+		_spec.SetField(businesscalendar.FieldHolidays, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedHolidays(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, businesscalendar.FieldHolidays, value)
+		})
+	}
+	if _u.mutation.HolidaysCleared() {
+		_spec.ClearField(businesscalendar.FieldHolidays, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ExtraWorkdays(); ok {
+		_spec.SetField(businesscalendar.FieldExtraWorkdays, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedExtraWorkdays(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, businesscalendar.FieldExtraWorkdays, value)
+		})
+	}
+	if _u.mutation.ExtraWorkdaysCleared() {
+		_spec.ClearField(businesscalendar.FieldExtraWorkdays, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.IsDefault(); ok {
+		_spec.SetField(businesscalendar.FieldIsDefault, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(businesscalendar.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(businesscalendar.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{businesscalendar.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BusinessCalendarUpdateOne is the builder for updating a single BusinessCalendar entity.
+type BusinessCalendarUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BusinessCalendarMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *BusinessCalendarUpdateOne) SetTenantID(v int) *BusinessCalendarUpdateOne {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *BusinessCalendarUpdateOne) SetNillableTenantID(v *int) *BusinessCalendarUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *BusinessCalendarUpdateOne) AddTenantID(v int) *BusinessCalendarUpdateOne {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BusinessCalendarUpdateOne) SetName(v string) *BusinessCalendarUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BusinessCalendarUpdateOne) SetNillableName(v *string) *BusinessCalendarUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *BusinessCalendarUpdateOne) SetDescription(v string) *BusinessCalendarUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BusinessCalendarUpdateOne) SetNillableDescription(v *string) *BusinessCalendarUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BusinessCalendarUpdateOne) ClearDescription() *BusinessCalendarUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (_u *BusinessCalendarUpdateOne) SetTimeZone(v string) *BusinessCalendarUpdateOne {
+	_u.mutation.SetTimeZone(v)
+	return _u
+}
+
+// SetNillableTimeZone sets the "time_zone" field if the given value is not nil.
+func (_u *BusinessCalendarUpdateOne) SetNillableTimeZone(v *string) *BusinessCalendarUpdateOne {
+	if v != nil {
+		_u.SetTimeZone(*v)
+	}
+	return _u
+}
+
+// SetWorkWindows sets the "work_windows" field.
+func (_u *BusinessCalendarUpdateOne) SetWorkWindows(v []schema.CalendarWorkWindow) *BusinessCalendarUpdateOne {
+	_u.mutation.SetWorkWindows(v)
+	return _u
+}
+
+// AppendWorkWindows appends value to the "work_windows" field.
+func (_u *BusinessCalendarUpdateOne) AppendWorkWindows(v []schema.CalendarWorkWindow) *BusinessCalendarUpdateOne {
+	_u.mutation.AppendWorkWindows(v)
+	return _u
+}
+
+// SetHolidays sets the "holidays" field.
+func (_u *BusinessCalendarUpdateOne) SetHolidays(v []schema.CalendarDay) *BusinessCalendarUpdateOne {
+	_u.mutation.SetHolidays(v)
+	return _u
+}
+
+// AppendHolidays appends value to the "holidays" field.
+func (_u *BusinessCalendarUpdateOne) AppendHolidays(v []schema.CalendarDay) *BusinessCalendarUpdateOne {
+	_u.mutation.AppendHolidays(v)
+	return _u
+}
+
+// ClearHolidays clears the value of the "holidays" field.
+func (_u *BusinessCalendarUpdateOne) ClearHolidays() *BusinessCalendarUpdateOne {
+	_u.mutation.ClearHolidays()
+	return _u
+}
+
+// SetExtraWorkdays sets the "extra_workdays" field.
+func (_u *BusinessCalendarUpdateOne) SetExtraWorkdays(v []schema.CalendarDay) *BusinessCalendarUpdateOne {
+	_u.mutation.SetExtraWorkdays(v)
+	return _u
+}
+
+// AppendExtraWorkdays appends value to the "extra_workdays" field.
+func (_u *BusinessCalendarUpdateOne) AppendExtraWorkdays(v []schema.CalendarDay) *BusinessCalendarUpdateOne {
+	_u.mutation.AppendExtraWorkdays(v)
+	return _u
+}
+
+// ClearExtraWorkdays clears the value of the "extra_workdays" field.
+func (_u *BusinessCalendarUpdateOne) ClearExtraWorkdays() *BusinessCalendarUpdateOne {
+	_u.mutation.ClearExtraWorkdays()
+	return _u
+}
+
+// SetIsDefault sets the "is_default" field.
+func (_u *BusinessCalendarUpdateOne) SetIsDefault(v bool) *BusinessCalendarUpdateOne {
+	_u.mutation.SetIsDefault(v)
+	return _u
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (_u *BusinessCalendarUpdateOne) SetNillableIsDefault(v *bool) *BusinessCalendarUpdateOne {
+	if v != nil {
+		_u.SetIsDefault(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *BusinessCalendarUpdateOne) SetCreatedAt(v time.Time) *BusinessCalendarUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *BusinessCalendarUpdateOne) SetNillableCreatedAt(v *time.Time) *BusinessCalendarUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BusinessCalendarUpdateOne) SetUpdatedAt(v time.Time) *BusinessCalendarUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the BusinessCalendarMutation object of the builder.
+func (_u *BusinessCalendarUpdateOne) Mutation() *BusinessCalendarMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BusinessCalendarUpdate builder.
+func (_u *BusinessCalendarUpdateOne) Where(ps ...predicate.BusinessCalendar) *BusinessCalendarUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BusinessCalendarUpdateOne) Select(field string, fields ...string) *BusinessCalendarUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BusinessCalendar entity.
+func (_u *BusinessCalendarUpdateOne) Save(ctx context.Context) (*BusinessCalendar, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BusinessCalendarUpdateOne) SaveX(ctx context.Context) *BusinessCalendar {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BusinessCalendarUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BusinessCalendarUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BusinessCalendarUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := businesscalendar.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BusinessCalendarUpdateOne) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := businesscalendar.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "BusinessCalendar.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := businesscalendar.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "BusinessCalendar.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BusinessCalendarUpdateOne) sqlSave(ctx context.Context) (_node *BusinessCalendar, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(businesscalendar.Table, businesscalendar.Columns, sqlgraph.NewFieldSpec(businesscalendar.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BusinessCalendar.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, businesscalendar.FieldID)
+		for _, f := range fields {
+			if !businesscalendar.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != businesscalendar.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(businesscalendar.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(businesscalendar.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(businesscalendar.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(businesscalendar.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(businesscalendar.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.TimeZone(); ok {
+		_spec.SetField(businesscalendar.FieldTimeZone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.WorkWindows(); ok {
+		_spec.SetField(businesscalendar.FieldWorkWindows, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedWorkWindows(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, businesscalendar.FieldWorkWindows, value)
+		})
+	}
+	if value, ok := _u.mutation.Holidays(); ok {
+		_spec.SetField(businesscalendar.FieldHolidays, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedHolidays(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, businesscalendar.FieldHolidays, value)
+		})
+	}
+	if _u.mutation.HolidaysCleared() {
+		_spec.ClearField(businesscalendar.FieldHolidays, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ExtraWorkdays(); ok {
+		_spec.SetField(businesscalendar.FieldExtraWorkdays, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedExtraWorkdays(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, businesscalendar.FieldExtraWorkdays, value)
+		})
+	}
+	if _u.mutation.ExtraWorkdaysCleared() {
+		_spec.ClearField(businesscalendar.FieldExtraWorkdays, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.IsDefault(); ok {
+		_spec.SetField(businesscalendar.FieldIsDefault, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(businesscalendar.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(businesscalendar.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &BusinessCalendar{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{businesscalendar.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"itsm-backend/ent/decisiondefinition"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// DecisionDefinition is the model entity for the DecisionDefinition schema.
+type DecisionDefinition struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 租户ID
+	TenantID int `json:"tenant_id,omitempty"`
+	// 决策Key，对应DMN decision的id
+	Key string `json:"key,omitempty"`
+	// 决策名称
+	Name string `json:"name,omitempty"`
+	// 版本号，按key递增
+	Version int `json:"version,omitempty"`
+	// 决策表命中策略
+	HitPolicy string `json:"hit_policy,omitempty"`
+	// DMN XML定义内容
+	DmnXML string `json:"dmn_xml,omitempty"`
+	// 随BPMN一起部署时的部署记录ID
+	DeploymentID *int `json:"deployment_id,omitempty"`
+	// 是否最新版本
+	IsLatest bool `json:"is_latest,omitempty"`
+	// 创建时间
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DecisionDefinition) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case decisiondefinition.FieldIsLatest:
+			values[i] = new(sql.NullBool)
+		case decisiondefinition.FieldID, decisiondefinition.FieldTenantID, decisiondefinition.FieldVersion, decisiondefinition.FieldDeploymentID:
+			values[i] = new(sql.NullInt64)
+		case decisiondefinition.FieldKey, decisiondefinition.FieldName, decisiondefinition.FieldHitPolicy, decisiondefinition.FieldDmnXML:
+			values[i] = new(sql.NullString)
+		case decisiondefinition.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DecisionDefinition fields.
+func (_m *DecisionDefinition) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case decisiondefinition.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case decisiondefinition.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = int(value.Int64)
+			}
+		case decisiondefinition.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				_m.Key = value.String
+			}
+		case decisiondefinition.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case decisiondefinition.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = int(value.Int64)
+			}
+		case decisiondefinition.FieldHitPolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hit_policy", values[i])
+			} else if value.Valid {
+				_m.HitPolicy = value.String
+			}
+		case decisiondefinition.FieldDmnXML:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field dmn_xml", values[i])
+			} else if value.Valid {
+				_m.DmnXML = value.String
+			}
+		case decisiondefinition.FieldDeploymentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deployment_id", values[i])
+			} else if value.Valid {
+				_m.DeploymentID = new(int)
+				*_m.DeploymentID = int(value.Int64)
+			}
+		case decisiondefinition.FieldIsLatest:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_latest", values[i])
+			} else if value.Valid {
+				_m.IsLatest = value.Bool
+			}
+		case decisiondefinition.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DecisionDefinition.
+// This includes values selected through modifiers, order, etc.
+func (_m *DecisionDefinition) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DecisionDefinition.
+// Note that you need to call DecisionDefinition.Unwrap() before calling this method if this DecisionDefinition
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DecisionDefinition) Update() *DecisionDefinitionUpdateOne {
+	return NewDecisionDefinitionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DecisionDefinition entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DecisionDefinition) Unwrap() *DecisionDefinition {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DecisionDefinition is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DecisionDefinition) String() string {
+	var builder strings.Builder
+	builder.WriteString("DecisionDefinition(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("key=")
+	builder.WriteString(_m.Key)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
+	builder.WriteString("hit_policy=")
+	builder.WriteString(_m.HitPolicy)
+	builder.WriteString(", ")
+	builder.WriteString("dmn_xml=")
+	builder.WriteString(_m.DmnXML)
+	builder.WriteString(", ")
+	if v := _m.DeploymentID; v != nil {
+		builder.WriteString("deployment_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("is_latest=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsLatest))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DecisionDefinitions is a parsable slice of DecisionDefinition.
+type DecisionDefinitions []*DecisionDefinition
@@ -0,0 +1,132 @@
+// Code generated by ent, DO NOT EDIT.
+
+package decisiondefinition
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the decisiondefinition type in the database.
+	Label = "decision_definition"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldKey holds the string denoting the key field in the database.
+	FieldKey = "key"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldHitPolicy holds the string denoting the hit_policy field in the database.
+	FieldHitPolicy = "hit_policy"
+	// FieldDmnXML holds the string denoting the dmn_xml field in the database.
+	FieldDmnXML = "dmn_xml"
+	// FieldDeploymentID holds the string denoting the deployment_id field in the database.
+	FieldDeploymentID = "deployment_id"
+	// FieldIsLatest holds the string denoting the is_latest field in the database.
+	FieldIsLatest = "is_latest"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the decisiondefinition in the database.
+	Table = "decision_definitions"
+)
+
+// Columns holds all SQL columns for decisiondefinition fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldKey,
+	FieldName,
+	FieldVersion,
+	FieldHitPolicy,
+	FieldDmnXML,
+	FieldDeploymentID,
+	FieldIsLatest,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	TenantIDValidator func(int) error
+	// KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	KeyValidator func(string) error
+	// VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	VersionValidator func(int) error
+	// DefaultHitPolicy holds the default value on creation for the "hit_policy" field.
+	DefaultHitPolicy string
+	// HitPolicyValidator is a validator for the "hit_policy" field. It is called by the builders before save.
+	HitPolicyValidator func(string) error
+	// DmnXMLValidator is a validator for the "dmn_xml" field. It is called by the builders before save.
+	DmnXMLValidator func(string) error
+	// DefaultIsLatest holds the default value on creation for the "is_latest" field.
+	DefaultIsLatest bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the DecisionDefinition queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByKey orders the results by the key field.
+func ByKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKey, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByHitPolicy orders the results by the hit_policy field.
+func ByHitPolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHitPolicy, opts...).ToFunc()
+}
+
+// ByDmnXML orders the results by the dmn_xml field.
+func ByDmnXML(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDmnXML, opts...).ToFunc()
+}
+
+// ByDeploymentID orders the results by the deployment_id field.
+func ByDeploymentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeploymentID, opts...).ToFunc()
+}
+
+// ByIsLatest orders the results by the is_latest field.
+func ByIsLatest(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsLatest, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package decisiondefinition
+
+import (
+	"itsm-backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Key applies equality check predicate on the "key" field. It's identical to KeyEQ.
+func Key(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldEQ(FieldKey, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldEQ(FieldName, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldEQ(FieldVersion, v))
+}
+
+// HitPolicy applies equality check predicate on the "hit_policy" field. It's identical to HitPolicyEQ.
+func HitPolicy(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldEQ(FieldHitPolicy, v))
+}
+
+// DmnXML applies equality check predicate on the "dmn_xml" field. It's identical to DmnXMLEQ.
+func DmnXML(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldEQ(FieldDmnXML, v))
+}
+
+// DeploymentID applies equality check predicate on the "deployment_id" field. It's identical to DeploymentIDEQ.
+func DeploymentID(v int) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldEQ(FieldDeploymentID, v))
+}
+
+// IsLatest applies equality check predicate on the "is_latest" field. It's identical to IsLatestEQ.
+func IsLatest(v bool) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldEQ(FieldIsLatest, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldLTE(FieldTenantID, v))
+}
+
+// KeyEQ applies the EQ predicate on the "key" field.
+func KeyEQ(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldEQ(FieldKey, v))
+}
+
+// KeyNEQ applies the NEQ predicate on the "key" field.
+func KeyNEQ(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldNEQ(FieldKey, v))
+}
+
+// KeyIn applies the In predicate on the "key" field.
+func KeyIn(vs ...string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldIn(FieldKey, vs...))
+}
+
+// KeyNotIn applies the NotIn predicate on the "key" field.
+func KeyNotIn(vs ...string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldNotIn(FieldKey, vs...))
+}
+
+// KeyGT applies the GT predicate on the "key" field.
+func KeyGT(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldGT(FieldKey, v))
+}
+
+// KeyGTE applies the GTE predicate on the "key" field.
+func KeyGTE(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldGTE(FieldKey, v))
+}
+
+// KeyLT applies the LT predicate on the "key" field.
+func KeyLT(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldLT(FieldKey, v))
+}
+
+// KeyLTE applies the LTE predicate on the "key" field.
+func KeyLTE(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldLTE(FieldKey, v))
+}
+
+// KeyContains applies the Contains predicate on the "key" field.
+func KeyContains(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldContains(FieldKey, v))
+}
+
+// KeyHasPrefix applies the HasPrefix predicate on the "key" field.
+func KeyHasPrefix(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldHasPrefix(FieldKey, v))
+}
+
+// KeyHasSuffix applies the HasSuffix predicate on the "key" field.
+func KeyHasSuffix(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldHasSuffix(FieldKey, v))
+}
+
+// KeyEqualFold applies the EqualFold predicate on the "key" field.
+func KeyEqualFold(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldEqualFold(FieldKey, v))
+}
+
+// KeyContainsFold applies the ContainsFold predicate on the "key" field.
+func KeyContainsFold(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldContainsFold(FieldKey, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldContainsFold(FieldName, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldLTE(FieldVersion, v))
+}
+
+// HitPolicyEQ applies the EQ predicate on the "hit_policy" field.
+func HitPolicyEQ(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldEQ(FieldHitPolicy, v))
+}
+
+// HitPolicyNEQ applies the NEQ predicate on the "hit_policy" field.
+func HitPolicyNEQ(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldNEQ(FieldHitPolicy, v))
+}
+
+// HitPolicyIn applies the In predicate on the "hit_policy" field.
+func HitPolicyIn(vs ...string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldIn(FieldHitPolicy, vs...))
+}
+
+// HitPolicyNotIn applies the NotIn predicate on the "hit_policy" field.
+func HitPolicyNotIn(vs ...string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldNotIn(FieldHitPolicy, vs...))
+}
+
+// HitPolicyGT applies the GT predicate on the "hit_policy" field.
+func HitPolicyGT(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldGT(FieldHitPolicy, v))
+}
+
+// HitPolicyGTE applies the GTE predicate on the "hit_policy" field.
+func HitPolicyGTE(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldGTE(FieldHitPolicy, v))
+}
+
+// HitPolicyLT applies the LT predicate on the "hit_policy" field.
+func HitPolicyLT(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldLT(FieldHitPolicy, v))
+}
+
+// HitPolicyLTE applies the LTE predicate on the "hit_policy" field.
+func HitPolicyLTE(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldLTE(FieldHitPolicy, v))
+}
+
+// HitPolicyContains applies the Contains predicate on the "hit_policy" field.
+func HitPolicyContains(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldContains(FieldHitPolicy, v))
+}
+
+// HitPolicyHasPrefix applies the HasPrefix predicate on the "hit_policy" field.
+func HitPolicyHasPrefix(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldHasPrefix(FieldHitPolicy, v))
+}
+
+// HitPolicyHasSuffix applies the HasSuffix predicate on the "hit_policy" field.
+func HitPolicyHasSuffix(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldHasSuffix(FieldHitPolicy, v))
+}
+
+// HitPolicyEqualFold applies the EqualFold predicate on the "hit_policy" field.
+func HitPolicyEqualFold(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldEqualFold(FieldHitPolicy, v))
+}
+
+// HitPolicyContainsFold applies the ContainsFold predicate on the "hit_policy" field.
+func HitPolicyContainsFold(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldContainsFold(FieldHitPolicy, v))
+}
+
+// DmnXMLEQ applies the EQ predicate on the "dmn_xml" field.
+func DmnXMLEQ(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldEQ(FieldDmnXML, v))
+}
+
+// DmnXMLNEQ applies the NEQ predicate on the "dmn_xml" field.
+func DmnXMLNEQ(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldNEQ(FieldDmnXML, v))
+}
+
+// DmnXMLIn applies the In predicate on the "dmn_xml" field.
+func DmnXMLIn(vs ...string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldIn(FieldDmnXML, vs...))
+}
+
+// DmnXMLNotIn applies the NotIn predicate on the "dmn_xml" field.
+func DmnXMLNotIn(vs ...string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldNotIn(FieldDmnXML, vs...))
+}
+
+// DmnXMLGT applies the GT predicate on the "dmn_xml" field.
+func DmnXMLGT(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldGT(FieldDmnXML, v))
+}
+
+// DmnXMLGTE applies the GTE predicate on the "dmn_xml" field.
+func DmnXMLGTE(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldGTE(FieldDmnXML, v))
+}
+
+// DmnXMLLT applies the LT predicate on the "dmn_xml" field.
+func DmnXMLLT(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldLT(FieldDmnXML, v))
+}
+
+// DmnXMLLTE applies the LTE predicate on the "dmn_xml" field.
+func DmnXMLLTE(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldLTE(FieldDmnXML, v))
+}
+
+// DmnXMLContains applies the Contains predicate on the "dmn_xml" field.
+func DmnXMLContains(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldContains(FieldDmnXML, v))
+}
+
+// DmnXMLHasPrefix applies the HasPrefix predicate on the "dmn_xml" field.
+func DmnXMLHasPrefix(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldHasPrefix(FieldDmnXML, v))
+}
+
+// DmnXMLHasSuffix applies the HasSuffix predicate on the "dmn_xml" field.
+func DmnXMLHasSuffix(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldHasSuffix(FieldDmnXML, v))
+}
+
+// DmnXMLEqualFold applies the EqualFold predicate on the "dmn_xml" field.
+func DmnXMLEqualFold(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldEqualFold(FieldDmnXML, v))
+}
+
+// DmnXMLContainsFold applies the ContainsFold predicate on the "dmn_xml" field.
+func DmnXMLContainsFold(v string) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldContainsFold(FieldDmnXML, v))
+}
+
+// DeploymentIDEQ applies the EQ predicate on the "deployment_id" field.
+func DeploymentIDEQ(v int) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldEQ(FieldDeploymentID, v))
+}
+
+// DeploymentIDNEQ applies the NEQ predicate on the "deployment_id" field.
+func DeploymentIDNEQ(v int) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldNEQ(FieldDeploymentID, v))
+}
+
+// DeploymentIDIn applies the In predicate on the "deployment_id" field.
+func DeploymentIDIn(vs ...int) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldIn(FieldDeploymentID, vs...))
+}
+
+// DeploymentIDNotIn applies the NotIn predicate on the "deployment_id" field.
+func DeploymentIDNotIn(vs ...int) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldNotIn(FieldDeploymentID, vs...))
+}
+
+// DeploymentIDGT applies the GT predicate on the "deployment_id" field.
+func DeploymentIDGT(v int) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldGT(FieldDeploymentID, v))
+}
+
+// DeploymentIDGTE applies the GTE predicate on the "deployment_id" field.
+func DeploymentIDGTE(v int) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldGTE(FieldDeploymentID, v))
+}
+
+// DeploymentIDLT applies the LT predicate on the "deployment_id" field.
+func DeploymentIDLT(v int) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldLT(FieldDeploymentID, v))
+}
+
+// DeploymentIDLTE applies the LTE predicate on the "deployment_id" field.
+func DeploymentIDLTE(v int) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldLTE(FieldDeploymentID, v))
+}
+
+// DeploymentIDIsNil applies the IsNil predicate on the "deployment_id" field.
+func DeploymentIDIsNil() predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldIsNull(FieldDeploymentID))
+}
+
+// DeploymentIDNotNil applies the NotNil predicate on the "deployment_id" field.
+func DeploymentIDNotNil() predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldNotNull(FieldDeploymentID))
+}
+
+// IsLatestEQ applies the EQ predicate on the "is_latest" field.
+func IsLatestEQ(v bool) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldEQ(FieldIsLatest, v))
+}
+
+// IsLatestNEQ applies the NEQ predicate on the "is_latest" field.
+func IsLatestNEQ(v bool) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldNEQ(FieldIsLatest, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DecisionDefinition) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DecisionDefinition) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DecisionDefinition) predicate.DecisionDefinition {
+	return predicate.DecisionDefinition(sql.NotPredicates(p))
+}
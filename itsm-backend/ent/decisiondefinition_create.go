@@ -0,0 +1,365 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/decisiondefinition"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DecisionDefinitionCreate is the builder for creating a DecisionDefinition entity.
+type DecisionDefinitionCreate struct {
+	config
+	mutation *DecisionDefinitionMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *DecisionDefinitionCreate) SetTenantID(v int) *DecisionDefinitionCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetKey sets the "key" field.
+func (_c *DecisionDefinitionCreate) SetKey(v string) *DecisionDefinitionCreate {
+	_c.mutation.SetKey(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *DecisionDefinitionCreate) SetName(v string) *DecisionDefinitionCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *DecisionDefinitionCreate) SetNillableName(v *string) *DecisionDefinitionCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *DecisionDefinitionCreate) SetVersion(v int) *DecisionDefinitionCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetHitPolicy sets the "hit_policy" field.
+func (_c *DecisionDefinitionCreate) SetHitPolicy(v string) *DecisionDefinitionCreate {
+	_c.mutation.SetHitPolicy(v)
+	return _c
+}
+
+// SetNillableHitPolicy sets the "hit_policy" field if the given value is not nil.
+func (_c *DecisionDefinitionCreate) SetNillableHitPolicy(v *string) *DecisionDefinitionCreate {
+	if v != nil {
+		_c.SetHitPolicy(*v)
+	}
+	return _c
+}
+
+// SetDmnXML sets the "dmn_xml" field.
+func (_c *DecisionDefinitionCreate) SetDmnXML(v string) *DecisionDefinitionCreate {
+	_c.mutation.SetDmnXML(v)
+	return _c
+}
+
+// SetDeploymentID sets the "deployment_id" field.
+func (_c *DecisionDefinitionCreate) SetDeploymentID(v int) *DecisionDefinitionCreate {
+	_c.mutation.SetDeploymentID(v)
+	return _c
+}
+
+// SetNillableDeploymentID sets the "deployment_id" field if the given value is not nil.
+func (_c *DecisionDefinitionCreate) SetNillableDeploymentID(v *int) *DecisionDefinitionCreate {
+	if v != nil {
+		_c.SetDeploymentID(*v)
+	}
+	return _c
+}
+
+// SetIsLatest sets the "is_latest" field.
+func (_c *DecisionDefinitionCreate) SetIsLatest(v bool) *DecisionDefinitionCreate {
+	_c.mutation.SetIsLatest(v)
+	return _c
+}
+
+// SetNillableIsLatest sets the "is_latest" field if the given value is not nil.
+func (_c *DecisionDefinitionCreate) SetNillableIsLatest(v *bool) *DecisionDefinitionCreate {
+	if v != nil {
+		_c.SetIsLatest(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DecisionDefinitionCreate) SetCreatedAt(v time.Time) *DecisionDefinitionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *DecisionDefinitionCreate) SetNillableCreatedAt(v *time.Time) *DecisionDefinitionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the DecisionDefinitionMutation object of the builder.
+func (_c *DecisionDefinitionCreate) Mutation() *DecisionDefinitionMutation {
+	return _c.mutation
+}
+
+// Save creates the DecisionDefinition in the database.
+func (_c *DecisionDefinitionCreate) Save(ctx context.Context) (*DecisionDefinition, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DecisionDefinitionCreate) SaveX(ctx context.Context) *DecisionDefinition {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DecisionDefinitionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DecisionDefinitionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DecisionDefinitionCreate) defaults() {
+	if _, ok := _c.mutation.HitPolicy(); !ok {
+		v := decisiondefinition.DefaultHitPolicy
+		_c.mutation.SetHitPolicy(v)
+	}
+	if _, ok := _c.mutation.IsLatest(); !ok {
+		v := decisiondefinition.DefaultIsLatest
+		_c.mutation.SetIsLatest(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := decisiondefinition.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DecisionDefinitionCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "DecisionDefinition.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := decisiondefinition.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "DecisionDefinition.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "DecisionDefinition.key"`)}
+	}
+	if v, ok := _c.mutation.Key(); ok {
+		if err := decisiondefinition.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "DecisionDefinition.key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "DecisionDefinition.version"`)}
+	}
+	if v, ok := _c.mutation.Version(); ok {
+		if err := decisiondefinition.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "DecisionDefinition.version": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.HitPolicy(); !ok {
+		return &ValidationError{Name: "hit_policy", err: errors.New(`ent: missing required field "DecisionDefinition.hit_policy"`)}
+	}
+	if v, ok := _c.mutation.HitPolicy(); ok {
+		if err := decisiondefinition.HitPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "hit_policy", err: fmt.Errorf(`ent: validator failed for field "DecisionDefinition.hit_policy": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DmnXML(); !ok {
+		return &ValidationError{Name: "dmn_xml", err: errors.New(`ent: missing required field "DecisionDefinition.dmn_xml"`)}
+	}
+	if v, ok := _c.mutation.DmnXML(); ok {
+		if err := decisiondefinition.DmnXMLValidator(v); err != nil {
+			return &ValidationError{Name: "dmn_xml", err: fmt.Errorf(`ent: validator failed for field "DecisionDefinition.dmn_xml": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IsLatest(); !ok {
+		return &ValidationError{Name: "is_latest", err: errors.New(`ent: missing required field "DecisionDefinition.is_latest"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DecisionDefinition.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *DecisionDefinitionCreate) sqlSave(ctx context.Context) (*DecisionDefinition, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DecisionDefinitionCreate) createSpec() (*DecisionDefinition, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DecisionDefinition{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(decisiondefinition.Table, sqlgraph.NewFieldSpec(decisiondefinition.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(decisiondefinition.FieldTenantID, field.TypeInt, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.Key(); ok {
+		_spec.SetField(decisiondefinition.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(decisiondefinition.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(decisiondefinition.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.HitPolicy(); ok {
+		_spec.SetField(decisiondefinition.FieldHitPolicy, field.TypeString, value)
+		_node.HitPolicy = value
+	}
+	if value, ok := _c.mutation.DmnXML(); ok {
+		_spec.SetField(decisiondefinition.FieldDmnXML, field.TypeString, value)
+		_node.DmnXML = value
+	}
+	if value, ok := _c.mutation.DeploymentID(); ok {
+		_spec.SetField(decisiondefinition.FieldDeploymentID, field.TypeInt, value)
+		_node.DeploymentID = &value
+	}
+	if value, ok := _c.mutation.IsLatest(); ok {
+		_spec.SetField(decisiondefinition.FieldIsLatest, field.TypeBool, value)
+		_node.IsLatest = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(decisiondefinition.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// DecisionDefinitionCreateBulk is the builder for creating many DecisionDefinition entities in bulk.
+type DecisionDefinitionCreateBulk struct {
+	config
+	err      error
+	builders []*DecisionDefinitionCreate
+}
+
+// Save creates the DecisionDefinition entities in the database.
+func (_c *DecisionDefinitionCreateBulk) Save(ctx context.Context) ([]*DecisionDefinition, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DecisionDefinition, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DecisionDefinitionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DecisionDefinitionCreateBulk) SaveX(ctx context.Context) []*DecisionDefinition {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DecisionDefinitionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DecisionDefinitionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
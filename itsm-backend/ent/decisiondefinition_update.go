@@ -0,0 +1,644 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/decisiondefinition"
+	"itsm-backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DecisionDefinitionUpdate is the builder for updating DecisionDefinition entities.
+type DecisionDefinitionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DecisionDefinitionMutation
+}
+
+// Where appends a list predicates to the DecisionDefinitionUpdate builder.
+func (_u *DecisionDefinitionUpdate) Where(ps ...predicate.DecisionDefinition) *DecisionDefinitionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *DecisionDefinitionUpdate) SetTenantID(v int) *DecisionDefinitionUpdate {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *DecisionDefinitionUpdate) SetNillableTenantID(v *int) *DecisionDefinitionUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *DecisionDefinitionUpdate) AddTenantID(v int) *DecisionDefinitionUpdate {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetKey sets the "key" field.
+func (_u *DecisionDefinitionUpdate) SetKey(v string) *DecisionDefinitionUpdate {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *DecisionDefinitionUpdate) SetNillableKey(v *string) *DecisionDefinitionUpdate {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *DecisionDefinitionUpdate) SetName(v string) *DecisionDefinitionUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *DecisionDefinitionUpdate) SetNillableName(v *string) *DecisionDefinitionUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *DecisionDefinitionUpdate) ClearName() *DecisionDefinitionUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *DecisionDefinitionUpdate) SetVersion(v int) *DecisionDefinitionUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *DecisionDefinitionUpdate) SetNillableVersion(v *int) *DecisionDefinitionUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *DecisionDefinitionUpdate) AddVersion(v int) *DecisionDefinitionUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetHitPolicy sets the "hit_policy" field.
+func (_u *DecisionDefinitionUpdate) SetHitPolicy(v string) *DecisionDefinitionUpdate {
+	_u.mutation.SetHitPolicy(v)
+	return _u
+}
+
+// SetNillableHitPolicy sets the "hit_policy" field if the given value is not nil.
+func (_u *DecisionDefinitionUpdate) SetNillableHitPolicy(v *string) *DecisionDefinitionUpdate {
+	if v != nil {
+		_u.SetHitPolicy(*v)
+	}
+	return _u
+}
+
+// SetDmnXML sets the "dmn_xml" field.
+func (_u *DecisionDefinitionUpdate) SetDmnXML(v string) *DecisionDefinitionUpdate {
+	_u.mutation.SetDmnXML(v)
+	return _u
+}
+
+// SetNillableDmnXML sets the "dmn_xml" field if the given value is not nil.
+func (_u *DecisionDefinitionUpdate) SetNillableDmnXML(v *string) *DecisionDefinitionUpdate {
+	if v != nil {
+		_u.SetDmnXML(*v)
+	}
+	return _u
+}
+
+// SetDeploymentID sets the "deployment_id" field.
+func (_u *DecisionDefinitionUpdate) SetDeploymentID(v int) *DecisionDefinitionUpdate {
+	_u.mutation.ResetDeploymentID()
+	_u.mutation.SetDeploymentID(v)
+	return _u
+}
+
+// SetNillableDeploymentID sets the "deployment_id" field if the given value is not nil.
+func (_u *DecisionDefinitionUpdate) SetNillableDeploymentID(v *int) *DecisionDefinitionUpdate {
+	if v != nil {
+		_u.SetDeploymentID(*v)
+	}
+	return _u
+}
+
+// AddDeploymentID adds value to the "deployment_id" field.
+func (_u *DecisionDefinitionUpdate) AddDeploymentID(v int) *DecisionDefinitionUpdate {
+	_u.mutation.AddDeploymentID(v)
+	return _u
+}
+
+// ClearDeploymentID clears the value of the "deployment_id" field.
+func (_u *DecisionDefinitionUpdate) ClearDeploymentID() *DecisionDefinitionUpdate {
+	_u.mutation.ClearDeploymentID()
+	return _u
+}
+
+// SetIsLatest sets the "is_latest" field.
+func (_u *DecisionDefinitionUpdate) SetIsLatest(v bool) *DecisionDefinitionUpdate {
+	_u.mutation.SetIsLatest(v)
+	return _u
+}
+
+// SetNillableIsLatest sets the "is_latest" field if the given value is not nil.
+func (_u *DecisionDefinitionUpdate) SetNillableIsLatest(v *bool) *DecisionDefinitionUpdate {
+	if v != nil {
+		_u.SetIsLatest(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *DecisionDefinitionUpdate) SetCreatedAt(v time.Time) *DecisionDefinitionUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *DecisionDefinitionUpdate) SetNillableCreatedAt(v *time.Time) *DecisionDefinitionUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the DecisionDefinitionMutation object of the builder.
+func (_u *DecisionDefinitionUpdate) Mutation() *DecisionDefinitionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DecisionDefinitionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DecisionDefinitionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DecisionDefinitionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DecisionDefinitionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DecisionDefinitionUpdate) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := decisiondefinition.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "DecisionDefinition.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Key(); ok {
+		if err := decisiondefinition.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "DecisionDefinition.key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Version(); ok {
+		if err := decisiondefinition.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "DecisionDefinition.version": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HitPolicy(); ok {
+		if err := decisiondefinition.HitPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "hit_policy", err: fmt.Errorf(`ent: validator failed for field "DecisionDefinition.hit_policy": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DmnXML(); ok {
+		if err := decisiondefinition.DmnXMLValidator(v); err != nil {
+			return &ValidationError{Name: "dmn_xml", err: fmt.Errorf(`ent: validator failed for field "DecisionDefinition.dmn_xml": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DecisionDefinitionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(decisiondefinition.Table, decisiondefinition.Columns, sqlgraph.NewFieldSpec(decisiondefinition.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(decisiondefinition.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(decisiondefinition.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(decisiondefinition.FieldKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(decisiondefinition.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(decisiondefinition.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(decisiondefinition.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(decisiondefinition.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.HitPolicy(); ok {
+		_spec.SetField(decisiondefinition.FieldHitPolicy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DmnXML(); ok {
+		_spec.SetField(decisiondefinition.FieldDmnXML, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DeploymentID(); ok {
+		_spec.SetField(decisiondefinition.FieldDeploymentID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDeploymentID(); ok {
+		_spec.AddField(decisiondefinition.FieldDeploymentID, field.TypeInt, value)
+	}
+	if _u.mutation.DeploymentIDCleared() {
+		_spec.ClearField(decisiondefinition.FieldDeploymentID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.IsLatest(); ok {
+		_spec.SetField(decisiondefinition.FieldIsLatest, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(decisiondefinition.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{decisiondefinition.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DecisionDefinitionUpdateOne is the builder for updating a single DecisionDefinition entity.
+type DecisionDefinitionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DecisionDefinitionMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *DecisionDefinitionUpdateOne) SetTenantID(v int) *DecisionDefinitionUpdateOne {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *DecisionDefinitionUpdateOne) SetNillableTenantID(v *int) *DecisionDefinitionUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *DecisionDefinitionUpdateOne) AddTenantID(v int) *DecisionDefinitionUpdateOne {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetKey sets the "key" field.
+func (_u *DecisionDefinitionUpdateOne) SetKey(v string) *DecisionDefinitionUpdateOne {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *DecisionDefinitionUpdateOne) SetNillableKey(v *string) *DecisionDefinitionUpdateOne {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *DecisionDefinitionUpdateOne) SetName(v string) *DecisionDefinitionUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *DecisionDefinitionUpdateOne) SetNillableName(v *string) *DecisionDefinitionUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *DecisionDefinitionUpdateOne) ClearName() *DecisionDefinitionUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *DecisionDefinitionUpdateOne) SetVersion(v int) *DecisionDefinitionUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *DecisionDefinitionUpdateOne) SetNillableVersion(v *int) *DecisionDefinitionUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *DecisionDefinitionUpdateOne) AddVersion(v int) *DecisionDefinitionUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetHitPolicy sets the "hit_policy" field.
+func (_u *DecisionDefinitionUpdateOne) SetHitPolicy(v string) *DecisionDefinitionUpdateOne {
+	_u.mutation.SetHitPolicy(v)
+	return _u
+}
+
+// SetNillableHitPolicy sets the "hit_policy" field if the given value is not nil.
+func (_u *DecisionDefinitionUpdateOne) SetNillableHitPolicy(v *string) *DecisionDefinitionUpdateOne {
+	if v != nil {
+		_u.SetHitPolicy(*v)
+	}
+	return _u
+}
+
+// SetDmnXML sets the "dmn_xml" field.
+func (_u *DecisionDefinitionUpdateOne) SetDmnXML(v string) *DecisionDefinitionUpdateOne {
+	_u.mutation.SetDmnXML(v)
+	return _u
+}
+
+// SetNillableDmnXML sets the "dmn_xml" field if the given value is not nil.
+func (_u *DecisionDefinitionUpdateOne) SetNillableDmnXML(v *string) *DecisionDefinitionUpdateOne {
+	if v != nil {
+		_u.SetDmnXML(*v)
+	}
+	return _u
+}
+
+// SetDeploymentID sets the "deployment_id" field.
+func (_u *DecisionDefinitionUpdateOne) SetDeploymentID(v int) *DecisionDefinitionUpdateOne {
+	_u.mutation.ResetDeploymentID()
+	_u.mutation.SetDeploymentID(v)
+	return _u
+}
+
+// SetNillableDeploymentID sets the "deployment_id" field if the given value is not nil.
+func (_u *DecisionDefinitionUpdateOne) SetNillableDeploymentID(v *int) *DecisionDefinitionUpdateOne {
+	if v != nil {
+		_u.SetDeploymentID(*v)
+	}
+	return _u
+}
+
+// AddDeploymentID adds value to the "deployment_id" field.
+func (_u *DecisionDefinitionUpdateOne) AddDeploymentID(v int) *DecisionDefinitionUpdateOne {
+	_u.mutation.AddDeploymentID(v)
+	return _u
+}
+
+// ClearDeploymentID clears the value of the "deployment_id" field.
+func (_u *DecisionDefinitionUpdateOne) ClearDeploymentID() *DecisionDefinitionUpdateOne {
+	_u.mutation.ClearDeploymentID()
+	return _u
+}
+
+// SetIsLatest sets the "is_latest" field.
+func (_u *DecisionDefinitionUpdateOne) SetIsLatest(v bool) *DecisionDefinitionUpdateOne {
+	_u.mutation.SetIsLatest(v)
+	return _u
+}
+
+// SetNillableIsLatest sets the "is_latest" field if the given value is not nil.
+func (_u *DecisionDefinitionUpdateOne) SetNillableIsLatest(v *bool) *DecisionDefinitionUpdateOne {
+	if v != nil {
+		_u.SetIsLatest(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *DecisionDefinitionUpdateOne) SetCreatedAt(v time.Time) *DecisionDefinitionUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *DecisionDefinitionUpdateOne) SetNillableCreatedAt(v *time.Time) *DecisionDefinitionUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the DecisionDefinitionMutation object of the builder.
+func (_u *DecisionDefinitionUpdateOne) Mutation() *DecisionDefinitionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DecisionDefinitionUpdate builder.
+func (_u *DecisionDefinitionUpdateOne) Where(ps ...predicate.DecisionDefinition) *DecisionDefinitionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DecisionDefinitionUpdateOne) Select(field string, fields ...string) *DecisionDefinitionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DecisionDefinition entity.
+func (_u *DecisionDefinitionUpdateOne) Save(ctx context.Context) (*DecisionDefinition, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DecisionDefinitionUpdateOne) SaveX(ctx context.Context) *DecisionDefinition {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DecisionDefinitionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DecisionDefinitionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DecisionDefinitionUpdateOne) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := decisiondefinition.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "DecisionDefinition.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Key(); ok {
+		if err := decisiondefinition.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "DecisionDefinition.key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Version(); ok {
+		if err := decisiondefinition.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "DecisionDefinition.version": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HitPolicy(); ok {
+		if err := decisiondefinition.HitPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "hit_policy", err: fmt.Errorf(`ent: validator failed for field "DecisionDefinition.hit_policy": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DmnXML(); ok {
+		if err := decisiondefinition.DmnXMLValidator(v); err != nil {
+			return &ValidationError{Name: "dmn_xml", err: fmt.Errorf(`ent: validator failed for field "DecisionDefinition.dmn_xml": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DecisionDefinitionUpdateOne) sqlSave(ctx context.Context) (_node *DecisionDefinition, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(decisiondefinition.Table, decisiondefinition.Columns, sqlgraph.NewFieldSpec(decisiondefinition.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DecisionDefinition.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, decisiondefinition.FieldID)
+		for _, f := range fields {
+			if !decisiondefinition.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != decisiondefinition.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(decisiondefinition.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(decisiondefinition.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(decisiondefinition.FieldKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(decisiondefinition.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(decisiondefinition.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(decisiondefinition.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(decisiondefinition.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.HitPolicy(); ok {
+		_spec.SetField(decisiondefinition.FieldHitPolicy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DmnXML(); ok {
+		_spec.SetField(decisiondefinition.FieldDmnXML, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DeploymentID(); ok {
+		_spec.SetField(decisiondefinition.FieldDeploymentID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDeploymentID(); ok {
+		_spec.AddField(decisiondefinition.FieldDeploymentID, field.TypeInt, value)
+	}
+	if _u.mutation.DeploymentIDCleared() {
+		_spec.ClearField(decisiondefinition.FieldDeploymentID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.IsLatest(); ok {
+		_spec.SetField(decisiondefinition.FieldIsLatest, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(decisiondefinition.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &DecisionDefinition{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{decisiondefinition.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
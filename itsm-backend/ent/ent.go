@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"itsm-backend/ent/alertsuppressionrule"
 	"itsm-backend/ent/application"
 	"itsm-backend/ent/approvalchain"
 	"itsm-backend/ent/approvalrecord"
@@ -15,6 +16,7 @@ import (
 	"itsm-backend/ent/auditlog"
 	"itsm-backend/ent/bootstraptoken"
 	"itsm-backend/ent/bpmnpermission"
+	"itsm-backend/ent/businesscalendar"
 	"itsm-backend/ent/cabmember"
 	"itsm-backend/ent/change"
 	"itsm-backend/ent/changepir"
@@ -32,6 +34,7 @@ import (
 	"itsm-backend/ent/configurationitemhistory"
 	"itsm-backend/ent/contract"
 	"itsm-backend/ent/conversation"
+	"itsm-backend/ent/decisiondefinition"
 	"itsm-backend/ent/department"
 	"itsm-backend/ent/discoveryjob"
 	"itsm-backend/ent/discoveryresult"
@@ -39,8 +42,12 @@ import (
 	"itsm-backend/ent/domainconfig"
 	"itsm-backend/ent/endpointacl"
 	"itsm-backend/ent/engineerskill"
+	"itsm-backend/ent/escalationpolicy"
 	"itsm-backend/ent/feishuticketsync"
 	"itsm-backend/ent/group"
+	"itsm-backend/ent/inbounddedupkey"
+	"itsm-backend/ent/inboundemail"
+	"itsm-backend/ent/inboundmessagelog"
 	"itsm-backend/ent/incident"
 	"itsm-backend/ent/incidentalert"
 	"itsm-backend/ent/incidentescalationrule"
@@ -55,14 +62,19 @@ import (
 	"itsm-backend/ent/knowledgearticlesession"
 	"itsm-backend/ent/knowledgearticleversion"
 	"itsm-backend/ent/knownerror"
+	"itsm-backend/ent/majorincident"
 	"itsm-backend/ent/marketplaceitem"
 	"itsm-backend/ent/menu"
 	"itsm-backend/ent/message"
 	"itsm-backend/ent/microservice"
+	"itsm-backend/ent/monitoringalert"
 	"itsm-backend/ent/mspallocation"
 	"itsm-backend/ent/notification"
 	"itsm-backend/ent/notificationdelivery"
 	"itsm-backend/ent/notificationpreference"
+	"itsm-backend/ent/oncalloverride"
+	"itsm-backend/ent/oncallpage"
+	"itsm-backend/ent/oncallschedule"
 	"itsm-backend/ent/operationalcommand"
 	"itsm-backend/ent/passwordresettoken"
 	"itsm-backend/ent/permission"
@@ -73,7 +85,10 @@ import (
 	"itsm-backend/ent/processbinding"
 	"itsm-backend/ent/processdefinition"
 	"itsm-backend/ent/processdeployment"
+	"itsm-backend/ent/processeventinstance"
+	"itsm-backend/ent/processeventsubscription"
 	"itsm-backend/ent/processexecutionhistory"
+	"itsm-backend/ent/processincident"
 	"itsm-backend/ent/processinstance"
 	"itsm-backend/ent/processtask"
 	"itsm-backend/ent/processvariable"
@@ -86,6 +101,7 @@ import (
 	"itsm-backend/ent/role"
 	"itsm-backend/ent/rolepermission"
 	"itsm-backend/ent/rootcauseanalysis"
+	"itsm-backend/ent/searchdocument"
 	"itsm-backend/ent/servicecatalog"
 	"itsm-backend/ent/servicecatalogitem"
 	"itsm-backend/ent/servicerequest"
@@ -113,6 +129,10 @@ import (
 	"itsm-backend/ent/ticketcc"
 	"itsm-backend/ent/ticketcomment"
 	"itsm-backend/ent/ticketnotification"
+	"itsm-backend/ent/ticketschedule"
+	"itsm-backend/ent/ticketschedulerun"
+	"itsm-backend/ent/ticketslametric"
+	"itsm-backend/ent/ticketslapause"
 	"itsm-backend/ent/tickettag"
 	"itsm-backend/ent/tickettemplate"
 	"itsm-backend/ent/tickettype"
@@ -125,6 +145,7 @@ import (
 	"itsm-backend/ent/workflowinstance"
 	"itsm-backend/ent/workflowtask"
 	"itsm-backend/ent/workflowversion"
+	"itsm-backend/ent/worklog"
 	"reflect"
 	"sync"
 
@@ -191,6 +212,7 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
+			alertsuppressionrule.Table:        alertsuppressionrule.ValidColumn,
 			application.Table:                 application.ValidColumn,
 			approvalchain.Table:               approvalchain.ValidColumn,
 			approvalrecord.Table:              approvalrecord.ValidColumn,
@@ -200,6 +222,7 @@ func checkColumn(t, c string) error {
 			auditlog.Table:                    auditlog.ValidColumn,
 			bpmnpermission.Table:              bpmnpermission.ValidColumn,
 			bootstraptoken.Table:              bootstraptoken.ValidColumn,
+			businesscalendar.Table:            businesscalendar.ValidColumn,
 			cabmember.Table:                   cabmember.ValidColumn,
 			ciattributedefinition.Table:       ciattributedefinition.ValidColumn,
 			cirelationship.Table:              cirelationship.ValidColumn,
@@ -217,6 +240,7 @@ func checkColumn(t, c string) error {
 			configurationitemhistory.Table:    configurationitemhistory.ValidColumn,
 			contract.Table:                    contract.ValidColumn,
 			conversation.Table:                conversation.ValidColumn,
+			decisiondefinition.Table:          decisiondefinition.ValidColumn,
 			department.Table:                  department.ValidColumn,
 			discoveryjob.Table:                discoveryjob.ValidColumn,
 			discoveryresult.Table:             discoveryresult.ValidColumn,
@@ -224,8 +248,12 @@ func checkColumn(t, c string) error {
 			domainconfig.Table:                domainconfig.ValidColumn,
 			endpointacl.Table:                 endpointacl.ValidColumn,
 			engineerskill.Table:               engineerskill.ValidColumn,
+			escalationpolicy.Table:            escalationpolicy.ValidColumn,
 			feishuticketsync.Table:            feishuticketsync.ValidColumn,
 			group.Table:                       group.ValidColumn,
+			inbounddedupkey.Table:             inbounddedupkey.ValidColumn,
+			inboundemail.Table:                inboundemail.ValidColumn,
+			inboundmessagelog.Table:           inboundmessagelog.ValidColumn,
 			incident.Table:                    incident.ValidColumn,
 			incidentalert.Table:               incidentalert.ValidColumn,
 			incidentescalationrule.Table:      incidentescalationrule.ValidColumn,
@@ -241,13 +269,18 @@ func checkColumn(t, c string) error {
 			knowledgearticleversion.Table:     knowledgearticleversion.ValidColumn,
 			knownerror.Table:                  knownerror.ValidColumn,
 			mspallocation.Table:               mspallocation.ValidColumn,
+			majorincident.Table:               majorincident.ValidColumn,
 			marketplaceitem.Table:             marketplaceitem.ValidColumn,
 			menu.Table:                        menu.ValidColumn,
 			message.Table:                     message.ValidColumn,
 			microservice.Table:                microservice.ValidColumn,
+			monitoringalert.Table:             monitoringalert.ValidColumn,
 			notification.Table:                notification.ValidColumn,
 			notificationdelivery.Table:        notificationdelivery.ValidColumn,
 			notificationpreference.Table:      notificationpreference.ValidColumn,
+			oncalloverride.Table:              oncalloverride.ValidColumn,
+			oncallpage.Table:                  oncallpage.ValidColumn,
+			oncallschedule.Table:              oncallschedule.ValidColumn,
 			operationalcommand.Table:          operationalcommand.ValidColumn,
 			passwordresettoken.Table:          passwordresettoken.ValidColumn,
 			permission.Table:                  permission.ValidColumn,
@@ -258,7 +291,10 @@ func checkColumn(t, c string) error {
 			processbinding.Table:              processbinding.ValidColumn,
 			processdefinition.Table:           processdefinition.ValidColumn,
 			processdeployment.Table:           processdeployment.ValidColumn,
+			processeventinstance.Table:        processeventinstance.ValidColumn,
+			processeventsubscription.Table:    processeventsubscription.ValidColumn,
 			processexecutionhistory.Table:     processexecutionhistory.ValidColumn,
+			processincident.Table:             processincident.ValidColumn,
 			processinstance.Table:             processinstance.ValidColumn,
 			processtask.Table:                 processtask.ValidColumn,
 			processvariable.Table:             processvariable.ValidColumn,
@@ -277,6 +313,7 @@ func checkColumn(t, c string) error {
 			slametric.Table:                   slametric.ValidColumn,
 			slapolicy.Table:                   slapolicy.ValidColumn,
 			slaviolation.Table:                slaviolation.ValidColumn,
+			searchdocument.Table:              searchdocument.ValidColumn,
 			servicecatalog.Table:              servicecatalog.ValidColumn,
 			servicecatalogitem.Table:          servicecatalogitem.ValidColumn,
 			servicerequest.Table:              servicerequest.ValidColumn,
@@ -298,6 +335,10 @@ func checkColumn(t, c string) error {
 			ticketcategory.Table:              ticketcategory.ValidColumn,
 			ticketcomment.Table:               ticketcomment.ValidColumn,
 			ticketnotification.Table:          ticketnotification.ValidColumn,
+			ticketslametric.Table:             ticketslametric.ValidColumn,
+			ticketslapause.Table:              ticketslapause.ValidColumn,
+			ticketschedule.Table:              ticketschedule.ValidColumn,
+			ticketschedulerun.Table:           ticketschedulerun.ValidColumn,
 			tickettag.Table:                   tickettag.ValidColumn,
 			tickettemplate.Table:              tickettemplate.ValidColumn,
 			tickettype.Table:                  tickettype.ValidColumn,
@@ -306,6 +347,7 @@ func checkColumn(t, c string) error {
 			toolinvocation.Table:              toolinvocation.ValidColumn,
 			user.Table:                        user.ValidColumn,
 			vendor.Table:                      vendor.ValidColumn,
+			worklog.Table:                     worklog.ValidColumn,
 			workflow.Table:                    workflow.ValidColumn,
 			workflowinstance.Table:            workflowinstance.ValidColumn,
 			workflowtask.Table:                workflowtask.ValidColumn,
@@ -0,0 +1,216 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"itsm-backend/ent/escalationpolicy"
+	"itsm-backend/ent/schema"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// EscalationPolicy is the model entity for the EscalationPolicy schema.
+type EscalationPolicy struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 租户ID
+	TenantID int `json:"tenant_id,omitempty"`
+	// 策略名称
+	Name string `json:"name,omitempty"`
+	// 说明
+	Description string `json:"description,omitempty"`
+	// 所属团队ID
+	TeamID *int `json:"team_id,omitempty"`
+	// 升级步骤
+	Steps []schema.EscalationPolicyStep `json:"steps,omitempty"`
+	// 全部步骤无人确认后从第一步重复的次数
+	RepeatCount int `json:"repeat_count,omitempty"`
+	// 是否启用
+	Enabled bool `json:"enabled,omitempty"`
+	// 创建人ID
+	CreatedBy int `json:"created_by,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EscalationPolicy) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case escalationpolicy.FieldSteps:
+			values[i] = new([]byte)
+		case escalationpolicy.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case escalationpolicy.FieldID, escalationpolicy.FieldTenantID, escalationpolicy.FieldTeamID, escalationpolicy.FieldRepeatCount, escalationpolicy.FieldCreatedBy:
+			values[i] = new(sql.NullInt64)
+		case escalationpolicy.FieldName, escalationpolicy.FieldDescription:
+			values[i] = new(sql.NullString)
+		case escalationpolicy.FieldCreatedAt, escalationpolicy.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EscalationPolicy fields.
+func (_m *EscalationPolicy) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case escalationpolicy.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case escalationpolicy.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = int(value.Int64)
+			}
+		case escalationpolicy.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case escalationpolicy.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case escalationpolicy.FieldTeamID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field team_id", values[i])
+			} else if value.Valid {
+				_m.TeamID = new(int)
+				*_m.TeamID = int(value.Int64)
+			}
+		case escalationpolicy.FieldSteps:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field steps", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Steps); err != nil {
+					return fmt.Errorf("unmarshal field steps: %w", err)
+				}
+			}
+		case escalationpolicy.FieldRepeatCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field repeat_count", values[i])
+			} else if value.Valid {
+				_m.RepeatCount = int(value.Int64)
+			}
+		case escalationpolicy.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case escalationpolicy.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = int(value.Int64)
+			}
+		case escalationpolicy.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case escalationpolicy.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EscalationPolicy.
+// This includes values selected through modifiers, order, etc.
+func (_m *EscalationPolicy) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this EscalationPolicy.
+// Note that you need to call EscalationPolicy.Unwrap() before calling this method if this EscalationPolicy
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EscalationPolicy) Update() *EscalationPolicyUpdateOne {
+	return NewEscalationPolicyClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EscalationPolicy entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EscalationPolicy) Unwrap() *EscalationPolicy {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EscalationPolicy is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EscalationPolicy) String() string {
+	var builder strings.Builder
+	builder.WriteString("EscalationPolicy(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	if v := _m.TeamID; v != nil {
+		builder.WriteString("team_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("steps=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Steps))
+	builder.WriteString(", ")
+	builder.WriteString("repeat_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RepeatCount))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedBy))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EscalationPolicies is a parsable slice of EscalationPolicy.
+type EscalationPolicies []*EscalationPolicy
@@ -0,0 +1,525 @@
+// Code generated by ent, DO NOT EDIT.
+
+package escalationpolicy
+
+import (
+	"itsm-backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldEQ(FieldDescription, v))
+}
+
+// TeamID applies equality check predicate on the "team_id" field. It's identical to TeamIDEQ.
+func TeamID(v int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldEQ(FieldTeamID, v))
+}
+
+// RepeatCount applies equality check predicate on the "repeat_count" field. It's identical to RepeatCountEQ.
+func RepeatCount(v int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldEQ(FieldRepeatCount, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldEQ(FieldEnabled, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldLTE(FieldTenantID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// TeamIDEQ applies the EQ predicate on the "team_id" field.
+func TeamIDEQ(v int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldEQ(FieldTeamID, v))
+}
+
+// TeamIDNEQ applies the NEQ predicate on the "team_id" field.
+func TeamIDNEQ(v int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldNEQ(FieldTeamID, v))
+}
+
+// TeamIDIn applies the In predicate on the "team_id" field.
+func TeamIDIn(vs ...int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldIn(FieldTeamID, vs...))
+}
+
+// TeamIDNotIn applies the NotIn predicate on the "team_id" field.
+func TeamIDNotIn(vs ...int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldNotIn(FieldTeamID, vs...))
+}
+
+// TeamIDGT applies the GT predicate on the "team_id" field.
+func TeamIDGT(v int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldGT(FieldTeamID, v))
+}
+
+// TeamIDGTE applies the GTE predicate on the "team_id" field.
+func TeamIDGTE(v int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldGTE(FieldTeamID, v))
+}
+
+// TeamIDLT applies the LT predicate on the "team_id" field.
+func TeamIDLT(v int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldLT(FieldTeamID, v))
+}
+
+// TeamIDLTE applies the LTE predicate on the "team_id" field.
+func TeamIDLTE(v int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldLTE(FieldTeamID, v))
+}
+
+// TeamIDIsNil applies the IsNil predicate on the "team_id" field.
+func TeamIDIsNil() predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldIsNull(FieldTeamID))
+}
+
+// TeamIDNotNil applies the NotNil predicate on the "team_id" field.
+func TeamIDNotNil() predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldNotNull(FieldTeamID))
+}
+
+// RepeatCountEQ applies the EQ predicate on the "repeat_count" field.
+func RepeatCountEQ(v int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldEQ(FieldRepeatCount, v))
+}
+
+// RepeatCountNEQ applies the NEQ predicate on the "repeat_count" field.
+func RepeatCountNEQ(v int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldNEQ(FieldRepeatCount, v))
+}
+
+// RepeatCountIn applies the In predicate on the "repeat_count" field.
+func RepeatCountIn(vs ...int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldIn(FieldRepeatCount, vs...))
+}
+
+// RepeatCountNotIn applies the NotIn predicate on the "repeat_count" field.
+func RepeatCountNotIn(vs ...int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldNotIn(FieldRepeatCount, vs...))
+}
+
+// RepeatCountGT applies the GT predicate on the "repeat_count" field.
+func RepeatCountGT(v int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldGT(FieldRepeatCount, v))
+}
+
+// RepeatCountGTE applies the GTE predicate on the "repeat_count" field.
+func RepeatCountGTE(v int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldGTE(FieldRepeatCount, v))
+}
+
+// RepeatCountLT applies the LT predicate on the "repeat_count" field.
+func RepeatCountLT(v int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldLT(FieldRepeatCount, v))
+}
+
+// RepeatCountLTE applies the LTE predicate on the "repeat_count" field.
+func RepeatCountLTE(v int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldLTE(FieldRepeatCount, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v int) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EscalationPolicy) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EscalationPolicy) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EscalationPolicy) predicate.EscalationPolicy {
+	return predicate.EscalationPolicy(sql.NotPredicates(p))
+}
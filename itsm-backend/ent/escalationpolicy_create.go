@@ -0,0 +1,386 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/escalationpolicy"
+	"itsm-backend/ent/schema"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EscalationPolicyCreate is the builder for creating a EscalationPolicy entity.
+type EscalationPolicyCreate struct {
+	config
+	mutation *EscalationPolicyMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *EscalationPolicyCreate) SetTenantID(v int) *EscalationPolicyCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *EscalationPolicyCreate) SetName(v string) *EscalationPolicyCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *EscalationPolicyCreate) SetDescription(v string) *EscalationPolicyCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *EscalationPolicyCreate) SetNillableDescription(v *string) *EscalationPolicyCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetTeamID sets the "team_id" field.
+func (_c *EscalationPolicyCreate) SetTeamID(v int) *EscalationPolicyCreate {
+	_c.mutation.SetTeamID(v)
+	return _c
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (_c *EscalationPolicyCreate) SetNillableTeamID(v *int) *EscalationPolicyCreate {
+	if v != nil {
+		_c.SetTeamID(*v)
+	}
+	return _c
+}
+
+// SetSteps sets the "steps" field.
+func (_c *EscalationPolicyCreate) SetSteps(v []schema.EscalationPolicyStep) *EscalationPolicyCreate {
+	_c.mutation.SetSteps(v)
+	return _c
+}
+
+// SetRepeatCount sets the "repeat_count" field.
+func (_c *EscalationPolicyCreate) SetRepeatCount(v int) *EscalationPolicyCreate {
+	_c.mutation.SetRepeatCount(v)
+	return _c
+}
+
+// SetNillableRepeatCount sets the "repeat_count" field if the given value is not nil.
+func (_c *EscalationPolicyCreate) SetNillableRepeatCount(v *int) *EscalationPolicyCreate {
+	if v != nil {
+		_c.SetRepeatCount(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *EscalationPolicyCreate) SetEnabled(v bool) *EscalationPolicyCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *EscalationPolicyCreate) SetNillableEnabled(v *bool) *EscalationPolicyCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *EscalationPolicyCreate) SetCreatedBy(v int) *EscalationPolicyCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *EscalationPolicyCreate) SetNillableCreatedBy(v *int) *EscalationPolicyCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EscalationPolicyCreate) SetCreatedAt(v time.Time) *EscalationPolicyCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EscalationPolicyCreate) SetNillableCreatedAt(v *time.Time) *EscalationPolicyCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *EscalationPolicyCreate) SetUpdatedAt(v time.Time) *EscalationPolicyCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *EscalationPolicyCreate) SetNillableUpdatedAt(v *time.Time) *EscalationPolicyCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the EscalationPolicyMutation object of the builder.
+func (_c *EscalationPolicyCreate) Mutation() *EscalationPolicyMutation {
+	return _c.mutation
+}
+
+// Save creates the EscalationPolicy in the database.
+func (_c *EscalationPolicyCreate) Save(ctx context.Context) (*EscalationPolicy, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EscalationPolicyCreate) SaveX(ctx context.Context) *EscalationPolicy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EscalationPolicyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EscalationPolicyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EscalationPolicyCreate) defaults() {
+	if _, ok := _c.mutation.RepeatCount(); !ok {
+		v := escalationpolicy.DefaultRepeatCount
+		_c.mutation.SetRepeatCount(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := escalationpolicy.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := escalationpolicy.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := escalationpolicy.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EscalationPolicyCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "EscalationPolicy.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := escalationpolicy.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "EscalationPolicy.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "EscalationPolicy.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := escalationpolicy.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "EscalationPolicy.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Steps(); !ok {
+		return &ValidationError{Name: "steps", err: errors.New(`ent: missing required field "EscalationPolicy.steps"`)}
+	}
+	if _, ok := _c.mutation.RepeatCount(); !ok {
+		return &ValidationError{Name: "repeat_count", err: errors.New(`ent: missing required field "EscalationPolicy.repeat_count"`)}
+	}
+	if v, ok := _c.mutation.RepeatCount(); ok {
+		if err := escalationpolicy.RepeatCountValidator(v); err != nil {
+			return &ValidationError{Name: "repeat_count", err: fmt.Errorf(`ent: validator failed for field "EscalationPolicy.repeat_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "EscalationPolicy.enabled"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EscalationPolicy.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "EscalationPolicy.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *EscalationPolicyCreate) sqlSave(ctx context.Context) (*EscalationPolicy, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EscalationPolicyCreate) createSpec() (*EscalationPolicy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EscalationPolicy{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(escalationpolicy.Table, sqlgraph.NewFieldSpec(escalationpolicy.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(escalationpolicy.FieldTenantID, field.TypeInt, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(escalationpolicy.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(escalationpolicy.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.TeamID(); ok {
+		_spec.SetField(escalationpolicy.FieldTeamID, field.TypeInt, value)
+		_node.TeamID = &value
+	}
+	if value, ok := _c.mutation.Steps(); ok {
+		_spec.SetField(escalationpolicy.FieldSteps, field.TypeJSON, value)
+		_node.Steps = value
+	}
+	if value, ok := _c.mutation.RepeatCount(); ok {
+		_spec.SetField(escalationpolicy.FieldRepeatCount, field.TypeInt, value)
+		_node.RepeatCount = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(escalationpolicy.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(escalationpolicy.FieldCreatedBy, field.TypeInt, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(escalationpolicy.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(escalationpolicy.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// EscalationPolicyCreateBulk is the builder for creating many EscalationPolicy entities in bulk.
+type EscalationPolicyCreateBulk struct {
+	config
+	err      error
+	builders []*EscalationPolicyCreate
+}
+
+// Save creates the EscalationPolicy entities in the database.
+func (_c *EscalationPolicyCreateBulk) Save(ctx context.Context) ([]*EscalationPolicy, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EscalationPolicy, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EscalationPolicyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EscalationPolicyCreateBulk) SaveX(ctx context.Context) []*EscalationPolicy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EscalationPolicyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EscalationPolicyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,672 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/escalationpolicy"
+	"itsm-backend/ent/predicate"
+	"itsm-backend/ent/schema"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// EscalationPolicyUpdate is the builder for updating EscalationPolicy entities.
+type EscalationPolicyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EscalationPolicyMutation
+}
+
+// Where appends a list predicates to the EscalationPolicyUpdate builder.
+func (_u *EscalationPolicyUpdate) Where(ps ...predicate.EscalationPolicy) *EscalationPolicyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *EscalationPolicyUpdate) SetTenantID(v int) *EscalationPolicyUpdate {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *EscalationPolicyUpdate) SetNillableTenantID(v *int) *EscalationPolicyUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *EscalationPolicyUpdate) AddTenantID(v int) *EscalationPolicyUpdate {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *EscalationPolicyUpdate) SetName(v string) *EscalationPolicyUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *EscalationPolicyUpdate) SetNillableName(v *string) *EscalationPolicyUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *EscalationPolicyUpdate) SetDescription(v string) *EscalationPolicyUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *EscalationPolicyUpdate) SetNillableDescription(v *string) *EscalationPolicyUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *EscalationPolicyUpdate) ClearDescription() *EscalationPolicyUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetTeamID sets the "team_id" field.
+func (_u *EscalationPolicyUpdate) SetTeamID(v int) *EscalationPolicyUpdate {
+	_u.mutation.ResetTeamID()
+	_u.mutation.SetTeamID(v)
+	return _u
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (_u *EscalationPolicyUpdate) SetNillableTeamID(v *int) *EscalationPolicyUpdate {
+	if v != nil {
+		_u.SetTeamID(*v)
+	}
+	return _u
+}
+
+// AddTeamID adds value to the "team_id" field.
+func (_u *EscalationPolicyUpdate) AddTeamID(v int) *EscalationPolicyUpdate {
+	_u.mutation.AddTeamID(v)
+	return _u
+}
+
+// ClearTeamID clears the value of the "team_id" field.
+func (_u *EscalationPolicyUpdate) ClearTeamID() *EscalationPolicyUpdate {
+	_u.mutation.ClearTeamID()
+	return _u
+}
+
+// SetSteps sets the "steps" field.
+func (_u *EscalationPolicyUpdate) SetSteps(v []schema.EscalationPolicyStep) *EscalationPolicyUpdate {
+	_u.mutation.SetSteps(v)
+	return _u
+}
+
+// AppendSteps appends value to the "steps" field.
+func (_u *EscalationPolicyUpdate) AppendSteps(v []schema.EscalationPolicyStep) *EscalationPolicyUpdate {
+	_u.mutation.AppendSteps(v)
+	return _u
+}
+
+// SetRepeatCount sets the "repeat_count" field.
+func (_u *EscalationPolicyUpdate) SetRepeatCount(v int) *EscalationPolicyUpdate {
+	_u.mutation.ResetRepeatCount()
+	_u.mutation.SetRepeatCount(v)
+	return _u
+}
+
+// SetNillableRepeatCount sets the "repeat_count" field if the given value is not nil.
+func (_u *EscalationPolicyUpdate) SetNillableRepeatCount(v *int) *EscalationPolicyUpdate {
+	if v != nil {
+		_u.SetRepeatCount(*v)
+	}
+	return _u
+}
+
+// AddRepeatCount adds value to the "repeat_count" field.
+func (_u *EscalationPolicyUpdate) AddRepeatCount(v int) *EscalationPolicyUpdate {
+	_u.mutation.AddRepeatCount(v)
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *EscalationPolicyUpdate) SetEnabled(v bool) *EscalationPolicyUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *EscalationPolicyUpdate) SetNillableEnabled(v *bool) *EscalationPolicyUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *EscalationPolicyUpdate) SetCreatedBy(v int) *EscalationPolicyUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *EscalationPolicyUpdate) SetNillableCreatedBy(v *int) *EscalationPolicyUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *EscalationPolicyUpdate) AddCreatedBy(v int) *EscalationPolicyUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *EscalationPolicyUpdate) ClearCreatedBy() *EscalationPolicyUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EscalationPolicyUpdate) SetUpdatedAt(v time.Time) *EscalationPolicyUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the EscalationPolicyMutation object of the builder.
+func (_u *EscalationPolicyUpdate) Mutation() *EscalationPolicyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EscalationPolicyUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EscalationPolicyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EscalationPolicyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EscalationPolicyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EscalationPolicyUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := escalationpolicy.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EscalationPolicyUpdate) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := escalationpolicy.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "EscalationPolicy.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := escalationpolicy.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "EscalationPolicy.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RepeatCount(); ok {
+		if err := escalationpolicy.RepeatCountValidator(v); err != nil {
+			return &ValidationError{Name: "repeat_count", err: fmt.Errorf(`ent: validator failed for field "EscalationPolicy.repeat_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EscalationPolicyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(escalationpolicy.Table, escalationpolicy.Columns, sqlgraph.NewFieldSpec(escalationpolicy.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(escalationpolicy.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(escalationpolicy.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(escalationpolicy.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(escalationpolicy.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(escalationpolicy.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.TeamID(); ok {
+		_spec.SetField(escalationpolicy.FieldTeamID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTeamID(); ok {
+		_spec.AddField(escalationpolicy.FieldTeamID, field.TypeInt, value)
+	}
+	if _u.mutation.TeamIDCleared() {
+		_spec.ClearField(escalationpolicy.FieldTeamID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Steps(); ok {
+		_spec.SetField(escalationpolicy.FieldSteps, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSteps(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, escalationpolicy.FieldSteps, value)
+		})
+	}
+	if value, ok := _u.mutation.RepeatCount(); ok {
+		_spec.SetField(escalationpolicy.FieldRepeatCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRepeatCount(); ok {
+		_spec.AddField(escalationpolicy.FieldRepeatCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(escalationpolicy.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(escalationpolicy.FieldCreatedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(escalationpolicy.FieldCreatedBy, field.TypeInt, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(escalationpolicy.FieldCreatedBy, field.TypeInt)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(escalationpolicy.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{escalationpolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EscalationPolicyUpdateOne is the builder for updating a single EscalationPolicy entity.
+type EscalationPolicyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EscalationPolicyMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *EscalationPolicyUpdateOne) SetTenantID(v int) *EscalationPolicyUpdateOne {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *EscalationPolicyUpdateOne) SetNillableTenantID(v *int) *EscalationPolicyUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *EscalationPolicyUpdateOne) AddTenantID(v int) *EscalationPolicyUpdateOne {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *EscalationPolicyUpdateOne) SetName(v string) *EscalationPolicyUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *EscalationPolicyUpdateOne) SetNillableName(v *string) *EscalationPolicyUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *EscalationPolicyUpdateOne) SetDescription(v string) *EscalationPolicyUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *EscalationPolicyUpdateOne) SetNillableDescription(v *string) *EscalationPolicyUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *EscalationPolicyUpdateOne) ClearDescription() *EscalationPolicyUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetTeamID sets the "team_id" field.
+func (_u *EscalationPolicyUpdateOne) SetTeamID(v int) *EscalationPolicyUpdateOne {
+	_u.mutation.ResetTeamID()
+	_u.mutation.SetTeamID(v)
+	return _u
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (_u *EscalationPolicyUpdateOne) SetNillableTeamID(v *int) *EscalationPolicyUpdateOne {
+	if v != nil {
+		_u.SetTeamID(*v)
+	}
+	return _u
+}
+
+// AddTeamID adds value to the "team_id" field.
+func (_u *EscalationPolicyUpdateOne) AddTeamID(v int) *EscalationPolicyUpdateOne {
+	_u.mutation.AddTeamID(v)
+	return _u
+}
+
+// ClearTeamID clears the value of the "team_id" field.
+func (_u *EscalationPolicyUpdateOne) ClearTeamID() *EscalationPolicyUpdateOne {
+	_u.mutation.ClearTeamID()
+	return _u
+}
+
+// SetSteps sets the "steps" field.
+func (_u *EscalationPolicyUpdateOne) SetSteps(v []schema.EscalationPolicyStep) *EscalationPolicyUpdateOne {
+	_u.mutation.SetSteps(v)
+	return _u
+}
+
+// AppendSteps appends value to the "steps" field.
+func (_u *EscalationPolicyUpdateOne) AppendSteps(v []schema.EscalationPolicyStep) *EscalationPolicyUpdateOne {
+	_u.mutation.AppendSteps(v)
+	return _u
+}
+
+// SetRepeatCount sets the "repeat_count" field.
+func (_u *EscalationPolicyUpdateOne) SetRepeatCount(v int) *EscalationPolicyUpdateOne {
+	_u.mutation.ResetRepeatCount()
+	_u.mutation.SetRepeatCount(v)
+	return _u
+}
+
+// SetNillableRepeatCount sets the "repeat_count" field if the given value is not nil.
+func (_u *EscalationPolicyUpdateOne) SetNillableRepeatCount(v *int) *EscalationPolicyUpdateOne {
+	if v != nil {
+		_u.SetRepeatCount(*v)
+	}
+	return _u
+}
+
+// AddRepeatCount adds value to the "repeat_count" field.
+func (_u *EscalationPolicyUpdateOne) AddRepeatCount(v int) *EscalationPolicyUpdateOne {
+	_u.mutation.AddRepeatCount(v)
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *EscalationPolicyUpdateOne) SetEnabled(v bool) *EscalationPolicyUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *EscalationPolicyUpdateOne) SetNillableEnabled(v *bool) *EscalationPolicyUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *EscalationPolicyUpdateOne) SetCreatedBy(v int) *EscalationPolicyUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *EscalationPolicyUpdateOne) SetNillableCreatedBy(v *int) *EscalationPolicyUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *EscalationPolicyUpdateOne) AddCreatedBy(v int) *EscalationPolicyUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *EscalationPolicyUpdateOne) ClearCreatedBy() *EscalationPolicyUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EscalationPolicyUpdateOne) SetUpdatedAt(v time.Time) *EscalationPolicyUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the EscalationPolicyMutation object of the builder.
+func (_u *EscalationPolicyUpdateOne) Mutation() *EscalationPolicyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EscalationPolicyUpdate builder.
+func (_u *EscalationPolicyUpdateOne) Where(ps ...predicate.EscalationPolicy) *EscalationPolicyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EscalationPolicyUpdateOne) Select(field string, fields ...string) *EscalationPolicyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EscalationPolicy entity.
+func (_u *EscalationPolicyUpdateOne) Save(ctx context.Context) (*EscalationPolicy, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EscalationPolicyUpdateOne) SaveX(ctx context.Context) *EscalationPolicy {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EscalationPolicyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EscalationPolicyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EscalationPolicyUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := escalationpolicy.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EscalationPolicyUpdateOne) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := escalationpolicy.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "EscalationPolicy.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := escalationpolicy.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "EscalationPolicy.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RepeatCount(); ok {
+		if err := escalationpolicy.RepeatCountValidator(v); err != nil {
+			return &ValidationError{Name: "repeat_count", err: fmt.Errorf(`ent: validator failed for field "EscalationPolicy.repeat_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EscalationPolicyUpdateOne) sqlSave(ctx context.Context) (_node *EscalationPolicy, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(escalationpolicy.Table, escalationpolicy.Columns, sqlgraph.NewFieldSpec(escalationpolicy.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EscalationPolicy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, escalationpolicy.FieldID)
+		for _, f := range fields {
+			if !escalationpolicy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != escalationpolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(escalationpolicy.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(escalationpolicy.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(escalationpolicy.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(escalationpolicy.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(escalationpolicy.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.TeamID(); ok {
+		_spec.SetField(escalationpolicy.FieldTeamID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTeamID(); ok {
+		_spec.AddField(escalationpolicy.FieldTeamID, field.TypeInt, value)
+	}
+	if _u.mutation.TeamIDCleared() {
+		_spec.ClearField(escalationpolicy.FieldTeamID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Steps(); ok {
+		_spec.SetField(escalationpolicy.FieldSteps, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSteps(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, escalationpolicy.FieldSteps, value)
+		})
+	}
+	if value, ok := _u.mutation.RepeatCount(); ok {
+		_spec.SetField(escalationpolicy.FieldRepeatCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRepeatCount(); ok {
+		_spec.AddField(escalationpolicy.FieldRepeatCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(escalationpolicy.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(escalationpolicy.FieldCreatedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(escalationpolicy.FieldCreatedBy, field.TypeInt, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(escalationpolicy.FieldCreatedBy, field.TypeInt)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(escalationpolicy.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &EscalationPolicy{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{escalationpolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -8,6 +8,18 @@ import (
 	"itsm-backend/ent"
 )
 
+// The AlertSuppressionRuleFunc type is an adapter to allow the use of ordinary
+// function as AlertSuppressionRule mutator.
+type AlertSuppressionRuleFunc func(context.Context, *ent.AlertSuppressionRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AlertSuppressionRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AlertSuppressionRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AlertSuppressionRuleMutation", m)
+}
+
 // The ApplicationFunc type is an adapter to allow the use of ordinary
 // function as Application mutator.
 type ApplicationFunc func(context.Context, *ent.ApplicationMutation) (ent.Value, error)
@@ -116,6 +128,18 @@ func (f BootstrapTokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BootstrapTokenMutation", m)
 }
 
+// The BusinessCalendarFunc type is an adapter to allow the use of ordinary
+// function as BusinessCalendar mutator.
+type BusinessCalendarFunc func(context.Context, *ent.BusinessCalendarMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BusinessCalendarFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BusinessCalendarMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BusinessCalendarMutation", m)
+}
+
 // The CABMemberFunc type is an adapter to allow the use of ordinary
 // function as CABMember mutator.
 type CABMemberFunc func(context.Context, *ent.CABMemberMutation) (ent.Value, error)
@@ -320,6 +344,18 @@ func (f ConversationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ConversationMutation", m)
 }
 
+// The DecisionDefinitionFunc type is an adapter to allow the use of ordinary
+// function as DecisionDefinition mutator.
+type DecisionDefinitionFunc func(context.Context, *ent.DecisionDefinitionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DecisionDefinitionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DecisionDefinitionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DecisionDefinitionMutation", m)
+}
+
 // The DepartmentFunc type is an adapter to allow the use of ordinary
 // function as Department mutator.
 type DepartmentFunc func(context.Context, *ent.DepartmentMutation) (ent.Value, error)
@@ -404,6 +440,18 @@ func (f EngineerSkillFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EngineerSkillMutation", m)
 }
 
+// The EscalationPolicyFunc type is an adapter to allow the use of ordinary
+// function as EscalationPolicy mutator.
+type EscalationPolicyFunc func(context.Context, *ent.EscalationPolicyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EscalationPolicyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EscalationPolicyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EscalationPolicyMutation", m)
+}
+
 // The FeishuTicketSyncFunc type is an adapter to allow the use of ordinary
 // function as FeishuTicketSync mutator.
 type FeishuTicketSyncFunc func(context.Context, *ent.FeishuTicketSyncMutation) (ent.Value, error)
@@ -428,6 +476,42 @@ func (f GroupFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GroupMutation", m)
 }
 
+// The InboundDedupKeyFunc type is an adapter to allow the use of ordinary
+// function as InboundDedupKey mutator.
+type InboundDedupKeyFunc func(context.Context, *ent.InboundDedupKeyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InboundDedupKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.InboundDedupKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InboundDedupKeyMutation", m)
+}
+
+// The InboundEmailFunc type is an adapter to allow the use of ordinary
+// function as InboundEmail mutator.
+type InboundEmailFunc func(context.Context, *ent.InboundEmailMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InboundEmailFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.InboundEmailMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InboundEmailMutation", m)
+}
+
+// The InboundMessageLogFunc type is an adapter to allow the use of ordinary
+// function as InboundMessageLog mutator.
+type InboundMessageLogFunc func(context.Context, *ent.InboundMessageLogMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InboundMessageLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.InboundMessageLogMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InboundMessageLogMutation", m)
+}
+
 // The IncidentFunc type is an adapter to allow the use of ordinary
 // function as Incident mutator.
 type IncidentFunc func(context.Context, *ent.IncidentMutation) (ent.Value, error)
@@ -608,6 +692,18 @@ func (f MSPAllocationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MSPAllocationMutation", m)
 }
 
+// The MajorIncidentFunc type is an adapter to allow the use of ordinary
+// function as MajorIncident mutator.
+type MajorIncidentFunc func(context.Context, *ent.MajorIncidentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MajorIncidentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MajorIncidentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MajorIncidentMutation", m)
+}
+
 // The MarketplaceItemFunc type is an adapter to allow the use of ordinary
 // function as MarketplaceItem mutator.
 type MarketplaceItemFunc func(context.Context, *ent.MarketplaceItemMutation) (ent.Value, error)
@@ -656,6 +752,18 @@ func (f MicroserviceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MicroserviceMutation", m)
 }
 
+// The MonitoringAlertFunc type is an adapter to allow the use of ordinary
+// function as MonitoringAlert mutator.
+type MonitoringAlertFunc func(context.Context, *ent.MonitoringAlertMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MonitoringAlertFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MonitoringAlertMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MonitoringAlertMutation", m)
+}
+
 // The NotificationFunc type is an adapter to allow the use of ordinary
 // function as Notification mutator.
 type NotificationFunc func(context.Context, *ent.NotificationMutation) (ent.Value, error)
@@ -692,6 +800,42 @@ func (f NotificationPreferenceFunc) Mutate(ctx context.Context, m ent.Mutation)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NotificationPreferenceMutation", m)
 }
 
+// The OnCallOverrideFunc type is an adapter to allow the use of ordinary
+// function as OnCallOverride mutator.
+type OnCallOverrideFunc func(context.Context, *ent.OnCallOverrideMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OnCallOverrideFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OnCallOverrideMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OnCallOverrideMutation", m)
+}
+
+// The OnCallPageFunc type is an adapter to allow the use of ordinary
+// function as OnCallPage mutator.
+type OnCallPageFunc func(context.Context, *ent.OnCallPageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OnCallPageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OnCallPageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OnCallPageMutation", m)
+}
+
+// The OnCallScheduleFunc type is an adapter to allow the use of ordinary
+// function as OnCallSchedule mutator.
+type OnCallScheduleFunc func(context.Context, *ent.OnCallScheduleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OnCallScheduleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OnCallScheduleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OnCallScheduleMutation", m)
+}
+
 // The OperationalCommandFunc type is an adapter to allow the use of ordinary
 // function as OperationalCommand mutator.
 type OperationalCommandFunc func(context.Context, *ent.OperationalCommandMutation) (ent.Value, error)
@@ -812,6 +956,30 @@ func (f ProcessDeploymentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProcessDeploymentMutation", m)
 }
 
+// The ProcessEventInstanceFunc type is an adapter to allow the use of ordinary
+// function as ProcessEventInstance mutator.
+type ProcessEventInstanceFunc func(context.Context, *ent.ProcessEventInstanceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProcessEventInstanceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ProcessEventInstanceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProcessEventInstanceMutation", m)
+}
+
+// The ProcessEventSubscriptionFunc type is an adapter to allow the use of ordinary
+// function as ProcessEventSubscription mutator.
+type ProcessEventSubscriptionFunc func(context.Context, *ent.ProcessEventSubscriptionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProcessEventSubscriptionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ProcessEventSubscriptionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProcessEventSubscriptionMutation", m)
+}
+
 // The ProcessExecutionHistoryFunc type is an adapter to allow the use of ordinary
 // function as ProcessExecutionHistory mutator.
 type ProcessExecutionHistoryFunc func(context.Context, *ent.ProcessExecutionHistoryMutation) (ent.Value, error)
@@ -824,6 +992,18 @@ func (f ProcessExecutionHistoryFunc) Mutate(ctx context.Context, m ent.Mutation)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProcessExecutionHistoryMutation", m)
 }
 
+// The ProcessIncidentFunc type is an adapter to allow the use of ordinary
+// function as ProcessIncident mutator.
+type ProcessIncidentFunc func(context.Context, *ent.ProcessIncidentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProcessIncidentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ProcessIncidentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProcessIncidentMutation", m)
+}
+
 // The ProcessInstanceFunc type is an adapter to allow the use of ordinary
 // function as ProcessInstance mutator.
 type ProcessInstanceFunc func(context.Context, *ent.ProcessInstanceMutation) (ent.Value, error)
@@ -1040,6 +1220,18 @@ func (f SLAViolationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SLAViolationMutation", m)
 }
 
+// The SearchDocumentFunc type is an adapter to allow the use of ordinary
+// function as SearchDocument mutator.
+type SearchDocumentFunc func(context.Context, *ent.SearchDocumentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SearchDocumentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SearchDocumentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SearchDocumentMutation", m)
+}
+
 // The ServiceCatalogFunc type is an adapter to allow the use of ordinary
 // function as ServiceCatalog mutator.
 type ServiceCatalogFunc func(context.Context, *ent.ServiceCatalogMutation) (ent.Value, error)
@@ -1292,6 +1484,54 @@ func (f TicketNotificationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TicketNotificationMutation", m)
 }
 
+// The TicketSLAMetricFunc type is an adapter to allow the use of ordinary
+// function as TicketSLAMetric mutator.
+type TicketSLAMetricFunc func(context.Context, *ent.TicketSLAMetricMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TicketSLAMetricFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TicketSLAMetricMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TicketSLAMetricMutation", m)
+}
+
+// The TicketSLAPauseFunc type is an adapter to allow the use of ordinary
+// function as TicketSLAPause mutator.
+type TicketSLAPauseFunc func(context.Context, *ent.TicketSLAPauseMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TicketSLAPauseFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TicketSLAPauseMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TicketSLAPauseMutation", m)
+}
+
+// The TicketScheduleFunc type is an adapter to allow the use of ordinary
+// function as TicketSchedule mutator.
+type TicketScheduleFunc func(context.Context, *ent.TicketScheduleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TicketScheduleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TicketScheduleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TicketScheduleMutation", m)
+}
+
+// The TicketScheduleRunFunc type is an adapter to allow the use of ordinary
+// function as TicketScheduleRun mutator.
+type TicketScheduleRunFunc func(context.Context, *ent.TicketScheduleRunMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TicketScheduleRunFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TicketScheduleRunMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TicketScheduleRunMutation", m)
+}
+
 // The TicketTagFunc type is an adapter to allow the use of ordinary
 // function as TicketTag mutator.
 type TicketTagFunc func(context.Context, *ent.TicketTagMutation) (ent.Value, error)
@@ -1388,6 +1628,18 @@ func (f VendorFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, erro
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.VendorMutation", m)
 }
 
+// The WorkLogFunc type is an adapter to allow the use of ordinary
+// function as WorkLog mutator.
+type WorkLogFunc func(context.Context, *ent.WorkLogMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WorkLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WorkLogMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WorkLogMutation", m)
+}
+
 // The WorkflowFunc type is an adapter to allow the use of ordinary
 // function as Workflow mutator.
 type WorkflowFunc func(context.Context, *ent.WorkflowMutation) (ent.Value, error)
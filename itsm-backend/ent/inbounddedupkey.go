@@ -0,0 +1,139 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"itsm-backend/ent/inbounddedupkey"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// InboundDedupKey is the model entity for the InboundDedupKey schema.
+type InboundDedupKey struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 租户ID，无租户上下文的消息为 0
+	TenantID int `json:"tenant_id,omitempty"`
+	// 去重 key
+	DedupKey string `json:"dedup_key,omitempty"`
+	// 过期时间
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// 创建时间
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InboundDedupKey) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case inbounddedupkey.FieldID, inbounddedupkey.FieldTenantID:
+			values[i] = new(sql.NullInt64)
+		case inbounddedupkey.FieldDedupKey:
+			values[i] = new(sql.NullString)
+		case inbounddedupkey.FieldExpiresAt, inbounddedupkey.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InboundDedupKey fields.
+func (_m *InboundDedupKey) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case inbounddedupkey.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case inbounddedupkey.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = int(value.Int64)
+			}
+		case inbounddedupkey.FieldDedupKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field dedup_key", values[i])
+			} else if value.Valid {
+				_m.DedupKey = value.String
+			}
+		case inbounddedupkey.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case inbounddedupkey.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the InboundDedupKey.
+// This includes values selected through modifiers, order, etc.
+func (_m *InboundDedupKey) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this InboundDedupKey.
+// Note that you need to call InboundDedupKey.Unwrap() before calling this method if this InboundDedupKey
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *InboundDedupKey) Update() *InboundDedupKeyUpdateOne {
+	return NewInboundDedupKeyClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the InboundDedupKey entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *InboundDedupKey) Unwrap() *InboundDedupKey {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InboundDedupKey is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *InboundDedupKey) String() string {
+	var builder strings.Builder
+	builder.WriteString("InboundDedupKey(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("dedup_key=")
+	builder.WriteString(_m.DedupKey)
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InboundDedupKeys is a parsable slice of InboundDedupKey.
+type InboundDedupKeys []*InboundDedupKey
@@ -0,0 +1,263 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/inbounddedupkey"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InboundDedupKeyCreate is the builder for creating a InboundDedupKey entity.
+type InboundDedupKeyCreate struct {
+	config
+	mutation *InboundDedupKeyMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *InboundDedupKeyCreate) SetTenantID(v int) *InboundDedupKeyCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *InboundDedupKeyCreate) SetNillableTenantID(v *int) *InboundDedupKeyCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetDedupKey sets the "dedup_key" field.
+func (_c *InboundDedupKeyCreate) SetDedupKey(v string) *InboundDedupKeyCreate {
+	_c.mutation.SetDedupKey(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *InboundDedupKeyCreate) SetExpiresAt(v time.Time) *InboundDedupKeyCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *InboundDedupKeyCreate) SetCreatedAt(v time.Time) *InboundDedupKeyCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *InboundDedupKeyCreate) SetNillableCreatedAt(v *time.Time) *InboundDedupKeyCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the InboundDedupKeyMutation object of the builder.
+func (_c *InboundDedupKeyCreate) Mutation() *InboundDedupKeyMutation {
+	return _c.mutation
+}
+
+// Save creates the InboundDedupKey in the database.
+func (_c *InboundDedupKeyCreate) Save(ctx context.Context) (*InboundDedupKey, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *InboundDedupKeyCreate) SaveX(ctx context.Context) *InboundDedupKey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InboundDedupKeyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InboundDedupKeyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *InboundDedupKeyCreate) defaults() {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		v := inbounddedupkey.DefaultTenantID
+		_c.mutation.SetTenantID(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := inbounddedupkey.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *InboundDedupKeyCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "InboundDedupKey.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := inbounddedupkey.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "InboundDedupKey.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DedupKey(); !ok {
+		return &ValidationError{Name: "dedup_key", err: errors.New(`ent: missing required field "InboundDedupKey.dedup_key"`)}
+	}
+	if v, ok := _c.mutation.DedupKey(); ok {
+		if err := inbounddedupkey.DedupKeyValidator(v); err != nil {
+			return &ValidationError{Name: "dedup_key", err: fmt.Errorf(`ent: validator failed for field "InboundDedupKey.dedup_key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "InboundDedupKey.expires_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "InboundDedupKey.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *InboundDedupKeyCreate) sqlSave(ctx context.Context) (*InboundDedupKey, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *InboundDedupKeyCreate) createSpec() (*InboundDedupKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InboundDedupKey{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(inbounddedupkey.Table, sqlgraph.NewFieldSpec(inbounddedupkey.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(inbounddedupkey.FieldTenantID, field.TypeInt, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.DedupKey(); ok {
+		_spec.SetField(inbounddedupkey.FieldDedupKey, field.TypeString, value)
+		_node.DedupKey = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(inbounddedupkey.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(inbounddedupkey.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// InboundDedupKeyCreateBulk is the builder for creating many InboundDedupKey entities in bulk.
+type InboundDedupKeyCreateBulk struct {
+	config
+	err      error
+	builders []*InboundDedupKeyCreate
+}
+
+// Save creates the InboundDedupKey entities in the database.
+func (_c *InboundDedupKeyCreateBulk) Save(ctx context.Context) ([]*InboundDedupKey, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*InboundDedupKey, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InboundDedupKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *InboundDedupKeyCreateBulk) SaveX(ctx context.Context) []*InboundDedupKey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InboundDedupKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InboundDedupKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"itsm-backend/ent/inbounddedupkey"
+	"itsm-backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InboundDedupKeyDelete is the builder for deleting a InboundDedupKey entity.
+type InboundDedupKeyDelete struct {
+	config
+	hooks    []Hook
+	mutation *InboundDedupKeyMutation
+}
+
+// Where appends a list predicates to the InboundDedupKeyDelete builder.
+func (_d *InboundDedupKeyDelete) Where(ps ...predicate.InboundDedupKey) *InboundDedupKeyDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *InboundDedupKeyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *InboundDedupKeyDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *InboundDedupKeyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(inbounddedupkey.Table, sqlgraph.NewFieldSpec(inbounddedupkey.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// InboundDedupKeyDeleteOne is the builder for deleting a single InboundDedupKey entity.
+type InboundDedupKeyDeleteOne struct {
+	_d *InboundDedupKeyDelete
+}
+
+// Where appends a list predicates to the InboundDedupKeyDelete builder.
+func (_d *InboundDedupKeyDeleteOne) Where(ps ...predicate.InboundDedupKey) *InboundDedupKeyDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *InboundDedupKeyDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{inbounddedupkey.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *InboundDedupKeyDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
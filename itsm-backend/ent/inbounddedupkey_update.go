@@ -0,0 +1,334 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/inbounddedupkey"
+	"itsm-backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InboundDedupKeyUpdate is the builder for updating InboundDedupKey entities.
+type InboundDedupKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InboundDedupKeyMutation
+}
+
+// Where appends a list predicates to the InboundDedupKeyUpdate builder.
+func (_u *InboundDedupKeyUpdate) Where(ps ...predicate.InboundDedupKey) *InboundDedupKeyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *InboundDedupKeyUpdate) SetTenantID(v int) *InboundDedupKeyUpdate {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *InboundDedupKeyUpdate) SetNillableTenantID(v *int) *InboundDedupKeyUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *InboundDedupKeyUpdate) AddTenantID(v int) *InboundDedupKeyUpdate {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetDedupKey sets the "dedup_key" field.
+func (_u *InboundDedupKeyUpdate) SetDedupKey(v string) *InboundDedupKeyUpdate {
+	_u.mutation.SetDedupKey(v)
+	return _u
+}
+
+// SetNillableDedupKey sets the "dedup_key" field if the given value is not nil.
+func (_u *InboundDedupKeyUpdate) SetNillableDedupKey(v *string) *InboundDedupKeyUpdate {
+	if v != nil {
+		_u.SetDedupKey(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *InboundDedupKeyUpdate) SetExpiresAt(v time.Time) *InboundDedupKeyUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *InboundDedupKeyUpdate) SetNillableExpiresAt(v *time.Time) *InboundDedupKeyUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the InboundDedupKeyMutation object of the builder.
+func (_u *InboundDedupKeyUpdate) Mutation() *InboundDedupKeyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *InboundDedupKeyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InboundDedupKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *InboundDedupKeyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InboundDedupKeyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InboundDedupKeyUpdate) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := inbounddedupkey.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "InboundDedupKey.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DedupKey(); ok {
+		if err := inbounddedupkey.DedupKeyValidator(v); err != nil {
+			return &ValidationError{Name: "dedup_key", err: fmt.Errorf(`ent: validator failed for field "InboundDedupKey.dedup_key": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *InboundDedupKeyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(inbounddedupkey.Table, inbounddedupkey.Columns, sqlgraph.NewFieldSpec(inbounddedupkey.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(inbounddedupkey.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(inbounddedupkey.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DedupKey(); ok {
+		_spec.SetField(inbounddedupkey.FieldDedupKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(inbounddedupkey.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{inbounddedupkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// InboundDedupKeyUpdateOne is the builder for updating a single InboundDedupKey entity.
+type InboundDedupKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InboundDedupKeyMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *InboundDedupKeyUpdateOne) SetTenantID(v int) *InboundDedupKeyUpdateOne {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *InboundDedupKeyUpdateOne) SetNillableTenantID(v *int) *InboundDedupKeyUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *InboundDedupKeyUpdateOne) AddTenantID(v int) *InboundDedupKeyUpdateOne {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetDedupKey sets the "dedup_key" field.
+func (_u *InboundDedupKeyUpdateOne) SetDedupKey(v string) *InboundDedupKeyUpdateOne {
+	_u.mutation.SetDedupKey(v)
+	return _u
+}
+
+// SetNillableDedupKey sets the "dedup_key" field if the given value is not nil.
+func (_u *InboundDedupKeyUpdateOne) SetNillableDedupKey(v *string) *InboundDedupKeyUpdateOne {
+	if v != nil {
+		_u.SetDedupKey(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *InboundDedupKeyUpdateOne) SetExpiresAt(v time.Time) *InboundDedupKeyUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *InboundDedupKeyUpdateOne) SetNillableExpiresAt(v *time.Time) *InboundDedupKeyUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the InboundDedupKeyMutation object of the builder.
+func (_u *InboundDedupKeyUpdateOne) Mutation() *InboundDedupKeyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the InboundDedupKeyUpdate builder.
+func (_u *InboundDedupKeyUpdateOne) Where(ps ...predicate.InboundDedupKey) *InboundDedupKeyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *InboundDedupKeyUpdateOne) Select(field string, fields ...string) *InboundDedupKeyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated InboundDedupKey entity.
+func (_u *InboundDedupKeyUpdateOne) Save(ctx context.Context) (*InboundDedupKey, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InboundDedupKeyUpdateOne) SaveX(ctx context.Context) *InboundDedupKey {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *InboundDedupKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InboundDedupKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InboundDedupKeyUpdateOne) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := inbounddedupkey.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "InboundDedupKey.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DedupKey(); ok {
+		if err := inbounddedupkey.DedupKeyValidator(v); err != nil {
+			return &ValidationError{Name: "dedup_key", err: fmt.Errorf(`ent: validator failed for field "InboundDedupKey.dedup_key": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *InboundDedupKeyUpdateOne) sqlSave(ctx context.Context) (_node *InboundDedupKey, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(inbounddedupkey.Table, inbounddedupkey.Columns, sqlgraph.NewFieldSpec(inbounddedupkey.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "InboundDedupKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, inbounddedupkey.FieldID)
+		for _, f := range fields {
+			if !inbounddedupkey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != inbounddedupkey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(inbounddedupkey.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(inbounddedupkey.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DedupKey(); ok {
+		_spec.SetField(inbounddedupkey.FieldDedupKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(inbounddedupkey.FieldExpiresAt, field.TypeTime, value)
+	}
+	_node = &InboundDedupKey{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{inbounddedupkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,285 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"itsm-backend/ent/inboundemail"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// InboundEmail is the model entity for the InboundEmail schema.
+type InboundEmail struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 租户ID
+	TenantID int `json:"tenant_id,omitempty"`
+	// 接收邮箱地址
+	Mailbox string `json:"mailbox,omitempty"`
+	// 邮件 Message-ID（不含尖括号）
+	MessageID string `json:"message_id,omitempty"`
+	// In-Reply-To
+	InReplyTo string `json:"in_reply_to,omitempty"`
+	// References 链
+	References []string `json:"references,omitempty"`
+	// 发件人地址
+	FromAddress string `json:"from_address,omitempty"`
+	// 发件人名称
+	FromName string `json:"from_name,omitempty"`
+	// 邮件主题
+	Subject string `json:"subject,omitempty"`
+	// 处理结果: pending 处理中/created 新建工单/threaded 追加到已有工单/ignored 已忽略/failed 处理失败
+	Status inboundemail.Status `json:"status,omitempty"`
+	// 忽略或失败原因
+	Reason string `json:"reason,omitempty"`
+	// 关联工单ID
+	TicketID *int `json:"ticket_id,omitempty"`
+	// 追加的评论ID
+	CommentID *int `json:"comment_id,omitempty"`
+	// 映射到的发件用户ID
+	UserID *int `json:"user_id,omitempty"`
+	// 已保存附件数
+	AttachmentCount int `json:"attachment_count,omitempty"`
+	// 接收时间
+	ReceivedAt time.Time `json:"received_at,omitempty"`
+	// 创建时间
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InboundEmail) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case inboundemail.FieldReferences:
+			values[i] = new([]byte)
+		case inboundemail.FieldID, inboundemail.FieldTenantID, inboundemail.FieldTicketID, inboundemail.FieldCommentID, inboundemail.FieldUserID, inboundemail.FieldAttachmentCount:
+			values[i] = new(sql.NullInt64)
+		case inboundemail.FieldMailbox, inboundemail.FieldMessageID, inboundemail.FieldInReplyTo, inboundemail.FieldFromAddress, inboundemail.FieldFromName, inboundemail.FieldSubject, inboundemail.FieldStatus, inboundemail.FieldReason:
+			values[i] = new(sql.NullString)
+		case inboundemail.FieldReceivedAt, inboundemail.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InboundEmail fields.
+func (_m *InboundEmail) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case inboundemail.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case inboundemail.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = int(value.Int64)
+			}
+		case inboundemail.FieldMailbox:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mailbox", values[i])
+			} else if value.Valid {
+				_m.Mailbox = value.String
+			}
+		case inboundemail.FieldMessageID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message_id", values[i])
+			} else if value.Valid {
+				_m.MessageID = value.String
+			}
+		case inboundemail.FieldInReplyTo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field in_reply_to", values[i])
+			} else if value.Valid {
+				_m.InReplyTo = value.String
+			}
+		case inboundemail.FieldReferences:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field references", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.References); err != nil {
+					return fmt.Errorf("unmarshal field references: %w", err)
+				}
+			}
+		case inboundemail.FieldFromAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_address", values[i])
+			} else if value.Valid {
+				_m.FromAddress = value.String
+			}
+		case inboundemail.FieldFromName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_name", values[i])
+			} else if value.Valid {
+				_m.FromName = value.String
+			}
+		case inboundemail.FieldSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject", values[i])
+			} else if value.Valid {
+				_m.Subject = value.String
+			}
+		case inboundemail.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = inboundemail.Status(value.String)
+			}
+		case inboundemail.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case inboundemail.FieldTicketID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field ticket_id", values[i])
+			} else if value.Valid {
+				_m.TicketID = new(int)
+				*_m.TicketID = int(value.Int64)
+			}
+		case inboundemail.FieldCommentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field comment_id", values[i])
+			} else if value.Valid {
+				_m.CommentID = new(int)
+				*_m.CommentID = int(value.Int64)
+			}
+		case inboundemail.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = new(int)
+				*_m.UserID = int(value.Int64)
+			}
+		case inboundemail.FieldAttachmentCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attachment_count", values[i])
+			} else if value.Valid {
+				_m.AttachmentCount = int(value.Int64)
+			}
+		case inboundemail.FieldReceivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field received_at", values[i])
+			} else if value.Valid {
+				_m.ReceivedAt = value.Time
+			}
+		case inboundemail.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the InboundEmail.
+// This includes values selected through modifiers, order, etc.
+func (_m *InboundEmail) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this InboundEmail.
+// Note that you need to call InboundEmail.Unwrap() before calling this method if this InboundEmail
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *InboundEmail) Update() *InboundEmailUpdateOne {
+	return NewInboundEmailClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the InboundEmail entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *InboundEmail) Unwrap() *InboundEmail {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InboundEmail is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *InboundEmail) String() string {
+	var builder strings.Builder
+	builder.WriteString("InboundEmail(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("mailbox=")
+	builder.WriteString(_m.Mailbox)
+	builder.WriteString(", ")
+	builder.WriteString("message_id=")
+	builder.WriteString(_m.MessageID)
+	builder.WriteString(", ")
+	builder.WriteString("in_reply_to=")
+	builder.WriteString(_m.InReplyTo)
+	builder.WriteString(", ")
+	builder.WriteString("references=")
+	builder.WriteString(fmt.Sprintf("%v", _m.References))
+	builder.WriteString(", ")
+	builder.WriteString("from_address=")
+	builder.WriteString(_m.FromAddress)
+	builder.WriteString(", ")
+	builder.WriteString("from_name=")
+	builder.WriteString(_m.FromName)
+	builder.WriteString(", ")
+	builder.WriteString("subject=")
+	builder.WriteString(_m.Subject)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	if v := _m.TicketID; v != nil {
+		builder.WriteString("ticket_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CommentID; v != nil {
+		builder.WriteString("comment_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.UserID; v != nil {
+		builder.WriteString("user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("attachment_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AttachmentCount))
+	builder.WriteString(", ")
+	builder.WriteString("received_at=")
+	builder.WriteString(_m.ReceivedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InboundEmails is a parsable slice of InboundEmail.
+type InboundEmails []*InboundEmail
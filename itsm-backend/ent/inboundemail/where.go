@@ -0,0 +1,995 @@
+// Code generated by ent, DO NOT EDIT.
+
+package inboundemail
+
+import (
+	"itsm-backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Mailbox applies equality check predicate on the "mailbox" field. It's identical to MailboxEQ.
+func Mailbox(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEQ(FieldMailbox, v))
+}
+
+// MessageID applies equality check predicate on the "message_id" field. It's identical to MessageIDEQ.
+func MessageID(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEQ(FieldMessageID, v))
+}
+
+// InReplyTo applies equality check predicate on the "in_reply_to" field. It's identical to InReplyToEQ.
+func InReplyTo(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEQ(FieldInReplyTo, v))
+}
+
+// FromAddress applies equality check predicate on the "from_address" field. It's identical to FromAddressEQ.
+func FromAddress(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEQ(FieldFromAddress, v))
+}
+
+// FromName applies equality check predicate on the "from_name" field. It's identical to FromNameEQ.
+func FromName(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEQ(FieldFromName, v))
+}
+
+// Subject applies equality check predicate on the "subject" field. It's identical to SubjectEQ.
+func Subject(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEQ(FieldSubject, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEQ(FieldReason, v))
+}
+
+// TicketID applies equality check predicate on the "ticket_id" field. It's identical to TicketIDEQ.
+func TicketID(v int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEQ(FieldTicketID, v))
+}
+
+// CommentID applies equality check predicate on the "comment_id" field. It's identical to CommentIDEQ.
+func CommentID(v int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEQ(FieldCommentID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEQ(FieldUserID, v))
+}
+
+// AttachmentCount applies equality check predicate on the "attachment_count" field. It's identical to AttachmentCountEQ.
+func AttachmentCount(v int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEQ(FieldAttachmentCount, v))
+}
+
+// ReceivedAt applies equality check predicate on the "received_at" field. It's identical to ReceivedAtEQ.
+func ReceivedAt(v time.Time) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEQ(FieldReceivedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldLTE(FieldTenantID, v))
+}
+
+// MailboxEQ applies the EQ predicate on the "mailbox" field.
+func MailboxEQ(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEQ(FieldMailbox, v))
+}
+
+// MailboxNEQ applies the NEQ predicate on the "mailbox" field.
+func MailboxNEQ(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNEQ(FieldMailbox, v))
+}
+
+// MailboxIn applies the In predicate on the "mailbox" field.
+func MailboxIn(vs ...string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldIn(FieldMailbox, vs...))
+}
+
+// MailboxNotIn applies the NotIn predicate on the "mailbox" field.
+func MailboxNotIn(vs ...string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNotIn(FieldMailbox, vs...))
+}
+
+// MailboxGT applies the GT predicate on the "mailbox" field.
+func MailboxGT(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldGT(FieldMailbox, v))
+}
+
+// MailboxGTE applies the GTE predicate on the "mailbox" field.
+func MailboxGTE(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldGTE(FieldMailbox, v))
+}
+
+// MailboxLT applies the LT predicate on the "mailbox" field.
+func MailboxLT(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldLT(FieldMailbox, v))
+}
+
+// MailboxLTE applies the LTE predicate on the "mailbox" field.
+func MailboxLTE(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldLTE(FieldMailbox, v))
+}
+
+// MailboxContains applies the Contains predicate on the "mailbox" field.
+func MailboxContains(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldContains(FieldMailbox, v))
+}
+
+// MailboxHasPrefix applies the HasPrefix predicate on the "mailbox" field.
+func MailboxHasPrefix(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldHasPrefix(FieldMailbox, v))
+}
+
+// MailboxHasSuffix applies the HasSuffix predicate on the "mailbox" field.
+func MailboxHasSuffix(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldHasSuffix(FieldMailbox, v))
+}
+
+// MailboxIsNil applies the IsNil predicate on the "mailbox" field.
+func MailboxIsNil() predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldIsNull(FieldMailbox))
+}
+
+// MailboxNotNil applies the NotNil predicate on the "mailbox" field.
+func MailboxNotNil() predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNotNull(FieldMailbox))
+}
+
+// MailboxEqualFold applies the EqualFold predicate on the "mailbox" field.
+func MailboxEqualFold(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEqualFold(FieldMailbox, v))
+}
+
+// MailboxContainsFold applies the ContainsFold predicate on the "mailbox" field.
+func MailboxContainsFold(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldContainsFold(FieldMailbox, v))
+}
+
+// MessageIDEQ applies the EQ predicate on the "message_id" field.
+func MessageIDEQ(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEQ(FieldMessageID, v))
+}
+
+// MessageIDNEQ applies the NEQ predicate on the "message_id" field.
+func MessageIDNEQ(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNEQ(FieldMessageID, v))
+}
+
+// MessageIDIn applies the In predicate on the "message_id" field.
+func MessageIDIn(vs ...string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldIn(FieldMessageID, vs...))
+}
+
+// MessageIDNotIn applies the NotIn predicate on the "message_id" field.
+func MessageIDNotIn(vs ...string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNotIn(FieldMessageID, vs...))
+}
+
+// MessageIDGT applies the GT predicate on the "message_id" field.
+func MessageIDGT(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldGT(FieldMessageID, v))
+}
+
+// MessageIDGTE applies the GTE predicate on the "message_id" field.
+func MessageIDGTE(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldGTE(FieldMessageID, v))
+}
+
+// MessageIDLT applies the LT predicate on the "message_id" field.
+func MessageIDLT(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldLT(FieldMessageID, v))
+}
+
+// MessageIDLTE applies the LTE predicate on the "message_id" field.
+func MessageIDLTE(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldLTE(FieldMessageID, v))
+}
+
+// MessageIDContains applies the Contains predicate on the "message_id" field.
+func MessageIDContains(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldContains(FieldMessageID, v))
+}
+
+// MessageIDHasPrefix applies the HasPrefix predicate on the "message_id" field.
+func MessageIDHasPrefix(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldHasPrefix(FieldMessageID, v))
+}
+
+// MessageIDHasSuffix applies the HasSuffix predicate on the "message_id" field.
+func MessageIDHasSuffix(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldHasSuffix(FieldMessageID, v))
+}
+
+// MessageIDEqualFold applies the EqualFold predicate on the "message_id" field.
+func MessageIDEqualFold(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEqualFold(FieldMessageID, v))
+}
+
+// MessageIDContainsFold applies the ContainsFold predicate on the "message_id" field.
+func MessageIDContainsFold(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldContainsFold(FieldMessageID, v))
+}
+
+// InReplyToEQ applies the EQ predicate on the "in_reply_to" field.
+func InReplyToEQ(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEQ(FieldInReplyTo, v))
+}
+
+// InReplyToNEQ applies the NEQ predicate on the "in_reply_to" field.
+func InReplyToNEQ(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNEQ(FieldInReplyTo, v))
+}
+
+// InReplyToIn applies the In predicate on the "in_reply_to" field.
+func InReplyToIn(vs ...string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldIn(FieldInReplyTo, vs...))
+}
+
+// InReplyToNotIn applies the NotIn predicate on the "in_reply_to" field.
+func InReplyToNotIn(vs ...string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNotIn(FieldInReplyTo, vs...))
+}
+
+// InReplyToGT applies the GT predicate on the "in_reply_to" field.
+func InReplyToGT(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldGT(FieldInReplyTo, v))
+}
+
+// InReplyToGTE applies the GTE predicate on the "in_reply_to" field.
+func InReplyToGTE(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldGTE(FieldInReplyTo, v))
+}
+
+// InReplyToLT applies the LT predicate on the "in_reply_to" field.
+func InReplyToLT(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldLT(FieldInReplyTo, v))
+}
+
+// InReplyToLTE applies the LTE predicate on the "in_reply_to" field.
+func InReplyToLTE(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldLTE(FieldInReplyTo, v))
+}
+
+// InReplyToContains applies the Contains predicate on the "in_reply_to" field.
+func InReplyToContains(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldContains(FieldInReplyTo, v))
+}
+
+// InReplyToHasPrefix applies the HasPrefix predicate on the "in_reply_to" field.
+func InReplyToHasPrefix(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldHasPrefix(FieldInReplyTo, v))
+}
+
+// InReplyToHasSuffix applies the HasSuffix predicate on the "in_reply_to" field.
+func InReplyToHasSuffix(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldHasSuffix(FieldInReplyTo, v))
+}
+
+// InReplyToIsNil applies the IsNil predicate on the "in_reply_to" field.
+func InReplyToIsNil() predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldIsNull(FieldInReplyTo))
+}
+
+// InReplyToNotNil applies the NotNil predicate on the "in_reply_to" field.
+func InReplyToNotNil() predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNotNull(FieldInReplyTo))
+}
+
+// InReplyToEqualFold applies the EqualFold predicate on the "in_reply_to" field.
+func InReplyToEqualFold(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEqualFold(FieldInReplyTo, v))
+}
+
+// InReplyToContainsFold applies the ContainsFold predicate on the "in_reply_to" field.
+func InReplyToContainsFold(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldContainsFold(FieldInReplyTo, v))
+}
+
+// ReferencesIsNil applies the IsNil predicate on the "references" field.
+func ReferencesIsNil() predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldIsNull(FieldReferences))
+}
+
+// ReferencesNotNil applies the NotNil predicate on the "references" field.
+func ReferencesNotNil() predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNotNull(FieldReferences))
+}
+
+// FromAddressEQ applies the EQ predicate on the "from_address" field.
+func FromAddressEQ(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEQ(FieldFromAddress, v))
+}
+
+// FromAddressNEQ applies the NEQ predicate on the "from_address" field.
+func FromAddressNEQ(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNEQ(FieldFromAddress, v))
+}
+
+// FromAddressIn applies the In predicate on the "from_address" field.
+func FromAddressIn(vs ...string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldIn(FieldFromAddress, vs...))
+}
+
+// FromAddressNotIn applies the NotIn predicate on the "from_address" field.
+func FromAddressNotIn(vs ...string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNotIn(FieldFromAddress, vs...))
+}
+
+// FromAddressGT applies the GT predicate on the "from_address" field.
+func FromAddressGT(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldGT(FieldFromAddress, v))
+}
+
+// FromAddressGTE applies the GTE predicate on the "from_address" field.
+func FromAddressGTE(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldGTE(FieldFromAddress, v))
+}
+
+// FromAddressLT applies the LT predicate on the "from_address" field.
+func FromAddressLT(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldLT(FieldFromAddress, v))
+}
+
+// FromAddressLTE applies the LTE predicate on the "from_address" field.
+func FromAddressLTE(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldLTE(FieldFromAddress, v))
+}
+
+// FromAddressContains applies the Contains predicate on the "from_address" field.
+func FromAddressContains(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldContains(FieldFromAddress, v))
+}
+
+// FromAddressHasPrefix applies the HasPrefix predicate on the "from_address" field.
+func FromAddressHasPrefix(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldHasPrefix(FieldFromAddress, v))
+}
+
+// FromAddressHasSuffix applies the HasSuffix predicate on the "from_address" field.
+func FromAddressHasSuffix(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldHasSuffix(FieldFromAddress, v))
+}
+
+// FromAddressIsNil applies the IsNil predicate on the "from_address" field.
+func FromAddressIsNil() predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldIsNull(FieldFromAddress))
+}
+
+// FromAddressNotNil applies the NotNil predicate on the "from_address" field.
+func FromAddressNotNil() predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNotNull(FieldFromAddress))
+}
+
+// FromAddressEqualFold applies the EqualFold predicate on the "from_address" field.
+func FromAddressEqualFold(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEqualFold(FieldFromAddress, v))
+}
+
+// FromAddressContainsFold applies the ContainsFold predicate on the "from_address" field.
+func FromAddressContainsFold(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldContainsFold(FieldFromAddress, v))
+}
+
+// FromNameEQ applies the EQ predicate on the "from_name" field.
+func FromNameEQ(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEQ(FieldFromName, v))
+}
+
+// FromNameNEQ applies the NEQ predicate on the "from_name" field.
+func FromNameNEQ(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNEQ(FieldFromName, v))
+}
+
+// FromNameIn applies the In predicate on the "from_name" field.
+func FromNameIn(vs ...string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldIn(FieldFromName, vs...))
+}
+
+// FromNameNotIn applies the NotIn predicate on the "from_name" field.
+func FromNameNotIn(vs ...string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNotIn(FieldFromName, vs...))
+}
+
+// FromNameGT applies the GT predicate on the "from_name" field.
+func FromNameGT(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldGT(FieldFromName, v))
+}
+
+// FromNameGTE applies the GTE predicate on the "from_name" field.
+func FromNameGTE(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldGTE(FieldFromName, v))
+}
+
+// FromNameLT applies the LT predicate on the "from_name" field.
+func FromNameLT(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldLT(FieldFromName, v))
+}
+
+// FromNameLTE applies the LTE predicate on the "from_name" field.
+func FromNameLTE(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldLTE(FieldFromName, v))
+}
+
+// FromNameContains applies the Contains predicate on the "from_name" field.
+func FromNameContains(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldContains(FieldFromName, v))
+}
+
+// FromNameHasPrefix applies the HasPrefix predicate on the "from_name" field.
+func FromNameHasPrefix(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldHasPrefix(FieldFromName, v))
+}
+
+// FromNameHasSuffix applies the HasSuffix predicate on the "from_name" field.
+func FromNameHasSuffix(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldHasSuffix(FieldFromName, v))
+}
+
+// FromNameIsNil applies the IsNil predicate on the "from_name" field.
+func FromNameIsNil() predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldIsNull(FieldFromName))
+}
+
+// FromNameNotNil applies the NotNil predicate on the "from_name" field.
+func FromNameNotNil() predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNotNull(FieldFromName))
+}
+
+// FromNameEqualFold applies the EqualFold predicate on the "from_name" field.
+func FromNameEqualFold(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEqualFold(FieldFromName, v))
+}
+
+// FromNameContainsFold applies the ContainsFold predicate on the "from_name" field.
+func FromNameContainsFold(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldContainsFold(FieldFromName, v))
+}
+
+// SubjectEQ applies the EQ predicate on the "subject" field.
+func SubjectEQ(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEQ(FieldSubject, v))
+}
+
+// SubjectNEQ applies the NEQ predicate on the "subject" field.
+func SubjectNEQ(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNEQ(FieldSubject, v))
+}
+
+// SubjectIn applies the In predicate on the "subject" field.
+func SubjectIn(vs ...string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldIn(FieldSubject, vs...))
+}
+
+// SubjectNotIn applies the NotIn predicate on the "subject" field.
+func SubjectNotIn(vs ...string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNotIn(FieldSubject, vs...))
+}
+
+// SubjectGT applies the GT predicate on the "subject" field.
+func SubjectGT(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldGT(FieldSubject, v))
+}
+
+// SubjectGTE applies the GTE predicate on the "subject" field.
+func SubjectGTE(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldGTE(FieldSubject, v))
+}
+
+// SubjectLT applies the LT predicate on the "subject" field.
+func SubjectLT(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldLT(FieldSubject, v))
+}
+
+// SubjectLTE applies the LTE predicate on the "subject" field.
+func SubjectLTE(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldLTE(FieldSubject, v))
+}
+
+// SubjectContains applies the Contains predicate on the "subject" field.
+func SubjectContains(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldContains(FieldSubject, v))
+}
+
+// SubjectHasPrefix applies the HasPrefix predicate on the "subject" field.
+func SubjectHasPrefix(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldHasPrefix(FieldSubject, v))
+}
+
+// SubjectHasSuffix applies the HasSuffix predicate on the "subject" field.
+func SubjectHasSuffix(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldHasSuffix(FieldSubject, v))
+}
+
+// SubjectIsNil applies the IsNil predicate on the "subject" field.
+func SubjectIsNil() predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldIsNull(FieldSubject))
+}
+
+// SubjectNotNil applies the NotNil predicate on the "subject" field.
+func SubjectNotNil() predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNotNull(FieldSubject))
+}
+
+// SubjectEqualFold applies the EqualFold predicate on the "subject" field.
+func SubjectEqualFold(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEqualFold(FieldSubject, v))
+}
+
+// SubjectContainsFold applies the ContainsFold predicate on the "subject" field.
+func SubjectContainsFold(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldContainsFold(FieldSubject, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldContainsFold(FieldReason, v))
+}
+
+// TicketIDEQ applies the EQ predicate on the "ticket_id" field.
+func TicketIDEQ(v int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEQ(FieldTicketID, v))
+}
+
+// TicketIDNEQ applies the NEQ predicate on the "ticket_id" field.
+func TicketIDNEQ(v int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNEQ(FieldTicketID, v))
+}
+
+// TicketIDIn applies the In predicate on the "ticket_id" field.
+func TicketIDIn(vs ...int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldIn(FieldTicketID, vs...))
+}
+
+// TicketIDNotIn applies the NotIn predicate on the "ticket_id" field.
+func TicketIDNotIn(vs ...int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNotIn(FieldTicketID, vs...))
+}
+
+// TicketIDGT applies the GT predicate on the "ticket_id" field.
+func TicketIDGT(v int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldGT(FieldTicketID, v))
+}
+
+// TicketIDGTE applies the GTE predicate on the "ticket_id" field.
+func TicketIDGTE(v int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldGTE(FieldTicketID, v))
+}
+
+// TicketIDLT applies the LT predicate on the "ticket_id" field.
+func TicketIDLT(v int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldLT(FieldTicketID, v))
+}
+
+// TicketIDLTE applies the LTE predicate on the "ticket_id" field.
+func TicketIDLTE(v int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldLTE(FieldTicketID, v))
+}
+
+// TicketIDIsNil applies the IsNil predicate on the "ticket_id" field.
+func TicketIDIsNil() predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldIsNull(FieldTicketID))
+}
+
+// TicketIDNotNil applies the NotNil predicate on the "ticket_id" field.
+func TicketIDNotNil() predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNotNull(FieldTicketID))
+}
+
+// CommentIDEQ applies the EQ predicate on the "comment_id" field.
+func CommentIDEQ(v int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEQ(FieldCommentID, v))
+}
+
+// CommentIDNEQ applies the NEQ predicate on the "comment_id" field.
+func CommentIDNEQ(v int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNEQ(FieldCommentID, v))
+}
+
+// CommentIDIn applies the In predicate on the "comment_id" field.
+func CommentIDIn(vs ...int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldIn(FieldCommentID, vs...))
+}
+
+// CommentIDNotIn applies the NotIn predicate on the "comment_id" field.
+func CommentIDNotIn(vs ...int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNotIn(FieldCommentID, vs...))
+}
+
+// CommentIDGT applies the GT predicate on the "comment_id" field.
+func CommentIDGT(v int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldGT(FieldCommentID, v))
+}
+
+// CommentIDGTE applies the GTE predicate on the "comment_id" field.
+func CommentIDGTE(v int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldGTE(FieldCommentID, v))
+}
+
+// CommentIDLT applies the LT predicate on the "comment_id" field.
+func CommentIDLT(v int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldLT(FieldCommentID, v))
+}
+
+// CommentIDLTE applies the LTE predicate on the "comment_id" field.
+func CommentIDLTE(v int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldLTE(FieldCommentID, v))
+}
+
+// CommentIDIsNil applies the IsNil predicate on the "comment_id" field.
+func CommentIDIsNil() predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldIsNull(FieldCommentID))
+}
+
+// CommentIDNotNil applies the NotNil predicate on the "comment_id" field.
+func CommentIDNotNil() predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNotNull(FieldCommentID))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNotNull(FieldUserID))
+}
+
+// AttachmentCountEQ applies the EQ predicate on the "attachment_count" field.
+func AttachmentCountEQ(v int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEQ(FieldAttachmentCount, v))
+}
+
+// AttachmentCountNEQ applies the NEQ predicate on the "attachment_count" field.
+func AttachmentCountNEQ(v int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNEQ(FieldAttachmentCount, v))
+}
+
+// AttachmentCountIn applies the In predicate on the "attachment_count" field.
+func AttachmentCountIn(vs ...int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldIn(FieldAttachmentCount, vs...))
+}
+
+// AttachmentCountNotIn applies the NotIn predicate on the "attachment_count" field.
+func AttachmentCountNotIn(vs ...int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNotIn(FieldAttachmentCount, vs...))
+}
+
+// AttachmentCountGT applies the GT predicate on the "attachment_count" field.
+func AttachmentCountGT(v int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldGT(FieldAttachmentCount, v))
+}
+
+// AttachmentCountGTE applies the GTE predicate on the "attachment_count" field.
+func AttachmentCountGTE(v int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldGTE(FieldAttachmentCount, v))
+}
+
+// AttachmentCountLT applies the LT predicate on the "attachment_count" field.
+func AttachmentCountLT(v int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldLT(FieldAttachmentCount, v))
+}
+
+// AttachmentCountLTE applies the LTE predicate on the "attachment_count" field.
+func AttachmentCountLTE(v int) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldLTE(FieldAttachmentCount, v))
+}
+
+// ReceivedAtEQ applies the EQ predicate on the "received_at" field.
+func ReceivedAtEQ(v time.Time) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEQ(FieldReceivedAt, v))
+}
+
+// ReceivedAtNEQ applies the NEQ predicate on the "received_at" field.
+func ReceivedAtNEQ(v time.Time) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNEQ(FieldReceivedAt, v))
+}
+
+// ReceivedAtIn applies the In predicate on the "received_at" field.
+func ReceivedAtIn(vs ...time.Time) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldIn(FieldReceivedAt, vs...))
+}
+
+// ReceivedAtNotIn applies the NotIn predicate on the "received_at" field.
+func ReceivedAtNotIn(vs ...time.Time) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNotIn(FieldReceivedAt, vs...))
+}
+
+// ReceivedAtGT applies the GT predicate on the "received_at" field.
+func ReceivedAtGT(v time.Time) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldGT(FieldReceivedAt, v))
+}
+
+// ReceivedAtGTE applies the GTE predicate on the "received_at" field.
+func ReceivedAtGTE(v time.Time) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldGTE(FieldReceivedAt, v))
+}
+
+// ReceivedAtLT applies the LT predicate on the "received_at" field.
+func ReceivedAtLT(v time.Time) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldLT(FieldReceivedAt, v))
+}
+
+// ReceivedAtLTE applies the LTE predicate on the "received_at" field.
+func ReceivedAtLTE(v time.Time) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldLTE(FieldReceivedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InboundEmail) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InboundEmail) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InboundEmail) predicate.InboundEmail {
+	return predicate.InboundEmail(sql.NotPredicates(p))
+}
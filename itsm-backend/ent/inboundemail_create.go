@@ -0,0 +1,490 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/inboundemail"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InboundEmailCreate is the builder for creating a InboundEmail entity.
+type InboundEmailCreate struct {
+	config
+	mutation *InboundEmailMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *InboundEmailCreate) SetTenantID(v int) *InboundEmailCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetMailbox sets the "mailbox" field.
+func (_c *InboundEmailCreate) SetMailbox(v string) *InboundEmailCreate {
+	_c.mutation.SetMailbox(v)
+	return _c
+}
+
+// SetNillableMailbox sets the "mailbox" field if the given value is not nil.
+func (_c *InboundEmailCreate) SetNillableMailbox(v *string) *InboundEmailCreate {
+	if v != nil {
+		_c.SetMailbox(*v)
+	}
+	return _c
+}
+
+// SetMessageID sets the "message_id" field.
+func (_c *InboundEmailCreate) SetMessageID(v string) *InboundEmailCreate {
+	_c.mutation.SetMessageID(v)
+	return _c
+}
+
+// SetInReplyTo sets the "in_reply_to" field.
+func (_c *InboundEmailCreate) SetInReplyTo(v string) *InboundEmailCreate {
+	_c.mutation.SetInReplyTo(v)
+	return _c
+}
+
+// SetNillableInReplyTo sets the "in_reply_to" field if the given value is not nil.
+func (_c *InboundEmailCreate) SetNillableInReplyTo(v *string) *InboundEmailCreate {
+	if v != nil {
+		_c.SetInReplyTo(*v)
+	}
+	return _c
+}
+
+// SetReferences sets the "references" field.
+func (_c *InboundEmailCreate) SetReferences(v []string) *InboundEmailCreate {
+	_c.mutation.SetReferences(v)
+	return _c
+}
+
+// SetFromAddress sets the "from_address" field.
+func (_c *InboundEmailCreate) SetFromAddress(v string) *InboundEmailCreate {
+	_c.mutation.SetFromAddress(v)
+	return _c
+}
+
+// SetNillableFromAddress sets the "from_address" field if the given value is not nil.
+func (_c *InboundEmailCreate) SetNillableFromAddress(v *string) *InboundEmailCreate {
+	if v != nil {
+		_c.SetFromAddress(*v)
+	}
+	return _c
+}
+
+// SetFromName sets the "from_name" field.
+func (_c *InboundEmailCreate) SetFromName(v string) *InboundEmailCreate {
+	_c.mutation.SetFromName(v)
+	return _c
+}
+
+// SetNillableFromName sets the "from_name" field if the given value is not nil.
+func (_c *InboundEmailCreate) SetNillableFromName(v *string) *InboundEmailCreate {
+	if v != nil {
+		_c.SetFromName(*v)
+	}
+	return _c
+}
+
+// SetSubject sets the "subject" field.
+func (_c *InboundEmailCreate) SetSubject(v string) *InboundEmailCreate {
+	_c.mutation.SetSubject(v)
+	return _c
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_c *InboundEmailCreate) SetNillableSubject(v *string) *InboundEmailCreate {
+	if v != nil {
+		_c.SetSubject(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *InboundEmailCreate) SetStatus(v inboundemail.Status) *InboundEmailCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *InboundEmailCreate) SetNillableStatus(v *inboundemail.Status) *InboundEmailCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *InboundEmailCreate) SetReason(v string) *InboundEmailCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *InboundEmailCreate) SetNillableReason(v *string) *InboundEmailCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetTicketID sets the "ticket_id" field.
+func (_c *InboundEmailCreate) SetTicketID(v int) *InboundEmailCreate {
+	_c.mutation.SetTicketID(v)
+	return _c
+}
+
+// SetNillableTicketID sets the "ticket_id" field if the given value is not nil.
+func (_c *InboundEmailCreate) SetNillableTicketID(v *int) *InboundEmailCreate {
+	if v != nil {
+		_c.SetTicketID(*v)
+	}
+	return _c
+}
+
+// SetCommentID sets the "comment_id" field.
+func (_c *InboundEmailCreate) SetCommentID(v int) *InboundEmailCreate {
+	_c.mutation.SetCommentID(v)
+	return _c
+}
+
+// SetNillableCommentID sets the "comment_id" field if the given value is not nil.
+func (_c *InboundEmailCreate) SetNillableCommentID(v *int) *InboundEmailCreate {
+	if v != nil {
+		_c.SetCommentID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *InboundEmailCreate) SetUserID(v int) *InboundEmailCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *InboundEmailCreate) SetNillableUserID(v *int) *InboundEmailCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetAttachmentCount sets the "attachment_count" field.
+func (_c *InboundEmailCreate) SetAttachmentCount(v int) *InboundEmailCreate {
+	_c.mutation.SetAttachmentCount(v)
+	return _c
+}
+
+// SetNillableAttachmentCount sets the "attachment_count" field if the given value is not nil.
+func (_c *InboundEmailCreate) SetNillableAttachmentCount(v *int) *InboundEmailCreate {
+	if v != nil {
+		_c.SetAttachmentCount(*v)
+	}
+	return _c
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (_c *InboundEmailCreate) SetReceivedAt(v time.Time) *InboundEmailCreate {
+	_c.mutation.SetReceivedAt(v)
+	return _c
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (_c *InboundEmailCreate) SetNillableReceivedAt(v *time.Time) *InboundEmailCreate {
+	if v != nil {
+		_c.SetReceivedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *InboundEmailCreate) SetCreatedAt(v time.Time) *InboundEmailCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *InboundEmailCreate) SetNillableCreatedAt(v *time.Time) *InboundEmailCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the InboundEmailMutation object of the builder.
+func (_c *InboundEmailCreate) Mutation() *InboundEmailMutation {
+	return _c.mutation
+}
+
+// Save creates the InboundEmail in the database.
+func (_c *InboundEmailCreate) Save(ctx context.Context) (*InboundEmail, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *InboundEmailCreate) SaveX(ctx context.Context) *InboundEmail {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InboundEmailCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InboundEmailCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *InboundEmailCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := inboundemail.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.AttachmentCount(); !ok {
+		v := inboundemail.DefaultAttachmentCount
+		_c.mutation.SetAttachmentCount(v)
+	}
+	if _, ok := _c.mutation.ReceivedAt(); !ok {
+		v := inboundemail.DefaultReceivedAt()
+		_c.mutation.SetReceivedAt(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := inboundemail.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *InboundEmailCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "InboundEmail.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := inboundemail.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "InboundEmail.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`ent: missing required field "InboundEmail.message_id"`)}
+	}
+	if v, ok := _c.mutation.MessageID(); ok {
+		if err := inboundemail.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`ent: validator failed for field "InboundEmail.message_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "InboundEmail.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := inboundemail.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "InboundEmail.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AttachmentCount(); !ok {
+		return &ValidationError{Name: "attachment_count", err: errors.New(`ent: missing required field "InboundEmail.attachment_count"`)}
+	}
+	if _, ok := _c.mutation.ReceivedAt(); !ok {
+		return &ValidationError{Name: "received_at", err: errors.New(`ent: missing required field "InboundEmail.received_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "InboundEmail.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *InboundEmailCreate) sqlSave(ctx context.Context) (*InboundEmail, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *InboundEmailCreate) createSpec() (*InboundEmail, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InboundEmail{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(inboundemail.Table, sqlgraph.NewFieldSpec(inboundemail.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(inboundemail.FieldTenantID, field.TypeInt, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.Mailbox(); ok {
+		_spec.SetField(inboundemail.FieldMailbox, field.TypeString, value)
+		_node.Mailbox = value
+	}
+	if value, ok := _c.mutation.MessageID(); ok {
+		_spec.SetField(inboundemail.FieldMessageID, field.TypeString, value)
+		_node.MessageID = value
+	}
+	if value, ok := _c.mutation.InReplyTo(); ok {
+		_spec.SetField(inboundemail.FieldInReplyTo, field.TypeString, value)
+		_node.InReplyTo = value
+	}
+	if value, ok := _c.mutation.References(); ok {
+		_spec.SetField(inboundemail.FieldReferences, field.TypeJSON, value)
+		_node.References = value
+	}
+	if value, ok := _c.mutation.FromAddress(); ok {
+		_spec.SetField(inboundemail.FieldFromAddress, field.TypeString, value)
+		_node.FromAddress = value
+	}
+	if value, ok := _c.mutation.FromName(); ok {
+		_spec.SetField(inboundemail.FieldFromName, field.TypeString, value)
+		_node.FromName = value
+	}
+	if value, ok := _c.mutation.Subject(); ok {
+		_spec.SetField(inboundemail.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(inboundemail.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(inboundemail.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.TicketID(); ok {
+		_spec.SetField(inboundemail.FieldTicketID, field.TypeInt, value)
+		_node.TicketID = &value
+	}
+	if value, ok := _c.mutation.CommentID(); ok {
+		_spec.SetField(inboundemail.FieldCommentID, field.TypeInt, value)
+		_node.CommentID = &value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(inboundemail.FieldUserID, field.TypeInt, value)
+		_node.UserID = &value
+	}
+	if value, ok := _c.mutation.AttachmentCount(); ok {
+		_spec.SetField(inboundemail.FieldAttachmentCount, field.TypeInt, value)
+		_node.AttachmentCount = value
+	}
+	if value, ok := _c.mutation.ReceivedAt(); ok {
+		_spec.SetField(inboundemail.FieldReceivedAt, field.TypeTime, value)
+		_node.ReceivedAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(inboundemail.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// InboundEmailCreateBulk is the builder for creating many InboundEmail entities in bulk.
+type InboundEmailCreateBulk struct {
+	config
+	err      error
+	builders []*InboundEmailCreate
+}
+
+// Save creates the InboundEmail entities in the database.
+func (_c *InboundEmailCreateBulk) Save(ctx context.Context) ([]*InboundEmail, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*InboundEmail, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InboundEmailMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *InboundEmailCreateBulk) SaveX(ctx context.Context) []*InboundEmail {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InboundEmailCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InboundEmailCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
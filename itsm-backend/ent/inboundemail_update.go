@@ -0,0 +1,1053 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/inboundemail"
+	"itsm-backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// InboundEmailUpdate is the builder for updating InboundEmail entities.
+type InboundEmailUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InboundEmailMutation
+}
+
+// Where appends a list predicates to the InboundEmailUpdate builder.
+func (_u *InboundEmailUpdate) Where(ps ...predicate.InboundEmail) *InboundEmailUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *InboundEmailUpdate) SetTenantID(v int) *InboundEmailUpdate {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *InboundEmailUpdate) SetNillableTenantID(v *int) *InboundEmailUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *InboundEmailUpdate) AddTenantID(v int) *InboundEmailUpdate {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetMailbox sets the "mailbox" field.
+func (_u *InboundEmailUpdate) SetMailbox(v string) *InboundEmailUpdate {
+	_u.mutation.SetMailbox(v)
+	return _u
+}
+
+// SetNillableMailbox sets the "mailbox" field if the given value is not nil.
+func (_u *InboundEmailUpdate) SetNillableMailbox(v *string) *InboundEmailUpdate {
+	if v != nil {
+		_u.SetMailbox(*v)
+	}
+	return _u
+}
+
+// ClearMailbox clears the value of the "mailbox" field.
+func (_u *InboundEmailUpdate) ClearMailbox() *InboundEmailUpdate {
+	_u.mutation.ClearMailbox()
+	return _u
+}
+
+// SetMessageID sets the "message_id" field.
+func (_u *InboundEmailUpdate) SetMessageID(v string) *InboundEmailUpdate {
+	_u.mutation.SetMessageID(v)
+	return _u
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_u *InboundEmailUpdate) SetNillableMessageID(v *string) *InboundEmailUpdate {
+	if v != nil {
+		_u.SetMessageID(*v)
+	}
+	return _u
+}
+
+// SetInReplyTo sets the "in_reply_to" field.
+func (_u *InboundEmailUpdate) SetInReplyTo(v string) *InboundEmailUpdate {
+	_u.mutation.SetInReplyTo(v)
+	return _u
+}
+
+// SetNillableInReplyTo sets the "in_reply_to" field if the given value is not nil.
+func (_u *InboundEmailUpdate) SetNillableInReplyTo(v *string) *InboundEmailUpdate {
+	if v != nil {
+		_u.SetInReplyTo(*v)
+	}
+	return _u
+}
+
+// ClearInReplyTo clears the value of the "in_reply_to" field.
+func (_u *InboundEmailUpdate) ClearInReplyTo() *InboundEmailUpdate {
+	_u.mutation.ClearInReplyTo()
+	return _u
+}
+
+// SetReferences sets the "references" field.
+func (_u *InboundEmailUpdate) SetReferences(v []string) *InboundEmailUpdate {
+	_u.mutation.SetReferences(v)
+	return _u
+}
+
+// AppendReferences appends value to the "references" field.
+func (_u *InboundEmailUpdate) AppendReferences(v []string) *InboundEmailUpdate {
+	_u.mutation.AppendReferences(v)
+	return _u
+}
+
+// ClearReferences clears the value of the "references" field.
+func (_u *InboundEmailUpdate) ClearReferences() *InboundEmailUpdate {
+	_u.mutation.ClearReferences()
+	return _u
+}
+
+// SetFromAddress sets the "from_address" field.
+func (_u *InboundEmailUpdate) SetFromAddress(v string) *InboundEmailUpdate {
+	_u.mutation.SetFromAddress(v)
+	return _u
+}
+
+// SetNillableFromAddress sets the "from_address" field if the given value is not nil.
+func (_u *InboundEmailUpdate) SetNillableFromAddress(v *string) *InboundEmailUpdate {
+	if v != nil {
+		_u.SetFromAddress(*v)
+	}
+	return _u
+}
+
+// ClearFromAddress clears the value of the "from_address" field.
+func (_u *InboundEmailUpdate) ClearFromAddress() *InboundEmailUpdate {
+	_u.mutation.ClearFromAddress()
+	return _u
+}
+
+// SetFromName sets the "from_name" field.
+func (_u *InboundEmailUpdate) SetFromName(v string) *InboundEmailUpdate {
+	_u.mutation.SetFromName(v)
+	return _u
+}
+
+// SetNillableFromName sets the "from_name" field if the given value is not nil.
+func (_u *InboundEmailUpdate) SetNillableFromName(v *string) *InboundEmailUpdate {
+	if v != nil {
+		_u.SetFromName(*v)
+	}
+	return _u
+}
+
+// ClearFromName clears the value of the "from_name" field.
+func (_u *InboundEmailUpdate) ClearFromName() *InboundEmailUpdate {
+	_u.mutation.ClearFromName()
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *InboundEmailUpdate) SetSubject(v string) *InboundEmailUpdate {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *InboundEmailUpdate) SetNillableSubject(v *string) *InboundEmailUpdate {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// ClearSubject clears the value of the "subject" field.
+func (_u *InboundEmailUpdate) ClearSubject() *InboundEmailUpdate {
+	_u.mutation.ClearSubject()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *InboundEmailUpdate) SetStatus(v inboundemail.Status) *InboundEmailUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *InboundEmailUpdate) SetNillableStatus(v *inboundemail.Status) *InboundEmailUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *InboundEmailUpdate) SetReason(v string) *InboundEmailUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *InboundEmailUpdate) SetNillableReason(v *string) *InboundEmailUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *InboundEmailUpdate) ClearReason() *InboundEmailUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetTicketID sets the "ticket_id" field.
+func (_u *InboundEmailUpdate) SetTicketID(v int) *InboundEmailUpdate {
+	_u.mutation.ResetTicketID()
+	_u.mutation.SetTicketID(v)
+	return _u
+}
+
+// SetNillableTicketID sets the "ticket_id" field if the given value is not nil.
+func (_u *InboundEmailUpdate) SetNillableTicketID(v *int) *InboundEmailUpdate {
+	if v != nil {
+		_u.SetTicketID(*v)
+	}
+	return _u
+}
+
+// AddTicketID adds value to the "ticket_id" field.
+func (_u *InboundEmailUpdate) AddTicketID(v int) *InboundEmailUpdate {
+	_u.mutation.AddTicketID(v)
+	return _u
+}
+
+// ClearTicketID clears the value of the "ticket_id" field.
+func (_u *InboundEmailUpdate) ClearTicketID() *InboundEmailUpdate {
+	_u.mutation.ClearTicketID()
+	return _u
+}
+
+// SetCommentID sets the "comment_id" field.
+func (_u *InboundEmailUpdate) SetCommentID(v int) *InboundEmailUpdate {
+	_u.mutation.ResetCommentID()
+	_u.mutation.SetCommentID(v)
+	return _u
+}
+
+// SetNillableCommentID sets the "comment_id" field if the given value is not nil.
+func (_u *InboundEmailUpdate) SetNillableCommentID(v *int) *InboundEmailUpdate {
+	if v != nil {
+		_u.SetCommentID(*v)
+	}
+	return _u
+}
+
+// AddCommentID adds value to the "comment_id" field.
+func (_u *InboundEmailUpdate) AddCommentID(v int) *InboundEmailUpdate {
+	_u.mutation.AddCommentID(v)
+	return _u
+}
+
+// ClearCommentID clears the value of the "comment_id" field.
+func (_u *InboundEmailUpdate) ClearCommentID() *InboundEmailUpdate {
+	_u.mutation.ClearCommentID()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *InboundEmailUpdate) SetUserID(v int) *InboundEmailUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *InboundEmailUpdate) SetNillableUserID(v *int) *InboundEmailUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *InboundEmailUpdate) AddUserID(v int) *InboundEmailUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *InboundEmailUpdate) ClearUserID() *InboundEmailUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetAttachmentCount sets the "attachment_count" field.
+func (_u *InboundEmailUpdate) SetAttachmentCount(v int) *InboundEmailUpdate {
+	_u.mutation.ResetAttachmentCount()
+	_u.mutation.SetAttachmentCount(v)
+	return _u
+}
+
+// SetNillableAttachmentCount sets the "attachment_count" field if the given value is not nil.
+func (_u *InboundEmailUpdate) SetNillableAttachmentCount(v *int) *InboundEmailUpdate {
+	if v != nil {
+		_u.SetAttachmentCount(*v)
+	}
+	return _u
+}
+
+// AddAttachmentCount adds value to the "attachment_count" field.
+func (_u *InboundEmailUpdate) AddAttachmentCount(v int) *InboundEmailUpdate {
+	_u.mutation.AddAttachmentCount(v)
+	return _u
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (_u *InboundEmailUpdate) SetReceivedAt(v time.Time) *InboundEmailUpdate {
+	_u.mutation.SetReceivedAt(v)
+	return _u
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (_u *InboundEmailUpdate) SetNillableReceivedAt(v *time.Time) *InboundEmailUpdate {
+	if v != nil {
+		_u.SetReceivedAt(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *InboundEmailUpdate) SetCreatedAt(v time.Time) *InboundEmailUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *InboundEmailUpdate) SetNillableCreatedAt(v *time.Time) *InboundEmailUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the InboundEmailMutation object of the builder.
+func (_u *InboundEmailUpdate) Mutation() *InboundEmailMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *InboundEmailUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InboundEmailUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *InboundEmailUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InboundEmailUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InboundEmailUpdate) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := inboundemail.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "InboundEmail.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MessageID(); ok {
+		if err := inboundemail.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`ent: validator failed for field "InboundEmail.message_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := inboundemail.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "InboundEmail.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *InboundEmailUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(inboundemail.Table, inboundemail.Columns, sqlgraph.NewFieldSpec(inboundemail.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(inboundemail.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(inboundemail.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Mailbox(); ok {
+		_spec.SetField(inboundemail.FieldMailbox, field.TypeString, value)
+	}
+	if _u.mutation.MailboxCleared() {
+		_spec.ClearField(inboundemail.FieldMailbox, field.TypeString)
+	}
+	if value, ok := _u.mutation.MessageID(); ok {
+		_spec.SetField(inboundemail.FieldMessageID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InReplyTo(); ok {
+		_spec.SetField(inboundemail.FieldInReplyTo, field.TypeString, value)
+	}
+	if _u.mutation.InReplyToCleared() {
+		_spec.ClearField(inboundemail.FieldInReplyTo, field.TypeString)
+	}
+	if value, ok := _u.mutation.References(); ok {
+		_spec.SetField(inboundemail.FieldReferences, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedReferences(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, inboundemail.FieldReferences, value)
+		})
+	}
+	if _u.mutation.ReferencesCleared() {
+		_spec.ClearField(inboundemail.FieldReferences, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.FromAddress(); ok {
+		_spec.SetField(inboundemail.FieldFromAddress, field.TypeString, value)
+	}
+	if _u.mutation.FromAddressCleared() {
+		_spec.ClearField(inboundemail.FieldFromAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.FromName(); ok {
+		_spec.SetField(inboundemail.FieldFromName, field.TypeString, value)
+	}
+	if _u.mutation.FromNameCleared() {
+		_spec.ClearField(inboundemail.FieldFromName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(inboundemail.FieldSubject, field.TypeString, value)
+	}
+	if _u.mutation.SubjectCleared() {
+		_spec.ClearField(inboundemail.FieldSubject, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(inboundemail.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(inboundemail.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(inboundemail.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.TicketID(); ok {
+		_spec.SetField(inboundemail.FieldTicketID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTicketID(); ok {
+		_spec.AddField(inboundemail.FieldTicketID, field.TypeInt, value)
+	}
+	if _u.mutation.TicketIDCleared() {
+		_spec.ClearField(inboundemail.FieldTicketID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CommentID(); ok {
+		_spec.SetField(inboundemail.FieldCommentID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCommentID(); ok {
+		_spec.AddField(inboundemail.FieldCommentID, field.TypeInt, value)
+	}
+	if _u.mutation.CommentIDCleared() {
+		_spec.ClearField(inboundemail.FieldCommentID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(inboundemail.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(inboundemail.FieldUserID, field.TypeInt, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(inboundemail.FieldUserID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.AttachmentCount(); ok {
+		_spec.SetField(inboundemail.FieldAttachmentCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttachmentCount(); ok {
+		_spec.AddField(inboundemail.FieldAttachmentCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ReceivedAt(); ok {
+		_spec.SetField(inboundemail.FieldReceivedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(inboundemail.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{inboundemail.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// InboundEmailUpdateOne is the builder for updating a single InboundEmail entity.
+type InboundEmailUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InboundEmailMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *InboundEmailUpdateOne) SetTenantID(v int) *InboundEmailUpdateOne {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *InboundEmailUpdateOne) SetNillableTenantID(v *int) *InboundEmailUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *InboundEmailUpdateOne) AddTenantID(v int) *InboundEmailUpdateOne {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetMailbox sets the "mailbox" field.
+func (_u *InboundEmailUpdateOne) SetMailbox(v string) *InboundEmailUpdateOne {
+	_u.mutation.SetMailbox(v)
+	return _u
+}
+
+// SetNillableMailbox sets the "mailbox" field if the given value is not nil.
+func (_u *InboundEmailUpdateOne) SetNillableMailbox(v *string) *InboundEmailUpdateOne {
+	if v != nil {
+		_u.SetMailbox(*v)
+	}
+	return _u
+}
+
+// ClearMailbox clears the value of the "mailbox" field.
+func (_u *InboundEmailUpdateOne) ClearMailbox() *InboundEmailUpdateOne {
+	_u.mutation.ClearMailbox()
+	return _u
+}
+
+// SetMessageID sets the "message_id" field.
+func (_u *InboundEmailUpdateOne) SetMessageID(v string) *InboundEmailUpdateOne {
+	_u.mutation.SetMessageID(v)
+	return _u
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_u *InboundEmailUpdateOne) SetNillableMessageID(v *string) *InboundEmailUpdateOne {
+	if v != nil {
+		_u.SetMessageID(*v)
+	}
+	return _u
+}
+
+// SetInReplyTo sets the "in_reply_to" field.
+func (_u *InboundEmailUpdateOne) SetInReplyTo(v string) *InboundEmailUpdateOne {
+	_u.mutation.SetInReplyTo(v)
+	return _u
+}
+
+// SetNillableInReplyTo sets the "in_reply_to" field if the given value is not nil.
+func (_u *InboundEmailUpdateOne) SetNillableInReplyTo(v *string) *InboundEmailUpdateOne {
+	if v != nil {
+		_u.SetInReplyTo(*v)
+	}
+	return _u
+}
+
+// ClearInReplyTo clears the value of the "in_reply_to" field.
+func (_u *InboundEmailUpdateOne) ClearInReplyTo() *InboundEmailUpdateOne {
+	_u.mutation.ClearInReplyTo()
+	return _u
+}
+
+// SetReferences sets the "references" field.
+func (_u *InboundEmailUpdateOne) SetReferences(v []string) *InboundEmailUpdateOne {
+	_u.mutation.SetReferences(v)
+	return _u
+}
+
+// AppendReferences appends value to the "references" field.
+func (_u *InboundEmailUpdateOne) AppendReferences(v []string) *InboundEmailUpdateOne {
+	_u.mutation.AppendReferences(v)
+	return _u
+}
+
+// ClearReferences clears the value of the "references" field.
+func (_u *InboundEmailUpdateOne) ClearReferences() *InboundEmailUpdateOne {
+	_u.mutation.ClearReferences()
+	return _u
+}
+
+// SetFromAddress sets the "from_address" field.
+func (_u *InboundEmailUpdateOne) SetFromAddress(v string) *InboundEmailUpdateOne {
+	_u.mutation.SetFromAddress(v)
+	return _u
+}
+
+// SetNillableFromAddress sets the "from_address" field if the given value is not nil.
+func (_u *InboundEmailUpdateOne) SetNillableFromAddress(v *string) *InboundEmailUpdateOne {
+	if v != nil {
+		_u.SetFromAddress(*v)
+	}
+	return _u
+}
+
+// ClearFromAddress clears the value of the "from_address" field.
+func (_u *InboundEmailUpdateOne) ClearFromAddress() *InboundEmailUpdateOne {
+	_u.mutation.ClearFromAddress()
+	return _u
+}
+
+// SetFromName sets the "from_name" field.
+func (_u *InboundEmailUpdateOne) SetFromName(v string) *InboundEmailUpdateOne {
+	_u.mutation.SetFromName(v)
+	return _u
+}
+
+// SetNillableFromName sets the "from_name" field if the given value is not nil.
+func (_u *InboundEmailUpdateOne) SetNillableFromName(v *string) *InboundEmailUpdateOne {
+	if v != nil {
+		_u.SetFromName(*v)
+	}
+	return _u
+}
+
+// ClearFromName clears the value of the "from_name" field.
+func (_u *InboundEmailUpdateOne) ClearFromName() *InboundEmailUpdateOne {
+	_u.mutation.ClearFromName()
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *InboundEmailUpdateOne) SetSubject(v string) *InboundEmailUpdateOne {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *InboundEmailUpdateOne) SetNillableSubject(v *string) *InboundEmailUpdateOne {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// ClearSubject clears the value of the "subject" field.
+func (_u *InboundEmailUpdateOne) ClearSubject() *InboundEmailUpdateOne {
+	_u.mutation.ClearSubject()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *InboundEmailUpdateOne) SetStatus(v inboundemail.Status) *InboundEmailUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *InboundEmailUpdateOne) SetNillableStatus(v *inboundemail.Status) *InboundEmailUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *InboundEmailUpdateOne) SetReason(v string) *InboundEmailUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *InboundEmailUpdateOne) SetNillableReason(v *string) *InboundEmailUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *InboundEmailUpdateOne) ClearReason() *InboundEmailUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetTicketID sets the "ticket_id" field.
+func (_u *InboundEmailUpdateOne) SetTicketID(v int) *InboundEmailUpdateOne {
+	_u.mutation.ResetTicketID()
+	_u.mutation.SetTicketID(v)
+	return _u
+}
+
+// SetNillableTicketID sets the "ticket_id" field if the given value is not nil.
+func (_u *InboundEmailUpdateOne) SetNillableTicketID(v *int) *InboundEmailUpdateOne {
+	if v != nil {
+		_u.SetTicketID(*v)
+	}
+	return _u
+}
+
+// AddTicketID adds value to the "ticket_id" field.
+func (_u *InboundEmailUpdateOne) AddTicketID(v int) *InboundEmailUpdateOne {
+	_u.mutation.AddTicketID(v)
+	return _u
+}
+
+// ClearTicketID clears the value of the "ticket_id" field.
+func (_u *InboundEmailUpdateOne) ClearTicketID() *InboundEmailUpdateOne {
+	_u.mutation.ClearTicketID()
+	return _u
+}
+
+// SetCommentID sets the "comment_id" field.
+func (_u *InboundEmailUpdateOne) SetCommentID(v int) *InboundEmailUpdateOne {
+	_u.mutation.ResetCommentID()
+	_u.mutation.SetCommentID(v)
+	return _u
+}
+
+// SetNillableCommentID sets the "comment_id" field if the given value is not nil.
+func (_u *InboundEmailUpdateOne) SetNillableCommentID(v *int) *InboundEmailUpdateOne {
+	if v != nil {
+		_u.SetCommentID(*v)
+	}
+	return _u
+}
+
+// AddCommentID adds value to the "comment_id" field.
+func (_u *InboundEmailUpdateOne) AddCommentID(v int) *InboundEmailUpdateOne {
+	_u.mutation.AddCommentID(v)
+	return _u
+}
+
+// ClearCommentID clears the value of the "comment_id" field.
+func (_u *InboundEmailUpdateOne) ClearCommentID() *InboundEmailUpdateOne {
+	_u.mutation.ClearCommentID()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *InboundEmailUpdateOne) SetUserID(v int) *InboundEmailUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *InboundEmailUpdateOne) SetNillableUserID(v *int) *InboundEmailUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *InboundEmailUpdateOne) AddUserID(v int) *InboundEmailUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *InboundEmailUpdateOne) ClearUserID() *InboundEmailUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetAttachmentCount sets the "attachment_count" field.
+func (_u *InboundEmailUpdateOne) SetAttachmentCount(v int) *InboundEmailUpdateOne {
+	_u.mutation.ResetAttachmentCount()
+	_u.mutation.SetAttachmentCount(v)
+	return _u
+}
+
+// SetNillableAttachmentCount sets the "attachment_count" field if the given value is not nil.
+func (_u *InboundEmailUpdateOne) SetNillableAttachmentCount(v *int) *InboundEmailUpdateOne {
+	if v != nil {
+		_u.SetAttachmentCount(*v)
+	}
+	return _u
+}
+
+// AddAttachmentCount adds value to the "attachment_count" field.
+func (_u *InboundEmailUpdateOne) AddAttachmentCount(v int) *InboundEmailUpdateOne {
+	_u.mutation.AddAttachmentCount(v)
+	return _u
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (_u *InboundEmailUpdateOne) SetReceivedAt(v time.Time) *InboundEmailUpdateOne {
+	_u.mutation.SetReceivedAt(v)
+	return _u
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (_u *InboundEmailUpdateOne) SetNillableReceivedAt(v *time.Time) *InboundEmailUpdateOne {
+	if v != nil {
+		_u.SetReceivedAt(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *InboundEmailUpdateOne) SetCreatedAt(v time.Time) *InboundEmailUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *InboundEmailUpdateOne) SetNillableCreatedAt(v *time.Time) *InboundEmailUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the InboundEmailMutation object of the builder.
+func (_u *InboundEmailUpdateOne) Mutation() *InboundEmailMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the InboundEmailUpdate builder.
+func (_u *InboundEmailUpdateOne) Where(ps ...predicate.InboundEmail) *InboundEmailUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *InboundEmailUpdateOne) Select(field string, fields ...string) *InboundEmailUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated InboundEmail entity.
+func (_u *InboundEmailUpdateOne) Save(ctx context.Context) (*InboundEmail, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InboundEmailUpdateOne) SaveX(ctx context.Context) *InboundEmail {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *InboundEmailUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InboundEmailUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InboundEmailUpdateOne) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := inboundemail.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "InboundEmail.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MessageID(); ok {
+		if err := inboundemail.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`ent: validator failed for field "InboundEmail.message_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := inboundemail.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "InboundEmail.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *InboundEmailUpdateOne) sqlSave(ctx context.Context) (_node *InboundEmail, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(inboundemail.Table, inboundemail.Columns, sqlgraph.NewFieldSpec(inboundemail.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "InboundEmail.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, inboundemail.FieldID)
+		for _, f := range fields {
+			if !inboundemail.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != inboundemail.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(inboundemail.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(inboundemail.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Mailbox(); ok {
+		_spec.SetField(inboundemail.FieldMailbox, field.TypeString, value)
+	}
+	if _u.mutation.MailboxCleared() {
+		_spec.ClearField(inboundemail.FieldMailbox, field.TypeString)
+	}
+	if value, ok := _u.mutation.MessageID(); ok {
+		_spec.SetField(inboundemail.FieldMessageID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InReplyTo(); ok {
+		_spec.SetField(inboundemail.FieldInReplyTo, field.TypeString, value)
+	}
+	if _u.mutation.InReplyToCleared() {
+		_spec.ClearField(inboundemail.FieldInReplyTo, field.TypeString)
+	}
+	if value, ok := _u.mutation.References(); ok {
+		_spec.SetField(inboundemail.FieldReferences, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedReferences(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, inboundemail.FieldReferences, value)
+		})
+	}
+	if _u.mutation.ReferencesCleared() {
+		_spec.ClearField(inboundemail.FieldReferences, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.FromAddress(); ok {
+		_spec.SetField(inboundemail.FieldFromAddress, field.TypeString, value)
+	}
+	if _u.mutation.FromAddressCleared() {
+		_spec.ClearField(inboundemail.FieldFromAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.FromName(); ok {
+		_spec.SetField(inboundemail.FieldFromName, field.TypeString, value)
+	}
+	if _u.mutation.FromNameCleared() {
+		_spec.ClearField(inboundemail.FieldFromName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(inboundemail.FieldSubject, field.TypeString, value)
+	}
+	if _u.mutation.SubjectCleared() {
+		_spec.ClearField(inboundemail.FieldSubject, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(inboundemail.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(inboundemail.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(inboundemail.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.TicketID(); ok {
+		_spec.SetField(inboundemail.FieldTicketID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTicketID(); ok {
+		_spec.AddField(inboundemail.FieldTicketID, field.TypeInt, value)
+	}
+	if _u.mutation.TicketIDCleared() {
+		_spec.ClearField(inboundemail.FieldTicketID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CommentID(); ok {
+		_spec.SetField(inboundemail.FieldCommentID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCommentID(); ok {
+		_spec.AddField(inboundemail.FieldCommentID, field.TypeInt, value)
+	}
+	if _u.mutation.CommentIDCleared() {
+		_spec.ClearField(inboundemail.FieldCommentID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(inboundemail.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(inboundemail.FieldUserID, field.TypeInt, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(inboundemail.FieldUserID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.AttachmentCount(); ok {
+		_spec.SetField(inboundemail.FieldAttachmentCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttachmentCount(); ok {
+		_spec.AddField(inboundemail.FieldAttachmentCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ReceivedAt(); ok {
+		_spec.SetField(inboundemail.FieldReceivedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(inboundemail.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &InboundEmail{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{inboundemail.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
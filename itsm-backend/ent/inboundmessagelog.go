@@ -0,0 +1,280 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"itsm-backend/ent/inboundmessagelog"
+	"itsm-backend/ent/schema"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// InboundMessageLog is the model entity for the InboundMessageLog schema.
+type InboundMessageLog struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 租户ID
+	TenantID int `json:"tenant_id,omitempty"`
+	// 连接器：feishu / slack / teams 等
+	ConnectorName string `json:"connector_name,omitempty"`
+	// IM 平台消息/事件ID
+	MessageID string `json:"message_id,omitempty"`
+	// 去重 key，为空表示该消息未参与去重
+	DedupKey string `json:"dedup_key,omitempty"`
+	// 入站消息类型，如 text / card_action
+	MessageType string `json:"message_type,omitempty"`
+	// 渠道
+	Channel string `json:"channel,omitempty"`
+	// IM 平台发送者ID
+	UserID string `json:"user_id,omitempty"`
+	// IM 会话ID
+	ChatID string `json:"chat_id,omitempty"`
+	// connector.InboundMessage 的 JSON，用于重放
+	Payload string `json:"payload,omitempty"`
+	// 状态：received 处理中，succeeded 全部成功，failed 存在失败的处理器
+	Status inboundmessagelog.Status `json:"status,omitempty"`
+	// 按处理器名记录的执行结果
+	HandlerResults map[string]schema.InboundHandlerResult `json:"handler_results,omitempty"`
+	// 管理端重放次数
+	ReplayCount int `json:"replay_count,omitempty"`
+	// 最近一次重放时间
+	LastReplayedAt *time.Time `json:"last_replayed_at,omitempty"`
+	// 接收时间
+	ReceivedAt time.Time `json:"received_at,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InboundMessageLog) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case inboundmessagelog.FieldHandlerResults:
+			values[i] = new([]byte)
+		case inboundmessagelog.FieldID, inboundmessagelog.FieldTenantID, inboundmessagelog.FieldReplayCount:
+			values[i] = new(sql.NullInt64)
+		case inboundmessagelog.FieldConnectorName, inboundmessagelog.FieldMessageID, inboundmessagelog.FieldDedupKey, inboundmessagelog.FieldMessageType, inboundmessagelog.FieldChannel, inboundmessagelog.FieldUserID, inboundmessagelog.FieldChatID, inboundmessagelog.FieldPayload, inboundmessagelog.FieldStatus:
+			values[i] = new(sql.NullString)
+		case inboundmessagelog.FieldLastReplayedAt, inboundmessagelog.FieldReceivedAt, inboundmessagelog.FieldCreatedAt, inboundmessagelog.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InboundMessageLog fields.
+func (_m *InboundMessageLog) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case inboundmessagelog.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case inboundmessagelog.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = int(value.Int64)
+			}
+		case inboundmessagelog.FieldConnectorName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field connector_name", values[i])
+			} else if value.Valid {
+				_m.ConnectorName = value.String
+			}
+		case inboundmessagelog.FieldMessageID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message_id", values[i])
+			} else if value.Valid {
+				_m.MessageID = value.String
+			}
+		case inboundmessagelog.FieldDedupKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field dedup_key", values[i])
+			} else if value.Valid {
+				_m.DedupKey = value.String
+			}
+		case inboundmessagelog.FieldMessageType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message_type", values[i])
+			} else if value.Valid {
+				_m.MessageType = value.String
+			}
+		case inboundmessagelog.FieldChannel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field channel", values[i])
+			} else if value.Valid {
+				_m.Channel = value.String
+			}
+		case inboundmessagelog.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case inboundmessagelog.FieldChatID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field chat_id", values[i])
+			} else if value.Valid {
+				_m.ChatID = value.String
+			}
+		case inboundmessagelog.FieldPayload:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value.Valid {
+				_m.Payload = value.String
+			}
+		case inboundmessagelog.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = inboundmessagelog.Status(value.String)
+			}
+		case inboundmessagelog.FieldHandlerResults:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field handler_results", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.HandlerResults); err != nil {
+					return fmt.Errorf("unmarshal field handler_results: %w", err)
+				}
+			}
+		case inboundmessagelog.FieldReplayCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field replay_count", values[i])
+			} else if value.Valid {
+				_m.ReplayCount = int(value.Int64)
+			}
+		case inboundmessagelog.FieldLastReplayedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_replayed_at", values[i])
+			} else if value.Valid {
+				_m.LastReplayedAt = new(time.Time)
+				*_m.LastReplayedAt = value.Time
+			}
+		case inboundmessagelog.FieldReceivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field received_at", values[i])
+			} else if value.Valid {
+				_m.ReceivedAt = value.Time
+			}
+		case inboundmessagelog.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case inboundmessagelog.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the InboundMessageLog.
+// This includes values selected through modifiers, order, etc.
+func (_m *InboundMessageLog) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this InboundMessageLog.
+// Note that you need to call InboundMessageLog.Unwrap() before calling this method if this InboundMessageLog
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *InboundMessageLog) Update() *InboundMessageLogUpdateOne {
+	return NewInboundMessageLogClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the InboundMessageLog entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *InboundMessageLog) Unwrap() *InboundMessageLog {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InboundMessageLog is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *InboundMessageLog) String() string {
+	var builder strings.Builder
+	builder.WriteString("InboundMessageLog(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("connector_name=")
+	builder.WriteString(_m.ConnectorName)
+	builder.WriteString(", ")
+	builder.WriteString("message_id=")
+	builder.WriteString(_m.MessageID)
+	builder.WriteString(", ")
+	builder.WriteString("dedup_key=")
+	builder.WriteString(_m.DedupKey)
+	builder.WriteString(", ")
+	builder.WriteString("message_type=")
+	builder.WriteString(_m.MessageType)
+	builder.WriteString(", ")
+	builder.WriteString("channel=")
+	builder.WriteString(_m.Channel)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("chat_id=")
+	builder.WriteString(_m.ChatID)
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(_m.Payload)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("handler_results=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HandlerResults))
+	builder.WriteString(", ")
+	builder.WriteString("replay_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReplayCount))
+	builder.WriteString(", ")
+	if v := _m.LastReplayedAt; v != nil {
+		builder.WriteString("last_replayed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("received_at=")
+	builder.WriteString(_m.ReceivedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InboundMessageLogs is a parsable slice of InboundMessageLog.
+type InboundMessageLogs []*InboundMessageLog
@@ -0,0 +1,211 @@
+// Code generated by ent, DO NOT EDIT.
+
+package inboundmessagelog
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the inboundmessagelog type in the database.
+	Label = "inbound_message_log"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldConnectorName holds the string denoting the connector_name field in the database.
+	FieldConnectorName = "connector_name"
+	// FieldMessageID holds the string denoting the message_id field in the database.
+	FieldMessageID = "message_id"
+	// FieldDedupKey holds the string denoting the dedup_key field in the database.
+	FieldDedupKey = "dedup_key"
+	// FieldMessageType holds the string denoting the message_type field in the database.
+	FieldMessageType = "message_type"
+	// FieldChannel holds the string denoting the channel field in the database.
+	FieldChannel = "channel"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldChatID holds the string denoting the chat_id field in the database.
+	FieldChatID = "chat_id"
+	// FieldPayload holds the string denoting the payload field in the database.
+	FieldPayload = "payload"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldHandlerResults holds the string denoting the handler_results field in the database.
+	FieldHandlerResults = "handler_results"
+	// FieldReplayCount holds the string denoting the replay_count field in the database.
+	FieldReplayCount = "replay_count"
+	// FieldLastReplayedAt holds the string denoting the last_replayed_at field in the database.
+	FieldLastReplayedAt = "last_replayed_at"
+	// FieldReceivedAt holds the string denoting the received_at field in the database.
+	FieldReceivedAt = "received_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the inboundmessagelog in the database.
+	Table = "inbound_message_logs"
+)
+
+// Columns holds all SQL columns for inboundmessagelog fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldConnectorName,
+	FieldMessageID,
+	FieldDedupKey,
+	FieldMessageType,
+	FieldChannel,
+	FieldUserID,
+	FieldChatID,
+	FieldPayload,
+	FieldStatus,
+	FieldHandlerResults,
+	FieldReplayCount,
+	FieldLastReplayedAt,
+	FieldReceivedAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	TenantIDValidator func(int) error
+	// ConnectorNameValidator is a validator for the "connector_name" field. It is called by the builders before save.
+	ConnectorNameValidator func(string) error
+	// DefaultReplayCount holds the default value on creation for the "replay_count" field.
+	DefaultReplayCount int
+	// ReplayCountValidator is a validator for the "replay_count" field. It is called by the builders before save.
+	ReplayCountValidator func(int) error
+	// DefaultReceivedAt holds the default value on creation for the "received_at" field.
+	DefaultReceivedAt func() time.Time
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusReceived is the default value of the Status enum.
+const DefaultStatus = StatusReceived
+
+// Status values.
+const (
+	StatusReceived  Status = "received"
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusReceived, StatusSucceeded, StatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("inboundmessagelog: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the InboundMessageLog queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByConnectorName orders the results by the connector_name field.
+func ByConnectorName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConnectorName, opts...).ToFunc()
+}
+
+// ByMessageID orders the results by the message_id field.
+func ByMessageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessageID, opts...).ToFunc()
+}
+
+// ByDedupKey orders the results by the dedup_key field.
+func ByDedupKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDedupKey, opts...).ToFunc()
+}
+
+// ByMessageType orders the results by the message_type field.
+func ByMessageType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessageType, opts...).ToFunc()
+}
+
+// ByChannel orders the results by the channel field.
+func ByChannel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChannel, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByChatID orders the results by the chat_id field.
+func ByChatID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChatID, opts...).ToFunc()
+}
+
+// ByPayload orders the results by the payload field.
+func ByPayload(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayload, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByReplayCount orders the results by the replay_count field.
+func ByReplayCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReplayCount, opts...).ToFunc()
+}
+
+// ByLastReplayedAt orders the results by the last_replayed_at field.
+func ByLastReplayedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastReplayedAt, opts...).ToFunc()
+}
+
+// ByReceivedAt orders the results by the received_at field.
+func ByReceivedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReceivedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
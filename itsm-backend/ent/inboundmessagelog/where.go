@@ -0,0 +1,1000 @@
+// Code generated by ent, DO NOT EDIT.
+
+package inboundmessagelog
+
+import (
+	"itsm-backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEQ(FieldTenantID, v))
+}
+
+// ConnectorName applies equality check predicate on the "connector_name" field. It's identical to ConnectorNameEQ.
+func ConnectorName(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEQ(FieldConnectorName, v))
+}
+
+// MessageID applies equality check predicate on the "message_id" field. It's identical to MessageIDEQ.
+func MessageID(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEQ(FieldMessageID, v))
+}
+
+// DedupKey applies equality check predicate on the "dedup_key" field. It's identical to DedupKeyEQ.
+func DedupKey(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEQ(FieldDedupKey, v))
+}
+
+// MessageType applies equality check predicate on the "message_type" field. It's identical to MessageTypeEQ.
+func MessageType(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEQ(FieldMessageType, v))
+}
+
+// Channel applies equality check predicate on the "channel" field. It's identical to ChannelEQ.
+func Channel(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEQ(FieldChannel, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEQ(FieldUserID, v))
+}
+
+// ChatID applies equality check predicate on the "chat_id" field. It's identical to ChatIDEQ.
+func ChatID(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEQ(FieldChatID, v))
+}
+
+// Payload applies equality check predicate on the "payload" field. It's identical to PayloadEQ.
+func Payload(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEQ(FieldPayload, v))
+}
+
+// ReplayCount applies equality check predicate on the "replay_count" field. It's identical to ReplayCountEQ.
+func ReplayCount(v int) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEQ(FieldReplayCount, v))
+}
+
+// LastReplayedAt applies equality check predicate on the "last_replayed_at" field. It's identical to LastReplayedAtEQ.
+func LastReplayedAt(v time.Time) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEQ(FieldLastReplayedAt, v))
+}
+
+// ReceivedAt applies equality check predicate on the "received_at" field. It's identical to ReceivedAtEQ.
+func ReceivedAt(v time.Time) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEQ(FieldReceivedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldLTE(FieldTenantID, v))
+}
+
+// ConnectorNameEQ applies the EQ predicate on the "connector_name" field.
+func ConnectorNameEQ(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEQ(FieldConnectorName, v))
+}
+
+// ConnectorNameNEQ applies the NEQ predicate on the "connector_name" field.
+func ConnectorNameNEQ(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNEQ(FieldConnectorName, v))
+}
+
+// ConnectorNameIn applies the In predicate on the "connector_name" field.
+func ConnectorNameIn(vs ...string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldIn(FieldConnectorName, vs...))
+}
+
+// ConnectorNameNotIn applies the NotIn predicate on the "connector_name" field.
+func ConnectorNameNotIn(vs ...string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNotIn(FieldConnectorName, vs...))
+}
+
+// ConnectorNameGT applies the GT predicate on the "connector_name" field.
+func ConnectorNameGT(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldGT(FieldConnectorName, v))
+}
+
+// ConnectorNameGTE applies the GTE predicate on the "connector_name" field.
+func ConnectorNameGTE(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldGTE(FieldConnectorName, v))
+}
+
+// ConnectorNameLT applies the LT predicate on the "connector_name" field.
+func ConnectorNameLT(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldLT(FieldConnectorName, v))
+}
+
+// ConnectorNameLTE applies the LTE predicate on the "connector_name" field.
+func ConnectorNameLTE(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldLTE(FieldConnectorName, v))
+}
+
+// ConnectorNameContains applies the Contains predicate on the "connector_name" field.
+func ConnectorNameContains(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldContains(FieldConnectorName, v))
+}
+
+// ConnectorNameHasPrefix applies the HasPrefix predicate on the "connector_name" field.
+func ConnectorNameHasPrefix(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldHasPrefix(FieldConnectorName, v))
+}
+
+// ConnectorNameHasSuffix applies the HasSuffix predicate on the "connector_name" field.
+func ConnectorNameHasSuffix(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldHasSuffix(FieldConnectorName, v))
+}
+
+// ConnectorNameEqualFold applies the EqualFold predicate on the "connector_name" field.
+func ConnectorNameEqualFold(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEqualFold(FieldConnectorName, v))
+}
+
+// ConnectorNameContainsFold applies the ContainsFold predicate on the "connector_name" field.
+func ConnectorNameContainsFold(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldContainsFold(FieldConnectorName, v))
+}
+
+// MessageIDEQ applies the EQ predicate on the "message_id" field.
+func MessageIDEQ(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEQ(FieldMessageID, v))
+}
+
+// MessageIDNEQ applies the NEQ predicate on the "message_id" field.
+func MessageIDNEQ(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNEQ(FieldMessageID, v))
+}
+
+// MessageIDIn applies the In predicate on the "message_id" field.
+func MessageIDIn(vs ...string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldIn(FieldMessageID, vs...))
+}
+
+// MessageIDNotIn applies the NotIn predicate on the "message_id" field.
+func MessageIDNotIn(vs ...string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNotIn(FieldMessageID, vs...))
+}
+
+// MessageIDGT applies the GT predicate on the "message_id" field.
+func MessageIDGT(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldGT(FieldMessageID, v))
+}
+
+// MessageIDGTE applies the GTE predicate on the "message_id" field.
+func MessageIDGTE(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldGTE(FieldMessageID, v))
+}
+
+// MessageIDLT applies the LT predicate on the "message_id" field.
+func MessageIDLT(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldLT(FieldMessageID, v))
+}
+
+// MessageIDLTE applies the LTE predicate on the "message_id" field.
+func MessageIDLTE(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldLTE(FieldMessageID, v))
+}
+
+// MessageIDContains applies the Contains predicate on the "message_id" field.
+func MessageIDContains(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldContains(FieldMessageID, v))
+}
+
+// MessageIDHasPrefix applies the HasPrefix predicate on the "message_id" field.
+func MessageIDHasPrefix(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldHasPrefix(FieldMessageID, v))
+}
+
+// MessageIDHasSuffix applies the HasSuffix predicate on the "message_id" field.
+func MessageIDHasSuffix(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldHasSuffix(FieldMessageID, v))
+}
+
+// MessageIDIsNil applies the IsNil predicate on the "message_id" field.
+func MessageIDIsNil() predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldIsNull(FieldMessageID))
+}
+
+// MessageIDNotNil applies the NotNil predicate on the "message_id" field.
+func MessageIDNotNil() predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNotNull(FieldMessageID))
+}
+
+// MessageIDEqualFold applies the EqualFold predicate on the "message_id" field.
+func MessageIDEqualFold(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEqualFold(FieldMessageID, v))
+}
+
+// MessageIDContainsFold applies the ContainsFold predicate on the "message_id" field.
+func MessageIDContainsFold(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldContainsFold(FieldMessageID, v))
+}
+
+// DedupKeyEQ applies the EQ predicate on the "dedup_key" field.
+func DedupKeyEQ(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEQ(FieldDedupKey, v))
+}
+
+// DedupKeyNEQ applies the NEQ predicate on the "dedup_key" field.
+func DedupKeyNEQ(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNEQ(FieldDedupKey, v))
+}
+
+// DedupKeyIn applies the In predicate on the "dedup_key" field.
+func DedupKeyIn(vs ...string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldIn(FieldDedupKey, vs...))
+}
+
+// DedupKeyNotIn applies the NotIn predicate on the "dedup_key" field.
+func DedupKeyNotIn(vs ...string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNotIn(FieldDedupKey, vs...))
+}
+
+// DedupKeyGT applies the GT predicate on the "dedup_key" field.
+func DedupKeyGT(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldGT(FieldDedupKey, v))
+}
+
+// DedupKeyGTE applies the GTE predicate on the "dedup_key" field.
+func DedupKeyGTE(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldGTE(FieldDedupKey, v))
+}
+
+// DedupKeyLT applies the LT predicate on the "dedup_key" field.
+func DedupKeyLT(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldLT(FieldDedupKey, v))
+}
+
+// DedupKeyLTE applies the LTE predicate on the "dedup_key" field.
+func DedupKeyLTE(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldLTE(FieldDedupKey, v))
+}
+
+// DedupKeyContains applies the Contains predicate on the "dedup_key" field.
+func DedupKeyContains(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldContains(FieldDedupKey, v))
+}
+
+// DedupKeyHasPrefix applies the HasPrefix predicate on the "dedup_key" field.
+func DedupKeyHasPrefix(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldHasPrefix(FieldDedupKey, v))
+}
+
+// DedupKeyHasSuffix applies the HasSuffix predicate on the "dedup_key" field.
+func DedupKeyHasSuffix(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldHasSuffix(FieldDedupKey, v))
+}
+
+// DedupKeyIsNil applies the IsNil predicate on the "dedup_key" field.
+func DedupKeyIsNil() predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldIsNull(FieldDedupKey))
+}
+
+// DedupKeyNotNil applies the NotNil predicate on the "dedup_key" field.
+func DedupKeyNotNil() predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNotNull(FieldDedupKey))
+}
+
+// DedupKeyEqualFold applies the EqualFold predicate on the "dedup_key" field.
+func DedupKeyEqualFold(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEqualFold(FieldDedupKey, v))
+}
+
+// DedupKeyContainsFold applies the ContainsFold predicate on the "dedup_key" field.
+func DedupKeyContainsFold(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldContainsFold(FieldDedupKey, v))
+}
+
+// MessageTypeEQ applies the EQ predicate on the "message_type" field.
+func MessageTypeEQ(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEQ(FieldMessageType, v))
+}
+
+// MessageTypeNEQ applies the NEQ predicate on the "message_type" field.
+func MessageTypeNEQ(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNEQ(FieldMessageType, v))
+}
+
+// MessageTypeIn applies the In predicate on the "message_type" field.
+func MessageTypeIn(vs ...string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldIn(FieldMessageType, vs...))
+}
+
+// MessageTypeNotIn applies the NotIn predicate on the "message_type" field.
+func MessageTypeNotIn(vs ...string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNotIn(FieldMessageType, vs...))
+}
+
+// MessageTypeGT applies the GT predicate on the "message_type" field.
+func MessageTypeGT(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldGT(FieldMessageType, v))
+}
+
+// MessageTypeGTE applies the GTE predicate on the "message_type" field.
+func MessageTypeGTE(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldGTE(FieldMessageType, v))
+}
+
+// MessageTypeLT applies the LT predicate on the "message_type" field.
+func MessageTypeLT(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldLT(FieldMessageType, v))
+}
+
+// MessageTypeLTE applies the LTE predicate on the "message_type" field.
+func MessageTypeLTE(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldLTE(FieldMessageType, v))
+}
+
+// MessageTypeContains applies the Contains predicate on the "message_type" field.
+func MessageTypeContains(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldContains(FieldMessageType, v))
+}
+
+// MessageTypeHasPrefix applies the HasPrefix predicate on the "message_type" field.
+func MessageTypeHasPrefix(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldHasPrefix(FieldMessageType, v))
+}
+
+// MessageTypeHasSuffix applies the HasSuffix predicate on the "message_type" field.
+func MessageTypeHasSuffix(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldHasSuffix(FieldMessageType, v))
+}
+
+// MessageTypeIsNil applies the IsNil predicate on the "message_type" field.
+func MessageTypeIsNil() predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldIsNull(FieldMessageType))
+}
+
+// MessageTypeNotNil applies the NotNil predicate on the "message_type" field.
+func MessageTypeNotNil() predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNotNull(FieldMessageType))
+}
+
+// MessageTypeEqualFold applies the EqualFold predicate on the "message_type" field.
+func MessageTypeEqualFold(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEqualFold(FieldMessageType, v))
+}
+
+// MessageTypeContainsFold applies the ContainsFold predicate on the "message_type" field.
+func MessageTypeContainsFold(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldContainsFold(FieldMessageType, v))
+}
+
+// ChannelEQ applies the EQ predicate on the "channel" field.
+func ChannelEQ(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEQ(FieldChannel, v))
+}
+
+// ChannelNEQ applies the NEQ predicate on the "channel" field.
+func ChannelNEQ(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNEQ(FieldChannel, v))
+}
+
+// ChannelIn applies the In predicate on the "channel" field.
+func ChannelIn(vs ...string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldIn(FieldChannel, vs...))
+}
+
+// ChannelNotIn applies the NotIn predicate on the "channel" field.
+func ChannelNotIn(vs ...string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNotIn(FieldChannel, vs...))
+}
+
+// ChannelGT applies the GT predicate on the "channel" field.
+func ChannelGT(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldGT(FieldChannel, v))
+}
+
+// ChannelGTE applies the GTE predicate on the "channel" field.
+func ChannelGTE(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldGTE(FieldChannel, v))
+}
+
+// ChannelLT applies the LT predicate on the "channel" field.
+func ChannelLT(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldLT(FieldChannel, v))
+}
+
+// ChannelLTE applies the LTE predicate on the "channel" field.
+func ChannelLTE(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldLTE(FieldChannel, v))
+}
+
+// ChannelContains applies the Contains predicate on the "channel" field.
+func ChannelContains(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldContains(FieldChannel, v))
+}
+
+// ChannelHasPrefix applies the HasPrefix predicate on the "channel" field.
+func ChannelHasPrefix(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldHasPrefix(FieldChannel, v))
+}
+
+// ChannelHasSuffix applies the HasSuffix predicate on the "channel" field.
+func ChannelHasSuffix(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldHasSuffix(FieldChannel, v))
+}
+
+// ChannelIsNil applies the IsNil predicate on the "channel" field.
+func ChannelIsNil() predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldIsNull(FieldChannel))
+}
+
+// ChannelNotNil applies the NotNil predicate on the "channel" field.
+func ChannelNotNil() predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNotNull(FieldChannel))
+}
+
+// ChannelEqualFold applies the EqualFold predicate on the "channel" field.
+func ChannelEqualFold(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEqualFold(FieldChannel, v))
+}
+
+// ChannelContainsFold applies the ContainsFold predicate on the "channel" field.
+func ChannelContainsFold(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldContainsFold(FieldChannel, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNotNull(FieldUserID))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// ChatIDEQ applies the EQ predicate on the "chat_id" field.
+func ChatIDEQ(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEQ(FieldChatID, v))
+}
+
+// ChatIDNEQ applies the NEQ predicate on the "chat_id" field.
+func ChatIDNEQ(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNEQ(FieldChatID, v))
+}
+
+// ChatIDIn applies the In predicate on the "chat_id" field.
+func ChatIDIn(vs ...string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldIn(FieldChatID, vs...))
+}
+
+// ChatIDNotIn applies the NotIn predicate on the "chat_id" field.
+func ChatIDNotIn(vs ...string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNotIn(FieldChatID, vs...))
+}
+
+// ChatIDGT applies the GT predicate on the "chat_id" field.
+func ChatIDGT(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldGT(FieldChatID, v))
+}
+
+// ChatIDGTE applies the GTE predicate on the "chat_id" field.
+func ChatIDGTE(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldGTE(FieldChatID, v))
+}
+
+// ChatIDLT applies the LT predicate on the "chat_id" field.
+func ChatIDLT(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldLT(FieldChatID, v))
+}
+
+// ChatIDLTE applies the LTE predicate on the "chat_id" field.
+func ChatIDLTE(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldLTE(FieldChatID, v))
+}
+
+// ChatIDContains applies the Contains predicate on the "chat_id" field.
+func ChatIDContains(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldContains(FieldChatID, v))
+}
+
+// ChatIDHasPrefix applies the HasPrefix predicate on the "chat_id" field.
+func ChatIDHasPrefix(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldHasPrefix(FieldChatID, v))
+}
+
+// ChatIDHasSuffix applies the HasSuffix predicate on the "chat_id" field.
+func ChatIDHasSuffix(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldHasSuffix(FieldChatID, v))
+}
+
+// ChatIDIsNil applies the IsNil predicate on the "chat_id" field.
+func ChatIDIsNil() predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldIsNull(FieldChatID))
+}
+
+// ChatIDNotNil applies the NotNil predicate on the "chat_id" field.
+func ChatIDNotNil() predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNotNull(FieldChatID))
+}
+
+// ChatIDEqualFold applies the EqualFold predicate on the "chat_id" field.
+func ChatIDEqualFold(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEqualFold(FieldChatID, v))
+}
+
+// ChatIDContainsFold applies the ContainsFold predicate on the "chat_id" field.
+func ChatIDContainsFold(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldContainsFold(FieldChatID, v))
+}
+
+// PayloadEQ applies the EQ predicate on the "payload" field.
+func PayloadEQ(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEQ(FieldPayload, v))
+}
+
+// PayloadNEQ applies the NEQ predicate on the "payload" field.
+func PayloadNEQ(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNEQ(FieldPayload, v))
+}
+
+// PayloadIn applies the In predicate on the "payload" field.
+func PayloadIn(vs ...string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldIn(FieldPayload, vs...))
+}
+
+// PayloadNotIn applies the NotIn predicate on the "payload" field.
+func PayloadNotIn(vs ...string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNotIn(FieldPayload, vs...))
+}
+
+// PayloadGT applies the GT predicate on the "payload" field.
+func PayloadGT(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldGT(FieldPayload, v))
+}
+
+// PayloadGTE applies the GTE predicate on the "payload" field.
+func PayloadGTE(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldGTE(FieldPayload, v))
+}
+
+// PayloadLT applies the LT predicate on the "payload" field.
+func PayloadLT(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldLT(FieldPayload, v))
+}
+
+// PayloadLTE applies the LTE predicate on the "payload" field.
+func PayloadLTE(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldLTE(FieldPayload, v))
+}
+
+// PayloadContains applies the Contains predicate on the "payload" field.
+func PayloadContains(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldContains(FieldPayload, v))
+}
+
+// PayloadHasPrefix applies the HasPrefix predicate on the "payload" field.
+func PayloadHasPrefix(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldHasPrefix(FieldPayload, v))
+}
+
+// PayloadHasSuffix applies the HasSuffix predicate on the "payload" field.
+func PayloadHasSuffix(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldHasSuffix(FieldPayload, v))
+}
+
+// PayloadEqualFold applies the EqualFold predicate on the "payload" field.
+func PayloadEqualFold(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEqualFold(FieldPayload, v))
+}
+
+// PayloadContainsFold applies the ContainsFold predicate on the "payload" field.
+func PayloadContainsFold(v string) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldContainsFold(FieldPayload, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// HandlerResultsIsNil applies the IsNil predicate on the "handler_results" field.
+func HandlerResultsIsNil() predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldIsNull(FieldHandlerResults))
+}
+
+// HandlerResultsNotNil applies the NotNil predicate on the "handler_results" field.
+func HandlerResultsNotNil() predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNotNull(FieldHandlerResults))
+}
+
+// ReplayCountEQ applies the EQ predicate on the "replay_count" field.
+func ReplayCountEQ(v int) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEQ(FieldReplayCount, v))
+}
+
+// ReplayCountNEQ applies the NEQ predicate on the "replay_count" field.
+func ReplayCountNEQ(v int) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNEQ(FieldReplayCount, v))
+}
+
+// ReplayCountIn applies the In predicate on the "replay_count" field.
+func ReplayCountIn(vs ...int) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldIn(FieldReplayCount, vs...))
+}
+
+// ReplayCountNotIn applies the NotIn predicate on the "replay_count" field.
+func ReplayCountNotIn(vs ...int) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNotIn(FieldReplayCount, vs...))
+}
+
+// ReplayCountGT applies the GT predicate on the "replay_count" field.
+func ReplayCountGT(v int) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldGT(FieldReplayCount, v))
+}
+
+// ReplayCountGTE applies the GTE predicate on the "replay_count" field.
+func ReplayCountGTE(v int) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldGTE(FieldReplayCount, v))
+}
+
+// ReplayCountLT applies the LT predicate on the "replay_count" field.
+func ReplayCountLT(v int) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldLT(FieldReplayCount, v))
+}
+
+// ReplayCountLTE applies the LTE predicate on the "replay_count" field.
+func ReplayCountLTE(v int) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldLTE(FieldReplayCount, v))
+}
+
+// LastReplayedAtEQ applies the EQ predicate on the "last_replayed_at" field.
+func LastReplayedAtEQ(v time.Time) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEQ(FieldLastReplayedAt, v))
+}
+
+// LastReplayedAtNEQ applies the NEQ predicate on the "last_replayed_at" field.
+func LastReplayedAtNEQ(v time.Time) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNEQ(FieldLastReplayedAt, v))
+}
+
+// LastReplayedAtIn applies the In predicate on the "last_replayed_at" field.
+func LastReplayedAtIn(vs ...time.Time) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldIn(FieldLastReplayedAt, vs...))
+}
+
+// LastReplayedAtNotIn applies the NotIn predicate on the "last_replayed_at" field.
+func LastReplayedAtNotIn(vs ...time.Time) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNotIn(FieldLastReplayedAt, vs...))
+}
+
+// LastReplayedAtGT applies the GT predicate on the "last_replayed_at" field.
+func LastReplayedAtGT(v time.Time) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldGT(FieldLastReplayedAt, v))
+}
+
+// LastReplayedAtGTE applies the GTE predicate on the "last_replayed_at" field.
+func LastReplayedAtGTE(v time.Time) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldGTE(FieldLastReplayedAt, v))
+}
+
+// LastReplayedAtLT applies the LT predicate on the "last_replayed_at" field.
+func LastReplayedAtLT(v time.Time) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldLT(FieldLastReplayedAt, v))
+}
+
+// LastReplayedAtLTE applies the LTE predicate on the "last_replayed_at" field.
+func LastReplayedAtLTE(v time.Time) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldLTE(FieldLastReplayedAt, v))
+}
+
+// LastReplayedAtIsNil applies the IsNil predicate on the "last_replayed_at" field.
+func LastReplayedAtIsNil() predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldIsNull(FieldLastReplayedAt))
+}
+
+// LastReplayedAtNotNil applies the NotNil predicate on the "last_replayed_at" field.
+func LastReplayedAtNotNil() predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNotNull(FieldLastReplayedAt))
+}
+
+// ReceivedAtEQ applies the EQ predicate on the "received_at" field.
+func ReceivedAtEQ(v time.Time) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEQ(FieldReceivedAt, v))
+}
+
+// ReceivedAtNEQ applies the NEQ predicate on the "received_at" field.
+func ReceivedAtNEQ(v time.Time) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNEQ(FieldReceivedAt, v))
+}
+
+// ReceivedAtIn applies the In predicate on the "received_at" field.
+func ReceivedAtIn(vs ...time.Time) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldIn(FieldReceivedAt, vs...))
+}
+
+// ReceivedAtNotIn applies the NotIn predicate on the "received_at" field.
+func ReceivedAtNotIn(vs ...time.Time) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNotIn(FieldReceivedAt, vs...))
+}
+
+// ReceivedAtGT applies the GT predicate on the "received_at" field.
+func ReceivedAtGT(v time.Time) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldGT(FieldReceivedAt, v))
+}
+
+// ReceivedAtGTE applies the GTE predicate on the "received_at" field.
+func ReceivedAtGTE(v time.Time) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldGTE(FieldReceivedAt, v))
+}
+
+// ReceivedAtLT applies the LT predicate on the "received_at" field.
+func ReceivedAtLT(v time.Time) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldLT(FieldReceivedAt, v))
+}
+
+// ReceivedAtLTE applies the LTE predicate on the "received_at" field.
+func ReceivedAtLTE(v time.Time) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldLTE(FieldReceivedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InboundMessageLog) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InboundMessageLog) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InboundMessageLog) predicate.InboundMessageLog {
+	return predicate.InboundMessageLog(sql.NotPredicates(p))
+}
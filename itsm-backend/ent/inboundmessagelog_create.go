@@ -0,0 +1,498 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/inboundmessagelog"
+	"itsm-backend/ent/schema"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InboundMessageLogCreate is the builder for creating a InboundMessageLog entity.
+type InboundMessageLogCreate struct {
+	config
+	mutation *InboundMessageLogMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *InboundMessageLogCreate) SetTenantID(v int) *InboundMessageLogCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetConnectorName sets the "connector_name" field.
+func (_c *InboundMessageLogCreate) SetConnectorName(v string) *InboundMessageLogCreate {
+	_c.mutation.SetConnectorName(v)
+	return _c
+}
+
+// SetMessageID sets the "message_id" field.
+func (_c *InboundMessageLogCreate) SetMessageID(v string) *InboundMessageLogCreate {
+	_c.mutation.SetMessageID(v)
+	return _c
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_c *InboundMessageLogCreate) SetNillableMessageID(v *string) *InboundMessageLogCreate {
+	if v != nil {
+		_c.SetMessageID(*v)
+	}
+	return _c
+}
+
+// SetDedupKey sets the "dedup_key" field.
+func (_c *InboundMessageLogCreate) SetDedupKey(v string) *InboundMessageLogCreate {
+	_c.mutation.SetDedupKey(v)
+	return _c
+}
+
+// SetNillableDedupKey sets the "dedup_key" field if the given value is not nil.
+func (_c *InboundMessageLogCreate) SetNillableDedupKey(v *string) *InboundMessageLogCreate {
+	if v != nil {
+		_c.SetDedupKey(*v)
+	}
+	return _c
+}
+
+// SetMessageType sets the "message_type" field.
+func (_c *InboundMessageLogCreate) SetMessageType(v string) *InboundMessageLogCreate {
+	_c.mutation.SetMessageType(v)
+	return _c
+}
+
+// SetNillableMessageType sets the "message_type" field if the given value is not nil.
+func (_c *InboundMessageLogCreate) SetNillableMessageType(v *string) *InboundMessageLogCreate {
+	if v != nil {
+		_c.SetMessageType(*v)
+	}
+	return _c
+}
+
+// SetChannel sets the "channel" field.
+func (_c *InboundMessageLogCreate) SetChannel(v string) *InboundMessageLogCreate {
+	_c.mutation.SetChannel(v)
+	return _c
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (_c *InboundMessageLogCreate) SetNillableChannel(v *string) *InboundMessageLogCreate {
+	if v != nil {
+		_c.SetChannel(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *InboundMessageLogCreate) SetUserID(v string) *InboundMessageLogCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *InboundMessageLogCreate) SetNillableUserID(v *string) *InboundMessageLogCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetChatID sets the "chat_id" field.
+func (_c *InboundMessageLogCreate) SetChatID(v string) *InboundMessageLogCreate {
+	_c.mutation.SetChatID(v)
+	return _c
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_c *InboundMessageLogCreate) SetNillableChatID(v *string) *InboundMessageLogCreate {
+	if v != nil {
+		_c.SetChatID(*v)
+	}
+	return _c
+}
+
+// SetPayload sets the "payload" field.
+func (_c *InboundMessageLogCreate) SetPayload(v string) *InboundMessageLogCreate {
+	_c.mutation.SetPayload(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *InboundMessageLogCreate) SetStatus(v inboundmessagelog.Status) *InboundMessageLogCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *InboundMessageLogCreate) SetNillableStatus(v *inboundmessagelog.Status) *InboundMessageLogCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetHandlerResults sets the "handler_results" field.
+func (_c *InboundMessageLogCreate) SetHandlerResults(v map[string]schema.InboundHandlerResult) *InboundMessageLogCreate {
+	_c.mutation.SetHandlerResults(v)
+	return _c
+}
+
+// SetReplayCount sets the "replay_count" field.
+func (_c *InboundMessageLogCreate) SetReplayCount(v int) *InboundMessageLogCreate {
+	_c.mutation.SetReplayCount(v)
+	return _c
+}
+
+// SetNillableReplayCount sets the "replay_count" field if the given value is not nil.
+func (_c *InboundMessageLogCreate) SetNillableReplayCount(v *int) *InboundMessageLogCreate {
+	if v != nil {
+		_c.SetReplayCount(*v)
+	}
+	return _c
+}
+
+// SetLastReplayedAt sets the "last_replayed_at" field.
+func (_c *InboundMessageLogCreate) SetLastReplayedAt(v time.Time) *InboundMessageLogCreate {
+	_c.mutation.SetLastReplayedAt(v)
+	return _c
+}
+
+// SetNillableLastReplayedAt sets the "last_replayed_at" field if the given value is not nil.
+func (_c *InboundMessageLogCreate) SetNillableLastReplayedAt(v *time.Time) *InboundMessageLogCreate {
+	if v != nil {
+		_c.SetLastReplayedAt(*v)
+	}
+	return _c
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (_c *InboundMessageLogCreate) SetReceivedAt(v time.Time) *InboundMessageLogCreate {
+	_c.mutation.SetReceivedAt(v)
+	return _c
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (_c *InboundMessageLogCreate) SetNillableReceivedAt(v *time.Time) *InboundMessageLogCreate {
+	if v != nil {
+		_c.SetReceivedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *InboundMessageLogCreate) SetCreatedAt(v time.Time) *InboundMessageLogCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *InboundMessageLogCreate) SetNillableCreatedAt(v *time.Time) *InboundMessageLogCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *InboundMessageLogCreate) SetUpdatedAt(v time.Time) *InboundMessageLogCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *InboundMessageLogCreate) SetNillableUpdatedAt(v *time.Time) *InboundMessageLogCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the InboundMessageLogMutation object of the builder.
+func (_c *InboundMessageLogCreate) Mutation() *InboundMessageLogMutation {
+	return _c.mutation
+}
+
+// Save creates the InboundMessageLog in the database.
+func (_c *InboundMessageLogCreate) Save(ctx context.Context) (*InboundMessageLog, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *InboundMessageLogCreate) SaveX(ctx context.Context) *InboundMessageLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InboundMessageLogCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InboundMessageLogCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *InboundMessageLogCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := inboundmessagelog.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.ReplayCount(); !ok {
+		v := inboundmessagelog.DefaultReplayCount
+		_c.mutation.SetReplayCount(v)
+	}
+	if _, ok := _c.mutation.ReceivedAt(); !ok {
+		v := inboundmessagelog.DefaultReceivedAt()
+		_c.mutation.SetReceivedAt(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := inboundmessagelog.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := inboundmessagelog.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *InboundMessageLogCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "InboundMessageLog.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := inboundmessagelog.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "InboundMessageLog.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ConnectorName(); !ok {
+		return &ValidationError{Name: "connector_name", err: errors.New(`ent: missing required field "InboundMessageLog.connector_name"`)}
+	}
+	if v, ok := _c.mutation.ConnectorName(); ok {
+		if err := inboundmessagelog.ConnectorNameValidator(v); err != nil {
+			return &ValidationError{Name: "connector_name", err: fmt.Errorf(`ent: validator failed for field "InboundMessageLog.connector_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`ent: missing required field "InboundMessageLog.payload"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "InboundMessageLog.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := inboundmessagelog.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "InboundMessageLog.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ReplayCount(); !ok {
+		return &ValidationError{Name: "replay_count", err: errors.New(`ent: missing required field "InboundMessageLog.replay_count"`)}
+	}
+	if v, ok := _c.mutation.ReplayCount(); ok {
+		if err := inboundmessagelog.ReplayCountValidator(v); err != nil {
+			return &ValidationError{Name: "replay_count", err: fmt.Errorf(`ent: validator failed for field "InboundMessageLog.replay_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ReceivedAt(); !ok {
+		return &ValidationError{Name: "received_at", err: errors.New(`ent: missing required field "InboundMessageLog.received_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "InboundMessageLog.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "InboundMessageLog.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *InboundMessageLogCreate) sqlSave(ctx context.Context) (*InboundMessageLog, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *InboundMessageLogCreate) createSpec() (*InboundMessageLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InboundMessageLog{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(inboundmessagelog.Table, sqlgraph.NewFieldSpec(inboundmessagelog.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(inboundmessagelog.FieldTenantID, field.TypeInt, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.ConnectorName(); ok {
+		_spec.SetField(inboundmessagelog.FieldConnectorName, field.TypeString, value)
+		_node.ConnectorName = value
+	}
+	if value, ok := _c.mutation.MessageID(); ok {
+		_spec.SetField(inboundmessagelog.FieldMessageID, field.TypeString, value)
+		_node.MessageID = value
+	}
+	if value, ok := _c.mutation.DedupKey(); ok {
+		_spec.SetField(inboundmessagelog.FieldDedupKey, field.TypeString, value)
+		_node.DedupKey = value
+	}
+	if value, ok := _c.mutation.MessageType(); ok {
+		_spec.SetField(inboundmessagelog.FieldMessageType, field.TypeString, value)
+		_node.MessageType = value
+	}
+	if value, ok := _c.mutation.Channel(); ok {
+		_spec.SetField(inboundmessagelog.FieldChannel, field.TypeString, value)
+		_node.Channel = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(inboundmessagelog.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.ChatID(); ok {
+		_spec.SetField(inboundmessagelog.FieldChatID, field.TypeString, value)
+		_node.ChatID = value
+	}
+	if value, ok := _c.mutation.Payload(); ok {
+		_spec.SetField(inboundmessagelog.FieldPayload, field.TypeString, value)
+		_node.Payload = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(inboundmessagelog.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.HandlerResults(); ok {
+		_spec.SetField(inboundmessagelog.FieldHandlerResults, field.TypeJSON, value)
+		_node.HandlerResults = value
+	}
+	if value, ok := _c.mutation.ReplayCount(); ok {
+		_spec.SetField(inboundmessagelog.FieldReplayCount, field.TypeInt, value)
+		_node.ReplayCount = value
+	}
+	if value, ok := _c.mutation.LastReplayedAt(); ok {
+		_spec.SetField(inboundmessagelog.FieldLastReplayedAt, field.TypeTime, value)
+		_node.LastReplayedAt = &value
+	}
+	if value, ok := _c.mutation.ReceivedAt(); ok {
+		_spec.SetField(inboundmessagelog.FieldReceivedAt, field.TypeTime, value)
+		_node.ReceivedAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(inboundmessagelog.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(inboundmessagelog.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// InboundMessageLogCreateBulk is the builder for creating many InboundMessageLog entities in bulk.
+type InboundMessageLogCreateBulk struct {
+	config
+	err      error
+	builders []*InboundMessageLogCreate
+}
+
+// Save creates the InboundMessageLog entities in the database.
+func (_c *InboundMessageLogCreateBulk) Save(ctx context.Context) ([]*InboundMessageLog, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*InboundMessageLog, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InboundMessageLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *InboundMessageLogCreateBulk) SaveX(ctx context.Context) []*InboundMessageLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InboundMessageLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InboundMessageLogCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,913 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/inboundmessagelog"
+	"itsm-backend/ent/predicate"
+	"itsm-backend/ent/schema"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InboundMessageLogUpdate is the builder for updating InboundMessageLog entities.
+type InboundMessageLogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InboundMessageLogMutation
+}
+
+// Where appends a list predicates to the InboundMessageLogUpdate builder.
+func (_u *InboundMessageLogUpdate) Where(ps ...predicate.InboundMessageLog) *InboundMessageLogUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *InboundMessageLogUpdate) SetTenantID(v int) *InboundMessageLogUpdate {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *InboundMessageLogUpdate) SetNillableTenantID(v *int) *InboundMessageLogUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *InboundMessageLogUpdate) AddTenantID(v int) *InboundMessageLogUpdate {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetConnectorName sets the "connector_name" field.
+func (_u *InboundMessageLogUpdate) SetConnectorName(v string) *InboundMessageLogUpdate {
+	_u.mutation.SetConnectorName(v)
+	return _u
+}
+
+// SetNillableConnectorName sets the "connector_name" field if the given value is not nil.
+func (_u *InboundMessageLogUpdate) SetNillableConnectorName(v *string) *InboundMessageLogUpdate {
+	if v != nil {
+		_u.SetConnectorName(*v)
+	}
+	return _u
+}
+
+// SetMessageID sets the "message_id" field.
+func (_u *InboundMessageLogUpdate) SetMessageID(v string) *InboundMessageLogUpdate {
+	_u.mutation.SetMessageID(v)
+	return _u
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_u *InboundMessageLogUpdate) SetNillableMessageID(v *string) *InboundMessageLogUpdate {
+	if v != nil {
+		_u.SetMessageID(*v)
+	}
+	return _u
+}
+
+// ClearMessageID clears the value of the "message_id" field.
+func (_u *InboundMessageLogUpdate) ClearMessageID() *InboundMessageLogUpdate {
+	_u.mutation.ClearMessageID()
+	return _u
+}
+
+// SetDedupKey sets the "dedup_key" field.
+func (_u *InboundMessageLogUpdate) SetDedupKey(v string) *InboundMessageLogUpdate {
+	_u.mutation.SetDedupKey(v)
+	return _u
+}
+
+// SetNillableDedupKey sets the "dedup_key" field if the given value is not nil.
+func (_u *InboundMessageLogUpdate) SetNillableDedupKey(v *string) *InboundMessageLogUpdate {
+	if v != nil {
+		_u.SetDedupKey(*v)
+	}
+	return _u
+}
+
+// ClearDedupKey clears the value of the "dedup_key" field.
+func (_u *InboundMessageLogUpdate) ClearDedupKey() *InboundMessageLogUpdate {
+	_u.mutation.ClearDedupKey()
+	return _u
+}
+
+// SetMessageType sets the "message_type" field.
+func (_u *InboundMessageLogUpdate) SetMessageType(v string) *InboundMessageLogUpdate {
+	_u.mutation.SetMessageType(v)
+	return _u
+}
+
+// SetNillableMessageType sets the "message_type" field if the given value is not nil.
+func (_u *InboundMessageLogUpdate) SetNillableMessageType(v *string) *InboundMessageLogUpdate {
+	if v != nil {
+		_u.SetMessageType(*v)
+	}
+	return _u
+}
+
+// ClearMessageType clears the value of the "message_type" field.
+func (_u *InboundMessageLogUpdate) ClearMessageType() *InboundMessageLogUpdate {
+	_u.mutation.ClearMessageType()
+	return _u
+}
+
+// SetChannel sets the "channel" field.
+func (_u *InboundMessageLogUpdate) SetChannel(v string) *InboundMessageLogUpdate {
+	_u.mutation.SetChannel(v)
+	return _u
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (_u *InboundMessageLogUpdate) SetNillableChannel(v *string) *InboundMessageLogUpdate {
+	if v != nil {
+		_u.SetChannel(*v)
+	}
+	return _u
+}
+
+// ClearChannel clears the value of the "channel" field.
+func (_u *InboundMessageLogUpdate) ClearChannel() *InboundMessageLogUpdate {
+	_u.mutation.ClearChannel()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *InboundMessageLogUpdate) SetUserID(v string) *InboundMessageLogUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *InboundMessageLogUpdate) SetNillableUserID(v *string) *InboundMessageLogUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *InboundMessageLogUpdate) ClearUserID() *InboundMessageLogUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *InboundMessageLogUpdate) SetChatID(v string) *InboundMessageLogUpdate {
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *InboundMessageLogUpdate) SetNillableChatID(v *string) *InboundMessageLogUpdate {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// ClearChatID clears the value of the "chat_id" field.
+func (_u *InboundMessageLogUpdate) ClearChatID() *InboundMessageLogUpdate {
+	_u.mutation.ClearChatID()
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *InboundMessageLogUpdate) SetPayload(v string) *InboundMessageLogUpdate {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// SetNillablePayload sets the "payload" field if the given value is not nil.
+func (_u *InboundMessageLogUpdate) SetNillablePayload(v *string) *InboundMessageLogUpdate {
+	if v != nil {
+		_u.SetPayload(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *InboundMessageLogUpdate) SetStatus(v inboundmessagelog.Status) *InboundMessageLogUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *InboundMessageLogUpdate) SetNillableStatus(v *inboundmessagelog.Status) *InboundMessageLogUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetHandlerResults sets the "handler_results" field.
+func (_u *InboundMessageLogUpdate) SetHandlerResults(v map[string]schema.InboundHandlerResult) *InboundMessageLogUpdate {
+	_u.mutation.SetHandlerResults(v)
+	return _u
+}
+
+// ClearHandlerResults clears the value of the "handler_results" field.
+func (_u *InboundMessageLogUpdate) ClearHandlerResults() *InboundMessageLogUpdate {
+	_u.mutation.ClearHandlerResults()
+	return _u
+}
+
+// SetReplayCount sets the "replay_count" field.
+func (_u *InboundMessageLogUpdate) SetReplayCount(v int) *InboundMessageLogUpdate {
+	_u.mutation.ResetReplayCount()
+	_u.mutation.SetReplayCount(v)
+	return _u
+}
+
+// SetNillableReplayCount sets the "replay_count" field if the given value is not nil.
+func (_u *InboundMessageLogUpdate) SetNillableReplayCount(v *int) *InboundMessageLogUpdate {
+	if v != nil {
+		_u.SetReplayCount(*v)
+	}
+	return _u
+}
+
+// AddReplayCount adds value to the "replay_count" field.
+func (_u *InboundMessageLogUpdate) AddReplayCount(v int) *InboundMessageLogUpdate {
+	_u.mutation.AddReplayCount(v)
+	return _u
+}
+
+// SetLastReplayedAt sets the "last_replayed_at" field.
+func (_u *InboundMessageLogUpdate) SetLastReplayedAt(v time.Time) *InboundMessageLogUpdate {
+	_u.mutation.SetLastReplayedAt(v)
+	return _u
+}
+
+// SetNillableLastReplayedAt sets the "last_replayed_at" field if the given value is not nil.
+func (_u *InboundMessageLogUpdate) SetNillableLastReplayedAt(v *time.Time) *InboundMessageLogUpdate {
+	if v != nil {
+		_u.SetLastReplayedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastReplayedAt clears the value of the "last_replayed_at" field.
+func (_u *InboundMessageLogUpdate) ClearLastReplayedAt() *InboundMessageLogUpdate {
+	_u.mutation.ClearLastReplayedAt()
+	return _u
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (_u *InboundMessageLogUpdate) SetReceivedAt(v time.Time) *InboundMessageLogUpdate {
+	_u.mutation.SetReceivedAt(v)
+	return _u
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (_u *InboundMessageLogUpdate) SetNillableReceivedAt(v *time.Time) *InboundMessageLogUpdate {
+	if v != nil {
+		_u.SetReceivedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *InboundMessageLogUpdate) SetUpdatedAt(v time.Time) *InboundMessageLogUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the InboundMessageLogMutation object of the builder.
+func (_u *InboundMessageLogUpdate) Mutation() *InboundMessageLogMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *InboundMessageLogUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InboundMessageLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *InboundMessageLogUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InboundMessageLogUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *InboundMessageLogUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := inboundmessagelog.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InboundMessageLogUpdate) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := inboundmessagelog.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "InboundMessageLog.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ConnectorName(); ok {
+		if err := inboundmessagelog.ConnectorNameValidator(v); err != nil {
+			return &ValidationError{Name: "connector_name", err: fmt.Errorf(`ent: validator failed for field "InboundMessageLog.connector_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := inboundmessagelog.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "InboundMessageLog.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ReplayCount(); ok {
+		if err := inboundmessagelog.ReplayCountValidator(v); err != nil {
+			return &ValidationError{Name: "replay_count", err: fmt.Errorf(`ent: validator failed for field "InboundMessageLog.replay_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *InboundMessageLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(inboundmessagelog.Table, inboundmessagelog.Columns, sqlgraph.NewFieldSpec(inboundmessagelog.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(inboundmessagelog.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(inboundmessagelog.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ConnectorName(); ok {
+		_spec.SetField(inboundmessagelog.FieldConnectorName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MessageID(); ok {
+		_spec.SetField(inboundmessagelog.FieldMessageID, field.TypeString, value)
+	}
+	if _u.mutation.MessageIDCleared() {
+		_spec.ClearField(inboundmessagelog.FieldMessageID, field.TypeString)
+	}
+	if value, ok := _u.mutation.DedupKey(); ok {
+		_spec.SetField(inboundmessagelog.FieldDedupKey, field.TypeString, value)
+	}
+	if _u.mutation.DedupKeyCleared() {
+		_spec.ClearField(inboundmessagelog.FieldDedupKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.MessageType(); ok {
+		_spec.SetField(inboundmessagelog.FieldMessageType, field.TypeString, value)
+	}
+	if _u.mutation.MessageTypeCleared() {
+		_spec.ClearField(inboundmessagelog.FieldMessageType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Channel(); ok {
+		_spec.SetField(inboundmessagelog.FieldChannel, field.TypeString, value)
+	}
+	if _u.mutation.ChannelCleared() {
+		_spec.ClearField(inboundmessagelog.FieldChannel, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(inboundmessagelog.FieldUserID, field.TypeString, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(inboundmessagelog.FieldUserID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ChatID(); ok {
+		_spec.SetField(inboundmessagelog.FieldChatID, field.TypeString, value)
+	}
+	if _u.mutation.ChatIDCleared() {
+		_spec.ClearField(inboundmessagelog.FieldChatID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(inboundmessagelog.FieldPayload, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(inboundmessagelog.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.HandlerResults(); ok {
+		_spec.SetField(inboundmessagelog.FieldHandlerResults, field.TypeJSON, value)
+	}
+	if _u.mutation.HandlerResultsCleared() {
+		_spec.ClearField(inboundmessagelog.FieldHandlerResults, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ReplayCount(); ok {
+		_spec.SetField(inboundmessagelog.FieldReplayCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReplayCount(); ok {
+		_spec.AddField(inboundmessagelog.FieldReplayCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastReplayedAt(); ok {
+		_spec.SetField(inboundmessagelog.FieldLastReplayedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastReplayedAtCleared() {
+		_spec.ClearField(inboundmessagelog.FieldLastReplayedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReceivedAt(); ok {
+		_spec.SetField(inboundmessagelog.FieldReceivedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(inboundmessagelog.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{inboundmessagelog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// InboundMessageLogUpdateOne is the builder for updating a single InboundMessageLog entity.
+type InboundMessageLogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InboundMessageLogMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *InboundMessageLogUpdateOne) SetTenantID(v int) *InboundMessageLogUpdateOne {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *InboundMessageLogUpdateOne) SetNillableTenantID(v *int) *InboundMessageLogUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *InboundMessageLogUpdateOne) AddTenantID(v int) *InboundMessageLogUpdateOne {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetConnectorName sets the "connector_name" field.
+func (_u *InboundMessageLogUpdateOne) SetConnectorName(v string) *InboundMessageLogUpdateOne {
+	_u.mutation.SetConnectorName(v)
+	return _u
+}
+
+// SetNillableConnectorName sets the "connector_name" field if the given value is not nil.
+func (_u *InboundMessageLogUpdateOne) SetNillableConnectorName(v *string) *InboundMessageLogUpdateOne {
+	if v != nil {
+		_u.SetConnectorName(*v)
+	}
+	return _u
+}
+
+// SetMessageID sets the "message_id" field.
+func (_u *InboundMessageLogUpdateOne) SetMessageID(v string) *InboundMessageLogUpdateOne {
+	_u.mutation.SetMessageID(v)
+	return _u
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_u *InboundMessageLogUpdateOne) SetNillableMessageID(v *string) *InboundMessageLogUpdateOne {
+	if v != nil {
+		_u.SetMessageID(*v)
+	}
+	return _u
+}
+
+// ClearMessageID clears the value of the "message_id" field.
+func (_u *InboundMessageLogUpdateOne) ClearMessageID() *InboundMessageLogUpdateOne {
+	_u.mutation.ClearMessageID()
+	return _u
+}
+
+// SetDedupKey sets the "dedup_key" field.
+func (_u *InboundMessageLogUpdateOne) SetDedupKey(v string) *InboundMessageLogUpdateOne {
+	_u.mutation.SetDedupKey(v)
+	return _u
+}
+
+// SetNillableDedupKey sets the "dedup_key" field if the given value is not nil.
+func (_u *InboundMessageLogUpdateOne) SetNillableDedupKey(v *string) *InboundMessageLogUpdateOne {
+	if v != nil {
+		_u.SetDedupKey(*v)
+	}
+	return _u
+}
+
+// ClearDedupKey clears the value of the "dedup_key" field.
+func (_u *InboundMessageLogUpdateOne) ClearDedupKey() *InboundMessageLogUpdateOne {
+	_u.mutation.ClearDedupKey()
+	return _u
+}
+
+// SetMessageType sets the "message_type" field.
+func (_u *InboundMessageLogUpdateOne) SetMessageType(v string) *InboundMessageLogUpdateOne {
+	_u.mutation.SetMessageType(v)
+	return _u
+}
+
+// SetNillableMessageType sets the "message_type" field if the given value is not nil.
+func (_u *InboundMessageLogUpdateOne) SetNillableMessageType(v *string) *InboundMessageLogUpdateOne {
+	if v != nil {
+		_u.SetMessageType(*v)
+	}
+	return _u
+}
+
+// ClearMessageType clears the value of the "message_type" field.
+func (_u *InboundMessageLogUpdateOne) ClearMessageType() *InboundMessageLogUpdateOne {
+	_u.mutation.ClearMessageType()
+	return _u
+}
+
+// SetChannel sets the "channel" field.
+func (_u *InboundMessageLogUpdateOne) SetChannel(v string) *InboundMessageLogUpdateOne {
+	_u.mutation.SetChannel(v)
+	return _u
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (_u *InboundMessageLogUpdateOne) SetNillableChannel(v *string) *InboundMessageLogUpdateOne {
+	if v != nil {
+		_u.SetChannel(*v)
+	}
+	return _u
+}
+
+// ClearChannel clears the value of the "channel" field.
+func (_u *InboundMessageLogUpdateOne) ClearChannel() *InboundMessageLogUpdateOne {
+	_u.mutation.ClearChannel()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *InboundMessageLogUpdateOne) SetUserID(v string) *InboundMessageLogUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *InboundMessageLogUpdateOne) SetNillableUserID(v *string) *InboundMessageLogUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *InboundMessageLogUpdateOne) ClearUserID() *InboundMessageLogUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetChatID sets the "chat_id" field.
+func (_u *InboundMessageLogUpdateOne) SetChatID(v string) *InboundMessageLogUpdateOne {
+	_u.mutation.SetChatID(v)
+	return _u
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (_u *InboundMessageLogUpdateOne) SetNillableChatID(v *string) *InboundMessageLogUpdateOne {
+	if v != nil {
+		_u.SetChatID(*v)
+	}
+	return _u
+}
+
+// ClearChatID clears the value of the "chat_id" field.
+func (_u *InboundMessageLogUpdateOne) ClearChatID() *InboundMessageLogUpdateOne {
+	_u.mutation.ClearChatID()
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *InboundMessageLogUpdateOne) SetPayload(v string) *InboundMessageLogUpdateOne {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// SetNillablePayload sets the "payload" field if the given value is not nil.
+func (_u *InboundMessageLogUpdateOne) SetNillablePayload(v *string) *InboundMessageLogUpdateOne {
+	if v != nil {
+		_u.SetPayload(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *InboundMessageLogUpdateOne) SetStatus(v inboundmessagelog.Status) *InboundMessageLogUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *InboundMessageLogUpdateOne) SetNillableStatus(v *inboundmessagelog.Status) *InboundMessageLogUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetHandlerResults sets the "handler_results" field.
+func (_u *InboundMessageLogUpdateOne) SetHandlerResults(v map[string]schema.InboundHandlerResult) *InboundMessageLogUpdateOne {
+	_u.mutation.SetHandlerResults(v)
+	return _u
+}
+
+// ClearHandlerResults clears the value of the "handler_results" field.
+func (_u *InboundMessageLogUpdateOne) ClearHandlerResults() *InboundMessageLogUpdateOne {
+	_u.mutation.ClearHandlerResults()
+	return _u
+}
+
+// SetReplayCount sets the "replay_count" field.
+func (_u *InboundMessageLogUpdateOne) SetReplayCount(v int) *InboundMessageLogUpdateOne {
+	_u.mutation.ResetReplayCount()
+	_u.mutation.SetReplayCount(v)
+	return _u
+}
+
+// SetNillableReplayCount sets the "replay_count" field if the given value is not nil.
+func (_u *InboundMessageLogUpdateOne) SetNillableReplayCount(v *int) *InboundMessageLogUpdateOne {
+	if v != nil {
+		_u.SetReplayCount(*v)
+	}
+	return _u
+}
+
+// AddReplayCount adds value to the "replay_count" field.
+func (_u *InboundMessageLogUpdateOne) AddReplayCount(v int) *InboundMessageLogUpdateOne {
+	_u.mutation.AddReplayCount(v)
+	return _u
+}
+
+// SetLastReplayedAt sets the "last_replayed_at" field.
+func (_u *InboundMessageLogUpdateOne) SetLastReplayedAt(v time.Time) *InboundMessageLogUpdateOne {
+	_u.mutation.SetLastReplayedAt(v)
+	return _u
+}
+
+// SetNillableLastReplayedAt sets the "last_replayed_at" field if the given value is not nil.
+func (_u *InboundMessageLogUpdateOne) SetNillableLastReplayedAt(v *time.Time) *InboundMessageLogUpdateOne {
+	if v != nil {
+		_u.SetLastReplayedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastReplayedAt clears the value of the "last_replayed_at" field.
+func (_u *InboundMessageLogUpdateOne) ClearLastReplayedAt() *InboundMessageLogUpdateOne {
+	_u.mutation.ClearLastReplayedAt()
+	return _u
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (_u *InboundMessageLogUpdateOne) SetReceivedAt(v time.Time) *InboundMessageLogUpdateOne {
+	_u.mutation.SetReceivedAt(v)
+	return _u
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (_u *InboundMessageLogUpdateOne) SetNillableReceivedAt(v *time.Time) *InboundMessageLogUpdateOne {
+	if v != nil {
+		_u.SetReceivedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *InboundMessageLogUpdateOne) SetUpdatedAt(v time.Time) *InboundMessageLogUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the InboundMessageLogMutation object of the builder.
+func (_u *InboundMessageLogUpdateOne) Mutation() *InboundMessageLogMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the InboundMessageLogUpdate builder.
+func (_u *InboundMessageLogUpdateOne) Where(ps ...predicate.InboundMessageLog) *InboundMessageLogUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *InboundMessageLogUpdateOne) Select(field string, fields ...string) *InboundMessageLogUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated InboundMessageLog entity.
+func (_u *InboundMessageLogUpdateOne) Save(ctx context.Context) (*InboundMessageLog, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InboundMessageLogUpdateOne) SaveX(ctx context.Context) *InboundMessageLog {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *InboundMessageLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InboundMessageLogUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *InboundMessageLogUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := inboundmessagelog.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InboundMessageLogUpdateOne) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := inboundmessagelog.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "InboundMessageLog.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ConnectorName(); ok {
+		if err := inboundmessagelog.ConnectorNameValidator(v); err != nil {
+			return &ValidationError{Name: "connector_name", err: fmt.Errorf(`ent: validator failed for field "InboundMessageLog.connector_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := inboundmessagelog.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "InboundMessageLog.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ReplayCount(); ok {
+		if err := inboundmessagelog.ReplayCountValidator(v); err != nil {
+			return &ValidationError{Name: "replay_count", err: fmt.Errorf(`ent: validator failed for field "InboundMessageLog.replay_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *InboundMessageLogUpdateOne) sqlSave(ctx context.Context) (_node *InboundMessageLog, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(inboundmessagelog.Table, inboundmessagelog.Columns, sqlgraph.NewFieldSpec(inboundmessagelog.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "InboundMessageLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, inboundmessagelog.FieldID)
+		for _, f := range fields {
+			if !inboundmessagelog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != inboundmessagelog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(inboundmessagelog.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(inboundmessagelog.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ConnectorName(); ok {
+		_spec.SetField(inboundmessagelog.FieldConnectorName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MessageID(); ok {
+		_spec.SetField(inboundmessagelog.FieldMessageID, field.TypeString, value)
+	}
+	if _u.mutation.MessageIDCleared() {
+		_spec.ClearField(inboundmessagelog.FieldMessageID, field.TypeString)
+	}
+	if value, ok := _u.mutation.DedupKey(); ok {
+		_spec.SetField(inboundmessagelog.FieldDedupKey, field.TypeString, value)
+	}
+	if _u.mutation.DedupKeyCleared() {
+		_spec.ClearField(inboundmessagelog.FieldDedupKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.MessageType(); ok {
+		_spec.SetField(inboundmessagelog.FieldMessageType, field.TypeString, value)
+	}
+	if _u.mutation.MessageTypeCleared() {
+		_spec.ClearField(inboundmessagelog.FieldMessageType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Channel(); ok {
+		_spec.SetField(inboundmessagelog.FieldChannel, field.TypeString, value)
+	}
+	if _u.mutation.ChannelCleared() {
+		_spec.ClearField(inboundmessagelog.FieldChannel, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(inboundmessagelog.FieldUserID, field.TypeString, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(inboundmessagelog.FieldUserID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ChatID(); ok {
+		_spec.SetField(inboundmessagelog.FieldChatID, field.TypeString, value)
+	}
+	if _u.mutation.ChatIDCleared() {
+		_spec.ClearField(inboundmessagelog.FieldChatID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(inboundmessagelog.FieldPayload, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(inboundmessagelog.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.HandlerResults(); ok {
+		_spec.SetField(inboundmessagelog.FieldHandlerResults, field.TypeJSON, value)
+	}
+	if _u.mutation.HandlerResultsCleared() {
+		_spec.ClearField(inboundmessagelog.FieldHandlerResults, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ReplayCount(); ok {
+		_spec.SetField(inboundmessagelog.FieldReplayCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReplayCount(); ok {
+		_spec.AddField(inboundmessagelog.FieldReplayCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastReplayedAt(); ok {
+		_spec.SetField(inboundmessagelog.FieldLastReplayedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastReplayedAtCleared() {
+		_spec.ClearField(inboundmessagelog.FieldLastReplayedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReceivedAt(); ok {
+		_spec.SetField(inboundmessagelog.FieldReceivedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(inboundmessagelog.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &InboundMessageLog{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{inboundmessagelog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -32,9 +32,9 @@ type IncidentEscalationRule struct {
 	FromStatus string `json:"from_status,omitempty"`
 	// 目标状态
 	ToStatus string `json:"to_status,omitempty"`
-	// 目标分配类型: group/role/user
+	// 目标分配类型: group/role/user/oncall（oncall 时 target_assignee_id 为值班表ID）
 	TargetAssigneeType string `json:"target_assignee_type,omitempty"`
-	// 目标分配人ID
+	// 目标分配人ID或值班表ID
 	TargetAssigneeID int `json:"target_assignee_id,omitempty"`
 	// 目标组
 	TargetGroup string `json:"target_group,omitempty"`
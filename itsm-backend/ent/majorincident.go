@@ -0,0 +1,346 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"itsm-backend/ent/majorincident"
+	"itsm-backend/ent/schema"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// MajorIncident is the model entity for the MajorIncident schema.
+type MajorIncident struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 租户ID
+	TenantID int `json:"tenant_id,omitempty"`
+	// 事件ID
+	IncidentID int `json:"incident_id,omitempty"`
+	// 事件指挥官用户ID
+	CommanderID int `json:"commander_id,omitempty"`
+	// 宣告人用户ID
+	DeclaredBy int `json:"declared_by,omitempty"`
+	// 宣告时间
+	DeclaredAt time.Time `json:"declared_at,omitempty"`
+	// 状态：active 处置中（按节奏通报），resolved 已解决
+	Status majorincident.Status `json:"status,omitempty"`
+	// 作战室所用 IM 连接器：feishu / dingtalk / wecom
+	WarRoomConnector string `json:"war_room_connector,omitempty"`
+	// 作战室群聊ID
+	WarRoomChatID string `json:"war_room_chat_id,omitempty"`
+	// 向作战室发消息使用的 Message.Channel
+	WarRoomChannel string `json:"war_room_channel,omitempty"`
+	// 作战室创建失败原因，不影响宣告本身
+	WarRoomError string `json:"war_room_error,omitempty"`
+	// 接收站内进展通报的干系人用户ID
+	StakeholderUserIds []int `json:"stakeholder_user_ids,omitempty"`
+	// 接收进展通报的外部通道（连接器 + 通道）
+	StakeholderChannels []schema.StakeholderChannel `json:"stakeholder_channels,omitempty"`
+	// 干系人进展通报间隔（分钟）
+	UpdateCadenceMinutes int `json:"update_cadence_minutes,omitempty"`
+	// 下一次进展通报时间，停止通报时为空
+	NextUpdateAt *time.Time `json:"next_update_at,omitempty"`
+	// 最近一次进展通报时间
+	LastUpdateAt *time.Time `json:"last_update_at,omitempty"`
+	// 已发送的进展通报次数
+	UpdateCount int `json:"update_count,omitempty"`
+	// 事件解决时间
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+	// 事后复盘草稿（Markdown）
+	PostmortemDraft string `json:"postmortem_draft,omitempty"`
+	// 复盘草稿生成时间
+	PostmortemGeneratedAt *time.Time `json:"postmortem_generated_at,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MajorIncident) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case majorincident.FieldStakeholderUserIds, majorincident.FieldStakeholderChannels:
+			values[i] = new([]byte)
+		case majorincident.FieldID, majorincident.FieldTenantID, majorincident.FieldIncidentID, majorincident.FieldCommanderID, majorincident.FieldDeclaredBy, majorincident.FieldUpdateCadenceMinutes, majorincident.FieldUpdateCount:
+			values[i] = new(sql.NullInt64)
+		case majorincident.FieldStatus, majorincident.FieldWarRoomConnector, majorincident.FieldWarRoomChatID, majorincident.FieldWarRoomChannel, majorincident.FieldWarRoomError, majorincident.FieldPostmortemDraft:
+			values[i] = new(sql.NullString)
+		case majorincident.FieldDeclaredAt, majorincident.FieldNextUpdateAt, majorincident.FieldLastUpdateAt, majorincident.FieldResolvedAt, majorincident.FieldPostmortemGeneratedAt, majorincident.FieldCreatedAt, majorincident.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MajorIncident fields.
+func (_m *MajorIncident) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case majorincident.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case majorincident.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = int(value.Int64)
+			}
+		case majorincident.FieldIncidentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field incident_id", values[i])
+			} else if value.Valid {
+				_m.IncidentID = int(value.Int64)
+			}
+		case majorincident.FieldCommanderID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field commander_id", values[i])
+			} else if value.Valid {
+				_m.CommanderID = int(value.Int64)
+			}
+		case majorincident.FieldDeclaredBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field declared_by", values[i])
+			} else if value.Valid {
+				_m.DeclaredBy = int(value.Int64)
+			}
+		case majorincident.FieldDeclaredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field declared_at", values[i])
+			} else if value.Valid {
+				_m.DeclaredAt = value.Time
+			}
+		case majorincident.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = majorincident.Status(value.String)
+			}
+		case majorincident.FieldWarRoomConnector:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field war_room_connector", values[i])
+			} else if value.Valid {
+				_m.WarRoomConnector = value.String
+			}
+		case majorincident.FieldWarRoomChatID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field war_room_chat_id", values[i])
+			} else if value.Valid {
+				_m.WarRoomChatID = value.String
+			}
+		case majorincident.FieldWarRoomChannel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field war_room_channel", values[i])
+			} else if value.Valid {
+				_m.WarRoomChannel = value.String
+			}
+		case majorincident.FieldWarRoomError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field war_room_error", values[i])
+			} else if value.Valid {
+				_m.WarRoomError = value.String
+			}
+		case majorincident.FieldStakeholderUserIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field stakeholder_user_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.StakeholderUserIds); err != nil {
+					return fmt.Errorf("unmarshal field stakeholder_user_ids: %w", err)
+				}
+			}
+		case majorincident.FieldStakeholderChannels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field stakeholder_channels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.StakeholderChannels); err != nil {
+					return fmt.Errorf("unmarshal field stakeholder_channels: %w", err)
+				}
+			}
+		case majorincident.FieldUpdateCadenceMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field update_cadence_minutes", values[i])
+			} else if value.Valid {
+				_m.UpdateCadenceMinutes = int(value.Int64)
+			}
+		case majorincident.FieldNextUpdateAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_update_at", values[i])
+			} else if value.Valid {
+				_m.NextUpdateAt = new(time.Time)
+				*_m.NextUpdateAt = value.Time
+			}
+		case majorincident.FieldLastUpdateAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_update_at", values[i])
+			} else if value.Valid {
+				_m.LastUpdateAt = new(time.Time)
+				*_m.LastUpdateAt = value.Time
+			}
+		case majorincident.FieldUpdateCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field update_count", values[i])
+			} else if value.Valid {
+				_m.UpdateCount = int(value.Int64)
+			}
+		case majorincident.FieldResolvedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_at", values[i])
+			} else if value.Valid {
+				_m.ResolvedAt = new(time.Time)
+				*_m.ResolvedAt = value.Time
+			}
+		case majorincident.FieldPostmortemDraft:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field postmortem_draft", values[i])
+			} else if value.Valid {
+				_m.PostmortemDraft = value.String
+			}
+		case majorincident.FieldPostmortemGeneratedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field postmortem_generated_at", values[i])
+			} else if value.Valid {
+				_m.PostmortemGeneratedAt = new(time.Time)
+				*_m.PostmortemGeneratedAt = value.Time
+			}
+		case majorincident.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case majorincident.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MajorIncident.
+// This includes values selected through modifiers, order, etc.
+func (_m *MajorIncident) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this MajorIncident.
+// Note that you need to call MajorIncident.Unwrap() before calling this method if this MajorIncident
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *MajorIncident) Update() *MajorIncidentUpdateOne {
+	return NewMajorIncidentClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the MajorIncident entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *MajorIncident) Unwrap() *MajorIncident {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MajorIncident is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *MajorIncident) String() string {
+	var builder strings.Builder
+	builder.WriteString("MajorIncident(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("incident_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IncidentID))
+	builder.WriteString(", ")
+	builder.WriteString("commander_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CommanderID))
+	builder.WriteString(", ")
+	builder.WriteString("declared_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeclaredBy))
+	builder.WriteString(", ")
+	builder.WriteString("declared_at=")
+	builder.WriteString(_m.DeclaredAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("war_room_connector=")
+	builder.WriteString(_m.WarRoomConnector)
+	builder.WriteString(", ")
+	builder.WriteString("war_room_chat_id=")
+	builder.WriteString(_m.WarRoomChatID)
+	builder.WriteString(", ")
+	builder.WriteString("war_room_channel=")
+	builder.WriteString(_m.WarRoomChannel)
+	builder.WriteString(", ")
+	builder.WriteString("war_room_error=")
+	builder.WriteString(_m.WarRoomError)
+	builder.WriteString(", ")
+	builder.WriteString("stakeholder_user_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StakeholderUserIds))
+	builder.WriteString(", ")
+	builder.WriteString("stakeholder_channels=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StakeholderChannels))
+	builder.WriteString(", ")
+	builder.WriteString("update_cadence_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdateCadenceMinutes))
+	builder.WriteString(", ")
+	if v := _m.NextUpdateAt; v != nil {
+		builder.WriteString("next_update_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastUpdateAt; v != nil {
+		builder.WriteString("last_update_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("update_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UpdateCount))
+	builder.WriteString(", ")
+	if v := _m.ResolvedAt; v != nil {
+		builder.WriteString("resolved_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("postmortem_draft=")
+	builder.WriteString(_m.PostmortemDraft)
+	builder.WriteString(", ")
+	if v := _m.PostmortemGeneratedAt; v != nil {
+		builder.WriteString("postmortem_generated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MajorIncidents is a parsable slice of MajorIncident.
+type MajorIncidents []*MajorIncident
@@ -0,0 +1,253 @@
+// Code generated by ent, DO NOT EDIT.
+
+package majorincident
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the majorincident type in the database.
+	Label = "major_incident"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldIncidentID holds the string denoting the incident_id field in the database.
+	FieldIncidentID = "incident_id"
+	// FieldCommanderID holds the string denoting the commander_id field in the database.
+	FieldCommanderID = "commander_id"
+	// FieldDeclaredBy holds the string denoting the declared_by field in the database.
+	FieldDeclaredBy = "declared_by"
+	// FieldDeclaredAt holds the string denoting the declared_at field in the database.
+	FieldDeclaredAt = "declared_at"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldWarRoomConnector holds the string denoting the war_room_connector field in the database.
+	FieldWarRoomConnector = "war_room_connector"
+	// FieldWarRoomChatID holds the string denoting the war_room_chat_id field in the database.
+	FieldWarRoomChatID = "war_room_chat_id"
+	// FieldWarRoomChannel holds the string denoting the war_room_channel field in the database.
+	FieldWarRoomChannel = "war_room_channel"
+	// FieldWarRoomError holds the string denoting the war_room_error field in the database.
+	FieldWarRoomError = "war_room_error"
+	// FieldStakeholderUserIds holds the string denoting the stakeholder_user_ids field in the database.
+	FieldStakeholderUserIds = "stakeholder_user_ids"
+	// FieldStakeholderChannels holds the string denoting the stakeholder_channels field in the database.
+	FieldStakeholderChannels = "stakeholder_channels"
+	// FieldUpdateCadenceMinutes holds the string denoting the update_cadence_minutes field in the database.
+	FieldUpdateCadenceMinutes = "update_cadence_minutes"
+	// FieldNextUpdateAt holds the string denoting the next_update_at field in the database.
+	FieldNextUpdateAt = "next_update_at"
+	// FieldLastUpdateAt holds the string denoting the last_update_at field in the database.
+	FieldLastUpdateAt = "last_update_at"
+	// FieldUpdateCount holds the string denoting the update_count field in the database.
+	FieldUpdateCount = "update_count"
+	// FieldResolvedAt holds the string denoting the resolved_at field in the database.
+	FieldResolvedAt = "resolved_at"
+	// FieldPostmortemDraft holds the string denoting the postmortem_draft field in the database.
+	FieldPostmortemDraft = "postmortem_draft"
+	// FieldPostmortemGeneratedAt holds the string denoting the postmortem_generated_at field in the database.
+	FieldPostmortemGeneratedAt = "postmortem_generated_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the majorincident in the database.
+	Table = "major_incidents"
+)
+
+// Columns holds all SQL columns for majorincident fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldIncidentID,
+	FieldCommanderID,
+	FieldDeclaredBy,
+	FieldDeclaredAt,
+	FieldStatus,
+	FieldWarRoomConnector,
+	FieldWarRoomChatID,
+	FieldWarRoomChannel,
+	FieldWarRoomError,
+	FieldStakeholderUserIds,
+	FieldStakeholderChannels,
+	FieldUpdateCadenceMinutes,
+	FieldNextUpdateAt,
+	FieldLastUpdateAt,
+	FieldUpdateCount,
+	FieldResolvedAt,
+	FieldPostmortemDraft,
+	FieldPostmortemGeneratedAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	TenantIDValidator func(int) error
+	// IncidentIDValidator is a validator for the "incident_id" field. It is called by the builders before save.
+	IncidentIDValidator func(int) error
+	// CommanderIDValidator is a validator for the "commander_id" field. It is called by the builders before save.
+	CommanderIDValidator func(int) error
+	// DeclaredByValidator is a validator for the "declared_by" field. It is called by the builders before save.
+	DeclaredByValidator func(int) error
+	// DefaultDeclaredAt holds the default value on creation for the "declared_at" field.
+	DefaultDeclaredAt func() time.Time
+	// DefaultUpdateCadenceMinutes holds the default value on creation for the "update_cadence_minutes" field.
+	DefaultUpdateCadenceMinutes int
+	// UpdateCadenceMinutesValidator is a validator for the "update_cadence_minutes" field. It is called by the builders before save.
+	UpdateCadenceMinutesValidator func(int) error
+	// DefaultUpdateCount holds the default value on creation for the "update_count" field.
+	DefaultUpdateCount int
+	// UpdateCountValidator is a validator for the "update_count" field. It is called by the builders before save.
+	UpdateCountValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusActive is the default value of the Status enum.
+const DefaultStatus = StatusActive
+
+// Status values.
+const (
+	StatusActive   Status = "active"
+	StatusResolved Status = "resolved"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusActive, StatusResolved:
+		return nil
+	default:
+		return fmt.Errorf("majorincident: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the MajorIncident queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByIncidentID orders the results by the incident_id field.
+func ByIncidentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIncidentID, opts...).ToFunc()
+}
+
+// ByCommanderID orders the results by the commander_id field.
+func ByCommanderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommanderID, opts...).ToFunc()
+}
+
+// ByDeclaredBy orders the results by the declared_by field.
+func ByDeclaredBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeclaredBy, opts...).ToFunc()
+}
+
+// ByDeclaredAt orders the results by the declared_at field.
+func ByDeclaredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeclaredAt, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByWarRoomConnector orders the results by the war_room_connector field.
+func ByWarRoomConnector(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWarRoomConnector, opts...).ToFunc()
+}
+
+// ByWarRoomChatID orders the results by the war_room_chat_id field.
+func ByWarRoomChatID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWarRoomChatID, opts...).ToFunc()
+}
+
+// ByWarRoomChannel orders the results by the war_room_channel field.
+func ByWarRoomChannel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWarRoomChannel, opts...).ToFunc()
+}
+
+// ByWarRoomError orders the results by the war_room_error field.
+func ByWarRoomError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWarRoomError, opts...).ToFunc()
+}
+
+// ByUpdateCadenceMinutes orders the results by the update_cadence_minutes field.
+func ByUpdateCadenceMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateCadenceMinutes, opts...).ToFunc()
+}
+
+// ByNextUpdateAt orders the results by the next_update_at field.
+func ByNextUpdateAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextUpdateAt, opts...).ToFunc()
+}
+
+// ByLastUpdateAt orders the results by the last_update_at field.
+func ByLastUpdateAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastUpdateAt, opts...).ToFunc()
+}
+
+// ByUpdateCount orders the results by the update_count field.
+func ByUpdateCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateCount, opts...).ToFunc()
+}
+
+// ByResolvedAt orders the results by the resolved_at field.
+func ByResolvedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolvedAt, opts...).ToFunc()
+}
+
+// ByPostmortemDraft orders the results by the postmortem_draft field.
+func ByPostmortemDraft(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPostmortemDraft, opts...).ToFunc()
+}
+
+// ByPostmortemGeneratedAt orders the results by the postmortem_generated_at field.
+func ByPostmortemGeneratedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPostmortemGeneratedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,1135 @@
+// Code generated by ent, DO NOT EDIT.
+
+package majorincident
+
+import (
+	"itsm-backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldTenantID, v))
+}
+
+// IncidentID applies equality check predicate on the "incident_id" field. It's identical to IncidentIDEQ.
+func IncidentID(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldIncidentID, v))
+}
+
+// CommanderID applies equality check predicate on the "commander_id" field. It's identical to CommanderIDEQ.
+func CommanderID(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldCommanderID, v))
+}
+
+// DeclaredBy applies equality check predicate on the "declared_by" field. It's identical to DeclaredByEQ.
+func DeclaredBy(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldDeclaredBy, v))
+}
+
+// DeclaredAt applies equality check predicate on the "declared_at" field. It's identical to DeclaredAtEQ.
+func DeclaredAt(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldDeclaredAt, v))
+}
+
+// WarRoomConnector applies equality check predicate on the "war_room_connector" field. It's identical to WarRoomConnectorEQ.
+func WarRoomConnector(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldWarRoomConnector, v))
+}
+
+// WarRoomChatID applies equality check predicate on the "war_room_chat_id" field. It's identical to WarRoomChatIDEQ.
+func WarRoomChatID(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldWarRoomChatID, v))
+}
+
+// WarRoomChannel applies equality check predicate on the "war_room_channel" field. It's identical to WarRoomChannelEQ.
+func WarRoomChannel(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldWarRoomChannel, v))
+}
+
+// WarRoomError applies equality check predicate on the "war_room_error" field. It's identical to WarRoomErrorEQ.
+func WarRoomError(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldWarRoomError, v))
+}
+
+// UpdateCadenceMinutes applies equality check predicate on the "update_cadence_minutes" field. It's identical to UpdateCadenceMinutesEQ.
+func UpdateCadenceMinutes(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldUpdateCadenceMinutes, v))
+}
+
+// NextUpdateAt applies equality check predicate on the "next_update_at" field. It's identical to NextUpdateAtEQ.
+func NextUpdateAt(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldNextUpdateAt, v))
+}
+
+// LastUpdateAt applies equality check predicate on the "last_update_at" field. It's identical to LastUpdateAtEQ.
+func LastUpdateAt(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldLastUpdateAt, v))
+}
+
+// UpdateCount applies equality check predicate on the "update_count" field. It's identical to UpdateCountEQ.
+func UpdateCount(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldUpdateCount, v))
+}
+
+// ResolvedAt applies equality check predicate on the "resolved_at" field. It's identical to ResolvedAtEQ.
+func ResolvedAt(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// PostmortemDraft applies equality check predicate on the "postmortem_draft" field. It's identical to PostmortemDraftEQ.
+func PostmortemDraft(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldPostmortemDraft, v))
+}
+
+// PostmortemGeneratedAt applies equality check predicate on the "postmortem_generated_at" field. It's identical to PostmortemGeneratedAtEQ.
+func PostmortemGeneratedAt(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldPostmortemGeneratedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLTE(FieldTenantID, v))
+}
+
+// IncidentIDEQ applies the EQ predicate on the "incident_id" field.
+func IncidentIDEQ(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldIncidentID, v))
+}
+
+// IncidentIDNEQ applies the NEQ predicate on the "incident_id" field.
+func IncidentIDNEQ(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNEQ(FieldIncidentID, v))
+}
+
+// IncidentIDIn applies the In predicate on the "incident_id" field.
+func IncidentIDIn(vs ...int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldIn(FieldIncidentID, vs...))
+}
+
+// IncidentIDNotIn applies the NotIn predicate on the "incident_id" field.
+func IncidentIDNotIn(vs ...int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNotIn(FieldIncidentID, vs...))
+}
+
+// IncidentIDGT applies the GT predicate on the "incident_id" field.
+func IncidentIDGT(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGT(FieldIncidentID, v))
+}
+
+// IncidentIDGTE applies the GTE predicate on the "incident_id" field.
+func IncidentIDGTE(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGTE(FieldIncidentID, v))
+}
+
+// IncidentIDLT applies the LT predicate on the "incident_id" field.
+func IncidentIDLT(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLT(FieldIncidentID, v))
+}
+
+// IncidentIDLTE applies the LTE predicate on the "incident_id" field.
+func IncidentIDLTE(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLTE(FieldIncidentID, v))
+}
+
+// CommanderIDEQ applies the EQ predicate on the "commander_id" field.
+func CommanderIDEQ(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldCommanderID, v))
+}
+
+// CommanderIDNEQ applies the NEQ predicate on the "commander_id" field.
+func CommanderIDNEQ(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNEQ(FieldCommanderID, v))
+}
+
+// CommanderIDIn applies the In predicate on the "commander_id" field.
+func CommanderIDIn(vs ...int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldIn(FieldCommanderID, vs...))
+}
+
+// CommanderIDNotIn applies the NotIn predicate on the "commander_id" field.
+func CommanderIDNotIn(vs ...int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNotIn(FieldCommanderID, vs...))
+}
+
+// CommanderIDGT applies the GT predicate on the "commander_id" field.
+func CommanderIDGT(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGT(FieldCommanderID, v))
+}
+
+// CommanderIDGTE applies the GTE predicate on the "commander_id" field.
+func CommanderIDGTE(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGTE(FieldCommanderID, v))
+}
+
+// CommanderIDLT applies the LT predicate on the "commander_id" field.
+func CommanderIDLT(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLT(FieldCommanderID, v))
+}
+
+// CommanderIDLTE applies the LTE predicate on the "commander_id" field.
+func CommanderIDLTE(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLTE(FieldCommanderID, v))
+}
+
+// DeclaredByEQ applies the EQ predicate on the "declared_by" field.
+func DeclaredByEQ(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldDeclaredBy, v))
+}
+
+// DeclaredByNEQ applies the NEQ predicate on the "declared_by" field.
+func DeclaredByNEQ(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNEQ(FieldDeclaredBy, v))
+}
+
+// DeclaredByIn applies the In predicate on the "declared_by" field.
+func DeclaredByIn(vs ...int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldIn(FieldDeclaredBy, vs...))
+}
+
+// DeclaredByNotIn applies the NotIn predicate on the "declared_by" field.
+func DeclaredByNotIn(vs ...int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNotIn(FieldDeclaredBy, vs...))
+}
+
+// DeclaredByGT applies the GT predicate on the "declared_by" field.
+func DeclaredByGT(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGT(FieldDeclaredBy, v))
+}
+
+// DeclaredByGTE applies the GTE predicate on the "declared_by" field.
+func DeclaredByGTE(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGTE(FieldDeclaredBy, v))
+}
+
+// DeclaredByLT applies the LT predicate on the "declared_by" field.
+func DeclaredByLT(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLT(FieldDeclaredBy, v))
+}
+
+// DeclaredByLTE applies the LTE predicate on the "declared_by" field.
+func DeclaredByLTE(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLTE(FieldDeclaredBy, v))
+}
+
+// DeclaredAtEQ applies the EQ predicate on the "declared_at" field.
+func DeclaredAtEQ(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldDeclaredAt, v))
+}
+
+// DeclaredAtNEQ applies the NEQ predicate on the "declared_at" field.
+func DeclaredAtNEQ(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNEQ(FieldDeclaredAt, v))
+}
+
+// DeclaredAtIn applies the In predicate on the "declared_at" field.
+func DeclaredAtIn(vs ...time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldIn(FieldDeclaredAt, vs...))
+}
+
+// DeclaredAtNotIn applies the NotIn predicate on the "declared_at" field.
+func DeclaredAtNotIn(vs ...time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNotIn(FieldDeclaredAt, vs...))
+}
+
+// DeclaredAtGT applies the GT predicate on the "declared_at" field.
+func DeclaredAtGT(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGT(FieldDeclaredAt, v))
+}
+
+// DeclaredAtGTE applies the GTE predicate on the "declared_at" field.
+func DeclaredAtGTE(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGTE(FieldDeclaredAt, v))
+}
+
+// DeclaredAtLT applies the LT predicate on the "declared_at" field.
+func DeclaredAtLT(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLT(FieldDeclaredAt, v))
+}
+
+// DeclaredAtLTE applies the LTE predicate on the "declared_at" field.
+func DeclaredAtLTE(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLTE(FieldDeclaredAt, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// WarRoomConnectorEQ applies the EQ predicate on the "war_room_connector" field.
+func WarRoomConnectorEQ(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldWarRoomConnector, v))
+}
+
+// WarRoomConnectorNEQ applies the NEQ predicate on the "war_room_connector" field.
+func WarRoomConnectorNEQ(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNEQ(FieldWarRoomConnector, v))
+}
+
+// WarRoomConnectorIn applies the In predicate on the "war_room_connector" field.
+func WarRoomConnectorIn(vs ...string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldIn(FieldWarRoomConnector, vs...))
+}
+
+// WarRoomConnectorNotIn applies the NotIn predicate on the "war_room_connector" field.
+func WarRoomConnectorNotIn(vs ...string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNotIn(FieldWarRoomConnector, vs...))
+}
+
+// WarRoomConnectorGT applies the GT predicate on the "war_room_connector" field.
+func WarRoomConnectorGT(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGT(FieldWarRoomConnector, v))
+}
+
+// WarRoomConnectorGTE applies the GTE predicate on the "war_room_connector" field.
+func WarRoomConnectorGTE(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGTE(FieldWarRoomConnector, v))
+}
+
+// WarRoomConnectorLT applies the LT predicate on the "war_room_connector" field.
+func WarRoomConnectorLT(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLT(FieldWarRoomConnector, v))
+}
+
+// WarRoomConnectorLTE applies the LTE predicate on the "war_room_connector" field.
+func WarRoomConnectorLTE(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLTE(FieldWarRoomConnector, v))
+}
+
+// WarRoomConnectorContains applies the Contains predicate on the "war_room_connector" field.
+func WarRoomConnectorContains(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldContains(FieldWarRoomConnector, v))
+}
+
+// WarRoomConnectorHasPrefix applies the HasPrefix predicate on the "war_room_connector" field.
+func WarRoomConnectorHasPrefix(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldHasPrefix(FieldWarRoomConnector, v))
+}
+
+// WarRoomConnectorHasSuffix applies the HasSuffix predicate on the "war_room_connector" field.
+func WarRoomConnectorHasSuffix(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldHasSuffix(FieldWarRoomConnector, v))
+}
+
+// WarRoomConnectorIsNil applies the IsNil predicate on the "war_room_connector" field.
+func WarRoomConnectorIsNil() predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldIsNull(FieldWarRoomConnector))
+}
+
+// WarRoomConnectorNotNil applies the NotNil predicate on the "war_room_connector" field.
+func WarRoomConnectorNotNil() predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNotNull(FieldWarRoomConnector))
+}
+
+// WarRoomConnectorEqualFold applies the EqualFold predicate on the "war_room_connector" field.
+func WarRoomConnectorEqualFold(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEqualFold(FieldWarRoomConnector, v))
+}
+
+// WarRoomConnectorContainsFold applies the ContainsFold predicate on the "war_room_connector" field.
+func WarRoomConnectorContainsFold(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldContainsFold(FieldWarRoomConnector, v))
+}
+
+// WarRoomChatIDEQ applies the EQ predicate on the "war_room_chat_id" field.
+func WarRoomChatIDEQ(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldWarRoomChatID, v))
+}
+
+// WarRoomChatIDNEQ applies the NEQ predicate on the "war_room_chat_id" field.
+func WarRoomChatIDNEQ(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNEQ(FieldWarRoomChatID, v))
+}
+
+// WarRoomChatIDIn applies the In predicate on the "war_room_chat_id" field.
+func WarRoomChatIDIn(vs ...string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldIn(FieldWarRoomChatID, vs...))
+}
+
+// WarRoomChatIDNotIn applies the NotIn predicate on the "war_room_chat_id" field.
+func WarRoomChatIDNotIn(vs ...string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNotIn(FieldWarRoomChatID, vs...))
+}
+
+// WarRoomChatIDGT applies the GT predicate on the "war_room_chat_id" field.
+func WarRoomChatIDGT(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGT(FieldWarRoomChatID, v))
+}
+
+// WarRoomChatIDGTE applies the GTE predicate on the "war_room_chat_id" field.
+func WarRoomChatIDGTE(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGTE(FieldWarRoomChatID, v))
+}
+
+// WarRoomChatIDLT applies the LT predicate on the "war_room_chat_id" field.
+func WarRoomChatIDLT(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLT(FieldWarRoomChatID, v))
+}
+
+// WarRoomChatIDLTE applies the LTE predicate on the "war_room_chat_id" field.
+func WarRoomChatIDLTE(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLTE(FieldWarRoomChatID, v))
+}
+
+// WarRoomChatIDContains applies the Contains predicate on the "war_room_chat_id" field.
+func WarRoomChatIDContains(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldContains(FieldWarRoomChatID, v))
+}
+
+// WarRoomChatIDHasPrefix applies the HasPrefix predicate on the "war_room_chat_id" field.
+func WarRoomChatIDHasPrefix(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldHasPrefix(FieldWarRoomChatID, v))
+}
+
+// WarRoomChatIDHasSuffix applies the HasSuffix predicate on the "war_room_chat_id" field.
+func WarRoomChatIDHasSuffix(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldHasSuffix(FieldWarRoomChatID, v))
+}
+
+// WarRoomChatIDIsNil applies the IsNil predicate on the "war_room_chat_id" field.
+func WarRoomChatIDIsNil() predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldIsNull(FieldWarRoomChatID))
+}
+
+// WarRoomChatIDNotNil applies the NotNil predicate on the "war_room_chat_id" field.
+func WarRoomChatIDNotNil() predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNotNull(FieldWarRoomChatID))
+}
+
+// WarRoomChatIDEqualFold applies the EqualFold predicate on the "war_room_chat_id" field.
+func WarRoomChatIDEqualFold(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEqualFold(FieldWarRoomChatID, v))
+}
+
+// WarRoomChatIDContainsFold applies the ContainsFold predicate on the "war_room_chat_id" field.
+func WarRoomChatIDContainsFold(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldContainsFold(FieldWarRoomChatID, v))
+}
+
+// WarRoomChannelEQ applies the EQ predicate on the "war_room_channel" field.
+func WarRoomChannelEQ(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldWarRoomChannel, v))
+}
+
+// WarRoomChannelNEQ applies the NEQ predicate on the "war_room_channel" field.
+func WarRoomChannelNEQ(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNEQ(FieldWarRoomChannel, v))
+}
+
+// WarRoomChannelIn applies the In predicate on the "war_room_channel" field.
+func WarRoomChannelIn(vs ...string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldIn(FieldWarRoomChannel, vs...))
+}
+
+// WarRoomChannelNotIn applies the NotIn predicate on the "war_room_channel" field.
+func WarRoomChannelNotIn(vs ...string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNotIn(FieldWarRoomChannel, vs...))
+}
+
+// WarRoomChannelGT applies the GT predicate on the "war_room_channel" field.
+func WarRoomChannelGT(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGT(FieldWarRoomChannel, v))
+}
+
+// WarRoomChannelGTE applies the GTE predicate on the "war_room_channel" field.
+func WarRoomChannelGTE(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGTE(FieldWarRoomChannel, v))
+}
+
+// WarRoomChannelLT applies the LT predicate on the "war_room_channel" field.
+func WarRoomChannelLT(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLT(FieldWarRoomChannel, v))
+}
+
+// WarRoomChannelLTE applies the LTE predicate on the "war_room_channel" field.
+func WarRoomChannelLTE(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLTE(FieldWarRoomChannel, v))
+}
+
+// WarRoomChannelContains applies the Contains predicate on the "war_room_channel" field.
+func WarRoomChannelContains(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldContains(FieldWarRoomChannel, v))
+}
+
+// WarRoomChannelHasPrefix applies the HasPrefix predicate on the "war_room_channel" field.
+func WarRoomChannelHasPrefix(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldHasPrefix(FieldWarRoomChannel, v))
+}
+
+// WarRoomChannelHasSuffix applies the HasSuffix predicate on the "war_room_channel" field.
+func WarRoomChannelHasSuffix(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldHasSuffix(FieldWarRoomChannel, v))
+}
+
+// WarRoomChannelIsNil applies the IsNil predicate on the "war_room_channel" field.
+func WarRoomChannelIsNil() predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldIsNull(FieldWarRoomChannel))
+}
+
+// WarRoomChannelNotNil applies the NotNil predicate on the "war_room_channel" field.
+func WarRoomChannelNotNil() predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNotNull(FieldWarRoomChannel))
+}
+
+// WarRoomChannelEqualFold applies the EqualFold predicate on the "war_room_channel" field.
+func WarRoomChannelEqualFold(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEqualFold(FieldWarRoomChannel, v))
+}
+
+// WarRoomChannelContainsFold applies the ContainsFold predicate on the "war_room_channel" field.
+func WarRoomChannelContainsFold(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldContainsFold(FieldWarRoomChannel, v))
+}
+
+// WarRoomErrorEQ applies the EQ predicate on the "war_room_error" field.
+func WarRoomErrorEQ(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldWarRoomError, v))
+}
+
+// WarRoomErrorNEQ applies the NEQ predicate on the "war_room_error" field.
+func WarRoomErrorNEQ(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNEQ(FieldWarRoomError, v))
+}
+
+// WarRoomErrorIn applies the In predicate on the "war_room_error" field.
+func WarRoomErrorIn(vs ...string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldIn(FieldWarRoomError, vs...))
+}
+
+// WarRoomErrorNotIn applies the NotIn predicate on the "war_room_error" field.
+func WarRoomErrorNotIn(vs ...string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNotIn(FieldWarRoomError, vs...))
+}
+
+// WarRoomErrorGT applies the GT predicate on the "war_room_error" field.
+func WarRoomErrorGT(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGT(FieldWarRoomError, v))
+}
+
+// WarRoomErrorGTE applies the GTE predicate on the "war_room_error" field.
+func WarRoomErrorGTE(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGTE(FieldWarRoomError, v))
+}
+
+// WarRoomErrorLT applies the LT predicate on the "war_room_error" field.
+func WarRoomErrorLT(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLT(FieldWarRoomError, v))
+}
+
+// WarRoomErrorLTE applies the LTE predicate on the "war_room_error" field.
+func WarRoomErrorLTE(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLTE(FieldWarRoomError, v))
+}
+
+// WarRoomErrorContains applies the Contains predicate on the "war_room_error" field.
+func WarRoomErrorContains(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldContains(FieldWarRoomError, v))
+}
+
+// WarRoomErrorHasPrefix applies the HasPrefix predicate on the "war_room_error" field.
+func WarRoomErrorHasPrefix(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldHasPrefix(FieldWarRoomError, v))
+}
+
+// WarRoomErrorHasSuffix applies the HasSuffix predicate on the "war_room_error" field.
+func WarRoomErrorHasSuffix(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldHasSuffix(FieldWarRoomError, v))
+}
+
+// WarRoomErrorIsNil applies the IsNil predicate on the "war_room_error" field.
+func WarRoomErrorIsNil() predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldIsNull(FieldWarRoomError))
+}
+
+// WarRoomErrorNotNil applies the NotNil predicate on the "war_room_error" field.
+func WarRoomErrorNotNil() predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNotNull(FieldWarRoomError))
+}
+
+// WarRoomErrorEqualFold applies the EqualFold predicate on the "war_room_error" field.
+func WarRoomErrorEqualFold(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEqualFold(FieldWarRoomError, v))
+}
+
+// WarRoomErrorContainsFold applies the ContainsFold predicate on the "war_room_error" field.
+func WarRoomErrorContainsFold(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldContainsFold(FieldWarRoomError, v))
+}
+
+// StakeholderUserIdsIsNil applies the IsNil predicate on the "stakeholder_user_ids" field.
+func StakeholderUserIdsIsNil() predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldIsNull(FieldStakeholderUserIds))
+}
+
+// StakeholderUserIdsNotNil applies the NotNil predicate on the "stakeholder_user_ids" field.
+func StakeholderUserIdsNotNil() predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNotNull(FieldStakeholderUserIds))
+}
+
+// StakeholderChannelsIsNil applies the IsNil predicate on the "stakeholder_channels" field.
+func StakeholderChannelsIsNil() predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldIsNull(FieldStakeholderChannels))
+}
+
+// StakeholderChannelsNotNil applies the NotNil predicate on the "stakeholder_channels" field.
+func StakeholderChannelsNotNil() predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNotNull(FieldStakeholderChannels))
+}
+
+// UpdateCadenceMinutesEQ applies the EQ predicate on the "update_cadence_minutes" field.
+func UpdateCadenceMinutesEQ(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldUpdateCadenceMinutes, v))
+}
+
+// UpdateCadenceMinutesNEQ applies the NEQ predicate on the "update_cadence_minutes" field.
+func UpdateCadenceMinutesNEQ(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNEQ(FieldUpdateCadenceMinutes, v))
+}
+
+// UpdateCadenceMinutesIn applies the In predicate on the "update_cadence_minutes" field.
+func UpdateCadenceMinutesIn(vs ...int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldIn(FieldUpdateCadenceMinutes, vs...))
+}
+
+// UpdateCadenceMinutesNotIn applies the NotIn predicate on the "update_cadence_minutes" field.
+func UpdateCadenceMinutesNotIn(vs ...int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNotIn(FieldUpdateCadenceMinutes, vs...))
+}
+
+// UpdateCadenceMinutesGT applies the GT predicate on the "update_cadence_minutes" field.
+func UpdateCadenceMinutesGT(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGT(FieldUpdateCadenceMinutes, v))
+}
+
+// UpdateCadenceMinutesGTE applies the GTE predicate on the "update_cadence_minutes" field.
+func UpdateCadenceMinutesGTE(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGTE(FieldUpdateCadenceMinutes, v))
+}
+
+// UpdateCadenceMinutesLT applies the LT predicate on the "update_cadence_minutes" field.
+func UpdateCadenceMinutesLT(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLT(FieldUpdateCadenceMinutes, v))
+}
+
+// UpdateCadenceMinutesLTE applies the LTE predicate on the "update_cadence_minutes" field.
+func UpdateCadenceMinutesLTE(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLTE(FieldUpdateCadenceMinutes, v))
+}
+
+// NextUpdateAtEQ applies the EQ predicate on the "next_update_at" field.
+func NextUpdateAtEQ(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldNextUpdateAt, v))
+}
+
+// NextUpdateAtNEQ applies the NEQ predicate on the "next_update_at" field.
+func NextUpdateAtNEQ(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNEQ(FieldNextUpdateAt, v))
+}
+
+// NextUpdateAtIn applies the In predicate on the "next_update_at" field.
+func NextUpdateAtIn(vs ...time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldIn(FieldNextUpdateAt, vs...))
+}
+
+// NextUpdateAtNotIn applies the NotIn predicate on the "next_update_at" field.
+func NextUpdateAtNotIn(vs ...time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNotIn(FieldNextUpdateAt, vs...))
+}
+
+// NextUpdateAtGT applies the GT predicate on the "next_update_at" field.
+func NextUpdateAtGT(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGT(FieldNextUpdateAt, v))
+}
+
+// NextUpdateAtGTE applies the GTE predicate on the "next_update_at" field.
+func NextUpdateAtGTE(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGTE(FieldNextUpdateAt, v))
+}
+
+// NextUpdateAtLT applies the LT predicate on the "next_update_at" field.
+func NextUpdateAtLT(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLT(FieldNextUpdateAt, v))
+}
+
+// NextUpdateAtLTE applies the LTE predicate on the "next_update_at" field.
+func NextUpdateAtLTE(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLTE(FieldNextUpdateAt, v))
+}
+
+// NextUpdateAtIsNil applies the IsNil predicate on the "next_update_at" field.
+func NextUpdateAtIsNil() predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldIsNull(FieldNextUpdateAt))
+}
+
+// NextUpdateAtNotNil applies the NotNil predicate on the "next_update_at" field.
+func NextUpdateAtNotNil() predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNotNull(FieldNextUpdateAt))
+}
+
+// LastUpdateAtEQ applies the EQ predicate on the "last_update_at" field.
+func LastUpdateAtEQ(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldLastUpdateAt, v))
+}
+
+// LastUpdateAtNEQ applies the NEQ predicate on the "last_update_at" field.
+func LastUpdateAtNEQ(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNEQ(FieldLastUpdateAt, v))
+}
+
+// LastUpdateAtIn applies the In predicate on the "last_update_at" field.
+func LastUpdateAtIn(vs ...time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldIn(FieldLastUpdateAt, vs...))
+}
+
+// LastUpdateAtNotIn applies the NotIn predicate on the "last_update_at" field.
+func LastUpdateAtNotIn(vs ...time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNotIn(FieldLastUpdateAt, vs...))
+}
+
+// LastUpdateAtGT applies the GT predicate on the "last_update_at" field.
+func LastUpdateAtGT(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGT(FieldLastUpdateAt, v))
+}
+
+// LastUpdateAtGTE applies the GTE predicate on the "last_update_at" field.
+func LastUpdateAtGTE(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGTE(FieldLastUpdateAt, v))
+}
+
+// LastUpdateAtLT applies the LT predicate on the "last_update_at" field.
+func LastUpdateAtLT(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLT(FieldLastUpdateAt, v))
+}
+
+// LastUpdateAtLTE applies the LTE predicate on the "last_update_at" field.
+func LastUpdateAtLTE(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLTE(FieldLastUpdateAt, v))
+}
+
+// LastUpdateAtIsNil applies the IsNil predicate on the "last_update_at" field.
+func LastUpdateAtIsNil() predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldIsNull(FieldLastUpdateAt))
+}
+
+// LastUpdateAtNotNil applies the NotNil predicate on the "last_update_at" field.
+func LastUpdateAtNotNil() predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNotNull(FieldLastUpdateAt))
+}
+
+// UpdateCountEQ applies the EQ predicate on the "update_count" field.
+func UpdateCountEQ(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldUpdateCount, v))
+}
+
+// UpdateCountNEQ applies the NEQ predicate on the "update_count" field.
+func UpdateCountNEQ(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNEQ(FieldUpdateCount, v))
+}
+
+// UpdateCountIn applies the In predicate on the "update_count" field.
+func UpdateCountIn(vs ...int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldIn(FieldUpdateCount, vs...))
+}
+
+// UpdateCountNotIn applies the NotIn predicate on the "update_count" field.
+func UpdateCountNotIn(vs ...int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNotIn(FieldUpdateCount, vs...))
+}
+
+// UpdateCountGT applies the GT predicate on the "update_count" field.
+func UpdateCountGT(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGT(FieldUpdateCount, v))
+}
+
+// UpdateCountGTE applies the GTE predicate on the "update_count" field.
+func UpdateCountGTE(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGTE(FieldUpdateCount, v))
+}
+
+// UpdateCountLT applies the LT predicate on the "update_count" field.
+func UpdateCountLT(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLT(FieldUpdateCount, v))
+}
+
+// UpdateCountLTE applies the LTE predicate on the "update_count" field.
+func UpdateCountLTE(v int) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLTE(FieldUpdateCount, v))
+}
+
+// ResolvedAtEQ applies the EQ predicate on the "resolved_at" field.
+func ResolvedAtEQ(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtNEQ applies the NEQ predicate on the "resolved_at" field.
+func ResolvedAtNEQ(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtIn applies the In predicate on the "resolved_at" field.
+func ResolvedAtIn(vs ...time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtNotIn applies the NotIn predicate on the "resolved_at" field.
+func ResolvedAtNotIn(vs ...time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNotIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtGT applies the GT predicate on the "resolved_at" field.
+func ResolvedAtGT(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGT(FieldResolvedAt, v))
+}
+
+// ResolvedAtGTE applies the GTE predicate on the "resolved_at" field.
+func ResolvedAtGTE(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtLT applies the LT predicate on the "resolved_at" field.
+func ResolvedAtLT(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLT(FieldResolvedAt, v))
+}
+
+// ResolvedAtLTE applies the LTE predicate on the "resolved_at" field.
+func ResolvedAtLTE(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtIsNil applies the IsNil predicate on the "resolved_at" field.
+func ResolvedAtIsNil() predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldIsNull(FieldResolvedAt))
+}
+
+// ResolvedAtNotNil applies the NotNil predicate on the "resolved_at" field.
+func ResolvedAtNotNil() predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNotNull(FieldResolvedAt))
+}
+
+// PostmortemDraftEQ applies the EQ predicate on the "postmortem_draft" field.
+func PostmortemDraftEQ(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldPostmortemDraft, v))
+}
+
+// PostmortemDraftNEQ applies the NEQ predicate on the "postmortem_draft" field.
+func PostmortemDraftNEQ(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNEQ(FieldPostmortemDraft, v))
+}
+
+// PostmortemDraftIn applies the In predicate on the "postmortem_draft" field.
+func PostmortemDraftIn(vs ...string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldIn(FieldPostmortemDraft, vs...))
+}
+
+// PostmortemDraftNotIn applies the NotIn predicate on the "postmortem_draft" field.
+func PostmortemDraftNotIn(vs ...string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNotIn(FieldPostmortemDraft, vs...))
+}
+
+// PostmortemDraftGT applies the GT predicate on the "postmortem_draft" field.
+func PostmortemDraftGT(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGT(FieldPostmortemDraft, v))
+}
+
+// PostmortemDraftGTE applies the GTE predicate on the "postmortem_draft" field.
+func PostmortemDraftGTE(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGTE(FieldPostmortemDraft, v))
+}
+
+// PostmortemDraftLT applies the LT predicate on the "postmortem_draft" field.
+func PostmortemDraftLT(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLT(FieldPostmortemDraft, v))
+}
+
+// PostmortemDraftLTE applies the LTE predicate on the "postmortem_draft" field.
+func PostmortemDraftLTE(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLTE(FieldPostmortemDraft, v))
+}
+
+// PostmortemDraftContains applies the Contains predicate on the "postmortem_draft" field.
+func PostmortemDraftContains(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldContains(FieldPostmortemDraft, v))
+}
+
+// PostmortemDraftHasPrefix applies the HasPrefix predicate on the "postmortem_draft" field.
+func PostmortemDraftHasPrefix(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldHasPrefix(FieldPostmortemDraft, v))
+}
+
+// PostmortemDraftHasSuffix applies the HasSuffix predicate on the "postmortem_draft" field.
+func PostmortemDraftHasSuffix(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldHasSuffix(FieldPostmortemDraft, v))
+}
+
+// PostmortemDraftIsNil applies the IsNil predicate on the "postmortem_draft" field.
+func PostmortemDraftIsNil() predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldIsNull(FieldPostmortemDraft))
+}
+
+// PostmortemDraftNotNil applies the NotNil predicate on the "postmortem_draft" field.
+func PostmortemDraftNotNil() predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNotNull(FieldPostmortemDraft))
+}
+
+// PostmortemDraftEqualFold applies the EqualFold predicate on the "postmortem_draft" field.
+func PostmortemDraftEqualFold(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEqualFold(FieldPostmortemDraft, v))
+}
+
+// PostmortemDraftContainsFold applies the ContainsFold predicate on the "postmortem_draft" field.
+func PostmortemDraftContainsFold(v string) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldContainsFold(FieldPostmortemDraft, v))
+}
+
+// PostmortemGeneratedAtEQ applies the EQ predicate on the "postmortem_generated_at" field.
+func PostmortemGeneratedAtEQ(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldPostmortemGeneratedAt, v))
+}
+
+// PostmortemGeneratedAtNEQ applies the NEQ predicate on the "postmortem_generated_at" field.
+func PostmortemGeneratedAtNEQ(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNEQ(FieldPostmortemGeneratedAt, v))
+}
+
+// PostmortemGeneratedAtIn applies the In predicate on the "postmortem_generated_at" field.
+func PostmortemGeneratedAtIn(vs ...time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldIn(FieldPostmortemGeneratedAt, vs...))
+}
+
+// PostmortemGeneratedAtNotIn applies the NotIn predicate on the "postmortem_generated_at" field.
+func PostmortemGeneratedAtNotIn(vs ...time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNotIn(FieldPostmortemGeneratedAt, vs...))
+}
+
+// PostmortemGeneratedAtGT applies the GT predicate on the "postmortem_generated_at" field.
+func PostmortemGeneratedAtGT(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGT(FieldPostmortemGeneratedAt, v))
+}
+
+// PostmortemGeneratedAtGTE applies the GTE predicate on the "postmortem_generated_at" field.
+func PostmortemGeneratedAtGTE(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGTE(FieldPostmortemGeneratedAt, v))
+}
+
+// PostmortemGeneratedAtLT applies the LT predicate on the "postmortem_generated_at" field.
+func PostmortemGeneratedAtLT(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLT(FieldPostmortemGeneratedAt, v))
+}
+
+// PostmortemGeneratedAtLTE applies the LTE predicate on the "postmortem_generated_at" field.
+func PostmortemGeneratedAtLTE(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLTE(FieldPostmortemGeneratedAt, v))
+}
+
+// PostmortemGeneratedAtIsNil applies the IsNil predicate on the "postmortem_generated_at" field.
+func PostmortemGeneratedAtIsNil() predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldIsNull(FieldPostmortemGeneratedAt))
+}
+
+// PostmortemGeneratedAtNotNil applies the NotNil predicate on the "postmortem_generated_at" field.
+func PostmortemGeneratedAtNotNil() predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNotNull(FieldPostmortemGeneratedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MajorIncident) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MajorIncident) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MajorIncident) predicate.MajorIncident {
+	return predicate.MajorIncident(sql.NotPredicates(p))
+}
@@ -0,0 +1,597 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/majorincident"
+	"itsm-backend/ent/schema"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MajorIncidentCreate is the builder for creating a MajorIncident entity.
+type MajorIncidentCreate struct {
+	config
+	mutation *MajorIncidentMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *MajorIncidentCreate) SetTenantID(v int) *MajorIncidentCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetIncidentID sets the "incident_id" field.
+func (_c *MajorIncidentCreate) SetIncidentID(v int) *MajorIncidentCreate {
+	_c.mutation.SetIncidentID(v)
+	return _c
+}
+
+// SetCommanderID sets the "commander_id" field.
+func (_c *MajorIncidentCreate) SetCommanderID(v int) *MajorIncidentCreate {
+	_c.mutation.SetCommanderID(v)
+	return _c
+}
+
+// SetDeclaredBy sets the "declared_by" field.
+func (_c *MajorIncidentCreate) SetDeclaredBy(v int) *MajorIncidentCreate {
+	_c.mutation.SetDeclaredBy(v)
+	return _c
+}
+
+// SetDeclaredAt sets the "declared_at" field.
+func (_c *MajorIncidentCreate) SetDeclaredAt(v time.Time) *MajorIncidentCreate {
+	_c.mutation.SetDeclaredAt(v)
+	return _c
+}
+
+// SetNillableDeclaredAt sets the "declared_at" field if the given value is not nil.
+func (_c *MajorIncidentCreate) SetNillableDeclaredAt(v *time.Time) *MajorIncidentCreate {
+	if v != nil {
+		_c.SetDeclaredAt(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *MajorIncidentCreate) SetStatus(v majorincident.Status) *MajorIncidentCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *MajorIncidentCreate) SetNillableStatus(v *majorincident.Status) *MajorIncidentCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetWarRoomConnector sets the "war_room_connector" field.
+func (_c *MajorIncidentCreate) SetWarRoomConnector(v string) *MajorIncidentCreate {
+	_c.mutation.SetWarRoomConnector(v)
+	return _c
+}
+
+// SetNillableWarRoomConnector sets the "war_room_connector" field if the given value is not nil.
+func (_c *MajorIncidentCreate) SetNillableWarRoomConnector(v *string) *MajorIncidentCreate {
+	if v != nil {
+		_c.SetWarRoomConnector(*v)
+	}
+	return _c
+}
+
+// SetWarRoomChatID sets the "war_room_chat_id" field.
+func (_c *MajorIncidentCreate) SetWarRoomChatID(v string) *MajorIncidentCreate {
+	_c.mutation.SetWarRoomChatID(v)
+	return _c
+}
+
+// SetNillableWarRoomChatID sets the "war_room_chat_id" field if the given value is not nil.
+func (_c *MajorIncidentCreate) SetNillableWarRoomChatID(v *string) *MajorIncidentCreate {
+	if v != nil {
+		_c.SetWarRoomChatID(*v)
+	}
+	return _c
+}
+
+// SetWarRoomChannel sets the "war_room_channel" field.
+func (_c *MajorIncidentCreate) SetWarRoomChannel(v string) *MajorIncidentCreate {
+	_c.mutation.SetWarRoomChannel(v)
+	return _c
+}
+
+// SetNillableWarRoomChannel sets the "war_room_channel" field if the given value is not nil.
+func (_c *MajorIncidentCreate) SetNillableWarRoomChannel(v *string) *MajorIncidentCreate {
+	if v != nil {
+		_c.SetWarRoomChannel(*v)
+	}
+	return _c
+}
+
+// SetWarRoomError sets the "war_room_error" field.
+func (_c *MajorIncidentCreate) SetWarRoomError(v string) *MajorIncidentCreate {
+	_c.mutation.SetWarRoomError(v)
+	return _c
+}
+
+// SetNillableWarRoomError sets the "war_room_error" field if the given value is not nil.
+func (_c *MajorIncidentCreate) SetNillableWarRoomError(v *string) *MajorIncidentCreate {
+	if v != nil {
+		_c.SetWarRoomError(*v)
+	}
+	return _c
+}
+
+// SetStakeholderUserIds sets the "stakeholder_user_ids" field.
+func (_c *MajorIncidentCreate) SetStakeholderUserIds(v []int) *MajorIncidentCreate {
+	_c.mutation.SetStakeholderUserIds(v)
+	return _c
+}
+
+// SetStakeholderChannels sets the "stakeholder_channels" field.
+func (_c *MajorIncidentCreate) SetStakeholderChannels(v []schema.StakeholderChannel) *MajorIncidentCreate {
+	_c.mutation.SetStakeholderChannels(v)
+	return _c
+}
+
+// SetUpdateCadenceMinutes sets the "update_cadence_minutes" field.
+func (_c *MajorIncidentCreate) SetUpdateCadenceMinutes(v int) *MajorIncidentCreate {
+	_c.mutation.SetUpdateCadenceMinutes(v)
+	return _c
+}
+
+// SetNillableUpdateCadenceMinutes sets the "update_cadence_minutes" field if the given value is not nil.
+func (_c *MajorIncidentCreate) SetNillableUpdateCadenceMinutes(v *int) *MajorIncidentCreate {
+	if v != nil {
+		_c.SetUpdateCadenceMinutes(*v)
+	}
+	return _c
+}
+
+// SetNextUpdateAt sets the "next_update_at" field.
+func (_c *MajorIncidentCreate) SetNextUpdateAt(v time.Time) *MajorIncidentCreate {
+	_c.mutation.SetNextUpdateAt(v)
+	return _c
+}
+
+// SetNillableNextUpdateAt sets the "next_update_at" field if the given value is not nil.
+func (_c *MajorIncidentCreate) SetNillableNextUpdateAt(v *time.Time) *MajorIncidentCreate {
+	if v != nil {
+		_c.SetNextUpdateAt(*v)
+	}
+	return _c
+}
+
+// SetLastUpdateAt sets the "last_update_at" field.
+func (_c *MajorIncidentCreate) SetLastUpdateAt(v time.Time) *MajorIncidentCreate {
+	_c.mutation.SetLastUpdateAt(v)
+	return _c
+}
+
+// SetNillableLastUpdateAt sets the "last_update_at" field if the given value is not nil.
+func (_c *MajorIncidentCreate) SetNillableLastUpdateAt(v *time.Time) *MajorIncidentCreate {
+	if v != nil {
+		_c.SetLastUpdateAt(*v)
+	}
+	return _c
+}
+
+// SetUpdateCount sets the "update_count" field.
+func (_c *MajorIncidentCreate) SetUpdateCount(v int) *MajorIncidentCreate {
+	_c.mutation.SetUpdateCount(v)
+	return _c
+}
+
+// SetNillableUpdateCount sets the "update_count" field if the given value is not nil.
+func (_c *MajorIncidentCreate) SetNillableUpdateCount(v *int) *MajorIncidentCreate {
+	if v != nil {
+		_c.SetUpdateCount(*v)
+	}
+	return _c
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_c *MajorIncidentCreate) SetResolvedAt(v time.Time) *MajorIncidentCreate {
+	_c.mutation.SetResolvedAt(v)
+	return _c
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_c *MajorIncidentCreate) SetNillableResolvedAt(v *time.Time) *MajorIncidentCreate {
+	if v != nil {
+		_c.SetResolvedAt(*v)
+	}
+	return _c
+}
+
+// SetPostmortemDraft sets the "postmortem_draft" field.
+func (_c *MajorIncidentCreate) SetPostmortemDraft(v string) *MajorIncidentCreate {
+	_c.mutation.SetPostmortemDraft(v)
+	return _c
+}
+
+// SetNillablePostmortemDraft sets the "postmortem_draft" field if the given value is not nil.
+func (_c *MajorIncidentCreate) SetNillablePostmortemDraft(v *string) *MajorIncidentCreate {
+	if v != nil {
+		_c.SetPostmortemDraft(*v)
+	}
+	return _c
+}
+
+// SetPostmortemGeneratedAt sets the "postmortem_generated_at" field.
+func (_c *MajorIncidentCreate) SetPostmortemGeneratedAt(v time.Time) *MajorIncidentCreate {
+	_c.mutation.SetPostmortemGeneratedAt(v)
+	return _c
+}
+
+// SetNillablePostmortemGeneratedAt sets the "postmortem_generated_at" field if the given value is not nil.
+func (_c *MajorIncidentCreate) SetNillablePostmortemGeneratedAt(v *time.Time) *MajorIncidentCreate {
+	if v != nil {
+		_c.SetPostmortemGeneratedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *MajorIncidentCreate) SetCreatedAt(v time.Time) *MajorIncidentCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *MajorIncidentCreate) SetNillableCreatedAt(v *time.Time) *MajorIncidentCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *MajorIncidentCreate) SetUpdatedAt(v time.Time) *MajorIncidentCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *MajorIncidentCreate) SetNillableUpdatedAt(v *time.Time) *MajorIncidentCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the MajorIncidentMutation object of the builder.
+func (_c *MajorIncidentCreate) Mutation() *MajorIncidentMutation {
+	return _c.mutation
+}
+
+// Save creates the MajorIncident in the database.
+func (_c *MajorIncidentCreate) Save(ctx context.Context) (*MajorIncident, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MajorIncidentCreate) SaveX(ctx context.Context) *MajorIncident {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MajorIncidentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MajorIncidentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MajorIncidentCreate) defaults() {
+	if _, ok := _c.mutation.DeclaredAt(); !ok {
+		v := majorincident.DefaultDeclaredAt()
+		_c.mutation.SetDeclaredAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := majorincident.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.UpdateCadenceMinutes(); !ok {
+		v := majorincident.DefaultUpdateCadenceMinutes
+		_c.mutation.SetUpdateCadenceMinutes(v)
+	}
+	if _, ok := _c.mutation.UpdateCount(); !ok {
+		v := majorincident.DefaultUpdateCount
+		_c.mutation.SetUpdateCount(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := majorincident.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := majorincident.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MajorIncidentCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "MajorIncident.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := majorincident.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "MajorIncident.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IncidentID(); !ok {
+		return &ValidationError{Name: "incident_id", err: errors.New(`ent: missing required field "MajorIncident.incident_id"`)}
+	}
+	if v, ok := _c.mutation.IncidentID(); ok {
+		if err := majorincident.IncidentIDValidator(v); err != nil {
+			return &ValidationError{Name: "incident_id", err: fmt.Errorf(`ent: validator failed for field "MajorIncident.incident_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CommanderID(); !ok {
+		return &ValidationError{Name: "commander_id", err: errors.New(`ent: missing required field "MajorIncident.commander_id"`)}
+	}
+	if v, ok := _c.mutation.CommanderID(); ok {
+		if err := majorincident.CommanderIDValidator(v); err != nil {
+			return &ValidationError{Name: "commander_id", err: fmt.Errorf(`ent: validator failed for field "MajorIncident.commander_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DeclaredBy(); !ok {
+		return &ValidationError{Name: "declared_by", err: errors.New(`ent: missing required field "MajorIncident.declared_by"`)}
+	}
+	if v, ok := _c.mutation.DeclaredBy(); ok {
+		if err := majorincident.DeclaredByValidator(v); err != nil {
+			return &ValidationError{Name: "declared_by", err: fmt.Errorf(`ent: validator failed for field "MajorIncident.declared_by": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DeclaredAt(); !ok {
+		return &ValidationError{Name: "declared_at", err: errors.New(`ent: missing required field "MajorIncident.declared_at"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "MajorIncident.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := majorincident.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "MajorIncident.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UpdateCadenceMinutes(); !ok {
+		return &ValidationError{Name: "update_cadence_minutes", err: errors.New(`ent: missing required field "MajorIncident.update_cadence_minutes"`)}
+	}
+	if v, ok := _c.mutation.UpdateCadenceMinutes(); ok {
+		if err := majorincident.UpdateCadenceMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "update_cadence_minutes", err: fmt.Errorf(`ent: validator failed for field "MajorIncident.update_cadence_minutes": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UpdateCount(); !ok {
+		return &ValidationError{Name: "update_count", err: errors.New(`ent: missing required field "MajorIncident.update_count"`)}
+	}
+	if v, ok := _c.mutation.UpdateCount(); ok {
+		if err := majorincident.UpdateCountValidator(v); err != nil {
+			return &ValidationError{Name: "update_count", err: fmt.Errorf(`ent: validator failed for field "MajorIncident.update_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MajorIncident.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "MajorIncident.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *MajorIncidentCreate) sqlSave(ctx context.Context) (*MajorIncident, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MajorIncidentCreate) createSpec() (*MajorIncident, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MajorIncident{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(majorincident.Table, sqlgraph.NewFieldSpec(majorincident.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(majorincident.FieldTenantID, field.TypeInt, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.IncidentID(); ok {
+		_spec.SetField(majorincident.FieldIncidentID, field.TypeInt, value)
+		_node.IncidentID = value
+	}
+	if value, ok := _c.mutation.CommanderID(); ok {
+		_spec.SetField(majorincident.FieldCommanderID, field.TypeInt, value)
+		_node.CommanderID = value
+	}
+	if value, ok := _c.mutation.DeclaredBy(); ok {
+		_spec.SetField(majorincident.FieldDeclaredBy, field.TypeInt, value)
+		_node.DeclaredBy = value
+	}
+	if value, ok := _c.mutation.DeclaredAt(); ok {
+		_spec.SetField(majorincident.FieldDeclaredAt, field.TypeTime, value)
+		_node.DeclaredAt = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(majorincident.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.WarRoomConnector(); ok {
+		_spec.SetField(majorincident.FieldWarRoomConnector, field.TypeString, value)
+		_node.WarRoomConnector = value
+	}
+	if value, ok := _c.mutation.WarRoomChatID(); ok {
+		_spec.SetField(majorincident.FieldWarRoomChatID, field.TypeString, value)
+		_node.WarRoomChatID = value
+	}
+	if value, ok := _c.mutation.WarRoomChannel(); ok {
+		_spec.SetField(majorincident.FieldWarRoomChannel, field.TypeString, value)
+		_node.WarRoomChannel = value
+	}
+	if value, ok := _c.mutation.WarRoomError(); ok {
+		_spec.SetField(majorincident.FieldWarRoomError, field.TypeString, value)
+		_node.WarRoomError = value
+	}
+	if value, ok := _c.mutation.StakeholderUserIds(); ok {
+		_spec.SetField(majorincident.FieldStakeholderUserIds, field.TypeJSON, value)
+		_node.StakeholderUserIds = value
+	}
+	if value, ok := _c.mutation.StakeholderChannels(); ok {
+		_spec.SetField(majorincident.FieldStakeholderChannels, field.TypeJSON, value)
+		_node.StakeholderChannels = value
+	}
+	if value, ok := _c.mutation.UpdateCadenceMinutes(); ok {
+		_spec.SetField(majorincident.FieldUpdateCadenceMinutes, field.TypeInt, value)
+		_node.UpdateCadenceMinutes = value
+	}
+	if value, ok := _c.mutation.NextUpdateAt(); ok {
+		_spec.SetField(majorincident.FieldNextUpdateAt, field.TypeTime, value)
+		_node.NextUpdateAt = &value
+	}
+	if value, ok := _c.mutation.LastUpdateAt(); ok {
+		_spec.SetField(majorincident.FieldLastUpdateAt, field.TypeTime, value)
+		_node.LastUpdateAt = &value
+	}
+	if value, ok := _c.mutation.UpdateCount(); ok {
+		_spec.SetField(majorincident.FieldUpdateCount, field.TypeInt, value)
+		_node.UpdateCount = value
+	}
+	if value, ok := _c.mutation.ResolvedAt(); ok {
+		_spec.SetField(majorincident.FieldResolvedAt, field.TypeTime, value)
+		_node.ResolvedAt = &value
+	}
+	if value, ok := _c.mutation.PostmortemDraft(); ok {
+		_spec.SetField(majorincident.FieldPostmortemDraft, field.TypeString, value)
+		_node.PostmortemDraft = value
+	}
+	if value, ok := _c.mutation.PostmortemGeneratedAt(); ok {
+		_spec.SetField(majorincident.FieldPostmortemGeneratedAt, field.TypeTime, value)
+		_node.PostmortemGeneratedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(majorincident.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(majorincident.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// MajorIncidentCreateBulk is the builder for creating many MajorIncident entities in bulk.
+type MajorIncidentCreateBulk struct {
+	config
+	err      error
+	builders []*MajorIncidentCreate
+}
+
+// Save creates the MajorIncident entities in the database.
+func (_c *MajorIncidentCreateBulk) Save(ctx context.Context) ([]*MajorIncident, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*MajorIncident, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MajorIncidentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MajorIncidentCreateBulk) SaveX(ctx context.Context) []*MajorIncident {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MajorIncidentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MajorIncidentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1276 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/majorincident"
+	"itsm-backend/ent/predicate"
+	"itsm-backend/ent/schema"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// MajorIncidentUpdate is the builder for updating MajorIncident entities.
+type MajorIncidentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MajorIncidentMutation
+}
+
+// Where appends a list predicates to the MajorIncidentUpdate builder.
+func (_u *MajorIncidentUpdate) Where(ps ...predicate.MajorIncident) *MajorIncidentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *MajorIncidentUpdate) SetTenantID(v int) *MajorIncidentUpdate {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *MajorIncidentUpdate) SetNillableTenantID(v *int) *MajorIncidentUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *MajorIncidentUpdate) AddTenantID(v int) *MajorIncidentUpdate {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetIncidentID sets the "incident_id" field.
+func (_u *MajorIncidentUpdate) SetIncidentID(v int) *MajorIncidentUpdate {
+	_u.mutation.ResetIncidentID()
+	_u.mutation.SetIncidentID(v)
+	return _u
+}
+
+// SetNillableIncidentID sets the "incident_id" field if the given value is not nil.
+func (_u *MajorIncidentUpdate) SetNillableIncidentID(v *int) *MajorIncidentUpdate {
+	if v != nil {
+		_u.SetIncidentID(*v)
+	}
+	return _u
+}
+
+// AddIncidentID adds value to the "incident_id" field.
+func (_u *MajorIncidentUpdate) AddIncidentID(v int) *MajorIncidentUpdate {
+	_u.mutation.AddIncidentID(v)
+	return _u
+}
+
+// SetCommanderID sets the "commander_id" field.
+func (_u *MajorIncidentUpdate) SetCommanderID(v int) *MajorIncidentUpdate {
+	_u.mutation.ResetCommanderID()
+	_u.mutation.SetCommanderID(v)
+	return _u
+}
+
+// SetNillableCommanderID sets the "commander_id" field if the given value is not nil.
+func (_u *MajorIncidentUpdate) SetNillableCommanderID(v *int) *MajorIncidentUpdate {
+	if v != nil {
+		_u.SetCommanderID(*v)
+	}
+	return _u
+}
+
+// AddCommanderID adds value to the "commander_id" field.
+func (_u *MajorIncidentUpdate) AddCommanderID(v int) *MajorIncidentUpdate {
+	_u.mutation.AddCommanderID(v)
+	return _u
+}
+
+// SetDeclaredBy sets the "declared_by" field.
+func (_u *MajorIncidentUpdate) SetDeclaredBy(v int) *MajorIncidentUpdate {
+	_u.mutation.ResetDeclaredBy()
+	_u.mutation.SetDeclaredBy(v)
+	return _u
+}
+
+// SetNillableDeclaredBy sets the "declared_by" field if the given value is not nil.
+func (_u *MajorIncidentUpdate) SetNillableDeclaredBy(v *int) *MajorIncidentUpdate {
+	if v != nil {
+		_u.SetDeclaredBy(*v)
+	}
+	return _u
+}
+
+// AddDeclaredBy adds value to the "declared_by" field.
+func (_u *MajorIncidentUpdate) AddDeclaredBy(v int) *MajorIncidentUpdate {
+	_u.mutation.AddDeclaredBy(v)
+	return _u
+}
+
+// SetDeclaredAt sets the "declared_at" field.
+func (_u *MajorIncidentUpdate) SetDeclaredAt(v time.Time) *MajorIncidentUpdate {
+	_u.mutation.SetDeclaredAt(v)
+	return _u
+}
+
+// SetNillableDeclaredAt sets the "declared_at" field if the given value is not nil.
+func (_u *MajorIncidentUpdate) SetNillableDeclaredAt(v *time.Time) *MajorIncidentUpdate {
+	if v != nil {
+		_u.SetDeclaredAt(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *MajorIncidentUpdate) SetStatus(v majorincident.Status) *MajorIncidentUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *MajorIncidentUpdate) SetNillableStatus(v *majorincident.Status) *MajorIncidentUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetWarRoomConnector sets the "war_room_connector" field.
+func (_u *MajorIncidentUpdate) SetWarRoomConnector(v string) *MajorIncidentUpdate {
+	_u.mutation.SetWarRoomConnector(v)
+	return _u
+}
+
+// SetNillableWarRoomConnector sets the "war_room_connector" field if the given value is not nil.
+func (_u *MajorIncidentUpdate) SetNillableWarRoomConnector(v *string) *MajorIncidentUpdate {
+	if v != nil {
+		_u.SetWarRoomConnector(*v)
+	}
+	return _u
+}
+
+// ClearWarRoomConnector clears the value of the "war_room_connector" field.
+func (_u *MajorIncidentUpdate) ClearWarRoomConnector() *MajorIncidentUpdate {
+	_u.mutation.ClearWarRoomConnector()
+	return _u
+}
+
+// SetWarRoomChatID sets the "war_room_chat_id" field.
+func (_u *MajorIncidentUpdate) SetWarRoomChatID(v string) *MajorIncidentUpdate {
+	_u.mutation.SetWarRoomChatID(v)
+	return _u
+}
+
+// SetNillableWarRoomChatID sets the "war_room_chat_id" field if the given value is not nil.
+func (_u *MajorIncidentUpdate) SetNillableWarRoomChatID(v *string) *MajorIncidentUpdate {
+	if v != nil {
+		_u.SetWarRoomChatID(*v)
+	}
+	return _u
+}
+
+// ClearWarRoomChatID clears the value of the "war_room_chat_id" field.
+func (_u *MajorIncidentUpdate) ClearWarRoomChatID() *MajorIncidentUpdate {
+	_u.mutation.ClearWarRoomChatID()
+	return _u
+}
+
+// SetWarRoomChannel sets the "war_room_channel" field.
+func (_u *MajorIncidentUpdate) SetWarRoomChannel(v string) *MajorIncidentUpdate {
+	_u.mutation.SetWarRoomChannel(v)
+	return _u
+}
+
+// SetNillableWarRoomChannel sets the "war_room_channel" field if the given value is not nil.
+func (_u *MajorIncidentUpdate) SetNillableWarRoomChannel(v *string) *MajorIncidentUpdate {
+	if v != nil {
+		_u.SetWarRoomChannel(*v)
+	}
+	return _u
+}
+
+// ClearWarRoomChannel clears the value of the "war_room_channel" field.
+func (_u *MajorIncidentUpdate) ClearWarRoomChannel() *MajorIncidentUpdate {
+	_u.mutation.ClearWarRoomChannel()
+	return _u
+}
+
+// SetWarRoomError sets the "war_room_error" field.
+func (_u *MajorIncidentUpdate) SetWarRoomError(v string) *MajorIncidentUpdate {
+	_u.mutation.SetWarRoomError(v)
+	return _u
+}
+
+// SetNillableWarRoomError sets the "war_room_error" field if the given value is not nil.
+func (_u *MajorIncidentUpdate) SetNillableWarRoomError(v *string) *MajorIncidentUpdate {
+	if v != nil {
+		_u.SetWarRoomError(*v)
+	}
+	return _u
+}
+
+// ClearWarRoomError clears the value of the "war_room_error" field.
+func (_u *MajorIncidentUpdate) ClearWarRoomError() *MajorIncidentUpdate {
+	_u.mutation.ClearWarRoomError()
+	return _u
+}
+
+// SetStakeholderUserIds sets the "stakeholder_user_ids" field.
+func (_u *MajorIncidentUpdate) SetStakeholderUserIds(v []int) *MajorIncidentUpdate {
+	_u.mutation.SetStakeholderUserIds(v)
+	return _u
+}
+
+// AppendStakeholderUserIds appends value to the "stakeholder_user_ids" field.
+func (_u *MajorIncidentUpdate) AppendStakeholderUserIds(v []int) *MajorIncidentUpdate {
+	_u.mutation.AppendStakeholderUserIds(v)
+	return _u
+}
+
+// ClearStakeholderUserIds clears the value of the "stakeholder_user_ids" field.
+func (_u *MajorIncidentUpdate) ClearStakeholderUserIds() *MajorIncidentUpdate {
+	_u.mutation.ClearStakeholderUserIds()
+	return _u
+}
+
+// SetStakeholderChannels sets the "stakeholder_channels" field.
+func (_u *MajorIncidentUpdate) SetStakeholderChannels(v []schema.StakeholderChannel) *MajorIncidentUpdate {
+	_u.mutation.SetStakeholderChannels(v)
+	return _u
+}
+
+// AppendStakeholderChannels appends value to the "stakeholder_channels" field.
+func (_u *MajorIncidentUpdate) AppendStakeholderChannels(v []schema.StakeholderChannel) *MajorIncidentUpdate {
+	_u.mutation.AppendStakeholderChannels(v)
+	return _u
+}
+
+// ClearStakeholderChannels clears the value of the "stakeholder_channels" field.
+func (_u *MajorIncidentUpdate) ClearStakeholderChannels() *MajorIncidentUpdate {
+	_u.mutation.ClearStakeholderChannels()
+	return _u
+}
+
+// SetUpdateCadenceMinutes sets the "update_cadence_minutes" field.
+func (_u *MajorIncidentUpdate) SetUpdateCadenceMinutes(v int) *MajorIncidentUpdate {
+	_u.mutation.ResetUpdateCadenceMinutes()
+	_u.mutation.SetUpdateCadenceMinutes(v)
+	return _u
+}
+
+// SetNillableUpdateCadenceMinutes sets the "update_cadence_minutes" field if the given value is not nil.
+func (_u *MajorIncidentUpdate) SetNillableUpdateCadenceMinutes(v *int) *MajorIncidentUpdate {
+	if v != nil {
+		_u.SetUpdateCadenceMinutes(*v)
+	}
+	return _u
+}
+
+// AddUpdateCadenceMinutes adds value to the "update_cadence_minutes" field.
+func (_u *MajorIncidentUpdate) AddUpdateCadenceMinutes(v int) *MajorIncidentUpdate {
+	_u.mutation.AddUpdateCadenceMinutes(v)
+	return _u
+}
+
+// SetNextUpdateAt sets the "next_update_at" field.
+func (_u *MajorIncidentUpdate) SetNextUpdateAt(v time.Time) *MajorIncidentUpdate {
+	_u.mutation.SetNextUpdateAt(v)
+	return _u
+}
+
+// SetNillableNextUpdateAt sets the "next_update_at" field if the given value is not nil.
+func (_u *MajorIncidentUpdate) SetNillableNextUpdateAt(v *time.Time) *MajorIncidentUpdate {
+	if v != nil {
+		_u.SetNextUpdateAt(*v)
+	}
+	return _u
+}
+
+// ClearNextUpdateAt clears the value of the "next_update_at" field.
+func (_u *MajorIncidentUpdate) ClearNextUpdateAt() *MajorIncidentUpdate {
+	_u.mutation.ClearNextUpdateAt()
+	return _u
+}
+
+// SetLastUpdateAt sets the "last_update_at" field.
+func (_u *MajorIncidentUpdate) SetLastUpdateAt(v time.Time) *MajorIncidentUpdate {
+	_u.mutation.SetLastUpdateAt(v)
+	return _u
+}
+
+// SetNillableLastUpdateAt sets the "last_update_at" field if the given value is not nil.
+func (_u *MajorIncidentUpdate) SetNillableLastUpdateAt(v *time.Time) *MajorIncidentUpdate {
+	if v != nil {
+		_u.SetLastUpdateAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUpdateAt clears the value of the "last_update_at" field.
+func (_u *MajorIncidentUpdate) ClearLastUpdateAt() *MajorIncidentUpdate {
+	_u.mutation.ClearLastUpdateAt()
+	return _u
+}
+
+// SetUpdateCount sets the "update_count" field.
+func (_u *MajorIncidentUpdate) SetUpdateCount(v int) *MajorIncidentUpdate {
+	_u.mutation.ResetUpdateCount()
+	_u.mutation.SetUpdateCount(v)
+	return _u
+}
+
+// SetNillableUpdateCount sets the "update_count" field if the given value is not nil.
+func (_u *MajorIncidentUpdate) SetNillableUpdateCount(v *int) *MajorIncidentUpdate {
+	if v != nil {
+		_u.SetUpdateCount(*v)
+	}
+	return _u
+}
+
+// AddUpdateCount adds value to the "update_count" field.
+func (_u *MajorIncidentUpdate) AddUpdateCount(v int) *MajorIncidentUpdate {
+	_u.mutation.AddUpdateCount(v)
+	return _u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_u *MajorIncidentUpdate) SetResolvedAt(v time.Time) *MajorIncidentUpdate {
+	_u.mutation.SetResolvedAt(v)
+	return _u
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_u *MajorIncidentUpdate) SetNillableResolvedAt(v *time.Time) *MajorIncidentUpdate {
+	if v != nil {
+		_u.SetResolvedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (_u *MajorIncidentUpdate) ClearResolvedAt() *MajorIncidentUpdate {
+	_u.mutation.ClearResolvedAt()
+	return _u
+}
+
+// SetPostmortemDraft sets the "postmortem_draft" field.
+func (_u *MajorIncidentUpdate) SetPostmortemDraft(v string) *MajorIncidentUpdate {
+	_u.mutation.SetPostmortemDraft(v)
+	return _u
+}
+
+// SetNillablePostmortemDraft sets the "postmortem_draft" field if the given value is not nil.
+func (_u *MajorIncidentUpdate) SetNillablePostmortemDraft(v *string) *MajorIncidentUpdate {
+	if v != nil {
+		_u.SetPostmortemDraft(*v)
+	}
+	return _u
+}
+
+// ClearPostmortemDraft clears the value of the "postmortem_draft" field.
+func (_u *MajorIncidentUpdate) ClearPostmortemDraft() *MajorIncidentUpdate {
+	_u.mutation.ClearPostmortemDraft()
+	return _u
+}
+
+// SetPostmortemGeneratedAt sets the "postmortem_generated_at" field.
+func (_u *MajorIncidentUpdate) SetPostmortemGeneratedAt(v time.Time) *MajorIncidentUpdate {
+	_u.mutation.SetPostmortemGeneratedAt(v)
+	return _u
+}
+
+// SetNillablePostmortemGeneratedAt sets the "postmortem_generated_at" field if the given value is not nil.
+func (_u *MajorIncidentUpdate) SetNillablePostmortemGeneratedAt(v *time.Time) *MajorIncidentUpdate {
+	if v != nil {
+		_u.SetPostmortemGeneratedAt(*v)
+	}
+	return _u
+}
+
+// ClearPostmortemGeneratedAt clears the value of the "postmortem_generated_at" field.
+func (_u *MajorIncidentUpdate) ClearPostmortemGeneratedAt() *MajorIncidentUpdate {
+	_u.mutation.ClearPostmortemGeneratedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *MajorIncidentUpdate) SetUpdatedAt(v time.Time) *MajorIncidentUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the MajorIncidentMutation object of the builder.
+func (_u *MajorIncidentUpdate) Mutation() *MajorIncidentMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MajorIncidentUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MajorIncidentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MajorIncidentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MajorIncidentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MajorIncidentUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := majorincident.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MajorIncidentUpdate) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := majorincident.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "MajorIncident.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IncidentID(); ok {
+		if err := majorincident.IncidentIDValidator(v); err != nil {
+			return &ValidationError{Name: "incident_id", err: fmt.Errorf(`ent: validator failed for field "MajorIncident.incident_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CommanderID(); ok {
+		if err := majorincident.CommanderIDValidator(v); err != nil {
+			return &ValidationError{Name: "commander_id", err: fmt.Errorf(`ent: validator failed for field "MajorIncident.commander_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeclaredBy(); ok {
+		if err := majorincident.DeclaredByValidator(v); err != nil {
+			return &ValidationError{Name: "declared_by", err: fmt.Errorf(`ent: validator failed for field "MajorIncident.declared_by": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := majorincident.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "MajorIncident.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UpdateCadenceMinutes(); ok {
+		if err := majorincident.UpdateCadenceMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "update_cadence_minutes", err: fmt.Errorf(`ent: validator failed for field "MajorIncident.update_cadence_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UpdateCount(); ok {
+		if err := majorincident.UpdateCountValidator(v); err != nil {
+			return &ValidationError{Name: "update_count", err: fmt.Errorf(`ent: validator failed for field "MajorIncident.update_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *MajorIncidentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(majorincident.Table, majorincident.Columns, sqlgraph.NewFieldSpec(majorincident.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(majorincident.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(majorincident.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IncidentID(); ok {
+		_spec.SetField(majorincident.FieldIncidentID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedIncidentID(); ok {
+		_spec.AddField(majorincident.FieldIncidentID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CommanderID(); ok {
+		_spec.SetField(majorincident.FieldCommanderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCommanderID(); ok {
+		_spec.AddField(majorincident.FieldCommanderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DeclaredBy(); ok {
+		_spec.SetField(majorincident.FieldDeclaredBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDeclaredBy(); ok {
+		_spec.AddField(majorincident.FieldDeclaredBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DeclaredAt(); ok {
+		_spec.SetField(majorincident.FieldDeclaredAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(majorincident.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.WarRoomConnector(); ok {
+		_spec.SetField(majorincident.FieldWarRoomConnector, field.TypeString, value)
+	}
+	if _u.mutation.WarRoomConnectorCleared() {
+		_spec.ClearField(majorincident.FieldWarRoomConnector, field.TypeString)
+	}
+	if value, ok := _u.mutation.WarRoomChatID(); ok {
+		_spec.SetField(majorincident.FieldWarRoomChatID, field.TypeString, value)
+	}
+	if _u.mutation.WarRoomChatIDCleared() {
+		_spec.ClearField(majorincident.FieldWarRoomChatID, field.TypeString)
+	}
+	if value, ok := _u.mutation.WarRoomChannel(); ok {
+		_spec.SetField(majorincident.FieldWarRoomChannel, field.TypeString, value)
+	}
+	if _u.mutation.WarRoomChannelCleared() {
+		_spec.ClearField(majorincident.FieldWarRoomChannel, field.TypeString)
+	}
+	if value, ok := _u.mutation.WarRoomError(); ok {
+		_spec.SetField(majorincident.FieldWarRoomError, field.TypeString, value)
+	}
+	if _u.mutation.WarRoomErrorCleared() {
+		_spec.ClearField(majorincident.FieldWarRoomError, field.TypeString)
+	}
+	if value, ok := _u.mutation.StakeholderUserIds(); ok {
+		_spec.SetField(majorincident.FieldStakeholderUserIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedStakeholderUserIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, majorincident.FieldStakeholderUserIds, value)
+		})
+	}
+	if _u.mutation.StakeholderUserIdsCleared() {
+		_spec.ClearField(majorincident.FieldStakeholderUserIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.StakeholderChannels(); ok {
+		_spec.SetField(majorincident.FieldStakeholderChannels, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedStakeholderChannels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, majorincident.FieldStakeholderChannels, value)
+		})
+	}
+	if _u.mutation.StakeholderChannelsCleared() {
+		_spec.ClearField(majorincident.FieldStakeholderChannels, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdateCadenceMinutes(); ok {
+		_spec.SetField(majorincident.FieldUpdateCadenceMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateCadenceMinutes(); ok {
+		_spec.AddField(majorincident.FieldUpdateCadenceMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextUpdateAt(); ok {
+		_spec.SetField(majorincident.FieldNextUpdateAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextUpdateAtCleared() {
+		_spec.ClearField(majorincident.FieldNextUpdateAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastUpdateAt(); ok {
+		_spec.SetField(majorincident.FieldLastUpdateAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUpdateAtCleared() {
+		_spec.ClearField(majorincident.FieldLastUpdateAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateCount(); ok {
+		_spec.SetField(majorincident.FieldUpdateCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateCount(); ok {
+		_spec.AddField(majorincident.FieldUpdateCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ResolvedAt(); ok {
+		_spec.SetField(majorincident.FieldResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolvedAtCleared() {
+		_spec.ClearField(majorincident.FieldResolvedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PostmortemDraft(); ok {
+		_spec.SetField(majorincident.FieldPostmortemDraft, field.TypeString, value)
+	}
+	if _u.mutation.PostmortemDraftCleared() {
+		_spec.ClearField(majorincident.FieldPostmortemDraft, field.TypeString)
+	}
+	if value, ok := _u.mutation.PostmortemGeneratedAt(); ok {
+		_spec.SetField(majorincident.FieldPostmortemGeneratedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PostmortemGeneratedAtCleared() {
+		_spec.ClearField(majorincident.FieldPostmortemGeneratedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(majorincident.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{majorincident.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MajorIncidentUpdateOne is the builder for updating a single MajorIncident entity.
+type MajorIncidentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MajorIncidentMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *MajorIncidentUpdateOne) SetTenantID(v int) *MajorIncidentUpdateOne {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *MajorIncidentUpdateOne) SetNillableTenantID(v *int) *MajorIncidentUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *MajorIncidentUpdateOne) AddTenantID(v int) *MajorIncidentUpdateOne {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetIncidentID sets the "incident_id" field.
+func (_u *MajorIncidentUpdateOne) SetIncidentID(v int) *MajorIncidentUpdateOne {
+	_u.mutation.ResetIncidentID()
+	_u.mutation.SetIncidentID(v)
+	return _u
+}
+
+// SetNillableIncidentID sets the "incident_id" field if the given value is not nil.
+func (_u *MajorIncidentUpdateOne) SetNillableIncidentID(v *int) *MajorIncidentUpdateOne {
+	if v != nil {
+		_u.SetIncidentID(*v)
+	}
+	return _u
+}
+
+// AddIncidentID adds value to the "incident_id" field.
+func (_u *MajorIncidentUpdateOne) AddIncidentID(v int) *MajorIncidentUpdateOne {
+	_u.mutation.AddIncidentID(v)
+	return _u
+}
+
+// SetCommanderID sets the "commander_id" field.
+func (_u *MajorIncidentUpdateOne) SetCommanderID(v int) *MajorIncidentUpdateOne {
+	_u.mutation.ResetCommanderID()
+	_u.mutation.SetCommanderID(v)
+	return _u
+}
+
+// SetNillableCommanderID sets the "commander_id" field if the given value is not nil.
+func (_u *MajorIncidentUpdateOne) SetNillableCommanderID(v *int) *MajorIncidentUpdateOne {
+	if v != nil {
+		_u.SetCommanderID(*v)
+	}
+	return _u
+}
+
+// AddCommanderID adds value to the "commander_id" field.
+func (_u *MajorIncidentUpdateOne) AddCommanderID(v int) *MajorIncidentUpdateOne {
+	_u.mutation.AddCommanderID(v)
+	return _u
+}
+
+// SetDeclaredBy sets the "declared_by" field.
+func (_u *MajorIncidentUpdateOne) SetDeclaredBy(v int) *MajorIncidentUpdateOne {
+	_u.mutation.ResetDeclaredBy()
+	_u.mutation.SetDeclaredBy(v)
+	return _u
+}
+
+// SetNillableDeclaredBy sets the "declared_by" field if the given value is not nil.
+func (_u *MajorIncidentUpdateOne) SetNillableDeclaredBy(v *int) *MajorIncidentUpdateOne {
+	if v != nil {
+		_u.SetDeclaredBy(*v)
+	}
+	return _u
+}
+
+// AddDeclaredBy adds value to the "declared_by" field.
+func (_u *MajorIncidentUpdateOne) AddDeclaredBy(v int) *MajorIncidentUpdateOne {
+	_u.mutation.AddDeclaredBy(v)
+	return _u
+}
+
+// SetDeclaredAt sets the "declared_at" field.
+func (_u *MajorIncidentUpdateOne) SetDeclaredAt(v time.Time) *MajorIncidentUpdateOne {
+	_u.mutation.SetDeclaredAt(v)
+	return _u
+}
+
+// SetNillableDeclaredAt sets the "declared_at" field if the given value is not nil.
+func (_u *MajorIncidentUpdateOne) SetNillableDeclaredAt(v *time.Time) *MajorIncidentUpdateOne {
+	if v != nil {
+		_u.SetDeclaredAt(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *MajorIncidentUpdateOne) SetStatus(v majorincident.Status) *MajorIncidentUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *MajorIncidentUpdateOne) SetNillableStatus(v *majorincident.Status) *MajorIncidentUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetWarRoomConnector sets the "war_room_connector" field.
+func (_u *MajorIncidentUpdateOne) SetWarRoomConnector(v string) *MajorIncidentUpdateOne {
+	_u.mutation.SetWarRoomConnector(v)
+	return _u
+}
+
+// SetNillableWarRoomConnector sets the "war_room_connector" field if the given value is not nil.
+func (_u *MajorIncidentUpdateOne) SetNillableWarRoomConnector(v *string) *MajorIncidentUpdateOne {
+	if v != nil {
+		_u.SetWarRoomConnector(*v)
+	}
+	return _u
+}
+
+// ClearWarRoomConnector clears the value of the "war_room_connector" field.
+func (_u *MajorIncidentUpdateOne) ClearWarRoomConnector() *MajorIncidentUpdateOne {
+	_u.mutation.ClearWarRoomConnector()
+	return _u
+}
+
+// SetWarRoomChatID sets the "war_room_chat_id" field.
+func (_u *MajorIncidentUpdateOne) SetWarRoomChatID(v string) *MajorIncidentUpdateOne {
+	_u.mutation.SetWarRoomChatID(v)
+	return _u
+}
+
+// SetNillableWarRoomChatID sets the "war_room_chat_id" field if the given value is not nil.
+func (_u *MajorIncidentUpdateOne) SetNillableWarRoomChatID(v *string) *MajorIncidentUpdateOne {
+	if v != nil {
+		_u.SetWarRoomChatID(*v)
+	}
+	return _u
+}
+
+// ClearWarRoomChatID clears the value of the "war_room_chat_id" field.
+func (_u *MajorIncidentUpdateOne) ClearWarRoomChatID() *MajorIncidentUpdateOne {
+	_u.mutation.ClearWarRoomChatID()
+	return _u
+}
+
+// SetWarRoomChannel sets the "war_room_channel" field.
+func (_u *MajorIncidentUpdateOne) SetWarRoomChannel(v string) *MajorIncidentUpdateOne {
+	_u.mutation.SetWarRoomChannel(v)
+	return _u
+}
+
+// SetNillableWarRoomChannel sets the "war_room_channel" field if the given value is not nil.
+func (_u *MajorIncidentUpdateOne) SetNillableWarRoomChannel(v *string) *MajorIncidentUpdateOne {
+	if v != nil {
+		_u.SetWarRoomChannel(*v)
+	}
+	return _u
+}
+
+// ClearWarRoomChannel clears the value of the "war_room_channel" field.
+func (_u *MajorIncidentUpdateOne) ClearWarRoomChannel() *MajorIncidentUpdateOne {
+	_u.mutation.ClearWarRoomChannel()
+	return _u
+}
+
+// SetWarRoomError sets the "war_room_error" field.
+func (_u *MajorIncidentUpdateOne) SetWarRoomError(v string) *MajorIncidentUpdateOne {
+	_u.mutation.SetWarRoomError(v)
+	return _u
+}
+
+// SetNillableWarRoomError sets the "war_room_error" field if the given value is not nil.
+func (_u *MajorIncidentUpdateOne) SetNillableWarRoomError(v *string) *MajorIncidentUpdateOne {
+	if v != nil {
+		_u.SetWarRoomError(*v)
+	}
+	return _u
+}
+
+// ClearWarRoomError clears the value of the "war_room_error" field.
+func (_u *MajorIncidentUpdateOne) ClearWarRoomError() *MajorIncidentUpdateOne {
+	_u.mutation.ClearWarRoomError()
+	return _u
+}
+
+// SetStakeholderUserIds sets the "stakeholder_user_ids" field.
+func (_u *MajorIncidentUpdateOne) SetStakeholderUserIds(v []int) *MajorIncidentUpdateOne {
+	_u.mutation.SetStakeholderUserIds(v)
+	return _u
+}
+
+// AppendStakeholderUserIds appends value to the "stakeholder_user_ids" field.
+func (_u *MajorIncidentUpdateOne) AppendStakeholderUserIds(v []int) *MajorIncidentUpdateOne {
+	_u.mutation.AppendStakeholderUserIds(v)
+	return _u
+}
+
+// ClearStakeholderUserIds clears the value of the "stakeholder_user_ids" field.
+func (_u *MajorIncidentUpdateOne) ClearStakeholderUserIds() *MajorIncidentUpdateOne {
+	_u.mutation.ClearStakeholderUserIds()
+	return _u
+}
+
+// SetStakeholderChannels sets the "stakeholder_channels" field.
+func (_u *MajorIncidentUpdateOne) SetStakeholderChannels(v []schema.StakeholderChannel) *MajorIncidentUpdateOne {
+	_u.mutation.SetStakeholderChannels(v)
+	return _u
+}
+
+// AppendStakeholderChannels appends value to the "stakeholder_channels" field.
+func (_u *MajorIncidentUpdateOne) AppendStakeholderChannels(v []schema.StakeholderChannel) *MajorIncidentUpdateOne {
+	_u.mutation.AppendStakeholderChannels(v)
+	return _u
+}
+
+// ClearStakeholderChannels clears the value of the "stakeholder_channels" field.
+func (_u *MajorIncidentUpdateOne) ClearStakeholderChannels() *MajorIncidentUpdateOne {
+	_u.mutation.ClearStakeholderChannels()
+	return _u
+}
+
+// SetUpdateCadenceMinutes sets the "update_cadence_minutes" field.
+func (_u *MajorIncidentUpdateOne) SetUpdateCadenceMinutes(v int) *MajorIncidentUpdateOne {
+	_u.mutation.ResetUpdateCadenceMinutes()
+	_u.mutation.SetUpdateCadenceMinutes(v)
+	return _u
+}
+
+// SetNillableUpdateCadenceMinutes sets the "update_cadence_minutes" field if the given value is not nil.
+func (_u *MajorIncidentUpdateOne) SetNillableUpdateCadenceMinutes(v *int) *MajorIncidentUpdateOne {
+	if v != nil {
+		_u.SetUpdateCadenceMinutes(*v)
+	}
+	return _u
+}
+
+// AddUpdateCadenceMinutes adds value to the "update_cadence_minutes" field.
+func (_u *MajorIncidentUpdateOne) AddUpdateCadenceMinutes(v int) *MajorIncidentUpdateOne {
+	_u.mutation.AddUpdateCadenceMinutes(v)
+	return _u
+}
+
+// SetNextUpdateAt sets the "next_update_at" field.
+func (_u *MajorIncidentUpdateOne) SetNextUpdateAt(v time.Time) *MajorIncidentUpdateOne {
+	_u.mutation.SetNextUpdateAt(v)
+	return _u
+}
+
+// SetNillableNextUpdateAt sets the "next_update_at" field if the given value is not nil.
+func (_u *MajorIncidentUpdateOne) SetNillableNextUpdateAt(v *time.Time) *MajorIncidentUpdateOne {
+	if v != nil {
+		_u.SetNextUpdateAt(*v)
+	}
+	return _u
+}
+
+// ClearNextUpdateAt clears the value of the "next_update_at" field.
+func (_u *MajorIncidentUpdateOne) ClearNextUpdateAt() *MajorIncidentUpdateOne {
+	_u.mutation.ClearNextUpdateAt()
+	return _u
+}
+
+// SetLastUpdateAt sets the "last_update_at" field.
+func (_u *MajorIncidentUpdateOne) SetLastUpdateAt(v time.Time) *MajorIncidentUpdateOne {
+	_u.mutation.SetLastUpdateAt(v)
+	return _u
+}
+
+// SetNillableLastUpdateAt sets the "last_update_at" field if the given value is not nil.
+func (_u *MajorIncidentUpdateOne) SetNillableLastUpdateAt(v *time.Time) *MajorIncidentUpdateOne {
+	if v != nil {
+		_u.SetLastUpdateAt(*v)
+	}
+	return _u
+}
+
+// ClearLastUpdateAt clears the value of the "last_update_at" field.
+func (_u *MajorIncidentUpdateOne) ClearLastUpdateAt() *MajorIncidentUpdateOne {
+	_u.mutation.ClearLastUpdateAt()
+	return _u
+}
+
+// SetUpdateCount sets the "update_count" field.
+func (_u *MajorIncidentUpdateOne) SetUpdateCount(v int) *MajorIncidentUpdateOne {
+	_u.mutation.ResetUpdateCount()
+	_u.mutation.SetUpdateCount(v)
+	return _u
+}
+
+// SetNillableUpdateCount sets the "update_count" field if the given value is not nil.
+func (_u *MajorIncidentUpdateOne) SetNillableUpdateCount(v *int) *MajorIncidentUpdateOne {
+	if v != nil {
+		_u.SetUpdateCount(*v)
+	}
+	return _u
+}
+
+// AddUpdateCount adds value to the "update_count" field.
+func (_u *MajorIncidentUpdateOne) AddUpdateCount(v int) *MajorIncidentUpdateOne {
+	_u.mutation.AddUpdateCount(v)
+	return _u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_u *MajorIncidentUpdateOne) SetResolvedAt(v time.Time) *MajorIncidentUpdateOne {
+	_u.mutation.SetResolvedAt(v)
+	return _u
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_u *MajorIncidentUpdateOne) SetNillableResolvedAt(v *time.Time) *MajorIncidentUpdateOne {
+	if v != nil {
+		_u.SetResolvedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (_u *MajorIncidentUpdateOne) ClearResolvedAt() *MajorIncidentUpdateOne {
+	_u.mutation.ClearResolvedAt()
+	return _u
+}
+
+// SetPostmortemDraft sets the "postmortem_draft" field.
+func (_u *MajorIncidentUpdateOne) SetPostmortemDraft(v string) *MajorIncidentUpdateOne {
+	_u.mutation.SetPostmortemDraft(v)
+	return _u
+}
+
+// SetNillablePostmortemDraft sets the "postmortem_draft" field if the given value is not nil.
+func (_u *MajorIncidentUpdateOne) SetNillablePostmortemDraft(v *string) *MajorIncidentUpdateOne {
+	if v != nil {
+		_u.SetPostmortemDraft(*v)
+	}
+	return _u
+}
+
+// ClearPostmortemDraft clears the value of the "postmortem_draft" field.
+func (_u *MajorIncidentUpdateOne) ClearPostmortemDraft() *MajorIncidentUpdateOne {
+	_u.mutation.ClearPostmortemDraft()
+	return _u
+}
+
+// SetPostmortemGeneratedAt sets the "postmortem_generated_at" field.
+func (_u *MajorIncidentUpdateOne) SetPostmortemGeneratedAt(v time.Time) *MajorIncidentUpdateOne {
+	_u.mutation.SetPostmortemGeneratedAt(v)
+	return _u
+}
+
+// SetNillablePostmortemGeneratedAt sets the "postmortem_generated_at" field if the given value is not nil.
+func (_u *MajorIncidentUpdateOne) SetNillablePostmortemGeneratedAt(v *time.Time) *MajorIncidentUpdateOne {
+	if v != nil {
+		_u.SetPostmortemGeneratedAt(*v)
+	}
+	return _u
+}
+
+// ClearPostmortemGeneratedAt clears the value of the "postmortem_generated_at" field.
+func (_u *MajorIncidentUpdateOne) ClearPostmortemGeneratedAt() *MajorIncidentUpdateOne {
+	_u.mutation.ClearPostmortemGeneratedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *MajorIncidentUpdateOne) SetUpdatedAt(v time.Time) *MajorIncidentUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the MajorIncidentMutation object of the builder.
+func (_u *MajorIncidentUpdateOne) Mutation() *MajorIncidentMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the MajorIncidentUpdate builder.
+func (_u *MajorIncidentUpdateOne) Where(ps ...predicate.MajorIncident) *MajorIncidentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MajorIncidentUpdateOne) Select(field string, fields ...string) *MajorIncidentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated MajorIncident entity.
+func (_u *MajorIncidentUpdateOne) Save(ctx context.Context) (*MajorIncident, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MajorIncidentUpdateOne) SaveX(ctx context.Context) *MajorIncident {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MajorIncidentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MajorIncidentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MajorIncidentUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := majorincident.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MajorIncidentUpdateOne) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := majorincident.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "MajorIncident.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IncidentID(); ok {
+		if err := majorincident.IncidentIDValidator(v); err != nil {
+			return &ValidationError{Name: "incident_id", err: fmt.Errorf(`ent: validator failed for field "MajorIncident.incident_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CommanderID(); ok {
+		if err := majorincident.CommanderIDValidator(v); err != nil {
+			return &ValidationError{Name: "commander_id", err: fmt.Errorf(`ent: validator failed for field "MajorIncident.commander_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeclaredBy(); ok {
+		if err := majorincident.DeclaredByValidator(v); err != nil {
+			return &ValidationError{Name: "declared_by", err: fmt.Errorf(`ent: validator failed for field "MajorIncident.declared_by": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := majorincident.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "MajorIncident.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UpdateCadenceMinutes(); ok {
+		if err := majorincident.UpdateCadenceMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "update_cadence_minutes", err: fmt.Errorf(`ent: validator failed for field "MajorIncident.update_cadence_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UpdateCount(); ok {
+		if err := majorincident.UpdateCountValidator(v); err != nil {
+			return &ValidationError{Name: "update_count", err: fmt.Errorf(`ent: validator failed for field "MajorIncident.update_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *MajorIncidentUpdateOne) sqlSave(ctx context.Context) (_node *MajorIncident, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(majorincident.Table, majorincident.Columns, sqlgraph.NewFieldSpec(majorincident.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MajorIncident.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, majorincident.FieldID)
+		for _, f := range fields {
+			if !majorincident.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != majorincident.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(majorincident.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(majorincident.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IncidentID(); ok {
+		_spec.SetField(majorincident.FieldIncidentID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedIncidentID(); ok {
+		_spec.AddField(majorincident.FieldIncidentID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CommanderID(); ok {
+		_spec.SetField(majorincident.FieldCommanderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCommanderID(); ok {
+		_spec.AddField(majorincident.FieldCommanderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DeclaredBy(); ok {
+		_spec.SetField(majorincident.FieldDeclaredBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDeclaredBy(); ok {
+		_spec.AddField(majorincident.FieldDeclaredBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DeclaredAt(); ok {
+		_spec.SetField(majorincident.FieldDeclaredAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(majorincident.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.WarRoomConnector(); ok {
+		_spec.SetField(majorincident.FieldWarRoomConnector, field.TypeString, value)
+	}
+	if _u.mutation.WarRoomConnectorCleared() {
+		_spec.ClearField(majorincident.FieldWarRoomConnector, field.TypeString)
+	}
+	if value, ok := _u.mutation.WarRoomChatID(); ok {
+		_spec.SetField(majorincident.FieldWarRoomChatID, field.TypeString, value)
+	}
+	if _u.mutation.WarRoomChatIDCleared() {
+		_spec.ClearField(majorincident.FieldWarRoomChatID, field.TypeString)
+	}
+	if value, ok := _u.mutation.WarRoomChannel(); ok {
+		_spec.SetField(majorincident.FieldWarRoomChannel, field.TypeString, value)
+	}
+	if _u.mutation.WarRoomChannelCleared() {
+		_spec.ClearField(majorincident.FieldWarRoomChannel, field.TypeString)
+	}
+	if value, ok := _u.mutation.WarRoomError(); ok {
+		_spec.SetField(majorincident.FieldWarRoomError, field.TypeString, value)
+	}
+	if _u.mutation.WarRoomErrorCleared() {
+		_spec.ClearField(majorincident.FieldWarRoomError, field.TypeString)
+	}
+	if value, ok := _u.mutation.StakeholderUserIds(); ok {
+		_spec.SetField(majorincident.FieldStakeholderUserIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedStakeholderUserIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, majorincident.FieldStakeholderUserIds, value)
+		})
+	}
+	if _u.mutation.StakeholderUserIdsCleared() {
+		_spec.ClearField(majorincident.FieldStakeholderUserIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.StakeholderChannels(); ok {
+		_spec.SetField(majorincident.FieldStakeholderChannels, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedStakeholderChannels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, majorincident.FieldStakeholderChannels, value)
+		})
+	}
+	if _u.mutation.StakeholderChannelsCleared() {
+		_spec.ClearField(majorincident.FieldStakeholderChannels, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdateCadenceMinutes(); ok {
+		_spec.SetField(majorincident.FieldUpdateCadenceMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateCadenceMinutes(); ok {
+		_spec.AddField(majorincident.FieldUpdateCadenceMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextUpdateAt(); ok {
+		_spec.SetField(majorincident.FieldNextUpdateAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextUpdateAtCleared() {
+		_spec.ClearField(majorincident.FieldNextUpdateAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastUpdateAt(); ok {
+		_spec.SetField(majorincident.FieldLastUpdateAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastUpdateAtCleared() {
+		_spec.ClearField(majorincident.FieldLastUpdateAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdateCount(); ok {
+		_spec.SetField(majorincident.FieldUpdateCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUpdateCount(); ok {
+		_spec.AddField(majorincident.FieldUpdateCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ResolvedAt(); ok {
+		_spec.SetField(majorincident.FieldResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolvedAtCleared() {
+		_spec.ClearField(majorincident.FieldResolvedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PostmortemDraft(); ok {
+		_spec.SetField(majorincident.FieldPostmortemDraft, field.TypeString, value)
+	}
+	if _u.mutation.PostmortemDraftCleared() {
+		_spec.ClearField(majorincident.FieldPostmortemDraft, field.TypeString)
+	}
+	if value, ok := _u.mutation.PostmortemGeneratedAt(); ok {
+		_spec.SetField(majorincident.FieldPostmortemGeneratedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PostmortemGeneratedAtCleared() {
+		_spec.ClearField(majorincident.FieldPostmortemGeneratedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(majorincident.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &MajorIncident{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{majorincident.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
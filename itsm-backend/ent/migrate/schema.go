@@ -8,6 +8,36 @@ import (
 )
 
 var (
+	// AlertSuppressionRulesColumns holds the columns for the "alert_suppression_rules" table.
+	AlertSuppressionRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "source", Type: field.TypeString, Nullable: true},
+		{Name: "matchers", Type: field.TypeJSON},
+		{Name: "starts_at", Type: field.TypeTime, Nullable: true},
+		{Name: "ends_at", Type: field.TypeTime, Nullable: true},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "hit_count", Type: field.TypeInt, Default: 0},
+		{Name: "last_hit_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_by", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// AlertSuppressionRulesTable holds the schema information for the "alert_suppression_rules" table.
+	AlertSuppressionRulesTable = &schema.Table{
+		Name:       "alert_suppression_rules",
+		Columns:    AlertSuppressionRulesColumns,
+		PrimaryKey: []*schema.Column{AlertSuppressionRulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "alertsuppressionrule_tenant_id_enabled",
+				Unique:  false,
+				Columns: []*schema.Column{AlertSuppressionRulesColumns[1], AlertSuppressionRulesColumns[8]},
+			},
+		},
+	}
 	// ApplicationsColumns holds the columns for the "applications" table.
 	ApplicationsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -387,6 +417,38 @@ var (
 			},
 		},
 	}
+	// BusinessCalendarsColumns holds the columns for the "business_calendars" table.
+	BusinessCalendarsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "time_zone", Type: field.TypeString, Default: "Asia/Shanghai"},
+		{Name: "work_windows", Type: field.TypeJSON},
+		{Name: "holidays", Type: field.TypeJSON, Nullable: true},
+		{Name: "extra_workdays", Type: field.TypeJSON, Nullable: true},
+		{Name: "is_default", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// BusinessCalendarsTable holds the schema information for the "business_calendars" table.
+	BusinessCalendarsTable = &schema.Table{
+		Name:       "business_calendars",
+		Columns:    BusinessCalendarsColumns,
+		PrimaryKey: []*schema.Column{BusinessCalendarsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "businesscalendar_tenant_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{BusinessCalendarsColumns[1], BusinessCalendarsColumns[2]},
+			},
+			{
+				Name:    "businesscalendar_tenant_id_is_default",
+				Unique:  false,
+				Columns: []*schema.Column{BusinessCalendarsColumns[1], BusinessCalendarsColumns[8]},
+			},
+		},
+	}
 	// CabMembersColumns holds the columns for the "cab_members" table.
 	CabMembersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -1202,6 +1264,37 @@ var (
 		Columns:    ConversationsColumns,
 		PrimaryKey: []*schema.Column{ConversationsColumns[0]},
 	}
+	// DecisionDefinitionsColumns holds the columns for the "decision_definitions" table.
+	DecisionDefinitionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "key", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "version", Type: field.TypeInt},
+		{Name: "hit_policy", Type: field.TypeString, Size: 32, Default: "UNIQUE"},
+		{Name: "dmn_xml", Type: field.TypeString, Size: 2147483647},
+		{Name: "deployment_id", Type: field.TypeInt, Nullable: true},
+		{Name: "is_latest", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// DecisionDefinitionsTable holds the schema information for the "decision_definitions" table.
+	DecisionDefinitionsTable = &schema.Table{
+		Name:       "decision_definitions",
+		Columns:    DecisionDefinitionsColumns,
+		PrimaryKey: []*schema.Column{DecisionDefinitionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "decisiondefinition_tenant_id_key_version",
+				Unique:  true,
+				Columns: []*schema.Column{DecisionDefinitionsColumns[1], DecisionDefinitionsColumns[2], DecisionDefinitionsColumns[4]},
+			},
+			{
+				Name:    "decisiondefinition_tenant_id_key_is_latest",
+				Unique:  false,
+				Columns: []*schema.Column{DecisionDefinitionsColumns[1], DecisionDefinitionsColumns[2], DecisionDefinitionsColumns[8]},
+			},
+		},
+	}
 	// DepartmentsColumns holds the columns for the "departments" table.
 	DepartmentsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -1432,6 +1525,33 @@ var (
 		Columns:    EngineerSkillsColumns,
 		PrimaryKey: []*schema.Column{EngineerSkillsColumns[0]},
 	}
+	// EscalationPoliciesColumns holds the columns for the "escalation_policies" table.
+	EscalationPoliciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "team_id", Type: field.TypeInt, Nullable: true},
+		{Name: "steps", Type: field.TypeJSON},
+		{Name: "repeat_count", Type: field.TypeInt, Default: 0},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "created_by", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// EscalationPoliciesTable holds the schema information for the "escalation_policies" table.
+	EscalationPoliciesTable = &schema.Table{
+		Name:       "escalation_policies",
+		Columns:    EscalationPoliciesColumns,
+		PrimaryKey: []*schema.Column{EscalationPoliciesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "escalationpolicy_tenant_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{EscalationPoliciesColumns[1], EscalationPoliciesColumns[2]},
+			},
+		},
+	}
 	// FeishuTicketSyncsColumns holds the columns for the "feishu_ticket_syncs" table.
 	FeishuTicketSyncsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -1496,6 +1616,118 @@ var (
 			},
 		},
 	}
+	// InboundDedupKeysColumns holds the columns for the "inbound_dedup_keys" table.
+	InboundDedupKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt, Default: 0},
+		{Name: "dedup_key", Type: field.TypeString},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// InboundDedupKeysTable holds the schema information for the "inbound_dedup_keys" table.
+	InboundDedupKeysTable = &schema.Table{
+		Name:       "inbound_dedup_keys",
+		Columns:    InboundDedupKeysColumns,
+		PrimaryKey: []*schema.Column{InboundDedupKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "inbounddedupkey_tenant_id_dedup_key",
+				Unique:  true,
+				Columns: []*schema.Column{InboundDedupKeysColumns[1], InboundDedupKeysColumns[2]},
+			},
+			{
+				Name:    "inbounddedupkey_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{InboundDedupKeysColumns[3]},
+			},
+		},
+	}
+	// InboundEmailsColumns holds the columns for the "inbound_emails" table.
+	InboundEmailsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "mailbox", Type: field.TypeString, Nullable: true},
+		{Name: "message_id", Type: field.TypeString},
+		{Name: "in_reply_to", Type: field.TypeString, Nullable: true},
+		{Name: "references", Type: field.TypeJSON, Nullable: true},
+		{Name: "from_address", Type: field.TypeString, Nullable: true},
+		{Name: "from_name", Type: field.TypeString, Nullable: true},
+		{Name: "subject", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "created", "threaded", "ignored", "failed"}, Default: "pending"},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+		{Name: "ticket_id", Type: field.TypeInt, Nullable: true},
+		{Name: "comment_id", Type: field.TypeInt, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+		{Name: "attachment_count", Type: field.TypeInt, Default: 0},
+		{Name: "received_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// InboundEmailsTable holds the schema information for the "inbound_emails" table.
+	InboundEmailsTable = &schema.Table{
+		Name:       "inbound_emails",
+		Columns:    InboundEmailsColumns,
+		PrimaryKey: []*schema.Column{InboundEmailsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "inboundemail_tenant_id_message_id",
+				Unique:  true,
+				Columns: []*schema.Column{InboundEmailsColumns[1], InboundEmailsColumns[3]},
+			},
+			{
+				Name:    "inboundemail_tenant_id_ticket_id",
+				Unique:  false,
+				Columns: []*schema.Column{InboundEmailsColumns[1], InboundEmailsColumns[11]},
+			},
+			{
+				Name:    "inboundemail_tenant_id_received_at",
+				Unique:  false,
+				Columns: []*schema.Column{InboundEmailsColumns[1], InboundEmailsColumns[15]},
+			},
+		},
+	}
+	// InboundMessageLogsColumns holds the columns for the "inbound_message_logs" table.
+	InboundMessageLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "connector_name", Type: field.TypeString},
+		{Name: "message_id", Type: field.TypeString, Nullable: true},
+		{Name: "dedup_key", Type: field.TypeString, Nullable: true},
+		{Name: "message_type", Type: field.TypeString, Nullable: true},
+		{Name: "channel", Type: field.TypeString, Nullable: true},
+		{Name: "user_id", Type: field.TypeString, Nullable: true},
+		{Name: "chat_id", Type: field.TypeString, Nullable: true},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"received", "succeeded", "failed"}, Default: "received"},
+		{Name: "handler_results", Type: field.TypeJSON, Nullable: true},
+		{Name: "replay_count", Type: field.TypeInt, Default: 0},
+		{Name: "last_replayed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "received_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// InboundMessageLogsTable holds the schema information for the "inbound_message_logs" table.
+	InboundMessageLogsTable = &schema.Table{
+		Name:       "inbound_message_logs",
+		Columns:    InboundMessageLogsColumns,
+		PrimaryKey: []*schema.Column{InboundMessageLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "inboundmessagelog_tenant_id_connector_name_received_at",
+				Unique:  false,
+				Columns: []*schema.Column{InboundMessageLogsColumns[1], InboundMessageLogsColumns[2], InboundMessageLogsColumns[14]},
+			},
+			{
+				Name:    "inboundmessagelog_tenant_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{InboundMessageLogsColumns[1], InboundMessageLogsColumns[10]},
+			},
+			{
+				Name:    "inboundmessagelog_tenant_id_dedup_key",
+				Unique:  false,
+				Columns: []*schema.Column{InboundMessageLogsColumns[1], InboundMessageLogsColumns[4]},
+			},
+		},
+	}
 	// IncidentsColumns holds the columns for the "incidents" table.
 	IncidentsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -1977,6 +2209,49 @@ var (
 			},
 		},
 	}
+	// MajorIncidentsColumns holds the columns for the "major_incidents" table.
+	MajorIncidentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "incident_id", Type: field.TypeInt},
+		{Name: "commander_id", Type: field.TypeInt},
+		{Name: "declared_by", Type: field.TypeInt},
+		{Name: "declared_at", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "resolved"}, Default: "active"},
+		{Name: "war_room_connector", Type: field.TypeString, Nullable: true},
+		{Name: "war_room_chat_id", Type: field.TypeString, Nullable: true},
+		{Name: "war_room_channel", Type: field.TypeString, Nullable: true},
+		{Name: "war_room_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "stakeholder_user_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "stakeholder_channels", Type: field.TypeJSON, Nullable: true},
+		{Name: "update_cadence_minutes", Type: field.TypeInt, Default: 30},
+		{Name: "next_update_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_update_at", Type: field.TypeTime, Nullable: true},
+		{Name: "update_count", Type: field.TypeInt, Default: 0},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "postmortem_draft", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "postmortem_generated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// MajorIncidentsTable holds the schema information for the "major_incidents" table.
+	MajorIncidentsTable = &schema.Table{
+		Name:       "major_incidents",
+		Columns:    MajorIncidentsColumns,
+		PrimaryKey: []*schema.Column{MajorIncidentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "majorincident_tenant_id_incident_id",
+				Unique:  true,
+				Columns: []*schema.Column{MajorIncidentsColumns[1], MajorIncidentsColumns[2]},
+			},
+			{
+				Name:    "majorincident_status_next_update_at",
+				Unique:  false,
+				Columns: []*schema.Column{MajorIncidentsColumns[6], MajorIncidentsColumns[14]},
+			},
+		},
+	}
 	// MarketplaceItemsColumns holds the columns for the "marketplace_items" table.
 	MarketplaceItemsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -2113,6 +2388,60 @@ var (
 			},
 		},
 	}
+	// MonitoringAlertsColumns holds the columns for the "monitoring_alerts" table.
+	MonitoringAlertsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "source", Type: field.TypeString},
+		{Name: "fingerprint", Type: field.TypeString},
+		{Name: "alert_name", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"firing", "resolved"}, Default: "firing"},
+		{Name: "severity", Type: field.TypeString, Default: "medium"},
+		{Name: "summary", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "labels", Type: field.TypeJSON, Nullable: true},
+		{Name: "annotations", Type: field.TypeJSON, Nullable: true},
+		{Name: "generator_url", Type: field.TypeString, Nullable: true},
+		{Name: "starts_at", Type: field.TypeTime},
+		{Name: "ends_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_received_at", Type: field.TypeTime},
+		{Name: "receive_count", Type: field.TypeInt, Default: 1},
+		{Name: "incident_id", Type: field.TypeInt, Nullable: true},
+		{Name: "incident_alert_id", Type: field.TypeInt, Nullable: true},
+		{Name: "configuration_item_id", Type: field.TypeInt, Nullable: true},
+		{Name: "correlation_reason", Type: field.TypeEnum, Nullable: true, Enums: []string{"label", "topology", "similarity"}},
+		{Name: "root_alert_id", Type: field.TypeInt, Nullable: true},
+		{Name: "suppressed_by_rule_id", Type: field.TypeInt, Nullable: true},
+		{Name: "state_changes", Type: field.TypeJSON, Nullable: true},
+		{Name: "flapping", Type: field.TypeBool, Default: false},
+		{Name: "incidents_opened", Type: field.TypeInt, Default: 0},
+		{Name: "correlated_count", Type: field.TypeInt, Default: 0},
+		{Name: "suppressed_count", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// MonitoringAlertsTable holds the schema information for the "monitoring_alerts" table.
+	MonitoringAlertsTable = &schema.Table{
+		Name:       "monitoring_alerts",
+		Columns:    MonitoringAlertsColumns,
+		PrimaryKey: []*schema.Column{MonitoringAlertsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "monitoringalert_tenant_id_source_fingerprint",
+				Unique:  true,
+				Columns: []*schema.Column{MonitoringAlertsColumns[1], MonitoringAlertsColumns[2], MonitoringAlertsColumns[3]},
+			},
+			{
+				Name:    "monitoringalert_tenant_id_incident_id",
+				Unique:  false,
+				Columns: []*schema.Column{MonitoringAlertsColumns[1], MonitoringAlertsColumns[15]},
+			},
+			{
+				Name:    "monitoringalert_tenant_id_status_last_received_at",
+				Unique:  false,
+				Columns: []*schema.Column{MonitoringAlertsColumns[1], MonitoringAlertsColumns[5], MonitoringAlertsColumns[13]},
+			},
+		},
+	}
 	// NotificationsColumns holds the columns for the "notifications" table.
 	NotificationsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -2206,6 +2535,105 @@ var (
 			},
 		},
 	}
+	// OnCallOverridesColumns holds the columns for the "on_call_overrides" table.
+	OnCallOverridesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "schedule_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "original_user_id", Type: field.TypeInt, Nullable: true},
+		{Name: "start_at", Type: field.TypeTime},
+		{Name: "end_at", Type: field.TypeTime},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "swap_group", Type: field.TypeString, Nullable: true},
+		{Name: "created_by", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// OnCallOverridesTable holds the schema information for the "on_call_overrides" table.
+	OnCallOverridesTable = &schema.Table{
+		Name:       "on_call_overrides",
+		Columns:    OnCallOverridesColumns,
+		PrimaryKey: []*schema.Column{OnCallOverridesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "oncalloverride_tenant_id_schedule_id_start_at",
+				Unique:  false,
+				Columns: []*schema.Column{OnCallOverridesColumns[1], OnCallOverridesColumns[2], OnCallOverridesColumns[5]},
+			},
+			{
+				Name:    "oncalloverride_swap_group",
+				Unique:  false,
+				Columns: []*schema.Column{OnCallOverridesColumns[8]},
+			},
+		},
+	}
+	// OnCallPagesColumns holds the columns for the "on_call_pages" table.
+	OnCallPagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "policy_id", Type: field.TypeInt},
+		{Name: "incident_id", Type: field.TypeInt},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"triggered", "acknowledged", "resolved", "exhausted"}, Default: "triggered"},
+		{Name: "step", Type: field.TypeInt, Default: 0},
+		{Name: "round", Type: field.TypeInt, Default: 0},
+		{Name: "notified_user_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "next_escalation_at", Type: field.TypeTime, Nullable: true},
+		{Name: "acknowledged_by", Type: field.TypeInt, Nullable: true},
+		{Name: "acknowledged_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_by", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// OnCallPagesTable holds the schema information for the "on_call_pages" table.
+	OnCallPagesTable = &schema.Table{
+		Name:       "on_call_pages",
+		Columns:    OnCallPagesColumns,
+		PrimaryKey: []*schema.Column{OnCallPagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "oncallpage_status_next_escalation_at",
+				Unique:  false,
+				Columns: []*schema.Column{OnCallPagesColumns[4], OnCallPagesColumns[8]},
+			},
+			{
+				Name:    "oncallpage_tenant_id_incident_id",
+				Unique:  false,
+				Columns: []*schema.Column{OnCallPagesColumns[1], OnCallPagesColumns[3]},
+			},
+		},
+	}
+	// OnCallSchedulesColumns holds the columns for the "on_call_schedules" table.
+	OnCallSchedulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "team_id", Type: field.TypeInt, Nullable: true},
+		{Name: "time_zone", Type: field.TypeString, Default: "Asia/Shanghai"},
+		{Name: "layers", Type: field.TypeJSON},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "created_by", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// OnCallSchedulesTable holds the schema information for the "on_call_schedules" table.
+	OnCallSchedulesTable = &schema.Table{
+		Name:       "on_call_schedules",
+		Columns:    OnCallSchedulesColumns,
+		PrimaryKey: []*schema.Column{OnCallSchedulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "oncallschedule_tenant_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{OnCallSchedulesColumns[1], OnCallSchedulesColumns[2]},
+			},
+			{
+				Name:    "oncallschedule_tenant_id_team_id",
+				Unique:  false,
+				Columns: []*schema.Column{OnCallSchedulesColumns[1], OnCallSchedulesColumns[4]},
+			},
+		},
+	}
 	// OperationalCommandsColumns holds the columns for the "operational_commands" table.
 	OperationalCommandsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -2677,6 +3105,95 @@ var (
 			},
 		},
 	}
+	// ProcessEventInstancesColumns holds the columns for the "process_event_instances" table.
+	ProcessEventInstancesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "event_instance_id", Type: field.TypeString, Unique: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "event_definition_id", Type: field.TypeString},
+		{Name: "event_type", Type: field.TypeString, Nullable: true, Size: 32},
+		{Name: "trigger", Type: field.TypeString, Nullable: true, Size: 32},
+		{Name: "event_name", Type: field.TypeString, Nullable: true, Size: 200},
+		{Name: "process_instance_id", Type: field.TypeString, Nullable: true},
+		{Name: "subscription_id", Type: field.TypeInt, Nullable: true},
+		{Name: "correlation_keys", Type: field.TypeJSON, Nullable: true},
+		{Name: "variables", Type: field.TypeJSON, Nullable: true},
+		{Name: "status", Type: field.TypeString, Size: 32, Default: "pending"},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2000},
+		{Name: "retry_count", Type: field.TypeInt, Default: 0},
+		{Name: "triggered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ProcessEventInstancesTable holds the schema information for the "process_event_instances" table.
+	ProcessEventInstancesTable = &schema.Table{
+		Name:       "process_event_instances",
+		Columns:    ProcessEventInstancesColumns,
+		PrimaryKey: []*schema.Column{ProcessEventInstancesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "processeventinstance_tenant_id_process_instance_id",
+				Unique:  false,
+				Columns: []*schema.Column{ProcessEventInstancesColumns[2], ProcessEventInstancesColumns[7]},
+			},
+			{
+				Name:    "processeventinstance_tenant_id_event_definition_id",
+				Unique:  false,
+				Columns: []*schema.Column{ProcessEventInstancesColumns[2], ProcessEventInstancesColumns[3]},
+			},
+			{
+				Name:    "processeventinstance_tenant_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{ProcessEventInstancesColumns[2], ProcessEventInstancesColumns[11]},
+			},
+			{
+				Name:    "processeventinstance_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ProcessEventInstancesColumns[16]},
+			},
+		},
+	}
+	// ProcessEventSubscriptionsColumns holds the columns for the "process_event_subscriptions" table.
+	ProcessEventSubscriptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "process_instance_id", Type: field.TypeInt},
+		{Name: "process_definition_key", Type: field.TypeString},
+		{Name: "business_key", Type: field.TypeString, Nullable: true},
+		{Name: "element_id", Type: field.TypeString},
+		{Name: "attached_to", Type: field.TypeString, Nullable: true},
+		{Name: "interrupting", Type: field.TypeBool, Default: true},
+		{Name: "event_type", Type: field.TypeString, Size: 32},
+		{Name: "event_name", Type: field.TypeString, Size: 200},
+		{Name: "status", Type: field.TypeString, Size: 32, Default: "waiting"},
+		{Name: "correlated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ProcessEventSubscriptionsTable holds the schema information for the "process_event_subscriptions" table.
+	ProcessEventSubscriptionsTable = &schema.Table{
+		Name:       "process_event_subscriptions",
+		Columns:    ProcessEventSubscriptionsColumns,
+		PrimaryKey: []*schema.Column{ProcessEventSubscriptionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "processeventsubscription_tenant_id_event_type_event_name_status",
+				Unique:  false,
+				Columns: []*schema.Column{ProcessEventSubscriptionsColumns[1], ProcessEventSubscriptionsColumns[8], ProcessEventSubscriptionsColumns[9], ProcessEventSubscriptionsColumns[10]},
+			},
+			{
+				Name:    "processeventsubscription_tenant_id_process_instance_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{ProcessEventSubscriptionsColumns[1], ProcessEventSubscriptionsColumns[2], ProcessEventSubscriptionsColumns[10]},
+			},
+			{
+				Name:    "processeventsubscription_business_key",
+				Unique:  false,
+				Columns: []*schema.Column{ProcessEventSubscriptionsColumns[4]},
+			},
+		},
+	}
 	// ProcessExecutionHistoriesColumns holds the columns for the "process_execution_histories" table.
 	ProcessExecutionHistoriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -2764,6 +3281,41 @@ var (
 			},
 		},
 	}
+	// ProcessIncidentsColumns holds the columns for the "process_incidents" table.
+	ProcessIncidentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "process_instance_id", Type: field.TypeInt},
+		{Name: "process_definition_key", Type: field.TypeString},
+		{Name: "element_id", Type: field.TypeString},
+		{Name: "incident_type", Type: field.TypeString, Size: 32, Default: "failed_job"},
+		{Name: "message", Type: field.TypeString, Nullable: true, Size: 2000},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "status", Type: field.TypeString, Size: 32, Default: "open"},
+		{Name: "resolution", Type: field.TypeString, Nullable: true, Size: 32},
+		{Name: "resolved_by", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ProcessIncidentsTable holds the schema information for the "process_incidents" table.
+	ProcessIncidentsTable = &schema.Table{
+		Name:       "process_incidents",
+		Columns:    ProcessIncidentsColumns,
+		PrimaryKey: []*schema.Column{ProcessIncidentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "processincident_tenant_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{ProcessIncidentsColumns[1], ProcessIncidentsColumns[8]},
+			},
+			{
+				Name:    "processincident_tenant_id_process_instance_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{ProcessIncidentsColumns[1], ProcessIncidentsColumns[2], ProcessIncidentsColumns[8]},
+			},
+		},
+	}
 	// ProcessInstancesColumns holds the columns for the "process_instances" table.
 	ProcessInstancesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -3400,6 +3952,8 @@ var (
 		{Name: "response_time", Type: field.TypeInt, Default: 30},
 		{Name: "resolution_time", Type: field.TypeInt, Default: 240},
 		{Name: "business_hours", Type: field.TypeJSON, Nullable: true},
+		{Name: "calendar_id", Type: field.TypeInt, Nullable: true},
+		{Name: "pause_conditions", Type: field.TypeJSON, Nullable: true},
 		{Name: "escalation_rules", Type: field.TypeJSON, Nullable: true},
 		{Name: "conditions", Type: field.TypeJSON, Nullable: true},
 		{Name: "is_active", Type: field.TypeBool, Default: true},
@@ -3416,7 +3970,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "sla_definitions_sla_policies_sla_definition",
-				Columns:    []*schema.Column{SLADefinitionsColumns[14]},
+				Columns:    []*schema.Column{SLADefinitionsColumns[16]},
 				RefColumns: []*schema.Column{SLAPoliciesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -3458,9 +4012,12 @@ var (
 		{Name: "customer_tier", Type: field.TypeString, Nullable: true},
 		{Name: "ticket_type", Type: field.TypeString, Nullable: true},
 		{Name: "priority", Type: field.TypeString, Nullable: true},
+		{Name: "agreement_type", Type: field.TypeString, Default: "sla"},
+		{Name: "metrics", Type: field.TypeJSON, Nullable: true},
 		{Name: "response_time_minutes", Type: field.TypeInt},
 		{Name: "resolution_time_minutes", Type: field.TypeInt},
 		{Name: "business_hours", Type: field.TypeJSON, Nullable: true},
+		{Name: "calendar_id", Type: field.TypeInt, Nullable: true},
 		{Name: "exclude_weekends", Type: field.TypeBool, Default: false},
 		{Name: "exclude_holidays", Type: field.TypeBool, Default: false},
 		{Name: "escalation_rules", Type: field.TypeJSON, Nullable: true},
@@ -3469,12 +4026,28 @@ var (
 		{Name: "tenant_id", Type: field.TypeInt},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "team_id", Type: field.TypeInt, Nullable: true},
+		{Name: "vendor_id", Type: field.TypeInt, Nullable: true},
 	}
 	// SLAPoliciesTable holds the schema information for the "sla_policies" table.
 	SLAPoliciesTable = &schema.Table{
 		Name:       "sla_policies",
 		Columns:    SLAPoliciesColumns,
 		PrimaryKey: []*schema.Column{SLAPoliciesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sla_policies_teams_olas",
+				Columns:    []*schema.Column{SLAPoliciesColumns[20]},
+				RefColumns: []*schema.Column{TeamsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "sla_policies_vendors_underpinning_contracts",
+				Columns:    []*schema.Column{SLAPoliciesColumns[21]},
+				RefColumns: []*schema.Column{VendorsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
 	}
 	// SLAViolationsColumns holds the columns for the "sla_violations" table.
 	SLAViolationsColumns = []*schema.Column{
@@ -3483,6 +4056,8 @@ var (
 		{Name: "ticket_type", Type: field.TypeString, Default: "ticket"},
 		{Name: "sla_name", Type: field.TypeString, Default: "Default SLA"},
 		{Name: "violation_type", Type: field.TypeString},
+		{Name: "agreement_type", Type: field.TypeString, Default: "sla"},
+		{Name: "metric_key", Type: field.TypeString, Nullable: true},
 		{Name: "violation_time", Type: field.TypeTime},
 		{Name: "violation_occurred_at", Type: field.TypeTime},
 		{Name: "expected_time", Type: field.TypeInt, Nullable: true},
@@ -3508,18 +4083,55 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "sla_violations_sla_definitions_violations",
-				Columns:    []*schema.Column{SLAViolationsColumns[19]},
+				Columns:    []*schema.Column{SLAViolationsColumns[21]},
 				RefColumns: []*schema.Column{SLADefinitionsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "sla_violations_tickets_sla_violations",
-				Columns:    []*schema.Column{SLAViolationsColumns[20]},
+				Columns:    []*schema.Column{SLAViolationsColumns[22]},
 				RefColumns: []*schema.Column{TicketsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 	}
+	// SearchDocumentsColumns holds the columns for the "search_documents" table.
+	SearchDocumentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "entity_type", Type: field.TypeString},
+		{Name: "entity_id", Type: field.TypeInt},
+		{Name: "parent_id", Type: field.TypeInt, Nullable: true},
+		{Name: "reference", Type: field.TypeString, Nullable: true},
+		{Name: "title", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "body", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "title_terms", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "terms", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "owner_id", Type: field.TypeInt, Nullable: true},
+		{Name: "assignee_id", Type: field.TypeInt, Nullable: true},
+		{Name: "restricted", Type: field.TypeBool, Default: false},
+		{Name: "source_updated_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// SearchDocumentsTable holds the schema information for the "search_documents" table.
+	SearchDocumentsTable = &schema.Table{
+		Name:       "search_documents",
+		Columns:    SearchDocumentsColumns,
+		PrimaryKey: []*schema.Column{SearchDocumentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "searchdocument_tenant_id_entity_type_entity_id",
+				Unique:  true,
+				Columns: []*schema.Column{SearchDocumentsColumns[1], SearchDocumentsColumns[2], SearchDocumentsColumns[3]},
+			},
+			{
+				Name:    "searchdocument_tenant_id_entity_type_parent_id",
+				Unique:  false,
+				Columns: []*schema.Column{SearchDocumentsColumns[1], SearchDocumentsColumns[2], SearchDocumentsColumns[4]},
+			},
+		},
+	}
 	// ServiceCatalogsColumns holds the columns for the "service_catalogs" table.
 	ServiceCatalogsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -3899,6 +4511,7 @@ var (
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "status", Type: field.TypeString, Default: "active"},
 		{Name: "manager_id", Type: field.TypeInt, Nullable: true},
+		{Name: "calendar_id", Type: field.TypeInt, Nullable: true},
 		{Name: "tenant_id", Type: field.TypeInt},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
@@ -4006,6 +4619,9 @@ var (
 		{Name: "sla_definition_id", Type: field.TypeInt, Nullable: true},
 		{Name: "sla_response_deadline", Type: field.TypeTime, Nullable: true},
 		{Name: "sla_resolution_deadline", Type: field.TypeTime, Nullable: true},
+		{Name: "sla_on_hold", Type: field.TypeBool, Default: false},
+		{Name: "sla_paused_at", Type: field.TypeTime, Nullable: true},
+		{Name: "vendor_id", Type: field.TypeInt, Nullable: true},
 		{Name: "first_response_at", Type: field.TypeTime, Nullable: true},
 		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
 		{Name: "resolution", Type: field.TypeString, Nullable: true, Size: 2147483647},
@@ -4042,61 +4658,61 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "tickets_configuration_items_tickets",
-				Columns:    []*schema.Column{TicketsColumns[35]},
+				Columns:    []*schema.Column{TicketsColumns[38]},
 				RefColumns: []*schema.Column{ConfigurationItemsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "tickets_departments_tickets",
-				Columns:    []*schema.Column{TicketsColumns[36]},
+				Columns:    []*schema.Column{TicketsColumns[39]},
 				RefColumns: []*schema.Column{DepartmentsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "tickets_problems_tickets",
-				Columns:    []*schema.Column{TicketsColumns[37]},
+				Columns:    []*schema.Column{TicketsColumns[40]},
 				RefColumns: []*schema.Column{ProblemsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "tickets_sla_definitions_tickets",
-				Columns:    []*schema.Column{TicketsColumns[38]},
+				Columns:    []*schema.Column{TicketsColumns[41]},
 				RefColumns: []*schema.Column{SLADefinitionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "tickets_sla_policies_tickets",
-				Columns:    []*schema.Column{TicketsColumns[39]},
+				Columns:    []*schema.Column{TicketsColumns[42]},
 				RefColumns: []*schema.Column{SLAPoliciesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "tickets_ticket_tags_tickets",
-				Columns:    []*schema.Column{TicketsColumns[40]},
+				Columns:    []*schema.Column{TicketsColumns[43]},
 				RefColumns: []*schema.Column{TicketTagsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "tickets_ticket_templates_tickets",
-				Columns:    []*schema.Column{TicketsColumns[41]},
+				Columns:    []*schema.Column{TicketsColumns[44]},
 				RefColumns: []*schema.Column{TicketTemplatesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "tickets_ticket_types_tickets",
-				Columns:    []*schema.Column{TicketsColumns[42]},
+				Columns:    []*schema.Column{TicketsColumns[45]},
 				RefColumns: []*schema.Column{TicketTypesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "tickets_users_tickets",
-				Columns:    []*schema.Column{TicketsColumns[43]},
+				Columns:    []*schema.Column{TicketsColumns[46]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "tickets_users_assigned_tickets",
-				Columns:    []*schema.Column{TicketsColumns[44]},
+				Columns:    []*schema.Column{TicketsColumns[47]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -4125,17 +4741,17 @@ var (
 			{
 				Name:    "ticket_requester_id",
 				Unique:  false,
-				Columns: []*schema.Column{TicketsColumns[43]},
+				Columns: []*schema.Column{TicketsColumns[46]},
 			},
 			{
 				Name:    "ticket_assignee_id",
 				Unique:  false,
-				Columns: []*schema.Column{TicketsColumns[44]},
+				Columns: []*schema.Column{TicketsColumns[47]},
 			},
 			{
 				Name:    "ticket_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{TicketsColumns[28]},
+				Columns: []*schema.Column{TicketsColumns[31]},
 			},
 			{
 				Name:    "ticket_tenant_id",
@@ -4150,12 +4766,12 @@ var (
 			{
 				Name:    "ticket_tenant_id_requester_id",
 				Unique:  false,
-				Columns: []*schema.Column{TicketsColumns[10], TicketsColumns[43]},
+				Columns: []*schema.Column{TicketsColumns[10], TicketsColumns[46]},
 			},
 			{
 				Name:    "ticket_tenant_id_ticket_type_id",
 				Unique:  false,
-				Columns: []*schema.Column{TicketsColumns[10], TicketsColumns[42]},
+				Columns: []*schema.Column{TicketsColumns[10], TicketsColumns[45]},
 			},
 			{
 				Name:    "ticket_status_priority",
@@ -4165,7 +4781,7 @@ var (
 			{
 				Name:    "ticket_requester_id_status",
 				Unique:  false,
-				Columns: []*schema.Column{TicketsColumns[43], TicketsColumns[3]},
+				Columns: []*schema.Column{TicketsColumns[46], TicketsColumns[3]},
 			},
 		},
 	}
@@ -4417,6 +5033,198 @@ var (
 			},
 		},
 	}
+	// TicketSLAMetricsColumns holds the columns for the "ticket_sla_metrics" table.
+	TicketSLAMetricsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "policy_id", Type: field.TypeInt},
+		{Name: "sla_definition_id", Type: field.TypeInt, Nullable: true},
+		{Name: "agreement_type", Type: field.TypeString, Default: "sla"},
+		{Name: "metric_key", Type: field.TypeString},
+		{Name: "metric_name", Type: field.TypeString, Nullable: true},
+		{Name: "cycle", Type: field.TypeInt, Default: 1},
+		{Name: "status", Type: field.TypeString, Default: "running"},
+		{Name: "target_minutes", Type: field.TypeInt},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "deadline", Type: field.TypeTime},
+		{Name: "paused_at", Type: field.TypeTime, Nullable: true},
+		{Name: "paused_minutes", Type: field.TypeInt, Default: 0},
+		{Name: "stopped_at", Type: field.TypeTime, Nullable: true},
+		{Name: "breached", Type: field.TypeBool, Default: false},
+		{Name: "breached_at", Type: field.TypeTime, Nullable: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "ticket_id", Type: field.TypeInt},
+	}
+	// TicketSLAMetricsTable holds the schema information for the "ticket_sla_metrics" table.
+	TicketSLAMetricsTable = &schema.Table{
+		Name:       "ticket_sla_metrics",
+		Columns:    TicketSLAMetricsColumns,
+		PrimaryKey: []*schema.Column{TicketSLAMetricsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "ticket_sla_metrics_tickets_sla_metrics",
+				Columns:    []*schema.Column{TicketSLAMetricsColumns[19]},
+				RefColumns: []*schema.Column{TicketsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ticketslametric_tenant_id_ticket_id",
+				Unique:  false,
+				Columns: []*schema.Column{TicketSLAMetricsColumns[16], TicketSLAMetricsColumns[19]},
+			},
+			{
+				Name:    "ticketslametric_ticket_id_policy_id_metric_key_cycle",
+				Unique:  true,
+				Columns: []*schema.Column{TicketSLAMetricsColumns[19], TicketSLAMetricsColumns[1], TicketSLAMetricsColumns[4], TicketSLAMetricsColumns[6]},
+			},
+			{
+				Name:    "ticketslametric_tenant_id_status_deadline",
+				Unique:  false,
+				Columns: []*schema.Column{TicketSLAMetricsColumns[16], TicketSLAMetricsColumns[7], TicketSLAMetricsColumns[10]},
+			},
+			{
+				Name:    "ticketslametric_sla_definition_id",
+				Unique:  false,
+				Columns: []*schema.Column{TicketSLAMetricsColumns[2]},
+			},
+		},
+	}
+	// TicketSLAPausesColumns holds the columns for the "ticket_sla_pauses" table.
+	TicketSLAPausesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "sla_definition_id", Type: field.TypeInt, Nullable: true},
+		{Name: "reason", Type: field.TypeString},
+		{Name: "ticket_status", Type: field.TypeString, Nullable: true},
+		{Name: "paused_at", Type: field.TypeTime},
+		{Name: "resumed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "paused_minutes", Type: field.TypeInt, Default: 0},
+		{Name: "response_deadline_before", Type: field.TypeTime, Nullable: true},
+		{Name: "response_deadline_after", Type: field.TypeTime, Nullable: true},
+		{Name: "resolution_deadline_before", Type: field.TypeTime, Nullable: true},
+		{Name: "resolution_deadline_after", Type: field.TypeTime, Nullable: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "ticket_id", Type: field.TypeInt},
+	}
+	// TicketSLAPausesTable holds the schema information for the "ticket_sla_pauses" table.
+	TicketSLAPausesTable = &schema.Table{
+		Name:       "ticket_sla_pauses",
+		Columns:    TicketSLAPausesColumns,
+		PrimaryKey: []*schema.Column{TicketSLAPausesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "ticket_sla_pauses_tickets_sla_pauses",
+				Columns:    []*schema.Column{TicketSLAPausesColumns[14]},
+				RefColumns: []*schema.Column{TicketsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ticketslapause_tenant_id_ticket_id",
+				Unique:  false,
+				Columns: []*schema.Column{TicketSLAPausesColumns[11], TicketSLAPausesColumns[14]},
+			},
+			{
+				Name:    "ticketslapause_ticket_id_resumed_at",
+				Unique:  false,
+				Columns: []*schema.Column{TicketSLAPausesColumns[14], TicketSLAPausesColumns[5]},
+			},
+		},
+	}
+	// TicketSchedulesColumns holds the columns for the "ticket_schedules" table.
+	TicketSchedulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "source_type", Type: field.TypeEnum, Enums: []string{"template", "catalog_item"}},
+		{Name: "template_id", Type: field.TypeInt, Nullable: true},
+		{Name: "catalog_item_id", Type: field.TypeInt, Nullable: true},
+		{Name: "title", Type: field.TypeString, Nullable: true},
+		{Name: "priority", Type: field.TypeString, Nullable: true},
+		{Name: "requester_id", Type: field.TypeInt},
+		{Name: "assignee_id", Type: field.TypeInt, Nullable: true},
+		{Name: "form_fields", Type: field.TypeJSON, Nullable: true},
+		{Name: "rule_type", Type: field.TypeEnum, Enums: []string{"cron", "rrule"}},
+		{Name: "expression", Type: field.TypeString},
+		{Name: "time_zone", Type: field.TypeString, Default: "Asia/Shanghai"},
+		{Name: "start_at", Type: field.TypeTime},
+		{Name: "end_at", Type: field.TypeTime, Nullable: true},
+		{Name: "calendar_id", Type: field.TypeInt, Nullable: true},
+		{Name: "holiday_policy", Type: field.TypeEnum, Enums: []string{"none", "skip", "next_business_day"}, Default: "none"},
+		{Name: "catch_up_policy", Type: field.TypeEnum, Enums: []string{"all", "latest", "none"}, Default: "latest"},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "revision", Type: field.TypeInt, Default: 1},
+		{Name: "next_run_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_occurrence_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_run_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_ticket_id", Type: field.TypeInt, Nullable: true},
+		{Name: "created_by", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// TicketSchedulesTable holds the schema information for the "ticket_schedules" table.
+	TicketSchedulesTable = &schema.Table{
+		Name:       "ticket_schedules",
+		Columns:    TicketSchedulesColumns,
+		PrimaryKey: []*schema.Column{TicketSchedulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ticketschedule_tenant_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{TicketSchedulesColumns[1], TicketSchedulesColumns[2]},
+			},
+			{
+				Name:    "ticketschedule_tenant_id_enabled",
+				Unique:  false,
+				Columns: []*schema.Column{TicketSchedulesColumns[1], TicketSchedulesColumns[20]},
+			},
+		},
+	}
+	// TicketScheduleRunsColumns holds the columns for the "ticket_schedule_runs" table.
+	TicketScheduleRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "occurrence_at", Type: field.TypeTime},
+		{Name: "fire_at", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "generating", "generated", "skipped", "failed"}, Default: "pending"},
+		{Name: "ticket_id", Type: field.TypeInt, Nullable: true},
+		{Name: "message", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "schedule_id", Type: field.TypeInt},
+	}
+	// TicketScheduleRunsTable holds the schema information for the "ticket_schedule_runs" table.
+	TicketScheduleRunsTable = &schema.Table{
+		Name:       "ticket_schedule_runs",
+		Columns:    TicketScheduleRunsColumns,
+		PrimaryKey: []*schema.Column{TicketScheduleRunsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "ticket_schedule_runs_ticket_schedules_runs",
+				Columns:    []*schema.Column{TicketScheduleRunsColumns[9]},
+				RefColumns: []*schema.Column{TicketSchedulesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ticketschedulerun_schedule_id_occurrence_at",
+				Unique:  true,
+				Columns: []*schema.Column{TicketScheduleRunsColumns[9], TicketScheduleRunsColumns[2]},
+			},
+			{
+				Name:    "ticketschedulerun_tenant_id_schedule_id",
+				Unique:  false,
+				Columns: []*schema.Column{TicketScheduleRunsColumns[1], TicketScheduleRunsColumns[9]},
+			},
+		},
+	}
 	// TicketTagsColumns holds the columns for the "ticket_tags" table.
 	TicketTagsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -4529,6 +5337,7 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "name", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "query", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "filters", Type: field.TypeJSON, Nullable: true},
 		{Name: "columns", Type: field.TypeJSON, Nullable: true},
 		{Name: "sort_config", Type: field.TypeJSON, Nullable: true},
@@ -4547,7 +5356,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "ticket_views_users_creator",
-				Columns:    []*schema.Column{TicketViewsColumns[11]},
+				Columns:    []*schema.Column{TicketViewsColumns[12]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -4636,6 +5445,8 @@ var (
 		{Name: "department", Type: field.TypeString, Nullable: true},
 		{Name: "phone", Type: field.TypeString, Nullable: true},
 		{Name: "feishu_open_id", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "slack_user_id", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "teams_user_id", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "password_hash", Type: field.TypeString},
 		{Name: "active", Type: field.TypeBool, Default: true},
 		{Name: "created_at", Type: field.TypeTime},
@@ -4657,31 +5468,31 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "users_assets_assigned_to_user",
-				Columns:    []*schema.Column{UsersColumns[15]},
+				Columns:    []*schema.Column{UsersColumns[17]},
 				RefColumns: []*schema.Column{AssetsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "users_departments_users",
-				Columns:    []*schema.Column{UsersColumns[16]},
+				Columns:    []*schema.Column{UsersColumns[18]},
 				RefColumns: []*schema.Column{DepartmentsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "users_groups_members",
-				Columns:    []*schema.Column{UsersColumns[17]},
+				Columns:    []*schema.Column{UsersColumns[19]},
 				RefColumns: []*schema.Column{GroupsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "users_teams_users",
-				Columns:    []*schema.Column{UsersColumns[18]},
+				Columns:    []*schema.Column{UsersColumns[20]},
 				RefColumns: []*schema.Column{TeamsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "users_tenants_users",
-				Columns:    []*schema.Column{UsersColumns[19]},
+				Columns:    []*schema.Column{UsersColumns[21]},
 				RefColumns: []*schema.Column{TenantsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -4710,6 +5521,64 @@ var (
 		Columns:    VendorsColumns,
 		PrimaryKey: []*schema.Column{VendorsColumns[0]},
 	}
+	// WorkLogsColumns holds the columns for the "work_logs" table.
+	WorkLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "team_id", Type: field.TypeInt, Nullable: true},
+		{Name: "ticket_id", Type: field.TypeInt, Nullable: true},
+		{Name: "change_id", Type: field.TypeInt, Nullable: true},
+		{Name: "problem_id", Type: field.TypeInt, Nullable: true},
+		{Name: "category", Type: field.TypeString, Nullable: true},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "ended_at", Type: field.TypeTime, Nullable: true},
+		{Name: "duration_minutes", Type: field.TypeInt, Default: 0},
+		{Name: "billable", Type: field.TypeBool, Default: false},
+		{Name: "activity_type", Type: field.TypeEnum, Enums: []string{"general", "troubleshooting", "implementation", "communication", "meeting", "travel", "onsite", "documentation"}, Default: "general"},
+		{Name: "comment", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "msp_provider_id", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// WorkLogsTable holds the schema information for the "work_logs" table.
+	WorkLogsTable = &schema.Table{
+		Name:       "work_logs",
+		Columns:    WorkLogsColumns,
+		PrimaryKey: []*schema.Column{WorkLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "worklog_tenant_id_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{WorkLogsColumns[1], WorkLogsColumns[8]},
+			},
+			{
+				Name:    "worklog_tenant_id_user_id_ended_at",
+				Unique:  false,
+				Columns: []*schema.Column{WorkLogsColumns[1], WorkLogsColumns[2], WorkLogsColumns[9]},
+			},
+			{
+				Name:    "worklog_tenant_id_ticket_id",
+				Unique:  false,
+				Columns: []*schema.Column{WorkLogsColumns[1], WorkLogsColumns[4]},
+			},
+			{
+				Name:    "worklog_tenant_id_change_id",
+				Unique:  false,
+				Columns: []*schema.Column{WorkLogsColumns[1], WorkLogsColumns[5]},
+			},
+			{
+				Name:    "worklog_tenant_id_problem_id",
+				Unique:  false,
+				Columns: []*schema.Column{WorkLogsColumns[1], WorkLogsColumns[6]},
+			},
+			{
+				Name:    "worklog_msp_provider_id_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{WorkLogsColumns[14], WorkLogsColumns[8]},
+			},
+		},
+	}
 	// WorkflowsColumns holds the columns for the "workflows" table.
 	WorkflowsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -5235,6 +6104,7 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AlertSuppressionRulesTable,
 		ApplicationsTable,
 		ApprovalChainsTable,
 		ApprovalRecordsTable,
@@ -5244,6 +6114,7 @@ var (
 		AuditLogsTable,
 		BpmnPermissionsTable,
 		BootstrapTokensTable,
+		BusinessCalendarsTable,
 		CabMembersTable,
 		CiAttributeDefinitionsTable,
 		CiRelationshipsTable,
@@ -5261,6 +6132,7 @@ var (
 		ConfigurationItemHistoriesTable,
 		ContractsTable,
 		ConversationsTable,
+		DecisionDefinitionsTable,
 		DepartmentsTable,
 		DiscoveryJobsTable,
 		DiscoveryResultsTable,
@@ -5268,8 +6140,12 @@ var (
 		DomainConfigsTable,
 		EndpointAcLsTable,
 		EngineerSkillsTable,
+		EscalationPoliciesTable,
 		FeishuTicketSyncsTable,
 		GroupsTable,
+		InboundDedupKeysTable,
+		InboundEmailsTable,
+		InboundMessageLogsTable,
 		IncidentsTable,
 		IncidentAlertsTable,
 		IncidentEscalationRulesTable,
@@ -5285,13 +6161,18 @@ var (
 		KnowledgeArticleVersionsTable,
 		KnownErrorsTable,
 		MspAllocationsTable,
+		MajorIncidentsTable,
 		MarketplaceItemsTable,
 		MenusTable,
 		MessagesTable,
 		MicroservicesTable,
+		MonitoringAlertsTable,
 		NotificationsTable,
 		NotificationDeliveriesTable,
 		NotificationPreferencesTable,
+		OnCallOverridesTable,
+		OnCallPagesTable,
+		OnCallSchedulesTable,
 		OperationalCommandsTable,
 		PasswordResetTokensTable,
 		PermissionsTable,
@@ -5302,7 +6183,10 @@ var (
 		ProcessBindingsTable,
 		ProcessDefinitionsTable,
 		ProcessDeploymentsTable,
+		ProcessEventInstancesTable,
+		ProcessEventSubscriptionsTable,
 		ProcessExecutionHistoriesTable,
+		ProcessIncidentsTable,
 		ProcessInstancesTable,
 		ProcessTasksTable,
 		ProcessVariablesTable,
@@ -5321,6 +6205,7 @@ var (
 		SLAMetricsTable,
 		SLAPoliciesTable,
 		SLAViolationsTable,
+		SearchDocumentsTable,
 		ServiceCatalogsTable,
 		ServiceCatalogItemsTable,
 		ServiceRequestsTable,
@@ -5342,6 +6227,10 @@ var (
 		TicketCategoriesTable,
 		TicketCommentsTable,
 		TicketNotificationsTable,
+		TicketSLAMetricsTable,
+		TicketSLAPausesTable,
+		TicketSchedulesTable,
+		TicketScheduleRunsTable,
 		TicketTagsTable,
 		TicketTemplatesTable,
 		TicketTypesTable,
@@ -5350,6 +6239,7 @@ var (
 		ToolInvocationsTable,
 		UsersTable,
 		VendorsTable,
+		WorkLogsTable,
 		WorkflowsTable,
 		WorkflowInstancesTable,
 		WorkflowTasksTable,
@@ -5433,6 +6323,8 @@ func init() {
 	SLAAlertRulesTable.ForeignKeys[0].RefTable = SLADefinitionsTable
 	SLADefinitionsTable.ForeignKeys[0].RefTable = SLAPoliciesTable
 	SLAMetricsTable.ForeignKeys[0].RefTable = SLADefinitionsTable
+	SLAPoliciesTable.ForeignKeys[0].RefTable = TeamsTable
+	SLAPoliciesTable.ForeignKeys[1].RefTable = VendorsTable
 	SLAViolationsTable.ForeignKeys[0].RefTable = SLADefinitionsTable
 	SLAViolationsTable.ForeignKeys[1].RefTable = TicketsTable
 	ServiceCatalogItemsTable.ForeignKeys[0].RefTable = ServiceCatalogsTable
@@ -5460,6 +6352,9 @@ func init() {
 	TicketCommentsTable.ForeignKeys[1].RefTable = UsersTable
 	TicketNotificationsTable.ForeignKeys[0].RefTable = TicketsTable
 	TicketNotificationsTable.ForeignKeys[1].RefTable = UsersTable
+	TicketSLAMetricsTable.ForeignKeys[0].RefTable = TicketsTable
+	TicketSLAPausesTable.ForeignKeys[0].RefTable = TicketsTable
+	TicketScheduleRunsTable.ForeignKeys[0].RefTable = TicketSchedulesTable
 	TicketTagsTable.ForeignKeys[0].RefTable = TicketsTable
 	TicketViewsTable.ForeignKeys[0].RefTable = UsersTable
 	TicketWorkflowRecordsTable.ForeignKeys[0].RefTable = TicketsTable
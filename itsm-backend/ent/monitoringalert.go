@@ -0,0 +1,424 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"itsm-backend/ent/monitoringalert"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// MonitoringAlert is the model entity for the MonitoringAlert schema.
+type MonitoringAlert struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 租户ID
+	TenantID int `json:"tenant_id,omitempty"`
+	// 告警源连接器：alertmanager / zabbix / grafana
+	Source string `json:"source,omitempty"`
+	// 告警指纹，告警源内唯一
+	Fingerprint string `json:"fingerprint,omitempty"`
+	// 告警名称
+	AlertName string `json:"alert_name,omitempty"`
+	// 状态：firing 告警中，resolved 已恢复
+	Status monitoringalert.Status `json:"status,omitempty"`
+	// 归一化严重程度：critical / high / medium / low
+	Severity string `json:"severity,omitempty"`
+	// 告警摘要
+	Summary string `json:"summary,omitempty"`
+	// 告警标签
+	Labels map[string]string `json:"labels,omitempty"`
+	// 告警注解
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// 告警源中的详情链接
+	GeneratorURL string `json:"generator_url,omitempty"`
+	// 告警开始时间
+	StartsAt time.Time `json:"starts_at,omitempty"`
+	// 告警恢复时间
+	EndsAt *time.Time `json:"ends_at,omitempty"`
+	// 最近一次收到推送的时间
+	LastReceivedAt time.Time `json:"last_received_at,omitempty"`
+	// 累计收到推送次数
+	ReceiveCount int `json:"receive_count,omitempty"`
+	// 关联事件ID
+	IncidentID *int `json:"incident_id,omitempty"`
+	// 关联事件告警ID
+	IncidentAlertID *int `json:"incident_alert_id,omitempty"`
+	// 按标签映射到的配置项ID
+	ConfigurationItemID *int `json:"configuration_item_id,omitempty"`
+	// 归并原因：label 标签相同，topology 上游配置项已告警，similarity 文本相似；为空表示独立开启事件
+	CorrelationReason *monitoringalert.CorrelationReason `json:"correlation_reason,omitempty"`
+	// 归并到的主告警台账ID
+	RootAlertID *int `json:"root_alert_id,omitempty"`
+	// 最近一次命中的屏蔽规则ID
+	SuppressedByRuleID *int `json:"suppressed_by_rule_id,omitempty"`
+	// 抖动检测窗口内的状态切换时间
+	StateChanges []time.Time `json:"state_changes,omitempty"`
+	// 是否处于抖动中
+	Flapping bool `json:"flapping,omitempty"`
+	// 累计由本告警开启的事件数
+	IncidentsOpened int `json:"incidents_opened,omitempty"`
+	// 累计归并到已有事件的次数
+	CorrelatedCount int `json:"correlated_count,omitempty"`
+	// 累计被屏蔽规则拦截的推送次数
+	SuppressedCount int `json:"suppressed_count,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MonitoringAlert) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case monitoringalert.FieldLabels, monitoringalert.FieldAnnotations, monitoringalert.FieldStateChanges:
+			values[i] = new([]byte)
+		case monitoringalert.FieldFlapping:
+			values[i] = new(sql.NullBool)
+		case monitoringalert.FieldID, monitoringalert.FieldTenantID, monitoringalert.FieldReceiveCount, monitoringalert.FieldIncidentID, monitoringalert.FieldIncidentAlertID, monitoringalert.FieldConfigurationItemID, monitoringalert.FieldRootAlertID, monitoringalert.FieldSuppressedByRuleID, monitoringalert.FieldIncidentsOpened, monitoringalert.FieldCorrelatedCount, monitoringalert.FieldSuppressedCount:
+			values[i] = new(sql.NullInt64)
+		case monitoringalert.FieldSource, monitoringalert.FieldFingerprint, monitoringalert.FieldAlertName, monitoringalert.FieldStatus, monitoringalert.FieldSeverity, monitoringalert.FieldSummary, monitoringalert.FieldGeneratorURL, monitoringalert.FieldCorrelationReason:
+			values[i] = new(sql.NullString)
+		case monitoringalert.FieldStartsAt, monitoringalert.FieldEndsAt, monitoringalert.FieldLastReceivedAt, monitoringalert.FieldCreatedAt, monitoringalert.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MonitoringAlert fields.
+func (_m *MonitoringAlert) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case monitoringalert.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case monitoringalert.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = int(value.Int64)
+			}
+		case monitoringalert.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = value.String
+			}
+		case monitoringalert.FieldFingerprint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fingerprint", values[i])
+			} else if value.Valid {
+				_m.Fingerprint = value.String
+			}
+		case monitoringalert.FieldAlertName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field alert_name", values[i])
+			} else if value.Valid {
+				_m.AlertName = value.String
+			}
+		case monitoringalert.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = monitoringalert.Status(value.String)
+			}
+		case monitoringalert.FieldSeverity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field severity", values[i])
+			} else if value.Valid {
+				_m.Severity = value.String
+			}
+		case monitoringalert.FieldSummary:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field summary", values[i])
+			} else if value.Valid {
+				_m.Summary = value.String
+			}
+		case monitoringalert.FieldLabels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field labels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Labels); err != nil {
+					return fmt.Errorf("unmarshal field labels: %w", err)
+				}
+			}
+		case monitoringalert.FieldAnnotations:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field annotations", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Annotations); err != nil {
+					return fmt.Errorf("unmarshal field annotations: %w", err)
+				}
+			}
+		case monitoringalert.FieldGeneratorURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field generator_url", values[i])
+			} else if value.Valid {
+				_m.GeneratorURL = value.String
+			}
+		case monitoringalert.FieldStartsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
+			} else if value.Valid {
+				_m.StartsAt = value.Time
+			}
+		case monitoringalert.FieldEndsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ends_at", values[i])
+			} else if value.Valid {
+				_m.EndsAt = new(time.Time)
+				*_m.EndsAt = value.Time
+			}
+		case monitoringalert.FieldLastReceivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_received_at", values[i])
+			} else if value.Valid {
+				_m.LastReceivedAt = value.Time
+			}
+		case monitoringalert.FieldReceiveCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field receive_count", values[i])
+			} else if value.Valid {
+				_m.ReceiveCount = int(value.Int64)
+			}
+		case monitoringalert.FieldIncidentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field incident_id", values[i])
+			} else if value.Valid {
+				_m.IncidentID = new(int)
+				*_m.IncidentID = int(value.Int64)
+			}
+		case monitoringalert.FieldIncidentAlertID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field incident_alert_id", values[i])
+			} else if value.Valid {
+				_m.IncidentAlertID = new(int)
+				*_m.IncidentAlertID = int(value.Int64)
+			}
+		case monitoringalert.FieldConfigurationItemID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field configuration_item_id", values[i])
+			} else if value.Valid {
+				_m.ConfigurationItemID = new(int)
+				*_m.ConfigurationItemID = int(value.Int64)
+			}
+		case monitoringalert.FieldCorrelationReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field correlation_reason", values[i])
+			} else if value.Valid {
+				_m.CorrelationReason = new(monitoringalert.CorrelationReason)
+				*_m.CorrelationReason = monitoringalert.CorrelationReason(value.String)
+			}
+		case monitoringalert.FieldRootAlertID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field root_alert_id", values[i])
+			} else if value.Valid {
+				_m.RootAlertID = new(int)
+				*_m.RootAlertID = int(value.Int64)
+			}
+		case monitoringalert.FieldSuppressedByRuleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field suppressed_by_rule_id", values[i])
+			} else if value.Valid {
+				_m.SuppressedByRuleID = new(int)
+				*_m.SuppressedByRuleID = int(value.Int64)
+			}
+		case monitoringalert.FieldStateChanges:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field state_changes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.StateChanges); err != nil {
+					return fmt.Errorf("unmarshal field state_changes: %w", err)
+				}
+			}
+		case monitoringalert.FieldFlapping:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field flapping", values[i])
+			} else if value.Valid {
+				_m.Flapping = value.Bool
+			}
+		case monitoringalert.FieldIncidentsOpened:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field incidents_opened", values[i])
+			} else if value.Valid {
+				_m.IncidentsOpened = int(value.Int64)
+			}
+		case monitoringalert.FieldCorrelatedCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field correlated_count", values[i])
+			} else if value.Valid {
+				_m.CorrelatedCount = int(value.Int64)
+			}
+		case monitoringalert.FieldSuppressedCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field suppressed_count", values[i])
+			} else if value.Valid {
+				_m.SuppressedCount = int(value.Int64)
+			}
+		case monitoringalert.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case monitoringalert.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MonitoringAlert.
+// This includes values selected through modifiers, order, etc.
+func (_m *MonitoringAlert) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this MonitoringAlert.
+// Note that you need to call MonitoringAlert.Unwrap() before calling this method if this MonitoringAlert
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *MonitoringAlert) Update() *MonitoringAlertUpdateOne {
+	return NewMonitoringAlertClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the MonitoringAlert entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *MonitoringAlert) Unwrap() *MonitoringAlert {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MonitoringAlert is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *MonitoringAlert) String() string {
+	var builder strings.Builder
+	builder.WriteString("MonitoringAlert(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(_m.Source)
+	builder.WriteString(", ")
+	builder.WriteString("fingerprint=")
+	builder.WriteString(_m.Fingerprint)
+	builder.WriteString(", ")
+	builder.WriteString("alert_name=")
+	builder.WriteString(_m.AlertName)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("severity=")
+	builder.WriteString(_m.Severity)
+	builder.WriteString(", ")
+	builder.WriteString("summary=")
+	builder.WriteString(_m.Summary)
+	builder.WriteString(", ")
+	builder.WriteString("labels=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Labels))
+	builder.WriteString(", ")
+	builder.WriteString("annotations=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Annotations))
+	builder.WriteString(", ")
+	builder.WriteString("generator_url=")
+	builder.WriteString(_m.GeneratorURL)
+	builder.WriteString(", ")
+	builder.WriteString("starts_at=")
+	builder.WriteString(_m.StartsAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.EndsAt; v != nil {
+		builder.WriteString("ends_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_received_at=")
+	builder.WriteString(_m.LastReceivedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("receive_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReceiveCount))
+	builder.WriteString(", ")
+	if v := _m.IncidentID; v != nil {
+		builder.WriteString("incident_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.IncidentAlertID; v != nil {
+		builder.WriteString("incident_alert_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ConfigurationItemID; v != nil {
+		builder.WriteString("configuration_item_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CorrelationReason; v != nil {
+		builder.WriteString("correlation_reason=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.RootAlertID; v != nil {
+		builder.WriteString("root_alert_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.SuppressedByRuleID; v != nil {
+		builder.WriteString("suppressed_by_rule_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("state_changes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StateChanges))
+	builder.WriteString(", ")
+	builder.WriteString("flapping=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Flapping))
+	builder.WriteString(", ")
+	builder.WriteString("incidents_opened=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IncidentsOpened))
+	builder.WriteString(", ")
+	builder.WriteString("correlated_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CorrelatedCount))
+	builder.WriteString(", ")
+	builder.WriteString("suppressed_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SuppressedCount))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MonitoringAlerts is a parsable slice of MonitoringAlert.
+type MonitoringAlerts []*MonitoringAlert
@@ -0,0 +1,334 @@
+// Code generated by ent, DO NOT EDIT.
+
+package monitoringalert
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the monitoringalert type in the database.
+	Label = "monitoring_alert"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldFingerprint holds the string denoting the fingerprint field in the database.
+	FieldFingerprint = "fingerprint"
+	// FieldAlertName holds the string denoting the alert_name field in the database.
+	FieldAlertName = "alert_name"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldSeverity holds the string denoting the severity field in the database.
+	FieldSeverity = "severity"
+	// FieldSummary holds the string denoting the summary field in the database.
+	FieldSummary = "summary"
+	// FieldLabels holds the string denoting the labels field in the database.
+	FieldLabels = "labels"
+	// FieldAnnotations holds the string denoting the annotations field in the database.
+	FieldAnnotations = "annotations"
+	// FieldGeneratorURL holds the string denoting the generator_url field in the database.
+	FieldGeneratorURL = "generator_url"
+	// FieldStartsAt holds the string denoting the starts_at field in the database.
+	FieldStartsAt = "starts_at"
+	// FieldEndsAt holds the string denoting the ends_at field in the database.
+	FieldEndsAt = "ends_at"
+	// FieldLastReceivedAt holds the string denoting the last_received_at field in the database.
+	FieldLastReceivedAt = "last_received_at"
+	// FieldReceiveCount holds the string denoting the receive_count field in the database.
+	FieldReceiveCount = "receive_count"
+	// FieldIncidentID holds the string denoting the incident_id field in the database.
+	FieldIncidentID = "incident_id"
+	// FieldIncidentAlertID holds the string denoting the incident_alert_id field in the database.
+	FieldIncidentAlertID = "incident_alert_id"
+	// FieldConfigurationItemID holds the string denoting the configuration_item_id field in the database.
+	FieldConfigurationItemID = "configuration_item_id"
+	// FieldCorrelationReason holds the string denoting the correlation_reason field in the database.
+	FieldCorrelationReason = "correlation_reason"
+	// FieldRootAlertID holds the string denoting the root_alert_id field in the database.
+	FieldRootAlertID = "root_alert_id"
+	// FieldSuppressedByRuleID holds the string denoting the suppressed_by_rule_id field in the database.
+	FieldSuppressedByRuleID = "suppressed_by_rule_id"
+	// FieldStateChanges holds the string denoting the state_changes field in the database.
+	FieldStateChanges = "state_changes"
+	// FieldFlapping holds the string denoting the flapping field in the database.
+	FieldFlapping = "flapping"
+	// FieldIncidentsOpened holds the string denoting the incidents_opened field in the database.
+	FieldIncidentsOpened = "incidents_opened"
+	// FieldCorrelatedCount holds the string denoting the correlated_count field in the database.
+	FieldCorrelatedCount = "correlated_count"
+	// FieldSuppressedCount holds the string denoting the suppressed_count field in the database.
+	FieldSuppressedCount = "suppressed_count"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the monitoringalert in the database.
+	Table = "monitoring_alerts"
+)
+
+// Columns holds all SQL columns for monitoringalert fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldSource,
+	FieldFingerprint,
+	FieldAlertName,
+	FieldStatus,
+	FieldSeverity,
+	FieldSummary,
+	FieldLabels,
+	FieldAnnotations,
+	FieldGeneratorURL,
+	FieldStartsAt,
+	FieldEndsAt,
+	FieldLastReceivedAt,
+	FieldReceiveCount,
+	FieldIncidentID,
+	FieldIncidentAlertID,
+	FieldConfigurationItemID,
+	FieldCorrelationReason,
+	FieldRootAlertID,
+	FieldSuppressedByRuleID,
+	FieldStateChanges,
+	FieldFlapping,
+	FieldIncidentsOpened,
+	FieldCorrelatedCount,
+	FieldSuppressedCount,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	TenantIDValidator func(int) error
+	// SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	SourceValidator func(string) error
+	// FingerprintValidator is a validator for the "fingerprint" field. It is called by the builders before save.
+	FingerprintValidator func(string) error
+	// AlertNameValidator is a validator for the "alert_name" field. It is called by the builders before save.
+	AlertNameValidator func(string) error
+	// DefaultSeverity holds the default value on creation for the "severity" field.
+	DefaultSeverity string
+	// DefaultStartsAt holds the default value on creation for the "starts_at" field.
+	DefaultStartsAt func() time.Time
+	// DefaultLastReceivedAt holds the default value on creation for the "last_received_at" field.
+	DefaultLastReceivedAt func() time.Time
+	// DefaultReceiveCount holds the default value on creation for the "receive_count" field.
+	DefaultReceiveCount int
+	// ReceiveCountValidator is a validator for the "receive_count" field. It is called by the builders before save.
+	ReceiveCountValidator func(int) error
+	// DefaultFlapping holds the default value on creation for the "flapping" field.
+	DefaultFlapping bool
+	// DefaultIncidentsOpened holds the default value on creation for the "incidents_opened" field.
+	DefaultIncidentsOpened int
+	// IncidentsOpenedValidator is a validator for the "incidents_opened" field. It is called by the builders before save.
+	IncidentsOpenedValidator func(int) error
+	// DefaultCorrelatedCount holds the default value on creation for the "correlated_count" field.
+	DefaultCorrelatedCount int
+	// CorrelatedCountValidator is a validator for the "correlated_count" field. It is called by the builders before save.
+	CorrelatedCountValidator func(int) error
+	// DefaultSuppressedCount holds the default value on creation for the "suppressed_count" field.
+	DefaultSuppressedCount int
+	// SuppressedCountValidator is a validator for the "suppressed_count" field. It is called by the builders before save.
+	SuppressedCountValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusFiring is the default value of the Status enum.
+const DefaultStatus = StatusFiring
+
+// Status values.
+const (
+	StatusFiring   Status = "firing"
+	StatusResolved Status = "resolved"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusFiring, StatusResolved:
+		return nil
+	default:
+		return fmt.Errorf("monitoringalert: invalid enum value for status field: %q", s)
+	}
+}
+
+// CorrelationReason defines the type for the "correlation_reason" enum field.
+type CorrelationReason string
+
+// CorrelationReason values.
+const (
+	CorrelationReasonLabel      CorrelationReason = "label"
+	CorrelationReasonTopology   CorrelationReason = "topology"
+	CorrelationReasonSimilarity CorrelationReason = "similarity"
+)
+
+func (cr CorrelationReason) String() string {
+	return string(cr)
+}
+
+// CorrelationReasonValidator is a validator for the "correlation_reason" field enum values. It is called by the builders before save.
+func CorrelationReasonValidator(cr CorrelationReason) error {
+	switch cr {
+	case CorrelationReasonLabel, CorrelationReasonTopology, CorrelationReasonSimilarity:
+		return nil
+	default:
+		return fmt.Errorf("monitoringalert: invalid enum value for correlation_reason field: %q", cr)
+	}
+}
+
+// OrderOption defines the ordering options for the MonitoringAlert queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByFingerprint orders the results by the fingerprint field.
+func ByFingerprint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFingerprint, opts...).ToFunc()
+}
+
+// ByAlertName orders the results by the alert_name field.
+func ByAlertName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAlertName, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// BySeverity orders the results by the severity field.
+func BySeverity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeverity, opts...).ToFunc()
+}
+
+// BySummary orders the results by the summary field.
+func BySummary(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSummary, opts...).ToFunc()
+}
+
+// ByGeneratorURL orders the results by the generator_url field.
+func ByGeneratorURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGeneratorURL, opts...).ToFunc()
+}
+
+// ByStartsAt orders the results by the starts_at field.
+func ByStartsAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartsAt, opts...).ToFunc()
+}
+
+// ByEndsAt orders the results by the ends_at field.
+func ByEndsAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndsAt, opts...).ToFunc()
+}
+
+// ByLastReceivedAt orders the results by the last_received_at field.
+func ByLastReceivedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastReceivedAt, opts...).ToFunc()
+}
+
+// ByReceiveCount orders the results by the receive_count field.
+func ByReceiveCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReceiveCount, opts...).ToFunc()
+}
+
+// ByIncidentID orders the results by the incident_id field.
+func ByIncidentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIncidentID, opts...).ToFunc()
+}
+
+// ByIncidentAlertID orders the results by the incident_alert_id field.
+func ByIncidentAlertID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIncidentAlertID, opts...).ToFunc()
+}
+
+// ByConfigurationItemID orders the results by the configuration_item_id field.
+func ByConfigurationItemID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConfigurationItemID, opts...).ToFunc()
+}
+
+// ByCorrelationReason orders the results by the correlation_reason field.
+func ByCorrelationReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCorrelationReason, opts...).ToFunc()
+}
+
+// ByRootAlertID orders the results by the root_alert_id field.
+func ByRootAlertID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRootAlertID, opts...).ToFunc()
+}
+
+// BySuppressedByRuleID orders the results by the suppressed_by_rule_id field.
+func BySuppressedByRuleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuppressedByRuleID, opts...).ToFunc()
+}
+
+// ByFlapping orders the results by the flapping field.
+func ByFlapping(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFlapping, opts...).ToFunc()
+}
+
+// ByIncidentsOpened orders the results by the incidents_opened field.
+func ByIncidentsOpened(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIncidentsOpened, opts...).ToFunc()
+}
+
+// ByCorrelatedCount orders the results by the correlated_count field.
+func ByCorrelatedCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCorrelatedCount, opts...).ToFunc()
+}
+
+// BySuppressedCount orders the results by the suppressed_count field.
+func BySuppressedCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuppressedCount, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
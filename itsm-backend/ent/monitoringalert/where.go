@@ -0,0 +1,1340 @@
+// Code generated by ent, DO NOT EDIT.
+
+package monitoringalert
+
+import (
+	"itsm-backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldSource, v))
+}
+
+// Fingerprint applies equality check predicate on the "fingerprint" field. It's identical to FingerprintEQ.
+func Fingerprint(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldFingerprint, v))
+}
+
+// AlertName applies equality check predicate on the "alert_name" field. It's identical to AlertNameEQ.
+func AlertName(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldAlertName, v))
+}
+
+// Severity applies equality check predicate on the "severity" field. It's identical to SeverityEQ.
+func Severity(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldSeverity, v))
+}
+
+// Summary applies equality check predicate on the "summary" field. It's identical to SummaryEQ.
+func Summary(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldSummary, v))
+}
+
+// GeneratorURL applies equality check predicate on the "generator_url" field. It's identical to GeneratorURLEQ.
+func GeneratorURL(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldGeneratorURL, v))
+}
+
+// StartsAt applies equality check predicate on the "starts_at" field. It's identical to StartsAtEQ.
+func StartsAt(v time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// EndsAt applies equality check predicate on the "ends_at" field. It's identical to EndsAtEQ.
+func EndsAt(v time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldEndsAt, v))
+}
+
+// LastReceivedAt applies equality check predicate on the "last_received_at" field. It's identical to LastReceivedAtEQ.
+func LastReceivedAt(v time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldLastReceivedAt, v))
+}
+
+// ReceiveCount applies equality check predicate on the "receive_count" field. It's identical to ReceiveCountEQ.
+func ReceiveCount(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldReceiveCount, v))
+}
+
+// IncidentID applies equality check predicate on the "incident_id" field. It's identical to IncidentIDEQ.
+func IncidentID(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldIncidentID, v))
+}
+
+// IncidentAlertID applies equality check predicate on the "incident_alert_id" field. It's identical to IncidentAlertIDEQ.
+func IncidentAlertID(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldIncidentAlertID, v))
+}
+
+// ConfigurationItemID applies equality check predicate on the "configuration_item_id" field. It's identical to ConfigurationItemIDEQ.
+func ConfigurationItemID(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldConfigurationItemID, v))
+}
+
+// RootAlertID applies equality check predicate on the "root_alert_id" field. It's identical to RootAlertIDEQ.
+func RootAlertID(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldRootAlertID, v))
+}
+
+// SuppressedByRuleID applies equality check predicate on the "suppressed_by_rule_id" field. It's identical to SuppressedByRuleIDEQ.
+func SuppressedByRuleID(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldSuppressedByRuleID, v))
+}
+
+// Flapping applies equality check predicate on the "flapping" field. It's identical to FlappingEQ.
+func Flapping(v bool) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldFlapping, v))
+}
+
+// IncidentsOpened applies equality check predicate on the "incidents_opened" field. It's identical to IncidentsOpenedEQ.
+func IncidentsOpened(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldIncidentsOpened, v))
+}
+
+// CorrelatedCount applies equality check predicate on the "correlated_count" field. It's identical to CorrelatedCountEQ.
+func CorrelatedCount(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldCorrelatedCount, v))
+}
+
+// SuppressedCount applies equality check predicate on the "suppressed_count" field. It's identical to SuppressedCountEQ.
+func SuppressedCount(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldSuppressedCount, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLTE(FieldTenantID, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldContainsFold(FieldSource, v))
+}
+
+// FingerprintEQ applies the EQ predicate on the "fingerprint" field.
+func FingerprintEQ(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldFingerprint, v))
+}
+
+// FingerprintNEQ applies the NEQ predicate on the "fingerprint" field.
+func FingerprintNEQ(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNEQ(FieldFingerprint, v))
+}
+
+// FingerprintIn applies the In predicate on the "fingerprint" field.
+func FingerprintIn(vs ...string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldIn(FieldFingerprint, vs...))
+}
+
+// FingerprintNotIn applies the NotIn predicate on the "fingerprint" field.
+func FingerprintNotIn(vs ...string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNotIn(FieldFingerprint, vs...))
+}
+
+// FingerprintGT applies the GT predicate on the "fingerprint" field.
+func FingerprintGT(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGT(FieldFingerprint, v))
+}
+
+// FingerprintGTE applies the GTE predicate on the "fingerprint" field.
+func FingerprintGTE(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGTE(FieldFingerprint, v))
+}
+
+// FingerprintLT applies the LT predicate on the "fingerprint" field.
+func FingerprintLT(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLT(FieldFingerprint, v))
+}
+
+// FingerprintLTE applies the LTE predicate on the "fingerprint" field.
+func FingerprintLTE(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLTE(FieldFingerprint, v))
+}
+
+// FingerprintContains applies the Contains predicate on the "fingerprint" field.
+func FingerprintContains(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldContains(FieldFingerprint, v))
+}
+
+// FingerprintHasPrefix applies the HasPrefix predicate on the "fingerprint" field.
+func FingerprintHasPrefix(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldHasPrefix(FieldFingerprint, v))
+}
+
+// FingerprintHasSuffix applies the HasSuffix predicate on the "fingerprint" field.
+func FingerprintHasSuffix(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldHasSuffix(FieldFingerprint, v))
+}
+
+// FingerprintEqualFold applies the EqualFold predicate on the "fingerprint" field.
+func FingerprintEqualFold(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEqualFold(FieldFingerprint, v))
+}
+
+// FingerprintContainsFold applies the ContainsFold predicate on the "fingerprint" field.
+func FingerprintContainsFold(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldContainsFold(FieldFingerprint, v))
+}
+
+// AlertNameEQ applies the EQ predicate on the "alert_name" field.
+func AlertNameEQ(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldAlertName, v))
+}
+
+// AlertNameNEQ applies the NEQ predicate on the "alert_name" field.
+func AlertNameNEQ(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNEQ(FieldAlertName, v))
+}
+
+// AlertNameIn applies the In predicate on the "alert_name" field.
+func AlertNameIn(vs ...string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldIn(FieldAlertName, vs...))
+}
+
+// AlertNameNotIn applies the NotIn predicate on the "alert_name" field.
+func AlertNameNotIn(vs ...string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNotIn(FieldAlertName, vs...))
+}
+
+// AlertNameGT applies the GT predicate on the "alert_name" field.
+func AlertNameGT(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGT(FieldAlertName, v))
+}
+
+// AlertNameGTE applies the GTE predicate on the "alert_name" field.
+func AlertNameGTE(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGTE(FieldAlertName, v))
+}
+
+// AlertNameLT applies the LT predicate on the "alert_name" field.
+func AlertNameLT(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLT(FieldAlertName, v))
+}
+
+// AlertNameLTE applies the LTE predicate on the "alert_name" field.
+func AlertNameLTE(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLTE(FieldAlertName, v))
+}
+
+// AlertNameContains applies the Contains predicate on the "alert_name" field.
+func AlertNameContains(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldContains(FieldAlertName, v))
+}
+
+// AlertNameHasPrefix applies the HasPrefix predicate on the "alert_name" field.
+func AlertNameHasPrefix(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldHasPrefix(FieldAlertName, v))
+}
+
+// AlertNameHasSuffix applies the HasSuffix predicate on the "alert_name" field.
+func AlertNameHasSuffix(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldHasSuffix(FieldAlertName, v))
+}
+
+// AlertNameEqualFold applies the EqualFold predicate on the "alert_name" field.
+func AlertNameEqualFold(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEqualFold(FieldAlertName, v))
+}
+
+// AlertNameContainsFold applies the ContainsFold predicate on the "alert_name" field.
+func AlertNameContainsFold(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldContainsFold(FieldAlertName, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// SeverityEQ applies the EQ predicate on the "severity" field.
+func SeverityEQ(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldSeverity, v))
+}
+
+// SeverityNEQ applies the NEQ predicate on the "severity" field.
+func SeverityNEQ(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNEQ(FieldSeverity, v))
+}
+
+// SeverityIn applies the In predicate on the "severity" field.
+func SeverityIn(vs ...string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldIn(FieldSeverity, vs...))
+}
+
+// SeverityNotIn applies the NotIn predicate on the "severity" field.
+func SeverityNotIn(vs ...string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNotIn(FieldSeverity, vs...))
+}
+
+// SeverityGT applies the GT predicate on the "severity" field.
+func SeverityGT(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGT(FieldSeverity, v))
+}
+
+// SeverityGTE applies the GTE predicate on the "severity" field.
+func SeverityGTE(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGTE(FieldSeverity, v))
+}
+
+// SeverityLT applies the LT predicate on the "severity" field.
+func SeverityLT(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLT(FieldSeverity, v))
+}
+
+// SeverityLTE applies the LTE predicate on the "severity" field.
+func SeverityLTE(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLTE(FieldSeverity, v))
+}
+
+// SeverityContains applies the Contains predicate on the "severity" field.
+func SeverityContains(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldContains(FieldSeverity, v))
+}
+
+// SeverityHasPrefix applies the HasPrefix predicate on the "severity" field.
+func SeverityHasPrefix(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldHasPrefix(FieldSeverity, v))
+}
+
+// SeverityHasSuffix applies the HasSuffix predicate on the "severity" field.
+func SeverityHasSuffix(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldHasSuffix(FieldSeverity, v))
+}
+
+// SeverityEqualFold applies the EqualFold predicate on the "severity" field.
+func SeverityEqualFold(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEqualFold(FieldSeverity, v))
+}
+
+// SeverityContainsFold applies the ContainsFold predicate on the "severity" field.
+func SeverityContainsFold(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldContainsFold(FieldSeverity, v))
+}
+
+// SummaryEQ applies the EQ predicate on the "summary" field.
+func SummaryEQ(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldSummary, v))
+}
+
+// SummaryNEQ applies the NEQ predicate on the "summary" field.
+func SummaryNEQ(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNEQ(FieldSummary, v))
+}
+
+// SummaryIn applies the In predicate on the "summary" field.
+func SummaryIn(vs ...string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldIn(FieldSummary, vs...))
+}
+
+// SummaryNotIn applies the NotIn predicate on the "summary" field.
+func SummaryNotIn(vs ...string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNotIn(FieldSummary, vs...))
+}
+
+// SummaryGT applies the GT predicate on the "summary" field.
+func SummaryGT(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGT(FieldSummary, v))
+}
+
+// SummaryGTE applies the GTE predicate on the "summary" field.
+func SummaryGTE(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGTE(FieldSummary, v))
+}
+
+// SummaryLT applies the LT predicate on the "summary" field.
+func SummaryLT(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLT(FieldSummary, v))
+}
+
+// SummaryLTE applies the LTE predicate on the "summary" field.
+func SummaryLTE(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLTE(FieldSummary, v))
+}
+
+// SummaryContains applies the Contains predicate on the "summary" field.
+func SummaryContains(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldContains(FieldSummary, v))
+}
+
+// SummaryHasPrefix applies the HasPrefix predicate on the "summary" field.
+func SummaryHasPrefix(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldHasPrefix(FieldSummary, v))
+}
+
+// SummaryHasSuffix applies the HasSuffix predicate on the "summary" field.
+func SummaryHasSuffix(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldHasSuffix(FieldSummary, v))
+}
+
+// SummaryIsNil applies the IsNil predicate on the "summary" field.
+func SummaryIsNil() predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldIsNull(FieldSummary))
+}
+
+// SummaryNotNil applies the NotNil predicate on the "summary" field.
+func SummaryNotNil() predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNotNull(FieldSummary))
+}
+
+// SummaryEqualFold applies the EqualFold predicate on the "summary" field.
+func SummaryEqualFold(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEqualFold(FieldSummary, v))
+}
+
+// SummaryContainsFold applies the ContainsFold predicate on the "summary" field.
+func SummaryContainsFold(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldContainsFold(FieldSummary, v))
+}
+
+// LabelsIsNil applies the IsNil predicate on the "labels" field.
+func LabelsIsNil() predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldIsNull(FieldLabels))
+}
+
+// LabelsNotNil applies the NotNil predicate on the "labels" field.
+func LabelsNotNil() predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNotNull(FieldLabels))
+}
+
+// AnnotationsIsNil applies the IsNil predicate on the "annotations" field.
+func AnnotationsIsNil() predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldIsNull(FieldAnnotations))
+}
+
+// AnnotationsNotNil applies the NotNil predicate on the "annotations" field.
+func AnnotationsNotNil() predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNotNull(FieldAnnotations))
+}
+
+// GeneratorURLEQ applies the EQ predicate on the "generator_url" field.
+func GeneratorURLEQ(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldGeneratorURL, v))
+}
+
+// GeneratorURLNEQ applies the NEQ predicate on the "generator_url" field.
+func GeneratorURLNEQ(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNEQ(FieldGeneratorURL, v))
+}
+
+// GeneratorURLIn applies the In predicate on the "generator_url" field.
+func GeneratorURLIn(vs ...string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldIn(FieldGeneratorURL, vs...))
+}
+
+// GeneratorURLNotIn applies the NotIn predicate on the "generator_url" field.
+func GeneratorURLNotIn(vs ...string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNotIn(FieldGeneratorURL, vs...))
+}
+
+// GeneratorURLGT applies the GT predicate on the "generator_url" field.
+func GeneratorURLGT(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGT(FieldGeneratorURL, v))
+}
+
+// GeneratorURLGTE applies the GTE predicate on the "generator_url" field.
+func GeneratorURLGTE(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGTE(FieldGeneratorURL, v))
+}
+
+// GeneratorURLLT applies the LT predicate on the "generator_url" field.
+func GeneratorURLLT(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLT(FieldGeneratorURL, v))
+}
+
+// GeneratorURLLTE applies the LTE predicate on the "generator_url" field.
+func GeneratorURLLTE(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLTE(FieldGeneratorURL, v))
+}
+
+// GeneratorURLContains applies the Contains predicate on the "generator_url" field.
+func GeneratorURLContains(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldContains(FieldGeneratorURL, v))
+}
+
+// GeneratorURLHasPrefix applies the HasPrefix predicate on the "generator_url" field.
+func GeneratorURLHasPrefix(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldHasPrefix(FieldGeneratorURL, v))
+}
+
+// GeneratorURLHasSuffix applies the HasSuffix predicate on the "generator_url" field.
+func GeneratorURLHasSuffix(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldHasSuffix(FieldGeneratorURL, v))
+}
+
+// GeneratorURLIsNil applies the IsNil predicate on the "generator_url" field.
+func GeneratorURLIsNil() predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldIsNull(FieldGeneratorURL))
+}
+
+// GeneratorURLNotNil applies the NotNil predicate on the "generator_url" field.
+func GeneratorURLNotNil() predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNotNull(FieldGeneratorURL))
+}
+
+// GeneratorURLEqualFold applies the EqualFold predicate on the "generator_url" field.
+func GeneratorURLEqualFold(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEqualFold(FieldGeneratorURL, v))
+}
+
+// GeneratorURLContainsFold applies the ContainsFold predicate on the "generator_url" field.
+func GeneratorURLContainsFold(v string) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldContainsFold(FieldGeneratorURL, v))
+}
+
+// StartsAtEQ applies the EQ predicate on the "starts_at" field.
+func StartsAtEQ(v time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// StartsAtNEQ applies the NEQ predicate on the "starts_at" field.
+func StartsAtNEQ(v time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNEQ(FieldStartsAt, v))
+}
+
+// StartsAtIn applies the In predicate on the "starts_at" field.
+func StartsAtIn(vs ...time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldIn(FieldStartsAt, vs...))
+}
+
+// StartsAtNotIn applies the NotIn predicate on the "starts_at" field.
+func StartsAtNotIn(vs ...time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNotIn(FieldStartsAt, vs...))
+}
+
+// StartsAtGT applies the GT predicate on the "starts_at" field.
+func StartsAtGT(v time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGT(FieldStartsAt, v))
+}
+
+// StartsAtGTE applies the GTE predicate on the "starts_at" field.
+func StartsAtGTE(v time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGTE(FieldStartsAt, v))
+}
+
+// StartsAtLT applies the LT predicate on the "starts_at" field.
+func StartsAtLT(v time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLT(FieldStartsAt, v))
+}
+
+// StartsAtLTE applies the LTE predicate on the "starts_at" field.
+func StartsAtLTE(v time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLTE(FieldStartsAt, v))
+}
+
+// EndsAtEQ applies the EQ predicate on the "ends_at" field.
+func EndsAtEQ(v time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldEndsAt, v))
+}
+
+// EndsAtNEQ applies the NEQ predicate on the "ends_at" field.
+func EndsAtNEQ(v time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNEQ(FieldEndsAt, v))
+}
+
+// EndsAtIn applies the In predicate on the "ends_at" field.
+func EndsAtIn(vs ...time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldIn(FieldEndsAt, vs...))
+}
+
+// EndsAtNotIn applies the NotIn predicate on the "ends_at" field.
+func EndsAtNotIn(vs ...time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNotIn(FieldEndsAt, vs...))
+}
+
+// EndsAtGT applies the GT predicate on the "ends_at" field.
+func EndsAtGT(v time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGT(FieldEndsAt, v))
+}
+
+// EndsAtGTE applies the GTE predicate on the "ends_at" field.
+func EndsAtGTE(v time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGTE(FieldEndsAt, v))
+}
+
+// EndsAtLT applies the LT predicate on the "ends_at" field.
+func EndsAtLT(v time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLT(FieldEndsAt, v))
+}
+
+// EndsAtLTE applies the LTE predicate on the "ends_at" field.
+func EndsAtLTE(v time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLTE(FieldEndsAt, v))
+}
+
+// EndsAtIsNil applies the IsNil predicate on the "ends_at" field.
+func EndsAtIsNil() predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldIsNull(FieldEndsAt))
+}
+
+// EndsAtNotNil applies the NotNil predicate on the "ends_at" field.
+func EndsAtNotNil() predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNotNull(FieldEndsAt))
+}
+
+// LastReceivedAtEQ applies the EQ predicate on the "last_received_at" field.
+func LastReceivedAtEQ(v time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldLastReceivedAt, v))
+}
+
+// LastReceivedAtNEQ applies the NEQ predicate on the "last_received_at" field.
+func LastReceivedAtNEQ(v time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNEQ(FieldLastReceivedAt, v))
+}
+
+// LastReceivedAtIn applies the In predicate on the "last_received_at" field.
+func LastReceivedAtIn(vs ...time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldIn(FieldLastReceivedAt, vs...))
+}
+
+// LastReceivedAtNotIn applies the NotIn predicate on the "last_received_at" field.
+func LastReceivedAtNotIn(vs ...time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNotIn(FieldLastReceivedAt, vs...))
+}
+
+// LastReceivedAtGT applies the GT predicate on the "last_received_at" field.
+func LastReceivedAtGT(v time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGT(FieldLastReceivedAt, v))
+}
+
+// LastReceivedAtGTE applies the GTE predicate on the "last_received_at" field.
+func LastReceivedAtGTE(v time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGTE(FieldLastReceivedAt, v))
+}
+
+// LastReceivedAtLT applies the LT predicate on the "last_received_at" field.
+func LastReceivedAtLT(v time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLT(FieldLastReceivedAt, v))
+}
+
+// LastReceivedAtLTE applies the LTE predicate on the "last_received_at" field.
+func LastReceivedAtLTE(v time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLTE(FieldLastReceivedAt, v))
+}
+
+// ReceiveCountEQ applies the EQ predicate on the "receive_count" field.
+func ReceiveCountEQ(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldReceiveCount, v))
+}
+
+// ReceiveCountNEQ applies the NEQ predicate on the "receive_count" field.
+func ReceiveCountNEQ(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNEQ(FieldReceiveCount, v))
+}
+
+// ReceiveCountIn applies the In predicate on the "receive_count" field.
+func ReceiveCountIn(vs ...int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldIn(FieldReceiveCount, vs...))
+}
+
+// ReceiveCountNotIn applies the NotIn predicate on the "receive_count" field.
+func ReceiveCountNotIn(vs ...int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNotIn(FieldReceiveCount, vs...))
+}
+
+// ReceiveCountGT applies the GT predicate on the "receive_count" field.
+func ReceiveCountGT(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGT(FieldReceiveCount, v))
+}
+
+// ReceiveCountGTE applies the GTE predicate on the "receive_count" field.
+func ReceiveCountGTE(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGTE(FieldReceiveCount, v))
+}
+
+// ReceiveCountLT applies the LT predicate on the "receive_count" field.
+func ReceiveCountLT(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLT(FieldReceiveCount, v))
+}
+
+// ReceiveCountLTE applies the LTE predicate on the "receive_count" field.
+func ReceiveCountLTE(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLTE(FieldReceiveCount, v))
+}
+
+// IncidentIDEQ applies the EQ predicate on the "incident_id" field.
+func IncidentIDEQ(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldIncidentID, v))
+}
+
+// IncidentIDNEQ applies the NEQ predicate on the "incident_id" field.
+func IncidentIDNEQ(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNEQ(FieldIncidentID, v))
+}
+
+// IncidentIDIn applies the In predicate on the "incident_id" field.
+func IncidentIDIn(vs ...int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldIn(FieldIncidentID, vs...))
+}
+
+// IncidentIDNotIn applies the NotIn predicate on the "incident_id" field.
+func IncidentIDNotIn(vs ...int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNotIn(FieldIncidentID, vs...))
+}
+
+// IncidentIDGT applies the GT predicate on the "incident_id" field.
+func IncidentIDGT(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGT(FieldIncidentID, v))
+}
+
+// IncidentIDGTE applies the GTE predicate on the "incident_id" field.
+func IncidentIDGTE(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGTE(FieldIncidentID, v))
+}
+
+// IncidentIDLT applies the LT predicate on the "incident_id" field.
+func IncidentIDLT(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLT(FieldIncidentID, v))
+}
+
+// IncidentIDLTE applies the LTE predicate on the "incident_id" field.
+func IncidentIDLTE(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLTE(FieldIncidentID, v))
+}
+
+// IncidentIDIsNil applies the IsNil predicate on the "incident_id" field.
+func IncidentIDIsNil() predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldIsNull(FieldIncidentID))
+}
+
+// IncidentIDNotNil applies the NotNil predicate on the "incident_id" field.
+func IncidentIDNotNil() predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNotNull(FieldIncidentID))
+}
+
+// IncidentAlertIDEQ applies the EQ predicate on the "incident_alert_id" field.
+func IncidentAlertIDEQ(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldIncidentAlertID, v))
+}
+
+// IncidentAlertIDNEQ applies the NEQ predicate on the "incident_alert_id" field.
+func IncidentAlertIDNEQ(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNEQ(FieldIncidentAlertID, v))
+}
+
+// IncidentAlertIDIn applies the In predicate on the "incident_alert_id" field.
+func IncidentAlertIDIn(vs ...int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldIn(FieldIncidentAlertID, vs...))
+}
+
+// IncidentAlertIDNotIn applies the NotIn predicate on the "incident_alert_id" field.
+func IncidentAlertIDNotIn(vs ...int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNotIn(FieldIncidentAlertID, vs...))
+}
+
+// IncidentAlertIDGT applies the GT predicate on the "incident_alert_id" field.
+func IncidentAlertIDGT(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGT(FieldIncidentAlertID, v))
+}
+
+// IncidentAlertIDGTE applies the GTE predicate on the "incident_alert_id" field.
+func IncidentAlertIDGTE(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGTE(FieldIncidentAlertID, v))
+}
+
+// IncidentAlertIDLT applies the LT predicate on the "incident_alert_id" field.
+func IncidentAlertIDLT(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLT(FieldIncidentAlertID, v))
+}
+
+// IncidentAlertIDLTE applies the LTE predicate on the "incident_alert_id" field.
+func IncidentAlertIDLTE(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLTE(FieldIncidentAlertID, v))
+}
+
+// IncidentAlertIDIsNil applies the IsNil predicate on the "incident_alert_id" field.
+func IncidentAlertIDIsNil() predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldIsNull(FieldIncidentAlertID))
+}
+
+// IncidentAlertIDNotNil applies the NotNil predicate on the "incident_alert_id" field.
+func IncidentAlertIDNotNil() predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNotNull(FieldIncidentAlertID))
+}
+
+// ConfigurationItemIDEQ applies the EQ predicate on the "configuration_item_id" field.
+func ConfigurationItemIDEQ(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldConfigurationItemID, v))
+}
+
+// ConfigurationItemIDNEQ applies the NEQ predicate on the "configuration_item_id" field.
+func ConfigurationItemIDNEQ(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNEQ(FieldConfigurationItemID, v))
+}
+
+// ConfigurationItemIDIn applies the In predicate on the "configuration_item_id" field.
+func ConfigurationItemIDIn(vs ...int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldIn(FieldConfigurationItemID, vs...))
+}
+
+// ConfigurationItemIDNotIn applies the NotIn predicate on the "configuration_item_id" field.
+func ConfigurationItemIDNotIn(vs ...int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNotIn(FieldConfigurationItemID, vs...))
+}
+
+// ConfigurationItemIDGT applies the GT predicate on the "configuration_item_id" field.
+func ConfigurationItemIDGT(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGT(FieldConfigurationItemID, v))
+}
+
+// ConfigurationItemIDGTE applies the GTE predicate on the "configuration_item_id" field.
+func ConfigurationItemIDGTE(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGTE(FieldConfigurationItemID, v))
+}
+
+// ConfigurationItemIDLT applies the LT predicate on the "configuration_item_id" field.
+func ConfigurationItemIDLT(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLT(FieldConfigurationItemID, v))
+}
+
+// ConfigurationItemIDLTE applies the LTE predicate on the "configuration_item_id" field.
+func ConfigurationItemIDLTE(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLTE(FieldConfigurationItemID, v))
+}
+
+// ConfigurationItemIDIsNil applies the IsNil predicate on the "configuration_item_id" field.
+func ConfigurationItemIDIsNil() predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldIsNull(FieldConfigurationItemID))
+}
+
+// ConfigurationItemIDNotNil applies the NotNil predicate on the "configuration_item_id" field.
+func ConfigurationItemIDNotNil() predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNotNull(FieldConfigurationItemID))
+}
+
+// CorrelationReasonEQ applies the EQ predicate on the "correlation_reason" field.
+func CorrelationReasonEQ(v CorrelationReason) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldCorrelationReason, v))
+}
+
+// CorrelationReasonNEQ applies the NEQ predicate on the "correlation_reason" field.
+func CorrelationReasonNEQ(v CorrelationReason) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNEQ(FieldCorrelationReason, v))
+}
+
+// CorrelationReasonIn applies the In predicate on the "correlation_reason" field.
+func CorrelationReasonIn(vs ...CorrelationReason) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldIn(FieldCorrelationReason, vs...))
+}
+
+// CorrelationReasonNotIn applies the NotIn predicate on the "correlation_reason" field.
+func CorrelationReasonNotIn(vs ...CorrelationReason) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNotIn(FieldCorrelationReason, vs...))
+}
+
+// CorrelationReasonIsNil applies the IsNil predicate on the "correlation_reason" field.
+func CorrelationReasonIsNil() predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldIsNull(FieldCorrelationReason))
+}
+
+// CorrelationReasonNotNil applies the NotNil predicate on the "correlation_reason" field.
+func CorrelationReasonNotNil() predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNotNull(FieldCorrelationReason))
+}
+
+// RootAlertIDEQ applies the EQ predicate on the "root_alert_id" field.
+func RootAlertIDEQ(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldRootAlertID, v))
+}
+
+// RootAlertIDNEQ applies the NEQ predicate on the "root_alert_id" field.
+func RootAlertIDNEQ(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNEQ(FieldRootAlertID, v))
+}
+
+// RootAlertIDIn applies the In predicate on the "root_alert_id" field.
+func RootAlertIDIn(vs ...int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldIn(FieldRootAlertID, vs...))
+}
+
+// RootAlertIDNotIn applies the NotIn predicate on the "root_alert_id" field.
+func RootAlertIDNotIn(vs ...int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNotIn(FieldRootAlertID, vs...))
+}
+
+// RootAlertIDGT applies the GT predicate on the "root_alert_id" field.
+func RootAlertIDGT(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGT(FieldRootAlertID, v))
+}
+
+// RootAlertIDGTE applies the GTE predicate on the "root_alert_id" field.
+func RootAlertIDGTE(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGTE(FieldRootAlertID, v))
+}
+
+// RootAlertIDLT applies the LT predicate on the "root_alert_id" field.
+func RootAlertIDLT(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLT(FieldRootAlertID, v))
+}
+
+// RootAlertIDLTE applies the LTE predicate on the "root_alert_id" field.
+func RootAlertIDLTE(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLTE(FieldRootAlertID, v))
+}
+
+// RootAlertIDIsNil applies the IsNil predicate on the "root_alert_id" field.
+func RootAlertIDIsNil() predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldIsNull(FieldRootAlertID))
+}
+
+// RootAlertIDNotNil applies the NotNil predicate on the "root_alert_id" field.
+func RootAlertIDNotNil() predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNotNull(FieldRootAlertID))
+}
+
+// SuppressedByRuleIDEQ applies the EQ predicate on the "suppressed_by_rule_id" field.
+func SuppressedByRuleIDEQ(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldSuppressedByRuleID, v))
+}
+
+// SuppressedByRuleIDNEQ applies the NEQ predicate on the "suppressed_by_rule_id" field.
+func SuppressedByRuleIDNEQ(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNEQ(FieldSuppressedByRuleID, v))
+}
+
+// SuppressedByRuleIDIn applies the In predicate on the "suppressed_by_rule_id" field.
+func SuppressedByRuleIDIn(vs ...int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldIn(FieldSuppressedByRuleID, vs...))
+}
+
+// SuppressedByRuleIDNotIn applies the NotIn predicate on the "suppressed_by_rule_id" field.
+func SuppressedByRuleIDNotIn(vs ...int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNotIn(FieldSuppressedByRuleID, vs...))
+}
+
+// SuppressedByRuleIDGT applies the GT predicate on the "suppressed_by_rule_id" field.
+func SuppressedByRuleIDGT(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGT(FieldSuppressedByRuleID, v))
+}
+
+// SuppressedByRuleIDGTE applies the GTE predicate on the "suppressed_by_rule_id" field.
+func SuppressedByRuleIDGTE(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGTE(FieldSuppressedByRuleID, v))
+}
+
+// SuppressedByRuleIDLT applies the LT predicate on the "suppressed_by_rule_id" field.
+func SuppressedByRuleIDLT(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLT(FieldSuppressedByRuleID, v))
+}
+
+// SuppressedByRuleIDLTE applies the LTE predicate on the "suppressed_by_rule_id" field.
+func SuppressedByRuleIDLTE(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLTE(FieldSuppressedByRuleID, v))
+}
+
+// SuppressedByRuleIDIsNil applies the IsNil predicate on the "suppressed_by_rule_id" field.
+func SuppressedByRuleIDIsNil() predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldIsNull(FieldSuppressedByRuleID))
+}
+
+// SuppressedByRuleIDNotNil applies the NotNil predicate on the "suppressed_by_rule_id" field.
+func SuppressedByRuleIDNotNil() predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNotNull(FieldSuppressedByRuleID))
+}
+
+// StateChangesIsNil applies the IsNil predicate on the "state_changes" field.
+func StateChangesIsNil() predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldIsNull(FieldStateChanges))
+}
+
+// StateChangesNotNil applies the NotNil predicate on the "state_changes" field.
+func StateChangesNotNil() predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNotNull(FieldStateChanges))
+}
+
+// FlappingEQ applies the EQ predicate on the "flapping" field.
+func FlappingEQ(v bool) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldFlapping, v))
+}
+
+// FlappingNEQ applies the NEQ predicate on the "flapping" field.
+func FlappingNEQ(v bool) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNEQ(FieldFlapping, v))
+}
+
+// IncidentsOpenedEQ applies the EQ predicate on the "incidents_opened" field.
+func IncidentsOpenedEQ(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldIncidentsOpened, v))
+}
+
+// IncidentsOpenedNEQ applies the NEQ predicate on the "incidents_opened" field.
+func IncidentsOpenedNEQ(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNEQ(FieldIncidentsOpened, v))
+}
+
+// IncidentsOpenedIn applies the In predicate on the "incidents_opened" field.
+func IncidentsOpenedIn(vs ...int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldIn(FieldIncidentsOpened, vs...))
+}
+
+// IncidentsOpenedNotIn applies the NotIn predicate on the "incidents_opened" field.
+func IncidentsOpenedNotIn(vs ...int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNotIn(FieldIncidentsOpened, vs...))
+}
+
+// IncidentsOpenedGT applies the GT predicate on the "incidents_opened" field.
+func IncidentsOpenedGT(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGT(FieldIncidentsOpened, v))
+}
+
+// IncidentsOpenedGTE applies the GTE predicate on the "incidents_opened" field.
+func IncidentsOpenedGTE(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGTE(FieldIncidentsOpened, v))
+}
+
+// IncidentsOpenedLT applies the LT predicate on the "incidents_opened" field.
+func IncidentsOpenedLT(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLT(FieldIncidentsOpened, v))
+}
+
+// IncidentsOpenedLTE applies the LTE predicate on the "incidents_opened" field.
+func IncidentsOpenedLTE(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLTE(FieldIncidentsOpened, v))
+}
+
+// CorrelatedCountEQ applies the EQ predicate on the "correlated_count" field.
+func CorrelatedCountEQ(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldCorrelatedCount, v))
+}
+
+// CorrelatedCountNEQ applies the NEQ predicate on the "correlated_count" field.
+func CorrelatedCountNEQ(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNEQ(FieldCorrelatedCount, v))
+}
+
+// CorrelatedCountIn applies the In predicate on the "correlated_count" field.
+func CorrelatedCountIn(vs ...int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldIn(FieldCorrelatedCount, vs...))
+}
+
+// CorrelatedCountNotIn applies the NotIn predicate on the "correlated_count" field.
+func CorrelatedCountNotIn(vs ...int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNotIn(FieldCorrelatedCount, vs...))
+}
+
+// CorrelatedCountGT applies the GT predicate on the "correlated_count" field.
+func CorrelatedCountGT(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGT(FieldCorrelatedCount, v))
+}
+
+// CorrelatedCountGTE applies the GTE predicate on the "correlated_count" field.
+func CorrelatedCountGTE(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGTE(FieldCorrelatedCount, v))
+}
+
+// CorrelatedCountLT applies the LT predicate on the "correlated_count" field.
+func CorrelatedCountLT(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLT(FieldCorrelatedCount, v))
+}
+
+// CorrelatedCountLTE applies the LTE predicate on the "correlated_count" field.
+func CorrelatedCountLTE(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLTE(FieldCorrelatedCount, v))
+}
+
+// SuppressedCountEQ applies the EQ predicate on the "suppressed_count" field.
+func SuppressedCountEQ(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldSuppressedCount, v))
+}
+
+// SuppressedCountNEQ applies the NEQ predicate on the "suppressed_count" field.
+func SuppressedCountNEQ(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNEQ(FieldSuppressedCount, v))
+}
+
+// SuppressedCountIn applies the In predicate on the "suppressed_count" field.
+func SuppressedCountIn(vs ...int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldIn(FieldSuppressedCount, vs...))
+}
+
+// SuppressedCountNotIn applies the NotIn predicate on the "suppressed_count" field.
+func SuppressedCountNotIn(vs ...int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNotIn(FieldSuppressedCount, vs...))
+}
+
+// SuppressedCountGT applies the GT predicate on the "suppressed_count" field.
+func SuppressedCountGT(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGT(FieldSuppressedCount, v))
+}
+
+// SuppressedCountGTE applies the GTE predicate on the "suppressed_count" field.
+func SuppressedCountGTE(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGTE(FieldSuppressedCount, v))
+}
+
+// SuppressedCountLT applies the LT predicate on the "suppressed_count" field.
+func SuppressedCountLT(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLT(FieldSuppressedCount, v))
+}
+
+// SuppressedCountLTE applies the LTE predicate on the "suppressed_count" field.
+func SuppressedCountLTE(v int) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLTE(FieldSuppressedCount, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MonitoringAlert) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MonitoringAlert) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MonitoringAlert) predicate.MonitoringAlert {
+	return predicate.MonitoringAlert(sql.NotPredicates(p))
+}
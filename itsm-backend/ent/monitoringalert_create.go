@@ -0,0 +1,746 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/monitoringalert"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MonitoringAlertCreate is the builder for creating a MonitoringAlert entity.
+type MonitoringAlertCreate struct {
+	config
+	mutation *MonitoringAlertMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *MonitoringAlertCreate) SetTenantID(v int) *MonitoringAlertCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *MonitoringAlertCreate) SetSource(v string) *MonitoringAlertCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (_c *MonitoringAlertCreate) SetFingerprint(v string) *MonitoringAlertCreate {
+	_c.mutation.SetFingerprint(v)
+	return _c
+}
+
+// SetAlertName sets the "alert_name" field.
+func (_c *MonitoringAlertCreate) SetAlertName(v string) *MonitoringAlertCreate {
+	_c.mutation.SetAlertName(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *MonitoringAlertCreate) SetStatus(v monitoringalert.Status) *MonitoringAlertCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *MonitoringAlertCreate) SetNillableStatus(v *monitoringalert.Status) *MonitoringAlertCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetSeverity sets the "severity" field.
+func (_c *MonitoringAlertCreate) SetSeverity(v string) *MonitoringAlertCreate {
+	_c.mutation.SetSeverity(v)
+	return _c
+}
+
+// SetNillableSeverity sets the "severity" field if the given value is not nil.
+func (_c *MonitoringAlertCreate) SetNillableSeverity(v *string) *MonitoringAlertCreate {
+	if v != nil {
+		_c.SetSeverity(*v)
+	}
+	return _c
+}
+
+// SetSummary sets the "summary" field.
+func (_c *MonitoringAlertCreate) SetSummary(v string) *MonitoringAlertCreate {
+	_c.mutation.SetSummary(v)
+	return _c
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (_c *MonitoringAlertCreate) SetNillableSummary(v *string) *MonitoringAlertCreate {
+	if v != nil {
+		_c.SetSummary(*v)
+	}
+	return _c
+}
+
+// SetLabels sets the "labels" field.
+func (_c *MonitoringAlertCreate) SetLabels(v map[string]string) *MonitoringAlertCreate {
+	_c.mutation.SetLabels(v)
+	return _c
+}
+
+// SetAnnotations sets the "annotations" field.
+func (_c *MonitoringAlertCreate) SetAnnotations(v map[string]string) *MonitoringAlertCreate {
+	_c.mutation.SetAnnotations(v)
+	return _c
+}
+
+// SetGeneratorURL sets the "generator_url" field.
+func (_c *MonitoringAlertCreate) SetGeneratorURL(v string) *MonitoringAlertCreate {
+	_c.mutation.SetGeneratorURL(v)
+	return _c
+}
+
+// SetNillableGeneratorURL sets the "generator_url" field if the given value is not nil.
+func (_c *MonitoringAlertCreate) SetNillableGeneratorURL(v *string) *MonitoringAlertCreate {
+	if v != nil {
+		_c.SetGeneratorURL(*v)
+	}
+	return _c
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (_c *MonitoringAlertCreate) SetStartsAt(v time.Time) *MonitoringAlertCreate {
+	_c.mutation.SetStartsAt(v)
+	return _c
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (_c *MonitoringAlertCreate) SetNillableStartsAt(v *time.Time) *MonitoringAlertCreate {
+	if v != nil {
+		_c.SetStartsAt(*v)
+	}
+	return _c
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (_c *MonitoringAlertCreate) SetEndsAt(v time.Time) *MonitoringAlertCreate {
+	_c.mutation.SetEndsAt(v)
+	return _c
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (_c *MonitoringAlertCreate) SetNillableEndsAt(v *time.Time) *MonitoringAlertCreate {
+	if v != nil {
+		_c.SetEndsAt(*v)
+	}
+	return _c
+}
+
+// SetLastReceivedAt sets the "last_received_at" field.
+func (_c *MonitoringAlertCreate) SetLastReceivedAt(v time.Time) *MonitoringAlertCreate {
+	_c.mutation.SetLastReceivedAt(v)
+	return _c
+}
+
+// SetNillableLastReceivedAt sets the "last_received_at" field if the given value is not nil.
+func (_c *MonitoringAlertCreate) SetNillableLastReceivedAt(v *time.Time) *MonitoringAlertCreate {
+	if v != nil {
+		_c.SetLastReceivedAt(*v)
+	}
+	return _c
+}
+
+// SetReceiveCount sets the "receive_count" field.
+func (_c *MonitoringAlertCreate) SetReceiveCount(v int) *MonitoringAlertCreate {
+	_c.mutation.SetReceiveCount(v)
+	return _c
+}
+
+// SetNillableReceiveCount sets the "receive_count" field if the given value is not nil.
+func (_c *MonitoringAlertCreate) SetNillableReceiveCount(v *int) *MonitoringAlertCreate {
+	if v != nil {
+		_c.SetReceiveCount(*v)
+	}
+	return _c
+}
+
+// SetIncidentID sets the "incident_id" field.
+func (_c *MonitoringAlertCreate) SetIncidentID(v int) *MonitoringAlertCreate {
+	_c.mutation.SetIncidentID(v)
+	return _c
+}
+
+// SetNillableIncidentID sets the "incident_id" field if the given value is not nil.
+func (_c *MonitoringAlertCreate) SetNillableIncidentID(v *int) *MonitoringAlertCreate {
+	if v != nil {
+		_c.SetIncidentID(*v)
+	}
+	return _c
+}
+
+// SetIncidentAlertID sets the "incident_alert_id" field.
+func (_c *MonitoringAlertCreate) SetIncidentAlertID(v int) *MonitoringAlertCreate {
+	_c.mutation.SetIncidentAlertID(v)
+	return _c
+}
+
+// SetNillableIncidentAlertID sets the "incident_alert_id" field if the given value is not nil.
+func (_c *MonitoringAlertCreate) SetNillableIncidentAlertID(v *int) *MonitoringAlertCreate {
+	if v != nil {
+		_c.SetIncidentAlertID(*v)
+	}
+	return _c
+}
+
+// SetConfigurationItemID sets the "configuration_item_id" field.
+func (_c *MonitoringAlertCreate) SetConfigurationItemID(v int) *MonitoringAlertCreate {
+	_c.mutation.SetConfigurationItemID(v)
+	return _c
+}
+
+// SetNillableConfigurationItemID sets the "configuration_item_id" field if the given value is not nil.
+func (_c *MonitoringAlertCreate) SetNillableConfigurationItemID(v *int) *MonitoringAlertCreate {
+	if v != nil {
+		_c.SetConfigurationItemID(*v)
+	}
+	return _c
+}
+
+// SetCorrelationReason sets the "correlation_reason" field.
+func (_c *MonitoringAlertCreate) SetCorrelationReason(v monitoringalert.CorrelationReason) *MonitoringAlertCreate {
+	_c.mutation.SetCorrelationReason(v)
+	return _c
+}
+
+// SetNillableCorrelationReason sets the "correlation_reason" field if the given value is not nil.
+func (_c *MonitoringAlertCreate) SetNillableCorrelationReason(v *monitoringalert.CorrelationReason) *MonitoringAlertCreate {
+	if v != nil {
+		_c.SetCorrelationReason(*v)
+	}
+	return _c
+}
+
+// SetRootAlertID sets the "root_alert_id" field.
+func (_c *MonitoringAlertCreate) SetRootAlertID(v int) *MonitoringAlertCreate {
+	_c.mutation.SetRootAlertID(v)
+	return _c
+}
+
+// SetNillableRootAlertID sets the "root_alert_id" field if the given value is not nil.
+func (_c *MonitoringAlertCreate) SetNillableRootAlertID(v *int) *MonitoringAlertCreate {
+	if v != nil {
+		_c.SetRootAlertID(*v)
+	}
+	return _c
+}
+
+// SetSuppressedByRuleID sets the "suppressed_by_rule_id" field.
+func (_c *MonitoringAlertCreate) SetSuppressedByRuleID(v int) *MonitoringAlertCreate {
+	_c.mutation.SetSuppressedByRuleID(v)
+	return _c
+}
+
+// SetNillableSuppressedByRuleID sets the "suppressed_by_rule_id" field if the given value is not nil.
+func (_c *MonitoringAlertCreate) SetNillableSuppressedByRuleID(v *int) *MonitoringAlertCreate {
+	if v != nil {
+		_c.SetSuppressedByRuleID(*v)
+	}
+	return _c
+}
+
+// SetStateChanges sets the "state_changes" field.
+func (_c *MonitoringAlertCreate) SetStateChanges(v []time.Time) *MonitoringAlertCreate {
+	_c.mutation.SetStateChanges(v)
+	return _c
+}
+
+// SetFlapping sets the "flapping" field.
+func (_c *MonitoringAlertCreate) SetFlapping(v bool) *MonitoringAlertCreate {
+	_c.mutation.SetFlapping(v)
+	return _c
+}
+
+// SetNillableFlapping sets the "flapping" field if the given value is not nil.
+func (_c *MonitoringAlertCreate) SetNillableFlapping(v *bool) *MonitoringAlertCreate {
+	if v != nil {
+		_c.SetFlapping(*v)
+	}
+	return _c
+}
+
+// SetIncidentsOpened sets the "incidents_opened" field.
+func (_c *MonitoringAlertCreate) SetIncidentsOpened(v int) *MonitoringAlertCreate {
+	_c.mutation.SetIncidentsOpened(v)
+	return _c
+}
+
+// SetNillableIncidentsOpened sets the "incidents_opened" field if the given value is not nil.
+func (_c *MonitoringAlertCreate) SetNillableIncidentsOpened(v *int) *MonitoringAlertCreate {
+	if v != nil {
+		_c.SetIncidentsOpened(*v)
+	}
+	return _c
+}
+
+// SetCorrelatedCount sets the "correlated_count" field.
+func (_c *MonitoringAlertCreate) SetCorrelatedCount(v int) *MonitoringAlertCreate {
+	_c.mutation.SetCorrelatedCount(v)
+	return _c
+}
+
+// SetNillableCorrelatedCount sets the "correlated_count" field if the given value is not nil.
+func (_c *MonitoringAlertCreate) SetNillableCorrelatedCount(v *int) *MonitoringAlertCreate {
+	if v != nil {
+		_c.SetCorrelatedCount(*v)
+	}
+	return _c
+}
+
+// SetSuppressedCount sets the "suppressed_count" field.
+func (_c *MonitoringAlertCreate) SetSuppressedCount(v int) *MonitoringAlertCreate {
+	_c.mutation.SetSuppressedCount(v)
+	return _c
+}
+
+// SetNillableSuppressedCount sets the "suppressed_count" field if the given value is not nil.
+func (_c *MonitoringAlertCreate) SetNillableSuppressedCount(v *int) *MonitoringAlertCreate {
+	if v != nil {
+		_c.SetSuppressedCount(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *MonitoringAlertCreate) SetCreatedAt(v time.Time) *MonitoringAlertCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *MonitoringAlertCreate) SetNillableCreatedAt(v *time.Time) *MonitoringAlertCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *MonitoringAlertCreate) SetUpdatedAt(v time.Time) *MonitoringAlertCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *MonitoringAlertCreate) SetNillableUpdatedAt(v *time.Time) *MonitoringAlertCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the MonitoringAlertMutation object of the builder.
+func (_c *MonitoringAlertCreate) Mutation() *MonitoringAlertMutation {
+	return _c.mutation
+}
+
+// Save creates the MonitoringAlert in the database.
+func (_c *MonitoringAlertCreate) Save(ctx context.Context) (*MonitoringAlert, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MonitoringAlertCreate) SaveX(ctx context.Context) *MonitoringAlert {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MonitoringAlertCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MonitoringAlertCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MonitoringAlertCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := monitoringalert.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Severity(); !ok {
+		v := monitoringalert.DefaultSeverity
+		_c.mutation.SetSeverity(v)
+	}
+	if _, ok := _c.mutation.StartsAt(); !ok {
+		v := monitoringalert.DefaultStartsAt()
+		_c.mutation.SetStartsAt(v)
+	}
+	if _, ok := _c.mutation.LastReceivedAt(); !ok {
+		v := monitoringalert.DefaultLastReceivedAt()
+		_c.mutation.SetLastReceivedAt(v)
+	}
+	if _, ok := _c.mutation.ReceiveCount(); !ok {
+		v := monitoringalert.DefaultReceiveCount
+		_c.mutation.SetReceiveCount(v)
+	}
+	if _, ok := _c.mutation.Flapping(); !ok {
+		v := monitoringalert.DefaultFlapping
+		_c.mutation.SetFlapping(v)
+	}
+	if _, ok := _c.mutation.IncidentsOpened(); !ok {
+		v := monitoringalert.DefaultIncidentsOpened
+		_c.mutation.SetIncidentsOpened(v)
+	}
+	if _, ok := _c.mutation.CorrelatedCount(); !ok {
+		v := monitoringalert.DefaultCorrelatedCount
+		_c.mutation.SetCorrelatedCount(v)
+	}
+	if _, ok := _c.mutation.SuppressedCount(); !ok {
+		v := monitoringalert.DefaultSuppressedCount
+		_c.mutation.SetSuppressedCount(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := monitoringalert.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := monitoringalert.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MonitoringAlertCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "MonitoringAlert.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := monitoringalert.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "MonitoringAlert.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "MonitoringAlert.source"`)}
+	}
+	if v, ok := _c.mutation.Source(); ok {
+		if err := monitoringalert.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "MonitoringAlert.source": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Fingerprint(); !ok {
+		return &ValidationError{Name: "fingerprint", err: errors.New(`ent: missing required field "MonitoringAlert.fingerprint"`)}
+	}
+	if v, ok := _c.mutation.Fingerprint(); ok {
+		if err := monitoringalert.FingerprintValidator(v); err != nil {
+			return &ValidationError{Name: "fingerprint", err: fmt.Errorf(`ent: validator failed for field "MonitoringAlert.fingerprint": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AlertName(); !ok {
+		return &ValidationError{Name: "alert_name", err: errors.New(`ent: missing required field "MonitoringAlert.alert_name"`)}
+	}
+	if v, ok := _c.mutation.AlertName(); ok {
+		if err := monitoringalert.AlertNameValidator(v); err != nil {
+			return &ValidationError{Name: "alert_name", err: fmt.Errorf(`ent: validator failed for field "MonitoringAlert.alert_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "MonitoringAlert.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := monitoringalert.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "MonitoringAlert.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Severity(); !ok {
+		return &ValidationError{Name: "severity", err: errors.New(`ent: missing required field "MonitoringAlert.severity"`)}
+	}
+	if _, ok := _c.mutation.StartsAt(); !ok {
+		return &ValidationError{Name: "starts_at", err: errors.New(`ent: missing required field "MonitoringAlert.starts_at"`)}
+	}
+	if _, ok := _c.mutation.LastReceivedAt(); !ok {
+		return &ValidationError{Name: "last_received_at", err: errors.New(`ent: missing required field "MonitoringAlert.last_received_at"`)}
+	}
+	if _, ok := _c.mutation.ReceiveCount(); !ok {
+		return &ValidationError{Name: "receive_count", err: errors.New(`ent: missing required field "MonitoringAlert.receive_count"`)}
+	}
+	if v, ok := _c.mutation.ReceiveCount(); ok {
+		if err := monitoringalert.ReceiveCountValidator(v); err != nil {
+			return &ValidationError{Name: "receive_count", err: fmt.Errorf(`ent: validator failed for field "MonitoringAlert.receive_count": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.CorrelationReason(); ok {
+		if err := monitoringalert.CorrelationReasonValidator(v); err != nil {
+			return &ValidationError{Name: "correlation_reason", err: fmt.Errorf(`ent: validator failed for field "MonitoringAlert.correlation_reason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Flapping(); !ok {
+		return &ValidationError{Name: "flapping", err: errors.New(`ent: missing required field "MonitoringAlert.flapping"`)}
+	}
+	if _, ok := _c.mutation.IncidentsOpened(); !ok {
+		return &ValidationError{Name: "incidents_opened", err: errors.New(`ent: missing required field "MonitoringAlert.incidents_opened"`)}
+	}
+	if v, ok := _c.mutation.IncidentsOpened(); ok {
+		if err := monitoringalert.IncidentsOpenedValidator(v); err != nil {
+			return &ValidationError{Name: "incidents_opened", err: fmt.Errorf(`ent: validator failed for field "MonitoringAlert.incidents_opened": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CorrelatedCount(); !ok {
+		return &ValidationError{Name: "correlated_count", err: errors.New(`ent: missing required field "MonitoringAlert.correlated_count"`)}
+	}
+	if v, ok := _c.mutation.CorrelatedCount(); ok {
+		if err := monitoringalert.CorrelatedCountValidator(v); err != nil {
+			return &ValidationError{Name: "correlated_count", err: fmt.Errorf(`ent: validator failed for field "MonitoringAlert.correlated_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SuppressedCount(); !ok {
+		return &ValidationError{Name: "suppressed_count", err: errors.New(`ent: missing required field "MonitoringAlert.suppressed_count"`)}
+	}
+	if v, ok := _c.mutation.SuppressedCount(); ok {
+		if err := monitoringalert.SuppressedCountValidator(v); err != nil {
+			return &ValidationError{Name: "suppressed_count", err: fmt.Errorf(`ent: validator failed for field "MonitoringAlert.suppressed_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MonitoringAlert.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "MonitoringAlert.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *MonitoringAlertCreate) sqlSave(ctx context.Context) (*MonitoringAlert, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MonitoringAlertCreate) createSpec() (*MonitoringAlert, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MonitoringAlert{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(monitoringalert.Table, sqlgraph.NewFieldSpec(monitoringalert.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(monitoringalert.FieldTenantID, field.TypeInt, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(monitoringalert.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.Fingerprint(); ok {
+		_spec.SetField(monitoringalert.FieldFingerprint, field.TypeString, value)
+		_node.Fingerprint = value
+	}
+	if value, ok := _c.mutation.AlertName(); ok {
+		_spec.SetField(monitoringalert.FieldAlertName, field.TypeString, value)
+		_node.AlertName = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(monitoringalert.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Severity(); ok {
+		_spec.SetField(monitoringalert.FieldSeverity, field.TypeString, value)
+		_node.Severity = value
+	}
+	if value, ok := _c.mutation.Summary(); ok {
+		_spec.SetField(monitoringalert.FieldSummary, field.TypeString, value)
+		_node.Summary = value
+	}
+	if value, ok := _c.mutation.Labels(); ok {
+		_spec.SetField(monitoringalert.FieldLabels, field.TypeJSON, value)
+		_node.Labels = value
+	}
+	if value, ok := _c.mutation.Annotations(); ok {
+		_spec.SetField(monitoringalert.FieldAnnotations, field.TypeJSON, value)
+		_node.Annotations = value
+	}
+	if value, ok := _c.mutation.GeneratorURL(); ok {
+		_spec.SetField(monitoringalert.FieldGeneratorURL, field.TypeString, value)
+		_node.GeneratorURL = value
+	}
+	if value, ok := _c.mutation.StartsAt(); ok {
+		_spec.SetField(monitoringalert.FieldStartsAt, field.TypeTime, value)
+		_node.StartsAt = value
+	}
+	if value, ok := _c.mutation.EndsAt(); ok {
+		_spec.SetField(monitoringalert.FieldEndsAt, field.TypeTime, value)
+		_node.EndsAt = &value
+	}
+	if value, ok := _c.mutation.LastReceivedAt(); ok {
+		_spec.SetField(monitoringalert.FieldLastReceivedAt, field.TypeTime, value)
+		_node.LastReceivedAt = value
+	}
+	if value, ok := _c.mutation.ReceiveCount(); ok {
+		_spec.SetField(monitoringalert.FieldReceiveCount, field.TypeInt, value)
+		_node.ReceiveCount = value
+	}
+	if value, ok := _c.mutation.IncidentID(); ok {
+		_spec.SetField(monitoringalert.FieldIncidentID, field.TypeInt, value)
+		_node.IncidentID = &value
+	}
+	if value, ok := _c.mutation.IncidentAlertID(); ok {
+		_spec.SetField(monitoringalert.FieldIncidentAlertID, field.TypeInt, value)
+		_node.IncidentAlertID = &value
+	}
+	if value, ok := _c.mutation.ConfigurationItemID(); ok {
+		_spec.SetField(monitoringalert.FieldConfigurationItemID, field.TypeInt, value)
+		_node.ConfigurationItemID = &value
+	}
+	if value, ok := _c.mutation.CorrelationReason(); ok {
+		_spec.SetField(monitoringalert.FieldCorrelationReason, field.TypeEnum, value)
+		_node.CorrelationReason = &value
+	}
+	if value, ok := _c.mutation.RootAlertID(); ok {
+		_spec.SetField(monitoringalert.FieldRootAlertID, field.TypeInt, value)
+		_node.RootAlertID = &value
+	}
+	if value, ok := _c.mutation.SuppressedByRuleID(); ok {
+		_spec.SetField(monitoringalert.FieldSuppressedByRuleID, field.TypeInt, value)
+		_node.SuppressedByRuleID = &value
+	}
+	if value, ok := _c.mutation.StateChanges(); ok {
+		_spec.SetField(monitoringalert.FieldStateChanges, field.TypeJSON, value)
+		_node.StateChanges = value
+	}
+	if value, ok := _c.mutation.Flapping(); ok {
+		_spec.SetField(monitoringalert.FieldFlapping, field.TypeBool, value)
+		_node.Flapping = value
+	}
+	if value, ok := _c.mutation.IncidentsOpened(); ok {
+		_spec.SetField(monitoringalert.FieldIncidentsOpened, field.TypeInt, value)
+		_node.IncidentsOpened = value
+	}
+	if value, ok := _c.mutation.CorrelatedCount(); ok {
+		_spec.SetField(monitoringalert.FieldCorrelatedCount, field.TypeInt, value)
+		_node.CorrelatedCount = value
+	}
+	if value, ok := _c.mutation.SuppressedCount(); ok {
+		_spec.SetField(monitoringalert.FieldSuppressedCount, field.TypeInt, value)
+		_node.SuppressedCount = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(monitoringalert.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(monitoringalert.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// MonitoringAlertCreateBulk is the builder for creating many MonitoringAlert entities in bulk.
+type MonitoringAlertCreateBulk struct {
+	config
+	err      error
+	builders []*MonitoringAlertCreate
+}
+
+// Save creates the MonitoringAlert entities in the database.
+func (_c *MonitoringAlertCreateBulk) Save(ctx context.Context) ([]*MonitoringAlert, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*MonitoringAlert, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MonitoringAlertMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MonitoringAlertCreateBulk) SaveX(ctx context.Context) []*MonitoringAlert {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MonitoringAlertCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MonitoringAlertCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1569 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/monitoringalert"
+	"itsm-backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// MonitoringAlertUpdate is the builder for updating MonitoringAlert entities.
+type MonitoringAlertUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MonitoringAlertMutation
+}
+
+// Where appends a list predicates to the MonitoringAlertUpdate builder.
+func (_u *MonitoringAlertUpdate) Where(ps ...predicate.MonitoringAlert) *MonitoringAlertUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *MonitoringAlertUpdate) SetTenantID(v int) *MonitoringAlertUpdate {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *MonitoringAlertUpdate) SetNillableTenantID(v *int) *MonitoringAlertUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *MonitoringAlertUpdate) AddTenantID(v int) *MonitoringAlertUpdate {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *MonitoringAlertUpdate) SetSource(v string) *MonitoringAlertUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *MonitoringAlertUpdate) SetNillableSource(v *string) *MonitoringAlertUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (_u *MonitoringAlertUpdate) SetFingerprint(v string) *MonitoringAlertUpdate {
+	_u.mutation.SetFingerprint(v)
+	return _u
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (_u *MonitoringAlertUpdate) SetNillableFingerprint(v *string) *MonitoringAlertUpdate {
+	if v != nil {
+		_u.SetFingerprint(*v)
+	}
+	return _u
+}
+
+// SetAlertName sets the "alert_name" field.
+func (_u *MonitoringAlertUpdate) SetAlertName(v string) *MonitoringAlertUpdate {
+	_u.mutation.SetAlertName(v)
+	return _u
+}
+
+// SetNillableAlertName sets the "alert_name" field if the given value is not nil.
+func (_u *MonitoringAlertUpdate) SetNillableAlertName(v *string) *MonitoringAlertUpdate {
+	if v != nil {
+		_u.SetAlertName(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *MonitoringAlertUpdate) SetStatus(v monitoringalert.Status) *MonitoringAlertUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *MonitoringAlertUpdate) SetNillableStatus(v *monitoringalert.Status) *MonitoringAlertUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetSeverity sets the "severity" field.
+func (_u *MonitoringAlertUpdate) SetSeverity(v string) *MonitoringAlertUpdate {
+	_u.mutation.SetSeverity(v)
+	return _u
+}
+
+// SetNillableSeverity sets the "severity" field if the given value is not nil.
+func (_u *MonitoringAlertUpdate) SetNillableSeverity(v *string) *MonitoringAlertUpdate {
+	if v != nil {
+		_u.SetSeverity(*v)
+	}
+	return _u
+}
+
+// SetSummary sets the "summary" field.
+func (_u *MonitoringAlertUpdate) SetSummary(v string) *MonitoringAlertUpdate {
+	_u.mutation.SetSummary(v)
+	return _u
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (_u *MonitoringAlertUpdate) SetNillableSummary(v *string) *MonitoringAlertUpdate {
+	if v != nil {
+		_u.SetSummary(*v)
+	}
+	return _u
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (_u *MonitoringAlertUpdate) ClearSummary() *MonitoringAlertUpdate {
+	_u.mutation.ClearSummary()
+	return _u
+}
+
+// SetLabels sets the "labels" field.
+func (_u *MonitoringAlertUpdate) SetLabels(v map[string]string) *MonitoringAlertUpdate {
+	_u.mutation.SetLabels(v)
+	return _u
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (_u *MonitoringAlertUpdate) ClearLabels() *MonitoringAlertUpdate {
+	_u.mutation.ClearLabels()
+	return _u
+}
+
+// SetAnnotations sets the "annotations" field.
+func (_u *MonitoringAlertUpdate) SetAnnotations(v map[string]string) *MonitoringAlertUpdate {
+	_u.mutation.SetAnnotations(v)
+	return _u
+}
+
+// ClearAnnotations clears the value of the "annotations" field.
+func (_u *MonitoringAlertUpdate) ClearAnnotations() *MonitoringAlertUpdate {
+	_u.mutation.ClearAnnotations()
+	return _u
+}
+
+// SetGeneratorURL sets the "generator_url" field.
+func (_u *MonitoringAlertUpdate) SetGeneratorURL(v string) *MonitoringAlertUpdate {
+	_u.mutation.SetGeneratorURL(v)
+	return _u
+}
+
+// SetNillableGeneratorURL sets the "generator_url" field if the given value is not nil.
+func (_u *MonitoringAlertUpdate) SetNillableGeneratorURL(v *string) *MonitoringAlertUpdate {
+	if v != nil {
+		_u.SetGeneratorURL(*v)
+	}
+	return _u
+}
+
+// ClearGeneratorURL clears the value of the "generator_url" field.
+func (_u *MonitoringAlertUpdate) ClearGeneratorURL() *MonitoringAlertUpdate {
+	_u.mutation.ClearGeneratorURL()
+	return _u
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (_u *MonitoringAlertUpdate) SetStartsAt(v time.Time) *MonitoringAlertUpdate {
+	_u.mutation.SetStartsAt(v)
+	return _u
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (_u *MonitoringAlertUpdate) SetNillableStartsAt(v *time.Time) *MonitoringAlertUpdate {
+	if v != nil {
+		_u.SetStartsAt(*v)
+	}
+	return _u
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (_u *MonitoringAlertUpdate) SetEndsAt(v time.Time) *MonitoringAlertUpdate {
+	_u.mutation.SetEndsAt(v)
+	return _u
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (_u *MonitoringAlertUpdate) SetNillableEndsAt(v *time.Time) *MonitoringAlertUpdate {
+	if v != nil {
+		_u.SetEndsAt(*v)
+	}
+	return _u
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (_u *MonitoringAlertUpdate) ClearEndsAt() *MonitoringAlertUpdate {
+	_u.mutation.ClearEndsAt()
+	return _u
+}
+
+// SetLastReceivedAt sets the "last_received_at" field.
+func (_u *MonitoringAlertUpdate) SetLastReceivedAt(v time.Time) *MonitoringAlertUpdate {
+	_u.mutation.SetLastReceivedAt(v)
+	return _u
+}
+
+// SetNillableLastReceivedAt sets the "last_received_at" field if the given value is not nil.
+func (_u *MonitoringAlertUpdate) SetNillableLastReceivedAt(v *time.Time) *MonitoringAlertUpdate {
+	if v != nil {
+		_u.SetLastReceivedAt(*v)
+	}
+	return _u
+}
+
+// SetReceiveCount sets the "receive_count" field.
+func (_u *MonitoringAlertUpdate) SetReceiveCount(v int) *MonitoringAlertUpdate {
+	_u.mutation.ResetReceiveCount()
+	_u.mutation.SetReceiveCount(v)
+	return _u
+}
+
+// SetNillableReceiveCount sets the "receive_count" field if the given value is not nil.
+func (_u *MonitoringAlertUpdate) SetNillableReceiveCount(v *int) *MonitoringAlertUpdate {
+	if v != nil {
+		_u.SetReceiveCount(*v)
+	}
+	return _u
+}
+
+// AddReceiveCount adds value to the "receive_count" field.
+func (_u *MonitoringAlertUpdate) AddReceiveCount(v int) *MonitoringAlertUpdate {
+	_u.mutation.AddReceiveCount(v)
+	return _u
+}
+
+// SetIncidentID sets the "incident_id" field.
+func (_u *MonitoringAlertUpdate) SetIncidentID(v int) *MonitoringAlertUpdate {
+	_u.mutation.ResetIncidentID()
+	_u.mutation.SetIncidentID(v)
+	return _u
+}
+
+// SetNillableIncidentID sets the "incident_id" field if the given value is not nil.
+func (_u *MonitoringAlertUpdate) SetNillableIncidentID(v *int) *MonitoringAlertUpdate {
+	if v != nil {
+		_u.SetIncidentID(*v)
+	}
+	return _u
+}
+
+// AddIncidentID adds value to the "incident_id" field.
+func (_u *MonitoringAlertUpdate) AddIncidentID(v int) *MonitoringAlertUpdate {
+	_u.mutation.AddIncidentID(v)
+	return _u
+}
+
+// ClearIncidentID clears the value of the "incident_id" field.
+func (_u *MonitoringAlertUpdate) ClearIncidentID() *MonitoringAlertUpdate {
+	_u.mutation.ClearIncidentID()
+	return _u
+}
+
+// SetIncidentAlertID sets the "incident_alert_id" field.
+func (_u *MonitoringAlertUpdate) SetIncidentAlertID(v int) *MonitoringAlertUpdate {
+	_u.mutation.ResetIncidentAlertID()
+	_u.mutation.SetIncidentAlertID(v)
+	return _u
+}
+
+// SetNillableIncidentAlertID sets the "incident_alert_id" field if the given value is not nil.
+func (_u *MonitoringAlertUpdate) SetNillableIncidentAlertID(v *int) *MonitoringAlertUpdate {
+	if v != nil {
+		_u.SetIncidentAlertID(*v)
+	}
+	return _u
+}
+
+// AddIncidentAlertID adds value to the "incident_alert_id" field.
+func (_u *MonitoringAlertUpdate) AddIncidentAlertID(v int) *MonitoringAlertUpdate {
+	_u.mutation.AddIncidentAlertID(v)
+	return _u
+}
+
+// ClearIncidentAlertID clears the value of the "incident_alert_id" field.
+func (_u *MonitoringAlertUpdate) ClearIncidentAlertID() *MonitoringAlertUpdate {
+	_u.mutation.ClearIncidentAlertID()
+	return _u
+}
+
+// SetConfigurationItemID sets the "configuration_item_id" field.
+func (_u *MonitoringAlertUpdate) SetConfigurationItemID(v int) *MonitoringAlertUpdate {
+	_u.mutation.ResetConfigurationItemID()
+	_u.mutation.SetConfigurationItemID(v)
+	return _u
+}
+
+// SetNillableConfigurationItemID sets the "configuration_item_id" field if the given value is not nil.
+func (_u *MonitoringAlertUpdate) SetNillableConfigurationItemID(v *int) *MonitoringAlertUpdate {
+	if v != nil {
+		_u.SetConfigurationItemID(*v)
+	}
+	return _u
+}
+
+// AddConfigurationItemID adds value to the "configuration_item_id" field.
+func (_u *MonitoringAlertUpdate) AddConfigurationItemID(v int) *MonitoringAlertUpdate {
+	_u.mutation.AddConfigurationItemID(v)
+	return _u
+}
+
+// ClearConfigurationItemID clears the value of the "configuration_item_id" field.
+func (_u *MonitoringAlertUpdate) ClearConfigurationItemID() *MonitoringAlertUpdate {
+	_u.mutation.ClearConfigurationItemID()
+	return _u
+}
+
+// SetCorrelationReason sets the "correlation_reason" field.
+func (_u *MonitoringAlertUpdate) SetCorrelationReason(v monitoringalert.CorrelationReason) *MonitoringAlertUpdate {
+	_u.mutation.SetCorrelationReason(v)
+	return _u
+}
+
+// SetNillableCorrelationReason sets the "correlation_reason" field if the given value is not nil.
+func (_u *MonitoringAlertUpdate) SetNillableCorrelationReason(v *monitoringalert.CorrelationReason) *MonitoringAlertUpdate {
+	if v != nil {
+		_u.SetCorrelationReason(*v)
+	}
+	return _u
+}
+
+// ClearCorrelationReason clears the value of the "correlation_reason" field.
+func (_u *MonitoringAlertUpdate) ClearCorrelationReason() *MonitoringAlertUpdate {
+	_u.mutation.ClearCorrelationReason()
+	return _u
+}
+
+// SetRootAlertID sets the "root_alert_id" field.
+func (_u *MonitoringAlertUpdate) SetRootAlertID(v int) *MonitoringAlertUpdate {
+	_u.mutation.ResetRootAlertID()
+	_u.mutation.SetRootAlertID(v)
+	return _u
+}
+
+// SetNillableRootAlertID sets the "root_alert_id" field if the given value is not nil.
+func (_u *MonitoringAlertUpdate) SetNillableRootAlertID(v *int) *MonitoringAlertUpdate {
+	if v != nil {
+		_u.SetRootAlertID(*v)
+	}
+	return _u
+}
+
+// AddRootAlertID adds value to the "root_alert_id" field.
+func (_u *MonitoringAlertUpdate) AddRootAlertID(v int) *MonitoringAlertUpdate {
+	_u.mutation.AddRootAlertID(v)
+	return _u
+}
+
+// ClearRootAlertID clears the value of the "root_alert_id" field.
+func (_u *MonitoringAlertUpdate) ClearRootAlertID() *MonitoringAlertUpdate {
+	_u.mutation.ClearRootAlertID()
+	return _u
+}
+
+// SetSuppressedByRuleID sets the "suppressed_by_rule_id" field.
+func (_u *MonitoringAlertUpdate) SetSuppressedByRuleID(v int) *MonitoringAlertUpdate {
+	_u.mutation.ResetSuppressedByRuleID()
+	_u.mutation.SetSuppressedByRuleID(v)
+	return _u
+}
+
+// SetNillableSuppressedByRuleID sets the "suppressed_by_rule_id" field if the given value is not nil.
+func (_u *MonitoringAlertUpdate) SetNillableSuppressedByRuleID(v *int) *MonitoringAlertUpdate {
+	if v != nil {
+		_u.SetSuppressedByRuleID(*v)
+	}
+	return _u
+}
+
+// AddSuppressedByRuleID adds value to the "suppressed_by_rule_id" field.
+func (_u *MonitoringAlertUpdate) AddSuppressedByRuleID(v int) *MonitoringAlertUpdate {
+	_u.mutation.AddSuppressedByRuleID(v)
+	return _u
+}
+
+// ClearSuppressedByRuleID clears the value of the "suppressed_by_rule_id" field.
+func (_u *MonitoringAlertUpdate) ClearSuppressedByRuleID() *MonitoringAlertUpdate {
+	_u.mutation.ClearSuppressedByRuleID()
+	return _u
+}
+
+// SetStateChanges sets the "state_changes" field.
+func (_u *MonitoringAlertUpdate) SetStateChanges(v []time.Time) *MonitoringAlertUpdate {
+	_u.mutation.SetStateChanges(v)
+	return _u
+}
+
+// AppendStateChanges appends value to the "state_changes" field.
+func (_u *MonitoringAlertUpdate) AppendStateChanges(v []time.Time) *MonitoringAlertUpdate {
+	_u.mutation.AppendStateChanges(v)
+	return _u
+}
+
+// ClearStateChanges clears the value of the "state_changes" field.
+func (_u *MonitoringAlertUpdate) ClearStateChanges() *MonitoringAlertUpdate {
+	_u.mutation.ClearStateChanges()
+	return _u
+}
+
+// SetFlapping sets the "flapping" field.
+func (_u *MonitoringAlertUpdate) SetFlapping(v bool) *MonitoringAlertUpdate {
+	_u.mutation.SetFlapping(v)
+	return _u
+}
+
+// SetNillableFlapping sets the "flapping" field if the given value is not nil.
+func (_u *MonitoringAlertUpdate) SetNillableFlapping(v *bool) *MonitoringAlertUpdate {
+	if v != nil {
+		_u.SetFlapping(*v)
+	}
+	return _u
+}
+
+// SetIncidentsOpened sets the "incidents_opened" field.
+func (_u *MonitoringAlertUpdate) SetIncidentsOpened(v int) *MonitoringAlertUpdate {
+	_u.mutation.ResetIncidentsOpened()
+	_u.mutation.SetIncidentsOpened(v)
+	return _u
+}
+
+// SetNillableIncidentsOpened sets the "incidents_opened" field if the given value is not nil.
+func (_u *MonitoringAlertUpdate) SetNillableIncidentsOpened(v *int) *MonitoringAlertUpdate {
+	if v != nil {
+		_u.SetIncidentsOpened(*v)
+	}
+	return _u
+}
+
+// AddIncidentsOpened adds value to the "incidents_opened" field.
+func (_u *MonitoringAlertUpdate) AddIncidentsOpened(v int) *MonitoringAlertUpdate {
+	_u.mutation.AddIncidentsOpened(v)
+	return _u
+}
+
+// SetCorrelatedCount sets the "correlated_count" field.
+func (_u *MonitoringAlertUpdate) SetCorrelatedCount(v int) *MonitoringAlertUpdate {
+	_u.mutation.ResetCorrelatedCount()
+	_u.mutation.SetCorrelatedCount(v)
+	return _u
+}
+
+// SetNillableCorrelatedCount sets the "correlated_count" field if the given value is not nil.
+func (_u *MonitoringAlertUpdate) SetNillableCorrelatedCount(v *int) *MonitoringAlertUpdate {
+	if v != nil {
+		_u.SetCorrelatedCount(*v)
+	}
+	return _u
+}
+
+// AddCorrelatedCount adds value to the "correlated_count" field.
+func (_u *MonitoringAlertUpdate) AddCorrelatedCount(v int) *MonitoringAlertUpdate {
+	_u.mutation.AddCorrelatedCount(v)
+	return _u
+}
+
+// SetSuppressedCount sets the "suppressed_count" field.
+func (_u *MonitoringAlertUpdate) SetSuppressedCount(v int) *MonitoringAlertUpdate {
+	_u.mutation.ResetSuppressedCount()
+	_u.mutation.SetSuppressedCount(v)
+	return _u
+}
+
+// SetNillableSuppressedCount sets the "suppressed_count" field if the given value is not nil.
+func (_u *MonitoringAlertUpdate) SetNillableSuppressedCount(v *int) *MonitoringAlertUpdate {
+	if v != nil {
+		_u.SetSuppressedCount(*v)
+	}
+	return _u
+}
+
+// AddSuppressedCount adds value to the "suppressed_count" field.
+func (_u *MonitoringAlertUpdate) AddSuppressedCount(v int) *MonitoringAlertUpdate {
+	_u.mutation.AddSuppressedCount(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *MonitoringAlertUpdate) SetUpdatedAt(v time.Time) *MonitoringAlertUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the MonitoringAlertMutation object of the builder.
+func (_u *MonitoringAlertUpdate) Mutation() *MonitoringAlertMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MonitoringAlertUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MonitoringAlertUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MonitoringAlertUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MonitoringAlertUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MonitoringAlertUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := monitoringalert.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MonitoringAlertUpdate) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := monitoringalert.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "MonitoringAlert.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Source(); ok {
+		if err := monitoringalert.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "MonitoringAlert.source": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Fingerprint(); ok {
+		if err := monitoringalert.FingerprintValidator(v); err != nil {
+			return &ValidationError{Name: "fingerprint", err: fmt.Errorf(`ent: validator failed for field "MonitoringAlert.fingerprint": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AlertName(); ok {
+		if err := monitoringalert.AlertNameValidator(v); err != nil {
+			return &ValidationError{Name: "alert_name", err: fmt.Errorf(`ent: validator failed for field "MonitoringAlert.alert_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := monitoringalert.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "MonitoringAlert.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ReceiveCount(); ok {
+		if err := monitoringalert.ReceiveCountValidator(v); err != nil {
+			return &ValidationError{Name: "receive_count", err: fmt.Errorf(`ent: validator failed for field "MonitoringAlert.receive_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CorrelationReason(); ok {
+		if err := monitoringalert.CorrelationReasonValidator(v); err != nil {
+			return &ValidationError{Name: "correlation_reason", err: fmt.Errorf(`ent: validator failed for field "MonitoringAlert.correlation_reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IncidentsOpened(); ok {
+		if err := monitoringalert.IncidentsOpenedValidator(v); err != nil {
+			return &ValidationError{Name: "incidents_opened", err: fmt.Errorf(`ent: validator failed for field "MonitoringAlert.incidents_opened": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CorrelatedCount(); ok {
+		if err := monitoringalert.CorrelatedCountValidator(v); err != nil {
+			return &ValidationError{Name: "correlated_count", err: fmt.Errorf(`ent: validator failed for field "MonitoringAlert.correlated_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SuppressedCount(); ok {
+		if err := monitoringalert.SuppressedCountValidator(v); err != nil {
+			return &ValidationError{Name: "suppressed_count", err: fmt.Errorf(`ent: validator failed for field "MonitoringAlert.suppressed_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *MonitoringAlertUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(monitoringalert.Table, monitoringalert.Columns, sqlgraph.NewFieldSpec(monitoringalert.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(monitoringalert.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(monitoringalert.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(monitoringalert.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Fingerprint(); ok {
+		_spec.SetField(monitoringalert.FieldFingerprint, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AlertName(); ok {
+		_spec.SetField(monitoringalert.FieldAlertName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(monitoringalert.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Severity(); ok {
+		_spec.SetField(monitoringalert.FieldSeverity, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Summary(); ok {
+		_spec.SetField(monitoringalert.FieldSummary, field.TypeString, value)
+	}
+	if _u.mutation.SummaryCleared() {
+		_spec.ClearField(monitoringalert.FieldSummary, field.TypeString)
+	}
+	if value, ok := _u.mutation.Labels(); ok {
+		_spec.SetField(monitoringalert.FieldLabels, field.TypeJSON, value)
+	}
+	if _u.mutation.LabelsCleared() {
+		_spec.ClearField(monitoringalert.FieldLabels, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Annotations(); ok {
+		_spec.SetField(monitoringalert.FieldAnnotations, field.TypeJSON, value)
+	}
+	if _u.mutation.AnnotationsCleared() {
+		_spec.ClearField(monitoringalert.FieldAnnotations, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.GeneratorURL(); ok {
+		_spec.SetField(monitoringalert.FieldGeneratorURL, field.TypeString, value)
+	}
+	if _u.mutation.GeneratorURLCleared() {
+		_spec.ClearField(monitoringalert.FieldGeneratorURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartsAt(); ok {
+		_spec.SetField(monitoringalert.FieldStartsAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndsAt(); ok {
+		_spec.SetField(monitoringalert.FieldEndsAt, field.TypeTime, value)
+	}
+	if _u.mutation.EndsAtCleared() {
+		_spec.ClearField(monitoringalert.FieldEndsAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastReceivedAt(); ok {
+		_spec.SetField(monitoringalert.FieldLastReceivedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ReceiveCount(); ok {
+		_spec.SetField(monitoringalert.FieldReceiveCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReceiveCount(); ok {
+		_spec.AddField(monitoringalert.FieldReceiveCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IncidentID(); ok {
+		_spec.SetField(monitoringalert.FieldIncidentID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedIncidentID(); ok {
+		_spec.AddField(monitoringalert.FieldIncidentID, field.TypeInt, value)
+	}
+	if _u.mutation.IncidentIDCleared() {
+		_spec.ClearField(monitoringalert.FieldIncidentID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.IncidentAlertID(); ok {
+		_spec.SetField(monitoringalert.FieldIncidentAlertID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedIncidentAlertID(); ok {
+		_spec.AddField(monitoringalert.FieldIncidentAlertID, field.TypeInt, value)
+	}
+	if _u.mutation.IncidentAlertIDCleared() {
+		_spec.ClearField(monitoringalert.FieldIncidentAlertID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ConfigurationItemID(); ok {
+		_spec.SetField(monitoringalert.FieldConfigurationItemID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConfigurationItemID(); ok {
+		_spec.AddField(monitoringalert.FieldConfigurationItemID, field.TypeInt, value)
+	}
+	if _u.mutation.ConfigurationItemIDCleared() {
+		_spec.ClearField(monitoringalert.FieldConfigurationItemID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CorrelationReason(); ok {
+		_spec.SetField(monitoringalert.FieldCorrelationReason, field.TypeEnum, value)
+	}
+	if _u.mutation.CorrelationReasonCleared() {
+		_spec.ClearField(monitoringalert.FieldCorrelationReason, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.RootAlertID(); ok {
+		_spec.SetField(monitoringalert.FieldRootAlertID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRootAlertID(); ok {
+		_spec.AddField(monitoringalert.FieldRootAlertID, field.TypeInt, value)
+	}
+	if _u.mutation.RootAlertIDCleared() {
+		_spec.ClearField(monitoringalert.FieldRootAlertID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.SuppressedByRuleID(); ok {
+		_spec.SetField(monitoringalert.FieldSuppressedByRuleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSuppressedByRuleID(); ok {
+		_spec.AddField(monitoringalert.FieldSuppressedByRuleID, field.TypeInt, value)
+	}
+	if _u.mutation.SuppressedByRuleIDCleared() {
+		_spec.ClearField(monitoringalert.FieldSuppressedByRuleID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.StateChanges(); ok {
+		_spec.SetField(monitoringalert.FieldStateChanges, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedStateChanges(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, monitoringalert.FieldStateChanges, value)
+		})
+	}
+	if _u.mutation.StateChangesCleared() {
+		_spec.ClearField(monitoringalert.FieldStateChanges, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Flapping(); ok {
+		_spec.SetField(monitoringalert.FieldFlapping, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.IncidentsOpened(); ok {
+		_spec.SetField(monitoringalert.FieldIncidentsOpened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedIncidentsOpened(); ok {
+		_spec.AddField(monitoringalert.FieldIncidentsOpened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CorrelatedCount(); ok {
+		_spec.SetField(monitoringalert.FieldCorrelatedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCorrelatedCount(); ok {
+		_spec.AddField(monitoringalert.FieldCorrelatedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SuppressedCount(); ok {
+		_spec.SetField(monitoringalert.FieldSuppressedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSuppressedCount(); ok {
+		_spec.AddField(monitoringalert.FieldSuppressedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(monitoringalert.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{monitoringalert.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MonitoringAlertUpdateOne is the builder for updating a single MonitoringAlert entity.
+type MonitoringAlertUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MonitoringAlertMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *MonitoringAlertUpdateOne) SetTenantID(v int) *MonitoringAlertUpdateOne {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *MonitoringAlertUpdateOne) SetNillableTenantID(v *int) *MonitoringAlertUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *MonitoringAlertUpdateOne) AddTenantID(v int) *MonitoringAlertUpdateOne {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *MonitoringAlertUpdateOne) SetSource(v string) *MonitoringAlertUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *MonitoringAlertUpdateOne) SetNillableSource(v *string) *MonitoringAlertUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (_u *MonitoringAlertUpdateOne) SetFingerprint(v string) *MonitoringAlertUpdateOne {
+	_u.mutation.SetFingerprint(v)
+	return _u
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (_u *MonitoringAlertUpdateOne) SetNillableFingerprint(v *string) *MonitoringAlertUpdateOne {
+	if v != nil {
+		_u.SetFingerprint(*v)
+	}
+	return _u
+}
+
+// SetAlertName sets the "alert_name" field.
+func (_u *MonitoringAlertUpdateOne) SetAlertName(v string) *MonitoringAlertUpdateOne {
+	_u.mutation.SetAlertName(v)
+	return _u
+}
+
+// SetNillableAlertName sets the "alert_name" field if the given value is not nil.
+func (_u *MonitoringAlertUpdateOne) SetNillableAlertName(v *string) *MonitoringAlertUpdateOne {
+	if v != nil {
+		_u.SetAlertName(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *MonitoringAlertUpdateOne) SetStatus(v monitoringalert.Status) *MonitoringAlertUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *MonitoringAlertUpdateOne) SetNillableStatus(v *monitoringalert.Status) *MonitoringAlertUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetSeverity sets the "severity" field.
+func (_u *MonitoringAlertUpdateOne) SetSeverity(v string) *MonitoringAlertUpdateOne {
+	_u.mutation.SetSeverity(v)
+	return _u
+}
+
+// SetNillableSeverity sets the "severity" field if the given value is not nil.
+func (_u *MonitoringAlertUpdateOne) SetNillableSeverity(v *string) *MonitoringAlertUpdateOne {
+	if v != nil {
+		_u.SetSeverity(*v)
+	}
+	return _u
+}
+
+// SetSummary sets the "summary" field.
+func (_u *MonitoringAlertUpdateOne) SetSummary(v string) *MonitoringAlertUpdateOne {
+	_u.mutation.SetSummary(v)
+	return _u
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (_u *MonitoringAlertUpdateOne) SetNillableSummary(v *string) *MonitoringAlertUpdateOne {
+	if v != nil {
+		_u.SetSummary(*v)
+	}
+	return _u
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (_u *MonitoringAlertUpdateOne) ClearSummary() *MonitoringAlertUpdateOne {
+	_u.mutation.ClearSummary()
+	return _u
+}
+
+// SetLabels sets the "labels" field.
+func (_u *MonitoringAlertUpdateOne) SetLabels(v map[string]string) *MonitoringAlertUpdateOne {
+	_u.mutation.SetLabels(v)
+	return _u
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (_u *MonitoringAlertUpdateOne) ClearLabels() *MonitoringAlertUpdateOne {
+	_u.mutation.ClearLabels()
+	return _u
+}
+
+// SetAnnotations sets the "annotations" field.
+func (_u *MonitoringAlertUpdateOne) SetAnnotations(v map[string]string) *MonitoringAlertUpdateOne {
+	_u.mutation.SetAnnotations(v)
+	return _u
+}
+
+// ClearAnnotations clears the value of the "annotations" field.
+func (_u *MonitoringAlertUpdateOne) ClearAnnotations() *MonitoringAlertUpdateOne {
+	_u.mutation.ClearAnnotations()
+	return _u
+}
+
+// SetGeneratorURL sets the "generator_url" field.
+func (_u *MonitoringAlertUpdateOne) SetGeneratorURL(v string) *MonitoringAlertUpdateOne {
+	_u.mutation.SetGeneratorURL(v)
+	return _u
+}
+
+// SetNillableGeneratorURL sets the "generator_url" field if the given value is not nil.
+func (_u *MonitoringAlertUpdateOne) SetNillableGeneratorURL(v *string) *MonitoringAlertUpdateOne {
+	if v != nil {
+		_u.SetGeneratorURL(*v)
+	}
+	return _u
+}
+
+// ClearGeneratorURL clears the value of the "generator_url" field.
+func (_u *MonitoringAlertUpdateOne) ClearGeneratorURL() *MonitoringAlertUpdateOne {
+	_u.mutation.ClearGeneratorURL()
+	return _u
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (_u *MonitoringAlertUpdateOne) SetStartsAt(v time.Time) *MonitoringAlertUpdateOne {
+	_u.mutation.SetStartsAt(v)
+	return _u
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (_u *MonitoringAlertUpdateOne) SetNillableStartsAt(v *time.Time) *MonitoringAlertUpdateOne {
+	if v != nil {
+		_u.SetStartsAt(*v)
+	}
+	return _u
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (_u *MonitoringAlertUpdateOne) SetEndsAt(v time.Time) *MonitoringAlertUpdateOne {
+	_u.mutation.SetEndsAt(v)
+	return _u
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (_u *MonitoringAlertUpdateOne) SetNillableEndsAt(v *time.Time) *MonitoringAlertUpdateOne {
+	if v != nil {
+		_u.SetEndsAt(*v)
+	}
+	return _u
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (_u *MonitoringAlertUpdateOne) ClearEndsAt() *MonitoringAlertUpdateOne {
+	_u.mutation.ClearEndsAt()
+	return _u
+}
+
+// SetLastReceivedAt sets the "last_received_at" field.
+func (_u *MonitoringAlertUpdateOne) SetLastReceivedAt(v time.Time) *MonitoringAlertUpdateOne {
+	_u.mutation.SetLastReceivedAt(v)
+	return _u
+}
+
+// SetNillableLastReceivedAt sets the "last_received_at" field if the given value is not nil.
+func (_u *MonitoringAlertUpdateOne) SetNillableLastReceivedAt(v *time.Time) *MonitoringAlertUpdateOne {
+	if v != nil {
+		_u.SetLastReceivedAt(*v)
+	}
+	return _u
+}
+
+// SetReceiveCount sets the "receive_count" field.
+func (_u *MonitoringAlertUpdateOne) SetReceiveCount(v int) *MonitoringAlertUpdateOne {
+	_u.mutation.ResetReceiveCount()
+	_u.mutation.SetReceiveCount(v)
+	return _u
+}
+
+// SetNillableReceiveCount sets the "receive_count" field if the given value is not nil.
+func (_u *MonitoringAlertUpdateOne) SetNillableReceiveCount(v *int) *MonitoringAlertUpdateOne {
+	if v != nil {
+		_u.SetReceiveCount(*v)
+	}
+	return _u
+}
+
+// AddReceiveCount adds value to the "receive_count" field.
+func (_u *MonitoringAlertUpdateOne) AddReceiveCount(v int) *MonitoringAlertUpdateOne {
+	_u.mutation.AddReceiveCount(v)
+	return _u
+}
+
+// SetIncidentID sets the "incident_id" field.
+func (_u *MonitoringAlertUpdateOne) SetIncidentID(v int) *MonitoringAlertUpdateOne {
+	_u.mutation.ResetIncidentID()
+	_u.mutation.SetIncidentID(v)
+	return _u
+}
+
+// SetNillableIncidentID sets the "incident_id" field if the given value is not nil.
+func (_u *MonitoringAlertUpdateOne) SetNillableIncidentID(v *int) *MonitoringAlertUpdateOne {
+	if v != nil {
+		_u.SetIncidentID(*v)
+	}
+	return _u
+}
+
+// AddIncidentID adds value to the "incident_id" field.
+func (_u *MonitoringAlertUpdateOne) AddIncidentID(v int) *MonitoringAlertUpdateOne {
+	_u.mutation.AddIncidentID(v)
+	return _u
+}
+
+// ClearIncidentID clears the value of the "incident_id" field.
+func (_u *MonitoringAlertUpdateOne) ClearIncidentID() *MonitoringAlertUpdateOne {
+	_u.mutation.ClearIncidentID()
+	return _u
+}
+
+// SetIncidentAlertID sets the "incident_alert_id" field.
+func (_u *MonitoringAlertUpdateOne) SetIncidentAlertID(v int) *MonitoringAlertUpdateOne {
+	_u.mutation.ResetIncidentAlertID()
+	_u.mutation.SetIncidentAlertID(v)
+	return _u
+}
+
+// SetNillableIncidentAlertID sets the "incident_alert_id" field if the given value is not nil.
+func (_u *MonitoringAlertUpdateOne) SetNillableIncidentAlertID(v *int) *MonitoringAlertUpdateOne {
+	if v != nil {
+		_u.SetIncidentAlertID(*v)
+	}
+	return _u
+}
+
+// AddIncidentAlertID adds value to the "incident_alert_id" field.
+func (_u *MonitoringAlertUpdateOne) AddIncidentAlertID(v int) *MonitoringAlertUpdateOne {
+	_u.mutation.AddIncidentAlertID(v)
+	return _u
+}
+
+// ClearIncidentAlertID clears the value of the "incident_alert_id" field.
+func (_u *MonitoringAlertUpdateOne) ClearIncidentAlertID() *MonitoringAlertUpdateOne {
+	_u.mutation.ClearIncidentAlertID()
+	return _u
+}
+
+// SetConfigurationItemID sets the "configuration_item_id" field.
+func (_u *MonitoringAlertUpdateOne) SetConfigurationItemID(v int) *MonitoringAlertUpdateOne {
+	_u.mutation.ResetConfigurationItemID()
+	_u.mutation.SetConfigurationItemID(v)
+	return _u
+}
+
+// SetNillableConfigurationItemID sets the "configuration_item_id" field if the given value is not nil.
+func (_u *MonitoringAlertUpdateOne) SetNillableConfigurationItemID(v *int) *MonitoringAlertUpdateOne {
+	if v != nil {
+		_u.SetConfigurationItemID(*v)
+	}
+	return _u
+}
+
+// AddConfigurationItemID adds value to the "configuration_item_id" field.
+func (_u *MonitoringAlertUpdateOne) AddConfigurationItemID(v int) *MonitoringAlertUpdateOne {
+	_u.mutation.AddConfigurationItemID(v)
+	return _u
+}
+
+// ClearConfigurationItemID clears the value of the "configuration_item_id" field.
+func (_u *MonitoringAlertUpdateOne) ClearConfigurationItemID() *MonitoringAlertUpdateOne {
+	_u.mutation.ClearConfigurationItemID()
+	return _u
+}
+
+// SetCorrelationReason sets the "correlation_reason" field.
+func (_u *MonitoringAlertUpdateOne) SetCorrelationReason(v monitoringalert.CorrelationReason) *MonitoringAlertUpdateOne {
+	_u.mutation.SetCorrelationReason(v)
+	return _u
+}
+
+// SetNillableCorrelationReason sets the "correlation_reason" field if the given value is not nil.
+func (_u *MonitoringAlertUpdateOne) SetNillableCorrelationReason(v *monitoringalert.CorrelationReason) *MonitoringAlertUpdateOne {
+	if v != nil {
+		_u.SetCorrelationReason(*v)
+	}
+	return _u
+}
+
+// ClearCorrelationReason clears the value of the "correlation_reason" field.
+func (_u *MonitoringAlertUpdateOne) ClearCorrelationReason() *MonitoringAlertUpdateOne {
+	_u.mutation.ClearCorrelationReason()
+	return _u
+}
+
+// SetRootAlertID sets the "root_alert_id" field.
+func (_u *MonitoringAlertUpdateOne) SetRootAlertID(v int) *MonitoringAlertUpdateOne {
+	_u.mutation.ResetRootAlertID()
+	_u.mutation.SetRootAlertID(v)
+	return _u
+}
+
+// SetNillableRootAlertID sets the "root_alert_id" field if the given value is not nil.
+func (_u *MonitoringAlertUpdateOne) SetNillableRootAlertID(v *int) *MonitoringAlertUpdateOne {
+	if v != nil {
+		_u.SetRootAlertID(*v)
+	}
+	return _u
+}
+
+// AddRootAlertID adds value to the "root_alert_id" field.
+func (_u *MonitoringAlertUpdateOne) AddRootAlertID(v int) *MonitoringAlertUpdateOne {
+	_u.mutation.AddRootAlertID(v)
+	return _u
+}
+
+// ClearRootAlertID clears the value of the "root_alert_id" field.
+func (_u *MonitoringAlertUpdateOne) ClearRootAlertID() *MonitoringAlertUpdateOne {
+	_u.mutation.ClearRootAlertID()
+	return _u
+}
+
+// SetSuppressedByRuleID sets the "suppressed_by_rule_id" field.
+func (_u *MonitoringAlertUpdateOne) SetSuppressedByRuleID(v int) *MonitoringAlertUpdateOne {
+	_u.mutation.ResetSuppressedByRuleID()
+	_u.mutation.SetSuppressedByRuleID(v)
+	return _u
+}
+
+// SetNillableSuppressedByRuleID sets the "suppressed_by_rule_id" field if the given value is not nil.
+func (_u *MonitoringAlertUpdateOne) SetNillableSuppressedByRuleID(v *int) *MonitoringAlertUpdateOne {
+	if v != nil {
+		_u.SetSuppressedByRuleID(*v)
+	}
+	return _u
+}
+
+// AddSuppressedByRuleID adds value to the "suppressed_by_rule_id" field.
+func (_u *MonitoringAlertUpdateOne) AddSuppressedByRuleID(v int) *MonitoringAlertUpdateOne {
+	_u.mutation.AddSuppressedByRuleID(v)
+	return _u
+}
+
+// ClearSuppressedByRuleID clears the value of the "suppressed_by_rule_id" field.
+func (_u *MonitoringAlertUpdateOne) ClearSuppressedByRuleID() *MonitoringAlertUpdateOne {
+	_u.mutation.ClearSuppressedByRuleID()
+	return _u
+}
+
+// SetStateChanges sets the "state_changes" field.
+func (_u *MonitoringAlertUpdateOne) SetStateChanges(v []time.Time) *MonitoringAlertUpdateOne {
+	_u.mutation.SetStateChanges(v)
+	return _u
+}
+
+// AppendStateChanges appends value to the "state_changes" field.
+func (_u *MonitoringAlertUpdateOne) AppendStateChanges(v []time.Time) *MonitoringAlertUpdateOne {
+	_u.mutation.AppendStateChanges(v)
+	return _u
+}
+
+// ClearStateChanges clears the value of the "state_changes" field.
+func (_u *MonitoringAlertUpdateOne) ClearStateChanges() *MonitoringAlertUpdateOne {
+	_u.mutation.ClearStateChanges()
+	return _u
+}
+
+// SetFlapping sets the "flapping" field.
+func (_u *MonitoringAlertUpdateOne) SetFlapping(v bool) *MonitoringAlertUpdateOne {
+	_u.mutation.SetFlapping(v)
+	return _u
+}
+
+// SetNillableFlapping sets the "flapping" field if the given value is not nil.
+func (_u *MonitoringAlertUpdateOne) SetNillableFlapping(v *bool) *MonitoringAlertUpdateOne {
+	if v != nil {
+		_u.SetFlapping(*v)
+	}
+	return _u
+}
+
+// SetIncidentsOpened sets the "incidents_opened" field.
+func (_u *MonitoringAlertUpdateOne) SetIncidentsOpened(v int) *MonitoringAlertUpdateOne {
+	_u.mutation.ResetIncidentsOpened()
+	_u.mutation.SetIncidentsOpened(v)
+	return _u
+}
+
+// SetNillableIncidentsOpened sets the "incidents_opened" field if the given value is not nil.
+func (_u *MonitoringAlertUpdateOne) SetNillableIncidentsOpened(v *int) *MonitoringAlertUpdateOne {
+	if v != nil {
+		_u.SetIncidentsOpened(*v)
+	}
+	return _u
+}
+
+// AddIncidentsOpened adds value to the "incidents_opened" field.
+func (_u *MonitoringAlertUpdateOne) AddIncidentsOpened(v int) *MonitoringAlertUpdateOne {
+	_u.mutation.AddIncidentsOpened(v)
+	return _u
+}
+
+// SetCorrelatedCount sets the "correlated_count" field.
+func (_u *MonitoringAlertUpdateOne) SetCorrelatedCount(v int) *MonitoringAlertUpdateOne {
+	_u.mutation.ResetCorrelatedCount()
+	_u.mutation.SetCorrelatedCount(v)
+	return _u
+}
+
+// SetNillableCorrelatedCount sets the "correlated_count" field if the given value is not nil.
+func (_u *MonitoringAlertUpdateOne) SetNillableCorrelatedCount(v *int) *MonitoringAlertUpdateOne {
+	if v != nil {
+		_u.SetCorrelatedCount(*v)
+	}
+	return _u
+}
+
+// AddCorrelatedCount adds value to the "correlated_count" field.
+func (_u *MonitoringAlertUpdateOne) AddCorrelatedCount(v int) *MonitoringAlertUpdateOne {
+	_u.mutation.AddCorrelatedCount(v)
+	return _u
+}
+
+// SetSuppressedCount sets the "suppressed_count" field.
+func (_u *MonitoringAlertUpdateOne) SetSuppressedCount(v int) *MonitoringAlertUpdateOne {
+	_u.mutation.ResetSuppressedCount()
+	_u.mutation.SetSuppressedCount(v)
+	return _u
+}
+
+// SetNillableSuppressedCount sets the "suppressed_count" field if the given value is not nil.
+func (_u *MonitoringAlertUpdateOne) SetNillableSuppressedCount(v *int) *MonitoringAlertUpdateOne {
+	if v != nil {
+		_u.SetSuppressedCount(*v)
+	}
+	return _u
+}
+
+// AddSuppressedCount adds value to the "suppressed_count" field.
+func (_u *MonitoringAlertUpdateOne) AddSuppressedCount(v int) *MonitoringAlertUpdateOne {
+	_u.mutation.AddSuppressedCount(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *MonitoringAlertUpdateOne) SetUpdatedAt(v time.Time) *MonitoringAlertUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the MonitoringAlertMutation object of the builder.
+func (_u *MonitoringAlertUpdateOne) Mutation() *MonitoringAlertMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the MonitoringAlertUpdate builder.
+func (_u *MonitoringAlertUpdateOne) Where(ps ...predicate.MonitoringAlert) *MonitoringAlertUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MonitoringAlertUpdateOne) Select(field string, fields ...string) *MonitoringAlertUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated MonitoringAlert entity.
+func (_u *MonitoringAlertUpdateOne) Save(ctx context.Context) (*MonitoringAlert, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MonitoringAlertUpdateOne) SaveX(ctx context.Context) *MonitoringAlert {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MonitoringAlertUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MonitoringAlertUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MonitoringAlertUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := monitoringalert.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MonitoringAlertUpdateOne) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := monitoringalert.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "MonitoringAlert.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Source(); ok {
+		if err := monitoringalert.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "MonitoringAlert.source": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Fingerprint(); ok {
+		if err := monitoringalert.FingerprintValidator(v); err != nil {
+			return &ValidationError{Name: "fingerprint", err: fmt.Errorf(`ent: validator failed for field "MonitoringAlert.fingerprint": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AlertName(); ok {
+		if err := monitoringalert.AlertNameValidator(v); err != nil {
+			return &ValidationError{Name: "alert_name", err: fmt.Errorf(`ent: validator failed for field "MonitoringAlert.alert_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := monitoringalert.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "MonitoringAlert.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ReceiveCount(); ok {
+		if err := monitoringalert.ReceiveCountValidator(v); err != nil {
+			return &ValidationError{Name: "receive_count", err: fmt.Errorf(`ent: validator failed for field "MonitoringAlert.receive_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CorrelationReason(); ok {
+		if err := monitoringalert.CorrelationReasonValidator(v); err != nil {
+			return &ValidationError{Name: "correlation_reason", err: fmt.Errorf(`ent: validator failed for field "MonitoringAlert.correlation_reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IncidentsOpened(); ok {
+		if err := monitoringalert.IncidentsOpenedValidator(v); err != nil {
+			return &ValidationError{Name: "incidents_opened", err: fmt.Errorf(`ent: validator failed for field "MonitoringAlert.incidents_opened": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CorrelatedCount(); ok {
+		if err := monitoringalert.CorrelatedCountValidator(v); err != nil {
+			return &ValidationError{Name: "correlated_count", err: fmt.Errorf(`ent: validator failed for field "MonitoringAlert.correlated_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SuppressedCount(); ok {
+		if err := monitoringalert.SuppressedCountValidator(v); err != nil {
+			return &ValidationError{Name: "suppressed_count", err: fmt.Errorf(`ent: validator failed for field "MonitoringAlert.suppressed_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *MonitoringAlertUpdateOne) sqlSave(ctx context.Context) (_node *MonitoringAlert, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(monitoringalert.Table, monitoringalert.Columns, sqlgraph.NewFieldSpec(monitoringalert.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MonitoringAlert.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, monitoringalert.FieldID)
+		for _, f := range fields {
+			if !monitoringalert.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != monitoringalert.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(monitoringalert.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(monitoringalert.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(monitoringalert.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Fingerprint(); ok {
+		_spec.SetField(monitoringalert.FieldFingerprint, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AlertName(); ok {
+		_spec.SetField(monitoringalert.FieldAlertName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(monitoringalert.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Severity(); ok {
+		_spec.SetField(monitoringalert.FieldSeverity, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Summary(); ok {
+		_spec.SetField(monitoringalert.FieldSummary, field.TypeString, value)
+	}
+	if _u.mutation.SummaryCleared() {
+		_spec.ClearField(monitoringalert.FieldSummary, field.TypeString)
+	}
+	if value, ok := _u.mutation.Labels(); ok {
+		_spec.SetField(monitoringalert.FieldLabels, field.TypeJSON, value)
+	}
+	if _u.mutation.LabelsCleared() {
+		_spec.ClearField(monitoringalert.FieldLabels, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Annotations(); ok {
+		_spec.SetField(monitoringalert.FieldAnnotations, field.TypeJSON, value)
+	}
+	if _u.mutation.AnnotationsCleared() {
+		_spec.ClearField(monitoringalert.FieldAnnotations, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.GeneratorURL(); ok {
+		_spec.SetField(monitoringalert.FieldGeneratorURL, field.TypeString, value)
+	}
+	if _u.mutation.GeneratorURLCleared() {
+		_spec.ClearField(monitoringalert.FieldGeneratorURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartsAt(); ok {
+		_spec.SetField(monitoringalert.FieldStartsAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndsAt(); ok {
+		_spec.SetField(monitoringalert.FieldEndsAt, field.TypeTime, value)
+	}
+	if _u.mutation.EndsAtCleared() {
+		_spec.ClearField(monitoringalert.FieldEndsAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastReceivedAt(); ok {
+		_spec.SetField(monitoringalert.FieldLastReceivedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ReceiveCount(); ok {
+		_spec.SetField(monitoringalert.FieldReceiveCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReceiveCount(); ok {
+		_spec.AddField(monitoringalert.FieldReceiveCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IncidentID(); ok {
+		_spec.SetField(monitoringalert.FieldIncidentID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedIncidentID(); ok {
+		_spec.AddField(monitoringalert.FieldIncidentID, field.TypeInt, value)
+	}
+	if _u.mutation.IncidentIDCleared() {
+		_spec.ClearField(monitoringalert.FieldIncidentID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.IncidentAlertID(); ok {
+		_spec.SetField(monitoringalert.FieldIncidentAlertID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedIncidentAlertID(); ok {
+		_spec.AddField(monitoringalert.FieldIncidentAlertID, field.TypeInt, value)
+	}
+	if _u.mutation.IncidentAlertIDCleared() {
+		_spec.ClearField(monitoringalert.FieldIncidentAlertID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ConfigurationItemID(); ok {
+		_spec.SetField(monitoringalert.FieldConfigurationItemID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedConfigurationItemID(); ok {
+		_spec.AddField(monitoringalert.FieldConfigurationItemID, field.TypeInt, value)
+	}
+	if _u.mutation.ConfigurationItemIDCleared() {
+		_spec.ClearField(monitoringalert.FieldConfigurationItemID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CorrelationReason(); ok {
+		_spec.SetField(monitoringalert.FieldCorrelationReason, field.TypeEnum, value)
+	}
+	if _u.mutation.CorrelationReasonCleared() {
+		_spec.ClearField(monitoringalert.FieldCorrelationReason, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.RootAlertID(); ok {
+		_spec.SetField(monitoringalert.FieldRootAlertID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRootAlertID(); ok {
+		_spec.AddField(monitoringalert.FieldRootAlertID, field.TypeInt, value)
+	}
+	if _u.mutation.RootAlertIDCleared() {
+		_spec.ClearField(monitoringalert.FieldRootAlertID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.SuppressedByRuleID(); ok {
+		_spec.SetField(monitoringalert.FieldSuppressedByRuleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSuppressedByRuleID(); ok {
+		_spec.AddField(monitoringalert.FieldSuppressedByRuleID, field.TypeInt, value)
+	}
+	if _u.mutation.SuppressedByRuleIDCleared() {
+		_spec.ClearField(monitoringalert.FieldSuppressedByRuleID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.StateChanges(); ok {
+		_spec.SetField(monitoringalert.FieldStateChanges, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedStateChanges(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, monitoringalert.FieldStateChanges, value)
+		})
+	}
+	if _u.mutation.StateChangesCleared() {
+		_spec.ClearField(monitoringalert.FieldStateChanges, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Flapping(); ok {
+		_spec.SetField(monitoringalert.FieldFlapping, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.IncidentsOpened(); ok {
+		_spec.SetField(monitoringalert.FieldIncidentsOpened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedIncidentsOpened(); ok {
+		_spec.AddField(monitoringalert.FieldIncidentsOpened, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CorrelatedCount(); ok {
+		_spec.SetField(monitoringalert.FieldCorrelatedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCorrelatedCount(); ok {
+		_spec.AddField(monitoringalert.FieldCorrelatedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SuppressedCount(); ok {
+		_spec.SetField(monitoringalert.FieldSuppressedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSuppressedCount(); ok {
+		_spec.AddField(monitoringalert.FieldSuppressedCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(monitoringalert.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &MonitoringAlert{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{monitoringalert.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
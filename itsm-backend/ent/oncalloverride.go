@@ -0,0 +1,208 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"itsm-backend/ent/oncalloverride"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// OnCallOverride is the model entity for the OnCallOverride schema.
+type OnCallOverride struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 租户ID
+	TenantID int `json:"tenant_id,omitempty"`
+	// 值班表ID
+	ScheduleID int `json:"schedule_id,omitempty"`
+	// 替换后的值班人ID
+	UserID int `json:"user_id,omitempty"`
+	// 被替换的值班人ID，为空表示覆盖该时段的任何人
+	OriginalUserID *int `json:"original_user_id,omitempty"`
+	// 开始时间
+	StartAt time.Time `json:"start_at,omitempty"`
+	// 结束时间
+	EndAt time.Time `json:"end_at,omitempty"`
+	// 替换原因
+	Reason string `json:"reason,omitempty"`
+	// 换班时成对创建的两条替换共用的标识，删除其一时一并删除
+	SwapGroup string `json:"swap_group,omitempty"`
+	// 创建人ID
+	CreatedBy int `json:"created_by,omitempty"`
+	// 创建时间
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OnCallOverride) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case oncalloverride.FieldID, oncalloverride.FieldTenantID, oncalloverride.FieldScheduleID, oncalloverride.FieldUserID, oncalloverride.FieldOriginalUserID, oncalloverride.FieldCreatedBy:
+			values[i] = new(sql.NullInt64)
+		case oncalloverride.FieldReason, oncalloverride.FieldSwapGroup:
+			values[i] = new(sql.NullString)
+		case oncalloverride.FieldStartAt, oncalloverride.FieldEndAt, oncalloverride.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OnCallOverride fields.
+func (_m *OnCallOverride) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case oncalloverride.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case oncalloverride.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = int(value.Int64)
+			}
+		case oncalloverride.FieldScheduleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field schedule_id", values[i])
+			} else if value.Valid {
+				_m.ScheduleID = int(value.Int64)
+			}
+		case oncalloverride.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case oncalloverride.FieldOriginalUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field original_user_id", values[i])
+			} else if value.Valid {
+				_m.OriginalUserID = new(int)
+				*_m.OriginalUserID = int(value.Int64)
+			}
+		case oncalloverride.FieldStartAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_at", values[i])
+			} else if value.Valid {
+				_m.StartAt = value.Time
+			}
+		case oncalloverride.FieldEndAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_at", values[i])
+			} else if value.Valid {
+				_m.EndAt = value.Time
+			}
+		case oncalloverride.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case oncalloverride.FieldSwapGroup:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field swap_group", values[i])
+			} else if value.Valid {
+				_m.SwapGroup = value.String
+			}
+		case oncalloverride.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = int(value.Int64)
+			}
+		case oncalloverride.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OnCallOverride.
+// This includes values selected through modifiers, order, etc.
+func (_m *OnCallOverride) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this OnCallOverride.
+// Note that you need to call OnCallOverride.Unwrap() before calling this method if this OnCallOverride
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *OnCallOverride) Update() *OnCallOverrideUpdateOne {
+	return NewOnCallOverrideClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the OnCallOverride entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *OnCallOverride) Unwrap() *OnCallOverride {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OnCallOverride is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *OnCallOverride) String() string {
+	var builder strings.Builder
+	builder.WriteString("OnCallOverride(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("schedule_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ScheduleID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	if v := _m.OriginalUserID; v != nil {
+		builder.WriteString("original_user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("start_at=")
+	builder.WriteString(_m.StartAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("end_at=")
+	builder.WriteString(_m.EndAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("swap_group=")
+	builder.WriteString(_m.SwapGroup)
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedBy))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OnCallOverrides is a parsable slice of OnCallOverride.
+type OnCallOverrides []*OnCallOverride
@@ -0,0 +1,610 @@
+// Code generated by ent, DO NOT EDIT.
+
+package oncalloverride
+
+import (
+	"itsm-backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldEQ(FieldTenantID, v))
+}
+
+// ScheduleID applies equality check predicate on the "schedule_id" field. It's identical to ScheduleIDEQ.
+func ScheduleID(v int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldEQ(FieldScheduleID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldEQ(FieldUserID, v))
+}
+
+// OriginalUserID applies equality check predicate on the "original_user_id" field. It's identical to OriginalUserIDEQ.
+func OriginalUserID(v int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldEQ(FieldOriginalUserID, v))
+}
+
+// StartAt applies equality check predicate on the "start_at" field. It's identical to StartAtEQ.
+func StartAt(v time.Time) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldEQ(FieldStartAt, v))
+}
+
+// EndAt applies equality check predicate on the "end_at" field. It's identical to EndAtEQ.
+func EndAt(v time.Time) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldEQ(FieldEndAt, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldEQ(FieldReason, v))
+}
+
+// SwapGroup applies equality check predicate on the "swap_group" field. It's identical to SwapGroupEQ.
+func SwapGroup(v string) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldEQ(FieldSwapGroup, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldLTE(FieldTenantID, v))
+}
+
+// ScheduleIDEQ applies the EQ predicate on the "schedule_id" field.
+func ScheduleIDEQ(v int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldEQ(FieldScheduleID, v))
+}
+
+// ScheduleIDNEQ applies the NEQ predicate on the "schedule_id" field.
+func ScheduleIDNEQ(v int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldNEQ(FieldScheduleID, v))
+}
+
+// ScheduleIDIn applies the In predicate on the "schedule_id" field.
+func ScheduleIDIn(vs ...int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldIn(FieldScheduleID, vs...))
+}
+
+// ScheduleIDNotIn applies the NotIn predicate on the "schedule_id" field.
+func ScheduleIDNotIn(vs ...int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldNotIn(FieldScheduleID, vs...))
+}
+
+// ScheduleIDGT applies the GT predicate on the "schedule_id" field.
+func ScheduleIDGT(v int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldGT(FieldScheduleID, v))
+}
+
+// ScheduleIDGTE applies the GTE predicate on the "schedule_id" field.
+func ScheduleIDGTE(v int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldGTE(FieldScheduleID, v))
+}
+
+// ScheduleIDLT applies the LT predicate on the "schedule_id" field.
+func ScheduleIDLT(v int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldLT(FieldScheduleID, v))
+}
+
+// ScheduleIDLTE applies the LTE predicate on the "schedule_id" field.
+func ScheduleIDLTE(v int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldLTE(FieldScheduleID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldLTE(FieldUserID, v))
+}
+
+// OriginalUserIDEQ applies the EQ predicate on the "original_user_id" field.
+func OriginalUserIDEQ(v int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldEQ(FieldOriginalUserID, v))
+}
+
+// OriginalUserIDNEQ applies the NEQ predicate on the "original_user_id" field.
+func OriginalUserIDNEQ(v int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldNEQ(FieldOriginalUserID, v))
+}
+
+// OriginalUserIDIn applies the In predicate on the "original_user_id" field.
+func OriginalUserIDIn(vs ...int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldIn(FieldOriginalUserID, vs...))
+}
+
+// OriginalUserIDNotIn applies the NotIn predicate on the "original_user_id" field.
+func OriginalUserIDNotIn(vs ...int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldNotIn(FieldOriginalUserID, vs...))
+}
+
+// OriginalUserIDGT applies the GT predicate on the "original_user_id" field.
+func OriginalUserIDGT(v int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldGT(FieldOriginalUserID, v))
+}
+
+// OriginalUserIDGTE applies the GTE predicate on the "original_user_id" field.
+func OriginalUserIDGTE(v int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldGTE(FieldOriginalUserID, v))
+}
+
+// OriginalUserIDLT applies the LT predicate on the "original_user_id" field.
+func OriginalUserIDLT(v int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldLT(FieldOriginalUserID, v))
+}
+
+// OriginalUserIDLTE applies the LTE predicate on the "original_user_id" field.
+func OriginalUserIDLTE(v int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldLTE(FieldOriginalUserID, v))
+}
+
+// OriginalUserIDIsNil applies the IsNil predicate on the "original_user_id" field.
+func OriginalUserIDIsNil() predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldIsNull(FieldOriginalUserID))
+}
+
+// OriginalUserIDNotNil applies the NotNil predicate on the "original_user_id" field.
+func OriginalUserIDNotNil() predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldNotNull(FieldOriginalUserID))
+}
+
+// StartAtEQ applies the EQ predicate on the "start_at" field.
+func StartAtEQ(v time.Time) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldEQ(FieldStartAt, v))
+}
+
+// StartAtNEQ applies the NEQ predicate on the "start_at" field.
+func StartAtNEQ(v time.Time) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldNEQ(FieldStartAt, v))
+}
+
+// StartAtIn applies the In predicate on the "start_at" field.
+func StartAtIn(vs ...time.Time) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldIn(FieldStartAt, vs...))
+}
+
+// StartAtNotIn applies the NotIn predicate on the "start_at" field.
+func StartAtNotIn(vs ...time.Time) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldNotIn(FieldStartAt, vs...))
+}
+
+// StartAtGT applies the GT predicate on the "start_at" field.
+func StartAtGT(v time.Time) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldGT(FieldStartAt, v))
+}
+
+// StartAtGTE applies the GTE predicate on the "start_at" field.
+func StartAtGTE(v time.Time) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldGTE(FieldStartAt, v))
+}
+
+// StartAtLT applies the LT predicate on the "start_at" field.
+func StartAtLT(v time.Time) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldLT(FieldStartAt, v))
+}
+
+// StartAtLTE applies the LTE predicate on the "start_at" field.
+func StartAtLTE(v time.Time) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldLTE(FieldStartAt, v))
+}
+
+// EndAtEQ applies the EQ predicate on the "end_at" field.
+func EndAtEQ(v time.Time) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldEQ(FieldEndAt, v))
+}
+
+// EndAtNEQ applies the NEQ predicate on the "end_at" field.
+func EndAtNEQ(v time.Time) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldNEQ(FieldEndAt, v))
+}
+
+// EndAtIn applies the In predicate on the "end_at" field.
+func EndAtIn(vs ...time.Time) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldIn(FieldEndAt, vs...))
+}
+
+// EndAtNotIn applies the NotIn predicate on the "end_at" field.
+func EndAtNotIn(vs ...time.Time) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldNotIn(FieldEndAt, vs...))
+}
+
+// EndAtGT applies the GT predicate on the "end_at" field.
+func EndAtGT(v time.Time) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldGT(FieldEndAt, v))
+}
+
+// EndAtGTE applies the GTE predicate on the "end_at" field.
+func EndAtGTE(v time.Time) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldGTE(FieldEndAt, v))
+}
+
+// EndAtLT applies the LT predicate on the "end_at" field.
+func EndAtLT(v time.Time) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldLT(FieldEndAt, v))
+}
+
+// EndAtLTE applies the LTE predicate on the "end_at" field.
+func EndAtLTE(v time.Time) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldLTE(FieldEndAt, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldContainsFold(FieldReason, v))
+}
+
+// SwapGroupEQ applies the EQ predicate on the "swap_group" field.
+func SwapGroupEQ(v string) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldEQ(FieldSwapGroup, v))
+}
+
+// SwapGroupNEQ applies the NEQ predicate on the "swap_group" field.
+func SwapGroupNEQ(v string) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldNEQ(FieldSwapGroup, v))
+}
+
+// SwapGroupIn applies the In predicate on the "swap_group" field.
+func SwapGroupIn(vs ...string) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldIn(FieldSwapGroup, vs...))
+}
+
+// SwapGroupNotIn applies the NotIn predicate on the "swap_group" field.
+func SwapGroupNotIn(vs ...string) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldNotIn(FieldSwapGroup, vs...))
+}
+
+// SwapGroupGT applies the GT predicate on the "swap_group" field.
+func SwapGroupGT(v string) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldGT(FieldSwapGroup, v))
+}
+
+// SwapGroupGTE applies the GTE predicate on the "swap_group" field.
+func SwapGroupGTE(v string) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldGTE(FieldSwapGroup, v))
+}
+
+// SwapGroupLT applies the LT predicate on the "swap_group" field.
+func SwapGroupLT(v string) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldLT(FieldSwapGroup, v))
+}
+
+// SwapGroupLTE applies the LTE predicate on the "swap_group" field.
+func SwapGroupLTE(v string) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldLTE(FieldSwapGroup, v))
+}
+
+// SwapGroupContains applies the Contains predicate on the "swap_group" field.
+func SwapGroupContains(v string) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldContains(FieldSwapGroup, v))
+}
+
+// SwapGroupHasPrefix applies the HasPrefix predicate on the "swap_group" field.
+func SwapGroupHasPrefix(v string) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldHasPrefix(FieldSwapGroup, v))
+}
+
+// SwapGroupHasSuffix applies the HasSuffix predicate on the "swap_group" field.
+func SwapGroupHasSuffix(v string) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldHasSuffix(FieldSwapGroup, v))
+}
+
+// SwapGroupIsNil applies the IsNil predicate on the "swap_group" field.
+func SwapGroupIsNil() predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldIsNull(FieldSwapGroup))
+}
+
+// SwapGroupNotNil applies the NotNil predicate on the "swap_group" field.
+func SwapGroupNotNil() predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldNotNull(FieldSwapGroup))
+}
+
+// SwapGroupEqualFold applies the EqualFold predicate on the "swap_group" field.
+func SwapGroupEqualFold(v string) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldEqualFold(FieldSwapGroup, v))
+}
+
+// SwapGroupContainsFold applies the ContainsFold predicate on the "swap_group" field.
+func SwapGroupContainsFold(v string) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldContainsFold(FieldSwapGroup, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v int) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OnCallOverride) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OnCallOverride) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OnCallOverride) predicate.OnCallOverride {
+	return predicate.OnCallOverride(sql.NotPredicates(p))
+}
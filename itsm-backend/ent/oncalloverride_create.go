@@ -0,0 +1,359 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/oncalloverride"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OnCallOverrideCreate is the builder for creating a OnCallOverride entity.
+type OnCallOverrideCreate struct {
+	config
+	mutation *OnCallOverrideMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *OnCallOverrideCreate) SetTenantID(v int) *OnCallOverrideCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (_c *OnCallOverrideCreate) SetScheduleID(v int) *OnCallOverrideCreate {
+	_c.mutation.SetScheduleID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *OnCallOverrideCreate) SetUserID(v int) *OnCallOverrideCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetOriginalUserID sets the "original_user_id" field.
+func (_c *OnCallOverrideCreate) SetOriginalUserID(v int) *OnCallOverrideCreate {
+	_c.mutation.SetOriginalUserID(v)
+	return _c
+}
+
+// SetNillableOriginalUserID sets the "original_user_id" field if the given value is not nil.
+func (_c *OnCallOverrideCreate) SetNillableOriginalUserID(v *int) *OnCallOverrideCreate {
+	if v != nil {
+		_c.SetOriginalUserID(*v)
+	}
+	return _c
+}
+
+// SetStartAt sets the "start_at" field.
+func (_c *OnCallOverrideCreate) SetStartAt(v time.Time) *OnCallOverrideCreate {
+	_c.mutation.SetStartAt(v)
+	return _c
+}
+
+// SetEndAt sets the "end_at" field.
+func (_c *OnCallOverrideCreate) SetEndAt(v time.Time) *OnCallOverrideCreate {
+	_c.mutation.SetEndAt(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *OnCallOverrideCreate) SetReason(v string) *OnCallOverrideCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *OnCallOverrideCreate) SetNillableReason(v *string) *OnCallOverrideCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetSwapGroup sets the "swap_group" field.
+func (_c *OnCallOverrideCreate) SetSwapGroup(v string) *OnCallOverrideCreate {
+	_c.mutation.SetSwapGroup(v)
+	return _c
+}
+
+// SetNillableSwapGroup sets the "swap_group" field if the given value is not nil.
+func (_c *OnCallOverrideCreate) SetNillableSwapGroup(v *string) *OnCallOverrideCreate {
+	if v != nil {
+		_c.SetSwapGroup(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *OnCallOverrideCreate) SetCreatedBy(v int) *OnCallOverrideCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *OnCallOverrideCreate) SetNillableCreatedBy(v *int) *OnCallOverrideCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *OnCallOverrideCreate) SetCreatedAt(v time.Time) *OnCallOverrideCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *OnCallOverrideCreate) SetNillableCreatedAt(v *time.Time) *OnCallOverrideCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the OnCallOverrideMutation object of the builder.
+func (_c *OnCallOverrideCreate) Mutation() *OnCallOverrideMutation {
+	return _c.mutation
+}
+
+// Save creates the OnCallOverride in the database.
+func (_c *OnCallOverrideCreate) Save(ctx context.Context) (*OnCallOverride, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OnCallOverrideCreate) SaveX(ctx context.Context) *OnCallOverride {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OnCallOverrideCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OnCallOverrideCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OnCallOverrideCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := oncalloverride.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OnCallOverrideCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "OnCallOverride.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := oncalloverride.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "OnCallOverride.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ScheduleID(); !ok {
+		return &ValidationError{Name: "schedule_id", err: errors.New(`ent: missing required field "OnCallOverride.schedule_id"`)}
+	}
+	if v, ok := _c.mutation.ScheduleID(); ok {
+		if err := oncalloverride.ScheduleIDValidator(v); err != nil {
+			return &ValidationError{Name: "schedule_id", err: fmt.Errorf(`ent: validator failed for field "OnCallOverride.schedule_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "OnCallOverride.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := oncalloverride.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "OnCallOverride.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StartAt(); !ok {
+		return &ValidationError{Name: "start_at", err: errors.New(`ent: missing required field "OnCallOverride.start_at"`)}
+	}
+	if _, ok := _c.mutation.EndAt(); !ok {
+		return &ValidationError{Name: "end_at", err: errors.New(`ent: missing required field "OnCallOverride.end_at"`)}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := oncalloverride.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "OnCallOverride.reason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OnCallOverride.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *OnCallOverrideCreate) sqlSave(ctx context.Context) (*OnCallOverride, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OnCallOverrideCreate) createSpec() (*OnCallOverride, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OnCallOverride{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(oncalloverride.Table, sqlgraph.NewFieldSpec(oncalloverride.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(oncalloverride.FieldTenantID, field.TypeInt, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.ScheduleID(); ok {
+		_spec.SetField(oncalloverride.FieldScheduleID, field.TypeInt, value)
+		_node.ScheduleID = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(oncalloverride.FieldUserID, field.TypeInt, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.OriginalUserID(); ok {
+		_spec.SetField(oncalloverride.FieldOriginalUserID, field.TypeInt, value)
+		_node.OriginalUserID = &value
+	}
+	if value, ok := _c.mutation.StartAt(); ok {
+		_spec.SetField(oncalloverride.FieldStartAt, field.TypeTime, value)
+		_node.StartAt = value
+	}
+	if value, ok := _c.mutation.EndAt(); ok {
+		_spec.SetField(oncalloverride.FieldEndAt, field.TypeTime, value)
+		_node.EndAt = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(oncalloverride.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.SwapGroup(); ok {
+		_spec.SetField(oncalloverride.FieldSwapGroup, field.TypeString, value)
+		_node.SwapGroup = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(oncalloverride.FieldCreatedBy, field.TypeInt, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(oncalloverride.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnCallOverrideCreateBulk is the builder for creating many OnCallOverride entities in bulk.
+type OnCallOverrideCreateBulk struct {
+	config
+	err      error
+	builders []*OnCallOverrideCreate
+}
+
+// Save creates the OnCallOverride entities in the database.
+func (_c *OnCallOverrideCreateBulk) Save(ctx context.Context) ([]*OnCallOverride, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OnCallOverride, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OnCallOverrideMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OnCallOverrideCreateBulk) SaveX(ctx context.Context) []*OnCallOverride {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OnCallOverrideCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OnCallOverrideCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
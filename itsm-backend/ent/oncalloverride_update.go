@@ -0,0 +1,710 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/oncalloverride"
+	"itsm-backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OnCallOverrideUpdate is the builder for updating OnCallOverride entities.
+type OnCallOverrideUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OnCallOverrideMutation
+}
+
+// Where appends a list predicates to the OnCallOverrideUpdate builder.
+func (_u *OnCallOverrideUpdate) Where(ps ...predicate.OnCallOverride) *OnCallOverrideUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *OnCallOverrideUpdate) SetTenantID(v int) *OnCallOverrideUpdate {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *OnCallOverrideUpdate) SetNillableTenantID(v *int) *OnCallOverrideUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *OnCallOverrideUpdate) AddTenantID(v int) *OnCallOverrideUpdate {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (_u *OnCallOverrideUpdate) SetScheduleID(v int) *OnCallOverrideUpdate {
+	_u.mutation.ResetScheduleID()
+	_u.mutation.SetScheduleID(v)
+	return _u
+}
+
+// SetNillableScheduleID sets the "schedule_id" field if the given value is not nil.
+func (_u *OnCallOverrideUpdate) SetNillableScheduleID(v *int) *OnCallOverrideUpdate {
+	if v != nil {
+		_u.SetScheduleID(*v)
+	}
+	return _u
+}
+
+// AddScheduleID adds value to the "schedule_id" field.
+func (_u *OnCallOverrideUpdate) AddScheduleID(v int) *OnCallOverrideUpdate {
+	_u.mutation.AddScheduleID(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *OnCallOverrideUpdate) SetUserID(v int) *OnCallOverrideUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *OnCallOverrideUpdate) SetNillableUserID(v *int) *OnCallOverrideUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *OnCallOverrideUpdate) AddUserID(v int) *OnCallOverrideUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetOriginalUserID sets the "original_user_id" field.
+func (_u *OnCallOverrideUpdate) SetOriginalUserID(v int) *OnCallOverrideUpdate {
+	_u.mutation.ResetOriginalUserID()
+	_u.mutation.SetOriginalUserID(v)
+	return _u
+}
+
+// SetNillableOriginalUserID sets the "original_user_id" field if the given value is not nil.
+func (_u *OnCallOverrideUpdate) SetNillableOriginalUserID(v *int) *OnCallOverrideUpdate {
+	if v != nil {
+		_u.SetOriginalUserID(*v)
+	}
+	return _u
+}
+
+// AddOriginalUserID adds value to the "original_user_id" field.
+func (_u *OnCallOverrideUpdate) AddOriginalUserID(v int) *OnCallOverrideUpdate {
+	_u.mutation.AddOriginalUserID(v)
+	return _u
+}
+
+// ClearOriginalUserID clears the value of the "original_user_id" field.
+func (_u *OnCallOverrideUpdate) ClearOriginalUserID() *OnCallOverrideUpdate {
+	_u.mutation.ClearOriginalUserID()
+	return _u
+}
+
+// SetStartAt sets the "start_at" field.
+func (_u *OnCallOverrideUpdate) SetStartAt(v time.Time) *OnCallOverrideUpdate {
+	_u.mutation.SetStartAt(v)
+	return _u
+}
+
+// SetNillableStartAt sets the "start_at" field if the given value is not nil.
+func (_u *OnCallOverrideUpdate) SetNillableStartAt(v *time.Time) *OnCallOverrideUpdate {
+	if v != nil {
+		_u.SetStartAt(*v)
+	}
+	return _u
+}
+
+// SetEndAt sets the "end_at" field.
+func (_u *OnCallOverrideUpdate) SetEndAt(v time.Time) *OnCallOverrideUpdate {
+	_u.mutation.SetEndAt(v)
+	return _u
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (_u *OnCallOverrideUpdate) SetNillableEndAt(v *time.Time) *OnCallOverrideUpdate {
+	if v != nil {
+		_u.SetEndAt(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *OnCallOverrideUpdate) SetReason(v string) *OnCallOverrideUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *OnCallOverrideUpdate) SetNillableReason(v *string) *OnCallOverrideUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *OnCallOverrideUpdate) ClearReason() *OnCallOverrideUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetSwapGroup sets the "swap_group" field.
+func (_u *OnCallOverrideUpdate) SetSwapGroup(v string) *OnCallOverrideUpdate {
+	_u.mutation.SetSwapGroup(v)
+	return _u
+}
+
+// SetNillableSwapGroup sets the "swap_group" field if the given value is not nil.
+func (_u *OnCallOverrideUpdate) SetNillableSwapGroup(v *string) *OnCallOverrideUpdate {
+	if v != nil {
+		_u.SetSwapGroup(*v)
+	}
+	return _u
+}
+
+// ClearSwapGroup clears the value of the "swap_group" field.
+func (_u *OnCallOverrideUpdate) ClearSwapGroup() *OnCallOverrideUpdate {
+	_u.mutation.ClearSwapGroup()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *OnCallOverrideUpdate) SetCreatedBy(v int) *OnCallOverrideUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *OnCallOverrideUpdate) SetNillableCreatedBy(v *int) *OnCallOverrideUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *OnCallOverrideUpdate) AddCreatedBy(v int) *OnCallOverrideUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *OnCallOverrideUpdate) ClearCreatedBy() *OnCallOverrideUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// Mutation returns the OnCallOverrideMutation object of the builder.
+func (_u *OnCallOverrideUpdate) Mutation() *OnCallOverrideMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OnCallOverrideUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OnCallOverrideUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OnCallOverrideUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OnCallOverrideUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OnCallOverrideUpdate) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := oncalloverride.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "OnCallOverride.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ScheduleID(); ok {
+		if err := oncalloverride.ScheduleIDValidator(v); err != nil {
+			return &ValidationError{Name: "schedule_id", err: fmt.Errorf(`ent: validator failed for field "OnCallOverride.schedule_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := oncalloverride.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "OnCallOverride.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := oncalloverride.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "OnCallOverride.reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *OnCallOverrideUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oncalloverride.Table, oncalloverride.Columns, sqlgraph.NewFieldSpec(oncalloverride.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(oncalloverride.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(oncalloverride.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ScheduleID(); ok {
+		_spec.SetField(oncalloverride.FieldScheduleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedScheduleID(); ok {
+		_spec.AddField(oncalloverride.FieldScheduleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(oncalloverride.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(oncalloverride.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OriginalUserID(); ok {
+		_spec.SetField(oncalloverride.FieldOriginalUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOriginalUserID(); ok {
+		_spec.AddField(oncalloverride.FieldOriginalUserID, field.TypeInt, value)
+	}
+	if _u.mutation.OriginalUserIDCleared() {
+		_spec.ClearField(oncalloverride.FieldOriginalUserID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.StartAt(); ok {
+		_spec.SetField(oncalloverride.FieldStartAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndAt(); ok {
+		_spec.SetField(oncalloverride.FieldEndAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(oncalloverride.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(oncalloverride.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.SwapGroup(); ok {
+		_spec.SetField(oncalloverride.FieldSwapGroup, field.TypeString, value)
+	}
+	if _u.mutation.SwapGroupCleared() {
+		_spec.ClearField(oncalloverride.FieldSwapGroup, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(oncalloverride.FieldCreatedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(oncalloverride.FieldCreatedBy, field.TypeInt, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(oncalloverride.FieldCreatedBy, field.TypeInt)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oncalloverride.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OnCallOverrideUpdateOne is the builder for updating a single OnCallOverride entity.
+type OnCallOverrideUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OnCallOverrideMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *OnCallOverrideUpdateOne) SetTenantID(v int) *OnCallOverrideUpdateOne {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *OnCallOverrideUpdateOne) SetNillableTenantID(v *int) *OnCallOverrideUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *OnCallOverrideUpdateOne) AddTenantID(v int) *OnCallOverrideUpdateOne {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (_u *OnCallOverrideUpdateOne) SetScheduleID(v int) *OnCallOverrideUpdateOne {
+	_u.mutation.ResetScheduleID()
+	_u.mutation.SetScheduleID(v)
+	return _u
+}
+
+// SetNillableScheduleID sets the "schedule_id" field if the given value is not nil.
+func (_u *OnCallOverrideUpdateOne) SetNillableScheduleID(v *int) *OnCallOverrideUpdateOne {
+	if v != nil {
+		_u.SetScheduleID(*v)
+	}
+	return _u
+}
+
+// AddScheduleID adds value to the "schedule_id" field.
+func (_u *OnCallOverrideUpdateOne) AddScheduleID(v int) *OnCallOverrideUpdateOne {
+	_u.mutation.AddScheduleID(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *OnCallOverrideUpdateOne) SetUserID(v int) *OnCallOverrideUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *OnCallOverrideUpdateOne) SetNillableUserID(v *int) *OnCallOverrideUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *OnCallOverrideUpdateOne) AddUserID(v int) *OnCallOverrideUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetOriginalUserID sets the "original_user_id" field.
+func (_u *OnCallOverrideUpdateOne) SetOriginalUserID(v int) *OnCallOverrideUpdateOne {
+	_u.mutation.ResetOriginalUserID()
+	_u.mutation.SetOriginalUserID(v)
+	return _u
+}
+
+// SetNillableOriginalUserID sets the "original_user_id" field if the given value is not nil.
+func (_u *OnCallOverrideUpdateOne) SetNillableOriginalUserID(v *int) *OnCallOverrideUpdateOne {
+	if v != nil {
+		_u.SetOriginalUserID(*v)
+	}
+	return _u
+}
+
+// AddOriginalUserID adds value to the "original_user_id" field.
+func (_u *OnCallOverrideUpdateOne) AddOriginalUserID(v int) *OnCallOverrideUpdateOne {
+	_u.mutation.AddOriginalUserID(v)
+	return _u
+}
+
+// ClearOriginalUserID clears the value of the "original_user_id" field.
+func (_u *OnCallOverrideUpdateOne) ClearOriginalUserID() *OnCallOverrideUpdateOne {
+	_u.mutation.ClearOriginalUserID()
+	return _u
+}
+
+// SetStartAt sets the "start_at" field.
+func (_u *OnCallOverrideUpdateOne) SetStartAt(v time.Time) *OnCallOverrideUpdateOne {
+	_u.mutation.SetStartAt(v)
+	return _u
+}
+
+// SetNillableStartAt sets the "start_at" field if the given value is not nil.
+func (_u *OnCallOverrideUpdateOne) SetNillableStartAt(v *time.Time) *OnCallOverrideUpdateOne {
+	if v != nil {
+		_u.SetStartAt(*v)
+	}
+	return _u
+}
+
+// SetEndAt sets the "end_at" field.
+func (_u *OnCallOverrideUpdateOne) SetEndAt(v time.Time) *OnCallOverrideUpdateOne {
+	_u.mutation.SetEndAt(v)
+	return _u
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (_u *OnCallOverrideUpdateOne) SetNillableEndAt(v *time.Time) *OnCallOverrideUpdateOne {
+	if v != nil {
+		_u.SetEndAt(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *OnCallOverrideUpdateOne) SetReason(v string) *OnCallOverrideUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *OnCallOverrideUpdateOne) SetNillableReason(v *string) *OnCallOverrideUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *OnCallOverrideUpdateOne) ClearReason() *OnCallOverrideUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetSwapGroup sets the "swap_group" field.
+func (_u *OnCallOverrideUpdateOne) SetSwapGroup(v string) *OnCallOverrideUpdateOne {
+	_u.mutation.SetSwapGroup(v)
+	return _u
+}
+
+// SetNillableSwapGroup sets the "swap_group" field if the given value is not nil.
+func (_u *OnCallOverrideUpdateOne) SetNillableSwapGroup(v *string) *OnCallOverrideUpdateOne {
+	if v != nil {
+		_u.SetSwapGroup(*v)
+	}
+	return _u
+}
+
+// ClearSwapGroup clears the value of the "swap_group" field.
+func (_u *OnCallOverrideUpdateOne) ClearSwapGroup() *OnCallOverrideUpdateOne {
+	_u.mutation.ClearSwapGroup()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *OnCallOverrideUpdateOne) SetCreatedBy(v int) *OnCallOverrideUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *OnCallOverrideUpdateOne) SetNillableCreatedBy(v *int) *OnCallOverrideUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *OnCallOverrideUpdateOne) AddCreatedBy(v int) *OnCallOverrideUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *OnCallOverrideUpdateOne) ClearCreatedBy() *OnCallOverrideUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// Mutation returns the OnCallOverrideMutation object of the builder.
+func (_u *OnCallOverrideUpdateOne) Mutation() *OnCallOverrideMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the OnCallOverrideUpdate builder.
+func (_u *OnCallOverrideUpdateOne) Where(ps ...predicate.OnCallOverride) *OnCallOverrideUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OnCallOverrideUpdateOne) Select(field string, fields ...string) *OnCallOverrideUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OnCallOverride entity.
+func (_u *OnCallOverrideUpdateOne) Save(ctx context.Context) (*OnCallOverride, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OnCallOverrideUpdateOne) SaveX(ctx context.Context) *OnCallOverride {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OnCallOverrideUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OnCallOverrideUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OnCallOverrideUpdateOne) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := oncalloverride.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "OnCallOverride.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ScheduleID(); ok {
+		if err := oncalloverride.ScheduleIDValidator(v); err != nil {
+			return &ValidationError{Name: "schedule_id", err: fmt.Errorf(`ent: validator failed for field "OnCallOverride.schedule_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := oncalloverride.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "OnCallOverride.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := oncalloverride.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "OnCallOverride.reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *OnCallOverrideUpdateOne) sqlSave(ctx context.Context) (_node *OnCallOverride, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oncalloverride.Table, oncalloverride.Columns, sqlgraph.NewFieldSpec(oncalloverride.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OnCallOverride.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oncalloverride.FieldID)
+		for _, f := range fields {
+			if !oncalloverride.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != oncalloverride.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(oncalloverride.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(oncalloverride.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ScheduleID(); ok {
+		_spec.SetField(oncalloverride.FieldScheduleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedScheduleID(); ok {
+		_spec.AddField(oncalloverride.FieldScheduleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(oncalloverride.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(oncalloverride.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OriginalUserID(); ok {
+		_spec.SetField(oncalloverride.FieldOriginalUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOriginalUserID(); ok {
+		_spec.AddField(oncalloverride.FieldOriginalUserID, field.TypeInt, value)
+	}
+	if _u.mutation.OriginalUserIDCleared() {
+		_spec.ClearField(oncalloverride.FieldOriginalUserID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.StartAt(); ok {
+		_spec.SetField(oncalloverride.FieldStartAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndAt(); ok {
+		_spec.SetField(oncalloverride.FieldEndAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(oncalloverride.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(oncalloverride.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.SwapGroup(); ok {
+		_spec.SetField(oncalloverride.FieldSwapGroup, field.TypeString, value)
+	}
+	if _u.mutation.SwapGroupCleared() {
+		_spec.ClearField(oncalloverride.FieldSwapGroup, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(oncalloverride.FieldCreatedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(oncalloverride.FieldCreatedBy, field.TypeInt, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(oncalloverride.FieldCreatedBy, field.TypeInt)
+	}
+	_node = &OnCallOverride{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oncalloverride.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,252 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"itsm-backend/ent/oncallpage"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// OnCallPage is the model entity for the OnCallPage schema.
+type OnCallPage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 租户ID
+	TenantID int `json:"tenant_id,omitempty"`
+	// 升级策略ID
+	PolicyID int `json:"policy_id,omitempty"`
+	// 事件ID
+	IncidentID int `json:"incident_id,omitempty"`
+	// 状态：triggered 呼叫中，acknowledged 已确认，resolved 事件已解决，exhausted 无人确认
+	Status oncallpage.Status `json:"status,omitempty"`
+	// 当前步骤序号（从 0 开始）
+	Step int `json:"step,omitempty"`
+	// 当前重复轮次（从 0 开始）
+	Round int `json:"round,omitempty"`
+	// 当前步骤已呼叫的用户ID
+	NotifiedUserIds []int `json:"notified_user_ids,omitempty"`
+	// 下次升级时间，呼叫结束后为空
+	NextEscalationAt *time.Time `json:"next_escalation_at,omitempty"`
+	// 确认人ID
+	AcknowledgedBy *int `json:"acknowledged_by,omitempty"`
+	// 确认时间
+	AcknowledgedAt *time.Time `json:"acknowledged_at,omitempty"`
+	// 发起人ID
+	CreatedBy int `json:"created_by,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OnCallPage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case oncallpage.FieldNotifiedUserIds:
+			values[i] = new([]byte)
+		case oncallpage.FieldID, oncallpage.FieldTenantID, oncallpage.FieldPolicyID, oncallpage.FieldIncidentID, oncallpage.FieldStep, oncallpage.FieldRound, oncallpage.FieldAcknowledgedBy, oncallpage.FieldCreatedBy:
+			values[i] = new(sql.NullInt64)
+		case oncallpage.FieldStatus:
+			values[i] = new(sql.NullString)
+		case oncallpage.FieldNextEscalationAt, oncallpage.FieldAcknowledgedAt, oncallpage.FieldCreatedAt, oncallpage.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OnCallPage fields.
+func (_m *OnCallPage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case oncallpage.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case oncallpage.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = int(value.Int64)
+			}
+		case oncallpage.FieldPolicyID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field policy_id", values[i])
+			} else if value.Valid {
+				_m.PolicyID = int(value.Int64)
+			}
+		case oncallpage.FieldIncidentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field incident_id", values[i])
+			} else if value.Valid {
+				_m.IncidentID = int(value.Int64)
+			}
+		case oncallpage.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = oncallpage.Status(value.String)
+			}
+		case oncallpage.FieldStep:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field step", values[i])
+			} else if value.Valid {
+				_m.Step = int(value.Int64)
+			}
+		case oncallpage.FieldRound:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field round", values[i])
+			} else if value.Valid {
+				_m.Round = int(value.Int64)
+			}
+		case oncallpage.FieldNotifiedUserIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field notified_user_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.NotifiedUserIds); err != nil {
+					return fmt.Errorf("unmarshal field notified_user_ids: %w", err)
+				}
+			}
+		case oncallpage.FieldNextEscalationAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_escalation_at", values[i])
+			} else if value.Valid {
+				_m.NextEscalationAt = new(time.Time)
+				*_m.NextEscalationAt = value.Time
+			}
+		case oncallpage.FieldAcknowledgedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field acknowledged_by", values[i])
+			} else if value.Valid {
+				_m.AcknowledgedBy = new(int)
+				*_m.AcknowledgedBy = int(value.Int64)
+			}
+		case oncallpage.FieldAcknowledgedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field acknowledged_at", values[i])
+			} else if value.Valid {
+				_m.AcknowledgedAt = new(time.Time)
+				*_m.AcknowledgedAt = value.Time
+			}
+		case oncallpage.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = int(value.Int64)
+			}
+		case oncallpage.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case oncallpage.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OnCallPage.
+// This includes values selected through modifiers, order, etc.
+func (_m *OnCallPage) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this OnCallPage.
+// Note that you need to call OnCallPage.Unwrap() before calling this method if this OnCallPage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *OnCallPage) Update() *OnCallPageUpdateOne {
+	return NewOnCallPageClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the OnCallPage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *OnCallPage) Unwrap() *OnCallPage {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OnCallPage is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *OnCallPage) String() string {
+	var builder strings.Builder
+	builder.WriteString("OnCallPage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("policy_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PolicyID))
+	builder.WriteString(", ")
+	builder.WriteString("incident_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IncidentID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("step=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Step))
+	builder.WriteString(", ")
+	builder.WriteString("round=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Round))
+	builder.WriteString(", ")
+	builder.WriteString("notified_user_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NotifiedUserIds))
+	builder.WriteString(", ")
+	if v := _m.NextEscalationAt; v != nil {
+		builder.WriteString("next_escalation_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.AcknowledgedBy; v != nil {
+		builder.WriteString("acknowledged_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.AcknowledgedAt; v != nil {
+		builder.WriteString("acknowledged_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedBy))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OnCallPages is a parsable slice of OnCallPage.
+type OnCallPages []*OnCallPage
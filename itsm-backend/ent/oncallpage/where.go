@@ -0,0 +1,635 @@
+// Code generated by ent, DO NOT EDIT.
+
+package oncallpage
+
+import (
+	"itsm-backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldEQ(FieldTenantID, v))
+}
+
+// PolicyID applies equality check predicate on the "policy_id" field. It's identical to PolicyIDEQ.
+func PolicyID(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldEQ(FieldPolicyID, v))
+}
+
+// IncidentID applies equality check predicate on the "incident_id" field. It's identical to IncidentIDEQ.
+func IncidentID(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldEQ(FieldIncidentID, v))
+}
+
+// Step applies equality check predicate on the "step" field. It's identical to StepEQ.
+func Step(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldEQ(FieldStep, v))
+}
+
+// Round applies equality check predicate on the "round" field. It's identical to RoundEQ.
+func Round(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldEQ(FieldRound, v))
+}
+
+// NextEscalationAt applies equality check predicate on the "next_escalation_at" field. It's identical to NextEscalationAtEQ.
+func NextEscalationAt(v time.Time) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldEQ(FieldNextEscalationAt, v))
+}
+
+// AcknowledgedBy applies equality check predicate on the "acknowledged_by" field. It's identical to AcknowledgedByEQ.
+func AcknowledgedBy(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldEQ(FieldAcknowledgedBy, v))
+}
+
+// AcknowledgedAt applies equality check predicate on the "acknowledged_at" field. It's identical to AcknowledgedAtEQ.
+func AcknowledgedAt(v time.Time) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldEQ(FieldAcknowledgedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldLTE(FieldTenantID, v))
+}
+
+// PolicyIDEQ applies the EQ predicate on the "policy_id" field.
+func PolicyIDEQ(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldEQ(FieldPolicyID, v))
+}
+
+// PolicyIDNEQ applies the NEQ predicate on the "policy_id" field.
+func PolicyIDNEQ(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldNEQ(FieldPolicyID, v))
+}
+
+// PolicyIDIn applies the In predicate on the "policy_id" field.
+func PolicyIDIn(vs ...int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldIn(FieldPolicyID, vs...))
+}
+
+// PolicyIDNotIn applies the NotIn predicate on the "policy_id" field.
+func PolicyIDNotIn(vs ...int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldNotIn(FieldPolicyID, vs...))
+}
+
+// PolicyIDGT applies the GT predicate on the "policy_id" field.
+func PolicyIDGT(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldGT(FieldPolicyID, v))
+}
+
+// PolicyIDGTE applies the GTE predicate on the "policy_id" field.
+func PolicyIDGTE(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldGTE(FieldPolicyID, v))
+}
+
+// PolicyIDLT applies the LT predicate on the "policy_id" field.
+func PolicyIDLT(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldLT(FieldPolicyID, v))
+}
+
+// PolicyIDLTE applies the LTE predicate on the "policy_id" field.
+func PolicyIDLTE(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldLTE(FieldPolicyID, v))
+}
+
+// IncidentIDEQ applies the EQ predicate on the "incident_id" field.
+func IncidentIDEQ(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldEQ(FieldIncidentID, v))
+}
+
+// IncidentIDNEQ applies the NEQ predicate on the "incident_id" field.
+func IncidentIDNEQ(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldNEQ(FieldIncidentID, v))
+}
+
+// IncidentIDIn applies the In predicate on the "incident_id" field.
+func IncidentIDIn(vs ...int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldIn(FieldIncidentID, vs...))
+}
+
+// IncidentIDNotIn applies the NotIn predicate on the "incident_id" field.
+func IncidentIDNotIn(vs ...int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldNotIn(FieldIncidentID, vs...))
+}
+
+// IncidentIDGT applies the GT predicate on the "incident_id" field.
+func IncidentIDGT(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldGT(FieldIncidentID, v))
+}
+
+// IncidentIDGTE applies the GTE predicate on the "incident_id" field.
+func IncidentIDGTE(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldGTE(FieldIncidentID, v))
+}
+
+// IncidentIDLT applies the LT predicate on the "incident_id" field.
+func IncidentIDLT(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldLT(FieldIncidentID, v))
+}
+
+// IncidentIDLTE applies the LTE predicate on the "incident_id" field.
+func IncidentIDLTE(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldLTE(FieldIncidentID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StepEQ applies the EQ predicate on the "step" field.
+func StepEQ(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldEQ(FieldStep, v))
+}
+
+// StepNEQ applies the NEQ predicate on the "step" field.
+func StepNEQ(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldNEQ(FieldStep, v))
+}
+
+// StepIn applies the In predicate on the "step" field.
+func StepIn(vs ...int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldIn(FieldStep, vs...))
+}
+
+// StepNotIn applies the NotIn predicate on the "step" field.
+func StepNotIn(vs ...int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldNotIn(FieldStep, vs...))
+}
+
+// StepGT applies the GT predicate on the "step" field.
+func StepGT(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldGT(FieldStep, v))
+}
+
+// StepGTE applies the GTE predicate on the "step" field.
+func StepGTE(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldGTE(FieldStep, v))
+}
+
+// StepLT applies the LT predicate on the "step" field.
+func StepLT(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldLT(FieldStep, v))
+}
+
+// StepLTE applies the LTE predicate on the "step" field.
+func StepLTE(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldLTE(FieldStep, v))
+}
+
+// RoundEQ applies the EQ predicate on the "round" field.
+func RoundEQ(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldEQ(FieldRound, v))
+}
+
+// RoundNEQ applies the NEQ predicate on the "round" field.
+func RoundNEQ(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldNEQ(FieldRound, v))
+}
+
+// RoundIn applies the In predicate on the "round" field.
+func RoundIn(vs ...int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldIn(FieldRound, vs...))
+}
+
+// RoundNotIn applies the NotIn predicate on the "round" field.
+func RoundNotIn(vs ...int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldNotIn(FieldRound, vs...))
+}
+
+// RoundGT applies the GT predicate on the "round" field.
+func RoundGT(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldGT(FieldRound, v))
+}
+
+// RoundGTE applies the GTE predicate on the "round" field.
+func RoundGTE(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldGTE(FieldRound, v))
+}
+
+// RoundLT applies the LT predicate on the "round" field.
+func RoundLT(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldLT(FieldRound, v))
+}
+
+// RoundLTE applies the LTE predicate on the "round" field.
+func RoundLTE(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldLTE(FieldRound, v))
+}
+
+// NotifiedUserIdsIsNil applies the IsNil predicate on the "notified_user_ids" field.
+func NotifiedUserIdsIsNil() predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldIsNull(FieldNotifiedUserIds))
+}
+
+// NotifiedUserIdsNotNil applies the NotNil predicate on the "notified_user_ids" field.
+func NotifiedUserIdsNotNil() predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldNotNull(FieldNotifiedUserIds))
+}
+
+// NextEscalationAtEQ applies the EQ predicate on the "next_escalation_at" field.
+func NextEscalationAtEQ(v time.Time) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldEQ(FieldNextEscalationAt, v))
+}
+
+// NextEscalationAtNEQ applies the NEQ predicate on the "next_escalation_at" field.
+func NextEscalationAtNEQ(v time.Time) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldNEQ(FieldNextEscalationAt, v))
+}
+
+// NextEscalationAtIn applies the In predicate on the "next_escalation_at" field.
+func NextEscalationAtIn(vs ...time.Time) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldIn(FieldNextEscalationAt, vs...))
+}
+
+// NextEscalationAtNotIn applies the NotIn predicate on the "next_escalation_at" field.
+func NextEscalationAtNotIn(vs ...time.Time) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldNotIn(FieldNextEscalationAt, vs...))
+}
+
+// NextEscalationAtGT applies the GT predicate on the "next_escalation_at" field.
+func NextEscalationAtGT(v time.Time) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldGT(FieldNextEscalationAt, v))
+}
+
+// NextEscalationAtGTE applies the GTE predicate on the "next_escalation_at" field.
+func NextEscalationAtGTE(v time.Time) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldGTE(FieldNextEscalationAt, v))
+}
+
+// NextEscalationAtLT applies the LT predicate on the "next_escalation_at" field.
+func NextEscalationAtLT(v time.Time) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldLT(FieldNextEscalationAt, v))
+}
+
+// NextEscalationAtLTE applies the LTE predicate on the "next_escalation_at" field.
+func NextEscalationAtLTE(v time.Time) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldLTE(FieldNextEscalationAt, v))
+}
+
+// NextEscalationAtIsNil applies the IsNil predicate on the "next_escalation_at" field.
+func NextEscalationAtIsNil() predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldIsNull(FieldNextEscalationAt))
+}
+
+// NextEscalationAtNotNil applies the NotNil predicate on the "next_escalation_at" field.
+func NextEscalationAtNotNil() predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldNotNull(FieldNextEscalationAt))
+}
+
+// AcknowledgedByEQ applies the EQ predicate on the "acknowledged_by" field.
+func AcknowledgedByEQ(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldEQ(FieldAcknowledgedBy, v))
+}
+
+// AcknowledgedByNEQ applies the NEQ predicate on the "acknowledged_by" field.
+func AcknowledgedByNEQ(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldNEQ(FieldAcknowledgedBy, v))
+}
+
+// AcknowledgedByIn applies the In predicate on the "acknowledged_by" field.
+func AcknowledgedByIn(vs ...int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldIn(FieldAcknowledgedBy, vs...))
+}
+
+// AcknowledgedByNotIn applies the NotIn predicate on the "acknowledged_by" field.
+func AcknowledgedByNotIn(vs ...int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldNotIn(FieldAcknowledgedBy, vs...))
+}
+
+// AcknowledgedByGT applies the GT predicate on the "acknowledged_by" field.
+func AcknowledgedByGT(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldGT(FieldAcknowledgedBy, v))
+}
+
+// AcknowledgedByGTE applies the GTE predicate on the "acknowledged_by" field.
+func AcknowledgedByGTE(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldGTE(FieldAcknowledgedBy, v))
+}
+
+// AcknowledgedByLT applies the LT predicate on the "acknowledged_by" field.
+func AcknowledgedByLT(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldLT(FieldAcknowledgedBy, v))
+}
+
+// AcknowledgedByLTE applies the LTE predicate on the "acknowledged_by" field.
+func AcknowledgedByLTE(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldLTE(FieldAcknowledgedBy, v))
+}
+
+// AcknowledgedByIsNil applies the IsNil predicate on the "acknowledged_by" field.
+func AcknowledgedByIsNil() predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldIsNull(FieldAcknowledgedBy))
+}
+
+// AcknowledgedByNotNil applies the NotNil predicate on the "acknowledged_by" field.
+func AcknowledgedByNotNil() predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldNotNull(FieldAcknowledgedBy))
+}
+
+// AcknowledgedAtEQ applies the EQ predicate on the "acknowledged_at" field.
+func AcknowledgedAtEQ(v time.Time) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldEQ(FieldAcknowledgedAt, v))
+}
+
+// AcknowledgedAtNEQ applies the NEQ predicate on the "acknowledged_at" field.
+func AcknowledgedAtNEQ(v time.Time) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldNEQ(FieldAcknowledgedAt, v))
+}
+
+// AcknowledgedAtIn applies the In predicate on the "acknowledged_at" field.
+func AcknowledgedAtIn(vs ...time.Time) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldIn(FieldAcknowledgedAt, vs...))
+}
+
+// AcknowledgedAtNotIn applies the NotIn predicate on the "acknowledged_at" field.
+func AcknowledgedAtNotIn(vs ...time.Time) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldNotIn(FieldAcknowledgedAt, vs...))
+}
+
+// AcknowledgedAtGT applies the GT predicate on the "acknowledged_at" field.
+func AcknowledgedAtGT(v time.Time) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldGT(FieldAcknowledgedAt, v))
+}
+
+// AcknowledgedAtGTE applies the GTE predicate on the "acknowledged_at" field.
+func AcknowledgedAtGTE(v time.Time) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldGTE(FieldAcknowledgedAt, v))
+}
+
+// AcknowledgedAtLT applies the LT predicate on the "acknowledged_at" field.
+func AcknowledgedAtLT(v time.Time) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldLT(FieldAcknowledgedAt, v))
+}
+
+// AcknowledgedAtLTE applies the LTE predicate on the "acknowledged_at" field.
+func AcknowledgedAtLTE(v time.Time) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldLTE(FieldAcknowledgedAt, v))
+}
+
+// AcknowledgedAtIsNil applies the IsNil predicate on the "acknowledged_at" field.
+func AcknowledgedAtIsNil() predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldIsNull(FieldAcknowledgedAt))
+}
+
+// AcknowledgedAtNotNil applies the NotNil predicate on the "acknowledged_at" field.
+func AcknowledgedAtNotNil() predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldNotNull(FieldAcknowledgedAt))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v int) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OnCallPage) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OnCallPage) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OnCallPage) predicate.OnCallPage {
+	return predicate.OnCallPage(sql.NotPredicates(p))
+}
@@ -0,0 +1,453 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/oncallpage"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OnCallPageCreate is the builder for creating a OnCallPage entity.
+type OnCallPageCreate struct {
+	config
+	mutation *OnCallPageMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *OnCallPageCreate) SetTenantID(v int) *OnCallPageCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetPolicyID sets the "policy_id" field.
+func (_c *OnCallPageCreate) SetPolicyID(v int) *OnCallPageCreate {
+	_c.mutation.SetPolicyID(v)
+	return _c
+}
+
+// SetIncidentID sets the "incident_id" field.
+func (_c *OnCallPageCreate) SetIncidentID(v int) *OnCallPageCreate {
+	_c.mutation.SetIncidentID(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *OnCallPageCreate) SetStatus(v oncallpage.Status) *OnCallPageCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *OnCallPageCreate) SetNillableStatus(v *oncallpage.Status) *OnCallPageCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetStep sets the "step" field.
+func (_c *OnCallPageCreate) SetStep(v int) *OnCallPageCreate {
+	_c.mutation.SetStep(v)
+	return _c
+}
+
+// SetNillableStep sets the "step" field if the given value is not nil.
+func (_c *OnCallPageCreate) SetNillableStep(v *int) *OnCallPageCreate {
+	if v != nil {
+		_c.SetStep(*v)
+	}
+	return _c
+}
+
+// SetRound sets the "round" field.
+func (_c *OnCallPageCreate) SetRound(v int) *OnCallPageCreate {
+	_c.mutation.SetRound(v)
+	return _c
+}
+
+// SetNillableRound sets the "round" field if the given value is not nil.
+func (_c *OnCallPageCreate) SetNillableRound(v *int) *OnCallPageCreate {
+	if v != nil {
+		_c.SetRound(*v)
+	}
+	return _c
+}
+
+// SetNotifiedUserIds sets the "notified_user_ids" field.
+func (_c *OnCallPageCreate) SetNotifiedUserIds(v []int) *OnCallPageCreate {
+	_c.mutation.SetNotifiedUserIds(v)
+	return _c
+}
+
+// SetNextEscalationAt sets the "next_escalation_at" field.
+func (_c *OnCallPageCreate) SetNextEscalationAt(v time.Time) *OnCallPageCreate {
+	_c.mutation.SetNextEscalationAt(v)
+	return _c
+}
+
+// SetNillableNextEscalationAt sets the "next_escalation_at" field if the given value is not nil.
+func (_c *OnCallPageCreate) SetNillableNextEscalationAt(v *time.Time) *OnCallPageCreate {
+	if v != nil {
+		_c.SetNextEscalationAt(*v)
+	}
+	return _c
+}
+
+// SetAcknowledgedBy sets the "acknowledged_by" field.
+func (_c *OnCallPageCreate) SetAcknowledgedBy(v int) *OnCallPageCreate {
+	_c.mutation.SetAcknowledgedBy(v)
+	return _c
+}
+
+// SetNillableAcknowledgedBy sets the "acknowledged_by" field if the given value is not nil.
+func (_c *OnCallPageCreate) SetNillableAcknowledgedBy(v *int) *OnCallPageCreate {
+	if v != nil {
+		_c.SetAcknowledgedBy(*v)
+	}
+	return _c
+}
+
+// SetAcknowledgedAt sets the "acknowledged_at" field.
+func (_c *OnCallPageCreate) SetAcknowledgedAt(v time.Time) *OnCallPageCreate {
+	_c.mutation.SetAcknowledgedAt(v)
+	return _c
+}
+
+// SetNillableAcknowledgedAt sets the "acknowledged_at" field if the given value is not nil.
+func (_c *OnCallPageCreate) SetNillableAcknowledgedAt(v *time.Time) *OnCallPageCreate {
+	if v != nil {
+		_c.SetAcknowledgedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *OnCallPageCreate) SetCreatedBy(v int) *OnCallPageCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *OnCallPageCreate) SetNillableCreatedBy(v *int) *OnCallPageCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *OnCallPageCreate) SetCreatedAt(v time.Time) *OnCallPageCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *OnCallPageCreate) SetNillableCreatedAt(v *time.Time) *OnCallPageCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *OnCallPageCreate) SetUpdatedAt(v time.Time) *OnCallPageCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *OnCallPageCreate) SetNillableUpdatedAt(v *time.Time) *OnCallPageCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the OnCallPageMutation object of the builder.
+func (_c *OnCallPageCreate) Mutation() *OnCallPageMutation {
+	return _c.mutation
+}
+
+// Save creates the OnCallPage in the database.
+func (_c *OnCallPageCreate) Save(ctx context.Context) (*OnCallPage, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OnCallPageCreate) SaveX(ctx context.Context) *OnCallPage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OnCallPageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OnCallPageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OnCallPageCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := oncallpage.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Step(); !ok {
+		v := oncallpage.DefaultStep
+		_c.mutation.SetStep(v)
+	}
+	if _, ok := _c.mutation.Round(); !ok {
+		v := oncallpage.DefaultRound
+		_c.mutation.SetRound(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := oncallpage.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := oncallpage.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OnCallPageCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "OnCallPage.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := oncallpage.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "OnCallPage.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PolicyID(); !ok {
+		return &ValidationError{Name: "policy_id", err: errors.New(`ent: missing required field "OnCallPage.policy_id"`)}
+	}
+	if v, ok := _c.mutation.PolicyID(); ok {
+		if err := oncallpage.PolicyIDValidator(v); err != nil {
+			return &ValidationError{Name: "policy_id", err: fmt.Errorf(`ent: validator failed for field "OnCallPage.policy_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IncidentID(); !ok {
+		return &ValidationError{Name: "incident_id", err: errors.New(`ent: missing required field "OnCallPage.incident_id"`)}
+	}
+	if v, ok := _c.mutation.IncidentID(); ok {
+		if err := oncallpage.IncidentIDValidator(v); err != nil {
+			return &ValidationError{Name: "incident_id", err: fmt.Errorf(`ent: validator failed for field "OnCallPage.incident_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "OnCallPage.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := oncallpage.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "OnCallPage.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Step(); !ok {
+		return &ValidationError{Name: "step", err: errors.New(`ent: missing required field "OnCallPage.step"`)}
+	}
+	if v, ok := _c.mutation.Step(); ok {
+		if err := oncallpage.StepValidator(v); err != nil {
+			return &ValidationError{Name: "step", err: fmt.Errorf(`ent: validator failed for field "OnCallPage.step": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Round(); !ok {
+		return &ValidationError{Name: "round", err: errors.New(`ent: missing required field "OnCallPage.round"`)}
+	}
+	if v, ok := _c.mutation.Round(); ok {
+		if err := oncallpage.RoundValidator(v); err != nil {
+			return &ValidationError{Name: "round", err: fmt.Errorf(`ent: validator failed for field "OnCallPage.round": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OnCallPage.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "OnCallPage.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *OnCallPageCreate) sqlSave(ctx context.Context) (*OnCallPage, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OnCallPageCreate) createSpec() (*OnCallPage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OnCallPage{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(oncallpage.Table, sqlgraph.NewFieldSpec(oncallpage.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(oncallpage.FieldTenantID, field.TypeInt, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.PolicyID(); ok {
+		_spec.SetField(oncallpage.FieldPolicyID, field.TypeInt, value)
+		_node.PolicyID = value
+	}
+	if value, ok := _c.mutation.IncidentID(); ok {
+		_spec.SetField(oncallpage.FieldIncidentID, field.TypeInt, value)
+		_node.IncidentID = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(oncallpage.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Step(); ok {
+		_spec.SetField(oncallpage.FieldStep, field.TypeInt, value)
+		_node.Step = value
+	}
+	if value, ok := _c.mutation.Round(); ok {
+		_spec.SetField(oncallpage.FieldRound, field.TypeInt, value)
+		_node.Round = value
+	}
+	if value, ok := _c.mutation.NotifiedUserIds(); ok {
+		_spec.SetField(oncallpage.FieldNotifiedUserIds, field.TypeJSON, value)
+		_node.NotifiedUserIds = value
+	}
+	if value, ok := _c.mutation.NextEscalationAt(); ok {
+		_spec.SetField(oncallpage.FieldNextEscalationAt, field.TypeTime, value)
+		_node.NextEscalationAt = &value
+	}
+	if value, ok := _c.mutation.AcknowledgedBy(); ok {
+		_spec.SetField(oncallpage.FieldAcknowledgedBy, field.TypeInt, value)
+		_node.AcknowledgedBy = &value
+	}
+	if value, ok := _c.mutation.AcknowledgedAt(); ok {
+		_spec.SetField(oncallpage.FieldAcknowledgedAt, field.TypeTime, value)
+		_node.AcknowledgedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(oncallpage.FieldCreatedBy, field.TypeInt, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(oncallpage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(oncallpage.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnCallPageCreateBulk is the builder for creating many OnCallPage entities in bulk.
+type OnCallPageCreateBulk struct {
+	config
+	err      error
+	builders []*OnCallPageCreate
+}
+
+// Save creates the OnCallPage entities in the database.
+func (_c *OnCallPageCreateBulk) Save(ctx context.Context) ([]*OnCallPage, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OnCallPage, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OnCallPageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OnCallPageCreateBulk) SaveX(ctx context.Context) []*OnCallPage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OnCallPageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OnCallPageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
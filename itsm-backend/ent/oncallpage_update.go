@@ -0,0 +1,899 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/oncallpage"
+	"itsm-backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// OnCallPageUpdate is the builder for updating OnCallPage entities.
+type OnCallPageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OnCallPageMutation
+}
+
+// Where appends a list predicates to the OnCallPageUpdate builder.
+func (_u *OnCallPageUpdate) Where(ps ...predicate.OnCallPage) *OnCallPageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *OnCallPageUpdate) SetTenantID(v int) *OnCallPageUpdate {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *OnCallPageUpdate) SetNillableTenantID(v *int) *OnCallPageUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *OnCallPageUpdate) AddTenantID(v int) *OnCallPageUpdate {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetPolicyID sets the "policy_id" field.
+func (_u *OnCallPageUpdate) SetPolicyID(v int) *OnCallPageUpdate {
+	_u.mutation.ResetPolicyID()
+	_u.mutation.SetPolicyID(v)
+	return _u
+}
+
+// SetNillablePolicyID sets the "policy_id" field if the given value is not nil.
+func (_u *OnCallPageUpdate) SetNillablePolicyID(v *int) *OnCallPageUpdate {
+	if v != nil {
+		_u.SetPolicyID(*v)
+	}
+	return _u
+}
+
+// AddPolicyID adds value to the "policy_id" field.
+func (_u *OnCallPageUpdate) AddPolicyID(v int) *OnCallPageUpdate {
+	_u.mutation.AddPolicyID(v)
+	return _u
+}
+
+// SetIncidentID sets the "incident_id" field.
+func (_u *OnCallPageUpdate) SetIncidentID(v int) *OnCallPageUpdate {
+	_u.mutation.ResetIncidentID()
+	_u.mutation.SetIncidentID(v)
+	return _u
+}
+
+// SetNillableIncidentID sets the "incident_id" field if the given value is not nil.
+func (_u *OnCallPageUpdate) SetNillableIncidentID(v *int) *OnCallPageUpdate {
+	if v != nil {
+		_u.SetIncidentID(*v)
+	}
+	return _u
+}
+
+// AddIncidentID adds value to the "incident_id" field.
+func (_u *OnCallPageUpdate) AddIncidentID(v int) *OnCallPageUpdate {
+	_u.mutation.AddIncidentID(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *OnCallPageUpdate) SetStatus(v oncallpage.Status) *OnCallPageUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *OnCallPageUpdate) SetNillableStatus(v *oncallpage.Status) *OnCallPageUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetStep sets the "step" field.
+func (_u *OnCallPageUpdate) SetStep(v int) *OnCallPageUpdate {
+	_u.mutation.ResetStep()
+	_u.mutation.SetStep(v)
+	return _u
+}
+
+// SetNillableStep sets the "step" field if the given value is not nil.
+func (_u *OnCallPageUpdate) SetNillableStep(v *int) *OnCallPageUpdate {
+	if v != nil {
+		_u.SetStep(*v)
+	}
+	return _u
+}
+
+// AddStep adds value to the "step" field.
+func (_u *OnCallPageUpdate) AddStep(v int) *OnCallPageUpdate {
+	_u.mutation.AddStep(v)
+	return _u
+}
+
+// SetRound sets the "round" field.
+func (_u *OnCallPageUpdate) SetRound(v int) *OnCallPageUpdate {
+	_u.mutation.ResetRound()
+	_u.mutation.SetRound(v)
+	return _u
+}
+
+// SetNillableRound sets the "round" field if the given value is not nil.
+func (_u *OnCallPageUpdate) SetNillableRound(v *int) *OnCallPageUpdate {
+	if v != nil {
+		_u.SetRound(*v)
+	}
+	return _u
+}
+
+// AddRound adds value to the "round" field.
+func (_u *OnCallPageUpdate) AddRound(v int) *OnCallPageUpdate {
+	_u.mutation.AddRound(v)
+	return _u
+}
+
+// SetNotifiedUserIds sets the "notified_user_ids" field.
+func (_u *OnCallPageUpdate) SetNotifiedUserIds(v []int) *OnCallPageUpdate {
+	_u.mutation.SetNotifiedUserIds(v)
+	return _u
+}
+
+// AppendNotifiedUserIds appends value to the "notified_user_ids" field.
+func (_u *OnCallPageUpdate) AppendNotifiedUserIds(v []int) *OnCallPageUpdate {
+	_u.mutation.AppendNotifiedUserIds(v)
+	return _u
+}
+
+// ClearNotifiedUserIds clears the value of the "notified_user_ids" field.
+func (_u *OnCallPageUpdate) ClearNotifiedUserIds() *OnCallPageUpdate {
+	_u.mutation.ClearNotifiedUserIds()
+	return _u
+}
+
+// SetNextEscalationAt sets the "next_escalation_at" field.
+func (_u *OnCallPageUpdate) SetNextEscalationAt(v time.Time) *OnCallPageUpdate {
+	_u.mutation.SetNextEscalationAt(v)
+	return _u
+}
+
+// SetNillableNextEscalationAt sets the "next_escalation_at" field if the given value is not nil.
+func (_u *OnCallPageUpdate) SetNillableNextEscalationAt(v *time.Time) *OnCallPageUpdate {
+	if v != nil {
+		_u.SetNextEscalationAt(*v)
+	}
+	return _u
+}
+
+// ClearNextEscalationAt clears the value of the "next_escalation_at" field.
+func (_u *OnCallPageUpdate) ClearNextEscalationAt() *OnCallPageUpdate {
+	_u.mutation.ClearNextEscalationAt()
+	return _u
+}
+
+// SetAcknowledgedBy sets the "acknowledged_by" field.
+func (_u *OnCallPageUpdate) SetAcknowledgedBy(v int) *OnCallPageUpdate {
+	_u.mutation.ResetAcknowledgedBy()
+	_u.mutation.SetAcknowledgedBy(v)
+	return _u
+}
+
+// SetNillableAcknowledgedBy sets the "acknowledged_by" field if the given value is not nil.
+func (_u *OnCallPageUpdate) SetNillableAcknowledgedBy(v *int) *OnCallPageUpdate {
+	if v != nil {
+		_u.SetAcknowledgedBy(*v)
+	}
+	return _u
+}
+
+// AddAcknowledgedBy adds value to the "acknowledged_by" field.
+func (_u *OnCallPageUpdate) AddAcknowledgedBy(v int) *OnCallPageUpdate {
+	_u.mutation.AddAcknowledgedBy(v)
+	return _u
+}
+
+// ClearAcknowledgedBy clears the value of the "acknowledged_by" field.
+func (_u *OnCallPageUpdate) ClearAcknowledgedBy() *OnCallPageUpdate {
+	_u.mutation.ClearAcknowledgedBy()
+	return _u
+}
+
+// SetAcknowledgedAt sets the "acknowledged_at" field.
+func (_u *OnCallPageUpdate) SetAcknowledgedAt(v time.Time) *OnCallPageUpdate {
+	_u.mutation.SetAcknowledgedAt(v)
+	return _u
+}
+
+// SetNillableAcknowledgedAt sets the "acknowledged_at" field if the given value is not nil.
+func (_u *OnCallPageUpdate) SetNillableAcknowledgedAt(v *time.Time) *OnCallPageUpdate {
+	if v != nil {
+		_u.SetAcknowledgedAt(*v)
+	}
+	return _u
+}
+
+// ClearAcknowledgedAt clears the value of the "acknowledged_at" field.
+func (_u *OnCallPageUpdate) ClearAcknowledgedAt() *OnCallPageUpdate {
+	_u.mutation.ClearAcknowledgedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *OnCallPageUpdate) SetCreatedBy(v int) *OnCallPageUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *OnCallPageUpdate) SetNillableCreatedBy(v *int) *OnCallPageUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *OnCallPageUpdate) AddCreatedBy(v int) *OnCallPageUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *OnCallPageUpdate) ClearCreatedBy() *OnCallPageUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OnCallPageUpdate) SetUpdatedAt(v time.Time) *OnCallPageUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the OnCallPageMutation object of the builder.
+func (_u *OnCallPageUpdate) Mutation() *OnCallPageMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OnCallPageUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OnCallPageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OnCallPageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OnCallPageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OnCallPageUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := oncallpage.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OnCallPageUpdate) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := oncallpage.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "OnCallPage.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PolicyID(); ok {
+		if err := oncallpage.PolicyIDValidator(v); err != nil {
+			return &ValidationError{Name: "policy_id", err: fmt.Errorf(`ent: validator failed for field "OnCallPage.policy_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IncidentID(); ok {
+		if err := oncallpage.IncidentIDValidator(v); err != nil {
+			return &ValidationError{Name: "incident_id", err: fmt.Errorf(`ent: validator failed for field "OnCallPage.incident_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := oncallpage.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "OnCallPage.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Step(); ok {
+		if err := oncallpage.StepValidator(v); err != nil {
+			return &ValidationError{Name: "step", err: fmt.Errorf(`ent: validator failed for field "OnCallPage.step": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Round(); ok {
+		if err := oncallpage.RoundValidator(v); err != nil {
+			return &ValidationError{Name: "round", err: fmt.Errorf(`ent: validator failed for field "OnCallPage.round": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *OnCallPageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oncallpage.Table, oncallpage.Columns, sqlgraph.NewFieldSpec(oncallpage.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(oncallpage.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(oncallpage.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PolicyID(); ok {
+		_spec.SetField(oncallpage.FieldPolicyID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPolicyID(); ok {
+		_spec.AddField(oncallpage.FieldPolicyID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IncidentID(); ok {
+		_spec.SetField(oncallpage.FieldIncidentID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedIncidentID(); ok {
+		_spec.AddField(oncallpage.FieldIncidentID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(oncallpage.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Step(); ok {
+		_spec.SetField(oncallpage.FieldStep, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStep(); ok {
+		_spec.AddField(oncallpage.FieldStep, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Round(); ok {
+		_spec.SetField(oncallpage.FieldRound, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRound(); ok {
+		_spec.AddField(oncallpage.FieldRound, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NotifiedUserIds(); ok {
+		_spec.SetField(oncallpage.FieldNotifiedUserIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedNotifiedUserIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oncallpage.FieldNotifiedUserIds, value)
+		})
+	}
+	if _u.mutation.NotifiedUserIdsCleared() {
+		_spec.ClearField(oncallpage.FieldNotifiedUserIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.NextEscalationAt(); ok {
+		_spec.SetField(oncallpage.FieldNextEscalationAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextEscalationAtCleared() {
+		_spec.ClearField(oncallpage.FieldNextEscalationAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AcknowledgedBy(); ok {
+		_spec.SetField(oncallpage.FieldAcknowledgedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAcknowledgedBy(); ok {
+		_spec.AddField(oncallpage.FieldAcknowledgedBy, field.TypeInt, value)
+	}
+	if _u.mutation.AcknowledgedByCleared() {
+		_spec.ClearField(oncallpage.FieldAcknowledgedBy, field.TypeInt)
+	}
+	if value, ok := _u.mutation.AcknowledgedAt(); ok {
+		_spec.SetField(oncallpage.FieldAcknowledgedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AcknowledgedAtCleared() {
+		_spec.ClearField(oncallpage.FieldAcknowledgedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(oncallpage.FieldCreatedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(oncallpage.FieldCreatedBy, field.TypeInt, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(oncallpage.FieldCreatedBy, field.TypeInt)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(oncallpage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oncallpage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OnCallPageUpdateOne is the builder for updating a single OnCallPage entity.
+type OnCallPageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OnCallPageMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *OnCallPageUpdateOne) SetTenantID(v int) *OnCallPageUpdateOne {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *OnCallPageUpdateOne) SetNillableTenantID(v *int) *OnCallPageUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *OnCallPageUpdateOne) AddTenantID(v int) *OnCallPageUpdateOne {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetPolicyID sets the "policy_id" field.
+func (_u *OnCallPageUpdateOne) SetPolicyID(v int) *OnCallPageUpdateOne {
+	_u.mutation.ResetPolicyID()
+	_u.mutation.SetPolicyID(v)
+	return _u
+}
+
+// SetNillablePolicyID sets the "policy_id" field if the given value is not nil.
+func (_u *OnCallPageUpdateOne) SetNillablePolicyID(v *int) *OnCallPageUpdateOne {
+	if v != nil {
+		_u.SetPolicyID(*v)
+	}
+	return _u
+}
+
+// AddPolicyID adds value to the "policy_id" field.
+func (_u *OnCallPageUpdateOne) AddPolicyID(v int) *OnCallPageUpdateOne {
+	_u.mutation.AddPolicyID(v)
+	return _u
+}
+
+// SetIncidentID sets the "incident_id" field.
+func (_u *OnCallPageUpdateOne) SetIncidentID(v int) *OnCallPageUpdateOne {
+	_u.mutation.ResetIncidentID()
+	_u.mutation.SetIncidentID(v)
+	return _u
+}
+
+// SetNillableIncidentID sets the "incident_id" field if the given value is not nil.
+func (_u *OnCallPageUpdateOne) SetNillableIncidentID(v *int) *OnCallPageUpdateOne {
+	if v != nil {
+		_u.SetIncidentID(*v)
+	}
+	return _u
+}
+
+// AddIncidentID adds value to the "incident_id" field.
+func (_u *OnCallPageUpdateOne) AddIncidentID(v int) *OnCallPageUpdateOne {
+	_u.mutation.AddIncidentID(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *OnCallPageUpdateOne) SetStatus(v oncallpage.Status) *OnCallPageUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *OnCallPageUpdateOne) SetNillableStatus(v *oncallpage.Status) *OnCallPageUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetStep sets the "step" field.
+func (_u *OnCallPageUpdateOne) SetStep(v int) *OnCallPageUpdateOne {
+	_u.mutation.ResetStep()
+	_u.mutation.SetStep(v)
+	return _u
+}
+
+// SetNillableStep sets the "step" field if the given value is not nil.
+func (_u *OnCallPageUpdateOne) SetNillableStep(v *int) *OnCallPageUpdateOne {
+	if v != nil {
+		_u.SetStep(*v)
+	}
+	return _u
+}
+
+// AddStep adds value to the "step" field.
+func (_u *OnCallPageUpdateOne) AddStep(v int) *OnCallPageUpdateOne {
+	_u.mutation.AddStep(v)
+	return _u
+}
+
+// SetRound sets the "round" field.
+func (_u *OnCallPageUpdateOne) SetRound(v int) *OnCallPageUpdateOne {
+	_u.mutation.ResetRound()
+	_u.mutation.SetRound(v)
+	return _u
+}
+
+// SetNillableRound sets the "round" field if the given value is not nil.
+func (_u *OnCallPageUpdateOne) SetNillableRound(v *int) *OnCallPageUpdateOne {
+	if v != nil {
+		_u.SetRound(*v)
+	}
+	return _u
+}
+
+// AddRound adds value to the "round" field.
+func (_u *OnCallPageUpdateOne) AddRound(v int) *OnCallPageUpdateOne {
+	_u.mutation.AddRound(v)
+	return _u
+}
+
+// SetNotifiedUserIds sets the "notified_user_ids" field.
+func (_u *OnCallPageUpdateOne) SetNotifiedUserIds(v []int) *OnCallPageUpdateOne {
+	_u.mutation.SetNotifiedUserIds(v)
+	return _u
+}
+
+// AppendNotifiedUserIds appends value to the "notified_user_ids" field.
+func (_u *OnCallPageUpdateOne) AppendNotifiedUserIds(v []int) *OnCallPageUpdateOne {
+	_u.mutation.AppendNotifiedUserIds(v)
+	return _u
+}
+
+// ClearNotifiedUserIds clears the value of the "notified_user_ids" field.
+func (_u *OnCallPageUpdateOne) ClearNotifiedUserIds() *OnCallPageUpdateOne {
+	_u.mutation.ClearNotifiedUserIds()
+	return _u
+}
+
+// SetNextEscalationAt sets the "next_escalation_at" field.
+func (_u *OnCallPageUpdateOne) SetNextEscalationAt(v time.Time) *OnCallPageUpdateOne {
+	_u.mutation.SetNextEscalationAt(v)
+	return _u
+}
+
+// SetNillableNextEscalationAt sets the "next_escalation_at" field if the given value is not nil.
+func (_u *OnCallPageUpdateOne) SetNillableNextEscalationAt(v *time.Time) *OnCallPageUpdateOne {
+	if v != nil {
+		_u.SetNextEscalationAt(*v)
+	}
+	return _u
+}
+
+// ClearNextEscalationAt clears the value of the "next_escalation_at" field.
+func (_u *OnCallPageUpdateOne) ClearNextEscalationAt() *OnCallPageUpdateOne {
+	_u.mutation.ClearNextEscalationAt()
+	return _u
+}
+
+// SetAcknowledgedBy sets the "acknowledged_by" field.
+func (_u *OnCallPageUpdateOne) SetAcknowledgedBy(v int) *OnCallPageUpdateOne {
+	_u.mutation.ResetAcknowledgedBy()
+	_u.mutation.SetAcknowledgedBy(v)
+	return _u
+}
+
+// SetNillableAcknowledgedBy sets the "acknowledged_by" field if the given value is not nil.
+func (_u *OnCallPageUpdateOne) SetNillableAcknowledgedBy(v *int) *OnCallPageUpdateOne {
+	if v != nil {
+		_u.SetAcknowledgedBy(*v)
+	}
+	return _u
+}
+
+// AddAcknowledgedBy adds value to the "acknowledged_by" field.
+func (_u *OnCallPageUpdateOne) AddAcknowledgedBy(v int) *OnCallPageUpdateOne {
+	_u.mutation.AddAcknowledgedBy(v)
+	return _u
+}
+
+// ClearAcknowledgedBy clears the value of the "acknowledged_by" field.
+func (_u *OnCallPageUpdateOne) ClearAcknowledgedBy() *OnCallPageUpdateOne {
+	_u.mutation.ClearAcknowledgedBy()
+	return _u
+}
+
+// SetAcknowledgedAt sets the "acknowledged_at" field.
+func (_u *OnCallPageUpdateOne) SetAcknowledgedAt(v time.Time) *OnCallPageUpdateOne {
+	_u.mutation.SetAcknowledgedAt(v)
+	return _u
+}
+
+// SetNillableAcknowledgedAt sets the "acknowledged_at" field if the given value is not nil.
+func (_u *OnCallPageUpdateOne) SetNillableAcknowledgedAt(v *time.Time) *OnCallPageUpdateOne {
+	if v != nil {
+		_u.SetAcknowledgedAt(*v)
+	}
+	return _u
+}
+
+// ClearAcknowledgedAt clears the value of the "acknowledged_at" field.
+func (_u *OnCallPageUpdateOne) ClearAcknowledgedAt() *OnCallPageUpdateOne {
+	_u.mutation.ClearAcknowledgedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *OnCallPageUpdateOne) SetCreatedBy(v int) *OnCallPageUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *OnCallPageUpdateOne) SetNillableCreatedBy(v *int) *OnCallPageUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *OnCallPageUpdateOne) AddCreatedBy(v int) *OnCallPageUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *OnCallPageUpdateOne) ClearCreatedBy() *OnCallPageUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OnCallPageUpdateOne) SetUpdatedAt(v time.Time) *OnCallPageUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the OnCallPageMutation object of the builder.
+func (_u *OnCallPageUpdateOne) Mutation() *OnCallPageMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the OnCallPageUpdate builder.
+func (_u *OnCallPageUpdateOne) Where(ps ...predicate.OnCallPage) *OnCallPageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OnCallPageUpdateOne) Select(field string, fields ...string) *OnCallPageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OnCallPage entity.
+func (_u *OnCallPageUpdateOne) Save(ctx context.Context) (*OnCallPage, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OnCallPageUpdateOne) SaveX(ctx context.Context) *OnCallPage {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OnCallPageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OnCallPageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OnCallPageUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := oncallpage.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OnCallPageUpdateOne) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := oncallpage.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "OnCallPage.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PolicyID(); ok {
+		if err := oncallpage.PolicyIDValidator(v); err != nil {
+			return &ValidationError{Name: "policy_id", err: fmt.Errorf(`ent: validator failed for field "OnCallPage.policy_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IncidentID(); ok {
+		if err := oncallpage.IncidentIDValidator(v); err != nil {
+			return &ValidationError{Name: "incident_id", err: fmt.Errorf(`ent: validator failed for field "OnCallPage.incident_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := oncallpage.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "OnCallPage.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Step(); ok {
+		if err := oncallpage.StepValidator(v); err != nil {
+			return &ValidationError{Name: "step", err: fmt.Errorf(`ent: validator failed for field "OnCallPage.step": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Round(); ok {
+		if err := oncallpage.RoundValidator(v); err != nil {
+			return &ValidationError{Name: "round", err: fmt.Errorf(`ent: validator failed for field "OnCallPage.round": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *OnCallPageUpdateOne) sqlSave(ctx context.Context) (_node *OnCallPage, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oncallpage.Table, oncallpage.Columns, sqlgraph.NewFieldSpec(oncallpage.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OnCallPage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oncallpage.FieldID)
+		for _, f := range fields {
+			if !oncallpage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != oncallpage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(oncallpage.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(oncallpage.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PolicyID(); ok {
+		_spec.SetField(oncallpage.FieldPolicyID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPolicyID(); ok {
+		_spec.AddField(oncallpage.FieldPolicyID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IncidentID(); ok {
+		_spec.SetField(oncallpage.FieldIncidentID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedIncidentID(); ok {
+		_spec.AddField(oncallpage.FieldIncidentID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(oncallpage.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Step(); ok {
+		_spec.SetField(oncallpage.FieldStep, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStep(); ok {
+		_spec.AddField(oncallpage.FieldStep, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Round(); ok {
+		_spec.SetField(oncallpage.FieldRound, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRound(); ok {
+		_spec.AddField(oncallpage.FieldRound, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NotifiedUserIds(); ok {
+		_spec.SetField(oncallpage.FieldNotifiedUserIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedNotifiedUserIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oncallpage.FieldNotifiedUserIds, value)
+		})
+	}
+	if _u.mutation.NotifiedUserIdsCleared() {
+		_spec.ClearField(oncallpage.FieldNotifiedUserIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.NextEscalationAt(); ok {
+		_spec.SetField(oncallpage.FieldNextEscalationAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextEscalationAtCleared() {
+		_spec.ClearField(oncallpage.FieldNextEscalationAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AcknowledgedBy(); ok {
+		_spec.SetField(oncallpage.FieldAcknowledgedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAcknowledgedBy(); ok {
+		_spec.AddField(oncallpage.FieldAcknowledgedBy, field.TypeInt, value)
+	}
+	if _u.mutation.AcknowledgedByCleared() {
+		_spec.ClearField(oncallpage.FieldAcknowledgedBy, field.TypeInt)
+	}
+	if value, ok := _u.mutation.AcknowledgedAt(); ok {
+		_spec.SetField(oncallpage.FieldAcknowledgedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AcknowledgedAtCleared() {
+		_spec.ClearField(oncallpage.FieldAcknowledgedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(oncallpage.FieldCreatedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(oncallpage.FieldCreatedBy, field.TypeInt, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(oncallpage.FieldCreatedBy, field.TypeInt)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(oncallpage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &OnCallPage{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oncallpage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,642 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/oncallschedule"
+	"itsm-backend/ent/predicate"
+	"itsm-backend/ent/schema"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// OnCallScheduleUpdate is the builder for updating OnCallSchedule entities.
+type OnCallScheduleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OnCallScheduleMutation
+}
+
+// Where appends a list predicates to the OnCallScheduleUpdate builder.
+func (_u *OnCallScheduleUpdate) Where(ps ...predicate.OnCallSchedule) *OnCallScheduleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *OnCallScheduleUpdate) SetTenantID(v int) *OnCallScheduleUpdate {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *OnCallScheduleUpdate) SetNillableTenantID(v *int) *OnCallScheduleUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *OnCallScheduleUpdate) AddTenantID(v int) *OnCallScheduleUpdate {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *OnCallScheduleUpdate) SetName(v string) *OnCallScheduleUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *OnCallScheduleUpdate) SetNillableName(v *string) *OnCallScheduleUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *OnCallScheduleUpdate) SetDescription(v string) *OnCallScheduleUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *OnCallScheduleUpdate) SetNillableDescription(v *string) *OnCallScheduleUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *OnCallScheduleUpdate) ClearDescription() *OnCallScheduleUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetTeamID sets the "team_id" field.
+func (_u *OnCallScheduleUpdate) SetTeamID(v int) *OnCallScheduleUpdate {
+	_u.mutation.ResetTeamID()
+	_u.mutation.SetTeamID(v)
+	return _u
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (_u *OnCallScheduleUpdate) SetNillableTeamID(v *int) *OnCallScheduleUpdate {
+	if v != nil {
+		_u.SetTeamID(*v)
+	}
+	return _u
+}
+
+// AddTeamID adds value to the "team_id" field.
+func (_u *OnCallScheduleUpdate) AddTeamID(v int) *OnCallScheduleUpdate {
+	_u.mutation.AddTeamID(v)
+	return _u
+}
+
+// ClearTeamID clears the value of the "team_id" field.
+func (_u *OnCallScheduleUpdate) ClearTeamID() *OnCallScheduleUpdate {
+	_u.mutation.ClearTeamID()
+	return _u
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (_u *OnCallScheduleUpdate) SetTimeZone(v string) *OnCallScheduleUpdate {
+	_u.mutation.SetTimeZone(v)
+	return _u
+}
+
+// SetNillableTimeZone sets the "time_zone" field if the given value is not nil.
+func (_u *OnCallScheduleUpdate) SetNillableTimeZone(v *string) *OnCallScheduleUpdate {
+	if v != nil {
+		_u.SetTimeZone(*v)
+	}
+	return _u
+}
+
+// SetLayers sets the "layers" field.
+func (_u *OnCallScheduleUpdate) SetLayers(v []schema.OnCallLayer) *OnCallScheduleUpdate {
+	_u.mutation.SetLayers(v)
+	return _u
+}
+
+// AppendLayers appends value to the "layers" field.
+func (_u *OnCallScheduleUpdate) AppendLayers(v []schema.OnCallLayer) *OnCallScheduleUpdate {
+	_u.mutation.AppendLayers(v)
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *OnCallScheduleUpdate) SetEnabled(v bool) *OnCallScheduleUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *OnCallScheduleUpdate) SetNillableEnabled(v *bool) *OnCallScheduleUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *OnCallScheduleUpdate) SetCreatedBy(v int) *OnCallScheduleUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *OnCallScheduleUpdate) SetNillableCreatedBy(v *int) *OnCallScheduleUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *OnCallScheduleUpdate) AddCreatedBy(v int) *OnCallScheduleUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *OnCallScheduleUpdate) ClearCreatedBy() *OnCallScheduleUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OnCallScheduleUpdate) SetUpdatedAt(v time.Time) *OnCallScheduleUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the OnCallScheduleMutation object of the builder.
+func (_u *OnCallScheduleUpdate) Mutation() *OnCallScheduleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OnCallScheduleUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OnCallScheduleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OnCallScheduleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OnCallScheduleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OnCallScheduleUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := oncallschedule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OnCallScheduleUpdate) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := oncallschedule.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "OnCallSchedule.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := oncallschedule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OnCallSchedule.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *OnCallScheduleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oncallschedule.Table, oncallschedule.Columns, sqlgraph.NewFieldSpec(oncallschedule.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(oncallschedule.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(oncallschedule.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(oncallschedule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(oncallschedule.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(oncallschedule.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.TeamID(); ok {
+		_spec.SetField(oncallschedule.FieldTeamID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTeamID(); ok {
+		_spec.AddField(oncallschedule.FieldTeamID, field.TypeInt, value)
+	}
+	if _u.mutation.TeamIDCleared() {
+		_spec.ClearField(oncallschedule.FieldTeamID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.TimeZone(); ok {
+		_spec.SetField(oncallschedule.FieldTimeZone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Layers(); ok {
+		_spec.SetField(oncallschedule.FieldLayers, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLayers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oncallschedule.FieldLayers, value)
+		})
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(oncallschedule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(oncallschedule.FieldCreatedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(oncallschedule.FieldCreatedBy, field.TypeInt, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(oncallschedule.FieldCreatedBy, field.TypeInt)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(oncallschedule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oncallschedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OnCallScheduleUpdateOne is the builder for updating a single OnCallSchedule entity.
+type OnCallScheduleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OnCallScheduleMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *OnCallScheduleUpdateOne) SetTenantID(v int) *OnCallScheduleUpdateOne {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *OnCallScheduleUpdateOne) SetNillableTenantID(v *int) *OnCallScheduleUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *OnCallScheduleUpdateOne) AddTenantID(v int) *OnCallScheduleUpdateOne {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *OnCallScheduleUpdateOne) SetName(v string) *OnCallScheduleUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *OnCallScheduleUpdateOne) SetNillableName(v *string) *OnCallScheduleUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *OnCallScheduleUpdateOne) SetDescription(v string) *OnCallScheduleUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *OnCallScheduleUpdateOne) SetNillableDescription(v *string) *OnCallScheduleUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *OnCallScheduleUpdateOne) ClearDescription() *OnCallScheduleUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetTeamID sets the "team_id" field.
+func (_u *OnCallScheduleUpdateOne) SetTeamID(v int) *OnCallScheduleUpdateOne {
+	_u.mutation.ResetTeamID()
+	_u.mutation.SetTeamID(v)
+	return _u
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (_u *OnCallScheduleUpdateOne) SetNillableTeamID(v *int) *OnCallScheduleUpdateOne {
+	if v != nil {
+		_u.SetTeamID(*v)
+	}
+	return _u
+}
+
+// AddTeamID adds value to the "team_id" field.
+func (_u *OnCallScheduleUpdateOne) AddTeamID(v int) *OnCallScheduleUpdateOne {
+	_u.mutation.AddTeamID(v)
+	return _u
+}
+
+// ClearTeamID clears the value of the "team_id" field.
+func (_u *OnCallScheduleUpdateOne) ClearTeamID() *OnCallScheduleUpdateOne {
+	_u.mutation.ClearTeamID()
+	return _u
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (_u *OnCallScheduleUpdateOne) SetTimeZone(v string) *OnCallScheduleUpdateOne {
+	_u.mutation.SetTimeZone(v)
+	return _u
+}
+
+// SetNillableTimeZone sets the "time_zone" field if the given value is not nil.
+func (_u *OnCallScheduleUpdateOne) SetNillableTimeZone(v *string) *OnCallScheduleUpdateOne {
+	if v != nil {
+		_u.SetTimeZone(*v)
+	}
+	return _u
+}
+
+// SetLayers sets the "layers" field.
+func (_u *OnCallScheduleUpdateOne) SetLayers(v []schema.OnCallLayer) *OnCallScheduleUpdateOne {
+	_u.mutation.SetLayers(v)
+	return _u
+}
+
+// AppendLayers appends value to the "layers" field.
+func (_u *OnCallScheduleUpdateOne) AppendLayers(v []schema.OnCallLayer) *OnCallScheduleUpdateOne {
+	_u.mutation.AppendLayers(v)
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *OnCallScheduleUpdateOne) SetEnabled(v bool) *OnCallScheduleUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *OnCallScheduleUpdateOne) SetNillableEnabled(v *bool) *OnCallScheduleUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *OnCallScheduleUpdateOne) SetCreatedBy(v int) *OnCallScheduleUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *OnCallScheduleUpdateOne) SetNillableCreatedBy(v *int) *OnCallScheduleUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *OnCallScheduleUpdateOne) AddCreatedBy(v int) *OnCallScheduleUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *OnCallScheduleUpdateOne) ClearCreatedBy() *OnCallScheduleUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OnCallScheduleUpdateOne) SetUpdatedAt(v time.Time) *OnCallScheduleUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the OnCallScheduleMutation object of the builder.
+func (_u *OnCallScheduleUpdateOne) Mutation() *OnCallScheduleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the OnCallScheduleUpdate builder.
+func (_u *OnCallScheduleUpdateOne) Where(ps ...predicate.OnCallSchedule) *OnCallScheduleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OnCallScheduleUpdateOne) Select(field string, fields ...string) *OnCallScheduleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OnCallSchedule entity.
+func (_u *OnCallScheduleUpdateOne) Save(ctx context.Context) (*OnCallSchedule, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OnCallScheduleUpdateOne) SaveX(ctx context.Context) *OnCallSchedule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OnCallScheduleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OnCallScheduleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OnCallScheduleUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := oncallschedule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OnCallScheduleUpdateOne) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := oncallschedule.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "OnCallSchedule.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := oncallschedule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OnCallSchedule.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *OnCallScheduleUpdateOne) sqlSave(ctx context.Context) (_node *OnCallSchedule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oncallschedule.Table, oncallschedule.Columns, sqlgraph.NewFieldSpec(oncallschedule.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OnCallSchedule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oncallschedule.FieldID)
+		for _, f := range fields {
+			if !oncallschedule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != oncallschedule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(oncallschedule.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(oncallschedule.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(oncallschedule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(oncallschedule.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(oncallschedule.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.TeamID(); ok {
+		_spec.SetField(oncallschedule.FieldTeamID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTeamID(); ok {
+		_spec.AddField(oncallschedule.FieldTeamID, field.TypeInt, value)
+	}
+	if _u.mutation.TeamIDCleared() {
+		_spec.ClearField(oncallschedule.FieldTeamID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.TimeZone(); ok {
+		_spec.SetField(oncallschedule.FieldTimeZone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Layers(); ok {
+		_spec.SetField(oncallschedule.FieldLayers, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLayers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oncallschedule.FieldLayers, value)
+		})
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(oncallschedule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(oncallschedule.FieldCreatedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(oncallschedule.FieldCreatedBy, field.TypeInt, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(oncallschedule.FieldCreatedBy, field.TypeInt)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(oncallschedule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &OnCallSchedule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oncallschedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -6,6 +6,9 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AlertSuppressionRule is the predicate function for alertsuppressionrule builders.
+type AlertSuppressionRule func(*sql.Selector)
+
 // Application is the predicate function for application builders.
 type Application func(*sql.Selector)
 
@@ -33,6 +36,9 @@ type BPMNPermission func(*sql.Selector)
 // BootstrapToken is the predicate function for bootstraptoken builders.
 type BootstrapToken func(*sql.Selector)
 
+// BusinessCalendar is the predicate function for businesscalendar builders.
+type BusinessCalendar func(*sql.Selector)
+
 // CABMember is the predicate function for cabmember builders.
 type CABMember func(*sql.Selector)
 
@@ -84,6 +90,9 @@ type Contract func(*sql.Selector)
 // Conversation is the predicate function for conversation builders.
 type Conversation func(*sql.Selector)
 
+// DecisionDefinition is the predicate function for decisiondefinition builders.
+type DecisionDefinition func(*sql.Selector)
+
 // Department is the predicate function for department builders.
 type Department func(*sql.Selector)
 
@@ -105,12 +114,24 @@ type EndpointACL func(*sql.Selector)
 // EngineerSkill is the predicate function for engineerskill builders.
 type EngineerSkill func(*sql.Selector)
 
+// EscalationPolicy is the predicate function for escalationpolicy builders.
+type EscalationPolicy func(*sql.Selector)
+
 // FeishuTicketSync is the predicate function for feishuticketsync builders.
 type FeishuTicketSync func(*sql.Selector)
 
 // Group is the predicate function for group builders.
 type Group func(*sql.Selector)
 
+// InboundDedupKey is the predicate function for inbounddedupkey builders.
+type InboundDedupKey func(*sql.Selector)
+
+// InboundEmail is the predicate function for inboundemail builders.
+type InboundEmail func(*sql.Selector)
+
+// InboundMessageLog is the predicate function for inboundmessagelog builders.
+type InboundMessageLog func(*sql.Selector)
+
 // Incident is the predicate function for incident builders.
 type Incident func(*sql.Selector)
 
@@ -156,6 +177,9 @@ type KnownError func(*sql.Selector)
 // MSPAllocation is the predicate function for mspallocation builders.
 type MSPAllocation func(*sql.Selector)
 
+// MajorIncident is the predicate function for majorincident builders.
+type MajorIncident func(*sql.Selector)
+
 // MarketplaceItem is the predicate function for marketplaceitem builders.
 type MarketplaceItem func(*sql.Selector)
 
@@ -168,6 +192,9 @@ type Message func(*sql.Selector)
 // Microservice is the predicate function for microservice builders.
 type Microservice func(*sql.Selector)
 
+// MonitoringAlert is the predicate function for monitoringalert builders.
+type MonitoringAlert func(*sql.Selector)
+
 // Notification is the predicate function for notification builders.
 type Notification func(*sql.Selector)
 
@@ -177,6 +204,15 @@ type NotificationDelivery func(*sql.Selector)
 // NotificationPreference is the predicate function for notificationpreference builders.
 type NotificationPreference func(*sql.Selector)
 
+// OnCallOverride is the predicate function for oncalloverride builders.
+type OnCallOverride func(*sql.Selector)
+
+// OnCallPage is the predicate function for oncallpage builders.
+type OnCallPage func(*sql.Selector)
+
+// OnCallSchedule is the predicate function for oncallschedule builders.
+type OnCallSchedule func(*sql.Selector)
+
 // OperationalCommand is the predicate function for operationalcommand builders.
 type OperationalCommand func(*sql.Selector)
 
@@ -207,9 +243,18 @@ type ProcessDefinition func(*sql.Selector)
 // ProcessDeployment is the predicate function for processdeployment builders.
 type ProcessDeployment func(*sql.Selector)
 
+// ProcessEventInstance is the predicate function for processeventinstance builders.
+type ProcessEventInstance func(*sql.Selector)
+
+// ProcessEventSubscription is the predicate function for processeventsubscription builders.
+type ProcessEventSubscription func(*sql.Selector)
+
 // ProcessExecutionHistory is the predicate function for processexecutionhistory builders.
 type ProcessExecutionHistory func(*sql.Selector)
 
+// ProcessIncident is the predicate function for processincident builders.
+type ProcessIncident func(*sql.Selector)
+
 // ProcessInstance is the predicate function for processinstance builders.
 type ProcessInstance func(*sql.Selector)
 
@@ -264,6 +309,9 @@ type SLAPolicy func(*sql.Selector)
 // SLAViolation is the predicate function for slaviolation builders.
 type SLAViolation func(*sql.Selector)
 
+// SearchDocument is the predicate function for searchdocument builders.
+type SearchDocument func(*sql.Selector)
+
 // ServiceCatalog is the predicate function for servicecatalog builders.
 type ServiceCatalog func(*sql.Selector)
 
@@ -327,6 +375,18 @@ type TicketComment func(*sql.Selector)
 // TicketNotification is the predicate function for ticketnotification builders.
 type TicketNotification func(*sql.Selector)
 
+// TicketSLAMetric is the predicate function for ticketslametric builders.
+type TicketSLAMetric func(*sql.Selector)
+
+// TicketSLAPause is the predicate function for ticketslapause builders.
+type TicketSLAPause func(*sql.Selector)
+
+// TicketSchedule is the predicate function for ticketschedule builders.
+type TicketSchedule func(*sql.Selector)
+
+// TicketScheduleRun is the predicate function for ticketschedulerun builders.
+type TicketScheduleRun func(*sql.Selector)
+
 // TicketTag is the predicate function for tickettag builders.
 type TicketTag func(*sql.Selector)
 
@@ -351,6 +411,9 @@ type User func(*sql.Selector)
 // Vendor is the predicate function for vendor builders.
 type Vendor func(*sql.Selector)
 
+// WorkLog is the predicate function for worklog builders.
+type WorkLog func(*sql.Selector)
+
 // Workflow is the predicate function for workflow builders.
 type Workflow func(*sql.Selector)
 
@@ -0,0 +1,298 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"itsm-backend/ent/processeventinstance"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ProcessEventInstance is the model entity for the ProcessEventInstance schema.
+type ProcessEventInstance struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 事件实例ID
+	EventInstanceID string `json:"event_instance_id,omitempty"`
+	// 租户ID
+	TenantID int `json:"tenant_id,omitempty"`
+	// 事件定义ID（BPMN元素ID，消息/信号广播时为名称）
+	EventDefinitionID string `json:"event_definition_id,omitempty"`
+	// 事件类型：start, intermediate, boundary, end
+	EventType string `json:"event_type,omitempty"`
+	// 触发器：message, signal, timer, error 等
+	Trigger string `json:"trigger,omitempty"`
+	// 消息名称或信号名称
+	EventName string `json:"event_name,omitempty"`
+	// 流程实例业务ID（PI-...）
+	ProcessInstanceID string `json:"process_instance_id,omitempty"`
+	// 命中的事件订阅ID
+	SubscriptionID *int `json:"subscription_id,omitempty"`
+	// 关联键
+	CorrelationKeys map[string]interface{} `json:"correlation_keys,omitempty"`
+	// 随事件传入的变量
+	Variables map[string]interface{} `json:"variables,omitempty"`
+	// 状态：pending, triggered, completed, failed
+	Status string `json:"status,omitempty"`
+	// 错误信息
+	Error string `json:"error,omitempty"`
+	// 重试次数
+	RetryCount int `json:"retry_count,omitempty"`
+	// 触发时间
+	TriggeredAt *time.Time `json:"triggered_at,omitempty"`
+	// 完成时间
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProcessEventInstance) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case processeventinstance.FieldCorrelationKeys, processeventinstance.FieldVariables:
+			values[i] = new([]byte)
+		case processeventinstance.FieldID, processeventinstance.FieldTenantID, processeventinstance.FieldSubscriptionID, processeventinstance.FieldRetryCount:
+			values[i] = new(sql.NullInt64)
+		case processeventinstance.FieldEventInstanceID, processeventinstance.FieldEventDefinitionID, processeventinstance.FieldEventType, processeventinstance.FieldTrigger, processeventinstance.FieldEventName, processeventinstance.FieldProcessInstanceID, processeventinstance.FieldStatus, processeventinstance.FieldError:
+			values[i] = new(sql.NullString)
+		case processeventinstance.FieldTriggeredAt, processeventinstance.FieldCompletedAt, processeventinstance.FieldCreatedAt, processeventinstance.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProcessEventInstance fields.
+func (_m *ProcessEventInstance) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case processeventinstance.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case processeventinstance.FieldEventInstanceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_instance_id", values[i])
+			} else if value.Valid {
+				_m.EventInstanceID = value.String
+			}
+		case processeventinstance.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = int(value.Int64)
+			}
+		case processeventinstance.FieldEventDefinitionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_definition_id", values[i])
+			} else if value.Valid {
+				_m.EventDefinitionID = value.String
+			}
+		case processeventinstance.FieldEventType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_type", values[i])
+			} else if value.Valid {
+				_m.EventType = value.String
+			}
+		case processeventinstance.FieldTrigger:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trigger", values[i])
+			} else if value.Valid {
+				_m.Trigger = value.String
+			}
+		case processeventinstance.FieldEventName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_name", values[i])
+			} else if value.Valid {
+				_m.EventName = value.String
+			}
+		case processeventinstance.FieldProcessInstanceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field process_instance_id", values[i])
+			} else if value.Valid {
+				_m.ProcessInstanceID = value.String
+			}
+		case processeventinstance.FieldSubscriptionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field subscription_id", values[i])
+			} else if value.Valid {
+				_m.SubscriptionID = new(int)
+				*_m.SubscriptionID = int(value.Int64)
+			}
+		case processeventinstance.FieldCorrelationKeys:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field correlation_keys", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.CorrelationKeys); err != nil {
+					return fmt.Errorf("unmarshal field correlation_keys: %w", err)
+				}
+			}
+		case processeventinstance.FieldVariables:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field variables", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Variables); err != nil {
+					return fmt.Errorf("unmarshal field variables: %w", err)
+				}
+			}
+		case processeventinstance.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case processeventinstance.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				_m.Error = value.String
+			}
+		case processeventinstance.FieldRetryCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field retry_count", values[i])
+			} else if value.Valid {
+				_m.RetryCount = int(value.Int64)
+			}
+		case processeventinstance.FieldTriggeredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field triggered_at", values[i])
+			} else if value.Valid {
+				_m.TriggeredAt = new(time.Time)
+				*_m.TriggeredAt = value.Time
+			}
+		case processeventinstance.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				_m.CompletedAt = new(time.Time)
+				*_m.CompletedAt = value.Time
+			}
+		case processeventinstance.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case processeventinstance.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProcessEventInstance.
+// This includes values selected through modifiers, order, etc.
+func (_m *ProcessEventInstance) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ProcessEventInstance.
+// Note that you need to call ProcessEventInstance.Unwrap() before calling this method if this ProcessEventInstance
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ProcessEventInstance) Update() *ProcessEventInstanceUpdateOne {
+	return NewProcessEventInstanceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ProcessEventInstance entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ProcessEventInstance) Unwrap() *ProcessEventInstance {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProcessEventInstance is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ProcessEventInstance) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProcessEventInstance(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("event_instance_id=")
+	builder.WriteString(_m.EventInstanceID)
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("event_definition_id=")
+	builder.WriteString(_m.EventDefinitionID)
+	builder.WriteString(", ")
+	builder.WriteString("event_type=")
+	builder.WriteString(_m.EventType)
+	builder.WriteString(", ")
+	builder.WriteString("trigger=")
+	builder.WriteString(_m.Trigger)
+	builder.WriteString(", ")
+	builder.WriteString("event_name=")
+	builder.WriteString(_m.EventName)
+	builder.WriteString(", ")
+	builder.WriteString("process_instance_id=")
+	builder.WriteString(_m.ProcessInstanceID)
+	builder.WriteString(", ")
+	if v := _m.SubscriptionID; v != nil {
+		builder.WriteString("subscription_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("correlation_keys=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CorrelationKeys))
+	builder.WriteString(", ")
+	builder.WriteString("variables=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Variables))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(_m.Error)
+	builder.WriteString(", ")
+	builder.WriteString("retry_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RetryCount))
+	builder.WriteString(", ")
+	if v := _m.TriggeredAt; v != nil {
+		builder.WriteString("triggered_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProcessEventInstances is a parsable slice of ProcessEventInstance.
+type ProcessEventInstances []*ProcessEventInstance
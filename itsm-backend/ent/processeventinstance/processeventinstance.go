@@ -0,0 +1,198 @@
+// Code generated by ent, DO NOT EDIT.
+
+package processeventinstance
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the processeventinstance type in the database.
+	Label = "process_event_instance"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldEventInstanceID holds the string denoting the event_instance_id field in the database.
+	FieldEventInstanceID = "event_instance_id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldEventDefinitionID holds the string denoting the event_definition_id field in the database.
+	FieldEventDefinitionID = "event_definition_id"
+	// FieldEventType holds the string denoting the event_type field in the database.
+	FieldEventType = "event_type"
+	// FieldTrigger holds the string denoting the trigger field in the database.
+	FieldTrigger = "trigger"
+	// FieldEventName holds the string denoting the event_name field in the database.
+	FieldEventName = "event_name"
+	// FieldProcessInstanceID holds the string denoting the process_instance_id field in the database.
+	FieldProcessInstanceID = "process_instance_id"
+	// FieldSubscriptionID holds the string denoting the subscription_id field in the database.
+	FieldSubscriptionID = "subscription_id"
+	// FieldCorrelationKeys holds the string denoting the correlation_keys field in the database.
+	FieldCorrelationKeys = "correlation_keys"
+	// FieldVariables holds the string denoting the variables field in the database.
+	FieldVariables = "variables"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldRetryCount holds the string denoting the retry_count field in the database.
+	FieldRetryCount = "retry_count"
+	// FieldTriggeredAt holds the string denoting the triggered_at field in the database.
+	FieldTriggeredAt = "triggered_at"
+	// FieldCompletedAt holds the string denoting the completed_at field in the database.
+	FieldCompletedAt = "completed_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the processeventinstance in the database.
+	Table = "process_event_instances"
+)
+
+// Columns holds all SQL columns for processeventinstance fields.
+var Columns = []string{
+	FieldID,
+	FieldEventInstanceID,
+	FieldTenantID,
+	FieldEventDefinitionID,
+	FieldEventType,
+	FieldTrigger,
+	FieldEventName,
+	FieldProcessInstanceID,
+	FieldSubscriptionID,
+	FieldCorrelationKeys,
+	FieldVariables,
+	FieldStatus,
+	FieldError,
+	FieldRetryCount,
+	FieldTriggeredAt,
+	FieldCompletedAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// EventInstanceIDValidator is a validator for the "event_instance_id" field. It is called by the builders before save.
+	EventInstanceIDValidator func(string) error
+	// TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	TenantIDValidator func(int) error
+	// EventDefinitionIDValidator is a validator for the "event_definition_id" field. It is called by the builders before save.
+	EventDefinitionIDValidator func(string) error
+	// EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	EventTypeValidator func(string) error
+	// TriggerValidator is a validator for the "trigger" field. It is called by the builders before save.
+	TriggerValidator func(string) error
+	// EventNameValidator is a validator for the "event_name" field. It is called by the builders before save.
+	EventNameValidator func(string) error
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	StatusValidator func(string) error
+	// ErrorValidator is a validator for the "error" field. It is called by the builders before save.
+	ErrorValidator func(string) error
+	// DefaultRetryCount holds the default value on creation for the "retry_count" field.
+	DefaultRetryCount int
+	// RetryCountValidator is a validator for the "retry_count" field. It is called by the builders before save.
+	RetryCountValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the ProcessEventInstance queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByEventInstanceID orders the results by the event_instance_id field.
+func ByEventInstanceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventInstanceID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByEventDefinitionID orders the results by the event_definition_id field.
+func ByEventDefinitionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventDefinitionID, opts...).ToFunc()
+}
+
+// ByEventType orders the results by the event_type field.
+func ByEventType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventType, opts...).ToFunc()
+}
+
+// ByTrigger orders the results by the trigger field.
+func ByTrigger(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrigger, opts...).ToFunc()
+}
+
+// ByEventName orders the results by the event_name field.
+func ByEventName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventName, opts...).ToFunc()
+}
+
+// ByProcessInstanceID orders the results by the process_instance_id field.
+func ByProcessInstanceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProcessInstanceID, opts...).ToFunc()
+}
+
+// BySubscriptionID orders the results by the subscription_id field.
+func BySubscriptionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubscriptionID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByRetryCount orders the results by the retry_count field.
+func ByRetryCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetryCount, opts...).ToFunc()
+}
+
+// ByTriggeredAt orders the results by the triggered_at field.
+func ByTriggeredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTriggeredAt, opts...).ToFunc()
+}
+
+// ByCompletedAt orders the results by the completed_at field.
+func ByCompletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompletedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,1045 @@
+// Code generated by ent, DO NOT EDIT.
+
+package processeventinstance
+
+import (
+	"itsm-backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldLTE(FieldID, id))
+}
+
+// EventInstanceID applies equality check predicate on the "event_instance_id" field. It's identical to EventInstanceIDEQ.
+func EventInstanceID(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEQ(FieldEventInstanceID, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEQ(FieldTenantID, v))
+}
+
+// EventDefinitionID applies equality check predicate on the "event_definition_id" field. It's identical to EventDefinitionIDEQ.
+func EventDefinitionID(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEQ(FieldEventDefinitionID, v))
+}
+
+// EventType applies equality check predicate on the "event_type" field. It's identical to EventTypeEQ.
+func EventType(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEQ(FieldEventType, v))
+}
+
+// Trigger applies equality check predicate on the "trigger" field. It's identical to TriggerEQ.
+func Trigger(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEQ(FieldTrigger, v))
+}
+
+// EventName applies equality check predicate on the "event_name" field. It's identical to EventNameEQ.
+func EventName(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEQ(FieldEventName, v))
+}
+
+// ProcessInstanceID applies equality check predicate on the "process_instance_id" field. It's identical to ProcessInstanceIDEQ.
+func ProcessInstanceID(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEQ(FieldProcessInstanceID, v))
+}
+
+// SubscriptionID applies equality check predicate on the "subscription_id" field. It's identical to SubscriptionIDEQ.
+func SubscriptionID(v int) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEQ(FieldStatus, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEQ(FieldError, v))
+}
+
+// RetryCount applies equality check predicate on the "retry_count" field. It's identical to RetryCountEQ.
+func RetryCount(v int) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEQ(FieldRetryCount, v))
+}
+
+// TriggeredAt applies equality check predicate on the "triggered_at" field. It's identical to TriggeredAtEQ.
+func TriggeredAt(v time.Time) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEQ(FieldTriggeredAt, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// EventInstanceIDEQ applies the EQ predicate on the "event_instance_id" field.
+func EventInstanceIDEQ(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEQ(FieldEventInstanceID, v))
+}
+
+// EventInstanceIDNEQ applies the NEQ predicate on the "event_instance_id" field.
+func EventInstanceIDNEQ(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNEQ(FieldEventInstanceID, v))
+}
+
+// EventInstanceIDIn applies the In predicate on the "event_instance_id" field.
+func EventInstanceIDIn(vs ...string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldIn(FieldEventInstanceID, vs...))
+}
+
+// EventInstanceIDNotIn applies the NotIn predicate on the "event_instance_id" field.
+func EventInstanceIDNotIn(vs ...string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNotIn(FieldEventInstanceID, vs...))
+}
+
+// EventInstanceIDGT applies the GT predicate on the "event_instance_id" field.
+func EventInstanceIDGT(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldGT(FieldEventInstanceID, v))
+}
+
+// EventInstanceIDGTE applies the GTE predicate on the "event_instance_id" field.
+func EventInstanceIDGTE(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldGTE(FieldEventInstanceID, v))
+}
+
+// EventInstanceIDLT applies the LT predicate on the "event_instance_id" field.
+func EventInstanceIDLT(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldLT(FieldEventInstanceID, v))
+}
+
+// EventInstanceIDLTE applies the LTE predicate on the "event_instance_id" field.
+func EventInstanceIDLTE(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldLTE(FieldEventInstanceID, v))
+}
+
+// EventInstanceIDContains applies the Contains predicate on the "event_instance_id" field.
+func EventInstanceIDContains(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldContains(FieldEventInstanceID, v))
+}
+
+// EventInstanceIDHasPrefix applies the HasPrefix predicate on the "event_instance_id" field.
+func EventInstanceIDHasPrefix(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldHasPrefix(FieldEventInstanceID, v))
+}
+
+// EventInstanceIDHasSuffix applies the HasSuffix predicate on the "event_instance_id" field.
+func EventInstanceIDHasSuffix(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldHasSuffix(FieldEventInstanceID, v))
+}
+
+// EventInstanceIDEqualFold applies the EqualFold predicate on the "event_instance_id" field.
+func EventInstanceIDEqualFold(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEqualFold(FieldEventInstanceID, v))
+}
+
+// EventInstanceIDContainsFold applies the ContainsFold predicate on the "event_instance_id" field.
+func EventInstanceIDContainsFold(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldContainsFold(FieldEventInstanceID, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldLTE(FieldTenantID, v))
+}
+
+// EventDefinitionIDEQ applies the EQ predicate on the "event_definition_id" field.
+func EventDefinitionIDEQ(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEQ(FieldEventDefinitionID, v))
+}
+
+// EventDefinitionIDNEQ applies the NEQ predicate on the "event_definition_id" field.
+func EventDefinitionIDNEQ(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNEQ(FieldEventDefinitionID, v))
+}
+
+// EventDefinitionIDIn applies the In predicate on the "event_definition_id" field.
+func EventDefinitionIDIn(vs ...string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldIn(FieldEventDefinitionID, vs...))
+}
+
+// EventDefinitionIDNotIn applies the NotIn predicate on the "event_definition_id" field.
+func EventDefinitionIDNotIn(vs ...string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNotIn(FieldEventDefinitionID, vs...))
+}
+
+// EventDefinitionIDGT applies the GT predicate on the "event_definition_id" field.
+func EventDefinitionIDGT(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldGT(FieldEventDefinitionID, v))
+}
+
+// EventDefinitionIDGTE applies the GTE predicate on the "event_definition_id" field.
+func EventDefinitionIDGTE(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldGTE(FieldEventDefinitionID, v))
+}
+
+// EventDefinitionIDLT applies the LT predicate on the "event_definition_id" field.
+func EventDefinitionIDLT(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldLT(FieldEventDefinitionID, v))
+}
+
+// EventDefinitionIDLTE applies the LTE predicate on the "event_definition_id" field.
+func EventDefinitionIDLTE(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldLTE(FieldEventDefinitionID, v))
+}
+
+// EventDefinitionIDContains applies the Contains predicate on the "event_definition_id" field.
+func EventDefinitionIDContains(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldContains(FieldEventDefinitionID, v))
+}
+
+// EventDefinitionIDHasPrefix applies the HasPrefix predicate on the "event_definition_id" field.
+func EventDefinitionIDHasPrefix(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldHasPrefix(FieldEventDefinitionID, v))
+}
+
+// EventDefinitionIDHasSuffix applies the HasSuffix predicate on the "event_definition_id" field.
+func EventDefinitionIDHasSuffix(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldHasSuffix(FieldEventDefinitionID, v))
+}
+
+// EventDefinitionIDEqualFold applies the EqualFold predicate on the "event_definition_id" field.
+func EventDefinitionIDEqualFold(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEqualFold(FieldEventDefinitionID, v))
+}
+
+// EventDefinitionIDContainsFold applies the ContainsFold predicate on the "event_definition_id" field.
+func EventDefinitionIDContainsFold(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldContainsFold(FieldEventDefinitionID, v))
+}
+
+// EventTypeEQ applies the EQ predicate on the "event_type" field.
+func EventTypeEQ(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEQ(FieldEventType, v))
+}
+
+// EventTypeNEQ applies the NEQ predicate on the "event_type" field.
+func EventTypeNEQ(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNEQ(FieldEventType, v))
+}
+
+// EventTypeIn applies the In predicate on the "event_type" field.
+func EventTypeIn(vs ...string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldIn(FieldEventType, vs...))
+}
+
+// EventTypeNotIn applies the NotIn predicate on the "event_type" field.
+func EventTypeNotIn(vs ...string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNotIn(FieldEventType, vs...))
+}
+
+// EventTypeGT applies the GT predicate on the "event_type" field.
+func EventTypeGT(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldGT(FieldEventType, v))
+}
+
+// EventTypeGTE applies the GTE predicate on the "event_type" field.
+func EventTypeGTE(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldGTE(FieldEventType, v))
+}
+
+// EventTypeLT applies the LT predicate on the "event_type" field.
+func EventTypeLT(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldLT(FieldEventType, v))
+}
+
+// EventTypeLTE applies the LTE predicate on the "event_type" field.
+func EventTypeLTE(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldLTE(FieldEventType, v))
+}
+
+// EventTypeContains applies the Contains predicate on the "event_type" field.
+func EventTypeContains(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldContains(FieldEventType, v))
+}
+
+// EventTypeHasPrefix applies the HasPrefix predicate on the "event_type" field.
+func EventTypeHasPrefix(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldHasPrefix(FieldEventType, v))
+}
+
+// EventTypeHasSuffix applies the HasSuffix predicate on the "event_type" field.
+func EventTypeHasSuffix(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldHasSuffix(FieldEventType, v))
+}
+
+// EventTypeIsNil applies the IsNil predicate on the "event_type" field.
+func EventTypeIsNil() predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldIsNull(FieldEventType))
+}
+
+// EventTypeNotNil applies the NotNil predicate on the "event_type" field.
+func EventTypeNotNil() predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNotNull(FieldEventType))
+}
+
+// EventTypeEqualFold applies the EqualFold predicate on the "event_type" field.
+func EventTypeEqualFold(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEqualFold(FieldEventType, v))
+}
+
+// EventTypeContainsFold applies the ContainsFold predicate on the "event_type" field.
+func EventTypeContainsFold(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldContainsFold(FieldEventType, v))
+}
+
+// TriggerEQ applies the EQ predicate on the "trigger" field.
+func TriggerEQ(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEQ(FieldTrigger, v))
+}
+
+// TriggerNEQ applies the NEQ predicate on the "trigger" field.
+func TriggerNEQ(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNEQ(FieldTrigger, v))
+}
+
+// TriggerIn applies the In predicate on the "trigger" field.
+func TriggerIn(vs ...string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldIn(FieldTrigger, vs...))
+}
+
+// TriggerNotIn applies the NotIn predicate on the "trigger" field.
+func TriggerNotIn(vs ...string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNotIn(FieldTrigger, vs...))
+}
+
+// TriggerGT applies the GT predicate on the "trigger" field.
+func TriggerGT(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldGT(FieldTrigger, v))
+}
+
+// TriggerGTE applies the GTE predicate on the "trigger" field.
+func TriggerGTE(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldGTE(FieldTrigger, v))
+}
+
+// TriggerLT applies the LT predicate on the "trigger" field.
+func TriggerLT(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldLT(FieldTrigger, v))
+}
+
+// TriggerLTE applies the LTE predicate on the "trigger" field.
+func TriggerLTE(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldLTE(FieldTrigger, v))
+}
+
+// TriggerContains applies the Contains predicate on the "trigger" field.
+func TriggerContains(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldContains(FieldTrigger, v))
+}
+
+// TriggerHasPrefix applies the HasPrefix predicate on the "trigger" field.
+func TriggerHasPrefix(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldHasPrefix(FieldTrigger, v))
+}
+
+// TriggerHasSuffix applies the HasSuffix predicate on the "trigger" field.
+func TriggerHasSuffix(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldHasSuffix(FieldTrigger, v))
+}
+
+// TriggerIsNil applies the IsNil predicate on the "trigger" field.
+func TriggerIsNil() predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldIsNull(FieldTrigger))
+}
+
+// TriggerNotNil applies the NotNil predicate on the "trigger" field.
+func TriggerNotNil() predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNotNull(FieldTrigger))
+}
+
+// TriggerEqualFold applies the EqualFold predicate on the "trigger" field.
+func TriggerEqualFold(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEqualFold(FieldTrigger, v))
+}
+
+// TriggerContainsFold applies the ContainsFold predicate on the "trigger" field.
+func TriggerContainsFold(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldContainsFold(FieldTrigger, v))
+}
+
+// EventNameEQ applies the EQ predicate on the "event_name" field.
+func EventNameEQ(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEQ(FieldEventName, v))
+}
+
+// EventNameNEQ applies the NEQ predicate on the "event_name" field.
+func EventNameNEQ(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNEQ(FieldEventName, v))
+}
+
+// EventNameIn applies the In predicate on the "event_name" field.
+func EventNameIn(vs ...string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldIn(FieldEventName, vs...))
+}
+
+// EventNameNotIn applies the NotIn predicate on the "event_name" field.
+func EventNameNotIn(vs ...string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNotIn(FieldEventName, vs...))
+}
+
+// EventNameGT applies the GT predicate on the "event_name" field.
+func EventNameGT(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldGT(FieldEventName, v))
+}
+
+// EventNameGTE applies the GTE predicate on the "event_name" field.
+func EventNameGTE(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldGTE(FieldEventName, v))
+}
+
+// EventNameLT applies the LT predicate on the "event_name" field.
+func EventNameLT(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldLT(FieldEventName, v))
+}
+
+// EventNameLTE applies the LTE predicate on the "event_name" field.
+func EventNameLTE(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldLTE(FieldEventName, v))
+}
+
+// EventNameContains applies the Contains predicate on the "event_name" field.
+func EventNameContains(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldContains(FieldEventName, v))
+}
+
+// EventNameHasPrefix applies the HasPrefix predicate on the "event_name" field.
+func EventNameHasPrefix(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldHasPrefix(FieldEventName, v))
+}
+
+// EventNameHasSuffix applies the HasSuffix predicate on the "event_name" field.
+func EventNameHasSuffix(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldHasSuffix(FieldEventName, v))
+}
+
+// EventNameIsNil applies the IsNil predicate on the "event_name" field.
+func EventNameIsNil() predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldIsNull(FieldEventName))
+}
+
+// EventNameNotNil applies the NotNil predicate on the "event_name" field.
+func EventNameNotNil() predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNotNull(FieldEventName))
+}
+
+// EventNameEqualFold applies the EqualFold predicate on the "event_name" field.
+func EventNameEqualFold(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEqualFold(FieldEventName, v))
+}
+
+// EventNameContainsFold applies the ContainsFold predicate on the "event_name" field.
+func EventNameContainsFold(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldContainsFold(FieldEventName, v))
+}
+
+// ProcessInstanceIDEQ applies the EQ predicate on the "process_instance_id" field.
+func ProcessInstanceIDEQ(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEQ(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDNEQ applies the NEQ predicate on the "process_instance_id" field.
+func ProcessInstanceIDNEQ(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNEQ(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDIn applies the In predicate on the "process_instance_id" field.
+func ProcessInstanceIDIn(vs ...string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldIn(FieldProcessInstanceID, vs...))
+}
+
+// ProcessInstanceIDNotIn applies the NotIn predicate on the "process_instance_id" field.
+func ProcessInstanceIDNotIn(vs ...string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNotIn(FieldProcessInstanceID, vs...))
+}
+
+// ProcessInstanceIDGT applies the GT predicate on the "process_instance_id" field.
+func ProcessInstanceIDGT(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldGT(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDGTE applies the GTE predicate on the "process_instance_id" field.
+func ProcessInstanceIDGTE(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldGTE(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDLT applies the LT predicate on the "process_instance_id" field.
+func ProcessInstanceIDLT(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldLT(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDLTE applies the LTE predicate on the "process_instance_id" field.
+func ProcessInstanceIDLTE(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldLTE(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDContains applies the Contains predicate on the "process_instance_id" field.
+func ProcessInstanceIDContains(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldContains(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDHasPrefix applies the HasPrefix predicate on the "process_instance_id" field.
+func ProcessInstanceIDHasPrefix(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldHasPrefix(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDHasSuffix applies the HasSuffix predicate on the "process_instance_id" field.
+func ProcessInstanceIDHasSuffix(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldHasSuffix(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDIsNil applies the IsNil predicate on the "process_instance_id" field.
+func ProcessInstanceIDIsNil() predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldIsNull(FieldProcessInstanceID))
+}
+
+// ProcessInstanceIDNotNil applies the NotNil predicate on the "process_instance_id" field.
+func ProcessInstanceIDNotNil() predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNotNull(FieldProcessInstanceID))
+}
+
+// ProcessInstanceIDEqualFold applies the EqualFold predicate on the "process_instance_id" field.
+func ProcessInstanceIDEqualFold(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEqualFold(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDContainsFold applies the ContainsFold predicate on the "process_instance_id" field.
+func ProcessInstanceIDContainsFold(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldContainsFold(FieldProcessInstanceID, v))
+}
+
+// SubscriptionIDEQ applies the EQ predicate on the "subscription_id" field.
+func SubscriptionIDEQ(v int) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDNEQ applies the NEQ predicate on the "subscription_id" field.
+func SubscriptionIDNEQ(v int) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDIn applies the In predicate on the "subscription_id" field.
+func SubscriptionIDIn(vs ...int) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldIn(FieldSubscriptionID, vs...))
+}
+
+// SubscriptionIDNotIn applies the NotIn predicate on the "subscription_id" field.
+func SubscriptionIDNotIn(vs ...int) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNotIn(FieldSubscriptionID, vs...))
+}
+
+// SubscriptionIDGT applies the GT predicate on the "subscription_id" field.
+func SubscriptionIDGT(v int) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldGT(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDGTE applies the GTE predicate on the "subscription_id" field.
+func SubscriptionIDGTE(v int) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldGTE(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDLT applies the LT predicate on the "subscription_id" field.
+func SubscriptionIDLT(v int) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldLT(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDLTE applies the LTE predicate on the "subscription_id" field.
+func SubscriptionIDLTE(v int) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldLTE(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDIsNil applies the IsNil predicate on the "subscription_id" field.
+func SubscriptionIDIsNil() predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldIsNull(FieldSubscriptionID))
+}
+
+// SubscriptionIDNotNil applies the NotNil predicate on the "subscription_id" field.
+func SubscriptionIDNotNil() predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNotNull(FieldSubscriptionID))
+}
+
+// CorrelationKeysIsNil applies the IsNil predicate on the "correlation_keys" field.
+func CorrelationKeysIsNil() predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldIsNull(FieldCorrelationKeys))
+}
+
+// CorrelationKeysNotNil applies the NotNil predicate on the "correlation_keys" field.
+func CorrelationKeysNotNil() predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNotNull(FieldCorrelationKeys))
+}
+
+// VariablesIsNil applies the IsNil predicate on the "variables" field.
+func VariablesIsNil() predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldIsNull(FieldVariables))
+}
+
+// VariablesNotNil applies the NotNil predicate on the "variables" field.
+func VariablesNotNil() predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNotNull(FieldVariables))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldContainsFold(FieldError, v))
+}
+
+// RetryCountEQ applies the EQ predicate on the "retry_count" field.
+func RetryCountEQ(v int) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEQ(FieldRetryCount, v))
+}
+
+// RetryCountNEQ applies the NEQ predicate on the "retry_count" field.
+func RetryCountNEQ(v int) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNEQ(FieldRetryCount, v))
+}
+
+// RetryCountIn applies the In predicate on the "retry_count" field.
+func RetryCountIn(vs ...int) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldIn(FieldRetryCount, vs...))
+}
+
+// RetryCountNotIn applies the NotIn predicate on the "retry_count" field.
+func RetryCountNotIn(vs ...int) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNotIn(FieldRetryCount, vs...))
+}
+
+// RetryCountGT applies the GT predicate on the "retry_count" field.
+func RetryCountGT(v int) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldGT(FieldRetryCount, v))
+}
+
+// RetryCountGTE applies the GTE predicate on the "retry_count" field.
+func RetryCountGTE(v int) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldGTE(FieldRetryCount, v))
+}
+
+// RetryCountLT applies the LT predicate on the "retry_count" field.
+func RetryCountLT(v int) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldLT(FieldRetryCount, v))
+}
+
+// RetryCountLTE applies the LTE predicate on the "retry_count" field.
+func RetryCountLTE(v int) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldLTE(FieldRetryCount, v))
+}
+
+// TriggeredAtEQ applies the EQ predicate on the "triggered_at" field.
+func TriggeredAtEQ(v time.Time) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEQ(FieldTriggeredAt, v))
+}
+
+// TriggeredAtNEQ applies the NEQ predicate on the "triggered_at" field.
+func TriggeredAtNEQ(v time.Time) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNEQ(FieldTriggeredAt, v))
+}
+
+// TriggeredAtIn applies the In predicate on the "triggered_at" field.
+func TriggeredAtIn(vs ...time.Time) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldIn(FieldTriggeredAt, vs...))
+}
+
+// TriggeredAtNotIn applies the NotIn predicate on the "triggered_at" field.
+func TriggeredAtNotIn(vs ...time.Time) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNotIn(FieldTriggeredAt, vs...))
+}
+
+// TriggeredAtGT applies the GT predicate on the "triggered_at" field.
+func TriggeredAtGT(v time.Time) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldGT(FieldTriggeredAt, v))
+}
+
+// TriggeredAtGTE applies the GTE predicate on the "triggered_at" field.
+func TriggeredAtGTE(v time.Time) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldGTE(FieldTriggeredAt, v))
+}
+
+// TriggeredAtLT applies the LT predicate on the "triggered_at" field.
+func TriggeredAtLT(v time.Time) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldLT(FieldTriggeredAt, v))
+}
+
+// TriggeredAtLTE applies the LTE predicate on the "triggered_at" field.
+func TriggeredAtLTE(v time.Time) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldLTE(FieldTriggeredAt, v))
+}
+
+// TriggeredAtIsNil applies the IsNil predicate on the "triggered_at" field.
+func TriggeredAtIsNil() predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldIsNull(FieldTriggeredAt))
+}
+
+// TriggeredAtNotNil applies the NotNil predicate on the "triggered_at" field.
+func TriggeredAtNotNil() predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNotNull(FieldTriggeredAt))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// CompletedAtIsNil applies the IsNil predicate on the "completed_at" field.
+func CompletedAtIsNil() predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldIsNull(FieldCompletedAt))
+}
+
+// CompletedAtNotNil applies the NotNil predicate on the "completed_at" field.
+func CompletedAtNotNil() predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNotNull(FieldCompletedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProcessEventInstance) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProcessEventInstance) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProcessEventInstance) predicate.ProcessEventInstance {
+	return predicate.ProcessEventInstance(sql.NotPredicates(p))
+}
@@ -0,0 +1,525 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/processeventinstance"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProcessEventInstanceCreate is the builder for creating a ProcessEventInstance entity.
+type ProcessEventInstanceCreate struct {
+	config
+	mutation *ProcessEventInstanceMutation
+	hooks    []Hook
+}
+
+// SetEventInstanceID sets the "event_instance_id" field.
+func (_c *ProcessEventInstanceCreate) SetEventInstanceID(v string) *ProcessEventInstanceCreate {
+	_c.mutation.SetEventInstanceID(v)
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ProcessEventInstanceCreate) SetTenantID(v int) *ProcessEventInstanceCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetEventDefinitionID sets the "event_definition_id" field.
+func (_c *ProcessEventInstanceCreate) SetEventDefinitionID(v string) *ProcessEventInstanceCreate {
+	_c.mutation.SetEventDefinitionID(v)
+	return _c
+}
+
+// SetEventType sets the "event_type" field.
+func (_c *ProcessEventInstanceCreate) SetEventType(v string) *ProcessEventInstanceCreate {
+	_c.mutation.SetEventType(v)
+	return _c
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_c *ProcessEventInstanceCreate) SetNillableEventType(v *string) *ProcessEventInstanceCreate {
+	if v != nil {
+		_c.SetEventType(*v)
+	}
+	return _c
+}
+
+// SetTrigger sets the "trigger" field.
+func (_c *ProcessEventInstanceCreate) SetTrigger(v string) *ProcessEventInstanceCreate {
+	_c.mutation.SetTrigger(v)
+	return _c
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (_c *ProcessEventInstanceCreate) SetNillableTrigger(v *string) *ProcessEventInstanceCreate {
+	if v != nil {
+		_c.SetTrigger(*v)
+	}
+	return _c
+}
+
+// SetEventName sets the "event_name" field.
+func (_c *ProcessEventInstanceCreate) SetEventName(v string) *ProcessEventInstanceCreate {
+	_c.mutation.SetEventName(v)
+	return _c
+}
+
+// SetNillableEventName sets the "event_name" field if the given value is not nil.
+func (_c *ProcessEventInstanceCreate) SetNillableEventName(v *string) *ProcessEventInstanceCreate {
+	if v != nil {
+		_c.SetEventName(*v)
+	}
+	return _c
+}
+
+// SetProcessInstanceID sets the "process_instance_id" field.
+func (_c *ProcessEventInstanceCreate) SetProcessInstanceID(v string) *ProcessEventInstanceCreate {
+	_c.mutation.SetProcessInstanceID(v)
+	return _c
+}
+
+// SetNillableProcessInstanceID sets the "process_instance_id" field if the given value is not nil.
+func (_c *ProcessEventInstanceCreate) SetNillableProcessInstanceID(v *string) *ProcessEventInstanceCreate {
+	if v != nil {
+		_c.SetProcessInstanceID(*v)
+	}
+	return _c
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (_c *ProcessEventInstanceCreate) SetSubscriptionID(v int) *ProcessEventInstanceCreate {
+	_c.mutation.SetSubscriptionID(v)
+	return _c
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (_c *ProcessEventInstanceCreate) SetNillableSubscriptionID(v *int) *ProcessEventInstanceCreate {
+	if v != nil {
+		_c.SetSubscriptionID(*v)
+	}
+	return _c
+}
+
+// SetCorrelationKeys sets the "correlation_keys" field.
+func (_c *ProcessEventInstanceCreate) SetCorrelationKeys(v map[string]interface{}) *ProcessEventInstanceCreate {
+	_c.mutation.SetCorrelationKeys(v)
+	return _c
+}
+
+// SetVariables sets the "variables" field.
+func (_c *ProcessEventInstanceCreate) SetVariables(v map[string]interface{}) *ProcessEventInstanceCreate {
+	_c.mutation.SetVariables(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ProcessEventInstanceCreate) SetStatus(v string) *ProcessEventInstanceCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ProcessEventInstanceCreate) SetNillableStatus(v *string) *ProcessEventInstanceCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *ProcessEventInstanceCreate) SetError(v string) *ProcessEventInstanceCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *ProcessEventInstanceCreate) SetNillableError(v *string) *ProcessEventInstanceCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetRetryCount sets the "retry_count" field.
+func (_c *ProcessEventInstanceCreate) SetRetryCount(v int) *ProcessEventInstanceCreate {
+	_c.mutation.SetRetryCount(v)
+	return _c
+}
+
+// SetNillableRetryCount sets the "retry_count" field if the given value is not nil.
+func (_c *ProcessEventInstanceCreate) SetNillableRetryCount(v *int) *ProcessEventInstanceCreate {
+	if v != nil {
+		_c.SetRetryCount(*v)
+	}
+	return _c
+}
+
+// SetTriggeredAt sets the "triggered_at" field.
+func (_c *ProcessEventInstanceCreate) SetTriggeredAt(v time.Time) *ProcessEventInstanceCreate {
+	_c.mutation.SetTriggeredAt(v)
+	return _c
+}
+
+// SetNillableTriggeredAt sets the "triggered_at" field if the given value is not nil.
+func (_c *ProcessEventInstanceCreate) SetNillableTriggeredAt(v *time.Time) *ProcessEventInstanceCreate {
+	if v != nil {
+		_c.SetTriggeredAt(*v)
+	}
+	return _c
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_c *ProcessEventInstanceCreate) SetCompletedAt(v time.Time) *ProcessEventInstanceCreate {
+	_c.mutation.SetCompletedAt(v)
+	return _c
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_c *ProcessEventInstanceCreate) SetNillableCompletedAt(v *time.Time) *ProcessEventInstanceCreate {
+	if v != nil {
+		_c.SetCompletedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ProcessEventInstanceCreate) SetCreatedAt(v time.Time) *ProcessEventInstanceCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ProcessEventInstanceCreate) SetNillableCreatedAt(v *time.Time) *ProcessEventInstanceCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ProcessEventInstanceCreate) SetUpdatedAt(v time.Time) *ProcessEventInstanceCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ProcessEventInstanceCreate) SetNillableUpdatedAt(v *time.Time) *ProcessEventInstanceCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ProcessEventInstanceMutation object of the builder.
+func (_c *ProcessEventInstanceCreate) Mutation() *ProcessEventInstanceMutation {
+	return _c.mutation
+}
+
+// Save creates the ProcessEventInstance in the database.
+func (_c *ProcessEventInstanceCreate) Save(ctx context.Context) (*ProcessEventInstance, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ProcessEventInstanceCreate) SaveX(ctx context.Context) *ProcessEventInstance {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProcessEventInstanceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProcessEventInstanceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ProcessEventInstanceCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := processeventinstance.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.RetryCount(); !ok {
+		v := processeventinstance.DefaultRetryCount
+		_c.mutation.SetRetryCount(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := processeventinstance.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := processeventinstance.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ProcessEventInstanceCreate) check() error {
+	if _, ok := _c.mutation.EventInstanceID(); !ok {
+		return &ValidationError{Name: "event_instance_id", err: errors.New(`ent: missing required field "ProcessEventInstance.event_instance_id"`)}
+	}
+	if v, ok := _c.mutation.EventInstanceID(); ok {
+		if err := processeventinstance.EventInstanceIDValidator(v); err != nil {
+			return &ValidationError{Name: "event_instance_id", err: fmt.Errorf(`ent: validator failed for field "ProcessEventInstance.event_instance_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "ProcessEventInstance.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := processeventinstance.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "ProcessEventInstance.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EventDefinitionID(); !ok {
+		return &ValidationError{Name: "event_definition_id", err: errors.New(`ent: missing required field "ProcessEventInstance.event_definition_id"`)}
+	}
+	if v, ok := _c.mutation.EventDefinitionID(); ok {
+		if err := processeventinstance.EventDefinitionIDValidator(v); err != nil {
+			return &ValidationError{Name: "event_definition_id", err: fmt.Errorf(`ent: validator failed for field "ProcessEventInstance.event_definition_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.EventType(); ok {
+		if err := processeventinstance.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "ProcessEventInstance.event_type": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Trigger(); ok {
+		if err := processeventinstance.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "ProcessEventInstance.trigger": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.EventName(); ok {
+		if err := processeventinstance.EventNameValidator(v); err != nil {
+			return &ValidationError{Name: "event_name", err: fmt.Errorf(`ent: validator failed for field "ProcessEventInstance.event_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ProcessEventInstance.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := processeventinstance.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ProcessEventInstance.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Error(); ok {
+		if err := processeventinstance.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "ProcessEventInstance.error": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RetryCount(); !ok {
+		return &ValidationError{Name: "retry_count", err: errors.New(`ent: missing required field "ProcessEventInstance.retry_count"`)}
+	}
+	if v, ok := _c.mutation.RetryCount(); ok {
+		if err := processeventinstance.RetryCountValidator(v); err != nil {
+			return &ValidationError{Name: "retry_count", err: fmt.Errorf(`ent: validator failed for field "ProcessEventInstance.retry_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProcessEventInstance.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ProcessEventInstance.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *ProcessEventInstanceCreate) sqlSave(ctx context.Context) (*ProcessEventInstance, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ProcessEventInstanceCreate) createSpec() (*ProcessEventInstance, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProcessEventInstance{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(processeventinstance.Table, sqlgraph.NewFieldSpec(processeventinstance.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.EventInstanceID(); ok {
+		_spec.SetField(processeventinstance.FieldEventInstanceID, field.TypeString, value)
+		_node.EventInstanceID = value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(processeventinstance.FieldTenantID, field.TypeInt, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.EventDefinitionID(); ok {
+		_spec.SetField(processeventinstance.FieldEventDefinitionID, field.TypeString, value)
+		_node.EventDefinitionID = value
+	}
+	if value, ok := _c.mutation.EventType(); ok {
+		_spec.SetField(processeventinstance.FieldEventType, field.TypeString, value)
+		_node.EventType = value
+	}
+	if value, ok := _c.mutation.Trigger(); ok {
+		_spec.SetField(processeventinstance.FieldTrigger, field.TypeString, value)
+		_node.Trigger = value
+	}
+	if value, ok := _c.mutation.EventName(); ok {
+		_spec.SetField(processeventinstance.FieldEventName, field.TypeString, value)
+		_node.EventName = value
+	}
+	if value, ok := _c.mutation.ProcessInstanceID(); ok {
+		_spec.SetField(processeventinstance.FieldProcessInstanceID, field.TypeString, value)
+		_node.ProcessInstanceID = value
+	}
+	if value, ok := _c.mutation.SubscriptionID(); ok {
+		_spec.SetField(processeventinstance.FieldSubscriptionID, field.TypeInt, value)
+		_node.SubscriptionID = &value
+	}
+	if value, ok := _c.mutation.CorrelationKeys(); ok {
+		_spec.SetField(processeventinstance.FieldCorrelationKeys, field.TypeJSON, value)
+		_node.CorrelationKeys = value
+	}
+	if value, ok := _c.mutation.Variables(); ok {
+		_spec.SetField(processeventinstance.FieldVariables, field.TypeJSON, value)
+		_node.Variables = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(processeventinstance.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(processeventinstance.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := _c.mutation.RetryCount(); ok {
+		_spec.SetField(processeventinstance.FieldRetryCount, field.TypeInt, value)
+		_node.RetryCount = value
+	}
+	if value, ok := _c.mutation.TriggeredAt(); ok {
+		_spec.SetField(processeventinstance.FieldTriggeredAt, field.TypeTime, value)
+		_node.TriggeredAt = &value
+	}
+	if value, ok := _c.mutation.CompletedAt(); ok {
+		_spec.SetField(processeventinstance.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(processeventinstance.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(processeventinstance.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// ProcessEventInstanceCreateBulk is the builder for creating many ProcessEventInstance entities in bulk.
+type ProcessEventInstanceCreateBulk struct {
+	config
+	err      error
+	builders []*ProcessEventInstanceCreate
+}
+
+// Save creates the ProcessEventInstance entities in the database.
+func (_c *ProcessEventInstanceCreateBulk) Save(ctx context.Context) ([]*ProcessEventInstance, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ProcessEventInstance, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProcessEventInstanceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ProcessEventInstanceCreateBulk) SaveX(ctx context.Context) []*ProcessEventInstance {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProcessEventInstanceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProcessEventInstanceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
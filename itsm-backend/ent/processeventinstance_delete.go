@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"itsm-backend/ent/predicate"
+	"itsm-backend/ent/processeventinstance"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProcessEventInstanceDelete is the builder for deleting a ProcessEventInstance entity.
+type ProcessEventInstanceDelete struct {
+	config
+	hooks    []Hook
+	mutation *ProcessEventInstanceMutation
+}
+
+// Where appends a list predicates to the ProcessEventInstanceDelete builder.
+func (_d *ProcessEventInstanceDelete) Where(ps ...predicate.ProcessEventInstance) *ProcessEventInstanceDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ProcessEventInstanceDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ProcessEventInstanceDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ProcessEventInstanceDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(processeventinstance.Table, sqlgraph.NewFieldSpec(processeventinstance.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ProcessEventInstanceDeleteOne is the builder for deleting a single ProcessEventInstance entity.
+type ProcessEventInstanceDeleteOne struct {
+	_d *ProcessEventInstanceDelete
+}
+
+// Where appends a list predicates to the ProcessEventInstanceDelete builder.
+func (_d *ProcessEventInstanceDeleteOne) Where(ps ...predicate.ProcessEventInstance) *ProcessEventInstanceDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ProcessEventInstanceDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{processeventinstance.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ProcessEventInstanceDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1070 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/predicate"
+	"itsm-backend/ent/processeventinstance"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProcessEventInstanceUpdate is the builder for updating ProcessEventInstance entities.
+type ProcessEventInstanceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProcessEventInstanceMutation
+}
+
+// Where appends a list predicates to the ProcessEventInstanceUpdate builder.
+func (_u *ProcessEventInstanceUpdate) Where(ps ...predicate.ProcessEventInstance) *ProcessEventInstanceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetEventInstanceID sets the "event_instance_id" field.
+func (_u *ProcessEventInstanceUpdate) SetEventInstanceID(v string) *ProcessEventInstanceUpdate {
+	_u.mutation.SetEventInstanceID(v)
+	return _u
+}
+
+// SetNillableEventInstanceID sets the "event_instance_id" field if the given value is not nil.
+func (_u *ProcessEventInstanceUpdate) SetNillableEventInstanceID(v *string) *ProcessEventInstanceUpdate {
+	if v != nil {
+		_u.SetEventInstanceID(*v)
+	}
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *ProcessEventInstanceUpdate) SetTenantID(v int) *ProcessEventInstanceUpdate {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *ProcessEventInstanceUpdate) SetNillableTenantID(v *int) *ProcessEventInstanceUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *ProcessEventInstanceUpdate) AddTenantID(v int) *ProcessEventInstanceUpdate {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetEventDefinitionID sets the "event_definition_id" field.
+func (_u *ProcessEventInstanceUpdate) SetEventDefinitionID(v string) *ProcessEventInstanceUpdate {
+	_u.mutation.SetEventDefinitionID(v)
+	return _u
+}
+
+// SetNillableEventDefinitionID sets the "event_definition_id" field if the given value is not nil.
+func (_u *ProcessEventInstanceUpdate) SetNillableEventDefinitionID(v *string) *ProcessEventInstanceUpdate {
+	if v != nil {
+		_u.SetEventDefinitionID(*v)
+	}
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *ProcessEventInstanceUpdate) SetEventType(v string) *ProcessEventInstanceUpdate {
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *ProcessEventInstanceUpdate) SetNillableEventType(v *string) *ProcessEventInstanceUpdate {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// ClearEventType clears the value of the "event_type" field.
+func (_u *ProcessEventInstanceUpdate) ClearEventType() *ProcessEventInstanceUpdate {
+	_u.mutation.ClearEventType()
+	return _u
+}
+
+// SetTrigger sets the "trigger" field.
+func (_u *ProcessEventInstanceUpdate) SetTrigger(v string) *ProcessEventInstanceUpdate {
+	_u.mutation.SetTrigger(v)
+	return _u
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (_u *ProcessEventInstanceUpdate) SetNillableTrigger(v *string) *ProcessEventInstanceUpdate {
+	if v != nil {
+		_u.SetTrigger(*v)
+	}
+	return _u
+}
+
+// ClearTrigger clears the value of the "trigger" field.
+func (_u *ProcessEventInstanceUpdate) ClearTrigger() *ProcessEventInstanceUpdate {
+	_u.mutation.ClearTrigger()
+	return _u
+}
+
+// SetEventName sets the "event_name" field.
+func (_u *ProcessEventInstanceUpdate) SetEventName(v string) *ProcessEventInstanceUpdate {
+	_u.mutation.SetEventName(v)
+	return _u
+}
+
+// SetNillableEventName sets the "event_name" field if the given value is not nil.
+func (_u *ProcessEventInstanceUpdate) SetNillableEventName(v *string) *ProcessEventInstanceUpdate {
+	if v != nil {
+		_u.SetEventName(*v)
+	}
+	return _u
+}
+
+// ClearEventName clears the value of the "event_name" field.
+func (_u *ProcessEventInstanceUpdate) ClearEventName() *ProcessEventInstanceUpdate {
+	_u.mutation.ClearEventName()
+	return _u
+}
+
+// SetProcessInstanceID sets the "process_instance_id" field.
+func (_u *ProcessEventInstanceUpdate) SetProcessInstanceID(v string) *ProcessEventInstanceUpdate {
+	_u.mutation.SetProcessInstanceID(v)
+	return _u
+}
+
+// SetNillableProcessInstanceID sets the "process_instance_id" field if the given value is not nil.
+func (_u *ProcessEventInstanceUpdate) SetNillableProcessInstanceID(v *string) *ProcessEventInstanceUpdate {
+	if v != nil {
+		_u.SetProcessInstanceID(*v)
+	}
+	return _u
+}
+
+// ClearProcessInstanceID clears the value of the "process_instance_id" field.
+func (_u *ProcessEventInstanceUpdate) ClearProcessInstanceID() *ProcessEventInstanceUpdate {
+	_u.mutation.ClearProcessInstanceID()
+	return _u
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (_u *ProcessEventInstanceUpdate) SetSubscriptionID(v int) *ProcessEventInstanceUpdate {
+	_u.mutation.ResetSubscriptionID()
+	_u.mutation.SetSubscriptionID(v)
+	return _u
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (_u *ProcessEventInstanceUpdate) SetNillableSubscriptionID(v *int) *ProcessEventInstanceUpdate {
+	if v != nil {
+		_u.SetSubscriptionID(*v)
+	}
+	return _u
+}
+
+// AddSubscriptionID adds value to the "subscription_id" field.
+func (_u *ProcessEventInstanceUpdate) AddSubscriptionID(v int) *ProcessEventInstanceUpdate {
+	_u.mutation.AddSubscriptionID(v)
+	return _u
+}
+
+// ClearSubscriptionID clears the value of the "subscription_id" field.
+func (_u *ProcessEventInstanceUpdate) ClearSubscriptionID() *ProcessEventInstanceUpdate {
+	_u.mutation.ClearSubscriptionID()
+	return _u
+}
+
+// SetCorrelationKeys sets the "correlation_keys" field.
+func (_u *ProcessEventInstanceUpdate) SetCorrelationKeys(v map[string]interface{}) *ProcessEventInstanceUpdate {
+	_u.mutation.SetCorrelationKeys(v)
+	return _u
+}
+
+// ClearCorrelationKeys clears the value of the "correlation_keys" field.
+func (_u *ProcessEventInstanceUpdate) ClearCorrelationKeys() *ProcessEventInstanceUpdate {
+	_u.mutation.ClearCorrelationKeys()
+	return _u
+}
+
+// SetVariables sets the "variables" field.
+func (_u *ProcessEventInstanceUpdate) SetVariables(v map[string]interface{}) *ProcessEventInstanceUpdate {
+	_u.mutation.SetVariables(v)
+	return _u
+}
+
+// ClearVariables clears the value of the "variables" field.
+func (_u *ProcessEventInstanceUpdate) ClearVariables() *ProcessEventInstanceUpdate {
+	_u.mutation.ClearVariables()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ProcessEventInstanceUpdate) SetStatus(v string) *ProcessEventInstanceUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ProcessEventInstanceUpdate) SetNillableStatus(v *string) *ProcessEventInstanceUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *ProcessEventInstanceUpdate) SetError(v string) *ProcessEventInstanceUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *ProcessEventInstanceUpdate) SetNillableError(v *string) *ProcessEventInstanceUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *ProcessEventInstanceUpdate) ClearError() *ProcessEventInstanceUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetRetryCount sets the "retry_count" field.
+func (_u *ProcessEventInstanceUpdate) SetRetryCount(v int) *ProcessEventInstanceUpdate {
+	_u.mutation.ResetRetryCount()
+	_u.mutation.SetRetryCount(v)
+	return _u
+}
+
+// SetNillableRetryCount sets the "retry_count" field if the given value is not nil.
+func (_u *ProcessEventInstanceUpdate) SetNillableRetryCount(v *int) *ProcessEventInstanceUpdate {
+	if v != nil {
+		_u.SetRetryCount(*v)
+	}
+	return _u
+}
+
+// AddRetryCount adds value to the "retry_count" field.
+func (_u *ProcessEventInstanceUpdate) AddRetryCount(v int) *ProcessEventInstanceUpdate {
+	_u.mutation.AddRetryCount(v)
+	return _u
+}
+
+// SetTriggeredAt sets the "triggered_at" field.
+func (_u *ProcessEventInstanceUpdate) SetTriggeredAt(v time.Time) *ProcessEventInstanceUpdate {
+	_u.mutation.SetTriggeredAt(v)
+	return _u
+}
+
+// SetNillableTriggeredAt sets the "triggered_at" field if the given value is not nil.
+func (_u *ProcessEventInstanceUpdate) SetNillableTriggeredAt(v *time.Time) *ProcessEventInstanceUpdate {
+	if v != nil {
+		_u.SetTriggeredAt(*v)
+	}
+	return _u
+}
+
+// ClearTriggeredAt clears the value of the "triggered_at" field.
+func (_u *ProcessEventInstanceUpdate) ClearTriggeredAt() *ProcessEventInstanceUpdate {
+	_u.mutation.ClearTriggeredAt()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *ProcessEventInstanceUpdate) SetCompletedAt(v time.Time) *ProcessEventInstanceUpdate {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *ProcessEventInstanceUpdate) SetNillableCompletedAt(v *time.Time) *ProcessEventInstanceUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *ProcessEventInstanceUpdate) ClearCompletedAt() *ProcessEventInstanceUpdate {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ProcessEventInstanceUpdate) SetCreatedAt(v time.Time) *ProcessEventInstanceUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ProcessEventInstanceUpdate) SetNillableCreatedAt(v *time.Time) *ProcessEventInstanceUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ProcessEventInstanceUpdate) SetUpdatedAt(v time.Time) *ProcessEventInstanceUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ProcessEventInstanceMutation object of the builder.
+func (_u *ProcessEventInstanceUpdate) Mutation() *ProcessEventInstanceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ProcessEventInstanceUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProcessEventInstanceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ProcessEventInstanceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProcessEventInstanceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ProcessEventInstanceUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := processeventinstance.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ProcessEventInstanceUpdate) check() error {
+	if v, ok := _u.mutation.EventInstanceID(); ok {
+		if err := processeventinstance.EventInstanceIDValidator(v); err != nil {
+			return &ValidationError{Name: "event_instance_id", err: fmt.Errorf(`ent: validator failed for field "ProcessEventInstance.event_instance_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := processeventinstance.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "ProcessEventInstance.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EventDefinitionID(); ok {
+		if err := processeventinstance.EventDefinitionIDValidator(v); err != nil {
+			return &ValidationError{Name: "event_definition_id", err: fmt.Errorf(`ent: validator failed for field "ProcessEventInstance.event_definition_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EventType(); ok {
+		if err := processeventinstance.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "ProcessEventInstance.event_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Trigger(); ok {
+		if err := processeventinstance.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "ProcessEventInstance.trigger": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EventName(); ok {
+		if err := processeventinstance.EventNameValidator(v); err != nil {
+			return &ValidationError{Name: "event_name", err: fmt.Errorf(`ent: validator failed for field "ProcessEventInstance.event_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := processeventinstance.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ProcessEventInstance.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Error(); ok {
+		if err := processeventinstance.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "ProcessEventInstance.error": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RetryCount(); ok {
+		if err := processeventinstance.RetryCountValidator(v); err != nil {
+			return &ValidationError{Name: "retry_count", err: fmt.Errorf(`ent: validator failed for field "ProcessEventInstance.retry_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ProcessEventInstanceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(processeventinstance.Table, processeventinstance.Columns, sqlgraph.NewFieldSpec(processeventinstance.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EventInstanceID(); ok {
+		_spec.SetField(processeventinstance.FieldEventInstanceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(processeventinstance.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(processeventinstance.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EventDefinitionID(); ok {
+		_spec.SetField(processeventinstance.FieldEventDefinitionID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(processeventinstance.FieldEventType, field.TypeString, value)
+	}
+	if _u.mutation.EventTypeCleared() {
+		_spec.ClearField(processeventinstance.FieldEventType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Trigger(); ok {
+		_spec.SetField(processeventinstance.FieldTrigger, field.TypeString, value)
+	}
+	if _u.mutation.TriggerCleared() {
+		_spec.ClearField(processeventinstance.FieldTrigger, field.TypeString)
+	}
+	if value, ok := _u.mutation.EventName(); ok {
+		_spec.SetField(processeventinstance.FieldEventName, field.TypeString, value)
+	}
+	if _u.mutation.EventNameCleared() {
+		_spec.ClearField(processeventinstance.FieldEventName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProcessInstanceID(); ok {
+		_spec.SetField(processeventinstance.FieldProcessInstanceID, field.TypeString, value)
+	}
+	if _u.mutation.ProcessInstanceIDCleared() {
+		_spec.ClearField(processeventinstance.FieldProcessInstanceID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SubscriptionID(); ok {
+		_spec.SetField(processeventinstance.FieldSubscriptionID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSubscriptionID(); ok {
+		_spec.AddField(processeventinstance.FieldSubscriptionID, field.TypeInt, value)
+	}
+	if _u.mutation.SubscriptionIDCleared() {
+		_spec.ClearField(processeventinstance.FieldSubscriptionID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CorrelationKeys(); ok {
+		_spec.SetField(processeventinstance.FieldCorrelationKeys, field.TypeJSON, value)
+	}
+	if _u.mutation.CorrelationKeysCleared() {
+		_spec.ClearField(processeventinstance.FieldCorrelationKeys, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Variables(); ok {
+		_spec.SetField(processeventinstance.FieldVariables, field.TypeJSON, value)
+	}
+	if _u.mutation.VariablesCleared() {
+		_spec.ClearField(processeventinstance.FieldVariables, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(processeventinstance.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(processeventinstance.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(processeventinstance.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.RetryCount(); ok {
+		_spec.SetField(processeventinstance.FieldRetryCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRetryCount(); ok {
+		_spec.AddField(processeventinstance.FieldRetryCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TriggeredAt(); ok {
+		_spec.SetField(processeventinstance.FieldTriggeredAt, field.TypeTime, value)
+	}
+	if _u.mutation.TriggeredAtCleared() {
+		_spec.ClearField(processeventinstance.FieldTriggeredAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(processeventinstance.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(processeventinstance.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(processeventinstance.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(processeventinstance.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{processeventinstance.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ProcessEventInstanceUpdateOne is the builder for updating a single ProcessEventInstance entity.
+type ProcessEventInstanceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProcessEventInstanceMutation
+}
+
+// SetEventInstanceID sets the "event_instance_id" field.
+func (_u *ProcessEventInstanceUpdateOne) SetEventInstanceID(v string) *ProcessEventInstanceUpdateOne {
+	_u.mutation.SetEventInstanceID(v)
+	return _u
+}
+
+// SetNillableEventInstanceID sets the "event_instance_id" field if the given value is not nil.
+func (_u *ProcessEventInstanceUpdateOne) SetNillableEventInstanceID(v *string) *ProcessEventInstanceUpdateOne {
+	if v != nil {
+		_u.SetEventInstanceID(*v)
+	}
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *ProcessEventInstanceUpdateOne) SetTenantID(v int) *ProcessEventInstanceUpdateOne {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *ProcessEventInstanceUpdateOne) SetNillableTenantID(v *int) *ProcessEventInstanceUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *ProcessEventInstanceUpdateOne) AddTenantID(v int) *ProcessEventInstanceUpdateOne {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetEventDefinitionID sets the "event_definition_id" field.
+func (_u *ProcessEventInstanceUpdateOne) SetEventDefinitionID(v string) *ProcessEventInstanceUpdateOne {
+	_u.mutation.SetEventDefinitionID(v)
+	return _u
+}
+
+// SetNillableEventDefinitionID sets the "event_definition_id" field if the given value is not nil.
+func (_u *ProcessEventInstanceUpdateOne) SetNillableEventDefinitionID(v *string) *ProcessEventInstanceUpdateOne {
+	if v != nil {
+		_u.SetEventDefinitionID(*v)
+	}
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *ProcessEventInstanceUpdateOne) SetEventType(v string) *ProcessEventInstanceUpdateOne {
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *ProcessEventInstanceUpdateOne) SetNillableEventType(v *string) *ProcessEventInstanceUpdateOne {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// ClearEventType clears the value of the "event_type" field.
+func (_u *ProcessEventInstanceUpdateOne) ClearEventType() *ProcessEventInstanceUpdateOne {
+	_u.mutation.ClearEventType()
+	return _u
+}
+
+// SetTrigger sets the "trigger" field.
+func (_u *ProcessEventInstanceUpdateOne) SetTrigger(v string) *ProcessEventInstanceUpdateOne {
+	_u.mutation.SetTrigger(v)
+	return _u
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (_u *ProcessEventInstanceUpdateOne) SetNillableTrigger(v *string) *ProcessEventInstanceUpdateOne {
+	if v != nil {
+		_u.SetTrigger(*v)
+	}
+	return _u
+}
+
+// ClearTrigger clears the value of the "trigger" field.
+func (_u *ProcessEventInstanceUpdateOne) ClearTrigger() *ProcessEventInstanceUpdateOne {
+	_u.mutation.ClearTrigger()
+	return _u
+}
+
+// SetEventName sets the "event_name" field.
+func (_u *ProcessEventInstanceUpdateOne) SetEventName(v string) *ProcessEventInstanceUpdateOne {
+	_u.mutation.SetEventName(v)
+	return _u
+}
+
+// SetNillableEventName sets the "event_name" field if the given value is not nil.
+func (_u *ProcessEventInstanceUpdateOne) SetNillableEventName(v *string) *ProcessEventInstanceUpdateOne {
+	if v != nil {
+		_u.SetEventName(*v)
+	}
+	return _u
+}
+
+// ClearEventName clears the value of the "event_name" field.
+func (_u *ProcessEventInstanceUpdateOne) ClearEventName() *ProcessEventInstanceUpdateOne {
+	_u.mutation.ClearEventName()
+	return _u
+}
+
+// SetProcessInstanceID sets the "process_instance_id" field.
+func (_u *ProcessEventInstanceUpdateOne) SetProcessInstanceID(v string) *ProcessEventInstanceUpdateOne {
+	_u.mutation.SetProcessInstanceID(v)
+	return _u
+}
+
+// SetNillableProcessInstanceID sets the "process_instance_id" field if the given value is not nil.
+func (_u *ProcessEventInstanceUpdateOne) SetNillableProcessInstanceID(v *string) *ProcessEventInstanceUpdateOne {
+	if v != nil {
+		_u.SetProcessInstanceID(*v)
+	}
+	return _u
+}
+
+// ClearProcessInstanceID clears the value of the "process_instance_id" field.
+func (_u *ProcessEventInstanceUpdateOne) ClearProcessInstanceID() *ProcessEventInstanceUpdateOne {
+	_u.mutation.ClearProcessInstanceID()
+	return _u
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (_u *ProcessEventInstanceUpdateOne) SetSubscriptionID(v int) *ProcessEventInstanceUpdateOne {
+	_u.mutation.ResetSubscriptionID()
+	_u.mutation.SetSubscriptionID(v)
+	return _u
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (_u *ProcessEventInstanceUpdateOne) SetNillableSubscriptionID(v *int) *ProcessEventInstanceUpdateOne {
+	if v != nil {
+		_u.SetSubscriptionID(*v)
+	}
+	return _u
+}
+
+// AddSubscriptionID adds value to the "subscription_id" field.
+func (_u *ProcessEventInstanceUpdateOne) AddSubscriptionID(v int) *ProcessEventInstanceUpdateOne {
+	_u.mutation.AddSubscriptionID(v)
+	return _u
+}
+
+// ClearSubscriptionID clears the value of the "subscription_id" field.
+func (_u *ProcessEventInstanceUpdateOne) ClearSubscriptionID() *ProcessEventInstanceUpdateOne {
+	_u.mutation.ClearSubscriptionID()
+	return _u
+}
+
+// SetCorrelationKeys sets the "correlation_keys" field.
+func (_u *ProcessEventInstanceUpdateOne) SetCorrelationKeys(v map[string]interface{}) *ProcessEventInstanceUpdateOne {
+	_u.mutation.SetCorrelationKeys(v)
+	return _u
+}
+
+// ClearCorrelationKeys clears the value of the "correlation_keys" field.
+func (_u *ProcessEventInstanceUpdateOne) ClearCorrelationKeys() *ProcessEventInstanceUpdateOne {
+	_u.mutation.ClearCorrelationKeys()
+	return _u
+}
+
+// SetVariables sets the "variables" field.
+func (_u *ProcessEventInstanceUpdateOne) SetVariables(v map[string]interface{}) *ProcessEventInstanceUpdateOne {
+	_u.mutation.SetVariables(v)
+	return _u
+}
+
+// ClearVariables clears the value of the "variables" field.
+func (_u *ProcessEventInstanceUpdateOne) ClearVariables() *ProcessEventInstanceUpdateOne {
+	_u.mutation.ClearVariables()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ProcessEventInstanceUpdateOne) SetStatus(v string) *ProcessEventInstanceUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ProcessEventInstanceUpdateOne) SetNillableStatus(v *string) *ProcessEventInstanceUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *ProcessEventInstanceUpdateOne) SetError(v string) *ProcessEventInstanceUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *ProcessEventInstanceUpdateOne) SetNillableError(v *string) *ProcessEventInstanceUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *ProcessEventInstanceUpdateOne) ClearError() *ProcessEventInstanceUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetRetryCount sets the "retry_count" field.
+func (_u *ProcessEventInstanceUpdateOne) SetRetryCount(v int) *ProcessEventInstanceUpdateOne {
+	_u.mutation.ResetRetryCount()
+	_u.mutation.SetRetryCount(v)
+	return _u
+}
+
+// SetNillableRetryCount sets the "retry_count" field if the given value is not nil.
+func (_u *ProcessEventInstanceUpdateOne) SetNillableRetryCount(v *int) *ProcessEventInstanceUpdateOne {
+	if v != nil {
+		_u.SetRetryCount(*v)
+	}
+	return _u
+}
+
+// AddRetryCount adds value to the "retry_count" field.
+func (_u *ProcessEventInstanceUpdateOne) AddRetryCount(v int) *ProcessEventInstanceUpdateOne {
+	_u.mutation.AddRetryCount(v)
+	return _u
+}
+
+// SetTriggeredAt sets the "triggered_at" field.
+func (_u *ProcessEventInstanceUpdateOne) SetTriggeredAt(v time.Time) *ProcessEventInstanceUpdateOne {
+	_u.mutation.SetTriggeredAt(v)
+	return _u
+}
+
+// SetNillableTriggeredAt sets the "triggered_at" field if the given value is not nil.
+func (_u *ProcessEventInstanceUpdateOne) SetNillableTriggeredAt(v *time.Time) *ProcessEventInstanceUpdateOne {
+	if v != nil {
+		_u.SetTriggeredAt(*v)
+	}
+	return _u
+}
+
+// ClearTriggeredAt clears the value of the "triggered_at" field.
+func (_u *ProcessEventInstanceUpdateOne) ClearTriggeredAt() *ProcessEventInstanceUpdateOne {
+	_u.mutation.ClearTriggeredAt()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *ProcessEventInstanceUpdateOne) SetCompletedAt(v time.Time) *ProcessEventInstanceUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *ProcessEventInstanceUpdateOne) SetNillableCompletedAt(v *time.Time) *ProcessEventInstanceUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *ProcessEventInstanceUpdateOne) ClearCompletedAt() *ProcessEventInstanceUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ProcessEventInstanceUpdateOne) SetCreatedAt(v time.Time) *ProcessEventInstanceUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ProcessEventInstanceUpdateOne) SetNillableCreatedAt(v *time.Time) *ProcessEventInstanceUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ProcessEventInstanceUpdateOne) SetUpdatedAt(v time.Time) *ProcessEventInstanceUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ProcessEventInstanceMutation object of the builder.
+func (_u *ProcessEventInstanceUpdateOne) Mutation() *ProcessEventInstanceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ProcessEventInstanceUpdate builder.
+func (_u *ProcessEventInstanceUpdateOne) Where(ps ...predicate.ProcessEventInstance) *ProcessEventInstanceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ProcessEventInstanceUpdateOne) Select(field string, fields ...string) *ProcessEventInstanceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ProcessEventInstance entity.
+func (_u *ProcessEventInstanceUpdateOne) Save(ctx context.Context) (*ProcessEventInstance, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProcessEventInstanceUpdateOne) SaveX(ctx context.Context) *ProcessEventInstance {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ProcessEventInstanceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProcessEventInstanceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ProcessEventInstanceUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := processeventinstance.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ProcessEventInstanceUpdateOne) check() error {
+	if v, ok := _u.mutation.EventInstanceID(); ok {
+		if err := processeventinstance.EventInstanceIDValidator(v); err != nil {
+			return &ValidationError{Name: "event_instance_id", err: fmt.Errorf(`ent: validator failed for field "ProcessEventInstance.event_instance_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := processeventinstance.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "ProcessEventInstance.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EventDefinitionID(); ok {
+		if err := processeventinstance.EventDefinitionIDValidator(v); err != nil {
+			return &ValidationError{Name: "event_definition_id", err: fmt.Errorf(`ent: validator failed for field "ProcessEventInstance.event_definition_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EventType(); ok {
+		if err := processeventinstance.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "ProcessEventInstance.event_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Trigger(); ok {
+		if err := processeventinstance.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "ProcessEventInstance.trigger": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EventName(); ok {
+		if err := processeventinstance.EventNameValidator(v); err != nil {
+			return &ValidationError{Name: "event_name", err: fmt.Errorf(`ent: validator failed for field "ProcessEventInstance.event_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := processeventinstance.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ProcessEventInstance.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Error(); ok {
+		if err := processeventinstance.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "ProcessEventInstance.error": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RetryCount(); ok {
+		if err := processeventinstance.RetryCountValidator(v); err != nil {
+			return &ValidationError{Name: "retry_count", err: fmt.Errorf(`ent: validator failed for field "ProcessEventInstance.retry_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ProcessEventInstanceUpdateOne) sqlSave(ctx context.Context) (_node *ProcessEventInstance, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(processeventinstance.Table, processeventinstance.Columns, sqlgraph.NewFieldSpec(processeventinstance.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProcessEventInstance.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, processeventinstance.FieldID)
+		for _, f := range fields {
+			if !processeventinstance.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != processeventinstance.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EventInstanceID(); ok {
+		_spec.SetField(processeventinstance.FieldEventInstanceID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(processeventinstance.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(processeventinstance.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EventDefinitionID(); ok {
+		_spec.SetField(processeventinstance.FieldEventDefinitionID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(processeventinstance.FieldEventType, field.TypeString, value)
+	}
+	if _u.mutation.EventTypeCleared() {
+		_spec.ClearField(processeventinstance.FieldEventType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Trigger(); ok {
+		_spec.SetField(processeventinstance.FieldTrigger, field.TypeString, value)
+	}
+	if _u.mutation.TriggerCleared() {
+		_spec.ClearField(processeventinstance.FieldTrigger, field.TypeString)
+	}
+	if value, ok := _u.mutation.EventName(); ok {
+		_spec.SetField(processeventinstance.FieldEventName, field.TypeString, value)
+	}
+	if _u.mutation.EventNameCleared() {
+		_spec.ClearField(processeventinstance.FieldEventName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProcessInstanceID(); ok {
+		_spec.SetField(processeventinstance.FieldProcessInstanceID, field.TypeString, value)
+	}
+	if _u.mutation.ProcessInstanceIDCleared() {
+		_spec.ClearField(processeventinstance.FieldProcessInstanceID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SubscriptionID(); ok {
+		_spec.SetField(processeventinstance.FieldSubscriptionID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSubscriptionID(); ok {
+		_spec.AddField(processeventinstance.FieldSubscriptionID, field.TypeInt, value)
+	}
+	if _u.mutation.SubscriptionIDCleared() {
+		_spec.ClearField(processeventinstance.FieldSubscriptionID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CorrelationKeys(); ok {
+		_spec.SetField(processeventinstance.FieldCorrelationKeys, field.TypeJSON, value)
+	}
+	if _u.mutation.CorrelationKeysCleared() {
+		_spec.ClearField(processeventinstance.FieldCorrelationKeys, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Variables(); ok {
+		_spec.SetField(processeventinstance.FieldVariables, field.TypeJSON, value)
+	}
+	if _u.mutation.VariablesCleared() {
+		_spec.ClearField(processeventinstance.FieldVariables, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(processeventinstance.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(processeventinstance.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(processeventinstance.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.RetryCount(); ok {
+		_spec.SetField(processeventinstance.FieldRetryCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRetryCount(); ok {
+		_spec.AddField(processeventinstance.FieldRetryCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TriggeredAt(); ok {
+		_spec.SetField(processeventinstance.FieldTriggeredAt, field.TypeTime, value)
+	}
+	if _u.mutation.TriggeredAtCleared() {
+		_spec.ClearField(processeventinstance.FieldTriggeredAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(processeventinstance.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(processeventinstance.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(processeventinstance.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(processeventinstance.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &ProcessEventInstance{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{processeventinstance.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,243 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"itsm-backend/ent/processeventsubscription"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ProcessEventSubscription is the model entity for the ProcessEventSubscription schema.
+type ProcessEventSubscription struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 租户ID
+	TenantID int `json:"tenant_id,omitempty"`
+	// 流程实例ID
+	ProcessInstanceID int `json:"process_instance_id,omitempty"`
+	// 流程定义Key
+	ProcessDefinitionKey string `json:"process_definition_key,omitempty"`
+	// 业务键，冗余存储便于按业务键关联
+	BusinessKey string `json:"business_key,omitempty"`
+	// 订阅所在的BPMN元素ID（中间事件/接收任务/边界事件）
+	ElementID string `json:"element_id,omitempty"`
+	// 边界事件所挂载的活动ID，非边界事件为空
+	AttachedTo string `json:"attached_to,omitempty"`
+	// 边界事件是否中断所挂载活动
+	Interrupting bool `json:"interrupting,omitempty"`
+	// 事件类型：message, signal
+	EventType string `json:"event_type,omitempty"`
+	// 消息名称或信号名称
+	EventName string `json:"event_name,omitempty"`
+	// 状态：waiting, correlated, cancelled
+	Status string `json:"status,omitempty"`
+	// 关联时间
+	CorrelatedAt *time.Time `json:"correlated_at,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProcessEventSubscription) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case processeventsubscription.FieldInterrupting:
+			values[i] = new(sql.NullBool)
+		case processeventsubscription.FieldID, processeventsubscription.FieldTenantID, processeventsubscription.FieldProcessInstanceID:
+			values[i] = new(sql.NullInt64)
+		case processeventsubscription.FieldProcessDefinitionKey, processeventsubscription.FieldBusinessKey, processeventsubscription.FieldElementID, processeventsubscription.FieldAttachedTo, processeventsubscription.FieldEventType, processeventsubscription.FieldEventName, processeventsubscription.FieldStatus:
+			values[i] = new(sql.NullString)
+		case processeventsubscription.FieldCorrelatedAt, processeventsubscription.FieldCreatedAt, processeventsubscription.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProcessEventSubscription fields.
+func (_m *ProcessEventSubscription) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case processeventsubscription.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case processeventsubscription.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = int(value.Int64)
+			}
+		case processeventsubscription.FieldProcessInstanceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field process_instance_id", values[i])
+			} else if value.Valid {
+				_m.ProcessInstanceID = int(value.Int64)
+			}
+		case processeventsubscription.FieldProcessDefinitionKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field process_definition_key", values[i])
+			} else if value.Valid {
+				_m.ProcessDefinitionKey = value.String
+			}
+		case processeventsubscription.FieldBusinessKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field business_key", values[i])
+			} else if value.Valid {
+				_m.BusinessKey = value.String
+			}
+		case processeventsubscription.FieldElementID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field element_id", values[i])
+			} else if value.Valid {
+				_m.ElementID = value.String
+			}
+		case processeventsubscription.FieldAttachedTo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field attached_to", values[i])
+			} else if value.Valid {
+				_m.AttachedTo = value.String
+			}
+		case processeventsubscription.FieldInterrupting:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field interrupting", values[i])
+			} else if value.Valid {
+				_m.Interrupting = value.Bool
+			}
+		case processeventsubscription.FieldEventType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_type", values[i])
+			} else if value.Valid {
+				_m.EventType = value.String
+			}
+		case processeventsubscription.FieldEventName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_name", values[i])
+			} else if value.Valid {
+				_m.EventName = value.String
+			}
+		case processeventsubscription.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case processeventsubscription.FieldCorrelatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field correlated_at", values[i])
+			} else if value.Valid {
+				_m.CorrelatedAt = new(time.Time)
+				*_m.CorrelatedAt = value.Time
+			}
+		case processeventsubscription.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case processeventsubscription.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProcessEventSubscription.
+// This includes values selected through modifiers, order, etc.
+func (_m *ProcessEventSubscription) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ProcessEventSubscription.
+// Note that you need to call ProcessEventSubscription.Unwrap() before calling this method if this ProcessEventSubscription
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ProcessEventSubscription) Update() *ProcessEventSubscriptionUpdateOne {
+	return NewProcessEventSubscriptionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ProcessEventSubscription entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ProcessEventSubscription) Unwrap() *ProcessEventSubscription {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProcessEventSubscription is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ProcessEventSubscription) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProcessEventSubscription(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("process_instance_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProcessInstanceID))
+	builder.WriteString(", ")
+	builder.WriteString("process_definition_key=")
+	builder.WriteString(_m.ProcessDefinitionKey)
+	builder.WriteString(", ")
+	builder.WriteString("business_key=")
+	builder.WriteString(_m.BusinessKey)
+	builder.WriteString(", ")
+	builder.WriteString("element_id=")
+	builder.WriteString(_m.ElementID)
+	builder.WriteString(", ")
+	builder.WriteString("attached_to=")
+	builder.WriteString(_m.AttachedTo)
+	builder.WriteString(", ")
+	builder.WriteString("interrupting=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Interrupting))
+	builder.WriteString(", ")
+	builder.WriteString("event_type=")
+	builder.WriteString(_m.EventType)
+	builder.WriteString(", ")
+	builder.WriteString("event_name=")
+	builder.WriteString(_m.EventName)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	if v := _m.CorrelatedAt; v != nil {
+		builder.WriteString("correlated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProcessEventSubscriptions is a parsable slice of ProcessEventSubscription.
+type ProcessEventSubscriptions []*ProcessEventSubscription
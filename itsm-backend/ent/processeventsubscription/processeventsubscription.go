@@ -0,0 +1,172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package processeventsubscription
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the processeventsubscription type in the database.
+	Label = "process_event_subscription"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldProcessInstanceID holds the string denoting the process_instance_id field in the database.
+	FieldProcessInstanceID = "process_instance_id"
+	// FieldProcessDefinitionKey holds the string denoting the process_definition_key field in the database.
+	FieldProcessDefinitionKey = "process_definition_key"
+	// FieldBusinessKey holds the string denoting the business_key field in the database.
+	FieldBusinessKey = "business_key"
+	// FieldElementID holds the string denoting the element_id field in the database.
+	FieldElementID = "element_id"
+	// FieldAttachedTo holds the string denoting the attached_to field in the database.
+	FieldAttachedTo = "attached_to"
+	// FieldInterrupting holds the string denoting the interrupting field in the database.
+	FieldInterrupting = "interrupting"
+	// FieldEventType holds the string denoting the event_type field in the database.
+	FieldEventType = "event_type"
+	// FieldEventName holds the string denoting the event_name field in the database.
+	FieldEventName = "event_name"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCorrelatedAt holds the string denoting the correlated_at field in the database.
+	FieldCorrelatedAt = "correlated_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the processeventsubscription in the database.
+	Table = "process_event_subscriptions"
+)
+
+// Columns holds all SQL columns for processeventsubscription fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldProcessInstanceID,
+	FieldProcessDefinitionKey,
+	FieldBusinessKey,
+	FieldElementID,
+	FieldAttachedTo,
+	FieldInterrupting,
+	FieldEventType,
+	FieldEventName,
+	FieldStatus,
+	FieldCorrelatedAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	TenantIDValidator func(int) error
+	// ProcessInstanceIDValidator is a validator for the "process_instance_id" field. It is called by the builders before save.
+	ProcessInstanceIDValidator func(int) error
+	// ProcessDefinitionKeyValidator is a validator for the "process_definition_key" field. It is called by the builders before save.
+	ProcessDefinitionKeyValidator func(string) error
+	// ElementIDValidator is a validator for the "element_id" field. It is called by the builders before save.
+	ElementIDValidator func(string) error
+	// DefaultInterrupting holds the default value on creation for the "interrupting" field.
+	DefaultInterrupting bool
+	// EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	EventTypeValidator func(string) error
+	// EventNameValidator is a validator for the "event_name" field. It is called by the builders before save.
+	EventNameValidator func(string) error
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	StatusValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the ProcessEventSubscription queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByProcessInstanceID orders the results by the process_instance_id field.
+func ByProcessInstanceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProcessInstanceID, opts...).ToFunc()
+}
+
+// ByProcessDefinitionKey orders the results by the process_definition_key field.
+func ByProcessDefinitionKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProcessDefinitionKey, opts...).ToFunc()
+}
+
+// ByBusinessKey orders the results by the business_key field.
+func ByBusinessKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBusinessKey, opts...).ToFunc()
+}
+
+// ByElementID orders the results by the element_id field.
+func ByElementID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldElementID, opts...).ToFunc()
+}
+
+// ByAttachedTo orders the results by the attached_to field.
+func ByAttachedTo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttachedTo, opts...).ToFunc()
+}
+
+// ByInterrupting orders the results by the interrupting field.
+func ByInterrupting(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInterrupting, opts...).ToFunc()
+}
+
+// ByEventType orders the results by the event_type field.
+func ByEventType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventType, opts...).ToFunc()
+}
+
+// ByEventName orders the results by the event_name field.
+func ByEventName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventName, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCorrelatedAt orders the results by the correlated_at field.
+func ByCorrelatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCorrelatedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
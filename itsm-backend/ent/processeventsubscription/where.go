@@ -0,0 +1,830 @@
+// Code generated by ent, DO NOT EDIT.
+
+package processeventsubscription
+
+import (
+	"itsm-backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldEQ(FieldTenantID, v))
+}
+
+// ProcessInstanceID applies equality check predicate on the "process_instance_id" field. It's identical to ProcessInstanceIDEQ.
+func ProcessInstanceID(v int) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldEQ(FieldProcessInstanceID, v))
+}
+
+// ProcessDefinitionKey applies equality check predicate on the "process_definition_key" field. It's identical to ProcessDefinitionKeyEQ.
+func ProcessDefinitionKey(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldEQ(FieldProcessDefinitionKey, v))
+}
+
+// BusinessKey applies equality check predicate on the "business_key" field. It's identical to BusinessKeyEQ.
+func BusinessKey(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldEQ(FieldBusinessKey, v))
+}
+
+// ElementID applies equality check predicate on the "element_id" field. It's identical to ElementIDEQ.
+func ElementID(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldEQ(FieldElementID, v))
+}
+
+// AttachedTo applies equality check predicate on the "attached_to" field. It's identical to AttachedToEQ.
+func AttachedTo(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldEQ(FieldAttachedTo, v))
+}
+
+// Interrupting applies equality check predicate on the "interrupting" field. It's identical to InterruptingEQ.
+func Interrupting(v bool) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldEQ(FieldInterrupting, v))
+}
+
+// EventType applies equality check predicate on the "event_type" field. It's identical to EventTypeEQ.
+func EventType(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldEQ(FieldEventType, v))
+}
+
+// EventName applies equality check predicate on the "event_name" field. It's identical to EventNameEQ.
+func EventName(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldEQ(FieldEventName, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldEQ(FieldStatus, v))
+}
+
+// CorrelatedAt applies equality check predicate on the "correlated_at" field. It's identical to CorrelatedAtEQ.
+func CorrelatedAt(v time.Time) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldEQ(FieldCorrelatedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldLTE(FieldTenantID, v))
+}
+
+// ProcessInstanceIDEQ applies the EQ predicate on the "process_instance_id" field.
+func ProcessInstanceIDEQ(v int) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldEQ(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDNEQ applies the NEQ predicate on the "process_instance_id" field.
+func ProcessInstanceIDNEQ(v int) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldNEQ(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDIn applies the In predicate on the "process_instance_id" field.
+func ProcessInstanceIDIn(vs ...int) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldIn(FieldProcessInstanceID, vs...))
+}
+
+// ProcessInstanceIDNotIn applies the NotIn predicate on the "process_instance_id" field.
+func ProcessInstanceIDNotIn(vs ...int) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldNotIn(FieldProcessInstanceID, vs...))
+}
+
+// ProcessInstanceIDGT applies the GT predicate on the "process_instance_id" field.
+func ProcessInstanceIDGT(v int) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldGT(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDGTE applies the GTE predicate on the "process_instance_id" field.
+func ProcessInstanceIDGTE(v int) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldGTE(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDLT applies the LT predicate on the "process_instance_id" field.
+func ProcessInstanceIDLT(v int) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldLT(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDLTE applies the LTE predicate on the "process_instance_id" field.
+func ProcessInstanceIDLTE(v int) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldLTE(FieldProcessInstanceID, v))
+}
+
+// ProcessDefinitionKeyEQ applies the EQ predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyEQ(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldEQ(FieldProcessDefinitionKey, v))
+}
+
+// ProcessDefinitionKeyNEQ applies the NEQ predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyNEQ(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldNEQ(FieldProcessDefinitionKey, v))
+}
+
+// ProcessDefinitionKeyIn applies the In predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyIn(vs ...string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldIn(FieldProcessDefinitionKey, vs...))
+}
+
+// ProcessDefinitionKeyNotIn applies the NotIn predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyNotIn(vs ...string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldNotIn(FieldProcessDefinitionKey, vs...))
+}
+
+// ProcessDefinitionKeyGT applies the GT predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyGT(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldGT(FieldProcessDefinitionKey, v))
+}
+
+// ProcessDefinitionKeyGTE applies the GTE predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyGTE(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldGTE(FieldProcessDefinitionKey, v))
+}
+
+// ProcessDefinitionKeyLT applies the LT predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyLT(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldLT(FieldProcessDefinitionKey, v))
+}
+
+// ProcessDefinitionKeyLTE applies the LTE predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyLTE(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldLTE(FieldProcessDefinitionKey, v))
+}
+
+// ProcessDefinitionKeyContains applies the Contains predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyContains(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldContains(FieldProcessDefinitionKey, v))
+}
+
+// ProcessDefinitionKeyHasPrefix applies the HasPrefix predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyHasPrefix(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldHasPrefix(FieldProcessDefinitionKey, v))
+}
+
+// ProcessDefinitionKeyHasSuffix applies the HasSuffix predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyHasSuffix(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldHasSuffix(FieldProcessDefinitionKey, v))
+}
+
+// ProcessDefinitionKeyEqualFold applies the EqualFold predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyEqualFold(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldEqualFold(FieldProcessDefinitionKey, v))
+}
+
+// ProcessDefinitionKeyContainsFold applies the ContainsFold predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyContainsFold(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldContainsFold(FieldProcessDefinitionKey, v))
+}
+
+// BusinessKeyEQ applies the EQ predicate on the "business_key" field.
+func BusinessKeyEQ(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldEQ(FieldBusinessKey, v))
+}
+
+// BusinessKeyNEQ applies the NEQ predicate on the "business_key" field.
+func BusinessKeyNEQ(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldNEQ(FieldBusinessKey, v))
+}
+
+// BusinessKeyIn applies the In predicate on the "business_key" field.
+func BusinessKeyIn(vs ...string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldIn(FieldBusinessKey, vs...))
+}
+
+// BusinessKeyNotIn applies the NotIn predicate on the "business_key" field.
+func BusinessKeyNotIn(vs ...string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldNotIn(FieldBusinessKey, vs...))
+}
+
+// BusinessKeyGT applies the GT predicate on the "business_key" field.
+func BusinessKeyGT(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldGT(FieldBusinessKey, v))
+}
+
+// BusinessKeyGTE applies the GTE predicate on the "business_key" field.
+func BusinessKeyGTE(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldGTE(FieldBusinessKey, v))
+}
+
+// BusinessKeyLT applies the LT predicate on the "business_key" field.
+func BusinessKeyLT(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldLT(FieldBusinessKey, v))
+}
+
+// BusinessKeyLTE applies the LTE predicate on the "business_key" field.
+func BusinessKeyLTE(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldLTE(FieldBusinessKey, v))
+}
+
+// BusinessKeyContains applies the Contains predicate on the "business_key" field.
+func BusinessKeyContains(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldContains(FieldBusinessKey, v))
+}
+
+// BusinessKeyHasPrefix applies the HasPrefix predicate on the "business_key" field.
+func BusinessKeyHasPrefix(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldHasPrefix(FieldBusinessKey, v))
+}
+
+// BusinessKeyHasSuffix applies the HasSuffix predicate on the "business_key" field.
+func BusinessKeyHasSuffix(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldHasSuffix(FieldBusinessKey, v))
+}
+
+// BusinessKeyIsNil applies the IsNil predicate on the "business_key" field.
+func BusinessKeyIsNil() predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldIsNull(FieldBusinessKey))
+}
+
+// BusinessKeyNotNil applies the NotNil predicate on the "business_key" field.
+func BusinessKeyNotNil() predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldNotNull(FieldBusinessKey))
+}
+
+// BusinessKeyEqualFold applies the EqualFold predicate on the "business_key" field.
+func BusinessKeyEqualFold(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldEqualFold(FieldBusinessKey, v))
+}
+
+// BusinessKeyContainsFold applies the ContainsFold predicate on the "business_key" field.
+func BusinessKeyContainsFold(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldContainsFold(FieldBusinessKey, v))
+}
+
+// ElementIDEQ applies the EQ predicate on the "element_id" field.
+func ElementIDEQ(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldEQ(FieldElementID, v))
+}
+
+// ElementIDNEQ applies the NEQ predicate on the "element_id" field.
+func ElementIDNEQ(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldNEQ(FieldElementID, v))
+}
+
+// ElementIDIn applies the In predicate on the "element_id" field.
+func ElementIDIn(vs ...string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldIn(FieldElementID, vs...))
+}
+
+// ElementIDNotIn applies the NotIn predicate on the "element_id" field.
+func ElementIDNotIn(vs ...string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldNotIn(FieldElementID, vs...))
+}
+
+// ElementIDGT applies the GT predicate on the "element_id" field.
+func ElementIDGT(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldGT(FieldElementID, v))
+}
+
+// ElementIDGTE applies the GTE predicate on the "element_id" field.
+func ElementIDGTE(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldGTE(FieldElementID, v))
+}
+
+// ElementIDLT applies the LT predicate on the "element_id" field.
+func ElementIDLT(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldLT(FieldElementID, v))
+}
+
+// ElementIDLTE applies the LTE predicate on the "element_id" field.
+func ElementIDLTE(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldLTE(FieldElementID, v))
+}
+
+// ElementIDContains applies the Contains predicate on the "element_id" field.
+func ElementIDContains(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldContains(FieldElementID, v))
+}
+
+// ElementIDHasPrefix applies the HasPrefix predicate on the "element_id" field.
+func ElementIDHasPrefix(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldHasPrefix(FieldElementID, v))
+}
+
+// ElementIDHasSuffix applies the HasSuffix predicate on the "element_id" field.
+func ElementIDHasSuffix(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldHasSuffix(FieldElementID, v))
+}
+
+// ElementIDEqualFold applies the EqualFold predicate on the "element_id" field.
+func ElementIDEqualFold(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldEqualFold(FieldElementID, v))
+}
+
+// ElementIDContainsFold applies the ContainsFold predicate on the "element_id" field.
+func ElementIDContainsFold(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldContainsFold(FieldElementID, v))
+}
+
+// AttachedToEQ applies the EQ predicate on the "attached_to" field.
+func AttachedToEQ(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldEQ(FieldAttachedTo, v))
+}
+
+// AttachedToNEQ applies the NEQ predicate on the "attached_to" field.
+func AttachedToNEQ(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldNEQ(FieldAttachedTo, v))
+}
+
+// AttachedToIn applies the In predicate on the "attached_to" field.
+func AttachedToIn(vs ...string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldIn(FieldAttachedTo, vs...))
+}
+
+// AttachedToNotIn applies the NotIn predicate on the "attached_to" field.
+func AttachedToNotIn(vs ...string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldNotIn(FieldAttachedTo, vs...))
+}
+
+// AttachedToGT applies the GT predicate on the "attached_to" field.
+func AttachedToGT(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldGT(FieldAttachedTo, v))
+}
+
+// AttachedToGTE applies the GTE predicate on the "attached_to" field.
+func AttachedToGTE(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldGTE(FieldAttachedTo, v))
+}
+
+// AttachedToLT applies the LT predicate on the "attached_to" field.
+func AttachedToLT(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldLT(FieldAttachedTo, v))
+}
+
+// AttachedToLTE applies the LTE predicate on the "attached_to" field.
+func AttachedToLTE(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldLTE(FieldAttachedTo, v))
+}
+
+// AttachedToContains applies the Contains predicate on the "attached_to" field.
+func AttachedToContains(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldContains(FieldAttachedTo, v))
+}
+
+// AttachedToHasPrefix applies the HasPrefix predicate on the "attached_to" field.
+func AttachedToHasPrefix(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldHasPrefix(FieldAttachedTo, v))
+}
+
+// AttachedToHasSuffix applies the HasSuffix predicate on the "attached_to" field.
+func AttachedToHasSuffix(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldHasSuffix(FieldAttachedTo, v))
+}
+
+// AttachedToIsNil applies the IsNil predicate on the "attached_to" field.
+func AttachedToIsNil() predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldIsNull(FieldAttachedTo))
+}
+
+// AttachedToNotNil applies the NotNil predicate on the "attached_to" field.
+func AttachedToNotNil() predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldNotNull(FieldAttachedTo))
+}
+
+// AttachedToEqualFold applies the EqualFold predicate on the "attached_to" field.
+func AttachedToEqualFold(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldEqualFold(FieldAttachedTo, v))
+}
+
+// AttachedToContainsFold applies the ContainsFold predicate on the "attached_to" field.
+func AttachedToContainsFold(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldContainsFold(FieldAttachedTo, v))
+}
+
+// InterruptingEQ applies the EQ predicate on the "interrupting" field.
+func InterruptingEQ(v bool) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldEQ(FieldInterrupting, v))
+}
+
+// InterruptingNEQ applies the NEQ predicate on the "interrupting" field.
+func InterruptingNEQ(v bool) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldNEQ(FieldInterrupting, v))
+}
+
+// EventTypeEQ applies the EQ predicate on the "event_type" field.
+func EventTypeEQ(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldEQ(FieldEventType, v))
+}
+
+// EventTypeNEQ applies the NEQ predicate on the "event_type" field.
+func EventTypeNEQ(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldNEQ(FieldEventType, v))
+}
+
+// EventTypeIn applies the In predicate on the "event_type" field.
+func EventTypeIn(vs ...string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldIn(FieldEventType, vs...))
+}
+
+// EventTypeNotIn applies the NotIn predicate on the "event_type" field.
+func EventTypeNotIn(vs ...string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldNotIn(FieldEventType, vs...))
+}
+
+// EventTypeGT applies the GT predicate on the "event_type" field.
+func EventTypeGT(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldGT(FieldEventType, v))
+}
+
+// EventTypeGTE applies the GTE predicate on the "event_type" field.
+func EventTypeGTE(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldGTE(FieldEventType, v))
+}
+
+// EventTypeLT applies the LT predicate on the "event_type" field.
+func EventTypeLT(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldLT(FieldEventType, v))
+}
+
+// EventTypeLTE applies the LTE predicate on the "event_type" field.
+func EventTypeLTE(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldLTE(FieldEventType, v))
+}
+
+// EventTypeContains applies the Contains predicate on the "event_type" field.
+func EventTypeContains(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldContains(FieldEventType, v))
+}
+
+// EventTypeHasPrefix applies the HasPrefix predicate on the "event_type" field.
+func EventTypeHasPrefix(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldHasPrefix(FieldEventType, v))
+}
+
+// EventTypeHasSuffix applies the HasSuffix predicate on the "event_type" field.
+func EventTypeHasSuffix(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldHasSuffix(FieldEventType, v))
+}
+
+// EventTypeEqualFold applies the EqualFold predicate on the "event_type" field.
+func EventTypeEqualFold(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldEqualFold(FieldEventType, v))
+}
+
+// EventTypeContainsFold applies the ContainsFold predicate on the "event_type" field.
+func EventTypeContainsFold(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldContainsFold(FieldEventType, v))
+}
+
+// EventNameEQ applies the EQ predicate on the "event_name" field.
+func EventNameEQ(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldEQ(FieldEventName, v))
+}
+
+// EventNameNEQ applies the NEQ predicate on the "event_name" field.
+func EventNameNEQ(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldNEQ(FieldEventName, v))
+}
+
+// EventNameIn applies the In predicate on the "event_name" field.
+func EventNameIn(vs ...string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldIn(FieldEventName, vs...))
+}
+
+// EventNameNotIn applies the NotIn predicate on the "event_name" field.
+func EventNameNotIn(vs ...string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldNotIn(FieldEventName, vs...))
+}
+
+// EventNameGT applies the GT predicate on the "event_name" field.
+func EventNameGT(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldGT(FieldEventName, v))
+}
+
+// EventNameGTE applies the GTE predicate on the "event_name" field.
+func EventNameGTE(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldGTE(FieldEventName, v))
+}
+
+// EventNameLT applies the LT predicate on the "event_name" field.
+func EventNameLT(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldLT(FieldEventName, v))
+}
+
+// EventNameLTE applies the LTE predicate on the "event_name" field.
+func EventNameLTE(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldLTE(FieldEventName, v))
+}
+
+// EventNameContains applies the Contains predicate on the "event_name" field.
+func EventNameContains(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldContains(FieldEventName, v))
+}
+
+// EventNameHasPrefix applies the HasPrefix predicate on the "event_name" field.
+func EventNameHasPrefix(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldHasPrefix(FieldEventName, v))
+}
+
+// EventNameHasSuffix applies the HasSuffix predicate on the "event_name" field.
+func EventNameHasSuffix(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldHasSuffix(FieldEventName, v))
+}
+
+// EventNameEqualFold applies the EqualFold predicate on the "event_name" field.
+func EventNameEqualFold(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldEqualFold(FieldEventName, v))
+}
+
+// EventNameContainsFold applies the ContainsFold predicate on the "event_name" field.
+func EventNameContainsFold(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldContainsFold(FieldEventName, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CorrelatedAtEQ applies the EQ predicate on the "correlated_at" field.
+func CorrelatedAtEQ(v time.Time) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldEQ(FieldCorrelatedAt, v))
+}
+
+// CorrelatedAtNEQ applies the NEQ predicate on the "correlated_at" field.
+func CorrelatedAtNEQ(v time.Time) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldNEQ(FieldCorrelatedAt, v))
+}
+
+// CorrelatedAtIn applies the In predicate on the "correlated_at" field.
+func CorrelatedAtIn(vs ...time.Time) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldIn(FieldCorrelatedAt, vs...))
+}
+
+// CorrelatedAtNotIn applies the NotIn predicate on the "correlated_at" field.
+func CorrelatedAtNotIn(vs ...time.Time) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldNotIn(FieldCorrelatedAt, vs...))
+}
+
+// CorrelatedAtGT applies the GT predicate on the "correlated_at" field.
+func CorrelatedAtGT(v time.Time) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldGT(FieldCorrelatedAt, v))
+}
+
+// CorrelatedAtGTE applies the GTE predicate on the "correlated_at" field.
+func CorrelatedAtGTE(v time.Time) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldGTE(FieldCorrelatedAt, v))
+}
+
+// CorrelatedAtLT applies the LT predicate on the "correlated_at" field.
+func CorrelatedAtLT(v time.Time) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldLT(FieldCorrelatedAt, v))
+}
+
+// CorrelatedAtLTE applies the LTE predicate on the "correlated_at" field.
+func CorrelatedAtLTE(v time.Time) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldLTE(FieldCorrelatedAt, v))
+}
+
+// CorrelatedAtIsNil applies the IsNil predicate on the "correlated_at" field.
+func CorrelatedAtIsNil() predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldIsNull(FieldCorrelatedAt))
+}
+
+// CorrelatedAtNotNil applies the NotNil predicate on the "correlated_at" field.
+func CorrelatedAtNotNil() predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldNotNull(FieldCorrelatedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProcessEventSubscription) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProcessEventSubscription) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProcessEventSubscription) predicate.ProcessEventSubscription {
+	return predicate.ProcessEventSubscription(sql.NotPredicates(p))
+}
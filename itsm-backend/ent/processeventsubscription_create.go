@@ -0,0 +1,444 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/processeventsubscription"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProcessEventSubscriptionCreate is the builder for creating a ProcessEventSubscription entity.
+type ProcessEventSubscriptionCreate struct {
+	config
+	mutation *ProcessEventSubscriptionMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ProcessEventSubscriptionCreate) SetTenantID(v int) *ProcessEventSubscriptionCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetProcessInstanceID sets the "process_instance_id" field.
+func (_c *ProcessEventSubscriptionCreate) SetProcessInstanceID(v int) *ProcessEventSubscriptionCreate {
+	_c.mutation.SetProcessInstanceID(v)
+	return _c
+}
+
+// SetProcessDefinitionKey sets the "process_definition_key" field.
+func (_c *ProcessEventSubscriptionCreate) SetProcessDefinitionKey(v string) *ProcessEventSubscriptionCreate {
+	_c.mutation.SetProcessDefinitionKey(v)
+	return _c
+}
+
+// SetBusinessKey sets the "business_key" field.
+func (_c *ProcessEventSubscriptionCreate) SetBusinessKey(v string) *ProcessEventSubscriptionCreate {
+	_c.mutation.SetBusinessKey(v)
+	return _c
+}
+
+// SetNillableBusinessKey sets the "business_key" field if the given value is not nil.
+func (_c *ProcessEventSubscriptionCreate) SetNillableBusinessKey(v *string) *ProcessEventSubscriptionCreate {
+	if v != nil {
+		_c.SetBusinessKey(*v)
+	}
+	return _c
+}
+
+// SetElementID sets the "element_id" field.
+func (_c *ProcessEventSubscriptionCreate) SetElementID(v string) *ProcessEventSubscriptionCreate {
+	_c.mutation.SetElementID(v)
+	return _c
+}
+
+// SetAttachedTo sets the "attached_to" field.
+func (_c *ProcessEventSubscriptionCreate) SetAttachedTo(v string) *ProcessEventSubscriptionCreate {
+	_c.mutation.SetAttachedTo(v)
+	return _c
+}
+
+// SetNillableAttachedTo sets the "attached_to" field if the given value is not nil.
+func (_c *ProcessEventSubscriptionCreate) SetNillableAttachedTo(v *string) *ProcessEventSubscriptionCreate {
+	if v != nil {
+		_c.SetAttachedTo(*v)
+	}
+	return _c
+}
+
+// SetInterrupting sets the "interrupting" field.
+func (_c *ProcessEventSubscriptionCreate) SetInterrupting(v bool) *ProcessEventSubscriptionCreate {
+	_c.mutation.SetInterrupting(v)
+	return _c
+}
+
+// SetNillableInterrupting sets the "interrupting" field if the given value is not nil.
+func (_c *ProcessEventSubscriptionCreate) SetNillableInterrupting(v *bool) *ProcessEventSubscriptionCreate {
+	if v != nil {
+		_c.SetInterrupting(*v)
+	}
+	return _c
+}
+
+// SetEventType sets the "event_type" field.
+func (_c *ProcessEventSubscriptionCreate) SetEventType(v string) *ProcessEventSubscriptionCreate {
+	_c.mutation.SetEventType(v)
+	return _c
+}
+
+// SetEventName sets the "event_name" field.
+func (_c *ProcessEventSubscriptionCreate) SetEventName(v string) *ProcessEventSubscriptionCreate {
+	_c.mutation.SetEventName(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ProcessEventSubscriptionCreate) SetStatus(v string) *ProcessEventSubscriptionCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ProcessEventSubscriptionCreate) SetNillableStatus(v *string) *ProcessEventSubscriptionCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetCorrelatedAt sets the "correlated_at" field.
+func (_c *ProcessEventSubscriptionCreate) SetCorrelatedAt(v time.Time) *ProcessEventSubscriptionCreate {
+	_c.mutation.SetCorrelatedAt(v)
+	return _c
+}
+
+// SetNillableCorrelatedAt sets the "correlated_at" field if the given value is not nil.
+func (_c *ProcessEventSubscriptionCreate) SetNillableCorrelatedAt(v *time.Time) *ProcessEventSubscriptionCreate {
+	if v != nil {
+		_c.SetCorrelatedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ProcessEventSubscriptionCreate) SetCreatedAt(v time.Time) *ProcessEventSubscriptionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ProcessEventSubscriptionCreate) SetNillableCreatedAt(v *time.Time) *ProcessEventSubscriptionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ProcessEventSubscriptionCreate) SetUpdatedAt(v time.Time) *ProcessEventSubscriptionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ProcessEventSubscriptionCreate) SetNillableUpdatedAt(v *time.Time) *ProcessEventSubscriptionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ProcessEventSubscriptionMutation object of the builder.
+func (_c *ProcessEventSubscriptionCreate) Mutation() *ProcessEventSubscriptionMutation {
+	return _c.mutation
+}
+
+// Save creates the ProcessEventSubscription in the database.
+func (_c *ProcessEventSubscriptionCreate) Save(ctx context.Context) (*ProcessEventSubscription, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ProcessEventSubscriptionCreate) SaveX(ctx context.Context) *ProcessEventSubscription {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProcessEventSubscriptionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProcessEventSubscriptionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ProcessEventSubscriptionCreate) defaults() {
+	if _, ok := _c.mutation.Interrupting(); !ok {
+		v := processeventsubscription.DefaultInterrupting
+		_c.mutation.SetInterrupting(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := processeventsubscription.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := processeventsubscription.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := processeventsubscription.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ProcessEventSubscriptionCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "ProcessEventSubscription.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := processeventsubscription.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "ProcessEventSubscription.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ProcessInstanceID(); !ok {
+		return &ValidationError{Name: "process_instance_id", err: errors.New(`ent: missing required field "ProcessEventSubscription.process_instance_id"`)}
+	}
+	if v, ok := _c.mutation.ProcessInstanceID(); ok {
+		if err := processeventsubscription.ProcessInstanceIDValidator(v); err != nil {
+			return &ValidationError{Name: "process_instance_id", err: fmt.Errorf(`ent: validator failed for field "ProcessEventSubscription.process_instance_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ProcessDefinitionKey(); !ok {
+		return &ValidationError{Name: "process_definition_key", err: errors.New(`ent: missing required field "ProcessEventSubscription.process_definition_key"`)}
+	}
+	if v, ok := _c.mutation.ProcessDefinitionKey(); ok {
+		if err := processeventsubscription.ProcessDefinitionKeyValidator(v); err != nil {
+			return &ValidationError{Name: "process_definition_key", err: fmt.Errorf(`ent: validator failed for field "ProcessEventSubscription.process_definition_key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ElementID(); !ok {
+		return &ValidationError{Name: "element_id", err: errors.New(`ent: missing required field "ProcessEventSubscription.element_id"`)}
+	}
+	if v, ok := _c.mutation.ElementID(); ok {
+		if err := processeventsubscription.ElementIDValidator(v); err != nil {
+			return &ValidationError{Name: "element_id", err: fmt.Errorf(`ent: validator failed for field "ProcessEventSubscription.element_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Interrupting(); !ok {
+		return &ValidationError{Name: "interrupting", err: errors.New(`ent: missing required field "ProcessEventSubscription.interrupting"`)}
+	}
+	if _, ok := _c.mutation.EventType(); !ok {
+		return &ValidationError{Name: "event_type", err: errors.New(`ent: missing required field "ProcessEventSubscription.event_type"`)}
+	}
+	if v, ok := _c.mutation.EventType(); ok {
+		if err := processeventsubscription.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "ProcessEventSubscription.event_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EventName(); !ok {
+		return &ValidationError{Name: "event_name", err: errors.New(`ent: missing required field "ProcessEventSubscription.event_name"`)}
+	}
+	if v, ok := _c.mutation.EventName(); ok {
+		if err := processeventsubscription.EventNameValidator(v); err != nil {
+			return &ValidationError{Name: "event_name", err: fmt.Errorf(`ent: validator failed for field "ProcessEventSubscription.event_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ProcessEventSubscription.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := processeventsubscription.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ProcessEventSubscription.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProcessEventSubscription.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ProcessEventSubscription.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *ProcessEventSubscriptionCreate) sqlSave(ctx context.Context) (*ProcessEventSubscription, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ProcessEventSubscriptionCreate) createSpec() (*ProcessEventSubscription, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProcessEventSubscription{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(processeventsubscription.Table, sqlgraph.NewFieldSpec(processeventsubscription.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(processeventsubscription.FieldTenantID, field.TypeInt, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.ProcessInstanceID(); ok {
+		_spec.SetField(processeventsubscription.FieldProcessInstanceID, field.TypeInt, value)
+		_node.ProcessInstanceID = value
+	}
+	if value, ok := _c.mutation.ProcessDefinitionKey(); ok {
+		_spec.SetField(processeventsubscription.FieldProcessDefinitionKey, field.TypeString, value)
+		_node.ProcessDefinitionKey = value
+	}
+	if value, ok := _c.mutation.BusinessKey(); ok {
+		_spec.SetField(processeventsubscription.FieldBusinessKey, field.TypeString, value)
+		_node.BusinessKey = value
+	}
+	if value, ok := _c.mutation.ElementID(); ok {
+		_spec.SetField(processeventsubscription.FieldElementID, field.TypeString, value)
+		_node.ElementID = value
+	}
+	if value, ok := _c.mutation.AttachedTo(); ok {
+		_spec.SetField(processeventsubscription.FieldAttachedTo, field.TypeString, value)
+		_node.AttachedTo = value
+	}
+	if value, ok := _c.mutation.Interrupting(); ok {
+		_spec.SetField(processeventsubscription.FieldInterrupting, field.TypeBool, value)
+		_node.Interrupting = value
+	}
+	if value, ok := _c.mutation.EventType(); ok {
+		_spec.SetField(processeventsubscription.FieldEventType, field.TypeString, value)
+		_node.EventType = value
+	}
+	if value, ok := _c.mutation.EventName(); ok {
+		_spec.SetField(processeventsubscription.FieldEventName, field.TypeString, value)
+		_node.EventName = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(processeventsubscription.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.CorrelatedAt(); ok {
+		_spec.SetField(processeventsubscription.FieldCorrelatedAt, field.TypeTime, value)
+		_node.CorrelatedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(processeventsubscription.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(processeventsubscription.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// ProcessEventSubscriptionCreateBulk is the builder for creating many ProcessEventSubscription entities in bulk.
+type ProcessEventSubscriptionCreateBulk struct {
+	config
+	err      error
+	builders []*ProcessEventSubscriptionCreate
+}
+
+// Save creates the ProcessEventSubscription entities in the database.
+func (_c *ProcessEventSubscriptionCreateBulk) Save(ctx context.Context) ([]*ProcessEventSubscription, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ProcessEventSubscription, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProcessEventSubscriptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ProcessEventSubscriptionCreateBulk) SaveX(ctx context.Context) []*ProcessEventSubscription {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProcessEventSubscriptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProcessEventSubscriptionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"itsm-backend/ent/predicate"
+	"itsm-backend/ent/processeventsubscription"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProcessEventSubscriptionDelete is the builder for deleting a ProcessEventSubscription entity.
+type ProcessEventSubscriptionDelete struct {
+	config
+	hooks    []Hook
+	mutation *ProcessEventSubscriptionMutation
+}
+
+// Where appends a list predicates to the ProcessEventSubscriptionDelete builder.
+func (_d *ProcessEventSubscriptionDelete) Where(ps ...predicate.ProcessEventSubscription) *ProcessEventSubscriptionDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ProcessEventSubscriptionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ProcessEventSubscriptionDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ProcessEventSubscriptionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(processeventsubscription.Table, sqlgraph.NewFieldSpec(processeventsubscription.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ProcessEventSubscriptionDeleteOne is the builder for deleting a single ProcessEventSubscription entity.
+type ProcessEventSubscriptionDeleteOne struct {
+	_d *ProcessEventSubscriptionDelete
+}
+
+// Where appends a list predicates to the ProcessEventSubscriptionDelete builder.
+func (_d *ProcessEventSubscriptionDeleteOne) Where(ps ...predicate.ProcessEventSubscription) *ProcessEventSubscriptionDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ProcessEventSubscriptionDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{processeventsubscription.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ProcessEventSubscriptionDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,800 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/predicate"
+	"itsm-backend/ent/processeventsubscription"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProcessEventSubscriptionUpdate is the builder for updating ProcessEventSubscription entities.
+type ProcessEventSubscriptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProcessEventSubscriptionMutation
+}
+
+// Where appends a list predicates to the ProcessEventSubscriptionUpdate builder.
+func (_u *ProcessEventSubscriptionUpdate) Where(ps ...predicate.ProcessEventSubscription) *ProcessEventSubscriptionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *ProcessEventSubscriptionUpdate) SetTenantID(v int) *ProcessEventSubscriptionUpdate {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *ProcessEventSubscriptionUpdate) SetNillableTenantID(v *int) *ProcessEventSubscriptionUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *ProcessEventSubscriptionUpdate) AddTenantID(v int) *ProcessEventSubscriptionUpdate {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetProcessInstanceID sets the "process_instance_id" field.
+func (_u *ProcessEventSubscriptionUpdate) SetProcessInstanceID(v int) *ProcessEventSubscriptionUpdate {
+	_u.mutation.ResetProcessInstanceID()
+	_u.mutation.SetProcessInstanceID(v)
+	return _u
+}
+
+// SetNillableProcessInstanceID sets the "process_instance_id" field if the given value is not nil.
+func (_u *ProcessEventSubscriptionUpdate) SetNillableProcessInstanceID(v *int) *ProcessEventSubscriptionUpdate {
+	if v != nil {
+		_u.SetProcessInstanceID(*v)
+	}
+	return _u
+}
+
+// AddProcessInstanceID adds value to the "process_instance_id" field.
+func (_u *ProcessEventSubscriptionUpdate) AddProcessInstanceID(v int) *ProcessEventSubscriptionUpdate {
+	_u.mutation.AddProcessInstanceID(v)
+	return _u
+}
+
+// SetProcessDefinitionKey sets the "process_definition_key" field.
+func (_u *ProcessEventSubscriptionUpdate) SetProcessDefinitionKey(v string) *ProcessEventSubscriptionUpdate {
+	_u.mutation.SetProcessDefinitionKey(v)
+	return _u
+}
+
+// SetNillableProcessDefinitionKey sets the "process_definition_key" field if the given value is not nil.
+func (_u *ProcessEventSubscriptionUpdate) SetNillableProcessDefinitionKey(v *string) *ProcessEventSubscriptionUpdate {
+	if v != nil {
+		_u.SetProcessDefinitionKey(*v)
+	}
+	return _u
+}
+
+// SetBusinessKey sets the "business_key" field.
+func (_u *ProcessEventSubscriptionUpdate) SetBusinessKey(v string) *ProcessEventSubscriptionUpdate {
+	_u.mutation.SetBusinessKey(v)
+	return _u
+}
+
+// SetNillableBusinessKey sets the "business_key" field if the given value is not nil.
+func (_u *ProcessEventSubscriptionUpdate) SetNillableBusinessKey(v *string) *ProcessEventSubscriptionUpdate {
+	if v != nil {
+		_u.SetBusinessKey(*v)
+	}
+	return _u
+}
+
+// ClearBusinessKey clears the value of the "business_key" field.
+func (_u *ProcessEventSubscriptionUpdate) ClearBusinessKey() *ProcessEventSubscriptionUpdate {
+	_u.mutation.ClearBusinessKey()
+	return _u
+}
+
+// SetElementID sets the "element_id" field.
+func (_u *ProcessEventSubscriptionUpdate) SetElementID(v string) *ProcessEventSubscriptionUpdate {
+	_u.mutation.SetElementID(v)
+	return _u
+}
+
+// SetNillableElementID sets the "element_id" field if the given value is not nil.
+func (_u *ProcessEventSubscriptionUpdate) SetNillableElementID(v *string) *ProcessEventSubscriptionUpdate {
+	if v != nil {
+		_u.SetElementID(*v)
+	}
+	return _u
+}
+
+// SetAttachedTo sets the "attached_to" field.
+func (_u *ProcessEventSubscriptionUpdate) SetAttachedTo(v string) *ProcessEventSubscriptionUpdate {
+	_u.mutation.SetAttachedTo(v)
+	return _u
+}
+
+// SetNillableAttachedTo sets the "attached_to" field if the given value is not nil.
+func (_u *ProcessEventSubscriptionUpdate) SetNillableAttachedTo(v *string) *ProcessEventSubscriptionUpdate {
+	if v != nil {
+		_u.SetAttachedTo(*v)
+	}
+	return _u
+}
+
+// ClearAttachedTo clears the value of the "attached_to" field.
+func (_u *ProcessEventSubscriptionUpdate) ClearAttachedTo() *ProcessEventSubscriptionUpdate {
+	_u.mutation.ClearAttachedTo()
+	return _u
+}
+
+// SetInterrupting sets the "interrupting" field.
+func (_u *ProcessEventSubscriptionUpdate) SetInterrupting(v bool) *ProcessEventSubscriptionUpdate {
+	_u.mutation.SetInterrupting(v)
+	return _u
+}
+
+// SetNillableInterrupting sets the "interrupting" field if the given value is not nil.
+func (_u *ProcessEventSubscriptionUpdate) SetNillableInterrupting(v *bool) *ProcessEventSubscriptionUpdate {
+	if v != nil {
+		_u.SetInterrupting(*v)
+	}
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *ProcessEventSubscriptionUpdate) SetEventType(v string) *ProcessEventSubscriptionUpdate {
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *ProcessEventSubscriptionUpdate) SetNillableEventType(v *string) *ProcessEventSubscriptionUpdate {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// SetEventName sets the "event_name" field.
+func (_u *ProcessEventSubscriptionUpdate) SetEventName(v string) *ProcessEventSubscriptionUpdate {
+	_u.mutation.SetEventName(v)
+	return _u
+}
+
+// SetNillableEventName sets the "event_name" field if the given value is not nil.
+func (_u *ProcessEventSubscriptionUpdate) SetNillableEventName(v *string) *ProcessEventSubscriptionUpdate {
+	if v != nil {
+		_u.SetEventName(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ProcessEventSubscriptionUpdate) SetStatus(v string) *ProcessEventSubscriptionUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ProcessEventSubscriptionUpdate) SetNillableStatus(v *string) *ProcessEventSubscriptionUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetCorrelatedAt sets the "correlated_at" field.
+func (_u *ProcessEventSubscriptionUpdate) SetCorrelatedAt(v time.Time) *ProcessEventSubscriptionUpdate {
+	_u.mutation.SetCorrelatedAt(v)
+	return _u
+}
+
+// SetNillableCorrelatedAt sets the "correlated_at" field if the given value is not nil.
+func (_u *ProcessEventSubscriptionUpdate) SetNillableCorrelatedAt(v *time.Time) *ProcessEventSubscriptionUpdate {
+	if v != nil {
+		_u.SetCorrelatedAt(*v)
+	}
+	return _u
+}
+
+// ClearCorrelatedAt clears the value of the "correlated_at" field.
+func (_u *ProcessEventSubscriptionUpdate) ClearCorrelatedAt() *ProcessEventSubscriptionUpdate {
+	_u.mutation.ClearCorrelatedAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ProcessEventSubscriptionUpdate) SetCreatedAt(v time.Time) *ProcessEventSubscriptionUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ProcessEventSubscriptionUpdate) SetNillableCreatedAt(v *time.Time) *ProcessEventSubscriptionUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ProcessEventSubscriptionUpdate) SetUpdatedAt(v time.Time) *ProcessEventSubscriptionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ProcessEventSubscriptionMutation object of the builder.
+func (_u *ProcessEventSubscriptionUpdate) Mutation() *ProcessEventSubscriptionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ProcessEventSubscriptionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProcessEventSubscriptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ProcessEventSubscriptionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProcessEventSubscriptionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ProcessEventSubscriptionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := processeventsubscription.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ProcessEventSubscriptionUpdate) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := processeventsubscription.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "ProcessEventSubscription.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ProcessInstanceID(); ok {
+		if err := processeventsubscription.ProcessInstanceIDValidator(v); err != nil {
+			return &ValidationError{Name: "process_instance_id", err: fmt.Errorf(`ent: validator failed for field "ProcessEventSubscription.process_instance_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ProcessDefinitionKey(); ok {
+		if err := processeventsubscription.ProcessDefinitionKeyValidator(v); err != nil {
+			return &ValidationError{Name: "process_definition_key", err: fmt.Errorf(`ent: validator failed for field "ProcessEventSubscription.process_definition_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ElementID(); ok {
+		if err := processeventsubscription.ElementIDValidator(v); err != nil {
+			return &ValidationError{Name: "element_id", err: fmt.Errorf(`ent: validator failed for field "ProcessEventSubscription.element_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EventType(); ok {
+		if err := processeventsubscription.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "ProcessEventSubscription.event_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EventName(); ok {
+		if err := processeventsubscription.EventNameValidator(v); err != nil {
+			return &ValidationError{Name: "event_name", err: fmt.Errorf(`ent: validator failed for field "ProcessEventSubscription.event_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := processeventsubscription.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ProcessEventSubscription.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ProcessEventSubscriptionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(processeventsubscription.Table, processeventsubscription.Columns, sqlgraph.NewFieldSpec(processeventsubscription.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(processeventsubscription.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(processeventsubscription.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ProcessInstanceID(); ok {
+		_spec.SetField(processeventsubscription.FieldProcessInstanceID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProcessInstanceID(); ok {
+		_spec.AddField(processeventsubscription.FieldProcessInstanceID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ProcessDefinitionKey(); ok {
+		_spec.SetField(processeventsubscription.FieldProcessDefinitionKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BusinessKey(); ok {
+		_spec.SetField(processeventsubscription.FieldBusinessKey, field.TypeString, value)
+	}
+	if _u.mutation.BusinessKeyCleared() {
+		_spec.ClearField(processeventsubscription.FieldBusinessKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.ElementID(); ok {
+		_spec.SetField(processeventsubscription.FieldElementID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AttachedTo(); ok {
+		_spec.SetField(processeventsubscription.FieldAttachedTo, field.TypeString, value)
+	}
+	if _u.mutation.AttachedToCleared() {
+		_spec.ClearField(processeventsubscription.FieldAttachedTo, field.TypeString)
+	}
+	if value, ok := _u.mutation.Interrupting(); ok {
+		_spec.SetField(processeventsubscription.FieldInterrupting, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(processeventsubscription.FieldEventType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventName(); ok {
+		_spec.SetField(processeventsubscription.FieldEventName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(processeventsubscription.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CorrelatedAt(); ok {
+		_spec.SetField(processeventsubscription.FieldCorrelatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CorrelatedAtCleared() {
+		_spec.ClearField(processeventsubscription.FieldCorrelatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(processeventsubscription.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(processeventsubscription.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{processeventsubscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ProcessEventSubscriptionUpdateOne is the builder for updating a single ProcessEventSubscription entity.
+type ProcessEventSubscriptionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProcessEventSubscriptionMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *ProcessEventSubscriptionUpdateOne) SetTenantID(v int) *ProcessEventSubscriptionUpdateOne {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *ProcessEventSubscriptionUpdateOne) SetNillableTenantID(v *int) *ProcessEventSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *ProcessEventSubscriptionUpdateOne) AddTenantID(v int) *ProcessEventSubscriptionUpdateOne {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetProcessInstanceID sets the "process_instance_id" field.
+func (_u *ProcessEventSubscriptionUpdateOne) SetProcessInstanceID(v int) *ProcessEventSubscriptionUpdateOne {
+	_u.mutation.ResetProcessInstanceID()
+	_u.mutation.SetProcessInstanceID(v)
+	return _u
+}
+
+// SetNillableProcessInstanceID sets the "process_instance_id" field if the given value is not nil.
+func (_u *ProcessEventSubscriptionUpdateOne) SetNillableProcessInstanceID(v *int) *ProcessEventSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetProcessInstanceID(*v)
+	}
+	return _u
+}
+
+// AddProcessInstanceID adds value to the "process_instance_id" field.
+func (_u *ProcessEventSubscriptionUpdateOne) AddProcessInstanceID(v int) *ProcessEventSubscriptionUpdateOne {
+	_u.mutation.AddProcessInstanceID(v)
+	return _u
+}
+
+// SetProcessDefinitionKey sets the "process_definition_key" field.
+func (_u *ProcessEventSubscriptionUpdateOne) SetProcessDefinitionKey(v string) *ProcessEventSubscriptionUpdateOne {
+	_u.mutation.SetProcessDefinitionKey(v)
+	return _u
+}
+
+// SetNillableProcessDefinitionKey sets the "process_definition_key" field if the given value is not nil.
+func (_u *ProcessEventSubscriptionUpdateOne) SetNillableProcessDefinitionKey(v *string) *ProcessEventSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetProcessDefinitionKey(*v)
+	}
+	return _u
+}
+
+// SetBusinessKey sets the "business_key" field.
+func (_u *ProcessEventSubscriptionUpdateOne) SetBusinessKey(v string) *ProcessEventSubscriptionUpdateOne {
+	_u.mutation.SetBusinessKey(v)
+	return _u
+}
+
+// SetNillableBusinessKey sets the "business_key" field if the given value is not nil.
+func (_u *ProcessEventSubscriptionUpdateOne) SetNillableBusinessKey(v *string) *ProcessEventSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetBusinessKey(*v)
+	}
+	return _u
+}
+
+// ClearBusinessKey clears the value of the "business_key" field.
+func (_u *ProcessEventSubscriptionUpdateOne) ClearBusinessKey() *ProcessEventSubscriptionUpdateOne {
+	_u.mutation.ClearBusinessKey()
+	return _u
+}
+
+// SetElementID sets the "element_id" field.
+func (_u *ProcessEventSubscriptionUpdateOne) SetElementID(v string) *ProcessEventSubscriptionUpdateOne {
+	_u.mutation.SetElementID(v)
+	return _u
+}
+
+// SetNillableElementID sets the "element_id" field if the given value is not nil.
+func (_u *ProcessEventSubscriptionUpdateOne) SetNillableElementID(v *string) *ProcessEventSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetElementID(*v)
+	}
+	return _u
+}
+
+// SetAttachedTo sets the "attached_to" field.
+func (_u *ProcessEventSubscriptionUpdateOne) SetAttachedTo(v string) *ProcessEventSubscriptionUpdateOne {
+	_u.mutation.SetAttachedTo(v)
+	return _u
+}
+
+// SetNillableAttachedTo sets the "attached_to" field if the given value is not nil.
+func (_u *ProcessEventSubscriptionUpdateOne) SetNillableAttachedTo(v *string) *ProcessEventSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetAttachedTo(*v)
+	}
+	return _u
+}
+
+// ClearAttachedTo clears the value of the "attached_to" field.
+func (_u *ProcessEventSubscriptionUpdateOne) ClearAttachedTo() *ProcessEventSubscriptionUpdateOne {
+	_u.mutation.ClearAttachedTo()
+	return _u
+}
+
+// SetInterrupting sets the "interrupting" field.
+func (_u *ProcessEventSubscriptionUpdateOne) SetInterrupting(v bool) *ProcessEventSubscriptionUpdateOne {
+	_u.mutation.SetInterrupting(v)
+	return _u
+}
+
+// SetNillableInterrupting sets the "interrupting" field if the given value is not nil.
+func (_u *ProcessEventSubscriptionUpdateOne) SetNillableInterrupting(v *bool) *ProcessEventSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetInterrupting(*v)
+	}
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *ProcessEventSubscriptionUpdateOne) SetEventType(v string) *ProcessEventSubscriptionUpdateOne {
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *ProcessEventSubscriptionUpdateOne) SetNillableEventType(v *string) *ProcessEventSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// SetEventName sets the "event_name" field.
+func (_u *ProcessEventSubscriptionUpdateOne) SetEventName(v string) *ProcessEventSubscriptionUpdateOne {
+	_u.mutation.SetEventName(v)
+	return _u
+}
+
+// SetNillableEventName sets the "event_name" field if the given value is not nil.
+func (_u *ProcessEventSubscriptionUpdateOne) SetNillableEventName(v *string) *ProcessEventSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetEventName(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ProcessEventSubscriptionUpdateOne) SetStatus(v string) *ProcessEventSubscriptionUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ProcessEventSubscriptionUpdateOne) SetNillableStatus(v *string) *ProcessEventSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetCorrelatedAt sets the "correlated_at" field.
+func (_u *ProcessEventSubscriptionUpdateOne) SetCorrelatedAt(v time.Time) *ProcessEventSubscriptionUpdateOne {
+	_u.mutation.SetCorrelatedAt(v)
+	return _u
+}
+
+// SetNillableCorrelatedAt sets the "correlated_at" field if the given value is not nil.
+func (_u *ProcessEventSubscriptionUpdateOne) SetNillableCorrelatedAt(v *time.Time) *ProcessEventSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetCorrelatedAt(*v)
+	}
+	return _u
+}
+
+// ClearCorrelatedAt clears the value of the "correlated_at" field.
+func (_u *ProcessEventSubscriptionUpdateOne) ClearCorrelatedAt() *ProcessEventSubscriptionUpdateOne {
+	_u.mutation.ClearCorrelatedAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ProcessEventSubscriptionUpdateOne) SetCreatedAt(v time.Time) *ProcessEventSubscriptionUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ProcessEventSubscriptionUpdateOne) SetNillableCreatedAt(v *time.Time) *ProcessEventSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ProcessEventSubscriptionUpdateOne) SetUpdatedAt(v time.Time) *ProcessEventSubscriptionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ProcessEventSubscriptionMutation object of the builder.
+func (_u *ProcessEventSubscriptionUpdateOne) Mutation() *ProcessEventSubscriptionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ProcessEventSubscriptionUpdate builder.
+func (_u *ProcessEventSubscriptionUpdateOne) Where(ps ...predicate.ProcessEventSubscription) *ProcessEventSubscriptionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ProcessEventSubscriptionUpdateOne) Select(field string, fields ...string) *ProcessEventSubscriptionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ProcessEventSubscription entity.
+func (_u *ProcessEventSubscriptionUpdateOne) Save(ctx context.Context) (*ProcessEventSubscription, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProcessEventSubscriptionUpdateOne) SaveX(ctx context.Context) *ProcessEventSubscription {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ProcessEventSubscriptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProcessEventSubscriptionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ProcessEventSubscriptionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := processeventsubscription.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ProcessEventSubscriptionUpdateOne) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := processeventsubscription.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "ProcessEventSubscription.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ProcessInstanceID(); ok {
+		if err := processeventsubscription.ProcessInstanceIDValidator(v); err != nil {
+			return &ValidationError{Name: "process_instance_id", err: fmt.Errorf(`ent: validator failed for field "ProcessEventSubscription.process_instance_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ProcessDefinitionKey(); ok {
+		if err := processeventsubscription.ProcessDefinitionKeyValidator(v); err != nil {
+			return &ValidationError{Name: "process_definition_key", err: fmt.Errorf(`ent: validator failed for field "ProcessEventSubscription.process_definition_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ElementID(); ok {
+		if err := processeventsubscription.ElementIDValidator(v); err != nil {
+			return &ValidationError{Name: "element_id", err: fmt.Errorf(`ent: validator failed for field "ProcessEventSubscription.element_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EventType(); ok {
+		if err := processeventsubscription.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "ProcessEventSubscription.event_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EventName(); ok {
+		if err := processeventsubscription.EventNameValidator(v); err != nil {
+			return &ValidationError{Name: "event_name", err: fmt.Errorf(`ent: validator failed for field "ProcessEventSubscription.event_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := processeventsubscription.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ProcessEventSubscription.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ProcessEventSubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *ProcessEventSubscription, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(processeventsubscription.Table, processeventsubscription.Columns, sqlgraph.NewFieldSpec(processeventsubscription.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProcessEventSubscription.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, processeventsubscription.FieldID)
+		for _, f := range fields {
+			if !processeventsubscription.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != processeventsubscription.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(processeventsubscription.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(processeventsubscription.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ProcessInstanceID(); ok {
+		_spec.SetField(processeventsubscription.FieldProcessInstanceID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProcessInstanceID(); ok {
+		_spec.AddField(processeventsubscription.FieldProcessInstanceID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ProcessDefinitionKey(); ok {
+		_spec.SetField(processeventsubscription.FieldProcessDefinitionKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BusinessKey(); ok {
+		_spec.SetField(processeventsubscription.FieldBusinessKey, field.TypeString, value)
+	}
+	if _u.mutation.BusinessKeyCleared() {
+		_spec.ClearField(processeventsubscription.FieldBusinessKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.ElementID(); ok {
+		_spec.SetField(processeventsubscription.FieldElementID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AttachedTo(); ok {
+		_spec.SetField(processeventsubscription.FieldAttachedTo, field.TypeString, value)
+	}
+	if _u.mutation.AttachedToCleared() {
+		_spec.ClearField(processeventsubscription.FieldAttachedTo, field.TypeString)
+	}
+	if value, ok := _u.mutation.Interrupting(); ok {
+		_spec.SetField(processeventsubscription.FieldInterrupting, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(processeventsubscription.FieldEventType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventName(); ok {
+		_spec.SetField(processeventsubscription.FieldEventName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(processeventsubscription.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CorrelatedAt(); ok {
+		_spec.SetField(processeventsubscription.FieldCorrelatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CorrelatedAtCleared() {
+		_spec.ClearField(processeventsubscription.FieldCorrelatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(processeventsubscription.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(processeventsubscription.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &ProcessEventSubscription{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{processeventsubscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
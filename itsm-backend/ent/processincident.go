@@ -0,0 +1,241 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"itsm-backend/ent/processincident"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ProcessIncident is the model entity for the ProcessIncident schema.
+type ProcessIncident struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 租户ID
+	TenantID int `json:"tenant_id,omitempty"`
+	// 流程实例ID
+	ProcessInstanceID int `json:"process_instance_id,omitempty"`
+	// 流程定义Key
+	ProcessDefinitionKey string `json:"process_definition_key,omitempty"`
+	// 发生事故的BPMN元素ID
+	ElementID string `json:"element_id,omitempty"`
+	// 事故类型：failed_job
+	IncidentType string `json:"incident_type,omitempty"`
+	// 最后一次失败的错误信息
+	Message string `json:"message,omitempty"`
+	// 已执行次数
+	Attempts int `json:"attempts,omitempty"`
+	// 状态：open, resolved
+	Status string `json:"status,omitempty"`
+	// 处理方式：retried, skipped
+	Resolution string `json:"resolution,omitempty"`
+	// 处理人
+	ResolvedBy string `json:"resolved_by,omitempty"`
+	// 处理时间
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProcessIncident) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case processincident.FieldID, processincident.FieldTenantID, processincident.FieldProcessInstanceID, processincident.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case processincident.FieldProcessDefinitionKey, processincident.FieldElementID, processincident.FieldIncidentType, processincident.FieldMessage, processincident.FieldStatus, processincident.FieldResolution, processincident.FieldResolvedBy:
+			values[i] = new(sql.NullString)
+		case processincident.FieldResolvedAt, processincident.FieldCreatedAt, processincident.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProcessIncident fields.
+func (_m *ProcessIncident) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case processincident.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case processincident.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = int(value.Int64)
+			}
+		case processincident.FieldProcessInstanceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field process_instance_id", values[i])
+			} else if value.Valid {
+				_m.ProcessInstanceID = int(value.Int64)
+			}
+		case processincident.FieldProcessDefinitionKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field process_definition_key", values[i])
+			} else if value.Valid {
+				_m.ProcessDefinitionKey = value.String
+			}
+		case processincident.FieldElementID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field element_id", values[i])
+			} else if value.Valid {
+				_m.ElementID = value.String
+			}
+		case processincident.FieldIncidentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field incident_type", values[i])
+			} else if value.Valid {
+				_m.IncidentType = value.String
+			}
+		case processincident.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				_m.Message = value.String
+			}
+		case processincident.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				_m.Attempts = int(value.Int64)
+			}
+		case processincident.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case processincident.FieldResolution:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resolution", values[i])
+			} else if value.Valid {
+				_m.Resolution = value.String
+			}
+		case processincident.FieldResolvedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_by", values[i])
+			} else if value.Valid {
+				_m.ResolvedBy = value.String
+			}
+		case processincident.FieldResolvedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_at", values[i])
+			} else if value.Valid {
+				_m.ResolvedAt = new(time.Time)
+				*_m.ResolvedAt = value.Time
+			}
+		case processincident.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case processincident.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProcessIncident.
+// This includes values selected through modifiers, order, etc.
+func (_m *ProcessIncident) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ProcessIncident.
+// Note that you need to call ProcessIncident.Unwrap() before calling this method if this ProcessIncident
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ProcessIncident) Update() *ProcessIncidentUpdateOne {
+	return NewProcessIncidentClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ProcessIncident entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ProcessIncident) Unwrap() *ProcessIncident {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProcessIncident is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ProcessIncident) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProcessIncident(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("process_instance_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProcessInstanceID))
+	builder.WriteString(", ")
+	builder.WriteString("process_definition_key=")
+	builder.WriteString(_m.ProcessDefinitionKey)
+	builder.WriteString(", ")
+	builder.WriteString("element_id=")
+	builder.WriteString(_m.ElementID)
+	builder.WriteString(", ")
+	builder.WriteString("incident_type=")
+	builder.WriteString(_m.IncidentType)
+	builder.WriteString(", ")
+	builder.WriteString("message=")
+	builder.WriteString(_m.Message)
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("resolution=")
+	builder.WriteString(_m.Resolution)
+	builder.WriteString(", ")
+	builder.WriteString("resolved_by=")
+	builder.WriteString(_m.ResolvedBy)
+	builder.WriteString(", ")
+	if v := _m.ResolvedAt; v != nil {
+		builder.WriteString("resolved_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProcessIncidents is a parsable slice of ProcessIncident.
+type ProcessIncidents []*ProcessIncident
@@ -0,0 +1,180 @@
+// Code generated by ent, DO NOT EDIT.
+
+package processincident
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the processincident type in the database.
+	Label = "process_incident"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldProcessInstanceID holds the string denoting the process_instance_id field in the database.
+	FieldProcessInstanceID = "process_instance_id"
+	// FieldProcessDefinitionKey holds the string denoting the process_definition_key field in the database.
+	FieldProcessDefinitionKey = "process_definition_key"
+	// FieldElementID holds the string denoting the element_id field in the database.
+	FieldElementID = "element_id"
+	// FieldIncidentType holds the string denoting the incident_type field in the database.
+	FieldIncidentType = "incident_type"
+	// FieldMessage holds the string denoting the message field in the database.
+	FieldMessage = "message"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldResolution holds the string denoting the resolution field in the database.
+	FieldResolution = "resolution"
+	// FieldResolvedBy holds the string denoting the resolved_by field in the database.
+	FieldResolvedBy = "resolved_by"
+	// FieldResolvedAt holds the string denoting the resolved_at field in the database.
+	FieldResolvedAt = "resolved_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the processincident in the database.
+	Table = "process_incidents"
+)
+
+// Columns holds all SQL columns for processincident fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldProcessInstanceID,
+	FieldProcessDefinitionKey,
+	FieldElementID,
+	FieldIncidentType,
+	FieldMessage,
+	FieldAttempts,
+	FieldStatus,
+	FieldResolution,
+	FieldResolvedBy,
+	FieldResolvedAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	TenantIDValidator func(int) error
+	// ProcessInstanceIDValidator is a validator for the "process_instance_id" field. It is called by the builders before save.
+	ProcessInstanceIDValidator func(int) error
+	// ProcessDefinitionKeyValidator is a validator for the "process_definition_key" field. It is called by the builders before save.
+	ProcessDefinitionKeyValidator func(string) error
+	// ElementIDValidator is a validator for the "element_id" field. It is called by the builders before save.
+	ElementIDValidator func(string) error
+	// DefaultIncidentType holds the default value on creation for the "incident_type" field.
+	DefaultIncidentType string
+	// IncidentTypeValidator is a validator for the "incident_type" field. It is called by the builders before save.
+	IncidentTypeValidator func(string) error
+	// MessageValidator is a validator for the "message" field. It is called by the builders before save.
+	MessageValidator func(string) error
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int
+	// AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	AttemptsValidator func(int) error
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	StatusValidator func(string) error
+	// ResolutionValidator is a validator for the "resolution" field. It is called by the builders before save.
+	ResolutionValidator func(string) error
+	// ResolvedByValidator is a validator for the "resolved_by" field. It is called by the builders before save.
+	ResolvedByValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the ProcessIncident queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByProcessInstanceID orders the results by the process_instance_id field.
+func ByProcessInstanceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProcessInstanceID, opts...).ToFunc()
+}
+
+// ByProcessDefinitionKey orders the results by the process_definition_key field.
+func ByProcessDefinitionKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProcessDefinitionKey, opts...).ToFunc()
+}
+
+// ByElementID orders the results by the element_id field.
+func ByElementID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldElementID, opts...).ToFunc()
+}
+
+// ByIncidentType orders the results by the incident_type field.
+func ByIncidentType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIncidentType, opts...).ToFunc()
+}
+
+// ByMessage orders the results by the message field.
+func ByMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessage, opts...).ToFunc()
+}
+
+// ByAttempts orders the results by the attempts field.
+func ByAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttempts, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByResolution orders the results by the resolution field.
+func ByResolution(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolution, opts...).ToFunc()
+}
+
+// ByResolvedBy orders the results by the resolved_by field.
+func ByResolvedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolvedBy, opts...).ToFunc()
+}
+
+// ByResolvedAt orders the results by the resolved_at field.
+func ByResolvedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolvedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
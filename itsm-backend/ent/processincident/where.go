@@ -0,0 +1,870 @@
+// Code generated by ent, DO NOT EDIT.
+
+package processincident
+
+import (
+	"itsm-backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldEQ(FieldTenantID, v))
+}
+
+// ProcessInstanceID applies equality check predicate on the "process_instance_id" field. It's identical to ProcessInstanceIDEQ.
+func ProcessInstanceID(v int) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldEQ(FieldProcessInstanceID, v))
+}
+
+// ProcessDefinitionKey applies equality check predicate on the "process_definition_key" field. It's identical to ProcessDefinitionKeyEQ.
+func ProcessDefinitionKey(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldEQ(FieldProcessDefinitionKey, v))
+}
+
+// ElementID applies equality check predicate on the "element_id" field. It's identical to ElementIDEQ.
+func ElementID(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldEQ(FieldElementID, v))
+}
+
+// IncidentType applies equality check predicate on the "incident_type" field. It's identical to IncidentTypeEQ.
+func IncidentType(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldEQ(FieldIncidentType, v))
+}
+
+// Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
+func Message(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldEQ(FieldMessage, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldEQ(FieldAttempts, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldEQ(FieldStatus, v))
+}
+
+// Resolution applies equality check predicate on the "resolution" field. It's identical to ResolutionEQ.
+func Resolution(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldEQ(FieldResolution, v))
+}
+
+// ResolvedBy applies equality check predicate on the "resolved_by" field. It's identical to ResolvedByEQ.
+func ResolvedBy(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldEQ(FieldResolvedBy, v))
+}
+
+// ResolvedAt applies equality check predicate on the "resolved_at" field. It's identical to ResolvedAtEQ.
+func ResolvedAt(v time.Time) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldLTE(FieldTenantID, v))
+}
+
+// ProcessInstanceIDEQ applies the EQ predicate on the "process_instance_id" field.
+func ProcessInstanceIDEQ(v int) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldEQ(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDNEQ applies the NEQ predicate on the "process_instance_id" field.
+func ProcessInstanceIDNEQ(v int) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldNEQ(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDIn applies the In predicate on the "process_instance_id" field.
+func ProcessInstanceIDIn(vs ...int) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldIn(FieldProcessInstanceID, vs...))
+}
+
+// ProcessInstanceIDNotIn applies the NotIn predicate on the "process_instance_id" field.
+func ProcessInstanceIDNotIn(vs ...int) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldNotIn(FieldProcessInstanceID, vs...))
+}
+
+// ProcessInstanceIDGT applies the GT predicate on the "process_instance_id" field.
+func ProcessInstanceIDGT(v int) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldGT(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDGTE applies the GTE predicate on the "process_instance_id" field.
+func ProcessInstanceIDGTE(v int) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldGTE(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDLT applies the LT predicate on the "process_instance_id" field.
+func ProcessInstanceIDLT(v int) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldLT(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDLTE applies the LTE predicate on the "process_instance_id" field.
+func ProcessInstanceIDLTE(v int) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldLTE(FieldProcessInstanceID, v))
+}
+
+// ProcessDefinitionKeyEQ applies the EQ predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyEQ(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldEQ(FieldProcessDefinitionKey, v))
+}
+
+// ProcessDefinitionKeyNEQ applies the NEQ predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyNEQ(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldNEQ(FieldProcessDefinitionKey, v))
+}
+
+// ProcessDefinitionKeyIn applies the In predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyIn(vs ...string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldIn(FieldProcessDefinitionKey, vs...))
+}
+
+// ProcessDefinitionKeyNotIn applies the NotIn predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyNotIn(vs ...string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldNotIn(FieldProcessDefinitionKey, vs...))
+}
+
+// ProcessDefinitionKeyGT applies the GT predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyGT(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldGT(FieldProcessDefinitionKey, v))
+}
+
+// ProcessDefinitionKeyGTE applies the GTE predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyGTE(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldGTE(FieldProcessDefinitionKey, v))
+}
+
+// ProcessDefinitionKeyLT applies the LT predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyLT(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldLT(FieldProcessDefinitionKey, v))
+}
+
+// ProcessDefinitionKeyLTE applies the LTE predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyLTE(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldLTE(FieldProcessDefinitionKey, v))
+}
+
+// ProcessDefinitionKeyContains applies the Contains predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyContains(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldContains(FieldProcessDefinitionKey, v))
+}
+
+// ProcessDefinitionKeyHasPrefix applies the HasPrefix predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyHasPrefix(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldHasPrefix(FieldProcessDefinitionKey, v))
+}
+
+// ProcessDefinitionKeyHasSuffix applies the HasSuffix predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyHasSuffix(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldHasSuffix(FieldProcessDefinitionKey, v))
+}
+
+// ProcessDefinitionKeyEqualFold applies the EqualFold predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyEqualFold(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldEqualFold(FieldProcessDefinitionKey, v))
+}
+
+// ProcessDefinitionKeyContainsFold applies the ContainsFold predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyContainsFold(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldContainsFold(FieldProcessDefinitionKey, v))
+}
+
+// ElementIDEQ applies the EQ predicate on the "element_id" field.
+func ElementIDEQ(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldEQ(FieldElementID, v))
+}
+
+// ElementIDNEQ applies the NEQ predicate on the "element_id" field.
+func ElementIDNEQ(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldNEQ(FieldElementID, v))
+}
+
+// ElementIDIn applies the In predicate on the "element_id" field.
+func ElementIDIn(vs ...string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldIn(FieldElementID, vs...))
+}
+
+// ElementIDNotIn applies the NotIn predicate on the "element_id" field.
+func ElementIDNotIn(vs ...string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldNotIn(FieldElementID, vs...))
+}
+
+// ElementIDGT applies the GT predicate on the "element_id" field.
+func ElementIDGT(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldGT(FieldElementID, v))
+}
+
+// ElementIDGTE applies the GTE predicate on the "element_id" field.
+func ElementIDGTE(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldGTE(FieldElementID, v))
+}
+
+// ElementIDLT applies the LT predicate on the "element_id" field.
+func ElementIDLT(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldLT(FieldElementID, v))
+}
+
+// ElementIDLTE applies the LTE predicate on the "element_id" field.
+func ElementIDLTE(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldLTE(FieldElementID, v))
+}
+
+// ElementIDContains applies the Contains predicate on the "element_id" field.
+func ElementIDContains(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldContains(FieldElementID, v))
+}
+
+// ElementIDHasPrefix applies the HasPrefix predicate on the "element_id" field.
+func ElementIDHasPrefix(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldHasPrefix(FieldElementID, v))
+}
+
+// ElementIDHasSuffix applies the HasSuffix predicate on the "element_id" field.
+func ElementIDHasSuffix(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldHasSuffix(FieldElementID, v))
+}
+
+// ElementIDEqualFold applies the EqualFold predicate on the "element_id" field.
+func ElementIDEqualFold(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldEqualFold(FieldElementID, v))
+}
+
+// ElementIDContainsFold applies the ContainsFold predicate on the "element_id" field.
+func ElementIDContainsFold(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldContainsFold(FieldElementID, v))
+}
+
+// IncidentTypeEQ applies the EQ predicate on the "incident_type" field.
+func IncidentTypeEQ(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldEQ(FieldIncidentType, v))
+}
+
+// IncidentTypeNEQ applies the NEQ predicate on the "incident_type" field.
+func IncidentTypeNEQ(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldNEQ(FieldIncidentType, v))
+}
+
+// IncidentTypeIn applies the In predicate on the "incident_type" field.
+func IncidentTypeIn(vs ...string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldIn(FieldIncidentType, vs...))
+}
+
+// IncidentTypeNotIn applies the NotIn predicate on the "incident_type" field.
+func IncidentTypeNotIn(vs ...string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldNotIn(FieldIncidentType, vs...))
+}
+
+// IncidentTypeGT applies the GT predicate on the "incident_type" field.
+func IncidentTypeGT(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldGT(FieldIncidentType, v))
+}
+
+// IncidentTypeGTE applies the GTE predicate on the "incident_type" field.
+func IncidentTypeGTE(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldGTE(FieldIncidentType, v))
+}
+
+// IncidentTypeLT applies the LT predicate on the "incident_type" field.
+func IncidentTypeLT(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldLT(FieldIncidentType, v))
+}
+
+// IncidentTypeLTE applies the LTE predicate on the "incident_type" field.
+func IncidentTypeLTE(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldLTE(FieldIncidentType, v))
+}
+
+// IncidentTypeContains applies the Contains predicate on the "incident_type" field.
+func IncidentTypeContains(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldContains(FieldIncidentType, v))
+}
+
+// IncidentTypeHasPrefix applies the HasPrefix predicate on the "incident_type" field.
+func IncidentTypeHasPrefix(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldHasPrefix(FieldIncidentType, v))
+}
+
+// IncidentTypeHasSuffix applies the HasSuffix predicate on the "incident_type" field.
+func IncidentTypeHasSuffix(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldHasSuffix(FieldIncidentType, v))
+}
+
+// IncidentTypeEqualFold applies the EqualFold predicate on the "incident_type" field.
+func IncidentTypeEqualFold(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldEqualFold(FieldIncidentType, v))
+}
+
+// IncidentTypeContainsFold applies the ContainsFold predicate on the "incident_type" field.
+func IncidentTypeContainsFold(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldContainsFold(FieldIncidentType, v))
+}
+
+// MessageEQ applies the EQ predicate on the "message" field.
+func MessageEQ(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldEQ(FieldMessage, v))
+}
+
+// MessageNEQ applies the NEQ predicate on the "message" field.
+func MessageNEQ(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldNEQ(FieldMessage, v))
+}
+
+// MessageIn applies the In predicate on the "message" field.
+func MessageIn(vs ...string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldIn(FieldMessage, vs...))
+}
+
+// MessageNotIn applies the NotIn predicate on the "message" field.
+func MessageNotIn(vs ...string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldNotIn(FieldMessage, vs...))
+}
+
+// MessageGT applies the GT predicate on the "message" field.
+func MessageGT(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldGT(FieldMessage, v))
+}
+
+// MessageGTE applies the GTE predicate on the "message" field.
+func MessageGTE(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldGTE(FieldMessage, v))
+}
+
+// MessageLT applies the LT predicate on the "message" field.
+func MessageLT(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldLT(FieldMessage, v))
+}
+
+// MessageLTE applies the LTE predicate on the "message" field.
+func MessageLTE(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldLTE(FieldMessage, v))
+}
+
+// MessageContains applies the Contains predicate on the "message" field.
+func MessageContains(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldContains(FieldMessage, v))
+}
+
+// MessageHasPrefix applies the HasPrefix predicate on the "message" field.
+func MessageHasPrefix(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldHasPrefix(FieldMessage, v))
+}
+
+// MessageHasSuffix applies the HasSuffix predicate on the "message" field.
+func MessageHasSuffix(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldHasSuffix(FieldMessage, v))
+}
+
+// MessageIsNil applies the IsNil predicate on the "message" field.
+func MessageIsNil() predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldIsNull(FieldMessage))
+}
+
+// MessageNotNil applies the NotNil predicate on the "message" field.
+func MessageNotNil() predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldNotNull(FieldMessage))
+}
+
+// MessageEqualFold applies the EqualFold predicate on the "message" field.
+func MessageEqualFold(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldEqualFold(FieldMessage, v))
+}
+
+// MessageContainsFold applies the ContainsFold predicate on the "message" field.
+func MessageContainsFold(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldContainsFold(FieldMessage, v))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldLTE(FieldAttempts, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// ResolutionEQ applies the EQ predicate on the "resolution" field.
+func ResolutionEQ(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldEQ(FieldResolution, v))
+}
+
+// ResolutionNEQ applies the NEQ predicate on the "resolution" field.
+func ResolutionNEQ(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldNEQ(FieldResolution, v))
+}
+
+// ResolutionIn applies the In predicate on the "resolution" field.
+func ResolutionIn(vs ...string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldIn(FieldResolution, vs...))
+}
+
+// ResolutionNotIn applies the NotIn predicate on the "resolution" field.
+func ResolutionNotIn(vs ...string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldNotIn(FieldResolution, vs...))
+}
+
+// ResolutionGT applies the GT predicate on the "resolution" field.
+func ResolutionGT(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldGT(FieldResolution, v))
+}
+
+// ResolutionGTE applies the GTE predicate on the "resolution" field.
+func ResolutionGTE(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldGTE(FieldResolution, v))
+}
+
+// ResolutionLT applies the LT predicate on the "resolution" field.
+func ResolutionLT(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldLT(FieldResolution, v))
+}
+
+// ResolutionLTE applies the LTE predicate on the "resolution" field.
+func ResolutionLTE(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldLTE(FieldResolution, v))
+}
+
+// ResolutionContains applies the Contains predicate on the "resolution" field.
+func ResolutionContains(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldContains(FieldResolution, v))
+}
+
+// ResolutionHasPrefix applies the HasPrefix predicate on the "resolution" field.
+func ResolutionHasPrefix(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldHasPrefix(FieldResolution, v))
+}
+
+// ResolutionHasSuffix applies the HasSuffix predicate on the "resolution" field.
+func ResolutionHasSuffix(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldHasSuffix(FieldResolution, v))
+}
+
+// ResolutionIsNil applies the IsNil predicate on the "resolution" field.
+func ResolutionIsNil() predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldIsNull(FieldResolution))
+}
+
+// ResolutionNotNil applies the NotNil predicate on the "resolution" field.
+func ResolutionNotNil() predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldNotNull(FieldResolution))
+}
+
+// ResolutionEqualFold applies the EqualFold predicate on the "resolution" field.
+func ResolutionEqualFold(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldEqualFold(FieldResolution, v))
+}
+
+// ResolutionContainsFold applies the ContainsFold predicate on the "resolution" field.
+func ResolutionContainsFold(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldContainsFold(FieldResolution, v))
+}
+
+// ResolvedByEQ applies the EQ predicate on the "resolved_by" field.
+func ResolvedByEQ(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldEQ(FieldResolvedBy, v))
+}
+
+// ResolvedByNEQ applies the NEQ predicate on the "resolved_by" field.
+func ResolvedByNEQ(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldNEQ(FieldResolvedBy, v))
+}
+
+// ResolvedByIn applies the In predicate on the "resolved_by" field.
+func ResolvedByIn(vs ...string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldIn(FieldResolvedBy, vs...))
+}
+
+// ResolvedByNotIn applies the NotIn predicate on the "resolved_by" field.
+func ResolvedByNotIn(vs ...string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldNotIn(FieldResolvedBy, vs...))
+}
+
+// ResolvedByGT applies the GT predicate on the "resolved_by" field.
+func ResolvedByGT(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldGT(FieldResolvedBy, v))
+}
+
+// ResolvedByGTE applies the GTE predicate on the "resolved_by" field.
+func ResolvedByGTE(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldGTE(FieldResolvedBy, v))
+}
+
+// ResolvedByLT applies the LT predicate on the "resolved_by" field.
+func ResolvedByLT(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldLT(FieldResolvedBy, v))
+}
+
+// ResolvedByLTE applies the LTE predicate on the "resolved_by" field.
+func ResolvedByLTE(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldLTE(FieldResolvedBy, v))
+}
+
+// ResolvedByContains applies the Contains predicate on the "resolved_by" field.
+func ResolvedByContains(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldContains(FieldResolvedBy, v))
+}
+
+// ResolvedByHasPrefix applies the HasPrefix predicate on the "resolved_by" field.
+func ResolvedByHasPrefix(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldHasPrefix(FieldResolvedBy, v))
+}
+
+// ResolvedByHasSuffix applies the HasSuffix predicate on the "resolved_by" field.
+func ResolvedByHasSuffix(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldHasSuffix(FieldResolvedBy, v))
+}
+
+// ResolvedByIsNil applies the IsNil predicate on the "resolved_by" field.
+func ResolvedByIsNil() predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldIsNull(FieldResolvedBy))
+}
+
+// ResolvedByNotNil applies the NotNil predicate on the "resolved_by" field.
+func ResolvedByNotNil() predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldNotNull(FieldResolvedBy))
+}
+
+// ResolvedByEqualFold applies the EqualFold predicate on the "resolved_by" field.
+func ResolvedByEqualFold(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldEqualFold(FieldResolvedBy, v))
+}
+
+// ResolvedByContainsFold applies the ContainsFold predicate on the "resolved_by" field.
+func ResolvedByContainsFold(v string) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldContainsFold(FieldResolvedBy, v))
+}
+
+// ResolvedAtEQ applies the EQ predicate on the "resolved_at" field.
+func ResolvedAtEQ(v time.Time) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtNEQ applies the NEQ predicate on the "resolved_at" field.
+func ResolvedAtNEQ(v time.Time) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldNEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtIn applies the In predicate on the "resolved_at" field.
+func ResolvedAtIn(vs ...time.Time) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtNotIn applies the NotIn predicate on the "resolved_at" field.
+func ResolvedAtNotIn(vs ...time.Time) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldNotIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtGT applies the GT predicate on the "resolved_at" field.
+func ResolvedAtGT(v time.Time) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldGT(FieldResolvedAt, v))
+}
+
+// ResolvedAtGTE applies the GTE predicate on the "resolved_at" field.
+func ResolvedAtGTE(v time.Time) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldGTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtLT applies the LT predicate on the "resolved_at" field.
+func ResolvedAtLT(v time.Time) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldLT(FieldResolvedAt, v))
+}
+
+// ResolvedAtLTE applies the LTE predicate on the "resolved_at" field.
+func ResolvedAtLTE(v time.Time) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldLTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtIsNil applies the IsNil predicate on the "resolved_at" field.
+func ResolvedAtIsNil() predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldIsNull(FieldResolvedAt))
+}
+
+// ResolvedAtNotNil applies the NotNil predicate on the "resolved_at" field.
+func ResolvedAtNotNil() predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldNotNull(FieldResolvedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProcessIncident) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProcessIncident) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProcessIncident) predicate.ProcessIncident {
+	return predicate.ProcessIncident(sql.NotPredicates(p))
+}
@@ -0,0 +1,476 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/processincident"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProcessIncidentCreate is the builder for creating a ProcessIncident entity.
+type ProcessIncidentCreate struct {
+	config
+	mutation *ProcessIncidentMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ProcessIncidentCreate) SetTenantID(v int) *ProcessIncidentCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetProcessInstanceID sets the "process_instance_id" field.
+func (_c *ProcessIncidentCreate) SetProcessInstanceID(v int) *ProcessIncidentCreate {
+	_c.mutation.SetProcessInstanceID(v)
+	return _c
+}
+
+// SetProcessDefinitionKey sets the "process_definition_key" field.
+func (_c *ProcessIncidentCreate) SetProcessDefinitionKey(v string) *ProcessIncidentCreate {
+	_c.mutation.SetProcessDefinitionKey(v)
+	return _c
+}
+
+// SetElementID sets the "element_id" field.
+func (_c *ProcessIncidentCreate) SetElementID(v string) *ProcessIncidentCreate {
+	_c.mutation.SetElementID(v)
+	return _c
+}
+
+// SetIncidentType sets the "incident_type" field.
+func (_c *ProcessIncidentCreate) SetIncidentType(v string) *ProcessIncidentCreate {
+	_c.mutation.SetIncidentType(v)
+	return _c
+}
+
+// SetNillableIncidentType sets the "incident_type" field if the given value is not nil.
+func (_c *ProcessIncidentCreate) SetNillableIncidentType(v *string) *ProcessIncidentCreate {
+	if v != nil {
+		_c.SetIncidentType(*v)
+	}
+	return _c
+}
+
+// SetMessage sets the "message" field.
+func (_c *ProcessIncidentCreate) SetMessage(v string) *ProcessIncidentCreate {
+	_c.mutation.SetMessage(v)
+	return _c
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_c *ProcessIncidentCreate) SetNillableMessage(v *string) *ProcessIncidentCreate {
+	if v != nil {
+		_c.SetMessage(*v)
+	}
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *ProcessIncidentCreate) SetAttempts(v int) *ProcessIncidentCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *ProcessIncidentCreate) SetNillableAttempts(v *int) *ProcessIncidentCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ProcessIncidentCreate) SetStatus(v string) *ProcessIncidentCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ProcessIncidentCreate) SetNillableStatus(v *string) *ProcessIncidentCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetResolution sets the "resolution" field.
+func (_c *ProcessIncidentCreate) SetResolution(v string) *ProcessIncidentCreate {
+	_c.mutation.SetResolution(v)
+	return _c
+}
+
+// SetNillableResolution sets the "resolution" field if the given value is not nil.
+func (_c *ProcessIncidentCreate) SetNillableResolution(v *string) *ProcessIncidentCreate {
+	if v != nil {
+		_c.SetResolution(*v)
+	}
+	return _c
+}
+
+// SetResolvedBy sets the "resolved_by" field.
+func (_c *ProcessIncidentCreate) SetResolvedBy(v string) *ProcessIncidentCreate {
+	_c.mutation.SetResolvedBy(v)
+	return _c
+}
+
+// SetNillableResolvedBy sets the "resolved_by" field if the given value is not nil.
+func (_c *ProcessIncidentCreate) SetNillableResolvedBy(v *string) *ProcessIncidentCreate {
+	if v != nil {
+		_c.SetResolvedBy(*v)
+	}
+	return _c
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_c *ProcessIncidentCreate) SetResolvedAt(v time.Time) *ProcessIncidentCreate {
+	_c.mutation.SetResolvedAt(v)
+	return _c
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_c *ProcessIncidentCreate) SetNillableResolvedAt(v *time.Time) *ProcessIncidentCreate {
+	if v != nil {
+		_c.SetResolvedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ProcessIncidentCreate) SetCreatedAt(v time.Time) *ProcessIncidentCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ProcessIncidentCreate) SetNillableCreatedAt(v *time.Time) *ProcessIncidentCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ProcessIncidentCreate) SetUpdatedAt(v time.Time) *ProcessIncidentCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ProcessIncidentCreate) SetNillableUpdatedAt(v *time.Time) *ProcessIncidentCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ProcessIncidentMutation object of the builder.
+func (_c *ProcessIncidentCreate) Mutation() *ProcessIncidentMutation {
+	return _c.mutation
+}
+
+// Save creates the ProcessIncident in the database.
+func (_c *ProcessIncidentCreate) Save(ctx context.Context) (*ProcessIncident, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ProcessIncidentCreate) SaveX(ctx context.Context) *ProcessIncident {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProcessIncidentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProcessIncidentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ProcessIncidentCreate) defaults() {
+	if _, ok := _c.mutation.IncidentType(); !ok {
+		v := processincident.DefaultIncidentType
+		_c.mutation.SetIncidentType(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := processincident.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := processincident.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := processincident.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := processincident.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ProcessIncidentCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "ProcessIncident.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := processincident.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "ProcessIncident.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ProcessInstanceID(); !ok {
+		return &ValidationError{Name: "process_instance_id", err: errors.New(`ent: missing required field "ProcessIncident.process_instance_id"`)}
+	}
+	if v, ok := _c.mutation.ProcessInstanceID(); ok {
+		if err := processincident.ProcessInstanceIDValidator(v); err != nil {
+			return &ValidationError{Name: "process_instance_id", err: fmt.Errorf(`ent: validator failed for field "ProcessIncident.process_instance_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ProcessDefinitionKey(); !ok {
+		return &ValidationError{Name: "process_definition_key", err: errors.New(`ent: missing required field "ProcessIncident.process_definition_key"`)}
+	}
+	if v, ok := _c.mutation.ProcessDefinitionKey(); ok {
+		if err := processincident.ProcessDefinitionKeyValidator(v); err != nil {
+			return &ValidationError{Name: "process_definition_key", err: fmt.Errorf(`ent: validator failed for field "ProcessIncident.process_definition_key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ElementID(); !ok {
+		return &ValidationError{Name: "element_id", err: errors.New(`ent: missing required field "ProcessIncident.element_id"`)}
+	}
+	if v, ok := _c.mutation.ElementID(); ok {
+		if err := processincident.ElementIDValidator(v); err != nil {
+			return &ValidationError{Name: "element_id", err: fmt.Errorf(`ent: validator failed for field "ProcessIncident.element_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IncidentType(); !ok {
+		return &ValidationError{Name: "incident_type", err: errors.New(`ent: missing required field "ProcessIncident.incident_type"`)}
+	}
+	if v, ok := _c.mutation.IncidentType(); ok {
+		if err := processincident.IncidentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "incident_type", err: fmt.Errorf(`ent: validator failed for field "ProcessIncident.incident_type": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Message(); ok {
+		if err := processincident.MessageValidator(v); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`ent: validator failed for field "ProcessIncident.message": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "ProcessIncident.attempts"`)}
+	}
+	if v, ok := _c.mutation.Attempts(); ok {
+		if err := processincident.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`ent: validator failed for field "ProcessIncident.attempts": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ProcessIncident.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := processincident.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ProcessIncident.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Resolution(); ok {
+		if err := processincident.ResolutionValidator(v); err != nil {
+			return &ValidationError{Name: "resolution", err: fmt.Errorf(`ent: validator failed for field "ProcessIncident.resolution": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ResolvedBy(); ok {
+		if err := processincident.ResolvedByValidator(v); err != nil {
+			return &ValidationError{Name: "resolved_by", err: fmt.Errorf(`ent: validator failed for field "ProcessIncident.resolved_by": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProcessIncident.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ProcessIncident.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *ProcessIncidentCreate) sqlSave(ctx context.Context) (*ProcessIncident, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ProcessIncidentCreate) createSpec() (*ProcessIncident, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProcessIncident{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(processincident.Table, sqlgraph.NewFieldSpec(processincident.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(processincident.FieldTenantID, field.TypeInt, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.ProcessInstanceID(); ok {
+		_spec.SetField(processincident.FieldProcessInstanceID, field.TypeInt, value)
+		_node.ProcessInstanceID = value
+	}
+	if value, ok := _c.mutation.ProcessDefinitionKey(); ok {
+		_spec.SetField(processincident.FieldProcessDefinitionKey, field.TypeString, value)
+		_node.ProcessDefinitionKey = value
+	}
+	if value, ok := _c.mutation.ElementID(); ok {
+		_spec.SetField(processincident.FieldElementID, field.TypeString, value)
+		_node.ElementID = value
+	}
+	if value, ok := _c.mutation.IncidentType(); ok {
+		_spec.SetField(processincident.FieldIncidentType, field.TypeString, value)
+		_node.IncidentType = value
+	}
+	if value, ok := _c.mutation.Message(); ok {
+		_spec.SetField(processincident.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(processincident.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(processincident.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Resolution(); ok {
+		_spec.SetField(processincident.FieldResolution, field.TypeString, value)
+		_node.Resolution = value
+	}
+	if value, ok := _c.mutation.ResolvedBy(); ok {
+		_spec.SetField(processincident.FieldResolvedBy, field.TypeString, value)
+		_node.ResolvedBy = value
+	}
+	if value, ok := _c.mutation.ResolvedAt(); ok {
+		_spec.SetField(processincident.FieldResolvedAt, field.TypeTime, value)
+		_node.ResolvedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(processincident.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(processincident.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// ProcessIncidentCreateBulk is the builder for creating many ProcessIncident entities in bulk.
+type ProcessIncidentCreateBulk struct {
+	config
+	err      error
+	builders []*ProcessIncidentCreate
+}
+
+// Save creates the ProcessIncident entities in the database.
+func (_c *ProcessIncidentCreateBulk) Save(ctx context.Context) ([]*ProcessIncident, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ProcessIncident, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProcessIncidentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ProcessIncidentCreateBulk) SaveX(ctx context.Context) []*ProcessIncident {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProcessIncidentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProcessIncidentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,868 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/predicate"
+	"itsm-backend/ent/processincident"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProcessIncidentUpdate is the builder for updating ProcessIncident entities.
+type ProcessIncidentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProcessIncidentMutation
+}
+
+// Where appends a list predicates to the ProcessIncidentUpdate builder.
+func (_u *ProcessIncidentUpdate) Where(ps ...predicate.ProcessIncident) *ProcessIncidentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *ProcessIncidentUpdate) SetTenantID(v int) *ProcessIncidentUpdate {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *ProcessIncidentUpdate) SetNillableTenantID(v *int) *ProcessIncidentUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *ProcessIncidentUpdate) AddTenantID(v int) *ProcessIncidentUpdate {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetProcessInstanceID sets the "process_instance_id" field.
+func (_u *ProcessIncidentUpdate) SetProcessInstanceID(v int) *ProcessIncidentUpdate {
+	_u.mutation.ResetProcessInstanceID()
+	_u.mutation.SetProcessInstanceID(v)
+	return _u
+}
+
+// SetNillableProcessInstanceID sets the "process_instance_id" field if the given value is not nil.
+func (_u *ProcessIncidentUpdate) SetNillableProcessInstanceID(v *int) *ProcessIncidentUpdate {
+	if v != nil {
+		_u.SetProcessInstanceID(*v)
+	}
+	return _u
+}
+
+// AddProcessInstanceID adds value to the "process_instance_id" field.
+func (_u *ProcessIncidentUpdate) AddProcessInstanceID(v int) *ProcessIncidentUpdate {
+	_u.mutation.AddProcessInstanceID(v)
+	return _u
+}
+
+// SetProcessDefinitionKey sets the "process_definition_key" field.
+func (_u *ProcessIncidentUpdate) SetProcessDefinitionKey(v string) *ProcessIncidentUpdate {
+	_u.mutation.SetProcessDefinitionKey(v)
+	return _u
+}
+
+// SetNillableProcessDefinitionKey sets the "process_definition_key" field if the given value is not nil.
+func (_u *ProcessIncidentUpdate) SetNillableProcessDefinitionKey(v *string) *ProcessIncidentUpdate {
+	if v != nil {
+		_u.SetProcessDefinitionKey(*v)
+	}
+	return _u
+}
+
+// SetElementID sets the "element_id" field.
+func (_u *ProcessIncidentUpdate) SetElementID(v string) *ProcessIncidentUpdate {
+	_u.mutation.SetElementID(v)
+	return _u
+}
+
+// SetNillableElementID sets the "element_id" field if the given value is not nil.
+func (_u *ProcessIncidentUpdate) SetNillableElementID(v *string) *ProcessIncidentUpdate {
+	if v != nil {
+		_u.SetElementID(*v)
+	}
+	return _u
+}
+
+// SetIncidentType sets the "incident_type" field.
+func (_u *ProcessIncidentUpdate) SetIncidentType(v string) *ProcessIncidentUpdate {
+	_u.mutation.SetIncidentType(v)
+	return _u
+}
+
+// SetNillableIncidentType sets the "incident_type" field if the given value is not nil.
+func (_u *ProcessIncidentUpdate) SetNillableIncidentType(v *string) *ProcessIncidentUpdate {
+	if v != nil {
+		_u.SetIncidentType(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *ProcessIncidentUpdate) SetMessage(v string) *ProcessIncidentUpdate {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *ProcessIncidentUpdate) SetNillableMessage(v *string) *ProcessIncidentUpdate {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *ProcessIncidentUpdate) ClearMessage() *ProcessIncidentUpdate {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *ProcessIncidentUpdate) SetAttempts(v int) *ProcessIncidentUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *ProcessIncidentUpdate) SetNillableAttempts(v *int) *ProcessIncidentUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *ProcessIncidentUpdate) AddAttempts(v int) *ProcessIncidentUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ProcessIncidentUpdate) SetStatus(v string) *ProcessIncidentUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ProcessIncidentUpdate) SetNillableStatus(v *string) *ProcessIncidentUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetResolution sets the "resolution" field.
+func (_u *ProcessIncidentUpdate) SetResolution(v string) *ProcessIncidentUpdate {
+	_u.mutation.SetResolution(v)
+	return _u
+}
+
+// SetNillableResolution sets the "resolution" field if the given value is not nil.
+func (_u *ProcessIncidentUpdate) SetNillableResolution(v *string) *ProcessIncidentUpdate {
+	if v != nil {
+		_u.SetResolution(*v)
+	}
+	return _u
+}
+
+// ClearResolution clears the value of the "resolution" field.
+func (_u *ProcessIncidentUpdate) ClearResolution() *ProcessIncidentUpdate {
+	_u.mutation.ClearResolution()
+	return _u
+}
+
+// SetResolvedBy sets the "resolved_by" field.
+func (_u *ProcessIncidentUpdate) SetResolvedBy(v string) *ProcessIncidentUpdate {
+	_u.mutation.SetResolvedBy(v)
+	return _u
+}
+
+// SetNillableResolvedBy sets the "resolved_by" field if the given value is not nil.
+func (_u *ProcessIncidentUpdate) SetNillableResolvedBy(v *string) *ProcessIncidentUpdate {
+	if v != nil {
+		_u.SetResolvedBy(*v)
+	}
+	return _u
+}
+
+// ClearResolvedBy clears the value of the "resolved_by" field.
+func (_u *ProcessIncidentUpdate) ClearResolvedBy() *ProcessIncidentUpdate {
+	_u.mutation.ClearResolvedBy()
+	return _u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_u *ProcessIncidentUpdate) SetResolvedAt(v time.Time) *ProcessIncidentUpdate {
+	_u.mutation.SetResolvedAt(v)
+	return _u
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_u *ProcessIncidentUpdate) SetNillableResolvedAt(v *time.Time) *ProcessIncidentUpdate {
+	if v != nil {
+		_u.SetResolvedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (_u *ProcessIncidentUpdate) ClearResolvedAt() *ProcessIncidentUpdate {
+	_u.mutation.ClearResolvedAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ProcessIncidentUpdate) SetCreatedAt(v time.Time) *ProcessIncidentUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ProcessIncidentUpdate) SetNillableCreatedAt(v *time.Time) *ProcessIncidentUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ProcessIncidentUpdate) SetUpdatedAt(v time.Time) *ProcessIncidentUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ProcessIncidentMutation object of the builder.
+func (_u *ProcessIncidentUpdate) Mutation() *ProcessIncidentMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ProcessIncidentUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProcessIncidentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ProcessIncidentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProcessIncidentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ProcessIncidentUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := processincident.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ProcessIncidentUpdate) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := processincident.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "ProcessIncident.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ProcessInstanceID(); ok {
+		if err := processincident.ProcessInstanceIDValidator(v); err != nil {
+			return &ValidationError{Name: "process_instance_id", err: fmt.Errorf(`ent: validator failed for field "ProcessIncident.process_instance_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ProcessDefinitionKey(); ok {
+		if err := processincident.ProcessDefinitionKeyValidator(v); err != nil {
+			return &ValidationError{Name: "process_definition_key", err: fmt.Errorf(`ent: validator failed for field "ProcessIncident.process_definition_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ElementID(); ok {
+		if err := processincident.ElementIDValidator(v); err != nil {
+			return &ValidationError{Name: "element_id", err: fmt.Errorf(`ent: validator failed for field "ProcessIncident.element_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IncidentType(); ok {
+		if err := processincident.IncidentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "incident_type", err: fmt.Errorf(`ent: validator failed for field "ProcessIncident.incident_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Message(); ok {
+		if err := processincident.MessageValidator(v); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`ent: validator failed for field "ProcessIncident.message": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Attempts(); ok {
+		if err := processincident.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`ent: validator failed for field "ProcessIncident.attempts": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := processincident.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ProcessIncident.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Resolution(); ok {
+		if err := processincident.ResolutionValidator(v); err != nil {
+			return &ValidationError{Name: "resolution", err: fmt.Errorf(`ent: validator failed for field "ProcessIncident.resolution": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ResolvedBy(); ok {
+		if err := processincident.ResolvedByValidator(v); err != nil {
+			return &ValidationError{Name: "resolved_by", err: fmt.Errorf(`ent: validator failed for field "ProcessIncident.resolved_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ProcessIncidentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(processincident.Table, processincident.Columns, sqlgraph.NewFieldSpec(processincident.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(processincident.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(processincident.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ProcessInstanceID(); ok {
+		_spec.SetField(processincident.FieldProcessInstanceID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProcessInstanceID(); ok {
+		_spec.AddField(processincident.FieldProcessInstanceID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ProcessDefinitionKey(); ok {
+		_spec.SetField(processincident.FieldProcessDefinitionKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ElementID(); ok {
+		_spec.SetField(processincident.FieldElementID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IncidentType(); ok {
+		_spec.SetField(processincident.FieldIncidentType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(processincident.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(processincident.FieldMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(processincident.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(processincident.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(processincident.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Resolution(); ok {
+		_spec.SetField(processincident.FieldResolution, field.TypeString, value)
+	}
+	if _u.mutation.ResolutionCleared() {
+		_spec.ClearField(processincident.FieldResolution, field.TypeString)
+	}
+	if value, ok := _u.mutation.ResolvedBy(); ok {
+		_spec.SetField(processincident.FieldResolvedBy, field.TypeString, value)
+	}
+	if _u.mutation.ResolvedByCleared() {
+		_spec.ClearField(processincident.FieldResolvedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.ResolvedAt(); ok {
+		_spec.SetField(processincident.FieldResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolvedAtCleared() {
+		_spec.ClearField(processincident.FieldResolvedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(processincident.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(processincident.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{processincident.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ProcessIncidentUpdateOne is the builder for updating a single ProcessIncident entity.
+type ProcessIncidentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProcessIncidentMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *ProcessIncidentUpdateOne) SetTenantID(v int) *ProcessIncidentUpdateOne {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *ProcessIncidentUpdateOne) SetNillableTenantID(v *int) *ProcessIncidentUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *ProcessIncidentUpdateOne) AddTenantID(v int) *ProcessIncidentUpdateOne {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetProcessInstanceID sets the "process_instance_id" field.
+func (_u *ProcessIncidentUpdateOne) SetProcessInstanceID(v int) *ProcessIncidentUpdateOne {
+	_u.mutation.ResetProcessInstanceID()
+	_u.mutation.SetProcessInstanceID(v)
+	return _u
+}
+
+// SetNillableProcessInstanceID sets the "process_instance_id" field if the given value is not nil.
+func (_u *ProcessIncidentUpdateOne) SetNillableProcessInstanceID(v *int) *ProcessIncidentUpdateOne {
+	if v != nil {
+		_u.SetProcessInstanceID(*v)
+	}
+	return _u
+}
+
+// AddProcessInstanceID adds value to the "process_instance_id" field.
+func (_u *ProcessIncidentUpdateOne) AddProcessInstanceID(v int) *ProcessIncidentUpdateOne {
+	_u.mutation.AddProcessInstanceID(v)
+	return _u
+}
+
+// SetProcessDefinitionKey sets the "process_definition_key" field.
+func (_u *ProcessIncidentUpdateOne) SetProcessDefinitionKey(v string) *ProcessIncidentUpdateOne {
+	_u.mutation.SetProcessDefinitionKey(v)
+	return _u
+}
+
+// SetNillableProcessDefinitionKey sets the "process_definition_key" field if the given value is not nil.
+func (_u *ProcessIncidentUpdateOne) SetNillableProcessDefinitionKey(v *string) *ProcessIncidentUpdateOne {
+	if v != nil {
+		_u.SetProcessDefinitionKey(*v)
+	}
+	return _u
+}
+
+// SetElementID sets the "element_id" field.
+func (_u *ProcessIncidentUpdateOne) SetElementID(v string) *ProcessIncidentUpdateOne {
+	_u.mutation.SetElementID(v)
+	return _u
+}
+
+// SetNillableElementID sets the "element_id" field if the given value is not nil.
+func (_u *ProcessIncidentUpdateOne) SetNillableElementID(v *string) *ProcessIncidentUpdateOne {
+	if v != nil {
+		_u.SetElementID(*v)
+	}
+	return _u
+}
+
+// SetIncidentType sets the "incident_type" field.
+func (_u *ProcessIncidentUpdateOne) SetIncidentType(v string) *ProcessIncidentUpdateOne {
+	_u.mutation.SetIncidentType(v)
+	return _u
+}
+
+// SetNillableIncidentType sets the "incident_type" field if the given value is not nil.
+func (_u *ProcessIncidentUpdateOne) SetNillableIncidentType(v *string) *ProcessIncidentUpdateOne {
+	if v != nil {
+		_u.SetIncidentType(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *ProcessIncidentUpdateOne) SetMessage(v string) *ProcessIncidentUpdateOne {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *ProcessIncidentUpdateOne) SetNillableMessage(v *string) *ProcessIncidentUpdateOne {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *ProcessIncidentUpdateOne) ClearMessage() *ProcessIncidentUpdateOne {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *ProcessIncidentUpdateOne) SetAttempts(v int) *ProcessIncidentUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *ProcessIncidentUpdateOne) SetNillableAttempts(v *int) *ProcessIncidentUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *ProcessIncidentUpdateOne) AddAttempts(v int) *ProcessIncidentUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ProcessIncidentUpdateOne) SetStatus(v string) *ProcessIncidentUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ProcessIncidentUpdateOne) SetNillableStatus(v *string) *ProcessIncidentUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetResolution sets the "resolution" field.
+func (_u *ProcessIncidentUpdateOne) SetResolution(v string) *ProcessIncidentUpdateOne {
+	_u.mutation.SetResolution(v)
+	return _u
+}
+
+// SetNillableResolution sets the "resolution" field if the given value is not nil.
+func (_u *ProcessIncidentUpdateOne) SetNillableResolution(v *string) *ProcessIncidentUpdateOne {
+	if v != nil {
+		_u.SetResolution(*v)
+	}
+	return _u
+}
+
+// ClearResolution clears the value of the "resolution" field.
+func (_u *ProcessIncidentUpdateOne) ClearResolution() *ProcessIncidentUpdateOne {
+	_u.mutation.ClearResolution()
+	return _u
+}
+
+// SetResolvedBy sets the "resolved_by" field.
+func (_u *ProcessIncidentUpdateOne) SetResolvedBy(v string) *ProcessIncidentUpdateOne {
+	_u.mutation.SetResolvedBy(v)
+	return _u
+}
+
+// SetNillableResolvedBy sets the "resolved_by" field if the given value is not nil.
+func (_u *ProcessIncidentUpdateOne) SetNillableResolvedBy(v *string) *ProcessIncidentUpdateOne {
+	if v != nil {
+		_u.SetResolvedBy(*v)
+	}
+	return _u
+}
+
+// ClearResolvedBy clears the value of the "resolved_by" field.
+func (_u *ProcessIncidentUpdateOne) ClearResolvedBy() *ProcessIncidentUpdateOne {
+	_u.mutation.ClearResolvedBy()
+	return _u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (_u *ProcessIncidentUpdateOne) SetResolvedAt(v time.Time) *ProcessIncidentUpdateOne {
+	_u.mutation.SetResolvedAt(v)
+	return _u
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (_u *ProcessIncidentUpdateOne) SetNillableResolvedAt(v *time.Time) *ProcessIncidentUpdateOne {
+	if v != nil {
+		_u.SetResolvedAt(*v)
+	}
+	return _u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (_u *ProcessIncidentUpdateOne) ClearResolvedAt() *ProcessIncidentUpdateOne {
+	_u.mutation.ClearResolvedAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ProcessIncidentUpdateOne) SetCreatedAt(v time.Time) *ProcessIncidentUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ProcessIncidentUpdateOne) SetNillableCreatedAt(v *time.Time) *ProcessIncidentUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ProcessIncidentUpdateOne) SetUpdatedAt(v time.Time) *ProcessIncidentUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ProcessIncidentMutation object of the builder.
+func (_u *ProcessIncidentUpdateOne) Mutation() *ProcessIncidentMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ProcessIncidentUpdate builder.
+func (_u *ProcessIncidentUpdateOne) Where(ps ...predicate.ProcessIncident) *ProcessIncidentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ProcessIncidentUpdateOne) Select(field string, fields ...string) *ProcessIncidentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ProcessIncident entity.
+func (_u *ProcessIncidentUpdateOne) Save(ctx context.Context) (*ProcessIncident, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProcessIncidentUpdateOne) SaveX(ctx context.Context) *ProcessIncident {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ProcessIncidentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProcessIncidentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ProcessIncidentUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := processincident.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ProcessIncidentUpdateOne) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := processincident.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "ProcessIncident.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ProcessInstanceID(); ok {
+		if err := processincident.ProcessInstanceIDValidator(v); err != nil {
+			return &ValidationError{Name: "process_instance_id", err: fmt.Errorf(`ent: validator failed for field "ProcessIncident.process_instance_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ProcessDefinitionKey(); ok {
+		if err := processincident.ProcessDefinitionKeyValidator(v); err != nil {
+			return &ValidationError{Name: "process_definition_key", err: fmt.Errorf(`ent: validator failed for field "ProcessIncident.process_definition_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ElementID(); ok {
+		if err := processincident.ElementIDValidator(v); err != nil {
+			return &ValidationError{Name: "element_id", err: fmt.Errorf(`ent: validator failed for field "ProcessIncident.element_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IncidentType(); ok {
+		if err := processincident.IncidentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "incident_type", err: fmt.Errorf(`ent: validator failed for field "ProcessIncident.incident_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Message(); ok {
+		if err := processincident.MessageValidator(v); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`ent: validator failed for field "ProcessIncident.message": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Attempts(); ok {
+		if err := processincident.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`ent: validator failed for field "ProcessIncident.attempts": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := processincident.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ProcessIncident.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Resolution(); ok {
+		if err := processincident.ResolutionValidator(v); err != nil {
+			return &ValidationError{Name: "resolution", err: fmt.Errorf(`ent: validator failed for field "ProcessIncident.resolution": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ResolvedBy(); ok {
+		if err := processincident.ResolvedByValidator(v); err != nil {
+			return &ValidationError{Name: "resolved_by", err: fmt.Errorf(`ent: validator failed for field "ProcessIncident.resolved_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ProcessIncidentUpdateOne) sqlSave(ctx context.Context) (_node *ProcessIncident, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(processincident.Table, processincident.Columns, sqlgraph.NewFieldSpec(processincident.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProcessIncident.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, processincident.FieldID)
+		for _, f := range fields {
+			if !processincident.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != processincident.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(processincident.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(processincident.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ProcessInstanceID(); ok {
+		_spec.SetField(processincident.FieldProcessInstanceID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProcessInstanceID(); ok {
+		_spec.AddField(processincident.FieldProcessInstanceID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ProcessDefinitionKey(); ok {
+		_spec.SetField(processincident.FieldProcessDefinitionKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ElementID(); ok {
+		_spec.SetField(processincident.FieldElementID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IncidentType(); ok {
+		_spec.SetField(processincident.FieldIncidentType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(processincident.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(processincident.FieldMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(processincident.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(processincident.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(processincident.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Resolution(); ok {
+		_spec.SetField(processincident.FieldResolution, field.TypeString, value)
+	}
+	if _u.mutation.ResolutionCleared() {
+		_spec.ClearField(processincident.FieldResolution, field.TypeString)
+	}
+	if value, ok := _u.mutation.ResolvedBy(); ok {
+		_spec.SetField(processincident.FieldResolvedBy, field.TypeString, value)
+	}
+	if _u.mutation.ResolvedByCleared() {
+		_spec.ClearField(processincident.FieldResolvedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.ResolvedAt(); ok {
+		_spec.SetField(processincident.FieldResolvedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResolvedAtCleared() {
+		_spec.ClearField(processincident.FieldResolvedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(processincident.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(processincident.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &ProcessIncident{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{processincident.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
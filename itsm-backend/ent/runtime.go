@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"itsm-backend/ent/alertsuppressionrule"
 	"itsm-backend/ent/application"
 	"itsm-backend/ent/approvalchain"
 	"itsm-backend/ent/approvalrecord"
@@ -12,6 +13,7 @@ import (
 	"itsm-backend/ent/auditlog"
 	"itsm-backend/ent/bootstraptoken"
 	"itsm-backend/ent/bpmnpermission"
+	"itsm-backend/ent/businesscalendar"
 	"itsm-backend/ent/cabmember"
 	"itsm-backend/ent/change"
 	"itsm-backend/ent/changepir"
@@ -29,6 +31,7 @@ import (
 	"itsm-backend/ent/configurationitemhistory"
 	"itsm-backend/ent/contract"
 	"itsm-backend/ent/conversation"
+	"itsm-backend/ent/decisiondefinition"
 	"itsm-backend/ent/department"
 	"itsm-backend/ent/discoveryjob"
 	"itsm-backend/ent/discoveryresult"
@@ -36,8 +39,12 @@ import (
 	"itsm-backend/ent/domainconfig"
 	"itsm-backend/ent/endpointacl"
 	"itsm-backend/ent/engineerskill"
+	"itsm-backend/ent/escalationpolicy"
 	"itsm-backend/ent/feishuticketsync"
 	"itsm-backend/ent/group"
+	"itsm-backend/ent/inbounddedupkey"
+	"itsm-backend/ent/inboundemail"
+	"itsm-backend/ent/inboundmessagelog"
 	"itsm-backend/ent/incident"
 	"itsm-backend/ent/incidentalert"
 	"itsm-backend/ent/incidentescalationrule"
@@ -52,14 +59,19 @@ import (
 	"itsm-backend/ent/knowledgearticlesession"
 	"itsm-backend/ent/knowledgearticleversion"
 	"itsm-backend/ent/knownerror"
+	"itsm-backend/ent/majorincident"
 	"itsm-backend/ent/marketplaceitem"
 	"itsm-backend/ent/menu"
 	"itsm-backend/ent/message"
 	"itsm-backend/ent/microservice"
+	"itsm-backend/ent/monitoringalert"
 	"itsm-backend/ent/mspallocation"
 	"itsm-backend/ent/notification"
 	"itsm-backend/ent/notificationdelivery"
 	"itsm-backend/ent/notificationpreference"
+	"itsm-backend/ent/oncalloverride"
+	"itsm-backend/ent/oncallpage"
+	"itsm-backend/ent/oncallschedule"
 	"itsm-backend/ent/operationalcommand"
 	"itsm-backend/ent/passwordresettoken"
 	"itsm-backend/ent/permission"
@@ -70,7 +82,10 @@ import (
 	"itsm-backend/ent/processbinding"
 	"itsm-backend/ent/processdefinition"
 	"itsm-backend/ent/processdeployment"
+	"itsm-backend/ent/processeventinstance"
+	"itsm-backend/ent/processeventsubscription"
 	"itsm-backend/ent/processexecutionhistory"
+	"itsm-backend/ent/processincident"
 	"itsm-backend/ent/processinstance"
 	"itsm-backend/ent/processtask"
 	"itsm-backend/ent/processvariable"
@@ -83,6 +98,7 @@ import (
 	"itsm-backend/ent/role"
 	"itsm-backend/ent/rootcauseanalysis"
 	"itsm-backend/ent/schema"
+	"itsm-backend/ent/searchdocument"
 	"itsm-backend/ent/servicecatalog"
 	"itsm-backend/ent/servicecatalogitem"
 	"itsm-backend/ent/servicerequest"
@@ -110,6 +126,10 @@ import (
 	"itsm-backend/ent/ticketcc"
 	"itsm-backend/ent/ticketcomment"
 	"itsm-backend/ent/ticketnotification"
+	"itsm-backend/ent/ticketschedule"
+	"itsm-backend/ent/ticketschedulerun"
+	"itsm-backend/ent/ticketslametric"
+	"itsm-backend/ent/ticketslapause"
 	"itsm-backend/ent/tickettag"
 	"itsm-backend/ent/tickettemplate"
 	"itsm-backend/ent/tickettype"
@@ -122,6 +142,7 @@ import (
 	"itsm-backend/ent/workflowinstance"
 	"itsm-backend/ent/workflowtask"
 	"itsm-backend/ent/workflowversion"
+	"itsm-backend/ent/worklog"
 	"time"
 )
 
@@ -129,6 +150,50 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	alertsuppressionruleFields := schema.AlertSuppressionRule{}.Fields()
+	_ = alertsuppressionruleFields
+	// alertsuppressionruleDescTenantID is the schema descriptor for tenant_id field.
+	alertsuppressionruleDescTenantID := alertsuppressionruleFields[0].Descriptor()
+	// alertsuppressionrule.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	alertsuppressionrule.TenantIDValidator = alertsuppressionruleDescTenantID.Validators[0].(func(int) error)
+	// alertsuppressionruleDescName is the schema descriptor for name field.
+	alertsuppressionruleDescName := alertsuppressionruleFields[1].Descriptor()
+	// alertsuppressionrule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	alertsuppressionrule.NameValidator = func() func(string) error {
+		validators := alertsuppressionruleDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// alertsuppressionruleDescEnabled is the schema descriptor for enabled field.
+	alertsuppressionruleDescEnabled := alertsuppressionruleFields[7].Descriptor()
+	// alertsuppressionrule.DefaultEnabled holds the default value on creation for the enabled field.
+	alertsuppressionrule.DefaultEnabled = alertsuppressionruleDescEnabled.Default.(bool)
+	// alertsuppressionruleDescHitCount is the schema descriptor for hit_count field.
+	alertsuppressionruleDescHitCount := alertsuppressionruleFields[8].Descriptor()
+	// alertsuppressionrule.DefaultHitCount holds the default value on creation for the hit_count field.
+	alertsuppressionrule.DefaultHitCount = alertsuppressionruleDescHitCount.Default.(int)
+	// alertsuppressionrule.HitCountValidator is a validator for the "hit_count" field. It is called by the builders before save.
+	alertsuppressionrule.HitCountValidator = alertsuppressionruleDescHitCount.Validators[0].(func(int) error)
+	// alertsuppressionruleDescCreatedAt is the schema descriptor for created_at field.
+	alertsuppressionruleDescCreatedAt := alertsuppressionruleFields[11].Descriptor()
+	// alertsuppressionrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	alertsuppressionrule.DefaultCreatedAt = alertsuppressionruleDescCreatedAt.Default.(func() time.Time)
+	// alertsuppressionruleDescUpdatedAt is the schema descriptor for updated_at field.
+	alertsuppressionruleDescUpdatedAt := alertsuppressionruleFields[12].Descriptor()
+	// alertsuppressionrule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	alertsuppressionrule.DefaultUpdatedAt = alertsuppressionruleDescUpdatedAt.Default.(func() time.Time)
+	// alertsuppressionrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	alertsuppressionrule.UpdateDefaultUpdatedAt = alertsuppressionruleDescUpdatedAt.UpdateDefault.(func() time.Time)
 	applicationFields := schema.Application{}.Fields()
 	_ = applicationFields
 	// applicationDescName is the schema descriptor for name field.
@@ -419,6 +484,34 @@ func init() {
 	bootstraptokenDescTenantID := bootstraptokenFields[5].Descriptor()
 	// bootstraptoken.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
 	bootstraptoken.TenantIDValidator = bootstraptokenDescTenantID.Validators[0].(func(int) error)
+	businesscalendarFields := schema.BusinessCalendar{}.Fields()
+	_ = businesscalendarFields
+	// businesscalendarDescTenantID is the schema descriptor for tenant_id field.
+	businesscalendarDescTenantID := businesscalendarFields[0].Descriptor()
+	// businesscalendar.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	businesscalendar.TenantIDValidator = businesscalendarDescTenantID.Validators[0].(func(int) error)
+	// businesscalendarDescName is the schema descriptor for name field.
+	businesscalendarDescName := businesscalendarFields[1].Descriptor()
+	// businesscalendar.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	businesscalendar.NameValidator = businesscalendarDescName.Validators[0].(func(string) error)
+	// businesscalendarDescTimeZone is the schema descriptor for time_zone field.
+	businesscalendarDescTimeZone := businesscalendarFields[3].Descriptor()
+	// businesscalendar.DefaultTimeZone holds the default value on creation for the time_zone field.
+	businesscalendar.DefaultTimeZone = businesscalendarDescTimeZone.Default.(string)
+	// businesscalendarDescIsDefault is the schema descriptor for is_default field.
+	businesscalendarDescIsDefault := businesscalendarFields[7].Descriptor()
+	// businesscalendar.DefaultIsDefault holds the default value on creation for the is_default field.
+	businesscalendar.DefaultIsDefault = businesscalendarDescIsDefault.Default.(bool)
+	// businesscalendarDescCreatedAt is the schema descriptor for created_at field.
+	businesscalendarDescCreatedAt := businesscalendarFields[8].Descriptor()
+	// businesscalendar.DefaultCreatedAt holds the default value on creation for the created_at field.
+	businesscalendar.DefaultCreatedAt = businesscalendarDescCreatedAt.Default.(func() time.Time)
+	// businesscalendarDescUpdatedAt is the schema descriptor for updated_at field.
+	businesscalendarDescUpdatedAt := businesscalendarFields[9].Descriptor()
+	// businesscalendar.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	businesscalendar.DefaultUpdatedAt = businesscalendarDescUpdatedAt.Default.(func() time.Time)
+	// businesscalendar.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	businesscalendar.UpdateDefaultUpdatedAt = businesscalendarDescUpdatedAt.UpdateDefault.(func() time.Time)
 	cabmemberFields := schema.CABMember{}.Fields()
 	_ = cabmemberFields
 	// cabmemberDescUserID is the schema descriptor for user_id field.
@@ -981,6 +1074,38 @@ func init() {
 	conversationDescTitle := conversationFields[3].Descriptor()
 	// conversation.DefaultTitle holds the default value on creation for the title field.
 	conversation.DefaultTitle = conversationDescTitle.Default.(string)
+	decisiondefinitionFields := schema.DecisionDefinition{}.Fields()
+	_ = decisiondefinitionFields
+	// decisiondefinitionDescTenantID is the schema descriptor for tenant_id field.
+	decisiondefinitionDescTenantID := decisiondefinitionFields[0].Descriptor()
+	// decisiondefinition.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	decisiondefinition.TenantIDValidator = decisiondefinitionDescTenantID.Validators[0].(func(int) error)
+	// decisiondefinitionDescKey is the schema descriptor for key field.
+	decisiondefinitionDescKey := decisiondefinitionFields[1].Descriptor()
+	// decisiondefinition.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	decisiondefinition.KeyValidator = decisiondefinitionDescKey.Validators[0].(func(string) error)
+	// decisiondefinitionDescVersion is the schema descriptor for version field.
+	decisiondefinitionDescVersion := decisiondefinitionFields[3].Descriptor()
+	// decisiondefinition.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	decisiondefinition.VersionValidator = decisiondefinitionDescVersion.Validators[0].(func(int) error)
+	// decisiondefinitionDescHitPolicy is the schema descriptor for hit_policy field.
+	decisiondefinitionDescHitPolicy := decisiondefinitionFields[4].Descriptor()
+	// decisiondefinition.DefaultHitPolicy holds the default value on creation for the hit_policy field.
+	decisiondefinition.DefaultHitPolicy = decisiondefinitionDescHitPolicy.Default.(string)
+	// decisiondefinition.HitPolicyValidator is a validator for the "hit_policy" field. It is called by the builders before save.
+	decisiondefinition.HitPolicyValidator = decisiondefinitionDescHitPolicy.Validators[0].(func(string) error)
+	// decisiondefinitionDescDmnXML is the schema descriptor for dmn_xml field.
+	decisiondefinitionDescDmnXML := decisiondefinitionFields[5].Descriptor()
+	// decisiondefinition.DmnXMLValidator is a validator for the "dmn_xml" field. It is called by the builders before save.
+	decisiondefinition.DmnXMLValidator = decisiondefinitionDescDmnXML.Validators[0].(func(string) error)
+	// decisiondefinitionDescIsLatest is the schema descriptor for is_latest field.
+	decisiondefinitionDescIsLatest := decisiondefinitionFields[7].Descriptor()
+	// decisiondefinition.DefaultIsLatest holds the default value on creation for the is_latest field.
+	decisiondefinition.DefaultIsLatest = decisiondefinitionDescIsLatest.Default.(bool)
+	// decisiondefinitionDescCreatedAt is the schema descriptor for created_at field.
+	decisiondefinitionDescCreatedAt := decisiondefinitionFields[8].Descriptor()
+	// decisiondefinition.DefaultCreatedAt holds the default value on creation for the created_at field.
+	decisiondefinition.DefaultCreatedAt = decisiondefinitionDescCreatedAt.Default.(func() time.Time)
 	departmentFields := schema.Department{}.Fields()
 	_ = departmentFields
 	// departmentDescName is the schema descriptor for name field.
@@ -1225,6 +1350,50 @@ func init() {
 	engineerskill.DefaultUpdatedAt = engineerskillDescUpdatedAt.Default.(func() time.Time)
 	// engineerskill.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	engineerskill.UpdateDefaultUpdatedAt = engineerskillDescUpdatedAt.UpdateDefault.(func() time.Time)
+	escalationpolicyFields := schema.EscalationPolicy{}.Fields()
+	_ = escalationpolicyFields
+	// escalationpolicyDescTenantID is the schema descriptor for tenant_id field.
+	escalationpolicyDescTenantID := escalationpolicyFields[0].Descriptor()
+	// escalationpolicy.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	escalationpolicy.TenantIDValidator = escalationpolicyDescTenantID.Validators[0].(func(int) error)
+	// escalationpolicyDescName is the schema descriptor for name field.
+	escalationpolicyDescName := escalationpolicyFields[1].Descriptor()
+	// escalationpolicy.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	escalationpolicy.NameValidator = func() func(string) error {
+		validators := escalationpolicyDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// escalationpolicyDescRepeatCount is the schema descriptor for repeat_count field.
+	escalationpolicyDescRepeatCount := escalationpolicyFields[5].Descriptor()
+	// escalationpolicy.DefaultRepeatCount holds the default value on creation for the repeat_count field.
+	escalationpolicy.DefaultRepeatCount = escalationpolicyDescRepeatCount.Default.(int)
+	// escalationpolicy.RepeatCountValidator is a validator for the "repeat_count" field. It is called by the builders before save.
+	escalationpolicy.RepeatCountValidator = escalationpolicyDescRepeatCount.Validators[0].(func(int) error)
+	// escalationpolicyDescEnabled is the schema descriptor for enabled field.
+	escalationpolicyDescEnabled := escalationpolicyFields[6].Descriptor()
+	// escalationpolicy.DefaultEnabled holds the default value on creation for the enabled field.
+	escalationpolicy.DefaultEnabled = escalationpolicyDescEnabled.Default.(bool)
+	// escalationpolicyDescCreatedAt is the schema descriptor for created_at field.
+	escalationpolicyDescCreatedAt := escalationpolicyFields[8].Descriptor()
+	// escalationpolicy.DefaultCreatedAt holds the default value on creation for the created_at field.
+	escalationpolicy.DefaultCreatedAt = escalationpolicyDescCreatedAt.Default.(func() time.Time)
+	// escalationpolicyDescUpdatedAt is the schema descriptor for updated_at field.
+	escalationpolicyDescUpdatedAt := escalationpolicyFields[9].Descriptor()
+	// escalationpolicy.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	escalationpolicy.DefaultUpdatedAt = escalationpolicyDescUpdatedAt.Default.(func() time.Time)
+	// escalationpolicy.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	escalationpolicy.UpdateDefaultUpdatedAt = escalationpolicyDescUpdatedAt.UpdateDefault.(func() time.Time)
 	feishuticketsyncFields := schema.FeishuTicketSync{}.Fields()
 	_ = feishuticketsyncFields
 	// feishuticketsyncDescTenantID is the schema descriptor for tenant_id field.
@@ -1273,6 +1442,74 @@ func init() {
 	group.DefaultUpdatedAt = groupDescUpdatedAt.Default.(func() time.Time)
 	// group.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	group.UpdateDefaultUpdatedAt = groupDescUpdatedAt.UpdateDefault.(func() time.Time)
+	inbounddedupkeyFields := schema.InboundDedupKey{}.Fields()
+	_ = inbounddedupkeyFields
+	// inbounddedupkeyDescTenantID is the schema descriptor for tenant_id field.
+	inbounddedupkeyDescTenantID := inbounddedupkeyFields[0].Descriptor()
+	// inbounddedupkey.DefaultTenantID holds the default value on creation for the tenant_id field.
+	inbounddedupkey.DefaultTenantID = inbounddedupkeyDescTenantID.Default.(int)
+	// inbounddedupkey.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	inbounddedupkey.TenantIDValidator = inbounddedupkeyDescTenantID.Validators[0].(func(int) error)
+	// inbounddedupkeyDescDedupKey is the schema descriptor for dedup_key field.
+	inbounddedupkeyDescDedupKey := inbounddedupkeyFields[1].Descriptor()
+	// inbounddedupkey.DedupKeyValidator is a validator for the "dedup_key" field. It is called by the builders before save.
+	inbounddedupkey.DedupKeyValidator = inbounddedupkeyDescDedupKey.Validators[0].(func(string) error)
+	// inbounddedupkeyDescCreatedAt is the schema descriptor for created_at field.
+	inbounddedupkeyDescCreatedAt := inbounddedupkeyFields[3].Descriptor()
+	// inbounddedupkey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	inbounddedupkey.DefaultCreatedAt = inbounddedupkeyDescCreatedAt.Default.(func() time.Time)
+	inboundemailFields := schema.InboundEmail{}.Fields()
+	_ = inboundemailFields
+	// inboundemailDescTenantID is the schema descriptor for tenant_id field.
+	inboundemailDescTenantID := inboundemailFields[0].Descriptor()
+	// inboundemail.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	inboundemail.TenantIDValidator = inboundemailDescTenantID.Validators[0].(func(int) error)
+	// inboundemailDescMessageID is the schema descriptor for message_id field.
+	inboundemailDescMessageID := inboundemailFields[2].Descriptor()
+	// inboundemail.MessageIDValidator is a validator for the "message_id" field. It is called by the builders before save.
+	inboundemail.MessageIDValidator = inboundemailDescMessageID.Validators[0].(func(string) error)
+	// inboundemailDescAttachmentCount is the schema descriptor for attachment_count field.
+	inboundemailDescAttachmentCount := inboundemailFields[13].Descriptor()
+	// inboundemail.DefaultAttachmentCount holds the default value on creation for the attachment_count field.
+	inboundemail.DefaultAttachmentCount = inboundemailDescAttachmentCount.Default.(int)
+	// inboundemailDescReceivedAt is the schema descriptor for received_at field.
+	inboundemailDescReceivedAt := inboundemailFields[14].Descriptor()
+	// inboundemail.DefaultReceivedAt holds the default value on creation for the received_at field.
+	inboundemail.DefaultReceivedAt = inboundemailDescReceivedAt.Default.(func() time.Time)
+	// inboundemailDescCreatedAt is the schema descriptor for created_at field.
+	inboundemailDescCreatedAt := inboundemailFields[15].Descriptor()
+	// inboundemail.DefaultCreatedAt holds the default value on creation for the created_at field.
+	inboundemail.DefaultCreatedAt = inboundemailDescCreatedAt.Default.(func() time.Time)
+	inboundmessagelogFields := schema.InboundMessageLog{}.Fields()
+	_ = inboundmessagelogFields
+	// inboundmessagelogDescTenantID is the schema descriptor for tenant_id field.
+	inboundmessagelogDescTenantID := inboundmessagelogFields[0].Descriptor()
+	// inboundmessagelog.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	inboundmessagelog.TenantIDValidator = inboundmessagelogDescTenantID.Validators[0].(func(int) error)
+	// inboundmessagelogDescConnectorName is the schema descriptor for connector_name field.
+	inboundmessagelogDescConnectorName := inboundmessagelogFields[1].Descriptor()
+	// inboundmessagelog.ConnectorNameValidator is a validator for the "connector_name" field. It is called by the builders before save.
+	inboundmessagelog.ConnectorNameValidator = inboundmessagelogDescConnectorName.Validators[0].(func(string) error)
+	// inboundmessagelogDescReplayCount is the schema descriptor for replay_count field.
+	inboundmessagelogDescReplayCount := inboundmessagelogFields[11].Descriptor()
+	// inboundmessagelog.DefaultReplayCount holds the default value on creation for the replay_count field.
+	inboundmessagelog.DefaultReplayCount = inboundmessagelogDescReplayCount.Default.(int)
+	// inboundmessagelog.ReplayCountValidator is a validator for the "replay_count" field. It is called by the builders before save.
+	inboundmessagelog.ReplayCountValidator = inboundmessagelogDescReplayCount.Validators[0].(func(int) error)
+	// inboundmessagelogDescReceivedAt is the schema descriptor for received_at field.
+	inboundmessagelogDescReceivedAt := inboundmessagelogFields[13].Descriptor()
+	// inboundmessagelog.DefaultReceivedAt holds the default value on creation for the received_at field.
+	inboundmessagelog.DefaultReceivedAt = inboundmessagelogDescReceivedAt.Default.(func() time.Time)
+	// inboundmessagelogDescCreatedAt is the schema descriptor for created_at field.
+	inboundmessagelogDescCreatedAt := inboundmessagelogFields[14].Descriptor()
+	// inboundmessagelog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	inboundmessagelog.DefaultCreatedAt = inboundmessagelogDescCreatedAt.Default.(func() time.Time)
+	// inboundmessagelogDescUpdatedAt is the schema descriptor for updated_at field.
+	inboundmessagelogDescUpdatedAt := inboundmessagelogFields[15].Descriptor()
+	// inboundmessagelog.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	inboundmessagelog.DefaultUpdatedAt = inboundmessagelogDescUpdatedAt.Default.(func() time.Time)
+	// inboundmessagelog.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	inboundmessagelog.UpdateDefaultUpdatedAt = inboundmessagelogDescUpdatedAt.UpdateDefault.(func() time.Time)
 	incidentFields := schema.Incident{}.Fields()
 	_ = incidentFields
 	// incidentDescTitle is the schema descriptor for title field.
@@ -1771,6 +2008,50 @@ func init() {
 	mspallocationDescCreatedAt := mspallocationFields[5].Descriptor()
 	// mspallocation.DefaultCreatedAt holds the default value on creation for the created_at field.
 	mspallocation.DefaultCreatedAt = mspallocationDescCreatedAt.Default.(func() time.Time)
+	majorincidentFields := schema.MajorIncident{}.Fields()
+	_ = majorincidentFields
+	// majorincidentDescTenantID is the schema descriptor for tenant_id field.
+	majorincidentDescTenantID := majorincidentFields[0].Descriptor()
+	// majorincident.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	majorincident.TenantIDValidator = majorincidentDescTenantID.Validators[0].(func(int) error)
+	// majorincidentDescIncidentID is the schema descriptor for incident_id field.
+	majorincidentDescIncidentID := majorincidentFields[1].Descriptor()
+	// majorincident.IncidentIDValidator is a validator for the "incident_id" field. It is called by the builders before save.
+	majorincident.IncidentIDValidator = majorincidentDescIncidentID.Validators[0].(func(int) error)
+	// majorincidentDescCommanderID is the schema descriptor for commander_id field.
+	majorincidentDescCommanderID := majorincidentFields[2].Descriptor()
+	// majorincident.CommanderIDValidator is a validator for the "commander_id" field. It is called by the builders before save.
+	majorincident.CommanderIDValidator = majorincidentDescCommanderID.Validators[0].(func(int) error)
+	// majorincidentDescDeclaredBy is the schema descriptor for declared_by field.
+	majorincidentDescDeclaredBy := majorincidentFields[3].Descriptor()
+	// majorincident.DeclaredByValidator is a validator for the "declared_by" field. It is called by the builders before save.
+	majorincident.DeclaredByValidator = majorincidentDescDeclaredBy.Validators[0].(func(int) error)
+	// majorincidentDescDeclaredAt is the schema descriptor for declared_at field.
+	majorincidentDescDeclaredAt := majorincidentFields[4].Descriptor()
+	// majorincident.DefaultDeclaredAt holds the default value on creation for the declared_at field.
+	majorincident.DefaultDeclaredAt = majorincidentDescDeclaredAt.Default.(func() time.Time)
+	// majorincidentDescUpdateCadenceMinutes is the schema descriptor for update_cadence_minutes field.
+	majorincidentDescUpdateCadenceMinutes := majorincidentFields[12].Descriptor()
+	// majorincident.DefaultUpdateCadenceMinutes holds the default value on creation for the update_cadence_minutes field.
+	majorincident.DefaultUpdateCadenceMinutes = majorincidentDescUpdateCadenceMinutes.Default.(int)
+	// majorincident.UpdateCadenceMinutesValidator is a validator for the "update_cadence_minutes" field. It is called by the builders before save.
+	majorincident.UpdateCadenceMinutesValidator = majorincidentDescUpdateCadenceMinutes.Validators[0].(func(int) error)
+	// majorincidentDescUpdateCount is the schema descriptor for update_count field.
+	majorincidentDescUpdateCount := majorincidentFields[15].Descriptor()
+	// majorincident.DefaultUpdateCount holds the default value on creation for the update_count field.
+	majorincident.DefaultUpdateCount = majorincidentDescUpdateCount.Default.(int)
+	// majorincident.UpdateCountValidator is a validator for the "update_count" field. It is called by the builders before save.
+	majorincident.UpdateCountValidator = majorincidentDescUpdateCount.Validators[0].(func(int) error)
+	// majorincidentDescCreatedAt is the schema descriptor for created_at field.
+	majorincidentDescCreatedAt := majorincidentFields[19].Descriptor()
+	// majorincident.DefaultCreatedAt holds the default value on creation for the created_at field.
+	majorincident.DefaultCreatedAt = majorincidentDescCreatedAt.Default.(func() time.Time)
+	// majorincidentDescUpdatedAt is the schema descriptor for updated_at field.
+	majorincidentDescUpdatedAt := majorincidentFields[20].Descriptor()
+	// majorincident.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	majorincident.DefaultUpdatedAt = majorincidentDescUpdatedAt.Default.(func() time.Time)
+	// majorincident.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	majorincident.UpdateDefaultUpdatedAt = majorincidentDescUpdatedAt.UpdateDefault.(func() time.Time)
 	marketplaceitemFields := schema.MarketplaceItem{}.Fields()
 	_ = marketplaceitemFields
 	// marketplaceitemDescRating is the schema descriptor for rating field.
@@ -1867,6 +2148,74 @@ func init() {
 	microservice.DefaultUpdatedAt = microserviceDescUpdatedAt.Default.(func() time.Time)
 	// microservice.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	microservice.UpdateDefaultUpdatedAt = microserviceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	monitoringalertFields := schema.MonitoringAlert{}.Fields()
+	_ = monitoringalertFields
+	// monitoringalertDescTenantID is the schema descriptor for tenant_id field.
+	monitoringalertDescTenantID := monitoringalertFields[0].Descriptor()
+	// monitoringalert.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	monitoringalert.TenantIDValidator = monitoringalertDescTenantID.Validators[0].(func(int) error)
+	// monitoringalertDescSource is the schema descriptor for source field.
+	monitoringalertDescSource := monitoringalertFields[1].Descriptor()
+	// monitoringalert.SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	monitoringalert.SourceValidator = monitoringalertDescSource.Validators[0].(func(string) error)
+	// monitoringalertDescFingerprint is the schema descriptor for fingerprint field.
+	monitoringalertDescFingerprint := monitoringalertFields[2].Descriptor()
+	// monitoringalert.FingerprintValidator is a validator for the "fingerprint" field. It is called by the builders before save.
+	monitoringalert.FingerprintValidator = monitoringalertDescFingerprint.Validators[0].(func(string) error)
+	// monitoringalertDescAlertName is the schema descriptor for alert_name field.
+	monitoringalertDescAlertName := monitoringalertFields[3].Descriptor()
+	// monitoringalert.AlertNameValidator is a validator for the "alert_name" field. It is called by the builders before save.
+	monitoringalert.AlertNameValidator = monitoringalertDescAlertName.Validators[0].(func(string) error)
+	// monitoringalertDescSeverity is the schema descriptor for severity field.
+	monitoringalertDescSeverity := monitoringalertFields[5].Descriptor()
+	// monitoringalert.DefaultSeverity holds the default value on creation for the severity field.
+	monitoringalert.DefaultSeverity = monitoringalertDescSeverity.Default.(string)
+	// monitoringalertDescStartsAt is the schema descriptor for starts_at field.
+	monitoringalertDescStartsAt := monitoringalertFields[10].Descriptor()
+	// monitoringalert.DefaultStartsAt holds the default value on creation for the starts_at field.
+	monitoringalert.DefaultStartsAt = monitoringalertDescStartsAt.Default.(func() time.Time)
+	// monitoringalertDescLastReceivedAt is the schema descriptor for last_received_at field.
+	monitoringalertDescLastReceivedAt := monitoringalertFields[12].Descriptor()
+	// monitoringalert.DefaultLastReceivedAt holds the default value on creation for the last_received_at field.
+	monitoringalert.DefaultLastReceivedAt = monitoringalertDescLastReceivedAt.Default.(func() time.Time)
+	// monitoringalertDescReceiveCount is the schema descriptor for receive_count field.
+	monitoringalertDescReceiveCount := monitoringalertFields[13].Descriptor()
+	// monitoringalert.DefaultReceiveCount holds the default value on creation for the receive_count field.
+	monitoringalert.DefaultReceiveCount = monitoringalertDescReceiveCount.Default.(int)
+	// monitoringalert.ReceiveCountValidator is a validator for the "receive_count" field. It is called by the builders before save.
+	monitoringalert.ReceiveCountValidator = monitoringalertDescReceiveCount.Validators[0].(func(int) error)
+	// monitoringalertDescFlapping is the schema descriptor for flapping field.
+	monitoringalertDescFlapping := monitoringalertFields[21].Descriptor()
+	// monitoringalert.DefaultFlapping holds the default value on creation for the flapping field.
+	monitoringalert.DefaultFlapping = monitoringalertDescFlapping.Default.(bool)
+	// monitoringalertDescIncidentsOpened is the schema descriptor for incidents_opened field.
+	monitoringalertDescIncidentsOpened := monitoringalertFields[22].Descriptor()
+	// monitoringalert.DefaultIncidentsOpened holds the default value on creation for the incidents_opened field.
+	monitoringalert.DefaultIncidentsOpened = monitoringalertDescIncidentsOpened.Default.(int)
+	// monitoringalert.IncidentsOpenedValidator is a validator for the "incidents_opened" field. It is called by the builders before save.
+	monitoringalert.IncidentsOpenedValidator = monitoringalertDescIncidentsOpened.Validators[0].(func(int) error)
+	// monitoringalertDescCorrelatedCount is the schema descriptor for correlated_count field.
+	monitoringalertDescCorrelatedCount := monitoringalertFields[23].Descriptor()
+	// monitoringalert.DefaultCorrelatedCount holds the default value on creation for the correlated_count field.
+	monitoringalert.DefaultCorrelatedCount = monitoringalertDescCorrelatedCount.Default.(int)
+	// monitoringalert.CorrelatedCountValidator is a validator for the "correlated_count" field. It is called by the builders before save.
+	monitoringalert.CorrelatedCountValidator = monitoringalertDescCorrelatedCount.Validators[0].(func(int) error)
+	// monitoringalertDescSuppressedCount is the schema descriptor for suppressed_count field.
+	monitoringalertDescSuppressedCount := monitoringalertFields[24].Descriptor()
+	// monitoringalert.DefaultSuppressedCount holds the default value on creation for the suppressed_count field.
+	monitoringalert.DefaultSuppressedCount = monitoringalertDescSuppressedCount.Default.(int)
+	// monitoringalert.SuppressedCountValidator is a validator for the "suppressed_count" field. It is called by the builders before save.
+	monitoringalert.SuppressedCountValidator = monitoringalertDescSuppressedCount.Validators[0].(func(int) error)
+	// monitoringalertDescCreatedAt is the schema descriptor for created_at field.
+	monitoringalertDescCreatedAt := monitoringalertFields[25].Descriptor()
+	// monitoringalert.DefaultCreatedAt holds the default value on creation for the created_at field.
+	monitoringalert.DefaultCreatedAt = monitoringalertDescCreatedAt.Default.(func() time.Time)
+	// monitoringalertDescUpdatedAt is the schema descriptor for updated_at field.
+	monitoringalertDescUpdatedAt := monitoringalertFields[26].Descriptor()
+	// monitoringalert.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	monitoringalert.DefaultUpdatedAt = monitoringalertDescUpdatedAt.Default.(func() time.Time)
+	// monitoringalert.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	monitoringalert.UpdateDefaultUpdatedAt = monitoringalertDescUpdatedAt.UpdateDefault.(func() time.Time)
 	notificationFields := schema.Notification{}.Fields()
 	_ = notificationFields
 	// notificationDescTitle is the schema descriptor for title field.
@@ -2021,6 +2370,106 @@ func init() {
 	notificationpreference.DefaultUpdatedAt = notificationpreferenceDescUpdatedAt.Default.(func() time.Time)
 	// notificationpreference.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	notificationpreference.UpdateDefaultUpdatedAt = notificationpreferenceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	oncalloverrideFields := schema.OnCallOverride{}.Fields()
+	_ = oncalloverrideFields
+	// oncalloverrideDescTenantID is the schema descriptor for tenant_id field.
+	oncalloverrideDescTenantID := oncalloverrideFields[0].Descriptor()
+	// oncalloverride.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	oncalloverride.TenantIDValidator = oncalloverrideDescTenantID.Validators[0].(func(int) error)
+	// oncalloverrideDescScheduleID is the schema descriptor for schedule_id field.
+	oncalloverrideDescScheduleID := oncalloverrideFields[1].Descriptor()
+	// oncalloverride.ScheduleIDValidator is a validator for the "schedule_id" field. It is called by the builders before save.
+	oncalloverride.ScheduleIDValidator = oncalloverrideDescScheduleID.Validators[0].(func(int) error)
+	// oncalloverrideDescUserID is the schema descriptor for user_id field.
+	oncalloverrideDescUserID := oncalloverrideFields[2].Descriptor()
+	// oncalloverride.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	oncalloverride.UserIDValidator = oncalloverrideDescUserID.Validators[0].(func(int) error)
+	// oncalloverrideDescReason is the schema descriptor for reason field.
+	oncalloverrideDescReason := oncalloverrideFields[6].Descriptor()
+	// oncalloverride.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	oncalloverride.ReasonValidator = oncalloverrideDescReason.Validators[0].(func(string) error)
+	// oncalloverrideDescCreatedAt is the schema descriptor for created_at field.
+	oncalloverrideDescCreatedAt := oncalloverrideFields[9].Descriptor()
+	// oncalloverride.DefaultCreatedAt holds the default value on creation for the created_at field.
+	oncalloverride.DefaultCreatedAt = oncalloverrideDescCreatedAt.Default.(func() time.Time)
+	oncallpageFields := schema.OnCallPage{}.Fields()
+	_ = oncallpageFields
+	// oncallpageDescTenantID is the schema descriptor for tenant_id field.
+	oncallpageDescTenantID := oncallpageFields[0].Descriptor()
+	// oncallpage.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	oncallpage.TenantIDValidator = oncallpageDescTenantID.Validators[0].(func(int) error)
+	// oncallpageDescPolicyID is the schema descriptor for policy_id field.
+	oncallpageDescPolicyID := oncallpageFields[1].Descriptor()
+	// oncallpage.PolicyIDValidator is a validator for the "policy_id" field. It is called by the builders before save.
+	oncallpage.PolicyIDValidator = oncallpageDescPolicyID.Validators[0].(func(int) error)
+	// oncallpageDescIncidentID is the schema descriptor for incident_id field.
+	oncallpageDescIncidentID := oncallpageFields[2].Descriptor()
+	// oncallpage.IncidentIDValidator is a validator for the "incident_id" field. It is called by the builders before save.
+	oncallpage.IncidentIDValidator = oncallpageDescIncidentID.Validators[0].(func(int) error)
+	// oncallpageDescStep is the schema descriptor for step field.
+	oncallpageDescStep := oncallpageFields[4].Descriptor()
+	// oncallpage.DefaultStep holds the default value on creation for the step field.
+	oncallpage.DefaultStep = oncallpageDescStep.Default.(int)
+	// oncallpage.StepValidator is a validator for the "step" field. It is called by the builders before save.
+	oncallpage.StepValidator = oncallpageDescStep.Validators[0].(func(int) error)
+	// oncallpageDescRound is the schema descriptor for round field.
+	oncallpageDescRound := oncallpageFields[5].Descriptor()
+	// oncallpage.DefaultRound holds the default value on creation for the round field.
+	oncallpage.DefaultRound = oncallpageDescRound.Default.(int)
+	// oncallpage.RoundValidator is a validator for the "round" field. It is called by the builders before save.
+	oncallpage.RoundValidator = oncallpageDescRound.Validators[0].(func(int) error)
+	// oncallpageDescCreatedAt is the schema descriptor for created_at field.
+	oncallpageDescCreatedAt := oncallpageFields[11].Descriptor()
+	// oncallpage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	oncallpage.DefaultCreatedAt = oncallpageDescCreatedAt.Default.(func() time.Time)
+	// oncallpageDescUpdatedAt is the schema descriptor for updated_at field.
+	oncallpageDescUpdatedAt := oncallpageFields[12].Descriptor()
+	// oncallpage.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	oncallpage.DefaultUpdatedAt = oncallpageDescUpdatedAt.Default.(func() time.Time)
+	// oncallpage.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	oncallpage.UpdateDefaultUpdatedAt = oncallpageDescUpdatedAt.UpdateDefault.(func() time.Time)
+	oncallscheduleFields := schema.OnCallSchedule{}.Fields()
+	_ = oncallscheduleFields
+	// oncallscheduleDescTenantID is the schema descriptor for tenant_id field.
+	oncallscheduleDescTenantID := oncallscheduleFields[0].Descriptor()
+	// oncallschedule.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	oncallschedule.TenantIDValidator = oncallscheduleDescTenantID.Validators[0].(func(int) error)
+	// oncallscheduleDescName is the schema descriptor for name field.
+	oncallscheduleDescName := oncallscheduleFields[1].Descriptor()
+	// oncallschedule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	oncallschedule.NameValidator = func() func(string) error {
+		validators := oncallscheduleDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// oncallscheduleDescTimeZone is the schema descriptor for time_zone field.
+	oncallscheduleDescTimeZone := oncallscheduleFields[4].Descriptor()
+	// oncallschedule.DefaultTimeZone holds the default value on creation for the time_zone field.
+	oncallschedule.DefaultTimeZone = oncallscheduleDescTimeZone.Default.(string)
+	// oncallscheduleDescEnabled is the schema descriptor for enabled field.
+	oncallscheduleDescEnabled := oncallscheduleFields[6].Descriptor()
+	// oncallschedule.DefaultEnabled holds the default value on creation for the enabled field.
+	oncallschedule.DefaultEnabled = oncallscheduleDescEnabled.Default.(bool)
+	// oncallscheduleDescCreatedAt is the schema descriptor for created_at field.
+	oncallscheduleDescCreatedAt := oncallscheduleFields[8].Descriptor()
+	// oncallschedule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	oncallschedule.DefaultCreatedAt = oncallscheduleDescCreatedAt.Default.(func() time.Time)
+	// oncallscheduleDescUpdatedAt is the schema descriptor for updated_at field.
+	oncallscheduleDescUpdatedAt := oncallscheduleFields[9].Descriptor()
+	// oncallschedule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	oncallschedule.DefaultUpdatedAt = oncallscheduleDescUpdatedAt.Default.(func() time.Time)
+	// oncallschedule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	oncallschedule.UpdateDefaultUpdatedAt = oncallscheduleDescUpdatedAt.UpdateDefault.(func() time.Time)
 	operationalcommandFields := schema.OperationalCommand{}.Fields()
 	_ = operationalcommandFields
 	// operationalcommandDescTenantID is the schema descriptor for tenant_id field.
@@ -2451,6 +2900,132 @@ func init() {
 	processdeployment.DefaultUpdatedAt = processdeploymentDescUpdatedAt.Default.(func() time.Time)
 	// processdeployment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	processdeployment.UpdateDefaultUpdatedAt = processdeploymentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	processeventinstanceFields := schema.ProcessEventInstance{}.Fields()
+	_ = processeventinstanceFields
+	// processeventinstanceDescEventInstanceID is the schema descriptor for event_instance_id field.
+	processeventinstanceDescEventInstanceID := processeventinstanceFields[0].Descriptor()
+	// processeventinstance.EventInstanceIDValidator is a validator for the "event_instance_id" field. It is called by the builders before save.
+	processeventinstance.EventInstanceIDValidator = processeventinstanceDescEventInstanceID.Validators[0].(func(string) error)
+	// processeventinstanceDescTenantID is the schema descriptor for tenant_id field.
+	processeventinstanceDescTenantID := processeventinstanceFields[1].Descriptor()
+	// processeventinstance.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	processeventinstance.TenantIDValidator = processeventinstanceDescTenantID.Validators[0].(func(int) error)
+	// processeventinstanceDescEventDefinitionID is the schema descriptor for event_definition_id field.
+	processeventinstanceDescEventDefinitionID := processeventinstanceFields[2].Descriptor()
+	// processeventinstance.EventDefinitionIDValidator is a validator for the "event_definition_id" field. It is called by the builders before save.
+	processeventinstance.EventDefinitionIDValidator = processeventinstanceDescEventDefinitionID.Validators[0].(func(string) error)
+	// processeventinstanceDescEventType is the schema descriptor for event_type field.
+	processeventinstanceDescEventType := processeventinstanceFields[3].Descriptor()
+	// processeventinstance.EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	processeventinstance.EventTypeValidator = processeventinstanceDescEventType.Validators[0].(func(string) error)
+	// processeventinstanceDescTrigger is the schema descriptor for trigger field.
+	processeventinstanceDescTrigger := processeventinstanceFields[4].Descriptor()
+	// processeventinstance.TriggerValidator is a validator for the "trigger" field. It is called by the builders before save.
+	processeventinstance.TriggerValidator = processeventinstanceDescTrigger.Validators[0].(func(string) error)
+	// processeventinstanceDescEventName is the schema descriptor for event_name field.
+	processeventinstanceDescEventName := processeventinstanceFields[5].Descriptor()
+	// processeventinstance.EventNameValidator is a validator for the "event_name" field. It is called by the builders before save.
+	processeventinstance.EventNameValidator = processeventinstanceDescEventName.Validators[0].(func(string) error)
+	// processeventinstanceDescStatus is the schema descriptor for status field.
+	processeventinstanceDescStatus := processeventinstanceFields[10].Descriptor()
+	// processeventinstance.DefaultStatus holds the default value on creation for the status field.
+	processeventinstance.DefaultStatus = processeventinstanceDescStatus.Default.(string)
+	// processeventinstance.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	processeventinstance.StatusValidator = processeventinstanceDescStatus.Validators[0].(func(string) error)
+	// processeventinstanceDescError is the schema descriptor for error field.
+	processeventinstanceDescError := processeventinstanceFields[11].Descriptor()
+	// processeventinstance.ErrorValidator is a validator for the "error" field. It is called by the builders before save.
+	processeventinstance.ErrorValidator = processeventinstanceDescError.Validators[0].(func(string) error)
+	// processeventinstanceDescRetryCount is the schema descriptor for retry_count field.
+	processeventinstanceDescRetryCount := processeventinstanceFields[12].Descriptor()
+	// processeventinstance.DefaultRetryCount holds the default value on creation for the retry_count field.
+	processeventinstance.DefaultRetryCount = processeventinstanceDescRetryCount.Default.(int)
+	// processeventinstance.RetryCountValidator is a validator for the "retry_count" field. It is called by the builders before save.
+	processeventinstance.RetryCountValidator = processeventinstanceDescRetryCount.Validators[0].(func(int) error)
+	// processeventinstanceDescCreatedAt is the schema descriptor for created_at field.
+	processeventinstanceDescCreatedAt := processeventinstanceFields[15].Descriptor()
+	// processeventinstance.DefaultCreatedAt holds the default value on creation for the created_at field.
+	processeventinstance.DefaultCreatedAt = processeventinstanceDescCreatedAt.Default.(func() time.Time)
+	// processeventinstanceDescUpdatedAt is the schema descriptor for updated_at field.
+	processeventinstanceDescUpdatedAt := processeventinstanceFields[16].Descriptor()
+	// processeventinstance.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	processeventinstance.DefaultUpdatedAt = processeventinstanceDescUpdatedAt.Default.(func() time.Time)
+	// processeventinstance.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	processeventinstance.UpdateDefaultUpdatedAt = processeventinstanceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	processeventsubscriptionFields := schema.ProcessEventSubscription{}.Fields()
+	_ = processeventsubscriptionFields
+	// processeventsubscriptionDescTenantID is the schema descriptor for tenant_id field.
+	processeventsubscriptionDescTenantID := processeventsubscriptionFields[0].Descriptor()
+	// processeventsubscription.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	processeventsubscription.TenantIDValidator = processeventsubscriptionDescTenantID.Validators[0].(func(int) error)
+	// processeventsubscriptionDescProcessInstanceID is the schema descriptor for process_instance_id field.
+	processeventsubscriptionDescProcessInstanceID := processeventsubscriptionFields[1].Descriptor()
+	// processeventsubscription.ProcessInstanceIDValidator is a validator for the "process_instance_id" field. It is called by the builders before save.
+	processeventsubscription.ProcessInstanceIDValidator = processeventsubscriptionDescProcessInstanceID.Validators[0].(func(int) error)
+	// processeventsubscriptionDescProcessDefinitionKey is the schema descriptor for process_definition_key field.
+	processeventsubscriptionDescProcessDefinitionKey := processeventsubscriptionFields[2].Descriptor()
+	// processeventsubscription.ProcessDefinitionKeyValidator is a validator for the "process_definition_key" field. It is called by the builders before save.
+	processeventsubscription.ProcessDefinitionKeyValidator = processeventsubscriptionDescProcessDefinitionKey.Validators[0].(func(string) error)
+	// processeventsubscriptionDescElementID is the schema descriptor for element_id field.
+	processeventsubscriptionDescElementID := processeventsubscriptionFields[4].Descriptor()
+	// processeventsubscription.ElementIDValidator is a validator for the "element_id" field. It is called by the builders before save.
+	processeventsubscription.ElementIDValidator = processeventsubscriptionDescElementID.Validators[0].(func(string) error)
+	// processeventsubscriptionDescInterrupting is the schema descriptor for interrupting field.
+	processeventsubscriptionDescInterrupting := processeventsubscriptionFields[6].Descriptor()
+	// processeventsubscription.DefaultInterrupting holds the default value on creation for the interrupting field.
+	processeventsubscription.DefaultInterrupting = processeventsubscriptionDescInterrupting.Default.(bool)
+	// processeventsubscriptionDescEventType is the schema descriptor for event_type field.
+	processeventsubscriptionDescEventType := processeventsubscriptionFields[7].Descriptor()
+	// processeventsubscription.EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	processeventsubscription.EventTypeValidator = func() func(string) error {
+		validators := processeventsubscriptionDescEventType.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(event_type string) error {
+			for _, fn := range fns {
+				if err := fn(event_type); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// processeventsubscriptionDescEventName is the schema descriptor for event_name field.
+	processeventsubscriptionDescEventName := processeventsubscriptionFields[8].Descriptor()
+	// processeventsubscription.EventNameValidator is a validator for the "event_name" field. It is called by the builders before save.
+	processeventsubscription.EventNameValidator = func() func(string) error {
+		validators := processeventsubscriptionDescEventName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(event_name string) error {
+			for _, fn := range fns {
+				if err := fn(event_name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// processeventsubscriptionDescStatus is the schema descriptor for status field.
+	processeventsubscriptionDescStatus := processeventsubscriptionFields[9].Descriptor()
+	// processeventsubscription.DefaultStatus holds the default value on creation for the status field.
+	processeventsubscription.DefaultStatus = processeventsubscriptionDescStatus.Default.(string)
+	// processeventsubscription.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	processeventsubscription.StatusValidator = processeventsubscriptionDescStatus.Validators[0].(func(string) error)
+	// processeventsubscriptionDescCreatedAt is the schema descriptor for created_at field.
+	processeventsubscriptionDescCreatedAt := processeventsubscriptionFields[11].Descriptor()
+	// processeventsubscription.DefaultCreatedAt holds the default value on creation for the created_at field.
+	processeventsubscription.DefaultCreatedAt = processeventsubscriptionDescCreatedAt.Default.(func() time.Time)
+	// processeventsubscriptionDescUpdatedAt is the schema descriptor for updated_at field.
+	processeventsubscriptionDescUpdatedAt := processeventsubscriptionFields[12].Descriptor()
+	// processeventsubscription.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	processeventsubscription.DefaultUpdatedAt = processeventsubscriptionDescUpdatedAt.Default.(func() time.Time)
+	// processeventsubscription.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	processeventsubscription.UpdateDefaultUpdatedAt = processeventsubscriptionDescUpdatedAt.UpdateDefault.(func() time.Time)
 	processexecutionhistoryFields := schema.ProcessExecutionHistory{}.Fields()
 	_ = processexecutionhistoryFields
 	// processexecutionhistoryDescHistoryID is the schema descriptor for history_id field.
@@ -2485,6 +3060,64 @@ func init() {
 	processexecutionhistoryDescCreatedAt := processexecutionhistoryFields[16].Descriptor()
 	// processexecutionhistory.DefaultCreatedAt holds the default value on creation for the created_at field.
 	processexecutionhistory.DefaultCreatedAt = processexecutionhistoryDescCreatedAt.Default.(func() time.Time)
+	processincidentFields := schema.ProcessIncident{}.Fields()
+	_ = processincidentFields
+	// processincidentDescTenantID is the schema descriptor for tenant_id field.
+	processincidentDescTenantID := processincidentFields[0].Descriptor()
+	// processincident.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	processincident.TenantIDValidator = processincidentDescTenantID.Validators[0].(func(int) error)
+	// processincidentDescProcessInstanceID is the schema descriptor for process_instance_id field.
+	processincidentDescProcessInstanceID := processincidentFields[1].Descriptor()
+	// processincident.ProcessInstanceIDValidator is a validator for the "process_instance_id" field. It is called by the builders before save.
+	processincident.ProcessInstanceIDValidator = processincidentDescProcessInstanceID.Validators[0].(func(int) error)
+	// processincidentDescProcessDefinitionKey is the schema descriptor for process_definition_key field.
+	processincidentDescProcessDefinitionKey := processincidentFields[2].Descriptor()
+	// processincident.ProcessDefinitionKeyValidator is a validator for the "process_definition_key" field. It is called by the builders before save.
+	processincident.ProcessDefinitionKeyValidator = processincidentDescProcessDefinitionKey.Validators[0].(func(string) error)
+	// processincidentDescElementID is the schema descriptor for element_id field.
+	processincidentDescElementID := processincidentFields[3].Descriptor()
+	// processincident.ElementIDValidator is a validator for the "element_id" field. It is called by the builders before save.
+	processincident.ElementIDValidator = processincidentDescElementID.Validators[0].(func(string) error)
+	// processincidentDescIncidentType is the schema descriptor for incident_type field.
+	processincidentDescIncidentType := processincidentFields[4].Descriptor()
+	// processincident.DefaultIncidentType holds the default value on creation for the incident_type field.
+	processincident.DefaultIncidentType = processincidentDescIncidentType.Default.(string)
+	// processincident.IncidentTypeValidator is a validator for the "incident_type" field. It is called by the builders before save.
+	processincident.IncidentTypeValidator = processincidentDescIncidentType.Validators[0].(func(string) error)
+	// processincidentDescMessage is the schema descriptor for message field.
+	processincidentDescMessage := processincidentFields[5].Descriptor()
+	// processincident.MessageValidator is a validator for the "message" field. It is called by the builders before save.
+	processincident.MessageValidator = processincidentDescMessage.Validators[0].(func(string) error)
+	// processincidentDescAttempts is the schema descriptor for attempts field.
+	processincidentDescAttempts := processincidentFields[6].Descriptor()
+	// processincident.DefaultAttempts holds the default value on creation for the attempts field.
+	processincident.DefaultAttempts = processincidentDescAttempts.Default.(int)
+	// processincident.AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	processincident.AttemptsValidator = processincidentDescAttempts.Validators[0].(func(int) error)
+	// processincidentDescStatus is the schema descriptor for status field.
+	processincidentDescStatus := processincidentFields[7].Descriptor()
+	// processincident.DefaultStatus holds the default value on creation for the status field.
+	processincident.DefaultStatus = processincidentDescStatus.Default.(string)
+	// processincident.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	processincident.StatusValidator = processincidentDescStatus.Validators[0].(func(string) error)
+	// processincidentDescResolution is the schema descriptor for resolution field.
+	processincidentDescResolution := processincidentFields[8].Descriptor()
+	// processincident.ResolutionValidator is a validator for the "resolution" field. It is called by the builders before save.
+	processincident.ResolutionValidator = processincidentDescResolution.Validators[0].(func(string) error)
+	// processincidentDescResolvedBy is the schema descriptor for resolved_by field.
+	processincidentDescResolvedBy := processincidentFields[9].Descriptor()
+	// processincident.ResolvedByValidator is a validator for the "resolved_by" field. It is called by the builders before save.
+	processincident.ResolvedByValidator = processincidentDescResolvedBy.Validators[0].(func(string) error)
+	// processincidentDescCreatedAt is the schema descriptor for created_at field.
+	processincidentDescCreatedAt := processincidentFields[11].Descriptor()
+	// processincident.DefaultCreatedAt holds the default value on creation for the created_at field.
+	processincident.DefaultCreatedAt = processincidentDescCreatedAt.Default.(func() time.Time)
+	// processincidentDescUpdatedAt is the schema descriptor for updated_at field.
+	processincidentDescUpdatedAt := processincidentFields[12].Descriptor()
+	// processincident.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	processincident.DefaultUpdatedAt = processincidentDescUpdatedAt.Default.(func() time.Time)
+	// processincident.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	processincident.UpdateDefaultUpdatedAt = processincidentDescUpdatedAt.UpdateDefault.(func() time.Time)
 	processinstanceFields := schema.ProcessInstance{}.Fields()
 	_ = processinstanceFields
 	// processinstanceDescProcessInstanceID is the schema descriptor for process_instance_id field.
@@ -3028,19 +3661,19 @@ func init() {
 	// sladefinition.ResolutionTimeValidator is a validator for the "resolution_time" field. It is called by the builders before save.
 	sladefinition.ResolutionTimeValidator = sladefinitionDescResolutionTime.Validators[0].(func(int) error)
 	// sladefinitionDescIsActive is the schema descriptor for is_active field.
-	sladefinitionDescIsActive := sladefinitionFields[9].Descriptor()
+	sladefinitionDescIsActive := sladefinitionFields[11].Descriptor()
 	// sladefinition.DefaultIsActive holds the default value on creation for the is_active field.
 	sladefinition.DefaultIsActive = sladefinitionDescIsActive.Default.(bool)
 	// sladefinitionDescTenantID is the schema descriptor for tenant_id field.
-	sladefinitionDescTenantID := sladefinitionFields[10].Descriptor()
+	sladefinitionDescTenantID := sladefinitionFields[12].Descriptor()
 	// sladefinition.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
 	sladefinition.TenantIDValidator = sladefinitionDescTenantID.Validators[0].(func(int) error)
 	// sladefinitionDescCreatedAt is the schema descriptor for created_at field.
-	sladefinitionDescCreatedAt := sladefinitionFields[11].Descriptor()
+	sladefinitionDescCreatedAt := sladefinitionFields[13].Descriptor()
 	// sladefinition.DefaultCreatedAt holds the default value on creation for the created_at field.
 	sladefinition.DefaultCreatedAt = sladefinitionDescCreatedAt.Default.(func() time.Time)
 	// sladefinitionDescUpdatedAt is the schema descriptor for updated_at field.
-	sladefinitionDescUpdatedAt := sladefinitionFields[12].Descriptor()
+	sladefinitionDescUpdatedAt := sladefinitionFields[14].Descriptor()
 	// sladefinition.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	sladefinition.DefaultUpdatedAt = sladefinitionDescUpdatedAt.Default.(func() time.Time)
 	// sladefinition.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -3083,40 +3716,44 @@ func init() {
 	slapolicyDescName := slapolicyFields[0].Descriptor()
 	// slapolicy.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	slapolicy.NameValidator = slapolicyDescName.Validators[0].(func(string) error)
+	// slapolicyDescAgreementType is the schema descriptor for agreement_type field.
+	slapolicyDescAgreementType := slapolicyFields[5].Descriptor()
+	// slapolicy.DefaultAgreementType holds the default value on creation for the agreement_type field.
+	slapolicy.DefaultAgreementType = slapolicyDescAgreementType.Default.(string)
 	// slapolicyDescResponseTimeMinutes is the schema descriptor for response_time_minutes field.
-	slapolicyDescResponseTimeMinutes := slapolicyFields[5].Descriptor()
+	slapolicyDescResponseTimeMinutes := slapolicyFields[9].Descriptor()
 	// slapolicy.ResponseTimeMinutesValidator is a validator for the "response_time_minutes" field. It is called by the builders before save.
 	slapolicy.ResponseTimeMinutesValidator = slapolicyDescResponseTimeMinutes.Validators[0].(func(int) error)
 	// slapolicyDescResolutionTimeMinutes is the schema descriptor for resolution_time_minutes field.
-	slapolicyDescResolutionTimeMinutes := slapolicyFields[6].Descriptor()
+	slapolicyDescResolutionTimeMinutes := slapolicyFields[10].Descriptor()
 	// slapolicy.ResolutionTimeMinutesValidator is a validator for the "resolution_time_minutes" field. It is called by the builders before save.
 	slapolicy.ResolutionTimeMinutesValidator = slapolicyDescResolutionTimeMinutes.Validators[0].(func(int) error)
 	// slapolicyDescExcludeWeekends is the schema descriptor for exclude_weekends field.
-	slapolicyDescExcludeWeekends := slapolicyFields[8].Descriptor()
+	slapolicyDescExcludeWeekends := slapolicyFields[13].Descriptor()
 	// slapolicy.DefaultExcludeWeekends holds the default value on creation for the exclude_weekends field.
 	slapolicy.DefaultExcludeWeekends = slapolicyDescExcludeWeekends.Default.(bool)
 	// slapolicyDescExcludeHolidays is the schema descriptor for exclude_holidays field.
-	slapolicyDescExcludeHolidays := slapolicyFields[9].Descriptor()
+	slapolicyDescExcludeHolidays := slapolicyFields[14].Descriptor()
 	// slapolicy.DefaultExcludeHolidays holds the default value on creation for the exclude_holidays field.
 	slapolicy.DefaultExcludeHolidays = slapolicyDescExcludeHolidays.Default.(bool)
 	// slapolicyDescIsActive is the schema descriptor for is_active field.
-	slapolicyDescIsActive := slapolicyFields[11].Descriptor()
+	slapolicyDescIsActive := slapolicyFields[16].Descriptor()
 	// slapolicy.DefaultIsActive holds the default value on creation for the is_active field.
 	slapolicy.DefaultIsActive = slapolicyDescIsActive.Default.(bool)
 	// slapolicyDescPriorityScore is the schema descriptor for priority_score field.
-	slapolicyDescPriorityScore := slapolicyFields[12].Descriptor()
+	slapolicyDescPriorityScore := slapolicyFields[17].Descriptor()
 	// slapolicy.DefaultPriorityScore holds the default value on creation for the priority_score field.
 	slapolicy.DefaultPriorityScore = slapolicyDescPriorityScore.Default.(int)
 	// slapolicyDescTenantID is the schema descriptor for tenant_id field.
-	slapolicyDescTenantID := slapolicyFields[13].Descriptor()
+	slapolicyDescTenantID := slapolicyFields[18].Descriptor()
 	// slapolicy.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
 	slapolicy.TenantIDValidator = slapolicyDescTenantID.Validators[0].(func(int) error)
 	// slapolicyDescCreatedAt is the schema descriptor for created_at field.
-	slapolicyDescCreatedAt := slapolicyFields[14].Descriptor()
+	slapolicyDescCreatedAt := slapolicyFields[19].Descriptor()
 	// slapolicy.DefaultCreatedAt holds the default value on creation for the created_at field.
 	slapolicy.DefaultCreatedAt = slapolicyDescCreatedAt.Default.(func() time.Time)
 	// slapolicyDescUpdatedAt is the schema descriptor for updated_at field.
-	slapolicyDescUpdatedAt := slapolicyFields[15].Descriptor()
+	slapolicyDescUpdatedAt := slapolicyFields[20].Descriptor()
 	// slapolicy.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	slapolicy.DefaultUpdatedAt = slapolicyDescUpdatedAt.Default.(func() time.Time)
 	// slapolicy.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -3147,44 +3784,96 @@ func init() {
 	slaviolationDescViolationType := slaviolationFields[5].Descriptor()
 	// slaviolation.ViolationTypeValidator is a validator for the "violation_type" field. It is called by the builders before save.
 	slaviolation.ViolationTypeValidator = slaviolationDescViolationType.Validators[0].(func(string) error)
+	// slaviolationDescAgreementType is the schema descriptor for agreement_type field.
+	slaviolationDescAgreementType := slaviolationFields[6].Descriptor()
+	// slaviolation.DefaultAgreementType holds the default value on creation for the agreement_type field.
+	slaviolation.DefaultAgreementType = slaviolationDescAgreementType.Default.(string)
 	// slaviolationDescViolationTime is the schema descriptor for violation_time field.
-	slaviolationDescViolationTime := slaviolationFields[6].Descriptor()
+	slaviolationDescViolationTime := slaviolationFields[8].Descriptor()
 	// slaviolation.DefaultViolationTime holds the default value on creation for the violation_time field.
 	slaviolation.DefaultViolationTime = slaviolationDescViolationTime.Default.(func() time.Time)
 	// slaviolationDescViolationOccurredAt is the schema descriptor for violation_occurred_at field.
-	slaviolationDescViolationOccurredAt := slaviolationFields[7].Descriptor()
+	slaviolationDescViolationOccurredAt := slaviolationFields[9].Descriptor()
 	// slaviolation.DefaultViolationOccurredAt holds the default value on creation for the violation_occurred_at field.
 	slaviolation.DefaultViolationOccurredAt = slaviolationDescViolationOccurredAt.Default.(func() time.Time)
 	// slaviolationDescOverdueMinutes is the schema descriptor for overdue_minutes field.
-	slaviolationDescOverdueMinutes := slaviolationFields[10].Descriptor()
+	slaviolationDescOverdueMinutes := slaviolationFields[12].Descriptor()
 	// slaviolation.DefaultOverdueMinutes holds the default value on creation for the overdue_minutes field.
 	slaviolation.DefaultOverdueMinutes = slaviolationDescOverdueMinutes.Default.(int)
 	// slaviolationDescStatus is the schema descriptor for status field.
-	slaviolationDescStatus := slaviolationFields[11].Descriptor()
+	slaviolationDescStatus := slaviolationFields[13].Descriptor()
 	// slaviolation.DefaultStatus holds the default value on creation for the status field.
 	slaviolation.DefaultStatus = slaviolationDescStatus.Default.(string)
 	// slaviolationDescSeverity is the schema descriptor for severity field.
-	slaviolationDescSeverity := slaviolationFields[13].Descriptor()
+	slaviolationDescSeverity := slaviolationFields[15].Descriptor()
 	// slaviolation.DefaultSeverity holds the default value on creation for the severity field.
 	slaviolation.DefaultSeverity = slaviolationDescSeverity.Default.(string)
 	// slaviolationDescIsResolved is the schema descriptor for is_resolved field.
-	slaviolationDescIsResolved := slaviolationFields[14].Descriptor()
+	slaviolationDescIsResolved := slaviolationFields[16].Descriptor()
 	// slaviolation.DefaultIsResolved holds the default value on creation for the is_resolved field.
 	slaviolation.DefaultIsResolved = slaviolationDescIsResolved.Default.(bool)
 	// slaviolationDescTenantID is the schema descriptor for tenant_id field.
-	slaviolationDescTenantID := slaviolationFields[17].Descriptor()
+	slaviolationDescTenantID := slaviolationFields[19].Descriptor()
 	// slaviolation.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
 	slaviolation.TenantIDValidator = slaviolationDescTenantID.Validators[0].(func(int) error)
 	// slaviolationDescCreatedAt is the schema descriptor for created_at field.
-	slaviolationDescCreatedAt := slaviolationFields[18].Descriptor()
+	slaviolationDescCreatedAt := slaviolationFields[20].Descriptor()
 	// slaviolation.DefaultCreatedAt holds the default value on creation for the created_at field.
 	slaviolation.DefaultCreatedAt = slaviolationDescCreatedAt.Default.(func() time.Time)
 	// slaviolationDescUpdatedAt is the schema descriptor for updated_at field.
-	slaviolationDescUpdatedAt := slaviolationFields[19].Descriptor()
+	slaviolationDescUpdatedAt := slaviolationFields[21].Descriptor()
 	// slaviolation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	slaviolation.DefaultUpdatedAt = slaviolationDescUpdatedAt.Default.(func() time.Time)
 	// slaviolation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	slaviolation.UpdateDefaultUpdatedAt = slaviolationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	searchdocumentFields := schema.SearchDocument{}.Fields()
+	_ = searchdocumentFields
+	// searchdocumentDescTenantID is the schema descriptor for tenant_id field.
+	searchdocumentDescTenantID := searchdocumentFields[0].Descriptor()
+	// searchdocument.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	searchdocument.TenantIDValidator = searchdocumentDescTenantID.Validators[0].(func(int) error)
+	// searchdocumentDescEntityType is the schema descriptor for entity_type field.
+	searchdocumentDescEntityType := searchdocumentFields[1].Descriptor()
+	// searchdocument.EntityTypeValidator is a validator for the "entity_type" field. It is called by the builders before save.
+	searchdocument.EntityTypeValidator = searchdocumentDescEntityType.Validators[0].(func(string) error)
+	// searchdocumentDescEntityID is the schema descriptor for entity_id field.
+	searchdocumentDescEntityID := searchdocumentFields[2].Descriptor()
+	// searchdocument.EntityIDValidator is a validator for the "entity_id" field. It is called by the builders before save.
+	searchdocument.EntityIDValidator = searchdocumentDescEntityID.Validators[0].(func(int) error)
+	// searchdocumentDescTitle is the schema descriptor for title field.
+	searchdocumentDescTitle := searchdocumentFields[5].Descriptor()
+	// searchdocument.DefaultTitle holds the default value on creation for the title field.
+	searchdocument.DefaultTitle = searchdocumentDescTitle.Default.(string)
+	// searchdocumentDescBody is the schema descriptor for body field.
+	searchdocumentDescBody := searchdocumentFields[6].Descriptor()
+	// searchdocument.DefaultBody holds the default value on creation for the body field.
+	searchdocument.DefaultBody = searchdocumentDescBody.Default.(string)
+	// searchdocumentDescTitleTerms is the schema descriptor for title_terms field.
+	searchdocumentDescTitleTerms := searchdocumentFields[7].Descriptor()
+	// searchdocument.DefaultTitleTerms holds the default value on creation for the title_terms field.
+	searchdocument.DefaultTitleTerms = searchdocumentDescTitleTerms.Default.(string)
+	// searchdocumentDescTerms is the schema descriptor for terms field.
+	searchdocumentDescTerms := searchdocumentFields[8].Descriptor()
+	// searchdocument.DefaultTerms holds the default value on creation for the terms field.
+	searchdocument.DefaultTerms = searchdocumentDescTerms.Default.(string)
+	// searchdocumentDescRestricted is the schema descriptor for restricted field.
+	searchdocumentDescRestricted := searchdocumentFields[11].Descriptor()
+	// searchdocument.DefaultRestricted holds the default value on creation for the restricted field.
+	searchdocument.DefaultRestricted = searchdocumentDescRestricted.Default.(bool)
+	// searchdocumentDescSourceUpdatedAt is the schema descriptor for source_updated_at field.
+	searchdocumentDescSourceUpdatedAt := searchdocumentFields[12].Descriptor()
+	// searchdocument.DefaultSourceUpdatedAt holds the default value on creation for the source_updated_at field.
+	searchdocument.DefaultSourceUpdatedAt = searchdocumentDescSourceUpdatedAt.Default.(func() time.Time)
+	// searchdocumentDescCreatedAt is the schema descriptor for created_at field.
+	searchdocumentDescCreatedAt := searchdocumentFields[13].Descriptor()
+	// searchdocument.DefaultCreatedAt holds the default value on creation for the created_at field.
+	searchdocument.DefaultCreatedAt = searchdocumentDescCreatedAt.Default.(func() time.Time)
+	// searchdocumentDescUpdatedAt is the schema descriptor for updated_at field.
+	searchdocumentDescUpdatedAt := searchdocumentFields[14].Descriptor()
+	// searchdocument.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	searchdocument.DefaultUpdatedAt = searchdocumentDescUpdatedAt.Default.(func() time.Time)
+	// searchdocument.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	searchdocument.UpdateDefaultUpdatedAt = searchdocumentDescUpdatedAt.UpdateDefault.(func() time.Time)
 	servicecatalogFields := schema.ServiceCatalog{}.Fields()
 	_ = servicecatalogFields
 	// servicecatalogDescName is the schema descriptor for name field.
@@ -3514,15 +4203,15 @@ func init() {
 	// team.DefaultStatus holds the default value on creation for the status field.
 	team.DefaultStatus = teamDescStatus.Default.(string)
 	// teamDescTenantID is the schema descriptor for tenant_id field.
-	teamDescTenantID := teamFields[5].Descriptor()
+	teamDescTenantID := teamFields[6].Descriptor()
 	// team.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
 	team.TenantIDValidator = teamDescTenantID.Validators[0].(func(int) error)
 	// teamDescCreatedAt is the schema descriptor for created_at field.
-	teamDescCreatedAt := teamFields[6].Descriptor()
+	teamDescCreatedAt := teamFields[7].Descriptor()
 	// team.DefaultCreatedAt holds the default value on creation for the created_at field.
 	team.DefaultCreatedAt = teamDescCreatedAt.Default.(func() time.Time)
 	// teamDescUpdatedAt is the schema descriptor for updated_at field.
-	teamDescUpdatedAt := teamFields[7].Descriptor()
+	teamDescUpdatedAt := teamFields[8].Descriptor()
 	// team.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	team.DefaultUpdatedAt = teamDescUpdatedAt.Default.(func() time.Time)
 	// team.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -3621,28 +4310,32 @@ func init() {
 	ticketDescTenantID := ticketFields[12].Descriptor()
 	// ticket.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
 	ticket.TenantIDValidator = ticketDescTenantID.Validators[0].(func(int) error)
+	// ticketDescSLAOnHold is the schema descriptor for sla_on_hold field.
+	ticketDescSLAOnHold := ticketFields[20].Descriptor()
+	// ticket.DefaultSLAOnHold holds the default value on creation for the sla_on_hold field.
+	ticket.DefaultSLAOnHold = ticketDescSLAOnHold.Default.(bool)
 	// ticketDescRating is the schema descriptor for rating field.
-	ticketDescRating := ticketFields[25].Descriptor()
+	ticketDescRating := ticketFields[28].Descriptor()
 	// ticket.RatingValidator is a validator for the "rating" field. It is called by the builders before save.
 	ticket.RatingValidator = ticketDescRating.Validators[0].(func(int) error)
 	// ticketDescVersion is the schema descriptor for version field.
-	ticketDescVersion := ticketFields[29].Descriptor()
+	ticketDescVersion := ticketFields[32].Descriptor()
 	// ticket.DefaultVersion holds the default value on creation for the version field.
 	ticket.DefaultVersion = ticketDescVersion.Default.(int)
 	// ticket.VersionValidator is a validator for the "version" field. It is called by the builders before save.
 	ticket.VersionValidator = ticketDescVersion.Validators[0].(func(int) error)
 	// ticketDescCreatedAt is the schema descriptor for created_at field.
-	ticketDescCreatedAt := ticketFields[30].Descriptor()
+	ticketDescCreatedAt := ticketFields[33].Descriptor()
 	// ticket.DefaultCreatedAt holds the default value on creation for the created_at field.
 	ticket.DefaultCreatedAt = ticketDescCreatedAt.Default.(func() time.Time)
 	// ticketDescUpdatedAt is the schema descriptor for updated_at field.
-	ticketDescUpdatedAt := ticketFields[31].Descriptor()
+	ticketDescUpdatedAt := ticketFields[34].Descriptor()
 	// ticket.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	ticket.DefaultUpdatedAt = ticketDescUpdatedAt.Default.(func() time.Time)
 	// ticket.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	ticket.UpdateDefaultUpdatedAt = ticketDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// ticketDescIsManagedByMsp is the schema descriptor for is_managed_by_msp field.
-	ticketDescIsManagedByMsp := ticketFields[32].Descriptor()
+	ticketDescIsManagedByMsp := ticketFields[35].Descriptor()
 	// ticket.DefaultIsManagedByMsp holds the default value on creation for the is_managed_by_msp field.
 	ticket.DefaultIsManagedByMsp = ticketDescIsManagedByMsp.Default.(bool)
 	ticketapprovalFields := schema.TicketApproval{}.Fields()
@@ -3875,6 +4568,146 @@ func init() {
 	ticketnotificationDescCreatedAt := ticketnotificationFields[9].Descriptor()
 	// ticketnotification.DefaultCreatedAt holds the default value on creation for the created_at field.
 	ticketnotification.DefaultCreatedAt = ticketnotificationDescCreatedAt.Default.(func() time.Time)
+	ticketslametricFields := schema.TicketSLAMetric{}.Fields()
+	_ = ticketslametricFields
+	// ticketslametricDescTicketID is the schema descriptor for ticket_id field.
+	ticketslametricDescTicketID := ticketslametricFields[0].Descriptor()
+	// ticketslametric.TicketIDValidator is a validator for the "ticket_id" field. It is called by the builders before save.
+	ticketslametric.TicketIDValidator = ticketslametricDescTicketID.Validators[0].(func(int) error)
+	// ticketslametricDescPolicyID is the schema descriptor for policy_id field.
+	ticketslametricDescPolicyID := ticketslametricFields[1].Descriptor()
+	// ticketslametric.PolicyIDValidator is a validator for the "policy_id" field. It is called by the builders before save.
+	ticketslametric.PolicyIDValidator = ticketslametricDescPolicyID.Validators[0].(func(int) error)
+	// ticketslametricDescAgreementType is the schema descriptor for agreement_type field.
+	ticketslametricDescAgreementType := ticketslametricFields[3].Descriptor()
+	// ticketslametric.DefaultAgreementType holds the default value on creation for the agreement_type field.
+	ticketslametric.DefaultAgreementType = ticketslametricDescAgreementType.Default.(string)
+	// ticketslametricDescMetricKey is the schema descriptor for metric_key field.
+	ticketslametricDescMetricKey := ticketslametricFields[4].Descriptor()
+	// ticketslametric.MetricKeyValidator is a validator for the "metric_key" field. It is called by the builders before save.
+	ticketslametric.MetricKeyValidator = ticketslametricDescMetricKey.Validators[0].(func(string) error)
+	// ticketslametricDescCycle is the schema descriptor for cycle field.
+	ticketslametricDescCycle := ticketslametricFields[6].Descriptor()
+	// ticketslametric.DefaultCycle holds the default value on creation for the cycle field.
+	ticketslametric.DefaultCycle = ticketslametricDescCycle.Default.(int)
+	// ticketslametricDescStatus is the schema descriptor for status field.
+	ticketslametricDescStatus := ticketslametricFields[7].Descriptor()
+	// ticketslametric.DefaultStatus holds the default value on creation for the status field.
+	ticketslametric.DefaultStatus = ticketslametricDescStatus.Default.(string)
+	// ticketslametricDescTargetMinutes is the schema descriptor for target_minutes field.
+	ticketslametricDescTargetMinutes := ticketslametricFields[8].Descriptor()
+	// ticketslametric.TargetMinutesValidator is a validator for the "target_minutes" field. It is called by the builders before save.
+	ticketslametric.TargetMinutesValidator = ticketslametricDescTargetMinutes.Validators[0].(func(int) error)
+	// ticketslametricDescPausedMinutes is the schema descriptor for paused_minutes field.
+	ticketslametricDescPausedMinutes := ticketslametricFields[12].Descriptor()
+	// ticketslametric.DefaultPausedMinutes holds the default value on creation for the paused_minutes field.
+	ticketslametric.DefaultPausedMinutes = ticketslametricDescPausedMinutes.Default.(int)
+	// ticketslametricDescBreached is the schema descriptor for breached field.
+	ticketslametricDescBreached := ticketslametricFields[14].Descriptor()
+	// ticketslametric.DefaultBreached holds the default value on creation for the breached field.
+	ticketslametric.DefaultBreached = ticketslametricDescBreached.Default.(bool)
+	// ticketslametricDescTenantID is the schema descriptor for tenant_id field.
+	ticketslametricDescTenantID := ticketslametricFields[16].Descriptor()
+	// ticketslametric.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	ticketslametric.TenantIDValidator = ticketslametricDescTenantID.Validators[0].(func(int) error)
+	// ticketslametricDescCreatedAt is the schema descriptor for created_at field.
+	ticketslametricDescCreatedAt := ticketslametricFields[17].Descriptor()
+	// ticketslametric.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ticketslametric.DefaultCreatedAt = ticketslametricDescCreatedAt.Default.(func() time.Time)
+	// ticketslametricDescUpdatedAt is the schema descriptor for updated_at field.
+	ticketslametricDescUpdatedAt := ticketslametricFields[18].Descriptor()
+	// ticketslametric.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	ticketslametric.DefaultUpdatedAt = ticketslametricDescUpdatedAt.Default.(func() time.Time)
+	// ticketslametric.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	ticketslametric.UpdateDefaultUpdatedAt = ticketslametricDescUpdatedAt.UpdateDefault.(func() time.Time)
+	ticketslapauseFields := schema.TicketSLAPause{}.Fields()
+	_ = ticketslapauseFields
+	// ticketslapauseDescTicketID is the schema descriptor for ticket_id field.
+	ticketslapauseDescTicketID := ticketslapauseFields[0].Descriptor()
+	// ticketslapause.TicketIDValidator is a validator for the "ticket_id" field. It is called by the builders before save.
+	ticketslapause.TicketIDValidator = ticketslapauseDescTicketID.Validators[0].(func(int) error)
+	// ticketslapauseDescReason is the schema descriptor for reason field.
+	ticketslapauseDescReason := ticketslapauseFields[2].Descriptor()
+	// ticketslapause.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	ticketslapause.ReasonValidator = ticketslapauseDescReason.Validators[0].(func(string) error)
+	// ticketslapauseDescPausedMinutes is the schema descriptor for paused_minutes field.
+	ticketslapauseDescPausedMinutes := ticketslapauseFields[6].Descriptor()
+	// ticketslapause.DefaultPausedMinutes holds the default value on creation for the paused_minutes field.
+	ticketslapause.DefaultPausedMinutes = ticketslapauseDescPausedMinutes.Default.(int)
+	// ticketslapauseDescTenantID is the schema descriptor for tenant_id field.
+	ticketslapauseDescTenantID := ticketslapauseFields[11].Descriptor()
+	// ticketslapause.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	ticketslapause.TenantIDValidator = ticketslapauseDescTenantID.Validators[0].(func(int) error)
+	// ticketslapauseDescCreatedAt is the schema descriptor for created_at field.
+	ticketslapauseDescCreatedAt := ticketslapauseFields[12].Descriptor()
+	// ticketslapause.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ticketslapause.DefaultCreatedAt = ticketslapauseDescCreatedAt.Default.(func() time.Time)
+	// ticketslapauseDescUpdatedAt is the schema descriptor for updated_at field.
+	ticketslapauseDescUpdatedAt := ticketslapauseFields[13].Descriptor()
+	// ticketslapause.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	ticketslapause.DefaultUpdatedAt = ticketslapauseDescUpdatedAt.Default.(func() time.Time)
+	// ticketslapause.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	ticketslapause.UpdateDefaultUpdatedAt = ticketslapauseDescUpdatedAt.UpdateDefault.(func() time.Time)
+	ticketscheduleFields := schema.TicketSchedule{}.Fields()
+	_ = ticketscheduleFields
+	// ticketscheduleDescTenantID is the schema descriptor for tenant_id field.
+	ticketscheduleDescTenantID := ticketscheduleFields[0].Descriptor()
+	// ticketschedule.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	ticketschedule.TenantIDValidator = ticketscheduleDescTenantID.Validators[0].(func(int) error)
+	// ticketscheduleDescName is the schema descriptor for name field.
+	ticketscheduleDescName := ticketscheduleFields[1].Descriptor()
+	// ticketschedule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	ticketschedule.NameValidator = ticketscheduleDescName.Validators[0].(func(string) error)
+	// ticketscheduleDescRequesterID is the schema descriptor for requester_id field.
+	ticketscheduleDescRequesterID := ticketscheduleFields[8].Descriptor()
+	// ticketschedule.RequesterIDValidator is a validator for the "requester_id" field. It is called by the builders before save.
+	ticketschedule.RequesterIDValidator = ticketscheduleDescRequesterID.Validators[0].(func(int) error)
+	// ticketscheduleDescExpression is the schema descriptor for expression field.
+	ticketscheduleDescExpression := ticketscheduleFields[12].Descriptor()
+	// ticketschedule.ExpressionValidator is a validator for the "expression" field. It is called by the builders before save.
+	ticketschedule.ExpressionValidator = ticketscheduleDescExpression.Validators[0].(func(string) error)
+	// ticketscheduleDescTimeZone is the schema descriptor for time_zone field.
+	ticketscheduleDescTimeZone := ticketscheduleFields[13].Descriptor()
+	// ticketschedule.DefaultTimeZone holds the default value on creation for the time_zone field.
+	ticketschedule.DefaultTimeZone = ticketscheduleDescTimeZone.Default.(string)
+	// ticketscheduleDescEnabled is the schema descriptor for enabled field.
+	ticketscheduleDescEnabled := ticketscheduleFields[19].Descriptor()
+	// ticketschedule.DefaultEnabled holds the default value on creation for the enabled field.
+	ticketschedule.DefaultEnabled = ticketscheduleDescEnabled.Default.(bool)
+	// ticketscheduleDescRevision is the schema descriptor for revision field.
+	ticketscheduleDescRevision := ticketscheduleFields[20].Descriptor()
+	// ticketschedule.DefaultRevision holds the default value on creation for the revision field.
+	ticketschedule.DefaultRevision = ticketscheduleDescRevision.Default.(int)
+	// ticketscheduleDescCreatedAt is the schema descriptor for created_at field.
+	ticketscheduleDescCreatedAt := ticketscheduleFields[26].Descriptor()
+	// ticketschedule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ticketschedule.DefaultCreatedAt = ticketscheduleDescCreatedAt.Default.(func() time.Time)
+	// ticketscheduleDescUpdatedAt is the schema descriptor for updated_at field.
+	ticketscheduleDescUpdatedAt := ticketscheduleFields[27].Descriptor()
+	// ticketschedule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	ticketschedule.DefaultUpdatedAt = ticketscheduleDescUpdatedAt.Default.(func() time.Time)
+	// ticketschedule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	ticketschedule.UpdateDefaultUpdatedAt = ticketscheduleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	ticketschedulerunFields := schema.TicketScheduleRun{}.Fields()
+	_ = ticketschedulerunFields
+	// ticketschedulerunDescTenantID is the schema descriptor for tenant_id field.
+	ticketschedulerunDescTenantID := ticketschedulerunFields[0].Descriptor()
+	// ticketschedulerun.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	ticketschedulerun.TenantIDValidator = ticketschedulerunDescTenantID.Validators[0].(func(int) error)
+	// ticketschedulerunDescScheduleID is the schema descriptor for schedule_id field.
+	ticketschedulerunDescScheduleID := ticketschedulerunFields[1].Descriptor()
+	// ticketschedulerun.ScheduleIDValidator is a validator for the "schedule_id" field. It is called by the builders before save.
+	ticketschedulerun.ScheduleIDValidator = ticketschedulerunDescScheduleID.Validators[0].(func(int) error)
+	// ticketschedulerunDescCreatedAt is the schema descriptor for created_at field.
+	ticketschedulerunDescCreatedAt := ticketschedulerunFields[7].Descriptor()
+	// ticketschedulerun.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ticketschedulerun.DefaultCreatedAt = ticketschedulerunDescCreatedAt.Default.(func() time.Time)
+	// ticketschedulerunDescUpdatedAt is the schema descriptor for updated_at field.
+	ticketschedulerunDescUpdatedAt := ticketschedulerunFields[8].Descriptor()
+	// ticketschedulerun.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	ticketschedulerun.DefaultUpdatedAt = ticketschedulerunDescUpdatedAt.Default.(func() time.Time)
+	// ticketschedulerun.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	ticketschedulerun.UpdateDefaultUpdatedAt = ticketschedulerunDescUpdatedAt.UpdateDefault.(func() time.Time)
 	tickettagFields := schema.TicketTag{}.Fields()
 	_ = tickettagFields
 	// tickettagDescName is the schema descriptor for name field.
@@ -4022,23 +4855,23 @@ func init() {
 	// ticketview.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	ticketview.NameValidator = ticketviewDescName.Validators[0].(func(string) error)
 	// ticketviewDescIsShared is the schema descriptor for is_shared field.
-	ticketviewDescIsShared := ticketviewFields[6].Descriptor()
+	ticketviewDescIsShared := ticketviewFields[7].Descriptor()
 	// ticketview.DefaultIsShared holds the default value on creation for the is_shared field.
 	ticketview.DefaultIsShared = ticketviewDescIsShared.Default.(bool)
 	// ticketviewDescCreatedBy is the schema descriptor for created_by field.
-	ticketviewDescCreatedBy := ticketviewFields[7].Descriptor()
+	ticketviewDescCreatedBy := ticketviewFields[8].Descriptor()
 	// ticketview.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
 	ticketview.CreatedByValidator = ticketviewDescCreatedBy.Validators[0].(func(int) error)
 	// ticketviewDescTenantID is the schema descriptor for tenant_id field.
-	ticketviewDescTenantID := ticketviewFields[8].Descriptor()
+	ticketviewDescTenantID := ticketviewFields[9].Descriptor()
 	// ticketview.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
 	ticketview.TenantIDValidator = ticketviewDescTenantID.Validators[0].(func(int) error)
 	// ticketviewDescCreatedAt is the schema descriptor for created_at field.
-	ticketviewDescCreatedAt := ticketviewFields[9].Descriptor()
+	ticketviewDescCreatedAt := ticketviewFields[10].Descriptor()
 	// ticketview.DefaultCreatedAt holds the default value on creation for the created_at field.
 	ticketview.DefaultCreatedAt = ticketviewDescCreatedAt.Default.(func() time.Time)
 	// ticketviewDescUpdatedAt is the schema descriptor for updated_at field.
-	ticketviewDescUpdatedAt := ticketviewFields[10].Descriptor()
+	ticketviewDescUpdatedAt := ticketviewFields[11].Descriptor()
 	// ticketview.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	ticketview.DefaultUpdatedAt = ticketviewDescUpdatedAt.Default.(func() time.Time)
 	// ticketview.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -4106,29 +4939,29 @@ func init() {
 	// user.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	user.NameValidator = userDescName.Validators[0].(func(string) error)
 	// userDescPasswordHash is the schema descriptor for password_hash field.
-	userDescPasswordHash := userFields[8].Descriptor()
+	userDescPasswordHash := userFields[10].Descriptor()
 	// user.PasswordHashValidator is a validator for the "password_hash" field. It is called by the builders before save.
 	user.PasswordHashValidator = userDescPasswordHash.Validators[0].(func(string) error)
 	// userDescActive is the schema descriptor for active field.
-	userDescActive := userFields[9].Descriptor()
+	userDescActive := userFields[11].Descriptor()
 	// user.DefaultActive holds the default value on creation for the active field.
 	user.DefaultActive = userDescActive.Default.(bool)
 	// userDescTenantID is the schema descriptor for tenant_id field.
-	userDescTenantID := userFields[10].Descriptor()
+	userDescTenantID := userFields[12].Descriptor()
 	// user.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
 	user.TenantIDValidator = userDescTenantID.Validators[0].(func(int) error)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[11].Descriptor()
+	userDescCreatedAt := userFields[13].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[12].Descriptor()
+	userDescUpdatedAt := userFields[14].Descriptor()
 	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// userDescIsBootstrapAdmin is the schema descriptor for is_bootstrap_admin field.
-	userDescIsBootstrapAdmin := userFields[15].Descriptor()
+	userDescIsBootstrapAdmin := userFields[17].Descriptor()
 	// user.DefaultIsBootstrapAdmin holds the default value on creation for the is_bootstrap_admin field.
 	user.DefaultIsBootstrapAdmin = userDescIsBootstrapAdmin.Default.(bool)
 	vendorFields := schema.Vendor{}.Fields()
@@ -4157,6 +4990,36 @@ func init() {
 	vendorDescUpdatedAt := vendorFields[12].Descriptor()
 	// vendor.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	vendor.DefaultUpdatedAt = vendorDescUpdatedAt.Default.(func() time.Time)
+	worklogFields := schema.WorkLog{}.Fields()
+	_ = worklogFields
+	// worklogDescTenantID is the schema descriptor for tenant_id field.
+	worklogDescTenantID := worklogFields[0].Descriptor()
+	// worklog.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	worklog.TenantIDValidator = worklogDescTenantID.Validators[0].(func(int) error)
+	// worklogDescUserID is the schema descriptor for user_id field.
+	worklogDescUserID := worklogFields[1].Descriptor()
+	// worklog.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	worklog.UserIDValidator = worklogDescUserID.Validators[0].(func(int) error)
+	// worklogDescDurationMinutes is the schema descriptor for duration_minutes field.
+	worklogDescDurationMinutes := worklogFields[9].Descriptor()
+	// worklog.DefaultDurationMinutes holds the default value on creation for the duration_minutes field.
+	worklog.DefaultDurationMinutes = worklogDescDurationMinutes.Default.(int)
+	// worklog.DurationMinutesValidator is a validator for the "duration_minutes" field. It is called by the builders before save.
+	worklog.DurationMinutesValidator = worklogDescDurationMinutes.Validators[0].(func(int) error)
+	// worklogDescBillable is the schema descriptor for billable field.
+	worklogDescBillable := worklogFields[10].Descriptor()
+	// worklog.DefaultBillable holds the default value on creation for the billable field.
+	worklog.DefaultBillable = worklogDescBillable.Default.(bool)
+	// worklogDescCreatedAt is the schema descriptor for created_at field.
+	worklogDescCreatedAt := worklogFields[14].Descriptor()
+	// worklog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	worklog.DefaultCreatedAt = worklogDescCreatedAt.Default.(func() time.Time)
+	// worklogDescUpdatedAt is the schema descriptor for updated_at field.
+	worklogDescUpdatedAt := worklogFields[15].Descriptor()
+	// worklog.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	worklog.DefaultUpdatedAt = worklogDescUpdatedAt.Default.(func() time.Time)
+	// worklog.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	worklog.UpdateDefaultUpdatedAt = worklogDescUpdatedAt.UpdateDefault.(func() time.Time)
 	workflowFields := schema.Workflow{}.Fields()
 	_ = workflowFields
 	// workflowDescName is the schema descriptor for name field.
@@ -0,0 +1,80 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AlertSuppressionRule 告警屏蔽规则：生效窗口内标签全部匹配的告警只记台账，不开启或归并事件
+// （用于计划维护、已知噪声等场景）。
+type AlertSuppressionRule struct {
+	ent.Schema
+}
+
+// Fields of the AlertSuppressionRule.
+func (AlertSuppressionRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant_id").
+			Comment("租户ID").
+			Positive(),
+		field.String("name").
+			Comment("规则名称").
+			NotEmpty().
+			MaxLen(100),
+		field.Text("description").
+			Comment("规则说明").
+			Optional(),
+		field.String("source").
+			Comment("限定的告警源，为空表示所有告警源").
+			Optional(),
+		field.JSON("matchers", []AlertMatcher{}).
+			Comment("标签匹配条件，全部满足才屏蔽"),
+		field.Time("starts_at").
+			Comment("生效开始时间，为空表示立即生效").
+			Optional().
+			Nillable(),
+		field.Time("ends_at").
+			Comment("生效结束时间，为空表示长期有效").
+			Optional().
+			Nillable(),
+		field.Bool("enabled").
+			Comment("是否启用").
+			Default(true),
+		field.Int("hit_count").
+			Comment("累计命中次数").
+			Default(0).
+			NonNegative(),
+		field.Time("last_hit_at").
+			Comment("最近命中时间").
+			Optional().
+			Nillable(),
+		field.Int("created_by").
+			Comment("创建人ID").
+			Optional(),
+		field.Time("created_at").
+			Comment("创建时间").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Comment("更新时间").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the AlertSuppressionRule.
+func (AlertSuppressionRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "enabled"),
+	}
+}
+
+// AlertMatcher 标签匹配条件，语义与 Alertmanager 静默一致：= 相等，!= 不等，=~ 正则全匹配，!~ 正则不匹配
+type AlertMatcher struct {
+	Label string `json:"label"`
+	Op    string `json:"op"`
+	Value string `json:"value"`
+}
@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// BusinessCalendar 工作日历。
+// SLA 策略、SLA 定义、团队和 BPMN 流程 SLA 通过 calendar_id 引用日历，
+// 截止时间只在日历的工作时段内计时；节假日整天不计时，调休上班日按周一的时段计时。
+type BusinessCalendar struct {
+	ent.Schema
+}
+
+// Fields of the BusinessCalendar.
+func (BusinessCalendar) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant_id").
+			Comment("租户ID").
+			Positive(),
+		field.String("name").
+			Comment("日历名称").
+			NotEmpty(),
+		field.Text("description").
+			Comment("日历描述").
+			Optional(),
+		field.String("time_zone").
+			Comment("时区，工作时段按此时区计算").
+			Default("Asia/Shanghai"),
+		field.JSON("work_windows", []CalendarWorkWindow{}).
+			Comment("每周工作时段，同一天可配置多个时段（分段班次）"),
+		field.JSON("holidays", []CalendarDay{}).
+			Comment("节假日").
+			Optional(),
+		field.JSON("extra_workdays", []CalendarDay{}).
+			Comment("调休上班日").
+			Optional(),
+		field.Bool("is_default").
+			Comment("是否租户默认日历").
+			Default(false),
+		field.Time("created_at").
+			Comment("创建时间").
+			Default(time.Now),
+		field.Time("updated_at").
+			Comment("更新时间").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the BusinessCalendar.
+func (BusinessCalendar) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "name").
+			Unique(),
+		index.Fields("tenant_id", "is_default"),
+	}
+}
+
+// CalendarWorkWindow 工作时段
+type CalendarWorkWindow struct {
+	Weekday int    `json:"weekday"` // 1-7 (周一到周日)
+	Start   string `json:"start"`   // 开始时间: "09:00"
+	End     string `json:"end"`     // 结束时间: "18:00"，不晚于开始时间表示跨零点的夜班
+}
+
+// CalendarDay 日历中的特殊日期
+type CalendarDay struct {
+	Date string `json:"date"` // 日期: "2026-10-01"
+	Name string `json:"name,omitempty"`
+}
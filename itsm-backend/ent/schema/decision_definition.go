@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DecisionDefinition DMN 决策定义。
+// 每次部署同一 key 的决策生成新的整数版本，业务规则任务默认引用最新版本；
+// 一个 DMN 文件包含多个决策时每个决策各占一行，dmn_xml 保存完整文件。
+type DecisionDefinition struct {
+	ent.Schema
+}
+
+// Fields of the DecisionDefinition.
+func (DecisionDefinition) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant_id").
+			Comment("租户ID").
+			Positive(),
+		field.String("key").
+			Comment("决策Key，对应DMN decision的id").
+			NotEmpty(),
+		field.String("name").
+			Comment("决策名称").
+			Optional(),
+		field.Int("version").
+			Comment("版本号，按key递增").
+			Positive(),
+		field.String("hit_policy").
+			Comment("决策表命中策略").
+			Default("UNIQUE").
+			MaxLen(32),
+		field.Text("dmn_xml").
+			Comment("DMN XML定义内容").
+			NotEmpty(),
+		field.Int("deployment_id").
+			Comment("随BPMN一起部署时的部署记录ID").
+			Optional().
+			Nillable(),
+		field.Bool("is_latest").
+			Comment("是否最新版本").
+			Default(true),
+		field.Time("created_at").
+			Comment("创建时间").
+			Default(time.Now),
+	}
+}
+
+// Indexes of the DecisionDefinition.
+func (DecisionDefinition) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "key", "version").
+			Unique(),
+		index.Fields("tenant_id", "key", "is_latest"),
+	}
+}
@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// EscalationPolicy 值班升级策略：按步骤依次呼叫值班表的当前值班人或指定用户，
+// 每一步在确认超时内无人确认时升级到下一步，全部步骤走完后按 repeat_count 从头重复。
+type EscalationPolicy struct {
+	ent.Schema
+}
+
+// Fields of the EscalationPolicy.
+func (EscalationPolicy) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant_id").
+			Comment("租户ID").
+			Positive(),
+		field.String("name").
+			Comment("策略名称").
+			NotEmpty().
+			MaxLen(100),
+		field.Text("description").
+			Comment("说明").
+			Optional(),
+		field.Int("team_id").
+			Comment("所属团队ID").
+			Optional().
+			Nillable(),
+		field.JSON("steps", []EscalationPolicyStep{}).
+			Comment("升级步骤"),
+		field.Int("repeat_count").
+			Comment("全部步骤无人确认后从第一步重复的次数").
+			Default(0).
+			NonNegative(),
+		field.Bool("enabled").
+			Comment("是否启用").
+			Default(true),
+		field.Int("created_by").
+			Comment("创建人ID").
+			Optional(),
+		field.Time("created_at").
+			Comment("创建时间").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Comment("更新时间").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the EscalationPolicy.
+func (EscalationPolicy) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "name").
+			Unique(),
+	}
+}
+
+// EscalationPolicyStep 升级步骤：同时呼叫全部目标，ack_timeout_minutes 内无人确认则升级
+type EscalationPolicyStep struct {
+	AckTimeoutMinutes int                `json:"ackTimeoutMinutes"`
+	Targets           []EscalationTarget `json:"targets"`
+}
+
+// EscalationTarget 呼叫目标：schedule 为值班表的当前值班人，user 为指定用户
+type EscalationTarget struct {
+	Type string `json:"type"`
+	ID   int    `json:"id"`
+}
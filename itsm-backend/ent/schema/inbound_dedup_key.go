@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// InboundDedupKey 入站消息去重 key（未配置 Redis 时 connector.Router 的集群级去重存储）。
+// (tenant_id, dedup_key) 唯一：插入成功即首次占用；过期行在再次占用前删除，并由后台定期清理。
+type InboundDedupKey struct {
+	ent.Schema
+}
+
+// Fields of the InboundDedupKey.
+func (InboundDedupKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant_id").
+			Comment("租户ID，无租户上下文的消息为 0").
+			Default(0).
+			NonNegative(),
+		field.String("dedup_key").
+			Comment("去重 key").
+			NotEmpty(),
+		field.Time("expires_at").
+			Comment("过期时间"),
+		field.Time("created_at").
+			Comment("创建时间").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the InboundDedupKey.
+func (InboundDedupKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "dedup_key").
+			Unique(),
+		index.Fields("expires_at"),
+	}
+}
@@ -0,0 +1,84 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// InboundEmail 入站邮件台账。
+// (tenant_id, message_id) 唯一：IMAP 重复拉取或 Webhook 重试时据此去重；
+// 回复线程通过 In-Reply-To/References 命中本表中已关联工单的邮件完成归并。
+type InboundEmail struct {
+	ent.Schema
+}
+
+// Fields of the InboundEmail.
+func (InboundEmail) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant_id").
+			Comment("租户ID").
+			Positive(),
+		field.String("mailbox").
+			Comment("接收邮箱地址").
+			Optional(),
+		field.String("message_id").
+			Comment("邮件 Message-ID（不含尖括号）").
+			NotEmpty(),
+		field.String("in_reply_to").
+			Comment("In-Reply-To").
+			Optional(),
+		field.Strings("references").
+			Comment("References 链").
+			Optional(),
+		field.String("from_address").
+			Comment("发件人地址").
+			Optional(),
+		field.String("from_name").
+			Comment("发件人名称").
+			Optional(),
+		field.String("subject").
+			Comment("邮件主题").
+			Optional(),
+		field.Enum("status").
+			Comment("处理结果: pending 处理中/created 新建工单/threaded 追加到已有工单/ignored 已忽略/failed 处理失败").
+			Values("pending", "created", "threaded", "ignored", "failed").
+			Default("pending"),
+		field.String("reason").
+			Comment("忽略或失败原因").
+			Optional(),
+		field.Int("ticket_id").
+			Comment("关联工单ID").
+			Optional().
+			Nillable(),
+		field.Int("comment_id").
+			Comment("追加的评论ID").
+			Optional().
+			Nillable(),
+		field.Int("user_id").
+			Comment("映射到的发件用户ID").
+			Optional().
+			Nillable(),
+		field.Int("attachment_count").
+			Comment("已保存附件数").
+			Default(0),
+		field.Time("received_at").
+			Comment("接收时间").
+			Default(time.Now),
+		field.Time("created_at").
+			Comment("创建时间").
+			Default(time.Now),
+	}
+}
+
+// Indexes of the InboundEmail.
+func (InboundEmail) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "message_id").
+			Unique(),
+		index.Fields("tenant_id", "ticket_id"),
+		index.Fields("tenant_id", "received_at"),
+	}
+}
@@ -0,0 +1,92 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// InboundMessageLog IM 入站消息日志（connector.Router 派发的每条消息）。
+// payload 保存完整的 connector.InboundMessage 以便重放；handler_results 按处理器名记录执行状态，
+// 失败的处理器经 commandbus 单独重试，管理端可查看并手动重放。
+type InboundMessageLog struct {
+	ent.Schema
+}
+
+// InboundHandlerResult 单个处理器的执行结果
+type InboundHandlerResult struct {
+	// Status pending 待执行，succeeded 成功，failed 失败（重试中或已放弃）
+	Status    string    `json:"status"`
+	Error     string    `json:"error,omitempty"`
+	Attempts  int       `json:"attempts"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// Fields of the InboundMessageLog.
+func (InboundMessageLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant_id").
+			Comment("租户ID").
+			Positive(),
+		field.String("connector_name").
+			Comment("连接器：feishu / slack / teams 等").
+			NotEmpty(),
+		field.String("message_id").
+			Comment("IM 平台消息/事件ID").
+			Optional(),
+		field.String("dedup_key").
+			Comment("去重 key，为空表示该消息未参与去重").
+			Optional(),
+		field.String("message_type").
+			Comment("入站消息类型，如 text / card_action").
+			Optional(),
+		field.String("channel").
+			Comment("渠道").
+			Optional(),
+		field.String("user_id").
+			Comment("IM 平台发送者ID").
+			Optional(),
+		field.String("chat_id").
+			Comment("IM 会话ID").
+			Optional(),
+		field.Text("payload").
+			Comment("connector.InboundMessage 的 JSON，用于重放"),
+		field.Enum("status").
+			Comment("状态：received 处理中，succeeded 全部成功，failed 存在失败的处理器").
+			Values("received", "succeeded", "failed").
+			Default("received"),
+		field.JSON("handler_results", map[string]InboundHandlerResult{}).
+			Comment("按处理器名记录的执行结果").
+			Optional(),
+		field.Int("replay_count").
+			Comment("管理端重放次数").
+			Default(0).
+			NonNegative(),
+		field.Time("last_replayed_at").
+			Comment("最近一次重放时间").
+			Optional().
+			Nillable(),
+		field.Time("received_at").
+			Comment("接收时间").
+			Default(time.Now),
+		field.Time("created_at").
+			Comment("创建时间").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Comment("更新时间").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the InboundMessageLog.
+func (InboundMessageLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "connector_name", "received_at"),
+		index.Fields("tenant_id", "status"),
+		index.Fields("tenant_id", "dedup_key"),
+	}
+}
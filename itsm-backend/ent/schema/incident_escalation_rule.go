@@ -38,10 +38,10 @@ func (IncidentEscalationRule) Fields() []ent.Field {
 			Comment("目标状态").
 			Optional(),
 		field.String("target_assignee_type").
-			Comment("目标分配类型: group/role/user").
+			Comment("目标分配类型: group/role/user/oncall（oncall 时 target_assignee_id 为值班表ID）").
 			NotEmpty(),
 		field.Int("target_assignee_id").
-			Comment("目标分配人ID").
+			Comment("目标分配人ID或值班表ID").
 			Optional(),
 		field.String("target_group").
 			Comment("目标组").
@@ -0,0 +1,109 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MajorIncident 重大事件宣告记录。每个事件至多宣告一次，记录事件指挥官、作战室群聊、
+// 干系人及通报节奏；事件处于 active 时后台按 update_cadence_minutes 向干系人发送进展通报，
+// 事件解决后停止通报并生成事后复盘草稿。
+type MajorIncident struct {
+	ent.Schema
+}
+
+// Fields of the MajorIncident.
+func (MajorIncident) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant_id").
+			Comment("租户ID").
+			Positive(),
+		field.Int("incident_id").
+			Comment("事件ID").
+			Positive(),
+		field.Int("commander_id").
+			Comment("事件指挥官用户ID").
+			Positive(),
+		field.Int("declared_by").
+			Comment("宣告人用户ID").
+			Positive(),
+		field.Time("declared_at").
+			Comment("宣告时间").
+			Default(time.Now),
+		field.Enum("status").
+			Comment("状态：active 处置中（按节奏通报），resolved 已解决").
+			Values("active", "resolved").
+			Default("active"),
+		field.String("war_room_connector").
+			Comment("作战室所用 IM 连接器：feishu / dingtalk / wecom").
+			Optional(),
+		field.String("war_room_chat_id").
+			Comment("作战室群聊ID").
+			Optional(),
+		field.String("war_room_channel").
+			Comment("向作战室发消息使用的 Message.Channel").
+			Optional(),
+		field.Text("war_room_error").
+			Comment("作战室创建失败原因，不影响宣告本身").
+			Optional(),
+		field.JSON("stakeholder_user_ids", []int{}).
+			Comment("接收站内进展通报的干系人用户ID").
+			Optional(),
+		field.JSON("stakeholder_channels", []StakeholderChannel{}).
+			Comment("接收进展通报的外部通道（连接器 + 通道）").
+			Optional(),
+		field.Int("update_cadence_minutes").
+			Comment("干系人进展通报间隔（分钟）").
+			Default(30).
+			Range(5, 1440),
+		field.Time("next_update_at").
+			Comment("下一次进展通报时间，停止通报时为空").
+			Optional().
+			Nillable(),
+		field.Time("last_update_at").
+			Comment("最近一次进展通报时间").
+			Optional().
+			Nillable(),
+		field.Int("update_count").
+			Comment("已发送的进展通报次数").
+			Default(0).
+			NonNegative(),
+		field.Time("resolved_at").
+			Comment("事件解决时间").
+			Optional().
+			Nillable(),
+		field.Text("postmortem_draft").
+			Comment("事后复盘草稿（Markdown）").
+			Optional(),
+		field.Time("postmortem_generated_at").
+			Comment("复盘草稿生成时间").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Comment("创建时间").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Comment("更新时间").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the MajorIncident.
+func (MajorIncident) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "incident_id").
+			Unique(),
+		index.Fields("status", "next_update_at"),
+	}
+}
+
+// StakeholderChannel 干系人外部通报通道，Channel 的含义由连接器决定（open_id、群机器人 webhook 等）
+type StakeholderChannel struct {
+	Connector string `json:"connector"`
+	Channel   string `json:"channel"`
+}
@@ -0,0 +1,129 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MonitoringAlert 监控告警台账（Alertmanager / Zabbix / Grafana 推送）。
+// (tenant_id, source, fingerprint) 唯一：同一告警反复推送只更新本行并追加到同一事件；
+// 关联事件已关闭后再次触发时改挂到新建的事件上；时间窗内与其他告警关联时归并到主告警的事件，
+// 命中屏蔽规则时只记台账不建事件。
+type MonitoringAlert struct {
+	ent.Schema
+}
+
+// Fields of the MonitoringAlert.
+func (MonitoringAlert) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant_id").
+			Comment("租户ID").
+			Positive(),
+		field.String("source").
+			Comment("告警源连接器：alertmanager / zabbix / grafana").
+			NotEmpty(),
+		field.String("fingerprint").
+			Comment("告警指纹，告警源内唯一").
+			NotEmpty(),
+		field.String("alert_name").
+			Comment("告警名称").
+			NotEmpty(),
+		field.Enum("status").
+			Comment("状态：firing 告警中，resolved 已恢复").
+			Values("firing", "resolved").
+			Default("firing"),
+		field.String("severity").
+			Comment("归一化严重程度：critical / high / medium / low").
+			Default("medium"),
+		field.Text("summary").
+			Comment("告警摘要").
+			Optional(),
+		field.JSON("labels", map[string]string{}).
+			Comment("告警标签").
+			Optional(),
+		field.JSON("annotations", map[string]string{}).
+			Comment("告警注解").
+			Optional(),
+		field.String("generator_url").
+			Comment("告警源中的详情链接").
+			Optional(),
+		field.Time("starts_at").
+			Comment("告警开始时间").
+			Default(time.Now),
+		field.Time("ends_at").
+			Comment("告警恢复时间").
+			Optional().
+			Nillable(),
+		field.Time("last_received_at").
+			Comment("最近一次收到推送的时间").
+			Default(time.Now),
+		field.Int("receive_count").
+			Comment("累计收到推送次数").
+			Default(1).
+			NonNegative(),
+		field.Int("incident_id").
+			Comment("关联事件ID").
+			Optional().
+			Nillable(),
+		field.Int("incident_alert_id").
+			Comment("关联事件告警ID").
+			Optional().
+			Nillable(),
+		field.Int("configuration_item_id").
+			Comment("按标签映射到的配置项ID").
+			Optional().
+			Nillable(),
+		field.Enum("correlation_reason").
+			Comment("归并原因：label 标签相同，topology 上游配置项已告警，similarity 文本相似；为空表示独立开启事件").
+			Values("label", "topology", "similarity").
+			Optional().
+			Nillable(),
+		field.Int("root_alert_id").
+			Comment("归并到的主告警台账ID").
+			Optional().
+			Nillable(),
+		field.Int("suppressed_by_rule_id").
+			Comment("最近一次命中的屏蔽规则ID").
+			Optional().
+			Nillable(),
+		field.JSON("state_changes", []time.Time{}).
+			Comment("抖动检测窗口内的状态切换时间").
+			Optional(),
+		field.Bool("flapping").
+			Comment("是否处于抖动中").
+			Default(false),
+		field.Int("incidents_opened").
+			Comment("累计由本告警开启的事件数").
+			Default(0).
+			NonNegative(),
+		field.Int("correlated_count").
+			Comment("累计归并到已有事件的次数").
+			Default(0).
+			NonNegative(),
+		field.Int("suppressed_count").
+			Comment("累计被屏蔽规则拦截的推送次数").
+			Default(0).
+			NonNegative(),
+		field.Time("created_at").
+			Comment("创建时间").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Comment("更新时间").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the MonitoringAlert.
+func (MonitoringAlert) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "source", "fingerprint").
+			Unique(),
+		index.Fields("tenant_id", "incident_id"),
+		index.Fields("tenant_id", "status", "last_received_at"),
+	}
+}
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OnCallOverride 值班替换：[start_at, end_at) 内由 user_id 值班。
+// original_user_id 为空时无条件覆盖该时段；非空时只替换该时段内原本由 original_user_id 值的班（顶班、换班）。
+// 多条替换重叠时后创建的优先。
+type OnCallOverride struct {
+	ent.Schema
+}
+
+// Fields of the OnCallOverride.
+func (OnCallOverride) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant_id").
+			Comment("租户ID").
+			Positive(),
+		field.Int("schedule_id").
+			Comment("值班表ID").
+			Positive(),
+		field.Int("user_id").
+			Comment("替换后的值班人ID").
+			Positive(),
+		field.Int("original_user_id").
+			Comment("被替换的值班人ID，为空表示覆盖该时段的任何人").
+			Optional().
+			Nillable(),
+		field.Time("start_at").
+			Comment("开始时间"),
+		field.Time("end_at").
+			Comment("结束时间"),
+		field.String("reason").
+			Comment("替换原因").
+			Optional().
+			MaxLen(500),
+		field.String("swap_group").
+			Comment("换班时成对创建的两条替换共用的标识，删除其一时一并删除").
+			Optional(),
+		field.Int("created_by").
+			Comment("创建人ID").
+			Optional(),
+		field.Time("created_at").
+			Comment("创建时间").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the OnCallOverride.
+func (OnCallOverride) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "schedule_id", "start_at"),
+		index.Fields("swap_group"),
+	}
+}
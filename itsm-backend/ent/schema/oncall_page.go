@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OnCallPage 一次按升级策略发起的值班呼叫。triggered 期间到达 next_escalation_at 仍未确认时
+// 升级到下一步；被呼叫人确认、事件被确认或解决后结束；步骤与重复次数用尽仍无人确认时为 exhausted。
+type OnCallPage struct {
+	ent.Schema
+}
+
+// Fields of the OnCallPage.
+func (OnCallPage) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant_id").
+			Comment("租户ID").
+			Positive(),
+		field.Int("policy_id").
+			Comment("升级策略ID").
+			Positive(),
+		field.Int("incident_id").
+			Comment("事件ID").
+			Positive(),
+		field.Enum("status").
+			Comment("状态：triggered 呼叫中，acknowledged 已确认，resolved 事件已解决，exhausted 无人确认").
+			Values("triggered", "acknowledged", "resolved", "exhausted").
+			Default("triggered"),
+		field.Int("step").
+			Comment("当前步骤序号（从 0 开始）").
+			Default(0).
+			NonNegative(),
+		field.Int("round").
+			Comment("当前重复轮次（从 0 开始）").
+			Default(0).
+			NonNegative(),
+		field.JSON("notified_user_ids", []int{}).
+			Comment("当前步骤已呼叫的用户ID").
+			Optional(),
+		field.Time("next_escalation_at").
+			Comment("下次升级时间，呼叫结束后为空").
+			Optional().
+			Nillable(),
+		field.Int("acknowledged_by").
+			Comment("确认人ID").
+			Optional().
+			Nillable(),
+		field.Time("acknowledged_at").
+			Comment("确认时间").
+			Optional().
+			Nillable(),
+		field.Int("created_by").
+			Comment("发起人ID").
+			Optional(),
+		field.Time("created_at").
+			Comment("创建时间").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Comment("更新时间").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the OnCallPage.
+func (OnCallPage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_escalation_at"),
+		index.Fields("tenant_id", "incident_id"),
+	}
+}
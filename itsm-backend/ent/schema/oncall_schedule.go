@@ -0,0 +1,84 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OnCallSchedule 值班表。由若干轮值层叠加而成，靠后的层在其覆盖时段内优先于靠前的层；
+// 值班替换（override）再覆盖层叠结果。轮换交接时刻按 time_zone 的当地时间计算，夏令时切换不影响交接时刻。
+type OnCallSchedule struct {
+	ent.Schema
+}
+
+// Fields of the OnCallSchedule.
+func (OnCallSchedule) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant_id").
+			Comment("租户ID").
+			Positive(),
+		field.String("name").
+			Comment("值班表名称").
+			NotEmpty().
+			MaxLen(100),
+		field.Text("description").
+			Comment("说明").
+			Optional(),
+		field.Int("team_id").
+			Comment("所属团队ID，用于按团队查询当前值班人").
+			Optional().
+			Nillable(),
+		field.String("time_zone").
+			Comment("IANA 时区，轮换交接与时段限制按该时区的当地时间计算").
+			Default("Asia/Shanghai"),
+		field.JSON("layers", []OnCallLayer{}).
+			Comment("轮值层，靠后的层优先"),
+		field.Bool("enabled").
+			Comment("是否启用；停用的值班表不参与值班解析").
+			Default(true),
+		field.Int("created_by").
+			Comment("创建人ID").
+			Optional(),
+		field.Time("created_at").
+			Comment("创建时间").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Comment("更新时间").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the OnCallSchedule.
+func (OnCallSchedule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "name").
+			Unique(),
+		index.Fields("tenant_id", "team_id"),
+	}
+}
+
+// OnCallLayer 轮值层：从 start 起按 rotation 周期在 user_ids 间依次轮换
+type OnCallLayer struct {
+	Name string `json:"name"`
+	// Rotation 轮换方式：daily 每 turn_length 天交接，weekly 每 turn_length 周交接，custom 每 shift_minutes 分钟交接
+	Rotation     string     `json:"rotation"`
+	TurnLength   int        `json:"turnLength,omitempty"`   // daily/weekly 每人连续值班的天数/周数，默认 1
+	ShiftMinutes int        `json:"shiftMinutes,omitempty"` // custom 每班时长（分钟）
+	Start        time.Time  `json:"start"`                  // 第一班开始时间，其当地时刻即每日交接时刻
+	End          *time.Time `json:"end,omitempty"`          // 层失效时间，为空表示长期有效
+	UserIDs      []int      `json:"userIds"`
+	// Restrictions 时段限制，为空表示全天候；非空时层只在这些时段内生效
+	Restrictions []OnCallRestriction `json:"restrictions,omitempty"`
+}
+
+// OnCallRestriction 轮值层生效时段
+type OnCallRestriction struct {
+	Weekday int    `json:"weekday"` // 1-7 (周一到周日)，0 表示每天
+	Start   string `json:"start"`   // 开始时间: "09:00"
+	End     string `json:"end"`     // 结束时间: "18:00"，不晚于开始时间表示跨零点的夜班
+}
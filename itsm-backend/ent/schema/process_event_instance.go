@@ -0,0 +1,92 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ProcessEventInstance BPMN事件触发记录（消息关联、信号广播、事件触发请求）。
+type ProcessEventInstance struct {
+	ent.Schema
+}
+
+// Fields of the ProcessEventInstance.
+func (ProcessEventInstance) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("event_instance_id").
+			Comment("事件实例ID").
+			Unique().
+			NotEmpty(),
+		field.Int("tenant_id").
+			Comment("租户ID").
+			Positive(),
+		field.String("event_definition_id").
+			Comment("事件定义ID（BPMN元素ID，消息/信号广播时为名称）").
+			NotEmpty(),
+		field.String("event_type").
+			Comment("事件类型：start, intermediate, boundary, end").
+			Optional().
+			MaxLen(32),
+		field.String("trigger").
+			Comment("触发器：message, signal, timer, error 等").
+			Optional().
+			MaxLen(32),
+		field.String("event_name").
+			Comment("消息名称或信号名称").
+			Optional().
+			MaxLen(200),
+		field.String("process_instance_id").
+			Comment("流程实例业务ID（PI-...）").
+			Optional(),
+		field.Int("subscription_id").
+			Comment("命中的事件订阅ID").
+			Optional().
+			Nillable(),
+		field.JSON("correlation_keys", map[string]interface{}{}).
+			Comment("关联键").
+			Optional(),
+		field.JSON("variables", map[string]interface{}{}).
+			Comment("随事件传入的变量").
+			Optional(),
+		field.String("status").
+			Comment("状态：pending, triggered, completed, failed").
+			Default("pending").
+			MaxLen(32),
+		field.String("error").
+			Comment("错误信息").
+			Optional().
+			MaxLen(2000),
+		field.Int("retry_count").
+			Comment("重试次数").
+			Default(0).
+			NonNegative(),
+		field.Time("triggered_at").
+			Comment("触发时间").
+			Optional().
+			Nillable(),
+		field.Time("completed_at").
+			Comment("完成时间").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Comment("创建时间").
+			Default(time.Now),
+		field.Time("updated_at").
+			Comment("更新时间").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the ProcessEventInstance.
+func (ProcessEventInstance) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "process_instance_id"),
+		index.Fields("tenant_id", "event_definition_id"),
+		index.Fields("tenant_id", "status"),
+		index.Fields("created_at"),
+	}
+}
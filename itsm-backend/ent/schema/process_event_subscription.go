@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ProcessEventSubscription 流程实例在消息/信号捕获节点上的等待订阅。
+// 进入中间捕获事件、接收任务或挂载了消息/信号边界事件的活动时创建，
+// 关联成功后置为 correlated，活动结束或实例终止时置为 cancelled。
+type ProcessEventSubscription struct {
+	ent.Schema
+}
+
+// Fields of the ProcessEventSubscription.
+func (ProcessEventSubscription) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant_id").
+			Comment("租户ID").
+			Positive(),
+		field.Int("process_instance_id").
+			Comment("流程实例ID").
+			Positive(),
+		field.String("process_definition_key").
+			Comment("流程定义Key").
+			NotEmpty(),
+		field.String("business_key").
+			Comment("业务键，冗余存储便于按业务键关联").
+			Optional(),
+		field.String("element_id").
+			Comment("订阅所在的BPMN元素ID（中间事件/接收任务/边界事件）").
+			NotEmpty(),
+		field.String("attached_to").
+			Comment("边界事件所挂载的活动ID，非边界事件为空").
+			Optional(),
+		field.Bool("interrupting").
+			Comment("边界事件是否中断所挂载活动").
+			Default(true),
+		field.String("event_type").
+			Comment("事件类型：message, signal").
+			NotEmpty().
+			MaxLen(32),
+		field.String("event_name").
+			Comment("消息名称或信号名称").
+			NotEmpty().
+			MaxLen(200),
+		field.String("status").
+			Comment("状态：waiting, correlated, cancelled").
+			Default("waiting").
+			MaxLen(32),
+		field.Time("correlated_at").
+			Comment("关联时间").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Comment("创建时间").
+			Default(time.Now),
+		field.Time("updated_at").
+			Comment("更新时间").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the ProcessEventSubscription.
+func (ProcessEventSubscription) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "event_type", "event_name", "status"),
+		index.Fields("tenant_id", "process_instance_id", "status"),
+		index.Fields("business_key"),
+	}
+}
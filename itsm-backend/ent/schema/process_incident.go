@@ -0,0 +1,77 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ProcessIncident 流程实例上的运行事故。
+// 异步服务任务按重试策略重试耗尽后创建 open 状态的事故，实例停在该服务任务上等待运维处理；
+// 运维重试（retried）或跳过（skipped）后事故关闭。
+type ProcessIncident struct {
+	ent.Schema
+}
+
+// Fields of the ProcessIncident.
+func (ProcessIncident) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant_id").
+			Comment("租户ID").
+			Positive(),
+		field.Int("process_instance_id").
+			Comment("流程实例ID").
+			Positive(),
+		field.String("process_definition_key").
+			Comment("流程定义Key").
+			NotEmpty(),
+		field.String("element_id").
+			Comment("发生事故的BPMN元素ID").
+			NotEmpty(),
+		field.String("incident_type").
+			Comment("事故类型：failed_job").
+			Default("failed_job").
+			MaxLen(32),
+		field.String("message").
+			Comment("最后一次失败的错误信息").
+			Optional().
+			MaxLen(2000),
+		field.Int("attempts").
+			Comment("已执行次数").
+			Default(0).
+			NonNegative(),
+		field.String("status").
+			Comment("状态：open, resolved").
+			Default("open").
+			MaxLen(32),
+		field.String("resolution").
+			Comment("处理方式：retried, skipped").
+			Optional().
+			MaxLen(32),
+		field.String("resolved_by").
+			Comment("处理人").
+			Optional().
+			MaxLen(100),
+		field.Time("resolved_at").
+			Comment("处理时间").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Comment("创建时间").
+			Default(time.Now),
+		field.Time("updated_at").
+			Comment("更新时间").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the ProcessIncident.
+func (ProcessIncident) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "status"),
+		index.Fields("tenant_id", "process_instance_id", "status"),
+	}
+}
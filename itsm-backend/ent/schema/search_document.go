@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SearchDocument 全文检索索引文档。
+// 工单、工单评论、知识文章与配置项各对应一条文档，由命令总线在业务写入后异步刷新；
+// terms 保存分词结果（中日韩文字按单字+二元组切分），PostgreSQL 上建立 GIN 倒排索引。
+// owner_id / assignee_id / restricted 冗余保存可见性信息，检索时据此按调用者权限过滤。
+type SearchDocument struct {
+	ent.Schema
+}
+
+// Fields of the SearchDocument.
+func (SearchDocument) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant_id").
+			Comment("租户ID").
+			Positive(),
+		field.String("entity_type").
+			Comment("实体类型: ticket/ticket_comment/knowledge_article/configuration_item").
+			NotEmpty(),
+		field.Int("entity_id").
+			Comment("实体ID").
+			Positive(),
+		field.Int("parent_id").
+			Comment("父实体ID，评论为所属工单ID").
+			Optional(),
+		field.String("reference").
+			Comment("展示用编号，如工单号、配置项类型、文章分类").
+			Optional(),
+		field.Text("title").
+			Comment("标题").
+			Default(""),
+		field.Text("body").
+			Comment("正文").
+			Default(""),
+		field.Text("title_terms").
+			Comment("标题分词，空格分隔").
+			Default(""),
+		field.Text("terms").
+			Comment("标题与正文分词，空格分隔，保留重复以计算词频").
+			Default(""),
+		field.Int("owner_id").
+			Comment("归属人：工单/评论为工单提交人，知识文章为作者").
+			Optional(),
+		field.Int("assignee_id").
+			Comment("工单处理人，评论继承所属工单").
+			Optional(),
+		field.Bool("restricted").
+			Comment("受限文档：内部备注、未发布的知识文章").
+			Default(false),
+		field.Time("source_updated_at").
+			Comment("源实体更新时间").
+			Default(time.Now),
+		field.Time("created_at").
+			Comment("创建时间").
+			Default(time.Now),
+		field.Time("updated_at").
+			Comment("更新时间").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the SearchDocument.
+func (SearchDocument) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the SearchDocument.
+func (SearchDocument) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "entity_type", "entity_id").Unique(),
+		index.Fields("tenant_id", "entity_type", "parent_id"),
+	}
+}
@@ -9,7 +9,9 @@ import (
 )
 
 // SLAPolicy holds the schema definition for the SLAPolicy entity.
-// 多层级SLA策略，支持按客户等级、工单类型等维度配置
+// 多层级SLA策略，支持按客户等级、工单类型等维度配置。
+// agreement_type 区分对客户承诺的 SLA、绑定处理团队的内部 OLA 与绑定供应商的支撑合同 UC，
+// 三者都通过 metrics 声明多个计时指标（首次响应、定期更新、解决等），按工单逐一跟踪。
 type SLAPolicy struct {
 	ent.Schema
 }
@@ -32,6 +34,20 @@ func (SLAPolicy) Fields() []ent.Field {
 		field.String("priority").
 			Comment("优先级: critical/high/medium/low").
 			Optional(),
+		field.String("agreement_type").
+			Comment("协议类型: sla(客户服务级别协议)/ola(内部运营级别协议)/uc(供应商支撑合同)").
+			Default("sla"),
+		field.Int("team_id").
+			Comment("OLA 适用的处理团队ID").
+			Optional().
+			Nillable(),
+		field.Int("vendor_id").
+			Comment("UC 适用的供应商ID").
+			Optional().
+			Nillable(),
+		field.JSON("metrics", []SLAMetricDefinition{}).
+			Comment("计时指标，为空时按响应/解决时间生成首次响应与解决两个指标").
+			Optional(),
 		field.Int("response_time_minutes").
 			Comment("响应时间(分钟)").
 			Positive(),
@@ -41,6 +57,10 @@ func (SLAPolicy) Fields() []ent.Field {
 		field.JSON("business_hours", map[string]interface{}{}).
 			Comment("业务时间配置: 工作日、时段、时区").
 			Optional(),
+		field.Int("calendar_id").
+			Comment("工作日历ID，设置后替代 business_hours 与排除周末/节假日配置").
+			Optional().
+			Nillable(),
 		field.Bool("exclude_weekends").
 			Comment("是否排除周末").
 			Default(false),
@@ -76,9 +96,33 @@ func (SLAPolicy) Edges() []ent.Edge {
 			Comment("关联的SLA定义"),
 		edge.To("tickets", Ticket.Type).
 			Comment("应用此策略的工单"),
+		edge.From("team", Team.Type).
+			Ref("olas").
+			Field("team_id").
+			Unique().
+			Comment("OLA 适用的处理团队"),
+		edge.From("vendor", Vendor.Type).
+			Ref("underpinning_contracts").
+			Field("vendor_id").
+			Unique().
+			Comment("UC 适用的供应商"),
 	}
 }
 
+// SLAMetricDefinition SLA计时指标。
+// 条件是基于 ticket（工单字段）与 event（触发事件）的表达式：
+// 非周期指标在开始条件成立时开始计时，停止条件成立时结束；
+// 周期指标（如每4小时更新一次）在停止后只要开始条件仍成立就开启下一周期，开始条件不再成立时取消当前周期。
+type SLAMetricDefinition struct {
+	Key            string `json:"key"`                       // 指标标识: first_response, next_update, resolution
+	Name           string `json:"name"`                      // 指标名称
+	TargetMinutes  int    `json:"target_minutes"`            // 目标时长（工作分钟）
+	Recurring      bool   `json:"recurring,omitempty"`       // 是否周期指标
+	StartCondition string `json:"start_condition,omitempty"` // 开始条件，为空表示建单即开始
+	StopCondition  string `json:"stop_condition"`            // 停止条件
+	PauseCondition string `json:"pause_condition,omitempty"` // 暂停条件，成立期间不计时
+}
+
 // BusinessHoursConfig 业务时间配置
 type BusinessHoursConfig struct {
 	WorkDays    []int    `json:"work_days"`    // 工作日: 1-7 (周一到周日)
@@ -6,6 +6,8 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+
+	"itsm-backend/common/slapause"
 )
 
 type SLADefinition struct{ ent.Schema }
@@ -19,6 +21,8 @@ func (SLADefinition) Fields() []ent.Field {
 		field.Int("response_time").Comment("响应时间(分钟)").Positive().Default(30),
 		field.Int("resolution_time").Comment("解决时间(分钟)").Positive().Default(240),
 		field.JSON("business_hours", map[string]interface{}{}).Comment("营业时间配置").Optional(),
+		field.Int("calendar_id").Comment("工作日历ID，设置后替代营业时间配置").Optional().Nillable(),
+		field.JSON("pause_conditions", &SLAPauseConditions{}).Comment("SLA计时暂停条件").Optional(),
 		field.JSON("escalation_rules", map[string]interface{}{}).Comment("升级规则").Optional(),
 		field.JSON("conditions", map[string]interface{}{}).Comment("适用条件").Optional(),
 		field.Bool("is_active").Comment("是否激活").Default(true),
@@ -36,3 +40,6 @@ func (SLADefinition) Edges() []ent.Edge {
 		edge.To("alert_rules", SLAAlertRule.Type).Comment("SLA预警规则"),
 	}
 }
+
+// SLAPauseConditions SLA计时暂停条件，定义见 slapause.Conditions
+type SLAPauseConditions = slapause.Conditions
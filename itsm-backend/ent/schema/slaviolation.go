@@ -18,6 +18,8 @@ func (SLAViolation) Fields() []ent.Field {
 		field.String("sla_name").Comment("SLA名称").Default("Default SLA"),
 		field.Int("ticket_id").Comment("工单ID").Positive(),
 		field.String("violation_type").Comment("违规类型").NotEmpty(),
+		field.String("agreement_type").Comment("协议类型: sla/ola/uc").Default("sla"),
+		field.String("metric_key").Comment("违约的计时指标标识，策略指标违约时填写").Optional(),
 		field.Time("violation_time").Comment("违规时间").Default(time.Now),
 		field.Time("violation_occurred_at").Comment("违规发生时间").Default(time.Now),
 		field.Int("expected_time").Comment("期望时间(时间戳)").Optional(),
@@ -31,6 +31,10 @@ func (Team) Fields() []ent.Field {
 		field.Int("manager_id").
 			Comment("负责人ID").
 			Optional(),
+		field.Int("calendar_id").
+			Comment("工作日历ID").
+			Optional().
+			Nillable(),
 		field.Int("tenant_id").
 			Comment("租户ID").
 			Positive(),
@@ -55,5 +59,7 @@ func (Team) Edges() []ent.Edge {
 			Comment("团队成员"),
 		edge.To("tags", Tag.Type).
 			Comment("团队标签"),
+		edge.To("olas", SLAPolicy.Type).
+			Comment("团队承担的内部运营级别协议"),
 	}
 }
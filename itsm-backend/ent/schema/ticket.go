@@ -70,6 +70,17 @@ func (Ticket) Fields() []ent.Field {
 		field.Time("sla_resolution_deadline").
 			Comment("SLA解决截止时间").
 			Optional(),
+		field.Bool("sla_on_hold").
+			Comment("是否挂起（SLA定义配置 on_hold 暂停条件时停止计时）").
+			Default(false),
+		field.Time("sla_paused_at").
+			Comment("SLA计时暂停时间，为空表示正在计时").
+			Optional().
+			Nillable(),
+		field.Int("vendor_id").
+			Comment("承接处理的供应商ID，用于跟踪供应商支撑合同").
+			Optional().
+			Nillable(),
 		field.Time("first_response_at").
 			Comment("首次响应时间").
 			Optional(),
@@ -144,6 +155,10 @@ func (Ticket) Edges() []ent.Edge {
 		edge.To("cc_users", TicketCC.Type),
 		edge.To("sla_violations", SLAViolation.Type),
 		edge.To("sla_alert_history", SLAAlertHistory.Type),
+		edge.To("sla_pauses", TicketSLAPause.Type).
+			Comment("SLA计时暂停区间"),
+		edge.To("sla_metrics", TicketSLAMetric.Type).
+			Comment("SLA/OLA/UC 计时指标"),
 		edge.To("root_cause_analyses", RootCauseAnalysis.Type),
 		edge.To("feishu_syncs", FeishuTicketSync.Type),
 		edge.From("requester", User.Type).
@@ -0,0 +1,136 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TicketSchedule 周期工单计划（预防性维护）。
+// 按 cron 或 RRULE 表达式在指定时区周期性地以工单模板或服务目录项生成工单，
+// 可通过工作日历跳过或顺延节假日，停机恢复后按补发策略处理错过的发生时刻。
+// 每次发生以 OperationalCommand 持久化（available_at 即触发时间），幂等键精确到发生时刻。
+type TicketSchedule struct {
+	ent.Schema
+}
+
+// Fields of the TicketSchedule.
+func (TicketSchedule) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant_id").
+			Comment("租户ID").
+			Positive(),
+		field.String("name").
+			Comment("计划名称").
+			NotEmpty(),
+		field.Text("description").
+			Comment("计划描述").
+			Optional(),
+		field.Enum("source_type").
+			Comment("工单来源: template/catalog_item").
+			Values("template", "catalog_item"),
+		field.Int("template_id").
+			Comment("工单模板ID").
+			Optional().
+			Nillable(),
+		field.Int("catalog_item_id").
+			Comment("服务目录项ID").
+			Optional().
+			Nillable(),
+		field.String("title").
+			Comment("工单标题，为空时取模板或服务名称并附加发生日期").
+			Optional(),
+		field.String("priority").
+			Comment("工单优先级，为空时取模板默认优先级").
+			Optional(),
+		field.Int("requester_id").
+			Comment("工单申请人ID").
+			Positive(),
+		field.Int("assignee_id").
+			Comment("工单处理人ID").
+			Optional().
+			Nillable(),
+		field.JSON("form_fields", map[string]interface{}{}).
+			Comment("工单表单字段值").
+			Optional(),
+		field.Enum("rule_type").
+			Comment("表达式类型: cron/rrule").
+			Values("cron", "rrule"),
+		field.String("expression").
+			Comment("cron 或 RRULE 表达式").
+			NotEmpty(),
+		field.String("time_zone").
+			Comment("表达式所在时区").
+			Default("Asia/Shanghai"),
+		field.Time("start_at").
+			Comment("生效时间，RRULE 以此作为 DTSTART"),
+		field.Time("end_at").
+			Comment("失效时间，为空表示长期有效").
+			Optional().
+			Nillable(),
+		field.Int("calendar_id").
+			Comment("工作日历ID，为空时使用租户默认日历").
+			Optional().
+			Nillable(),
+		field.Enum("holiday_policy").
+			Comment("非工作日处理: none 照常生成/skip 跳过/next_business_day 顺延到下一个工作日").
+			Values("none", "skip", "next_business_day").
+			Default("none"),
+		field.Enum("catch_up_policy").
+			Comment("停机恢复后错过的发生时刻: all 全部补发/latest 只补最近一次/none 不补发").
+			Values("all", "latest", "none").
+			Default("latest"),
+		field.Bool("enabled").
+			Comment("是否启用").
+			Default(true),
+		field.Int("revision").
+			Comment("调度版本，修改表达式或启停时递增，旧版本的待触发命令作废").
+			Default(1),
+		field.Time("next_run_at").
+			Comment("下一次触发时间").
+			Optional().
+			Nillable(),
+		field.Time("last_occurrence_at").
+			Comment("最近一次处理的计划发生时刻").
+			Optional().
+			Nillable(),
+		field.Time("last_run_at").
+			Comment("最近一次生成工单的时间").
+			Optional().
+			Nillable(),
+		field.Int("last_ticket_id").
+			Comment("最近一次生成的工单ID").
+			Optional().
+			Nillable(),
+		field.Int("created_by").
+			Comment("创建人ID").
+			Optional(),
+		field.Time("created_at").
+			Comment("创建时间").
+			Default(time.Now),
+		field.Time("updated_at").
+			Comment("更新时间").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the TicketSchedule.
+func (TicketSchedule) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("runs", TicketScheduleRun.Type).
+			Comment("各次发生的执行记录"),
+	}
+}
+
+// Indexes of the TicketSchedule.
+func (TicketSchedule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "name").
+			Unique(),
+		index.Fields("tenant_id", "enabled"),
+	}
+}
@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TicketScheduleRun 周期工单计划的单次发生记录。
+// (schedule_id, occurrence_at) 唯一，建单前以 pending/failed → generating 的条件更新占用该发生，
+// 命令重试或重复投递时据此保证同一发生时刻只生成一张工单；
+// 按补发策略跳过的发生时刻同样留档。
+type TicketScheduleRun struct {
+	ent.Schema
+}
+
+// Fields of the TicketScheduleRun.
+func (TicketScheduleRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant_id").
+			Comment("租户ID").
+			Positive(),
+		field.Int("schedule_id").
+			Comment("计划ID").
+			Positive(),
+		field.Time("occurrence_at").
+			Comment("计划发生时刻"),
+		field.Time("fire_at").
+			Comment("实际触发时刻（节假日顺延后）"),
+		field.Enum("status").
+			Comment("状态: pending 待生成/generating 建单中/generated 已生成/skipped 已跳过/failed 生成失败").
+			Values("pending", "generating", "generated", "skipped", "failed").
+			Default("pending"),
+		field.Int("ticket_id").
+			Comment("生成的工单ID").
+			Optional().
+			Nillable(),
+		field.String("message").
+			Comment("跳过原因或失败信息").
+			Optional(),
+		field.Time("created_at").
+			Comment("创建时间").
+			Default(time.Now),
+		field.Time("updated_at").
+			Comment("更新时间").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the TicketScheduleRun.
+func (TicketScheduleRun) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("schedule", TicketSchedule.Type).
+			Ref("runs").
+			Field("schedule_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the TicketScheduleRun.
+func (TicketScheduleRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("schedule_id", "occurrence_at").
+			Unique(),
+		index.Fields("tenant_id", "schedule_id"),
+	}
+}
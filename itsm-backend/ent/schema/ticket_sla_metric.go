@@ -0,0 +1,103 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TicketSLAMetric 工单SLA计时指标周期。
+// 每条记录对应某个协议（SLA/OLA/UC）中一个指标的一次计时：非周期指标只有一个周期，
+// 周期指标（如定期更新）每满足一次停止条件就结束当前周期并开启下一周期。
+type TicketSLAMetric struct {
+	ent.Schema
+}
+
+// Fields of the TicketSLAMetric.
+func (TicketSLAMetric) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("ticket_id").
+			Comment("工单ID").
+			Positive(),
+		field.Int("policy_id").
+			Comment("SLA策略ID").
+			Positive(),
+		field.Int("sla_definition_id").
+			Comment("工单绑定的SLA定义ID，用于按定义汇总达标率").
+			Optional(),
+		field.String("agreement_type").
+			Comment("协议类型: sla/ola/uc").
+			Default("sla"),
+		field.String("metric_key").
+			Comment("指标标识").
+			NotEmpty(),
+		field.String("metric_name").
+			Comment("指标名称").
+			Optional(),
+		field.Int("cycle").
+			Comment("计时周期序号，从1开始").
+			Default(1),
+		field.String("status").
+			Comment("状态: running/paused/completed/cancelled").
+			Default("running"),
+		field.Int("target_minutes").
+			Comment("目标时长（工作分钟）").
+			Positive(),
+		field.Time("started_at").
+			Comment("开始计时时间"),
+		field.Time("deadline").
+			Comment("截止时间，暂停恢复后顺延"),
+		field.Time("paused_at").
+			Comment("暂停时间，为空表示未暂停").
+			Optional().
+			Nillable(),
+		field.Int("paused_minutes").
+			Comment("累计暂停的工作分钟数").
+			Default(0),
+		field.Time("stopped_at").
+			Comment("停止计时时间").
+			Optional().
+			Nillable(),
+		field.Bool("breached").
+			Comment("是否违约").
+			Default(false),
+		field.Time("breached_at").
+			Comment("违约时间").
+			Optional().
+			Nillable(),
+		field.Int("tenant_id").
+			Comment("租户ID").
+			Positive(),
+		field.Time("created_at").
+			Comment("创建时间").
+			Default(time.Now),
+		field.Time("updated_at").
+			Comment("更新时间").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the TicketSLAMetric.
+func (TicketSLAMetric) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("ticket", Ticket.Type).
+			Ref("sla_metrics").
+			Field("ticket_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the TicketSLAMetric.
+func (TicketSLAMetric) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "ticket_id"),
+		index.Fields("ticket_id", "policy_id", "metric_key", "cycle").Unique(),
+		index.Fields("tenant_id", "status", "deadline"),
+		index.Fields("sla_definition_id"),
+	}
+}
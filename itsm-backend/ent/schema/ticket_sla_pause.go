@@ -0,0 +1,89 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TicketSLAPause 工单SLA计时暂停区间。
+// 工单进入 SLA 定义配置的暂停条件（如等待客户/供应商、挂起）时开启一条区间，
+// 条件解除时关闭区间并按暂停期间的工作分钟数顺延截止时间；顺延前后的截止时间一并留档，作为计时审计依据。
+type TicketSLAPause struct {
+	ent.Schema
+}
+
+// Fields of the TicketSLAPause.
+func (TicketSLAPause) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("ticket_id").
+			Comment("工单ID").
+			Positive(),
+		field.Int("sla_definition_id").
+			Comment("暂停时生效的SLA定义ID").
+			Optional(),
+		field.String("reason").
+			Comment("暂停原因: status/on_hold").
+			NotEmpty(),
+		field.String("ticket_status").
+			Comment("暂停时的工单状态").
+			Optional(),
+		field.Time("paused_at").
+			Comment("暂停时间"),
+		field.Time("resumed_at").
+			Comment("恢复时间，为空表示仍在暂停中").
+			Optional().
+			Nillable(),
+		field.Int("paused_minutes").
+			Comment("暂停期间的工作分钟数，恢复时计算").
+			Default(0),
+		field.Time("response_deadline_before").
+			Comment("顺延前的响应截止时间").
+			Optional().
+			Nillable(),
+		field.Time("response_deadline_after").
+			Comment("顺延后的响应截止时间").
+			Optional().
+			Nillable(),
+		field.Time("resolution_deadline_before").
+			Comment("顺延前的解决截止时间").
+			Optional().
+			Nillable(),
+		field.Time("resolution_deadline_after").
+			Comment("顺延后的解决截止时间").
+			Optional().
+			Nillable(),
+		field.Int("tenant_id").
+			Comment("租户ID").
+			Positive(),
+		field.Time("created_at").
+			Comment("创建时间").
+			Default(time.Now),
+		field.Time("updated_at").
+			Comment("更新时间").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the TicketSLAPause.
+func (TicketSLAPause) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("ticket", Ticket.Type).
+			Ref("sla_pauses").
+			Field("ticket_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the TicketSLAPause.
+func (TicketSLAPause) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "ticket_id"),
+		index.Fields("ticket_id", "resumed_at"),
+	}
+}
@@ -22,6 +22,9 @@ func (TicketView) Fields() []ent.Field {
 		field.Text("description").
 			Comment("视图描述").
 			Optional(),
+		field.Text("query").
+			Comment("TQL 查询语句，设置后优先于 filters").
+			Optional(),
 		field.JSON("filters", map[string]interface{}{}).
 			Comment("筛选条件（JSON格式）").
 			Optional(),
@@ -44,6 +44,14 @@ func (User) Fields() []ent.Field {
 			Comment("飞书用户OpenID").
 			Optional().
 			Unique(),
+		field.String("slack_user_id").
+			Comment("Slack 成员ID").
+			Optional().
+			Unique(),
+		field.String("teams_user_id").
+			Comment("Teams 用户 AAD 对象ID").
+			Optional().
+			Unique(),
 		field.String("password_hash").
 			Comment("密码哈希").
 			NotEmpty(),
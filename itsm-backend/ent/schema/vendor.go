@@ -34,5 +34,7 @@ func (Vendor) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("contracts", Contract.Type),
 		edge.To("assets", Asset.Type),
+		edge.To("underpinning_contracts", SLAPolicy.Type).
+			Comment("供应商支撑合同（UC）"),
 	}
 }
@@ -0,0 +1,92 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WorkLog 工时记录。每条记录挂在一个工单、变更或问题上（三者有且仅有一个）；
+// ended_at 为空表示计时器仍在运行，停止时按实际时长写入 duration_minutes。
+// team_id、category 与 msp_provider_id 在记录时快照，便于按团队、分类与 MSP 客户汇总和计费，
+// 不随人员调岗或工单改分类而改变历史工时的归属。
+type WorkLog struct {
+	ent.Schema
+}
+
+// Fields of the WorkLog.
+func (WorkLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant_id").
+			Comment("租户ID（工单/变更/问题所在租户，MSP 场景下即客户租户）").
+			Positive(),
+		field.Int("user_id").
+			Comment("工时所属用户ID").
+			Positive(),
+		field.Int("team_id").
+			Comment("记录时用户所属团队ID").
+			Optional().
+			Nillable(),
+		field.Int("ticket_id").
+			Comment("工单ID").
+			Optional().
+			Nillable(),
+		field.Int("change_id").
+			Comment("变更ID").
+			Optional().
+			Nillable(),
+		field.Int("problem_id").
+			Comment("问题ID").
+			Optional().
+			Nillable(),
+		field.String("category").
+			Comment("记录时的分类：工单分类名称、问题分类或变更类型").
+			Optional(),
+		field.Time("started_at").
+			Comment("开始时间"),
+		field.Time("ended_at").
+			Comment("结束时间，为空表示计时中").
+			Optional().
+			Nillable(),
+		field.Int("duration_minutes").
+			Comment("时长（分钟），计时中为 0").
+			Default(0).
+			NonNegative(),
+		field.Bool("billable").
+			Comment("是否可计费").
+			Default(false),
+		field.Enum("activity_type").
+			Comment("活动类型").
+			Values("general", "troubleshooting", "implementation", "communication", "meeting", "travel", "onsite", "documentation").
+			Default("general"),
+		field.Text("comment").
+			Comment("工作说明").
+			Optional(),
+		field.Int("msp_provider_id").
+			Comment("工单由 MSP 托管时的服务提供商租户ID，作为 MSP 向客户开票的依据").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Comment("创建时间").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Comment("更新时间").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the WorkLog.
+func (WorkLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "started_at"),
+		index.Fields("tenant_id", "user_id", "ended_at"),
+		index.Fields("tenant_id", "ticket_id"),
+		index.Fields("tenant_id", "change_id"),
+		index.Fields("tenant_id", "problem_id"),
+		index.Fields("msp_provider_id", "started_at"),
+	}
+}
@@ -0,0 +1,262 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"itsm-backend/ent/searchdocument"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// SearchDocument is the model entity for the SearchDocument schema.
+type SearchDocument struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 租户ID
+	TenantID int `json:"tenant_id,omitempty"`
+	// 实体类型: ticket/ticket_comment/knowledge_article/configuration_item
+	EntityType string `json:"entity_type,omitempty"`
+	// 实体ID
+	EntityID int `json:"entity_id,omitempty"`
+	// 父实体ID，评论为所属工单ID
+	ParentID int `json:"parent_id,omitempty"`
+	// 展示用编号，如工单号、配置项类型、文章分类
+	Reference string `json:"reference,omitempty"`
+	// 标题
+	Title string `json:"title,omitempty"`
+	// 正文
+	Body string `json:"body,omitempty"`
+	// 标题分词，空格分隔
+	TitleTerms string `json:"title_terms,omitempty"`
+	// 标题与正文分词，空格分隔，保留重复以计算词频
+	Terms string `json:"terms,omitempty"`
+	// 归属人：工单/评论为工单提交人，知识文章为作者
+	OwnerID int `json:"owner_id,omitempty"`
+	// 工单处理人，评论继承所属工单
+	AssigneeID int `json:"assignee_id,omitempty"`
+	// 受限文档：内部备注、未发布的知识文章
+	Restricted bool `json:"restricted,omitempty"`
+	// 源实体更新时间
+	SourceUpdatedAt time.Time `json:"source_updated_at,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SearchDocument) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case searchdocument.FieldRestricted:
+			values[i] = new(sql.NullBool)
+		case searchdocument.FieldID, searchdocument.FieldTenantID, searchdocument.FieldEntityID, searchdocument.FieldParentID, searchdocument.FieldOwnerID, searchdocument.FieldAssigneeID:
+			values[i] = new(sql.NullInt64)
+		case searchdocument.FieldEntityType, searchdocument.FieldReference, searchdocument.FieldTitle, searchdocument.FieldBody, searchdocument.FieldTitleTerms, searchdocument.FieldTerms:
+			values[i] = new(sql.NullString)
+		case searchdocument.FieldSourceUpdatedAt, searchdocument.FieldCreatedAt, searchdocument.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SearchDocument fields.
+func (_m *SearchDocument) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case searchdocument.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case searchdocument.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = int(value.Int64)
+			}
+		case searchdocument.FieldEntityType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field entity_type", values[i])
+			} else if value.Valid {
+				_m.EntityType = value.String
+			}
+		case searchdocument.FieldEntityID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field entity_id", values[i])
+			} else if value.Valid {
+				_m.EntityID = int(value.Int64)
+			}
+		case searchdocument.FieldParentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				_m.ParentID = int(value.Int64)
+			}
+		case searchdocument.FieldReference:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reference", values[i])
+			} else if value.Valid {
+				_m.Reference = value.String
+			}
+		case searchdocument.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = value.String
+			}
+		case searchdocument.FieldBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value.Valid {
+				_m.Body = value.String
+			}
+		case searchdocument.FieldTitleTerms:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title_terms", values[i])
+			} else if value.Valid {
+				_m.TitleTerms = value.String
+			}
+		case searchdocument.FieldTerms:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field terms", values[i])
+			} else if value.Valid {
+				_m.Terms = value.String
+			}
+		case searchdocument.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				_m.OwnerID = int(value.Int64)
+			}
+		case searchdocument.FieldAssigneeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field assignee_id", values[i])
+			} else if value.Valid {
+				_m.AssigneeID = int(value.Int64)
+			}
+		case searchdocument.FieldRestricted:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field restricted", values[i])
+			} else if value.Valid {
+				_m.Restricted = value.Bool
+			}
+		case searchdocument.FieldSourceUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field source_updated_at", values[i])
+			} else if value.Valid {
+				_m.SourceUpdatedAt = value.Time
+			}
+		case searchdocument.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case searchdocument.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SearchDocument.
+// This includes values selected through modifiers, order, etc.
+func (_m *SearchDocument) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SearchDocument.
+// Note that you need to call SearchDocument.Unwrap() before calling this method if this SearchDocument
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SearchDocument) Update() *SearchDocumentUpdateOne {
+	return NewSearchDocumentClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SearchDocument entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SearchDocument) Unwrap() *SearchDocument {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SearchDocument is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SearchDocument) String() string {
+	var builder strings.Builder
+	builder.WriteString("SearchDocument(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("entity_type=")
+	builder.WriteString(_m.EntityType)
+	builder.WriteString(", ")
+	builder.WriteString("entity_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EntityID))
+	builder.WriteString(", ")
+	builder.WriteString("parent_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ParentID))
+	builder.WriteString(", ")
+	builder.WriteString("reference=")
+	builder.WriteString(_m.Reference)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(_m.Title)
+	builder.WriteString(", ")
+	builder.WriteString("body=")
+	builder.WriteString(_m.Body)
+	builder.WriteString(", ")
+	builder.WriteString("title_terms=")
+	builder.WriteString(_m.TitleTerms)
+	builder.WriteString(", ")
+	builder.WriteString("terms=")
+	builder.WriteString(_m.Terms)
+	builder.WriteString(", ")
+	builder.WriteString("owner_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OwnerID))
+	builder.WriteString(", ")
+	builder.WriteString("assignee_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AssigneeID))
+	builder.WriteString(", ")
+	builder.WriteString("restricted=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Restricted))
+	builder.WriteString(", ")
+	builder.WriteString("source_updated_at=")
+	builder.WriteString(_m.SourceUpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SearchDocuments is a parsable slice of SearchDocument.
+type SearchDocuments []*SearchDocument
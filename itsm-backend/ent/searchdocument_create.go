@@ -0,0 +1,503 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/searchdocument"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SearchDocumentCreate is the builder for creating a SearchDocument entity.
+type SearchDocumentCreate struct {
+	config
+	mutation *SearchDocumentMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *SearchDocumentCreate) SetTenantID(v int) *SearchDocumentCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetEntityType sets the "entity_type" field.
+func (_c *SearchDocumentCreate) SetEntityType(v string) *SearchDocumentCreate {
+	_c.mutation.SetEntityType(v)
+	return _c
+}
+
+// SetEntityID sets the "entity_id" field.
+func (_c *SearchDocumentCreate) SetEntityID(v int) *SearchDocumentCreate {
+	_c.mutation.SetEntityID(v)
+	return _c
+}
+
+// SetParentID sets the "parent_id" field.
+func (_c *SearchDocumentCreate) SetParentID(v int) *SearchDocumentCreate {
+	_c.mutation.SetParentID(v)
+	return _c
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_c *SearchDocumentCreate) SetNillableParentID(v *int) *SearchDocumentCreate {
+	if v != nil {
+		_c.SetParentID(*v)
+	}
+	return _c
+}
+
+// SetReference sets the "reference" field.
+func (_c *SearchDocumentCreate) SetReference(v string) *SearchDocumentCreate {
+	_c.mutation.SetReference(v)
+	return _c
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (_c *SearchDocumentCreate) SetNillableReference(v *string) *SearchDocumentCreate {
+	if v != nil {
+		_c.SetReference(*v)
+	}
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *SearchDocumentCreate) SetTitle(v string) *SearchDocumentCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_c *SearchDocumentCreate) SetNillableTitle(v *string) *SearchDocumentCreate {
+	if v != nil {
+		_c.SetTitle(*v)
+	}
+	return _c
+}
+
+// SetBody sets the "body" field.
+func (_c *SearchDocumentCreate) SetBody(v string) *SearchDocumentCreate {
+	_c.mutation.SetBody(v)
+	return _c
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_c *SearchDocumentCreate) SetNillableBody(v *string) *SearchDocumentCreate {
+	if v != nil {
+		_c.SetBody(*v)
+	}
+	return _c
+}
+
+// SetTitleTerms sets the "title_terms" field.
+func (_c *SearchDocumentCreate) SetTitleTerms(v string) *SearchDocumentCreate {
+	_c.mutation.SetTitleTerms(v)
+	return _c
+}
+
+// SetNillableTitleTerms sets the "title_terms" field if the given value is not nil.
+func (_c *SearchDocumentCreate) SetNillableTitleTerms(v *string) *SearchDocumentCreate {
+	if v != nil {
+		_c.SetTitleTerms(*v)
+	}
+	return _c
+}
+
+// SetTerms sets the "terms" field.
+func (_c *SearchDocumentCreate) SetTerms(v string) *SearchDocumentCreate {
+	_c.mutation.SetTerms(v)
+	return _c
+}
+
+// SetNillableTerms sets the "terms" field if the given value is not nil.
+func (_c *SearchDocumentCreate) SetNillableTerms(v *string) *SearchDocumentCreate {
+	if v != nil {
+		_c.SetTerms(*v)
+	}
+	return _c
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_c *SearchDocumentCreate) SetOwnerID(v int) *SearchDocumentCreate {
+	_c.mutation.SetOwnerID(v)
+	return _c
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_c *SearchDocumentCreate) SetNillableOwnerID(v *int) *SearchDocumentCreate {
+	if v != nil {
+		_c.SetOwnerID(*v)
+	}
+	return _c
+}
+
+// SetAssigneeID sets the "assignee_id" field.
+func (_c *SearchDocumentCreate) SetAssigneeID(v int) *SearchDocumentCreate {
+	_c.mutation.SetAssigneeID(v)
+	return _c
+}
+
+// SetNillableAssigneeID sets the "assignee_id" field if the given value is not nil.
+func (_c *SearchDocumentCreate) SetNillableAssigneeID(v *int) *SearchDocumentCreate {
+	if v != nil {
+		_c.SetAssigneeID(*v)
+	}
+	return _c
+}
+
+// SetRestricted sets the "restricted" field.
+func (_c *SearchDocumentCreate) SetRestricted(v bool) *SearchDocumentCreate {
+	_c.mutation.SetRestricted(v)
+	return _c
+}
+
+// SetNillableRestricted sets the "restricted" field if the given value is not nil.
+func (_c *SearchDocumentCreate) SetNillableRestricted(v *bool) *SearchDocumentCreate {
+	if v != nil {
+		_c.SetRestricted(*v)
+	}
+	return _c
+}
+
+// SetSourceUpdatedAt sets the "source_updated_at" field.
+func (_c *SearchDocumentCreate) SetSourceUpdatedAt(v time.Time) *SearchDocumentCreate {
+	_c.mutation.SetSourceUpdatedAt(v)
+	return _c
+}
+
+// SetNillableSourceUpdatedAt sets the "source_updated_at" field if the given value is not nil.
+func (_c *SearchDocumentCreate) SetNillableSourceUpdatedAt(v *time.Time) *SearchDocumentCreate {
+	if v != nil {
+		_c.SetSourceUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SearchDocumentCreate) SetCreatedAt(v time.Time) *SearchDocumentCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SearchDocumentCreate) SetNillableCreatedAt(v *time.Time) *SearchDocumentCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SearchDocumentCreate) SetUpdatedAt(v time.Time) *SearchDocumentCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *SearchDocumentCreate) SetNillableUpdatedAt(v *time.Time) *SearchDocumentCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the SearchDocumentMutation object of the builder.
+func (_c *SearchDocumentCreate) Mutation() *SearchDocumentMutation {
+	return _c.mutation
+}
+
+// Save creates the SearchDocument in the database.
+func (_c *SearchDocumentCreate) Save(ctx context.Context) (*SearchDocument, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SearchDocumentCreate) SaveX(ctx context.Context) *SearchDocument {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SearchDocumentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SearchDocumentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SearchDocumentCreate) defaults() {
+	if _, ok := _c.mutation.Title(); !ok {
+		v := searchdocument.DefaultTitle
+		_c.mutation.SetTitle(v)
+	}
+	if _, ok := _c.mutation.Body(); !ok {
+		v := searchdocument.DefaultBody
+		_c.mutation.SetBody(v)
+	}
+	if _, ok := _c.mutation.TitleTerms(); !ok {
+		v := searchdocument.DefaultTitleTerms
+		_c.mutation.SetTitleTerms(v)
+	}
+	if _, ok := _c.mutation.Terms(); !ok {
+		v := searchdocument.DefaultTerms
+		_c.mutation.SetTerms(v)
+	}
+	if _, ok := _c.mutation.Restricted(); !ok {
+		v := searchdocument.DefaultRestricted
+		_c.mutation.SetRestricted(v)
+	}
+	if _, ok := _c.mutation.SourceUpdatedAt(); !ok {
+		v := searchdocument.DefaultSourceUpdatedAt()
+		_c.mutation.SetSourceUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := searchdocument.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := searchdocument.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SearchDocumentCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "SearchDocument.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := searchdocument.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "SearchDocument.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EntityType(); !ok {
+		return &ValidationError{Name: "entity_type", err: errors.New(`ent: missing required field "SearchDocument.entity_type"`)}
+	}
+	if v, ok := _c.mutation.EntityType(); ok {
+		if err := searchdocument.EntityTypeValidator(v); err != nil {
+			return &ValidationError{Name: "entity_type", err: fmt.Errorf(`ent: validator failed for field "SearchDocument.entity_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EntityID(); !ok {
+		return &ValidationError{Name: "entity_id", err: errors.New(`ent: missing required field "SearchDocument.entity_id"`)}
+	}
+	if v, ok := _c.mutation.EntityID(); ok {
+		if err := searchdocument.EntityIDValidator(v); err != nil {
+			return &ValidationError{Name: "entity_id", err: fmt.Errorf(`ent: validator failed for field "SearchDocument.entity_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "SearchDocument.title"`)}
+	}
+	if _, ok := _c.mutation.Body(); !ok {
+		return &ValidationError{Name: "body", err: errors.New(`ent: missing required field "SearchDocument.body"`)}
+	}
+	if _, ok := _c.mutation.TitleTerms(); !ok {
+		return &ValidationError{Name: "title_terms", err: errors.New(`ent: missing required field "SearchDocument.title_terms"`)}
+	}
+	if _, ok := _c.mutation.Terms(); !ok {
+		return &ValidationError{Name: "terms", err: errors.New(`ent: missing required field "SearchDocument.terms"`)}
+	}
+	if _, ok := _c.mutation.Restricted(); !ok {
+		return &ValidationError{Name: "restricted", err: errors.New(`ent: missing required field "SearchDocument.restricted"`)}
+	}
+	if _, ok := _c.mutation.SourceUpdatedAt(); !ok {
+		return &ValidationError{Name: "source_updated_at", err: errors.New(`ent: missing required field "SearchDocument.source_updated_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SearchDocument.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SearchDocument.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *SearchDocumentCreate) sqlSave(ctx context.Context) (*SearchDocument, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SearchDocumentCreate) createSpec() (*SearchDocument, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SearchDocument{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(searchdocument.Table, sqlgraph.NewFieldSpec(searchdocument.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(searchdocument.FieldTenantID, field.TypeInt, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.EntityType(); ok {
+		_spec.SetField(searchdocument.FieldEntityType, field.TypeString, value)
+		_node.EntityType = value
+	}
+	if value, ok := _c.mutation.EntityID(); ok {
+		_spec.SetField(searchdocument.FieldEntityID, field.TypeInt, value)
+		_node.EntityID = value
+	}
+	if value, ok := _c.mutation.ParentID(); ok {
+		_spec.SetField(searchdocument.FieldParentID, field.TypeInt, value)
+		_node.ParentID = value
+	}
+	if value, ok := _c.mutation.Reference(); ok {
+		_spec.SetField(searchdocument.FieldReference, field.TypeString, value)
+		_node.Reference = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(searchdocument.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Body(); ok {
+		_spec.SetField(searchdocument.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := _c.mutation.TitleTerms(); ok {
+		_spec.SetField(searchdocument.FieldTitleTerms, field.TypeString, value)
+		_node.TitleTerms = value
+	}
+	if value, ok := _c.mutation.Terms(); ok {
+		_spec.SetField(searchdocument.FieldTerms, field.TypeString, value)
+		_node.Terms = value
+	}
+	if value, ok := _c.mutation.OwnerID(); ok {
+		_spec.SetField(searchdocument.FieldOwnerID, field.TypeInt, value)
+		_node.OwnerID = value
+	}
+	if value, ok := _c.mutation.AssigneeID(); ok {
+		_spec.SetField(searchdocument.FieldAssigneeID, field.TypeInt, value)
+		_node.AssigneeID = value
+	}
+	if value, ok := _c.mutation.Restricted(); ok {
+		_spec.SetField(searchdocument.FieldRestricted, field.TypeBool, value)
+		_node.Restricted = value
+	}
+	if value, ok := _c.mutation.SourceUpdatedAt(); ok {
+		_spec.SetField(searchdocument.FieldSourceUpdatedAt, field.TypeTime, value)
+		_node.SourceUpdatedAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(searchdocument.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(searchdocument.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// SearchDocumentCreateBulk is the builder for creating many SearchDocument entities in bulk.
+type SearchDocumentCreateBulk struct {
+	config
+	err      error
+	builders []*SearchDocumentCreate
+}
+
+// Save creates the SearchDocument entities in the database.
+func (_c *SearchDocumentCreateBulk) Save(ctx context.Context) ([]*SearchDocument, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SearchDocument, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SearchDocumentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SearchDocumentCreateBulk) SaveX(ctx context.Context) []*SearchDocument {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SearchDocumentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SearchDocumentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
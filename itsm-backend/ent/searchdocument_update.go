@@ -0,0 +1,906 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/predicate"
+	"itsm-backend/ent/searchdocument"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SearchDocumentUpdate is the builder for updating SearchDocument entities.
+type SearchDocumentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SearchDocumentMutation
+}
+
+// Where appends a list predicates to the SearchDocumentUpdate builder.
+func (_u *SearchDocumentUpdate) Where(ps ...predicate.SearchDocument) *SearchDocumentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *SearchDocumentUpdate) SetTenantID(v int) *SearchDocumentUpdate {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *SearchDocumentUpdate) SetNillableTenantID(v *int) *SearchDocumentUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *SearchDocumentUpdate) AddTenantID(v int) *SearchDocumentUpdate {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetEntityType sets the "entity_type" field.
+func (_u *SearchDocumentUpdate) SetEntityType(v string) *SearchDocumentUpdate {
+	_u.mutation.SetEntityType(v)
+	return _u
+}
+
+// SetNillableEntityType sets the "entity_type" field if the given value is not nil.
+func (_u *SearchDocumentUpdate) SetNillableEntityType(v *string) *SearchDocumentUpdate {
+	if v != nil {
+		_u.SetEntityType(*v)
+	}
+	return _u
+}
+
+// SetEntityID sets the "entity_id" field.
+func (_u *SearchDocumentUpdate) SetEntityID(v int) *SearchDocumentUpdate {
+	_u.mutation.ResetEntityID()
+	_u.mutation.SetEntityID(v)
+	return _u
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (_u *SearchDocumentUpdate) SetNillableEntityID(v *int) *SearchDocumentUpdate {
+	if v != nil {
+		_u.SetEntityID(*v)
+	}
+	return _u
+}
+
+// AddEntityID adds value to the "entity_id" field.
+func (_u *SearchDocumentUpdate) AddEntityID(v int) *SearchDocumentUpdate {
+	_u.mutation.AddEntityID(v)
+	return _u
+}
+
+// SetParentID sets the "parent_id" field.
+func (_u *SearchDocumentUpdate) SetParentID(v int) *SearchDocumentUpdate {
+	_u.mutation.ResetParentID()
+	_u.mutation.SetParentID(v)
+	return _u
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_u *SearchDocumentUpdate) SetNillableParentID(v *int) *SearchDocumentUpdate {
+	if v != nil {
+		_u.SetParentID(*v)
+	}
+	return _u
+}
+
+// AddParentID adds value to the "parent_id" field.
+func (_u *SearchDocumentUpdate) AddParentID(v int) *SearchDocumentUpdate {
+	_u.mutation.AddParentID(v)
+	return _u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (_u *SearchDocumentUpdate) ClearParentID() *SearchDocumentUpdate {
+	_u.mutation.ClearParentID()
+	return _u
+}
+
+// SetReference sets the "reference" field.
+func (_u *SearchDocumentUpdate) SetReference(v string) *SearchDocumentUpdate {
+	_u.mutation.SetReference(v)
+	return _u
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (_u *SearchDocumentUpdate) SetNillableReference(v *string) *SearchDocumentUpdate {
+	if v != nil {
+		_u.SetReference(*v)
+	}
+	return _u
+}
+
+// ClearReference clears the value of the "reference" field.
+func (_u *SearchDocumentUpdate) ClearReference() *SearchDocumentUpdate {
+	_u.mutation.ClearReference()
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *SearchDocumentUpdate) SetTitle(v string) *SearchDocumentUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *SearchDocumentUpdate) SetNillableTitle(v *string) *SearchDocumentUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetBody sets the "body" field.
+func (_u *SearchDocumentUpdate) SetBody(v string) *SearchDocumentUpdate {
+	_u.mutation.SetBody(v)
+	return _u
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_u *SearchDocumentUpdate) SetNillableBody(v *string) *SearchDocumentUpdate {
+	if v != nil {
+		_u.SetBody(*v)
+	}
+	return _u
+}
+
+// SetTitleTerms sets the "title_terms" field.
+func (_u *SearchDocumentUpdate) SetTitleTerms(v string) *SearchDocumentUpdate {
+	_u.mutation.SetTitleTerms(v)
+	return _u
+}
+
+// SetNillableTitleTerms sets the "title_terms" field if the given value is not nil.
+func (_u *SearchDocumentUpdate) SetNillableTitleTerms(v *string) *SearchDocumentUpdate {
+	if v != nil {
+		_u.SetTitleTerms(*v)
+	}
+	return _u
+}
+
+// SetTerms sets the "terms" field.
+func (_u *SearchDocumentUpdate) SetTerms(v string) *SearchDocumentUpdate {
+	_u.mutation.SetTerms(v)
+	return _u
+}
+
+// SetNillableTerms sets the "terms" field if the given value is not nil.
+func (_u *SearchDocumentUpdate) SetNillableTerms(v *string) *SearchDocumentUpdate {
+	if v != nil {
+		_u.SetTerms(*v)
+	}
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *SearchDocumentUpdate) SetOwnerID(v int) *SearchDocumentUpdate {
+	_u.mutation.ResetOwnerID()
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *SearchDocumentUpdate) SetNillableOwnerID(v *int) *SearchDocumentUpdate {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// AddOwnerID adds value to the "owner_id" field.
+func (_u *SearchDocumentUpdate) AddOwnerID(v int) *SearchDocumentUpdate {
+	_u.mutation.AddOwnerID(v)
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *SearchDocumentUpdate) ClearOwnerID() *SearchDocumentUpdate {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetAssigneeID sets the "assignee_id" field.
+func (_u *SearchDocumentUpdate) SetAssigneeID(v int) *SearchDocumentUpdate {
+	_u.mutation.ResetAssigneeID()
+	_u.mutation.SetAssigneeID(v)
+	return _u
+}
+
+// SetNillableAssigneeID sets the "assignee_id" field if the given value is not nil.
+func (_u *SearchDocumentUpdate) SetNillableAssigneeID(v *int) *SearchDocumentUpdate {
+	if v != nil {
+		_u.SetAssigneeID(*v)
+	}
+	return _u
+}
+
+// AddAssigneeID adds value to the "assignee_id" field.
+func (_u *SearchDocumentUpdate) AddAssigneeID(v int) *SearchDocumentUpdate {
+	_u.mutation.AddAssigneeID(v)
+	return _u
+}
+
+// ClearAssigneeID clears the value of the "assignee_id" field.
+func (_u *SearchDocumentUpdate) ClearAssigneeID() *SearchDocumentUpdate {
+	_u.mutation.ClearAssigneeID()
+	return _u
+}
+
+// SetRestricted sets the "restricted" field.
+func (_u *SearchDocumentUpdate) SetRestricted(v bool) *SearchDocumentUpdate {
+	_u.mutation.SetRestricted(v)
+	return _u
+}
+
+// SetNillableRestricted sets the "restricted" field if the given value is not nil.
+func (_u *SearchDocumentUpdate) SetNillableRestricted(v *bool) *SearchDocumentUpdate {
+	if v != nil {
+		_u.SetRestricted(*v)
+	}
+	return _u
+}
+
+// SetSourceUpdatedAt sets the "source_updated_at" field.
+func (_u *SearchDocumentUpdate) SetSourceUpdatedAt(v time.Time) *SearchDocumentUpdate {
+	_u.mutation.SetSourceUpdatedAt(v)
+	return _u
+}
+
+// SetNillableSourceUpdatedAt sets the "source_updated_at" field if the given value is not nil.
+func (_u *SearchDocumentUpdate) SetNillableSourceUpdatedAt(v *time.Time) *SearchDocumentUpdate {
+	if v != nil {
+		_u.SetSourceUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *SearchDocumentUpdate) SetCreatedAt(v time.Time) *SearchDocumentUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *SearchDocumentUpdate) SetNillableCreatedAt(v *time.Time) *SearchDocumentUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SearchDocumentUpdate) SetUpdatedAt(v time.Time) *SearchDocumentUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the SearchDocumentMutation object of the builder.
+func (_u *SearchDocumentUpdate) Mutation() *SearchDocumentMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SearchDocumentUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SearchDocumentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SearchDocumentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SearchDocumentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SearchDocumentUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := searchdocument.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SearchDocumentUpdate) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := searchdocument.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "SearchDocument.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EntityType(); ok {
+		if err := searchdocument.EntityTypeValidator(v); err != nil {
+			return &ValidationError{Name: "entity_type", err: fmt.Errorf(`ent: validator failed for field "SearchDocument.entity_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EntityID(); ok {
+		if err := searchdocument.EntityIDValidator(v); err != nil {
+			return &ValidationError{Name: "entity_id", err: fmt.Errorf(`ent: validator failed for field "SearchDocument.entity_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SearchDocumentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(searchdocument.Table, searchdocument.Columns, sqlgraph.NewFieldSpec(searchdocument.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(searchdocument.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(searchdocument.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EntityType(); ok {
+		_spec.SetField(searchdocument.FieldEntityType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EntityID(); ok {
+		_spec.SetField(searchdocument.FieldEntityID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEntityID(); ok {
+		_spec.AddField(searchdocument.FieldEntityID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ParentID(); ok {
+		_spec.SetField(searchdocument.FieldParentID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedParentID(); ok {
+		_spec.AddField(searchdocument.FieldParentID, field.TypeInt, value)
+	}
+	if _u.mutation.ParentIDCleared() {
+		_spec.ClearField(searchdocument.FieldParentID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Reference(); ok {
+		_spec.SetField(searchdocument.FieldReference, field.TypeString, value)
+	}
+	if _u.mutation.ReferenceCleared() {
+		_spec.ClearField(searchdocument.FieldReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(searchdocument.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Body(); ok {
+		_spec.SetField(searchdocument.FieldBody, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TitleTerms(); ok {
+		_spec.SetField(searchdocument.FieldTitleTerms, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Terms(); ok {
+		_spec.SetField(searchdocument.FieldTerms, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(searchdocument.FieldOwnerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOwnerID(); ok {
+		_spec.AddField(searchdocument.FieldOwnerID, field.TypeInt, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(searchdocument.FieldOwnerID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.AssigneeID(); ok {
+		_spec.SetField(searchdocument.FieldAssigneeID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAssigneeID(); ok {
+		_spec.AddField(searchdocument.FieldAssigneeID, field.TypeInt, value)
+	}
+	if _u.mutation.AssigneeIDCleared() {
+		_spec.ClearField(searchdocument.FieldAssigneeID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Restricted(); ok {
+		_spec.SetField(searchdocument.FieldRestricted, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SourceUpdatedAt(); ok {
+		_spec.SetField(searchdocument.FieldSourceUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(searchdocument.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(searchdocument.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{searchdocument.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SearchDocumentUpdateOne is the builder for updating a single SearchDocument entity.
+type SearchDocumentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SearchDocumentMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *SearchDocumentUpdateOne) SetTenantID(v int) *SearchDocumentUpdateOne {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *SearchDocumentUpdateOne) SetNillableTenantID(v *int) *SearchDocumentUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *SearchDocumentUpdateOne) AddTenantID(v int) *SearchDocumentUpdateOne {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetEntityType sets the "entity_type" field.
+func (_u *SearchDocumentUpdateOne) SetEntityType(v string) *SearchDocumentUpdateOne {
+	_u.mutation.SetEntityType(v)
+	return _u
+}
+
+// SetNillableEntityType sets the "entity_type" field if the given value is not nil.
+func (_u *SearchDocumentUpdateOne) SetNillableEntityType(v *string) *SearchDocumentUpdateOne {
+	if v != nil {
+		_u.SetEntityType(*v)
+	}
+	return _u
+}
+
+// SetEntityID sets the "entity_id" field.
+func (_u *SearchDocumentUpdateOne) SetEntityID(v int) *SearchDocumentUpdateOne {
+	_u.mutation.ResetEntityID()
+	_u.mutation.SetEntityID(v)
+	return _u
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (_u *SearchDocumentUpdateOne) SetNillableEntityID(v *int) *SearchDocumentUpdateOne {
+	if v != nil {
+		_u.SetEntityID(*v)
+	}
+	return _u
+}
+
+// AddEntityID adds value to the "entity_id" field.
+func (_u *SearchDocumentUpdateOne) AddEntityID(v int) *SearchDocumentUpdateOne {
+	_u.mutation.AddEntityID(v)
+	return _u
+}
+
+// SetParentID sets the "parent_id" field.
+func (_u *SearchDocumentUpdateOne) SetParentID(v int) *SearchDocumentUpdateOne {
+	_u.mutation.ResetParentID()
+	_u.mutation.SetParentID(v)
+	return _u
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_u *SearchDocumentUpdateOne) SetNillableParentID(v *int) *SearchDocumentUpdateOne {
+	if v != nil {
+		_u.SetParentID(*v)
+	}
+	return _u
+}
+
+// AddParentID adds value to the "parent_id" field.
+func (_u *SearchDocumentUpdateOne) AddParentID(v int) *SearchDocumentUpdateOne {
+	_u.mutation.AddParentID(v)
+	return _u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (_u *SearchDocumentUpdateOne) ClearParentID() *SearchDocumentUpdateOne {
+	_u.mutation.ClearParentID()
+	return _u
+}
+
+// SetReference sets the "reference" field.
+func (_u *SearchDocumentUpdateOne) SetReference(v string) *SearchDocumentUpdateOne {
+	_u.mutation.SetReference(v)
+	return _u
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (_u *SearchDocumentUpdateOne) SetNillableReference(v *string) *SearchDocumentUpdateOne {
+	if v != nil {
+		_u.SetReference(*v)
+	}
+	return _u
+}
+
+// ClearReference clears the value of the "reference" field.
+func (_u *SearchDocumentUpdateOne) ClearReference() *SearchDocumentUpdateOne {
+	_u.mutation.ClearReference()
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *SearchDocumentUpdateOne) SetTitle(v string) *SearchDocumentUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *SearchDocumentUpdateOne) SetNillableTitle(v *string) *SearchDocumentUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetBody sets the "body" field.
+func (_u *SearchDocumentUpdateOne) SetBody(v string) *SearchDocumentUpdateOne {
+	_u.mutation.SetBody(v)
+	return _u
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_u *SearchDocumentUpdateOne) SetNillableBody(v *string) *SearchDocumentUpdateOne {
+	if v != nil {
+		_u.SetBody(*v)
+	}
+	return _u
+}
+
+// SetTitleTerms sets the "title_terms" field.
+func (_u *SearchDocumentUpdateOne) SetTitleTerms(v string) *SearchDocumentUpdateOne {
+	_u.mutation.SetTitleTerms(v)
+	return _u
+}
+
+// SetNillableTitleTerms sets the "title_terms" field if the given value is not nil.
+func (_u *SearchDocumentUpdateOne) SetNillableTitleTerms(v *string) *SearchDocumentUpdateOne {
+	if v != nil {
+		_u.SetTitleTerms(*v)
+	}
+	return _u
+}
+
+// SetTerms sets the "terms" field.
+func (_u *SearchDocumentUpdateOne) SetTerms(v string) *SearchDocumentUpdateOne {
+	_u.mutation.SetTerms(v)
+	return _u
+}
+
+// SetNillableTerms sets the "terms" field if the given value is not nil.
+func (_u *SearchDocumentUpdateOne) SetNillableTerms(v *string) *SearchDocumentUpdateOne {
+	if v != nil {
+		_u.SetTerms(*v)
+	}
+	return _u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (_u *SearchDocumentUpdateOne) SetOwnerID(v int) *SearchDocumentUpdateOne {
+	_u.mutation.ResetOwnerID()
+	_u.mutation.SetOwnerID(v)
+	return _u
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (_u *SearchDocumentUpdateOne) SetNillableOwnerID(v *int) *SearchDocumentUpdateOne {
+	if v != nil {
+		_u.SetOwnerID(*v)
+	}
+	return _u
+}
+
+// AddOwnerID adds value to the "owner_id" field.
+func (_u *SearchDocumentUpdateOne) AddOwnerID(v int) *SearchDocumentUpdateOne {
+	_u.mutation.AddOwnerID(v)
+	return _u
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (_u *SearchDocumentUpdateOne) ClearOwnerID() *SearchDocumentUpdateOne {
+	_u.mutation.ClearOwnerID()
+	return _u
+}
+
+// SetAssigneeID sets the "assignee_id" field.
+func (_u *SearchDocumentUpdateOne) SetAssigneeID(v int) *SearchDocumentUpdateOne {
+	_u.mutation.ResetAssigneeID()
+	_u.mutation.SetAssigneeID(v)
+	return _u
+}
+
+// SetNillableAssigneeID sets the "assignee_id" field if the given value is not nil.
+func (_u *SearchDocumentUpdateOne) SetNillableAssigneeID(v *int) *SearchDocumentUpdateOne {
+	if v != nil {
+		_u.SetAssigneeID(*v)
+	}
+	return _u
+}
+
+// AddAssigneeID adds value to the "assignee_id" field.
+func (_u *SearchDocumentUpdateOne) AddAssigneeID(v int) *SearchDocumentUpdateOne {
+	_u.mutation.AddAssigneeID(v)
+	return _u
+}
+
+// ClearAssigneeID clears the value of the "assignee_id" field.
+func (_u *SearchDocumentUpdateOne) ClearAssigneeID() *SearchDocumentUpdateOne {
+	_u.mutation.ClearAssigneeID()
+	return _u
+}
+
+// SetRestricted sets the "restricted" field.
+func (_u *SearchDocumentUpdateOne) SetRestricted(v bool) *SearchDocumentUpdateOne {
+	_u.mutation.SetRestricted(v)
+	return _u
+}
+
+// SetNillableRestricted sets the "restricted" field if the given value is not nil.
+func (_u *SearchDocumentUpdateOne) SetNillableRestricted(v *bool) *SearchDocumentUpdateOne {
+	if v != nil {
+		_u.SetRestricted(*v)
+	}
+	return _u
+}
+
+// SetSourceUpdatedAt sets the "source_updated_at" field.
+func (_u *SearchDocumentUpdateOne) SetSourceUpdatedAt(v time.Time) *SearchDocumentUpdateOne {
+	_u.mutation.SetSourceUpdatedAt(v)
+	return _u
+}
+
+// SetNillableSourceUpdatedAt sets the "source_updated_at" field if the given value is not nil.
+func (_u *SearchDocumentUpdateOne) SetNillableSourceUpdatedAt(v *time.Time) *SearchDocumentUpdateOne {
+	if v != nil {
+		_u.SetSourceUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *SearchDocumentUpdateOne) SetCreatedAt(v time.Time) *SearchDocumentUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *SearchDocumentUpdateOne) SetNillableCreatedAt(v *time.Time) *SearchDocumentUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SearchDocumentUpdateOne) SetUpdatedAt(v time.Time) *SearchDocumentUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the SearchDocumentMutation object of the builder.
+func (_u *SearchDocumentUpdateOne) Mutation() *SearchDocumentMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SearchDocumentUpdate builder.
+func (_u *SearchDocumentUpdateOne) Where(ps ...predicate.SearchDocument) *SearchDocumentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SearchDocumentUpdateOne) Select(field string, fields ...string) *SearchDocumentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SearchDocument entity.
+func (_u *SearchDocumentUpdateOne) Save(ctx context.Context) (*SearchDocument, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SearchDocumentUpdateOne) SaveX(ctx context.Context) *SearchDocument {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SearchDocumentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SearchDocumentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SearchDocumentUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := searchdocument.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SearchDocumentUpdateOne) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := searchdocument.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "SearchDocument.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EntityType(); ok {
+		if err := searchdocument.EntityTypeValidator(v); err != nil {
+			return &ValidationError{Name: "entity_type", err: fmt.Errorf(`ent: validator failed for field "SearchDocument.entity_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EntityID(); ok {
+		if err := searchdocument.EntityIDValidator(v); err != nil {
+			return &ValidationError{Name: "entity_id", err: fmt.Errorf(`ent: validator failed for field "SearchDocument.entity_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SearchDocumentUpdateOne) sqlSave(ctx context.Context) (_node *SearchDocument, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(searchdocument.Table, searchdocument.Columns, sqlgraph.NewFieldSpec(searchdocument.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SearchDocument.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, searchdocument.FieldID)
+		for _, f := range fields {
+			if !searchdocument.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != searchdocument.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(searchdocument.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(searchdocument.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EntityType(); ok {
+		_spec.SetField(searchdocument.FieldEntityType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EntityID(); ok {
+		_spec.SetField(searchdocument.FieldEntityID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEntityID(); ok {
+		_spec.AddField(searchdocument.FieldEntityID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ParentID(); ok {
+		_spec.SetField(searchdocument.FieldParentID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedParentID(); ok {
+		_spec.AddField(searchdocument.FieldParentID, field.TypeInt, value)
+	}
+	if _u.mutation.ParentIDCleared() {
+		_spec.ClearField(searchdocument.FieldParentID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Reference(); ok {
+		_spec.SetField(searchdocument.FieldReference, field.TypeString, value)
+	}
+	if _u.mutation.ReferenceCleared() {
+		_spec.ClearField(searchdocument.FieldReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(searchdocument.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Body(); ok {
+		_spec.SetField(searchdocument.FieldBody, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TitleTerms(); ok {
+		_spec.SetField(searchdocument.FieldTitleTerms, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Terms(); ok {
+		_spec.SetField(searchdocument.FieldTerms, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OwnerID(); ok {
+		_spec.SetField(searchdocument.FieldOwnerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOwnerID(); ok {
+		_spec.AddField(searchdocument.FieldOwnerID, field.TypeInt, value)
+	}
+	if _u.mutation.OwnerIDCleared() {
+		_spec.ClearField(searchdocument.FieldOwnerID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.AssigneeID(); ok {
+		_spec.SetField(searchdocument.FieldAssigneeID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAssigneeID(); ok {
+		_spec.AddField(searchdocument.FieldAssigneeID, field.TypeInt, value)
+	}
+	if _u.mutation.AssigneeIDCleared() {
+		_spec.ClearField(searchdocument.FieldAssigneeID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Restricted(); ok {
+		_spec.SetField(searchdocument.FieldRestricted, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SourceUpdatedAt(); ok {
+		_spec.SetField(searchdocument.FieldSourceUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(searchdocument.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(searchdocument.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &SearchDocument{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{searchdocument.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
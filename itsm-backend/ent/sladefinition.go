@@ -5,6 +5,7 @@ package ent
 import (
 	"encoding/json"
 	"fmt"
+	"itsm-backend/ent/schema"
 	"itsm-backend/ent/sladefinition"
 	"strings"
 	"time"
@@ -32,6 +33,10 @@ type SLADefinition struct {
 	ResolutionTime int `json:"resolution_time,omitempty"`
 	// 营业时间配置
 	BusinessHours map[string]interface{} `json:"business_hours,omitempty"`
+	// 工作日历ID，设置后替代营业时间配置
+	CalendarID *int `json:"calendar_id,omitempty"`
+	// SLA计时暂停条件
+	PauseConditions *schema.SLAPauseConditions `json:"pause_conditions,omitempty"`
 	// 升级规则
 	EscalationRules map[string]interface{} `json:"escalation_rules,omitempty"`
 	// 适用条件
@@ -107,11 +112,11 @@ func (*SLADefinition) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case sladefinition.FieldBusinessHours, sladefinition.FieldEscalationRules, sladefinition.FieldConditions:
+		case sladefinition.FieldBusinessHours, sladefinition.FieldPauseConditions, sladefinition.FieldEscalationRules, sladefinition.FieldConditions:
 			values[i] = new([]byte)
 		case sladefinition.FieldIsActive:
 			values[i] = new(sql.NullBool)
-		case sladefinition.FieldID, sladefinition.FieldResponseTime, sladefinition.FieldResolutionTime, sladefinition.FieldTenantID:
+		case sladefinition.FieldID, sladefinition.FieldResponseTime, sladefinition.FieldResolutionTime, sladefinition.FieldCalendarID, sladefinition.FieldTenantID:
 			values[i] = new(sql.NullInt64)
 		case sladefinition.FieldName, sladefinition.FieldDescription, sladefinition.FieldServiceType, sladefinition.FieldPriority:
 			values[i] = new(sql.NullString)
@@ -184,6 +189,21 @@ func (_m *SLADefinition) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field business_hours: %w", err)
 				}
 			}
+		case sladefinition.FieldCalendarID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field calendar_id", values[i])
+			} else if value.Valid {
+				_m.CalendarID = new(int)
+				*_m.CalendarID = int(value.Int64)
+			}
+		case sladefinition.FieldPauseConditions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field pause_conditions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.PauseConditions); err != nil {
+					return fmt.Errorf("unmarshal field pause_conditions: %w", err)
+				}
+			}
 		case sladefinition.FieldEscalationRules:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field escalation_rules", values[i])
@@ -308,6 +328,14 @@ func (_m *SLADefinition) String() string {
 	builder.WriteString("business_hours=")
 	builder.WriteString(fmt.Sprintf("%v", _m.BusinessHours))
 	builder.WriteString(", ")
+	if v := _m.CalendarID; v != nil {
+		builder.WriteString("calendar_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("pause_conditions=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PauseConditions))
+	builder.WriteString(", ")
 	builder.WriteString("escalation_rules=")
 	builder.WriteString(fmt.Sprintf("%v", _m.EscalationRules))
 	builder.WriteString(", ")
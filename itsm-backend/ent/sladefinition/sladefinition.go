@@ -28,6 +28,10 @@ const (
 	FieldResolutionTime = "resolution_time"
 	// FieldBusinessHours holds the string denoting the business_hours field in the database.
 	FieldBusinessHours = "business_hours"
+	// FieldCalendarID holds the string denoting the calendar_id field in the database.
+	FieldCalendarID = "calendar_id"
+	// FieldPauseConditions holds the string denoting the pause_conditions field in the database.
+	FieldPauseConditions = "pause_conditions"
 	// FieldEscalationRules holds the string denoting the escalation_rules field in the database.
 	FieldEscalationRules = "escalation_rules"
 	// FieldConditions holds the string denoting the conditions field in the database.
@@ -90,6 +94,8 @@ var Columns = []string{
 	FieldResponseTime,
 	FieldResolutionTime,
 	FieldBusinessHours,
+	FieldCalendarID,
+	FieldPauseConditions,
 	FieldEscalationRules,
 	FieldConditions,
 	FieldIsActive,
@@ -180,6 +186,11 @@ func ByResolutionTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldResolutionTime, opts...).ToFunc()
 }
 
+// ByCalendarID orders the results by the calendar_id field.
+func ByCalendarID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCalendarID, opts...).ToFunc()
+}
+
 // ByIsActive orders the results by the is_active field.
 func ByIsActive(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsActive, opts...).ToFunc()
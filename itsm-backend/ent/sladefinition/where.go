@@ -85,6 +85,11 @@ func ResolutionTime(v int) predicate.SLADefinition {
 	return predicate.SLADefinition(sql.FieldEQ(FieldResolutionTime, v))
 }
 
+// CalendarID applies equality check predicate on the "calendar_id" field. It's identical to CalendarIDEQ.
+func CalendarID(v int) predicate.SLADefinition {
+	return predicate.SLADefinition(sql.FieldEQ(FieldCalendarID, v))
+}
+
 // IsActive applies equality check predicate on the "is_active" field. It's identical to IsActiveEQ.
 func IsActive(v bool) predicate.SLADefinition {
 	return predicate.SLADefinition(sql.FieldEQ(FieldIsActive, v))
@@ -485,6 +490,66 @@ func BusinessHoursNotNil() predicate.SLADefinition {
 	return predicate.SLADefinition(sql.FieldNotNull(FieldBusinessHours))
 }
 
+// CalendarIDEQ applies the EQ predicate on the "calendar_id" field.
+func CalendarIDEQ(v int) predicate.SLADefinition {
+	return predicate.SLADefinition(sql.FieldEQ(FieldCalendarID, v))
+}
+
+// CalendarIDNEQ applies the NEQ predicate on the "calendar_id" field.
+func CalendarIDNEQ(v int) predicate.SLADefinition {
+	return predicate.SLADefinition(sql.FieldNEQ(FieldCalendarID, v))
+}
+
+// CalendarIDIn applies the In predicate on the "calendar_id" field.
+func CalendarIDIn(vs ...int) predicate.SLADefinition {
+	return predicate.SLADefinition(sql.FieldIn(FieldCalendarID, vs...))
+}
+
+// CalendarIDNotIn applies the NotIn predicate on the "calendar_id" field.
+func CalendarIDNotIn(vs ...int) predicate.SLADefinition {
+	return predicate.SLADefinition(sql.FieldNotIn(FieldCalendarID, vs...))
+}
+
+// CalendarIDGT applies the GT predicate on the "calendar_id" field.
+func CalendarIDGT(v int) predicate.SLADefinition {
+	return predicate.SLADefinition(sql.FieldGT(FieldCalendarID, v))
+}
+
+// CalendarIDGTE applies the GTE predicate on the "calendar_id" field.
+func CalendarIDGTE(v int) predicate.SLADefinition {
+	return predicate.SLADefinition(sql.FieldGTE(FieldCalendarID, v))
+}
+
+// CalendarIDLT applies the LT predicate on the "calendar_id" field.
+func CalendarIDLT(v int) predicate.SLADefinition {
+	return predicate.SLADefinition(sql.FieldLT(FieldCalendarID, v))
+}
+
+// CalendarIDLTE applies the LTE predicate on the "calendar_id" field.
+func CalendarIDLTE(v int) predicate.SLADefinition {
+	return predicate.SLADefinition(sql.FieldLTE(FieldCalendarID, v))
+}
+
+// CalendarIDIsNil applies the IsNil predicate on the "calendar_id" field.
+func CalendarIDIsNil() predicate.SLADefinition {
+	return predicate.SLADefinition(sql.FieldIsNull(FieldCalendarID))
+}
+
+// CalendarIDNotNil applies the NotNil predicate on the "calendar_id" field.
+func CalendarIDNotNil() predicate.SLADefinition {
+	return predicate.SLADefinition(sql.FieldNotNull(FieldCalendarID))
+}
+
+// PauseConditionsIsNil applies the IsNil predicate on the "pause_conditions" field.
+func PauseConditionsIsNil() predicate.SLADefinition {
+	return predicate.SLADefinition(sql.FieldIsNull(FieldPauseConditions))
+}
+
+// PauseConditionsNotNil applies the NotNil predicate on the "pause_conditions" field.
+func PauseConditionsNotNil() predicate.SLADefinition {
+	return predicate.SLADefinition(sql.FieldNotNull(FieldPauseConditions))
+}
+
 // EscalationRulesIsNil applies the IsNil predicate on the "escalation_rules" field.
 func EscalationRulesIsNil() predicate.SLADefinition {
 	return predicate.SLADefinition(sql.FieldIsNull(FieldEscalationRules))
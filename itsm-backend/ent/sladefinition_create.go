@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"itsm-backend/ent/schema"
 	"itsm-backend/ent/slaalertrule"
 	"itsm-backend/ent/sladefinition"
 	"itsm-backend/ent/slametric"
@@ -106,6 +107,26 @@ func (_c *SLADefinitionCreate) SetBusinessHours(v map[string]interface{}) *SLADe
 	return _c
 }
 
+// SetCalendarID sets the "calendar_id" field.
+func (_c *SLADefinitionCreate) SetCalendarID(v int) *SLADefinitionCreate {
+	_c.mutation.SetCalendarID(v)
+	return _c
+}
+
+// SetNillableCalendarID sets the "calendar_id" field if the given value is not nil.
+func (_c *SLADefinitionCreate) SetNillableCalendarID(v *int) *SLADefinitionCreate {
+	if v != nil {
+		_c.SetCalendarID(*v)
+	}
+	return _c
+}
+
+// SetPauseConditions sets the "pause_conditions" field.
+func (_c *SLADefinitionCreate) SetPauseConditions(v *schema.SLAPauseConditions) *SLADefinitionCreate {
+	_c.mutation.SetPauseConditions(v)
+	return _c
+}
+
 // SetEscalationRules sets the "escalation_rules" field.
 func (_c *SLADefinitionCreate) SetEscalationRules(v map[string]interface{}) *SLADefinitionCreate {
 	_c.mutation.SetEscalationRules(v)
@@ -380,6 +401,14 @@ func (_c *SLADefinitionCreate) createSpec() (*SLADefinition, *sqlgraph.CreateSpe
 		_spec.SetField(sladefinition.FieldBusinessHours, field.TypeJSON, value)
 		_node.BusinessHours = value
 	}
+	if value, ok := _c.mutation.CalendarID(); ok {
+		_spec.SetField(sladefinition.FieldCalendarID, field.TypeInt, value)
+		_node.CalendarID = &value
+	}
+	if value, ok := _c.mutation.PauseConditions(); ok {
+		_spec.SetField(sladefinition.FieldPauseConditions, field.TypeJSON, value)
+		_node.PauseConditions = value
+	}
 	if value, ok := _c.mutation.EscalationRules(); ok {
 		_spec.SetField(sladefinition.FieldEscalationRules, field.TypeJSON, value)
 		_node.EscalationRules = value
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"itsm-backend/ent/predicate"
+	"itsm-backend/ent/schema"
 	"itsm-backend/ent/slaalertrule"
 	"itsm-backend/ent/sladefinition"
 	"itsm-backend/ent/slametric"
@@ -160,6 +161,45 @@ func (_u *SLADefinitionUpdate) ClearBusinessHours() *SLADefinitionUpdate {
 	return _u
 }
 
+// SetCalendarID sets the "calendar_id" field.
+func (_u *SLADefinitionUpdate) SetCalendarID(v int) *SLADefinitionUpdate {
+	_u.mutation.ResetCalendarID()
+	_u.mutation.SetCalendarID(v)
+	return _u
+}
+
+// SetNillableCalendarID sets the "calendar_id" field if the given value is not nil.
+func (_u *SLADefinitionUpdate) SetNillableCalendarID(v *int) *SLADefinitionUpdate {
+	if v != nil {
+		_u.SetCalendarID(*v)
+	}
+	return _u
+}
+
+// AddCalendarID adds value to the "calendar_id" field.
+func (_u *SLADefinitionUpdate) AddCalendarID(v int) *SLADefinitionUpdate {
+	_u.mutation.AddCalendarID(v)
+	return _u
+}
+
+// ClearCalendarID clears the value of the "calendar_id" field.
+func (_u *SLADefinitionUpdate) ClearCalendarID() *SLADefinitionUpdate {
+	_u.mutation.ClearCalendarID()
+	return _u
+}
+
+// SetPauseConditions sets the "pause_conditions" field.
+func (_u *SLADefinitionUpdate) SetPauseConditions(v *schema.SLAPauseConditions) *SLADefinitionUpdate {
+	_u.mutation.SetPauseConditions(v)
+	return _u
+}
+
+// ClearPauseConditions clears the value of the "pause_conditions" field.
+func (_u *SLADefinitionUpdate) ClearPauseConditions() *SLADefinitionUpdate {
+	_u.mutation.ClearPauseConditions()
+	return _u
+}
+
 // SetEscalationRules sets the "escalation_rules" field.
 func (_u *SLADefinitionUpdate) SetEscalationRules(v map[string]interface{}) *SLADefinitionUpdate {
 	_u.mutation.SetEscalationRules(v)
@@ -500,6 +540,21 @@ func (_u *SLADefinitionUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if _u.mutation.BusinessHoursCleared() {
 		_spec.ClearField(sladefinition.FieldBusinessHours, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.CalendarID(); ok {
+		_spec.SetField(sladefinition.FieldCalendarID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCalendarID(); ok {
+		_spec.AddField(sladefinition.FieldCalendarID, field.TypeInt, value)
+	}
+	if _u.mutation.CalendarIDCleared() {
+		_spec.ClearField(sladefinition.FieldCalendarID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.PauseConditions(); ok {
+		_spec.SetField(sladefinition.FieldPauseConditions, field.TypeJSON, value)
+	}
+	if _u.mutation.PauseConditionsCleared() {
+		_spec.ClearField(sladefinition.FieldPauseConditions, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.EscalationRules(); ok {
 		_spec.SetField(sladefinition.FieldEscalationRules, field.TypeJSON, value)
 	}
@@ -855,6 +910,45 @@ func (_u *SLADefinitionUpdateOne) ClearBusinessHours() *SLADefinitionUpdateOne {
 	return _u
 }
 
+// SetCalendarID sets the "calendar_id" field.
+func (_u *SLADefinitionUpdateOne) SetCalendarID(v int) *SLADefinitionUpdateOne {
+	_u.mutation.ResetCalendarID()
+	_u.mutation.SetCalendarID(v)
+	return _u
+}
+
+// SetNillableCalendarID sets the "calendar_id" field if the given value is not nil.
+func (_u *SLADefinitionUpdateOne) SetNillableCalendarID(v *int) *SLADefinitionUpdateOne {
+	if v != nil {
+		_u.SetCalendarID(*v)
+	}
+	return _u
+}
+
+// AddCalendarID adds value to the "calendar_id" field.
+func (_u *SLADefinitionUpdateOne) AddCalendarID(v int) *SLADefinitionUpdateOne {
+	_u.mutation.AddCalendarID(v)
+	return _u
+}
+
+// ClearCalendarID clears the value of the "calendar_id" field.
+func (_u *SLADefinitionUpdateOne) ClearCalendarID() *SLADefinitionUpdateOne {
+	_u.mutation.ClearCalendarID()
+	return _u
+}
+
+// SetPauseConditions sets the "pause_conditions" field.
+func (_u *SLADefinitionUpdateOne) SetPauseConditions(v *schema.SLAPauseConditions) *SLADefinitionUpdateOne {
+	_u.mutation.SetPauseConditions(v)
+	return _u
+}
+
+// ClearPauseConditions clears the value of the "pause_conditions" field.
+func (_u *SLADefinitionUpdateOne) ClearPauseConditions() *SLADefinitionUpdateOne {
+	_u.mutation.ClearPauseConditions()
+	return _u
+}
+
 // SetEscalationRules sets the "escalation_rules" field.
 func (_u *SLADefinitionUpdateOne) SetEscalationRules(v map[string]interface{}) *SLADefinitionUpdateOne {
 	_u.mutation.SetEscalationRules(v)
@@ -1225,6 +1319,21 @@ func (_u *SLADefinitionUpdateOne) sqlSave(ctx context.Context) (_node *SLADefini
 	if _u.mutation.BusinessHoursCleared() {
 		_spec.ClearField(sladefinition.FieldBusinessHours, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.CalendarID(); ok {
+		_spec.SetField(sladefinition.FieldCalendarID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCalendarID(); ok {
+		_spec.AddField(sladefinition.FieldCalendarID, field.TypeInt, value)
+	}
+	if _u.mutation.CalendarIDCleared() {
+		_spec.ClearField(sladefinition.FieldCalendarID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.PauseConditions(); ok {
+		_spec.SetField(sladefinition.FieldPauseConditions, field.TypeJSON, value)
+	}
+	if _u.mutation.PauseConditionsCleared() {
+		_spec.ClearField(sladefinition.FieldPauseConditions, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.EscalationRules(); ok {
 		_spec.SetField(sladefinition.FieldEscalationRules, field.TypeJSON, value)
 	}
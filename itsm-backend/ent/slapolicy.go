@@ -5,7 +5,10 @@ package ent
 import (
 	"encoding/json"
 	"fmt"
+	"itsm-backend/ent/schema"
 	"itsm-backend/ent/slapolicy"
+	"itsm-backend/ent/team"
+	"itsm-backend/ent/vendor"
 	"strings"
 	"time"
 
@@ -28,12 +31,22 @@ type SLAPolicy struct {
 	TicketType string `json:"ticket_type,omitempty"`
 	// 优先级: critical/high/medium/low
 	Priority string `json:"priority,omitempty"`
+	// 协议类型: sla(客户服务级别协议)/ola(内部运营级别协议)/uc(供应商支撑合同)
+	AgreementType string `json:"agreement_type,omitempty"`
+	// OLA 适用的处理团队ID
+	TeamID *int `json:"team_id,omitempty"`
+	// UC 适用的供应商ID
+	VendorID *int `json:"vendor_id,omitempty"`
+	// 计时指标，为空时按响应/解决时间生成首次响应与解决两个指标
+	Metrics []schema.SLAMetricDefinition `json:"metrics,omitempty"`
 	// 响应时间(分钟)
 	ResponseTimeMinutes int `json:"response_time_minutes,omitempty"`
 	// 解决时间(分钟)
 	ResolutionTimeMinutes int `json:"resolution_time_minutes,omitempty"`
 	// 业务时间配置: 工作日、时段、时区
 	BusinessHours map[string]interface{} `json:"business_hours,omitempty"`
+	// 工作日历ID，设置后替代 business_hours 与排除周末/节假日配置
+	CalendarID *int `json:"calendar_id,omitempty"`
 	// 是否排除周末
 	ExcludeWeekends bool `json:"exclude_weekends,omitempty"`
 	// 是否排除节假日
@@ -62,9 +75,13 @@ type SLAPolicyEdges struct {
 	SLADefinition []*SLADefinition `json:"sla_definition,omitempty"`
 	// 应用此策略的工单
 	Tickets []*Ticket `json:"tickets,omitempty"`
+	// OLA 适用的处理团队
+	Team *Team `json:"team,omitempty"`
+	// UC 适用的供应商
+	Vendor *Vendor `json:"vendor,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [4]bool
 }
 
 // SLADefinitionOrErr returns the SLADefinition value or an error if the edge
@@ -85,18 +102,40 @@ func (e SLAPolicyEdges) TicketsOrErr() ([]*Ticket, error) {
 	return nil, &NotLoadedError{edge: "tickets"}
 }
 
+// TeamOrErr returns the Team value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SLAPolicyEdges) TeamOrErr() (*Team, error) {
+	if e.Team != nil {
+		return e.Team, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: team.Label}
+	}
+	return nil, &NotLoadedError{edge: "team"}
+}
+
+// VendorOrErr returns the Vendor value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SLAPolicyEdges) VendorOrErr() (*Vendor, error) {
+	if e.Vendor != nil {
+		return e.Vendor, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: vendor.Label}
+	}
+	return nil, &NotLoadedError{edge: "vendor"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*SLAPolicy) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case slapolicy.FieldBusinessHours, slapolicy.FieldEscalationRules:
+		case slapolicy.FieldMetrics, slapolicy.FieldBusinessHours, slapolicy.FieldEscalationRules:
 			values[i] = new([]byte)
 		case slapolicy.FieldExcludeWeekends, slapolicy.FieldExcludeHolidays, slapolicy.FieldIsActive:
 			values[i] = new(sql.NullBool)
-		case slapolicy.FieldID, slapolicy.FieldResponseTimeMinutes, slapolicy.FieldResolutionTimeMinutes, slapolicy.FieldPriorityScore, slapolicy.FieldTenantID:
+		case slapolicy.FieldID, slapolicy.FieldTeamID, slapolicy.FieldVendorID, slapolicy.FieldResponseTimeMinutes, slapolicy.FieldResolutionTimeMinutes, slapolicy.FieldCalendarID, slapolicy.FieldPriorityScore, slapolicy.FieldTenantID:
 			values[i] = new(sql.NullInt64)
-		case slapolicy.FieldName, slapolicy.FieldDescription, slapolicy.FieldCustomerTier, slapolicy.FieldTicketType, slapolicy.FieldPriority:
+		case slapolicy.FieldName, slapolicy.FieldDescription, slapolicy.FieldCustomerTier, slapolicy.FieldTicketType, slapolicy.FieldPriority, slapolicy.FieldAgreementType:
 			values[i] = new(sql.NullString)
 		case slapolicy.FieldCreatedAt, slapolicy.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -151,6 +190,34 @@ func (_m *SLAPolicy) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Priority = value.String
 			}
+		case slapolicy.FieldAgreementType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field agreement_type", values[i])
+			} else if value.Valid {
+				_m.AgreementType = value.String
+			}
+		case slapolicy.FieldTeamID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field team_id", values[i])
+			} else if value.Valid {
+				_m.TeamID = new(int)
+				*_m.TeamID = int(value.Int64)
+			}
+		case slapolicy.FieldVendorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field vendor_id", values[i])
+			} else if value.Valid {
+				_m.VendorID = new(int)
+				*_m.VendorID = int(value.Int64)
+			}
+		case slapolicy.FieldMetrics:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metrics", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Metrics); err != nil {
+					return fmt.Errorf("unmarshal field metrics: %w", err)
+				}
+			}
 		case slapolicy.FieldResponseTimeMinutes:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field response_time_minutes", values[i])
@@ -171,6 +238,13 @@ func (_m *SLAPolicy) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field business_hours: %w", err)
 				}
 			}
+		case slapolicy.FieldCalendarID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field calendar_id", values[i])
+			} else if value.Valid {
+				_m.CalendarID = new(int)
+				*_m.CalendarID = int(value.Int64)
+			}
 		case slapolicy.FieldExcludeWeekends:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field exclude_weekends", values[i])
@@ -244,6 +318,16 @@ func (_m *SLAPolicy) QueryTickets() *TicketQuery {
 	return NewSLAPolicyClient(_m.config).QueryTickets(_m)
 }
 
+// QueryTeam queries the "team" edge of the SLAPolicy entity.
+func (_m *SLAPolicy) QueryTeam() *TeamQuery {
+	return NewSLAPolicyClient(_m.config).QueryTeam(_m)
+}
+
+// QueryVendor queries the "vendor" edge of the SLAPolicy entity.
+func (_m *SLAPolicy) QueryVendor() *VendorQuery {
+	return NewSLAPolicyClient(_m.config).QueryVendor(_m)
+}
+
 // Update returns a builder for updating this SLAPolicy.
 // Note that you need to call SLAPolicy.Unwrap() before calling this method if this SLAPolicy
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -282,6 +366,22 @@ func (_m *SLAPolicy) String() string {
 	builder.WriteString("priority=")
 	builder.WriteString(_m.Priority)
 	builder.WriteString(", ")
+	builder.WriteString("agreement_type=")
+	builder.WriteString(_m.AgreementType)
+	builder.WriteString(", ")
+	if v := _m.TeamID; v != nil {
+		builder.WriteString("team_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.VendorID; v != nil {
+		builder.WriteString("vendor_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("metrics=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Metrics))
+	builder.WriteString(", ")
 	builder.WriteString("response_time_minutes=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ResponseTimeMinutes))
 	builder.WriteString(", ")
@@ -291,6 +391,11 @@ func (_m *SLAPolicy) String() string {
 	builder.WriteString("business_hours=")
 	builder.WriteString(fmt.Sprintf("%v", _m.BusinessHours))
 	builder.WriteString(", ")
+	if v := _m.CalendarID; v != nil {
+		builder.WriteString("calendar_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("exclude_weekends=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ExcludeWeekends))
 	builder.WriteString(", ")
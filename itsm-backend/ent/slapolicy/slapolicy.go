@@ -24,12 +24,22 @@ const (
 	FieldTicketType = "ticket_type"
 	// FieldPriority holds the string denoting the priority field in the database.
 	FieldPriority = "priority"
+	// FieldAgreementType holds the string denoting the agreement_type field in the database.
+	FieldAgreementType = "agreement_type"
+	// FieldTeamID holds the string denoting the team_id field in the database.
+	FieldTeamID = "team_id"
+	// FieldVendorID holds the string denoting the vendor_id field in the database.
+	FieldVendorID = "vendor_id"
+	// FieldMetrics holds the string denoting the metrics field in the database.
+	FieldMetrics = "metrics"
 	// FieldResponseTimeMinutes holds the string denoting the response_time_minutes field in the database.
 	FieldResponseTimeMinutes = "response_time_minutes"
 	// FieldResolutionTimeMinutes holds the string denoting the resolution_time_minutes field in the database.
 	FieldResolutionTimeMinutes = "resolution_time_minutes"
 	// FieldBusinessHours holds the string denoting the business_hours field in the database.
 	FieldBusinessHours = "business_hours"
+	// FieldCalendarID holds the string denoting the calendar_id field in the database.
+	FieldCalendarID = "calendar_id"
 	// FieldExcludeWeekends holds the string denoting the exclude_weekends field in the database.
 	FieldExcludeWeekends = "exclude_weekends"
 	// FieldExcludeHolidays holds the string denoting the exclude_holidays field in the database.
@@ -50,6 +60,10 @@ const (
 	EdgeSLADefinition = "sla_definition"
 	// EdgeTickets holds the string denoting the tickets edge name in mutations.
 	EdgeTickets = "tickets"
+	// EdgeTeam holds the string denoting the team edge name in mutations.
+	EdgeTeam = "team"
+	// EdgeVendor holds the string denoting the vendor edge name in mutations.
+	EdgeVendor = "vendor"
 	// Table holds the table name of the slapolicy in the database.
 	Table = "sla_policies"
 	// SLADefinitionTable is the table that holds the sla_definition relation/edge.
@@ -66,6 +80,20 @@ const (
 	TicketsInverseTable = "tickets"
 	// TicketsColumn is the table column denoting the tickets relation/edge.
 	TicketsColumn = "sla_policy_tickets"
+	// TeamTable is the table that holds the team relation/edge.
+	TeamTable = "sla_policies"
+	// TeamInverseTable is the table name for the Team entity.
+	// It exists in this package in order to avoid circular dependency with the "team" package.
+	TeamInverseTable = "teams"
+	// TeamColumn is the table column denoting the team relation/edge.
+	TeamColumn = "team_id"
+	// VendorTable is the table that holds the vendor relation/edge.
+	VendorTable = "sla_policies"
+	// VendorInverseTable is the table name for the Vendor entity.
+	// It exists in this package in order to avoid circular dependency with the "vendor" package.
+	VendorInverseTable = "vendors"
+	// VendorColumn is the table column denoting the vendor relation/edge.
+	VendorColumn = "vendor_id"
 )
 
 // Columns holds all SQL columns for slapolicy fields.
@@ -76,9 +104,14 @@ var Columns = []string{
 	FieldCustomerTier,
 	FieldTicketType,
 	FieldPriority,
+	FieldAgreementType,
+	FieldTeamID,
+	FieldVendorID,
+	FieldMetrics,
 	FieldResponseTimeMinutes,
 	FieldResolutionTimeMinutes,
 	FieldBusinessHours,
+	FieldCalendarID,
 	FieldExcludeWeekends,
 	FieldExcludeHolidays,
 	FieldEscalationRules,
@@ -102,6 +135,8 @@ func ValidColumn(column string) bool {
 var (
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
+	// DefaultAgreementType holds the default value on creation for the "agreement_type" field.
+	DefaultAgreementType string
 	// ResponseTimeMinutesValidator is a validator for the "response_time_minutes" field. It is called by the builders before save.
 	ResponseTimeMinutesValidator func(int) error
 	// ResolutionTimeMinutesValidator is a validator for the "resolution_time_minutes" field. It is called by the builders before save.
@@ -157,6 +192,21 @@ func ByPriority(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPriority, opts...).ToFunc()
 }
 
+// ByAgreementType orders the results by the agreement_type field.
+func ByAgreementType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAgreementType, opts...).ToFunc()
+}
+
+// ByTeamID orders the results by the team_id field.
+func ByTeamID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTeamID, opts...).ToFunc()
+}
+
+// ByVendorID orders the results by the vendor_id field.
+func ByVendorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVendorID, opts...).ToFunc()
+}
+
 // ByResponseTimeMinutes orders the results by the response_time_minutes field.
 func ByResponseTimeMinutes(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldResponseTimeMinutes, opts...).ToFunc()
@@ -167,6 +217,11 @@ func ByResolutionTimeMinutes(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldResolutionTimeMinutes, opts...).ToFunc()
 }
 
+// ByCalendarID orders the results by the calendar_id field.
+func ByCalendarID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCalendarID, opts...).ToFunc()
+}
+
 // ByExcludeWeekends orders the results by the exclude_weekends field.
 func ByExcludeWeekends(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldExcludeWeekends, opts...).ToFunc()
@@ -229,6 +284,20 @@ func ByTickets(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newTicketsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByTeamField orders the results by team field.
+func ByTeamField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTeamStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByVendorField orders the results by vendor field.
+func ByVendorField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVendorStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newSLADefinitionStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -243,3 +312,17 @@ func newTicketsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, TicketsTable, TicketsColumn),
 	)
 }
+func newTeamStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TeamInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TeamTable, TeamColumn),
+	)
+}
+func newVendorStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VendorInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, VendorTable, VendorColumn),
+	)
+}
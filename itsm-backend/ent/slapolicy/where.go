@@ -80,6 +80,21 @@ func Priority(v string) predicate.SLAPolicy {
 	return predicate.SLAPolicy(sql.FieldEQ(FieldPriority, v))
 }
 
+// AgreementType applies equality check predicate on the "agreement_type" field. It's identical to AgreementTypeEQ.
+func AgreementType(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldAgreementType, v))
+}
+
+// TeamID applies equality check predicate on the "team_id" field. It's identical to TeamIDEQ.
+func TeamID(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldTeamID, v))
+}
+
+// VendorID applies equality check predicate on the "vendor_id" field. It's identical to VendorIDEQ.
+func VendorID(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldVendorID, v))
+}
+
 // ResponseTimeMinutes applies equality check predicate on the "response_time_minutes" field. It's identical to ResponseTimeMinutesEQ.
 func ResponseTimeMinutes(v int) predicate.SLAPolicy {
 	return predicate.SLAPolicy(sql.FieldEQ(FieldResponseTimeMinutes, v))
@@ -90,6 +105,11 @@ func ResolutionTimeMinutes(v int) predicate.SLAPolicy {
 	return predicate.SLAPolicy(sql.FieldEQ(FieldResolutionTimeMinutes, v))
 }
 
+// CalendarID applies equality check predicate on the "calendar_id" field. It's identical to CalendarIDEQ.
+func CalendarID(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldCalendarID, v))
+}
+
 // ExcludeWeekends applies equality check predicate on the "exclude_weekends" field. It's identical to ExcludeWeekendsEQ.
 func ExcludeWeekends(v bool) predicate.SLAPolicy {
 	return predicate.SLAPolicy(sql.FieldEQ(FieldExcludeWeekends, v))
@@ -490,6 +510,141 @@ func PriorityContainsFold(v string) predicate.SLAPolicy {
 	return predicate.SLAPolicy(sql.FieldContainsFold(FieldPriority, v))
 }
 
+// AgreementTypeEQ applies the EQ predicate on the "agreement_type" field.
+func AgreementTypeEQ(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldAgreementType, v))
+}
+
+// AgreementTypeNEQ applies the NEQ predicate on the "agreement_type" field.
+func AgreementTypeNEQ(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNEQ(FieldAgreementType, v))
+}
+
+// AgreementTypeIn applies the In predicate on the "agreement_type" field.
+func AgreementTypeIn(vs ...string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldIn(FieldAgreementType, vs...))
+}
+
+// AgreementTypeNotIn applies the NotIn predicate on the "agreement_type" field.
+func AgreementTypeNotIn(vs ...string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNotIn(FieldAgreementType, vs...))
+}
+
+// AgreementTypeGT applies the GT predicate on the "agreement_type" field.
+func AgreementTypeGT(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldGT(FieldAgreementType, v))
+}
+
+// AgreementTypeGTE applies the GTE predicate on the "agreement_type" field.
+func AgreementTypeGTE(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldGTE(FieldAgreementType, v))
+}
+
+// AgreementTypeLT applies the LT predicate on the "agreement_type" field.
+func AgreementTypeLT(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldLT(FieldAgreementType, v))
+}
+
+// AgreementTypeLTE applies the LTE predicate on the "agreement_type" field.
+func AgreementTypeLTE(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldLTE(FieldAgreementType, v))
+}
+
+// AgreementTypeContains applies the Contains predicate on the "agreement_type" field.
+func AgreementTypeContains(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldContains(FieldAgreementType, v))
+}
+
+// AgreementTypeHasPrefix applies the HasPrefix predicate on the "agreement_type" field.
+func AgreementTypeHasPrefix(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldHasPrefix(FieldAgreementType, v))
+}
+
+// AgreementTypeHasSuffix applies the HasSuffix predicate on the "agreement_type" field.
+func AgreementTypeHasSuffix(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldHasSuffix(FieldAgreementType, v))
+}
+
+// AgreementTypeEqualFold applies the EqualFold predicate on the "agreement_type" field.
+func AgreementTypeEqualFold(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEqualFold(FieldAgreementType, v))
+}
+
+// AgreementTypeContainsFold applies the ContainsFold predicate on the "agreement_type" field.
+func AgreementTypeContainsFold(v string) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldContainsFold(FieldAgreementType, v))
+}
+
+// TeamIDEQ applies the EQ predicate on the "team_id" field.
+func TeamIDEQ(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldTeamID, v))
+}
+
+// TeamIDNEQ applies the NEQ predicate on the "team_id" field.
+func TeamIDNEQ(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNEQ(FieldTeamID, v))
+}
+
+// TeamIDIn applies the In predicate on the "team_id" field.
+func TeamIDIn(vs ...int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldIn(FieldTeamID, vs...))
+}
+
+// TeamIDNotIn applies the NotIn predicate on the "team_id" field.
+func TeamIDNotIn(vs ...int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNotIn(FieldTeamID, vs...))
+}
+
+// TeamIDIsNil applies the IsNil predicate on the "team_id" field.
+func TeamIDIsNil() predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldIsNull(FieldTeamID))
+}
+
+// TeamIDNotNil applies the NotNil predicate on the "team_id" field.
+func TeamIDNotNil() predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNotNull(FieldTeamID))
+}
+
+// VendorIDEQ applies the EQ predicate on the "vendor_id" field.
+func VendorIDEQ(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldVendorID, v))
+}
+
+// VendorIDNEQ applies the NEQ predicate on the "vendor_id" field.
+func VendorIDNEQ(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNEQ(FieldVendorID, v))
+}
+
+// VendorIDIn applies the In predicate on the "vendor_id" field.
+func VendorIDIn(vs ...int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldIn(FieldVendorID, vs...))
+}
+
+// VendorIDNotIn applies the NotIn predicate on the "vendor_id" field.
+func VendorIDNotIn(vs ...int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNotIn(FieldVendorID, vs...))
+}
+
+// VendorIDIsNil applies the IsNil predicate on the "vendor_id" field.
+func VendorIDIsNil() predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldIsNull(FieldVendorID))
+}
+
+// VendorIDNotNil applies the NotNil predicate on the "vendor_id" field.
+func VendorIDNotNil() predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNotNull(FieldVendorID))
+}
+
+// MetricsIsNil applies the IsNil predicate on the "metrics" field.
+func MetricsIsNil() predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldIsNull(FieldMetrics))
+}
+
+// MetricsNotNil applies the NotNil predicate on the "metrics" field.
+func MetricsNotNil() predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNotNull(FieldMetrics))
+}
+
 // ResponseTimeMinutesEQ applies the EQ predicate on the "response_time_minutes" field.
 func ResponseTimeMinutesEQ(v int) predicate.SLAPolicy {
 	return predicate.SLAPolicy(sql.FieldEQ(FieldResponseTimeMinutes, v))
@@ -580,6 +735,56 @@ func BusinessHoursNotNil() predicate.SLAPolicy {
 	return predicate.SLAPolicy(sql.FieldNotNull(FieldBusinessHours))
 }
 
+// CalendarIDEQ applies the EQ predicate on the "calendar_id" field.
+func CalendarIDEQ(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldEQ(FieldCalendarID, v))
+}
+
+// CalendarIDNEQ applies the NEQ predicate on the "calendar_id" field.
+func CalendarIDNEQ(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNEQ(FieldCalendarID, v))
+}
+
+// CalendarIDIn applies the In predicate on the "calendar_id" field.
+func CalendarIDIn(vs ...int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldIn(FieldCalendarID, vs...))
+}
+
+// CalendarIDNotIn applies the NotIn predicate on the "calendar_id" field.
+func CalendarIDNotIn(vs ...int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNotIn(FieldCalendarID, vs...))
+}
+
+// CalendarIDGT applies the GT predicate on the "calendar_id" field.
+func CalendarIDGT(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldGT(FieldCalendarID, v))
+}
+
+// CalendarIDGTE applies the GTE predicate on the "calendar_id" field.
+func CalendarIDGTE(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldGTE(FieldCalendarID, v))
+}
+
+// CalendarIDLT applies the LT predicate on the "calendar_id" field.
+func CalendarIDLT(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldLT(FieldCalendarID, v))
+}
+
+// CalendarIDLTE applies the LTE predicate on the "calendar_id" field.
+func CalendarIDLTE(v int) predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldLTE(FieldCalendarID, v))
+}
+
+// CalendarIDIsNil applies the IsNil predicate on the "calendar_id" field.
+func CalendarIDIsNil() predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldIsNull(FieldCalendarID))
+}
+
+// CalendarIDNotNil applies the NotNil predicate on the "calendar_id" field.
+func CalendarIDNotNil() predicate.SLAPolicy {
+	return predicate.SLAPolicy(sql.FieldNotNull(FieldCalendarID))
+}
+
 // ExcludeWeekendsEQ applies the EQ predicate on the "exclude_weekends" field.
 func ExcludeWeekendsEQ(v bool) predicate.SLAPolicy {
 	return predicate.SLAPolicy(sql.FieldEQ(FieldExcludeWeekends, v))
@@ -826,6 +1031,52 @@ func HasTicketsWith(preds ...predicate.Ticket) predicate.SLAPolicy {
 	})
 }
 
+// HasTeam applies the HasEdge predicate on the "team" edge.
+func HasTeam() predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TeamTable, TeamColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTeamWith applies the HasEdge predicate on the "team" edge with a given conditions (other predicates).
+func HasTeamWith(preds ...predicate.Team) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		step := newTeamStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasVendor applies the HasEdge predicate on the "vendor" edge.
+func HasVendor() predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, VendorTable, VendorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVendorWith applies the HasEdge predicate on the "vendor" edge with a given conditions (other predicates).
+func HasVendorWith(preds ...predicate.Vendor) predicate.SLAPolicy {
+	return predicate.SLAPolicy(func(s *sql.Selector) {
+		step := newVendorStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.SLAPolicy) predicate.SLAPolicy {
 	return predicate.SLAPolicy(sql.AndPredicates(predicates...))
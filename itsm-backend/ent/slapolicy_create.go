@@ -6,9 +6,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"itsm-backend/ent/schema"
 	"itsm-backend/ent/sladefinition"
 	"itsm-backend/ent/slapolicy"
+	"itsm-backend/ent/team"
 	"itsm-backend/ent/ticket"
+	"itsm-backend/ent/vendor"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -84,6 +87,54 @@ func (_c *SLAPolicyCreate) SetNillablePriority(v *string) *SLAPolicyCreate {
 	return _c
 }
 
+// SetAgreementType sets the "agreement_type" field.
+func (_c *SLAPolicyCreate) SetAgreementType(v string) *SLAPolicyCreate {
+	_c.mutation.SetAgreementType(v)
+	return _c
+}
+
+// SetNillableAgreementType sets the "agreement_type" field if the given value is not nil.
+func (_c *SLAPolicyCreate) SetNillableAgreementType(v *string) *SLAPolicyCreate {
+	if v != nil {
+		_c.SetAgreementType(*v)
+	}
+	return _c
+}
+
+// SetTeamID sets the "team_id" field.
+func (_c *SLAPolicyCreate) SetTeamID(v int) *SLAPolicyCreate {
+	_c.mutation.SetTeamID(v)
+	return _c
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (_c *SLAPolicyCreate) SetNillableTeamID(v *int) *SLAPolicyCreate {
+	if v != nil {
+		_c.SetTeamID(*v)
+	}
+	return _c
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (_c *SLAPolicyCreate) SetVendorID(v int) *SLAPolicyCreate {
+	_c.mutation.SetVendorID(v)
+	return _c
+}
+
+// SetNillableVendorID sets the "vendor_id" field if the given value is not nil.
+func (_c *SLAPolicyCreate) SetNillableVendorID(v *int) *SLAPolicyCreate {
+	if v != nil {
+		_c.SetVendorID(*v)
+	}
+	return _c
+}
+
+// SetMetrics sets the "metrics" field.
+func (_c *SLAPolicyCreate) SetMetrics(v []schema.SLAMetricDefinition) *SLAPolicyCreate {
+	_c.mutation.SetMetrics(v)
+	return _c
+}
+
 // SetResponseTimeMinutes sets the "response_time_minutes" field.
 func (_c *SLAPolicyCreate) SetResponseTimeMinutes(v int) *SLAPolicyCreate {
 	_c.mutation.SetResponseTimeMinutes(v)
@@ -102,6 +153,20 @@ func (_c *SLAPolicyCreate) SetBusinessHours(v map[string]interface{}) *SLAPolicy
 	return _c
 }
 
+// SetCalendarID sets the "calendar_id" field.
+func (_c *SLAPolicyCreate) SetCalendarID(v int) *SLAPolicyCreate {
+	_c.mutation.SetCalendarID(v)
+	return _c
+}
+
+// SetNillableCalendarID sets the "calendar_id" field if the given value is not nil.
+func (_c *SLAPolicyCreate) SetNillableCalendarID(v *int) *SLAPolicyCreate {
+	if v != nil {
+		_c.SetCalendarID(*v)
+	}
+	return _c
+}
+
 // SetExcludeWeekends sets the "exclude_weekends" field.
 func (_c *SLAPolicyCreate) SetExcludeWeekends(v bool) *SLAPolicyCreate {
 	_c.mutation.SetExcludeWeekends(v)
@@ -228,6 +293,16 @@ func (_c *SLAPolicyCreate) AddTickets(v ...*Ticket) *SLAPolicyCreate {
 	return _c.AddTicketIDs(ids...)
 }
 
+// SetTeam sets the "team" edge to the Team entity.
+func (_c *SLAPolicyCreate) SetTeam(v *Team) *SLAPolicyCreate {
+	return _c.SetTeamID(v.ID)
+}
+
+// SetVendor sets the "vendor" edge to the Vendor entity.
+func (_c *SLAPolicyCreate) SetVendor(v *Vendor) *SLAPolicyCreate {
+	return _c.SetVendorID(v.ID)
+}
+
 // Mutation returns the SLAPolicyMutation object of the builder.
 func (_c *SLAPolicyCreate) Mutation() *SLAPolicyMutation {
 	return _c.mutation
@@ -263,6 +338,10 @@ func (_c *SLAPolicyCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *SLAPolicyCreate) defaults() {
+	if _, ok := _c.mutation.AgreementType(); !ok {
+		v := slapolicy.DefaultAgreementType
+		_c.mutation.SetAgreementType(v)
+	}
 	if _, ok := _c.mutation.ExcludeWeekends(); !ok {
 		v := slapolicy.DefaultExcludeWeekends
 		_c.mutation.SetExcludeWeekends(v)
@@ -299,6 +378,9 @@ func (_c *SLAPolicyCreate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SLAPolicy.name": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.AgreementType(); !ok {
+		return &ValidationError{Name: "agreement_type", err: errors.New(`ent: missing required field "SLAPolicy.agreement_type"`)}
+	}
 	if _, ok := _c.mutation.ResponseTimeMinutes(); !ok {
 		return &ValidationError{Name: "response_time_minutes", err: errors.New(`ent: missing required field "SLAPolicy.response_time_minutes"`)}
 	}
@@ -387,6 +469,14 @@ func (_c *SLAPolicyCreate) createSpec() (*SLAPolicy, *sqlgraph.CreateSpec) {
 		_spec.SetField(slapolicy.FieldPriority, field.TypeString, value)
 		_node.Priority = value
 	}
+	if value, ok := _c.mutation.AgreementType(); ok {
+		_spec.SetField(slapolicy.FieldAgreementType, field.TypeString, value)
+		_node.AgreementType = value
+	}
+	if value, ok := _c.mutation.Metrics(); ok {
+		_spec.SetField(slapolicy.FieldMetrics, field.TypeJSON, value)
+		_node.Metrics = value
+	}
 	if value, ok := _c.mutation.ResponseTimeMinutes(); ok {
 		_spec.SetField(slapolicy.FieldResponseTimeMinutes, field.TypeInt, value)
 		_node.ResponseTimeMinutes = value
@@ -399,6 +489,10 @@ func (_c *SLAPolicyCreate) createSpec() (*SLAPolicy, *sqlgraph.CreateSpec) {
 		_spec.SetField(slapolicy.FieldBusinessHours, field.TypeJSON, value)
 		_node.BusinessHours = value
 	}
+	if value, ok := _c.mutation.CalendarID(); ok {
+		_spec.SetField(slapolicy.FieldCalendarID, field.TypeInt, value)
+		_node.CalendarID = &value
+	}
 	if value, ok := _c.mutation.ExcludeWeekends(); ok {
 		_spec.SetField(slapolicy.FieldExcludeWeekends, field.TypeBool, value)
 		_node.ExcludeWeekends = value
@@ -463,6 +557,40 @@ func (_c *SLAPolicyCreate) createSpec() (*SLAPolicy, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   slapolicy.TeamTable,
+			Columns: []string{slapolicy.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TeamID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.VendorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   slapolicy.VendorTable,
+			Columns: []string{slapolicy.VendorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vendor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.VendorID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -9,7 +9,9 @@ import (
 	"itsm-backend/ent/predicate"
 	"itsm-backend/ent/sladefinition"
 	"itsm-backend/ent/slapolicy"
+	"itsm-backend/ent/team"
 	"itsm-backend/ent/ticket"
+	"itsm-backend/ent/vendor"
 	"math"
 
 	"entgo.io/ent"
@@ -27,6 +29,8 @@ type SLAPolicyQuery struct {
 	predicates        []predicate.SLAPolicy
 	withSLADefinition *SLADefinitionQuery
 	withTickets       *TicketQuery
+	withTeam          *TeamQuery
+	withVendor        *VendorQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -107,6 +111,50 @@ func (_q *SLAPolicyQuery) QueryTickets() *TicketQuery {
 	return query
 }
 
+// QueryTeam chains the current query on the "team" edge.
+func (_q *SLAPolicyQuery) QueryTeam() *TeamQuery {
+	query := (&TeamClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(slapolicy.Table, slapolicy.FieldID, selector),
+			sqlgraph.To(team.Table, team.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, slapolicy.TeamTable, slapolicy.TeamColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryVendor chains the current query on the "vendor" edge.
+func (_q *SLAPolicyQuery) QueryVendor() *VendorQuery {
+	query := (&VendorClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(slapolicy.Table, slapolicy.FieldID, selector),
+			sqlgraph.To(vendor.Table, vendor.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, slapolicy.VendorTable, slapolicy.VendorColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first SLAPolicy entity from the query.
 // Returns a *NotFoundError when no SLAPolicy was found.
 func (_q *SLAPolicyQuery) First(ctx context.Context) (*SLAPolicy, error) {
@@ -301,6 +349,8 @@ func (_q *SLAPolicyQuery) Clone() *SLAPolicyQuery {
 		predicates:        append([]predicate.SLAPolicy{}, _q.predicates...),
 		withSLADefinition: _q.withSLADefinition.Clone(),
 		withTickets:       _q.withTickets.Clone(),
+		withTeam:          _q.withTeam.Clone(),
+		withVendor:        _q.withVendor.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -329,6 +379,28 @@ func (_q *SLAPolicyQuery) WithTickets(opts ...func(*TicketQuery)) *SLAPolicyQuer
 	return _q
 }
 
+// WithTeam tells the query-builder to eager-load the nodes that are connected to
+// the "team" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SLAPolicyQuery) WithTeam(opts ...func(*TeamQuery)) *SLAPolicyQuery {
+	query := (&TeamClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTeam = query
+	return _q
+}
+
+// WithVendor tells the query-builder to eager-load the nodes that are connected to
+// the "vendor" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SLAPolicyQuery) WithVendor(opts ...func(*VendorQuery)) *SLAPolicyQuery {
+	query := (&VendorClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withVendor = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -407,9 +479,11 @@ func (_q *SLAPolicyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SL
 	var (
 		nodes       = []*SLAPolicy{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [4]bool{
 			_q.withSLADefinition != nil,
 			_q.withTickets != nil,
+			_q.withTeam != nil,
+			_q.withVendor != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -444,6 +518,18 @@ func (_q *SLAPolicyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SL
 			return nil, err
 		}
 	}
+	if query := _q.withTeam; query != nil {
+		if err := _q.loadTeam(ctx, query, nodes, nil,
+			func(n *SLAPolicy, e *Team) { n.Edges.Team = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withVendor; query != nil {
+		if err := _q.loadVendor(ctx, query, nodes, nil,
+			func(n *SLAPolicy, e *Vendor) { n.Edges.Vendor = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -509,6 +595,70 @@ func (_q *SLAPolicyQuery) loadTickets(ctx context.Context, query *TicketQuery, n
 	}
 	return nil
 }
+func (_q *SLAPolicyQuery) loadTeam(ctx context.Context, query *TeamQuery, nodes []*SLAPolicy, init func(*SLAPolicy), assign func(*SLAPolicy, *Team)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*SLAPolicy)
+	for i := range nodes {
+		if nodes[i].TeamID == nil {
+			continue
+		}
+		fk := *nodes[i].TeamID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(team.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "team_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *SLAPolicyQuery) loadVendor(ctx context.Context, query *VendorQuery, nodes []*SLAPolicy, init func(*SLAPolicy), assign func(*SLAPolicy, *Vendor)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*SLAPolicy)
+	for i := range nodes {
+		if nodes[i].VendorID == nil {
+			continue
+		}
+		fk := *nodes[i].VendorID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(vendor.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "vendor_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *SLAPolicyQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -535,6 +685,12 @@ func (_q *SLAPolicyQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if _q.withTeam != nil {
+			_spec.Node.AddColumnOnce(slapolicy.FieldTeamID)
+		}
+		if _q.withVendor != nil {
+			_spec.Node.AddColumnOnce(slapolicy.FieldVendorID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -7,13 +7,17 @@ import (
 	"errors"
 	"fmt"
 	"itsm-backend/ent/predicate"
+	"itsm-backend/ent/schema"
 	"itsm-backend/ent/sladefinition"
 	"itsm-backend/ent/slapolicy"
+	"itsm-backend/ent/team"
 	"itsm-backend/ent/ticket"
+	"itsm-backend/ent/vendor"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 )
 
@@ -124,6 +128,78 @@ func (_u *SLAPolicyUpdate) ClearPriority() *SLAPolicyUpdate {
 	return _u
 }
 
+// SetAgreementType sets the "agreement_type" field.
+func (_u *SLAPolicyUpdate) SetAgreementType(v string) *SLAPolicyUpdate {
+	_u.mutation.SetAgreementType(v)
+	return _u
+}
+
+// SetNillableAgreementType sets the "agreement_type" field if the given value is not nil.
+func (_u *SLAPolicyUpdate) SetNillableAgreementType(v *string) *SLAPolicyUpdate {
+	if v != nil {
+		_u.SetAgreementType(*v)
+	}
+	return _u
+}
+
+// SetTeamID sets the "team_id" field.
+func (_u *SLAPolicyUpdate) SetTeamID(v int) *SLAPolicyUpdate {
+	_u.mutation.SetTeamID(v)
+	return _u
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (_u *SLAPolicyUpdate) SetNillableTeamID(v *int) *SLAPolicyUpdate {
+	if v != nil {
+		_u.SetTeamID(*v)
+	}
+	return _u
+}
+
+// ClearTeamID clears the value of the "team_id" field.
+func (_u *SLAPolicyUpdate) ClearTeamID() *SLAPolicyUpdate {
+	_u.mutation.ClearTeamID()
+	return _u
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (_u *SLAPolicyUpdate) SetVendorID(v int) *SLAPolicyUpdate {
+	_u.mutation.SetVendorID(v)
+	return _u
+}
+
+// SetNillableVendorID sets the "vendor_id" field if the given value is not nil.
+func (_u *SLAPolicyUpdate) SetNillableVendorID(v *int) *SLAPolicyUpdate {
+	if v != nil {
+		_u.SetVendorID(*v)
+	}
+	return _u
+}
+
+// ClearVendorID clears the value of the "vendor_id" field.
+func (_u *SLAPolicyUpdate) ClearVendorID() *SLAPolicyUpdate {
+	_u.mutation.ClearVendorID()
+	return _u
+}
+
+// SetMetrics sets the "metrics" field.
+func (_u *SLAPolicyUpdate) SetMetrics(v []schema.SLAMetricDefinition) *SLAPolicyUpdate {
+	_u.mutation.SetMetrics(v)
+	return _u
+}
+
+// AppendMetrics appends value to the "metrics" field.
+func (_u *SLAPolicyUpdate) AppendMetrics(v []schema.SLAMetricDefinition) *SLAPolicyUpdate {
+	_u.mutation.AppendMetrics(v)
+	return _u
+}
+
+// ClearMetrics clears the value of the "metrics" field.
+func (_u *SLAPolicyUpdate) ClearMetrics() *SLAPolicyUpdate {
+	_u.mutation.ClearMetrics()
+	return _u
+}
+
 // SetResponseTimeMinutes sets the "response_time_minutes" field.
 func (_u *SLAPolicyUpdate) SetResponseTimeMinutes(v int) *SLAPolicyUpdate {
 	_u.mutation.ResetResponseTimeMinutes()
@@ -178,6 +254,33 @@ func (_u *SLAPolicyUpdate) ClearBusinessHours() *SLAPolicyUpdate {
 	return _u
 }
 
+// SetCalendarID sets the "calendar_id" field.
+func (_u *SLAPolicyUpdate) SetCalendarID(v int) *SLAPolicyUpdate {
+	_u.mutation.ResetCalendarID()
+	_u.mutation.SetCalendarID(v)
+	return _u
+}
+
+// SetNillableCalendarID sets the "calendar_id" field if the given value is not nil.
+func (_u *SLAPolicyUpdate) SetNillableCalendarID(v *int) *SLAPolicyUpdate {
+	if v != nil {
+		_u.SetCalendarID(*v)
+	}
+	return _u
+}
+
+// AddCalendarID adds value to the "calendar_id" field.
+func (_u *SLAPolicyUpdate) AddCalendarID(v int) *SLAPolicyUpdate {
+	_u.mutation.AddCalendarID(v)
+	return _u
+}
+
+// ClearCalendarID clears the value of the "calendar_id" field.
+func (_u *SLAPolicyUpdate) ClearCalendarID() *SLAPolicyUpdate {
+	_u.mutation.ClearCalendarID()
+	return _u
+}
+
 // SetExcludeWeekends sets the "exclude_weekends" field.
 func (_u *SLAPolicyUpdate) SetExcludeWeekends(v bool) *SLAPolicyUpdate {
 	_u.mutation.SetExcludeWeekends(v)
@@ -324,6 +427,16 @@ func (_u *SLAPolicyUpdate) AddTickets(v ...*Ticket) *SLAPolicyUpdate {
 	return _u.AddTicketIDs(ids...)
 }
 
+// SetTeam sets the "team" edge to the Team entity.
+func (_u *SLAPolicyUpdate) SetTeam(v *Team) *SLAPolicyUpdate {
+	return _u.SetTeamID(v.ID)
+}
+
+// SetVendor sets the "vendor" edge to the Vendor entity.
+func (_u *SLAPolicyUpdate) SetVendor(v *Vendor) *SLAPolicyUpdate {
+	return _u.SetVendorID(v.ID)
+}
+
 // Mutation returns the SLAPolicyMutation object of the builder.
 func (_u *SLAPolicyUpdate) Mutation() *SLAPolicyMutation {
 	return _u.mutation
@@ -371,6 +484,18 @@ func (_u *SLAPolicyUpdate) RemoveTickets(v ...*Ticket) *SLAPolicyUpdate {
 	return _u.RemoveTicketIDs(ids...)
 }
 
+// ClearTeam clears the "team" edge to the Team entity.
+func (_u *SLAPolicyUpdate) ClearTeam() *SLAPolicyUpdate {
+	_u.mutation.ClearTeam()
+	return _u
+}
+
+// ClearVendor clears the "vendor" edge to the Vendor entity.
+func (_u *SLAPolicyUpdate) ClearVendor() *SLAPolicyUpdate {
+	_u.mutation.ClearVendor()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *SLAPolicyUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -471,6 +596,20 @@ func (_u *SLAPolicyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.PriorityCleared() {
 		_spec.ClearField(slapolicy.FieldPriority, field.TypeString)
 	}
+	if value, ok := _u.mutation.AgreementType(); ok {
+		_spec.SetField(slapolicy.FieldAgreementType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Metrics(); ok {
+		_spec.SetField(slapolicy.FieldMetrics, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMetrics(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, slapolicy.FieldMetrics, value)
+		})
+	}
+	if _u.mutation.MetricsCleared() {
+		_spec.ClearField(slapolicy.FieldMetrics, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.ResponseTimeMinutes(); ok {
 		_spec.SetField(slapolicy.FieldResponseTimeMinutes, field.TypeInt, value)
 	}
@@ -489,6 +628,15 @@ func (_u *SLAPolicyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.BusinessHoursCleared() {
 		_spec.ClearField(slapolicy.FieldBusinessHours, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.CalendarID(); ok {
+		_spec.SetField(slapolicy.FieldCalendarID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCalendarID(); ok {
+		_spec.AddField(slapolicy.FieldCalendarID, field.TypeInt, value)
+	}
+	if _u.mutation.CalendarIDCleared() {
+		_spec.ClearField(slapolicy.FieldCalendarID, field.TypeInt)
+	}
 	if value, ok := _u.mutation.ExcludeWeekends(); ok {
 		_spec.SetField(slapolicy.FieldExcludeWeekends, field.TypeBool, value)
 	}
@@ -612,6 +760,64 @@ func (_u *SLAPolicyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.TeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   slapolicy.TeamTable,
+			Columns: []string{slapolicy.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   slapolicy.TeamTable,
+			Columns: []string{slapolicy.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.VendorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   slapolicy.VendorTable,
+			Columns: []string{slapolicy.VendorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vendor.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VendorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   slapolicy.VendorTable,
+			Columns: []string{slapolicy.VendorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vendor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{slapolicy.Label}
@@ -726,6 +932,78 @@ func (_u *SLAPolicyUpdateOne) ClearPriority() *SLAPolicyUpdateOne {
 	return _u
 }
 
+// SetAgreementType sets the "agreement_type" field.
+func (_u *SLAPolicyUpdateOne) SetAgreementType(v string) *SLAPolicyUpdateOne {
+	_u.mutation.SetAgreementType(v)
+	return _u
+}
+
+// SetNillableAgreementType sets the "agreement_type" field if the given value is not nil.
+func (_u *SLAPolicyUpdateOne) SetNillableAgreementType(v *string) *SLAPolicyUpdateOne {
+	if v != nil {
+		_u.SetAgreementType(*v)
+	}
+	return _u
+}
+
+// SetTeamID sets the "team_id" field.
+func (_u *SLAPolicyUpdateOne) SetTeamID(v int) *SLAPolicyUpdateOne {
+	_u.mutation.SetTeamID(v)
+	return _u
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (_u *SLAPolicyUpdateOne) SetNillableTeamID(v *int) *SLAPolicyUpdateOne {
+	if v != nil {
+		_u.SetTeamID(*v)
+	}
+	return _u
+}
+
+// ClearTeamID clears the value of the "team_id" field.
+func (_u *SLAPolicyUpdateOne) ClearTeamID() *SLAPolicyUpdateOne {
+	_u.mutation.ClearTeamID()
+	return _u
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (_u *SLAPolicyUpdateOne) SetVendorID(v int) *SLAPolicyUpdateOne {
+	_u.mutation.SetVendorID(v)
+	return _u
+}
+
+// SetNillableVendorID sets the "vendor_id" field if the given value is not nil.
+func (_u *SLAPolicyUpdateOne) SetNillableVendorID(v *int) *SLAPolicyUpdateOne {
+	if v != nil {
+		_u.SetVendorID(*v)
+	}
+	return _u
+}
+
+// ClearVendorID clears the value of the "vendor_id" field.
+func (_u *SLAPolicyUpdateOne) ClearVendorID() *SLAPolicyUpdateOne {
+	_u.mutation.ClearVendorID()
+	return _u
+}
+
+// SetMetrics sets the "metrics" field.
+func (_u *SLAPolicyUpdateOne) SetMetrics(v []schema.SLAMetricDefinition) *SLAPolicyUpdateOne {
+	_u.mutation.SetMetrics(v)
+	return _u
+}
+
+// AppendMetrics appends value to the "metrics" field.
+func (_u *SLAPolicyUpdateOne) AppendMetrics(v []schema.SLAMetricDefinition) *SLAPolicyUpdateOne {
+	_u.mutation.AppendMetrics(v)
+	return _u
+}
+
+// ClearMetrics clears the value of the "metrics" field.
+func (_u *SLAPolicyUpdateOne) ClearMetrics() *SLAPolicyUpdateOne {
+	_u.mutation.ClearMetrics()
+	return _u
+}
+
 // SetResponseTimeMinutes sets the "response_time_minutes" field.
 func (_u *SLAPolicyUpdateOne) SetResponseTimeMinutes(v int) *SLAPolicyUpdateOne {
 	_u.mutation.ResetResponseTimeMinutes()
@@ -780,6 +1058,33 @@ func (_u *SLAPolicyUpdateOne) ClearBusinessHours() *SLAPolicyUpdateOne {
 	return _u
 }
 
+// SetCalendarID sets the "calendar_id" field.
+func (_u *SLAPolicyUpdateOne) SetCalendarID(v int) *SLAPolicyUpdateOne {
+	_u.mutation.ResetCalendarID()
+	_u.mutation.SetCalendarID(v)
+	return _u
+}
+
+// SetNillableCalendarID sets the "calendar_id" field if the given value is not nil.
+func (_u *SLAPolicyUpdateOne) SetNillableCalendarID(v *int) *SLAPolicyUpdateOne {
+	if v != nil {
+		_u.SetCalendarID(*v)
+	}
+	return _u
+}
+
+// AddCalendarID adds value to the "calendar_id" field.
+func (_u *SLAPolicyUpdateOne) AddCalendarID(v int) *SLAPolicyUpdateOne {
+	_u.mutation.AddCalendarID(v)
+	return _u
+}
+
+// ClearCalendarID clears the value of the "calendar_id" field.
+func (_u *SLAPolicyUpdateOne) ClearCalendarID() *SLAPolicyUpdateOne {
+	_u.mutation.ClearCalendarID()
+	return _u
+}
+
 // SetExcludeWeekends sets the "exclude_weekends" field.
 func (_u *SLAPolicyUpdateOne) SetExcludeWeekends(v bool) *SLAPolicyUpdateOne {
 	_u.mutation.SetExcludeWeekends(v)
@@ -926,6 +1231,16 @@ func (_u *SLAPolicyUpdateOne) AddTickets(v ...*Ticket) *SLAPolicyUpdateOne {
 	return _u.AddTicketIDs(ids...)
 }
 
+// SetTeam sets the "team" edge to the Team entity.
+func (_u *SLAPolicyUpdateOne) SetTeam(v *Team) *SLAPolicyUpdateOne {
+	return _u.SetTeamID(v.ID)
+}
+
+// SetVendor sets the "vendor" edge to the Vendor entity.
+func (_u *SLAPolicyUpdateOne) SetVendor(v *Vendor) *SLAPolicyUpdateOne {
+	return _u.SetVendorID(v.ID)
+}
+
 // Mutation returns the SLAPolicyMutation object of the builder.
 func (_u *SLAPolicyUpdateOne) Mutation() *SLAPolicyMutation {
 	return _u.mutation
@@ -973,6 +1288,18 @@ func (_u *SLAPolicyUpdateOne) RemoveTickets(v ...*Ticket) *SLAPolicyUpdateOne {
 	return _u.RemoveTicketIDs(ids...)
 }
 
+// ClearTeam clears the "team" edge to the Team entity.
+func (_u *SLAPolicyUpdateOne) ClearTeam() *SLAPolicyUpdateOne {
+	_u.mutation.ClearTeam()
+	return _u
+}
+
+// ClearVendor clears the "vendor" edge to the Vendor entity.
+func (_u *SLAPolicyUpdateOne) ClearVendor() *SLAPolicyUpdateOne {
+	_u.mutation.ClearVendor()
+	return _u
+}
+
 // Where appends a list predicates to the SLAPolicyUpdate builder.
 func (_u *SLAPolicyUpdateOne) Where(ps ...predicate.SLAPolicy) *SLAPolicyUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1103,6 +1430,20 @@ func (_u *SLAPolicyUpdateOne) sqlSave(ctx context.Context) (_node *SLAPolicy, er
 	if _u.mutation.PriorityCleared() {
 		_spec.ClearField(slapolicy.FieldPriority, field.TypeString)
 	}
+	if value, ok := _u.mutation.AgreementType(); ok {
+		_spec.SetField(slapolicy.FieldAgreementType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Metrics(); ok {
+		_spec.SetField(slapolicy.FieldMetrics, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMetrics(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, slapolicy.FieldMetrics, value)
+		})
+	}
+	if _u.mutation.MetricsCleared() {
+		_spec.ClearField(slapolicy.FieldMetrics, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.ResponseTimeMinutes(); ok {
 		_spec.SetField(slapolicy.FieldResponseTimeMinutes, field.TypeInt, value)
 	}
@@ -1121,6 +1462,15 @@ func (_u *SLAPolicyUpdateOne) sqlSave(ctx context.Context) (_node *SLAPolicy, er
 	if _u.mutation.BusinessHoursCleared() {
 		_spec.ClearField(slapolicy.FieldBusinessHours, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.CalendarID(); ok {
+		_spec.SetField(slapolicy.FieldCalendarID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCalendarID(); ok {
+		_spec.AddField(slapolicy.FieldCalendarID, field.TypeInt, value)
+	}
+	if _u.mutation.CalendarIDCleared() {
+		_spec.ClearField(slapolicy.FieldCalendarID, field.TypeInt)
+	}
 	if value, ok := _u.mutation.ExcludeWeekends(); ok {
 		_spec.SetField(slapolicy.FieldExcludeWeekends, field.TypeBool, value)
 	}
@@ -1244,6 +1594,64 @@ func (_u *SLAPolicyUpdateOne) sqlSave(ctx context.Context) (_node *SLAPolicy, er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.TeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   slapolicy.TeamTable,
+			Columns: []string{slapolicy.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   slapolicy.TeamTable,
+			Columns: []string{slapolicy.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.VendorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   slapolicy.VendorTable,
+			Columns: []string{slapolicy.VendorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vendor.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VendorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   slapolicy.VendorTable,
+			Columns: []string{slapolicy.VendorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vendor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &SLAPolicy{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
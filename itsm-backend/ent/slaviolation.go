@@ -31,6 +31,10 @@ type SLAViolation struct {
 	TicketID int `json:"ticket_id,omitempty"`
 	// 违规类型
 	ViolationType string `json:"violation_type,omitempty"`
+	// 协议类型: sla/ola/uc
+	AgreementType string `json:"agreement_type,omitempty"`
+	// 违约的计时指标标识，策略指标违约时填写
+	MetricKey string `json:"metric_key,omitempty"`
 	// 违规时间
 	ViolationTime time.Time `json:"violation_time,omitempty"`
 	// 违规发生时间
@@ -107,7 +111,7 @@ func (*SLAViolation) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case slaviolation.FieldID, slaviolation.FieldCreatedBy, slaviolation.FieldSLADefinitionID, slaviolation.FieldTicketID, slaviolation.FieldExpectedTime, slaviolation.FieldActualTime, slaviolation.FieldOverdueMinutes, slaviolation.FieldTenantID:
 			values[i] = new(sql.NullInt64)
-		case slaviolation.FieldTicketType, slaviolation.FieldSLAName, slaviolation.FieldViolationType, slaviolation.FieldStatus, slaviolation.FieldDescription, slaviolation.FieldSeverity, slaviolation.FieldResolutionNotes:
+		case slaviolation.FieldTicketType, slaviolation.FieldSLAName, slaviolation.FieldViolationType, slaviolation.FieldAgreementType, slaviolation.FieldMetricKey, slaviolation.FieldStatus, slaviolation.FieldDescription, slaviolation.FieldSeverity, slaviolation.FieldResolutionNotes:
 			values[i] = new(sql.NullString)
 		case slaviolation.FieldViolationTime, slaviolation.FieldViolationOccurredAt, slaviolation.FieldResolvedAt, slaviolation.FieldCreatedAt, slaviolation.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -168,6 +172,18 @@ func (_m *SLAViolation) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ViolationType = value.String
 			}
+		case slaviolation.FieldAgreementType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field agreement_type", values[i])
+			} else if value.Valid {
+				_m.AgreementType = value.String
+			}
+		case slaviolation.FieldMetricKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field metric_key", values[i])
+			} else if value.Valid {
+				_m.MetricKey = value.String
+			}
 		case slaviolation.FieldViolationTime:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field violation_time", values[i])
@@ -316,6 +332,12 @@ func (_m *SLAViolation) String() string {
 	builder.WriteString("violation_type=")
 	builder.WriteString(_m.ViolationType)
 	builder.WriteString(", ")
+	builder.WriteString("agreement_type=")
+	builder.WriteString(_m.AgreementType)
+	builder.WriteString(", ")
+	builder.WriteString("metric_key=")
+	builder.WriteString(_m.MetricKey)
+	builder.WriteString(", ")
 	builder.WriteString("violation_time=")
 	builder.WriteString(_m.ViolationTime.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -26,6 +26,10 @@ const (
 	FieldTicketID = "ticket_id"
 	// FieldViolationType holds the string denoting the violation_type field in the database.
 	FieldViolationType = "violation_type"
+	// FieldAgreementType holds the string denoting the agreement_type field in the database.
+	FieldAgreementType = "agreement_type"
+	// FieldMetricKey holds the string denoting the metric_key field in the database.
+	FieldMetricKey = "metric_key"
 	// FieldViolationTime holds the string denoting the violation_time field in the database.
 	FieldViolationTime = "violation_time"
 	// FieldViolationOccurredAt holds the string denoting the violation_occurred_at field in the database.
@@ -85,6 +89,8 @@ var Columns = []string{
 	FieldSLAName,
 	FieldTicketID,
 	FieldViolationType,
+	FieldAgreementType,
+	FieldMetricKey,
 	FieldViolationTime,
 	FieldViolationOccurredAt,
 	FieldExpectedTime,
@@ -124,6 +130,8 @@ var (
 	TicketIDValidator func(int) error
 	// ViolationTypeValidator is a validator for the "violation_type" field. It is called by the builders before save.
 	ViolationTypeValidator func(string) error
+	// DefaultAgreementType holds the default value on creation for the "agreement_type" field.
+	DefaultAgreementType string
 	// DefaultViolationTime holds the default value on creation for the "violation_time" field.
 	DefaultViolationTime func() time.Time
 	// DefaultViolationOccurredAt holds the default value on creation for the "violation_occurred_at" field.
@@ -184,6 +192,16 @@ func ByViolationType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldViolationType, opts...).ToFunc()
 }
 
+// ByAgreementType orders the results by the agreement_type field.
+func ByAgreementType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAgreementType, opts...).ToFunc()
+}
+
+// ByMetricKey orders the results by the metric_key field.
+func ByMetricKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMetricKey, opts...).ToFunc()
+}
+
 // ByViolationTime orders the results by the violation_time field.
 func ByViolationTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldViolationTime, opts...).ToFunc()
@@ -85,6 +85,16 @@ func ViolationType(v string) predicate.SLAViolation {
 	return predicate.SLAViolation(sql.FieldEQ(FieldViolationType, v))
 }
 
+// AgreementType applies equality check predicate on the "agreement_type" field. It's identical to AgreementTypeEQ.
+func AgreementType(v string) predicate.SLAViolation {
+	return predicate.SLAViolation(sql.FieldEQ(FieldAgreementType, v))
+}
+
+// MetricKey applies equality check predicate on the "metric_key" field. It's identical to MetricKeyEQ.
+func MetricKey(v string) predicate.SLAViolation {
+	return predicate.SLAViolation(sql.FieldEQ(FieldMetricKey, v))
+}
+
 // ViolationTime applies equality check predicate on the "violation_time" field. It's identical to ViolationTimeEQ.
 func ViolationTime(v time.Time) predicate.SLAViolation {
 	return predicate.SLAViolation(sql.FieldEQ(FieldViolationTime, v))
@@ -430,6 +440,146 @@ func ViolationTypeContainsFold(v string) predicate.SLAViolation {
 	return predicate.SLAViolation(sql.FieldContainsFold(FieldViolationType, v))
 }
 
+// AgreementTypeEQ applies the EQ predicate on the "agreement_type" field.
+func AgreementTypeEQ(v string) predicate.SLAViolation {
+	return predicate.SLAViolation(sql.FieldEQ(FieldAgreementType, v))
+}
+
+// AgreementTypeNEQ applies the NEQ predicate on the "agreement_type" field.
+func AgreementTypeNEQ(v string) predicate.SLAViolation {
+	return predicate.SLAViolation(sql.FieldNEQ(FieldAgreementType, v))
+}
+
+// AgreementTypeIn applies the In predicate on the "agreement_type" field.
+func AgreementTypeIn(vs ...string) predicate.SLAViolation {
+	return predicate.SLAViolation(sql.FieldIn(FieldAgreementType, vs...))
+}
+
+// AgreementTypeNotIn applies the NotIn predicate on the "agreement_type" field.
+func AgreementTypeNotIn(vs ...string) predicate.SLAViolation {
+	return predicate.SLAViolation(sql.FieldNotIn(FieldAgreementType, vs...))
+}
+
+// AgreementTypeGT applies the GT predicate on the "agreement_type" field.
+func AgreementTypeGT(v string) predicate.SLAViolation {
+	return predicate.SLAViolation(sql.FieldGT(FieldAgreementType, v))
+}
+
+// AgreementTypeGTE applies the GTE predicate on the "agreement_type" field.
+func AgreementTypeGTE(v string) predicate.SLAViolation {
+	return predicate.SLAViolation(sql.FieldGTE(FieldAgreementType, v))
+}
+
+// AgreementTypeLT applies the LT predicate on the "agreement_type" field.
+func AgreementTypeLT(v string) predicate.SLAViolation {
+	return predicate.SLAViolation(sql.FieldLT(FieldAgreementType, v))
+}
+
+// AgreementTypeLTE applies the LTE predicate on the "agreement_type" field.
+func AgreementTypeLTE(v string) predicate.SLAViolation {
+	return predicate.SLAViolation(sql.FieldLTE(FieldAgreementType, v))
+}
+
+// AgreementTypeContains applies the Contains predicate on the "agreement_type" field.
+func AgreementTypeContains(v string) predicate.SLAViolation {
+	return predicate.SLAViolation(sql.FieldContains(FieldAgreementType, v))
+}
+
+// AgreementTypeHasPrefix applies the HasPrefix predicate on the "agreement_type" field.
+func AgreementTypeHasPrefix(v string) predicate.SLAViolation {
+	return predicate.SLAViolation(sql.FieldHasPrefix(FieldAgreementType, v))
+}
+
+// AgreementTypeHasSuffix applies the HasSuffix predicate on the "agreement_type" field.
+func AgreementTypeHasSuffix(v string) predicate.SLAViolation {
+	return predicate.SLAViolation(sql.FieldHasSuffix(FieldAgreementType, v))
+}
+
+// AgreementTypeEqualFold applies the EqualFold predicate on the "agreement_type" field.
+func AgreementTypeEqualFold(v string) predicate.SLAViolation {
+	return predicate.SLAViolation(sql.FieldEqualFold(FieldAgreementType, v))
+}
+
+// AgreementTypeContainsFold applies the ContainsFold predicate on the "agreement_type" field.
+func AgreementTypeContainsFold(v string) predicate.SLAViolation {
+	return predicate.SLAViolation(sql.FieldContainsFold(FieldAgreementType, v))
+}
+
+// MetricKeyEQ applies the EQ predicate on the "metric_key" field.
+func MetricKeyEQ(v string) predicate.SLAViolation {
+	return predicate.SLAViolation(sql.FieldEQ(FieldMetricKey, v))
+}
+
+// MetricKeyNEQ applies the NEQ predicate on the "metric_key" field.
+func MetricKeyNEQ(v string) predicate.SLAViolation {
+	return predicate.SLAViolation(sql.FieldNEQ(FieldMetricKey, v))
+}
+
+// MetricKeyIn applies the In predicate on the "metric_key" field.
+func MetricKeyIn(vs ...string) predicate.SLAViolation {
+	return predicate.SLAViolation(sql.FieldIn(FieldMetricKey, vs...))
+}
+
+// MetricKeyNotIn applies the NotIn predicate on the "metric_key" field.
+func MetricKeyNotIn(vs ...string) predicate.SLAViolation {
+	return predicate.SLAViolation(sql.FieldNotIn(FieldMetricKey, vs...))
+}
+
+// MetricKeyGT applies the GT predicate on the "metric_key" field.
+func MetricKeyGT(v string) predicate.SLAViolation {
+	return predicate.SLAViolation(sql.FieldGT(FieldMetricKey, v))
+}
+
+// MetricKeyGTE applies the GTE predicate on the "metric_key" field.
+func MetricKeyGTE(v string) predicate.SLAViolation {
+	return predicate.SLAViolation(sql.FieldGTE(FieldMetricKey, v))
+}
+
+// MetricKeyLT applies the LT predicate on the "metric_key" field.
+func MetricKeyLT(v string) predicate.SLAViolation {
+	return predicate.SLAViolation(sql.FieldLT(FieldMetricKey, v))
+}
+
+// MetricKeyLTE applies the LTE predicate on the "metric_key" field.
+func MetricKeyLTE(v string) predicate.SLAViolation {
+	return predicate.SLAViolation(sql.FieldLTE(FieldMetricKey, v))
+}
+
+// MetricKeyContains applies the Contains predicate on the "metric_key" field.
+func MetricKeyContains(v string) predicate.SLAViolation {
+	return predicate.SLAViolation(sql.FieldContains(FieldMetricKey, v))
+}
+
+// MetricKeyHasPrefix applies the HasPrefix predicate on the "metric_key" field.
+func MetricKeyHasPrefix(v string) predicate.SLAViolation {
+	return predicate.SLAViolation(sql.FieldHasPrefix(FieldMetricKey, v))
+}
+
+// MetricKeyHasSuffix applies the HasSuffix predicate on the "metric_key" field.
+func MetricKeyHasSuffix(v string) predicate.SLAViolation {
+	return predicate.SLAViolation(sql.FieldHasSuffix(FieldMetricKey, v))
+}
+
+// MetricKeyIsNil applies the IsNil predicate on the "metric_key" field.
+func MetricKeyIsNil() predicate.SLAViolation {
+	return predicate.SLAViolation(sql.FieldIsNull(FieldMetricKey))
+}
+
+// MetricKeyNotNil applies the NotNil predicate on the "metric_key" field.
+func MetricKeyNotNil() predicate.SLAViolation {
+	return predicate.SLAViolation(sql.FieldNotNull(FieldMetricKey))
+}
+
+// MetricKeyEqualFold applies the EqualFold predicate on the "metric_key" field.
+func MetricKeyEqualFold(v string) predicate.SLAViolation {
+	return predicate.SLAViolation(sql.FieldEqualFold(FieldMetricKey, v))
+}
+
+// MetricKeyContainsFold applies the ContainsFold predicate on the "metric_key" field.
+func MetricKeyContainsFold(v string) predicate.SLAViolation {
+	return predicate.SLAViolation(sql.FieldContainsFold(FieldMetricKey, v))
+}
+
 // ViolationTimeEQ applies the EQ predicate on the "violation_time" field.
 func ViolationTimeEQ(v time.Time) predicate.SLAViolation {
 	return predicate.SLAViolation(sql.FieldEQ(FieldViolationTime, v))
@@ -82,6 +82,34 @@ func (_c *SLAViolationCreate) SetViolationType(v string) *SLAViolationCreate {
 	return _c
 }
 
+// SetAgreementType sets the "agreement_type" field.
+func (_c *SLAViolationCreate) SetAgreementType(v string) *SLAViolationCreate {
+	_c.mutation.SetAgreementType(v)
+	return _c
+}
+
+// SetNillableAgreementType sets the "agreement_type" field if the given value is not nil.
+func (_c *SLAViolationCreate) SetNillableAgreementType(v *string) *SLAViolationCreate {
+	if v != nil {
+		_c.SetAgreementType(*v)
+	}
+	return _c
+}
+
+// SetMetricKey sets the "metric_key" field.
+func (_c *SLAViolationCreate) SetMetricKey(v string) *SLAViolationCreate {
+	_c.mutation.SetMetricKey(v)
+	return _c
+}
+
+// SetNillableMetricKey sets the "metric_key" field if the given value is not nil.
+func (_c *SLAViolationCreate) SetNillableMetricKey(v *string) *SLAViolationCreate {
+	if v != nil {
+		_c.SetMetricKey(*v)
+	}
+	return _c
+}
+
 // SetViolationTime sets the "violation_time" field.
 func (_c *SLAViolationCreate) SetViolationTime(v time.Time) *SLAViolationCreate {
 	_c.mutation.SetViolationTime(v)
@@ -327,6 +355,10 @@ func (_c *SLAViolationCreate) defaults() {
 		v := slaviolation.DefaultSLAName
 		_c.mutation.SetSLAName(v)
 	}
+	if _, ok := _c.mutation.AgreementType(); !ok {
+		v := slaviolation.DefaultAgreementType
+		_c.mutation.SetAgreementType(v)
+	}
 	if _, ok := _c.mutation.ViolationTime(); !ok {
 		v := slaviolation.DefaultViolationTime()
 		_c.mutation.SetViolationTime(v)
@@ -396,6 +428,9 @@ func (_c *SLAViolationCreate) check() error {
 			return &ValidationError{Name: "violation_type", err: fmt.Errorf(`ent: validator failed for field "SLAViolation.violation_type": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.AgreementType(); !ok {
+		return &ValidationError{Name: "agreement_type", err: errors.New(`ent: missing required field "SLAViolation.agreement_type"`)}
+	}
 	if _, ok := _c.mutation.ViolationTime(); !ok {
 		return &ValidationError{Name: "violation_time", err: errors.New(`ent: missing required field "SLAViolation.violation_time"`)}
 	}
@@ -476,6 +511,14 @@ func (_c *SLAViolationCreate) createSpec() (*SLAViolation, *sqlgraph.CreateSpec)
 		_spec.SetField(slaviolation.FieldViolationType, field.TypeString, value)
 		_node.ViolationType = value
 	}
+	if value, ok := _c.mutation.AgreementType(); ok {
+		_spec.SetField(slaviolation.FieldAgreementType, field.TypeString, value)
+		_node.AgreementType = value
+	}
+	if value, ok := _c.mutation.MetricKey(); ok {
+		_spec.SetField(slaviolation.FieldMetricKey, field.TypeString, value)
+		_node.MetricKey = value
+	}
 	if value, ok := _c.mutation.ViolationTime(); ok {
 		_spec.SetField(slaviolation.FieldViolationTime, field.TypeTime, value)
 		_node.ViolationTime = value
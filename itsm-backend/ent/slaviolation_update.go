@@ -121,6 +121,40 @@ func (_u *SLAViolationUpdate) SetNillableViolationType(v *string) *SLAViolationU
 	return _u
 }
 
+// SetAgreementType sets the "agreement_type" field.
+func (_u *SLAViolationUpdate) SetAgreementType(v string) *SLAViolationUpdate {
+	_u.mutation.SetAgreementType(v)
+	return _u
+}
+
+// SetNillableAgreementType sets the "agreement_type" field if the given value is not nil.
+func (_u *SLAViolationUpdate) SetNillableAgreementType(v *string) *SLAViolationUpdate {
+	if v != nil {
+		_u.SetAgreementType(*v)
+	}
+	return _u
+}
+
+// SetMetricKey sets the "metric_key" field.
+func (_u *SLAViolationUpdate) SetMetricKey(v string) *SLAViolationUpdate {
+	_u.mutation.SetMetricKey(v)
+	return _u
+}
+
+// SetNillableMetricKey sets the "metric_key" field if the given value is not nil.
+func (_u *SLAViolationUpdate) SetNillableMetricKey(v *string) *SLAViolationUpdate {
+	if v != nil {
+		_u.SetMetricKey(*v)
+	}
+	return _u
+}
+
+// ClearMetricKey clears the value of the "metric_key" field.
+func (_u *SLAViolationUpdate) ClearMetricKey() *SLAViolationUpdate {
+	_u.mutation.ClearMetricKey()
+	return _u
+}
+
 // SetViolationTime sets the "violation_time" field.
 func (_u *SLAViolationUpdate) SetViolationTime(v time.Time) *SLAViolationUpdate {
 	_u.mutation.SetViolationTime(v)
@@ -488,6 +522,15 @@ func (_u *SLAViolationUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if value, ok := _u.mutation.ViolationType(); ok {
 		_spec.SetField(slaviolation.FieldViolationType, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.AgreementType(); ok {
+		_spec.SetField(slaviolation.FieldAgreementType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MetricKey(); ok {
+		_spec.SetField(slaviolation.FieldMetricKey, field.TypeString, value)
+	}
+	if _u.mutation.MetricKeyCleared() {
+		_spec.ClearField(slaviolation.FieldMetricKey, field.TypeString)
+	}
 	if value, ok := _u.mutation.ViolationTime(); ok {
 		_spec.SetField(slaviolation.FieldViolationTime, field.TypeTime, value)
 	}
@@ -726,6 +769,40 @@ func (_u *SLAViolationUpdateOne) SetNillableViolationType(v *string) *SLAViolati
 	return _u
 }
 
+// SetAgreementType sets the "agreement_type" field.
+func (_u *SLAViolationUpdateOne) SetAgreementType(v string) *SLAViolationUpdateOne {
+	_u.mutation.SetAgreementType(v)
+	return _u
+}
+
+// SetNillableAgreementType sets the "agreement_type" field if the given value is not nil.
+func (_u *SLAViolationUpdateOne) SetNillableAgreementType(v *string) *SLAViolationUpdateOne {
+	if v != nil {
+		_u.SetAgreementType(*v)
+	}
+	return _u
+}
+
+// SetMetricKey sets the "metric_key" field.
+func (_u *SLAViolationUpdateOne) SetMetricKey(v string) *SLAViolationUpdateOne {
+	_u.mutation.SetMetricKey(v)
+	return _u
+}
+
+// SetNillableMetricKey sets the "metric_key" field if the given value is not nil.
+func (_u *SLAViolationUpdateOne) SetNillableMetricKey(v *string) *SLAViolationUpdateOne {
+	if v != nil {
+		_u.SetMetricKey(*v)
+	}
+	return _u
+}
+
+// ClearMetricKey clears the value of the "metric_key" field.
+func (_u *SLAViolationUpdateOne) ClearMetricKey() *SLAViolationUpdateOne {
+	_u.mutation.ClearMetricKey()
+	return _u
+}
+
 // SetViolationTime sets the "violation_time" field.
 func (_u *SLAViolationUpdateOne) SetViolationTime(v time.Time) *SLAViolationUpdateOne {
 	_u.mutation.SetViolationTime(v)
@@ -1123,6 +1200,15 @@ func (_u *SLAViolationUpdateOne) sqlSave(ctx context.Context) (_node *SLAViolati
 	if value, ok := _u.mutation.ViolationType(); ok {
 		_spec.SetField(slaviolation.FieldViolationType, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.AgreementType(); ok {
+		_spec.SetField(slaviolation.FieldAgreementType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MetricKey(); ok {
+		_spec.SetField(slaviolation.FieldMetricKey, field.TypeString, value)
+	}
+	if _u.mutation.MetricKeyCleared() {
+		_spec.ClearField(slaviolation.FieldMetricKey, field.TypeString)
+	}
 	if value, ok := _u.mutation.ViolationTime(); ok {
 		_spec.SetField(slaviolation.FieldViolationTime, field.TypeTime, value)
 	}
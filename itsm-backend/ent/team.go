@@ -27,6 +27,8 @@ type Team struct {
 	Status string `json:"status,omitempty"`
 	// 负责人ID
 	ManagerID int `json:"manager_id,omitempty"`
+	// 工作日历ID
+	CalendarID *int `json:"calendar_id,omitempty"`
 	// 租户ID
 	TenantID int `json:"tenant_id,omitempty"`
 	// 创建时间
@@ -47,9 +49,11 @@ type TeamEdges struct {
 	Users []*User `json:"users,omitempty"`
 	// 团队标签
 	Tags []*Tag `json:"tags,omitempty"`
+	// 团队承担的内部运营级别协议
+	Olas []*SLAPolicy `json:"olas,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // UsersOrErr returns the Users value or an error if the edge
@@ -70,12 +74,21 @@ func (e TeamEdges) TagsOrErr() ([]*Tag, error) {
 	return nil, &NotLoadedError{edge: "tags"}
 }
 
+// OlasOrErr returns the Olas value or an error if the edge
+// was not loaded in eager-loading.
+func (e TeamEdges) OlasOrErr() ([]*SLAPolicy, error) {
+	if e.loadedTypes[2] {
+		return e.Olas, nil
+	}
+	return nil, &NotLoadedError{edge: "olas"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Team) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case team.FieldID, team.FieldManagerID, team.FieldTenantID:
+		case team.FieldID, team.FieldManagerID, team.FieldCalendarID, team.FieldTenantID:
 			values[i] = new(sql.NullInt64)
 		case team.FieldName, team.FieldCode, team.FieldDescription, team.FieldStatus:
 			values[i] = new(sql.NullString)
@@ -132,6 +145,13 @@ func (_m *Team) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ManagerID = int(value.Int64)
 			}
+		case team.FieldCalendarID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field calendar_id", values[i])
+			} else if value.Valid {
+				_m.CalendarID = new(int)
+				*_m.CalendarID = int(value.Int64)
+			}
 		case team.FieldTenantID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
@@ -180,6 +200,11 @@ func (_m *Team) QueryTags() *TagQuery {
 	return NewTeamClient(_m.config).QueryTags(_m)
 }
 
+// QueryOlas queries the "olas" edge of the Team entity.
+func (_m *Team) QueryOlas() *SLAPolicyQuery {
+	return NewTeamClient(_m.config).QueryOlas(_m)
+}
+
 // Update returns a builder for updating this Team.
 // Note that you need to call Team.Unwrap() before calling this method if this Team
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -218,6 +243,11 @@ func (_m *Team) String() string {
 	builder.WriteString("manager_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ManagerID))
 	builder.WriteString(", ")
+	if v := _m.CalendarID; v != nil {
+		builder.WriteString("calendar_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("tenant_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
 	builder.WriteString(", ")
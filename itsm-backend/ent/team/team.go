@@ -24,6 +24,8 @@ const (
 	FieldStatus = "status"
 	// FieldManagerID holds the string denoting the manager_id field in the database.
 	FieldManagerID = "manager_id"
+	// FieldCalendarID holds the string denoting the calendar_id field in the database.
+	FieldCalendarID = "calendar_id"
 	// FieldTenantID holds the string denoting the tenant_id field in the database.
 	FieldTenantID = "tenant_id"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -36,6 +38,8 @@ const (
 	EdgeUsers = "users"
 	// EdgeTags holds the string denoting the tags edge name in mutations.
 	EdgeTags = "tags"
+	// EdgeOlas holds the string denoting the olas edge name in mutations.
+	EdgeOlas = "olas"
 	// Table holds the table name of the team in the database.
 	Table = "teams"
 	// UsersTable is the table that holds the users relation/edge.
@@ -50,6 +54,13 @@ const (
 	// TagsInverseTable is the table name for the Tag entity.
 	// It exists in this package in order to avoid circular dependency with the "tag" package.
 	TagsInverseTable = "tags"
+	// OlasTable is the table that holds the olas relation/edge.
+	OlasTable = "sla_policies"
+	// OlasInverseTable is the table name for the SLAPolicy entity.
+	// It exists in this package in order to avoid circular dependency with the "slapolicy" package.
+	OlasInverseTable = "sla_policies"
+	// OlasColumn is the table column denoting the olas relation/edge.
+	OlasColumn = "team_id"
 )
 
 // Columns holds all SQL columns for team fields.
@@ -60,6 +71,7 @@ var Columns = []string{
 	FieldDescription,
 	FieldStatus,
 	FieldManagerID,
+	FieldCalendarID,
 	FieldTenantID,
 	FieldCreatedAt,
 	FieldUpdatedAt,
@@ -132,6 +144,11 @@ func ByManagerID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldManagerID, opts...).ToFunc()
 }
 
+// ByCalendarID orders the results by the calendar_id field.
+func ByCalendarID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCalendarID, opts...).ToFunc()
+}
+
 // ByTenantID orders the results by the tenant_id field.
 func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
@@ -179,6 +196,20 @@ func ByTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByOlasCount orders the results by olas count.
+func ByOlasCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOlasStep(), opts...)
+	}
+}
+
+// ByOlas orders the results by olas terms.
+func ByOlas(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOlasStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUsersStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -193,3 +224,10 @@ func newTagsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, false, TagsTable, TagsPrimaryKey...),
 	)
 }
+func newOlasStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OlasInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OlasTable, OlasColumn),
+	)
+}
@@ -80,6 +80,11 @@ func ManagerID(v int) predicate.Team {
 	return predicate.Team(sql.FieldEQ(FieldManagerID, v))
 }
 
+// CalendarID applies equality check predicate on the "calendar_id" field. It's identical to CalendarIDEQ.
+func CalendarID(v int) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldCalendarID, v))
+}
+
 // TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
 func TenantID(v int) predicate.Team {
 	return predicate.Team(sql.FieldEQ(FieldTenantID, v))
@@ -420,6 +425,56 @@ func ManagerIDNotNil() predicate.Team {
 	return predicate.Team(sql.FieldNotNull(FieldManagerID))
 }
 
+// CalendarIDEQ applies the EQ predicate on the "calendar_id" field.
+func CalendarIDEQ(v int) predicate.Team {
+	return predicate.Team(sql.FieldEQ(FieldCalendarID, v))
+}
+
+// CalendarIDNEQ applies the NEQ predicate on the "calendar_id" field.
+func CalendarIDNEQ(v int) predicate.Team {
+	return predicate.Team(sql.FieldNEQ(FieldCalendarID, v))
+}
+
+// CalendarIDIn applies the In predicate on the "calendar_id" field.
+func CalendarIDIn(vs ...int) predicate.Team {
+	return predicate.Team(sql.FieldIn(FieldCalendarID, vs...))
+}
+
+// CalendarIDNotIn applies the NotIn predicate on the "calendar_id" field.
+func CalendarIDNotIn(vs ...int) predicate.Team {
+	return predicate.Team(sql.FieldNotIn(FieldCalendarID, vs...))
+}
+
+// CalendarIDGT applies the GT predicate on the "calendar_id" field.
+func CalendarIDGT(v int) predicate.Team {
+	return predicate.Team(sql.FieldGT(FieldCalendarID, v))
+}
+
+// CalendarIDGTE applies the GTE predicate on the "calendar_id" field.
+func CalendarIDGTE(v int) predicate.Team {
+	return predicate.Team(sql.FieldGTE(FieldCalendarID, v))
+}
+
+// CalendarIDLT applies the LT predicate on the "calendar_id" field.
+func CalendarIDLT(v int) predicate.Team {
+	return predicate.Team(sql.FieldLT(FieldCalendarID, v))
+}
+
+// CalendarIDLTE applies the LTE predicate on the "calendar_id" field.
+func CalendarIDLTE(v int) predicate.Team {
+	return predicate.Team(sql.FieldLTE(FieldCalendarID, v))
+}
+
+// CalendarIDIsNil applies the IsNil predicate on the "calendar_id" field.
+func CalendarIDIsNil() predicate.Team {
+	return predicate.Team(sql.FieldIsNull(FieldCalendarID))
+}
+
+// CalendarIDNotNil applies the NotNil predicate on the "calendar_id" field.
+func CalendarIDNotNil() predicate.Team {
+	return predicate.Team(sql.FieldNotNull(FieldCalendarID))
+}
+
 // TenantIDEQ applies the EQ predicate on the "tenant_id" field.
 func TenantIDEQ(v int) predicate.Team {
 	return predicate.Team(sql.FieldEQ(FieldTenantID, v))
@@ -636,6 +691,29 @@ func HasTagsWith(preds ...predicate.Tag) predicate.Team {
 	})
 }
 
+// HasOlas applies the HasEdge predicate on the "olas" edge.
+func HasOlas() predicate.Team {
+	return predicate.Team(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OlasTable, OlasColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOlasWith applies the HasEdge predicate on the "olas" edge with a given conditions (other predicates).
+func HasOlasWith(preds ...predicate.SLAPolicy) predicate.Team {
+	return predicate.Team(func(s *sql.Selector) {
+		step := newOlasStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Team) predicate.Team {
 	return predicate.Team(sql.AndPredicates(predicates...))
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"itsm-backend/ent/slapolicy"
 	"itsm-backend/ent/tag"
 	"itsm-backend/ent/team"
 	"itsm-backend/ent/user"
@@ -76,6 +77,20 @@ func (_c *TeamCreate) SetNillableManagerID(v *int) *TeamCreate {
 	return _c
 }
 
+// SetCalendarID sets the "calendar_id" field.
+func (_c *TeamCreate) SetCalendarID(v int) *TeamCreate {
+	_c.mutation.SetCalendarID(v)
+	return _c
+}
+
+// SetNillableCalendarID sets the "calendar_id" field if the given value is not nil.
+func (_c *TeamCreate) SetNillableCalendarID(v *int) *TeamCreate {
+	if v != nil {
+		_c.SetCalendarID(*v)
+	}
+	return _c
+}
+
 // SetTenantID sets the "tenant_id" field.
 func (_c *TeamCreate) SetTenantID(v int) *TeamCreate {
 	_c.mutation.SetTenantID(v)
@@ -154,6 +169,21 @@ func (_c *TeamCreate) AddTags(v ...*Tag) *TeamCreate {
 	return _c.AddTagIDs(ids...)
 }
 
+// AddOlaIDs adds the "olas" edge to the SLAPolicy entity by IDs.
+func (_c *TeamCreate) AddOlaIDs(ids ...int) *TeamCreate {
+	_c.mutation.AddOlaIDs(ids...)
+	return _c
+}
+
+// AddOlas adds the "olas" edges to the SLAPolicy entity.
+func (_c *TeamCreate) AddOlas(v ...*SLAPolicy) *TeamCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddOlaIDs(ids...)
+}
+
 // Mutation returns the TeamMutation object of the builder.
 func (_c *TeamCreate) Mutation() *TeamMutation {
 	return _c.mutation
@@ -284,6 +314,10 @@ func (_c *TeamCreate) createSpec() (*Team, *sqlgraph.CreateSpec) {
 		_spec.SetField(team.FieldManagerID, field.TypeInt, value)
 		_node.ManagerID = value
 	}
+	if value, ok := _c.mutation.CalendarID(); ok {
+		_spec.SetField(team.FieldCalendarID, field.TypeInt, value)
+		_node.CalendarID = &value
+	}
 	if value, ok := _c.mutation.TenantID(); ok {
 		_spec.SetField(team.FieldTenantID, field.TypeInt, value)
 		_node.TenantID = value
@@ -332,6 +366,22 @@ func (_c *TeamCreate) createSpec() (*Team, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.OlasIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.OlasTable,
+			Columns: []string{team.OlasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slapolicy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
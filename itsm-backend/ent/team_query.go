@@ -7,6 +7,7 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"itsm-backend/ent/predicate"
+	"itsm-backend/ent/slapolicy"
 	"itsm-backend/ent/tag"
 	"itsm-backend/ent/team"
 	"itsm-backend/ent/user"
@@ -27,6 +28,7 @@ type TeamQuery struct {
 	predicates []predicate.Team
 	withUsers  *UserQuery
 	withTags   *TagQuery
+	withOlas   *SLAPolicyQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -107,6 +109,28 @@ func (_q *TeamQuery) QueryTags() *TagQuery {
 	return query
 }
 
+// QueryOlas chains the current query on the "olas" edge.
+func (_q *TeamQuery) QueryOlas() *SLAPolicyQuery {
+	query := (&SLAPolicyClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(team.Table, team.FieldID, selector),
+			sqlgraph.To(slapolicy.Table, slapolicy.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, team.OlasTable, team.OlasColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Team entity from the query.
 // Returns a *NotFoundError when no Team was found.
 func (_q *TeamQuery) First(ctx context.Context) (*Team, error) {
@@ -301,6 +325,7 @@ func (_q *TeamQuery) Clone() *TeamQuery {
 		predicates: append([]predicate.Team{}, _q.predicates...),
 		withUsers:  _q.withUsers.Clone(),
 		withTags:   _q.withTags.Clone(),
+		withOlas:   _q.withOlas.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -329,6 +354,17 @@ func (_q *TeamQuery) WithTags(opts ...func(*TagQuery)) *TeamQuery {
 	return _q
 }
 
+// WithOlas tells the query-builder to eager-load the nodes that are connected to
+// the "olas" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TeamQuery) WithOlas(opts ...func(*SLAPolicyQuery)) *TeamQuery {
+	query := (&SLAPolicyClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withOlas = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -407,9 +443,10 @@ func (_q *TeamQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Team, e
 	var (
 		nodes       = []*Team{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			_q.withUsers != nil,
 			_q.withTags != nil,
+			_q.withOlas != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -444,6 +481,13 @@ func (_q *TeamQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Team, e
 			return nil, err
 		}
 	}
+	if query := _q.withOlas; query != nil {
+		if err := _q.loadOlas(ctx, query, nodes,
+			func(n *Team) { n.Edges.Olas = []*SLAPolicy{} },
+			func(n *Team, e *SLAPolicy) { n.Edges.Olas = append(n.Edges.Olas, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -539,6 +583,39 @@ func (_q *TeamQuery) loadTags(ctx context.Context, query *TagQuery, nodes []*Tea
 	}
 	return nil
 }
+func (_q *TeamQuery) loadOlas(ctx context.Context, query *SLAPolicyQuery, nodes []*Team, init func(*Team), assign func(*Team, *SLAPolicy)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Team)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(slapolicy.FieldTeamID)
+	}
+	query.Where(predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(team.OlasColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TeamID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "team_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "team_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *TeamQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
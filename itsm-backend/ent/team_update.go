@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"itsm-backend/ent/predicate"
+	"itsm-backend/ent/slapolicy"
 	"itsm-backend/ent/tag"
 	"itsm-backend/ent/team"
 	"itsm-backend/ent/user"
@@ -119,6 +120,33 @@ func (_u *TeamUpdate) ClearManagerID() *TeamUpdate {
 	return _u
 }
 
+// SetCalendarID sets the "calendar_id" field.
+func (_u *TeamUpdate) SetCalendarID(v int) *TeamUpdate {
+	_u.mutation.ResetCalendarID()
+	_u.mutation.SetCalendarID(v)
+	return _u
+}
+
+// SetNillableCalendarID sets the "calendar_id" field if the given value is not nil.
+func (_u *TeamUpdate) SetNillableCalendarID(v *int) *TeamUpdate {
+	if v != nil {
+		_u.SetCalendarID(*v)
+	}
+	return _u
+}
+
+// AddCalendarID adds value to the "calendar_id" field.
+func (_u *TeamUpdate) AddCalendarID(v int) *TeamUpdate {
+	_u.mutation.AddCalendarID(v)
+	return _u
+}
+
+// ClearCalendarID clears the value of the "calendar_id" field.
+func (_u *TeamUpdate) ClearCalendarID() *TeamUpdate {
+	_u.mutation.ClearCalendarID()
+	return _u
+}
+
 // SetTenantID sets the "tenant_id" field.
 func (_u *TeamUpdate) SetTenantID(v int) *TeamUpdate {
 	_u.mutation.ResetTenantID()
@@ -210,6 +238,21 @@ func (_u *TeamUpdate) AddTags(v ...*Tag) *TeamUpdate {
 	return _u.AddTagIDs(ids...)
 }
 
+// AddOlaIDs adds the "olas" edge to the SLAPolicy entity by IDs.
+func (_u *TeamUpdate) AddOlaIDs(ids ...int) *TeamUpdate {
+	_u.mutation.AddOlaIDs(ids...)
+	return _u
+}
+
+// AddOlas adds the "olas" edges to the SLAPolicy entity.
+func (_u *TeamUpdate) AddOlas(v ...*SLAPolicy) *TeamUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOlaIDs(ids...)
+}
+
 // Mutation returns the TeamMutation object of the builder.
 func (_u *TeamUpdate) Mutation() *TeamMutation {
 	return _u.mutation
@@ -257,6 +300,27 @@ func (_u *TeamUpdate) RemoveTags(v ...*Tag) *TeamUpdate {
 	return _u.RemoveTagIDs(ids...)
 }
 
+// ClearOlas clears all "olas" edges to the SLAPolicy entity.
+func (_u *TeamUpdate) ClearOlas() *TeamUpdate {
+	_u.mutation.ClearOlas()
+	return _u
+}
+
+// RemoveOlaIDs removes the "olas" edge to SLAPolicy entities by IDs.
+func (_u *TeamUpdate) RemoveOlaIDs(ids ...int) *TeamUpdate {
+	_u.mutation.RemoveOlaIDs(ids...)
+	return _u
+}
+
+// RemoveOlas removes "olas" edges to SLAPolicy entities.
+func (_u *TeamUpdate) RemoveOlas(v ...*SLAPolicy) *TeamUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOlaIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *TeamUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -349,6 +413,15 @@ func (_u *TeamUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ManagerIDCleared() {
 		_spec.ClearField(team.FieldManagerID, field.TypeInt)
 	}
+	if value, ok := _u.mutation.CalendarID(); ok {
+		_spec.SetField(team.FieldCalendarID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCalendarID(); ok {
+		_spec.AddField(team.FieldCalendarID, field.TypeInt, value)
+	}
+	if _u.mutation.CalendarIDCleared() {
+		_spec.ClearField(team.FieldCalendarID, field.TypeInt)
+	}
 	if value, ok := _u.mutation.TenantID(); ok {
 		_spec.SetField(team.FieldTenantID, field.TypeInt, value)
 	}
@@ -457,6 +530,51 @@ func (_u *TeamUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.OlasCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.OlasTable,
+			Columns: []string{team.OlasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slapolicy.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOlasIDs(); len(nodes) > 0 && !_u.mutation.OlasCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.OlasTable,
+			Columns: []string{team.OlasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slapolicy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OlasIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.OlasTable,
+			Columns: []string{team.OlasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slapolicy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{team.Label}
@@ -566,6 +684,33 @@ func (_u *TeamUpdateOne) ClearManagerID() *TeamUpdateOne {
 	return _u
 }
 
+// SetCalendarID sets the "calendar_id" field.
+func (_u *TeamUpdateOne) SetCalendarID(v int) *TeamUpdateOne {
+	_u.mutation.ResetCalendarID()
+	_u.mutation.SetCalendarID(v)
+	return _u
+}
+
+// SetNillableCalendarID sets the "calendar_id" field if the given value is not nil.
+func (_u *TeamUpdateOne) SetNillableCalendarID(v *int) *TeamUpdateOne {
+	if v != nil {
+		_u.SetCalendarID(*v)
+	}
+	return _u
+}
+
+// AddCalendarID adds value to the "calendar_id" field.
+func (_u *TeamUpdateOne) AddCalendarID(v int) *TeamUpdateOne {
+	_u.mutation.AddCalendarID(v)
+	return _u
+}
+
+// ClearCalendarID clears the value of the "calendar_id" field.
+func (_u *TeamUpdateOne) ClearCalendarID() *TeamUpdateOne {
+	_u.mutation.ClearCalendarID()
+	return _u
+}
+
 // SetTenantID sets the "tenant_id" field.
 func (_u *TeamUpdateOne) SetTenantID(v int) *TeamUpdateOne {
 	_u.mutation.ResetTenantID()
@@ -657,6 +802,21 @@ func (_u *TeamUpdateOne) AddTags(v ...*Tag) *TeamUpdateOne {
 	return _u.AddTagIDs(ids...)
 }
 
+// AddOlaIDs adds the "olas" edge to the SLAPolicy entity by IDs.
+func (_u *TeamUpdateOne) AddOlaIDs(ids ...int) *TeamUpdateOne {
+	_u.mutation.AddOlaIDs(ids...)
+	return _u
+}
+
+// AddOlas adds the "olas" edges to the SLAPolicy entity.
+func (_u *TeamUpdateOne) AddOlas(v ...*SLAPolicy) *TeamUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOlaIDs(ids...)
+}
+
 // Mutation returns the TeamMutation object of the builder.
 func (_u *TeamUpdateOne) Mutation() *TeamMutation {
 	return _u.mutation
@@ -704,6 +864,27 @@ func (_u *TeamUpdateOne) RemoveTags(v ...*Tag) *TeamUpdateOne {
 	return _u.RemoveTagIDs(ids...)
 }
 
+// ClearOlas clears all "olas" edges to the SLAPolicy entity.
+func (_u *TeamUpdateOne) ClearOlas() *TeamUpdateOne {
+	_u.mutation.ClearOlas()
+	return _u
+}
+
+// RemoveOlaIDs removes the "olas" edge to SLAPolicy entities by IDs.
+func (_u *TeamUpdateOne) RemoveOlaIDs(ids ...int) *TeamUpdateOne {
+	_u.mutation.RemoveOlaIDs(ids...)
+	return _u
+}
+
+// RemoveOlas removes "olas" edges to SLAPolicy entities.
+func (_u *TeamUpdateOne) RemoveOlas(v ...*SLAPolicy) *TeamUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOlaIDs(ids...)
+}
+
 // Where appends a list predicates to the TeamUpdate builder.
 func (_u *TeamUpdateOne) Where(ps ...predicate.Team) *TeamUpdateOne {
 	_u.mutation.Where(ps...)
@@ -826,6 +1007,15 @@ func (_u *TeamUpdateOne) sqlSave(ctx context.Context) (_node *Team, err error) {
 	if _u.mutation.ManagerIDCleared() {
 		_spec.ClearField(team.FieldManagerID, field.TypeInt)
 	}
+	if value, ok := _u.mutation.CalendarID(); ok {
+		_spec.SetField(team.FieldCalendarID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCalendarID(); ok {
+		_spec.AddField(team.FieldCalendarID, field.TypeInt, value)
+	}
+	if _u.mutation.CalendarIDCleared() {
+		_spec.ClearField(team.FieldCalendarID, field.TypeInt)
+	}
 	if value, ok := _u.mutation.TenantID(); ok {
 		_spec.SetField(team.FieldTenantID, field.TypeInt, value)
 	}
@@ -934,6 +1124,51 @@ func (_u *TeamUpdateOne) sqlSave(ctx context.Context) (_node *Team, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.OlasCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.OlasTable,
+			Columns: []string{team.OlasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slapolicy.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOlasIDs(); len(nodes) > 0 && !_u.mutation.OlasCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.OlasTable,
+			Columns: []string{team.OlasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slapolicy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OlasIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   team.OlasTable,
+			Columns: []string{team.OlasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slapolicy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Team{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
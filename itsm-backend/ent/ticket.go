@@ -60,6 +60,12 @@ type Ticket struct {
 	SLAResponseDeadline time.Time `json:"sla_response_deadline,omitempty"`
 	// SLA解决截止时间
 	SLAResolutionDeadline time.Time `json:"sla_resolution_deadline,omitempty"`
+	// 是否挂起（SLA定义配置 on_hold 暂停条件时停止计时）
+	SLAOnHold bool `json:"sla_on_hold,omitempty"`
+	// SLA计时暂停时间，为空表示正在计时
+	SLAPausedAt *time.Time `json:"sla_paused_at,omitempty"`
+	// 承接处理的供应商ID，用于跟踪供应商支撑合同
+	VendorID *int `json:"vendor_id,omitempty"`
 	// 首次响应时间
 	FirstResponseAt time.Time `json:"first_response_at,omitempty"`
 	// 解决时间
@@ -131,6 +137,10 @@ type TicketEdges struct {
 	SLAViolations []*SLAViolation `json:"sla_violations,omitempty"`
 	// SLAAlertHistory holds the value of the sla_alert_history edge.
 	SLAAlertHistory []*SLAAlertHistory `json:"sla_alert_history,omitempty"`
+	// SLA计时暂停区间
+	SLAPauses []*TicketSLAPause `json:"sla_pauses,omitempty"`
+	// SLA/OLA/UC 计时指标
+	SLAMetrics []*TicketSLAMetric `json:"sla_metrics,omitempty"`
 	// RootCauseAnalyses holds the value of the root_cause_analyses edge.
 	RootCauseAnalyses []*RootCauseAnalysis `json:"root_cause_analyses,omitempty"`
 	// FeishuSyncs holds the value of the feishu_syncs edge.
@@ -145,7 +155,7 @@ type TicketEdges struct {
 	ConfiguredType *TicketType `json:"configured_type,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [17]bool
+	loadedTypes [19]bool
 }
 
 // CommentsOrErr returns the Comments value or an error if the edge
@@ -247,10 +257,28 @@ func (e TicketEdges) SLAAlertHistoryOrErr() ([]*SLAAlertHistory, error) {
 	return nil, &NotLoadedError{edge: "sla_alert_history"}
 }
 
+// SLAPausesOrErr returns the SLAPauses value or an error if the edge
+// was not loaded in eager-loading.
+func (e TicketEdges) SLAPausesOrErr() ([]*TicketSLAPause, error) {
+	if e.loadedTypes[11] {
+		return e.SLAPauses, nil
+	}
+	return nil, &NotLoadedError{edge: "sla_pauses"}
+}
+
+// SLAMetricsOrErr returns the SLAMetrics value or an error if the edge
+// was not loaded in eager-loading.
+func (e TicketEdges) SLAMetricsOrErr() ([]*TicketSLAMetric, error) {
+	if e.loadedTypes[12] {
+		return e.SLAMetrics, nil
+	}
+	return nil, &NotLoadedError{edge: "sla_metrics"}
+}
+
 // RootCauseAnalysesOrErr returns the RootCauseAnalyses value or an error if the edge
 // was not loaded in eager-loading.
 func (e TicketEdges) RootCauseAnalysesOrErr() ([]*RootCauseAnalysis, error) {
-	if e.loadedTypes[11] {
+	if e.loadedTypes[13] {
 		return e.RootCauseAnalyses, nil
 	}
 	return nil, &NotLoadedError{edge: "root_cause_analyses"}
@@ -259,7 +287,7 @@ func (e TicketEdges) RootCauseAnalysesOrErr() ([]*RootCauseAnalysis, error) {
 // FeishuSyncsOrErr returns the FeishuSyncs value or an error if the edge
 // was not loaded in eager-loading.
 func (e TicketEdges) FeishuSyncsOrErr() ([]*FeishuTicketSync, error) {
-	if e.loadedTypes[12] {
+	if e.loadedTypes[14] {
 		return e.FeishuSyncs, nil
 	}
 	return nil, &NotLoadedError{edge: "feishu_syncs"}
@@ -270,7 +298,7 @@ func (e TicketEdges) FeishuSyncsOrErr() ([]*FeishuTicketSync, error) {
 func (e TicketEdges) RequesterOrErr() (*User, error) {
 	if e.Requester != nil {
 		return e.Requester, nil
-	} else if e.loadedTypes[13] {
+	} else if e.loadedTypes[15] {
 		return nil, &NotFoundError{label: user.Label}
 	}
 	return nil, &NotLoadedError{edge: "requester"}
@@ -281,7 +309,7 @@ func (e TicketEdges) RequesterOrErr() (*User, error) {
 func (e TicketEdges) AssigneeOrErr() (*User, error) {
 	if e.Assignee != nil {
 		return e.Assignee, nil
-	} else if e.loadedTypes[14] {
+	} else if e.loadedTypes[16] {
 		return nil, &NotFoundError{label: user.Label}
 	}
 	return nil, &NotLoadedError{edge: "assignee"}
@@ -290,7 +318,7 @@ func (e TicketEdges) AssigneeOrErr() (*User, error) {
 // CategoryOrErr returns the Category value or an error if the edge
 // was not loaded in eager-loading.
 func (e TicketEdges) CategoryOrErr() ([]*TicketCategory, error) {
-	if e.loadedTypes[15] {
+	if e.loadedTypes[17] {
 		return e.Category, nil
 	}
 	return nil, &NotLoadedError{edge: "category"}
@@ -301,7 +329,7 @@ func (e TicketEdges) CategoryOrErr() ([]*TicketCategory, error) {
 func (e TicketEdges) ConfiguredTypeOrErr() (*TicketType, error) {
 	if e.ConfiguredType != nil {
 		return e.ConfiguredType, nil
-	} else if e.loadedTypes[16] {
+	} else if e.loadedTypes[18] {
 		return nil, &NotFoundError{label: tickettype.Label}
 	}
 	return nil, &NotLoadedError{edge: "configured_type"}
@@ -314,13 +342,13 @@ func (*Ticket) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case ticket.FieldFormFields:
 			values[i] = new([]byte)
-		case ticket.FieldIsManagedByMsp:
+		case ticket.FieldSLAOnHold, ticket.FieldIsManagedByMsp:
 			values[i] = new(sql.NullBool)
-		case ticket.FieldID, ticket.FieldTicketTypeID, ticket.FieldRequesterID, ticket.FieldAssigneeID, ticket.FieldTenantID, ticket.FieldTemplateID, ticket.FieldCategoryID, ticket.FieldDepartmentID, ticket.FieldParentTicketID, ticket.FieldSLADefinitionID, ticket.FieldRating, ticket.FieldRatedBy, ticket.FieldVersion, ticket.FieldMspProviderID, ticket.FieldManagedByUserID:
+		case ticket.FieldID, ticket.FieldTicketTypeID, ticket.FieldRequesterID, ticket.FieldAssigneeID, ticket.FieldTenantID, ticket.FieldTemplateID, ticket.FieldCategoryID, ticket.FieldDepartmentID, ticket.FieldParentTicketID, ticket.FieldSLADefinitionID, ticket.FieldVendorID, ticket.FieldRating, ticket.FieldRatedBy, ticket.FieldVersion, ticket.FieldMspProviderID, ticket.FieldManagedByUserID:
 			values[i] = new(sql.NullInt64)
 		case ticket.FieldTitle, ticket.FieldDescription, ticket.FieldStatus, ticket.FieldType, ticket.FieldTicketTypeCodeSnapshot, ticket.FieldTicketTypeNameSnapshot, ticket.FieldPriority, ticket.FieldTicketNumber, ticket.FieldResolution, ticket.FieldResolutionCategory, ticket.FieldRatingComment, ticket.FieldMspTicketID:
 			values[i] = new(sql.NullString)
-		case ticket.FieldSLAResponseDeadline, ticket.FieldSLAResolutionDeadline, ticket.FieldFirstResponseAt, ticket.FieldResolvedAt, ticket.FieldClosedAt, ticket.FieldRatedAt, ticket.FieldCreatedAt, ticket.FieldUpdatedAt, ticket.FieldDeletedAt:
+		case ticket.FieldSLAResponseDeadline, ticket.FieldSLAResolutionDeadline, ticket.FieldSLAPausedAt, ticket.FieldFirstResponseAt, ticket.FieldResolvedAt, ticket.FieldClosedAt, ticket.FieldRatedAt, ticket.FieldCreatedAt, ticket.FieldUpdatedAt, ticket.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		case ticket.ForeignKeys[0]: // configuration_item_tickets
 			values[i] = new(sql.NullInt64)
@@ -479,6 +507,26 @@ func (_m *Ticket) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.SLAResolutionDeadline = value.Time
 			}
+		case ticket.FieldSLAOnHold:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field sla_on_hold", values[i])
+			} else if value.Valid {
+				_m.SLAOnHold = value.Bool
+			}
+		case ticket.FieldSLAPausedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field sla_paused_at", values[i])
+			} else if value.Valid {
+				_m.SLAPausedAt = new(time.Time)
+				*_m.SLAPausedAt = value.Time
+			}
+		case ticket.FieldVendorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field vendor_id", values[i])
+			} else if value.Valid {
+				_m.VendorID = new(int)
+				*_m.VendorID = int(value.Int64)
+			}
 		case ticket.FieldFirstResponseAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field first_response_at", values[i])
@@ -700,6 +748,16 @@ func (_m *Ticket) QuerySLAAlertHistory() *SLAAlertHistoryQuery {
 	return NewTicketClient(_m.config).QuerySLAAlertHistory(_m)
 }
 
+// QuerySLAPauses queries the "sla_pauses" edge of the Ticket entity.
+func (_m *Ticket) QuerySLAPauses() *TicketSLAPauseQuery {
+	return NewTicketClient(_m.config).QuerySLAPauses(_m)
+}
+
+// QuerySLAMetrics queries the "sla_metrics" edge of the Ticket entity.
+func (_m *Ticket) QuerySLAMetrics() *TicketSLAMetricQuery {
+	return NewTicketClient(_m.config).QuerySLAMetrics(_m)
+}
+
 // QueryRootCauseAnalyses queries the "root_cause_analyses" edge of the Ticket entity.
 func (_m *Ticket) QueryRootCauseAnalyses() *RootCauseAnalysisQuery {
 	return NewTicketClient(_m.config).QueryRootCauseAnalyses(_m)
@@ -813,6 +871,19 @@ func (_m *Ticket) String() string {
 	builder.WriteString("sla_resolution_deadline=")
 	builder.WriteString(_m.SLAResolutionDeadline.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("sla_on_hold=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SLAOnHold))
+	builder.WriteString(", ")
+	if v := _m.SLAPausedAt; v != nil {
+		builder.WriteString("sla_paused_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.VendorID; v != nil {
+		builder.WriteString("vendor_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("first_response_at=")
 	builder.WriteString(_m.FirstResponseAt.Format(time.ANSIC))
 	builder.WriteString(", ")
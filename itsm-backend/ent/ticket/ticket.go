@@ -54,6 +54,12 @@ const (
 	FieldSLAResponseDeadline = "sla_response_deadline"
 	// FieldSLAResolutionDeadline holds the string denoting the sla_resolution_deadline field in the database.
 	FieldSLAResolutionDeadline = "sla_resolution_deadline"
+	// FieldSLAOnHold holds the string denoting the sla_on_hold field in the database.
+	FieldSLAOnHold = "sla_on_hold"
+	// FieldSLAPausedAt holds the string denoting the sla_paused_at field in the database.
+	FieldSLAPausedAt = "sla_paused_at"
+	// FieldVendorID holds the string denoting the vendor_id field in the database.
+	FieldVendorID = "vendor_id"
 	// FieldFirstResponseAt holds the string denoting the first_response_at field in the database.
 	FieldFirstResponseAt = "first_response_at"
 	// FieldResolvedAt holds the string denoting the resolved_at field in the database.
@@ -110,6 +116,10 @@ const (
 	EdgeSLAViolations = "sla_violations"
 	// EdgeSLAAlertHistory holds the string denoting the sla_alert_history edge name in mutations.
 	EdgeSLAAlertHistory = "sla_alert_history"
+	// EdgeSLAPauses holds the string denoting the sla_pauses edge name in mutations.
+	EdgeSLAPauses = "sla_pauses"
+	// EdgeSLAMetrics holds the string denoting the sla_metrics edge name in mutations.
+	EdgeSLAMetrics = "sla_metrics"
 	// EdgeRootCauseAnalyses holds the string denoting the root_cause_analyses edge name in mutations.
 	EdgeRootCauseAnalyses = "root_cause_analyses"
 	// EdgeFeishuSyncs holds the string denoting the feishu_syncs edge name in mutations.
@@ -196,6 +206,20 @@ const (
 	SLAAlertHistoryInverseTable = "sla_alert_histories"
 	// SLAAlertHistoryColumn is the table column denoting the sla_alert_history relation/edge.
 	SLAAlertHistoryColumn = "ticket_id"
+	// SLAPausesTable is the table that holds the sla_pauses relation/edge.
+	SLAPausesTable = "ticket_sla_pauses"
+	// SLAPausesInverseTable is the table name for the TicketSLAPause entity.
+	// It exists in this package in order to avoid circular dependency with the "ticketslapause" package.
+	SLAPausesInverseTable = "ticket_sla_pauses"
+	// SLAPausesColumn is the table column denoting the sla_pauses relation/edge.
+	SLAPausesColumn = "ticket_id"
+	// SLAMetricsTable is the table that holds the sla_metrics relation/edge.
+	SLAMetricsTable = "ticket_sla_metrics"
+	// SLAMetricsInverseTable is the table name for the TicketSLAMetric entity.
+	// It exists in this package in order to avoid circular dependency with the "ticketslametric" package.
+	SLAMetricsInverseTable = "ticket_sla_metrics"
+	// SLAMetricsColumn is the table column denoting the sla_metrics relation/edge.
+	SLAMetricsColumn = "ticket_id"
 	// RootCauseAnalysesTable is the table that holds the root_cause_analyses relation/edge.
 	RootCauseAnalysesTable = "root_cause_analyses"
 	// RootCauseAnalysesInverseTable is the table name for the RootCauseAnalysis entity.
@@ -261,6 +285,9 @@ var Columns = []string{
 	FieldSLADefinitionID,
 	FieldSLAResponseDeadline,
 	FieldSLAResolutionDeadline,
+	FieldSLAOnHold,
+	FieldSLAPausedAt,
+	FieldVendorID,
 	FieldFirstResponseAt,
 	FieldResolvedAt,
 	FieldResolution,
@@ -337,6 +364,8 @@ var (
 	RequesterIDValidator func(int) error
 	// TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
 	TenantIDValidator func(int) error
+	// DefaultSLAOnHold holds the default value on creation for the "sla_on_hold" field.
+	DefaultSLAOnHold bool
 	// RatingValidator is a validator for the "rating" field. It is called by the builders before save.
 	RatingValidator func(int) error
 	// DefaultVersion holds the default value on creation for the "version" field.
@@ -456,6 +485,21 @@ func BySLAResolutionDeadline(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSLAResolutionDeadline, opts...).ToFunc()
 }
 
+// BySLAOnHold orders the results by the sla_on_hold field.
+func BySLAOnHold(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSLAOnHold, opts...).ToFunc()
+}
+
+// BySLAPausedAt orders the results by the sla_paused_at field.
+func BySLAPausedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSLAPausedAt, opts...).ToFunc()
+}
+
+// ByVendorID orders the results by the vendor_id field.
+func ByVendorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVendorID, opts...).ToFunc()
+}
+
 // ByFirstResponseAt orders the results by the first_response_at field.
 func ByFirstResponseAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFirstResponseAt, opts...).ToFunc()
@@ -695,6 +739,34 @@ func BySLAAlertHistory(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// BySLAPausesCount orders the results by sla_pauses count.
+func BySLAPausesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSLAPausesStep(), opts...)
+	}
+}
+
+// BySLAPauses orders the results by sla_pauses terms.
+func BySLAPauses(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSLAPausesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySLAMetricsCount orders the results by sla_metrics count.
+func BySLAMetricsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSLAMetricsStep(), opts...)
+	}
+}
+
+// BySLAMetrics orders the results by sla_metrics terms.
+func BySLAMetrics(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSLAMetricsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByRootCauseAnalysesCount orders the results by root_cause_analyses count.
 func ByRootCauseAnalysesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -834,6 +906,20 @@ func newSLAAlertHistoryStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, SLAAlertHistoryTable, SLAAlertHistoryColumn),
 	)
 }
+func newSLAPausesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SLAPausesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SLAPausesTable, SLAPausesColumn),
+	)
+}
+func newSLAMetricsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SLAMetricsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SLAMetricsTable, SLAMetricsColumn),
+	)
+}
 func newRootCauseAnalysesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -150,6 +150,21 @@ func SLAResolutionDeadline(v time.Time) predicate.Ticket {
 	return predicate.Ticket(sql.FieldEQ(FieldSLAResolutionDeadline, v))
 }
 
+// SLAOnHold applies equality check predicate on the "sla_on_hold" field. It's identical to SLAOnHoldEQ.
+func SLAOnHold(v bool) predicate.Ticket {
+	return predicate.Ticket(sql.FieldEQ(FieldSLAOnHold, v))
+}
+
+// SLAPausedAt applies equality check predicate on the "sla_paused_at" field. It's identical to SLAPausedAtEQ.
+func SLAPausedAt(v time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldEQ(FieldSLAPausedAt, v))
+}
+
+// VendorID applies equality check predicate on the "vendor_id" field. It's identical to VendorIDEQ.
+func VendorID(v int) predicate.Ticket {
+	return predicate.Ticket(sql.FieldEQ(FieldVendorID, v))
+}
+
 // FirstResponseAt applies equality check predicate on the "first_response_at" field. It's identical to FirstResponseAtEQ.
 func FirstResponseAt(v time.Time) predicate.Ticket {
 	return predicate.Ticket(sql.FieldEQ(FieldFirstResponseAt, v))
@@ -1255,6 +1270,116 @@ func SLAResolutionDeadlineNotNil() predicate.Ticket {
 	return predicate.Ticket(sql.FieldNotNull(FieldSLAResolutionDeadline))
 }
 
+// SLAOnHoldEQ applies the EQ predicate on the "sla_on_hold" field.
+func SLAOnHoldEQ(v bool) predicate.Ticket {
+	return predicate.Ticket(sql.FieldEQ(FieldSLAOnHold, v))
+}
+
+// SLAOnHoldNEQ applies the NEQ predicate on the "sla_on_hold" field.
+func SLAOnHoldNEQ(v bool) predicate.Ticket {
+	return predicate.Ticket(sql.FieldNEQ(FieldSLAOnHold, v))
+}
+
+// SLAPausedAtEQ applies the EQ predicate on the "sla_paused_at" field.
+func SLAPausedAtEQ(v time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldEQ(FieldSLAPausedAt, v))
+}
+
+// SLAPausedAtNEQ applies the NEQ predicate on the "sla_paused_at" field.
+func SLAPausedAtNEQ(v time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldNEQ(FieldSLAPausedAt, v))
+}
+
+// SLAPausedAtIn applies the In predicate on the "sla_paused_at" field.
+func SLAPausedAtIn(vs ...time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldIn(FieldSLAPausedAt, vs...))
+}
+
+// SLAPausedAtNotIn applies the NotIn predicate on the "sla_paused_at" field.
+func SLAPausedAtNotIn(vs ...time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldNotIn(FieldSLAPausedAt, vs...))
+}
+
+// SLAPausedAtGT applies the GT predicate on the "sla_paused_at" field.
+func SLAPausedAtGT(v time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldGT(FieldSLAPausedAt, v))
+}
+
+// SLAPausedAtGTE applies the GTE predicate on the "sla_paused_at" field.
+func SLAPausedAtGTE(v time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldGTE(FieldSLAPausedAt, v))
+}
+
+// SLAPausedAtLT applies the LT predicate on the "sla_paused_at" field.
+func SLAPausedAtLT(v time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldLT(FieldSLAPausedAt, v))
+}
+
+// SLAPausedAtLTE applies the LTE predicate on the "sla_paused_at" field.
+func SLAPausedAtLTE(v time.Time) predicate.Ticket {
+	return predicate.Ticket(sql.FieldLTE(FieldSLAPausedAt, v))
+}
+
+// SLAPausedAtIsNil applies the IsNil predicate on the "sla_paused_at" field.
+func SLAPausedAtIsNil() predicate.Ticket {
+	return predicate.Ticket(sql.FieldIsNull(FieldSLAPausedAt))
+}
+
+// SLAPausedAtNotNil applies the NotNil predicate on the "sla_paused_at" field.
+func SLAPausedAtNotNil() predicate.Ticket {
+	return predicate.Ticket(sql.FieldNotNull(FieldSLAPausedAt))
+}
+
+// VendorIDEQ applies the EQ predicate on the "vendor_id" field.
+func VendorIDEQ(v int) predicate.Ticket {
+	return predicate.Ticket(sql.FieldEQ(FieldVendorID, v))
+}
+
+// VendorIDNEQ applies the NEQ predicate on the "vendor_id" field.
+func VendorIDNEQ(v int) predicate.Ticket {
+	return predicate.Ticket(sql.FieldNEQ(FieldVendorID, v))
+}
+
+// VendorIDIn applies the In predicate on the "vendor_id" field.
+func VendorIDIn(vs ...int) predicate.Ticket {
+	return predicate.Ticket(sql.FieldIn(FieldVendorID, vs...))
+}
+
+// VendorIDNotIn applies the NotIn predicate on the "vendor_id" field.
+func VendorIDNotIn(vs ...int) predicate.Ticket {
+	return predicate.Ticket(sql.FieldNotIn(FieldVendorID, vs...))
+}
+
+// VendorIDGT applies the GT predicate on the "vendor_id" field.
+func VendorIDGT(v int) predicate.Ticket {
+	return predicate.Ticket(sql.FieldGT(FieldVendorID, v))
+}
+
+// VendorIDGTE applies the GTE predicate on the "vendor_id" field.
+func VendorIDGTE(v int) predicate.Ticket {
+	return predicate.Ticket(sql.FieldGTE(FieldVendorID, v))
+}
+
+// VendorIDLT applies the LT predicate on the "vendor_id" field.
+func VendorIDLT(v int) predicate.Ticket {
+	return predicate.Ticket(sql.FieldLT(FieldVendorID, v))
+}
+
+// VendorIDLTE applies the LTE predicate on the "vendor_id" field.
+func VendorIDLTE(v int) predicate.Ticket {
+	return predicate.Ticket(sql.FieldLTE(FieldVendorID, v))
+}
+
+// VendorIDIsNil applies the IsNil predicate on the "vendor_id" field.
+func VendorIDIsNil() predicate.Ticket {
+	return predicate.Ticket(sql.FieldIsNull(FieldVendorID))
+}
+
+// VendorIDNotNil applies the NotNil predicate on the "vendor_id" field.
+func VendorIDNotNil() predicate.Ticket {
+	return predicate.Ticket(sql.FieldNotNull(FieldVendorID))
+}
+
 // FirstResponseAtEQ applies the EQ predicate on the "first_response_at" field.
 func FirstResponseAtEQ(v time.Time) predicate.Ticket {
 	return predicate.Ticket(sql.FieldEQ(FieldFirstResponseAt, v))
@@ -2388,6 +2513,52 @@ func HasSLAAlertHistoryWith(preds ...predicate.SLAAlertHistory) predicate.Ticket
 	})
 }
 
+// HasSLAPauses applies the HasEdge predicate on the "sla_pauses" edge.
+func HasSLAPauses() predicate.Ticket {
+	return predicate.Ticket(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SLAPausesTable, SLAPausesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSLAPausesWith applies the HasEdge predicate on the "sla_pauses" edge with a given conditions (other predicates).
+func HasSLAPausesWith(preds ...predicate.TicketSLAPause) predicate.Ticket {
+	return predicate.Ticket(func(s *sql.Selector) {
+		step := newSLAPausesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSLAMetrics applies the HasEdge predicate on the "sla_metrics" edge.
+func HasSLAMetrics() predicate.Ticket {
+	return predicate.Ticket(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SLAMetricsTable, SLAMetricsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSLAMetricsWith applies the HasEdge predicate on the "sla_metrics" edge with a given conditions (other predicates).
+func HasSLAMetricsWith(preds ...predicate.TicketSLAMetric) predicate.Ticket {
+	return predicate.Ticket(func(s *sql.Selector) {
+		step := newSLAMetricsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasRootCauseAnalyses applies the HasEdge predicate on the "root_cause_analyses" edge.
 func HasRootCauseAnalyses() predicate.Ticket {
 	return predicate.Ticket(func(s *sql.Selector) {
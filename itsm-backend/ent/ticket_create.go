@@ -18,6 +18,8 @@ import (
 	"itsm-backend/ent/ticketcc"
 	"itsm-backend/ent/ticketcomment"
 	"itsm-backend/ent/ticketnotification"
+	"itsm-backend/ent/ticketslametric"
+	"itsm-backend/ent/ticketslapause"
 	"itsm-backend/ent/tickettag"
 	"itsm-backend/ent/tickettype"
 	"itsm-backend/ent/ticketworkflowrecord"
@@ -275,6 +277,48 @@ func (_c *TicketCreate) SetNillableSLAResolutionDeadline(v *time.Time) *TicketCr
 	return _c
 }
 
+// SetSLAOnHold sets the "sla_on_hold" field.
+func (_c *TicketCreate) SetSLAOnHold(v bool) *TicketCreate {
+	_c.mutation.SetSLAOnHold(v)
+	return _c
+}
+
+// SetNillableSLAOnHold sets the "sla_on_hold" field if the given value is not nil.
+func (_c *TicketCreate) SetNillableSLAOnHold(v *bool) *TicketCreate {
+	if v != nil {
+		_c.SetSLAOnHold(*v)
+	}
+	return _c
+}
+
+// SetSLAPausedAt sets the "sla_paused_at" field.
+func (_c *TicketCreate) SetSLAPausedAt(v time.Time) *TicketCreate {
+	_c.mutation.SetSLAPausedAt(v)
+	return _c
+}
+
+// SetNillableSLAPausedAt sets the "sla_paused_at" field if the given value is not nil.
+func (_c *TicketCreate) SetNillableSLAPausedAt(v *time.Time) *TicketCreate {
+	if v != nil {
+		_c.SetSLAPausedAt(*v)
+	}
+	return _c
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (_c *TicketCreate) SetVendorID(v int) *TicketCreate {
+	_c.mutation.SetVendorID(v)
+	return _c
+}
+
+// SetNillableVendorID sets the "vendor_id" field if the given value is not nil.
+func (_c *TicketCreate) SetNillableVendorID(v *int) *TicketCreate {
+	if v != nil {
+		_c.SetVendorID(*v)
+	}
+	return _c
+}
+
 // SetFirstResponseAt sets the "first_response_at" field.
 func (_c *TicketCreate) SetFirstResponseAt(v time.Time) *TicketCreate {
 	_c.mutation.SetFirstResponseAt(v)
@@ -678,6 +722,36 @@ func (_c *TicketCreate) AddSLAAlertHistory(v ...*SLAAlertHistory) *TicketCreate
 	return _c.AddSLAAlertHistoryIDs(ids...)
 }
 
+// AddSLAPauseIDs adds the "sla_pauses" edge to the TicketSLAPause entity by IDs.
+func (_c *TicketCreate) AddSLAPauseIDs(ids ...int) *TicketCreate {
+	_c.mutation.AddSLAPauseIDs(ids...)
+	return _c
+}
+
+// AddSLAPauses adds the "sla_pauses" edges to the TicketSLAPause entity.
+func (_c *TicketCreate) AddSLAPauses(v ...*TicketSLAPause) *TicketCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSLAPauseIDs(ids...)
+}
+
+// AddSLAMetricIDs adds the "sla_metrics" edge to the TicketSLAMetric entity by IDs.
+func (_c *TicketCreate) AddSLAMetricIDs(ids ...int) *TicketCreate {
+	_c.mutation.AddSLAMetricIDs(ids...)
+	return _c
+}
+
+// AddSLAMetrics adds the "sla_metrics" edges to the TicketSLAMetric entity.
+func (_c *TicketCreate) AddSLAMetrics(v ...*TicketSLAMetric) *TicketCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSLAMetricIDs(ids...)
+}
+
 // AddRootCauseAnalysisIDs adds the "root_cause_analyses" edge to the RootCauseAnalysis entity by IDs.
 func (_c *TicketCreate) AddRootCauseAnalysisIDs(ids ...int) *TicketCreate {
 	_c.mutation.AddRootCauseAnalysisIDs(ids...)
@@ -803,6 +877,10 @@ func (_c *TicketCreate) defaults() {
 		v := ticket.DefaultPriority
 		_c.mutation.SetPriority(v)
 	}
+	if _, ok := _c.mutation.SLAOnHold(); !ok {
+		v := ticket.DefaultSLAOnHold
+		_c.mutation.SetSLAOnHold(v)
+	}
 	if _, ok := _c.mutation.Version(); !ok {
 		v := ticket.DefaultVersion
 		_c.mutation.SetVersion(v)
@@ -877,6 +955,9 @@ func (_c *TicketCreate) check() error {
 			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "Ticket.tenant_id": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.SLAOnHold(); !ok {
+		return &ValidationError{Name: "sla_on_hold", err: errors.New(`ent: missing required field "Ticket.sla_on_hold"`)}
+	}
 	if v, ok := _c.mutation.Rating(); ok {
 		if err := ticket.RatingValidator(v); err != nil {
 			return &ValidationError{Name: "rating", err: fmt.Errorf(`ent: validator failed for field "Ticket.rating": %w`, err)}
@@ -996,6 +1077,18 @@ func (_c *TicketCreate) createSpec() (*Ticket, *sqlgraph.CreateSpec) {
 		_spec.SetField(ticket.FieldSLAResolutionDeadline, field.TypeTime, value)
 		_node.SLAResolutionDeadline = value
 	}
+	if value, ok := _c.mutation.SLAOnHold(); ok {
+		_spec.SetField(ticket.FieldSLAOnHold, field.TypeBool, value)
+		_node.SLAOnHold = value
+	}
+	if value, ok := _c.mutation.SLAPausedAt(); ok {
+		_spec.SetField(ticket.FieldSLAPausedAt, field.TypeTime, value)
+		_node.SLAPausedAt = &value
+	}
+	if value, ok := _c.mutation.VendorID(); ok {
+		_spec.SetField(ticket.FieldVendorID, field.TypeInt, value)
+		_node.VendorID = &value
+	}
 	if value, ok := _c.mutation.FirstResponseAt(); ok {
 		_spec.SetField(ticket.FieldFirstResponseAt, field.TypeTime, value)
 		_node.FirstResponseAt = value
@@ -1240,6 +1333,38 @@ func (_c *TicketCreate) createSpec() (*Ticket, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.SLAPausesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticket.SLAPausesTable,
+			Columns: []string{ticket.SLAPausesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketslapause.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SLAMetricsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticket.SLAMetricsTable,
+			Columns: []string{ticket.SLAMetricsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketslametric.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.RootCauseAnalysesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
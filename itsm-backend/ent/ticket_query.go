@@ -19,6 +19,8 @@ import (
 	"itsm-backend/ent/ticketcc"
 	"itsm-backend/ent/ticketcomment"
 	"itsm-backend/ent/ticketnotification"
+	"itsm-backend/ent/ticketslametric"
+	"itsm-backend/ent/ticketslapause"
 	"itsm-backend/ent/tickettag"
 	"itsm-backend/ent/tickettype"
 	"itsm-backend/ent/ticketworkflowrecord"
@@ -49,6 +51,8 @@ type TicketQuery struct {
 	withCcUsers           *TicketCCQuery
 	withSLAViolations     *SLAViolationQuery
 	withSLAAlertHistory   *SLAAlertHistoryQuery
+	withSLAPauses         *TicketSLAPauseQuery
+	withSLAMetrics        *TicketSLAMetricQuery
 	withRootCauseAnalyses *RootCauseAnalysisQuery
 	withFeishuSyncs       *FeishuTicketSyncQuery
 	withRequester         *UserQuery
@@ -334,6 +338,50 @@ func (_q *TicketQuery) QuerySLAAlertHistory() *SLAAlertHistoryQuery {
 	return query
 }
 
+// QuerySLAPauses chains the current query on the "sla_pauses" edge.
+func (_q *TicketQuery) QuerySLAPauses() *TicketSLAPauseQuery {
+	query := (&TicketSLAPauseClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ticket.Table, ticket.FieldID, selector),
+			sqlgraph.To(ticketslapause.Table, ticketslapause.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ticket.SLAPausesTable, ticket.SLAPausesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySLAMetrics chains the current query on the "sla_metrics" edge.
+func (_q *TicketQuery) QuerySLAMetrics() *TicketSLAMetricQuery {
+	query := (&TicketSLAMetricClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ticket.Table, ticket.FieldID, selector),
+			sqlgraph.To(ticketslametric.Table, ticketslametric.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ticket.SLAMetricsTable, ticket.SLAMetricsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryRootCauseAnalyses chains the current query on the "root_cause_analyses" edge.
 func (_q *TicketQuery) QueryRootCauseAnalyses() *RootCauseAnalysisQuery {
 	query := (&RootCauseAnalysisClient{config: _q.config}).Query()
@@ -669,6 +717,8 @@ func (_q *TicketQuery) Clone() *TicketQuery {
 		withCcUsers:           _q.withCcUsers.Clone(),
 		withSLAViolations:     _q.withSLAViolations.Clone(),
 		withSLAAlertHistory:   _q.withSLAAlertHistory.Clone(),
+		withSLAPauses:         _q.withSLAPauses.Clone(),
+		withSLAMetrics:        _q.withSLAMetrics.Clone(),
 		withRootCauseAnalyses: _q.withRootCauseAnalyses.Clone(),
 		withFeishuSyncs:       _q.withFeishuSyncs.Clone(),
 		withRequester:         _q.withRequester.Clone(),
@@ -802,6 +852,28 @@ func (_q *TicketQuery) WithSLAAlertHistory(opts ...func(*SLAAlertHistoryQuery))
 	return _q
 }
 
+// WithSLAPauses tells the query-builder to eager-load the nodes that are connected to
+// the "sla_pauses" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TicketQuery) WithSLAPauses(opts ...func(*TicketSLAPauseQuery)) *TicketQuery {
+	query := (&TicketSLAPauseClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSLAPauses = query
+	return _q
+}
+
+// WithSLAMetrics tells the query-builder to eager-load the nodes that are connected to
+// the "sla_metrics" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TicketQuery) WithSLAMetrics(opts ...func(*TicketSLAMetricQuery)) *TicketQuery {
+	query := (&TicketSLAMetricClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSLAMetrics = query
+	return _q
+}
+
 // WithRootCauseAnalyses tells the query-builder to eager-load the nodes that are connected to
 // the "root_cause_analyses" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *TicketQuery) WithRootCauseAnalyses(opts ...func(*RootCauseAnalysisQuery)) *TicketQuery {
@@ -947,7 +1019,7 @@ func (_q *TicketQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Ticke
 		nodes       = []*Ticket{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [17]bool{
+		loadedTypes = [19]bool{
 			_q.withComments != nil,
 			_q.withAttachments != nil,
 			_q.withTags != nil,
@@ -959,6 +1031,8 @@ func (_q *TicketQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Ticke
 			_q.withCcUsers != nil,
 			_q.withSLAViolations != nil,
 			_q.withSLAAlertHistory != nil,
+			_q.withSLAPauses != nil,
+			_q.withSLAMetrics != nil,
 			_q.withRootCauseAnalyses != nil,
 			_q.withFeishuSyncs != nil,
 			_q.withRequester != nil,
@@ -1065,6 +1139,20 @@ func (_q *TicketQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Ticke
 			return nil, err
 		}
 	}
+	if query := _q.withSLAPauses; query != nil {
+		if err := _q.loadSLAPauses(ctx, query, nodes,
+			func(n *Ticket) { n.Edges.SLAPauses = []*TicketSLAPause{} },
+			func(n *Ticket, e *TicketSLAPause) { n.Edges.SLAPauses = append(n.Edges.SLAPauses, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSLAMetrics; query != nil {
+		if err := _q.loadSLAMetrics(ctx, query, nodes,
+			func(n *Ticket) { n.Edges.SLAMetrics = []*TicketSLAMetric{} },
+			func(n *Ticket, e *TicketSLAMetric) { n.Edges.SLAMetrics = append(n.Edges.SLAMetrics, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withRootCauseAnalyses; query != nil {
 		if err := _q.loadRootCauseAnalyses(ctx, query, nodes,
 			func(n *Ticket) { n.Edges.RootCauseAnalyses = []*RootCauseAnalysis{} },
@@ -1471,6 +1559,66 @@ func (_q *TicketQuery) loadSLAAlertHistory(ctx context.Context, query *SLAAlertH
 	}
 	return nil
 }
+func (_q *TicketQuery) loadSLAPauses(ctx context.Context, query *TicketSLAPauseQuery, nodes []*Ticket, init func(*Ticket), assign func(*Ticket, *TicketSLAPause)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Ticket)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(ticketslapause.FieldTicketID)
+	}
+	query.Where(predicate.TicketSLAPause(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(ticket.SLAPausesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TicketID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "ticket_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TicketQuery) loadSLAMetrics(ctx context.Context, query *TicketSLAMetricQuery, nodes []*Ticket, init func(*Ticket), assign func(*Ticket, *TicketSLAMetric)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Ticket)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(ticketslametric.FieldTicketID)
+	}
+	query.Where(predicate.TicketSLAMetric(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(ticket.SLAMetricsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TicketID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "ticket_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (_q *TicketQuery) loadRootCauseAnalyses(ctx context.Context, query *RootCauseAnalysisQuery, nodes []*Ticket, init func(*Ticket), assign func(*Ticket, *RootCauseAnalysis)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*Ticket)
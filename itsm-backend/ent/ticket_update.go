@@ -19,6 +19,8 @@ import (
 	"itsm-backend/ent/ticketcc"
 	"itsm-backend/ent/ticketcomment"
 	"itsm-backend/ent/ticketnotification"
+	"itsm-backend/ent/ticketslametric"
+	"itsm-backend/ent/ticketslapause"
 	"itsm-backend/ent/tickettag"
 	"itsm-backend/ent/tickettype"
 	"itsm-backend/ent/ticketworkflowrecord"
@@ -429,6 +431,67 @@ func (_u *TicketUpdate) ClearSLAResolutionDeadline() *TicketUpdate {
 	return _u
 }
 
+// SetSLAOnHold sets the "sla_on_hold" field.
+func (_u *TicketUpdate) SetSLAOnHold(v bool) *TicketUpdate {
+	_u.mutation.SetSLAOnHold(v)
+	return _u
+}
+
+// SetNillableSLAOnHold sets the "sla_on_hold" field if the given value is not nil.
+func (_u *TicketUpdate) SetNillableSLAOnHold(v *bool) *TicketUpdate {
+	if v != nil {
+		_u.SetSLAOnHold(*v)
+	}
+	return _u
+}
+
+// SetSLAPausedAt sets the "sla_paused_at" field.
+func (_u *TicketUpdate) SetSLAPausedAt(v time.Time) *TicketUpdate {
+	_u.mutation.SetSLAPausedAt(v)
+	return _u
+}
+
+// SetNillableSLAPausedAt sets the "sla_paused_at" field if the given value is not nil.
+func (_u *TicketUpdate) SetNillableSLAPausedAt(v *time.Time) *TicketUpdate {
+	if v != nil {
+		_u.SetSLAPausedAt(*v)
+	}
+	return _u
+}
+
+// ClearSLAPausedAt clears the value of the "sla_paused_at" field.
+func (_u *TicketUpdate) ClearSLAPausedAt() *TicketUpdate {
+	_u.mutation.ClearSLAPausedAt()
+	return _u
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (_u *TicketUpdate) SetVendorID(v int) *TicketUpdate {
+	_u.mutation.ResetVendorID()
+	_u.mutation.SetVendorID(v)
+	return _u
+}
+
+// SetNillableVendorID sets the "vendor_id" field if the given value is not nil.
+func (_u *TicketUpdate) SetNillableVendorID(v *int) *TicketUpdate {
+	if v != nil {
+		_u.SetVendorID(*v)
+	}
+	return _u
+}
+
+// AddVendorID adds value to the "vendor_id" field.
+func (_u *TicketUpdate) AddVendorID(v int) *TicketUpdate {
+	_u.mutation.AddVendorID(v)
+	return _u
+}
+
+// ClearVendorID clears the value of the "vendor_id" field.
+func (_u *TicketUpdate) ClearVendorID() *TicketUpdate {
+	_u.mutation.ClearVendorID()
+	return _u
+}
+
 // SetFirstResponseAt sets the "first_response_at" field.
 func (_u *TicketUpdate) SetFirstResponseAt(v time.Time) *TicketUpdate {
 	_u.mutation.SetFirstResponseAt(v)
@@ -937,6 +1000,36 @@ func (_u *TicketUpdate) AddSLAAlertHistory(v ...*SLAAlertHistory) *TicketUpdate
 	return _u.AddSLAAlertHistoryIDs(ids...)
 }
 
+// AddSLAPauseIDs adds the "sla_pauses" edge to the TicketSLAPause entity by IDs.
+func (_u *TicketUpdate) AddSLAPauseIDs(ids ...int) *TicketUpdate {
+	_u.mutation.AddSLAPauseIDs(ids...)
+	return _u
+}
+
+// AddSLAPauses adds the "sla_pauses" edges to the TicketSLAPause entity.
+func (_u *TicketUpdate) AddSLAPauses(v ...*TicketSLAPause) *TicketUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSLAPauseIDs(ids...)
+}
+
+// AddSLAMetricIDs adds the "sla_metrics" edge to the TicketSLAMetric entity by IDs.
+func (_u *TicketUpdate) AddSLAMetricIDs(ids ...int) *TicketUpdate {
+	_u.mutation.AddSLAMetricIDs(ids...)
+	return _u
+}
+
+// AddSLAMetrics adds the "sla_metrics" edges to the TicketSLAMetric entity.
+func (_u *TicketUpdate) AddSLAMetrics(v ...*TicketSLAMetric) *TicketUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSLAMetricIDs(ids...)
+}
+
 // AddRootCauseAnalysisIDs adds the "root_cause_analyses" edge to the RootCauseAnalysis entity by IDs.
 func (_u *TicketUpdate) AddRootCauseAnalysisIDs(ids ...int) *TicketUpdate {
 	_u.mutation.AddRootCauseAnalysisIDs(ids...)
@@ -1247,6 +1340,48 @@ func (_u *TicketUpdate) RemoveSLAAlertHistory(v ...*SLAAlertHistory) *TicketUpda
 	return _u.RemoveSLAAlertHistoryIDs(ids...)
 }
 
+// ClearSLAPauses clears all "sla_pauses" edges to the TicketSLAPause entity.
+func (_u *TicketUpdate) ClearSLAPauses() *TicketUpdate {
+	_u.mutation.ClearSLAPauses()
+	return _u
+}
+
+// RemoveSLAPauseIDs removes the "sla_pauses" edge to TicketSLAPause entities by IDs.
+func (_u *TicketUpdate) RemoveSLAPauseIDs(ids ...int) *TicketUpdate {
+	_u.mutation.RemoveSLAPauseIDs(ids...)
+	return _u
+}
+
+// RemoveSLAPauses removes "sla_pauses" edges to TicketSLAPause entities.
+func (_u *TicketUpdate) RemoveSLAPauses(v ...*TicketSLAPause) *TicketUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSLAPauseIDs(ids...)
+}
+
+// ClearSLAMetrics clears all "sla_metrics" edges to the TicketSLAMetric entity.
+func (_u *TicketUpdate) ClearSLAMetrics() *TicketUpdate {
+	_u.mutation.ClearSLAMetrics()
+	return _u
+}
+
+// RemoveSLAMetricIDs removes the "sla_metrics" edge to TicketSLAMetric entities by IDs.
+func (_u *TicketUpdate) RemoveSLAMetricIDs(ids ...int) *TicketUpdate {
+	_u.mutation.RemoveSLAMetricIDs(ids...)
+	return _u
+}
+
+// RemoveSLAMetrics removes "sla_metrics" edges to TicketSLAMetric entities.
+func (_u *TicketUpdate) RemoveSLAMetrics(v ...*TicketSLAMetric) *TicketUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSLAMetricIDs(ids...)
+}
+
 // ClearRootCauseAnalyses clears all "root_cause_analyses" edges to the RootCauseAnalysis entity.
 func (_u *TicketUpdate) ClearRootCauseAnalyses() *TicketUpdate {
 	_u.mutation.ClearRootCauseAnalyses()
@@ -1523,6 +1658,24 @@ func (_u *TicketUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.SLAResolutionDeadlineCleared() {
 		_spec.ClearField(ticket.FieldSLAResolutionDeadline, field.TypeTime)
 	}
+	if value, ok := _u.mutation.SLAOnHold(); ok {
+		_spec.SetField(ticket.FieldSLAOnHold, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SLAPausedAt(); ok {
+		_spec.SetField(ticket.FieldSLAPausedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SLAPausedAtCleared() {
+		_spec.ClearField(ticket.FieldSLAPausedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VendorID(); ok {
+		_spec.SetField(ticket.FieldVendorID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVendorID(); ok {
+		_spec.AddField(ticket.FieldVendorID, field.TypeInt, value)
+	}
+	if _u.mutation.VendorIDCleared() {
+		_spec.ClearField(ticket.FieldVendorID, field.TypeInt)
+	}
 	if value, ok := _u.mutation.FirstResponseAt(); ok {
 		_spec.SetField(ticket.FieldFirstResponseAt, field.TypeTime, value)
 	}
@@ -2123,6 +2276,96 @@ func (_u *TicketUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SLAPausesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticket.SLAPausesTable,
+			Columns: []string{ticket.SLAPausesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketslapause.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSLAPausesIDs(); len(nodes) > 0 && !_u.mutation.SLAPausesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticket.SLAPausesTable,
+			Columns: []string{ticket.SLAPausesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketslapause.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SLAPausesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticket.SLAPausesTable,
+			Columns: []string{ticket.SLAPausesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketslapause.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SLAMetricsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticket.SLAMetricsTable,
+			Columns: []string{ticket.SLAMetricsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketslametric.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSLAMetricsIDs(); len(nodes) > 0 && !_u.mutation.SLAMetricsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticket.SLAMetricsTable,
+			Columns: []string{ticket.SLAMetricsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketslametric.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SLAMetricsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticket.SLAMetricsTable,
+			Columns: []string{ticket.SLAMetricsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketslametric.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.RootCauseAnalysesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -2751,6 +2994,67 @@ func (_u *TicketUpdateOne) ClearSLAResolutionDeadline() *TicketUpdateOne {
 	return _u
 }
 
+// SetSLAOnHold sets the "sla_on_hold" field.
+func (_u *TicketUpdateOne) SetSLAOnHold(v bool) *TicketUpdateOne {
+	_u.mutation.SetSLAOnHold(v)
+	return _u
+}
+
+// SetNillableSLAOnHold sets the "sla_on_hold" field if the given value is not nil.
+func (_u *TicketUpdateOne) SetNillableSLAOnHold(v *bool) *TicketUpdateOne {
+	if v != nil {
+		_u.SetSLAOnHold(*v)
+	}
+	return _u
+}
+
+// SetSLAPausedAt sets the "sla_paused_at" field.
+func (_u *TicketUpdateOne) SetSLAPausedAt(v time.Time) *TicketUpdateOne {
+	_u.mutation.SetSLAPausedAt(v)
+	return _u
+}
+
+// SetNillableSLAPausedAt sets the "sla_paused_at" field if the given value is not nil.
+func (_u *TicketUpdateOne) SetNillableSLAPausedAt(v *time.Time) *TicketUpdateOne {
+	if v != nil {
+		_u.SetSLAPausedAt(*v)
+	}
+	return _u
+}
+
+// ClearSLAPausedAt clears the value of the "sla_paused_at" field.
+func (_u *TicketUpdateOne) ClearSLAPausedAt() *TicketUpdateOne {
+	_u.mutation.ClearSLAPausedAt()
+	return _u
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (_u *TicketUpdateOne) SetVendorID(v int) *TicketUpdateOne {
+	_u.mutation.ResetVendorID()
+	_u.mutation.SetVendorID(v)
+	return _u
+}
+
+// SetNillableVendorID sets the "vendor_id" field if the given value is not nil.
+func (_u *TicketUpdateOne) SetNillableVendorID(v *int) *TicketUpdateOne {
+	if v != nil {
+		_u.SetVendorID(*v)
+	}
+	return _u
+}
+
+// AddVendorID adds value to the "vendor_id" field.
+func (_u *TicketUpdateOne) AddVendorID(v int) *TicketUpdateOne {
+	_u.mutation.AddVendorID(v)
+	return _u
+}
+
+// ClearVendorID clears the value of the "vendor_id" field.
+func (_u *TicketUpdateOne) ClearVendorID() *TicketUpdateOne {
+	_u.mutation.ClearVendorID()
+	return _u
+}
+
 // SetFirstResponseAt sets the "first_response_at" field.
 func (_u *TicketUpdateOne) SetFirstResponseAt(v time.Time) *TicketUpdateOne {
 	_u.mutation.SetFirstResponseAt(v)
@@ -3259,6 +3563,36 @@ func (_u *TicketUpdateOne) AddSLAAlertHistory(v ...*SLAAlertHistory) *TicketUpda
 	return _u.AddSLAAlertHistoryIDs(ids...)
 }
 
+// AddSLAPauseIDs adds the "sla_pauses" edge to the TicketSLAPause entity by IDs.
+func (_u *TicketUpdateOne) AddSLAPauseIDs(ids ...int) *TicketUpdateOne {
+	_u.mutation.AddSLAPauseIDs(ids...)
+	return _u
+}
+
+// AddSLAPauses adds the "sla_pauses" edges to the TicketSLAPause entity.
+func (_u *TicketUpdateOne) AddSLAPauses(v ...*TicketSLAPause) *TicketUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSLAPauseIDs(ids...)
+}
+
+// AddSLAMetricIDs adds the "sla_metrics" edge to the TicketSLAMetric entity by IDs.
+func (_u *TicketUpdateOne) AddSLAMetricIDs(ids ...int) *TicketUpdateOne {
+	_u.mutation.AddSLAMetricIDs(ids...)
+	return _u
+}
+
+// AddSLAMetrics adds the "sla_metrics" edges to the TicketSLAMetric entity.
+func (_u *TicketUpdateOne) AddSLAMetrics(v ...*TicketSLAMetric) *TicketUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSLAMetricIDs(ids...)
+}
+
 // AddRootCauseAnalysisIDs adds the "root_cause_analyses" edge to the RootCauseAnalysis entity by IDs.
 func (_u *TicketUpdateOne) AddRootCauseAnalysisIDs(ids ...int) *TicketUpdateOne {
 	_u.mutation.AddRootCauseAnalysisIDs(ids...)
@@ -3569,6 +3903,48 @@ func (_u *TicketUpdateOne) RemoveSLAAlertHistory(v ...*SLAAlertHistory) *TicketU
 	return _u.RemoveSLAAlertHistoryIDs(ids...)
 }
 
+// ClearSLAPauses clears all "sla_pauses" edges to the TicketSLAPause entity.
+func (_u *TicketUpdateOne) ClearSLAPauses() *TicketUpdateOne {
+	_u.mutation.ClearSLAPauses()
+	return _u
+}
+
+// RemoveSLAPauseIDs removes the "sla_pauses" edge to TicketSLAPause entities by IDs.
+func (_u *TicketUpdateOne) RemoveSLAPauseIDs(ids ...int) *TicketUpdateOne {
+	_u.mutation.RemoveSLAPauseIDs(ids...)
+	return _u
+}
+
+// RemoveSLAPauses removes "sla_pauses" edges to TicketSLAPause entities.
+func (_u *TicketUpdateOne) RemoveSLAPauses(v ...*TicketSLAPause) *TicketUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSLAPauseIDs(ids...)
+}
+
+// ClearSLAMetrics clears all "sla_metrics" edges to the TicketSLAMetric entity.
+func (_u *TicketUpdateOne) ClearSLAMetrics() *TicketUpdateOne {
+	_u.mutation.ClearSLAMetrics()
+	return _u
+}
+
+// RemoveSLAMetricIDs removes the "sla_metrics" edge to TicketSLAMetric entities by IDs.
+func (_u *TicketUpdateOne) RemoveSLAMetricIDs(ids ...int) *TicketUpdateOne {
+	_u.mutation.RemoveSLAMetricIDs(ids...)
+	return _u
+}
+
+// RemoveSLAMetrics removes "sla_metrics" edges to TicketSLAMetric entities.
+func (_u *TicketUpdateOne) RemoveSLAMetrics(v ...*TicketSLAMetric) *TicketUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSLAMetricIDs(ids...)
+}
+
 // ClearRootCauseAnalyses clears all "root_cause_analyses" edges to the RootCauseAnalysis entity.
 func (_u *TicketUpdateOne) ClearRootCauseAnalyses() *TicketUpdateOne {
 	_u.mutation.ClearRootCauseAnalyses()
@@ -3875,6 +4251,24 @@ func (_u *TicketUpdateOne) sqlSave(ctx context.Context) (_node *Ticket, err erro
 	if _u.mutation.SLAResolutionDeadlineCleared() {
 		_spec.ClearField(ticket.FieldSLAResolutionDeadline, field.TypeTime)
 	}
+	if value, ok := _u.mutation.SLAOnHold(); ok {
+		_spec.SetField(ticket.FieldSLAOnHold, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SLAPausedAt(); ok {
+		_spec.SetField(ticket.FieldSLAPausedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SLAPausedAtCleared() {
+		_spec.ClearField(ticket.FieldSLAPausedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VendorID(); ok {
+		_spec.SetField(ticket.FieldVendorID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVendorID(); ok {
+		_spec.AddField(ticket.FieldVendorID, field.TypeInt, value)
+	}
+	if _u.mutation.VendorIDCleared() {
+		_spec.ClearField(ticket.FieldVendorID, field.TypeInt)
+	}
 	if value, ok := _u.mutation.FirstResponseAt(); ok {
 		_spec.SetField(ticket.FieldFirstResponseAt, field.TypeTime, value)
 	}
@@ -4475,6 +4869,96 @@ func (_u *TicketUpdateOne) sqlSave(ctx context.Context) (_node *Ticket, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SLAPausesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticket.SLAPausesTable,
+			Columns: []string{ticket.SLAPausesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketslapause.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSLAPausesIDs(); len(nodes) > 0 && !_u.mutation.SLAPausesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticket.SLAPausesTable,
+			Columns: []string{ticket.SLAPausesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketslapause.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SLAPausesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticket.SLAPausesTable,
+			Columns: []string{ticket.SLAPausesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketslapause.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SLAMetricsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticket.SLAMetricsTable,
+			Columns: []string{ticket.SLAMetricsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketslametric.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSLAMetricsIDs(); len(nodes) > 0 && !_u.mutation.SLAMetricsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticket.SLAMetricsTable,
+			Columns: []string{ticket.SLAMetricsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketslametric.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SLAMetricsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticket.SLAMetricsTable,
+			Columns: []string{ticket.SLAMetricsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketslametric.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.RootCauseAnalysesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
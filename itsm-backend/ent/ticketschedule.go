@@ -0,0 +1,463 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"itsm-backend/ent/ticketschedule"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// TicketSchedule is the model entity for the TicketSchedule schema.
+type TicketSchedule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 租户ID
+	TenantID int `json:"tenant_id,omitempty"`
+	// 计划名称
+	Name string `json:"name,omitempty"`
+	// 计划描述
+	Description string `json:"description,omitempty"`
+	// 工单来源: template/catalog_item
+	SourceType ticketschedule.SourceType `json:"source_type,omitempty"`
+	// 工单模板ID
+	TemplateID *int `json:"template_id,omitempty"`
+	// 服务目录项ID
+	CatalogItemID *int `json:"catalog_item_id,omitempty"`
+	// 工单标题，为空时取模板或服务名称并附加发生日期
+	Title string `json:"title,omitempty"`
+	// 工单优先级，为空时取模板默认优先级
+	Priority string `json:"priority,omitempty"`
+	// 工单申请人ID
+	RequesterID int `json:"requester_id,omitempty"`
+	// 工单处理人ID
+	AssigneeID *int `json:"assignee_id,omitempty"`
+	// 工单表单字段值
+	FormFields map[string]interface{} `json:"form_fields,omitempty"`
+	// 表达式类型: cron/rrule
+	RuleType ticketschedule.RuleType `json:"rule_type,omitempty"`
+	// cron 或 RRULE 表达式
+	Expression string `json:"expression,omitempty"`
+	// 表达式所在时区
+	TimeZone string `json:"time_zone,omitempty"`
+	// 生效时间，RRULE 以此作为 DTSTART
+	StartAt time.Time `json:"start_at,omitempty"`
+	// 失效时间，为空表示长期有效
+	EndAt *time.Time `json:"end_at,omitempty"`
+	// 工作日历ID，为空时使用租户默认日历
+	CalendarID *int `json:"calendar_id,omitempty"`
+	// 非工作日处理: none 照常生成/skip 跳过/next_business_day 顺延到下一个工作日
+	HolidayPolicy ticketschedule.HolidayPolicy `json:"holiday_policy,omitempty"`
+	// 停机恢复后错过的发生时刻: all 全部补发/latest 只补最近一次/none 不补发
+	CatchUpPolicy ticketschedule.CatchUpPolicy `json:"catch_up_policy,omitempty"`
+	// 是否启用
+	Enabled bool `json:"enabled,omitempty"`
+	// 调度版本，修改表达式或启停时递增，旧版本的待触发命令作废
+	Revision int `json:"revision,omitempty"`
+	// 下一次触发时间
+	NextRunAt *time.Time `json:"next_run_at,omitempty"`
+	// 最近一次处理的计划发生时刻
+	LastOccurrenceAt *time.Time `json:"last_occurrence_at,omitempty"`
+	// 最近一次生成工单的时间
+	LastRunAt *time.Time `json:"last_run_at,omitempty"`
+	// 最近一次生成的工单ID
+	LastTicketID *int `json:"last_ticket_id,omitempty"`
+	// 创建人ID
+	CreatedBy int `json:"created_by,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TicketScheduleQuery when eager-loading is set.
+	Edges        TicketScheduleEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TicketScheduleEdges holds the relations/edges for other nodes in the graph.
+type TicketScheduleEdges struct {
+	// 各次发生的执行记录
+	Runs []*TicketScheduleRun `json:"runs,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// RunsOrErr returns the Runs value or an error if the edge
+// was not loaded in eager-loading.
+func (e TicketScheduleEdges) RunsOrErr() ([]*TicketScheduleRun, error) {
+	if e.loadedTypes[0] {
+		return e.Runs, nil
+	}
+	return nil, &NotLoadedError{edge: "runs"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TicketSchedule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ticketschedule.FieldFormFields:
+			values[i] = new([]byte)
+		case ticketschedule.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case ticketschedule.FieldID, ticketschedule.FieldTenantID, ticketschedule.FieldTemplateID, ticketschedule.FieldCatalogItemID, ticketschedule.FieldRequesterID, ticketschedule.FieldAssigneeID, ticketschedule.FieldCalendarID, ticketschedule.FieldRevision, ticketschedule.FieldLastTicketID, ticketschedule.FieldCreatedBy:
+			values[i] = new(sql.NullInt64)
+		case ticketschedule.FieldName, ticketschedule.FieldDescription, ticketschedule.FieldSourceType, ticketschedule.FieldTitle, ticketschedule.FieldPriority, ticketschedule.FieldRuleType, ticketschedule.FieldExpression, ticketschedule.FieldTimeZone, ticketschedule.FieldHolidayPolicy, ticketschedule.FieldCatchUpPolicy:
+			values[i] = new(sql.NullString)
+		case ticketschedule.FieldStartAt, ticketschedule.FieldEndAt, ticketschedule.FieldNextRunAt, ticketschedule.FieldLastOccurrenceAt, ticketschedule.FieldLastRunAt, ticketschedule.FieldCreatedAt, ticketschedule.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TicketSchedule fields.
+func (_m *TicketSchedule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ticketschedule.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case ticketschedule.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = int(value.Int64)
+			}
+		case ticketschedule.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case ticketschedule.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case ticketschedule.FieldSourceType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_type", values[i])
+			} else if value.Valid {
+				_m.SourceType = ticketschedule.SourceType(value.String)
+			}
+		case ticketschedule.FieldTemplateID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field template_id", values[i])
+			} else if value.Valid {
+				_m.TemplateID = new(int)
+				*_m.TemplateID = int(value.Int64)
+			}
+		case ticketschedule.FieldCatalogItemID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field catalog_item_id", values[i])
+			} else if value.Valid {
+				_m.CatalogItemID = new(int)
+				*_m.CatalogItemID = int(value.Int64)
+			}
+		case ticketschedule.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = value.String
+			}
+		case ticketschedule.FieldPriority:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				_m.Priority = value.String
+			}
+		case ticketschedule.FieldRequesterID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field requester_id", values[i])
+			} else if value.Valid {
+				_m.RequesterID = int(value.Int64)
+			}
+		case ticketschedule.FieldAssigneeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field assignee_id", values[i])
+			} else if value.Valid {
+				_m.AssigneeID = new(int)
+				*_m.AssigneeID = int(value.Int64)
+			}
+		case ticketschedule.FieldFormFields:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field form_fields", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.FormFields); err != nil {
+					return fmt.Errorf("unmarshal field form_fields: %w", err)
+				}
+			}
+		case ticketschedule.FieldRuleType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rule_type", values[i])
+			} else if value.Valid {
+				_m.RuleType = ticketschedule.RuleType(value.String)
+			}
+		case ticketschedule.FieldExpression:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field expression", values[i])
+			} else if value.Valid {
+				_m.Expression = value.String
+			}
+		case ticketschedule.FieldTimeZone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field time_zone", values[i])
+			} else if value.Valid {
+				_m.TimeZone = value.String
+			}
+		case ticketschedule.FieldStartAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_at", values[i])
+			} else if value.Valid {
+				_m.StartAt = value.Time
+			}
+		case ticketschedule.FieldEndAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_at", values[i])
+			} else if value.Valid {
+				_m.EndAt = new(time.Time)
+				*_m.EndAt = value.Time
+			}
+		case ticketschedule.FieldCalendarID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field calendar_id", values[i])
+			} else if value.Valid {
+				_m.CalendarID = new(int)
+				*_m.CalendarID = int(value.Int64)
+			}
+		case ticketschedule.FieldHolidayPolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field holiday_policy", values[i])
+			} else if value.Valid {
+				_m.HolidayPolicy = ticketschedule.HolidayPolicy(value.String)
+			}
+		case ticketschedule.FieldCatchUpPolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field catch_up_policy", values[i])
+			} else if value.Valid {
+				_m.CatchUpPolicy = ticketschedule.CatchUpPolicy(value.String)
+			}
+		case ticketschedule.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case ticketschedule.FieldRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field revision", values[i])
+			} else if value.Valid {
+				_m.Revision = int(value.Int64)
+			}
+		case ticketschedule.FieldNextRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_run_at", values[i])
+			} else if value.Valid {
+				_m.NextRunAt = new(time.Time)
+				*_m.NextRunAt = value.Time
+			}
+		case ticketschedule.FieldLastOccurrenceAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_occurrence_at", values[i])
+			} else if value.Valid {
+				_m.LastOccurrenceAt = new(time.Time)
+				*_m.LastOccurrenceAt = value.Time
+			}
+		case ticketschedule.FieldLastRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_run_at", values[i])
+			} else if value.Valid {
+				_m.LastRunAt = new(time.Time)
+				*_m.LastRunAt = value.Time
+			}
+		case ticketschedule.FieldLastTicketID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_ticket_id", values[i])
+			} else if value.Valid {
+				_m.LastTicketID = new(int)
+				*_m.LastTicketID = int(value.Int64)
+			}
+		case ticketschedule.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = int(value.Int64)
+			}
+		case ticketschedule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case ticketschedule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TicketSchedule.
+// This includes values selected through modifiers, order, etc.
+func (_m *TicketSchedule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryRuns queries the "runs" edge of the TicketSchedule entity.
+func (_m *TicketSchedule) QueryRuns() *TicketScheduleRunQuery {
+	return NewTicketScheduleClient(_m.config).QueryRuns(_m)
+}
+
+// Update returns a builder for updating this TicketSchedule.
+// Note that you need to call TicketSchedule.Unwrap() before calling this method if this TicketSchedule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TicketSchedule) Update() *TicketScheduleUpdateOne {
+	return NewTicketScheduleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TicketSchedule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TicketSchedule) Unwrap() *TicketSchedule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TicketSchedule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TicketSchedule) String() string {
+	var builder strings.Builder
+	builder.WriteString("TicketSchedule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("source_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SourceType))
+	builder.WriteString(", ")
+	if v := _m.TemplateID; v != nil {
+		builder.WriteString("template_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CatalogItemID; v != nil {
+		builder.WriteString("catalog_item_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(_m.Title)
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(_m.Priority)
+	builder.WriteString(", ")
+	builder.WriteString("requester_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequesterID))
+	builder.WriteString(", ")
+	if v := _m.AssigneeID; v != nil {
+		builder.WriteString("assignee_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("form_fields=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FormFields))
+	builder.WriteString(", ")
+	builder.WriteString("rule_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RuleType))
+	builder.WriteString(", ")
+	builder.WriteString("expression=")
+	builder.WriteString(_m.Expression)
+	builder.WriteString(", ")
+	builder.WriteString("time_zone=")
+	builder.WriteString(_m.TimeZone)
+	builder.WriteString(", ")
+	builder.WriteString("start_at=")
+	builder.WriteString(_m.StartAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.EndAt; v != nil {
+		builder.WriteString("end_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.CalendarID; v != nil {
+		builder.WriteString("calendar_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("holiday_policy=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HolidayPolicy))
+	builder.WriteString(", ")
+	builder.WriteString("catch_up_policy=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CatchUpPolicy))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("revision=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Revision))
+	builder.WriteString(", ")
+	if v := _m.NextRunAt; v != nil {
+		builder.WriteString("next_run_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastOccurrenceAt; v != nil {
+		builder.WriteString("last_occurrence_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastRunAt; v != nil {
+		builder.WriteString("last_run_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastTicketID; v != nil {
+		builder.WriteString("last_ticket_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedBy))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TicketSchedules is a parsable slice of TicketSchedule.
+type TicketSchedules []*TicketSchedule
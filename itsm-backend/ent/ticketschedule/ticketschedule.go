@@ -0,0 +1,415 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ticketschedule
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the ticketschedule type in the database.
+	Label = "ticket_schedule"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldSourceType holds the string denoting the source_type field in the database.
+	FieldSourceType = "source_type"
+	// FieldTemplateID holds the string denoting the template_id field in the database.
+	FieldTemplateID = "template_id"
+	// FieldCatalogItemID holds the string denoting the catalog_item_id field in the database.
+	FieldCatalogItemID = "catalog_item_id"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldPriority holds the string denoting the priority field in the database.
+	FieldPriority = "priority"
+	// FieldRequesterID holds the string denoting the requester_id field in the database.
+	FieldRequesterID = "requester_id"
+	// FieldAssigneeID holds the string denoting the assignee_id field in the database.
+	FieldAssigneeID = "assignee_id"
+	// FieldFormFields holds the string denoting the form_fields field in the database.
+	FieldFormFields = "form_fields"
+	// FieldRuleType holds the string denoting the rule_type field in the database.
+	FieldRuleType = "rule_type"
+	// FieldExpression holds the string denoting the expression field in the database.
+	FieldExpression = "expression"
+	// FieldTimeZone holds the string denoting the time_zone field in the database.
+	FieldTimeZone = "time_zone"
+	// FieldStartAt holds the string denoting the start_at field in the database.
+	FieldStartAt = "start_at"
+	// FieldEndAt holds the string denoting the end_at field in the database.
+	FieldEndAt = "end_at"
+	// FieldCalendarID holds the string denoting the calendar_id field in the database.
+	FieldCalendarID = "calendar_id"
+	// FieldHolidayPolicy holds the string denoting the holiday_policy field in the database.
+	FieldHolidayPolicy = "holiday_policy"
+	// FieldCatchUpPolicy holds the string denoting the catch_up_policy field in the database.
+	FieldCatchUpPolicy = "catch_up_policy"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldRevision holds the string denoting the revision field in the database.
+	FieldRevision = "revision"
+	// FieldNextRunAt holds the string denoting the next_run_at field in the database.
+	FieldNextRunAt = "next_run_at"
+	// FieldLastOccurrenceAt holds the string denoting the last_occurrence_at field in the database.
+	FieldLastOccurrenceAt = "last_occurrence_at"
+	// FieldLastRunAt holds the string denoting the last_run_at field in the database.
+	FieldLastRunAt = "last_run_at"
+	// FieldLastTicketID holds the string denoting the last_ticket_id field in the database.
+	FieldLastTicketID = "last_ticket_id"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeRuns holds the string denoting the runs edge name in mutations.
+	EdgeRuns = "runs"
+	// Table holds the table name of the ticketschedule in the database.
+	Table = "ticket_schedules"
+	// RunsTable is the table that holds the runs relation/edge.
+	RunsTable = "ticket_schedule_runs"
+	// RunsInverseTable is the table name for the TicketScheduleRun entity.
+	// It exists in this package in order to avoid circular dependency with the "ticketschedulerun" package.
+	RunsInverseTable = "ticket_schedule_runs"
+	// RunsColumn is the table column denoting the runs relation/edge.
+	RunsColumn = "schedule_id"
+)
+
+// Columns holds all SQL columns for ticketschedule fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldName,
+	FieldDescription,
+	FieldSourceType,
+	FieldTemplateID,
+	FieldCatalogItemID,
+	FieldTitle,
+	FieldPriority,
+	FieldRequesterID,
+	FieldAssigneeID,
+	FieldFormFields,
+	FieldRuleType,
+	FieldExpression,
+	FieldTimeZone,
+	FieldStartAt,
+	FieldEndAt,
+	FieldCalendarID,
+	FieldHolidayPolicy,
+	FieldCatchUpPolicy,
+	FieldEnabled,
+	FieldRevision,
+	FieldNextRunAt,
+	FieldLastOccurrenceAt,
+	FieldLastRunAt,
+	FieldLastTicketID,
+	FieldCreatedBy,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	TenantIDValidator func(int) error
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// RequesterIDValidator is a validator for the "requester_id" field. It is called by the builders before save.
+	RequesterIDValidator func(int) error
+	// ExpressionValidator is a validator for the "expression" field. It is called by the builders before save.
+	ExpressionValidator func(string) error
+	// DefaultTimeZone holds the default value on creation for the "time_zone" field.
+	DefaultTimeZone string
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultRevision holds the default value on creation for the "revision" field.
+	DefaultRevision int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// SourceType defines the type for the "source_type" enum field.
+type SourceType string
+
+// SourceType values.
+const (
+	SourceTypeTemplate    SourceType = "template"
+	SourceTypeCatalogItem SourceType = "catalog_item"
+)
+
+func (st SourceType) String() string {
+	return string(st)
+}
+
+// SourceTypeValidator is a validator for the "source_type" field enum values. It is called by the builders before save.
+func SourceTypeValidator(st SourceType) error {
+	switch st {
+	case SourceTypeTemplate, SourceTypeCatalogItem:
+		return nil
+	default:
+		return fmt.Errorf("ticketschedule: invalid enum value for source_type field: %q", st)
+	}
+}
+
+// RuleType defines the type for the "rule_type" enum field.
+type RuleType string
+
+// RuleType values.
+const (
+	RuleTypeCron  RuleType = "cron"
+	RuleTypeRrule RuleType = "rrule"
+)
+
+func (rt RuleType) String() string {
+	return string(rt)
+}
+
+// RuleTypeValidator is a validator for the "rule_type" field enum values. It is called by the builders before save.
+func RuleTypeValidator(rt RuleType) error {
+	switch rt {
+	case RuleTypeCron, RuleTypeRrule:
+		return nil
+	default:
+		return fmt.Errorf("ticketschedule: invalid enum value for rule_type field: %q", rt)
+	}
+}
+
+// HolidayPolicy defines the type for the "holiday_policy" enum field.
+type HolidayPolicy string
+
+// HolidayPolicyNone is the default value of the HolidayPolicy enum.
+const DefaultHolidayPolicy = HolidayPolicyNone
+
+// HolidayPolicy values.
+const (
+	HolidayPolicyNone            HolidayPolicy = "none"
+	HolidayPolicySkip            HolidayPolicy = "skip"
+	HolidayPolicyNextBusinessDay HolidayPolicy = "next_business_day"
+)
+
+func (hp HolidayPolicy) String() string {
+	return string(hp)
+}
+
+// HolidayPolicyValidator is a validator for the "holiday_policy" field enum values. It is called by the builders before save.
+func HolidayPolicyValidator(hp HolidayPolicy) error {
+	switch hp {
+	case HolidayPolicyNone, HolidayPolicySkip, HolidayPolicyNextBusinessDay:
+		return nil
+	default:
+		return fmt.Errorf("ticketschedule: invalid enum value for holiday_policy field: %q", hp)
+	}
+}
+
+// CatchUpPolicy defines the type for the "catch_up_policy" enum field.
+type CatchUpPolicy string
+
+// CatchUpPolicyLatest is the default value of the CatchUpPolicy enum.
+const DefaultCatchUpPolicy = CatchUpPolicyLatest
+
+// CatchUpPolicy values.
+const (
+	CatchUpPolicyAll    CatchUpPolicy = "all"
+	CatchUpPolicyLatest CatchUpPolicy = "latest"
+	CatchUpPolicyNone   CatchUpPolicy = "none"
+)
+
+func (cup CatchUpPolicy) String() string {
+	return string(cup)
+}
+
+// CatchUpPolicyValidator is a validator for the "catch_up_policy" field enum values. It is called by the builders before save.
+func CatchUpPolicyValidator(cup CatchUpPolicy) error {
+	switch cup {
+	case CatchUpPolicyAll, CatchUpPolicyLatest, CatchUpPolicyNone:
+		return nil
+	default:
+		return fmt.Errorf("ticketschedule: invalid enum value for catch_up_policy field: %q", cup)
+	}
+}
+
+// OrderOption defines the ordering options for the TicketSchedule queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// BySourceType orders the results by the source_type field.
+func BySourceType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceType, opts...).ToFunc()
+}
+
+// ByTemplateID orders the results by the template_id field.
+func ByTemplateID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTemplateID, opts...).ToFunc()
+}
+
+// ByCatalogItemID orders the results by the catalog_item_id field.
+func ByCatalogItemID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCatalogItemID, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByPriority orders the results by the priority field.
+func ByPriority(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriority, opts...).ToFunc()
+}
+
+// ByRequesterID orders the results by the requester_id field.
+func ByRequesterID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequesterID, opts...).ToFunc()
+}
+
+// ByAssigneeID orders the results by the assignee_id field.
+func ByAssigneeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssigneeID, opts...).ToFunc()
+}
+
+// ByRuleType orders the results by the rule_type field.
+func ByRuleType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRuleType, opts...).ToFunc()
+}
+
+// ByExpression orders the results by the expression field.
+func ByExpression(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpression, opts...).ToFunc()
+}
+
+// ByTimeZone orders the results by the time_zone field.
+func ByTimeZone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimeZone, opts...).ToFunc()
+}
+
+// ByStartAt orders the results by the start_at field.
+func ByStartAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartAt, opts...).ToFunc()
+}
+
+// ByEndAt orders the results by the end_at field.
+func ByEndAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndAt, opts...).ToFunc()
+}
+
+// ByCalendarID orders the results by the calendar_id field.
+func ByCalendarID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCalendarID, opts...).ToFunc()
+}
+
+// ByHolidayPolicy orders the results by the holiday_policy field.
+func ByHolidayPolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHolidayPolicy, opts...).ToFunc()
+}
+
+// ByCatchUpPolicy orders the results by the catch_up_policy field.
+func ByCatchUpPolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCatchUpPolicy, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByRevision orders the results by the revision field.
+func ByRevision(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRevision, opts...).ToFunc()
+}
+
+// ByNextRunAt orders the results by the next_run_at field.
+func ByNextRunAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextRunAt, opts...).ToFunc()
+}
+
+// ByLastOccurrenceAt orders the results by the last_occurrence_at field.
+func ByLastOccurrenceAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastOccurrenceAt, opts...).ToFunc()
+}
+
+// ByLastRunAt orders the results by the last_run_at field.
+func ByLastRunAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastRunAt, opts...).ToFunc()
+}
+
+// ByLastTicketID orders the results by the last_ticket_id field.
+func ByLastTicketID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastTicketID, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByRunsCount orders the results by runs count.
+func ByRunsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRunsStep(), opts...)
+	}
+}
+
+// ByRuns orders the results by runs terms.
+func ByRuns(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRunsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newRunsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RunsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RunsTable, RunsColumn),
+	)
+}
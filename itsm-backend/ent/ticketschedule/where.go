@@ -0,0 +1,1469 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ticketschedule
+
+import (
+	"itsm-backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldDescription, v))
+}
+
+// TemplateID applies equality check predicate on the "template_id" field. It's identical to TemplateIDEQ.
+func TemplateID(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldTemplateID, v))
+}
+
+// CatalogItemID applies equality check predicate on the "catalog_item_id" field. It's identical to CatalogItemIDEQ.
+func CatalogItemID(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldCatalogItemID, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldTitle, v))
+}
+
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldPriority, v))
+}
+
+// RequesterID applies equality check predicate on the "requester_id" field. It's identical to RequesterIDEQ.
+func RequesterID(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldRequesterID, v))
+}
+
+// AssigneeID applies equality check predicate on the "assignee_id" field. It's identical to AssigneeIDEQ.
+func AssigneeID(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldAssigneeID, v))
+}
+
+// Expression applies equality check predicate on the "expression" field. It's identical to ExpressionEQ.
+func Expression(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldExpression, v))
+}
+
+// TimeZone applies equality check predicate on the "time_zone" field. It's identical to TimeZoneEQ.
+func TimeZone(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldTimeZone, v))
+}
+
+// StartAt applies equality check predicate on the "start_at" field. It's identical to StartAtEQ.
+func StartAt(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldStartAt, v))
+}
+
+// EndAt applies equality check predicate on the "end_at" field. It's identical to EndAtEQ.
+func EndAt(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldEndAt, v))
+}
+
+// CalendarID applies equality check predicate on the "calendar_id" field. It's identical to CalendarIDEQ.
+func CalendarID(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldCalendarID, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// Revision applies equality check predicate on the "revision" field. It's identical to RevisionEQ.
+func Revision(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldRevision, v))
+}
+
+// NextRunAt applies equality check predicate on the "next_run_at" field. It's identical to NextRunAtEQ.
+func NextRunAt(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldNextRunAt, v))
+}
+
+// LastOccurrenceAt applies equality check predicate on the "last_occurrence_at" field. It's identical to LastOccurrenceAtEQ.
+func LastOccurrenceAt(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldLastOccurrenceAt, v))
+}
+
+// LastRunAt applies equality check predicate on the "last_run_at" field. It's identical to LastRunAtEQ.
+func LastRunAt(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldLastRunAt, v))
+}
+
+// LastTicketID applies equality check predicate on the "last_ticket_id" field. It's identical to LastTicketIDEQ.
+func LastTicketID(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldLastTicketID, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLTE(FieldTenantID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// SourceTypeEQ applies the EQ predicate on the "source_type" field.
+func SourceTypeEQ(v SourceType) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldSourceType, v))
+}
+
+// SourceTypeNEQ applies the NEQ predicate on the "source_type" field.
+func SourceTypeNEQ(v SourceType) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNEQ(FieldSourceType, v))
+}
+
+// SourceTypeIn applies the In predicate on the "source_type" field.
+func SourceTypeIn(vs ...SourceType) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIn(FieldSourceType, vs...))
+}
+
+// SourceTypeNotIn applies the NotIn predicate on the "source_type" field.
+func SourceTypeNotIn(vs ...SourceType) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotIn(FieldSourceType, vs...))
+}
+
+// TemplateIDEQ applies the EQ predicate on the "template_id" field.
+func TemplateIDEQ(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldTemplateID, v))
+}
+
+// TemplateIDNEQ applies the NEQ predicate on the "template_id" field.
+func TemplateIDNEQ(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNEQ(FieldTemplateID, v))
+}
+
+// TemplateIDIn applies the In predicate on the "template_id" field.
+func TemplateIDIn(vs ...int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIn(FieldTemplateID, vs...))
+}
+
+// TemplateIDNotIn applies the NotIn predicate on the "template_id" field.
+func TemplateIDNotIn(vs ...int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotIn(FieldTemplateID, vs...))
+}
+
+// TemplateIDGT applies the GT predicate on the "template_id" field.
+func TemplateIDGT(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGT(FieldTemplateID, v))
+}
+
+// TemplateIDGTE applies the GTE predicate on the "template_id" field.
+func TemplateIDGTE(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGTE(FieldTemplateID, v))
+}
+
+// TemplateIDLT applies the LT predicate on the "template_id" field.
+func TemplateIDLT(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLT(FieldTemplateID, v))
+}
+
+// TemplateIDLTE applies the LTE predicate on the "template_id" field.
+func TemplateIDLTE(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLTE(FieldTemplateID, v))
+}
+
+// TemplateIDIsNil applies the IsNil predicate on the "template_id" field.
+func TemplateIDIsNil() predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIsNull(FieldTemplateID))
+}
+
+// TemplateIDNotNil applies the NotNil predicate on the "template_id" field.
+func TemplateIDNotNil() predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotNull(FieldTemplateID))
+}
+
+// CatalogItemIDEQ applies the EQ predicate on the "catalog_item_id" field.
+func CatalogItemIDEQ(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldCatalogItemID, v))
+}
+
+// CatalogItemIDNEQ applies the NEQ predicate on the "catalog_item_id" field.
+func CatalogItemIDNEQ(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNEQ(FieldCatalogItemID, v))
+}
+
+// CatalogItemIDIn applies the In predicate on the "catalog_item_id" field.
+func CatalogItemIDIn(vs ...int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIn(FieldCatalogItemID, vs...))
+}
+
+// CatalogItemIDNotIn applies the NotIn predicate on the "catalog_item_id" field.
+func CatalogItemIDNotIn(vs ...int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotIn(FieldCatalogItemID, vs...))
+}
+
+// CatalogItemIDGT applies the GT predicate on the "catalog_item_id" field.
+func CatalogItemIDGT(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGT(FieldCatalogItemID, v))
+}
+
+// CatalogItemIDGTE applies the GTE predicate on the "catalog_item_id" field.
+func CatalogItemIDGTE(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGTE(FieldCatalogItemID, v))
+}
+
+// CatalogItemIDLT applies the LT predicate on the "catalog_item_id" field.
+func CatalogItemIDLT(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLT(FieldCatalogItemID, v))
+}
+
+// CatalogItemIDLTE applies the LTE predicate on the "catalog_item_id" field.
+func CatalogItemIDLTE(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLTE(FieldCatalogItemID, v))
+}
+
+// CatalogItemIDIsNil applies the IsNil predicate on the "catalog_item_id" field.
+func CatalogItemIDIsNil() predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIsNull(FieldCatalogItemID))
+}
+
+// CatalogItemIDNotNil applies the NotNil predicate on the "catalog_item_id" field.
+func CatalogItemIDNotNil() predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotNull(FieldCatalogItemID))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleIsNil applies the IsNil predicate on the "title" field.
+func TitleIsNil() predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIsNull(FieldTitle))
+}
+
+// TitleNotNil applies the NotNil predicate on the "title" field.
+func TitleNotNil() predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotNull(FieldTitle))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGT(FieldPriority, v))
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGTE(FieldPriority, v))
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLT(FieldPriority, v))
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLTE(FieldPriority, v))
+}
+
+// PriorityContains applies the Contains predicate on the "priority" field.
+func PriorityContains(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldContains(FieldPriority, v))
+}
+
+// PriorityHasPrefix applies the HasPrefix predicate on the "priority" field.
+func PriorityHasPrefix(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldHasPrefix(FieldPriority, v))
+}
+
+// PriorityHasSuffix applies the HasSuffix predicate on the "priority" field.
+func PriorityHasSuffix(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldHasSuffix(FieldPriority, v))
+}
+
+// PriorityIsNil applies the IsNil predicate on the "priority" field.
+func PriorityIsNil() predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIsNull(FieldPriority))
+}
+
+// PriorityNotNil applies the NotNil predicate on the "priority" field.
+func PriorityNotNil() predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotNull(FieldPriority))
+}
+
+// PriorityEqualFold applies the EqualFold predicate on the "priority" field.
+func PriorityEqualFold(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEqualFold(FieldPriority, v))
+}
+
+// PriorityContainsFold applies the ContainsFold predicate on the "priority" field.
+func PriorityContainsFold(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldContainsFold(FieldPriority, v))
+}
+
+// RequesterIDEQ applies the EQ predicate on the "requester_id" field.
+func RequesterIDEQ(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldRequesterID, v))
+}
+
+// RequesterIDNEQ applies the NEQ predicate on the "requester_id" field.
+func RequesterIDNEQ(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNEQ(FieldRequesterID, v))
+}
+
+// RequesterIDIn applies the In predicate on the "requester_id" field.
+func RequesterIDIn(vs ...int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIn(FieldRequesterID, vs...))
+}
+
+// RequesterIDNotIn applies the NotIn predicate on the "requester_id" field.
+func RequesterIDNotIn(vs ...int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotIn(FieldRequesterID, vs...))
+}
+
+// RequesterIDGT applies the GT predicate on the "requester_id" field.
+func RequesterIDGT(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGT(FieldRequesterID, v))
+}
+
+// RequesterIDGTE applies the GTE predicate on the "requester_id" field.
+func RequesterIDGTE(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGTE(FieldRequesterID, v))
+}
+
+// RequesterIDLT applies the LT predicate on the "requester_id" field.
+func RequesterIDLT(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLT(FieldRequesterID, v))
+}
+
+// RequesterIDLTE applies the LTE predicate on the "requester_id" field.
+func RequesterIDLTE(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLTE(FieldRequesterID, v))
+}
+
+// AssigneeIDEQ applies the EQ predicate on the "assignee_id" field.
+func AssigneeIDEQ(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldAssigneeID, v))
+}
+
+// AssigneeIDNEQ applies the NEQ predicate on the "assignee_id" field.
+func AssigneeIDNEQ(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNEQ(FieldAssigneeID, v))
+}
+
+// AssigneeIDIn applies the In predicate on the "assignee_id" field.
+func AssigneeIDIn(vs ...int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIn(FieldAssigneeID, vs...))
+}
+
+// AssigneeIDNotIn applies the NotIn predicate on the "assignee_id" field.
+func AssigneeIDNotIn(vs ...int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotIn(FieldAssigneeID, vs...))
+}
+
+// AssigneeIDGT applies the GT predicate on the "assignee_id" field.
+func AssigneeIDGT(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGT(FieldAssigneeID, v))
+}
+
+// AssigneeIDGTE applies the GTE predicate on the "assignee_id" field.
+func AssigneeIDGTE(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGTE(FieldAssigneeID, v))
+}
+
+// AssigneeIDLT applies the LT predicate on the "assignee_id" field.
+func AssigneeIDLT(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLT(FieldAssigneeID, v))
+}
+
+// AssigneeIDLTE applies the LTE predicate on the "assignee_id" field.
+func AssigneeIDLTE(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLTE(FieldAssigneeID, v))
+}
+
+// AssigneeIDIsNil applies the IsNil predicate on the "assignee_id" field.
+func AssigneeIDIsNil() predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIsNull(FieldAssigneeID))
+}
+
+// AssigneeIDNotNil applies the NotNil predicate on the "assignee_id" field.
+func AssigneeIDNotNil() predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotNull(FieldAssigneeID))
+}
+
+// FormFieldsIsNil applies the IsNil predicate on the "form_fields" field.
+func FormFieldsIsNil() predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIsNull(FieldFormFields))
+}
+
+// FormFieldsNotNil applies the NotNil predicate on the "form_fields" field.
+func FormFieldsNotNil() predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotNull(FieldFormFields))
+}
+
+// RuleTypeEQ applies the EQ predicate on the "rule_type" field.
+func RuleTypeEQ(v RuleType) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldRuleType, v))
+}
+
+// RuleTypeNEQ applies the NEQ predicate on the "rule_type" field.
+func RuleTypeNEQ(v RuleType) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNEQ(FieldRuleType, v))
+}
+
+// RuleTypeIn applies the In predicate on the "rule_type" field.
+func RuleTypeIn(vs ...RuleType) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIn(FieldRuleType, vs...))
+}
+
+// RuleTypeNotIn applies the NotIn predicate on the "rule_type" field.
+func RuleTypeNotIn(vs ...RuleType) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotIn(FieldRuleType, vs...))
+}
+
+// ExpressionEQ applies the EQ predicate on the "expression" field.
+func ExpressionEQ(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldExpression, v))
+}
+
+// ExpressionNEQ applies the NEQ predicate on the "expression" field.
+func ExpressionNEQ(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNEQ(FieldExpression, v))
+}
+
+// ExpressionIn applies the In predicate on the "expression" field.
+func ExpressionIn(vs ...string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIn(FieldExpression, vs...))
+}
+
+// ExpressionNotIn applies the NotIn predicate on the "expression" field.
+func ExpressionNotIn(vs ...string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotIn(FieldExpression, vs...))
+}
+
+// ExpressionGT applies the GT predicate on the "expression" field.
+func ExpressionGT(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGT(FieldExpression, v))
+}
+
+// ExpressionGTE applies the GTE predicate on the "expression" field.
+func ExpressionGTE(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGTE(FieldExpression, v))
+}
+
+// ExpressionLT applies the LT predicate on the "expression" field.
+func ExpressionLT(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLT(FieldExpression, v))
+}
+
+// ExpressionLTE applies the LTE predicate on the "expression" field.
+func ExpressionLTE(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLTE(FieldExpression, v))
+}
+
+// ExpressionContains applies the Contains predicate on the "expression" field.
+func ExpressionContains(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldContains(FieldExpression, v))
+}
+
+// ExpressionHasPrefix applies the HasPrefix predicate on the "expression" field.
+func ExpressionHasPrefix(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldHasPrefix(FieldExpression, v))
+}
+
+// ExpressionHasSuffix applies the HasSuffix predicate on the "expression" field.
+func ExpressionHasSuffix(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldHasSuffix(FieldExpression, v))
+}
+
+// ExpressionEqualFold applies the EqualFold predicate on the "expression" field.
+func ExpressionEqualFold(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEqualFold(FieldExpression, v))
+}
+
+// ExpressionContainsFold applies the ContainsFold predicate on the "expression" field.
+func ExpressionContainsFold(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldContainsFold(FieldExpression, v))
+}
+
+// TimeZoneEQ applies the EQ predicate on the "time_zone" field.
+func TimeZoneEQ(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldTimeZone, v))
+}
+
+// TimeZoneNEQ applies the NEQ predicate on the "time_zone" field.
+func TimeZoneNEQ(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNEQ(FieldTimeZone, v))
+}
+
+// TimeZoneIn applies the In predicate on the "time_zone" field.
+func TimeZoneIn(vs ...string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIn(FieldTimeZone, vs...))
+}
+
+// TimeZoneNotIn applies the NotIn predicate on the "time_zone" field.
+func TimeZoneNotIn(vs ...string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotIn(FieldTimeZone, vs...))
+}
+
+// TimeZoneGT applies the GT predicate on the "time_zone" field.
+func TimeZoneGT(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGT(FieldTimeZone, v))
+}
+
+// TimeZoneGTE applies the GTE predicate on the "time_zone" field.
+func TimeZoneGTE(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGTE(FieldTimeZone, v))
+}
+
+// TimeZoneLT applies the LT predicate on the "time_zone" field.
+func TimeZoneLT(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLT(FieldTimeZone, v))
+}
+
+// TimeZoneLTE applies the LTE predicate on the "time_zone" field.
+func TimeZoneLTE(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLTE(FieldTimeZone, v))
+}
+
+// TimeZoneContains applies the Contains predicate on the "time_zone" field.
+func TimeZoneContains(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldContains(FieldTimeZone, v))
+}
+
+// TimeZoneHasPrefix applies the HasPrefix predicate on the "time_zone" field.
+func TimeZoneHasPrefix(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldHasPrefix(FieldTimeZone, v))
+}
+
+// TimeZoneHasSuffix applies the HasSuffix predicate on the "time_zone" field.
+func TimeZoneHasSuffix(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldHasSuffix(FieldTimeZone, v))
+}
+
+// TimeZoneEqualFold applies the EqualFold predicate on the "time_zone" field.
+func TimeZoneEqualFold(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEqualFold(FieldTimeZone, v))
+}
+
+// TimeZoneContainsFold applies the ContainsFold predicate on the "time_zone" field.
+func TimeZoneContainsFold(v string) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldContainsFold(FieldTimeZone, v))
+}
+
+// StartAtEQ applies the EQ predicate on the "start_at" field.
+func StartAtEQ(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldStartAt, v))
+}
+
+// StartAtNEQ applies the NEQ predicate on the "start_at" field.
+func StartAtNEQ(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNEQ(FieldStartAt, v))
+}
+
+// StartAtIn applies the In predicate on the "start_at" field.
+func StartAtIn(vs ...time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIn(FieldStartAt, vs...))
+}
+
+// StartAtNotIn applies the NotIn predicate on the "start_at" field.
+func StartAtNotIn(vs ...time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotIn(FieldStartAt, vs...))
+}
+
+// StartAtGT applies the GT predicate on the "start_at" field.
+func StartAtGT(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGT(FieldStartAt, v))
+}
+
+// StartAtGTE applies the GTE predicate on the "start_at" field.
+func StartAtGTE(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGTE(FieldStartAt, v))
+}
+
+// StartAtLT applies the LT predicate on the "start_at" field.
+func StartAtLT(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLT(FieldStartAt, v))
+}
+
+// StartAtLTE applies the LTE predicate on the "start_at" field.
+func StartAtLTE(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLTE(FieldStartAt, v))
+}
+
+// EndAtEQ applies the EQ predicate on the "end_at" field.
+func EndAtEQ(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldEndAt, v))
+}
+
+// EndAtNEQ applies the NEQ predicate on the "end_at" field.
+func EndAtNEQ(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNEQ(FieldEndAt, v))
+}
+
+// EndAtIn applies the In predicate on the "end_at" field.
+func EndAtIn(vs ...time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIn(FieldEndAt, vs...))
+}
+
+// EndAtNotIn applies the NotIn predicate on the "end_at" field.
+func EndAtNotIn(vs ...time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotIn(FieldEndAt, vs...))
+}
+
+// EndAtGT applies the GT predicate on the "end_at" field.
+func EndAtGT(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGT(FieldEndAt, v))
+}
+
+// EndAtGTE applies the GTE predicate on the "end_at" field.
+func EndAtGTE(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGTE(FieldEndAt, v))
+}
+
+// EndAtLT applies the LT predicate on the "end_at" field.
+func EndAtLT(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLT(FieldEndAt, v))
+}
+
+// EndAtLTE applies the LTE predicate on the "end_at" field.
+func EndAtLTE(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLTE(FieldEndAt, v))
+}
+
+// EndAtIsNil applies the IsNil predicate on the "end_at" field.
+func EndAtIsNil() predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIsNull(FieldEndAt))
+}
+
+// EndAtNotNil applies the NotNil predicate on the "end_at" field.
+func EndAtNotNil() predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotNull(FieldEndAt))
+}
+
+// CalendarIDEQ applies the EQ predicate on the "calendar_id" field.
+func CalendarIDEQ(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldCalendarID, v))
+}
+
+// CalendarIDNEQ applies the NEQ predicate on the "calendar_id" field.
+func CalendarIDNEQ(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNEQ(FieldCalendarID, v))
+}
+
+// CalendarIDIn applies the In predicate on the "calendar_id" field.
+func CalendarIDIn(vs ...int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIn(FieldCalendarID, vs...))
+}
+
+// CalendarIDNotIn applies the NotIn predicate on the "calendar_id" field.
+func CalendarIDNotIn(vs ...int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotIn(FieldCalendarID, vs...))
+}
+
+// CalendarIDGT applies the GT predicate on the "calendar_id" field.
+func CalendarIDGT(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGT(FieldCalendarID, v))
+}
+
+// CalendarIDGTE applies the GTE predicate on the "calendar_id" field.
+func CalendarIDGTE(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGTE(FieldCalendarID, v))
+}
+
+// CalendarIDLT applies the LT predicate on the "calendar_id" field.
+func CalendarIDLT(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLT(FieldCalendarID, v))
+}
+
+// CalendarIDLTE applies the LTE predicate on the "calendar_id" field.
+func CalendarIDLTE(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLTE(FieldCalendarID, v))
+}
+
+// CalendarIDIsNil applies the IsNil predicate on the "calendar_id" field.
+func CalendarIDIsNil() predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIsNull(FieldCalendarID))
+}
+
+// CalendarIDNotNil applies the NotNil predicate on the "calendar_id" field.
+func CalendarIDNotNil() predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotNull(FieldCalendarID))
+}
+
+// HolidayPolicyEQ applies the EQ predicate on the "holiday_policy" field.
+func HolidayPolicyEQ(v HolidayPolicy) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldHolidayPolicy, v))
+}
+
+// HolidayPolicyNEQ applies the NEQ predicate on the "holiday_policy" field.
+func HolidayPolicyNEQ(v HolidayPolicy) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNEQ(FieldHolidayPolicy, v))
+}
+
+// HolidayPolicyIn applies the In predicate on the "holiday_policy" field.
+func HolidayPolicyIn(vs ...HolidayPolicy) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIn(FieldHolidayPolicy, vs...))
+}
+
+// HolidayPolicyNotIn applies the NotIn predicate on the "holiday_policy" field.
+func HolidayPolicyNotIn(vs ...HolidayPolicy) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotIn(FieldHolidayPolicy, vs...))
+}
+
+// CatchUpPolicyEQ applies the EQ predicate on the "catch_up_policy" field.
+func CatchUpPolicyEQ(v CatchUpPolicy) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldCatchUpPolicy, v))
+}
+
+// CatchUpPolicyNEQ applies the NEQ predicate on the "catch_up_policy" field.
+func CatchUpPolicyNEQ(v CatchUpPolicy) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNEQ(FieldCatchUpPolicy, v))
+}
+
+// CatchUpPolicyIn applies the In predicate on the "catch_up_policy" field.
+func CatchUpPolicyIn(vs ...CatchUpPolicy) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIn(FieldCatchUpPolicy, vs...))
+}
+
+// CatchUpPolicyNotIn applies the NotIn predicate on the "catch_up_policy" field.
+func CatchUpPolicyNotIn(vs ...CatchUpPolicy) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotIn(FieldCatchUpPolicy, vs...))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// RevisionEQ applies the EQ predicate on the "revision" field.
+func RevisionEQ(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldRevision, v))
+}
+
+// RevisionNEQ applies the NEQ predicate on the "revision" field.
+func RevisionNEQ(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNEQ(FieldRevision, v))
+}
+
+// RevisionIn applies the In predicate on the "revision" field.
+func RevisionIn(vs ...int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIn(FieldRevision, vs...))
+}
+
+// RevisionNotIn applies the NotIn predicate on the "revision" field.
+func RevisionNotIn(vs ...int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotIn(FieldRevision, vs...))
+}
+
+// RevisionGT applies the GT predicate on the "revision" field.
+func RevisionGT(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGT(FieldRevision, v))
+}
+
+// RevisionGTE applies the GTE predicate on the "revision" field.
+func RevisionGTE(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGTE(FieldRevision, v))
+}
+
+// RevisionLT applies the LT predicate on the "revision" field.
+func RevisionLT(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLT(FieldRevision, v))
+}
+
+// RevisionLTE applies the LTE predicate on the "revision" field.
+func RevisionLTE(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLTE(FieldRevision, v))
+}
+
+// NextRunAtEQ applies the EQ predicate on the "next_run_at" field.
+func NextRunAtEQ(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldNextRunAt, v))
+}
+
+// NextRunAtNEQ applies the NEQ predicate on the "next_run_at" field.
+func NextRunAtNEQ(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNEQ(FieldNextRunAt, v))
+}
+
+// NextRunAtIn applies the In predicate on the "next_run_at" field.
+func NextRunAtIn(vs ...time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIn(FieldNextRunAt, vs...))
+}
+
+// NextRunAtNotIn applies the NotIn predicate on the "next_run_at" field.
+func NextRunAtNotIn(vs ...time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotIn(FieldNextRunAt, vs...))
+}
+
+// NextRunAtGT applies the GT predicate on the "next_run_at" field.
+func NextRunAtGT(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGT(FieldNextRunAt, v))
+}
+
+// NextRunAtGTE applies the GTE predicate on the "next_run_at" field.
+func NextRunAtGTE(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGTE(FieldNextRunAt, v))
+}
+
+// NextRunAtLT applies the LT predicate on the "next_run_at" field.
+func NextRunAtLT(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLT(FieldNextRunAt, v))
+}
+
+// NextRunAtLTE applies the LTE predicate on the "next_run_at" field.
+func NextRunAtLTE(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLTE(FieldNextRunAt, v))
+}
+
+// NextRunAtIsNil applies the IsNil predicate on the "next_run_at" field.
+func NextRunAtIsNil() predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIsNull(FieldNextRunAt))
+}
+
+// NextRunAtNotNil applies the NotNil predicate on the "next_run_at" field.
+func NextRunAtNotNil() predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotNull(FieldNextRunAt))
+}
+
+// LastOccurrenceAtEQ applies the EQ predicate on the "last_occurrence_at" field.
+func LastOccurrenceAtEQ(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldLastOccurrenceAt, v))
+}
+
+// LastOccurrenceAtNEQ applies the NEQ predicate on the "last_occurrence_at" field.
+func LastOccurrenceAtNEQ(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNEQ(FieldLastOccurrenceAt, v))
+}
+
+// LastOccurrenceAtIn applies the In predicate on the "last_occurrence_at" field.
+func LastOccurrenceAtIn(vs ...time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIn(FieldLastOccurrenceAt, vs...))
+}
+
+// LastOccurrenceAtNotIn applies the NotIn predicate on the "last_occurrence_at" field.
+func LastOccurrenceAtNotIn(vs ...time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotIn(FieldLastOccurrenceAt, vs...))
+}
+
+// LastOccurrenceAtGT applies the GT predicate on the "last_occurrence_at" field.
+func LastOccurrenceAtGT(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGT(FieldLastOccurrenceAt, v))
+}
+
+// LastOccurrenceAtGTE applies the GTE predicate on the "last_occurrence_at" field.
+func LastOccurrenceAtGTE(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGTE(FieldLastOccurrenceAt, v))
+}
+
+// LastOccurrenceAtLT applies the LT predicate on the "last_occurrence_at" field.
+func LastOccurrenceAtLT(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLT(FieldLastOccurrenceAt, v))
+}
+
+// LastOccurrenceAtLTE applies the LTE predicate on the "last_occurrence_at" field.
+func LastOccurrenceAtLTE(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLTE(FieldLastOccurrenceAt, v))
+}
+
+// LastOccurrenceAtIsNil applies the IsNil predicate on the "last_occurrence_at" field.
+func LastOccurrenceAtIsNil() predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIsNull(FieldLastOccurrenceAt))
+}
+
+// LastOccurrenceAtNotNil applies the NotNil predicate on the "last_occurrence_at" field.
+func LastOccurrenceAtNotNil() predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotNull(FieldLastOccurrenceAt))
+}
+
+// LastRunAtEQ applies the EQ predicate on the "last_run_at" field.
+func LastRunAtEQ(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldLastRunAt, v))
+}
+
+// LastRunAtNEQ applies the NEQ predicate on the "last_run_at" field.
+func LastRunAtNEQ(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNEQ(FieldLastRunAt, v))
+}
+
+// LastRunAtIn applies the In predicate on the "last_run_at" field.
+func LastRunAtIn(vs ...time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIn(FieldLastRunAt, vs...))
+}
+
+// LastRunAtNotIn applies the NotIn predicate on the "last_run_at" field.
+func LastRunAtNotIn(vs ...time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotIn(FieldLastRunAt, vs...))
+}
+
+// LastRunAtGT applies the GT predicate on the "last_run_at" field.
+func LastRunAtGT(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGT(FieldLastRunAt, v))
+}
+
+// LastRunAtGTE applies the GTE predicate on the "last_run_at" field.
+func LastRunAtGTE(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGTE(FieldLastRunAt, v))
+}
+
+// LastRunAtLT applies the LT predicate on the "last_run_at" field.
+func LastRunAtLT(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLT(FieldLastRunAt, v))
+}
+
+// LastRunAtLTE applies the LTE predicate on the "last_run_at" field.
+func LastRunAtLTE(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLTE(FieldLastRunAt, v))
+}
+
+// LastRunAtIsNil applies the IsNil predicate on the "last_run_at" field.
+func LastRunAtIsNil() predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIsNull(FieldLastRunAt))
+}
+
+// LastRunAtNotNil applies the NotNil predicate on the "last_run_at" field.
+func LastRunAtNotNil() predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotNull(FieldLastRunAt))
+}
+
+// LastTicketIDEQ applies the EQ predicate on the "last_ticket_id" field.
+func LastTicketIDEQ(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldLastTicketID, v))
+}
+
+// LastTicketIDNEQ applies the NEQ predicate on the "last_ticket_id" field.
+func LastTicketIDNEQ(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNEQ(FieldLastTicketID, v))
+}
+
+// LastTicketIDIn applies the In predicate on the "last_ticket_id" field.
+func LastTicketIDIn(vs ...int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIn(FieldLastTicketID, vs...))
+}
+
+// LastTicketIDNotIn applies the NotIn predicate on the "last_ticket_id" field.
+func LastTicketIDNotIn(vs ...int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotIn(FieldLastTicketID, vs...))
+}
+
+// LastTicketIDGT applies the GT predicate on the "last_ticket_id" field.
+func LastTicketIDGT(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGT(FieldLastTicketID, v))
+}
+
+// LastTicketIDGTE applies the GTE predicate on the "last_ticket_id" field.
+func LastTicketIDGTE(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGTE(FieldLastTicketID, v))
+}
+
+// LastTicketIDLT applies the LT predicate on the "last_ticket_id" field.
+func LastTicketIDLT(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLT(FieldLastTicketID, v))
+}
+
+// LastTicketIDLTE applies the LTE predicate on the "last_ticket_id" field.
+func LastTicketIDLTE(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLTE(FieldLastTicketID, v))
+}
+
+// LastTicketIDIsNil applies the IsNil predicate on the "last_ticket_id" field.
+func LastTicketIDIsNil() predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIsNull(FieldLastTicketID))
+}
+
+// LastTicketIDNotNil applies the NotNil predicate on the "last_ticket_id" field.
+func LastTicketIDNotNil() predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotNull(FieldLastTicketID))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v int) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasRuns applies the HasEdge predicate on the "runs" edge.
+func HasRuns() predicate.TicketSchedule {
+	return predicate.TicketSchedule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RunsTable, RunsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRunsWith applies the HasEdge predicate on the "runs" edge with a given conditions (other predicates).
+func HasRunsWith(preds ...predicate.TicketScheduleRun) predicate.TicketSchedule {
+	return predicate.TicketSchedule(func(s *sql.Selector) {
+		step := newRunsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TicketSchedule) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TicketSchedule) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TicketSchedule) predicate.TicketSchedule {
+	return predicate.TicketSchedule(sql.NotPredicates(p))
+}
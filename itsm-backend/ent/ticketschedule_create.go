@@ -0,0 +1,759 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/ticketschedule"
+	"itsm-backend/ent/ticketschedulerun"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TicketScheduleCreate is the builder for creating a TicketSchedule entity.
+type TicketScheduleCreate struct {
+	config
+	mutation *TicketScheduleMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *TicketScheduleCreate) SetTenantID(v int) *TicketScheduleCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *TicketScheduleCreate) SetName(v string) *TicketScheduleCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *TicketScheduleCreate) SetDescription(v string) *TicketScheduleCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *TicketScheduleCreate) SetNillableDescription(v *string) *TicketScheduleCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetSourceType sets the "source_type" field.
+func (_c *TicketScheduleCreate) SetSourceType(v ticketschedule.SourceType) *TicketScheduleCreate {
+	_c.mutation.SetSourceType(v)
+	return _c
+}
+
+// SetTemplateID sets the "template_id" field.
+func (_c *TicketScheduleCreate) SetTemplateID(v int) *TicketScheduleCreate {
+	_c.mutation.SetTemplateID(v)
+	return _c
+}
+
+// SetNillableTemplateID sets the "template_id" field if the given value is not nil.
+func (_c *TicketScheduleCreate) SetNillableTemplateID(v *int) *TicketScheduleCreate {
+	if v != nil {
+		_c.SetTemplateID(*v)
+	}
+	return _c
+}
+
+// SetCatalogItemID sets the "catalog_item_id" field.
+func (_c *TicketScheduleCreate) SetCatalogItemID(v int) *TicketScheduleCreate {
+	_c.mutation.SetCatalogItemID(v)
+	return _c
+}
+
+// SetNillableCatalogItemID sets the "catalog_item_id" field if the given value is not nil.
+func (_c *TicketScheduleCreate) SetNillableCatalogItemID(v *int) *TicketScheduleCreate {
+	if v != nil {
+		_c.SetCatalogItemID(*v)
+	}
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *TicketScheduleCreate) SetTitle(v string) *TicketScheduleCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_c *TicketScheduleCreate) SetNillableTitle(v *string) *TicketScheduleCreate {
+	if v != nil {
+		_c.SetTitle(*v)
+	}
+	return _c
+}
+
+// SetPriority sets the "priority" field.
+func (_c *TicketScheduleCreate) SetPriority(v string) *TicketScheduleCreate {
+	_c.mutation.SetPriority(v)
+	return _c
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_c *TicketScheduleCreate) SetNillablePriority(v *string) *TicketScheduleCreate {
+	if v != nil {
+		_c.SetPriority(*v)
+	}
+	return _c
+}
+
+// SetRequesterID sets the "requester_id" field.
+func (_c *TicketScheduleCreate) SetRequesterID(v int) *TicketScheduleCreate {
+	_c.mutation.SetRequesterID(v)
+	return _c
+}
+
+// SetAssigneeID sets the "assignee_id" field.
+func (_c *TicketScheduleCreate) SetAssigneeID(v int) *TicketScheduleCreate {
+	_c.mutation.SetAssigneeID(v)
+	return _c
+}
+
+// SetNillableAssigneeID sets the "assignee_id" field if the given value is not nil.
+func (_c *TicketScheduleCreate) SetNillableAssigneeID(v *int) *TicketScheduleCreate {
+	if v != nil {
+		_c.SetAssigneeID(*v)
+	}
+	return _c
+}
+
+// SetFormFields sets the "form_fields" field.
+func (_c *TicketScheduleCreate) SetFormFields(v map[string]interface{}) *TicketScheduleCreate {
+	_c.mutation.SetFormFields(v)
+	return _c
+}
+
+// SetRuleType sets the "rule_type" field.
+func (_c *TicketScheduleCreate) SetRuleType(v ticketschedule.RuleType) *TicketScheduleCreate {
+	_c.mutation.SetRuleType(v)
+	return _c
+}
+
+// SetExpression sets the "expression" field.
+func (_c *TicketScheduleCreate) SetExpression(v string) *TicketScheduleCreate {
+	_c.mutation.SetExpression(v)
+	return _c
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (_c *TicketScheduleCreate) SetTimeZone(v string) *TicketScheduleCreate {
+	_c.mutation.SetTimeZone(v)
+	return _c
+}
+
+// SetNillableTimeZone sets the "time_zone" field if the given value is not nil.
+func (_c *TicketScheduleCreate) SetNillableTimeZone(v *string) *TicketScheduleCreate {
+	if v != nil {
+		_c.SetTimeZone(*v)
+	}
+	return _c
+}
+
+// SetStartAt sets the "start_at" field.
+func (_c *TicketScheduleCreate) SetStartAt(v time.Time) *TicketScheduleCreate {
+	_c.mutation.SetStartAt(v)
+	return _c
+}
+
+// SetEndAt sets the "end_at" field.
+func (_c *TicketScheduleCreate) SetEndAt(v time.Time) *TicketScheduleCreate {
+	_c.mutation.SetEndAt(v)
+	return _c
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (_c *TicketScheduleCreate) SetNillableEndAt(v *time.Time) *TicketScheduleCreate {
+	if v != nil {
+		_c.SetEndAt(*v)
+	}
+	return _c
+}
+
+// SetCalendarID sets the "calendar_id" field.
+func (_c *TicketScheduleCreate) SetCalendarID(v int) *TicketScheduleCreate {
+	_c.mutation.SetCalendarID(v)
+	return _c
+}
+
+// SetNillableCalendarID sets the "calendar_id" field if the given value is not nil.
+func (_c *TicketScheduleCreate) SetNillableCalendarID(v *int) *TicketScheduleCreate {
+	if v != nil {
+		_c.SetCalendarID(*v)
+	}
+	return _c
+}
+
+// SetHolidayPolicy sets the "holiday_policy" field.
+func (_c *TicketScheduleCreate) SetHolidayPolicy(v ticketschedule.HolidayPolicy) *TicketScheduleCreate {
+	_c.mutation.SetHolidayPolicy(v)
+	return _c
+}
+
+// SetNillableHolidayPolicy sets the "holiday_policy" field if the given value is not nil.
+func (_c *TicketScheduleCreate) SetNillableHolidayPolicy(v *ticketschedule.HolidayPolicy) *TicketScheduleCreate {
+	if v != nil {
+		_c.SetHolidayPolicy(*v)
+	}
+	return _c
+}
+
+// SetCatchUpPolicy sets the "catch_up_policy" field.
+func (_c *TicketScheduleCreate) SetCatchUpPolicy(v ticketschedule.CatchUpPolicy) *TicketScheduleCreate {
+	_c.mutation.SetCatchUpPolicy(v)
+	return _c
+}
+
+// SetNillableCatchUpPolicy sets the "catch_up_policy" field if the given value is not nil.
+func (_c *TicketScheduleCreate) SetNillableCatchUpPolicy(v *ticketschedule.CatchUpPolicy) *TicketScheduleCreate {
+	if v != nil {
+		_c.SetCatchUpPolicy(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *TicketScheduleCreate) SetEnabled(v bool) *TicketScheduleCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *TicketScheduleCreate) SetNillableEnabled(v *bool) *TicketScheduleCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetRevision sets the "revision" field.
+func (_c *TicketScheduleCreate) SetRevision(v int) *TicketScheduleCreate {
+	_c.mutation.SetRevision(v)
+	return _c
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_c *TicketScheduleCreate) SetNillableRevision(v *int) *TicketScheduleCreate {
+	if v != nil {
+		_c.SetRevision(*v)
+	}
+	return _c
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (_c *TicketScheduleCreate) SetNextRunAt(v time.Time) *TicketScheduleCreate {
+	_c.mutation.SetNextRunAt(v)
+	return _c
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (_c *TicketScheduleCreate) SetNillableNextRunAt(v *time.Time) *TicketScheduleCreate {
+	if v != nil {
+		_c.SetNextRunAt(*v)
+	}
+	return _c
+}
+
+// SetLastOccurrenceAt sets the "last_occurrence_at" field.
+func (_c *TicketScheduleCreate) SetLastOccurrenceAt(v time.Time) *TicketScheduleCreate {
+	_c.mutation.SetLastOccurrenceAt(v)
+	return _c
+}
+
+// SetNillableLastOccurrenceAt sets the "last_occurrence_at" field if the given value is not nil.
+func (_c *TicketScheduleCreate) SetNillableLastOccurrenceAt(v *time.Time) *TicketScheduleCreate {
+	if v != nil {
+		_c.SetLastOccurrenceAt(*v)
+	}
+	return _c
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_c *TicketScheduleCreate) SetLastRunAt(v time.Time) *TicketScheduleCreate {
+	_c.mutation.SetLastRunAt(v)
+	return _c
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_c *TicketScheduleCreate) SetNillableLastRunAt(v *time.Time) *TicketScheduleCreate {
+	if v != nil {
+		_c.SetLastRunAt(*v)
+	}
+	return _c
+}
+
+// SetLastTicketID sets the "last_ticket_id" field.
+func (_c *TicketScheduleCreate) SetLastTicketID(v int) *TicketScheduleCreate {
+	_c.mutation.SetLastTicketID(v)
+	return _c
+}
+
+// SetNillableLastTicketID sets the "last_ticket_id" field if the given value is not nil.
+func (_c *TicketScheduleCreate) SetNillableLastTicketID(v *int) *TicketScheduleCreate {
+	if v != nil {
+		_c.SetLastTicketID(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *TicketScheduleCreate) SetCreatedBy(v int) *TicketScheduleCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *TicketScheduleCreate) SetNillableCreatedBy(v *int) *TicketScheduleCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TicketScheduleCreate) SetCreatedAt(v time.Time) *TicketScheduleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TicketScheduleCreate) SetNillableCreatedAt(v *time.Time) *TicketScheduleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *TicketScheduleCreate) SetUpdatedAt(v time.Time) *TicketScheduleCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *TicketScheduleCreate) SetNillableUpdatedAt(v *time.Time) *TicketScheduleCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// AddRunIDs adds the "runs" edge to the TicketScheduleRun entity by IDs.
+func (_c *TicketScheduleCreate) AddRunIDs(ids ...int) *TicketScheduleCreate {
+	_c.mutation.AddRunIDs(ids...)
+	return _c
+}
+
+// AddRuns adds the "runs" edges to the TicketScheduleRun entity.
+func (_c *TicketScheduleCreate) AddRuns(v ...*TicketScheduleRun) *TicketScheduleCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRunIDs(ids...)
+}
+
+// Mutation returns the TicketScheduleMutation object of the builder.
+func (_c *TicketScheduleCreate) Mutation() *TicketScheduleMutation {
+	return _c.mutation
+}
+
+// Save creates the TicketSchedule in the database.
+func (_c *TicketScheduleCreate) Save(ctx context.Context) (*TicketSchedule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TicketScheduleCreate) SaveX(ctx context.Context) *TicketSchedule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TicketScheduleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TicketScheduleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TicketScheduleCreate) defaults() {
+	if _, ok := _c.mutation.TimeZone(); !ok {
+		v := ticketschedule.DefaultTimeZone
+		_c.mutation.SetTimeZone(v)
+	}
+	if _, ok := _c.mutation.HolidayPolicy(); !ok {
+		v := ticketschedule.DefaultHolidayPolicy
+		_c.mutation.SetHolidayPolicy(v)
+	}
+	if _, ok := _c.mutation.CatchUpPolicy(); !ok {
+		v := ticketschedule.DefaultCatchUpPolicy
+		_c.mutation.SetCatchUpPolicy(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := ticketschedule.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.Revision(); !ok {
+		v := ticketschedule.DefaultRevision
+		_c.mutation.SetRevision(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := ticketschedule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := ticketschedule.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TicketScheduleCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "TicketSchedule.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := ticketschedule.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "TicketSchedule.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "TicketSchedule.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := ticketschedule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TicketSchedule.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SourceType(); !ok {
+		return &ValidationError{Name: "source_type", err: errors.New(`ent: missing required field "TicketSchedule.source_type"`)}
+	}
+	if v, ok := _c.mutation.SourceType(); ok {
+		if err := ticketschedule.SourceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "source_type", err: fmt.Errorf(`ent: validator failed for field "TicketSchedule.source_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RequesterID(); !ok {
+		return &ValidationError{Name: "requester_id", err: errors.New(`ent: missing required field "TicketSchedule.requester_id"`)}
+	}
+	if v, ok := _c.mutation.RequesterID(); ok {
+		if err := ticketschedule.RequesterIDValidator(v); err != nil {
+			return &ValidationError{Name: "requester_id", err: fmt.Errorf(`ent: validator failed for field "TicketSchedule.requester_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RuleType(); !ok {
+		return &ValidationError{Name: "rule_type", err: errors.New(`ent: missing required field "TicketSchedule.rule_type"`)}
+	}
+	if v, ok := _c.mutation.RuleType(); ok {
+		if err := ticketschedule.RuleTypeValidator(v); err != nil {
+			return &ValidationError{Name: "rule_type", err: fmt.Errorf(`ent: validator failed for field "TicketSchedule.rule_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Expression(); !ok {
+		return &ValidationError{Name: "expression", err: errors.New(`ent: missing required field "TicketSchedule.expression"`)}
+	}
+	if v, ok := _c.mutation.Expression(); ok {
+		if err := ticketschedule.ExpressionValidator(v); err != nil {
+			return &ValidationError{Name: "expression", err: fmt.Errorf(`ent: validator failed for field "TicketSchedule.expression": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TimeZone(); !ok {
+		return &ValidationError{Name: "time_zone", err: errors.New(`ent: missing required field "TicketSchedule.time_zone"`)}
+	}
+	if _, ok := _c.mutation.StartAt(); !ok {
+		return &ValidationError{Name: "start_at", err: errors.New(`ent: missing required field "TicketSchedule.start_at"`)}
+	}
+	if _, ok := _c.mutation.HolidayPolicy(); !ok {
+		return &ValidationError{Name: "holiday_policy", err: errors.New(`ent: missing required field "TicketSchedule.holiday_policy"`)}
+	}
+	if v, ok := _c.mutation.HolidayPolicy(); ok {
+		if err := ticketschedule.HolidayPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "holiday_policy", err: fmt.Errorf(`ent: validator failed for field "TicketSchedule.holiday_policy": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CatchUpPolicy(); !ok {
+		return &ValidationError{Name: "catch_up_policy", err: errors.New(`ent: missing required field "TicketSchedule.catch_up_policy"`)}
+	}
+	if v, ok := _c.mutation.CatchUpPolicy(); ok {
+		if err := ticketschedule.CatchUpPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "catch_up_policy", err: fmt.Errorf(`ent: validator failed for field "TicketSchedule.catch_up_policy": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "TicketSchedule.enabled"`)}
+	}
+	if _, ok := _c.mutation.Revision(); !ok {
+		return &ValidationError{Name: "revision", err: errors.New(`ent: missing required field "TicketSchedule.revision"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TicketSchedule.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TicketSchedule.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *TicketScheduleCreate) sqlSave(ctx context.Context) (*TicketSchedule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TicketScheduleCreate) createSpec() (*TicketSchedule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TicketSchedule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(ticketschedule.Table, sqlgraph.NewFieldSpec(ticketschedule.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(ticketschedule.FieldTenantID, field.TypeInt, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(ticketschedule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(ticketschedule.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.SourceType(); ok {
+		_spec.SetField(ticketschedule.FieldSourceType, field.TypeEnum, value)
+		_node.SourceType = value
+	}
+	if value, ok := _c.mutation.TemplateID(); ok {
+		_spec.SetField(ticketschedule.FieldTemplateID, field.TypeInt, value)
+		_node.TemplateID = &value
+	}
+	if value, ok := _c.mutation.CatalogItemID(); ok {
+		_spec.SetField(ticketschedule.FieldCatalogItemID, field.TypeInt, value)
+		_node.CatalogItemID = &value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(ticketschedule.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Priority(); ok {
+		_spec.SetField(ticketschedule.FieldPriority, field.TypeString, value)
+		_node.Priority = value
+	}
+	if value, ok := _c.mutation.RequesterID(); ok {
+		_spec.SetField(ticketschedule.FieldRequesterID, field.TypeInt, value)
+		_node.RequesterID = value
+	}
+	if value, ok := _c.mutation.AssigneeID(); ok {
+		_spec.SetField(ticketschedule.FieldAssigneeID, field.TypeInt, value)
+		_node.AssigneeID = &value
+	}
+	if value, ok := _c.mutation.FormFields(); ok {
+		_spec.SetField(ticketschedule.FieldFormFields, field.TypeJSON, value)
+		_node.FormFields = value
+	}
+	if value, ok := _c.mutation.RuleType(); ok {
+		_spec.SetField(ticketschedule.FieldRuleType, field.TypeEnum, value)
+		_node.RuleType = value
+	}
+	if value, ok := _c.mutation.Expression(); ok {
+		_spec.SetField(ticketschedule.FieldExpression, field.TypeString, value)
+		_node.Expression = value
+	}
+	if value, ok := _c.mutation.TimeZone(); ok {
+		_spec.SetField(ticketschedule.FieldTimeZone, field.TypeString, value)
+		_node.TimeZone = value
+	}
+	if value, ok := _c.mutation.StartAt(); ok {
+		_spec.SetField(ticketschedule.FieldStartAt, field.TypeTime, value)
+		_node.StartAt = value
+	}
+	if value, ok := _c.mutation.EndAt(); ok {
+		_spec.SetField(ticketschedule.FieldEndAt, field.TypeTime, value)
+		_node.EndAt = &value
+	}
+	if value, ok := _c.mutation.CalendarID(); ok {
+		_spec.SetField(ticketschedule.FieldCalendarID, field.TypeInt, value)
+		_node.CalendarID = &value
+	}
+	if value, ok := _c.mutation.HolidayPolicy(); ok {
+		_spec.SetField(ticketschedule.FieldHolidayPolicy, field.TypeEnum, value)
+		_node.HolidayPolicy = value
+	}
+	if value, ok := _c.mutation.CatchUpPolicy(); ok {
+		_spec.SetField(ticketschedule.FieldCatchUpPolicy, field.TypeEnum, value)
+		_node.CatchUpPolicy = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(ticketschedule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.Revision(); ok {
+		_spec.SetField(ticketschedule.FieldRevision, field.TypeInt, value)
+		_node.Revision = value
+	}
+	if value, ok := _c.mutation.NextRunAt(); ok {
+		_spec.SetField(ticketschedule.FieldNextRunAt, field.TypeTime, value)
+		_node.NextRunAt = &value
+	}
+	if value, ok := _c.mutation.LastOccurrenceAt(); ok {
+		_spec.SetField(ticketschedule.FieldLastOccurrenceAt, field.TypeTime, value)
+		_node.LastOccurrenceAt = &value
+	}
+	if value, ok := _c.mutation.LastRunAt(); ok {
+		_spec.SetField(ticketschedule.FieldLastRunAt, field.TypeTime, value)
+		_node.LastRunAt = &value
+	}
+	if value, ok := _c.mutation.LastTicketID(); ok {
+		_spec.SetField(ticketschedule.FieldLastTicketID, field.TypeInt, value)
+		_node.LastTicketID = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(ticketschedule.FieldCreatedBy, field.TypeInt, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(ticketschedule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(ticketschedule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.RunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticketschedule.RunsTable,
+			Columns: []string{ticketschedule.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketschedulerun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TicketScheduleCreateBulk is the builder for creating many TicketSchedule entities in bulk.
+type TicketScheduleCreateBulk struct {
+	config
+	err      error
+	builders []*TicketScheduleCreate
+}
+
+// Save creates the TicketSchedule entities in the database.
+func (_c *TicketScheduleCreateBulk) Save(ctx context.Context) ([]*TicketSchedule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TicketSchedule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TicketScheduleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TicketScheduleCreateBulk) SaveX(ctx context.Context) []*TicketSchedule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TicketScheduleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TicketScheduleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1805 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/predicate"
+	"itsm-backend/ent/ticketschedule"
+	"itsm-backend/ent/ticketschedulerun"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TicketScheduleUpdate is the builder for updating TicketSchedule entities.
+type TicketScheduleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TicketScheduleMutation
+}
+
+// Where appends a list predicates to the TicketScheduleUpdate builder.
+func (_u *TicketScheduleUpdate) Where(ps ...predicate.TicketSchedule) *TicketScheduleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *TicketScheduleUpdate) SetTenantID(v int) *TicketScheduleUpdate {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *TicketScheduleUpdate) SetNillableTenantID(v *int) *TicketScheduleUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *TicketScheduleUpdate) AddTenantID(v int) *TicketScheduleUpdate {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *TicketScheduleUpdate) SetName(v string) *TicketScheduleUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TicketScheduleUpdate) SetNillableName(v *string) *TicketScheduleUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *TicketScheduleUpdate) SetDescription(v string) *TicketScheduleUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *TicketScheduleUpdate) SetNillableDescription(v *string) *TicketScheduleUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *TicketScheduleUpdate) ClearDescription() *TicketScheduleUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetSourceType sets the "source_type" field.
+func (_u *TicketScheduleUpdate) SetSourceType(v ticketschedule.SourceType) *TicketScheduleUpdate {
+	_u.mutation.SetSourceType(v)
+	return _u
+}
+
+// SetNillableSourceType sets the "source_type" field if the given value is not nil.
+func (_u *TicketScheduleUpdate) SetNillableSourceType(v *ticketschedule.SourceType) *TicketScheduleUpdate {
+	if v != nil {
+		_u.SetSourceType(*v)
+	}
+	return _u
+}
+
+// SetTemplateID sets the "template_id" field.
+func (_u *TicketScheduleUpdate) SetTemplateID(v int) *TicketScheduleUpdate {
+	_u.mutation.ResetTemplateID()
+	_u.mutation.SetTemplateID(v)
+	return _u
+}
+
+// SetNillableTemplateID sets the "template_id" field if the given value is not nil.
+func (_u *TicketScheduleUpdate) SetNillableTemplateID(v *int) *TicketScheduleUpdate {
+	if v != nil {
+		_u.SetTemplateID(*v)
+	}
+	return _u
+}
+
+// AddTemplateID adds value to the "template_id" field.
+func (_u *TicketScheduleUpdate) AddTemplateID(v int) *TicketScheduleUpdate {
+	_u.mutation.AddTemplateID(v)
+	return _u
+}
+
+// ClearTemplateID clears the value of the "template_id" field.
+func (_u *TicketScheduleUpdate) ClearTemplateID() *TicketScheduleUpdate {
+	_u.mutation.ClearTemplateID()
+	return _u
+}
+
+// SetCatalogItemID sets the "catalog_item_id" field.
+func (_u *TicketScheduleUpdate) SetCatalogItemID(v int) *TicketScheduleUpdate {
+	_u.mutation.ResetCatalogItemID()
+	_u.mutation.SetCatalogItemID(v)
+	return _u
+}
+
+// SetNillableCatalogItemID sets the "catalog_item_id" field if the given value is not nil.
+func (_u *TicketScheduleUpdate) SetNillableCatalogItemID(v *int) *TicketScheduleUpdate {
+	if v != nil {
+		_u.SetCatalogItemID(*v)
+	}
+	return _u
+}
+
+// AddCatalogItemID adds value to the "catalog_item_id" field.
+func (_u *TicketScheduleUpdate) AddCatalogItemID(v int) *TicketScheduleUpdate {
+	_u.mutation.AddCatalogItemID(v)
+	return _u
+}
+
+// ClearCatalogItemID clears the value of the "catalog_item_id" field.
+func (_u *TicketScheduleUpdate) ClearCatalogItemID() *TicketScheduleUpdate {
+	_u.mutation.ClearCatalogItemID()
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *TicketScheduleUpdate) SetTitle(v string) *TicketScheduleUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *TicketScheduleUpdate) SetNillableTitle(v *string) *TicketScheduleUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// ClearTitle clears the value of the "title" field.
+func (_u *TicketScheduleUpdate) ClearTitle() *TicketScheduleUpdate {
+	_u.mutation.ClearTitle()
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *TicketScheduleUpdate) SetPriority(v string) *TicketScheduleUpdate {
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *TicketScheduleUpdate) SetNillablePriority(v *string) *TicketScheduleUpdate {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// ClearPriority clears the value of the "priority" field.
+func (_u *TicketScheduleUpdate) ClearPriority() *TicketScheduleUpdate {
+	_u.mutation.ClearPriority()
+	return _u
+}
+
+// SetRequesterID sets the "requester_id" field.
+func (_u *TicketScheduleUpdate) SetRequesterID(v int) *TicketScheduleUpdate {
+	_u.mutation.ResetRequesterID()
+	_u.mutation.SetRequesterID(v)
+	return _u
+}
+
+// SetNillableRequesterID sets the "requester_id" field if the given value is not nil.
+func (_u *TicketScheduleUpdate) SetNillableRequesterID(v *int) *TicketScheduleUpdate {
+	if v != nil {
+		_u.SetRequesterID(*v)
+	}
+	return _u
+}
+
+// AddRequesterID adds value to the "requester_id" field.
+func (_u *TicketScheduleUpdate) AddRequesterID(v int) *TicketScheduleUpdate {
+	_u.mutation.AddRequesterID(v)
+	return _u
+}
+
+// SetAssigneeID sets the "assignee_id" field.
+func (_u *TicketScheduleUpdate) SetAssigneeID(v int) *TicketScheduleUpdate {
+	_u.mutation.ResetAssigneeID()
+	_u.mutation.SetAssigneeID(v)
+	return _u
+}
+
+// SetNillableAssigneeID sets the "assignee_id" field if the given value is not nil.
+func (_u *TicketScheduleUpdate) SetNillableAssigneeID(v *int) *TicketScheduleUpdate {
+	if v != nil {
+		_u.SetAssigneeID(*v)
+	}
+	return _u
+}
+
+// AddAssigneeID adds value to the "assignee_id" field.
+func (_u *TicketScheduleUpdate) AddAssigneeID(v int) *TicketScheduleUpdate {
+	_u.mutation.AddAssigneeID(v)
+	return _u
+}
+
+// ClearAssigneeID clears the value of the "assignee_id" field.
+func (_u *TicketScheduleUpdate) ClearAssigneeID() *TicketScheduleUpdate {
+	_u.mutation.ClearAssigneeID()
+	return _u
+}
+
+// SetFormFields sets the "form_fields" field.
+func (_u *TicketScheduleUpdate) SetFormFields(v map[string]interface{}) *TicketScheduleUpdate {
+	_u.mutation.SetFormFields(v)
+	return _u
+}
+
+// ClearFormFields clears the value of the "form_fields" field.
+func (_u *TicketScheduleUpdate) ClearFormFields() *TicketScheduleUpdate {
+	_u.mutation.ClearFormFields()
+	return _u
+}
+
+// SetRuleType sets the "rule_type" field.
+func (_u *TicketScheduleUpdate) SetRuleType(v ticketschedule.RuleType) *TicketScheduleUpdate {
+	_u.mutation.SetRuleType(v)
+	return _u
+}
+
+// SetNillableRuleType sets the "rule_type" field if the given value is not nil.
+func (_u *TicketScheduleUpdate) SetNillableRuleType(v *ticketschedule.RuleType) *TicketScheduleUpdate {
+	if v != nil {
+		_u.SetRuleType(*v)
+	}
+	return _u
+}
+
+// SetExpression sets the "expression" field.
+func (_u *TicketScheduleUpdate) SetExpression(v string) *TicketScheduleUpdate {
+	_u.mutation.SetExpression(v)
+	return _u
+}
+
+// SetNillableExpression sets the "expression" field if the given value is not nil.
+func (_u *TicketScheduleUpdate) SetNillableExpression(v *string) *TicketScheduleUpdate {
+	if v != nil {
+		_u.SetExpression(*v)
+	}
+	return _u
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (_u *TicketScheduleUpdate) SetTimeZone(v string) *TicketScheduleUpdate {
+	_u.mutation.SetTimeZone(v)
+	return _u
+}
+
+// SetNillableTimeZone sets the "time_zone" field if the given value is not nil.
+func (_u *TicketScheduleUpdate) SetNillableTimeZone(v *string) *TicketScheduleUpdate {
+	if v != nil {
+		_u.SetTimeZone(*v)
+	}
+	return _u
+}
+
+// SetStartAt sets the "start_at" field.
+func (_u *TicketScheduleUpdate) SetStartAt(v time.Time) *TicketScheduleUpdate {
+	_u.mutation.SetStartAt(v)
+	return _u
+}
+
+// SetNillableStartAt sets the "start_at" field if the given value is not nil.
+func (_u *TicketScheduleUpdate) SetNillableStartAt(v *time.Time) *TicketScheduleUpdate {
+	if v != nil {
+		_u.SetStartAt(*v)
+	}
+	return _u
+}
+
+// SetEndAt sets the "end_at" field.
+func (_u *TicketScheduleUpdate) SetEndAt(v time.Time) *TicketScheduleUpdate {
+	_u.mutation.SetEndAt(v)
+	return _u
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (_u *TicketScheduleUpdate) SetNillableEndAt(v *time.Time) *TicketScheduleUpdate {
+	if v != nil {
+		_u.SetEndAt(*v)
+	}
+	return _u
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (_u *TicketScheduleUpdate) ClearEndAt() *TicketScheduleUpdate {
+	_u.mutation.ClearEndAt()
+	return _u
+}
+
+// SetCalendarID sets the "calendar_id" field.
+func (_u *TicketScheduleUpdate) SetCalendarID(v int) *TicketScheduleUpdate {
+	_u.mutation.ResetCalendarID()
+	_u.mutation.SetCalendarID(v)
+	return _u
+}
+
+// SetNillableCalendarID sets the "calendar_id" field if the given value is not nil.
+func (_u *TicketScheduleUpdate) SetNillableCalendarID(v *int) *TicketScheduleUpdate {
+	if v != nil {
+		_u.SetCalendarID(*v)
+	}
+	return _u
+}
+
+// AddCalendarID adds value to the "calendar_id" field.
+func (_u *TicketScheduleUpdate) AddCalendarID(v int) *TicketScheduleUpdate {
+	_u.mutation.AddCalendarID(v)
+	return _u
+}
+
+// ClearCalendarID clears the value of the "calendar_id" field.
+func (_u *TicketScheduleUpdate) ClearCalendarID() *TicketScheduleUpdate {
+	_u.mutation.ClearCalendarID()
+	return _u
+}
+
+// SetHolidayPolicy sets the "holiday_policy" field.
+func (_u *TicketScheduleUpdate) SetHolidayPolicy(v ticketschedule.HolidayPolicy) *TicketScheduleUpdate {
+	_u.mutation.SetHolidayPolicy(v)
+	return _u
+}
+
+// SetNillableHolidayPolicy sets the "holiday_policy" field if the given value is not nil.
+func (_u *TicketScheduleUpdate) SetNillableHolidayPolicy(v *ticketschedule.HolidayPolicy) *TicketScheduleUpdate {
+	if v != nil {
+		_u.SetHolidayPolicy(*v)
+	}
+	return _u
+}
+
+// SetCatchUpPolicy sets the "catch_up_policy" field.
+func (_u *TicketScheduleUpdate) SetCatchUpPolicy(v ticketschedule.CatchUpPolicy) *TicketScheduleUpdate {
+	_u.mutation.SetCatchUpPolicy(v)
+	return _u
+}
+
+// SetNillableCatchUpPolicy sets the "catch_up_policy" field if the given value is not nil.
+func (_u *TicketScheduleUpdate) SetNillableCatchUpPolicy(v *ticketschedule.CatchUpPolicy) *TicketScheduleUpdate {
+	if v != nil {
+		_u.SetCatchUpPolicy(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *TicketScheduleUpdate) SetEnabled(v bool) *TicketScheduleUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *TicketScheduleUpdate) SetNillableEnabled(v *bool) *TicketScheduleUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetRevision sets the "revision" field.
+func (_u *TicketScheduleUpdate) SetRevision(v int) *TicketScheduleUpdate {
+	_u.mutation.ResetRevision()
+	_u.mutation.SetRevision(v)
+	return _u
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_u *TicketScheduleUpdate) SetNillableRevision(v *int) *TicketScheduleUpdate {
+	if v != nil {
+		_u.SetRevision(*v)
+	}
+	return _u
+}
+
+// AddRevision adds value to the "revision" field.
+func (_u *TicketScheduleUpdate) AddRevision(v int) *TicketScheduleUpdate {
+	_u.mutation.AddRevision(v)
+	return _u
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (_u *TicketScheduleUpdate) SetNextRunAt(v time.Time) *TicketScheduleUpdate {
+	_u.mutation.SetNextRunAt(v)
+	return _u
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (_u *TicketScheduleUpdate) SetNillableNextRunAt(v *time.Time) *TicketScheduleUpdate {
+	if v != nil {
+		_u.SetNextRunAt(*v)
+	}
+	return _u
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (_u *TicketScheduleUpdate) ClearNextRunAt() *TicketScheduleUpdate {
+	_u.mutation.ClearNextRunAt()
+	return _u
+}
+
+// SetLastOccurrenceAt sets the "last_occurrence_at" field.
+func (_u *TicketScheduleUpdate) SetLastOccurrenceAt(v time.Time) *TicketScheduleUpdate {
+	_u.mutation.SetLastOccurrenceAt(v)
+	return _u
+}
+
+// SetNillableLastOccurrenceAt sets the "last_occurrence_at" field if the given value is not nil.
+func (_u *TicketScheduleUpdate) SetNillableLastOccurrenceAt(v *time.Time) *TicketScheduleUpdate {
+	if v != nil {
+		_u.SetLastOccurrenceAt(*v)
+	}
+	return _u
+}
+
+// ClearLastOccurrenceAt clears the value of the "last_occurrence_at" field.
+func (_u *TicketScheduleUpdate) ClearLastOccurrenceAt() *TicketScheduleUpdate {
+	_u.mutation.ClearLastOccurrenceAt()
+	return _u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_u *TicketScheduleUpdate) SetLastRunAt(v time.Time) *TicketScheduleUpdate {
+	_u.mutation.SetLastRunAt(v)
+	return _u
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_u *TicketScheduleUpdate) SetNillableLastRunAt(v *time.Time) *TicketScheduleUpdate {
+	if v != nil {
+		_u.SetLastRunAt(*v)
+	}
+	return _u
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (_u *TicketScheduleUpdate) ClearLastRunAt() *TicketScheduleUpdate {
+	_u.mutation.ClearLastRunAt()
+	return _u
+}
+
+// SetLastTicketID sets the "last_ticket_id" field.
+func (_u *TicketScheduleUpdate) SetLastTicketID(v int) *TicketScheduleUpdate {
+	_u.mutation.ResetLastTicketID()
+	_u.mutation.SetLastTicketID(v)
+	return _u
+}
+
+// SetNillableLastTicketID sets the "last_ticket_id" field if the given value is not nil.
+func (_u *TicketScheduleUpdate) SetNillableLastTicketID(v *int) *TicketScheduleUpdate {
+	if v != nil {
+		_u.SetLastTicketID(*v)
+	}
+	return _u
+}
+
+// AddLastTicketID adds value to the "last_ticket_id" field.
+func (_u *TicketScheduleUpdate) AddLastTicketID(v int) *TicketScheduleUpdate {
+	_u.mutation.AddLastTicketID(v)
+	return _u
+}
+
+// ClearLastTicketID clears the value of the "last_ticket_id" field.
+func (_u *TicketScheduleUpdate) ClearLastTicketID() *TicketScheduleUpdate {
+	_u.mutation.ClearLastTicketID()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *TicketScheduleUpdate) SetCreatedBy(v int) *TicketScheduleUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *TicketScheduleUpdate) SetNillableCreatedBy(v *int) *TicketScheduleUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *TicketScheduleUpdate) AddCreatedBy(v int) *TicketScheduleUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *TicketScheduleUpdate) ClearCreatedBy() *TicketScheduleUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *TicketScheduleUpdate) SetCreatedAt(v time.Time) *TicketScheduleUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *TicketScheduleUpdate) SetNillableCreatedAt(v *time.Time) *TicketScheduleUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TicketScheduleUpdate) SetUpdatedAt(v time.Time) *TicketScheduleUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddRunIDs adds the "runs" edge to the TicketScheduleRun entity by IDs.
+func (_u *TicketScheduleUpdate) AddRunIDs(ids ...int) *TicketScheduleUpdate {
+	_u.mutation.AddRunIDs(ids...)
+	return _u
+}
+
+// AddRuns adds the "runs" edges to the TicketScheduleRun entity.
+func (_u *TicketScheduleUpdate) AddRuns(v ...*TicketScheduleRun) *TicketScheduleUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRunIDs(ids...)
+}
+
+// Mutation returns the TicketScheduleMutation object of the builder.
+func (_u *TicketScheduleUpdate) Mutation() *TicketScheduleMutation {
+	return _u.mutation
+}
+
+// ClearRuns clears all "runs" edges to the TicketScheduleRun entity.
+func (_u *TicketScheduleUpdate) ClearRuns() *TicketScheduleUpdate {
+	_u.mutation.ClearRuns()
+	return _u
+}
+
+// RemoveRunIDs removes the "runs" edge to TicketScheduleRun entities by IDs.
+func (_u *TicketScheduleUpdate) RemoveRunIDs(ids ...int) *TicketScheduleUpdate {
+	_u.mutation.RemoveRunIDs(ids...)
+	return _u
+}
+
+// RemoveRuns removes "runs" edges to TicketScheduleRun entities.
+func (_u *TicketScheduleUpdate) RemoveRuns(v ...*TicketScheduleRun) *TicketScheduleUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRunIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TicketScheduleUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TicketScheduleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TicketScheduleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TicketScheduleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TicketScheduleUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := ticketschedule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TicketScheduleUpdate) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := ticketschedule.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "TicketSchedule.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := ticketschedule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TicketSchedule.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SourceType(); ok {
+		if err := ticketschedule.SourceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "source_type", err: fmt.Errorf(`ent: validator failed for field "TicketSchedule.source_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RequesterID(); ok {
+		if err := ticketschedule.RequesterIDValidator(v); err != nil {
+			return &ValidationError{Name: "requester_id", err: fmt.Errorf(`ent: validator failed for field "TicketSchedule.requester_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RuleType(); ok {
+		if err := ticketschedule.RuleTypeValidator(v); err != nil {
+			return &ValidationError{Name: "rule_type", err: fmt.Errorf(`ent: validator failed for field "TicketSchedule.rule_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Expression(); ok {
+		if err := ticketschedule.ExpressionValidator(v); err != nil {
+			return &ValidationError{Name: "expression", err: fmt.Errorf(`ent: validator failed for field "TicketSchedule.expression": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HolidayPolicy(); ok {
+		if err := ticketschedule.HolidayPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "holiday_policy", err: fmt.Errorf(`ent: validator failed for field "TicketSchedule.holiday_policy": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CatchUpPolicy(); ok {
+		if err := ticketschedule.CatchUpPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "catch_up_policy", err: fmt.Errorf(`ent: validator failed for field "TicketSchedule.catch_up_policy": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *TicketScheduleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ticketschedule.Table, ticketschedule.Columns, sqlgraph.NewFieldSpec(ticketschedule.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(ticketschedule.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(ticketschedule.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(ticketschedule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(ticketschedule.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(ticketschedule.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.SourceType(); ok {
+		_spec.SetField(ticketschedule.FieldSourceType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TemplateID(); ok {
+		_spec.SetField(ticketschedule.FieldTemplateID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTemplateID(); ok {
+		_spec.AddField(ticketschedule.FieldTemplateID, field.TypeInt, value)
+	}
+	if _u.mutation.TemplateIDCleared() {
+		_spec.ClearField(ticketschedule.FieldTemplateID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CatalogItemID(); ok {
+		_spec.SetField(ticketschedule.FieldCatalogItemID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCatalogItemID(); ok {
+		_spec.AddField(ticketschedule.FieldCatalogItemID, field.TypeInt, value)
+	}
+	if _u.mutation.CatalogItemIDCleared() {
+		_spec.ClearField(ticketschedule.FieldCatalogItemID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(ticketschedule.FieldTitle, field.TypeString, value)
+	}
+	if _u.mutation.TitleCleared() {
+		_spec.ClearField(ticketschedule.FieldTitle, field.TypeString)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(ticketschedule.FieldPriority, field.TypeString, value)
+	}
+	if _u.mutation.PriorityCleared() {
+		_spec.ClearField(ticketschedule.FieldPriority, field.TypeString)
+	}
+	if value, ok := _u.mutation.RequesterID(); ok {
+		_spec.SetField(ticketschedule.FieldRequesterID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRequesterID(); ok {
+		_spec.AddField(ticketschedule.FieldRequesterID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AssigneeID(); ok {
+		_spec.SetField(ticketschedule.FieldAssigneeID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAssigneeID(); ok {
+		_spec.AddField(ticketschedule.FieldAssigneeID, field.TypeInt, value)
+	}
+	if _u.mutation.AssigneeIDCleared() {
+		_spec.ClearField(ticketschedule.FieldAssigneeID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.FormFields(); ok {
+		_spec.SetField(ticketschedule.FieldFormFields, field.TypeJSON, value)
+	}
+	if _u.mutation.FormFieldsCleared() {
+		_spec.ClearField(ticketschedule.FieldFormFields, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RuleType(); ok {
+		_spec.SetField(ticketschedule.FieldRuleType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Expression(); ok {
+		_spec.SetField(ticketschedule.FieldExpression, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TimeZone(); ok {
+		_spec.SetField(ticketschedule.FieldTimeZone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartAt(); ok {
+		_spec.SetField(ticketschedule.FieldStartAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndAt(); ok {
+		_spec.SetField(ticketschedule.FieldEndAt, field.TypeTime, value)
+	}
+	if _u.mutation.EndAtCleared() {
+		_spec.ClearField(ticketschedule.FieldEndAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CalendarID(); ok {
+		_spec.SetField(ticketschedule.FieldCalendarID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCalendarID(); ok {
+		_spec.AddField(ticketschedule.FieldCalendarID, field.TypeInt, value)
+	}
+	if _u.mutation.CalendarIDCleared() {
+		_spec.ClearField(ticketschedule.FieldCalendarID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.HolidayPolicy(); ok {
+		_spec.SetField(ticketschedule.FieldHolidayPolicy, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.CatchUpPolicy(); ok {
+		_spec.SetField(ticketschedule.FieldCatchUpPolicy, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(ticketschedule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Revision(); ok {
+		_spec.SetField(ticketschedule.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRevision(); ok {
+		_spec.AddField(ticketschedule.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextRunAt(); ok {
+		_spec.SetField(ticketschedule.FieldNextRunAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextRunAtCleared() {
+		_spec.ClearField(ticketschedule.FieldNextRunAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastOccurrenceAt(); ok {
+		_spec.SetField(ticketschedule.FieldLastOccurrenceAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastOccurrenceAtCleared() {
+		_spec.ClearField(ticketschedule.FieldLastOccurrenceAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastRunAt(); ok {
+		_spec.SetField(ticketschedule.FieldLastRunAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastRunAtCleared() {
+		_spec.ClearField(ticketschedule.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastTicketID(); ok {
+		_spec.SetField(ticketschedule.FieldLastTicketID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastTicketID(); ok {
+		_spec.AddField(ticketschedule.FieldLastTicketID, field.TypeInt, value)
+	}
+	if _u.mutation.LastTicketIDCleared() {
+		_spec.ClearField(ticketschedule.FieldLastTicketID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(ticketschedule.FieldCreatedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(ticketschedule.FieldCreatedBy, field.TypeInt, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(ticketschedule.FieldCreatedBy, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(ticketschedule.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(ticketschedule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticketschedule.RunsTable,
+			Columns: []string{ticketschedule.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketschedulerun.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRunsIDs(); len(nodes) > 0 && !_u.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticketschedule.RunsTable,
+			Columns: []string{ticketschedule.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketschedulerun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticketschedule.RunsTable,
+			Columns: []string{ticketschedule.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketschedulerun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ticketschedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TicketScheduleUpdateOne is the builder for updating a single TicketSchedule entity.
+type TicketScheduleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TicketScheduleMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *TicketScheduleUpdateOne) SetTenantID(v int) *TicketScheduleUpdateOne {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *TicketScheduleUpdateOne) SetNillableTenantID(v *int) *TicketScheduleUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *TicketScheduleUpdateOne) AddTenantID(v int) *TicketScheduleUpdateOne {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *TicketScheduleUpdateOne) SetName(v string) *TicketScheduleUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TicketScheduleUpdateOne) SetNillableName(v *string) *TicketScheduleUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *TicketScheduleUpdateOne) SetDescription(v string) *TicketScheduleUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *TicketScheduleUpdateOne) SetNillableDescription(v *string) *TicketScheduleUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *TicketScheduleUpdateOne) ClearDescription() *TicketScheduleUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetSourceType sets the "source_type" field.
+func (_u *TicketScheduleUpdateOne) SetSourceType(v ticketschedule.SourceType) *TicketScheduleUpdateOne {
+	_u.mutation.SetSourceType(v)
+	return _u
+}
+
+// SetNillableSourceType sets the "source_type" field if the given value is not nil.
+func (_u *TicketScheduleUpdateOne) SetNillableSourceType(v *ticketschedule.SourceType) *TicketScheduleUpdateOne {
+	if v != nil {
+		_u.SetSourceType(*v)
+	}
+	return _u
+}
+
+// SetTemplateID sets the "template_id" field.
+func (_u *TicketScheduleUpdateOne) SetTemplateID(v int) *TicketScheduleUpdateOne {
+	_u.mutation.ResetTemplateID()
+	_u.mutation.SetTemplateID(v)
+	return _u
+}
+
+// SetNillableTemplateID sets the "template_id" field if the given value is not nil.
+func (_u *TicketScheduleUpdateOne) SetNillableTemplateID(v *int) *TicketScheduleUpdateOne {
+	if v != nil {
+		_u.SetTemplateID(*v)
+	}
+	return _u
+}
+
+// AddTemplateID adds value to the "template_id" field.
+func (_u *TicketScheduleUpdateOne) AddTemplateID(v int) *TicketScheduleUpdateOne {
+	_u.mutation.AddTemplateID(v)
+	return _u
+}
+
+// ClearTemplateID clears the value of the "template_id" field.
+func (_u *TicketScheduleUpdateOne) ClearTemplateID() *TicketScheduleUpdateOne {
+	_u.mutation.ClearTemplateID()
+	return _u
+}
+
+// SetCatalogItemID sets the "catalog_item_id" field.
+func (_u *TicketScheduleUpdateOne) SetCatalogItemID(v int) *TicketScheduleUpdateOne {
+	_u.mutation.ResetCatalogItemID()
+	_u.mutation.SetCatalogItemID(v)
+	return _u
+}
+
+// SetNillableCatalogItemID sets the "catalog_item_id" field if the given value is not nil.
+func (_u *TicketScheduleUpdateOne) SetNillableCatalogItemID(v *int) *TicketScheduleUpdateOne {
+	if v != nil {
+		_u.SetCatalogItemID(*v)
+	}
+	return _u
+}
+
+// AddCatalogItemID adds value to the "catalog_item_id" field.
+func (_u *TicketScheduleUpdateOne) AddCatalogItemID(v int) *TicketScheduleUpdateOne {
+	_u.mutation.AddCatalogItemID(v)
+	return _u
+}
+
+// ClearCatalogItemID clears the value of the "catalog_item_id" field.
+func (_u *TicketScheduleUpdateOne) ClearCatalogItemID() *TicketScheduleUpdateOne {
+	_u.mutation.ClearCatalogItemID()
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *TicketScheduleUpdateOne) SetTitle(v string) *TicketScheduleUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *TicketScheduleUpdateOne) SetNillableTitle(v *string) *TicketScheduleUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// ClearTitle clears the value of the "title" field.
+func (_u *TicketScheduleUpdateOne) ClearTitle() *TicketScheduleUpdateOne {
+	_u.mutation.ClearTitle()
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *TicketScheduleUpdateOne) SetPriority(v string) *TicketScheduleUpdateOne {
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *TicketScheduleUpdateOne) SetNillablePriority(v *string) *TicketScheduleUpdateOne {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// ClearPriority clears the value of the "priority" field.
+func (_u *TicketScheduleUpdateOne) ClearPriority() *TicketScheduleUpdateOne {
+	_u.mutation.ClearPriority()
+	return _u
+}
+
+// SetRequesterID sets the "requester_id" field.
+func (_u *TicketScheduleUpdateOne) SetRequesterID(v int) *TicketScheduleUpdateOne {
+	_u.mutation.ResetRequesterID()
+	_u.mutation.SetRequesterID(v)
+	return _u
+}
+
+// SetNillableRequesterID sets the "requester_id" field if the given value is not nil.
+func (_u *TicketScheduleUpdateOne) SetNillableRequesterID(v *int) *TicketScheduleUpdateOne {
+	if v != nil {
+		_u.SetRequesterID(*v)
+	}
+	return _u
+}
+
+// AddRequesterID adds value to the "requester_id" field.
+func (_u *TicketScheduleUpdateOne) AddRequesterID(v int) *TicketScheduleUpdateOne {
+	_u.mutation.AddRequesterID(v)
+	return _u
+}
+
+// SetAssigneeID sets the "assignee_id" field.
+func (_u *TicketScheduleUpdateOne) SetAssigneeID(v int) *TicketScheduleUpdateOne {
+	_u.mutation.ResetAssigneeID()
+	_u.mutation.SetAssigneeID(v)
+	return _u
+}
+
+// SetNillableAssigneeID sets the "assignee_id" field if the given value is not nil.
+func (_u *TicketScheduleUpdateOne) SetNillableAssigneeID(v *int) *TicketScheduleUpdateOne {
+	if v != nil {
+		_u.SetAssigneeID(*v)
+	}
+	return _u
+}
+
+// AddAssigneeID adds value to the "assignee_id" field.
+func (_u *TicketScheduleUpdateOne) AddAssigneeID(v int) *TicketScheduleUpdateOne {
+	_u.mutation.AddAssigneeID(v)
+	return _u
+}
+
+// ClearAssigneeID clears the value of the "assignee_id" field.
+func (_u *TicketScheduleUpdateOne) ClearAssigneeID() *TicketScheduleUpdateOne {
+	_u.mutation.ClearAssigneeID()
+	return _u
+}
+
+// SetFormFields sets the "form_fields" field.
+func (_u *TicketScheduleUpdateOne) SetFormFields(v map[string]interface{}) *TicketScheduleUpdateOne {
+	_u.mutation.SetFormFields(v)
+	return _u
+}
+
+// ClearFormFields clears the value of the "form_fields" field.
+func (_u *TicketScheduleUpdateOne) ClearFormFields() *TicketScheduleUpdateOne {
+	_u.mutation.ClearFormFields()
+	return _u
+}
+
+// SetRuleType sets the "rule_type" field.
+func (_u *TicketScheduleUpdateOne) SetRuleType(v ticketschedule.RuleType) *TicketScheduleUpdateOne {
+	_u.mutation.SetRuleType(v)
+	return _u
+}
+
+// SetNillableRuleType sets the "rule_type" field if the given value is not nil.
+func (_u *TicketScheduleUpdateOne) SetNillableRuleType(v *ticketschedule.RuleType) *TicketScheduleUpdateOne {
+	if v != nil {
+		_u.SetRuleType(*v)
+	}
+	return _u
+}
+
+// SetExpression sets the "expression" field.
+func (_u *TicketScheduleUpdateOne) SetExpression(v string) *TicketScheduleUpdateOne {
+	_u.mutation.SetExpression(v)
+	return _u
+}
+
+// SetNillableExpression sets the "expression" field if the given value is not nil.
+func (_u *TicketScheduleUpdateOne) SetNillableExpression(v *string) *TicketScheduleUpdateOne {
+	if v != nil {
+		_u.SetExpression(*v)
+	}
+	return _u
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (_u *TicketScheduleUpdateOne) SetTimeZone(v string) *TicketScheduleUpdateOne {
+	_u.mutation.SetTimeZone(v)
+	return _u
+}
+
+// SetNillableTimeZone sets the "time_zone" field if the given value is not nil.
+func (_u *TicketScheduleUpdateOne) SetNillableTimeZone(v *string) *TicketScheduleUpdateOne {
+	if v != nil {
+		_u.SetTimeZone(*v)
+	}
+	return _u
+}
+
+// SetStartAt sets the "start_at" field.
+func (_u *TicketScheduleUpdateOne) SetStartAt(v time.Time) *TicketScheduleUpdateOne {
+	_u.mutation.SetStartAt(v)
+	return _u
+}
+
+// SetNillableStartAt sets the "start_at" field if the given value is not nil.
+func (_u *TicketScheduleUpdateOne) SetNillableStartAt(v *time.Time) *TicketScheduleUpdateOne {
+	if v != nil {
+		_u.SetStartAt(*v)
+	}
+	return _u
+}
+
+// SetEndAt sets the "end_at" field.
+func (_u *TicketScheduleUpdateOne) SetEndAt(v time.Time) *TicketScheduleUpdateOne {
+	_u.mutation.SetEndAt(v)
+	return _u
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (_u *TicketScheduleUpdateOne) SetNillableEndAt(v *time.Time) *TicketScheduleUpdateOne {
+	if v != nil {
+		_u.SetEndAt(*v)
+	}
+	return _u
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (_u *TicketScheduleUpdateOne) ClearEndAt() *TicketScheduleUpdateOne {
+	_u.mutation.ClearEndAt()
+	return _u
+}
+
+// SetCalendarID sets the "calendar_id" field.
+func (_u *TicketScheduleUpdateOne) SetCalendarID(v int) *TicketScheduleUpdateOne {
+	_u.mutation.ResetCalendarID()
+	_u.mutation.SetCalendarID(v)
+	return _u
+}
+
+// SetNillableCalendarID sets the "calendar_id" field if the given value is not nil.
+func (_u *TicketScheduleUpdateOne) SetNillableCalendarID(v *int) *TicketScheduleUpdateOne {
+	if v != nil {
+		_u.SetCalendarID(*v)
+	}
+	return _u
+}
+
+// AddCalendarID adds value to the "calendar_id" field.
+func (_u *TicketScheduleUpdateOne) AddCalendarID(v int) *TicketScheduleUpdateOne {
+	_u.mutation.AddCalendarID(v)
+	return _u
+}
+
+// ClearCalendarID clears the value of the "calendar_id" field.
+func (_u *TicketScheduleUpdateOne) ClearCalendarID() *TicketScheduleUpdateOne {
+	_u.mutation.ClearCalendarID()
+	return _u
+}
+
+// SetHolidayPolicy sets the "holiday_policy" field.
+func (_u *TicketScheduleUpdateOne) SetHolidayPolicy(v ticketschedule.HolidayPolicy) *TicketScheduleUpdateOne {
+	_u.mutation.SetHolidayPolicy(v)
+	return _u
+}
+
+// SetNillableHolidayPolicy sets the "holiday_policy" field if the given value is not nil.
+func (_u *TicketScheduleUpdateOne) SetNillableHolidayPolicy(v *ticketschedule.HolidayPolicy) *TicketScheduleUpdateOne {
+	if v != nil {
+		_u.SetHolidayPolicy(*v)
+	}
+	return _u
+}
+
+// SetCatchUpPolicy sets the "catch_up_policy" field.
+func (_u *TicketScheduleUpdateOne) SetCatchUpPolicy(v ticketschedule.CatchUpPolicy) *TicketScheduleUpdateOne {
+	_u.mutation.SetCatchUpPolicy(v)
+	return _u
+}
+
+// SetNillableCatchUpPolicy sets the "catch_up_policy" field if the given value is not nil.
+func (_u *TicketScheduleUpdateOne) SetNillableCatchUpPolicy(v *ticketschedule.CatchUpPolicy) *TicketScheduleUpdateOne {
+	if v != nil {
+		_u.SetCatchUpPolicy(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *TicketScheduleUpdateOne) SetEnabled(v bool) *TicketScheduleUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *TicketScheduleUpdateOne) SetNillableEnabled(v *bool) *TicketScheduleUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetRevision sets the "revision" field.
+func (_u *TicketScheduleUpdateOne) SetRevision(v int) *TicketScheduleUpdateOne {
+	_u.mutation.ResetRevision()
+	_u.mutation.SetRevision(v)
+	return _u
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_u *TicketScheduleUpdateOne) SetNillableRevision(v *int) *TicketScheduleUpdateOne {
+	if v != nil {
+		_u.SetRevision(*v)
+	}
+	return _u
+}
+
+// AddRevision adds value to the "revision" field.
+func (_u *TicketScheduleUpdateOne) AddRevision(v int) *TicketScheduleUpdateOne {
+	_u.mutation.AddRevision(v)
+	return _u
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (_u *TicketScheduleUpdateOne) SetNextRunAt(v time.Time) *TicketScheduleUpdateOne {
+	_u.mutation.SetNextRunAt(v)
+	return _u
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (_u *TicketScheduleUpdateOne) SetNillableNextRunAt(v *time.Time) *TicketScheduleUpdateOne {
+	if v != nil {
+		_u.SetNextRunAt(*v)
+	}
+	return _u
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (_u *TicketScheduleUpdateOne) ClearNextRunAt() *TicketScheduleUpdateOne {
+	_u.mutation.ClearNextRunAt()
+	return _u
+}
+
+// SetLastOccurrenceAt sets the "last_occurrence_at" field.
+func (_u *TicketScheduleUpdateOne) SetLastOccurrenceAt(v time.Time) *TicketScheduleUpdateOne {
+	_u.mutation.SetLastOccurrenceAt(v)
+	return _u
+}
+
+// SetNillableLastOccurrenceAt sets the "last_occurrence_at" field if the given value is not nil.
+func (_u *TicketScheduleUpdateOne) SetNillableLastOccurrenceAt(v *time.Time) *TicketScheduleUpdateOne {
+	if v != nil {
+		_u.SetLastOccurrenceAt(*v)
+	}
+	return _u
+}
+
+// ClearLastOccurrenceAt clears the value of the "last_occurrence_at" field.
+func (_u *TicketScheduleUpdateOne) ClearLastOccurrenceAt() *TicketScheduleUpdateOne {
+	_u.mutation.ClearLastOccurrenceAt()
+	return _u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_u *TicketScheduleUpdateOne) SetLastRunAt(v time.Time) *TicketScheduleUpdateOne {
+	_u.mutation.SetLastRunAt(v)
+	return _u
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_u *TicketScheduleUpdateOne) SetNillableLastRunAt(v *time.Time) *TicketScheduleUpdateOne {
+	if v != nil {
+		_u.SetLastRunAt(*v)
+	}
+	return _u
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (_u *TicketScheduleUpdateOne) ClearLastRunAt() *TicketScheduleUpdateOne {
+	_u.mutation.ClearLastRunAt()
+	return _u
+}
+
+// SetLastTicketID sets the "last_ticket_id" field.
+func (_u *TicketScheduleUpdateOne) SetLastTicketID(v int) *TicketScheduleUpdateOne {
+	_u.mutation.ResetLastTicketID()
+	_u.mutation.SetLastTicketID(v)
+	return _u
+}
+
+// SetNillableLastTicketID sets the "last_ticket_id" field if the given value is not nil.
+func (_u *TicketScheduleUpdateOne) SetNillableLastTicketID(v *int) *TicketScheduleUpdateOne {
+	if v != nil {
+		_u.SetLastTicketID(*v)
+	}
+	return _u
+}
+
+// AddLastTicketID adds value to the "last_ticket_id" field.
+func (_u *TicketScheduleUpdateOne) AddLastTicketID(v int) *TicketScheduleUpdateOne {
+	_u.mutation.AddLastTicketID(v)
+	return _u
+}
+
+// ClearLastTicketID clears the value of the "last_ticket_id" field.
+func (_u *TicketScheduleUpdateOne) ClearLastTicketID() *TicketScheduleUpdateOne {
+	_u.mutation.ClearLastTicketID()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *TicketScheduleUpdateOne) SetCreatedBy(v int) *TicketScheduleUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *TicketScheduleUpdateOne) SetNillableCreatedBy(v *int) *TicketScheduleUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *TicketScheduleUpdateOne) AddCreatedBy(v int) *TicketScheduleUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *TicketScheduleUpdateOne) ClearCreatedBy() *TicketScheduleUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *TicketScheduleUpdateOne) SetCreatedAt(v time.Time) *TicketScheduleUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *TicketScheduleUpdateOne) SetNillableCreatedAt(v *time.Time) *TicketScheduleUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TicketScheduleUpdateOne) SetUpdatedAt(v time.Time) *TicketScheduleUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddRunIDs adds the "runs" edge to the TicketScheduleRun entity by IDs.
+func (_u *TicketScheduleUpdateOne) AddRunIDs(ids ...int) *TicketScheduleUpdateOne {
+	_u.mutation.AddRunIDs(ids...)
+	return _u
+}
+
+// AddRuns adds the "runs" edges to the TicketScheduleRun entity.
+func (_u *TicketScheduleUpdateOne) AddRuns(v ...*TicketScheduleRun) *TicketScheduleUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRunIDs(ids...)
+}
+
+// Mutation returns the TicketScheduleMutation object of the builder.
+func (_u *TicketScheduleUpdateOne) Mutation() *TicketScheduleMutation {
+	return _u.mutation
+}
+
+// ClearRuns clears all "runs" edges to the TicketScheduleRun entity.
+func (_u *TicketScheduleUpdateOne) ClearRuns() *TicketScheduleUpdateOne {
+	_u.mutation.ClearRuns()
+	return _u
+}
+
+// RemoveRunIDs removes the "runs" edge to TicketScheduleRun entities by IDs.
+func (_u *TicketScheduleUpdateOne) RemoveRunIDs(ids ...int) *TicketScheduleUpdateOne {
+	_u.mutation.RemoveRunIDs(ids...)
+	return _u
+}
+
+// RemoveRuns removes "runs" edges to TicketScheduleRun entities.
+func (_u *TicketScheduleUpdateOne) RemoveRuns(v ...*TicketScheduleRun) *TicketScheduleUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRunIDs(ids...)
+}
+
+// Where appends a list predicates to the TicketScheduleUpdate builder.
+func (_u *TicketScheduleUpdateOne) Where(ps ...predicate.TicketSchedule) *TicketScheduleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TicketScheduleUpdateOne) Select(field string, fields ...string) *TicketScheduleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TicketSchedule entity.
+func (_u *TicketScheduleUpdateOne) Save(ctx context.Context) (*TicketSchedule, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TicketScheduleUpdateOne) SaveX(ctx context.Context) *TicketSchedule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TicketScheduleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TicketScheduleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TicketScheduleUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := ticketschedule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TicketScheduleUpdateOne) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := ticketschedule.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "TicketSchedule.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := ticketschedule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TicketSchedule.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SourceType(); ok {
+		if err := ticketschedule.SourceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "source_type", err: fmt.Errorf(`ent: validator failed for field "TicketSchedule.source_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RequesterID(); ok {
+		if err := ticketschedule.RequesterIDValidator(v); err != nil {
+			return &ValidationError{Name: "requester_id", err: fmt.Errorf(`ent: validator failed for field "TicketSchedule.requester_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RuleType(); ok {
+		if err := ticketschedule.RuleTypeValidator(v); err != nil {
+			return &ValidationError{Name: "rule_type", err: fmt.Errorf(`ent: validator failed for field "TicketSchedule.rule_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Expression(); ok {
+		if err := ticketschedule.ExpressionValidator(v); err != nil {
+			return &ValidationError{Name: "expression", err: fmt.Errorf(`ent: validator failed for field "TicketSchedule.expression": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HolidayPolicy(); ok {
+		if err := ticketschedule.HolidayPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "holiday_policy", err: fmt.Errorf(`ent: validator failed for field "TicketSchedule.holiday_policy": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CatchUpPolicy(); ok {
+		if err := ticketschedule.CatchUpPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "catch_up_policy", err: fmt.Errorf(`ent: validator failed for field "TicketSchedule.catch_up_policy": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *TicketScheduleUpdateOne) sqlSave(ctx context.Context) (_node *TicketSchedule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ticketschedule.Table, ticketschedule.Columns, sqlgraph.NewFieldSpec(ticketschedule.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TicketSchedule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ticketschedule.FieldID)
+		for _, f := range fields {
+			if !ticketschedule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ticketschedule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(ticketschedule.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(ticketschedule.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(ticketschedule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(ticketschedule.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(ticketschedule.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.SourceType(); ok {
+		_spec.SetField(ticketschedule.FieldSourceType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TemplateID(); ok {
+		_spec.SetField(ticketschedule.FieldTemplateID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTemplateID(); ok {
+		_spec.AddField(ticketschedule.FieldTemplateID, field.TypeInt, value)
+	}
+	if _u.mutation.TemplateIDCleared() {
+		_spec.ClearField(ticketschedule.FieldTemplateID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CatalogItemID(); ok {
+		_spec.SetField(ticketschedule.FieldCatalogItemID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCatalogItemID(); ok {
+		_spec.AddField(ticketschedule.FieldCatalogItemID, field.TypeInt, value)
+	}
+	if _u.mutation.CatalogItemIDCleared() {
+		_spec.ClearField(ticketschedule.FieldCatalogItemID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(ticketschedule.FieldTitle, field.TypeString, value)
+	}
+	if _u.mutation.TitleCleared() {
+		_spec.ClearField(ticketschedule.FieldTitle, field.TypeString)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(ticketschedule.FieldPriority, field.TypeString, value)
+	}
+	if _u.mutation.PriorityCleared() {
+		_spec.ClearField(ticketschedule.FieldPriority, field.TypeString)
+	}
+	if value, ok := _u.mutation.RequesterID(); ok {
+		_spec.SetField(ticketschedule.FieldRequesterID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRequesterID(); ok {
+		_spec.AddField(ticketschedule.FieldRequesterID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AssigneeID(); ok {
+		_spec.SetField(ticketschedule.FieldAssigneeID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAssigneeID(); ok {
+		_spec.AddField(ticketschedule.FieldAssigneeID, field.TypeInt, value)
+	}
+	if _u.mutation.AssigneeIDCleared() {
+		_spec.ClearField(ticketschedule.FieldAssigneeID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.FormFields(); ok {
+		_spec.SetField(ticketschedule.FieldFormFields, field.TypeJSON, value)
+	}
+	if _u.mutation.FormFieldsCleared() {
+		_spec.ClearField(ticketschedule.FieldFormFields, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RuleType(); ok {
+		_spec.SetField(ticketschedule.FieldRuleType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Expression(); ok {
+		_spec.SetField(ticketschedule.FieldExpression, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TimeZone(); ok {
+		_spec.SetField(ticketschedule.FieldTimeZone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartAt(); ok {
+		_spec.SetField(ticketschedule.FieldStartAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndAt(); ok {
+		_spec.SetField(ticketschedule.FieldEndAt, field.TypeTime, value)
+	}
+	if _u.mutation.EndAtCleared() {
+		_spec.ClearField(ticketschedule.FieldEndAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CalendarID(); ok {
+		_spec.SetField(ticketschedule.FieldCalendarID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCalendarID(); ok {
+		_spec.AddField(ticketschedule.FieldCalendarID, field.TypeInt, value)
+	}
+	if _u.mutation.CalendarIDCleared() {
+		_spec.ClearField(ticketschedule.FieldCalendarID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.HolidayPolicy(); ok {
+		_spec.SetField(ticketschedule.FieldHolidayPolicy, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.CatchUpPolicy(); ok {
+		_spec.SetField(ticketschedule.FieldCatchUpPolicy, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(ticketschedule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Revision(); ok {
+		_spec.SetField(ticketschedule.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRevision(); ok {
+		_spec.AddField(ticketschedule.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextRunAt(); ok {
+		_spec.SetField(ticketschedule.FieldNextRunAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextRunAtCleared() {
+		_spec.ClearField(ticketschedule.FieldNextRunAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastOccurrenceAt(); ok {
+		_spec.SetField(ticketschedule.FieldLastOccurrenceAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastOccurrenceAtCleared() {
+		_spec.ClearField(ticketschedule.FieldLastOccurrenceAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastRunAt(); ok {
+		_spec.SetField(ticketschedule.FieldLastRunAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastRunAtCleared() {
+		_spec.ClearField(ticketschedule.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastTicketID(); ok {
+		_spec.SetField(ticketschedule.FieldLastTicketID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastTicketID(); ok {
+		_spec.AddField(ticketschedule.FieldLastTicketID, field.TypeInt, value)
+	}
+	if _u.mutation.LastTicketIDCleared() {
+		_spec.ClearField(ticketschedule.FieldLastTicketID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(ticketschedule.FieldCreatedBy, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(ticketschedule.FieldCreatedBy, field.TypeInt, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(ticketschedule.FieldCreatedBy, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(ticketschedule.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(ticketschedule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticketschedule.RunsTable,
+			Columns: []string{ticketschedule.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketschedulerun.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRunsIDs(); len(nodes) > 0 && !_u.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticketschedule.RunsTable,
+			Columns: []string{ticketschedule.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketschedulerun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   ticketschedule.RunsTable,
+			Columns: []string{ticketschedule.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketschedulerun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TicketSchedule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ticketschedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
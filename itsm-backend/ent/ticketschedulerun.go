@@ -0,0 +1,226 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"itsm-backend/ent/ticketschedule"
+	"itsm-backend/ent/ticketschedulerun"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// TicketScheduleRun is the model entity for the TicketScheduleRun schema.
+type TicketScheduleRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 租户ID
+	TenantID int `json:"tenant_id,omitempty"`
+	// 计划ID
+	ScheduleID int `json:"schedule_id,omitempty"`
+	// 计划发生时刻
+	OccurrenceAt time.Time `json:"occurrence_at,omitempty"`
+	// 实际触发时刻（节假日顺延后）
+	FireAt time.Time `json:"fire_at,omitempty"`
+	// 状态: pending 待生成/generating 建单中/generated 已生成/skipped 已跳过/failed 生成失败
+	Status ticketschedulerun.Status `json:"status,omitempty"`
+	// 生成的工单ID
+	TicketID *int `json:"ticket_id,omitempty"`
+	// 跳过原因或失败信息
+	Message string `json:"message,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TicketScheduleRunQuery when eager-loading is set.
+	Edges        TicketScheduleRunEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TicketScheduleRunEdges holds the relations/edges for other nodes in the graph.
+type TicketScheduleRunEdges struct {
+	// Schedule holds the value of the schedule edge.
+	Schedule *TicketSchedule `json:"schedule,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ScheduleOrErr returns the Schedule value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TicketScheduleRunEdges) ScheduleOrErr() (*TicketSchedule, error) {
+	if e.Schedule != nil {
+		return e.Schedule, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: ticketschedule.Label}
+	}
+	return nil, &NotLoadedError{edge: "schedule"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TicketScheduleRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ticketschedulerun.FieldID, ticketschedulerun.FieldTenantID, ticketschedulerun.FieldScheduleID, ticketschedulerun.FieldTicketID:
+			values[i] = new(sql.NullInt64)
+		case ticketschedulerun.FieldStatus, ticketschedulerun.FieldMessage:
+			values[i] = new(sql.NullString)
+		case ticketschedulerun.FieldOccurrenceAt, ticketschedulerun.FieldFireAt, ticketschedulerun.FieldCreatedAt, ticketschedulerun.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TicketScheduleRun fields.
+func (_m *TicketScheduleRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ticketschedulerun.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case ticketschedulerun.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = int(value.Int64)
+			}
+		case ticketschedulerun.FieldScheduleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field schedule_id", values[i])
+			} else if value.Valid {
+				_m.ScheduleID = int(value.Int64)
+			}
+		case ticketschedulerun.FieldOccurrenceAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field occurrence_at", values[i])
+			} else if value.Valid {
+				_m.OccurrenceAt = value.Time
+			}
+		case ticketschedulerun.FieldFireAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field fire_at", values[i])
+			} else if value.Valid {
+				_m.FireAt = value.Time
+			}
+		case ticketschedulerun.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = ticketschedulerun.Status(value.String)
+			}
+		case ticketschedulerun.FieldTicketID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field ticket_id", values[i])
+			} else if value.Valid {
+				_m.TicketID = new(int)
+				*_m.TicketID = int(value.Int64)
+			}
+		case ticketschedulerun.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				_m.Message = value.String
+			}
+		case ticketschedulerun.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case ticketschedulerun.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TicketScheduleRun.
+// This includes values selected through modifiers, order, etc.
+func (_m *TicketScheduleRun) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QuerySchedule queries the "schedule" edge of the TicketScheduleRun entity.
+func (_m *TicketScheduleRun) QuerySchedule() *TicketScheduleQuery {
+	return NewTicketScheduleRunClient(_m.config).QuerySchedule(_m)
+}
+
+// Update returns a builder for updating this TicketScheduleRun.
+// Note that you need to call TicketScheduleRun.Unwrap() before calling this method if this TicketScheduleRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TicketScheduleRun) Update() *TicketScheduleRunUpdateOne {
+	return NewTicketScheduleRunClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TicketScheduleRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TicketScheduleRun) Unwrap() *TicketScheduleRun {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TicketScheduleRun is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TicketScheduleRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("TicketScheduleRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("schedule_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ScheduleID))
+	builder.WriteString(", ")
+	builder.WriteString("occurrence_at=")
+	builder.WriteString(_m.OccurrenceAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("fire_at=")
+	builder.WriteString(_m.FireAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.TicketID; v != nil {
+		builder.WriteString("ticket_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("message=")
+	builder.WriteString(_m.Message)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TicketScheduleRuns is a parsable slice of TicketScheduleRun.
+type TicketScheduleRuns []*TicketScheduleRun
@@ -0,0 +1,499 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ticketschedulerun
+
+import (
+	"itsm-backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldEQ(FieldTenantID, v))
+}
+
+// ScheduleID applies equality check predicate on the "schedule_id" field. It's identical to ScheduleIDEQ.
+func ScheduleID(v int) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldEQ(FieldScheduleID, v))
+}
+
+// OccurrenceAt applies equality check predicate on the "occurrence_at" field. It's identical to OccurrenceAtEQ.
+func OccurrenceAt(v time.Time) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldEQ(FieldOccurrenceAt, v))
+}
+
+// FireAt applies equality check predicate on the "fire_at" field. It's identical to FireAtEQ.
+func FireAt(v time.Time) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldEQ(FieldFireAt, v))
+}
+
+// TicketID applies equality check predicate on the "ticket_id" field. It's identical to TicketIDEQ.
+func TicketID(v int) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldEQ(FieldTicketID, v))
+}
+
+// Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
+func Message(v string) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldEQ(FieldMessage, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldLTE(FieldTenantID, v))
+}
+
+// ScheduleIDEQ applies the EQ predicate on the "schedule_id" field.
+func ScheduleIDEQ(v int) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldEQ(FieldScheduleID, v))
+}
+
+// ScheduleIDNEQ applies the NEQ predicate on the "schedule_id" field.
+func ScheduleIDNEQ(v int) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldNEQ(FieldScheduleID, v))
+}
+
+// ScheduleIDIn applies the In predicate on the "schedule_id" field.
+func ScheduleIDIn(vs ...int) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldIn(FieldScheduleID, vs...))
+}
+
+// ScheduleIDNotIn applies the NotIn predicate on the "schedule_id" field.
+func ScheduleIDNotIn(vs ...int) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldNotIn(FieldScheduleID, vs...))
+}
+
+// OccurrenceAtEQ applies the EQ predicate on the "occurrence_at" field.
+func OccurrenceAtEQ(v time.Time) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldEQ(FieldOccurrenceAt, v))
+}
+
+// OccurrenceAtNEQ applies the NEQ predicate on the "occurrence_at" field.
+func OccurrenceAtNEQ(v time.Time) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldNEQ(FieldOccurrenceAt, v))
+}
+
+// OccurrenceAtIn applies the In predicate on the "occurrence_at" field.
+func OccurrenceAtIn(vs ...time.Time) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldIn(FieldOccurrenceAt, vs...))
+}
+
+// OccurrenceAtNotIn applies the NotIn predicate on the "occurrence_at" field.
+func OccurrenceAtNotIn(vs ...time.Time) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldNotIn(FieldOccurrenceAt, vs...))
+}
+
+// OccurrenceAtGT applies the GT predicate on the "occurrence_at" field.
+func OccurrenceAtGT(v time.Time) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldGT(FieldOccurrenceAt, v))
+}
+
+// OccurrenceAtGTE applies the GTE predicate on the "occurrence_at" field.
+func OccurrenceAtGTE(v time.Time) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldGTE(FieldOccurrenceAt, v))
+}
+
+// OccurrenceAtLT applies the LT predicate on the "occurrence_at" field.
+func OccurrenceAtLT(v time.Time) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldLT(FieldOccurrenceAt, v))
+}
+
+// OccurrenceAtLTE applies the LTE predicate on the "occurrence_at" field.
+func OccurrenceAtLTE(v time.Time) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldLTE(FieldOccurrenceAt, v))
+}
+
+// FireAtEQ applies the EQ predicate on the "fire_at" field.
+func FireAtEQ(v time.Time) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldEQ(FieldFireAt, v))
+}
+
+// FireAtNEQ applies the NEQ predicate on the "fire_at" field.
+func FireAtNEQ(v time.Time) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldNEQ(FieldFireAt, v))
+}
+
+// FireAtIn applies the In predicate on the "fire_at" field.
+func FireAtIn(vs ...time.Time) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldIn(FieldFireAt, vs...))
+}
+
+// FireAtNotIn applies the NotIn predicate on the "fire_at" field.
+func FireAtNotIn(vs ...time.Time) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldNotIn(FieldFireAt, vs...))
+}
+
+// FireAtGT applies the GT predicate on the "fire_at" field.
+func FireAtGT(v time.Time) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldGT(FieldFireAt, v))
+}
+
+// FireAtGTE applies the GTE predicate on the "fire_at" field.
+func FireAtGTE(v time.Time) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldGTE(FieldFireAt, v))
+}
+
+// FireAtLT applies the LT predicate on the "fire_at" field.
+func FireAtLT(v time.Time) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldLT(FieldFireAt, v))
+}
+
+// FireAtLTE applies the LTE predicate on the "fire_at" field.
+func FireAtLTE(v time.Time) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldLTE(FieldFireAt, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// TicketIDEQ applies the EQ predicate on the "ticket_id" field.
+func TicketIDEQ(v int) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldEQ(FieldTicketID, v))
+}
+
+// TicketIDNEQ applies the NEQ predicate on the "ticket_id" field.
+func TicketIDNEQ(v int) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldNEQ(FieldTicketID, v))
+}
+
+// TicketIDIn applies the In predicate on the "ticket_id" field.
+func TicketIDIn(vs ...int) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldIn(FieldTicketID, vs...))
+}
+
+// TicketIDNotIn applies the NotIn predicate on the "ticket_id" field.
+func TicketIDNotIn(vs ...int) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldNotIn(FieldTicketID, vs...))
+}
+
+// TicketIDGT applies the GT predicate on the "ticket_id" field.
+func TicketIDGT(v int) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldGT(FieldTicketID, v))
+}
+
+// TicketIDGTE applies the GTE predicate on the "ticket_id" field.
+func TicketIDGTE(v int) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldGTE(FieldTicketID, v))
+}
+
+// TicketIDLT applies the LT predicate on the "ticket_id" field.
+func TicketIDLT(v int) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldLT(FieldTicketID, v))
+}
+
+// TicketIDLTE applies the LTE predicate on the "ticket_id" field.
+func TicketIDLTE(v int) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldLTE(FieldTicketID, v))
+}
+
+// TicketIDIsNil applies the IsNil predicate on the "ticket_id" field.
+func TicketIDIsNil() predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldIsNull(FieldTicketID))
+}
+
+// TicketIDNotNil applies the NotNil predicate on the "ticket_id" field.
+func TicketIDNotNil() predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldNotNull(FieldTicketID))
+}
+
+// MessageEQ applies the EQ predicate on the "message" field.
+func MessageEQ(v string) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldEQ(FieldMessage, v))
+}
+
+// MessageNEQ applies the NEQ predicate on the "message" field.
+func MessageNEQ(v string) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldNEQ(FieldMessage, v))
+}
+
+// MessageIn applies the In predicate on the "message" field.
+func MessageIn(vs ...string) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldIn(FieldMessage, vs...))
+}
+
+// MessageNotIn applies the NotIn predicate on the "message" field.
+func MessageNotIn(vs ...string) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldNotIn(FieldMessage, vs...))
+}
+
+// MessageGT applies the GT predicate on the "message" field.
+func MessageGT(v string) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldGT(FieldMessage, v))
+}
+
+// MessageGTE applies the GTE predicate on the "message" field.
+func MessageGTE(v string) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldGTE(FieldMessage, v))
+}
+
+// MessageLT applies the LT predicate on the "message" field.
+func MessageLT(v string) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldLT(FieldMessage, v))
+}
+
+// MessageLTE applies the LTE predicate on the "message" field.
+func MessageLTE(v string) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldLTE(FieldMessage, v))
+}
+
+// MessageContains applies the Contains predicate on the "message" field.
+func MessageContains(v string) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldContains(FieldMessage, v))
+}
+
+// MessageHasPrefix applies the HasPrefix predicate on the "message" field.
+func MessageHasPrefix(v string) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldHasPrefix(FieldMessage, v))
+}
+
+// MessageHasSuffix applies the HasSuffix predicate on the "message" field.
+func MessageHasSuffix(v string) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldHasSuffix(FieldMessage, v))
+}
+
+// MessageIsNil applies the IsNil predicate on the "message" field.
+func MessageIsNil() predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldIsNull(FieldMessage))
+}
+
+// MessageNotNil applies the NotNil predicate on the "message" field.
+func MessageNotNil() predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldNotNull(FieldMessage))
+}
+
+// MessageEqualFold applies the EqualFold predicate on the "message" field.
+func MessageEqualFold(v string) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldEqualFold(FieldMessage, v))
+}
+
+// MessageContainsFold applies the ContainsFold predicate on the "message" field.
+func MessageContainsFold(v string) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldContainsFold(FieldMessage, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasSchedule applies the HasEdge predicate on the "schedule" edge.
+func HasSchedule() predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ScheduleTable, ScheduleColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasScheduleWith applies the HasEdge predicate on the "schedule" edge with a given conditions (other predicates).
+func HasScheduleWith(preds ...predicate.TicketSchedule) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(func(s *sql.Selector) {
+		step := newScheduleStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TicketScheduleRun) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TicketScheduleRun) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TicketScheduleRun) predicate.TicketScheduleRun {
+	return predicate.TicketScheduleRun(sql.NotPredicates(p))
+}
@@ -0,0 +1,377 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/ticketschedule"
+	"itsm-backend/ent/ticketschedulerun"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TicketScheduleRunCreate is the builder for creating a TicketScheduleRun entity.
+type TicketScheduleRunCreate struct {
+	config
+	mutation *TicketScheduleRunMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *TicketScheduleRunCreate) SetTenantID(v int) *TicketScheduleRunCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (_c *TicketScheduleRunCreate) SetScheduleID(v int) *TicketScheduleRunCreate {
+	_c.mutation.SetScheduleID(v)
+	return _c
+}
+
+// SetOccurrenceAt sets the "occurrence_at" field.
+func (_c *TicketScheduleRunCreate) SetOccurrenceAt(v time.Time) *TicketScheduleRunCreate {
+	_c.mutation.SetOccurrenceAt(v)
+	return _c
+}
+
+// SetFireAt sets the "fire_at" field.
+func (_c *TicketScheduleRunCreate) SetFireAt(v time.Time) *TicketScheduleRunCreate {
+	_c.mutation.SetFireAt(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *TicketScheduleRunCreate) SetStatus(v ticketschedulerun.Status) *TicketScheduleRunCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *TicketScheduleRunCreate) SetNillableStatus(v *ticketschedulerun.Status) *TicketScheduleRunCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetTicketID sets the "ticket_id" field.
+func (_c *TicketScheduleRunCreate) SetTicketID(v int) *TicketScheduleRunCreate {
+	_c.mutation.SetTicketID(v)
+	return _c
+}
+
+// SetNillableTicketID sets the "ticket_id" field if the given value is not nil.
+func (_c *TicketScheduleRunCreate) SetNillableTicketID(v *int) *TicketScheduleRunCreate {
+	if v != nil {
+		_c.SetTicketID(*v)
+	}
+	return _c
+}
+
+// SetMessage sets the "message" field.
+func (_c *TicketScheduleRunCreate) SetMessage(v string) *TicketScheduleRunCreate {
+	_c.mutation.SetMessage(v)
+	return _c
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_c *TicketScheduleRunCreate) SetNillableMessage(v *string) *TicketScheduleRunCreate {
+	if v != nil {
+		_c.SetMessage(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TicketScheduleRunCreate) SetCreatedAt(v time.Time) *TicketScheduleRunCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TicketScheduleRunCreate) SetNillableCreatedAt(v *time.Time) *TicketScheduleRunCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *TicketScheduleRunCreate) SetUpdatedAt(v time.Time) *TicketScheduleRunCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *TicketScheduleRunCreate) SetNillableUpdatedAt(v *time.Time) *TicketScheduleRunCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetSchedule sets the "schedule" edge to the TicketSchedule entity.
+func (_c *TicketScheduleRunCreate) SetSchedule(v *TicketSchedule) *TicketScheduleRunCreate {
+	return _c.SetScheduleID(v.ID)
+}
+
+// Mutation returns the TicketScheduleRunMutation object of the builder.
+func (_c *TicketScheduleRunCreate) Mutation() *TicketScheduleRunMutation {
+	return _c.mutation
+}
+
+// Save creates the TicketScheduleRun in the database.
+func (_c *TicketScheduleRunCreate) Save(ctx context.Context) (*TicketScheduleRun, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TicketScheduleRunCreate) SaveX(ctx context.Context) *TicketScheduleRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TicketScheduleRunCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TicketScheduleRunCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TicketScheduleRunCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := ticketschedulerun.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := ticketschedulerun.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := ticketschedulerun.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TicketScheduleRunCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "TicketScheduleRun.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := ticketschedulerun.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "TicketScheduleRun.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ScheduleID(); !ok {
+		return &ValidationError{Name: "schedule_id", err: errors.New(`ent: missing required field "TicketScheduleRun.schedule_id"`)}
+	}
+	if v, ok := _c.mutation.ScheduleID(); ok {
+		if err := ticketschedulerun.ScheduleIDValidator(v); err != nil {
+			return &ValidationError{Name: "schedule_id", err: fmt.Errorf(`ent: validator failed for field "TicketScheduleRun.schedule_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.OccurrenceAt(); !ok {
+		return &ValidationError{Name: "occurrence_at", err: errors.New(`ent: missing required field "TicketScheduleRun.occurrence_at"`)}
+	}
+	if _, ok := _c.mutation.FireAt(); !ok {
+		return &ValidationError{Name: "fire_at", err: errors.New(`ent: missing required field "TicketScheduleRun.fire_at"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "TicketScheduleRun.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := ticketschedulerun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TicketScheduleRun.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TicketScheduleRun.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TicketScheduleRun.updated_at"`)}
+	}
+	if len(_c.mutation.ScheduleIDs()) == 0 {
+		return &ValidationError{Name: "schedule", err: errors.New(`ent: missing required edge "TicketScheduleRun.schedule"`)}
+	}
+	return nil
+}
+
+func (_c *TicketScheduleRunCreate) sqlSave(ctx context.Context) (*TicketScheduleRun, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TicketScheduleRunCreate) createSpec() (*TicketScheduleRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TicketScheduleRun{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(ticketschedulerun.Table, sqlgraph.NewFieldSpec(ticketschedulerun.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(ticketschedulerun.FieldTenantID, field.TypeInt, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.OccurrenceAt(); ok {
+		_spec.SetField(ticketschedulerun.FieldOccurrenceAt, field.TypeTime, value)
+		_node.OccurrenceAt = value
+	}
+	if value, ok := _c.mutation.FireAt(); ok {
+		_spec.SetField(ticketschedulerun.FieldFireAt, field.TypeTime, value)
+		_node.FireAt = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(ticketschedulerun.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.TicketID(); ok {
+		_spec.SetField(ticketschedulerun.FieldTicketID, field.TypeInt, value)
+		_node.TicketID = &value
+	}
+	if value, ok := _c.mutation.Message(); ok {
+		_spec.SetField(ticketschedulerun.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(ticketschedulerun.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(ticketschedulerun.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.ScheduleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ticketschedulerun.ScheduleTable,
+			Columns: []string{ticketschedulerun.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketschedule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ScheduleID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TicketScheduleRunCreateBulk is the builder for creating many TicketScheduleRun entities in bulk.
+type TicketScheduleRunCreateBulk struct {
+	config
+	err      error
+	builders []*TicketScheduleRunCreate
+}
+
+// Save creates the TicketScheduleRun entities in the database.
+func (_c *TicketScheduleRunCreateBulk) Save(ctx context.Context) ([]*TicketScheduleRun, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TicketScheduleRun, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TicketScheduleRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TicketScheduleRunCreateBulk) SaveX(ctx context.Context) []*TicketScheduleRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TicketScheduleRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TicketScheduleRunCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
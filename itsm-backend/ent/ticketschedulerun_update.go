@@ -0,0 +1,687 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/predicate"
+	"itsm-backend/ent/ticketschedule"
+	"itsm-backend/ent/ticketschedulerun"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TicketScheduleRunUpdate is the builder for updating TicketScheduleRun entities.
+type TicketScheduleRunUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TicketScheduleRunMutation
+}
+
+// Where appends a list predicates to the TicketScheduleRunUpdate builder.
+func (_u *TicketScheduleRunUpdate) Where(ps ...predicate.TicketScheduleRun) *TicketScheduleRunUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *TicketScheduleRunUpdate) SetTenantID(v int) *TicketScheduleRunUpdate {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *TicketScheduleRunUpdate) SetNillableTenantID(v *int) *TicketScheduleRunUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *TicketScheduleRunUpdate) AddTenantID(v int) *TicketScheduleRunUpdate {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (_u *TicketScheduleRunUpdate) SetScheduleID(v int) *TicketScheduleRunUpdate {
+	_u.mutation.SetScheduleID(v)
+	return _u
+}
+
+// SetNillableScheduleID sets the "schedule_id" field if the given value is not nil.
+func (_u *TicketScheduleRunUpdate) SetNillableScheduleID(v *int) *TicketScheduleRunUpdate {
+	if v != nil {
+		_u.SetScheduleID(*v)
+	}
+	return _u
+}
+
+// SetOccurrenceAt sets the "occurrence_at" field.
+func (_u *TicketScheduleRunUpdate) SetOccurrenceAt(v time.Time) *TicketScheduleRunUpdate {
+	_u.mutation.SetOccurrenceAt(v)
+	return _u
+}
+
+// SetNillableOccurrenceAt sets the "occurrence_at" field if the given value is not nil.
+func (_u *TicketScheduleRunUpdate) SetNillableOccurrenceAt(v *time.Time) *TicketScheduleRunUpdate {
+	if v != nil {
+		_u.SetOccurrenceAt(*v)
+	}
+	return _u
+}
+
+// SetFireAt sets the "fire_at" field.
+func (_u *TicketScheduleRunUpdate) SetFireAt(v time.Time) *TicketScheduleRunUpdate {
+	_u.mutation.SetFireAt(v)
+	return _u
+}
+
+// SetNillableFireAt sets the "fire_at" field if the given value is not nil.
+func (_u *TicketScheduleRunUpdate) SetNillableFireAt(v *time.Time) *TicketScheduleRunUpdate {
+	if v != nil {
+		_u.SetFireAt(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *TicketScheduleRunUpdate) SetStatus(v ticketschedulerun.Status) *TicketScheduleRunUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *TicketScheduleRunUpdate) SetNillableStatus(v *ticketschedulerun.Status) *TicketScheduleRunUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetTicketID sets the "ticket_id" field.
+func (_u *TicketScheduleRunUpdate) SetTicketID(v int) *TicketScheduleRunUpdate {
+	_u.mutation.ResetTicketID()
+	_u.mutation.SetTicketID(v)
+	return _u
+}
+
+// SetNillableTicketID sets the "ticket_id" field if the given value is not nil.
+func (_u *TicketScheduleRunUpdate) SetNillableTicketID(v *int) *TicketScheduleRunUpdate {
+	if v != nil {
+		_u.SetTicketID(*v)
+	}
+	return _u
+}
+
+// AddTicketID adds value to the "ticket_id" field.
+func (_u *TicketScheduleRunUpdate) AddTicketID(v int) *TicketScheduleRunUpdate {
+	_u.mutation.AddTicketID(v)
+	return _u
+}
+
+// ClearTicketID clears the value of the "ticket_id" field.
+func (_u *TicketScheduleRunUpdate) ClearTicketID() *TicketScheduleRunUpdate {
+	_u.mutation.ClearTicketID()
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *TicketScheduleRunUpdate) SetMessage(v string) *TicketScheduleRunUpdate {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *TicketScheduleRunUpdate) SetNillableMessage(v *string) *TicketScheduleRunUpdate {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *TicketScheduleRunUpdate) ClearMessage() *TicketScheduleRunUpdate {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *TicketScheduleRunUpdate) SetCreatedAt(v time.Time) *TicketScheduleRunUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *TicketScheduleRunUpdate) SetNillableCreatedAt(v *time.Time) *TicketScheduleRunUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TicketScheduleRunUpdate) SetUpdatedAt(v time.Time) *TicketScheduleRunUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetSchedule sets the "schedule" edge to the TicketSchedule entity.
+func (_u *TicketScheduleRunUpdate) SetSchedule(v *TicketSchedule) *TicketScheduleRunUpdate {
+	return _u.SetScheduleID(v.ID)
+}
+
+// Mutation returns the TicketScheduleRunMutation object of the builder.
+func (_u *TicketScheduleRunUpdate) Mutation() *TicketScheduleRunMutation {
+	return _u.mutation
+}
+
+// ClearSchedule clears the "schedule" edge to the TicketSchedule entity.
+func (_u *TicketScheduleRunUpdate) ClearSchedule() *TicketScheduleRunUpdate {
+	_u.mutation.ClearSchedule()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TicketScheduleRunUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TicketScheduleRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TicketScheduleRunUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TicketScheduleRunUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TicketScheduleRunUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := ticketschedulerun.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TicketScheduleRunUpdate) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := ticketschedulerun.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "TicketScheduleRun.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ScheduleID(); ok {
+		if err := ticketschedulerun.ScheduleIDValidator(v); err != nil {
+			return &ValidationError{Name: "schedule_id", err: fmt.Errorf(`ent: validator failed for field "TicketScheduleRun.schedule_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := ticketschedulerun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TicketScheduleRun.status": %w`, err)}
+		}
+	}
+	if _u.mutation.ScheduleCleared() && len(_u.mutation.ScheduleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TicketScheduleRun.schedule"`)
+	}
+	return nil
+}
+
+func (_u *TicketScheduleRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ticketschedulerun.Table, ticketschedulerun.Columns, sqlgraph.NewFieldSpec(ticketschedulerun.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(ticketschedulerun.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(ticketschedulerun.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OccurrenceAt(); ok {
+		_spec.SetField(ticketschedulerun.FieldOccurrenceAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FireAt(); ok {
+		_spec.SetField(ticketschedulerun.FieldFireAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(ticketschedulerun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TicketID(); ok {
+		_spec.SetField(ticketschedulerun.FieldTicketID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTicketID(); ok {
+		_spec.AddField(ticketschedulerun.FieldTicketID, field.TypeInt, value)
+	}
+	if _u.mutation.TicketIDCleared() {
+		_spec.ClearField(ticketschedulerun.FieldTicketID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(ticketschedulerun.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(ticketschedulerun.FieldMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(ticketschedulerun.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(ticketschedulerun.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ScheduleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ticketschedulerun.ScheduleTable,
+			Columns: []string{ticketschedulerun.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketschedule.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScheduleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ticketschedulerun.ScheduleTable,
+			Columns: []string{ticketschedulerun.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketschedule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ticketschedulerun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TicketScheduleRunUpdateOne is the builder for updating a single TicketScheduleRun entity.
+type TicketScheduleRunUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TicketScheduleRunMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *TicketScheduleRunUpdateOne) SetTenantID(v int) *TicketScheduleRunUpdateOne {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *TicketScheduleRunUpdateOne) SetNillableTenantID(v *int) *TicketScheduleRunUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *TicketScheduleRunUpdateOne) AddTenantID(v int) *TicketScheduleRunUpdateOne {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (_u *TicketScheduleRunUpdateOne) SetScheduleID(v int) *TicketScheduleRunUpdateOne {
+	_u.mutation.SetScheduleID(v)
+	return _u
+}
+
+// SetNillableScheduleID sets the "schedule_id" field if the given value is not nil.
+func (_u *TicketScheduleRunUpdateOne) SetNillableScheduleID(v *int) *TicketScheduleRunUpdateOne {
+	if v != nil {
+		_u.SetScheduleID(*v)
+	}
+	return _u
+}
+
+// SetOccurrenceAt sets the "occurrence_at" field.
+func (_u *TicketScheduleRunUpdateOne) SetOccurrenceAt(v time.Time) *TicketScheduleRunUpdateOne {
+	_u.mutation.SetOccurrenceAt(v)
+	return _u
+}
+
+// SetNillableOccurrenceAt sets the "occurrence_at" field if the given value is not nil.
+func (_u *TicketScheduleRunUpdateOne) SetNillableOccurrenceAt(v *time.Time) *TicketScheduleRunUpdateOne {
+	if v != nil {
+		_u.SetOccurrenceAt(*v)
+	}
+	return _u
+}
+
+// SetFireAt sets the "fire_at" field.
+func (_u *TicketScheduleRunUpdateOne) SetFireAt(v time.Time) *TicketScheduleRunUpdateOne {
+	_u.mutation.SetFireAt(v)
+	return _u
+}
+
+// SetNillableFireAt sets the "fire_at" field if the given value is not nil.
+func (_u *TicketScheduleRunUpdateOne) SetNillableFireAt(v *time.Time) *TicketScheduleRunUpdateOne {
+	if v != nil {
+		_u.SetFireAt(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *TicketScheduleRunUpdateOne) SetStatus(v ticketschedulerun.Status) *TicketScheduleRunUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *TicketScheduleRunUpdateOne) SetNillableStatus(v *ticketschedulerun.Status) *TicketScheduleRunUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetTicketID sets the "ticket_id" field.
+func (_u *TicketScheduleRunUpdateOne) SetTicketID(v int) *TicketScheduleRunUpdateOne {
+	_u.mutation.ResetTicketID()
+	_u.mutation.SetTicketID(v)
+	return _u
+}
+
+// SetNillableTicketID sets the "ticket_id" field if the given value is not nil.
+func (_u *TicketScheduleRunUpdateOne) SetNillableTicketID(v *int) *TicketScheduleRunUpdateOne {
+	if v != nil {
+		_u.SetTicketID(*v)
+	}
+	return _u
+}
+
+// AddTicketID adds value to the "ticket_id" field.
+func (_u *TicketScheduleRunUpdateOne) AddTicketID(v int) *TicketScheduleRunUpdateOne {
+	_u.mutation.AddTicketID(v)
+	return _u
+}
+
+// ClearTicketID clears the value of the "ticket_id" field.
+func (_u *TicketScheduleRunUpdateOne) ClearTicketID() *TicketScheduleRunUpdateOne {
+	_u.mutation.ClearTicketID()
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *TicketScheduleRunUpdateOne) SetMessage(v string) *TicketScheduleRunUpdateOne {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *TicketScheduleRunUpdateOne) SetNillableMessage(v *string) *TicketScheduleRunUpdateOne {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *TicketScheduleRunUpdateOne) ClearMessage() *TicketScheduleRunUpdateOne {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *TicketScheduleRunUpdateOne) SetCreatedAt(v time.Time) *TicketScheduleRunUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *TicketScheduleRunUpdateOne) SetNillableCreatedAt(v *time.Time) *TicketScheduleRunUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TicketScheduleRunUpdateOne) SetUpdatedAt(v time.Time) *TicketScheduleRunUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetSchedule sets the "schedule" edge to the TicketSchedule entity.
+func (_u *TicketScheduleRunUpdateOne) SetSchedule(v *TicketSchedule) *TicketScheduleRunUpdateOne {
+	return _u.SetScheduleID(v.ID)
+}
+
+// Mutation returns the TicketScheduleRunMutation object of the builder.
+func (_u *TicketScheduleRunUpdateOne) Mutation() *TicketScheduleRunMutation {
+	return _u.mutation
+}
+
+// ClearSchedule clears the "schedule" edge to the TicketSchedule entity.
+func (_u *TicketScheduleRunUpdateOne) ClearSchedule() *TicketScheduleRunUpdateOne {
+	_u.mutation.ClearSchedule()
+	return _u
+}
+
+// Where appends a list predicates to the TicketScheduleRunUpdate builder.
+func (_u *TicketScheduleRunUpdateOne) Where(ps ...predicate.TicketScheduleRun) *TicketScheduleRunUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TicketScheduleRunUpdateOne) Select(field string, fields ...string) *TicketScheduleRunUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TicketScheduleRun entity.
+func (_u *TicketScheduleRunUpdateOne) Save(ctx context.Context) (*TicketScheduleRun, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TicketScheduleRunUpdateOne) SaveX(ctx context.Context) *TicketScheduleRun {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TicketScheduleRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TicketScheduleRunUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TicketScheduleRunUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := ticketschedulerun.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TicketScheduleRunUpdateOne) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := ticketschedulerun.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "TicketScheduleRun.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ScheduleID(); ok {
+		if err := ticketschedulerun.ScheduleIDValidator(v); err != nil {
+			return &ValidationError{Name: "schedule_id", err: fmt.Errorf(`ent: validator failed for field "TicketScheduleRun.schedule_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := ticketschedulerun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TicketScheduleRun.status": %w`, err)}
+		}
+	}
+	if _u.mutation.ScheduleCleared() && len(_u.mutation.ScheduleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TicketScheduleRun.schedule"`)
+	}
+	return nil
+}
+
+func (_u *TicketScheduleRunUpdateOne) sqlSave(ctx context.Context) (_node *TicketScheduleRun, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ticketschedulerun.Table, ticketschedulerun.Columns, sqlgraph.NewFieldSpec(ticketschedulerun.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TicketScheduleRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ticketschedulerun.FieldID)
+		for _, f := range fields {
+			if !ticketschedulerun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ticketschedulerun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(ticketschedulerun.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(ticketschedulerun.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OccurrenceAt(); ok {
+		_spec.SetField(ticketschedulerun.FieldOccurrenceAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FireAt(); ok {
+		_spec.SetField(ticketschedulerun.FieldFireAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(ticketschedulerun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TicketID(); ok {
+		_spec.SetField(ticketschedulerun.FieldTicketID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTicketID(); ok {
+		_spec.AddField(ticketschedulerun.FieldTicketID, field.TypeInt, value)
+	}
+	if _u.mutation.TicketIDCleared() {
+		_spec.ClearField(ticketschedulerun.FieldTicketID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(ticketschedulerun.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(ticketschedulerun.FieldMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(ticketschedulerun.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(ticketschedulerun.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ScheduleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ticketschedulerun.ScheduleTable,
+			Columns: []string{ticketschedulerun.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketschedule.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScheduleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ticketschedulerun.ScheduleTable,
+			Columns: []string{ticketschedulerun.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticketschedule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TicketScheduleRun{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ticketschedulerun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
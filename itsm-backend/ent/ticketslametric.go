@@ -0,0 +1,344 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"itsm-backend/ent/ticket"
+	"itsm-backend/ent/ticketslametric"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// TicketSLAMetric is the model entity for the TicketSLAMetric schema.
+type TicketSLAMetric struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 工单ID
+	TicketID int `json:"ticket_id,omitempty"`
+	// SLA策略ID
+	PolicyID int `json:"policy_id,omitempty"`
+	// 工单绑定的SLA定义ID，用于按定义汇总达标率
+	SLADefinitionID int `json:"sla_definition_id,omitempty"`
+	// 协议类型: sla/ola/uc
+	AgreementType string `json:"agreement_type,omitempty"`
+	// 指标标识
+	MetricKey string `json:"metric_key,omitempty"`
+	// 指标名称
+	MetricName string `json:"metric_name,omitempty"`
+	// 计时周期序号，从1开始
+	Cycle int `json:"cycle,omitempty"`
+	// 状态: running/paused/completed/cancelled
+	Status string `json:"status,omitempty"`
+	// 目标时长（工作分钟）
+	TargetMinutes int `json:"target_minutes,omitempty"`
+	// 开始计时时间
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// 截止时间，暂停恢复后顺延
+	Deadline time.Time `json:"deadline,omitempty"`
+	// 暂停时间，为空表示未暂停
+	PausedAt *time.Time `json:"paused_at,omitempty"`
+	// 累计暂停的工作分钟数
+	PausedMinutes int `json:"paused_minutes,omitempty"`
+	// 停止计时时间
+	StoppedAt *time.Time `json:"stopped_at,omitempty"`
+	// 是否违约
+	Breached bool `json:"breached,omitempty"`
+	// 违约时间
+	BreachedAt *time.Time `json:"breached_at,omitempty"`
+	// 租户ID
+	TenantID int `json:"tenant_id,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TicketSLAMetricQuery when eager-loading is set.
+	Edges        TicketSLAMetricEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TicketSLAMetricEdges holds the relations/edges for other nodes in the graph.
+type TicketSLAMetricEdges struct {
+	// Ticket holds the value of the ticket edge.
+	Ticket *Ticket `json:"ticket,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TicketOrErr returns the Ticket value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TicketSLAMetricEdges) TicketOrErr() (*Ticket, error) {
+	if e.Ticket != nil {
+		return e.Ticket, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: ticket.Label}
+	}
+	return nil, &NotLoadedError{edge: "ticket"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TicketSLAMetric) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ticketslametric.FieldBreached:
+			values[i] = new(sql.NullBool)
+		case ticketslametric.FieldID, ticketslametric.FieldTicketID, ticketslametric.FieldPolicyID, ticketslametric.FieldSLADefinitionID, ticketslametric.FieldCycle, ticketslametric.FieldTargetMinutes, ticketslametric.FieldPausedMinutes, ticketslametric.FieldTenantID:
+			values[i] = new(sql.NullInt64)
+		case ticketslametric.FieldAgreementType, ticketslametric.FieldMetricKey, ticketslametric.FieldMetricName, ticketslametric.FieldStatus:
+			values[i] = new(sql.NullString)
+		case ticketslametric.FieldStartedAt, ticketslametric.FieldDeadline, ticketslametric.FieldPausedAt, ticketslametric.FieldStoppedAt, ticketslametric.FieldBreachedAt, ticketslametric.FieldCreatedAt, ticketslametric.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TicketSLAMetric fields.
+func (_m *TicketSLAMetric) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ticketslametric.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case ticketslametric.FieldTicketID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field ticket_id", values[i])
+			} else if value.Valid {
+				_m.TicketID = int(value.Int64)
+			}
+		case ticketslametric.FieldPolicyID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field policy_id", values[i])
+			} else if value.Valid {
+				_m.PolicyID = int(value.Int64)
+			}
+		case ticketslametric.FieldSLADefinitionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sla_definition_id", values[i])
+			} else if value.Valid {
+				_m.SLADefinitionID = int(value.Int64)
+			}
+		case ticketslametric.FieldAgreementType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field agreement_type", values[i])
+			} else if value.Valid {
+				_m.AgreementType = value.String
+			}
+		case ticketslametric.FieldMetricKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field metric_key", values[i])
+			} else if value.Valid {
+				_m.MetricKey = value.String
+			}
+		case ticketslametric.FieldMetricName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field metric_name", values[i])
+			} else if value.Valid {
+				_m.MetricName = value.String
+			}
+		case ticketslametric.FieldCycle:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cycle", values[i])
+			} else if value.Valid {
+				_m.Cycle = int(value.Int64)
+			}
+		case ticketslametric.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case ticketslametric.FieldTargetMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field target_minutes", values[i])
+			} else if value.Valid {
+				_m.TargetMinutes = int(value.Int64)
+			}
+		case ticketslametric.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				_m.StartedAt = value.Time
+			}
+		case ticketslametric.FieldDeadline:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deadline", values[i])
+			} else if value.Valid {
+				_m.Deadline = value.Time
+			}
+		case ticketslametric.FieldPausedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field paused_at", values[i])
+			} else if value.Valid {
+				_m.PausedAt = new(time.Time)
+				*_m.PausedAt = value.Time
+			}
+		case ticketslametric.FieldPausedMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field paused_minutes", values[i])
+			} else if value.Valid {
+				_m.PausedMinutes = int(value.Int64)
+			}
+		case ticketslametric.FieldStoppedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field stopped_at", values[i])
+			} else if value.Valid {
+				_m.StoppedAt = new(time.Time)
+				*_m.StoppedAt = value.Time
+			}
+		case ticketslametric.FieldBreached:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field breached", values[i])
+			} else if value.Valid {
+				_m.Breached = value.Bool
+			}
+		case ticketslametric.FieldBreachedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field breached_at", values[i])
+			} else if value.Valid {
+				_m.BreachedAt = new(time.Time)
+				*_m.BreachedAt = value.Time
+			}
+		case ticketslametric.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = int(value.Int64)
+			}
+		case ticketslametric.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case ticketslametric.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TicketSLAMetric.
+// This includes values selected through modifiers, order, etc.
+func (_m *TicketSLAMetric) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTicket queries the "ticket" edge of the TicketSLAMetric entity.
+func (_m *TicketSLAMetric) QueryTicket() *TicketQuery {
+	return NewTicketSLAMetricClient(_m.config).QueryTicket(_m)
+}
+
+// Update returns a builder for updating this TicketSLAMetric.
+// Note that you need to call TicketSLAMetric.Unwrap() before calling this method if this TicketSLAMetric
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TicketSLAMetric) Update() *TicketSLAMetricUpdateOne {
+	return NewTicketSLAMetricClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TicketSLAMetric entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TicketSLAMetric) Unwrap() *TicketSLAMetric {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TicketSLAMetric is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TicketSLAMetric) String() string {
+	var builder strings.Builder
+	builder.WriteString("TicketSLAMetric(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("ticket_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TicketID))
+	builder.WriteString(", ")
+	builder.WriteString("policy_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PolicyID))
+	builder.WriteString(", ")
+	builder.WriteString("sla_definition_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SLADefinitionID))
+	builder.WriteString(", ")
+	builder.WriteString("agreement_type=")
+	builder.WriteString(_m.AgreementType)
+	builder.WriteString(", ")
+	builder.WriteString("metric_key=")
+	builder.WriteString(_m.MetricKey)
+	builder.WriteString(", ")
+	builder.WriteString("metric_name=")
+	builder.WriteString(_m.MetricName)
+	builder.WriteString(", ")
+	builder.WriteString("cycle=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Cycle))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("target_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TargetMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(_m.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deadline=")
+	builder.WriteString(_m.Deadline.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.PausedAt; v != nil {
+		builder.WriteString("paused_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("paused_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PausedMinutes))
+	builder.WriteString(", ")
+	if v := _m.StoppedAt; v != nil {
+		builder.WriteString("stopped_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("breached=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Breached))
+	builder.WriteString(", ")
+	if v := _m.BreachedAt; v != nil {
+		builder.WriteString("breached_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TicketSLAMetrics is a parsable slice of TicketSLAMetric.
+type TicketSLAMetrics []*TicketSLAMetric
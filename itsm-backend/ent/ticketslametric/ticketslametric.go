@@ -0,0 +1,246 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ticketslametric
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the ticketslametric type in the database.
+	Label = "ticket_sla_metric"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTicketID holds the string denoting the ticket_id field in the database.
+	FieldTicketID = "ticket_id"
+	// FieldPolicyID holds the string denoting the policy_id field in the database.
+	FieldPolicyID = "policy_id"
+	// FieldSLADefinitionID holds the string denoting the sla_definition_id field in the database.
+	FieldSLADefinitionID = "sla_definition_id"
+	// FieldAgreementType holds the string denoting the agreement_type field in the database.
+	FieldAgreementType = "agreement_type"
+	// FieldMetricKey holds the string denoting the metric_key field in the database.
+	FieldMetricKey = "metric_key"
+	// FieldMetricName holds the string denoting the metric_name field in the database.
+	FieldMetricName = "metric_name"
+	// FieldCycle holds the string denoting the cycle field in the database.
+	FieldCycle = "cycle"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldTargetMinutes holds the string denoting the target_minutes field in the database.
+	FieldTargetMinutes = "target_minutes"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldDeadline holds the string denoting the deadline field in the database.
+	FieldDeadline = "deadline"
+	// FieldPausedAt holds the string denoting the paused_at field in the database.
+	FieldPausedAt = "paused_at"
+	// FieldPausedMinutes holds the string denoting the paused_minutes field in the database.
+	FieldPausedMinutes = "paused_minutes"
+	// FieldStoppedAt holds the string denoting the stopped_at field in the database.
+	FieldStoppedAt = "stopped_at"
+	// FieldBreached holds the string denoting the breached field in the database.
+	FieldBreached = "breached"
+	// FieldBreachedAt holds the string denoting the breached_at field in the database.
+	FieldBreachedAt = "breached_at"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeTicket holds the string denoting the ticket edge name in mutations.
+	EdgeTicket = "ticket"
+	// Table holds the table name of the ticketslametric in the database.
+	Table = "ticket_sla_metrics"
+	// TicketTable is the table that holds the ticket relation/edge.
+	TicketTable = "ticket_sla_metrics"
+	// TicketInverseTable is the table name for the Ticket entity.
+	// It exists in this package in order to avoid circular dependency with the "ticket" package.
+	TicketInverseTable = "tickets"
+	// TicketColumn is the table column denoting the ticket relation/edge.
+	TicketColumn = "ticket_id"
+)
+
+// Columns holds all SQL columns for ticketslametric fields.
+var Columns = []string{
+	FieldID,
+	FieldTicketID,
+	FieldPolicyID,
+	FieldSLADefinitionID,
+	FieldAgreementType,
+	FieldMetricKey,
+	FieldMetricName,
+	FieldCycle,
+	FieldStatus,
+	FieldTargetMinutes,
+	FieldStartedAt,
+	FieldDeadline,
+	FieldPausedAt,
+	FieldPausedMinutes,
+	FieldStoppedAt,
+	FieldBreached,
+	FieldBreachedAt,
+	FieldTenantID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TicketIDValidator is a validator for the "ticket_id" field. It is called by the builders before save.
+	TicketIDValidator func(int) error
+	// PolicyIDValidator is a validator for the "policy_id" field. It is called by the builders before save.
+	PolicyIDValidator func(int) error
+	// DefaultAgreementType holds the default value on creation for the "agreement_type" field.
+	DefaultAgreementType string
+	// MetricKeyValidator is a validator for the "metric_key" field. It is called by the builders before save.
+	MetricKeyValidator func(string) error
+	// DefaultCycle holds the default value on creation for the "cycle" field.
+	DefaultCycle int
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// TargetMinutesValidator is a validator for the "target_minutes" field. It is called by the builders before save.
+	TargetMinutesValidator func(int) error
+	// DefaultPausedMinutes holds the default value on creation for the "paused_minutes" field.
+	DefaultPausedMinutes int
+	// DefaultBreached holds the default value on creation for the "breached" field.
+	DefaultBreached bool
+	// TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	TenantIDValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the TicketSLAMetric queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTicketID orders the results by the ticket_id field.
+func ByTicketID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTicketID, opts...).ToFunc()
+}
+
+// ByPolicyID orders the results by the policy_id field.
+func ByPolicyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPolicyID, opts...).ToFunc()
+}
+
+// BySLADefinitionID orders the results by the sla_definition_id field.
+func BySLADefinitionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSLADefinitionID, opts...).ToFunc()
+}
+
+// ByAgreementType orders the results by the agreement_type field.
+func ByAgreementType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAgreementType, opts...).ToFunc()
+}
+
+// ByMetricKey orders the results by the metric_key field.
+func ByMetricKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMetricKey, opts...).ToFunc()
+}
+
+// ByMetricName orders the results by the metric_name field.
+func ByMetricName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMetricName, opts...).ToFunc()
+}
+
+// ByCycle orders the results by the cycle field.
+func ByCycle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCycle, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByTargetMinutes orders the results by the target_minutes field.
+func ByTargetMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetMinutes, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByDeadline orders the results by the deadline field.
+func ByDeadline(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeadline, opts...).ToFunc()
+}
+
+// ByPausedAt orders the results by the paused_at field.
+func ByPausedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPausedAt, opts...).ToFunc()
+}
+
+// ByPausedMinutes orders the results by the paused_minutes field.
+func ByPausedMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPausedMinutes, opts...).ToFunc()
+}
+
+// ByStoppedAt orders the results by the stopped_at field.
+func ByStoppedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStoppedAt, opts...).ToFunc()
+}
+
+// ByBreached orders the results by the breached field.
+func ByBreached(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBreached, opts...).ToFunc()
+}
+
+// ByBreachedAt orders the results by the breached_at field.
+func ByBreachedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBreachedAt, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByTicketField orders the results by ticket field.
+func ByTicketField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTicketStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newTicketStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TicketInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TicketTable, TicketColumn),
+	)
+}
@@ -0,0 +1,1049 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ticketslametric
+
+import (
+	"itsm-backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldLTE(FieldID, id))
+}
+
+// TicketID applies equality check predicate on the "ticket_id" field. It's identical to TicketIDEQ.
+func TicketID(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldTicketID, v))
+}
+
+// PolicyID applies equality check predicate on the "policy_id" field. It's identical to PolicyIDEQ.
+func PolicyID(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldPolicyID, v))
+}
+
+// SLADefinitionID applies equality check predicate on the "sla_definition_id" field. It's identical to SLADefinitionIDEQ.
+func SLADefinitionID(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldSLADefinitionID, v))
+}
+
+// AgreementType applies equality check predicate on the "agreement_type" field. It's identical to AgreementTypeEQ.
+func AgreementType(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldAgreementType, v))
+}
+
+// MetricKey applies equality check predicate on the "metric_key" field. It's identical to MetricKeyEQ.
+func MetricKey(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldMetricKey, v))
+}
+
+// MetricName applies equality check predicate on the "metric_name" field. It's identical to MetricNameEQ.
+func MetricName(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldMetricName, v))
+}
+
+// Cycle applies equality check predicate on the "cycle" field. It's identical to CycleEQ.
+func Cycle(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldCycle, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldStatus, v))
+}
+
+// TargetMinutes applies equality check predicate on the "target_minutes" field. It's identical to TargetMinutesEQ.
+func TargetMinutes(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldTargetMinutes, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// Deadline applies equality check predicate on the "deadline" field. It's identical to DeadlineEQ.
+func Deadline(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldDeadline, v))
+}
+
+// PausedAt applies equality check predicate on the "paused_at" field. It's identical to PausedAtEQ.
+func PausedAt(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldPausedAt, v))
+}
+
+// PausedMinutes applies equality check predicate on the "paused_minutes" field. It's identical to PausedMinutesEQ.
+func PausedMinutes(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldPausedMinutes, v))
+}
+
+// StoppedAt applies equality check predicate on the "stopped_at" field. It's identical to StoppedAtEQ.
+func StoppedAt(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldStoppedAt, v))
+}
+
+// Breached applies equality check predicate on the "breached" field. It's identical to BreachedEQ.
+func Breached(v bool) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldBreached, v))
+}
+
+// BreachedAt applies equality check predicate on the "breached_at" field. It's identical to BreachedAtEQ.
+func BreachedAt(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldBreachedAt, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldTenantID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TicketIDEQ applies the EQ predicate on the "ticket_id" field.
+func TicketIDEQ(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldTicketID, v))
+}
+
+// TicketIDNEQ applies the NEQ predicate on the "ticket_id" field.
+func TicketIDNEQ(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNEQ(FieldTicketID, v))
+}
+
+// TicketIDIn applies the In predicate on the "ticket_id" field.
+func TicketIDIn(vs ...int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldIn(FieldTicketID, vs...))
+}
+
+// TicketIDNotIn applies the NotIn predicate on the "ticket_id" field.
+func TicketIDNotIn(vs ...int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNotIn(FieldTicketID, vs...))
+}
+
+// PolicyIDEQ applies the EQ predicate on the "policy_id" field.
+func PolicyIDEQ(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldPolicyID, v))
+}
+
+// PolicyIDNEQ applies the NEQ predicate on the "policy_id" field.
+func PolicyIDNEQ(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNEQ(FieldPolicyID, v))
+}
+
+// PolicyIDIn applies the In predicate on the "policy_id" field.
+func PolicyIDIn(vs ...int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldIn(FieldPolicyID, vs...))
+}
+
+// PolicyIDNotIn applies the NotIn predicate on the "policy_id" field.
+func PolicyIDNotIn(vs ...int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNotIn(FieldPolicyID, vs...))
+}
+
+// PolicyIDGT applies the GT predicate on the "policy_id" field.
+func PolicyIDGT(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldGT(FieldPolicyID, v))
+}
+
+// PolicyIDGTE applies the GTE predicate on the "policy_id" field.
+func PolicyIDGTE(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldGTE(FieldPolicyID, v))
+}
+
+// PolicyIDLT applies the LT predicate on the "policy_id" field.
+func PolicyIDLT(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldLT(FieldPolicyID, v))
+}
+
+// PolicyIDLTE applies the LTE predicate on the "policy_id" field.
+func PolicyIDLTE(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldLTE(FieldPolicyID, v))
+}
+
+// SLADefinitionIDEQ applies the EQ predicate on the "sla_definition_id" field.
+func SLADefinitionIDEQ(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldSLADefinitionID, v))
+}
+
+// SLADefinitionIDNEQ applies the NEQ predicate on the "sla_definition_id" field.
+func SLADefinitionIDNEQ(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNEQ(FieldSLADefinitionID, v))
+}
+
+// SLADefinitionIDIn applies the In predicate on the "sla_definition_id" field.
+func SLADefinitionIDIn(vs ...int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldIn(FieldSLADefinitionID, vs...))
+}
+
+// SLADefinitionIDNotIn applies the NotIn predicate on the "sla_definition_id" field.
+func SLADefinitionIDNotIn(vs ...int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNotIn(FieldSLADefinitionID, vs...))
+}
+
+// SLADefinitionIDGT applies the GT predicate on the "sla_definition_id" field.
+func SLADefinitionIDGT(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldGT(FieldSLADefinitionID, v))
+}
+
+// SLADefinitionIDGTE applies the GTE predicate on the "sla_definition_id" field.
+func SLADefinitionIDGTE(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldGTE(FieldSLADefinitionID, v))
+}
+
+// SLADefinitionIDLT applies the LT predicate on the "sla_definition_id" field.
+func SLADefinitionIDLT(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldLT(FieldSLADefinitionID, v))
+}
+
+// SLADefinitionIDLTE applies the LTE predicate on the "sla_definition_id" field.
+func SLADefinitionIDLTE(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldLTE(FieldSLADefinitionID, v))
+}
+
+// SLADefinitionIDIsNil applies the IsNil predicate on the "sla_definition_id" field.
+func SLADefinitionIDIsNil() predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldIsNull(FieldSLADefinitionID))
+}
+
+// SLADefinitionIDNotNil applies the NotNil predicate on the "sla_definition_id" field.
+func SLADefinitionIDNotNil() predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNotNull(FieldSLADefinitionID))
+}
+
+// AgreementTypeEQ applies the EQ predicate on the "agreement_type" field.
+func AgreementTypeEQ(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldAgreementType, v))
+}
+
+// AgreementTypeNEQ applies the NEQ predicate on the "agreement_type" field.
+func AgreementTypeNEQ(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNEQ(FieldAgreementType, v))
+}
+
+// AgreementTypeIn applies the In predicate on the "agreement_type" field.
+func AgreementTypeIn(vs ...string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldIn(FieldAgreementType, vs...))
+}
+
+// AgreementTypeNotIn applies the NotIn predicate on the "agreement_type" field.
+func AgreementTypeNotIn(vs ...string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNotIn(FieldAgreementType, vs...))
+}
+
+// AgreementTypeGT applies the GT predicate on the "agreement_type" field.
+func AgreementTypeGT(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldGT(FieldAgreementType, v))
+}
+
+// AgreementTypeGTE applies the GTE predicate on the "agreement_type" field.
+func AgreementTypeGTE(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldGTE(FieldAgreementType, v))
+}
+
+// AgreementTypeLT applies the LT predicate on the "agreement_type" field.
+func AgreementTypeLT(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldLT(FieldAgreementType, v))
+}
+
+// AgreementTypeLTE applies the LTE predicate on the "agreement_type" field.
+func AgreementTypeLTE(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldLTE(FieldAgreementType, v))
+}
+
+// AgreementTypeContains applies the Contains predicate on the "agreement_type" field.
+func AgreementTypeContains(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldContains(FieldAgreementType, v))
+}
+
+// AgreementTypeHasPrefix applies the HasPrefix predicate on the "agreement_type" field.
+func AgreementTypeHasPrefix(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldHasPrefix(FieldAgreementType, v))
+}
+
+// AgreementTypeHasSuffix applies the HasSuffix predicate on the "agreement_type" field.
+func AgreementTypeHasSuffix(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldHasSuffix(FieldAgreementType, v))
+}
+
+// AgreementTypeEqualFold applies the EqualFold predicate on the "agreement_type" field.
+func AgreementTypeEqualFold(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEqualFold(FieldAgreementType, v))
+}
+
+// AgreementTypeContainsFold applies the ContainsFold predicate on the "agreement_type" field.
+func AgreementTypeContainsFold(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldContainsFold(FieldAgreementType, v))
+}
+
+// MetricKeyEQ applies the EQ predicate on the "metric_key" field.
+func MetricKeyEQ(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldMetricKey, v))
+}
+
+// MetricKeyNEQ applies the NEQ predicate on the "metric_key" field.
+func MetricKeyNEQ(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNEQ(FieldMetricKey, v))
+}
+
+// MetricKeyIn applies the In predicate on the "metric_key" field.
+func MetricKeyIn(vs ...string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldIn(FieldMetricKey, vs...))
+}
+
+// MetricKeyNotIn applies the NotIn predicate on the "metric_key" field.
+func MetricKeyNotIn(vs ...string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNotIn(FieldMetricKey, vs...))
+}
+
+// MetricKeyGT applies the GT predicate on the "metric_key" field.
+func MetricKeyGT(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldGT(FieldMetricKey, v))
+}
+
+// MetricKeyGTE applies the GTE predicate on the "metric_key" field.
+func MetricKeyGTE(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldGTE(FieldMetricKey, v))
+}
+
+// MetricKeyLT applies the LT predicate on the "metric_key" field.
+func MetricKeyLT(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldLT(FieldMetricKey, v))
+}
+
+// MetricKeyLTE applies the LTE predicate on the "metric_key" field.
+func MetricKeyLTE(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldLTE(FieldMetricKey, v))
+}
+
+// MetricKeyContains applies the Contains predicate on the "metric_key" field.
+func MetricKeyContains(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldContains(FieldMetricKey, v))
+}
+
+// MetricKeyHasPrefix applies the HasPrefix predicate on the "metric_key" field.
+func MetricKeyHasPrefix(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldHasPrefix(FieldMetricKey, v))
+}
+
+// MetricKeyHasSuffix applies the HasSuffix predicate on the "metric_key" field.
+func MetricKeyHasSuffix(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldHasSuffix(FieldMetricKey, v))
+}
+
+// MetricKeyEqualFold applies the EqualFold predicate on the "metric_key" field.
+func MetricKeyEqualFold(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEqualFold(FieldMetricKey, v))
+}
+
+// MetricKeyContainsFold applies the ContainsFold predicate on the "metric_key" field.
+func MetricKeyContainsFold(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldContainsFold(FieldMetricKey, v))
+}
+
+// MetricNameEQ applies the EQ predicate on the "metric_name" field.
+func MetricNameEQ(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldMetricName, v))
+}
+
+// MetricNameNEQ applies the NEQ predicate on the "metric_name" field.
+func MetricNameNEQ(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNEQ(FieldMetricName, v))
+}
+
+// MetricNameIn applies the In predicate on the "metric_name" field.
+func MetricNameIn(vs ...string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldIn(FieldMetricName, vs...))
+}
+
+// MetricNameNotIn applies the NotIn predicate on the "metric_name" field.
+func MetricNameNotIn(vs ...string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNotIn(FieldMetricName, vs...))
+}
+
+// MetricNameGT applies the GT predicate on the "metric_name" field.
+func MetricNameGT(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldGT(FieldMetricName, v))
+}
+
+// MetricNameGTE applies the GTE predicate on the "metric_name" field.
+func MetricNameGTE(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldGTE(FieldMetricName, v))
+}
+
+// MetricNameLT applies the LT predicate on the "metric_name" field.
+func MetricNameLT(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldLT(FieldMetricName, v))
+}
+
+// MetricNameLTE applies the LTE predicate on the "metric_name" field.
+func MetricNameLTE(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldLTE(FieldMetricName, v))
+}
+
+// MetricNameContains applies the Contains predicate on the "metric_name" field.
+func MetricNameContains(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldContains(FieldMetricName, v))
+}
+
+// MetricNameHasPrefix applies the HasPrefix predicate on the "metric_name" field.
+func MetricNameHasPrefix(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldHasPrefix(FieldMetricName, v))
+}
+
+// MetricNameHasSuffix applies the HasSuffix predicate on the "metric_name" field.
+func MetricNameHasSuffix(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldHasSuffix(FieldMetricName, v))
+}
+
+// MetricNameIsNil applies the IsNil predicate on the "metric_name" field.
+func MetricNameIsNil() predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldIsNull(FieldMetricName))
+}
+
+// MetricNameNotNil applies the NotNil predicate on the "metric_name" field.
+func MetricNameNotNil() predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNotNull(FieldMetricName))
+}
+
+// MetricNameEqualFold applies the EqualFold predicate on the "metric_name" field.
+func MetricNameEqualFold(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEqualFold(FieldMetricName, v))
+}
+
+// MetricNameContainsFold applies the ContainsFold predicate on the "metric_name" field.
+func MetricNameContainsFold(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldContainsFold(FieldMetricName, v))
+}
+
+// CycleEQ applies the EQ predicate on the "cycle" field.
+func CycleEQ(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldCycle, v))
+}
+
+// CycleNEQ applies the NEQ predicate on the "cycle" field.
+func CycleNEQ(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNEQ(FieldCycle, v))
+}
+
+// CycleIn applies the In predicate on the "cycle" field.
+func CycleIn(vs ...int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldIn(FieldCycle, vs...))
+}
+
+// CycleNotIn applies the NotIn predicate on the "cycle" field.
+func CycleNotIn(vs ...int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNotIn(FieldCycle, vs...))
+}
+
+// CycleGT applies the GT predicate on the "cycle" field.
+func CycleGT(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldGT(FieldCycle, v))
+}
+
+// CycleGTE applies the GTE predicate on the "cycle" field.
+func CycleGTE(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldGTE(FieldCycle, v))
+}
+
+// CycleLT applies the LT predicate on the "cycle" field.
+func CycleLT(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldLT(FieldCycle, v))
+}
+
+// CycleLTE applies the LTE predicate on the "cycle" field.
+func CycleLTE(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldLTE(FieldCycle, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// TargetMinutesEQ applies the EQ predicate on the "target_minutes" field.
+func TargetMinutesEQ(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldTargetMinutes, v))
+}
+
+// TargetMinutesNEQ applies the NEQ predicate on the "target_minutes" field.
+func TargetMinutesNEQ(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNEQ(FieldTargetMinutes, v))
+}
+
+// TargetMinutesIn applies the In predicate on the "target_minutes" field.
+func TargetMinutesIn(vs ...int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldIn(FieldTargetMinutes, vs...))
+}
+
+// TargetMinutesNotIn applies the NotIn predicate on the "target_minutes" field.
+func TargetMinutesNotIn(vs ...int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNotIn(FieldTargetMinutes, vs...))
+}
+
+// TargetMinutesGT applies the GT predicate on the "target_minutes" field.
+func TargetMinutesGT(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldGT(FieldTargetMinutes, v))
+}
+
+// TargetMinutesGTE applies the GTE predicate on the "target_minutes" field.
+func TargetMinutesGTE(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldGTE(FieldTargetMinutes, v))
+}
+
+// TargetMinutesLT applies the LT predicate on the "target_minutes" field.
+func TargetMinutesLT(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldLT(FieldTargetMinutes, v))
+}
+
+// TargetMinutesLTE applies the LTE predicate on the "target_minutes" field.
+func TargetMinutesLTE(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldLTE(FieldTargetMinutes, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// DeadlineEQ applies the EQ predicate on the "deadline" field.
+func DeadlineEQ(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldDeadline, v))
+}
+
+// DeadlineNEQ applies the NEQ predicate on the "deadline" field.
+func DeadlineNEQ(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNEQ(FieldDeadline, v))
+}
+
+// DeadlineIn applies the In predicate on the "deadline" field.
+func DeadlineIn(vs ...time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldIn(FieldDeadline, vs...))
+}
+
+// DeadlineNotIn applies the NotIn predicate on the "deadline" field.
+func DeadlineNotIn(vs ...time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNotIn(FieldDeadline, vs...))
+}
+
+// DeadlineGT applies the GT predicate on the "deadline" field.
+func DeadlineGT(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldGT(FieldDeadline, v))
+}
+
+// DeadlineGTE applies the GTE predicate on the "deadline" field.
+func DeadlineGTE(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldGTE(FieldDeadline, v))
+}
+
+// DeadlineLT applies the LT predicate on the "deadline" field.
+func DeadlineLT(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldLT(FieldDeadline, v))
+}
+
+// DeadlineLTE applies the LTE predicate on the "deadline" field.
+func DeadlineLTE(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldLTE(FieldDeadline, v))
+}
+
+// PausedAtEQ applies the EQ predicate on the "paused_at" field.
+func PausedAtEQ(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldPausedAt, v))
+}
+
+// PausedAtNEQ applies the NEQ predicate on the "paused_at" field.
+func PausedAtNEQ(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNEQ(FieldPausedAt, v))
+}
+
+// PausedAtIn applies the In predicate on the "paused_at" field.
+func PausedAtIn(vs ...time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldIn(FieldPausedAt, vs...))
+}
+
+// PausedAtNotIn applies the NotIn predicate on the "paused_at" field.
+func PausedAtNotIn(vs ...time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNotIn(FieldPausedAt, vs...))
+}
+
+// PausedAtGT applies the GT predicate on the "paused_at" field.
+func PausedAtGT(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldGT(FieldPausedAt, v))
+}
+
+// PausedAtGTE applies the GTE predicate on the "paused_at" field.
+func PausedAtGTE(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldGTE(FieldPausedAt, v))
+}
+
+// PausedAtLT applies the LT predicate on the "paused_at" field.
+func PausedAtLT(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldLT(FieldPausedAt, v))
+}
+
+// PausedAtLTE applies the LTE predicate on the "paused_at" field.
+func PausedAtLTE(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldLTE(FieldPausedAt, v))
+}
+
+// PausedAtIsNil applies the IsNil predicate on the "paused_at" field.
+func PausedAtIsNil() predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldIsNull(FieldPausedAt))
+}
+
+// PausedAtNotNil applies the NotNil predicate on the "paused_at" field.
+func PausedAtNotNil() predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNotNull(FieldPausedAt))
+}
+
+// PausedMinutesEQ applies the EQ predicate on the "paused_minutes" field.
+func PausedMinutesEQ(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldPausedMinutes, v))
+}
+
+// PausedMinutesNEQ applies the NEQ predicate on the "paused_minutes" field.
+func PausedMinutesNEQ(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNEQ(FieldPausedMinutes, v))
+}
+
+// PausedMinutesIn applies the In predicate on the "paused_minutes" field.
+func PausedMinutesIn(vs ...int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldIn(FieldPausedMinutes, vs...))
+}
+
+// PausedMinutesNotIn applies the NotIn predicate on the "paused_minutes" field.
+func PausedMinutesNotIn(vs ...int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNotIn(FieldPausedMinutes, vs...))
+}
+
+// PausedMinutesGT applies the GT predicate on the "paused_minutes" field.
+func PausedMinutesGT(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldGT(FieldPausedMinutes, v))
+}
+
+// PausedMinutesGTE applies the GTE predicate on the "paused_minutes" field.
+func PausedMinutesGTE(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldGTE(FieldPausedMinutes, v))
+}
+
+// PausedMinutesLT applies the LT predicate on the "paused_minutes" field.
+func PausedMinutesLT(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldLT(FieldPausedMinutes, v))
+}
+
+// PausedMinutesLTE applies the LTE predicate on the "paused_minutes" field.
+func PausedMinutesLTE(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldLTE(FieldPausedMinutes, v))
+}
+
+// StoppedAtEQ applies the EQ predicate on the "stopped_at" field.
+func StoppedAtEQ(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldStoppedAt, v))
+}
+
+// StoppedAtNEQ applies the NEQ predicate on the "stopped_at" field.
+func StoppedAtNEQ(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNEQ(FieldStoppedAt, v))
+}
+
+// StoppedAtIn applies the In predicate on the "stopped_at" field.
+func StoppedAtIn(vs ...time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldIn(FieldStoppedAt, vs...))
+}
+
+// StoppedAtNotIn applies the NotIn predicate on the "stopped_at" field.
+func StoppedAtNotIn(vs ...time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNotIn(FieldStoppedAt, vs...))
+}
+
+// StoppedAtGT applies the GT predicate on the "stopped_at" field.
+func StoppedAtGT(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldGT(FieldStoppedAt, v))
+}
+
+// StoppedAtGTE applies the GTE predicate on the "stopped_at" field.
+func StoppedAtGTE(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldGTE(FieldStoppedAt, v))
+}
+
+// StoppedAtLT applies the LT predicate on the "stopped_at" field.
+func StoppedAtLT(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldLT(FieldStoppedAt, v))
+}
+
+// StoppedAtLTE applies the LTE predicate on the "stopped_at" field.
+func StoppedAtLTE(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldLTE(FieldStoppedAt, v))
+}
+
+// StoppedAtIsNil applies the IsNil predicate on the "stopped_at" field.
+func StoppedAtIsNil() predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldIsNull(FieldStoppedAt))
+}
+
+// StoppedAtNotNil applies the NotNil predicate on the "stopped_at" field.
+func StoppedAtNotNil() predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNotNull(FieldStoppedAt))
+}
+
+// BreachedEQ applies the EQ predicate on the "breached" field.
+func BreachedEQ(v bool) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldBreached, v))
+}
+
+// BreachedNEQ applies the NEQ predicate on the "breached" field.
+func BreachedNEQ(v bool) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNEQ(FieldBreached, v))
+}
+
+// BreachedAtEQ applies the EQ predicate on the "breached_at" field.
+func BreachedAtEQ(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldBreachedAt, v))
+}
+
+// BreachedAtNEQ applies the NEQ predicate on the "breached_at" field.
+func BreachedAtNEQ(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNEQ(FieldBreachedAt, v))
+}
+
+// BreachedAtIn applies the In predicate on the "breached_at" field.
+func BreachedAtIn(vs ...time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldIn(FieldBreachedAt, vs...))
+}
+
+// BreachedAtNotIn applies the NotIn predicate on the "breached_at" field.
+func BreachedAtNotIn(vs ...time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNotIn(FieldBreachedAt, vs...))
+}
+
+// BreachedAtGT applies the GT predicate on the "breached_at" field.
+func BreachedAtGT(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldGT(FieldBreachedAt, v))
+}
+
+// BreachedAtGTE applies the GTE predicate on the "breached_at" field.
+func BreachedAtGTE(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldGTE(FieldBreachedAt, v))
+}
+
+// BreachedAtLT applies the LT predicate on the "breached_at" field.
+func BreachedAtLT(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldLT(FieldBreachedAt, v))
+}
+
+// BreachedAtLTE applies the LTE predicate on the "breached_at" field.
+func BreachedAtLTE(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldLTE(FieldBreachedAt, v))
+}
+
+// BreachedAtIsNil applies the IsNil predicate on the "breached_at" field.
+func BreachedAtIsNil() predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldIsNull(FieldBreachedAt))
+}
+
+// BreachedAtNotNil applies the NotNil predicate on the "breached_at" field.
+func BreachedAtNotNil() predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNotNull(FieldBreachedAt))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldLTE(FieldTenantID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasTicket applies the HasEdge predicate on the "ticket" edge.
+func HasTicket() predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TicketTable, TicketColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTicketWith applies the HasEdge predicate on the "ticket" edge with a given conditions (other predicates).
+func HasTicketWith(preds ...predicate.Ticket) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(func(s *sql.Selector) {
+		step := newTicketStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TicketSLAMetric) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TicketSLAMetric) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TicketSLAMetric) predicate.TicketSLAMetric {
+	return predicate.TicketSLAMetric(sql.NotPredicates(p))
+}
@@ -0,0 +1,580 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/ticket"
+	"itsm-backend/ent/ticketslametric"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TicketSLAMetricCreate is the builder for creating a TicketSLAMetric entity.
+type TicketSLAMetricCreate struct {
+	config
+	mutation *TicketSLAMetricMutation
+	hooks    []Hook
+}
+
+// SetTicketID sets the "ticket_id" field.
+func (_c *TicketSLAMetricCreate) SetTicketID(v int) *TicketSLAMetricCreate {
+	_c.mutation.SetTicketID(v)
+	return _c
+}
+
+// SetPolicyID sets the "policy_id" field.
+func (_c *TicketSLAMetricCreate) SetPolicyID(v int) *TicketSLAMetricCreate {
+	_c.mutation.SetPolicyID(v)
+	return _c
+}
+
+// SetSLADefinitionID sets the "sla_definition_id" field.
+func (_c *TicketSLAMetricCreate) SetSLADefinitionID(v int) *TicketSLAMetricCreate {
+	_c.mutation.SetSLADefinitionID(v)
+	return _c
+}
+
+// SetNillableSLADefinitionID sets the "sla_definition_id" field if the given value is not nil.
+func (_c *TicketSLAMetricCreate) SetNillableSLADefinitionID(v *int) *TicketSLAMetricCreate {
+	if v != nil {
+		_c.SetSLADefinitionID(*v)
+	}
+	return _c
+}
+
+// SetAgreementType sets the "agreement_type" field.
+func (_c *TicketSLAMetricCreate) SetAgreementType(v string) *TicketSLAMetricCreate {
+	_c.mutation.SetAgreementType(v)
+	return _c
+}
+
+// SetNillableAgreementType sets the "agreement_type" field if the given value is not nil.
+func (_c *TicketSLAMetricCreate) SetNillableAgreementType(v *string) *TicketSLAMetricCreate {
+	if v != nil {
+		_c.SetAgreementType(*v)
+	}
+	return _c
+}
+
+// SetMetricKey sets the "metric_key" field.
+func (_c *TicketSLAMetricCreate) SetMetricKey(v string) *TicketSLAMetricCreate {
+	_c.mutation.SetMetricKey(v)
+	return _c
+}
+
+// SetMetricName sets the "metric_name" field.
+func (_c *TicketSLAMetricCreate) SetMetricName(v string) *TicketSLAMetricCreate {
+	_c.mutation.SetMetricName(v)
+	return _c
+}
+
+// SetNillableMetricName sets the "metric_name" field if the given value is not nil.
+func (_c *TicketSLAMetricCreate) SetNillableMetricName(v *string) *TicketSLAMetricCreate {
+	if v != nil {
+		_c.SetMetricName(*v)
+	}
+	return _c
+}
+
+// SetCycle sets the "cycle" field.
+func (_c *TicketSLAMetricCreate) SetCycle(v int) *TicketSLAMetricCreate {
+	_c.mutation.SetCycle(v)
+	return _c
+}
+
+// SetNillableCycle sets the "cycle" field if the given value is not nil.
+func (_c *TicketSLAMetricCreate) SetNillableCycle(v *int) *TicketSLAMetricCreate {
+	if v != nil {
+		_c.SetCycle(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *TicketSLAMetricCreate) SetStatus(v string) *TicketSLAMetricCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *TicketSLAMetricCreate) SetNillableStatus(v *string) *TicketSLAMetricCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetTargetMinutes sets the "target_minutes" field.
+func (_c *TicketSLAMetricCreate) SetTargetMinutes(v int) *TicketSLAMetricCreate {
+	_c.mutation.SetTargetMinutes(v)
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *TicketSLAMetricCreate) SetStartedAt(v time.Time) *TicketSLAMetricCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetDeadline sets the "deadline" field.
+func (_c *TicketSLAMetricCreate) SetDeadline(v time.Time) *TicketSLAMetricCreate {
+	_c.mutation.SetDeadline(v)
+	return _c
+}
+
+// SetPausedAt sets the "paused_at" field.
+func (_c *TicketSLAMetricCreate) SetPausedAt(v time.Time) *TicketSLAMetricCreate {
+	_c.mutation.SetPausedAt(v)
+	return _c
+}
+
+// SetNillablePausedAt sets the "paused_at" field if the given value is not nil.
+func (_c *TicketSLAMetricCreate) SetNillablePausedAt(v *time.Time) *TicketSLAMetricCreate {
+	if v != nil {
+		_c.SetPausedAt(*v)
+	}
+	return _c
+}
+
+// SetPausedMinutes sets the "paused_minutes" field.
+func (_c *TicketSLAMetricCreate) SetPausedMinutes(v int) *TicketSLAMetricCreate {
+	_c.mutation.SetPausedMinutes(v)
+	return _c
+}
+
+// SetNillablePausedMinutes sets the "paused_minutes" field if the given value is not nil.
+func (_c *TicketSLAMetricCreate) SetNillablePausedMinutes(v *int) *TicketSLAMetricCreate {
+	if v != nil {
+		_c.SetPausedMinutes(*v)
+	}
+	return _c
+}
+
+// SetStoppedAt sets the "stopped_at" field.
+func (_c *TicketSLAMetricCreate) SetStoppedAt(v time.Time) *TicketSLAMetricCreate {
+	_c.mutation.SetStoppedAt(v)
+	return _c
+}
+
+// SetNillableStoppedAt sets the "stopped_at" field if the given value is not nil.
+func (_c *TicketSLAMetricCreate) SetNillableStoppedAt(v *time.Time) *TicketSLAMetricCreate {
+	if v != nil {
+		_c.SetStoppedAt(*v)
+	}
+	return _c
+}
+
+// SetBreached sets the "breached" field.
+func (_c *TicketSLAMetricCreate) SetBreached(v bool) *TicketSLAMetricCreate {
+	_c.mutation.SetBreached(v)
+	return _c
+}
+
+// SetNillableBreached sets the "breached" field if the given value is not nil.
+func (_c *TicketSLAMetricCreate) SetNillableBreached(v *bool) *TicketSLAMetricCreate {
+	if v != nil {
+		_c.SetBreached(*v)
+	}
+	return _c
+}
+
+// SetBreachedAt sets the "breached_at" field.
+func (_c *TicketSLAMetricCreate) SetBreachedAt(v time.Time) *TicketSLAMetricCreate {
+	_c.mutation.SetBreachedAt(v)
+	return _c
+}
+
+// SetNillableBreachedAt sets the "breached_at" field if the given value is not nil.
+func (_c *TicketSLAMetricCreate) SetNillableBreachedAt(v *time.Time) *TicketSLAMetricCreate {
+	if v != nil {
+		_c.SetBreachedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *TicketSLAMetricCreate) SetTenantID(v int) *TicketSLAMetricCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TicketSLAMetricCreate) SetCreatedAt(v time.Time) *TicketSLAMetricCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TicketSLAMetricCreate) SetNillableCreatedAt(v *time.Time) *TicketSLAMetricCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *TicketSLAMetricCreate) SetUpdatedAt(v time.Time) *TicketSLAMetricCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *TicketSLAMetricCreate) SetNillableUpdatedAt(v *time.Time) *TicketSLAMetricCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetTicket sets the "ticket" edge to the Ticket entity.
+func (_c *TicketSLAMetricCreate) SetTicket(v *Ticket) *TicketSLAMetricCreate {
+	return _c.SetTicketID(v.ID)
+}
+
+// Mutation returns the TicketSLAMetricMutation object of the builder.
+func (_c *TicketSLAMetricCreate) Mutation() *TicketSLAMetricMutation {
+	return _c.mutation
+}
+
+// Save creates the TicketSLAMetric in the database.
+func (_c *TicketSLAMetricCreate) Save(ctx context.Context) (*TicketSLAMetric, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TicketSLAMetricCreate) SaveX(ctx context.Context) *TicketSLAMetric {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TicketSLAMetricCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TicketSLAMetricCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TicketSLAMetricCreate) defaults() {
+	if _, ok := _c.mutation.AgreementType(); !ok {
+		v := ticketslametric.DefaultAgreementType
+		_c.mutation.SetAgreementType(v)
+	}
+	if _, ok := _c.mutation.Cycle(); !ok {
+		v := ticketslametric.DefaultCycle
+		_c.mutation.SetCycle(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := ticketslametric.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.PausedMinutes(); !ok {
+		v := ticketslametric.DefaultPausedMinutes
+		_c.mutation.SetPausedMinutes(v)
+	}
+	if _, ok := _c.mutation.Breached(); !ok {
+		v := ticketslametric.DefaultBreached
+		_c.mutation.SetBreached(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := ticketslametric.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := ticketslametric.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TicketSLAMetricCreate) check() error {
+	if _, ok := _c.mutation.TicketID(); !ok {
+		return &ValidationError{Name: "ticket_id", err: errors.New(`ent: missing required field "TicketSLAMetric.ticket_id"`)}
+	}
+	if v, ok := _c.mutation.TicketID(); ok {
+		if err := ticketslametric.TicketIDValidator(v); err != nil {
+			return &ValidationError{Name: "ticket_id", err: fmt.Errorf(`ent: validator failed for field "TicketSLAMetric.ticket_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PolicyID(); !ok {
+		return &ValidationError{Name: "policy_id", err: errors.New(`ent: missing required field "TicketSLAMetric.policy_id"`)}
+	}
+	if v, ok := _c.mutation.PolicyID(); ok {
+		if err := ticketslametric.PolicyIDValidator(v); err != nil {
+			return &ValidationError{Name: "policy_id", err: fmt.Errorf(`ent: validator failed for field "TicketSLAMetric.policy_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AgreementType(); !ok {
+		return &ValidationError{Name: "agreement_type", err: errors.New(`ent: missing required field "TicketSLAMetric.agreement_type"`)}
+	}
+	if _, ok := _c.mutation.MetricKey(); !ok {
+		return &ValidationError{Name: "metric_key", err: errors.New(`ent: missing required field "TicketSLAMetric.metric_key"`)}
+	}
+	if v, ok := _c.mutation.MetricKey(); ok {
+		if err := ticketslametric.MetricKeyValidator(v); err != nil {
+			return &ValidationError{Name: "metric_key", err: fmt.Errorf(`ent: validator failed for field "TicketSLAMetric.metric_key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Cycle(); !ok {
+		return &ValidationError{Name: "cycle", err: errors.New(`ent: missing required field "TicketSLAMetric.cycle"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "TicketSLAMetric.status"`)}
+	}
+	if _, ok := _c.mutation.TargetMinutes(); !ok {
+		return &ValidationError{Name: "target_minutes", err: errors.New(`ent: missing required field "TicketSLAMetric.target_minutes"`)}
+	}
+	if v, ok := _c.mutation.TargetMinutes(); ok {
+		if err := ticketslametric.TargetMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "target_minutes", err: fmt.Errorf(`ent: validator failed for field "TicketSLAMetric.target_minutes": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "TicketSLAMetric.started_at"`)}
+	}
+	if _, ok := _c.mutation.Deadline(); !ok {
+		return &ValidationError{Name: "deadline", err: errors.New(`ent: missing required field "TicketSLAMetric.deadline"`)}
+	}
+	if _, ok := _c.mutation.PausedMinutes(); !ok {
+		return &ValidationError{Name: "paused_minutes", err: errors.New(`ent: missing required field "TicketSLAMetric.paused_minutes"`)}
+	}
+	if _, ok := _c.mutation.Breached(); !ok {
+		return &ValidationError{Name: "breached", err: errors.New(`ent: missing required field "TicketSLAMetric.breached"`)}
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "TicketSLAMetric.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := ticketslametric.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "TicketSLAMetric.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TicketSLAMetric.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TicketSLAMetric.updated_at"`)}
+	}
+	if len(_c.mutation.TicketIDs()) == 0 {
+		return &ValidationError{Name: "ticket", err: errors.New(`ent: missing required edge "TicketSLAMetric.ticket"`)}
+	}
+	return nil
+}
+
+func (_c *TicketSLAMetricCreate) sqlSave(ctx context.Context) (*TicketSLAMetric, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TicketSLAMetricCreate) createSpec() (*TicketSLAMetric, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TicketSLAMetric{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(ticketslametric.Table, sqlgraph.NewFieldSpec(ticketslametric.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.PolicyID(); ok {
+		_spec.SetField(ticketslametric.FieldPolicyID, field.TypeInt, value)
+		_node.PolicyID = value
+	}
+	if value, ok := _c.mutation.SLADefinitionID(); ok {
+		_spec.SetField(ticketslametric.FieldSLADefinitionID, field.TypeInt, value)
+		_node.SLADefinitionID = value
+	}
+	if value, ok := _c.mutation.AgreementType(); ok {
+		_spec.SetField(ticketslametric.FieldAgreementType, field.TypeString, value)
+		_node.AgreementType = value
+	}
+	if value, ok := _c.mutation.MetricKey(); ok {
+		_spec.SetField(ticketslametric.FieldMetricKey, field.TypeString, value)
+		_node.MetricKey = value
+	}
+	if value, ok := _c.mutation.MetricName(); ok {
+		_spec.SetField(ticketslametric.FieldMetricName, field.TypeString, value)
+		_node.MetricName = value
+	}
+	if value, ok := _c.mutation.Cycle(); ok {
+		_spec.SetField(ticketslametric.FieldCycle, field.TypeInt, value)
+		_node.Cycle = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(ticketslametric.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.TargetMinutes(); ok {
+		_spec.SetField(ticketslametric.FieldTargetMinutes, field.TypeInt, value)
+		_node.TargetMinutes = value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(ticketslametric.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := _c.mutation.Deadline(); ok {
+		_spec.SetField(ticketslametric.FieldDeadline, field.TypeTime, value)
+		_node.Deadline = value
+	}
+	if value, ok := _c.mutation.PausedAt(); ok {
+		_spec.SetField(ticketslametric.FieldPausedAt, field.TypeTime, value)
+		_node.PausedAt = &value
+	}
+	if value, ok := _c.mutation.PausedMinutes(); ok {
+		_spec.SetField(ticketslametric.FieldPausedMinutes, field.TypeInt, value)
+		_node.PausedMinutes = value
+	}
+	if value, ok := _c.mutation.StoppedAt(); ok {
+		_spec.SetField(ticketslametric.FieldStoppedAt, field.TypeTime, value)
+		_node.StoppedAt = &value
+	}
+	if value, ok := _c.mutation.Breached(); ok {
+		_spec.SetField(ticketslametric.FieldBreached, field.TypeBool, value)
+		_node.Breached = value
+	}
+	if value, ok := _c.mutation.BreachedAt(); ok {
+		_spec.SetField(ticketslametric.FieldBreachedAt, field.TypeTime, value)
+		_node.BreachedAt = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(ticketslametric.FieldTenantID, field.TypeInt, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(ticketslametric.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(ticketslametric.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.TicketIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ticketslametric.TicketTable,
+			Columns: []string{ticketslametric.TicketColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticket.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TicketID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TicketSLAMetricCreateBulk is the builder for creating many TicketSLAMetric entities in bulk.
+type TicketSLAMetricCreateBulk struct {
+	config
+	err      error
+	builders []*TicketSLAMetricCreate
+}
+
+// Save creates the TicketSLAMetric entities in the database.
+func (_c *TicketSLAMetricCreateBulk) Save(ctx context.Context) ([]*TicketSLAMetric, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TicketSLAMetric, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TicketSLAMetricMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TicketSLAMetricCreateBulk) SaveX(ctx context.Context) []*TicketSLAMetric {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TicketSLAMetricCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TicketSLAMetricCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"itsm-backend/ent/predicate"
+	"itsm-backend/ent/ticketslametric"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TicketSLAMetricDelete is the builder for deleting a TicketSLAMetric entity.
+type TicketSLAMetricDelete struct {
+	config
+	hooks    []Hook
+	mutation *TicketSLAMetricMutation
+}
+
+// Where appends a list predicates to the TicketSLAMetricDelete builder.
+func (_d *TicketSLAMetricDelete) Where(ps ...predicate.TicketSLAMetric) *TicketSLAMetricDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *TicketSLAMetricDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *TicketSLAMetricDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *TicketSLAMetricDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(ticketslametric.Table, sqlgraph.NewFieldSpec(ticketslametric.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// TicketSLAMetricDeleteOne is the builder for deleting a single TicketSLAMetric entity.
+type TicketSLAMetricDeleteOne struct {
+	_d *TicketSLAMetricDelete
+}
+
+// Where appends a list predicates to the TicketSLAMetricDelete builder.
+func (_d *TicketSLAMetricDeleteOne) Where(ps ...predicate.TicketSLAMetric) *TicketSLAMetricDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *TicketSLAMetricDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{ticketslametric.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *TicketSLAMetricDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/predicate"
+	"itsm-backend/ent/ticket"
+	"itsm-backend/ent/ticketslametric"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TicketSLAMetricUpdate is the builder for updating TicketSLAMetric entities.
+type TicketSLAMetricUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TicketSLAMetricMutation
+}
+
+// Where appends a list predicates to the TicketSLAMetricUpdate builder.
+func (_u *TicketSLAMetricUpdate) Where(ps ...predicate.TicketSLAMetric) *TicketSLAMetricUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTicketID sets the "ticket_id" field.
+func (_u *TicketSLAMetricUpdate) SetTicketID(v int) *TicketSLAMetricUpdate {
+	_u.mutation.SetTicketID(v)
+	return _u
+}
+
+// SetNillableTicketID sets the "ticket_id" field if the given value is not nil.
+func (_u *TicketSLAMetricUpdate) SetNillableTicketID(v *int) *TicketSLAMetricUpdate {
+	if v != nil {
+		_u.SetTicketID(*v)
+	}
+	return _u
+}
+
+// SetPolicyID sets the "policy_id" field.
+func (_u *TicketSLAMetricUpdate) SetPolicyID(v int) *TicketSLAMetricUpdate {
+	_u.mutation.ResetPolicyID()
+	_u.mutation.SetPolicyID(v)
+	return _u
+}
+
+// SetNillablePolicyID sets the "policy_id" field if the given value is not nil.
+func (_u *TicketSLAMetricUpdate) SetNillablePolicyID(v *int) *TicketSLAMetricUpdate {
+	if v != nil {
+		_u.SetPolicyID(*v)
+	}
+	return _u
+}
+
+// AddPolicyID adds value to the "policy_id" field.
+func (_u *TicketSLAMetricUpdate) AddPolicyID(v int) *TicketSLAMetricUpdate {
+	_u.mutation.AddPolicyID(v)
+	return _u
+}
+
+// SetSLADefinitionID sets the "sla_definition_id" field.
+func (_u *TicketSLAMetricUpdate) SetSLADefinitionID(v int) *TicketSLAMetricUpdate {
+	_u.mutation.ResetSLADefinitionID()
+	_u.mutation.SetSLADefinitionID(v)
+	return _u
+}
+
+// SetNillableSLADefinitionID sets the "sla_definition_id" field if the given value is not nil.
+func (_u *TicketSLAMetricUpdate) SetNillableSLADefinitionID(v *int) *TicketSLAMetricUpdate {
+	if v != nil {
+		_u.SetSLADefinitionID(*v)
+	}
+	return _u
+}
+
+// AddSLADefinitionID adds value to the "sla_definition_id" field.
+func (_u *TicketSLAMetricUpdate) AddSLADefinitionID(v int) *TicketSLAMetricUpdate {
+	_u.mutation.AddSLADefinitionID(v)
+	return _u
+}
+
+// ClearSLADefinitionID clears the value of the "sla_definition_id" field.
+func (_u *TicketSLAMetricUpdate) ClearSLADefinitionID() *TicketSLAMetricUpdate {
+	_u.mutation.ClearSLADefinitionID()
+	return _u
+}
+
+// SetAgreementType sets the "agreement_type" field.
+func (_u *TicketSLAMetricUpdate) SetAgreementType(v string) *TicketSLAMetricUpdate {
+	_u.mutation.SetAgreementType(v)
+	return _u
+}
+
+// SetNillableAgreementType sets the "agreement_type" field if the given value is not nil.
+func (_u *TicketSLAMetricUpdate) SetNillableAgreementType(v *string) *TicketSLAMetricUpdate {
+	if v != nil {
+		_u.SetAgreementType(*v)
+	}
+	return _u
+}
+
+// SetMetricKey sets the "metric_key" field.
+func (_u *TicketSLAMetricUpdate) SetMetricKey(v string) *TicketSLAMetricUpdate {
+	_u.mutation.SetMetricKey(v)
+	return _u
+}
+
+// SetNillableMetricKey sets the "metric_key" field if the given value is not nil.
+func (_u *TicketSLAMetricUpdate) SetNillableMetricKey(v *string) *TicketSLAMetricUpdate {
+	if v != nil {
+		_u.SetMetricKey(*v)
+	}
+	return _u
+}
+
+// SetMetricName sets the "metric_name" field.
+func (_u *TicketSLAMetricUpdate) SetMetricName(v string) *TicketSLAMetricUpdate {
+	_u.mutation.SetMetricName(v)
+	return _u
+}
+
+// SetNillableMetricName sets the "metric_name" field if the given value is not nil.
+func (_u *TicketSLAMetricUpdate) SetNillableMetricName(v *string) *TicketSLAMetricUpdate {
+	if v != nil {
+		_u.SetMetricName(*v)
+	}
+	return _u
+}
+
+// ClearMetricName clears the value of the "metric_name" field.
+func (_u *TicketSLAMetricUpdate) ClearMetricName() *TicketSLAMetricUpdate {
+	_u.mutation.ClearMetricName()
+	return _u
+}
+
+// SetCycle sets the "cycle" field.
+func (_u *TicketSLAMetricUpdate) SetCycle(v int) *TicketSLAMetricUpdate {
+	_u.mutation.ResetCycle()
+	_u.mutation.SetCycle(v)
+	return _u
+}
+
+// SetNillableCycle sets the "cycle" field if the given value is not nil.
+func (_u *TicketSLAMetricUpdate) SetNillableCycle(v *int) *TicketSLAMetricUpdate {
+	if v != nil {
+		_u.SetCycle(*v)
+	}
+	return _u
+}
+
+// AddCycle adds value to the "cycle" field.
+func (_u *TicketSLAMetricUpdate) AddCycle(v int) *TicketSLAMetricUpdate {
+	_u.mutation.AddCycle(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *TicketSLAMetricUpdate) SetStatus(v string) *TicketSLAMetricUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *TicketSLAMetricUpdate) SetNillableStatus(v *string) *TicketSLAMetricUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetTargetMinutes sets the "target_minutes" field.
+func (_u *TicketSLAMetricUpdate) SetTargetMinutes(v int) *TicketSLAMetricUpdate {
+	_u.mutation.ResetTargetMinutes()
+	_u.mutation.SetTargetMinutes(v)
+	return _u
+}
+
+// SetNillableTargetMinutes sets the "target_minutes" field if the given value is not nil.
+func (_u *TicketSLAMetricUpdate) SetNillableTargetMinutes(v *int) *TicketSLAMetricUpdate {
+	if v != nil {
+		_u.SetTargetMinutes(*v)
+	}
+	return _u
+}
+
+// AddTargetMinutes adds value to the "target_minutes" field.
+func (_u *TicketSLAMetricUpdate) AddTargetMinutes(v int) *TicketSLAMetricUpdate {
+	_u.mutation.AddTargetMinutes(v)
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *TicketSLAMetricUpdate) SetStartedAt(v time.Time) *TicketSLAMetricUpdate {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *TicketSLAMetricUpdate) SetNillableStartedAt(v *time.Time) *TicketSLAMetricUpdate {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// SetDeadline sets the "deadline" field.
+func (_u *TicketSLAMetricUpdate) SetDeadline(v time.Time) *TicketSLAMetricUpdate {
+	_u.mutation.SetDeadline(v)
+	return _u
+}
+
+// SetNillableDeadline sets the "deadline" field if the given value is not nil.
+func (_u *TicketSLAMetricUpdate) SetNillableDeadline(v *time.Time) *TicketSLAMetricUpdate {
+	if v != nil {
+		_u.SetDeadline(*v)
+	}
+	return _u
+}
+
+// SetPausedAt sets the "paused_at" field.
+func (_u *TicketSLAMetricUpdate) SetPausedAt(v time.Time) *TicketSLAMetricUpdate {
+	_u.mutation.SetPausedAt(v)
+	return _u
+}
+
+// SetNillablePausedAt sets the "paused_at" field if the given value is not nil.
+func (_u *TicketSLAMetricUpdate) SetNillablePausedAt(v *time.Time) *TicketSLAMetricUpdate {
+	if v != nil {
+		_u.SetPausedAt(*v)
+	}
+	return _u
+}
+
+// ClearPausedAt clears the value of the "paused_at" field.
+func (_u *TicketSLAMetricUpdate) ClearPausedAt() *TicketSLAMetricUpdate {
+	_u.mutation.ClearPausedAt()
+	return _u
+}
+
+// SetPausedMinutes sets the "paused_minutes" field.
+func (_u *TicketSLAMetricUpdate) SetPausedMinutes(v int) *TicketSLAMetricUpdate {
+	_u.mutation.ResetPausedMinutes()
+	_u.mutation.SetPausedMinutes(v)
+	return _u
+}
+
+// SetNillablePausedMinutes sets the "paused_minutes" field if the given value is not nil.
+func (_u *TicketSLAMetricUpdate) SetNillablePausedMinutes(v *int) *TicketSLAMetricUpdate {
+	if v != nil {
+		_u.SetPausedMinutes(*v)
+	}
+	return _u
+}
+
+// AddPausedMinutes adds value to the "paused_minutes" field.
+func (_u *TicketSLAMetricUpdate) AddPausedMinutes(v int) *TicketSLAMetricUpdate {
+	_u.mutation.AddPausedMinutes(v)
+	return _u
+}
+
+// SetStoppedAt sets the "stopped_at" field.
+func (_u *TicketSLAMetricUpdate) SetStoppedAt(v time.Time) *TicketSLAMetricUpdate {
+	_u.mutation.SetStoppedAt(v)
+	return _u
+}
+
+// SetNillableStoppedAt sets the "stopped_at" field if the given value is not nil.
+func (_u *TicketSLAMetricUpdate) SetNillableStoppedAt(v *time.Time) *TicketSLAMetricUpdate {
+	if v != nil {
+		_u.SetStoppedAt(*v)
+	}
+	return _u
+}
+
+// ClearStoppedAt clears the value of the "stopped_at" field.
+func (_u *TicketSLAMetricUpdate) ClearStoppedAt() *TicketSLAMetricUpdate {
+	_u.mutation.ClearStoppedAt()
+	return _u
+}
+
+// SetBreached sets the "breached" field.
+func (_u *TicketSLAMetricUpdate) SetBreached(v bool) *TicketSLAMetricUpdate {
+	_u.mutation.SetBreached(v)
+	return _u
+}
+
+// SetNillableBreached sets the "breached" field if the given value is not nil.
+func (_u *TicketSLAMetricUpdate) SetNillableBreached(v *bool) *TicketSLAMetricUpdate {
+	if v != nil {
+		_u.SetBreached(*v)
+	}
+	return _u
+}
+
+// SetBreachedAt sets the "breached_at" field.
+func (_u *TicketSLAMetricUpdate) SetBreachedAt(v time.Time) *TicketSLAMetricUpdate {
+	_u.mutation.SetBreachedAt(v)
+	return _u
+}
+
+// SetNillableBreachedAt sets the "breached_at" field if the given value is not nil.
+func (_u *TicketSLAMetricUpdate) SetNillableBreachedAt(v *time.Time) *TicketSLAMetricUpdate {
+	if v != nil {
+		_u.SetBreachedAt(*v)
+	}
+	return _u
+}
+
+// ClearBreachedAt clears the value of the "breached_at" field.
+func (_u *TicketSLAMetricUpdate) ClearBreachedAt() *TicketSLAMetricUpdate {
+	_u.mutation.ClearBreachedAt()
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *TicketSLAMetricUpdate) SetTenantID(v int) *TicketSLAMetricUpdate {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *TicketSLAMetricUpdate) SetNillableTenantID(v *int) *TicketSLAMetricUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *TicketSLAMetricUpdate) AddTenantID(v int) *TicketSLAMetricUpdate {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *TicketSLAMetricUpdate) SetCreatedAt(v time.Time) *TicketSLAMetricUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *TicketSLAMetricUpdate) SetNillableCreatedAt(v *time.Time) *TicketSLAMetricUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TicketSLAMetricUpdate) SetUpdatedAt(v time.Time) *TicketSLAMetricUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTicket sets the "ticket" edge to the Ticket entity.
+func (_u *TicketSLAMetricUpdate) SetTicket(v *Ticket) *TicketSLAMetricUpdate {
+	return _u.SetTicketID(v.ID)
+}
+
+// Mutation returns the TicketSLAMetricMutation object of the builder.
+func (_u *TicketSLAMetricUpdate) Mutation() *TicketSLAMetricMutation {
+	return _u.mutation
+}
+
+// ClearTicket clears the "ticket" edge to the Ticket entity.
+func (_u *TicketSLAMetricUpdate) ClearTicket() *TicketSLAMetricUpdate {
+	_u.mutation.ClearTicket()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TicketSLAMetricUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TicketSLAMetricUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TicketSLAMetricUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TicketSLAMetricUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TicketSLAMetricUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := ticketslametric.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TicketSLAMetricUpdate) check() error {
+	if v, ok := _u.mutation.TicketID(); ok {
+		if err := ticketslametric.TicketIDValidator(v); err != nil {
+			return &ValidationError{Name: "ticket_id", err: fmt.Errorf(`ent: validator failed for field "TicketSLAMetric.ticket_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PolicyID(); ok {
+		if err := ticketslametric.PolicyIDValidator(v); err != nil {
+			return &ValidationError{Name: "policy_id", err: fmt.Errorf(`ent: validator failed for field "TicketSLAMetric.policy_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MetricKey(); ok {
+		if err := ticketslametric.MetricKeyValidator(v); err != nil {
+			return &ValidationError{Name: "metric_key", err: fmt.Errorf(`ent: validator failed for field "TicketSLAMetric.metric_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TargetMinutes(); ok {
+		if err := ticketslametric.TargetMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "target_minutes", err: fmt.Errorf(`ent: validator failed for field "TicketSLAMetric.target_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := ticketslametric.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "TicketSLAMetric.tenant_id": %w`, err)}
+		}
+	}
+	if _u.mutation.TicketCleared() && len(_u.mutation.TicketIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TicketSLAMetric.ticket"`)
+	}
+	return nil
+}
+
+func (_u *TicketSLAMetricUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ticketslametric.Table, ticketslametric.Columns, sqlgraph.NewFieldSpec(ticketslametric.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PolicyID(); ok {
+		_spec.SetField(ticketslametric.FieldPolicyID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPolicyID(); ok {
+		_spec.AddField(ticketslametric.FieldPolicyID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SLADefinitionID(); ok {
+		_spec.SetField(ticketslametric.FieldSLADefinitionID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSLADefinitionID(); ok {
+		_spec.AddField(ticketslametric.FieldSLADefinitionID, field.TypeInt, value)
+	}
+	if _u.mutation.SLADefinitionIDCleared() {
+		_spec.ClearField(ticketslametric.FieldSLADefinitionID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.AgreementType(); ok {
+		_spec.SetField(ticketslametric.FieldAgreementType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MetricKey(); ok {
+		_spec.SetField(ticketslametric.FieldMetricKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MetricName(); ok {
+		_spec.SetField(ticketslametric.FieldMetricName, field.TypeString, value)
+	}
+	if _u.mutation.MetricNameCleared() {
+		_spec.ClearField(ticketslametric.FieldMetricName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Cycle(); ok {
+		_spec.SetField(ticketslametric.FieldCycle, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCycle(); ok {
+		_spec.AddField(ticketslametric.FieldCycle, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(ticketslametric.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TargetMinutes(); ok {
+		_spec.SetField(ticketslametric.FieldTargetMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTargetMinutes(); ok {
+		_spec.AddField(ticketslametric.FieldTargetMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(ticketslametric.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Deadline(); ok {
+		_spec.SetField(ticketslametric.FieldDeadline, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PausedAt(); ok {
+		_spec.SetField(ticketslametric.FieldPausedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PausedAtCleared() {
+		_spec.ClearField(ticketslametric.FieldPausedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PausedMinutes(); ok {
+		_spec.SetField(ticketslametric.FieldPausedMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPausedMinutes(); ok {
+		_spec.AddField(ticketslametric.FieldPausedMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StoppedAt(); ok {
+		_spec.SetField(ticketslametric.FieldStoppedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StoppedAtCleared() {
+		_spec.ClearField(ticketslametric.FieldStoppedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Breached(); ok {
+		_spec.SetField(ticketslametric.FieldBreached, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.BreachedAt(); ok {
+		_spec.SetField(ticketslametric.FieldBreachedAt, field.TypeTime, value)
+	}
+	if _u.mutation.BreachedAtCleared() {
+		_spec.ClearField(ticketslametric.FieldBreachedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(ticketslametric.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(ticketslametric.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(ticketslametric.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(ticketslametric.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TicketCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ticketslametric.TicketTable,
+			Columns: []string{ticketslametric.TicketColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticket.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TicketIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ticketslametric.TicketTable,
+			Columns: []string{ticketslametric.TicketColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticket.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ticketslametric.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TicketSLAMetricUpdateOne is the builder for updating a single TicketSLAMetric entity.
+type TicketSLAMetricUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TicketSLAMetricMutation
+}
+
+// SetTicketID sets the "ticket_id" field.
+func (_u *TicketSLAMetricUpdateOne) SetTicketID(v int) *TicketSLAMetricUpdateOne {
+	_u.mutation.SetTicketID(v)
+	return _u
+}
+
+// SetNillableTicketID sets the "ticket_id" field if the given value is not nil.
+func (_u *TicketSLAMetricUpdateOne) SetNillableTicketID(v *int) *TicketSLAMetricUpdateOne {
+	if v != nil {
+		_u.SetTicketID(*v)
+	}
+	return _u
+}
+
+// SetPolicyID sets the "policy_id" field.
+func (_u *TicketSLAMetricUpdateOne) SetPolicyID(v int) *TicketSLAMetricUpdateOne {
+	_u.mutation.ResetPolicyID()
+	_u.mutation.SetPolicyID(v)
+	return _u
+}
+
+// SetNillablePolicyID sets the "policy_id" field if the given value is not nil.
+func (_u *TicketSLAMetricUpdateOne) SetNillablePolicyID(v *int) *TicketSLAMetricUpdateOne {
+	if v != nil {
+		_u.SetPolicyID(*v)
+	}
+	return _u
+}
+
+// AddPolicyID adds value to the "policy_id" field.
+func (_u *TicketSLAMetricUpdateOne) AddPolicyID(v int) *TicketSLAMetricUpdateOne {
+	_u.mutation.AddPolicyID(v)
+	return _u
+}
+
+// SetSLADefinitionID sets the "sla_definition_id" field.
+func (_u *TicketSLAMetricUpdateOne) SetSLADefinitionID(v int) *TicketSLAMetricUpdateOne {
+	_u.mutation.ResetSLADefinitionID()
+	_u.mutation.SetSLADefinitionID(v)
+	return _u
+}
+
+// SetNillableSLADefinitionID sets the "sla_definition_id" field if the given value is not nil.
+func (_u *TicketSLAMetricUpdateOne) SetNillableSLADefinitionID(v *int) *TicketSLAMetricUpdateOne {
+	if v != nil {
+		_u.SetSLADefinitionID(*v)
+	}
+	return _u
+}
+
+// AddSLADefinitionID adds value to the "sla_definition_id" field.
+func (_u *TicketSLAMetricUpdateOne) AddSLADefinitionID(v int) *TicketSLAMetricUpdateOne {
+	_u.mutation.AddSLADefinitionID(v)
+	return _u
+}
+
+// ClearSLADefinitionID clears the value of the "sla_definition_id" field.
+func (_u *TicketSLAMetricUpdateOne) ClearSLADefinitionID() *TicketSLAMetricUpdateOne {
+	_u.mutation.ClearSLADefinitionID()
+	return _u
+}
+
+// SetAgreementType sets the "agreement_type" field.
+func (_u *TicketSLAMetricUpdateOne) SetAgreementType(v string) *TicketSLAMetricUpdateOne {
+	_u.mutation.SetAgreementType(v)
+	return _u
+}
+
+// SetNillableAgreementType sets the "agreement_type" field if the given value is not nil.
+func (_u *TicketSLAMetricUpdateOne) SetNillableAgreementType(v *string) *TicketSLAMetricUpdateOne {
+	if v != nil {
+		_u.SetAgreementType(*v)
+	}
+	return _u
+}
+
+// SetMetricKey sets the "metric_key" field.
+func (_u *TicketSLAMetricUpdateOne) SetMetricKey(v string) *TicketSLAMetricUpdateOne {
+	_u.mutation.SetMetricKey(v)
+	return _u
+}
+
+// SetNillableMetricKey sets the "metric_key" field if the given value is not nil.
+func (_u *TicketSLAMetricUpdateOne) SetNillableMetricKey(v *string) *TicketSLAMetricUpdateOne {
+	if v != nil {
+		_u.SetMetricKey(*v)
+	}
+	return _u
+}
+
+// SetMetricName sets the "metric_name" field.
+func (_u *TicketSLAMetricUpdateOne) SetMetricName(v string) *TicketSLAMetricUpdateOne {
+	_u.mutation.SetMetricName(v)
+	return _u
+}
+
+// SetNillableMetricName sets the "metric_name" field if the given value is not nil.
+func (_u *TicketSLAMetricUpdateOne) SetNillableMetricName(v *string) *TicketSLAMetricUpdateOne {
+	if v != nil {
+		_u.SetMetricName(*v)
+	}
+	return _u
+}
+
+// ClearMetricName clears the value of the "metric_name" field.
+func (_u *TicketSLAMetricUpdateOne) ClearMetricName() *TicketSLAMetricUpdateOne {
+	_u.mutation.ClearMetricName()
+	return _u
+}
+
+// SetCycle sets the "cycle" field.
+func (_u *TicketSLAMetricUpdateOne) SetCycle(v int) *TicketSLAMetricUpdateOne {
+	_u.mutation.ResetCycle()
+	_u.mutation.SetCycle(v)
+	return _u
+}
+
+// SetNillableCycle sets the "cycle" field if the given value is not nil.
+func (_u *TicketSLAMetricUpdateOne) SetNillableCycle(v *int) *TicketSLAMetricUpdateOne {
+	if v != nil {
+		_u.SetCycle(*v)
+	}
+	return _u
+}
+
+// AddCycle adds value to the "cycle" field.
+func (_u *TicketSLAMetricUpdateOne) AddCycle(v int) *TicketSLAMetricUpdateOne {
+	_u.mutation.AddCycle(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *TicketSLAMetricUpdateOne) SetStatus(v string) *TicketSLAMetricUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *TicketSLAMetricUpdateOne) SetNillableStatus(v *string) *TicketSLAMetricUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetTargetMinutes sets the "target_minutes" field.
+func (_u *TicketSLAMetricUpdateOne) SetTargetMinutes(v int) *TicketSLAMetricUpdateOne {
+	_u.mutation.ResetTargetMinutes()
+	_u.mutation.SetTargetMinutes(v)
+	return _u
+}
+
+// SetNillableTargetMinutes sets the "target_minutes" field if the given value is not nil.
+func (_u *TicketSLAMetricUpdateOne) SetNillableTargetMinutes(v *int) *TicketSLAMetricUpdateOne {
+	if v != nil {
+		_u.SetTargetMinutes(*v)
+	}
+	return _u
+}
+
+// AddTargetMinutes adds value to the "target_minutes" field.
+func (_u *TicketSLAMetricUpdateOne) AddTargetMinutes(v int) *TicketSLAMetricUpdateOne {
+	_u.mutation.AddTargetMinutes(v)
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *TicketSLAMetricUpdateOne) SetStartedAt(v time.Time) *TicketSLAMetricUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *TicketSLAMetricUpdateOne) SetNillableStartedAt(v *time.Time) *TicketSLAMetricUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// SetDeadline sets the "deadline" field.
+func (_u *TicketSLAMetricUpdateOne) SetDeadline(v time.Time) *TicketSLAMetricUpdateOne {
+	_u.mutation.SetDeadline(v)
+	return _u
+}
+
+// SetNillableDeadline sets the "deadline" field if the given value is not nil.
+func (_u *TicketSLAMetricUpdateOne) SetNillableDeadline(v *time.Time) *TicketSLAMetricUpdateOne {
+	if v != nil {
+		_u.SetDeadline(*v)
+	}
+	return _u
+}
+
+// SetPausedAt sets the "paused_at" field.
+func (_u *TicketSLAMetricUpdateOne) SetPausedAt(v time.Time) *TicketSLAMetricUpdateOne {
+	_u.mutation.SetPausedAt(v)
+	return _u
+}
+
+// SetNillablePausedAt sets the "paused_at" field if the given value is not nil.
+func (_u *TicketSLAMetricUpdateOne) SetNillablePausedAt(v *time.Time) *TicketSLAMetricUpdateOne {
+	if v != nil {
+		_u.SetPausedAt(*v)
+	}
+	return _u
+}
+
+// ClearPausedAt clears the value of the "paused_at" field.
+func (_u *TicketSLAMetricUpdateOne) ClearPausedAt() *TicketSLAMetricUpdateOne {
+	_u.mutation.ClearPausedAt()
+	return _u
+}
+
+// SetPausedMinutes sets the "paused_minutes" field.
+func (_u *TicketSLAMetricUpdateOne) SetPausedMinutes(v int) *TicketSLAMetricUpdateOne {
+	_u.mutation.ResetPausedMinutes()
+	_u.mutation.SetPausedMinutes(v)
+	return _u
+}
+
+// SetNillablePausedMinutes sets the "paused_minutes" field if the given value is not nil.
+func (_u *TicketSLAMetricUpdateOne) SetNillablePausedMinutes(v *int) *TicketSLAMetricUpdateOne {
+	if v != nil {
+		_u.SetPausedMinutes(*v)
+	}
+	return _u
+}
+
+// AddPausedMinutes adds value to the "paused_minutes" field.
+func (_u *TicketSLAMetricUpdateOne) AddPausedMinutes(v int) *TicketSLAMetricUpdateOne {
+	_u.mutation.AddPausedMinutes(v)
+	return _u
+}
+
+// SetStoppedAt sets the "stopped_at" field.
+func (_u *TicketSLAMetricUpdateOne) SetStoppedAt(v time.Time) *TicketSLAMetricUpdateOne {
+	_u.mutation.SetStoppedAt(v)
+	return _u
+}
+
+// SetNillableStoppedAt sets the "stopped_at" field if the given value is not nil.
+func (_u *TicketSLAMetricUpdateOne) SetNillableStoppedAt(v *time.Time) *TicketSLAMetricUpdateOne {
+	if v != nil {
+		_u.SetStoppedAt(*v)
+	}
+	return _u
+}
+
+// ClearStoppedAt clears the value of the "stopped_at" field.
+func (_u *TicketSLAMetricUpdateOne) ClearStoppedAt() *TicketSLAMetricUpdateOne {
+	_u.mutation.ClearStoppedAt()
+	return _u
+}
+
+// SetBreached sets the "breached" field.
+func (_u *TicketSLAMetricUpdateOne) SetBreached(v bool) *TicketSLAMetricUpdateOne {
+	_u.mutation.SetBreached(v)
+	return _u
+}
+
+// SetNillableBreached sets the "breached" field if the given value is not nil.
+func (_u *TicketSLAMetricUpdateOne) SetNillableBreached(v *bool) *TicketSLAMetricUpdateOne {
+	if v != nil {
+		_u.SetBreached(*v)
+	}
+	return _u
+}
+
+// SetBreachedAt sets the "breached_at" field.
+func (_u *TicketSLAMetricUpdateOne) SetBreachedAt(v time.Time) *TicketSLAMetricUpdateOne {
+	_u.mutation.SetBreachedAt(v)
+	return _u
+}
+
+// SetNillableBreachedAt sets the "breached_at" field if the given value is not nil.
+func (_u *TicketSLAMetricUpdateOne) SetNillableBreachedAt(v *time.Time) *TicketSLAMetricUpdateOne {
+	if v != nil {
+		_u.SetBreachedAt(*v)
+	}
+	return _u
+}
+
+// ClearBreachedAt clears the value of the "breached_at" field.
+func (_u *TicketSLAMetricUpdateOne) ClearBreachedAt() *TicketSLAMetricUpdateOne {
+	_u.mutation.ClearBreachedAt()
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *TicketSLAMetricUpdateOne) SetTenantID(v int) *TicketSLAMetricUpdateOne {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *TicketSLAMetricUpdateOne) SetNillableTenantID(v *int) *TicketSLAMetricUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *TicketSLAMetricUpdateOne) AddTenantID(v int) *TicketSLAMetricUpdateOne {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *TicketSLAMetricUpdateOne) SetCreatedAt(v time.Time) *TicketSLAMetricUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *TicketSLAMetricUpdateOne) SetNillableCreatedAt(v *time.Time) *TicketSLAMetricUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TicketSLAMetricUpdateOne) SetUpdatedAt(v time.Time) *TicketSLAMetricUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTicket sets the "ticket" edge to the Ticket entity.
+func (_u *TicketSLAMetricUpdateOne) SetTicket(v *Ticket) *TicketSLAMetricUpdateOne {
+	return _u.SetTicketID(v.ID)
+}
+
+// Mutation returns the TicketSLAMetricMutation object of the builder.
+func (_u *TicketSLAMetricUpdateOne) Mutation() *TicketSLAMetricMutation {
+	return _u.mutation
+}
+
+// ClearTicket clears the "ticket" edge to the Ticket entity.
+func (_u *TicketSLAMetricUpdateOne) ClearTicket() *TicketSLAMetricUpdateOne {
+	_u.mutation.ClearTicket()
+	return _u
+}
+
+// Where appends a list predicates to the TicketSLAMetricUpdate builder.
+func (_u *TicketSLAMetricUpdateOne) Where(ps ...predicate.TicketSLAMetric) *TicketSLAMetricUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TicketSLAMetricUpdateOne) Select(field string, fields ...string) *TicketSLAMetricUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TicketSLAMetric entity.
+func (_u *TicketSLAMetricUpdateOne) Save(ctx context.Context) (*TicketSLAMetric, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TicketSLAMetricUpdateOne) SaveX(ctx context.Context) *TicketSLAMetric {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TicketSLAMetricUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TicketSLAMetricUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TicketSLAMetricUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := ticketslametric.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TicketSLAMetricUpdateOne) check() error {
+	if v, ok := _u.mutation.TicketID(); ok {
+		if err := ticketslametric.TicketIDValidator(v); err != nil {
+			return &ValidationError{Name: "ticket_id", err: fmt.Errorf(`ent: validator failed for field "TicketSLAMetric.ticket_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PolicyID(); ok {
+		if err := ticketslametric.PolicyIDValidator(v); err != nil {
+			return &ValidationError{Name: "policy_id", err: fmt.Errorf(`ent: validator failed for field "TicketSLAMetric.policy_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MetricKey(); ok {
+		if err := ticketslametric.MetricKeyValidator(v); err != nil {
+			return &ValidationError{Name: "metric_key", err: fmt.Errorf(`ent: validator failed for field "TicketSLAMetric.metric_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TargetMinutes(); ok {
+		if err := ticketslametric.TargetMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "target_minutes", err: fmt.Errorf(`ent: validator failed for field "TicketSLAMetric.target_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := ticketslametric.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "TicketSLAMetric.tenant_id": %w`, err)}
+		}
+	}
+	if _u.mutation.TicketCleared() && len(_u.mutation.TicketIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TicketSLAMetric.ticket"`)
+	}
+	return nil
+}
+
+func (_u *TicketSLAMetricUpdateOne) sqlSave(ctx context.Context) (_node *TicketSLAMetric, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ticketslametric.Table, ticketslametric.Columns, sqlgraph.NewFieldSpec(ticketslametric.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TicketSLAMetric.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ticketslametric.FieldID)
+		for _, f := range fields {
+			if !ticketslametric.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ticketslametric.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PolicyID(); ok {
+		_spec.SetField(ticketslametric.FieldPolicyID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPolicyID(); ok {
+		_spec.AddField(ticketslametric.FieldPolicyID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SLADefinitionID(); ok {
+		_spec.SetField(ticketslametric.FieldSLADefinitionID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSLADefinitionID(); ok {
+		_spec.AddField(ticketslametric.FieldSLADefinitionID, field.TypeInt, value)
+	}
+	if _u.mutation.SLADefinitionIDCleared() {
+		_spec.ClearField(ticketslametric.FieldSLADefinitionID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.AgreementType(); ok {
+		_spec.SetField(ticketslametric.FieldAgreementType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MetricKey(); ok {
+		_spec.SetField(ticketslametric.FieldMetricKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MetricName(); ok {
+		_spec.SetField(ticketslametric.FieldMetricName, field.TypeString, value)
+	}
+	if _u.mutation.MetricNameCleared() {
+		_spec.ClearField(ticketslametric.FieldMetricName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Cycle(); ok {
+		_spec.SetField(ticketslametric.FieldCycle, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCycle(); ok {
+		_spec.AddField(ticketslametric.FieldCycle, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(ticketslametric.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TargetMinutes(); ok {
+		_spec.SetField(ticketslametric.FieldTargetMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTargetMinutes(); ok {
+		_spec.AddField(ticketslametric.FieldTargetMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(ticketslametric.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Deadline(); ok {
+		_spec.SetField(ticketslametric.FieldDeadline, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PausedAt(); ok {
+		_spec.SetField(ticketslametric.FieldPausedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PausedAtCleared() {
+		_spec.ClearField(ticketslametric.FieldPausedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PausedMinutes(); ok {
+		_spec.SetField(ticketslametric.FieldPausedMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPausedMinutes(); ok {
+		_spec.AddField(ticketslametric.FieldPausedMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StoppedAt(); ok {
+		_spec.SetField(ticketslametric.FieldStoppedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StoppedAtCleared() {
+		_spec.ClearField(ticketslametric.FieldStoppedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Breached(); ok {
+		_spec.SetField(ticketslametric.FieldBreached, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.BreachedAt(); ok {
+		_spec.SetField(ticketslametric.FieldBreachedAt, field.TypeTime, value)
+	}
+	if _u.mutation.BreachedAtCleared() {
+		_spec.ClearField(ticketslametric.FieldBreachedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(ticketslametric.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(ticketslametric.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(ticketslametric.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(ticketslametric.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TicketCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ticketslametric.TicketTable,
+			Columns: []string{ticketslametric.TicketColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticket.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TicketIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ticketslametric.TicketTable,
+			Columns: []string{ticketslametric.TicketColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticket.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TicketSLAMetric{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ticketslametric.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
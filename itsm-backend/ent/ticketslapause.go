@@ -0,0 +1,293 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"itsm-backend/ent/ticket"
+	"itsm-backend/ent/ticketslapause"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// TicketSLAPause is the model entity for the TicketSLAPause schema.
+type TicketSLAPause struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 工单ID
+	TicketID int `json:"ticket_id,omitempty"`
+	// 暂停时生效的SLA定义ID
+	SLADefinitionID int `json:"sla_definition_id,omitempty"`
+	// 暂停原因: status/on_hold
+	Reason string `json:"reason,omitempty"`
+	// 暂停时的工单状态
+	TicketStatus string `json:"ticket_status,omitempty"`
+	// 暂停时间
+	PausedAt time.Time `json:"paused_at,omitempty"`
+	// 恢复时间，为空表示仍在暂停中
+	ResumedAt *time.Time `json:"resumed_at,omitempty"`
+	// 暂停期间的工作分钟数，恢复时计算
+	PausedMinutes int `json:"paused_minutes,omitempty"`
+	// 顺延前的响应截止时间
+	ResponseDeadlineBefore *time.Time `json:"response_deadline_before,omitempty"`
+	// 顺延后的响应截止时间
+	ResponseDeadlineAfter *time.Time `json:"response_deadline_after,omitempty"`
+	// 顺延前的解决截止时间
+	ResolutionDeadlineBefore *time.Time `json:"resolution_deadline_before,omitempty"`
+	// 顺延后的解决截止时间
+	ResolutionDeadlineAfter *time.Time `json:"resolution_deadline_after,omitempty"`
+	// 租户ID
+	TenantID int `json:"tenant_id,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TicketSLAPauseQuery when eager-loading is set.
+	Edges        TicketSLAPauseEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TicketSLAPauseEdges holds the relations/edges for other nodes in the graph.
+type TicketSLAPauseEdges struct {
+	// Ticket holds the value of the ticket edge.
+	Ticket *Ticket `json:"ticket,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TicketOrErr returns the Ticket value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TicketSLAPauseEdges) TicketOrErr() (*Ticket, error) {
+	if e.Ticket != nil {
+		return e.Ticket, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: ticket.Label}
+	}
+	return nil, &NotLoadedError{edge: "ticket"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TicketSLAPause) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ticketslapause.FieldID, ticketslapause.FieldTicketID, ticketslapause.FieldSLADefinitionID, ticketslapause.FieldPausedMinutes, ticketslapause.FieldTenantID:
+			values[i] = new(sql.NullInt64)
+		case ticketslapause.FieldReason, ticketslapause.FieldTicketStatus:
+			values[i] = new(sql.NullString)
+		case ticketslapause.FieldPausedAt, ticketslapause.FieldResumedAt, ticketslapause.FieldResponseDeadlineBefore, ticketslapause.FieldResponseDeadlineAfter, ticketslapause.FieldResolutionDeadlineBefore, ticketslapause.FieldResolutionDeadlineAfter, ticketslapause.FieldCreatedAt, ticketslapause.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TicketSLAPause fields.
+func (_m *TicketSLAPause) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ticketslapause.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case ticketslapause.FieldTicketID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field ticket_id", values[i])
+			} else if value.Valid {
+				_m.TicketID = int(value.Int64)
+			}
+		case ticketslapause.FieldSLADefinitionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sla_definition_id", values[i])
+			} else if value.Valid {
+				_m.SLADefinitionID = int(value.Int64)
+			}
+		case ticketslapause.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case ticketslapause.FieldTicketStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ticket_status", values[i])
+			} else if value.Valid {
+				_m.TicketStatus = value.String
+			}
+		case ticketslapause.FieldPausedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field paused_at", values[i])
+			} else if value.Valid {
+				_m.PausedAt = value.Time
+			}
+		case ticketslapause.FieldResumedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resumed_at", values[i])
+			} else if value.Valid {
+				_m.ResumedAt = new(time.Time)
+				*_m.ResumedAt = value.Time
+			}
+		case ticketslapause.FieldPausedMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field paused_minutes", values[i])
+			} else if value.Valid {
+				_m.PausedMinutes = int(value.Int64)
+			}
+		case ticketslapause.FieldResponseDeadlineBefore:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field response_deadline_before", values[i])
+			} else if value.Valid {
+				_m.ResponseDeadlineBefore = new(time.Time)
+				*_m.ResponseDeadlineBefore = value.Time
+			}
+		case ticketslapause.FieldResponseDeadlineAfter:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field response_deadline_after", values[i])
+			} else if value.Valid {
+				_m.ResponseDeadlineAfter = new(time.Time)
+				*_m.ResponseDeadlineAfter = value.Time
+			}
+		case ticketslapause.FieldResolutionDeadlineBefore:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolution_deadline_before", values[i])
+			} else if value.Valid {
+				_m.ResolutionDeadlineBefore = new(time.Time)
+				*_m.ResolutionDeadlineBefore = value.Time
+			}
+		case ticketslapause.FieldResolutionDeadlineAfter:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolution_deadline_after", values[i])
+			} else if value.Valid {
+				_m.ResolutionDeadlineAfter = new(time.Time)
+				*_m.ResolutionDeadlineAfter = value.Time
+			}
+		case ticketslapause.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = int(value.Int64)
+			}
+		case ticketslapause.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case ticketslapause.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TicketSLAPause.
+// This includes values selected through modifiers, order, etc.
+func (_m *TicketSLAPause) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTicket queries the "ticket" edge of the TicketSLAPause entity.
+func (_m *TicketSLAPause) QueryTicket() *TicketQuery {
+	return NewTicketSLAPauseClient(_m.config).QueryTicket(_m)
+}
+
+// Update returns a builder for updating this TicketSLAPause.
+// Note that you need to call TicketSLAPause.Unwrap() before calling this method if this TicketSLAPause
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TicketSLAPause) Update() *TicketSLAPauseUpdateOne {
+	return NewTicketSLAPauseClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TicketSLAPause entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TicketSLAPause) Unwrap() *TicketSLAPause {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TicketSLAPause is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TicketSLAPause) String() string {
+	var builder strings.Builder
+	builder.WriteString("TicketSLAPause(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("ticket_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TicketID))
+	builder.WriteString(", ")
+	builder.WriteString("sla_definition_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SLADefinitionID))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("ticket_status=")
+	builder.WriteString(_m.TicketStatus)
+	builder.WriteString(", ")
+	builder.WriteString("paused_at=")
+	builder.WriteString(_m.PausedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ResumedAt; v != nil {
+		builder.WriteString("resumed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("paused_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PausedMinutes))
+	builder.WriteString(", ")
+	if v := _m.ResponseDeadlineBefore; v != nil {
+		builder.WriteString("response_deadline_before=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ResponseDeadlineAfter; v != nil {
+		builder.WriteString("response_deadline_after=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ResolutionDeadlineBefore; v != nil {
+		builder.WriteString("resolution_deadline_before=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ResolutionDeadlineAfter; v != nil {
+		builder.WriteString("resolution_deadline_after=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TicketSLAPauses is a parsable slice of TicketSLAPause.
+type TicketSLAPauses []*TicketSLAPause
@@ -0,0 +1,194 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ticketslapause
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the ticketslapause type in the database.
+	Label = "ticket_sla_pause"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTicketID holds the string denoting the ticket_id field in the database.
+	FieldTicketID = "ticket_id"
+	// FieldSLADefinitionID holds the string denoting the sla_definition_id field in the database.
+	FieldSLADefinitionID = "sla_definition_id"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldTicketStatus holds the string denoting the ticket_status field in the database.
+	FieldTicketStatus = "ticket_status"
+	// FieldPausedAt holds the string denoting the paused_at field in the database.
+	FieldPausedAt = "paused_at"
+	// FieldResumedAt holds the string denoting the resumed_at field in the database.
+	FieldResumedAt = "resumed_at"
+	// FieldPausedMinutes holds the string denoting the paused_minutes field in the database.
+	FieldPausedMinutes = "paused_minutes"
+	// FieldResponseDeadlineBefore holds the string denoting the response_deadline_before field in the database.
+	FieldResponseDeadlineBefore = "response_deadline_before"
+	// FieldResponseDeadlineAfter holds the string denoting the response_deadline_after field in the database.
+	FieldResponseDeadlineAfter = "response_deadline_after"
+	// FieldResolutionDeadlineBefore holds the string denoting the resolution_deadline_before field in the database.
+	FieldResolutionDeadlineBefore = "resolution_deadline_before"
+	// FieldResolutionDeadlineAfter holds the string denoting the resolution_deadline_after field in the database.
+	FieldResolutionDeadlineAfter = "resolution_deadline_after"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeTicket holds the string denoting the ticket edge name in mutations.
+	EdgeTicket = "ticket"
+	// Table holds the table name of the ticketslapause in the database.
+	Table = "ticket_sla_pauses"
+	// TicketTable is the table that holds the ticket relation/edge.
+	TicketTable = "ticket_sla_pauses"
+	// TicketInverseTable is the table name for the Ticket entity.
+	// It exists in this package in order to avoid circular dependency with the "ticket" package.
+	TicketInverseTable = "tickets"
+	// TicketColumn is the table column denoting the ticket relation/edge.
+	TicketColumn = "ticket_id"
+)
+
+// Columns holds all SQL columns for ticketslapause fields.
+var Columns = []string{
+	FieldID,
+	FieldTicketID,
+	FieldSLADefinitionID,
+	FieldReason,
+	FieldTicketStatus,
+	FieldPausedAt,
+	FieldResumedAt,
+	FieldPausedMinutes,
+	FieldResponseDeadlineBefore,
+	FieldResponseDeadlineAfter,
+	FieldResolutionDeadlineBefore,
+	FieldResolutionDeadlineAfter,
+	FieldTenantID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TicketIDValidator is a validator for the "ticket_id" field. It is called by the builders before save.
+	TicketIDValidator func(int) error
+	// ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	ReasonValidator func(string) error
+	// DefaultPausedMinutes holds the default value on creation for the "paused_minutes" field.
+	DefaultPausedMinutes int
+	// TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	TenantIDValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the TicketSLAPause queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTicketID orders the results by the ticket_id field.
+func ByTicketID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTicketID, opts...).ToFunc()
+}
+
+// BySLADefinitionID orders the results by the sla_definition_id field.
+func BySLADefinitionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSLADefinitionID, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByTicketStatus orders the results by the ticket_status field.
+func ByTicketStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTicketStatus, opts...).ToFunc()
+}
+
+// ByPausedAt orders the results by the paused_at field.
+func ByPausedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPausedAt, opts...).ToFunc()
+}
+
+// ByResumedAt orders the results by the resumed_at field.
+func ByResumedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResumedAt, opts...).ToFunc()
+}
+
+// ByPausedMinutes orders the results by the paused_minutes field.
+func ByPausedMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPausedMinutes, opts...).ToFunc()
+}
+
+// ByResponseDeadlineBefore orders the results by the response_deadline_before field.
+func ByResponseDeadlineBefore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResponseDeadlineBefore, opts...).ToFunc()
+}
+
+// ByResponseDeadlineAfter orders the results by the response_deadline_after field.
+func ByResponseDeadlineAfter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResponseDeadlineAfter, opts...).ToFunc()
+}
+
+// ByResolutionDeadlineBefore orders the results by the resolution_deadline_before field.
+func ByResolutionDeadlineBefore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolutionDeadlineBefore, opts...).ToFunc()
+}
+
+// ByResolutionDeadlineAfter orders the results by the resolution_deadline_after field.
+func ByResolutionDeadlineAfter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolutionDeadlineAfter, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByTicketField orders the results by ticket field.
+func ByTicketField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTicketStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newTicketStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TicketInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TicketTable, TicketColumn),
+	)
+}
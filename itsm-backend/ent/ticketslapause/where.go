@@ -0,0 +1,824 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ticketslapause
+
+import (
+	"itsm-backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldLTE(FieldID, id))
+}
+
+// TicketID applies equality check predicate on the "ticket_id" field. It's identical to TicketIDEQ.
+func TicketID(v int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldEQ(FieldTicketID, v))
+}
+
+// SLADefinitionID applies equality check predicate on the "sla_definition_id" field. It's identical to SLADefinitionIDEQ.
+func SLADefinitionID(v int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldEQ(FieldSLADefinitionID, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldEQ(FieldReason, v))
+}
+
+// TicketStatus applies equality check predicate on the "ticket_status" field. It's identical to TicketStatusEQ.
+func TicketStatus(v string) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldEQ(FieldTicketStatus, v))
+}
+
+// PausedAt applies equality check predicate on the "paused_at" field. It's identical to PausedAtEQ.
+func PausedAt(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldEQ(FieldPausedAt, v))
+}
+
+// ResumedAt applies equality check predicate on the "resumed_at" field. It's identical to ResumedAtEQ.
+func ResumedAt(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldEQ(FieldResumedAt, v))
+}
+
+// PausedMinutes applies equality check predicate on the "paused_minutes" field. It's identical to PausedMinutesEQ.
+func PausedMinutes(v int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldEQ(FieldPausedMinutes, v))
+}
+
+// ResponseDeadlineBefore applies equality check predicate on the "response_deadline_before" field. It's identical to ResponseDeadlineBeforeEQ.
+func ResponseDeadlineBefore(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldEQ(FieldResponseDeadlineBefore, v))
+}
+
+// ResponseDeadlineAfter applies equality check predicate on the "response_deadline_after" field. It's identical to ResponseDeadlineAfterEQ.
+func ResponseDeadlineAfter(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldEQ(FieldResponseDeadlineAfter, v))
+}
+
+// ResolutionDeadlineBefore applies equality check predicate on the "resolution_deadline_before" field. It's identical to ResolutionDeadlineBeforeEQ.
+func ResolutionDeadlineBefore(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldEQ(FieldResolutionDeadlineBefore, v))
+}
+
+// ResolutionDeadlineAfter applies equality check predicate on the "resolution_deadline_after" field. It's identical to ResolutionDeadlineAfterEQ.
+func ResolutionDeadlineAfter(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldEQ(FieldResolutionDeadlineAfter, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldEQ(FieldTenantID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TicketIDEQ applies the EQ predicate on the "ticket_id" field.
+func TicketIDEQ(v int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldEQ(FieldTicketID, v))
+}
+
+// TicketIDNEQ applies the NEQ predicate on the "ticket_id" field.
+func TicketIDNEQ(v int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNEQ(FieldTicketID, v))
+}
+
+// TicketIDIn applies the In predicate on the "ticket_id" field.
+func TicketIDIn(vs ...int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldIn(FieldTicketID, vs...))
+}
+
+// TicketIDNotIn applies the NotIn predicate on the "ticket_id" field.
+func TicketIDNotIn(vs ...int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNotIn(FieldTicketID, vs...))
+}
+
+// SLADefinitionIDEQ applies the EQ predicate on the "sla_definition_id" field.
+func SLADefinitionIDEQ(v int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldEQ(FieldSLADefinitionID, v))
+}
+
+// SLADefinitionIDNEQ applies the NEQ predicate on the "sla_definition_id" field.
+func SLADefinitionIDNEQ(v int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNEQ(FieldSLADefinitionID, v))
+}
+
+// SLADefinitionIDIn applies the In predicate on the "sla_definition_id" field.
+func SLADefinitionIDIn(vs ...int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldIn(FieldSLADefinitionID, vs...))
+}
+
+// SLADefinitionIDNotIn applies the NotIn predicate on the "sla_definition_id" field.
+func SLADefinitionIDNotIn(vs ...int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNotIn(FieldSLADefinitionID, vs...))
+}
+
+// SLADefinitionIDGT applies the GT predicate on the "sla_definition_id" field.
+func SLADefinitionIDGT(v int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldGT(FieldSLADefinitionID, v))
+}
+
+// SLADefinitionIDGTE applies the GTE predicate on the "sla_definition_id" field.
+func SLADefinitionIDGTE(v int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldGTE(FieldSLADefinitionID, v))
+}
+
+// SLADefinitionIDLT applies the LT predicate on the "sla_definition_id" field.
+func SLADefinitionIDLT(v int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldLT(FieldSLADefinitionID, v))
+}
+
+// SLADefinitionIDLTE applies the LTE predicate on the "sla_definition_id" field.
+func SLADefinitionIDLTE(v int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldLTE(FieldSLADefinitionID, v))
+}
+
+// SLADefinitionIDIsNil applies the IsNil predicate on the "sla_definition_id" field.
+func SLADefinitionIDIsNil() predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldIsNull(FieldSLADefinitionID))
+}
+
+// SLADefinitionIDNotNil applies the NotNil predicate on the "sla_definition_id" field.
+func SLADefinitionIDNotNil() predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNotNull(FieldSLADefinitionID))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldContainsFold(FieldReason, v))
+}
+
+// TicketStatusEQ applies the EQ predicate on the "ticket_status" field.
+func TicketStatusEQ(v string) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldEQ(FieldTicketStatus, v))
+}
+
+// TicketStatusNEQ applies the NEQ predicate on the "ticket_status" field.
+func TicketStatusNEQ(v string) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNEQ(FieldTicketStatus, v))
+}
+
+// TicketStatusIn applies the In predicate on the "ticket_status" field.
+func TicketStatusIn(vs ...string) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldIn(FieldTicketStatus, vs...))
+}
+
+// TicketStatusNotIn applies the NotIn predicate on the "ticket_status" field.
+func TicketStatusNotIn(vs ...string) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNotIn(FieldTicketStatus, vs...))
+}
+
+// TicketStatusGT applies the GT predicate on the "ticket_status" field.
+func TicketStatusGT(v string) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldGT(FieldTicketStatus, v))
+}
+
+// TicketStatusGTE applies the GTE predicate on the "ticket_status" field.
+func TicketStatusGTE(v string) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldGTE(FieldTicketStatus, v))
+}
+
+// TicketStatusLT applies the LT predicate on the "ticket_status" field.
+func TicketStatusLT(v string) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldLT(FieldTicketStatus, v))
+}
+
+// TicketStatusLTE applies the LTE predicate on the "ticket_status" field.
+func TicketStatusLTE(v string) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldLTE(FieldTicketStatus, v))
+}
+
+// TicketStatusContains applies the Contains predicate on the "ticket_status" field.
+func TicketStatusContains(v string) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldContains(FieldTicketStatus, v))
+}
+
+// TicketStatusHasPrefix applies the HasPrefix predicate on the "ticket_status" field.
+func TicketStatusHasPrefix(v string) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldHasPrefix(FieldTicketStatus, v))
+}
+
+// TicketStatusHasSuffix applies the HasSuffix predicate on the "ticket_status" field.
+func TicketStatusHasSuffix(v string) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldHasSuffix(FieldTicketStatus, v))
+}
+
+// TicketStatusIsNil applies the IsNil predicate on the "ticket_status" field.
+func TicketStatusIsNil() predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldIsNull(FieldTicketStatus))
+}
+
+// TicketStatusNotNil applies the NotNil predicate on the "ticket_status" field.
+func TicketStatusNotNil() predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNotNull(FieldTicketStatus))
+}
+
+// TicketStatusEqualFold applies the EqualFold predicate on the "ticket_status" field.
+func TicketStatusEqualFold(v string) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldEqualFold(FieldTicketStatus, v))
+}
+
+// TicketStatusContainsFold applies the ContainsFold predicate on the "ticket_status" field.
+func TicketStatusContainsFold(v string) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldContainsFold(FieldTicketStatus, v))
+}
+
+// PausedAtEQ applies the EQ predicate on the "paused_at" field.
+func PausedAtEQ(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldEQ(FieldPausedAt, v))
+}
+
+// PausedAtNEQ applies the NEQ predicate on the "paused_at" field.
+func PausedAtNEQ(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNEQ(FieldPausedAt, v))
+}
+
+// PausedAtIn applies the In predicate on the "paused_at" field.
+func PausedAtIn(vs ...time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldIn(FieldPausedAt, vs...))
+}
+
+// PausedAtNotIn applies the NotIn predicate on the "paused_at" field.
+func PausedAtNotIn(vs ...time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNotIn(FieldPausedAt, vs...))
+}
+
+// PausedAtGT applies the GT predicate on the "paused_at" field.
+func PausedAtGT(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldGT(FieldPausedAt, v))
+}
+
+// PausedAtGTE applies the GTE predicate on the "paused_at" field.
+func PausedAtGTE(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldGTE(FieldPausedAt, v))
+}
+
+// PausedAtLT applies the LT predicate on the "paused_at" field.
+func PausedAtLT(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldLT(FieldPausedAt, v))
+}
+
+// PausedAtLTE applies the LTE predicate on the "paused_at" field.
+func PausedAtLTE(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldLTE(FieldPausedAt, v))
+}
+
+// ResumedAtEQ applies the EQ predicate on the "resumed_at" field.
+func ResumedAtEQ(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldEQ(FieldResumedAt, v))
+}
+
+// ResumedAtNEQ applies the NEQ predicate on the "resumed_at" field.
+func ResumedAtNEQ(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNEQ(FieldResumedAt, v))
+}
+
+// ResumedAtIn applies the In predicate on the "resumed_at" field.
+func ResumedAtIn(vs ...time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldIn(FieldResumedAt, vs...))
+}
+
+// ResumedAtNotIn applies the NotIn predicate on the "resumed_at" field.
+func ResumedAtNotIn(vs ...time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNotIn(FieldResumedAt, vs...))
+}
+
+// ResumedAtGT applies the GT predicate on the "resumed_at" field.
+func ResumedAtGT(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldGT(FieldResumedAt, v))
+}
+
+// ResumedAtGTE applies the GTE predicate on the "resumed_at" field.
+func ResumedAtGTE(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldGTE(FieldResumedAt, v))
+}
+
+// ResumedAtLT applies the LT predicate on the "resumed_at" field.
+func ResumedAtLT(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldLT(FieldResumedAt, v))
+}
+
+// ResumedAtLTE applies the LTE predicate on the "resumed_at" field.
+func ResumedAtLTE(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldLTE(FieldResumedAt, v))
+}
+
+// ResumedAtIsNil applies the IsNil predicate on the "resumed_at" field.
+func ResumedAtIsNil() predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldIsNull(FieldResumedAt))
+}
+
+// ResumedAtNotNil applies the NotNil predicate on the "resumed_at" field.
+func ResumedAtNotNil() predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNotNull(FieldResumedAt))
+}
+
+// PausedMinutesEQ applies the EQ predicate on the "paused_minutes" field.
+func PausedMinutesEQ(v int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldEQ(FieldPausedMinutes, v))
+}
+
+// PausedMinutesNEQ applies the NEQ predicate on the "paused_minutes" field.
+func PausedMinutesNEQ(v int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNEQ(FieldPausedMinutes, v))
+}
+
+// PausedMinutesIn applies the In predicate on the "paused_minutes" field.
+func PausedMinutesIn(vs ...int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldIn(FieldPausedMinutes, vs...))
+}
+
+// PausedMinutesNotIn applies the NotIn predicate on the "paused_minutes" field.
+func PausedMinutesNotIn(vs ...int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNotIn(FieldPausedMinutes, vs...))
+}
+
+// PausedMinutesGT applies the GT predicate on the "paused_minutes" field.
+func PausedMinutesGT(v int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldGT(FieldPausedMinutes, v))
+}
+
+// PausedMinutesGTE applies the GTE predicate on the "paused_minutes" field.
+func PausedMinutesGTE(v int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldGTE(FieldPausedMinutes, v))
+}
+
+// PausedMinutesLT applies the LT predicate on the "paused_minutes" field.
+func PausedMinutesLT(v int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldLT(FieldPausedMinutes, v))
+}
+
+// PausedMinutesLTE applies the LTE predicate on the "paused_minutes" field.
+func PausedMinutesLTE(v int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldLTE(FieldPausedMinutes, v))
+}
+
+// ResponseDeadlineBeforeEQ applies the EQ predicate on the "response_deadline_before" field.
+func ResponseDeadlineBeforeEQ(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldEQ(FieldResponseDeadlineBefore, v))
+}
+
+// ResponseDeadlineBeforeNEQ applies the NEQ predicate on the "response_deadline_before" field.
+func ResponseDeadlineBeforeNEQ(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNEQ(FieldResponseDeadlineBefore, v))
+}
+
+// ResponseDeadlineBeforeIn applies the In predicate on the "response_deadline_before" field.
+func ResponseDeadlineBeforeIn(vs ...time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldIn(FieldResponseDeadlineBefore, vs...))
+}
+
+// ResponseDeadlineBeforeNotIn applies the NotIn predicate on the "response_deadline_before" field.
+func ResponseDeadlineBeforeNotIn(vs ...time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNotIn(FieldResponseDeadlineBefore, vs...))
+}
+
+// ResponseDeadlineBeforeGT applies the GT predicate on the "response_deadline_before" field.
+func ResponseDeadlineBeforeGT(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldGT(FieldResponseDeadlineBefore, v))
+}
+
+// ResponseDeadlineBeforeGTE applies the GTE predicate on the "response_deadline_before" field.
+func ResponseDeadlineBeforeGTE(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldGTE(FieldResponseDeadlineBefore, v))
+}
+
+// ResponseDeadlineBeforeLT applies the LT predicate on the "response_deadline_before" field.
+func ResponseDeadlineBeforeLT(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldLT(FieldResponseDeadlineBefore, v))
+}
+
+// ResponseDeadlineBeforeLTE applies the LTE predicate on the "response_deadline_before" field.
+func ResponseDeadlineBeforeLTE(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldLTE(FieldResponseDeadlineBefore, v))
+}
+
+// ResponseDeadlineBeforeIsNil applies the IsNil predicate on the "response_deadline_before" field.
+func ResponseDeadlineBeforeIsNil() predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldIsNull(FieldResponseDeadlineBefore))
+}
+
+// ResponseDeadlineBeforeNotNil applies the NotNil predicate on the "response_deadline_before" field.
+func ResponseDeadlineBeforeNotNil() predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNotNull(FieldResponseDeadlineBefore))
+}
+
+// ResponseDeadlineAfterEQ applies the EQ predicate on the "response_deadline_after" field.
+func ResponseDeadlineAfterEQ(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldEQ(FieldResponseDeadlineAfter, v))
+}
+
+// ResponseDeadlineAfterNEQ applies the NEQ predicate on the "response_deadline_after" field.
+func ResponseDeadlineAfterNEQ(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNEQ(FieldResponseDeadlineAfter, v))
+}
+
+// ResponseDeadlineAfterIn applies the In predicate on the "response_deadline_after" field.
+func ResponseDeadlineAfterIn(vs ...time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldIn(FieldResponseDeadlineAfter, vs...))
+}
+
+// ResponseDeadlineAfterNotIn applies the NotIn predicate on the "response_deadline_after" field.
+func ResponseDeadlineAfterNotIn(vs ...time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNotIn(FieldResponseDeadlineAfter, vs...))
+}
+
+// ResponseDeadlineAfterGT applies the GT predicate on the "response_deadline_after" field.
+func ResponseDeadlineAfterGT(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldGT(FieldResponseDeadlineAfter, v))
+}
+
+// ResponseDeadlineAfterGTE applies the GTE predicate on the "response_deadline_after" field.
+func ResponseDeadlineAfterGTE(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldGTE(FieldResponseDeadlineAfter, v))
+}
+
+// ResponseDeadlineAfterLT applies the LT predicate on the "response_deadline_after" field.
+func ResponseDeadlineAfterLT(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldLT(FieldResponseDeadlineAfter, v))
+}
+
+// ResponseDeadlineAfterLTE applies the LTE predicate on the "response_deadline_after" field.
+func ResponseDeadlineAfterLTE(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldLTE(FieldResponseDeadlineAfter, v))
+}
+
+// ResponseDeadlineAfterIsNil applies the IsNil predicate on the "response_deadline_after" field.
+func ResponseDeadlineAfterIsNil() predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldIsNull(FieldResponseDeadlineAfter))
+}
+
+// ResponseDeadlineAfterNotNil applies the NotNil predicate on the "response_deadline_after" field.
+func ResponseDeadlineAfterNotNil() predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNotNull(FieldResponseDeadlineAfter))
+}
+
+// ResolutionDeadlineBeforeEQ applies the EQ predicate on the "resolution_deadline_before" field.
+func ResolutionDeadlineBeforeEQ(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldEQ(FieldResolutionDeadlineBefore, v))
+}
+
+// ResolutionDeadlineBeforeNEQ applies the NEQ predicate on the "resolution_deadline_before" field.
+func ResolutionDeadlineBeforeNEQ(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNEQ(FieldResolutionDeadlineBefore, v))
+}
+
+// ResolutionDeadlineBeforeIn applies the In predicate on the "resolution_deadline_before" field.
+func ResolutionDeadlineBeforeIn(vs ...time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldIn(FieldResolutionDeadlineBefore, vs...))
+}
+
+// ResolutionDeadlineBeforeNotIn applies the NotIn predicate on the "resolution_deadline_before" field.
+func ResolutionDeadlineBeforeNotIn(vs ...time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNotIn(FieldResolutionDeadlineBefore, vs...))
+}
+
+// ResolutionDeadlineBeforeGT applies the GT predicate on the "resolution_deadline_before" field.
+func ResolutionDeadlineBeforeGT(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldGT(FieldResolutionDeadlineBefore, v))
+}
+
+// ResolutionDeadlineBeforeGTE applies the GTE predicate on the "resolution_deadline_before" field.
+func ResolutionDeadlineBeforeGTE(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldGTE(FieldResolutionDeadlineBefore, v))
+}
+
+// ResolutionDeadlineBeforeLT applies the LT predicate on the "resolution_deadline_before" field.
+func ResolutionDeadlineBeforeLT(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldLT(FieldResolutionDeadlineBefore, v))
+}
+
+// ResolutionDeadlineBeforeLTE applies the LTE predicate on the "resolution_deadline_before" field.
+func ResolutionDeadlineBeforeLTE(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldLTE(FieldResolutionDeadlineBefore, v))
+}
+
+// ResolutionDeadlineBeforeIsNil applies the IsNil predicate on the "resolution_deadline_before" field.
+func ResolutionDeadlineBeforeIsNil() predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldIsNull(FieldResolutionDeadlineBefore))
+}
+
+// ResolutionDeadlineBeforeNotNil applies the NotNil predicate on the "resolution_deadline_before" field.
+func ResolutionDeadlineBeforeNotNil() predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNotNull(FieldResolutionDeadlineBefore))
+}
+
+// ResolutionDeadlineAfterEQ applies the EQ predicate on the "resolution_deadline_after" field.
+func ResolutionDeadlineAfterEQ(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldEQ(FieldResolutionDeadlineAfter, v))
+}
+
+// ResolutionDeadlineAfterNEQ applies the NEQ predicate on the "resolution_deadline_after" field.
+func ResolutionDeadlineAfterNEQ(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNEQ(FieldResolutionDeadlineAfter, v))
+}
+
+// ResolutionDeadlineAfterIn applies the In predicate on the "resolution_deadline_after" field.
+func ResolutionDeadlineAfterIn(vs ...time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldIn(FieldResolutionDeadlineAfter, vs...))
+}
+
+// ResolutionDeadlineAfterNotIn applies the NotIn predicate on the "resolution_deadline_after" field.
+func ResolutionDeadlineAfterNotIn(vs ...time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNotIn(FieldResolutionDeadlineAfter, vs...))
+}
+
+// ResolutionDeadlineAfterGT applies the GT predicate on the "resolution_deadline_after" field.
+func ResolutionDeadlineAfterGT(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldGT(FieldResolutionDeadlineAfter, v))
+}
+
+// ResolutionDeadlineAfterGTE applies the GTE predicate on the "resolution_deadline_after" field.
+func ResolutionDeadlineAfterGTE(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldGTE(FieldResolutionDeadlineAfter, v))
+}
+
+// ResolutionDeadlineAfterLT applies the LT predicate on the "resolution_deadline_after" field.
+func ResolutionDeadlineAfterLT(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldLT(FieldResolutionDeadlineAfter, v))
+}
+
+// ResolutionDeadlineAfterLTE applies the LTE predicate on the "resolution_deadline_after" field.
+func ResolutionDeadlineAfterLTE(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldLTE(FieldResolutionDeadlineAfter, v))
+}
+
+// ResolutionDeadlineAfterIsNil applies the IsNil predicate on the "resolution_deadline_after" field.
+func ResolutionDeadlineAfterIsNil() predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldIsNull(FieldResolutionDeadlineAfter))
+}
+
+// ResolutionDeadlineAfterNotNil applies the NotNil predicate on the "resolution_deadline_after" field.
+func ResolutionDeadlineAfterNotNil() predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNotNull(FieldResolutionDeadlineAfter))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldLTE(FieldTenantID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasTicket applies the HasEdge predicate on the "ticket" edge.
+func HasTicket() predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TicketTable, TicketColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTicketWith applies the HasEdge predicate on the "ticket" edge with a given conditions (other predicates).
+func HasTicketWith(preds ...predicate.Ticket) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(func(s *sql.Selector) {
+		step := newTicketStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TicketSLAPause) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TicketSLAPause) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TicketSLAPause) predicate.TicketSLAPause {
+	return predicate.TicketSLAPause(sql.NotPredicates(p))
+}
@@ -0,0 +1,467 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/ticket"
+	"itsm-backend/ent/ticketslapause"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TicketSLAPauseCreate is the builder for creating a TicketSLAPause entity.
+type TicketSLAPauseCreate struct {
+	config
+	mutation *TicketSLAPauseMutation
+	hooks    []Hook
+}
+
+// SetTicketID sets the "ticket_id" field.
+func (_c *TicketSLAPauseCreate) SetTicketID(v int) *TicketSLAPauseCreate {
+	_c.mutation.SetTicketID(v)
+	return _c
+}
+
+// SetSLADefinitionID sets the "sla_definition_id" field.
+func (_c *TicketSLAPauseCreate) SetSLADefinitionID(v int) *TicketSLAPauseCreate {
+	_c.mutation.SetSLADefinitionID(v)
+	return _c
+}
+
+// SetNillableSLADefinitionID sets the "sla_definition_id" field if the given value is not nil.
+func (_c *TicketSLAPauseCreate) SetNillableSLADefinitionID(v *int) *TicketSLAPauseCreate {
+	if v != nil {
+		_c.SetSLADefinitionID(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *TicketSLAPauseCreate) SetReason(v string) *TicketSLAPauseCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetTicketStatus sets the "ticket_status" field.
+func (_c *TicketSLAPauseCreate) SetTicketStatus(v string) *TicketSLAPauseCreate {
+	_c.mutation.SetTicketStatus(v)
+	return _c
+}
+
+// SetNillableTicketStatus sets the "ticket_status" field if the given value is not nil.
+func (_c *TicketSLAPauseCreate) SetNillableTicketStatus(v *string) *TicketSLAPauseCreate {
+	if v != nil {
+		_c.SetTicketStatus(*v)
+	}
+	return _c
+}
+
+// SetPausedAt sets the "paused_at" field.
+func (_c *TicketSLAPauseCreate) SetPausedAt(v time.Time) *TicketSLAPauseCreate {
+	_c.mutation.SetPausedAt(v)
+	return _c
+}
+
+// SetResumedAt sets the "resumed_at" field.
+func (_c *TicketSLAPauseCreate) SetResumedAt(v time.Time) *TicketSLAPauseCreate {
+	_c.mutation.SetResumedAt(v)
+	return _c
+}
+
+// SetNillableResumedAt sets the "resumed_at" field if the given value is not nil.
+func (_c *TicketSLAPauseCreate) SetNillableResumedAt(v *time.Time) *TicketSLAPauseCreate {
+	if v != nil {
+		_c.SetResumedAt(*v)
+	}
+	return _c
+}
+
+// SetPausedMinutes sets the "paused_minutes" field.
+func (_c *TicketSLAPauseCreate) SetPausedMinutes(v int) *TicketSLAPauseCreate {
+	_c.mutation.SetPausedMinutes(v)
+	return _c
+}
+
+// SetNillablePausedMinutes sets the "paused_minutes" field if the given value is not nil.
+func (_c *TicketSLAPauseCreate) SetNillablePausedMinutes(v *int) *TicketSLAPauseCreate {
+	if v != nil {
+		_c.SetPausedMinutes(*v)
+	}
+	return _c
+}
+
+// SetResponseDeadlineBefore sets the "response_deadline_before" field.
+func (_c *TicketSLAPauseCreate) SetResponseDeadlineBefore(v time.Time) *TicketSLAPauseCreate {
+	_c.mutation.SetResponseDeadlineBefore(v)
+	return _c
+}
+
+// SetNillableResponseDeadlineBefore sets the "response_deadline_before" field if the given value is not nil.
+func (_c *TicketSLAPauseCreate) SetNillableResponseDeadlineBefore(v *time.Time) *TicketSLAPauseCreate {
+	if v != nil {
+		_c.SetResponseDeadlineBefore(*v)
+	}
+	return _c
+}
+
+// SetResponseDeadlineAfter sets the "response_deadline_after" field.
+func (_c *TicketSLAPauseCreate) SetResponseDeadlineAfter(v time.Time) *TicketSLAPauseCreate {
+	_c.mutation.SetResponseDeadlineAfter(v)
+	return _c
+}
+
+// SetNillableResponseDeadlineAfter sets the "response_deadline_after" field if the given value is not nil.
+func (_c *TicketSLAPauseCreate) SetNillableResponseDeadlineAfter(v *time.Time) *TicketSLAPauseCreate {
+	if v != nil {
+		_c.SetResponseDeadlineAfter(*v)
+	}
+	return _c
+}
+
+// SetResolutionDeadlineBefore sets the "resolution_deadline_before" field.
+func (_c *TicketSLAPauseCreate) SetResolutionDeadlineBefore(v time.Time) *TicketSLAPauseCreate {
+	_c.mutation.SetResolutionDeadlineBefore(v)
+	return _c
+}
+
+// SetNillableResolutionDeadlineBefore sets the "resolution_deadline_before" field if the given value is not nil.
+func (_c *TicketSLAPauseCreate) SetNillableResolutionDeadlineBefore(v *time.Time) *TicketSLAPauseCreate {
+	if v != nil {
+		_c.SetResolutionDeadlineBefore(*v)
+	}
+	return _c
+}
+
+// SetResolutionDeadlineAfter sets the "resolution_deadline_after" field.
+func (_c *TicketSLAPauseCreate) SetResolutionDeadlineAfter(v time.Time) *TicketSLAPauseCreate {
+	_c.mutation.SetResolutionDeadlineAfter(v)
+	return _c
+}
+
+// SetNillableResolutionDeadlineAfter sets the "resolution_deadline_after" field if the given value is not nil.
+func (_c *TicketSLAPauseCreate) SetNillableResolutionDeadlineAfter(v *time.Time) *TicketSLAPauseCreate {
+	if v != nil {
+		_c.SetResolutionDeadlineAfter(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *TicketSLAPauseCreate) SetTenantID(v int) *TicketSLAPauseCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TicketSLAPauseCreate) SetCreatedAt(v time.Time) *TicketSLAPauseCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TicketSLAPauseCreate) SetNillableCreatedAt(v *time.Time) *TicketSLAPauseCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *TicketSLAPauseCreate) SetUpdatedAt(v time.Time) *TicketSLAPauseCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *TicketSLAPauseCreate) SetNillableUpdatedAt(v *time.Time) *TicketSLAPauseCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetTicket sets the "ticket" edge to the Ticket entity.
+func (_c *TicketSLAPauseCreate) SetTicket(v *Ticket) *TicketSLAPauseCreate {
+	return _c.SetTicketID(v.ID)
+}
+
+// Mutation returns the TicketSLAPauseMutation object of the builder.
+func (_c *TicketSLAPauseCreate) Mutation() *TicketSLAPauseMutation {
+	return _c.mutation
+}
+
+// Save creates the TicketSLAPause in the database.
+func (_c *TicketSLAPauseCreate) Save(ctx context.Context) (*TicketSLAPause, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TicketSLAPauseCreate) SaveX(ctx context.Context) *TicketSLAPause {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TicketSLAPauseCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TicketSLAPauseCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TicketSLAPauseCreate) defaults() {
+	if _, ok := _c.mutation.PausedMinutes(); !ok {
+		v := ticketslapause.DefaultPausedMinutes
+		_c.mutation.SetPausedMinutes(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := ticketslapause.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := ticketslapause.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TicketSLAPauseCreate) check() error {
+	if _, ok := _c.mutation.TicketID(); !ok {
+		return &ValidationError{Name: "ticket_id", err: errors.New(`ent: missing required field "TicketSLAPause.ticket_id"`)}
+	}
+	if v, ok := _c.mutation.TicketID(); ok {
+		if err := ticketslapause.TicketIDValidator(v); err != nil {
+			return &ValidationError{Name: "ticket_id", err: fmt.Errorf(`ent: validator failed for field "TicketSLAPause.ticket_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "TicketSLAPause.reason"`)}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := ticketslapause.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "TicketSLAPause.reason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PausedAt(); !ok {
+		return &ValidationError{Name: "paused_at", err: errors.New(`ent: missing required field "TicketSLAPause.paused_at"`)}
+	}
+	if _, ok := _c.mutation.PausedMinutes(); !ok {
+		return &ValidationError{Name: "paused_minutes", err: errors.New(`ent: missing required field "TicketSLAPause.paused_minutes"`)}
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "TicketSLAPause.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := ticketslapause.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "TicketSLAPause.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TicketSLAPause.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TicketSLAPause.updated_at"`)}
+	}
+	if len(_c.mutation.TicketIDs()) == 0 {
+		return &ValidationError{Name: "ticket", err: errors.New(`ent: missing required edge "TicketSLAPause.ticket"`)}
+	}
+	return nil
+}
+
+func (_c *TicketSLAPauseCreate) sqlSave(ctx context.Context) (*TicketSLAPause, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TicketSLAPauseCreate) createSpec() (*TicketSLAPause, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TicketSLAPause{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(ticketslapause.Table, sqlgraph.NewFieldSpec(ticketslapause.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.SLADefinitionID(); ok {
+		_spec.SetField(ticketslapause.FieldSLADefinitionID, field.TypeInt, value)
+		_node.SLADefinitionID = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(ticketslapause.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.TicketStatus(); ok {
+		_spec.SetField(ticketslapause.FieldTicketStatus, field.TypeString, value)
+		_node.TicketStatus = value
+	}
+	if value, ok := _c.mutation.PausedAt(); ok {
+		_spec.SetField(ticketslapause.FieldPausedAt, field.TypeTime, value)
+		_node.PausedAt = value
+	}
+	if value, ok := _c.mutation.ResumedAt(); ok {
+		_spec.SetField(ticketslapause.FieldResumedAt, field.TypeTime, value)
+		_node.ResumedAt = &value
+	}
+	if value, ok := _c.mutation.PausedMinutes(); ok {
+		_spec.SetField(ticketslapause.FieldPausedMinutes, field.TypeInt, value)
+		_node.PausedMinutes = value
+	}
+	if value, ok := _c.mutation.ResponseDeadlineBefore(); ok {
+		_spec.SetField(ticketslapause.FieldResponseDeadlineBefore, field.TypeTime, value)
+		_node.ResponseDeadlineBefore = &value
+	}
+	if value, ok := _c.mutation.ResponseDeadlineAfter(); ok {
+		_spec.SetField(ticketslapause.FieldResponseDeadlineAfter, field.TypeTime, value)
+		_node.ResponseDeadlineAfter = &value
+	}
+	if value, ok := _c.mutation.ResolutionDeadlineBefore(); ok {
+		_spec.SetField(ticketslapause.FieldResolutionDeadlineBefore, field.TypeTime, value)
+		_node.ResolutionDeadlineBefore = &value
+	}
+	if value, ok := _c.mutation.ResolutionDeadlineAfter(); ok {
+		_spec.SetField(ticketslapause.FieldResolutionDeadlineAfter, field.TypeTime, value)
+		_node.ResolutionDeadlineAfter = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(ticketslapause.FieldTenantID, field.TypeInt, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(ticketslapause.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(ticketslapause.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.TicketIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ticketslapause.TicketTable,
+			Columns: []string{ticketslapause.TicketColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticket.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TicketID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TicketSLAPauseCreateBulk is the builder for creating many TicketSLAPause entities in bulk.
+type TicketSLAPauseCreateBulk struct {
+	config
+	err      error
+	builders []*TicketSLAPauseCreate
+}
+
+// Save creates the TicketSLAPause entities in the database.
+func (_c *TicketSLAPauseCreateBulk) Save(ctx context.Context) ([]*TicketSLAPause, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TicketSLAPause, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TicketSLAPauseMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TicketSLAPauseCreateBulk) SaveX(ctx context.Context) []*TicketSLAPause {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TicketSLAPauseCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TicketSLAPauseCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,967 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/predicate"
+	"itsm-backend/ent/ticket"
+	"itsm-backend/ent/ticketslapause"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TicketSLAPauseUpdate is the builder for updating TicketSLAPause entities.
+type TicketSLAPauseUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TicketSLAPauseMutation
+}
+
+// Where appends a list predicates to the TicketSLAPauseUpdate builder.
+func (_u *TicketSLAPauseUpdate) Where(ps ...predicate.TicketSLAPause) *TicketSLAPauseUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTicketID sets the "ticket_id" field.
+func (_u *TicketSLAPauseUpdate) SetTicketID(v int) *TicketSLAPauseUpdate {
+	_u.mutation.SetTicketID(v)
+	return _u
+}
+
+// SetNillableTicketID sets the "ticket_id" field if the given value is not nil.
+func (_u *TicketSLAPauseUpdate) SetNillableTicketID(v *int) *TicketSLAPauseUpdate {
+	if v != nil {
+		_u.SetTicketID(*v)
+	}
+	return _u
+}
+
+// SetSLADefinitionID sets the "sla_definition_id" field.
+func (_u *TicketSLAPauseUpdate) SetSLADefinitionID(v int) *TicketSLAPauseUpdate {
+	_u.mutation.ResetSLADefinitionID()
+	_u.mutation.SetSLADefinitionID(v)
+	return _u
+}
+
+// SetNillableSLADefinitionID sets the "sla_definition_id" field if the given value is not nil.
+func (_u *TicketSLAPauseUpdate) SetNillableSLADefinitionID(v *int) *TicketSLAPauseUpdate {
+	if v != nil {
+		_u.SetSLADefinitionID(*v)
+	}
+	return _u
+}
+
+// AddSLADefinitionID adds value to the "sla_definition_id" field.
+func (_u *TicketSLAPauseUpdate) AddSLADefinitionID(v int) *TicketSLAPauseUpdate {
+	_u.mutation.AddSLADefinitionID(v)
+	return _u
+}
+
+// ClearSLADefinitionID clears the value of the "sla_definition_id" field.
+func (_u *TicketSLAPauseUpdate) ClearSLADefinitionID() *TicketSLAPauseUpdate {
+	_u.mutation.ClearSLADefinitionID()
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *TicketSLAPauseUpdate) SetReason(v string) *TicketSLAPauseUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *TicketSLAPauseUpdate) SetNillableReason(v *string) *TicketSLAPauseUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetTicketStatus sets the "ticket_status" field.
+func (_u *TicketSLAPauseUpdate) SetTicketStatus(v string) *TicketSLAPauseUpdate {
+	_u.mutation.SetTicketStatus(v)
+	return _u
+}
+
+// SetNillableTicketStatus sets the "ticket_status" field if the given value is not nil.
+func (_u *TicketSLAPauseUpdate) SetNillableTicketStatus(v *string) *TicketSLAPauseUpdate {
+	if v != nil {
+		_u.SetTicketStatus(*v)
+	}
+	return _u
+}
+
+// ClearTicketStatus clears the value of the "ticket_status" field.
+func (_u *TicketSLAPauseUpdate) ClearTicketStatus() *TicketSLAPauseUpdate {
+	_u.mutation.ClearTicketStatus()
+	return _u
+}
+
+// SetPausedAt sets the "paused_at" field.
+func (_u *TicketSLAPauseUpdate) SetPausedAt(v time.Time) *TicketSLAPauseUpdate {
+	_u.mutation.SetPausedAt(v)
+	return _u
+}
+
+// SetNillablePausedAt sets the "paused_at" field if the given value is not nil.
+func (_u *TicketSLAPauseUpdate) SetNillablePausedAt(v *time.Time) *TicketSLAPauseUpdate {
+	if v != nil {
+		_u.SetPausedAt(*v)
+	}
+	return _u
+}
+
+// SetResumedAt sets the "resumed_at" field.
+func (_u *TicketSLAPauseUpdate) SetResumedAt(v time.Time) *TicketSLAPauseUpdate {
+	_u.mutation.SetResumedAt(v)
+	return _u
+}
+
+// SetNillableResumedAt sets the "resumed_at" field if the given value is not nil.
+func (_u *TicketSLAPauseUpdate) SetNillableResumedAt(v *time.Time) *TicketSLAPauseUpdate {
+	if v != nil {
+		_u.SetResumedAt(*v)
+	}
+	return _u
+}
+
+// ClearResumedAt clears the value of the "resumed_at" field.
+func (_u *TicketSLAPauseUpdate) ClearResumedAt() *TicketSLAPauseUpdate {
+	_u.mutation.ClearResumedAt()
+	return _u
+}
+
+// SetPausedMinutes sets the "paused_minutes" field.
+func (_u *TicketSLAPauseUpdate) SetPausedMinutes(v int) *TicketSLAPauseUpdate {
+	_u.mutation.ResetPausedMinutes()
+	_u.mutation.SetPausedMinutes(v)
+	return _u
+}
+
+// SetNillablePausedMinutes sets the "paused_minutes" field if the given value is not nil.
+func (_u *TicketSLAPauseUpdate) SetNillablePausedMinutes(v *int) *TicketSLAPauseUpdate {
+	if v != nil {
+		_u.SetPausedMinutes(*v)
+	}
+	return _u
+}
+
+// AddPausedMinutes adds value to the "paused_minutes" field.
+func (_u *TicketSLAPauseUpdate) AddPausedMinutes(v int) *TicketSLAPauseUpdate {
+	_u.mutation.AddPausedMinutes(v)
+	return _u
+}
+
+// SetResponseDeadlineBefore sets the "response_deadline_before" field.
+func (_u *TicketSLAPauseUpdate) SetResponseDeadlineBefore(v time.Time) *TicketSLAPauseUpdate {
+	_u.mutation.SetResponseDeadlineBefore(v)
+	return _u
+}
+
+// SetNillableResponseDeadlineBefore sets the "response_deadline_before" field if the given value is not nil.
+func (_u *TicketSLAPauseUpdate) SetNillableResponseDeadlineBefore(v *time.Time) *TicketSLAPauseUpdate {
+	if v != nil {
+		_u.SetResponseDeadlineBefore(*v)
+	}
+	return _u
+}
+
+// ClearResponseDeadlineBefore clears the value of the "response_deadline_before" field.
+func (_u *TicketSLAPauseUpdate) ClearResponseDeadlineBefore() *TicketSLAPauseUpdate {
+	_u.mutation.ClearResponseDeadlineBefore()
+	return _u
+}
+
+// SetResponseDeadlineAfter sets the "response_deadline_after" field.
+func (_u *TicketSLAPauseUpdate) SetResponseDeadlineAfter(v time.Time) *TicketSLAPauseUpdate {
+	_u.mutation.SetResponseDeadlineAfter(v)
+	return _u
+}
+
+// SetNillableResponseDeadlineAfter sets the "response_deadline_after" field if the given value is not nil.
+func (_u *TicketSLAPauseUpdate) SetNillableResponseDeadlineAfter(v *time.Time) *TicketSLAPauseUpdate {
+	if v != nil {
+		_u.SetResponseDeadlineAfter(*v)
+	}
+	return _u
+}
+
+// ClearResponseDeadlineAfter clears the value of the "response_deadline_after" field.
+func (_u *TicketSLAPauseUpdate) ClearResponseDeadlineAfter() *TicketSLAPauseUpdate {
+	_u.mutation.ClearResponseDeadlineAfter()
+	return _u
+}
+
+// SetResolutionDeadlineBefore sets the "resolution_deadline_before" field.
+func (_u *TicketSLAPauseUpdate) SetResolutionDeadlineBefore(v time.Time) *TicketSLAPauseUpdate {
+	_u.mutation.SetResolutionDeadlineBefore(v)
+	return _u
+}
+
+// SetNillableResolutionDeadlineBefore sets the "resolution_deadline_before" field if the given value is not nil.
+func (_u *TicketSLAPauseUpdate) SetNillableResolutionDeadlineBefore(v *time.Time) *TicketSLAPauseUpdate {
+	if v != nil {
+		_u.SetResolutionDeadlineBefore(*v)
+	}
+	return _u
+}
+
+// ClearResolutionDeadlineBefore clears the value of the "resolution_deadline_before" field.
+func (_u *TicketSLAPauseUpdate) ClearResolutionDeadlineBefore() *TicketSLAPauseUpdate {
+	_u.mutation.ClearResolutionDeadlineBefore()
+	return _u
+}
+
+// SetResolutionDeadlineAfter sets the "resolution_deadline_after" field.
+func (_u *TicketSLAPauseUpdate) SetResolutionDeadlineAfter(v time.Time) *TicketSLAPauseUpdate {
+	_u.mutation.SetResolutionDeadlineAfter(v)
+	return _u
+}
+
+// SetNillableResolutionDeadlineAfter sets the "resolution_deadline_after" field if the given value is not nil.
+func (_u *TicketSLAPauseUpdate) SetNillableResolutionDeadlineAfter(v *time.Time) *TicketSLAPauseUpdate {
+	if v != nil {
+		_u.SetResolutionDeadlineAfter(*v)
+	}
+	return _u
+}
+
+// ClearResolutionDeadlineAfter clears the value of the "resolution_deadline_after" field.
+func (_u *TicketSLAPauseUpdate) ClearResolutionDeadlineAfter() *TicketSLAPauseUpdate {
+	_u.mutation.ClearResolutionDeadlineAfter()
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *TicketSLAPauseUpdate) SetTenantID(v int) *TicketSLAPauseUpdate {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *TicketSLAPauseUpdate) SetNillableTenantID(v *int) *TicketSLAPauseUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *TicketSLAPauseUpdate) AddTenantID(v int) *TicketSLAPauseUpdate {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *TicketSLAPauseUpdate) SetCreatedAt(v time.Time) *TicketSLAPauseUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *TicketSLAPauseUpdate) SetNillableCreatedAt(v *time.Time) *TicketSLAPauseUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TicketSLAPauseUpdate) SetUpdatedAt(v time.Time) *TicketSLAPauseUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTicket sets the "ticket" edge to the Ticket entity.
+func (_u *TicketSLAPauseUpdate) SetTicket(v *Ticket) *TicketSLAPauseUpdate {
+	return _u.SetTicketID(v.ID)
+}
+
+// Mutation returns the TicketSLAPauseMutation object of the builder.
+func (_u *TicketSLAPauseUpdate) Mutation() *TicketSLAPauseMutation {
+	return _u.mutation
+}
+
+// ClearTicket clears the "ticket" edge to the Ticket entity.
+func (_u *TicketSLAPauseUpdate) ClearTicket() *TicketSLAPauseUpdate {
+	_u.mutation.ClearTicket()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TicketSLAPauseUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TicketSLAPauseUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TicketSLAPauseUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TicketSLAPauseUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TicketSLAPauseUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := ticketslapause.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TicketSLAPauseUpdate) check() error {
+	if v, ok := _u.mutation.TicketID(); ok {
+		if err := ticketslapause.TicketIDValidator(v); err != nil {
+			return &ValidationError{Name: "ticket_id", err: fmt.Errorf(`ent: validator failed for field "TicketSLAPause.ticket_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := ticketslapause.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "TicketSLAPause.reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := ticketslapause.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "TicketSLAPause.tenant_id": %w`, err)}
+		}
+	}
+	if _u.mutation.TicketCleared() && len(_u.mutation.TicketIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TicketSLAPause.ticket"`)
+	}
+	return nil
+}
+
+func (_u *TicketSLAPauseUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ticketslapause.Table, ticketslapause.Columns, sqlgraph.NewFieldSpec(ticketslapause.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.SLADefinitionID(); ok {
+		_spec.SetField(ticketslapause.FieldSLADefinitionID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSLADefinitionID(); ok {
+		_spec.AddField(ticketslapause.FieldSLADefinitionID, field.TypeInt, value)
+	}
+	if _u.mutation.SLADefinitionIDCleared() {
+		_spec.ClearField(ticketslapause.FieldSLADefinitionID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(ticketslapause.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TicketStatus(); ok {
+		_spec.SetField(ticketslapause.FieldTicketStatus, field.TypeString, value)
+	}
+	if _u.mutation.TicketStatusCleared() {
+		_spec.ClearField(ticketslapause.FieldTicketStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.PausedAt(); ok {
+		_spec.SetField(ticketslapause.FieldPausedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResumedAt(); ok {
+		_spec.SetField(ticketslapause.FieldResumedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResumedAtCleared() {
+		_spec.ClearField(ticketslapause.FieldResumedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PausedMinutes(); ok {
+		_spec.SetField(ticketslapause.FieldPausedMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPausedMinutes(); ok {
+		_spec.AddField(ticketslapause.FieldPausedMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ResponseDeadlineBefore(); ok {
+		_spec.SetField(ticketslapause.FieldResponseDeadlineBefore, field.TypeTime, value)
+	}
+	if _u.mutation.ResponseDeadlineBeforeCleared() {
+		_spec.ClearField(ticketslapause.FieldResponseDeadlineBefore, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ResponseDeadlineAfter(); ok {
+		_spec.SetField(ticketslapause.FieldResponseDeadlineAfter, field.TypeTime, value)
+	}
+	if _u.mutation.ResponseDeadlineAfterCleared() {
+		_spec.ClearField(ticketslapause.FieldResponseDeadlineAfter, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ResolutionDeadlineBefore(); ok {
+		_spec.SetField(ticketslapause.FieldResolutionDeadlineBefore, field.TypeTime, value)
+	}
+	if _u.mutation.ResolutionDeadlineBeforeCleared() {
+		_spec.ClearField(ticketslapause.FieldResolutionDeadlineBefore, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ResolutionDeadlineAfter(); ok {
+		_spec.SetField(ticketslapause.FieldResolutionDeadlineAfter, field.TypeTime, value)
+	}
+	if _u.mutation.ResolutionDeadlineAfterCleared() {
+		_spec.ClearField(ticketslapause.FieldResolutionDeadlineAfter, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(ticketslapause.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(ticketslapause.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(ticketslapause.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(ticketslapause.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TicketCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ticketslapause.TicketTable,
+			Columns: []string{ticketslapause.TicketColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticket.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TicketIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ticketslapause.TicketTable,
+			Columns: []string{ticketslapause.TicketColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticket.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ticketslapause.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TicketSLAPauseUpdateOne is the builder for updating a single TicketSLAPause entity.
+type TicketSLAPauseUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TicketSLAPauseMutation
+}
+
+// SetTicketID sets the "ticket_id" field.
+func (_u *TicketSLAPauseUpdateOne) SetTicketID(v int) *TicketSLAPauseUpdateOne {
+	_u.mutation.SetTicketID(v)
+	return _u
+}
+
+// SetNillableTicketID sets the "ticket_id" field if the given value is not nil.
+func (_u *TicketSLAPauseUpdateOne) SetNillableTicketID(v *int) *TicketSLAPauseUpdateOne {
+	if v != nil {
+		_u.SetTicketID(*v)
+	}
+	return _u
+}
+
+// SetSLADefinitionID sets the "sla_definition_id" field.
+func (_u *TicketSLAPauseUpdateOne) SetSLADefinitionID(v int) *TicketSLAPauseUpdateOne {
+	_u.mutation.ResetSLADefinitionID()
+	_u.mutation.SetSLADefinitionID(v)
+	return _u
+}
+
+// SetNillableSLADefinitionID sets the "sla_definition_id" field if the given value is not nil.
+func (_u *TicketSLAPauseUpdateOne) SetNillableSLADefinitionID(v *int) *TicketSLAPauseUpdateOne {
+	if v != nil {
+		_u.SetSLADefinitionID(*v)
+	}
+	return _u
+}
+
+// AddSLADefinitionID adds value to the "sla_definition_id" field.
+func (_u *TicketSLAPauseUpdateOne) AddSLADefinitionID(v int) *TicketSLAPauseUpdateOne {
+	_u.mutation.AddSLADefinitionID(v)
+	return _u
+}
+
+// ClearSLADefinitionID clears the value of the "sla_definition_id" field.
+func (_u *TicketSLAPauseUpdateOne) ClearSLADefinitionID() *TicketSLAPauseUpdateOne {
+	_u.mutation.ClearSLADefinitionID()
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *TicketSLAPauseUpdateOne) SetReason(v string) *TicketSLAPauseUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *TicketSLAPauseUpdateOne) SetNillableReason(v *string) *TicketSLAPauseUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetTicketStatus sets the "ticket_status" field.
+func (_u *TicketSLAPauseUpdateOne) SetTicketStatus(v string) *TicketSLAPauseUpdateOne {
+	_u.mutation.SetTicketStatus(v)
+	return _u
+}
+
+// SetNillableTicketStatus sets the "ticket_status" field if the given value is not nil.
+func (_u *TicketSLAPauseUpdateOne) SetNillableTicketStatus(v *string) *TicketSLAPauseUpdateOne {
+	if v != nil {
+		_u.SetTicketStatus(*v)
+	}
+	return _u
+}
+
+// ClearTicketStatus clears the value of the "ticket_status" field.
+func (_u *TicketSLAPauseUpdateOne) ClearTicketStatus() *TicketSLAPauseUpdateOne {
+	_u.mutation.ClearTicketStatus()
+	return _u
+}
+
+// SetPausedAt sets the "paused_at" field.
+func (_u *TicketSLAPauseUpdateOne) SetPausedAt(v time.Time) *TicketSLAPauseUpdateOne {
+	_u.mutation.SetPausedAt(v)
+	return _u
+}
+
+// SetNillablePausedAt sets the "paused_at" field if the given value is not nil.
+func (_u *TicketSLAPauseUpdateOne) SetNillablePausedAt(v *time.Time) *TicketSLAPauseUpdateOne {
+	if v != nil {
+		_u.SetPausedAt(*v)
+	}
+	return _u
+}
+
+// SetResumedAt sets the "resumed_at" field.
+func (_u *TicketSLAPauseUpdateOne) SetResumedAt(v time.Time) *TicketSLAPauseUpdateOne {
+	_u.mutation.SetResumedAt(v)
+	return _u
+}
+
+// SetNillableResumedAt sets the "resumed_at" field if the given value is not nil.
+func (_u *TicketSLAPauseUpdateOne) SetNillableResumedAt(v *time.Time) *TicketSLAPauseUpdateOne {
+	if v != nil {
+		_u.SetResumedAt(*v)
+	}
+	return _u
+}
+
+// ClearResumedAt clears the value of the "resumed_at" field.
+func (_u *TicketSLAPauseUpdateOne) ClearResumedAt() *TicketSLAPauseUpdateOne {
+	_u.mutation.ClearResumedAt()
+	return _u
+}
+
+// SetPausedMinutes sets the "paused_minutes" field.
+func (_u *TicketSLAPauseUpdateOne) SetPausedMinutes(v int) *TicketSLAPauseUpdateOne {
+	_u.mutation.ResetPausedMinutes()
+	_u.mutation.SetPausedMinutes(v)
+	return _u
+}
+
+// SetNillablePausedMinutes sets the "paused_minutes" field if the given value is not nil.
+func (_u *TicketSLAPauseUpdateOne) SetNillablePausedMinutes(v *int) *TicketSLAPauseUpdateOne {
+	if v != nil {
+		_u.SetPausedMinutes(*v)
+	}
+	return _u
+}
+
+// AddPausedMinutes adds value to the "paused_minutes" field.
+func (_u *TicketSLAPauseUpdateOne) AddPausedMinutes(v int) *TicketSLAPauseUpdateOne {
+	_u.mutation.AddPausedMinutes(v)
+	return _u
+}
+
+// SetResponseDeadlineBefore sets the "response_deadline_before" field.
+func (_u *TicketSLAPauseUpdateOne) SetResponseDeadlineBefore(v time.Time) *TicketSLAPauseUpdateOne {
+	_u.mutation.SetResponseDeadlineBefore(v)
+	return _u
+}
+
+// SetNillableResponseDeadlineBefore sets the "response_deadline_before" field if the given value is not nil.
+func (_u *TicketSLAPauseUpdateOne) SetNillableResponseDeadlineBefore(v *time.Time) *TicketSLAPauseUpdateOne {
+	if v != nil {
+		_u.SetResponseDeadlineBefore(*v)
+	}
+	return _u
+}
+
+// ClearResponseDeadlineBefore clears the value of the "response_deadline_before" field.
+func (_u *TicketSLAPauseUpdateOne) ClearResponseDeadlineBefore() *TicketSLAPauseUpdateOne {
+	_u.mutation.ClearResponseDeadlineBefore()
+	return _u
+}
+
+// SetResponseDeadlineAfter sets the "response_deadline_after" field.
+func (_u *TicketSLAPauseUpdateOne) SetResponseDeadlineAfter(v time.Time) *TicketSLAPauseUpdateOne {
+	_u.mutation.SetResponseDeadlineAfter(v)
+	return _u
+}
+
+// SetNillableResponseDeadlineAfter sets the "response_deadline_after" field if the given value is not nil.
+func (_u *TicketSLAPauseUpdateOne) SetNillableResponseDeadlineAfter(v *time.Time) *TicketSLAPauseUpdateOne {
+	if v != nil {
+		_u.SetResponseDeadlineAfter(*v)
+	}
+	return _u
+}
+
+// ClearResponseDeadlineAfter clears the value of the "response_deadline_after" field.
+func (_u *TicketSLAPauseUpdateOne) ClearResponseDeadlineAfter() *TicketSLAPauseUpdateOne {
+	_u.mutation.ClearResponseDeadlineAfter()
+	return _u
+}
+
+// SetResolutionDeadlineBefore sets the "resolution_deadline_before" field.
+func (_u *TicketSLAPauseUpdateOne) SetResolutionDeadlineBefore(v time.Time) *TicketSLAPauseUpdateOne {
+	_u.mutation.SetResolutionDeadlineBefore(v)
+	return _u
+}
+
+// SetNillableResolutionDeadlineBefore sets the "resolution_deadline_before" field if the given value is not nil.
+func (_u *TicketSLAPauseUpdateOne) SetNillableResolutionDeadlineBefore(v *time.Time) *TicketSLAPauseUpdateOne {
+	if v != nil {
+		_u.SetResolutionDeadlineBefore(*v)
+	}
+	return _u
+}
+
+// ClearResolutionDeadlineBefore clears the value of the "resolution_deadline_before" field.
+func (_u *TicketSLAPauseUpdateOne) ClearResolutionDeadlineBefore() *TicketSLAPauseUpdateOne {
+	_u.mutation.ClearResolutionDeadlineBefore()
+	return _u
+}
+
+// SetResolutionDeadlineAfter sets the "resolution_deadline_after" field.
+func (_u *TicketSLAPauseUpdateOne) SetResolutionDeadlineAfter(v time.Time) *TicketSLAPauseUpdateOne {
+	_u.mutation.SetResolutionDeadlineAfter(v)
+	return _u
+}
+
+// SetNillableResolutionDeadlineAfter sets the "resolution_deadline_after" field if the given value is not nil.
+func (_u *TicketSLAPauseUpdateOne) SetNillableResolutionDeadlineAfter(v *time.Time) *TicketSLAPauseUpdateOne {
+	if v != nil {
+		_u.SetResolutionDeadlineAfter(*v)
+	}
+	return _u
+}
+
+// ClearResolutionDeadlineAfter clears the value of the "resolution_deadline_after" field.
+func (_u *TicketSLAPauseUpdateOne) ClearResolutionDeadlineAfter() *TicketSLAPauseUpdateOne {
+	_u.mutation.ClearResolutionDeadlineAfter()
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *TicketSLAPauseUpdateOne) SetTenantID(v int) *TicketSLAPauseUpdateOne {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *TicketSLAPauseUpdateOne) SetNillableTenantID(v *int) *TicketSLAPauseUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *TicketSLAPauseUpdateOne) AddTenantID(v int) *TicketSLAPauseUpdateOne {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *TicketSLAPauseUpdateOne) SetCreatedAt(v time.Time) *TicketSLAPauseUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *TicketSLAPauseUpdateOne) SetNillableCreatedAt(v *time.Time) *TicketSLAPauseUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TicketSLAPauseUpdateOne) SetUpdatedAt(v time.Time) *TicketSLAPauseUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTicket sets the "ticket" edge to the Ticket entity.
+func (_u *TicketSLAPauseUpdateOne) SetTicket(v *Ticket) *TicketSLAPauseUpdateOne {
+	return _u.SetTicketID(v.ID)
+}
+
+// Mutation returns the TicketSLAPauseMutation object of the builder.
+func (_u *TicketSLAPauseUpdateOne) Mutation() *TicketSLAPauseMutation {
+	return _u.mutation
+}
+
+// ClearTicket clears the "ticket" edge to the Ticket entity.
+func (_u *TicketSLAPauseUpdateOne) ClearTicket() *TicketSLAPauseUpdateOne {
+	_u.mutation.ClearTicket()
+	return _u
+}
+
+// Where appends a list predicates to the TicketSLAPauseUpdate builder.
+func (_u *TicketSLAPauseUpdateOne) Where(ps ...predicate.TicketSLAPause) *TicketSLAPauseUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TicketSLAPauseUpdateOne) Select(field string, fields ...string) *TicketSLAPauseUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TicketSLAPause entity.
+func (_u *TicketSLAPauseUpdateOne) Save(ctx context.Context) (*TicketSLAPause, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TicketSLAPauseUpdateOne) SaveX(ctx context.Context) *TicketSLAPause {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TicketSLAPauseUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TicketSLAPauseUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TicketSLAPauseUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := ticketslapause.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TicketSLAPauseUpdateOne) check() error {
+	if v, ok := _u.mutation.TicketID(); ok {
+		if err := ticketslapause.TicketIDValidator(v); err != nil {
+			return &ValidationError{Name: "ticket_id", err: fmt.Errorf(`ent: validator failed for field "TicketSLAPause.ticket_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := ticketslapause.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "TicketSLAPause.reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := ticketslapause.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "TicketSLAPause.tenant_id": %w`, err)}
+		}
+	}
+	if _u.mutation.TicketCleared() && len(_u.mutation.TicketIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TicketSLAPause.ticket"`)
+	}
+	return nil
+}
+
+func (_u *TicketSLAPauseUpdateOne) sqlSave(ctx context.Context) (_node *TicketSLAPause, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ticketslapause.Table, ticketslapause.Columns, sqlgraph.NewFieldSpec(ticketslapause.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TicketSLAPause.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ticketslapause.FieldID)
+		for _, f := range fields {
+			if !ticketslapause.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ticketslapause.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.SLADefinitionID(); ok {
+		_spec.SetField(ticketslapause.FieldSLADefinitionID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSLADefinitionID(); ok {
+		_spec.AddField(ticketslapause.FieldSLADefinitionID, field.TypeInt, value)
+	}
+	if _u.mutation.SLADefinitionIDCleared() {
+		_spec.ClearField(ticketslapause.FieldSLADefinitionID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(ticketslapause.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TicketStatus(); ok {
+		_spec.SetField(ticketslapause.FieldTicketStatus, field.TypeString, value)
+	}
+	if _u.mutation.TicketStatusCleared() {
+		_spec.ClearField(ticketslapause.FieldTicketStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.PausedAt(); ok {
+		_spec.SetField(ticketslapause.FieldPausedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ResumedAt(); ok {
+		_spec.SetField(ticketslapause.FieldResumedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ResumedAtCleared() {
+		_spec.ClearField(ticketslapause.FieldResumedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PausedMinutes(); ok {
+		_spec.SetField(ticketslapause.FieldPausedMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPausedMinutes(); ok {
+		_spec.AddField(ticketslapause.FieldPausedMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ResponseDeadlineBefore(); ok {
+		_spec.SetField(ticketslapause.FieldResponseDeadlineBefore, field.TypeTime, value)
+	}
+	if _u.mutation.ResponseDeadlineBeforeCleared() {
+		_spec.ClearField(ticketslapause.FieldResponseDeadlineBefore, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ResponseDeadlineAfter(); ok {
+		_spec.SetField(ticketslapause.FieldResponseDeadlineAfter, field.TypeTime, value)
+	}
+	if _u.mutation.ResponseDeadlineAfterCleared() {
+		_spec.ClearField(ticketslapause.FieldResponseDeadlineAfter, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ResolutionDeadlineBefore(); ok {
+		_spec.SetField(ticketslapause.FieldResolutionDeadlineBefore, field.TypeTime, value)
+	}
+	if _u.mutation.ResolutionDeadlineBeforeCleared() {
+		_spec.ClearField(ticketslapause.FieldResolutionDeadlineBefore, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ResolutionDeadlineAfter(); ok {
+		_spec.SetField(ticketslapause.FieldResolutionDeadlineAfter, field.TypeTime, value)
+	}
+	if _u.mutation.ResolutionDeadlineAfterCleared() {
+		_spec.ClearField(ticketslapause.FieldResolutionDeadlineAfter, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(ticketslapause.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(ticketslapause.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(ticketslapause.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(ticketslapause.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TicketCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ticketslapause.TicketTable,
+			Columns: []string{ticketslapause.TicketColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticket.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TicketIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ticketslapause.TicketTable,
+			Columns: []string{ticketslapause.TicketColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ticket.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TicketSLAPause{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ticketslapause.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
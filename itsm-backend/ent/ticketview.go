@@ -23,6 +23,8 @@ type TicketView struct {
 	Name string `json:"name,omitempty"`
 	// 视图描述
 	Description string `json:"description,omitempty"`
+	// TQL 查询语句，设置后优先于 filters
+	Query string `json:"query,omitempty"`
 	// 筛选条件（JSON格式）
 	Filters map[string]interface{} `json:"filters,omitempty"`
 	// 显示的列（JSON数组）
@@ -78,7 +80,7 @@ func (*TicketView) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case ticketview.FieldID, ticketview.FieldCreatedBy, ticketview.FieldTenantID:
 			values[i] = new(sql.NullInt64)
-		case ticketview.FieldName, ticketview.FieldDescription:
+		case ticketview.FieldName, ticketview.FieldDescription, ticketview.FieldQuery:
 			values[i] = new(sql.NullString)
 		case ticketview.FieldCreatedAt, ticketview.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -115,6 +117,12 @@ func (_m *TicketView) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Description = value.String
 			}
+		case ticketview.FieldQuery:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field query", values[i])
+			} else if value.Valid {
+				_m.Query = value.String
+			}
 		case ticketview.FieldFilters:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field filters", values[i])
@@ -224,6 +232,9 @@ func (_m *TicketView) String() string {
 	builder.WriteString("description=")
 	builder.WriteString(_m.Description)
 	builder.WriteString(", ")
+	builder.WriteString("query=")
+	builder.WriteString(_m.Query)
+	builder.WriteString(", ")
 	builder.WriteString("filters=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Filters))
 	builder.WriteString(", ")
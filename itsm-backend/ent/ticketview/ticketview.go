@@ -18,6 +18,8 @@ const (
 	FieldName = "name"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
+	// FieldQuery holds the string denoting the query field in the database.
+	FieldQuery = "query"
 	// FieldFilters holds the string denoting the filters field in the database.
 	FieldFilters = "filters"
 	// FieldColumns holds the string denoting the columns field in the database.
@@ -54,6 +56,7 @@ var Columns = []string{
 	FieldID,
 	FieldName,
 	FieldDescription,
+	FieldQuery,
 	FieldFilters,
 	FieldColumns,
 	FieldSortConfig,
@@ -110,6 +113,11 @@ func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
 }
 
+// ByQuery orders the results by the query field.
+func ByQuery(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuery, opts...).ToFunc()
+}
+
 // ByIsShared orders the results by the is_shared field.
 func ByIsShared(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsShared, opts...).ToFunc()
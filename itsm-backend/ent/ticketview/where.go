@@ -65,6 +65,11 @@ func Description(v string) predicate.TicketView {
 	return predicate.TicketView(sql.FieldEQ(FieldDescription, v))
 }
 
+// Query applies equality check predicate on the "query" field. It's identical to QueryEQ.
+func Query(v string) predicate.TicketView {
+	return predicate.TicketView(sql.FieldEQ(FieldQuery, v))
+}
+
 // IsShared applies equality check predicate on the "is_shared" field. It's identical to IsSharedEQ.
 func IsShared(v bool) predicate.TicketView {
 	return predicate.TicketView(sql.FieldEQ(FieldIsShared, v))
@@ -230,6 +235,81 @@ func DescriptionContainsFold(v string) predicate.TicketView {
 	return predicate.TicketView(sql.FieldContainsFold(FieldDescription, v))
 }
 
+// QueryEQ applies the EQ predicate on the "query" field.
+func QueryEQ(v string) predicate.TicketView {
+	return predicate.TicketView(sql.FieldEQ(FieldQuery, v))
+}
+
+// QueryNEQ applies the NEQ predicate on the "query" field.
+func QueryNEQ(v string) predicate.TicketView {
+	return predicate.TicketView(sql.FieldNEQ(FieldQuery, v))
+}
+
+// QueryIn applies the In predicate on the "query" field.
+func QueryIn(vs ...string) predicate.TicketView {
+	return predicate.TicketView(sql.FieldIn(FieldQuery, vs...))
+}
+
+// QueryNotIn applies the NotIn predicate on the "query" field.
+func QueryNotIn(vs ...string) predicate.TicketView {
+	return predicate.TicketView(sql.FieldNotIn(FieldQuery, vs...))
+}
+
+// QueryGT applies the GT predicate on the "query" field.
+func QueryGT(v string) predicate.TicketView {
+	return predicate.TicketView(sql.FieldGT(FieldQuery, v))
+}
+
+// QueryGTE applies the GTE predicate on the "query" field.
+func QueryGTE(v string) predicate.TicketView {
+	return predicate.TicketView(sql.FieldGTE(FieldQuery, v))
+}
+
+// QueryLT applies the LT predicate on the "query" field.
+func QueryLT(v string) predicate.TicketView {
+	return predicate.TicketView(sql.FieldLT(FieldQuery, v))
+}
+
+// QueryLTE applies the LTE predicate on the "query" field.
+func QueryLTE(v string) predicate.TicketView {
+	return predicate.TicketView(sql.FieldLTE(FieldQuery, v))
+}
+
+// QueryContains applies the Contains predicate on the "query" field.
+func QueryContains(v string) predicate.TicketView {
+	return predicate.TicketView(sql.FieldContains(FieldQuery, v))
+}
+
+// QueryHasPrefix applies the HasPrefix predicate on the "query" field.
+func QueryHasPrefix(v string) predicate.TicketView {
+	return predicate.TicketView(sql.FieldHasPrefix(FieldQuery, v))
+}
+
+// QueryHasSuffix applies the HasSuffix predicate on the "query" field.
+func QueryHasSuffix(v string) predicate.TicketView {
+	return predicate.TicketView(sql.FieldHasSuffix(FieldQuery, v))
+}
+
+// QueryIsNil applies the IsNil predicate on the "query" field.
+func QueryIsNil() predicate.TicketView {
+	return predicate.TicketView(sql.FieldIsNull(FieldQuery))
+}
+
+// QueryNotNil applies the NotNil predicate on the "query" field.
+func QueryNotNil() predicate.TicketView {
+	return predicate.TicketView(sql.FieldNotNull(FieldQuery))
+}
+
+// QueryEqualFold applies the EqualFold predicate on the "query" field.
+func QueryEqualFold(v string) predicate.TicketView {
+	return predicate.TicketView(sql.FieldEqualFold(FieldQuery, v))
+}
+
+// QueryContainsFold applies the ContainsFold predicate on the "query" field.
+func QueryContainsFold(v string) predicate.TicketView {
+	return predicate.TicketView(sql.FieldContainsFold(FieldQuery, v))
+}
+
 // FiltersIsNil applies the IsNil predicate on the "filters" field.
 func FiltersIsNil() predicate.TicketView {
 	return predicate.TicketView(sql.FieldIsNull(FieldFilters))
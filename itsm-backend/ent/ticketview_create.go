@@ -41,6 +41,20 @@ func (_c *TicketViewCreate) SetNillableDescription(v *string) *TicketViewCreate
 	return _c
 }
 
+// SetQuery sets the "query" field.
+func (_c *TicketViewCreate) SetQuery(v string) *TicketViewCreate {
+	_c.mutation.SetQuery(v)
+	return _c
+}
+
+// SetNillableQuery sets the "query" field if the given value is not nil.
+func (_c *TicketViewCreate) SetNillableQuery(v *string) *TicketViewCreate {
+	if v != nil {
+		_c.SetQuery(*v)
+	}
+	return _c
+}
+
 // SetFilters sets the "filters" field.
 func (_c *TicketViewCreate) SetFilters(v map[string]interface{}) *TicketViewCreate {
 	_c.mutation.SetFilters(v)
@@ -251,6 +265,10 @@ func (_c *TicketViewCreate) createSpec() (*TicketView, *sqlgraph.CreateSpec) {
 		_spec.SetField(ticketview.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
+	if value, ok := _c.mutation.Query(); ok {
+		_spec.SetField(ticketview.FieldQuery, field.TypeString, value)
+		_node.Query = value
+	}
 	if value, ok := _c.mutation.Filters(); ok {
 		_spec.SetField(ticketview.FieldFilters, field.TypeJSON, value)
 		_node.Filters = value
@@ -64,6 +64,26 @@ func (_u *TicketViewUpdate) ClearDescription() *TicketViewUpdate {
 	return _u
 }
 
+// SetQuery sets the "query" field.
+func (_u *TicketViewUpdate) SetQuery(v string) *TicketViewUpdate {
+	_u.mutation.SetQuery(v)
+	return _u
+}
+
+// SetNillableQuery sets the "query" field if the given value is not nil.
+func (_u *TicketViewUpdate) SetNillableQuery(v *string) *TicketViewUpdate {
+	if v != nil {
+		_u.SetQuery(*v)
+	}
+	return _u
+}
+
+// ClearQuery clears the value of the "query" field.
+func (_u *TicketViewUpdate) ClearQuery() *TicketViewUpdate {
+	_u.mutation.ClearQuery()
+	return _u
+}
+
 // SetFilters sets the "filters" field.
 func (_u *TicketViewUpdate) SetFilters(v map[string]interface{}) *TicketViewUpdate {
 	_u.mutation.SetFilters(v)
@@ -289,6 +309,12 @@ func (_u *TicketViewUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if _u.mutation.DescriptionCleared() {
 		_spec.ClearField(ticketview.FieldDescription, field.TypeString)
 	}
+	if value, ok := _u.mutation.Query(); ok {
+		_spec.SetField(ticketview.FieldQuery, field.TypeString, value)
+	}
+	if _u.mutation.QueryCleared() {
+		_spec.ClearField(ticketview.FieldQuery, field.TypeString)
+	}
 	if value, ok := _u.mutation.Filters(); ok {
 		_spec.SetField(ticketview.FieldFilters, field.TypeJSON, value)
 	}
@@ -416,6 +442,26 @@ func (_u *TicketViewUpdateOne) ClearDescription() *TicketViewUpdateOne {
 	return _u
 }
 
+// SetQuery sets the "query" field.
+func (_u *TicketViewUpdateOne) SetQuery(v string) *TicketViewUpdateOne {
+	_u.mutation.SetQuery(v)
+	return _u
+}
+
+// SetNillableQuery sets the "query" field if the given value is not nil.
+func (_u *TicketViewUpdateOne) SetNillableQuery(v *string) *TicketViewUpdateOne {
+	if v != nil {
+		_u.SetQuery(*v)
+	}
+	return _u
+}
+
+// ClearQuery clears the value of the "query" field.
+func (_u *TicketViewUpdateOne) ClearQuery() *TicketViewUpdateOne {
+	_u.mutation.ClearQuery()
+	return _u
+}
+
 // SetFilters sets the "filters" field.
 func (_u *TicketViewUpdateOne) SetFilters(v map[string]interface{}) *TicketViewUpdateOne {
 	_u.mutation.SetFilters(v)
@@ -671,6 +717,12 @@ func (_u *TicketViewUpdateOne) sqlSave(ctx context.Context) (_node *TicketView,
 	if _u.mutation.DescriptionCleared() {
 		_spec.ClearField(ticketview.FieldDescription, field.TypeString)
 	}
+	if value, ok := _u.mutation.Query(); ok {
+		_spec.SetField(ticketview.FieldQuery, field.TypeString, value)
+	}
+	if _u.mutation.QueryCleared() {
+		_spec.ClearField(ticketview.FieldQuery, field.TypeString)
+	}
 	if value, ok := _u.mutation.Filters(); ok {
 		_spec.SetField(ticketview.FieldFilters, field.TypeJSON, value)
 	}
@@ -12,6 +12,8 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AlertSuppressionRule is the client for interacting with the AlertSuppressionRule builders.
+	AlertSuppressionRule *AlertSuppressionRuleClient
 	// Application is the client for interacting with the Application builders.
 	Application *ApplicationClient
 	// ApprovalChain is the client for interacting with the ApprovalChain builders.
@@ -30,6 +32,8 @@ type Tx struct {
 	BPMNPermission *BPMNPermissionClient
 	// BootstrapToken is the client for interacting with the BootstrapToken builders.
 	BootstrapToken *BootstrapTokenClient
+	// BusinessCalendar is the client for interacting with the BusinessCalendar builders.
+	BusinessCalendar *BusinessCalendarClient
 	// CABMember is the client for interacting with the CABMember builders.
 	CABMember *CABMemberClient
 	// CIAttributeDefinition is the client for interacting with the CIAttributeDefinition builders.
@@ -64,6 +68,8 @@ type Tx struct {
 	Contract *ContractClient
 	// Conversation is the client for interacting with the Conversation builders.
 	Conversation *ConversationClient
+	// DecisionDefinition is the client for interacting with the DecisionDefinition builders.
+	DecisionDefinition *DecisionDefinitionClient
 	// Department is the client for interacting with the Department builders.
 	Department *DepartmentClient
 	// DiscoveryJob is the client for interacting with the DiscoveryJob builders.
@@ -78,10 +84,18 @@ type Tx struct {
 	EndpointACL *EndpointACLClient
 	// EngineerSkill is the client for interacting with the EngineerSkill builders.
 	EngineerSkill *EngineerSkillClient
+	// EscalationPolicy is the client for interacting with the EscalationPolicy builders.
+	EscalationPolicy *EscalationPolicyClient
 	// FeishuTicketSync is the client for interacting with the FeishuTicketSync builders.
 	FeishuTicketSync *FeishuTicketSyncClient
 	// Group is the client for interacting with the Group builders.
 	Group *GroupClient
+	// InboundDedupKey is the client for interacting with the InboundDedupKey builders.
+	InboundDedupKey *InboundDedupKeyClient
+	// InboundEmail is the client for interacting with the InboundEmail builders.
+	InboundEmail *InboundEmailClient
+	// InboundMessageLog is the client for interacting with the InboundMessageLog builders.
+	InboundMessageLog *InboundMessageLogClient
 	// Incident is the client for interacting with the Incident builders.
 	Incident *IncidentClient
 	// IncidentAlert is the client for interacting with the IncidentAlert builders.
@@ -112,6 +126,8 @@ type Tx struct {
 	KnownError *KnownErrorClient
 	// MSPAllocation is the client for interacting with the MSPAllocation builders.
 	MSPAllocation *MSPAllocationClient
+	// MajorIncident is the client for interacting with the MajorIncident builders.
+	MajorIncident *MajorIncidentClient
 	// MarketplaceItem is the client for interacting with the MarketplaceItem builders.
 	MarketplaceItem *MarketplaceItemClient
 	// Menu is the client for interacting with the Menu builders.
@@ -120,12 +136,20 @@ type Tx struct {
 	Message *MessageClient
 	// Microservice is the client for interacting with the Microservice builders.
 	Microservice *MicroserviceClient
+	// MonitoringAlert is the client for interacting with the MonitoringAlert builders.
+	MonitoringAlert *MonitoringAlertClient
 	// Notification is the client for interacting with the Notification builders.
 	Notification *NotificationClient
 	// NotificationDelivery is the client for interacting with the NotificationDelivery builders.
 	NotificationDelivery *NotificationDeliveryClient
 	// NotificationPreference is the client for interacting with the NotificationPreference builders.
 	NotificationPreference *NotificationPreferenceClient
+	// OnCallOverride is the client for interacting with the OnCallOverride builders.
+	OnCallOverride *OnCallOverrideClient
+	// OnCallPage is the client for interacting with the OnCallPage builders.
+	OnCallPage *OnCallPageClient
+	// OnCallSchedule is the client for interacting with the OnCallSchedule builders.
+	OnCallSchedule *OnCallScheduleClient
 	// OperationalCommand is the client for interacting with the OperationalCommand builders.
 	OperationalCommand *OperationalCommandClient
 	// PasswordResetToken is the client for interacting with the PasswordResetToken builders.
@@ -146,8 +170,14 @@ type Tx struct {
 	ProcessDefinition *ProcessDefinitionClient
 	// ProcessDeployment is the client for interacting with the ProcessDeployment builders.
 	ProcessDeployment *ProcessDeploymentClient
+	// ProcessEventInstance is the client for interacting with the ProcessEventInstance builders.
+	ProcessEventInstance *ProcessEventInstanceClient
+	// ProcessEventSubscription is the client for interacting with the ProcessEventSubscription builders.
+	ProcessEventSubscription *ProcessEventSubscriptionClient
 	// ProcessExecutionHistory is the client for interacting with the ProcessExecutionHistory builders.
 	ProcessExecutionHistory *ProcessExecutionHistoryClient
+	// ProcessIncident is the client for interacting with the ProcessIncident builders.
+	ProcessIncident *ProcessIncidentClient
 	// ProcessInstance is the client for interacting with the ProcessInstance builders.
 	ProcessInstance *ProcessInstanceClient
 	// ProcessTask is the client for interacting with the ProcessTask builders.
@@ -184,6 +214,8 @@ type Tx struct {
 	SLAPolicy *SLAPolicyClient
 	// SLAViolation is the client for interacting with the SLAViolation builders.
 	SLAViolation *SLAViolationClient
+	// SearchDocument is the client for interacting with the SearchDocument builders.
+	SearchDocument *SearchDocumentClient
 	// ServiceCatalog is the client for interacting with the ServiceCatalog builders.
 	ServiceCatalog *ServiceCatalogClient
 	// ServiceCatalogItem is the client for interacting with the ServiceCatalogItem builders.
@@ -226,6 +258,14 @@ type Tx struct {
 	TicketComment *TicketCommentClient
 	// TicketNotification is the client for interacting with the TicketNotification builders.
 	TicketNotification *TicketNotificationClient
+	// TicketSLAMetric is the client for interacting with the TicketSLAMetric builders.
+	TicketSLAMetric *TicketSLAMetricClient
+	// TicketSLAPause is the client for interacting with the TicketSLAPause builders.
+	TicketSLAPause *TicketSLAPauseClient
+	// TicketSchedule is the client for interacting with the TicketSchedule builders.
+	TicketSchedule *TicketScheduleClient
+	// TicketScheduleRun is the client for interacting with the TicketScheduleRun builders.
+	TicketScheduleRun *TicketScheduleRunClient
 	// TicketTag is the client for interacting with the TicketTag builders.
 	TicketTag *TicketTagClient
 	// TicketTemplate is the client for interacting with the TicketTemplate builders.
@@ -242,6 +282,8 @@ type Tx struct {
 	User *UserClient
 	// Vendor is the client for interacting with the Vendor builders.
 	Vendor *VendorClient
+	// WorkLog is the client for interacting with the WorkLog builders.
+	WorkLog *WorkLogClient
 	// Workflow is the client for interacting with the Workflow builders.
 	Workflow *WorkflowClient
 	// WorkflowInstance is the client for interacting with the WorkflowInstance builders.
@@ -381,6 +423,7 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AlertSuppressionRule = NewAlertSuppressionRuleClient(tx.config)
 	tx.Application = NewApplicationClient(tx.config)
 	tx.ApprovalChain = NewApprovalChainClient(tx.config)
 	tx.ApprovalRecord = NewApprovalRecordClient(tx.config)
@@ -390,6 +433,7 @@ func (tx *Tx) init() {
 	tx.AuditLog = NewAuditLogClient(tx.config)
 	tx.BPMNPermission = NewBPMNPermissionClient(tx.config)
 	tx.BootstrapToken = NewBootstrapTokenClient(tx.config)
+	tx.BusinessCalendar = NewBusinessCalendarClient(tx.config)
 	tx.CABMember = NewCABMemberClient(tx.config)
 	tx.CIAttributeDefinition = NewCIAttributeDefinitionClient(tx.config)
 	tx.CIRelationship = NewCIRelationshipClient(tx.config)
@@ -407,6 +451,7 @@ func (tx *Tx) init() {
 	tx.ConfigurationItemHistory = NewConfigurationItemHistoryClient(tx.config)
 	tx.Contract = NewContractClient(tx.config)
 	tx.Conversation = NewConversationClient(tx.config)
+	tx.DecisionDefinition = NewDecisionDefinitionClient(tx.config)
 	tx.Department = NewDepartmentClient(tx.config)
 	tx.DiscoveryJob = NewDiscoveryJobClient(tx.config)
 	tx.DiscoveryResult = NewDiscoveryResultClient(tx.config)
@@ -414,8 +459,12 @@ func (tx *Tx) init() {
 	tx.DomainConfig = NewDomainConfigClient(tx.config)
 	tx.EndpointACL = NewEndpointACLClient(tx.config)
 	tx.EngineerSkill = NewEngineerSkillClient(tx.config)
+	tx.EscalationPolicy = NewEscalationPolicyClient(tx.config)
 	tx.FeishuTicketSync = NewFeishuTicketSyncClient(tx.config)
 	tx.Group = NewGroupClient(tx.config)
+	tx.InboundDedupKey = NewInboundDedupKeyClient(tx.config)
+	tx.InboundEmail = NewInboundEmailClient(tx.config)
+	tx.InboundMessageLog = NewInboundMessageLogClient(tx.config)
 	tx.Incident = NewIncidentClient(tx.config)
 	tx.IncidentAlert = NewIncidentAlertClient(tx.config)
 	tx.IncidentEscalationRule = NewIncidentEscalationRuleClient(tx.config)
@@ -431,13 +480,18 @@ func (tx *Tx) init() {
 	tx.KnowledgeArticleVersion = NewKnowledgeArticleVersionClient(tx.config)
 	tx.KnownError = NewKnownErrorClient(tx.config)
 	tx.MSPAllocation = NewMSPAllocationClient(tx.config)
+	tx.MajorIncident = NewMajorIncidentClient(tx.config)
 	tx.MarketplaceItem = NewMarketplaceItemClient(tx.config)
 	tx.Menu = NewMenuClient(tx.config)
 	tx.Message = NewMessageClient(tx.config)
 	tx.Microservice = NewMicroserviceClient(tx.config)
+	tx.MonitoringAlert = NewMonitoringAlertClient(tx.config)
 	tx.Notification = NewNotificationClient(tx.config)
 	tx.NotificationDelivery = NewNotificationDeliveryClient(tx.config)
 	tx.NotificationPreference = NewNotificationPreferenceClient(tx.config)
+	tx.OnCallOverride = NewOnCallOverrideClient(tx.config)
+	tx.OnCallPage = NewOnCallPageClient(tx.config)
+	tx.OnCallSchedule = NewOnCallScheduleClient(tx.config)
 	tx.OperationalCommand = NewOperationalCommandClient(tx.config)
 	tx.PasswordResetToken = NewPasswordResetTokenClient(tx.config)
 	tx.Permission = NewPermissionClient(tx.config)
@@ -448,7 +502,10 @@ func (tx *Tx) init() {
 	tx.ProcessBinding = NewProcessBindingClient(tx.config)
 	tx.ProcessDefinition = NewProcessDefinitionClient(tx.config)
 	tx.ProcessDeployment = NewProcessDeploymentClient(tx.config)
+	tx.ProcessEventInstance = NewProcessEventInstanceClient(tx.config)
+	tx.ProcessEventSubscription = NewProcessEventSubscriptionClient(tx.config)
 	tx.ProcessExecutionHistory = NewProcessExecutionHistoryClient(tx.config)
+	tx.ProcessIncident = NewProcessIncidentClient(tx.config)
 	tx.ProcessInstance = NewProcessInstanceClient(tx.config)
 	tx.ProcessTask = NewProcessTaskClient(tx.config)
 	tx.ProcessVariable = NewProcessVariableClient(tx.config)
@@ -467,6 +524,7 @@ func (tx *Tx) init() {
 	tx.SLAMetric = NewSLAMetricClient(tx.config)
 	tx.SLAPolicy = NewSLAPolicyClient(tx.config)
 	tx.SLAViolation = NewSLAViolationClient(tx.config)
+	tx.SearchDocument = NewSearchDocumentClient(tx.config)
 	tx.ServiceCatalog = NewServiceCatalogClient(tx.config)
 	tx.ServiceCatalogItem = NewServiceCatalogItemClient(tx.config)
 	tx.ServiceRequest = NewServiceRequestClient(tx.config)
@@ -488,6 +546,10 @@ func (tx *Tx) init() {
 	tx.TicketCategory = NewTicketCategoryClient(tx.config)
 	tx.TicketComment = NewTicketCommentClient(tx.config)
 	tx.TicketNotification = NewTicketNotificationClient(tx.config)
+	tx.TicketSLAMetric = NewTicketSLAMetricClient(tx.config)
+	tx.TicketSLAPause = NewTicketSLAPauseClient(tx.config)
+	tx.TicketSchedule = NewTicketScheduleClient(tx.config)
+	tx.TicketScheduleRun = NewTicketScheduleRunClient(tx.config)
 	tx.TicketTag = NewTicketTagClient(tx.config)
 	tx.TicketTemplate = NewTicketTemplateClient(tx.config)
 	tx.TicketType = NewTicketTypeClient(tx.config)
@@ -496,6 +558,7 @@ func (tx *Tx) init() {
 	tx.ToolInvocation = NewToolInvocationClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.Vendor = NewVendorClient(tx.config)
+	tx.WorkLog = NewWorkLogClient(tx.config)
 	tx.Workflow = NewWorkflowClient(tx.config)
 	tx.WorkflowInstance = NewWorkflowInstanceClient(tx.config)
 	tx.WorkflowTask = NewWorkflowTaskClient(tx.config)
@@ -509,7 +572,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Application.QueryXXX(), the query will be executed
+// applies a query, for example: AlertSuppressionRule.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -35,6 +35,10 @@ type User struct {
 	Phone string `json:"phone,omitempty"`
 	// 飞书用户OpenID
 	FeishuOpenID string `json:"feishu_open_id,omitempty"`
+	// Slack 成员ID
+	SlackUserID string `json:"slack_user_id,omitempty"`
+	// Teams 用户 AAD 对象ID
+	TeamsUserID string `json:"teams_user_id,omitempty"`
 	// 密码哈希
 	PasswordHash string `json:"password_hash,omitempty"`
 	// 是否激活
@@ -256,7 +260,7 @@ func (*User) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case user.FieldID, user.FieldDepartmentID, user.FieldTenantID, user.FieldAssignedByMspID:
 			values[i] = new(sql.NullInt64)
-		case user.FieldUsername, user.FieldEmail, user.FieldName, user.FieldRole, user.FieldDepartment, user.FieldPhone, user.FieldFeishuOpenID, user.FieldPasswordHash, user.FieldMspRole:
+		case user.FieldUsername, user.FieldEmail, user.FieldName, user.FieldRole, user.FieldDepartment, user.FieldPhone, user.FieldFeishuOpenID, user.FieldSlackUserID, user.FieldTeamsUserID, user.FieldPasswordHash, user.FieldMspRole:
 			values[i] = new(sql.NullString)
 		case user.FieldCreatedAt, user.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -335,6 +339,18 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.FeishuOpenID = value.String
 			}
+		case user.FieldSlackUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field slack_user_id", values[i])
+			} else if value.Valid {
+				_m.SlackUserID = value.String
+			}
+		case user.FieldTeamsUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field teams_user_id", values[i])
+			} else if value.Valid {
+				_m.TeamsUserID = value.String
+			}
 		case user.FieldPasswordHash:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field password_hash", values[i])
@@ -544,6 +560,12 @@ func (_m *User) String() string {
 	builder.WriteString("feishu_open_id=")
 	builder.WriteString(_m.FeishuOpenID)
 	builder.WriteString(", ")
+	builder.WriteString("slack_user_id=")
+	builder.WriteString(_m.SlackUserID)
+	builder.WriteString(", ")
+	builder.WriteString("teams_user_id=")
+	builder.WriteString(_m.TeamsUserID)
+	builder.WriteString(", ")
 	builder.WriteString("password_hash=")
 	builder.WriteString(_m.PasswordHash)
 	builder.WriteString(", ")
@@ -31,6 +31,10 @@ const (
 	FieldPhone = "phone"
 	// FieldFeishuOpenID holds the string denoting the feishu_open_id field in the database.
 	FieldFeishuOpenID = "feishu_open_id"
+	// FieldSlackUserID holds the string denoting the slack_user_id field in the database.
+	FieldSlackUserID = "slack_user_id"
+	// FieldTeamsUserID holds the string denoting the teams_user_id field in the database.
+	FieldTeamsUserID = "teams_user_id"
 	// FieldPasswordHash holds the string denoting the password_hash field in the database.
 	FieldPasswordHash = "password_hash"
 	// FieldActive holds the string denoting the active field in the database.
@@ -200,6 +204,8 @@ var Columns = []string{
 	FieldDepartmentID,
 	FieldPhone,
 	FieldFeishuOpenID,
+	FieldSlackUserID,
+	FieldTeamsUserID,
 	FieldPasswordHash,
 	FieldActive,
 	FieldTenantID,
@@ -371,6 +377,16 @@ func ByFeishuOpenID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFeishuOpenID, opts...).ToFunc()
 }
 
+// BySlackUserID orders the results by the slack_user_id field.
+func BySlackUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSlackUserID, opts...).ToFunc()
+}
+
+// ByTeamsUserID orders the results by the teams_user_id field.
+func ByTeamsUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTeamsUserID, opts...).ToFunc()
+}
+
 // ByPasswordHash orders the results by the password_hash field.
 func ByPasswordHash(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPasswordHash, opts...).ToFunc()
@@ -90,6 +90,16 @@ func FeishuOpenID(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldFeishuOpenID, v))
 }
 
+// SlackUserID applies equality check predicate on the "slack_user_id" field. It's identical to SlackUserIDEQ.
+func SlackUserID(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldSlackUserID, v))
+}
+
+// TeamsUserID applies equality check predicate on the "teams_user_id" field. It's identical to TeamsUserIDEQ.
+func TeamsUserID(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTeamsUserID, v))
+}
+
 // PasswordHash applies equality check predicate on the "password_hash" field. It's identical to PasswordHashEQ.
 func PasswordHash(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldPasswordHash, v))
@@ -595,6 +605,156 @@ func FeishuOpenIDContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldFeishuOpenID, v))
 }
 
+// SlackUserIDEQ applies the EQ predicate on the "slack_user_id" field.
+func SlackUserIDEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldSlackUserID, v))
+}
+
+// SlackUserIDNEQ applies the NEQ predicate on the "slack_user_id" field.
+func SlackUserIDNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldSlackUserID, v))
+}
+
+// SlackUserIDIn applies the In predicate on the "slack_user_id" field.
+func SlackUserIDIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldSlackUserID, vs...))
+}
+
+// SlackUserIDNotIn applies the NotIn predicate on the "slack_user_id" field.
+func SlackUserIDNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldSlackUserID, vs...))
+}
+
+// SlackUserIDGT applies the GT predicate on the "slack_user_id" field.
+func SlackUserIDGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldSlackUserID, v))
+}
+
+// SlackUserIDGTE applies the GTE predicate on the "slack_user_id" field.
+func SlackUserIDGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldSlackUserID, v))
+}
+
+// SlackUserIDLT applies the LT predicate on the "slack_user_id" field.
+func SlackUserIDLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldSlackUserID, v))
+}
+
+// SlackUserIDLTE applies the LTE predicate on the "slack_user_id" field.
+func SlackUserIDLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldSlackUserID, v))
+}
+
+// SlackUserIDContains applies the Contains predicate on the "slack_user_id" field.
+func SlackUserIDContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldSlackUserID, v))
+}
+
+// SlackUserIDHasPrefix applies the HasPrefix predicate on the "slack_user_id" field.
+func SlackUserIDHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldSlackUserID, v))
+}
+
+// SlackUserIDHasSuffix applies the HasSuffix predicate on the "slack_user_id" field.
+func SlackUserIDHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldSlackUserID, v))
+}
+
+// SlackUserIDIsNil applies the IsNil predicate on the "slack_user_id" field.
+func SlackUserIDIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldSlackUserID))
+}
+
+// SlackUserIDNotNil applies the NotNil predicate on the "slack_user_id" field.
+func SlackUserIDNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldSlackUserID))
+}
+
+// SlackUserIDEqualFold applies the EqualFold predicate on the "slack_user_id" field.
+func SlackUserIDEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldSlackUserID, v))
+}
+
+// SlackUserIDContainsFold applies the ContainsFold predicate on the "slack_user_id" field.
+func SlackUserIDContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldSlackUserID, v))
+}
+
+// TeamsUserIDEQ applies the EQ predicate on the "teams_user_id" field.
+func TeamsUserIDEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTeamsUserID, v))
+}
+
+// TeamsUserIDNEQ applies the NEQ predicate on the "teams_user_id" field.
+func TeamsUserIDNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldTeamsUserID, v))
+}
+
+// TeamsUserIDIn applies the In predicate on the "teams_user_id" field.
+func TeamsUserIDIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldTeamsUserID, vs...))
+}
+
+// TeamsUserIDNotIn applies the NotIn predicate on the "teams_user_id" field.
+func TeamsUserIDNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldTeamsUserID, vs...))
+}
+
+// TeamsUserIDGT applies the GT predicate on the "teams_user_id" field.
+func TeamsUserIDGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldTeamsUserID, v))
+}
+
+// TeamsUserIDGTE applies the GTE predicate on the "teams_user_id" field.
+func TeamsUserIDGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldTeamsUserID, v))
+}
+
+// TeamsUserIDLT applies the LT predicate on the "teams_user_id" field.
+func TeamsUserIDLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldTeamsUserID, v))
+}
+
+// TeamsUserIDLTE applies the LTE predicate on the "teams_user_id" field.
+func TeamsUserIDLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldTeamsUserID, v))
+}
+
+// TeamsUserIDContains applies the Contains predicate on the "teams_user_id" field.
+func TeamsUserIDContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldTeamsUserID, v))
+}
+
+// TeamsUserIDHasPrefix applies the HasPrefix predicate on the "teams_user_id" field.
+func TeamsUserIDHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldTeamsUserID, v))
+}
+
+// TeamsUserIDHasSuffix applies the HasSuffix predicate on the "teams_user_id" field.
+func TeamsUserIDHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldTeamsUserID, v))
+}
+
+// TeamsUserIDIsNil applies the IsNil predicate on the "teams_user_id" field.
+func TeamsUserIDIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldTeamsUserID))
+}
+
+// TeamsUserIDNotNil applies the NotNil predicate on the "teams_user_id" field.
+func TeamsUserIDNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldTeamsUserID))
+}
+
+// TeamsUserIDEqualFold applies the EqualFold predicate on the "teams_user_id" field.
+func TeamsUserIDEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldTeamsUserID, v))
+}
+
+// TeamsUserIDContainsFold applies the ContainsFold predicate on the "teams_user_id" field.
+func TeamsUserIDContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldTeamsUserID, v))
+}
+
 // PasswordHashEQ applies the EQ predicate on the "password_hash" field.
 func PasswordHashEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldPasswordHash, v))
@@ -123,6 +123,34 @@ func (_c *UserCreate) SetNillableFeishuOpenID(v *string) *UserCreate {
 	return _c
 }
 
+// SetSlackUserID sets the "slack_user_id" field.
+func (_c *UserCreate) SetSlackUserID(v string) *UserCreate {
+	_c.mutation.SetSlackUserID(v)
+	return _c
+}
+
+// SetNillableSlackUserID sets the "slack_user_id" field if the given value is not nil.
+func (_c *UserCreate) SetNillableSlackUserID(v *string) *UserCreate {
+	if v != nil {
+		_c.SetSlackUserID(*v)
+	}
+	return _c
+}
+
+// SetTeamsUserID sets the "teams_user_id" field.
+func (_c *UserCreate) SetTeamsUserID(v string) *UserCreate {
+	_c.mutation.SetTeamsUserID(v)
+	return _c
+}
+
+// SetNillableTeamsUserID sets the "teams_user_id" field if the given value is not nil.
+func (_c *UserCreate) SetNillableTeamsUserID(v *string) *UserCreate {
+	if v != nil {
+		_c.SetTeamsUserID(*v)
+	}
+	return _c
+}
+
 // SetPasswordHash sets the "password_hash" field.
 func (_c *UserCreate) SetPasswordHash(v string) *UserCreate {
 	_c.mutation.SetPasswordHash(v)
@@ -634,6 +662,14 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldFeishuOpenID, field.TypeString, value)
 		_node.FeishuOpenID = value
 	}
+	if value, ok := _c.mutation.SlackUserID(); ok {
+		_spec.SetField(user.FieldSlackUserID, field.TypeString, value)
+		_node.SlackUserID = value
+	}
+	if value, ok := _c.mutation.TeamsUserID(); ok {
+		_spec.SetField(user.FieldTeamsUserID, field.TypeString, value)
+		_node.TeamsUserID = value
+	}
 	if value, ok := _c.mutation.PasswordHash(); ok {
 		_spec.SetField(user.FieldPasswordHash, field.TypeString, value)
 		_node.PasswordHash = value
@@ -179,6 +179,46 @@ func (_u *UserUpdate) ClearFeishuOpenID() *UserUpdate {
 	return _u
 }
 
+// SetSlackUserID sets the "slack_user_id" field.
+func (_u *UserUpdate) SetSlackUserID(v string) *UserUpdate {
+	_u.mutation.SetSlackUserID(v)
+	return _u
+}
+
+// SetNillableSlackUserID sets the "slack_user_id" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableSlackUserID(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetSlackUserID(*v)
+	}
+	return _u
+}
+
+// ClearSlackUserID clears the value of the "slack_user_id" field.
+func (_u *UserUpdate) ClearSlackUserID() *UserUpdate {
+	_u.mutation.ClearSlackUserID()
+	return _u
+}
+
+// SetTeamsUserID sets the "teams_user_id" field.
+func (_u *UserUpdate) SetTeamsUserID(v string) *UserUpdate {
+	_u.mutation.SetTeamsUserID(v)
+	return _u
+}
+
+// SetNillableTeamsUserID sets the "teams_user_id" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableTeamsUserID(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetTeamsUserID(*v)
+	}
+	return _u
+}
+
+// ClearTeamsUserID clears the value of the "teams_user_id" field.
+func (_u *UserUpdate) ClearTeamsUserID() *UserUpdate {
+	_u.mutation.ClearTeamsUserID()
+	return _u
+}
+
 // SetPasswordHash sets the "password_hash" field.
 func (_u *UserUpdate) SetPasswordHash(v string) *UserUpdate {
 	_u.mutation.SetPasswordHash(v)
@@ -968,6 +1008,18 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.FeishuOpenIDCleared() {
 		_spec.ClearField(user.FieldFeishuOpenID, field.TypeString)
 	}
+	if value, ok := _u.mutation.SlackUserID(); ok {
+		_spec.SetField(user.FieldSlackUserID, field.TypeString, value)
+	}
+	if _u.mutation.SlackUserIDCleared() {
+		_spec.ClearField(user.FieldSlackUserID, field.TypeString)
+	}
+	if value, ok := _u.mutation.TeamsUserID(); ok {
+		_spec.SetField(user.FieldTeamsUserID, field.TypeString, value)
+	}
+	if _u.mutation.TeamsUserIDCleared() {
+		_spec.ClearField(user.FieldTeamsUserID, field.TypeString)
+	}
 	if value, ok := _u.mutation.PasswordHash(); ok {
 		_spec.SetField(user.FieldPasswordHash, field.TypeString, value)
 	}
@@ -1842,6 +1894,46 @@ func (_u *UserUpdateOne) ClearFeishuOpenID() *UserUpdateOne {
 	return _u
 }
 
+// SetSlackUserID sets the "slack_user_id" field.
+func (_u *UserUpdateOne) SetSlackUserID(v string) *UserUpdateOne {
+	_u.mutation.SetSlackUserID(v)
+	return _u
+}
+
+// SetNillableSlackUserID sets the "slack_user_id" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableSlackUserID(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetSlackUserID(*v)
+	}
+	return _u
+}
+
+// ClearSlackUserID clears the value of the "slack_user_id" field.
+func (_u *UserUpdateOne) ClearSlackUserID() *UserUpdateOne {
+	_u.mutation.ClearSlackUserID()
+	return _u
+}
+
+// SetTeamsUserID sets the "teams_user_id" field.
+func (_u *UserUpdateOne) SetTeamsUserID(v string) *UserUpdateOne {
+	_u.mutation.SetTeamsUserID(v)
+	return _u
+}
+
+// SetNillableTeamsUserID sets the "teams_user_id" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableTeamsUserID(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetTeamsUserID(*v)
+	}
+	return _u
+}
+
+// ClearTeamsUserID clears the value of the "teams_user_id" field.
+func (_u *UserUpdateOne) ClearTeamsUserID() *UserUpdateOne {
+	_u.mutation.ClearTeamsUserID()
+	return _u
+}
+
 // SetPasswordHash sets the "password_hash" field.
 func (_u *UserUpdateOne) SetPasswordHash(v string) *UserUpdateOne {
 	_u.mutation.SetPasswordHash(v)
@@ -2661,6 +2753,18 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if _u.mutation.FeishuOpenIDCleared() {
 		_spec.ClearField(user.FieldFeishuOpenID, field.TypeString)
 	}
+	if value, ok := _u.mutation.SlackUserID(); ok {
+		_spec.SetField(user.FieldSlackUserID, field.TypeString, value)
+	}
+	if _u.mutation.SlackUserIDCleared() {
+		_spec.ClearField(user.FieldSlackUserID, field.TypeString)
+	}
+	if value, ok := _u.mutation.TeamsUserID(); ok {
+		_spec.SetField(user.FieldTeamsUserID, field.TypeString, value)
+	}
+	if _u.mutation.TeamsUserIDCleared() {
+		_spec.ClearField(user.FieldTeamsUserID, field.TypeString)
+	}
 	if value, ok := _u.mutation.PasswordHash(); ok {
 		_spec.SetField(user.FieldPasswordHash, field.TypeString, value)
 	}
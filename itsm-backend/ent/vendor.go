@@ -55,9 +55,11 @@ type VendorEdges struct {
 	Contracts []*Contract `json:"contracts,omitempty"`
 	// Assets holds the value of the assets edge.
 	Assets []*Asset `json:"assets,omitempty"`
+	// 供应商支撑合同（UC）
+	UnderpinningContracts []*SLAPolicy `json:"underpinning_contracts,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // ContractsOrErr returns the Contracts value or an error if the edge
@@ -78,6 +80,15 @@ func (e VendorEdges) AssetsOrErr() ([]*Asset, error) {
 	return nil, &NotLoadedError{edge: "assets"}
 }
 
+// UnderpinningContractsOrErr returns the UnderpinningContracts value or an error if the edge
+// was not loaded in eager-loading.
+func (e VendorEdges) UnderpinningContractsOrErr() ([]*SLAPolicy, error) {
+	if e.loadedTypes[2] {
+		return e.UnderpinningContracts, nil
+	}
+	return nil, &NotLoadedError{edge: "underpinning_contracts"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Vendor) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -213,6 +224,11 @@ func (_m *Vendor) QueryAssets() *AssetQuery {
 	return NewVendorClient(_m.config).QueryAssets(_m)
 }
 
+// QueryUnderpinningContracts queries the "underpinning_contracts" edge of the Vendor entity.
+func (_m *Vendor) QueryUnderpinningContracts() *SLAPolicyQuery {
+	return NewVendorClient(_m.config).QueryUnderpinningContracts(_m)
+}
+
 // Update returns a builder for updating this Vendor.
 // Note that you need to call Vendor.Unwrap() before calling this method if this Vendor
 // was returned from a transaction, and the transaction was committed or rolled back.
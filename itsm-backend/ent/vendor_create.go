@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"itsm-backend/ent/asset"
 	"itsm-backend/ent/contract"
+	"itsm-backend/ent/slapolicy"
 	"itsm-backend/ent/vendor"
 	"time"
 
@@ -162,6 +163,21 @@ func (_c *VendorCreate) AddAssets(v ...*Asset) *VendorCreate {
 	return _c.AddAssetIDs(ids...)
 }
 
+// AddUnderpinningContractIDs adds the "underpinning_contracts" edge to the SLAPolicy entity by IDs.
+func (_c *VendorCreate) AddUnderpinningContractIDs(ids ...int) *VendorCreate {
+	_c.mutation.AddUnderpinningContractIDs(ids...)
+	return _c
+}
+
+// AddUnderpinningContracts adds the "underpinning_contracts" edges to the SLAPolicy entity.
+func (_c *VendorCreate) AddUnderpinningContracts(v ...*SLAPolicy) *VendorCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddUnderpinningContractIDs(ids...)
+}
+
 // Mutation returns the VendorMutation object of the builder.
 func (_c *VendorCreate) Mutation() *VendorMutation {
 	return _c.mutation
@@ -376,6 +392,22 @@ func (_c *VendorCreate) createSpec() (*Vendor, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.UnderpinningContractsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vendor.UnderpinningContractsTable,
+			Columns: []string{vendor.UnderpinningContractsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slapolicy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -9,6 +9,7 @@ import (
 	"itsm-backend/ent/asset"
 	"itsm-backend/ent/contract"
 	"itsm-backend/ent/predicate"
+	"itsm-backend/ent/slapolicy"
 	"itsm-backend/ent/vendor"
 	"math"
 
@@ -21,12 +22,13 @@ import (
 // VendorQuery is the builder for querying Vendor entities.
 type VendorQuery struct {
 	config
-	ctx           *QueryContext
-	order         []vendor.OrderOption
-	inters        []Interceptor
-	predicates    []predicate.Vendor
-	withContracts *ContractQuery
-	withAssets    *AssetQuery
+	ctx                       *QueryContext
+	order                     []vendor.OrderOption
+	inters                    []Interceptor
+	predicates                []predicate.Vendor
+	withContracts             *ContractQuery
+	withAssets                *AssetQuery
+	withUnderpinningContracts *SLAPolicyQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -107,6 +109,28 @@ func (_q *VendorQuery) QueryAssets() *AssetQuery {
 	return query
 }
 
+// QueryUnderpinningContracts chains the current query on the "underpinning_contracts" edge.
+func (_q *VendorQuery) QueryUnderpinningContracts() *SLAPolicyQuery {
+	query := (&SLAPolicyClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vendor.Table, vendor.FieldID, selector),
+			sqlgraph.To(slapolicy.Table, slapolicy.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, vendor.UnderpinningContractsTable, vendor.UnderpinningContractsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Vendor entity from the query.
 // Returns a *NotFoundError when no Vendor was found.
 func (_q *VendorQuery) First(ctx context.Context) (*Vendor, error) {
@@ -294,13 +318,14 @@ func (_q *VendorQuery) Clone() *VendorQuery {
 		return nil
 	}
 	return &VendorQuery{
-		config:        _q.config,
-		ctx:           _q.ctx.Clone(),
-		order:         append([]vendor.OrderOption{}, _q.order...),
-		inters:        append([]Interceptor{}, _q.inters...),
-		predicates:    append([]predicate.Vendor{}, _q.predicates...),
-		withContracts: _q.withContracts.Clone(),
-		withAssets:    _q.withAssets.Clone(),
+		config:                    _q.config,
+		ctx:                       _q.ctx.Clone(),
+		order:                     append([]vendor.OrderOption{}, _q.order...),
+		inters:                    append([]Interceptor{}, _q.inters...),
+		predicates:                append([]predicate.Vendor{}, _q.predicates...),
+		withContracts:             _q.withContracts.Clone(),
+		withAssets:                _q.withAssets.Clone(),
+		withUnderpinningContracts: _q.withUnderpinningContracts.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -329,6 +354,17 @@ func (_q *VendorQuery) WithAssets(opts ...func(*AssetQuery)) *VendorQuery {
 	return _q
 }
 
+// WithUnderpinningContracts tells the query-builder to eager-load the nodes that are connected to
+// the "underpinning_contracts" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *VendorQuery) WithUnderpinningContracts(opts ...func(*SLAPolicyQuery)) *VendorQuery {
+	query := (&SLAPolicyClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withUnderpinningContracts = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -407,9 +443,10 @@ func (_q *VendorQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Vendo
 	var (
 		nodes       = []*Vendor{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			_q.withContracts != nil,
 			_q.withAssets != nil,
+			_q.withUnderpinningContracts != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -444,6 +481,15 @@ func (_q *VendorQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Vendo
 			return nil, err
 		}
 	}
+	if query := _q.withUnderpinningContracts; query != nil {
+		if err := _q.loadUnderpinningContracts(ctx, query, nodes,
+			func(n *Vendor) { n.Edges.UnderpinningContracts = []*SLAPolicy{} },
+			func(n *Vendor, e *SLAPolicy) {
+				n.Edges.UnderpinningContracts = append(n.Edges.UnderpinningContracts, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -508,6 +554,39 @@ func (_q *VendorQuery) loadAssets(ctx context.Context, query *AssetQuery, nodes
 	}
 	return nil
 }
+func (_q *VendorQuery) loadUnderpinningContracts(ctx context.Context, query *SLAPolicyQuery, nodes []*Vendor, init func(*Vendor), assign func(*Vendor, *SLAPolicy)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Vendor)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(slapolicy.FieldVendorID)
+	}
+	query.Where(predicate.SLAPolicy(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(vendor.UnderpinningContractsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.VendorID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "vendor_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "vendor_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *VendorQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
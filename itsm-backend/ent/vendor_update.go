@@ -9,6 +9,7 @@ import (
 	"itsm-backend/ent/asset"
 	"itsm-backend/ent/contract"
 	"itsm-backend/ent/predicate"
+	"itsm-backend/ent/slapolicy"
 	"itsm-backend/ent/vendor"
 	"time"
 
@@ -256,6 +257,21 @@ func (_u *VendorUpdate) AddAssets(v ...*Asset) *VendorUpdate {
 	return _u.AddAssetIDs(ids...)
 }
 
+// AddUnderpinningContractIDs adds the "underpinning_contracts" edge to the SLAPolicy entity by IDs.
+func (_u *VendorUpdate) AddUnderpinningContractIDs(ids ...int) *VendorUpdate {
+	_u.mutation.AddUnderpinningContractIDs(ids...)
+	return _u
+}
+
+// AddUnderpinningContracts adds the "underpinning_contracts" edges to the SLAPolicy entity.
+func (_u *VendorUpdate) AddUnderpinningContracts(v ...*SLAPolicy) *VendorUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddUnderpinningContractIDs(ids...)
+}
+
 // Mutation returns the VendorMutation object of the builder.
 func (_u *VendorUpdate) Mutation() *VendorMutation {
 	return _u.mutation
@@ -303,6 +319,27 @@ func (_u *VendorUpdate) RemoveAssets(v ...*Asset) *VendorUpdate {
 	return _u.RemoveAssetIDs(ids...)
 }
 
+// ClearUnderpinningContracts clears all "underpinning_contracts" edges to the SLAPolicy entity.
+func (_u *VendorUpdate) ClearUnderpinningContracts() *VendorUpdate {
+	_u.mutation.ClearUnderpinningContracts()
+	return _u
+}
+
+// RemoveUnderpinningContractIDs removes the "underpinning_contracts" edge to SLAPolicy entities by IDs.
+func (_u *VendorUpdate) RemoveUnderpinningContractIDs(ids ...int) *VendorUpdate {
+	_u.mutation.RemoveUnderpinningContractIDs(ids...)
+	return _u
+}
+
+// RemoveUnderpinningContracts removes "underpinning_contracts" edges to SLAPolicy entities.
+func (_u *VendorUpdate) RemoveUnderpinningContracts(v ...*SLAPolicy) *VendorUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUnderpinningContractIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *VendorUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -492,6 +529,51 @@ func (_u *VendorUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.UnderpinningContractsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vendor.UnderpinningContractsTable,
+			Columns: []string{vendor.UnderpinningContractsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slapolicy.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedUnderpinningContractsIDs(); len(nodes) > 0 && !_u.mutation.UnderpinningContractsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vendor.UnderpinningContractsTable,
+			Columns: []string{vendor.UnderpinningContractsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slapolicy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UnderpinningContractsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vendor.UnderpinningContractsTable,
+			Columns: []string{vendor.UnderpinningContractsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slapolicy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{vendor.Label}
@@ -738,6 +820,21 @@ func (_u *VendorUpdateOne) AddAssets(v ...*Asset) *VendorUpdateOne {
 	return _u.AddAssetIDs(ids...)
 }
 
+// AddUnderpinningContractIDs adds the "underpinning_contracts" edge to the SLAPolicy entity by IDs.
+func (_u *VendorUpdateOne) AddUnderpinningContractIDs(ids ...int) *VendorUpdateOne {
+	_u.mutation.AddUnderpinningContractIDs(ids...)
+	return _u
+}
+
+// AddUnderpinningContracts adds the "underpinning_contracts" edges to the SLAPolicy entity.
+func (_u *VendorUpdateOne) AddUnderpinningContracts(v ...*SLAPolicy) *VendorUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddUnderpinningContractIDs(ids...)
+}
+
 // Mutation returns the VendorMutation object of the builder.
 func (_u *VendorUpdateOne) Mutation() *VendorMutation {
 	return _u.mutation
@@ -785,6 +882,27 @@ func (_u *VendorUpdateOne) RemoveAssets(v ...*Asset) *VendorUpdateOne {
 	return _u.RemoveAssetIDs(ids...)
 }
 
+// ClearUnderpinningContracts clears all "underpinning_contracts" edges to the SLAPolicy entity.
+func (_u *VendorUpdateOne) ClearUnderpinningContracts() *VendorUpdateOne {
+	_u.mutation.ClearUnderpinningContracts()
+	return _u
+}
+
+// RemoveUnderpinningContractIDs removes the "underpinning_contracts" edge to SLAPolicy entities by IDs.
+func (_u *VendorUpdateOne) RemoveUnderpinningContractIDs(ids ...int) *VendorUpdateOne {
+	_u.mutation.RemoveUnderpinningContractIDs(ids...)
+	return _u
+}
+
+// RemoveUnderpinningContracts removes "underpinning_contracts" edges to SLAPolicy entities.
+func (_u *VendorUpdateOne) RemoveUnderpinningContracts(v ...*SLAPolicy) *VendorUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveUnderpinningContractIDs(ids...)
+}
+
 // Where appends a list predicates to the VendorUpdate builder.
 func (_u *VendorUpdateOne) Where(ps ...predicate.Vendor) *VendorUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1004,6 +1122,51 @@ func (_u *VendorUpdateOne) sqlSave(ctx context.Context) (_node *Vendor, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.UnderpinningContractsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vendor.UnderpinningContractsTable,
+			Columns: []string{vendor.UnderpinningContractsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slapolicy.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedUnderpinningContractsIDs(); len(nodes) > 0 && !_u.mutation.UnderpinningContractsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vendor.UnderpinningContractsTable,
+			Columns: []string{vendor.UnderpinningContractsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slapolicy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UnderpinningContractsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vendor.UnderpinningContractsTable,
+			Columns: []string{vendor.UnderpinningContractsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slapolicy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Vendor{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
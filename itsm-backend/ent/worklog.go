@@ -0,0 +1,291 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"itsm-backend/ent/worklog"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// WorkLog is the model entity for the WorkLog schema.
+type WorkLog struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 租户ID（工单/变更/问题所在租户，MSP 场景下即客户租户）
+	TenantID int `json:"tenant_id,omitempty"`
+	// 工时所属用户ID
+	UserID int `json:"user_id,omitempty"`
+	// 记录时用户所属团队ID
+	TeamID *int `json:"team_id,omitempty"`
+	// 工单ID
+	TicketID *int `json:"ticket_id,omitempty"`
+	// 变更ID
+	ChangeID *int `json:"change_id,omitempty"`
+	// 问题ID
+	ProblemID *int `json:"problem_id,omitempty"`
+	// 记录时的分类：工单分类名称、问题分类或变更类型
+	Category string `json:"category,omitempty"`
+	// 开始时间
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// 结束时间，为空表示计时中
+	EndedAt *time.Time `json:"ended_at,omitempty"`
+	// 时长（分钟），计时中为 0
+	DurationMinutes int `json:"duration_minutes,omitempty"`
+	// 是否可计费
+	Billable bool `json:"billable,omitempty"`
+	// 活动类型
+	ActivityType worklog.ActivityType `json:"activity_type,omitempty"`
+	// 工作说明
+	Comment string `json:"comment,omitempty"`
+	// 工单由 MSP 托管时的服务提供商租户ID，作为 MSP 向客户开票的依据
+	MspProviderID *int `json:"msp_provider_id,omitempty"`
+	// 创建时间
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WorkLog) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case worklog.FieldBillable:
+			values[i] = new(sql.NullBool)
+		case worklog.FieldID, worklog.FieldTenantID, worklog.FieldUserID, worklog.FieldTeamID, worklog.FieldTicketID, worklog.FieldChangeID, worklog.FieldProblemID, worklog.FieldDurationMinutes, worklog.FieldMspProviderID:
+			values[i] = new(sql.NullInt64)
+		case worklog.FieldCategory, worklog.FieldActivityType, worklog.FieldComment:
+			values[i] = new(sql.NullString)
+		case worklog.FieldStartedAt, worklog.FieldEndedAt, worklog.FieldCreatedAt, worklog.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WorkLog fields.
+func (_m *WorkLog) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case worklog.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case worklog.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = int(value.Int64)
+			}
+		case worklog.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case worklog.FieldTeamID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field team_id", values[i])
+			} else if value.Valid {
+				_m.TeamID = new(int)
+				*_m.TeamID = int(value.Int64)
+			}
+		case worklog.FieldTicketID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field ticket_id", values[i])
+			} else if value.Valid {
+				_m.TicketID = new(int)
+				*_m.TicketID = int(value.Int64)
+			}
+		case worklog.FieldChangeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field change_id", values[i])
+			} else if value.Valid {
+				_m.ChangeID = new(int)
+				*_m.ChangeID = int(value.Int64)
+			}
+		case worklog.FieldProblemID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field problem_id", values[i])
+			} else if value.Valid {
+				_m.ProblemID = new(int)
+				*_m.ProblemID = int(value.Int64)
+			}
+		case worklog.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				_m.Category = value.String
+			}
+		case worklog.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				_m.StartedAt = value.Time
+			}
+		case worklog.FieldEndedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ended_at", values[i])
+			} else if value.Valid {
+				_m.EndedAt = new(time.Time)
+				*_m.EndedAt = value.Time
+			}
+		case worklog.FieldDurationMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_minutes", values[i])
+			} else if value.Valid {
+				_m.DurationMinutes = int(value.Int64)
+			}
+		case worklog.FieldBillable:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field billable", values[i])
+			} else if value.Valid {
+				_m.Billable = value.Bool
+			}
+		case worklog.FieldActivityType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field activity_type", values[i])
+			} else if value.Valid {
+				_m.ActivityType = worklog.ActivityType(value.String)
+			}
+		case worklog.FieldComment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field comment", values[i])
+			} else if value.Valid {
+				_m.Comment = value.String
+			}
+		case worklog.FieldMspProviderID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field msp_provider_id", values[i])
+			} else if value.Valid {
+				_m.MspProviderID = new(int)
+				*_m.MspProviderID = int(value.Int64)
+			}
+		case worklog.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case worklog.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WorkLog.
+// This includes values selected through modifiers, order, etc.
+func (_m *WorkLog) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this WorkLog.
+// Note that you need to call WorkLog.Unwrap() before calling this method if this WorkLog
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *WorkLog) Update() *WorkLogUpdateOne {
+	return NewWorkLogClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the WorkLog entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *WorkLog) Unwrap() *WorkLog {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WorkLog is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *WorkLog) String() string {
+	var builder strings.Builder
+	builder.WriteString("WorkLog(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	if v := _m.TeamID; v != nil {
+		builder.WriteString("team_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TicketID; v != nil {
+		builder.WriteString("ticket_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ChangeID; v != nil {
+		builder.WriteString("change_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ProblemID; v != nil {
+		builder.WriteString("problem_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("category=")
+	builder.WriteString(_m.Category)
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(_m.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.EndedAt; v != nil {
+		builder.WriteString("ended_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("duration_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DurationMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("billable=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Billable))
+	builder.WriteString(", ")
+	builder.WriteString("activity_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ActivityType))
+	builder.WriteString(", ")
+	builder.WriteString("comment=")
+	builder.WriteString(_m.Comment)
+	builder.WriteString(", ")
+	if v := _m.MspProviderID; v != nil {
+		builder.WriteString("msp_provider_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WorkLogs is a parsable slice of WorkLog.
+type WorkLogs []*WorkLog
@@ -0,0 +1,865 @@
+// Code generated by ent, DO NOT EDIT.
+
+package worklog
+
+import (
+	"itsm-backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldEQ(FieldTenantID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldEQ(FieldUserID, v))
+}
+
+// TeamID applies equality check predicate on the "team_id" field. It's identical to TeamIDEQ.
+func TeamID(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldEQ(FieldTeamID, v))
+}
+
+// TicketID applies equality check predicate on the "ticket_id" field. It's identical to TicketIDEQ.
+func TicketID(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldEQ(FieldTicketID, v))
+}
+
+// ChangeID applies equality check predicate on the "change_id" field. It's identical to ChangeIDEQ.
+func ChangeID(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldEQ(FieldChangeID, v))
+}
+
+// ProblemID applies equality check predicate on the "problem_id" field. It's identical to ProblemIDEQ.
+func ProblemID(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldEQ(FieldProblemID, v))
+}
+
+// Category applies equality check predicate on the "category" field. It's identical to CategoryEQ.
+func Category(v string) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldEQ(FieldCategory, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// EndedAt applies equality check predicate on the "ended_at" field. It's identical to EndedAtEQ.
+func EndedAt(v time.Time) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// DurationMinutes applies equality check predicate on the "duration_minutes" field. It's identical to DurationMinutesEQ.
+func DurationMinutes(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldEQ(FieldDurationMinutes, v))
+}
+
+// Billable applies equality check predicate on the "billable" field. It's identical to BillableEQ.
+func Billable(v bool) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldEQ(FieldBillable, v))
+}
+
+// Comment applies equality check predicate on the "comment" field. It's identical to CommentEQ.
+func Comment(v string) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldEQ(FieldComment, v))
+}
+
+// MspProviderID applies equality check predicate on the "msp_provider_id" field. It's identical to MspProviderIDEQ.
+func MspProviderID(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldEQ(FieldMspProviderID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldLTE(FieldTenantID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldLTE(FieldUserID, v))
+}
+
+// TeamIDEQ applies the EQ predicate on the "team_id" field.
+func TeamIDEQ(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldEQ(FieldTeamID, v))
+}
+
+// TeamIDNEQ applies the NEQ predicate on the "team_id" field.
+func TeamIDNEQ(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNEQ(FieldTeamID, v))
+}
+
+// TeamIDIn applies the In predicate on the "team_id" field.
+func TeamIDIn(vs ...int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldIn(FieldTeamID, vs...))
+}
+
+// TeamIDNotIn applies the NotIn predicate on the "team_id" field.
+func TeamIDNotIn(vs ...int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNotIn(FieldTeamID, vs...))
+}
+
+// TeamIDGT applies the GT predicate on the "team_id" field.
+func TeamIDGT(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldGT(FieldTeamID, v))
+}
+
+// TeamIDGTE applies the GTE predicate on the "team_id" field.
+func TeamIDGTE(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldGTE(FieldTeamID, v))
+}
+
+// TeamIDLT applies the LT predicate on the "team_id" field.
+func TeamIDLT(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldLT(FieldTeamID, v))
+}
+
+// TeamIDLTE applies the LTE predicate on the "team_id" field.
+func TeamIDLTE(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldLTE(FieldTeamID, v))
+}
+
+// TeamIDIsNil applies the IsNil predicate on the "team_id" field.
+func TeamIDIsNil() predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldIsNull(FieldTeamID))
+}
+
+// TeamIDNotNil applies the NotNil predicate on the "team_id" field.
+func TeamIDNotNil() predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNotNull(FieldTeamID))
+}
+
+// TicketIDEQ applies the EQ predicate on the "ticket_id" field.
+func TicketIDEQ(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldEQ(FieldTicketID, v))
+}
+
+// TicketIDNEQ applies the NEQ predicate on the "ticket_id" field.
+func TicketIDNEQ(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNEQ(FieldTicketID, v))
+}
+
+// TicketIDIn applies the In predicate on the "ticket_id" field.
+func TicketIDIn(vs ...int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldIn(FieldTicketID, vs...))
+}
+
+// TicketIDNotIn applies the NotIn predicate on the "ticket_id" field.
+func TicketIDNotIn(vs ...int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNotIn(FieldTicketID, vs...))
+}
+
+// TicketIDGT applies the GT predicate on the "ticket_id" field.
+func TicketIDGT(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldGT(FieldTicketID, v))
+}
+
+// TicketIDGTE applies the GTE predicate on the "ticket_id" field.
+func TicketIDGTE(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldGTE(FieldTicketID, v))
+}
+
+// TicketIDLT applies the LT predicate on the "ticket_id" field.
+func TicketIDLT(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldLT(FieldTicketID, v))
+}
+
+// TicketIDLTE applies the LTE predicate on the "ticket_id" field.
+func TicketIDLTE(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldLTE(FieldTicketID, v))
+}
+
+// TicketIDIsNil applies the IsNil predicate on the "ticket_id" field.
+func TicketIDIsNil() predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldIsNull(FieldTicketID))
+}
+
+// TicketIDNotNil applies the NotNil predicate on the "ticket_id" field.
+func TicketIDNotNil() predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNotNull(FieldTicketID))
+}
+
+// ChangeIDEQ applies the EQ predicate on the "change_id" field.
+func ChangeIDEQ(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldEQ(FieldChangeID, v))
+}
+
+// ChangeIDNEQ applies the NEQ predicate on the "change_id" field.
+func ChangeIDNEQ(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNEQ(FieldChangeID, v))
+}
+
+// ChangeIDIn applies the In predicate on the "change_id" field.
+func ChangeIDIn(vs ...int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldIn(FieldChangeID, vs...))
+}
+
+// ChangeIDNotIn applies the NotIn predicate on the "change_id" field.
+func ChangeIDNotIn(vs ...int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNotIn(FieldChangeID, vs...))
+}
+
+// ChangeIDGT applies the GT predicate on the "change_id" field.
+func ChangeIDGT(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldGT(FieldChangeID, v))
+}
+
+// ChangeIDGTE applies the GTE predicate on the "change_id" field.
+func ChangeIDGTE(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldGTE(FieldChangeID, v))
+}
+
+// ChangeIDLT applies the LT predicate on the "change_id" field.
+func ChangeIDLT(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldLT(FieldChangeID, v))
+}
+
+// ChangeIDLTE applies the LTE predicate on the "change_id" field.
+func ChangeIDLTE(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldLTE(FieldChangeID, v))
+}
+
+// ChangeIDIsNil applies the IsNil predicate on the "change_id" field.
+func ChangeIDIsNil() predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldIsNull(FieldChangeID))
+}
+
+// ChangeIDNotNil applies the NotNil predicate on the "change_id" field.
+func ChangeIDNotNil() predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNotNull(FieldChangeID))
+}
+
+// ProblemIDEQ applies the EQ predicate on the "problem_id" field.
+func ProblemIDEQ(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldEQ(FieldProblemID, v))
+}
+
+// ProblemIDNEQ applies the NEQ predicate on the "problem_id" field.
+func ProblemIDNEQ(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNEQ(FieldProblemID, v))
+}
+
+// ProblemIDIn applies the In predicate on the "problem_id" field.
+func ProblemIDIn(vs ...int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldIn(FieldProblemID, vs...))
+}
+
+// ProblemIDNotIn applies the NotIn predicate on the "problem_id" field.
+func ProblemIDNotIn(vs ...int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNotIn(FieldProblemID, vs...))
+}
+
+// ProblemIDGT applies the GT predicate on the "problem_id" field.
+func ProblemIDGT(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldGT(FieldProblemID, v))
+}
+
+// ProblemIDGTE applies the GTE predicate on the "problem_id" field.
+func ProblemIDGTE(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldGTE(FieldProblemID, v))
+}
+
+// ProblemIDLT applies the LT predicate on the "problem_id" field.
+func ProblemIDLT(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldLT(FieldProblemID, v))
+}
+
+// ProblemIDLTE applies the LTE predicate on the "problem_id" field.
+func ProblemIDLTE(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldLTE(FieldProblemID, v))
+}
+
+// ProblemIDIsNil applies the IsNil predicate on the "problem_id" field.
+func ProblemIDIsNil() predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldIsNull(FieldProblemID))
+}
+
+// ProblemIDNotNil applies the NotNil predicate on the "problem_id" field.
+func ProblemIDNotNil() predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNotNull(FieldProblemID))
+}
+
+// CategoryEQ applies the EQ predicate on the "category" field.
+func CategoryEQ(v string) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldEQ(FieldCategory, v))
+}
+
+// CategoryNEQ applies the NEQ predicate on the "category" field.
+func CategoryNEQ(v string) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNEQ(FieldCategory, v))
+}
+
+// CategoryIn applies the In predicate on the "category" field.
+func CategoryIn(vs ...string) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldIn(FieldCategory, vs...))
+}
+
+// CategoryNotIn applies the NotIn predicate on the "category" field.
+func CategoryNotIn(vs ...string) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNotIn(FieldCategory, vs...))
+}
+
+// CategoryGT applies the GT predicate on the "category" field.
+func CategoryGT(v string) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldGT(FieldCategory, v))
+}
+
+// CategoryGTE applies the GTE predicate on the "category" field.
+func CategoryGTE(v string) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldGTE(FieldCategory, v))
+}
+
+// CategoryLT applies the LT predicate on the "category" field.
+func CategoryLT(v string) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldLT(FieldCategory, v))
+}
+
+// CategoryLTE applies the LTE predicate on the "category" field.
+func CategoryLTE(v string) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldLTE(FieldCategory, v))
+}
+
+// CategoryContains applies the Contains predicate on the "category" field.
+func CategoryContains(v string) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldContains(FieldCategory, v))
+}
+
+// CategoryHasPrefix applies the HasPrefix predicate on the "category" field.
+func CategoryHasPrefix(v string) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldHasPrefix(FieldCategory, v))
+}
+
+// CategoryHasSuffix applies the HasSuffix predicate on the "category" field.
+func CategoryHasSuffix(v string) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldHasSuffix(FieldCategory, v))
+}
+
+// CategoryIsNil applies the IsNil predicate on the "category" field.
+func CategoryIsNil() predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldIsNull(FieldCategory))
+}
+
+// CategoryNotNil applies the NotNil predicate on the "category" field.
+func CategoryNotNil() predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNotNull(FieldCategory))
+}
+
+// CategoryEqualFold applies the EqualFold predicate on the "category" field.
+func CategoryEqualFold(v string) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldEqualFold(FieldCategory, v))
+}
+
+// CategoryContainsFold applies the ContainsFold predicate on the "category" field.
+func CategoryContainsFold(v string) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldContainsFold(FieldCategory, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// EndedAtEQ applies the EQ predicate on the "ended_at" field.
+func EndedAtEQ(v time.Time) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// EndedAtNEQ applies the NEQ predicate on the "ended_at" field.
+func EndedAtNEQ(v time.Time) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNEQ(FieldEndedAt, v))
+}
+
+// EndedAtIn applies the In predicate on the "ended_at" field.
+func EndedAtIn(vs ...time.Time) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldIn(FieldEndedAt, vs...))
+}
+
+// EndedAtNotIn applies the NotIn predicate on the "ended_at" field.
+func EndedAtNotIn(vs ...time.Time) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNotIn(FieldEndedAt, vs...))
+}
+
+// EndedAtGT applies the GT predicate on the "ended_at" field.
+func EndedAtGT(v time.Time) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldGT(FieldEndedAt, v))
+}
+
+// EndedAtGTE applies the GTE predicate on the "ended_at" field.
+func EndedAtGTE(v time.Time) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldGTE(FieldEndedAt, v))
+}
+
+// EndedAtLT applies the LT predicate on the "ended_at" field.
+func EndedAtLT(v time.Time) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldLT(FieldEndedAt, v))
+}
+
+// EndedAtLTE applies the LTE predicate on the "ended_at" field.
+func EndedAtLTE(v time.Time) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldLTE(FieldEndedAt, v))
+}
+
+// EndedAtIsNil applies the IsNil predicate on the "ended_at" field.
+func EndedAtIsNil() predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldIsNull(FieldEndedAt))
+}
+
+// EndedAtNotNil applies the NotNil predicate on the "ended_at" field.
+func EndedAtNotNil() predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNotNull(FieldEndedAt))
+}
+
+// DurationMinutesEQ applies the EQ predicate on the "duration_minutes" field.
+func DurationMinutesEQ(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldEQ(FieldDurationMinutes, v))
+}
+
+// DurationMinutesNEQ applies the NEQ predicate on the "duration_minutes" field.
+func DurationMinutesNEQ(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNEQ(FieldDurationMinutes, v))
+}
+
+// DurationMinutesIn applies the In predicate on the "duration_minutes" field.
+func DurationMinutesIn(vs ...int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldIn(FieldDurationMinutes, vs...))
+}
+
+// DurationMinutesNotIn applies the NotIn predicate on the "duration_minutes" field.
+func DurationMinutesNotIn(vs ...int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNotIn(FieldDurationMinutes, vs...))
+}
+
+// DurationMinutesGT applies the GT predicate on the "duration_minutes" field.
+func DurationMinutesGT(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldGT(FieldDurationMinutes, v))
+}
+
+// DurationMinutesGTE applies the GTE predicate on the "duration_minutes" field.
+func DurationMinutesGTE(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldGTE(FieldDurationMinutes, v))
+}
+
+// DurationMinutesLT applies the LT predicate on the "duration_minutes" field.
+func DurationMinutesLT(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldLT(FieldDurationMinutes, v))
+}
+
+// DurationMinutesLTE applies the LTE predicate on the "duration_minutes" field.
+func DurationMinutesLTE(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldLTE(FieldDurationMinutes, v))
+}
+
+// BillableEQ applies the EQ predicate on the "billable" field.
+func BillableEQ(v bool) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldEQ(FieldBillable, v))
+}
+
+// BillableNEQ applies the NEQ predicate on the "billable" field.
+func BillableNEQ(v bool) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNEQ(FieldBillable, v))
+}
+
+// ActivityTypeEQ applies the EQ predicate on the "activity_type" field.
+func ActivityTypeEQ(v ActivityType) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldEQ(FieldActivityType, v))
+}
+
+// ActivityTypeNEQ applies the NEQ predicate on the "activity_type" field.
+func ActivityTypeNEQ(v ActivityType) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNEQ(FieldActivityType, v))
+}
+
+// ActivityTypeIn applies the In predicate on the "activity_type" field.
+func ActivityTypeIn(vs ...ActivityType) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldIn(FieldActivityType, vs...))
+}
+
+// ActivityTypeNotIn applies the NotIn predicate on the "activity_type" field.
+func ActivityTypeNotIn(vs ...ActivityType) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNotIn(FieldActivityType, vs...))
+}
+
+// CommentEQ applies the EQ predicate on the "comment" field.
+func CommentEQ(v string) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldEQ(FieldComment, v))
+}
+
+// CommentNEQ applies the NEQ predicate on the "comment" field.
+func CommentNEQ(v string) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNEQ(FieldComment, v))
+}
+
+// CommentIn applies the In predicate on the "comment" field.
+func CommentIn(vs ...string) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldIn(FieldComment, vs...))
+}
+
+// CommentNotIn applies the NotIn predicate on the "comment" field.
+func CommentNotIn(vs ...string) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNotIn(FieldComment, vs...))
+}
+
+// CommentGT applies the GT predicate on the "comment" field.
+func CommentGT(v string) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldGT(FieldComment, v))
+}
+
+// CommentGTE applies the GTE predicate on the "comment" field.
+func CommentGTE(v string) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldGTE(FieldComment, v))
+}
+
+// CommentLT applies the LT predicate on the "comment" field.
+func CommentLT(v string) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldLT(FieldComment, v))
+}
+
+// CommentLTE applies the LTE predicate on the "comment" field.
+func CommentLTE(v string) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldLTE(FieldComment, v))
+}
+
+// CommentContains applies the Contains predicate on the "comment" field.
+func CommentContains(v string) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldContains(FieldComment, v))
+}
+
+// CommentHasPrefix applies the HasPrefix predicate on the "comment" field.
+func CommentHasPrefix(v string) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldHasPrefix(FieldComment, v))
+}
+
+// CommentHasSuffix applies the HasSuffix predicate on the "comment" field.
+func CommentHasSuffix(v string) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldHasSuffix(FieldComment, v))
+}
+
+// CommentIsNil applies the IsNil predicate on the "comment" field.
+func CommentIsNil() predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldIsNull(FieldComment))
+}
+
+// CommentNotNil applies the NotNil predicate on the "comment" field.
+func CommentNotNil() predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNotNull(FieldComment))
+}
+
+// CommentEqualFold applies the EqualFold predicate on the "comment" field.
+func CommentEqualFold(v string) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldEqualFold(FieldComment, v))
+}
+
+// CommentContainsFold applies the ContainsFold predicate on the "comment" field.
+func CommentContainsFold(v string) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldContainsFold(FieldComment, v))
+}
+
+// MspProviderIDEQ applies the EQ predicate on the "msp_provider_id" field.
+func MspProviderIDEQ(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldEQ(FieldMspProviderID, v))
+}
+
+// MspProviderIDNEQ applies the NEQ predicate on the "msp_provider_id" field.
+func MspProviderIDNEQ(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNEQ(FieldMspProviderID, v))
+}
+
+// MspProviderIDIn applies the In predicate on the "msp_provider_id" field.
+func MspProviderIDIn(vs ...int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldIn(FieldMspProviderID, vs...))
+}
+
+// MspProviderIDNotIn applies the NotIn predicate on the "msp_provider_id" field.
+func MspProviderIDNotIn(vs ...int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNotIn(FieldMspProviderID, vs...))
+}
+
+// MspProviderIDGT applies the GT predicate on the "msp_provider_id" field.
+func MspProviderIDGT(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldGT(FieldMspProviderID, v))
+}
+
+// MspProviderIDGTE applies the GTE predicate on the "msp_provider_id" field.
+func MspProviderIDGTE(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldGTE(FieldMspProviderID, v))
+}
+
+// MspProviderIDLT applies the LT predicate on the "msp_provider_id" field.
+func MspProviderIDLT(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldLT(FieldMspProviderID, v))
+}
+
+// MspProviderIDLTE applies the LTE predicate on the "msp_provider_id" field.
+func MspProviderIDLTE(v int) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldLTE(FieldMspProviderID, v))
+}
+
+// MspProviderIDIsNil applies the IsNil predicate on the "msp_provider_id" field.
+func MspProviderIDIsNil() predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldIsNull(FieldMspProviderID))
+}
+
+// MspProviderIDNotNil applies the NotNil predicate on the "msp_provider_id" field.
+func MspProviderIDNotNil() predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNotNull(FieldMspProviderID))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.WorkLog {
+	return predicate.WorkLog(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WorkLog) predicate.WorkLog {
+	return predicate.WorkLog(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WorkLog) predicate.WorkLog {
+	return predicate.WorkLog(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WorkLog) predicate.WorkLog {
+	return predicate.WorkLog(sql.NotPredicates(p))
+}
@@ -0,0 +1,221 @@
+// Code generated by ent, DO NOT EDIT.
+
+package worklog
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the worklog type in the database.
+	Label = "work_log"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldTeamID holds the string denoting the team_id field in the database.
+	FieldTeamID = "team_id"
+	// FieldTicketID holds the string denoting the ticket_id field in the database.
+	FieldTicketID = "ticket_id"
+	// FieldChangeID holds the string denoting the change_id field in the database.
+	FieldChangeID = "change_id"
+	// FieldProblemID holds the string denoting the problem_id field in the database.
+	FieldProblemID = "problem_id"
+	// FieldCategory holds the string denoting the category field in the database.
+	FieldCategory = "category"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldEndedAt holds the string denoting the ended_at field in the database.
+	FieldEndedAt = "ended_at"
+	// FieldDurationMinutes holds the string denoting the duration_minutes field in the database.
+	FieldDurationMinutes = "duration_minutes"
+	// FieldBillable holds the string denoting the billable field in the database.
+	FieldBillable = "billable"
+	// FieldActivityType holds the string denoting the activity_type field in the database.
+	FieldActivityType = "activity_type"
+	// FieldComment holds the string denoting the comment field in the database.
+	FieldComment = "comment"
+	// FieldMspProviderID holds the string denoting the msp_provider_id field in the database.
+	FieldMspProviderID = "msp_provider_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the worklog in the database.
+	Table = "work_logs"
+)
+
+// Columns holds all SQL columns for worklog fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldUserID,
+	FieldTeamID,
+	FieldTicketID,
+	FieldChangeID,
+	FieldProblemID,
+	FieldCategory,
+	FieldStartedAt,
+	FieldEndedAt,
+	FieldDurationMinutes,
+	FieldBillable,
+	FieldActivityType,
+	FieldComment,
+	FieldMspProviderID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	TenantIDValidator func(int) error
+	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	UserIDValidator func(int) error
+	// DefaultDurationMinutes holds the default value on creation for the "duration_minutes" field.
+	DefaultDurationMinutes int
+	// DurationMinutesValidator is a validator for the "duration_minutes" field. It is called by the builders before save.
+	DurationMinutesValidator func(int) error
+	// DefaultBillable holds the default value on creation for the "billable" field.
+	DefaultBillable bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// ActivityType defines the type for the "activity_type" enum field.
+type ActivityType string
+
+// ActivityTypeGeneral is the default value of the ActivityType enum.
+const DefaultActivityType = ActivityTypeGeneral
+
+// ActivityType values.
+const (
+	ActivityTypeGeneral         ActivityType = "general"
+	ActivityTypeTroubleshooting ActivityType = "troubleshooting"
+	ActivityTypeImplementation  ActivityType = "implementation"
+	ActivityTypeCommunication   ActivityType = "communication"
+	ActivityTypeMeeting         ActivityType = "meeting"
+	ActivityTypeTravel          ActivityType = "travel"
+	ActivityTypeOnsite          ActivityType = "onsite"
+	ActivityTypeDocumentation   ActivityType = "documentation"
+)
+
+func (at ActivityType) String() string {
+	return string(at)
+}
+
+// ActivityTypeValidator is a validator for the "activity_type" field enum values. It is called by the builders before save.
+func ActivityTypeValidator(at ActivityType) error {
+	switch at {
+	case ActivityTypeGeneral, ActivityTypeTroubleshooting, ActivityTypeImplementation, ActivityTypeCommunication, ActivityTypeMeeting, ActivityTypeTravel, ActivityTypeOnsite, ActivityTypeDocumentation:
+		return nil
+	default:
+		return fmt.Errorf("worklog: invalid enum value for activity_type field: %q", at)
+	}
+}
+
+// OrderOption defines the ordering options for the WorkLog queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByTeamID orders the results by the team_id field.
+func ByTeamID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTeamID, opts...).ToFunc()
+}
+
+// ByTicketID orders the results by the ticket_id field.
+func ByTicketID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTicketID, opts...).ToFunc()
+}
+
+// ByChangeID orders the results by the change_id field.
+func ByChangeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChangeID, opts...).ToFunc()
+}
+
+// ByProblemID orders the results by the problem_id field.
+func ByProblemID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProblemID, opts...).ToFunc()
+}
+
+// ByCategory orders the results by the category field.
+func ByCategory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategory, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByEndedAt orders the results by the ended_at field.
+func ByEndedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndedAt, opts...).ToFunc()
+}
+
+// ByDurationMinutes orders the results by the duration_minutes field.
+func ByDurationMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDurationMinutes, opts...).ToFunc()
+}
+
+// ByBillable orders the results by the billable field.
+func ByBillable(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBillable, opts...).ToFunc()
+}
+
+// ByActivityType orders the results by the activity_type field.
+func ByActivityType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActivityType, opts...).ToFunc()
+}
+
+// ByComment orders the results by the comment field.
+func ByComment(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldComment, opts...).ToFunc()
+}
+
+// ByMspProviderID orders the results by the msp_provider_id field.
+func ByMspProviderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMspProviderID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,505 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/worklog"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WorkLogCreate is the builder for creating a WorkLog entity.
+type WorkLogCreate struct {
+	config
+	mutation *WorkLogMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *WorkLogCreate) SetTenantID(v int) *WorkLogCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *WorkLogCreate) SetUserID(v int) *WorkLogCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetTeamID sets the "team_id" field.
+func (_c *WorkLogCreate) SetTeamID(v int) *WorkLogCreate {
+	_c.mutation.SetTeamID(v)
+	return _c
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (_c *WorkLogCreate) SetNillableTeamID(v *int) *WorkLogCreate {
+	if v != nil {
+		_c.SetTeamID(*v)
+	}
+	return _c
+}
+
+// SetTicketID sets the "ticket_id" field.
+func (_c *WorkLogCreate) SetTicketID(v int) *WorkLogCreate {
+	_c.mutation.SetTicketID(v)
+	return _c
+}
+
+// SetNillableTicketID sets the "ticket_id" field if the given value is not nil.
+func (_c *WorkLogCreate) SetNillableTicketID(v *int) *WorkLogCreate {
+	if v != nil {
+		_c.SetTicketID(*v)
+	}
+	return _c
+}
+
+// SetChangeID sets the "change_id" field.
+func (_c *WorkLogCreate) SetChangeID(v int) *WorkLogCreate {
+	_c.mutation.SetChangeID(v)
+	return _c
+}
+
+// SetNillableChangeID sets the "change_id" field if the given value is not nil.
+func (_c *WorkLogCreate) SetNillableChangeID(v *int) *WorkLogCreate {
+	if v != nil {
+		_c.SetChangeID(*v)
+	}
+	return _c
+}
+
+// SetProblemID sets the "problem_id" field.
+func (_c *WorkLogCreate) SetProblemID(v int) *WorkLogCreate {
+	_c.mutation.SetProblemID(v)
+	return _c
+}
+
+// SetNillableProblemID sets the "problem_id" field if the given value is not nil.
+func (_c *WorkLogCreate) SetNillableProblemID(v *int) *WorkLogCreate {
+	if v != nil {
+		_c.SetProblemID(*v)
+	}
+	return _c
+}
+
+// SetCategory sets the "category" field.
+func (_c *WorkLogCreate) SetCategory(v string) *WorkLogCreate {
+	_c.mutation.SetCategory(v)
+	return _c
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_c *WorkLogCreate) SetNillableCategory(v *string) *WorkLogCreate {
+	if v != nil {
+		_c.SetCategory(*v)
+	}
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *WorkLogCreate) SetStartedAt(v time.Time) *WorkLogCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (_c *WorkLogCreate) SetEndedAt(v time.Time) *WorkLogCreate {
+	_c.mutation.SetEndedAt(v)
+	return _c
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_c *WorkLogCreate) SetNillableEndedAt(v *time.Time) *WorkLogCreate {
+	if v != nil {
+		_c.SetEndedAt(*v)
+	}
+	return _c
+}
+
+// SetDurationMinutes sets the "duration_minutes" field.
+func (_c *WorkLogCreate) SetDurationMinutes(v int) *WorkLogCreate {
+	_c.mutation.SetDurationMinutes(v)
+	return _c
+}
+
+// SetNillableDurationMinutes sets the "duration_minutes" field if the given value is not nil.
+func (_c *WorkLogCreate) SetNillableDurationMinutes(v *int) *WorkLogCreate {
+	if v != nil {
+		_c.SetDurationMinutes(*v)
+	}
+	return _c
+}
+
+// SetBillable sets the "billable" field.
+func (_c *WorkLogCreate) SetBillable(v bool) *WorkLogCreate {
+	_c.mutation.SetBillable(v)
+	return _c
+}
+
+// SetNillableBillable sets the "billable" field if the given value is not nil.
+func (_c *WorkLogCreate) SetNillableBillable(v *bool) *WorkLogCreate {
+	if v != nil {
+		_c.SetBillable(*v)
+	}
+	return _c
+}
+
+// SetActivityType sets the "activity_type" field.
+func (_c *WorkLogCreate) SetActivityType(v worklog.ActivityType) *WorkLogCreate {
+	_c.mutation.SetActivityType(v)
+	return _c
+}
+
+// SetNillableActivityType sets the "activity_type" field if the given value is not nil.
+func (_c *WorkLogCreate) SetNillableActivityType(v *worklog.ActivityType) *WorkLogCreate {
+	if v != nil {
+		_c.SetActivityType(*v)
+	}
+	return _c
+}
+
+// SetComment sets the "comment" field.
+func (_c *WorkLogCreate) SetComment(v string) *WorkLogCreate {
+	_c.mutation.SetComment(v)
+	return _c
+}
+
+// SetNillableComment sets the "comment" field if the given value is not nil.
+func (_c *WorkLogCreate) SetNillableComment(v *string) *WorkLogCreate {
+	if v != nil {
+		_c.SetComment(*v)
+	}
+	return _c
+}
+
+// SetMspProviderID sets the "msp_provider_id" field.
+func (_c *WorkLogCreate) SetMspProviderID(v int) *WorkLogCreate {
+	_c.mutation.SetMspProviderID(v)
+	return _c
+}
+
+// SetNillableMspProviderID sets the "msp_provider_id" field if the given value is not nil.
+func (_c *WorkLogCreate) SetNillableMspProviderID(v *int) *WorkLogCreate {
+	if v != nil {
+		_c.SetMspProviderID(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *WorkLogCreate) SetCreatedAt(v time.Time) *WorkLogCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *WorkLogCreate) SetNillableCreatedAt(v *time.Time) *WorkLogCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *WorkLogCreate) SetUpdatedAt(v time.Time) *WorkLogCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *WorkLogCreate) SetNillableUpdatedAt(v *time.Time) *WorkLogCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the WorkLogMutation object of the builder.
+func (_c *WorkLogCreate) Mutation() *WorkLogMutation {
+	return _c.mutation
+}
+
+// Save creates the WorkLog in the database.
+func (_c *WorkLogCreate) Save(ctx context.Context) (*WorkLog, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WorkLogCreate) SaveX(ctx context.Context) *WorkLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WorkLogCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WorkLogCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WorkLogCreate) defaults() {
+	if _, ok := _c.mutation.DurationMinutes(); !ok {
+		v := worklog.DefaultDurationMinutes
+		_c.mutation.SetDurationMinutes(v)
+	}
+	if _, ok := _c.mutation.Billable(); !ok {
+		v := worklog.DefaultBillable
+		_c.mutation.SetBillable(v)
+	}
+	if _, ok := _c.mutation.ActivityType(); !ok {
+		v := worklog.DefaultActivityType
+		_c.mutation.SetActivityType(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := worklog.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := worklog.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WorkLogCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "WorkLog.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := worklog.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "WorkLog.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "WorkLog.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := worklog.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "WorkLog.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "WorkLog.started_at"`)}
+	}
+	if _, ok := _c.mutation.DurationMinutes(); !ok {
+		return &ValidationError{Name: "duration_minutes", err: errors.New(`ent: missing required field "WorkLog.duration_minutes"`)}
+	}
+	if v, ok := _c.mutation.DurationMinutes(); ok {
+		if err := worklog.DurationMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "duration_minutes", err: fmt.Errorf(`ent: validator failed for field "WorkLog.duration_minutes": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Billable(); !ok {
+		return &ValidationError{Name: "billable", err: errors.New(`ent: missing required field "WorkLog.billable"`)}
+	}
+	if _, ok := _c.mutation.ActivityType(); !ok {
+		return &ValidationError{Name: "activity_type", err: errors.New(`ent: missing required field "WorkLog.activity_type"`)}
+	}
+	if v, ok := _c.mutation.ActivityType(); ok {
+		if err := worklog.ActivityTypeValidator(v); err != nil {
+			return &ValidationError{Name: "activity_type", err: fmt.Errorf(`ent: validator failed for field "WorkLog.activity_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WorkLog.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "WorkLog.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *WorkLogCreate) sqlSave(ctx context.Context) (*WorkLog, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WorkLogCreate) createSpec() (*WorkLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WorkLog{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(worklog.Table, sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(worklog.FieldTenantID, field.TypeInt, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(worklog.FieldUserID, field.TypeInt, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.TeamID(); ok {
+		_spec.SetField(worklog.FieldTeamID, field.TypeInt, value)
+		_node.TeamID = &value
+	}
+	if value, ok := _c.mutation.TicketID(); ok {
+		_spec.SetField(worklog.FieldTicketID, field.TypeInt, value)
+		_node.TicketID = &value
+	}
+	if value, ok := _c.mutation.ChangeID(); ok {
+		_spec.SetField(worklog.FieldChangeID, field.TypeInt, value)
+		_node.ChangeID = &value
+	}
+	if value, ok := _c.mutation.ProblemID(); ok {
+		_spec.SetField(worklog.FieldProblemID, field.TypeInt, value)
+		_node.ProblemID = &value
+	}
+	if value, ok := _c.mutation.Category(); ok {
+		_spec.SetField(worklog.FieldCategory, field.TypeString, value)
+		_node.Category = value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(worklog.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := _c.mutation.EndedAt(); ok {
+		_spec.SetField(worklog.FieldEndedAt, field.TypeTime, value)
+		_node.EndedAt = &value
+	}
+	if value, ok := _c.mutation.DurationMinutes(); ok {
+		_spec.SetField(worklog.FieldDurationMinutes, field.TypeInt, value)
+		_node.DurationMinutes = value
+	}
+	if value, ok := _c.mutation.Billable(); ok {
+		_spec.SetField(worklog.FieldBillable, field.TypeBool, value)
+		_node.Billable = value
+	}
+	if value, ok := _c.mutation.ActivityType(); ok {
+		_spec.SetField(worklog.FieldActivityType, field.TypeEnum, value)
+		_node.ActivityType = value
+	}
+	if value, ok := _c.mutation.Comment(); ok {
+		_spec.SetField(worklog.FieldComment, field.TypeString, value)
+		_node.Comment = value
+	}
+	if value, ok := _c.mutation.MspProviderID(); ok {
+		_spec.SetField(worklog.FieldMspProviderID, field.TypeInt, value)
+		_node.MspProviderID = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(worklog.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(worklog.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// WorkLogCreateBulk is the builder for creating many WorkLog entities in bulk.
+type WorkLogCreateBulk struct {
+	config
+	err      error
+	builders []*WorkLogCreate
+}
+
+// Save creates the WorkLog entities in the database.
+func (_c *WorkLogCreateBulk) Save(ctx context.Context) ([]*WorkLog, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WorkLog, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WorkLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WorkLogCreateBulk) SaveX(ctx context.Context) []*WorkLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WorkLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WorkLogCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1048 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"itsm-backend/ent/predicate"
+	"itsm-backend/ent/worklog"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WorkLogUpdate is the builder for updating WorkLog entities.
+type WorkLogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WorkLogMutation
+}
+
+// Where appends a list predicates to the WorkLogUpdate builder.
+func (_u *WorkLogUpdate) Where(ps ...predicate.WorkLog) *WorkLogUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *WorkLogUpdate) SetTenantID(v int) *WorkLogUpdate {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *WorkLogUpdate) SetNillableTenantID(v *int) *WorkLogUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *WorkLogUpdate) AddTenantID(v int) *WorkLogUpdate {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *WorkLogUpdate) SetUserID(v int) *WorkLogUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *WorkLogUpdate) SetNillableUserID(v *int) *WorkLogUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *WorkLogUpdate) AddUserID(v int) *WorkLogUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetTeamID sets the "team_id" field.
+func (_u *WorkLogUpdate) SetTeamID(v int) *WorkLogUpdate {
+	_u.mutation.ResetTeamID()
+	_u.mutation.SetTeamID(v)
+	return _u
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (_u *WorkLogUpdate) SetNillableTeamID(v *int) *WorkLogUpdate {
+	if v != nil {
+		_u.SetTeamID(*v)
+	}
+	return _u
+}
+
+// AddTeamID adds value to the "team_id" field.
+func (_u *WorkLogUpdate) AddTeamID(v int) *WorkLogUpdate {
+	_u.mutation.AddTeamID(v)
+	return _u
+}
+
+// ClearTeamID clears the value of the "team_id" field.
+func (_u *WorkLogUpdate) ClearTeamID() *WorkLogUpdate {
+	_u.mutation.ClearTeamID()
+	return _u
+}
+
+// SetTicketID sets the "ticket_id" field.
+func (_u *WorkLogUpdate) SetTicketID(v int) *WorkLogUpdate {
+	_u.mutation.ResetTicketID()
+	_u.mutation.SetTicketID(v)
+	return _u
+}
+
+// SetNillableTicketID sets the "ticket_id" field if the given value is not nil.
+func (_u *WorkLogUpdate) SetNillableTicketID(v *int) *WorkLogUpdate {
+	if v != nil {
+		_u.SetTicketID(*v)
+	}
+	return _u
+}
+
+// AddTicketID adds value to the "ticket_id" field.
+func (_u *WorkLogUpdate) AddTicketID(v int) *WorkLogUpdate {
+	_u.mutation.AddTicketID(v)
+	return _u
+}
+
+// ClearTicketID clears the value of the "ticket_id" field.
+func (_u *WorkLogUpdate) ClearTicketID() *WorkLogUpdate {
+	_u.mutation.ClearTicketID()
+	return _u
+}
+
+// SetChangeID sets the "change_id" field.
+func (_u *WorkLogUpdate) SetChangeID(v int) *WorkLogUpdate {
+	_u.mutation.ResetChangeID()
+	_u.mutation.SetChangeID(v)
+	return _u
+}
+
+// SetNillableChangeID sets the "change_id" field if the given value is not nil.
+func (_u *WorkLogUpdate) SetNillableChangeID(v *int) *WorkLogUpdate {
+	if v != nil {
+		_u.SetChangeID(*v)
+	}
+	return _u
+}
+
+// AddChangeID adds value to the "change_id" field.
+func (_u *WorkLogUpdate) AddChangeID(v int) *WorkLogUpdate {
+	_u.mutation.AddChangeID(v)
+	return _u
+}
+
+// ClearChangeID clears the value of the "change_id" field.
+func (_u *WorkLogUpdate) ClearChangeID() *WorkLogUpdate {
+	_u.mutation.ClearChangeID()
+	return _u
+}
+
+// SetProblemID sets the "problem_id" field.
+func (_u *WorkLogUpdate) SetProblemID(v int) *WorkLogUpdate {
+	_u.mutation.ResetProblemID()
+	_u.mutation.SetProblemID(v)
+	return _u
+}
+
+// SetNillableProblemID sets the "problem_id" field if the given value is not nil.
+func (_u *WorkLogUpdate) SetNillableProblemID(v *int) *WorkLogUpdate {
+	if v != nil {
+		_u.SetProblemID(*v)
+	}
+	return _u
+}
+
+// AddProblemID adds value to the "problem_id" field.
+func (_u *WorkLogUpdate) AddProblemID(v int) *WorkLogUpdate {
+	_u.mutation.AddProblemID(v)
+	return _u
+}
+
+// ClearProblemID clears the value of the "problem_id" field.
+func (_u *WorkLogUpdate) ClearProblemID() *WorkLogUpdate {
+	_u.mutation.ClearProblemID()
+	return _u
+}
+
+// SetCategory sets the "category" field.
+func (_u *WorkLogUpdate) SetCategory(v string) *WorkLogUpdate {
+	_u.mutation.SetCategory(v)
+	return _u
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_u *WorkLogUpdate) SetNillableCategory(v *string) *WorkLogUpdate {
+	if v != nil {
+		_u.SetCategory(*v)
+	}
+	return _u
+}
+
+// ClearCategory clears the value of the "category" field.
+func (_u *WorkLogUpdate) ClearCategory() *WorkLogUpdate {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *WorkLogUpdate) SetStartedAt(v time.Time) *WorkLogUpdate {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *WorkLogUpdate) SetNillableStartedAt(v *time.Time) *WorkLogUpdate {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (_u *WorkLogUpdate) SetEndedAt(v time.Time) *WorkLogUpdate {
+	_u.mutation.SetEndedAt(v)
+	return _u
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_u *WorkLogUpdate) SetNillableEndedAt(v *time.Time) *WorkLogUpdate {
+	if v != nil {
+		_u.SetEndedAt(*v)
+	}
+	return _u
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (_u *WorkLogUpdate) ClearEndedAt() *WorkLogUpdate {
+	_u.mutation.ClearEndedAt()
+	return _u
+}
+
+// SetDurationMinutes sets the "duration_minutes" field.
+func (_u *WorkLogUpdate) SetDurationMinutes(v int) *WorkLogUpdate {
+	_u.mutation.ResetDurationMinutes()
+	_u.mutation.SetDurationMinutes(v)
+	return _u
+}
+
+// SetNillableDurationMinutes sets the "duration_minutes" field if the given value is not nil.
+func (_u *WorkLogUpdate) SetNillableDurationMinutes(v *int) *WorkLogUpdate {
+	if v != nil {
+		_u.SetDurationMinutes(*v)
+	}
+	return _u
+}
+
+// AddDurationMinutes adds value to the "duration_minutes" field.
+func (_u *WorkLogUpdate) AddDurationMinutes(v int) *WorkLogUpdate {
+	_u.mutation.AddDurationMinutes(v)
+	return _u
+}
+
+// SetBillable sets the "billable" field.
+func (_u *WorkLogUpdate) SetBillable(v bool) *WorkLogUpdate {
+	_u.mutation.SetBillable(v)
+	return _u
+}
+
+// SetNillableBillable sets the "billable" field if the given value is not nil.
+func (_u *WorkLogUpdate) SetNillableBillable(v *bool) *WorkLogUpdate {
+	if v != nil {
+		_u.SetBillable(*v)
+	}
+	return _u
+}
+
+// SetActivityType sets the "activity_type" field.
+func (_u *WorkLogUpdate) SetActivityType(v worklog.ActivityType) *WorkLogUpdate {
+	_u.mutation.SetActivityType(v)
+	return _u
+}
+
+// SetNillableActivityType sets the "activity_type" field if the given value is not nil.
+func (_u *WorkLogUpdate) SetNillableActivityType(v *worklog.ActivityType) *WorkLogUpdate {
+	if v != nil {
+		_u.SetActivityType(*v)
+	}
+	return _u
+}
+
+// SetComment sets the "comment" field.
+func (_u *WorkLogUpdate) SetComment(v string) *WorkLogUpdate {
+	_u.mutation.SetComment(v)
+	return _u
+}
+
+// SetNillableComment sets the "comment" field if the given value is not nil.
+func (_u *WorkLogUpdate) SetNillableComment(v *string) *WorkLogUpdate {
+	if v != nil {
+		_u.SetComment(*v)
+	}
+	return _u
+}
+
+// ClearComment clears the value of the "comment" field.
+func (_u *WorkLogUpdate) ClearComment() *WorkLogUpdate {
+	_u.mutation.ClearComment()
+	return _u
+}
+
+// SetMspProviderID sets the "msp_provider_id" field.
+func (_u *WorkLogUpdate) SetMspProviderID(v int) *WorkLogUpdate {
+	_u.mutation.ResetMspProviderID()
+	_u.mutation.SetMspProviderID(v)
+	return _u
+}
+
+// SetNillableMspProviderID sets the "msp_provider_id" field if the given value is not nil.
+func (_u *WorkLogUpdate) SetNillableMspProviderID(v *int) *WorkLogUpdate {
+	if v != nil {
+		_u.SetMspProviderID(*v)
+	}
+	return _u
+}
+
+// AddMspProviderID adds value to the "msp_provider_id" field.
+func (_u *WorkLogUpdate) AddMspProviderID(v int) *WorkLogUpdate {
+	_u.mutation.AddMspProviderID(v)
+	return _u
+}
+
+// ClearMspProviderID clears the value of the "msp_provider_id" field.
+func (_u *WorkLogUpdate) ClearMspProviderID() *WorkLogUpdate {
+	_u.mutation.ClearMspProviderID()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WorkLogUpdate) SetUpdatedAt(v time.Time) *WorkLogUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the WorkLogMutation object of the builder.
+func (_u *WorkLogUpdate) Mutation() *WorkLogMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WorkLogUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WorkLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WorkLogUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WorkLogUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WorkLogUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := worklog.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WorkLogUpdate) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := worklog.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "WorkLog.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := worklog.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "WorkLog.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DurationMinutes(); ok {
+		if err := worklog.DurationMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "duration_minutes", err: fmt.Errorf(`ent: validator failed for field "WorkLog.duration_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ActivityType(); ok {
+		if err := worklog.ActivityTypeValidator(v); err != nil {
+			return &ValidationError{Name: "activity_type", err: fmt.Errorf(`ent: validator failed for field "WorkLog.activity_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *WorkLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(worklog.Table, worklog.Columns, sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(worklog.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(worklog.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(worklog.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(worklog.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TeamID(); ok {
+		_spec.SetField(worklog.FieldTeamID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTeamID(); ok {
+		_spec.AddField(worklog.FieldTeamID, field.TypeInt, value)
+	}
+	if _u.mutation.TeamIDCleared() {
+		_spec.ClearField(worklog.FieldTeamID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.TicketID(); ok {
+		_spec.SetField(worklog.FieldTicketID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTicketID(); ok {
+		_spec.AddField(worklog.FieldTicketID, field.TypeInt, value)
+	}
+	if _u.mutation.TicketIDCleared() {
+		_spec.ClearField(worklog.FieldTicketID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ChangeID(); ok {
+		_spec.SetField(worklog.FieldChangeID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChangeID(); ok {
+		_spec.AddField(worklog.FieldChangeID, field.TypeInt, value)
+	}
+	if _u.mutation.ChangeIDCleared() {
+		_spec.ClearField(worklog.FieldChangeID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ProblemID(); ok {
+		_spec.SetField(worklog.FieldProblemID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProblemID(); ok {
+		_spec.AddField(worklog.FieldProblemID, field.TypeInt, value)
+	}
+	if _u.mutation.ProblemIDCleared() {
+		_spec.ClearField(worklog.FieldProblemID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Category(); ok {
+		_spec.SetField(worklog.FieldCategory, field.TypeString, value)
+	}
+	if _u.mutation.CategoryCleared() {
+		_spec.ClearField(worklog.FieldCategory, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(worklog.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndedAt(); ok {
+		_spec.SetField(worklog.FieldEndedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EndedAtCleared() {
+		_spec.ClearField(worklog.FieldEndedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DurationMinutes(); ok {
+		_spec.SetField(worklog.FieldDurationMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMinutes(); ok {
+		_spec.AddField(worklog.FieldDurationMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Billable(); ok {
+		_spec.SetField(worklog.FieldBillable, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ActivityType(); ok {
+		_spec.SetField(worklog.FieldActivityType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Comment(); ok {
+		_spec.SetField(worklog.FieldComment, field.TypeString, value)
+	}
+	if _u.mutation.CommentCleared() {
+		_spec.ClearField(worklog.FieldComment, field.TypeString)
+	}
+	if value, ok := _u.mutation.MspProviderID(); ok {
+		_spec.SetField(worklog.FieldMspProviderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMspProviderID(); ok {
+		_spec.AddField(worklog.FieldMspProviderID, field.TypeInt, value)
+	}
+	if _u.mutation.MspProviderIDCleared() {
+		_spec.ClearField(worklog.FieldMspProviderID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(worklog.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{worklog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WorkLogUpdateOne is the builder for updating a single WorkLog entity.
+type WorkLogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WorkLogMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *WorkLogUpdateOne) SetTenantID(v int) *WorkLogUpdateOne {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *WorkLogUpdateOne) SetNillableTenantID(v *int) *WorkLogUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *WorkLogUpdateOne) AddTenantID(v int) *WorkLogUpdateOne {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *WorkLogUpdateOne) SetUserID(v int) *WorkLogUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *WorkLogUpdateOne) SetNillableUserID(v *int) *WorkLogUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *WorkLogUpdateOne) AddUserID(v int) *WorkLogUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetTeamID sets the "team_id" field.
+func (_u *WorkLogUpdateOne) SetTeamID(v int) *WorkLogUpdateOne {
+	_u.mutation.ResetTeamID()
+	_u.mutation.SetTeamID(v)
+	return _u
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (_u *WorkLogUpdateOne) SetNillableTeamID(v *int) *WorkLogUpdateOne {
+	if v != nil {
+		_u.SetTeamID(*v)
+	}
+	return _u
+}
+
+// AddTeamID adds value to the "team_id" field.
+func (_u *WorkLogUpdateOne) AddTeamID(v int) *WorkLogUpdateOne {
+	_u.mutation.AddTeamID(v)
+	return _u
+}
+
+// ClearTeamID clears the value of the "team_id" field.
+func (_u *WorkLogUpdateOne) ClearTeamID() *WorkLogUpdateOne {
+	_u.mutation.ClearTeamID()
+	return _u
+}
+
+// SetTicketID sets the "ticket_id" field.
+func (_u *WorkLogUpdateOne) SetTicketID(v int) *WorkLogUpdateOne {
+	_u.mutation.ResetTicketID()
+	_u.mutation.SetTicketID(v)
+	return _u
+}
+
+// SetNillableTicketID sets the "ticket_id" field if the given value is not nil.
+func (_u *WorkLogUpdateOne) SetNillableTicketID(v *int) *WorkLogUpdateOne {
+	if v != nil {
+		_u.SetTicketID(*v)
+	}
+	return _u
+}
+
+// AddTicketID adds value to the "ticket_id" field.
+func (_u *WorkLogUpdateOne) AddTicketID(v int) *WorkLogUpdateOne {
+	_u.mutation.AddTicketID(v)
+	return _u
+}
+
+// ClearTicketID clears the value of the "ticket_id" field.
+func (_u *WorkLogUpdateOne) ClearTicketID() *WorkLogUpdateOne {
+	_u.mutation.ClearTicketID()
+	return _u
+}
+
+// SetChangeID sets the "change_id" field.
+func (_u *WorkLogUpdateOne) SetChangeID(v int) *WorkLogUpdateOne {
+	_u.mutation.ResetChangeID()
+	_u.mutation.SetChangeID(v)
+	return _u
+}
+
+// SetNillableChangeID sets the "change_id" field if the given value is not nil.
+func (_u *WorkLogUpdateOne) SetNillableChangeID(v *int) *WorkLogUpdateOne {
+	if v != nil {
+		_u.SetChangeID(*v)
+	}
+	return _u
+}
+
+// AddChangeID adds value to the "change_id" field.
+func (_u *WorkLogUpdateOne) AddChangeID(v int) *WorkLogUpdateOne {
+	_u.mutation.AddChangeID(v)
+	return _u
+}
+
+// ClearChangeID clears the value of the "change_id" field.
+func (_u *WorkLogUpdateOne) ClearChangeID() *WorkLogUpdateOne {
+	_u.mutation.ClearChangeID()
+	return _u
+}
+
+// SetProblemID sets the "problem_id" field.
+func (_u *WorkLogUpdateOne) SetProblemID(v int) *WorkLogUpdateOne {
+	_u.mutation.ResetProblemID()
+	_u.mutation.SetProblemID(v)
+	return _u
+}
+
+// SetNillableProblemID sets the "problem_id" field if the given value is not nil.
+func (_u *WorkLogUpdateOne) SetNillableProblemID(v *int) *WorkLogUpdateOne {
+	if v != nil {
+		_u.SetProblemID(*v)
+	}
+	return _u
+}
+
+// AddProblemID adds value to the "problem_id" field.
+func (_u *WorkLogUpdateOne) AddProblemID(v int) *WorkLogUpdateOne {
+	_u.mutation.AddProblemID(v)
+	return _u
+}
+
+// ClearProblemID clears the value of the "problem_id" field.
+func (_u *WorkLogUpdateOne) ClearProblemID() *WorkLogUpdateOne {
+	_u.mutation.ClearProblemID()
+	return _u
+}
+
+// SetCategory sets the "category" field.
+func (_u *WorkLogUpdateOne) SetCategory(v string) *WorkLogUpdateOne {
+	_u.mutation.SetCategory(v)
+	return _u
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_u *WorkLogUpdateOne) SetNillableCategory(v *string) *WorkLogUpdateOne {
+	if v != nil {
+		_u.SetCategory(*v)
+	}
+	return _u
+}
+
+// ClearCategory clears the value of the "category" field.
+func (_u *WorkLogUpdateOne) ClearCategory() *WorkLogUpdateOne {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *WorkLogUpdateOne) SetStartedAt(v time.Time) *WorkLogUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *WorkLogUpdateOne) SetNillableStartedAt(v *time.Time) *WorkLogUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (_u *WorkLogUpdateOne) SetEndedAt(v time.Time) *WorkLogUpdateOne {
+	_u.mutation.SetEndedAt(v)
+	return _u
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_u *WorkLogUpdateOne) SetNillableEndedAt(v *time.Time) *WorkLogUpdateOne {
+	if v != nil {
+		_u.SetEndedAt(*v)
+	}
+	return _u
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (_u *WorkLogUpdateOne) ClearEndedAt() *WorkLogUpdateOne {
+	_u.mutation.ClearEndedAt()
+	return _u
+}
+
+// SetDurationMinutes sets the "duration_minutes" field.
+func (_u *WorkLogUpdateOne) SetDurationMinutes(v int) *WorkLogUpdateOne {
+	_u.mutation.ResetDurationMinutes()
+	_u.mutation.SetDurationMinutes(v)
+	return _u
+}
+
+// SetNillableDurationMinutes sets the "duration_minutes" field if the given value is not nil.
+func (_u *WorkLogUpdateOne) SetNillableDurationMinutes(v *int) *WorkLogUpdateOne {
+	if v != nil {
+		_u.SetDurationMinutes(*v)
+	}
+	return _u
+}
+
+// AddDurationMinutes adds value to the "duration_minutes" field.
+func (_u *WorkLogUpdateOne) AddDurationMinutes(v int) *WorkLogUpdateOne {
+	_u.mutation.AddDurationMinutes(v)
+	return _u
+}
+
+// SetBillable sets the "billable" field.
+func (_u *WorkLogUpdateOne) SetBillable(v bool) *WorkLogUpdateOne {
+	_u.mutation.SetBillable(v)
+	return _u
+}
+
+// SetNillableBillable sets the "billable" field if the given value is not nil.
+func (_u *WorkLogUpdateOne) SetNillableBillable(v *bool) *WorkLogUpdateOne {
+	if v != nil {
+		_u.SetBillable(*v)
+	}
+	return _u
+}
+
+// SetActivityType sets the "activity_type" field.
+func (_u *WorkLogUpdateOne) SetActivityType(v worklog.ActivityType) *WorkLogUpdateOne {
+	_u.mutation.SetActivityType(v)
+	return _u
+}
+
+// SetNillableActivityType sets the "activity_type" field if the given value is not nil.
+func (_u *WorkLogUpdateOne) SetNillableActivityType(v *worklog.ActivityType) *WorkLogUpdateOne {
+	if v != nil {
+		_u.SetActivityType(*v)
+	}
+	return _u
+}
+
+// SetComment sets the "comment" field.
+func (_u *WorkLogUpdateOne) SetComment(v string) *WorkLogUpdateOne {
+	_u.mutation.SetComment(v)
+	return _u
+}
+
+// SetNillableComment sets the "comment" field if the given value is not nil.
+func (_u *WorkLogUpdateOne) SetNillableComment(v *string) *WorkLogUpdateOne {
+	if v != nil {
+		_u.SetComment(*v)
+	}
+	return _u
+}
+
+// ClearComment clears the value of the "comment" field.
+func (_u *WorkLogUpdateOne) ClearComment() *WorkLogUpdateOne {
+	_u.mutation.ClearComment()
+	return _u
+}
+
+// SetMspProviderID sets the "msp_provider_id" field.
+func (_u *WorkLogUpdateOne) SetMspProviderID(v int) *WorkLogUpdateOne {
+	_u.mutation.ResetMspProviderID()
+	_u.mutation.SetMspProviderID(v)
+	return _u
+}
+
+// SetNillableMspProviderID sets the "msp_provider_id" field if the given value is not nil.
+func (_u *WorkLogUpdateOne) SetNillableMspProviderID(v *int) *WorkLogUpdateOne {
+	if v != nil {
+		_u.SetMspProviderID(*v)
+	}
+	return _u
+}
+
+// AddMspProviderID adds value to the "msp_provider_id" field.
+func (_u *WorkLogUpdateOne) AddMspProviderID(v int) *WorkLogUpdateOne {
+	_u.mutation.AddMspProviderID(v)
+	return _u
+}
+
+// ClearMspProviderID clears the value of the "msp_provider_id" field.
+func (_u *WorkLogUpdateOne) ClearMspProviderID() *WorkLogUpdateOne {
+	_u.mutation.ClearMspProviderID()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WorkLogUpdateOne) SetUpdatedAt(v time.Time) *WorkLogUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the WorkLogMutation object of the builder.
+func (_u *WorkLogUpdateOne) Mutation() *WorkLogMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the WorkLogUpdate builder.
+func (_u *WorkLogUpdateOne) Where(ps ...predicate.WorkLog) *WorkLogUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WorkLogUpdateOne) Select(field string, fields ...string) *WorkLogUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WorkLog entity.
+func (_u *WorkLogUpdateOne) Save(ctx context.Context) (*WorkLog, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WorkLogUpdateOne) SaveX(ctx context.Context) *WorkLog {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WorkLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WorkLogUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WorkLogUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := worklog.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WorkLogUpdateOne) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := worklog.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "WorkLog.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := worklog.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "WorkLog.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DurationMinutes(); ok {
+		if err := worklog.DurationMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "duration_minutes", err: fmt.Errorf(`ent: validator failed for field "WorkLog.duration_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ActivityType(); ok {
+		if err := worklog.ActivityTypeValidator(v); err != nil {
+			return &ValidationError{Name: "activity_type", err: fmt.Errorf(`ent: validator failed for field "WorkLog.activity_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *WorkLogUpdateOne) sqlSave(ctx context.Context) (_node *WorkLog, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(worklog.Table, worklog.Columns, sqlgraph.NewFieldSpec(worklog.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WorkLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, worklog.FieldID)
+		for _, f := range fields {
+			if !worklog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != worklog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(worklog.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(worklog.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(worklog.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(worklog.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TeamID(); ok {
+		_spec.SetField(worklog.FieldTeamID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTeamID(); ok {
+		_spec.AddField(worklog.FieldTeamID, field.TypeInt, value)
+	}
+	if _u.mutation.TeamIDCleared() {
+		_spec.ClearField(worklog.FieldTeamID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.TicketID(); ok {
+		_spec.SetField(worklog.FieldTicketID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTicketID(); ok {
+		_spec.AddField(worklog.FieldTicketID, field.TypeInt, value)
+	}
+	if _u.mutation.TicketIDCleared() {
+		_spec.ClearField(worklog.FieldTicketID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ChangeID(); ok {
+		_spec.SetField(worklog.FieldChangeID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChangeID(); ok {
+		_spec.AddField(worklog.FieldChangeID, field.TypeInt, value)
+	}
+	if _u.mutation.ChangeIDCleared() {
+		_spec.ClearField(worklog.FieldChangeID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ProblemID(); ok {
+		_spec.SetField(worklog.FieldProblemID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProblemID(); ok {
+		_spec.AddField(worklog.FieldProblemID, field.TypeInt, value)
+	}
+	if _u.mutation.ProblemIDCleared() {
+		_spec.ClearField(worklog.FieldProblemID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Category(); ok {
+		_spec.SetField(worklog.FieldCategory, field.TypeString, value)
+	}
+	if _u.mutation.CategoryCleared() {
+		_spec.ClearField(worklog.FieldCategory, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(worklog.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndedAt(); ok {
+		_spec.SetField(worklog.FieldEndedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EndedAtCleared() {
+		_spec.ClearField(worklog.FieldEndedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DurationMinutes(); ok {
+		_spec.SetField(worklog.FieldDurationMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMinutes(); ok {
+		_spec.AddField(worklog.FieldDurationMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Billable(); ok {
+		_spec.SetField(worklog.FieldBillable, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ActivityType(); ok {
+		_spec.SetField(worklog.FieldActivityType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Comment(); ok {
+		_spec.SetField(worklog.FieldComment, field.TypeString, value)
+	}
+	if _u.mutation.CommentCleared() {
+		_spec.ClearField(worklog.FieldComment, field.TypeString)
+	}
+	if value, ok := _u.mutation.MspProviderID(); ok {
+		_spec.SetField(worklog.FieldMspProviderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMspProviderID(); ok {
+		_spec.AddField(worklog.FieldMspProviderID, field.TypeInt, value)
+	}
+	if _u.mutation.MspProviderIDCleared() {
+		_spec.ClearField(worklog.FieldMspProviderID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(worklog.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &WorkLog{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{worklog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
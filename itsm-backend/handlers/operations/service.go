@@ -13,7 +13,7 @@ import (
 	"itsm-backend/internal/commandbus"
 )
 
-const StatusCancelled = "cancelled"
+const StatusCancelled = commandbus.StatusCancelled
 
 var (
 	ErrCommandNotFound = errors.New("operational command not found")
@@ -2,6 +2,8 @@ package sla
 
 import (
 	"time"
+
+	"itsm-backend/common/slapause"
 )
 
 // SLADefinition represents an SLA policy
@@ -14,6 +16,8 @@ type SLADefinition struct {
 	ResponseTime    int                    `json:"responseTime"`   // in minutes
 	ResolutionTime  int                    `json:"resolutionTime"` // in minutes
 	BusinessHours   map[string]interface{} `json:"businessHours"`
+	CalendarID      *int                   `json:"calendarId,omitempty"`
+	PauseConditions *slapause.Conditions   `json:"pauseConditions,omitempty"`
 	EscalationRules map[string]interface{} `json:"escalationRules"`
 	Conditions      map[string]interface{} `json:"conditions"`
 	IsActive        bool                   `json:"isActive"`
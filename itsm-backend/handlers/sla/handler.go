@@ -32,6 +32,8 @@ func toSLADefinitionDTO(s *SLADefinition) *dto.SLADefinitionResponse {
 		ResponseTime:    s.ResponseTime,
 		ResolutionTime:  s.ResolutionTime,
 		BusinessHours:   s.BusinessHours,
+		CalendarID:      s.CalendarID,
+		PauseConditions: s.PauseConditions,
 		EscalationRules: s.EscalationRules,
 		Conditions:      s.Conditions,
 		IsActive:        s.IsActive,
@@ -58,6 +60,8 @@ func (h *Handler) CreateSLADefinition(c *gin.Context) {
 		ResponseTime:    req.ResponseTime,
 		ResolutionTime:  req.ResolutionTime,
 		BusinessHours:   req.BusinessHours,
+		CalendarID:      req.CalendarID,
+		PauseConditions: req.PauseConditions,
 		EscalationRules: req.EscalationRules,
 		Conditions:      req.Conditions,
 		IsActive:        req.IsActive,
@@ -152,6 +156,15 @@ func (h *Handler) UpdateSLADefinition(c *gin.Context) {
 	if req.BusinessHours != nil {
 		existing.BusinessHours = req.BusinessHours
 	}
+	if req.CalendarID != nil {
+		existing.CalendarID = req.CalendarID
+		if *req.CalendarID <= 0 {
+			existing.CalendarID = nil
+		}
+	}
+	if req.PauseConditions != nil {
+		existing.PauseConditions = req.PauseConditions
+	}
 	if req.EscalationRules != nil {
 		existing.EscalationRules = req.EscalationRules
 	}
@@ -37,6 +37,8 @@ func toSLADefinitionDomain(e *ent.SLADefinition) *SLADefinition {
 		ResponseTime:    e.ResponseTime,
 		ResolutionTime:  e.ResolutionTime,
 		BusinessHours:   e.BusinessHours,
+		CalendarID:      e.CalendarID,
+		PauseConditions: e.PauseConditions,
 		EscalationRules: e.EscalationRules,
 		Conditions:      e.Conditions,
 		IsActive:        e.IsActive,
@@ -55,6 +57,8 @@ func (r *EntRepository) CreateDefinition(ctx context.Context, s *SLADefinition)
 		SetResponseTime(s.ResponseTime).
 		SetResolutionTime(s.ResolutionTime).
 		SetBusinessHours(s.BusinessHours).
+		SetNillableCalendarID(s.CalendarID).
+		SetPauseConditions(s.PauseConditions).
 		SetEscalationRules(s.EscalationRules).
 		SetConditions(s.Conditions).
 		SetIsActive(s.IsActive).
@@ -96,7 +100,13 @@ func (r *EntRepository) ListDefinitions(ctx context.Context, tenantID int, page,
 }
 
 func (r *EntRepository) UpdateDefinition(ctx context.Context, s *SLADefinition) (*SLADefinition, error) {
-	e, err := r.client.SLADefinition.UpdateOneID(s.ID).
+	update := r.client.SLADefinition.UpdateOneID(s.ID)
+	if s.CalendarID != nil {
+		update.SetCalendarID(*s.CalendarID)
+	} else {
+		update.ClearCalendarID()
+	}
+	e, err := update.
 		Where(sladefinition.TenantID(s.TenantID)).
 		SetName(s.Name).
 		SetDescription(s.Description).
@@ -105,6 +115,7 @@ func (r *EntRepository) UpdateDefinition(ctx context.Context, s *SLADefinition)
 		SetResponseTime(s.ResponseTime).
 		SetResolutionTime(s.ResolutionTime).
 		SetBusinessHours(s.BusinessHours).
+		SetPauseConditions(s.PauseConditions).
 		SetEscalationRules(s.EscalationRules).
 		SetConditions(s.Conditions).
 		SetIsActive(s.IsActive).
@@ -20,7 +20,11 @@ import (
 	"itsm-backend/connector"
 	_ "itsm-backend/connector/builtin/console"
 	_ "itsm-backend/connector/builtin/dingtalk"
+	_ "itsm-backend/connector/builtin/email"
 	_ "itsm-backend/connector/builtin/feishu"
+	_ "itsm-backend/connector/builtin/monitoring"
+	_ "itsm-backend/connector/builtin/slack"
+	_ "itsm-backend/connector/builtin/teams"
 	_ "itsm-backend/connector/builtin/webhook"
 	_ "itsm-backend/connector/builtin/wecom"
 	"itsm-backend/connector/marketplace"
@@ -74,6 +78,16 @@ type Application struct {
 	VectorStore   *service.VectorStore
 	CommandWorker *commandbus.Worker
 	SkillRegistry *service.SkillRegistry
+	// TicketSchedules 周期工单计划，后台定时巡检并续排中断的命令链
+	TicketSchedules *service.TicketScheduleService
+	// EmailInbound 邮件渠道入站，后台定时轮询各租户 IMAP 邮箱
+	EmailInbound *service.EmailInboundService
+	// MajorIncidents 重大事件，后台定时发送到期的干系人进展通报
+	MajorIncidents *service.MajorIncidentService
+	// OnCall 值班管理，后台定时把确认超时的值班呼叫升级到下一步
+	OnCall *service.OnCallService
+	// InboundMessages IM 入站消息日志，后台定时清理过期去重 key 与历史日志
+	InboundMessages *service.InboundMessageService
 
 	// backgroundWG 跟踪由 startBackgroundTasks 启动的所有后台 goroutine。
 	// 在 Stop() 中等待它们退出，避免应用关闭时强制杀死进行中的任务。
@@ -240,6 +254,12 @@ func NewApplication() *Application {
 	if err := commandRegistry.Register(commandbus.CommandStartBPMN, workflowCommandHandler.Handle); err != nil {
 		sugar.Fatalw("Failed to register workflow command handler", "error", err)
 	}
+	if err := commandRegistry.Register(commandbus.CommandFireBPMNTimer, processEngine.(*service.CustomProcessEngine).HandleTimerCommand); err != nil {
+		sugar.Fatalw("Failed to register BPMN timer command handler", "error", err)
+	}
+	if err := commandRegistry.Register(commandbus.CommandExecuteBPMNJob, processEngine.(*service.CustomProcessEngine).HandleAsyncJobCommand); err != nil {
+		sugar.Fatalw("Failed to register BPMN async job command handler", "error", err)
+	}
 	workerOwner, _ := os.Hostname()
 	if workerOwner == "" {
 		workerOwner = "itsm-api"
@@ -282,6 +302,12 @@ func NewApplication() *Application {
 	if err := commandRegistry.Register(commandbus.CommandSyncTicketFeishu, ticketFeishuCommandHandler.Handle); err != nil {
 		sugar.Fatalw("Failed to register ticket feishu command handler", "error", err)
 	}
+	// 全文检索：业务写入经 ent hook 投递 search.index 命令，由 worker 异步刷新索引
+	fullTextSearchService := service.NewFullTextSearchService(client, sugar)
+	if err := commandRegistry.Register(commandbus.CommandIndexSearchDocument, fullTextSearchService.HandleIndexCommand); err != nil {
+		sugar.Fatalw("Failed to register search index command handler", "error", err)
+	}
+	fullTextSearchService.RegisterHooks(client)
 
 	// V2 工单服务（构造函数注入）
 	ticketService := service.NewTicketService(&service.TicketServiceConfig{
@@ -298,6 +324,11 @@ func NewApplication() *Application {
 	})
 	ticketService.EnableWorkflowOutbox()
 	ticketService.EnableSideEffectOutbox()
+	// 周期工单计划：每次发生以 ticket.schedule.fire 命令入箱，available_at 即触发时刻
+	ticketScheduleService := service.NewTicketScheduleService(client, ticketService, sugar)
+	if err := commandRegistry.Register(commandbus.CommandFireTicketSchedule, ticketScheduleService.HandleFireCommand); err != nil {
+		sugar.Fatalw("Failed to register ticket schedule command handler", "error", err)
+	}
 	_ = sequenceService // V2 内部通过 Repository.GenerateTicketNumber 使用 sequence；保留为运行时上下文依赖
 
 	// 为 IncidentService 注入序列服务与原生数据库连接（S-4 编号事务锁）
@@ -400,6 +431,7 @@ func NewApplication() *Application {
 	}
 	incidentMonitoringService := service.NewIncidentMonitoringService(client, sugar)
 	incidentAlertingService := service.NewIncidentAlertingService(client, sugar)
+	incidentAlertingService.SetConnectorManager(connectorManager)
 	ticketDependencyService := service.NewTicketDependencyService(client, sugar)
 	analyticsService := service.NewAnalyticsService(client, sugar)
 	predictionService := service.NewPredictionService(client, sugar)
@@ -469,11 +501,51 @@ func NewApplication() *Application {
 	// Set notification service dependencies
 	ticketService.SetNotificationService(ticketNotificationService)
 	ticketCommentService.SetNotificationService(ticketNotificationService)
+
+	// 邮件渠道入站：发件人映射、建单与回复线程归并，复用带通知的评论服务与附件服务
+	emailInboundService := service.NewEmailInboundService(client, ticketService, connectorManager, sugar)
+	emailInboundService.SetCommentService(ticketCommentService)
+	emailInboundService.SetAttachmentService(ticketAttachmentService)
+	emailInboundController := controller.NewEmailInboundController(connectorManager, emailInboundService, sugar)
+
+	// 监控告警入站：按指纹去重，触发时开启/更新事件，恢复时自动解决；
+	// 关联降噪按 CMDB 拓扑、标签与文本相似度把同一故障的告警归并到一个事件
+	alertCorrelationService := service.NewAlertCorrelationService(client, ciRelationshipService, sugar)
+	monitoringAlertService := service.NewMonitoringAlertService(client, incidentService, sugar)
+	monitoringAlertService.SetCorrelationService(alertCorrelationService)
+	monitoringAlertController := controller.NewMonitoringAlertController(connectorManager, monitoringAlertService, sugar)
+	alertCorrelationController := controller.NewAlertCorrelationController(alertCorrelationService, sugar)
 	ticketRatingService.SetNotificationService(ticketNotificationService)
 
 	rootCauseAnalysisService := service.NewRootCauseAnalysisService(client)
 	incidentController := controller.NewIncidentController(incidentService, incidentRuleEngine, incidentMonitoringService, incidentAlertingService, rootCauseAnalysisService, sugar)
+
+	// 重大事件：作战室经 IM 连接器建群，进展通报走站内通知与连接器，复盘草稿由 LLM 摘要生成
+	majorIncidentService := service.NewMajorIncidentService(client, incidentService, connectorManager, sugar)
+	majorIncidentService.SetNotificationService(notificationService)
+	majorIncidentService.SetSummarizeService(service.NewSummarizeService(llmGateway, sugar.Desugar()))
+	incidentService.SetMajorIncidentService(majorIncidentService)
+	majorIncidentController := controller.NewMajorIncidentController(majorIncidentService)
+
+	// 值班：多层轮值与值班替换决定当前值班人，升级策略按确认超时逐步呼叫；日历订阅令牌以 JWT 密钥签名
+	onCallService := service.NewOnCallService(client, incidentService, sugar)
+	onCallService.SetNotificationService(notificationService)
+	onCallService.SetCalendarFeedSecret(cfg.JWT.Secret)
+	incidentService.SetOnCallService(onCallService)
+	onCallController := controller.NewOnCallController(onCallService)
 	approvalController := controller.NewApprovalController(approvalService)
+	// IM 入站路由：数据库唯一索引做集群级去重（Redis 可用时改用 SETNX），消息与处理结果落库，失败的处理器经命令总线重试
+	inboundRouter := connector.NewRouter(sugar)
+	inboundRouter.SetDedupStore(service.NewInboundDedupStore(client))
+	inboundMessageService := service.NewInboundMessageService(client, inboundRouter, sugar)
+	if err := commandRegistry.Register(commandbus.CommandRetryInboundHandler, inboundMessageService.HandleRetryCommand); err != nil {
+		sugar.Fatalw("Failed to register inbound retry command handler", "error", err)
+	}
+	inboundMessageController := controller.NewInboundMessageController(inboundMessageService, sugar)
+	// IM 卡片按钮（审批、认领事件、分派工单）经入站路由回到业务服务
+	chatActionService := service.NewChatActionService(client, connectorManager, approvalService, ticketService, incidentService, sugar)
+	inboundRouter.RegisterNamed("chat_action", chatActionService.HandleInbound)
+	chatOpsController := controller.NewChatOpsController(connectorManager, inboundRouter, sugar)
 
 	serviceController := controller.NewServiceController(serviceCatalogService, serviceRequestService)
 	provisioningService := service.NewProvisioningService(client, sugar)
@@ -512,11 +584,13 @@ func NewApplication() *Application {
 
 	// BPMN Monitoring Service & Controller（监控 + 完整执行轨迹时间线）
 	bpmnMonitoringService := service.NewBPMNMonitoringService(client, bpmnAuditService, sugar)
+	bpmnMonitoringService.SetProcessEngine(processEngine.(*service.CustomProcessEngine))
 	bpmnMonitoringController := controller.NewBPMNMonitoringController(bpmnMonitoringService)
 	// BPMN AI Generator Service & Controller (AI驱动的流程生成)
 	bpmnDeploymentService := service.NewBPMNDeploymentService(client)
 	bpmnAIGeneratorService := service.NewBPMNAIGeneratorService(llmGateway, bpmnDeploymentService)
 	bpmnAIGeneratorController := controller.NewBPMNAIGeneratorController(bpmnAIGeneratorService)
+	bpmnDecisionController := controller.NewBPMNDecisionController(bpmnDeploymentService, processEngine.(*service.CustomProcessEngine))
 
 	// A2UI Ticket Controller (AI-driven UI表单)
 	a2uiTicketService := service.NewA2UITicketService(nil)
@@ -524,6 +598,7 @@ func NewApplication() *Application {
 
 	// Global Search Controller (全局搜索)
 	globalSearchController := controller.NewGlobalSearchController(client)
+	searchController := controller.NewSearchController(fullTextSearchService, sugar)
 
 	// Standard Change Handler (标准变更模板库)
 	standardChangeHandler := standard_change.NewHandler(client, sugar)
@@ -535,6 +610,9 @@ func NewApplication() *Application {
 	// Feishu 连接器控制器
 	feishuSyncService := service.NewFeishuSyncService(client, sugar)
 	feishuController := controller.NewFeishuController(connectorManager, feishuSyncService, marketplaceSvc, sugar)
+	feishuSyncService.SetConnectorManager(connectorManager)
+	inboundRouter.RegisterNamed(service.FeishuInboundHandlerName, feishuSyncService.HandleInbound)
+	feishuController.SetInboundRouter(inboundRouter)
 
 	// Set process trigger service for workflow integration (after processTriggerService is declared)
 	ticketService.SetProcessTriggerService(processTriggerService)
@@ -628,6 +706,9 @@ func NewApplication() *Application {
 	// SLA 模板服务（开箱即用）
 	slaTemplateService := service.NewSLATemplateService(client, sugar)
 	slaTemplateController := controller.NewSLATemplateController(slaTemplateService)
+	businessCalendarController := controller.NewBusinessCalendarController(service.NewBusinessCalendarService(client))
+	ticketScheduleController := controller.NewTicketScheduleController(ticketScheduleService)
+	workLogController := controller.NewWorkLogController(service.NewWorkLogService(client, sugar))
 
 	// AI Domain
 	aiRepo := ai.NewEntRepository(client)
@@ -672,6 +753,7 @@ func NewApplication() *Application {
 		} else {
 			commonServiceDomain.SetRedis(commonRedis)
 			middleware.ConfigureAccessTokenRevocationRedis(commonRedis)
+			inboundRouter.SetDedupStore(connector.NewRedisDedupStore(commonRedis))
 			sugar.Info("refresh token blacklist enabled via redis")
 		}
 		pingCancel()
@@ -806,6 +888,7 @@ func NewApplication() *Application {
 		BPMNProcessTriggerController:    bpmnProcessTriggerController,
 		BPMNDashboardController:         bpmnDashboardController,
 		BPMNMonitoringController:        bpmnMonitoringController,
+		BPMNDecisionController:          bpmnDecisionController,
 		BPMNAIGeneratorController:       bpmnAIGeneratorController,
 		A2UITicketController:            a2uiTicketController,
 		CMDBController:                  cmdbController,
@@ -859,6 +942,16 @@ func NewApplication() *Application {
 		KnowledgeHandler:               knowledgeHandler,
 		SLAHandler:                     slaHandler,
 		SLATemplateController:          slaTemplateController,
+		BusinessCalendarController:     businessCalendarController,
+		TicketScheduleController:       ticketScheduleController,
+		EmailInboundController:         emailInboundController,
+		MonitoringAlertController:      monitoringAlertController,
+		AlertCorrelationController:     alertCorrelationController,
+		WorkLogController:              workLogController,
+		MajorIncidentController:        majorIncidentController,
+		OnCallController:               onCallController,
+		ChatOpsController:              chatOpsController,
+		InboundMessageController:       inboundMessageController,
 		AIHandler:                      aiHandler, // Added AI domain handler
 		CommonHandler:                  commonHandler,
 		AuthController:                 authController,
@@ -869,6 +962,7 @@ func NewApplication() *Application {
 
 		// Global Search
 		GlobalSearchController: globalSearchController,
+		SearchController:       searchController,
 
 		// Standard Change Handler
 		StandardChangeHandler: standardChangeHandler,
@@ -897,14 +991,19 @@ func NewApplication() *Application {
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
 	return &Application{
-		Cfg:           cfg,
-		Logger:        sugar,
-		DBClient:      client,
-		Router:        r,
-		Embedder:      embedder,
-		VectorStore:   vectorStore,
-		CommandWorker: commandWorker,
-		SkillRegistry: skillRegistry,
+		Cfg:             cfg,
+		Logger:          sugar,
+		DBClient:        client,
+		Router:          r,
+		Embedder:        embedder,
+		VectorStore:     vectorStore,
+		CommandWorker:   commandWorker,
+		SkillRegistry:   skillRegistry,
+		TicketSchedules: ticketScheduleService,
+		EmailInbound:    emailInboundService,
+		MajorIncidents:  majorIncidentService,
+		OnCall:          onCallService,
+		InboundMessages: inboundMessageService,
 	}
 }
 
@@ -1196,6 +1295,106 @@ func (app *Application) startBackgroundTasks(ctx context.Context) {
 		}
 	})
 
+	// 周期工单计划巡检：启动时及每小时为命令链中断（停机、死信）的计划按补发策略续排
+	if app.TicketSchedules != nil {
+		safeGo("ticket-schedule-reconcile", func() {
+			ticker := time.NewTicker(time.Hour)
+			defer ticker.Stop()
+			for {
+				if armed, err := app.TicketSchedules.ReconcileSchedules(ctx); err != nil {
+					app.Logger.Warnw("ticket schedule reconcile failed", "error", err)
+				} else if armed > 0 {
+					app.Logger.Infow("ticket schedules re-armed", "count", armed)
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+				}
+			}
+		})
+	}
+
+	// 邮件渠道：每分钟轮询已配置 IMAP 的邮件连接器，未读邮件建单或追加回复
+	if app.EmailInbound != nil {
+		safeGo("email-inbound-poll", func() {
+			ticker := time.NewTicker(time.Minute)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+				}
+				if n, err := app.EmailInbound.PollMailboxes(ctx); err != nil {
+					app.Logger.Warnw("email inbound poll failed", "error", err)
+				} else if n > 0 {
+					app.Logger.Infow("inbound emails processed", "count", n)
+				}
+			}
+		})
+	}
+
+	// 重大事件：每分钟发送到期的干系人进展通报
+	if app.MajorIncidents != nil {
+		safeGo("major-incident-updates", func() {
+			ticker := time.NewTicker(time.Minute)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+				}
+				if n, err := app.MajorIncidents.DispatchDueUpdates(ctx); err != nil {
+					app.Logger.Warnw("major incident updates failed", "error", err)
+				} else if n > 0 {
+					app.Logger.Infow("major incident updates sent", "count", n)
+				}
+			}
+		})
+	}
+
+	// 值班呼叫：每分钟把确认超时的呼叫升级到下一步
+	if app.OnCall != nil {
+		safeGo("oncall-escalations", func() {
+			ticker := time.NewTicker(time.Minute)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+				}
+				if n, err := app.OnCall.DispatchDueEscalations(ctx); err != nil {
+					app.Logger.Warnw("on-call escalations failed", "error", err)
+				} else if n > 0 {
+					app.Logger.Infow("on-call pages escalated", "count", n)
+				}
+			}
+		})
+	}
+
+	// IM 入站：每小时清理过期的去重 key 与超过保留期的成功消息日志
+	if app.InboundMessages != nil {
+		safeGo("inbound-message-purge", func() {
+			ticker := time.NewTicker(time.Hour)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+				}
+				if n, err := app.InboundMessages.PurgeExpired(ctx); err != nil {
+					app.Logger.Warnw("inbound message purge failed", "error", err)
+				} else if n > 0 {
+					app.Logger.Infow("inbound messages purged", "count", n)
+				}
+			}
+		})
+	}
+
 	// SLA Monitoring and Escalation background tasks
 	safeGo("sla-monitor-escalation", func() {
 		slaMonitorService := service.NewSLAMonitorService(app.DBClient, app.Logger)
@@ -22,6 +22,7 @@ const (
 	StatusProcessing = "processing"
 	StatusSucceeded  = "succeeded"
 	StatusDeadLetter = "dead_letter"
+	StatusCancelled  = "cancelled"
 
 	CommandStartBPMN            = "workflow.start"
 	CommandDeliverNotification  = "notification.deliver"
@@ -30,6 +31,11 @@ const (
 	CommandExecuteTicketRules   = "ticket.rules.execute"
 	CommandSyncTicketFeishu     = "ticket.feishu.sync"
 	CommandExecuteIncidentRules = "incident.rules.execute"
+	CommandFireBPMNTimer        = "workflow.timer.fire"
+	CommandExecuteBPMNJob       = "workflow.job.execute"
+	CommandIndexSearchDocument  = "search.index"
+	CommandFireTicketSchedule   = "ticket.schedule.fire"
+	CommandRetryInboundHandler  = "connector.inbound.retry"
 )
 
 var ErrLeaseLost = errors.New("operational command lease lost")
@@ -85,6 +91,8 @@ type EnqueueRequest struct {
 	IdempotencyKey string
 	Payload        map[string]interface{}
 	MaxAttempts    int
+	// AvailableAt delays the first delivery; zero means immediately.
+	AvailableAt time.Time
 }
 
 func Enqueue(ctx context.Context, client *ent.Client, req EnqueueRequest) (*ent.OperationalCommand, error) {
@@ -115,12 +123,16 @@ func EnqueueSQLTx(ctx context.Context, tx *sql.Tx, req EnqueueRequest) error {
 		return fmt.Errorf("marshal operational command payload: %w", err)
 	}
 	now := time.Now()
+	availableAt := now
+	if !req.AvailableAt.IsZero() {
+		availableAt = req.AvailableAt
+	}
 	_, err = tx.ExecContext(ctx, `
 		INSERT INTO operational_commands
 			(tenant_id, command_type, aggregate_type, aggregate_id, idempotency_key, payload,
 			 status, attempt, max_attempts, available_at, fencing_token, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, 'pending', 0, $7, $8, 0, $8, $8)
-	`, req.TenantID, req.CommandType, req.AggregateType, req.AggregateID, req.IdempotencyKey, string(payload), maxAttempts, now)
+		VALUES ($1, $2, $3, $4, $5, $6, 'pending', 0, $7, $8, 0, $9, $9)
+	`, req.TenantID, req.CommandType, req.AggregateType, req.AggregateID, req.IdempotencyKey, string(payload), maxAttempts, availableAt, now)
 	if err != nil {
 		return fmt.Errorf("insert operational command: %w", err)
 	}
@@ -135,6 +147,9 @@ func enqueue(ctx context.Context, create *ent.OperationalCommandCreate, req Enqu
 	if maxAttempts <= 0 {
 		maxAttempts = 8
 	}
+	if !req.AvailableAt.IsZero() {
+		create.SetAvailableAt(req.AvailableAt)
+	}
 	return create.
 		SetTenantID(req.TenantID).
 		SetCommandType(req.CommandType).
@@ -84,6 +84,11 @@ var RegisteredMigrations = []Migration{
 		Description: "Persist configured ticket type snapshots and dynamic form data; add runtime bindings",
 		RollbackSQL: "",
 	},
+	{
+		Version:     "016_add_search_document_fts_index",
+		Description: "Add GIN tsvector index over application-tokenized search document terms (CJK bigram full-text search)",
+		RollbackSQL: "DROP INDEX IF EXISTS idx_search_documents_terms_fts;",
+	},
 }
 
 // PostSchemaMigrations returns a defensive copy of the canonical active stream.
@@ -673,6 +678,13 @@ CREATE INDEX IF NOT EXISTS idx_ticket_types_tenant_sort ON ticket_types(tenant_i
 -- 修复历史脏数据：旧 seeder 误将 custom_fields 写成 JSON 数组 '[]'，
 -- 但 ent schema 定义为 object(map[string]interface{})，会导致查询列表时 unmarshal 失败 500。
 UPDATE ticket_types SET custom_fields = '{}'::jsonb WHERE jsonb_typeof(custom_fields) = 'array';
+`
+	case "016_add_search_document_fts_index":
+		return `
+-- 分词在应用侧完成（CJK 单字+二元组），terms 按空格分隔原样转为 tsvector 词元，
+-- 不经过 to_tsvector 的文本解析，避免默认 parser 将整段中文当作一个词。
+CREATE INDEX IF NOT EXISTS idx_search_documents_terms_fts
+  ON search_documents USING GIN (array_to_tsvector(string_to_array(terms, ' ')));
 `
 	default:
 		return ""
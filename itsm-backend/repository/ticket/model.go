@@ -15,9 +15,12 @@ const (
 	StatusOpen       Status = "open"
 	StatusInProgress Status = "in_progress"
 	StatusPending    Status = "pending"
-	StatusResolved   Status = "resolved"
-	StatusClosed     Status = "closed"
-	StatusCancelled  Status = "cancelled"
+	// StatusPendingCustomer / StatusPendingVendor 等待客户、供应商答复
+	StatusPendingCustomer Status = "pending_customer"
+	StatusPendingVendor   Status = "pending_vendor"
+	StatusResolved        Status = "resolved"
+	StatusClosed          Status = "closed"
+	StatusCancelled       Status = "cancelled"
 )
 
 // Priority 工单优先级类型
@@ -226,5 +229,7 @@ type UpdateParams struct {
 	TagIDs      []int
 	Resolution  *string
 	FormFields  *map[string]interface{}
-	Version     int // 乐观锁版本号
+	OnHold      *bool // 挂起标记，SLA 定义配置了 on_hold 暂停条件时停止计时
+	VendorID    *int  // 承接供应商，0 表示解除
+	Version     int   // 乐观锁版本号
 }
@@ -272,7 +272,7 @@ func (r *EntRepository) Update(ctx context.Context, id int, params *UpdateParams
 			builder.SetResolvedAt(time.Now()).ClearClosedAt()
 		case StatusClosed:
 			builder.SetClosedAt(time.Now())
-		case StatusNew, StatusOpen, StatusInProgress, StatusPending:
+		case StatusNew, StatusOpen, StatusInProgress, StatusPending, StatusPendingCustomer, StatusPendingVendor:
 			builder.ClearResolvedAt().ClearClosedAt()
 		}
 	}
@@ -301,6 +301,16 @@ func (r *EntRepository) Update(ctx context.Context, id int, params *UpdateParams
 	if params.Resolution != nil {
 		builder.SetResolution(*params.Resolution)
 	}
+	if params.OnHold != nil {
+		builder.SetSLAOnHold(*params.OnHold)
+	}
+	if params.VendorID != nil {
+		if *params.VendorID > 0 {
+			builder.SetVendorID(*params.VendorID)
+		} else {
+			builder.ClearVendorID()
+		}
+	}
 	if params.FormFields != nil {
 		builder.SetFormFields(*params.FormFields)
 	}
@@ -349,7 +359,7 @@ func (r *EntRepository) UpdateWithTxHook(ctx context.Context, id int, params *Up
 			builder.SetResolvedAt(time.Now()).ClearClosedAt()
 		case StatusClosed:
 			builder.SetClosedAt(time.Now())
-		case StatusNew, StatusOpen, StatusInProgress, StatusPending:
+		case StatusNew, StatusOpen, StatusInProgress, StatusPending, StatusPendingCustomer, StatusPendingVendor:
 			builder.ClearResolvedAt().ClearClosedAt()
 		}
 	}
@@ -381,6 +391,16 @@ func (r *EntRepository) UpdateWithTxHook(ctx context.Context, id int, params *Up
 	if params.Resolution != nil {
 		builder.SetResolution(*params.Resolution)
 	}
+	if params.OnHold != nil {
+		builder.SetSLAOnHold(*params.OnHold)
+	}
+	if params.VendorID != nil {
+		if *params.VendorID > 0 {
+			builder.SetVendorID(*params.VendorID)
+		} else {
+			builder.ClearVendorID()
+		}
+	}
 	updatedEntity, err := builder.Save(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
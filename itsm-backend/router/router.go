@@ -213,6 +213,7 @@ type RouterConfig struct {
 	BPMNProcessTriggerController    *controller.BPMNProcessTriggerController
 	BPMNDashboardController         *controller.BPMNDashboardController
 	BPMNMonitoringController        *controller.BPMNMonitoringController
+	BPMNDecisionController          *controller.BPMNDecisionController
 	BPMNAIGeneratorController       *controller.BPMNAIGeneratorController
 
 	A2UITicketController *controller.A2UITicketController
@@ -277,6 +278,36 @@ type RouterConfig struct {
 	AuthController        *controller.AuthController
 	RoleHandler           *common.RoleHandler
 
+	// 工作日历（SLA 计时口径）
+	BusinessCalendarController *controller.BusinessCalendarController
+
+	// 周期工单计划（预防性维护）
+	TicketScheduleController *controller.TicketScheduleController
+
+	// 邮件渠道入站（IMAP 轮询 / Webhook 原始 MIME）
+	EmailInboundController *controller.EmailInboundController
+
+	// 监控告警入站（Alertmanager / Zabbix / Grafana）
+	MonitoringAlertController *controller.MonitoringAlertController
+
+	// 告警关联降噪（屏蔽规则、降噪统计）
+	AlertCorrelationController *controller.AlertCorrelationController
+
+	// 工时记录（工单/变更/问题计时与汇总）
+	WorkLogController *controller.WorkLogController
+
+	// 重大事件（宣告、作战室、干系人通报、时间线与复盘草稿）
+	MajorIncidentController *controller.MajorIncidentController
+
+	// 值班管理（值班表、值班替换、升级策略、值班呼叫与日历订阅）
+	OnCallController *controller.OnCallController
+
+	// IM 卡片交互回调（Slack / Teams）
+	ChatOpsController *controller.ChatOpsController
+
+	// IM 入站消息日志（查看处理结果、手动重放）
+	InboundMessageController *controller.InboundMessageController
+
 	// Sprint C — Skill Registry v1
 	SkillHandler *skill.Handler
 
@@ -285,6 +316,7 @@ type RouterConfig struct {
 
 	// Global Search
 	GlobalSearchController *controller.GlobalSearchController
+	SearchController       *controller.SearchController
 
 	// Standard Change Handler (标准变更模板库)
 	StandardChangeHandler *standard_change.Handler
@@ -540,9 +572,11 @@ func SetupRoutes(r *gin.Engine, config *RouterConfig) {
 				tickets.GET("/views/:id", middleware.RequirePermission("view", "read"), config.TicketViewController.GetTicketView)
 				tickets.PUT("/views/:id", middleware.RequirePermission("view", "update"), config.TicketViewController.UpdateTicketView)
 				tickets.DELETE("/views/:id", middleware.RequirePermission("view", "delete"), config.TicketViewController.DeleteTicketView)
+				tickets.GET("/views/:id/tickets", middleware.RequirePermission("ticket", "read"), config.TicketViewController.RunTicketView)
 			}
 
 			tickets.GET("/search", middleware.RequirePermission("ticket", "read"), config.TicketController.SearchTickets)
+			tickets.POST("/query", middleware.RequirePermission("ticket", "read"), config.TicketController.QueryTickets)
 			tickets.GET("/stats", middleware.RequirePermission("ticket", "read"), config.TicketController.GetTicketStats)
 			tickets.GET("/overdue", middleware.RequirePermission("ticket", "read"), config.TicketController.GetOverdueTickets)
 			tickets.POST("/export", middleware.RequirePermission("ticket", "export"), config.TicketController.ExportTickets)
@@ -578,6 +612,8 @@ func SetupRoutes(r *gin.Engine, config *RouterConfig) {
 			tickets.POST("/:id/assign", middleware.RequirePermission("ticket", "assign"), config.TicketController.AssignTicket)
 			tickets.POST("/:id/resolve", middleware.RequirePermission("ticket", "update"), config.TicketController.ResolveTicket)
 			tickets.POST("/:id/close", middleware.RequirePermission("ticket", "update"), config.TicketController.CloseTicket)
+			tickets.POST("/:id/merge", middleware.RequirePermission("ticket", "update"), config.TicketController.MergeTickets)
+			tickets.POST("/:id/split", middleware.RequirePermission("ticket", "create"), config.TicketController.SplitTicket)
 
 			// 工单SLA信息
 			tickets.GET("/:id/sla", middleware.RequirePermission("ticket", "read"), config.TicketController.GetTicketSLAInfo)
@@ -873,6 +909,12 @@ func SetupRoutes(r *gin.Engine, config *RouterConfig) {
 				inc.POST("/alerts/:id/resolve", middleware.RequirePermission("incident", "write"), config.IncidentController.ResolveAlert)
 			}
 		}
+		if config.MajorIncidentController != nil {
+			config.MajorIncidentController.RegisterRoutes(tenant.(*gin.RouterGroup))
+		}
+		if config.OnCallController != nil {
+			config.OnCallController.RegisterRoutes(tenant.(*gin.RouterGroup))
+		}
 		if config.IncidentController != nil && config.CMDBController != nil {
 			tenant.GET("/incidents/configuration-items", middleware.RequirePermission("cmdb", "read"), config.CMDBController.ListCIs)
 		}
@@ -1170,6 +1212,41 @@ func SetupRoutes(r *gin.Engine, config *RouterConfig) {
 			if config.SLATemplateController != nil {
 				config.SLATemplateController.RegisterRoutes(tenant.(*gin.RouterGroup))
 			}
+
+			// 工作日历（节假日、调休与分段班次）
+			if config.BusinessCalendarController != nil {
+				config.BusinessCalendarController.RegisterRoutes(tenant.(*gin.RouterGroup))
+			}
+
+			// 周期工单计划（预防性维护）
+			if config.TicketScheduleController != nil {
+				config.TicketScheduleController.RegisterRoutes(tenant.(*gin.RouterGroup))
+			}
+
+			// 邮件渠道入站台账
+			if config.EmailInboundController != nil {
+				config.EmailInboundController.RegisterRoutes(tenant.(*gin.RouterGroup))
+			}
+
+			// IM 入站消息日志
+			if config.InboundMessageController != nil {
+				config.InboundMessageController.RegisterRoutes(tenant.(*gin.RouterGroup))
+			}
+
+			// 监控告警台账
+			if config.MonitoringAlertController != nil {
+				config.MonitoringAlertController.RegisterRoutes(tenant.(*gin.RouterGroup))
+			}
+
+			// 告警关联降噪
+			if config.AlertCorrelationController != nil {
+				config.AlertCorrelationController.RegisterRoutes(tenant.(*gin.RouterGroup))
+			}
+
+			// 工时记录
+			if config.WorkLogController != nil {
+				config.WorkLogController.RegisterRoutes(tenant.(*gin.RouterGroup))
+			}
 		}
 
 		// ==================== AI & Analytics (DDD) ====================
@@ -1535,6 +1612,11 @@ func SetupRoutes(r *gin.Engine, config *RouterConfig) {
 			config.BPMNMonitoringController.RegisterRoutes(tenant.(*gin.RouterGroup))
 		}
 
+		// DMN 决策定义（业务规则任务引用的决策表）
+		if config.BPMNDecisionController != nil {
+			config.BPMNDecisionController.RegisterRoutes(tenant.(*gin.RouterGroup))
+		}
+
 		// A2UI Ticket Controller (AI-driven UI表单)
 		if config.A2UITicketController != nil {
 			config.A2UITicketController.RegisterRoutes(tenant.(*gin.RouterGroup))
@@ -1545,6 +1627,13 @@ func SetupRoutes(r *gin.Engine, config *RouterConfig) {
 			config.GlobalSearchController.RegisterRoutes(tenant.(*gin.RouterGroup))
 		}
 
+		// Full-text Search (全文检索，按调用者权限过滤实体类型与可见范围)
+		if config.SearchController != nil {
+			search := tenant.(*gin.RouterGroup).Group("/search")
+			search.GET("", config.SearchController.Search)
+			search.POST("/reindex", middleware.RequirePermission("system", "write"), config.SearchController.Reindex)
+		}
+
 		// Standard Change Handler (标准变更模板库)
 		if config.StandardChangeHandler != nil {
 			config.StandardChangeHandler.RegisterRoutes(tenant.(*gin.RouterGroup))
@@ -1855,4 +1944,24 @@ func SetupRoutes(r *gin.Engine, config *RouterConfig) {
 	if config.FeishuController != nil {
 		SetupFeishuRoutes(auth, public, config.FeishuController)
 	}
+
+	// 邮件入站 Webhook（公开访问，邮件网关调用）
+	if config.EmailInboundController != nil {
+		config.EmailInboundController.RegisterPublicRoutes(public)
+	}
+
+	// 监控告警推送（公开访问，Alertmanager / Zabbix / Grafana 调用）
+	if config.MonitoringAlertController != nil {
+		config.MonitoringAlertController.RegisterPublicRoutes(public)
+	}
+
+	// 值班日历订阅（公开访问，日历客户端以签名令牌拉取）
+	if config.OnCallController != nil {
+		config.OnCallController.RegisterPublicRoutes(public)
+	}
+
+	// Slack / Teams 事件与卡片按钮回调（公开访问，签名由连接器校验）
+	if config.ChatOpsController != nil {
+		config.ChatOpsController.RegisterPublicRoutes(public)
+	}
 }
@@ -0,0 +1,592 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"itsm-backend/common"
+	monitorconn "itsm-backend/connector/builtin/monitoring"
+	"itsm-backend/dto"
+	"itsm-backend/ent"
+	"itsm-backend/ent/alertsuppressionrule"
+	"itsm-backend/ent/incident"
+	"itsm-backend/ent/monitoringalert"
+	"itsm-backend/ent/schema"
+
+	"go.uber.org/zap"
+)
+
+const (
+	alertCorrelationDefaultWindow     = 10 * time.Minute
+	alertCorrelationCandidateLimit    = 200
+	alertCorrelationDefaultSimilarity = 0.8
+	alertCorrelationDefaultDepth      = 3
+	alertFlapDefaultWindow            = 30 * time.Minute
+	alertNoiseReportDefaultRange      = 7 * 24 * time.Hour
+)
+
+var (
+	// ErrAlertSuppressionRuleNotFound 屏蔽规则不存在或不属于当前租户
+	ErrAlertSuppressionRuleNotFound = errors.New("屏蔽规则不存在")
+	// ErrAlertSuppressionRuleInvalid 屏蔽规则参数无效
+	ErrAlertSuppressionRuleInvalid = errors.New("屏蔽规则无效")
+)
+
+// AlertCorrelationService 告警关联降噪：屏蔽规则拦截、时间窗内按拓扑/标签/文本相似度归并到已有事件、
+// 抖动检测，以及按告警源统计降噪率
+type AlertCorrelationService struct {
+	client        *ent.Client
+	relationships *CIRelationshipService
+	logger        *zap.SugaredLogger
+	now           func() time.Time
+}
+
+// NewAlertCorrelationService 创建告警关联降噪服务；relationships 为空时不做拓扑关联
+func NewAlertCorrelationService(client *ent.Client, relationships *CIRelationshipService, logger *zap.SugaredLogger) *AlertCorrelationService {
+	return &AlertCorrelationService{client: client, relationships: relationships, logger: logger, now: time.Now}
+}
+
+// AlertCorrelation 告警归并结果
+type AlertCorrelation struct {
+	Root       *ent.MonitoringAlert // 归并到的主告警
+	IncidentID int
+	Reason     monitoringalert.CorrelationReason
+	Detail     string
+}
+
+// MatchSuppression 返回告警命中的第一条生效中的屏蔽规则并累计命中次数，未命中返回 nil
+func (s *AlertCorrelationService) MatchSuppression(ctx context.Context, tenantID int, alert monitorconn.Alert) (*ent.AlertSuppressionRule, error) {
+	now := s.now()
+	rules, err := s.client.AlertSuppressionRule.Query().
+		Where(
+			alertsuppressionrule.TenantID(tenantID),
+			alertsuppressionrule.Enabled(true),
+			alertsuppressionrule.Or(alertsuppressionrule.StartsAtIsNil(), alertsuppressionrule.StartsAtLTE(now)),
+			alertsuppressionrule.Or(alertsuppressionrule.EndsAtIsNil(), alertsuppressionrule.EndsAtGT(now)),
+		).
+		Order(ent.Asc(alertsuppressionrule.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询屏蔽规则失败: %w", err)
+	}
+	for _, rule := range rules {
+		if rule.Source != "" && rule.Source != alert.Source {
+			continue
+		}
+		if !matchAlertLabels(rule.Matchers, alert.Labels) {
+			continue
+		}
+		if err := s.client.AlertSuppressionRule.UpdateOneID(rule.ID).AddHitCount(1).SetLastHitAt(now).Exec(ctx); err != nil {
+			s.logger.Warnw("Failed to record suppression rule hit", "rule_id", rule.ID, "error", err)
+		}
+		return rule, nil
+	}
+	return nil, nil
+}
+
+// Correlate 在关联时间窗内为新告警寻找可归并的、关联事件仍未解决的告警。
+// 依次尝试：拓扑（同一配置项或其上游配置项已告警，如交换机故障时下游主机的告警）、
+// 标签（关联标签全部相同）、文本相似度（关联标签明确不同的不比较）；均未命中返回 nil。selfID 为本告警台账ID（新告警为 0）
+func (s *AlertCorrelationService) Correlate(ctx context.Context, tenantID int, policy monitorconn.Policy, alert monitorconn.Alert, ciID, selfID int) (*AlertCorrelation, error) {
+	window := policy.CorrelationWindow
+	if window <= 0 {
+		window = alertCorrelationDefaultWindow
+	}
+	query := s.client.MonitoringAlert.Query().
+		Where(
+			monitoringalert.TenantID(tenantID),
+			monitoringalert.StatusEQ(monitoringalert.StatusFiring),
+			monitoringalert.IncidentIDNotNil(),
+			monitoringalert.LastReceivedAtGTE(s.now().Add(-window)),
+		)
+	if selfID > 0 {
+		query = query.Where(monitoringalert.IDNEQ(selfID))
+	}
+	candidates, err := query.
+		Order(ent.Desc(monitoringalert.FieldLastReceivedAt), ent.Desc(monitoringalert.FieldID)).
+		Limit(alertCorrelationCandidateLimit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询关联候选告警失败: %w", err)
+	}
+	candidates, err = s.withOpenIncident(ctx, tenantID, candidates)
+	if err != nil || len(candidates) == 0 {
+		return nil, err
+	}
+
+	if ciID > 0 {
+		match, err := s.correlateByTopology(ctx, tenantID, policy, ciID, candidates)
+		if err != nil || match != nil {
+			return match, err
+		}
+	}
+
+	keys := policy.CorrelationLabels
+	for _, c := range candidates {
+		if shared := sharedCorrelationLabels(keys, alert.Labels, c.Labels); len(shared) > 0 {
+			return &AlertCorrelation{
+				Root: c, IncidentID: *c.IncidentID, Reason: monitoringalert.CorrelationReasonLabel,
+				Detail: fmt.Sprintf("标签 %s 与告警「%s」相同", strings.Join(shared, ", "), c.AlertName),
+			}, nil
+		}
+	}
+
+	threshold := policy.SimilarityThreshold
+	if threshold <= 0 || threshold > 1 {
+		threshold = alertCorrelationDefaultSimilarity
+	}
+	text := alert.Name + " " + alertSummary(alert)
+	var best *ent.MonitoringAlert
+	bestScore := 0.0
+	for _, c := range candidates {
+		if conflictingScopeLabels(keys, alert.Labels, c.Labels) {
+			continue
+		}
+		if score := textSimilarity(text, c.AlertName+" "+c.Summary); score >= threshold && score > bestScore {
+			best, bestScore = c, score
+		}
+	}
+	if best != nil {
+		return &AlertCorrelation{
+			Root: best, IncidentID: *best.IncidentID, Reason: monitoringalert.CorrelationReasonSimilarity,
+			Detail: fmt.Sprintf("与告警「%s」文本相似度 %.0f%%", best.AlertName, bestScore*100),
+		}, nil
+	}
+	return nil, nil
+}
+
+// withOpenIncident 只保留关联事件仍未解决的候选告警
+func (s *AlertCorrelationService) withOpenIncident(ctx context.Context, tenantID int, candidates []*ent.MonitoringAlert) ([]*ent.MonitoringAlert, error) {
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+	ids := make([]int, 0, len(candidates))
+	seen := make(map[int]bool, len(candidates))
+	for _, c := range candidates {
+		if !seen[*c.IncidentID] {
+			seen[*c.IncidentID] = true
+			ids = append(ids, *c.IncidentID)
+		}
+	}
+	openIDs, err := s.client.Incident.Query().
+		Where(
+			incident.IDIn(ids...),
+			incident.TenantID(tenantID),
+			incident.DeletedAtIsNil(),
+			incident.StatusNotIn(common.IncidentStatusResolved, common.IncidentStatusClosed, common.IncidentStatusCancelled),
+		).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询关联事件失败: %w", err)
+	}
+	open := make(map[int]bool, len(openIDs))
+	for _, id := range openIDs {
+		open[id] = true
+	}
+	out := candidates[:0]
+	for _, c := range candidates {
+		if open[*c.IncidentID] {
+			out = append(out, c)
+		}
+	}
+	return out, nil
+}
+
+// correlateByTopology 候选告警的配置项是新告警配置项本身或其上游（影响它的配置项）时归并，取最近的一层
+func (s *AlertCorrelationService) correlateByTopology(ctx context.Context, tenantID int, policy monitorconn.Policy, ciID int, candidates []*ent.MonitoringAlert) (*AlertCorrelation, error) {
+	withCI := false
+	for _, c := range candidates {
+		if c.ConfigurationItemID != nil {
+			withCI = true
+			break
+		}
+	}
+	if !withCI {
+		return nil, nil
+	}
+	distance := map[int]int{ciID: 0}
+	if s.relationships != nil {
+		depth := policy.TopologyDepth
+		if depth <= 0 {
+			depth = alertCorrelationDefaultDepth
+		}
+		if depth > maxCIImpactAnalysisDepth {
+			depth = maxCIImpactAnalysisDepth
+		}
+		upstream, _, _, _, err := s.relationships.traverseImpactLayers(ctx, tenantID, ciID, depth, "upstream")
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range upstream {
+			distance[item.CIID] = item.Distance
+		}
+	}
+	var best *ent.MonitoringAlert
+	bestDistance := 0
+	for _, c := range candidates {
+		if c.ConfigurationItemID == nil {
+			continue
+		}
+		if d, ok := distance[*c.ConfigurationItemID]; ok && (best == nil || d < bestDistance) {
+			best, bestDistance = c, d
+		}
+	}
+	if best == nil {
+		return nil, nil
+	}
+	detail := fmt.Sprintf("同一配置项已有告警「%s」", best.AlertName)
+	if bestDistance > 0 {
+		detail = fmt.Sprintf("上游配置项 #%d 已有告警「%s」（相距 %d 层）", *best.ConfigurationItemID, best.AlertName, bestDistance)
+	}
+	return &AlertCorrelation{Root: best, IncidentID: *best.IncidentID, Reason: monitoringalert.CorrelationReasonTopology, Detail: detail}, nil
+}
+
+// NoiseReport 按告警源统计降噪效果：去重后的告警数、推送次数、开启事件数、归并与屏蔽次数及降噪率
+func (s *AlertCorrelationService) NoiseReport(ctx context.Context, tenantID int, from, to time.Time) (*dto.AlertNoiseReport, error) {
+	if to.IsZero() {
+		to = s.now()
+	}
+	if from.IsZero() {
+		from = to.Add(-alertNoiseReportDefaultRange)
+	}
+	if !from.Before(to) {
+		return nil, errors.New("统计开始时间必须早于结束时间")
+	}
+	var groups []struct {
+		Source          string `json:"source"`
+		Count           int    `json:"count"`
+		Notifications   int    `json:"notifications"`
+		IncidentsOpened int    `json:"incidents_opened"`
+		Correlated      int    `json:"correlated"`
+		Suppressed      int    `json:"suppressed"`
+	}
+	err := s.client.MonitoringAlert.Query().
+		Where(monitoringalert.TenantID(tenantID), monitoringalert.LastReceivedAtGTE(from), monitoringalert.LastReceivedAtLT(to)).
+		GroupBy(monitoringalert.FieldSource).
+		Aggregate(
+			ent.Count(),
+			ent.As(ent.Sum(monitoringalert.FieldReceiveCount), "notifications"),
+			ent.As(ent.Sum(monitoringalert.FieldIncidentsOpened), "incidents_opened"),
+			ent.As(ent.Sum(monitoringalert.FieldCorrelatedCount), "correlated"),
+			ent.As(ent.Sum(monitoringalert.FieldSuppressedCount), "suppressed"),
+		).
+		Scan(ctx, &groups)
+	if err != nil {
+		return nil, fmt.Errorf("统计告警降噪失败: %w", err)
+	}
+	var flapping []struct {
+		Source string `json:"source"`
+		Count  int    `json:"count"`
+	}
+	err = s.client.MonitoringAlert.Query().
+		Where(monitoringalert.TenantID(tenantID), monitoringalert.LastReceivedAtGTE(from), monitoringalert.LastReceivedAtLT(to), monitoringalert.Flapping(true)).
+		GroupBy(monitoringalert.FieldSource).
+		Aggregate(ent.Count()).
+		Scan(ctx, &flapping)
+	if err != nil {
+		return nil, fmt.Errorf("统计抖动告警失败: %w", err)
+	}
+	flappingBySource := make(map[string]int, len(flapping))
+	for _, f := range flapping {
+		flappingBySource[f.Source] = f.Count
+	}
+
+	report := &dto.AlertNoiseReport{From: from, To: to, Sources: make([]dto.AlertNoiseStats, 0, len(groups)), Total: dto.AlertNoiseStats{Source: "all"}}
+	for _, g := range groups {
+		stats := dto.AlertNoiseStats{
+			Source: g.Source, Alerts: g.Count, Notifications: g.Notifications, IncidentsOpened: g.IncidentsOpened,
+			Correlated: g.Correlated, Suppressed: g.Suppressed, Flapping: flappingBySource[g.Source],
+		}
+		stats.NoiseReductionRatio = noiseReductionRatio(stats)
+		report.Sources = append(report.Sources, stats)
+
+		report.Total.Alerts += stats.Alerts
+		report.Total.Notifications += stats.Notifications
+		report.Total.IncidentsOpened += stats.IncidentsOpened
+		report.Total.Correlated += stats.Correlated
+		report.Total.Suppressed += stats.Suppressed
+		report.Total.Flapping += stats.Flapping
+	}
+	report.Total.NoiseReductionRatio = noiseReductionRatio(report.Total)
+	sort.Slice(report.Sources, func(i, j int) bool { return report.Sources[i].Source < report.Sources[j].Source })
+	return report, nil
+}
+
+// CreateSuppressionRule 创建屏蔽规则
+func (s *AlertCorrelationService) CreateSuppressionRule(ctx context.Context, tenantID, userID int, req *dto.CreateAlertSuppressionRuleRequest) (*ent.AlertSuppressionRule, error) {
+	if err := validateAlertMatchers(req.Matchers); err != nil {
+		return nil, err
+	}
+	if err := validateSuppressionWindow(req.StartsAt, req.EndsAt); err != nil {
+		return nil, err
+	}
+	rule, err := s.client.AlertSuppressionRule.Create().
+		SetTenantID(tenantID).
+		SetName(strings.TrimSpace(req.Name)).
+		SetDescription(req.Description).
+		SetSource(req.Source).
+		SetMatchers(req.Matchers).
+		SetNillableStartsAt(req.StartsAt).
+		SetNillableEndsAt(req.EndsAt).
+		SetNillableEnabled(req.Enabled).
+		SetCreatedBy(userID).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("创建屏蔽规则失败: %w", err)
+	}
+	return rule, nil
+}
+
+// UpdateSuppressionRule 修改屏蔽规则
+func (s *AlertCorrelationService) UpdateSuppressionRule(ctx context.Context, tenantID, id int, req *dto.UpdateAlertSuppressionRuleRequest) (*ent.AlertSuppressionRule, error) {
+	rule, err := s.getSuppressionRule(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	update := rule.Update()
+	if req.Name != nil {
+		if strings.TrimSpace(*req.Name) == "" {
+			return nil, fmt.Errorf("%w: 规则名称不能为空", ErrAlertSuppressionRuleInvalid)
+		}
+		update.SetName(strings.TrimSpace(*req.Name))
+	}
+	if req.Description != nil {
+		update.SetDescription(*req.Description)
+	}
+	if req.Source != nil {
+		update.SetSource(*req.Source)
+	}
+	if req.Matchers != nil {
+		if err := validateAlertMatchers(*req.Matchers); err != nil {
+			return nil, err
+		}
+		update.SetMatchers(*req.Matchers)
+	}
+	startsAt, endsAt := rule.StartsAt, rule.EndsAt
+	if req.ClearWindow {
+		startsAt, endsAt = nil, nil
+		update.ClearStartsAt().ClearEndsAt()
+	}
+	if req.StartsAt != nil {
+		startsAt = req.StartsAt
+		update.SetStartsAt(*req.StartsAt)
+	}
+	if req.EndsAt != nil {
+		endsAt = req.EndsAt
+		update.SetEndsAt(*req.EndsAt)
+	}
+	if err := validateSuppressionWindow(startsAt, endsAt); err != nil {
+		return nil, err
+	}
+	if req.Enabled != nil {
+		update.SetEnabled(*req.Enabled)
+	}
+	saved, err := update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("更新屏蔽规则失败: %w", err)
+	}
+	return saved, nil
+}
+
+// DeleteSuppressionRule 删除屏蔽规则
+func (s *AlertCorrelationService) DeleteSuppressionRule(ctx context.Context, tenantID, id int) error {
+	n, err := s.client.AlertSuppressionRule.Delete().
+		Where(alertsuppressionrule.ID(id), alertsuppressionrule.TenantID(tenantID)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("删除屏蔽规则失败: %w", err)
+	}
+	if n == 0 {
+		return ErrAlertSuppressionRuleNotFound
+	}
+	return nil
+}
+
+// ListSuppressionRules 列出租户的屏蔽规则
+func (s *AlertCorrelationService) ListSuppressionRules(ctx context.Context, tenantID int) ([]*ent.AlertSuppressionRule, error) {
+	return s.client.AlertSuppressionRule.Query().
+		Where(alertsuppressionrule.TenantID(tenantID)).
+		Order(ent.Desc(alertsuppressionrule.FieldID)).
+		All(ctx)
+}
+
+func (s *AlertCorrelationService) getSuppressionRule(ctx context.Context, tenantID, id int) (*ent.AlertSuppressionRule, error) {
+	rule, err := s.client.AlertSuppressionRule.Query().
+		Where(alertsuppressionrule.ID(id), alertsuppressionrule.TenantID(tenantID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrAlertSuppressionRuleNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询屏蔽规则失败: %w", err)
+	}
+	return rule, nil
+}
+
+func validateSuppressionWindow(startsAt, endsAt *time.Time) error {
+	if startsAt != nil && endsAt != nil && !startsAt.Before(*endsAt) {
+		return fmt.Errorf("%w: 生效开始时间必须早于结束时间", ErrAlertSuppressionRuleInvalid)
+	}
+	return nil
+}
+
+func validateAlertMatchers(matchers []schema.AlertMatcher) error {
+	if len(matchers) == 0 {
+		return fmt.Errorf("%w: 至少需要一个标签匹配条件", ErrAlertSuppressionRuleInvalid)
+	}
+	for _, m := range matchers {
+		if strings.TrimSpace(m.Label) == "" {
+			return fmt.Errorf("%w: 匹配条件的标签名不能为空", ErrAlertSuppressionRuleInvalid)
+		}
+		switch m.Op {
+		case "", "=", "!=":
+		case "=~", "!~":
+			if _, err := regexp.Compile("^(?:" + m.Value + ")$"); err != nil {
+				return fmt.Errorf("%w: 标签 %s 的正则无效: %v", ErrAlertSuppressionRuleInvalid, m.Label, err)
+			}
+		default:
+			return fmt.Errorf("%w: 不支持的匹配操作符 %s", ErrAlertSuppressionRuleInvalid, m.Op)
+		}
+	}
+	return nil
+}
+
+// matchAlertLabels 标签全部满足匹配条件；缺失的标签视为空字符串
+func matchAlertLabels(matchers []schema.AlertMatcher, labels map[string]string) bool {
+	if len(matchers) == 0 {
+		return false
+	}
+	for _, m := range matchers {
+		value := labels[m.Label]
+		var ok bool
+		switch m.Op {
+		case "", "=":
+			ok = value == m.Value
+		case "!=":
+			ok = value != m.Value
+		case "=~", "!~":
+			re, err := regexp.Compile("^(?:" + m.Value + ")$")
+			if err != nil {
+				return false
+			}
+			ok = re.MatchString(value) == (m.Op == "=~")
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// sharedCorrelationLabels 任一方带有的关联标签在双方都相同时返回相同的 k=v 列表，否则返回 nil
+func sharedCorrelationLabels(keys []string, a, b map[string]string) []string {
+	var shared []string
+	for _, k := range keys {
+		va, vb := a[k], b[k]
+		if va == "" && vb == "" {
+			continue
+		}
+		if va != vb {
+			return nil
+		}
+		shared = append(shared, k+"="+va)
+	}
+	return shared
+}
+
+// conflictingScopeLabels 双方都带有、取值却不同的关联标签（alertname 除外，告警名已参与文本比较），
+// 如 cluster 不同的同名告警不做文本相似归并
+func conflictingScopeLabels(keys []string, a, b map[string]string) bool {
+	for _, k := range keys {
+		if k == "alertname" {
+			continue
+		}
+		if va, vb := a[k], b[k]; va != "" && vb != "" && va != vb {
+			return true
+		}
+	}
+	return false
+}
+
+// textSimilarity 文本相似度：归一化（小写、数字串折叠）后字符二元组的 Jaccard 系数，兼容中英文
+func textSimilarity(a, b string) float64 {
+	sa, sb := textBigrams(a), textBigrams(b)
+	if len(sa) == 0 || len(sb) == 0 {
+		return 0
+	}
+	inter := 0
+	for g := range sa {
+		if sb[g] {
+			inter++
+		}
+	}
+	return float64(inter) / float64(len(sa)+len(sb)-inter)
+}
+
+func textBigrams(s string) map[string]bool {
+	runes := make([]rune, 0, len(s))
+	lastDigit := false
+	for _, r := range strings.ToLower(s) {
+		switch {
+		case unicode.IsDigit(r):
+			if !lastDigit {
+				runes = append(runes, '0')
+			}
+			lastDigit = true
+			continue
+		case unicode.IsSpace(r) || unicode.IsPunct(r) || unicode.IsSymbol(r):
+			if len(runes) > 0 && runes[len(runes)-1] != ' ' {
+				runes = append(runes, ' ')
+			}
+		default:
+			runes = append(runes, r)
+		}
+		lastDigit = false
+	}
+	grams := make(map[string]bool, len(runes))
+	for i := 0; i+1 < len(runes); i++ {
+		if runes[i] == ' ' && runes[i+1] == ' ' {
+			continue
+		}
+		grams[string(runes[i:i+2])] = true
+	}
+	return grams
+}
+
+// flapState 记录一次状态切换并判断是否抖动：抖动窗口内的切换次数达到阈值即视为抖动
+func flapState(changes []time.Time, transitioned bool, policy monitorconn.Policy, now time.Time) ([]time.Time, bool) {
+	if policy.FlapThreshold <= 0 {
+		return nil, false
+	}
+	window := policy.FlapWindow
+	if window <= 0 {
+		window = alertFlapDefaultWindow
+	}
+	kept := make([]time.Time, 0, len(changes)+1)
+	for _, t := range changes {
+		if now.Sub(t) < window {
+			kept = append(kept, t)
+		}
+	}
+	if transitioned {
+		kept = append(kept, now)
+	}
+	return kept, len(kept) >= policy.FlapThreshold
+}
+
+func noiseReductionRatio(stats dto.AlertNoiseStats) float64 {
+	if stats.Notifications == 0 {
+		return 0
+	}
+	ratio := 1 - float64(stats.IncidentsOpened)/float64(stats.Notifications)
+	if ratio < 0 {
+		return 0
+	}
+	return float64(int(ratio*10000+0.5)) / 10000
+}
@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"itsm-backend/common"
+	monitorconn "itsm-backend/connector/builtin/monitoring"
+	"itsm-backend/dto"
+	"itsm-backend/ent/enttest"
+	"itsm-backend/ent/incidentalert"
+	"itsm-backend/ent/incidentevent"
+	"itsm-backend/ent/monitoringalert"
+	"itsm-backend/ent/schema"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestAlertCorrelationService(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", testDSN())
+	defer client.Close()
+	ctx := context.Background()
+	logger := zap.NewNop().Sugar()
+
+	tenant, err := createIncidentTestTenant(ctx, client, "correlation")
+	require.NoError(t, err)
+	reporter, err := createIncidentTestUser(ctx, client, tenant.ID, "correlation-bot")
+	require.NoError(t, err)
+	ciType, err := createTestCIType(ctx, client, tenant.ID, "network")
+	require.NoError(t, err)
+	coreSwitch, err := createTestCI(ctx, client, tenant.ID, ciType.ID, "core-sw-01")
+	require.NoError(t, err)
+	host, err := createTestCI(ctx, client, tenant.ID, ciType.ID, "app-01")
+	require.NoError(t, err)
+	// 交换机影响主机：主机是交换机的下游
+	client.CIRelationship.Create().SetRelationshipType("impacts").SetSourceCiID(coreSwitch.ID).SetTargetCiID(host.ID).SetTenantID(tenant.ID).SaveX(ctx)
+
+	incidents := NewIncidentService(client, logger)
+	correlation := NewAlertCorrelationService(client, NewCIRelationshipService(client, logger), logger)
+	svc := NewMonitoringAlertService(client, incidents, logger)
+	svc.SetCorrelationService(correlation)
+	clock := time.Now()
+	svc.now = func() time.Time { return clock }
+	correlation.now = func() time.Time { return clock }
+
+	policy := monitorconn.Policy{
+		ReporterID: reporter.ID, Category: "monitoring", AutoResolve: true, CILabels: []string{"host"},
+		Correlate: true, CorrelationWindow: 10 * time.Minute, CorrelationLabels: []string{"alertname", "cluster"},
+		SimilarityThreshold: 0.8, TopologyDepth: 3, FlapThreshold: 3, FlapWindow: 30 * time.Minute,
+	}
+	fire := func(source, fingerprint, name string, labels map[string]string) dto.MonitoringAlertIngestItem {
+		res, err := svc.Ingest(ctx, tenant.ID, policy, []monitorconn.Alert{{
+			Source: source, Fingerprint: fingerprint, Status: monitorconn.StatusFiring, Name: name,
+			Severity: "high", StartsAt: clock, Labels: labels, Annotations: map[string]string{},
+		}})
+		require.NoError(t, err)
+		return res.Items[0]
+	}
+	resolve := func(source, fingerprint, name string) dto.MonitoringAlertIngestItem {
+		res, err := svc.Ingest(ctx, tenant.ID, policy, []monitorconn.Alert{{
+			Source: source, Fingerprint: fingerprint, Status: monitorconn.StatusResolved, Name: name,
+			Severity: "high", StartsAt: clock, Labels: map[string]string{}, Annotations: map[string]string{},
+		}})
+		require.NoError(t, err)
+		return res.Items[0]
+	}
+
+	t.Run("topology: downstream host alert joins switch incident", func(t *testing.T) {
+		parent := fire("zabbix", "sw-1", "Interface down", map[string]string{"host": "core-sw-01"})
+		require.Equal(t, "created", parent.Action)
+
+		child := fire("alertmanager", "app-1", "TargetDown", map[string]string{"host": "app-01", "alertname": "TargetDown"})
+		require.Equal(t, "correlated", child.Action)
+		require.Equal(t, parent.IncidentID, child.IncidentID)
+
+		row := client.MonitoringAlert.Query().Where(monitoringalert.Fingerprint("app-1")).OnlyX(ctx)
+		require.Equal(t, monitoringalert.CorrelationReasonTopology, *row.CorrelationReason)
+		require.Equal(t, 1, row.CorrelatedCount)
+		require.Equal(t, 0, row.IncidentsOpened)
+		require.Equal(t, 2, client.IncidentAlert.Query().Where(incidentalert.IncidentID(parent.IncidentID)).CountX(ctx), "父事件挂载子告警")
+		require.Equal(t, 1, client.IncidentEvent.Query().Where(incidentevent.IncidentID(parent.IncidentID), incidentevent.EventType("alert_correlated")).CountX(ctx))
+
+		// 父告警恢复但子告警仍在告警：不自动解决
+		require.Equal(t, "annotated", resolve("zabbix", "sw-1", "Interface down").Action)
+		require.Equal(t, "resolved", resolve("alertmanager", "app-1", "TargetDown").Action)
+		require.Equal(t, common.IncidentStatusResolved, client.Incident.GetX(ctx, parent.IncidentID).Status)
+	})
+
+	t.Run("labels and similarity", func(t *testing.T) {
+		first := fire("alertmanager", "cpu-1", "HighCPU", map[string]string{"alertname": "HighCPU", "cluster": "prod", "instance": "a"})
+		require.Equal(t, "created", first.Action)
+		second := fire("alertmanager", "cpu-2", "HighCPU", map[string]string{"alertname": "HighCPU", "cluster": "prod", "instance": "b"})
+		require.Equal(t, "correlated", second.Action)
+		require.Equal(t, first.IncidentID, second.IncidentID)
+		other := fire("alertmanager", "cpu-3", "HighCPU", map[string]string{"alertname": "HighCPU", "cluster": "staging"})
+		require.Equal(t, "created", other.Action, "关联标签不同不归并")
+
+		disk1 := fire("grafana", "disk-1", "磁盘空间不足 db-01", map[string]string{"alertname": "disk_db01"})
+		require.Equal(t, "created", disk1.Action)
+		disk2 := fire("grafana", "disk-2", "磁盘空间不足 db-02", map[string]string{"alertname": "disk_db02"})
+		require.Equal(t, "correlated", disk2.Action)
+		require.Equal(t, disk1.IncidentID, disk2.IncidentID)
+		row := client.MonitoringAlert.Query().Where(monitoringalert.Fingerprint("disk-2")).OnlyX(ctx)
+		require.Equal(t, monitoringalert.CorrelationReasonSimilarity, *row.CorrelationReason)
+	})
+
+	t.Run("suppression rules", func(t *testing.T) {
+		_, err := correlation.CreateSuppressionRule(ctx, tenant.ID, reporter.ID, &dto.CreateAlertSuppressionRuleRequest{
+			Name: "bad regex", Matchers: []schema.AlertMatcher{{Label: "env", Op: "=~", Value: "("}},
+		})
+		require.ErrorIs(t, err, ErrAlertSuppressionRuleInvalid)
+
+		future := clock.Add(time.Hour)
+		_, err = correlation.CreateSuppressionRule(ctx, tenant.ID, reporter.ID, &dto.CreateAlertSuppressionRuleRequest{
+			Name: "下周维护", Matchers: []schema.AlertMatcher{{Label: "env", Op: "=", Value: "prod"}}, StartsAt: &future,
+		})
+		require.NoError(t, err)
+		rule, err := correlation.CreateSuppressionRule(ctx, tenant.ID, reporter.ID, &dto.CreateAlertSuppressionRuleRequest{
+			Name: "测试环境", Source: "alertmanager", Matchers: []schema.AlertMatcher{{Label: "env", Op: "=~", Value: "staging|dev"}},
+		})
+		require.NoError(t, err)
+
+		item := fire("alertmanager", "noise-1", "Noisy", map[string]string{"alertname": "Noisy", "env": "dev"})
+		require.Equal(t, "suppressed", item.Action)
+		require.Zero(t, item.IncidentID)
+		require.Equal(t, "suppressed", fire("alertmanager", "noise-1", "Noisy", map[string]string{"alertname": "Noisy", "env": "dev"}).Action)
+		require.Equal(t, 2, client.AlertSuppressionRule.GetX(ctx, rule.ID).HitCount)
+		row := client.MonitoringAlert.Query().Where(monitoringalert.Fingerprint("noise-1")).OnlyX(ctx)
+		require.Equal(t, 2, row.SuppressedCount)
+		require.Nil(t, row.IncidentID)
+
+		require.Equal(t, "created", fire("alertmanager", "prod-1", "ProdOnly", map[string]string{"alertname": "ProdOnly", "env": "prod"}).Action, "未到生效时间的规则不屏蔽")
+
+		disabled := false
+		_, err = correlation.UpdateSuppressionRule(ctx, tenant.ID, rule.ID, &dto.UpdateAlertSuppressionRuleRequest{Enabled: &disabled})
+		require.NoError(t, err)
+		require.Equal(t, "created", fire("alertmanager", "noise-1", "Noisy", map[string]string{"alertname": "Noisy", "env": "dev"}).Action)
+		require.ErrorIs(t, correlation.DeleteSuppressionRule(ctx, tenant.ID+1, rule.ID), ErrAlertSuppressionRuleNotFound)
+	})
+
+	t.Run("flapping alert is not auto-resolved", func(t *testing.T) {
+		labels := map[string]string{"alertname": "Flappy"}
+		opened := fire("alertmanager", "flap-1", "Flappy", labels)
+		require.Equal(t, "created", opened.Action)
+		require.Equal(t, "resolved", resolve("alertmanager", "flap-1", "Flappy").Action)
+		require.Equal(t, "reopened", fire("alertmanager", "flap-1", "Flappy", labels).Action)
+
+		require.Equal(t, "annotated", resolve("alertmanager", "flap-1", "Flappy").Action, "窗口内第 3 次切换进入抖动")
+		require.Equal(t, common.IncidentStatusInProgress, client.Incident.GetX(ctx, opened.IncidentID).Status)
+		row := client.MonitoringAlert.Query().Where(monitoringalert.Fingerprint("flap-1")).OnlyX(ctx)
+		require.True(t, row.Flapping)
+		require.Len(t, row.StateChanges, 3)
+		require.Equal(t, 1, client.IncidentEvent.Query().Where(incidentevent.IncidentID(opened.IncidentID), incidentevent.EventType("alert_flapping")).CountX(ctx))
+
+		// 窗口过后恢复正常判断
+		clock = clock.Add(time.Hour)
+		require.Equal(t, "updated", fire("alertmanager", "flap-1", "Flappy", labels).Action)
+		require.Equal(t, "resolved", resolve("alertmanager", "flap-1", "Flappy").Action)
+	})
+
+	t.Run("noise report per source", func(t *testing.T) {
+		report, err := correlation.NoiseReport(ctx, tenant.ID, clock.Add(-24*time.Hour), clock.Add(time.Minute))
+		require.NoError(t, err)
+		bySource := map[string]dto.AlertNoiseStats{}
+		for _, s := range report.Sources {
+			bySource[s.Source] = s
+		}
+		grafana := bySource["grafana"]
+		require.Equal(t, 2, grafana.Alerts)
+		require.Equal(t, 2, grafana.Notifications)
+		require.Equal(t, 1, grafana.IncidentsOpened)
+		require.Equal(t, 1, grafana.Correlated)
+		require.InDelta(t, 0.5, grafana.NoiseReductionRatio, 1e-9)
+
+		am := bySource["alertmanager"]
+		require.Equal(t, 2, am.Suppressed)
+		require.Equal(t, 2, am.Correlated)
+		require.Greater(t, am.NoiseReductionRatio, 0.0)
+		require.Equal(t, report.Total.Notifications, am.Notifications+grafana.Notifications+bySource["zabbix"].Notifications)
+
+		_, err = correlation.NoiseReport(ctx, tenant.ID, clock, clock.Add(-time.Hour))
+		require.Error(t, err)
+	})
+}
+
+func TestTextSimilarity(t *testing.T) {
+	require.Equal(t, 1.0, textSimilarity("Disk usage 91% on db-1", "disk usage 97% on DB-2"))
+	require.Less(t, textSimilarity("HighCPU on web", "Database replication lag"), 0.3)
+	require.Zero(t, textSimilarity("", "x"))
+}
+
+func TestMatchAlertLabels(t *testing.T) {
+	labels := map[string]string{"env": "staging", "team": "ops"}
+	require.True(t, matchAlertLabels([]schema.AlertMatcher{{Label: "env", Op: "=~", Value: "stag.*"}, {Label: "team", Value: "ops"}}, labels))
+	require.False(t, matchAlertLabels([]schema.AlertMatcher{{Label: "env", Op: "!=", Value: "staging"}}, labels))
+	require.True(t, matchAlertLabels([]schema.AlertMatcher{{Label: "region", Op: "!~", Value: "cn-.*"}}, labels), "缺失的标签按空值匹配")
+	require.False(t, matchAlertLabels(nil, labels))
+}
@@ -296,7 +296,7 @@ func resolveChainApprovers(ctx context.Context, client *ent.Client, logger *zap.
 		return usersByRole(client, evalCtx.TenantID, assigneeValue)
 	case "group":
 		return bpmn.NewGroupResolver(client).ExpandGroupsToUsers(ctx, evalCtx.TenantID, assigneeValue)
-	case "dept_manager", "team_leader", "project_manager", "temp_team_leader":
+	case "dept_manager", "team_leader", "project_manager", "temp_team_leader", "oncall":
 		scopeID, err := strconv.Atoi(assigneeValue)
 		if err != nil {
 			return nil, nil, fmt.Errorf("无效的审批人范围ID: %s", assigneeValue)
@@ -309,12 +309,15 @@ func resolveChainApprovers(ctx context.Context, client *ent.Client, logger *zap.
 			appCtx.TeamID = scopeID
 		case "project_manager":
 			appCtx.ProjectID = scopeID
+		case "oncall":
+			appCtx.ScheduleID = scopeID
 		}
 		registry := approver.NewResolverRegistry(logger)
 		registry.Register(approver.NewDeptManagerResolver())
 		registry.Register(approver.NewTeamLeaderResolver())
 		registry.Register(approver.NewProjectMgrResolver())
 		registry.Register(approver.NewTempTeamResolver())
+		registry.Register(approver.NewOnCallResolver())
 		infos, err := registry.Resolve(ctx, client, assigneeType, appCtx)
 		if err != nil {
 			return nil, nil, err
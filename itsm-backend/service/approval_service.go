@@ -975,7 +975,8 @@ func (s *ApprovalService) parseWorkflowNodes(nodesJSON interface{}) ([]workflowN
 					dto.ApprovalNodeTypeTeamLeader,
 					dto.ApprovalNodeTypeProjectManager,
 					dto.ApprovalNodeTypeTempTeamLeader,
-					dto.ApprovalNodeTypeAmountBased:
+					dto.ApprovalNodeTypeAmountBased,
+					dto.ApprovalNodeTypeOnCall:
 					node.AssigneeType = string(config.ApproverType)
 				}
 			}
@@ -1052,7 +1053,7 @@ func (s *ApprovalService) resolveApprover(ctx context.Context, assigneeType, ass
 			return 0, "", fmt.Errorf("审批组 '%s' 未解析到成员", assigneeValue)
 		}
 		return ids[0], "", nil
-	case "dept_manager", "team_leader", "project_manager", "temp_team_leader":
+	case "dept_manager", "team_leader", "project_manager", "temp_team_leader", "oncall":
 		scopeID, err := strconv.Atoi(assigneeValue)
 		if err != nil {
 			return 0, "", fmt.Errorf("无效的审批人范围ID: %s", assigneeValue)
@@ -1068,6 +1069,8 @@ func (s *ApprovalService) resolveApprover(ctx context.Context, assigneeType, ass
 			appCtx.ProjectID = scopeID
 		case "temp_team_leader":
 			appCtx.TeamID = scopeID
+		case "oncall":
+			appCtx.ScheduleID = scopeID
 		}
 
 		registry := approver.NewResolverRegistry(s.logger)
@@ -1075,6 +1078,7 @@ func (s *ApprovalService) resolveApprover(ctx context.Context, assigneeType, ass
 		registry.Register(approver.NewTeamLeaderResolver())
 		registry.Register(approver.NewProjectMgrResolver())
 		registry.Register(approver.NewTempTeamResolver())
+		registry.Register(approver.NewOnCallResolver())
 
 		approvers, err := registry.Resolve(ctx, s.client, assigneeType, appCtx)
 		if err != nil {
@@ -0,0 +1,62 @@
+package approver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"itsm-backend/ent"
+	"itsm-backend/ent/user"
+	"itsm-backend/service/oncall"
+)
+
+// OnCallResolver resolves the current on-call user of a schedule as approver
+type OnCallResolver struct {
+	now func() time.Time
+}
+
+// NewOnCallResolver creates a new OnCallResolver
+func NewOnCallResolver() *OnCallResolver {
+	return &OnCallResolver{now: time.Now}
+}
+
+// GetType returns the resolver type
+func (r *OnCallResolver) GetType() string {
+	return "oncall"
+}
+
+// Resolve resolves whoever is on call for the schedule right now, overrides included
+func (r *OnCallResolver) Resolve(ctx context.Context, client *ent.Client, appCtx *ApproverContext) ([]*ApproverInfo, error) {
+	if appCtx.ScheduleID == 0 {
+		return nil, fmt.Errorf("schedule_id is required for oncall resolver")
+	}
+
+	shift, err := oncall.Current(ctx, client, appCtx.TenantID, appCtx.ScheduleID, r.now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve on-call for schedule %d: %w", appCtx.ScheduleID, err)
+	}
+
+	onCallUser, err := client.User.Query().
+		Where(
+			user.IDEQ(shift.UserID),
+			user.TenantIDEQ(appCtx.TenantID),
+			user.Active(true),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("on-call user not found or inactive: %d", shift.UserID)
+		}
+		return nil, fmt.Errorf("failed to query on-call user: %w", err)
+	}
+
+	return []*ApproverInfo{
+		{
+			UserID:    onCallUser.ID,
+			UserName:  onCallUser.Name,
+			UserEmail: onCallUser.Email,
+			Role:      "oncall",
+			Source:    fmt.Sprintf("oncall_schedule:%d", appCtx.ScheduleID),
+		},
+	}, nil
+}
@@ -26,6 +26,7 @@ type ApproverContext struct {
 	DepartmentID int                    `json:"departmentId,omitempty"`
 	TeamID       int                    `json:"teamId,omitempty"`
 	ProjectID    int                    `json:"projectId,omitempty"`
+	ScheduleID   int                    `json:"scheduleId,omitempty"` // on-call schedule for the oncall resolver
 	Amount       float64                `json:"amount,omitempty"`
 	Variables    map[string]interface{} `json:"variables,omitempty"`
 }
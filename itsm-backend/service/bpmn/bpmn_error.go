@@ -0,0 +1,24 @@
+package bpmn
+
+import "fmt"
+
+// BPMNError 服务任务处理器抛出的 BPMN 业务错误。
+// 处理器返回携带 Code 的 BPMNError（可被 errors.Wrap 包装）时，流程引擎不再让整个事务失败，
+// 而是按错误码路由到匹配的错误边界事件；没有任何作用域捕获时才作为普通错误返回。
+type BPMNError struct {
+	Code    string
+	Message string
+}
+
+// NewBPMNError 创建 BPMN 业务错误
+func NewBPMNError(code, message string) *BPMNError {
+	return &BPMNError{Code: code, Message: message}
+}
+
+// Error 实现 error 接口
+func (e *BPMNError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("BPMN错误 %s", e.Code)
+	}
+	return fmt.Sprintf("BPMN错误 %s: %s", e.Code, e.Message)
+}
@@ -271,6 +271,8 @@ func parseNotifyChannels(value string) []string {
 		"feishu":   {},
 		"dingtalk": {},
 		"wecom":    {},
+		"slack":    {},
+		"teams":    {},
 		"webhook":  {},
 	}
 	seen := map[string]struct{}{}
@@ -0,0 +1,313 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"itsm-backend/ent"
+	"itsm-backend/ent/processincident"
+	"itsm-backend/internal/commandbus"
+	"itsm-backend/service/bpmn"
+)
+
+// BPMN 异步服务任务
+//
+// 声明了 camunda:asyncBefore 的服务任务不在推进流程的事务内执行：实例在该任务上进入等待态，
+// 同一事务内写入一条 CommandExecuteBPMNJob 命令，由 commandbus worker 执行回调后继续推进。
+// 回调失败按任务的 failedJobRetryTimeCycle（缺省 R3/PT5M）重新调度，次数用尽后在实例上
+// 创建 open 状态的 ProcessIncident，等待运维在监控服务中重试或跳过。
+
+const (
+	// bpmnAsyncDefaultRetryCycle 未配置 failedJobRetryTimeCycle 时的重试策略：共执行 3 次，间隔 5 分钟
+	bpmnAsyncDefaultRetryCycle = "R3/PT5M"
+	// bpmnAsyncJobMaxAttempts 命令本身投递失败（如实例被暂停）时 commandbus 的最大重试次数
+	bpmnAsyncJobMaxAttempts = 20
+
+	bpmnIncidentFailedJob = "failed_job"
+	bpmnIncidentOpen      = "open"
+	bpmnIncidentResolved  = "resolved"
+
+	// 事故关闭方式：运维重试、运维跳过、实例被终止
+	BPMNIncidentRetried    = "retried"
+	BPMNIncidentSkipped    = "skipped"
+	BPMNIncidentTerminated = "terminated"
+)
+
+// asyncJobIdempotencyPrefix 同一实例同一服务任务的异步命令共享前缀
+func asyncJobIdempotencyPrefix(instanceID int, elementID string) string {
+	return fmt.Sprintf("bpmn-job:%d:%s:", instanceID, elementID)
+}
+
+// asyncRetryPolicy 解析服务任务的重试策略，返回总执行次数与重试间隔；配置无效时回落到缺省策略
+func (e *CustomProcessEngine) asyncRetryPolicy(task *BPMNServiceTask) (int, isoDuration) {
+	if cycle := task.RetryTimeCycle(); cycle != "" {
+		spec, err := parseBPMNTimerCycle(cycle)
+		if err == nil && spec.Repetitions > 0 {
+			return spec.Repetitions, spec.Duration
+		}
+		e.logger.Warnw("服务任务重试策略无效，使用缺省策略", "elementID", task.ID, "cycle", cycle, "error", err)
+	}
+	spec, _ := parseBPMNTimerCycle(bpmnAsyncDefaultRetryCycle)
+	return spec.Repetitions, spec.Duration
+}
+
+// enterAsyncServiceTask 进入异步服务任务：在任务上等待并调度第一次执行
+func (e *CustomProcessEngine) enterAsyncServiceTask(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, process *BPMNProcess, task *BPMNServiceTask) error {
+	if err := e.markElementWaiting(ctx, txc, instance, task.ID, true); err != nil {
+		return err
+	}
+	if err := e.enterActivityBoundaries(ctx, txc, instance, process, task.ID); err != nil {
+		return err
+	}
+	return e.scheduleAsyncJob(ctx, txc, instance, task.ID, 1, time.Now())
+}
+
+// scheduleAsyncJob 写入一条异步服务任务命令，attempt 为本次是第几次执行
+func (e *CustomProcessEngine) scheduleAsyncJob(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, elementID string, attempt int, availableAt time.Time) error {
+	_, err := commandbus.Enqueue(ctx, txc, commandbus.EnqueueRequest{
+		TenantID:       instance.TenantID,
+		CommandType:    commandbus.CommandExecuteBPMNJob,
+		AggregateType:  bpmnTimerAggregateInstance,
+		AggregateID:    instance.ID,
+		IdempotencyKey: fmt.Sprintf("%s%d", asyncJobIdempotencyPrefix(instance.ID, elementID), availableAt.UnixNano()),
+		Payload: map[string]interface{}{
+			"element_id": elementID,
+			"attempt":    attempt,
+		},
+		MaxAttempts: bpmnAsyncJobMaxAttempts,
+		AvailableAt: availableAt,
+	})
+	if err != nil {
+		return fmt.Errorf("调度异步服务任务 %s 失败: %w", elementID, err)
+	}
+	return nil
+}
+
+// HandleAsyncJobCommand 是 commandbus.CommandExecuteBPMNJob 的处理器。
+// 回调在事务外执行，避免慢速外部调用长时间占用事务；结果再在新事务内推进流程或安排重试。
+func (e *CustomProcessEngine) HandleAsyncJobCommand(ctx context.Context, cmd *ent.OperationalCommand) error {
+	if cmd == nil || cmd.TenantID <= 0 || cmd.AggregateID <= 0 {
+		return fmt.Errorf("invalid BPMN job command")
+	}
+	ctx = context.WithValue(ctx, bpmn.BPMNTenantIDContextKey, cmd.TenantID)
+	elementID, _ := cmd.Payload["element_id"].(string)
+	if elementID == "" {
+		return fmt.Errorf("BPMN job command %d has no element_id", cmd.ID)
+	}
+	attempt, ok := numericInt(cmd.Payload["attempt"])
+	if !ok || attempt <= 0 {
+		attempt = 1
+	}
+
+	instance, err := e.client.ProcessInstance.Get(ctx, cmd.AggregateID)
+	if err != nil || instance.TenantID != cmd.TenantID {
+		e.logger.Warnw("异步服务任务对应的流程实例不存在，忽略", "command", cmd.ID, "instance", cmd.AggregateID)
+		return nil
+	}
+	switch instance.Status {
+	case "running":
+	case "suspended":
+		// 返回错误交由 commandbus 退避重试，实例恢复后再执行
+		return fmt.Errorf("流程实例 %s 已暂停，异步服务任务延后执行", instance.ProcessInstanceID)
+	default:
+		return nil
+	}
+	if !isElementWaiting(instance, elementID) {
+		e.logger.Infow("异步服务任务已失效（实例不在该节点等待），忽略", "instance", instance.ID, "element", elementID)
+		return nil
+	}
+	process, err := e.loadInstanceProcess(ctx, e.client, instance)
+	if err != nil {
+		return err
+	}
+	task := e.findServiceTask(process, elementID)
+	if task == nil {
+		return fmt.Errorf("服务任务 %s 不存在于流程定义中", elementID)
+	}
+
+	var outputs map[string]interface{}
+	var execErr error
+	if task.MultiInstance != nil {
+		outputs, execErr = e.runMultiInstanceServiceTask(ctx, instance.Variables, task)
+	} else {
+		execErr = e.runServiceTask(ctx, instance.Variables, task, nil)
+	}
+	return e.finishAsyncJob(ctx, instance.ID, process, task, attempt, outputs, execErr)
+}
+
+// finishAsyncJob 在事务内处理异步执行结果：成功时合并输出变量（多实例的输出集合）并沿出边推进；
+// BPMNError 路由到错误边界事件；其余失败按重试策略重新调度，次数用尽则创建事故
+func (e *CustomProcessEngine) finishAsyncJob(ctx context.Context, instanceID int, process *BPMNProcess, task *BPMNServiceTask, attempt int, outputs map[string]interface{}, execErr error) error {
+	tx, err := e.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+	txc := tx.Client()
+
+	instance, err := txc.ProcessInstance.Get(ctx, instanceID)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("获取流程实例失败: %w", err)
+	}
+	// 执行期间实例已结束或任务已被边界事件中断：结果作废
+	if instance.Status != "running" || !isElementWaiting(instance, task.ID) {
+		_ = tx.Rollback()
+		return nil
+	}
+
+	if execErr == nil {
+		if len(outputs) > 0 {
+			if instance, err = e.mergeVariablesInTx(ctx, txc, instance.ID, outputs); err != nil {
+				_ = tx.Rollback()
+				return err
+			}
+		}
+		err = e.leaveAsyncServiceTask(ctx, txc, instance, process, task.ID)
+	} else {
+		err = e.handleAsyncJobFailure(ctx, txc, instance, process, task, attempt, execErr)
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("提交事务失败: %w", err)
+	}
+	return nil
+}
+
+// leaveAsyncServiceTask 异步服务任务结束（执行成功或被运维跳过）：离开等待态并沿出边推进
+func (e *CustomProcessEngine) leaveAsyncServiceTask(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, process *BPMNProcess, elementID string) error {
+	if err := e.markElementWaiting(ctx, txc, instance, elementID, false); err != nil {
+		return err
+	}
+	if err := e.cancelBoundaryEvents(ctx, txc, instance, process, elementID); err != nil {
+		return err
+	}
+	e.registerCompensable(ctx, txc, instance, process, elementID)
+	e.markElementDone(ctx, txc, instance, elementID)
+	return e.executeStep(ctx, txc, instance, process, elementID, instance.Variables)
+}
+
+// handleAsyncJobFailure 异步执行失败
+func (e *CustomProcessEngine) handleAsyncJobFailure(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, process *BPMNProcess, task *BPMNServiceTask, attempt int, execErr error) error {
+	var bpmnErr *bpmn.BPMNError
+	if errors.As(execErr, &bpmnErr) {
+		handled, err := e.throwError(ctx, txc, instance, process, task.ID, bpmnErr.Code, bpmnErr.Message)
+		if err != nil || handled {
+			return err
+		}
+	}
+	retries, interval := e.asyncRetryPolicy(task)
+	if attempt < retries {
+		next := interval.addTo(time.Now())
+		e.logger.Warnw("异步服务任务执行失败，稍后重试", "instance", instance.ProcessInstanceID, "element", task.ID,
+			"attempt", attempt, "retries", retries, "next", next, "error", execErr)
+		return e.scheduleAsyncJob(ctx, txc, instance, task.ID, attempt+1, next)
+	}
+	return e.createIncident(ctx, txc, instance, task.ID, attempt, execErr)
+}
+
+// createIncident 重试用尽：在实例上创建事故，实例继续停在该服务任务上
+func (e *CustomProcessEngine) createIncident(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, elementID string, attempts int, cause error) error {
+	message := cause.Error()
+	if len(message) > 2000 {
+		message = strings.ToValidUTF8(message[:2000], "")
+	}
+	incident, err := txc.ProcessIncident.Create().
+		SetTenantID(instance.TenantID).
+		SetProcessInstanceID(instance.ID).
+		SetProcessDefinitionKey(instance.ProcessDefinitionKey).
+		SetElementID(elementID).
+		SetIncidentType(bpmnIncidentFailedJob).
+		SetMessage(message).
+		SetAttempts(attempts).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("创建流程事故失败: %w", err)
+	}
+	e.logger.Errorw("异步服务任务重试用尽，已创建流程事故", "instance", instance.ProcessInstanceID, "element", elementID,
+		"incident", incident.ID, "attempts", attempts, "error", cause)
+	e.recordScopeHistory(ctx, txc, instance, elementID, ActivityTypeServiceTask, "incident.created", message)
+	return nil
+}
+
+// resolveIncident 运维处理事故：retried 重新调度服务任务（重试次数重新计算），skipped 不执行回调直接沿出边推进
+func (e *CustomProcessEngine) resolveIncident(ctx context.Context, tenantID, incidentID int, resolution, operator string) error {
+	if tenantID <= 0 {
+		return fmt.Errorf("缺少有效租户上下文")
+	}
+	if resolution != BPMNIncidentRetried && resolution != BPMNIncidentSkipped {
+		return fmt.Errorf("不支持的事故处理方式: %s", resolution)
+	}
+	ctx = context.WithValue(ctx, bpmn.BPMNTenantIDContextKey, tenantID)
+	tx, err := e.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+	txc := tx.Client()
+
+	incident, err := txc.ProcessIncident.Query().
+		Where(processincident.ID(incidentID), processincident.TenantID(tenantID)).
+		Only(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("获取流程事故失败: %w", err)
+	}
+	claimed, err := txc.ProcessIncident.Update().
+		Where(processincident.ID(incident.ID), processincident.StatusEQ(bpmnIncidentOpen)).
+		SetStatus(bpmnIncidentResolved).
+		SetResolution(resolution).
+		SetResolvedBy(operator).
+		SetResolvedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("更新流程事故失败: %w", err)
+	}
+	if claimed != 1 {
+		_ = tx.Rollback()
+		return fmt.Errorf("流程事故 %d 已被处理", incident.ID)
+	}
+
+	instance, err := txc.ProcessInstance.Get(ctx, incident.ProcessInstanceID)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("获取流程实例失败: %w", err)
+	}
+	// 实例已结束或已离开该任务时只关闭事故
+	if instance.Status == "running" && isElementWaiting(instance, incident.ElementID) {
+		if resolution == BPMNIncidentRetried {
+			err = e.scheduleAsyncJob(ctx, txc, instance, incident.ElementID, 1, time.Now())
+		} else {
+			var process *BPMNProcess
+			if process, err = e.loadInstanceProcess(ctx, txc, instance); err == nil {
+				e.recordScopeHistory(ctx, txc, instance, incident.ElementID, ActivityTypeServiceTask, "incident.skipped", operator)
+				err = e.leaveAsyncServiceTask(ctx, txc, instance, process, incident.ElementID)
+			}
+		}
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("提交事务失败: %w", err)
+	}
+	return nil
+}
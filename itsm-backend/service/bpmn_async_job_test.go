@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"itsm-backend/ent"
+	"itsm-backend/ent/operationalcommand"
+	"itsm-backend/ent/processincident"
+	"itsm-backend/internal/commandbus"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 异步调用 webhook，失败按 R2/PT10M 重试
+const asyncServiceTaskBPMN = `<?xml version="1.0" encoding="UTF-8"?>
+<bpmn:definitions xmlns:bpmn="http://www.omg.org/spec/BPMN/20100524/MODEL" xmlns:camunda="http://camunda.org/schema/1.0/bpmn" id="Definitions_async" targetNamespace="http://bpmn.io/schema/bpmn">
+  <bpmn:process id="Process_async" name="Async" isExecutable="true">
+    <bpmn:startEvent id="StartEvent_1"/>
+    <bpmn:serviceTask id="Notify" name="通知外部系统" implementation="webhook" camunda:asyncBefore="true">
+      <bpmn:extensionElements>
+        <camunda:failedJobRetryTimeCycle>R2/PT10M</camunda:failedJobRetryTimeCycle>
+      </bpmn:extensionElements>
+    </bpmn:serviceTask>
+    <bpmn:userTask id="Review" name="复核" assignee="1"/>
+    <bpmn:endEvent id="EndEvent_1"/>
+    <bpmn:sequenceFlow id="Flow_1" sourceRef="StartEvent_1" targetRef="Notify"/>
+    <bpmn:sequenceFlow id="Flow_2" sourceRef="Notify" targetRef="Review"/>
+    <bpmn:sequenceFlow id="Flow_3" sourceRef="Review" targetRef="EndEvent_1"/>
+  </bpmn:process>
+</bpmn:definitions>`
+
+// pendingJobs 返回待执行的异步服务任务命令
+func pendingJobs(t *testing.T, client *ent.Client, ctx context.Context) []*ent.OperationalCommand {
+	t.Helper()
+	cmds, err := client.OperationalCommand.Query().
+		Where(
+			operationalcommand.CommandType(commandbus.CommandExecuteBPMNJob),
+			operationalcommand.StatusEQ(commandbus.StatusPending),
+		).
+		All(ctx)
+	require.NoError(t, err)
+	return cmds
+}
+
+// runJob 模拟 commandbus worker 执行一条异步命令
+func runJob(t *testing.T, engine *CustomProcessEngine, client *ent.Client, ctx context.Context, cmd *ent.OperationalCommand) {
+	t.Helper()
+	require.NoError(t, engine.HandleAsyncJobCommand(ctx, cmd))
+	_, err := client.OperationalCommand.UpdateOneID(cmd.ID).SetStatus(commandbus.StatusSucceeded).Save(ctx)
+	require.NoError(t, err)
+}
+
+func TestAsyncServiceTask_ExecutesInWorker(t *testing.T) {
+	engine, client, ctx := seedTimerEngine(t, "async_ok", asyncServiceTaskBPMN)
+	var calls []string
+	engine.callbackRegistry.RegisterHandler(&stubServiceTaskHandler{id: "webhook", calls: &calls})
+
+	inst, err := engine.StartProcess(ctx, "async_ok", "REQ-1", map[string]interface{}{})
+	require.NoError(t, err)
+	assert.Empty(t, calls, "asyncBefore 服务任务不应在启动事务内执行")
+	inst, err = client.ProcessInstance.Get(ctx, inst.ID)
+	require.NoError(t, err)
+	assert.True(t, isElementWaiting(inst, "Notify"))
+
+	jobs := pendingJobs(t, client, ctx)
+	require.Len(t, jobs, 1)
+	runJob(t, engine, client, ctx, jobs[0])
+	assert.Equal(t, []string{"webhook"}, calls)
+	openTask(t, client, ctx, inst.ID, "Review")
+
+	// 重复投递不应再次执行
+	require.NoError(t, engine.HandleAsyncJobCommand(ctx, jobs[0]))
+	assert.Len(t, calls, 1)
+}
+
+func TestAsyncServiceTask_RetriesThenRaisesIncident(t *testing.T) {
+	engine, client, ctx := seedTimerEngine(t, "async_fail", asyncServiceTaskBPMN)
+	handler := &stubServiceTaskHandler{id: "webhook", err: assert.AnError}
+	engine.callbackRegistry.RegisterHandler(handler)
+	monitoring := NewBPMNMonitoringService(client, nil, engine.logger)
+	monitoring.SetProcessEngine(engine)
+
+	inst, err := engine.StartProcess(ctx, "async_fail", "REQ-2", map[string]interface{}{})
+	require.NoError(t, err)
+	runJob(t, engine, client, ctx, pendingJobs(t, client, ctx)[0])
+
+	retry := pendingJobs(t, client, ctx)
+	require.Len(t, retry, 1, "第一次失败后应按重试策略重新调度")
+	assert.EqualValues(t, 2, retry[0].Payload["attempt"])
+	assert.WithinDuration(t, time.Now().Add(10*time.Minute), retry[0].AvailableAt, time.Minute)
+	runJob(t, engine, client, ctx, retry[0])
+	assert.Empty(t, pendingJobs(t, client, ctx), "重试用尽后不再调度")
+
+	incidents, total, err := monitoring.ListProcessIncidents(ctx, &ListProcessIncidentsQuery{TenantID: 11, Status: "open"})
+	require.NoError(t, err)
+	require.Equal(t, 1, total)
+	assert.Equal(t, "Notify", incidents[0].ElementID)
+	assert.Equal(t, 2, incidents[0].Attempts)
+	assert.Contains(t, incidents[0].Message, assert.AnError.Error())
+	inst, err = client.ProcessInstance.Get(ctx, inst.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "running", inst.Status)
+	assert.True(t, isElementWaiting(inst, "Notify"))
+
+	// 运维重试：外部系统恢复后执行成功
+	handler.err = nil
+	require.NoError(t, monitoring.RetryProcessIncident(ctx, 11, incidents[0].ID, "7"))
+	runJob(t, engine, client, ctx, pendingJobs(t, client, ctx)[0])
+	openTask(t, client, ctx, inst.ID, "Review")
+	resolved, err := client.ProcessIncident.Get(ctx, incidents[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, "resolved", resolved.Status)
+	assert.Equal(t, BPMNIncidentRetried, resolved.Resolution)
+	assert.Error(t, monitoring.SkipProcessIncident(ctx, 11, incidents[0].ID, "7"), "已处理的事故不能再次处理")
+}
+
+func TestAsyncServiceTask_SkipIncidentContinues(t *testing.T) {
+	engine, client, ctx := seedTimerEngine(t, "async_skip", asyncServiceTaskBPMN)
+	engine.callbackRegistry.RegisterHandler(&stubServiceTaskHandler{id: "webhook", err: assert.AnError})
+	monitoring := NewBPMNMonitoringService(client, nil, engine.logger)
+	monitoring.SetProcessEngine(engine)
+
+	inst, err := engine.StartProcess(ctx, "async_skip", "REQ-3", map[string]interface{}{})
+	require.NoError(t, err)
+	for jobs := pendingJobs(t, client, ctx); len(jobs) > 0; jobs = pendingJobs(t, client, ctx) {
+		runJob(t, engine, client, ctx, jobs[0])
+	}
+	incident, err := client.ProcessIncident.Query().Where(processincident.ProcessInstanceID(inst.ID)).Only(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, monitoring.SkipProcessIncident(ctx, 11, incident.ID, "7"))
+	openTask(t, client, ctx, inst.ID, "Review")
+	inst, err = client.ProcessInstance.Get(ctx, inst.ID)
+	require.NoError(t, err)
+	assert.False(t, isElementWaiting(inst, "Notify"))
+	assert.Empty(t, pendingJobs(t, client, ctx), "跳过事故不应再调度服务任务")
+}
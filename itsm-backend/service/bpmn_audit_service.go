@@ -46,15 +46,19 @@ const (
 
 // ActivityType 活动类型
 const (
+	ActivityTypeProcess           = "process"
 	ActivityTypeStartEvent        = "startEvent"
 	ActivityTypeEndEvent          = "endEvent"
 	ActivityTypeUserTask          = "userTask"
 	ActivityTypeServiceTask       = "serviceTask"
 	ActivityTypeScriptTask        = "scriptTask"
+	ActivityTypeBusinessRuleTask  = "businessRuleTask"
 	ActivityTypeManualTask        = "manualTask"
 	ActivityTypeGateway           = "gateway"
 	ActivityTypeSubProcess        = "subProcess"
+	ActivityTypeCallActivity      = "callActivity"
 	ActivityTypeIntermediateEvent = "intermediateEvent"
+	ActivityTypeBoundaryEvent     = "boundaryEvent"
 )
 
 // AuditContext 审计上下文
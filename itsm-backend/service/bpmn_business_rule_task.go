@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"itsm-backend/ent"
+	"itsm-backend/ent/decisiondefinition"
+)
+
+// BPMN 业务规则任务
+//
+// 业务规则任务按 decisionRef 引用 DMN 决策表（默认取最新部署版本，decisionRefBinding="version"
+// 时取 decisionRefVersion 指定的版本），以实例变量为输入求值，输出写回流程变量后沿出边继续：
+//   - 单结果（UNIQUE / FIRST / ANY，或带聚合的 COLLECT）：每个输出列写入同名变量
+//   - 多结果（COLLECT / RULE ORDER）：每个输出列写入同名列表变量，按规则顺序排列
+//
+// 声明 resultVariable 时另外写入完整结果：单结果单输出列为该值，单结果多输出列为对象，多结果为对象列表。
+
+func (e *CustomProcessEngine) findBusinessRuleTask(process *BPMNProcess, id string) *BPMNBusinessRuleTask {
+	for _, task := range process.scopeOf(id).BusinessRuleTasks {
+		if task.ID == id {
+			return task
+		}
+	}
+	return nil
+}
+
+// executeBusinessRuleTask 求值业务规则任务引用的决策并继续推进
+func (e *CustomProcessEngine) executeBusinessRuleTask(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, process *BPMNProcess, task *BPMNBusinessRuleTask) error {
+	version := 0
+	if strings.EqualFold(task.DecisionRefBinding, "version") {
+		v, err := strconv.Atoi(strings.TrimSpace(task.DecisionRefVersion))
+		if err != nil || v <= 0 {
+			return fmt.Errorf("业务规则任务 %s 的 decisionRefVersion 无效: %q", task.ID, task.DecisionRefVersion)
+		}
+		version = v
+	}
+	definition, decision, err := loadDecision(ctx, txc, instance.TenantID, task.DecisionKey(), version)
+	if err != nil {
+		return fmt.Errorf("业务规则任务 %s 加载决策失败: %w", task.ID, err)
+	}
+	result, err := NewDMNEngine(e.exprEngine).Evaluate(decision, instance.Variables)
+	if err != nil {
+		return fmt.Errorf("业务规则任务 %s 求值决策失败: %w", task.ID, err)
+	}
+	result.Version = definition.Version
+
+	if outputs := decisionResultVariables(decision.DecisionTable, result, task.ResultVariable); len(outputs) > 0 {
+		updated, err := e.mergeVariablesInTx(ctx, txc, instance.ID, outputs)
+		if err != nil {
+			return err
+		}
+		*instance = *updated
+	}
+	e.recordScopeHistory(ctx, txc, instance, task.ID, ActivityTypeBusinessRuleTask, "decision.evaluated",
+		fmt.Sprintf("%s:%d %s", definition.Key, definition.Version, strings.Join(result.MatchedRules, ",")))
+
+	e.registerCompensable(ctx, txc, instance, process, task.ID)
+	e.markElementDone(ctx, txc, instance, task.ID)
+	return e.executeStep(ctx, txc, instance, process, task.ID, instance.Variables)
+}
+
+// EvaluateDecision 以给定变量直接求值当前租户下的决策，version 为 0 时取最新版本。
+// 供业务分析人员在发布规则前试算，不影响任何流程实例。
+func (e *CustomProcessEngine) EvaluateDecision(ctx context.Context, key string, version int, variables map[string]interface{}) (*DMNDecisionResult, error) {
+	tenantID, err := requireBPMNTenantContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	definition, decision, err := loadDecision(ctx, e.client, tenantID, key, version)
+	if err != nil {
+		return nil, err
+	}
+	result, err := NewDMNEngine(e.exprEngine).Evaluate(decision, variables)
+	if err != nil {
+		return nil, err
+	}
+	result.Version = definition.Version
+	return result, nil
+}
+
+// loadDecision 加载租户下指定 key 的决策定义并解析出决策表，version 为 0 时取最新版本
+func loadDecision(ctx context.Context, client *ent.Client, tenantID int, key string, version int) (*ent.DecisionDefinition, *DMNDecision, error) {
+	if key == "" {
+		return nil, nil, fmt.Errorf("未指定决策Key")
+	}
+	query := client.DecisionDefinition.Query().
+		Where(decisiondefinition.TenantID(tenantID), decisiondefinition.Key(key))
+	if version > 0 {
+		query = query.Where(decisiondefinition.Version(version))
+	} else {
+		query = query.Where(decisiondefinition.IsLatest(true))
+	}
+	definition, err := query.Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, fmt.Errorf("决策 %s 不存在", key)
+		}
+		return nil, nil, fmt.Errorf("查询决策定义失败: %w", err)
+	}
+	definitions, err := ParseDMNXML([]byte(definition.DmnXML))
+	if err != nil {
+		return nil, nil, err
+	}
+	decision := definitions.Decision(key)
+	if decision == nil {
+		return nil, nil, fmt.Errorf("决策定义 %s:%d 中缺少决策 %s", key, definition.Version, key)
+	}
+	return definition, decision, nil
+}
+
+// decisionResultVariables 把决策结果映射为要写入的流程变量
+func decisionResultVariables(table *DMNDecisionTable, result *DMNDecisionResult, resultVariable string) map[string]interface{} {
+	outputs := make(map[string]interface{})
+	if table.SingleResult() {
+		entry := result.SingleEntry()
+		for name, value := range entry {
+			outputs[name] = value
+		}
+		if resultVariable != "" {
+			switch {
+			case entry == nil:
+				outputs[resultVariable] = nil
+			case len(table.Outputs) == 1:
+				outputs[resultVariable] = entry[table.Outputs[0].OutputName()]
+			default:
+				outputs[resultVariable] = entry
+			}
+		}
+		return outputs
+	}
+
+	for _, output := range table.Outputs {
+		name := output.OutputName()
+		values := make([]interface{}, 0, len(result.Entries))
+		for _, entry := range result.Entries {
+			values = append(values, entry[name])
+		}
+		outputs[name] = values
+	}
+	if resultVariable != "" {
+		entries := make([]interface{}, 0, len(result.Entries))
+		for _, entry := range result.Entries {
+			entries = append(entries, entry)
+		}
+		outputs[resultVariable] = entries
+	}
+	return outputs
+}
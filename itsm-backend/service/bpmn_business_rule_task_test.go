@@ -0,0 +1,130 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"itsm-backend/ent/decisiondefinition"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 业务规则任务按优先级矩阵定级后由排他网关路由
+const businessRuleTaskBPMN = `<?xml version="1.0" encoding="UTF-8"?>
+<bpmn:definitions xmlns:bpmn="http://www.omg.org/spec/BPMN/20100524/MODEL" xmlns:camunda="http://camunda.org/schema/1.0/bpmn" id="Definitions_rule" targetNamespace="http://bpmn.io/schema/bpmn">
+  <bpmn:process id="Process_rule" name="Rule" isExecutable="true">
+    <bpmn:startEvent id="StartEvent_1"/>
+    <bpmn:businessRuleTask id="Classify" name="定级" camunda:decisionRef="priority_matrix" camunda:resultVariable="classification"/>
+    <bpmn:exclusiveGateway id="Gateway_1"/>
+    <bpmn:userTask id="Major" name="重大事件处理" assignee="1"/>
+    <bpmn:userTask id="Normal" name="常规处理" assignee="1"/>
+    <bpmn:endEvent id="EndEvent_1"/>
+    <bpmn:sequenceFlow id="Flow_1" sourceRef="StartEvent_1" targetRef="Classify"/>
+    <bpmn:sequenceFlow id="Flow_2" sourceRef="Classify" targetRef="Gateway_1"/>
+    <bpmn:sequenceFlow id="Flow_3" sourceRef="Gateway_1" targetRef="Major">
+      <bpmn:conditionExpression>priority == "P1"</bpmn:conditionExpression>
+    </bpmn:sequenceFlow>
+    <bpmn:sequenceFlow id="Flow_4" sourceRef="Gateway_1" targetRef="Normal">
+      <bpmn:conditionExpression>priority != "P1"</bpmn:conditionExpression>
+    </bpmn:sequenceFlow>
+    <bpmn:sequenceFlow id="Flow_5" sourceRef="Major" targetRef="EndEvent_1"/>
+    <bpmn:sequenceFlow id="Flow_6" sourceRef="Normal" targetRef="EndEvent_1"/>
+  </bpmn:process>
+</bpmn:definitions>`
+
+func TestBusinessRuleTask_WritesDecisionOutputs(t *testing.T) {
+	engine, client, ctx := seedTimerEngine(t, "rule_route", businessRuleTaskBPMN)
+	deployService := NewBPMNDeploymentService(client)
+	deployed, err := deployService.DeployDecisionDefinition(ctx, &DeployDecisionDefinitionRequest{DMNXML: priorityMatrixDMN, TenantID: 11})
+	require.NoError(t, err)
+	require.Len(t, deployed, 1)
+	assert.Equal(t, 1, deployed[0].Version)
+	assert.Equal(t, DMNHitPolicyUnique, deployed[0].HitPolicy)
+
+	inst, err := engine.StartProcess(ctx, "rule_route", "INC-1", map[string]interface{}{"impact": "high", "urgency": "high"})
+	require.NoError(t, err)
+	openTask(t, client, ctx, inst.ID, "Major")
+	inst, err = client.ProcessInstance.Get(ctx, inst.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "P1", inst.Variables["priority"])
+	assert.EqualValues(t, 4, inst.Variables["sla_hours"])
+	assert.Equal(t, map[string]interface{}{"priority": "P1", "sla_hours": float64(4)}, inst.Variables["classification"])
+
+	inst, err = engine.StartProcess(ctx, "rule_route", "INC-2", map[string]interface{}{"impact": "low", "urgency": "high"})
+	require.NoError(t, err)
+	openTask(t, client, ctx, inst.ID, "Normal")
+}
+
+func TestBusinessRuleTask_UsesLatestOrPinnedVersion(t *testing.T) {
+	pinned := strings.Replace(businessRuleTaskBPMN, `camunda:resultVariable="classification"`,
+		`camunda:decisionRefBinding="version" camunda:decisionRefVersion="1"`, 1)
+	engine, client, ctx := seedTimerEngine(t, "rule_latest", businessRuleTaskBPMN)
+	deployExtraDefinition(t, client, "rule_pinned", pinned)
+	deployService := NewBPMNDeploymentService(client)
+	_, err := deployService.DeployDecisionDefinition(ctx, &DeployDecisionDefinitionRequest{DMNXML: priorityMatrixDMN, TenantID: 11})
+	require.NoError(t, err)
+
+	// 业务分析人员调整规则：高影响 + 中紧急度也升级为 P1，无需重新部署流程
+	v2 := strings.Replace(priorityMatrixDMN, `<inputEntry><text>"high"</text></inputEntry>
+        <inputEntry><text>"high"</text></inputEntry>`, `<inputEntry><text>"high"</text></inputEntry>
+        <inputEntry><text>"high","medium"</text></inputEntry>`, 1)
+	v2 = strings.Replace(v2, `<inputEntry><text>"medium","low"</text></inputEntry>`, `<inputEntry><text>"low"</text></inputEntry>`, 1)
+	deployed, err := deployService.DeployDecisionDefinition(ctx, &DeployDecisionDefinitionRequest{DMNXML: v2, TenantID: 11})
+	require.NoError(t, err)
+	assert.Equal(t, 2, deployed[0].Version)
+	latest, err := client.DecisionDefinition.Query().
+		Where(decisiondefinition.Key("priority_matrix"), decisiondefinition.IsLatest(true)).
+		All(ctx)
+	require.NoError(t, err)
+	require.Len(t, latest, 1)
+	assert.Equal(t, 2, latest[0].Version)
+
+	vars := map[string]interface{}{"impact": "high", "urgency": "medium"}
+	inst, err := engine.StartProcess(ctx, "rule_latest", "INC-3", vars)
+	require.NoError(t, err)
+	openTask(t, client, ctx, inst.ID, "Major")
+
+	inst, err = engine.StartProcess(ctx, "rule_pinned", "INC-4", vars)
+	require.NoError(t, err)
+	openTask(t, client, ctx, inst.ID, "Normal")
+
+	result, err := engine.EvaluateDecision(ctx, "priority_matrix", 1, vars)
+	require.NoError(t, err)
+	assert.Equal(t, "P2", result.SingleEntry()["priority"])
+	assert.Equal(t, 1, result.Version)
+}
+
+func TestBusinessRuleTask_CollectWritesLists(t *testing.T) {
+	const approversDMN = `<definitions id="d" namespace="n"><decision id="approvers" name="审批人">
+  <decisionTable hitPolicy="COLLECT">
+    <input><inputExpression><text>risk</text></inputExpression></input>
+    <output name="group" typeRef="string"/>
+    <rule><inputEntry><text>>= 1</text></inputEntry><outputEntry><text>"team_lead"</text></outputEntry></rule>
+    <rule><inputEntry><text>>= 5</text></inputEntry><outputEntry><text>"cab"</text></outputEntry></rule>
+    <rule><inputEntry><text>>= 9</text></inputEntry><outputEntry><text>"cio"</text></outputEntry></rule>
+  </decisionTable></decision></definitions>`
+	bpmnXML := strings.Replace(businessRuleTaskBPMN, `camunda:decisionRef="priority_matrix" camunda:resultVariable="classification"`,
+		`camunda:decisionRef="approvers" camunda:resultVariable="approval_rules"`, 1)
+	engine, client, ctx := seedTimerEngine(t, "rule_collect", bpmnXML)
+
+	// 与流程一起部署决策
+	_, err := NewBPMNDeploymentService(client).DeployProcessDefinition(ctx, &DeployProcessDefinitionRequest{
+		Name:     "审批人规则",
+		BPMNXML:  strings.Replace(bpmnXML, `id="Process_rule"`, `id="rule_collect_v2"`, 1),
+		DMNXML:   []string{approversDMN},
+		TenantID: 11,
+	})
+	require.NoError(t, err)
+
+	inst, err := engine.StartProcess(ctx, "rule_collect", "CHG-1", map[string]interface{}{"risk": 6, "priority": "P3"})
+	require.NoError(t, err)
+	openTask(t, client, ctx, inst.ID, "Normal")
+	inst, err = client.ProcessInstance.Get(ctx, inst.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"team_lead", "cab"}, inst.Variables["group"])
+	assert.Len(t, inst.Variables["approval_rules"], 2)
+	definition, err := client.DecisionDefinition.Query().Where(decisiondefinition.Key("approvers")).Only(ctx)
+	require.NoError(t, err)
+	assert.NotNil(t, definition.DeploymentID, "随流程部署的决策应记录部署ID")
+}
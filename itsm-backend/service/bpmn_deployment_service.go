@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"itsm-backend/ent"
+	"itsm-backend/ent/decisiondefinition"
 	"itsm-backend/ent/processdefinition"
 	"itsm-backend/ent/processdeployment"
 	"itsm-backend/ent/processinstance"
@@ -46,6 +47,13 @@ func (s *BPMNDeploymentService) DeployProcessDefinition(ctx context.Context, req
 		return nil, fmt.Errorf("无法提取流程信息")
 	}
 
+	// 随流程一起部署的DMN决策先校验，避免流程已部署而决策表非法
+	for i, dmnXML := range req.DMNXML {
+		if _, err := ParseDMNXML([]byte(dmnXML)); err != nil {
+			return nil, fmt.Errorf("第%d个DMN决策验证失败: %w", i+1, err)
+		}
+	}
+
 	// 创建部署记录
 	deployment, err := s.client.ProcessDeployment.Create().
 		SetDeploymentID(fmt.Sprintf("DEP-%s-%d", processInfo["id"].(string), time.Now().Unix())).
@@ -61,13 +69,25 @@ func (s *BPMNDeploymentService) DeployProcessDefinition(ctx context.Context, req
 	}
 
 	// 创建流程定义
-	_, err = s.createProcessDefinition(ctx, req, deployment, processInfo)
+	processDef, err := s.createProcessDefinition(ctx, req, deployment, processInfo)
 	if err != nil {
 		// 如果创建流程定义失败，删除部署记录
 		s.client.ProcessDeployment.DeleteOne(deployment).Exec(ctx)
 		return nil, fmt.Errorf("创建流程定义失败: %w", err)
 	}
 
+	// 调度定时启动事件（同时取消旧版本的启动定时器）
+	if err := ScheduleStartTimers(ctx, s.client, processDef, definitions.Processes[0]); err != nil {
+		return nil, fmt.Errorf("调度定时启动事件失败: %w", err)
+	}
+
+	// 部署随流程提交的DMN决策，记录所属部署
+	for _, dmnXML := range req.DMNXML {
+		if _, err := s.deployDecisions(ctx, req.TenantID, dmnXML, &deployment.ID); err != nil {
+			return nil, fmt.Errorf("部署DMN决策失败: %w", err)
+		}
+	}
+
 	// 更新部署记录，关联流程定义
 	// 注意：ProcessDeployment没有ProcessDefinitionID字段，需要通过其他方式关联
 	// 这里暂时跳过，或者可以通过元数据存储关联信息
@@ -321,12 +341,103 @@ func (s *BPMNDeploymentService) GetDeploymentHistory(ctx context.Context, proces
 	return deployments, nil
 }
 
+// DeployDecisionDefinition 单独部署DMN决策（不重新部署流程）。
+// 文件中每个决策按 key 生成新版本并成为最新版本，引用最新版本的业务规则任务随即使用新规则。
+func (s *BPMNDeploymentService) DeployDecisionDefinition(ctx context.Context, req *DeployDecisionDefinitionRequest) ([]*ent.DecisionDefinition, error) {
+	if req.TenantID <= 0 {
+		return nil, fmt.Errorf("缺少有效租户上下文")
+	}
+	if _, err := ParseDMNXML([]byte(req.DMNXML)); err != nil {
+		return nil, fmt.Errorf("DMN决策验证失败: %w", err)
+	}
+	return s.deployDecisions(ctx, req.TenantID, req.DMNXML, nil)
+}
+
+// deployDecisions 在一个事务内为DMN文件中的每个决策创建新版本
+func (s *BPMNDeploymentService) deployDecisions(ctx context.Context, tenantID int, dmnXML string, deploymentID *int) ([]*ent.DecisionDefinition, error) {
+	definitions, err := ParseDMNXML([]byte(dmnXML))
+	if err != nil {
+		return nil, err
+	}
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("开启事务失败: %w", err)
+	}
+	txc := tx.Client()
+	deployed := make([]*ent.DecisionDefinition, 0, len(definitions.Decisions))
+	for _, decision := range definitions.Decisions {
+		latest, err := txc.DecisionDefinition.Query().
+			Where(decisiondefinition.TenantID(tenantID), decisiondefinition.Key(decision.ID)).
+			Order(ent.Desc(decisiondefinition.FieldVersion)).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			_ = tx.Rollback()
+			return nil, fmt.Errorf("查询决策定义失败: %w", err)
+		}
+		version := 1
+		if latest != nil {
+			version = latest.Version + 1
+		}
+		if _, err := txc.DecisionDefinition.Update().
+			Where(decisiondefinition.TenantID(tenantID), decisiondefinition.Key(decision.ID), decisiondefinition.IsLatest(true)).
+			SetIsLatest(false).
+			Save(ctx); err != nil {
+			_ = tx.Rollback()
+			return nil, fmt.Errorf("更新旧版本决策状态失败: %w", err)
+		}
+		definition, err := txc.DecisionDefinition.Create().
+			SetTenantID(tenantID).
+			SetKey(decision.ID).
+			SetName(decision.Name).
+			SetVersion(version).
+			SetHitPolicy(decision.DecisionTable.HitPolicyOrDefault()).
+			SetDmnXML(dmnXML).
+			SetNillableDeploymentID(deploymentID).
+			SetIsLatest(true).
+			Save(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, fmt.Errorf("创建决策定义失败: %w", err)
+		}
+		deployed = append(deployed, definition)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("提交事务失败: %w", err)
+	}
+	return deployed, nil
+}
+
+// ListDecisionDefinitions 查询决策定义：key 为空时返回各决策的最新版本，否则返回该决策的全部版本（新版本在前）
+func (s *BPMNDeploymentService) ListDecisionDefinitions(ctx context.Context, tenantID int, key string) ([]*ent.DecisionDefinition, error) {
+	query := s.client.DecisionDefinition.Query().
+		Where(decisiondefinition.TenantID(tenantID))
+	if key != "" {
+		query = query.Where(decisiondefinition.Key(key)).
+			Order(ent.Desc(decisiondefinition.FieldVersion))
+	} else {
+		query = query.Where(decisiondefinition.IsLatest(true)).
+			Order(ent.Asc(decisiondefinition.FieldKey))
+	}
+	definitions, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询决策定义失败: %w", err)
+	}
+	return definitions, nil
+}
+
 // DeployProcessDefinitionRequest 部署流程定义请求
 type DeployProcessDefinitionRequest struct {
-	Name        string `json:"name" binding:"required"`
-	Description string `json:"description"`
-	BPMNXML     string `json:"bpmnXml" binding:"required"`
-	TenantID    int    `json:"tenantId" binding:"required"`
+	Name        string   `json:"name" binding:"required"`
+	Description string   `json:"description"`
+	BPMNXML     string   `json:"bpmnXml" binding:"required"`
+	DMNXML      []string `json:"dmnXml"` // 随流程一起部署的DMN决策文件，可选
+	TenantID    int      `json:"tenantId" binding:"required"`
+}
+
+// DeployDecisionDefinitionRequest 部署DMN决策请求
+type DeployDecisionDefinitionRequest struct {
+	DMNXML   string `json:"dmnXml" binding:"required"`
+	TenantID int    `json:"tenantId"`
 }
 
 // ListDeploymentsRequest 获取部署记录列表请求
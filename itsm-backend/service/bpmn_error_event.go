@@ -0,0 +1,349 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"itsm-backend/ent"
+	"itsm-backend/ent/processinstance"
+	"itsm-backend/service/bpmn"
+)
+
+// BPMN 错误事件与补偿：
+//
+// 服务任务处理器返回 bpmn.BPMNError、或流程到达错误结束事件时，引擎由内向外逐层查找
+// 错误码匹配的错误边界事件（指定 errorRef 的优先，未指定 errorRef 的兜底捕获任意错误）：
+// 先看抛出元素本身，再看外层嵌入式子流程，到达流程顶层后沿调用活动传递给父实例。
+//
+// 挂载了补偿边界事件的活动正常结束后登记到 _compensable_；补偿结束事件/补偿中间抛出事件
+// 按完成顺序的逆序执行这些活动经 association 关联的补偿处理服务任务。
+
+// bpmnCompensableVariable 按完成顺序记录已结束且可补偿的活动ID
+const bpmnCompensableVariable = "_compensable_"
+
+// findErrorBoundary 查找挂载在 activityID 上、能捕获错误码 code 的错误边界事件
+func (e *CustomProcessEngine) findErrorBoundary(process *BPMNProcess, activityID, code string) *BPMNBoundaryEvent {
+	var catchAll *BPMNBoundaryEvent
+	for _, boundary := range process.scopeOf(activityID).BoundaryEvents {
+		if boundary.AttachedToRef != activityID {
+			continue
+		}
+		ref, isError := eventErrorRef(boundary.ErrorRef, boundary.ErrorDefinition)
+		if !isError {
+			continue
+		}
+		if ref == "" {
+			if catchAll == nil {
+				catchAll = boundary
+			}
+			continue
+		}
+		if process.ErrorCode(ref) == code {
+			return boundary
+		}
+	}
+	return catchAll
+}
+
+// throwError 从 sourceID 抛出错误码为 code 的 BPMN 错误，返回是否被某个错误边界事件捕获
+func (e *CustomProcessEngine) throwError(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, process *BPMNProcess, sourceID, code, message string) (bool, error) {
+	e.logger.Infow("抛出BPMN错误", "instance", instance.ProcessInstanceID, "source", sourceID, "errorCode", code)
+	for elementID := sourceID; elementID != ""; {
+		if boundary := e.findErrorBoundary(process, elementID, code); boundary != nil {
+			return true, e.catchError(ctx, txc, instance, process, boundary, code, message)
+		}
+		sub := process.subProcessOf(elementID)
+		if sub == nil {
+			break
+		}
+		elementID = sub.ID
+	}
+	if instance.ParentProcessInstanceID == "" {
+		return false, nil
+	}
+	return e.propagateErrorToParent(ctx, txc, instance, code, message)
+}
+
+// throwTaskError 活动执行失败：bpmn.BPMNError 路由到错误边界事件；非 BPMN 错误或错误未被捕获时原样返回，使整个事务失败
+func (e *CustomProcessEngine) throwTaskError(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, process *BPMNProcess, elementID string, err error) error {
+	var bpmnErr *bpmn.BPMNError
+	if !errors.As(err, &bpmnErr) {
+		return err
+	}
+	handled, throwErr := e.throwError(ctx, txc, instance, process, elementID, bpmnErr.Code, bpmnErr.Message)
+	if throwErr != nil {
+		return throwErr
+	}
+	if !handled {
+		return err
+	}
+	return nil
+}
+
+// catchError 错误边界事件捕获错误：中断所挂载活动，写入错误码/错误信息变量后沿边界事件出边继续
+func (e *CustomProcessEngine) catchError(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, process *BPMNProcess, boundary *BPMNBoundaryEvent, code, message string) error {
+	// 服务任务同步抛错时活动并不处于等待态，activateBoundaryEvent 返回 false 不影响捕获
+	if _, err := e.activateBoundaryEvent(ctx, txc, instance, process, boundary); err != nil {
+		return err
+	}
+	if def := boundary.ErrorDefinition; def != nil && (def.ErrorCodeVariable != "" || def.ErrorMessageVariable != "") {
+		vars := map[string]interface{}{}
+		if def.ErrorCodeVariable != "" {
+			vars[def.ErrorCodeVariable] = code
+		}
+		if def.ErrorMessageVariable != "" {
+			vars[def.ErrorMessageVariable] = message
+		}
+		fresh, err := e.mergeVariablesInTx(ctx, txc, instance.ID, vars)
+		if err != nil {
+			return fmt.Errorf("写入错误变量失败: %w", err)
+		}
+		*instance = *fresh
+	}
+	e.markElementDone(ctx, txc, instance, boundary.ID)
+	e.recordScopeHistory(ctx, txc, instance, boundary.ID, ActivityTypeBoundaryEvent, "error.caught", code)
+	return e.executeStep(ctx, txc, instance, process, boundary.ID, instance.Variables)
+}
+
+// propagateErrorToParent 子实例内未被捕获的错误交给父实例，视为由等待中的调用活动抛出
+func (e *CustomProcessEngine) propagateErrorToParent(ctx context.Context, txc *ent.Client, child *ent.ProcessInstance, code, message string) (bool, error) {
+	activityID, _ := child.Variables[bpmnCallActivityVariable].(string)
+	parent, err := txc.ProcessInstance.Query().
+		Where(
+			processinstance.ProcessInstanceID(child.ParentProcessInstanceID),
+			processinstance.TenantID(child.TenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		return false, fmt.Errorf("获取父流程实例失败: %w", err)
+	}
+	if parent.Status != "running" || !isElementWaiting(parent, activityID) {
+		return false, nil
+	}
+	process, err := e.loadInstanceProcess(ctx, txc, parent)
+	if err != nil {
+		return false, err
+	}
+	return e.throwError(ctx, txc, parent, process, activityID, code, message)
+}
+
+// handleErrorEndEvent 到达错误结束事件：抛出错误；没有任何作用域捕获时按普通结束事件结束当前作用域
+func (e *CustomProcessEngine) handleErrorEndEvent(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, process *BPMNProcess, endEvent *BPMNEndEvent, errorRef string) error {
+	handled, err := e.throwError(ctx, txc, instance, process, endEvent.ID, process.ErrorCode(errorRef), endEvent.Name)
+	if err != nil || handled {
+		return err
+	}
+	e.logger.Warnw("错误结束事件未被捕获，按普通结束处理", "instance", instance.ProcessInstanceID, "endEvent", endEvent.ID, "errorRef", errorRef)
+	return e.completeScope(ctx, txc, instance, process, endEvent.ID)
+}
+
+// compensationHandlerOf 返回活动的补偿处理活动ID：挂载在活动上的补偿边界事件经 association 指向的活动
+func (e *CustomProcessEngine) compensationHandlerOf(process *BPMNProcess, activityID string) string {
+	scope := process.scopeOf(activityID)
+	for _, boundary := range scope.BoundaryEvents {
+		if boundary.AttachedToRef != activityID || boundary.CompensateDefinition == nil {
+			continue
+		}
+		for _, association := range scope.Associations {
+			if association.SourceRef == boundary.ID {
+				return association.TargetRef
+			}
+		}
+	}
+	return ""
+}
+
+// registerCompensable 活动正常结束时，若其定义了补偿处理则登记为可补偿
+func (e *CustomProcessEngine) registerCompensable(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, process *BPMNProcess, activityID string) {
+	if e.compensationHandlerOf(process, activityID) == "" {
+		return
+	}
+	if instance.Variables == nil {
+		instance.Variables = map[string]interface{}{}
+	}
+	compensable, _ := instance.Variables[bpmnCompensableVariable].([]interface{})
+	instance.Variables[bpmnCompensableVariable] = append(compensable, activityID)
+	if _, err := txc.ProcessInstance.UpdateOneID(instance.ID).SetVariables(instance.Variables).Save(ctx); err != nil {
+		e.logger.Warnw("registerCompensable 持久化失败", "elementID", activityID, "error", err)
+	}
+}
+
+// compensate 执行补偿：按完成顺序的逆序调用可补偿活动的补偿处理。
+// 抛出事件位于嵌入式子流程内时只补偿该子流程内的活动；activityRef 非空时只补偿指定活动。
+func (e *CustomProcessEngine) compensate(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, process *BPMNProcess, throwEventID, activityRef string) error {
+	var inScope map[string]bool
+	if sub := process.subProcessOf(throwEventID); sub != nil {
+		inScope = map[string]bool{}
+		for _, id := range scopeElementIDs(sub) {
+			inScope[id] = true
+		}
+	}
+	compensable, _ := instance.Variables[bpmnCompensableVariable].([]interface{})
+	remaining := make([]interface{}, 0, len(compensable))
+	targets := make([]string, 0, len(compensable))
+	for _, item := range compensable {
+		id, _ := item.(string)
+		if (activityRef == "" || id == activityRef) && (inScope == nil || inScope[id]) {
+			targets = append(targets, id)
+			continue
+		}
+		remaining = append(remaining, item)
+	}
+	for i := len(targets) - 1; i >= 0; i-- {
+		if err := e.runCompensationHandler(ctx, txc, instance, process, targets[i]); err != nil {
+			return err
+		}
+	}
+	instance.Variables[bpmnCompensableVariable] = remaining
+	if _, err := txc.ProcessInstance.UpdateOneID(instance.ID).SetVariables(instance.Variables).Save(ctx); err != nil {
+		return fmt.Errorf("更新可补偿活动失败: %w", err)
+	}
+	return nil
+}
+
+// runCompensationHandler 执行单个活动的补偿处理服务任务，变量 compensatedActivity 为被补偿的活动ID
+func (e *CustomProcessEngine) runCompensationHandler(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, process *BPMNProcess, activityID string) error {
+	handlerID := e.compensationHandlerOf(process, activityID)
+	task := e.findServiceTask(process, handlerID)
+	if task == nil {
+		return fmt.Errorf("活动 [%s] 的补偿处理 [%s] 必须是服务任务", activityID, handlerID)
+	}
+	variables := map[string]interface{}{"compensatedActivity": activityID}
+	if err := e.runServiceTask(ctx, instance.Variables, task, variables); err != nil {
+		return fmt.Errorf("补偿活动 [%s] 失败: %w", activityID, err)
+	}
+	e.recordScopeHistory(ctx, txc, instance, handlerID, ActivityTypeServiceTask, "compensation.executed", activityID)
+	return nil
+}
+
+// runServiceTask 通过 CallbackRegistry 执行服务任务；extra 为追加给处理器的变量。
+// 未注册的服务任务视为 NoOp，仅记录警告不阻断流程。
+func (e *CustomProcessEngine) runServiceTask(ctx context.Context, instanceVariables map[string]interface{}, task *BPMNServiceTask, extra map[string]interface{}) error {
+	_, err := e.runServiceTaskWithOutput(ctx, instanceVariables, task, extra)
+	return err
+}
+
+// runServiceTaskWithOutput 同 runServiceTask，并返回处理器的输出变量
+func (e *CustomProcessEngine) runServiceTaskWithOutput(ctx context.Context, instanceVariables map[string]interface{}, task *BPMNServiceTask, extra map[string]interface{}) (map[string]interface{}, error) {
+	if e.callbackRegistry == nil {
+		return nil, nil
+	}
+	serviceRef := serviceTaskRef(task)
+	handler := e.callbackRegistry.GetHandler(serviceRef)
+	if handler == nil {
+		// 尝试按任务类型匹配
+		handler = e.callbackRegistry.GetHandler(task.GetType())
+	}
+	if handler == nil {
+		e.logger.Warnw("未注册的 ServiceTask，跳过执行", "serviceRef", serviceRef, "elementID", task.ID)
+		return nil, nil
+	}
+	e.logger.Infow("执行 ServiceTask 回调", "serviceRef", serviceRef, "elementID", task.ID)
+	taskVariables := mergeServiceTaskVariables(instanceVariables, task)
+	for key, value := range extra {
+		taskVariables[key] = value
+	}
+	result, err := handler.Execute(ctx, nil, taskVariables)
+	if err != nil {
+		return nil, fmt.Errorf("ServiceTask %s 执行失败: %w", serviceRef, err)
+	}
+	if result == nil {
+		return nil, nil
+	}
+	return result.OutputVars, nil
+}
+
+// serviceTaskRef 解析服务任务的服务引用：implementation > class > delegateExpression > operationRef > name > id
+func serviceTaskRef(task *BPMNServiceTask) string {
+	switch {
+	case task.Implementation != "":
+		return task.Implementation
+	case task.Class != "":
+		return task.Class
+	case task.DelegateExpression != "":
+		return task.DelegateExpression
+	case task.OperationRef != "":
+		return task.OperationRef
+	case task.Name != "":
+		return task.Name
+	}
+	return task.ID
+}
+
+// findIntermediateThrowEvent 查找中间抛出事件
+func (e *CustomProcessEngine) findIntermediateThrowEvent(process *BPMNProcess, id string) *BPMNIntermediateThrowEvent {
+	for _, event := range process.scopeOf(id).IntermediateThrowEvents {
+		if event.ID == id {
+			return event
+		}
+	}
+	return nil
+}
+
+// raiseError 由事件接口在指定流程实例中抛出错误（独立事务）
+func (e *CustomProcessEngine) raiseError(ctx context.Context, tenantID int, processInstanceID, sourceID, code, message string) error {
+	return e.runInInstanceTx(ctx, tenantID, processInstanceID, func(txc *ent.Client, instance *ent.ProcessInstance, process *BPMNProcess) error {
+		handled, err := e.throwError(ctx, txc, instance, process, sourceID, code, message)
+		if err != nil {
+			return err
+		}
+		if !handled {
+			return fmt.Errorf("错误 %s 未被任何错误边界事件捕获", code)
+		}
+		return nil
+	})
+}
+
+// raiseCompensation 由事件接口在指定流程实例中触发补偿（独立事务）
+func (e *CustomProcessEngine) raiseCompensation(ctx context.Context, tenantID int, processInstanceID, throwEventID, activityRef string) error {
+	return e.runInInstanceTx(ctx, tenantID, processInstanceID, func(txc *ent.Client, instance *ent.ProcessInstance, process *BPMNProcess) error {
+		return e.compensate(ctx, txc, instance, process, throwEventID, activityRef)
+	})
+}
+
+// runInInstanceTx 在事务内加载运行中的流程实例及其流程定义并执行 fn，fn 返回错误时回滚
+func (e *CustomProcessEngine) runInInstanceTx(ctx context.Context, tenantID int, processInstanceID string, fn func(txc *ent.Client, instance *ent.ProcessInstance, process *BPMNProcess) error) error {
+	if tenantID <= 0 {
+		return fmt.Errorf("缺少有效租户上下文")
+	}
+	tx, err := e.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+	txc := tx.Client()
+
+	instance, err := txc.ProcessInstance.Query().
+		Where(
+			processinstance.ProcessInstanceID(processInstanceID),
+			processinstance.TenantID(tenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("获取流程实例失败: %w", err)
+	}
+	if instance.Status != "running" {
+		_ = tx.Rollback()
+		return fmt.Errorf("流程实例 %s 当前状态为 %s，不能触发事件", instance.ProcessInstanceID, instance.Status)
+	}
+	process, err := e.loadInstanceProcess(ctx, txc, instance)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := fn(txc, instance, process); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("提交事务失败: %w", err)
+	}
+	return nil
+}
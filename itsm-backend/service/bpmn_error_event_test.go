@@ -0,0 +1,269 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"itsm-backend/dto"
+	"itsm-backend/ent"
+	"itsm-backend/ent/processinstance"
+	"itsm-backend/ent/processtask"
+	"itsm-backend/service/bpmn"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubServiceTaskHandler 测试用服务任务处理器：记录调用顺序，可返回指定错误
+type stubServiceTaskHandler struct {
+	id    string
+	err   error
+	calls *[]string
+}
+
+func (h *stubServiceTaskHandler) GetTaskType() string  { return h.id }
+func (h *stubServiceTaskHandler) GetHandlerID() string { return h.id }
+func (h *stubServiceTaskHandler) Validate(ctx context.Context, config map[string]interface{}) error {
+	return nil
+}
+func (h *stubServiceTaskHandler) Execute(ctx context.Context, task *ent.ProcessTask, variables map[string]interface{}) (*dto.ServiceTaskResult, error) {
+	if h.calls != nil {
+		call := h.id
+		if activity, ok := variables["compensatedActivity"].(string); ok {
+			call += ":" + activity
+		}
+		*h.calls = append(*h.calls, call)
+	}
+	return nil, h.err
+}
+
+// 开通资源失败时按错误码进入人工处理
+const provisionErrorBPMN = `<?xml version="1.0" encoding="UTF-8"?>
+<bpmn:definitions xmlns:bpmn="http://www.omg.org/spec/BPMN/20100524/MODEL" id="Definitions_err" targetNamespace="http://bpmn.io/schema/bpmn">
+  <bpmn:error id="Error_Quota" name="QuotaExceeded" errorCode="QUOTA_EXCEEDED"/>
+  <bpmn:process id="Process_err" name="Provision" isExecutable="true">
+    <bpmn:startEvent id="StartEvent_1"/>
+    <bpmn:serviceTask id="Provision" name="开通资源" implementation="provision"/>
+    <bpmn:boundaryEvent id="QuotaError" attachedToRef="Provision">
+      <bpmn:errorEventDefinition errorRef="Error_Quota" errorCodeVariable="error_code" errorMessageVariable="error_message"/>
+    </bpmn:boundaryEvent>
+    <bpmn:boundaryEvent id="AnyError" attachedToRef="Provision">
+      <bpmn:errorEventDefinition/>
+    </bpmn:boundaryEvent>
+    <bpmn:userTask id="RequestQuota" name="申请配额" assignee="1"/>
+    <bpmn:userTask id="Manual" name="人工处理" assignee="1"/>
+    <bpmn:endEvent id="EndEvent_1"/>
+    <bpmn:endEvent id="EndEvent_2"/>
+    <bpmn:endEvent id="EndEvent_3"/>
+    <bpmn:sequenceFlow id="Flow_1" sourceRef="StartEvent_1" targetRef="Provision"/>
+    <bpmn:sequenceFlow id="Flow_2" sourceRef="Provision" targetRef="EndEvent_1"/>
+    <bpmn:sequenceFlow id="Flow_3" sourceRef="QuotaError" targetRef="RequestQuota"/>
+    <bpmn:sequenceFlow id="Flow_4" sourceRef="RequestQuota" targetRef="EndEvent_2"/>
+    <bpmn:sequenceFlow id="Flow_5" sourceRef="AnyError" targetRef="Manual"/>
+    <bpmn:sequenceFlow id="Flow_6" sourceRef="Manual" targetRef="EndEvent_3"/>
+  </bpmn:process>
+</bpmn:definitions>`
+
+// 子流程内的错误结束事件由子流程上的错误边界事件捕获
+const subProcessErrorEndBPMN = `<?xml version="1.0" encoding="UTF-8"?>
+<bpmn:definitions xmlns:bpmn="http://www.omg.org/spec/BPMN/20100524/MODEL" id="Definitions_suberr" targetNamespace="http://bpmn.io/schema/bpmn">
+  <bpmn:error id="Error_Rejected" errorCode="REJECTED"/>
+  <bpmn:process id="Process_suberr" name="Review" isExecutable="true">
+    <bpmn:startEvent id="StartEvent_1"/>
+    <bpmn:subProcess id="Review">
+      <bpmn:startEvent id="Review_Start"/>
+      <bpmn:userTask id="Review_Tech" name="Review_Tech" assignee="1"/>
+      <bpmn:exclusiveGateway id="Review_Gateway"/>
+      <bpmn:endEvent id="Review_End"/>
+      <bpmn:endEvent id="Review_Rejected">
+        <bpmn:errorEventDefinition errorRef="Error_Rejected"/>
+      </bpmn:endEvent>
+      <bpmn:sequenceFlow id="Review_Flow_1" sourceRef="Review_Start" targetRef="Review_Tech"/>
+      <bpmn:sequenceFlow id="Review_Flow_2" sourceRef="Review_Tech" targetRef="Review_Gateway"/>
+      <bpmn:sequenceFlow id="Review_Flow_3" sourceRef="Review_Gateway" targetRef="Review_End">
+        <bpmn:conditionExpression>approved == true</bpmn:conditionExpression>
+      </bpmn:sequenceFlow>
+      <bpmn:sequenceFlow id="Review_Flow_4" sourceRef="Review_Gateway" targetRef="Review_Rejected">
+        <bpmn:conditionExpression>approved == false</bpmn:conditionExpression>
+      </bpmn:sequenceFlow>
+    </bpmn:subProcess>
+    <bpmn:boundaryEvent id="Rejected" attachedToRef="Review">
+      <bpmn:errorEventDefinition errorRef="Error_Rejected"/>
+    </bpmn:boundaryEvent>
+    <bpmn:userTask id="Implement" name="Implement" assignee="1"/>
+    <bpmn:userTask id="Rework" name="Rework" assignee="1"/>
+    <bpmn:endEvent id="EndEvent_1"/>
+    <bpmn:endEvent id="EndEvent_2"/>
+    <bpmn:sequenceFlow id="Flow_1" sourceRef="StartEvent_1" targetRef="Review"/>
+    <bpmn:sequenceFlow id="Flow_2" sourceRef="Review" targetRef="Implement"/>
+    <bpmn:sequenceFlow id="Flow_3" sourceRef="Implement" targetRef="EndEvent_1"/>
+    <bpmn:sequenceFlow id="Flow_4" sourceRef="Rejected" targetRef="Rework"/>
+    <bpmn:sequenceFlow id="Flow_5" sourceRef="Rework" targetRef="EndEvent_2"/>
+  </bpmn:process>
+</bpmn:definitions>`
+
+// 父流程调用活动上的错误边界事件捕获子流程抛出的错误
+const callerCatchesChildErrorBPMN = `<?xml version="1.0" encoding="UTF-8"?>
+<bpmn:definitions xmlns:bpmn="http://www.omg.org/spec/BPMN/20100524/MODEL" id="Definitions_caller" targetNamespace="http://bpmn.io/schema/bpmn">
+  <bpmn:process id="Process_caller" name="Caller" isExecutable="true">
+    <bpmn:startEvent id="StartEvent_1"/>
+    <bpmn:callActivity id="CAB" calledElement="cab_reject"/>
+    <bpmn:boundaryEvent id="CABRejected" attachedToRef="CAB">
+      <bpmn:errorEventDefinition/>
+    </bpmn:boundaryEvent>
+    <bpmn:userTask id="Implement" name="Implement" assignee="1"/>
+    <bpmn:userTask id="Rework" name="Rework" assignee="1"/>
+    <bpmn:endEvent id="EndEvent_1"/>
+    <bpmn:endEvent id="EndEvent_2"/>
+    <bpmn:sequenceFlow id="Flow_1" sourceRef="StartEvent_1" targetRef="CAB"/>
+    <bpmn:sequenceFlow id="Flow_2" sourceRef="CAB" targetRef="Implement"/>
+    <bpmn:sequenceFlow id="Flow_3" sourceRef="Implement" targetRef="EndEvent_1"/>
+    <bpmn:sequenceFlow id="Flow_4" sourceRef="CABRejected" targetRef="Rework"/>
+    <bpmn:sequenceFlow id="Flow_5" sourceRef="Rework" targetRef="EndEvent_2"/>
+  </bpmn:process>
+</bpmn:definitions>`
+
+const cabRejectBPMN = `<?xml version="1.0" encoding="UTF-8"?>
+<bpmn:definitions xmlns:bpmn="http://www.omg.org/spec/BPMN/20100524/MODEL" id="Definitions_cabrej" targetNamespace="http://bpmn.io/schema/bpmn">
+  <bpmn:error id="Error_CAB" errorCode="CAB_REJECTED"/>
+  <bpmn:process id="Process_cabrej" name="CAB" isExecutable="true">
+    <bpmn:startEvent id="StartEvent_1"/>
+    <bpmn:userTask id="CAB_Review" name="CAB_Review" assignee="1"/>
+    <bpmn:endEvent id="EndEvent_1">
+      <bpmn:errorEventDefinition errorRef="Error_CAB"/>
+    </bpmn:endEvent>
+    <bpmn:sequenceFlow id="Flow_1" sourceRef="StartEvent_1" targetRef="CAB_Review"/>
+    <bpmn:sequenceFlow id="Flow_2" sourceRef="CAB_Review" targetRef="EndEvent_1"/>
+  </bpmn:process>
+</bpmn:definitions>`
+
+// 云资源开通：创建 VPC、创建 ECS 后校验失败，补偿结束事件逆序回滚
+const provisionCompensationBPMN = `<?xml version="1.0" encoding="UTF-8"?>
+<bpmn:definitions xmlns:bpmn="http://www.omg.org/spec/BPMN/20100524/MODEL" id="Definitions_comp" targetNamespace="http://bpmn.io/schema/bpmn">
+  <bpmn:process id="Process_comp" name="Provision" isExecutable="true">
+    <bpmn:startEvent id="StartEvent_1"/>
+    <bpmn:serviceTask id="CreateVPC" implementation="create_vpc"/>
+    <bpmn:boundaryEvent id="CompensateVPC" attachedToRef="CreateVPC">
+      <bpmn:compensateEventDefinition/>
+    </bpmn:boundaryEvent>
+    <bpmn:serviceTask id="DeleteVPC" implementation="delete_vpc" isForCompensation="true"/>
+    <bpmn:serviceTask id="CreateECS" implementation="create_ecs"/>
+    <bpmn:boundaryEvent id="CompensateECS" attachedToRef="CreateECS">
+      <bpmn:compensateEventDefinition/>
+    </bpmn:boundaryEvent>
+    <bpmn:serviceTask id="DeleteECS" implementation="delete_ecs" isForCompensation="true"/>
+    <bpmn:userTask id="Verify" name="Verify" assignee="1"/>
+    <bpmn:exclusiveGateway id="Gateway_1"/>
+    <bpmn:endEvent id="EndEvent_1"/>
+    <bpmn:endEvent id="Rollback">
+      <bpmn:compensateEventDefinition/>
+    </bpmn:endEvent>
+    <bpmn:sequenceFlow id="Flow_1" sourceRef="StartEvent_1" targetRef="CreateVPC"/>
+    <bpmn:sequenceFlow id="Flow_2" sourceRef="CreateVPC" targetRef="CreateECS"/>
+    <bpmn:sequenceFlow id="Flow_3" sourceRef="CreateECS" targetRef="Verify"/>
+    <bpmn:sequenceFlow id="Flow_4" sourceRef="Verify" targetRef="Gateway_1"/>
+    <bpmn:sequenceFlow id="Flow_5" sourceRef="Gateway_1" targetRef="EndEvent_1">
+      <bpmn:conditionExpression>verified == true</bpmn:conditionExpression>
+    </bpmn:sequenceFlow>
+    <bpmn:sequenceFlow id="Flow_6" sourceRef="Gateway_1" targetRef="Rollback">
+      <bpmn:conditionExpression>verified == false</bpmn:conditionExpression>
+    </bpmn:sequenceFlow>
+    <bpmn:association id="Association_1" sourceRef="CompensateVPC" targetRef="DeleteVPC"/>
+    <bpmn:association id="Association_2" sourceRef="CompensateECS" targetRef="DeleteECS"/>
+  </bpmn:process>
+</bpmn:definitions>`
+
+func TestServiceTaskBPMNError_RoutesToMatchingBoundary(t *testing.T) {
+	engine, client, ctx := seedTimerEngine(t, "provision_err", provisionErrorBPMN)
+	engine.callbackRegistry.RegisterHandler(&stubServiceTaskHandler{
+		id:  "provision",
+		err: bpmn.NewBPMNError("QUOTA_EXCEEDED", "配额不足"),
+	})
+
+	inst, err := engine.StartProcess(ctx, "provision_err", "REQ-1", map[string]interface{}{})
+	require.NoError(t, err)
+	inst, err = client.ProcessInstance.Get(ctx, inst.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "running", inst.Status)
+	assert.Equal(t, "QUOTA_EXCEEDED", inst.Variables["error_code"])
+	assert.Equal(t, "配额不足", inst.Variables["error_message"])
+	openTask(t, client, ctx, inst.ID, "RequestQuota")
+
+	// 未声明的错误码由未指定 errorRef 的边界事件兜底
+	engine.callbackRegistry.RegisterHandler(&stubServiceTaskHandler{id: "provision", err: bpmn.NewBPMNError("TIMEOUT", "")})
+	inst, err = engine.StartProcess(ctx, "provision_err", "REQ-2", map[string]interface{}{})
+	require.NoError(t, err)
+	openTask(t, client, ctx, inst.ID, "Manual")
+}
+
+func TestServiceTaskPlainError_NotCaught(t *testing.T) {
+	engine, client, ctx := seedTimerEngine(t, "provision_fail", provisionErrorBPMN)
+	engine.callbackRegistry.RegisterHandler(&stubServiceTaskHandler{id: "provision", err: assert.AnError})
+
+	_, err := engine.StartProcess(ctx, "provision_fail", "REQ-3", map[string]interface{}{})
+	require.ErrorIs(t, err, assert.AnError, "非 BPMNError 不应被错误边界事件捕获")
+	manual, err := client.ProcessTask.Query().Where(processtask.TaskDefinitionKey("Manual")).Exist(ctx)
+	require.NoError(t, err)
+	assert.False(t, manual)
+}
+
+func TestErrorEndEvent_CaughtBySubProcessBoundary(t *testing.T) {
+	engine, client, ctx := seedTimerEngine(t, "sub_error", subProcessErrorEndBPMN)
+
+	inst, err := engine.StartProcess(ctx, "sub_error", "CHG-1", map[string]interface{}{})
+	require.NoError(t, err)
+	tech := openTask(t, client, ctx, inst.ID, "Review_Tech")
+	require.NoError(t, engine.CompleteTask(ctx, tech.TaskID, map[string]interface{}{"approved": false}))
+
+	inst, err = client.ProcessInstance.Get(ctx, inst.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "running", inst.Status)
+	assert.False(t, isElementWaiting(inst, "Review"))
+	openTask(t, client, ctx, inst.ID, "Rework")
+	implement, err := client.ProcessTask.Query().
+		Where(processtask.ProcessInstanceID(inst.ID), processtask.TaskDefinitionKey("Implement")).Exist(ctx)
+	require.NoError(t, err)
+	assert.False(t, implement, "子流程被错误中断，不应沿正常出边继续")
+}
+
+func TestErrorEndEvent_PropagatesToCallActivityBoundary(t *testing.T) {
+	engine, client, ctx := seedTimerEngine(t, "caller_error", callerCatchesChildErrorBPMN)
+	deployExtraDefinition(t, client, "cab_reject", cabRejectBPMN)
+
+	parent, err := engine.StartProcess(ctx, "caller_error", "CHG-2", map[string]interface{}{})
+	require.NoError(t, err)
+	child, err := client.ProcessInstance.Query().Where(processinstance.ParentProcessInstanceID(parent.ProcessInstanceID)).Only(ctx)
+	require.NoError(t, err)
+	review := openTask(t, client, ctx, child.ID, "CAB_Review")
+	require.NoError(t, engine.CompleteTask(ctx, review.TaskID, map[string]interface{}{}))
+
+	child, err = client.ProcessInstance.Get(ctx, child.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "terminated", child.Status)
+	parent, err = client.ProcessInstance.Get(ctx, parent.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "running", parent.Status)
+	assert.False(t, isElementWaiting(parent, "CAB"))
+	openTask(t, client, ctx, parent.ID, "Rework")
+}
+
+func TestCompensateEndEvent_RunsHandlersInReverseOrder(t *testing.T) {
+	engine, client, ctx := seedTimerEngine(t, "provision_comp", provisionCompensationBPMN)
+	var calls []string
+	for _, id := range []string{"create_vpc", "create_ecs", "delete_vpc", "delete_ecs"} {
+		engine.callbackRegistry.RegisterHandler(&stubServiceTaskHandler{id: id, calls: &calls})
+	}
+
+	inst, err := engine.StartProcess(ctx, "provision_comp", "REQ-4", map[string]interface{}{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"create_vpc", "create_ecs"}, calls)
+
+	verify := openTask(t, client, ctx, inst.ID, "Verify")
+	require.NoError(t, engine.CompleteTask(ctx, verify.TaskID, map[string]interface{}{"verified": false}))
+	assert.Equal(t, []string{"create_vpc", "create_ecs", "delete_ecs:CreateECS", "delete_vpc:CreateVPC"}, calls)
+
+	inst, err = client.ProcessInstance.Get(ctx, inst.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "completed", inst.Status)
+	assert.Empty(t, inst.Variables[bpmnCompensableVariable])
+}
@@ -0,0 +1,639 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"itsm-backend/ent"
+	"itsm-backend/ent/processeventinstance"
+	"itsm-backend/ent/processeventsubscription"
+	"itsm-backend/ent/processinstance"
+	"itsm-backend/ent/processtask"
+)
+
+const (
+	bpmnSubscriptionWaiting    = "waiting"
+	bpmnSubscriptionCorrelated = "correlated"
+	bpmnSubscriptionCancelled  = "cancelled"
+
+	bpmnEventMessage = "message"
+	bpmnEventSignal  = "signal"
+)
+
+var (
+	// ErrMessageNotCorrelated 没有流程实例在等待该消息（或关联键不匹配）
+	ErrMessageNotCorrelated = errors.New("no process instance is waiting for the message")
+	// ErrMessageAmbiguous 关联键命中了多个等待中的流程实例
+	ErrMessageAmbiguous = errors.New("message correlates to more than one process instance")
+)
+
+// MessageCorrelationResult 消息关联结果
+type MessageCorrelationResult struct {
+	EventInstanceID   string `json:"eventInstanceId"`
+	ProcessInstanceID string `json:"processInstanceId"`
+	ElementID         string `json:"elementId"`
+}
+
+// SignalBroadcastResult 信号广播结果
+type SignalBroadcastResult struct {
+	SignalName         string   `json:"signalName"`
+	EventInstanceIDs   []string `json:"eventInstanceIds"`
+	ProcessInstanceIDs []string `json:"processInstanceIds"`
+	FailedInstanceIDs  []string `json:"failedInstanceIds,omitempty"`
+}
+
+// eventInstanceRecord 待写入 process_event_instances 的事件触发记录
+type eventInstanceRecord struct {
+	ID                string
+	TenantID          int
+	DefinitionID      string
+	EventType         string
+	Trigger           string
+	EventName         string
+	ProcessInstanceID string
+	SubscriptionID    *int
+	CorrelationKeys   map[string]interface{}
+	Variables         map[string]interface{}
+	Status            string
+	Error             string
+}
+
+// saveProcessEventInstance 按 event_instance_id 写入或更新事件实例记录；ID 为空时自动生成
+func saveProcessEventInstance(ctx context.Context, client *ent.Client, rec *eventInstanceRecord) (*ent.ProcessEventInstance, error) {
+	now := time.Now()
+	if rec.ID == "" {
+		rec.ID = fmt.Sprintf("EI-%d", now.UnixNano())
+	}
+	existing, err := client.ProcessEventInstance.Query().
+		Where(processeventinstance.EventInstanceID(rec.ID), processeventinstance.TenantID(rec.TenantID)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("查询事件实例失败: %w", err)
+	}
+	if existing == nil {
+		create := client.ProcessEventInstance.Create().
+			SetEventInstanceID(rec.ID).
+			SetTenantID(rec.TenantID).
+			SetEventDefinitionID(rec.DefinitionID).
+			SetEventType(rec.EventType).
+			SetTrigger(rec.Trigger).
+			SetEventName(rec.EventName).
+			SetProcessInstanceID(rec.ProcessInstanceID).
+			SetNillableSubscriptionID(rec.SubscriptionID).
+			SetCorrelationKeys(rec.CorrelationKeys).
+			SetVariables(rec.Variables).
+			SetStatus(rec.Status).
+			SetError(rec.Error)
+		if rec.Status != "pending" {
+			create.SetTriggeredAt(now)
+		}
+		if rec.Status == "completed" || rec.Status == "failed" {
+			create.SetCompletedAt(now)
+		}
+		return create.Save(ctx)
+	}
+	update := existing.Update().
+		SetStatus(rec.Status).
+		SetError(rec.Error)
+	if rec.EventName != "" {
+		update.SetEventName(rec.EventName)
+	}
+	if rec.ProcessInstanceID != "" {
+		update.SetProcessInstanceID(rec.ProcessInstanceID)
+	}
+	if rec.SubscriptionID != nil {
+		update.SetSubscriptionID(*rec.SubscriptionID)
+	}
+	if rec.CorrelationKeys != nil {
+		update.SetCorrelationKeys(rec.CorrelationKeys)
+	}
+	if existing.TriggeredAt == nil && rec.Status != "pending" {
+		update.SetTriggeredAt(now)
+	}
+	if rec.Status == "completed" || rec.Status == "failed" {
+		update.SetCompletedAt(now)
+	}
+	return update.Save(ctx)
+}
+
+// catchEventTrigger 返回捕获事件的订阅类型与名称；非消息/信号事件返回空类型
+func catchEventTrigger(process *BPMNProcess, messageRef string, messageDef *BPMNMessageEventDefinition, signalRef string, signalDef *BPMNSignalEventDefinition) (string, string) {
+	if ref := eventMessageRef(messageRef, messageDef); ref != "" || messageDef != nil {
+		return bpmnEventMessage, process.MessageName(ref)
+	}
+	if ref := eventSignalRef(signalRef, signalDef); ref != "" || signalDef != nil {
+		return bpmnEventSignal, process.SignalName(ref)
+	}
+	return "", ""
+}
+
+// intermediateEventTrigger 中间捕获事件的消息/信号订阅类型与名称（event 可为 nil）
+func intermediateEventTrigger(process *BPMNProcess, event *BPMNIntermediateEvent) (string, string) {
+	if event == nil {
+		return "", ""
+	}
+	return catchEventTrigger(process, event.MessageRef, event.MessageDefinition, event.SignalRef, event.SignalDefinition)
+}
+
+// boundaryEventTrigger 边界事件的消息/信号订阅类型与名称
+func boundaryEventTrigger(process *BPMNProcess, boundary *BPMNBoundaryEvent) (string, string) {
+	return catchEventTrigger(process, boundary.MessageRef, boundary.MessageDefinition, boundary.SignalRef, boundary.SignalDefinition)
+}
+
+// subscribeEvent 为实例在某元素上登记消息/信号订阅；同一元素已有等待中的订阅时不重复登记
+func (e *CustomProcessEngine) subscribeEvent(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, elementID, attachedTo string, interrupting bool, eventType, eventName string) error {
+	if eventName == "" {
+		return fmt.Errorf("事件 [%s] 未声明%s名称", elementID, eventType)
+	}
+	exists, err := txc.ProcessEventSubscription.Query().
+		Where(
+			processeventsubscription.TenantID(instance.TenantID),
+			processeventsubscription.ProcessInstanceID(instance.ID),
+			processeventsubscription.ElementID(elementID),
+			processeventsubscription.StatusEQ(bpmnSubscriptionWaiting),
+		).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("查询事件订阅失败: %w", err)
+	}
+	if exists {
+		return nil
+	}
+	_, err = txc.ProcessEventSubscription.Create().
+		SetTenantID(instance.TenantID).
+		SetProcessInstanceID(instance.ID).
+		SetProcessDefinitionKey(instance.ProcessDefinitionKey).
+		SetBusinessKey(instance.BusinessKey).
+		SetElementID(elementID).
+		SetAttachedTo(attachedTo).
+		SetInterrupting(interrupting).
+		SetEventType(eventType).
+		SetEventName(eventName).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("登记事件订阅 %s 失败: %w", elementID, err)
+	}
+	e.logger.Infow("BPMN 事件订阅已登记", "instance", instance.ID, "element", elementID, "type", eventType, "name", eventName)
+	return nil
+}
+
+// enterCatchEvent 进入消息/信号捕获节点（中间捕获事件、接收任务）：登记订阅并在此等待
+func (e *CustomProcessEngine) enterCatchEvent(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, elementID, eventType, eventName string) error {
+	if err := e.markElementWaiting(ctx, txc, instance, elementID, true); err != nil {
+		return err
+	}
+	return e.subscribeEvent(ctx, txc, instance, elementID, "", true, eventType, eventName)
+}
+
+// enterActivityBoundaries 活动进入等待态时激活其上挂载的边界事件（定时器与消息/信号订阅）
+func (e *CustomProcessEngine) enterActivityBoundaries(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, process *BPMNProcess, activityID string) error {
+	if err := e.scheduleBoundaryTimers(ctx, txc, instance, process, activityID); err != nil {
+		return err
+	}
+	for _, boundary := range process.scopeOf(activityID).BoundaryEvents {
+		if boundary.AttachedToRef != activityID {
+			continue
+		}
+		eventType, eventName := boundaryEventTrigger(process, boundary)
+		if eventType == "" {
+			continue
+		}
+		if err := e.subscribeEvent(ctx, txc, instance, boundary.ID, activityID, boundary.IsInterrupting(), eventType, eventName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cancelEventSubscriptions 取消实例上指定元素（为空则全部）的等待中订阅
+func (e *CustomProcessEngine) cancelEventSubscriptions(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, elementIDs ...string) error {
+	query := txc.ProcessEventSubscription.Update().
+		Where(
+			processeventsubscription.TenantID(instance.TenantID),
+			processeventsubscription.ProcessInstanceID(instance.ID),
+			processeventsubscription.StatusEQ(bpmnSubscriptionWaiting),
+		)
+	if len(elementIDs) > 0 {
+		query = query.Where(processeventsubscription.ElementIDIn(elementIDs...))
+	}
+	if _, err := query.SetStatus(bpmnSubscriptionCancelled).Save(ctx); err != nil {
+		return fmt.Errorf("取消事件订阅失败: %w", err)
+	}
+	return nil
+}
+
+// cancelBoundaryEvents 活动结束（正常完成或被中断）时撤销其上挂载的全部边界事件
+func (e *CustomProcessEngine) cancelBoundaryEvents(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, process *BPMNProcess, activityID string) error {
+	var ids []string
+	for _, boundary := range process.scopeOf(activityID).BoundaryEvents {
+		if boundary.AttachedToRef == activityID {
+			ids = append(ids, boundary.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	if err := e.cancelInstanceTimers(ctx, txc, instance, ids...); err != nil {
+		return err
+	}
+	return e.cancelEventSubscriptions(ctx, txc, instance, ids...)
+}
+
+// activateBoundaryEvent 边界事件触发时处理所挂载活动：
+// 活动已结束返回 false；中断型边界事件取消活动的未结束任务、订阅与其余边界事件。
+func (e *CustomProcessEngine) activateBoundaryEvent(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, process *BPMNProcess, boundary *BPMNBoundaryEvent) (bool, error) {
+	activityKeys := []string{boundary.AttachedToRef, boundary.AttachedToRef + "_counter"}
+	active, err := txc.ProcessTask.Query().
+		Where(
+			processtask.ProcessInstanceID(instance.ID),
+			processtask.TaskDefinitionKeyIn(activityKeys...),
+			processtask.StatusNotIn("completed", "cancelled"),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("查询挂载活动状态失败: %w", err)
+	}
+	if !active && !isElementWaiting(instance, boundary.AttachedToRef) {
+		return false, nil
+	}
+	if !boundary.IsInterrupting() {
+		return true, nil
+	}
+	if _, err := txc.ProcessTask.Update().
+		Where(
+			processtask.ProcessInstanceID(instance.ID),
+			processtask.TaskDefinitionKeyIn(activityKeys...),
+			processtask.StatusNotIn("completed", "cancelled"),
+		).
+		SetStatus("cancelled").
+		SetCompletedTime(time.Now()).
+		Save(ctx); err != nil {
+		return false, fmt.Errorf("中断挂载活动失败: %w", err)
+	}
+	// 调用活动/子流程：一并撤销其内部执行
+	if err := e.interruptActivity(ctx, txc, instance, process, boundary.AttachedToRef); err != nil {
+		return false, err
+	}
+	if err := e.cancelBoundaryEvents(ctx, txc, instance, process, boundary.AttachedToRef); err != nil {
+		return false, err
+	}
+	// 接收任务等活动自身的订阅同样失效
+	if err := e.cancelEventSubscriptions(ctx, txc, instance, boundary.AttachedToRef); err != nil {
+		return false, err
+	}
+	// 事件网关后的接收任务被中断：其余竞争事件同样失效
+	if err := e.settleEventGateway(ctx, txc, instance, process, boundary.AttachedToRef); err != nil {
+		return false, err
+	}
+	if err := e.markElementWaiting(ctx, txc, instance, boundary.AttachedToRef, false); err != nil {
+		return false, err
+	}
+	if err := e.clearMultiInstanceState(ctx, txc, instance, boundary.AttachedToRef); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// subscriptionMatch 命中关联条件的订阅及其流程实例
+type subscriptionMatch struct {
+	subscription *ent.ProcessEventSubscription
+	instance     *ent.ProcessInstance
+}
+
+// findSubscriptions 查询租户内等待指定消息/信号的订阅，并按关联键过滤
+func (e *CustomProcessEngine) findSubscriptions(ctx context.Context, tenantID int, eventType, eventName string, correlationKeys map[string]interface{}) ([]subscriptionMatch, error) {
+	subscriptions, err := e.client.ProcessEventSubscription.Query().
+		Where(
+			processeventsubscription.TenantID(tenantID),
+			processeventsubscription.EventType(eventType),
+			processeventsubscription.EventName(eventName),
+			processeventsubscription.StatusEQ(bpmnSubscriptionWaiting),
+		).
+		Order(ent.Asc(processeventsubscription.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询事件订阅失败: %w", err)
+	}
+	if len(subscriptions) == 0 {
+		return nil, nil
+	}
+	instanceIDs := make([]int, 0, len(subscriptions))
+	for _, sub := range subscriptions {
+		instanceIDs = append(instanceIDs, sub.ProcessInstanceID)
+	}
+	instances, err := e.client.ProcessInstance.Query().
+		Where(processinstance.IDIn(instanceIDs...), processinstance.TenantID(tenantID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询流程实例失败: %w", err)
+	}
+	byID := make(map[int]*ent.ProcessInstance, len(instances))
+	for _, instance := range instances {
+		byID[instance.ID] = instance
+	}
+	var matches []subscriptionMatch
+	for _, sub := range subscriptions {
+		instance := byID[sub.ProcessInstanceID]
+		if instance == nil || !matchCorrelationKeys(instance, correlationKeys) {
+			continue
+		}
+		matches = append(matches, subscriptionMatch{subscription: sub, instance: instance})
+	}
+	return matches, nil
+}
+
+// matchCorrelationKeys 关联键匹配：business_key / process_instance_id 对应实例字段，
+// 其余键与同名流程变量做等值比较（按字符串形式，兼容 JSON 数字反序列化为 float64）。
+func matchCorrelationKeys(instance *ent.ProcessInstance, correlationKeys map[string]interface{}) bool {
+	for key, expected := range correlationKeys {
+		want := fmt.Sprint(expected)
+		switch key {
+		case "business_key", "businessKey":
+			if instance.BusinessKey != want {
+				return false
+			}
+		case "process_instance_id", "processInstanceId":
+			if instance.ProcessInstanceID != want {
+				return false
+			}
+		default:
+			actual, ok := instance.Variables[key]
+			if !ok || fmt.Sprint(actual) != want {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// CorrelateMessage 将消息关联到唯一一个正在等待它的流程实例（中间消息捕获事件、接收任务或消息边界事件），
+// 合并变量后推进流程。未命中返回 ErrMessageNotCorrelated，命中多个返回 ErrMessageAmbiguous。
+func (e *CustomProcessEngine) CorrelateMessage(ctx context.Context, messageName string, correlationKeys map[string]interface{}, variables map[string]interface{}) (*MessageCorrelationResult, error) {
+	tenantID, err := requireBPMNTenantContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return e.correlateMessage(ctx, tenantID, "", messageName, correlationKeys, variables)
+}
+
+// correlateMessage 同 CorrelateMessage；eventInstanceID 非空时复用调用方已创建的事件实例记录
+func (e *CustomProcessEngine) correlateMessage(ctx context.Context, tenantID int, eventInstanceID, messageName string, correlationKeys map[string]interface{}, variables map[string]interface{}) (*MessageCorrelationResult, error) {
+	if messageName == "" {
+		return nil, fmt.Errorf("消息名称不能为空")
+	}
+	rec := &eventInstanceRecord{
+		ID:              eventInstanceID,
+		TenantID:        tenantID,
+		DefinitionID:    messageName,
+		EventType:       string(EventIntermediate),
+		Trigger:         bpmnEventMessage,
+		EventName:       messageName,
+		CorrelationKeys: correlationKeys,
+		Variables:       variables,
+	}
+	matches, err := e.findSubscriptions(ctx, tenantID, bpmnEventMessage, messageName, correlationKeys)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case len(matches) == 0:
+		err = fmt.Errorf("%w: %s", ErrMessageNotCorrelated, messageName)
+	case len(matches) > 1:
+		err = fmt.Errorf("%w: %s 命中 %d 个流程实例", ErrMessageAmbiguous, messageName, len(matches))
+	default:
+		err = e.deliverEvent(ctx, matches[0], variables, rec)
+	}
+	if err != nil {
+		e.failEventInstance(ctx, rec, err)
+		return nil, err
+	}
+	return &MessageCorrelationResult{
+		EventInstanceID:   rec.ID,
+		ProcessInstanceID: matches[0].instance.ProcessInstanceID,
+		ElementID:         matches[0].subscription.ElementID,
+	}, nil
+}
+
+// BroadcastSignal 向租户内所有订阅该信号的流程实例投递信号。
+// 每个实例在独立事务中推进，单个实例失败不影响其他实例，失败实例列于 FailedInstanceIDs。
+func (e *CustomProcessEngine) BroadcastSignal(ctx context.Context, signalName string, variables map[string]interface{}) (*SignalBroadcastResult, error) {
+	tenantID, err := requireBPMNTenantContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return e.broadcastSignal(ctx, tenantID, "", signalName, variables)
+}
+
+// broadcastSignal 同 BroadcastSignal；eventInstanceID 非空时首个投递复用调用方已创建的事件实例记录
+func (e *CustomProcessEngine) broadcastSignal(ctx context.Context, tenantID int, eventInstanceID, signalName string, variables map[string]interface{}) (*SignalBroadcastResult, error) {
+	if signalName == "" {
+		return nil, fmt.Errorf("信号名称不能为空")
+	}
+	matches, err := e.findSubscriptions(ctx, tenantID, bpmnEventSignal, signalName, nil)
+	if err != nil {
+		return nil, err
+	}
+	result := &SignalBroadcastResult{SignalName: signalName, EventInstanceIDs: []string{}, ProcessInstanceIDs: []string{}}
+	newRecord := func() *eventInstanceRecord {
+		rec := &eventInstanceRecord{
+			ID:           eventInstanceID,
+			TenantID:     tenantID,
+			DefinitionID: signalName,
+			EventType:    string(EventIntermediate),
+			Trigger:      bpmnEventSignal,
+			EventName:    signalName,
+			Variables:    variables,
+		}
+		eventInstanceID = ""
+		return rec
+	}
+	if len(matches) == 0 {
+		// 无订阅者也留下广播记录，便于排查「信号发出但流程没动」
+		rec := newRecord()
+		rec.Status = "completed"
+		if _, err := saveProcessEventInstance(ctx, e.client, rec); err != nil {
+			return nil, err
+		}
+		result.EventInstanceIDs = append(result.EventInstanceIDs, rec.ID)
+		return result, nil
+	}
+	for _, match := range matches {
+		rec := newRecord()
+		if err := e.deliverEvent(ctx, match, variables, rec); err != nil {
+			e.logger.Warnw("信号投递失败", "signal", signalName, "instance", match.instance.ProcessInstanceID, "error", err)
+			e.failEventInstance(ctx, rec, err)
+			result.FailedInstanceIDs = append(result.FailedInstanceIDs, match.instance.ProcessInstanceID)
+			continue
+		}
+		result.EventInstanceIDs = append(result.EventInstanceIDs, rec.ID)
+		result.ProcessInstanceIDs = append(result.ProcessInstanceIDs, match.instance.ProcessInstanceID)
+	}
+	return result, nil
+}
+
+// failEventInstance 记录失败的事件实例；记录本身失败仅告警
+func (e *CustomProcessEngine) failEventInstance(ctx context.Context, rec *eventInstanceRecord, cause error) {
+	rec.Status = "failed"
+	rec.Error = cause.Error()
+	if _, err := saveProcessEventInstance(ctx, e.client, rec); err != nil {
+		e.logger.Warnw("保存事件实例失败", "eventInstance", rec.ID, "error", err)
+	}
+}
+
+// deliverEvent 在单个事务内把事件投递给一个订阅：认领订阅 → 合并变量 → 推进流程 → 写事件实例。
+// 中断型订阅以 waiting→correlated 条件更新认领，并发投递时只有一个成功。
+func (e *CustomProcessEngine) deliverEvent(ctx context.Context, match subscriptionMatch, variables map[string]interface{}, rec *eventInstanceRecord) error {
+	sub := match.subscription
+	rec.ProcessInstanceID = match.instance.ProcessInstanceID
+	rec.SubscriptionID = &sub.ID
+	if sub.AttachedTo != "" {
+		rec.EventType = string(EventBoundary)
+	}
+	if rec.DefinitionID == sub.EventName {
+		rec.DefinitionID = sub.ElementID
+	}
+
+	tx, err := e.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+	txc := tx.Client()
+
+	if sub.AttachedTo == "" || sub.Interrupting {
+		claimed, err := txc.ProcessEventSubscription.Update().
+			Where(
+				processeventsubscription.ID(sub.ID),
+				processeventsubscription.StatusEQ(bpmnSubscriptionWaiting),
+			).
+			SetStatus(bpmnSubscriptionCorrelated).
+			SetCorrelatedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("认领事件订阅失败: %w", err)
+		}
+		if claimed != 1 {
+			_ = tx.Rollback()
+			return fmt.Errorf("事件订阅 %s 已被处理", sub.ElementID)
+		}
+	}
+
+	instance, err := txc.ProcessInstance.Get(ctx, sub.ProcessInstanceID)
+	if err != nil || instance.TenantID != sub.TenantID {
+		_ = tx.Rollback()
+		return fmt.Errorf("获取流程实例失败: %w", err)
+	}
+	if instance.Status != "running" {
+		_ = tx.Rollback()
+		return fmt.Errorf("流程实例 %s 当前状态为 %s，不能接收事件", instance.ProcessInstanceID, instance.Status)
+	}
+	if len(variables) > 0 {
+		if instance, err = e.mergeVariablesInTx(ctx, txc, instance.ID, variables); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("合并实例变量失败: %w", err)
+		}
+	}
+	process, err := e.loadInstanceProcess(ctx, txc, instance)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if sub.AttachedTo == "" {
+		err = e.resumeCatchEvent(ctx, txc, instance, process, sub)
+	} else {
+		err = e.fireBoundarySubscription(ctx, txc, instance, process, sub)
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	rec.Status = "completed"
+	if _, err := saveProcessEventInstance(ctx, txc, rec); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("保存事件实例失败: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("提交事务失败: %w", err)
+	}
+	return nil
+}
+
+// resumeCatchEvent 中间捕获事件/接收任务收到事件：离开等待态，撤销其边界事件并沿出边推进
+func (e *CustomProcessEngine) resumeCatchEvent(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, process *BPMNProcess, sub *ent.ProcessEventSubscription) error {
+	if err := e.settleEventGateway(ctx, txc, instance, process, sub.ElementID); err != nil {
+		return err
+	}
+	if err := e.markElementWaiting(ctx, txc, instance, sub.ElementID, false); err != nil {
+		return err
+	}
+	if err := e.cancelBoundaryEvents(ctx, txc, instance, process, sub.ElementID); err != nil {
+		return err
+	}
+	e.markElementDone(ctx, txc, instance, sub.ElementID)
+	e.recordEventHistory(ctx, txc, instance, sub)
+	return e.executeStep(ctx, txc, instance, process, sub.ElementID, instance.Variables)
+}
+
+// fireBoundarySubscription 消息/信号边界事件触发：中断或旁路所挂载活动后沿边界事件出边推进
+func (e *CustomProcessEngine) fireBoundarySubscription(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, process *BPMNProcess, sub *ent.ProcessEventSubscription) error {
+	boundary := e.findBoundaryEvent(process, sub.ElementID)
+	if boundary == nil {
+		return fmt.Errorf("边界事件 %s 不存在于流程定义中", sub.ElementID)
+	}
+	fired, err := e.activateBoundaryEvent(ctx, txc, instance, process, boundary)
+	if err != nil {
+		return err
+	}
+	if !fired {
+		return fmt.Errorf("边界事件 %s 所挂载活动已结束", sub.ElementID)
+	}
+	e.markElementDone(ctx, txc, instance, sub.ElementID)
+	e.recordEventHistory(ctx, txc, instance, sub)
+	return e.executeStep(ctx, txc, instance, process, sub.ElementID, instance.Variables)
+}
+
+// recordEventHistory 记录消息/信号触发历史
+func (e *CustomProcessEngine) recordEventHistory(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, sub *ent.ProcessEventSubscription) {
+	_, err := txc.ProcessExecutionHistory.Create().
+		SetHistoryID(fmt.Sprintf("HIST-%s-%d", sub.ElementID, time.Now().UnixNano())).
+		SetProcessInstanceID(instance.ID).
+		SetProcessDefinitionKey(instance.ProcessDefinitionKey).
+		SetActivityID(sub.ElementID).
+		SetActivityType(sub.EventType + "_event").
+		SetEventType(fmt.Sprintf("%s.correlated", sub.EventType)).
+		SetEventDetail(sub.EventName).
+		SetVariables(instance.Variables).
+		SetTenantID(instance.TenantID).
+		SetTimestamp(time.Now()).
+		Save(ctx)
+	if err != nil {
+		e.logger.Warnw("recordEventHistory 保存失败", "error", err)
+	}
+}
+
+func (e *CustomProcessEngine) findBoundaryEvent(process *BPMNProcess, id string) *BPMNBoundaryEvent {
+	for _, event := range process.scopeOf(id).BoundaryEvents {
+		if event.ID == id {
+			return event
+		}
+	}
+	return nil
+}
+
+func (e *CustomProcessEngine) findReceiveTask(process *BPMNProcess, id string) *BPMNReceiveTask {
+	for _, task := range process.scopeOf(id).ReceiveTasks {
+		if task.ID == id {
+			return task
+		}
+	}
+	return nil
+}
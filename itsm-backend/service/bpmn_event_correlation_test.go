@@ -0,0 +1,201 @@
+package service
+
+import (
+	"testing"
+
+	"itsm-backend/ent/processeventsubscription"
+	"itsm-backend/ent/processinstance"
+	"itsm-backend/ent/processtask"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 变更流程：提交后等待 CI/CD 流水线回调「部署完成」消息
+const messageCatchBPMN = `<?xml version="1.0" encoding="UTF-8"?>
+<bpmn:definitions xmlns:bpmn="http://www.omg.org/spec/BPMN/20100524/MODEL" id="Definitions_msg" targetNamespace="http://bpmn.io/schema/bpmn">
+  <bpmn:message id="Msg_deploy" name="deployment_finished"/>
+  <bpmn:process id="Process_msg" name="Message" isExecutable="true">
+    <bpmn:startEvent id="StartEvent_1"/>
+    <bpmn:intermediateCatchEvent id="Wait_Deploy" name="等待部署完成">
+      <bpmn:messageEventDefinition messageRef="Msg_deploy"/>
+    </bpmn:intermediateCatchEvent>
+    <bpmn:userTask id="Verify" name="验证"/>
+    <bpmn:endEvent id="EndEvent_1"/>
+    <bpmn:sequenceFlow id="Flow_1" sourceRef="StartEvent_1" targetRef="Wait_Deploy"/>
+    <bpmn:sequenceFlow id="Flow_2" sourceRef="Wait_Deploy" targetRef="Verify"/>
+    <bpmn:sequenceFlow id="Flow_3" sourceRef="Verify" targetRef="EndEvent_1"/>
+  </bpmn:process>
+</bpmn:definitions>`
+
+// 飞书卡片回调：接收任务等待审批结果
+const receiveTaskBPMN = `<?xml version="1.0" encoding="UTF-8"?>
+<bpmn:definitions xmlns:bpmn="http://www.omg.org/spec/BPMN/20100524/MODEL" id="Definitions_recv" targetNamespace="http://bpmn.io/schema/bpmn">
+  <bpmn:process id="Process_recv" name="Receive" isExecutable="true">
+    <bpmn:startEvent id="StartEvent_1"/>
+    <bpmn:receiveTask id="Wait_Card" name="等待飞书卡片回调" messageRef="feishu_card_callback"/>
+    <bpmn:endEvent id="EndEvent_1"/>
+    <bpmn:sequenceFlow id="Flow_1" sourceRef="StartEvent_1" targetRef="Wait_Card"/>
+    <bpmn:sequenceFlow id="Flow_2" sourceRef="Wait_Card" targetRef="EndEvent_1"/>
+  </bpmn:process>
+</bpmn:definitions>`
+
+// 信号「变更冻结解除」唤醒所有等待实例
+const signalCatchBPMN = `<?xml version="1.0" encoding="UTF-8"?>
+<bpmn:definitions xmlns:bpmn="http://www.omg.org/spec/BPMN/20100524/MODEL" id="Definitions_sig" targetNamespace="http://bpmn.io/schema/bpmn">
+  <bpmn:signal id="Sig_unfreeze" name="change_freeze_lifted"/>
+  <bpmn:process id="Process_sig" name="Signal" isExecutable="true">
+    <bpmn:startEvent id="StartEvent_1"/>
+    <bpmn:intermediateCatchEvent id="Wait_Unfreeze">
+      <bpmn:signalEventDefinition signalRef="Sig_unfreeze"/>
+    </bpmn:intermediateCatchEvent>
+    <bpmn:userTask id="Implement" name="实施"/>
+    <bpmn:endEvent id="EndEvent_1"/>
+    <bpmn:sequenceFlow id="Flow_1" sourceRef="StartEvent_1" targetRef="Wait_Unfreeze"/>
+    <bpmn:sequenceFlow id="Flow_2" sourceRef="Wait_Unfreeze" targetRef="Implement"/>
+    <bpmn:sequenceFlow id="Flow_3" sourceRef="Implement" targetRef="EndEvent_1"/>
+  </bpmn:process>
+</bpmn:definitions>`
+
+// 审批期间收到「撤回」消息中断审批
+const messageBoundaryBPMN = `<?xml version="1.0" encoding="UTF-8"?>
+<bpmn:definitions xmlns:bpmn="http://www.omg.org/spec/BPMN/20100524/MODEL" id="Definitions_mb" targetNamespace="http://bpmn.io/schema/bpmn">
+  <bpmn:process id="Process_mb" name="MessageBoundary" isExecutable="true">
+    <bpmn:startEvent id="StartEvent_1"/>
+    <bpmn:userTask id="Approve" name="审批" assignee="1"/>
+    <bpmn:boundaryEvent id="Withdrawn" attachedToRef="Approve">
+      <bpmn:messageEventDefinition messageRef="change_withdrawn"/>
+    </bpmn:boundaryEvent>
+    <bpmn:userTask id="Cleanup" name="撤回处理" assignee="1"/>
+    <bpmn:endEvent id="EndEvent_1"/>
+    <bpmn:endEvent id="EndEvent_2"/>
+    <bpmn:sequenceFlow id="Flow_1" sourceRef="StartEvent_1" targetRef="Approve"/>
+    <bpmn:sequenceFlow id="Flow_2" sourceRef="Approve" targetRef="EndEvent_1"/>
+    <bpmn:sequenceFlow id="Flow_3" sourceRef="Withdrawn" targetRef="Cleanup"/>
+    <bpmn:sequenceFlow id="Flow_4" sourceRef="Cleanup" targetRef="EndEvent_2"/>
+  </bpmn:process>
+</bpmn:definitions>`
+
+func TestCorrelateMessage_IntermediateCatchByVariable(t *testing.T) {
+	engine, client, ctx := seedTimerEngine(t, "msgDemo", messageCatchBPMN)
+
+	first, err := engine.StartProcess(ctx, "msgDemo", "CHG-1", map[string]interface{}{"ticket_id": 101})
+	require.NoError(t, err)
+	_, err = engine.StartProcess(ctx, "msgDemo", "CHG-2", map[string]interface{}{"ticket_id": 102})
+	require.NoError(t, err)
+
+	_, err = engine.CorrelateMessage(ctx, "deployment_finished", nil, nil)
+	assert.ErrorIs(t, err, ErrMessageAmbiguous, "两个实例都在等待时不带关联键应拒绝")
+	_, err = engine.CorrelateMessage(ctx, "deployment_finished", map[string]interface{}{"ticket_id": 999}, nil)
+	assert.ErrorIs(t, err, ErrMessageNotCorrelated)
+
+	result, err := engine.CorrelateMessage(ctx, "deployment_finished",
+		map[string]interface{}{"ticket_id": float64(101)}, map[string]interface{}{"pipeline_status": "success"})
+	require.NoError(t, err)
+	assert.Equal(t, first.ProcessInstanceID, result.ProcessInstanceID)
+	assert.Equal(t, "Wait_Deploy", result.ElementID)
+
+	task, err := client.ProcessTask.Query().Where(processtask.ProcessInstanceID(first.ID)).Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "Verify", task.TaskDefinitionKey)
+	inst, err := client.ProcessInstance.Get(ctx, first.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "success", inst.Variables["pipeline_status"])
+
+	// 已关联的订阅不能被重复消费
+	_, err = engine.CorrelateMessage(ctx, "deployment_finished", map[string]interface{}{"ticket_id": 101}, nil)
+	assert.ErrorIs(t, err, ErrMessageNotCorrelated)
+
+	events, total, err := NewBPMNEventService(client).GetEventInstances(ctx, &ListEventInstancesRequest{TenantID: 11})
+	require.NoError(t, err)
+	assert.Equal(t, 4, total, "每次关联（含失败）都应留下事件实例记录")
+	completed, _, err := NewBPMNEventService(client).GetEventInstances(ctx, &ListEventInstancesRequest{
+		TenantID: 11, ProcessInstanceID: first.ProcessInstanceID, Status: "completed",
+	})
+	require.NoError(t, err)
+	require.Len(t, completed, 1)
+	assert.Equal(t, result.EventInstanceID, completed[0].ID)
+	assert.NotNil(t, completed[0].CompletedAt)
+	assert.Len(t, events, 4)
+}
+
+func TestCorrelateMessage_ReceiveTaskByBusinessKey(t *testing.T) {
+	engine, client, ctx := seedTimerEngine(t, "recvDemo", receiveTaskBPMN)
+
+	inst, err := engine.StartProcess(ctx, "recvDemo", "CHG-42", map[string]interface{}{})
+	require.NoError(t, err)
+	sub, err := client.ProcessEventSubscription.Query().Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "feishu_card_callback", sub.EventName)
+	assert.Equal(t, "CHG-42", sub.BusinessKey)
+
+	_, err = engine.CorrelateMessage(ctx, "feishu_card_callback", map[string]interface{}{"business_key": "CHG-42"}, nil)
+	require.NoError(t, err)
+
+	done, err := client.ProcessInstance.Get(ctx, inst.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "completed", done.Status)
+}
+
+func TestBroadcastSignal_WakesAllSubscribers(t *testing.T) {
+	engine, client, ctx := seedTimerEngine(t, "sigDemo", signalCatchBPMN)
+
+	for _, biz := range []string{"CHG-1", "CHG-2"} {
+		_, err := engine.StartProcess(ctx, "sigDemo", biz, map[string]interface{}{})
+		require.NoError(t, err)
+	}
+
+	result, err := engine.BroadcastSignal(ctx, "change_freeze_lifted", map[string]interface{}{"lifted_by": "cab"})
+	require.NoError(t, err)
+	assert.Len(t, result.ProcessInstanceIDs, 2)
+	assert.Empty(t, result.FailedInstanceIDs)
+
+	tasks, err := client.ProcessTask.Query().Where(processtask.TaskDefinitionKey("Implement")).Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, tasks)
+	waiting, err := client.ProcessEventSubscription.Query().
+		Where(processeventsubscription.StatusEQ(bpmnSubscriptionWaiting)).Count(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, waiting)
+
+	// 没有订阅者的广播也成功，并留下记录
+	again, err := engine.BroadcastSignal(ctx, "change_freeze_lifted", nil)
+	require.NoError(t, err)
+	assert.Empty(t, again.ProcessInstanceIDs)
+	assert.Len(t, again.EventInstanceIDs, 1)
+}
+
+func TestMessageBoundary_InterruptsTask(t *testing.T) {
+	engine, client, ctx := seedTimerEngine(t, "mbDemo", messageBoundaryBPMN)
+
+	inst, err := engine.StartProcess(ctx, "mbDemo", "CHG-7", map[string]interface{}{})
+	require.NoError(t, err)
+
+	_, err = engine.CorrelateMessage(ctx, "change_withdrawn", map[string]interface{}{"process_instance_id": inst.ProcessInstanceID}, nil)
+	require.NoError(t, err)
+
+	approve, err := client.ProcessTask.Query().
+		Where(processtask.ProcessInstanceID(inst.ID), processtask.TaskDefinitionKey("Approve")).Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "cancelled", approve.Status)
+	_, err = client.ProcessTask.Query().
+		Where(processtask.ProcessInstanceID(inst.ID), processtask.TaskDefinitionKey("Cleanup")).Only(ctx)
+	require.NoError(t, err)
+}
+
+func TestMessageBoundary_CancelledWhenTaskCompletes(t *testing.T) {
+	engine, client, ctx := seedTimerEngine(t, "mbDone", messageBoundaryBPMN)
+
+	inst, err := engine.StartProcess(ctx, "mbDone", "CHG-8", map[string]interface{}{})
+	require.NoError(t, err)
+	approve, err := client.ProcessTask.Query().
+		Where(processtask.ProcessInstanceID(inst.ID), processtask.TaskDefinitionKey("Approve")).Only(ctx)
+	require.NoError(t, err)
+	require.NoError(t, engine.CompleteTask(ctx, approve.TaskID, map[string]interface{}{}))
+
+	_, err = engine.CorrelateMessage(ctx, "change_withdrawn", nil, nil)
+	assert.ErrorIs(t, err, ErrMessageNotCorrelated, "审批完成后撤回消息不应再命中")
+	done, err := client.ProcessInstance.Query().Where(processinstance.ID(inst.ID)).Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "completed", done.Status)
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"itsm-backend/ent"
+)
+
+// BPMN 事件网关
+//
+// 进入事件网关时同时激活出边上的全部捕获事件（消息/信号订阅、中间定时器、接收任务），实例在这些事件上等待。
+// 任一事件触发（消息关联、信号广播、定时器到期，或接收任务的中断边界事件）时，在同一事务内撤销其余事件的
+// 订阅与定时器，只有胜出的分支继续推进；并发触发的后来者发现目标已不在等待，整个事务回滚。
+
+func (e *CustomProcessEngine) findEventBasedGateway(process *BPMNProcess, id string) *BPMNEventBasedGateway {
+	for _, gateway := range process.scopeOf(id).EventBasedGateways {
+		if gateway.ID == id {
+			return gateway
+		}
+	}
+	return nil
+}
+
+// eventGatewayTargets 事件网关出边上的目标元素
+func eventGatewayTargets(process *BPMNProcess, gateway *BPMNEventBasedGateway) []string {
+	var targets []string
+	for _, flow := range process.scopeOf(gateway.ID).SequenceFlows {
+		if flow.SourceRef == gateway.ID {
+			targets = append(targets, flow.TargetRef)
+		}
+	}
+	return targets
+}
+
+// enterEventBasedGateway 进入事件网关：激活全部竞争事件并等待
+func (e *CustomProcessEngine) enterEventBasedGateway(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, process *BPMNProcess, gateway *BPMNEventBasedGateway) error {
+	e.markElementDone(ctx, txc, instance, gateway.ID)
+	for _, target := range eventGatewayTargets(process, gateway) {
+		if err := e.armEventGatewayTarget(ctx, txc, instance, process, target); err != nil {
+			return fmt.Errorf("事件网关 [%s] 激活事件失败: %w", gateway.ID, err)
+		}
+	}
+	return nil
+}
+
+// armEventGatewayTarget 激活事件网关的单个目标事件
+func (e *CustomProcessEngine) armEventGatewayTarget(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, process *BPMNProcess, elementID string) error {
+	if event := e.findIntermediateEvent(process, elementID); event != nil {
+		if event.TimerDefinition != nil || event.TimerRef != "" {
+			return e.enterIntermediateTimer(ctx, txc, instance, event)
+		}
+		if eventType, eventName := intermediateEventTrigger(process, event); eventType != "" {
+			return e.enterCatchEvent(ctx, txc, instance, elementID, eventType, eventName)
+		}
+	}
+	if task := e.findReceiveTask(process, elementID); task != nil {
+		if err := e.enterCatchEvent(ctx, txc, instance, elementID, bpmnEventMessage, process.MessageName(task.MessageRef)); err != nil {
+			return err
+		}
+		return e.enterActivityBoundaries(ctx, txc, instance, process, elementID)
+	}
+	return fmt.Errorf("目标 [%s] 不是可等待的捕获事件", elementID)
+}
+
+// eventGatewayOf 返回以 elementID 为出边目标的事件网关
+func (e *CustomProcessEngine) eventGatewayOf(process *BPMNProcess, elementID string) *BPMNEventBasedGateway {
+	for _, flow := range process.scopeOf(elementID).SequenceFlows {
+		if flow.TargetRef != elementID {
+			continue
+		}
+		if gateway := e.findEventBasedGateway(process, flow.SourceRef); gateway != nil {
+			return gateway
+		}
+	}
+	return nil
+}
+
+// settleEventGateway 事件网关的目标事件触发：撤销其余竞争事件。elementID 不是事件网关目标时不做处理
+func (e *CustomProcessEngine) settleEventGateway(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, process *BPMNProcess, elementID string) error {
+	gateway := e.eventGatewayOf(process, elementID)
+	if gateway == nil {
+		return nil
+	}
+	if !isElementWaiting(instance, elementID) {
+		return fmt.Errorf("事件网关 [%s] 已由其他事件触发", gateway.ID)
+	}
+	var others []string
+	for _, target := range eventGatewayTargets(process, gateway) {
+		if target != elementID {
+			others = append(others, target)
+		}
+	}
+	if len(others) == 0 {
+		return nil
+	}
+	if err := e.cancelInstanceTimers(ctx, txc, instance, others...); err != nil {
+		return err
+	}
+	if err := e.cancelEventSubscriptions(ctx, txc, instance, others...); err != nil {
+		return err
+	}
+	for _, id := range others {
+		if err := e.markElementWaiting(ctx, txc, instance, id, false); err != nil {
+			return err
+		}
+		// 接收任务上挂载的边界事件一并撤销
+		if err := e.cancelBoundaryEvents(ctx, txc, instance, process, id); err != nil {
+			return err
+		}
+	}
+	e.recordScopeHistory(ctx, txc, instance, gateway.ID, ActivityTypeGateway, "gateway.event_selected", elementID)
+	return nil
+}
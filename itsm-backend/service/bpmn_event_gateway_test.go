@@ -0,0 +1,124 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"itsm-backend/ent/processeventsubscription"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 待客户反馈：客户回复、审批到达或 3 天无响应自动关闭，先到者胜出
+const pendingCustomerBPMN = `<?xml version="1.0" encoding="UTF-8"?>
+<bpmn:definitions xmlns:bpmn="http://www.omg.org/spec/BPMN/20100524/MODEL" id="Definitions_evgw" targetNamespace="http://bpmn.io/schema/bpmn">
+  <bpmn:message id="Msg_reply" name="customer_replied"/>
+  <bpmn:process id="Process_evgw" name="PendingCustomer" isExecutable="true">
+    <bpmn:startEvent id="StartEvent_1"/>
+    <bpmn:eventBasedGateway id="Wait_Any" name="等待客户"/>
+    <bpmn:intermediateCatchEvent id="Customer_Replied" name="客户回复">
+      <bpmn:messageEventDefinition messageRef="Msg_reply"/>
+    </bpmn:intermediateCatchEvent>
+    <bpmn:receiveTask id="Approval_Arrived" name="审批到达" messageRef="approval_arrived"/>
+    <bpmn:intermediateCatchEvent id="Three_Days" name="3 天无响应">
+      <bpmn:timerEventDefinition><bpmn:timeDuration>P3D</bpmn:timeDuration></bpmn:timerEventDefinition>
+    </bpmn:intermediateCatchEvent>
+    <bpmn:userTask id="Resume" name="继续处理" assignee="1"/>
+    <bpmn:userTask id="Fulfil" name="执行审批结果" assignee="1"/>
+    <bpmn:endEvent id="AutoClosed"/>
+    <bpmn:endEvent id="EndEvent_1"/>
+    <bpmn:sequenceFlow id="Flow_1" sourceRef="StartEvent_1" targetRef="Wait_Any"/>
+    <bpmn:sequenceFlow id="Flow_2" sourceRef="Wait_Any" targetRef="Customer_Replied"/>
+    <bpmn:sequenceFlow id="Flow_3" sourceRef="Wait_Any" targetRef="Approval_Arrived"/>
+    <bpmn:sequenceFlow id="Flow_4" sourceRef="Wait_Any" targetRef="Three_Days"/>
+    <bpmn:sequenceFlow id="Flow_5" sourceRef="Customer_Replied" targetRef="Resume"/>
+    <bpmn:sequenceFlow id="Flow_6" sourceRef="Approval_Arrived" targetRef="Fulfil"/>
+    <bpmn:sequenceFlow id="Flow_7" sourceRef="Three_Days" targetRef="AutoClosed"/>
+    <bpmn:sequenceFlow id="Flow_8" sourceRef="Resume" targetRef="EndEvent_1"/>
+    <bpmn:sequenceFlow id="Flow_9" sourceRef="Fulfil" targetRef="EndEvent_1"/>
+  </bpmn:process>
+</bpmn:definitions>`
+
+func TestEventBasedGateway_MessageWinsRace(t *testing.T) {
+	engine, client, ctx := seedTimerEngine(t, "pending_msg", pendingCustomerBPMN)
+
+	inst, err := engine.StartProcess(ctx, "pending_msg", "INC-1", map[string]interface{}{})
+	require.NoError(t, err)
+	waiting, err := client.ProcessEventSubscription.Query().
+		Where(processeventsubscription.StatusEQ(bpmnSubscriptionWaiting)).All(ctx)
+	require.NoError(t, err)
+	assert.Len(t, waiting, 2)
+	require.Len(t, pendingTimers(t, client, ctx), 1)
+
+	_, err = engine.CorrelateMessage(ctx, "customer_replied", map[string]interface{}{"business_key": "INC-1"}, map[string]interface{}{"reply": "已恢复"})
+	require.NoError(t, err)
+	openTask(t, client, ctx, inst.ID, "Resume")
+	assert.Empty(t, pendingTimers(t, client, ctx), "胜出后撤销定时器")
+	remaining, err := client.ProcessEventSubscription.Query().
+		Where(processeventsubscription.StatusEQ(bpmnSubscriptionWaiting)).Count(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, remaining, "胜出后撤销其余订阅")
+
+	_, err = engine.CorrelateMessage(ctx, "approval_arrived", map[string]interface{}{"business_key": "INC-1"}, nil)
+	assert.Error(t, err, "落败的事件不再可关联")
+	inst, err = client.ProcessInstance.Get(ctx, inst.ID)
+	require.NoError(t, err)
+	assert.Empty(t, inst.Variables["_waiting_"])
+}
+
+func TestEventBasedGateway_TimerWinsRace(t *testing.T) {
+	engine, client, ctx := seedTimerEngine(t, "pending_timer", pendingCustomerBPMN)
+
+	inst, err := engine.StartProcess(ctx, "pending_timer", "INC-2", map[string]interface{}{})
+	require.NoError(t, err)
+	cmds := pendingTimers(t, client, ctx)
+	require.Len(t, cmds, 1)
+	require.NoError(t, engine.HandleTimerCommand(ctx, cmds[0]))
+
+	inst, err = client.ProcessInstance.Get(ctx, inst.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "completed", inst.Status, "3 天无响应自动关闭")
+	remaining, err := client.ProcessEventSubscription.Query().
+		Where(processeventsubscription.StatusEQ(bpmnSubscriptionWaiting)).Count(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, remaining)
+
+	// 定时器重复投递（如 commandbus 重试）被忽略
+	require.NoError(t, engine.HandleTimerCommand(ctx, cmds[0]))
+}
+
+func TestEventBasedGateway_Validation(t *testing.T) {
+	parser := NewBPMNParser()
+	_, err := parser.ParseXML([]byte(pendingCustomerBPMN))
+	require.NoError(t, err)
+
+	cases := map[string]struct {
+		xml  string
+		want string
+	}{
+		"条件出边": {
+			strings.Replace(pendingCustomerBPMN, `<bpmn:sequenceFlow id="Flow_2" sourceRef="Wait_Any" targetRef="Customer_Replied"/>`,
+				`<bpmn:sequenceFlow id="Flow_2" sourceRef="Wait_Any" targetRef="Customer_Replied"><bpmn:conditionExpression>x</bpmn:conditionExpression></bpmn:sequenceFlow>`, 1),
+			"不能设置条件",
+		},
+		"非捕获事件目标": {
+			strings.Replace(pendingCustomerBPMN, `sourceRef="Wait_Any" targetRef="Three_Days"`, `sourceRef="Wait_Any" targetRef="Resume"`, 1),
+			"必须是消息/定时器/信号中间捕获事件或接收任务",
+		},
+		"单条出边": {
+			strings.Replace(strings.Replace(pendingCustomerBPMN,
+				`<bpmn:sequenceFlow id="Flow_3" sourceRef="Wait_Any" targetRef="Approval_Arrived"/>`, "", 1),
+				`<bpmn:sequenceFlow id="Flow_4" sourceRef="Wait_Any" targetRef="Three_Days"/>`, "", 1),
+			"至少需要两条出边",
+		},
+		"实例化网关": {
+			strings.Replace(pendingCustomerBPMN, `<bpmn:eventBasedGateway id="Wait_Any"`, `<bpmn:eventBasedGateway id="Wait_Any" instantiate="true"`, 1),
+			"instantiate",
+		},
+	}
+	for name, tc := range cases {
+		_, err := parser.ParseXML([]byte(tc.xml))
+		assert.ErrorContains(t, err, tc.want, name)
+	}
+}
@@ -6,11 +6,16 @@ import (
 	"time"
 
 	"itsm-backend/ent"
+	"itsm-backend/ent/processdefinition"
+	"itsm-backend/ent/processeventinstance"
+	"itsm-backend/ent/processinstance"
+	"itsm-backend/service/bpmn"
 )
 
 // BPMNEventService BPMN事件处理服务
 type BPMNEventService struct {
 	client *ent.Client
+	engine *CustomProcessEngine
 }
 
 // NewBPMNEventService 创建BPMN事件服务实例
@@ -18,6 +23,11 @@ func NewBPMNEventService(client *ent.Client) *BPMNEventService {
 	return &BPMNEventService{client: client}
 }
 
+// SetProcessEngine 接入流程引擎，消息/信号事件经引擎的订阅表关联到等待中的流程实例
+func (s *BPMNEventService) SetProcessEngine(engine *CustomProcessEngine) {
+	s.engine = engine
+}
+
 // EventType 事件类型
 type EventType string
 
@@ -57,6 +67,9 @@ type EventDefinition struct {
 	Handler     string                 `json:"handler,omitempty"`     // 事件处理器
 	Timeout     string                 `json:"timeout,omitempty"`     // 超时设置
 	RetryPolicy *RetryPolicy           `json:"retryPolicy,omitempty"` // 重试策略
+
+	// targets 出向流指向的目标元素ID，由 BPMN 定义解析得到
+	targets []string
 }
 
 // Position 位置信息
@@ -108,8 +121,10 @@ type EventTriggerResult struct {
 
 // TriggerEvent 触发事件
 func (s *BPMNEventService) TriggerEvent(ctx context.Context, req *EventTriggerRequest) (*EventTriggerResult, error) {
+	ctx = context.WithValue(ctx, bpmn.BPMNTenantIDContextKey, req.TenantID)
+
 	// 获取事件定义
-	eventDef, err := s.getEventDefinition(ctx, req.EventDefinitionID, req.TenantID)
+	eventDef, err := s.getEventDefinition(ctx, req)
 	if err != nil {
 		return nil, fmt.Errorf("获取事件定义失败: %w", err)
 	}
@@ -137,12 +152,14 @@ func (s *BPMNEventService) TriggerEvent(ctx context.Context, req *EventTriggerRe
 
 	if err != nil {
 		// 更新事件实例状态为失败
-		s.updateEventInstanceStatus(ctx, eventInstance.ID, "failed", err.Error())
+		_ = s.updateEventInstanceStatus(ctx, req.TenantID, eventInstance.ID, "failed", err.Error())
 		return nil, err
 	}
 
 	// 更新事件实例状态为完成
-	s.updateEventInstanceStatus(ctx, eventInstance.ID, "completed", "")
+	if err := s.updateEventInstanceStatus(ctx, req.TenantID, eventInstance.ID, "completed", ""); err != nil {
+		return nil, err
+	}
 
 	return result, nil
 }
@@ -184,18 +201,25 @@ func (s *BPMNEventService) handleIntermediateEvent(ctx context.Context, eventDef
 	}
 
 	// 中间事件根据触发器类型处理
+	var err error
 	switch eventDef.Trigger {
 	case TriggerMessage:
-		result.NextActivities = s.handleMessageEvent(ctx, eventDef, req)
+		result.NextActivities, err = s.handleMessageEvent(ctx, eventDef, eventInstance, req)
 	case TriggerTimer:
-		result.NextActivities = s.handleTimerEvent(ctx, eventDef, req)
+		result.NextActivities, err = s.handleTimerEvent(ctx, eventDef, req)
+		result.Variables = req.Variables
 	case TriggerSignal:
-		result.NextActivities = s.handleSignalEvent(ctx, eventDef, req)
+		result.NextActivities, err = s.handleSignalEvent(ctx, eventDef, eventInstance, req)
 	case TriggerError:
-		result.NextActivities = s.handleErrorEvent(ctx, eventDef, req)
+		result.NextActivities, err = s.handleErrorEvent(ctx, eventDef, req)
+	case TriggerCompensate:
+		result.NextActivities, err = s.handleCompensateEndEvent(ctx, eventDef, req)
 	default:
 		result.NextActivities = s.getNextActivitiesFromEvent(eventDef)
 	}
+	if err != nil {
+		return nil, err
+	}
 
 	return result, nil
 }
@@ -221,10 +245,18 @@ func (s *BPMNEventService) handleEndEvent(ctx context.Context, eventDef *EventDe
 		}
 	case TriggerError:
 		// 处理错误结束事件
-		result.NextActivities = s.handleErrorEndEvent(ctx, eventDef, req)
+		next, err := s.handleErrorEndEvent(ctx, eventDef, req)
+		if err != nil {
+			return nil, err
+		}
+		result.NextActivities = next
 	case TriggerCompensate:
 		// 处理补偿结束事件
-		result.NextActivities = s.handleCompensateEndEvent(ctx, eventDef, req)
+		next, err := s.handleCompensateEndEvent(ctx, eventDef, req)
+		if err != nil {
+			return nil, err
+		}
+		result.NextActivities = next
 	default:
 		// 正常结束事件
 		if req.ProcessInstanceID != "" {
@@ -247,6 +279,32 @@ func (s *BPMNEventService) handleBoundaryEvent(ctx context.Context, eventDef *Ev
 		Variables:       req.Variables,
 	}
 
+	// 消息/信号边界事件经订阅关联，由引擎负责中断或旁路所挂载活动
+	switch eventDef.Trigger {
+	case TriggerMessage:
+		next, err := s.handleMessageEvent(ctx, eventDef, eventInstance, req)
+		if err != nil {
+			return nil, err
+		}
+		result.NextActivities = next
+		return result, nil
+	case TriggerSignal:
+		next, err := s.handleSignalEvent(ctx, eventDef, eventInstance, req)
+		if err != nil {
+			return nil, err
+		}
+		result.NextActivities = next
+		return result, nil
+	case TriggerError:
+		// 错误边界事件：由挂载活动抛出错误，引擎中断活动并沿边界事件出边继续
+		next, err := s.handleErrorEvent(ctx, eventDef, req)
+		if err != nil {
+			return nil, err
+		}
+		result.NextActivities = next
+		return result, nil
+	}
+
 	// 边界事件通常中断当前活动并执行异常处理流程
 	if req.ProcessInstanceID != "" {
 		// 中断当前活动
@@ -262,55 +320,140 @@ func (s *BPMNEventService) handleBoundaryEvent(ctx context.Context, eventDef *Ev
 	return result, nil
 }
 
-// handleMessageEvent 处理消息事件
-func (s *BPMNEventService) handleMessageEvent(ctx context.Context, eventDef *EventDefinition, req *EventTriggerRequest) []string {
-	// 消息事件处理逻辑
-	// 这里可以实现消息队列、WebSocket等消息处理机制
-	return s.getNextActivitiesFromEvent(eventDef)
+// handleMessageEvent 处理消息事件：按消息名称与关联键关联到唯一一个等待中的流程实例。
+// 指定了 ProcessInstanceID 时以其作为关联键，变量中的 correlationKeys 可追加业务键等条件。
+func (s *BPMNEventService) handleMessageEvent(ctx context.Context, eventDef *EventDefinition, eventInstance *EventInstance, req *EventTriggerRequest) ([]string, error) {
+	if s.engine == nil {
+		return nil, fmt.Errorf("事件服务未接入流程引擎，无法关联消息")
+	}
+	messageName, _ := eventDef.Properties["messageName"].(string)
+	correlationKeys, variables := splitCorrelationKeys(req)
+	if _, err := s.engine.correlateMessage(ctx, req.TenantID, eventInstance.ID, messageName, correlationKeys, variables); err != nil {
+		return nil, err
+	}
+	return s.getNextActivitiesFromEvent(eventDef), nil
+}
+
+// splitCorrelationKeys 从触发请求中拆出关联键，其余变量原样写入流程实例
+func splitCorrelationKeys(req *EventTriggerRequest) (map[string]interface{}, map[string]interface{}) {
+	correlationKeys := map[string]interface{}{}
+	variables := make(map[string]interface{}, len(req.Variables))
+	for k, v := range req.Variables {
+		if k == "correlationKeys" {
+			if keys, ok := v.(map[string]interface{}); ok {
+				for key, value := range keys {
+					correlationKeys[key] = value
+				}
+			}
+			continue
+		}
+		variables[k] = v
+	}
+	if req.ProcessInstanceID != "" {
+		correlationKeys["process_instance_id"] = req.ProcessInstanceID
+	}
+	return correlationKeys, variables
 }
 
-// handleTimerEvent 处理定时器事件
-func (s *BPMNEventService) handleTimerEvent(ctx context.Context, eventDef *EventDefinition, req *EventTriggerRequest) []string {
-	// 定时器事件处理逻辑
-	// 这里可以实现定时任务调度
-	return s.getNextActivitiesFromEvent(eventDef)
+// handleTimerEvent 处理定时器事件。
+// 能定位到流程实例时，经引擎的中间定时器入口在该事件上进入等待并以 OperationalCommand 持久化定时器，
+// 到期后由 commandbus 触发 CustomProcessEngine 继续推进；到期时间写入返回变量 timer_due_at。
+func (s *BPMNEventService) handleTimerEvent(ctx context.Context, eventDef *EventDefinition, req *EventTriggerRequest) ([]string, error) {
+	if req.ProcessInstanceID == "" {
+		return s.getNextActivitiesFromEvent(eventDef), nil
+	}
+	if s.engine == nil {
+		return nil, fmt.Errorf("事件服务未接入流程引擎，无法调度定时器")
+	}
+	dueAt, err := s.engine.armIntermediateTimer(ctx, req.TenantID, req.ProcessInstanceID, eventDef.ID, req.Variables)
+	if err != nil {
+		return nil, err
+	}
+	if req.Variables == nil {
+		req.Variables = map[string]interface{}{}
+	}
+	req.Variables["timer_due_at"] = dueAt.Format(time.RFC3339)
+	// 定时器尚未到期：当前不流转到任何后续活动
+	return []string{}, nil
 }
 
-// handleSignalEvent 处理信号事件
-func (s *BPMNEventService) handleSignalEvent(ctx context.Context, eventDef *EventDefinition, req *EventTriggerRequest) []string {
-	// 信号事件处理逻辑
-	// 这里可以实现信号广播和接收机制
-	return s.getNextActivitiesFromEvent(eventDef)
+// handleSignalEvent 处理信号事件：向租户内所有订阅该信号的流程实例广播
+func (s *BPMNEventService) handleSignalEvent(ctx context.Context, eventDef *EventDefinition, eventInstance *EventInstance, req *EventTriggerRequest) ([]string, error) {
+	if s.engine == nil {
+		return nil, fmt.Errorf("事件服务未接入流程引擎，无法广播信号")
+	}
+	signalName, _ := eventDef.Properties["signalName"].(string)
+	_, variables := splitCorrelationKeys(req)
+	if _, err := s.engine.broadcastSignal(ctx, req.TenantID, eventInstance.ID, signalName, variables); err != nil {
+		return nil, err
+	}
+	return s.getNextActivitiesFromEvent(eventDef), nil
+}
+
+// handleErrorEvent 处理错误事件：在流程实例中抛出错误，由引擎路由到匹配的错误边界事件。
+// 边界事件从其挂载活动抛出；错误码取变量 errorCode，缺省为事件定义的 errorCode。
+func (s *BPMNEventService) handleErrorEvent(ctx context.Context, eventDef *EventDefinition, req *EventTriggerRequest) ([]string, error) {
+	if s.engine == nil {
+		return nil, fmt.Errorf("事件服务未接入流程引擎，无法抛出错误")
+	}
+	if req.ProcessInstanceID == "" {
+		return nil, fmt.Errorf("错误事件必须指定流程实例")
+	}
+	sourceID := eventDef.ID
+	if attachedTo, _ := eventDef.Properties["attachedToRef"].(string); attachedTo != "" {
+		sourceID = attachedTo
+	}
+	code, message := errorFromRequest(eventDef, req)
+	if err := s.engine.raiseError(ctx, req.TenantID, req.ProcessInstanceID, sourceID, code, message); err != nil {
+		return nil, err
+	}
+	return s.getNextActivitiesFromEvent(eventDef), nil
 }
 
-// handleErrorEvent 处理错误事件
-func (s *BPMNEventService) handleErrorEvent(ctx context.Context, eventDef *EventDefinition, req *EventTriggerRequest) []string {
-	// 错误事件处理逻辑
-	// 这里可以实现错误处理和恢复机制
-	return s.getNextActivitiesFromEvent(eventDef)
+// handleErrorEndEvent 处理错误结束事件：从结束事件所在作用域向外抛出错误
+func (s *BPMNEventService) handleErrorEndEvent(ctx context.Context, eventDef *EventDefinition, req *EventTriggerRequest) ([]string, error) {
+	if s.engine == nil {
+		return nil, fmt.Errorf("事件服务未接入流程引擎，无法抛出错误")
+	}
+	if req.ProcessInstanceID == "" {
+		return nil, fmt.Errorf("错误结束事件必须指定流程实例")
+	}
+	code, message := errorFromRequest(eventDef, req)
+	if err := s.engine.raiseError(ctx, req.TenantID, req.ProcessInstanceID, eventDef.ID, code, message); err != nil {
+		return nil, err
+	}
+	return []string{}, nil
 }
 
-// handleErrorEndEvent 处理错误结束事件
-func (s *BPMNEventService) handleErrorEndEvent(ctx context.Context, eventDef *EventDefinition, req *EventTriggerRequest) []string {
-	// 错误结束事件处理逻辑
-	return []string{}
+// handleCompensateEndEvent 处理补偿事件（补偿结束事件与补偿中间抛出事件）：逆序执行已完成活动的补偿处理
+func (s *BPMNEventService) handleCompensateEndEvent(ctx context.Context, eventDef *EventDefinition, req *EventTriggerRequest) ([]string, error) {
+	if s.engine == nil {
+		return nil, fmt.Errorf("事件服务未接入流程引擎，无法执行补偿")
+	}
+	if req.ProcessInstanceID == "" {
+		return nil, fmt.Errorf("补偿事件必须指定流程实例")
+	}
+	activityRef, _ := eventDef.Properties["activityRef"].(string)
+	if err := s.engine.raiseCompensation(ctx, req.TenantID, req.ProcessInstanceID, eventDef.ID, activityRef); err != nil {
+		return nil, err
+	}
+	return s.getNextActivitiesFromEvent(eventDef), nil
 }
 
-// handleCompensateEndEvent 处理补偿结束事件
-func (s *BPMNEventService) handleCompensateEndEvent(ctx context.Context, eventDef *EventDefinition, req *EventTriggerRequest) []string {
-	// 补偿结束事件处理逻辑
-	return []string{}
+// errorFromRequest 解析触发请求中的错误码与错误信息
+func errorFromRequest(eventDef *EventDefinition, req *EventTriggerRequest) (string, string) {
+	code, _ := eventDef.Properties["errorCode"].(string)
+	if v, ok := req.Variables["errorCode"].(string); ok && v != "" {
+		code = v
+	}
+	message, _ := req.Variables["errorMessage"].(string)
+	return code, message
 }
 
-// getNextActivitiesFromEvent 从事件获取下一个活动
+// getNextActivitiesFromEvent 从事件获取下一个活动（出向流的目标元素）
 func (s *BPMNEventService) getNextActivitiesFromEvent(eventDef *EventDefinition) []string {
-	// 从事件定义中获取出向流，确定下一个活动
-	var activities []string
-	for _, outgoing := range eventDef.Outgoing {
-		// 这里应该解析出向流，获取目标活动
-		activities = append(activities, fmt.Sprintf("activity_%s", outgoing))
-	}
-	return activities
+	activities := make([]string, 0, len(eventDef.targets))
+	return append(activities, eventDef.targets...)
 }
 
 // getExceptionHandlingActivities 获取异常处理活动
@@ -319,49 +462,222 @@ func (s *BPMNEventService) getExceptionHandlingActivities(eventDef *EventDefinit
 	return []string{"exception_handler"}
 }
 
-// createEventInstance 创建事件实例
+// createEventInstance 创建事件实例记录（pending）
 func (s *BPMNEventService) createEventInstance(ctx context.Context, req *EventTriggerRequest, eventDef *EventDefinition) (*EventInstance, error) {
-	// 这里应该创建事件实例记录
-	// 简化实现，返回模拟数据
-	eventInstance := &EventInstance{
-		ID:                fmt.Sprintf("EI-%d", time.Now().UnixNano()),
-		EventDefinitionID: req.EventDefinitionID,
+	record, err := saveProcessEventInstance(ctx, s.client, &eventInstanceRecord{
+		TenantID:          req.TenantID,
+		DefinitionID:      req.EventDefinitionID,
+		EventType:         string(eventDef.Type),
+		Trigger:           string(eventDef.Trigger),
+		EventName:         eventDef.Name,
 		ProcessInstanceID: req.ProcessInstanceID,
-		Status:            "pending",
 		Variables:         req.Variables,
-		RetryCount:        0,
-		TenantID:          req.TenantID,
-		CreatedAt:         time.Now(),
-		UpdatedAt:         time.Now(),
+		Status:            "pending",
+	})
+	if err != nil {
+		return nil, err
 	}
-
-	return eventInstance, nil
+	return toEventInstance(record), nil
 }
 
 // updateEventInstanceStatus 更新事件实例状态
-func (s *BPMNEventService) updateEventInstanceStatus(ctx context.Context, eventInstanceID string, status string, errorMsg string) error {
-	// 这里应该更新事件实例状态
-	// 简化实现，直接返回
+func (s *BPMNEventService) updateEventInstanceStatus(ctx context.Context, tenantID int, eventInstanceID string, status string, errorMsg string) error {
+	_, err := saveProcessEventInstance(ctx, s.client, &eventInstanceRecord{
+		ID:       eventInstanceID,
+		TenantID: tenantID,
+		Status:   status,
+		Error:    errorMsg,
+	})
+	if err != nil {
+		return fmt.Errorf("更新事件实例状态失败: %w", err)
+	}
 	return nil
 }
 
-// getEventDefinition 获取事件定义
-func (s *BPMNEventService) getEventDefinition(ctx context.Context, eventDefinitionID string, tenantID int) (*EventDefinition, error) {
-	// 这里应该从数据库或配置中获取事件定义
-	// 简化实现，返回模拟数据
-	eventDef := &EventDefinition{
-		ID:          eventDefinitionID,
-		Type:        EventStart,
-		Trigger:     TriggerNone,
-		Name:        "Default Event",
-		Description: "Default event description",
-		Position:    Position{X: 0, Y: 0},
-		Incoming:    []string{},
-		Outgoing:    []string{"flow_1"},
-		Properties:  map[string]interface{}{},
+// toEventInstance 持久化记录转换为事件实例
+func toEventInstance(record *ent.ProcessEventInstance) *EventInstance {
+	return &EventInstance{
+		ID:                record.EventInstanceID,
+		EventDefinitionID: record.EventDefinitionID,
+		ProcessInstanceID: record.ProcessInstanceID,
+		Status:            record.Status,
+		TriggeredAt:       record.TriggeredAt,
+		CompletedAt:       record.CompletedAt,
+		Variables:         record.Variables,
+		Error:             record.Error,
+		RetryCount:        record.RetryCount,
+		TenantID:          record.TenantID,
+		CreatedAt:         record.CreatedAt,
+		UpdatedAt:         record.UpdatedAt,
 	}
+}
 
-	return eventDef, nil
+// getEventDefinition 从 BPMN 定义中解析事件元素。
+// 指定流程实例时使用实例绑定的定义版本；否则在租户当前生效的流程定义中查找（如开始事件）。
+func (s *BPMNEventService) getEventDefinition(ctx context.Context, req *EventTriggerRequest) (*EventDefinition, error) {
+	parser := NewBPMNParser()
+	var candidates []*ent.ProcessDefinition
+	if req.ProcessInstanceID != "" {
+		instance, err := s.client.ProcessInstance.Query().
+			Where(processinstance.ProcessInstanceID(req.ProcessInstanceID), processinstance.TenantID(req.TenantID)).
+			Only(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("获取流程实例失败: %w", err)
+		}
+		definition, err := s.client.ProcessDefinition.Query().
+			Where(processdefinition.ID(instance.ProcessDefinitionID), processdefinition.TenantID(req.TenantID)).
+			Only(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("获取流程定义失败: %w", err)
+		}
+		candidates = append(candidates, definition)
+	} else {
+		definitions, err := s.client.ProcessDefinition.Query().
+			Where(
+				processdefinition.TenantID(req.TenantID),
+				processdefinition.IsActive(true),
+				processdefinition.IsLatest(true),
+			).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("查询流程定义失败: %w", err)
+		}
+		candidates = definitions
+	}
+	for _, definition := range candidates {
+		parsed, err := parser.ParseXML(definition.BpmnXML)
+		if err != nil {
+			continue
+		}
+		for _, process := range parsed.Processes {
+			if eventDef := buildEventDefinition(process, req.EventDefinitionID); eventDef != nil {
+				return eventDef, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("事件定义 %s 不存在", req.EventDefinitionID)
+}
+
+// buildEventDefinition 将流程中的事件元素（含接收任务）转换为 EventDefinition，未找到返回 nil
+func buildEventDefinition(process *BPMNProcess, elementID string) *EventDefinition {
+	eventDef := &EventDefinition{ID: elementID, Trigger: TriggerNone, Properties: map[string]interface{}{}}
+	setTimer := func(timerRef string, def *BPMNTimerEventDefinition) bool {
+		if def == nil && timerRef == "" {
+			return false
+		}
+		eventDef.Trigger = TriggerTimer
+		if def != nil {
+			eventDef.Properties["timeDuration"] = def.TimeDuration
+			eventDef.Properties["timeDate"] = def.TimeDate
+			eventDef.Properties["timeCycle"] = def.TimeCycle
+		} else {
+			// timerRef 的类型（周期/时长/日期）由 parseBPMNTimerSpec 按表达式识别，不能归为时长
+			eventDef.Properties["timerRef"] = timerRef
+		}
+		return true
+	}
+	setMessageOrSignal := func(messageRef string, messageDef *BPMNMessageEventDefinition, signalRef string, signalDef *BPMNSignalEventDefinition) {
+		switch eventType, name := catchEventTrigger(process, messageRef, messageDef, signalRef, signalDef); eventType {
+		case bpmnEventMessage:
+			eventDef.Trigger = TriggerMessage
+			eventDef.Properties["messageName"] = name
+		case bpmnEventSignal:
+			eventDef.Trigger = TriggerSignal
+			eventDef.Properties["signalName"] = name
+		}
+	}
+
+	setError := func(errorRef string) {
+		eventDef.Trigger = TriggerError
+		eventDef.Properties["errorRef"] = errorRef
+		eventDef.Properties["errorCode"] = process.ErrorCode(errorRef)
+	}
+
+	found := false
+	for _, event := range process.StartEvents {
+		if event.ID == elementID {
+			found = true
+			eventDef.Type, eventDef.Name = EventStart, event.Name
+			if !setTimer(event.TimerRef, event.TimerDefinition) {
+				setMessageOrSignal(event.MessageRef, event.MessageDefinition, event.SignalRef, event.SignalDefinition)
+			}
+		}
+	}
+	for _, event := range process.IntermediateEvents {
+		if event.ID == elementID {
+			found = true
+			eventDef.Type, eventDef.Name = EventIntermediate, event.Name
+			if !setTimer(event.TimerRef, event.TimerDefinition) {
+				setMessageOrSignal(event.MessageRef, event.MessageDefinition, event.SignalRef, event.SignalDefinition)
+			}
+		}
+	}
+	for _, task := range process.ReceiveTasks {
+		if task.ID == elementID {
+			found = true
+			eventDef.Type, eventDef.Name, eventDef.Trigger = EventIntermediate, task.Name, TriggerMessage
+			eventDef.Properties["messageName"] = process.MessageName(task.MessageRef)
+		}
+	}
+	for _, event := range process.BoundaryEvents {
+		if event.ID == elementID {
+			found = true
+			eventDef.Type, eventDef.Name = EventBoundary, event.Name
+			eventDef.Properties["attachedToRef"] = event.AttachedToRef
+			eventDef.Properties["cancelActivity"] = event.IsInterrupting()
+			switch errorRef, isError := eventErrorRef(event.ErrorRef, event.ErrorDefinition); {
+			case setTimer(event.TimerRef, event.TimerDefinition):
+			case isError:
+				setError(errorRef)
+			case event.CompensateDefinition != nil:
+				eventDef.Trigger = TriggerCompensate
+			default:
+				setMessageOrSignal(event.MessageRef, event.MessageDefinition, event.SignalRef, event.SignalDefinition)
+			}
+		}
+	}
+	for _, event := range process.IntermediateThrowEvents {
+		if event.ID == elementID {
+			found = true
+			eventDef.Type, eventDef.Name = EventIntermediate, event.Name
+			if def := event.CompensateDefinition; def != nil {
+				eventDef.Trigger = TriggerCompensate
+				eventDef.Properties["activityRef"] = def.ActivityRef
+			}
+		}
+	}
+	for _, event := range process.EndEvents {
+		if event.ID == elementID {
+			found = true
+			eventDef.Type, eventDef.Name = EventEnd, event.Name
+			switch errorRef, isError := eventErrorRef(event.ErrorRef, event.ErrorDefinition); {
+			case event.EventType == string(TriggerTerminate):
+				eventDef.Trigger = TriggerTerminate
+			case isError:
+				setError(errorRef)
+			case event.CompensateDefinition != nil:
+				eventDef.Trigger = TriggerCompensate
+				eventDef.Properties["activityRef"] = event.CompensateDefinition.ActivityRef
+			default:
+				setMessageOrSignal(event.MessageRef, nil, event.SignalRef, nil)
+			}
+		}
+	}
+	if !found {
+		return nil
+	}
+	eventDef.Incoming = []string{}
+	eventDef.Outgoing = []string{}
+	for _, flow := range process.SequenceFlows {
+		if flow.TargetRef == elementID {
+			eventDef.Incoming = append(eventDef.Incoming, flow.ID)
+		}
+		if flow.SourceRef == elementID {
+			eventDef.Outgoing = append(eventDef.Outgoing, flow.ID)
+			eventDef.targets = append(eventDef.targets, flow.TargetRef)
+		}
+	}
+	return eventDef
 }
 
 // createProcessInstanceFromStartEvent 从开始事件创建流程实例
@@ -393,11 +709,56 @@ func (s *BPMNEventService) interruptCurrentActivity(ctx context.Context, process
 	return nil
 }
 
-// GetEventInstances 获取事件实例列表
+// GetEventInstances 获取事件实例列表（消息关联、信号广播与事件触发记录）
 func (s *BPMNEventService) GetEventInstances(ctx context.Context, req *ListEventInstancesRequest) ([]*EventInstance, int, error) {
-	// 这里应该查询事件实例列表
-	// 简化实现，返回空列表
-	return []*EventInstance{}, 0, nil
+	if req.TenantID <= 0 {
+		return nil, 0, fmt.Errorf("缺少有效租户上下文")
+	}
+	query := s.client.ProcessEventInstance.Query().
+		Where(processeventinstance.TenantID(req.TenantID))
+	if req.EventDefinitionID != "" {
+		query = query.Where(processeventinstance.EventDefinitionID(req.EventDefinitionID))
+	}
+	if req.ProcessInstanceID != "" {
+		query = query.Where(processeventinstance.ProcessInstanceID(req.ProcessInstanceID))
+	}
+	if req.Status != "" {
+		query = query.Where(processeventinstance.Status(req.Status))
+	}
+	if req.Trigger != "" {
+		query = query.Where(processeventinstance.Trigger(req.Trigger))
+	}
+	if !req.StartTime.IsZero() {
+		query = query.Where(processeventinstance.CreatedAtGTE(req.StartTime))
+	}
+	if !req.EndTime.IsZero() {
+		query = query.Where(processeventinstance.CreatedAtLTE(req.EndTime))
+	}
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("统计事件实例失败: %w", err)
+	}
+	page, pageSize := req.Page, req.PageSize
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+	records, err := query.
+		Order(ent.Desc(processeventinstance.FieldCreatedAt), ent.Desc(processeventinstance.FieldID)).
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("查询事件实例失败: %w", err)
+	}
+	instances := make([]*EventInstance, 0, len(records))
+	for _, record := range records {
+		instances = append(instances, toEventInstance(record))
+	}
+	return instances, total, nil
 }
 
 // ListEventInstancesRequest 查询事件实例请求
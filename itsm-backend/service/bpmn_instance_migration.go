@@ -0,0 +1,740 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"itsm-backend/ent"
+	"itsm-backend/ent/operationalcommand"
+	"itsm-backend/ent/processdefinition"
+	"itsm-backend/ent/processeventsubscription"
+	"itsm-backend/ent/processincident"
+	"itsm-backend/ent/processinstance"
+	"itsm-backend/ent/processtask"
+	"itsm-backend/internal/commandbus"
+)
+
+// BPMN 流程实例迁移
+//
+// 把运行中的流程实例从一个流程定义版本迁移到同一流程的另一个版本：
+//   - 迁移计划由源活动ID → 目标活动ID 的映射指令组成；未写指令的活动若在目标版本中存在同ID、同类型的元素则按同ID映射
+//   - 计划先对照两个版本的解析结果校验（元素存在、类型一致），再逐个实例校验：实例当前所在的活动
+//     （待办任务、等待中的事件/子流程/调用活动/异步任务）都必须能映射，且映射后仍处于对应的子流程作用域内
+//   - 迁移时改写实例的流程定义、待办任务的任务定义Key、引擎状态变量（_done_ 汇聚状态、_waiting_、
+//     可补偿活动）、事件订阅、定时器与异步作业、未解决的事故以及子实例记录的调用活动；业务变量原样保留
+//   - 目标版本新增在活动上的边界事件会在迁移后激活；目标版本中不存在的边界事件随之撤销
+//   - 每个实例在独立事务中迁移，单个实例失败不影响其它实例；DryRun 只校验并返回报告
+
+// ErrInvalidMigrationPlan 迁移计划本身不合法（版本不匹配、活动不存在或类型不一致）
+var ErrInvalidMigrationPlan = errors.New("invalid process migration plan")
+
+// ProcessMigrationInstruction 迁移指令：源版本活动 → 目标版本活动
+type ProcessMigrationInstruction struct {
+	SourceActivityID string `json:"sourceActivityId" binding:"required"`
+	TargetActivityID string `json:"targetActivityId" binding:"required"`
+}
+
+// ProcessMigrationRequest 流程实例迁移请求
+type ProcessMigrationRequest struct {
+	SourceDefinitionID int                           `json:"sourceDefinitionId" binding:"required"`
+	TargetDefinitionID int                           `json:"targetDefinitionId" binding:"required"`
+	Instructions       []ProcessMigrationInstruction `json:"instructions"`
+	// ProcessInstanceIDs 指定要迁移的实例，为空时迁移源版本上全部运行中/挂起的实例
+	ProcessInstanceIDs []string `json:"processInstanceIds"`
+	// Variables 迁移时写入实例的变量（例如目标版本新增的必填变量）
+	Variables map[string]interface{} `json:"variables"`
+	DryRun    bool                   `json:"dryRun"`
+}
+
+// ProcessMigrationReport 迁移报告
+type ProcessMigrationReport struct {
+	SourceDefinitionID int                               `json:"sourceDefinitionId"`
+	TargetDefinitionID int                               `json:"targetDefinitionId"`
+	SourceVersion      string                            `json:"sourceVersion"`
+	TargetVersion      string                            `json:"targetVersion"`
+	DryRun             bool                              `json:"dryRun"`
+	Total              int                               `json:"total"`
+	Migratable         int                               `json:"migratable"`
+	Migrated           int                               `json:"migrated"`
+	Failed             int                               `json:"failed"`
+	Warnings           []string                          `json:"warnings,omitempty"`
+	Instances          []*ProcessInstanceMigrationResult `json:"instances"`
+}
+
+// ProcessInstanceMigrationResult 单个实例的迁移结果
+type ProcessInstanceMigrationResult struct {
+	ProcessInstanceID string            `json:"processInstanceId"`
+	BusinessKey       string            `json:"businessKey,omitempty"`
+	Status            string            `json:"status"`
+	ActiveActivities  map[string]string `json:"activeActivities"` // 源活动ID → 目标活动ID
+	OpenTasks         int               `json:"openTasks"`
+	Migratable        bool              `json:"migratable"`
+	Migrated          bool              `json:"migrated"`
+	Errors            []string          `json:"errors,omitempty"`
+	Warnings          []string          `json:"warnings,omitempty"`
+}
+
+// processMigrationPlan 已校验的迁移计划
+type processMigrationPlan struct {
+	source, target               *ent.ProcessDefinition
+	sourceProcess, targetProcess *BPMNProcess
+	sourceTypes, targetTypes     map[string]string
+	instructions                 map[string]string
+}
+
+// mapActivity 源活动在目标版本中的对应活动：显式指令优先，其次为同ID、同类型的元素
+func (p *processMigrationPlan) mapActivity(id string) (string, bool) {
+	if target, ok := p.instructions[id]; ok {
+		return target, true
+	}
+	if sourceType, ok := p.sourceTypes[id]; ok && p.targetTypes[id] == sourceType {
+		return id, true
+	}
+	return "", false
+}
+
+// mapBoundary 边界事件的对应边界事件：除类型外还要求挂载到对应的活动上
+func (p *processMigrationPlan) mapBoundary(e *CustomProcessEngine, id string) (string, bool) {
+	targetID, ok := p.mapActivity(id)
+	if !ok {
+		return "", false
+	}
+	source := e.findBoundaryEvent(p.sourceProcess, id)
+	target := e.findBoundaryEvent(p.targetProcess, targetID)
+	if source == nil || target == nil {
+		return "", false
+	}
+	attachedTo, ok := p.mapActivity(source.AttachedToRef)
+	return targetID, ok && attachedTo == target.AttachedToRef
+}
+
+// scopeID 元素所在嵌入式子流程的ID，顶层元素返回空串
+func scopeID(process *BPMNProcess, elementID string) string {
+	if sub := process.subProcessOf(elementID); sub != nil {
+		return sub.ID
+	}
+	return ""
+}
+
+// MigrateProcessInstances 按迁移计划把实例迁移到目标版本；DryRun 时只校验并返回报告
+func (e *CustomProcessEngine) MigrateProcessInstances(ctx context.Context, req *ProcessMigrationRequest) (*ProcessMigrationReport, error) {
+	tenantID, err := requireBPMNTenantContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, fmt.Errorf("%w: 迁移请求不能为空", ErrInvalidMigrationPlan)
+	}
+	for name := range req.Variables {
+		if engineStateVariables[name] {
+			return nil, fmt.Errorf("%w: 不允许写入引擎状态变量 %s", ErrInvalidMigrationPlan, name)
+		}
+	}
+	plan, err := e.buildMigrationPlan(ctx, tenantID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	query := e.client.ProcessInstance.Query().
+		Where(
+			processinstance.TenantID(tenantID),
+			processinstance.ProcessDefinitionID(plan.source.ID),
+			processinstance.StatusIn("running", "suspended"),
+		).
+		Order(ent.Asc(processinstance.FieldID))
+	if len(req.ProcessInstanceIDs) > 0 {
+		query = query.Where(processinstance.ProcessInstanceIDIn(req.ProcessInstanceIDs...))
+	}
+	instances, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询待迁移流程实例失败: %w", err)
+	}
+
+	report := &ProcessMigrationReport{
+		SourceDefinitionID: plan.source.ID,
+		TargetDefinitionID: plan.target.ID,
+		SourceVersion:      plan.source.Version,
+		TargetVersion:      plan.target.Version,
+		DryRun:             req.DryRun,
+		Total:              len(instances),
+		Warnings:           e.migrationPlanWarnings(plan),
+		Instances:          make([]*ProcessInstanceMigrationResult, 0, len(instances)),
+	}
+	found := make(map[string]bool, len(instances))
+	for _, instance := range instances {
+		found[instance.ProcessInstanceID] = true
+		var result *ProcessInstanceMigrationResult
+		if req.DryRun {
+			result, _ = e.prepareInstanceMigration(ctx, e.client, plan, instance)
+		} else {
+			result = e.migrateInstance(ctx, plan, instance, req.Variables)
+		}
+		if result.Migratable {
+			report.Migratable++
+		}
+		if result.Migrated {
+			report.Migrated++
+		}
+		if len(result.Errors) > 0 {
+			report.Failed++
+		}
+		report.Instances = append(report.Instances, result)
+	}
+	// 显式指定但不在源版本上运行的实例
+	for _, id := range req.ProcessInstanceIDs {
+		if !found[id] {
+			report.Total++
+			report.Failed++
+			report.Instances = append(report.Instances, &ProcessInstanceMigrationResult{
+				ProcessInstanceID: id,
+				Errors:            []string{"流程实例不存在、已结束或不在源版本上"},
+			})
+		}
+	}
+	return report, nil
+}
+
+// buildMigrationPlan 加载并解析两个版本，校验迁移指令
+func (e *CustomProcessEngine) buildMigrationPlan(ctx context.Context, tenantID int, req *ProcessMigrationRequest) (*processMigrationPlan, error) {
+	if req.SourceDefinitionID == req.TargetDefinitionID {
+		return nil, fmt.Errorf("%w: 源版本与目标版本相同", ErrInvalidMigrationPlan)
+	}
+	plan := &processMigrationPlan{instructions: make(map[string]string, len(req.Instructions))}
+	load := func(id int) (*ent.ProcessDefinition, *BPMNProcess, error) {
+		definition, err := e.client.ProcessDefinition.Query().
+			Where(processdefinition.ID(id), processdefinition.TenantID(tenantID)).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			return nil, nil, fmt.Errorf("%w: 流程定义 %d 不存在", ErrInvalidMigrationPlan, id)
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("获取流程定义失败: %w", err)
+		}
+		definitions, err := e.parser.ParseXML(definition.BpmnXML)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: 解析流程定义 %d 失败: %v", ErrInvalidMigrationPlan, id, err)
+		}
+		return definition, definitions.Processes[0], nil
+	}
+	var err error
+	if plan.source, plan.sourceProcess, err = load(req.SourceDefinitionID); err != nil {
+		return nil, err
+	}
+	if plan.target, plan.targetProcess, err = load(req.TargetDefinitionID); err != nil {
+		return nil, err
+	}
+	if plan.source.Key != plan.target.Key {
+		return nil, fmt.Errorf("%w: 只能在同一流程（%s）的版本之间迁移，目标为 %s", ErrInvalidMigrationPlan, plan.source.Key, plan.target.Key)
+	}
+	plan.sourceTypes = map[string]string{}
+	collectMigrationElementTypes(plan.sourceProcess, plan.sourceTypes)
+	plan.targetTypes = map[string]string{}
+	collectMigrationElementTypes(plan.targetProcess, plan.targetTypes)
+
+	var problems []string
+	for _, instruction := range req.Instructions {
+		sourceType, ok := plan.sourceTypes[instruction.SourceActivityID]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("源版本中不存在活动 %s", instruction.SourceActivityID))
+			continue
+		}
+		targetType, ok := plan.targetTypes[instruction.TargetActivityID]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("目标版本中不存在活动 %s", instruction.TargetActivityID))
+			continue
+		}
+		if sourceType != targetType {
+			problems = append(problems, fmt.Sprintf("活动 %s（%s）不能映射到 %s（%s）", instruction.SourceActivityID, sourceType, instruction.TargetActivityID, targetType))
+			continue
+		}
+		if existing, dup := plan.instructions[instruction.SourceActivityID]; dup && existing != instruction.TargetActivityID {
+			problems = append(problems, fmt.Sprintf("活动 %s 存在多条迁移指令", instruction.SourceActivityID))
+			continue
+		}
+		plan.instructions[instruction.SourceActivityID] = instruction.TargetActivityID
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidMigrationPlan, strings.Join(problems, "; "))
+	}
+	return plan, nil
+}
+
+// migrationPlanWarnings 计划级提示：目标版本汇聚网关的入边分支在源版本中没有对应活动时，迁移后汇聚可能一直等待
+func (e *CustomProcessEngine) migrationPlanWarnings(plan *processMigrationPlan) []string {
+	mapped := make(map[string]bool, len(plan.sourceTypes))
+	for id := range plan.sourceTypes {
+		if target, ok := plan.mapActivity(id); ok {
+			mapped[target] = true
+		}
+	}
+	var warnings []string
+	for id, elementType := range plan.targetTypes {
+		if elementType != "parallelGateway" && elementType != "inclusiveGateway" {
+			continue
+		}
+		incoming := e.findIncomingFlows(plan.targetProcess, id)
+		if len(incoming) < 2 {
+			continue
+		}
+		for _, flow := range incoming {
+			if !mapped[flow.SourceRef] {
+				warnings = append(warnings, fmt.Sprintf("汇聚网关 %s 的入边分支 %s 在源版本中没有对应活动，已越过该分支的实例可能无法完成汇聚", id, flow.SourceRef))
+			}
+		}
+	}
+	sort.Strings(warnings)
+	return warnings
+}
+
+// instanceMigration 单个实例迁移要改写的内容
+type instanceMigration struct {
+	instance      *ent.ProcessInstance
+	activities    map[string]string // 活跃活动：源 → 目标
+	boundaries    map[string]string // 可保留的边界事件：源 → 目标
+	tasks         []*ent.ProcessTask
+	subscriptions []*ent.ProcessEventSubscription
+	commands      []*ent.OperationalCommand
+	incidents     []*ent.ProcessIncident
+	children      []*ent.ProcessInstance
+}
+
+// prepareInstanceMigration 收集实例的运行时状态并逐项校验能否迁移
+func (e *CustomProcessEngine) prepareInstanceMigration(ctx context.Context, client *ent.Client, plan *processMigrationPlan, instance *ent.ProcessInstance) (*ProcessInstanceMigrationResult, *instanceMigration) {
+	result := &ProcessInstanceMigrationResult{
+		ProcessInstanceID: instance.ProcessInstanceID,
+		BusinessKey:       instance.BusinessKey,
+		Status:            instance.Status,
+		ActiveActivities:  map[string]string{},
+	}
+	fail := func(format string, args ...interface{}) (*ProcessInstanceMigrationResult, *instanceMigration) {
+		result.Errors = append(result.Errors, fmt.Sprintf(format, args...))
+		return result, nil
+	}
+	m := &instanceMigration{instance: instance, activities: map[string]string{}, boundaries: map[string]string{}}
+
+	var err error
+	m.tasks, err = client.ProcessTask.Query().
+		Where(
+			processtask.TenantID(instance.TenantID),
+			processtask.ProcessInstanceID(instance.ID),
+			processtask.StatusNotIn("completed", "cancelled"),
+		).
+		All(ctx)
+	if err != nil {
+		return fail("查询待办任务失败: %v", err)
+	}
+	m.subscriptions, err = client.ProcessEventSubscription.Query().
+		Where(
+			processeventsubscription.TenantID(instance.TenantID),
+			processeventsubscription.ProcessInstanceID(instance.ID),
+			processeventsubscription.StatusEQ(bpmnSubscriptionWaiting),
+		).
+		All(ctx)
+	if err != nil {
+		return fail("查询事件订阅失败: %v", err)
+	}
+	m.commands, err = client.OperationalCommand.Query().
+		Where(
+			operationalcommand.TenantID(instance.TenantID),
+			operationalcommand.CommandTypeIn(commandbus.CommandFireBPMNTimer, commandbus.CommandExecuteBPMNJob),
+			operationalcommand.AggregateType(bpmnTimerAggregateInstance),
+			operationalcommand.AggregateID(instance.ID),
+			operationalcommand.StatusIn(commandbus.StatusPending, commandbus.StatusProcessing),
+		).
+		All(ctx)
+	if err != nil {
+		return fail("查询定时器与异步作业失败: %v", err)
+	}
+	m.incidents, err = client.ProcessIncident.Query().
+		Where(
+			processincident.TenantID(instance.TenantID),
+			processincident.ProcessInstanceID(instance.ID),
+			processincident.StatusEQ(bpmnIncidentOpen),
+		).
+		All(ctx)
+	if err != nil {
+		return fail("查询事故失败: %v", err)
+	}
+	m.children, err = client.ProcessInstance.Query().
+		Where(
+			processinstance.TenantID(instance.TenantID),
+			processinstance.ParentProcessInstanceID(instance.ProcessInstanceID),
+			processinstance.StatusIn("running", "suspended"),
+		).
+		All(ctx)
+	if err != nil {
+		return fail("查询子流程实例失败: %v", err)
+	}
+	result.OpenTasks = len(m.tasks)
+
+	// 活跃活动：待办任务与等待中的元素
+	active := map[string]bool{}
+	for _, task := range m.tasks {
+		active[task.TaskDefinitionKey] = true
+	}
+	waiting, _ := instance.Variables["_waiting_"].(map[string]interface{})
+	for id, v := range waiting {
+		if v == true {
+			active[id] = true
+		}
+	}
+	for id := range active {
+		targetID, ok := plan.mapActivity(id)
+		if !ok {
+			result.Errors = append(result.Errors, fmt.Sprintf("活动 %s 在目标版本中没有对应的活动", id))
+			continue
+		}
+		m.activities[id] = targetID
+		result.ActiveActivities[id] = targetID
+	}
+	// 活跃活动映射后必须仍位于对应的子流程作用域内
+	for id, targetID := range m.activities {
+		sourceScope, targetScope := scopeID(plan.sourceProcess, id), scopeID(plan.targetProcess, targetID)
+		mappedScope := ""
+		if sourceScope != "" {
+			mappedScope = m.activities[sourceScope]
+		}
+		if mappedScope != targetScope {
+			result.Errors = append(result.Errors, fmt.Sprintf("活动 %s 映射到 %s 后所在的子流程作用域不一致", id, targetID))
+		}
+	}
+
+	// 运行时记录所引用的元素：活跃活动本身，或挂载在活跃活动上的边界事件
+	resolve := func(kind, elementID string) bool {
+		if _, ok := m.activities[elementID]; ok {
+			return true
+		}
+		if plan.sourceTypes[elementID] != "" && strings.HasPrefix(plan.sourceTypes[elementID], ActivityTypeBoundaryEvent) {
+			if targetID, ok := plan.mapBoundary(e, elementID); ok {
+				m.boundaries[elementID] = targetID
+			} else {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("边界事件 %s 在目标版本中没有对应的边界事件，其%s将被撤销", elementID, kind))
+			}
+			return true
+		}
+		result.Errors = append(result.Errors, fmt.Sprintf("%s引用的元素 %s 不是活跃活动", kind, elementID))
+		return false
+	}
+	for _, sub := range m.subscriptions {
+		resolve("事件订阅", sub.ElementID)
+	}
+	for _, cmd := range m.commands {
+		elementID, _ := cmd.Payload["element_id"].(string)
+		if cmd.Status == commandbus.StatusProcessing {
+			result.Errors = append(result.Errors, fmt.Sprintf("元素 %s 的作业正在执行，请稍后重试", elementID))
+			continue
+		}
+		resolve("定时器/作业", elementID)
+	}
+	for _, incident := range m.incidents {
+		resolve("事故", incident.ElementID)
+	}
+	for _, child := range m.children {
+		if activityID, _ := child.Variables[bpmnCallActivityVariable].(string); activityID != "" {
+			resolve("子流程实例", activityID)
+		}
+	}
+
+	// 已完成活动的补偿记录：目标版本中没有对应活动时无法再补偿
+	compensable, _ := instance.Variables[bpmnCompensableVariable].([]interface{})
+	for _, v := range compensable {
+		if id, _ := v.(string); id != "" {
+			if _, ok := plan.mapActivity(id); !ok {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("已完成活动 %s 在目标版本中没有对应活动，迁移后不再补偿", id))
+			}
+		}
+	}
+
+	sort.Strings(result.Errors)
+	sort.Strings(result.Warnings)
+	if len(result.Errors) > 0 {
+		return result, nil
+	}
+	result.Migratable = true
+	return result, m
+}
+
+// migrateInstance 在独立事务中重新校验并迁移单个实例
+func (e *CustomProcessEngine) migrateInstance(ctx context.Context, plan *processMigrationPlan, instance *ent.ProcessInstance, variables map[string]interface{}) *ProcessInstanceMigrationResult {
+	tx, err := e.client.Tx(ctx)
+	if err != nil {
+		return &ProcessInstanceMigrationResult{ProcessInstanceID: instance.ProcessInstanceID, Errors: []string{fmt.Sprintf("开启事务失败: %v", err)}}
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+	txc := tx.Client()
+
+	// 事务内重新读取，避免与并发推进的流程交错
+	current, err := txc.ProcessInstance.Get(ctx, instance.ID)
+	if err != nil || current.ProcessDefinitionID != plan.source.ID {
+		_ = tx.Rollback()
+		return &ProcessInstanceMigrationResult{ProcessInstanceID: instance.ProcessInstanceID, Errors: []string{"流程实例已变化，不在源版本上"}}
+	}
+	result, m := e.prepareInstanceMigration(ctx, txc, plan, current)
+	if m == nil {
+		_ = tx.Rollback()
+		return result
+	}
+	if err := e.applyInstanceMigration(ctx, txc, plan, m, variables); err != nil {
+		_ = tx.Rollback()
+		result.Migratable = false
+		result.Errors = append(result.Errors, err.Error())
+		return result
+	}
+	if err := tx.Commit(); err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("提交事务失败: %v", err))
+		return result
+	}
+	result.Migrated = true
+	e.logger.Infow("流程实例已迁移", "instance", current.ProcessInstanceID,
+		"from", plan.source.ID, "to", plan.target.ID, "activities", result.ActiveActivities)
+	return result
+}
+
+// applyInstanceMigration 改写实例及其运行时记录
+func (e *CustomProcessEngine) applyInstanceMigration(ctx context.Context, txc *ent.Client, plan *processMigrationPlan, m *instanceMigration, variables map[string]interface{}) error {
+	instance := m.instance
+	mapElement := func(id string) (string, bool) {
+		if target, ok := m.activities[id]; ok {
+			return target, true
+		}
+		target, ok := m.boundaries[id]
+		return target, ok
+	}
+
+	for _, task := range m.tasks {
+		targetID := m.activities[task.TaskDefinitionKey]
+		update := txc.ProcessTask.UpdateOne(task).SetTaskDefinitionKey(targetID)
+		if userTask := e.findUserTask(plan.targetProcess, targetID); userTask != nil && userTask.Name != "" {
+			update = update.SetTaskName(userTask.Name)
+		}
+		if _, err := update.Save(ctx); err != nil {
+			return fmt.Errorf("迁移任务 %s 失败: %w", task.TaskID, err)
+		}
+	}
+
+	for _, sub := range m.subscriptions {
+		targetID, ok := mapElement(sub.ElementID)
+		if !ok {
+			if _, err := txc.ProcessEventSubscription.UpdateOne(sub).SetStatus(bpmnSubscriptionCancelled).Save(ctx); err != nil {
+				return fmt.Errorf("撤销事件订阅失败: %w", err)
+			}
+			continue
+		}
+		update := txc.ProcessEventSubscription.UpdateOne(sub).SetElementID(targetID)
+		if boundary := e.findBoundaryEvent(plan.targetProcess, targetID); boundary != nil {
+			eventType, eventName := boundaryEventTrigger(plan.targetProcess, boundary)
+			update = update.SetAttachedTo(boundary.AttachedToRef).SetInterrupting(boundary.IsInterrupting())
+			if eventType != "" && eventName != "" {
+				update = update.SetEventType(eventType).SetEventName(eventName)
+			}
+		}
+		if _, err := update.Save(ctx); err != nil {
+			return fmt.Errorf("迁移事件订阅失败: %w", err)
+		}
+	}
+
+	for _, cmd := range m.commands {
+		elementID, _ := cmd.Payload["element_id"].(string)
+		targetID, ok := mapElement(elementID)
+		if !ok {
+			if _, err := txc.OperationalCommand.UpdateOne(cmd).SetStatus(commandbus.StatusCancelled).Save(ctx); err != nil {
+				return fmt.Errorf("撤销定时器失败: %w", err)
+			}
+			continue
+		}
+		if targetID == elementID {
+			continue
+		}
+		oldPrefix, newPrefix := timerIdempotencyPrefix(instance.ID, elementID), timerIdempotencyPrefix(instance.ID, targetID)
+		if cmd.CommandType == commandbus.CommandExecuteBPMNJob {
+			oldPrefix, newPrefix = asyncJobIdempotencyPrefix(instance.ID, elementID), asyncJobIdempotencyPrefix(instance.ID, targetID)
+		}
+		payload := make(map[string]interface{}, len(cmd.Payload))
+		for k, v := range cmd.Payload {
+			payload[k] = v
+		}
+		payload["element_id"] = targetID
+		if _, err := txc.OperationalCommand.UpdateOne(cmd).
+			SetPayload(payload).
+			SetIdempotencyKey(newPrefix + strings.TrimPrefix(cmd.IdempotencyKey, oldPrefix)).
+			Save(ctx); err != nil {
+			return fmt.Errorf("迁移定时器/作业失败: %w", err)
+		}
+	}
+
+	for _, incident := range m.incidents {
+		if targetID, ok := mapElement(incident.ElementID); ok && targetID != incident.ElementID {
+			if _, err := txc.ProcessIncident.UpdateOne(incident).SetElementID(targetID).Save(ctx); err != nil {
+				return fmt.Errorf("迁移事故失败: %w", err)
+			}
+		}
+	}
+
+	for _, child := range m.children {
+		activityID, _ := child.Variables[bpmnCallActivityVariable].(string)
+		targetID, ok := m.activities[activityID]
+		if !ok || targetID == activityID {
+			continue
+		}
+		childVars := make(map[string]interface{}, len(child.Variables))
+		for k, v := range child.Variables {
+			childVars[k] = v
+		}
+		childVars[bpmnCallActivityVariable] = targetID
+		if _, err := txc.ProcessInstance.UpdateOne(child).SetVariables(childVars).SetVersion(child.Version + 1).Save(ctx); err != nil {
+			return fmt.Errorf("迁移子流程实例 %s 失败: %w", child.ProcessInstanceID, err)
+		}
+	}
+
+	// 变量：业务变量原样保留，引擎状态按映射改写
+	migrated := make(map[string]interface{}, len(instance.Variables)+len(variables))
+	for k, v := range instance.Variables {
+		migrated[k] = v
+	}
+	for k, v := range variables {
+		migrated[k] = v
+	}
+	for _, name := range []string{"_done_", "_waiting_", bpmnMultiInstanceVariable} {
+		state, ok := instance.Variables[name].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		remapped := make(map[string]interface{}, len(state))
+		for id, v := range state {
+			if targetID, ok := plan.mapActivity(id); ok {
+				remapped[targetID] = v
+			}
+		}
+		migrated[name] = remapped
+	}
+	if compensable, ok := instance.Variables[bpmnCompensableVariable].([]interface{}); ok {
+		remapped := make([]interface{}, 0, len(compensable))
+		for _, v := range compensable {
+			id, _ := v.(string)
+			if targetID, ok := plan.mapActivity(id); ok {
+				remapped = append(remapped, targetID)
+			}
+		}
+		migrated[bpmnCompensableVariable] = remapped
+	}
+
+	update := txc.ProcessInstance.UpdateOne(instance).
+		SetProcessDefinitionID(plan.target.ID).
+		SetVariables(migrated).
+		SetVersion(instance.Version + 1)
+	if targetID, ok := plan.mapActivity(instance.CurrentActivityID); ok && instance.CurrentActivityID != "" {
+		update = update.SetCurrentActivityID(targetID)
+		if userTask := e.findUserTask(plan.targetProcess, targetID); userTask != nil && userTask.Name != "" {
+			update = update.SetCurrentActivityName(userTask.Name)
+		}
+	}
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return fmt.Errorf("更新流程实例失败: %w", err)
+	}
+	*instance = *updated
+
+	// 激活目标版本在活跃活动上新增的边界事件（已迁移的订阅与定时器不会重复登记）
+	if instance.Status == "running" {
+		targets := make([]string, 0, len(m.activities))
+		for _, targetID := range m.activities {
+			targets = append(targets, targetID)
+		}
+		sort.Strings(targets)
+		for _, targetID := range targets {
+			if err := e.enterActivityBoundaries(ctx, txc, instance, plan.targetProcess, targetID); err != nil {
+				return err
+			}
+		}
+	}
+	e.recordScopeHistory(ctx, txc, instance, plan.targetProcess.ID, ActivityTypeProcess, "instance.migrated",
+		fmt.Sprintf("流程定义 %d（版本 %s）→ %d（版本 %s）", plan.source.ID, plan.source.Version, plan.target.ID, plan.target.Version))
+	return nil
+}
+
+// collectMigrationElementTypes 收集流程（含嵌入式子流程）全部流程节点的类型；事件类型附带触发方式，
+// 保证定时器事件只能映射到定时器事件、消息事件只能映射到消息事件
+func collectMigrationElementTypes(process *BPMNProcess, types map[string]string) {
+	for _, event := range process.StartEvents {
+		types[event.ID] = ActivityTypeStartEvent
+	}
+	for _, event := range process.EndEvents {
+		types[event.ID] = ActivityTypeEndEvent
+	}
+	// 多实例与单实例活动的运行状态不同，不能互相迁移
+	for _, task := range process.UserTasks {
+		types[task.ID] = ActivityTypeUserTask
+		if task.MultiInstance != nil {
+			types[task.ID] += ":multiInstance"
+		}
+	}
+	for _, task := range process.ServiceTasks {
+		types[task.ID] = ActivityTypeServiceTask
+		if task.MultiInstance != nil {
+			types[task.ID] += ":multiInstance"
+		}
+	}
+	for _, task := range process.ScriptTasks {
+		types[task.ID] = ActivityTypeScriptTask
+	}
+	for _, task := range process.BusinessRuleTasks {
+		types[task.ID] = ActivityTypeBusinessRuleTask
+	}
+	for _, task := range process.ManualTasks {
+		types[task.ID] = ActivityTypeManualTask
+	}
+	for _, task := range process.ReceiveTasks {
+		types[task.ID] = "receiveTask"
+	}
+	for _, activity := range process.CallActivities {
+		types[activity.ID] = ActivityTypeCallActivity
+	}
+	for _, gateway := range process.ExclusiveGateways {
+		types[gateway.ID] = "exclusiveGateway"
+	}
+	for _, gateway := range process.ParallelGateways {
+		types[gateway.ID] = "parallelGateway"
+	}
+	for _, gateway := range process.InclusiveGateways {
+		types[gateway.ID] = "inclusiveGateway"
+	}
+	for _, gateway := range process.EventBasedGateways {
+		types[gateway.ID] = "eventBasedGateway"
+	}
+	for _, event := range process.IntermediateEvents {
+		kind, _ := intermediateEventTrigger(process, event)
+		if event.TimerDefinition != nil || event.TimerRef != "" {
+			kind = "timer"
+		}
+		types[event.ID] = ActivityTypeIntermediateEvent + ":" + kind
+	}
+	for _, event := range process.IntermediateThrowEvents {
+		types[event.ID] = "intermediateThrowEvent"
+	}
+	for _, boundary := range process.BoundaryEvents {
+		kind, _ := boundaryEventTrigger(process, boundary)
+		switch {
+		case boundary.TimerDefinition != nil || boundary.TimerRef != "":
+			kind = "timer"
+		case boundary.CompensateDefinition != nil:
+			kind = "compensate"
+		default:
+			if _, isError := eventErrorRef(boundary.ErrorRef, boundary.ErrorDefinition); isError {
+				kind = "error"
+			}
+		}
+		types[boundary.ID] = ActivityTypeBoundaryEvent + ":" + kind
+	}
+	for _, sub := range process.SubProcesses {
+		types[sub.ID] = ActivityTypeSubProcess
+		collectMigrationElementTypes(&sub.BPMNProcess, types)
+	}
+}
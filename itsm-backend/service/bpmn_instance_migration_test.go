@@ -0,0 +1,221 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"itsm-backend/ent"
+	"itsm-backend/ent/processdefinition"
+	"itsm-backend/ent/processeventsubscription"
+	"itsm-backend/ent/processtask"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 变更流程 v1：评审后并行实施与验证，实施带 SLA 定时器
+const migrationV1BPMN = `<?xml version="1.0" encoding="UTF-8"?>
+<bpmn:definitions xmlns:bpmn="http://www.omg.org/spec/BPMN/20100524/MODEL" id="Definitions_mig" targetNamespace="http://bpmn.io/schema/bpmn">
+  <bpmn:process id="Process_change" name="Change" isExecutable="true">
+    <bpmn:startEvent id="StartEvent_1"/>
+    <bpmn:userTask id="Review" name="变更评审" assignee="1"/>
+    <bpmn:parallelGateway id="Fork"/>
+    <bpmn:userTask id="Implement" name="实施" assignee="1"/>
+    <bpmn:boundaryEvent id="Implement_Timeout" attachedToRef="Implement" cancelActivity="false">
+      <bpmn:timerEventDefinition><bpmn:timeDuration>PT4H</bpmn:timeDuration></bpmn:timerEventDefinition>
+    </bpmn:boundaryEvent>
+    <bpmn:userTask id="Verify" name="验证" assignee="1"/>
+    <bpmn:userTask id="Legacy" name="旧版人工登记" assignee="1"/>
+    <bpmn:parallelGateway id="Join"/>
+    <bpmn:endEvent id="EndEvent_1"/>
+    <bpmn:endEvent id="EndEvent_2"/>
+    <bpmn:sequenceFlow id="Flow_1" sourceRef="StartEvent_1" targetRef="Review"/>
+    <bpmn:sequenceFlow id="Flow_2" sourceRef="Review" targetRef="Fork"/>
+    <bpmn:sequenceFlow id="Flow_3" sourceRef="Fork" targetRef="Implement"/>
+    <bpmn:sequenceFlow id="Flow_4" sourceRef="Fork" targetRef="Verify"/>
+    <bpmn:sequenceFlow id="Flow_5" sourceRef="Implement" targetRef="Join"/>
+    <bpmn:sequenceFlow id="Flow_6" sourceRef="Verify" targetRef="Join"/>
+    <bpmn:sequenceFlow id="Flow_7" sourceRef="Join" targetRef="EndEvent_1"/>
+    <bpmn:sequenceFlow id="Flow_8" sourceRef="Implement_Timeout" targetRef="EndEvent_2"/>
+  </bpmn:process>
+</bpmn:definitions>`
+
+// v2：评审改名为 Assess，SLA 定时器改为 Implement_SLA，实施新增取消消息边界事件，去掉旧版人工登记
+const migrationV2BPMN = `<?xml version="1.0" encoding="UTF-8"?>
+<bpmn:definitions xmlns:bpmn="http://www.omg.org/spec/BPMN/20100524/MODEL" id="Definitions_mig" targetNamespace="http://bpmn.io/schema/bpmn">
+  <bpmn:message id="Msg_Cancel" name="change_cancelled"/>
+  <bpmn:process id="Process_change" name="Change" isExecutable="true">
+    <bpmn:startEvent id="StartEvent_1"/>
+    <bpmn:userTask id="Assess" name="变更评估" assignee="1"/>
+    <bpmn:parallelGateway id="Fork"/>
+    <bpmn:userTask id="Implement" name="实施" assignee="1"/>
+    <bpmn:boundaryEvent id="Implement_SLA" attachedToRef="Implement" cancelActivity="false">
+      <bpmn:timerEventDefinition><bpmn:timeDuration>PT4H</bpmn:timeDuration></bpmn:timerEventDefinition>
+    </bpmn:boundaryEvent>
+    <bpmn:boundaryEvent id="Implement_Cancel" attachedToRef="Implement">
+      <bpmn:messageEventDefinition messageRef="Msg_Cancel"/>
+    </bpmn:boundaryEvent>
+    <bpmn:userTask id="Verify" name="验证" assignee="1"/>
+    <bpmn:parallelGateway id="Join"/>
+    <bpmn:endEvent id="EndEvent_1"/>
+    <bpmn:endEvent id="EndEvent_2"/>
+    <bpmn:sequenceFlow id="Flow_1" sourceRef="StartEvent_1" targetRef="Assess"/>
+    <bpmn:sequenceFlow id="Flow_2" sourceRef="Assess" targetRef="Fork"/>
+    <bpmn:sequenceFlow id="Flow_3" sourceRef="Fork" targetRef="Implement"/>
+    <bpmn:sequenceFlow id="Flow_4" sourceRef="Fork" targetRef="Verify"/>
+    <bpmn:sequenceFlow id="Flow_5" sourceRef="Implement" targetRef="Join"/>
+    <bpmn:sequenceFlow id="Flow_6" sourceRef="Verify" targetRef="Join"/>
+    <bpmn:sequenceFlow id="Flow_7" sourceRef="Join" targetRef="EndEvent_1"/>
+    <bpmn:sequenceFlow id="Flow_8" sourceRef="Implement_SLA" targetRef="EndEvent_2"/>
+    <bpmn:sequenceFlow id="Flow_9" sourceRef="Implement_Cancel" targetRef="EndEvent_2"/>
+  </bpmn:process>
+</bpmn:definitions>`
+
+// seedMigrationVersions 部署同一流程的两个版本，返回 v1、v2 的流程定义
+func seedMigrationVersions(t *testing.T, key string) (*CustomProcessEngine, *ent.Client, context.Context, *ent.ProcessDefinition, *ent.ProcessDefinition) {
+	t.Helper()
+	engine, client, ctx := seedTimerEngine(t, key, migrationV1BPMN)
+	v1, err := client.ProcessDefinition.Query().Where(processdefinition.Key(key)).Only(ctx)
+	require.NoError(t, err)
+	dep, err := client.ProcessDeployment.Create().
+		SetDeploymentID("DEP-" + key + "-2").SetDeploymentName(key).SetTenantID(11).Save(ctx)
+	require.NoError(t, err)
+	v2, err := client.ProcessDefinition.Create().
+		SetKey(key).SetName(key).SetVersion("1.1.0").SetBpmnXML([]byte(migrationV2BPMN)).
+		SetDeploymentID(dep.ID).SetTenantID(11).SetIsActive(true).SetIsLatest(false).Save(ctx)
+	require.NoError(t, err)
+	return engine, client, ctx, v1, v2
+}
+
+func TestMigrateProcessInstances_DryRunThenMigrate(t *testing.T) {
+	engine, client, ctx, v1, v2 := seedMigrationVersions(t, "change_mig")
+
+	// 实例一停在评审；实例二已并行分支，验证已完成、实施进行中（汇聚等待实施）
+	atReview, err := engine.StartProcess(ctx, "change_mig", "CHG-1", map[string]interface{}{"risk": "low"})
+	require.NoError(t, err)
+	inFlight, err := engine.StartProcess(ctx, "change_mig", "CHG-2", map[string]interface{}{"risk": "high"})
+	require.NoError(t, err)
+	require.NoError(t, engine.CompleteTask(ctx, openTask(t, client, ctx, inFlight.ID, "Review").TaskID, nil))
+	require.NoError(t, engine.CompleteTask(ctx, openTask(t, client, ctx, inFlight.ID, "Verify").TaskID, nil))
+	require.Len(t, pendingTimers(t, client, ctx), 1)
+
+	req := &ProcessMigrationRequest{
+		SourceDefinitionID: v1.ID,
+		TargetDefinitionID: v2.ID,
+		Instructions: []ProcessMigrationInstruction{
+			{SourceActivityID: "Review", TargetActivityID: "Assess"},
+			{SourceActivityID: "Implement_Timeout", TargetActivityID: "Implement_SLA"},
+		},
+		Variables: map[string]interface{}{"migrated_from": v1.Version},
+		DryRun:    true,
+	}
+	report, err := engine.MigrateProcessInstances(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, 2, report.Total)
+	assert.Equal(t, 2, report.Migratable)
+	assert.Zero(t, report.Migrated)
+	assert.Equal(t, map[string]string{"Review": "Assess"}, report.Instances[0].ActiveActivities)
+	assert.Equal(t, map[string]string{"Implement": "Implement"}, report.Instances[1].ActiveActivities)
+	unchanged, err := client.ProcessInstance.Get(ctx, atReview.ID)
+	require.NoError(t, err)
+	assert.Equal(t, v1.ID, unchanged.ProcessDefinitionID, "dry-run 不应修改实例")
+
+	req.DryRun = false
+	report, err = engine.MigrateProcessInstances(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, 2, report.Migrated)
+	assert.Zero(t, report.Failed)
+
+	// 任务与变量随实例迁移
+	migrated, err := client.ProcessInstance.Get(ctx, atReview.ID)
+	require.NoError(t, err)
+	assert.Equal(t, v2.ID, migrated.ProcessDefinitionID)
+	assert.Equal(t, "low", migrated.Variables["risk"])
+	assert.Equal(t, v1.Version, migrated.Variables["migrated_from"])
+	assess := openTask(t, client, ctx, atReview.ID, "Assess")
+	assert.Equal(t, "变更评估", assess.TaskName)
+
+	// 定时器改挂到新的边界事件，目标版本新增的消息边界事件被激活
+	timers := pendingTimers(t, client, ctx)
+	require.Len(t, timers, 1)
+	assert.Equal(t, "Implement_SLA", timers[0].Payload["element_id"])
+	assert.True(t, strings.HasPrefix(timers[0].IdempotencyKey, timerIdempotencyPrefix(inFlight.ID, "Implement_SLA")))
+	cancelSub, err := client.ProcessEventSubscription.Query().
+		Where(
+			processeventsubscription.ProcessInstanceID(inFlight.ID),
+			processeventsubscription.ElementID("Implement_Cancel"),
+		).
+		Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "change_cancelled", cancelSub.EventName)
+
+	// 在新版本上继续推进：汇聚状态保留，实施完成即汇聚结束
+	require.NoError(t, engine.CompleteTask(ctx, openTask(t, client, ctx, inFlight.ID, "Implement").TaskID, nil))
+	inFlight, err = client.ProcessInstance.Get(ctx, inFlight.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "completed", inFlight.Status)
+
+	require.NoError(t, engine.CompleteTask(ctx, assess.TaskID, nil))
+	openTask(t, client, ctx, atReview.ID, "Implement")
+	openTask(t, client, ctx, atReview.ID, "Verify")
+}
+
+func TestMigrateProcessInstances_Validation(t *testing.T) {
+	engine, client, ctx, v1, v2 := seedMigrationVersions(t, "change_mig_invalid")
+
+	_, err := engine.MigrateProcessInstances(ctx, &ProcessMigrationRequest{
+		SourceDefinitionID: v1.ID, TargetDefinitionID: v2.ID,
+		Instructions: []ProcessMigrationInstruction{{SourceActivityID: "Review", TargetActivityID: "Fork"}},
+	})
+	assert.ErrorIs(t, err, ErrInvalidMigrationPlan)
+	assert.ErrorContains(t, err, "不能映射到")
+
+	_, err = engine.MigrateProcessInstances(ctx, &ProcessMigrationRequest{
+		SourceDefinitionID: v1.ID, TargetDefinitionID: v2.ID,
+		Instructions: []ProcessMigrationInstruction{{SourceActivityID: "Missing", TargetActivityID: "Assess"}},
+	})
+	assert.ErrorIs(t, err, ErrInvalidMigrationPlan)
+
+	deployExtraDefinition(t, client, "other_process", migrationV2BPMN)
+	other, err := client.ProcessDefinition.Query().Where(processdefinition.Key("other_process")).Only(ctx)
+	require.NoError(t, err)
+	_, err = engine.MigrateProcessInstances(ctx, &ProcessMigrationRequest{SourceDefinitionID: v1.ID, TargetDefinitionID: other.ID})
+	assert.ErrorIs(t, err, ErrInvalidMigrationPlan)
+
+	_, err = engine.MigrateProcessInstances(ctx, &ProcessMigrationRequest{
+		SourceDefinitionID: v1.ID, TargetDefinitionID: v2.ID,
+		Variables: map[string]interface{}{"_done_": map[string]interface{}{}},
+	})
+	assert.ErrorIs(t, err, ErrInvalidMigrationPlan)
+
+	// 实例停在目标版本已删除的活动上且没有指令：报告中失败，其它实例照常迁移
+	stuck, err := engine.StartProcess(ctx, "change_mig_invalid", "CHG-3", nil)
+	require.NoError(t, err)
+	review := openTask(t, client, ctx, stuck.ID, "Review")
+	_, err = client.ProcessTask.UpdateOne(review).SetTaskDefinitionKey("Legacy").Save(ctx)
+	require.NoError(t, err)
+	ok, err := engine.StartProcess(ctx, "change_mig_invalid", "CHG-4", nil)
+	require.NoError(t, err)
+
+	report, err := engine.MigrateProcessInstances(ctx, &ProcessMigrationRequest{
+		SourceDefinitionID: v1.ID, TargetDefinitionID: v2.ID,
+		Instructions:       []ProcessMigrationInstruction{{SourceActivityID: "Review", TargetActivityID: "Assess"}},
+		ProcessInstanceIDs: []string{stuck.ProcessInstanceID, ok.ProcessInstanceID, "PI-missing"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, report.Total)
+	assert.Equal(t, 1, report.Migrated)
+	assert.Equal(t, 2, report.Failed)
+	assert.Contains(t, report.Instances[0].Errors[0], "Legacy")
+	assert.False(t, report.Instances[0].Migrated)
+
+	stuck, err = client.ProcessInstance.Get(ctx, stuck.ID)
+	require.NoError(t, err)
+	assert.Equal(t, v1.ID, stuck.ProcessDefinitionID)
+	count, err := client.ProcessTask.Query().
+		Where(processtask.ProcessInstanceID(ok.ID), processtask.TaskDefinitionKey("Assess")).
+		Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
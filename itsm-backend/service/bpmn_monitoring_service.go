@@ -10,6 +10,7 @@ import (
 
 	"itsm-backend/ent"
 	"itsm-backend/ent/processexecutionhistory"
+	"itsm-backend/ent/processincident"
 	"itsm-backend/ent/processinstance"
 	"itsm-backend/ent/processtask"
 
@@ -20,6 +21,7 @@ import (
 type BPMNMonitoringService struct {
 	client       *ent.Client
 	auditService *BPMNAuditService
+	engine       *CustomProcessEngine
 	logger       *zap.SugaredLogger
 }
 
@@ -37,6 +39,11 @@ func (s *BPMNMonitoringService) SetAuditService(auditService *BPMNAuditService)
 	s.auditService = auditService
 }
 
+// SetProcessEngine 接入流程引擎，用于重试或跳过流程事故
+func (s *BPMNMonitoringService) SetProcessEngine(engine *CustomProcessEngine) {
+	s.engine = engine
+}
+
 // ProcessMetrics 流程指标
 type ProcessMetrics struct {
 	ProcessDefinitionKey string                  `json:"processDefinitionKey"`
@@ -144,6 +151,7 @@ type ProcessInstanceStatus struct {
 	TenantID          int                    `json:"tenantId"`
 	EstimatedDuration time.Duration          `json:"estimatedDuration"`
 	RiskLevel         string                 `json:"riskLevel"` // low, medium, high
+	OpenIncidents     int                    `json:"openIncidents"`
 }
 
 // AuditLogEntry 审计日志条目
@@ -390,6 +398,18 @@ func (s *BPMNMonitoringService) GetProcessInstanceStatus(ctx context.Context, pr
 		status.Progress = progress
 	}
 
+	// 未处理的流程事故
+	openIncidents, err := s.client.ProcessIncident.Query().
+		Where(
+			processincident.TenantID(tenantID),
+			processincident.ProcessInstanceID(instance.ID),
+			processincident.StatusEQ(bpmnIncidentOpen),
+		).
+		Count(ctx)
+	if err == nil {
+		status.OpenIncidents = openIncidents
+	}
+
 	return status, nil
 }
 
@@ -1287,3 +1307,69 @@ func (s *BPMNMonitoringService) ListProcessInstancesStatus(ctx context.Context,
 
 	return statuses, total, nil
 }
+
+// ListProcessIncidentsQuery 流程事故查询参数
+type ListProcessIncidentsQuery struct {
+	TenantID          int
+	ProcessInstanceID int
+	ElementID         string
+	Status            string // open, resolved；为空时查询全部
+	Page              int
+	PageSize          int
+}
+
+// ListProcessIncidents 查询流程事故（异步服务任务重试用尽等），按创建时间倒序
+func (s *BPMNMonitoringService) ListProcessIncidents(ctx context.Context, query *ListProcessIncidentsQuery) ([]*ent.ProcessIncident, int, error) {
+	if query == nil || query.TenantID <= 0 {
+		return nil, 0, fmt.Errorf("缺少有效租户上下文")
+	}
+	dbQuery := s.client.ProcessIncident.Query().
+		Where(processincident.TenantID(query.TenantID))
+	if query.ProcessInstanceID > 0 {
+		dbQuery = dbQuery.Where(processincident.ProcessInstanceID(query.ProcessInstanceID))
+	}
+	if query.ElementID != "" {
+		dbQuery = dbQuery.Where(processincident.ElementID(query.ElementID))
+	}
+	if query.Status != "" {
+		dbQuery = dbQuery.Where(processincident.Status(query.Status))
+	}
+
+	total, err := dbQuery.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("查询流程事故总数失败: %w", err)
+	}
+	page := query.Page
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := query.PageSize
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+	incidents, err := dbQuery.
+		Order(ent.Desc(processincident.FieldCreatedAt), ent.Desc(processincident.FieldID)).
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("查询流程事故失败: %w", err)
+	}
+	return incidents, total, nil
+}
+
+// RetryProcessIncident 重试流程事故：重新调度失败的服务任务，重试次数重新计算
+func (s *BPMNMonitoringService) RetryProcessIncident(ctx context.Context, tenantID, incidentID int, operator string) error {
+	if s.engine == nil {
+		return fmt.Errorf("监控服务未接入流程引擎，无法重试事故")
+	}
+	return s.engine.resolveIncident(ctx, tenantID, incidentID, BPMNIncidentRetried, operator)
+}
+
+// SkipProcessIncident 跳过流程事故：不再执行失败的服务任务，直接沿其出边继续
+func (s *BPMNMonitoringService) SkipProcessIncident(ctx context.Context, tenantID, incidentID int, operator string) error {
+	if s.engine == nil {
+		return fmt.Errorf("监控服务未接入流程引擎，无法跳过事故")
+	}
+	return s.engine.resolveIncident(ctx, tenantID, incidentID, BPMNIncidentSkipped, operator)
+}
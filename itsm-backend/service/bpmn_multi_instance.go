@@ -0,0 +1,437 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"itsm-backend/ent"
+	"itsm-backend/ent/processtask"
+)
+
+// BPMN 多实例活动
+//
+// 用户任务与服务任务可声明 multiInstanceLoopCharacteristics：
+//   - 实例数量取自 collection（变量名或 ${表达式}，字符串按逗号拆分），未配置时取 loopCardinality
+//   - 每个实例拥有本地变量 loopCounter、nrOfInstances 与 elementVariable，不写回流程变量
+//   - 实例结果按 loopCounter 写入 outputCollection：配置 outputElement 时为其求值结果，
+//     否则用户任务为提交的变量、服务任务为处理器的输出变量；被完成条件提前结束的实例对应位置为 nil
+//   - completionCondition 在每个实例完成后求值，可引用 nrOfInstances / nrOfCompletedInstances /
+//     nrOfActiveInstances；满足条件时撤销其余实例并离开活动，求值失败视为不满足
+//
+// 用户任务并行模式一次创建全部任务，串行模式上一个完成后再创建下一个；运行状态保存在引擎变量
+// _multi_instance_ 中。服务任务在同一事务内依次执行全部实例，isSequential 不影响执行方式。
+
+const (
+	bpmnMultiInstanceVariable = "_multi_instance_"
+	bpmnLoopCounterVariable   = "loopCounter"
+)
+
+// multiInstanceState 多实例活动的运行状态
+type multiInstanceState struct {
+	Instances int           `json:"nrOfInstances"`
+	Completed int           `json:"nrOfCompletedInstances"`
+	Active    int           `json:"nrOfActiveInstances"`
+	Next      int           `json:"nextIndex"`
+	Items     []interface{} `json:"items,omitempty"`
+	Outputs   []interface{} `json:"outputs"`
+}
+
+// locals 第 index 个实例的本地变量
+func (s *multiInstanceState) locals(mi *BPMNMultiInstanceLoopCharacteristics, index int) map[string]interface{} {
+	locals := map[string]interface{}{
+		bpmnLoopCounterVariable: index,
+		"nrOfInstances":         s.Instances,
+	}
+	if mi.ElementVariable != "" && index < len(s.Items) {
+		locals[mi.ElementVariable] = s.Items[index]
+	}
+	return locals
+}
+
+// counters 完成条件可引用的计数变量
+func (s *multiInstanceState) counters() map[string]interface{} {
+	return map[string]interface{}{
+		"nrOfInstances":          s.Instances,
+		"nrOfCompletedInstances": s.Completed,
+		"nrOfActiveInstances":    s.Active,
+	}
+}
+
+// unwrapExpression 剥离 ${...} 包裹（expr-lang 无法编译 ${} 前缀）
+func unwrapExpression(expression string) string {
+	expression = strings.TrimSpace(expression)
+	if strings.HasPrefix(expression, "${") && strings.HasSuffix(expression, "}") {
+		expression = strings.TrimSpace(expression[2 : len(expression)-1])
+	}
+	return expression
+}
+
+// mergeVariableMaps 按顺序合并变量，后者覆盖前者
+func mergeVariableMaps(maps ...map[string]interface{}) map[string]interface{} {
+	merged := map[string]interface{}{}
+	for _, m := range maps {
+		for k, v := range m {
+			merged[k] = v
+		}
+	}
+	return merged
+}
+
+// multiInstanceItems 解析多实例的集合元素与实例数量
+func (e *CustomProcessEngine) multiInstanceItems(mi *BPMNMultiInstanceLoopCharacteristics, variables map[string]interface{}) ([]interface{}, int, error) {
+	if collection := unwrapExpression(mi.Collection); collection != "" {
+		value, ok := variables[collection]
+		if !ok {
+			var err error
+			if value, err = e.exprEngine.Evaluate(collection, variables); err != nil {
+				return nil, 0, fmt.Errorf("集合 %s 求值失败: %w", mi.Collection, err)
+			}
+		}
+		items, err := multiInstanceCollection(value)
+		if err != nil {
+			return nil, 0, fmt.Errorf("集合 %s 无效: %w", mi.Collection, err)
+		}
+		return items, len(items), nil
+	}
+	cardinality := unwrapExpression(mi.LoopCardinality)
+	if cardinality == "" {
+		return nil, 0, fmt.Errorf("缺少 loopCardinality 或 collection")
+	}
+	value, err := e.exprEngine.EvaluateNumeric(cardinality, variables)
+	if err != nil {
+		return nil, 0, fmt.Errorf("loopCardinality %s 求值失败: %w", mi.LoopCardinality, err)
+	}
+	if value < 0 || value != float64(int(value)) {
+		return nil, 0, fmt.Errorf("loopCardinality 必须为非负整数，实际为 %v", value)
+	}
+	return nil, int(value), nil
+}
+
+// multiInstanceCollection 将集合变量转换为元素列表
+func multiInstanceCollection(value interface{}) ([]interface{}, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case []interface{}:
+		return v, nil
+	case string:
+		var items []interface{}
+		for _, item := range splitNonEmptyCSV(v) {
+			items = append(items, item)
+		}
+		return items, nil
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, fmt.Errorf("期望列表，实际为 %T", value)
+	}
+	items := make([]interface{}, rv.Len())
+	for i := range items {
+		items[i] = rv.Index(i).Interface()
+	}
+	return items, nil
+}
+
+// loadMultiInstanceState 读取活动的多实例运行状态
+func loadMultiInstanceState(instance *ent.ProcessInstance, activityID string) (*multiInstanceState, bool) {
+	states, _ := instance.Variables[bpmnMultiInstanceVariable].(map[string]interface{})
+	raw, ok := states[activityID]
+	if !ok {
+		return nil, false
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, false
+	}
+	var state multiInstanceState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, false
+	}
+	return &state, true
+}
+
+// multiInstanceStates 返回写入 state（为 nil 时删除）后的全部多实例状态
+func multiInstanceStates(instance *ent.ProcessInstance, activityID string, state *multiInstanceState) (map[string]interface{}, error) {
+	current, _ := instance.Variables[bpmnMultiInstanceVariable].(map[string]interface{})
+	states := make(map[string]interface{}, len(current)+1)
+	for k, v := range current {
+		states[k] = v
+	}
+	if state == nil {
+		delete(states, activityID)
+		return states, nil
+	}
+	data, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("序列化多实例状态失败: %w", err)
+	}
+	var value map[string]interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, fmt.Errorf("序列化多实例状态失败: %w", err)
+	}
+	states[activityID] = value
+	return states, nil
+}
+
+// saveMultiInstanceState 持久化多实例状态（state 为 nil 时删除），extra 为同时写入的流程变量
+func (e *CustomProcessEngine) saveMultiInstanceState(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, activityID string, state *multiInstanceState, extra map[string]interface{}) error {
+	states, err := multiInstanceStates(instance, activityID, state)
+	if err != nil {
+		return err
+	}
+	vars := map[string]interface{}{bpmnMultiInstanceVariable: states}
+	for k, v := range extra {
+		vars[k] = v
+	}
+	updated, err := e.mergeVariablesInTx(ctx, txc, instance.ID, vars)
+	if err != nil {
+		return err
+	}
+	*instance = *updated
+	return nil
+}
+
+// clearMultiInstanceState 活动被中断时清除其多实例状态
+func (e *CustomProcessEngine) clearMultiInstanceState(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, activityID string) error {
+	if _, ok := loadMultiInstanceState(instance, activityID); !ok {
+		return nil
+	}
+	return e.saveMultiInstanceState(ctx, txc, instance, activityID, nil, nil)
+}
+
+// multiInstanceOutput 计算单个实例写入输出集合的结果
+func (e *CustomProcessEngine) multiInstanceOutput(mi *BPMNMultiInstanceLoopCharacteristics, variables, locals, results map[string]interface{}) (interface{}, error) {
+	if mi.OutputElement == "" {
+		return mergeVariableMaps(results), nil
+	}
+	value, err := e.exprEngine.Evaluate(unwrapExpression(mi.OutputElement), mergeVariableMaps(variables, locals, results))
+	if err != nil {
+		return nil, fmt.Errorf("outputElement %s 求值失败: %w", mi.OutputElement, err)
+	}
+	return value, nil
+}
+
+// multiInstanceCompleted 判断多实例活动是否结束：全部实例完成或满足完成条件
+func (e *CustomProcessEngine) multiInstanceCompleted(activityID string, mi *BPMNMultiInstanceLoopCharacteristics, state *multiInstanceState, variables, locals, results map[string]interface{}) bool {
+	if state.Completed >= state.Instances {
+		return true
+	}
+	condition := unwrapExpression(mi.CompletionCondition)
+	if condition == "" {
+		return false
+	}
+	env := mergeVariableMaps(variables, locals, results, state.counters())
+	if mi.OutputCollection != "" {
+		env[mi.OutputCollection] = state.Outputs
+	}
+	completed, err := e.exprEngine.EvaluateCondition(condition, env)
+	if err != nil {
+		e.logger.Errorw("多实例完成条件评估失败，继续等待其余实例", "element", activityID, "expression", condition, "error", err)
+		return false
+	}
+	return completed
+}
+
+// findMultiInstanceUserTask 任务属于多实例用户任务时返回其定义
+func (e *CustomProcessEngine) findMultiInstanceUserTask(process *BPMNProcess, task *ent.ProcessTask) *BPMNUserTask {
+	userTask := e.findUserTask(process, task.TaskDefinitionKey)
+	if userTask == nil || userTask.MultiInstance == nil {
+		return nil
+	}
+	if _, ok := task.TaskVariables[bpmnLoopCounterVariable]; !ok {
+		return nil
+	}
+	return userTask
+}
+
+// multiInstanceTaskVariables 多实例任务完成时保存的任务变量：提交的变量 + 实例本地变量
+func multiInstanceTaskVariables(task *ent.ProcessTask, mi *BPMNMultiInstanceLoopCharacteristics, variables map[string]interface{}) map[string]interface{} {
+	merged := mergeVariableMaps(variables)
+	for _, name := range []string{bpmnLoopCounterVariable, "nrOfInstances", mi.ElementVariable} {
+		if v, ok := task.TaskVariables[name]; ok && name != "" {
+			merged[name] = v
+		}
+	}
+	return merged
+}
+
+// enterMultiInstanceUserTask 进入多实例用户任务：并行模式创建全部任务实例，串行模式只创建第一个
+func (e *CustomProcessEngine) enterMultiInstanceUserTask(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, process *BPMNProcess, task *BPMNUserTask) error {
+	mi := task.MultiInstance
+	items, count, err := e.multiInstanceItems(mi, instance.Variables)
+	if err != nil {
+		return fmt.Errorf("多实例活动 [%s] 配置无效: %w", task.ID, err)
+	}
+	if count == 0 {
+		// 空集合：不创建任务，直接离开活动
+		return e.leaveMultiInstanceActivity(ctx, txc, instance, process, task.ID, mi, nil)
+	}
+	state := &multiInstanceState{Instances: count, Items: items, Outputs: make([]interface{}, count)}
+	n := count
+	if mi.IsSequential {
+		n = 1
+	}
+	for i := 0; i < n; i++ {
+		if err := e.createUserTaskInstance(ctx, txc, instance, task, state.locals(mi, i)); err != nil {
+			return err
+		}
+	}
+	state.Active, state.Next = n, n
+	if err := e.saveMultiInstanceState(ctx, txc, instance, task.ID, state, nil); err != nil {
+		return err
+	}
+	e.logger.Infow("多实例用户任务已创建", "element", task.ID, "instances", count, "sequential", mi.IsSequential)
+	return e.enterActivityBoundaries(ctx, txc, instance, process, task.ID)
+}
+
+// completeMultiInstanceTask 完成多实例用户任务的一个实例：结果写入输出集合，
+// 活动结束时撤销其余实例并推进流程，否则串行模式创建下一个实例
+func (e *CustomProcessEngine) completeMultiInstanceTask(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, process *BPMNProcess, task *BPMNUserTask, taskVariables, variables map[string]interface{}) error {
+	mi := task.MultiInstance
+	state, ok := loadMultiInstanceState(instance, task.ID)
+	if !ok {
+		return fmt.Errorf("多实例活动 [%s] 不在运行中", task.ID)
+	}
+	index, ok := numericInt(taskVariables[bpmnLoopCounterVariable])
+	if !ok || index < 0 || index >= state.Instances {
+		return fmt.Errorf("多实例活动 [%s] 的实例序号无效: %v", task.ID, taskVariables[bpmnLoopCounterVariable])
+	}
+	output, err := e.multiInstanceOutput(mi, instance.Variables, taskVariables, variables)
+	if err != nil {
+		return err
+	}
+	state.Outputs[index] = output
+	state.Completed++
+	state.Active--
+
+	if e.multiInstanceCompleted(task.ID, mi, state, instance.Variables, taskVariables, variables) {
+		if _, err := txc.ProcessTask.Update().
+			Where(
+				processtask.ProcessInstanceID(instance.ID),
+				processtask.TaskDefinitionKey(task.ID),
+				processtask.StatusNotIn("completed", "cancelled"),
+			).
+			SetStatus("cancelled").
+			SetCompletedTime(time.Now()).
+			Save(ctx); err != nil {
+			return fmt.Errorf("撤销剩余多实例任务失败: %w", err)
+		}
+		return e.leaveMultiInstanceActivity(ctx, txc, instance, process, task.ID, mi, state.Outputs)
+	}
+
+	if mi.IsSequential && state.Next < state.Instances {
+		if err := e.createUserTaskInstance(ctx, txc, instance, task, state.locals(mi, state.Next)); err != nil {
+			return err
+		}
+		state.Next++
+		state.Active++
+	}
+	var extra map[string]interface{}
+	if mi.OutputCollection != "" {
+		extra = map[string]interface{}{mi.OutputCollection: state.Outputs}
+	}
+	return e.saveMultiInstanceState(ctx, txc, instance, task.ID, state, extra)
+}
+
+// leaveMultiInstanceActivity 多实例活动结束：写入输出集合、清除运行状态并沿出边推进
+func (e *CustomProcessEngine) leaveMultiInstanceActivity(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, process *BPMNProcess, activityID string, mi *BPMNMultiInstanceLoopCharacteristics, outputs []interface{}) error {
+	var extra map[string]interface{}
+	if mi.OutputCollection != "" {
+		if outputs == nil {
+			outputs = []interface{}{}
+		}
+		extra = map[string]interface{}{mi.OutputCollection: outputs}
+	}
+	if err := e.saveMultiInstanceState(ctx, txc, instance, activityID, nil, extra); err != nil {
+		return err
+	}
+	if err := e.cancelBoundaryEvents(ctx, txc, instance, process, activityID); err != nil {
+		return err
+	}
+	e.registerCompensable(ctx, txc, instance, process, activityID)
+	e.markElementDone(ctx, txc, instance, activityID)
+	return e.executeStep(ctx, txc, instance, process, activityID, instance.Variables)
+}
+
+// executeMultiInstanceServiceTask 同步执行多实例服务任务，失败按 BPMN 错误路由
+func (e *CustomProcessEngine) executeMultiInstanceServiceTask(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, process *BPMNProcess, task *BPMNServiceTask) error {
+	outputs, err := e.runMultiInstanceServiceTask(ctx, instance.Variables, task)
+	if err != nil {
+		return e.throwTaskError(ctx, txc, instance, process, task.ID, err)
+	}
+	if len(outputs) > 0 {
+		updated, err := e.mergeVariablesInTx(ctx, txc, instance.ID, outputs)
+		if err != nil {
+			return err
+		}
+		*instance = *updated
+	}
+	e.registerCompensable(ctx, txc, instance, process, task.ID)
+	e.markElementDone(ctx, txc, instance, task.ID)
+	return e.executeStep(ctx, txc, instance, process, task.ID, instance.Variables)
+}
+
+// runMultiInstanceServiceTask 依次执行服务任务的每个实例，返回需写入流程的输出集合变量
+func (e *CustomProcessEngine) runMultiInstanceServiceTask(ctx context.Context, variables map[string]interface{}, task *BPMNServiceTask) (map[string]interface{}, error) {
+	mi := task.MultiInstance
+	items, count, err := e.multiInstanceItems(mi, variables)
+	if err != nil {
+		return nil, fmt.Errorf("多实例活动 [%s] 配置无效: %w", task.ID, err)
+	}
+	state := &multiInstanceState{Instances: count, Items: items, Outputs: make([]interface{}, count)}
+	for i := 0; i < count; i++ {
+		locals := state.locals(mi, i)
+		results, err := e.runServiceTaskWithOutput(ctx, variables, task, locals)
+		if err != nil {
+			return nil, err
+		}
+		output, err := e.multiInstanceOutput(mi, variables, locals, results)
+		if err != nil {
+			return nil, err
+		}
+		state.Outputs[i] = output
+		state.Completed++
+		state.Next = i + 1
+		if e.multiInstanceCompleted(task.ID, mi, state, variables, locals, results) {
+			break
+		}
+	}
+	if mi.OutputCollection == "" {
+		return nil, nil
+	}
+	return map[string]interface{}{mi.OutputCollection: state.Outputs}, nil
+}
+
+// resolveAssignmentExpression 解析 ${...} 形式的分配表达式（如 ${owner}），求值环境为流程变量 + 本地变量；
+// 非表达式原样返回，求值失败返回空串交由默认分配。列表结果按逗号拼接
+func (e *CustomProcessEngine) resolveAssignmentExpression(value string, variables, locals map[string]interface{}) string {
+	trimmed := strings.TrimSpace(value)
+	if !strings.HasPrefix(trimmed, "${") || !strings.HasSuffix(trimmed, "}") {
+		return value
+	}
+	result, err := e.exprEngine.Evaluate(unwrapExpression(trimmed), mergeVariableMaps(variables, locals))
+	if err != nil || result == nil {
+		e.logger.Warnw("分配表达式求值失败", "expression", value, "error", err)
+		return ""
+	}
+	if items, err := multiInstanceCollection(result); err == nil && reflect.TypeOf(result).Kind() != reflect.String {
+		values := make([]string, 0, len(items))
+		for _, item := range items {
+			values = append(values, assignmentString(item))
+		}
+		return strings.Join(values, ",")
+	}
+	return assignmentString(result)
+}
+
+func assignmentString(value interface{}) string {
+	if f, ok := value.(float64); ok {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	return fmt.Sprint(value)
+}
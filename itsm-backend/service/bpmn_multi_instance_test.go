@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"itsm-backend/dto"
+	"itsm-backend/ent"
+	"itsm-backend/ent/processtask"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// CAB 投票：5 名委员并行投票，60% 完成即结束
+const cabVotingBPMN = `<?xml version="1.0" encoding="UTF-8"?>
+<bpmn:definitions xmlns:bpmn="http://www.omg.org/spec/BPMN/20100524/MODEL" xmlns:camunda="http://camunda.org/schema/1.0/bpmn" id="Definitions_mi" targetNamespace="http://bpmn.io/schema/bpmn">
+  <bpmn:process id="Process_mi" name="CAB" isExecutable="true">
+    <bpmn:startEvent id="StartEvent_1"/>
+    <bpmn:userTask id="Vote" name="CAB 投票" assignee="1">
+      <bpmn:multiInstanceLoopCharacteristics camunda:outputCollection="votes" camunda:outputElement="${approved}">
+        <bpmn:loopCardinality>${cab_size}</bpmn:loopCardinality>
+        <bpmn:completionCondition>${nrOfCompletedInstances/nrOfInstances >= 0.6}</bpmn:completionCondition>
+      </bpmn:multiInstanceLoopCharacteristics>
+    </bpmn:userTask>
+    <bpmn:userTask id="Implement" name="实施变更" assignee="1"/>
+    <bpmn:endEvent id="EndEvent_1"/>
+    <bpmn:sequenceFlow id="Flow_1" sourceRef="StartEvent_1" targetRef="Vote"/>
+    <bpmn:sequenceFlow id="Flow_2" sourceRef="Vote" targetRef="Implement"/>
+    <bpmn:sequenceFlow id="Flow_3" sourceRef="Implement" targetRef="EndEvent_1"/>
+  </bpmn:process>
+</bpmn:definitions>`
+
+// 逐个 CI 校验，随后对每个服务负责人分发确认
+const ciValidationBPMN = `<?xml version="1.0" encoding="UTF-8"?>
+<bpmn:definitions xmlns:bpmn="http://www.omg.org/spec/BPMN/20100524/MODEL" xmlns:camunda="http://camunda.org/schema/1.0/bpmn" id="Definitions_ci" targetNamespace="http://bpmn.io/schema/bpmn">
+  <bpmn:process id="Process_ci" name="CI" isExecutable="true">
+    <bpmn:startEvent id="StartEvent_1"/>
+    <bpmn:userTask id="Validate" name="校验 CI" assignee="1">
+      <bpmn:multiInstanceLoopCharacteristics isSequential="true" camunda:collection="cis" camunda:elementVariable="ci" camunda:outputCollection="validations"/>
+    </bpmn:userTask>
+    <bpmn:userTask id="Confirm" name="负责人确认" assignee="${owner}">
+      <bpmn:multiInstanceLoopCharacteristics camunda:collection="${owners}" camunda:elementVariable="owner"/>
+    </bpmn:userTask>
+    <bpmn:endEvent id="EndEvent_1"/>
+    <bpmn:sequenceFlow id="Flow_1" sourceRef="StartEvent_1" targetRef="Validate"/>
+    <bpmn:sequenceFlow id="Flow_2" sourceRef="Validate" targetRef="Confirm"/>
+    <bpmn:sequenceFlow id="Flow_3" sourceRef="Confirm" targetRef="EndEvent_1"/>
+  </bpmn:process>
+</bpmn:definitions>`
+
+func openTasks(t *testing.T, client *ent.Client, ctx context.Context, instanceID int, key string) []*ent.ProcessTask {
+	t.Helper()
+	tasks, err := client.ProcessTask.Query().
+		Where(
+			processtask.ProcessInstanceID(instanceID),
+			processtask.TaskDefinitionKey(key),
+			processtask.StatusNotIn("completed", "cancelled"),
+		).
+		Order(ent.Asc(processtask.FieldID)).
+		All(ctx)
+	require.NoError(t, err)
+	return tasks
+}
+
+func TestMultiInstanceUserTask_ParallelCompletionCondition(t *testing.T) {
+	engine, client, ctx := seedTimerEngine(t, "cab_vote", cabVotingBPMN)
+
+	inst, err := engine.StartProcess(ctx, "cab_vote", "CHG-1", map[string]interface{}{"cab_size": 5})
+	require.NoError(t, err)
+	votes := openTasks(t, client, ctx, inst.ID, "Vote")
+	require.Len(t, votes, 5)
+	for i, task := range votes {
+		assert.EqualValues(t, i, task.TaskVariables[bpmnLoopCounterVariable])
+	}
+
+	require.NoError(t, engine.CompleteTask(ctx, votes[0].TaskID, map[string]interface{}{"approved": true}))
+	require.NoError(t, engine.CompleteTask(ctx, votes[3].TaskID, map[string]interface{}{"approved": false}))
+	assert.Len(t, openTasks(t, client, ctx, inst.ID, "Vote"), 3, "未达到完成条件时继续等待")
+	inst, err = client.ProcessInstance.Get(ctx, inst.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{true, nil, nil, false, nil}, inst.Variables["votes"])
+	assert.NotContains(t, inst.Variables, "approved", "实例提交的变量属于本地变量")
+
+	require.NoError(t, engine.CompleteTask(ctx, votes[1].TaskID, map[string]interface{}{"approved": true}))
+	assert.Empty(t, openTasks(t, client, ctx, inst.ID, "Vote"), "满足完成条件后撤销其余实例")
+	openTask(t, client, ctx, inst.ID, "Implement")
+	inst, err = client.ProcessInstance.Get(ctx, inst.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{true, true, nil, false, nil}, inst.Variables["votes"])
+	assert.Empty(t, inst.Variables[bpmnMultiInstanceVariable])
+
+	err = engine.CompleteTask(ctx, votes[2].TaskID, map[string]interface{}{"approved": true})
+	assert.ErrorContains(t, err, "任务已结束")
+}
+
+func TestMultiInstanceUserTask_SequentialCollectionAndFanOut(t *testing.T) {
+	engine, client, ctx := seedTimerEngine(t, "ci_check", ciValidationBPMN)
+
+	inst, err := engine.StartProcess(ctx, "ci_check", "CHG-2", map[string]interface{}{
+		"cis":    []interface{}{"db-1", "web-1"},
+		"owners": []interface{}{"3", 5},
+	})
+	require.NoError(t, err)
+
+	// 串行：上一个实例完成后才创建下一个，元素变量写入任务本地变量
+	for _, ci := range []string{"db-1", "web-1"} {
+		task := openTask(t, client, ctx, inst.ID, "Validate")
+		assert.Equal(t, ci, task.TaskVariables["ci"])
+		require.NoError(t, engine.CompleteTask(ctx, task.TaskID, map[string]interface{}{"valid": ci != "web-1"}))
+	}
+	inst, err = client.ProcessInstance.Get(ctx, inst.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"valid": true},
+		map[string]interface{}{"valid": false},
+	}, inst.Variables["validations"])
+	assert.NotContains(t, inst.Variables, "ci")
+
+	// 并行分发：分配人由元素变量求值
+	confirms := openTasks(t, client, ctx, inst.ID, "Confirm")
+	require.Len(t, confirms, 2)
+	assert.Equal(t, "3", confirms[0].Assignee)
+	assert.Equal(t, "5", confirms[1].Assignee)
+
+	// 空集合：不创建任务直接离开活动
+	inst, err = engine.StartProcess(ctx, "ci_check", "CHG-3", map[string]interface{}{
+		"cis":    []interface{}{},
+		"owners": "7,8",
+	})
+	require.NoError(t, err)
+	assert.Empty(t, openTasks(t, client, ctx, inst.ID, "Validate"))
+	confirms = openTasks(t, client, ctx, inst.ID, "Confirm")
+	require.Len(t, confirms, 2)
+	assert.Equal(t, "8", confirms[1].Assignee)
+	inst, err = client.ProcessInstance.Get(ctx, inst.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{}, inst.Variables["validations"])
+}
+
+func TestMultiInstanceUserTask_InvalidConfiguration(t *testing.T) {
+	bpmnXML := strings.Replace(cabVotingBPMN, `<bpmn:loopCardinality>${cab_size}</bpmn:loopCardinality>`, "", 1)
+	engine, _, ctx := seedTimerEngine(t, "cab_invalid", bpmnXML)
+
+	_, err := engine.StartProcess(ctx, "cab_invalid", "CHG-4", map[string]interface{}{})
+	assert.ErrorContains(t, err, "缺少 loopCardinality 或 collection")
+}
+
+// ciCheckHandler 按元素变量 ci 返回校验结果
+type ciCheckHandler struct {
+	calls *[]string
+}
+
+func (h *ciCheckHandler) GetTaskType() string  { return "ci_check" }
+func (h *ciCheckHandler) GetHandlerID() string { return "ci_check" }
+func (h *ciCheckHandler) Validate(ctx context.Context, config map[string]interface{}) error {
+	return nil
+}
+func (h *ciCheckHandler) Execute(ctx context.Context, task *ent.ProcessTask, variables map[string]interface{}) (*dto.ServiceTaskResult, error) {
+	ci, _ := variables["ci"].(string)
+	*h.calls = append(*h.calls, ci)
+	return &dto.ServiceTaskResult{Success: true, OutputVars: map[string]interface{}{"status": ci + ":ok"}}, nil
+}
+
+func TestMultiInstanceServiceTask_AggregatesOutputs(t *testing.T) {
+	const bpmnXML = `<?xml version="1.0" encoding="UTF-8"?>
+<bpmn:definitions xmlns:bpmn="http://www.omg.org/spec/BPMN/20100524/MODEL" xmlns:camunda="http://camunda.org/schema/1.0/bpmn" id="Definitions_mis" targetNamespace="http://bpmn.io/schema/bpmn">
+  <bpmn:process id="Process_mis" name="CI" isExecutable="true">
+    <bpmn:startEvent id="StartEvent_1"/>
+    <bpmn:serviceTask id="Check" name="检查 CI" implementation="ci_check">
+      <bpmn:multiInstanceLoopCharacteristics camunda:collection="cis" camunda:elementVariable="ci" camunda:outputCollection="checks" camunda:outputElement="${status}">
+        <bpmn:completionCondition>${nrOfCompletedInstances == stop_after}</bpmn:completionCondition>
+      </bpmn:multiInstanceLoopCharacteristics>
+    </bpmn:serviceTask>
+    <bpmn:userTask id="Review" name="复核" assignee="1"/>
+    <bpmn:endEvent id="EndEvent_1"/>
+    <bpmn:sequenceFlow id="Flow_1" sourceRef="StartEvent_1" targetRef="Check"/>
+    <bpmn:sequenceFlow id="Flow_2" sourceRef="Check" targetRef="Review"/>
+    <bpmn:sequenceFlow id="Flow_3" sourceRef="Review" targetRef="EndEvent_1"/>
+  </bpmn:process>
+</bpmn:definitions>`
+	engine, client, ctx := seedTimerEngine(t, "ci_service", bpmnXML)
+	var calls []string
+	engine.callbackRegistry.RegisterHandler(&ciCheckHandler{calls: &calls})
+
+	inst, err := engine.StartProcess(ctx, "ci_service", "CHG-5", map[string]interface{}{
+		"cis": []interface{}{"a", "b", "c"}, "stop_after": 2,
+	})
+	require.NoError(t, err)
+	openTask(t, client, ctx, inst.ID, "Review")
+	assert.Equal(t, []string{"a", "b"}, calls, "满足完成条件后不再执行其余实例")
+	inst, err = client.ProcessInstance.Get(ctx, inst.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"a:ok", "b:ok", nil}, inst.Variables["checks"])
+	assert.NotContains(t, inst.Variables, "status")
+}
@@ -40,6 +40,11 @@ type ProcessEngine interface {
 	SuspendProcess(ctx context.Context, processInstanceID string, reason string) error
 	ResumeProcess(ctx context.Context, processInstanceID string) error
 	TerminateProcess(ctx context.Context, processInstanceID string, reason string) error
+	// 事件关联
+	CorrelateMessage(ctx context.Context, messageName string, correlationKeys map[string]interface{}, variables map[string]interface{}) (*MessageCorrelationResult, error)
+	BroadcastSignal(ctx context.Context, signalName string, variables map[string]interface{}) (*SignalBroadcastResult, error)
+	// 版本迁移
+	MigrateProcessInstances(ctx context.Context, req *ProcessMigrationRequest) (*ProcessMigrationReport, error)
 }
 
 // ProcessDefinitionService 流程定义服务接口
@@ -102,6 +107,7 @@ type CustomProcessEngine struct {
 	exprEngine       *ExpressionEngine      // 表达式引擎
 	expressionVars   map[string]interface{} // 表达式变量
 	callbackRegistry *bpmn.CallbackRegistry // 服务任务回调注册中心
+	scriptSandbox    *ScriptSandbox         // 脚本任务沙箱
 	groupResolver    *bpmn.GroupResolver    // 审批组解析器：candidateGroups → 候选用户
 	// 内部服务
 	processDefinitionService *bpmnProcessDefinitionService
@@ -120,6 +126,7 @@ func NewCustomProcessEngine(client *ent.Client, logger *zap.SugaredLogger) Proce
 		exprEngine:       NewExpressionEngine(),
 		expressionVars:   make(map[string]interface{}),
 		callbackRegistry: bpmn.NewCallbackRegistry(client, logger),
+		scriptSandbox:    NewScriptSandbox(),
 		groupResolver:    bpmn.NewGroupResolver(client),
 	}
 	engine.processDefinitionService = &bpmnProcessDefinitionService{client: client, logger: logger}
@@ -246,31 +253,15 @@ func (e *CustomProcessEngine) StartProcess(ctx context.Context, processDefinitio
 	}
 	process := bpmnDefinitions.Processes[0]
 
-	// 3. 找到开始事件
-	if len(process.StartEvents) == 0 {
+	// 3. 找到开始事件（手工启动优先选择无定时器的开始事件）
+	startEvent := manualStartEvent(process)
+	if startEvent == nil {
 		return nil, fmt.Errorf("流程缺少开始事件")
 	}
-	startEvent := process.StartEvents[0]
 
-	// 4. 创建流程实例
-	instance, err := e.client.ProcessInstance.Create().
-		SetProcessInstanceID(fmt.Sprintf("PI-%s-%d", processDefinitionKey, time.Now().UnixNano())).
-		SetBusinessKey(businessKey).
-		SetProcessDefinitionKey(processDefinitionKey).
-		SetProcessDefinitionID(definition.ID).
-		SetStatus("running").
-		SetVariables(variables).
-		SetStartTime(time.Now()).
-		SetTenantID(definition.TenantID).
-		SetCurrentActivityID(startEvent.ID).
-		SetCurrentActivityName(startEvent.Name).
-		Save(ctx)
+	// 4-5. 创建流程实例并从开始事件推进
+	instance, err := e.startProcessAt(ctx, e.client, definition, process, startEvent, nil, businessKey, variables)
 	if err != nil {
-		return nil, fmt.Errorf("创建流程实例失败: %w", err)
-	}
-
-	// 5. 执行流程推进（从StartEvent开始）
-	if err := e.executeStep(ctx, e.client, instance, process, startEvent.ID, variables); err != nil {
 		return nil, err
 	}
 
@@ -289,6 +280,51 @@ func (e *CustomProcessEngine) StartProcess(ctx context.Context, processDefinitio
 	return instance, nil
 }
 
+// manualStartEvent 选取手工启动（及调用活动启动）使用的开始事件：优先无定时器的开始事件
+func manualStartEvent(process *BPMNProcess) *BPMNStartEvent {
+	if len(process.StartEvents) == 0 {
+		return nil
+	}
+	for _, event := range process.StartEvents {
+		if event.TimerDefinition == nil && event.TimerRef == "" {
+			return event
+		}
+	}
+	return process.StartEvents[0]
+}
+
+// startProcessAt 以指定开始事件创建流程实例并推进到第一个等待态。
+// parent 非空时新实例作为调用活动的子实例，记录父/根流程实例ID。
+func (e *CustomProcessEngine) startProcessAt(ctx context.Context, txc *ent.Client, definition *ent.ProcessDefinition, process *BPMNProcess, startEvent *BPMNStartEvent, parent *ent.ProcessInstance, businessKey string, variables map[string]interface{}) (*ent.ProcessInstance, error) {
+	create := txc.ProcessInstance.Create()
+	if parent != nil {
+		rootID := parent.RootProcessInstanceID
+		if rootID == "" {
+			rootID = parent.ProcessInstanceID
+		}
+		create.SetParentProcessInstanceID(parent.ProcessInstanceID).SetRootProcessInstanceID(rootID)
+	}
+	instance, err := create.
+		SetProcessInstanceID(fmt.Sprintf("PI-%s-%d", definition.Key, time.Now().UnixNano())).
+		SetBusinessKey(businessKey).
+		SetProcessDefinitionKey(definition.Key).
+		SetProcessDefinitionID(definition.ID).
+		SetStatus("running").
+		SetVariables(variables).
+		SetStartTime(time.Now()).
+		SetTenantID(definition.TenantID).
+		SetCurrentActivityID(startEvent.ID).
+		SetCurrentActivityName(startEvent.Name).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("创建流程实例失败: %w", err)
+	}
+	if err := e.executeStep(ctx, txc, instance, process, startEvent.ID, variables); err != nil {
+		return nil, err
+	}
+	return instance, nil
+}
+
 // CompleteTask 完成任务。
 // 整个「置完成 → 合并变量 → 推进流程 → 记录审批决策」包进单个 ent.Tx，
 // 任一步骤失败整体回滚，彻底消除并发下的半成品状态（P2 事务原子化）。
@@ -360,6 +396,12 @@ func (e *CustomProcessEngine) CompleteTask(ctx context.Context, taskID string, v
 		return fmt.Errorf("任务已结束，不能重复完成")
 	}
 
+	// 多实例任务：提交的变量属于该实例本地，与实例的 loopCounter 等本地变量一起保存在任务上
+	multiInstance := e.findMultiInstanceUserTask(process, task)
+	taskVariables := variables
+	if multiInstance != nil {
+		taskVariables = multiInstanceTaskVariables(task, multiInstance.MultiInstance, variables)
+	}
 	updated, err := txc.ProcessTask.Update().
 		Where(
 			processtask.ID(task.ID),
@@ -369,7 +411,7 @@ func (e *CustomProcessEngine) CompleteTask(ctx context.Context, taskID string, v
 		).
 		SetStatus("completed").
 		SetCompletedTime(time.Now()).
-		SetTaskVariables(variables).
+		SetTaskVariables(taskVariables).
 		Save(ctx)
 	if err != nil {
 		_ = tx.Rollback()
@@ -379,18 +421,32 @@ func (e *CustomProcessEngine) CompleteTask(ctx context.Context, taskID string, v
 		_ = tx.Rollback()
 		return fmt.Errorf("任务已被处理，请刷新后重试")
 	}
+	if multiInstance != nil {
+		// 多实例：汇总实例结果，满足完成条件（或全部实例完成）时活动才结束并推进流程
+		if err := e.completeMultiInstanceTask(ctx, txc, instance, process, multiInstance, taskVariables, variables); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	} else {
+		// 活动正常结束，撤销其上挂载的边界事件（定时器与消息/信号订阅）
+		if err := e.cancelBoundaryEvents(ctx, txc, instance, process, task.TaskDefinitionKey); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+		e.registerCompensable(ctx, txc, instance, process, task.TaskDefinitionKey)
 
-	// 5. 在事务内合并变量（无并发写者，直接合并即可）
-	instance, err = e.mergeVariablesInTx(ctx, txc, instance.ID, variables)
-	if err != nil {
-		_ = tx.Rollback()
-		return fmt.Errorf("合并实例变量失败: %w", err)
-	}
+		// 5. 在事务内合并变量（无并发写者，直接合并即可）
+		instance, err = e.mergeVariablesInTx(ctx, txc, instance.ID, variables)
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("合并实例变量失败: %w", err)
+		}
 
-	// 6. 执行流程推进（从当前UserTask继续）
-	if err := e.executeStep(ctx, txc, instance, process, task.TaskDefinitionKey, instance.Variables); err != nil {
-		_ = tx.Rollback()
-		return err
+		// 6. 执行流程推进（从当前UserTask继续）
+		if err := e.executeStep(ctx, txc, instance, process, task.TaskDefinitionKey, instance.Variables); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
 	}
 	if err := e.recordApprovalDecision(ctx, txc, instance, task, variables); err != nil {
 		_ = tx.Rollback()
@@ -504,7 +560,7 @@ func (e *CustomProcessEngine) executeStep(ctx context.Context, txc *ent.Client,
 
 	if len(outgoingFlows) == 0 {
 		if e.isEndEvent(process, currentElementID) {
-			return e.completeProcess(ctx, txc, instance)
+			return e.completeScope(ctx, txc, instance, process, currentElementID)
 		}
 		return nil
 	}
@@ -549,60 +605,89 @@ func (e *CustomProcessEngine) handleElement(ctx context.Context, txc *ent.Client
 	// Debug: log element info
 	e.logger.Debugw("handleElement called", "elementID", elementID, "elementName", elementName, "userTasksCount", len(process.UserTasks))
 
-	if task := e.findUserTask(process, elementID); task != nil {
+	if task := e.findUserTask(process, elementID); task != nil && task.MultiInstance != nil {
+		// 多实例用户任务：按集合或基数并行/串行创建任务实例
+		return e.enterMultiInstanceUserTask(ctx, txc, instance, process, task)
+	} else if task != nil {
 		e.logger.Infow("Found user task, creating task", "taskID", task.ID, "taskName", task.Name)
-		return e.createUserTask(ctx, txc, instance, task)
+		if err := e.createUserTask(ctx, txc, instance, task); err != nil {
+			return err
+		}
+		return e.enterActivityBoundaries(ctx, txc, instance, process, task.ID)
+	} else if event := e.findIntermediateEvent(process, elementID); event != nil && (event.TimerDefinition != nil || event.TimerRef != "") {
+		// 中间定时器捕获事件：持久化定时器后在此等待，到期由 commandbus 触发继续推进
+		return e.enterIntermediateTimer(ctx, txc, instance, event)
+	} else if eventType, eventName := intermediateEventTrigger(process, event); eventType != "" {
+		// 中间消息/信号捕获事件：登记订阅后在此等待 CorrelateMessage / BroadcastSignal
+		return e.enterCatchEvent(ctx, txc, instance, elementID, eventType, eventName)
+	} else if receiveTask := e.findReceiveTask(process, elementID); receiveTask != nil {
+		// 接收任务：等待与 messageRef 对应的消息
+		if err := e.enterCatchEvent(ctx, txc, instance, elementID, bpmnEventMessage, process.MessageName(receiveTask.MessageRef)); err != nil {
+			return err
+		}
+		return e.enterActivityBoundaries(ctx, txc, instance, process, elementID)
 	} else if endEvent := e.findEndEvent(process, elementID); endEvent != nil {
 		e.markElementDone(ctx, txc, instance, elementID)
-		return e.completeProcess(ctx, txc, instance)
+		if errorRef, isError := eventErrorRef(endEvent.ErrorRef, endEvent.ErrorDefinition); isError {
+			// 错误结束事件：向外层作用域抛出错误
+			return e.handleErrorEndEvent(ctx, txc, instance, process, endEvent, errorRef)
+		}
+		if def := endEvent.CompensateDefinition; def != nil {
+			// 补偿结束事件：先逆序补偿已完成活动再结束作用域
+			if err := e.compensate(ctx, txc, instance, process, elementID, def.ActivityRef); err != nil {
+				return err
+			}
+		}
+		return e.completeScope(ctx, txc, instance, process, elementID)
+	} else if event := e.findIntermediateThrowEvent(process, elementID); event != nil && event.CompensateDefinition != nil {
+		// 补偿中间抛出事件：逆序补偿已完成活动后沿出边继续
+		if err := e.compensate(ctx, txc, instance, process, elementID, event.CompensateDefinition.ActivityRef); err != nil {
+			return err
+		}
+		e.markElementDone(ctx, txc, instance, elementID)
+		return e.executeStep(ctx, txc, instance, process, elementID, instance.Variables)
 	} else if gateway := e.findParallelGateway(process, elementID); gateway != nil {
 		// 并行网关：分叉激活所有出边；汇聚等待所有入边分支完成（F-1）
 		return e.handleParallelGateway(ctx, txc, instance, process, gateway, 0)
 	} else if gateway := e.findInclusiveGateway(process, elementID); gateway != nil {
 		// 包容网关：分叉激活所有命中条件的出边；汇聚等待所有入边分支完成（F-1）
 		return e.handleInclusiveGateway(ctx, txc, instance, process, gateway, 0)
+	} else if gateway := e.findEventBasedGateway(process, elementID); gateway != nil {
+		// 事件网关：同时等待出边上的全部捕获事件，最先触发者胜出
+		return e.enterEventBasedGateway(ctx, txc, instance, process, gateway)
 	} else if gateway := e.findExclusiveGateway(process, elementID); gateway != nil {
 		e.markElementDone(ctx, txc, instance, elementID)
 		return e.executeStep(ctx, txc, instance, process, elementID, instance.Variables)
-	} else if serviceTask := e.findServiceTask(process, elementID); serviceTask != nil {
+	} else if serviceTask := e.findServiceTask(process, elementID); serviceTask != nil && serviceTask.AsyncBefore {
+		// 异步服务任务：交由 commandbus 在事务外执行，实例在此等待
+		return e.enterAsyncServiceTask(ctx, txc, instance, process, serviceTask)
+	} else if serviceTask != nil && serviceTask.MultiInstance != nil {
+		// 多实例服务任务：对每个元素依次执行，结果汇总到输出集合
+		return e.executeMultiInstanceServiceTask(ctx, txc, instance, process, serviceTask)
+	} else if serviceTask != nil {
 		// 通过 CallbackRegistry 执行真实的服务任务逻辑
-		serviceRef := serviceTask.ID
-		if serviceTask.Name != "" {
-			serviceRef = serviceTask.Name
-		}
-		// 尝试通过实现类或表达式属性获取服务引用
-		if serviceTask.Implementation != "" {
-			serviceRef = serviceTask.Implementation
-		} else if serviceTask.Class != "" {
-			serviceRef = serviceTask.Class
-		} else if serviceTask.DelegateExpression != "" {
-			serviceRef = serviceTask.DelegateExpression
-		} else if serviceTask.OperationRef != "" {
-			serviceRef = serviceTask.OperationRef
-		}
-
-		// 查找并执行 Callback
-		if e.callbackRegistry != nil {
-			handler := e.callbackRegistry.GetHandler(serviceRef)
-			if handler == nil {
-				// 尝试按任务类型匹配
-				handler = e.callbackRegistry.GetHandler(serviceTask.GetType())
-			}
-			if handler != nil {
-				e.logger.Infow("执行 ServiceTask 回调", "serviceRef", serviceRef, "elementID", elementID)
-				taskVariables := mergeServiceTaskVariables(instance.Variables, serviceTask)
-				if _, err := handler.Execute(ctx, nil, taskVariables); err != nil {
-					return fmt.Errorf("ServiceTask %s 执行失败: %w", serviceRef, err)
-				}
-			} else {
-				// 未注册的 ServiceTask 视为 NoOp，仅记录警告不阻断流程
-				e.logger.Warnw("未注册的 ServiceTask，跳过执行", "serviceRef", serviceRef, "elementID", elementID)
-			}
+		if err := e.runServiceTask(ctx, instance.Variables, serviceTask, nil); err != nil {
+			// 处理器返回 BPMNError：路由到错误边界事件，未被捕获时整个事务失败
+			return e.throwTaskError(ctx, txc, instance, process, elementID, err)
 		}
+		e.registerCompensable(ctx, txc, instance, process, elementID)
 		e.markElementDone(ctx, txc, instance, elementID)
 		return e.executeStep(ctx, txc, instance, process, elementID, instance.Variables)
-	}
-
+	} else if scriptTask := e.findScriptTask(process, elementID); scriptTask != nil {
+		// 脚本任务：在沙箱中执行脚本，写入的变量合并回实例后继续；失败按 BPMN 错误路由
+		return e.executeScriptTask(ctx, txc, instance, process, scriptTask)
+	} else if ruleTask := e.findBusinessRuleTask(process, elementID); ruleTask != nil && ruleTask.DecisionKey() != "" {
+		// 业务规则任务：求值 DMN 决策表，输出写入流程变量后继续（未引用决策的沿用直通行为）
+		return e.executeBusinessRuleTask(ctx, txc, instance, process, ruleTask)
+	} else if activity := e.findCallActivity(process, elementID); activity != nil {
+		// 调用活动：启动被调用流程的子实例并在此等待其结束
+		return e.enterCallActivity(ctx, txc, instance, process, activity)
+	} else if sub := e.findSubProcess(process, elementID); sub != nil && !sub.TriggeredByEvent {
+		// 嵌入式子流程：进入子流程作用域，从其开始事件推进
+		return e.enterSubProcess(ctx, txc, instance, process, sub)
+	}
+
+	e.registerCompensable(ctx, txc, instance, process, elementID)
 	e.markElementDone(ctx, txc, instance, elementID)
 	return e.executeStep(ctx, txc, instance, process, elementID, instance.Variables)
 }
@@ -657,9 +742,14 @@ func (e *CustomProcessEngine) createUserTask(ctx context.Context, txc *ent.Clien
 		e.logger.Infow("createUserTask: 复用已存在的活跃任务", "existingTaskID", existing.TaskID, "node", task.ID)
 		return nil
 	}
+	return e.createUserTaskInstance(ctx, txc, instance, task, nil)
+}
 
+// createUserTaskInstance 创建一个用户任务实例；locals 为多实例的本地变量，写入任务变量并参与 ${...} 分配表达式求值
+func (e *CustomProcessEngine) createUserTaskInstance(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, task *BPMNUserTask, locals map[string]interface{}) error {
 	// 自动分配逻辑：优先级 BPMN定义 > 流程变量(request/assignee) > 默认分配
-	assignee := task.Assignee
+	assignee := e.resolveAssignmentExpression(task.Assignee, instance.Variables, locals)
+	candidateUsers := e.resolveAssignmentExpression(task.CandidateUsers, instance.Variables, locals)
 
 	// 辅助函数：从变量中提取用户ID
 	getUserID := func(key string) string {
@@ -703,7 +793,7 @@ func (e *CustomProcessEngine) createUserTask(ctx context.Context, txc *ent.Clien
 
 	// 展开 candidateGroups 为具体用户，合并到 candidate_users。
 	// 这样「我的待办」接口才有可能查到分配给我的任务。
-	expandedCandidateUsers := task.CandidateUsers
+	expandedCandidateUsers := candidateUsers
 	if e.groupResolver != nil && strings.TrimSpace(task.CandidateGroups) != "" {
 		_, groupUsernames, err := e.groupResolver.ExpandGroupsToUsers(ctx, instance.TenantID, task.CandidateGroups)
 		if err != nil {
@@ -715,7 +805,7 @@ func (e *CustomProcessEngine) createUserTask(ctx context.Context, txc *ent.Clien
 				"error", err,
 			)
 		} else {
-			expandedCandidateUsers = e.groupResolver.MergeCandidateUsers(task.CandidateUsers, groupUsernames)
+			expandedCandidateUsers = e.groupResolver.MergeCandidateUsers(candidateUsers, groupUsernames)
 			e.logger.Infow(
 				"审批组已展开",
 				"taskID", task.ID,
@@ -733,8 +823,15 @@ func (e *CustomProcessEngine) createUserTask(ctx context.Context, txc *ent.Clien
 		"allowAddApprover":        task.AllowAddApprover,
 		"commentRequiredOnReject": task.CommentRequiredOnReject,
 	}
+	taskID := fmt.Sprintf("TASK-%s-%d", task.ID, time.Now().UnixNano())
+	if counter, ok := locals[bpmnLoopCounterVariable]; ok {
+		taskID = fmt.Sprintf("%s-%v", taskID, counter)
+	}
+	for k, v := range locals {
+		taskConfig[k] = v
+	}
 	createdTask, err := txc.ProcessTask.Create().
-		SetTaskID(fmt.Sprintf("TASK-%s-%d", task.ID, time.Now().UnixNano())).
+		SetTaskID(taskID).
 		SetProcessInstanceID(instance.ID).
 		SetProcessDefinitionKey(instance.ProcessDefinitionKey).
 		SetTaskDefinitionKey(task.ID).
@@ -942,7 +1039,18 @@ func (e *CustomProcessEngine) completeProcess(ctx context.Context, txc *ent.Clie
 		SetStatus("completed").
 		SetEndTime(time.Now()).
 		Save(ctx)
-	return err
+	if err != nil {
+		return err
+	}
+	// 实例已结束：其余分支残留的事件订阅不再有效
+	if err := e.cancelEventSubscriptions(ctx, txc, instance); err != nil {
+		return err
+	}
+	// 调用活动启动的子实例结束：回到父实例继续
+	if instance.ParentProcessInstanceID != "" {
+		return e.resumeCallingInstance(ctx, txc, instance)
+	}
+	return nil
 }
 
 // recordGatewayHistory 将网关路由决策写入流程执行历史，使并行/包容/排他网关的
@@ -981,7 +1089,7 @@ func (e *CustomProcessEngine) recordGatewayHistory(ctx context.Context, txc *ent
 
 func (e *CustomProcessEngine) findOutgoingFlows(process *BPMNProcess, sourceRef string) []*BPMNSequenceFlow {
 	var flows []*BPMNSequenceFlow
-	for _, flow := range process.SequenceFlows {
+	for _, flow := range process.scopeOf(sourceRef).SequenceFlows {
 		if flow.SourceRef == sourceRef {
 			flows = append(flows, flow)
 		}
@@ -1032,7 +1140,7 @@ func (e *CustomProcessEngine) evaluateCondition(flow *BPMNSequenceFlow, variable
 }
 
 func (e *CustomProcessEngine) isEndEvent(process *BPMNProcess, id string) bool {
-	for _, event := range process.EndEvents {
+	for _, event := range process.scopeOf(id).EndEvents {
 		if event.ID == id {
 			return true
 		}
@@ -1041,7 +1149,7 @@ func (e *CustomProcessEngine) isEndEvent(process *BPMNProcess, id string) bool {
 }
 
 func (e *CustomProcessEngine) findUserTask(process *BPMNProcess, id string) *BPMNUserTask {
-	for _, task := range process.UserTasks {
+	for _, task := range process.scopeOf(id).UserTasks {
 		if task.ID == id {
 			return task
 		}
@@ -1050,7 +1158,16 @@ func (e *CustomProcessEngine) findUserTask(process *BPMNProcess, id string) *BPM
 }
 
 func (e *CustomProcessEngine) findEndEvent(process *BPMNProcess, id string) *BPMNEndEvent {
-	for _, event := range process.EndEvents {
+	for _, event := range process.scopeOf(id).EndEvents {
+		if event.ID == id {
+			return event
+		}
+	}
+	return nil
+}
+
+func (e *CustomProcessEngine) findIntermediateEvent(process *BPMNProcess, id string) *BPMNIntermediateEvent {
+	for _, event := range process.scopeOf(id).IntermediateEvents {
 		if event.ID == id {
 			return event
 		}
@@ -1059,7 +1176,7 @@ func (e *CustomProcessEngine) findEndEvent(process *BPMNProcess, id string) *BPM
 }
 
 func (e *CustomProcessEngine) findExclusiveGateway(process *BPMNProcess, id string) *BPMNExclusiveGateway {
-	for _, gateway := range process.ExclusiveGateways {
+	for _, gateway := range process.scopeOf(id).ExclusiveGateways {
 		if gateway.ID == id {
 			return gateway
 		}
@@ -1068,7 +1185,7 @@ func (e *CustomProcessEngine) findExclusiveGateway(process *BPMNProcess, id stri
 }
 
 func (e *CustomProcessEngine) findParallelGateway(process *BPMNProcess, id string) *BPMNParallelGateway {
-	for _, gateway := range process.ParallelGateways {
+	for _, gateway := range process.scopeOf(id).ParallelGateways {
 		if gateway.ID == id {
 			return gateway
 		}
@@ -1077,7 +1194,7 @@ func (e *CustomProcessEngine) findParallelGateway(process *BPMNProcess, id strin
 }
 
 func (e *CustomProcessEngine) findInclusiveGateway(process *BPMNProcess, id string) *BPMNInclusiveGateway {
-	for _, gateway := range process.InclusiveGateways {
+	for _, gateway := range process.scopeOf(id).InclusiveGateways {
 		if gateway.ID == id {
 			return gateway
 		}
@@ -1088,7 +1205,7 @@ func (e *CustomProcessEngine) findInclusiveGateway(process *BPMNProcess, id stri
 // findIncomingFlows 返回以 targetRef 为目标的顺序流（即 targetRef 的入边）
 func (e *CustomProcessEngine) findIncomingFlows(process *BPMNProcess, targetRef string) []*BPMNSequenceFlow {
 	var flows []*BPMNSequenceFlow
-	for _, flow := range process.SequenceFlows {
+	for _, flow := range process.scopeOf(targetRef).SequenceFlows {
 		if flow.TargetRef == targetRef {
 			flows = append(flows, flow)
 		}
@@ -1104,7 +1221,7 @@ func (e *CustomProcessEngine) handleParallelGateway(ctx context.Context, txc *en
 		return fmt.Errorf("并行网关分叉层级超过上限，可能存在环路: %s", gateway.ID)
 	}
 	incoming := e.findIncomingFlows(process, gateway.ID)
-	if len(incoming) > 1 && !e.allIncomingBranchesCompleted(ctx, instance, process, gateway.ID) {
+	if len(incoming) > 1 && !e.allIncomingBranchesCompleted(ctx, txc, instance, process, gateway.ID) {
 		e.logger.Infow("并行网关汇聚等待其余分支完成", "gateway", gateway.ID, "instance", instance.ID)
 		e.recordGatewayHistory(ctx, txc, instance, gateway.ID, "parallel", "join-wait", nil, instance.Variables)
 		return nil // 仍有分支未结束，等待，不推进
@@ -1127,7 +1244,7 @@ func (e *CustomProcessEngine) handleInclusiveGateway(ctx context.Context, txc *e
 		return fmt.Errorf("包容网关分叉层级超过上限，可能存在环路: %s", gateway.ID)
 	}
 	incoming := e.findIncomingFlows(process, gateway.ID)
-	if len(incoming) > 1 && !e.allIncomingBranchesCompleted(ctx, instance, process, gateway.ID) {
+	if len(incoming) > 1 && !e.allIncomingBranchesCompleted(ctx, txc, instance, process, gateway.ID) {
 		e.logger.Infow("包容网关汇聚等待其余分支完成", "gateway", gateway.ID, "instance", instance.ID)
 		e.recordGatewayHistory(ctx, txc, instance, gateway.ID, "inclusive", "join-wait", nil, instance.Variables)
 		return nil
@@ -1187,7 +1304,8 @@ func (e *CustomProcessEngine) markElementDone(ctx context.Context, txc *ent.Clie
 //   - 以服务任务/子网关/排他网关/结束事件为源的分支：必须已在 _done_ 中标记为完成。
 //     旧实现对这些非用户任务源直接 continue（永远视为完成），会导致提前汇聚或死锁（P1 网关完整性）。
 //   - 查询失败时保守返回 false（视为未完成），避免提前汇聚。
-func (e *CustomProcessEngine) allIncomingBranchesCompleted(ctx context.Context, instance *ent.ProcessInstance, process *BPMNProcess, gatewayID string) bool {
+//   - 必须在当前事务内查询：刚在本事务中完成的任务对事务外不可见，否则最后一个分支永远汇聚不上。
+func (e *CustomProcessEngine) allIncomingBranchesCompleted(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, process *BPMNProcess, gatewayID string) bool {
 	done := map[string]interface{}{}
 	if instance.Variables != nil {
 		if d, ok := instance.Variables["_done_"].(map[string]interface{}); ok {
@@ -1198,7 +1316,7 @@ func (e *CustomProcessEngine) allIncomingBranchesCompleted(ctx context.Context,
 		src := flow.SourceRef
 		if e.findUserTask(process, src) != nil {
 			// 用户任务源：以 DB 实际完成状态为准
-			open, err := e.client.ProcessTask.Query().
+			open, err := txc.ProcessTask.Query().
 				Where(
 					processtask.ProcessInstanceID(instance.ID),
 					processtask.TaskDefinitionKey(src),
@@ -1239,7 +1357,7 @@ func (e *CustomProcessEngine) DetectStuckInstances(ctx context.Context, tenantID
 }
 
 func (e *CustomProcessEngine) findServiceTask(process *BPMNProcess, id string) *BPMNServiceTask {
-	for _, task := range process.ServiceTasks {
+	for _, task := range process.scopeOf(id).ServiceTasks {
 		if task.ID == id {
 			return task
 		}
@@ -1319,6 +1437,10 @@ func (e *CustomProcessEngine) ResumeProcess(ctx context.Context, processInstance
 	if err != nil {
 		return fmt.Errorf("恢复流程实例失败: %w", err)
 	}
+	// 暂停期间到期的定时器已被顺延，恢复后提前到原到期时间
+	if err := e.resumeInstanceTimers(ctx, e.client, instance); err != nil {
+		e.logger.Warnw("恢复定时器失败，将在顺延时间到达后触发", "instance", instance.ID, "error", err)
+	}
 
 	// 3. 记录审计日志
 	userID := 0
@@ -1360,25 +1482,9 @@ func (e *CustomProcessEngine) TerminateProcess(ctx context.Context, processInsta
 		return fmt.Errorf("获取流程实例失败: %w", err)
 	}
 
-	// 2. 更新实例状态
-	_, err = e.client.ProcessInstance.UpdateOne(instance).
-		SetStatus("terminated").
-		SetEndTime(time.Now()).
-		Save(ctx)
-	if err != nil {
-		return fmt.Errorf("终止流程实例失败: %w", err)
-	}
-
-	// 3. 取消所有进行中的任务
-	_, err = e.client.ProcessTask.Update().
-		Where(processtask.ProcessInstanceID(instance.ID)).
-		Where(processtask.StatusNEQ("completed")).
-		Where(processtask.StatusNEQ("cancelled")).
-		SetStatus("cancelled").
-		SetCompletedTime(time.Now()).
-		Save(ctx)
-	if err != nil {
-		e.logger.Warnw("取消流程任务失败", "error", err)
+	// 2-3. 更新实例状态、取消进行中的任务/定时器/订阅，并级联终止调用活动的子实例
+	if err := e.terminateInstance(ctx, e.client, instance); err != nil {
+		return err
 	}
 
 	// 4. 记录审计日志
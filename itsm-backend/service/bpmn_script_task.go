@@ -0,0 +1,275 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"itsm-backend/ent"
+	"itsm-backend/ent/user"
+	"itsm-backend/service/bpmn"
+
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
+)
+
+// BPMN 脚本任务
+//
+// 脚本任务在沙箱中运行 expr-lang 脚本（scriptFormat 为空、expr 或 expression），不嵌入 JavaScript 解释器：
+//   - 语句以 ; 分隔，可用 let 定义局部变量，最后一个表达式的值写入 resultVariable
+//   - 流程变量可直接按名称读取；getVariable / setVariable / hasVariable 读写流程变量，写入在脚本成功后统一合并
+//   - 只开放白名单函数：ExpressionEngine 内置的字符串/数学/日期函数，以及 format、addDuration、formatTime、
+//     lookup（按名称注册的租户内查询）。引擎通过 RegisterFunction 注册的函数不对脚本开放
+//   - 限制脚本长度、语法树节点数、VM 内存预算与执行时长；超限、编译或运行失败以 SCRIPT_ERROR 抛出 BPMN 错误，
+//     脚本也可调用 throwError(code, message) 抛出指定错误码，均可被错误边界事件捕获
+
+// BPMNScriptErrorCode 脚本执行失败时抛出的 BPMN 错误码
+const BPMNScriptErrorCode = "SCRIPT_ERROR"
+
+const (
+	bpmnScriptMaxLength    = 16 * 1024
+	bpmnScriptMaxNodes     = 2000
+	bpmnScriptMemoryBudget = 100000
+	bpmnScriptTimeout      = 2 * time.Second
+)
+
+// ScriptLookupFunc 脚本 lookup 查询：在租户范围内按 key 查找数据，返回值须可 JSON 序列化
+type ScriptLookupFunc func(ctx context.Context, client *ent.Client, tenantID int, key interface{}) (interface{}, error)
+
+// ScriptSandbox 脚本任务沙箱
+type ScriptSandbox struct {
+	Timeout      time.Duration // 单次执行时长上限
+	MemoryBudget uint          // expr VM 内存预算（分配单元数）
+	MaxNodes     uint          // 脚本语法树节点数上限
+	MaxLength    int           // 脚本长度上限（字节）
+
+	mu      sync.RWMutex
+	lookups map[string]ScriptLookupFunc
+}
+
+// ScriptResult 脚本执行结果
+type ScriptResult struct {
+	Value     interface{}            // 最后一个表达式的值
+	Variables map[string]interface{} // 脚本通过 setVariable 写入的流程变量
+}
+
+// NewScriptSandbox 创建脚本沙箱，默认注册 user 查询
+func NewScriptSandbox() *ScriptSandbox {
+	s := &ScriptSandbox{
+		Timeout:      bpmnScriptTimeout,
+		MemoryBudget: bpmnScriptMemoryBudget,
+		MaxNodes:     bpmnScriptMaxNodes,
+		MaxLength:    bpmnScriptMaxLength,
+		lookups:      make(map[string]ScriptLookupFunc),
+	}
+	s.RegisterLookup("user", lookupScriptUser)
+	return s
+}
+
+// RegisterLookup 注册脚本可调用的 lookup(name, key) 查询，同名覆盖
+func (s *ScriptSandbox) RegisterLookup(name string, fn ScriptLookupFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lookups[name] = fn
+}
+
+func (s *ScriptSandbox) lookup(name string) (ScriptLookupFunc, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	fn, ok := s.lookups[name]
+	return fn, ok
+}
+
+// Run 在沙箱中执行脚本。失败时返回 *bpmn.BPMNError
+func (s *ScriptSandbox) Run(ctx context.Context, client *ent.Client, tenantID int, format, script string, variables map[string]interface{}) (*ScriptResult, error) {
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case "", "expr", "expression":
+	default:
+		return nil, scriptError("不支持的脚本格式 %q，仅支持 expr", format)
+	}
+	script = strings.TrimSpace(script)
+	result := &ScriptResult{Variables: map[string]interface{}{}}
+	if script == "" {
+		return result, nil
+	}
+	if len(script) > s.MaxLength {
+		return nil, scriptError("脚本长度 %d 超过上限 %d", len(script), s.MaxLength)
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, s.Timeout)
+	defer cancel()
+	env := s.buildEnv(runCtx, client, tenantID, variables, result.Variables)
+	program, err := expr.Compile(script, expr.Env(env), expr.MaxNodes(s.MaxNodes))
+	if err != nil {
+		return nil, scriptError("脚本编译失败: %v", err)
+	}
+
+	type outcome struct {
+		value interface{}
+		err   error
+	}
+	done := make(chan outcome, 1)
+	go func() {
+		machine := &vm.VM{MemoryBudget: s.MemoryBudget}
+		value, err := machine.Run(program, env)
+		done <- outcome{value: value, err: err}
+	}()
+
+	select {
+	case out := <-done:
+		if out.err != nil {
+			var bpmnErr *bpmn.BPMNError
+			if errors.As(out.err, &bpmnErr) {
+				return nil, bpmnErr
+			}
+			return nil, scriptError("脚本执行失败: %v", out.err)
+		}
+		if _, err := json.Marshal(out.value); err != nil {
+			return nil, scriptError("脚本结果无法序列化: %v", err)
+		}
+		result.Value = out.value
+		return result, nil
+	case <-runCtx.Done():
+		// expr VM 不支持中途取消：放弃等待，内存预算保证遗留的执行有界
+		return nil, scriptError("脚本执行超过时限 %s", s.Timeout)
+	}
+}
+
+// buildEnv 构建脚本环境：流程变量（不含引擎内部状态）+ 变量读写 API + 白名单函数
+func (s *ScriptSandbox) buildEnv(ctx context.Context, client *ent.Client, tenantID int, variables, writes map[string]interface{}) map[string]interface{} {
+	env := make(map[string]interface{}, len(variables)+64)
+	for k, v := range variables {
+		if !engineStateVariables[k] {
+			env[k] = v
+		}
+	}
+	NewExpressionEngine().registerBuiltinFunctions(env)
+
+	readVariable := func(name string) (interface{}, bool) {
+		if v, ok := writes[name]; ok {
+			return v, true
+		}
+		if engineStateVariables[name] {
+			return nil, false
+		}
+		v, ok := variables[name]
+		return v, ok
+	}
+	env["getVariable"] = func(name string) interface{} {
+		v, _ := readVariable(name)
+		return v
+	}
+	env["hasVariable"] = func(name string) bool {
+		_, ok := readVariable(name)
+		return ok
+	}
+	env["setVariable"] = func(name string, value interface{}) (interface{}, error) {
+		if name == "" || engineStateVariables[name] {
+			return nil, fmt.Errorf("不允许写入变量 %q", name)
+		}
+		if _, err := json.Marshal(value); err != nil {
+			return nil, fmt.Errorf("变量 %s 的值无法序列化: %w", name, err)
+		}
+		writes[name] = value
+		return value, nil
+	}
+	env["throwError"] = func(code, message string) (interface{}, error) {
+		return nil, bpmn.NewBPMNError(code, message)
+	}
+
+	env["format"] = fmt.Sprintf
+	env["addDuration"] = func(t, duration string) (string, error) {
+		base, err := time.Parse(time.RFC3339, t)
+		if err != nil {
+			return "", fmt.Errorf("无法解析时间 %q: %w", t, err)
+		}
+		d, err := parseISODuration(duration)
+		if err != nil {
+			return "", err
+		}
+		return d.addTo(base).Format(time.RFC3339), nil
+	}
+	env["formatTime"] = func(t, layout string) (string, error) {
+		parsed, err := time.Parse(time.RFC3339, t)
+		if err != nil {
+			return "", fmt.Errorf("无法解析时间 %q: %w", t, err)
+		}
+		return parsed.Format(layout), nil
+	}
+	env["lookup"] = func(name string, key interface{}) (interface{}, error) {
+		fn, ok := s.lookup(name)
+		if !ok {
+			return nil, fmt.Errorf("未注册的 lookup: %s", name)
+		}
+		return fn(ctx, client, tenantID, key)
+	}
+	return env
+}
+
+// lookupScriptUser 按用户ID查询本租户用户的公开信息，不存在时返回 nil
+func lookupScriptUser(ctx context.Context, client *ent.Client, tenantID int, key interface{}) (interface{}, error) {
+	id, ok := numericInt(key)
+	if !ok {
+		return nil, fmt.Errorf("无效的用户ID: %v", key)
+	}
+	u, err := client.User.Query().Where(user.ID(id), user.TenantID(tenantID)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询用户失败: %w", err)
+	}
+	return map[string]interface{}{
+		"id":            u.ID,
+		"username":      u.Username,
+		"name":          u.Name,
+		"email":         u.Email,
+		"department":    u.Department,
+		"department_id": u.DepartmentID,
+	}, nil
+}
+
+func scriptError(format string, args ...interface{}) *bpmn.BPMNError {
+	return bpmn.NewBPMNError(BPMNScriptErrorCode, fmt.Sprintf(format, args...))
+}
+
+func (e *CustomProcessEngine) findScriptTask(process *BPMNProcess, id string) *BPMNScriptTask {
+	for _, task := range process.scopeOf(id).ScriptTasks {
+		if task.ID == id {
+			return task
+		}
+	}
+	return nil
+}
+
+// ScriptSandbox 返回脚本任务沙箱，用于注册 lookup 或调整限制
+func (e *CustomProcessEngine) ScriptSandbox() *ScriptSandbox {
+	return e.scriptSandbox
+}
+
+// executeScriptTask 执行脚本任务：成功时合并脚本写入的变量并继续，失败时按 BPMN 错误路由
+func (e *CustomProcessEngine) executeScriptTask(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, process *BPMNProcess, task *BPMNScriptTask) error {
+	result, err := e.scriptSandbox.Run(ctx, txc, instance.TenantID, task.ScriptFormat, task.Script, instance.Variables)
+	if err != nil {
+		e.logger.Warnw("脚本任务执行失败", "instance", instance.ProcessInstanceID, "element", task.ID, "error", err)
+		return e.throwTaskError(ctx, txc, instance, process, task.ID, err)
+	}
+	outputs := result.Variables
+	if task.ResultVariable != "" {
+		outputs[task.ResultVariable] = result.Value
+	}
+	if len(outputs) > 0 {
+		updated, err := e.mergeVariablesInTx(ctx, txc, instance.ID, outputs)
+		if err != nil {
+			return err
+		}
+		*instance = *updated
+	}
+	e.registerCompensable(ctx, txc, instance, process, task.ID)
+	e.markElementDone(ctx, txc, instance, task.ID)
+	return e.executeStep(ctx, txc, instance, process, task.ID, instance.Variables)
+}
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"itsm-backend/ent"
+	"itsm-backend/ent/enttest"
+	"itsm-backend/service/bpmn"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 脚本计算报价并校验金额，校验失败进入人工复核
+const scriptTaskBPMN = `<?xml version="1.0" encoding="UTF-8"?>
+<bpmn:definitions xmlns:bpmn="http://www.omg.org/spec/BPMN/20100524/MODEL" xmlns:camunda="http://camunda.org/schema/1.0/bpmn" id="Definitions_script" targetNamespace="http://bpmn.io/schema/bpmn">
+  <bpmn:error id="Error_Limit" errorCode="OVER_LIMIT"/>
+  <bpmn:process id="Process_script" name="Quote" isExecutable="true">
+    <bpmn:startEvent id="StartEvent_1"/>
+    <bpmn:scriptTask id="Quote" name="计算报价" scriptFormat="expr" camunda:resultVariable="quote_label">
+      <bpmn:script><![CDATA[
+        let total = price * quantity;
+        setVariable("total", total);
+        setVariable("due_at", addDuration(requested_at, "P2D"));
+        total > 10000 ? throwError("OVER_LIMIT", format("金额 %v 超过限额", total)) : nil;
+        format("%s x%v", upper(sku), quantity)
+      ]]></bpmn:script>
+    </bpmn:scriptTask>
+    <bpmn:boundaryEvent id="OverLimit" attachedToRef="Quote">
+      <bpmn:errorEventDefinition errorRef="Error_Limit" errorMessageVariable="limit_message"/>
+    </bpmn:boundaryEvent>
+    <bpmn:boundaryEvent id="ScriptFailed" attachedToRef="Quote">
+      <bpmn:errorEventDefinition errorCodeVariable="script_error_code"/>
+    </bpmn:boundaryEvent>
+    <bpmn:userTask id="Confirm" name="确认报价" assignee="1"/>
+    <bpmn:userTask id="Approve" name="超额审批" assignee="1"/>
+    <bpmn:userTask id="Fix" name="修复数据" assignee="1"/>
+    <bpmn:endEvent id="EndEvent_1"/>
+    <bpmn:sequenceFlow id="Flow_1" sourceRef="StartEvent_1" targetRef="Quote"/>
+    <bpmn:sequenceFlow id="Flow_2" sourceRef="Quote" targetRef="Confirm"/>
+    <bpmn:sequenceFlow id="Flow_3" sourceRef="Confirm" targetRef="EndEvent_1"/>
+    <bpmn:sequenceFlow id="Flow_4" sourceRef="OverLimit" targetRef="Approve"/>
+    <bpmn:sequenceFlow id="Flow_5" sourceRef="Approve" targetRef="EndEvent_1"/>
+    <bpmn:sequenceFlow id="Flow_6" sourceRef="ScriptFailed" targetRef="Fix"/>
+    <bpmn:sequenceFlow id="Flow_7" sourceRef="Fix" targetRef="EndEvent_1"/>
+  </bpmn:process>
+</bpmn:definitions>`
+
+func TestScriptTask_WritesVariables(t *testing.T) {
+	engine, client, ctx := seedTimerEngine(t, "script_ok", scriptTaskBPMN)
+
+	inst, err := engine.StartProcess(ctx, "script_ok", "Q-1", map[string]interface{}{
+		"price": 120.5, "quantity": 4, "sku": "vm-small", "requested_at": "2026-03-01T09:00:00Z",
+	})
+	require.NoError(t, err)
+	openTask(t, client, ctx, inst.ID, "Confirm")
+	inst, err = client.ProcessInstance.Get(ctx, inst.ID)
+	require.NoError(t, err)
+	assert.EqualValues(t, 482, inst.Variables["total"])
+	assert.Equal(t, "2026-03-03T09:00:00Z", inst.Variables["due_at"])
+	assert.Equal(t, "VM-SMALL x4", inst.Variables["quote_label"])
+}
+
+func TestScriptTask_FailuresRaiseBPMNErrors(t *testing.T) {
+	engine, client, ctx := seedTimerEngine(t, "script_err", scriptTaskBPMN)
+
+	// 脚本主动抛出错误码，由对应的错误边界事件捕获
+	inst, err := engine.StartProcess(ctx, "script_err", "Q-2", map[string]interface{}{
+		"price": 5000, "quantity": 3, "sku": "gpu", "requested_at": "2026-03-01T09:00:00Z",
+	})
+	require.NoError(t, err)
+	openTask(t, client, ctx, inst.ID, "Approve")
+	inst, err = client.ProcessInstance.Get(ctx, inst.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "金额 15000 超过限额", inst.Variables["limit_message"])
+	assert.NotContains(t, inst.Variables, "total", "失败脚本写入的变量不应合并")
+
+	// 运行错误（时间格式非法）以 SCRIPT_ERROR 抛出，由兜底错误边界事件捕获
+	inst, err = engine.StartProcess(ctx, "script_err", "Q-3", map[string]interface{}{
+		"price": 1, "quantity": 1, "sku": "disk", "requested_at": "yesterday",
+	})
+	require.NoError(t, err)
+	openTask(t, client, ctx, inst.ID, "Fix")
+	inst, err = client.ProcessInstance.Get(ctx, inst.ID)
+	require.NoError(t, err)
+	assert.Equal(t, BPMNScriptErrorCode, inst.Variables["script_error_code"])
+}
+
+func TestScriptTask_UncaughtErrorFailsStart(t *testing.T) {
+	bpmnXML := strings.Replace(scriptTaskBPMN, `<bpmn:errorEventDefinition errorCodeVariable="script_error_code"/>`, `<bpmn:errorEventDefinition errorRef="Error_Limit"/>`, 1)
+	bpmnXML = strings.Replace(bpmnXML, `attachedToRef="Quote">
+      <bpmn:errorEventDefinition errorRef="Error_Limit" errorMessageVariable`, `attachedToRef="Other">
+      <bpmn:errorEventDefinition errorRef="Error_Limit" errorMessageVariable`, 1)
+	engine, _, ctx := seedTimerEngine(t, "script_uncaught", bpmnXML)
+
+	_, err := engine.StartProcess(ctx, "script_uncaught", "Q-4", map[string]interface{}{"price": 1})
+	var bpmnErr *bpmn.BPMNError
+	require.ErrorAs(t, err, &bpmnErr)
+	assert.Equal(t, BPMNScriptErrorCode, bpmnErr.Code)
+}
+
+func TestScriptSandbox_Limits(t *testing.T) {
+	sandbox := NewScriptSandbox()
+	ctx := context.Background()
+	vars := map[string]interface{}{"_done_": map[string]interface{}{"A": true}, "count": 2}
+
+	result, err := sandbox.Run(ctx, nil, 11, "", `hasVariable("_done_") ? "leak" : getVariable("count") + 1`, vars)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, result.Value, "引擎内部状态变量对脚本不可见")
+
+	_, err = sandbox.Run(ctx, nil, 11, "", `setVariable("_done_", nil)`, vars)
+	assert.ErrorContains(t, err, "不允许写入变量")
+
+	_, err = sandbox.Run(ctx, nil, 11, "javascript", `1`, vars)
+	assert.ErrorContains(t, err, "不支持的脚本格式")
+
+	_, err = sandbox.Run(ctx, nil, 11, "", `getTasks("admin")`, vars)
+	assert.ErrorContains(t, err, "编译失败", "引擎注册的函数不对脚本开放")
+
+	_, err = sandbox.Run(ctx, nil, 11, "", `1..1000000`, vars)
+	assert.ErrorContains(t, err, "memory budget exceeded")
+
+	sandbox.MaxLength = 8
+	_, err = sandbox.Run(ctx, nil, 11, "", `count + count + count`, vars)
+	assert.ErrorContains(t, err, "超过上限")
+
+	sandbox = NewScriptSandbox()
+	sandbox.Timeout = 50 * time.Millisecond
+	sandbox.RegisterLookup("slow", func(ctx context.Context, client *ent.Client, tenantID int, key interface{}) (interface{}, error) {
+		<-time.After(time.Second)
+		return key, nil
+	})
+	_, err = sandbox.Run(ctx, nil, 11, "", `lookup("slow", 1)`, vars)
+	var bpmnErr *bpmn.BPMNError
+	require.ErrorAs(t, err, &bpmnErr)
+	assert.Equal(t, BPMNScriptErrorCode, bpmnErr.Code)
+	assert.Contains(t, bpmnErr.Message, "超过时限")
+}
+
+func TestScriptSandbox_UserLookupIsTenantScoped(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", testDSN())
+	t.Cleanup(func() { client.Close() })
+	ctx := context.Background()
+	tn := mkEvalTenant(t, ctx, client, "script")
+	u := mkEvalUser(t, ctx, client, tn.ID, "end_user", "script")
+
+	sandbox := NewScriptSandbox()
+	result, err := sandbox.Run(ctx, client, tn.ID, "", `lookup("user", id).name`, map[string]interface{}{"id": u.ID})
+	require.NoError(t, err)
+	assert.Equal(t, "User script", result.Value)
+
+	result, err = sandbox.Run(ctx, client, tn.ID+1, "", `lookup("user", id) == nil`, map[string]interface{}{"id": u.ID})
+	require.NoError(t, err)
+	assert.Equal(t, true, result.Value, "其他租户的用户不可见")
+}
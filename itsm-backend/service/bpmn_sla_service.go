@@ -35,7 +35,10 @@ type ProcessSLA struct {
 	DeadlineMinutes      int    `json:"deadlineMinutes"`
 	WarningMinutes       int    `json:"warningMinutes"` // 预警时间
 	BusinessHoursOnly    bool   `json:"businessHoursOnly"`
-	Priority             string `json:"priority,omitempty"` // 对应任务优先级
+	Priority             string `json:"priority,omitempty"`   // 对应任务优先级
+	CalendarID           *int   `json:"calendarId,omitempty"` // 工作日历，未指定时依次取团队日历、租户默认日历
+	TeamID               *int   `json:"teamId,omitempty"`     // 处理团队，使用团队的工作日历
+	TenantID             int    `json:"-"`
 }
 
 // SLAStatus SLA状态
@@ -66,6 +69,7 @@ func (s *BPMNSLAService) GetProcessSLA(ctx context.Context, processDefinitionKey
 			DeadlineMinutes:      480, // 8小时
 			WarningMinutes:       360, // 6小时
 			BusinessHoursOnly:    true,
+			TenantID:             definition.TenantID,
 		}, nil
 	}
 
@@ -79,12 +83,16 @@ func (s *BPMNSLAService) GetProcessSLA(ctx context.Context, processDefinitionKey
 		warningMinutes = int(v)
 	}
 
+	calendarID, teamID := slaCalendarRefs(slaConfig)
 	return &ProcessSLA{
 		ProcessDefinitionKey: processDefinitionKey,
 		MilestoneName:        "process_completion",
 		DeadlineMinutes:      deadlineMinutes,
 		WarningMinutes:       warningMinutes,
 		BusinessHoursOnly:    true,
+		CalendarID:           calendarID,
+		TeamID:               teamID,
+		TenantID:             definition.TenantID,
 	}, nil
 }
 
@@ -116,6 +124,7 @@ func (s *BPMNSLAService) GetTaskSLA(ctx context.Context, task *ent.ProcessTask)
 		warningMinutes = int(v)
 	}
 
+	calendarID, teamID := slaCalendarRefs(slaConfig)
 	return &ProcessSLA{
 		ProcessDefinitionKey: task.ProcessDefinitionKey,
 		TaskDefinitionKey:    task.TaskDefinitionKey,
@@ -123,17 +132,32 @@ func (s *BPMNSLAService) GetTaskSLA(ctx context.Context, task *ent.ProcessTask)
 		DeadlineMinutes:      deadlineMinutes,
 		WarningMinutes:       warningMinutes,
 		BusinessHoursOnly:    true,
+		CalendarID:           calendarID,
+		TeamID:               teamID,
+		TenantID:             task.TenantID,
 	}, nil
 }
 
+// slaCalendarRefs 读取 SLA 配置中的 calendar_id 与 team_id
+func slaCalendarRefs(slaConfig map[string]interface{}) (calendarID, teamID *int) {
+	if v, ok := numericInt(slaConfig["calendar_id"]); ok && v > 0 {
+		calendarID = &v
+	}
+	if v, ok := numericInt(slaConfig["team_id"]); ok && v > 0 {
+		teamID = &v
+	}
+	return calendarID, teamID
+}
+
 // CalculateSLAStatus 计算SLA状态
 func (s *BPMNSLAService) CalculateSLAStatus(ctx context.Context, startTime time.Time, sla *ProcessSLA) (string, time.Time, time.Time, error) {
 	var deadline, warning time.Time
 
 	if sla.BusinessHoursOnly {
 		// 仅计算工作时间
-		deadline = s.calculateBusinessHoursDeadline(startTime, sla.DeadlineMinutes)
-		warning = s.calculateBusinessHoursDeadline(startTime, sla.WarningMinutes)
+		calendar := s.slaCalendar(ctx, sla)
+		deadline = calendar.AddBusinessMinutes(startTime, sla.DeadlineMinutes)
+		warning = calendar.AddBusinessMinutes(startTime, sla.WarningMinutes)
 	} else {
 		deadline = startTime.Add(time.Duration(sla.DeadlineMinutes) * time.Minute)
 		warning = startTime.Add(time.Duration(sla.WarningMinutes) * time.Minute)
@@ -151,57 +175,38 @@ func (s *BPMNSLAService) CalculateSLAStatus(ctx context.Context, startTime time.
 	return status, deadline, warning, nil
 }
 
-// calculateBusinessHoursDeadline 计算工作时间截止时间
-func (s *BPMNSLAService) calculateBusinessHoursDeadline(startTime time.Time, minutes int) time.Time {
-	// 默认工作时间为周一至周五 9:00-18:00
-	workStartHour := 9
-	workEndHour := 18
-
-	remainingMinutes := minutes
-	current := startTime
-
-	// 如果在工作时间外，调整到下一个工作日开始
-	hour := current.Hour()
-	if hour < workStartHour {
-		current = time.Date(current.Year(), current.Month(), current.Day(), workStartHour, 0, 0, 0, current.Location())
-	} else if hour >= workEndHour {
-		// 移到第二天
-		current = time.Date(current.Year(), current.Month(), current.Day()+1, workStartHour, 0, 0, 0, current.Location())
-	}
-
-	for remainingMinutes > 0 {
-		// 检查是否在工作日
-		weekday := current.Weekday()
-		if weekday == time.Saturday || weekday == time.Sunday {
-			// 移到下一个工作日
-			daysToAdd := 1
-			if weekday == time.Saturday {
-				daysToAdd = 2
-			}
-			current = current.AddDate(0, 0, daysToAdd)
-			current = time.Date(current.Year(), current.Month(), current.Day(), workStartHour, 0, 0, 0, current.Location())
-			continue
+// slaCalendar 解析流程SLA的工作日历：指定日历 > 团队日历 > 租户默认日历 > 内置周一至周五 9:00-18:00
+func (s *BPMNSLAService) slaCalendar(ctx context.Context, sla *ProcessSLA) *BusinessCalendarCalculator {
+	calendars := NewBusinessCalendarService(s.client)
+	if sla.CalendarID != nil {
+		calendar, err := calendars.Calculator(ctx, sla.TenantID, *sla.CalendarID)
+		if err == nil {
+			return calendar
 		}
-
-		// 计算当天剩余工作时间
-		hour = current.Hour()
-		if hour < workStartHour {
-			current = time.Date(current.Year(), current.Month(), current.Day(), workStartHour, 0, 0, 0, current.Location())
-			hour = workStartHour
+		s.logger.Warnw("加载流程SLA工作日历失败", "calendar_id", *sla.CalendarID, "error", err)
+	}
+	if sla.TeamID != nil {
+		calendar, err := calendars.TeamCalculator(ctx, sla.TenantID, *sla.TeamID)
+		if err != nil {
+			s.logger.Warnw("加载团队工作日历失败", "team_id", *sla.TeamID, "error", err)
+		} else if calendar != nil {
+			return calendar
 		}
-
-		minutesInDay := (workEndHour - hour) * 60
-		if remainingMinutes <= minutesInDay {
-			current = current.Add(time.Duration(remainingMinutes) * time.Minute)
-			remainingMinutes = 0
-		} else {
-			remainingMinutes -= minutesInDay
-			// 移到下一天
-			current = time.Date(current.Year(), current.Month(), current.Day()+1, workStartHour, 0, 0, 0, current.Location())
+	}
+	if sla.TenantID > 0 {
+		calendar, err := calendars.DefaultCalculator(ctx, sla.TenantID)
+		if err != nil {
+			s.logger.Warnw("加载租户默认工作日历失败", "tenant_id", sla.TenantID, "error", err)
+		} else if calendar != nil {
+			return calendar
 		}
 	}
+	return DefaultBusinessCalendarCalculator()
+}
 
-	return current
+// calculateBusinessHoursDeadline 按内置工作日历（周一至周五 9:00-18:00）计算截止时间
+func (s *BPMNSLAService) calculateBusinessHoursDeadline(startTime time.Time, minutes int) time.Time {
+	return DefaultBusinessCalendarCalculator().AddBusinessMinutes(startTime, minutes)
 }
 
 // GetProcessInstanceSLAInfo 获取流程实例SLA信息
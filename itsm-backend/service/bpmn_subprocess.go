@@ -0,0 +1,423 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"itsm-backend/ent"
+	"itsm-backend/ent/processdefinition"
+	"itsm-backend/ent/processincident"
+	"itsm-backend/ent/processinstance"
+	"itsm-backend/ent/processtask"
+)
+
+// BPMN 调用活动与嵌入式子流程
+//
+// 调用活动（callActivity）按 calledElement 启动被调用流程定义的子实例，父实例在调用活动上
+// 等待；子实例结束时按 out 映射把变量写回父实例并从调用活动继续。终止父实例、或中断型边界事件
+// 打断调用活动时，级联终止其子实例。
+//
+// 嵌入式子流程（subProcess）与所在流程共享同一实例，但内部元素构成独立作用域：
+// 从子流程自己的开始事件进入，到达其结束事件时只结束子流程并沿子流程出边继续。
+
+// bpmnCallActivityVariable 子实例中记录发起调用的父流程调用活动ID
+const bpmnCallActivityVariable = "_call_activity_"
+
+// engineStateVariables 引擎内部状态变量，不参与调用活动的变量传递
+var engineStateVariables = map[string]bool{
+	"_done_":                  true,
+	"_waiting_":               true,
+	bpmnCallActivityVariable:  true,
+	bpmnCompensableVariable:   true,
+	bpmnMultiInstanceVariable: true,
+}
+
+// scopeOf 返回直接包含 elementID 的作用域：嵌入式子流程内的元素返回子流程，其余返回流程本身
+func (p *BPMNProcess) scopeOf(elementID string) *BPMNProcess {
+	if sub := p.subProcessOf(elementID); sub != nil {
+		return &sub.BPMNProcess
+	}
+	return p
+}
+
+// subProcessOf 返回直接包含 elementID 的嵌入式子流程（支持多层嵌套）；顶层元素返回 nil
+func (p *BPMNProcess) subProcessOf(elementID string) *BPMNSubProcess {
+	parser := &BPMNParser{}
+	for _, sub := range p.SubProcesses {
+		if parser.elementExists(&sub.BPMNProcess, elementID) {
+			return sub
+		}
+		if inner := sub.subProcessOf(elementID); inner != nil {
+			return inner
+		}
+	}
+	return nil
+}
+
+// scopeElementIDs 返回子流程内（含嵌套子流程）的全部元素ID
+func scopeElementIDs(sub *BPMNSubProcess) []string {
+	ids := (&BPMNParser{}).getAllElementIDs(&sub.BPMNProcess)
+	for _, inner := range sub.SubProcesses {
+		ids = append(ids, scopeElementIDs(inner)...)
+	}
+	return ids
+}
+
+func (e *CustomProcessEngine) findCallActivity(process *BPMNProcess, id string) *BPMNCallActivity {
+	for _, activity := range process.scopeOf(id).CallActivities {
+		if activity.ID == id {
+			return activity
+		}
+	}
+	return nil
+}
+
+func (e *CustomProcessEngine) findSubProcess(process *BPMNProcess, id string) *BPMNSubProcess {
+	for _, sub := range process.scopeOf(id).SubProcesses {
+		if sub.ID == id {
+			return sub
+		}
+	}
+	return nil
+}
+
+// enterSubProcess 进入嵌入式子流程：子流程节点进入等待态并激活其边界事件，再从内部开始事件推进。
+// 重复进入（如回退循环）时清空内部元素的完成标记，使子流程内的汇聚网关重新计数。
+func (e *CustomProcessEngine) enterSubProcess(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, process *BPMNProcess, sub *BPMNSubProcess) error {
+	startEvent := manualStartEvent(&sub.BPMNProcess)
+	if startEvent == nil {
+		return fmt.Errorf("子流程 [%s] 缺少开始事件", sub.ID)
+	}
+	if done, ok := instance.Variables["_done_"].(map[string]interface{}); ok {
+		for _, id := range scopeElementIDs(sub) {
+			delete(done, id)
+		}
+	}
+	if err := e.markElementWaiting(ctx, txc, instance, sub.ID, true); err != nil {
+		return err
+	}
+	if err := e.enterActivityBoundaries(ctx, txc, instance, process, sub.ID); err != nil {
+		return err
+	}
+	e.recordScopeHistory(ctx, txc, instance, sub.ID, ActivityTypeSubProcess, "subprocess.started", "")
+	e.markElementDone(ctx, txc, instance, startEvent.ID)
+	return e.executeStep(ctx, txc, instance, process, startEvent.ID, instance.Variables)
+}
+
+// completeScope 到达结束事件：位于嵌入式子流程内时只结束该子流程并沿其出边继续，否则结束流程实例
+func (e *CustomProcessEngine) completeScope(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, process *BPMNProcess, endEventID string) error {
+	sub := process.subProcessOf(endEventID)
+	if sub == nil {
+		return e.completeProcess(ctx, txc, instance)
+	}
+	// 子流程已被边界事件中断，或已由其它分支结束
+	if !isElementWaiting(instance, sub.ID) {
+		return nil
+	}
+	if err := e.markElementWaiting(ctx, txc, instance, sub.ID, false); err != nil {
+		return err
+	}
+	if err := e.cancelBoundaryEvents(ctx, txc, instance, process, sub.ID); err != nil {
+		return err
+	}
+	e.registerCompensable(ctx, txc, instance, process, sub.ID)
+	e.markElementDone(ctx, txc, instance, sub.ID)
+	e.recordScopeHistory(ctx, txc, instance, sub.ID, ActivityTypeSubProcess, "subprocess.completed", endEventID)
+	return e.executeStep(ctx, txc, instance, process, sub.ID, instance.Variables)
+}
+
+// resolveCalledDefinition 按调用活动的 calledElement 与版本绑定查找被调用的流程定义（限定同租户）
+func (e *CustomProcessEngine) resolveCalledDefinition(ctx context.Context, txc *ent.Client, tenantID int, activity *BPMNCallActivity) (*ent.ProcessDefinition, error) {
+	if activity.CalledElement == "" {
+		return nil, fmt.Errorf("调用活动 [%s] 缺少 calledElement", activity.ID)
+	}
+	query := txc.ProcessDefinition.Query().
+		Where(
+			processdefinition.Key(activity.CalledElement),
+			processdefinition.TenantID(tenantID),
+		)
+	switch activity.CalledElementBinding {
+	case "", "latest":
+		query = query.Where(processdefinition.IsActive(true), processdefinition.IsLatest(true))
+	case "version":
+		if activity.CalledElementVersion == "" {
+			return nil, fmt.Errorf("调用活动 [%s] 绑定 version 时必须指定 calledElementVersion", activity.ID)
+		}
+		query = query.Where(processdefinition.Version(activity.CalledElementVersion))
+	default:
+		return nil, fmt.Errorf("调用活动 [%s] 不支持的版本绑定: %s", activity.ID, activity.CalledElementBinding)
+	}
+	definition, err := query.First(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("获取被调用流程定义 %s 失败: %w", activity.CalledElement, err)
+	}
+	return definition, nil
+}
+
+// mapCallVariables 按 in/out 映射从 source 生成要传递的变量。
+// inheritAll 或 variables="all" 传递全部业务变量（引擎内部状态变量除外）；
+// source 按变量名取值，sourceExpression 以表达式引擎求值，结果写入 target（缺省同名）。
+func (e *CustomProcessEngine) mapCallVariables(mappings []*BPMNVariableMapping, inheritAll bool, source map[string]interface{}) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	copyAll := func() {
+		for key, value := range source {
+			if !engineStateVariables[key] {
+				result[key] = value
+			}
+		}
+	}
+	if inheritAll {
+		copyAll()
+	}
+	for _, mapping := range mappings {
+		switch {
+		case mapping.Variables == "all":
+			copyAll()
+		case mapping.SourceExpression != "":
+			if mapping.Target == "" {
+				return nil, fmt.Errorf("变量映射表达式 %s 缺少 target", mapping.SourceExpression)
+			}
+			expr := strings.TrimSpace(mapping.SourceExpression)
+			if strings.HasPrefix(expr, "${") && strings.HasSuffix(expr, "}") {
+				expr = strings.TrimSpace(expr[2 : len(expr)-1])
+			}
+			value, err := e.exprEngine.Evaluate(expr, source)
+			if err != nil {
+				return nil, fmt.Errorf("变量映射表达式 %s 求值失败: %w", mapping.SourceExpression, err)
+			}
+			result[mapping.Target] = value
+		case mapping.Source != "":
+			target := mapping.Target
+			if target == "" {
+				target = mapping.Source
+			}
+			if value, ok := source[mapping.Source]; ok {
+				result[target] = value
+			}
+		}
+	}
+	return result, nil
+}
+
+// enterCallActivity 进入调用活动：父实例在调用活动上等待，并以 in 映射后的变量启动被调用流程的子实例。
+// 子实例启动后若同步结束会立即推进父实例，因此返回前重新加载父实例，避免上层调用方用旧快照覆盖变量。
+func (e *CustomProcessEngine) enterCallActivity(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, process *BPMNProcess, activity *BPMNCallActivity) error {
+	definition, err := e.resolveCalledDefinition(ctx, txc, instance.TenantID, activity)
+	if err != nil {
+		return err
+	}
+	calledDefinitions, err := e.parser.ParseXML(definition.BpmnXML)
+	if err != nil {
+		return fmt.Errorf("解析被调用流程 %s 失败: %w", definition.Key, err)
+	}
+	calledProcess := calledDefinitions.Processes[0]
+	startEvent := manualStartEvent(calledProcess)
+	if startEvent == nil {
+		return fmt.Errorf("被调用流程 %s 缺少开始事件", definition.Key)
+	}
+	variables, err := e.mapCallVariables(activity.InputMappings(), activity.InheritVariables, instance.Variables)
+	if err != nil {
+		return fmt.Errorf("调用活动 [%s] 输入映射失败: %w", activity.ID, err)
+	}
+	variables[bpmnCallActivityVariable] = activity.ID
+
+	if err := e.markElementWaiting(ctx, txc, instance, activity.ID, true); err != nil {
+		return err
+	}
+	if err := e.enterActivityBoundaries(ctx, txc, instance, process, activity.ID); err != nil {
+		return err
+	}
+	e.recordScopeHistory(ctx, txc, instance, activity.ID, ActivityTypeCallActivity, "callActivity.started", definition.Key)
+	if _, err := e.startProcessAt(ctx, txc, definition, calledProcess, startEvent, instance, instance.BusinessKey, variables); err != nil {
+		return fmt.Errorf("调用活动 [%s] 启动子流程失败: %w", activity.ID, err)
+	}
+
+	fresh, err := txc.ProcessInstance.Get(ctx, instance.ID)
+	if err != nil {
+		return fmt.Errorf("重新加载流程实例失败: %w", err)
+	}
+	*instance = *fresh
+	return nil
+}
+
+// resumeCallingInstance 子实例结束：按 out 映射把变量写回父实例，父实例离开调用活动继续推进。
+// 父实例已不在该调用活动上等待（被边界事件中断、已终止等）时忽略。
+func (e *CustomProcessEngine) resumeCallingInstance(ctx context.Context, txc *ent.Client, child *ent.ProcessInstance) error {
+	child, err := txc.ProcessInstance.Get(ctx, child.ID)
+	if err != nil {
+		return fmt.Errorf("查询子流程实例失败: %w", err)
+	}
+	activityID, _ := child.Variables[bpmnCallActivityVariable].(string)
+	parent, err := txc.ProcessInstance.Query().
+		Where(
+			processinstance.ProcessInstanceID(child.ParentProcessInstanceID),
+			processinstance.TenantID(child.TenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("获取父流程实例失败: %w", err)
+	}
+	if parent.Status != "running" || !isElementWaiting(parent, activityID) {
+		e.logger.Infow("父流程实例不在调用活动上等待，忽略子流程结束", "parent", parent.ProcessInstanceID, "activity", activityID)
+		return nil
+	}
+	process, err := e.loadInstanceProcess(ctx, txc, parent)
+	if err != nil {
+		return err
+	}
+	activity := e.findCallActivity(process, activityID)
+	if activity == nil {
+		return fmt.Errorf("调用活动 %s 不存在于流程定义中", activityID)
+	}
+	outputs, err := e.mapCallVariables(activity.OutputMappings(), false, child.Variables)
+	if err != nil {
+		return fmt.Errorf("调用活动 [%s] 输出映射失败: %w", activity.ID, err)
+	}
+	if len(outputs) > 0 {
+		if parent, err = e.mergeVariablesInTx(ctx, txc, parent.ID, outputs); err != nil {
+			return fmt.Errorf("合并父流程变量失败: %w", err)
+		}
+	}
+	if err := e.markElementWaiting(ctx, txc, parent, activityID, false); err != nil {
+		return err
+	}
+	if err := e.cancelBoundaryEvents(ctx, txc, parent, process, activityID); err != nil {
+		return err
+	}
+	e.registerCompensable(ctx, txc, parent, process, activityID)
+	e.markElementDone(ctx, txc, parent, activityID)
+	e.recordScopeHistory(ctx, txc, parent, activityID, ActivityTypeCallActivity, "callActivity.completed", child.ProcessInstanceID)
+	return e.executeStep(ctx, txc, parent, process, activityID, parent.Variables)
+}
+
+// interruptActivity 中断型边界事件打断调用活动或嵌入式子流程时，撤销其内部执行：
+// 调用活动终止其子实例；子流程取消内部未结束的任务、定时器、订阅、等待态及内部调用活动的子实例。
+func (e *CustomProcessEngine) interruptActivity(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, process *BPMNProcess, activityID string) error {
+	if e.findCallActivity(process, activityID) != nil {
+		return e.terminateChildInstances(ctx, txc, instance, activityID)
+	}
+	sub := e.findSubProcess(process, activityID)
+	if sub == nil {
+		return nil
+	}
+	ids := scopeElementIDs(sub)
+	taskKeys := make([]string, 0, len(ids)*2)
+	for _, id := range ids {
+		taskKeys = append(taskKeys, id, id+"_counter")
+	}
+	if _, err := txc.ProcessTask.Update().
+		Where(
+			processtask.ProcessInstanceID(instance.ID),
+			processtask.TaskDefinitionKeyIn(taskKeys...),
+			processtask.StatusNotIn("completed", "cancelled"),
+		).
+		SetStatus("cancelled").
+		SetCompletedTime(time.Now()).
+		Save(ctx); err != nil {
+		return fmt.Errorf("中断子流程任务失败: %w", err)
+	}
+	if err := e.cancelInstanceTimers(ctx, txc, instance, ids...); err != nil {
+		return err
+	}
+	if err := e.cancelEventSubscriptions(ctx, txc, instance, ids...); err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if isElementWaiting(instance, id) {
+			if err := e.markElementWaiting(ctx, txc, instance, id, false); err != nil {
+				return err
+			}
+		}
+		if err := e.clearMultiInstanceState(ctx, txc, instance, id); err != nil {
+			return err
+		}
+	}
+	return e.terminateChildInstances(ctx, txc, instance, ids...)
+}
+
+// terminateInstance 终止流程实例：置为 terminated，取消未结束任务、定时器与事件订阅，并级联终止子实例
+func (e *CustomProcessEngine) terminateInstance(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance) error {
+	if _, err := txc.ProcessInstance.UpdateOne(instance).
+		SetStatus("terminated").
+		SetEndTime(time.Now()).
+		Save(ctx); err != nil {
+		return fmt.Errorf("终止流程实例失败: %w", err)
+	}
+
+	if _, err := txc.ProcessTask.Update().
+		Where(processtask.ProcessInstanceID(instance.ID)).
+		Where(processtask.StatusNEQ("completed")).
+		Where(processtask.StatusNEQ("cancelled")).
+		SetStatus("cancelled").
+		SetCompletedTime(time.Now()).
+		Save(ctx); err != nil {
+		e.logger.Warnw("取消流程任务失败", "error", err)
+	}
+	if err := e.cancelInstanceTimers(ctx, txc, instance); err != nil {
+		e.logger.Warnw("取消流程定时器失败", "error", err)
+	}
+	if err := e.cancelEventSubscriptions(ctx, txc, instance); err != nil {
+		e.logger.Warnw("取消流程事件订阅失败", "error", err)
+	}
+	// 实例终止后其未处理的事故不再需要运维介入
+	if _, err := txc.ProcessIncident.Update().
+		Where(
+			processincident.ProcessInstanceID(instance.ID),
+			processincident.StatusEQ(bpmnIncidentOpen),
+		).
+		SetStatus(bpmnIncidentResolved).
+		SetResolution(BPMNIncidentTerminated).
+		SetResolvedAt(time.Now()).
+		Save(ctx); err != nil {
+		e.logger.Warnw("关闭流程事故失败", "error", err)
+	}
+	return e.terminateChildInstances(ctx, txc, instance)
+}
+
+// terminateChildInstances 终止父实例由调用活动启动、尚未结束的子实例；指定 activityIDs 时只终止这些调用活动的子实例
+func (e *CustomProcessEngine) terminateChildInstances(ctx context.Context, txc *ent.Client, parent *ent.ProcessInstance, activityIDs ...string) error {
+	children, err := txc.ProcessInstance.Query().
+		Where(
+			processinstance.ParentProcessInstanceID(parent.ProcessInstanceID),
+			processinstance.TenantID(parent.TenantID),
+			processinstance.StatusIn("running", "suspended"),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("查询子流程实例失败: %w", err)
+	}
+	only := make(map[string]bool, len(activityIDs))
+	for _, id := range activityIDs {
+		only[id] = true
+	}
+	for _, child := range children {
+		if activityID, _ := child.Variables[bpmnCallActivityVariable].(string); len(only) > 0 && !only[activityID] {
+			continue
+		}
+		if err := e.terminateInstance(ctx, txc, child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordScopeHistory 记录调用活动/子流程的进入与结束，detail 为被调用流程或子实例等补充信息
+func (e *CustomProcessEngine) recordScopeHistory(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, elementID, activityType, eventType, detail string) {
+	_, err := txc.ProcessExecutionHistory.Create().
+		SetHistoryID(fmt.Sprintf("HIST-%s-%d", elementID, time.Now().UnixNano())).
+		SetProcessInstanceID(instance.ID).
+		SetProcessDefinitionKey(instance.ProcessDefinitionKey).
+		SetActivityID(elementID).
+		SetActivityType(activityType).
+		SetEventType(eventType).
+		SetEventDetail(detail).
+		SetVariables(instance.Variables).
+		SetTenantID(instance.TenantID).
+		SetTimestamp(time.Now()).
+		Save(ctx)
+	if err != nil {
+		e.logger.Warnw("recordScopeHistory 保存失败", "error", err)
+	}
+}
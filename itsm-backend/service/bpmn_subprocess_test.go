@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"itsm-backend/ent"
+	"itsm-backend/ent/processinstance"
+	"itsm-backend/ent/processtask"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 变更流程调用标准 CAB 审批流程
+const changeCallsCABBPMN = `<?xml version="1.0" encoding="UTF-8"?>
+<bpmn:definitions xmlns:bpmn="http://www.omg.org/spec/BPMN/20100524/MODEL" xmlns:camunda="http://camunda.org/schema/1.0/bpmn" id="Definitions_chg" targetNamespace="http://bpmn.io/schema/bpmn">
+  <bpmn:process id="Process_chg" name="Change" isExecutable="true">
+    <bpmn:startEvent id="StartEvent_1"/>
+    <bpmn:callActivity id="CAB" name="CAB审批" calledElement="cab_approval">
+      <bpmn:extensionElements>
+        <camunda:in source="change_id" target="subject_id"/>
+        <camunda:in sourceExpression="${risk * 2}" target="score"/>
+        <camunda:out source="cab_result" target="cab_decision"/>
+      </bpmn:extensionElements>
+    </bpmn:callActivity>
+    <bpmn:userTask id="Implement" name="实施" assignee="1"/>
+    <bpmn:endEvent id="EndEvent_1"/>
+    <bpmn:sequenceFlow id="Flow_1" sourceRef="StartEvent_1" targetRef="CAB"/>
+    <bpmn:sequenceFlow id="Flow_2" sourceRef="CAB" targetRef="Implement"/>
+    <bpmn:sequenceFlow id="Flow_3" sourceRef="Implement" targetRef="EndEvent_1"/>
+  </bpmn:process>
+</bpmn:definitions>`
+
+const cabApprovalBPMN = `<?xml version="1.0" encoding="UTF-8"?>
+<bpmn:definitions xmlns:bpmn="http://www.omg.org/spec/BPMN/20100524/MODEL" id="Definitions_cab" targetNamespace="http://bpmn.io/schema/bpmn">
+  <bpmn:process id="Process_cab" name="CAB" isExecutable="true">
+    <bpmn:startEvent id="StartEvent_1"/>
+    <bpmn:userTask id="CAB_Review" name="CAB评审" assignee="1"/>
+    <bpmn:endEvent id="EndEvent_1"/>
+    <bpmn:sequenceFlow id="Flow_1" sourceRef="StartEvent_1" targetRef="CAB_Review"/>
+    <bpmn:sequenceFlow id="Flow_2" sourceRef="CAB_Review" targetRef="EndEvent_1"/>
+  </bpmn:process>
+</bpmn:definitions>`
+
+// 无等待节点的子流程：启动即结束
+const autoApproveBPMN = `<?xml version="1.0" encoding="UTF-8"?>
+<bpmn:definitions xmlns:bpmn="http://www.omg.org/spec/BPMN/20100524/MODEL" id="Definitions_auto" targetNamespace="http://bpmn.io/schema/bpmn">
+  <bpmn:process id="Process_auto" name="Auto" isExecutable="true">
+    <bpmn:startEvent id="StartEvent_1"/>
+    <bpmn:endEvent id="EndEvent_1"/>
+    <bpmn:sequenceFlow id="Flow_1" sourceRef="StartEvent_1" targetRef="EndEvent_1"/>
+  </bpmn:process>
+</bpmn:definitions>`
+
+const changeCallsAutoBPMN = `<?xml version="1.0" encoding="UTF-8"?>
+<bpmn:definitions xmlns:bpmn="http://www.omg.org/spec/BPMN/20100524/MODEL" id="Definitions_chg2" targetNamespace="http://bpmn.io/schema/bpmn">
+  <bpmn:process id="Process_chg2" name="Change" isExecutable="true">
+    <bpmn:startEvent id="StartEvent_1"/>
+    <bpmn:callActivity id="Auto" calledElement="auto_approve" inheritVariables="true">
+      <bpmn:extensionElements>
+        <bpmn:out variables="all"/>
+      </bpmn:extensionElements>
+    </bpmn:callActivity>
+    <bpmn:userTask id="Implement" name="实施" assignee="1"/>
+    <bpmn:endEvent id="EndEvent_1"/>
+    <bpmn:sequenceFlow id="Flow_1" sourceRef="StartEvent_1" targetRef="Auto"/>
+    <bpmn:sequenceFlow id="Flow_2" sourceRef="Auto" targetRef="Implement"/>
+    <bpmn:sequenceFlow id="Flow_3" sourceRef="Implement" targetRef="EndEvent_1"/>
+  </bpmn:process>
+</bpmn:definitions>`
+
+// 嵌入式子流程：内部结束事件只结束子流程；挂载「撤回」消息边界事件
+const embeddedSubProcessBPMN = `<?xml version="1.0" encoding="UTF-8"?>
+<bpmn:definitions xmlns:bpmn="http://www.omg.org/spec/BPMN/20100524/MODEL" id="Definitions_sub" targetNamespace="http://bpmn.io/schema/bpmn">
+  <bpmn:process id="Process_sub" name="SubProcess" isExecutable="true">
+    <bpmn:startEvent id="StartEvent_1"/>
+    <bpmn:subProcess id="Review" name="评审">
+      <bpmn:startEvent id="Review_Start"/>
+      <bpmn:userTask id="Review_Tech" name="技术评审" assignee="1"/>
+      <bpmn:endEvent id="Review_End"/>
+      <bpmn:sequenceFlow id="Review_Flow_1" sourceRef="Review_Start" targetRef="Review_Tech"/>
+      <bpmn:sequenceFlow id="Review_Flow_2" sourceRef="Review_Tech" targetRef="Review_End"/>
+    </bpmn:subProcess>
+    <bpmn:boundaryEvent id="Withdrawn" attachedToRef="Review">
+      <bpmn:messageEventDefinition messageRef="change_withdrawn"/>
+    </bpmn:boundaryEvent>
+    <bpmn:userTask id="Implement" name="实施" assignee="1"/>
+    <bpmn:userTask id="Cleanup" name="撤回处理" assignee="1"/>
+    <bpmn:endEvent id="EndEvent_1"/>
+    <bpmn:endEvent id="EndEvent_2"/>
+    <bpmn:sequenceFlow id="Flow_1" sourceRef="StartEvent_1" targetRef="Review"/>
+    <bpmn:sequenceFlow id="Flow_2" sourceRef="Review" targetRef="Implement"/>
+    <bpmn:sequenceFlow id="Flow_3" sourceRef="Implement" targetRef="EndEvent_1"/>
+    <bpmn:sequenceFlow id="Flow_4" sourceRef="Withdrawn" targetRef="Cleanup"/>
+    <bpmn:sequenceFlow id="Flow_5" sourceRef="Cleanup" targetRef="EndEvent_2"/>
+  </bpmn:process>
+</bpmn:definitions>`
+
+// deployExtraDefinition 在 seedTimerEngine 的租户下再部署一个流程定义（被调用流程）
+func deployExtraDefinition(t *testing.T, client *ent.Client, key, bpmnXML string) {
+	t.Helper()
+	dep, err := client.ProcessDeployment.Create().
+		SetDeploymentID("DEP-" + key).SetDeploymentName(key).SetTenantID(11).Save(context.Background())
+	require.NoError(t, err)
+	_, err = client.ProcessDefinition.Create().
+		SetKey(key).SetName(key).SetBpmnXML([]byte(bpmnXML)).
+		SetDeploymentID(dep.ID).SetTenantID(11).SetIsActive(true).SetIsLatest(true).Save(context.Background())
+	require.NoError(t, err)
+}
+
+func openTask(t *testing.T, client *ent.Client, ctx context.Context, instanceID int, key string) *ent.ProcessTask {
+	t.Helper()
+	task, err := client.ProcessTask.Query().
+		Where(
+			processtask.ProcessInstanceID(instanceID),
+			processtask.TaskDefinitionKey(key),
+			processtask.StatusNotIn("completed", "cancelled"),
+		).
+		Only(ctx)
+	require.NoError(t, err)
+	return task
+}
+
+func TestCallActivity_StartsChildAndMapsVariables(t *testing.T) {
+	engine, client, ctx := seedTimerEngine(t, "change_cab", changeCallsCABBPMN)
+	deployExtraDefinition(t, client, "cab_approval", cabApprovalBPMN)
+
+	parent, err := engine.StartProcess(ctx, "change_cab", "CHG-1", map[string]interface{}{"change_id": 42, "risk": 3, "secret": "x"})
+	require.NoError(t, err)
+
+	child, err := client.ProcessInstance.Query().Where(processinstance.ProcessDefinitionKey("cab_approval")).Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, parent.ProcessInstanceID, child.ParentProcessInstanceID)
+	assert.Equal(t, parent.ProcessInstanceID, child.RootProcessInstanceID)
+	assert.Equal(t, "CHG-1", child.BusinessKey)
+	assert.EqualValues(t, 42, child.Variables["subject_id"])
+	assert.EqualValues(t, 6, child.Variables["score"])
+	assert.NotContains(t, child.Variables, "secret", "未映射的变量不应传入子流程")
+
+	review := openTask(t, client, ctx, child.ID, "CAB_Review")
+	require.NoError(t, engine.CompleteTask(ctx, review.TaskID, map[string]interface{}{"cab_result": "approved"}))
+
+	child, err = client.ProcessInstance.Get(ctx, child.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "completed", child.Status)
+	parent, err = client.ProcessInstance.Get(ctx, parent.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "running", parent.Status)
+	assert.Equal(t, "approved", parent.Variables["cab_decision"])
+	assert.NotContains(t, parent.Variables, "cab_result")
+	openTask(t, client, ctx, parent.ID, "Implement")
+}
+
+func TestCallActivity_SynchronousChildContinuesParent(t *testing.T) {
+	engine, client, ctx := seedTimerEngine(t, "change_auto", changeCallsAutoBPMN)
+	deployExtraDefinition(t, client, "auto_approve", autoApproveBPMN)
+
+	parent, err := engine.StartProcess(ctx, "change_auto", "CHG-2", map[string]interface{}{"change_id": 7})
+	require.NoError(t, err)
+
+	child, err := client.ProcessInstance.Query().Where(processinstance.ProcessDefinitionKey("auto_approve")).Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "completed", child.Status)
+	assert.EqualValues(t, 7, child.Variables["change_id"], "inheritVariables 应传递全部业务变量")
+
+	parent, err = client.ProcessInstance.Get(ctx, parent.ID)
+	require.NoError(t, err)
+	assert.False(t, isElementWaiting(parent, "Auto"))
+	openTask(t, client, ctx, parent.ID, "Implement")
+}
+
+func TestCallActivity_TerminateParentCascades(t *testing.T) {
+	engine, client, ctx := seedTimerEngine(t, "change_term", changeCallsCABBPMN)
+	deployExtraDefinition(t, client, "cab_approval", cabApprovalBPMN)
+
+	parent, err := engine.StartProcess(ctx, "change_term", "CHG-3", map[string]interface{}{"change_id": 1, "risk": 1})
+	require.NoError(t, err)
+	require.NoError(t, engine.TerminateProcess(ctx, parent.ProcessInstanceID, "变更取消"))
+
+	child, err := client.ProcessInstance.Query().Where(processinstance.ParentProcessInstanceID(parent.ProcessInstanceID)).Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "terminated", child.Status)
+	review, err := client.ProcessTask.Query().Where(processtask.ProcessInstanceID(child.ID)).Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "cancelled", review.Status)
+}
+
+func TestEmbeddedSubProcess_EndEventOnlyEndsScope(t *testing.T) {
+	engine, client, ctx := seedTimerEngine(t, "sub_scope", embeddedSubProcessBPMN)
+
+	inst, err := engine.StartProcess(ctx, "sub_scope", "CHG-4", map[string]interface{}{})
+	require.NoError(t, err)
+	tech := openTask(t, client, ctx, inst.ID, "Review_Tech")
+	require.NoError(t, engine.CompleteTask(ctx, tech.TaskID, map[string]interface{}{}))
+
+	inst, err = client.ProcessInstance.Get(ctx, inst.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "running", inst.Status, "子流程结束事件不应结束整个流程")
+	implement := openTask(t, client, ctx, inst.ID, "Implement")
+
+	// 子流程正常结束后其边界事件订阅失效
+	_, err = engine.CorrelateMessage(ctx, "change_withdrawn", nil, nil)
+	assert.ErrorIs(t, err, ErrMessageNotCorrelated)
+
+	require.NoError(t, engine.CompleteTask(ctx, implement.TaskID, map[string]interface{}{}))
+	inst, err = client.ProcessInstance.Get(ctx, inst.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "completed", inst.Status)
+}
+
+func TestEmbeddedSubProcess_InterruptingBoundaryCancelsInnerTasks(t *testing.T) {
+	engine, client, ctx := seedTimerEngine(t, "sub_interrupt", embeddedSubProcessBPMN)
+
+	inst, err := engine.StartProcess(ctx, "sub_interrupt", "CHG-5", map[string]interface{}{})
+	require.NoError(t, err)
+	_, err = engine.CorrelateMessage(ctx, "change_withdrawn", map[string]interface{}{"business_key": "CHG-5"}, nil)
+	require.NoError(t, err)
+
+	tech, err := client.ProcessTask.Query().
+		Where(processtask.ProcessInstanceID(inst.ID), processtask.TaskDefinitionKey("Review_Tech")).Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "cancelled", tech.Status)
+	openTask(t, client, ctx, inst.ID, "Cleanup")
+	implement, err := client.ProcessTask.Query().
+		Where(processtask.ProcessInstanceID(inst.ID), processtask.TaskDefinitionKey("Implement")).Exist(ctx)
+	require.NoError(t, err)
+	assert.False(t, implement)
+}
@@ -0,0 +1,787 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"itsm-backend/ent"
+	"itsm-backend/ent/operationalcommand"
+	"itsm-backend/ent/predicate"
+	"itsm-backend/ent/processdefinition"
+	"itsm-backend/internal/commandbus"
+	"itsm-backend/service/bpmn"
+)
+
+// BPMN 定时器事件
+//
+// 中间捕获定时器、边界定时器（中断 / 非中断）与定时启动事件统一以
+// OperationalCommand 持久化：available_at 即触发时间，由 commandbus worker
+// 在到期后投递给 HandleTimerCommand。进程重启不会丢失定时器；取消定时器即把
+// 仍处于 pending 的命令置为 cancelled。
+
+const (
+	bpmnTimerKindIntermediate = "intermediate"
+	bpmnTimerKindBoundary     = "boundary"
+	bpmnTimerKindStart        = "start"
+
+	bpmnTimerTypeDuration = "duration"
+	bpmnTimerTypeDate     = "date"
+	bpmnTimerTypeCycle    = "cycle"
+
+	// bpmnTimerAggregateInstance / bpmnTimerAggregateDefinition 为定时器命令的聚合类型：
+	// 实例级定时器挂在流程实例上，定时启动事件挂在流程定义上。
+	bpmnTimerAggregateInstance   = "process_instance"
+	bpmnTimerAggregateDefinition = "process_definition"
+
+	// bpmnTimerMaxAttempts 定时器触发失败（如事务冲突）时的最大重试次数
+	bpmnTimerMaxAttempts = 20
+
+	// bpmnTimerSuspendedRecheck 实例暂停期间到期的定时器顺延的间隔；实例恢复时提前到原到期时间
+	bpmnTimerSuspendedRecheck = time.Hour
+)
+
+// isoDuration ISO-8601 时长（PnYnMnWnDTnHnMnS）。年月日按日历计算，时分秒按绝对时长计算。
+type isoDuration struct {
+	Years  int
+	Months int
+	Days   int
+	Clock  time.Duration
+}
+
+var isoDurationPattern = regexp.MustCompile(`^P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+
+// parseISODuration 解析 ISO-8601 时长，如 PT48H、P2D、P1DT12H、P1W
+func parseISODuration(value string) (isoDuration, error) {
+	value = strings.ToUpper(strings.TrimSpace(value))
+	m := isoDurationPattern.FindStringSubmatch(value)
+	if m == nil || value == "P" || strings.HasSuffix(value, "T") {
+		return isoDuration{}, fmt.Errorf("无效的 ISO-8601 时长: %q", value)
+	}
+	atoi := func(s string) int {
+		if s == "" {
+			return 0
+		}
+		n, _ := strconv.Atoi(s)
+		return n
+	}
+	d := isoDuration{
+		Years:  atoi(m[1]),
+		Months: atoi(m[2]),
+		Days:   atoi(m[3])*7 + atoi(m[4]),
+		Clock:  time.Duration(atoi(m[5]))*time.Hour + time.Duration(atoi(m[6]))*time.Minute,
+	}
+	if m[7] != "" {
+		seconds, err := strconv.ParseFloat(m[7], 64)
+		if err != nil {
+			return isoDuration{}, fmt.Errorf("无效的 ISO-8601 时长: %q", value)
+		}
+		d.Clock += time.Duration(seconds * float64(time.Second))
+	}
+	if d.Years == 0 && d.Months == 0 && d.Days == 0 && d.Clock == 0 {
+		return isoDuration{}, fmt.Errorf("ISO-8601 时长不能为零: %q", value)
+	}
+	return d, nil
+}
+
+// addTo 返回 t 加上该时长后的时间
+func (d isoDuration) addTo(t time.Time) time.Time {
+	return t.AddDate(d.Years, d.Months, d.Days).Add(d.Clock)
+}
+
+// bpmnTimerSpec 解析后的定时器定义
+type bpmnTimerSpec struct {
+	Type       string
+	Expression string
+	Duration   isoDuration
+	Date       time.Time
+	Start      time.Time // 仅 cycle：显式起始时间，零值表示从调度时刻开始
+	// Repetitions 仅 cycle：剩余触发次数，-1 表示无限
+	Repetitions int
+}
+
+// parseBPMNTimerDate 解析 ISO-8601 日期时间（RFC3339，或不带时区的本地时间）
+func parseBPMNTimerDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02T15:04", "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("无效的 ISO-8601 日期: %q", value)
+}
+
+// parseBPMNTimerCycle 解析 R[n]/[start/]duration 形式的重复周期
+func parseBPMNTimerCycle(value string) (*bpmnTimerSpec, error) {
+	parts := strings.Split(strings.TrimSpace(value), "/")
+	if len(parts) < 2 || len(parts) > 3 || !strings.HasPrefix(strings.ToUpper(parts[0]), "R") {
+		return nil, fmt.Errorf("无效的 ISO-8601 重复周期: %q", value)
+	}
+	spec := &bpmnTimerSpec{Type: bpmnTimerTypeCycle, Expression: value, Repetitions: -1}
+	if count := parts[0][1:]; count != "" {
+		n, err := strconv.Atoi(count)
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("无效的重复次数: %q", value)
+		}
+		spec.Repetitions = n
+	}
+	durationPart := parts[len(parts)-1]
+	if len(parts) == 3 {
+		start, err := parseBPMNTimerDate(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		spec.Start = start
+	}
+	d, err := parseISODuration(durationPart)
+	if err != nil {
+		return nil, err
+	}
+	spec.Duration = d
+	return spec, nil
+}
+
+// resolveTimerExpression 支持 ${var} 形式从流程变量取定时器表达式
+func resolveTimerExpression(expression string, variables map[string]interface{}) string {
+	expression = strings.TrimSpace(expression)
+	if strings.HasPrefix(expression, "${") && strings.HasSuffix(expression, "}") {
+		name := strings.TrimSpace(expression[2 : len(expression)-1])
+		if v, ok := variables[name]; ok && v != nil {
+			return strings.TrimSpace(fmt.Sprint(v))
+		}
+	}
+	return expression
+}
+
+// parseBPMNTimerSpec 从 timerEventDefinition（或兼容的 timerRef 属性）解析定时器。
+// timerRef 属性按以下顺序识别：R 开头为周期，P 开头为时长，否则为日期。
+func parseBPMNTimerSpec(def *BPMNTimerEventDefinition, timerRef string, variables map[string]interface{}) (*bpmnTimerSpec, error) {
+	var typ, expression string
+	switch {
+	case def != nil && strings.TrimSpace(def.TimeDuration) != "":
+		typ, expression = bpmnTimerTypeDuration, def.TimeDuration
+	case def != nil && strings.TrimSpace(def.TimeDate) != "":
+		typ, expression = bpmnTimerTypeDate, def.TimeDate
+	case def != nil && strings.TrimSpace(def.TimeCycle) != "":
+		typ, expression = bpmnTimerTypeCycle, def.TimeCycle
+	case strings.TrimSpace(timerRef) != "":
+		expression = timerRef
+	default:
+		return nil, nil
+	}
+	expression = resolveTimerExpression(expression, variables)
+	if typ == "" {
+		switch upper := strings.ToUpper(expression); {
+		case strings.HasPrefix(upper, "R"):
+			typ = bpmnTimerTypeCycle
+		case strings.HasPrefix(upper, "P"):
+			typ = bpmnTimerTypeDuration
+		default:
+			typ = bpmnTimerTypeDate
+		}
+	}
+
+	switch typ {
+	case bpmnTimerTypeDuration:
+		d, err := parseISODuration(expression)
+		if err != nil {
+			return nil, err
+		}
+		return &bpmnTimerSpec{Type: typ, Expression: expression, Duration: d}, nil
+	case bpmnTimerTypeDate:
+		t, err := parseBPMNTimerDate(expression)
+		if err != nil {
+			return nil, err
+		}
+		return &bpmnTimerSpec{Type: typ, Expression: expression, Date: t}, nil
+	default:
+		return parseBPMNTimerCycle(expression)
+	}
+}
+
+// firstFireTime 计算从 now 起的首次触发时间
+func (s *bpmnTimerSpec) firstFireTime(now time.Time) time.Time {
+	switch s.Type {
+	case bpmnTimerTypeDate:
+		return s.Date
+	case bpmnTimerTypeCycle:
+		if !s.Start.IsZero() {
+			next := s.Start
+			for next.Before(now) {
+				next = s.Duration.addTo(next)
+			}
+			return next
+		}
+		return s.Duration.addTo(now)
+	default:
+		return s.Duration.addTo(now)
+	}
+}
+
+// timerIdempotencyPrefix 同一实例同一元素的定时器命令共享前缀，用于去重与批量取消
+func timerIdempotencyPrefix(instanceID int, elementID string) string {
+	return fmt.Sprintf("bpmn-timer:%d:%s:", instanceID, elementID)
+}
+
+// firingTimerCommandKey ctx 中记录正在触发的定时器命令ID
+type firingTimerCommandKey struct{}
+
+// scheduleInstanceTimer 为流程实例上的定时器事件写入一条延迟命令。
+// 同一元素已存在未完成的定时器时直接复用，保证 createUserTask 等幂等重入不会重复调度；
+// 正在触发的定时器命令除外：流程经该定时器回到同一元素（如升级后退回审批）时需调度新的定时器。
+func (e *CustomProcessEngine) scheduleInstanceTimer(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, elementID, kind string, spec *bpmnTimerSpec, payload map[string]interface{}) error {
+	prefix := timerIdempotencyPrefix(instance.ID, elementID)
+	existing := []predicate.OperationalCommand{
+		operationalcommand.TenantID(instance.TenantID),
+		operationalcommand.CommandType(commandbus.CommandFireBPMNTimer),
+		operationalcommand.IdempotencyKeyHasPrefix(prefix),
+		operationalcommand.StatusIn(commandbus.StatusPending, commandbus.StatusProcessing),
+	}
+	if firingID, ok := ctx.Value(firingTimerCommandKey{}).(int); ok {
+		existing = append(existing, operationalcommand.IDNEQ(firingID))
+	}
+	exists, err := txc.OperationalCommand.Query().Where(existing...).Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("查询已有定时器失败: %w", err)
+	}
+	if exists {
+		return nil
+	}
+	fireAt := spec.firstFireTime(time.Now())
+	if payload == nil {
+		payload = map[string]interface{}{}
+	}
+	payload["kind"] = kind
+	payload["element_id"] = elementID
+	payload["timer_type"] = spec.Type
+	payload["expression"] = spec.Expression
+	payload["due_at"] = fireAt.Format(time.RFC3339)
+	if spec.Type == bpmnTimerTypeCycle {
+		payload["remaining"] = spec.Repetitions
+	}
+	_, err = commandbus.Enqueue(ctx, txc, commandbus.EnqueueRequest{
+		TenantID:       instance.TenantID,
+		CommandType:    commandbus.CommandFireBPMNTimer,
+		AggregateType:  bpmnTimerAggregateInstance,
+		AggregateID:    instance.ID,
+		IdempotencyKey: fmt.Sprintf("%s%d", prefix, fireAt.UnixNano()),
+		Payload:        payload,
+		MaxAttempts:    bpmnTimerMaxAttempts,
+		AvailableAt:    fireAt,
+	})
+	if err != nil {
+		return fmt.Errorf("调度定时器 %s 失败: %w", elementID, err)
+	}
+	e.logger.Infow("BPMN 定时器已调度", "instance", instance.ID, "element", elementID, "kind", kind, "fireAt", fireAt)
+	return nil
+}
+
+// scheduleBoundaryTimers 为进入等待态的活动调度其上挂载的所有边界定时器
+func (e *CustomProcessEngine) scheduleBoundaryTimers(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, process *BPMNProcess, activityID string) error {
+	for _, boundary := range process.scopeOf(activityID).BoundaryEvents {
+		if boundary.AttachedToRef != activityID || (boundary.TimerDefinition == nil && boundary.TimerRef == "") {
+			continue
+		}
+		spec, err := parseBPMNTimerSpec(boundary.TimerDefinition, boundary.TimerRef, instance.Variables)
+		if err != nil {
+			return fmt.Errorf("边界定时器 [%s] 配置无效: %w", boundary.ID, err)
+		}
+		if err := e.scheduleInstanceTimer(ctx, txc, instance, boundary.ID, bpmnTimerKindBoundary, spec, map[string]interface{}{
+			"attached_to":  activityID,
+			"interrupting": boundary.IsInterrupting(),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cancelInstanceTimers 取消实例上指定元素（为空则全部）的 pending 定时器
+func (e *CustomProcessEngine) cancelInstanceTimers(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, elementIDs ...string) error {
+	query := txc.OperationalCommand.Update().
+		Where(
+			operationalcommand.TenantID(instance.TenantID),
+			operationalcommand.CommandType(commandbus.CommandFireBPMNTimer),
+			operationalcommand.AggregateType(bpmnTimerAggregateInstance),
+			operationalcommand.AggregateID(instance.ID),
+			operationalcommand.StatusEQ(commandbus.StatusPending),
+		)
+	if len(elementIDs) > 0 {
+		var prefixes []predicate.OperationalCommand
+		for _, elementID := range elementIDs {
+			prefixes = append(prefixes, operationalcommand.IdempotencyKeyHasPrefix(timerIdempotencyPrefix(instance.ID, elementID)))
+		}
+		query = query.Where(operationalcommand.Or(prefixes...))
+	}
+	if _, err := query.SetStatus(commandbus.StatusCancelled).SetCompletedAt(time.Now()).Save(ctx); err != nil {
+		return fmt.Errorf("取消定时器失败: %w", err)
+	}
+	return nil
+}
+
+// markElementWaiting 标记实例在某元素上处于等待态（写入 _waiting_），供事件到达时校验
+func (e *CustomProcessEngine) markElementWaiting(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, elementID string, waiting bool) error {
+	if instance.Variables == nil {
+		instance.Variables = map[string]interface{}{}
+	}
+	states, ok := instance.Variables["_waiting_"].(map[string]interface{})
+	if !ok {
+		states = map[string]interface{}{}
+		instance.Variables["_waiting_"] = states
+	}
+	if waiting {
+		states[elementID] = true
+	} else {
+		delete(states, elementID)
+	}
+	if _, err := txc.ProcessInstance.UpdateOneID(instance.ID).SetVariables(instance.Variables).Save(ctx); err != nil {
+		return fmt.Errorf("记录节点 [%s] 等待状态失败: %w", elementID, err)
+	}
+	return nil
+}
+
+// isElementWaiting 实例是否正在某元素上等待
+func isElementWaiting(instance *ent.ProcessInstance, elementID string) bool {
+	states, _ := instance.Variables["_waiting_"].(map[string]interface{})
+	return states[elementID] == true
+}
+
+// enterIntermediateTimer 进入中间定时器捕获事件：调度定时器并在此等待
+func (e *CustomProcessEngine) enterIntermediateTimer(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, event *BPMNIntermediateEvent) error {
+	spec, err := parseBPMNTimerSpec(event.TimerDefinition, event.TimerRef, instance.Variables)
+	if err != nil {
+		return fmt.Errorf("中间定时器 [%s] 配置无效: %w", event.ID, err)
+	}
+	if err := e.markElementWaiting(ctx, txc, instance, event.ID, true); err != nil {
+		return err
+	}
+	return e.scheduleInstanceTimer(ctx, txc, instance, event.ID, bpmnTimerKindIntermediate, spec, nil)
+}
+
+// armIntermediateTimer 由事件接口在指定流程实例中进入中间定时器（独立事务）：
+// 合并触发变量后沿 enterIntermediateTimer 标记等待并调度定时器，返回待触发定时器的到期时间
+func (e *CustomProcessEngine) armIntermediateTimer(ctx context.Context, tenantID int, processInstanceID, elementID string, variables map[string]interface{}) (time.Time, error) {
+	var dueAt time.Time
+	err := e.runInInstanceTx(ctx, tenantID, processInstanceID, func(txc *ent.Client, instance *ent.ProcessInstance, process *BPMNProcess) error {
+		event := e.findIntermediateEvent(process, elementID)
+		if event == nil || (event.TimerDefinition == nil && event.TimerRef == "") {
+			return fmt.Errorf("流程实例 %s 中不存在中间定时器事件 %s", processInstanceID, elementID)
+		}
+		if instance.Variables == nil {
+			instance.Variables = map[string]interface{}{}
+		}
+		for k, v := range variables {
+			instance.Variables[k] = v
+		}
+		if err := e.enterIntermediateTimer(ctx, txc, instance, event); err != nil {
+			return err
+		}
+		// 已有 pending 定时器时 scheduleInstanceTimer 复用之，到期时间以实际命令为准
+		cmd, err := txc.OperationalCommand.Query().
+			Where(
+				operationalcommand.TenantID(instance.TenantID),
+				operationalcommand.CommandType(commandbus.CommandFireBPMNTimer),
+				operationalcommand.IdempotencyKeyHasPrefix(timerIdempotencyPrefix(instance.ID, elementID)),
+				operationalcommand.StatusIn(commandbus.StatusPending, commandbus.StatusProcessing),
+			).
+			Order(ent.Desc(operationalcommand.FieldID)).
+			First(ctx)
+		if err != nil {
+			return fmt.Errorf("查询定时器 %s 失败: %w", elementID, err)
+		}
+		dueAt = cmd.AvailableAt
+		return nil
+	})
+	return dueAt, err
+}
+
+// loadInstanceProcess 加载实例固定绑定的流程定义并解析
+func (e *CustomProcessEngine) loadInstanceProcess(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance) (*BPMNProcess, error) {
+	definition, err := txc.ProcessDefinition.Query().
+		Where(
+			processdefinition.ID(instance.ProcessDefinitionID),
+			processdefinition.TenantID(instance.TenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("获取流程定义失败: %w", err)
+	}
+	definitions, err := e.parser.ParseXML(definition.BpmnXML)
+	if err != nil {
+		return nil, fmt.Errorf("解析BPMN失败: %w", err)
+	}
+	return definitions.Processes[0], nil
+}
+
+// HandleTimerCommand 是 commandbus.CommandFireBPMNTimer 的处理器
+func (e *CustomProcessEngine) HandleTimerCommand(ctx context.Context, cmd *ent.OperationalCommand) error {
+	if cmd == nil || cmd.TenantID <= 0 || cmd.AggregateID <= 0 {
+		return fmt.Errorf("invalid BPMN timer command")
+	}
+	ctx = context.WithValue(ctx, bpmn.BPMNTenantIDContextKey, cmd.TenantID)
+	ctx = context.WithValue(ctx, firingTimerCommandKey{}, cmd.ID)
+	kind, _ := cmd.Payload["kind"].(string)
+	elementID, _ := cmd.Payload["element_id"].(string)
+	if elementID == "" {
+		return fmt.Errorf("BPMN timer command %d has no element_id", cmd.ID)
+	}
+	if kind == bpmnTimerKindStart {
+		return e.fireStartTimer(ctx, cmd, elementID)
+	}
+
+	tx, err := e.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+	txc := tx.Client()
+
+	instance, err := txc.ProcessInstance.Get(ctx, cmd.AggregateID)
+	if err != nil || instance.TenantID != cmd.TenantID {
+		_ = tx.Rollback()
+		e.logger.Warnw("定时器对应的流程实例不存在，忽略", "command", cmd.ID, "instance", cmd.AggregateID)
+		return nil
+	}
+	switch instance.Status {
+	case "running":
+	case "suspended":
+		// 不能返回错误：长时间暂停会耗尽重试次数进入死信。顺延为新的 pending 命令，恢复时提前到原到期时间
+		if err := e.deferSuspendedTimer(ctx, txc, instance, cmd, elementID); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("提交事务失败: %w", err)
+		}
+		return nil
+	default:
+		_ = tx.Rollback()
+		return nil
+	}
+	process, err := e.loadInstanceProcess(ctx, txc, instance)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	switch kind {
+	case bpmnTimerKindIntermediate:
+		err = e.fireIntermediateTimer(ctx, txc, instance, process, elementID)
+	case bpmnTimerKindBoundary:
+		err = e.fireBoundaryTimer(ctx, txc, instance, process, cmd, elementID)
+	default:
+		err = fmt.Errorf("未知的定时器类型 %q", kind)
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("提交事务失败: %w", err)
+	}
+	return nil
+}
+
+// deferSuspendedTimer 实例暂停时顺延定时器：复制命令（保留原到期时间与周期信息）延后 bpmnTimerSuspendedRecheck 再检查
+func (e *CustomProcessEngine) deferSuspendedTimer(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, cmd *ent.OperationalCommand, elementID string) error {
+	recheckAt := time.Now().Add(bpmnTimerSuspendedRecheck)
+	payload := make(map[string]interface{}, len(cmd.Payload))
+	for k, v := range cmd.Payload {
+		payload[k] = v
+	}
+	_, err := commandbus.Enqueue(ctx, txc, commandbus.EnqueueRequest{
+		TenantID:       instance.TenantID,
+		CommandType:    commandbus.CommandFireBPMNTimer,
+		AggregateType:  bpmnTimerAggregateInstance,
+		AggregateID:    instance.ID,
+		IdempotencyKey: fmt.Sprintf("%s%d", timerIdempotencyPrefix(instance.ID, elementID), recheckAt.UnixNano()),
+		Payload:        payload,
+		MaxAttempts:    bpmnTimerMaxAttempts,
+		AvailableAt:    recheckAt,
+	})
+	if err != nil {
+		return fmt.Errorf("顺延定时器 %s 失败: %w", elementID, err)
+	}
+	e.logger.Infow("流程实例已暂停，定时器顺延", "instance", instance.ID, "element", elementID, "recheckAt", recheckAt)
+	return nil
+}
+
+// resumeInstanceTimers 实例恢复后把暂停期间顺延的定时器提前到原到期时间（已过期的立即触发）
+func (e *CustomProcessEngine) resumeInstanceTimers(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance) error {
+	cmds, err := txc.OperationalCommand.Query().
+		Where(
+			operationalcommand.TenantID(instance.TenantID),
+			operationalcommand.CommandType(commandbus.CommandFireBPMNTimer),
+			operationalcommand.AggregateType(bpmnTimerAggregateInstance),
+			operationalcommand.AggregateID(instance.ID),
+			operationalcommand.StatusEQ(commandbus.StatusPending),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("查询定时器失败: %w", err)
+	}
+	now := time.Now()
+	for _, cmd := range cmds {
+		due, err := time.Parse(time.RFC3339, fmt.Sprint(cmd.Payload["due_at"]))
+		if err != nil {
+			continue
+		}
+		if due.Before(now) {
+			due = now
+		}
+		if !cmd.AvailableAt.After(due) {
+			continue
+		}
+		if _, err := txc.OperationalCommand.UpdateOne(cmd).SetAvailableAt(due).Save(ctx); err != nil {
+			return fmt.Errorf("恢复定时器失败: %w", err)
+		}
+	}
+	return nil
+}
+
+// fireIntermediateTimer 中间定时器到期：离开等待态并沿出边推进
+func (e *CustomProcessEngine) fireIntermediateTimer(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, process *BPMNProcess, elementID string) error {
+	if !isElementWaiting(instance, elementID) {
+		e.logger.Infow("中间定时器已失效（实例不在该节点等待），忽略", "instance", instance.ID, "element", elementID)
+		return nil
+	}
+	if err := e.settleEventGateway(ctx, txc, instance, process, elementID); err != nil {
+		return err
+	}
+	if err := e.markElementWaiting(ctx, txc, instance, elementID, false); err != nil {
+		return err
+	}
+	e.markElementDone(ctx, txc, instance, elementID)
+	e.recordTimerHistory(ctx, txc, instance, elementID, bpmnTimerKindIntermediate)
+	return e.executeStep(ctx, txc, instance, process, elementID, instance.Variables)
+}
+
+// fireBoundaryTimer 边界定时器到期。
+// 中断型：取消所挂载活动的未结束任务与其余边界定时器，再沿边界事件出边推进；
+// 非中断型：活动保持不变，额外开启一条边界事件出边分支，timeCycle 时继续调度下一次。
+func (e *CustomProcessEngine) fireBoundaryTimer(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, process *BPMNProcess, cmd *ent.OperationalCommand, elementID string) error {
+	boundary := e.findBoundaryEvent(process, elementID)
+	if boundary == nil {
+		return fmt.Errorf("边界事件 %s 不存在于流程定义中", elementID)
+	}
+	fired, err := e.activateBoundaryEvent(ctx, txc, instance, process, boundary)
+	if err != nil {
+		return err
+	}
+	if !fired {
+		e.logger.Infow("边界定时器所挂载活动已结束，忽略", "instance", instance.ID, "boundary", elementID)
+		return nil
+	}
+	if timerType, _ := cmd.Payload["timer_type"].(string); !boundary.IsInterrupting() && timerType == bpmnTimerTypeCycle {
+		if err := e.rescheduleCycleTimer(ctx, txc, instance.TenantID, bpmnTimerAggregateInstance, instance.ID,
+			timerIdempotencyPrefix(instance.ID, elementID), cmd); err != nil {
+			return err
+		}
+	}
+
+	e.markElementDone(ctx, txc, instance, elementID)
+	e.recordTimerHistory(ctx, txc, instance, elementID, bpmnTimerKindBoundary)
+	return e.executeStep(ctx, txc, instance, process, elementID, instance.Variables)
+}
+
+// rescheduleCycleTimer 按 timeCycle 调度下一次触发（剩余次数用尽则停止）
+func (e *CustomProcessEngine) rescheduleCycleTimer(ctx context.Context, txc *ent.Client, tenantID int, aggregateType string, aggregateID int, prefix string, cmd *ent.OperationalCommand) error {
+	remaining := -1
+	if n, ok := numericInt(cmd.Payload["remaining"]); ok {
+		remaining = n
+	}
+	if remaining > 0 {
+		remaining--
+	}
+	if remaining == 0 {
+		return nil
+	}
+	expression, _ := cmd.Payload["expression"].(string)
+	spec, err := parseBPMNTimerCycle(expression)
+	if err != nil {
+		return err
+	}
+	previous, err := time.Parse(time.RFC3339, fmt.Sprint(cmd.Payload["due_at"]))
+	if err != nil {
+		previous = time.Now()
+	}
+	next := spec.Duration.addTo(previous)
+	if now := time.Now(); next.Before(now) {
+		// 停机期间错过的周期不补发，只从当前时刻继续
+		next = spec.Duration.addTo(now)
+	}
+	payload := make(map[string]interface{}, len(cmd.Payload))
+	for k, v := range cmd.Payload {
+		payload[k] = v
+	}
+	payload["remaining"] = remaining
+	payload["due_at"] = next.Format(time.RFC3339)
+	_, err = commandbus.Enqueue(ctx, txc, commandbus.EnqueueRequest{
+		TenantID:       tenantID,
+		CommandType:    commandbus.CommandFireBPMNTimer,
+		AggregateType:  aggregateType,
+		AggregateID:    aggregateID,
+		IdempotencyKey: fmt.Sprintf("%s%d", prefix, next.UnixNano()),
+		Payload:        payload,
+		MaxAttempts:    bpmnTimerMaxAttempts,
+		AvailableAt:    next,
+	})
+	if err != nil {
+		return fmt.Errorf("调度下一次周期定时器失败: %w", err)
+	}
+	return nil
+}
+
+// fireStartTimer 定时启动事件到期：以该开始事件启动新实例，周期定时器继续调度下一次
+func (e *CustomProcessEngine) fireStartTimer(ctx context.Context, cmd *ent.OperationalCommand, elementID string) error {
+	definition, err := e.client.ProcessDefinition.Query().
+		Where(processdefinition.ID(cmd.AggregateID), processdefinition.TenantID(cmd.TenantID)).
+		Only(ctx)
+	if err != nil {
+		e.logger.Warnw("定时启动事件对应的流程定义不存在，忽略", "command", cmd.ID, "definition", cmd.AggregateID)
+		return nil
+	}
+	// 流程定义已停用或被新版本取代时不再启动，也不再续期
+	if !definition.IsActive || !definition.IsLatest {
+		return nil
+	}
+	definitions, err := e.parser.ParseXML(definition.BpmnXML)
+	if err != nil {
+		return fmt.Errorf("解析BPMN失败: %w", err)
+	}
+	process := definitions.Processes[0]
+	var startEvent *BPMNStartEvent
+	for _, event := range process.StartEvents {
+		if event.ID == elementID {
+			startEvent = event
+			break
+		}
+	}
+	if startEvent == nil {
+		return fmt.Errorf("开始事件 %s 不存在于流程定义中", elementID)
+	}
+
+	tx, err := e.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+	txc := tx.Client()
+	businessKey := fmt.Sprintf("timer:%s:%d", elementID, cmd.ID)
+	variables := map[string]interface{}{"triggered_by": "timer", "timer_event_id": elementID}
+	if _, err := e.startProcessAt(ctx, txc, definition, process, startEvent, nil, businessKey, variables); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if timerType, _ := cmd.Payload["timer_type"].(string); timerType == bpmnTimerTypeCycle {
+		if err := e.rescheduleCycleTimer(ctx, txc, cmd.TenantID, bpmnTimerAggregateDefinition, definition.ID,
+			startTimerIdempotencyPrefix(definition.ID, elementID), cmd); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("提交事务失败: %w", err)
+	}
+	return nil
+}
+
+// recordTimerHistory 记录定时器触发历史，便于在实例历史中审计「何时因超时而流转」
+func (e *CustomProcessEngine) recordTimerHistory(ctx context.Context, txc *ent.Client, instance *ent.ProcessInstance, elementID, kind string) {
+	_, err := txc.ProcessExecutionHistory.Create().
+		SetHistoryID(fmt.Sprintf("HIST-%s-%d", elementID, time.Now().UnixNano())).
+		SetProcessInstanceID(instance.ID).
+		SetProcessDefinitionKey(instance.ProcessDefinitionKey).
+		SetActivityID(elementID).
+		SetActivityType("timer_event").
+		SetEventType(fmt.Sprintf("timer.%s.fired", kind)).
+		SetVariables(instance.Variables).
+		SetTenantID(instance.TenantID).
+		SetTimestamp(time.Now()).
+		Save(ctx)
+	if err != nil {
+		e.logger.Warnw("recordTimerHistory 保存失败", "error", err)
+	}
+}
+
+// startTimerIdempotencyPrefix 定时启动事件命令前缀
+func startTimerIdempotencyPrefix(definitionID int, elementID string) string {
+	return fmt.Sprintf("bpmn-start-timer:%d:%s:", definitionID, elementID)
+}
+
+// ScheduleStartTimers 为流程定义中的定时启动事件调度首次触发，并取消同 Key 旧版本的启动定时器。
+// 由部署服务在流程定义生效后调用；client 可以是事务客户端。
+func ScheduleStartTimers(ctx context.Context, client *ent.Client, definition *ent.ProcessDefinition, process *BPMNProcess) error {
+	previous, err := client.ProcessDefinition.Query().
+		Where(
+			processdefinition.TenantID(definition.TenantID),
+			processdefinition.Key(definition.Key),
+			processdefinition.IDNEQ(definition.ID),
+		).
+		IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("查询旧版本流程定义失败: %w", err)
+	}
+	if len(previous) > 0 {
+		if _, err := client.OperationalCommand.Update().
+			Where(
+				operationalcommand.TenantID(definition.TenantID),
+				operationalcommand.CommandType(commandbus.CommandFireBPMNTimer),
+				operationalcommand.AggregateType(bpmnTimerAggregateDefinition),
+				operationalcommand.AggregateIDIn(previous...),
+				operationalcommand.StatusEQ(commandbus.StatusPending),
+			).
+			SetStatus(commandbus.StatusCancelled).
+			SetCompletedAt(time.Now()).
+			Save(ctx); err != nil {
+			return fmt.Errorf("取消旧版本启动定时器失败: %w", err)
+		}
+	}
+
+	for _, event := range process.StartEvents {
+		spec, err := parseBPMNTimerSpec(event.TimerDefinition, event.TimerRef, nil)
+		if err != nil {
+			return fmt.Errorf("定时启动事件 [%s] 配置无效: %w", event.ID, err)
+		}
+		if spec == nil {
+			continue
+		}
+		fireAt := spec.firstFireTime(time.Now())
+		payload := map[string]interface{}{
+			"kind":       bpmnTimerKindStart,
+			"element_id": event.ID,
+			"timer_type": spec.Type,
+			"expression": spec.Expression,
+			"due_at":     fireAt.Format(time.RFC3339),
+		}
+		if spec.Type == bpmnTimerTypeCycle {
+			payload["remaining"] = spec.Repetitions
+		}
+		if _, err := commandbus.Enqueue(ctx, client, commandbus.EnqueueRequest{
+			TenantID:       definition.TenantID,
+			CommandType:    commandbus.CommandFireBPMNTimer,
+			AggregateType:  bpmnTimerAggregateDefinition,
+			AggregateID:    definition.ID,
+			IdempotencyKey: fmt.Sprintf("%s%d", startTimerIdempotencyPrefix(definition.ID, event.ID), fireAt.UnixNano()),
+			Payload:        payload,
+			MaxAttempts:    bpmnTimerMaxAttempts,
+			AvailableAt:    fireAt,
+		}); err != nil {
+			return fmt.Errorf("调度定时启动事件 [%s] 失败: %w", event.ID, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,401 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"itsm-backend/ent"
+	"itsm-backend/ent/enttest"
+	"itsm-backend/ent/operationalcommand"
+	"itsm-backend/ent/processtask"
+	"itsm-backend/internal/commandbus"
+	"itsm-backend/service/bpmn"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"go.uber.org/zap/zaptest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 「等待 2 天后提醒」：中间定时器捕获事件后接用户任务
+const intermediateTimerBPMN = `<?xml version="1.0" encoding="UTF-8"?>
+<bpmn:definitions xmlns:bpmn="http://www.omg.org/spec/BPMN/20100524/MODEL" id="Definitions_timer" targetNamespace="http://bpmn.io/schema/bpmn">
+  <bpmn:process id="Process_timer" name="Timer" isExecutable="true">
+    <bpmn:startEvent id="StartEvent_1"/>
+    <bpmn:intermediateCatchEvent id="Wait_2d" name="等待两天">
+      <bpmn:timerEventDefinition><bpmn:timeDuration>P2D</bpmn:timeDuration></bpmn:timerEventDefinition>
+    </bpmn:intermediateCatchEvent>
+    <bpmn:userTask id="Remind" name="提醒"/>
+    <bpmn:endEvent id="EndEvent_1"/>
+    <bpmn:sequenceFlow id="Flow_1" sourceRef="StartEvent_1" targetRef="Wait_2d"/>
+    <bpmn:sequenceFlow id="Flow_2" sourceRef="Wait_2d" targetRef="Remind"/>
+    <bpmn:sequenceFlow id="Flow_3" sourceRef="Remind" targetRef="EndEvent_1"/>
+  </bpmn:process>
+</bpmn:definitions>`
+
+// 审批任务 48h 未处理自动升级（中断型边界定时器）
+const boundaryTimerBPMN = `<?xml version="1.0" encoding="UTF-8"?>
+<bpmn:definitions xmlns:bpmn="http://www.omg.org/spec/BPMN/20100524/MODEL" id="Definitions_boundary" targetNamespace="http://bpmn.io/schema/bpmn">
+  <bpmn:process id="Process_boundary" name="Boundary" isExecutable="true">
+    <bpmn:startEvent id="StartEvent_1"/>
+    <bpmn:userTask id="Approve" name="审批" assignee="1"/>
+    <bpmn:boundaryEvent id="Escalate_48h" attachedToRef="Approve" cancelActivity="%s">
+      <bpmn:timerEventDefinition><bpmn:timeDuration>PT48H</bpmn:timeDuration></bpmn:timerEventDefinition>
+    </bpmn:boundaryEvent>
+    <bpmn:userTask id="Escalated" name="升级处理" assignee="1"/>
+    <bpmn:endEvent id="EndEvent_1"/>
+    <bpmn:endEvent id="EndEvent_2"/>
+    <bpmn:sequenceFlow id="Flow_1" sourceRef="StartEvent_1" targetRef="Approve"/>
+    <bpmn:sequenceFlow id="Flow_2" sourceRef="Approve" targetRef="EndEvent_1"/>
+    <bpmn:sequenceFlow id="Flow_3" sourceRef="Escalate_48h" targetRef="Escalated"/>
+    <bpmn:sequenceFlow id="Flow_4" sourceRef="Escalated" targetRef="EndEvent_2"/>
+  </bpmn:process>
+</bpmn:definitions>`
+
+func seedTimerEngine(t *testing.T, key, bpmnXML string) (*CustomProcessEngine, *ent.Client, context.Context) {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", testDSN())
+	t.Cleanup(func() { client.Close() })
+	engine := NewCustomProcessEngine(client, zaptest.NewLogger(t).Sugar()).(*CustomProcessEngine)
+	tenantID := 11
+	dep, err := client.ProcessDeployment.Create().
+		SetDeploymentID("DEP-" + key).SetDeploymentName(key).SetTenantID(tenantID).Save(context.Background())
+	require.NoError(t, err)
+	_, err = client.ProcessDefinition.Create().
+		SetKey(key).SetName(key).SetBpmnXML([]byte(bpmnXML)).
+		SetDeploymentID(dep.ID).SetTenantID(tenantID).SetIsActive(true).SetIsLatest(true).Save(context.Background())
+	require.NoError(t, err)
+	return engine, client, context.WithValue(context.Background(), bpmn.BPMNTenantIDContextKey, tenantID)
+}
+
+func pendingTimers(t *testing.T, client *ent.Client, ctx context.Context) []*ent.OperationalCommand {
+	t.Helper()
+	cmds, err := client.OperationalCommand.Query().
+		Where(
+			operationalcommand.CommandType(commandbus.CommandFireBPMNTimer),
+			operationalcommand.StatusEQ(commandbus.StatusPending),
+		).
+		All(ctx)
+	require.NoError(t, err)
+	return cmds
+}
+
+func TestParseISODuration(t *testing.T) {
+	base := time.Date(2026, 1, 31, 10, 0, 0, 0, time.UTC)
+	cases := []struct {
+		expr string
+		want time.Time
+	}{
+		{"PT48H", base.Add(48 * time.Hour)},
+		{"P2D", base.AddDate(0, 0, 2)},
+		{"P1DT12H30M", base.AddDate(0, 0, 1).Add(12*time.Hour + 30*time.Minute)},
+		{"P1W", base.AddDate(0, 0, 7)},
+		{"PT0.5S", base.Add(500 * time.Millisecond)},
+		{"P1M", base.AddDate(0, 1, 0)},
+	}
+	for _, tc := range cases {
+		d, err := parseISODuration(tc.expr)
+		require.NoError(t, err, tc.expr)
+		assert.Equal(t, tc.want, d.addTo(base), tc.expr)
+	}
+	for _, bad := range []string{"", "P", "PT", "48H", "P0D", "PXD"} {
+		_, err := parseISODuration(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestParseBPMNTimerSpec(t *testing.T) {
+	spec, err := parseBPMNTimerSpec(&BPMNTimerEventDefinition{TimeCycle: "R3/PT10M"}, "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, bpmnTimerTypeCycle, spec.Type)
+	assert.Equal(t, 3, spec.Repetitions)
+
+	spec, err = parseBPMNTimerSpec(&BPMNTimerEventDefinition{TimeCycle: "R/2026-01-01T00:00:00Z/P1D"}, "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, -1, spec.Repetitions)
+	now := time.Date(2026, 3, 5, 8, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC), spec.firstFireTime(now).UTC())
+
+	spec, err = parseBPMNTimerSpec(&BPMNTimerEventDefinition{TimeDate: "2026-12-01T09:00:00+08:00"}, "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 12, 1, 1, 0, 0, 0, time.UTC), spec.firstFireTime(now).UTC())
+
+	// timerRef 兼容属性 + ${var} 变量引用
+	spec, err = parseBPMNTimerSpec(nil, "${reminderDelay}", map[string]interface{}{"reminderDelay": "PT4H"})
+	require.NoError(t, err)
+	assert.Equal(t, bpmnTimerTypeDuration, spec.Type)
+
+	spec, err = parseBPMNTimerSpec(nil, "", nil)
+	require.NoError(t, err)
+	assert.Nil(t, spec)
+}
+
+func TestIntermediateTimer_SchedulesAndFires(t *testing.T) {
+	engine, client, ctx := seedTimerEngine(t, "timerDemo", intermediateTimerBPMN)
+
+	inst, err := engine.StartProcess(ctx, "timerDemo", "biz-1", map[string]interface{}{})
+	require.NoError(t, err)
+
+	cmds := pendingTimers(t, client, ctx)
+	require.Len(t, cmds, 1, "进入中间定时器应持久化一条延迟命令")
+	assert.Equal(t, inst.ID, cmds[0].AggregateID)
+	assert.WithinDuration(t, time.Now().Add(48*time.Hour), cmds[0].AvailableAt, time.Minute)
+	open, err := client.ProcessTask.Query().Where(processtask.ProcessInstanceID(inst.ID)).Count(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, open, "定时器到期前不应创建后续任务")
+
+	require.NoError(t, engine.HandleTimerCommand(ctx, cmds[0]))
+
+	task, err := client.ProcessTask.Query().Where(processtask.ProcessInstanceID(inst.ID)).Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "Remind", task.TaskDefinitionKey)
+
+	// 重复投递（如 worker 租约过期重放）不应再次推进
+	require.NoError(t, engine.HandleTimerCommand(ctx, cmds[0]))
+	count, err := client.ProcessTask.Query().Where(processtask.ProcessInstanceID(inst.ID)).Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestEventServiceTimer_ArmsIntermediateTimer(t *testing.T) {
+	engine, client, ctx := seedTimerEngine(t, "timerEventDemo", intermediateTimerBPMN)
+	inst, err := engine.StartProcess(ctx, "timerEventDemo", "biz-1", map[string]interface{}{})
+	require.NoError(t, err)
+	// 模拟实例尚未在定时器上等待：取消已调度的定时器并清除等待态
+	require.NoError(t, engine.cancelInstanceTimers(ctx, client, inst))
+	inst, err = client.ProcessInstance.Get(ctx, inst.ID)
+	require.NoError(t, err)
+	require.NoError(t, engine.markElementWaiting(ctx, client, inst, "Wait_2d", false))
+
+	svc := NewBPMNEventService(client)
+	svc.SetProcessEngine(engine)
+	result, err := svc.TriggerEvent(ctx, &EventTriggerRequest{
+		EventDefinitionID: "Wait_2d", ProcessInstanceID: inst.ProcessInstanceID, TenantID: 11,
+	})
+	require.NoError(t, err, "未传 variables 时不应 panic 或失败")
+	assert.Empty(t, result.NextActivities)
+	assert.NotEmpty(t, result.Variables["timer_due_at"])
+
+	cmds := pendingTimers(t, client, ctx)
+	require.Len(t, cmds, 1)
+	require.NoError(t, engine.HandleTimerCommand(ctx, cmds[0]))
+	task, err := client.ProcessTask.Query().Where(processtask.ProcessInstanceID(inst.ID)).Only(ctx)
+	require.NoError(t, err, "经事件接口调度的定时器到期后应推进流程")
+	assert.Equal(t, "Remind", task.TaskDefinitionKey)
+}
+
+func TestBuildEventDefinition_KeepsTimerRef(t *testing.T) {
+	process := &BPMNProcess{IntermediateEvents: []*BPMNIntermediateEvent{{ID: "Wait", TimerRef: "2026-12-01T09:00:00+08:00"}}}
+	eventDef := buildEventDefinition(process, "Wait")
+	require.NotNil(t, eventDef)
+	assert.Equal(t, TriggerTimer, eventDef.Trigger)
+	assert.Equal(t, "2026-12-01T09:00:00+08:00", eventDef.Properties["timerRef"])
+	assert.Nil(t, eventDef.Properties["timeDuration"])
+}
+
+func TestBoundaryTimer_InterruptingEscalates(t *testing.T) {
+	engine, client, ctx := seedTimerEngine(t, "boundaryDemo", fmt.Sprintf(boundaryTimerBPMN, "true"))
+
+	inst, err := engine.StartProcess(ctx, "boundaryDemo", "biz-2", map[string]interface{}{})
+	require.NoError(t, err)
+	cmds := pendingTimers(t, client, ctx)
+	require.Len(t, cmds, 1)
+	assert.Equal(t, "Escalate_48h", cmds[0].Payload["element_id"])
+
+	require.NoError(t, engine.HandleTimerCommand(ctx, cmds[0]))
+
+	approve, err := client.ProcessTask.Query().
+		Where(processtask.ProcessInstanceID(inst.ID), processtask.TaskDefinitionKey("Approve")).Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "cancelled", approve.Status, "中断型边界定时器应取消原审批任务")
+	escalated, err := client.ProcessTask.Query().
+		Where(processtask.ProcessInstanceID(inst.ID), processtask.TaskDefinitionKey("Escalated")).Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "created", escalated.Status)
+}
+
+func TestBoundaryTimer_NonInterruptingKeepsTask(t *testing.T) {
+	engine, client, ctx := seedTimerEngine(t, "boundaryNI", fmt.Sprintf(boundaryTimerBPMN, "false"))
+
+	inst, err := engine.StartProcess(ctx, "boundaryNI", "biz-3", map[string]interface{}{})
+	require.NoError(t, err)
+	cmds := pendingTimers(t, client, ctx)
+	require.Len(t, cmds, 1)
+	require.NoError(t, engine.HandleTimerCommand(ctx, cmds[0]))
+
+	open, err := client.ProcessTask.Query().
+		Where(processtask.ProcessInstanceID(inst.ID), processtask.StatusNotIn("completed", "cancelled")).All(ctx)
+	require.NoError(t, err)
+	assert.Len(t, open, 2, "非中断型边界定时器应保留原任务并并行开启升级分支")
+}
+
+func TestBoundaryTimer_CancelledWhenTaskCompletes(t *testing.T) {
+	engine, client, ctx := seedTimerEngine(t, "boundaryDone", fmt.Sprintf(boundaryTimerBPMN, "true"))
+
+	inst, err := engine.StartProcess(ctx, "boundaryDone", "biz-4", map[string]interface{}{})
+	require.NoError(t, err)
+	require.Len(t, pendingTimers(t, client, ctx), 1)
+
+	approve, err := client.ProcessTask.Query().
+		Where(processtask.ProcessInstanceID(inst.ID), processtask.TaskDefinitionKey("Approve")).Only(ctx)
+	require.NoError(t, err)
+	require.NoError(t, engine.CompleteTask(ctx, approve.TaskID, map[string]interface{}{}))
+
+	assert.Empty(t, pendingTimers(t, client, ctx), "任务按时完成后边界定时器应被取消")
+	cancelled, err := client.OperationalCommand.Query().
+		Where(operationalcommand.StatusEQ(commandbus.StatusCancelled)).Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, cancelled)
+}
+
+func TestScheduleStartTimers(t *testing.T) {
+	const startTimerBPMN = `<?xml version="1.0" encoding="UTF-8"?>
+<bpmn:definitions xmlns:bpmn="http://www.omg.org/spec/BPMN/20100524/MODEL" id="Definitions_start" targetNamespace="http://bpmn.io/schema/bpmn">
+  <bpmn:process id="Process_start" name="Start" isExecutable="true">
+    <bpmn:startEvent id="Every_Day">
+      <bpmn:timerEventDefinition><bpmn:timeCycle>R2/PT24H</bpmn:timeCycle></bpmn:timerEventDefinition>
+    </bpmn:startEvent>
+    <bpmn:userTask id="Check" name="巡检"/>
+    <bpmn:endEvent id="EndEvent_1"/>
+    <bpmn:sequenceFlow id="Flow_1" sourceRef="Every_Day" targetRef="Check"/>
+    <bpmn:sequenceFlow id="Flow_2" sourceRef="Check" targetRef="EndEvent_1"/>
+  </bpmn:process>
+</bpmn:definitions>`
+	engine, client, ctx := seedTimerEngine(t, "Process_start", startTimerBPMN)
+	def, err := client.ProcessDefinition.Query().Only(ctx)
+	require.NoError(t, err)
+	defs, err := NewBPMNParser().ParseXML([]byte(startTimerBPMN))
+	require.NoError(t, err)
+
+	require.NoError(t, ScheduleStartTimers(ctx, client, def, defs.Processes[0]))
+	cmds := pendingTimers(t, client, ctx)
+	require.Len(t, cmds, 1)
+	assert.Equal(t, bpmnTimerAggregateDefinition, cmds[0].AggregateType)
+
+	require.NoError(t, engine.HandleTimerCommand(ctx, cmds[0]))
+	instances, err := client.ProcessInstance.Query().All(ctx)
+	require.NoError(t, err)
+	require.Len(t, instances, 1, "定时启动事件到期应启动一个新实例")
+
+	// R2：首次触发后还应调度剩余的一次
+	next, err := client.OperationalCommand.Query().
+		Where(
+			operationalcommand.CommandType(commandbus.CommandFireBPMNTimer),
+			operationalcommand.IDNEQ(cmds[0].ID),
+		).
+		Only(ctx)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, next.Payload["remaining"])
+}
+
+// claimTimer 模拟 commandbus worker 认领命令：触发期间命令处于 processing
+func claimTimer(t *testing.T, client *ent.Client, ctx context.Context, cmd *ent.OperationalCommand) *ent.OperationalCommand {
+	t.Helper()
+	claimed, err := client.OperationalCommand.UpdateOne(cmd).SetStatus(commandbus.StatusProcessing).Save(ctx)
+	require.NoError(t, err)
+	return claimed
+}
+
+func TestIntermediateTimer_LoopReschedules(t *testing.T) {
+	const loopBPMN = `<?xml version="1.0" encoding="UTF-8"?>
+<bpmn:definitions xmlns:bpmn="http://www.omg.org/spec/BPMN/20100524/MODEL" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" id="Definitions_loop" targetNamespace="http://bpmn.io/schema/bpmn">
+  <bpmn:process id="Process_loop" name="Loop" isExecutable="true">
+    <bpmn:startEvent id="StartEvent_1"/>
+    <bpmn:intermediateCatchEvent id="Poll" name="每小时检查">
+      <bpmn:timerEventDefinition><bpmn:timeDuration>PT1H</bpmn:timeDuration></bpmn:timerEventDefinition>
+    </bpmn:intermediateCatchEvent>
+    <bpmn:exclusiveGateway id="Gateway_1" default="Flow_3"/>
+    <bpmn:endEvent id="EndEvent_1"/>
+    <bpmn:sequenceFlow id="Flow_1" sourceRef="StartEvent_1" targetRef="Poll"/>
+    <bpmn:sequenceFlow id="Flow_2" sourceRef="Poll" targetRef="Gateway_1"/>
+    <bpmn:sequenceFlow id="Flow_3" sourceRef="Gateway_1" targetRef="Poll"/>
+    <bpmn:sequenceFlow id="Flow_4" sourceRef="Gateway_1" targetRef="EndEvent_1">
+      <bpmn:conditionExpression xsi:type="bpmn:tFormalExpression">done == true</bpmn:conditionExpression>
+    </bpmn:sequenceFlow>
+  </bpmn:process>
+</bpmn:definitions>`
+	engine, client, ctx := seedTimerEngine(t, "timerLoop", loopBPMN)
+	inst, err := engine.StartProcess(ctx, "timerLoop", "biz-loop", map[string]interface{}{})
+	require.NoError(t, err)
+	cmds := pendingTimers(t, client, ctx)
+	require.Len(t, cmds, 1)
+
+	require.NoError(t, engine.HandleTimerCommand(ctx, claimTimer(t, client, ctx, cmds[0])))
+
+	next := pendingTimers(t, client, ctx)
+	require.Len(t, next, 1, "回到同一中间定时器时应调度新的定时器，不能复用正在触发的命令")
+	assert.NotEqual(t, cmds[0].ID, next[0].ID)
+	reloaded, err := client.ProcessInstance.Get(ctx, inst.ID)
+	require.NoError(t, err)
+	assert.True(t, isElementWaiting(reloaded, "Poll"))
+}
+
+func TestBoundaryTimer_EscalateReturnsToApproval(t *testing.T) {
+	const returnBPMN = `<?xml version="1.0" encoding="UTF-8"?>
+<bpmn:definitions xmlns:bpmn="http://www.omg.org/spec/BPMN/20100524/MODEL" id="Definitions_return" targetNamespace="http://bpmn.io/schema/bpmn">
+  <bpmn:process id="Process_return" name="Return" isExecutable="true">
+    <bpmn:startEvent id="StartEvent_1"/>
+    <bpmn:userTask id="Approve" name="审批" assignee="1"/>
+    <bpmn:boundaryEvent id="Escalate_48h" attachedToRef="Approve" cancelActivity="true">
+      <bpmn:timerEventDefinition><bpmn:timeDuration>PT48H</bpmn:timeDuration></bpmn:timerEventDefinition>
+    </bpmn:boundaryEvent>
+    <bpmn:endEvent id="EndEvent_1"/>
+    <bpmn:sequenceFlow id="Flow_1" sourceRef="StartEvent_1" targetRef="Approve"/>
+    <bpmn:sequenceFlow id="Flow_2" sourceRef="Approve" targetRef="EndEvent_1"/>
+    <bpmn:sequenceFlow id="Flow_3" sourceRef="Escalate_48h" targetRef="Approve"/>
+  </bpmn:process>
+</bpmn:definitions>`
+	engine, client, ctx := seedTimerEngine(t, "boundaryReturn", returnBPMN)
+	inst, err := engine.StartProcess(ctx, "boundaryReturn", "biz-return", map[string]interface{}{})
+	require.NoError(t, err)
+	cmds := pendingTimers(t, client, ctx)
+	require.Len(t, cmds, 1)
+
+	require.NoError(t, engine.HandleTimerCommand(ctx, claimTimer(t, client, ctx, cmds[0])))
+
+	open, err := client.ProcessTask.Query().
+		Where(processtask.ProcessInstanceID(inst.ID), processtask.TaskDefinitionKey("Approve"), processtask.StatusNotIn("completed", "cancelled")).
+		Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, open, "升级后应退回重新审批")
+	next := pendingTimers(t, client, ctx)
+	require.Len(t, next, 1, "重新进入审批任务时应重新调度边界定时器")
+	assert.NotEqual(t, cmds[0].ID, next[0].ID)
+	assert.Equal(t, "Escalate_48h", next[0].Payload["element_id"])
+}
+
+func TestIntermediateTimer_SuspendedDefersUntilResume(t *testing.T) {
+	engine, client, ctx := seedTimerEngine(t, "timerSuspend", intermediateTimerBPMN)
+	inst, err := engine.StartProcess(ctx, "timerSuspend", "biz-suspend", map[string]interface{}{})
+	require.NoError(t, err)
+	cmds := pendingTimers(t, client, ctx)
+	require.Len(t, cmds, 1)
+	// 模拟实例暂停期间定时器已到期
+	payload := cmds[0].Payload
+	payload["due_at"] = time.Now().Add(-time.Hour).Format(time.RFC3339)
+	due, err := client.OperationalCommand.UpdateOne(cmds[0]).SetPayload(payload).Save(ctx)
+	require.NoError(t, err)
+	require.NoError(t, engine.SuspendProcess(ctx, inst.ProcessInstanceID, "维护窗口"))
+
+	require.NoError(t, engine.HandleTimerCommand(ctx, claimTimer(t, client, ctx, due)), "暂停时不能返回错误，否则重试耗尽后定时器进入死信")
+	count, err := client.ProcessTask.Query().Where(processtask.ProcessInstanceID(inst.ID)).Count(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, count, "暂停期间不应推进流程")
+	deferred := pendingTimers(t, client, ctx)
+	require.Len(t, deferred, 1, "暂停期间到期的定时器应顺延")
+	assert.WithinDuration(t, time.Now().Add(bpmnTimerSuspendedRecheck), deferred[0].AvailableAt, time.Minute)
+
+	require.NoError(t, engine.ResumeProcess(ctx, inst.ProcessInstanceID))
+	resumed, err := client.OperationalCommand.Get(ctx, deferred[0].ID)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), resumed.AvailableAt, time.Minute, "恢复后已到期的定时器应立即触发")
+
+	require.NoError(t, engine.HandleTimerCommand(ctx, claimTimer(t, client, ctx, resumed)))
+	task, err := client.ProcessTask.Query().Where(processtask.ProcessInstanceID(inst.ID)).Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "Remind", task.TaskDefinitionKey)
+}
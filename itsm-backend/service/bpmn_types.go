@@ -1,6 +1,9 @@
 package service
 
-import "encoding/xml"
+import (
+	"encoding/xml"
+	"strings"
+)
 
 // BPMNElement BPMN元素的基础接口
 type BPMNElement interface {
@@ -11,28 +14,65 @@ type BPMNElement interface {
 
 // BPMNProcess BPMN流程定义
 type BPMNProcess struct {
-	ID                 string                   `xml:"id,attr"`
-	Name               string                   `xml:"name,attr"`
-	ProcessType        string                   `xml:"processType,attr"`
-	IsExecutable       bool                     `xml:"isExecutable,attr"`
-	IsClosed           bool                     `xml:"isClosed,attr"`
-	StartEvents        []*BPMNStartEvent        `xml:"startEvent"`
-	EndEvents          []*BPMNEndEvent          `xml:"endEvent"`
-	UserTasks          []*BPMNUserTask          `xml:"userTask"`
-	ServiceTasks       []*BPMNServiceTask       `xml:"serviceTask"`
-	ScriptTasks        []*BPMNScriptTask        `xml:"scriptTask"`
-	BusinessRuleTasks  []*BPMNBusinessRuleTask  `xml:"businessRuleTask"`
-	ManualTasks        []*BPMNManualTask        `xml:"manualTask"`
-	CallActivities     []*BPMNCallActivity      `xml:"callActivity"`
-	ExclusiveGateways  []*BPMNExclusiveGateway  `xml:"exclusiveGateway"`
-	ParallelGateways   []*BPMNParallelGateway   `xml:"parallelGateway"`
-	InclusiveGateways  []*BPMNInclusiveGateway  `xml:"inclusiveGateway"`
+	ID                string                  `xml:"id,attr"`
+	Name              string                  `xml:"name,attr"`
+	ProcessType       string                  `xml:"processType,attr"`
+	IsExecutable      bool                    `xml:"isExecutable,attr"`
+	IsClosed          bool                    `xml:"isClosed,attr"`
+	StartEvents       []*BPMNStartEvent       `xml:"startEvent"`
+	EndEvents         []*BPMNEndEvent         `xml:"endEvent"`
+	UserTasks         []*BPMNUserTask         `xml:"userTask"`
+	ServiceTasks      []*BPMNServiceTask      `xml:"serviceTask"`
+	ScriptTasks       []*BPMNScriptTask       `xml:"scriptTask"`
+	BusinessRuleTasks []*BPMNBusinessRuleTask `xml:"businessRuleTask"`
+	ManualTasks       []*BPMNManualTask       `xml:"manualTask"`
+	ReceiveTasks      []*BPMNReceiveTask      `xml:"receiveTask"`
+	CallActivities    []*BPMNCallActivity     `xml:"callActivity"`
+	ExclusiveGateways []*BPMNExclusiveGateway `xml:"exclusiveGateway"`
+	ParallelGateways  []*BPMNParallelGateway  `xml:"parallelGateway"`
+	InclusiveGateways []*BPMNInclusiveGateway `xml:"inclusiveGateway"`
+	// EventBasedGateways 事件网关：等待出边上最先发生的捕获事件
+	EventBasedGateways []*BPMNEventBasedGateway `xml:"eventBasedGateway"`
 	SequenceFlows      []*BPMNSequenceFlow      `xml:"sequenceFlow"`
 	SubProcesses       []*BPMNSubProcess        `xml:"subProcess"`
 	BoundaryEvents     []*BPMNBoundaryEvent     `xml:"boundaryEvent"`
 	IntermediateEvents []*BPMNIntermediateEvent `xml:"intermediateCatchEvent"`
-	DataObjects        []*BPMNDataObject        `xml:"dataObject"`
-	DataStores         []*BPMNDataStore         `xml:"dataStore"`
+	// IntermediateThrowEvents 中间抛出事件（目前执行补偿抛出，其余类型直接流转）
+	IntermediateThrowEvents []*BPMNIntermediateThrowEvent `xml:"intermediateThrowEvent"`
+	DataObjects             []*BPMNDataObject             `xml:"dataObject"`
+	DataStores              []*BPMNDataStore              `xml:"dataStore"`
+	// Associations 关联（补偿边界事件通过关联指向补偿处理活动）
+	Associations []*BPMNAssociation `xml:"association"`
+
+	// messageNames/signalNames 由解析器根据 definitions 下的 <message>/<signal> 填充（ID → 名称）
+	messageNames map[string]string
+	signalNames  map[string]string
+	// errorCodes 由解析器根据 definitions 下的 <error> 填充（ID → errorCode）
+	errorCodes map[string]string
+}
+
+// MessageName 将 messageRef 解析为消息名称；未声明 <message> 时直接把引用本身当作名称
+func (p *BPMNProcess) MessageName(ref string) string {
+	if name := p.messageNames[ref]; name != "" {
+		return name
+	}
+	return ref
+}
+
+// SignalName 将 signalRef 解析为信号名称；未声明 <signal> 时直接把引用本身当作名称
+func (p *BPMNProcess) SignalName(ref string) string {
+	if name := p.signalNames[ref]; name != "" {
+		return name
+	}
+	return ref
+}
+
+// ErrorCode 将 errorRef 解析为错误码；未声明 <error> 时直接把引用本身当作错误码
+func (p *BPMNProcess) ErrorCode(ref string) string {
+	if code := p.errorCodes[ref]; code != "" {
+		return code
+	}
+	return ref
 }
 
 // BPMNStartEvent 开始事件
@@ -43,6 +83,10 @@ type BPMNStartEvent struct {
 	MessageRef string `xml:"messageRef,attr"`
 	TimerRef   string `xml:"timerRef,attr"`
 	SignalRef  string `xml:"signalRef,attr"`
+	// TimerDefinition 标准 timerEventDefinition 子元素（timeDuration/timeDate/timeCycle）
+	TimerDefinition   *BPMNTimerEventDefinition   `xml:"timerEventDefinition"`
+	MessageDefinition *BPMNMessageEventDefinition `xml:"messageEventDefinition"`
+	SignalDefinition  *BPMNSignalEventDefinition  `xml:"signalEventDefinition"`
 }
 
 // GetID 获取ID
@@ -62,6 +106,10 @@ type BPMNEndEvent struct {
 	MessageRef string `xml:"messageRef,attr"`
 	SignalRef  string `xml:"signalRef,attr"`
 	ErrorRef   string `xml:"errorRef,attr"`
+	// ErrorDefinition 错误结束事件：向上层作用域抛出错误
+	ErrorDefinition *BPMNErrorEventDefinition `xml:"errorEventDefinition"`
+	// CompensateDefinition 补偿结束事件：补偿已完成的活动后结束
+	CompensateDefinition *BPMNCompensateEventDefinition `xml:"compensateEventDefinition"`
 }
 
 // GetID 获取ID
@@ -91,6 +139,8 @@ type BPMNUserTask struct {
 	AllowDelegate           bool   `xml:"allowDelegate,attr"`
 	AllowAddApprover        bool   `xml:"allowAddApprover,attr"`
 	CommentRequiredOnReject bool   `xml:"commentRequiredOnReject,attr"`
+	// MultiInstance 多实例特性，非空时按集合或基数为每个实例创建一个任务
+	MultiInstance *BPMNMultiInstanceLoopCharacteristics `xml:"multiInstanceLoopCharacteristics"`
 }
 
 // GetID 获取ID
@@ -106,6 +156,7 @@ func (e *BPMNUserTask) GetType() string { return "UserTask" }
 type BPMNServiceTask struct {
 	ID                 string `xml:"id,attr"`
 	Name               string `xml:"name,attr"`
+	IsForCompensation  bool   `xml:"isForCompensation,attr"`
 	Type               string `xml:"type,attr"`
 	OperationRef       string `xml:"operationRef,attr"`
 	Implementation     string `xml:"implementation,attr"`
@@ -119,6 +170,39 @@ type BPMNServiceTask struct {
 	CCVariable         string `xml:"ccVariable,attr"`
 	CCNotify           string `xml:"ccNotify,attr"`
 	NotifyChannels     string `xml:"notifyChannels,attr"`
+	// AsyncBefore camunda:asyncBefore，为 true 时服务任务经 commandbus 异步执行
+	AsyncBefore       bool                       `xml:"asyncBefore,attr"`
+	ExtensionElements *BPMNServiceTaskExtensions `xml:"extensionElements"`
+	// MultiInstance 多实例特性，非空时对集合中的每个元素（或按基数）各执行一次
+	MultiInstance *BPMNMultiInstanceLoopCharacteristics `xml:"multiInstanceLoopCharacteristics"`
+}
+
+// BPMNMultiInstanceLoopCharacteristics 多实例循环特性
+//   - 实例数量取自 collection（变量名或 ${表达式}，元素依次写入 elementVariable），未配置时取 loopCardinality
+//   - 每个实例拥有本地变量 loopCounter（从 0 开始）与 elementVariable；实例结果按 loopCounter 写入 outputCollection
+//   - completionCondition 在每个实例完成后求值，可引用 nrOfInstances / nrOfCompletedInstances / nrOfActiveInstances
+type BPMNMultiInstanceLoopCharacteristics struct {
+	IsSequential        bool   `xml:"isSequential,attr"`
+	Collection          string `xml:"collection,attr"`
+	ElementVariable     string `xml:"elementVariable,attr"`
+	OutputCollection    string `xml:"outputCollection,attr"`
+	OutputElement       string `xml:"outputElement,attr"`
+	LoopCardinality     string `xml:"loopCardinality"`
+	CompletionCondition string `xml:"completionCondition"`
+}
+
+// BPMNServiceTaskExtensions 服务任务扩展元素
+type BPMNServiceTaskExtensions struct {
+	// FailedJobRetryTimeCycle camunda:failedJobRetryTimeCycle，异步执行的重试策略，如 R3/PT5M
+	FailedJobRetryTimeCycle string `xml:"failedJobRetryTimeCycle"`
+}
+
+// RetryTimeCycle 返回异步执行的重试策略，未配置时为空
+func (e *BPMNServiceTask) RetryTimeCycle() string {
+	if e.ExtensionElements == nil {
+		return ""
+	}
+	return strings.TrimSpace(e.ExtensionElements.FailedJobRetryTimeCycle)
 }
 
 // GetID 获取ID
@@ -136,6 +220,8 @@ type BPMNScriptTask struct {
 	Name         string `xml:"name,attr"`
 	ScriptFormat string `xml:"scriptFormat,attr"`
 	Script       string `xml:"script"`
+	// ResultVariable 脚本最后一个表达式的值写入该流程变量（camunda:resultVariable）
+	ResultVariable string `xml:"resultVariable,attr"`
 }
 
 // GetID 获取ID
@@ -194,6 +280,24 @@ func (e *BPMNInclusiveGateway) GetName() string { return e.Name }
 // GetType 获取类型
 func (e *BPMNInclusiveGateway) GetType() string { return "InclusiveGateway" }
 
+// BPMNEventBasedGateway 事件网关。出边目标须为消息/定时器/信号中间捕获事件或接收任务，
+// 最先触发的事件胜出，其余事件的订阅与定时器随之撤销
+type BPMNEventBasedGateway struct {
+	ID               string `xml:"id,attr"`
+	Name             string `xml:"name,attr"`
+	Instantiate      bool   `xml:"instantiate,attr"`
+	EventGatewayType string `xml:"eventGatewayType,attr"`
+}
+
+// GetID 获取ID
+func (e *BPMNEventBasedGateway) GetID() string { return e.ID }
+
+// GetName 获取名称
+func (e *BPMNEventBasedGateway) GetName() string { return e.Name }
+
+// GetType 获取类型
+func (e *BPMNEventBasedGateway) GetType() string { return "EventBasedGateway" }
+
 // BPMNSequenceFlow 顺序流
 type BPMNSequenceFlow struct {
 	ID                  string                   `xml:"id,attr"`
@@ -218,11 +322,12 @@ type BPMNConditionExpression struct {
 	Expression string `xml:",chardata"`
 }
 
-// BPMNSubProcess 子流程
+// BPMNSubProcess 子流程。
+// 嵌入式子流程与流程共用同一套元素结构（开始/结束事件、任务、网关、顺序流、嵌套子流程），
+// 内部元素构成独立作用域，由子流程自己的开始事件进入、结束事件离开。
 type BPMNSubProcess struct {
-	ID               string `xml:"id,attr"`
-	Name             string `xml:"name,attr"`
-	TriggeredByEvent bool   `xml:"triggeredByEvent,attr"`
+	BPMNProcess
+	TriggeredByEvent bool `xml:"triggeredByEvent,attr"`
 }
 
 // GetID 获取ID
@@ -243,6 +348,19 @@ type BPMNBusinessRuleTask struct {
 	Expression         string `xml:"expression,attr"`
 	DelegateExpression string `xml:"delegateExpression,attr"`
 	DecisionTaskRef    string `xml:"decisionTaskRef,attr"`
+	// DMN 决策引用（camunda:decisionRef 等）；binding 为 latest（默认）或 version
+	DecisionRef        string `xml:"decisionRef,attr"`
+	DecisionRefBinding string `xml:"decisionRefBinding,attr"`
+	DecisionRefVersion string `xml:"decisionRefVersion,attr"`
+	ResultVariable     string `xml:"resultVariable,attr"`
+}
+
+// DecisionKey 引用的决策Key，兼容旧的 decisionTaskRef 属性
+func (e *BPMNBusinessRuleTask) DecisionKey() string {
+	if e.DecisionRef != "" {
+		return e.DecisionRef
+	}
+	return e.DecisionTaskRef
 }
 
 // GetID 获取ID
@@ -269,6 +387,22 @@ func (e *BPMNManualTask) GetName() string { return e.Name }
 // GetType 获取类型
 func (e *BPMNManualTask) GetType() string { return "ManualTask" }
 
+// BPMNReceiveTask 接收任务：等待与 messageRef 同名的消息关联后继续
+type BPMNReceiveTask struct {
+	ID         string `xml:"id,attr"`
+	Name       string `xml:"name,attr"`
+	MessageRef string `xml:"messageRef,attr"`
+}
+
+// GetID 获取ID
+func (e *BPMNReceiveTask) GetID() string { return e.ID }
+
+// GetName 获取名称
+func (e *BPMNReceiveTask) GetName() string { return e.Name }
+
+// GetType 获取类型
+func (e *BPMNReceiveTask) GetType() string { return "ReceiveTask" }
+
 // BPMNCallActivity 调用活动
 type BPMNCallActivity struct {
 	ID                string `xml:"id,attr"`
@@ -276,6 +410,11 @@ type BPMNCallActivity struct {
 	CalledElement     string `xml:"calledElement,attr"`
 	CalledElementType string `xml:"calledElementType,attr"`
 	InheritVariables  bool   `xml:"inheritVariables,attr"`
+	// CalledElementBinding 被调用流程的版本绑定：latest（默认，最新生效版本）或 version
+	CalledElementBinding string `xml:"calledElementBinding,attr"`
+	// CalledElementVersion binding=version 时固定调用的流程定义版本
+	CalledElementVersion string                      `xml:"calledElementVersion,attr"`
+	ExtensionElements    *BPMNCallActivityExtensions `xml:"extensionElements"`
 }
 
 // GetID 获取ID
@@ -287,6 +426,37 @@ func (e *BPMNCallActivity) GetName() string { return e.Name }
 // GetType 获取类型
 func (e *BPMNCallActivity) GetType() string { return "CallActivity" }
 
+// InputMappings 父流程 → 子流程的变量映射
+func (e *BPMNCallActivity) InputMappings() []*BPMNVariableMapping {
+	if e.ExtensionElements == nil {
+		return nil
+	}
+	return e.ExtensionElements.In
+}
+
+// OutputMappings 子流程 → 父流程的变量映射
+func (e *BPMNCallActivity) OutputMappings() []*BPMNVariableMapping {
+	if e.ExtensionElements == nil {
+		return nil
+	}
+	return e.ExtensionElements.Out
+}
+
+// BPMNCallActivityExtensions 调用活动扩展元素（兼容 camunda:in / camunda:out）
+type BPMNCallActivityExtensions struct {
+	In  []*BPMNVariableMapping `xml:"in"`
+	Out []*BPMNVariableMapping `xml:"out"`
+}
+
+// BPMNVariableMapping 调用活动变量映射：source（变量名）或 sourceExpression（表达式）写入 target；
+// variables="all" 表示传递全部变量
+type BPMNVariableMapping struct {
+	Source           string `xml:"source,attr"`
+	SourceExpression string `xml:"sourceExpression,attr"`
+	Target           string `xml:"target,attr"`
+	Variables        string `xml:"variables,attr"`
+}
+
 // BPMNDataObject 数据对象
 type BPMNDataObject struct {
 	ID           string `xml:"id,attr"`
@@ -324,10 +494,27 @@ func (e *BPMNDataStore) GetType() string { return "DataStore" }
 
 // BPMNBoundaryEvent 边界事件
 type BPMNBoundaryEvent struct {
-	ID             string `xml:"id,attr"`
-	Name           string `xml:"name,attr"`
-	AttachedToRef  string `xml:"attachedToRef,attr"`
-	CancelActivity bool   `xml:"cancelActivity,attr"`
+	ID            string `xml:"id,attr"`
+	Name          string `xml:"name,attr"`
+	AttachedToRef string `xml:"attachedToRef,attr"`
+	// CancelActivity 为 nil 表示未声明，按 BPMN 规范默认中断（true）
+	CancelActivity    *bool                       `xml:"cancelActivity,attr"`
+	TimerRef          string                      `xml:"timerRef,attr"`
+	MessageRef        string                      `xml:"messageRef,attr"`
+	SignalRef         string                      `xml:"signalRef,attr"`
+	ErrorRef          string                      `xml:"errorRef,attr"`
+	TimerDefinition   *BPMNTimerEventDefinition   `xml:"timerEventDefinition"`
+	MessageDefinition *BPMNMessageEventDefinition `xml:"messageEventDefinition"`
+	SignalDefinition  *BPMNSignalEventDefinition  `xml:"signalEventDefinition"`
+	// ErrorDefinition 错误边界事件：捕获所挂载活动（及其内部）抛出的错误，总是中断型
+	ErrorDefinition *BPMNErrorEventDefinition `xml:"errorEventDefinition"`
+	// CompensateDefinition 补偿边界事件：通过关联指向所挂载活动的补偿处理活动
+	CompensateDefinition *BPMNCompensateEventDefinition `xml:"compensateEventDefinition"`
+}
+
+// IsInterrupting 是否为中断型边界事件（cancelActivity 缺省为 true）
+func (e *BPMNBoundaryEvent) IsInterrupting() bool {
+	return e.CancelActivity == nil || *e.CancelActivity
 }
 
 // GetID 获取ID
@@ -347,6 +534,10 @@ type BPMNIntermediateEvent struct {
 	MessageRef string `xml:"messageRef,attr"`
 	TimerRef   string `xml:"timerRef,attr"`
 	SignalRef  string `xml:"signalRef,attr"`
+	// TimerDefinition 标准 timerEventDefinition 子元素（timeDuration/timeDate/timeCycle）
+	TimerDefinition   *BPMNTimerEventDefinition   `xml:"timerEventDefinition"`
+	MessageDefinition *BPMNMessageEventDefinition `xml:"messageEventDefinition"`
+	SignalDefinition  *BPMNSignalEventDefinition  `xml:"signalEventDefinition"`
 }
 
 // GetID 获取ID
@@ -358,11 +549,118 @@ func (e *BPMNIntermediateEvent) GetName() string { return e.Name }
 // GetType 获取类型
 func (e *BPMNIntermediateEvent) GetType() string { return "IntermediateEvent" }
 
+// BPMNTimerEventDefinition 定时器事件定义，三者取其一（ISO-8601）
+type BPMNTimerEventDefinition struct {
+	ID           string `xml:"id,attr"`
+	TimeDuration string `xml:"timeDuration"`
+	TimeDate     string `xml:"timeDate"`
+	TimeCycle    string `xml:"timeCycle"`
+}
+
+// BPMNMessageEventDefinition 消息事件定义
+type BPMNMessageEventDefinition struct {
+	ID         string `xml:"id,attr"`
+	MessageRef string `xml:"messageRef,attr"`
+}
+
+// BPMNSignalEventDefinition 信号事件定义
+type BPMNSignalEventDefinition struct {
+	ID        string `xml:"id,attr"`
+	SignalRef string `xml:"signalRef,attr"`
+}
+
+// BPMNErrorEventDefinition 错误事件定义。
+// errorRef 为空的错误边界事件捕获任意错误；errorCodeVariable/errorMessageVariable
+// 指定捕获后写入错误码与错误信息的流程变量（兼容 camunda: 前缀）。
+type BPMNErrorEventDefinition struct {
+	ID                   string `xml:"id,attr"`
+	ErrorRef             string `xml:"errorRef,attr"`
+	ErrorCodeVariable    string `xml:"errorCodeVariable,attr"`
+	ErrorMessageVariable string `xml:"errorMessageVariable,attr"`
+}
+
+// BPMNCompensateEventDefinition 补偿事件定义；activityRef 为空时补偿作用域内全部已完成活动
+type BPMNCompensateEventDefinition struct {
+	ID          string `xml:"id,attr"`
+	ActivityRef string `xml:"activityRef,attr"`
+}
+
+// BPMNErrorDeclaration definitions 下声明的错误
+type BPMNErrorDeclaration struct {
+	ID        string `xml:"id,attr"`
+	Name      string `xml:"name,attr"`
+	ErrorCode string `xml:"errorCode,attr"`
+}
+
+// BPMNIntermediateThrowEvent 中间抛出事件
+type BPMNIntermediateThrowEvent struct {
+	ID                   string                         `xml:"id,attr"`
+	Name                 string                         `xml:"name,attr"`
+	CompensateDefinition *BPMNCompensateEventDefinition `xml:"compensateEventDefinition"`
+}
+
+// GetID 获取ID
+func (e *BPMNIntermediateThrowEvent) GetID() string { return e.ID }
+
+// GetName 获取名称
+func (e *BPMNIntermediateThrowEvent) GetName() string { return e.Name }
+
+// GetType 获取类型
+func (e *BPMNIntermediateThrowEvent) GetType() string { return "IntermediateThrowEvent" }
+
+// BPMNAssociation 关联
+type BPMNAssociation struct {
+	ID                   string `xml:"id,attr"`
+	SourceRef            string `xml:"sourceRef,attr"`
+	TargetRef            string `xml:"targetRef,attr"`
+	AssociationDirection string `xml:"associationDirection,attr"`
+}
+
+// BPMNMessage definitions 下声明的消息
+type BPMNMessage struct {
+	ID   string `xml:"id,attr"`
+	Name string `xml:"name,attr"`
+}
+
+// BPMNSignal definitions 下声明的信号
+type BPMNSignal struct {
+	ID   string `xml:"id,attr"`
+	Name string `xml:"name,attr"`
+}
+
+// eventMessageRef 属性优先，其次取 messageEventDefinition 子元素
+func eventMessageRef(attr string, def *BPMNMessageEventDefinition) string {
+	if attr != "" || def == nil {
+		return attr
+	}
+	return def.MessageRef
+}
+
+// eventErrorRef 返回事件的 errorRef 及其是否为错误事件（errorRef 属性或 errorEventDefinition 子元素）
+func eventErrorRef(attr string, def *BPMNErrorEventDefinition) (string, bool) {
+	if attr != "" || def == nil {
+		return attr, attr != ""
+	}
+	return def.ErrorRef, true
+}
+
+// eventSignalRef 属性优先，其次取 signalEventDefinition 子元素
+func eventSignalRef(attr string, def *BPMNSignalEventDefinition) string {
+	if attr != "" || def == nil {
+		return attr
+	}
+	return def.SignalRef
+}
+
 // BPMNDefinitions BPMN定义根元素
 type BPMNDefinitions struct {
 	XMLName         xml.Name       `xml:"definitions"`
 	ID              string         `xml:"id,attr"`
 	Name            string         `xml:"name,attr"`
 	TargetNamespace string         `xml:"targetNamespace,attr"`
-	Processes       []*BPMNProcess `xml:"process"`
+	Messages        []*BPMNMessage `xml:"message"`
+	Signals         []*BPMNSignal  `xml:"signal"`
+	// Errors definitions 下声明的错误（errorRef → errorCode）
+	Errors    []*BPMNErrorDeclaration `xml:"error"`
+	Processes []*BPMNProcess          `xml:"process"`
 }
@@ -29,6 +29,25 @@ func (p *BPMNParser) ParseXML(xmlData []byte) (*BPMNDefinitions, error) {
 		return nil, fmt.Errorf("BPMN验证失败: %w", err)
 	}
 
+	// 将 definitions 级的 <message>/<signal> 声明下发到各流程，供 messageRef/signalRef 解析名称
+	messageNames := make(map[string]string, len(definitions.Messages))
+	for _, message := range definitions.Messages {
+		messageNames[message.ID] = message.Name
+	}
+	signalNames := make(map[string]string, len(definitions.Signals))
+	for _, signal := range definitions.Signals {
+		signalNames[signal.ID] = signal.Name
+	}
+	errorCodes := make(map[string]string, len(definitions.Errors))
+	for _, declared := range definitions.Errors {
+		errorCodes[declared.ID] = declared.ErrorCode
+	}
+	for _, process := range definitions.Processes {
+		process.messageNames = messageNames
+		process.signalNames = signalNames
+		process.errorCodes = errorCodes
+	}
+
 	return &definitions, nil
 }
 
@@ -81,6 +100,13 @@ func (p *BPMNParser) validateProcess(process *BPMNProcess) error {
 		return fmt.Errorf("网关验证失败: %w", err)
 	}
 
+	// 嵌入式子流程是独立作用域，按同样规则递归验证
+	for _, subProcess := range process.SubProcesses {
+		if err := p.validateProcess(&subProcess.BPMNProcess); err != nil {
+			return fmt.Errorf("子流程 [%s] 验证失败: %w", subProcess.ID, err)
+		}
+	}
+
 	return nil
 }
 
@@ -131,9 +157,63 @@ func (p *BPMNParser) validateGateways(process *BPMNProcess) error {
 		}
 	}
 
+	// 验证事件网关：出边无条件、目标为可竞争的捕获事件且不被其他顺序流进入
+	for _, gateway := range process.EventBasedGateways {
+		if err := p.validateEventBasedGateway(process, gateway); err != nil {
+			return fmt.Errorf("事件网关 [%s] %w", gateway.ID, err)
+		}
+	}
+
 	return nil
 }
 
+// validateEventBasedGateway 验证事件网关的出边与目标事件
+func (p *BPMNParser) validateEventBasedGateway(process *BPMNProcess, gateway *BPMNEventBasedGateway) error {
+	if gateway.Instantiate {
+		return fmt.Errorf("暂不支持 instantiate 实例化网关")
+	}
+	incoming := make(map[string]int)
+	for _, flow := range process.SequenceFlows {
+		incoming[flow.TargetRef]++
+	}
+	outgoing := 0
+	for _, flow := range process.SequenceFlows {
+		if flow.SourceRef != gateway.ID {
+			continue
+		}
+		outgoing++
+		if flow.ConditionExpression != nil && strings.TrimSpace(flow.ConditionExpression.Expression) != "" {
+			return fmt.Errorf("的出边 [%s] 不能设置条件", flow.ID)
+		}
+		if !isEventGatewayTarget(process, flow.TargetRef) {
+			return fmt.Errorf("的出边 [%s] 目标 [%s] 必须是消息/定时器/信号中间捕获事件或接收任务", flow.ID, flow.TargetRef)
+		}
+		if incoming[flow.TargetRef] > 1 {
+			return fmt.Errorf("的目标 [%s] 不能有其他入边", flow.TargetRef)
+		}
+	}
+	if outgoing < 2 {
+		return fmt.Errorf("至少需要两条出边")
+	}
+	return nil
+}
+
+// isEventGatewayTarget 元素是否可作为事件网关的出边目标
+func isEventGatewayTarget(process *BPMNProcess, elementID string) bool {
+	for _, event := range process.IntermediateEvents {
+		if event.ID == elementID {
+			kind, _ := intermediateEventTrigger(process, event)
+			return kind != "" || event.TimerDefinition != nil || event.TimerRef != ""
+		}
+	}
+	for _, task := range process.ReceiveTasks {
+		if task.ID == elementID {
+			return true
+		}
+	}
+	return false
+}
+
 // elementExists 检查元素是否存在
 func (p *BPMNParser) elementExists(process *BPMNProcess, elementID string) bool {
 	// 检查开始事件
@@ -185,6 +265,13 @@ func (p *BPMNParser) elementExists(process *BPMNProcess, elementID string) bool
 		}
 	}
 
+	// 检查接收任务
+	for _, task := range process.ReceiveTasks {
+		if task.ID == elementID {
+			return true
+		}
+	}
+
 	// 检查调用活动
 	for _, activity := range process.CallActivities {
 		if activity.ID == elementID {
@@ -213,6 +300,13 @@ func (p *BPMNParser) elementExists(process *BPMNProcess, elementID string) bool
 		}
 	}
 
+	// 检查事件网关
+	for _, gateway := range process.EventBasedGateways {
+		if gateway.ID == elementID {
+			return true
+		}
+	}
+
 	// 检查子流程
 	for _, subProcess := range process.SubProcesses {
 		if subProcess.ID == elementID {
@@ -234,6 +328,13 @@ func (p *BPMNParser) elementExists(process *BPMNProcess, elementID string) bool
 		}
 	}
 
+	// 检查中间抛出事件
+	for _, event := range process.IntermediateThrowEvents {
+		if event.ID == elementID {
+			return true
+		}
+	}
+
 	// 检查数据对象
 	for _, dataObj := range process.DataObjects {
 		if dataObj.ID == elementID {
@@ -273,10 +374,12 @@ func (p *BPMNParser) ExtractProcessInfo(definitions *BPMNDefinitions) map[string
 		"scriptTasks":        len(process.ScriptTasks),
 		"businessRuleTasks":  len(process.BusinessRuleTasks),
 		"manualTasks":        len(process.ManualTasks),
+		"receiveTasks":       len(process.ReceiveTasks),
 		"callActivities":     len(process.CallActivities),
 		"exclusiveGateways":  len(process.ExclusiveGateways),
 		"parallelGateways":   len(process.ParallelGateways),
 		"inclusiveGateways":  len(process.InclusiveGateways),
+		"eventBasedGateways": len(process.EventBasedGateways),
 		"sequenceFlows":      len(process.SequenceFlows),
 		"subProcesses":       len(process.SubProcesses),
 		"boundaryEvents":     len(process.BoundaryEvents),
@@ -455,9 +558,10 @@ func (p *BPMNParser) ExtractDataStores(definitions *BPMNDefinitions) []map[strin
 // ExtractGateways 提取所有网关
 func (p *BPMNParser) ExtractGateways(definitions *BPMNDefinitions) map[string][]map[string]interface{} {
 	gateways := map[string][]map[string]interface{}{
-		"exclusive": make([]map[string]interface{}, 0),
-		"parallel":  make([]map[string]interface{}, 0),
-		"inclusive": make([]map[string]interface{}, 0),
+		"exclusive":  make([]map[string]interface{}, 0),
+		"parallel":   make([]map[string]interface{}, 0),
+		"inclusive":  make([]map[string]interface{}, 0),
+		"eventBased": make([]map[string]interface{}, 0),
 	}
 
 	for _, process := range definitions.Processes {
@@ -492,6 +596,16 @@ func (p *BPMNParser) ExtractGateways(definitions *BPMNDefinitions) map[string][]
 			}
 			gateways["inclusive"] = append(gateways["inclusive"], gatewayInfo)
 		}
+
+		// 事件网关
+		for _, gateway := range process.EventBasedGateways {
+			gatewayInfo := map[string]interface{}{
+				"id":        gateway.ID,
+				"name":      gateway.Name,
+				"processId": process.ID,
+			}
+			gateways["eventBased"] = append(gateways["eventBased"], gatewayInfo)
+		}
 	}
 
 	return gateways
@@ -565,6 +679,7 @@ func (p *BPMNParser) GenerateBPMNSummary(definitions *BPMNDefinitions) map[strin
 				"exclusiveGateways":  len(process.ExclusiveGateways),
 				"parallelGateways":   len(process.ParallelGateways),
 				"inclusiveGateways":  len(process.InclusiveGateways),
+				"eventBasedGateways": len(process.EventBasedGateways),
 				"sequenceFlows":      len(process.SequenceFlows),
 				"subProcesses":       len(process.SubProcesses),
 				"boundaryEvents":     len(process.BoundaryEvents),
@@ -615,33 +730,35 @@ func (p *BPMNParser) ConvertToProcessDefinition(definitions *BPMNDefinitions, te
 func (p *BPMNParser) countTotalElements(process *BPMNProcess) int {
 	return len(process.StartEvents) + len(process.EndEvents) +
 		len(process.UserTasks) + len(process.ServiceTasks) + len(process.ScriptTasks) +
-		len(process.BusinessRuleTasks) + len(process.ManualTasks) + len(process.CallActivities) +
-		len(process.ExclusiveGateways) + len(process.ParallelGateways) + len(process.InclusiveGateways) +
+		len(process.BusinessRuleTasks) + len(process.ManualTasks) + len(process.ReceiveTasks) + len(process.CallActivities) +
+		len(process.ExclusiveGateways) + len(process.ParallelGateways) + len(process.InclusiveGateways) + len(process.EventBasedGateways) +
 		len(process.SequenceFlows) + len(process.SubProcesses) +
-		len(process.BoundaryEvents) + len(process.IntermediateEvents) +
+		len(process.BoundaryEvents) + len(process.IntermediateEvents) + len(process.IntermediateThrowEvents) +
 		len(process.DataObjects) + len(process.DataStores)
 }
 
 // getElementTypeCounts 获取各类型元素的数量统计
 func (p *BPMNParser) getElementTypeCounts(process *BPMNProcess) map[string]int {
 	return map[string]int{
-		"startEvents":        len(process.StartEvents),
-		"endEvents":          len(process.EndEvents),
-		"userTasks":          len(process.UserTasks),
-		"serviceTasks":       len(process.ServiceTasks),
-		"scriptTasks":        len(process.ScriptTasks),
-		"businessRuleTasks":  len(process.BusinessRuleTasks),
-		"manualTasks":        len(process.ManualTasks),
-		"callActivities":     len(process.CallActivities),
-		"exclusiveGateways":  len(process.ExclusiveGateways),
-		"parallelGateways":   len(process.ParallelGateways),
-		"inclusiveGateways":  len(process.InclusiveGateways),
-		"sequenceFlows":      len(process.SequenceFlows),
-		"subProcesses":       len(process.SubProcesses),
-		"boundaryEvents":     len(process.BoundaryEvents),
-		"intermediateEvents": len(process.IntermediateEvents),
-		"dataObjects":        len(process.DataObjects),
-		"dataStores":         len(process.DataStores),
+		"startEvents":             len(process.StartEvents),
+		"endEvents":               len(process.EndEvents),
+		"userTasks":               len(process.UserTasks),
+		"serviceTasks":            len(process.ServiceTasks),
+		"scriptTasks":             len(process.ScriptTasks),
+		"businessRuleTasks":       len(process.BusinessRuleTasks),
+		"manualTasks":             len(process.ManualTasks),
+		"callActivities":          len(process.CallActivities),
+		"exclusiveGateways":       len(process.ExclusiveGateways),
+		"parallelGateways":        len(process.ParallelGateways),
+		"inclusiveGateways":       len(process.InclusiveGateways),
+		"eventBasedGateways":      len(process.EventBasedGateways),
+		"sequenceFlows":           len(process.SequenceFlows),
+		"subProcesses":            len(process.SubProcesses),
+		"boundaryEvents":          len(process.BoundaryEvents),
+		"intermediateEvents":      len(process.IntermediateEvents),
+		"intermediateThrowEvents": len(process.IntermediateThrowEvents),
+		"dataObjects":             len(process.DataObjects),
+		"dataStores":              len(process.DataStores),
 	}
 }
 
@@ -796,6 +913,9 @@ func (p *BPMNParser) getAllElementIDs(process *BPMNProcess) []string {
 	for _, task := range process.ManualTasks {
 		ids = append(ids, task.ID)
 	}
+	for _, task := range process.ReceiveTasks {
+		ids = append(ids, task.ID)
+	}
 	for _, activity := range process.CallActivities {
 		ids = append(ids, activity.ID)
 	}
@@ -808,6 +928,9 @@ func (p *BPMNParser) getAllElementIDs(process *BPMNProcess) []string {
 	for _, gateway := range process.InclusiveGateways {
 		ids = append(ids, gateway.ID)
 	}
+	for _, gateway := range process.EventBasedGateways {
+		ids = append(ids, gateway.ID)
+	}
 	for _, subProcess := range process.SubProcesses {
 		ids = append(ids, subProcess.ID)
 	}
@@ -817,6 +940,9 @@ func (p *BPMNParser) getAllElementIDs(process *BPMNProcess) []string {
 	for _, event := range process.IntermediateEvents {
 		ids = append(ids, event.ID)
 	}
+	for _, event := range process.IntermediateThrowEvents {
+		ids = append(ids, event.ID)
+	}
 	for _, dataObj := range process.DataObjects {
 		ids = append(ids, dataObj.ID)
 	}
@@ -1097,7 +1223,7 @@ func (p *BPMNParser) GetBPMNElementMetrics(definitions *BPMNDefinitions) map[str
 			"startEvents":        len(process.StartEvents),
 			"endEvents":          len(process.EndEvents),
 			"totalTasks":         len(process.UserTasks) + len(process.ServiceTasks) + len(process.ScriptTasks) + len(process.BusinessRuleTasks) + len(process.ManualTasks),
-			"totalGateways":      len(process.ExclusiveGateways) + len(process.ParallelGateways) + len(process.InclusiveGateways),
+			"totalGateways":      len(process.ExclusiveGateways) + len(process.ParallelGateways) + len(process.InclusiveGateways) + len(process.EventBasedGateways),
 			"totalFlows":         len(process.SequenceFlows),
 			"subProcesses":       len(process.SubProcesses),
 			"boundaryEvents":     len(process.BoundaryEvents),
@@ -1116,7 +1242,7 @@ func (p *BPMNParser) GetBPMNElementMetrics(definitions *BPMNDefinitions) map[str
 		}
 
 		// 计算网关密度
-		gatewayCount := len(process.ExclusiveGateways) + len(process.ParallelGateways) + len(process.InclusiveGateways)
+		gatewayCount := len(process.ExclusiveGateways) + len(process.ParallelGateways) + len(process.InclusiveGateways) + len(process.EventBasedGateways)
 		if elementCount > 0 {
 			processMetrics["gatewayDensity"] = float64(gatewayCount) / float64(elementCount)
 		} else {
@@ -0,0 +1,488 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"itsm-backend/ent"
+	"itsm-backend/ent/schema"
+)
+
+// 工作日历计算
+//
+// 工单 SLA、SLA 策略与 BPMN 流程 SLA 统一通过 BusinessCalendarCalculator 计算截止时间：
+// 每个星期几可配置多个工作时段（分段班次），结束时间不晚于开始时间的时段跨越零点（夜班），
+// 夜班时段归属其开始的那一天；节假日整天不计时，调休上班日按周一的时段计时（周一无时段时取最早有时段的一天）。
+
+const (
+	calendarDateLayout = "2006-01-02"
+	// maxCalendarScanDays 计算时最多向后扫描的天数，避免配置异常（如全年节假日）导致死循环
+	maxCalendarScanDays = 3660
+)
+
+// calendarWindow 一天内的工作时段，单位为距零点的分钟数；end 超过 1440 表示跨零点
+type calendarWindow struct {
+	start int
+	end   int
+}
+
+// businessInterval 具体日期上的一段工作时间 [start, end)
+type businessInterval struct {
+	start time.Time
+	end   time.Time
+}
+
+// BusinessCalendarCalculator 工作日历计算器
+type BusinessCalendarCalculator struct {
+	loc      *time.Location // nil 表示按输入时刻自身的时区计算
+	windows  [7][]calendarWindow
+	holidays map[string]bool
+	workdays map[string]bool // 调休上班日
+}
+
+// NewBusinessCalendarCalculator 由日历配置构建计算器
+func NewBusinessCalendarCalculator(timeZone string, windows []schema.CalendarWorkWindow, holidays, extraWorkdays []schema.CalendarDay) (*BusinessCalendarCalculator, error) {
+	c := &BusinessCalendarCalculator{holidays: map[string]bool{}, workdays: map[string]bool{}}
+	if timeZone != "" {
+		loc, err := time.LoadLocation(timeZone)
+		if err != nil {
+			return nil, fmt.Errorf("无效的时区 %q: %w", timeZone, err)
+		}
+		c.loc = loc
+	}
+	for _, w := range windows {
+		if w.Weekday < 1 || w.Weekday > 7 {
+			return nil, fmt.Errorf("无效的星期 %d，应为 1-7", w.Weekday)
+		}
+		start, ok := parseCalendarClock(w.Start, false)
+		if !ok {
+			return nil, fmt.Errorf("无效的开始时间 %q", w.Start)
+		}
+		end, ok := parseCalendarClock(w.End, true)
+		if !ok {
+			return nil, fmt.Errorf("无效的结束时间 %q", w.End)
+		}
+		if end <= start {
+			end += 24 * 60
+		}
+		weekday := time.Weekday(w.Weekday % 7)
+		c.windows[weekday] = append(c.windows[weekday], calendarWindow{start: start, end: end})
+	}
+	for weekday := range c.windows {
+		day := c.windows[weekday]
+		sort.Slice(day, func(i, j int) bool { return day[i].start < day[j].start })
+		for i := 1; i < len(day); i++ {
+			if day[i].start < day[i-1].end {
+				return nil, fmt.Errorf("%s 的工作时段重叠", time.Weekday(weekday))
+			}
+		}
+	}
+	for _, d := range holidays {
+		key, err := normalizeCalendarDate(d.Date)
+		if err != nil {
+			return nil, err
+		}
+		c.holidays[key] = true
+	}
+	for _, d := range extraWorkdays {
+		key, err := normalizeCalendarDate(d.Date)
+		if err != nil {
+			return nil, err
+		}
+		c.workdays[key] = true
+	}
+	return c, nil
+}
+
+// NewBusinessCalendarCalculatorFromEntity 由日历实体构建计算器
+func NewBusinessCalendarCalculatorFromEntity(cal *ent.BusinessCalendar) (*BusinessCalendarCalculator, error) {
+	c, err := NewBusinessCalendarCalculator(cal.TimeZone, cal.WorkWindows, cal.Holidays, cal.ExtraWorkdays)
+	if err != nil {
+		return nil, fmt.Errorf("工作日历 [%s] 配置无效: %w", cal.Name, err)
+	}
+	return c, nil
+}
+
+// DefaultBusinessCalendarCalculator 内置默认日历：周一至周五 9:00-18:00，按输入时刻的时区计算
+func DefaultBusinessCalendarCalculator() *BusinessCalendarCalculator {
+	return newWeeklyCalendarCalculator(nil, weekdayNumbers(1, 5), 9*60, 18*60, nil)
+}
+
+// newWeeklyCalendarCalculator 每个工作日使用同一时段的日历，用于兼容旧的营业时间配置
+func newWeeklyCalendarCalculator(loc *time.Location, workDays []time.Weekday, start, end int, holidays map[string]bool) *BusinessCalendarCalculator {
+	c := &BusinessCalendarCalculator{loc: loc, holidays: map[string]bool{}, workdays: map[string]bool{}}
+	for _, weekday := range workDays {
+		c.windows[weekday] = []calendarWindow{{start: start, end: end}}
+	}
+	for day := range holidays {
+		c.holidays[day] = true
+	}
+	return c
+}
+
+// weekdayNumbers 把 1-7 (周一到周日) 的区间转换为 time.Weekday
+func weekdayNumbers(from, to int) []time.Weekday {
+	var days []time.Weekday
+	for n := from; n <= to; n++ {
+		days = append(days, time.Weekday(n%7))
+	}
+	return days
+}
+
+// withHolidays 合并额外的节假日与调休上班日
+func (c *BusinessCalendarCalculator) withHolidays(holidays, workdays map[string]bool) *BusinessCalendarCalculator {
+	for day := range holidays {
+		c.holidays[day] = true
+	}
+	for day := range workdays {
+		c.workdays[day] = true
+	}
+	return c
+}
+
+// hasWindows 日历是否配置了任何工作时段；没有时段的日历按 7x24 计时
+func (c *BusinessCalendarCalculator) hasWindows() bool {
+	for _, day := range c.windows {
+		if len(day) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// makeUpWindows 调休上班日使用的时段
+func (c *BusinessCalendarCalculator) makeUpWindows() []calendarWindow {
+	for _, weekday := range weekdayNumbers(1, 7) {
+		if len(c.windows[weekday]) > 0 {
+			return c.windows[weekday]
+		}
+	}
+	return nil
+}
+
+func (c *BusinessCalendarCalculator) localize(t time.Time) time.Time {
+	if c.loc != nil {
+		return t.In(c.loc)
+	}
+	return t
+}
+
+// IsHoliday 判断 t 所在日期（按日历时区）是否为节假日
+func (c *BusinessCalendarCalculator) IsHoliday(t time.Time) bool {
+	return c.holidays[c.localize(t).Format(calendarDateLayout)]
+}
+
+// IsBusinessDay 判断 t 所在日期（按日历时区）是否为工作日：非节假日且当天有工作时段，调休上班日视为工作日
+func (c *BusinessCalendarCalculator) IsBusinessDay(t time.Time) bool {
+	local := c.localize(t)
+	if !c.hasWindows() {
+		return !c.holidays[local.Format(calendarDateLayout)]
+	}
+	y, m, d := local.Date()
+	return len(c.dayIntervals(time.Date(y, m, d, 0, 0, 0, 0, local.Location()))) > 0
+}
+
+// dayIntervals 返回某一天开始的工作时段
+func (c *BusinessCalendarCalculator) dayIntervals(day time.Time) []businessInterval {
+	key := day.Format(calendarDateLayout)
+	windows := c.windows[day.Weekday()]
+	if c.holidays[key] {
+		return nil
+	}
+	if c.workdays[key] {
+		windows = c.makeUpWindows()
+	}
+	y, m, d := day.Date()
+	intervals := make([]businessInterval, 0, len(windows))
+	for _, w := range windows {
+		intervals = append(intervals, businessInterval{
+			start: time.Date(y, m, d, 0, w.start, 0, 0, day.Location()),
+			end:   time.Date(y, m, d, 0, w.end, 0, 0, day.Location()),
+		})
+	}
+	return intervals
+}
+
+// walk 按时间顺序遍历 from 之后（含跨入 from 的夜班）的工作时段，fn 返回 false 时停止
+func (c *BusinessCalendarCalculator) walk(from time.Time, fn func(businessInterval) bool) {
+	from = c.localize(from)
+	y, m, d := from.Date()
+	// 从前一天开始，覆盖跨零点进入 from 当天的夜班
+	day := time.Date(y, m, d-1, 0, 0, 0, 0, from.Location())
+	for i := 0; i < maxCalendarScanDays; i++ {
+		for _, interval := range c.dayIntervals(day) {
+			if !interval.end.After(from) {
+				continue
+			}
+			if !fn(interval) {
+				return
+			}
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+}
+
+// AddBusinessMinutes 从 start 开始只在工作时段内消耗 minutes 分钟，返回截止时刻
+func (c *BusinessCalendarCalculator) AddBusinessMinutes(start time.Time, minutes int) time.Time {
+	if minutes <= 0 {
+		return start
+	}
+	remaining := time.Duration(minutes) * time.Minute
+	if !c.hasWindows() {
+		return start.Add(remaining)
+	}
+	cursor := start
+	deadline := time.Time{}
+	c.walk(start, func(interval businessInterval) bool {
+		from := interval.start
+		if from.Before(cursor) {
+			// 时段重叠（如夜班延续到次日早班）时不重复计时
+			from = cursor
+		}
+		if !interval.end.After(from) {
+			return true
+		}
+		available := interval.end.Sub(from)
+		if remaining <= available {
+			deadline = from.Add(remaining)
+			return false
+		}
+		remaining -= available
+		cursor = interval.end
+		return true
+	})
+	if deadline.IsZero() {
+		deadline = cursor
+	}
+	return deadline.In(start.Location())
+}
+
+// NextBusinessTime 把 t 对齐到最近的工作时刻：t 在工作时段内时原样返回，否则返回下一个时段的开始
+func (c *BusinessCalendarCalculator) NextBusinessTime(t time.Time) time.Time {
+	if !c.hasWindows() {
+		return t
+	}
+	next := t
+	c.walk(t, func(interval businessInterval) bool {
+		if interval.start.After(t) {
+			next = interval.start.In(t.Location())
+		}
+		return false
+	})
+	return next
+}
+
+// IsBusinessTime 判断 t 是否处于工作时段
+func (c *BusinessCalendarCalculator) IsBusinessTime(t time.Time) bool {
+	return c.NextBusinessTime(t).Equal(t)
+}
+
+// BusinessMinutesBetween 计算 [from, to) 之间的工作分钟数
+func (c *BusinessCalendarCalculator) BusinessMinutesBetween(from, to time.Time) int {
+	if !to.After(from) {
+		return 0
+	}
+	if !c.hasWindows() {
+		return int(to.Sub(from).Minutes())
+	}
+	var total time.Duration
+	cursor := from
+	c.walk(from, func(interval businessInterval) bool {
+		if !interval.start.Before(to) {
+			return false
+		}
+		start, end := interval.start, interval.end
+		if start.Before(cursor) {
+			start = cursor
+		}
+		if end.After(to) {
+			end = to
+		}
+		if end.After(start) {
+			total += end.Sub(start)
+			cursor = end
+		}
+		return true
+	})
+	return int(total.Minutes())
+}
+
+// parseCalendarClock 解析 "HH:MM"；allowMidnight 为 true 时接受 "24:00"
+func parseCalendarClock(value string, allowMidnight bool) (int, bool) {
+	parts := strings.Split(strings.TrimSpace(value), ":")
+	if len(parts) != 2 {
+		return 0, false
+	}
+	hour, errHour := strconv.Atoi(parts[0])
+	minute, errMinute := strconv.Atoi(parts[1])
+	if errHour != nil || errMinute != nil || minute < 0 || minute > 59 || hour < 0 {
+		return 0, false
+	}
+	if allowMidnight && hour == 24 && minute == 0 {
+		return 24 * 60, true
+	}
+	if hour > 23 {
+		return 0, false
+	}
+	return hour*60 + minute, true
+}
+
+// normalizeCalendarDate 校验并规范化日期为 "2006-01-02"
+func normalizeCalendarDate(value string) (string, error) {
+	day, err := time.Parse(calendarDateLayout, strings.TrimSpace(value))
+	if err != nil {
+		return "", fmt.Errorf("无效的日期 %q，应为 YYYY-MM-DD", value)
+	}
+	return day.Format(calendarDateLayout), nil
+}
+
+// ==================== iCalendar 导入 ====================
+
+var (
+	icsDurationDays = regexp.MustCompile(`^P(?:(\d+)W)?(?:(\d+)D)?`)
+	// icsWorkdayMarkers 节假日订阅源中表示调休上班的标题关键字
+	icsWorkdayMarkers = []string{"补班", "上班", "调班", "(班)", "（班）"}
+)
+
+// ParseICSCalendarDays 解析 iCalendar (.ics) 中的全天事件：
+// 标题含"补班""上班"等关键字的事件作为调休上班日，其余作为节假日；多日事件按天展开（DTEND 不含）。
+func ParseICSCalendarDays(data []byte) (holidays, workdays []schema.CalendarDay, err error) {
+	var (
+		inEvent bool
+		event   map[string]icsProperty
+		found   bool
+	)
+	for _, line := range unfoldICSLines(data) {
+		name, prop := parseICSProperty(line)
+		switch {
+		case name == "BEGIN" && strings.EqualFold(prop.value, "VCALENDAR"):
+			found = true
+		case name == "BEGIN" && strings.EqualFold(prop.value, "VEVENT"):
+			inEvent, event = true, map[string]icsProperty{}
+		case name == "END" && strings.EqualFold(prop.value, "VEVENT"):
+			inEvent = false
+			days, err := icsEventDays(event)
+			if err != nil {
+				return nil, nil, err
+			}
+			if len(days) == 0 {
+				continue
+			}
+			if isICSWorkday(days[0].Name) {
+				workdays = append(workdays, days...)
+			} else {
+				holidays = append(holidays, days...)
+			}
+		case inEvent && name != "":
+			if _, exists := event[name]; !exists {
+				event[name] = prop
+			}
+		}
+	}
+	if !found {
+		return nil, nil, fmt.Errorf("不是有效的 iCalendar 文件")
+	}
+	return holidays, workdays, nil
+}
+
+type icsProperty struct {
+	params string
+	value  string
+}
+
+// unfoldICSLines 按 RFC 5545 展开折行（以空格或制表符开头的行是上一行的延续）
+func unfoldICSLines(data []byte) []string {
+	var lines []string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// parseICSProperty 解析 "NAME;PARAM=...:VALUE"
+func parseICSProperty(line string) (string, icsProperty) {
+	colon := strings.Index(line, ":")
+	if colon < 0 {
+		return "", icsProperty{}
+	}
+	head, value := line[:colon], line[colon+1:]
+	name, params, _ := strings.Cut(head, ";")
+	return strings.ToUpper(strings.TrimSpace(name)), icsProperty{params: params, value: strings.TrimSpace(value)}
+}
+
+// icsEventDays 把事件展开为逐日的日历日期
+func icsEventDays(event map[string]icsProperty) ([]schema.CalendarDay, error) {
+	if strings.EqualFold(event["STATUS"].value, "CANCELLED") {
+		return nil, nil
+	}
+	dtstart, ok := event["DTSTART"]
+	if !ok {
+		return nil, nil
+	}
+	start, err := parseICSDate(dtstart.value)
+	if err != nil {
+		return nil, err
+	}
+	end := start.AddDate(0, 0, 1)
+	if dtend, ok := event["DTEND"]; ok {
+		if end, err = parseICSDate(dtend.value); err != nil {
+			return nil, err
+		}
+		if !strings.Contains(dtend.params, "VALUE=DATE") && strings.Contains(dtend.value, "T") {
+			// 带时刻的结束时间包含当天
+			end = end.AddDate(0, 0, 1)
+		}
+	} else if duration, ok := event["DURATION"]; ok {
+		if m := icsDurationDays.FindStringSubmatch(duration.value); m != nil {
+			weeks, _ := strconv.Atoi(m[1])
+			days, _ := strconv.Atoi(m[2])
+			if weeks*7+days > 0 {
+				end = start.AddDate(0, 0, weeks*7+days)
+			}
+		}
+	}
+	name := unescapeICSText(event["SUMMARY"].value)
+	var days []schema.CalendarDay
+	for day := start; day.Before(end) && len(days) < 366; day = day.AddDate(0, 0, 1) {
+		days = append(days, schema.CalendarDay{Date: day.Format(calendarDateLayout), Name: name})
+	}
+	return days, nil
+}
+
+// parseICSDate 取 DATE 或 DATE-TIME 值的日期部分
+func parseICSDate(value string) (time.Time, error) {
+	if len(value) < 8 {
+		return time.Time{}, fmt.Errorf("无效的 iCalendar 日期 %q", value)
+	}
+	day, err := time.Parse("20060102", value[:8])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("无效的 iCalendar 日期 %q", value)
+	}
+	return day, nil
+}
+
+func unescapeICSText(value string) string {
+	return strings.NewReplacer(`\n`, " ", `\N`, " ", `\,`, ",", `\;`, ";", `\\`, `\`).Replace(value)
+}
+
+func isICSWorkday(summary string) bool {
+	for _, marker := range icsWorkdayMarkers {
+		if strings.Contains(summary, marker) {
+			return true
+		}
+	}
+	return false
+}
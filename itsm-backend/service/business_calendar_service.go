@@ -0,0 +1,327 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"itsm-backend/dto"
+	"itsm-backend/ent"
+	"itsm-backend/ent/businesscalendar"
+	"itsm-backend/ent/schema"
+	"itsm-backend/ent/sladefinition"
+	"itsm-backend/ent/slapolicy"
+	"itsm-backend/ent/team"
+	"itsm-backend/ent/ticketschedule"
+)
+
+// BusinessCalendarService 工作日历服务
+type BusinessCalendarService struct {
+	client *ent.Client
+}
+
+// NewBusinessCalendarService 创建工作日历服务
+func NewBusinessCalendarService(client *ent.Client) *BusinessCalendarService {
+	return &BusinessCalendarService{client: client}
+}
+
+// CreateCalendar 创建工作日历
+func (s *BusinessCalendarService) CreateCalendar(ctx context.Context, req *dto.CreateBusinessCalendarRequest) (*ent.BusinessCalendar, error) {
+	timeZone := req.TimeZone
+	if timeZone == "" {
+		timeZone = "Asia/Shanghai"
+	}
+	if err := validateBusinessCalendar(timeZone, req.WorkWindows, req.Holidays, req.ExtraWorkdays); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+	if req.IsDefault {
+		if err := clearDefaultCalendar(ctx, tx.Client(), req.TenantID); err != nil {
+			return nil, fmt.Errorf("取消原默认日历失败: %w", err)
+		}
+	}
+	created, err := tx.BusinessCalendar.Create().
+		SetTenantID(req.TenantID).
+		SetName(req.Name).
+		SetDescription(req.Description).
+		SetTimeZone(timeZone).
+		SetWorkWindows(req.WorkWindows).
+		SetHolidays(normalizeCalendarDays(req.Holidays)).
+		SetExtraWorkdays(normalizeCalendarDays(req.ExtraWorkdays)).
+		SetIsDefault(req.IsDefault).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("创建工作日历失败: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("提交事务失败: %w", err)
+	}
+	return created, nil
+}
+
+// UpdateCalendar 更新工作日历
+func (s *BusinessCalendarService) UpdateCalendar(ctx context.Context, tenantID, id int, req *dto.UpdateBusinessCalendarRequest) (*ent.BusinessCalendar, error) {
+	existing, err := s.GetCalendar(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	timeZone, windows, holidays, workdays := existing.TimeZone, existing.WorkWindows, existing.Holidays, existing.ExtraWorkdays
+	if req.TimeZone != nil {
+		timeZone = *req.TimeZone
+	}
+	if req.WorkWindows != nil {
+		windows = *req.WorkWindows
+	}
+	if req.Holidays != nil {
+		holidays = *req.Holidays
+	}
+	if req.ExtraWorkdays != nil {
+		workdays = *req.ExtraWorkdays
+	}
+	if err := validateBusinessCalendar(timeZone, windows, holidays, workdays); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+	update := tx.BusinessCalendar.UpdateOne(existing).
+		SetTimeZone(timeZone).
+		SetWorkWindows(windows).
+		SetHolidays(normalizeCalendarDays(holidays)).
+		SetExtraWorkdays(normalizeCalendarDays(workdays))
+	if req.Name != nil {
+		update.SetName(*req.Name)
+	}
+	if req.Description != nil {
+		update.SetDescription(*req.Description)
+	}
+	if req.IsDefault != nil {
+		if *req.IsDefault && !existing.IsDefault {
+			if err := clearDefaultCalendar(ctx, tx.Client(), tenantID); err != nil {
+				return nil, fmt.Errorf("取消原默认日历失败: %w", err)
+			}
+		}
+		update.SetIsDefault(*req.IsDefault)
+	}
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("更新工作日历失败: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("提交事务失败: %w", err)
+	}
+	return updated, nil
+}
+
+// GetCalendar 获取工作日历
+func (s *BusinessCalendarService) GetCalendar(ctx context.Context, tenantID, id int) (*ent.BusinessCalendar, error) {
+	return s.client.BusinessCalendar.Query().
+		Where(businesscalendar.ID(id), businesscalendar.TenantID(tenantID)).
+		Only(ctx)
+}
+
+// ListCalendars 获取租户的工作日历
+func (s *BusinessCalendarService) ListCalendars(ctx context.Context, tenantID int) ([]*ent.BusinessCalendar, error) {
+	return s.client.BusinessCalendar.Query().
+		Where(businesscalendar.TenantID(tenantID)).
+		Order(ent.Asc(businesscalendar.FieldID)).
+		All(ctx)
+}
+
+// DeleteCalendar 删除工作日历，仍被 SLA 策略、SLA 定义、团队或周期工单计划引用时拒绝删除
+func (s *BusinessCalendarService) DeleteCalendar(ctx context.Context, tenantID, id int) error {
+	if _, err := s.GetCalendar(ctx, tenantID, id); err != nil {
+		return err
+	}
+	references := []func() (bool, error){
+		func() (bool, error) {
+			return s.client.SLAPolicy.Query().Where(slapolicy.TenantID(tenantID), slapolicy.CalendarID(id)).Exist(ctx)
+		},
+		func() (bool, error) {
+			return s.client.SLADefinition.Query().Where(sladefinition.TenantID(tenantID), sladefinition.CalendarID(id)).Exist(ctx)
+		},
+		func() (bool, error) {
+			return s.client.Team.Query().Where(team.TenantID(tenantID), team.CalendarID(id), team.DeletedAtIsNil()).Exist(ctx)
+		},
+	}
+	for _, referenced := range references {
+		exists, err := referenced()
+		if err != nil {
+			return fmt.Errorf("检查工作日历引用失败: %w", err)
+		}
+		if exists {
+			return fmt.Errorf("工作日历仍被 SLA 或团队引用，无法删除")
+		}
+	}
+	scheduled, err := s.client.TicketSchedule.Query().Where(ticketschedule.TenantID(tenantID), ticketschedule.CalendarID(id)).Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("检查工作日历引用失败: %w", err)
+	}
+	if scheduled {
+		return fmt.Errorf("工作日历仍被周期工单计划引用，无法删除")
+	}
+	return s.client.BusinessCalendar.DeleteOneID(id).Exec(ctx)
+}
+
+// ImportICS 从 iCalendar 文件导入节假日与调休上班日，与已有日期合并，同一日期以导入内容为准
+func (s *BusinessCalendarService) ImportICS(ctx context.Context, tenantID, id int, data []byte) (*dto.BusinessCalendarImportResult, error) {
+	cal, err := s.GetCalendar(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	holidays, workdays, err := ParseICSCalendarDays(data)
+	if err != nil {
+		return nil, fmt.Errorf("解析 iCalendar 失败: %w", err)
+	}
+
+	imported := map[string]bool{}
+	for _, day := range append(append([]schema.CalendarDay{}, holidays...), workdays...) {
+		imported[day.Date] = true
+	}
+	keep := func(days []schema.CalendarDay) []schema.CalendarDay {
+		var kept []schema.CalendarDay
+		for _, day := range days {
+			if !imported[day.Date] {
+				kept = append(kept, day)
+			}
+		}
+		return kept
+	}
+	_, err = cal.Update().
+		SetHolidays(normalizeCalendarDays(append(keep(cal.Holidays), holidays...))).
+		SetExtraWorkdays(normalizeCalendarDays(append(keep(cal.ExtraWorkdays), workdays...))).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("保存导入的节假日失败: %w", err)
+	}
+	return &dto.BusinessCalendarImportResult{
+		HolidaysImported:      len(holidays),
+		ExtraWorkdaysImported: len(workdays),
+	}, nil
+}
+
+// AssignCalendar 为 SLA 策略、SLA 定义或团队指定工作日历
+func (s *BusinessCalendarService) AssignCalendar(ctx context.Context, tenantID int, req *dto.AssignBusinessCalendarRequest) error {
+	if req.CalendarID != nil {
+		if _, err := s.GetCalendar(ctx, tenantID, *req.CalendarID); err != nil {
+			return fmt.Errorf("工作日历不存在: %w", err)
+		}
+	}
+	var affected int
+	var err error
+	switch req.TargetType {
+	case "sla_policy":
+		update := s.client.SLAPolicy.Update().Where(slapolicy.ID(req.TargetID), slapolicy.TenantID(tenantID))
+		if req.CalendarID != nil {
+			update.SetCalendarID(*req.CalendarID)
+		} else {
+			update.ClearCalendarID()
+		}
+		affected, err = update.Save(ctx)
+	case "sla_definition":
+		update := s.client.SLADefinition.Update().Where(sladefinition.ID(req.TargetID), sladefinition.TenantID(tenantID))
+		if req.CalendarID != nil {
+			update.SetCalendarID(*req.CalendarID)
+		} else {
+			update.ClearCalendarID()
+		}
+		affected, err = update.Save(ctx)
+	case "team":
+		update := s.client.Team.Update().Where(team.ID(req.TargetID), team.TenantID(tenantID), team.DeletedAtIsNil())
+		if req.CalendarID != nil {
+			update.SetCalendarID(*req.CalendarID)
+		} else {
+			update.ClearCalendarID()
+		}
+		affected, err = update.Save(ctx)
+	default:
+		return fmt.Errorf("不支持的日历指定对象: %s", req.TargetType)
+	}
+	if err != nil {
+		return fmt.Errorf("指定工作日历失败: %w", err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s 不存在或不属于当前租户: id=%d", req.TargetType, req.TargetID)
+	}
+	return nil
+}
+
+// Calculator 加载指定日历的计算器
+func (s *BusinessCalendarService) Calculator(ctx context.Context, tenantID, id int) (*BusinessCalendarCalculator, error) {
+	cal, err := s.GetCalendar(ctx, tenantID, id)
+	if err != nil {
+		return nil, fmt.Errorf("获取工作日历失败: %w", err)
+	}
+	return NewBusinessCalendarCalculatorFromEntity(cal)
+}
+
+// DefaultCalculator 加载租户默认日历的计算器，租户未设置默认日历时返回 nil
+func (s *BusinessCalendarService) DefaultCalculator(ctx context.Context, tenantID int) (*BusinessCalendarCalculator, error) {
+	cal, err := s.client.BusinessCalendar.Query().
+		Where(businesscalendar.TenantID(tenantID), businesscalendar.IsDefault(true)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("获取默认工作日历失败: %w", err)
+	}
+	return NewBusinessCalendarCalculatorFromEntity(cal)
+}
+
+// TeamCalculator 加载团队日历的计算器，团队未指定日历时返回 nil
+func (s *BusinessCalendarService) TeamCalculator(ctx context.Context, tenantID, teamID int) (*BusinessCalendarCalculator, error) {
+	t, err := s.client.Team.Query().
+		Where(team.ID(teamID), team.TenantID(tenantID), team.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("获取团队失败: %w", err)
+	}
+	if t.CalendarID == nil {
+		return nil, nil
+	}
+	return s.Calculator(ctx, tenantID, *t.CalendarID)
+}
+
+// validateBusinessCalendar 校验日历配置
+func validateBusinessCalendar(timeZone string, windows []schema.CalendarWorkWindow, holidays, workdays []schema.CalendarDay) error {
+	if len(windows) == 0 {
+		return fmt.Errorf("工作日历至少需要一个工作时段")
+	}
+	if _, err := NewBusinessCalendarCalculator(timeZone, windows, holidays, workdays); err != nil {
+		return fmt.Errorf("工作日历配置无效: %w", err)
+	}
+	return nil
+}
+
+// normalizeCalendarDays 规范化日期格式，按日期去重并排序
+func normalizeCalendarDays(days []schema.CalendarDay) []schema.CalendarDay {
+	byDate := map[string]schema.CalendarDay{}
+	for _, day := range days {
+		if date, err := normalizeCalendarDate(day.Date); err == nil {
+			day.Date = date
+			byDate[date] = day
+		}
+	}
+	normalized := make([]schema.CalendarDay, 0, len(byDate))
+	for _, day := range byDate {
+		normalized = append(normalized, day)
+	}
+	sort.Slice(normalized, func(i, j int) bool { return normalized[i].Date < normalized[j].Date })
+	return normalized
+}
+
+func clearDefaultCalendar(ctx context.Context, client *ent.Client, tenantID int) error {
+	return client.BusinessCalendar.Update().
+		Where(businesscalendar.TenantID(tenantID), businesscalendar.IsDefault(true)).
+		SetIsDefault(false).
+		Exec(ctx)
+}
@@ -0,0 +1,239 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"itsm-backend/dto"
+	"itsm-backend/ent/schema"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+// 上海工作日历：工作日 9:00-12:00、13:00-18:00 两段，国庆 10-01 至 10-09 放假，10-10（周六）调休上班
+func shanghaiCalendarWindows() []schema.CalendarWorkWindow {
+	var windows []schema.CalendarWorkWindow
+	for weekday := 1; weekday <= 5; weekday++ {
+		windows = append(windows,
+			schema.CalendarWorkWindow{Weekday: weekday, Start: "09:00", End: "12:00"},
+			schema.CalendarWorkWindow{Weekday: weekday, Start: "13:00", End: "18:00"},
+		)
+	}
+	return windows
+}
+
+func nationalDayHolidays() []schema.CalendarDay {
+	var days []schema.CalendarDay
+	for d := 1; d <= 9; d++ {
+		days = append(days, schema.CalendarDay{Date: time.Date(2026, 10, d, 0, 0, 0, 0, time.UTC).Format(calendarDateLayout), Name: "国庆节"})
+	}
+	return days
+}
+
+var nationalDayMakeUp = []schema.CalendarDay{{Date: "2026-10-10", Name: "国庆节补班"}}
+
+func TestBusinessCalendarCalculator_SplitShiftsHolidaysAndMakeUpDays(t *testing.T) {
+	calendar, err := NewBusinessCalendarCalculator("Asia/Shanghai", shanghaiCalendarWindows(), nationalDayHolidays(), nationalDayMakeUp)
+	require.NoError(t, err)
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	require.NoError(t, err)
+
+	start := time.Date(2026, 9, 30, 17, 0, 0, 0, shanghai)
+	// 节前 60 分钟，调休周六 9:00-12:00 共 180 分钟，午休后再 60 分钟
+	deadline := calendar.AddBusinessMinutes(start, 300)
+	assert.Equal(t, time.Date(2026, 10, 10, 14, 0, 0, 0, shanghai), deadline.In(shanghai))
+	assert.Equal(t, 300, calendar.BusinessMinutesBetween(start, deadline))
+
+	// 输入为 UTC 时按日历时区计算，返回值保持输入时区
+	startUTC := time.Date(2026, 9, 29, 1, 0, 0, 0, time.UTC) // 上海 09:00
+	deadline = calendar.AddBusinessMinutes(startUTC, 240)
+	assert.Equal(t, time.Date(2026, 9, 29, 6, 0, 0, 0, time.UTC), deadline, "午休不计时")
+	assert.Equal(t, time.UTC, deadline.Location())
+
+	lunch := time.Date(2026, 9, 29, 12, 30, 0, 0, shanghai)
+	assert.False(t, calendar.IsBusinessTime(lunch))
+	assert.Equal(t, time.Date(2026, 9, 29, 13, 0, 0, 0, shanghai), calendar.NextBusinessTime(lunch))
+	assert.False(t, calendar.IsBusinessTime(time.Date(2026, 10, 8, 10, 0, 0, 0, shanghai)), "节假日")
+	assert.True(t, calendar.IsBusinessTime(time.Date(2026, 10, 10, 10, 0, 0, 0, shanghai)), "调休上班日")
+	assert.True(t, calendar.IsHoliday(time.Date(2026, 10, 1, 0, 30, 0, 0, shanghai)))
+}
+
+func TestBusinessCalendarCalculator_OvernightShift(t *testing.T) {
+	var windows []schema.CalendarWorkWindow
+	for weekday := 1; weekday <= 7; weekday++ {
+		windows = append(windows, schema.CalendarWorkWindow{Weekday: weekday, Start: "22:00", End: "06:00"})
+	}
+	calendar, err := NewBusinessCalendarCalculator("UTC", windows, []schema.CalendarDay{{Date: "2026-10-12"}}, nil)
+	require.NoError(t, err)
+
+	// 周一 05:00 处于周日开始的夜班内；周一开始的夜班因节假日不计时
+	start := time.Date(2026, 10, 12, 5, 0, 0, 0, time.UTC)
+	assert.True(t, calendar.IsBusinessTime(start))
+	assert.Equal(t, time.Date(2026, 10, 13, 23, 0, 0, 0, time.UTC), calendar.AddBusinessMinutes(start, 120))
+}
+
+func TestBusinessCalendarCalculator_InvalidConfiguration(t *testing.T) {
+	cases := map[string]struct {
+		timeZone string
+		windows  []schema.CalendarWorkWindow
+		holidays []schema.CalendarDay
+		want     string
+	}{
+		"时区":   {"Mars/Base", shanghaiCalendarWindows(), nil, "无效的时区"},
+		"星期":   {"UTC", []schema.CalendarWorkWindow{{Weekday: 8, Start: "09:00", End: "18:00"}}, nil, "无效的星期"},
+		"时刻":   {"UTC", []schema.CalendarWorkWindow{{Weekday: 1, Start: "9点", End: "18:00"}}, nil, "无效的开始时间"},
+		"时段重叠": {"UTC", []schema.CalendarWorkWindow{{Weekday: 1, Start: "09:00", End: "13:00"}, {Weekday: 1, Start: "12:00", End: "18:00"}}, nil, "重叠"},
+		"日期":   {"UTC", shanghaiCalendarWindows(), []schema.CalendarDay{{Date: "2026/10/01"}}, "无效的日期"},
+	}
+	for name, tc := range cases {
+		_, err := NewBusinessCalendarCalculator(tc.timeZone, tc.windows, tc.holidays, nil)
+		assert.ErrorContains(t, err, tc.want, name)
+	}
+}
+
+const chinaHolidaysICS = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"PRODID:-//holiday//CN\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:national-day\r\n" +
+	"DTSTART;VALUE=DATE:20261001\r\n" +
+	"DTEND;VALUE=DATE:20261004\r\n" +
+	"SUMMARY:国庆\r\n" +
+	" 节\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:make-up\r\n" +
+	"DTSTART;VALUE=DATE:20261010\r\n" +
+	"SUMMARY:国庆节补班\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:mid-autumn\r\n" +
+	"DTSTART:20260925T000000\r\n" +
+	"DURATION:P1D\r\n" +
+	"SUMMARY:中秋节\\, 团圆\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:cancelled\r\n" +
+	"STATUS:CANCELLED\r\n" +
+	"DTSTART;VALUE=DATE:20261231\r\n" +
+	"SUMMARY:已取消\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestParseICSCalendarDays(t *testing.T) {
+	holidays, workdays, err := ParseICSCalendarDays([]byte(chinaHolidaysICS))
+	require.NoError(t, err)
+	assert.Equal(t, []schema.CalendarDay{
+		{Date: "2026-10-01", Name: "国庆节"},
+		{Date: "2026-10-02", Name: "国庆节"},
+		{Date: "2026-10-03", Name: "国庆节"},
+		{Date: "2026-09-25", Name: "中秋节, 团圆"},
+	}, holidays)
+	assert.Equal(t, []schema.CalendarDay{{Date: "2026-10-10", Name: "国庆节补班"}}, workdays)
+
+	_, _, err = ParseICSCalendarDays([]byte("not a calendar"))
+	assert.ErrorContains(t, err, "不是有效的 iCalendar 文件")
+}
+
+// 同一日历指定给 SLA 策略、SLA 定义与 BPMN 流程 SLA 时三者给出相同的截止时间
+func TestBusinessCalendar_ServicesShareCalculator(t *testing.T) {
+	client, policyService, ctx := setupSLAPolicyTest(t)
+	defer client.Close()
+	tenant := createPolicyTenant(ctx, t, client)
+	calendars := NewBusinessCalendarService(client)
+
+	cal, err := calendars.CreateCalendar(ctx, &dto.CreateBusinessCalendarRequest{
+		Name:        "上海总部",
+		TimeZone:    "Asia/Shanghai",
+		WorkWindows: shanghaiCalendarWindows(),
+		TenantID:    tenant.ID,
+	})
+	require.NoError(t, err)
+	result, err := calendars.ImportICS(ctx, tenant.ID, cal.ID, []byte(chinaHolidaysICS))
+	require.NoError(t, err)
+	assert.Equal(t, &dto.BusinessCalendarImportResult{HolidaysImported: 4, ExtraWorkdaysImported: 1}, result)
+	_, err = calendars.UpdateCalendar(ctx, tenant.ID, cal.ID, &dto.UpdateBusinessCalendarRequest{
+		Holidays: &[]schema.CalendarDay{{Date: "2026-10-01"}, {Date: "2026-10-02"}, {Date: "2026-10-03"}, {Date: "2026-10-04"},
+			{Date: "2026-10-05"}, {Date: "2026-10-06"}, {Date: "2026-10-07"}, {Date: "2026-10-08"}, {Date: "2026-10-09"}},
+	})
+	require.NoError(t, err)
+
+	req := sampleCreateRequest(tenant.ID)
+	req.ResolutionTimeMinutes = 300
+	req.CalendarID = &cal.ID
+	policy, err := policyService.CreateSLAPolicy(ctx, req)
+	require.NoError(t, err)
+	definition, err := client.SLADefinition.Create().
+		SetName("P2").SetResponseTime(60).SetResolutionTime(300).
+		SetCalendarID(cal.ID).SetTenantID(tenant.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	start := time.Date(2026, 9, 30, 9, 0, 0, 0, time.UTC) // 上海 17:00
+	want := time.Date(2026, 10, 10, 6, 0, 0, 0, time.UTC) // 上海调休周六 14:00
+
+	assert.Equal(t, want, policyService.CalculateSLAExpireTime(ctx, policy, start))
+	ticketSLA := NewTicketSLAService(client, zaptest.NewLogger(t).Sugar())
+	assert.Equal(t, want, ticketSLA.calculateDeadline(ctx, definition, start, definition.ResolutionTime))
+	bpmnSLA := NewBPMNSLAService(client, zaptest.NewLogger(t).Sugar())
+	_, deadline, _, err := bpmnSLA.CalculateSLAStatus(ctx, start, &ProcessSLA{
+		DeadlineMinutes: 300, WarningMinutes: 240, BusinessHoursOnly: true, CalendarID: &cal.ID, TenantID: tenant.ID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, want, deadline)
+
+	err = calendars.DeleteCalendar(ctx, tenant.ID, cal.ID)
+	assert.ErrorContains(t, err, "仍被 SLA 或团队引用")
+}
+
+func TestBusinessCalendarService_TeamAndDefaultResolution(t *testing.T) {
+	client, _, ctx := setupSLAPolicyTest(t)
+	defer client.Close()
+	tenant := createPolicyTenant(ctx, t, client)
+	calendars := NewBusinessCalendarService(client)
+	bpmnSLA := NewBPMNSLAService(client, zaptest.NewLogger(t).Sugar())
+
+	var allDay []schema.CalendarWorkWindow
+	for weekday := 1; weekday <= 7; weekday++ {
+		allDay = append(allDay, schema.CalendarWorkWindow{Weekday: weekday, Start: "00:00", End: "24:00"})
+	}
+	noc, err := calendars.CreateCalendar(ctx, &dto.CreateBusinessCalendarRequest{
+		Name: "NOC 7x24", TimeZone: "UTC", WorkWindows: allDay, TenantID: tenant.ID,
+	})
+	require.NoError(t, err)
+	office, err := calendars.CreateCalendar(ctx, &dto.CreateBusinessCalendarRequest{
+		Name: "办公室", TimeZone: "UTC", WorkWindows: shanghaiCalendarWindows(), IsDefault: true, TenantID: tenant.ID,
+	})
+	require.NoError(t, err)
+	team, err := client.Team.Create().SetName("NOC").SetCode("noc").SetTenantID(tenant.ID).Save(ctx)
+	require.NoError(t, err)
+	require.NoError(t, calendars.AssignCalendar(ctx, tenant.ID, &dto.AssignBusinessCalendarRequest{
+		TargetType: "team", TargetID: team.ID, CalendarID: &noc.ID,
+	}))
+	err = calendars.AssignCalendar(ctx, tenant.ID, &dto.AssignBusinessCalendarRequest{TargetType: "team", TargetID: team.ID + 100, CalendarID: &noc.ID})
+	assert.ErrorContains(t, err, "不存在或不属于当前租户")
+
+	saturday := time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)
+	_, deadline, _, err := bpmnSLA.CalculateSLAStatus(ctx, saturday, &ProcessSLA{
+		DeadlineMinutes: 60, WarningMinutes: 30, BusinessHoursOnly: true, TeamID: &team.ID, TenantID: tenant.ID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, saturday.Add(time.Hour), deadline, "团队日历 7x24")
+
+	_, deadline, _, err = bpmnSLA.CalculateSLAStatus(ctx, saturday, &ProcessSLA{
+		DeadlineMinutes: 60, WarningMinutes: 30, BusinessHoursOnly: true, TenantID: tenant.ID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC), deadline, "租户默认日历")
+
+	// 设置新的默认日历时取消原默认日历
+	isDefault := true
+	_, err = calendars.UpdateCalendar(ctx, tenant.ID, noc.ID, &dto.UpdateBusinessCalendarRequest{IsDefault: &isDefault})
+	require.NoError(t, err)
+	office, err = calendars.GetCalendar(ctx, tenant.ID, office.ID)
+	require.NoError(t, err)
+	assert.False(t, office.IsDefault)
+	require.NoError(t, calendars.DeleteCalendar(ctx, tenant.ID, office.ID))
+}
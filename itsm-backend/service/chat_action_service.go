@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"itsm-backend/common"
+	"itsm-backend/common/tenantctx"
+	"itsm-backend/connector"
+	"itsm-backend/ent"
+	"itsm-backend/ent/approvalrecord"
+	"itsm-backend/ent/user"
+	"itsm-backend/middleware"
+
+	"go.uber.org/zap"
+)
+
+// 卡片按钮回传值格式 "<操作>:<ID>"，如 approval:approve:12；由 ChatActionValue 生成
+const (
+	ChatActionApprove     = "approval:approve"
+	ChatActionReject      = "approval:reject"
+	ChatActionAcknowledge = "incident:ack"
+	ChatActionAssignToMe  = "ticket:assign_me"
+)
+
+var (
+	ErrChatActionInvalid   = errors.New("无法识别的卡片操作")
+	ErrChatUserNotBound    = errors.New("IM 账号未绑定 ITSM 用户，请在个人资料中绑定后重试")
+	ErrChatActionForbidden = errors.New("无权执行该操作")
+)
+
+// interactiveChatChannels 支持卡片按钮回调的通知渠道，投递工单通知时附带操作按钮
+var interactiveChatChannels = map[string]bool{"slack": true, "teams": true}
+
+// ChatActionService 处理 IM 卡片按钮回调：审批通过/拒绝、认领事件、分派工单给自己
+// 以 connector.InboundHandler 形式注册到 connector.Router，租户由入站网关写入 ctx
+type ChatActionService struct {
+	client     *ent.Client
+	connectors *connector.Manager
+	approvals  *ApprovalService
+	tickets    *TicketService
+	incidents  *IncidentService
+	logger     *zap.SugaredLogger
+}
+
+// NewChatActionService 创建卡片操作服务
+func NewChatActionService(client *ent.Client, connectors *connector.Manager, approvals *ApprovalService, tickets *TicketService, incidents *IncidentService, logger *zap.SugaredLogger) *ChatActionService {
+	return &ChatActionService{
+		client:     client,
+		connectors: connectors,
+		approvals:  approvals,
+		tickets:    tickets,
+		incidents:  incidents,
+		logger:     logger,
+	}
+}
+
+// ChatActionValue 生成卡片按钮回传值
+func ChatActionValue(action string, id int) string {
+	return action + ":" + strconv.Itoa(id)
+}
+
+// HandleInbound 实现 connector.InboundHandler：非卡片回调直接忽略
+// 执行结果（含失败原因）就地回复给点击者，错误同时返回给 Router 记录；
+// 业务拒绝（未绑定、无权限、审批已处理等）已答复点击者，标记为不可重试，避免重试重复回复与重复执行
+func (s *ChatActionService) HandleInbound(ctx context.Context, msg *connector.InboundMessage) error {
+	if msg == nil || msg.Type != connector.InboundCardAction {
+		return nil
+	}
+	tenantID, ok := tenantctx.TenantID(ctx)
+	if !ok || tenantID <= 0 {
+		return fmt.Errorf("卡片回调缺少租户上下文")
+	}
+	value, _ := msg.Extras[connector.ExtraActionValue].(string)
+	result, err := s.Execute(ctx, tenantID, msg.ConnectorName, msg.UserID, value)
+	if err != nil {
+		s.logger.Warnw("Chat card action failed", "tenant_id", tenantID, "connector", msg.ConnectorName,
+			"im_user", msg.UserID, "value", value, "error", err)
+		result = "操作失败：" + chatActionErrorText(err)
+	}
+	s.respond(ctx, tenantID, msg, result)
+	if err != nil && isChatActionRejection(err) {
+		return connector.Permanent(err)
+	}
+	return err
+}
+
+// Execute 以 IM 账号绑定的 ITSM 用户身份执行卡片操作，返回展示给点击者的结果文案
+func (s *ChatActionService) Execute(ctx context.Context, tenantID int, connectorName, imUserID, value string) (string, error) {
+	action, id, err := parseChatAction(value)
+	if err != nil {
+		return "", err
+	}
+	operator, err := s.resolveUser(ctx, tenantID, connectorName, imUserID)
+	if err != nil {
+		return "", err
+	}
+	comment := "通过 " + connectorName + " 卡片操作"
+	switch action {
+	case ChatActionApprove, ChatActionReject:
+		verb, label := "approve", "通过"
+		if action == ChatActionReject {
+			verb, label = "reject", "拒绝"
+		}
+		// 审批人身份与顺序由 SubmitApproval 校验
+		if err := s.approvals.SubmitApproval(ctx, id, operator.ID, verb, comment, nil, tenantID); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s 已%s审批（记录 #%d）", operator.Name, label, id), nil
+	case ChatActionAcknowledge:
+		if !middleware.HasResourcePermission(ctx, s.client, string(operator.Role), "incident", "write", tenantID) {
+			return "", ErrChatActionForbidden
+		}
+		if err := s.incidents.AcknowledgeIncident(ctx, id, operator.ID, tenantID); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s 已认领事件 #%d", operator.Name, id), nil
+	case ChatActionAssignToMe:
+		if !middleware.HasResourcePermission(ctx, s.client, string(operator.Role), "ticket", "assign", tenantID) {
+			return "", ErrChatActionForbidden
+		}
+		tk, err := s.tickets.AssignTicket(ctx, id, operator.ID, tenantID)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("工单 %s 已分派给 %s", tk.TicketNumber, operator.Name), nil
+	}
+	return "", ErrChatActionInvalid
+}
+
+// resolveUser 按连接器对应的 IM 账号字段查找租户内启用的用户
+func (s *ChatActionService) resolveUser(ctx context.Context, tenantID int, connectorName, imUserID string) (*ent.User, error) {
+	if imUserID == "" {
+		return nil, ErrChatUserNotBound
+	}
+	query := s.client.User.Query().Where(user.TenantIDEQ(tenantID), user.ActiveEQ(true))
+	switch connectorName {
+	case "slack":
+		query = query.Where(user.SlackUserIDEQ(imUserID))
+	case "teams":
+		query = query.Where(user.TeamsUserIDEQ(imUserID))
+	case "feishu":
+		query = query.Where(user.FeishuOpenIDEQ(imUserID))
+	default:
+		return nil, ErrChatUserNotBound
+	}
+	u, err := query.Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrChatUserNotBound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询 IM 绑定用户失败: %w", err)
+	}
+	return u, nil
+}
+
+// respond 优先就地更新被点击的卡片，连接器不支持时在原会话回复
+func (s *ChatActionService) respond(ctx context.Context, tenantID int, msg *connector.InboundMessage, text string) {
+	if s.connectors == nil {
+		return
+	}
+	conn, ok := s.connectors.Get(tenantID, msg.ConnectorName)
+	if !ok {
+		return
+	}
+	reply := &connector.Message{Type: "text", Content: text}
+	var err error
+	if responder, ok := conn.(connector.ActionResponder); ok {
+		err = responder.RespondAction(ctx, msg, reply)
+	} else if msg.ChatID != "" {
+		reply.Channel, reply.ReplyTo = msg.ChatID, msg.MessageID
+		err = conn.Send(ctx, reply)
+	}
+	if err != nil {
+		s.logger.Warnw("Failed to respond chat card action", "tenant_id", tenantID, "connector", msg.ConnectorName, "error", err)
+	}
+}
+
+func parseChatAction(value string) (string, int, error) {
+	idx := strings.LastIndex(value, ":")
+	if idx <= 0 {
+		return "", 0, ErrChatActionInvalid
+	}
+	id, err := strconv.Atoi(value[idx+1:])
+	if err != nil || id <= 0 {
+		return "", 0, ErrChatActionInvalid
+	}
+	switch action := value[:idx]; action {
+	case ChatActionApprove, ChatActionReject, ChatActionAcknowledge, ChatActionAssignToMe:
+		return action, id, nil
+	}
+	return "", 0, ErrChatActionInvalid
+}
+
+// isChatActionRejection 业务拒绝：重试结果不变，与内部错误（数据库、网络）区分
+func isChatActionRejection(err error) bool {
+	if errors.Is(err, ErrChatActionInvalid) || errors.Is(err, ErrChatUserNotBound) || errors.Is(err, ErrChatActionForbidden) || ent.IsNotFound(err) {
+		return true
+	}
+	var appErr *common.AppError
+	if errors.As(err, &appErr) && appErr.HTTPStatus >= 400 && appErr.HTTPStatus < 500 {
+		return true
+	}
+	msg := err.Error()
+	return strings.Contains(msg, "already processed") || strings.Contains(msg, "not authorized to approve")
+}
+
+// chatActionErrorText 只向 IM 暴露业务可读的错误，内部错误统一提示
+func chatActionErrorText(err error) string {
+	switch {
+	case errors.Is(err, ErrChatActionInvalid), errors.Is(err, ErrChatUserNotBound), errors.Is(err, ErrChatActionForbidden):
+		return err.Error()
+	case strings.Contains(err.Error(), "already processed"):
+		return "该审批已处理"
+	case strings.Contains(err.Error(), "not authorized to approve"):
+		return "您不是该审批的当前审批人"
+	}
+	return "处理失败，请在 ITSM 中查看详情"
+}
+
+// ticketChatActions 工单通知的卡片按钮：收件人有待审批记录时给出通过/拒绝，工单未分派时给出"分派给我"
+func ticketChatActions(ctx context.Context, client *ent.Client, tenantID int, tk *ent.Ticket, recipientID int) []connector.Action {
+	record, err := client.ApprovalRecord.Query().
+		Where(
+			approvalrecord.TenantIDEQ(tenantID),
+			approvalrecord.TicketIDEQ(tk.ID),
+			approvalrecord.ApproverIDEQ(recipientID),
+			approvalrecord.StatusEQ("pending"),
+		).
+		Order(ent.Asc(approvalrecord.FieldCurrentLevel)).
+		First(ctx)
+	if err == nil {
+		return []connector.Action{
+			{Type: "primary", Text: "通过", Value: ChatActionValue(ChatActionApprove, record.ID)},
+			{Type: "danger", Text: "拒绝", Value: ChatActionValue(ChatActionReject, record.ID)},
+		}
+	}
+	if tk.AssigneeID == 0 {
+		return []connector.Action{{Type: "button", Text: "分派给我", Value: ChatActionValue(ChatActionAssignToMe, tk.ID)}}
+	}
+	return nil
+}
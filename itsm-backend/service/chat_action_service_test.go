@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"itsm-backend/common/tenantctx"
+	"itsm-backend/connector"
+	"itsm-backend/ent/enttest"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestChatActionService(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", testDSN())
+	defer client.Close()
+	ctx := context.Background()
+	logger := zap.NewNop().Sugar()
+
+	tenant, err := createIncidentTestTenant(ctx, client, "chatops")
+	require.NoError(t, err)
+	approverUser, err := createIncidentTestUser(ctx, client, tenant.ID, "chatops-approver")
+	require.NoError(t, err)
+	approverUser, err = approverUser.Update().SetSlackUserID("U_APPROVER").Save(ctx)
+	require.NoError(t, err)
+	admin, err := client.User.Create().
+		SetUsername("chatops-admin").SetEmail("chatops-admin@example.com").SetName("值班管理员").
+		SetPasswordHash("hashedpassword").SetRole("super_admin").SetActive(true).
+		SetTeamsUserID("aad-admin").SetTenantID(tenant.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	incidents := NewIncidentService(client, logger)
+	svc := NewChatActionService(client, nil, NewApprovalService(client, logger), NewTicketServiceForTest(client, logger), incidents, logger)
+
+	tk, err := client.Ticket.Create().
+		SetTitle("VPN 无法连接").SetDescription("desc").SetPriority("high").SetType("ticket").
+		SetStatus("open").SetTicketNumber("TKT-CHATOPS-001").
+		SetTenantID(tenant.ID).SetRequesterID(approverUser.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	t.Run("parse action value", func(t *testing.T) {
+		action, id, err := parseChatAction(ChatActionValue(ChatActionAssignToMe, 42))
+		require.NoError(t, err)
+		require.Equal(t, ChatActionAssignToMe, action)
+		require.Equal(t, 42, id)
+		for _, v := range []string{"", "ticket:assign_me", "ticket:assign_me:x", "ticket:delete:1", "approval:approve:0"} {
+			_, _, err := parseChatAction(v)
+			require.ErrorIs(t, err, ErrChatActionInvalid, v)
+		}
+	})
+
+	t.Run("unbound im user", func(t *testing.T) {
+		_, err := svc.Execute(ctx, tenant.ID, "slack", "U_UNKNOWN", ChatActionValue(ChatActionAssignToMe, tk.ID))
+		require.ErrorIs(t, err, ErrChatUserNotBound)
+		// 不同租户下的绑定不可跨租户使用
+		_, err = svc.Execute(ctx, tenant.ID+1000, "slack", "U_APPROVER", ChatActionValue(ChatActionAssignToMe, tk.ID))
+		require.ErrorIs(t, err, ErrChatUserNotBound)
+	})
+
+	t.Run("assign to me", func(t *testing.T) {
+		actions := ticketChatActions(ctx, client, tenant.ID, tk, admin.ID)
+		require.Len(t, actions, 1)
+		require.Equal(t, ChatActionValue(ChatActionAssignToMe, tk.ID), actions[0].Value)
+
+		msg := &connector.InboundMessage{
+			ConnectorName: "teams", Type: connector.InboundCardAction, UserID: "aad-admin",
+			Extras: map[string]interface{}{connector.ExtraActionValue: actions[0].Value},
+		}
+		require.NoError(t, svc.HandleInbound(tenantctx.WithTenantID(ctx, tenant.ID), msg))
+		updated, err := client.Ticket.Get(ctx, tk.ID)
+		require.NoError(t, err)
+		require.Equal(t, admin.ID, updated.AssigneeID)
+		require.Empty(t, ticketChatActions(ctx, client, tenant.ID, updated, admin.ID))
+	})
+
+	t.Run("approve from card", func(t *testing.T) {
+		workflow, err := client.ApprovalWorkflow.Create().
+			SetName("ChatOps 审批").SetIsActive(true).SetTenantID(tenant.ID).
+			SetNodes([]map[string]interface{}{{
+				"level": 1, "name": "L1", "approverType": "user",
+				"approverIds": []int{approverUser.ID}, "approvalMode": "any",
+			}}).
+			Save(ctx)
+		require.NoError(t, err)
+		record, err := client.ApprovalRecord.Create().
+			SetTicketID(tk.ID).SetTicketNumber(tk.TicketNumber).SetTicketTitle(tk.Title).
+			SetWorkflowID(workflow.ID).SetWorkflowName(workflow.Name).
+			SetCurrentLevel(1).SetTotalLevels(1).SetStepOrder(1).
+			SetApproverID(approverUser.ID).SetApproverName(approverUser.Name).
+			SetStatus("pending").SetTenantID(tenant.ID).
+			Save(ctx)
+		require.NoError(t, err)
+
+		actions := ticketChatActions(ctx, client, tenant.ID, tk, approverUser.ID)
+		require.Len(t, actions, 2)
+		require.Equal(t, ChatActionValue(ChatActionApprove, record.ID), actions[0].Value)
+		require.Equal(t, ChatActionValue(ChatActionReject, record.ID), actions[1].Value)
+
+		// 非审批人点击按钮由 SubmitApproval 拒绝
+		_, err = svc.Execute(ctx, tenant.ID, "teams", "aad-admin", actions[0].Value)
+		require.Error(t, err)
+		require.Equal(t, "您不是该审批的当前审批人", chatActionErrorText(err))
+
+		result, err := svc.Execute(ctx, tenant.ID, "slack", "U_APPROVER", actions[0].Value)
+		require.NoError(t, err)
+		require.Contains(t, result, "已通过审批")
+		updated, err := client.ApprovalRecord.Get(ctx, record.ID)
+		require.NoError(t, err)
+		require.Equal(t, "approved", updated.Status)
+
+		// 重复点击不会再次处理，且已答复点击者，不再重试
+		err = svc.HandleInbound(tenantctx.WithTenantID(ctx, tenant.ID), &connector.InboundMessage{
+			ConnectorName: "slack", Type: connector.InboundCardAction, UserID: "U_APPROVER",
+			Extras: map[string]interface{}{connector.ExtraActionValue: actions[1].Value},
+		})
+		require.ErrorIs(t, err, connector.ErrPermanent)
+	})
+
+	t.Run("business rejections are not retried", func(t *testing.T) {
+		err := svc.HandleInbound(tenantctx.WithTenantID(ctx, tenant.ID), &connector.InboundMessage{
+			ConnectorName: "slack", Type: connector.InboundCardAction, UserID: "U_UNKNOWN",
+			Extras: map[string]interface{}{connector.ExtraActionValue: ChatActionValue(ChatActionAssignToMe, tk.ID)},
+		})
+		require.ErrorIs(t, err, ErrChatUserNotBound)
+		require.ErrorIs(t, err, connector.ErrPermanent)
+		require.False(t, isChatActionRejection(errors.New("database is locked")))
+	})
+
+	t.Run("non card messages ignored", func(t *testing.T) {
+		require.NoError(t, svc.HandleInbound(ctx, &connector.InboundMessage{ConnectorName: "slack", Type: "text", Content: "hi"}))
+	})
+}
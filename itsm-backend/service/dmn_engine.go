@@ -0,0 +1,222 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DMNEngine DMN 决策表求值引擎。
+// 输入表达式与输出条目使用 FEEL 字面量/变量路径，复杂表达式交给 ExpressionEngine；
+// 输入条目按 FEEL 一元测试匹配（见 dmn_feel.go）。
+type DMNEngine struct {
+	feel *feelEvaluator
+}
+
+// NewDMNEngine 创建DMN引擎
+func NewDMNEngine(exprEngine *ExpressionEngine) *DMNEngine {
+	if exprEngine == nil {
+		exprEngine = NewExpressionEngine()
+	}
+	return &DMNEngine{feel: &feelEvaluator{exprEngine: exprEngine}}
+}
+
+// DMNDecisionResult 决策求值结果
+type DMNDecisionResult struct {
+	DecisionKey  string                   `json:"decisionKey"`
+	Version      int                      `json:"version,omitempty"`
+	HitPolicy    string                   `json:"hitPolicy"`
+	MatchedRules []string                 `json:"matchedRules"`
+	Entries      []map[string]interface{} `json:"entries"` // 每条命中规则的输出；带聚合的 COLLECT 为聚合后的单条
+}
+
+// Evaluate 对输入变量求值决策表
+func (d *DMNEngine) Evaluate(decision *DMNDecision, variables map[string]interface{}) (*DMNDecisionResult, error) {
+	table := decision.DecisionTable
+	if table == nil {
+		return nil, fmt.Errorf("决策 %s 不是决策表", decision.ID)
+	}
+	if variables == nil {
+		variables = map[string]interface{}{}
+	}
+
+	inputs := make([]interface{}, len(table.Inputs))
+	for i, input := range table.Inputs {
+		value, err := d.feel.evaluateValue(input.InputExpression.Text, variables)
+		if err != nil {
+			return nil, fmt.Errorf("决策 %s 输入 %s 求值失败: %w", decision.ID, inputLabel(input), err)
+		}
+		inputs[i] = value
+	}
+
+	policy := table.HitPolicyOrDefault()
+	result := &DMNDecisionResult{DecisionKey: decision.ID, HitPolicy: policy, MatchedRules: []string{}, Entries: []map[string]interface{}{}}
+	for i, rule := range table.Rules {
+		matched, err := d.matchRule(rule, inputs, variables)
+		if err != nil {
+			return nil, fmt.Errorf("决策 %s 第%d条规则匹配失败: %w", decision.ID, i+1, err)
+		}
+		if !matched {
+			continue
+		}
+		entry, err := d.ruleOutput(table, rule, variables)
+		if err != nil {
+			return nil, fmt.Errorf("决策 %s 第%d条规则输出求值失败: %w", decision.ID, i+1, err)
+		}
+		result.MatchedRules = append(result.MatchedRules, ruleLabel(rule, i))
+		result.Entries = append(result.Entries, entry)
+		if policy == DMNHitPolicyFirst {
+			break
+		}
+	}
+
+	switch policy {
+	case DMNHitPolicyUnique:
+		if len(result.Entries) > 1 {
+			return nil, fmt.Errorf("决策 %s 违反 UNIQUE 命中策略，同时命中规则: %s", decision.ID, strings.Join(result.MatchedRules, ", "))
+		}
+	case DMNHitPolicyAny:
+		for _, entry := range result.Entries {
+			if !outputsEqual(result.Entries[0], entry) {
+				return nil, fmt.Errorf("决策 %s 违反 ANY 命中策略，命中规则的输出不一致: %s", decision.ID, strings.Join(result.MatchedRules, ", "))
+			}
+		}
+		if len(result.Entries) > 1 {
+			result.Entries = result.Entries[:1]
+		}
+	case DMNHitPolicyCollect:
+		if table.Aggregation != "" {
+			aggregated, err := aggregateOutputs(table.Aggregation, table.Outputs[0].OutputName(), result.Entries)
+			if err != nil {
+				return nil, fmt.Errorf("决策 %s 聚合失败: %w", decision.ID, err)
+			}
+			result.Entries = []map[string]interface{}{aggregated}
+		}
+	}
+	return result, nil
+}
+
+// matchRule 规则的全部输入条目都满足时命中
+func (d *DMNEngine) matchRule(rule *DMNRule, inputs []interface{}, variables map[string]interface{}) (bool, error) {
+	for i, entry := range rule.InputEntries {
+		matched, err := d.feel.matchUnaryTests(entry.Text, inputs[i], variables)
+		if err != nil || !matched {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// ruleOutput 求值命中规则的输出条目，并按输出列 typeRef 规整类型
+func (d *DMNEngine) ruleOutput(table *DMNDecisionTable, rule *DMNRule, variables map[string]interface{}) (map[string]interface{}, error) {
+	entry := make(map[string]interface{}, len(table.Outputs))
+	for i, output := range table.Outputs {
+		value, err := d.feel.evaluateValue(rule.OutputEntries[i].Text, variables)
+		if err != nil {
+			return nil, fmt.Errorf("输出 %s: %w", output.OutputName(), err)
+		}
+		entry[output.OutputName()] = coerceDMNOutput(output.TypeRef, value)
+	}
+	return entry, nil
+}
+
+// SingleEntry 单结果决策的输出（未命中时为 nil）
+func (r *DMNDecisionResult) SingleEntry() map[string]interface{} {
+	if len(r.Entries) == 0 {
+		return nil
+	}
+	return r.Entries[0]
+}
+
+// coerceDMNOutput 按 typeRef 规整输出值：integer/long 取整，string 格式化为字符串
+func coerceDMNOutput(typeRef string, value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	switch strings.ToLower(typeRef) {
+	case "integer", "long":
+		if f, ok := toFloat64(value); ok {
+			return int(f)
+		}
+	case "double", "number":
+		if f, ok := toFloat64(value); ok {
+			return f
+		}
+	case "string":
+		if _, ok := value.(string); !ok {
+			return fmt.Sprintf("%v", value)
+		}
+	}
+	return value
+}
+
+// aggregateOutputs COLLECT 聚合：SUM/MIN/MAX 针对数值输出，COUNT 统计非空输出个数
+func aggregateOutputs(aggregation, name string, entries []map[string]interface{}) (map[string]interface{}, error) {
+	if aggregation == DMNAggregationCount {
+		count := 0
+		for _, entry := range entries {
+			if entry[name] != nil {
+				count++
+			}
+		}
+		return map[string]interface{}{name: count}, nil
+	}
+	var values []float64
+	for _, entry := range entries {
+		if entry[name] == nil {
+			continue
+		}
+		f, ok := toFloat64(entry[name])
+		if !ok {
+			return nil, fmt.Errorf("%s 聚合要求数值输出，实际为 %T", aggregation, entry[name])
+		}
+		values = append(values, f)
+	}
+	if len(values) == 0 {
+		return map[string]interface{}{name: nil}, nil
+	}
+	acc := values[0]
+	for _, v := range values[1:] {
+		switch aggregation {
+		case DMNAggregationSum:
+			acc += v
+		case DMNAggregationMin:
+			if v < acc {
+				acc = v
+			}
+		case DMNAggregationMax:
+			if v > acc {
+				acc = v
+			}
+		}
+	}
+	return map[string]interface{}{name: acc}, nil
+}
+
+func outputsEqual(a, b map[string]interface{}) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if !feelEqual(v, b[k]) {
+			return false
+		}
+	}
+	return true
+}
+
+func inputLabel(input *DMNInput) string {
+	if input.Label != "" {
+		return input.Label
+	}
+	if input.ID != "" {
+		return input.ID
+	}
+	return input.InputExpression.Text
+}
+
+func ruleLabel(rule *DMNRule, index int) string {
+	if rule.ID != "" {
+		return rule.ID
+	}
+	return fmt.Sprintf("rule#%d", index+1)
+}
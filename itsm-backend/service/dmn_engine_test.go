@@ -0,0 +1,178 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 影响度 × 紧急度 → 优先级
+const priorityMatrixDMN = `<?xml version="1.0" encoding="UTF-8"?>
+<definitions xmlns="https://www.omg.org/spec/DMN/20191111/MODEL/" id="priority" name="Priority" namespace="http://itsm">
+  <decision id="priority_matrix" name="优先级矩阵">
+    <decisionTable id="DecisionTable_1" hitPolicy="UNIQUE">
+      <input id="Input_1" label="影响度">
+        <inputExpression id="InputExpression_1" typeRef="string"><text>impact</text></inputExpression>
+      </input>
+      <input id="Input_2" label="紧急度">
+        <inputExpression id="InputExpression_2" typeRef="string"><text>urgency</text></inputExpression>
+      </input>
+      <output id="Output_1" name="priority" typeRef="string"/>
+      <output id="Output_2" name="sla_hours" typeRef="integer"/>
+      <rule id="R1">
+        <inputEntry><text>"high"</text></inputEntry>
+        <inputEntry><text>"high"</text></inputEntry>
+        <outputEntry><text>"P1"</text></outputEntry>
+        <outputEntry><text>4</text></outputEntry>
+      </rule>
+      <rule id="R2">
+        <inputEntry><text>"high"</text></inputEntry>
+        <inputEntry><text>"medium","low"</text></inputEntry>
+        <outputEntry><text>"P2"</text></outputEntry>
+        <outputEntry><text>8</text></outputEntry>
+      </rule>
+      <rule id="R3">
+        <inputEntry><text>not("high")</text></inputEntry>
+        <inputEntry><text>-</text></inputEntry>
+        <outputEntry><text>"P3"</text></outputEntry>
+        <outputEntry><text>24</text></outputEntry>
+      </rule>
+    </decisionTable>
+  </decision>
+</definitions>`
+
+func parseTestDecision(t *testing.T, xml, id string) *DMNDecision {
+	t.Helper()
+	definitions, err := ParseDMNXML([]byte(xml))
+	require.NoError(t, err)
+	decision := definitions.Decision(id)
+	require.NotNil(t, decision)
+	return decision
+}
+
+func TestDMNEngine_UniquePriorityMatrix(t *testing.T) {
+	decision := parseTestDecision(t, priorityMatrixDMN, "priority_matrix")
+	engine := NewDMNEngine(nil)
+
+	cases := []struct {
+		impact, urgency string
+		priority        string
+		hours           int
+	}{
+		{"high", "high", "P1", 4},
+		{"high", "low", "P2", 8},
+		{"medium", "high", "P3", 24},
+		{"low", "low", "P3", 24},
+	}
+	for _, tc := range cases {
+		result, err := engine.Evaluate(decision, map[string]interface{}{"impact": tc.impact, "urgency": tc.urgency})
+		require.NoError(t, err)
+		require.Len(t, result.Entries, 1, "%s/%s", tc.impact, tc.urgency)
+		assert.Equal(t, tc.priority, result.SingleEntry()["priority"])
+		assert.Equal(t, tc.hours, result.SingleEntry()["sla_hours"])
+	}
+
+	// 没有规则命中时结果为空
+	result, err := engine.Evaluate(decision, map[string]interface{}{"impact": "high", "urgency": "unknown"})
+	require.NoError(t, err)
+	assert.Empty(t, result.Entries)
+	assert.Nil(t, result.SingleEntry())
+}
+
+func TestDMNEngine_HitPolicies(t *testing.T) {
+	table := func(hitPolicy, aggregation string) string {
+		return `<definitions id="d" namespace="n"><decision id="score" name="score">
+  <decisionTable hitPolicy="` + hitPolicy + `" aggregation="` + aggregation + `">
+    <input><inputExpression><text>amount</text></inputExpression></input>
+    <output name="points" typeRef="integer"/>
+    <rule id="small"><inputEntry><text>[0..100]</text></inputEntry><outputEntry><text>1</text></outputEntry></rule>
+    <rule id="medium"><inputEntry><text>> 50</text></inputEntry><outputEntry><text>5</text></outputEntry></rule>
+    <rule id="large"><inputEntry><text>]80..1000)</text></inputEntry><outputEntry><text>10</text></outputEntry></rule>
+  </decisionTable></decision></definitions>`
+	}
+	engine := NewDMNEngine(nil)
+	vars := map[string]interface{}{"amount": 90.0}
+
+	_, err := engine.Evaluate(parseTestDecision(t, table("UNIQUE", ""), "score"), vars)
+	assert.ErrorContains(t, err, "UNIQUE")
+
+	result, err := engine.Evaluate(parseTestDecision(t, table("FIRST", ""), "score"), vars)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"small"}, result.MatchedRules)
+
+	result, err = engine.Evaluate(parseTestDecision(t, table("RULE ORDER", ""), "score"), vars)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"small", "medium", "large"}, result.MatchedRules)
+	assert.Len(t, result.Entries, 3)
+
+	result, err = engine.Evaluate(parseTestDecision(t, table("COLLECT", ""), "score"), map[string]interface{}{"amount": 60})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"small", "medium"}, result.MatchedRules)
+
+	result, err = engine.Evaluate(parseTestDecision(t, table("COLLECT", "SUM"), "score"), vars)
+	require.NoError(t, err)
+	assert.EqualValues(t, 16, result.SingleEntry()["points"])
+
+	result, err = engine.Evaluate(parseTestDecision(t, table("COLLECT", "MAX"), "score"), vars)
+	require.NoError(t, err)
+	assert.EqualValues(t, 10, result.SingleEntry()["points"])
+
+	result, err = engine.Evaluate(parseTestDecision(t, table("COLLECT", "COUNT"), "score"), map[string]interface{}{"amount": 1000})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, result.SingleEntry()["points"], "区间 ]80..1000) 不含 1000")
+}
+
+func TestFEELUnaryTests(t *testing.T) {
+	feel := &feelEvaluator{exprEngine: NewExpressionEngine()}
+	vars := map[string]interface{}{"threshold": 10, "ticket": map[string]interface{}{"category": "network"}}
+	cases := []struct {
+		test  string
+		input interface{}
+		match bool
+	}{
+		{"-", nil, true},
+		{"", "x", true},
+		{`"network"`, "network", true},
+		{`"network", "storage"`, "storage", true},
+		{`not("network", "storage")`, "storage", false},
+		{`not("network")`, "database", true},
+		{"< 10", 9.5, true},
+		{"<= threshold", 10, true},
+		{"> threshold", 10, false},
+		{"!= 3", 4, true},
+		{"[1..5]", 5, true},
+		{"[1..5)", 5, false},
+		{"]1..5]", 1, false},
+		{"(1..5)", 3.0, true},
+		{"3, [10..20]", 15, true},
+		{"true", true, true},
+		{"null", nil, true},
+		{"> 5", nil, false},
+		{"? > 3 and ? < 8", 5, true},
+		{`? == ticket.category`, "network", true},
+		{`ticket.category`, "network", true},
+		{`>= date("2026-01-01")`, "2026-03-01T08:00:00Z", true},
+		{`< date and time("2026-01-01T00:00:00")`, "2026-03-01T08:00:00Z", false},
+	}
+	for _, tc := range cases {
+		matched, err := feel.matchUnaryTests(tc.test, tc.input, vars)
+		require.NoError(t, err, tc.test)
+		assert.Equal(t, tc.match, matched, "%s 对 %v", tc.test, tc.input)
+	}
+}
+
+func TestParseDMNXML_Validation(t *testing.T) {
+	_, err := ParseDMNXML([]byte(`<definitions id="d"><decision id="lit"><literalExpression><text>1</text></literalExpression></decision></definitions>`))
+	assert.ErrorContains(t, err, "仅支持决策表")
+
+	_, err = ParseDMNXML([]byte(`<definitions id="d"><decision id="p"><decisionTable hitPolicy="PRIORITY"><output name="x"/></decisionTable></decision></definitions>`))
+	assert.ErrorContains(t, err, "不支持的命中策略")
+
+	_, err = ParseDMNXML([]byte(`<definitions id="d"><decision id="m"><decisionTable>
+    <input><inputExpression><text>a</text></inputExpression></input>
+    <output name="x"/>
+    <rule><outputEntry><text>1</text></outputEntry></rule>
+  </decisionTable></decision></definitions>`))
+	assert.ErrorContains(t, err, "输入条目数")
+}
@@ -0,0 +1,344 @@
+package service
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FEEL 一元测试（unary tests）求值
+//
+// 支持的语法（DMN 1.3 输入条目常用子集）：
+//   - "-" 或空：匹配任意值
+//   - 字面量：数字、"字符串"、true/false、null、date("2024-01-01")、date and time("...")
+//   - 比较：< 10、<= 10、> x、>= x、!= "low"（端点可以是字面量或变量表达式）
+//   - 区间：[1..10]、(1..10)、]1..10[、[1..10) 等
+//   - 列表：多个测试用逗号分隔，任一命中即匹配
+//   - 取反：not("low", "medium")
+//   - 含 ? 的表达式：? 代表当前输入值，如 ? > 3 and ? < 8，交由表达式引擎求值
+
+// feelInputVariable 含 ? 的一元测试交给表达式引擎时，? 被替换成的变量名
+const feelInputVariable = "__feel_input__"
+
+var (
+	feelIdentifierPath = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)*$`)
+	feelNumber         = regexp.MustCompile(`^-?\d+(\.\d+)?$`)
+)
+
+// feelEvaluator FEEL 一元测试与字面量求值器，非字面量的表达式交给 ExpressionEngine
+type feelEvaluator struct {
+	exprEngine *ExpressionEngine
+}
+
+// matchUnaryTests 判断输入值是否满足一元测试单元格
+func (f *feelEvaluator) matchUnaryTests(text string, input interface{}, variables map[string]interface{}) (bool, error) {
+	test := strings.TrimSpace(text)
+	if test == "" || test == "-" {
+		return true, nil
+	}
+	if inner, ok := feelNegation(test); ok {
+		matched, err := f.matchUnaryTests(inner, input, variables)
+		return !matched, err
+	}
+	for _, part := range splitFEELList(test) {
+		matched, err := f.matchUnaryTest(part, input, variables)
+		if err != nil {
+			return false, fmt.Errorf("一元测试 %q 求值失败: %w", part, err)
+		}
+		if matched {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// matchUnaryTest 求值单个（非列表）一元测试
+func (f *feelEvaluator) matchUnaryTest(test string, input interface{}, variables map[string]interface{}) (bool, error) {
+	for _, op := range []string{"<=", ">=", "!=", "<", ">", "="} {
+		if strings.HasPrefix(test, op) {
+			endpoint, err := f.evaluateValue(strings.TrimSpace(test[len(op):]), variables)
+			if err != nil {
+				return false, err
+			}
+			if op == "=" {
+				return feelEqual(input, endpoint), nil
+			}
+			if op == "!=" {
+				return !feelEqual(input, endpoint), nil
+			}
+			cmp, ok := feelCompare(input, endpoint)
+			if !ok {
+				return false, nil
+			}
+			switch op {
+			case "<":
+				return cmp < 0, nil
+			case "<=":
+				return cmp <= 0, nil
+			case ">":
+				return cmp > 0, nil
+			default:
+				return cmp >= 0, nil
+			}
+		}
+	}
+
+	if low, high, lowOpen, highOpen, ok := parseFEELInterval(test); ok {
+		lowValue, err := f.evaluateValue(low, variables)
+		if err != nil {
+			return false, err
+		}
+		highValue, err := f.evaluateValue(high, variables)
+		if err != nil {
+			return false, err
+		}
+		lowCmp, ok := feelCompare(input, lowValue)
+		if !ok || lowCmp < 0 || (lowOpen && lowCmp == 0) {
+			return false, nil
+		}
+		highCmp, ok := feelCompare(input, highValue)
+		if !ok || highCmp > 0 || (highOpen && highCmp == 0) {
+			return false, nil
+		}
+		return true, nil
+	}
+
+	if expression, ok := replaceFEELInput(test); ok {
+		env := make(map[string]interface{}, len(variables)+1)
+		for k, v := range variables {
+			env[k] = v
+		}
+		env[feelInputVariable] = input
+		return f.exprEngine.EvaluateCondition(expression, env)
+	}
+
+	value, err := f.evaluateValue(test, variables)
+	if err != nil {
+		return false, err
+	}
+	return feelEqual(input, value), nil
+}
+
+// evaluateValue 求值 FEEL 字面量；非字面量时按变量路径或表达式求值
+func (f *feelEvaluator) evaluateValue(text string, variables map[string]interface{}) (interface{}, error) {
+	text = strings.TrimSpace(text)
+	switch {
+	case text == "" || text == "null":
+		return nil, nil
+	case text == "true":
+		return true, nil
+	case text == "false":
+		return false, nil
+	case strings.HasPrefix(text, `"`) && strings.HasSuffix(text, `"`) && len(text) >= 2:
+		if s, err := strconv.Unquote(text); err == nil {
+			return s, nil
+		}
+		return text[1 : len(text)-1], nil
+	case feelNumber.MatchString(text):
+		if n, err := strconv.Atoi(text); err == nil {
+			return n, nil
+		}
+		return strconv.ParseFloat(text, 64)
+	}
+	if arg, ok := feelFunctionArg(text, "date and time"); ok {
+		return parseFEELTime(arg, time.RFC3339, "2006-01-02T15:04:05")
+	}
+	if arg, ok := feelFunctionArg(text, "date"); ok {
+		return parseFEELTime(arg, "2006-01-02")
+	}
+	if feelIdentifierPath.MatchString(text) {
+		return lookupVariablePath(variables, text), nil
+	}
+	return f.exprEngine.Evaluate(text, variables)
+}
+
+// lookupVariablePath 按 a.b.c 路径读取变量，任一段缺失时返回 nil（FEEL 中缺失即 null）
+func lookupVariablePath(variables map[string]interface{}, path string) interface{} {
+	var current interface{} = variables
+	for _, segment := range strings.Split(path, ".") {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		current = m[segment]
+	}
+	return current
+}
+
+// feelNegation 识别 not(...) 且括号包住整个测试
+func feelNegation(test string) (string, bool) {
+	if !strings.HasPrefix(test, "not(") || !strings.HasSuffix(test, ")") {
+		return "", false
+	}
+	inner := test[len("not(") : len(test)-1]
+	depth := 0
+	inString := false
+	for _, r := range inner {
+		switch {
+		case r == '"':
+			inString = !inString
+		case inString:
+		case r == '(':
+			depth++
+		case r == ')':
+			depth--
+			if depth < 0 {
+				return "", false
+			}
+		}
+	}
+	return inner, depth == 0
+}
+
+// splitFEELList 按顶层逗号拆分一元测试列表。
+// 区间端点的括号方向不固定（如 ]1..10[），因此只把紧跟标识符的 "(" 视为函数调用嵌套。
+func splitFEELList(test string) []string {
+	var parts []string
+	depth := 0
+	inString := false
+	start := 0
+	for i := 0; i < len(test); i++ {
+		c := test[i]
+		switch {
+		case c == '"' && (i == 0 || test[i-1] != '\\'):
+			inString = !inString
+		case inString:
+		case c == '(' && i > 0 && isFEELNameChar(test[i-1]):
+			depth++
+		case c == ')' && depth > 0:
+			depth--
+		case c == ',' && depth == 0:
+			parts = append(parts, strings.TrimSpace(test[start:i]))
+			start = i + 1
+		}
+	}
+	return append(parts, strings.TrimSpace(test[start:]))
+}
+
+func isFEELNameChar(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// parseFEELInterval 解析区间 [a..b] / (a..b) / ]a..b[ 等
+func parseFEELInterval(test string) (low, high string, lowOpen, highOpen bool, ok bool) {
+	if len(test) < 5 {
+		return "", "", false, false, false
+	}
+	first, last := test[0], test[len(test)-1]
+	if !strings.ContainsRune("[(]", rune(first)) || !strings.ContainsRune("])[", rune(last)) {
+		return "", "", false, false, false
+	}
+	bounds := strings.SplitN(test[1:len(test)-1], "..", 2)
+	if len(bounds) != 2 {
+		return "", "", false, false, false
+	}
+	return strings.TrimSpace(bounds[0]), strings.TrimSpace(bounds[1]), first != '[', last != ']', true
+}
+
+// replaceFEELInput 把字符串字面量之外的 ? 替换为输入变量，不含 ? 时返回 false
+func replaceFEELInput(test string) (string, bool) {
+	var b strings.Builder
+	inString := false
+	replaced := false
+	for i := 0; i < len(test); i++ {
+		c := test[i]
+		if c == '"' && (i == 0 || test[i-1] != '\\') {
+			inString = !inString
+		}
+		if c == '?' && !inString {
+			b.WriteString(feelInputVariable)
+			replaced = true
+			continue
+		}
+		b.WriteByte(c)
+	}
+	return b.String(), replaced
+}
+
+// feelFunctionArg 提取 name("arg") 形式的字符串参数
+func feelFunctionArg(text, name string) (string, bool) {
+	prefix := name + "("
+	if !strings.HasPrefix(text, prefix) || !strings.HasSuffix(text, ")") {
+		return "", false
+	}
+	arg := strings.TrimSpace(text[len(prefix) : len(text)-1])
+	s, err := strconv.Unquote(arg)
+	if err != nil {
+		return "", false
+	}
+	return s, true
+}
+
+func parseFEELTime(value string, layouts ...string) (time.Time, error) {
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("无法解析日期时间: %s", value)
+}
+
+// feelEqual FEEL 相等：数字按数值比较，时间按时刻比较，其余要求类型与值都相同
+func feelEqual(a, b interface{}) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	if cmp, ok := feelCompare(a, b); ok {
+		return cmp == 0
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// feelCompare 比较两个值；类型不可比较（含 null）时返回 false
+func feelCompare(a, b interface{}) (int, bool) {
+	if af, ok := toFloat64(a); ok {
+		bf, ok := toFloat64(b)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case af < bf:
+			return -1, true
+		case af > bf:
+			return 1, true
+		}
+		return 0, true
+	}
+	_, aIsTime := a.(time.Time)
+	_, bIsTime := b.(time.Time)
+	if aIsTime || bIsTime {
+		at, ok := feelTime(a)
+		if !ok {
+			return 0, false
+		}
+		bt, ok := feelTime(b)
+		if !ok {
+			return 0, false
+		}
+		return at.Compare(bt), true
+	}
+	as, ok := a.(string)
+	if !ok {
+		return 0, false
+	}
+	bs, ok := b.(string)
+	if !ok {
+		return 0, false
+	}
+	return strings.Compare(as, bs), true
+}
+
+// feelTime 取时间值；流程变量中的时间经 JSON 往返后是 RFC3339 字符串，与 date() 字面量比较时按时间解析
+func feelTime(v interface{}) (time.Time, bool) {
+	switch t := v.(type) {
+	case time.Time:
+		return t, true
+	case string:
+		parsed, err := parseFEELTime(t, time.RFC3339, "2006-01-02T15:04:05", "2006-01-02")
+		return parsed, err == nil
+	}
+	return time.Time{}, false
+}
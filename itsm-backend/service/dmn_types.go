@@ -0,0 +1,197 @@
+package service
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// DMN 1.3 决策表模型
+//
+// 只解析决策表（decisionTable）所需的元素；字面量表达式决策、决策需求图（DRD）等不在支持范围内，
+// 部署时直接拒绝，避免运行期才发现无法求值。
+
+// DMN 命中策略
+const (
+	DMNHitPolicyUnique    = "UNIQUE"
+	DMNHitPolicyFirst     = "FIRST"
+	DMNHitPolicyAny       = "ANY"
+	DMNHitPolicyCollect   = "COLLECT"
+	DMNHitPolicyRuleOrder = "RULE ORDER"
+)
+
+// DMN COLLECT 聚合方式
+const (
+	DMNAggregationSum   = "SUM"
+	DMNAggregationMin   = "MIN"
+	DMNAggregationMax   = "MAX"
+	DMNAggregationCount = "COUNT"
+)
+
+// DMNDefinitions DMN 定义根元素
+type DMNDefinitions struct {
+	XMLName   xml.Name       `xml:"definitions"`
+	ID        string         `xml:"id,attr"`
+	Name      string         `xml:"name,attr"`
+	Namespace string         `xml:"namespace,attr"`
+	Decisions []*DMNDecision `xml:"decision"`
+}
+
+// DMNDecision 决策
+type DMNDecision struct {
+	ID                string                `xml:"id,attr"`
+	Name              string                `xml:"name,attr"`
+	DecisionTable     *DMNDecisionTable     `xml:"decisionTable"`
+	LiteralExpression *DMNLiteralExpression `xml:"literalExpression"`
+}
+
+// DMNDecisionTable 决策表
+type DMNDecisionTable struct {
+	ID          string       `xml:"id,attr"`
+	HitPolicy   string       `xml:"hitPolicy,attr"`
+	Aggregation string       `xml:"aggregation,attr"`
+	Inputs      []*DMNInput  `xml:"input"`
+	Outputs     []*DMNOutput `xml:"output"`
+	Rules       []*DMNRule   `xml:"rule"`
+}
+
+// DMNInput 决策表输入列
+type DMNInput struct {
+	ID              string               `xml:"id,attr"`
+	Label           string               `xml:"label,attr"`
+	InputExpression DMNLiteralExpression `xml:"inputExpression"`
+}
+
+// DMNOutput 决策表输出列
+type DMNOutput struct {
+	ID      string `xml:"id,attr"`
+	Label   string `xml:"label,attr"`
+	Name    string `xml:"name,attr"`
+	TypeRef string `xml:"typeRef,attr"`
+}
+
+// DMNRule 决策表规则（一行）
+type DMNRule struct {
+	ID            string                 `xml:"id,attr"`
+	Description   string                 `xml:"description"`
+	InputEntries  []DMNLiteralExpression `xml:"inputEntry"`
+	OutputEntries []DMNLiteralExpression `xml:"outputEntry"`
+}
+
+// DMNLiteralExpression 字面量表达式 / 一元测试单元格
+type DMNLiteralExpression struct {
+	ID      string `xml:"id,attr"`
+	TypeRef string `xml:"typeRef,attr"`
+	Text    string `xml:"text"`
+}
+
+// HitPolicyOrDefault 返回规范化后的命中策略，未声明时为 UNIQUE
+func (t *DMNDecisionTable) HitPolicyOrDefault() string {
+	policy := strings.ToUpper(strings.Join(strings.Fields(t.HitPolicy), " "))
+	if policy == "" {
+		return DMNHitPolicyUnique
+	}
+	return policy
+}
+
+// SingleResult 命中策略是否只产生一条结果（单命中策略或带聚合的 COLLECT）
+func (t *DMNDecisionTable) SingleResult() bool {
+	switch t.HitPolicyOrDefault() {
+	case DMNHitPolicyUnique, DMNHitPolicyFirst, DMNHitPolicyAny:
+		return true
+	}
+	return t.Aggregation != ""
+}
+
+// OutputName 输出列写入结果时使用的名称：name > label > id
+func (o *DMNOutput) OutputName() string {
+	if o.Name != "" {
+		return o.Name
+	}
+	if o.Label != "" {
+		return o.Label
+	}
+	return o.ID
+}
+
+// ParseDMNXML 解析并校验 DMN XML
+func ParseDMNXML(data []byte) (*DMNDefinitions, error) {
+	var definitions DMNDefinitions
+	if err := xml.Unmarshal(data, &definitions); err != nil {
+		return nil, fmt.Errorf("DMN XML解析失败: %w", err)
+	}
+	if len(definitions.Decisions) == 0 {
+		return nil, fmt.Errorf("DMN 中未定义任何决策")
+	}
+	seen := make(map[string]bool, len(definitions.Decisions))
+	for _, decision := range definitions.Decisions {
+		if err := validateDMNDecision(decision); err != nil {
+			return nil, err
+		}
+		if seen[decision.ID] {
+			return nil, fmt.Errorf("决策ID重复: %s", decision.ID)
+		}
+		seen[decision.ID] = true
+	}
+	return &definitions, nil
+}
+
+// Decision 按ID查找决策
+func (d *DMNDefinitions) Decision(id string) *DMNDecision {
+	for _, decision := range d.Decisions {
+		if decision.ID == id {
+			return decision
+		}
+	}
+	return nil
+}
+
+func validateDMNDecision(decision *DMNDecision) error {
+	if decision.ID == "" {
+		return fmt.Errorf("决策缺少id")
+	}
+	table := decision.DecisionTable
+	if table == nil {
+		return fmt.Errorf("决策 %s 不是决策表，当前仅支持决策表", decision.ID)
+	}
+	switch table.HitPolicyOrDefault() {
+	case DMNHitPolicyUnique, DMNHitPolicyFirst, DMNHitPolicyAny, DMNHitPolicyRuleOrder:
+		if table.Aggregation != "" {
+			return fmt.Errorf("决策 %s: 只有 COLLECT 命中策略可以声明聚合方式", decision.ID)
+		}
+	case DMNHitPolicyCollect:
+		switch table.Aggregation {
+		case "", DMNAggregationSum, DMNAggregationMin, DMNAggregationMax, DMNAggregationCount:
+		default:
+			return fmt.Errorf("决策 %s: 不支持的聚合方式 %s", decision.ID, table.Aggregation)
+		}
+		if table.Aggregation != "" && len(table.Outputs) != 1 {
+			return fmt.Errorf("决策 %s: 带聚合的 COLLECT 决策表只能有一个输出列", decision.ID)
+		}
+	default:
+		return fmt.Errorf("决策 %s: 不支持的命中策略 %s", decision.ID, table.HitPolicy)
+	}
+	if len(table.Outputs) == 0 {
+		return fmt.Errorf("决策 %s 未定义输出列", decision.ID)
+	}
+	names := make(map[string]bool, len(table.Outputs))
+	for _, output := range table.Outputs {
+		name := output.OutputName()
+		if name == "" {
+			return fmt.Errorf("决策 %s 的输出列缺少name", decision.ID)
+		}
+		if names[name] {
+			return fmt.Errorf("决策 %s 的输出列名称重复: %s", decision.ID, name)
+		}
+		names[name] = true
+	}
+	for i, rule := range table.Rules {
+		if len(rule.InputEntries) != len(table.Inputs) {
+			return fmt.Errorf("决策 %s 第%d条规则的输入条目数(%d)与输入列数(%d)不一致", decision.ID, i+1, len(rule.InputEntries), len(table.Inputs))
+		}
+		if len(rule.OutputEntries) != len(table.Outputs) {
+			return fmt.Errorf("决策 %s 第%d条规则的输出条目数(%d)与输出列数(%d)不一致", decision.ID, i+1, len(rule.OutputEntries), len(table.Outputs))
+		}
+	}
+	return nil
+}
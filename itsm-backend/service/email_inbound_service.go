@@ -0,0 +1,427 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"itsm-backend/common/tenantctx"
+	"itsm-backend/connector"
+	emailconn "itsm-backend/connector/builtin/email"
+	"itsm-backend/dto"
+	"itsm-backend/ent"
+	"itsm-backend/ent/inboundemail"
+	"itsm-backend/ent/predicate"
+	"itsm-backend/ent/ticket"
+	"itsm-backend/ent/ticketcategory"
+	"itsm-backend/ent/ticketcc"
+	"itsm-backend/ent/user"
+
+	"go.uber.org/zap"
+)
+
+const (
+	emailConnectorName      = "email"
+	emailTicketTitleMaxLen  = 200
+	emailTicketBodyMaxLen   = 5000
+	emailInboundListLimit   = 50
+	emailInboundListMaxSize = 200
+	// emailPendingStaleAfter 处理中记录超过该时长视为上次处理中途失败，允许重新处理
+	emailPendingStaleAfter = 10 * time.Minute
+)
+
+// EmailInboundService 邮件渠道入站处理：发件人映射、新建工单、按线程追加回复、附件入库。
+// 每封邮件以 (tenant_id, message_id) 落台账，IMAP 重复拉取与 Webhook 重试都不会重复建单。
+type EmailInboundService struct {
+	client         *ent.Client
+	logger         *zap.SugaredLogger
+	ticketService  *TicketService
+	commentService *TicketCommentService
+	attachments    *TicketAttachmentService
+	connectors     *connector.Manager
+}
+
+// NewEmailInboundService 创建邮件入站服务
+func NewEmailInboundService(client *ent.Client, ticketService *TicketService, connectors *connector.Manager, logger *zap.SugaredLogger) *EmailInboundService {
+	return &EmailInboundService{
+		client:         client,
+		logger:         logger,
+		ticketService:  ticketService,
+		commentService: NewTicketCommentService(client, logger),
+		connectors:     connectors,
+	}
+}
+
+// SetCommentService 注入评论服务（复用已配置通知的实例）
+func (s *EmailInboundService) SetCommentService(commentService *TicketCommentService) {
+	if commentService != nil {
+		s.commentService = commentService
+	}
+}
+
+// SetAttachmentService 注入附件服务；未注入时邮件附件不入库
+func (s *EmailInboundService) SetAttachmentService(attachments *TicketAttachmentService) {
+	s.attachments = attachments
+}
+
+// HandleInbound 处理连接器解析出的入站邮件
+func (s *EmailInboundService) HandleInbound(ctx context.Context, tenantID int, mailbox emailconn.Mailbox, msg *connector.InboundMessage) (*ent.InboundEmail, error) {
+	if msg == nil {
+		return nil, fmt.Errorf("入站消息为空")
+	}
+	mail, ok := msg.Extras["email"].(*emailconn.Message)
+	if !ok || mail == nil {
+		return nil, fmt.Errorf("入站消息不是邮件")
+	}
+	return s.Process(ctx, tenantID, mailbox, mail)
+}
+
+// Process 处理一封入站邮件，返回台账记录。重复投递直接返回已有记录。
+func (s *EmailInboundService) Process(ctx context.Context, tenantID int, mailbox emailconn.Mailbox, mail *emailconn.Message) (*ent.InboundEmail, error) {
+	record, fresh, err := s.claim(ctx, tenantID, mailbox, mail)
+	if err != nil || !fresh {
+		return record, err
+	}
+
+	if reason := mail.IgnoreReason(mailbox.Address); reason != "" {
+		return s.finish(ctx, record.Update().SetStatus(inboundemail.StatusIgnored).SetReason(reason))
+	}
+	sender, known, err := s.resolveSender(ctx, tenantID, mailbox, mail.From.Address)
+	if err != nil {
+		return s.fail(ctx, record, err)
+	}
+	if sender == nil {
+		return s.finish(ctx, record.Update().SetStatus(inboundemail.StatusIgnored).SetReason("unknown_sender"))
+	}
+
+	// 只有按地址识别出的用户才能回复到已有工单，兜底报告人收到的陌生邮件一律新建工单
+	if known {
+		target, err := s.resolveThread(ctx, tenantID, mailbox, mail)
+		if err != nil {
+			return s.fail(ctx, record, err)
+		}
+		if target != nil {
+			allowed, err := s.canReply(ctx, tenantID, sender, target)
+			if err != nil {
+				return s.fail(ctx, record, err)
+			}
+			if allowed {
+				return s.reply(ctx, record, tenantID, sender, target, mail)
+			}
+			s.logger.Infow("Email reply sender is not a ticket participant, creating new ticket",
+				"tenant_id", tenantID, "ticket_id", target.ID, "from", mail.From.Address)
+		}
+	}
+	return s.create(ctx, record, tenantID, mailbox, sender, mail)
+}
+
+// claim 以唯一索引抢占台账记录；已处理完成或正在处理中的邮件返回 fresh=false
+func (s *EmailInboundService) claim(ctx context.Context, tenantID int, mailbox emailconn.Mailbox, mail *emailconn.Message) (*ent.InboundEmail, bool, error) {
+	create := s.client.InboundEmail.Create().
+		SetTenantID(tenantID).
+		SetMailbox(mailbox.Address).
+		SetMessageID(truncateRunes(mail.MessageID, 255)).
+		SetInReplyTo(truncateRunes(mail.InReplyTo, 255)).
+		SetReferences(mail.References).
+		SetFromAddress(mail.From.Address).
+		SetFromName(truncateRunes(mail.From.Name, 255)).
+		SetSubject(truncateRunes(mail.Subject, 255)).
+		SetStatus(inboundemail.StatusPending)
+	if !mail.Date.IsZero() {
+		create.SetReceivedAt(mail.Date)
+	}
+	record, err := create.Save(ctx)
+	if err == nil {
+		return record, true, nil
+	}
+	if !ent.IsConstraintError(err) {
+		return nil, false, fmt.Errorf("记录入站邮件失败: %w", err)
+	}
+	existing, err := s.client.InboundEmail.Query().
+		Where(inboundemail.TenantID(tenantID), inboundemail.MessageID(truncateRunes(mail.MessageID, 255))).
+		Only(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("查询入站邮件失败: %w", err)
+	}
+	retry := existing.Status == inboundemail.StatusFailed ||
+		(existing.Status == inboundemail.StatusPending && time.Since(existing.CreatedAt) > emailPendingStaleAfter)
+	if !retry {
+		return existing, false, nil
+	}
+	existing, err = existing.Update().SetStatus(inboundemail.StatusPending).ClearReason().Save(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("重置入站邮件失败: %w", err)
+	}
+	return existing, true, nil
+}
+
+// resolveSender 按发件地址映射租户内启用用户；未匹配时使用邮箱配置的兜底报告人（known=false）
+func (s *EmailInboundService) resolveSender(ctx context.Context, tenantID int, mailbox emailconn.Mailbox, address string) (*ent.User, bool, error) {
+	if address != "" {
+		u, err := s.client.User.Query().
+			Where(user.TenantID(tenantID), user.EmailEqualFold(address), user.Active(true)).
+			First(ctx)
+		if err == nil {
+			return u, true, nil
+		}
+		if !ent.IsNotFound(err) {
+			return nil, false, fmt.Errorf("查询发件人失败: %w", err)
+		}
+	}
+	if mailbox.DefaultRequesterID <= 0 {
+		return nil, false, nil
+	}
+	u, err := s.client.User.Query().
+		Where(user.ID(mailbox.DefaultRequesterID), user.TenantID(tenantID), user.Active(true)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("查询兜底报告人失败: %w", err)
+	}
+	return u, false, nil
+}
+
+// resolveThread 依次按 In-Reply-To/References 命中的历史邮件、本系统出站 Message-ID、主题 [TICKET-xxx] 标签定位工单
+func (s *EmailInboundService) resolveThread(ctx context.Context, tenantID int, mailbox emailconn.Mailbox, mail *emailconn.Message) (*ent.Ticket, error) {
+	refs := make([]string, 0, len(mail.References)+1)
+	if mail.InReplyTo != "" {
+		refs = append(refs, mail.InReplyTo)
+	}
+	for i := len(mail.References) - 1; i >= 0; i-- {
+		refs = append(refs, mail.References[i])
+	}
+	if len(refs) > 0 {
+		prior, err := s.client.InboundEmail.Query().
+			Where(
+				inboundemail.TenantID(tenantID),
+				inboundemail.MessageIDIn(refs...),
+				inboundemail.TicketIDNotNil(),
+			).
+			Order(ent.Desc(inboundemail.FieldReceivedAt)).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, fmt.Errorf("查询邮件线程失败: %w", err)
+		}
+		if prior != nil {
+			if t, err := s.tenantTicket(ctx, tenantID, ticket.ID(*prior.TicketID)); t != nil || err != nil {
+				return t, err
+			}
+		}
+		domain := mailbox.Address[strings.LastIndex(mailbox.Address, "@")+1:]
+		for _, ref := range refs {
+			if id := emailconn.TicketIDFromMessageID(ref, domain); id > 0 {
+				if t, err := s.tenantTicket(ctx, tenantID, ticket.ID(id)); t != nil || err != nil {
+					return t, err
+				}
+			}
+		}
+	}
+	tag := emailconn.TicketTag(mail.Subject)
+	if tag == "" {
+		return nil, nil
+	}
+	if id, err := strconv.Atoi(tag); err == nil {
+		return s.tenantTicket(ctx, tenantID, ticket.ID(id))
+	}
+	return s.tenantTicket(ctx, tenantID, ticket.TicketNumber(tag))
+}
+
+func (s *EmailInboundService) tenantTicket(ctx context.Context, tenantID int, where ...predicate.Ticket) (*ent.Ticket, error) {
+	t, err := s.client.Ticket.Query().Where(append(where, ticket.TenantID(tenantID))...).First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询工单失败: %w", err)
+	}
+	return t, nil
+}
+
+// canReply 回复者须为工单报告人、处理人、抄送人或服务台人员，防止伪造标签向他人工单写入
+func (s *EmailInboundService) canReply(ctx context.Context, tenantID int, sender *ent.User, t *ent.Ticket) (bool, error) {
+	if t.RequesterID == sender.ID || (t.AssigneeID > 0 && t.AssigneeID == sender.ID) {
+		return true, nil
+	}
+	switch string(sender.Role) {
+	case "super_admin", "admin", "manager", "agent", "technician", "security":
+		return true, nil
+	}
+	cc, err := s.client.TicketCC.Query().
+		Where(ticketcc.TenantID(tenantID), ticketcc.TicketID(t.ID), ticketcc.UserID(sender.ID), ticketcc.IsActive(true)).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("查询工单抄送人失败: %w", err)
+	}
+	return cc, nil
+}
+
+// reply 把去掉引用与签名的回复正文追加为工单评论，附件挂到该评论
+func (s *EmailInboundService) reply(ctx context.Context, record *ent.InboundEmail, tenantID int, sender *ent.User, t *ent.Ticket, mail *emailconn.Message) (*ent.InboundEmail, error) {
+	attachmentIDs := s.saveAttachments(ctx, tenantID, t.ID, sender.ID, mail)
+	content := truncateRunes(emailconn.StripReply(mail.Text), emailTicketBodyMaxLen)
+	if content == "" {
+		content = "（邮件回复无正文）"
+	}
+	comment, err := s.commentService.CreateTicketComment(ctx, t.ID, &dto.CreateTicketCommentRequest{
+		Content:     content,
+		Attachments: attachmentIDs,
+	}, sender.ID, tenantID)
+	if err != nil {
+		return s.fail(ctx, record, err)
+	}
+	s.logger.Infow("Email reply threaded onto ticket", "tenant_id", tenantID, "ticket_id", t.ID, "from", mail.From.Address)
+	return s.finish(ctx, record.Update().
+		SetStatus(inboundemail.StatusThreaded).
+		SetTicketID(t.ID).
+		SetCommentID(comment.ID).
+		SetUserID(sender.ID).
+		SetAttachmentCount(len(attachmentIDs)))
+}
+
+// create 以邮件主题与正文新建工单，附件挂到工单
+func (s *EmailInboundService) create(ctx context.Context, record *ent.InboundEmail, tenantID int, mailbox emailconn.Mailbox, sender *ent.User, mail *emailconn.Message) (*ent.InboundEmail, error) {
+	title := truncateRunes(emailconn.CleanSubject(mail.Subject), emailTicketTitleMaxLen)
+	if utf8.RuneCountInString(title) < 2 {
+		title = truncateRunes("来自邮件的请求: "+mail.From.Address, emailTicketTitleMaxLen)
+	}
+	priority := mailbox.DefaultPriority
+	if priority == "" {
+		priority = "medium"
+	}
+	req := &dto.CreateTicketRequest{
+		Title:       title,
+		Description: truncateRunes(mail.Text, emailTicketBodyMaxLen),
+		Priority:    priority,
+		Type:        mailbox.DefaultType,
+		RequesterID: sender.ID,
+		FormFields: map[string]interface{}{
+			"source":           "email",
+			"email_message_id": mail.MessageID,
+			"email_from":       mail.From.Address,
+			"email_mailbox":    mailbox.Address,
+		},
+	}
+	if mailbox.Category != "" {
+		if matched, err := s.client.TicketCategory.Query().
+			Where(ticketcategory.NameEQ(mailbox.Category), ticketcategory.TenantIDEQ(tenantID), ticketcategory.IsActiveEQ(true)).
+			First(ctx); err == nil {
+			req.CategoryID = &matched.ID
+		}
+	}
+	t, err := s.ticketService.CreateTicket(ctx, req, tenantID)
+	if err != nil {
+		return s.fail(ctx, record, err)
+	}
+	attachmentIDs := s.saveAttachments(ctx, tenantID, t.ID, sender.ID, mail)
+	s.logger.Infow("Ticket created from email", "tenant_id", tenantID, "ticket_id", t.ID, "from", mail.From.Address)
+	return s.finish(ctx, record.Update().
+		SetStatus(inboundemail.StatusCreated).
+		SetTicketID(t.ID).
+		SetUserID(sender.ID).
+		SetAttachmentCount(len(attachmentIDs)))
+}
+
+// saveAttachments 保存非内嵌附件；单个附件被拒（类型、大小、扫描）只记日志，不影响邮件处理
+func (s *EmailInboundService) saveAttachments(ctx context.Context, tenantID, ticketID, userID int, mail *emailconn.Message) []int {
+	if s.attachments == nil {
+		return nil
+	}
+	ids := make([]int, 0, len(mail.Attachments))
+	for _, att := range mail.Attachments {
+		if att.Inline && mail.HTML != "" {
+			continue
+		}
+		saved, err := s.attachments.UploadAttachment(ctx, ticketID, &FileHeader{
+			Filename:    att.Filename,
+			Size:        int64(len(att.Data)),
+			ContentType: att.ContentType,
+			Reader:      bytes.NewReader(att.Data),
+		}, userID, tenantID)
+		if err != nil {
+			s.logger.Warnw("Email attachment rejected", "tenant_id", tenantID, "ticket_id", ticketID,
+				"file_name", att.Filename, "error", err)
+			continue
+		}
+		ids = append(ids, saved.ID)
+	}
+	return ids
+}
+
+func (s *EmailInboundService) finish(ctx context.Context, update *ent.InboundEmailUpdateOne) (*ent.InboundEmail, error) {
+	record, err := update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("更新入站邮件记录失败: %w", err)
+	}
+	return record, nil
+}
+
+func (s *EmailInboundService) fail(ctx context.Context, record *ent.InboundEmail, cause error) (*ent.InboundEmail, error) {
+	if _, err := record.Update().SetStatus(inboundemail.StatusFailed).SetReason(truncateRunes(cause.Error(), 500)).Save(ctx); err != nil {
+		s.logger.Warnw("Failed to mark inbound email failed", "id", record.ID, "error", err)
+	}
+	return nil, cause
+}
+
+// PollMailboxes 轮询所有已配置 IMAP 的邮件连接器实例，返回成功处理的邮件数
+func (s *EmailInboundService) PollMailboxes(ctx context.Context) (int, error) {
+	if s.connectors == nil {
+		return 0, nil
+	}
+	ctx = tenantctx.SystemContext(ctx, "email:poll", "poll inbound mailboxes of provisioned email connectors")
+	total := 0
+	for _, inst := range s.connectors.Instances(emailConnectorName) {
+		conn, ok := inst.Connector.(*emailconn.Email)
+		if !ok || !conn.PollingEnabled() {
+			continue
+		}
+		tenantID := inst.Config.TenantID
+		tenantCtx := tenantctx.WithTenantID(ctx, tenantID)
+		mailbox := conn.Mailbox()
+		n, err := conn.Poll(tenantCtx, func(ctx context.Context, msg *connector.InboundMessage) error {
+			_, err := s.HandleInbound(ctx, tenantID, mailbox, msg)
+			if err != nil {
+				s.logger.Warnw("Inbound email processing failed", "tenant_id", tenantID, "mailbox", mailbox.Address,
+					"message_id", msg.MessageID, "error", err)
+			}
+			return err
+		})
+		total += n
+		if err != nil {
+			s.logger.Warnw("Mailbox poll failed", "tenant_id", tenantID, "mailbox", mailbox.Address, "error", err)
+		}
+	}
+	return total, nil
+}
+
+// ListInboundEmails 查询租户入站邮件台账（按接收时间倒序）
+func (s *EmailInboundService) ListInboundEmails(ctx context.Context, tenantID int, status string, limit int) ([]*ent.InboundEmail, error) {
+	if limit <= 0 {
+		limit = emailInboundListLimit
+	}
+	if limit > emailInboundListMaxSize {
+		limit = emailInboundListMaxSize
+	}
+	query := s.client.InboundEmail.Query().Where(inboundemail.TenantID(tenantID))
+	if status != "" {
+		st := inboundemail.Status(status)
+		if err := inboundemail.StatusValidator(st); err != nil {
+			return nil, fmt.Errorf("无效的状态: %s", status)
+		}
+		query = query.Where(inboundemail.StatusEQ(st))
+	}
+	return query.Order(ent.Desc(inboundemail.FieldReceivedAt), ent.Desc(inboundemail.FieldID)).Limit(limit).All(ctx)
+}
+
+func truncateRunes(s string, max int) string {
+	s = strings.TrimSpace(s)
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	return strings.TrimSpace(string([]rune(s)[:max]))
+}
@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	emailconn "itsm-backend/connector/builtin/email"
+	"itsm-backend/ent"
+	"itsm-backend/ent/enttest"
+	"itsm-backend/ent/inboundemail"
+	entTicket "itsm-backend/ent/ticket"
+	"itsm-backend/ent/ticketattachment"
+	"itsm-backend/ent/ticketcomment"
+	"itsm-backend/ent/user"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestEmailInboundProcessing(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", testDSN())
+	defer client.Close()
+	ctx := context.Background()
+	logger := zaptest.NewLogger(t).Sugar()
+
+	tenant, err := client.Tenant.Create().SetName("Mail Tenant").SetCode("mail").SetDomain("mail.com").SetStatus("active").Save(ctx)
+	require.NoError(t, err)
+	newUser := func(name, role string) *ent.User {
+		u, err := client.User.Create().
+			SetUsername(name).
+			SetEmail(name + "@customer.com").
+			SetName(name).
+			SetPasswordHash("hashedpassword").
+			SetRole(user.Role(role)).
+			SetActive(true).
+			SetTenantID(tenant.ID).
+			Save(ctx)
+		require.NoError(t, err)
+		return u
+	}
+	alice := newUser("alice", "end_user")
+	bob := newUser("bob", "end_user")
+	agent := newUser("agent", "agent")
+	catchAll := newUser("mailbot", "end_user")
+
+	svc := NewEmailInboundService(client, NewTicketServiceForTest(client, logger), nil, logger)
+	attachments := NewTicketAttachmentService(client, logger)
+	attachments.uploadDir = t.TempDir()
+	svc.SetAttachmentService(attachments)
+	mailbox := emailconn.Mailbox{Address: "support@itsm.example.com"}
+
+	process := func(t *testing.T, mb emailconn.Mailbox, raw string) *ent.InboundEmail {
+		mail, err := emailconn.Parse([]byte(strings.ReplaceAll(raw, "\n", "\r\n")))
+		require.NoError(t, err)
+		record, err := svc.Process(ctx, tenant.ID, mb, mail)
+		require.NoError(t, err)
+		return record
+	}
+	ticketCount := func(t *testing.T) int {
+		n, err := client.Ticket.Query().Where(entTicket.TenantID(tenant.ID)).Count(ctx)
+		require.NoError(t, err)
+		return n
+	}
+
+	var ticketID int
+	t.Run("new email creates ticket with attachment", func(t *testing.T) {
+		record := process(t, mailbox, `From: Alice <ALICE@customer.com>
+To: support@itsm.example.com
+Subject: =?UTF-8?B?VlBOIOaXoOazlei/nuaOpQ==?=
+Message-ID: <m1@customer.com>
+MIME-Version: 1.0
+Content-Type: multipart/mixed; boundary="b1"
+
+--b1
+Content-Type: text/plain; charset=UTF-8
+
+从今天早上开始 VPN 一直连接超时。
+
+--
+Alice
+--b1
+Content-Type: application/pdf; name="vpn.pdf"
+Content-Disposition: attachment; filename="vpn.pdf"
+Content-Transfer-Encoding: base64
+
+JVBERi0xLjQKJUVPRgo=
+--b1--
+`)
+		assert.Equal(t, inboundemail.StatusCreated, record.Status)
+		require.NotNil(t, record.TicketID)
+		assert.Equal(t, alice.ID, *record.UserID)
+		assert.Equal(t, 1, record.AttachmentCount)
+		ticketID = *record.TicketID
+
+		tk, err := client.Ticket.Get(ctx, ticketID)
+		require.NoError(t, err)
+		assert.Equal(t, "VPN 无法连接", tk.Title)
+		assert.Equal(t, alice.ID, tk.RequesterID)
+		assert.Contains(t, tk.Description, "VPN 一直连接超时")
+		assert.Equal(t, "email", tk.FormFields["source"])
+		assert.Equal(t, "m1@customer.com", tk.FormFields["email_message_id"])
+		att, err := client.TicketAttachment.Query().Where(ticketattachment.TicketID(ticketID)).Only(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "vpn.pdf", att.FileName)
+	})
+
+	t.Run("redelivery is deduplicated", func(t *testing.T) {
+		before := ticketCount(t)
+		record := process(t, mailbox, "From: alice@customer.com\nSubject: VPN 无法连接\nMessage-ID: <m1@customer.com>\n\nagain\n")
+		assert.Equal(t, inboundemail.StatusCreated, record.Status)
+		assert.Equal(t, ticketID, *record.TicketID)
+		assert.Equal(t, before, ticketCount(t))
+	})
+
+	t.Run("reply threads via In-Reply-To and strips quoted text", func(t *testing.T) {
+		before := ticketCount(t)
+		record := process(t, mailbox, `From: alice@customer.com
+Subject: Re: VPN 无法连接
+Message-ID: <m2@customer.com>
+In-Reply-To: <m1@customer.com>
+References: <m1@customer.com>
+Content-Type: text/plain; charset=UTF-8
+
+重启电脑后还是不行。
+
+On Mon, Oct 12, 2026 at 9:00 AM Support <support@itsm.example.com> wrote:
+> 请尝试重启电脑
+`)
+		assert.Equal(t, inboundemail.StatusThreaded, record.Status)
+		assert.Equal(t, ticketID, *record.TicketID)
+		assert.Equal(t, before, ticketCount(t))
+		comment, err := client.TicketComment.Get(ctx, *record.CommentID)
+		require.NoError(t, err)
+		assert.Equal(t, "重启电脑后还是不行。", comment.Content)
+		assert.Equal(t, alice.ID, comment.UserID)
+		assert.False(t, comment.IsInternal)
+	})
+
+	t.Run("agent reply threads via subject tag", func(t *testing.T) {
+		record := process(t, mailbox, "From: agent@customer.com\nSubject: RE: [TICKET-"+strconv.Itoa(ticketID)+"] VPN 无法连接\nMessage-ID: <m3@customer.com>\n\n已重置 VPN 账号，请再试一次。\n\n发自我的iPhone\n")
+		assert.Equal(t, inboundemail.StatusThreaded, record.Status)
+		assert.Equal(t, ticketID, *record.TicketID)
+		assert.Equal(t, agent.ID, *record.UserID)
+		comments, err := client.TicketComment.Query().Where(ticketcomment.TicketID(ticketID)).Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, comments)
+	})
+
+	t.Run("reply to outbound notification threads via Message-ID", func(t *testing.T) {
+		outbound := emailconn.OutboundMessageID(ticketID, "abcdef0123456789", "itsm.example.com")
+		record := process(t, mailbox, "From: alice@customer.com\nSubject: Re: 工单已更新\nMessage-ID: <m4@customer.com>\nIn-Reply-To: <"+outbound+">\n\n好的，谢谢\n")
+		assert.Equal(t, inboundemail.StatusThreaded, record.Status)
+		assert.Equal(t, ticketID, *record.TicketID)
+	})
+
+	t.Run("non-participant tag reply opens a new ticket", func(t *testing.T) {
+		before := ticketCount(t)
+		record := process(t, mailbox, "From: bob@customer.com\nSubject: Re: [TICKET-"+strconv.Itoa(ticketID)+"] VPN 无法连接\nMessage-ID: <m5@customer.com>\n\n我也连不上\n")
+		assert.Equal(t, inboundemail.StatusCreated, record.Status)
+		assert.NotEqual(t, ticketID, *record.TicketID)
+		assert.Equal(t, bob.ID, *record.UserID)
+		assert.Equal(t, before+1, ticketCount(t))
+	})
+
+	t.Run("auto replies and loops are ignored", func(t *testing.T) {
+		before := ticketCount(t)
+		cases := map[string]string{
+			"auto_submitted": "From: alice@customer.com\nSubject: Out of office\nAuto-Submitted: auto-replied\nMessage-ID: <auto1@customer.com>\n\nI am away\n",
+			"auto_reply":     "From: alice@customer.com\nSubject: 自动回复: VPN 无法连接\nMessage-ID: <auto2@customer.com>\n\n休假中\n",
+			"loop_header":    "From: alice@customer.com\nSubject: hello\nX-ITSM-Loop: support@itsm.example.com\nMessage-ID: <auto3@customer.com>\n\nhi\n",
+			"loop_self":      "From: support@itsm.example.com\nSubject: hello\nMessage-ID: <auto4@customer.com>\n\nhi\n",
+			"system_sender":  "From: MAILER-DAEMON@customer.com\nSubject: failure notice\nMessage-ID: <auto5@customer.com>\n\nbounced\n",
+			"bulk":           "From: alice@customer.com\nSubject: newsletter\nPrecedence: bulk\nMessage-ID: <auto6@customer.com>\n\nnews\n",
+		}
+		for reason, raw := range cases {
+			record := process(t, mailbox, raw)
+			assert.Equal(t, inboundemail.StatusIgnored, record.Status, reason)
+			assert.Equal(t, reason, record.Reason, reason)
+		}
+		assert.Equal(t, before, ticketCount(t))
+	})
+
+	t.Run("unknown sender ignored unless a default requester is configured", func(t *testing.T) {
+		raw := "From: stranger@elsewhere.com\nSubject: Re: [TICKET-" + strconv.Itoa(ticketID) + "] 打印机坏了\nMessage-ID: <s1@elsewhere.com>\n\n三楼打印机卡纸\n"
+		record := process(t, mailbox, raw)
+		assert.Equal(t, inboundemail.StatusIgnored, record.Status)
+		assert.Equal(t, "unknown_sender", record.Reason)
+
+		withDefault := mailbox
+		withDefault.DefaultRequesterID = catchAll.ID
+		withDefault.DefaultPriority = "high"
+		raw = strings.Replace(raw, "<s1@", "<s2@", 1)
+		record = process(t, withDefault, raw)
+		assert.Equal(t, inboundemail.StatusCreated, record.Status)
+		// 陌生发件人即便带了标签也不能回复到已有工单
+		assert.NotEqual(t, ticketID, *record.TicketID)
+		tk, err := client.Ticket.Get(ctx, *record.TicketID)
+		require.NoError(t, err)
+		assert.Equal(t, catchAll.ID, tk.RequesterID)
+		assert.Equal(t, "打印机坏了", tk.Title)
+		assert.EqualValues(t, "high", tk.Priority)
+		assert.Equal(t, "stranger@elsewhere.com", tk.FormFields["email_from"])
+	})
+
+	t.Run("failed processing is retried on redelivery", func(t *testing.T) {
+		bad := mailbox
+		bad.DefaultPriority = "bogus"
+		raw := "From: alice@customer.com\nSubject: 新需求\nMessage-ID: <f1@customer.com>\n\n申请一台显示器\n"
+		mail, err := emailconn.Parse([]byte(raw))
+		require.NoError(t, err)
+		_, err = svc.Process(ctx, tenant.ID, bad, mail)
+		require.Error(t, err)
+		failed, err := client.InboundEmail.Query().Where(inboundemail.MessageID("f1@customer.com")).Only(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, inboundemail.StatusFailed, failed.Status)
+
+		record := process(t, mailbox, raw)
+		assert.Equal(t, failed.ID, record.ID)
+		assert.Equal(t, inboundemail.StatusCreated, record.Status)
+	})
+
+	t.Run("list is tenant scoped and filterable", func(t *testing.T) {
+		all, err := svc.ListInboundEmails(ctx, tenant.ID, "", 0)
+		require.NoError(t, err)
+		assert.NotEmpty(t, all)
+		ignored, err := svc.ListInboundEmails(ctx, tenant.ID, "ignored", 0)
+		require.NoError(t, err)
+		for _, r := range ignored {
+			assert.Equal(t, inboundemail.StatusIgnored, r.Status)
+		}
+		other, err := svc.ListInboundEmails(ctx, tenant.ID+1, "", 0)
+		require.NoError(t, err)
+		assert.Empty(t, other)
+		_, err = svc.ListInboundEmails(ctx, tenant.ID, "bogus", 0)
+		assert.Error(t, err)
+	})
+}
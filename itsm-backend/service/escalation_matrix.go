@@ -18,7 +18,9 @@ type EscalationLevel struct {
 	AfterMinutes  int      `json:"afterMinutes"`  // 距离告警开始多少分钟后触发
 	NotifyRoles   []string `json:"notifyRoles"`   // 通知角色名（manager/team_lead/director）
 	NotifyUserIDs []int    `json:"notifyUserIDs"` // 通知具体用户 ID
-	Description   string   `json:"description"`   // 描述（用于审计/通知内容）
+	// NotifyScheduleIDs 通知值班表在升级时刻的值班人
+	NotifyScheduleIDs []int  `json:"notifyScheduleIDs,omitempty"`
+	Description       string `json:"description"` // 描述（用于审计/通知内容）
 }
 
 // EscalationMatrix 优先级 → 升级级别链
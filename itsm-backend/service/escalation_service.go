@@ -11,6 +11,7 @@ import (
 	"itsm-backend/ent/slaalertrule"
 	"itsm-backend/ent/ticket"
 	"itsm-backend/ent/user"
+	"itsm-backend/service/oncall"
 
 	"go.uber.org/zap"
 )
@@ -210,10 +211,10 @@ func (e *EscalationService) escalateToLevelByMatrix(
 // resolveNotifyUsers 解析升级级别的通知用户
 //
 // 输入：
-//   - level: 矩阵级别配置（含 NotifyRoles 和 NotifyUserIDs）
+//   - level: 矩阵级别配置（含 NotifyRoles、NotifyUserIDs 和 NotifyScheduleIDs）
 //   - tenantID: 租户 ID
 //
-// 输出：合并后的 userIDs（先去 NotifyUserIDs，再按 NotifyRoles 查询）
+// 输出：合并后的 userIDs（先去 NotifyUserIDs，再按 NotifyRoles 查询，最后取各值班表当前值班人）
 func (e *EscalationService) resolveNotifyUsers(ctx context.Context, level *EscalationLevel, tenantID int) []int {
 	if level == nil {
 		return nil
@@ -240,6 +241,17 @@ func (e *EscalationService) resolveNotifyUsers(ctx context.Context, level *Escal
 				}
 			}
 		}
+		for _, scheduleID := range level.NotifyScheduleIDs {
+			shift, err := oncall.Current(ctx, e.client, tenantID, scheduleID, time.Now())
+			if err != nil {
+				e.logger.Warnw("Failed to resolve on-call for escalation", "schedule_id", scheduleID, "error", err)
+				continue
+			}
+			if !seen[shift.UserID] {
+				out = append(out, shift.UserID)
+				seen[shift.UserID] = true
+			}
+		}
 	}
 	return out
 }
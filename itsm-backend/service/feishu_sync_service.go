@@ -6,6 +6,8 @@ import (
 	"strings"
 	"time"
 
+	"itsm-backend/common/tenantctx"
+	"itsm-backend/connector"
 	feishuConn "itsm-backend/connector/builtin/feishu"
 	"itsm-backend/dto"
 	"itsm-backend/ent"
@@ -16,15 +18,56 @@ import (
 	"go.uber.org/zap"
 )
 
+// FeishuInboundHandlerName 飞书任务事件同步在入站路由中的处理器名，写入入站日志供重试与重放定位
+const FeishuInboundHandlerName = "feishu_task_sync"
+
 type FeishuSyncService struct {
-	client *ent.Client
-	logger *zap.SugaredLogger
+	client     *ent.Client
+	connectors *connector.Manager
+	logger     *zap.SugaredLogger
 }
 
 func NewFeishuSyncService(client *ent.Client, logger *zap.SugaredLogger) *FeishuSyncService {
 	return &FeishuSyncService{client: client, logger: logger}
 }
 
+// SetConnectorManager 注入连接器管理器，入站处理器据此取租户的飞书连接器（运行时依赖注入）
+func (s *FeishuSyncService) SetConnectorManager(m *connector.Manager) {
+	s.connectors = m
+}
+
+// HandleInbound 实现 connector.InboundHandler：把飞书任务事件同步为工单，其余消息直接忽略
+// 经入站路由派发后，失败的同步随入站日志重试，也可在管理端重放
+func (s *FeishuSyncService) HandleInbound(ctx context.Context, msg *connector.InboundMessage) error {
+	if msg == nil || msg.ConnectorName != "feishu" || msg.Type != "task_event" {
+		return nil
+	}
+	tenantID, ok := tenantctx.TenantID(ctx)
+	if !ok || tenantID <= 0 {
+		return fmt.Errorf("飞书任务事件缺少租户上下文")
+	}
+	taskData, ok := msg.Extras["task_data"].(map[string]interface{})
+	if !ok {
+		return connector.Permanent(fmt.Errorf("invalid feishu task event data"))
+	}
+	if extractFeishuTaskGUID(taskData) == "" {
+		return connector.Permanent(fmt.Errorf("missing feishu task guid"))
+	}
+	if s.connectors == nil {
+		return fmt.Errorf("connector manager not configured")
+	}
+	conn, ok := s.connectors.Get(tenantID, "feishu")
+	if !ok {
+		return fmt.Errorf("feishu connector not configured")
+	}
+	fc, ok := conn.(*feishuConn.Feishu)
+	if !ok {
+		return fmt.Errorf("feishu connector not configured")
+	}
+	_, err := s.HandleTaskEvent(ctx, tenantID, fc, msg.Content, taskData)
+	return err
+}
+
 func (s *FeishuSyncService) SyncTicketToFeishu(ctx context.Context, tenantID, ticketID int, fc *feishuConn.Feishu) (*dto.FeishuTicketSyncResponse, error) {
 	if s.client == nil {
 		return nil, fmt.Errorf("ent client not configured")
@@ -0,0 +1,899 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"itsm-backend/dto"
+	"itsm-backend/ent"
+	"itsm-backend/ent/configurationitem"
+	"itsm-backend/ent/knowledgearticle"
+	"itsm-backend/ent/predicate"
+	"itsm-backend/ent/searchdocument"
+	"itsm-backend/ent/ticket"
+	"itsm-backend/ent/ticketcomment"
+	"itsm-backend/internal/commandbus"
+	"itsm-backend/middleware"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"go.uber.org/zap"
+)
+
+// 全文检索实体类型
+const (
+	SearchEntityTicket            = "ticket"
+	SearchEntityTicketComment     = "ticket_comment"
+	SearchEntityKnowledgeArticle  = "knowledge_article"
+	SearchEntityConfigurationItem = "configuration_item"
+)
+
+// searchEntityResources 实体类型对应的 RBAC 资源，调用者需具备 read 权限才能检索该类实体
+var searchEntityResources = map[string]string{
+	SearchEntityTicket:            "ticket",
+	SearchEntityTicketComment:     "ticket",
+	SearchEntityKnowledgeArticle:  "knowledge",
+	SearchEntityConfigurationItem: "cmdb",
+}
+
+// searchEntityTypes 实体类型的固定顺序，用于分面输出与批量重建
+var searchEntityTypes = []string{
+	SearchEntityTicket,
+	SearchEntityTicketComment,
+	SearchEntityKnowledgeArticle,
+	SearchEntityConfigurationItem,
+}
+
+// 检索参数
+const (
+	defaultSearchLimit   = 20
+	maxSearchLimit       = 100
+	maxSearchQueryTokens = 16   // 查询分词上限，超出部分忽略
+	searchCandidateLimit = 1000 // 参与打分的候选文档上限，按源更新时间取最新
+	searchSnippetWindow  = 60
+	searchBM25K1         = 1.2
+	searchBM25B          = 0.75
+	searchTitleBoost     = 2.0
+	searchPhraseBoost    = 1.5
+	searchReindexBatch   = 200
+)
+
+// ErrUnsupportedSearchType 请求了未知的检索实体类型
+var ErrUnsupportedSearchType = errors.New("不支持的检索类型")
+
+// SearchCaller 检索调用者，用于租户隔离与可见性过滤
+type SearchCaller struct {
+	TenantID int
+	UserID   int
+	Role     string
+}
+
+// FullTextSearchService 全文检索服务。
+// 索引文档由 ent hook 在业务写入的同一事务内投递 search.index 命令，命令处理器异步刷新；
+// 检索按分词倒排匹配，BM25 打分并对标题命中加权，结果按调用者可见范围过滤。
+type FullTextSearchService struct {
+	client *ent.Client
+	logger *zap.SugaredLogger
+}
+
+// NewFullTextSearchService 创建全文检索服务
+func NewFullTextSearchService(client *ent.Client, logger *zap.SugaredLogger) *FullTextSearchService {
+	return &FullTextSearchService{client: client, logger: logger}
+}
+
+// Search 全文检索，返回按相关度排序的命中项、分面计数与总数
+func (s *FullTextSearchService) Search(ctx context.Context, req *dto.FullTextSearchRequest, caller SearchCaller) (*dto.FullTextSearchResponse, error) {
+	if caller.TenantID <= 0 {
+		return nil, fmt.Errorf("租户ID无效")
+	}
+	resp := &dto.FullTextSearchResponse{Hits: []*dto.FullTextSearchHit{}, Facets: map[string]int{}}
+	tokens := tokenizeSearchQuery(req.Query)
+	if len(tokens) == 0 {
+		return resp, nil
+	}
+	if len(tokens) > maxSearchQueryTokens {
+		tokens = tokens[:maxSearchQueryTokens]
+	}
+	types, err := s.searchableTypes(ctx, req.Types, caller)
+	if err != nil {
+		return nil, err
+	}
+	if len(types) == 0 {
+		return resp, nil
+	}
+
+	preds := []predicate.SearchDocument{
+		searchdocument.TenantID(caller.TenantID),
+		s.visibility(ctx, types, caller),
+		searchTermsMatch(tokens),
+	}
+
+	var facets []struct {
+		EntityType string `json:"entity_type"`
+		Count      int    `json:"count"`
+	}
+	if err := s.client.SearchDocument.Query().Where(preds...).
+		GroupBy(searchdocument.FieldEntityType).
+		Aggregate(ent.Count()).
+		Scan(ctx, &facets); err != nil {
+		return nil, fmt.Errorf("统计检索分面失败: %w", err)
+	}
+	for _, f := range facets {
+		resp.Facets[f.EntityType] = f.Count
+		resp.Total += f.Count
+	}
+	if resp.Total == 0 {
+		return resp, nil
+	}
+
+	docs, err := s.client.SearchDocument.Query().Where(preds...).
+		Order(ent.Desc(searchdocument.FieldSourceUpdatedAt), ent.Desc(searchdocument.FieldID)).
+		Limit(searchCandidateLimit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("检索失败: %w", err)
+	}
+	idf, err := s.inverseDocumentFrequency(ctx, caller.TenantID, tokens)
+	if err != nil {
+		return nil, err
+	}
+	scores := scoreSearchDocuments(docs, tokens, idf, req.Query)
+	sort.SliceStable(docs, func(i, j int) bool {
+		if scores[docs[i].ID] != scores[docs[j].ID] {
+			return scores[docs[i].ID] > scores[docs[j].ID]
+		}
+		return docs[i].SourceUpdatedAt.After(docs[j].SourceUpdatedAt)
+	})
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	if limit > maxSearchLimit {
+		limit = maxSearchLimit
+	}
+	offset := req.Offset
+	if offset < 0 {
+		offset = 0
+	}
+	if offset >= len(docs) {
+		return resp, nil
+	}
+	end := offset + limit
+	if end > len(docs) {
+		end = len(docs)
+	}
+	segments := splitSearchSegments(req.Query)
+	for _, doc := range docs[offset:end] {
+		resp.Hits = append(resp.Hits, toSearchHit(doc, segments, scores[doc.ID]))
+	}
+	return resp, nil
+}
+
+// searchableTypes 取请求的实体类型与调用者有 read 权限的实体类型的交集
+func (s *FullTextSearchService) searchableTypes(ctx context.Context, requested []string, caller SearchCaller) ([]string, error) {
+	wanted := make(map[string]bool)
+	for _, t := range requested {
+		for _, part := range strings.Split(t, ",") {
+			part = strings.TrimSpace(part)
+			if part == "" {
+				continue
+			}
+			if _, ok := searchEntityResources[part]; !ok {
+				return nil, fmt.Errorf("%w: %s", ErrUnsupportedSearchType, part)
+			}
+			wanted[part] = true
+		}
+	}
+	var types []string
+	allowed := make(map[string]bool)
+	for _, t := range searchEntityTypes {
+		if len(wanted) > 0 && !wanted[t] {
+			continue
+		}
+		resource := searchEntityResources[t]
+		ok, checked := allowed[resource]
+		if !checked {
+			ok = middleware.HasResourcePermission(ctx, s.client, caller.Role, resource, "read", caller.TenantID)
+			allowed[resource] = ok
+		}
+		if ok {
+			types = append(types, t)
+		}
+	}
+	return types, nil
+}
+
+// visibility 按实体类型组合可见性条件：
+//   - 工单与评论：非全量数据角色仅可见本人提交或处理的工单；内部备注仅支持人员与工单处理人可见
+//   - 知识文章：草稿仅作者与具备 knowledge:write 权限者可见
+//   - 配置项：具备 cmdb:read 即可见
+func (s *FullTextSearchService) visibility(ctx context.Context, types []string, caller SearchCaller) predicate.SearchDocument {
+	ownedOrAssigned := searchdocument.Or(searchdocument.OwnerID(caller.UserID), searchdocument.AssigneeID(caller.UserID))
+	scopeAll := isTicketDataScopeAllRole(caller.Role)
+	var branches []predicate.SearchDocument
+	for _, t := range types {
+		conds := []predicate.SearchDocument{searchdocument.EntityType(t)}
+		switch t {
+		case SearchEntityTicket:
+			if !scopeAll {
+				conds = append(conds, ownedOrAssigned)
+			}
+		case SearchEntityTicketComment:
+			if !scopeAll {
+				conds = append(conds, ownedOrAssigned)
+			}
+			if !isInternalCommentRole(caller.Role) {
+				conds = append(conds, searchdocument.Or(searchdocument.Restricted(false), searchdocument.AssigneeID(caller.UserID)))
+			}
+		case SearchEntityKnowledgeArticle:
+			if !middleware.HasResourcePermission(ctx, s.client, caller.Role, "knowledge", "write", caller.TenantID) {
+				conds = append(conds, searchdocument.Or(searchdocument.Restricted(false), searchdocument.OwnerID(caller.UserID)))
+			}
+		}
+		branches = append(branches, searchdocument.And(conds...))
+	}
+	return searchdocument.Or(branches...)
+}
+
+// searchTermsMatch 文档需包含全部查询词。PostgreSQL 上使用 GIN 倒排索引
+// （见迁移 016_add_search_document_fts_index），分词在应用侧完成，词元原样写入 tsvector，
+// 不经过数据库的文本解析，因此不受数据库 locale 对中文字符的影响；其他方言按空格分隔的词表匹配。
+func searchTermsMatch(tokens []string) predicate.SearchDocument {
+	return func(s *sql.Selector) {
+		col := s.C(searchdocument.FieldTerms)
+		if s.Dialect() == dialect.Postgres {
+			lexemes := make([]string, len(tokens))
+			for i, token := range tokens {
+				lexemes[i] = "'" + strings.NewReplacer(`\`, `\\`, "'", "''").Replace(token) + "'"
+			}
+			s.Where(sql.ExprP(fmt.Sprintf("array_to_tsvector(string_to_array(%s, ' ')) @@ ?::tsquery", col), strings.Join(lexemes, " & ")))
+			return
+		}
+		preds := make([]*sql.Predicate, 0, len(tokens))
+		for _, token := range tokens {
+			preds = append(preds, sql.ExprP(fmt.Sprintf("(' ' || %s || ' ') LIKE ?", col), "% "+token+" %"))
+		}
+		s.Where(sql.And(preds...))
+	}
+}
+
+// inverseDocumentFrequency 按租户全部文档计算每个查询词的 IDF
+func (s *FullTextSearchService) inverseDocumentFrequency(ctx context.Context, tenantID int, tokens []string) (map[string]float64, error) {
+	total, err := s.client.SearchDocument.Query().Where(searchdocument.TenantID(tenantID)).Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("统计索引文档失败: %w", err)
+	}
+	idf := make(map[string]float64, len(tokens))
+	for _, token := range tokens {
+		df, err := s.client.SearchDocument.Query().
+			Where(searchdocument.TenantID(tenantID), searchTermsMatch([]string{token})).
+			Count(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("统计词频失败: %w", err)
+		}
+		idf[token] = math.Log(1 + (float64(total-df)+0.5)/(float64(df)+0.5))
+	}
+	return idf, nil
+}
+
+// scoreSearchDocuments BM25 打分：正文按词频与文档长度归一，标题命中与整句命中额外加权
+func scoreSearchDocuments(docs []*ent.SearchDocument, tokens []string, idf map[string]float64, query string) map[int]float64 {
+	type termStats struct {
+		tf     map[string]int
+		title  map[string]bool
+		length int
+	}
+	stats := make([]termStats, len(docs))
+	totalLength := 0
+	for i, doc := range docs {
+		terms := strings.Fields(doc.Terms)
+		st := termStats{tf: make(map[string]int), title: make(map[string]bool), length: len(terms)}
+		for _, term := range terms {
+			st.tf[term]++
+		}
+		for _, term := range strings.Fields(doc.TitleTerms) {
+			st.title[term] = true
+		}
+		stats[i] = st
+		totalLength += st.length
+	}
+	avgLength := 1.0
+	if len(docs) > 0 && totalLength > 0 {
+		avgLength = float64(totalLength) / float64(len(docs))
+	}
+	phrase := strings.ToLower(strings.TrimSpace(query))
+	scores := make(map[int]float64, len(docs))
+	for i, doc := range docs {
+		st := stats[i]
+		score := 0.0
+		for _, token := range tokens {
+			tf := float64(st.tf[token])
+			norm := searchBM25K1 * (1 - searchBM25B + searchBM25B*float64(st.length)/avgLength)
+			score += idf[token] * tf * (searchBM25K1 + 1) / (tf + norm)
+			if st.title[token] {
+				score += idf[token] * searchTitleBoost
+			}
+		}
+		if phrase != "" && strings.Contains(strings.ToLower(doc.Title), phrase) {
+			score *= searchPhraseBoost
+		}
+		scores[doc.ID] = math.Round(score*1000) / 1000
+	}
+	return scores
+}
+
+// toSearchHit 生成命中项，优先截取正文中的命中片段，正文未命中时高亮标题
+func toSearchHit(doc *ent.SearchDocument, segments []searchSegment, score float64) *dto.FullTextSearchHit {
+	highlight, matched := highlightSearchText(doc.Body, segments, searchSnippetWindow)
+	if !matched {
+		highlight, _ = highlightSearchText(doc.Title, segments, 0)
+	}
+	return &dto.FullTextSearchHit{
+		Type:      doc.EntityType,
+		ID:        doc.EntityID,
+		ParentID:  doc.ParentID,
+		Reference: doc.Reference,
+		Title:     doc.Title,
+		Highlight: highlight,
+		Score:     score,
+		UpdatedAt: doc.SourceUpdatedAt,
+	}
+}
+
+// searchDocumentInput 待写入的索引文档
+type searchDocumentInput struct {
+	EntityType string
+	EntityID   int
+	ParentID   int
+	Reference  string
+	Title      string
+	Body       string
+	// IndexTitle 为 false 时标题仅用于展示（评论展示所属工单标题，不参与匹配）
+	IndexTitle bool
+	OwnerID    int
+	AssigneeID int
+	Restricted bool
+	UpdatedAt  time.Time
+}
+
+// IndexEntity 刷新单个实体的索引文档；实体已删除或不存在时移除文档
+func (s *FullTextSearchService) IndexEntity(ctx context.Context, tenantID int, entityType string, entityID int) error {
+	_, err := s.indexEntity(ctx, tenantID, entityType, entityID)
+	return err
+}
+
+// indexEntity 刷新索引文档，返回是否写入了文档（false 表示源实体不可索引，文档已移除）
+func (s *FullTextSearchService) indexEntity(ctx context.Context, tenantID int, entityType string, entityID int) (bool, error) {
+	var (
+		input *searchDocumentInput
+		err   error
+	)
+	switch entityType {
+	case SearchEntityTicket:
+		input, err = s.ticketDocument(ctx, tenantID, entityID)
+	case SearchEntityTicketComment:
+		input, err = s.commentDocument(ctx, tenantID, entityID)
+	case SearchEntityKnowledgeArticle:
+		input, err = s.knowledgeDocument(ctx, tenantID, entityID)
+	case SearchEntityConfigurationItem:
+		input, err = s.configurationItemDocument(ctx, tenantID, entityID)
+	default:
+		return false, fmt.Errorf("%w: %s", ErrUnsupportedSearchType, entityType)
+	}
+	if err != nil {
+		return false, err
+	}
+	if input == nil {
+		return false, s.removeDocument(ctx, tenantID, entityType, entityID)
+	}
+	if err := s.upsertDocument(ctx, tenantID, input); err != nil {
+		return false, err
+	}
+	if entityType == SearchEntityTicket {
+		if err := s.syncCommentVisibility(ctx, tenantID, input); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func (s *FullTextSearchService) ticketDocument(ctx context.Context, tenantID, ticketID int) (*searchDocumentInput, error) {
+	t, err := s.client.Ticket.Query().
+		Where(ticket.ID(ticketID), ticket.TenantID(tenantID), ticket.DeletedAtIsNil()).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("加载工单失败: %w", err)
+	}
+	body := []string{t.Description}
+	// 自定义字段中的文本值一并检索
+	keys := make([]string, 0, len(t.FormFields))
+	for key := range t.FormFields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if v, ok := t.FormFields[key].(string); ok && strings.TrimSpace(v) != "" {
+			body = append(body, v)
+		}
+	}
+	return &searchDocumentInput{
+		EntityType: SearchEntityTicket,
+		EntityID:   t.ID,
+		Reference:  t.TicketNumber,
+		Title:      t.Title,
+		Body:       strings.Join(body, "\n"),
+		IndexTitle: true,
+		OwnerID:    t.RequesterID,
+		AssigneeID: t.AssigneeID,
+		UpdatedAt:  t.UpdatedAt,
+	}, nil
+}
+
+func (s *FullTextSearchService) commentDocument(ctx context.Context, tenantID, commentID int) (*searchDocumentInput, error) {
+	c, err := s.client.TicketComment.Query().
+		Where(ticketcomment.ID(commentID), ticketcomment.TenantID(tenantID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("加载工单评论失败: %w", err)
+	}
+	t, err := s.client.Ticket.Query().
+		Where(ticket.ID(c.TicketID), ticket.TenantID(tenantID), ticket.DeletedAtIsNil()).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("加载评论所属工单失败: %w", err)
+	}
+	return &searchDocumentInput{
+		EntityType: SearchEntityTicketComment,
+		EntityID:   c.ID,
+		ParentID:   t.ID,
+		Reference:  t.TicketNumber,
+		Title:      t.Title,
+		Body:       c.Content,
+		OwnerID:    t.RequesterID,
+		AssigneeID: t.AssigneeID,
+		Restricted: c.IsInternal,
+		UpdatedAt:  c.UpdatedAt,
+	}, nil
+}
+
+func (s *FullTextSearchService) knowledgeDocument(ctx context.Context, tenantID, articleID int) (*searchDocumentInput, error) {
+	a, err := s.client.KnowledgeArticle.Query().
+		Where(knowledgearticle.ID(articleID), knowledgearticle.TenantID(tenantID), knowledgearticle.DeletedAtIsNil()).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("加载知识文章失败: %w", err)
+	}
+	body := a.Content
+	if strings.TrimSpace(a.Tags) != "" {
+		body += "\n" + a.Tags
+	}
+	return &searchDocumentInput{
+		EntityType: SearchEntityKnowledgeArticle,
+		EntityID:   a.ID,
+		Reference:  a.Category,
+		Title:      a.Title,
+		Body:       body,
+		IndexTitle: true,
+		OwnerID:    a.AuthorID,
+		Restricted: !a.IsPublished,
+		UpdatedAt:  a.UpdatedAt,
+	}, nil
+}
+
+func (s *FullTextSearchService) configurationItemDocument(ctx context.Context, tenantID, ciID int) (*searchDocumentInput, error) {
+	ci, err := s.client.ConfigurationItem.Query().
+		Where(configurationitem.ID(ciID), configurationitem.TenantID(tenantID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("加载配置项失败: %w", err)
+	}
+	var body []string
+	for _, v := range []string{ci.Description, ci.AssetTag, ci.SerialNumber, ci.Model, ci.Vendor, ci.Location, ci.Environment, ci.CloudResourceID} {
+		if strings.TrimSpace(v) != "" {
+			body = append(body, v)
+		}
+	}
+	return &searchDocumentInput{
+		EntityType: SearchEntityConfigurationItem,
+		EntityID:   ci.ID,
+		Reference:  ci.CiType,
+		Title:      ci.Name,
+		Body:       strings.Join(body, "\n"),
+		IndexTitle: true,
+		UpdatedAt:  ci.UpdatedAt,
+	}, nil
+}
+
+// upsertDocument 写入索引文档；并发写入同一实体时唯一索引冲突则改为更新
+func (s *FullTextSearchService) upsertDocument(ctx context.Context, tenantID int, in *searchDocumentInput) error {
+	var titleTerms []string
+	if in.IndexTitle {
+		titleTerms = tokenizeSearchIndex(in.Title)
+	}
+	terms := append(append([]string{}, titleTerms...), tokenizeSearchIndex(in.Body)...)
+	if in.Reference != "" && in.EntityType != SearchEntityTicketComment {
+		terms = append(terms, tokenizeSearchIndex(in.Reference)...)
+	}
+	if in.UpdatedAt.IsZero() {
+		in.UpdatedAt = time.Now()
+	}
+
+	update := func() (int, error) {
+		u := s.client.SearchDocument.Update().
+			Where(
+				searchdocument.TenantID(tenantID),
+				searchdocument.EntityType(in.EntityType),
+				searchdocument.EntityID(in.EntityID),
+			).
+			SetReference(in.Reference).
+			SetTitle(in.Title).
+			SetBody(in.Body).
+			SetTitleTerms(strings.Join(titleTerms, " ")).
+			SetTerms(strings.Join(terms, " ")).
+			SetRestricted(in.Restricted).
+			SetSourceUpdatedAt(in.UpdatedAt)
+		setSearchOptionalInts(u, in)
+		return u.Save(ctx)
+	}
+
+	n, err := update()
+	if err != nil {
+		return fmt.Errorf("更新索引文档失败: %w", err)
+	}
+	if n > 0 {
+		return nil
+	}
+	create := s.client.SearchDocument.Create().
+		SetTenantID(tenantID).
+		SetEntityType(in.EntityType).
+		SetEntityID(in.EntityID).
+		SetReference(in.Reference).
+		SetTitle(in.Title).
+		SetBody(in.Body).
+		SetTitleTerms(strings.Join(titleTerms, " ")).
+		SetTerms(strings.Join(terms, " ")).
+		SetRestricted(in.Restricted).
+		SetSourceUpdatedAt(in.UpdatedAt)
+	if in.ParentID > 0 {
+		create.SetParentID(in.ParentID)
+	}
+	if in.OwnerID > 0 {
+		create.SetOwnerID(in.OwnerID)
+	}
+	if in.AssigneeID > 0 {
+		create.SetAssigneeID(in.AssigneeID)
+	}
+	if _, err := create.Save(ctx); err != nil {
+		if !ent.IsConstraintError(err) {
+			return fmt.Errorf("写入索引文档失败: %w", err)
+		}
+		if _, err := update(); err != nil {
+			return fmt.Errorf("更新索引文档失败: %w", err)
+		}
+	}
+	return nil
+}
+
+// setSearchOptionalInts 更新可选的归属字段，值为 0 表示清空
+func setSearchOptionalInts(u *ent.SearchDocumentUpdate, in *searchDocumentInput) {
+	if in.ParentID > 0 {
+		u.SetParentID(in.ParentID)
+	} else {
+		u.ClearParentID()
+	}
+	if in.OwnerID > 0 {
+		u.SetOwnerID(in.OwnerID)
+	} else {
+		u.ClearOwnerID()
+	}
+	if in.AssigneeID > 0 {
+		u.SetAssigneeID(in.AssigneeID)
+	} else {
+		u.ClearAssigneeID()
+	}
+}
+
+// syncCommentVisibility 工单提交人或处理人变更后，同步其评论文档的可见性
+func (s *FullTextSearchService) syncCommentVisibility(ctx context.Context, tenantID int, in *searchDocumentInput) error {
+	u := s.client.SearchDocument.Update().
+		Where(
+			searchdocument.TenantID(tenantID),
+			searchdocument.EntityType(SearchEntityTicketComment),
+			searchdocument.ParentID(in.EntityID),
+		).
+		SetTitle(in.Title).
+		SetReference(in.Reference)
+	if in.OwnerID > 0 {
+		u.SetOwnerID(in.OwnerID)
+	} else {
+		u.ClearOwnerID()
+	}
+	if in.AssigneeID > 0 {
+		u.SetAssigneeID(in.AssigneeID)
+	} else {
+		u.ClearAssigneeID()
+	}
+	if _, err := u.Save(ctx); err != nil {
+		return fmt.Errorf("同步评论可见性失败: %w", err)
+	}
+	return nil
+}
+
+// removeDocument 删除索引文档；工单删除时一并删除其评论文档
+func (s *FullTextSearchService) removeDocument(ctx context.Context, tenantID int, entityType string, entityID int) error {
+	match := searchdocument.And(searchdocument.EntityType(entityType), searchdocument.EntityID(entityID))
+	if entityType == SearchEntityTicket {
+		match = searchdocument.Or(match, searchdocument.And(
+			searchdocument.EntityType(SearchEntityTicketComment),
+			searchdocument.ParentID(entityID),
+		))
+	}
+	if _, err := s.client.SearchDocument.Delete().
+		Where(searchdocument.TenantID(tenantID), match).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("删除索引文档失败: %w", err)
+	}
+	return nil
+}
+
+// ReindexTenant 重建租户全部索引文档，用于首次启用或修复索引；返回各实体类型的索引数
+func (s *FullTextSearchService) ReindexTenant(ctx context.Context, tenantID int) (map[string]int, error) {
+	if tenantID <= 0 {
+		return nil, fmt.Errorf("租户ID无效")
+	}
+	indexed := make(map[string]int, len(searchEntityTypes))
+	for _, entityType := range searchEntityTypes {
+		lastID := 0
+		for {
+			ids, err := s.entityIDsAfter(ctx, tenantID, entityType, lastID)
+			if err != nil {
+				return nil, err
+			}
+			if len(ids) == 0 {
+				break
+			}
+			for _, id := range ids {
+				ok, err := s.indexEntity(ctx, tenantID, entityType, id)
+				if err != nil {
+					return nil, fmt.Errorf("重建索引失败 (%s %d): %w", entityType, id, err)
+				}
+				if ok {
+					indexed[entityType]++
+				}
+			}
+			lastID = ids[len(ids)-1]
+		}
+	}
+	// 清理源实体已不存在的文档
+	for _, entityType := range searchEntityTypes {
+		if err := s.pruneOrphans(ctx, tenantID, entityType); err != nil {
+			return nil, err
+		}
+	}
+	s.logger.Infow("Search index rebuilt", "tenant_id", tenantID, "indexed", indexed)
+	return indexed, nil
+}
+
+// entityIDsAfter 按 ID 顺序分批列出租户内待索引实体
+func (s *FullTextSearchService) entityIDsAfter(ctx context.Context, tenantID int, entityType string, afterID int) ([]int, error) {
+	var (
+		ids []int
+		err error
+	)
+	switch entityType {
+	case SearchEntityTicket:
+		ids, err = s.client.Ticket.Query().
+			Where(ticket.TenantID(tenantID), ticket.DeletedAtIsNil(), ticket.IDGT(afterID)).
+			Order(ent.Asc(ticket.FieldID)).Limit(searchReindexBatch).IDs(ctx)
+	case SearchEntityTicketComment:
+		ids, err = s.client.TicketComment.Query().
+			Where(ticketcomment.TenantID(tenantID), ticketcomment.IDGT(afterID)).
+			Order(ent.Asc(ticketcomment.FieldID)).Limit(searchReindexBatch).IDs(ctx)
+	case SearchEntityKnowledgeArticle:
+		ids, err = s.client.KnowledgeArticle.Query().
+			Where(knowledgearticle.TenantID(tenantID), knowledgearticle.DeletedAtIsNil(), knowledgearticle.IDGT(afterID)).
+			Order(ent.Asc(knowledgearticle.FieldID)).Limit(searchReindexBatch).IDs(ctx)
+	case SearchEntityConfigurationItem:
+		ids, err = s.client.ConfigurationItem.Query().
+			Where(configurationitem.TenantID(tenantID), configurationitem.IDGT(afterID)).
+			Order(ent.Asc(configurationitem.FieldID)).Limit(searchReindexBatch).IDs(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("列出待索引实体失败: %w", err)
+	}
+	return ids, nil
+}
+
+// pruneOrphans 删除源实体已删除的索引文档
+func (s *FullTextSearchService) pruneOrphans(ctx context.Context, tenantID int, entityType string) error {
+	lastID := 0
+	for {
+		docs, err := s.client.SearchDocument.Query().
+			Where(searchdocument.TenantID(tenantID), searchdocument.EntityType(entityType), searchdocument.IDGT(lastID)).
+			Order(ent.Asc(searchdocument.FieldID)).
+			Select(searchdocument.FieldID, searchdocument.FieldEntityID).
+			Limit(searchReindexBatch).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("列出索引文档失败: %w", err)
+		}
+		if len(docs) == 0 {
+			return nil
+		}
+		for _, doc := range docs {
+			exists, err := s.entityExists(ctx, tenantID, entityType, doc.EntityID)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				if err := s.removeDocument(ctx, tenantID, entityType, doc.EntityID); err != nil {
+					return err
+				}
+			}
+		}
+		lastID = docs[len(docs)-1].ID
+	}
+}
+
+func (s *FullTextSearchService) entityExists(ctx context.Context, tenantID int, entityType string, id int) (bool, error) {
+	var (
+		exists bool
+		err    error
+	)
+	switch entityType {
+	case SearchEntityTicket:
+		exists, err = s.client.Ticket.Query().Where(ticket.ID(id), ticket.TenantID(tenantID), ticket.DeletedAtIsNil()).Exist(ctx)
+	case SearchEntityTicketComment:
+		exists, err = s.client.TicketComment.Query().Where(ticketcomment.ID(id), ticketcomment.TenantID(tenantID)).Exist(ctx)
+	case SearchEntityKnowledgeArticle:
+		exists, err = s.client.KnowledgeArticle.Query().Where(knowledgearticle.ID(id), knowledgearticle.TenantID(tenantID), knowledgearticle.DeletedAtIsNil()).Exist(ctx)
+	case SearchEntityConfigurationItem:
+		exists, err = s.client.ConfigurationItem.Query().Where(configurationitem.ID(id), configurationitem.TenantID(tenantID)).Exist(ctx)
+	}
+	if err != nil {
+		return false, fmt.Errorf("检查源实体失败: %w", err)
+	}
+	return exists, nil
+}
+
+// HandleIndexCommand 处理 search.index 命令：按聚合类型与 ID 刷新索引文档
+func (s *FullTextSearchService) HandleIndexCommand(ctx context.Context, cmd *ent.OperationalCommand) error {
+	if cmd == nil || cmd.TenantID <= 0 || cmd.AggregateID <= 0 {
+		return fmt.Errorf("invalid search index command")
+	}
+	if _, ok := searchEntityResources[cmd.AggregateType]; !ok {
+		return fmt.Errorf("invalid search index command aggregate %s", cmd.AggregateType)
+	}
+	indexCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	return s.IndexEntity(indexCtx, cmd.TenantID, cmd.AggregateType, cmd.AggregateID)
+}
+
+// searchIndexedMutation 被索引实体的 ent mutation 共有的方法
+type searchIndexedMutation interface {
+	ent.Mutation
+	ID() (int, bool)
+	IDs(ctx context.Context) ([]int, error)
+	Client() *ent.Client
+}
+
+// RegisterHooks 为被索引实体注册 ent hook：写入成功后在同一事务内投递 search.index 命令，
+// 业务回滚时命令随之回滚；批量更新/删除在执行前解析受影响的 ID 与租户。
+func (s *FullTextSearchService) RegisterHooks(client *ent.Client) {
+	client.Ticket.Use(s.indexHook(SearchEntityTicket, func(ctx context.Context, c *ent.Client, ids []int) ([]*searchEntityRef, error) {
+		rows, err := c.Ticket.Query().Where(ticket.IDIn(ids...)).Select(ticket.FieldID, ticket.FieldTenantID).All(ctx)
+		refs := make([]*searchEntityRef, 0, len(rows))
+		for _, r := range rows {
+			refs = append(refs, &searchEntityRef{ID: r.ID, TenantID: r.TenantID})
+		}
+		return refs, err
+	}))
+	client.TicketComment.Use(s.indexHook(SearchEntityTicketComment, func(ctx context.Context, c *ent.Client, ids []int) ([]*searchEntityRef, error) {
+		rows, err := c.TicketComment.Query().Where(ticketcomment.IDIn(ids...)).Select(ticketcomment.FieldID, ticketcomment.FieldTenantID).All(ctx)
+		refs := make([]*searchEntityRef, 0, len(rows))
+		for _, r := range rows {
+			refs = append(refs, &searchEntityRef{ID: r.ID, TenantID: r.TenantID})
+		}
+		return refs, err
+	}))
+	client.KnowledgeArticle.Use(s.indexHook(SearchEntityKnowledgeArticle, func(ctx context.Context, c *ent.Client, ids []int) ([]*searchEntityRef, error) {
+		rows, err := c.KnowledgeArticle.Query().Where(knowledgearticle.IDIn(ids...)).Select(knowledgearticle.FieldID, knowledgearticle.FieldTenantID).All(ctx)
+		refs := make([]*searchEntityRef, 0, len(rows))
+		for _, r := range rows {
+			refs = append(refs, &searchEntityRef{ID: r.ID, TenantID: r.TenantID})
+		}
+		return refs, err
+	}))
+	client.ConfigurationItem.Use(s.indexHook(SearchEntityConfigurationItem, func(ctx context.Context, c *ent.Client, ids []int) ([]*searchEntityRef, error) {
+		rows, err := c.ConfigurationItem.Query().Where(configurationitem.IDIn(ids...)).Select(configurationitem.FieldID, configurationitem.FieldTenantID).All(ctx)
+		refs := make([]*searchEntityRef, 0, len(rows))
+		for _, r := range rows {
+			refs = append(refs, &searchEntityRef{ID: r.ID, TenantID: r.TenantID})
+		}
+		return refs, err
+	}))
+}
+
+// searchEntityRef 待刷新索引的实体
+type searchEntityRef struct {
+	ID       int
+	TenantID int
+}
+
+func (s *FullTextSearchService) indexHook(entityType string, resolve func(context.Context, *ent.Client, []int) ([]*searchEntityRef, error)) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			mutation, ok := m.(searchIndexedMutation)
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+			var refs []*searchEntityRef
+			if !m.Op().Is(ent.OpCreate) {
+				ids, err := mutation.IDs(ctx)
+				if err != nil {
+					return nil, err
+				}
+				if len(ids) > 0 {
+					if refs, err = resolve(ctx, mutation.Client(), ids); err != nil {
+						return nil, err
+					}
+				}
+			}
+			value, err := next.Mutate(ctx, m)
+			if err != nil {
+				return value, err
+			}
+			if m.Op().Is(ent.OpCreate) {
+				id, hasID := mutation.ID()
+				tenant, _ := m.Field("tenant_id")
+				if tenantID, isInt := tenant.(int); hasID && isInt {
+					refs = append(refs, &searchEntityRef{ID: id, TenantID: tenantID})
+				}
+			}
+			for _, ref := range refs {
+				s.enqueueIndex(ctx, mutation.Client(), entityType, ref)
+			}
+			return value, nil
+		})
+	}
+}
+
+// enqueueIndex 投递索引刷新命令。索引是派生数据，投递失败只记录告警，不影响业务写入，
+// 可通过重建索引修复。
+func (s *FullTextSearchService) enqueueIndex(ctx context.Context, client *ent.Client, entityType string, ref *searchEntityRef) {
+	if ref.TenantID <= 0 || ref.ID <= 0 {
+		return
+	}
+	_, err := commandbus.Enqueue(ctx, client, commandbus.EnqueueRequest{
+		TenantID:       ref.TenantID,
+		CommandType:    commandbus.CommandIndexSearchDocument,
+		AggregateType:  entityType,
+		AggregateID:    ref.ID,
+		IdempotencyKey: fmt.Sprintf("search:%s:%d:%d", entityType, ref.ID, time.Now().UnixNano()),
+		MaxAttempts:    5,
+	})
+	if err != nil {
+		s.logger.Warnw("Enqueue search index command failed (best-effort, ignoring)", "error", err, "entity_type", entityType, "entity_id", ref.ID, "tenant_id", ref.TenantID)
+	}
+}
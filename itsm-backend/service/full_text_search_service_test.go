@@ -0,0 +1,230 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"itsm-backend/dto"
+	"itsm-backend/ent"
+	"itsm-backend/ent/enttest"
+	"itsm-backend/ent/operationalcommand"
+	"itsm-backend/ent/user"
+	"itsm-backend/internal/commandbus"
+	"itsm-backend/middleware"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestSearchTokenizer(t *testing.T) {
+	assert.Equal(t, []string{"网", "网络", "络", "络故", "故", "故障", "障", "vpn", "502"}, tokenizeSearchIndex("网络故障：ＶＰＮ 502"))
+	assert.Equal(t, []string{"网络", "络故", "故障", "vpn"}, tokenizeSearchQuery("网络故障 VPN vpn"))
+	assert.Equal(t, []string{"网"}, tokenizeSearchQuery("网"))
+	assert.Empty(t, tokenizeSearchQuery("  ，。!  "))
+
+	highlight, matched := highlightSearchText("公司<网络>故障 decision CI", splitSearchSegments("网络 ci"), 0)
+	assert.True(t, matched)
+	assert.Equal(t, "公司&lt;<em>网络</em>&gt;故障 decision <em>CI</em>", highlight)
+	_, matched = highlightSearchText("打印机卡纸", splitSearchSegments("网络"), 0)
+	assert.False(t, matched)
+}
+
+func TestFullTextSearch(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", testDSN())
+	defer client.Close()
+	ctx := context.Background()
+
+	previousMode := middleware.PermissionConfig.Mode
+	middleware.PermissionConfig.Mode = middleware.PermissionConfigModeHardcodeOnly
+	defer func() { middleware.PermissionConfig.Mode = previousMode }()
+
+	svc := NewFullTextSearchService(client, zap.NewNop().Sugar())
+	svc.RegisterHooks(client)
+
+	tenant, err := client.Tenant.Create().SetName("Search Tenant").SetCode("search").SetDomain("search.com").SetStatus("active").Save(ctx)
+	require.NoError(t, err)
+	other, err := client.Tenant.Create().SetName("Other Tenant").SetCode("other").SetDomain("other.com").SetStatus("active").Save(ctx)
+	require.NoError(t, err)
+
+	newUser := func(tenantID int, username string, role user.Role) *ent.User {
+		u, err := client.User.Create().
+			SetUsername(username).
+			SetEmail(username + "@search.com").
+			SetName(username).
+			SetPasswordHash("hashedpassword").
+			SetRole(role).
+			SetActive(true).
+			SetTenantID(tenantID).
+			Save(ctx)
+		require.NoError(t, err)
+		return u
+	}
+	requester := newUser(tenant.ID, "requester", "end_user")
+	colleague := newUser(tenant.ID, "colleague", "end_user")
+	agent := newUser(tenant.ID, "agent", "agent")
+	admin := newUser(tenant.ID, "admin", "admin")
+	outsider := newUser(other.ID, "outsider", "admin")
+
+	newTicket := func(tenantID int, number, title, description string, requesterID, assigneeID int) *ent.Ticket {
+		builder := client.Ticket.Create().
+			SetTitle(title).
+			SetDescription(description).
+			SetPriority("high").
+			SetStatus("open").
+			SetTicketNumber(number).
+			SetTenantID(tenantID).
+			SetRequesterID(requesterID)
+		if assigneeID > 0 {
+			builder.SetAssigneeID(assigneeID)
+		}
+		created, err := builder.Save(ctx)
+		require.NoError(t, err)
+		return created
+	}
+	mine := newTicket(tenant.ID, "INC-001", "公司网络故障频发", "办公区 VPN 无法连接", requester.ID, agent.ID)
+	theirs := newTicket(tenant.ID, "INC-002", "打印机无法打印", "怀疑是网络故障导致", colleague.ID, 0)
+	newTicket(other.ID, "INC-003", "网络故障", "其他租户的工单", outsider.ID, 0)
+
+	_, err = client.TicketComment.Create().SetTicketID(mine.ID).SetUserID(agent.ID).SetContent("已重启交换机，网络恢复").SetTenantID(tenant.ID).Save(ctx)
+	require.NoError(t, err)
+	internal, err := client.TicketComment.Create().SetTicketID(mine.ID).SetUserID(agent.ID).SetContent("内部排查：核心网络故障，待厂商确认").SetIsInternal(true).SetTenantID(tenant.ID).Save(ctx)
+	require.NoError(t, err)
+
+	published, err := client.KnowledgeArticle.Create().SetTitle("网络故障排查指南").SetContent("先检查网线与交换机端口").SetCategory("网络").SetIsPublished(true).SetAuthorID(agent.ID).SetTenantID(tenant.ID).Save(ctx)
+	require.NoError(t, err)
+	draft, err := client.KnowledgeArticle.Create().SetTitle("网络故障应急预案").SetContent("草稿").SetCategory("网络").SetIsPublished(false).SetAuthorID(agent.ID).SetTenantID(tenant.ID).Save(ctx)
+	require.NoError(t, err)
+
+	ciType, err := createTestCIType(ctx, client, tenant.ID, "switch")
+	require.NoError(t, err)
+	ci, err := client.ConfigurationItem.Create().SetName("core-switch-01").SetDescription("核心网络故障切换设备").SetCiType("switch").SetCiTypeID(ciType.ID).
+		SetStatus("active").SetEnvironment("production").SetCriticality("high").SetTenantID(tenant.ID).Save(ctx)
+	require.NoError(t, err)
+
+	// 模拟 worker：执行 hook 投递的索引命令
+	drain := func(t *testing.T) {
+		cmds, err := client.OperationalCommand.Query().
+			Where(operationalcommand.CommandType(commandbus.CommandIndexSearchDocument), operationalcommand.Status(commandbus.StatusPending)).
+			All(ctx)
+		require.NoError(t, err)
+		for _, cmd := range cmds {
+			require.NoError(t, svc.HandleIndexCommand(ctx, cmd))
+			require.NoError(t, client.OperationalCommand.UpdateOne(cmd).SetStatus(commandbus.StatusSucceeded).Exec(ctx))
+		}
+	}
+	drain(t)
+
+	search := func(t *testing.T, query string, user *ent.User, types ...string) *dto.FullTextSearchResponse {
+		resp, err := svc.Search(ctx, &dto.FullTextSearchRequest{Query: query, Types: types}, SearchCaller{TenantID: user.TenantID, UserID: user.ID, Role: string(user.Role)})
+		require.NoError(t, err)
+		return resp
+	}
+	hitKeys := func(resp *dto.FullTextSearchResponse) map[string]bool {
+		keys := make(map[string]bool, len(resp.Hits))
+		for _, hit := range resp.Hits {
+			keys[hit.Type+":"+hit.Reference+":"+hit.Title] = true
+		}
+		return keys
+	}
+
+	t.Run("管理员按二元组命中全部类型", func(t *testing.T) {
+		resp := search(t, "网络故障", admin)
+		assert.Equal(t, map[string]int{
+			SearchEntityTicket:            2,
+			SearchEntityTicketComment:     1,
+			SearchEntityKnowledgeArticle:  2,
+			SearchEntityConfigurationItem: 1,
+		}, resp.Facets)
+		assert.Equal(t, 6, resp.Total)
+		require.Len(t, resp.Hits, 6)
+		for _, hit := range resp.Hits {
+			assert.Greater(t, hit.Score, 0.0)
+			assert.Contains(t, hit.Highlight, "<em>网络故障</em>")
+		}
+		// 标题整句命中的文档排在正文命中之前
+		assert.Contains(t, []string{"公司网络故障频发", "网络故障排查指南", "网络故障应急预案"}, resp.Hits[0].Title)
+	})
+
+	t.Run("最终用户仅可见本人工单、公开评论与已发布文章", func(t *testing.T) {
+		resp := search(t, "网络故障", requester)
+		assert.Equal(t, map[string]int{SearchEntityTicket: 1, SearchEntityKnowledgeArticle: 1, SearchEntityConfigurationItem: 1}, resp.Facets)
+		keys := hitKeys(resp)
+		assert.True(t, keys["ticket:INC-001:公司网络故障频发"])
+		assert.True(t, keys["knowledge_article:网络:网络故障排查指南"])
+
+		comments := search(t, "网络", requester, SearchEntityTicketComment)
+		require.Len(t, comments.Hits, 1)
+		assert.Equal(t, "INC-001", comments.Hits[0].Reference)
+		assert.Equal(t, mine.ID, comments.Hits[0].ParentID)
+		assert.NotEqual(t, internal.ID, comments.Hits[0].ID)
+	})
+
+	t.Run("处理人可见内部备注与草稿", func(t *testing.T) {
+		resp := search(t, "网络故障", agent)
+		assert.Equal(t, 1, resp.Facets[SearchEntityTicket], "未分配的他人工单不可见")
+		assert.Equal(t, 1, resp.Facets[SearchEntityTicketComment])
+		assert.Equal(t, 2, resp.Facets[SearchEntityKnowledgeArticle])
+		assert.Equal(t, 1, resp.Facets[SearchEntityConfigurationItem])
+	})
+
+	t.Run("租户隔离", func(t *testing.T) {
+		resp := search(t, "网络故障", outsider)
+		require.Len(t, resp.Hits, 1)
+		assert.Equal(t, "INC-003", resp.Hits[0].Reference)
+	})
+
+	t.Run("拉丁词大小写无关，类型过滤", func(t *testing.T) {
+		resp := search(t, "vpn", requester)
+		require.Len(t, resp.Hits, 1)
+		assert.Equal(t, "办公区 <em>VPN</em> 无法连接", resp.Hits[0].Highlight)
+
+		resp = search(t, "core-switch", admin, SearchEntityConfigurationItem)
+		require.Len(t, resp.Hits, 1)
+		assert.Equal(t, ci.ID, resp.Hits[0].ID)
+
+		_, err := svc.Search(ctx, &dto.FullTextSearchRequest{Query: "网络", Types: []string{"change"}}, SearchCaller{TenantID: tenant.ID, UserID: admin.ID, Role: "admin"})
+		assert.ErrorIs(t, err, ErrUnsupportedSearchType)
+	})
+
+	t.Run("分页", func(t *testing.T) {
+		resp, err := svc.Search(ctx, &dto.FullTextSearchRequest{Query: "网络故障", Limit: 4, Offset: 4}, SearchCaller{TenantID: tenant.ID, UserID: admin.ID, Role: "admin"})
+		require.NoError(t, err)
+		assert.Equal(t, 6, resp.Total)
+		assert.Len(t, resp.Hits, 2)
+	})
+
+	t.Run("变更经 hook 同步索引", func(t *testing.T) {
+		require.NoError(t, client.Ticket.UpdateOne(theirs).SetAssigneeID(agent.ID).Exec(ctx))
+		require.NoError(t, client.KnowledgeArticle.UpdateOne(draft).SetIsPublished(true).Exec(ctx))
+		require.NoError(t, client.Ticket.UpdateOne(mine).SetDeletedAt(time.Now()).Exec(ctx))
+		drain(t)
+
+		resp := search(t, "网络故障", agent)
+		keys := hitKeys(resp)
+		assert.True(t, keys["ticket:INC-002:打印机无法打印"], "重新分配后处理人可见")
+		assert.False(t, keys["ticket:INC-001:公司网络故障频发"], "删除的工单移出索引")
+		assert.Zero(t, resp.Facets[SearchEntityTicketComment], "工单删除时评论文档一并移除")
+
+		resp = search(t, "应急预案", requester)
+		require.Len(t, resp.Hits, 1)
+		assert.Equal(t, draft.ID, resp.Hits[0].ID)
+	})
+
+	t.Run("重建索引", func(t *testing.T) {
+		require.NoError(t, client.KnowledgeArticle.DeleteOne(published).Exec(ctx))
+		drain(t)
+		_, err := client.SearchDocument.Delete().Exec(ctx)
+		require.NoError(t, err)
+
+		indexed, err := svc.ReindexTenant(ctx, tenant.ID)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{
+			SearchEntityTicket:            1,
+			SearchEntityKnowledgeArticle:  1,
+			SearchEntityConfigurationItem: 1,
+		}, indexed)
+		assert.Equal(t, 3, search(t, "网络故障", admin).Total)
+	})
+}
@@ -0,0 +1,347 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"itsm-backend/common/tenantctx"
+	"itsm-backend/connector"
+	"itsm-backend/ent"
+	"itsm-backend/ent/inbounddedupkey"
+	"itsm-backend/ent/inboundmessagelog"
+	"itsm-backend/ent/schema"
+	"itsm-backend/internal/commandbus"
+
+	"go.uber.org/zap"
+)
+
+// 入站消息日志：connector.Router 通过去重的每条消息落库，记录各处理器的执行结果。
+// 首次派发失败的处理器经 commandbus（connector.inbound.retry）单独重试，退避与死信沿用命令总线；
+// 管理端可查看消息并按处理器手动重放。未配置 Redis 时 InboundDedupStore 以数据库唯一索引做集群级去重。
+
+const (
+	inboundMessageAggregate = "inbound_message"
+	// inboundRetryMaxAttempts 失败处理器经命令总线重试的最大次数
+	inboundRetryMaxAttempts = 6
+	// inboundRetryDelay 首次重试的延迟，避开 IM 平台自身的重试窗口
+	inboundRetryDelay = 30 * time.Second
+	// inboundMessageRetention 成功消息日志的保留时长，失败消息保留至人工处理
+	inboundMessageRetention = 30 * 24 * time.Hour
+
+	inboundMessageListLimit   = 50
+	inboundMessageListMaxSize = 200
+
+	inboundHandlerPending   = "pending"
+	inboundHandlerSucceeded = "succeeded"
+	inboundHandlerFailed    = "failed"
+)
+
+var (
+	ErrInboundMessageNotFound = errors.New("入站消息不存在")
+	ErrInboundMessageInvalid  = errors.New("入站消息参数无效")
+)
+
+// InboundMessageService 入站消息日志、失败重试与重放
+type InboundMessageService struct {
+	client *ent.Client
+	router *connector.Router
+	logger *zap.SugaredLogger
+	now    func() time.Time
+}
+
+// NewInboundMessageService 创建入站消息服务并作为 InboundRecorder 注入 router
+func NewInboundMessageService(client *ent.Client, router *connector.Router, logger *zap.SugaredLogger) *InboundMessageService {
+	s := &InboundMessageService{client: client, router: router, logger: logger, now: time.Now}
+	if router != nil {
+		router.SetRecorder(s)
+	}
+	return s
+}
+
+// RecordInbound 实现 connector.InboundRecorder；缺少租户上下文的消息不落库
+func (s *InboundMessageService) RecordInbound(ctx context.Context, dedupKey string, msg *connector.InboundMessage, handlers []string) (int, error) {
+	tenantID, ok := tenantctx.TenantID(ctx)
+	if !ok || tenantID <= 0 || msg == nil {
+		return 0, nil
+	}
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return 0, fmt.Errorf("序列化入站消息失败: %w", err)
+	}
+	now := s.now()
+	results := make(map[string]schema.InboundHandlerResult, len(handlers))
+	for _, name := range handlers {
+		results[name] = schema.InboundHandlerResult{Status: inboundHandlerPending, UpdatedAt: now}
+	}
+	receivedAt := msg.ReceivedAt
+	if receivedAt.IsZero() {
+		receivedAt = now
+	}
+	connectorName := msg.ConnectorName
+	if connectorName == "" {
+		connectorName = "unknown"
+	}
+	rec, err := s.client.InboundMessageLog.Create().
+		SetTenantID(tenantID).
+		SetConnectorName(connectorName).
+		SetMessageID(msg.MessageID).
+		SetDedupKey(dedupKey).
+		SetMessageType(msg.Type).
+		SetChannel(msg.Channel).
+		SetUserID(msg.UserID).
+		SetChatID(msg.ChatID).
+		SetPayload(string(payload)).
+		SetHandlerResults(results).
+		SetReceivedAt(receivedAt).
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("记录入站消息失败: %w", err)
+	}
+	return rec.ID, nil
+}
+
+// RecordResults 实现 connector.InboundRecorder：回写首次派发结果，并为失败的处理器排入重试命令
+func (s *InboundMessageService) RecordResults(ctx context.Context, logID int, results map[string]error) {
+	if err := s.applyResults(ctx, logID, results, true); err != nil {
+		s.logger.Warnw("Failed to record inbound handler results", "log_id", logID, "error", err)
+	}
+}
+
+// applyResults 合并处理器结果并重算消息状态；enqueueRetry 时为失败的处理器排入重试命令（同一事务），
+// 标记为 connector.Permanent 的失败不重试
+func (s *InboundMessageService) applyResults(ctx context.Context, logID int, results map[string]error, enqueueRetry bool) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+	rec, err := tx.InboundMessageLog.Get(ctx, logID)
+	if err != nil {
+		return err
+	}
+	merged := make(map[string]schema.InboundHandlerResult, len(rec.HandlerResults)+len(results))
+	for name, r := range rec.HandlerResults {
+		merged[name] = r
+	}
+	now := s.now()
+	failed := make([]string, 0)
+	for name, herr := range results {
+		r := merged[name]
+		r.Attempts++
+		r.UpdatedAt = now
+		if herr != nil {
+			r.Status, r.Error = inboundHandlerFailed, truncateRunes(herr.Error(), 1000)
+			if !errors.Is(herr, connector.ErrPermanent) {
+				failed = append(failed, name)
+			}
+		} else {
+			r.Status, r.Error = inboundHandlerSucceeded, ""
+		}
+		merged[name] = r
+	}
+	if _, err := tx.InboundMessageLog.UpdateOneID(logID).
+		SetHandlerResults(merged).
+		SetStatus(inboundMessageStatus(merged)).
+		Save(ctx); err != nil {
+		return err
+	}
+	if enqueueRetry {
+		sort.Strings(failed)
+		for _, name := range failed {
+			if _, err := commandbus.EnqueueTx(ctx, tx, commandbus.EnqueueRequest{
+				TenantID:       rec.TenantID,
+				CommandType:    commandbus.CommandRetryInboundHandler,
+				AggregateType:  inboundMessageAggregate,
+				AggregateID:    logID,
+				IdempotencyKey: fmt.Sprintf("inbound:%d:%s", logID, name),
+				Payload:        map[string]interface{}{"handler": name},
+				MaxAttempts:    inboundRetryMaxAttempts,
+				AvailableAt:    now.Add(inboundRetryDelay),
+			}); err != nil {
+				return fmt.Errorf("排入入站处理器重试失败: %w", err)
+			}
+		}
+	}
+	return tx.Commit()
+}
+
+// HandleRetryCommand 是 commandbus.CommandRetryInboundHandler 的处理器：重新执行单个失败的处理器。
+// 返回处理器错误以沿用命令总线的退避重试与死信；已成功（如被手动重放）、处理器已下线或返回不可重试错误时直接结束。
+func (s *InboundMessageService) HandleRetryCommand(ctx context.Context, cmd *ent.OperationalCommand) error {
+	if cmd == nil || cmd.TenantID <= 0 || cmd.AggregateID <= 0 {
+		return fmt.Errorf("invalid inbound retry command")
+	}
+	handler, _ := cmd.Payload["handler"].(string)
+	if handler == "" {
+		return fmt.Errorf("入站重试命令 %d 缺少处理器名", cmd.ID)
+	}
+	rec, err := s.client.InboundMessageLog.Query().
+		Where(inboundmessagelog.ID(cmd.AggregateID), inboundmessagelog.TenantID(cmd.TenantID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		s.logger.Warnw("入站消息日志不存在，忽略重试", "command", cmd.ID, "log_id", cmd.AggregateID)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if rec.HandlerResults[handler].Status == inboundHandlerSucceeded {
+		return nil
+	}
+	results, err := s.replay(ctx, rec, []string{handler})
+	if errors.Is(err, connector.ErrUnknownInboundHandler) {
+		s.logger.Warnw("入站处理器已下线，放弃重试", "command", cmd.ID, "log_id", rec.ID, "handler", handler)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := s.applyResults(ctx, rec.ID, results, false); err != nil {
+		return err
+	}
+	if herr := results[handler]; errors.Is(herr, connector.ErrPermanent) {
+		s.logger.Warnw("入站处理器返回不可重试错误，停止重试", "command", cmd.ID, "log_id", rec.ID, "handler", handler, "error", herr)
+		return nil
+	}
+	return results[handler]
+}
+
+// ListInboundMessages 入站消息日志列表，按接收时间倒序
+func (s *InboundMessageService) ListInboundMessages(ctx context.Context, tenantID int, connectorName, status string, limit int) ([]*ent.InboundMessageLog, error) {
+	if limit <= 0 {
+		limit = inboundMessageListLimit
+	}
+	if limit > inboundMessageListMaxSize {
+		limit = inboundMessageListMaxSize
+	}
+	query := s.client.InboundMessageLog.Query().Where(inboundmessagelog.TenantID(tenantID))
+	if connectorName != "" {
+		query = query.Where(inboundmessagelog.ConnectorName(connectorName))
+	}
+	if status != "" {
+		st := inboundmessagelog.Status(status)
+		if err := inboundmessagelog.StatusValidator(st); err != nil {
+			return nil, fmt.Errorf("无效的状态: %s", status)
+		}
+		query = query.Where(inboundmessagelog.StatusEQ(st))
+	}
+	return query.Order(ent.Desc(inboundmessagelog.FieldReceivedAt), ent.Desc(inboundmessagelog.FieldID)).Limit(limit).All(ctx)
+}
+
+// GetInboundMessage 入站消息详情（含原始负载与各处理器结果）
+func (s *InboundMessageService) GetInboundMessage(ctx context.Context, tenantID, id int) (*ent.InboundMessageLog, error) {
+	rec, err := s.client.InboundMessageLog.Query().
+		Where(inboundmessagelog.ID(id), inboundmessagelog.TenantID(tenantID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrInboundMessageNotFound
+	}
+	return rec, err
+}
+
+// ReplayInboundMessage 手动重放入站消息：handlers 为空时重放全部已注册处理器。
+// 重放跳过去重，处理器需自行保证幂等（如审批已处理、工单已分派时返回业务错误）；结果写回日志，不再自动重试。
+func (s *InboundMessageService) ReplayInboundMessage(ctx context.Context, tenantID, id int, handlers []string) (*ent.InboundMessageLog, error) {
+	rec, err := s.GetInboundMessage(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	results, err := s.replay(tenantctx.WithTenantID(ctx, tenantID), rec, handlers)
+	if errors.Is(err, connector.ErrUnknownInboundHandler) {
+		return nil, fmt.Errorf("%w: %v", ErrInboundMessageInvalid, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := s.applyResults(ctx, rec.ID, results, false); err != nil {
+		return nil, err
+	}
+	return s.client.InboundMessageLog.UpdateOneID(rec.ID).
+		AddReplayCount(1).
+		SetLastReplayedAt(s.now()).
+		Save(ctx)
+}
+
+func (s *InboundMessageService) replay(ctx context.Context, rec *ent.InboundMessageLog, handlers []string) (map[string]error, error) {
+	if s.router == nil {
+		return nil, fmt.Errorf("入站路由未初始化")
+	}
+	var msg connector.InboundMessage
+	if err := json.Unmarshal([]byte(rec.Payload), &msg); err != nil {
+		return nil, fmt.Errorf("解析入站消息 %d 失败: %w", rec.ID, err)
+	}
+	return s.router.Replay(ctx, &msg, handlers...)
+}
+
+// PurgeExpired 清理过期的去重 key 与超过保留期的成功消息日志
+func (s *InboundMessageService) PurgeExpired(ctx context.Context) (int, error) {
+	ctx = tenantctx.SystemContext(ctx, "connector:inbound-purge", "purge expired inbound dedup keys and message logs across tenants")
+	now := s.now()
+	keys, err := s.client.InboundDedupKey.Delete().Where(inbounddedupkey.ExpiresAtLTE(now)).Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+	logs, err := s.client.InboundMessageLog.Delete().
+		Where(
+			inboundmessagelog.StatusEQ(inboundmessagelog.StatusSucceeded),
+			inboundmessagelog.ReceivedAtLT(now.Add(-inboundMessageRetention)),
+		).
+		Exec(ctx)
+	return keys + logs, err
+}
+
+// inboundMessageStatus 存在失败处理器即为 failed，全部成功为 succeeded，其余为 received
+func inboundMessageStatus(results map[string]schema.InboundHandlerResult) inboundmessagelog.Status {
+	status := inboundmessagelog.StatusSucceeded
+	for _, r := range results {
+		switch r.Status {
+		case inboundHandlerFailed:
+			return inboundmessagelog.StatusFailed
+		case inboundHandlerPending:
+			status = inboundmessagelog.StatusReceived
+		}
+	}
+	return status
+}
+
+// InboundDedupStore 基于数据库唯一索引的 connector.DedupStore，未配置 Redis 时提供集群级去重
+type InboundDedupStore struct {
+	client *ent.Client
+	now    func() time.Time
+}
+
+// NewInboundDedupStore 创建数据库去重存储
+func NewInboundDedupStore(client *ent.Client) *InboundDedupStore {
+	return &InboundDedupStore{client: client, now: time.Now}
+}
+
+// Claim 实现 connector.DedupStore：先删除同 key 的过期行，再插入；唯一索引冲突即已被其他副本占用
+func (s *InboundDedupStore) Claim(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	tenantID, _ := tenantctx.TenantID(ctx)
+	if tenantID < 0 {
+		tenantID = 0
+	}
+	now := s.now()
+	if _, err := s.client.InboundDedupKey.Delete().
+		Where(
+			inbounddedupkey.TenantID(tenantID),
+			inbounddedupkey.DedupKey(key),
+			inbounddedupkey.ExpiresAtLTE(now),
+		).
+		Exec(ctx); err != nil {
+		return false, err
+	}
+	err := s.client.InboundDedupKey.Create().
+		SetTenantID(tenantID).
+		SetDedupKey(key).
+		SetExpiresAt(now.Add(ttl)).
+		Exec(ctx)
+	if ent.IsConstraintError(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"itsm-backend/common/tenantctx"
+	"itsm-backend/connector"
+	"itsm-backend/ent"
+	"itsm-backend/ent/enttest"
+	"itsm-backend/ent/inboundmessagelog"
+	"itsm-backend/ent/operationalcommand"
+	"itsm-backend/internal/commandbus"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestInboundDedupStore(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", testDSN())
+	defer client.Close()
+	now := time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)
+	store := NewInboundDedupStore(client)
+	store.now = func() time.Time { return now }
+	ctx := tenantctx.WithTenantID(context.Background(), 1)
+
+	claimed, err := store.Claim(ctx, "feishu:1:m1@c1", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	// 唯一索引冲突：另一副本已占用
+	claimed, err = store.Claim(ctx, "feishu:1:m1@c1", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, claimed)
+
+	claimed, err = store.Claim(tenantctx.WithTenantID(context.Background(), 2), "feishu:1:m1@c1", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, claimed, "不同租户的 key 互不影响")
+
+	now = now.Add(time.Minute)
+	claimed, err = store.Claim(ctx, "feishu:1:m1@c1", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, claimed, "过期后可重新占用")
+}
+
+func TestInboundMessageService(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", testDSN())
+	defer client.Close()
+	logger := zaptest.NewLogger(t).Sugar()
+
+	tenant, err := client.Tenant.Create().SetName("Inbound Tenant").SetCode("inbound").SetDomain("inbound.com").SetStatus("active").Save(context.Background())
+	require.NoError(t, err)
+	ctx := tenantctx.WithTenantID(context.Background(), tenant.ID)
+	now := time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)
+
+	router := connector.NewRouter(nil)
+	router.SetDedupStore(NewInboundDedupStore(client))
+	svc := NewInboundMessageService(client, router, logger)
+	svc.now = func() time.Time { return now }
+
+	calls := map[string]int{}
+	ticketDown := true
+	router.RegisterNamed("approval", func(_ context.Context, _ *connector.InboundMessage) error {
+		calls["approval"]++
+		return nil
+	})
+	router.RegisterNamed("ticket", func(_ context.Context, _ *connector.InboundMessage) error {
+		calls["ticket"]++
+		if ticketDown {
+			return errors.New("ticket service unavailable")
+		}
+		return nil
+	})
+
+	msg := &connector.InboundMessage{ConnectorName: "feishu", MessageID: "m1", Channel: "c1", Type: "text", Content: "/approve 42"}
+	require.NoError(t, router.Dispatch(ctx, msg))
+
+	logs, err := client.InboundMessageLog.Query().Where(inboundmessagelog.TenantID(tenant.ID)).All(ctx)
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	rec := logs[0]
+	assert.Equal(t, inboundmessagelog.StatusFailed, rec.Status)
+	assert.Equal(t, inboundHandlerSucceeded, rec.HandlerResults["approval"].Status)
+	assert.Equal(t, inboundHandlerFailed, rec.HandlerResults["ticket"].Status)
+	assert.Equal(t, 1, rec.HandlerResults["ticket"].Attempts)
+
+	retryCommands := func(t *testing.T) []*ent.OperationalCommand {
+		cmds, err := client.OperationalCommand.Query().
+			Where(
+				operationalcommand.CommandType(commandbus.CommandRetryInboundHandler),
+				operationalcommand.AggregateID(rec.ID),
+			).
+			All(ctx)
+		require.NoError(t, err)
+		return cmds
+	}
+
+	t.Run("失败的处理器排入重试命令", func(t *testing.T) {
+		cmds := retryCommands(t)
+		require.Len(t, cmds, 1)
+		assert.Equal(t, fmt.Sprintf("inbound:%d:ticket", rec.ID), cmds[0].IdempotencyKey)
+		assert.Equal(t, "ticket", cmds[0].Payload["handler"])
+		assert.True(t, now.Add(inboundRetryDelay).Equal(cmds[0].AvailableAt))
+	})
+
+	t.Run("重复投递被去重", func(t *testing.T) {
+		require.NoError(t, router.Dispatch(ctx, msg))
+		count, err := client.InboundMessageLog.Query().Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+		assert.Equal(t, 1, calls["approval"])
+	})
+
+	t.Run("重试仍失败时返回处理器错误", func(t *testing.T) {
+		cmd := retryCommands(t)[0]
+		assert.ErrorContains(t, svc.HandleRetryCommand(ctx, cmd), "unavailable")
+		reloaded, err := svc.GetInboundMessage(ctx, tenant.ID, rec.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 2, reloaded.HandlerResults["ticket"].Attempts)
+		assert.Len(t, retryCommands(t), 1, "重试不再排入新命令")
+	})
+
+	t.Run("重试成功", func(t *testing.T) {
+		ticketDown = false
+		cmd := retryCommands(t)[0]
+		require.NoError(t, svc.HandleRetryCommand(ctx, cmd))
+		reloaded, err := svc.GetInboundMessage(ctx, tenant.ID, rec.ID)
+		require.NoError(t, err)
+		assert.Equal(t, inboundmessagelog.StatusSucceeded, reloaded.Status)
+		assert.Equal(t, inboundHandlerSucceeded, reloaded.HandlerResults["ticket"].Status)
+		assert.Empty(t, reloaded.HandlerResults["ticket"].Error)
+		assert.Equal(t, 1, calls["approval"], "重试只执行失败的处理器")
+
+		ticketCalls := calls["ticket"]
+		require.NoError(t, svc.HandleRetryCommand(ctx, cmd))
+		assert.Equal(t, ticketCalls, calls["ticket"], "已成功的处理器不再执行")
+	})
+
+	t.Run("手动重放", func(t *testing.T) {
+		replayed, err := svc.ReplayInboundMessage(ctx, tenant.ID, rec.ID, []string{"approval"})
+		require.NoError(t, err)
+		assert.Equal(t, 1, replayed.ReplayCount)
+		require.NotNil(t, replayed.LastReplayedAt)
+		assert.Equal(t, 2, calls["approval"])
+		assert.Equal(t, 2, replayed.HandlerResults["approval"].Attempts)
+
+		replayed, err = svc.ReplayInboundMessage(ctx, tenant.ID, rec.ID, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 2, replayed.ReplayCount)
+		assert.Equal(t, 3, calls["approval"])
+		assert.Equal(t, inboundmessagelog.StatusSucceeded, replayed.Status)
+
+		_, err = svc.ReplayInboundMessage(ctx, tenant.ID, rec.ID, []string{"unknown"})
+		assert.ErrorIs(t, err, ErrInboundMessageInvalid)
+		_, err = svc.ReplayInboundMessage(ctx, tenant.ID+1, rec.ID, nil)
+		assert.ErrorIs(t, err, ErrInboundMessageNotFound)
+	})
+
+	t.Run("不可重试的失败不排入重试", func(t *testing.T) {
+		router.RegisterNamed("rejecting", func(_ context.Context, m *connector.InboundMessage) error {
+			if m.MessageID == "m2" {
+				return connector.Permanent(errors.New("已拒绝"))
+			}
+			return nil
+		})
+		require.NoError(t, router.Dispatch(ctx, &connector.InboundMessage{ConnectorName: "feishu", MessageID: "m2", Channel: "c1", Type: "text"}))
+		rejected, err := client.InboundMessageLog.Query().Where(inboundmessagelog.MessageID("m2")).Only(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, inboundmessagelog.StatusFailed, rejected.Status)
+		assert.Equal(t, inboundHandlerFailed, rejected.HandlerResults["rejecting"].Status)
+		assert.Equal(t, "已拒绝", rejected.HandlerResults["rejecting"].Error)
+		count, err := client.OperationalCommand.Query().
+			Where(
+				operationalcommand.CommandType(commandbus.CommandRetryInboundHandler),
+				operationalcommand.AggregateID(rejected.ID),
+				operationalcommand.IdempotencyKey(fmt.Sprintf("inbound:%d:rejecting", rejected.ID)),
+			).
+			Count(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+}
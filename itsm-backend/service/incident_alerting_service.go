@@ -9,6 +9,8 @@ import (
 	"strings"
 	"time"
 
+	"itsm-backend/connector"
+	slackconn "itsm-backend/connector/builtin/slack"
 	"itsm-backend/dto"
 	"itsm-backend/ent"
 	"itsm-backend/ent/incident"
@@ -20,8 +22,9 @@ import (
 )
 
 type IncidentAlertingService struct {
-	client *ent.Client
-	logger *zap.SugaredLogger
+	client     *ent.Client
+	connectors *connector.Manager
+	logger     *zap.SugaredLogger
 }
 
 func NewIncidentAlertingService(client *ent.Client, logger *zap.SugaredLogger) *IncidentAlertingService {
@@ -31,6 +34,11 @@ func NewIncidentAlertingService(client *ent.Client, logger *zap.SugaredLogger) *
 	}
 }
 
+// SetConnectorManager 注入连接器管理器：租户已安装 Slack 连接器时经其投递告警
+func (s *IncidentAlertingService) SetConnectorManager(connectors *connector.Manager) {
+	s.connectors = connectors
+}
+
 // AlertChannel 告警渠道接口
 type AlertChannel interface {
 	Send(ctx context.Context, alert *dto.IncidentAlertResponse) error
@@ -118,20 +126,38 @@ func (c *SMSChannel) IsEnabled() bool {
 }
 
 // SlackChannel Slack告警渠道
+// 租户已安装 Slack 连接器时经连接器投递（支持卡片上"认领"按钮回调），否则投递到全局 Incoming Webhook
 type SlackChannel struct {
 	webhookURL string
 	channel    string
+	connectors *connector.Manager
 	logger     *zap.SugaredLogger
 }
 
 func (c *SlackChannel) Send(ctx context.Context, alert *dto.IncidentAlertResponse) error {
 	c.logger.Infow("Sending Slack alert", "alert_id", alert.ID, "channel", c.channel)
-	if os.Getenv("GIN_MODE") == "release" {
-		return fmt.Errorf("slack alert delivery must use the connector lifecycle")
+	msg := &connector.Message{
+		Channel: c.channel,
+		Type:    "card",
+		Card: &connector.Card{
+			Header: &connector.CardHeader{Title: alert.AlertName, Subtitle: fmt.Sprintf("事件 #%d · %s", alert.IncidentID, alert.Severity), Color: alertColor(alert.Severity)},
+			Elements: []connector.CardElement{
+				{Type: "text", Text: alert.Message},
+				{Type: "button", Action: &connector.Action{Type: "primary", Text: "认领", Value: ChatActionValue(ChatActionAcknowledge, alert.IncidentID)}},
+			},
+		},
+		Actions: []connector.Action{{Type: "link", Text: "查看事件", URL: fmt.Sprintf("/incidents/%d", alert.IncidentID)}},
 	}
-	time.Sleep(150 * time.Millisecond)
-	c.logger.Infow("Slack alert simulated in non-production environment", "alert_id", alert.ID)
-	return nil
+	if c.connectors != nil {
+		if _, ok := c.connectors.Get(alert.TenantID, "slack"); ok {
+			return c.connectors.Send(ctx, alert.TenantID, "slack", msg)
+		}
+	}
+	if c.webhookURL == "" {
+		return fmt.Errorf("slack alert channel is not configured for tenant %d", alert.TenantID)
+	}
+	blocks, text := slackconn.Blocks(msg, "")
+	return slackconn.NewClient("", "").PostWebhook(ctx, c.webhookURL, map[string]interface{}{"text": text, "blocks": blocks})
 }
 
 func (c *SlackChannel) GetName() string {
@@ -139,7 +165,17 @@ func (c *SlackChannel) GetName() string {
 }
 
 func (c *SlackChannel) IsEnabled() bool {
-	return c.webhookURL != ""
+	return c.webhookURL != "" || c.connectors != nil
+}
+
+func alertColor(severity string) string {
+	switch severity {
+	case "critical", "high":
+		return "red"
+	case "medium":
+		return "orange"
+	}
+	return "blue"
 }
 
 // WebhookChannel Webhook告警渠道
@@ -344,6 +380,7 @@ func (s *IncidentAlertingService) getAlertChannels(channelNames []string) []Aler
 			channels = append(channels, &SlackChannel{
 				webhookURL: webhookURL,
 				channel:    channel,
+				connectors: s.connectors,
 				logger:     s.logger,
 			})
 		case "webhook":
@@ -9,6 +9,7 @@ import (
 	"itsm-backend/ent"
 	"itsm-backend/ent/incident"
 	"itsm-backend/ent/slaviolation"
+	"itsm-backend/service/oncall"
 
 	"go.uber.org/zap"
 )
@@ -240,7 +241,15 @@ func (s *IncidentEscalationService) escalateIncident(ctx context.Context, incide
 		update.SetStatus(rule.ToStatus)
 	}
 
-	if rule.TargetAssigneeID > 0 {
+	if rule.TargetAssigneeType == "oncall" && rule.TargetAssigneeID > 0 {
+		// target_assignee_id 为值班表ID，分配给升级时刻的值班人；无人值班时保持原处理人
+		shift, err := oncall.Current(ctx, s.client, incidentEnt.TenantID, rule.TargetAssigneeID, time.Now())
+		if err != nil {
+			s.logger.Warnw("Failed to resolve on-call assignee", "incident_id", incidentEnt.ID, "schedule_id", rule.TargetAssigneeID, "error", err)
+		} else {
+			update.SetAssigneeID(shift.UserID)
+		}
+	} else if rule.TargetAssigneeID > 0 {
 		update.SetAssigneeID(rule.TargetAssigneeID)
 	}
 
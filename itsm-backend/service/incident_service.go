@@ -44,6 +44,8 @@ type IncidentService struct {
 	rawDB                 *sql.DB // for transactional SELECT FOR UPDATE (S-4 修复)
 	workflowOutboxEnabled bool
 	rulesOutboxEnabled    bool
+	majorIncidents        *MajorIncidentService
+	onCall                *OnCallService
 }
 
 func NewIncidentService(client *ent.Client, logger *zap.SugaredLogger) *IncidentService {
@@ -53,6 +55,16 @@ func NewIncidentService(client *ent.Client, logger *zap.SugaredLogger) *Incident
 	}
 }
 
+// SetMajorIncidentService 设置重大事件服务，重大事件解决或重新打开时停止/恢复干系人通报并生成复盘草稿
+func (s *IncidentService) SetMajorIncidentService(majorIncidents *MajorIncidentService) {
+	s.majorIncidents = majorIncidents
+}
+
+// SetOnCallService 设置值班服务，事件确认或解决时结束进行中的值班呼叫，并支持分配给值班表的当前值班人
+func (s *IncidentService) SetOnCallService(onCall *OnCallService) {
+	s.onCall = onCall
+}
+
 // SetProcessTriggerService 设置流程触发服务
 func (s *IncidentService) SetProcessTriggerService(triggerService ProcessTriggerServiceInterface) {
 	s.processTriggerService = triggerService
@@ -570,8 +582,8 @@ func (s *IncidentService) UpdateIncident(ctx context.Context, id int, req *dto.U
 		return nil, fmt.Errorf("failed to update incident: %w", err)
 	}
 
-	// 记录事件更新活动
-	_, err = s.CreateIncidentEvent(ctx, &dto.CreateIncidentEventRequest{
+	// 记录事件更新活动；状态有变化时记为状态变更，供时间线还原状态流转
+	updateEvent := &dto.CreateIncidentEventRequest{
 		IncidentID:  id,
 		EventType:   "update",
 		EventName:   "事件更新",
@@ -579,7 +591,14 @@ func (s *IncidentService) UpdateIncident(ctx context.Context, id int, req *dto.U
 		Status:      "active",
 		Severity:    "info",
 		Source:      "system",
-	}, tenantID)
+	}
+	if req.Status != nil && *req.Status != currentIncident.Status {
+		updateEvent.EventType = "status_change"
+		updateEvent.EventName = "状态变更"
+		updateEvent.Description = fmt.Sprintf("事件状态从 %s 变更为 %s", currentIncident.Status, *req.Status)
+		updateEvent.Data = map[string]interface{}{"from": currentIncident.Status, "to": *req.Status}
+	}
+	_, err = s.CreateIncidentEvent(ctx, updateEvent, tenantID)
 	if err != nil {
 		s.logger.Errorw("Failed to create incident event", "error", err)
 	}
@@ -634,6 +653,18 @@ func (s *IncidentService) AssignIncident(ctx context.Context, id int, assigneeID
 	return s.toIncidentResponse(updatedIncident), nil
 }
 
+// AssignIncidentToOnCall 将事件分配给值班表在当前时刻的值班人
+func (s *IncidentService) AssignIncidentToOnCall(ctx context.Context, id, scheduleID, tenantID int) (*dto.IncidentResponse, error) {
+	if s.onCall == nil {
+		return nil, fmt.Errorf("on-call service not configured")
+	}
+	assigneeID, err := s.onCall.CurrentOnCallUser(ctx, tenantID, scheduleID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return s.AssignIncident(ctx, id, assigneeID, tenantID)
+}
+
 func (s *IncidentService) validateIncidentAssignee(ctx context.Context, assigneeID, tenantID int) error {
 	if assigneeID <= 0 {
 		return fmt.Errorf("invalid assignee id")
@@ -1373,6 +1404,11 @@ func (s *IncidentService) AcknowledgeIncident(ctx context.Context, id, userID, t
 		Description: fmt.Sprintf("事件由用户 %d 确认", userID), Status: "active", Severity: "info",
 		UserID: &userID, Source: "user",
 	}, tenantID)
+	if s.onCall != nil {
+		if err := s.onCall.OnIncidentAcknowledged(ctx, tenantID, id, userID); err != nil {
+			s.logger.Warnw("Failed to stop on-call pages", "incident_id", id, "tenant_id", tenantID, "error", err)
+		}
+	}
 	return eventErr
 }
 
@@ -1427,6 +1463,17 @@ func (s *IncidentService) ResolveIncident(ctx context.Context, id, userID, tenan
 		Data:   map[string]interface{}{"rootCause": strings.TrimSpace(rootCause)},
 		UserID: &userID, Source: "user",
 	}, tenantID)
+	if eventErr == nil && incidentEntity.IsMajorIncident && s.majorIncidents != nil {
+		// 复盘草稿生成失败不影响解决本身，可稍后手工重新生成
+		if err := s.majorIncidents.OnIncidentResolved(ctx, tenantID, id); err != nil {
+			s.logger.Warnw("Failed to wrap up major incident", "incident_id", id, "tenant_id", tenantID, "error", err)
+		}
+	}
+	if s.onCall != nil {
+		if err := s.onCall.OnIncidentResolved(ctx, tenantID, id); err != nil {
+			s.logger.Warnw("Failed to stop on-call pages", "incident_id", id, "tenant_id", tenantID, "error", err)
+		}
+	}
 	return eventErr
 }
 
@@ -1497,6 +1544,11 @@ func (s *IncidentService) ReopenIncident(ctx context.Context, id, userID, tenant
 		Description: fmt.Sprintf("事件由用户 %d 重新打开", userID), Status: "active", Severity: "info",
 		UserID: &userID, Source: "user",
 	}, tenantID)
+	if eventErr == nil && incidentEntity.IsMajorIncident && s.majorIncidents != nil {
+		if err := s.majorIncidents.OnIncidentReopened(ctx, tenantID, id); err != nil {
+			s.logger.Warnw("Failed to resume major incident updates", "incident_id", id, "tenant_id", tenantID, "error", err)
+		}
+	}
 	return eventErr
 }
 
@@ -0,0 +1,854 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"itsm-backend/common"
+	"itsm-backend/common/tenantctx"
+	"itsm-backend/connector"
+	"itsm-backend/dto"
+	"itsm-backend/ent"
+	"itsm-backend/ent/incident"
+	"itsm-backend/ent/incidentevent"
+	"itsm-backend/ent/majorincident"
+	"itsm-backend/ent/schema"
+	"itsm-backend/ent/user"
+
+	"go.uber.org/zap"
+)
+
+var (
+	ErrMajorIncidentAlreadyDeclared = errors.New("重大事件已宣告")
+	ErrMajorIncidentNotDeclared     = errors.New("事件尚未宣告为重大事件")
+	ErrMajorIncidentClosed          = errors.New("已解决或已关闭的事件不能宣告为重大事件")
+	ErrMajorIncidentResolved        = errors.New("重大事件已解决，不再发送进展通报")
+	ErrMajorIncidentInvalidUser     = errors.New("指挥官或干系人不存在、已停用或不属于当前租户")
+)
+
+const (
+	defaultMajorIncidentCadence = 30
+	// majorIncidentUpdateEntries 每次进展通报最多列出的新增时间线条目
+	majorIncidentUpdateEntries = 10
+	majorIncidentNotification  = "major_incident"
+)
+
+// MajorIncidentService 重大事件（P1）处置：宣告并指定指挥官、经 IM 连接器建立作战室群聊、
+// 按节奏向干系人通报进展、汇总事件时间线，事件解决后借助 SummarizeService 生成复盘草稿。
+type MajorIncidentService struct {
+	client        *ent.Client
+	incidents     *IncidentService
+	connectors    *connector.Manager
+	notifications *NotificationService
+	summarizer    *SummarizeService
+	logger        *zap.SugaredLogger
+	now           func() time.Time
+}
+
+// NewMajorIncidentService 创建重大事件服务
+func NewMajorIncidentService(client *ent.Client, incidents *IncidentService, connectors *connector.Manager, logger *zap.SugaredLogger) *MajorIncidentService {
+	return &MajorIncidentService{
+		client:     client,
+		incidents:  incidents,
+		connectors: connectors,
+		logger:     logger,
+		now:        time.Now,
+	}
+}
+
+// SetNotificationService 设置站内通知服务，用于向指挥官与干系人发送通报
+func (s *MajorIncidentService) SetNotificationService(notifications *NotificationService) {
+	s.notifications = notifications
+}
+
+// SetSummarizeService 设置摘要服务，用于生成复盘草稿的概要与行动项；未设置时仅按时间线拼装草稿
+func (s *MajorIncidentService) SetSummarizeService(summarizer *SummarizeService) {
+	s.summarizer = summarizer
+}
+
+// Declare 宣告重大事件。事件尚未标记为重大事件时先按影响信息升级；作战室创建失败只记录原因，不阻断宣告。
+func (s *MajorIncidentService) Declare(ctx context.Context, tenantID, incidentID, userID int, req *dto.DeclareMajorIncidentRequest) (*dto.MajorIncidentResponse, error) {
+	inc, err := s.loadIncident(ctx, tenantID, incidentID)
+	if err != nil {
+		return nil, err
+	}
+	if inc.Status == common.IncidentStatusResolved || inc.Status == common.IncidentStatusClosed {
+		return nil, ErrMajorIncidentClosed
+	}
+	exists, err := s.client.MajorIncident.Query().
+		Where(majorincident.TenantID(tenantID), majorincident.IncidentID(incidentID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询重大事件失败: %w", err)
+	}
+	if exists {
+		return nil, ErrMajorIncidentAlreadyDeclared
+	}
+	commander, err := s.loadResponder(ctx, tenantID, req.CommanderID)
+	if err != nil {
+		return nil, err
+	}
+	stakeholders := uniquePositiveIDs(req.StakeholderUserIDs)
+	if err := s.ensureUsers(ctx, tenantID, stakeholders); err != nil {
+		return nil, err
+	}
+
+	if !inc.IsMajorIncident {
+		if err := s.incidents.EscalateToMajorIncident(ctx, incidentID, userID, tenantID, &req.EscalateMajorIncidentRequest); err != nil {
+			return nil, err
+		}
+	}
+
+	cadence := req.UpdateCadenceMinutes
+	if cadence <= 0 {
+		cadence = defaultMajorIncidentCadence
+	}
+	now := s.now()
+	rec, err := s.client.MajorIncident.Create().
+		SetTenantID(tenantID).
+		SetIncidentID(incidentID).
+		SetCommanderID(commander.ID).
+		SetDeclaredBy(userID).
+		SetDeclaredAt(now).
+		SetStakeholderUserIds(stakeholders).
+		SetStakeholderChannels(toSchemaChannels(req.StakeholderChannels)).
+		SetUpdateCadenceMinutes(cadence).
+		SetNextUpdateAt(now.Add(time.Duration(cadence) * time.Minute)).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, ErrMajorIncidentAlreadyDeclared
+		}
+		return nil, fmt.Errorf("创建重大事件失败: %w", err)
+	}
+	s.recordEvent(ctx, tenantID, incidentID, &userID, "major_incident_declared", "宣告重大事件",
+		fmt.Sprintf("指挥官：%s，通报间隔 %d 分钟", displayName(commander), cadence),
+		map[string]interface{}{"commanderId": commander.ID, "updateCadenceMinutes": cadence})
+
+	if req.WarRoom != nil {
+		rec = s.openWarRoom(ctx, rec, inc, commander, stakeholders, req.WarRoom, userID)
+	}
+
+	s.notifyUsers(ctx, tenantID, []int{commander.ID}, "你已被指定为重大事件指挥官",
+		fmt.Sprintf("%s %s 已宣告为重大事件，请立即组织处置。", inc.IncidentNumber, inc.Title))
+	content := s.statusUpdateContent(ctx, rec, inc, commander, "重大事件已宣告："+strings.TrimSpace(req.BusinessImpact), nil)
+	if err := s.broadcast(ctx, rec, "【重大事件】"+inc.IncidentNumber+" "+inc.Title, content); err != nil {
+		s.logger.Warnw("Major incident declaration broadcast incomplete", "incident_id", incidentID, "error", err)
+	}
+	return s.toResponse(ctx, rec)
+}
+
+// openWarRoom 通过 IM 连接器创建作战室群聊，失败原因写入 war_room_error；开场简报随宣告通报一并投递到群内
+func (s *MajorIncidentService) openWarRoom(ctx context.Context, rec *ent.MajorIncident, inc *ent.Incident, commander *ent.User, stakeholders []int, req *dto.WarRoomRequest, userID int) *ent.MajorIncident {
+	spec := connector.ChatSpec{
+		Name:        strings.TrimSpace(req.Name),
+		Description: fmt.Sprintf("重大事件 %s 作战室", inc.IncidentNumber),
+		OwnerID:     strings.TrimSpace(req.OwnerIMID),
+		MemberIDs:   append([]string(nil), req.MemberIMIDs...),
+	}
+	if spec.Name == "" {
+		spec.Name = truncateRunes(fmt.Sprintf("[P1] %s %s", inc.IncidentNumber, inc.Title), 60)
+	}
+	if req.Connector == "feishu" {
+		// 飞书成员使用 open_id，已绑定飞书账号的指挥官与干系人自动入群
+		if spec.OwnerID == "" {
+			spec.OwnerID = commander.FeishuOpenID
+		}
+		spec.MemberIDs = append(spec.MemberIDs, commander.FeishuOpenID)
+		if len(stakeholders) > 0 {
+			openIDs, err := s.client.User.Query().
+				Where(user.IDIn(stakeholders...), user.TenantID(rec.TenantID), user.FeishuOpenIDNEQ("")).
+				Select(user.FieldFeishuOpenID).
+				Strings(ctx)
+			if err == nil {
+				spec.MemberIDs = append(spec.MemberIDs, openIDs...)
+			}
+		}
+	}
+
+	update := s.client.MajorIncident.UpdateOne(rec).SetWarRoomConnector(req.Connector)
+	chat, err := s.createChat(ctx, rec.TenantID, req.Connector, spec)
+	if err != nil {
+		s.logger.Warnw("Failed to create war room", "incident_id", inc.ID, "connector", req.Connector, "error", err)
+		update.SetWarRoomError(err.Error())
+		s.recordEvent(ctx, rec.TenantID, inc.ID, &userID, "war_room_failed", "作战室创建失败", err.Error(),
+			map[string]interface{}{"connector": req.Connector})
+	} else {
+		update.SetWarRoomChatID(chat.ID).SetWarRoomChannel(chat.Channel).ClearWarRoomError()
+		s.recordEvent(ctx, rec.TenantID, inc.ID, &userID, "war_room_created", "作战室已建立",
+			fmt.Sprintf("%s 群聊：%s", req.Connector, chat.Name),
+			map[string]interface{}{"connector": req.Connector, "chatId": chat.ID})
+	}
+	saved, err := update.Save(ctx)
+	if err != nil {
+		s.logger.Warnw("Failed to save war room", "incident_id", inc.ID, "error", err)
+		return rec
+	}
+	return saved
+}
+
+func (s *MajorIncidentService) createChat(ctx context.Context, tenantID int, name string, spec connector.ChatSpec) (*connector.Chat, error) {
+	if s.connectors == nil {
+		return nil, fmt.Errorf("未配置连接器")
+	}
+	chat, err := s.connectors.CreateChat(ctx, tenantID, name, spec)
+	if errors.Is(err, connector.ErrNotSupported) {
+		return nil, fmt.Errorf("连接器 %s 不支持创建群聊", name)
+	}
+	return chat, err
+}
+
+// GetMajorIncident 获取事件的重大事件记录
+func (s *MajorIncidentService) GetMajorIncident(ctx context.Context, tenantID, incidentID int) (*dto.MajorIncidentResponse, error) {
+	rec, err := s.load(ctx, tenantID, incidentID)
+	if err != nil {
+		return nil, err
+	}
+	return s.toResponse(ctx, rec)
+}
+
+// ListMajorIncidents 列出租户的重大事件（按宣告时间倒序）
+func (s *MajorIncidentService) ListMajorIncidents(ctx context.Context, tenantID int, filter *dto.MajorIncidentListFilter) ([]*dto.MajorIncidentResponse, error) {
+	query := s.client.MajorIncident.Query().Where(majorincident.TenantID(tenantID))
+	if filter != nil && filter.Status != "" {
+		query = query.Where(majorincident.StatusEQ(majorincident.Status(filter.Status)))
+	}
+	records, err := query.Order(ent.Desc(majorincident.FieldDeclaredAt)).Limit(200).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询重大事件失败: %w", err)
+	}
+	out := make([]*dto.MajorIncidentResponse, 0, len(records))
+	for _, rec := range records {
+		resp, err := s.toResponse(ctx, rec)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, resp)
+	}
+	return out, nil
+}
+
+// UpdateMajorIncident 更换指挥官、调整干系人或通报节奏。节奏变化时以最近一次通报为起点重新计算下一次通报时间
+func (s *MajorIncidentService) UpdateMajorIncident(ctx context.Context, tenantID, incidentID, userID int, req *dto.UpdateMajorIncidentRequest) (*dto.MajorIncidentResponse, error) {
+	rec, err := s.load(ctx, tenantID, incidentID)
+	if err != nil {
+		return nil, err
+	}
+	update := s.client.MajorIncident.UpdateOne(rec)
+	var newCommander *ent.User
+	if req.CommanderID != nil && *req.CommanderID != rec.CommanderID {
+		if newCommander, err = s.loadResponder(ctx, tenantID, *req.CommanderID); err != nil {
+			return nil, err
+		}
+		update.SetCommanderID(newCommander.ID)
+	}
+	if req.StakeholderUserIDs != nil {
+		ids := uniquePositiveIDs(*req.StakeholderUserIDs)
+		if err := s.ensureUsers(ctx, tenantID, ids); err != nil {
+			return nil, err
+		}
+		update.SetStakeholderUserIds(ids)
+	}
+	if req.StakeholderChannels != nil {
+		update.SetStakeholderChannels(toSchemaChannels(*req.StakeholderChannels))
+	}
+	if req.UpdateCadenceMinutes != nil && *req.UpdateCadenceMinutes != rec.UpdateCadenceMinutes {
+		update.SetUpdateCadenceMinutes(*req.UpdateCadenceMinutes)
+		if rec.Status == majorincident.StatusActive {
+			update.SetNextUpdateAt(s.nextUpdateAfter(rec, *req.UpdateCadenceMinutes))
+		}
+	}
+	saved, err := update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("更新重大事件失败: %w", err)
+	}
+	if newCommander != nil {
+		s.recordEvent(ctx, tenantID, incidentID, &userID, "commander_changed", "更换事件指挥官",
+			"新指挥官："+displayName(newCommander),
+			map[string]interface{}{"from": rec.CommanderID, "to": newCommander.ID})
+		if inc, err := s.loadIncident(ctx, tenantID, incidentID); err == nil {
+			s.notifyUsers(ctx, tenantID, []int{newCommander.ID}, "你已被指定为重大事件指挥官",
+				fmt.Sprintf("%s %s 的指挥官已移交给你。", inc.IncidentNumber, inc.Title))
+		}
+	}
+	return s.toResponse(ctx, saved)
+}
+
+// nextUpdateAfter 以最近一次通报（没有则为宣告时间）加新的间隔作为下一次通报时间，已过期时立即通报
+func (s *MajorIncidentService) nextUpdateAfter(rec *ent.MajorIncident, cadence int) time.Time {
+	from := rec.DeclaredAt
+	if rec.LastUpdateAt != nil {
+		from = *rec.LastUpdateAt
+	}
+	next := from.Add(time.Duration(cadence) * time.Minute)
+	if now := s.now(); next.Before(now) {
+		return now
+	}
+	return next
+}
+
+// PostUpdate 手工发布一次进展通报，并从此刻起重新计时下一次自动通报
+func (s *MajorIncidentService) PostUpdate(ctx context.Context, tenantID, incidentID, userID int, req *dto.PostStakeholderUpdateRequest) (*dto.MajorIncidentResponse, error) {
+	rec, err := s.load(ctx, tenantID, incidentID)
+	if err != nil {
+		return nil, err
+	}
+	if rec.Status != majorincident.StatusActive {
+		return nil, ErrMajorIncidentResolved
+	}
+	saved, err := s.sendUpdate(ctx, rec, strings.TrimSpace(req.Message), &userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.toResponse(ctx, saved)
+}
+
+// DispatchDueUpdates 发送到期的干系人进展通报，返回发送的通报数。多个实例并发巡检时，
+// 先以 next_update_at 做条件更新认领，认领成功者才发送，同一轮通报不会重复发出。
+//
+// RLS 说明：枚举到期记录是跨租户操作，使用 SystemContext；逐条处理时切回租户上下文。
+func (s *MajorIncidentService) DispatchDueUpdates(ctx context.Context) (int, error) {
+	ctx = tenantctx.SystemContext(ctx, "major_incident:updates", "send due stakeholder updates of active major incidents")
+	due, err := s.client.MajorIncident.Query().
+		Where(majorincident.StatusEQ(majorincident.StatusActive), majorincident.NextUpdateAtLTE(s.now())).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("查询待通报重大事件失败: %w", err)
+	}
+	sent := 0
+	for _, rec := range due {
+		tenantCtx := tenantctx.WithTenantID(ctx, rec.TenantID)
+		inc, err := s.loadIncident(tenantCtx, rec.TenantID, rec.IncidentID)
+		if errors.Is(err, ErrIncidentNotFound) {
+			// 事件已删除：停止通报
+			_ = s.client.MajorIncident.UpdateOne(rec).ClearNextUpdateAt().Exec(tenantCtx)
+			continue
+		}
+		if err != nil {
+			s.logger.Warnw("Failed to load major incident", "incident_id", rec.IncidentID, "error", err)
+			continue
+		}
+		if inc.Status == common.IncidentStatusResolved || inc.Status == common.IncidentStatusClosed {
+			// 绕过解决动作结束的事件（如直接关闭）在此收尾
+			if err := s.OnIncidentResolved(tenantCtx, rec.TenantID, rec.IncidentID); err != nil {
+				s.logger.Warnw("Failed to wrap up major incident", "incident_id", rec.IncidentID, "error", err)
+			}
+			continue
+		}
+		if _, err := s.sendUpdate(tenantCtx, rec, "", nil); err != nil {
+			s.logger.Warnw("Failed to send major incident update", "incident_id", rec.IncidentID, "error", err)
+			continue
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+// sendUpdate 认领并发送一次进展通报；认领失败（已被其他实例发送）时返回最新记录且不发送
+func (s *MajorIncidentService) sendUpdate(ctx context.Context, rec *ent.MajorIncident, message string, userID *int) (*ent.MajorIncident, error) {
+	now := s.now()
+	claim := s.client.MajorIncident.Update().
+		Where(majorincident.ID(rec.ID), majorincident.StatusEQ(majorincident.StatusActive))
+	if rec.NextUpdateAt != nil {
+		claim.Where(majorincident.NextUpdateAt(*rec.NextUpdateAt))
+	} else {
+		claim.Where(majorincident.NextUpdateAtIsNil())
+	}
+	n, err := claim.
+		SetNextUpdateAt(now.Add(time.Duration(rec.UpdateCadenceMinutes) * time.Minute)).
+		SetLastUpdateAt(now).
+		AddUpdateCount(1).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("更新通报时间失败: %w", err)
+	}
+	saved, err := s.client.MajorIncident.Get(ctx, rec.ID)
+	if err != nil {
+		return nil, fmt.Errorf("获取重大事件失败: %w", err)
+	}
+	if n == 0 {
+		return saved, nil
+	}
+
+	inc, err := s.loadIncident(ctx, rec.TenantID, rec.IncidentID)
+	if err != nil {
+		return nil, err
+	}
+	commander, _ := s.client.User.Get(ctx, rec.CommanderID)
+	since := rec.DeclaredAt
+	if rec.LastUpdateAt != nil {
+		since = *rec.LastUpdateAt
+	}
+	timeline, err := s.Timeline(ctx, rec.TenantID, rec.IncidentID)
+	if err != nil {
+		return nil, err
+	}
+	recent := make([]dto.IncidentTimelineEntry, 0)
+	for _, entry := range timeline {
+		if entry.At.After(since) && entry.Kind != "stakeholder_update" {
+			recent = append(recent, entry)
+		}
+	}
+	title := fmt.Sprintf("【重大事件进展 #%d】%s %s", saved.UpdateCount, inc.IncidentNumber, inc.Title)
+	content := s.statusUpdateContent(ctx, saved, inc, commander, message, recent)
+	if err := s.broadcast(ctx, saved, title, content); err != nil {
+		s.logger.Warnw("Major incident update broadcast incomplete", "incident_id", inc.ID, "error", err)
+	}
+	source := "system"
+	if userID != nil {
+		source = "user"
+	}
+	description := message
+	if description == "" {
+		description = fmt.Sprintf("第 %d 次定时进展通报", saved.UpdateCount)
+	}
+	s.recordEventFrom(ctx, rec.TenantID, rec.IncidentID, userID, source, "stakeholder_update", "干系人进展通报", description,
+		map[string]interface{}{"sequence": saved.UpdateCount})
+	return saved, nil
+}
+
+// OnIncidentResolved 事件解决（或关闭）后停止通报、发送收尾通报并生成复盘草稿；重复调用无副作用
+func (s *MajorIncidentService) OnIncidentResolved(ctx context.Context, tenantID, incidentID int) error {
+	rec, err := s.load(ctx, tenantID, incidentID)
+	if errors.Is(err, ErrMajorIncidentNotDeclared) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	now := s.now()
+	n, err := s.client.MajorIncident.Update().
+		Where(majorincident.ID(rec.ID), majorincident.StatusEQ(majorincident.StatusActive)).
+		SetStatus(majorincident.StatusResolved).
+		SetResolvedAt(now).
+		ClearNextUpdateAt().
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("更新重大事件状态失败: %w", err)
+	}
+	if n == 0 {
+		return nil
+	}
+	saved, err := s.client.MajorIncident.Get(ctx, rec.ID)
+	if err != nil {
+		return fmt.Errorf("获取重大事件失败: %w", err)
+	}
+	inc, err := s.loadIncident(ctx, tenantID, incidentID)
+	if err != nil {
+		return err
+	}
+	commander, _ := s.client.User.Get(ctx, saved.CommanderID)
+	content := s.statusUpdateContent(ctx, saved, inc, commander, "事件已解决，停止定时通报。复盘草稿已生成，请指挥官校对。", nil)
+	if err := s.broadcast(ctx, saved, "【重大事件已解决】"+inc.IncidentNumber+" "+inc.Title, content); err != nil {
+		s.logger.Warnw("Major incident resolution broadcast incomplete", "incident_id", incidentID, "error", err)
+	}
+	_, err = s.GeneratePostmortem(ctx, tenantID, incidentID)
+	return err
+}
+
+// OnIncidentReopened 重大事件重新打开后恢复定时通报
+func (s *MajorIncidentService) OnIncidentReopened(ctx context.Context, tenantID, incidentID int) error {
+	rec, err := s.load(ctx, tenantID, incidentID)
+	if errors.Is(err, ErrMajorIncidentNotDeclared) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if rec.Status == majorincident.StatusActive {
+		return nil
+	}
+	return s.client.MajorIncident.UpdateOne(rec).
+		SetStatus(majorincident.StatusActive).
+		ClearResolvedAt().
+		SetNextUpdateAt(s.now()).
+		Exec(ctx)
+}
+
+// GeneratePostmortem 按时间线、影响评估、根因与解决步骤生成复盘草稿；
+// 概要与后续行动项由 SummarizeService 生成，LLM 不可用时保留待补充占位。可重复调用以重新生成。
+func (s *MajorIncidentService) GeneratePostmortem(ctx context.Context, tenantID, incidentID int) (*dto.MajorIncidentResponse, error) {
+	rec, err := s.load(ctx, tenantID, incidentID)
+	if err != nil {
+		return nil, err
+	}
+	inc, err := s.loadIncident(ctx, tenantID, incidentID)
+	if err != nil {
+		return nil, err
+	}
+	timeline, err := s.Timeline(ctx, tenantID, incidentID)
+	if err != nil {
+		return nil, err
+	}
+	commander, _ := s.client.User.Get(ctx, rec.CommanderID)
+	draft := s.buildPostmortem(ctx, rec, inc, commander, timeline)
+	saved, err := s.client.MajorIncident.UpdateOne(rec).
+		SetPostmortemDraft(draft).
+		SetPostmortemGeneratedAt(s.now()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("保存复盘草稿失败: %w", err)
+	}
+	s.recordEvent(ctx, tenantID, incidentID, nil, "postmortem_draft", "生成复盘草稿", "事后复盘草稿已生成", nil)
+	return s.toResponse(ctx, saved)
+}
+
+func (s *MajorIncidentService) buildPostmortem(ctx context.Context, rec *ent.MajorIncident, inc *ent.Incident, commander *ent.User, timeline []dto.IncidentTimelineEntry) string {
+	var lines strings.Builder
+	for _, entry := range timeline {
+		lines.WriteString(timelineLine(entry))
+		lines.WriteString("\n")
+	}
+	timelineText := lines.String()
+
+	impact, _ := inc.ImpactAnalysis["majorIncident"].(map[string]interface{})
+	businessImpact := stringOr(impact["businessImpact"], "待补充")
+	rootCause := stringOr(inc.RootCause["rootCause"], "待补充")
+
+	summary := "待补充"
+	actions := []string{}
+	if s.summarizer != nil {
+		contextInfo := fmt.Sprintf("事件：%s %s\n业务影响：%s\n根本原因：%s", inc.IncidentNumber, inc.Title, businessImpact, rootCause)
+		if text, err := s.summarizer.SummarizeWithContext(ctx, timelineText, contextInfo, 500); err == nil && strings.TrimSpace(text) != "" {
+			// 无 LLM 时摘要退化为按字节截断，需剔除截断产生的半个字符
+			summary = strings.ToValidUTF8(strings.TrimSpace(text), "")
+		}
+		if items, err := s.summarizer.GenerateActionItems(ctx, contextInfo+"\n"+timelineText); err == nil {
+			actions = items
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# 事后复盘草稿：%s %s\n\n", inc.IncidentNumber, inc.Title)
+	b.WriteString("> 本草稿根据事件时间线自动生成，请指挥官校对补充后发布。\n\n")
+	b.WriteString("## 概要\n\n")
+	b.WriteString(summary)
+	b.WriteString("\n\n## 基本信息\n\n")
+	fmt.Fprintf(&b, "- 严重程度：%s，优先级：%s\n", inc.Severity, inc.Priority)
+	fmt.Fprintf(&b, "- 影响范围：%s\n", stringOr(impact["impactScope"], "待补充"))
+	fmt.Fprintf(&b, "- 业务影响：%s\n", businessImpact)
+	fmt.Fprintf(&b, "- 事件指挥官：%s\n", displayName(commander))
+	fmt.Fprintf(&b, "- 发现时间：%s\n", formatTimelineTime(detectedAt(inc)))
+	fmt.Fprintf(&b, "- 宣告时间：%s\n", formatTimelineTime(rec.DeclaredAt))
+	if !inc.ResolvedAt.IsZero() {
+		fmt.Fprintf(&b, "- 解决时间：%s\n", formatTimelineTime(inc.ResolvedAt))
+		fmt.Fprintf(&b, "- 持续时长：%s\n", formatDuration(inc.ResolvedAt.Sub(detectedAt(inc))))
+	}
+	fmt.Fprintf(&b, "- 进展通报：%d 次\n", rec.UpdateCount)
+	b.WriteString("\n## 时间线\n\n")
+	b.WriteString(timelineText)
+	b.WriteString("\n## 根本原因\n\n")
+	b.WriteString(rootCause)
+	b.WriteString("\n\n## 解决措施\n\n")
+	if len(inc.ResolutionSteps) == 0 {
+		b.WriteString("待补充\n")
+	}
+	for i, step := range inc.ResolutionSteps {
+		fmt.Fprintf(&b, "%d. %s\n", i+1, stringOr(step["description"], ""))
+	}
+	b.WriteString("\n## 后续行动项\n\n")
+	if len(actions) == 0 {
+		b.WriteString("- 待补充\n")
+	}
+	for _, item := range actions {
+		fmt.Fprintf(&b, "- %s\n", item)
+	}
+	return b.String()
+}
+
+// Timeline 汇总事件时间线：事件创建、活动记录（含评论、状态流转、通报与宣告）以及缺少活动记录的解决/关闭时间，按时间升序
+func (s *MajorIncidentService) Timeline(ctx context.Context, tenantID, incidentID int) ([]dto.IncidentTimelineEntry, error) {
+	inc, err := s.loadIncident(ctx, tenantID, incidentID)
+	if err != nil {
+		return nil, err
+	}
+	events, err := s.client.IncidentEvent.Query().
+		Where(incidentevent.IncidentID(incidentID), incidentevent.TenantID(tenantID)).
+		Order(ent.Asc(incidentevent.FieldOccurredAt), ent.Asc(incidentevent.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询事件活动失败: %w", err)
+	}
+
+	entries := make([]dto.IncidentTimelineEntry, 0, len(events)+3)
+	reporter := inc.ReporterID
+	entries = append(entries, dto.IncidentTimelineEntry{
+		At: detectedAt(inc), Kind: "created", Title: "事件创建", Description: inc.Title, UserID: &reporter, Source: inc.Source,
+	})
+	seen := map[string]bool{}
+	userIDs := []int{inc.ReporterID}
+	for _, ev := range events {
+		seen[ev.EventType] = true
+		entry := dto.IncidentTimelineEntry{
+			At: ev.OccurredAt, Kind: ev.EventType, Title: ev.EventName, Description: ev.Description,
+			Source: ev.Source, Data: ev.Data,
+		}
+		if ev.EventType == "comment" && entry.Title == "" {
+			entry.Title = "评论"
+		}
+		if ev.UserID > 0 {
+			uid := ev.UserID
+			entry.UserID = &uid
+			userIDs = append(userIDs, uid)
+		}
+		entries = append(entries, entry)
+	}
+	if !inc.ResolvedAt.IsZero() && !seen["resolution"] {
+		entries = append(entries, dto.IncidentTimelineEntry{At: inc.ResolvedAt, Kind: "resolution", Title: "事件解决", Source: "system"})
+	}
+	if !inc.ClosedAt.IsZero() && !seen["closure"] {
+		entries = append(entries, dto.IncidentTimelineEntry{At: inc.ClosedAt, Kind: "closure", Title: "事件关闭", Source: "system"})
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].At.Before(entries[j].At) })
+
+	names := s.userNames(ctx, tenantID, userIDs)
+	for i := range entries {
+		if entries[i].UserID != nil {
+			entries[i].UserName = names[*entries[i].UserID]
+		}
+	}
+	return entries, nil
+}
+
+// statusUpdateContent 组装进展通报正文：当前状态、持续时长、指挥官、手工说明与新增时间线
+func (s *MajorIncidentService) statusUpdateContent(ctx context.Context, rec *ent.MajorIncident, inc *ent.Incident, commander *ent.User, message string, recent []dto.IncidentTimelineEntry) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "**%s %s**\n", inc.IncidentNumber, inc.Title)
+	fmt.Fprintf(&b, "状态：%s　严重程度：%s　优先级：%s\n", inc.Status, inc.Severity, inc.Priority)
+	fmt.Fprintf(&b, "指挥官：%s　已持续：%s\n", displayName(commander), formatDuration(s.now().Sub(detectedAt(inc))))
+	if message != "" {
+		b.WriteString("\n")
+		b.WriteString(message)
+		b.WriteString("\n")
+	}
+	if len(recent) > 0 {
+		b.WriteString("\n最新进展：\n")
+		if len(recent) > majorIncidentUpdateEntries {
+			recent = recent[len(recent)-majorIncidentUpdateEntries:]
+		}
+		for _, entry := range recent {
+			b.WriteString(timelineLine(entry))
+			b.WriteString("\n")
+		}
+	}
+	if rec.Status == majorincident.StatusActive && rec.NextUpdateAt != nil {
+		fmt.Fprintf(&b, "\n下次通报：%s", formatTimelineTime(*rec.NextUpdateAt))
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// broadcast 向指挥官与干系人发送站内通知，并投递到作战室与外部通报通道；各通道互不影响，返回合并的投递错误
+func (s *MajorIncidentService) broadcast(ctx context.Context, rec *ent.MajorIncident, title, content string) error {
+	var errs []error
+	recipients := append([]int{rec.CommanderID}, rec.StakeholderUserIds...)
+	if s.notifications != nil {
+		if err := s.notifications.CreateSystemNotification(ctx, title, content, majorIncidentNotification, recipients, rec.TenantID); err != nil {
+			errs = append(errs, fmt.Errorf("站内通知: %w", err))
+		}
+	}
+	targets := make([]schema.StakeholderChannel, 0, len(rec.StakeholderChannels)+1)
+	if rec.WarRoomChannel != "" {
+		targets = append(targets, schema.StakeholderChannel{Connector: rec.WarRoomConnector, Channel: rec.WarRoomChannel})
+	}
+	targets = append(targets, rec.StakeholderChannels...)
+	for _, target := range targets {
+		if s.connectors == nil {
+			errs = append(errs, fmt.Errorf("%s: 未配置连接器", target.Connector))
+			break
+		}
+		msg := &connector.Message{Channel: target.Channel, Type: "markdown", Title: title, Content: content}
+		if err := s.connectors.Send(ctx, rec.TenantID, target.Connector, msg); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", target.Connector, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *MajorIncidentService) notifyUsers(ctx context.Context, tenantID int, userIDs []int, title, message string) {
+	if s.notifications == nil {
+		return
+	}
+	if err := s.notifications.CreateSystemNotification(ctx, title, message, majorIncidentNotification, userIDs, tenantID); err != nil {
+		s.logger.Warnw("Failed to notify major incident participants", "tenant_id", tenantID, "error", err)
+	}
+}
+
+func (s *MajorIncidentService) recordEvent(ctx context.Context, tenantID, incidentID int, userID *int, eventType, name, description string, data map[string]interface{}) {
+	source := "system"
+	if userID != nil {
+		source = "user"
+	}
+	s.recordEventFrom(ctx, tenantID, incidentID, userID, source, eventType, name, description, data)
+}
+
+func (s *MajorIncidentService) recordEventFrom(ctx context.Context, tenantID, incidentID int, userID *int, source, eventType, name, description string, data map[string]interface{}) {
+	_, err := s.incidents.CreateIncidentEvent(ctx, &dto.CreateIncidentEventRequest{
+		IncidentID: incidentID, EventType: eventType, EventName: name, Description: description,
+		Status: "active", Severity: "info", Data: data, UserID: userID, Source: source,
+	}, tenantID)
+	if err != nil {
+		s.logger.Warnw("Failed to record major incident event", "incident_id", incidentID, "type", eventType, "error", err)
+	}
+}
+
+func (s *MajorIncidentService) load(ctx context.Context, tenantID, incidentID int) (*ent.MajorIncident, error) {
+	rec, err := s.client.MajorIncident.Query().
+		Where(majorincident.TenantID(tenantID), majorincident.IncidentID(incidentID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrMajorIncidentNotDeclared
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询重大事件失败: %w", err)
+	}
+	return rec, nil
+}
+
+func (s *MajorIncidentService) loadIncident(ctx context.Context, tenantID, incidentID int) (*ent.Incident, error) {
+	inc, err := s.client.Incident.Query().
+		Where(incident.IDEQ(incidentID), incident.TenantIDEQ(tenantID), incident.DeletedAtIsNil()).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrIncidentNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询事件失败: %w", err)
+	}
+	return inc, nil
+}
+
+// loadResponder 校验指挥官：当前租户内启用的处理人员，最终用户不能担任
+func (s *MajorIncidentService) loadResponder(ctx context.Context, tenantID, userID int) (*ent.User, error) {
+	u, err := s.client.User.Query().
+		Where(user.ID(userID), user.TenantID(tenantID), user.Active(true), user.RoleNEQ(user.RoleEndUser)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrMajorIncidentInvalidUser
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询用户失败: %w", err)
+	}
+	return u, nil
+}
+
+func (s *MajorIncidentService) ensureUsers(ctx context.Context, tenantID int, ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	n, err := s.client.User.Query().Where(user.IDIn(ids...), user.TenantID(tenantID), user.Active(true)).Count(ctx)
+	if err != nil {
+		return fmt.Errorf("查询用户失败: %w", err)
+	}
+	if n != len(ids) {
+		return ErrMajorIncidentInvalidUser
+	}
+	return nil
+}
+
+func (s *MajorIncidentService) userNames(ctx context.Context, tenantID int, ids []int) map[int]string {
+	names := make(map[int]string)
+	ids = uniquePositiveIDs(ids)
+	if len(ids) == 0 {
+		return names
+	}
+	users, err := s.client.User.Query().Where(user.IDIn(ids...), user.TenantID(tenantID)).All(ctx)
+	if err != nil {
+		return names
+	}
+	for _, u := range users {
+		names[u.ID] = displayName(u)
+	}
+	return names
+}
+
+func (s *MajorIncidentService) toResponse(ctx context.Context, rec *ent.MajorIncident) (*dto.MajorIncidentResponse, error) {
+	resp := &dto.MajorIncidentResponse{
+		ID:                    rec.ID,
+		IncidentID:            rec.IncidentID,
+		CommanderID:           rec.CommanderID,
+		DeclaredBy:            rec.DeclaredBy,
+		DeclaredAt:            rec.DeclaredAt,
+		Status:                string(rec.Status),
+		WarRoomConnector:      rec.WarRoomConnector,
+		WarRoomChatID:         rec.WarRoomChatID,
+		WarRoomError:          rec.WarRoomError,
+		StakeholderUserIDs:    rec.StakeholderUserIds,
+		StakeholderChannels:   make([]dto.StakeholderChannel, 0, len(rec.StakeholderChannels)),
+		UpdateCadenceMinutes:  rec.UpdateCadenceMinutes,
+		NextUpdateAt:          rec.NextUpdateAt,
+		LastUpdateAt:          rec.LastUpdateAt,
+		UpdateCount:           rec.UpdateCount,
+		ResolvedAt:            rec.ResolvedAt,
+		PostmortemDraft:       rec.PostmortemDraft,
+		PostmortemGeneratedAt: rec.PostmortemGeneratedAt,
+	}
+	if resp.StakeholderUserIDs == nil {
+		resp.StakeholderUserIDs = []int{}
+	}
+	for _, ch := range rec.StakeholderChannels {
+		resp.StakeholderChannels = append(resp.StakeholderChannels, dto.StakeholderChannel{Connector: ch.Connector, Channel: ch.Channel})
+	}
+	if inc, err := s.client.Incident.Query().Where(incident.IDEQ(rec.IncidentID), incident.TenantIDEQ(rec.TenantID)).Only(ctx); err == nil {
+		resp.IncidentNumber = inc.IncidentNumber
+		resp.IncidentTitle = inc.Title
+	}
+	if commander, err := s.client.User.Get(ctx, rec.CommanderID); err == nil {
+		resp.CommanderName = displayName(commander)
+	}
+	return resp, nil
+}
+
+func toSchemaChannels(channels []dto.StakeholderChannel) []schema.StakeholderChannel {
+	out := make([]schema.StakeholderChannel, 0, len(channels))
+	for _, ch := range channels {
+		connectorName, channel := strings.TrimSpace(ch.Connector), strings.TrimSpace(ch.Channel)
+		if connectorName == "" || channel == "" {
+			continue
+		}
+		out = append(out, schema.StakeholderChannel{Connector: connectorName, Channel: channel})
+	}
+	return out
+}
+
+func detectedAt(inc *ent.Incident) time.Time {
+	if !inc.DetectedAt.IsZero() {
+		return inc.DetectedAt
+	}
+	return inc.CreatedAt
+}
+
+func timelineLine(entry dto.IncidentTimelineEntry) string {
+	line := fmt.Sprintf("- %s %s", formatTimelineTime(entry.At), entry.Title)
+	if entry.UserName != "" {
+		line += "（" + entry.UserName + "）"
+	}
+	if entry.Description != "" {
+		line += "：" + entry.Description
+	}
+	return line
+}
+
+func formatTimelineTime(t time.Time) string {
+	return t.Format("2006-01-02 15:04")
+}
+
+func displayName(u *ent.User) string {
+	if u == nil {
+		return "未指定"
+	}
+	if u.Name != "" {
+		return u.Name
+	}
+	return u.Username
+}
+
+func stringOr(v interface{}, def string) string {
+	if s, ok := v.(string); ok && strings.TrimSpace(s) != "" {
+		return strings.TrimSpace(s)
+	}
+	return def
+}
@@ -0,0 +1,244 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"itsm-backend/connector"
+	"itsm-backend/dto"
+	"itsm-backend/ent/enttest"
+	"itsm-backend/ent/incidentevent"
+	"itsm-backend/ent/notification"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// warRoomTestConnector 记录建群参数与已发送消息的 IM 连接器
+type warRoomTestConnector struct {
+	mu    sync.Mutex
+	specs []connector.ChatSpec
+	sent  []*connector.Message
+}
+
+func (*warRoomTestConnector) Manifest() connector.Manifest {
+	return connector.Manifest{
+		Name: "feishu", Version: "1.0.0", Title: "Test Feishu", Type: connector.TypeIM,
+		Capabilities: []connector.Capability{connector.CapSendMessage, connector.CapCreateChat}, RequiredPermissions: []string{"connector:send"},
+	}
+}
+func (*warRoomTestConnector) Init(context.Context, connector.Config) error { return nil }
+func (c *warRoomTestConnector) Send(_ context.Context, msg *connector.Message) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sent = append(c.sent, msg)
+	return nil
+}
+func (c *warRoomTestConnector) CreateChat(_ context.Context, spec connector.ChatSpec) (*connector.Chat, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.specs = append(c.specs, spec)
+	return &connector.Chat{ID: "oc_warroom", Name: spec.Name, Channel: "chat:oc_warroom"}, nil
+}
+func (*warRoomTestConnector) HealthCheck(context.Context) connector.HealthStatus {
+	return connector.HealthStatus{OK: true}
+}
+func (*warRoomTestConnector) Close() error { return nil }
+
+func (c *warRoomTestConnector) sentTo(channel string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	n := 0
+	for _, msg := range c.sent {
+		if msg.Channel == channel {
+			n++
+		}
+	}
+	return n
+}
+
+func TestMajorIncidentService(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", testDSN())
+	defer client.Close()
+	ctx := context.Background()
+	logger := zap.NewNop().Sugar()
+
+	tenant, err := createIncidentTestTenant(ctx, client, "major")
+	require.NoError(t, err)
+	reporter, err := createIncidentTestUser(ctx, client, tenant.ID, "major-reporter")
+	require.NoError(t, err)
+	commander, err := client.User.Create().SetUsername("ic").SetEmail("ic@example.com").SetName("指挥官").
+		SetPasswordHash("hash").SetRole("manager").SetActive(true).SetTenantID(tenant.ID).SetFeishuOpenID("ou_ic").Save(ctx)
+	require.NoError(t, err)
+	stakeholder, err := client.User.Create().SetUsername("cio").SetEmail("cio@example.com").SetName("CIO").
+		SetPasswordHash("hash").SetRole("end_user").SetActive(true).SetTenantID(tenant.ID).SetFeishuOpenID("ou_cio").Save(ctx)
+	require.NoError(t, err)
+
+	fake := &warRoomTestConnector{}
+	registry := connector.NewRegistry()
+	registry.Register(func() connector.Connector { return fake })
+	manager := connector.NewManager(registry, logger)
+	require.NoError(t, manager.Provision(ctx, connector.Config{TenantID: tenant.ID, Name: "feishu", Provider: "test", Enabled: true}))
+
+	incidents := NewIncidentService(client, logger)
+	svc := NewMajorIncidentService(client, incidents, manager, logger)
+	svc.SetNotificationService(NewNotificationService(client))
+	svc.SetSummarizeService(NewSummarizeService(nil, zap.NewNop()))
+	incidents.SetMajorIncidentService(svc)
+	clock := time.Now()
+	svc.now = func() time.Time { return clock }
+
+	inc, err := incidents.CreateIncident(ctx, &dto.CreateIncidentRequest{
+		Title: "支付网关全面不可用", Description: "所有支付请求超时", Priority: "critical", Severity: "high", Category: "network", Source: "monitoring",
+	}, tenant.ID, reporter.ID)
+	require.NoError(t, err)
+
+	declare := &dto.DeclareMajorIncidentRequest{
+		EscalateMajorIncidentRequest: dto.EscalateMajorIncidentRequest{ImpactScope: "critical", BusinessImpact: "线上支付全部失败"},
+		CommanderID:                  stakeholder.ID,
+		StakeholderUserIDs:           []int{stakeholder.ID},
+		StakeholderChannels:          []dto.StakeholderChannel{{Connector: "feishu", Channel: "ou_ceo"}},
+		WarRoom:                      &dto.WarRoomRequest{Connector: "feishu", MemberIMIDs: []string{"ou_dba"}},
+	}
+
+	t.Run("declare", func(t *testing.T) {
+		_, err := svc.Declare(ctx, tenant.ID, inc.ID, reporter.ID, declare)
+		require.ErrorIs(t, err, ErrMajorIncidentInvalidUser, "最终用户不能担任指挥官")
+
+		declare.CommanderID = commander.ID
+		resp, err := svc.Declare(ctx, tenant.ID, inc.ID, reporter.ID, declare)
+		require.NoError(t, err)
+		require.Equal(t, "active", resp.Status)
+		require.Equal(t, "oc_warroom", resp.WarRoomChatID)
+		require.Empty(t, resp.WarRoomError)
+		require.Equal(t, 30, resp.UpdateCadenceMinutes)
+		require.WithinDuration(t, clock.Add(30*time.Minute), *resp.NextUpdateAt, time.Second)
+
+		require.Len(t, fake.specs, 1)
+		require.Equal(t, "ou_ic", fake.specs[0].OwnerID)
+		require.ElementsMatch(t, []string{"ou_dba", "ou_ic", "ou_cio"}, fake.specs[0].MemberIDs)
+		require.Equal(t, 1, fake.sentTo("chat:oc_warroom"), "宣告简报投递到作战室")
+		require.Equal(t, 1, fake.sentTo("ou_ceo"))
+
+		stored, err := client.Incident.Get(ctx, inc.ID)
+		require.NoError(t, err)
+		require.True(t, stored.IsMajorIncident)
+		require.Equal(t, "critical", stored.Severity)
+		require.Equal(t, 2, client.Notification.Query().Where(notification.UserID(commander.ID)).CountX(ctx))
+
+		_, err = svc.Declare(ctx, tenant.ID, inc.ID, reporter.ID, declare)
+		require.ErrorIs(t, err, ErrMajorIncidentAlreadyDeclared)
+	})
+
+	t.Run("scheduled and manual updates", func(t *testing.T) {
+		require.NoError(t, incidents.AcknowledgeIncident(ctx, inc.ID, commander.ID, tenant.ID))
+		_, err := incidents.CreateIncidentEvent(ctx, &dto.CreateIncidentEventRequest{
+			IncidentID: inc.ID, EventType: "comment", EventName: "评论", Description: "已切换到备用网关", Status: "active", Severity: "info",
+			UserID: &commander.ID, Source: "user",
+		}, tenant.ID)
+		require.NoError(t, err)
+
+		n, err := svc.DispatchDueUpdates(ctx)
+		require.NoError(t, err)
+		require.Zero(t, n, "未到通报时间")
+
+		clock = clock.Add(31 * time.Minute)
+		n, err = svc.DispatchDueUpdates(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+		n, err = svc.DispatchDueUpdates(ctx)
+		require.NoError(t, err)
+		require.Zero(t, n, "同一轮通报只发送一次")
+		require.Equal(t, 2, fake.sentTo("chat:oc_warroom"))
+
+		resp, err := svc.PostUpdate(ctx, tenant.ID, inc.ID, commander.ID, &dto.PostStakeholderUpdateRequest{Message: "预计 15 分钟内恢复"})
+		require.NoError(t, err)
+		require.Equal(t, 2, resp.UpdateCount)
+		require.WithinDuration(t, clock.Add(30*time.Minute), *resp.NextUpdateAt, time.Second)
+
+		cadence := 10
+		resp, err = svc.UpdateMajorIncident(ctx, tenant.ID, inc.ID, commander.ID, &dto.UpdateMajorIncidentRequest{UpdateCadenceMinutes: &cadence})
+		require.NoError(t, err)
+		require.WithinDuration(t, clock.Add(10*time.Minute), *resp.NextUpdateAt, time.Second)
+
+		updates := client.IncidentEvent.Query().Where(incidentevent.IncidentID(inc.ID), incidentevent.EventType("stakeholder_update")).CountX(ctx)
+		require.Equal(t, 2, updates)
+	})
+
+	t.Run("timeline", func(t *testing.T) {
+		entries, err := svc.Timeline(ctx, tenant.ID, inc.ID)
+		require.NoError(t, err)
+		kinds := make([]string, 0, len(entries))
+		for i, entry := range entries {
+			kinds = append(kinds, entry.Kind)
+			if i > 0 {
+				require.False(t, entry.At.Before(entries[i-1].At), "时间线按时间升序")
+			}
+		}
+		require.Equal(t, "created", kinds[0])
+		for _, kind := range []string{"major_incident_escalation", "major_incident_declared", "war_room_created", "acknowledgement", "comment", "stakeholder_update"} {
+			require.Contains(t, kinds, kind)
+		}
+		for _, entry := range entries {
+			if entry.Kind == "comment" {
+				require.Equal(t, "指挥官", entry.UserName)
+			}
+		}
+	})
+
+	t.Run("resolution generates postmortem", func(t *testing.T) {
+		inProgress := "in_progress"
+		_, err := incidents.UpdateIncident(ctx, inc.ID, &dto.UpdateIncidentRequest{Status: &inProgress, Force: true}, tenant.ID)
+		require.NoError(t, err)
+		require.NoError(t, incidents.ResolveIncident(ctx, inc.ID, commander.ID, tenant.ID, "回滚网关配置", "证书轮换后配置未同步"))
+
+		resp, err := svc.GetMajorIncident(ctx, tenant.ID, inc.ID)
+		require.NoError(t, err)
+		require.Equal(t, "resolved", resp.Status)
+		require.Nil(t, resp.NextUpdateAt)
+		require.NotNil(t, resp.PostmortemGeneratedAt)
+		draft := resp.PostmortemDraft
+		require.True(t, strings.HasPrefix(draft, "# 事后复盘草稿："+inc.IncidentNumber))
+		require.Contains(t, draft, "证书轮换后配置未同步")
+		require.Contains(t, draft, "回滚网关配置")
+		require.Contains(t, draft, "已切换到备用网关")
+		require.Contains(t, draft, "线上支付全部失败")
+
+		clock = clock.Add(time.Hour)
+		n, err := svc.DispatchDueUpdates(ctx)
+		require.NoError(t, err)
+		require.Zero(t, n, "已解决的重大事件不再通报")
+		_, err = svc.PostUpdate(ctx, tenant.ID, inc.ID, commander.ID, &dto.PostStakeholderUpdateRequest{Message: "x"})
+		require.ErrorIs(t, err, ErrMajorIncidentResolved)
+
+		require.NoError(t, incidents.ReopenIncident(ctx, inc.ID, commander.ID, tenant.ID))
+		resp, err = svc.GetMajorIncident(ctx, tenant.ID, inc.ID)
+		require.NoError(t, err)
+		require.Equal(t, "active", resp.Status)
+		require.NotNil(t, resp.NextUpdateAt)
+	})
+
+	t.Run("war room failure does not block declaration", func(t *testing.T) {
+		other, err := incidents.CreateIncident(ctx, &dto.CreateIncidentRequest{
+			Title: "登录缓慢", Description: "SSO 超时", Priority: "high", Severity: "high", Category: "network", Source: "manual",
+		}, tenant.ID, reporter.ID)
+		require.NoError(t, err)
+		resp, err := svc.Declare(ctx, tenant.ID, other.ID, reporter.ID, &dto.DeclareMajorIncidentRequest{
+			EscalateMajorIncidentRequest: dto.EscalateMajorIncidentRequest{ImpactScope: "high", BusinessImpact: "员工无法登录"},
+			CommanderID:                  commander.ID,
+			UpdateCadenceMinutes:         15,
+			WarRoom:                      &dto.WarRoomRequest{Connector: "dingtalk", OwnerIMID: "u1"},
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.WarRoomError)
+		require.Empty(t, resp.WarRoomChatID)
+		require.Equal(t, 15, resp.UpdateCadenceMinutes)
+
+		list, err := svc.ListMajorIncidents(ctx, tenant.ID, &dto.MajorIncidentListFilter{Status: "active"})
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+	})
+}
@@ -0,0 +1,626 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"itsm-backend/common"
+	"itsm-backend/connector"
+	monitorconn "itsm-backend/connector/builtin/monitoring"
+	"itsm-backend/dto"
+	"itsm-backend/ent"
+	"itsm-backend/ent/configurationitem"
+	"itsm-backend/ent/incident"
+	"itsm-backend/ent/monitoringalert"
+
+	"go.uber.org/zap"
+)
+
+const (
+	monitoringIncidentTitleMaxLen = 200
+	monitoringIncidentBodyMaxLen  = 5000
+	monitoringAlertListLimit      = 50
+	monitoringAlertListMaxSize    = 200
+)
+
+// MonitoringAlertService 监控告警入站：按 (租户, 告警源, 指纹) 去重落台账，按标签映射配置项，
+// 告警触发时开启或更新事件及事件告警（注入关联降噪服务后先尝试屏蔽与归并），告警恢复时自动解决事件或在时间线上记录恢复。
+type MonitoringAlertService struct {
+	client      *ent.Client
+	logger      *zap.SugaredLogger
+	incidents   *IncidentService
+	correlation *AlertCorrelationService
+	now         func() time.Time
+}
+
+// NewMonitoringAlertService 创建监控告警入站服务
+func NewMonitoringAlertService(client *ent.Client, incidents *IncidentService, logger *zap.SugaredLogger) *MonitoringAlertService {
+	return &MonitoringAlertService{client: client, logger: logger, incidents: incidents, now: time.Now}
+}
+
+// SetCorrelationService 注入关联降噪服务；未注入时每条新告警各自开启事件
+func (s *MonitoringAlertService) SetCorrelationService(correlation *AlertCorrelationService) {
+	s.correlation = correlation
+}
+
+// HandleInbound 处理告警源连接器解析出的入站消息
+func (s *MonitoringAlertService) HandleInbound(ctx context.Context, tenantID int, policy monitorconn.Policy, msg *connector.InboundMessage) (*dto.MonitoringAlertIngestResult, error) {
+	alerts, ok := monitorconn.AlertsFrom(msg)
+	if !ok {
+		return nil, fmt.Errorf("入站消息不是监控告警")
+	}
+	return s.Ingest(ctx, tenantID, policy, alerts)
+}
+
+// Ingest 逐条处理告警。部分失败时仍处理其余告警并返回错误，告警源重试时已处理的告警按指纹幂等
+func (s *MonitoringAlertService) Ingest(ctx context.Context, tenantID int, policy monitorconn.Policy, alerts []monitorconn.Alert) (*dto.MonitoringAlertIngestResult, error) {
+	result := &dto.MonitoringAlertIngestResult{Received: len(alerts), Items: make([]dto.MonitoringAlertIngestItem, 0, len(alerts))}
+	var firstErr error
+	failed := 0
+	for _, alert := range alerts {
+		item := dto.MonitoringAlertIngestItem{Fingerprint: alert.Fingerprint, Status: alert.Status}
+		var err error
+		if alert.Status == monitorconn.StatusResolved {
+			item.Action, item.IncidentID, err = s.resolve(ctx, tenantID, policy, alert)
+		} else {
+			item.Action, item.IncidentID, err = s.fire(ctx, tenantID, policy, alert)
+		}
+		if err != nil {
+			s.logger.Warnw("Monitoring alert processing failed", "tenant_id", tenantID, "source", alert.Source,
+				"fingerprint", alert.Fingerprint, "error", err)
+			item.Action, item.Error = "failed", err.Error()
+			failed++
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+		result.Items = append(result.Items, item)
+	}
+	if firstErr != nil {
+		return result, fmt.Errorf("%d/%d 条告警处理失败: %w", failed, len(alerts), firstErr)
+	}
+	return result, nil
+}
+
+// fire 处理触发中的告警：台账中已关联未关闭事件的直接更新，已解决的事件重新打开；
+// 否则依次尝试屏蔽规则与关联归并，都未命中时新建事件
+func (s *MonitoringAlertService) fire(ctx context.Context, tenantID int, policy monitorconn.Policy, alert monitorconn.Alert) (string, int, error) {
+	record, err := s.client.MonitoringAlert.Query().
+		Where(monitoringalert.TenantID(tenantID), monitoringalert.Source(alert.Source), monitoringalert.Fingerprint(alert.Fingerprint)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return "", 0, fmt.Errorf("查询告警台账失败: %w", err)
+	}
+
+	var current *ent.Incident
+	if record != nil && record.IncidentID != nil {
+		current, err = s.client.Incident.Query().
+			Where(incident.ID(*record.IncidentID), incident.TenantID(tenantID), incident.DeletedAtIsNil()).
+			Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return "", 0, fmt.Errorf("查询关联事件失败: %w", err)
+		}
+	}
+
+	now := s.now()
+	flap := alertFlapOf(record, policy, now, record != nil && record.Status == monitoringalert.StatusResolved)
+	switch {
+	case current != nil && (current.Status == common.IncidentStatusClosed || current.Status == common.IncidentStatusCancelled):
+		current = nil // 已关闭的事件不再追加，本次触发视为新的一次故障
+	case current != nil && current.Status == common.IncidentStatusResolved:
+		if err := s.incidents.ReopenIncident(ctx, current.ID, policy.ReporterID, tenantID); err != nil {
+			return "", 0, fmt.Errorf("重新打开事件失败: %w", err)
+		}
+		s.annotate(ctx, tenantID, current.ID, "alert_refiring", "告警再次触发",
+			fmt.Sprintf("%s 告警「%s」在事件解决后再次触发，事件已重新打开", alert.Source, alert.Name), "warning", alert)
+		if err := s.touchFiring(ctx, record, alert, now, flap); err != nil {
+			return "", 0, err
+		}
+		s.noteFlapping(ctx, tenantID, current.ID, policy, alert, flap)
+		return "reopened", current.ID, nil
+	case current != nil:
+		if record.Status == monitoringalert.StatusResolved {
+			s.annotate(ctx, tenantID, current.ID, "alert_refiring", "告警再次触发",
+				fmt.Sprintf("%s 告警「%s」恢复后再次触发", alert.Source, alert.Name), "warning", alert)
+		}
+		if err := s.touchFiring(ctx, record, alert, now, flap); err != nil {
+			return "", 0, err
+		}
+		s.noteFlapping(ctx, tenantID, current.ID, policy, alert, flap)
+		return "updated", current.ID, nil
+	}
+
+	ciID, err := s.mapConfigurationItem(ctx, tenantID, policy.CILabels, alert.Labels)
+	if err != nil {
+		return "", 0, err
+	}
+	link := alertLedgerLink{action: "created", ciID: ciID, flap: flap}
+	if s.correlation != nil {
+		rule, err := s.correlation.MatchSuppression(ctx, tenantID, alert)
+		if err != nil {
+			return "", 0, err
+		}
+		if rule != nil {
+			link.action, link.suppressedBy = "suppressed", rule.ID
+		} else if policy.Correlate {
+			selfID := 0
+			if record != nil {
+				selfID = record.ID
+			}
+			match, err := s.correlation.Correlate(ctx, tenantID, policy, alert, ciID, selfID)
+			if err != nil {
+				return "", 0, err
+			}
+			if match != nil {
+				alertID, err := s.attachAlert(ctx, tenantID, match.IncidentID, alert)
+				if err != nil {
+					return "", 0, err
+				}
+				s.annotate(ctx, tenantID, match.IncidentID, "alert_correlated", "告警归并",
+					fmt.Sprintf("%s 告警「%s」已归并到本事件：%s", alert.Source, alert.Name, match.Detail), "info", alert)
+				link.action, link.incidentID, link.incidentAlertID = "correlated", match.IncidentID, alertID
+				link.reason, link.rootID = match.Reason, match.Root.ID
+			}
+		}
+	}
+	if link.action == "created" {
+		link.incidentID, link.incidentAlertID, err = s.openIncident(ctx, tenantID, policy, alert, ciID)
+		if err != nil {
+			return "", 0, err
+		}
+	}
+	if err := s.saveFiring(ctx, tenantID, record, alert, now, link); err != nil {
+		return "", 0, err
+	}
+	return link.action, link.incidentID, nil
+}
+
+// alertLedgerLink 新一轮触发时告警台账的去向：新建事件、归并到已有事件或被屏蔽
+type alertLedgerLink struct {
+	action          string // created / correlated / suppressed
+	incidentID      int
+	incidentAlertID int
+	ciID            int
+	reason          monitoringalert.CorrelationReason
+	rootID          int
+	suppressedBy    int
+	flap            alertFlap
+}
+
+// saveFiring 写入新一轮触发的告警台账
+func (s *MonitoringAlertService) saveFiring(ctx context.Context, tenantID int, record *ent.MonitoringAlert, alert monitorconn.Alert, now time.Time, link alertLedgerLink) error {
+	if record != nil {
+		update := s.applyAlert(record.Update(), alert).
+			SetStatus(monitoringalert.StatusFiring).
+			SetStartsAt(alert.StartsAt).
+			ClearEndsAt().
+			SetLastReceivedAt(now).
+			AddReceiveCount(1).
+			SetStateChanges(link.flap.changes).
+			SetFlapping(link.flap.flapping).
+			ClearIncidentID().
+			ClearIncidentAlertID().
+			ClearConfigurationItemID().
+			ClearCorrelationReason().
+			ClearRootAlertID()
+		if link.incidentID > 0 {
+			update.SetIncidentID(link.incidentID)
+		}
+		if link.incidentAlertID > 0 {
+			update.SetIncidentAlertID(link.incidentAlertID)
+		}
+		if link.ciID > 0 {
+			update.SetConfigurationItemID(link.ciID)
+		}
+		if link.rootID > 0 {
+			update.SetCorrelationReason(link.reason).SetRootAlertID(link.rootID)
+		}
+		switch link.action {
+		case "created":
+			update.AddIncidentsOpened(1)
+		case "correlated":
+			update.AddCorrelatedCount(1)
+		case "suppressed":
+			update.AddSuppressedCount(1).SetSuppressedByRuleID(link.suppressedBy)
+		}
+		if _, err := update.Save(ctx); err != nil {
+			return fmt.Errorf("更新告警台账失败: %w", err)
+		}
+		return nil
+	}
+
+	create := s.client.MonitoringAlert.Create().
+		SetTenantID(tenantID).
+		SetSource(alert.Source).
+		SetFingerprint(alert.Fingerprint).
+		SetAlertName(truncateRunes(alert.Name, 255)).
+		SetStatus(monitoringalert.StatusFiring).
+		SetSeverity(alert.Severity).
+		SetSummary(alertSummary(alert)).
+		SetLabels(alert.Labels).
+		SetAnnotations(alert.Annotations).
+		SetGeneratorURL(alert.GeneratorURL).
+		SetStartsAt(alert.StartsAt).
+		SetLastReceivedAt(now)
+	if link.incidentID > 0 {
+		create.SetIncidentID(link.incidentID)
+	}
+	if link.incidentAlertID > 0 {
+		create.SetIncidentAlertID(link.incidentAlertID)
+	}
+	if link.ciID > 0 {
+		create.SetConfigurationItemID(link.ciID)
+	}
+	if link.rootID > 0 {
+		create.SetCorrelationReason(link.reason).SetRootAlertID(link.rootID)
+	}
+	switch link.action {
+	case "created":
+		create.SetIncidentsOpened(1)
+	case "correlated":
+		create.SetCorrelatedCount(1)
+	case "suppressed":
+		create.SetSuppressedCount(1).SetSuppressedByRuleID(link.suppressedBy)
+	}
+	if _, err := create.Save(ctx); err != nil {
+		// 并发推送同一告警时唯一索引冲突：已由另一请求建好台账，本次的处理结果保留并在日志中提示
+		if ent.IsConstraintError(err) {
+			s.logger.Warnw("Concurrent monitoring alert delivery, ledger row already exists",
+				"tenant_id", tenantID, "source", alert.Source, "fingerprint", alert.Fingerprint, "action", link.action, "incident_id", link.incidentID)
+			return nil
+		}
+		return fmt.Errorf("写入告警台账失败: %w", err)
+	}
+	return nil
+}
+
+// resolve 处理恢复通知：关联事件的告警全部恢复且本告警未在抖动时按策略自动解决，否则仅在时间线上记录
+func (s *MonitoringAlertService) resolve(ctx context.Context, tenantID int, policy monitorconn.Policy, alert monitorconn.Alert) (string, int, error) {
+	record, err := s.client.MonitoringAlert.Query().
+		Where(monitoringalert.TenantID(tenantID), monitoringalert.Source(alert.Source), monitoringalert.Fingerprint(alert.Fingerprint)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return "ignored", 0, nil
+	}
+	if err != nil {
+		return "", 0, fmt.Errorf("查询告警台账失败: %w", err)
+	}
+	incidentID := 0
+	if record.IncidentID != nil {
+		incidentID = *record.IncidentID
+	}
+	if record.Status == monitoringalert.StatusResolved {
+		return "ignored", incidentID, nil
+	}
+
+	now := s.now()
+	endsAt := now
+	if alert.EndsAt != nil {
+		endsAt = *alert.EndsAt
+	}
+	flap := alertFlapOf(record, policy, now, true)
+	if _, err := s.applyAlert(record.Update(), alert).
+		SetStatus(monitoringalert.StatusResolved).
+		SetEndsAt(endsAt).
+		SetLastReceivedAt(now).
+		AddReceiveCount(1).
+		SetStateChanges(flap.changes).
+		SetFlapping(flap.flapping).
+		Save(ctx); err != nil {
+		return "", 0, fmt.Errorf("更新告警台账失败: %w", err)
+	}
+	if record.IncidentAlertID != nil {
+		if err := s.client.IncidentAlert.UpdateOneID(*record.IncidentAlertID).
+			SetStatus("resolved").
+			SetResolvedAt(endsAt).
+			SetUpdatedAt(now).
+			Exec(ctx); err != nil && !ent.IsNotFound(err) {
+			return "", 0, fmt.Errorf("更新事件告警失败: %w", err)
+		}
+	}
+	if incidentID == 0 {
+		return "ignored", 0, nil
+	}
+
+	current, err := s.client.Incident.Query().
+		Where(incident.ID(incidentID), incident.TenantID(tenantID), incident.DeletedAtIsNil()).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return "ignored", incidentID, nil
+	}
+	if err != nil {
+		return "", 0, fmt.Errorf("查询关联事件失败: %w", err)
+	}
+	switch current.Status {
+	case common.IncidentStatusResolved, common.IncidentStatusClosed, common.IncidentStatusCancelled:
+		return "annotated", incidentID, nil
+	}
+
+	s.noteFlapping(ctx, tenantID, incidentID, policy, alert, flap)
+
+	firing, err := s.client.MonitoringAlert.Query().
+		Where(monitoringalert.TenantID(tenantID), monitoringalert.IncidentID(incidentID), monitoringalert.StatusEQ(monitoringalert.StatusFiring)).
+		Count(ctx)
+	if err != nil {
+		return "", 0, fmt.Errorf("统计未恢复告警失败: %w", err)
+	}
+	description := fmt.Sprintf("%s 告警「%s」已恢复", alert.Source, alert.Name)
+	if firing > 0 {
+		s.annotate(ctx, tenantID, incidentID, "alert_resolved", "告警恢复",
+			fmt.Sprintf("%s，仍有 %d 条告警未恢复", description, firing), "info", alert)
+		return "annotated", incidentID, nil
+	}
+	if !policy.AutoResolve {
+		s.annotate(ctx, tenantID, incidentID, "alert_resolved", "告警恢复", description+"，关联告警已全部恢复", "info", alert)
+		return "annotated", incidentID, nil
+	}
+	if flap.flapping {
+		s.annotate(ctx, tenantID, incidentID, "alert_resolved", "告警恢复",
+			description+"，关联告警已全部恢复；告警抖动中，需处理人确认后解决", "info", alert)
+		return "annotated", incidentID, nil
+	}
+	if reason := s.autoResolve(ctx, tenantID, policy, current, alert); reason != "" {
+		s.annotate(ctx, tenantID, incidentID, "alert_resolved", "告警恢复",
+			fmt.Sprintf("%s，关联告警已全部恢复；%s", description, reason), "info", alert)
+		return "annotated", incidentID, nil
+	}
+	return "resolved", incidentID, nil
+}
+
+// autoResolve 告警全部恢复后解决事件。仅处理尚无人接手（new）或正在处理（in_progress）的事件；
+// 已被确认、挂起或升级的事件交由处理人决定，返回未自动解决的原因
+func (s *MonitoringAlertService) autoResolve(ctx context.Context, tenantID int, policy monitorconn.Policy, current *ent.Incident, alert monitorconn.Alert) string {
+	switch current.Status {
+	case common.IncidentStatusNew:
+		inProgress := common.IncidentStatusInProgress
+		if _, err := s.incidents.UpdateIncident(ctx, current.ID, &dto.UpdateIncidentRequest{Status: &inProgress, Force: true}, tenantID); err != nil {
+			s.logger.Warnw("Failed to move monitoring incident to in_progress", "incident_id", current.ID, "error", err)
+			return "自动解决失败：" + err.Error()
+		}
+	case common.IncidentStatusInProgress:
+	default:
+		return fmt.Sprintf("事件当前状态为 %s，需处理人确认后解决", current.Status)
+	}
+	resolution := fmt.Sprintf("监控告警已全部恢复（%s），系统自动解决", alert.Source)
+	if err := s.incidents.ResolveIncident(ctx, current.ID, policy.ReporterID, tenantID, resolution, ""); err != nil {
+		s.logger.Warnw("Failed to auto-resolve monitoring incident", "incident_id", current.ID, "error", err)
+		return "自动解决失败：" + err.Error()
+	}
+	return ""
+}
+
+// openIncident 新建事件与事件告警
+func (s *MonitoringAlertService) openIncident(ctx context.Context, tenantID int, policy monitorconn.Policy, alert monitorconn.Alert, ciID int) (int, int, error) {
+	req := &dto.CreateIncidentRequest{
+		Title:       truncateRunes(fmt.Sprintf("[%s] %s", alert.Source, alertSummary(alert)), monitoringIncidentTitleMaxLen),
+		Description: truncateRunes(alertDescription(alert), monitoringIncidentBodyMaxLen),
+		Type:        "alert",
+		Severity:    alert.Severity,
+		Urgency:     alert.Severity,
+		Category:    policy.Category,
+		Source:      "monitoring",
+		Metadata: map[string]interface{}{
+			"monitoring": map[string]interface{}{
+				"source":        alert.Source,
+				"fingerprint":   alert.Fingerprint,
+				"generator_url": alert.GeneratorURL,
+				"labels":        alert.Labels,
+			},
+		},
+		DetectedAt: &alert.StartsAt,
+	}
+	if ciID > 0 {
+		req.ConfigurationItemIDs = []int{ciID}
+	}
+	if policy.AssigneeID > 0 {
+		assignee := policy.AssigneeID
+		req.AssigneeID = &assignee
+	}
+	created, err := s.incidents.CreateIncident(ctx, req, tenantID, policy.ReporterID)
+	if err != nil {
+		return 0, 0, fmt.Errorf("创建事件失败: %w", err)
+	}
+	alertID, err := s.attachAlert(ctx, tenantID, created.ID, alert)
+	if err != nil {
+		return 0, 0, err
+	}
+	return created.ID, alertID, nil
+}
+
+// attachAlert 在事件下登记一条事件告警，归并的子告警也登记在主告警的事件下
+func (s *MonitoringAlertService) attachAlert(ctx context.Context, tenantID, incidentID int, alert monitorconn.Alert) (int, error) {
+	triggeredAt := alert.StartsAt
+	incidentAlert, err := s.incidents.CreateIncidentAlert(ctx, &dto.CreateIncidentAlertRequest{
+		IncidentID:  incidentID,
+		AlertType:   alert.Source,
+		AlertName:   truncateRunes(alert.Name, 255),
+		Message:     truncateRunes(alertDescription(alert), monitoringIncidentBodyMaxLen),
+		Severity:    alert.Severity,
+		TriggeredAt: &triggeredAt,
+		Metadata: map[string]interface{}{
+			"fingerprint":   alert.Fingerprint,
+			"labels":        alert.Labels,
+			"annotations":   alert.Annotations,
+			"generator_url": alert.GeneratorURL,
+		},
+	}, tenantID)
+	if err != nil {
+		return 0, fmt.Errorf("创建事件告警失败: %w", err)
+	}
+	return incidentAlert.ID, nil
+}
+
+// touchFiring 重复触发：刷新台账与事件告警
+func (s *MonitoringAlertService) touchFiring(ctx context.Context, record *ent.MonitoringAlert, alert monitorconn.Alert, now time.Time, flap alertFlap) error {
+	update := s.applyAlert(record.Update(), alert).
+		SetStatus(monitoringalert.StatusFiring).
+		ClearEndsAt().
+		SetLastReceivedAt(now).
+		AddReceiveCount(1).
+		SetStateChanges(flap.changes).
+		SetFlapping(flap.flapping)
+	if record.Status == monitoringalert.StatusResolved {
+		update.SetStartsAt(alert.StartsAt)
+	}
+	if _, err := update.Save(ctx); err != nil {
+		return fmt.Errorf("更新告警台账失败: %w", err)
+	}
+	if record.IncidentAlertID != nil {
+		if err := s.client.IncidentAlert.UpdateOneID(*record.IncidentAlertID).
+			SetStatus("active").
+			SetSeverity(alert.Severity).
+			ClearResolvedAt().
+			SetUpdatedAt(now).
+			Exec(ctx); err != nil && !ent.IsNotFound(err) {
+			return fmt.Errorf("更新事件告警失败: %w", err)
+		}
+	}
+	return nil
+}
+
+// alertFlap 本次推送后的抖动状态
+type alertFlap struct {
+	changes  []time.Time
+	flapping bool
+	started  bool // 本次推送使告警进入抖动
+}
+
+func alertFlapOf(record *ent.MonitoringAlert, policy monitorconn.Policy, now time.Time, transitioned bool) alertFlap {
+	var prev []time.Time
+	wasFlapping := false
+	if record != nil {
+		prev, wasFlapping = record.StateChanges, record.Flapping
+	}
+	changes, flapping := flapState(prev, transitioned, policy, now)
+	return alertFlap{changes: changes, flapping: flapping, started: flapping && !wasFlapping}
+}
+
+// noteFlapping 告警刚进入抖动时在事件时间线上记录一次
+func (s *MonitoringAlertService) noteFlapping(ctx context.Context, tenantID, incidentID int, policy monitorconn.Policy, alert monitorconn.Alert, flap alertFlap) {
+	if !flap.started || incidentID == 0 {
+		return
+	}
+	window := policy.FlapWindow
+	if window <= 0 {
+		window = alertFlapDefaultWindow
+	}
+	s.annotate(ctx, tenantID, incidentID, "alert_flapping", "告警抖动",
+		fmt.Sprintf("%s 告警「%s」在 %s 内状态切换 %d 次，抖动期间不自动解决事件", alert.Source, alert.Name, window, len(flap.changes)), "warning", alert)
+}
+
+func (s *MonitoringAlertService) applyAlert(update *ent.MonitoringAlertUpdateOne, alert monitorconn.Alert) *ent.MonitoringAlertUpdateOne {
+	return update.
+		SetAlertName(truncateRunes(alert.Name, 255)).
+		SetSeverity(alert.Severity).
+		SetSummary(alertSummary(alert)).
+		SetLabels(alert.Labels).
+		SetAnnotations(alert.Annotations).
+		SetGeneratorURL(alert.GeneratorURL)
+}
+
+// annotate 在事件时间线上记录告警状态变化；记录失败不影响告警处理
+func (s *MonitoringAlertService) annotate(ctx context.Context, tenantID, incidentID int, eventType, eventName, description, severity string, alert monitorconn.Alert) {
+	occurredAt := s.now()
+	if _, err := s.incidents.CreateIncidentEvent(ctx, &dto.CreateIncidentEventRequest{
+		IncidentID: incidentID, EventType: eventType, EventName: eventName,
+		Description: description, Status: "active", Severity: severity,
+		Data:       map[string]interface{}{"source": alert.Source, "fingerprint": alert.Fingerprint},
+		OccurredAt: &occurredAt, Source: "monitoring",
+	}, tenantID); err != nil {
+		s.logger.Warnw("Failed to record monitoring alert event", "incident_id", incidentID, "event_type", eventType, "error", err)
+	}
+}
+
+// mapConfigurationItem 按标签顺序映射配置项：ci_id 按ID匹配，其他标签按名称（忽略大小写）匹配，
+// instance 类标签去掉端口后再匹配。未命中返回 0
+func (s *MonitoringAlertService) mapConfigurationItem(ctx context.Context, tenantID int, keys []string, labels map[string]string) (int, error) {
+	for _, key := range keys {
+		value := strings.TrimSpace(labels[key])
+		if value == "" {
+			continue
+		}
+		if key == "ci_id" {
+			id, err := strconv.Atoi(value)
+			if err != nil || id <= 0 {
+				continue
+			}
+			ok, err := s.client.ConfigurationItem.Query().
+				Where(configurationitem.ID(id), configurationitem.TenantID(tenantID)).
+				Exist(ctx)
+			if err != nil {
+				return 0, fmt.Errorf("映射配置项失败: %w", err)
+			}
+			if ok {
+				return id, nil
+			}
+			continue
+		}
+		candidates := []string{value}
+		if host, _, err := net.SplitHostPort(value); err == nil && host != "" {
+			candidates = append(candidates, host)
+		}
+		for _, name := range candidates {
+			id, err := s.client.ConfigurationItem.Query().
+				Where(configurationitem.TenantID(tenantID), configurationitem.NameEqualFold(name)).
+				Order(ent.Asc(configurationitem.FieldID)).
+				FirstID(ctx)
+			if err == nil {
+				return id, nil
+			}
+			if !ent.IsNotFound(err) {
+				return 0, fmt.Errorf("映射配置项失败: %w", err)
+			}
+		}
+	}
+	return 0, nil
+}
+
+// ListAlerts 查询租户告警台账（按最近接收时间倒序）
+func (s *MonitoringAlertService) ListAlerts(ctx context.Context, tenantID int, status string, limit int) ([]*ent.MonitoringAlert, error) {
+	if limit <= 0 {
+		limit = monitoringAlertListLimit
+	}
+	if limit > monitoringAlertListMaxSize {
+		limit = monitoringAlertListMaxSize
+	}
+	query := s.client.MonitoringAlert.Query().Where(monitoringalert.TenantID(tenantID))
+	if status != "" {
+		st := monitoringalert.Status(status)
+		if err := monitoringalert.StatusValidator(st); err != nil {
+			return nil, errors.New("无效的状态: " + status)
+		}
+		query = query.Where(monitoringalert.StatusEQ(st))
+	}
+	return query.Order(ent.Desc(monitoringalert.FieldLastReceivedAt), ent.Desc(monitoringalert.FieldID)).Limit(limit).All(ctx)
+}
+
+func alertSummary(alert monitorconn.Alert) string {
+	return firstNonEmpty(alert.Summary, alert.Name)
+}
+
+// alertDescription 事件描述：告警描述 + 标签清单 + 详情链接
+func alertDescription(alert monitorconn.Alert) string {
+	var b strings.Builder
+	b.WriteString(firstNonEmpty(alert.Description, alertSummary(alert)))
+	if len(alert.Labels) > 0 {
+		keys := make([]string, 0, len(alert.Labels))
+		for k := range alert.Labels {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		b.WriteString("\n\n标签：")
+		for _, k := range keys {
+			b.WriteString("\n- " + k + "=" + alert.Labels[k])
+		}
+	}
+	if alert.GeneratorURL != "" {
+		b.WriteString("\n\n详情：" + alert.GeneratorURL)
+	}
+	return b.String()
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"itsm-backend/common"
+	monitorconn "itsm-backend/connector/builtin/monitoring"
+	"itsm-backend/ent"
+	"itsm-backend/ent/enttest"
+	"itsm-backend/ent/incidentevent"
+	"itsm-backend/ent/monitoringalert"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestMonitoringAlertService(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", testDSN())
+	defer client.Close()
+	ctx := context.Background()
+	logger := zap.NewNop().Sugar()
+
+	tenant, err := createIncidentTestTenant(ctx, client, "monitoring")
+	require.NoError(t, err)
+	reporter, err := createIncidentTestUser(ctx, client, tenant.ID, "monitoring-bot")
+	require.NoError(t, err)
+	ciType, err := createTestCIType(ctx, client, tenant.ID, "server")
+	require.NoError(t, err)
+	ci, err := createTestCI(ctx, client, tenant.ID, ciType.ID, "WEB-01")
+	require.NoError(t, err)
+
+	incidents := NewIncidentService(client, logger)
+	svc := NewMonitoringAlertService(client, incidents, logger)
+	clock := time.Now()
+	svc.now = func() time.Time { return clock }
+	policy := monitorconn.Policy{ReporterID: reporter.ID, Category: "monitoring", AutoResolve: true, CILabels: []string{"ci_id", "instance"}}
+
+	alert := func(fingerprint, status string) monitorconn.Alert {
+		return monitorconn.Alert{
+			Source: "alertmanager", Fingerprint: fingerprint, Status: status, Name: "HighCPU",
+			Summary: "CPU > 90%", Severity: "critical", StartsAt: clock.Add(-time.Minute),
+			Labels:      map[string]string{"alertname": "HighCPU", "instance": "web-01:9100", "group": "web"},
+			Annotations: map[string]string{},
+		}
+	}
+	ledger := func(fingerprint string) *ent.MonitoringAlert {
+		return client.MonitoringAlert.Query().Where(monitoringalert.TenantID(tenant.ID), monitoringalert.Fingerprint(fingerprint)).OnlyX(ctx)
+	}
+	var incidentID int
+
+	t.Run("firing opens incident and maps CI", func(t *testing.T) {
+		res, err := svc.Ingest(ctx, tenant.ID, policy, []monitorconn.Alert{alert("fp-1", monitorconn.StatusFiring)})
+		require.NoError(t, err)
+		require.Equal(t, "created", res.Items[0].Action)
+		incidentID = res.Items[0].IncidentID
+
+		inc, err := client.Incident.Get(ctx, incidentID)
+		require.NoError(t, err)
+		require.Equal(t, common.IncidentStatusNew, inc.Status)
+		require.Equal(t, "critical", inc.Severity)
+		require.Equal(t, "monitoring", inc.Source)
+		require.Contains(t, inc.Title, "CPU > 90%")
+
+		row := ledger("fp-1")
+		require.Equal(t, monitoringalert.StatusFiring, row.Status)
+		require.Equal(t, incidentID, *row.IncidentID)
+		require.NotNil(t, row.ConfigurationItemID, "instance 标签去掉端口后按名称忽略大小写匹配配置项")
+		require.Equal(t, ci.ID, *row.ConfigurationItemID)
+		require.Equal(t, 1, client.IncidentAlert.Query().CountX(ctx))
+	})
+
+	t.Run("duplicate firing updates existing incident", func(t *testing.T) {
+		res, err := svc.Ingest(ctx, tenant.ID, policy, []monitorconn.Alert{alert("fp-1", monitorconn.StatusFiring)})
+		require.NoError(t, err)
+		require.Equal(t, "updated", res.Items[0].Action)
+		require.Equal(t, incidentID, res.Items[0].IncidentID)
+		require.Equal(t, 2, ledger("fp-1").ReceiveCount)
+		require.Equal(t, 1, client.Incident.Query().CountX(ctx))
+	})
+
+	t.Run("partial recovery only annotates", func(t *testing.T) {
+		// 第二条告警归入同一事件（模拟同组告警）
+		second := alert("fp-2", monitorconn.StatusFiring)
+		res, err := svc.Ingest(ctx, tenant.ID, policy, []monitorconn.Alert{second})
+		require.NoError(t, err)
+		require.Equal(t, "created", res.Items[0].Action)
+		client.MonitoringAlert.Update().Where(monitoringalert.Fingerprint("fp-2")).SetIncidentID(incidentID).ExecX(ctx)
+
+		res, err = svc.Ingest(ctx, tenant.ID, policy, []monitorconn.Alert{alert("fp-1", monitorconn.StatusResolved)})
+		require.NoError(t, err)
+		require.Equal(t, "annotated", res.Items[0].Action)
+		require.Equal(t, common.IncidentStatusNew, client.Incident.GetX(ctx, incidentID).Status)
+		require.Equal(t, 1, client.IncidentEvent.Query().Where(incidentevent.IncidentID(incidentID), incidentevent.EventType("alert_resolved")).CountX(ctx))
+	})
+
+	t.Run("last recovery auto-resolves incident", func(t *testing.T) {
+		res, err := svc.Ingest(ctx, tenant.ID, policy, []monitorconn.Alert{alert("fp-2", monitorconn.StatusResolved)})
+		require.NoError(t, err)
+		require.Equal(t, "resolved", res.Items[0].Action)
+		require.Equal(t, common.IncidentStatusResolved, client.Incident.GetX(ctx, incidentID).Status)
+		require.Equal(t, monitoringalert.StatusResolved, ledger("fp-2").Status)
+
+		res, err = svc.Ingest(ctx, tenant.ID, policy, []monitorconn.Alert{alert("fp-2", monitorconn.StatusResolved)})
+		require.NoError(t, err)
+		require.Equal(t, "ignored", res.Items[0].Action, "重复的恢复通知幂等")
+	})
+
+	t.Run("refiring reopens resolved incident", func(t *testing.T) {
+		res, err := svc.Ingest(ctx, tenant.ID, policy, []monitorconn.Alert{alert("fp-1", monitorconn.StatusFiring)})
+		require.NoError(t, err)
+		require.Equal(t, "reopened", res.Items[0].Action)
+		require.Equal(t, incidentID, res.Items[0].IncidentID)
+		require.Equal(t, common.IncidentStatusInProgress, client.Incident.GetX(ctx, incidentID).Status)
+		require.Equal(t, monitoringalert.StatusFiring, ledger("fp-1").Status)
+		require.Equal(t, 1, client.IncidentEvent.Query().Where(incidentevent.IncidentID(incidentID), incidentevent.EventType("alert_refiring")).CountX(ctx))
+	})
+
+	t.Run("manual resolution policy annotates", func(t *testing.T) {
+		manual := policy
+		manual.AutoResolve = false
+		res, err := svc.Ingest(ctx, tenant.ID, manual, []monitorconn.Alert{alert("fp-1", monitorconn.StatusResolved)})
+		require.NoError(t, err)
+		require.Equal(t, "annotated", res.Items[0].Action)
+		require.Equal(t, common.IncidentStatusInProgress, client.Incident.GetX(ctx, incidentID).Status)
+	})
+
+	t.Run("unknown recovery is ignored and list filters", func(t *testing.T) {
+		res, err := svc.Ingest(ctx, tenant.ID, policy, []monitorconn.Alert{alert("fp-unknown", monitorconn.StatusResolved)})
+		require.NoError(t, err)
+		require.Equal(t, "ignored", res.Items[0].Action)
+
+		resolved, err := svc.ListAlerts(ctx, tenant.ID, "resolved", 0)
+		require.NoError(t, err)
+		require.Len(t, resolved, 2)
+		_, err = svc.ListAlerts(ctx, tenant.ID, "bogus", 0)
+		require.Error(t, err)
+	})
+}
@@ -180,9 +180,13 @@ func (h *NotificationDeliveryCommandHandler) deliverConnector(ctx context.Contex
 		}
 	}
 	messageID := cmd.IdempotencyKey
+	actions := []connector.Action{{Type: "link", Text: actionText, URL: actionURL}}
+	if tk != nil && interactiveChatChannels[channel] {
+		actions = append(ticketChatActions(ctx, h.client, cmd.TenantID, tk, recipient.ID), actions...)
+	}
 	err := h.connectors.Send(ctx, cmd.TenantID, channel, &connector.Message{
 		ID: messageID, Channel: target, Type: "text", Title: notificationType, Content: content,
-		Actions:  []connector.Action{{Type: "link", Text: actionText, URL: actionURL}},
+		Actions:  actions,
 		Metadata: map[string]interface{}{"resource_type": resourceType, "resource_id": resourceID, "recipient_id": recipient.ID, "command_id": cmd.ID},
 	})
 	if err != nil {
@@ -238,6 +242,13 @@ func notificationTarget(recipient *ent.User, channel string) string {
 		return recipient.FeishuOpenID
 	case "dingtalk", "wecom":
 		return recipient.Username
+	case "slack":
+		return recipient.SlackUserID
+	case "teams":
+		if recipient.TeamsUserID == "" {
+			return ""
+		}
+		return "user:" + recipient.TeamsUserID
 	case "email":
 		return recipient.Email
 	case "sms":
@@ -0,0 +1,70 @@
+package oncall
+
+import (
+	"strings"
+	"time"
+)
+
+const icalTimeLayout = "20060102T150405Z"
+
+// CalendarEvent iCal 订阅中的一个值班事件
+type CalendarEvent struct {
+	UID         string
+	Summary     string
+	Description string
+	Start       time.Time
+	End         time.Time
+}
+
+// ICal 按 RFC 5545 生成 iCalendar 文本（CRLF 换行、75 字节折行、文本转义），时间统一输出为 UTC
+func ICal(name string, events []CalendarEvent, stamp time.Time) string {
+	var b strings.Builder
+	write := func(line string) {
+		b.WriteString(foldICalLine(line))
+		b.WriteString("\r\n")
+	}
+	write("BEGIN:VCALENDAR")
+	write("VERSION:2.0")
+	write("PRODID:-//ITSM//On-Call//ZH")
+	write("CALSCALE:GREGORIAN")
+	write("METHOD:PUBLISH")
+	write("X-WR-CALNAME:" + escapeICalText(name))
+	for _, e := range events {
+		write("BEGIN:VEVENT")
+		write("UID:" + escapeICalText(e.UID))
+		write("DTSTAMP:" + stamp.UTC().Format(icalTimeLayout))
+		write("DTSTART:" + e.Start.UTC().Format(icalTimeLayout))
+		write("DTEND:" + e.End.UTC().Format(icalTimeLayout))
+		write("SUMMARY:" + escapeICalText(e.Summary))
+		if e.Description != "" {
+			write("DESCRIPTION:" + escapeICalText(e.Description))
+		}
+		write("TRANSP:TRANSPARENT")
+		write("END:VEVENT")
+	}
+	write("END:VCALENDAR")
+	return b.String()
+}
+
+func escapeICalText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(s)
+}
+
+// foldICalLine 超过 75 字节的内容行折行，续行以空格开头，不拆开多字节字符
+func foldICalLine(line string) string {
+	if len(line) <= 75 {
+		return line
+	}
+	var b strings.Builder
+	width := 0
+	for _, r := range line {
+		n := len(string(r))
+		if width+n > 75 {
+			b.WriteString("\r\n ")
+			width = 1
+		}
+		b.WriteRune(r)
+		width += n
+	}
+	return b.String()
+}
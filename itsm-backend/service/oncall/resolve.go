@@ -0,0 +1,65 @@
+package oncall
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"itsm-backend/ent"
+	"itsm-backend/ent/oncalloverride"
+	"itsm-backend/ent/oncallschedule"
+)
+
+var (
+	// ErrScheduleNotFound 值班表不存在或不属于当前租户
+	ErrScheduleNotFound = errors.New("值班表不存在")
+	// ErrScheduleDisabled 值班表已停用
+	ErrScheduleDisabled = errors.New("值班表已停用")
+	// ErrNobodyOnCall 值班表在该时刻无人值班
+	ErrNobodyOnCall = errors.New("当前无人值班")
+)
+
+// Load 加载租户的值班表及与 [from, to) 重叠的值班替换
+func Load(ctx context.Context, client *ent.Client, tenantID, scheduleID int, from, to time.Time) (*ent.OnCallSchedule, []*ent.OnCallOverride, error) {
+	schedule, err := client.OnCallSchedule.Query().
+		Where(oncallschedule.ID(scheduleID), oncallschedule.TenantID(tenantID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil, ErrScheduleNotFound
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("查询值班表失败: %w", err)
+	}
+	overrides, err := client.OnCallOverride.Query().
+		Where(
+			oncalloverride.TenantID(tenantID),
+			oncalloverride.ScheduleID(scheduleID),
+			oncalloverride.StartAtLT(to),
+			oncalloverride.EndAtGT(from),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("查询值班替换失败: %w", err)
+	}
+	return schedule, overrides, nil
+}
+
+// Current 返回值班表在 at 时刻的值班班次；值班表停用时返回 ErrScheduleDisabled，无人值班时返回 ErrNobodyOnCall
+func Current(ctx context.Context, client *ent.Client, tenantID, scheduleID int, at time.Time) (*Shift, error) {
+	schedule, overrides, err := Load(ctx, client, tenantID, scheduleID, at.Add(-shiftLookaround), at.Add(shiftLookaround))
+	if err != nil {
+		return nil, err
+	}
+	if !schedule.Enabled {
+		return nil, ErrScheduleDisabled
+	}
+	shift, err := At(schedule, overrides, at)
+	if err != nil {
+		return nil, err
+	}
+	if shift == nil {
+		return nil, ErrNobodyOnCall
+	}
+	return shift, nil
+}
@@ -0,0 +1,342 @@
+package oncall
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"itsm-backend/ent"
+	"itsm-backend/ent/schema"
+)
+
+// 值班计算
+//
+// 值班表由若干轮值层叠加：每层从 Start 起按轮换周期在 UserIDs 间依次轮换，可用时段限制只在部分时段生效；
+// 同一时刻多层都有人值班时取靠后的层。值班替换在层叠结果之上生效，重叠时后创建的优先。
+// daily/weekly 的交接按值班表时区的当地时刻计算（夏令时切换前后交接时刻不变），custom 按固定时长交接。
+
+const (
+	RotationDaily  = "daily"
+	RotationWeekly = "weekly"
+	RotationCustom = "custom"
+
+	// MinShiftMinutes custom 轮换每班的最短时长
+	MinShiftMinutes = 15
+	// MaxRenderRange 单次展开值班的最长区间，避免超长区间 × 短班次耗尽内存
+	MaxRenderRange = 93 * 24 * time.Hour
+	// shiftLookaround 查询某时刻值班人时向前后展开的范围，返回的班次起止最多延伸到该范围
+	shiftLookaround = 7 * 24 * time.Hour
+)
+
+// Shift 展开后的一段值班
+type Shift struct {
+	UserID     int       `json:"userId"`
+	Start      time.Time `json:"start"`
+	End        time.Time `json:"end"`
+	Layer      string    `json:"layer,omitempty"`      // 来源轮值层名称
+	OverrideID int       `json:"overrideId,omitempty"` // 来源值班替换ID，非替换为 0
+}
+
+// ValidateLayers 校验轮值层配置
+func ValidateLayers(layers []schema.OnCallLayer) error {
+	if len(layers) == 0 {
+		return fmt.Errorf("至少需要一个轮值层")
+	}
+	for i, layer := range layers {
+		name := layer.Name
+		if name == "" {
+			name = fmt.Sprintf("#%d", i+1)
+		}
+		switch layer.Rotation {
+		case RotationDaily, RotationWeekly:
+			if layer.TurnLength < 0 {
+				return fmt.Errorf("轮值层 %s: 每人连续值班数不能为负", name)
+			}
+		case RotationCustom:
+			if layer.ShiftMinutes < MinShiftMinutes {
+				return fmt.Errorf("轮值层 %s: 自定义班次不能短于 %d 分钟", name, MinShiftMinutes)
+			}
+		default:
+			return fmt.Errorf("轮值层 %s: 不支持的轮换方式 %q", name, layer.Rotation)
+		}
+		if layer.Start.IsZero() {
+			return fmt.Errorf("轮值层 %s: 缺少开始时间", name)
+		}
+		if layer.End != nil && !layer.End.After(layer.Start) {
+			return fmt.Errorf("轮值层 %s: 失效时间必须晚于开始时间", name)
+		}
+		if len(layer.UserIDs) == 0 {
+			return fmt.Errorf("轮值层 %s: 至少需要一名值班人", name)
+		}
+		for _, r := range layer.Restrictions {
+			if r.Weekday < 0 || r.Weekday > 7 {
+				return fmt.Errorf("轮值层 %s: 无效的星期 %d，应为 0-7", name, r.Weekday)
+			}
+			if _, ok := parseClock(r.Start); !ok {
+				return fmt.Errorf("轮值层 %s: 无效的时段开始时间 %q", name, r.Start)
+			}
+			if _, ok := parseClock(r.End); !ok {
+				return fmt.Errorf("轮值层 %s: 无效的时段结束时间 %q", name, r.End)
+			}
+		}
+	}
+	return nil
+}
+
+// Render 展开值班表在 [from, to) 内的最终值班（层叠 + 值班替换），相邻的同一来源班次合并
+func Render(schedule *ent.OnCallSchedule, overrides []*ent.OnCallOverride, from, to time.Time) ([]Shift, error) {
+	if !from.Before(to) {
+		return nil, fmt.Errorf("开始时间必须早于结束时间")
+	}
+	if to.Sub(from) > MaxRenderRange {
+		return nil, fmt.Errorf("查询区间不能超过 %d 天", int(MaxRenderRange.Hours()/24))
+	}
+	loc, err := time.LoadLocation(schedule.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("无效的时区 %q: %w", schedule.TimeZone, err)
+	}
+
+	layerShifts := make([][]Shift, len(schedule.Layers))
+	bounds := []time.Time{from, to}
+	for i, layer := range schedule.Layers {
+		layerShifts[i] = layerRotation(layer, loc, from, to)
+		for _, s := range layerShifts[i] {
+			bounds = append(bounds, s.Start, s.End)
+		}
+	}
+	active := make([]*ent.OnCallOverride, 0, len(overrides))
+	for _, o := range overrides {
+		if o.EndAt.After(from) && o.StartAt.Before(to) {
+			active = append(active, o)
+			bounds = append(bounds, clampTime(o.StartAt, from, to), clampTime(o.EndAt, from, to))
+		}
+	}
+	sort.SliceStable(active, func(i, j int) bool {
+		if !active[i].CreatedAt.Equal(active[j].CreatedAt) {
+			return active[i].CreatedAt.Before(active[j].CreatedAt)
+		}
+		return active[i].ID < active[j].ID
+	})
+	sort.Slice(bounds, func(i, j int) bool { return bounds[i].Before(bounds[j]) })
+
+	var out []Shift
+	cursors := make([]int, len(layerShifts)) // 各层班次按时间有序，随区间推进
+	for k := 0; k+1 < len(bounds); k++ {
+		start, end := bounds[k], bounds[k+1]
+		if !start.Before(end) {
+			continue
+		}
+		var current *Shift
+		for i := len(layerShifts) - 1; i >= 0; i-- {
+			for cursors[i] < len(layerShifts[i]) && !layerShifts[i][cursors[i]].End.After(start) {
+				cursors[i]++
+			}
+			if current != nil || cursors[i] == len(layerShifts[i]) {
+				continue
+			}
+			if s := layerShifts[i][cursors[i]]; !s.Start.After(start) {
+				current = &Shift{UserID: s.UserID, Layer: s.Layer}
+			}
+		}
+		for _, o := range active {
+			if o.StartAt.After(start) || !o.EndAt.After(start) {
+				continue
+			}
+			if o.OriginalUserID != nil && (current == nil || current.UserID != *o.OriginalUserID) {
+				continue
+			}
+			layer := ""
+			if current != nil {
+				layer = current.Layer
+			}
+			current = &Shift{UserID: o.UserID, Layer: layer, OverrideID: o.ID}
+		}
+		if current == nil {
+			continue
+		}
+		if n := len(out); n > 0 && out[n-1].End.Equal(start) && out[n-1].UserID == current.UserID &&
+			out[n-1].Layer == current.Layer && out[n-1].OverrideID == current.OverrideID {
+			out[n-1].End = end
+			continue
+		}
+		current.Start, current.End = start, end
+		out = append(out, *current)
+	}
+	return out, nil
+}
+
+// At 返回 at 时刻的值班班次，无人值班返回 nil；班次起止最多延伸到 at 前后 7 天
+func At(schedule *ent.OnCallSchedule, overrides []*ent.OnCallOverride, at time.Time) (*Shift, error) {
+	shifts, err := Render(schedule, overrides, at.Add(-shiftLookaround), at.Add(shiftLookaround))
+	if err != nil {
+		return nil, err
+	}
+	for i := range shifts {
+		if !shifts[i].Start.After(at) && shifts[i].End.After(at) {
+			return &shifts[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// layerRotation 展开单个轮值层在 [from, to) 内的班次（已按时段限制裁剪）
+func layerRotation(layer schema.OnCallLayer, loc *time.Location, from, to time.Time) []Shift {
+	if len(layer.UserIDs) == 0 {
+		return nil
+	}
+	if layer.End != nil && layer.End.Before(to) {
+		to = *layer.End
+	}
+	if from.Before(layer.Start) {
+		from = layer.Start
+	}
+	if !from.Before(to) {
+		return nil
+	}
+	r := newRotation(layer, loc)
+	var out []Shift
+	for i := r.index(from); ; i++ {
+		start, end := r.start(i), r.start(i+1)
+		if !start.Before(to) {
+			break
+		}
+		start, end = clampTime(start, from, to), clampTime(end, from, to)
+		if !start.Before(end) {
+			continue
+		}
+		user := layer.UserIDs[i%len(layer.UserIDs)]
+		if len(layer.Restrictions) == 0 {
+			out = append(out, Shift{UserID: user, Start: start, End: end, Layer: layer.Name})
+			continue
+		}
+		for _, w := range restrictionWindows(layer.Restrictions, loc, start, end) {
+			out = append(out, Shift{UserID: user, Start: w[0], End: w[1], Layer: layer.Name})
+		}
+	}
+	return out
+}
+
+// rotation 轮换周期：第 i 班的开始时间与某时刻所在的班次序号
+type rotation struct {
+	layer schema.OnCallLayer
+	loc   *time.Location
+	days  int           // daily/weekly 每班天数
+	shift time.Duration // custom 每班时长
+}
+
+func newRotation(layer schema.OnCallLayer, loc *time.Location) rotation {
+	r := rotation{layer: layer, loc: loc}
+	turn := layer.TurnLength
+	if turn <= 0 {
+		turn = 1
+	}
+	switch layer.Rotation {
+	case RotationWeekly:
+		r.days = 7 * turn
+	case RotationCustom:
+		r.shift = time.Duration(layer.ShiftMinutes) * time.Minute
+	default:
+		r.days = turn
+	}
+	return r
+}
+
+func (r rotation) start(i int) time.Time {
+	if r.shift > 0 {
+		return r.layer.Start.Add(time.Duration(i) * r.shift)
+	}
+	s := r.layer.Start.In(r.loc)
+	return time.Date(s.Year(), s.Month(), s.Day()+i*r.days, s.Hour(), s.Minute(), s.Second(), 0, r.loc)
+}
+
+// index t 所在班次序号，t 不早于层开始时间
+func (r rotation) index(t time.Time) int {
+	if r.shift > 0 {
+		return int(t.Sub(r.layer.Start) / r.shift)
+	}
+	s, lt := r.layer.Start.In(r.loc), t.In(r.loc)
+	days := civilDays(lt) - civilDays(s)
+	i := days / r.days
+	// 当地交接时刻之前仍属上一班；夏令时跳变时逐班校正
+	for i > 0 && r.start(i).After(t) {
+		i--
+	}
+	for !r.start(i + 1).After(t) {
+		i++
+	}
+	return i
+}
+
+// civilDays 当地日期距公元纪年的天数
+func civilDays(t time.Time) int {
+	return int(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Unix() / 86400)
+}
+
+// restrictionWindows 时段限制在 [start, end) 内的生效区间（已合并）
+func restrictionWindows(restrictions []schema.OnCallRestriction, loc *time.Location, start, end time.Time) [][2]time.Time {
+	var windows [][2]time.Time
+	ls := start.In(loc)
+	for day := time.Date(ls.Year(), ls.Month(), ls.Day()-1, 0, 0, 0, 0, loc); day.Before(end); day = time.Date(day.Year(), day.Month(), day.Day()+1, 0, 0, 0, 0, loc) {
+		weekday := int(day.Weekday())
+		if weekday == 0 {
+			weekday = 7
+		}
+		for _, r := range restrictions {
+			if r.Weekday != 0 && r.Weekday != weekday {
+				continue
+			}
+			from, ok1 := parseClock(r.Start)
+			to, ok2 := parseClock(r.End)
+			if !ok1 || !ok2 {
+				continue
+			}
+			if to <= from {
+				to += 24 * 60
+			}
+			ws := time.Date(day.Year(), day.Month(), day.Day(), 0, from, 0, 0, loc)
+			we := time.Date(day.Year(), day.Month(), day.Day(), 0, to, 0, 0, loc)
+			ws, we = clampTime(ws, start, end), clampTime(we, start, end)
+			if ws.Before(we) {
+				windows = append(windows, [2]time.Time{ws, we})
+			}
+		}
+	}
+	sort.Slice(windows, func(i, j int) bool { return windows[i][0].Before(windows[j][0]) })
+	merged := windows[:0]
+	for _, w := range windows {
+		if n := len(merged); n > 0 && !w[0].After(merged[n-1][1]) {
+			if w[1].After(merged[n-1][1]) {
+				merged[n-1][1] = w[1]
+			}
+			continue
+		}
+		merged = append(merged, w)
+	}
+	return merged
+}
+
+// parseClock 解析 "HH:MM"，返回距零点的分钟数；允许 "24:00"
+func parseClock(value string) (int, bool) {
+	parts := strings.Split(strings.TrimSpace(value), ":")
+	if len(parts) != 2 {
+		return 0, false
+	}
+	h, err1 := strconv.Atoi(parts[0])
+	m, err2 := strconv.Atoi(parts[1])
+	if err1 != nil || err2 != nil || h < 0 || m < 0 || m > 59 || h > 24 || (h == 24 && m != 0) {
+		return 0, false
+	}
+	return h*60 + m, true
+}
+
+func clampTime(t, lo, hi time.Time) time.Time {
+	if t.Before(lo) {
+		return lo
+	}
+	if t.After(hi) {
+		return hi
+	}
+	return t
+}
@@ -0,0 +1,167 @@
+package oncall
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"itsm-backend/ent"
+	"itsm-backend/ent/schema"
+
+	"github.com/stretchr/testify/require"
+)
+
+func mustLoc(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	require.NoError(t, err)
+	return loc
+}
+
+func testSchedule(tz string, layers ...schema.OnCallLayer) *ent.OnCallSchedule {
+	return &ent.OnCallSchedule{ID: 1, TimeZone: tz, Enabled: true, Layers: layers}
+}
+
+func userAt(t *testing.T, schedule *ent.OnCallSchedule, overrides []*ent.OnCallOverride, at time.Time) int {
+	t.Helper()
+	shift, err := At(schedule, overrides, at)
+	require.NoError(t, err)
+	if shift == nil {
+		return 0
+	}
+	return shift.UserID
+}
+
+func TestRenderDailyRotationHandsOffAtLocalTime(t *testing.T) {
+	sh := mustLoc(t, "Asia/Shanghai")
+	schedule := testSchedule("Asia/Shanghai", schema.OnCallLayer{
+		Name: "primary", Rotation: RotationDaily, Start: time.Date(2026, 3, 2, 9, 0, 0, 0, sh), UserIDs: []int{1, 2, 3},
+	})
+
+	require.Equal(t, 1, userAt(t, schedule, nil, time.Date(2026, 3, 2, 9, 0, 0, 0, sh)))
+	require.Equal(t, 1, userAt(t, schedule, nil, time.Date(2026, 3, 3, 8, 59, 0, 0, sh)))
+	require.Equal(t, 2, userAt(t, schedule, nil, time.Date(2026, 3, 3, 9, 0, 0, 0, sh)))
+	require.Equal(t, 1, userAt(t, schedule, nil, time.Date(2026, 3, 5, 10, 0, 0, 0, sh)), "三人轮换第四天回到第一人")
+	require.Equal(t, 0, userAt(t, schedule, nil, time.Date(2026, 3, 2, 8, 0, 0, 0, sh)), "开始前无人值班")
+
+	shifts, err := Render(schedule, nil, time.Date(2026, 3, 2, 9, 0, 0, 0, sh), time.Date(2026, 3, 4, 9, 0, 0, 0, sh))
+	require.NoError(t, err)
+	require.Len(t, shifts, 2)
+	require.Equal(t, 24*time.Hour, shifts[0].End.Sub(shifts[0].Start))
+}
+
+func TestRenderWeeklyRotationAcrossDST(t *testing.T) {
+	ny := mustLoc(t, "America/New_York")
+	// 2026-03-08 美东进入夏令时，周一 09:00 交接在切换前后保持当地时刻
+	schedule := testSchedule("America/New_York", schema.OnCallLayer{
+		Name: "weekly", Rotation: RotationWeekly, Start: time.Date(2026, 3, 2, 9, 0, 0, 0, ny), UserIDs: []int{7, 8},
+	})
+
+	shifts, err := Render(schedule, nil, time.Date(2026, 3, 2, 9, 0, 0, 0, ny), time.Date(2026, 3, 16, 9, 0, 0, 0, ny))
+	require.NoError(t, err)
+	require.Len(t, shifts, 2)
+	require.Equal(t, 7, shifts[0].UserID)
+	require.Equal(t, time.Date(2026, 3, 9, 9, 0, 0, 0, ny), shifts[0].End)
+	require.Equal(t, 167*time.Hour, shifts[0].End.Sub(shifts[0].Start), "跨夏令时的一周少一小时")
+	require.Equal(t, 8, shifts[1].UserID)
+	require.Equal(t, 9, shifts[1].Start.In(ny).Hour())
+
+	require.Equal(t, 7, userAt(t, schedule, nil, time.Date(2026, 3, 9, 8, 30, 0, 0, ny)))
+	require.Equal(t, 8, userAt(t, schedule, nil, time.Date(2026, 3, 9, 9, 0, 0, 0, ny)))
+}
+
+func TestRenderLayersAndRestrictions(t *testing.T) {
+	sh := mustLoc(t, "Asia/Shanghai")
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, sh) // 周一
+	schedule := testSchedule("Asia/Shanghai",
+		schema.OnCallLayer{Name: "base", Rotation: RotationWeekly, Start: start, UserIDs: []int{1}},
+		// 工作日白天由二线值班覆盖底层
+		schema.OnCallLayer{
+			Name: "business-hours", Rotation: RotationDaily, Start: start, UserIDs: []int{2, 3},
+			Restrictions: []schema.OnCallRestriction{
+				{Weekday: 1, Start: "09:00", End: "18:00"}, {Weekday: 2, Start: "09:00", End: "18:00"},
+				{Weekday: 3, Start: "09:00", End: "18:00"}, {Weekday: 4, Start: "09:00", End: "18:00"},
+				{Weekday: 5, Start: "09:00", End: "18:00"},
+			},
+		},
+	)
+
+	require.Equal(t, 1, userAt(t, schedule, nil, time.Date(2026, 3, 2, 8, 0, 0, 0, sh)))
+	require.Equal(t, 2, userAt(t, schedule, nil, time.Date(2026, 3, 2, 10, 0, 0, 0, sh)))
+	require.Equal(t, 3, userAt(t, schedule, nil, time.Date(2026, 3, 3, 10, 0, 0, 0, sh)))
+	require.Equal(t, 1, userAt(t, schedule, nil, time.Date(2026, 3, 3, 18, 0, 0, 0, sh)))
+	require.Equal(t, 1, userAt(t, schedule, nil, time.Date(2026, 3, 7, 10, 0, 0, 0, sh)), "周六白天不受限时段覆盖")
+
+	shifts, err := Render(schedule, nil, start, start.Add(24*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, shifts, 3)
+	require.Equal(t, []int{1, 2, 1}, []int{shifts[0].UserID, shifts[1].UserID, shifts[2].UserID})
+	require.Equal(t, "business-hours", shifts[1].Layer)
+}
+
+func TestRenderOvernightRestriction(t *testing.T) {
+	sh := mustLoc(t, "Asia/Shanghai")
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, sh)
+	schedule := testSchedule("Asia/Shanghai", schema.OnCallLayer{
+		Name: "night", Rotation: RotationWeekly, Start: start, UserIDs: []int{5},
+		Restrictions: []schema.OnCallRestriction{{Weekday: 0, Start: "22:00", End: "06:00"}},
+	})
+	require.Equal(t, 5, userAt(t, schedule, nil, time.Date(2026, 3, 3, 23, 0, 0, 0, sh)))
+	require.Equal(t, 5, userAt(t, schedule, nil, time.Date(2026, 3, 4, 5, 59, 0, 0, sh)))
+	require.Equal(t, 0, userAt(t, schedule, nil, time.Date(2026, 3, 4, 12, 0, 0, 0, sh)))
+}
+
+func TestRenderOverridesAndSwaps(t *testing.T) {
+	sh := mustLoc(t, "Asia/Shanghai")
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, sh)
+	schedule := testSchedule("Asia/Shanghai", schema.OnCallLayer{
+		Name: "primary", Rotation: RotationDaily, Start: start, UserIDs: []int{1, 2},
+	})
+	original := func(id int) *int { return &id }
+	overrides := []*ent.OnCallOverride{
+		// 换班：1 的 3/2 班与 2 的 3/3 班互换
+		{ID: 1, UserID: 2, OriginalUserID: original(1), StartAt: start, EndAt: start.Add(24 * time.Hour), CreatedAt: start},
+		{ID: 2, UserID: 1, OriginalUserID: original(2), StartAt: start.Add(24 * time.Hour), EndAt: start.Add(48 * time.Hour), CreatedAt: start},
+		// 后创建的临时替换优先
+		{ID: 3, UserID: 9, StartAt: start.Add(2 * time.Hour), EndAt: start.Add(3 * time.Hour), CreatedAt: start.Add(time.Minute)},
+		// 原值班人不匹配的替换不生效
+		{ID: 4, UserID: 9, OriginalUserID: original(2), StartAt: start.Add(48 * time.Hour), EndAt: start.Add(50 * time.Hour), CreatedAt: start},
+	}
+
+	require.Equal(t, 2, userAt(t, schedule, overrides, start.Add(time.Hour)))
+	require.Equal(t, 9, userAt(t, schedule, overrides, start.Add(150*time.Minute)))
+	require.Equal(t, 1, userAt(t, schedule, overrides, start.Add(30*time.Hour)))
+	require.Equal(t, 1, userAt(t, schedule, overrides, start.Add(49*time.Hour)))
+
+	shift, err := At(schedule, overrides, start.Add(30*time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 2, shift.OverrideID)
+	require.Equal(t, "primary", shift.Layer)
+}
+
+func TestValidateLayers(t *testing.T) {
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	require.NoError(t, ValidateLayers([]schema.OnCallLayer{{Rotation: RotationCustom, ShiftMinutes: 720, Start: start, UserIDs: []int{1}}}))
+	require.Error(t, ValidateLayers(nil))
+	require.Error(t, ValidateLayers([]schema.OnCallLayer{{Rotation: "monthly", Start: start, UserIDs: []int{1}}}))
+	require.Error(t, ValidateLayers([]schema.OnCallLayer{{Rotation: RotationCustom, ShiftMinutes: 5, Start: start, UserIDs: []int{1}}}))
+	require.Error(t, ValidateLayers([]schema.OnCallLayer{{Rotation: RotationDaily, Start: start}}))
+	require.Error(t, ValidateLayers([]schema.OnCallLayer{{Rotation: RotationDaily, Start: start, UserIDs: []int{1},
+		Restrictions: []schema.OnCallRestriction{{Weekday: 1, Start: "9点", End: "18:00"}}}}))
+}
+
+func TestICalFoldsAndEscapes(t *testing.T) {
+	start := time.Date(2026, 3, 2, 1, 0, 0, 0, time.UTC)
+	body := ICal("张三 的值班", []CalendarEvent{{
+		UID: "oncall-1-1-1@itsm", Summary: "值班: 核心系统, 一线; 夜班", Description: strings.Repeat("说明", 40),
+		Start: start, End: start.Add(12 * time.Hour),
+	}}, start)
+
+	require.True(t, strings.HasPrefix(body, "BEGIN:VCALENDAR\r\n"))
+	require.True(t, strings.HasSuffix(body, "END:VCALENDAR\r\n"))
+	require.Contains(t, body, "DTSTART:20260302T010000Z\r\n")
+	require.Contains(t, body, `SUMMARY:值班: 核心系统\, 一线\; 夜班`)
+	for _, line := range strings.Split(strings.TrimSuffix(body, "\r\n"), "\r\n") {
+		require.LessOrEqual(t, len(line), 75)
+	}
+}
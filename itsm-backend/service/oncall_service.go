@@ -0,0 +1,1104 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"itsm-backend/common"
+	"itsm-backend/common/tenantctx"
+	"itsm-backend/dto"
+	"itsm-backend/ent"
+	"itsm-backend/ent/escalationpolicy"
+	"itsm-backend/ent/incident"
+	"itsm-backend/ent/oncalloverride"
+	"itsm-backend/ent/oncallpage"
+	"itsm-backend/ent/oncallschedule"
+	"itsm-backend/ent/schema"
+	"itsm-backend/ent/team"
+	"itsm-backend/ent/user"
+	"itsm-backend/service/oncall"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var (
+	// ErrOnCallScheduleNotFound 值班表不存在或不属于当前租户
+	ErrOnCallScheduleNotFound = oncall.ErrScheduleNotFound
+	// ErrOnCallScheduleDisabled 值班表已停用
+	ErrOnCallScheduleDisabled = oncall.ErrScheduleDisabled
+	// ErrNobodyOnCall 值班表在该时刻无人值班
+	ErrNobodyOnCall = oncall.ErrNobodyOnCall
+	// ErrOnCallInvalid 值班表、替换或升级策略参数无效
+	ErrOnCallInvalid = errors.New("值班配置无效")
+	// ErrOnCallScheduleInUse 值班表仍被升级策略引用
+	ErrOnCallScheduleInUse = errors.New("值班表仍被升级策略引用")
+	// ErrOnCallOverrideNotFound 值班替换不存在
+	ErrOnCallOverrideNotFound = errors.New("值班替换不存在")
+	// ErrEscalationPolicyNotFound 升级策略不存在或不属于当前租户
+	ErrEscalationPolicyNotFound = errors.New("升级策略不存在")
+	// ErrOnCallPageNotFound 值班呼叫不存在
+	ErrOnCallPageNotFound = errors.New("值班呼叫不存在")
+	// ErrOnCallPageClosed 值班呼叫已结束
+	ErrOnCallPageClosed = errors.New("值班呼叫已结束")
+	// ErrOnCallFeedToken 日历订阅令牌无效
+	ErrOnCallFeedToken = errors.New("日历订阅令牌无效")
+)
+
+const (
+	defaultOnCallTimeZone = "Asia/Shanghai"
+	// defaultOnCallRange 未指定区间时展开的天数
+	defaultOnCallRange = 14 * 24 * time.Hour
+	// onCallFeedPast / onCallFeedFuture 日历订阅包含的过去与未来范围
+	onCallFeedPast     = 7 * 24 * time.Hour
+	onCallFeedFuture   = 60 * 24 * time.Hour
+	onCallNotification = "oncall_page"
+
+	EscalationTargetSchedule = "schedule"
+	EscalationTargetUser     = "user"
+)
+
+// OnCallService 值班管理：值班表（多层轮值、时段限制、时区）、值班替换与换班、按团队查询当前值班人、
+// 个人值班 iCal 订阅，以及按升级策略逐步呼叫值班人并在确认超时后升级。
+type OnCallService struct {
+	client        *ent.Client
+	incidents     *IncidentService
+	notifications *NotificationService
+	feedSecret    []byte
+	logger        *zap.SugaredLogger
+	now           func() time.Time
+}
+
+// NewOnCallService 创建值班服务
+func NewOnCallService(client *ent.Client, incidents *IncidentService, logger *zap.SugaredLogger) *OnCallService {
+	return &OnCallService{client: client, incidents: incidents, logger: logger, now: time.Now}
+}
+
+// SetNotificationService 设置站内通知服务，用于向被呼叫的值班人发送通知
+func (s *OnCallService) SetNotificationService(notifications *NotificationService) {
+	s.notifications = notifications
+}
+
+// SetCalendarFeedSecret 设置日历订阅令牌的签名密钥；未设置时不提供订阅地址
+func (s *OnCallService) SetCalendarFeedSecret(secret string) {
+	s.feedSecret = []byte(secret)
+}
+
+// ---- 值班表 ----
+
+// CreateSchedule 创建值班表
+func (s *OnCallService) CreateSchedule(ctx context.Context, tenantID, userID int, req *dto.CreateOnCallScheduleRequest) (*dto.OnCallScheduleResponse, error) {
+	timeZone := strings.TrimSpace(req.TimeZone)
+	if timeZone == "" {
+		timeZone = defaultOnCallTimeZone
+	}
+	if err := s.validateSchedule(ctx, tenantID, timeZone, req.TeamID, req.Layers); err != nil {
+		return nil, err
+	}
+	rec, err := s.client.OnCallSchedule.Create().
+		SetTenantID(tenantID).
+		SetName(strings.TrimSpace(req.Name)).
+		SetDescription(req.Description).
+		SetNillableTeamID(req.TeamID).
+		SetTimeZone(timeZone).
+		SetLayers(req.Layers).
+		SetNillableEnabled(req.Enabled).
+		SetCreatedBy(userID).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, fmt.Errorf("%w: 值班表名称已存在", ErrOnCallInvalid)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("创建值班表失败: %w", err)
+	}
+	return toOnCallScheduleResponse(rec), nil
+}
+
+// UpdateSchedule 修改值班表
+func (s *OnCallService) UpdateSchedule(ctx context.Context, tenantID, id int, req *dto.UpdateOnCallScheduleRequest) (*dto.OnCallScheduleResponse, error) {
+	rec, err := s.getSchedule(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	timeZone, teamID, layers := rec.TimeZone, rec.TeamID, rec.Layers
+	update := rec.Update()
+	if req.Name != nil {
+		if strings.TrimSpace(*req.Name) == "" {
+			return nil, fmt.Errorf("%w: 值班表名称不能为空", ErrOnCallInvalid)
+		}
+		update.SetName(strings.TrimSpace(*req.Name))
+	}
+	if req.Description != nil {
+		update.SetDescription(*req.Description)
+	}
+	if req.TeamID != nil {
+		if *req.TeamID == 0 {
+			teamID = nil
+			update.ClearTeamID()
+		} else {
+			teamID = req.TeamID
+			update.SetTeamID(*req.TeamID)
+		}
+	}
+	if req.TimeZone != nil {
+		timeZone = strings.TrimSpace(*req.TimeZone)
+		update.SetTimeZone(timeZone)
+	}
+	if req.Layers != nil {
+		layers = *req.Layers
+		update.SetLayers(layers)
+	}
+	if req.Enabled != nil {
+		update.SetEnabled(*req.Enabled)
+	}
+	if err := s.validateSchedule(ctx, tenantID, timeZone, teamID, layers); err != nil {
+		return nil, err
+	}
+	saved, err := update.Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, fmt.Errorf("%w: 值班表名称已存在", ErrOnCallInvalid)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("更新值班表失败: %w", err)
+	}
+	return toOnCallScheduleResponse(saved), nil
+}
+
+// DeleteSchedule 删除值班表及其值班替换；仍被升级策略引用时拒绝删除
+func (s *OnCallService) DeleteSchedule(ctx context.Context, tenantID, id int) error {
+	if _, err := s.getSchedule(ctx, tenantID, id); err != nil {
+		return err
+	}
+	policies, err := s.client.EscalationPolicy.Query().Where(escalationpolicy.TenantID(tenantID)).All(ctx)
+	if err != nil {
+		return fmt.Errorf("查询升级策略失败: %w", err)
+	}
+	for _, p := range policies {
+		for _, step := range p.Steps {
+			for _, target := range step.Targets {
+				if target.Type == EscalationTargetSchedule && target.ID == id {
+					return fmt.Errorf("%w: %s", ErrOnCallScheduleInUse, p.Name)
+				}
+			}
+		}
+	}
+	return s.withTx(ctx, func(tx *ent.Tx) error {
+		if _, err := tx.OnCallOverride.Delete().
+			Where(oncalloverride.TenantID(tenantID), oncalloverride.ScheduleID(id)).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("删除值班替换失败: %w", err)
+		}
+		if err := tx.OnCallSchedule.DeleteOneID(id).Exec(ctx); err != nil {
+			return fmt.Errorf("删除值班表失败: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetSchedule 获取值班表
+func (s *OnCallService) GetSchedule(ctx context.Context, tenantID, id int) (*dto.OnCallScheduleResponse, error) {
+	rec, err := s.getSchedule(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	return toOnCallScheduleResponse(rec), nil
+}
+
+// ListSchedules 列出值班表，teamID 为 0 时列出全部
+func (s *OnCallService) ListSchedules(ctx context.Context, tenantID, teamID int) ([]*dto.OnCallScheduleResponse, error) {
+	query := s.client.OnCallSchedule.Query().Where(oncallschedule.TenantID(tenantID))
+	if teamID > 0 {
+		query = query.Where(oncallschedule.TeamID(teamID))
+	}
+	recs, err := query.Order(ent.Asc(oncallschedule.FieldName)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询值班表失败: %w", err)
+	}
+	out := make([]*dto.OnCallScheduleResponse, 0, len(recs))
+	for _, rec := range recs {
+		out = append(out, toOnCallScheduleResponse(rec))
+	}
+	return out, nil
+}
+
+// Shifts 展开值班表在 [from, to) 内的最终值班，零值时默认从当前时间起 14 天
+func (s *OnCallService) Shifts(ctx context.Context, tenantID, scheduleID int, from, to time.Time) ([]dto.OnCallShift, error) {
+	from, to = s.defaultRange(from, to)
+	schedule, overrides, err := oncall.Load(ctx, s.client, tenantID, scheduleID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	shifts, err := oncall.Render(schedule, overrides, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrOnCallInvalid, err)
+	}
+	return s.toShifts(ctx, tenantID, shifts), nil
+}
+
+// WhoIsOnCall 查询 at 时刻各启用值班表的值班人，可按团队或值班表过滤
+func (s *OnCallService) WhoIsOnCall(ctx context.Context, tenantID, teamID, scheduleID int, at time.Time) ([]dto.OnCallNow, error) {
+	if at.IsZero() {
+		at = s.now()
+	}
+	query := s.client.OnCallSchedule.Query().Where(oncallschedule.TenantID(tenantID), oncallschedule.Enabled(true))
+	if teamID > 0 {
+		query = query.Where(oncallschedule.TeamID(teamID))
+	}
+	if scheduleID > 0 {
+		query = query.Where(oncallschedule.ID(scheduleID))
+	}
+	schedules, err := query.Order(ent.Asc(oncallschedule.FieldName)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询值班表失败: %w", err)
+	}
+	out := make([]dto.OnCallNow, 0, len(schedules))
+	for _, schedule := range schedules {
+		now := dto.OnCallNow{ScheduleID: schedule.ID, ScheduleName: schedule.Name, TeamID: schedule.TeamID}
+		shift, err := oncall.Current(ctx, s.client, tenantID, schedule.ID, at)
+		switch {
+		case errors.Is(err, oncall.ErrNobodyOnCall):
+		case err != nil:
+			s.logger.Warnw("Failed to resolve on-call", "schedule_id", schedule.ID, "error", err)
+		default:
+			shifts := s.toShifts(ctx, tenantID, []oncall.Shift{*shift})
+			now.Shift = &shifts[0]
+		}
+		out = append(out, now)
+	}
+	return out, nil
+}
+
+// CurrentOnCallUser 值班表在 at 时刻的值班人ID，供事件分配与审批人解析使用
+func (s *OnCallService) CurrentOnCallUser(ctx context.Context, tenantID, scheduleID int, at time.Time) (int, error) {
+	shift, err := oncall.Current(ctx, s.client, tenantID, scheduleID, at)
+	if err != nil {
+		return 0, err
+	}
+	return shift.UserID, nil
+}
+
+func (s *OnCallService) validateSchedule(ctx context.Context, tenantID int, timeZone string, teamID *int, layers []schema.OnCallLayer) error {
+	if _, err := time.LoadLocation(timeZone); err != nil || timeZone == "" {
+		return fmt.Errorf("%w: 无效的时区 %q", ErrOnCallInvalid, timeZone)
+	}
+	if err := oncall.ValidateLayers(layers); err != nil {
+		return fmt.Errorf("%w: %v", ErrOnCallInvalid, err)
+	}
+	if teamID != nil {
+		exists, err := s.client.Team.Query().
+			Where(team.ID(*teamID), team.TenantID(tenantID), team.DeletedAtIsNil()).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("查询团队失败: %w", err)
+		}
+		if !exists {
+			return fmt.Errorf("%w: 团队 %d 不存在", ErrOnCallInvalid, *teamID)
+		}
+	}
+	var userIDs []int
+	for _, layer := range layers {
+		userIDs = append(userIDs, layer.UserIDs...)
+	}
+	return s.ensureUsers(ctx, tenantID, userIDs)
+}
+
+func (s *OnCallService) getSchedule(ctx context.Context, tenantID, id int) (*ent.OnCallSchedule, error) {
+	rec, err := s.client.OnCallSchedule.Query().
+		Where(oncallschedule.ID(id), oncallschedule.TenantID(tenantID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrOnCallScheduleNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询值班表失败: %w", err)
+	}
+	return rec, nil
+}
+
+// ---- 值班替换 ----
+
+// CreateOverride 创建值班替换
+func (s *OnCallService) CreateOverride(ctx context.Context, tenantID, scheduleID, userID int, req *dto.CreateOnCallOverrideRequest) (*dto.OnCallOverrideResponse, error) {
+	if _, err := s.getSchedule(ctx, tenantID, scheduleID); err != nil {
+		return nil, err
+	}
+	if !req.StartAt.Before(req.EndAt) {
+		return nil, fmt.Errorf("%w: 替换开始时间必须早于结束时间", ErrOnCallInvalid)
+	}
+	users := []int{req.UserID}
+	if req.OriginalUserID != nil {
+		users = append(users, *req.OriginalUserID)
+	}
+	if err := s.ensureUsers(ctx, tenantID, users); err != nil {
+		return nil, err
+	}
+	rec, err := s.client.OnCallOverride.Create().
+		SetTenantID(tenantID).
+		SetScheduleID(scheduleID).
+		SetUserID(req.UserID).
+		SetNillableOriginalUserID(req.OriginalUserID).
+		SetStartAt(req.StartAt).
+		SetEndAt(req.EndAt).
+		SetReason(req.Reason).
+		SetCreatedBy(userID).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("创建值班替换失败: %w", err)
+	}
+	return toOnCallOverrideResponse(rec), nil
+}
+
+// SwapShifts 换班：成对创建两条只替换对方班次的值班替换。两段时间的开始时刻须分别由双方值班
+func (s *OnCallService) SwapShifts(ctx context.Context, tenantID, scheduleID, userID int, req *dto.SwapOnCallShiftsRequest) ([]*dto.OnCallOverrideResponse, error) {
+	if req.UserID == req.WithUserID {
+		return nil, fmt.Errorf("%w: 不能与自己换班", ErrOnCallInvalid)
+	}
+	if !req.StartAt.Before(req.EndAt) || !req.WithStartAt.Before(req.WithEndAt) {
+		return nil, fmt.Errorf("%w: 换班开始时间必须早于结束时间", ErrOnCallInvalid)
+	}
+	if err := s.ensureUsers(ctx, tenantID, []int{req.UserID, req.WithUserID}); err != nil {
+		return nil, err
+	}
+	for _, check := range []struct {
+		user int
+		at   time.Time
+	}{{req.UserID, req.StartAt}, {req.WithUserID, req.WithStartAt}} {
+		shift, err := oncall.Current(ctx, s.client, tenantID, scheduleID, check.at)
+		if err != nil && !errors.Is(err, oncall.ErrNobodyOnCall) {
+			return nil, err
+		}
+		if shift == nil || shift.UserID != check.user {
+			return nil, fmt.Errorf("%w: 用户 %d 在 %s 不值班", ErrOnCallInvalid, check.user, check.at.Format(time.RFC3339))
+		}
+	}
+	group := uuid.NewString()
+	var out []*dto.OnCallOverrideResponse
+	err := s.withTx(ctx, func(tx *ent.Tx) error {
+		pairs := []struct {
+			user, original int
+			start, end     time.Time
+		}{
+			{req.WithUserID, req.UserID, req.StartAt, req.EndAt},
+			{req.UserID, req.WithUserID, req.WithStartAt, req.WithEndAt},
+		}
+		for _, p := range pairs {
+			rec, err := tx.OnCallOverride.Create().
+				SetTenantID(tenantID).
+				SetScheduleID(scheduleID).
+				SetUserID(p.user).
+				SetOriginalUserID(p.original).
+				SetStartAt(p.start).
+				SetEndAt(p.end).
+				SetReason(req.Reason).
+				SetSwapGroup(group).
+				SetCreatedBy(userID).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("创建换班记录失败: %w", err)
+			}
+			out = append(out, toOnCallOverrideResponse(rec))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListOverrides 列出值班表在 [from, to) 内的值班替换
+func (s *OnCallService) ListOverrides(ctx context.Context, tenantID, scheduleID int, from, to time.Time) ([]*dto.OnCallOverrideResponse, error) {
+	from, to = s.defaultRange(from, to)
+	_, overrides, err := oncall.Load(ctx, s.client, tenantID, scheduleID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(overrides, func(i, j int) bool { return overrides[i].StartAt.Before(overrides[j].StartAt) })
+	out := make([]*dto.OnCallOverrideResponse, 0, len(overrides))
+	for _, o := range overrides {
+		out = append(out, toOnCallOverrideResponse(o))
+	}
+	return out, nil
+}
+
+// DeleteOverride 删除值班替换；换班记录成对删除
+func (s *OnCallService) DeleteOverride(ctx context.Context, tenantID, scheduleID, id int) error {
+	rec, err := s.client.OnCallOverride.Query().
+		Where(oncalloverride.ID(id), oncalloverride.TenantID(tenantID), oncalloverride.ScheduleID(scheduleID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return ErrOnCallOverrideNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("查询值班替换失败: %w", err)
+	}
+	del := s.client.OnCallOverride.Delete().Where(oncalloverride.TenantID(tenantID))
+	if rec.SwapGroup != "" {
+		del.Where(oncalloverride.SwapGroup(rec.SwapGroup))
+	} else {
+		del.Where(oncalloverride.ID(rec.ID))
+	}
+	if _, err := del.Exec(ctx); err != nil {
+		return fmt.Errorf("删除值班替换失败: %w", err)
+	}
+	return nil
+}
+
+// ---- 日历订阅 ----
+
+// CalendarFeed 返回用户的值班日历订阅地址
+func (s *OnCallService) CalendarFeed(tenantID, userID int) (*dto.OnCallCalendarFeed, error) {
+	if len(s.feedSecret) == 0 {
+		return nil, errors.New("未配置日历订阅签名密钥")
+	}
+	token := fmt.Sprintf("%d.%d.%s", tenantID, userID, s.signFeed(tenantID, userID))
+	return &dto.OnCallCalendarFeed{Token: token, Path: "/api/v1/oncall/calendar/" + token + ".ics"}, nil
+}
+
+// VerifyCalendarFeedToken 校验订阅令牌，返回租户与用户
+func (s *OnCallService) VerifyCalendarFeedToken(token string) (int, int, error) {
+	parts := strings.Split(strings.TrimSuffix(token, ".ics"), ".")
+	if len(s.feedSecret) == 0 || len(parts) != 3 {
+		return 0, 0, ErrOnCallFeedToken
+	}
+	tenantID, err1 := strconv.Atoi(parts[0])
+	userID, err2 := strconv.Atoi(parts[1])
+	if err1 != nil || err2 != nil || tenantID <= 0 || userID <= 0 {
+		return 0, 0, ErrOnCallFeedToken
+	}
+	if !hmac.Equal([]byte(parts[2]), []byte(s.signFeed(tenantID, userID))) {
+		return 0, 0, ErrOnCallFeedToken
+	}
+	return tenantID, userID, nil
+}
+
+func (s *OnCallService) signFeed(tenantID, userID int) string {
+	mac := hmac.New(sha256.New, s.feedSecret)
+	fmt.Fprintf(mac, "oncall-ical:%d:%d", tenantID, userID)
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil)[:18])
+}
+
+// UserCalendar 生成用户在所有启用值班表中的值班 iCal 日历（过去 7 天至未来 60 天）
+func (s *OnCallService) UserCalendar(ctx context.Context, tenantID, userID int) (string, error) {
+	u, err := s.client.User.Query().Where(user.ID(userID), user.TenantID(tenantID)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return "", fmt.Errorf("%w: 用户不存在", ErrOnCallInvalid)
+	}
+	if err != nil {
+		return "", fmt.Errorf("查询用户失败: %w", err)
+	}
+	now := s.now()
+	from, to := now.Add(-onCallFeedPast), now.Add(onCallFeedFuture)
+	schedules, err := s.client.OnCallSchedule.Query().
+		Where(oncallschedule.TenantID(tenantID), oncallschedule.Enabled(true)).
+		All(ctx)
+	if err != nil {
+		return "", fmt.Errorf("查询值班表失败: %w", err)
+	}
+	var events []oncall.CalendarEvent
+	for _, schedule := range schedules {
+		_, overrides, err := oncall.Load(ctx, s.client, tenantID, schedule.ID, from, to)
+		if err != nil {
+			return "", err
+		}
+		shifts, err := oncall.Render(schedule, overrides, from, to)
+		if err != nil {
+			s.logger.Warnw("Failed to render on-call schedule", "schedule_id", schedule.ID, "error", err)
+			continue
+		}
+		for _, shift := range shifts {
+			if shift.UserID != userID {
+				continue
+			}
+			description := "轮值层: " + shift.Layer
+			if shift.OverrideID > 0 {
+				description = "值班替换"
+			}
+			events = append(events, oncall.CalendarEvent{
+				UID:         fmt.Sprintf("oncall-%d-%d-%d@itsm", tenantID, schedule.ID, shift.Start.Unix()),
+				Summary:     "值班: " + schedule.Name,
+				Description: description,
+				Start:       shift.Start,
+				End:         shift.End,
+			})
+		}
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i].Start.Before(events[j].Start) })
+	return oncall.ICal(displayName(u)+" 的值班", events, now), nil
+}
+
+// ---- 升级策略 ----
+
+// CreatePolicy 创建升级策略
+func (s *OnCallService) CreatePolicy(ctx context.Context, tenantID, userID int, req *dto.CreateEscalationPolicyRequest) (*dto.EscalationPolicyResponse, error) {
+	if err := s.validatePolicy(ctx, tenantID, req.TeamID, req.Steps); err != nil {
+		return nil, err
+	}
+	rec, err := s.client.EscalationPolicy.Create().
+		SetTenantID(tenantID).
+		SetName(strings.TrimSpace(req.Name)).
+		SetDescription(req.Description).
+		SetNillableTeamID(req.TeamID).
+		SetSteps(req.Steps).
+		SetRepeatCount(req.RepeatCount).
+		SetNillableEnabled(req.Enabled).
+		SetCreatedBy(userID).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, fmt.Errorf("%w: 升级策略名称已存在", ErrOnCallInvalid)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("创建升级策略失败: %w", err)
+	}
+	return toEscalationPolicyResponse(rec), nil
+}
+
+// UpdatePolicy 修改升级策略；进行中的呼叫在下一次升级时按新步骤执行
+func (s *OnCallService) UpdatePolicy(ctx context.Context, tenantID, id int, req *dto.UpdateEscalationPolicyRequest) (*dto.EscalationPolicyResponse, error) {
+	rec, err := s.getPolicy(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	teamID, steps := rec.TeamID, rec.Steps
+	update := rec.Update()
+	if req.Name != nil {
+		if strings.TrimSpace(*req.Name) == "" {
+			return nil, fmt.Errorf("%w: 升级策略名称不能为空", ErrOnCallInvalid)
+		}
+		update.SetName(strings.TrimSpace(*req.Name))
+	}
+	if req.Description != nil {
+		update.SetDescription(*req.Description)
+	}
+	if req.TeamID != nil {
+		if *req.TeamID == 0 {
+			teamID = nil
+			update.ClearTeamID()
+		} else {
+			teamID = req.TeamID
+			update.SetTeamID(*req.TeamID)
+		}
+	}
+	if req.Steps != nil {
+		steps = *req.Steps
+		update.SetSteps(steps)
+	}
+	if req.RepeatCount != nil {
+		update.SetRepeatCount(*req.RepeatCount)
+	}
+	if req.Enabled != nil {
+		update.SetEnabled(*req.Enabled)
+	}
+	if err := s.validatePolicy(ctx, tenantID, teamID, steps); err != nil {
+		return nil, err
+	}
+	saved, err := update.Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, fmt.Errorf("%w: 升级策略名称已存在", ErrOnCallInvalid)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("更新升级策略失败: %w", err)
+	}
+	return toEscalationPolicyResponse(saved), nil
+}
+
+// DeletePolicy 删除升级策略；进行中的呼叫一并结束
+func (s *OnCallService) DeletePolicy(ctx context.Context, tenantID, id int) error {
+	if _, err := s.getPolicy(ctx, tenantID, id); err != nil {
+		return err
+	}
+	return s.withTx(ctx, func(tx *ent.Tx) error {
+		if err := tx.OnCallPage.Update().
+			Where(oncallpage.TenantID(tenantID), oncallpage.PolicyID(id), oncallpage.StatusEQ(oncallpage.StatusTriggered)).
+			SetStatus(oncallpage.StatusExhausted).
+			ClearNextEscalationAt().
+			Exec(ctx); err != nil {
+			return fmt.Errorf("结束进行中的呼叫失败: %w", err)
+		}
+		if err := tx.EscalationPolicy.DeleteOneID(id).Exec(ctx); err != nil {
+			return fmt.Errorf("删除升级策略失败: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetPolicy 获取升级策略
+func (s *OnCallService) GetPolicy(ctx context.Context, tenantID, id int) (*dto.EscalationPolicyResponse, error) {
+	rec, err := s.getPolicy(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	return toEscalationPolicyResponse(rec), nil
+}
+
+// ListPolicies 列出升级策略，teamID 为 0 时列出全部
+func (s *OnCallService) ListPolicies(ctx context.Context, tenantID, teamID int) ([]*dto.EscalationPolicyResponse, error) {
+	query := s.client.EscalationPolicy.Query().Where(escalationpolicy.TenantID(tenantID))
+	if teamID > 0 {
+		query = query.Where(escalationpolicy.TeamID(teamID))
+	}
+	recs, err := query.Order(ent.Asc(escalationpolicy.FieldName)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询升级策略失败: %w", err)
+	}
+	out := make([]*dto.EscalationPolicyResponse, 0, len(recs))
+	for _, rec := range recs {
+		out = append(out, toEscalationPolicyResponse(rec))
+	}
+	return out, nil
+}
+
+func (s *OnCallService) validatePolicy(ctx context.Context, tenantID int, teamID *int, steps []schema.EscalationPolicyStep) error {
+	if len(steps) == 0 {
+		return fmt.Errorf("%w: 至少需要一个升级步骤", ErrOnCallInvalid)
+	}
+	if teamID != nil {
+		exists, err := s.client.Team.Query().
+			Where(team.ID(*teamID), team.TenantID(tenantID), team.DeletedAtIsNil()).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("查询团队失败: %w", err)
+		}
+		if !exists {
+			return fmt.Errorf("%w: 团队 %d 不存在", ErrOnCallInvalid, *teamID)
+		}
+	}
+	var userIDs, scheduleIDs []int
+	for i, step := range steps {
+		if step.AckTimeoutMinutes < 1 || step.AckTimeoutMinutes > 24*60 {
+			return fmt.Errorf("%w: 第 %d 步的确认超时应为 1-1440 分钟", ErrOnCallInvalid, i+1)
+		}
+		if len(step.Targets) == 0 {
+			return fmt.Errorf("%w: 第 %d 步至少需要一个呼叫目标", ErrOnCallInvalid, i+1)
+		}
+		for _, target := range step.Targets {
+			switch target.Type {
+			case EscalationTargetSchedule:
+				scheduleIDs = append(scheduleIDs, target.ID)
+			case EscalationTargetUser:
+				userIDs = append(userIDs, target.ID)
+			default:
+				return fmt.Errorf("%w: 第 %d 步不支持的呼叫目标类型 %q", ErrOnCallInvalid, i+1, target.Type)
+			}
+		}
+	}
+	if ids := uniquePositiveIDs(scheduleIDs); len(ids) > 0 {
+		n, err := s.client.OnCallSchedule.Query().
+			Where(oncallschedule.IDIn(ids...), oncallschedule.TenantID(tenantID)).
+			Count(ctx)
+		if err != nil {
+			return fmt.Errorf("查询值班表失败: %w", err)
+		}
+		if n != len(ids) || len(ids) != len(scheduleIDs) && hasNonPositive(scheduleIDs) {
+			return fmt.Errorf("%w: 引用的值班表不存在", ErrOnCallInvalid)
+		}
+	}
+	return s.ensureUsers(ctx, tenantID, userIDs)
+}
+
+func (s *OnCallService) getPolicy(ctx context.Context, tenantID, id int) (*ent.EscalationPolicy, error) {
+	rec, err := s.client.EscalationPolicy.Query().
+		Where(escalationpolicy.ID(id), escalationpolicy.TenantID(tenantID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrEscalationPolicyNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询升级策略失败: %w", err)
+	}
+	return rec, nil
+}
+
+// ---- 值班呼叫 ----
+
+// TriggerPage 按升级策略为事件发起值班呼叫，立即呼叫第一步的目标
+func (s *OnCallService) TriggerPage(ctx context.Context, tenantID, incidentID, policyID, userID int) (*dto.OnCallPageResponse, error) {
+	policy, err := s.getPolicy(ctx, tenantID, policyID)
+	if err != nil {
+		return nil, err
+	}
+	if !policy.Enabled {
+		return nil, fmt.Errorf("%w: 升级策略已停用", ErrOnCallInvalid)
+	}
+	inc, err := s.client.Incident.Query().
+		Where(incident.ID(incidentID), incident.TenantID(tenantID), incident.DeletedAtIsNil()).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrIncidentNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询事件失败: %w", err)
+	}
+	if onCallIncidentClosed(inc.Status) {
+		return nil, fmt.Errorf("%w: 事件已解决或关闭", ErrOnCallInvalid)
+	}
+	create := s.client.OnCallPage.Create().
+		SetTenantID(tenantID).
+		SetPolicyID(policyID).
+		SetIncidentID(incidentID)
+	if userID > 0 {
+		create.SetCreatedBy(userID)
+	}
+	page, err := create.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("创建值班呼叫失败: %w", err)
+	}
+	page, err = s.notifyStep(ctx, page, policy, inc, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	return s.toPageResponse(page, policy), nil
+}
+
+// AcknowledgePage 确认值班呼叫并停止升级；事件处于可确认状态时一并确认事件
+func (s *OnCallService) AcknowledgePage(ctx context.Context, tenantID, pageID, userID int) (*dto.OnCallPageResponse, error) {
+	page, err := s.client.OnCallPage.Query().
+		Where(oncallpage.ID(pageID), oncallpage.TenantID(tenantID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrOnCallPageNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询值班呼叫失败: %w", err)
+	}
+	if page.Status != oncallpage.StatusTriggered {
+		return nil, ErrOnCallPageClosed
+	}
+	now := s.now()
+	n, err := s.client.OnCallPage.Update().
+		Where(oncallpage.ID(page.ID), oncallpage.StatusEQ(oncallpage.StatusTriggered)).
+		SetStatus(oncallpage.StatusAcknowledged).
+		SetAcknowledgedBy(userID).
+		SetAcknowledgedAt(now).
+		ClearNextEscalationAt().
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("确认值班呼叫失败: %w", err)
+	}
+	if n == 0 {
+		return nil, ErrOnCallPageClosed
+	}
+	s.recordPageEvent(ctx, tenantID, page.IncidentID, &userID, "oncall_acknowledged", "值班呼叫已确认",
+		fmt.Sprintf("用户 %d 确认了值班呼叫 #%d", userID, page.ID), map[string]interface{}{"pageId": page.ID})
+	if s.incidents != nil {
+		if inc, err := s.client.Incident.Get(ctx, page.IncidentID); err == nil &&
+			isValidIncidentStatusTransition(inc.Status, common.IncidentStatusAcknowledged) {
+			if err := s.incidents.AcknowledgeIncident(ctx, page.IncidentID, userID, tenantID); err != nil {
+				s.logger.Warnw("Failed to acknowledge incident from on-call page", "incident_id", page.IncidentID, "error", err)
+			}
+		}
+	}
+	saved, err := s.client.OnCallPage.Get(ctx, page.ID)
+	if err != nil {
+		return nil, fmt.Errorf("获取值班呼叫失败: %w", err)
+	}
+	policy, _ := s.client.EscalationPolicy.Get(ctx, saved.PolicyID)
+	return s.toPageResponse(saved, policy), nil
+}
+
+// ListPages 列出事件的值班呼叫
+func (s *OnCallService) ListPages(ctx context.Context, tenantID, incidentID int) ([]*dto.OnCallPageResponse, error) {
+	pages, err := s.client.OnCallPage.Query().
+		Where(oncallpage.TenantID(tenantID), oncallpage.IncidentID(incidentID)).
+		Order(ent.Desc(oncallpage.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询值班呼叫失败: %w", err)
+	}
+	policies := make(map[int]*ent.EscalationPolicy)
+	out := make([]*dto.OnCallPageResponse, 0, len(pages))
+	for _, page := range pages {
+		policy, ok := policies[page.PolicyID]
+		if !ok {
+			policy, _ = s.client.EscalationPolicy.Get(ctx, page.PolicyID)
+			policies[page.PolicyID] = policy
+		}
+		out = append(out, s.toPageResponse(page, policy))
+	}
+	return out, nil
+}
+
+// OnIncidentAcknowledged 事件被确认后结束其进行中的呼叫
+func (s *OnCallService) OnIncidentAcknowledged(ctx context.Context, tenantID, incidentID, userID int) error {
+	now := s.now()
+	return s.client.OnCallPage.Update().
+		Where(oncallpage.TenantID(tenantID), oncallpage.IncidentID(incidentID), oncallpage.StatusEQ(oncallpage.StatusTriggered)).
+		SetStatus(oncallpage.StatusAcknowledged).
+		SetAcknowledgedBy(userID).
+		SetAcknowledgedAt(now).
+		ClearNextEscalationAt().
+		Exec(ctx)
+}
+
+// OnIncidentResolved 事件解决或关闭后结束其进行中的呼叫
+func (s *OnCallService) OnIncidentResolved(ctx context.Context, tenantID, incidentID int) error {
+	return s.client.OnCallPage.Update().
+		Where(oncallpage.TenantID(tenantID), oncallpage.IncidentID(incidentID), oncallpage.StatusEQ(oncallpage.StatusTriggered)).
+		SetStatus(oncallpage.StatusResolved).
+		ClearNextEscalationAt().
+		Exec(ctx)
+}
+
+// DispatchDueEscalations 将确认超时的呼叫升级到下一步，返回处理的呼叫数。多个实例并发巡检时
+// 以 (step, round) 做条件更新认领，认领成功者才发送呼叫。
+//
+// RLS 说明：枚举到期呼叫是跨租户操作，使用 SystemContext；逐条处理时切回租户上下文。
+func (s *OnCallService) DispatchDueEscalations(ctx context.Context) (int, error) {
+	ctx = tenantctx.SystemContext(ctx, "oncall:escalations", "escalate unacknowledged on-call pages")
+	due, err := s.client.OnCallPage.Query().
+		Where(oncallpage.StatusEQ(oncallpage.StatusTriggered), oncallpage.NextEscalationAtLTE(s.now())).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("查询待升级呼叫失败: %w", err)
+	}
+	escalated := 0
+	for _, page := range due {
+		tenantCtx := tenantctx.WithTenantID(ctx, page.TenantID)
+		if err := s.escalate(tenantCtx, page); err != nil {
+			s.logger.Warnw("Failed to escalate on-call page", "page_id", page.ID, "error", err)
+			continue
+		}
+		escalated++
+	}
+	return escalated, nil
+}
+
+// escalate 呼叫确认超时：进入下一步；最后一步之后按重复次数从头开始，用尽时结束为 exhausted
+func (s *OnCallService) escalate(ctx context.Context, page *ent.OnCallPage) error {
+	inc, err := s.client.Incident.Query().
+		Where(incident.ID(page.IncidentID), incident.TenantID(page.TenantID)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("查询事件失败: %w", err)
+	}
+	if inc == nil || inc.DeletedAt != nil || onCallIncidentClosed(inc.Status) {
+		// 绕过解决动作结束（或已删除）的事件在此收尾
+		return s.OnIncidentResolved(ctx, page.TenantID, page.IncidentID)
+	}
+	policy, err := s.client.EscalationPolicy.Get(ctx, page.PolicyID)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("查询升级策略失败: %w", err)
+	}
+	step, round := page.Step+1, page.Round
+	if policy != nil && step >= len(policy.Steps) {
+		step, round = 0, round+1
+	}
+	if policy == nil || round > policy.RepeatCount {
+		n, err := s.client.OnCallPage.Update().
+			Where(oncallpage.ID(page.ID), oncallpage.StatusEQ(oncallpage.StatusTriggered), oncallpage.Step(page.Step), oncallpage.Round(page.Round)).
+			SetStatus(oncallpage.StatusExhausted).
+			ClearNextEscalationAt().
+			Save(ctx)
+		if err != nil || n == 0 {
+			return err
+		}
+		s.recordPageEvent(ctx, page.TenantID, page.IncidentID, nil, "oncall_exhausted", "值班呼叫无人确认",
+			fmt.Sprintf("值班呼叫 #%d 已走完全部升级步骤仍无人确认", page.ID), map[string]interface{}{"pageId": page.ID})
+		return nil
+	}
+	n, err := s.client.OnCallPage.Update().
+		Where(oncallpage.ID(page.ID), oncallpage.StatusEQ(oncallpage.StatusTriggered), oncallpage.Step(page.Step), oncallpage.Round(page.Round)).
+		SetStep(step).
+		SetRound(round).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("认领值班呼叫失败: %w", err)
+	}
+	if n == 0 {
+		return nil
+	}
+	page, err = s.client.OnCallPage.Get(ctx, page.ID)
+	if err != nil {
+		return fmt.Errorf("获取值班呼叫失败: %w", err)
+	}
+	_, err = s.notifyStep(ctx, page, policy, inc, step, round)
+	return err
+}
+
+// notifyStep 解析步骤目标的当前值班人并发出呼叫，记录到事件时间线，设置下次升级时间
+func (s *OnCallService) notifyStep(ctx context.Context, page *ent.OnCallPage, policy *ent.EscalationPolicy, inc *ent.Incident, step, round int) (*ent.OnCallPage, error) {
+	now := s.now()
+	spec := policy.Steps[step]
+	var userIDs []int
+	var missing []string
+	for _, target := range spec.Targets {
+		switch target.Type {
+		case EscalationTargetUser:
+			userIDs = append(userIDs, target.ID)
+		case EscalationTargetSchedule:
+			shift, err := oncall.Current(ctx, s.client, page.TenantID, target.ID, now)
+			if err != nil {
+				missing = append(missing, fmt.Sprintf("值班表 %d: %v", target.ID, err))
+				continue
+			}
+			userIDs = append(userIDs, shift.UserID)
+		}
+	}
+	userIDs = uniquePositiveIDs(userIDs)
+	saved, err := page.Update().
+		SetNotifiedUserIds(userIDs).
+		SetNextEscalationAt(now.Add(time.Duration(spec.AckTimeoutMinutes) * time.Minute)).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("更新值班呼叫失败: %w", err)
+	}
+
+	title := fmt.Sprintf("【值班呼叫】%s %s", inc.IncidentNumber, inc.Title)
+	message := fmt.Sprintf("事件 %s（优先级 %s）需要处理，请在 %d 分钟内确认，否则将升级。", inc.IncidentNumber, inc.Priority, spec.AckTimeoutMinutes)
+	if s.notifications != nil && len(userIDs) > 0 {
+		if err := s.notifications.CreateSystemNotification(ctx, title, message, onCallNotification, userIDs, page.TenantID); err != nil {
+			s.logger.Warnw("Failed to notify on-call responders", "page_id", page.ID, "error", err)
+		}
+	}
+	description := fmt.Sprintf("按升级策略「%s」第 %d 步呼叫用户 %v", policy.Name, step+1, userIDs)
+	if round > 0 {
+		description += fmt.Sprintf("（第 %d 轮重复）", round)
+	}
+	if len(missing) > 0 {
+		description += "；未解析到值班人: " + strings.Join(missing, "; ")
+	}
+	s.recordPageEvent(ctx, page.TenantID, page.IncidentID, nil, "oncall_page", "值班呼叫", description,
+		map[string]interface{}{"pageId": page.ID, "step": step, "round": round, "userIds": userIDs})
+	return saved, nil
+}
+
+func (s *OnCallService) recordPageEvent(ctx context.Context, tenantID, incidentID int, userID *int, eventType, name, description string, data map[string]interface{}) {
+	if s.incidents == nil {
+		return
+	}
+	source := "system"
+	if userID != nil {
+		source = "user"
+	}
+	_, err := s.incidents.CreateIncidentEvent(ctx, &dto.CreateIncidentEventRequest{
+		IncidentID: incidentID, EventType: eventType, EventName: name, Description: description,
+		Status: "active", Severity: "info", Data: data, UserID: userID, Source: source,
+	}, tenantID)
+	if err != nil {
+		s.logger.Warnw("Failed to record on-call event", "incident_id", incidentID, "type", eventType, "error", err)
+	}
+}
+
+// ---- 公共 ----
+
+func (s *OnCallService) defaultRange(from, to time.Time) (time.Time, time.Time) {
+	if from.IsZero() {
+		from = s.now()
+	}
+	if to.IsZero() {
+		to = from.Add(defaultOnCallRange)
+	}
+	return from, to
+}
+
+func (s *OnCallService) ensureUsers(ctx context.Context, tenantID int, ids []int) error {
+	unique := uniquePositiveIDs(ids)
+	if len(unique) == 0 {
+		if len(ids) > 0 {
+			return fmt.Errorf("%w: 无效的用户ID", ErrOnCallInvalid)
+		}
+		return nil
+	}
+	n, err := s.client.User.Query().Where(user.IDIn(unique...), user.TenantID(tenantID), user.Active(true)).Count(ctx)
+	if err != nil {
+		return fmt.Errorf("查询用户失败: %w", err)
+	}
+	if n != len(unique) || hasNonPositive(ids) {
+		return fmt.Errorf("%w: 部分用户不存在、已停用或不属于当前租户", ErrOnCallInvalid)
+	}
+	return nil
+}
+
+func (s *OnCallService) toShifts(ctx context.Context, tenantID int, shifts []oncall.Shift) []dto.OnCallShift {
+	ids := make([]int, 0, len(shifts))
+	for _, shift := range shifts {
+		ids = append(ids, shift.UserID)
+	}
+	names := make(map[int]string)
+	if ids = uniquePositiveIDs(ids); len(ids) > 0 {
+		if users, err := s.client.User.Query().Where(user.IDIn(ids...), user.TenantID(tenantID)).All(ctx); err == nil {
+			for _, u := range users {
+				names[u.ID] = displayName(u)
+			}
+		}
+	}
+	out := make([]dto.OnCallShift, 0, len(shifts))
+	for _, shift := range shifts {
+		out = append(out, dto.OnCallShift{
+			UserID: shift.UserID, UserName: names[shift.UserID], Start: shift.Start, End: shift.End,
+			Layer: shift.Layer, OverrideID: shift.OverrideID,
+		})
+	}
+	return out
+}
+
+func (s *OnCallService) toPageResponse(page *ent.OnCallPage, policy *ent.EscalationPolicy) *dto.OnCallPageResponse {
+	resp := &dto.OnCallPageResponse{
+		ID: page.ID, PolicyID: page.PolicyID, IncidentID: page.IncidentID, Status: string(page.Status),
+		Step: page.Step, Round: page.Round, NotifiedUserIDs: page.NotifiedUserIds,
+		NextEscalationAt: page.NextEscalationAt, AcknowledgedBy: page.AcknowledgedBy, AcknowledgedAt: page.AcknowledgedAt,
+		CreatedBy: page.CreatedBy, CreatedAt: page.CreatedAt,
+	}
+	if resp.NotifiedUserIDs == nil {
+		resp.NotifiedUserIDs = []int{}
+	}
+	if policy != nil {
+		resp.PolicyName = policy.Name
+	}
+	return resp
+}
+
+func toOnCallScheduleResponse(rec *ent.OnCallSchedule) *dto.OnCallScheduleResponse {
+	return &dto.OnCallScheduleResponse{
+		ID: rec.ID, Name: rec.Name, Description: rec.Description, TeamID: rec.TeamID, TimeZone: rec.TimeZone,
+		Layers: rec.Layers, Enabled: rec.Enabled, CreatedBy: rec.CreatedBy, CreatedAt: rec.CreatedAt, UpdatedAt: rec.UpdatedAt,
+	}
+}
+
+func toOnCallOverrideResponse(rec *ent.OnCallOverride) *dto.OnCallOverrideResponse {
+	return &dto.OnCallOverrideResponse{
+		ID: rec.ID, ScheduleID: rec.ScheduleID, UserID: rec.UserID, OriginalUserID: rec.OriginalUserID,
+		StartAt: rec.StartAt, EndAt: rec.EndAt, Reason: rec.Reason, SwapGroup: rec.SwapGroup,
+		CreatedBy: rec.CreatedBy, CreatedAt: rec.CreatedAt,
+	}
+}
+
+func toEscalationPolicyResponse(rec *ent.EscalationPolicy) *dto.EscalationPolicyResponse {
+	return &dto.EscalationPolicyResponse{
+		ID: rec.ID, Name: rec.Name, Description: rec.Description, TeamID: rec.TeamID, Steps: rec.Steps,
+		RepeatCount: rec.RepeatCount, Enabled: rec.Enabled, CreatedBy: rec.CreatedBy, CreatedAt: rec.CreatedAt, UpdatedAt: rec.UpdatedAt,
+	}
+}
+
+func onCallIncidentClosed(status string) bool {
+	return status == common.IncidentStatusResolved || status == common.IncidentStatusClosed || status == common.IncidentStatusCancelled
+}
+
+func hasNonPositive(ids []int) bool {
+	for _, id := range ids {
+		if id <= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *OnCallService) withTx(ctx context.Context, fn func(tx *ent.Tx) error) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer tx.Rollback()
+	if err := fn(tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,243 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"itsm-backend/common"
+	"itsm-backend/dto"
+	"itsm-backend/ent/enttest"
+	"itsm-backend/ent/incidentevent"
+	"itsm-backend/ent/notification"
+	"itsm-backend/ent/oncallpage"
+	"itsm-backend/ent/schema"
+	"itsm-backend/service/approver"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestOnCallService(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", testDSN())
+	defer client.Close()
+	ctx := context.Background()
+	logger := zap.NewNop().Sugar()
+
+	tenant, err := createIncidentTestTenant(ctx, client, "oncall")
+	require.NoError(t, err)
+	alice, err := createIncidentTestUser(ctx, client, tenant.ID, "oncall-alice")
+	require.NoError(t, err)
+	bob, err := createIncidentTestUser(ctx, client, tenant.ID, "oncall-bob")
+	require.NoError(t, err)
+	lead, err := createIncidentTestUser(ctx, client, tenant.ID, "oncall-lead")
+	require.NoError(t, err)
+	sre, err := client.Team.Create().SetName("SRE").SetCode("sre").SetTenantID(tenant.ID).Save(ctx)
+	require.NoError(t, err)
+
+	incidents := NewIncidentService(client, logger)
+	svc := NewOnCallService(client, incidents, logger)
+	svc.SetNotificationService(NewNotificationService(client))
+	svc.SetCalendarFeedSecret("test-secret")
+	incidents.SetOnCallService(svc)
+	clock := time.Now().Truncate(time.Minute)
+	svc.now = func() time.Time { return clock }
+
+	// 12 小时一班，alice 当前值班，bob 接下一班
+	shiftStart := clock.Add(-time.Hour)
+	schedule, err := svc.CreateSchedule(ctx, tenant.ID, lead.ID, &dto.CreateOnCallScheduleRequest{
+		Name: "SRE 一线", TeamID: &sre.ID, TimeZone: "Asia/Shanghai",
+		Layers: []schema.OnCallLayer{{Name: "primary", Rotation: "custom", ShiftMinutes: 720, Start: shiftStart, UserIDs: []int{alice.ID, bob.ID}}},
+	})
+	require.NoError(t, err)
+
+	t.Run("schedule validation", func(t *testing.T) {
+		_, err := svc.CreateSchedule(ctx, tenant.ID, lead.ID, &dto.CreateOnCallScheduleRequest{
+			Name: "bad tz", TimeZone: "Mars/Olympus",
+			Layers: []schema.OnCallLayer{{Rotation: "daily", Start: shiftStart, UserIDs: []int{alice.ID}}},
+		})
+		require.ErrorIs(t, err, ErrOnCallInvalid)
+		_, err = svc.CreateSchedule(ctx, tenant.ID, lead.ID, &dto.CreateOnCallScheduleRequest{
+			Name: "unknown user", Layers: []schema.OnCallLayer{{Rotation: "daily", Start: shiftStart, UserIDs: []int{99999}}},
+		})
+		require.ErrorIs(t, err, ErrOnCallInvalid)
+	})
+
+	t.Run("who is on call", func(t *testing.T) {
+		now, err := svc.WhoIsOnCall(ctx, tenant.ID, sre.ID, 0, time.Time{})
+		require.NoError(t, err)
+		require.Len(t, now, 1)
+		require.NotNil(t, now[0].Shift)
+		require.Equal(t, alice.ID, now[0].Shift.UserID)
+		require.Equal(t, "Test User", now[0].Shift.UserName)
+
+		later, err := svc.WhoIsOnCall(ctx, tenant.ID, sre.ID, 0, clock.Add(12*time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, bob.ID, later[0].Shift.UserID)
+
+		shifts, err := svc.Shifts(ctx, tenant.ID, schedule.ID, shiftStart, shiftStart.Add(48*time.Hour))
+		require.NoError(t, err)
+		require.Len(t, shifts, 4)
+	})
+
+	t.Run("swap shifts", func(t *testing.T) {
+		_, err := svc.SwapShifts(ctx, tenant.ID, schedule.ID, lead.ID, &dto.SwapOnCallShiftsRequest{
+			UserID: bob.ID, StartAt: shiftStart, EndAt: shiftStart.Add(12 * time.Hour),
+			WithUserID: alice.ID, WithStartAt: shiftStart.Add(12 * time.Hour), WithEndAt: shiftStart.Add(24 * time.Hour),
+		})
+		require.ErrorIs(t, err, ErrOnCallInvalid, "bob 在该时段不值班")
+
+		pair, err := svc.SwapShifts(ctx, tenant.ID, schedule.ID, lead.ID, &dto.SwapOnCallShiftsRequest{
+			UserID: alice.ID, StartAt: shiftStart, EndAt: shiftStart.Add(12 * time.Hour),
+			WithUserID: bob.ID, WithStartAt: shiftStart.Add(12 * time.Hour), WithEndAt: shiftStart.Add(24 * time.Hour),
+			Reason: "家中有事",
+		})
+		require.NoError(t, err)
+		require.Len(t, pair, 2)
+		require.Equal(t, pair[0].SwapGroup, pair[1].SwapGroup)
+
+		userID, err := svc.CurrentOnCallUser(ctx, tenant.ID, schedule.ID, clock)
+		require.NoError(t, err)
+		require.Equal(t, bob.ID, userID)
+		userID, err = svc.CurrentOnCallUser(ctx, tenant.ID, schedule.ID, clock.Add(12*time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, alice.ID, userID)
+
+		require.NoError(t, svc.DeleteOverride(ctx, tenant.ID, schedule.ID, pair[0].ID))
+		overrides, err := svc.ListOverrides(ctx, tenant.ID, schedule.ID, shiftStart, shiftStart.Add(48*time.Hour))
+		require.NoError(t, err)
+		require.Empty(t, overrides, "换班成对删除")
+		userID, err = svc.CurrentOnCallUser(ctx, tenant.ID, schedule.ID, clock)
+		require.NoError(t, err)
+		require.Equal(t, alice.ID, userID)
+	})
+
+	t.Run("calendar feed", func(t *testing.T) {
+		feed, err := svc.CalendarFeed(tenant.ID, bob.ID)
+		require.NoError(t, err)
+		tenantID, userID, err := svc.VerifyCalendarFeedToken(feed.Token + ".ics")
+		require.NoError(t, err)
+		require.Equal(t, []int{tenant.ID, bob.ID}, []int{tenantID, userID})
+		_, _, err = svc.VerifyCalendarFeedToken(strings.Replace(feed.Token, ".", "9.", 1))
+		require.ErrorIs(t, err, ErrOnCallFeedToken)
+
+		body, err := svc.UserCalendar(ctx, tenant.ID, bob.ID)
+		require.NoError(t, err)
+		require.Contains(t, body, "BEGIN:VEVENT")
+		require.Contains(t, body, "SUMMARY:值班: SRE 一线")
+	})
+
+	t.Run("approver and assignment", func(t *testing.T) {
+		infos, err := approver.NewOnCallResolver().Resolve(ctx, client, &approver.ApproverContext{TenantID: tenant.ID, ScheduleID: schedule.ID})
+		require.NoError(t, err)
+		require.Len(t, infos, 1)
+		require.Equal(t, alice.ID, infos[0].UserID)
+
+		inc, err := incidents.CreateIncident(ctx, &dto.CreateIncidentRequest{
+			Title: "数据库连接池耗尽", Description: "订单服务报错", Priority: "high", Severity: "high", Category: "database", Source: "monitoring",
+		}, tenant.ID, lead.ID)
+		require.NoError(t, err)
+		assigned, err := incidents.AssignIncidentToOnCall(ctx, inc.ID, schedule.ID, tenant.ID)
+		require.NoError(t, err)
+		require.Equal(t, alice.ID, *assigned.AssigneeID)
+	})
+
+	policy, err := svc.CreatePolicy(ctx, tenant.ID, lead.ID, &dto.CreateEscalationPolicyRequest{
+		Name: "SRE 升级",
+		Steps: []schema.EscalationPolicyStep{
+			{AckTimeoutMinutes: 5, Targets: []schema.EscalationTarget{{Type: "schedule", ID: schedule.ID}}},
+			{AckTimeoutMinutes: 10, Targets: []schema.EscalationTarget{{Type: "user", ID: lead.ID}}},
+		},
+	})
+	require.NoError(t, err)
+
+	newIncident := func(title string) *dto.IncidentResponse {
+		inc, err := incidents.CreateIncident(ctx, &dto.CreateIncidentRequest{
+			Title: title, Description: title, Priority: "critical", Severity: "high", Category: "network", Source: "monitoring",
+		}, tenant.ID, lead.ID)
+		require.NoError(t, err)
+		return inc
+	}
+
+	t.Run("policy guards schedule", func(t *testing.T) {
+		require.ErrorIs(t, svc.DeleteSchedule(ctx, tenant.ID, schedule.ID), ErrOnCallScheduleInUse)
+		_, err := svc.CreatePolicy(ctx, tenant.ID, lead.ID, &dto.CreateEscalationPolicyRequest{
+			Name: "bad", Steps: []schema.EscalationPolicyStep{{AckTimeoutMinutes: 5, Targets: []schema.EscalationTarget{{Type: "schedule", ID: 99999}}}},
+		})
+		require.ErrorIs(t, err, ErrOnCallInvalid)
+	})
+
+	t.Run("escalates on ack timeout until exhausted", func(t *testing.T) {
+		start := clock
+		defer func() { clock = start }()
+		inc := newIncident("核心交换机宕机")
+
+		page, err := svc.TriggerPage(ctx, tenant.ID, inc.ID, policy.ID, lead.ID)
+		require.NoError(t, err)
+		require.Equal(t, "triggered", page.Status)
+		require.Equal(t, []int{alice.ID}, page.NotifiedUserIDs)
+		require.Equal(t, 1, client.Notification.Query().Where(notification.UserID(alice.ID), notification.Type("oncall_page")).CountX(ctx))
+
+		n, err := svc.DispatchDueEscalations(ctx)
+		require.NoError(t, err)
+		require.Zero(t, n, "确认超时前不升级")
+
+		clock = clock.Add(6 * time.Minute)
+		n, err = svc.DispatchDueEscalations(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+		stored := client.OnCallPage.GetX(ctx, page.ID)
+		require.Equal(t, 1, stored.Step)
+		require.Equal(t, []int{lead.ID}, stored.NotifiedUserIds)
+		require.Equal(t, 1, client.Notification.Query().Where(notification.UserID(lead.ID), notification.Type("oncall_page")).CountX(ctx))
+
+		clock = clock.Add(11 * time.Minute)
+		_, err = svc.DispatchDueEscalations(ctx)
+		require.NoError(t, err)
+		stored = client.OnCallPage.GetX(ctx, page.ID)
+		require.Equal(t, oncallpage.StatusExhausted, stored.Status)
+		require.Nil(t, stored.NextEscalationAt)
+		require.Equal(t, 1, client.IncidentEvent.Query().
+			Where(incidentevent.IncidentID(inc.ID), incidentevent.EventType("oncall_exhausted")).CountX(ctx))
+		require.Equal(t, 2, client.IncidentEvent.Query().
+			Where(incidentevent.IncidentID(inc.ID), incidentevent.EventType("oncall_page")).CountX(ctx))
+	})
+
+	t.Run("acknowledge stops escalation", func(t *testing.T) {
+		start := clock
+		defer func() { clock = start }()
+		inc := newIncident("存储延迟升高")
+		page, err := svc.TriggerPage(ctx, tenant.ID, inc.ID, policy.ID, lead.ID)
+		require.NoError(t, err)
+
+		acked, err := svc.AcknowledgePage(ctx, tenant.ID, page.ID, alice.ID)
+		require.NoError(t, err)
+		require.Equal(t, "acknowledged", acked.Status)
+		require.Equal(t, alice.ID, *acked.AcknowledgedBy)
+		require.Equal(t, common.IncidentStatusAcknowledged, client.Incident.GetX(ctx, inc.ID).Status)
+
+		_, err = svc.AcknowledgePage(ctx, tenant.ID, page.ID, bob.ID)
+		require.ErrorIs(t, err, ErrOnCallPageClosed)
+
+		clock = clock.Add(time.Hour)
+		n, err := svc.DispatchDueEscalations(ctx)
+		require.NoError(t, err)
+		require.Zero(t, n)
+	})
+
+	t.Run("incident lifecycle closes pages", func(t *testing.T) {
+		inc := newIncident("VPN 无法登录")
+		page, err := svc.TriggerPage(ctx, tenant.ID, inc.ID, policy.ID, lead.ID)
+		require.NoError(t, err)
+		require.NoError(t, incidents.AcknowledgeIncident(ctx, inc.ID, bob.ID, tenant.ID))
+		stored := client.OnCallPage.GetX(ctx, page.ID)
+		require.Equal(t, oncallpage.StatusAcknowledged, stored.Status)
+		require.Equal(t, bob.ID, *stored.AcknowledgedBy)
+
+		pages, err := svc.ListPages(ctx, tenant.ID, inc.ID)
+		require.NoError(t, err)
+		require.Len(t, pages, 1)
+		require.Equal(t, "SRE 升级", pages[0].PolicyName)
+	})
+}
@@ -300,3 +300,161 @@ func (s *ReportExportService) ExportPredictionToExcel(ctx context.Context, predi
 	filename := fmt.Sprintf("prediction_report_%s.xlsx", time.Now().Format("20060102_150405"))
 	return buf.Bytes(), filename, nil
 }
+
+var workLogGroupTitles = map[string]string{
+	"user":          "用户",
+	"team":          "团队",
+	"customer":      "客户租户",
+	"category":      "分类",
+	"activity_type": "活动类型",
+}
+
+func workLogReportPeriod(report *dto.WorkLogReport) string {
+	from, to := "不限", "不限"
+	if report.From != nil {
+		from = report.From.Format("2006-01-02")
+	}
+	if report.To != nil {
+		to = report.To.Format("2006-01-02")
+	}
+	return fmt.Sprintf("统计区间: %s 至 %s", from, to)
+}
+
+// ExportWorkLogReportToExcel 导出工时汇总为Excel
+func (s *ReportExportService) ExportWorkLogReportToExcel(ctx context.Context, report *dto.WorkLogReport) ([]byte, string, error) {
+	f := excelize.NewFile()
+	sheet := "Sheet1"
+
+	titleStyle, _ := f.NewStyle(&excelize.Style{
+		Font:      &excelize.Font{Bold: true, Size: 14},
+		Fill:      excelize.Fill{Type: "pattern", Pattern: 1, Color: []string{"#4472C4"}},
+		Alignment: &excelize.Alignment{Horizontal: "center", Vertical: "center"},
+	})
+
+	headerStyle, _ := f.NewStyle(&excelize.Style{
+		Font:      &excelize.Font{Bold: true, Size: 11},
+		Fill:      excelize.Fill{Type: "pattern", Pattern: 1, Color: []string{"#D9E2F3"}},
+		Alignment: &excelize.Alignment{Horizontal: "center"},
+	})
+
+	// 标题
+	f.SetCellValue(sheet, "A1", "工时汇总报告")
+	f.MergeCell(sheet, "A1", "E1")
+	f.SetCellStyle(sheet, "A1", "E1", titleStyle)
+	f.SetRowHeight(sheet, 1, 25)
+
+	f.SetCellValue(sheet, "A2", workLogReportPeriod(report))
+	f.MergeCell(sheet, "A2", "E2")
+	f.SetCellValue(sheet, "A3", fmt.Sprintf("生成时间: %s", report.GeneratedAt.Format("2006-01-02 15:04:05")))
+	f.MergeCell(sheet, "A3", "E3")
+
+	// 表头
+	groupTitle := workLogGroupTitles[report.GroupBy]
+	if groupTitle == "" {
+		groupTitle = "分组"
+	}
+	headers := []string{groupTitle, "记录数", "总工时(小时)", "可计费工时(小时)", "不可计费工时(小时)"}
+	for i, h := range headers {
+		cell := fmt.Sprintf("%c%d", 'A'+i, 5)
+		f.SetCellValue(sheet, cell, h)
+		f.SetCellStyle(sheet, cell, cell, headerStyle)
+	}
+
+	// 数据
+	row := 6
+	for _, r := range report.Rows {
+		f.SetCellValue(sheet, fmt.Sprintf("A%d", row), neutralizeSpreadsheetFormula(r.Label))
+		f.SetCellValue(sheet, fmt.Sprintf("B%d", row), r.Entries)
+		f.SetCellValue(sheet, fmt.Sprintf("C%d", row), r.TotalHours)
+		f.SetCellValue(sheet, fmt.Sprintf("D%d", row), r.BillableHours)
+		f.SetCellValue(sheet, fmt.Sprintf("E%d", row), minutesToHours(r.TotalMinutes-r.BillableMinutes))
+		row++
+	}
+
+	// 合计
+	f.SetCellValue(sheet, fmt.Sprintf("A%d", row), "合计")
+	f.SetCellValue(sheet, fmt.Sprintf("C%d", row), report.TotalHours)
+	f.SetCellValue(sheet, fmt.Sprintf("D%d", row), report.BillableHours)
+	f.SetCellValue(sheet, fmt.Sprintf("E%d", row), minutesToHours(report.TotalMinutes-report.BillableMinutes))
+	f.SetCellStyle(sheet, fmt.Sprintf("A%d", row), fmt.Sprintf("E%d", row), headerStyle)
+
+	f.SetColWidth(sheet, "A", "A", 25)
+	f.SetColWidth(sheet, "B", "B", 10)
+	f.SetColWidth(sheet, "C", "E", 18)
+
+	var buf bytes.Buffer
+	if err := f.Write(&buf); err != nil {
+		return nil, "", fmt.Errorf("生成Excel失败: %w", err)
+	}
+
+	filename := fmt.Sprintf("worklog_report_%s.xlsx", time.Now().Format("20060102_150405"))
+	return buf.Bytes(), filename, nil
+}
+
+// ExportWorkLogReportToPDF 导出工时汇总为PDF
+func (s *ReportExportService) ExportWorkLogReportToPDF(ctx context.Context, report *dto.WorkLogReport) ([]byte, string, error) {
+	var content bytes.Buffer
+
+	content.WriteString("%PDF-1.4\n")
+	content.WriteString("1 0 obj\n<< /Type /Catalog /Pages 2 0 R >>\nendobj\n")
+	content.WriteString("2 0 obj\n<< /Type /Pages /Kids [3 0 R] /Count 1 >>\nendobj\n")
+	content.WriteString("3 0 obj\n<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Contents 4 0 R >>\nendobj\n")
+	content.WriteString("4 0 obj\n<< /Length 5 0 R >>\nstream\n")
+
+	// 标题
+	content.WriteString("BT\n")
+	content.WriteString("/F1 24 Tf\n")
+	content.WriteString("100 750 Td\n")
+	content.WriteString("(工时汇总报告) Tj\n")
+	content.WriteString("ET\n")
+
+	content.WriteString("BT\n")
+	content.WriteString("/F1 12 Tf\n")
+	content.WriteString("100 720 Td\n")
+	content.WriteString("(" + workLogReportPeriod(report) + ") Tj\n")
+	content.WriteString("0 -18 Td\n")
+	content.WriteString("(生成时间: " + report.GeneratedAt.Format("2006-01-02 15:04:05") + ") Tj\n")
+	content.WriteString("ET\n")
+
+	// 数据
+	y := 670
+	content.WriteString("BT\n")
+	content.WriteString("/F1 10 Tf\n")
+	for _, r := range report.Rows {
+		line := fmt.Sprintf("%s - 记录: %d, 总工时: %.2f 小时, 可计费: %.2f 小时", escapePDFText(r.Label), r.Entries, r.TotalHours, r.BillableHours)
+		content.WriteString(fmt.Sprintf("100 %d Td\n", y))
+		content.WriteString(fmt.Sprintf("(%s) Tj\n", line))
+		y -= 20
+	}
+	content.WriteString("ET\n")
+
+	// 合计
+	y -= 20
+	content.WriteString("BT\n")
+	content.WriteString("/F1 12 Tf\n")
+	content.WriteString(fmt.Sprintf("100 %d Td\n", y))
+	content.WriteString(fmt.Sprintf("(合计: %.2f 小时, 可计费: %.2f 小时) Tj\n", report.TotalHours, report.BillableHours))
+	content.WriteString("ET\n")
+
+	content.WriteString("endstream\nendobj\n")
+	content.WriteString("5 0 obj\n")
+	content.WriteString(fmt.Sprintf("%d\n", content.Len()))
+	content.WriteString("endobj\n")
+
+	content.WriteString("xref\n")
+	content.WriteString("0 6\n")
+	content.WriteString("0000000000 65535 f \n")
+	content.WriteString("0000000009 00000 n \n")
+	content.WriteString("0000000058 00000 n \n")
+	content.WriteString("0000000115 00000 n \n")
+	content.WriteString("0000000206 00000 n \n")
+	content.WriteString("0000000245 00000 n \n")
+
+	content.WriteString("trailer\n<< /Size 6 /Root 1 0 R >>\n")
+	content.WriteString("startxref\n")
+	content.WriteString(fmt.Sprintf("%d\n", content.Len()))
+	content.WriteString("%%EOF")
+
+	filename := fmt.Sprintf("worklog_report_%s.pdf", time.Now().Format("20060102_150405"))
+	return content.Bytes(), filename, nil
+}
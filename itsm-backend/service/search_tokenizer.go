@@ -0,0 +1,207 @@
+package service
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+// 全文检索分词
+//
+// 中日韩文字没有空格分词，按连续的 CJK 片段切分：索引时同时写入单字与相邻二元组，
+// 查询时片段长度 ≥2 只用二元组、单字片段用单字，因此“网络故障”可以命中“公司网络故障频发”，
+// 不依赖词典也不会漏掉跨词边界的匹配。拉丁字母与数字按连续片段成词并转为小写，
+// 全角字母数字先折叠为半角。
+
+// searchSegment 查询文本中的一个片段，用于高亮定位
+type searchSegment struct {
+	Text string
+	CJK  bool
+}
+
+// isSearchCJK 判断是否按 CJK 策略切分
+func isSearchCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+// isSearchWordRune 拉丁字母、数字等可组成单词的字符
+func isSearchWordRune(r rune) bool {
+	return (unicode.IsLetter(r) || unicode.IsDigit(r)) && !isSearchCJK(r)
+}
+
+// foldSearchRune 全角 ASCII 折叠为半角并转为小写
+func foldSearchRune(r rune) rune {
+	if r >= 0xFF01 && r <= 0xFF5E {
+		r -= 0xFEE0
+	}
+	return unicode.ToLower(r)
+}
+
+// splitSearchSegments 将文本切分为 CJK 片段与单词片段，其余字符作为分隔符
+func splitSearchSegments(text string) []searchSegment {
+	var segments []searchSegment
+	var current []rune
+	currentCJK := false
+	flush := func() {
+		if len(current) > 0 {
+			segments = append(segments, searchSegment{Text: string(current), CJK: currentCJK})
+			current = current[:0]
+		}
+	}
+	for _, r := range text {
+		r = foldSearchRune(r)
+		switch {
+		case isSearchCJK(r):
+			if !currentCJK {
+				flush()
+			}
+			currentCJK = true
+			current = append(current, r)
+		case isSearchWordRune(r):
+			if currentCJK {
+				flush()
+			}
+			currentCJK = false
+			current = append(current, r)
+		default:
+			flush()
+		}
+	}
+	flush()
+	return segments
+}
+
+// maxSearchTokenRunes 单个拉丁词的最大长度，超长内容（如 base64）截断，避免撑大索引
+const maxSearchTokenRunes = 64
+
+// tokenizeSearchIndex 索引分词：CJK 片段输出单字与二元组，单词输出小写形式；保留重复以计算词频
+func tokenizeSearchIndex(text string) []string {
+	var tokens []string
+	for _, seg := range splitSearchSegments(text) {
+		runes := []rune(seg.Text)
+		if !seg.CJK {
+			if len(runes) > maxSearchTokenRunes {
+				runes = runes[:maxSearchTokenRunes]
+			}
+			tokens = append(tokens, string(runes))
+			continue
+		}
+		for i, r := range runes {
+			tokens = append(tokens, string(r))
+			if i+1 < len(runes) {
+				tokens = append(tokens, string(runes[i:i+2]))
+			}
+		}
+	}
+	return tokens
+}
+
+// tokenizeSearchQuery 查询分词：CJK 片段只用二元组（单字片段用单字），结果去重
+func tokenizeSearchQuery(text string) []string {
+	var tokens []string
+	seen := make(map[string]bool)
+	add := func(token string) {
+		if !seen[token] {
+			seen[token] = true
+			tokens = append(tokens, token)
+		}
+	}
+	for _, seg := range splitSearchSegments(text) {
+		runes := []rune(seg.Text)
+		if !seg.CJK {
+			if len(runes) > maxSearchTokenRunes {
+				runes = runes[:maxSearchTokenRunes]
+			}
+			add(string(runes))
+			continue
+		}
+		if len(runes) == 1 {
+			add(seg.Text)
+			continue
+		}
+		for i := 0; i+1 < len(runes); i++ {
+			add(string(runes[i : i+2]))
+		}
+	}
+	return tokens
+}
+
+// highlightSearchText 截取命中位置附近的片段并用 <em> 标记命中词，其余内容做 HTML 转义。
+// 未命中时返回开头的片段并报告 false；window 为命中位置前后保留的字符数，0 表示不截取。
+func highlightSearchText(text string, segments []searchSegment, window int) (string, bool) {
+	runes := []rune(text)
+	folded := make([]rune, len(runes))
+	for i, r := range runes {
+		folded[i] = foldSearchRune(r)
+	}
+	marks := make([]bool, len(runes))
+	first := -1
+	for _, seg := range segments {
+		needle := []rune(seg.Text)
+		for i := 0; i+len(needle) <= len(folded); i++ {
+			if !runesEqual(folded[i:i+len(needle)], needle) {
+				continue
+			}
+			// 拉丁词只匹配完整单词或词首，避免 "ci" 高亮 "decision" 中间
+			if !seg.CJK && i > 0 && isSearchWordRune(folded[i-1]) {
+				continue
+			}
+			for j := i; j < i+len(needle); j++ {
+				marks[j] = true
+			}
+			if first < 0 || i < first {
+				first = i
+			}
+		}
+	}
+
+	matched := first >= 0
+	start, end := 0, len(runes)
+	if window > 0 {
+		if first > window {
+			start = first - window
+		}
+		if first < 0 {
+			first = 0
+		}
+		if limit := first + window; limit < end {
+			end = limit
+		}
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	inMark := false
+	for i := start; i < end; i++ {
+		if marks[i] != inMark {
+			if marks[i] {
+				b.WriteString("<em>")
+			} else {
+				b.WriteString("</em>")
+			}
+			inMark = marks[i]
+		}
+		b.WriteString(html.EscapeString(string(runes[i])))
+	}
+	if inMark {
+		b.WriteString("</em>")
+	}
+	if end < len(runes) {
+		b.WriteString("…")
+	}
+	return b.String(), matched
+}
+
+func runesEqual(a, b []rune) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
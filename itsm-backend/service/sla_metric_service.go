@@ -0,0 +1,633 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"itsm-backend/ent"
+	"itsm-backend/ent/schema"
+	"itsm-backend/ent/slapolicy"
+	"itsm-backend/ent/team"
+	"itsm-backend/ent/ticket"
+	"itsm-backend/ent/ticketslametric"
+	"itsm-backend/ent/user"
+
+	"go.uber.org/zap"
+)
+
+// SLA 计时指标
+//
+// SLA 策略（客户 SLA、团队 OLA、供应商 UC）通过 metrics 声明多个计时指标，每个指标的开始、停止、暂停条件
+// 都是基于 ticket 与 event 的表达式，例如：
+//
+//	首次响应  stop:  event.type == "commented" && event.public
+//	定期更新  start: !ticket.resolved  stop: event.type == "commented" && event.public  recurring
+//	解决      stop:  ticket.resolved   pause: ticket.status in ["pending_customer", "pending_vendor"]
+//
+// 工单事件（建单、状态变更、指派、评论）触发 RecordEvent 推进各指标的计时周期；
+// SLA 监控巡检时以 tick 事件兜底，并把超过截止时间的运行中周期判定为违约。
+
+// SLA 协议类型
+const (
+	SLAAgreementSLA = "sla"
+	SLAAgreementOLA = "ola"
+	SLAAgreementUC  = "uc"
+)
+
+// SLA 计时指标周期状态
+const (
+	SLAMetricStatusRunning   = "running"
+	SLAMetricStatusPaused    = "paused"
+	SLAMetricStatusCompleted = "completed"
+	SLAMetricStatusCancelled = "cancelled"
+)
+
+// SLA 计时事件类型
+const (
+	SLAEventCreated       = "created"
+	SLAEventStatusChanged = "status_changed"
+	SLAEventAssigned      = "assigned"
+	SLAEventCommented     = "commented"
+	SLAEventUpdated       = "updated"
+	SLAEventTick          = "tick"
+)
+
+// SLAMetricEvent 驱动指标计时的工单事件
+type SLAMetricEvent struct {
+	Type       string
+	FromStatus string
+	ToStatus   string
+	ActorID    int
+	Public     bool // 评论是否对申请人可见
+}
+
+// SLAMetricService SLA/OLA/UC 计时指标服务
+type SLAMetricService struct {
+	client   *ent.Client
+	logger   *zap.SugaredLogger
+	policies *SLAPolicyService
+	engine   *ExpressionEngine
+	nowFunc  func() time.Time
+}
+
+// NewSLAMetricService 创建计时指标服务
+func NewSLAMetricService(client *ent.Client, logger *zap.SugaredLogger) *SLAMetricService {
+	return &SLAMetricService{
+		client:   client,
+		logger:   logger,
+		policies: NewSLAPolicyService(client, logger),
+		engine:   NewExpressionEngine(),
+		nowFunc:  time.Now,
+	}
+}
+
+// withNowFunc 替换时间源（测试用）
+func (s *SLAMetricService) withNowFunc(now func() time.Time) *SLAMetricService {
+	s.nowFunc = now
+	return s
+}
+
+// policyMetrics 返回策略的计时指标；未配置时按响应/解决时间生成首次响应与解决两个指标
+func policyMetrics(p *ent.SLAPolicy) []schema.SLAMetricDefinition {
+	if len(p.Metrics) > 0 {
+		return p.Metrics
+	}
+	var metrics []schema.SLAMetricDefinition
+	if p.ResponseTimeMinutes > 0 {
+		metrics = append(metrics, schema.SLAMetricDefinition{
+			Key:           "first_response",
+			Name:          "首次响应",
+			TargetMinutes: p.ResponseTimeMinutes,
+			StopCondition: "ticket.first_responded || ticket.resolved",
+		})
+	}
+	if p.ResolutionTimeMinutes > 0 {
+		metrics = append(metrics, schema.SLAMetricDefinition{
+			Key:           "resolution",
+			Name:          "解决",
+			TargetMinutes: p.ResolutionTimeMinutes,
+			StopCondition: "ticket.resolved",
+		})
+	}
+	return metrics
+}
+
+// slaMetricEnv 构建指标条件表达式的变量：ticket 为工单字段，event 为触发事件
+func slaMetricEnv(t *ent.Ticket, event SLAMetricEvent) map[string]interface{} {
+	vendorID := 0
+	if t.VendorID != nil {
+		vendorID = *t.VendorID
+	}
+	return map[string]interface{}{
+		"ticket": map[string]interface{}{
+			"id":              t.ID,
+			"status":          t.Status,
+			"priority":        t.Priority,
+			"type":            t.Type,
+			"assignee_id":     t.AssigneeID,
+			"requester_id":    t.RequesterID,
+			"vendor_id":       vendorID,
+			"on_hold":         t.SLAOnHold,
+			"first_responded": !t.FirstResponseAt.IsZero(),
+			"resolved":        t.Status == "resolved" || t.Status == "closed",
+			"closed":          t.Status == "closed",
+		},
+		"event": map[string]interface{}{
+			"type":        event.Type,
+			"from_status": event.FromStatus,
+			"to_status":   event.ToStatus,
+			"actor_id":    event.ActorID,
+			"public":      event.Public,
+		},
+	}
+}
+
+// validateSLAMetrics 校验指标定义：标识唯一、目标时长为正、条件表达式可执行
+func validateSLAMetrics(metrics []schema.SLAMetricDefinition) error {
+	engine := NewExpressionEngine()
+	sample := slaMetricEnv(&ent.Ticket{}, SLAMetricEvent{Type: SLAEventCreated})
+	keys := make(map[string]bool, len(metrics))
+	for _, m := range metrics {
+		key := strings.TrimSpace(m.Key)
+		if key == "" {
+			return fmt.Errorf("SLA指标标识不能为空")
+		}
+		if keys[key] {
+			return fmt.Errorf("SLA指标标识重复: %s", key)
+		}
+		keys[key] = true
+		if m.TargetMinutes <= 0 {
+			return fmt.Errorf("SLA指标 %s 的目标时长必须大于0分钟", key)
+		}
+		if strings.TrimSpace(m.StopCondition) == "" {
+			return fmt.Errorf("SLA指标 %s 必须配置停止条件", key)
+		}
+		for _, cond := range []string{m.StartCondition, m.StopCondition, m.PauseCondition} {
+			if _, err := engine.EvaluateCondition(cond, sample); err != nil {
+				return fmt.Errorf("SLA指标 %s 条件无效: %w", key, err)
+			}
+		}
+	}
+	return nil
+}
+
+// RecordEvent 按工单事件推进各适用协议的指标计时
+func (s *SLAMetricService) RecordEvent(ctx context.Context, ticketID, tenantID int, event SLAMetricEvent) error {
+	t, err := s.client.Ticket.Query().
+		Where(ticket.IDEQ(ticketID), ticket.TenantIDEQ(tenantID)).
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("query ticket: %w", err)
+	}
+	return s.recordEvent(ctx, t, event)
+}
+
+func (s *SLAMetricService) recordEvent(ctx context.Context, t *ent.Ticket, event SLAMetricEvent) error {
+	now := s.nowFunc()
+	policies, err := s.applicablePolicies(ctx, t)
+	if err != nil {
+		return err
+	}
+	applicable := make(map[int]bool, len(policies))
+	for _, p := range policies {
+		applicable[p.ID] = true
+	}
+
+	// 不再适用的协议（如改派到其他团队、更换供应商）取消其进行中的周期
+	if _, err := s.client.TicketSLAMetric.Update().
+		Where(
+			ticketslametric.TicketIDEQ(t.ID),
+			ticketslametric.StatusIn(SLAMetricStatusRunning, SLAMetricStatusPaused),
+			ticketslametric.PolicyIDNotIn(keysOf(applicable)...),
+		).
+		SetStatus(SLAMetricStatusCancelled).
+		SetStoppedAt(now).
+		ClearPausedAt().
+		Save(ctx); err != nil {
+		return fmt.Errorf("cancel inapplicable SLA metrics: %w", err)
+	}
+
+	env := slaMetricEnv(t, event)
+	for _, p := range policies {
+		calc := s.policyCalculator(ctx, p)
+		for _, def := range policyMetrics(p) {
+			if err := s.advanceMetric(ctx, t, p, def, env, calc, now); err != nil {
+				return fmt.Errorf("advance SLA metric %s/%s: %w", p.AgreementType, def.Key, err)
+			}
+		}
+	}
+	return nil
+}
+
+// keysOf 返回集合中的ID
+func keysOf(set map[int]bool) []int {
+	ids := make([]int, 0, len(set))
+	for id := range set {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// applicablePolicies 返回工单适用的协议：最优客户 SLA、处理人所在团队的 OLA、承接供应商的 UC
+func (s *SLAMetricService) applicablePolicies(ctx context.Context, t *ent.Ticket) ([]*ent.SLAPolicy, error) {
+	var result []*ent.SLAPolicy
+	if p, err := s.policies.MatchSLAPolicy(ctx, t.TenantID, t.Type, t.Priority, ""); err == nil {
+		result = append(result, p)
+	}
+
+	if t.AssigneeID != 0 {
+		teamIDs, err := s.client.Team.Query().
+			Where(team.TenantIDEQ(t.TenantID), team.HasUsersWith(user.IDEQ(t.AssigneeID))).
+			IDs(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("query assignee teams: %w", err)
+		}
+		if len(teamIDs) > 0 {
+			olas, err := s.client.SLAPolicy.Query().
+				Where(
+					slapolicy.TenantIDEQ(t.TenantID),
+					slapolicy.IsActiveEQ(true),
+					slapolicy.AgreementTypeEQ(SLAAgreementOLA),
+					slapolicy.TeamIDIn(teamIDs...),
+				).
+				All(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("query OLA policies: %w", err)
+			}
+			result = append(result, filterPoliciesForTicket(olas, t)...)
+		}
+	}
+	if t.VendorID != nil {
+		ucs, err := s.client.SLAPolicy.Query().
+			Where(
+				slapolicy.TenantIDEQ(t.TenantID),
+				slapolicy.IsActiveEQ(true),
+				slapolicy.AgreementTypeEQ(SLAAgreementUC),
+				slapolicy.VendorIDEQ(*t.VendorID),
+			).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("query UC policies: %w", err)
+		}
+		result = append(result, filterPoliciesForTicket(ucs, t)...)
+	}
+	return result, nil
+}
+
+// filterPoliciesForTicket OLA/UC 配置了工单类型或优先级时只适用于匹配的工单
+func filterPoliciesForTicket(policies []*ent.SLAPolicy, t *ent.Ticket) []*ent.SLAPolicy {
+	var matched []*ent.SLAPolicy
+	for _, p := range policies {
+		if p.TicketType != "" && p.TicketType != t.Type {
+			continue
+		}
+		if p.Priority != "" && p.Priority != t.Priority {
+			continue
+		}
+		matched = append(matched, p)
+	}
+	return matched
+}
+
+// policyCalculator 策略未配置日历时，OLA 沿用团队的工作日历，否则 7x24 计时
+func (s *SLAMetricService) policyCalculator(ctx context.Context, p *ent.SLAPolicy) *BusinessCalendarCalculator {
+	if calc := s.policies.policyCalendar(ctx, p); calc != nil {
+		return calc
+	}
+	if p.AgreementType == SLAAgreementOLA && p.TeamID != nil {
+		tm, err := s.client.Team.Get(ctx, *p.TeamID)
+		if err == nil && tm.CalendarID != nil {
+			if calc, err := NewBusinessCalendarService(s.client).Calculator(ctx, p.TenantID, *tm.CalendarID); err == nil {
+				return calc
+			}
+		}
+	}
+	return newWeeklyCalendarCalculator(time.Local, nil, 0, 0, nil)
+}
+
+// evaluate 计算指标条件，表达式出错时记录日志并视为不成立
+func (s *SLAMetricService) evaluate(condition string, env map[string]interface{}) bool {
+	ok, err := s.engine.EvaluateCondition(condition, env)
+	if err != nil {
+		s.logger.Warnw("Failed to evaluate SLA metric condition", "condition", condition, "error", err)
+		return false
+	}
+	return ok
+}
+
+// advanceMetric 推进单个指标的当前周期
+func (s *SLAMetricService) advanceMetric(ctx context.Context, t *ent.Ticket, p *ent.SLAPolicy, def schema.SLAMetricDefinition, env map[string]interface{}, calc *BusinessCalendarCalculator, now time.Time) error {
+	current, err := s.client.TicketSLAMetric.Query().
+		Where(
+			ticketslametric.TicketIDEQ(t.ID),
+			ticketslametric.PolicyIDEQ(p.ID),
+			ticketslametric.MetricKeyEQ(def.Key),
+		).
+		Order(ent.Desc(ticketslametric.FieldCycle)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+	started := s.evaluate(def.StartCondition, env)
+
+	if current == nil {
+		if started && !s.evaluate(def.StopCondition, env) {
+			return s.startCycle(ctx, t, p, def, 1, calc, now)
+		}
+		return nil
+	}
+
+	switch current.Status {
+	case SLAMetricStatusRunning, SLAMetricStatusPaused:
+		if s.evaluate(def.StopCondition, env) {
+			if err := s.stopCycle(ctx, current, SLAMetricStatusCompleted, calc, now); err != nil {
+				return err
+			}
+			if def.Recurring && started {
+				return s.startCycle(ctx, t, p, def, current.Cycle+1, calc, now)
+			}
+			return nil
+		}
+		if def.Recurring && !started {
+			// 周期指标的开始条件即承诺生效条件，不再成立时（如工单已解决）取消当前周期
+			return s.stopCycle(ctx, current, SLAMetricStatusCancelled, calc, now)
+		}
+		if def.PauseCondition == "" {
+			return nil
+		}
+		paused := s.evaluate(def.PauseCondition, env)
+		if paused && current.Status == SLAMetricStatusRunning {
+			return s.client.TicketSLAMetric.UpdateOne(current).
+				SetStatus(SLAMetricStatusPaused).
+				SetPausedAt(now).
+				Exec(ctx)
+		}
+		if !paused && current.Status == SLAMetricStatusPaused {
+			return s.resumeCycle(current, calc, now).Exec(ctx)
+		}
+	case SLAMetricStatusCompleted:
+		if def.Recurring && started {
+			return s.startCycle(ctx, t, p, def, current.Cycle+1, calc, now)
+		}
+	}
+	return nil
+}
+
+// startCycle 开启指标的一个计时周期
+func (s *SLAMetricService) startCycle(ctx context.Context, t *ent.Ticket, p *ent.SLAPolicy, def schema.SLAMetricDefinition, cycle int, calc *BusinessCalendarCalculator, now time.Time) error {
+	name := def.Name
+	if name == "" {
+		name = def.Key
+	}
+	err := s.client.TicketSLAMetric.Create().
+		SetTicketID(t.ID).
+		SetPolicyID(p.ID).
+		SetSLADefinitionID(t.SLADefinitionID).
+		SetAgreementType(p.AgreementType).
+		SetMetricKey(def.Key).
+		SetMetricName(name).
+		SetCycle(cycle).
+		SetStatus(SLAMetricStatusRunning).
+		SetTargetMinutes(def.TargetMinutes).
+		SetStartedAt(now).
+		SetDeadline(calc.AddBusinessMinutes(now, def.TargetMinutes)).
+		SetTenantID(t.TenantID).
+		Exec(ctx)
+	if ent.IsConstraintError(err) {
+		// 并发事件已开启同一周期
+		return nil
+	}
+	return err
+}
+
+// resumeCycle 恢复暂停中的周期：累计暂停的工作分钟并顺延截止时间
+func (s *SLAMetricService) resumeCycle(m *ent.TicketSLAMetric, calc *BusinessCalendarCalculator, now time.Time) *ent.TicketSLAMetricUpdateOne {
+	pausedAt := *m.PausedAt
+	return s.client.TicketSLAMetric.UpdateOne(m).
+		SetStatus(SLAMetricStatusRunning).
+		ClearPausedAt().
+		SetPausedMinutes(m.PausedMinutes + calc.BusinessMinutesBetween(pausedAt, now)).
+		SetDeadline(shiftSLADeadline(calc, m.Deadline, pausedAt, now))
+}
+
+// stopCycle 结束周期；完成时按截止时间判定是否违约
+func (s *SLAMetricService) stopCycle(ctx context.Context, m *ent.TicketSLAMetric, status string, calc *BusinessCalendarCalculator, now time.Time) error {
+	var update *ent.TicketSLAMetricUpdateOne
+	deadline := m.Deadline
+	if m.Status == SLAMetricStatusPaused && m.PausedAt != nil {
+		update = s.resumeCycle(m, calc, now)
+		deadline = shiftSLADeadline(calc, m.Deadline, *m.PausedAt, now)
+	} else {
+		update = s.client.TicketSLAMetric.UpdateOne(m)
+	}
+	update.SetStatus(status).SetStoppedAt(now)
+	if status == SLAMetricStatusCompleted && !m.Breached && now.After(deadline) {
+		update.SetBreached(true).SetBreachedAt(deadline)
+	}
+	return update.Exec(ctx)
+}
+
+// SweepBreaches 以 tick 事件兜底推进工单的指标，并把超过截止时间的运行中周期标记为违约，返回新违约的周期
+func (s *SLAMetricService) SweepBreaches(ctx context.Context, tenantID int) ([]*ent.TicketSLAMetric, error) {
+	now := s.nowFunc()
+	ticketIDs, err := s.client.TicketSLAMetric.Query().
+		Where(
+			ticketslametric.TenantIDEQ(tenantID),
+			ticketslametric.StatusIn(SLAMetricStatusRunning, SLAMetricStatusPaused),
+		).
+		GroupBy(ticketslametric.FieldTicketID).
+		Ints(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query running SLA metrics: %w", err)
+	}
+	for _, id := range ticketIDs {
+		if err := s.RecordEvent(ctx, id, tenantID, SLAMetricEvent{Type: SLAEventTick}); err != nil {
+			s.logger.Warnw("Failed to advance SLA metrics", "ticket_id", id, "error", err)
+		}
+	}
+
+	overdue, err := s.client.TicketSLAMetric.Query().
+		Where(
+			ticketslametric.TenantIDEQ(tenantID),
+			ticketslametric.StatusEQ(SLAMetricStatusRunning),
+			ticketslametric.BreachedEQ(false),
+			ticketslametric.DeadlineLT(now),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query overdue SLA metrics: %w", err)
+	}
+	var breached []*ent.TicketSLAMetric
+	for _, m := range overdue {
+		// 条件更新，避免多实例巡检重复判定
+		n, err := s.client.TicketSLAMetric.Update().
+			Where(ticketslametric.IDEQ(m.ID), ticketslametric.BreachedEQ(false)).
+			SetBreached(true).
+			SetBreachedAt(m.Deadline).
+			Save(ctx)
+		if err != nil {
+			return breached, fmt.Errorf("mark SLA metric breached: %w", err)
+		}
+		if n > 0 {
+			m.Breached = true
+			m.BreachedAt = &m.Deadline
+			breached = append(breached, m)
+		}
+	}
+	return breached, nil
+}
+
+// ListTicketMetrics 列出工单各协议指标的计时周期
+func (s *SLAMetricService) ListTicketMetrics(ctx context.Context, ticketID, tenantID int) ([]*ent.TicketSLAMetric, error) {
+	return s.client.TicketSLAMetric.Query().
+		Where(ticketslametric.TicketIDEQ(ticketID), ticketslametric.TenantIDEQ(tenantID)).
+		Order(
+			ent.Asc(ticketslametric.FieldPolicyID),
+			ent.Asc(ticketslametric.FieldMetricKey),
+			ent.Asc(ticketslametric.FieldCycle),
+		).
+		All(ctx)
+}
+
+// TicketSLAMetricView 工单指标计时周期
+type TicketSLAMetricView struct {
+	PolicyID      int        `json:"policyId"`
+	AgreementType string     `json:"agreementType"`
+	MetricKey     string     `json:"metricKey"`
+	MetricName    string     `json:"metricName"`
+	Cycle         int        `json:"cycle"`
+	Status        string     `json:"status"`
+	TargetMinutes int        `json:"targetMinutes"`
+	StartedAt     time.Time  `json:"startedAt"`
+	Deadline      time.Time  `json:"deadline"`
+	PausedAt      *time.Time `json:"pausedAt,omitempty"`
+	PausedMinutes int        `json:"pausedMinutes"`
+	StoppedAt     *time.Time `json:"stoppedAt,omitempty"`
+	Breached      bool       `json:"breached"`
+	BreachedAt    *time.Time `json:"breachedAt,omitempty"`
+}
+
+func toTicketSLAMetricViews(rows []*ent.TicketSLAMetric) []*TicketSLAMetricView {
+	views := make([]*TicketSLAMetricView, 0, len(rows))
+	for _, m := range rows {
+		views = append(views, &TicketSLAMetricView{
+			PolicyID:      m.PolicyID,
+			AgreementType: m.AgreementType,
+			MetricKey:     m.MetricKey,
+			MetricName:    m.MetricName,
+			Cycle:         m.Cycle,
+			Status:        m.Status,
+			TargetMinutes: m.TargetMinutes,
+			StartedAt:     m.StartedAt,
+			Deadline:      m.Deadline,
+			PausedAt:      m.PausedAt,
+			PausedMinutes: m.PausedMinutes,
+			StoppedAt:     m.StoppedAt,
+			Breached:      m.Breached,
+			BreachedAt:    m.BreachedAt,
+		})
+	}
+	return views
+}
+
+// SLAMetricComplianceStat 单个协议指标的达标统计
+type SLAMetricComplianceStat struct {
+	AgreementType  string  `json:"agreementType"`
+	PolicyID       int     `json:"policyId"`
+	MetricKey      string  `json:"metricKey"`
+	MetricName     string  `json:"metricName"`
+	Total          int     `json:"total"`    // 计时周期数（不含未违约即取消的周期）
+	Met            int     `json:"met"`      // 按时完成
+	Breached       int     `json:"breached"` // 违约
+	Running        int     `json:"running"`  // 进行中且未违约
+	ComplianceRate float64 `json:"complianceRate"`
+}
+
+// metricComplianceBySLADefinition 按 SLA 定义汇总各协议指标的达标情况
+func (s *SLAMetricService) metricComplianceBySLADefinition(ctx context.Context, tenantID, slaDefinitionID int) ([]*SLAMetricComplianceStat, error) {
+	rows, err := s.client.TicketSLAMetric.Query().
+		Where(
+			ticketslametric.TenantIDEQ(tenantID),
+			ticketslametric.SLADefinitionIDEQ(slaDefinitionID),
+			// 取消的周期不计入，取消前已违约的仍计为违约
+			ticketslametric.Or(
+				ticketslametric.StatusNEQ(SLAMetricStatusCancelled),
+				ticketslametric.BreachedEQ(true),
+			),
+		).
+		Order(
+			ent.Asc(ticketslametric.FieldAgreementType),
+			ent.Asc(ticketslametric.FieldPolicyID),
+			ent.Asc(ticketslametric.FieldMetricKey),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var stats []*SLAMetricComplianceStat
+	index := make(map[string]*SLAMetricComplianceStat)
+	for _, m := range rows {
+		key := fmt.Sprintf("%d/%s", m.PolicyID, m.MetricKey)
+		stat := index[key]
+		if stat == nil {
+			stat = &SLAMetricComplianceStat{
+				AgreementType: m.AgreementType,
+				PolicyID:      m.PolicyID,
+				MetricKey:     m.MetricKey,
+				MetricName:    m.MetricName,
+			}
+			index[key] = stat
+			stats = append(stats, stat)
+		}
+		stat.Total++
+		switch {
+		case m.Breached:
+			stat.Breached++
+		case m.Status == SLAMetricStatusCompleted:
+			stat.Met++
+		default:
+			stat.Running++
+		}
+	}
+	for _, stat := range stats {
+		stat.ComplianceRate = float64(stat.Total-stat.Breached) / float64(stat.Total) * 100
+	}
+	return stats, nil
+}
+
+// metricViolationType 指标违约对应的 SLAViolation 违规类型，如 ola:first_response
+func metricViolationType(agreementType, metricKey string) string {
+	return agreementType + ":" + metricKey
+}
+
+// parseMetricViolationType 从违规类型解析协议类型与指标标识；response_time 等传统类型归为客户 SLA
+func parseMetricViolationType(violationType string) (agreementType, metricKey string) {
+	if agreement, key, ok := strings.Cut(violationType, ":"); ok {
+		return agreement, key
+	}
+	return SLAAgreementSLA, ""
+}
+
+// ticketUpdateSLAEvents 工单编辑对应的计时事件：改派、状态变更，其余字段变更记为 updated
+func ticketUpdateSLAEvents(fromStatus, toStatus string, assigneeChanged bool) []SLAMetricEvent {
+	var events []SLAMetricEvent
+	if assigneeChanged {
+		events = append(events, SLAMetricEvent{Type: SLAEventAssigned})
+	}
+	if toStatus != "" && toStatus != fromStatus {
+		events = append(events, SLAMetricEvent{Type: SLAEventStatusChanged, FromStatus: fromStatus, ToStatus: toStatus})
+	}
+	if len(events) == 0 {
+		events = append(events, SLAMetricEvent{Type: SLAEventUpdated})
+	}
+	return events
+}
+
+// recordTicketSLAEvent 工单事件后推进 SLA 指标计时。失败只记录日志，由 SLA 监控巡检兜底。
+func recordTicketSLAEvent(ctx context.Context, metrics *SLAMetricService, ticketID, tenantID int, event SLAMetricEvent) {
+	if err := metrics.RecordEvent(ctx, ticketID, tenantID, event); err != nil {
+		metrics.logger.Warnw("Failed to record SLA metric event", "ticket_id", ticketID, "event", event.Type, "error", err)
+	}
+}
@@ -0,0 +1,255 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"itsm-backend/dto"
+	"itsm-backend/ent"
+	"itsm-backend/ent/enttest"
+	"itsm-backend/ent/schema"
+	"itsm-backend/ent/ticketslametric"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+type slaMetricFixture struct {
+	tenant    *ent.Tenant
+	requester *ent.User
+	agent     *ent.User
+	sla       *ent.SLADefinition
+	ticket    *ent.Ticket
+}
+
+func newSLAMetricFixture(t *testing.T, client *ent.Client, createdAt time.Time) *slaMetricFixture {
+	t.Helper()
+	ctx := context.Background()
+	f := &slaMetricFixture{}
+	var err error
+	f.tenant, err = client.Tenant.Create().
+		SetName("Metric Tenant").SetCode("metric").SetDomain("metric.example.com").SetStatus("active").
+		Save(ctx)
+	require.NoError(t, err)
+	f.requester, err = client.User.Create().
+		SetUsername("metric-user").SetEmail("metric-user@example.com").SetName("Metric User").
+		SetPasswordHash("hash").SetRole("end_user").SetActive(true).SetTenantID(f.tenant.ID).
+		Save(ctx)
+	require.NoError(t, err)
+	f.agent, err = client.User.Create().
+		SetUsername("metric-agent").SetEmail("metric-agent@example.com").SetName("Metric Agent").
+		SetPasswordHash("hash").SetRole("agent").SetActive(true).SetTenantID(f.tenant.ID).
+		Save(ctx)
+	require.NoError(t, err)
+	f.sla, err = client.SLADefinition.Create().
+		SetName("MSP Gold").SetServiceType("incident").SetPriority("high").
+		SetResponseTime(60).SetResolutionTime(480).
+		SetTenantID(f.tenant.ID).
+		Save(ctx)
+	require.NoError(t, err)
+	f.ticket, err = client.Ticket.Create().
+		SetTitle("邮件服务中断").SetDescription("客户邮件无法收发").SetPriority("high").SetType("incident").
+		SetStatus("open").SetTicketNumber("SLA-METRIC").SetRequesterID(f.requester.ID).SetTenantID(f.tenant.ID).
+		SetSLADefinitionID(f.sla.ID).
+		SetCreatedAt(createdAt).
+		Save(ctx)
+	require.NoError(t, err)
+	return f
+}
+
+func slaMetricCycle(t *testing.T, client *ent.Client, ticketID int, key string, cycle int) *ent.TicketSLAMetric {
+	t.Helper()
+	return client.TicketSLAMetric.Query().
+		Where(
+			ticketslametric.TicketIDEQ(ticketID),
+			ticketslametric.MetricKeyEQ(key),
+			ticketslametric.CycleEQ(cycle),
+		).
+		OnlyX(context.Background())
+}
+
+func TestSLAMetricService_RecurringUpdateCadence(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", testDSN())
+	defer client.Close()
+	ctx := context.Background()
+
+	created := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	f := newSLAMetricFixture(t, client, created)
+	_, err := client.SLAPolicy.Create().
+		SetName("MSP 金牌").SetPriority("high").
+		SetResponseTimeMinutes(60).SetResolutionTimeMinutes(480).
+		SetMetrics([]schema.SLAMetricDefinition{
+			{Key: "first_response", Name: "首次响应", TargetMinutes: 60, StopCondition: `event.type == "commented" && event.public`},
+			{Key: "next_update", Name: "每4小时更新", TargetMinutes: 240, Recurring: true,
+				StartCondition: "!ticket.resolved", StopCondition: `event.type == "commented" && event.public`},
+			{Key: "resolution", Name: "解决", TargetMinutes: 480, StopCondition: "ticket.resolved",
+				PauseCondition: `ticket.status == "pending_customer"`},
+		}).
+		SetTenantID(f.tenant.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	now := created
+	logger := zaptest.NewLogger(t).Sugar()
+	svc := NewSLAMetricService(client, logger).withNowFunc(func() time.Time { return now })
+	tk := f.ticket
+
+	require.NoError(t, svc.RecordEvent(ctx, tk.ID, tk.TenantID, SLAMetricEvent{Type: SLAEventCreated}))
+	assert.Equal(t, 3, client.TicketSLAMetric.Query().CountX(ctx))
+	assert.True(t, slaMetricCycle(t, client, tk.ID, "next_update", 1).Deadline.Equal(created.Add(4*time.Hour)))
+
+	// 30 分钟后公开回复：首次响应达标，更新周期进入第二轮
+	now = created.Add(30 * time.Minute)
+	require.NoError(t, svc.RecordEvent(ctx, tk.ID, tk.TenantID, SLAMetricEvent{Type: SLAEventCommented, Public: true}))
+	first := slaMetricCycle(t, client, tk.ID, "first_response", 1)
+	assert.Equal(t, SLAMetricStatusCompleted, first.Status)
+	assert.False(t, first.Breached)
+	second := slaMetricCycle(t, client, tk.ID, "next_update", 2)
+	assert.Equal(t, SLAMetricStatusRunning, second.Status)
+	assert.True(t, second.Deadline.Equal(now.Add(4*time.Hour)))
+
+	// 超过 4 小时未更新：巡检判定第二轮违约；内部备注不算更新
+	now = created.Add(330 * time.Minute)
+	breached, err := svc.SweepBreaches(ctx, tk.TenantID)
+	require.NoError(t, err)
+	require.Len(t, breached, 1)
+	assert.Equal(t, "next_update", breached[0].MetricKey)
+	require.NoError(t, svc.RecordEvent(ctx, tk.ID, tk.TenantID, SLAMetricEvent{Type: SLAEventCommented, Public: false}))
+	assert.Equal(t, SLAMetricStatusRunning, slaMetricCycle(t, client, tk.ID, "next_update", 2).Status)
+
+	// 等待客户期间解决计时暂停，恢复后截止时间顺延
+	now = created.Add(340 * time.Minute)
+	require.NoError(t, client.Ticket.UpdateOneID(tk.ID).SetStatus("pending_customer").Exec(ctx))
+	require.NoError(t, svc.RecordEvent(ctx, tk.ID, tk.TenantID, SLAMetricEvent{Type: SLAEventStatusChanged, FromStatus: "open", ToStatus: "pending_customer"}))
+	assert.Equal(t, SLAMetricStatusPaused, slaMetricCycle(t, client, tk.ID, "resolution", 1).Status)
+
+	now = created.Add(400 * time.Minute)
+	require.NoError(t, client.Ticket.UpdateOneID(tk.ID).SetStatus("in_progress").Exec(ctx))
+	require.NoError(t, svc.RecordEvent(ctx, tk.ID, tk.TenantID, SLAMetricEvent{Type: SLAEventStatusChanged, FromStatus: "pending_customer", ToStatus: "in_progress"}))
+	resolution := slaMetricCycle(t, client, tk.ID, "resolution", 1)
+	assert.Equal(t, SLAMetricStatusRunning, resolution.Status)
+	assert.Equal(t, 60, resolution.PausedMinutes)
+	assert.True(t, resolution.Deadline.Equal(created.Add(540*time.Minute)), resolution.Deadline)
+
+	// 解决：解决指标按顺延后的截止时间达标，更新承诺失效取消当前周期
+	now = created.Add(500 * time.Minute)
+	require.NoError(t, client.Ticket.UpdateOneID(tk.ID).SetStatus("resolved").SetResolvedAt(now).Exec(ctx))
+	require.NoError(t, svc.RecordEvent(ctx, tk.ID, tk.TenantID, SLAMetricEvent{Type: SLAEventStatusChanged, FromStatus: "in_progress", ToStatus: "resolved"}))
+	resolution = slaMetricCycle(t, client, tk.ID, "resolution", 1)
+	assert.Equal(t, SLAMetricStatusCompleted, resolution.Status)
+	assert.False(t, resolution.Breached)
+	assert.Equal(t, SLAMetricStatusCancelled, slaMetricCycle(t, client, tk.ID, "next_update", 2).Status)
+
+	stats, err := NewSLAMonitorService(client, logger).GetSLAComplianceByDefinition(ctx, tk.TenantID)
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	byKey := make(map[string]*SLAMetricComplianceStat)
+	for _, m := range stats[0].Metrics {
+		byKey[m.MetricKey] = m
+	}
+	require.Len(t, byKey, 3)
+	assert.Equal(t, 1, byKey["first_response"].Met)
+	assert.Equal(t, 2, byKey["next_update"].Total)
+	assert.Equal(t, 1, byKey["next_update"].Breached)
+	assert.InDelta(t, 50.0, byKey["next_update"].ComplianceRate, 0.01)
+	assert.Equal(t, 1, byKey["resolution"].Met)
+}
+
+func TestSLAMetricService_TracksTeamOLAAndVendorUC(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", testDSN())
+	defer client.Close()
+	ctx := context.Background()
+
+	created := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	f := newSLAMetricFixture(t, client, created)
+	team, err := client.Team.Create().
+		SetName("网络组").SetCode("network").SetTenantID(f.tenant.ID).AddUserIDs(f.agent.ID).
+		Save(ctx)
+	require.NoError(t, err)
+	vendor, err := client.Vendor.Create().
+		SetName("运营商").SetCode("carrier").SetVendorType("IT服务").SetContactName("张三").
+		SetContactEmail("ops@carrier.example.com").SetContactPhone("10000").SetAddress("-").SetWebsite("-").
+		SetTenantID(f.tenant.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	policies := NewSLAPolicyService(client, zaptest.NewLogger(t).Sugar())
+	_, err = policies.CreateSLAPolicy(ctx, dto.CreateSLAPolicyRequest{
+		Name: "网络组 OLA", AgreementType: SLAAgreementOLA, ResponseTimeMinutes: 15, ResolutionTimeMinutes: 120,
+		IsActive: true, TenantID: f.tenant.ID,
+	})
+	assert.Error(t, err, "OLA 必须绑定团队")
+	ola, err := policies.CreateSLAPolicy(ctx, dto.CreateSLAPolicyRequest{
+		Name: "网络组 OLA", AgreementType: SLAAgreementOLA, TeamID: &team.ID,
+		ResponseTimeMinutes: 15, ResolutionTimeMinutes: 120, IsActive: true, TenantID: f.tenant.ID,
+	})
+	require.NoError(t, err)
+	uc, err := policies.CreateSLAPolicy(ctx, dto.CreateSLAPolicyRequest{
+		Name: "运营商 UC", AgreementType: SLAAgreementUC, VendorID: &vendor.ID,
+		ResponseTimeMinutes: 30, ResolutionTimeMinutes: 240, IsActive: true, TenantID: f.tenant.ID,
+		Metrics: []schema.SLAMetricDefinition{
+			{Key: "restore", Name: "恢复", TargetMinutes: 240, StopCondition: `ticket.resolved || ticket.status == "pending_customer"`},
+		},
+	})
+	require.NoError(t, err)
+
+	now := created
+	svc := NewSLAMetricService(client, zaptest.NewLogger(t).Sugar()).withNowFunc(func() time.Time { return now })
+	tk := f.ticket
+
+	// 未指派、无供应商且未配置客户 SLA 策略：不产生计时
+	require.NoError(t, svc.RecordEvent(ctx, tk.ID, tk.TenantID, SLAMetricEvent{Type: SLAEventCreated}))
+	assert.Equal(t, 0, client.TicketSLAMetric.Query().CountX(ctx))
+
+	// 指派给网络组成员：开始 OLA 计时（未配置指标时按响应/解决时间生成）
+	now = created.Add(5 * time.Minute)
+	require.NoError(t, client.Ticket.UpdateOneID(tk.ID).SetAssigneeID(f.agent.ID).Exec(ctx))
+	require.NoError(t, svc.RecordEvent(ctx, tk.ID, tk.TenantID, SLAMetricEvent{Type: SLAEventAssigned}))
+	olaRows := client.TicketSLAMetric.Query().Where(ticketslametric.PolicyIDEQ(ola.ID)).AllX(ctx)
+	require.Len(t, olaRows, 2)
+	for _, m := range olaRows {
+		assert.Equal(t, SLAAgreementOLA, m.AgreementType)
+		assert.Equal(t, f.sla.ID, m.SLADefinitionID)
+	}
+
+	// 转交供应商：开始 UC 计时
+	require.NoError(t, client.Ticket.UpdateOneID(tk.ID).SetVendorID(vendor.ID).Exec(ctx))
+	require.NoError(t, svc.RecordEvent(ctx, tk.ID, tk.TenantID, SLAMetricEvent{Type: SLAEventUpdated}))
+	restore := slaMetricCycle(t, client, tk.ID, "restore", 1)
+	assert.Equal(t, uc.ID, restore.PolicyID)
+	assert.True(t, restore.Deadline.Equal(now.Add(4*time.Hour)))
+
+	// 改派到团队外、解除供应商：OLA 与 UC 的进行中周期取消
+	other, err := client.User.Create().
+		SetUsername("other-agent").SetEmail("other@example.com").SetName("Other").
+		SetPasswordHash("hash").SetRole("agent").SetActive(true).SetTenantID(f.tenant.ID).
+		Save(ctx)
+	require.NoError(t, err)
+	now = created.Add(10 * time.Minute)
+	require.NoError(t, client.Ticket.UpdateOneID(tk.ID).SetAssigneeID(other.ID).ClearVendorID().Exec(ctx))
+	require.NoError(t, svc.RecordEvent(ctx, tk.ID, tk.TenantID, SLAMetricEvent{Type: SLAEventAssigned}))
+	assert.Equal(t, 0, client.TicketSLAMetric.Query().
+		Where(ticketslametric.StatusIn(SLAMetricStatusRunning, SLAMetricStatusPaused)).
+		CountX(ctx))
+}
+
+func TestValidateSLAMetrics(t *testing.T) {
+	valid := schema.SLAMetricDefinition{Key: "next_update", TargetMinutes: 240, Recurring: true,
+		StartCondition: "!ticket.resolved", StopCondition: `event.type == "commented" && event.public`}
+	assert.NoError(t, validateSLAMetrics([]schema.SLAMetricDefinition{valid}))
+	assert.Error(t, validateSLAMetrics([]schema.SLAMetricDefinition{valid, valid}), "重复标识")
+
+	missingStop := valid
+	missingStop.StopCondition = ""
+	assert.Error(t, validateSLAMetrics([]schema.SLAMetricDefinition{missingStop}))
+
+	badExpr := valid
+	badExpr.PauseCondition = "ticket.status =="
+	assert.Error(t, validateSLAMetrics([]schema.SLAMetricDefinition{badExpr}))
+
+	zeroTarget := valid
+	zeroTarget.TargetMinutes = 0
+	assert.Error(t, validateSLAMetrics([]schema.SLAMetricDefinition{zeroTarget}))
+}
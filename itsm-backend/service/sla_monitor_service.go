@@ -20,6 +20,8 @@ type SLAMonitorService struct {
 	logger          *zap.SugaredLogger
 	alertService    *SLAAlertService
 	notificationSvc *TicketNotificationService
+	clock           *TicketSLAService // SLA 计时暂停/恢复
+	metrics         *SLAMetricService // SLA/OLA/UC 计时指标
 }
 
 type SLAMetrics struct {
@@ -34,8 +36,10 @@ type SLAMetrics struct {
 
 func NewSLAMonitorService(client *ent.Client, logger *zap.SugaredLogger) *SLAMonitorService {
 	return &SLAMonitorService{
-		client: client,
-		logger: logger,
+		client:  client,
+		logger:  logger,
+		clock:   NewTicketSLAService(client, logger),
+		metrics: NewSLAMetricService(client, logger),
 	}
 }
 
@@ -55,6 +59,8 @@ type SLACheckStats struct {
 	ExistingViolations int `json:"existingViolations"` // 已存在的违规数
 	WarningsTriggered  int `json:"warningsTriggered"`  // 触发的预警数
 	AlertsTriggered    int `json:"alertsTriggered"`    // 触发的告警数
+	PausedTickets      int `json:"pausedTickets"`      // SLA 计时暂停中的工单数
+	MetricBreaches     int `json:"metricBreaches"`     // 新违约的 SLA/OLA/UC 指标周期数
 }
 
 // CheckSLAViolations 检查所有工单的SLA违规情况
@@ -122,6 +128,17 @@ func (s *SLAMonitorService) CheckSLAViolations(ctx context.Context, tenantID int
 		stats.TotalChecked += len(tickets)
 
 		for _, t := range tickets {
+			// 同步 SLA 计时暂停状态（兜底未经过服务层的状态变更），暂停中的工单不做预警与违规判定
+			if synced, err := s.clock.syncSLAClock(ctx, t, now); err != nil {
+				s.logger.Errorw("Failed to sync SLA clock", "ticket_id", t.ID, "error", err)
+			} else {
+				t = synced
+			}
+			if t.SLAPausedAt != nil {
+				stats.PausedTickets++
+				continue
+			}
+
 			// 检查是否需要发送预警（在SLA截止前）
 			if s.alertService != nil {
 				if warned := s.checkAndTriggerWarning(ctx, t, now); warned {
@@ -192,12 +209,39 @@ func (s *SLAMonitorService) CheckSLAViolations(ctx context.Context, tenantID int
 		}
 	}
 
+	// 策略指标（SLA/OLA/UC）违约判定；工单绑定了 SLA 定义时同时记录违规
+	breached, err := s.metrics.SweepBreaches(ctx, tenantID)
+	if err != nil {
+		s.logger.Errorw("Failed to sweep SLA metric breaches", "tenant_id", tenantID, "error", err)
+	}
+	stats.MetricBreaches = len(breached)
+	for _, m := range breached {
+		if m.SLADefinitionID == 0 {
+			continue
+		}
+		t, err := s.client.Ticket.Get(ctx, m.TicketID)
+		if err != nil {
+			s.logger.Errorw("Failed to load ticket for SLA metric breach", "ticket_id", m.TicketID, "error", err)
+			continue
+		}
+		created, cErr := s.createViolation(ctx, t, metricViolationType(m.AgreementType, m.MetricKey), m.Deadline, slaDefMap)
+		if cErr != nil {
+			s.logger.Errorw("Failed to create SLA metric violation", "ticket_id", t.ID, "metric", m.MetricKey, "error", cErr)
+		} else if created {
+			stats.NewViolations++
+		} else {
+			stats.ExistingViolations++
+		}
+	}
+
 	s.logger.Infow("SLA violation check completed", "tenant_id", tenantID,
 		"total_checked", stats.TotalChecked,
 		"new_violations", stats.NewViolations,
 		"existing_violations", stats.ExistingViolations,
 		"warnings", stats.WarningsTriggered,
-		"alerts", stats.AlertsTriggered)
+		"alerts", stats.AlertsTriggered,
+		"paused", stats.PausedTickets,
+		"metric_breaches", stats.MetricBreaches)
 	return stats, nil
 }
 
@@ -297,6 +341,7 @@ func (s *SLAMonitorService) createViolation(ctx context.Context, t *ent.Ticket,
 		return false, nil
 	}
 
+	agreementType, metricKey := parseMetricViolationType(violationType)
 	if _, err := tx.SLAViolation.Create().
 		SetCreatedBy(0).
 		SetTicketID(t.ID).
@@ -304,6 +349,8 @@ func (s *SLAMonitorService) createViolation(ctx context.Context, t *ent.Ticket,
 		SetSLADefinitionID(t.SLADefinitionID).
 		SetSLAName(slaName).
 		SetViolationType(violationType).
+		SetAgreementType(agreementType).
+		SetMetricKey(metricKey).
 		SetViolationTime(now).
 		SetDescription(description).
 		SetSeverity(severity).
@@ -489,12 +536,18 @@ func (s *SLAMonitorService) GetSLAComplianceByDefinition(ctx context.Context, te
 			).
 			Count(ctx)
 
+		metrics, err := s.metrics.metricComplianceBySLADefinition(ctx, tenantID, sla.ID)
+		if err != nil {
+			s.logger.Warnw("Failed to aggregate SLA metric compliance", "sla_definition_id", sla.ID, "error", err)
+		}
+
 		stats = append(stats, &SLAComplianceStat{
 			SLADefinitionID:   sla.ID,
 			SLADefinitionName: sla.Name,
 			TotalTickets:      total,
 			ViolatedTickets:   violated,
 			ComplianceRate:    float64(total-violated) / float64(total) * 100,
+			Metrics:           metrics,
 		})
 	}
 
@@ -507,6 +560,8 @@ type SLAComplianceStat struct {
 	TotalTickets      int     `json:"totalTickets"`
 	ViolatedTickets   int     `json:"violatedTickets"`
 	ComplianceRate    float64 `json:"complianceRate"`
+	// 各协议（SLA/OLA/UC）指标的达标情况
+	Metrics []*SLAMetricComplianceStat `json:"metrics,omitempty"`
 }
 
 // StartSLAWatcher 启动SLA定时检查任务
@@ -9,22 +9,26 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"go.uber.org/zap"
 
 	"itsm-backend/dto"
 	"itsm-backend/ent"
 	"itsm-backend/ent/slapolicy"
 	"itsm-backend/ent/slaviolation"
+	"itsm-backend/ent/team"
 	"itsm-backend/ent/ticket"
+	"itsm-backend/ent/vendor"
 )
 
 // SLAPolicyService SLA策略服务
 type SLAPolicyService struct {
 	client *ent.Client
+	logger *zap.SugaredLogger
 }
 
 // NewSLAPolicyService 创建SLA策略服务
-func NewSLAPolicyService(client *ent.Client) *SLAPolicyService {
-	return &SLAPolicyService{client: client}
+func NewSLAPolicyService(client *ent.Client, logger *zap.SugaredLogger) *SLAPolicyService {
+	return &SLAPolicyService{client: client, logger: logger}
 }
 
 // CreateSLAPolicy 创建SLA策略
@@ -32,6 +36,19 @@ func (s *SLAPolicyService) CreateSLAPolicy(ctx context.Context, input dto.Create
 	if err := validateCreateSLAPolicy(input); err != nil {
 		return nil, err
 	}
+	if err := s.validatePolicyCalendar(ctx, input.TenantID, input.CalendarID); err != nil {
+		return nil, err
+	}
+	agreementType := input.AgreementType
+	if agreementType == "" {
+		agreementType = SLAAgreementSLA
+	}
+	if err := s.validatePolicyAgreement(ctx, input.TenantID, agreementType, input.TeamID, input.VendorID); err != nil {
+		return nil, err
+	}
+	if err := validateSLAMetrics(input.Metrics); err != nil {
+		return nil, err
+	}
 	build := s.client.SLAPolicy.Create().
 		SetName(input.Name).
 		SetResponseTimeMinutes(input.ResponseTimeMinutes).
@@ -41,6 +58,7 @@ func (s *SLAPolicyService) CreateSLAPolicy(ctx context.Context, input dto.Create
 		SetEscalationRules(input.EscalationRules).
 		SetIsActive(input.IsActive).
 		SetPriorityScore(input.PriorityScore).
+		SetAgreementType(agreementType).
 		SetTenantID(input.TenantID)
 
 	if input.Description != "" {
@@ -58,6 +76,18 @@ func (s *SLAPolicyService) CreateSLAPolicy(ctx context.Context, input dto.Create
 	if input.BusinessHours != nil {
 		build.SetBusinessHours(input.BusinessHours)
 	}
+	if input.CalendarID != nil && *input.CalendarID > 0 {
+		build.SetCalendarID(*input.CalendarID)
+	}
+	if agreementType == SLAAgreementOLA {
+		build.SetTeamID(*input.TeamID)
+	}
+	if agreementType == SLAAgreementUC {
+		build.SetVendorID(*input.VendorID)
+	}
+	if len(input.Metrics) > 0 {
+		build.SetMetrics(input.Metrics)
+	}
 
 	return build.Save(ctx)
 }
@@ -97,6 +127,33 @@ func (s *SLAPolicyService) UpdateSLAPolicyForTenant(ctx context.Context, id, ten
 	if err := validateUpdateSLAPolicy(input); err != nil {
 		return nil, err
 	}
+	if err := s.validatePolicyCalendar(ctx, tenantID, input.CalendarID); err != nil {
+		return nil, err
+	}
+	if input.Metrics != nil {
+		if err := validateSLAMetrics(*input.Metrics); err != nil {
+			return nil, err
+		}
+	}
+	if input.AgreementType != nil || input.TeamID != nil || input.VendorID != nil {
+		current, err := s.GetSLAPolicyByIDForTenant(ctx, id, tenantID)
+		if err != nil {
+			return nil, err
+		}
+		agreementType, teamID, vendorID := current.AgreementType, current.TeamID, current.VendorID
+		if input.AgreementType != nil {
+			agreementType = *input.AgreementType
+		}
+		if input.TeamID != nil {
+			teamID = input.TeamID
+		}
+		if input.VendorID != nil {
+			vendorID = input.VendorID
+		}
+		if err := s.validatePolicyAgreement(ctx, tenantID, agreementType, teamID, vendorID); err != nil {
+			return nil, err
+		}
+	}
 	update := s.client.SLAPolicy.UpdateOneID(id).Where(slapolicy.TenantID(tenantID))
 	s.applyUpdateFields(update, input)
 	return update.Save(ctx)
@@ -134,6 +191,51 @@ func validateUpdateSLAPolicy(input dto.UpdateSLAPolicyRequest) error {
 	return nil
 }
 
+// validatePolicyCalendar 校验策略引用的工作日历属于当前租户
+func (s *SLAPolicyService) validatePolicyCalendar(ctx context.Context, tenantID int, calendarID *int) error {
+	if calendarID == nil || *calendarID <= 0 {
+		return nil
+	}
+	if _, err := NewBusinessCalendarService(s.client).GetCalendar(ctx, tenantID, *calendarID); err != nil {
+		return fmt.Errorf("工作日历不存在: %w", err)
+	}
+	return nil
+}
+
+// validatePolicyAgreement 校验协议类型及其绑定对象：OLA 必须绑定本租户团队，UC 必须绑定本租户供应商
+func (s *SLAPolicyService) validatePolicyAgreement(ctx context.Context, tenantID int, agreementType string, teamID, vendorID *int) error {
+	switch agreementType {
+	case SLAAgreementSLA:
+		return nil
+	case SLAAgreementOLA:
+		if teamID == nil || *teamID <= 0 {
+			return fmt.Errorf("OLA 必须指定处理团队")
+		}
+		exists, err := s.client.Team.Query().Where(team.ID(*teamID), team.TenantID(tenantID)).Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("处理团队不存在")
+		}
+		return nil
+	case SLAAgreementUC:
+		if vendorID == nil || *vendorID <= 0 {
+			return fmt.Errorf("支撑合同必须指定供应商")
+		}
+		exists, err := s.client.Vendor.Query().Where(vendor.ID(*vendorID), vendor.TenantID(tenantID)).Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("供应商不存在")
+		}
+		return nil
+	default:
+		return fmt.Errorf("不支持的协议类型: %s", agreementType)
+	}
+}
+
 func (s *SLAPolicyService) applyUpdateFields(update *ent.SLAPolicyUpdateOne, input dto.UpdateSLAPolicyRequest) {
 	if input.Name != nil {
 		update.SetName(*input.Name)
@@ -150,6 +252,30 @@ func (s *SLAPolicyService) applyUpdateFields(update *ent.SLAPolicyUpdateOne, inp
 	if input.Priority != nil {
 		update.SetPriority(*input.Priority)
 	}
+	if input.AgreementType != nil {
+		update.SetAgreementType(*input.AgreementType)
+	}
+	if input.TeamID != nil {
+		if *input.TeamID > 0 {
+			update.SetTeamID(*input.TeamID)
+		} else {
+			update.ClearTeamID()
+		}
+	}
+	if input.VendorID != nil {
+		if *input.VendorID > 0 {
+			update.SetVendorID(*input.VendorID)
+		} else {
+			update.ClearVendorID()
+		}
+	}
+	if input.Metrics != nil {
+		if len(*input.Metrics) > 0 {
+			update.SetMetrics(*input.Metrics)
+		} else {
+			update.ClearMetrics()
+		}
+	}
 	if input.ResponseTimeMinutes != nil {
 		update.SetResponseTimeMinutes(*input.ResponseTimeMinutes)
 	}
@@ -159,6 +285,13 @@ func (s *SLAPolicyService) applyUpdateFields(update *ent.SLAPolicyUpdateOne, inp
 	if input.BusinessHours != nil {
 		update.SetBusinessHours(*input.BusinessHours)
 	}
+	if input.CalendarID != nil {
+		if *input.CalendarID > 0 {
+			update.SetCalendarID(*input.CalendarID)
+		} else {
+			update.ClearCalendarID()
+		}
+	}
 	if input.ExcludeWeekends != nil {
 		update.SetExcludeWeekends(*input.ExcludeWeekends)
 	}
@@ -198,9 +331,10 @@ func (s *SLAPolicyService) MatchSLAPolicy(ctx context.Context, tenantID int, tic
 		return nil, fmt.Errorf("no active SLA policy found")
 	}
 
+	// 只在客户 SLA 中择优，OLA/UC 按团队与供应商另行叠加
 	var activePolicies []*ent.SLAPolicy
 	for _, p := range policies {
-		if p.IsActive {
+		if p.IsActive && p.AgreementType == SLAAgreementSLA {
 			activePolicies = append(activePolicies, p)
 		}
 	}
@@ -281,42 +415,52 @@ func (s *SLAPolicyService) getDefaultPolicy(policies []*ent.SLAPolicy) (*ent.SLA
 }
 
 // CalculateSLAExpireTime 计算SLA到期时间
-// 考虑业务时间配置
+// 策略指定了工作日历时按日历计时，否则按 business_hours 与排除周末/节假日配置计时
 func (s *SLAPolicyService) CalculateSLAExpireTime(ctx context.Context, policy *ent.SLAPolicy, startTime time.Time) time.Time {
-	_ = ctx
 	if policy == nil || policy.ResolutionTimeMinutes <= 0 {
 		return startTime
 	}
+	calendar := s.policyCalendar(ctx, policy)
+	if calendar == nil {
+		return startTime.Add(time.Duration(policy.ResolutionTimeMinutes) * time.Minute)
+	}
+	return calendar.AddBusinessMinutes(startTime, policy.ResolutionTimeMinutes)
+}
+
+// policyCalendar 解析策略适用的工作日历，返回 nil 表示 7x24 计时
+func (s *SLAPolicyService) policyCalendar(ctx context.Context, policy *ent.SLAPolicy) *BusinessCalendarCalculator {
+	calendars := NewBusinessCalendarService(s.client)
+	if policy.CalendarID != nil {
+		calendar, err := calendars.Calculator(ctx, policy.TenantID, *policy.CalendarID)
+		if err == nil {
+			return calendar
+		}
+		s.logger.Warnw("加载SLA策略工作日历失败，回退到营业时间配置",
+			"policy_id", policy.ID, "calendar_id", *policy.CalendarID, "tenant_id", policy.TenantID, "error", err)
+	}
 
 	windowStart, windowEnd, hasWindow := extractBusinessHoursWindow(policy.BusinessHours)
 	if !hasWindow && !policy.ExcludeWeekends && !policy.ExcludeHolidays {
-		return startTime.Add(time.Duration(policy.ResolutionTimeMinutes) * time.Minute)
+		return nil
 	}
-
 	if !hasWindow {
 		windowStart = 0
 		windowEnd = 24 * 60
 	}
-
-	current := alignToBusinessWindow(startTime, windowStart, windowEnd, policy.ExcludeWeekends)
-	remaining := policy.ResolutionTimeMinutes
-
-	for remaining > 0 {
-		current = alignToBusinessWindow(current, windowStart, windowEnd, policy.ExcludeWeekends)
-		dayEnd := minutesFromMidnight(current)
-		availableToday := windowEnd - dayEnd
-		if availableToday <= 0 {
-			current = nextBusinessDayStart(current, windowStart, policy.ExcludeWeekends)
-			continue
+	workDays := weekdayNumbers(1, 7)
+	if policy.ExcludeWeekends {
+		workDays = weekdayNumbers(1, 5)
+	}
+	calendar := newWeeklyCalendarCalculator(nil, workDays, windowStart, windowEnd, nil)
+	if policy.ExcludeHolidays {
+		// 节假日取自租户默认日历
+		if defaults, err := calendars.DefaultCalculator(ctx, policy.TenantID); err != nil {
+			s.logger.Warnw("加载租户默认工作日历失败", "policy_id", policy.ID, "tenant_id", policy.TenantID, "error", err)
+		} else if defaults != nil {
+			calendar.withHolidays(defaults.holidays, defaults.workdays)
 		}
-		if remaining <= availableToday {
-			return current.Add(time.Duration(remaining) * time.Minute)
-		}
-		remaining -= availableToday
-		current = nextBusinessDayStart(current, windowStart, policy.ExcludeWeekends)
 	}
-
-	return current
+	return calendar
 }
 
 func extractBusinessHoursWindow(businessHours map[string]interface{}) (int, int, bool) {
@@ -350,45 +494,6 @@ func parseClockMinutes(value interface{}) (int, bool) {
 	return hour*60 + minute, true
 }
 
-func alignToBusinessWindow(current time.Time, windowStart, windowEnd int, excludeWeekends bool) time.Time {
-	current = moveToWorkingDay(current, excludeWeekends)
-
-	minutes := minutesFromMidnight(current)
-	if minutes < windowStart {
-		return setMinutesFromMidnight(current, windowStart)
-	}
-	if minutes >= windowEnd {
-		return nextBusinessDayStart(current, windowStart, excludeWeekends)
-	}
-	return current
-}
-
-func nextBusinessDayStart(current time.Time, windowStart int, excludeWeekends bool) time.Time {
-	next := setMinutesFromMidnight(current, windowStart).AddDate(0, 0, 1)
-	next = moveToWorkingDay(next, excludeWeekends)
-	return setMinutesFromMidnight(next, windowStart)
-}
-
-func moveToWorkingDay(current time.Time, excludeWeekends bool) time.Time {
-	if !excludeWeekends {
-		return current
-	}
-	for current.Weekday() == time.Saturday || current.Weekday() == time.Sunday {
-		current = current.AddDate(0, 0, 1)
-	}
-	return current
-}
-
-func minutesFromMidnight(value time.Time) int {
-	return value.Hour()*60 + value.Minute()
-}
-
-func setMinutesFromMidnight(base time.Time, minutes int) time.Time {
-	hour := minutes / 60
-	minute := minutes % 60
-	return time.Date(base.Year(), base.Month(), base.Day(), hour, minute, 0, 0, base.Location())
-}
-
 // GetSLAComplianceRate 获取SLA合规率
 func (s *SLAPolicyService) GetSLAComplianceRate(ctx context.Context, tenantID int, startDate, endDate time.Time) (float64, error) {
 	var violatedTickets []struct {
@@ -15,12 +15,13 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
 )
 
 func setupSLAPolicyTest(t *testing.T) (*ent.Client, *SLAPolicyService, context.Context) {
 	dbName := strings.NewReplacer("/", "-", " ", "-", ":", "-").Replace(t.Name())
 	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", dbName))
-	svc := NewSLAPolicyService(client)
+	svc := NewSLAPolicyService(client, zaptest.NewLogger(t).Sugar())
 	return client, svc, context.Background()
 }
 
@@ -111,6 +111,10 @@ func (s *TicketAutomationRuleService) CreateAutomationRule(
 ) (*dto.AutomationRuleResponse, error) {
 	s.logger.Infow("Creating automation rule", "name", req.Name, "user_id", userID, "tenant_id", tenantID)
 
+	if err := s.validateQueryConditions(ctx, req.Conditions, tenantID); err != nil {
+		return nil, err
+	}
+
 	rule, err := s.client.TicketAutomationRule.Create().
 		SetName(req.Name).
 		SetNillableDescription(req.Description).
@@ -152,6 +156,9 @@ func (s *TicketAutomationRuleService) UpdateAutomationRule(
 		updateQuery.SetPriority(*req.Priority)
 	}
 	if req.Conditions != nil {
+		if err := s.validateQueryConditions(ctx, req.Conditions, tenantID); err != nil {
+			return nil, err
+		}
 		updateQuery.SetConditions(req.Conditions)
 	}
 	if req.Actions != nil {
@@ -308,6 +315,19 @@ func (s *TicketAutomationRuleService) evaluateConditions(
 	}
 
 	for _, condition := range conditions {
+		// TQL 查询条件：{"query": "priority in (high, urgent) AND assignee IS EMPTY"}
+		if query, ok := condition["query"].(string); ok {
+			matched, err := s.matchTicketQuery(ctx, query, ticketEntity)
+			if err != nil {
+				s.logger.Warnw("Failed to evaluate query condition", "query", query, "ticket_id", ticketEntity.ID, "error", err)
+				return false, fmt.Sprintf("查询条件无效: %v", err)
+			}
+			if !matched {
+				return false, fmt.Sprintf("查询条件不匹配: %s", query)
+			}
+			continue
+		}
+
 		field, ok := condition["field"].(string)
 		if !ok {
 			continue
@@ -325,6 +345,33 @@ func (s *TicketAutomationRuleService) evaluateConditions(
 	return true, "所有条件匹配"
 }
 
+// matchTicketQuery 判断工单是否满足 TQL 查询条件，规则没有执行人，不支持 currentUser()
+func (s *TicketAutomationRuleService) matchTicketQuery(ctx context.Context, query string, ticketEntity *ent.Ticket) (bool, error) {
+	compiled, err := compileTicketQuery(ctx, s.client, query, TicketQueryContext{TenantID: ticketEntity.TenantID})
+	if err != nil {
+		return false, err
+	}
+	return compiled.matches(ctx, s.client, ticketEntity.ID)
+}
+
+// validateQueryConditions 保存规则前校验其中的 TQL 查询条件
+func (s *TicketAutomationRuleService) validateQueryConditions(ctx context.Context, conditions []map[string]interface{}, tenantID int) error {
+	for _, condition := range conditions {
+		raw, exists := condition["query"]
+		if !exists {
+			continue
+		}
+		query, ok := raw.(string)
+		if !ok || strings.TrimSpace(query) == "" {
+			return fmt.Errorf("查询条件不能为空")
+		}
+		if _, err := compileTicketQuery(ctx, s.client, query, TicketQueryContext{TenantID: tenantID}); err != nil {
+			return fmt.Errorf("查询条件无效: %w", err)
+		}
+	}
+	return nil
+}
+
 // evaluateCondition 评估单个条件
 func (s *TicketAutomationRuleService) evaluateCondition(
 	field, operator string,
@@ -80,6 +80,8 @@ func (s *TicketCommentService) CreateTicketComment(ctx context.Context, ticketID
 		s.logger.Errorw("Failed to create ticket comment", "error", err)
 		return nil, fmt.Errorf("failed to create ticket comment: %w", err)
 	}
+	recordTicketSLAEvent(ctx, NewSLAMetricService(s.client, s.logger), ticketID, tenantID,
+		SLAMetricEvent{Type: SLAEventCommented, ActorID: userID, Public: !req.IsInternal})
 
 	// 查询用户信息
 	user, err := s.client.User.Get(ctx, userID)
@@ -261,11 +263,16 @@ func (s *TicketCommentService) canManageInternalComments(ctx context.Context, us
 	if err != nil {
 		return false, fmt.Errorf("authenticated user not found")
 	}
-	switch string(u.Role) {
+	return isInternalCommentRole(string(u.Role)), nil
+}
+
+// isInternalCommentRole 可查看和发表内部备注的支持人员角色
+func isInternalCommentRole(role string) bool {
+	switch role {
 	case "super_admin", "admin", "manager", "agent", "technician", "security":
-		return true, nil
+		return true
 	}
-	return false, nil
+	return false
 }
 
 func (s *TicketCommentService) validateCommentReferences(ctx context.Context, ticketID, tenantID int, mentions, attachments []int) error {
@@ -19,6 +19,7 @@ import (
 	"itsm-backend/ent/tickettag"
 	"itsm-backend/ent/tickettemplate"
 	"itsm-backend/ent/user"
+	"itsm-backend/ent/vendor"
 
 	"go.uber.org/zap"
 )
@@ -315,6 +316,19 @@ func (s *TicketCoreService) UpdateTicketBasic(ctx context.Context, ticketID int,
 		}
 		update = update.SetStatus(req.Status)
 	}
+	if req.OnHold != nil {
+		update = update.SetSLAOnHold(*req.OnHold)
+	}
+	if req.VendorID != nil {
+		if *req.VendorID > 0 {
+			if err := validateTicketVendor(ctx, s.client, *req.VendorID, tenantID); err != nil {
+				return nil, err
+			}
+			update = update.SetVendorID(*req.VendorID)
+		} else {
+			update = update.ClearVendorID()
+		}
+	}
 	if req.RequesterID > 0 {
 		if err := s.validateRequester(ctx, req.RequesterID, tenantID); err != nil {
 			return nil, fmt.Errorf("验证创建人失败: %w", err)
@@ -337,6 +351,13 @@ func (s *TicketCoreService) UpdateTicketBasic(ctx context.Context, ticketID int,
 	if err != nil {
 		return nil, fmt.Errorf("更新失败: %w", err)
 	}
+	if req.Status != "" || req.OnHold != nil {
+		syncTicketSLAClock(ctx, NewTicketSLAService(s.client, s.logger), ticketID, tenantID)
+	}
+	metrics := NewSLAMetricService(s.client, s.logger)
+	for _, event := range ticketUpdateSLAEvents(t.Status, req.Status, req.AssigneeID != 0 && req.AssigneeID != t.AssigneeID) {
+		recordTicketSLAEvent(ctx, metrics, ticketID, tenantID, event)
+	}
 	return ticket, nil
 }
 
@@ -534,6 +555,20 @@ func (s *TicketCoreService) validateAssignee(ctx context.Context, userID, tenant
 	return nil
 }
 
+// validateTicketVendor 校验工单承接供应商属于当前租户
+func validateTicketVendor(ctx context.Context, client *ent.Client, vendorID, tenantID int) error {
+	exists, err := client.Vendor.Query().
+		Where(vendor.ID(vendorID), vendor.TenantID(tenantID)).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("查询失败: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("供应商不存在")
+	}
+	return nil
+}
+
 func (s *TicketCoreService) validateTagIDs(ctx context.Context, tagIDs []int, tenantID int) ([]int, error) {
 	uniqueIDs := make(map[int]struct{}, len(tagIDs))
 	for _, tagID := range tagIDs {
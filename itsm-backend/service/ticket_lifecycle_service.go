@@ -66,7 +66,10 @@ func (s *TicketLifecycleService) ResolveTicket(ctx context.Context, ticketID int
 	}
 
 	// 只有处于开放状态的工单才能被解决
-	if t.Status != common.TicketStatusOpen && t.Status != common.TicketStatusInProgress && t.Status != common.TicketStatusPending {
+	switch t.Status {
+	case common.TicketStatusOpen, common.TicketStatusInProgress, common.TicketStatusPending,
+		common.TicketStatusPendingCustomer, common.TicketStatusPendingVendor:
+	default:
 		return nil, ErrInvalidTicketStatus
 	}
 
@@ -96,6 +99,10 @@ func (s *TicketLifecycleService) ResolveTicket(ctx context.Context, ticketID int
 		s.notificationService.SendResolutionNotification(ticketID, t.RequesterID, resolvedBy)
 	}
 
+	syncTicketSLAClock(ctx, NewTicketSLAService(s.client, s.logger), ticketID, tenantID)
+	recordTicketSLAEvent(ctx, NewSLAMetricService(s.client, s.logger), ticketID, tenantID,
+		SLAMetricEvent{Type: SLAEventStatusChanged, FromStatus: t.Status, ToStatus: updatedTicket.Status, ActorID: resolvedBy})
+
 	s.logger.Infow("Ticket resolved", "ticketID", ticketID, "resolvedBy", resolvedBy)
 	return updatedTicket, nil
 }
@@ -233,7 +240,8 @@ func (s *TicketLifecycleService) UpdateTicketStatus(ctx context.Context, ticketI
 		update.SetResolvedAt(time.Now()).ClearClosedAt()
 	case common.TicketStatusClosed:
 		update.SetClosedAt(time.Now())
-	case common.TicketStatusOpen, common.TicketStatusInProgress, common.TicketStatusPending:
+	case common.TicketStatusOpen, common.TicketStatusInProgress, common.TicketStatusPending,
+		common.TicketStatusPendingCustomer, common.TicketStatusPendingVendor:
 		update.ClearResolvedAt().ClearClosedAt()
 	}
 
@@ -250,6 +258,11 @@ func (s *TicketLifecycleService) UpdateTicketStatus(ctx context.Context, ticketI
 		"newStatus":      status,
 	})
 
+	// 进入/离开等待状态时暂停或恢复 SLA 计时
+	syncTicketSLAClock(ctx, NewTicketSLAService(s.client, s.logger), ticketID, tenantID)
+	recordTicketSLAEvent(ctx, NewSLAMetricService(s.client, s.logger), ticketID, tenantID,
+		SLAMetricEvent{Type: SLAEventStatusChanged, FromStatus: t.Status, ToStatus: status, ActorID: operatorID})
+
 	s.logger.Infow("Ticket status updated", "ticketID", ticketID, "newStatus", status, "operatorID", operatorID)
 	return updatedTicket, nil
 }
@@ -0,0 +1,572 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"itsm-backend/common"
+	"itsm-backend/dto"
+	"itsm-backend/ent"
+	entTicket "itsm-backend/ent/ticket"
+	"itsm-backend/ent/ticketattachment"
+	"itsm-backend/ent/ticketcc"
+	entTicketComment "itsm-backend/ent/ticketcomment"
+	"itsm-backend/ent/ticketslametric"
+	"itsm-backend/ent/user"
+	"itsm-backend/repository/ticket"
+)
+
+// 工单合并与拆分
+//
+// 合并：重复工单的评论、附件、抄送人与标签并入主工单，重复工单的提交人作为关注人加入主工单抄送，
+// 子工单改挂到主工单下；重复工单以 "merged" 解决分类关闭，其进行中的 SLA 计时周期取消（不计达成也不计违约），
+// 主工单计时保持不变。双方各写一条 merge 流转记录，主工单与重复工单互相建立关联，便于追溯。
+//
+// 拆分：按所选评论或表单字段创建子工单（parent_ticket_id 指向原工单），所选评论复制到子工单，
+// 原工单内容保持不变；原工单与每个子工单各写一条 split 流转记录。拆分中途失败时已创建的子工单被软删除。
+
+// 工单合并的解决分类
+const ticketResolutionCategoryMerged = "merged"
+
+// MergeTickets 将重复工单合并到主工单
+func (s *TicketService) MergeTickets(ctx context.Context, primaryID int, req *dto.MergeTicketsRequest, tenantID, userID int, role string) (*dto.MergeTicketsResponse, error) {
+	if s.client == nil {
+		return nil, fmt.Errorf("ent client not available for ticket merge")
+	}
+	duplicateIDs := uniqueIDs(req.DuplicateIDs)
+	if len(duplicateIDs) == 0 {
+		return nil, common.NewValidationError("请选择要合并的重复工单", nil)
+	}
+	for _, id := range duplicateIDs {
+		if id == primaryID {
+			return nil, common.NewValidationError("工单不能合并到自身", nil)
+		}
+	}
+
+	primary, err := s.client.Ticket.Query().
+		Where(entTicket.ID(primaryID), entTicket.TenantID(tenantID), entTicket.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, common.NewValidationError("主工单不存在", nil)
+		}
+		return nil, fmt.Errorf("获取主工单失败: %w", err)
+	}
+	if isTicketTerminalStatus(primary.Status) {
+		return nil, common.NewValidationError(fmt.Sprintf("主工单已%s，不能合并", ticketStatusLabel(primary.Status)), nil)
+	}
+	if err := ensureTicketOwnedOrScoped(primary, userID, role, "合并"); err != nil {
+		return nil, err
+	}
+
+	duplicates, err := s.client.Ticket.Query().
+		Where(entTicket.IDIn(duplicateIDs...), entTicket.TenantID(tenantID), entTicket.DeletedAtIsNil()).
+		Order(ent.Asc(entTicket.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("获取重复工单失败: %w", err)
+	}
+	if len(duplicates) != len(duplicateIDs) {
+		return nil, common.NewValidationError("重复工单不存在或不属于当前租户", nil)
+	}
+	// 重复工单会被关闭且评论（含内部备注）、附件、抄送迁入主工单，须与主工单同样校验操作权限
+	for _, dup := range duplicates {
+		if isTicketTerminalStatus(dup.Status) {
+			return nil, common.NewValidationError(fmt.Sprintf("工单 %s 已%s，不能合并", dup.TicketNumber, ticketStatusLabel(dup.Status)), nil)
+		}
+		if err := ensureTicketOwnedOrScoped(dup, userID, role, "合并"); err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &dto.MergeTicketsResponse{PrimaryID: primaryID, MergedIDs: duplicateIDs}
+	now := time.Now()
+	err = s.runTicketTx(ctx, func(tx *ent.Tx) error {
+		txClient := tx.Client()
+
+		// 评论与附件整体迁移到主工单，保留原作者与时间
+		moved, err := txClient.TicketComment.Update().
+			Where(entTicketComment.TicketIDIn(duplicateIDs...), entTicketComment.TenantID(tenantID)).
+			SetTicketID(primaryID).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("迁移评论失败: %w", err)
+		}
+		resp.MovedComments = moved
+		moved, err = txClient.TicketAttachment.Update().
+			Where(ticketattachment.TicketIDIn(duplicateIDs...), ticketattachment.TenantID(tenantID)).
+			SetTicketID(primaryID).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("迁移附件失败: %w", err)
+		}
+		resp.MovedAttachments = moved
+
+		// 抄送人与重复工单的提交人（关注人）并入主工单抄送
+		watchers, err := mergeTicketWatchers(ctx, txClient, primary, duplicates, userID, now)
+		if err != nil {
+			return err
+		}
+		resp.AddedCCUsers = watchers
+
+		tags, err := txClient.Ticket.Query().
+			Where(entTicket.IDIn(duplicateIDs...)).
+			QueryTags().
+			IDs(ctx)
+		if err != nil {
+			return fmt.Errorf("查询重复工单标签失败: %w", err)
+		}
+		existingTags, err := txClient.Ticket.Query().Where(entTicket.ID(primaryID)).QueryTags().IDs(ctx)
+		if err != nil {
+			return fmt.Errorf("查询主工单标签失败: %w", err)
+		}
+		newTags := subtractIDs(uniqueIDs(tags), existingTags)
+		resp.AddedTags = len(newTags)
+		// 工单标签为一对多关系，标签需先从重复工单解除才能挂到主工单
+		for _, dup := range duplicates {
+			if err := txClient.Ticket.UpdateOneID(dup.ID).ClearTags().Exec(ctx); err != nil {
+				return fmt.Errorf("迁移标签失败: %w", err)
+			}
+		}
+
+		primaryUpdate := txClient.Ticket.UpdateOneID(primaryID).
+			Where(entTicket.TenantID(tenantID), entTicket.DeletedAtIsNil()).
+			AddRelatedTicketIDs(duplicateIDs...)
+		if len(newTags) > 0 {
+			primaryUpdate.AddTagIDs(newTags...)
+		}
+		if err := primaryUpdate.Exec(ctx); err != nil {
+			return fmt.Errorf("更新主工单失败: %w", err)
+		}
+
+		// 重复工单的子工单改挂到主工单；主工单本身是重复工单的子工单时保持原父工单，避免自引用
+		if _, err := txClient.Ticket.Update().
+			Where(entTicket.ParentTicketIDIn(duplicateIDs...), entTicket.IDNEQ(primaryID), entTicket.TenantID(tenantID)).
+			SetParentTicketID(primaryID).
+			Save(ctx); err != nil {
+			return fmt.Errorf("迁移子工单失败: %w", err)
+		}
+
+		resolution := fmt.Sprintf("已合并至工单 %s", primary.TicketNumber)
+		closed := "closed"
+		for _, dup := range duplicates {
+			update := txClient.Ticket.UpdateOneID(dup.ID).
+				Where(entTicket.TenantID(tenantID), entTicket.DeletedAtIsNil()).
+				SetStatus(closed).
+				SetResolution(resolution).
+				SetResolutionCategory(ticketResolutionCategoryMerged).
+				SetClosedAt(now).
+				SetSLAOnHold(false).
+				AddRelatedTicketIDs(primaryID)
+			if dup.ResolvedAt.IsZero() {
+				update.SetResolvedAt(now)
+			}
+			if err := update.Exec(ctx); err != nil {
+				return fmt.Errorf("关闭重复工单 %s 失败: %w", dup.TicketNumber, err)
+			}
+			fromStatus := dup.Status
+			if err := createTicketWorkflowRecord(ctx, txClient, &dto.TicketWorkflowRecord{
+				TicketID:   dup.ID,
+				Action:     dto.WorkflowActionMerge,
+				FromStatus: &fromStatus,
+				ToStatus:   &closed,
+				Operator:   dto.WorkflowUserInfo{ID: userID},
+				Comment:    req.Comment,
+				Reason:     resolution,
+				Metadata: map[string]interface{}{
+					"merged_into":        primaryID,
+					"merged_into_number": primary.TicketNumber,
+				},
+			}, tenantID); err != nil {
+				return fmt.Errorf("记录流转记录失败: %w", err)
+			}
+		}
+
+		// 重复工单不再计时：进行中的周期取消，避免合并关闭被计为达成或违约
+		if _, err := txClient.TicketSLAMetric.Update().
+			Where(
+				ticketslametric.TicketIDIn(duplicateIDs...),
+				ticketslametric.StatusIn(SLAMetricStatusRunning, SLAMetricStatusPaused),
+			).
+			SetStatus(SLAMetricStatusCancelled).
+			SetStoppedAt(now).
+			ClearPausedAt().
+			Save(ctx); err != nil {
+			return fmt.Errorf("取消重复工单 SLA 计时失败: %w", err)
+		}
+
+		numbers := make([]string, len(duplicates))
+		for i, dup := range duplicates {
+			numbers[i] = dup.TicketNumber
+		}
+		if err := createTicketWorkflowRecord(ctx, txClient, &dto.TicketWorkflowRecord{
+			TicketID: primaryID,
+			Action:   dto.WorkflowActionMerge,
+			Operator: dto.WorkflowUserInfo{ID: userID},
+			Comment:  req.Comment,
+			Metadata: map[string]interface{}{
+				"merged_ids":        duplicateIDs,
+				"merged_numbers":    numbers,
+				"moved_comments":    resp.MovedComments,
+				"moved_attachments": resp.MovedAttachments,
+				"added_cc_users":    resp.AddedCCUsers,
+				"added_tags":        newTags,
+			},
+		}, tenantID); err != nil {
+			return fmt.Errorf("记录流转记录失败: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 提交后关闭重复工单的暂停区间并推进主工单计时（失败仅记录日志，由 SLA 巡检兜底）
+	slaService := NewTicketSLAService(s.client, s.logger)
+	for _, dup := range duplicates {
+		syncTicketSLAClock(ctx, slaService, dup.ID, tenantID)
+	}
+	recordTicketSLAEvent(ctx, NewSLAMetricService(s.client, s.logger), primaryID, tenantID,
+		SLAMetricEvent{Type: SLAEventUpdated, ActorID: userID})
+
+	s.logger.Infow("Tickets merged", "primary_id", primaryID, "duplicate_ids", duplicateIDs, "tenant_id", tenantID, "user_id", userID)
+	return resp, nil
+}
+
+// mergeTicketWatchers 将重复工单的有效抄送人及其提交人加入主工单抄送，重复工单上的抄送记录置为失效；
+// 返回主工单新增的抄送人数
+func mergeTicketWatchers(ctx context.Context, client *ent.Client, primary *ent.Ticket, duplicates []*ent.Ticket, operatorID int, now time.Time) (int, error) {
+	duplicateIDs := make([]int, len(duplicates))
+	candidates := make([]int, 0, len(duplicates))
+	for i, dup := range duplicates {
+		duplicateIDs[i] = dup.ID
+		candidates = append(candidates, dup.RequesterID)
+	}
+	ccUsers, err := client.TicketCC.Query().
+		Where(ticketcc.TicketIDIn(duplicateIDs...), ticketcc.TenantID(primary.TenantID), ticketcc.IsActive(true)).
+		Select(ticketcc.FieldUserID).
+		Ints(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("查询重复工单抄送人失败: %w", err)
+	}
+	candidates = append(candidates, ccUsers...)
+
+	existing, err := client.TicketCC.Query().
+		Where(ticketcc.TicketID(primary.ID), ticketcc.TenantID(primary.TenantID), ticketcc.IsActive(true)).
+		Select(ticketcc.FieldUserID).
+		Ints(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("查询主工单抄送人失败: %w", err)
+	}
+	// 主工单的提交人与处理人本身就会收到通知，无需抄送
+	existing = append(existing, primary.RequesterID)
+	if primary.AssigneeID > 0 {
+		existing = append(existing, primary.AssigneeID)
+	}
+
+	added := subtractIDs(uniqueIDs(candidates), existing)
+	for _, userID := range added {
+		if err := client.TicketCC.Create().
+			SetTicketID(primary.ID).
+			SetUserID(userID).
+			SetAddedBy(operatorID).
+			SetTenantID(primary.TenantID).
+			SetAddedAt(now).
+			SetIsActive(true).
+			Exec(ctx); err != nil {
+			return 0, fmt.Errorf("添加主工单抄送人失败: %w", err)
+		}
+	}
+	if _, err := client.TicketCC.Update().
+		Where(ticketcc.TicketIDIn(duplicateIDs...), ticketcc.TenantID(primary.TenantID), ticketcc.IsActive(true)).
+		SetIsActive(false).
+		Save(ctx); err != nil {
+		return 0, fmt.Errorf("失效重复工单抄送人失败: %w", err)
+	}
+	return len(added), nil
+}
+
+// SplitTicket 按所选评论或表单字段将工单拆分为多个子工单。
+// 子工单经 CreateTicket 逐个创建并各自提交（工单编号在事务外生成，无法将多张新工单放进同一事务），
+// 评论复制与全部流转记录在一个事务内写入；任一步失败时软删除已创建的子工单，不留下半拆分的结果。
+func (s *TicketService) SplitTicket(ctx context.Context, sourceID int, req *dto.SplitTicketRequest, tenantID, userID int, role string) ([]*ticket.Ticket, error) {
+	if s.client == nil {
+		return nil, fmt.Errorf("ent client not available for ticket split")
+	}
+	if len(req.Children) == 0 {
+		return nil, common.NewValidationError("请至少指定一个子工单", nil)
+	}
+	source, err := s.client.Ticket.Query().
+		Where(entTicket.ID(sourceID), entTicket.TenantID(tenantID), entTicket.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, common.NewValidationError("工单不存在", nil)
+		}
+		return nil, fmt.Errorf("获取工单失败: %w", err)
+	}
+	if isTicketTerminalStatus(source.Status) {
+		return nil, common.NewValidationError(fmt.Sprintf("工单已%s，不能拆分", ticketStatusLabel(source.Status)), nil)
+	}
+	if err := ensureTicketOwnedOrScoped(source, userID, role, "拆分"); err != nil {
+		return nil, err
+	}
+
+	// 先校验全部子工单的来源内容，避免部分创建
+	comments := make(map[int]*ent.TicketComment)
+	var commentIDs []int
+	for _, child := range req.Children {
+		commentIDs = append(commentIDs, child.CommentIDs...)
+	}
+	if commentIDs = uniqueIDs(commentIDs); len(commentIDs) > 0 {
+		rows, err := s.client.TicketComment.Query().
+			Where(entTicketComment.IDIn(commentIDs...), entTicketComment.TicketID(sourceID), entTicketComment.TenantID(tenantID)).
+			Order(ent.Asc(entTicketComment.FieldCreatedAt), ent.Asc(entTicketComment.FieldID)).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("获取评论失败: %w", err)
+		}
+		if len(rows) != len(commentIDs) {
+			return nil, common.NewValidationError("所选评论不存在或不属于该工单", nil)
+		}
+		for _, c := range rows {
+			comments[c.ID] = c
+		}
+	}
+	createReqs := make([]*dto.CreateTicketRequest, len(req.Children))
+	for i, child := range req.Children {
+		if len(child.CommentIDs) == 0 && len(child.FormFieldKeys) == 0 && strings.TrimSpace(child.Description) == "" {
+			return nil, common.NewValidationError(fmt.Sprintf("子工单 %q 需指定评论、表单字段或描述", child.Title), nil)
+		}
+		formFields := make(map[string]interface{}, len(child.FormFieldKeys))
+		for _, key := range child.FormFieldKeys {
+			value, ok := source.FormFields[key]
+			if !ok {
+				return nil, common.NewValidationError(fmt.Sprintf("工单不存在表单字段 %s", key), nil)
+			}
+			formFields[key] = value
+		}
+		description := strings.TrimSpace(child.Description)
+		if description == "" {
+			description = splitTicketDescription(child.CommentIDs, comments)
+		}
+		priority := child.Priority
+		if priority == "" {
+			priority = source.Priority
+		}
+		switch ticket.Priority(priority) {
+		case ticket.PriorityLow, ticket.PriorityMedium, ticket.PriorityHigh, ticket.PriorityUrgent, ticket.PriorityCritical:
+		default:
+			return nil, common.NewValidationError(fmt.Sprintf("子工单 %q 的优先级无效: %s", child.Title, priority), nil)
+		}
+		createReq := &dto.CreateTicketRequest{
+			Title:          child.Title,
+			Description:    description,
+			Priority:       priority,
+			Type:           source.Type,
+			RequesterID:    source.RequesterID,
+			AssigneeID:     child.AssigneeID,
+			ParentTicketID: &source.ID,
+			FormFields:     formFields,
+		}
+		if source.CategoryID > 0 {
+			createReq.CategoryID = &source.CategoryID
+		}
+		createReqs[i] = createReq
+	}
+
+	var assigneeIDs []int
+	for _, child := range req.Children {
+		if child.AssigneeID > 0 {
+			assigneeIDs = append(assigneeIDs, child.AssigneeID)
+		}
+	}
+	if assigneeIDs = uniqueIDs(assigneeIDs); len(assigneeIDs) > 0 {
+		found, err := s.client.User.Query().
+			Where(user.IDIn(assigneeIDs...), user.TenantIDEQ(tenantID), user.ActiveEQ(true)).
+			Count(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("验证处理人失败: %w", err)
+		}
+		if found != len(assigneeIDs) {
+			return nil, common.NewValidationError("子工单处理人不存在或不可用", nil)
+		}
+	}
+
+	children := make([]*ticket.Ticket, 0, len(createReqs))
+	childIDs := make([]int, 0, len(createReqs))
+	for _, createReq := range createReqs {
+		created, err := s.CreateTicket(ctx, createReq, tenantID)
+		if err != nil {
+			s.discardSplitChildren(ctx, childIDs, tenantID)
+			return nil, fmt.Errorf("创建子工单 %q 失败: %w", createReq.Title, err)
+		}
+		children = append(children, created)
+		childIDs = append(childIDs, created.ID)
+	}
+
+	err = s.runTicketTx(ctx, func(tx *ent.Tx) error {
+		txClient := tx.Client()
+		for i, created := range children {
+			child := req.Children[i]
+			// 所选评论复制到子工单，保留作者、可见性与原始时间
+			for _, id := range uniqueIDs(child.CommentIDs) {
+				c := comments[id]
+				if err := txClient.TicketComment.Create().
+					SetTicketID(created.ID).
+					SetUserID(c.UserID).
+					SetContent(c.Content).
+					SetIsInternal(c.IsInternal).
+					SetMentions(c.Mentions).
+					SetAttachments([]int{}).
+					SetTenantID(tenantID).
+					SetCreatedAt(c.CreatedAt).
+					SetUpdatedAt(c.UpdatedAt).
+					Exec(ctx); err != nil {
+					return fmt.Errorf("复制评论到子工单 %s 失败: %w", created.TicketNumber, err)
+				}
+			}
+			if err := createTicketWorkflowRecord(ctx, txClient, &dto.TicketWorkflowRecord{
+				TicketID: created.ID,
+				Action:   dto.WorkflowActionSplit,
+				Operator: dto.WorkflowUserInfo{ID: userID},
+				Comment:  req.Comment,
+				Metadata: map[string]interface{}{
+					"split_from":        sourceID,
+					"split_from_number": source.TicketNumber,
+					"comment_ids":       uniqueIDs(child.CommentIDs),
+					"form_field_keys":   child.FormFieldKeys,
+				},
+			}, tenantID); err != nil {
+				return fmt.Errorf("记录流转记录失败: %w", err)
+			}
+		}
+
+		numbers := make([]string, len(children))
+		for i, child := range children {
+			numbers[i] = child.TicketNumber
+		}
+		if err := createTicketWorkflowRecord(ctx, txClient, &dto.TicketWorkflowRecord{
+			TicketID: sourceID,
+			Action:   dto.WorkflowActionSplit,
+			Operator: dto.WorkflowUserInfo{ID: userID},
+			Comment:  req.Comment,
+			Metadata: map[string]interface{}{
+				"child_ids":     childIDs,
+				"child_numbers": numbers,
+			},
+		}, tenantID); err != nil {
+			return fmt.Errorf("记录流转记录失败: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		s.discardSplitChildren(ctx, childIDs, tenantID)
+		return nil, err
+	}
+
+	s.logger.Infow("Ticket split", "source_id", sourceID, "child_ids", childIDs, "tenant_id", tenantID, "user_id", userID)
+	return children, nil
+}
+
+// discardSplitChildren 拆分失败时软删除已创建的子工单，并取消其进行中的 SLA 计时（失败仅记录日志）
+func (s *TicketService) discardSplitChildren(ctx context.Context, childIDs []int, tenantID int) {
+	if len(childIDs) == 0 {
+		return
+	}
+	ctx = context.WithoutCancel(ctx)
+	now := time.Now()
+	err := s.runTicketTx(ctx, func(tx *ent.Tx) error {
+		if _, err := tx.Ticket.Update().
+			Where(entTicket.IDIn(childIDs...), entTicket.TenantID(tenantID), entTicket.DeletedAtIsNil()).
+			SetDeletedAt(now).
+			Save(ctx); err != nil {
+			return fmt.Errorf("删除子工单失败: %w", err)
+		}
+		if _, err := tx.TicketSLAMetric.Update().
+			Where(
+				ticketslametric.TicketIDIn(childIDs...),
+				ticketslametric.StatusIn(SLAMetricStatusRunning, SLAMetricStatusPaused),
+			).
+			SetStatus(SLAMetricStatusCancelled).
+			SetStoppedAt(now).
+			ClearPausedAt().
+			Save(ctx); err != nil {
+			return fmt.Errorf("取消子工单 SLA 计时失败: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Errorw("Failed to discard split children", "error", err, "child_ids", childIDs, "tenant_id", tenantID)
+	}
+}
+
+// splitTicketDescription 以所选评论（按时间顺序）拼接子工单描述
+func splitTicketDescription(commentIDs []int, comments map[int]*ent.TicketComment) string {
+	selected := make([]*ent.TicketComment, 0, len(commentIDs))
+	for _, id := range uniqueIDs(commentIDs) {
+		selected = append(selected, comments[id])
+	}
+	sort.SliceStable(selected, func(i, j int) bool {
+		return selected[i].CreatedAt.Before(selected[j].CreatedAt)
+	})
+	parts := make([]string, len(selected))
+	for i, c := range selected {
+		parts[i] = c.Content
+	}
+	return strings.Join(parts, "\n\n")
+}
+
+// runTicketTx 在事务中执行，失败回滚
+func (s *TicketService) runTicketTx(ctx context.Context, fn func(tx *ent.Tx) error) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("提交事务失败: %w", err)
+	}
+	return nil
+}
+
+// ensureTicketOwnedOrScoped 非全量数据角色只能对本人提交或处理的工单执行操作
+func ensureTicketOwnedOrScoped(t *ent.Ticket, userID int, role, action string) error {
+	if isTicketDataScopeAllRole(role) || t.RequesterID == userID || (t.AssigneeID > 0 && t.AssigneeID == userID) {
+		return nil
+	}
+	return common.NewForbiddenError(fmt.Sprintf("无权限%s工单 %d：仅工单创建人或处理人可操作", action, t.ID))
+}
+
+// isTicketTerminalStatus 已关闭或已取消的工单不再参与合并与拆分
+func isTicketTerminalStatus(status string) bool {
+	return status == "closed" || status == "cancelled"
+}
+
+func ticketStatusLabel(status string) string {
+	if status == "cancelled" {
+		return "取消"
+	}
+	return "关闭"
+}
+
+// subtractIDs 返回 ids 中不在 exclude 内的元素，保持原顺序
+func subtractIDs(ids, exclude []int) []int {
+	skip := make(map[int]struct{}, len(exclude))
+	for _, id := range exclude {
+		skip[id] = struct{}{}
+	}
+	result := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := skip[id]; !ok {
+			result = append(result, id)
+		}
+	}
+	return result
+}
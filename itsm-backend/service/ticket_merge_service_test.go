@@ -0,0 +1,307 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"itsm-backend/common"
+	"itsm-backend/dto"
+	"itsm-backend/ent"
+	"itsm-backend/ent/enttest"
+	entTicket "itsm-backend/ent/ticket"
+	"itsm-backend/ent/ticketattachment"
+	"itsm-backend/ent/ticketcc"
+	"itsm-backend/ent/ticketcomment"
+	"itsm-backend/ent/ticketworkflowrecord"
+	"itsm-backend/ent/user"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func newMergeTestFixture(t *testing.T) (*ent.Client, context.Context, *TicketService, *ent.Tenant, map[string]*ent.User) {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", testDSN())
+	t.Cleanup(func() { client.Close() })
+	ctx := context.Background()
+
+	tenant, err := client.Tenant.Create().SetName("Merge Tenant").SetCode("merge").SetDomain("merge.com").SetStatus("active").Save(ctx)
+	require.NoError(t, err)
+	users := make(map[string]*ent.User)
+	for _, spec := range []struct{ name, role string }{
+		{"alice", "end_user"}, {"bob", "end_user"}, {"carol", "end_user"}, {"agent", "agent"}, {"other", "agent"}, {"admin", "admin"},
+	} {
+		u, err := client.User.Create().
+			SetUsername(spec.name).
+			SetEmail(spec.name + "@merge.com").
+			SetName(spec.name).
+			SetPasswordHash("hashedpassword").
+			SetRole(user.Role(spec.role)).
+			SetActive(true).
+			SetTenantID(tenant.ID).
+			Save(ctx)
+		require.NoError(t, err)
+		users[spec.name] = u
+	}
+	return client, ctx, NewTicketServiceForTest(client, zaptest.NewLogger(t).Sugar()), tenant, users
+}
+
+func TestMergeTickets(t *testing.T) {
+	client, ctx, svc, tenant, users := newMergeTestFixture(t)
+
+	newTicket := func(title string, requester *ent.User) *ent.Ticket {
+		created, err := svc.CreateTicket(ctx, &dto.CreateTicketRequest{
+			Title: title, Description: title, Priority: "high", Type: "incident",
+			RequesterID: requester.ID, AssigneeID: users["agent"].ID,
+		}, tenant.ID)
+		require.NoError(t, err)
+		tk, err := client.Ticket.Get(ctx, created.ID)
+		require.NoError(t, err)
+		return tk
+	}
+	primary := newTicket("VPN 无法连接", users["alice"])
+	dup1 := newTicket("VPN 连不上", users["bob"])
+	dup2 := newTicket("VPN 故障", users["carol"])
+
+	_, err := client.TicketComment.Create().SetTicketID(dup1.ID).SetUserID(users["bob"].ID).SetContent("从早上开始连不上").SetTenantID(tenant.ID).Save(ctx)
+	require.NoError(t, err)
+	_, err = client.TicketAttachment.Create().SetTicketID(dup2.ID).SetFileName("err.png").SetFilePath("/tmp/err.png").SetFileURL("/files/err.png").
+		SetFileSize(10).SetFileType("png").SetMimeType("image/png").SetUploadedBy(users["carol"].ID).SetTenantID(tenant.ID).Save(ctx)
+	require.NoError(t, err)
+	_, err = client.TicketCC.Create().SetTicketID(dup1.ID).SetUserID(users["other"].ID).SetAddedBy(users["bob"].ID).SetTenantID(tenant.ID).Save(ctx)
+	require.NoError(t, err)
+	tag, err := client.TicketTag.Create().SetName("network").SetTenantID(tenant.ID).Save(ctx)
+	require.NoError(t, err)
+	require.NoError(t, client.Ticket.UpdateOneID(dup2.ID).AddTagIDs(tag.ID).Exec(ctx))
+	child, err := client.Ticket.Create().SetTitle("dup1 子任务").SetDescription("child").SetStatus("open").SetPriority("low").
+		SetTicketNumber("CHILD-1").SetRequesterID(users["bob"].ID).SetTenantID(tenant.ID).SetParentTicketID(dup1.ID).Save(ctx)
+	require.NoError(t, err)
+	metric, err := client.TicketSLAMetric.Create().SetTicketID(dup1.ID).SetPolicyID(1).SetMetricKey("resolution").SetTargetMinutes(60).
+		SetStartedAt(time.Now()).SetDeadline(time.Now().Add(time.Hour)).SetTenantID(tenant.ID).Save(ctx)
+	require.NoError(t, err)
+
+	t.Run("非处理人不能合并", func(t *testing.T) {
+		_, err := svc.MergeTickets(ctx, primary.ID, &dto.MergeTicketsRequest{DuplicateIDs: []int{dup1.ID}}, tenant.ID, users["bob"].ID, "end_user")
+		var appErr *common.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, common.ErrCodeForbidden, appErr.Code)
+	})
+
+	t.Run("不能合并无权操作的重复工单", func(t *testing.T) {
+		_, err := svc.MergeTickets(ctx, primary.ID, &dto.MergeTicketsRequest{DuplicateIDs: []int{dup1.ID}}, tenant.ID, users["alice"].ID, "end_user")
+		var appErr *common.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, common.ErrCodeForbidden, appErr.Code)
+		unchanged, err := client.Ticket.Get(ctx, dup1.ID)
+		require.NoError(t, err)
+		assert.Equal(t, dup1.Status, unchanged.Status)
+	})
+
+	t.Run("不能合并到自身", func(t *testing.T) {
+		_, err := svc.MergeTickets(ctx, primary.ID, &dto.MergeTicketsRequest{DuplicateIDs: []int{primary.ID}}, tenant.ID, users["admin"].ID, "admin")
+		var appErr *common.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, common.ErrCodeValidation, appErr.Code)
+	})
+
+	resp, err := svc.MergeTickets(ctx, primary.ID, &dto.MergeTicketsRequest{DuplicateIDs: []int{dup1.ID, dup2.ID, dup1.ID}, Comment: "同一次网络中断"},
+		tenant.ID, users["agent"].ID, "agent")
+	require.NoError(t, err)
+	assert.Equal(t, []int{dup1.ID, dup2.ID}, resp.MergedIDs)
+	assert.Equal(t, 1, resp.MovedComments)
+	assert.Equal(t, 1, resp.MovedAttachments)
+	assert.Equal(t, 3, resp.AddedCCUsers, "bob、carol 作为关注人，other 为原抄送人")
+	assert.Equal(t, 1, resp.AddedTags)
+
+	comments, err := client.TicketComment.Query().Where(ticketcomment.TicketID(primary.ID)).Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, comments)
+	attachments, err := client.TicketAttachment.Query().Where(ticketattachment.TicketID(primary.ID)).Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, attachments)
+	ccUsers, err := client.TicketCC.Query().Where(ticketcc.TicketID(primary.ID), ticketcc.IsActive(true)).Select(ticketcc.FieldUserID).Ints(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []int{users["bob"].ID, users["carol"].ID, users["other"].ID}, ccUsers)
+	staleCC, err := client.TicketCC.Query().Where(ticketcc.TicketID(dup1.ID), ticketcc.IsActive(true)).Count(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, staleCC)
+
+	primaryTags, err := client.Ticket.Query().Where(entTicket.ID(primary.ID)).QueryTags().IDs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []int{tag.ID}, primaryTags)
+	related, err := client.Ticket.Query().Where(entTicket.ID(primary.ID)).QueryRelatedTickets().IDs(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []int{dup1.ID, dup2.ID}, related)
+
+	for _, id := range []int{dup1.ID, dup2.ID} {
+		dup, err := client.Ticket.Get(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "closed", dup.Status)
+		assert.Equal(t, ticketResolutionCategoryMerged, dup.ResolutionCategory)
+		assert.Contains(t, dup.Resolution, primary.TicketNumber)
+		assert.NotNil(t, dup.ClosedAt)
+
+		record, err := client.TicketWorkflowRecord.Query().
+			Where(ticketworkflowrecord.TicketID(id), ticketworkflowrecord.Action(string(dto.WorkflowActionMerge))).
+			Only(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "closed", record.ToStatus)
+		assert.EqualValues(t, primary.ID, record.Metadata["merged_into"])
+	}
+	primaryRecord, err := client.TicketWorkflowRecord.Query().
+		Where(ticketworkflowrecord.TicketID(primary.ID), ticketworkflowrecord.Action(string(dto.WorkflowActionMerge))).
+		Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "同一次网络中断", primaryRecord.Comment)
+
+	reparented, err := client.Ticket.Get(ctx, child.ID)
+	require.NoError(t, err)
+	assert.Equal(t, primary.ID, reparented.ParentTicketID)
+
+	cancelled, err := client.TicketSLAMetric.Get(ctx, metric.ID)
+	require.NoError(t, err)
+	assert.Equal(t, SLAMetricStatusCancelled, cancelled.Status)
+	assert.False(t, cancelled.Breached)
+
+	t.Run("已关闭工单不能再次合并", func(t *testing.T) {
+		_, err := svc.MergeTickets(ctx, primary.ID, &dto.MergeTicketsRequest{DuplicateIDs: []int{dup1.ID}}, tenant.ID, users["admin"].ID, "admin")
+		assert.ErrorContains(t, err, "不能合并")
+	})
+
+	t.Run("主工单为重复工单的子工单时不改挂到自身", func(t *testing.T) {
+		parent := newTicket("邮件服务中断", users["alice"])
+		newChild := func(number string) *ent.Ticket {
+			tk, err := client.Ticket.Create().SetTitle(number).SetDescription(number).SetStatus("open").SetPriority("low").
+				SetTicketNumber(number).SetRequesterID(users["bob"].ID).SetTenantID(tenant.ID).SetParentTicketID(parent.ID).Save(ctx)
+			require.NoError(t, err)
+			return tk
+		}
+		sub := newChild("CHILD-2")
+		sibling := newChild("CHILD-3")
+
+		_, err := svc.MergeTickets(ctx, sub.ID, &dto.MergeTicketsRequest{DuplicateIDs: []int{parent.ID}}, tenant.ID, users["admin"].ID, "admin")
+		require.NoError(t, err)
+		reloaded, err := client.Ticket.Get(ctx, sub.ID)
+		require.NoError(t, err)
+		assert.Equal(t, parent.ID, reloaded.ParentTicketID)
+		moved, err := client.Ticket.Get(ctx, sibling.ID)
+		require.NoError(t, err)
+		assert.Equal(t, sub.ID, moved.ParentTicketID)
+	})
+}
+
+func TestSplitTicket(t *testing.T) {
+	client, ctx, svc, tenant, users := newMergeTestFixture(t)
+
+	created, err := svc.CreateTicket(ctx, &dto.CreateTicketRequest{
+		Title: "新员工入职", Description: "需要电脑和门禁", Priority: "medium", Type: "service_request",
+		RequesterID: users["alice"].ID, AssigneeID: users["agent"].ID,
+		FormFields: map[string]interface{}{"laptop": "MacBook Pro", "badge_floor": "5F", "start_date": "2026-11-01"},
+	}, tenant.ID)
+	require.NoError(t, err)
+	first, err := client.TicketComment.Create().SetTicketID(created.ID).SetUserID(users["alice"].ID).SetContent("电脑需要预装开发环境").
+		SetTenantID(tenant.ID).SetCreatedAt(time.Now().Add(-2 * time.Hour)).Save(ctx)
+	require.NoError(t, err)
+	second, err := client.TicketComment.Create().SetTicketID(created.ID).SetUserID(users["agent"].ID).SetContent("已确认型号").SetIsInternal(true).
+		SetTenantID(tenant.ID).SetCreatedAt(time.Now().Add(-time.Hour)).Save(ctx)
+	require.NoError(t, err)
+
+	t.Run("来源内容校验失败时不创建子工单", func(t *testing.T) {
+		_, err := svc.SplitTicket(ctx, created.ID, &dto.SplitTicketRequest{Children: []dto.SplitTicketChild{
+			{Title: "电脑采购", CommentIDs: []int{first.ID}},
+			{Title: "门禁", FormFieldKeys: []string{"missing"}},
+		}}, tenant.ID, users["agent"].ID, "agent")
+		assert.ErrorContains(t, err, "missing")
+		var appErr *common.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, common.ErrCodeValidation, appErr.Code)
+		count, err := client.Ticket.Query().Where(entTicket.ParentTicketID(created.ID)).Count(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, count)
+
+		_, err = svc.SplitTicket(ctx, created.ID, &dto.SplitTicketRequest{Children: []dto.SplitTicketChild{
+			{Title: "电脑采购", CommentIDs: []int{first.ID}, AssigneeID: users["agent"].ID + 1000},
+		}}, tenant.ID, users["agent"].ID, "agent")
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, common.ErrCodeValidation, appErr.Code)
+	})
+
+	t.Run("中途失败时回滚已创建的子工单", func(t *testing.T) {
+		failCopy := true
+		client.TicketComment.Use(func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				if failCopy && m.Op().Is(ent.OpCreate) {
+					return nil, errors.New("comment store unavailable")
+				}
+				return next.Mutate(ctx, m)
+			})
+		})
+		defer func() { failCopy = false }()
+
+		children, err := svc.SplitTicket(ctx, created.ID, &dto.SplitTicketRequest{Children: []dto.SplitTicketChild{
+			{Title: "门禁开通", FormFieldKeys: []string{"badge_floor"}},
+			{Title: "电脑采购", CommentIDs: []int{first.ID}},
+		}}, tenant.ID, users["agent"].ID, "agent")
+		require.Error(t, err)
+		assert.Nil(t, children)
+		live, err := client.Ticket.Query().Where(entTicket.ParentTicketID(created.ID), entTicket.DeletedAtIsNil()).Count(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, live, "已创建的子工单被软删除")
+		records, err := client.TicketWorkflowRecord.Query().
+			Where(ticketworkflowrecord.TicketID(created.ID), ticketworkflowrecord.Action(string(dto.WorkflowActionSplit))).
+			Count(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, records)
+	})
+
+	t.Run("非处理人不能拆分", func(t *testing.T) {
+		_, err := svc.SplitTicket(ctx, created.ID, &dto.SplitTicketRequest{Children: []dto.SplitTicketChild{{Title: "电脑采购", CommentIDs: []int{first.ID}}}},
+			tenant.ID, users["bob"].ID, "end_user")
+		var appErr *common.AppError
+		require.ErrorAs(t, err, &appErr)
+	})
+
+	children, err := svc.SplitTicket(ctx, created.ID, &dto.SplitTicketRequest{Children: []dto.SplitTicketChild{
+		{Title: "电脑采购", CommentIDs: []int{second.ID, first.ID}, FormFieldKeys: []string{"laptop"}},
+		{Title: "门禁开通", FormFieldKeys: []string{"badge_floor", "start_date"}, Priority: "high"},
+	}}, tenant.ID, users["agent"].ID, "agent")
+	require.NoError(t, err)
+	require.Len(t, children, 2)
+
+	laptop, err := client.Ticket.Get(ctx, children[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, laptop.ParentTicketID)
+	assert.Equal(t, users["alice"].ID, laptop.RequesterID)
+	assert.Equal(t, "service_request", laptop.Type)
+	assert.Equal(t, "medium", laptop.Priority)
+	assert.Equal(t, "电脑需要预装开发环境\n\n已确认型号", laptop.Description, "按评论时间顺序拼接")
+	assert.Equal(t, map[string]interface{}{"laptop": "MacBook Pro"}, laptop.FormFields)
+	copied, err := client.TicketComment.Query().Where(ticketcomment.TicketID(laptop.ID)).Order(ent.Asc(ticketcomment.FieldCreatedAt)).All(ctx)
+	require.NoError(t, err)
+	require.Len(t, copied, 2)
+	assert.False(t, copied[0].IsInternal)
+	assert.True(t, copied[1].IsInternal, "内部备注复制后仍为内部")
+
+	badge, err := client.Ticket.Get(ctx, children[1].ID)
+	require.NoError(t, err)
+	assert.Equal(t, "high", badge.Priority)
+	assert.Equal(t, map[string]interface{}{"badge_floor": "5F", "start_date": "2026-11-01"}, badge.FormFields)
+
+	sourceComments, err := client.TicketComment.Query().Where(ticketcomment.TicketID(created.ID)).Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, sourceComments, "原工单评论保持不变")
+
+	sourceRecord, err := client.TicketWorkflowRecord.Query().
+		Where(ticketworkflowrecord.TicketID(created.ID), ticketworkflowrecord.Action(string(dto.WorkflowActionSplit))).
+		Only(ctx)
+	require.NoError(t, err)
+	assert.Len(t, sourceRecord.Metadata["child_ids"], 2)
+	childRecords, err := client.TicketWorkflowRecord.Query().
+		Where(ticketworkflowrecord.TicketIDIn(laptop.ID, badge.ID), ticketworkflowrecord.Action(string(dto.WorkflowActionSplit))).
+		Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, childRecords)
+}
@@ -0,0 +1,842 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"itsm-backend/common"
+	"itsm-backend/dto"
+	"itsm-backend/ent"
+	"itsm-backend/ent/predicate"
+	"itsm-backend/ent/ticket"
+	"itsm-backend/ent/tickettype"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+)
+
+// TicketQueryContext TQL 编译上下文：租户隔离以及 currentUser()、now() 的取值。
+// UserID 为 0 时（如自动化规则）不允许使用 currentUser()。
+type TicketQueryContext struct {
+	TenantID int
+	UserID   int
+	Now      time.Time
+}
+
+// TQL 字段类型
+const (
+	tqlKindText   = "text"   // 字符串，支持 ~ 包含匹配
+	tqlKindString = "string" // 字符串，仅精确匹配
+	tqlKindEnum   = "enum"   // 取值受限的字符串
+	tqlKindInt    = "int"
+	tqlKindNumber = "number"
+	tqlKindTime   = "time"
+	tqlKindBool   = "bool"
+)
+
+// tqlField 字段目录中的一个可查询字段
+type tqlField struct {
+	Name     string
+	Column   string
+	Kind     string
+	Nullable bool
+	Enum     []string
+	User     bool // 可与 currentUser() 比较
+	Sortable bool
+	Multi    bool                       // 多选自定义字段，= / IN 按包含匹配
+	Custom   *dto.CustomFieldDefinition // 非空表示 form_fields 中的自定义字段
+}
+
+// tqlPriorityRank 优先级排序权重，ORDER BY priority 按权重而非字典序
+var tqlPriorityRank = map[string]int{"low": 1, "medium": 2, "high": 3, "urgent": 4, "critical": 5}
+
+// ticketQueryFields 工单字段目录
+var ticketQueryFields = map[string]*tqlField{
+	"id":                {Column: ticket.FieldID, Kind: tqlKindInt, Sortable: true},
+	"number":            {Column: ticket.FieldTicketNumber, Kind: tqlKindText, Sortable: true},
+	"title":             {Column: ticket.FieldTitle, Kind: tqlKindText, Sortable: true},
+	"description":       {Column: ticket.FieldDescription, Kind: tqlKindText},
+	"text":              {Kind: tqlKindText}, // 标题或描述
+	"status":            {Column: ticket.FieldStatus, Kind: tqlKindEnum, Sortable: true, Enum: []string{common.TicketStatusNew, common.TicketStatusOpen, common.TicketStatusAssigned, common.TicketStatusInProgress, common.TicketStatusPending, common.TicketStatusPendingCustomer, common.TicketStatusPendingVendor, common.TicketStatusApproved, common.TicketStatusRejected, common.TicketStatusResolved, common.TicketStatusClosed, common.TicketStatusCancelled}},
+	"priority":          {Column: ticket.FieldPriority, Kind: tqlKindEnum, Sortable: true, Enum: []string{"low", "medium", "high", "urgent", "critical"}},
+	"type":              {Column: ticket.FieldType, Kind: tqlKindString, Sortable: true},
+	"ticket_type":       {Column: ticket.FieldTicketTypeID, Kind: tqlKindInt, Nullable: true},
+	"assignee":          {Column: ticket.FieldAssigneeID, Kind: tqlKindInt, Nullable: true, User: true},
+	"requester":         {Column: ticket.FieldRequesterID, Kind: tqlKindInt, User: true},
+	"category":          {Column: ticket.FieldCategoryID, Kind: tqlKindInt, Nullable: true},
+	"department":        {Column: ticket.FieldDepartmentID, Kind: tqlKindInt, Nullable: true},
+	"parent":            {Column: ticket.FieldParentTicketID, Kind: tqlKindInt, Nullable: true},
+	"vendor":            {Column: ticket.FieldVendorID, Kind: tqlKindInt, Nullable: true},
+	"sla":               {Column: ticket.FieldSLADefinitionID, Kind: tqlKindInt, Nullable: true},
+	"sla.response":      {Column: ticket.FieldSLAResponseDeadline, Kind: tqlKindTime, Nullable: true, Sortable: true},
+	"sla.resolution":    {Column: ticket.FieldSLAResolutionDeadline, Kind: tqlKindTime, Nullable: true, Sortable: true},
+	"sla.paused":        {Column: ticket.FieldSLAPausedAt, Kind: tqlKindBool},
+	"on_hold":           {Column: ticket.FieldSLAOnHold, Kind: tqlKindBool},
+	"rating":            {Column: ticket.FieldRating, Kind: tqlKindInt, Nullable: true},
+	"created_at":        {Column: ticket.FieldCreatedAt, Kind: tqlKindTime, Sortable: true},
+	"updated_at":        {Column: ticket.FieldUpdatedAt, Kind: tqlKindTime, Sortable: true},
+	"first_response_at": {Column: ticket.FieldFirstResponseAt, Kind: tqlKindTime, Nullable: true},
+	"resolved_at":       {Column: ticket.FieldResolvedAt, Kind: tqlKindTime, Nullable: true},
+	"closed_at":         {Column: ticket.FieldClosedAt, Kind: tqlKindTime, Nullable: true},
+}
+
+// ticketQueryAliases 字段别名，兼容按数据库列名书写
+var ticketQueryAliases = map[string]string{
+	"ticket_number":           "number",
+	"ticket_type_id":          "ticket_type",
+	"assignee_id":             "assignee",
+	"requester_id":            "requester",
+	"category_id":             "category",
+	"department_id":           "department",
+	"parent_ticket_id":        "parent",
+	"vendor_id":               "vendor",
+	"sla_definition_id":       "sla",
+	"sla_response_deadline":   "sla.response",
+	"sla_resolution_deadline": "sla.resolution",
+	"sla_on_hold":             "on_hold",
+}
+
+func init() {
+	for name, field := range ticketQueryFields {
+		field.Name = name
+	}
+}
+
+// isTQLCustomFieldPrefix 自定义字段前缀：cf.<name> 或 form_fields.<name>
+func isTQLCustomFieldPrefix(prefix string) bool {
+	return prefix == "cf" || prefix == "form_fields"
+}
+
+// TicketQueryFieldNames 返回内置可查询字段名（不含别名与自定义字段）
+func TicketQueryFieldNames() []string {
+	names := make([]string, 0, len(ticketQueryFields))
+	for name := range ticketQueryFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// tqlSortKey 编译后的排序键
+type tqlSortKey struct {
+	Field *tqlField
+	Desc  bool
+}
+
+// compiledTicketQuery 编译后的查询：Predicate 已包含租户隔离与软删除过滤
+type compiledTicketQuery struct {
+	Query     *TicketQuery
+	Predicate predicate.Ticket
+	Orders    []tqlSortKey
+}
+
+// TicketQueryError 查询语法或校验错误，调用方据此返回参数错误而非内部错误
+type TicketQueryError struct {
+	Err error
+}
+
+func (e *TicketQueryError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *TicketQueryError) Unwrap() error {
+	return e.Err
+}
+
+// IsTicketQueryError 判断是否为查询语法或校验错误
+func IsTicketQueryError(err error) bool {
+	var queryErr *TicketQueryError
+	return errors.As(err, &queryErr)
+}
+
+// ticketQueryCompiler 将语法树校验并编译为 ent 谓词
+type ticketQueryCompiler struct {
+	client  *ent.Client
+	qctx    TicketQueryContext
+	custom  map[string]*tqlField // 惰性加载的自定义字段目录
+	loadErr error                // 加载自定义字段失败，属于内部错误
+}
+
+// compileTicketQuery 解析并编译 TQL 查询，校验字段、运算符与取值
+func compileTicketQuery(ctx context.Context, client *ent.Client, text string, qctx TicketQueryContext) (*compiledTicketQuery, error) {
+	query, err := ParseTicketQuery(text)
+	if err != nil {
+		return nil, &TicketQueryError{Err: err}
+	}
+	return compileParsedTicketQuery(ctx, client, query, qctx)
+}
+
+func compileParsedTicketQuery(ctx context.Context, client *ent.Client, query *TicketQuery, qctx TicketQueryContext) (*compiledTicketQuery, error) {
+	if qctx.TenantID <= 0 {
+		return nil, &TicketQueryError{Err: fmt.Errorf("租户ID无效")}
+	}
+	if qctx.Now.IsZero() {
+		qctx.Now = time.Now()
+	}
+	c := &ticketQueryCompiler{client: client, qctx: qctx}
+	preds := []predicate.Ticket{ticket.TenantID(qctx.TenantID), ticket.DeletedAtIsNil()}
+	if query.Where != nil {
+		where, err := c.compileExpr(ctx, query.Where)
+		if err != nil {
+			if c.loadErr != nil {
+				return nil, err
+			}
+			return nil, &TicketQueryError{Err: err}
+		}
+		preds = append(preds, where)
+	}
+	orders, err := c.compileOrders(query.OrderBy)
+	if err != nil {
+		return nil, &TicketQueryError{Err: err}
+	}
+	return &compiledTicketQuery{Query: query, Predicate: ticket.And(preds...), Orders: orders}, nil
+}
+
+func (c *ticketQueryCompiler) compileExpr(ctx context.Context, expr tqlExpr) (predicate.Ticket, error) {
+	switch node := expr.(type) {
+	case *tqlLogical:
+		left, err := c.compileExpr(ctx, node.Left)
+		if err != nil {
+			return nil, err
+		}
+		right, err := c.compileExpr(ctx, node.Right)
+		if err != nil {
+			return nil, err
+		}
+		if node.Op == "or" {
+			return ticket.Or(left, right), nil
+		}
+		return ticket.And(left, right), nil
+	case *tqlNot:
+		inner, err := c.compileExpr(ctx, node.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return ticket.Not(inner), nil
+	case *tqlCompare:
+		return c.compileCompare(ctx, node)
+	default:
+		return nil, fmt.Errorf("不支持的查询节点 %T", expr)
+	}
+}
+
+// resolveField 在字段目录中查找字段，cf.<name> 按租户工单类型的自定义字段解析
+func (c *ticketQueryCompiler) resolveField(ctx context.Context, name string) (*tqlField, error) {
+	if alias, ok := ticketQueryAliases[name]; ok {
+		name = alias
+	}
+	if field, ok := ticketQueryFields[name]; ok {
+		return field, nil
+	}
+	prefix, customName, found := strings.Cut(name, ".")
+	if !found || !isTQLCustomFieldPrefix(prefix) || customName == "" {
+		return nil, fmt.Errorf("未知字段 %s", name)
+	}
+	if c.custom == nil {
+		custom, err := loadTicketQueryCustomFields(ctx, c.client, c.qctx.TenantID)
+		if err != nil {
+			c.loadErr = err
+			return nil, err
+		}
+		c.custom = custom
+	}
+	field, ok := c.custom[customName]
+	if !ok {
+		return nil, fmt.Errorf("未知的自定义字段 %s", customName)
+	}
+	return field, nil
+}
+
+// loadTicketQueryCustomFields 汇总租户所有工单类型的自定义字段；
+// 同名字段在不同类型中定义不一致时退化为文本字段
+func loadTicketQueryCustomFields(ctx context.Context, client *ent.Client, tenantID int) (map[string]*tqlField, error) {
+	result := make(map[string]*tqlField)
+	if client == nil {
+		return result, nil
+	}
+	types, err := client.TicketType.Query().
+		Where(tickettype.TenantIDEQ(int64(tenantID))).
+		Select(tickettype.FieldCustomFields).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("加载自定义字段失败: %w", err)
+	}
+	for _, tt := range types {
+		for _, def := range convertCustomFields(tt.CustomFields) {
+			if def.Name == "" {
+				continue
+			}
+			field := customTicketQueryField(def)
+			if existing, ok := result[def.Name]; ok {
+				if existing.Kind != field.Kind || existing.Multi != field.Multi {
+					existing.Kind, existing.Multi, existing.Enum = tqlKindText, false, nil
+				} else if existing.Kind == tqlKindEnum {
+					existing.Enum = append(existing.Enum, field.Enum...)
+				}
+				continue
+			}
+			result[def.Name] = field
+		}
+	}
+	return result, nil
+}
+
+// customTicketQueryField 按自定义字段类型确定查询语义
+func customTicketQueryField(def dto.CustomFieldDefinition) *tqlField {
+	d := def
+	field := &tqlField{Name: "cf." + def.Name, Column: ticket.FieldFormFields, Nullable: true, Custom: &d}
+	switch def.Type {
+	case dto.CustomFieldTypeNumber:
+		field.Kind = tqlKindNumber
+	case dto.CustomFieldTypeBoolean, dto.CustomFieldTypeCheckbox:
+		field.Kind = tqlKindBool
+	case dto.CustomFieldTypeDate, dto.CustomFieldTypeDatetime:
+		field.Kind = tqlKindTime
+	case dto.CustomFieldTypeUser, dto.CustomFieldTypeUserPicker:
+		field.Kind, field.User = tqlKindInt, true
+	case dto.CustomFieldTypeDepartment, dto.CustomFieldTypeDepartmentPicker, dto.CustomFieldTypeCI:
+		field.Kind = tqlKindInt
+	case dto.CustomFieldTypeSelect, dto.CustomFieldTypeRadio, dto.CustomFieldTypeMultiSelect:
+		field.Kind = tqlKindEnum
+		field.Multi = def.Type == dto.CustomFieldTypeMultiSelect
+		for _, opt := range def.Options {
+			field.Enum = append(field.Enum, fmt.Sprint(opt.Value))
+		}
+	default:
+		field.Kind = tqlKindText
+	}
+	return field
+}
+
+// tqlOperatorAllowed 校验字段类型是否支持运算符
+func tqlOperatorAllowed(field *tqlField, op string) bool {
+	if field.Name == "text" {
+		return op == tqlOpContains || op == tqlOpNotContains
+	}
+	switch op {
+	case tqlOpContains, tqlOpNotContains:
+		return field.Kind == tqlKindText
+	case tqlOpGT, tqlOpGTE, tqlOpLT, tqlOpLTE:
+		return field.Kind == tqlKindInt || field.Kind == tqlKindNumber || field.Kind == tqlKindTime
+	case tqlOpEmpty, tqlOpNotEmpty:
+		return field.Nullable || field.Kind == tqlKindText || field.Kind == tqlKindString
+	case tqlOpIn, tqlOpNotIn:
+		return field.Kind != tqlKindBool
+	default:
+		return true
+	}
+}
+
+func (c *ticketQueryCompiler) compileCompare(ctx context.Context, cmp *tqlCompare) (predicate.Ticket, error) {
+	field, err := c.resolveField(ctx, cmp.Field)
+	if err != nil {
+		return nil, err
+	}
+	if !tqlOperatorAllowed(field, cmp.Op) {
+		return nil, fmt.Errorf("字段 %s 不支持运算符 %s", cmp.Field, strings.ToUpper(cmp.Op))
+	}
+	values := make([]interface{}, 0, len(cmp.Values))
+	for _, v := range cmp.Values {
+		converted, err := c.convertValue(field, v)
+		if err != nil {
+			return nil, fmt.Errorf("字段 %s: %w", cmp.Field, err)
+		}
+		values = append(values, converted)
+	}
+	if field.Custom != nil {
+		return compileCustomFieldCompare(field, cmp.Op, values), nil
+	}
+	switch field.Name {
+	case "text":
+		sub := values[0].(string)
+		matched := ticket.Or(ticket.TitleContainsFold(sub), ticket.DescriptionContainsFold(sub))
+		if cmp.Op == tqlOpNotContains {
+			return ticket.Not(matched), nil
+		}
+		return matched, nil
+	case "sla.paused":
+		paused := values[0].(bool)
+		if cmp.Op == tqlOpNEQ {
+			paused = !paused
+		}
+		if paused {
+			return ticket.SLAPausedAtNotNil(), nil
+		}
+		return ticket.SLAPausedAtIsNil(), nil
+	}
+	return compileColumnCompare(field, cmp.Op, values), nil
+}
+
+// compileColumnCompare 内置字段比较
+func compileColumnCompare(field *tqlField, op string, values []interface{}) predicate.Ticket {
+	col := field.Column
+	switch op {
+	case tqlOpEQ:
+		return predicate.Ticket(sql.FieldEQ(col, values[0]))
+	case tqlOpNEQ:
+		return predicate.Ticket(sql.FieldNEQ(col, values[0]))
+	case tqlOpGT:
+		return predicate.Ticket(sql.FieldGT(col, values[0]))
+	case tqlOpGTE:
+		return predicate.Ticket(sql.FieldGTE(col, values[0]))
+	case tqlOpLT:
+		return predicate.Ticket(sql.FieldLT(col, values[0]))
+	case tqlOpLTE:
+		return predicate.Ticket(sql.FieldLTE(col, values[0]))
+	case tqlOpContains:
+		return predicate.Ticket(sql.FieldContainsFold(col, values[0].(string)))
+	case tqlOpNotContains:
+		return ticket.Not(predicate.Ticket(sql.FieldContainsFold(col, values[0].(string))))
+	case tqlOpIn:
+		return predicate.Ticket(sql.FieldIn(col, values...))
+	case tqlOpNotIn:
+		return predicate.Ticket(sql.FieldNotIn(col, values...))
+	case tqlOpEmpty:
+		return columnEmpty(field)
+	default: // tqlOpNotEmpty
+		return ticket.Not(columnEmpty(field))
+	}
+}
+
+// columnEmpty 空值判断：可空整型字段历史数据可能以 0 表示未设置，字符串以空串表示
+func columnEmpty(field *tqlField) predicate.Ticket {
+	switch field.Kind {
+	case tqlKindInt:
+		if field.Nullable {
+			return ticket.Or(predicate.Ticket(sql.FieldIsNull(field.Column)), predicate.Ticket(sql.FieldEQ(field.Column, 0)))
+		}
+	case tqlKindText, tqlKindString:
+		if field.Nullable {
+			return ticket.Or(predicate.Ticket(sql.FieldIsNull(field.Column)), predicate.Ticket(sql.FieldEQ(field.Column, "")))
+		}
+		return predicate.Ticket(sql.FieldEQ(field.Column, ""))
+	}
+	return predicate.Ticket(sql.FieldIsNull(field.Column))
+}
+
+// compileCustomFieldCompare 自定义字段比较，下推为 form_fields 上的 JSON 谓词
+func compileCustomFieldCompare(field *tqlField, op string, values []interface{}) predicate.Ticket {
+	path := sqljson.Path(field.Custom.Name)
+	col := ticket.FieldFormFields
+	equal := func(v interface{}) *sql.Predicate {
+		if field.Multi {
+			return sqljson.ValueContains(col, v, path)
+		}
+		return sqljson.ValueEQ(col, v, path)
+	}
+	in := func() *sql.Predicate {
+		preds := make([]*sql.Predicate, 0, len(values))
+		for _, v := range values {
+			preds = append(preds, equal(v))
+		}
+		return sql.Or(preds...)
+	}
+	empty := func() *sql.Predicate {
+		return sql.Or(sql.Not(sqljson.HasKey(col, path)), sqljson.ValueIsNull(col, path), sqljson.ValueEQ(col, "", path))
+	}
+	return func(s *sql.Selector) {
+		switch op {
+		case tqlOpEQ:
+			s.Where(equal(values[0]))
+		case tqlOpNEQ:
+			s.Where(sql.And(sqljson.HasKey(col, path), sql.Not(equal(values[0]))))
+		case tqlOpGT:
+			s.Where(sqljson.ValueGT(col, values[0], path))
+		case tqlOpGTE:
+			s.Where(sqljson.ValueGTE(col, values[0], path))
+		case tqlOpLT:
+			s.Where(sqljson.ValueLT(col, values[0], path))
+		case tqlOpLTE:
+			s.Where(sqljson.ValueLTE(col, values[0], path))
+		case tqlOpContains:
+			s.Where(sqljson.StringContains(col, values[0].(string), path))
+		case tqlOpNotContains:
+			s.Where(sql.And(sqljson.HasKey(col, path), sql.Not(sqljson.StringContains(col, values[0].(string), path))))
+		case tqlOpIn:
+			s.Where(in())
+		case tqlOpNotIn:
+			s.Where(sql.And(sqljson.HasKey(col, path), sql.Not(in())))
+		case tqlOpEmpty:
+			s.Where(empty())
+		case tqlOpNotEmpty:
+			s.Where(sql.Not(empty()))
+		}
+	}
+}
+
+// convertValue 按字段类型校验并转换比较值
+func (c *ticketQueryCompiler) convertValue(field *tqlField, v tqlValue) (interface{}, error) {
+	if v.Kind == tqlValueFunc {
+		return c.evalFunc(field, v)
+	}
+	switch field.Kind {
+	case tqlKindText, tqlKindString:
+		if v.Kind == tqlValueBool {
+			return nil, fmt.Errorf("应为字符串，实际为 %s", v)
+		}
+		return v.String(), nil
+	case tqlKindEnum:
+		value := v.String()
+		for _, allowed := range field.Enum {
+			if strings.EqualFold(allowed, value) {
+				return allowed, nil
+			}
+		}
+		return nil, fmt.Errorf("无效的取值 %s，可选值: %s", value, strings.Join(field.Enum, ", "))
+	case tqlKindInt:
+		n, err := strconv.Atoi(v.String())
+		if err != nil || v.Kind == tqlValueBool {
+			return nil, fmt.Errorf("应为整数，实际为 %s", v)
+		}
+		return n, nil
+	case tqlKindNumber:
+		n, err := strconv.ParseFloat(v.String(), 64)
+		if err != nil || v.Kind == tqlValueBool || math.IsNaN(n) || math.IsInf(n, 0) {
+			return nil, fmt.Errorf("应为数字，实际为 %s", v)
+		}
+		return n, nil
+	case tqlKindBool:
+		if v.Kind != tqlValueBool {
+			return nil, fmt.Errorf("应为 true 或 false，实际为 %s", v)
+		}
+		return v.Bool, nil
+	case tqlKindTime:
+		if v.Kind != tqlValueString {
+			return nil, fmt.Errorf("应为时间，如 '2026-01-02' 或 now()-1d，实际为 %s", v)
+		}
+		t, err := parseTQLTime(v.Str, c.qctx.Now.Location())
+		if err != nil {
+			return nil, err
+		}
+		return c.timeValue(field, t), nil
+	}
+	return nil, fmt.Errorf("不支持的字段类型 %s", field.Kind)
+}
+
+// evalFunc 求值 now()、startOfDay()、currentUser()
+func (c *ticketQueryCompiler) evalFunc(field *tqlField, v tqlValue) (interface{}, error) {
+	switch strings.ToLower(v.Func) {
+	case "now", "startofday":
+		if field.Kind != tqlKindTime {
+			return nil, fmt.Errorf("%s() 只能用于时间字段", v.Func)
+		}
+		t := c.qctx.Now
+		if strings.EqualFold(v.Func, "startOfDay") {
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+		}
+		return c.timeValue(field, t.Add(v.Offset)), nil
+	case "currentuser":
+		if !field.User {
+			return nil, fmt.Errorf("currentUser() 只能用于人员字段")
+		}
+		if v.Offset != 0 {
+			return nil, fmt.Errorf("currentUser() 不支持时长偏移")
+		}
+		if c.qctx.UserID <= 0 {
+			return nil, fmt.Errorf("currentUser() 在当前上下文不可用")
+		}
+		return c.qctx.UserID, nil
+	default:
+		return nil, fmt.Errorf("未知函数 %s()", v.Func)
+	}
+}
+
+// timeValue 自定义日期字段在 form_fields 中以字符串保存，按字段类型格式化以便比较
+func (c *ticketQueryCompiler) timeValue(field *tqlField, t time.Time) interface{} {
+	if field.Custom == nil {
+		return t
+	}
+	if field.Custom.Type == dto.CustomFieldTypeDate {
+		return t.Format("2006-01-02")
+	}
+	return t.Format(time.RFC3339)
+}
+
+// parseTQLTime 解析时间字面量，无时区的写法按查询上下文所在时区解释
+func parseTQLTime(text string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, text); err == nil {
+		return t, nil
+	}
+	for _, layout := range []string{"2006-01-02 15:04:05", "2006-01-02 15:04", "2006-01-02T15:04", "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, text, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("无效的时间 %q", text)
+}
+
+// compileOrders 校验 ORDER BY，默认按创建时间倒序，并追加 ID 作为游标分页的唯一排序键
+func (c *ticketQueryCompiler) compileOrders(orders []TicketQueryOrder) ([]tqlSortKey, error) {
+	if len(orders) == 0 {
+		orders = []TicketQueryOrder{{Field: "created_at", Desc: true}}
+	}
+	keys := make([]tqlSortKey, 0, len(orders)+1)
+	seen := make(map[string]bool)
+	for _, order := range orders {
+		name := order.Field
+		if alias, ok := ticketQueryAliases[name]; ok {
+			name = alias
+		}
+		field, ok := ticketQueryFields[name]
+		if !ok || !field.Sortable {
+			return nil, fmt.Errorf("字段 %s 不支持排序", order.Field)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("排序字段 %s 重复", order.Field)
+		}
+		seen[name] = true
+		keys = append(keys, tqlSortKey{Field: field, Desc: order.Desc})
+	}
+	if !seen["id"] {
+		keys = append(keys, tqlSortKey{Field: ticketQueryFields["id"], Desc: keys[len(keys)-1].Desc})
+	}
+	return keys, nil
+}
+
+// expr 排序键的 SQL 表达式，优先级按权重排序
+func (k tqlSortKey) expr(s *sql.Selector) string {
+	col := s.C(k.Field.Column)
+	if k.Field.Name != "priority" {
+		return col
+	}
+	var b strings.Builder
+	b.WriteString("CASE " + col)
+	for _, name := range []string{"low", "medium", "high", "urgent", "critical"} {
+		fmt.Fprintf(&b, " WHEN '%s' THEN %d", name, tqlPriorityRank[name])
+	}
+	b.WriteString(" ELSE 0 END")
+	return b.String()
+}
+
+// value 取工单在排序键上的值，可空字段未设置时返回 nil
+func (k tqlSortKey) value(t *ent.Ticket) interface{} {
+	switch k.Field.Name {
+	case "id":
+		return t.ID
+	case "number":
+		return t.TicketNumber
+	case "title":
+		return t.Title
+	case "status":
+		return t.Status
+	case "priority":
+		return tqlPriorityRank[t.Priority]
+	case "type":
+		return t.Type
+	case "created_at":
+		return t.CreatedAt
+	case "updated_at":
+		return t.UpdatedAt
+	case "sla.response":
+		if t.SLAResponseDeadline.IsZero() {
+			return nil
+		}
+		return t.SLAResponseDeadline
+	case "sla.resolution":
+		if t.SLAResolutionDeadline.IsZero() {
+			return nil
+		}
+		return t.SLAResolutionDeadline
+	}
+	return nil
+}
+
+// restrictToRole 按角色收窄行级数据权限，与 ListTickets 一致：非全量数据角色仅可见本人提交或处理的工单
+func (q *compiledTicketQuery) restrictToRole(userID int, role string) {
+	if isTicketDataScopeAllRole(role) {
+		return
+	}
+	q.Predicate = ticket.And(q.Predicate, ticket.Or(ticket.RequesterID(userID), ticket.AssigneeID(userID)))
+}
+
+// applyOrder 应用排序；可空字段的空值始终排在最后
+func (q *compiledTicketQuery) applyOrder(query *ent.TicketQuery) *ent.TicketQuery {
+	return query.Order(func(s *sql.Selector) {
+		for _, key := range q.Orders {
+			expr := key.expr(s)
+			if key.Field.Nullable {
+				s.OrderExpr(sql.Expr(expr + " IS NULL"))
+			}
+			if key.Desc {
+				s.OrderExpr(sql.Expr(expr + " DESC"))
+			} else {
+				s.OrderExpr(sql.Expr(expr))
+			}
+		}
+	})
+}
+
+// ticketQueryCursor 游标内容：排序签名与上一页最后一行的排序键取值
+type ticketQueryCursor struct {
+	Order  string        `json:"o"`
+	Values []interface{} `json:"v"`
+}
+
+// orderSignature 排序签名，游标只能用于相同排序的查询
+func (q *compiledTicketQuery) orderSignature() string {
+	parts := make([]string, 0, len(q.Orders))
+	for _, key := range q.Orders {
+		dir := "asc"
+		if key.Desc {
+			dir = "desc"
+		}
+		parts = append(parts, key.Field.Name+" "+dir)
+	}
+	return strings.Join(parts, ",")
+}
+
+// encodeCursor 以最后一行生成下一页游标
+func (q *compiledTicketQuery) encodeCursor(last *ent.Ticket) (string, error) {
+	cursor := ticketQueryCursor{Order: q.orderSignature()}
+	for _, key := range q.Orders {
+		v := key.value(last)
+		if t, ok := v.(time.Time); ok {
+			v = t.Format(time.RFC3339Nano)
+		}
+		cursor.Values = append(cursor.Values, v)
+	}
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeCursor 解析游标并还原各排序键的取值类型
+func (q *compiledTicketQuery) decodeCursor(text string) ([]interface{}, error) {
+	data, err := base64.RawURLEncoding.DecodeString(text)
+	if err != nil {
+		return nil, fmt.Errorf("无效的分页游标")
+	}
+	var cursor ticketQueryCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || len(cursor.Values) != len(q.Orders) {
+		return nil, fmt.Errorf("无效的分页游标")
+	}
+	if cursor.Order != q.orderSignature() {
+		return nil, fmt.Errorf("分页游标与查询排序不一致")
+	}
+	values := make([]interface{}, len(cursor.Values))
+	for i, raw := range cursor.Values {
+		if raw == nil {
+			continue
+		}
+		key := q.Orders[i]
+		switch {
+		case key.Field.Kind == tqlKindTime:
+			s, _ := raw.(string)
+			t, err := time.Parse(time.RFC3339Nano, s)
+			if err != nil {
+				return nil, fmt.Errorf("无效的分页游标")
+			}
+			values[i] = t
+		case key.Field.Kind == tqlKindInt || key.Field.Name == "priority":
+			n, ok := raw.(float64)
+			if !ok {
+				return nil, fmt.Errorf("无效的分页游标")
+			}
+			values[i] = int(n)
+		default:
+			s, ok := raw.(string)
+			if !ok {
+				return nil, fmt.Errorf("无效的分页游标")
+			}
+			values[i] = s
+		}
+	}
+	return values, nil
+}
+
+// afterCursor 键集分页谓词：排在游标行之后的记录。
+// 对每个排序键 i：前 i-1 个键相等且第 i 个键更靠后；空值排在最后。
+func (q *compiledTicketQuery) afterCursor(values []interface{}) predicate.Ticket {
+	return func(s *sql.Selector) {
+		var branches []*sql.Predicate
+		var equal []*sql.Predicate
+		for i, key := range q.Orders {
+			expr := key.expr(s)
+			v := values[i]
+			var after *sql.Predicate
+			if v != nil {
+				op := ">"
+				if key.Desc {
+					op = "<"
+				}
+				after = sql.ExprP(fmt.Sprintf("%s %s ?", expr, op), v)
+				if key.Field.Nullable {
+					after = sql.Or(after, sql.ExprP(expr+" IS NULL"))
+				}
+			}
+			if after != nil {
+				branch := append(append([]*sql.Predicate{}, equal...), after)
+				branches = append(branches, sql.And(branch...))
+			}
+			if v == nil {
+				equal = append(equal, sql.ExprP(expr+" IS NULL"))
+			} else {
+				equal = append(equal, sql.ExprP(expr+" = ?", v))
+			}
+		}
+		if len(branches) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.Or(branches...))
+	}
+}
+
+// TicketQueryPage 查询结果页
+type TicketQueryPage struct {
+	Tickets    []*ent.Ticket
+	NextCursor string
+	HasMore    bool
+}
+
+// TQL 分页大小
+const (
+	defaultTicketQueryLimit = 50
+	maxTicketQueryLimit     = 500
+)
+
+// run 执行查询，cursor 为空时从第一页开始
+func (q *compiledTicketQuery) run(ctx context.Context, client *ent.Client, cursor string, limit int) (*TicketQueryPage, error) {
+	if limit <= 0 {
+		limit = defaultTicketQueryLimit
+	}
+	if limit > maxTicketQueryLimit {
+		limit = maxTicketQueryLimit
+	}
+	query := client.Ticket.Query().Where(q.Predicate)
+	if cursor != "" {
+		values, err := q.decodeCursor(cursor)
+		if err != nil {
+			return nil, &TicketQueryError{Err: err}
+		}
+		query = query.Where(q.afterCursor(values))
+	}
+	tickets, err := q.applyOrder(query).Limit(limit + 1).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("执行工单查询失败: %w", err)
+	}
+	page := &TicketQueryPage{Tickets: tickets}
+	if len(tickets) > limit {
+		page.Tickets = tickets[:limit]
+		page.HasMore = true
+		if page.NextCursor, err = q.encodeCursor(page.Tickets[limit-1]); err != nil {
+			return nil, err
+		}
+	}
+	return page, nil
+}
+
+// matches 判断单张工单是否满足查询条件（自动化规则条件使用）
+func (q *compiledTicketQuery) matches(ctx context.Context, client *ent.Client, ticketID int) (bool, error) {
+	return client.Ticket.Query().Where(q.Predicate, ticket.IDEQ(ticketID)).Exist(ctx)
+}
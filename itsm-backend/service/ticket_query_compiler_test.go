@@ -0,0 +1,284 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"itsm-backend/dto"
+	"itsm-backend/ent"
+	"itsm-backend/ent/enttest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestCompileTicketQuery(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", testDSN())
+	defer client.Close()
+	ctx := context.Background()
+
+	tenant, err := client.Tenant.Create().
+		SetName("Query Tenant").
+		SetCode("tql").
+		SetDomain("tql.com").
+		SetStatus("active").
+		Save(ctx)
+	require.NoError(t, err)
+	other, err := client.Tenant.Create().
+		SetName("Other Tenant").
+		SetCode("other").
+		SetDomain("other.com").
+		SetStatus("active").
+		Save(ctx)
+	require.NoError(t, err)
+
+	agent, err := client.User.Create().
+		SetUsername("agent").
+		SetEmail("agent@tql.com").
+		SetName("Agent").
+		SetPasswordHash("hashedpassword").
+		SetRole("agent").
+		SetActive(true).
+		SetTenantID(tenant.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	_, err = client.TicketType.Create().
+		SetCode("incident").
+		SetName("Incident").
+		SetDescription("Incident").
+		SetIcon("alert").
+		SetColor("red").
+		SetTenantID(int64(tenant.ID)).
+		SetCreatedBy(int64(agent.ID)).
+		SetCustomFields(map[string]interface{}{
+			"env": map[string]interface{}{
+				"name": "env", "label": "环境", "type": "select",
+				"options": []interface{}{
+					map[string]interface{}{"label": "生产", "value": "prod"},
+					map[string]interface{}{"label": "测试", "value": "test"},
+				},
+			},
+			"impact": map[string]interface{}{"name": "impact", "label": "影响用户数", "type": "number"},
+		}).
+		SetApprovalChain([]interface{}{}).
+		SetAssignmentRules([]interface{}{}).
+		SetNotificationConfig(map[string]interface{}{}).
+		SetPermissionConfig(map[string]interface{}{}).
+		SetCreatedAt(time.Now()).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	require.NoError(t, err)
+
+	now := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	create := func(tenantID int, number, priority string, assignee int, deadline time.Time, form map[string]interface{}) *ent.Ticket {
+		builder := client.Ticket.Create().
+			SetTitle("Ticket " + number).
+			SetDescription("description of " + number).
+			SetPriority(priority).
+			SetType("incident").
+			SetStatus("open").
+			SetTicketNumber(number).
+			SetTenantID(tenantID).
+			SetRequesterID(agent.ID).
+			SetCreatedAt(now.Add(-time.Hour)).
+			SetFormFields(form)
+		if assignee > 0 {
+			builder.SetAssigneeID(assignee)
+		}
+		if !deadline.IsZero() {
+			builder.SetSLAResolutionDeadline(deadline)
+		}
+		created, err := builder.Save(ctx)
+		require.NoError(t, err)
+		return created
+	}
+
+	urgentMine := create(tenant.ID, "TQL-001", "urgent", agent.ID, now.Add(time.Hour), map[string]interface{}{"env": "prod", "impact": 120})
+	highMineLater := create(tenant.ID, "TQL-002", "high", agent.ID, now.Add(5*time.Hour), map[string]interface{}{"env": "test", "impact": 3})
+	lowUnassigned := create(tenant.ID, "TQL-003", "low", 0, time.Time{}, map[string]interface{}{})
+	create(other.ID, "TQL-004", "urgent", agent.ID, now.Add(time.Hour), map[string]interface{}{"env": "prod"})
+
+	qctx := TicketQueryContext{TenantID: tenant.ID, UserID: agent.ID, Now: now}
+	ids := func(t *testing.T, text string) []int {
+		compiled, err := compileTicketQuery(ctx, client, text, qctx)
+		require.NoError(t, err)
+		page, err := compiled.run(ctx, client, "", 0)
+		require.NoError(t, err)
+		result := make([]int, 0, len(page.Tickets))
+		for _, tk := range page.Tickets {
+			result = append(result, tk.ID)
+		}
+		return result
+	}
+
+	t.Run("JQL 风格队列，按租户隔离", func(t *testing.T) {
+		got := ids(t, "priority in (high, urgent) AND assignee = currentUser() AND sla.resolution < now()+2h ORDER BY created_at DESC")
+		assert.Equal(t, []int{urgentMine.ID}, got)
+	})
+
+	t.Run("空值与取反", func(t *testing.T) {
+		assert.Equal(t, []int{lowUnassigned.ID}, ids(t, "assignee IS EMPTY"))
+		assert.ElementsMatch(t, []int{urgentMine.ID, highMineLater.ID}, ids(t, "NOT assignee IS EMPTY"))
+	})
+
+	t.Run("自定义字段", func(t *testing.T) {
+		assert.Equal(t, []int{urgentMine.ID}, ids(t, "cf.env = prod"))
+		assert.Equal(t, []int{highMineLater.ID}, ids(t, "form_fields.impact < 10"))
+	})
+
+	t.Run("按优先级权重排序", func(t *testing.T) {
+		assert.Equal(t, []int{urgentMine.ID, highMineLater.ID, lowUnassigned.ID}, ids(t, "ORDER BY priority DESC"))
+	})
+
+	t.Run("游标分页", func(t *testing.T) {
+		compiled, err := compileTicketQuery(ctx, client, "ORDER BY priority ASC", qctx)
+		require.NoError(t, err)
+		var seen []int
+		cursor := ""
+		for i := 0; i < 5; i++ {
+			page, err := compiled.run(ctx, client, cursor, 1)
+			require.NoError(t, err)
+			for _, tk := range page.Tickets {
+				seen = append(seen, tk.ID)
+			}
+			if !page.HasMore {
+				break
+			}
+			cursor = page.NextCursor
+		}
+		assert.Equal(t, []int{lowUnassigned.ID, highMineLater.ID, urgentMine.ID}, seen)
+
+		other, err := compileTicketQuery(ctx, client, "ORDER BY created_at", qctx)
+		require.NoError(t, err)
+		_, err = other.run(ctx, client, cursor, 1)
+		assert.True(t, IsTicketQueryError(err), "游标不能跨排序复用")
+	})
+
+	t.Run("校验错误", func(t *testing.T) {
+		cases := map[string]string{
+			"未知字段":           "severity = high",
+			"枚举取值无效":         "priority = blocker",
+			"未知自定义字段":        "cf.region = east",
+			"自定义选项无效":        "cf.env = staging",
+			"运算符不适用":         "priority ~ hi",
+			"currentUser 类型": "title = currentUser()",
+			"不可排序":           "ORDER BY description",
+		}
+		for name, text := range cases {
+			_, err := compileTicketQuery(ctx, client, text, qctx)
+			assert.True(t, IsTicketQueryError(err), "%s: %v", name, err)
+		}
+
+		_, err := compileTicketQuery(ctx, client, "assignee = currentUser()", TicketQueryContext{TenantID: tenant.ID})
+		assert.Error(t, err, "无执行人上下文时不允许 currentUser()")
+	})
+
+	t.Run("单工单匹配", func(t *testing.T) {
+		compiled, err := compileTicketQuery(ctx, client, fmt.Sprintf("priority = urgent AND number = '%s'", urgentMine.TicketNumber), qctx)
+		require.NoError(t, err)
+		matched, err := compiled.matches(ctx, client, urgentMine.ID)
+		require.NoError(t, err)
+		assert.True(t, matched)
+		matched, err = compiled.matches(ctx, client, highMineLater.ID)
+		require.NoError(t, err)
+		assert.False(t, matched)
+	})
+}
+
+func TestTicketQueryDataScope(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", testDSN())
+	defer client.Close()
+	ctx := context.Background()
+	logger := zaptest.NewLogger(t).Sugar()
+
+	tenant, err := client.Tenant.Create().
+		SetName("Scope Tenant").
+		SetCode("tql-scope").
+		SetDomain("scope.com").
+		SetStatus("active").
+		Save(ctx)
+	require.NoError(t, err)
+	newUser := func(name string) *ent.User {
+		u, err := client.User.Create().
+			SetUsername(name).
+			SetEmail(name + "@scope.com").
+			SetName(name).
+			SetPasswordHash("hashedpassword").
+			SetRole("end_user").
+			SetActive(true).
+			SetTenantID(tenant.ID).
+			Save(ctx)
+		require.NoError(t, err)
+		return u
+	}
+	alice, bob := newUser("alice"), newUser("bob")
+	create := func(number string, requester int) *ent.Ticket {
+		created, err := client.Ticket.Create().
+			SetTitle("Ticket " + number).
+			SetDescription("description of " + number).
+			SetPriority("medium").
+			SetType("incident").
+			SetStatus("open").
+			SetTicketNumber(number).
+			SetTenantID(tenant.ID).
+			SetRequesterID(requester).
+			Save(ctx)
+		require.NoError(t, err)
+		return created
+	}
+	mine := create("SCOPE-001", alice.ID)
+	theirs := create("SCOPE-002", bob.ID)
+
+	svc := NewTicketServiceForTest(client, logger)
+	views := NewTicketViewService(client, logger)
+	ids := func(page *TicketQueryPage) []int {
+		result := make([]int, 0, len(page.Tickets))
+		for _, tk := range page.Tickets {
+			result = append(result, tk.ID)
+		}
+		return result
+	}
+
+	t.Run("查询仅返回本人提交或处理的工单", func(t *testing.T) {
+		page, err := svc.QueryTickets(ctx, &dto.TicketQueryRequest{Query: "ORDER BY created_at"}, tenant.ID, alice.ID, "end_user")
+		require.NoError(t, err)
+		assert.Equal(t, []int{mine.ID}, ids(page))
+
+		page, err = svc.QueryTickets(ctx, &dto.TicketQueryRequest{Query: "ORDER BY created_at"}, tenant.ID, alice.ID, "admin")
+		require.NoError(t, err)
+		assert.Equal(t, []int{mine.ID, theirs.ID}, ids(page))
+	})
+
+	t.Run("导出不包含他人工单", func(t *testing.T) {
+		data, err := svc.ExportTicketsByQuery(ctx, tenant.ID, alice.ID, "end_user", "priority = medium", "json")
+		require.NoError(t, err)
+		assert.Contains(t, string(data), mine.TicketNumber)
+		assert.NotContains(t, string(data), theirs.TicketNumber)
+
+		data, err = svc.ExportTickets(ctx, tenant.ID, alice.ID, "end_user", map[string]interface{}{}, "json")
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), theirs.TicketNumber)
+	})
+
+	t.Run("共享视图按查看人收窄", func(t *testing.T) {
+		view, err := client.TicketView.Create().
+			SetName("全部工单").
+			SetQuery("ORDER BY created_at").
+			SetIsShared(true).
+			SetCreatedBy(bob.ID).
+			SetTenantID(tenant.ID).
+			Save(ctx)
+		require.NoError(t, err)
+
+		page, err := views.RunTicketView(ctx, view.ID, alice.ID, tenant.ID, "end_user", "", 0)
+		require.NoError(t, err)
+		assert.Equal(t, []int{mine.ID}, ids(page))
+
+		_, err = views.RunTicketView(ctx, view.ID+100, alice.ID, tenant.ID, "end_user", "", 0)
+		assert.True(t, ent.IsNotFound(err), "视图不存在应返回 NotFound: %v", err)
+	})
+}
@@ -0,0 +1,556 @@
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// 工单查询语言（TQL）
+//
+// 类 JQL 的工单查询语法，用于精确队列、保存视图、自动化规则条件与导出：
+//
+//	priority in (high, urgent) AND assignee = currentUser() AND sla.resolution < now()+2h ORDER BY created_at DESC
+//
+// 支持的语法：
+//   - 逻辑：AND、OR、NOT 与括号，优先级 NOT > AND > OR
+//   - 比较：=、!=、>、>=、<、<=
+//   - 集合：IN (a, b)、NOT IN (a, b)
+//   - 空值：IS EMPTY、IS NOT EMPTY（NULL 为 EMPTY 的同义词）
+//   - 文本包含：~（包含，不区分大小写）、!~（不包含）
+//   - 值：'字符串'、"字符串"、裸词（high）、数字、true/false
+//   - 函数：now()、startOfDay()、currentUser()，时间函数可加减时长，如 now()-7d、startOfDay()+9h
+//   - 时长单位：s、m、h、d、w
+//   - 排序：ORDER BY field [ASC|DESC], ...
+
+// tqlTokenKind 词法单元类型
+type tqlTokenKind int
+
+const (
+	tqlTokenEOF tqlTokenKind = iota
+	tqlTokenIdent
+	tqlTokenString
+	tqlTokenNumber
+	tqlTokenDuration
+	tqlTokenOperator
+	tqlTokenLParen
+	tqlTokenRParen
+	tqlTokenComma
+)
+
+// tqlToken 词法单元，Pos 为在查询文本中的字符偏移，用于错误定位
+type tqlToken struct {
+	Kind tqlTokenKind
+	Text string
+	Pos  int
+}
+
+// TQL 比较运算符
+const (
+	tqlOpEQ          = "="
+	tqlOpNEQ         = "!="
+	tqlOpGT          = ">"
+	tqlOpGTE         = ">="
+	tqlOpLT          = "<"
+	tqlOpLTE         = "<="
+	tqlOpContains    = "~"
+	tqlOpNotContains = "!~"
+	tqlOpIn          = "in"
+	tqlOpNotIn       = "not in"
+	tqlOpEmpty       = "is empty"
+	tqlOpNotEmpty    = "is not empty"
+)
+
+// TQL 值类型
+const (
+	tqlValueString = "string"
+	tqlValueNumber = "number"
+	tqlValueBool   = "bool"
+	tqlValueFunc   = "func"
+)
+
+// tqlValue 比较右侧的值；函数值的 Offset 为 now()+2h 中的时长偏移
+type tqlValue struct {
+	Kind   string
+	Str    string
+	Num    float64
+	Bool   bool
+	Func   string
+	Offset time.Duration
+	Pos    int
+}
+
+// String 返回值在查询中的书写形式，用于错误信息
+func (v tqlValue) String() string {
+	switch v.Kind {
+	case tqlValueNumber:
+		return strconv.FormatFloat(v.Num, 'f', -1, 64)
+	case tqlValueBool:
+		return strconv.FormatBool(v.Bool)
+	case tqlValueFunc:
+		if v.Offset != 0 {
+			sign := "+"
+			offset := v.Offset
+			if offset < 0 {
+				sign, offset = "-", -offset
+			}
+			return fmt.Sprintf("%s()%s%s", v.Func, sign, offset)
+		}
+		return v.Func + "()"
+	default:
+		return v.Str
+	}
+}
+
+// tqlExpr 查询条件语法树节点
+type tqlExpr interface {
+	tqlNode()
+}
+
+// tqlLogical AND / OR 节点
+type tqlLogical struct {
+	Op    string // and / or
+	Left  tqlExpr
+	Right tqlExpr
+}
+
+// tqlNot NOT 节点
+type tqlNot struct {
+	Expr tqlExpr
+}
+
+// tqlCompare 字段比较节点
+type tqlCompare struct {
+	Field  string
+	Op     string
+	Values []tqlValue
+	Pos    int
+}
+
+func (*tqlLogical) tqlNode() {}
+func (*tqlNot) tqlNode()     {}
+func (*tqlCompare) tqlNode() {}
+
+// TicketQueryOrder ORDER BY 子句中的一个排序键
+type TicketQueryOrder struct {
+	Field string
+	Desc  bool
+}
+
+// TicketQuery 解析后的工单查询，Where 为空表示不过滤
+type TicketQuery struct {
+	Text    string
+	Where   tqlExpr
+	OrderBy []TicketQueryOrder
+}
+
+// tqlKeywords 不能作为裸词值使用的保留字
+var tqlKeywords = map[string]bool{
+	"and": true, "or": true, "not": true, "in": true, "is": true,
+	"empty": true, "null": true, "order": true, "by": true,
+}
+
+// ParseTicketQuery 解析 TQL 查询文本，空文本返回不带条件的查询
+func ParseTicketQuery(text string) (*TicketQuery, error) {
+	tokens, err := tqlLex(text)
+	if err != nil {
+		return nil, err
+	}
+	p := &tqlParser{tokens: tokens}
+	query := &TicketQuery{Text: strings.TrimSpace(text)}
+	if !p.peekKeyword("order") && p.peek().Kind != tqlTokenEOF {
+		if query.Where, err = p.parseOr(); err != nil {
+			return nil, err
+		}
+	}
+	if p.peekKeyword("order") {
+		p.next()
+		if !p.peekKeyword("by") {
+			return nil, p.errorf(p.peek(), "ORDER 之后应为 BY")
+		}
+		p.next()
+		if query.OrderBy, err = p.parseOrderBy(); err != nil {
+			return nil, err
+		}
+	}
+	if tok := p.peek(); tok.Kind != tqlTokenEOF {
+		return nil, p.errorf(tok, "无法识别的内容 %q", tok.Text)
+	}
+	return query, nil
+}
+
+// tqlLex 将查询文本切分为词法单元
+func tqlLex(text string) ([]tqlToken, error) {
+	runes := []rune(text)
+	tokens := make([]tqlToken, 0, len(runes)/3+1)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, tqlToken{Kind: tqlTokenLParen, Text: "(", Pos: i})
+			i++
+		case r == ')':
+			tokens = append(tokens, tqlToken{Kind: tqlTokenRParen, Text: ")", Pos: i})
+			i++
+		case r == ',':
+			tokens = append(tokens, tqlToken{Kind: tqlTokenComma, Text: ",", Pos: i})
+			i++
+		case r == '\'' || r == '"':
+			start := i
+			var sb strings.Builder
+			i++
+			closed := false
+			for i < len(runes) {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					sb.WriteRune(runes[i+1])
+					i += 2
+					continue
+				}
+				if runes[i] == r {
+					closed = true
+					i++
+					break
+				}
+				sb.WriteRune(runes[i])
+				i++
+			}
+			if !closed {
+				return nil, fmt.Errorf("TQL 语法错误（位置 %d）: 字符串未闭合", start)
+			}
+			tokens = append(tokens, tqlToken{Kind: tqlTokenString, Text: sb.String(), Pos: start})
+		case strings.ContainsRune("=!<>~+-", r):
+			start := i
+			op := string(r)
+			if i+1 < len(runes) && ((r == '!' && (runes[i+1] == '=' || runes[i+1] == '~')) || ((r == '<' || r == '>') && runes[i+1] == '=')) {
+				op += string(runes[i+1])
+			}
+			if op == "!" {
+				return nil, fmt.Errorf("TQL 语法错误（位置 %d）: 无效的运算符 !", start)
+			}
+			i += len([]rune(op))
+			tokens = append(tokens, tqlToken{Kind: tqlTokenOperator, Text: op, Pos: start})
+		case unicode.IsDigit(r):
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			kind := tqlTokenNumber
+			if i < len(runes) && strings.ContainsRune("smhdw", runes[i]) && (i+1 == len(runes) || !tqlIdentRune(runes[i+1])) {
+				kind = tqlTokenDuration
+				i++
+			}
+			if i < len(runes) && tqlIdentRune(runes[i]) {
+				return nil, fmt.Errorf("TQL 语法错误（位置 %d）: 无效的数字 %q", start, string(runes[start:i+1]))
+			}
+			tokens = append(tokens, tqlToken{Kind: kind, Text: string(runes[start:i]), Pos: start})
+		case tqlIdentRune(r):
+			start := i
+			for i < len(runes) && (tqlIdentRune(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, tqlToken{Kind: tqlTokenIdent, Text: string(runes[start:i]), Pos: start})
+		default:
+			return nil, fmt.Errorf("TQL 语法错误（位置 %d）: 无法识别的字符 %q", i, string(r))
+		}
+	}
+	tokens = append(tokens, tqlToken{Kind: tqlTokenEOF, Pos: len(runes)})
+	return tokens, nil
+}
+
+// tqlIdentRune 标识符允许的字符（支持中文自定义字段名）
+func tqlIdentRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// tqlParser 递归下降语法分析器
+type tqlParser struct {
+	tokens []tqlToken
+	pos    int
+}
+
+func (p *tqlParser) peek() tqlToken {
+	return p.tokens[p.pos]
+}
+
+func (p *tqlParser) next() tqlToken {
+	tok := p.tokens[p.pos]
+	if tok.Kind != tqlTokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+// peekKeyword 判断下一个词法单元是否为指定关键字（不区分大小写）
+func (p *tqlParser) peekKeyword(keyword string) bool {
+	tok := p.peek()
+	return tok.Kind == tqlTokenIdent && strings.EqualFold(tok.Text, keyword)
+}
+
+func (p *tqlParser) errorf(tok tqlToken, format string, args ...interface{}) error {
+	return fmt.Errorf("TQL 语法错误（位置 %d）: %s", tok.Pos, fmt.Sprintf(format, args...))
+}
+
+// parseOr or_expr := and_expr { OR and_expr }
+func (p *tqlParser) parseOr() (tqlExpr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("or") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &tqlLogical{Op: "or", Left: left, Right: right}
+	}
+	return left, nil
+}
+
+// parseAnd and_expr := unary { AND unary }
+func (p *tqlParser) parseAnd() (tqlExpr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekKeyword("and") {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &tqlLogical{Op: "and", Left: left, Right: right}
+	}
+	return left, nil
+}
+
+// parseUnary unary := NOT unary | '(' or_expr ')' | comparison
+func (p *tqlParser) parseUnary() (tqlExpr, error) {
+	if p.peekKeyword("not") {
+		p.next()
+		inner, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &tqlNot{Expr: inner}, nil
+	}
+	if p.peek().Kind == tqlTokenLParen {
+		p.next()
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if tok := p.next(); tok.Kind != tqlTokenRParen {
+			return nil, p.errorf(tok, "缺少右括号")
+		}
+		return inner, nil
+	}
+	return p.parseComparison()
+}
+
+// parseComparison comparison := field op value | field [NOT] IN '(' value {, value} ')' | field IS [NOT] EMPTY
+func (p *tqlParser) parseComparison() (tqlExpr, error) {
+	fieldTok := p.next()
+	if fieldTok.Kind != tqlTokenIdent || tqlKeywords[strings.ToLower(fieldTok.Text)] {
+		return nil, p.errorf(fieldTok, "应为字段名，实际为 %q", fieldTok.Text)
+	}
+	cmp := &tqlCompare{Field: strings.ToLower(fieldTok.Text), Pos: fieldTok.Pos}
+	if strings.Contains(fieldTok.Text, ".") {
+		// 自定义字段名保留大小写，仅前缀归一化
+		prefix, name, _ := strings.Cut(fieldTok.Text, ".")
+		cmp.Field = strings.ToLower(prefix) + "." + name
+		if !isTQLCustomFieldPrefix(strings.ToLower(prefix)) {
+			cmp.Field = strings.ToLower(fieldTok.Text)
+		}
+	}
+
+	switch {
+	case p.peekKeyword("is"):
+		p.next()
+		cmp.Op = tqlOpEmpty
+		if p.peekKeyword("not") {
+			p.next()
+			cmp.Op = tqlOpNotEmpty
+		}
+		if !p.peekKeyword("empty") && !p.peekKeyword("null") {
+			return nil, p.errorf(p.peek(), "IS 之后应为 EMPTY 或 NOT EMPTY")
+		}
+		p.next()
+		return cmp, nil
+	case p.peekKeyword("in"), p.peekKeyword("not"):
+		cmp.Op = tqlOpIn
+		if p.peekKeyword("not") {
+			p.next()
+			if !p.peekKeyword("in") {
+				return nil, p.errorf(p.peek(), "NOT 之后应为 IN")
+			}
+			cmp.Op = tqlOpNotIn
+		}
+		p.next()
+		values, err := p.parseValueList()
+		if err != nil {
+			return nil, err
+		}
+		cmp.Values = values
+		return cmp, nil
+	}
+
+	opTok := p.next()
+	switch opTok.Text {
+	case tqlOpEQ, tqlOpNEQ, tqlOpGT, tqlOpGTE, tqlOpLT, tqlOpLTE, tqlOpContains, tqlOpNotContains:
+		if opTok.Kind != tqlTokenOperator {
+			return nil, p.errorf(opTok, "字段 %s 之后应为运算符", fieldTok.Text)
+		}
+		cmp.Op = opTok.Text
+	default:
+		return nil, p.errorf(opTok, "字段 %s 之后应为运算符，实际为 %q", fieldTok.Text, opTok.Text)
+	}
+	value, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	cmp.Values = []tqlValue{value}
+	return cmp, nil
+}
+
+// parseValueList '(' value {, value} ')'
+func (p *tqlParser) parseValueList() ([]tqlValue, error) {
+	if tok := p.next(); tok.Kind != tqlTokenLParen {
+		return nil, p.errorf(tok, "IN 之后应为括号列表")
+	}
+	var values []tqlValue
+	for {
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+		tok := p.next()
+		if tok.Kind == tqlTokenRParen {
+			return values, nil
+		}
+		if tok.Kind != tqlTokenComma {
+			return nil, p.errorf(tok, "值列表中应为逗号或右括号")
+		}
+	}
+}
+
+// parseValue value := string | number | ident | true | false | func '(' ')' [('+'|'-') duration]
+func (p *tqlParser) parseValue() (tqlValue, error) {
+	tok := p.next()
+	switch tok.Kind {
+	case tqlTokenString:
+		return tqlValue{Kind: tqlValueString, Str: tok.Text, Pos: tok.Pos}, nil
+	case tqlTokenNumber:
+		num, err := strconv.ParseFloat(tok.Text, 64)
+		if err != nil {
+			return tqlValue{}, p.errorf(tok, "无效的数字 %q", tok.Text)
+		}
+		return tqlValue{Kind: tqlValueNumber, Num: num, Str: tok.Text, Pos: tok.Pos}, nil
+	case tqlTokenOperator:
+		// 负数
+		if tok.Text == "-" && p.peek().Kind == tqlTokenNumber {
+			numTok := p.next()
+			num, err := strconv.ParseFloat(numTok.Text, 64)
+			if err != nil {
+				return tqlValue{}, p.errorf(numTok, "无效的数字 %q", numTok.Text)
+			}
+			return tqlValue{Kind: tqlValueNumber, Num: -num, Str: "-" + numTok.Text, Pos: tok.Pos}, nil
+		}
+	case tqlTokenIdent:
+		lower := strings.ToLower(tok.Text)
+		if p.peek().Kind == tqlTokenLParen {
+			p.next()
+			if closing := p.next(); closing.Kind != tqlTokenRParen {
+				return tqlValue{}, p.errorf(closing, "函数 %s 不接受参数", tok.Text)
+			}
+			value := tqlValue{Kind: tqlValueFunc, Func: tok.Text, Pos: tok.Pos}
+			if op := p.peek(); op.Kind == tqlTokenOperator && (op.Text == "+" || op.Text == "-") {
+				p.next()
+				durTok := p.next()
+				if durTok.Kind != tqlTokenDuration {
+					return tqlValue{}, p.errorf(durTok, "%s 之后应为时长，如 2h、30m、1d", op.Text)
+				}
+				offset, err := parseTQLDuration(durTok.Text)
+				if err != nil {
+					return tqlValue{}, p.errorf(durTok, "%v", err)
+				}
+				if op.Text == "-" {
+					offset = -offset
+				}
+				value.Offset = offset
+			}
+			return value, nil
+		}
+		if lower == "true" || lower == "false" {
+			return tqlValue{Kind: tqlValueBool, Bool: lower == "true", Str: lower, Pos: tok.Pos}, nil
+		}
+		if tqlKeywords[lower] {
+			return tqlValue{}, p.errorf(tok, "应为值，实际为关键字 %s", tok.Text)
+		}
+		return tqlValue{Kind: tqlValueString, Str: tok.Text, Pos: tok.Pos}, nil
+	}
+	if tok.Kind == tqlTokenEOF {
+		return tqlValue{}, p.errorf(tok, "查询意外结束，缺少值")
+	}
+	return tqlValue{}, p.errorf(tok, "应为值，实际为 %q", tok.Text)
+}
+
+// parseOrderBy order_by := field [ASC|DESC] {, field [ASC|DESC]}
+func (p *tqlParser) parseOrderBy() ([]TicketQueryOrder, error) {
+	var orders []TicketQueryOrder
+	for {
+		tok := p.next()
+		if tok.Kind != tqlTokenIdent || tqlKeywords[strings.ToLower(tok.Text)] {
+			return nil, p.errorf(tok, "ORDER BY 之后应为字段名")
+		}
+		order := TicketQueryOrder{Field: strings.ToLower(tok.Text)}
+		if p.peekKeyword("desc") {
+			p.next()
+			order.Desc = true
+		} else if p.peekKeyword("asc") {
+			p.next()
+		}
+		orders = append(orders, order)
+		if p.peek().Kind != tqlTokenComma {
+			return orders, nil
+		}
+		p.next()
+	}
+}
+
+// parseTQLDuration 解析 30m、2h、1d、1w 这类时长；d 按 24 小时、w 按 7 天计
+func parseTQLDuration(text string) (time.Duration, error) {
+	if len(text) < 2 {
+		return 0, fmt.Errorf("无效的时长 %q", text)
+	}
+	amount, err := strconv.ParseFloat(text[:len(text)-1], 64)
+	if err != nil {
+		return 0, fmt.Errorf("无效的时长 %q", text)
+	}
+	var unit time.Duration
+	switch text[len(text)-1] {
+	case 's':
+		unit = time.Second
+	case 'm':
+		unit = time.Minute
+	case 'h':
+		unit = time.Hour
+	case 'd':
+		unit = 24 * time.Hour
+	case 'w':
+		unit = 7 * 24 * time.Hour
+	default:
+		return 0, fmt.Errorf("无效的时长单位 %q", text)
+	}
+	return time.Duration(amount * float64(unit)), nil
+}
+
+// tqlQuote 将字符串转为 TQL 单引号字面量
+func tqlQuote(value string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, "'", `\'`).Replace(value) + "'"
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTicketQuery(t *testing.T) {
+	t.Run("JQL 风格示例", func(t *testing.T) {
+		query, err := ParseTicketQuery("priority in (high, urgent) AND assignee = currentUser() AND sla.resolution < now()+2h ORDER BY created_at DESC")
+		require.NoError(t, err)
+
+		and, ok := query.Where.(*tqlLogical)
+		require.True(t, ok)
+		assert.Equal(t, "and", and.Op)
+
+		sla, ok := and.Right.(*tqlCompare)
+		require.True(t, ok)
+		assert.Equal(t, "sla.resolution", sla.Field)
+		assert.Equal(t, tqlOpLT, sla.Op)
+		require.Len(t, sla.Values, 1)
+		assert.Equal(t, "now", sla.Values[0].Func)
+		assert.Equal(t, 2*time.Hour, sla.Values[0].Offset)
+
+		inner := and.Left.(*tqlLogical)
+		priority := inner.Left.(*tqlCompare)
+		assert.Equal(t, tqlOpIn, priority.Op)
+		assert.Len(t, priority.Values, 2)
+		assignee := inner.Right.(*tqlCompare)
+		assert.Equal(t, "currentUser", assignee.Values[0].Func)
+
+		assert.Equal(t, []TicketQueryOrder{{Field: "created_at", Desc: true}}, query.OrderBy)
+	})
+
+	t.Run("OR 优先级低于 AND，NOT 与括号", func(t *testing.T) {
+		query, err := ParseTicketQuery("status = open OR NOT (priority = low AND assignee IS EMPTY)")
+		require.NoError(t, err)
+		or := query.Where.(*tqlLogical)
+		assert.Equal(t, "or", or.Op)
+		not, ok := or.Right.(*tqlNot)
+		require.True(t, ok)
+		assert.Equal(t, "and", not.Expr.(*tqlLogical).Op)
+	})
+
+	t.Run("IS NOT EMPTY、NOT IN、包含与自定义字段", func(t *testing.T) {
+		query, err := ParseTicketQuery(`cf.Env NOT IN ('prod', "staging") and title ~ 'disk full' and vendor is not empty`)
+		require.NoError(t, err)
+		top := query.Where.(*tqlLogical)
+		left := top.Left.(*tqlLogical)
+		cf := left.Left.(*tqlCompare)
+		assert.Equal(t, "cf.Env", cf.Field, "自定义字段名保留大小写")
+		assert.Equal(t, tqlOpNotIn, cf.Op)
+		assert.Equal(t, tqlOpContains, left.Right.(*tqlCompare).Op)
+		assert.Equal(t, tqlOpNotEmpty, top.Right.(*tqlCompare).Op)
+	})
+
+	t.Run("仅排序", func(t *testing.T) {
+		query, err := ParseTicketQuery("ORDER BY priority DESC, id")
+		require.NoError(t, err)
+		assert.Nil(t, query.Where)
+		assert.Equal(t, []TicketQueryOrder{{Field: "priority", Desc: true}, {Field: "id"}}, query.OrderBy)
+	})
+
+	t.Run("空查询", func(t *testing.T) {
+		query, err := ParseTicketQuery("   ")
+		require.NoError(t, err)
+		assert.Nil(t, query.Where)
+		assert.Empty(t, query.OrderBy)
+	})
+
+	errorCases := map[string]string{
+		"字符串未闭合":       "title = 'abc",
+		"缺少值":          "priority =",
+		"缺少右括号":        "(priority = high",
+		"IS 后缺少 EMPTY": "assignee IS open",
+		"ORDER 后缺少 BY": "ORDER created_at",
+		"多余内容":         "priority = high high",
+		"时长无单位":        "created_at > now()-7",
+		"非法字符":         "priority = high;",
+	}
+	for name, text := range errorCases {
+		t.Run("错误/"+name, func(t *testing.T) {
+			_, err := ParseTicketQuery(text)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestTQLQuoteRoundTrip(t *testing.T) {
+	value := `it's a \ path`
+	query, err := ParseTicketQuery("title = " + tqlQuote(value))
+	require.NoError(t, err)
+	assert.Equal(t, value, query.Where.(*tqlCompare).Values[0].Str)
+}
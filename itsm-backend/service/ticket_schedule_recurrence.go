@@ -0,0 +1,547 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 周期表达式
+//
+// 周期工单计划支持两种表达式，均按计划的时区解释：
+//   - cron：标准 5 段（分 时 日 月 周），支持 *、范围、步长、列表与 JAN/MON 等名称，兼容 @daily 等宏；
+//     日与周同时受限时按 Vixie cron 语义取并集。
+//   - RRULE：RFC 5545 子集，FREQ=DAILY/WEEKLY/MONTHLY/YEARLY，支持 INTERVAL、COUNT、UNTIL、
+//     BYMONTH、BYMONTHDAY（负数从月末倒数）、BYDAY（可带序号，如 2TU、-1FR）、BYSETPOS、BYHOUR、BYMINUTE；
+//     DTSTART 取计划的生效时间。
+
+const (
+	ScheduleRuleCron  = "cron"
+	ScheduleRuleRRule = "rrule"
+
+	// maxRecurrenceScanDays 查找下一次发生时最多向后扫描的天数（覆盖 2 月 29 日等稀疏规则）
+	maxRecurrenceScanDays = 366 * 8
+	// maxRRuleCount RRULE COUNT 上限
+	maxRRuleCount = 10000
+)
+
+// scheduleRecurrence 周期表达式
+type scheduleRecurrence interface {
+	// Next 返回严格晚于 after 的下一次发生时刻，没有更多发生时返回 false
+	Next(after time.Time) (time.Time, bool)
+}
+
+// parseScheduleRecurrence 解析周期表达式；start 为 RRULE 的 DTSTART
+func parseScheduleRecurrence(ruleType, expression string, loc *time.Location, start time.Time) (scheduleRecurrence, error) {
+	switch ruleType {
+	case ScheduleRuleCron:
+		c, err := parseCronExpression(expression, loc)
+		if err != nil {
+			return nil, err
+		}
+		return c, nil
+	case ScheduleRuleRRule:
+		r, err := parseRRule(expression, loc, start)
+		if err != nil {
+			return nil, err
+		}
+		return r, nil
+	default:
+		return nil, fmt.Errorf("不支持的表达式类型: %s", ruleType)
+	}
+}
+
+// ==================== cron ====================
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var cronMonthNames = map[string]int{
+	"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+	"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+}
+
+var cronWeekdayNames = map[string]int{
+	"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+}
+
+// cronSchedule 解析后的 cron 表达式
+type cronSchedule struct {
+	loc      *time.Location
+	minutes  []int
+	hours    []int
+	days     []bool // 1-31
+	months   []bool // 1-12
+	weekdays []bool // 0-6，周日为 0
+	// dayRestricted / weekdayRestricted 日、周字段是否不以 * 开头，两者都受限时取并集
+	dayRestricted     bool
+	weekdayRestricted bool
+}
+
+// parseCronExpression 解析 5 段 cron 表达式
+func parseCronExpression(expression string, loc *time.Location) (*cronSchedule, error) {
+	expr := strings.TrimSpace(expression)
+	if macro, ok := cronMacros[strings.ToLower(expr)]; ok {
+		expr = macro
+	}
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron 表达式需要 5 段（分 时 日 月 周）: %q", expression)
+	}
+	minutes, err := parseCronField(fields[0], 0, 59, nil)
+	if err != nil {
+		return nil, fmt.Errorf("cron 分钟字段无效: %w", err)
+	}
+	hours, err := parseCronField(fields[1], 0, 23, nil)
+	if err != nil {
+		return nil, fmt.Errorf("cron 小时字段无效: %w", err)
+	}
+	days, err := parseCronField(fields[2], 1, 31, nil)
+	if err != nil {
+		return nil, fmt.Errorf("cron 日期字段无效: %w", err)
+	}
+	months, err := parseCronField(fields[3], 1, 12, cronMonthNames)
+	if err != nil {
+		return nil, fmt.Errorf("cron 月份字段无效: %w", err)
+	}
+	weekdays, err := parseCronField(fields[4], 0, 7, cronWeekdayNames)
+	if err != nil {
+		return nil, fmt.Errorf("cron 星期字段无效: %w", err)
+	}
+	// 7 与 0 均表示周日
+	if weekdays[7] {
+		weekdays[0] = true
+	}
+	c := &cronSchedule{
+		loc:               loc,
+		days:              days,
+		months:            months,
+		weekdays:          weekdays[:7],
+		dayRestricted:     !isCronWildcard(fields[2]),
+		weekdayRestricted: !isCronWildcard(fields[4]),
+	}
+	for minute, ok := range minutes {
+		if ok {
+			c.minutes = append(c.minutes, minute)
+		}
+	}
+	for hour, ok := range hours {
+		if ok {
+			c.hours = append(c.hours, hour)
+		}
+	}
+	return c, nil
+}
+
+func isCronWildcard(field string) bool {
+	return strings.HasPrefix(field, "*") || field == "?"
+}
+
+// parseCronField 解析单个 cron 字段，返回下标为取值的命中表
+func parseCronField(field string, min, max int, names map[string]int) ([]bool, error) {
+	set := make([]bool, max+1)
+	value := func(s string) (int, error) {
+		if n, ok := names[strings.ToUpper(s)]; ok {
+			return n, nil
+		}
+		n, err := strconv.Atoi(s)
+		if err != nil || n < min || n > max {
+			return 0, fmt.Errorf("取值 %q 超出范围 %d-%d", s, min, max)
+		}
+		return n, nil
+	}
+	for _, part := range strings.Split(field, ",") {
+		if part == "" {
+			return nil, fmt.Errorf("存在空的列表项: %q", field)
+		}
+		rangePart, step := part, 1
+		if idx := strings.Index(part, "/"); idx >= 0 {
+			n, err := strconv.Atoi(part[idx+1:])
+			if err != nil || n <= 0 {
+				return nil, fmt.Errorf("无效的步长: %q", part)
+			}
+			rangePart, step = part[:idx], n
+		}
+		lo, hi := min, max
+		switch {
+		case rangePart == "*" || rangePart == "?":
+		case strings.Contains(rangePart, "-"):
+			bounds := strings.SplitN(rangePart, "-", 2)
+			var err error
+			if lo, err = value(bounds[0]); err != nil {
+				return nil, err
+			}
+			if hi, err = value(bounds[1]); err != nil {
+				return nil, err
+			}
+			if lo > hi {
+				return nil, fmt.Errorf("范围起点大于终点: %q", part)
+			}
+		default:
+			n, err := value(rangePart)
+			if err != nil {
+				return nil, err
+			}
+			lo = n
+			if step == 1 {
+				hi = n
+			}
+		}
+		for n := lo; n <= hi; n += step {
+			set[n] = true
+		}
+	}
+	return set, nil
+}
+
+func (c *cronSchedule) matchDay(day time.Time) bool {
+	if !c.months[day.Month()] {
+		return false
+	}
+	dayMatch, weekdayMatch := c.days[day.Day()], c.weekdays[day.Weekday()]
+	if c.dayRestricted && c.weekdayRestricted {
+		return dayMatch || weekdayMatch
+	}
+	return dayMatch && weekdayMatch
+}
+
+// Next 逐日匹配日期字段，再在当天按时、分升序取第一个晚于 after 的时刻
+func (c *cronSchedule) Next(after time.Time) (time.Time, bool) {
+	local := after.In(c.loc)
+	y, m, d := local.Date()
+	for i := 0; i < maxRecurrenceScanDays; i++ {
+		day := time.Date(y, m, d+i, 0, 0, 0, 0, c.loc)
+		if !c.matchDay(day) {
+			continue
+		}
+		for _, hour := range c.hours {
+			for _, minute := range c.minutes {
+				t := time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, c.loc)
+				if t.After(after) {
+					return t, true
+				}
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// ==================== RRULE ====================
+
+var rruleWeekdayNames = map[string]time.Weekday{
+	"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday,
+	"TH": time.Thursday, "FR": time.Friday, "SA": time.Saturday,
+}
+
+// rruleWeekday BYDAY 项；ordinal 为 0 表示周期内每个该星期几
+type rruleWeekday struct {
+	weekday time.Weekday
+	ordinal int
+}
+
+// rruleSchedule 解析后的 RRULE
+type rruleSchedule struct {
+	loc        *time.Location
+	start      time.Time
+	freq       string
+	interval   int
+	count      int
+	until      time.Time
+	byMonth    []int
+	byMonthDay []int
+	byDay      []rruleWeekday
+	bySetPos   []int
+	byHour     []int
+	byMinute   []int
+}
+
+// parseRRule 解析 RRULE，可带 "RRULE:" 前缀
+func parseRRule(expression string, loc *time.Location, start time.Time) (*rruleSchedule, error) {
+	expr := strings.TrimSpace(expression)
+	if len(expr) >= 6 && strings.EqualFold(expr[:6], "RRULE:") {
+		expr = expr[6:]
+	}
+	r := &rruleSchedule{loc: loc, start: start.In(loc), interval: 1}
+	intList := func(key, value string, min, max int, allowNegative bool) ([]int, error) {
+		var out []int
+		for _, item := range strings.Split(value, ",") {
+			n, err := strconv.Atoi(item)
+			valid := err == nil && n >= min && n <= max
+			if allowNegative && err == nil && n < 0 && -n <= max {
+				valid = true
+			}
+			if !valid {
+				return nil, fmt.Errorf("RRULE %s 取值无效: %q", key, item)
+			}
+			out = append(out, n)
+		}
+		return out, nil
+	}
+	for _, part := range strings.Split(expr, ";") {
+		if part == "" {
+			continue
+		}
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 || kv[1] == "" {
+			return nil, fmt.Errorf("RRULE 片段无效: %q", part)
+		}
+		key, value := strings.ToUpper(kv[0]), strings.ToUpper(kv[1])
+		var err error
+		switch key {
+		case "FREQ":
+			switch value {
+			case "DAILY", "WEEKLY", "MONTHLY", "YEARLY":
+				r.freq = value
+			default:
+				return nil, fmt.Errorf("RRULE FREQ 仅支持 DAILY/WEEKLY/MONTHLY/YEARLY: %q", value)
+			}
+		case "INTERVAL":
+			if r.interval, err = strconv.Atoi(value); err != nil || r.interval <= 0 {
+				return nil, fmt.Errorf("RRULE INTERVAL 取值无效: %q", value)
+			}
+		case "COUNT":
+			if r.count, err = strconv.Atoi(value); err != nil || r.count <= 0 || r.count > maxRRuleCount {
+				return nil, fmt.Errorf("RRULE COUNT 取值无效: %q", value)
+			}
+		case "UNTIL":
+			if r.until, err = parseRRuleUntil(value, loc); err != nil {
+				return nil, err
+			}
+		case "BYMONTH":
+			if r.byMonth, err = intList(key, value, 1, 12, false); err != nil {
+				return nil, err
+			}
+		case "BYMONTHDAY":
+			if r.byMonthDay, err = intList(key, value, 1, 31, true); err != nil {
+				return nil, err
+			}
+		case "BYSETPOS":
+			if r.bySetPos, err = intList(key, value, 1, 366, true); err != nil {
+				return nil, err
+			}
+		case "BYHOUR":
+			if r.byHour, err = intList(key, value, 0, 23, false); err != nil {
+				return nil, err
+			}
+		case "BYMINUTE":
+			if r.byMinute, err = intList(key, value, 0, 59, false); err != nil {
+				return nil, err
+			}
+		case "BYDAY":
+			for _, item := range strings.Split(value, ",") {
+				if len(item) < 2 {
+					return nil, fmt.Errorf("RRULE BYDAY 取值无效: %q", item)
+				}
+				weekday, ok := rruleWeekdayNames[item[len(item)-2:]]
+				if !ok {
+					return nil, fmt.Errorf("RRULE BYDAY 取值无效: %q", item)
+				}
+				ordinal := 0
+				if prefix := item[:len(item)-2]; prefix != "" {
+					if ordinal, err = strconv.Atoi(prefix); err != nil || ordinal == 0 || ordinal > 53 || ordinal < -53 {
+						return nil, fmt.Errorf("RRULE BYDAY 序号无效: %q", item)
+					}
+				}
+				r.byDay = append(r.byDay, rruleWeekday{weekday: weekday, ordinal: ordinal})
+			}
+		case "WKST":
+			// 周期统一以周一为一周开始
+		default:
+			return nil, fmt.Errorf("不支持的 RRULE 属性: %s", key)
+		}
+	}
+	if r.freq == "" {
+		return nil, fmt.Errorf("RRULE 缺少 FREQ")
+	}
+	if r.count > 0 && !r.until.IsZero() {
+		return nil, fmt.Errorf("RRULE COUNT 与 UNTIL 不能同时使用")
+	}
+	for _, day := range r.byDay {
+		if day.ordinal != 0 && r.freq != "MONTHLY" && r.freq != "YEARLY" {
+			return nil, fmt.Errorf("RRULE BYDAY 序号仅适用于 MONTHLY/YEARLY")
+		}
+	}
+	// 未指定的日期与时刻取自 DTSTART
+	switch {
+	case r.freq == "WEEKLY" && len(r.byDay) == 0:
+		r.byDay = []rruleWeekday{{weekday: r.start.Weekday()}}
+	case r.freq == "MONTHLY" && len(r.byDay) == 0 && len(r.byMonthDay) == 0:
+		r.byMonthDay = []int{r.start.Day()}
+	case r.freq == "YEARLY" && len(r.byDay) == 0 && len(r.byMonthDay) == 0:
+		if len(r.byMonth) == 0 {
+			r.byMonth = []int{int(r.start.Month())}
+		}
+		r.byMonthDay = []int{r.start.Day()}
+	}
+	if len(r.byHour) == 0 {
+		r.byHour = []int{r.start.Hour()}
+	}
+	if len(r.byMinute) == 0 {
+		r.byMinute = []int{r.start.Minute()}
+	}
+	sort.Ints(r.byHour)
+	sort.Ints(r.byMinute)
+	return r, nil
+}
+
+// parseRRuleUntil 解析 UNTIL：带 Z 为 UTC，否则按计划时区；仅日期时包含当天全天
+func parseRRuleUntil(value string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("20060102T150405", value, loc); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("20060102", value, loc); err == nil {
+		return t.AddDate(0, 0, 1).Add(-time.Second), nil
+	}
+	return time.Time{}, fmt.Errorf("RRULE UNTIL 取值无效: %q", value)
+}
+
+// civilDays 自公元纪元起的天数，用于跨时区安全地计算日期差
+func civilDays(y int, m time.Month, d int) int {
+	return int(time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / 86400)
+}
+
+// period 返回第 k 个周期的第一天与天数
+func (r *rruleSchedule) period(k int) (time.Time, int) {
+	y, m, d := r.start.Date()
+	n := k * r.interval
+	switch r.freq {
+	case "DAILY":
+		return time.Date(y, m, d+n, 0, 0, 0, 0, time.UTC), 1
+	case "WEEKLY":
+		offset := (int(r.start.Weekday()) + 6) % 7
+		return time.Date(y, m, d-offset+7*n, 0, 0, 0, 0, time.UTC), 7
+	case "MONTHLY":
+		first := time.Date(y, m+time.Month(n), 1, 0, 0, 0, 0, time.UTC)
+		return first, first.AddDate(0, 1, -1).Day()
+	default:
+		first := time.Date(y+n, 1, 1, 0, 0, 0, 0, time.UTC)
+		return first, civilDays(y+n+1, 1, 1) - civilDays(y+n, 1, 1)
+	}
+}
+
+// firstPeriod 无 COUNT 时直接从 after 所在周期开始扫描
+func (r *rruleSchedule) firstPeriod(after time.Time) int {
+	if r.count > 0 || !after.After(r.start) {
+		return 0
+	}
+	local := after.In(r.loc)
+	sy, sm, sd := r.start.Date()
+	ay, am, ad := local.Date()
+	var elapsed int
+	switch r.freq {
+	case "DAILY":
+		elapsed = civilDays(ay, am, ad) - civilDays(sy, sm, sd)
+	case "WEEKLY":
+		elapsed = (civilDays(ay, am, ad) - civilDays(sy, sm, sd) + (int(r.start.Weekday())+6)%7) / 7
+	case "MONTHLY":
+		elapsed = (ay-sy)*12 + int(am-sm)
+	default:
+		elapsed = ay - sy
+	}
+	if k := elapsed/r.interval - 1; k > 0 {
+		return k
+	}
+	return 0
+}
+
+func containsInt(values []int, n int) bool {
+	for _, v := range values {
+		if v == n {
+			return true
+		}
+	}
+	return false
+}
+
+// matchDay 判断周期内的某一天是否命中 BYMONTH/BYMONTHDAY/BYDAY
+func (r *rruleSchedule) matchDay(day time.Time) bool {
+	if len(r.byMonth) > 0 && !containsInt(r.byMonth, int(day.Month())) {
+		return false
+	}
+	monthDays := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	if len(r.byMonthDay) > 0 && !containsInt(r.byMonthDay, day.Day()) && !containsInt(r.byMonthDay, day.Day()-monthDays-1) {
+		return false
+	}
+	if len(r.byDay) == 0 {
+		return true
+	}
+	// 序号在 MONTHLY 或指定了 BYMONTH 的 YEARLY 中按月计，否则按年计
+	index, total := day.Day(), monthDays
+	if r.freq == "YEARLY" && len(r.byMonth) == 0 {
+		index, total = day.YearDay(), time.Date(day.Year(), 12, 31, 0, 0, 0, 0, time.UTC).YearDay()
+	}
+	for _, rule := range r.byDay {
+		if rule.weekday != day.Weekday() {
+			continue
+		}
+		if rule.ordinal == 0 ||
+			rule.ordinal > 0 && (index-1)/7+1 == rule.ordinal ||
+			rule.ordinal < 0 && -((total-index)/7+1) == rule.ordinal {
+			return true
+		}
+	}
+	return false
+}
+
+// occurrences 展开第 k 个周期内的全部发生时刻（已按 BYSETPOS 筛选，升序）
+func (r *rruleSchedule) occurrences(k int) []time.Time {
+	first, days := r.period(k)
+	var set []time.Time
+	for i := 0; i < days; i++ {
+		day := first.AddDate(0, 0, i)
+		if !r.matchDay(day) {
+			continue
+		}
+		for _, hour := range r.byHour {
+			for _, minute := range r.byMinute {
+				set = append(set, time.Date(day.Year(), day.Month(), day.Day(), hour, minute, r.start.Second(), 0, r.loc))
+			}
+		}
+	}
+	if len(r.bySetPos) == 0 {
+		return set
+	}
+	var picked []time.Time
+	for i, t := range set {
+		for _, pos := range r.bySetPos {
+			if pos == i+1 || pos == i-len(set) {
+				picked = append(picked, t)
+				break
+			}
+		}
+	}
+	return picked
+}
+
+// Next 按周期顺序展开，COUNT 从 DTSTART 起累计
+func (r *rruleSchedule) Next(after time.Time) (time.Time, bool) {
+	seen := 0
+	first := r.firstPeriod(after)
+	for k := first; k < first+maxRecurrenceScanDays+r.count; k++ {
+		for _, t := range r.occurrences(k) {
+			if t.Before(r.start) {
+				continue
+			}
+			seen++
+			if r.count > 0 && seen > r.count || !r.until.IsZero() && t.After(r.until) {
+				return time.Time{}, false
+			}
+			if t.After(after) {
+				return t, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,996 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"itsm-backend/common/tenantctx"
+	"itsm-backend/dto"
+	"itsm-backend/ent"
+	"itsm-backend/ent/operationalcommand"
+	"itsm-backend/ent/servicecatalogitem"
+	entTicket "itsm-backend/ent/ticket"
+	"itsm-backend/ent/ticketcategory"
+	"itsm-backend/ent/ticketschedule"
+	"itsm-backend/ent/ticketschedulerun"
+	"itsm-backend/ent/tickettemplate"
+	"itsm-backend/ent/user"
+	"itsm-backend/internal/commandbus"
+	"itsm-backend/repository/ticket"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"go.uber.org/zap"
+)
+
+// 周期工单计划（预防性维护）
+//
+// 计划启用后，下一次发生以 ticket.schedule.fire 命令入箱：available_at 即触发时刻，
+// 幂等键 ticket_schedule:<计划ID>:<发生时刻Unix秒> 保证同一发生只入箱一次；worker 到期投递给
+// HandleFireCommand，生成工单后续排下一次，形成自续期的命令链。修改表达式或启停计划时递增 revision
+// 并作废待触发命令；ReconcileSchedules 为命令链中断（停机、死信）的计划按补发策略续排，
+// 并处理建单中途中断、停留在 generating 的执行记录。
+
+const (
+	TicketScheduleSourceTemplate    = "template"
+	TicketScheduleSourceCatalogItem = "catalog_item"
+
+	TicketScheduleHolidayNone            = "none"
+	TicketScheduleHolidaySkip            = "skip"
+	TicketScheduleHolidayNextBusinessDay = "next_business_day"
+
+	TicketScheduleCatchUpAll    = "all"
+	TicketScheduleCatchUpLatest = "latest"
+	TicketScheduleCatchUpNone   = "none"
+
+	ticketScheduleAggregate  = "ticket_schedule"
+	ticketScheduleRunField   = "ticket_schedule_run_id"
+	ticketScheduleTimeZone   = "Asia/Shanghai"
+	ticketScheduleDateLayout = "2006-01-02"
+	// ticketScheduleMisfireGrace catch_up_policy=none 时允许的触发延迟，超过即视为错过
+	ticketScheduleMisfireGrace = time.Hour
+	// ticketScheduleMaxAttempts 生成失败（如模板被停用）时的最大重试次数
+	ticketScheduleMaxAttempts = 10
+	// ticketScheduleGeneratingTimeout 执行记录停留在 generating 超过该时长即视为建单中途中断，由 ReconcileSchedules 处理
+	ticketScheduleGeneratingTimeout = 10 * time.Minute
+	// maxScheduleCatchUpScan 按补发策略跳过错过的发生时最多向后计算的次数
+	maxScheduleCatchUpScan = 100000
+	// maxScheduleArmAttempts 续排时跳过已处理发生的最大次数
+	maxScheduleArmAttempts = 100
+	// ticketSchedulePreviewCount 预览默认返回的发生次数
+	ticketSchedulePreviewCount = 10
+)
+
+// TicketScheduleService 周期工单计划服务
+type TicketScheduleService struct {
+	client          *ent.Client
+	logger          *zap.SugaredLogger
+	ticketService   *TicketService
+	templateService *TicketTemplateService
+	calendarService *BusinessCalendarService
+	now             func() time.Time
+}
+
+// NewTicketScheduleService 创建周期工单计划服务
+func NewTicketScheduleService(client *ent.Client, ticketService *TicketService, logger *zap.SugaredLogger) *TicketScheduleService {
+	return &TicketScheduleService{
+		client:          client,
+		logger:          logger,
+		ticketService:   ticketService,
+		templateService: NewTicketTemplateService(client),
+		calendarService: NewBusinessCalendarService(client),
+		now:             time.Now,
+	}
+}
+
+// scheduleOccurrence 一次发生：at 为表达式计算的计划时刻（幂等键依据），fireAt 为节假日顺延后的触发时刻
+type scheduleOccurrence struct {
+	at     time.Time
+	fireAt time.Time
+}
+
+// ticketSchedulePlan 计划的时间规则：表达式、生效区间与节假日处理
+type ticketSchedulePlan struct {
+	loc           *time.Location
+	recurrence    scheduleRecurrence
+	calendar      *BusinessCalendarCalculator // nil 表示不按日历处理节假日
+	holidayPolicy string
+	startAt       time.Time
+	endAt         *time.Time
+}
+
+// next 返回计划时刻严格晚于 after 的下一次发生
+func (p *ticketSchedulePlan) next(after time.Time) (scheduleOccurrence, bool) {
+	if after.Before(p.startAt) {
+		after = p.startAt.Add(-time.Second)
+	}
+	for i := 0; i < maxRecurrenceScanDays; i++ {
+		at, ok := p.recurrence.Next(after)
+		if !ok || p.endAt != nil && at.After(*p.endAt) {
+			return scheduleOccurrence{}, false
+		}
+		after = at
+		if p.calendar == nil || p.holidayPolicy == TicketScheduleHolidayNone || p.calendar.IsBusinessDay(at) {
+			return scheduleOccurrence{at: at, fireAt: at}, true
+		}
+		if p.holidayPolicy == TicketScheduleHolidaySkip {
+			continue
+		}
+		fireAt, ok := shiftToBusinessDay(p.calendar, at)
+		if !ok {
+			continue
+		}
+		// 顺延后不早于下一次发生时由下一次代替，避免同一天重复生成
+		if following, ok := p.recurrence.Next(at); ok && !following.After(fireAt) {
+			continue
+		}
+		return scheduleOccurrence{at: at, fireAt: fireAt}, true
+	}
+	return scheduleOccurrence{}, false
+}
+
+// catchUp 返回 after 之后按补发策略应当触发的发生：all 逐次补发；latest 跳到触发时刻不晚于 now 的最后一次；
+// none 跳过触发时刻早于 now 减宽限期的发生。skipped 为被跳过的次数。
+func (p *ticketSchedulePlan) catchUp(after, now time.Time, policy string) (occ scheduleOccurrence, skipped int, ok bool) {
+	occ, ok = p.next(after)
+	for i := 0; ok && policy != TicketScheduleCatchUpAll && i < maxScheduleCatchUpScan; i++ {
+		if policy == TicketScheduleCatchUpNone && !occ.fireAt.Before(now.Add(-ticketScheduleMisfireGrace)) {
+			break
+		}
+		following, more := p.next(occ.at)
+		if policy == TicketScheduleCatchUpLatest && (!more || following.fireAt.After(now)) {
+			break
+		}
+		occ, ok = following, more
+		skipped++
+	}
+	return occ, skipped, ok
+}
+
+// shiftToBusinessDay 保持当地时刻不变，顺延到下一个工作日
+func shiftToBusinessDay(calendar *BusinessCalendarCalculator, t time.Time) (time.Time, bool) {
+	y, m, d := t.Date()
+	for i := 1; i <= maxCalendarScanDays; i++ {
+		candidate := time.Date(y, m, d+i, t.Hour(), t.Minute(), t.Second(), 0, t.Location())
+		if calendar.IsBusinessDay(candidate) {
+			return candidate, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// buildPlan 解析表达式并加载节假日所用的工作日历：未指定日历时使用租户默认日历，租户也没有默认日历时不处理节假日
+func (s *TicketScheduleService) buildPlan(ctx context.Context, tenantID int, ruleType, expression, timeZone string, startAt time.Time, endAt *time.Time, calendarID *int, holidayPolicy string) (*ticketSchedulePlan, error) {
+	loc, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, fmt.Errorf("无效的时区 %q: %w", timeZone, err)
+	}
+	if endAt != nil && !endAt.After(startAt) {
+		return nil, fmt.Errorf("失效时间必须晚于生效时间")
+	}
+	recurrence, err := parseScheduleRecurrence(ruleType, expression, loc, startAt)
+	if err != nil {
+		return nil, err
+	}
+	plan := &ticketSchedulePlan{loc: loc, recurrence: recurrence, holidayPolicy: holidayPolicy, startAt: startAt, endAt: endAt}
+	if holidayPolicy == TicketScheduleHolidayNone {
+		return plan, nil
+	}
+	if calendarID != nil {
+		plan.calendar, err = s.calendarService.Calculator(ctx, tenantID, *calendarID)
+	} else {
+		plan.calendar, err = s.calendarService.DefaultCalculator(ctx, tenantID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+func (s *TicketScheduleService) loadPlan(ctx context.Context, sched *ent.TicketSchedule) (*ticketSchedulePlan, error) {
+	return s.buildPlan(ctx, sched.TenantID, string(sched.RuleType), sched.Expression, sched.TimeZone, sched.StartAt, sched.EndAt, sched.CalendarID, string(sched.HolidayPolicy))
+}
+
+// CreateSchedule 创建周期工单计划，启用时立即排入第一次发生
+func (s *TicketScheduleService) CreateSchedule(ctx context.Context, req *dto.CreateTicketScheduleRequest, tenantID, userID int) (*ent.TicketSchedule, error) {
+	requesterID := req.RequesterID
+	if requesterID == 0 {
+		requesterID = userID
+	}
+	timeZone := req.TimeZone
+	if timeZone == "" {
+		timeZone = ticketScheduleTimeZone
+	}
+	now := s.now()
+	startAt := now
+	if req.StartAt != nil {
+		startAt = *req.StartAt
+	}
+	holidayPolicy := req.HolidayPolicy
+	if holidayPolicy == "" {
+		holidayPolicy = TicketScheduleHolidayNone
+	}
+	catchUpPolicy := req.CatchUpPolicy
+	if catchUpPolicy == "" {
+		catchUpPolicy = TicketScheduleCatchUpLatest
+	}
+	enabled := req.Enabled == nil || *req.Enabled
+
+	if err := s.validateSource(ctx, tenantID, req.SourceType, req.TemplateID, req.CatalogItemID); err != nil {
+		return nil, err
+	}
+	if err := s.validateUsers(ctx, tenantID, requesterID, req.AssigneeID); err != nil {
+		return nil, err
+	}
+	plan, err := s.buildPlan(ctx, tenantID, req.RuleType, req.Expression, timeZone, startAt, req.EndAt, req.CalendarID, holidayPolicy)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := plan.next(now); !ok {
+		return nil, fmt.Errorf("表达式在生效区间内没有后续发生时刻")
+	}
+
+	var created *ent.TicketSchedule
+	err = s.runTx(ctx, func(c *ent.Client) error {
+		create := c.TicketSchedule.Create().
+			SetTenantID(tenantID).
+			SetName(strings.TrimSpace(req.Name)).
+			SetDescription(req.Description).
+			SetSourceType(ticketschedule.SourceType(req.SourceType)).
+			SetNillableTemplateID(req.TemplateID).
+			SetNillableCatalogItemID(req.CatalogItemID).
+			SetTitle(strings.TrimSpace(req.Title)).
+			SetPriority(req.Priority).
+			SetRequesterID(requesterID).
+			SetNillableAssigneeID(req.AssigneeID).
+			SetRuleType(ticketschedule.RuleType(req.RuleType)).
+			SetExpression(strings.TrimSpace(req.Expression)).
+			SetTimeZone(timeZone).
+			SetStartAt(startAt).
+			SetNillableEndAt(req.EndAt).
+			SetNillableCalendarID(req.CalendarID).
+			SetHolidayPolicy(ticketschedule.HolidayPolicy(holidayPolicy)).
+			SetCatchUpPolicy(ticketschedule.CatchUpPolicy(catchUpPolicy)).
+			SetEnabled(enabled).
+			SetCreatedBy(userID)
+		if req.FormFields != nil {
+			create.SetFormFields(req.FormFields)
+		}
+		sched, err := create.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("创建周期工单计划失败: %w", err)
+		}
+		if enabled {
+			if sched, err = s.arm(ctx, c, sched, plan, now); err != nil {
+				return err
+			}
+		}
+		created = sched
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// UpdateSchedule 更新周期工单计划。时间规则或启停状态变化时递增 revision、作废待触发命令并重新排期
+func (s *TicketScheduleService) UpdateSchedule(ctx context.Context, tenantID, id int, req *dto.UpdateTicketScheduleRequest) (*ent.TicketSchedule, error) {
+	sched, err := s.GetSchedule(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	ruleType, expression, timeZone := string(sched.RuleType), sched.Expression, sched.TimeZone
+	startAt, endAt, calendarID := sched.StartAt, sched.EndAt, sched.CalendarID
+	holidayPolicy, enabled := string(sched.HolidayPolicy), sched.Enabled
+	requesterID, assigneeID := sched.RequesterID, sched.AssigneeID
+	reschedule := false
+	if req.RuleType != nil && *req.RuleType != ruleType {
+		ruleType, reschedule = *req.RuleType, true
+	}
+	if req.Expression != nil && strings.TrimSpace(*req.Expression) != expression {
+		expression, reschedule = strings.TrimSpace(*req.Expression), true
+	}
+	if req.TimeZone != nil && *req.TimeZone != "" && *req.TimeZone != timeZone {
+		timeZone, reschedule = *req.TimeZone, true
+	}
+	if req.StartAt != nil && !req.StartAt.Equal(startAt) {
+		startAt, reschedule = *req.StartAt, true
+	}
+	if req.ClearEndAt && endAt != nil {
+		endAt, reschedule = nil, true
+	} else if req.EndAt != nil && (endAt == nil || !req.EndAt.Equal(*endAt)) {
+		endAt, reschedule = req.EndAt, true
+	}
+	if req.CalendarID != nil {
+		if *req.CalendarID == 0 {
+			calendarID = nil
+		} else {
+			calendarID = req.CalendarID
+		}
+		reschedule = true
+	}
+	if req.HolidayPolicy != nil && *req.HolidayPolicy != holidayPolicy {
+		holidayPolicy, reschedule = *req.HolidayPolicy, true
+	}
+	if req.Enabled != nil && *req.Enabled != enabled {
+		enabled, reschedule = *req.Enabled, true
+	}
+	if req.RequesterID != nil {
+		requesterID = *req.RequesterID
+	}
+	if req.AssigneeID != nil {
+		assigneeID = req.AssigneeID
+		if *req.AssigneeID == 0 {
+			assigneeID = nil
+		}
+	}
+	if err := s.validateUsers(ctx, tenantID, requesterID, assigneeID); err != nil {
+		return nil, err
+	}
+	plan, err := s.buildPlan(ctx, tenantID, ruleType, expression, timeZone, startAt, endAt, calendarID, holidayPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated *ent.TicketSchedule
+	err = s.runTx(ctx, func(c *ent.Client) error {
+		update := c.TicketSchedule.UpdateOneID(sched.ID).
+			SetRuleType(ticketschedule.RuleType(ruleType)).
+			SetExpression(expression).
+			SetTimeZone(timeZone).
+			SetStartAt(startAt).
+			SetNillableEndAt(endAt).
+			SetHolidayPolicy(ticketschedule.HolidayPolicy(holidayPolicy)).
+			SetEnabled(enabled).
+			SetRequesterID(requesterID)
+		if endAt == nil {
+			update.ClearEndAt()
+		}
+		if calendarID == nil {
+			update.ClearCalendarID()
+		} else {
+			update.SetCalendarID(*calendarID)
+		}
+		if assigneeID == nil {
+			update.ClearAssigneeID()
+		} else {
+			update.SetAssigneeID(*assigneeID)
+		}
+		if req.Name != nil {
+			update.SetName(strings.TrimSpace(*req.Name))
+		}
+		if req.Description != nil {
+			update.SetDescription(*req.Description)
+		}
+		if req.Title != nil {
+			update.SetTitle(strings.TrimSpace(*req.Title))
+		}
+		if req.Priority != nil {
+			update.SetPriority(*req.Priority)
+		}
+		if req.FormFields != nil {
+			update.SetFormFields(*req.FormFields)
+		}
+		if req.CatchUpPolicy != nil {
+			update.SetCatchUpPolicy(ticketschedule.CatchUpPolicy(*req.CatchUpPolicy))
+		}
+		if reschedule {
+			update.AddRevision(1).ClearNextRunAt()
+		}
+		saved, err := update.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("更新周期工单计划失败: %w", err)
+		}
+		if reschedule {
+			if err := cancelTicketScheduleCommands(ctx, c, saved); err != nil {
+				return err
+			}
+			if saved.Enabled {
+				after := s.now()
+				if saved.LastOccurrenceAt != nil && saved.LastOccurrenceAt.After(after) {
+					after = *saved.LastOccurrenceAt
+				}
+				if saved, err = s.arm(ctx, c, saved, plan, after); err != nil {
+					return err
+				}
+			}
+		}
+		updated = saved
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// GetSchedule 获取周期工单计划
+func (s *TicketScheduleService) GetSchedule(ctx context.Context, tenantID, id int) (*ent.TicketSchedule, error) {
+	return s.client.TicketSchedule.Query().
+		Where(ticketschedule.ID(id), ticketschedule.TenantID(tenantID)).
+		Only(ctx)
+}
+
+// ListSchedules 获取租户的周期工单计划
+func (s *TicketScheduleService) ListSchedules(ctx context.Context, tenantID int) ([]*ent.TicketSchedule, error) {
+	return s.client.TicketSchedule.Query().
+		Where(ticketschedule.TenantID(tenantID)).
+		Order(ent.Asc(ticketschedule.FieldID)).
+		All(ctx)
+}
+
+// ListRuns 获取计划最近的执行记录
+func (s *TicketScheduleService) ListRuns(ctx context.Context, tenantID, id, limit int) ([]*ent.TicketScheduleRun, error) {
+	if _, err := s.GetSchedule(ctx, tenantID, id); err != nil {
+		return nil, err
+	}
+	if limit <= 0 || limit > 200 {
+		limit = 50
+	}
+	return s.client.TicketScheduleRun.Query().
+		Where(ticketschedulerun.TenantID(tenantID), ticketschedulerun.ScheduleID(id)).
+		Order(ent.Desc(ticketschedulerun.FieldOccurrenceAt)).
+		Limit(limit).
+		All(ctx)
+}
+
+// DeleteSchedule 删除周期工单计划，作废待触发命令并清除执行记录；已生成的工单保留
+func (s *TicketScheduleService) DeleteSchedule(ctx context.Context, tenantID, id int) error {
+	sched, err := s.GetSchedule(ctx, tenantID, id)
+	if err != nil {
+		return err
+	}
+	return s.runTx(ctx, func(c *ent.Client) error {
+		if err := cancelTicketScheduleCommands(ctx, c, sched); err != nil {
+			return err
+		}
+		if _, err := c.TicketScheduleRun.Delete().Where(ticketschedulerun.ScheduleID(sched.ID)).Exec(ctx); err != nil {
+			return fmt.Errorf("删除执行记录失败: %w", err)
+		}
+		return c.TicketSchedule.DeleteOneID(sched.ID).Exec(ctx)
+	})
+}
+
+// Preview 预览表达式接下来的发生时刻（含节假日处理），用于保存前核对
+func (s *TicketScheduleService) Preview(ctx context.Context, tenantID int, req *dto.TicketSchedulePreviewRequest) ([]dto.TicketScheduleOccurrence, error) {
+	timeZone := req.TimeZone
+	if timeZone == "" {
+		timeZone = ticketScheduleTimeZone
+	}
+	holidayPolicy := req.HolidayPolicy
+	if holidayPolicy == "" {
+		holidayPolicy = TicketScheduleHolidayNone
+	}
+	now := s.now()
+	startAt := now
+	if req.StartAt != nil {
+		startAt = *req.StartAt
+	}
+	count := req.Count
+	if count <= 0 {
+		count = ticketSchedulePreviewCount
+	}
+	plan, err := s.buildPlan(ctx, tenantID, req.RuleType, req.Expression, timeZone, startAt, req.EndAt, req.CalendarID, holidayPolicy)
+	if err != nil {
+		return nil, err
+	}
+	occurrences := make([]dto.TicketScheduleOccurrence, 0, count)
+	after := now
+	for len(occurrences) < count {
+		occ, ok := plan.next(after)
+		if !ok {
+			break
+		}
+		occurrences = append(occurrences, dto.TicketScheduleOccurrence{OccurrenceAt: occ.at, FireAt: occ.fireAt})
+		after = occ.at
+	}
+	return occurrences, nil
+}
+
+// HandleFireCommand 是 commandbus.CommandFireTicketSchedule 的处理器：按补发策略生成或跳过本次发生，
+// 再续排下一次。计划已删除、停用或 revision 已变化的命令直接忽略。
+func (s *TicketScheduleService) HandleFireCommand(ctx context.Context, cmd *ent.OperationalCommand) error {
+	if cmd == nil || cmd.TenantID <= 0 || cmd.AggregateID <= 0 {
+		return fmt.Errorf("invalid ticket schedule command")
+	}
+	at, err := time.Parse(time.RFC3339, fmt.Sprint(cmd.Payload["occurrence_at"]))
+	if err != nil {
+		return fmt.Errorf("周期工单命令 %d 缺少发生时刻", cmd.ID)
+	}
+	fireAt, err := time.Parse(time.RFC3339, fmt.Sprint(cmd.Payload["fire_at"]))
+	if err != nil {
+		fireAt = at
+	}
+	sched, err := s.GetSchedule(ctx, cmd.TenantID, cmd.AggregateID)
+	if ent.IsNotFound(err) {
+		s.logger.Warnw("周期工单计划不存在，忽略", "command", cmd.ID, "schedule", cmd.AggregateID)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("获取周期工单计划失败: %w", err)
+	}
+	if revision, _ := numericInt(cmd.Payload["revision"]); !sched.Enabled || revision != sched.Revision {
+		return nil
+	}
+	plan, err := s.loadPlan(ctx, sched)
+	if err != nil {
+		return err
+	}
+	occ := scheduleOccurrence{at: at.In(plan.loc), fireAt: fireAt.In(plan.loc)}
+
+	// 以本次发生为起点套用补发策略：选中的仍是本次则生成，否则本次已被更晚的发生取代
+	chosen, _, ok := plan.catchUp(occ.at.Add(-time.Second), s.now(), string(sched.CatchUpPolicy))
+	if ok && chosen.at.Equal(occ.at) {
+		if err := s.generate(ctx, sched, occ); err != nil {
+			return err
+		}
+	} else if err := s.recordSkipped(ctx, sched, occ, fmt.Sprintf("错过的发生，按补发策略 %s 跳过", sched.CatchUpPolicy)); err != nil {
+		return err
+	}
+
+	return s.runTx(ctx, func(c *ent.Client) error {
+		saved, err := c.TicketSchedule.UpdateOneID(sched.ID).SetLastOccurrenceAt(occ.at).Save(ctx)
+		if err != nil {
+			return fmt.Errorf("更新周期工单计划失败: %w", err)
+		}
+		_, err = s.arm(ctx, c, saved, plan, occ.at)
+		return err
+	})
+}
+
+// ReconcileSchedules 为已启用但没有待触发命令的计划续排：命令链因停机中断或生成失败进入死信时，
+// 从最近处理的发生起按补发策略补排。续排前先处理超时停留在 generating 的执行记录（见 recoverStaleRuns）。
+// 返回续排的计划数。
+//
+// RLS 说明：枚举计划是跨租户操作，使用 SystemContext；逐计划处理时切回租户上下文。
+func (s *TicketScheduleService) ReconcileSchedules(ctx context.Context) (int, error) {
+	ctx = tenantctx.SystemContext(ctx, "ticket_schedule:reconcile",
+		"re-arm enabled ticket schedules whose command chain was interrupted")
+	schedules, err := s.client.TicketSchedule.Query().Where(ticketschedule.Enabled(true)).All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("查询周期工单计划失败: %w", err)
+	}
+	armed := 0
+	for _, sched := range schedules {
+		tenantCtx := tenantctx.WithTenantID(ctx, sched.TenantID)
+		if err := s.recoverStaleRuns(tenantCtx, sched); err != nil {
+			s.logger.Warnw("处理中断的周期工单执行记录失败", "schedule", sched.ID, "tenant_id", sched.TenantID, "error", err)
+		}
+		queued, err := s.client.OperationalCommand.Query().
+			Where(
+				operationalcommand.TenantID(sched.TenantID),
+				operationalcommand.CommandType(commandbus.CommandFireTicketSchedule),
+				operationalcommand.AggregateType(ticketScheduleAggregate),
+				operationalcommand.AggregateID(sched.ID),
+				operationalcommand.StatusIn(commandbus.StatusPending, commandbus.StatusProcessing),
+			).
+			Exist(tenantCtx)
+		if err != nil {
+			return armed, fmt.Errorf("查询周期工单命令失败: %w", err)
+		}
+		if queued {
+			continue
+		}
+		plan, err := s.loadPlan(tenantCtx, sched)
+		if err != nil {
+			s.logger.Warnw("周期工单计划配置无效，跳过续排", "schedule", sched.ID, "tenant_id", sched.TenantID, "error", err)
+			continue
+		}
+		after := sched.CreatedAt
+		if sched.LastOccurrenceAt != nil {
+			after = *sched.LastOccurrenceAt
+		}
+		if err := s.runTx(tenantCtx, func(c *ent.Client) error {
+			_, err := s.arm(tenantCtx, c, sched, plan, after)
+			return err
+		}); err != nil {
+			s.logger.Warnw("周期工单计划续排失败", "schedule", sched.ID, "tenant_id", sched.TenantID, "error", err)
+			continue
+		}
+		armed++
+	}
+	return armed, nil
+}
+
+// recoverStaleRuns 处理停留在 generating 超过 ticketScheduleGeneratingTimeout 的执行记录（建单前后进程崩溃或回写失败）：
+// 按工单表单中的 ticket_schedule_run_id 查找已建成的工单，找到则补记为 generated；
+// 找不到说明工单未建成，重置为 failed 后立即重新生成，失败信息留在执行记录中。
+func (s *TicketScheduleService) recoverStaleRuns(ctx context.Context, sched *ent.TicketSchedule) error {
+	runs, err := s.client.TicketScheduleRun.Query().
+		Where(
+			ticketschedulerun.ScheduleID(sched.ID),
+			ticketschedulerun.StatusEQ(ticketschedulerun.StatusGenerating),
+			ticketschedulerun.UpdatedAtLT(s.now().Add(-ticketScheduleGeneratingTimeout)),
+		).
+		Order(ent.Asc(ticketschedulerun.FieldOccurrenceAt)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("查询周期工单执行记录失败: %w", err)
+	}
+	if len(runs) == 0 {
+		return nil
+	}
+	plan, err := s.loadPlan(ctx, sched)
+	if err != nil {
+		return err
+	}
+	for _, run := range runs {
+		created, err := s.client.Ticket.Query().
+			Where(
+				entTicket.TenantID(sched.TenantID),
+				func(sel *sql.Selector) {
+					sel.Where(sqljson.ValueEQ(sel.C(entTicket.FieldFormFields), run.ID, sqljson.Path(ticketScheduleRunField)))
+				},
+			).
+			Order(ent.Asc(entTicket.FieldID)).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return fmt.Errorf("查询周期工单生成的工单失败: %w", err)
+		}
+		if created != nil {
+			if err := s.client.TicketScheduleRun.UpdateOneID(run.ID).
+				Where(ticketschedulerun.StatusEQ(ticketschedulerun.StatusGenerating)).
+				SetStatus(ticketschedulerun.StatusGenerated).
+				SetTicketID(created.ID).
+				Exec(ctx); err != nil && !ent.IsNotFound(err) {
+				return fmt.Errorf("更新周期工单执行记录失败: %w", err)
+			}
+			s.logger.Infow("中断的周期工单执行记录已补记为已生成", "schedule", sched.ID, "run", run.ID, "ticket", created.ID)
+			continue
+		}
+		reset, err := s.client.TicketScheduleRun.Update().
+			Where(ticketschedulerun.ID(run.ID), ticketschedulerun.StatusEQ(ticketschedulerun.StatusGenerating)).
+			SetStatus(ticketschedulerun.StatusFailed).
+			SetMessage("建单中断，重新生成").
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("重置周期工单执行记录失败: %w", err)
+		}
+		if reset == 0 {
+			continue
+		}
+		occ := scheduleOccurrence{at: run.OccurrenceAt.In(plan.loc), fireAt: run.FireAt.In(plan.loc)}
+		if err := s.generate(ctx, sched, occ); err != nil {
+			s.logger.Warnw("重新生成中断的周期工单失败", "schedule", sched.ID, "run", run.ID, "error", err)
+		}
+	}
+	return nil
+}
+
+// arm 把 after 之后按补发策略应触发的下一次发生入箱并回写 next_run_at，没有后续发生时清空
+func (s *TicketScheduleService) arm(ctx context.Context, c *ent.Client, sched *ent.TicketSchedule, plan *ticketSchedulePlan, after time.Time) (*ent.TicketSchedule, error) {
+	now := s.now()
+	for i := 0; i < maxScheduleArmAttempts; i++ {
+		occ, skipped, ok := plan.catchUp(after, now, string(sched.CatchUpPolicy))
+		if skipped > 0 {
+			s.logger.Infow("周期工单计划跳过错过的发生", "schedule", sched.ID, "skipped", skipped, "policy", sched.CatchUpPolicy)
+		}
+		if !ok {
+			return c.TicketSchedule.UpdateOneID(sched.ID).ClearNextRunAt().Save(ctx)
+		}
+		queued, err := enqueueTicketScheduleOccurrence(ctx, c, sched, occ)
+		if err != nil {
+			return nil, err
+		}
+		if queued {
+			return c.TicketSchedule.UpdateOneID(sched.ID).SetNextRunAt(occ.fireAt).Save(ctx)
+		}
+		after = occ.at
+	}
+	return nil, fmt.Errorf("周期工单计划 %d 无法确定下一次发生", sched.ID)
+}
+
+// enqueueTicketScheduleOccurrence 为一次发生入箱触发命令。同一发生的命令已存在时：已作废的恢复为待触发，
+// 待触发的刷新 revision；已执行完毕或进入死信的返回 false，由调用方顺延到下一次发生。
+func enqueueTicketScheduleOccurrence(ctx context.Context, c *ent.Client, sched *ent.TicketSchedule, occ scheduleOccurrence) (bool, error) {
+	key := fmt.Sprintf("ticket_schedule:%d:%d", sched.ID, occ.at.Unix())
+	payload := map[string]interface{}{
+		"revision":      sched.Revision,
+		"occurrence_at": occ.at.UTC().Format(time.RFC3339),
+		"fire_at":       occ.fireAt.UTC().Format(time.RFC3339),
+	}
+	existing, err := c.OperationalCommand.Query().
+		Where(
+			operationalcommand.TenantID(sched.TenantID),
+			operationalcommand.CommandType(commandbus.CommandFireTicketSchedule),
+			operationalcommand.IdempotencyKey(key),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		_, err = commandbus.Enqueue(ctx, c, commandbus.EnqueueRequest{
+			TenantID:       sched.TenantID,
+			CommandType:    commandbus.CommandFireTicketSchedule,
+			AggregateType:  ticketScheduleAggregate,
+			AggregateID:    sched.ID,
+			IdempotencyKey: key,
+			Payload:        payload,
+			MaxAttempts:    ticketScheduleMaxAttempts,
+			AvailableAt:    occ.fireAt,
+		})
+		if err != nil {
+			return false, fmt.Errorf("入箱周期工单命令失败: %w", err)
+		}
+		return true, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("查询周期工单命令失败: %w", err)
+	}
+	switch existing.Status {
+	case commandbus.StatusCancelled, commandbus.StatusPending:
+		err = c.OperationalCommand.UpdateOne(existing).
+			SetStatus(commandbus.StatusPending).
+			SetPayload(payload).
+			SetAvailableAt(occ.fireAt).
+			SetAttempt(0).
+			ClearCompletedAt().
+			ClearLastError().
+			Exec(ctx)
+		if err != nil {
+			return false, fmt.Errorf("恢复周期工单命令失败: %w", err)
+		}
+		return true, nil
+	case commandbus.StatusProcessing:
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// cancelTicketScheduleCommands 作废计划仍处于 pending 的触发命令
+func cancelTicketScheduleCommands(ctx context.Context, c *ent.Client, sched *ent.TicketSchedule) error {
+	_, err := c.OperationalCommand.Update().
+		Where(
+			operationalcommand.TenantID(sched.TenantID),
+			operationalcommand.CommandType(commandbus.CommandFireTicketSchedule),
+			operationalcommand.AggregateType(ticketScheduleAggregate),
+			operationalcommand.AggregateID(sched.ID),
+			operationalcommand.StatusEQ(commandbus.StatusPending),
+		).
+		SetStatus(commandbus.StatusCancelled).
+		SetCompletedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("作废周期工单命令失败: %w", err)
+	}
+	return nil
+}
+
+// generate 为一次发生生成工单。建单前以 pending/failed → generating 的条件更新占用该发生，
+// 建单与回写执行记录无法放进同一事务，占用失败（已生成，或上次建单中途中断停留在 generating）时不再建单，
+// 保证命令重试不会重复建单；停留在 generating 的发生由 ReconcileSchedules 超时后处理。
+// 补生成较早的发生时不覆盖计划上最近一次生成的工单。
+func (s *TicketScheduleService) generate(ctx context.Context, sched *ent.TicketSchedule, occ scheduleOccurrence) error {
+	run, err := s.occurrenceRun(ctx, sched, occ)
+	if err != nil {
+		return err
+	}
+	claimed, err := s.client.TicketScheduleRun.Update().
+		Where(
+			ticketschedulerun.ID(run.ID),
+			ticketschedulerun.StatusIn(ticketschedulerun.StatusPending, ticketschedulerun.StatusFailed),
+		).
+		SetStatus(ticketschedulerun.StatusGenerating).
+		ClearMessage().
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("占用周期工单执行记录失败: %w", err)
+	}
+	if claimed == 0 {
+		if current, err := s.client.TicketScheduleRun.Get(ctx, run.ID); err == nil && current.Status == ticketschedulerun.StatusGenerating {
+			s.logger.Warnw("周期工单发生已在建单中或上次建单中断，跳过以免重复建单", "schedule", sched.ID, "run", run.ID)
+		}
+		return nil
+	}
+	created, err := s.createTicket(ctx, sched, run, occ)
+	if err != nil {
+		message := []rune(err.Error())
+		if len(message) > 255 {
+			message = message[:255]
+		}
+		if updateErr := s.client.TicketScheduleRun.UpdateOneID(run.ID).SetStatus(ticketschedulerun.StatusFailed).SetMessage(string(message)).Exec(ctx); updateErr != nil {
+			s.logger.Warnw("记录周期工单生成失败状态失败", "schedule", sched.ID, "error", updateErr)
+		}
+		return fmt.Errorf("周期工单计划 %d 生成工单失败: %w", sched.ID, err)
+	}
+	if err := s.client.TicketScheduleRun.UpdateOneID(run.ID).
+		SetStatus(ticketschedulerun.StatusGenerated).
+		SetTicketID(created.ID).
+		Exec(ctx); err != nil {
+		// 工单已建成：返回 nil 避免命令重试重复建单，执行记录停留在 generating
+		s.logger.Errorw("更新周期工单执行记录失败", "schedule", sched.ID, "run", run.ID, "ticket", created.ID, "error", err)
+	}
+	if sched.LastOccurrenceAt != nil && occ.at.Before(*sched.LastOccurrenceAt) {
+		return nil
+	}
+	return s.client.TicketSchedule.UpdateOneID(sched.ID).
+		SetLastRunAt(s.now()).
+		SetLastTicketID(created.ID).
+		Exec(ctx)
+}
+
+// recordSkipped 记录按补发策略跳过的发生
+func (s *TicketScheduleService) recordSkipped(ctx context.Context, sched *ent.TicketSchedule, occ scheduleOccurrence, reason string) error {
+	run, err := s.occurrenceRun(ctx, sched, occ)
+	if err != nil {
+		return err
+	}
+	if run.Status == ticketschedulerun.StatusGenerated || run.Status == ticketschedulerun.StatusGenerating {
+		return nil
+	}
+	return s.client.TicketScheduleRun.UpdateOne(run).
+		SetStatus(ticketschedulerun.StatusSkipped).
+		SetMessage(reason).
+		Exec(ctx)
+}
+
+// occurrenceRun 获取或创建一次发生的执行记录，(schedule_id, occurrence_at) 唯一
+func (s *TicketScheduleService) occurrenceRun(ctx context.Context, sched *ent.TicketSchedule, occ scheduleOccurrence) (*ent.TicketScheduleRun, error) {
+	at := occ.at.UTC()
+	run, err := s.client.TicketScheduleRun.Query().
+		Where(ticketschedulerun.ScheduleID(sched.ID), ticketschedulerun.OccurrenceAt(at)).
+		Only(ctx)
+	if err == nil {
+		return run, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("查询周期工单执行记录失败: %w", err)
+	}
+	run, err = s.client.TicketScheduleRun.Create().
+		SetTenantID(sched.TenantID).
+		SetScheduleID(sched.ID).
+		SetOccurrenceAt(at).
+		SetFireAt(occ.fireAt.UTC()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("记录周期工单执行失败: %w", err)
+	}
+	return run, nil
+}
+
+// createTicket 按计划来源组装建单请求：模板计划套用模板的分类、优先级与表单默认值，
+// 服务目录计划以服务请求类型建单；标题为空时取来源名称并附加发生日期，标题中的 {date} 替换为发生日期。
+// 表单中记录计划与执行记录ID，供中断恢复时查找已建成的工单
+func (s *TicketScheduleService) createTicket(ctx context.Context, sched *ent.TicketSchedule, run *ent.TicketScheduleRun, occ scheduleOccurrence) (*ticket.Ticket, error) {
+	tenantID := sched.TenantID
+	formFields := make(map[string]interface{}, len(sched.FormFields)+2)
+	for key, value := range sched.FormFields {
+		formFields[key] = value
+	}
+	req := &dto.CreateTicketRequest{RequesterID: sched.RequesterID, Priority: "medium"}
+	var sourceName, category string
+	switch sched.SourceType {
+	case ticketschedule.SourceTypeTemplate:
+		if sched.TemplateID == nil {
+			return nil, fmt.Errorf("计划未指定工单模板")
+		}
+		tmpl, err := s.templateService.GetTemplate(ctx, *sched.TemplateID, tenantID)
+		if err != nil {
+			return nil, fmt.Errorf("工单模板不存在")
+		}
+		if formFields, err = s.templateService.RenderTemplate(ctx, tmpl.ID, tenantID, formFields); err != nil {
+			return nil, err
+		}
+		sourceName, category = tmpl.Name, tmpl.Category
+		req.Description = tmpl.Description
+		req.TemplateID = &tmpl.ID
+		if tmpl.Priority != "" {
+			req.Priority = tmpl.Priority
+		}
+	case ticketschedule.SourceTypeCatalogItem:
+		if sched.CatalogItemID == nil {
+			return nil, fmt.Errorf("计划未指定服务目录项")
+		}
+		item, err := s.client.ServiceCatalogItem.Query().
+			Where(servicecatalogitem.ID(*sched.CatalogItemID), servicecatalogitem.TenantID(tenantID), servicecatalogitem.IsActive(true)).
+			Only(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("服务目录项不存在或已停用")
+		}
+		sourceName, category = item.Name, item.Category
+		req.Description = item.Description
+		req.Type = "service_request"
+		formFields["service_catalog_item_id"] = item.ID
+	}
+	formFields["ticket_schedule_id"] = sched.ID
+	formFields["ticket_schedule_occurrence_at"] = occ.at.Format(time.RFC3339)
+	formFields[ticketScheduleRunField] = run.ID
+
+	date := occ.at.Format(ticketScheduleDateLayout)
+	req.Title = sourceName + " " + date
+	if sched.Title != "" {
+		req.Title = strings.ReplaceAll(sched.Title, "{date}", date)
+	}
+	if strings.TrimSpace(req.Description) == "" {
+		req.Description = fmt.Sprintf("由周期计划「%s」于 %s 自动生成", sched.Name, occ.at.Format("2006-01-02 15:04 MST"))
+	}
+	if sched.Priority != "" {
+		req.Priority = sched.Priority
+	}
+	if sched.AssigneeID != nil {
+		req.AssigneeID = *sched.AssigneeID
+	}
+	// 模板与服务目录的分类为自由文本，仅在存在同名工单分类时关联
+	if category != "" {
+		if matched, err := s.client.TicketCategory.Query().
+			Where(ticketcategory.NameEQ(category), ticketcategory.TenantIDEQ(tenantID), ticketcategory.IsActiveEQ(true)).
+			First(ctx); err == nil {
+			req.CategoryID = &matched.ID
+		}
+	}
+	req.FormFields = formFields
+	return s.ticketService.CreateTicket(ctx, req, tenantID)
+}
+
+// validateSource 校验计划来源：模板计划只能指定启用的模板，服务目录计划只能指定启用的服务目录项
+func (s *TicketScheduleService) validateSource(ctx context.Context, tenantID int, sourceType string, templateID, catalogItemID *int) error {
+	switch sourceType {
+	case TicketScheduleSourceTemplate:
+		if templateID == nil || catalogItemID != nil {
+			return fmt.Errorf("模板计划需要且只能指定工单模板")
+		}
+		exists, err := s.client.TicketTemplate.Query().
+			Where(tickettemplate.ID(*templateID), tickettemplate.TenantID(tenantID), tickettemplate.IsActive(true)).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("验证工单模板失败: %w", err)
+		}
+		if !exists {
+			return fmt.Errorf("工单模板不存在或不可用")
+		}
+	case TicketScheduleSourceCatalogItem:
+		if catalogItemID == nil || templateID != nil {
+			return fmt.Errorf("服务目录计划需要且只能指定服务目录项")
+		}
+		exists, err := s.client.ServiceCatalogItem.Query().
+			Where(servicecatalogitem.ID(*catalogItemID), servicecatalogitem.TenantID(tenantID), servicecatalogitem.IsActive(true)).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("验证服务目录项失败: %w", err)
+		}
+		if !exists {
+			return fmt.Errorf("服务目录项不存在或已停用")
+		}
+	default:
+		return fmt.Errorf("不支持的计划来源: %s", sourceType)
+	}
+	return nil
+}
+
+// validateUsers 校验申请人与处理人属于当前租户且可用
+func (s *TicketScheduleService) validateUsers(ctx context.Context, tenantID, requesterID int, assigneeID *int) error {
+	ids := []int{requesterID}
+	if assigneeID != nil {
+		ids = append(ids, *assigneeID)
+	}
+	for _, id := range ids {
+		exists, err := s.client.User.Query().
+			Where(user.ID(id), user.TenantID(tenantID), user.Active(true)).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("验证用户失败: %w", err)
+		}
+		if !exists {
+			return fmt.Errorf("用户 %d 不存在或不可用", id)
+		}
+	}
+	return nil
+}
+
+// runTx 在事务中执行，失败回滚
+func (s *TicketScheduleService) runTx(ctx context.Context, fn func(c *ent.Client) error) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	if err := fn(tx.Client()); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("提交事务失败: %w", err)
+	}
+	return nil
+}
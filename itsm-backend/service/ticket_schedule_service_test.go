@@ -0,0 +1,478 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"itsm-backend/dto"
+	"itsm-backend/ent"
+	"itsm-backend/ent/enttest"
+	"itsm-backend/ent/operationalcommand"
+	"itsm-backend/ent/schema"
+	entTicket "itsm-backend/ent/ticket"
+	"itsm-backend/ent/ticketschedulerun"
+	"itsm-backend/ent/user"
+	"itsm-backend/internal/commandbus"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestScheduleRecurrence(t *testing.T) {
+	loc, err := time.LoadLocation("Asia/Shanghai")
+	require.NoError(t, err)
+	at := func(y int, m time.Month, d, hh, mm int) time.Time { return time.Date(y, m, d, hh, mm, 0, 0, loc) }
+	after := at(2026, 10, 17, 10, 0) // 周六
+
+	cases := []struct {
+		name       string
+		ruleType   string
+		expression string
+		start      time.Time
+		want       []time.Time
+	}{
+		{"cron 每月 1 日", ScheduleRuleCron, "30 2 1 * *", after, []time.Time{at(2026, 11, 1, 2, 30), at(2026, 12, 1, 2, 30)}},
+		{"cron 工作日步长", ScheduleRuleCron, "*/20 9-10 * * MON-FRI", after, []time.Time{at(2026, 10, 19, 9, 0), at(2026, 10, 19, 9, 20), at(2026, 10, 19, 9, 40)}},
+		{"cron 日与周取并集", ScheduleRuleCron, "0 9 13 * FRI", after, []time.Time{at(2026, 10, 23, 9, 0), at(2026, 10, 30, 9, 0), at(2026, 11, 6, 9, 0), at(2026, 11, 13, 9, 0), at(2026, 11, 20, 9, 0)}},
+		{"cron 宏", ScheduleRuleCron, "@monthly", after, []time.Time{at(2026, 11, 1, 0, 0)}},
+		{"cron 闰日", ScheduleRuleCron, "0 0 29 2 *", after, []time.Time{at(2028, 2, 29, 0, 0)}},
+		{"RRULE 每月第二个周二", ScheduleRuleRRule, "RRULE:FREQ=MONTHLY;BYDAY=2TU;BYHOUR=22;BYMINUTE=0", at(2026, 10, 1, 9, 0), []time.Time{at(2026, 11, 10, 22, 0), at(2026, 12, 8, 22, 0), at(2027, 1, 12, 22, 0)}},
+		{"RRULE 每月最后一个工作日", ScheduleRuleRRule, "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1", at(2026, 11, 1, 8, 30), []time.Time{at(2026, 11, 30, 8, 30), at(2026, 12, 31, 8, 30), at(2027, 1, 29, 8, 30)}},
+		{"RRULE 每季度", ScheduleRuleRRule, "FREQ=MONTHLY;INTERVAL=3;BYMONTHDAY=1", at(2026, 10, 1, 9, 0), []time.Time{at(2027, 1, 1, 9, 0), at(2027, 4, 1, 9, 0)}},
+		{"RRULE 月末", ScheduleRuleRRule, "FREQ=MONTHLY;BYMONTHDAY=-1", at(2027, 1, 1, 18, 0), []time.Time{at(2027, 1, 31, 18, 0), at(2027, 2, 28, 18, 0)}},
+		{"RRULE COUNT", ScheduleRuleRRule, "FREQ=YEARLY;COUNT=2", at(2025, 12, 15, 10, 0), []time.Time{at(2026, 12, 15, 10, 0)}},
+		{"RRULE UNTIL 含当天", ScheduleRuleRRule, "FREQ=WEEKLY;BYDAY=MO;UNTIL=20261026", at(2026, 10, 1, 9, 0), []time.Time{at(2026, 10, 19, 9, 0), at(2026, 10, 26, 9, 0)}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			rec, err := parseScheduleRecurrence(tc.ruleType, tc.expression, loc, tc.start)
+			require.NoError(t, err)
+			var got []time.Time
+			cursor := after
+			for len(got) < len(tc.want)+1 {
+				next, ok := rec.Next(cursor)
+				if !ok {
+					break
+				}
+				got = append(got, next)
+				cursor = next
+			}
+			if len(got) > len(tc.want) {
+				got = got[:len(tc.want)]
+			}
+			require.Len(t, got, len(tc.want))
+			for i := range tc.want {
+				assert.True(t, tc.want[i].Equal(got[i]), "第 %d 次: 期望 %s，实际 %s", i+1, tc.want[i], got[i])
+			}
+		})
+	}
+
+	t.Run("COUNT 与 UNTIL 用尽后没有后续发生", func(t *testing.T) {
+		rec, err := parseScheduleRecurrence(ScheduleRuleRRule, "FREQ=YEARLY;COUNT=2", loc, at(2025, 12, 15, 10, 0))
+		require.NoError(t, err)
+		_, ok := rec.Next(at(2026, 12, 15, 10, 0))
+		assert.False(t, ok)
+		rec, err = parseScheduleRecurrence(ScheduleRuleRRule, "FREQ=WEEKLY;BYDAY=MO;UNTIL=20261026", loc, at(2026, 10, 1, 9, 0))
+		require.NoError(t, err)
+		_, ok = rec.Next(at(2026, 10, 26, 9, 0))
+		assert.False(t, ok)
+	})
+
+	for _, invalid := range []struct{ ruleType, expression string }{
+		{ScheduleRuleCron, "61 * * * *"},
+		{ScheduleRuleCron, "0 0 * *"},
+		{ScheduleRuleCron, "0 0 10-5 * *"},
+		{ScheduleRuleRRule, "FREQ=HOURLY"},
+		{ScheduleRuleRRule, "BYDAY=MO"},
+		{ScheduleRuleRRule, "FREQ=DAILY;BYDAY=1MO"},
+		{ScheduleRuleRRule, "FREQ=DAILY;COUNT=2;UNTIL=20270101"},
+		{ScheduleRuleRRule, "FREQ=MONTHLY;BYMONTHDAY=0"},
+		{"interval", "PT1H"},
+	} {
+		_, err := parseScheduleRecurrence(invalid.ruleType, invalid.expression, loc, after)
+		assert.Error(t, err, "%s %q", invalid.ruleType, invalid.expression)
+	}
+}
+
+func TestTicketSchedulePlan(t *testing.T) {
+	loc, err := time.LoadLocation("Asia/Shanghai")
+	require.NoError(t, err)
+	at := func(y int, m time.Month, d, hh, mm int) time.Time { return time.Date(y, m, d, hh, mm, 0, 0, loc) }
+	var windows []schema.CalendarWorkWindow
+	for weekday := 1; weekday <= 5; weekday++ {
+		windows = append(windows, schema.CalendarWorkWindow{Weekday: weekday, Start: "09:00", End: "18:00"})
+	}
+	calendar, err := NewBusinessCalendarCalculator("Asia/Shanghai", windows, []schema.CalendarDay{{Date: "2026-11-02", Name: "调休假"}}, nil)
+	require.NoError(t, err)
+	newPlan := func(expression, holidayPolicy string) *ticketSchedulePlan {
+		rec, err := parseScheduleRecurrence(ScheduleRuleCron, expression, loc, at(2026, 10, 1, 0, 0))
+		require.NoError(t, err)
+		return &ticketSchedulePlan{loc: loc, recurrence: rec, calendar: calendar, holidayPolicy: holidayPolicy, startAt: at(2026, 10, 1, 0, 0)}
+	}
+
+	t.Run("节假日跳过", func(t *testing.T) {
+		occ, ok := newPlan("0 9 2 * *", TicketScheduleHolidaySkip).next(at(2026, 10, 17, 0, 0))
+		require.True(t, ok)
+		assert.True(t, at(2026, 12, 2, 9, 0).Equal(occ.at))
+		assert.True(t, occ.at.Equal(occ.fireAt))
+	})
+
+	t.Run("节假日与周末顺延到下一个工作日", func(t *testing.T) {
+		plan := newPlan("0 9 2 * *", TicketScheduleHolidayNextBusinessDay)
+		occ, ok := plan.next(at(2026, 10, 17, 0, 0))
+		require.True(t, ok)
+		assert.True(t, at(2026, 11, 2, 9, 0).Equal(occ.at))
+		assert.True(t, at(2026, 11, 3, 9, 0).Equal(occ.fireAt))
+		occ, ok = plan.next(at(2026, 12, 31, 0, 0))
+		require.True(t, ok)
+		assert.True(t, at(2027, 1, 4, 9, 0).Equal(occ.fireAt), "1 月 2 日为周六")
+	})
+
+	t.Run("顺延不与下一次发生重叠", func(t *testing.T) {
+		occ, ok := newPlan("0 9 * * *", TicketScheduleHolidayNextBusinessDay).next(at(2026, 10, 16, 10, 0))
+		require.True(t, ok)
+		assert.True(t, at(2026, 10, 19, 9, 0).Equal(occ.at))
+		assert.True(t, occ.at.Equal(occ.fireAt))
+	})
+
+	t.Run("补发策略", func(t *testing.T) {
+		plan := newPlan("0 9 * * *", TicketScheduleHolidayNone)
+		last, now := at(2026, 10, 10, 9, 0), at(2026, 10, 17, 12, 0)
+
+		occ, skipped, ok := plan.catchUp(last, now, TicketScheduleCatchUpAll)
+		require.True(t, ok)
+		assert.True(t, at(2026, 10, 11, 9, 0).Equal(occ.at))
+		assert.Zero(t, skipped)
+
+		occ, skipped, ok = plan.catchUp(last, now, TicketScheduleCatchUpLatest)
+		require.True(t, ok)
+		assert.True(t, at(2026, 10, 17, 9, 0).Equal(occ.at))
+		assert.Equal(t, 6, skipped)
+
+		occ, skipped, ok = plan.catchUp(last, now, TicketScheduleCatchUpNone)
+		require.True(t, ok)
+		assert.True(t, at(2026, 10, 18, 9, 0).Equal(occ.at))
+		assert.Equal(t, 7, skipped)
+
+		occ, _, ok = plan.catchUp(at(2026, 10, 17, 9, 30), at(2026, 10, 17, 9, 40), TicketScheduleCatchUpLatest)
+		require.True(t, ok)
+		assert.True(t, at(2026, 10, 18, 9, 0).Equal(occ.at), "未落后时取下一次")
+	})
+}
+
+func TestTicketScheduleGeneration(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", testDSN())
+	defer client.Close()
+	ctx := context.Background()
+	logger := zaptest.NewLogger(t).Sugar()
+	loc, err := time.LoadLocation("Asia/Shanghai")
+	require.NoError(t, err)
+	at := func(y int, m time.Month, d, hh, mm int) time.Time { return time.Date(y, m, d, hh, mm, 0, 0, loc) }
+
+	tenant, err := client.Tenant.Create().SetName("Schedule Tenant").SetCode("schedule").SetDomain("schedule.com").SetStatus("active").Save(ctx)
+	require.NoError(t, err)
+	newUser := func(name, role string) *ent.User {
+		u, err := client.User.Create().
+			SetUsername(name).
+			SetEmail(name + "@schedule.com").
+			SetName(name).
+			SetPasswordHash("hashedpassword").
+			SetRole(user.Role(role)).
+			SetActive(true).
+			SetTenantID(tenant.ID).
+			Save(ctx)
+		require.NoError(t, err)
+		return u
+	}
+	admin := newUser("admin", "admin")
+	agent := newUser("ops", "agent")
+
+	template, err := client.TicketTemplate.Create().
+		SetName("月度补丁").
+		SetDescription("按补丁基线更新服务器").
+		SetCategory("运维").
+		SetPriority("high").
+		SetFormFields([]byte(`{"window":{"default":"22:00-02:00"},"hosts":{"required":true}}`)).
+		SetTenantID(tenant.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	now := at(2026, 10, 17, 10, 0)
+	svc := NewTicketScheduleService(client, NewTicketServiceForTest(client, logger), logger)
+	svc.now = func() time.Time { return now }
+
+	pendingCommands := func(t *testing.T, scheduleID int) []*ent.OperationalCommand {
+		cmds, err := client.OperationalCommand.Query().
+			Where(
+				operationalcommand.CommandType(commandbus.CommandFireTicketSchedule),
+				operationalcommand.AggregateID(scheduleID),
+				operationalcommand.StatusEQ(commandbus.StatusPending),
+			).
+			All(ctx)
+		require.NoError(t, err)
+		return cmds
+	}
+	// 模拟 worker：执行唯一一条待触发命令并标记完成
+	fire := func(t *testing.T, scheduleID int) *ent.OperationalCommand {
+		cmds := pendingCommands(t, scheduleID)
+		require.Len(t, cmds, 1)
+		require.NoError(t, svc.HandleFireCommand(ctx, cmds[0]))
+		require.NoError(t, client.OperationalCommand.UpdateOne(cmds[0]).SetStatus(commandbus.StatusSucceeded).Exec(ctx))
+		return cmds[0]
+	}
+	scheduledTickets := func(t *testing.T) []*ent.Ticket {
+		tickets, err := client.Ticket.Query().Where(entTicket.TenantID(tenant.ID)).Order(ent.Asc(entTicket.FieldID)).All(ctx)
+		require.NoError(t, err)
+		return tickets
+	}
+
+	startAt := at(2026, 10, 1, 0, 0)
+	sched, err := svc.CreateSchedule(ctx, &dto.CreateTicketScheduleRequest{
+		Name:          "生产服务器月度补丁",
+		SourceType:    TicketScheduleSourceTemplate,
+		TemplateID:    &template.ID,
+		Title:         "月度补丁 {date}",
+		AssigneeID:    &agent.ID,
+		FormFields:    map[string]interface{}{"hosts": "web-01,web-02"},
+		RuleType:      ScheduleRuleRRule,
+		Expression:    "FREQ=MONTHLY;BYDAY=2TU;BYHOUR=22;BYMINUTE=0",
+		TimeZone:      "Asia/Shanghai",
+		StartAt:       &startAt,
+		CatchUpPolicy: TicketScheduleCatchUpLatest,
+	}, tenant.ID, admin.ID)
+	require.NoError(t, err)
+	require.NotNil(t, sched.NextRunAt)
+	assert.True(t, at(2026, 11, 10, 22, 0).Equal(*sched.NextRunAt))
+	assert.Equal(t, admin.ID, sched.RequesterID)
+
+	t.Run("创建后排入第一次发生", func(t *testing.T) {
+		cmds := pendingCommands(t, sched.ID)
+		require.Len(t, cmds, 1)
+		assert.Equal(t, fmt.Sprintf("ticket_schedule:%d:%d", sched.ID, at(2026, 11, 10, 22, 0).Unix()), cmds[0].IdempotencyKey)
+		assert.True(t, at(2026, 11, 10, 22, 0).Equal(cmds[0].AvailableAt))
+	})
+
+	t.Run("到期生成工单并续排下一次", func(t *testing.T) {
+		now = at(2026, 11, 10, 22, 1)
+		cmd := fire(t, sched.ID)
+
+		tickets := scheduledTickets(t)
+		require.Len(t, tickets, 1)
+		assert.Equal(t, "月度补丁 2026-11-10", tickets[0].Title)
+		assert.EqualValues(t, "high", tickets[0].Priority)
+		assert.Equal(t, agent.ID, tickets[0].AssigneeID)
+		assert.Equal(t, template.ID, tickets[0].TemplateID)
+		assert.Equal(t, "22:00-02:00", tickets[0].FormFields["window"])
+		assert.Equal(t, "web-01,web-02", tickets[0].FormFields["hosts"])
+
+		cmds := pendingCommands(t, sched.ID)
+		require.Len(t, cmds, 1)
+		assert.True(t, at(2026, 12, 8, 22, 0).Equal(cmds[0].AvailableAt))
+
+		// 命令重复投递时同一发生不重复建单
+		require.NoError(t, svc.HandleFireCommand(ctx, cmd))
+		assert.Len(t, scheduledTickets(t), 1)
+		assert.Len(t, pendingCommands(t, sched.ID), 1)
+
+		// 建单后回写执行记录前中断（停留在 generating），重试也不重复建单
+		_, err := client.TicketScheduleRun.Update().
+			Where(ticketschedulerun.ScheduleID(sched.ID)).
+			SetStatus(ticketschedulerun.StatusGenerating).
+			ClearTicketID().
+			Save(ctx)
+		require.NoError(t, err)
+		require.NoError(t, svc.HandleFireCommand(ctx, cmd))
+		assert.Len(t, scheduledTickets(t), 1)
+		_, err = client.TicketScheduleRun.Update().
+			Where(ticketschedulerun.ScheduleID(sched.ID)).
+			SetStatus(ticketschedulerun.StatusGenerated).
+			SetTicketID(tickets[0].ID).
+			Save(ctx)
+		require.NoError(t, err)
+
+		reloaded, err := svc.GetSchedule(ctx, tenant.ID, sched.ID)
+		require.NoError(t, err)
+		require.NotNil(t, reloaded.LastTicketID)
+		assert.Equal(t, tickets[0].ID, *reloaded.LastTicketID)
+		assert.True(t, at(2026, 12, 8, 22, 0).Equal(*reloaded.NextRunAt))
+	})
+
+	t.Run("停机恢复后只补最近一次", func(t *testing.T) {
+		now = at(2027, 2, 20, 8, 0)
+		fire(t, sched.ID) // 2026-12-08 被 2027-02-09 取代
+		assert.Len(t, scheduledTickets(t), 1)
+		cmds := pendingCommands(t, sched.ID)
+		require.Len(t, cmds, 1)
+		assert.True(t, at(2027, 2, 9, 22, 0).Equal(cmds[0].AvailableAt))
+
+		fire(t, sched.ID)
+		tickets := scheduledTickets(t)
+		require.Len(t, tickets, 2)
+		assert.Equal(t, "月度补丁 2027-02-09", tickets[1].Title)
+
+		runs, err := svc.ListRuns(ctx, tenant.ID, sched.ID, 0)
+		require.NoError(t, err)
+		require.Len(t, runs, 3)
+		assert.Equal(t, ticketschedulerun.StatusGenerated, runs[0].Status)
+		assert.Equal(t, ticketschedulerun.StatusSkipped, runs[1].Status)
+		assert.Equal(t, ticketschedulerun.StatusGenerated, runs[2].Status)
+	})
+
+	t.Run("停用作废待触发命令，重新启用后续排", func(t *testing.T) {
+		stale := pendingCommands(t, sched.ID)
+		require.Len(t, stale, 1)
+		disabled := false
+		updated, err := svc.UpdateSchedule(ctx, tenant.ID, sched.ID, &dto.UpdateTicketScheduleRequest{Enabled: &disabled})
+		require.NoError(t, err)
+		assert.Nil(t, updated.NextRunAt)
+		assert.Equal(t, sched.Revision+1, updated.Revision)
+		assert.Empty(t, pendingCommands(t, sched.ID))
+
+		// 过期 revision 的命令不再生成工单
+		require.NoError(t, svc.HandleFireCommand(ctx, stale[0]))
+		assert.Len(t, scheduledTickets(t), 2)
+
+		enabled := true
+		updated, err = svc.UpdateSchedule(ctx, tenant.ID, sched.ID, &dto.UpdateTicketScheduleRequest{Enabled: &enabled})
+		require.NoError(t, err)
+		require.NotNil(t, updated.NextRunAt)
+		assert.True(t, at(2027, 3, 9, 22, 0).Equal(*updated.NextRunAt))
+		assert.Len(t, pendingCommands(t, sched.ID), 1)
+	})
+
+	t.Run("巡检为中断的命令链续排", func(t *testing.T) {
+		cmds := pendingCommands(t, sched.ID)
+		require.Len(t, cmds, 1)
+		require.NoError(t, client.OperationalCommand.UpdateOne(cmds[0]).SetStatus(commandbus.StatusDeadLetter).Exec(ctx))
+
+		armed, err := svc.ReconcileSchedules(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, armed)
+		cmds = pendingCommands(t, sched.ID)
+		require.Len(t, cmds, 1)
+		assert.True(t, at(2027, 4, 13, 22, 0).Equal(cmds[0].AvailableAt), "死信的发生不重复入箱")
+
+		armed, err = svc.ReconcileSchedules(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, armed)
+	})
+
+	t.Run("服务目录项计划", func(t *testing.T) {
+		catalog, err := client.ServiceCatalog.Create().SetName("安全服务").SetTenantID(tenant.ID).Save(ctx)
+		require.NoError(t, err)
+		item, err := client.ServiceCatalogItem.Create().SetCatalogID(catalog.ID).SetName("证书续期").SetDescription("续期即将到期的 TLS 证书").SetTenantID(tenant.ID).Save(ctx)
+		require.NoError(t, err)
+
+		now = at(2027, 2, 20, 8, 0)
+		certSchedule, err := svc.CreateSchedule(ctx, &dto.CreateTicketScheduleRequest{
+			Name:          "证书续期",
+			SourceType:    TicketScheduleSourceCatalogItem,
+			CatalogItemID: &item.ID,
+			RequesterID:   agent.ID,
+			RuleType:      ScheduleRuleCron,
+			Expression:    "0 9 1 */3 *",
+		}, tenant.ID, admin.ID)
+		require.NoError(t, err)
+		assert.True(t, at(2027, 4, 1, 9, 0).Equal(*certSchedule.NextRunAt))
+
+		now = at(2027, 4, 1, 9, 0)
+		fire(t, certSchedule.ID)
+		tickets := scheduledTickets(t)
+		require.Len(t, tickets, 3)
+		assert.Equal(t, "证书续期 2027-04-01", tickets[2].Title)
+		assert.EqualValues(t, "service_request", tickets[2].Type)
+		assert.EqualValues(t, item.ID, tickets[2].FormFields["service_catalog_item_id"])
+		assert.EqualValues(t, certSchedule.ID, tickets[2].FormFields["ticket_schedule_id"])
+	})
+
+	t.Run("巡检处理建单中断的执行记录", func(t *testing.T) {
+		before := len(scheduledTickets(t))
+		// 占用后、建单前崩溃：工单未建成，重置后重新生成
+		run, err := client.TicketScheduleRun.Create().
+			SetTenantID(tenant.ID).
+			SetScheduleID(sched.ID).
+			SetOccurrenceAt(at(2027, 3, 9, 22, 0).UTC()).
+			SetFireAt(at(2027, 3, 9, 22, 0).UTC()).
+			SetStatus(ticketschedulerun.StatusGenerating).
+			SetUpdatedAt(now.Add(-time.Hour)).
+			Save(ctx)
+		require.NoError(t, err)
+		_, err = svc.ReconcileSchedules(ctx)
+		require.NoError(t, err)
+		tickets := scheduledTickets(t)
+		require.Len(t, tickets, before+1)
+		regenerated := tickets[len(tickets)-1]
+		assert.Equal(t, "月度补丁 2027-03-09", regenerated.Title)
+		assert.EqualValues(t, run.ID, regenerated.FormFields["ticket_schedule_run_id"])
+		run, err = client.TicketScheduleRun.Get(ctx, run.ID)
+		require.NoError(t, err)
+		assert.Equal(t, ticketschedulerun.StatusGenerated, run.Status)
+		require.NotNil(t, run.TicketID)
+		assert.Equal(t, regenerated.ID, *run.TicketID)
+
+		// 建单后、回写前崩溃：按工单上的执行记录ID补记，不重复建单
+		require.NoError(t, client.TicketScheduleRun.UpdateOne(run).
+			SetStatus(ticketschedulerun.StatusGenerating).
+			ClearTicketID().
+			SetUpdatedAt(now.Add(-time.Hour)).
+			Exec(ctx))
+		_, err = svc.ReconcileSchedules(ctx)
+		require.NoError(t, err)
+		assert.Len(t, scheduledTickets(t), before+1)
+		run, err = client.TicketScheduleRun.Get(ctx, run.ID)
+		require.NoError(t, err)
+		assert.Equal(t, ticketschedulerun.StatusGenerated, run.Status)
+		require.NotNil(t, run.TicketID)
+		assert.Equal(t, regenerated.ID, *run.TicketID)
+
+		// 未超时的 generating 可能仍在建单中，不处理
+		require.NoError(t, client.TicketScheduleRun.UpdateOne(run).
+			SetStatus(ticketschedulerun.StatusGenerating).
+			ClearTicketID().
+			SetUpdatedAt(now).
+			Exec(ctx))
+		_, err = svc.ReconcileSchedules(ctx)
+		require.NoError(t, err)
+		run, err = client.TicketScheduleRun.Get(ctx, run.ID)
+		require.NoError(t, err)
+		assert.Equal(t, ticketschedulerun.StatusGenerating, run.Status)
+	})
+
+	t.Run("校验", func(t *testing.T) {
+		_, err := svc.CreateSchedule(ctx, &dto.CreateTicketScheduleRequest{
+			Name: "无效来源", SourceType: TicketScheduleSourceCatalogItem, TemplateID: &template.ID,
+			RuleType: ScheduleRuleCron, Expression: "@daily",
+		}, tenant.ID, admin.ID)
+		assert.Error(t, err)
+
+		_, err = svc.CreateSchedule(ctx, &dto.CreateTicketScheduleRequest{
+			Name: "无效表达式", SourceType: TicketScheduleSourceTemplate, TemplateID: &template.ID,
+			RuleType: ScheduleRuleCron, Expression: "0 25 * * *",
+		}, tenant.ID, admin.ID)
+		assert.Error(t, err)
+
+		past := at(2026, 1, 1, 0, 0)
+		_, err = svc.CreateSchedule(ctx, &dto.CreateTicketScheduleRequest{
+			Name: "已过期", SourceType: TicketScheduleSourceTemplate, TemplateID: &template.ID,
+			RuleType: ScheduleRuleRRule, Expression: "FREQ=DAILY;COUNT=3", StartAt: &past,
+		}, tenant.ID, admin.ID)
+		assert.ErrorContains(t, err, "没有后续发生时刻")
+
+		occurrences, err := svc.Preview(ctx, tenant.ID, &dto.TicketSchedulePreviewRequest{RuleType: ScheduleRuleCron, Expression: "0 9 * * MON", Count: 3})
+		require.NoError(t, err)
+		require.Len(t, occurrences, 3)
+		assert.Equal(t, time.Monday, occurrences[0].OccurrenceAt.Weekday())
+	})
+
+	t.Run("删除计划", func(t *testing.T) {
+		require.NoError(t, svc.DeleteSchedule(ctx, tenant.ID, sched.ID))
+		assert.Empty(t, pendingCommands(t, sched.ID))
+		count, err := client.TicketScheduleRun.Query().Where(ticketschedulerun.ScheduleID(sched.ID)).Count(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, count)
+		assert.Len(t, scheduledTickets(t), 4, "已生成的工单保留")
+	})
+}
@@ -22,6 +22,7 @@ type TicketSearchService struct {
 // TicketSearchServiceInterface 工单搜索服务接口
 type TicketSearchServiceInterface interface {
 	SearchTickets(ctx context.Context, searchTerm string, tenantID int) ([]*ent.Ticket, error)
+	QueryTickets(ctx context.Context, query, cursor string, limit int, qctx TicketQueryContext) (*TicketQueryPage, error)
 	GetOverdueTickets(ctx context.Context, tenantID int) ([]*ent.Ticket, error)
 	GetTicketStats(ctx context.Context, tenantID int) (*dto.TicketStatsResponse, error)
 	GetTicketAnalytics(ctx context.Context, tenantID int, dateFrom, dateTo time.Time) (*dto.TicketAnalyticsResponse, error)
@@ -64,6 +65,21 @@ func (s *TicketSearchService) SearchTickets(ctx context.Context, searchTerm stri
 	return tickets, nil
 }
 
+// QueryTickets 按 TQL 查询工单，支持游标分页；qctx 提供租户隔离与 currentUser() 取值
+func (s *TicketSearchService) QueryTickets(ctx context.Context, query, cursor string, limit int, qctx TicketQueryContext) (*TicketQueryPage, error) {
+	compiled, err := compileTicketQuery(ctx, s.client, query, qctx)
+	if err != nil {
+		return nil, err
+	}
+	page, err := compiled.run(ctx, s.client, cursor, limit)
+	if err != nil {
+		s.logger.Errorw("Failed to query tickets", "query", query, "tenant_id", qctx.TenantID, "error", err)
+		return nil, err
+	}
+	s.logger.Infow("Tickets queried", "tenant_id", qctx.TenantID, "query", query, "count", len(page.Tickets))
+	return page, nil
+}
+
 // GetOverdueTickets 获取已过期的工单
 func (s *TicketSearchService) GetOverdueTickets(ctx context.Context, tenantID int) ([]*ent.Ticket, error) {
 	now := time.Now()
@@ -378,6 +378,11 @@ func (s *TicketService) CreateTicket(ctx context.Context, req *dto.CreateTicketR
 			}
 		}
 	}
+	// 开始 SLA/OLA/UC 指标计时（SLA 定义与自动分派已落库之后）
+	if s.client != nil {
+		recordTicketSLAEvent(ctx, NewSLAMetricService(s.client, s.logger), tkt.ID, tenantID,
+			SLAMetricEvent{Type: SLAEventCreated, ToStatus: string(tkt.Status)})
+	}
 
 	// 触发审批（同步，走 ApprovalService，查找匹配工作流并创建 ApprovalRecord）
 	// 这是 V1 缺失的 Phase 1 #1 缺陷修复：V2 必须让工单进入审批链路
@@ -1104,6 +1109,14 @@ func (s *TicketService) UpdateTicket(ctx context.Context, id int, req *dto.Updat
 		}
 		params.AssigneeID = &req.AssigneeID
 	}
+	if req.VendorID != nil {
+		if *req.VendorID > 0 && s.client != nil {
+			if err := validateTicketVendor(ctx, s.client, *req.VendorID, tenantID); err != nil {
+				return nil, err
+			}
+		}
+		params.VendorID = req.VendorID
+	}
 	categoryID := req.CategoryID
 	if categoryID == nil && strings.TrimSpace(req.Category) != "" {
 		if s.client == nil {
@@ -1147,6 +1160,7 @@ func (s *TicketService) UpdateTicket(ctx context.Context, id int, req *dto.Updat
 	if req.Resolution != "" {
 		params.Resolution = &req.Resolution
 	}
+	params.OnHold = req.OnHold
 
 	// 更新工单
 	updated, err := s.updateTicketWithFeishuCommand(ctx, id, params, tenantID, "updated")
@@ -1154,6 +1168,17 @@ func (s *TicketService) UpdateTicket(ctx context.Context, id int, req *dto.Updat
 		s.logger.Errorw("Failed to update ticket", "error", err)
 		return nil, err
 	}
+	// 进入/离开等待状态或切换挂起标记时暂停或恢复 SLA 计时
+	if s.slaSvc != nil && (req.Status != "" || req.OnHold != nil) {
+		syncTicketSLAClock(ctx, s.slaSvc, id, tenantID)
+	}
+	if s.client != nil {
+		assigneeChanged := req.AssigneeID != 0 && (current.AssigneeID == nil || *current.AssigneeID != req.AssigneeID)
+		metrics := NewSLAMetricService(s.client, s.logger)
+		for _, event := range ticketUpdateSLAEvents(string(current.Status), req.Status, assigneeChanged) {
+			recordTicketSLAEvent(ctx, metrics, id, tenantID, event)
+		}
+	}
 
 	s.logger.Infow("Ticket updated", "ticket_id", id)
 
@@ -2084,12 +2109,15 @@ func (s *TicketService) entToDomain(e *ent.Ticket) *ticket.Ticket {
 
 // ==================== 导出/导入/批量分配/分析 ====================
 
-// ExportTickets 导出工单
-func (s *TicketService) ExportTickets(ctx context.Context, tenantID int, filters map[string]interface{}, format string) ([]byte, error) {
+// ExportTickets 导出工单；非全量数据角色仅导出本人提交或处理的工单
+func (s *TicketService) ExportTickets(ctx context.Context, tenantID, userID int, role string, filters map[string]interface{}, format string) ([]byte, error) {
 	if s.client == nil {
 		return nil, fmt.Errorf("ent client not available for export")
 	}
 	query := s.client.Ticket.Query().Where(entTicket.TenantID(tenantID))
+	if !isTicketDataScopeAllRole(role) {
+		query = query.Where(entTicket.Or(entTicket.RequesterID(userID), entTicket.AssigneeID(userID)))
+	}
 	if status, ok := filters["status"].(string); ok && status != "" {
 		query = query.Where(entTicket.StatusEQ(status))
 	}
@@ -2100,6 +2128,42 @@ func (s *TicketService) ExportTickets(ctx context.Context, tenantID int, filters
 	if err != nil {
 		return nil, err
 	}
+	return s.exportTicketData(tickets, format)
+}
+
+// ExportTicketsByQuery 按 TQL 查询导出工单，导出全部匹配结果，排序遵循查询中的 ORDER BY；
+// 行级数据权限与 ListTickets 一致
+func (s *TicketService) ExportTicketsByQuery(ctx context.Context, tenantID, userID int, role, query, format string) ([]byte, error) {
+	if s.client == nil {
+		return nil, fmt.Errorf("ent client not available for export")
+	}
+	compiled, err := compileTicketQuery(ctx, s.client, query, TicketQueryContext{TenantID: tenantID, UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+	compiled.restrictToRole(userID, role)
+	tickets, err := compiled.applyOrder(s.client.Ticket.Query().Where(compiled.Predicate)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.exportTicketData(tickets, format)
+}
+
+// QueryTickets 按 TQL 查询工单，支持游标分页；行级数据权限与 ListTickets 一致
+func (s *TicketService) QueryTickets(ctx context.Context, req *dto.TicketQueryRequest, tenantID, userID int, role string) (*TicketQueryPage, error) {
+	if s.client == nil {
+		return nil, fmt.Errorf("ent client not available for query")
+	}
+	compiled, err := compileTicketQuery(ctx, s.client, req.Query, TicketQueryContext{TenantID: tenantID, UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+	compiled.restrictToRole(userID, role)
+	return compiled.run(ctx, s.client, req.Cursor, req.Limit)
+}
+
+// exportTicketData 将工单按导出格式序列化
+func (s *TicketService) exportTicketData(tickets []*ent.Ticket, format string) ([]byte, error) {
 	exportData := make([]map[string]interface{}, 0, len(tickets))
 	for _, t := range tickets {
 		exportData = append(exportData, map[string]interface{}{
@@ -0,0 +1,331 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"itsm-backend/common/slapause"
+	"itsm-backend/ent"
+	"itsm-backend/ent/sladefinition"
+	"itsm-backend/ent/ticket"
+	"itsm-backend/ent/ticketslapause"
+)
+
+// SLA 计时暂停
+//
+// SLA 定义的 pause_conditions 配置暂停计时的工单状态（如 pending_customer、pending_vendor）与挂起标记。
+// 工单命中条件时开启一条 TicketSLAPause 区间并记录 tickets.sla_paused_at；条件解除时关闭区间，
+// 按暂停时刻剩余的工作分钟数从恢复时刻重新计算截止时间，顺延前后的截止时间写入区间留档。
+// 暂停期间 SLA 监控跳过该工单的预警与违规判定。
+
+// SLA 计时目标
+const (
+	SLATargetResponse   = "response"
+	SLATargetResolution = "resolution"
+)
+
+// SLATargetTime 单个 SLA 目标的计时情况（工作分钟，已扣除暂停区间）
+type SLATargetTime struct {
+	Target                   string     `json:"target"` // response, resolution
+	Deadline                 *time.Time `json:"deadline"`
+	ElapsedBusinessMinutes   int        `json:"elapsedBusinessMinutes"`
+	RemainingBusinessMinutes int        `json:"remainingBusinessMinutes"` // 负数表示已超时
+	PausedBusinessMinutes    int        `json:"pausedBusinessMinutes"`
+	Completed                bool       `json:"completed"`
+	Breached                 bool       `json:"breached"`
+}
+
+// SLAPauseInterval SLA 计时暂停区间
+type SLAPauseInterval struct {
+	ID                       int        `json:"id"`
+	Reason                   string     `json:"reason"`
+	TicketStatus             string     `json:"ticketStatus"`
+	PausedAt                 time.Time  `json:"pausedAt"`
+	ResumedAt                *time.Time `json:"resumedAt,omitempty"`
+	PausedMinutes            int        `json:"pausedMinutes"`
+	ResponseDeadlineBefore   *time.Time `json:"responseDeadlineBefore,omitempty"`
+	ResponseDeadlineAfter    *time.Time `json:"responseDeadlineAfter,omitempty"`
+	ResolutionDeadlineBefore *time.Time `json:"resolutionDeadlineBefore,omitempty"`
+	ResolutionDeadlineAfter  *time.Time `json:"resolutionDeadlineAfter,omitempty"`
+}
+
+// slaPauseReason 按暂停条件判断工单当前是否应停止计时，返回暂停原因；空串表示正常计时
+func slaPauseReason(conditions *slapause.Conditions, t *ent.Ticket) string {
+	if conditions == nil {
+		return ""
+	}
+	if conditions.OnHold && t.SLAOnHold {
+		return slapause.ReasonOnHold
+	}
+	for _, status := range conditions.Statuses {
+		if status == t.Status {
+			return slapause.ReasonStatus
+		}
+	}
+	return ""
+}
+
+// shiftSLADeadline 恢复计时后顺延截止时间：暂停时刻剩余的工作分钟数从恢复时刻重新计时。
+// 暂停前已经超时的截止时间保持不变。
+func shiftSLADeadline(calc *BusinessCalendarCalculator, deadline, pausedAt, resumedAt time.Time) time.Time {
+	if deadline.IsZero() || !deadline.After(pausedAt) {
+		return deadline
+	}
+	remaining := calc.BusinessMinutesBetween(pausedAt, deadline)
+	return calc.AddBusinessMinutes(resumedAt, remaining)
+}
+
+// slaCalculator 返回 SLA 定义计时使用的工作日历：绑定日历优先，其次营业时间配置，都没有时按 7x24 计时
+func (s *TicketSLAService) slaCalculator(ctx context.Context, sla *ent.SLADefinition) *BusinessCalendarCalculator {
+	if sla != nil && sla.CalendarID != nil {
+		calendar, err := NewBusinessCalendarService(s.client).Calculator(ctx, sla.TenantID, *sla.CalendarID)
+		if err == nil {
+			return calendar
+		}
+		s.logger.Warnw("Failed to load business calendar, falling back to business hours", "calendarID", *sla.CalendarID, "error", err)
+	}
+	if sla != nil && len(sla.BusinessHours) > 0 {
+		return parseBusinessHoursConfig(sla.BusinessHours).calculator()
+	}
+	return newWeeklyCalendarCalculator(time.Local, nil, 0, 0, nil)
+}
+
+// ticketSLADefinition 返回工单绑定的 SLA 定义，未绑定时返回 nil
+func (s *TicketSLAService) ticketSLADefinition(ctx context.Context, t *ent.Ticket) (*ent.SLADefinition, error) {
+	if t.SLADefinitionID == 0 {
+		return nil, nil
+	}
+	sla, err := s.client.SLADefinition.Query().
+		Where(sladefinition.IDEQ(t.SLADefinitionID), sladefinition.TenantIDEQ(t.TenantID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return sla, err
+}
+
+// SyncSLAClock 按工单当前状态与挂起标记暂停或恢复 SLA 计时。
+// 工单状态或挂起标记变更后调用；SLA 监控巡检时也会调用，兜底未经过服务层的状态变更。
+func (s *TicketSLAService) SyncSLAClock(ctx context.Context, ticketID int, tenantID int) error {
+	t, err := s.client.Ticket.Query().
+		Where(ticket.IDEQ(ticketID), ticket.TenantID(tenantID), ticket.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = s.syncSLAClock(ctx, t, s.nowFunc())
+	return err
+}
+
+// syncSLAClock 暂停或恢复工单的 SLA 计时，返回最新的工单（未变化时原样返回）
+func (s *TicketSLAService) syncSLAClock(ctx context.Context, t *ent.Ticket, now time.Time) (*ent.Ticket, error) {
+	if t.SLADefinitionID == 0 && t.SLAPausedAt == nil {
+		return t, nil
+	}
+	sla, err := s.ticketSLADefinition(ctx, t)
+	if err != nil {
+		return nil, fmt.Errorf("load SLA definition: %w", err)
+	}
+	reason := ""
+	if sla != nil && t.ResolvedAt.IsZero() && t.ClosedAt == nil {
+		reason = slaPauseReason(sla.PauseConditions, t)
+	}
+
+	switch {
+	case reason != "" && t.SLAPausedAt == nil:
+		return s.pauseSLAClock(ctx, t, sla, reason, now)
+	case reason == "" && t.SLAPausedAt != nil:
+		return s.resumeSLAClock(ctx, t, sla, now)
+	}
+	return t, nil
+}
+
+// pauseSLAClock 开启暂停区间并停止计时
+func (s *TicketSLAService) pauseSLAClock(ctx context.Context, t *ent.Ticket, sla *ent.SLADefinition, reason string, now time.Time) (*ent.Ticket, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin SLA pause transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// 条件更新：并发的另一次同步已经暂停时不再重复开启区间
+	updated, err := tx.Ticket.UpdateOneID(t.ID).
+		Where(ticket.TenantIDEQ(t.TenantID), ticket.SLAPausedAtIsNil()).
+		SetSLAPausedAt(now).
+		Save(ctx)
+	if ent.IsNotFound(err) {
+		return t, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("pause SLA clock: %w", err)
+	}
+
+	create := tx.TicketSLAPause.Create().
+		SetTicketID(t.ID).
+		SetSLADefinitionID(sla.ID).
+		SetReason(reason).
+		SetTicketStatus(t.Status).
+		SetPausedAt(now).
+		SetTenantID(t.TenantID)
+	if !t.SLAResponseDeadline.IsZero() {
+		create.SetResponseDeadlineBefore(t.SLAResponseDeadline)
+	}
+	if !t.SLAResolutionDeadline.IsZero() {
+		create.SetResolutionDeadlineBefore(t.SLAResolutionDeadline)
+	}
+	if _, err := create.Save(ctx); err != nil {
+		return nil, fmt.Errorf("record SLA pause: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit SLA pause: %w", err)
+	}
+
+	s.logger.Infow("SLA clock paused", "ticket_id", t.ID, "reason", reason, "status", t.Status)
+	return updated, nil
+}
+
+// resumeSLAClock 关闭暂停区间，顺延截止时间并恢复计时
+func (s *TicketSLAService) resumeSLAClock(ctx context.Context, t *ent.Ticket, sla *ent.SLADefinition, now time.Time) (*ent.Ticket, error) {
+	pausedAt := *t.SLAPausedAt
+	calc := s.slaCalculator(ctx, sla)
+	pausedMinutes := calc.BusinessMinutesBetween(pausedAt, now)
+
+	responseDeadline := t.SLAResponseDeadline
+	if t.FirstResponseAt.IsZero() || t.FirstResponseAt.After(pausedAt) {
+		responseDeadline = shiftSLADeadline(calc, t.SLAResponseDeadline, pausedAt, now)
+	}
+	resolutionDeadline := t.SLAResolutionDeadline
+	if t.ResolvedAt.IsZero() || t.ResolvedAt.After(pausedAt) {
+		resolutionDeadline = shiftSLADeadline(calc, t.SLAResolutionDeadline, pausedAt, now)
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin SLA resume transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	update := tx.Ticket.UpdateOneID(t.ID).
+		Where(ticket.TenantIDEQ(t.TenantID), ticket.SLAPausedAtNotNil()).
+		ClearSLAPausedAt()
+	if !responseDeadline.IsZero() {
+		update.SetSLAResponseDeadline(responseDeadline)
+	}
+	if !resolutionDeadline.IsZero() {
+		update.SetSLAResolutionDeadline(resolutionDeadline)
+	}
+	updated, err := update.Save(ctx)
+	if ent.IsNotFound(err) {
+		return t, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("resume SLA clock: %w", err)
+	}
+
+	closePause := tx.TicketSLAPause.Update().
+		Where(
+			ticketslapause.TicketIDEQ(t.ID),
+			ticketslapause.TenantIDEQ(t.TenantID),
+			ticketslapause.ResumedAtIsNil(),
+		).
+		SetResumedAt(now).
+		SetPausedMinutes(pausedMinutes)
+	if !responseDeadline.IsZero() {
+		closePause.SetResponseDeadlineAfter(responseDeadline)
+	}
+	if !resolutionDeadline.IsZero() {
+		closePause.SetResolutionDeadlineAfter(resolutionDeadline)
+	}
+	if _, err := closePause.Save(ctx); err != nil {
+		return nil, fmt.Errorf("close SLA pause: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit SLA resume: %w", err)
+	}
+
+	s.logger.Infow("SLA clock resumed", "ticket_id", t.ID, "paused_minutes", pausedMinutes,
+		"response_deadline", responseDeadline, "resolution_deadline", resolutionDeadline)
+	return updated, nil
+}
+
+// ListSLAPauses 返回工单的 SLA 暂停区间，按暂停时间升序
+func (s *TicketSLAService) ListSLAPauses(ctx context.Context, ticketID int, tenantID int) ([]*SLAPauseInterval, error) {
+	pauses, err := s.client.TicketSLAPause.Query().
+		Where(ticketslapause.TicketIDEQ(ticketID), ticketslapause.TenantIDEQ(tenantID)).
+		Order(ent.Asc(ticketslapause.FieldPausedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	intervals := make([]*SLAPauseInterval, 0, len(pauses))
+	for _, p := range pauses {
+		intervals = append(intervals, &SLAPauseInterval{
+			ID:                       p.ID,
+			Reason:                   p.Reason,
+			TicketStatus:             p.TicketStatus,
+			PausedAt:                 p.PausedAt,
+			ResumedAt:                p.ResumedAt,
+			PausedMinutes:            p.PausedMinutes,
+			ResponseDeadlineBefore:   p.ResponseDeadlineBefore,
+			ResponseDeadlineAfter:    p.ResponseDeadlineAfter,
+			ResolutionDeadlineBefore: p.ResolutionDeadlineBefore,
+			ResolutionDeadlineAfter:  p.ResolutionDeadlineAfter,
+		})
+	}
+	return intervals, nil
+}
+
+// slaTargetTime 计算单个 SLA 目标的工作时间：从建单计到目标完成时刻（未完成时计到当前时刻，暂停中冻结在暂停时刻），扣除其间的暂停区间
+func slaTargetTime(calc *BusinessCalendarCalculator, target string, createdAt time.Time, deadline *time.Time, completedAt time.Time, pauses []*SLAPauseInterval, now time.Time) SLATargetTime {
+	result := SLATargetTime{Target: target, Deadline: deadline, Completed: !completedAt.IsZero()}
+	until := now
+	if result.Completed {
+		until = completedAt
+	}
+	clock := until
+	for _, p := range pauses {
+		if p.ResumedAt == nil && !result.Completed && p.PausedAt.Before(clock) {
+			clock = p.PausedAt
+		}
+	}
+
+	pausedWithin := func(from, to time.Time) int {
+		total := 0
+		for _, p := range pauses {
+			start, end := p.PausedAt, now
+			if p.ResumedAt != nil {
+				end = *p.ResumedAt
+			}
+			if start.Before(from) {
+				start = from
+			}
+			if end.After(to) {
+				end = to
+			}
+			total += calc.BusinessMinutesBetween(start, end)
+		}
+		return total
+	}
+	result.PausedBusinessMinutes = pausedWithin(createdAt, until)
+	result.ElapsedBusinessMinutes = calc.BusinessMinutesBetween(createdAt, clock) - pausedWithin(createdAt, clock)
+	if result.ElapsedBusinessMinutes < 0 {
+		result.ElapsedBusinessMinutes = 0
+	}
+	if deadline != nil {
+		if deadline.After(clock) {
+			result.RemainingBusinessMinutes = calc.BusinessMinutesBetween(clock, *deadline)
+		} else {
+			result.RemainingBusinessMinutes = -calc.BusinessMinutesBetween(*deadline, clock)
+			result.Breached = clock.After(*deadline)
+		}
+	}
+	return result
+}
+
+// syncTicketSLAClock 工单状态或挂起标记变更后同步 SLA 计时。失败只记录日志，由 SLA 监控巡检兜底。
+func syncTicketSLAClock(ctx context.Context, sla *TicketSLAService, ticketID, tenantID int) {
+	if err := sla.SyncSLAClock(ctx, ticketID, tenantID); err != nil {
+		sla.logger.Warnw("Failed to sync SLA clock", "ticket_id", ticketID, "error", err)
+	}
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"itsm-backend/common/slapause"
+	"itsm-backend/ent"
+	"itsm-backend/ent/enttest"
+	"itsm-backend/ent/ticketslapause"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+// newSLAPauseFixture 创建带暂停条件的 SLA 定义与一张已落库截止时间的工单
+func newSLAPauseFixture(t *testing.T, client *ent.Client, createdAt time.Time) *ent.Ticket {
+	t.Helper()
+	ctx := context.Background()
+	tenant, err := client.Tenant.Create().
+		SetName("Pause Tenant").SetCode("pause").SetDomain("pause.example.com").SetStatus("active").
+		Save(ctx)
+	require.NoError(t, err)
+	requester, err := client.User.Create().
+		SetUsername("pause-user").SetEmail("pause@example.com").SetName("Pause User").
+		SetPasswordHash("hash").SetRole("end_user").SetActive(true).SetTenantID(tenant.ID).
+		Save(ctx)
+	require.NoError(t, err)
+	sla, err := client.SLADefinition.Create().
+		SetName("7x24").SetServiceType("incident").SetPriority("high").
+		SetResponseTime(60).SetResolutionTime(240).
+		SetPauseConditions(&slapause.Conditions{Statuses: []string{"pending_customer", "pending_vendor"}, OnHold: true}).
+		SetTenantID(tenant.ID).
+		Save(ctx)
+	require.NoError(t, err)
+	tk, err := client.Ticket.Create().
+		SetTitle("等待客户").SetDescription("等待客户答复").SetPriority("high").SetStatus("in_progress").
+		SetTicketNumber("SLA-PAUSE").SetRequesterID(requester.ID).SetTenantID(tenant.ID).
+		SetSLADefinitionID(sla.ID).
+		SetCreatedAt(createdAt).
+		SetSLAResponseDeadline(createdAt.Add(time.Hour)).
+		SetSLAResolutionDeadline(createdAt.Add(4 * time.Hour)).
+		Save(ctx)
+	require.NoError(t, err)
+	return tk
+}
+
+func TestTicketSLAService_SyncSLAClockShiftsDeadlinesAfterPause(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", testDSN())
+	defer client.Close()
+	ctx := context.Background()
+
+	created := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	tk := newSLAPauseFixture(t, client, created)
+	now := created.Add(30 * time.Minute)
+	service := NewTicketSLAService(client, zaptest.NewLogger(t).Sugar()).withNowFunc(func() time.Time { return now })
+
+	// 进入等待客户状态：开启暂停区间
+	require.NoError(t, client.Ticket.UpdateOneID(tk.ID).SetStatus("pending_customer").Exec(ctx))
+	require.NoError(t, service.SyncSLAClock(ctx, tk.ID, tk.TenantID))
+	paused := client.Ticket.GetX(ctx, tk.ID)
+	require.NotNil(t, paused.SLAPausedAt)
+	assert.True(t, paused.SLAPausedAt.Equal(now))
+
+	// 暂停期间重复同步不产生新区间
+	now = now.Add(time.Hour)
+	require.NoError(t, service.SyncSLAClock(ctx, tk.ID, tk.TenantID))
+	assert.Equal(t, 1, client.TicketSLAPause.Query().CountX(ctx))
+
+	info, err := service.GetTicketSLAInfo(ctx, tk.ID, tk.TenantID)
+	require.NoError(t, err)
+	assert.Equal(t, "paused", info.SLAStatus)
+	assert.True(t, info.Paused)
+	require.Len(t, info.Targets, 2)
+	assert.Equal(t, 30, info.Targets[0].ElapsedBusinessMinutes)
+	assert.Equal(t, 30, info.Targets[0].RemainingBusinessMinutes, "暂停中剩余时间冻结在暂停时刻")
+	assert.False(t, info.ResponseBreached, "暂停期间越过原截止时间不算违约")
+
+	// 恢复处理：截止时间顺延 2 小时暂停时长
+	now = now.Add(time.Hour)
+	require.NoError(t, client.Ticket.UpdateOneID(tk.ID).SetStatus("in_progress").Exec(ctx))
+	require.NoError(t, service.SyncSLAClock(ctx, tk.ID, tk.TenantID))
+	resumed := client.Ticket.GetX(ctx, tk.ID)
+	assert.Nil(t, resumed.SLAPausedAt)
+	assert.True(t, resumed.SLAResponseDeadline.Equal(created.Add(3*time.Hour)), resumed.SLAResponseDeadline)
+	assert.True(t, resumed.SLAResolutionDeadline.Equal(created.Add(6*time.Hour)), resumed.SLAResolutionDeadline)
+
+	pause := client.TicketSLAPause.Query().Where(ticketslapause.TicketIDEQ(tk.ID)).OnlyX(ctx)
+	assert.Equal(t, slapause.ReasonStatus, pause.Reason)
+	assert.Equal(t, "pending_customer", pause.TicketStatus)
+	assert.Equal(t, 120, pause.PausedMinutes)
+	require.NotNil(t, pause.ResumedAt)
+	assert.True(t, pause.ResponseDeadlineBefore.Equal(created.Add(time.Hour)))
+	assert.True(t, pause.ResponseDeadlineAfter.Equal(created.Add(3*time.Hour)))
+
+	info, err = service.GetTicketSLAInfo(ctx, tk.ID, tk.TenantID)
+	require.NoError(t, err)
+	assert.Equal(t, 30, info.Targets[0].ElapsedBusinessMinutes)
+	assert.Equal(t, 120, info.Targets[0].PausedBusinessMinutes)
+	assert.Equal(t, 30, info.Targets[0].RemainingBusinessMinutes)
+	require.Len(t, info.Pauses, 1)
+}
+
+func TestTicketSLAService_SyncSLAClockOnHold(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", testDSN())
+	defer client.Close()
+	ctx := context.Background()
+
+	created := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	tk := newSLAPauseFixture(t, client, created)
+	now := created.Add(2 * time.Hour)
+	service := NewTicketSLAService(client, zaptest.NewLogger(t).Sugar()).withNowFunc(func() time.Time { return now })
+
+	require.NoError(t, client.Ticket.UpdateOneID(tk.ID).SetSLAOnHold(true).Exec(ctx))
+	require.NoError(t, service.SyncSLAClock(ctx, tk.ID, tk.TenantID))
+	pause := client.TicketSLAPause.Query().OnlyX(ctx)
+	assert.Equal(t, slapause.ReasonOnHold, pause.Reason)
+
+	// 响应截止时间在暂停前已超时，恢复后不顺延；解决截止时间顺延
+	now = now.Add(time.Hour)
+	require.NoError(t, client.Ticket.UpdateOneID(tk.ID).SetSLAOnHold(false).Exec(ctx))
+	require.NoError(t, service.SyncSLAClock(ctx, tk.ID, tk.TenantID))
+	resumed := client.Ticket.GetX(ctx, tk.ID)
+	assert.True(t, resumed.SLAResponseDeadline.Equal(created.Add(time.Hour)))
+	assert.True(t, resumed.SLAResolutionDeadline.Equal(created.Add(5*time.Hour)))
+}
+
+func TestSLATargetTime_ExcludesPausedBusinessMinutes(t *testing.T) {
+	calc := newWeeklyCalendarCalculator(time.UTC, weekdayNumbers(1, 5), 9*60, 18*60, nil)
+	created := time.Date(2026, 3, 6, 16, 0, 0, 0, time.UTC) // 周五 16:00
+	resumed := time.Date(2026, 3, 9, 10, 0, 0, 0, time.UTC) // 周一 10:00
+	deadline := time.Date(2026, 3, 9, 16, 0, 0, 0, time.UTC)
+	pauses := []*SLAPauseInterval{{PausedAt: created.Add(time.Hour), ResumedAt: &resumed}}
+	now := time.Date(2026, 3, 9, 11, 0, 0, 0, time.UTC)
+
+	got := slaTargetTime(calc, SLATargetResolution, created, &deadline, time.Time{}, pauses, now)
+	assert.Equal(t, 120, got.PausedBusinessMinutes) // 周五 17-18 点 + 周一 9-10 点
+	assert.Equal(t, 120, got.ElapsedBusinessMinutes)
+	assert.Equal(t, 300, got.RemainingBusinessMinutes)
+	assert.False(t, got.Breached)
+}
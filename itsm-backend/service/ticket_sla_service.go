@@ -43,7 +43,14 @@ type TicketSLAInfoResult struct {
 	ResolutionTimeUsed int        `json:"resolutionTimeUsed"` // 分钟
 	ResponseBreached   bool       `json:"responseBreached"`
 	ResolutionBreached bool       `json:"resolutionBreached"`
-	SLAStatus          string     `json:"slaStatus"` // ok, warning, breached
+	SLAStatus          string     `json:"slaStatus"` // ok, warning, breached, paused
+	// 计时暂停：暂停中时 PausedAt 非空，Targets 为扣除暂停区间后的工作时间
+	Paused   bool                `json:"paused"`
+	PausedAt *time.Time          `json:"pausedAt,omitempty"`
+	Targets  []SLATargetTime     `json:"targets"`
+	Pauses   []*SLAPauseInterval `json:"pauses"`
+	// 各协议（SLA/OLA/UC）指标的计时周期
+	Metrics []*TicketSLAMetricView `json:"metrics"`
 }
 
 // SLADeadlineResult SLA截止时间计算结果
@@ -110,8 +117,11 @@ func (s *TicketSLAService) GetTicketSLAInfo(ctx context.Context, ticketID int, t
 		resolutionTimeUsed = int(t.ResolvedAt.Sub(t.CreatedAt).Minutes())
 	}
 
-	// 获取SLA定义
-	slaDef, err := s.getSLADefinition(ctx, tenantID, t.Type, t.Priority)
+	// 获取SLA定义：优先使用工单绑定的定义
+	slaDef, err := s.ticketSLADefinition(ctx, t)
+	if err == nil && slaDef == nil {
+		slaDef, err = s.getSLADefinition(ctx, tenantID, t.Type, t.Priority)
+	}
 	if err != nil {
 		s.logger.Warnw("Failed to get SLA definition", "error", err)
 		// 返回没有SLA信息的结果
@@ -129,37 +139,49 @@ func (s *TicketSLAService) GetTicketSLAInfo(ctx context.Context, ticketID int, t
 	// 计算截止时间。
 	// 阻断7/C-8 修复：统一读取 slaDef.BusinessHours 配置，与 CalculateSLADeadlineFromRequest
 	// 使用同一口径，消除"建单落库调整 / 查询展示不调整"的两路径结论相反问题。
+	// 已落库的截止时间包含暂停顺延，优先使用。
 	var responseDeadline, resolutionDeadline *time.Time
-	if slaDef.ResponseTime > 0 {
-		respDeadline := s.calculateDeadlineWithBusinessHours(t.CreatedAt, slaDef.ResponseTime, slaDef.BusinessHours)
+	if !t.SLAResponseDeadline.IsZero() {
+		responseDeadline = toPointer(t.SLAResponseDeadline)
+	} else if slaDef.ResponseTime > 0 {
+		respDeadline := s.calculateDeadline(ctx, slaDef, t.CreatedAt, slaDef.ResponseTime)
 		responseDeadline = &respDeadline
 	}
-	if slaDef.ResolutionTime > 0 {
-		resDeadline := s.calculateDeadlineWithBusinessHours(t.CreatedAt, slaDef.ResolutionTime, slaDef.BusinessHours)
+	if !t.SLAResolutionDeadline.IsZero() {
+		resolutionDeadline = toPointer(t.SLAResolutionDeadline)
+	} else if slaDef.ResolutionTime > 0 {
+		resDeadline := s.calculateDeadline(ctx, slaDef, t.CreatedAt, slaDef.ResolutionTime)
 		resolutionDeadline = &resDeadline
 	}
 
-	// 判断是否违规
-	responseBreached := false
-	resolutionBreached := false
-	slaStatus := "ok"
-
-	if responseDeadline != nil && time.Now().After(*responseDeadline) {
-		responseBreached = true
-		slaStatus = "breached"
+	// 按工作日历计算各目标的已用/剩余时间，暂停区间不计时
+	pauses, err := s.ListSLAPauses(ctx, t.ID, tenantID)
+	if err != nil {
+		s.logger.Errorw("Failed to list SLA pauses", "ticketID", ticketID, "error", err)
+		return nil, err
 	}
-
-	if resolutionDeadline != nil && time.Now().After(*resolutionDeadline) {
-		resolutionBreached = true
-		slaStatus = "breached"
+	metrics, err := NewSLAMetricService(s.client, s.logger).ListTicketMetrics(ctx, t.ID, tenantID)
+	if err != nil {
+		s.logger.Errorw("Failed to list SLA metrics", "ticketID", ticketID, "error", err)
+		return nil, err
 	}
+	now := s.nowFunc()
+	calc := s.slaCalculator(ctx, slaDef)
+	responseTarget := slaTargetTime(calc, SLATargetResponse, t.CreatedAt, responseDeadline, t.FirstResponseAt, pauses, now)
+	resolutionTarget := slaTargetTime(calc, SLATargetResolution, t.CreatedAt, resolutionDeadline, t.ResolvedAt, pauses, now)
 
-	// 检查警告状态（默认30分钟警告）
-	if !responseBreached && !resolutionBreached && responseDeadline != nil {
-		timeLeft := time.Until(*responseDeadline)
-		if timeLeft.Minutes() < 30 {
-			slaStatus = "warning"
-		}
+	// 判断是否违规：已完成的目标按完成时刻判定，暂停中按暂停时刻判定
+	responseBreached := responseTarget.Breached
+	resolutionBreached := resolutionTarget.Breached
+	slaStatus := "ok"
+	switch {
+	case responseBreached || resolutionBreached:
+		slaStatus = "breached"
+	case t.SLAPausedAt != nil:
+		slaStatus = "paused"
+	case responseDeadline != nil && !responseTarget.Completed && responseDeadline.Sub(now).Minutes() < 30:
+		// 检查警告状态（默认30分钟警告）
+		slaStatus = "warning"
 	}
 
 	return &TicketSLAInfoResult{
@@ -174,6 +196,11 @@ func (s *TicketSLAService) GetTicketSLAInfo(ctx context.Context, ticketID int, t
 		ResponseBreached:   responseBreached,
 		ResolutionBreached: resolutionBreached,
 		SLAStatus:          slaStatus,
+		Paused:             t.SLAPausedAt != nil,
+		PausedAt:           t.SLAPausedAt,
+		Targets:            []SLATargetTime{responseTarget, resolutionTarget},
+		Pauses:             pauses,
+		Metrics:            toTicketSLAMetricViews(metrics),
 	}, nil
 }
 
@@ -267,12 +294,12 @@ func (s *TicketSLAService) CalculateSLADeadline(ctx context.Context, tenantID in
 	now := time.Now()
 
 	if slaDef.ResponseTime > 0 {
-		respDeadline := s.calculateDeadlineWithBusinessHours(now, slaDef.ResponseTime, slaDef.BusinessHours)
+		respDeadline := s.calculateDeadline(ctx, slaDef, now, slaDef.ResponseTime)
 		result.ResponseDeadline = &respDeadline
 	}
 
 	if slaDef.ResolutionTime > 0 {
-		resDeadline := s.calculateDeadlineWithBusinessHours(now, slaDef.ResolutionTime, slaDef.BusinessHours)
+		resDeadline := s.calculateDeadline(ctx, slaDef, now, slaDef.ResolutionTime)
 		result.ResolutionDeadline = &resDeadline
 	}
 
@@ -314,6 +341,18 @@ func (s *TicketSLAService) getSLADefinition(ctx context.Context, tenantID int, t
 	}, nil
 }
 
+// calculateDeadline 按 SLA 定义的工作日历计算截止时间：绑定了工作日历时按日历计时，否则按 business_hours 营业时间配置。
+func (s *TicketSLAService) calculateDeadline(ctx context.Context, sla *ent.SLADefinition, startTime time.Time, durationMinutes int) time.Time {
+	if sla.CalendarID != nil {
+		calendar, err := NewBusinessCalendarService(s.client).Calculator(ctx, sla.TenantID, *sla.CalendarID)
+		if err == nil {
+			return calendar.AddBusinessMinutes(startTime, durationMinutes)
+		}
+		s.logger.Warnw("Failed to load business calendar, falling back to business hours", "calendarID", *sla.CalendarID, "error", err)
+	}
+	return s.calculateDeadlineWithBusinessHours(startTime, durationMinutes, sla.BusinessHours)
+}
+
 // calculateDeadlineWithBusinessHours applies an SLA definition's configured
 // business calendar. An empty calendar intentionally preserves 24x7 SLA time.
 func (s *TicketSLAService) calculateDeadlineWithBusinessHours(startTime time.Time, durationMinutes int, businessHours map[string]interface{}) time.Time {
@@ -327,7 +366,7 @@ func (s *TicketSLAService) calculateDeadlineWithBusinessHours(startTime time.Tim
 // 阻断7 说明：此方法保留向后兼容，仅用于"把某个时刻对齐到最近的工作时段起点"，
 // 不能用于计算 SLA 截止时间（截止时间必须用 calculateDeadline/addBusinessMinutes）。
 func (s *TicketSLAService) AdjustToBusinessHours(t time.Time) time.Time {
-	return adjustToBusinessHoursStart(t, defaultBusinessHoursConfig())
+	return DefaultBusinessCalendarCalculator().NextBusinessTime(t)
 }
 
 // businessHoursConfig 业务时间配置。
@@ -426,92 +465,23 @@ func parseBusinessHoursConfig(raw map[string]interface{}) businessHoursConfig {
 	return cfg
 }
 
-// isHoliday 判断给定日期是否为节假日。
-func (c businessHoursConfig) isHoliday(t time.Time) bool {
-	return c.holidays[t.Format("2006-01-02")]
-}
-
-// isWorkDay 判断给定日期是否为工作日（工作日集合 + 非节假日）。
-func (c businessHoursConfig) isWorkDay(t time.Time) bool {
-	if c.isHoliday(t) {
-		return false
-	}
-	return c.workDays[t.Weekday()]
-}
-
-// workDayStart 返回 t 所在工作日的工时开始时刻。
-func (c businessHoursConfig) workDayStart(t time.Time) time.Time {
-	y, m, d := t.Date()
-	return time.Date(y, m, d, c.startHour, c.startMin, 0, 0, t.Location())
-}
-
-// workDayEnd 返回 t 所在工作日的工时结束时刻。
-func (c businessHoursConfig) workDayEnd(t time.Time) time.Time {
-	y, m, d := t.Date()
-	return time.Date(y, m, d, c.endHour, c.endMin, 0, 0, t.Location())
-}
-
-// nextWorkDayStart 返回 t 之后下一个工作日的工时开始时刻。
-func (c businessHoursConfig) nextWorkDayStart(t time.Time) time.Time {
-	next := t.AddDate(0, 0, 1)
-	for !c.isWorkDay(next) {
-		next = next.AddDate(0, 0, 1)
-	}
-	return c.workDayStart(next)
-}
-
-// adjustToBusinessHoursStart 把时刻 t 对齐到最近的工作时段起点。
-// 若 t 已在工作时段内，返回 t；否则返回下一个工作时段起点。
-func adjustToBusinessHoursStart(t time.Time, cfg businessHoursConfig) time.Time {
-	// 跳过非工作日
-	for !cfg.isWorkDay(t) {
-		t = cfg.nextWorkDayStart(t)
-	}
-	dayStart := cfg.workDayStart(t)
-	dayEnd := cfg.workDayEnd(t)
-	if t.Before(dayStart) {
-		return dayStart
-	}
-	if !t.Before(dayEnd) {
-		// 当天工时已结束，跳到下一个工作日
-		return cfg.nextWorkDayStart(t)
+// calculator 把旧的营业时间配置转换为工作日历计算器。
+func (c businessHoursConfig) calculator() *BusinessCalendarCalculator {
+	var workDays []time.Weekday
+	for weekday, ok := range c.workDays {
+		if ok {
+			workDays = append(workDays, weekday)
+		}
 	}
-	return t
+	start := c.startHour*60 + c.startMin
+	end := c.endHour*60 + c.endMin
+	return newWeeklyCalendarCalculator(c.loc, workDays, start, end, c.holidays)
 }
 
 // addBusinessMinutes 从 start 开始，只在工作时段内消耗 minutes 分钟，返回截止时刻。
-// 阻断7 核心修复：正确排除非工作时段，而非把截止时刻平移到最近工时起点。
-// 算法：
-//  1. 若 start 在非工作时段，先把指针移到最近的工作时段起点。
-//  2. 计算当天剩余工时；若 minutes <= 当天剩余工时，截止时刻 = 当前指针 + minutes。
-//  3. 否则扣减当天剩余工时，跳到下一个工作日起点继续消耗，直到 minutes 耗尽。
+// 工作时段按配置时区计算，否则 9:00-18:00 窗口会按宿主机/UTC 时区计算，跨时区租户违约整体偏移。
 func addBusinessMinutes(start time.Time, minutes int, cfg businessHoursConfig) time.Time {
-	remaining := time.Duration(minutes) * time.Minute
-	loc := cfg.loc
-	if loc == nil {
-		loc = time.Local
-	}
-	// 关键修复：把起始时刻换算到配置时区后再计算工作窗口，
-	// 否则 9:00-18:00 窗口会按宿主机/UTC 时区计算，跨时区租户违约整体偏移。
-	cursor := adjustToBusinessHoursStart(start.In(loc), cfg)
-
-	// 防御性上限：避免极端 minutes 导致死循环（最多循环 365 天）。
-	for i := 0; i < 366 && remaining > 0; i++ {
-		dayEnd := cfg.workDayEnd(cursor)
-		available := dayEnd.Sub(cursor)
-		if available <= 0 {
-			// 理论上 adjustToBusinessHoursStart 已保证 cursor 在工时内，
-			// 此分支仅为防御性兜底，避免死循环。
-			cursor = cfg.nextWorkDayStart(cursor)
-			continue
-		}
-		if remaining <= available {
-			return cursor.Add(remaining)
-		}
-		remaining -= available
-		cursor = cfg.nextWorkDayStart(cursor)
-	}
-	return cursor
+	return cfg.calculator().AddBusinessMinutes(start, minutes)
 }
 
 // CalculateSLADeadlineFromRequest 根据请求参数计算SLA截止时间（包含SLADefinitionID）
@@ -575,9 +545,9 @@ func (s *TicketSLAService) CalculateSLADeadlineFromRequest(ctx context.Context,
 	// 阻断7/C-8 修复：统一走 SLA 配置的工作日历。
 	// 旧逻辑用 AdjustToBusinessHours 平移截止时刻，导致非工作时段被当作顺延而非排除，
 	// 且与 GetTicketSLAInfo 路径使用不同口径，造成同一工单"是否违规"两路径结论相反。
-	businessHoursOnly := len(sla.BusinessHours) > 0
-	responseDeadline := s.calculateDeadlineWithBusinessHours(now, sla.ResponseTime, sla.BusinessHours)
-	resolutionDeadline := s.calculateDeadlineWithBusinessHours(now, sla.ResolutionTime, sla.BusinessHours)
+	businessHoursOnly := len(sla.BusinessHours) > 0 || sla.CalendarID != nil
+	responseDeadline := s.calculateDeadline(ctx, sla, now, sla.ResponseTime)
+	resolutionDeadline := s.calculateDeadline(ctx, sla, now, sla.ResolutionTime)
 
 	return &SLADeadlineResult{
 		SLADefinitionID:    sla.ID,
@@ -594,9 +564,9 @@ func (s *TicketSLAService) CalculateSLADeadlineByDefinition(ctx context.Context,
 		return nil, fmt.Errorf("bound SLA definition is unavailable: %w", err)
 	}
 	now := s.nowFunc()
-	responseDeadline := s.calculateDeadlineWithBusinessHours(now, sla.ResponseTime, sla.BusinessHours)
-	resolutionDeadline := s.calculateDeadlineWithBusinessHours(now, sla.ResolutionTime, sla.BusinessHours)
-	return &SLADeadlineResult{SLADefinitionID: sla.ID, ResponseDeadline: &responseDeadline, ResolutionDeadline: &resolutionDeadline, BusinessHoursOnly: len(sla.BusinessHours) > 0}, nil
+	responseDeadline := s.calculateDeadline(ctx, sla, now, sla.ResponseTime)
+	resolutionDeadline := s.calculateDeadline(ctx, sla, now, sla.ResolutionTime)
+	return &SLADeadlineResult{SLADefinitionID: sla.ID, ResponseDeadline: &responseDeadline, ResolutionDeadline: &resolutionDeadline, BusinessHoursOnly: len(sla.BusinessHours) > 0 || sla.CalendarID != nil}, nil
 }
 
 // toPointer 返回指针（辅助函数）
@@ -2,8 +2,12 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
+	"unicode"
 
 	"itsm-backend/dto"
 	"itsm-backend/ent"
@@ -100,9 +104,14 @@ func (s *TicketViewService) CreateTicketView(
 ) (*dto.TicketViewResponse, error) {
 	s.logger.Infow("Creating ticket view", "name", req.Name, "user_id", userID, "tenant_id", tenantID)
 
+	if err := s.validateViewQuery(ctx, req.Query, userID, tenantID); err != nil {
+		return nil, err
+	}
+
 	view, err := s.client.TicketView.Create().
 		SetName(req.Name).
 		SetNillableDescription(req.Description).
+		SetQuery(strings.TrimSpace(req.Query)).
 		SetFilters(req.Filters).
 		SetColumns(req.Columns).
 		SetSortConfig(req.SortConfig).
@@ -156,6 +165,12 @@ func (s *TicketViewService) UpdateTicketView(
 	if req.Description != nil {
 		updateQuery.SetNillableDescription(req.Description)
 	}
+	if req.Query != nil {
+		if err := s.validateViewQuery(ctx, *req.Query, userID, tenantID); err != nil {
+			return nil, err
+		}
+		updateQuery.SetQuery(strings.TrimSpace(*req.Query))
+	}
 	if req.Filters != nil {
 		updateQuery.SetFilters(req.Filters)
 	}
@@ -261,3 +276,147 @@ func (s *TicketViewService) ShareTicketView(
 
 	return nil
 }
+
+// validateViewQuery 保存前校验视图的 TQL 查询（字段、运算符、自定义字段与取值）
+func (s *TicketViewService) validateViewQuery(ctx context.Context, query string, userID, tenantID int) error {
+	if strings.TrimSpace(query) == "" {
+		return nil
+	}
+	if _, err := compileTicketQuery(ctx, s.client, query, TicketQueryContext{TenantID: tenantID, UserID: userID}); err != nil {
+		return fmt.Errorf("视图查询无效: %w", err)
+	}
+	return nil
+}
+
+// RunTicketView 执行保存的视图，返回一页工单。
+// 视图未设置 TQL 时由 filters 编译得到；currentUser() 取当前查看人，共享视图对每个人展示各自的队列，
+// 结果同样受查看人的行级数据权限约束。视图不存在或无权访问时返回 ent.NotFoundError。
+func (s *TicketViewService) RunTicketView(
+	ctx context.Context,
+	viewID, userID, tenantID int,
+	role, cursor string,
+	limit int,
+) (*TicketQueryPage, error) {
+	view, err := s.client.TicketView.Query().
+		Where(
+			ticketview.ID(viewID),
+			ticketview.TenantID(tenantID),
+			ticketview.Or(
+				ticketview.CreatedBy(userID),
+				ticketview.IsShared(true),
+			),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ticket view not found: %w", err)
+	}
+
+	text := strings.TrimSpace(view.Query)
+	if text == "" {
+		text = ticketViewFiltersToTQL(view.Filters)
+	}
+	query, err := ParseTicketQuery(text)
+	if err != nil {
+		return nil, &TicketQueryError{Err: fmt.Errorf("视图查询无效: %w", err)}
+	}
+	if len(query.OrderBy) == 0 {
+		query.OrderBy = ticketViewSortToTQL(view.SortConfig)
+	}
+	compiled, err := compileParsedTicketQuery(ctx, s.client, query, TicketQueryContext{TenantID: tenantID, UserID: userID})
+	if err != nil {
+		return nil, fmt.Errorf("视图查询无效: %w", err)
+	}
+	compiled.restrictToRole(userID, role)
+	page, err := compiled.run(ctx, s.client, cursor, limit)
+	if err != nil {
+		s.logger.Errorw("Failed to run ticket view", "error", err, "view_id", viewID, "tenant_id", tenantID)
+		return nil, err
+	}
+	return page, nil
+}
+
+// ticketViewFiltersToTQL 将旧版结构化筛选条件转换为 TQL，无法表达的条件（分类名称、标签、SLA状态）忽略
+func ticketViewFiltersToTQL(filters map[string]interface{}) string {
+	if len(filters) == 0 {
+		return ""
+	}
+	var filter dto.TicketViewFilter
+	data, err := json.Marshal(filters)
+	if err != nil || json.Unmarshal(data, &filter) != nil {
+		return ""
+	}
+	var clauses []string
+	inClause := func(field string, values []string) {
+		if len(values) == 0 {
+			return
+		}
+		quoted := make([]string, len(values))
+		for i, v := range values {
+			quoted[i] = tqlQuote(v)
+		}
+		clauses = append(clauses, fmt.Sprintf("%s in (%s)", field, strings.Join(quoted, ", ")))
+	}
+	intInClause := func(field string, values []int) {
+		if len(values) == 0 {
+			return
+		}
+		parts := make([]string, len(values))
+		for i, v := range values {
+			parts[i] = fmt.Sprint(v)
+		}
+		clauses = append(clauses, fmt.Sprintf("%s in (%s)", field, strings.Join(parts, ", ")))
+	}
+	inClause("status", filter.Status)
+	inClause("priority", filter.Priority)
+	inClause("type", filter.Type)
+	intInClause("assignee", filter.AssigneeID)
+	intInClause("requester", filter.RequesterID)
+	if search := strings.TrimSpace(filter.Search); search != "" {
+		clauses = append(clauses, "text ~ "+tqlQuote(search))
+	}
+	if filter.DateFrom != "" {
+		clauses = append(clauses, "created_at >= "+tqlQuote(filter.DateFrom))
+	}
+	if filter.DateTo != "" {
+		clauses = append(clauses, "created_at <= "+tqlQuote(filter.DateTo))
+	}
+	customKeys := make([]string, 0, len(filter.Custom))
+	for key := range filter.Custom {
+		customKeys = append(customKeys, key)
+	}
+	sort.Strings(customKeys)
+	for _, key := range customKeys {
+		if strings.IndexFunc(key, func(r rune) bool { return !tqlIdentRune(r) }) >= 0 {
+			continue
+		}
+		clauses = append(clauses, fmt.Sprintf("cf.%s = %s", key, tqlQuote(filter.Custom[key])))
+	}
+	return strings.Join(clauses, " AND ")
+}
+
+// ticketViewSortToTQL 将视图排序配置转换为 ORDER BY，字段不可排序时沿用默认排序
+func ticketViewSortToTQL(config map[string]interface{}) []TicketQueryOrder {
+	fieldName, _ := config["field"].(string)
+	if fieldName == "" {
+		return nil
+	}
+	var b strings.Builder
+	for i, r := range fieldName {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	name := b.String()
+	if alias, ok := ticketQueryAliases[name]; ok {
+		name = alias
+	}
+	if field, ok := ticketQueryFields[name]; !ok || !field.Sortable {
+		return nil
+	}
+	direction, _ := config["direction"].(string)
+	return []TicketQueryOrder{{Field: name, Desc: strings.EqualFold(direction, "desc")}}
+}
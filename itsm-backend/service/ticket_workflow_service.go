@@ -104,6 +104,9 @@ func (s *TicketWorkflowService) AcceptTicket(ctx context.Context, req *dto.Accep
 	if txErr != nil {
 		return fmt.Errorf("提交接单事务失败: %w", txErr)
 	}
+	metrics := NewSLAMetricService(s.client, s.logger)
+	recordTicketSLAEvent(ctx, metrics, req.TicketID, tenantID, SLAMetricEvent{Type: SLAEventAssigned, ActorID: userID})
+	recordTicketSLAEvent(ctx, metrics, req.TicketID, tenantID, SLAMetricEvent{Type: SLAEventStatusChanged, FromStatus: tk.Status, ToStatus: "in_progress", ActorID: userID})
 	return txErr
 }
 
@@ -609,6 +612,12 @@ func (s *TicketWorkflowService) ResolveTicket(ctx context.Context, req *dto.Reso
 	}
 
 	txErr = tx.Commit()
+	if txErr == nil {
+		// 从等待状态直接解决时关闭 SLA 暂停区间
+		syncTicketSLAClock(ctx, NewTicketSLAService(s.client, s.logger), req.TicketID, tenantID)
+		recordTicketSLAEvent(ctx, NewSLAMetricService(s.client, s.logger), req.TicketID, tenantID,
+			SLAMetricEvent{Type: SLAEventStatusChanged, FromStatus: tk.Status, ToStatus: "resolved", ActorID: userID})
+	}
 	return txErr
 }
 
@@ -669,6 +678,10 @@ func (s *TicketWorkflowService) CloseTicket(ctx context.Context, req *dto.CloseT
 	}
 
 	txErr = tx.Commit()
+	if txErr == nil {
+		recordTicketSLAEvent(ctx, NewSLAMetricService(s.client, s.logger), req.TicketID, tenantID,
+			SLAMetricEvent{Type: SLAEventStatusChanged, FromStatus: tk.Status, ToStatus: newStatus, ActorID: userID})
+	}
 	return txErr
 }
 
@@ -725,6 +738,10 @@ func (s *TicketWorkflowService) ReopenTicket(ctx context.Context, req *dto.Reope
 	}
 
 	txErr = tx.Commit()
+	if txErr == nil {
+		recordTicketSLAEvent(ctx, NewSLAMetricService(s.client, s.logger), req.TicketID, tenantID,
+			SLAMetricEvent{Type: SLAEventStatusChanged, FromStatus: tk.Status, ToStatus: newStatus, ActorID: userID})
+	}
 	return txErr
 }
 
@@ -1025,6 +1042,8 @@ func normalizeNotifyChannels(channels []string) []string {
 		"feishu":   {},
 		"dingtalk": {},
 		"wecom":    {},
+		"slack":    {},
+		"teams":    {},
 		"webhook":  {},
 	}
 	seen := make(map[string]struct{}, len(channels))
@@ -1133,6 +1152,11 @@ func (s *TicketWorkflowService) createWorkflowRecord(ctx context.Context, record
 
 // createWorkflowRecordWithClient 使用指定的 Ent 客户端创建流转记录（支持事务内复用）
 func (s *TicketWorkflowService) createWorkflowRecordWithClient(ctx context.Context, client *ent.Client, record *dto.TicketWorkflowRecord, tenantID int) error {
+	return createTicketWorkflowRecord(ctx, client, record, tenantID)
+}
+
+// createTicketWorkflowRecord 写入工单流转记录，供合并/拆分等跨服务操作在事务内复用
+func createTicketWorkflowRecord(ctx context.Context, client *ent.Client, record *dto.TicketWorkflowRecord, tenantID int) error {
 	create := client.TicketWorkflowRecord.Create().
 		SetTicketID(record.TicketID).
 		SetAction(string(record.Action)).
@@ -261,6 +261,12 @@ func (s *UserService) UpdateUser(ctx context.Context, id int, req *dto.UpdateUse
 	if req.Phone != "" {
 		update = update.SetPhone(req.Phone)
 	}
+	if req.SlackUserID != "" {
+		update = update.SetSlackUserID(req.SlackUserID)
+	}
+	if req.TeamsUserID != "" {
+		update = update.SetTeamsUserID(req.TeamsUserID)
+	}
 	// 角色更新（仅在提供时设置），管理员权限由RBAC控制
 	if strings.TrimSpace(req.Role) != "" {
 		role := strings.ToLower(strings.TrimSpace(req.Role))
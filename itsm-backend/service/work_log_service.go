@@ -0,0 +1,602 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"time"
+
+	"itsm-backend/common"
+	"itsm-backend/dto"
+	"itsm-backend/ent"
+	"itsm-backend/ent/change"
+	"itsm-backend/ent/predicate"
+	"itsm-backend/ent/problem"
+	"itsm-backend/ent/team"
+	"itsm-backend/ent/tenant"
+	"itsm-backend/ent/ticket"
+	"itsm-backend/ent/ticketcategory"
+	"itsm-backend/ent/user"
+	"itsm-backend/ent/worklog"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// maxWorkLogMinutes 单条工时上限；忘记停止的计时器按此截断，由用户事后修正
+	maxWorkLogMinutes       = 24 * 60
+	workLogListDefaultLimit = 100
+	workLogListMaxLimit     = 500
+)
+
+var (
+	// ErrWorkLogTimerRunning 用户已有运行中的计时器
+	ErrWorkLogTimerRunning = errors.New("已有运行中的计时器，请先停止")
+	// ErrWorkLogNoTimer 用户没有运行中的计时器
+	ErrWorkLogNoTimer = errors.New("没有运行中的计时器")
+	// ErrWorkLogForbidden 无权修改他人的工时记录
+	ErrWorkLogForbidden = errors.New("只能修改自己的工时记录")
+)
+
+var workLogActivityLabels = map[string]string{
+	"general":         "常规",
+	"troubleshooting": "故障排查",
+	"implementation":  "实施",
+	"communication":   "沟通",
+	"meeting":         "会议",
+	"travel":          "差旅",
+	"onsite":          "现场支持",
+	"documentation":   "文档",
+}
+
+// WorkLogService 工单、变更、问题上的工时登记、计时器与汇总报表
+type WorkLogService struct {
+	client       *ent.Client
+	logger       *zap.SugaredLogger
+	reportExport *ReportExportService
+}
+
+// NewWorkLogService 创建工时服务
+func NewWorkLogService(client *ent.Client, logger *zap.SugaredLogger) *WorkLogService {
+	return &WorkLogService{client: client, logger: logger, reportExport: NewReportExportService()}
+}
+
+// workLogTarget 校验后的关联对象及记录时需要快照的归属信息
+type workLogTarget struct {
+	ticketID      *int
+	changeID      *int
+	problemID     *int
+	category      string
+	mspProviderID *int
+}
+
+// resolveTarget 校验关联对象属于当前租户，并取出分类与 MSP 托管信息
+func (s *WorkLogService) resolveTarget(ctx context.Context, tenantID int, target dto.WorkLogTarget) (*workLogTarget, error) {
+	set := 0
+	for _, id := range []*int{target.TicketID, target.ChangeID, target.ProblemID} {
+		if id != nil {
+			if *id <= 0 {
+				return nil, common.NewValidationError("无效的关联对象ID", nil)
+			}
+			set++
+		}
+	}
+	if set != 1 {
+		return nil, common.NewValidationError("必须且只能指定一个工单、变更或问题", nil)
+	}
+	out := &workLogTarget{}
+	switch {
+	case target.TicketID != nil:
+		t, err := s.client.Ticket.Query().
+			Where(ticket.IDEQ(*target.TicketID), ticket.TenantIDEQ(tenantID), ticket.DeletedAtIsNil()).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, common.NewAppError(common.ErrCodeNotFound, "工单不存在", http.StatusNotFound, nil)
+			}
+			return nil, fmt.Errorf("获取工单失败: %w", err)
+		}
+		out.ticketID = &t.ID
+		if t.CategoryID > 0 {
+			if cat, err := s.client.TicketCategory.Query().
+				Where(ticketcategory.IDEQ(t.CategoryID), ticketcategory.TenantIDEQ(tenantID)).
+				Only(ctx); err == nil {
+				out.category = cat.Name
+			}
+		}
+		if t.IsManagedByMsp && t.MspProviderID > 0 {
+			provider := t.MspProviderID
+			out.mspProviderID = &provider
+		}
+	case target.ChangeID != nil:
+		c, err := s.client.Change.Query().
+			Where(change.IDEQ(*target.ChangeID), change.TenantIDEQ(tenantID)).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, common.NewAppError(common.ErrCodeNotFound, "变更不存在", http.StatusNotFound, nil)
+			}
+			return nil, fmt.Errorf("获取变更失败: %w", err)
+		}
+		out.changeID = &c.ID
+		out.category = c.Type
+	default:
+		p, err := s.client.Problem.Query().
+			Where(problem.IDEQ(*target.ProblemID), problem.TenantIDEQ(tenantID), problem.DeletedAtIsNil()).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, common.NewAppError(common.ErrCodeNotFound, "问题不存在", http.StatusNotFound, nil)
+			}
+			return nil, fmt.Errorf("获取问题失败: %w", err)
+		}
+		out.problemID = &p.ID
+		out.category = p.Category
+	}
+	return out, nil
+}
+
+// actor 取出操作人；终端用户不登记工时
+func (s *WorkLogService) actor(ctx context.Context, userID int) (*ent.User, error) {
+	u, err := s.client.User.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, common.NewAppError(common.ErrCodeNotFound, "用户不存在", http.StatusNotFound, nil)
+		}
+		return nil, fmt.Errorf("获取用户失败: %w", err)
+	}
+	if !u.Active {
+		return nil, common.NewValidationError("用户已停用", nil)
+	}
+	if u.Role == user.RoleEndUser {
+		return nil, common.NewForbiddenError("终端用户不能登记工时")
+	}
+	return u, nil
+}
+
+// teamOf 记录时用户所属团队（MSP 员工的团队位于服务商租户，因此不按当前租户过滤）
+func (s *WorkLogService) teamOf(ctx context.Context, userID int) *int {
+	t, err := s.client.Team.Query().
+		Where(team.HasUsersWith(user.IDEQ(userID)), team.DeletedAtIsNil()).
+		Order(ent.Asc(team.FieldID)).
+		First(ctx)
+	if err != nil {
+		return nil
+	}
+	return &t.ID
+}
+
+func (s *WorkLogService) newEntry(c *ent.Client, tenantID, userID int, target *workLogTarget, teamID *int, startedAt time.Time, billable *bool, activityType, comment string) *ent.WorkLogCreate {
+	create := c.WorkLog.Create().
+		SetTenantID(tenantID).
+		SetUserID(userID).
+		SetNillableTeamID(teamID).
+		SetNillableTicketID(target.ticketID).
+		SetNillableChangeID(target.changeID).
+		SetNillableProblemID(target.problemID).
+		SetCategory(target.category).
+		SetNillableMspProviderID(target.mspProviderID).
+		SetStartedAt(startedAt).
+		SetComment(comment)
+	// MSP 托管工单上的工时默认计费
+	if billable != nil {
+		create.SetBillable(*billable)
+	} else {
+		create.SetBillable(target.mspProviderID != nil)
+	}
+	if activityType != "" {
+		create.SetActivityType(worklog.ActivityType(activityType))
+	}
+	return create
+}
+
+// LogTime 手工登记一段已完成的工时
+func (s *WorkLogService) LogTime(ctx context.Context, tenantID, userID int, req *dto.CreateWorkLogRequest) (*ent.WorkLog, error) {
+	if _, err := s.actor(ctx, userID); err != nil {
+		return nil, err
+	}
+	if req.DurationMinutes <= 0 || req.DurationMinutes > maxWorkLogMinutes {
+		return nil, common.NewValidationError(fmt.Sprintf("工时时长必须在 1 到 %d 分钟之间", maxWorkLogMinutes), nil)
+	}
+	if req.StartedAt.After(time.Now()) {
+		return nil, common.NewValidationError("开始时间不能晚于当前时间", nil)
+	}
+	target, err := s.resolveTarget(ctx, tenantID, req.WorkLogTarget)
+	if err != nil {
+		return nil, err
+	}
+	entry, err := s.newEntry(s.client, tenantID, userID, target, s.teamOf(ctx, userID), req.StartedAt, req.Billable, req.ActivityType, req.Comment).
+		SetEndedAt(req.StartedAt.Add(time.Duration(req.DurationMinutes) * time.Minute)).
+		SetDurationMinutes(req.DurationMinutes).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("登记工时失败: %w", err)
+	}
+	s.logger.Infow("Work logged", "tenant_id", tenantID, "user_id", userID, "work_log_id", entry.ID, "minutes", entry.DurationMinutes)
+	return entry, nil
+}
+
+// StartTimer 开始计时；每个用户在同一租户内同一时间只能有一个运行中的计时器
+func (s *WorkLogService) StartTimer(ctx context.Context, tenantID, userID int, req *dto.StartWorkLogTimerRequest) (*ent.WorkLog, error) {
+	if _, err := s.actor(ctx, userID); err != nil {
+		return nil, err
+	}
+	target, err := s.resolveTarget(ctx, tenantID, req.WorkLogTarget)
+	if err != nil {
+		return nil, err
+	}
+	teamID := s.teamOf(ctx, userID)
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("开启事务失败: %w", err)
+	}
+	running, err := tx.WorkLog.Query().
+		Where(worklog.TenantIDEQ(tenantID), worklog.UserIDEQ(userID), worklog.EndedAtIsNil()).
+		Exist(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("查询计时器失败: %w", err)
+	}
+	if running {
+		_ = tx.Rollback()
+		return nil, ErrWorkLogTimerRunning
+	}
+	entry, err := s.newEntry(tx.Client(), tenantID, userID, target, teamID, time.Now(), req.Billable, req.ActivityType, req.Comment).Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("开始计时失败: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("提交事务失败: %w", err)
+	}
+	return entry, nil
+}
+
+// RunningTimer 返回用户在当前租户运行中的计时器，没有时返回 nil
+func (s *WorkLogService) RunningTimer(ctx context.Context, tenantID, userID int) (*ent.WorkLog, error) {
+	entry, err := s.client.WorkLog.Query().
+		Where(worklog.TenantIDEQ(tenantID), worklog.UserIDEQ(userID), worklog.EndedAtIsNil()).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询计时器失败: %w", err)
+	}
+	return entry, nil
+}
+
+// StopTimer 停止计时并按实际时长（向上取整到分钟）写入工时
+func (s *WorkLogService) StopTimer(ctx context.Context, tenantID, userID int, req *dto.StopWorkLogTimerRequest) (*ent.WorkLog, error) {
+	running, err := s.RunningTimer(ctx, tenantID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if running == nil {
+		return nil, ErrWorkLogNoTimer
+	}
+	minutes := int(math.Ceil(time.Since(running.StartedAt).Minutes()))
+	if minutes < 1 {
+		minutes = 1
+	}
+	if minutes > maxWorkLogMinutes {
+		minutes = maxWorkLogMinutes
+	}
+	update := s.client.WorkLog.Update().
+		Where(worklog.IDEQ(running.ID), worklog.EndedAtIsNil()).
+		SetEndedAt(running.StartedAt.Add(time.Duration(minutes) * time.Minute)).
+		SetDurationMinutes(minutes)
+	if req != nil {
+		if req.Billable != nil {
+			update.SetBillable(*req.Billable)
+		}
+		if req.ActivityType != nil {
+			update.SetActivityType(worklog.ActivityType(*req.ActivityType))
+		}
+		if req.Comment != nil {
+			update.SetComment(*req.Comment)
+		}
+	}
+	// 以 ended_at IS NULL 为条件更新，并发停止时只有一次生效
+	n, err := update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("停止计时失败: %w", err)
+	}
+	if n == 0 {
+		return nil, ErrWorkLogNoTimer
+	}
+	return s.client.WorkLog.Get(ctx, running.ID)
+}
+
+// editable 取出可由 userID 修改的已结束记录：本人或管理员/经理
+func (s *WorkLogService) editable(ctx context.Context, tenantID, userID, id int) (*ent.WorkLog, error) {
+	entry, err := s.client.WorkLog.Query().
+		Where(worklog.IDEQ(id), worklog.TenantIDEQ(tenantID)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if entry.UserID != userID {
+		u, err := s.client.User.Get(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("获取用户失败: %w", err)
+		}
+		switch u.Role {
+		case user.RoleSuperAdmin, user.RoleAdmin, user.RoleManager:
+		default:
+			return nil, ErrWorkLogForbidden
+		}
+	}
+	return entry, nil
+}
+
+// UpdateWorkLog 修改已结束的工时记录
+func (s *WorkLogService) UpdateWorkLog(ctx context.Context, tenantID, userID, id int, req *dto.UpdateWorkLogRequest) (*ent.WorkLog, error) {
+	entry, err := s.editable(ctx, tenantID, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if entry.EndedAt == nil {
+		return nil, common.NewValidationError("计时中的记录请先停止", nil)
+	}
+	startedAt, minutes := entry.StartedAt, entry.DurationMinutes
+	if req.StartedAt != nil {
+		startedAt = *req.StartedAt
+	}
+	if req.DurationMinutes != nil {
+		minutes = *req.DurationMinutes
+	}
+	if minutes <= 0 || minutes > maxWorkLogMinutes {
+		return nil, common.NewValidationError(fmt.Sprintf("工时时长必须在 1 到 %d 分钟之间", maxWorkLogMinutes), nil)
+	}
+	if startedAt.After(time.Now()) {
+		return nil, common.NewValidationError("开始时间不能晚于当前时间", nil)
+	}
+	update := entry.Update().
+		SetStartedAt(startedAt).
+		SetDurationMinutes(minutes).
+		SetEndedAt(startedAt.Add(time.Duration(minutes) * time.Minute))
+	if req.Billable != nil {
+		update.SetBillable(*req.Billable)
+	}
+	if req.ActivityType != nil {
+		update.SetActivityType(worklog.ActivityType(*req.ActivityType))
+	}
+	if req.Comment != nil {
+		update.SetComment(*req.Comment)
+	}
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("更新工时失败: %w", err)
+	}
+	return updated, nil
+}
+
+// DeleteWorkLog 删除工时记录（含运行中的计时器）
+func (s *WorkLogService) DeleteWorkLog(ctx context.Context, tenantID, userID, id int) error {
+	entry, err := s.editable(ctx, tenantID, userID, id)
+	if err != nil {
+		return err
+	}
+	return s.client.WorkLog.DeleteOne(entry).Exec(ctx)
+}
+
+// ListWorkLogs 查询当前租户的工时记录，按开始时间倒序
+func (s *WorkLogService) ListWorkLogs(ctx context.Context, tenantID int, filter *dto.WorkLogListFilter) ([]*ent.WorkLog, error) {
+	query := s.client.WorkLog.Query().Where(worklog.TenantIDEQ(tenantID))
+	limit := workLogListDefaultLimit
+	if filter != nil {
+		if filter.TicketID > 0 {
+			query = query.Where(worklog.TicketIDEQ(filter.TicketID))
+		}
+		if filter.ChangeID > 0 {
+			query = query.Where(worklog.ChangeIDEQ(filter.ChangeID))
+		}
+		if filter.ProblemID > 0 {
+			query = query.Where(worklog.ProblemIDEQ(filter.ProblemID))
+		}
+		if filter.UserID > 0 {
+			query = query.Where(worklog.UserIDEQ(filter.UserID))
+		}
+		if filter.From != nil {
+			query = query.Where(worklog.StartedAtGTE(*filter.From))
+		}
+		if filter.To != nil {
+			query = query.Where(worklog.StartedAtLT(*filter.To))
+		}
+		if filter.Billable != nil {
+			query = query.Where(worklog.BillableEQ(*filter.Billable))
+		}
+		if filter.Limit > 0 {
+			limit = filter.Limit
+		}
+	}
+	if limit > workLogListMaxLimit {
+		limit = workLogListMaxLimit
+	}
+	entries, err := query.Order(ent.Desc(worklog.FieldStartedAt), ent.Desc(worklog.FieldID)).Limit(limit).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询工时记录失败: %w", err)
+	}
+	return entries, nil
+}
+
+// Report 按用户、团队、客户租户、分类或活动类型汇总已结束的工时。
+// 统计范围为本租户的工时，以及本租户作为 MSP 服务商托管的客户工单上的工时，
+// 因此 MSP 服务商按 customer 分组即得到各客户的计费工时。
+func (s *WorkLogService) Report(ctx context.Context, tenantID int, req *dto.WorkLogReportRequest) (*dto.WorkLogReport, error) {
+	groupBy := req.GroupBy
+	if groupBy == "" {
+		groupBy = "user"
+	}
+	preds := []predicate.WorkLog{
+		worklog.Or(worklog.TenantIDEQ(tenantID), worklog.MspProviderIDEQ(tenantID)),
+		worklog.EndedAtNotNil(),
+	}
+	if req.From != nil {
+		preds = append(preds, worklog.StartedAtGTE(*req.From))
+	}
+	if req.To != nil {
+		preds = append(preds, worklog.StartedAtLT(*req.To))
+	}
+	if req.Billable != nil {
+		preds = append(preds, worklog.BillableEQ(*req.Billable))
+	}
+	if req.MSPOnly {
+		preds = append(preds, worklog.MspProviderIDNotNil())
+	}
+	entries, err := s.client.WorkLog.Query().Where(preds...).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("查询工时记录失败: %w", err)
+	}
+
+	type bucket struct {
+		key   int
+		label string
+		row   dto.WorkLogReportRow
+	}
+	buckets := make(map[string]*bucket)
+	report := &dto.WorkLogReport{GroupBy: groupBy, From: req.From, To: req.To, GeneratedAt: time.Now()}
+	for _, e := range entries {
+		var key int
+		var name string
+		switch groupBy {
+		case "user":
+			key = e.UserID
+		case "team":
+			if e.TeamID != nil {
+				key = *e.TeamID
+			}
+		case "customer":
+			key = e.TenantID
+		case "category":
+			name = e.Category
+		case "activity_type":
+			name = string(e.ActivityType)
+		default:
+			return nil, common.NewValidationError("不支持的分组维度: "+groupBy, nil)
+		}
+		id := fmt.Sprintf("%d/%s", key, name)
+		b, ok := buckets[id]
+		if !ok {
+			b = &bucket{key: key, label: name}
+			buckets[id] = b
+		}
+		b.row.Entries++
+		b.row.TotalMinutes += e.DurationMinutes
+		report.TotalMinutes += e.DurationMinutes
+		if e.Billable {
+			b.row.BillableMinutes += e.DurationMinutes
+			report.BillableMinutes += e.DurationMinutes
+		}
+	}
+
+	keys := make([]int, 0, len(buckets))
+	for _, b := range buckets {
+		if b.key > 0 {
+			keys = append(keys, b.key)
+		}
+	}
+	labels, err := s.groupLabels(ctx, groupBy, keys)
+	if err != nil {
+		return nil, err
+	}
+	report.Rows = make([]dto.WorkLogReportRow, 0, len(buckets))
+	for _, b := range buckets {
+		row := b.row
+		row.Key = b.key
+		row.Label = b.label
+		switch groupBy {
+		case "user", "team", "customer":
+			row.Label = labels[b.key]
+			if row.Label == "" {
+				if b.key == 0 {
+					row.Label = "未分组"
+				} else {
+					row.Label = fmt.Sprintf("#%d", b.key)
+				}
+			}
+		case "category":
+			if row.Label == "" {
+				row.Label = "未分类"
+			}
+		case "activity_type":
+			if l, ok := workLogActivityLabels[row.Label]; ok {
+				row.Label = l
+			}
+		}
+		row.TotalHours = minutesToHours(row.TotalMinutes)
+		row.BillableHours = minutesToHours(row.BillableMinutes)
+		report.Rows = append(report.Rows, row)
+	}
+	sort.Slice(report.Rows, func(i, j int) bool {
+		if report.Rows[i].TotalMinutes != report.Rows[j].TotalMinutes {
+			return report.Rows[i].TotalMinutes > report.Rows[j].TotalMinutes
+		}
+		return report.Rows[i].Label < report.Rows[j].Label
+	})
+	report.TotalHours = minutesToHours(report.TotalMinutes)
+	report.BillableHours = minutesToHours(report.BillableMinutes)
+	return report, nil
+}
+
+// groupLabels 查出用户、团队或客户租户的名称
+func (s *WorkLogService) groupLabels(ctx context.Context, groupBy string, ids []int) (map[int]string, error) {
+	labels := make(map[int]string, len(ids))
+	if len(ids) == 0 {
+		return labels, nil
+	}
+	switch groupBy {
+	case "user":
+		users, err := s.client.User.Query().Where(user.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("查询用户失败: %w", err)
+		}
+		for _, u := range users {
+			labels[u.ID] = u.Name
+			if labels[u.ID] == "" {
+				labels[u.ID] = u.Username
+			}
+		}
+	case "team":
+		teams, err := s.client.Team.Query().Where(team.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("查询团队失败: %w", err)
+		}
+		for _, t := range teams {
+			labels[t.ID] = t.Name
+		}
+	case "customer":
+		tenants, err := s.client.Tenant.Query().Where(tenant.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("查询租户失败: %w", err)
+		}
+		for _, t := range tenants {
+			labels[t.ID] = t.Name
+		}
+	}
+	return labels, nil
+}
+
+// ExportReport 通过报告导出服务生成工时汇总的 Excel 或 PDF
+func (s *WorkLogService) ExportReport(ctx context.Context, tenantID int, req *dto.WorkLogReportRequest, format string) ([]byte, string, error) {
+	report, err := s.Report(ctx, tenantID, req)
+	if err != nil {
+		return nil, "", err
+	}
+	switch format {
+	case "excel":
+		return s.reportExport.ExportWorkLogReportToExcel(ctx, report)
+	case "pdf":
+		return s.reportExport.ExportWorkLogReportToPDF(ctx, report)
+	default:
+		return nil, "", common.NewValidationError("不支持的导出格式: "+format, nil)
+	}
+}
+
+func minutesToHours(minutes int) float64 {
+	return math.Round(float64(minutes)/60*100) / 100
+}
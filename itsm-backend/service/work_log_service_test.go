@@ -0,0 +1,322 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"itsm-backend/common"
+	"itsm-backend/dto"
+	"itsm-backend/ent"
+	"itsm-backend/ent/enttest"
+	"itsm-backend/ent/tenant"
+	"itsm-backend/ent/user"
+	"itsm-backend/ent/worklog"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestWorkLogService(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", testDSN())
+	defer client.Close()
+	ctx := context.Background()
+	svc := NewWorkLogService(client, zaptest.NewLogger(t).Sugar())
+
+	newTenant := func(name, code, tenantType string) *ent.Tenant {
+		tn, err := client.Tenant.Create().SetName(name).SetCode(code).SetType(tenant.Type(tenantType)).SetStatus("active").Save(ctx)
+		require.NoError(t, err)
+		return tn
+	}
+	msp := newTenant("MSP 服务商", "msp", "msp_provider")
+	customer := newTenant("客户甲", "cust_a", "msp_customer")
+	other := newTenant("其他租户", "other", "standard")
+
+	newUser := func(name, role string, tenantID int) *ent.User {
+		u, err := client.User.Create().
+			SetUsername(name).
+			SetEmail(name + "@example.com").
+			SetName(name).
+			SetPasswordHash("hashedpassword").
+			SetRole(user.Role(role)).
+			SetActive(true).
+			SetTenantID(tenantID).
+			Save(ctx)
+		require.NoError(t, err)
+		return u
+	}
+	alice := newUser("alice", "agent", customer.ID)
+	bob := newUser("bob", "technician", customer.ID)
+	manager := newUser("manager", "manager", customer.ID)
+	requester := newUser("requester", "end_user", customer.ID)
+	engineer := newUser("engineer", "agent", msp.ID)
+
+	network, err := client.Team.Create().SetName("网络组").SetCode("network").SetTenantID(customer.ID).AddUserIDs(alice.ID).Save(ctx)
+	require.NoError(t, err)
+	category, err := client.TicketCategory.Create().SetName("网络故障").SetCode("network").SetTenantID(customer.ID).Save(ctx)
+	require.NoError(t, err)
+
+	internalTicket, err := client.Ticket.Create().SetTitle("VPN 故障").SetStatus("open").SetPriority("high").
+		SetTicketNumber("T-1").SetRequesterID(requester.ID).SetTenantID(customer.ID).SetCategoryID(category.ID).Save(ctx)
+	require.NoError(t, err)
+	managedTicket, err := client.Ticket.Create().SetTitle("服务器巡检").SetStatus("open").SetPriority("medium").
+		SetTicketNumber("T-2").SetRequesterID(requester.ID).SetTenantID(customer.ID).
+		SetIsManagedByMsp(true).SetMspProviderID(msp.ID).Save(ctx)
+	require.NoError(t, err)
+	chg, err := client.Change.Create().SetTitle("核心交换机升级").SetType("emergency").SetCreatedBy(manager.ID).SetTenantID(customer.ID).Save(ctx)
+	require.NoError(t, err)
+	foreignTicket, err := client.Ticket.Create().SetTitle("别人的工单").SetStatus("open").SetPriority("low").
+		SetTicketNumber("T-3").SetRequesterID(newUser("outsider", "end_user", other.ID).ID).SetTenantID(other.ID).Save(ctx)
+	require.NoError(t, err)
+
+	started := time.Now().Add(-3 * time.Hour)
+	t.Run("log time snapshots team and category", func(t *testing.T) {
+		entry, err := svc.LogTime(ctx, customer.ID, alice.ID, &dto.CreateWorkLogRequest{
+			WorkLogTarget:   dto.WorkLogTarget{TicketID: &internalTicket.ID},
+			StartedAt:       started,
+			DurationMinutes: 90,
+			ActivityType:    "troubleshooting",
+			Comment:         "排查 VPN 网关",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 90, entry.DurationMinutes)
+		assert.Equal(t, started.Add(90*time.Minute).Unix(), entry.EndedAt.Unix())
+		assert.Equal(t, network.ID, *entry.TeamID)
+		assert.Equal(t, "网络故障", entry.Category)
+		assert.False(t, entry.Billable)
+		assert.Nil(t, entry.MspProviderID)
+		assert.Equal(t, worklog.ActivityTypeTroubleshooting, entry.ActivityType)
+	})
+
+	t.Run("msp managed ticket defaults to billable", func(t *testing.T) {
+		entry, err := svc.LogTime(ctx, customer.ID, engineer.ID, &dto.CreateWorkLogRequest{
+			WorkLogTarget:   dto.WorkLogTarget{TicketID: &managedTicket.ID},
+			StartedAt:       started,
+			DurationMinutes: 120,
+		})
+		require.NoError(t, err)
+		assert.True(t, entry.Billable)
+		assert.Equal(t, msp.ID, *entry.MspProviderID)
+		assert.Nil(t, entry.TeamID)
+
+		notBillable := false
+		entry, err = svc.LogTime(ctx, customer.ID, engineer.ID, &dto.CreateWorkLogRequest{
+			WorkLogTarget:   dto.WorkLogTarget{TicketID: &managedTicket.ID},
+			StartedAt:       started,
+			DurationMinutes: 30,
+			Billable:        &notBillable,
+			ActivityType:    "travel",
+		})
+		require.NoError(t, err)
+		assert.False(t, entry.Billable)
+	})
+
+	t.Run("change work uses change type as category", func(t *testing.T) {
+		entry, err := svc.LogTime(ctx, customer.ID, bob.ID, &dto.CreateWorkLogRequest{
+			WorkLogTarget:   dto.WorkLogTarget{ChangeID: &chg.ID},
+			StartedAt:       started,
+			DurationMinutes: 60,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, chg.ID, *entry.ChangeID)
+		assert.Equal(t, "emergency", entry.Category)
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		base := dto.CreateWorkLogRequest{StartedAt: started, DurationMinutes: 10}
+
+		errCode := func(err error) common.ErrorCode {
+			var appErr *common.AppError
+			require.ErrorAs(t, err, &appErr)
+			return appErr.Code
+		}
+
+		req := base
+		_, err := svc.LogTime(ctx, customer.ID, alice.ID, &req)
+		assert.ErrorContains(t, err, "必须且只能指定一个")
+		assert.Equal(t, common.ErrCodeValidation, errCode(err))
+
+		req.WorkLogTarget = dto.WorkLogTarget{TicketID: &internalTicket.ID, ChangeID: &chg.ID}
+		_, err = svc.LogTime(ctx, customer.ID, alice.ID, &req)
+		assert.ErrorContains(t, err, "必须且只能指定一个")
+
+		req.WorkLogTarget = dto.WorkLogTarget{TicketID: &foreignTicket.ID}
+		_, err = svc.LogTime(ctx, customer.ID, alice.ID, &req)
+		assert.ErrorContains(t, err, "工单不存在")
+		assert.Equal(t, common.ErrCodeNotFound, errCode(err))
+
+		req.WorkLogTarget = dto.WorkLogTarget{TicketID: &internalTicket.ID}
+		_, err = svc.LogTime(ctx, customer.ID, requester.ID, &req)
+		assert.ErrorContains(t, err, "终端用户")
+		assert.Equal(t, common.ErrCodeForbidden, errCode(err))
+
+		req.StartedAt = time.Now().Add(time.Hour)
+		_, err = svc.LogTime(ctx, customer.ID, alice.ID, &req)
+		assert.Equal(t, common.ErrCodeValidation, errCode(err))
+	})
+
+	t.Run("timer start and stop", func(t *testing.T) {
+		running, err := svc.RunningTimer(ctx, customer.ID, bob.ID)
+		require.NoError(t, err)
+		assert.Nil(t, running)
+
+		timer, err := svc.StartTimer(ctx, customer.ID, bob.ID, &dto.StartWorkLogTimerRequest{
+			WorkLogTarget: dto.WorkLogTarget{TicketID: &internalTicket.ID},
+			ActivityType:  "onsite",
+		})
+		require.NoError(t, err)
+		assert.Nil(t, timer.EndedAt)
+		assert.Zero(t, timer.DurationMinutes)
+
+		_, err = svc.StartTimer(ctx, customer.ID, bob.ID, &dto.StartWorkLogTimerRequest{
+			WorkLogTarget: dto.WorkLogTarget{ChangeID: &chg.ID},
+		})
+		assert.ErrorIs(t, err, ErrWorkLogTimerRunning)
+
+		// 正在计时的记录不计入汇总
+		report, err := svc.Report(ctx, customer.ID, &dto.WorkLogReportRequest{GroupBy: "user"})
+		require.NoError(t, err)
+		for _, row := range report.Rows {
+			if row.Key == bob.ID {
+				assert.Equal(t, 60, row.TotalMinutes)
+			}
+		}
+
+		_, err = svc.UpdateWorkLog(ctx, customer.ID, bob.ID, timer.ID, &dto.UpdateWorkLogRequest{})
+		assert.ErrorContains(t, err, "请先停止")
+
+		// 模拟已经计时约 89.5 分钟
+		_, err = client.WorkLog.UpdateOneID(timer.ID).SetStartedAt(time.Now().Add(-89*time.Minute - 30*time.Second)).Save(ctx)
+		require.NoError(t, err)
+		comment := "更换交换机端口"
+		stopped, err := svc.StopTimer(ctx, customer.ID, bob.ID, &dto.StopWorkLogTimerRequest{Comment: &comment})
+		require.NoError(t, err)
+		assert.Equal(t, 90, stopped.DurationMinutes)
+		require.NotNil(t, stopped.EndedAt)
+		assert.Equal(t, comment, stopped.Comment)
+		assert.Equal(t, worklog.ActivityTypeOnsite, stopped.ActivityType)
+
+		_, err = svc.StopTimer(ctx, customer.ID, bob.ID, nil)
+		assert.ErrorIs(t, err, ErrWorkLogNoTimer)
+	})
+
+	t.Run("only owner or manager may edit", func(t *testing.T) {
+		entries, err := svc.ListWorkLogs(ctx, customer.ID, &dto.WorkLogListFilter{UserID: alice.ID})
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		id := entries[0].ID
+
+		minutes := 100
+		_, err = svc.UpdateWorkLog(ctx, customer.ID, bob.ID, id, &dto.UpdateWorkLogRequest{DurationMinutes: &minutes})
+		assert.ErrorIs(t, err, ErrWorkLogForbidden)
+		assert.ErrorIs(t, svc.DeleteWorkLog(ctx, customer.ID, bob.ID, id), ErrWorkLogForbidden)
+
+		updated, err := svc.UpdateWorkLog(ctx, customer.ID, manager.ID, id, &dto.UpdateWorkLogRequest{DurationMinutes: &minutes})
+		require.NoError(t, err)
+		assert.Equal(t, 100, updated.DurationMinutes)
+		assert.Equal(t, updated.StartedAt.Add(100*time.Minute).Unix(), updated.EndedAt.Unix())
+
+		_, err = svc.UpdateWorkLog(ctx, other.ID, manager.ID, id, &dto.UpdateWorkLogRequest{DurationMinutes: &minutes})
+		assert.True(t, ent.IsNotFound(err))
+	})
+
+	t.Run("list filters", func(t *testing.T) {
+		entries, err := svc.ListWorkLogs(ctx, customer.ID, &dto.WorkLogListFilter{TicketID: managedTicket.ID})
+		require.NoError(t, err)
+		assert.Len(t, entries, 2)
+		billable := true
+		entries, err = svc.ListWorkLogs(ctx, customer.ID, &dto.WorkLogListFilter{Billable: &billable})
+		require.NoError(t, err)
+		assert.Len(t, entries, 1)
+		entries, err = svc.ListWorkLogs(ctx, other.ID, nil)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+
+	rowsByLabel := func(report *dto.WorkLogReport) map[string]dto.WorkLogReportRow {
+		out := make(map[string]dto.WorkLogReportRow)
+		for _, row := range report.Rows {
+			out[row.Label] = row
+		}
+		return out
+	}
+
+	t.Run("aggregations", func(t *testing.T) {
+		report, err := svc.Report(ctx, customer.ID, &dto.WorkLogReportRequest{GroupBy: "user"})
+		require.NoError(t, err)
+		// alice 100 + engineer 150 + bob 60 + 90
+		assert.Equal(t, 400, report.TotalMinutes)
+		assert.Equal(t, 120, report.BillableMinutes)
+		assert.Equal(t, 2.0, report.BillableHours)
+		rows := rowsByLabel(report)
+		assert.Equal(t, 150, rows["bob"].TotalMinutes)
+		assert.Equal(t, 2, rows["engineer"].Entries)
+		assert.Equal(t, 2.5, rows["engineer"].TotalHours)
+		assert.Equal(t, "bob", report.Rows[0].Label)
+
+		report, err = svc.Report(ctx, customer.ID, &dto.WorkLogReportRequest{GroupBy: "team"})
+		require.NoError(t, err)
+		rows = rowsByLabel(report)
+		assert.Equal(t, 100, rows["网络组"].TotalMinutes)
+		assert.Equal(t, 300, rows["未分组"].TotalMinutes)
+
+		report, err = svc.Report(ctx, customer.ID, &dto.WorkLogReportRequest{GroupBy: "category"})
+		require.NoError(t, err)
+		rows = rowsByLabel(report)
+		assert.Equal(t, 190, rows["网络故障"].TotalMinutes)
+		assert.Equal(t, 60, rows["emergency"].TotalMinutes)
+		assert.Equal(t, 150, rows["未分类"].TotalMinutes)
+
+		report, err = svc.Report(ctx, customer.ID, &dto.WorkLogReportRequest{GroupBy: "activity_type"})
+		require.NoError(t, err)
+		rows = rowsByLabel(report)
+		assert.Equal(t, 30, rows["差旅"].TotalMinutes)
+		assert.Equal(t, 90, rows["现场支持"].TotalMinutes)
+
+		from := time.Now().Add(time.Hour)
+		report, err = svc.Report(ctx, customer.ID, &dto.WorkLogReportRequest{From: &from})
+		require.NoError(t, err)
+		assert.Empty(t, report.Rows)
+	})
+
+	t.Run("msp provider bills per customer", func(t *testing.T) {
+		billable := true
+		report, err := svc.Report(ctx, msp.ID, &dto.WorkLogReportRequest{GroupBy: "customer", Billable: &billable})
+		require.NoError(t, err)
+		require.Len(t, report.Rows, 1)
+		assert.Equal(t, customer.ID, report.Rows[0].Key)
+		assert.Equal(t, "客户甲", report.Rows[0].Label)
+		assert.Equal(t, 2.0, report.Rows[0].BillableHours)
+
+		report, err = svc.Report(ctx, msp.ID, &dto.WorkLogReportRequest{GroupBy: "customer"})
+		require.NoError(t, err)
+		assert.Equal(t, 150, report.TotalMinutes)
+
+		// 客户租户按 msp_only 只看托管工单上的工时
+		report, err = svc.Report(ctx, customer.ID, &dto.WorkLogReportRequest{GroupBy: "customer", MSPOnly: true})
+		require.NoError(t, err)
+		assert.Equal(t, 150, report.TotalMinutes)
+
+		report, err = svc.Report(ctx, other.ID, &dto.WorkLogReportRequest{GroupBy: "customer"})
+		require.NoError(t, err)
+		assert.Empty(t, report.Rows)
+	})
+
+	t.Run("export through report export service", func(t *testing.T) {
+		data, filename, err := svc.ExportReport(ctx, msp.ID, &dto.WorkLogReportRequest{GroupBy: "customer"}, "excel")
+		require.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(data, []byte("PK")))
+		assert.Contains(t, filename, ".xlsx")
+
+		data, filename, err = svc.ExportReport(ctx, customer.ID, &dto.WorkLogReportRequest{GroupBy: "user"}, "pdf")
+		require.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(data, []byte("%PDF")))
+		assert.Contains(t, string(data), "bob")
+		assert.Contains(t, filename, ".pdf")
+
+		_, _, err = svc.ExportReport(ctx, customer.ID, &dto.WorkLogReportRequest{}, "csv")
+		assert.Error(t, err)
+	})
+}